@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func newBackupCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Restore backups taken by the server",
+	}
+	cmd.AddCommand(newBackupRestoreCmd(build))
+
+	return cmd
+}
+
+func newBackupRestoreCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Load a backup from the bucket (--key) or a local file (--file) into a fresh database",
+		Long: "Load a backup into a database migrated to the schema version the backup was taken at, " +
+			"which must have no users and entities yet. Everything is loaded in one transaction. " +
+			"Large backups may need a longer --timeout.",
+		Args: cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			key, _ := cmd.Flags().GetString("key")
+			file, _ := cmd.Flags().GetString("file")
+
+			var archive io.ReadCloser
+			switch {
+			case key != "" && file != "":
+				return errors.New("--key and --file are mutually exclusive")
+			case key != "":
+				if a.backupStore == nil {
+					return errors.New("--key needs backup.s3 to be configured")
+				}
+				r, err := a.backupStore.Get(ctx, key)
+				if err != nil {
+					return err
+				}
+				archive = r
+			case file != "":
+				f, err := os.Open(file)
+				if err != nil {
+					return err
+				}
+				archive = f
+			default:
+				return errors.New("either --key or --file is required")
+			}
+			defer archive.Close()
+
+			manifest, err := a.backup.Restore(ctx, archive)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(tw, "TABLE\tROWS")
+			for _, table := range manifest.Tables {
+				_, _ = fmt.Fprintf(tw, "%s\t%d\n", table.Name, table.Rows)
+			}
+			if err = tw.Flush(); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "backup of %s restored\n", manifest.CreatedAt.Format("2006-01-02 15:04:05 MST"))
+			if manifest.PasswordHashesOmitted {
+				_, _ = fmt.Fprintln(out, "the backup has no password hashes: nobody can log in until an admin created with `user create` sets new passwords")
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().String("key", "", "key of the archive in the backup bucket")
+	cmd.Flags().String("file", "", "path of a downloaded archive")
+
+	return cmd
+}
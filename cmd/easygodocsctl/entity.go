@@ -0,0 +1,275 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	confluenceusecase "github.com/66gu1/easygodocs/internal/app/confluence/usecase"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// exportNode is the on-disk format of entity export/import. IDs are not kept:
+// imported entities get new IDs and the hierarchy is restored from nesting.
+type exportNode struct {
+	Type     entity.Type  `json:"type"`
+	Name     string       `json:"name"`
+	Content  string       `json:"content"`
+	IsDraft  bool         `json:"is_draft,omitempty"`
+	Children []exportNode `json:"children,omitempty"`
+}
+
+func newEntityCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entity",
+		Short: "Export and import entity trees",
+	}
+	cmd.AddCommand(newEntityExportCmd(build), newEntityImportCmd(build), newEntityImportConfluenceCmd(build))
+
+	return cmd
+}
+
+func newEntityExportCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the whole tree, or the subtree under --root, as JSON",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			tree, err := a.entity.GetTree(ctx, nil, true)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("root") {
+				rootID, err := parseUUIDFlag(cmd, "root")
+				if err != nil {
+					return err
+				}
+				node := findNode(tree, rootID)
+				if node == nil {
+					return fmt.Errorf("entity %s not found", rootID)
+				}
+				tree = entity.Tree{node}
+			}
+
+			nodes, err := exportTree(ctx, a.entity, a.sanitizer, tree)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if path, _ := cmd.Flags().GetString("out"); path != "" {
+				f, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			return enc.Encode(nodes)
+		}),
+	}
+	cmd.Flags().String("root", "", "export only the subtree under this entity ID")
+	cmd.Flags().String("out", "", "output file (stdout when omitted)")
+
+	return cmd
+}
+
+func newEntityImportCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an entity tree produced by export",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			authorID, err := parseUUIDFlag(cmd, "author")
+			if err != nil {
+				return err
+			}
+			var parentID *uuid.UUID
+			if cmd.Flags().Changed("parent") {
+				id, err := parseUUIDFlag(cmd, "parent")
+				if err != nil {
+					return err
+				}
+				parentID = &id
+			}
+
+			var in io.Reader = cmd.InOrStdin()
+			if path, _ := cmd.Flags().GetString("file"); path != "" && path != "-" {
+				f, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+			var nodes []exportNode
+			if err = json.NewDecoder(in).Decode(&nodes); err != nil {
+				return fmt.Errorf("decode import file: %w", err)
+			}
+
+			count, err := importNodes(ctx, a.entity, a.sanitizer, cmd.ErrOrStderr(), nodes, parentID, authorID)
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%d entities imported\n", count)
+			return err
+		}),
+	}
+	cmd.Flags().String("file", "-", "input file (stdin when omitted or -)")
+	cmd.Flags().String("author", "", "user ID recorded as the author of imported entities")
+	cmd.Flags().String("parent", "", "attach imported roots under this entity ID")
+	_ = cmd.MarkFlagRequired("author")
+
+	return cmd
+}
+
+func newEntityImportConfluenceCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-confluence <export>",
+		Short: "Import a Confluence space exported as HTML, from a directory or zip file, as articles",
+		Args:  cobra.ExactArgs(1),
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, args []string) error {
+			authorID, err := parseUUIDFlag(cmd, "author")
+			if err != nil {
+				return err
+			}
+			var parentID *uuid.UUID
+			if cmd.Flags().Changed("parent") {
+				id, err := parseUUIDFlag(cmd, "parent")
+				if err != nil {
+					return err
+				}
+				parentID = &id
+			}
+
+			var export fs.FS
+			if strings.HasSuffix(strings.ToLower(args[0]), ".zip") {
+				zr, err := zip.OpenReader(args[0])
+				if err != nil {
+					return err
+				}
+				defer zr.Close()
+				export = zr
+			} else {
+				export = os.DirFS(args[0])
+			}
+
+			report, err := a.confluence.Import(ctx, confluenceusecase.ImportCmd{Export: export, ParentID: parentID, AuthorID: authorID})
+			if path, _ := cmd.Flags().GetString("report"); path != "" && report.Pages != nil {
+				if werr := writeReport(path, report); werr != nil && err == nil {
+					err = werr
+				}
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "STATUS\tFILE\tENTITY\tTITLE\tNOTE")
+			for _, page := range report.Pages {
+				entityID, note := "-", page.Error
+				if page.EntityID != nil {
+					entityID = page.EntityID.String()
+				}
+				if note == "" && len(page.Warnings) > 0 {
+					note = fmt.Sprintf("%d warnings", len(page.Warnings))
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", page.Status, page.File, entityID, page.Title, note)
+			}
+			if ferr := w.Flush(); ferr != nil && err == nil {
+				err = ferr
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%d imported, %d failed, %d skipped\n", report.Imported, report.Failed, report.Skipped)
+			return err
+		}),
+	}
+	cmd.Flags().String("author", "", "user ID recorded as the author of imported entities")
+	cmd.Flags().String("parent", "", "attach the imported pages under this entity ID")
+	cmd.Flags().String("report", "", "write the page mapping report as JSON to this file")
+	_ = cmd.MarkFlagRequired("author")
+
+	return cmd
+}
+
+func writeReport(path string, report any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func findNode(tree entity.Tree, id uuid.UUID) *entity.Node {
+	for _, node := range tree {
+		if node.ID == id {
+			return node
+		}
+		if found := findNode(node.Children, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// exportTree exports content without the markup the sanitize policy does not allow.
+func exportTree(ctx context.Context, core entityCore, sanitizer contentSanitizer, tree entity.Tree) ([]exportNode, error) {
+	nodes := make([]exportNode, 0, len(tree))
+	for _, node := range tree {
+		e, err := core.Get(ctx, node.ID)
+		if err != nil {
+			return nil, fmt.Errorf("export entity %s: %w", node.ID, err)
+		}
+		children, err := exportTree(ctx, core, sanitizer, node.Children)
+		if err != nil {
+			return nil, err
+		}
+		content, _ := sanitizer.Sanitize(e.Content)
+		nodes = append(nodes, exportNode{
+			Type:     e.Type,
+			Name:     e.Name,
+			Content:  content,
+			IsDraft:  e.CurrentVersion == nil,
+			Children: children,
+		})
+	}
+	return nodes, nil
+}
+
+// importNodes creates parents before their children and stops at the first error. Markup the sanitize
+// policy does not allow is removed from the content and reported to warn.
+func importNodes(ctx context.Context, core entityCore, sanitizer contentSanitizer, warn io.Writer, nodes []exportNode, parentID *uuid.UUID, authorID uuid.UUID) (int, error) {
+	count := 0
+	for _, node := range nodes {
+		content, removed := sanitizer.Sanitize(node.Content)
+		if len(removed) > 0 {
+			_, _ = fmt.Fprintf(warn, "entity %q: removed unsafe markup: %s\n", node.Name, strings.Join(removed, ", "))
+		}
+		id, _, err := core.Create(ctx, entity.CreateEntityReq{
+			Type:     node.Type,
+			Name:     node.Name,
+			Content:  content,
+			ParentID: parentID,
+			IsDraft:  node.IsDraft,
+			UserID:   authorID,
+		})
+		if err != nil {
+			return count, fmt.Errorf("import entity %q: %w", node.Name, err)
+		}
+		count++
+
+		n, err := importNodes(ctx, core, sanitizer, warn, node.Children, &id, authorID)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,331 @@
+// Command easygodocsctl is an administration CLI. It talks to the database directly
+// through the same core packages the server uses, so all domain validation applies.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	attachmentrepo "github.com/66gu1/easygodocs/internal/app/attachment/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	authrepo "github.com/66gu1/easygodocs/internal/app/auth/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	backuprepo "github.com/66gu1/easygodocs/internal/app/backup/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/confluence"
+	confluenceusecase "github.com/66gu1/easygodocs/internal/app/confluence/usecase"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	entityrepo "github.com/66gu1/easygodocs/internal/app/entity/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	quarantinerepo "github.com/66gu1/easygodocs/internal/app/quarantine/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	userrepo "github.com/66gu1/easygodocs/internal/app/user/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	workspacerepo "github.com/66gu1/easygodocs/internal/app/workspace/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/s3"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/66gu1/easygodocs/internal/infrastructure/system"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type userCore interface {
+	CreateUser(ctx context.Context, req user.CreateUserReq) (uuid.UUID, error)
+	GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (user.UsersPage, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+}
+
+type authCore interface {
+	AddUserRole(ctx context.Context, userRole auth.UserRole) error
+	DeleteUserRole(ctx context.Context, role auth.UserRole) error
+	DeleteSession(ctx context.Context, id, userID uuid.UUID) error
+	DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error
+	RepairGrants(ctx context.Context, dryRun bool) (auth.ConsistencyReport, error)
+}
+
+type entityCore interface {
+	Get(ctx context.Context, id uuid.UUID) (entity.Entity, error)
+	GetTree(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (entity.Tree, error)
+	Create(ctx context.Context, req entity.CreateEntityReq) (uuid.UUID, entity.ContentUsage, error)
+	Update(ctx context.Context, req entity.UpdateEntityReq) (entity.ContentUsage, error)
+}
+
+type contentSanitizer interface {
+	Sanitize(content string) (string, []string)
+}
+
+type confluenceService interface {
+	Import(ctx context.Context, cmd confluenceusecase.ImportCmd) (confluence.Report, error)
+}
+
+type backupCore interface {
+	Restore(ctx context.Context, r io.Reader) (backup.Manifest, error)
+}
+
+type backupStore interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type workspaceCore interface {
+	GetBySlug(ctx context.Context, slug string) (workspace.Workspace, error)
+}
+
+type app struct {
+	user       userCore
+	auth       authCore
+	entity     entityCore
+	workspace  workspaceCore
+	confluence confluenceService
+	sanitizer  contentSanitizer
+	backup     backupCore
+	// backupStore is nil when no bucket is configured
+	backupStore backupStore
+}
+
+func main() {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	if err := godotenv.Overload(".env"); err != nil {
+		log.Debug().Err(err).Msg("failed to load .env file, using environment variables")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := newRootCmd(newApp).ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
+
+// appBuilder wires the app for a command; it is newApp outside of tests.
+type appBuilder func(cmd *cobra.Command) (*app, error)
+
+func newRootCmd(build appBuilder) *cobra.Command {
+	root := &cobra.Command{
+		Use:          "easygodocsctl",
+		Short:        "Administration tool for EasyGoDocs",
+		SilenceUsage: true,
+	}
+	root.PersistentFlags().String("config", "", "path to the config file (YAML or TOML)")
+	root.PersistentFlags().String("dsn", "", "database DSN (overrides the config and $DATABASE_DSN)")
+	root.PersistentFlags().Duration("timeout", time.Minute, "timeout for a single command")
+	root.PersistentFlags().String("workspace", "", "slug of the workspace to work in (default workspace if empty)")
+
+	root.AddCommand(
+		newUserCmd(build),
+		newRoleCmd(build),
+		newEntityCmd(build),
+		newSessionCmd(build),
+		newConfigCmd(),
+		newBackupCmd(build),
+	)
+
+	return root
+}
+
+// withApp wires the cores with build and runs fn with a command-scoped timeout.
+func withApp(build appBuilder, fn func(ctx context.Context, cmd *cobra.Command, a *app, args []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		timeout, err := cmd.Flags().GetDuration("timeout")
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+		defer cancel()
+
+		a, err := build(cmd)
+		if err != nil {
+			return err
+		}
+		if slug, _ := cmd.Flags().GetString("workspace"); slug != "" {
+			ws, err := a.workspace.GetBySlug(ctx, slug)
+			if err != nil {
+				return fmt.Errorf("workspace %q: %w", slug, err)
+			}
+			ctx = contextx.SetWorkspaceID(ctx, ws.ID)
+		}
+
+		return fn(ctx, cmd, a, args)
+	}
+}
+
+func newApp(cmd *cobra.Command) (*app, error) {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if dsn, _ := cmd.Flags().GetString("dsn"); dsn != "" {
+		cfg.DatabaseDSN = dsn
+	}
+	if err = cfg.RequireSecrets(true, false); err != nil {
+		return nil, err
+	}
+
+	timeGen := &system.TimeGenerator{}
+	secretStore, err := cfg.SecretStore(timeGen)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := cfg.ResolveDSN(cmd.Context(), secretStore)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	idGen := &system.UUIDv7Generator{}
+	// passwords set here must be checked by the server, so they get the same pepper
+	passwordHasher := secure.NewPepperedPasswordHasher(secretStore.Key(secrets.PasswordPepper))
+
+	userRepo, err := userrepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	userValidator, err := user.NewValidator(cfg.User.ValidationConfig)
+	if err != nil {
+		return nil, err
+	}
+	uc, err := user.NewCore(userRepo, idGen, passwordHasher, userValidator, cfg.User.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	authRepo, err := authrepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	// tokens are never issued here, so an ephemeral signing key is enough
+	ac, err := auth.NewCore(authRepo, secure.NewTokenCodec(ephemeralKey()), idGen, &system.RNDGenerator{}, timeGen, passwordHasher, cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	// content written here must be readable by the server, so it gets the same content key
+	contentCipher := secure.NewContentCipher(secretStore.Key(secrets.ContentKey), secretStore.Key(secrets.ContentKeyPrevious))
+	entityRepo, err := entityrepo.NewRepository(db, cfg.Entity.Config, contentCipher)
+	if err != nil {
+		return nil, err
+	}
+	entityValidator, err := entity.NewValidator(cfg.Entity.ValidationConfig)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := entity.NewCore(entityRepo, entity.Generators{ID: idGen, Time: timeGen}, entityValidator, cfg.Entity.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceRepo, err := workspacerepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	wc, err := workspace.NewCore(workspaceRepo, idGen)
+	if err != nil {
+		return nil, err
+	}
+
+	blobStore, err := blob.NewLocalStore(cfg.Blob)
+	if err != nil {
+		return nil, err
+	}
+	scanner, err := scan.New(cfg.Scan)
+	if err != nil {
+		return nil, err
+	}
+	quarantineRepo, err := quarantinerepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	qc, err := quarantine.NewCore(quarantineRepo, blobStore, scanner, idGen, timeGen)
+	if err != nil {
+		return nil, err
+	}
+	attachmentRepo, err := attachmentrepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	atc, err := attachment.NewCore(attachmentRepo, blobStore, timeGen)
+	if err != nil {
+		return nil, err
+	}
+	sanitizer, err := sanitize.New(cfg.Sanitize)
+	if err != nil {
+		return nil, err
+	}
+	confluenceService := confluenceusecase.NewService(ec, atc, qc, sanitizer, idGen)
+
+	backupRepo, err := backuprepo.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := backup.NewCore(backupRepo, timeGen, cfg.Backup)
+	if err != nil {
+		return nil, err
+	}
+	a := &app{user: uc, auth: ac, entity: ec, workspace: wc, confluence: confluenceService, sanitizer: sanitizer, backup: bc}
+	if cfg.Backup.Enabled() {
+		if a.backupStore, err = s3.NewStore(cfg.Backup.S3, &http.Client{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+func loadConfig(cmd *cobra.Command) (config.Config, error) {
+	path, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	return config.Load(path)
+}
+
+func parseUUIDFlag(cmd *cobra.Command, name string) (uuid.UUID, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("--%s: invalid UUID %q", name, value)
+	}
+
+	return id, nil
+}
+
+func ephemeralKey() []byte {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
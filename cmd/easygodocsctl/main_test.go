@@ -0,0 +1,438 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/cmd/easygodocsctl/mocks"
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	user      *mocks.UserCoreMock
+	auth      *mocks.AuthCoreMock
+	entity    *mocks.EntityCoreMock
+	workspace *mocks.WorkspaceCoreMock
+	sanitizer *mocks.ContentSanitizerMock
+	backup    *mocks.BackupCoreMock
+	store     *mocks.BackupStoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		user:      mocks.NewUserCoreMock(t),
+		auth:      mocks.NewAuthCoreMock(t),
+		entity:    mocks.NewEntityCoreMock(t),
+		workspace: mocks.NewWorkspaceCoreMock(t),
+		sanitizer: mocks.NewContentSanitizerMock(t),
+		backup:    mocks.NewBackupCoreMock(t),
+		store:     mocks.NewBackupStoreMock(t),
+	}
+}
+
+// squash collapses the padding of tables and the indentation of JSON, so output compares by its cells.
+func squash(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRootCmd(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID      = uuid.New()
+		otherID     = uuid.New()
+		sessionID   = uuid.New()
+		entityID    = uuid.New()
+		childID     = uuid.New()
+		workspaceID = uuid.New()
+		at          = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		version     = 1
+		expErr      = errors.New("test error")
+	)
+
+	tests := []struct {
+		name  string
+		args  []string
+		stdin string
+		setup func(m mock)
+		// noStore leaves the backup bucket unconfigured
+		noStore  bool
+		buildErr error
+		out      string
+		errOut   string
+		err      error
+		errText  string
+	}{
+		{
+			name: "user list",
+			args: []string{"user", "list", "--include-deleted"},
+			setup: func(m mock) {
+				m.user.GetAllUsersMock.Expect(minimock.AnyContext, user.ListUsersOptions{IncludeDeleted: true}).Return(user.UsersPage{Users: []user.User{
+					{ID: userID, Email: "ann@example.com", Name: "Ann", CreatedAt: at},
+					{ID: otherID, Email: "bob@example.com", Name: "Bob", CreatedAt: at, DeletedAt: &at},
+				}}, nil)
+			},
+			out: "ID EMAIL NAME CREATED DELETED\n" +
+				userID.String() + " ann@example.com Ann 2026-01-02T03:04:05Z -\n" +
+				otherID.String() + " bob@example.com Bob 2026-01-02T03:04:05Z 2026-01-02T03:04:05Z\n",
+		},
+		{
+			name: "user list/error",
+			args: []string{"user", "list"},
+			setup: func(m mock) {
+				m.user.GetAllUsersMock.Return(user.UsersPage{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:  "user create/password from stdin",
+			args:  []string{"user", "create", "--email", "ann@example.com", "--name", "Ann"},
+			stdin: "secret\r\n",
+			setup: func(m mock) {
+				m.user.CreateUserMock.Expect(minimock.AnyContext, user.CreateUserReq{
+					Email: "ann@example.com", Name: "Ann", Password: []byte("secret"),
+				}).Return(userID, nil)
+			},
+			out: userID.String() + "\n",
+		},
+		{
+			name: "user create/password flag",
+			args: []string{"user", "create", "--email", "ann@example.com", "--name", "Ann", "--password", "secret"},
+			setup: func(m mock) {
+				m.user.CreateUserMock.Expect(minimock.AnyContext, user.CreateUserReq{
+					Email: "ann@example.com", Name: "Ann", Password: []byte("secret"),
+				}).Return(userID, nil)
+			},
+			out: userID.String() + "\n",
+		},
+		{
+			name:    "user create/no password",
+			args:    []string{"user", "create", "--email", "ann@example.com", "--name", "Ann"},
+			errText: "read password from stdin",
+		},
+		{
+			name:    "user create/missing flag",
+			args:    []string{"user", "create", "--name", "Ann"},
+			errText: `required flag(s) "email" not set`,
+		},
+		{
+			name: "user disable",
+			args: []string{"user", "disable", userID.String()},
+			setup: func(m mock) {
+				m.user.DeleteUserMock.Expect(minimock.AnyContext, userID).Return(nil)
+				m.auth.DeleteSessionsByUserIDMock.Expect(minimock.AnyContext, userID).Return(nil)
+			},
+			out: "user " + userID.String() + " disabled\n",
+		},
+		{
+			name: "user disable/sessions not revoked",
+			args: []string{"user", "disable", userID.String()},
+			setup: func(m mock) {
+				m.user.DeleteUserMock.Return(nil)
+				m.auth.DeleteSessionsByUserIDMock.Return(expErr)
+			},
+			err:     expErr,
+			errText: "user disabled, but revoking sessions failed",
+		},
+		{
+			name:    "user disable/invalid id",
+			args:    []string{"user", "disable", "nope"},
+			errText: `invalid user ID "nope"`,
+		},
+		{
+			name:    "user disable/no id",
+			args:    []string{"user", "disable"},
+			errText: "accepts 1 arg(s), received 0",
+		},
+		{
+			name: "session revoke/all",
+			args: []string{"session", "revoke", "--user", userID.String()},
+			setup: func(m mock) {
+				m.auth.DeleteSessionsByUserIDMock.Expect(minimock.AnyContext, userID).Return(nil)
+			},
+			out: "all sessions of user " + userID.String() + " revoked\n",
+		},
+		{
+			name: "session revoke/one",
+			args: []string{"session", "revoke", "--user", userID.String(), "--session", sessionID.String()},
+			setup: func(m mock) {
+				m.auth.DeleteSessionMock.Expect(minimock.AnyContext, sessionID, userID).Return(nil)
+			},
+			out: "session " + sessionID.String() + " revoked\n",
+		},
+		{
+			name:    "session revoke/invalid user",
+			args:    []string{"session", "revoke", "--user", "nope"},
+			errText: `--user: invalid UUID "nope"`,
+		},
+		{
+			name:    "session revoke/invalid session",
+			args:    []string{"session", "revoke", "--user", userID.String(), "--session", "nope"},
+			errText: `--session: invalid UUID "nope"`,
+		},
+		{
+			name: "role grant/admin",
+			args: []string{"role", "grant", "--user", userID.String(), "--role", "admin"},
+			setup: func(m mock) {
+				m.auth.AddUserRoleMock.Expect(minimock.AnyContext, auth.UserRole{UserID: userID, Role: auth.RoleAdmin}).Return(nil)
+			},
+			out: "grant: role admin (global) for user " + userID.String() + "\n",
+		},
+		{
+			name: "role revoke/entity role",
+			args: []string{"role", "revoke", "--user", userID.String(), "--role", "write", "--entity", entityID.String()},
+			setup: func(m mock) {
+				m.auth.DeleteUserRoleMock.Expect(minimock.AnyContext, auth.UserRole{UserID: userID, Role: auth.RoleWrite, EntityID: &entityID}).Return(nil)
+			},
+			out: "revoke: role write (" + entityID.String() + ") for user " + userID.String() + "\n",
+		},
+		{
+			name: "role grant/error",
+			args: []string{"role", "grant", "--user", userID.String(), "--role", "admin"},
+			setup: func(m mock) {
+				m.auth.AddUserRoleMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "role grant/invalid role",
+			args: []string{"role", "grant", "--user", userID.String(), "--role", "owner"},
+			err:  auth.ErrInvalidRole,
+		},
+		{
+			name: "role grant/entity role without entity",
+			args: []string{"role", "grant", "--user", userID.String(), "--role", "read"},
+			err:  auth.ErrRoleRequiresEntity(),
+		},
+		{
+			name: "role repair/dry run",
+			args: []string{"role", "repair", "--dry-run"},
+			setup: func(m mock) {
+				m.auth.RepairGrantsMock.Expect(minimock.AnyContext, true).Return(auth.ConsistencyReport{OrphanedGrants: []auth.OrphanedGrant{
+					{UserRole: auth.UserRole{UserID: userID, Role: auth.RoleAdmin}, Reason: auth.OrphanUserDeleted},
+					{UserRole: auth.UserRole{UserID: otherID, Role: auth.RoleRead, EntityID: &entityID}, Reason: auth.OrphanEntityDeleted},
+				}}, nil)
+			},
+			out: "USER ROLE ENTITY REASON\n" +
+				userID.String() + " admin global user_deleted\n" +
+				otherID.String() + " read " + entityID.String() + " entity_deleted\n" +
+				"2 grants would be removed\n",
+		},
+		{
+			name: "role repair",
+			args: []string{"role", "repair"},
+			setup: func(m mock) {
+				m.auth.RepairGrantsMock.Expect(minimock.AnyContext, false).Return(auth.ConsistencyReport{Repaired: true}, nil)
+			},
+			out: "0 grants removed\n",
+		},
+		{
+			name: "entity export/subtree",
+			args: []string{"entity", "export", "--root", childID.String()},
+			setup: func(m mock) {
+				m.entity.GetTreeMock.Expect(minimock.AnyContext, nil, true).Return(entity.Tree{
+					{ListItem: entity.ListItem{ID: entityID}, Children: []*entity.Node{{ListItem: entity.ListItem{ID: childID}}}},
+				}, nil)
+				m.entity.GetMock.Expect(minimock.AnyContext, childID).Return(entity.Entity{
+					ID: childID, Type: entity.TypeArticle, Name: "Intro", Content: "<script>x</script>Hi", CurrentVersion: &version,
+				}, nil)
+				m.sanitizer.SanitizeMock.Expect("<script>x</script>Hi").Return("Hi", []string{"script"})
+			},
+			out: "[\n{\n\"type\": \"article\",\n\"name\": \"Intro\",\n\"content\": \"Hi\"\n}\n]\n",
+		},
+		{
+			name: "entity export/unknown root",
+			args: []string{"entity", "export", "--root", childID.String()},
+			setup: func(m mock) {
+				m.entity.GetTreeMock.Return(entity.Tree{{ListItem: entity.ListItem{ID: entityID}}}, nil)
+			},
+			errText: "entity " + childID.String() + " not found",
+		},
+		{
+			name: "entity import",
+			args: []string{"entity", "import", "--author", userID.String(), "--parent", entityID.String()},
+			stdin: `[{"type": "department", "name": "Docs", "content": "",
+				"children": [{"type": "article", "name": "Intro", "content": "<script>x</script>Hi", "is_draft": true}]}]`,
+			setup: func(m mock) {
+				m.sanitizer.SanitizeMock.When("").Then("", nil)
+				m.sanitizer.SanitizeMock.When("<script>x</script>Hi").Then("Hi", []string{"script"})
+				m.entity.CreateMock.When(minimock.AnyContext, entity.CreateEntityReq{
+					Type: entity.TypeDepartment, Name: "Docs", ParentID: &entityID, UserID: userID,
+				}).Then(childID, entity.ContentUsage{}, nil)
+				m.entity.CreateMock.When(minimock.AnyContext, entity.CreateEntityReq{
+					Type: entity.TypeArticle, Name: "Intro", Content: "Hi", ParentID: &childID, IsDraft: true, UserID: userID,
+				}).Then(uuid.New(), entity.ContentUsage{}, nil)
+			},
+			out:    "2 entities imported\n",
+			errOut: "entity \"Intro\": removed unsafe markup: script\n",
+		},
+		{
+			name:  "entity import/partial",
+			args:  []string{"entity", "import", "--author", userID.String()},
+			stdin: `[{"type": "article", "name": "A"}, {"type": "article", "name": "B"}]`,
+			setup: func(m mock) {
+				m.sanitizer.SanitizeMock.Return("", nil)
+				m.entity.CreateMock.When(minimock.AnyContext, entity.CreateEntityReq{
+					Type: entity.TypeArticle, Name: "A", UserID: userID,
+				}).Then(childID, entity.ContentUsage{}, nil)
+				m.entity.CreateMock.When(minimock.AnyContext, entity.CreateEntityReq{
+					Type: entity.TypeArticle, Name: "B", UserID: userID,
+				}).Then(uuid.Nil, entity.ContentUsage{}, expErr)
+			},
+			out:     "1 entities imported\n",
+			err:     expErr,
+			errText: `import entity "B"`,
+		},
+		{
+			name:    "entity import/invalid file",
+			args:    []string{"entity", "import", "--author", userID.String()},
+			stdin:   "{",
+			errText: "decode import file",
+		},
+		{
+			name:    "entity import/missing author",
+			args:    []string{"entity", "import"},
+			errText: `required flag(s) "author" not set`,
+		},
+		{
+			name: "backup restore",
+			args: []string{"backup", "restore", "--key", "backup.zip"},
+			setup: func(m mock) {
+				m.store.GetMock.Expect(minimock.AnyContext, "backup.zip").Return(io.NopCloser(strings.NewReader("archive")), nil)
+				m.backup.RestoreMock.Set(func(_ context.Context, r io.Reader) (backup.Manifest, error) {
+					data, err := io.ReadAll(r)
+					if err != nil || string(data) != "archive" {
+						return backup.Manifest{}, expErr
+					}
+					return backup.Manifest{CreatedAt: at, PasswordHashesOmitted: true, Tables: []backup.Table{{Name: "users", Rows: 2}}}, nil
+				})
+			},
+			out: "TABLE ROWS\nusers 2\nbackup of 2026-01-02 03:04:05 UTC restored\n" +
+				"the backup has no password hashes: nobody can log in until an admin created with `user create` sets new passwords\n",
+		},
+		{
+			name: "backup restore/error",
+			args: []string{"backup", "restore", "--key", "backup.zip"},
+			setup: func(m mock) {
+				m.store.GetMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:    "backup restore/no source",
+			args:    []string{"backup", "restore"},
+			errText: "either --key or --file is required",
+		},
+		{
+			name:    "backup restore/both sources",
+			args:    []string{"backup", "restore", "--key", "backup.zip", "--file", "backup.zip"},
+			errText: "--key and --file are mutually exclusive",
+		},
+		{
+			name:    "backup restore/no bucket",
+			args:    []string{"backup", "restore", "--key", "backup.zip"},
+			noStore: true,
+			errText: "--key needs backup.s3 to be configured",
+		},
+		{
+			name: "workspace",
+			args: []string{"--workspace", "docs", "user", "list"},
+			setup: func(m mock) {
+				m.workspace.GetBySlugMock.Expect(minimock.AnyContext, "docs").Return(workspace.Workspace{ID: workspaceID, Slug: "docs"}, nil)
+				m.user.GetAllUsersMock.Set(func(ctx context.Context, _ user.ListUsersOptions) (user.UsersPage, error) {
+					if contextx.WorkspaceID(ctx) != workspaceID {
+						return user.UsersPage{}, expErr
+					}
+					return user.UsersPage{}, nil
+				})
+			},
+			out: "ID EMAIL NAME CREATED DELETED\n",
+		},
+		{
+			name: "workspace/unknown",
+			args: []string{"--workspace", "docs", "user", "list"},
+			setup: func(m mock) {
+				m.workspace.GetBySlugMock.Return(workspace.Workspace{}, expErr)
+			},
+			err:     expErr,
+			errText: `workspace "docs"`,
+		},
+		{
+			name:     "app error",
+			args:     []string{"user", "list"},
+			buildErr: expErr,
+			err:      expErr,
+		},
+		{
+			name:    "invalid timeout",
+			args:    []string{"--timeout", "soon", "user", "list"},
+			errText: `invalid argument "soon" for "--timeout"`,
+		},
+		{
+			name:    "unknown command",
+			args:    []string{"users"},
+			errText: `unknown command "users"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			a := &app{user: m.user, auth: m.auth, entity: m.entity, workspace: m.workspace, sanitizer: m.sanitizer, backup: m.backup}
+			if !tt.noStore {
+				a.backupStore = m.store
+			}
+
+			cmd := newRootCmd(func(*cobra.Command) (*app, error) {
+				if tt.buildErr != nil {
+					return nil, tt.buildErr
+				}
+				return a, nil
+			})
+			var out, errOut bytes.Buffer
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+			cmd.SetIn(strings.NewReader(tt.stdin))
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+
+			err := cmd.ExecuteContext(t.Context())
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+			if tt.errText != "" {
+				require.ErrorContains(t, err, tt.errText)
+			}
+			if tt.err == nil && tt.errText == "" {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.out, squash(out.String()))
+			require.Equal(t, tt.errOut, errOut.String())
+		})
+	}
+}
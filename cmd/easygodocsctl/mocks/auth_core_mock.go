@@ -0,0 +1,1870 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.authCore -o auth_core_mock.go -n AuthCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// AuthCoreMock implements mm_main.authCore
+type AuthCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddUserRole          func(ctx context.Context, userRole auth.UserRole) (err error)
+	funcAddUserRoleOrigin    string
+	inspectFuncAddUserRole   func(ctx context.Context, userRole auth.UserRole)
+	afterAddUserRoleCounter  uint64
+	beforeAddUserRoleCounter uint64
+	AddUserRoleMock          mAuthCoreMockAddUserRole
+
+	funcDeleteSession          func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)
+	funcDeleteSessionOrigin    string
+	inspectFuncDeleteSession   func(ctx context.Context, id uuid.UUID, userID uuid.UUID)
+	afterDeleteSessionCounter  uint64
+	beforeDeleteSessionCounter uint64
+	DeleteSessionMock          mAuthCoreMockDeleteSession
+
+	funcDeleteSessionsByUserID          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcDeleteSessionsByUserIDOrigin    string
+	inspectFuncDeleteSessionsByUserID   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteSessionsByUserIDCounter  uint64
+	beforeDeleteSessionsByUserIDCounter uint64
+	DeleteSessionsByUserIDMock          mAuthCoreMockDeleteSessionsByUserID
+
+	funcDeleteUserRole          func(ctx context.Context, role auth.UserRole) (err error)
+	funcDeleteUserRoleOrigin    string
+	inspectFuncDeleteUserRole   func(ctx context.Context, role auth.UserRole)
+	afterDeleteUserRoleCounter  uint64
+	beforeDeleteUserRoleCounter uint64
+	DeleteUserRoleMock          mAuthCoreMockDeleteUserRole
+
+	funcRepairGrants          func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)
+	funcRepairGrantsOrigin    string
+	inspectFuncRepairGrants   func(ctx context.Context, dryRun bool)
+	afterRepairGrantsCounter  uint64
+	beforeRepairGrantsCounter uint64
+	RepairGrantsMock          mAuthCoreMockRepairGrants
+}
+
+// NewAuthCoreMock returns a mock for mm_main.authCore
+func NewAuthCoreMock(t minimock.Tester) *AuthCoreMock {
+	m := &AuthCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddUserRoleMock = mAuthCoreMockAddUserRole{mock: m}
+	m.AddUserRoleMock.callArgs = []*AuthCoreMockAddUserRoleParams{}
+
+	m.DeleteSessionMock = mAuthCoreMockDeleteSession{mock: m}
+	m.DeleteSessionMock.callArgs = []*AuthCoreMockDeleteSessionParams{}
+
+	m.DeleteSessionsByUserIDMock = mAuthCoreMockDeleteSessionsByUserID{mock: m}
+	m.DeleteSessionsByUserIDMock.callArgs = []*AuthCoreMockDeleteSessionsByUserIDParams{}
+
+	m.DeleteUserRoleMock = mAuthCoreMockDeleteUserRole{mock: m}
+	m.DeleteUserRoleMock.callArgs = []*AuthCoreMockDeleteUserRoleParams{}
+
+	m.RepairGrantsMock = mAuthCoreMockRepairGrants{mock: m}
+	m.RepairGrantsMock.callArgs = []*AuthCoreMockRepairGrantsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthCoreMockAddUserRole struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockAddUserRoleExpectation
+	expectations       []*AuthCoreMockAddUserRoleExpectation
+
+	callArgs []*AuthCoreMockAddUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockAddUserRoleExpectation specifies expectation struct of the authCore.AddUserRole
+type AuthCoreMockAddUserRoleExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockAddUserRoleParams
+	paramPtrs          *AuthCoreMockAddUserRoleParamPtrs
+	expectationOrigins AuthCoreMockAddUserRoleExpectationOrigins
+	results            *AuthCoreMockAddUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockAddUserRoleParams contains parameters of the authCore.AddUserRole
+type AuthCoreMockAddUserRoleParams struct {
+	ctx      context.Context
+	userRole auth.UserRole
+}
+
+// AuthCoreMockAddUserRoleParamPtrs contains pointers to parameters of the authCore.AddUserRole
+type AuthCoreMockAddUserRoleParamPtrs struct {
+	ctx      *context.Context
+	userRole *auth.UserRole
+}
+
+// AuthCoreMockAddUserRoleResults contains results of the authCore.AddUserRole
+type AuthCoreMockAddUserRoleResults struct {
+	err error
+}
+
+// AuthCoreMockAddUserRoleOrigins contains origins of expectations of the authCore.AddUserRole
+type AuthCoreMockAddUserRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Optional() *mAuthCoreMockAddUserRole {
+	mmAddUserRole.optional = true
+	return mmAddUserRole
+}
+
+// Expect sets up expected params for authCore.AddUserRole
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Expect(ctx context.Context, userRole auth.UserRole) *mAuthCoreMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &AuthCoreMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmAddUserRole.defaultExpectation.params = &AuthCoreMockAddUserRoleParams{ctx, userRole}
+	mmAddUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddUserRole.expectations {
+		if minimock.Equal(e.params, mmAddUserRole.defaultExpectation.params) {
+			mmAddUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAddUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for authCore.AddUserRole
+func (mmAddUserRole *mAuthCoreMockAddUserRole) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &AuthCoreMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.params != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Expect")
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs == nil {
+		mmAddUserRole.defaultExpectation.paramPtrs = &AuthCoreMockAddUserRoleParamPtrs{}
+	}
+	mmAddUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddUserRole
+}
+
+// ExpectUserRoleParam2 sets up expected param userRole for authCore.AddUserRole
+func (mmAddUserRole *mAuthCoreMockAddUserRole) ExpectUserRoleParam2(userRole auth.UserRole) *mAuthCoreMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &AuthCoreMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.params != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Expect")
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs == nil {
+		mmAddUserRole.defaultExpectation.paramPtrs = &AuthCoreMockAddUserRoleParamPtrs{}
+	}
+	mmAddUserRole.defaultExpectation.paramPtrs.userRole = &userRole
+	mmAddUserRole.defaultExpectation.expectationOrigins.originUserRole = minimock.CallerInfo(1)
+
+	return mmAddUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the authCore.AddUserRole
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Inspect(f func(ctx context.Context, userRole auth.UserRole)) *mAuthCoreMockAddUserRole {
+	if mmAddUserRole.mock.inspectFuncAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.AddUserRole")
+	}
+
+	mmAddUserRole.mock.inspectFuncAddUserRole = f
+
+	return mmAddUserRole
+}
+
+// Return sets up results that will be returned by authCore.AddUserRole
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Return(err error) *AuthCoreMock {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &AuthCoreMockAddUserRoleExpectation{mock: mmAddUserRole.mock}
+	}
+	mmAddUserRole.defaultExpectation.results = &AuthCoreMockAddUserRoleResults{err}
+	mmAddUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole.mock
+}
+
+// Set uses given function f to mock the authCore.AddUserRole method
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Set(f func(ctx context.Context, userRole auth.UserRole) (err error)) *AuthCoreMock {
+	if mmAddUserRole.defaultExpectation != nil {
+		mmAddUserRole.mock.t.Fatalf("Default expectation is already set for the authCore.AddUserRole method")
+	}
+
+	if len(mmAddUserRole.expectations) > 0 {
+		mmAddUserRole.mock.t.Fatalf("Some expectations are already set for the authCore.AddUserRole method")
+	}
+
+	mmAddUserRole.mock.funcAddUserRole = f
+	mmAddUserRole.mock.funcAddUserRoleOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole.mock
+}
+
+// When sets expectation for the authCore.AddUserRole which will trigger the result defined by the following
+// Then helper
+func (mmAddUserRole *mAuthCoreMockAddUserRole) When(ctx context.Context, userRole auth.UserRole) *AuthCoreMockAddUserRoleExpectation {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("AuthCoreMock.AddUserRole mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockAddUserRoleExpectation{
+		mock:               mmAddUserRole.mock,
+		params:             &AuthCoreMockAddUserRoleParams{ctx, userRole},
+		expectationOrigins: AuthCoreMockAddUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddUserRole.expectations = append(mmAddUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up authCore.AddUserRole return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockAddUserRoleExpectation) Then(err error) *AuthCoreMock {
+	e.results = &AuthCoreMockAddUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times authCore.AddUserRole should be invoked
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Times(n uint64) *mAuthCoreMockAddUserRole {
+	if n == 0 {
+		mmAddUserRole.mock.t.Fatalf("Times of AuthCoreMock.AddUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddUserRole.expectedInvocations, n)
+	mmAddUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole
+}
+
+func (mmAddUserRole *mAuthCoreMockAddUserRole) invocationsDone() bool {
+	if len(mmAddUserRole.expectations) == 0 && mmAddUserRole.defaultExpectation == nil && mmAddUserRole.mock.funcAddUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddUserRole.mock.afterAddUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddUserRole implements mm_main.authCore
+func (mmAddUserRole *AuthCoreMock) AddUserRole(ctx context.Context, userRole auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmAddUserRole.beforeAddUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddUserRole.afterAddUserRoleCounter, 1)
+
+	mmAddUserRole.t.Helper()
+
+	if mmAddUserRole.inspectFuncAddUserRole != nil {
+		mmAddUserRole.inspectFuncAddUserRole(ctx, userRole)
+	}
+
+	mm_params := AuthCoreMockAddUserRoleParams{ctx, userRole}
+
+	// Record call args
+	mmAddUserRole.AddUserRoleMock.mutex.Lock()
+	mmAddUserRole.AddUserRoleMock.callArgs = append(mmAddUserRole.AddUserRoleMock.callArgs, &mm_params)
+	mmAddUserRole.AddUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmAddUserRole.AddUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddUserRole.AddUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddUserRole.AddUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddUserRole.AddUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAddUserRole.AddUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockAddUserRoleParams{ctx, userRole}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddUserRole.t.Errorf("AuthCoreMock.AddUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userRole != nil && !minimock.Equal(*mm_want_ptrs.userRole, mm_got.userRole) {
+				mmAddUserRole.t.Errorf("AuthCoreMock.AddUserRole got unexpected parameter userRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.originUserRole, *mm_want_ptrs.userRole, mm_got.userRole, minimock.Diff(*mm_want_ptrs.userRole, mm_got.userRole))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddUserRole.t.Errorf("AuthCoreMock.AddUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddUserRole.AddUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddUserRole.t.Fatal("No results are set for the AuthCoreMock.AddUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAddUserRole.funcAddUserRole != nil {
+		return mmAddUserRole.funcAddUserRole(ctx, userRole)
+	}
+	mmAddUserRole.t.Fatalf("Unexpected call to AuthCoreMock.AddUserRole. %v %v", ctx, userRole)
+	return
+}
+
+// AddUserRoleAfterCounter returns a count of finished AuthCoreMock.AddUserRole invocations
+func (mmAddUserRole *AuthCoreMock) AddUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUserRole.afterAddUserRoleCounter)
+}
+
+// AddUserRoleBeforeCounter returns a count of AuthCoreMock.AddUserRole invocations
+func (mmAddUserRole *AuthCoreMock) AddUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUserRole.beforeAddUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.AddUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddUserRole *mAuthCoreMockAddUserRole) Calls() []*AuthCoreMockAddUserRoleParams {
+	mmAddUserRole.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockAddUserRoleParams, len(mmAddUserRole.callArgs))
+	copy(argCopy, mmAddUserRole.callArgs)
+
+	mmAddUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddUserRoleDone returns true if the count of the AddUserRole invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockAddUserRoleDone() bool {
+	if m.AddUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddUserRoleMock.invocationsDone()
+}
+
+// MinimockAddUserRoleInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockAddUserRoleInspect() {
+	for _, e := range m.AddUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.AddUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddUserRoleCounter := mm_atomic.LoadUint64(&m.afterAddUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddUserRoleMock.defaultExpectation != nil && afterAddUserRoleCounter < 1 {
+		if m.AddUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.AddUserRole at\n%s", m.AddUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.AddUserRole at\n%s with params: %#v", m.AddUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.AddUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddUserRole != nil && afterAddUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.AddUserRole at\n%s", m.funcAddUserRoleOrigin)
+	}
+
+	if !m.AddUserRoleMock.invocationsDone() && afterAddUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.AddUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddUserRoleMock.expectedInvocations), m.AddUserRoleMock.expectedInvocationsOrigin, afterAddUserRoleCounter)
+	}
+}
+
+type mAuthCoreMockDeleteSession struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockDeleteSessionExpectation
+	expectations       []*AuthCoreMockDeleteSessionExpectation
+
+	callArgs []*AuthCoreMockDeleteSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockDeleteSessionExpectation specifies expectation struct of the authCore.DeleteSession
+type AuthCoreMockDeleteSessionExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockDeleteSessionParams
+	paramPtrs          *AuthCoreMockDeleteSessionParamPtrs
+	expectationOrigins AuthCoreMockDeleteSessionExpectationOrigins
+	results            *AuthCoreMockDeleteSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockDeleteSessionParams contains parameters of the authCore.DeleteSession
+type AuthCoreMockDeleteSessionParams struct {
+	ctx    context.Context
+	id     uuid.UUID
+	userID uuid.UUID
+}
+
+// AuthCoreMockDeleteSessionParamPtrs contains pointers to parameters of the authCore.DeleteSession
+type AuthCoreMockDeleteSessionParamPtrs struct {
+	ctx    *context.Context
+	id     *uuid.UUID
+	userID *uuid.UUID
+}
+
+// AuthCoreMockDeleteSessionResults contains results of the authCore.DeleteSession
+type AuthCoreMockDeleteSessionResults struct {
+	err error
+}
+
+// AuthCoreMockDeleteSessionOrigins contains origins of expectations of the authCore.DeleteSession
+type AuthCoreMockDeleteSessionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Optional() *mAuthCoreMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
+}
+
+// Expect sets up expected params for authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mAuthCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthCoreMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSession.defaultExpectation.params = &AuthCoreMockDeleteSessionParams{ctx, id, userID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthCoreMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthCoreMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectIdParam2 sets up expected param id for authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) ExpectIdParam2(id uuid.UUID) *mAuthCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthCoreMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthCoreMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// ExpectUserIDParam3 sets up expected param userID for authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) ExpectUserIDParam3(userID uuid.UUID) *mAuthCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthCoreMockDeleteSessionExpectation{}
+	}
+
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthCoreMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mAuthCoreMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.DeleteSession")
+	}
+
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by authCore.DeleteSession
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Return(err error) *AuthCoreMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthCoreMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &AuthCoreMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the authCore.DeleteSession method
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *AuthCoreMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the authCore.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the authCore.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the authCore.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mAuthCoreMockDeleteSession) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *AuthCoreMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthCoreMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &AuthCoreMockDeleteSessionParams{ctx, id, userID},
+		expectationOrigins: AuthCoreMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up authCore.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockDeleteSessionExpectation) Then(err error) *AuthCoreMock {
+	e.results = &AuthCoreMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times authCore.DeleteSession should be invoked
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Times(n uint64) *mAuthCoreMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of AuthCoreMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mAuthCoreMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements mm_main.authCore
+func (mmDeleteSession *AuthCoreMock) DeleteSession(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, id, userID)
+	}
+
+	mm_params := AuthCoreMockDeleteSessionParams{ctx, id, userID}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockDeleteSessionParams{ctx, id, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("AuthCoreMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSession.t.Errorf("AuthCoreMock.DeleteSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("AuthCoreMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("AuthCoreMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the AuthCoreMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, id, userID)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to AuthCoreMock.DeleteSession. %v %v %v", ctx, id, userID)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished AuthCoreMock.DeleteSession invocations
+func (mmDeleteSession *AuthCoreMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of AuthCoreMock.DeleteSession invocations
+func (mmDeleteSession *AuthCoreMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mAuthCoreMockDeleteSession) Calls() []*AuthCoreMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mAuthCoreMockDeleteSessionsByUserID struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockDeleteSessionsByUserIDExpectation
+	expectations       []*AuthCoreMockDeleteSessionsByUserIDExpectation
+
+	callArgs []*AuthCoreMockDeleteSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockDeleteSessionsByUserIDExpectation specifies expectation struct of the authCore.DeleteSessionsByUserID
+type AuthCoreMockDeleteSessionsByUserIDExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockDeleteSessionsByUserIDParams
+	paramPtrs          *AuthCoreMockDeleteSessionsByUserIDParamPtrs
+	expectationOrigins AuthCoreMockDeleteSessionsByUserIDExpectationOrigins
+	results            *AuthCoreMockDeleteSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockDeleteSessionsByUserIDParams contains parameters of the authCore.DeleteSessionsByUserID
+type AuthCoreMockDeleteSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthCoreMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the authCore.DeleteSessionsByUserID
+type AuthCoreMockDeleteSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthCoreMockDeleteSessionsByUserIDResults contains results of the authCore.DeleteSessionsByUserID
+type AuthCoreMockDeleteSessionsByUserIDResults struct {
+	err error
+}
+
+// AuthCoreMockDeleteSessionsByUserIDOrigins contains origins of expectations of the authCore.DeleteSessionsByUserID
+type AuthCoreMockDeleteSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Optional() *mAuthCoreMockDeleteSessionsByUserID {
+	mmDeleteSessionsByUserID.optional = true
+	return mmDeleteSessionsByUserID
+}
+
+// Expect sets up expected params for authCore.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthCoreMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSessionsByUserID.defaultExpectation.params = &AuthCoreMockDeleteSessionsByUserIDParams{ctx, userID}
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
+			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for authCore.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthCoreMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthCoreMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for authCore.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthCoreMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthCoreMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the authCore.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.DeleteSessionsByUserID")
+	}
+
+	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+
+	return mmDeleteSessionsByUserID
+}
+
+// Return sets up results that will be returned by authCore.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Return(err error) *AuthCoreMock {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthCoreMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.results = &AuthCoreMockDeleteSessionsByUserIDResults{err}
+	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the authCore.DeleteSessionsByUserID method
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *AuthCoreMock {
+	if mmDeleteSessionsByUserID.defaultExpectation != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the authCore.DeleteSessionsByUserID method")
+	}
+
+	if len(mmDeleteSessionsByUserID.expectations) > 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the authCore.DeleteSessionsByUserID method")
+	}
+
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// When sets expectation for the authCore.DeleteSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthCoreMockDeleteSessionsByUserIDExpectation {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthCoreMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockDeleteSessionsByUserIDExpectation{
+		mock:               mmDeleteSessionsByUserID.mock,
+		params:             &AuthCoreMockDeleteSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: AuthCoreMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up authCore.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockDeleteSessionsByUserIDExpectation) Then(err error) *AuthCoreMock {
+	e.results = &AuthCoreMockDeleteSessionsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times authCore.DeleteSessionsByUserID should be invoked
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Times(n uint64) *mAuthCoreMockDeleteSessionsByUserID {
+	if n == 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of AuthCoreMock.DeleteSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
+	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID
+}
+
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) invocationsDone() bool {
+	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSessionsByUserID implements mm_main.authCore
+func (mmDeleteSessionsByUserID *AuthCoreMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+
+	mmDeleteSessionsByUserID.t.Helper()
+
+	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := AuthCoreMockDeleteSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockDeleteSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSessionsByUserID.t.Errorf("AuthCoreMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSessionsByUserID.t.Errorf("AuthCoreMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSessionsByUserID.t.Errorf("AuthCoreMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSessionsByUserID.t.Fatal("No results are set for the AuthCoreMock.DeleteSessionsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
+		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	}
+	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to AuthCoreMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteSessionsByUserIDAfterCounter returns a count of finished AuthCoreMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *AuthCoreMock) DeleteSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+}
+
+// DeleteSessionsByUserIDBeforeCounter returns a count of AuthCoreMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *AuthCoreMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.DeleteSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSessionsByUserID *mAuthCoreMockDeleteSessionsByUserID) Calls() []*AuthCoreMockDeleteSessionsByUserIDParams {
+	mmDeleteSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
+	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+
+	mmDeleteSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockDeleteSessionsByUserIDDone() bool {
+	if m.DeleteSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockDeleteSessionsByUserIDInspect() {
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	}
+
+	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.DeleteSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	}
+}
+
+type mAuthCoreMockDeleteUserRole struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockDeleteUserRoleExpectation
+	expectations       []*AuthCoreMockDeleteUserRoleExpectation
+
+	callArgs []*AuthCoreMockDeleteUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockDeleteUserRoleExpectation specifies expectation struct of the authCore.DeleteUserRole
+type AuthCoreMockDeleteUserRoleExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockDeleteUserRoleParams
+	paramPtrs          *AuthCoreMockDeleteUserRoleParamPtrs
+	expectationOrigins AuthCoreMockDeleteUserRoleExpectationOrigins
+	results            *AuthCoreMockDeleteUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockDeleteUserRoleParams contains parameters of the authCore.DeleteUserRole
+type AuthCoreMockDeleteUserRoleParams struct {
+	ctx  context.Context
+	role auth.UserRole
+}
+
+// AuthCoreMockDeleteUserRoleParamPtrs contains pointers to parameters of the authCore.DeleteUserRole
+type AuthCoreMockDeleteUserRoleParamPtrs struct {
+	ctx  *context.Context
+	role *auth.UserRole
+}
+
+// AuthCoreMockDeleteUserRoleResults contains results of the authCore.DeleteUserRole
+type AuthCoreMockDeleteUserRoleResults struct {
+	err error
+}
+
+// AuthCoreMockDeleteUserRoleOrigins contains origins of expectations of the authCore.DeleteUserRole
+type AuthCoreMockDeleteUserRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Optional() *mAuthCoreMockDeleteUserRole {
+	mmDeleteUserRole.optional = true
+	return mmDeleteUserRole
+}
+
+// Expect sets up expected params for authCore.DeleteUserRole
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Expect(ctx context.Context, role auth.UserRole) *mAuthCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthCoreMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRole.defaultExpectation.params = &AuthCoreMockDeleteUserRoleParams{ctx, role}
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRole.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
+			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for authCore.DeleteUserRole
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthCoreMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthCoreMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// ExpectRoleParam2 sets up expected param role for authCore.DeleteUserRole
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) ExpectRoleParam2(role auth.UserRole) *mAuthCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthCoreMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthCoreMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the authCore.DeleteUserRole
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Inspect(f func(ctx context.Context, role auth.UserRole)) *mAuthCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.DeleteUserRole")
+	}
+
+	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+
+	return mmDeleteUserRole
+}
+
+// Return sets up results that will be returned by authCore.DeleteUserRole
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Return(err error) *AuthCoreMock {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthCoreMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	}
+	mmDeleteUserRole.defaultExpectation.results = &AuthCoreMockDeleteUserRoleResults{err}
+	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// Set uses given function f to mock the authCore.DeleteUserRole method
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Set(f func(ctx context.Context, role auth.UserRole) (err error)) *AuthCoreMock {
+	if mmDeleteUserRole.defaultExpectation != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the authCore.DeleteUserRole method")
+	}
+
+	if len(mmDeleteUserRole.expectations) > 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the authCore.DeleteUserRole method")
+	}
+
+	mmDeleteUserRole.mock.funcDeleteUserRole = f
+	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// When sets expectation for the authCore.DeleteUserRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) When(ctx context.Context, role auth.UserRole) *AuthCoreMockDeleteUserRoleExpectation {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthCoreMock.DeleteUserRole mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockDeleteUserRoleExpectation{
+		mock:               mmDeleteUserRole.mock,
+		params:             &AuthCoreMockDeleteUserRoleParams{ctx, role},
+		expectationOrigins: AuthCoreMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up authCore.DeleteUserRole return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockDeleteUserRoleExpectation) Then(err error) *AuthCoreMock {
+	e.results = &AuthCoreMockDeleteUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times authCore.DeleteUserRole should be invoked
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Times(n uint64) *mAuthCoreMockDeleteUserRole {
+	if n == 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Times of AuthCoreMock.DeleteUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
+	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole
+}
+
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) invocationsDone() bool {
+	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRole implements mm_main.authCore
+func (mmDeleteUserRole *AuthCoreMock) DeleteUserRole(ctx context.Context, role auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+
+	mmDeleteUserRole.t.Helper()
+
+	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	}
+
+	mm_params := AuthCoreMockDeleteUserRoleParams{ctx, role}
+
+	// Record call args
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
+	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockDeleteUserRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRole.t.Errorf("AuthCoreMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmDeleteUserRole.t.Errorf("AuthCoreMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRole.t.Errorf("AuthCoreMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRole.t.Fatal("No results are set for the AuthCoreMock.DeleteUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserRole.funcDeleteUserRole != nil {
+		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	}
+	mmDeleteUserRole.t.Fatalf("Unexpected call to AuthCoreMock.DeleteUserRole. %v %v", ctx, role)
+	return
+}
+
+// DeleteUserRoleAfterCounter returns a count of finished AuthCoreMock.DeleteUserRole invocations
+func (mmDeleteUserRole *AuthCoreMock) DeleteUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+}
+
+// DeleteUserRoleBeforeCounter returns a count of AuthCoreMock.DeleteUserRole invocations
+func (mmDeleteUserRole *AuthCoreMock) DeleteUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.DeleteUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRole *mAuthCoreMockDeleteUserRole) Calls() []*AuthCoreMockDeleteUserRoleParams {
+	mmDeleteUserRole.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
+	copy(argCopy, mmDeleteUserRole.callArgs)
+
+	mmDeleteUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockDeleteUserRoleDone() bool {
+	if m.DeleteUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRoleMock.invocationsDone()
+}
+
+// MinimockDeleteUserRoleInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockDeleteUserRoleInspect() {
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
+		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	}
+
+	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.DeleteUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	}
+}
+
+type mAuthCoreMockRepairGrants struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockRepairGrantsExpectation
+	expectations       []*AuthCoreMockRepairGrantsExpectation
+
+	callArgs []*AuthCoreMockRepairGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockRepairGrantsExpectation specifies expectation struct of the authCore.RepairGrants
+type AuthCoreMockRepairGrantsExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockRepairGrantsParams
+	paramPtrs          *AuthCoreMockRepairGrantsParamPtrs
+	expectationOrigins AuthCoreMockRepairGrantsExpectationOrigins
+	results            *AuthCoreMockRepairGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockRepairGrantsParams contains parameters of the authCore.RepairGrants
+type AuthCoreMockRepairGrantsParams struct {
+	ctx    context.Context
+	dryRun bool
+}
+
+// AuthCoreMockRepairGrantsParamPtrs contains pointers to parameters of the authCore.RepairGrants
+type AuthCoreMockRepairGrantsParamPtrs struct {
+	ctx    *context.Context
+	dryRun *bool
+}
+
+// AuthCoreMockRepairGrantsResults contains results of the authCore.RepairGrants
+type AuthCoreMockRepairGrantsResults struct {
+	c2  auth.ConsistencyReport
+	err error
+}
+
+// AuthCoreMockRepairGrantsOrigins contains origins of expectations of the authCore.RepairGrants
+type AuthCoreMockRepairGrantsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originDryRun string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Optional() *mAuthCoreMockRepairGrants {
+	mmRepairGrants.optional = true
+	return mmRepairGrants
+}
+
+// Expect sets up expected params for authCore.RepairGrants
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Expect(ctx context.Context, dryRun bool) *mAuthCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Set")
+	}
+
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthCoreMockRepairGrantsExpectation{}
+	}
+
+	if mmRepairGrants.defaultExpectation.paramPtrs != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by ExpectParams functions")
+	}
+
+	mmRepairGrants.defaultExpectation.params = &AuthCoreMockRepairGrantsParams{ctx, dryRun}
+	mmRepairGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRepairGrants.expectations {
+		if minimock.Equal(e.params, mmRepairGrants.defaultExpectation.params) {
+			mmRepairGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRepairGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmRepairGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for authCore.RepairGrants
+func (mmRepairGrants *mAuthCoreMockRepairGrants) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Set")
+	}
+
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthCoreMockRepairGrantsExpectation{}
+	}
+
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Expect")
+	}
+
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &AuthCoreMockRepairGrantsParamPtrs{}
+	}
+	mmRepairGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRepairGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRepairGrants
+}
+
+// ExpectDryRunParam2 sets up expected param dryRun for authCore.RepairGrants
+func (mmRepairGrants *mAuthCoreMockRepairGrants) ExpectDryRunParam2(dryRun bool) *mAuthCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Set")
+	}
+
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthCoreMockRepairGrantsExpectation{}
+	}
+
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Expect")
+	}
+
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &AuthCoreMockRepairGrantsParamPtrs{}
+	}
+	mmRepairGrants.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmRepairGrants.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
+
+	return mmRepairGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the authCore.RepairGrants
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Inspect(f func(ctx context.Context, dryRun bool)) *mAuthCoreMockRepairGrants {
+	if mmRepairGrants.mock.inspectFuncRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.RepairGrants")
+	}
+
+	mmRepairGrants.mock.inspectFuncRepairGrants = f
+
+	return mmRepairGrants
+}
+
+// Return sets up results that will be returned by authCore.RepairGrants
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Return(c2 auth.ConsistencyReport, err error) *AuthCoreMock {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Set")
+	}
+
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthCoreMockRepairGrantsExpectation{mock: mmRepairGrants.mock}
+	}
+	mmRepairGrants.defaultExpectation.results = &AuthCoreMockRepairGrantsResults{c2, err}
+	mmRepairGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
+}
+
+// Set uses given function f to mock the authCore.RepairGrants method
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Set(f func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)) *AuthCoreMock {
+	if mmRepairGrants.defaultExpectation != nil {
+		mmRepairGrants.mock.t.Fatalf("Default expectation is already set for the authCore.RepairGrants method")
+	}
+
+	if len(mmRepairGrants.expectations) > 0 {
+		mmRepairGrants.mock.t.Fatalf("Some expectations are already set for the authCore.RepairGrants method")
+	}
+
+	mmRepairGrants.mock.funcRepairGrants = f
+	mmRepairGrants.mock.funcRepairGrantsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
+}
+
+// When sets expectation for the authCore.RepairGrants which will trigger the result defined by the following
+// Then helper
+func (mmRepairGrants *mAuthCoreMockRepairGrants) When(ctx context.Context, dryRun bool) *AuthCoreMockRepairGrantsExpectation {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthCoreMock.RepairGrants mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockRepairGrantsExpectation{
+		mock:               mmRepairGrants.mock,
+		params:             &AuthCoreMockRepairGrantsParams{ctx, dryRun},
+		expectationOrigins: AuthCoreMockRepairGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRepairGrants.expectations = append(mmRepairGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up authCore.RepairGrants return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockRepairGrantsExpectation) Then(c2 auth.ConsistencyReport, err error) *AuthCoreMock {
+	e.results = &AuthCoreMockRepairGrantsResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times authCore.RepairGrants should be invoked
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Times(n uint64) *mAuthCoreMockRepairGrants {
+	if n == 0 {
+		mmRepairGrants.mock.t.Fatalf("Times of AuthCoreMock.RepairGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRepairGrants.expectedInvocations, n)
+	mmRepairGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants
+}
+
+func (mmRepairGrants *mAuthCoreMockRepairGrants) invocationsDone() bool {
+	if len(mmRepairGrants.expectations) == 0 && mmRepairGrants.defaultExpectation == nil && mmRepairGrants.mock.funcRepairGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRepairGrants.mock.afterRepairGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRepairGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RepairGrants implements mm_main.authCore
+func (mmRepairGrants *AuthCoreMock) RepairGrants(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmRepairGrants.beforeRepairGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRepairGrants.afterRepairGrantsCounter, 1)
+
+	mmRepairGrants.t.Helper()
+
+	if mmRepairGrants.inspectFuncRepairGrants != nil {
+		mmRepairGrants.inspectFuncRepairGrants(ctx, dryRun)
+	}
+
+	mm_params := AuthCoreMockRepairGrantsParams{ctx, dryRun}
+
+	// Record call args
+	mmRepairGrants.RepairGrantsMock.mutex.Lock()
+	mmRepairGrants.RepairGrantsMock.callArgs = append(mmRepairGrants.RepairGrantsMock.callArgs, &mm_params)
+	mmRepairGrants.RepairGrantsMock.mutex.Unlock()
+
+	for _, e := range mmRepairGrants.RepairGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmRepairGrants.RepairGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRepairGrants.RepairGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRepairGrants.RepairGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmRepairGrants.RepairGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockRepairGrantsParams{ctx, dryRun}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRepairGrants.t.Errorf("AuthCoreMock.RepairGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmRepairGrants.t.Errorf("AuthCoreMock.RepairGrants got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRepairGrants.t.Errorf("AuthCoreMock.RepairGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRepairGrants.RepairGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRepairGrants.t.Fatal("No results are set for the AuthCoreMock.RepairGrants")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmRepairGrants.funcRepairGrants != nil {
+		return mmRepairGrants.funcRepairGrants(ctx, dryRun)
+	}
+	mmRepairGrants.t.Fatalf("Unexpected call to AuthCoreMock.RepairGrants. %v %v", ctx, dryRun)
+	return
+}
+
+// RepairGrantsAfterCounter returns a count of finished AuthCoreMock.RepairGrants invocations
+func (mmRepairGrants *AuthCoreMock) RepairGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.afterRepairGrantsCounter)
+}
+
+// RepairGrantsBeforeCounter returns a count of AuthCoreMock.RepairGrants invocations
+func (mmRepairGrants *AuthCoreMock) RepairGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.beforeRepairGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.RepairGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRepairGrants *mAuthCoreMockRepairGrants) Calls() []*AuthCoreMockRepairGrantsParams {
+	mmRepairGrants.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockRepairGrantsParams, len(mmRepairGrants.callArgs))
+	copy(argCopy, mmRepairGrants.callArgs)
+
+	mmRepairGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRepairGrantsDone returns true if the count of the RepairGrants invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockRepairGrantsDone() bool {
+	if m.RepairGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RepairGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RepairGrantsMock.invocationsDone()
+}
+
+// MinimockRepairGrantsInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockRepairGrantsInspect() {
+	for _, e := range m.RepairGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.RepairGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRepairGrantsCounter := mm_atomic.LoadUint64(&m.afterRepairGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RepairGrantsMock.defaultExpectation != nil && afterRepairGrantsCounter < 1 {
+		if m.RepairGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.RepairGrants at\n%s", m.RepairGrantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.RepairGrants at\n%s with params: %#v", m.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *m.RepairGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRepairGrants != nil && afterRepairGrantsCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.RepairGrants at\n%s", m.funcRepairGrantsOrigin)
+	}
+
+	if !m.RepairGrantsMock.invocationsDone() && afterRepairGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.RepairGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RepairGrantsMock.expectedInvocations), m.RepairGrantsMock.expectedInvocationsOrigin, afterRepairGrantsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddUserRoleInspect()
+
+			m.MinimockDeleteSessionInspect()
+
+			m.MinimockDeleteSessionsByUserIDInspect()
+
+			m.MinimockDeleteUserRoleInspect()
+
+			m.MinimockRepairGrantsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddUserRoleDone() &&
+		m.MinimockDeleteSessionDone() &&
+		m.MinimockDeleteSessionsByUserIDDone() &&
+		m.MinimockDeleteUserRoleDone() &&
+		m.MinimockRepairGrantsDone()
+}
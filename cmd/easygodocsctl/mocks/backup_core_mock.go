@@ -0,0 +1,419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.backupCore -o backup_core_mock.go -n BackupCoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BackupCoreMock implements mm_main.backupCore
+type BackupCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRestore          func(ctx context.Context, r io.Reader) (m1 backup.Manifest, err error)
+	funcRestoreOrigin    string
+	inspectFuncRestore   func(ctx context.Context, r io.Reader)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mBackupCoreMockRestore
+}
+
+// NewBackupCoreMock returns a mock for mm_main.backupCore
+func NewBackupCoreMock(t minimock.Tester) *BackupCoreMock {
+	m := &BackupCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RestoreMock = mBackupCoreMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*BackupCoreMockRestoreParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBackupCoreMockRestore struct {
+	optional           bool
+	mock               *BackupCoreMock
+	defaultExpectation *BackupCoreMockRestoreExpectation
+	expectations       []*BackupCoreMockRestoreExpectation
+
+	callArgs []*BackupCoreMockRestoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BackupCoreMockRestoreExpectation specifies expectation struct of the backupCore.Restore
+type BackupCoreMockRestoreExpectation struct {
+	mock               *BackupCoreMock
+	params             *BackupCoreMockRestoreParams
+	paramPtrs          *BackupCoreMockRestoreParamPtrs
+	expectationOrigins BackupCoreMockRestoreExpectationOrigins
+	results            *BackupCoreMockRestoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BackupCoreMockRestoreParams contains parameters of the backupCore.Restore
+type BackupCoreMockRestoreParams struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// BackupCoreMockRestoreParamPtrs contains pointers to parameters of the backupCore.Restore
+type BackupCoreMockRestoreParamPtrs struct {
+	ctx *context.Context
+	r   *io.Reader
+}
+
+// BackupCoreMockRestoreResults contains results of the backupCore.Restore
+type BackupCoreMockRestoreResults struct {
+	m1  backup.Manifest
+	err error
+}
+
+// BackupCoreMockRestoreOrigins contains origins of expectations of the backupCore.Restore
+type BackupCoreMockRestoreExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originR   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestore *mBackupCoreMockRestore) Optional() *mBackupCoreMockRestore {
+	mmRestore.optional = true
+	return mmRestore
+}
+
+// Expect sets up expected params for backupCore.Restore
+func (mmRestore *mBackupCoreMockRestore) Expect(ctx context.Context, r io.Reader) *mBackupCoreMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &BackupCoreMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by ExpectParams functions")
+	}
+
+	mmRestore.defaultExpectation.params = &BackupCoreMockRestoreParams{ctx, r}
+	mmRestore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for backupCore.Restore
+func (mmRestore *mBackupCoreMockRestore) ExpectCtxParam1(ctx context.Context) *mBackupCoreMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &BackupCoreMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &BackupCoreMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// ExpectRParam2 sets up expected param r for backupCore.Restore
+func (mmRestore *mBackupCoreMockRestore) ExpectRParam2(r io.Reader) *mBackupCoreMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &BackupCoreMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &BackupCoreMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.r = &r
+	mmRestore.defaultExpectation.expectationOrigins.originR = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the backupCore.Restore
+func (mmRestore *mBackupCoreMockRestore) Inspect(f func(ctx context.Context, r io.Reader)) *mBackupCoreMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for BackupCoreMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by backupCore.Restore
+func (mmRestore *mBackupCoreMockRestore) Return(m1 backup.Manifest, err error) *BackupCoreMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &BackupCoreMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &BackupCoreMockRestoreResults{m1, err}
+	mmRestore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the backupCore.Restore method
+func (mmRestore *mBackupCoreMockRestore) Set(f func(ctx context.Context, r io.Reader) (m1 backup.Manifest, err error)) *BackupCoreMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the backupCore.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the backupCore.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	mmRestore.mock.funcRestoreOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// When sets expectation for the backupCore.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mBackupCoreMockRestore) When(ctx context.Context, r io.Reader) *BackupCoreMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("BackupCoreMock.Restore mock is already set by Set")
+	}
+
+	expectation := &BackupCoreMockRestoreExpectation{
+		mock:               mmRestore.mock,
+		params:             &BackupCoreMockRestoreParams{ctx, r},
+		expectationOrigins: BackupCoreMockRestoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up backupCore.Restore return parameters for the expectation previously defined by the When method
+func (e *BackupCoreMockRestoreExpectation) Then(m1 backup.Manifest, err error) *BackupCoreMock {
+	e.results = &BackupCoreMockRestoreResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times backupCore.Restore should be invoked
+func (mmRestore *mBackupCoreMockRestore) Times(n uint64) *mBackupCoreMockRestore {
+	if n == 0 {
+		mmRestore.mock.t.Fatalf("Times of BackupCoreMock.Restore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestore.expectedInvocations, n)
+	mmRestore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestore
+}
+
+func (mmRestore *mBackupCoreMockRestore) invocationsDone() bool {
+	if len(mmRestore.expectations) == 0 && mmRestore.defaultExpectation == nil && mmRestore.mock.funcRestore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestore.mock.afterRestoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Restore implements mm_main.backupCore
+func (mmRestore *BackupCoreMock) Restore(ctx context.Context, r io.Reader) (m1 backup.Manifest, err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	mmRestore.t.Helper()
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, r)
+	}
+
+	mm_params := BackupCoreMockRestoreParams{ctx, r}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_want_ptrs := mmRestore.RestoreMock.defaultExpectation.paramPtrs
+
+		mm_got := BackupCoreMockRestoreParams{ctx, r}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestore.t.Errorf("BackupCoreMock.Restore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.r != nil && !minimock.Equal(*mm_want_ptrs.r, mm_got.r) {
+				mmRestore.t.Errorf("BackupCoreMock.Restore got unexpected parameter r, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originR, *mm_want_ptrs.r, mm_got.r, minimock.Diff(*mm_want_ptrs.r, mm_got.r))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("BackupCoreMock.Restore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestore.RestoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the BackupCoreMock.Restore")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, r)
+	}
+	mmRestore.t.Fatalf("Unexpected call to BackupCoreMock.Restore. %v %v", ctx, r)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished BackupCoreMock.Restore invocations
+func (mmRestore *BackupCoreMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of BackupCoreMock.Restore invocations
+func (mmRestore *BackupCoreMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to BackupCoreMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mBackupCoreMockRestore) Calls() []*BackupCoreMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*BackupCoreMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *BackupCoreMock) MinimockRestoreDone() bool {
+	if m.RestoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreMock.invocationsDone()
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *BackupCoreMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BackupCoreMock.Restore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreCounter := mm_atomic.LoadUint64(&m.afterRestoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && afterRestoreCounter < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BackupCoreMock.Restore at\n%s", m.RestoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BackupCoreMock.Restore at\n%s with params: %#v", m.RestoreMock.defaultExpectation.expectationOrigins.origin, *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && afterRestoreCounter < 1 {
+		m.t.Errorf("Expected call to BackupCoreMock.Restore at\n%s", m.funcRestoreOrigin)
+	}
+
+	if !m.RestoreMock.invocationsDone() && afterRestoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to BackupCoreMock.Restore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreMock.expectedInvocations), m.RestoreMock.expectedInvocationsOrigin, afterRestoreCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BackupCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRestoreInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BackupCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BackupCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRestoreDone()
+}
@@ -0,0 +1,385 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.contentSanitizer -o content_sanitizer_mock.go -n ContentSanitizerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ContentSanitizerMock implements mm_main.contentSanitizer
+type ContentSanitizerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSanitize          func(content string) (s1 string, sa1 []string)
+	funcSanitizeOrigin    string
+	inspectFuncSanitize   func(content string)
+	afterSanitizeCounter  uint64
+	beforeSanitizeCounter uint64
+	SanitizeMock          mContentSanitizerMockSanitize
+}
+
+// NewContentSanitizerMock returns a mock for mm_main.contentSanitizer
+func NewContentSanitizerMock(t minimock.Tester) *ContentSanitizerMock {
+	m := &ContentSanitizerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SanitizeMock = mContentSanitizerMockSanitize{mock: m}
+	m.SanitizeMock.callArgs = []*ContentSanitizerMockSanitizeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mContentSanitizerMockSanitize struct {
+	optional           bool
+	mock               *ContentSanitizerMock
+	defaultExpectation *ContentSanitizerMockSanitizeExpectation
+	expectations       []*ContentSanitizerMockSanitizeExpectation
+
+	callArgs []*ContentSanitizerMockSanitizeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ContentSanitizerMockSanitizeExpectation specifies expectation struct of the contentSanitizer.Sanitize
+type ContentSanitizerMockSanitizeExpectation struct {
+	mock               *ContentSanitizerMock
+	params             *ContentSanitizerMockSanitizeParams
+	paramPtrs          *ContentSanitizerMockSanitizeParamPtrs
+	expectationOrigins ContentSanitizerMockSanitizeExpectationOrigins
+	results            *ContentSanitizerMockSanitizeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ContentSanitizerMockSanitizeParams contains parameters of the contentSanitizer.Sanitize
+type ContentSanitizerMockSanitizeParams struct {
+	content string
+}
+
+// ContentSanitizerMockSanitizeParamPtrs contains pointers to parameters of the contentSanitizer.Sanitize
+type ContentSanitizerMockSanitizeParamPtrs struct {
+	content *string
+}
+
+// ContentSanitizerMockSanitizeResults contains results of the contentSanitizer.Sanitize
+type ContentSanitizerMockSanitizeResults struct {
+	s1  string
+	sa1 []string
+}
+
+// ContentSanitizerMockSanitizeOrigins contains origins of expectations of the contentSanitizer.Sanitize
+type ContentSanitizerMockSanitizeExpectationOrigins struct {
+	origin        string
+	originContent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSanitize *mContentSanitizerMockSanitize) Optional() *mContentSanitizerMockSanitize {
+	mmSanitize.optional = true
+	return mmSanitize
+}
+
+// Expect sets up expected params for contentSanitizer.Sanitize
+func (mmSanitize *mContentSanitizerMockSanitize) Expect(content string) *mContentSanitizerMockSanitize {
+	if mmSanitize.mock.funcSanitize != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by Set")
+	}
+
+	if mmSanitize.defaultExpectation == nil {
+		mmSanitize.defaultExpectation = &ContentSanitizerMockSanitizeExpectation{}
+	}
+
+	if mmSanitize.defaultExpectation.paramPtrs != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by ExpectParams functions")
+	}
+
+	mmSanitize.defaultExpectation.params = &ContentSanitizerMockSanitizeParams{content}
+	mmSanitize.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSanitize.expectations {
+		if minimock.Equal(e.params, mmSanitize.defaultExpectation.params) {
+			mmSanitize.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSanitize.defaultExpectation.params)
+		}
+	}
+
+	return mmSanitize
+}
+
+// ExpectContentParam1 sets up expected param content for contentSanitizer.Sanitize
+func (mmSanitize *mContentSanitizerMockSanitize) ExpectContentParam1(content string) *mContentSanitizerMockSanitize {
+	if mmSanitize.mock.funcSanitize != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by Set")
+	}
+
+	if mmSanitize.defaultExpectation == nil {
+		mmSanitize.defaultExpectation = &ContentSanitizerMockSanitizeExpectation{}
+	}
+
+	if mmSanitize.defaultExpectation.params != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by Expect")
+	}
+
+	if mmSanitize.defaultExpectation.paramPtrs == nil {
+		mmSanitize.defaultExpectation.paramPtrs = &ContentSanitizerMockSanitizeParamPtrs{}
+	}
+	mmSanitize.defaultExpectation.paramPtrs.content = &content
+	mmSanitize.defaultExpectation.expectationOrigins.originContent = minimock.CallerInfo(1)
+
+	return mmSanitize
+}
+
+// Inspect accepts an inspector function that has same arguments as the contentSanitizer.Sanitize
+func (mmSanitize *mContentSanitizerMockSanitize) Inspect(f func(content string)) *mContentSanitizerMockSanitize {
+	if mmSanitize.mock.inspectFuncSanitize != nil {
+		mmSanitize.mock.t.Fatalf("Inspect function is already set for ContentSanitizerMock.Sanitize")
+	}
+
+	mmSanitize.mock.inspectFuncSanitize = f
+
+	return mmSanitize
+}
+
+// Return sets up results that will be returned by contentSanitizer.Sanitize
+func (mmSanitize *mContentSanitizerMockSanitize) Return(s1 string, sa1 []string) *ContentSanitizerMock {
+	if mmSanitize.mock.funcSanitize != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by Set")
+	}
+
+	if mmSanitize.defaultExpectation == nil {
+		mmSanitize.defaultExpectation = &ContentSanitizerMockSanitizeExpectation{mock: mmSanitize.mock}
+	}
+	mmSanitize.defaultExpectation.results = &ContentSanitizerMockSanitizeResults{s1, sa1}
+	mmSanitize.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSanitize.mock
+}
+
+// Set uses given function f to mock the contentSanitizer.Sanitize method
+func (mmSanitize *mContentSanitizerMockSanitize) Set(f func(content string) (s1 string, sa1 []string)) *ContentSanitizerMock {
+	if mmSanitize.defaultExpectation != nil {
+		mmSanitize.mock.t.Fatalf("Default expectation is already set for the contentSanitizer.Sanitize method")
+	}
+
+	if len(mmSanitize.expectations) > 0 {
+		mmSanitize.mock.t.Fatalf("Some expectations are already set for the contentSanitizer.Sanitize method")
+	}
+
+	mmSanitize.mock.funcSanitize = f
+	mmSanitize.mock.funcSanitizeOrigin = minimock.CallerInfo(1)
+	return mmSanitize.mock
+}
+
+// When sets expectation for the contentSanitizer.Sanitize which will trigger the result defined by the following
+// Then helper
+func (mmSanitize *mContentSanitizerMockSanitize) When(content string) *ContentSanitizerMockSanitizeExpectation {
+	if mmSanitize.mock.funcSanitize != nil {
+		mmSanitize.mock.t.Fatalf("ContentSanitizerMock.Sanitize mock is already set by Set")
+	}
+
+	expectation := &ContentSanitizerMockSanitizeExpectation{
+		mock:               mmSanitize.mock,
+		params:             &ContentSanitizerMockSanitizeParams{content},
+		expectationOrigins: ContentSanitizerMockSanitizeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSanitize.expectations = append(mmSanitize.expectations, expectation)
+	return expectation
+}
+
+// Then sets up contentSanitizer.Sanitize return parameters for the expectation previously defined by the When method
+func (e *ContentSanitizerMockSanitizeExpectation) Then(s1 string, sa1 []string) *ContentSanitizerMock {
+	e.results = &ContentSanitizerMockSanitizeResults{s1, sa1}
+	return e.mock
+}
+
+// Times sets number of times contentSanitizer.Sanitize should be invoked
+func (mmSanitize *mContentSanitizerMockSanitize) Times(n uint64) *mContentSanitizerMockSanitize {
+	if n == 0 {
+		mmSanitize.mock.t.Fatalf("Times of ContentSanitizerMock.Sanitize mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSanitize.expectedInvocations, n)
+	mmSanitize.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSanitize
+}
+
+func (mmSanitize *mContentSanitizerMockSanitize) invocationsDone() bool {
+	if len(mmSanitize.expectations) == 0 && mmSanitize.defaultExpectation == nil && mmSanitize.mock.funcSanitize == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSanitize.mock.afterSanitizeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSanitize.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Sanitize implements mm_main.contentSanitizer
+func (mmSanitize *ContentSanitizerMock) Sanitize(content string) (s1 string, sa1 []string) {
+	mm_atomic.AddUint64(&mmSanitize.beforeSanitizeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSanitize.afterSanitizeCounter, 1)
+
+	mmSanitize.t.Helper()
+
+	if mmSanitize.inspectFuncSanitize != nil {
+		mmSanitize.inspectFuncSanitize(content)
+	}
+
+	mm_params := ContentSanitizerMockSanitizeParams{content}
+
+	// Record call args
+	mmSanitize.SanitizeMock.mutex.Lock()
+	mmSanitize.SanitizeMock.callArgs = append(mmSanitize.SanitizeMock.callArgs, &mm_params)
+	mmSanitize.SanitizeMock.mutex.Unlock()
+
+	for _, e := range mmSanitize.SanitizeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.sa1
+		}
+	}
+
+	if mmSanitize.SanitizeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSanitize.SanitizeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSanitize.SanitizeMock.defaultExpectation.params
+		mm_want_ptrs := mmSanitize.SanitizeMock.defaultExpectation.paramPtrs
+
+		mm_got := ContentSanitizerMockSanitizeParams{content}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.content != nil && !minimock.Equal(*mm_want_ptrs.content, mm_got.content) {
+				mmSanitize.t.Errorf("ContentSanitizerMock.Sanitize got unexpected parameter content, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSanitize.SanitizeMock.defaultExpectation.expectationOrigins.originContent, *mm_want_ptrs.content, mm_got.content, minimock.Diff(*mm_want_ptrs.content, mm_got.content))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSanitize.t.Errorf("ContentSanitizerMock.Sanitize got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSanitize.SanitizeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSanitize.SanitizeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSanitize.t.Fatal("No results are set for the ContentSanitizerMock.Sanitize")
+		}
+		return (*mm_results).s1, (*mm_results).sa1
+	}
+	if mmSanitize.funcSanitize != nil {
+		return mmSanitize.funcSanitize(content)
+	}
+	mmSanitize.t.Fatalf("Unexpected call to ContentSanitizerMock.Sanitize. %v", content)
+	return
+}
+
+// SanitizeAfterCounter returns a count of finished ContentSanitizerMock.Sanitize invocations
+func (mmSanitize *ContentSanitizerMock) SanitizeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSanitize.afterSanitizeCounter)
+}
+
+// SanitizeBeforeCounter returns a count of ContentSanitizerMock.Sanitize invocations
+func (mmSanitize *ContentSanitizerMock) SanitizeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSanitize.beforeSanitizeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ContentSanitizerMock.Sanitize.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSanitize *mContentSanitizerMockSanitize) Calls() []*ContentSanitizerMockSanitizeParams {
+	mmSanitize.mutex.RLock()
+
+	argCopy := make([]*ContentSanitizerMockSanitizeParams, len(mmSanitize.callArgs))
+	copy(argCopy, mmSanitize.callArgs)
+
+	mmSanitize.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSanitizeDone returns true if the count of the Sanitize invocations corresponds
+// the number of defined expectations
+func (m *ContentSanitizerMock) MinimockSanitizeDone() bool {
+	if m.SanitizeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SanitizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SanitizeMock.invocationsDone()
+}
+
+// MinimockSanitizeInspect logs each unmet expectation
+func (m *ContentSanitizerMock) MinimockSanitizeInspect() {
+	for _, e := range m.SanitizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ContentSanitizerMock.Sanitize at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSanitizeCounter := mm_atomic.LoadUint64(&m.afterSanitizeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SanitizeMock.defaultExpectation != nil && afterSanitizeCounter < 1 {
+		if m.SanitizeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ContentSanitizerMock.Sanitize at\n%s", m.SanitizeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ContentSanitizerMock.Sanitize at\n%s with params: %#v", m.SanitizeMock.defaultExpectation.expectationOrigins.origin, *m.SanitizeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSanitize != nil && afterSanitizeCounter < 1 {
+		m.t.Errorf("Expected call to ContentSanitizerMock.Sanitize at\n%s", m.funcSanitizeOrigin)
+	}
+
+	if !m.SanitizeMock.invocationsDone() && afterSanitizeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ContentSanitizerMock.Sanitize at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SanitizeMock.expectedInvocations), m.SanitizeMock.expectedInvocationsOrigin, afterSanitizeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ContentSanitizerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSanitizeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ContentSanitizerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ContentSanitizerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSanitizeDone()
+}
@@ -0,0 +1,1519 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.entityCore -o entity_core_mock.go -n EntityCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// EntityCoreMock implements mm_main.entityCore
+type EntityCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, req entity.CreateEntityReq)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mEntityCoreMockCreate
+
+	funcGet          func(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id uuid.UUID)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mEntityCoreMockGet
+
+	funcGetTree          func(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (t1 entity.Tree, err error)
+	funcGetTreeOrigin    string
+	inspectFuncGetTree   func(ctx context.Context, permissions []uuid.UUID, isAdmin bool)
+	afterGetTreeCounter  uint64
+	beforeGetTreeCounter uint64
+	GetTreeMock          mEntityCoreMockGetTree
+
+	funcUpdate          func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, req entity.UpdateEntityReq)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mEntityCoreMockUpdate
+}
+
+// NewEntityCoreMock returns a mock for mm_main.entityCore
+func NewEntityCoreMock(t minimock.Tester) *EntityCoreMock {
+	m := &EntityCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mEntityCoreMockCreate{mock: m}
+	m.CreateMock.callArgs = []*EntityCoreMockCreateParams{}
+
+	m.GetMock = mEntityCoreMockGet{mock: m}
+	m.GetMock.callArgs = []*EntityCoreMockGetParams{}
+
+	m.GetTreeMock = mEntityCoreMockGetTree{mock: m}
+	m.GetTreeMock.callArgs = []*EntityCoreMockGetTreeParams{}
+
+	m.UpdateMock = mEntityCoreMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*EntityCoreMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEntityCoreMockCreate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockCreateExpectation
+	expectations       []*EntityCoreMockCreateExpectation
+
+	callArgs []*EntityCoreMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockCreateExpectation specifies expectation struct of the entityCore.Create
+type EntityCoreMockCreateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockCreateParams
+	paramPtrs          *EntityCoreMockCreateParamPtrs
+	expectationOrigins EntityCoreMockCreateExpectationOrigins
+	results            *EntityCoreMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockCreateParams contains parameters of the entityCore.Create
+type EntityCoreMockCreateParams struct {
+	ctx context.Context
+	req entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateParamPtrs contains pointers to parameters of the entityCore.Create
+type EntityCoreMockCreateParamPtrs struct {
+	ctx *context.Context
+	req *entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateResults contains results of the entityCore.Create
+type EntityCoreMockCreateResults struct {
+	u1  uuid.UUID
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockCreateOrigins contains origins of expectations of the entityCore.Create
+type EntityCoreMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mEntityCoreMockCreate) Optional() *mEntityCoreMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for entityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Expect(ctx context.Context, req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &EntityCoreMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for entityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for entityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectReqParam2(req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the entityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Inspect(f func(ctx context.Context, req entity.CreateEntityReq)) *mEntityCoreMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by entityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Return(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &EntityCoreMockCreateResults{u1, c2, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the entityCore.Create method
+func (mmCreate *mEntityCoreMockCreate) Set(f func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the entityCore.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the entityCore.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the entityCore.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mEntityCoreMockCreate) When(ctx context.Context, req entity.CreateEntityReq) *EntityCoreMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &EntityCoreMockCreateParams{ctx, req},
+		expectationOrigins: EntityCoreMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up entityCore.Create return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockCreateExpectation) Then(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockCreateResults{u1, c2, err}
+	return e.mock
+}
+
+// Times sets number of times entityCore.Create should be invoked
+func (mmCreate *mEntityCoreMockCreate) Times(n uint64) *mEntityCoreMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of EntityCoreMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mEntityCoreMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_main.entityCore
+func (mmCreate *EntityCoreMock) Create(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the EntityCoreMock.Create")
+		}
+		return (*mm_results).u1, (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to EntityCoreMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mEntityCoreMockCreate) Calls() []*EntityCoreMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mEntityCoreMockGet struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetExpectation
+	expectations       []*EntityCoreMockGetExpectation
+
+	callArgs []*EntityCoreMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetExpectation specifies expectation struct of the entityCore.Get
+type EntityCoreMockGetExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetParams
+	paramPtrs          *EntityCoreMockGetParamPtrs
+	expectationOrigins EntityCoreMockGetExpectationOrigins
+	results            *EntityCoreMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetParams contains parameters of the entityCore.Get
+type EntityCoreMockGetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// EntityCoreMockGetParamPtrs contains pointers to parameters of the entityCore.Get
+type EntityCoreMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// EntityCoreMockGetResults contains results of the entityCore.Get
+type EntityCoreMockGetResults struct {
+	e1  entity.Entity
+	err error
+}
+
+// EntityCoreMockGetOrigins contains origins of expectations of the entityCore.Get
+type EntityCoreMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mEntityCoreMockGet) Optional() *mEntityCoreMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for entityCore.Get
+func (mmGet *mEntityCoreMockGet) Expect(ctx context.Context, id uuid.UUID) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &EntityCoreMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for entityCore.Get
+func (mmGet *mEntityCoreMockGet) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &EntityCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for entityCore.Get
+func (mmGet *mEntityCoreMockGet) ExpectIdParam2(id uuid.UUID) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &EntityCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the entityCore.Get
+func (mmGet *mEntityCoreMockGet) Inspect(f func(ctx context.Context, id uuid.UUID)) *mEntityCoreMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by entityCore.Get
+func (mmGet *mEntityCoreMockGet) Return(e1 entity.Entity, err error) *EntityCoreMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &EntityCoreMockGetResults{e1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the entityCore.Get method
+func (mmGet *mEntityCoreMockGet) Set(f func(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error)) *EntityCoreMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the entityCore.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the entityCore.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the entityCore.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mEntityCoreMockGet) When(ctx context.Context, id uuid.UUID) *EntityCoreMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &EntityCoreMockGetParams{ctx, id},
+		expectationOrigins: EntityCoreMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up entityCore.Get return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetExpectation) Then(e1 entity.Entity, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times entityCore.Get should be invoked
+func (mmGet *mEntityCoreMockGet) Times(n uint64) *mEntityCoreMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of EntityCoreMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mEntityCoreMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_main.entityCore
+func (mmGet *EntityCoreMock) Get(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := EntityCoreMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the EntityCoreMock.Get")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to EntityCoreMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished EntityCoreMock.Get invocations
+func (mmGet *EntityCoreMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of EntityCoreMock.Get invocations
+func (mmGet *EntityCoreMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mEntityCoreMockGet) Calls() []*EntityCoreMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mEntityCoreMockGetTree struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetTreeExpectation
+	expectations       []*EntityCoreMockGetTreeExpectation
+
+	callArgs []*EntityCoreMockGetTreeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetTreeExpectation specifies expectation struct of the entityCore.GetTree
+type EntityCoreMockGetTreeExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetTreeParams
+	paramPtrs          *EntityCoreMockGetTreeParamPtrs
+	expectationOrigins EntityCoreMockGetTreeExpectationOrigins
+	results            *EntityCoreMockGetTreeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetTreeParams contains parameters of the entityCore.GetTree
+type EntityCoreMockGetTreeParams struct {
+	ctx         context.Context
+	permissions []uuid.UUID
+	isAdmin     bool
+}
+
+// EntityCoreMockGetTreeParamPtrs contains pointers to parameters of the entityCore.GetTree
+type EntityCoreMockGetTreeParamPtrs struct {
+	ctx         *context.Context
+	permissions *[]uuid.UUID
+	isAdmin     *bool
+}
+
+// EntityCoreMockGetTreeResults contains results of the entityCore.GetTree
+type EntityCoreMockGetTreeResults struct {
+	t1  entity.Tree
+	err error
+}
+
+// EntityCoreMockGetTreeOrigins contains origins of expectations of the entityCore.GetTree
+type EntityCoreMockGetTreeExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originPermissions string
+	originIsAdmin     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTree *mEntityCoreMockGetTree) Optional() *mEntityCoreMockGetTree {
+	mmGetTree.optional = true
+	return mmGetTree
+}
+
+// Expect sets up expected params for entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) Expect(ctx context.Context, permissions []uuid.UUID, isAdmin bool) *mEntityCoreMockGetTree {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	if mmGetTree.defaultExpectation == nil {
+		mmGetTree.defaultExpectation = &EntityCoreMockGetTreeExpectation{}
+	}
+
+	if mmGetTree.defaultExpectation.paramPtrs != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by ExpectParams functions")
+	}
+
+	mmGetTree.defaultExpectation.params = &EntityCoreMockGetTreeParams{ctx, permissions, isAdmin}
+	mmGetTree.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTree.expectations {
+		if minimock.Equal(e.params, mmGetTree.defaultExpectation.params) {
+			mmGetTree.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTree.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTree
+}
+
+// ExpectCtxParam1 sets up expected param ctx for entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGetTree {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	if mmGetTree.defaultExpectation == nil {
+		mmGetTree.defaultExpectation = &EntityCoreMockGetTreeExpectation{}
+	}
+
+	if mmGetTree.defaultExpectation.params != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Expect")
+	}
+
+	if mmGetTree.defaultExpectation.paramPtrs == nil {
+		mmGetTree.defaultExpectation.paramPtrs = &EntityCoreMockGetTreeParamPtrs{}
+	}
+	mmGetTree.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTree.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTree
+}
+
+// ExpectPermissionsParam2 sets up expected param permissions for entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) ExpectPermissionsParam2(permissions []uuid.UUID) *mEntityCoreMockGetTree {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	if mmGetTree.defaultExpectation == nil {
+		mmGetTree.defaultExpectation = &EntityCoreMockGetTreeExpectation{}
+	}
+
+	if mmGetTree.defaultExpectation.params != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Expect")
+	}
+
+	if mmGetTree.defaultExpectation.paramPtrs == nil {
+		mmGetTree.defaultExpectation.paramPtrs = &EntityCoreMockGetTreeParamPtrs{}
+	}
+	mmGetTree.defaultExpectation.paramPtrs.permissions = &permissions
+	mmGetTree.defaultExpectation.expectationOrigins.originPermissions = minimock.CallerInfo(1)
+
+	return mmGetTree
+}
+
+// ExpectIsAdminParam3 sets up expected param isAdmin for entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) ExpectIsAdminParam3(isAdmin bool) *mEntityCoreMockGetTree {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	if mmGetTree.defaultExpectation == nil {
+		mmGetTree.defaultExpectation = &EntityCoreMockGetTreeExpectation{}
+	}
+
+	if mmGetTree.defaultExpectation.params != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Expect")
+	}
+
+	if mmGetTree.defaultExpectation.paramPtrs == nil {
+		mmGetTree.defaultExpectation.paramPtrs = &EntityCoreMockGetTreeParamPtrs{}
+	}
+	mmGetTree.defaultExpectation.paramPtrs.isAdmin = &isAdmin
+	mmGetTree.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+
+	return mmGetTree
+}
+
+// Inspect accepts an inspector function that has same arguments as the entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) Inspect(f func(ctx context.Context, permissions []uuid.UUID, isAdmin bool)) *mEntityCoreMockGetTree {
+	if mmGetTree.mock.inspectFuncGetTree != nil {
+		mmGetTree.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.GetTree")
+	}
+
+	mmGetTree.mock.inspectFuncGetTree = f
+
+	return mmGetTree
+}
+
+// Return sets up results that will be returned by entityCore.GetTree
+func (mmGetTree *mEntityCoreMockGetTree) Return(t1 entity.Tree, err error) *EntityCoreMock {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	if mmGetTree.defaultExpectation == nil {
+		mmGetTree.defaultExpectation = &EntityCoreMockGetTreeExpectation{mock: mmGetTree.mock}
+	}
+	mmGetTree.defaultExpectation.results = &EntityCoreMockGetTreeResults{t1, err}
+	mmGetTree.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTree.mock
+}
+
+// Set uses given function f to mock the entityCore.GetTree method
+func (mmGetTree *mEntityCoreMockGetTree) Set(f func(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (t1 entity.Tree, err error)) *EntityCoreMock {
+	if mmGetTree.defaultExpectation != nil {
+		mmGetTree.mock.t.Fatalf("Default expectation is already set for the entityCore.GetTree method")
+	}
+
+	if len(mmGetTree.expectations) > 0 {
+		mmGetTree.mock.t.Fatalf("Some expectations are already set for the entityCore.GetTree method")
+	}
+
+	mmGetTree.mock.funcGetTree = f
+	mmGetTree.mock.funcGetTreeOrigin = minimock.CallerInfo(1)
+	return mmGetTree.mock
+}
+
+// When sets expectation for the entityCore.GetTree which will trigger the result defined by the following
+// Then helper
+func (mmGetTree *mEntityCoreMockGetTree) When(ctx context.Context, permissions []uuid.UUID, isAdmin bool) *EntityCoreMockGetTreeExpectation {
+	if mmGetTree.mock.funcGetTree != nil {
+		mmGetTree.mock.t.Fatalf("EntityCoreMock.GetTree mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetTreeExpectation{
+		mock:               mmGetTree.mock,
+		params:             &EntityCoreMockGetTreeParams{ctx, permissions, isAdmin},
+		expectationOrigins: EntityCoreMockGetTreeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTree.expectations = append(mmGetTree.expectations, expectation)
+	return expectation
+}
+
+// Then sets up entityCore.GetTree return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetTreeExpectation) Then(t1 entity.Tree, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetTreeResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times entityCore.GetTree should be invoked
+func (mmGetTree *mEntityCoreMockGetTree) Times(n uint64) *mEntityCoreMockGetTree {
+	if n == 0 {
+		mmGetTree.mock.t.Fatalf("Times of EntityCoreMock.GetTree mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTree.expectedInvocations, n)
+	mmGetTree.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTree
+}
+
+func (mmGetTree *mEntityCoreMockGetTree) invocationsDone() bool {
+	if len(mmGetTree.expectations) == 0 && mmGetTree.defaultExpectation == nil && mmGetTree.mock.funcGetTree == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTree.mock.afterGetTreeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTree.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTree implements mm_main.entityCore
+func (mmGetTree *EntityCoreMock) GetTree(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (t1 entity.Tree, err error) {
+	mm_atomic.AddUint64(&mmGetTree.beforeGetTreeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTree.afterGetTreeCounter, 1)
+
+	mmGetTree.t.Helper()
+
+	if mmGetTree.inspectFuncGetTree != nil {
+		mmGetTree.inspectFuncGetTree(ctx, permissions, isAdmin)
+	}
+
+	mm_params := EntityCoreMockGetTreeParams{ctx, permissions, isAdmin}
+
+	// Record call args
+	mmGetTree.GetTreeMock.mutex.Lock()
+	mmGetTree.GetTreeMock.callArgs = append(mmGetTree.GetTreeMock.callArgs, &mm_params)
+	mmGetTree.GetTreeMock.mutex.Unlock()
+
+	for _, e := range mmGetTree.GetTreeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTree.GetTreeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTree.GetTreeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTree.GetTreeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTree.GetTreeMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetTreeParams{ctx, permissions, isAdmin}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTree.t.Errorf("EntityCoreMock.GetTree got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTree.GetTreeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.permissions != nil && !minimock.Equal(*mm_want_ptrs.permissions, mm_got.permissions) {
+				mmGetTree.t.Errorf("EntityCoreMock.GetTree got unexpected parameter permissions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTree.GetTreeMock.defaultExpectation.expectationOrigins.originPermissions, *mm_want_ptrs.permissions, mm_got.permissions, minimock.Diff(*mm_want_ptrs.permissions, mm_got.permissions))
+			}
+
+			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
+				mmGetTree.t.Errorf("EntityCoreMock.GetTree got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTree.GetTreeMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTree.t.Errorf("EntityCoreMock.GetTree got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTree.GetTreeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTree.GetTreeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTree.t.Fatal("No results are set for the EntityCoreMock.GetTree")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTree.funcGetTree != nil {
+		return mmGetTree.funcGetTree(ctx, permissions, isAdmin)
+	}
+	mmGetTree.t.Fatalf("Unexpected call to EntityCoreMock.GetTree. %v %v %v", ctx, permissions, isAdmin)
+	return
+}
+
+// GetTreeAfterCounter returns a count of finished EntityCoreMock.GetTree invocations
+func (mmGetTree *EntityCoreMock) GetTreeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTree.afterGetTreeCounter)
+}
+
+// GetTreeBeforeCounter returns a count of EntityCoreMock.GetTree invocations
+func (mmGetTree *EntityCoreMock) GetTreeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTree.beforeGetTreeCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.GetTree.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTree *mEntityCoreMockGetTree) Calls() []*EntityCoreMockGetTreeParams {
+	mmGetTree.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetTreeParams, len(mmGetTree.callArgs))
+	copy(argCopy, mmGetTree.callArgs)
+
+	mmGetTree.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTreeDone returns true if the count of the GetTree invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetTreeDone() bool {
+	if m.GetTreeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTreeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTreeMock.invocationsDone()
+}
+
+// MinimockGetTreeInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetTreeInspect() {
+	for _, e := range m.GetTreeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.GetTree at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTreeCounter := mm_atomic.LoadUint64(&m.afterGetTreeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTreeMock.defaultExpectation != nil && afterGetTreeCounter < 1 {
+		if m.GetTreeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.GetTree at\n%s", m.GetTreeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.GetTree at\n%s with params: %#v", m.GetTreeMock.defaultExpectation.expectationOrigins.origin, *m.GetTreeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTree != nil && afterGetTreeCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.GetTree at\n%s", m.funcGetTreeOrigin)
+	}
+
+	if !m.GetTreeMock.invocationsDone() && afterGetTreeCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.GetTree at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTreeMock.expectedInvocations), m.GetTreeMock.expectedInvocationsOrigin, afterGetTreeCounter)
+	}
+}
+
+type mEntityCoreMockUpdate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockUpdateExpectation
+	expectations       []*EntityCoreMockUpdateExpectation
+
+	callArgs []*EntityCoreMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockUpdateExpectation specifies expectation struct of the entityCore.Update
+type EntityCoreMockUpdateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockUpdateParams
+	paramPtrs          *EntityCoreMockUpdateParamPtrs
+	expectationOrigins EntityCoreMockUpdateExpectationOrigins
+	results            *EntityCoreMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockUpdateParams contains parameters of the entityCore.Update
+type EntityCoreMockUpdateParams struct {
+	ctx context.Context
+	req entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateParamPtrs contains pointers to parameters of the entityCore.Update
+type EntityCoreMockUpdateParamPtrs struct {
+	ctx *context.Context
+	req *entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateResults contains results of the entityCore.Update
+type EntityCoreMockUpdateResults struct {
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockUpdateOrigins contains origins of expectations of the entityCore.Update
+type EntityCoreMockUpdateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mEntityCoreMockUpdate) Optional() *mEntityCoreMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for entityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Expect(ctx context.Context, req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &EntityCoreMockUpdateParams{ctx, req}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for entityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectReqParam2 sets up expected param req for entityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectReqParam2(req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.req = &req
+	mmUpdate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the entityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Inspect(f func(ctx context.Context, req entity.UpdateEntityReq)) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by entityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Return(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &EntityCoreMockUpdateResults{c2, err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the entityCore.Update method
+func (mmUpdate *mEntityCoreMockUpdate) Set(f func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the entityCore.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the entityCore.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the entityCore.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mEntityCoreMockUpdate) When(ctx context.Context, req entity.UpdateEntityReq) *EntityCoreMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &EntityCoreMockUpdateParams{ctx, req},
+		expectationOrigins: EntityCoreMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up entityCore.Update return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockUpdateExpectation) Then(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockUpdateResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times entityCore.Update should be invoked
+func (mmUpdate *mEntityCoreMockUpdate) Times(n uint64) *mEntityCoreMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of EntityCoreMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mEntityCoreMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_main.entityCore
+func (mmUpdate *EntityCoreMock) Update(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockUpdateParams{ctx, req}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockUpdateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the EntityCoreMock.Update")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, req)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to EntityCoreMock.Update. %v %v", ctx, req)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mEntityCoreMockUpdate) Calls() []*EntityCoreMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EntityCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetTreeInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EntityCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EntityCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetTreeDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,1130 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.userCore -o user_core_mock.go -n UserCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// UserCoreMock implements mm_main.userCore
+type UserCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateUser          func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)
+	funcCreateUserOrigin    string
+	inspectFuncCreateUser   func(ctx context.Context, req user.CreateUserReq)
+	afterCreateUserCounter  uint64
+	beforeCreateUserCounter uint64
+	CreateUserMock          mUserCoreMockCreateUser
+
+	funcDeleteUser          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteUserOrigin    string
+	inspectFuncDeleteUser   func(ctx context.Context, id uuid.UUID)
+	afterDeleteUserCounter  uint64
+	beforeDeleteUserCounter uint64
+	DeleteUserMock          mUserCoreMockDeleteUser
+
+	funcGetAllUsers          func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)
+	funcGetAllUsersOrigin    string
+	inspectFuncGetAllUsers   func(ctx context.Context, opts user.ListUsersOptions)
+	afterGetAllUsersCounter  uint64
+	beforeGetAllUsersCounter uint64
+	GetAllUsersMock          mUserCoreMockGetAllUsers
+}
+
+// NewUserCoreMock returns a mock for mm_main.userCore
+func NewUserCoreMock(t minimock.Tester) *UserCoreMock {
+	m := &UserCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateUserMock = mUserCoreMockCreateUser{mock: m}
+	m.CreateUserMock.callArgs = []*UserCoreMockCreateUserParams{}
+
+	m.DeleteUserMock = mUserCoreMockDeleteUser{mock: m}
+	m.DeleteUserMock.callArgs = []*UserCoreMockDeleteUserParams{}
+
+	m.GetAllUsersMock = mUserCoreMockGetAllUsers{mock: m}
+	m.GetAllUsersMock.callArgs = []*UserCoreMockGetAllUsersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserCoreMockCreateUser struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockCreateUserExpectation
+	expectations       []*UserCoreMockCreateUserExpectation
+
+	callArgs []*UserCoreMockCreateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockCreateUserExpectation specifies expectation struct of the userCore.CreateUser
+type UserCoreMockCreateUserExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockCreateUserParams
+	paramPtrs          *UserCoreMockCreateUserParamPtrs
+	expectationOrigins UserCoreMockCreateUserExpectationOrigins
+	results            *UserCoreMockCreateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockCreateUserParams contains parameters of the userCore.CreateUser
+type UserCoreMockCreateUserParams struct {
+	ctx context.Context
+	req user.CreateUserReq
+}
+
+// UserCoreMockCreateUserParamPtrs contains pointers to parameters of the userCore.CreateUser
+type UserCoreMockCreateUserParamPtrs struct {
+	ctx *context.Context
+	req *user.CreateUserReq
+}
+
+// UserCoreMockCreateUserResults contains results of the userCore.CreateUser
+type UserCoreMockCreateUserResults struct {
+	u1  uuid.UUID
+	err error
+}
+
+// UserCoreMockCreateUserOrigins contains origins of expectations of the userCore.CreateUser
+type UserCoreMockCreateUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mUserCoreMockCreateUser) Optional() *mUserCoreMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for userCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Expect(ctx context.Context, req user.CreateUserReq) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &UserCoreMockCreateUserParams{ctx, req}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for userCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserCoreMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectReqParam2 sets up expected param req for userCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) ExpectReqParam2(req user.CreateUserReq) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserCoreMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.req = &req
+	mmCreateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the userCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Inspect(f func(ctx context.Context, req user.CreateUserReq)) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserCoreMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by userCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Return(u1 uuid.UUID, err error) *UserCoreMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &UserCoreMockCreateUserResults{u1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the userCore.CreateUser method
+func (mmCreateUser *mUserCoreMockCreateUser) Set(f func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)) *UserCoreMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the userCore.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the userCore.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the userCore.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mUserCoreMockCreateUser) When(ctx context.Context, req user.CreateUserReq) *UserCoreMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &UserCoreMockCreateUserParams{ctx, req},
+		expectationOrigins: UserCoreMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up userCore.CreateUser return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockCreateUserExpectation) Then(u1 uuid.UUID, err error) *UserCoreMock {
+	e.results = &UserCoreMockCreateUserResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times userCore.CreateUser should be invoked
+func (mmCreateUser *mUserCoreMockCreateUser) Times(n uint64) *mUserCoreMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of UserCoreMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mUserCoreMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_main.userCore
+func (mmCreateUser *UserCoreMock) CreateUser(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, req)
+	}
+
+	mm_params := UserCoreMockCreateUserParams{ctx, req}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockCreateUserParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the UserCoreMock.CreateUser")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, req)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to UserCoreMock.CreateUser. %v %v", ctx, req)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished UserCoreMock.CreateUser invocations
+func (mmCreateUser *UserCoreMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of UserCoreMock.CreateUser invocations
+func (mmCreateUser *UserCoreMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mUserCoreMockCreateUser) Calls() []*UserCoreMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mUserCoreMockDeleteUser struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockDeleteUserExpectation
+	expectations       []*UserCoreMockDeleteUserExpectation
+
+	callArgs []*UserCoreMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockDeleteUserExpectation specifies expectation struct of the userCore.DeleteUser
+type UserCoreMockDeleteUserExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockDeleteUserParams
+	paramPtrs          *UserCoreMockDeleteUserParamPtrs
+	expectationOrigins UserCoreMockDeleteUserExpectationOrigins
+	results            *UserCoreMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockDeleteUserParams contains parameters of the userCore.DeleteUser
+type UserCoreMockDeleteUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// UserCoreMockDeleteUserParamPtrs contains pointers to parameters of the userCore.DeleteUser
+type UserCoreMockDeleteUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// UserCoreMockDeleteUserResults contains results of the userCore.DeleteUser
+type UserCoreMockDeleteUserResults struct {
+	err error
+}
+
+// UserCoreMockDeleteUserOrigins contains origins of expectations of the userCore.DeleteUser
+type UserCoreMockDeleteUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mUserCoreMockDeleteUser) Optional() *mUserCoreMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for userCore.DeleteUser
+func (mmDeleteUser *mUserCoreMockDeleteUser) Expect(ctx context.Context, id uuid.UUID) *mUserCoreMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserCoreMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &UserCoreMockDeleteUserParams{ctx, id}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for userCore.DeleteUser
+func (mmDeleteUser *mUserCoreMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mUserCoreMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserCoreMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserCoreMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectIdParam2 sets up expected param id for userCore.DeleteUser
+func (mmDeleteUser *mUserCoreMockDeleteUser) ExpectIdParam2(id uuid.UUID) *mUserCoreMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserCoreMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &UserCoreMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
+	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the userCore.DeleteUser
+func (mmDeleteUser *mUserCoreMockDeleteUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mUserCoreMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for UserCoreMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by userCore.DeleteUser
+func (mmDeleteUser *mUserCoreMockDeleteUser) Return(err error) *UserCoreMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &UserCoreMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &UserCoreMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the userCore.DeleteUser method
+func (mmDeleteUser *mUserCoreMockDeleteUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *UserCoreMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the userCore.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the userCore.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the userCore.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mUserCoreMockDeleteUser) When(ctx context.Context, id uuid.UUID) *UserCoreMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("UserCoreMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &UserCoreMockDeleteUserParams{ctx, id},
+		expectationOrigins: UserCoreMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up userCore.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockDeleteUserExpectation) Then(err error) *UserCoreMock {
+	e.results = &UserCoreMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times userCore.DeleteUser should be invoked
+func (mmDeleteUser *mUserCoreMockDeleteUser) Times(n uint64) *mUserCoreMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of UserCoreMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mUserCoreMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements mm_main.userCore
+func (mmDeleteUser *UserCoreMock) DeleteUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	}
+
+	mm_params := UserCoreMockDeleteUserParams{ctx, id}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockDeleteUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("UserCoreMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteUser.t.Errorf("UserCoreMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("UserCoreMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the UserCoreMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, id)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to UserCoreMock.DeleteUser. %v %v", ctx, id)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished UserCoreMock.DeleteUser invocations
+func (mmDeleteUser *UserCoreMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of UserCoreMock.DeleteUser invocations
+func (mmDeleteUser *UserCoreMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mUserCoreMockDeleteUser) Calls() []*UserCoreMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mUserCoreMockGetAllUsers struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockGetAllUsersExpectation
+	expectations       []*UserCoreMockGetAllUsersExpectation
+
+	callArgs []*UserCoreMockGetAllUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockGetAllUsersExpectation specifies expectation struct of the userCore.GetAllUsers
+type UserCoreMockGetAllUsersExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockGetAllUsersParams
+	paramPtrs          *UserCoreMockGetAllUsersParamPtrs
+	expectationOrigins UserCoreMockGetAllUsersExpectationOrigins
+	results            *UserCoreMockGetAllUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockGetAllUsersParams contains parameters of the userCore.GetAllUsers
+type UserCoreMockGetAllUsersParams struct {
+	ctx  context.Context
+	opts user.ListUsersOptions
+}
+
+// UserCoreMockGetAllUsersParamPtrs contains pointers to parameters of the userCore.GetAllUsers
+type UserCoreMockGetAllUsersParamPtrs struct {
+	ctx  *context.Context
+	opts *user.ListUsersOptions
+}
+
+// UserCoreMockGetAllUsersResults contains results of the userCore.GetAllUsers
+type UserCoreMockGetAllUsersResults struct {
+	u1  user.UsersPage
+	err error
+}
+
+// UserCoreMockGetAllUsersOrigins contains origins of expectations of the userCore.GetAllUsers
+type UserCoreMockGetAllUsersExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Optional() *mUserCoreMockGetAllUsers {
+	mmGetAllUsers.optional = true
+	return mmGetAllUsers
+}
+
+// Expect sets up expected params for userCore.GetAllUsers
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Expect(ctx context.Context, opts user.ListUsersOptions) *mUserCoreMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &UserCoreMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by ExpectParams functions")
+	}
+
+	mmGetAllUsers.defaultExpectation.params = &UserCoreMockGetAllUsersParams{ctx, opts}
+	mmGetAllUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAllUsers.expectations {
+		if minimock.Equal(e.params, mmGetAllUsers.defaultExpectation.params) {
+			mmGetAllUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAllUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAllUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for userCore.GetAllUsers
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) ExpectCtxParam1(ctx context.Context) *mUserCoreMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &UserCoreMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &UserCoreMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
+// ExpectOptsParam2 sets up expected param opts for userCore.GetAllUsers
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) ExpectOptsParam2(opts user.ListUsersOptions) *mUserCoreMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &UserCoreMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &UserCoreMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.opts = &opts
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the userCore.GetAllUsers
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Inspect(f func(ctx context.Context, opts user.ListUsersOptions)) *mUserCoreMockGetAllUsers {
+	if mmGetAllUsers.mock.inspectFuncGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("Inspect function is already set for UserCoreMock.GetAllUsers")
+	}
+
+	mmGetAllUsers.mock.inspectFuncGetAllUsers = f
+
+	return mmGetAllUsers
+}
+
+// Return sets up results that will be returned by userCore.GetAllUsers
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Return(u1 user.UsersPage, err error) *UserCoreMock {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &UserCoreMockGetAllUsersExpectation{mock: mmGetAllUsers.mock}
+	}
+	mmGetAllUsers.defaultExpectation.results = &UserCoreMockGetAllUsersResults{u1, err}
+	mmGetAllUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers.mock
+}
+
+// Set uses given function f to mock the userCore.GetAllUsers method
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Set(f func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)) *UserCoreMock {
+	if mmGetAllUsers.defaultExpectation != nil {
+		mmGetAllUsers.mock.t.Fatalf("Default expectation is already set for the userCore.GetAllUsers method")
+	}
+
+	if len(mmGetAllUsers.expectations) > 0 {
+		mmGetAllUsers.mock.t.Fatalf("Some expectations are already set for the userCore.GetAllUsers method")
+	}
+
+	mmGetAllUsers.mock.funcGetAllUsers = f
+	mmGetAllUsers.mock.funcGetAllUsersOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers.mock
+}
+
+// When sets expectation for the userCore.GetAllUsers which will trigger the result defined by the following
+// Then helper
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) When(ctx context.Context, opts user.ListUsersOptions) *UserCoreMockGetAllUsersExpectation {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("UserCoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockGetAllUsersExpectation{
+		mock:               mmGetAllUsers.mock,
+		params:             &UserCoreMockGetAllUsersParams{ctx, opts},
+		expectationOrigins: UserCoreMockGetAllUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAllUsers.expectations = append(mmGetAllUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up userCore.GetAllUsers return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockGetAllUsersExpectation) Then(u1 user.UsersPage, err error) *UserCoreMock {
+	e.results = &UserCoreMockGetAllUsersResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times userCore.GetAllUsers should be invoked
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Times(n uint64) *mUserCoreMockGetAllUsers {
+	if n == 0 {
+		mmGetAllUsers.mock.t.Fatalf("Times of UserCoreMock.GetAllUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAllUsers.expectedInvocations, n)
+	mmGetAllUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers
+}
+
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) invocationsDone() bool {
+	if len(mmGetAllUsers.expectations) == 0 && mmGetAllUsers.defaultExpectation == nil && mmGetAllUsers.mock.funcGetAllUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.mock.afterGetAllUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAllUsers implements mm_main.userCore
+func (mmGetAllUsers *UserCoreMock) GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error) {
+	mm_atomic.AddUint64(&mmGetAllUsers.beforeGetAllUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAllUsers.afterGetAllUsersCounter, 1)
+
+	mmGetAllUsers.t.Helper()
+
+	if mmGetAllUsers.inspectFuncGetAllUsers != nil {
+		mmGetAllUsers.inspectFuncGetAllUsers(ctx, opts)
+	}
+
+	mm_params := UserCoreMockGetAllUsersParams{ctx, opts}
+
+	// Record call args
+	mmGetAllUsers.GetAllUsersMock.mutex.Lock()
+	mmGetAllUsers.GetAllUsersMock.callArgs = append(mmGetAllUsers.GetAllUsersMock.callArgs, &mm_params)
+	mmGetAllUsers.GetAllUsersMock.mutex.Unlock()
+
+	for _, e := range mmGetAllUsers.GetAllUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetAllUsers.GetAllUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAllUsers.GetAllUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAllUsers.GetAllUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAllUsers.GetAllUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockGetAllUsersParams{ctx, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAllUsers.t.Errorf("UserCoreMock.GetAllUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmGetAllUsers.t.Errorf("UserCoreMock.GetAllUsers got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAllUsers.t.Errorf("UserCoreMock.GetAllUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAllUsers.GetAllUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAllUsers.t.Fatal("No results are set for the UserCoreMock.GetAllUsers")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetAllUsers.funcGetAllUsers != nil {
+		return mmGetAllUsers.funcGetAllUsers(ctx, opts)
+	}
+	mmGetAllUsers.t.Fatalf("Unexpected call to UserCoreMock.GetAllUsers. %v %v", ctx, opts)
+	return
+}
+
+// GetAllUsersAfterCounter returns a count of finished UserCoreMock.GetAllUsers invocations
+func (mmGetAllUsers *UserCoreMock) GetAllUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllUsers.afterGetAllUsersCounter)
+}
+
+// GetAllUsersBeforeCounter returns a count of UserCoreMock.GetAllUsers invocations
+func (mmGetAllUsers *UserCoreMock) GetAllUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllUsers.beforeGetAllUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.GetAllUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAllUsers *mUserCoreMockGetAllUsers) Calls() []*UserCoreMockGetAllUsersParams {
+	mmGetAllUsers.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockGetAllUsersParams, len(mmGetAllUsers.callArgs))
+	copy(argCopy, mmGetAllUsers.callArgs)
+
+	mmGetAllUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAllUsersDone returns true if the count of the GetAllUsers invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockGetAllUsersDone() bool {
+	if m.GetAllUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAllUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAllUsersMock.invocationsDone()
+}
+
+// MinimockGetAllUsersInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockGetAllUsersInspect() {
+	for _, e := range m.GetAllUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.GetAllUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAllUsersCounter := mm_atomic.LoadUint64(&m.afterGetAllUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAllUsersMock.defaultExpectation != nil && afterGetAllUsersCounter < 1 {
+		if m.GetAllUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.GetAllUsers at\n%s", m.GetAllUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.GetAllUsers at\n%s with params: %#v", m.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *m.GetAllUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAllUsers != nil && afterGetAllUsersCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.GetAllUsers at\n%s", m.funcGetAllUsersOrigin)
+	}
+
+	if !m.GetAllUsersMock.invocationsDone() && afterGetAllUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.GetAllUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAllUsersMock.expectedInvocations), m.GetAllUsersMock.expectedInvocationsOrigin, afterGetAllUsersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateUserInspect()
+
+			m.MinimockDeleteUserInspect()
+
+			m.MinimockGetAllUsersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateUserDone() &&
+		m.MinimockDeleteUserDone() &&
+		m.MinimockGetAllUsersDone()
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/cmd/easygodocsctl.workspaceCore -o workspace_core_mock.go -n WorkspaceCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/gojuno/minimock/v3"
+)
+
+// WorkspaceCoreMock implements mm_main.workspaceCore
+type WorkspaceCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetBySlug          func(ctx context.Context, slug string) (w1 workspace.Workspace, err error)
+	funcGetBySlugOrigin    string
+	inspectFuncGetBySlug   func(ctx context.Context, slug string)
+	afterGetBySlugCounter  uint64
+	beforeGetBySlugCounter uint64
+	GetBySlugMock          mWorkspaceCoreMockGetBySlug
+}
+
+// NewWorkspaceCoreMock returns a mock for mm_main.workspaceCore
+func NewWorkspaceCoreMock(t minimock.Tester) *WorkspaceCoreMock {
+	m := &WorkspaceCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetBySlugMock = mWorkspaceCoreMockGetBySlug{mock: m}
+	m.GetBySlugMock.callArgs = []*WorkspaceCoreMockGetBySlugParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWorkspaceCoreMockGetBySlug struct {
+	optional           bool
+	mock               *WorkspaceCoreMock
+	defaultExpectation *WorkspaceCoreMockGetBySlugExpectation
+	expectations       []*WorkspaceCoreMockGetBySlugExpectation
+
+	callArgs []*WorkspaceCoreMockGetBySlugParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WorkspaceCoreMockGetBySlugExpectation specifies expectation struct of the workspaceCore.GetBySlug
+type WorkspaceCoreMockGetBySlugExpectation struct {
+	mock               *WorkspaceCoreMock
+	params             *WorkspaceCoreMockGetBySlugParams
+	paramPtrs          *WorkspaceCoreMockGetBySlugParamPtrs
+	expectationOrigins WorkspaceCoreMockGetBySlugExpectationOrigins
+	results            *WorkspaceCoreMockGetBySlugResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WorkspaceCoreMockGetBySlugParams contains parameters of the workspaceCore.GetBySlug
+type WorkspaceCoreMockGetBySlugParams struct {
+	ctx  context.Context
+	slug string
+}
+
+// WorkspaceCoreMockGetBySlugParamPtrs contains pointers to parameters of the workspaceCore.GetBySlug
+type WorkspaceCoreMockGetBySlugParamPtrs struct {
+	ctx  *context.Context
+	slug *string
+}
+
+// WorkspaceCoreMockGetBySlugResults contains results of the workspaceCore.GetBySlug
+type WorkspaceCoreMockGetBySlugResults struct {
+	w1  workspace.Workspace
+	err error
+}
+
+// WorkspaceCoreMockGetBySlugOrigins contains origins of expectations of the workspaceCore.GetBySlug
+type WorkspaceCoreMockGetBySlugExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSlug string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Optional() *mWorkspaceCoreMockGetBySlug {
+	mmGetBySlug.optional = true
+	return mmGetBySlug
+}
+
+// Expect sets up expected params for workspaceCore.GetBySlug
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Expect(ctx context.Context, slug string) *mWorkspaceCoreMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &WorkspaceCoreMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by ExpectParams functions")
+	}
+
+	mmGetBySlug.defaultExpectation.params = &WorkspaceCoreMockGetBySlugParams{ctx, slug}
+	mmGetBySlug.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBySlug.expectations {
+		if minimock.Equal(e.params, mmGetBySlug.defaultExpectation.params) {
+			mmGetBySlug.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySlug.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBySlug
+}
+
+// ExpectCtxParam1 sets up expected param ctx for workspaceCore.GetBySlug
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) ExpectCtxParam1(ctx context.Context) *mWorkspaceCoreMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &WorkspaceCoreMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &WorkspaceCoreMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBySlug.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBySlug
+}
+
+// ExpectSlugParam2 sets up expected param slug for workspaceCore.GetBySlug
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) ExpectSlugParam2(slug string) *mWorkspaceCoreMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &WorkspaceCoreMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &WorkspaceCoreMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.slug = &slug
+	mmGetBySlug.defaultExpectation.expectationOrigins.originSlug = minimock.CallerInfo(1)
+
+	return mmGetBySlug
+}
+
+// Inspect accepts an inspector function that has same arguments as the workspaceCore.GetBySlug
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Inspect(f func(ctx context.Context, slug string)) *mWorkspaceCoreMockGetBySlug {
+	if mmGetBySlug.mock.inspectFuncGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("Inspect function is already set for WorkspaceCoreMock.GetBySlug")
+	}
+
+	mmGetBySlug.mock.inspectFuncGetBySlug = f
+
+	return mmGetBySlug
+}
+
+// Return sets up results that will be returned by workspaceCore.GetBySlug
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Return(w1 workspace.Workspace, err error) *WorkspaceCoreMock {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &WorkspaceCoreMockGetBySlugExpectation{mock: mmGetBySlug.mock}
+	}
+	mmGetBySlug.defaultExpectation.results = &WorkspaceCoreMockGetBySlugResults{w1, err}
+	mmGetBySlug.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug.mock
+}
+
+// Set uses given function f to mock the workspaceCore.GetBySlug method
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Set(f func(ctx context.Context, slug string) (w1 workspace.Workspace, err error)) *WorkspaceCoreMock {
+	if mmGetBySlug.defaultExpectation != nil {
+		mmGetBySlug.mock.t.Fatalf("Default expectation is already set for the workspaceCore.GetBySlug method")
+	}
+
+	if len(mmGetBySlug.expectations) > 0 {
+		mmGetBySlug.mock.t.Fatalf("Some expectations are already set for the workspaceCore.GetBySlug method")
+	}
+
+	mmGetBySlug.mock.funcGetBySlug = f
+	mmGetBySlug.mock.funcGetBySlugOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug.mock
+}
+
+// When sets expectation for the workspaceCore.GetBySlug which will trigger the result defined by the following
+// Then helper
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) When(ctx context.Context, slug string) *WorkspaceCoreMockGetBySlugExpectation {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("WorkspaceCoreMock.GetBySlug mock is already set by Set")
+	}
+
+	expectation := &WorkspaceCoreMockGetBySlugExpectation{
+		mock:               mmGetBySlug.mock,
+		params:             &WorkspaceCoreMockGetBySlugParams{ctx, slug},
+		expectationOrigins: WorkspaceCoreMockGetBySlugExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBySlug.expectations = append(mmGetBySlug.expectations, expectation)
+	return expectation
+}
+
+// Then sets up workspaceCore.GetBySlug return parameters for the expectation previously defined by the When method
+func (e *WorkspaceCoreMockGetBySlugExpectation) Then(w1 workspace.Workspace, err error) *WorkspaceCoreMock {
+	e.results = &WorkspaceCoreMockGetBySlugResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times workspaceCore.GetBySlug should be invoked
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Times(n uint64) *mWorkspaceCoreMockGetBySlug {
+	if n == 0 {
+		mmGetBySlug.mock.t.Fatalf("Times of WorkspaceCoreMock.GetBySlug mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBySlug.expectedInvocations, n)
+	mmGetBySlug.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug
+}
+
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) invocationsDone() bool {
+	if len(mmGetBySlug.expectations) == 0 && mmGetBySlug.defaultExpectation == nil && mmGetBySlug.mock.funcGetBySlug == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBySlug.mock.afterGetBySlugCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBySlug.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBySlug implements mm_main.workspaceCore
+func (mmGetBySlug *WorkspaceCoreMock) GetBySlug(ctx context.Context, slug string) (w1 workspace.Workspace, err error) {
+	mm_atomic.AddUint64(&mmGetBySlug.beforeGetBySlugCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBySlug.afterGetBySlugCounter, 1)
+
+	mmGetBySlug.t.Helper()
+
+	if mmGetBySlug.inspectFuncGetBySlug != nil {
+		mmGetBySlug.inspectFuncGetBySlug(ctx, slug)
+	}
+
+	mm_params := WorkspaceCoreMockGetBySlugParams{ctx, slug}
+
+	// Record call args
+	mmGetBySlug.GetBySlugMock.mutex.Lock()
+	mmGetBySlug.GetBySlugMock.callArgs = append(mmGetBySlug.GetBySlugMock.callArgs, &mm_params)
+	mmGetBySlug.GetBySlugMock.mutex.Unlock()
+
+	for _, e := range mmGetBySlug.GetBySlugMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmGetBySlug.GetBySlugMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBySlug.GetBySlugMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBySlug.GetBySlugMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBySlug.GetBySlugMock.defaultExpectation.paramPtrs
+
+		mm_got := WorkspaceCoreMockGetBySlugParams{ctx, slug}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBySlug.t.Errorf("WorkspaceCoreMock.GetBySlug got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmGetBySlug.t.Errorf("WorkspaceCoreMock.GetBySlug got unexpected parameter slug, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.originSlug, *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBySlug.t.Errorf("WorkspaceCoreMock.GetBySlug got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBySlug.GetBySlugMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBySlug.t.Fatal("No results are set for the WorkspaceCoreMock.GetBySlug")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmGetBySlug.funcGetBySlug != nil {
+		return mmGetBySlug.funcGetBySlug(ctx, slug)
+	}
+	mmGetBySlug.t.Fatalf("Unexpected call to WorkspaceCoreMock.GetBySlug. %v %v", ctx, slug)
+	return
+}
+
+// GetBySlugAfterCounter returns a count of finished WorkspaceCoreMock.GetBySlug invocations
+func (mmGetBySlug *WorkspaceCoreMock) GetBySlugAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.afterGetBySlugCounter)
+}
+
+// GetBySlugBeforeCounter returns a count of WorkspaceCoreMock.GetBySlug invocations
+func (mmGetBySlug *WorkspaceCoreMock) GetBySlugBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.beforeGetBySlugCounter)
+}
+
+// Calls returns a list of arguments used in each call to WorkspaceCoreMock.GetBySlug.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBySlug *mWorkspaceCoreMockGetBySlug) Calls() []*WorkspaceCoreMockGetBySlugParams {
+	mmGetBySlug.mutex.RLock()
+
+	argCopy := make([]*WorkspaceCoreMockGetBySlugParams, len(mmGetBySlug.callArgs))
+	copy(argCopy, mmGetBySlug.callArgs)
+
+	mmGetBySlug.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBySlugDone returns true if the count of the GetBySlug invocations corresponds
+// the number of defined expectations
+func (m *WorkspaceCoreMock) MinimockGetBySlugDone() bool {
+	if m.GetBySlugMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBySlugMock.invocationsDone()
+}
+
+// MinimockGetBySlugInspect logs each unmet expectation
+func (m *WorkspaceCoreMock) MinimockGetBySlugInspect() {
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WorkspaceCoreMock.GetBySlug at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBySlugCounter := mm_atomic.LoadUint64(&m.afterGetBySlugCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySlugMock.defaultExpectation != nil && afterGetBySlugCounter < 1 {
+		if m.GetBySlugMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WorkspaceCoreMock.GetBySlug at\n%s", m.GetBySlugMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WorkspaceCoreMock.GetBySlug at\n%s with params: %#v", m.GetBySlugMock.defaultExpectation.expectationOrigins.origin, *m.GetBySlugMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySlug != nil && afterGetBySlugCounter < 1 {
+		m.t.Errorf("Expected call to WorkspaceCoreMock.GetBySlug at\n%s", m.funcGetBySlugOrigin)
+	}
+
+	if !m.GetBySlugMock.invocationsDone() && afterGetBySlugCounter > 0 {
+		m.t.Errorf("Expected %d calls to WorkspaceCoreMock.GetBySlug at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBySlugMock.expectedInvocations), m.GetBySlugMock.expectedInvocationsOrigin, afterGetBySlugCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WorkspaceCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetBySlugInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WorkspaceCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WorkspaceCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetBySlugDone()
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/spf13/cobra"
+)
+
+func newRoleCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Grant and revoke user roles",
+	}
+	cmd.AddCommand(
+		newRoleChangeCmd(build, "grant", "Grant a role to a user", func(ctx context.Context, a *app, role auth.UserRole) error {
+			return a.auth.AddUserRole(ctx, role)
+		}),
+		newRoleChangeCmd(build, "revoke", "Revoke a role from a user", func(ctx context.Context, a *app, role auth.UserRole) error {
+			return a.auth.DeleteUserRole(ctx, role)
+		}),
+		newRoleRepairCmd(build),
+	)
+
+	return cmd
+}
+
+func newRoleChangeCmd(build appBuilder, use, short string, apply func(ctx context.Context, a *app, role auth.UserRole) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			userID, err := parseUUIDFlag(cmd, "user")
+			if err != nil {
+				return err
+			}
+			roleName, _ := cmd.Flags().GetString("role")
+			userRole := auth.UserRole{UserID: userID, Role: auth.Role(roleName)}
+			if cmd.Flags().Changed("entity") {
+				entityID, err := parseUUIDFlag(cmd, "entity")
+				if err != nil {
+					return err
+				}
+				userRole.EntityID = &entityID
+			}
+			if err = userRole.Role.Validate(); err != nil {
+				return err
+			}
+			if err = userRole.Role.ValidateEntity(userRole.EntityID); err != nil {
+				return err
+			}
+
+			if err = apply(ctx, a, userRole); err != nil {
+				return err
+			}
+
+			entity := "global"
+			if userRole.EntityID != nil {
+				entity = userRole.EntityID.String()
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s: role %s (%s) for user %s\n", use, userRole.Role, entity, userID)
+			return nil
+		}),
+	}
+	cmd.Flags().String("user", "", "user ID")
+	cmd.Flags().String("role", "", "role: admin, write or read")
+	cmd.Flags().String("entity", "", "entity ID (required for write/read, forbidden for admin)")
+	_ = cmd.MarkFlagRequired("user")
+	_ = cmd.MarkFlagRequired("role")
+
+	return cmd
+}
+
+func newRoleRepairCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Remove grants of deleted users, on deleted or unreachable entities, and repeated grants",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			report, err := a.auth.RepairGrants(ctx, dryRun)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if len(report.OrphanedGrants) > 0 {
+				w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+				_, _ = fmt.Fprintln(w, "USER\tROLE\tENTITY\tREASON")
+				for _, g := range report.OrphanedGrants {
+					entity := "global"
+					if g.EntityID != nil {
+						entity = g.EntityID.String()
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", g.UserID, g.Role, entity, g.Reason)
+				}
+				_ = w.Flush()
+			}
+			if report.Repaired {
+				_, _ = fmt.Fprintf(out, "%d grants removed\n", len(report.OrphanedGrants))
+			} else {
+				_, _ = fmt.Fprintf(out, "%d grants would be removed\n", len(report.OrphanedGrants))
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().Bool("dry-run", false, "only list the grants that would be removed")
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newSessionCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "Manage user sessions",
+	}
+	cmd.AddCommand(newSessionRevokeCmd(build))
+
+	return cmd
+}
+
+func newSessionRevokeCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke one session (--session) or all sessions of a user",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			userID, err := parseUUIDFlag(cmd, "user")
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("session") {
+				if err = a.auth.DeleteSessionsByUserID(ctx, userID); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "all sessions of user %s revoked\n", userID)
+				return nil
+			}
+
+			sessionID, err := parseUUIDFlag(cmd, "session")
+			if err != nil {
+				return err
+			}
+			if err = a.auth.DeleteSession(ctx, sessionID, userID); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "session %s revoked\n", sessionID)
+			return nil
+		}),
+	}
+	cmd.Flags().String("user", "", "user ID")
+	cmd.Flags().String("session", "", "session ID (all sessions of the user when omitted)")
+	_ = cmd.MarkFlagRequired("user")
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func newUserCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+	cmd.AddCommand(newUserListCmd(build), newUserCreateCmd(build), newUserDisableCmd(build))
+
+	return cmd
+}
+
+func newUserListCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+			page, err := a.user.GetAllUsers(ctx, user.ListUsersOptions{IncludeDeleted: includeDeleted})
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "ID\tEMAIL\tNAME\tCREATED\tDELETED")
+			for _, u := range page.Users {
+				deleted := "-"
+				if u.DeletedAt != nil {
+					deleted = u.DeletedAt.Format(time.RFC3339)
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", u.ID, u.Email, u.Name, u.CreatedAt.Format(time.RFC3339), deleted)
+			}
+			return w.Flush()
+		}),
+	}
+	cmd.Flags().Bool("include-deleted", false, "also list disabled (soft-deleted) users")
+
+	return cmd
+}
+
+func newUserCreateCmd(build appBuilder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user; the password is read from stdin unless --password is set",
+		Args:  cobra.NoArgs,
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, _ []string) error {
+			email, _ := cmd.Flags().GetString("email")
+			name, _ := cmd.Flags().GetString("name")
+			password, _ := cmd.Flags().GetString("password")
+			if password == "" {
+				line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if err != nil && line == "" {
+					return fmt.Errorf("read password from stdin: %w", err)
+				}
+				password = strings.TrimRight(line, "\r\n")
+			}
+
+			id, err := a.user.CreateUser(ctx, user.CreateUserReq{
+				Email:    email,
+				Name:     name,
+				Password: []byte(password),
+			})
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), id)
+			return nil
+		}),
+	}
+	cmd.Flags().String("email", "", "user email")
+	cmd.Flags().String("name", "", "user name")
+	cmd.Flags().String("password", "", "user password (visible in the process list, prefer stdin)")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newUserDisableCmd(build appBuilder) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable <user-id>",
+		Short: "Disable a user (soft delete) and revoke all of their sessions",
+		Args:  cobra.ExactArgs(1),
+		RunE: withApp(build, func(ctx context.Context, cmd *cobra.Command, a *app, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid user ID %q", args[0])
+			}
+			if err = a.user.DeleteUser(ctx, id); err != nil {
+				return err
+			}
+			if err = a.auth.DeleteSessionsByUserID(ctx, id); err != nil {
+				return fmt.Errorf("user disabled, but revoking sessions failed: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "user %s disabled\n", id)
+			return nil
+		}),
+	}
+}
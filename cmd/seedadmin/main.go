@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"flag"
 	"os"
 	"time"
 
@@ -12,6 +13,10 @@ import (
 	authrepo "github.com/66gu1/easygodocs/internal/app/auth/repo/gorm"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	userrepo "github.com/66gu1/easygodocs/internal/app/user/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	workspacerepo "github.com/66gu1/easygodocs/internal/app/workspace/repo/gorm"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
 	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/66gu1/easygodocs/internal/infrastructure/system"
 	"github.com/google/uuid"
@@ -24,22 +29,43 @@ import (
 )
 
 func main() {
+	configPath := flag.String("config", "", "path to the config file (YAML or TOML)")
+	workspaceSlug := flag.String("workspace", "", "slug of the workspace to seed the admin in (default workspace if empty)")
+	flag.Parse()
+
 	err := godotenv.Overload(".env")
 	if err != nil {
 		log.Debug().Err(err).Msg("failed to load .env.local file, using environment variables")
 	}
-	dsn := os.Getenv("DATABASE_DSN")
 	email := os.Getenv("ADMIN_EMAIL")
 	pass := os.Getenv("ADMIN_PASSWORD")
 
-	if dsn == "" || email == "" || pass == "" {
-		panic("DATABASE_DSN, ADMIN_EMAIL and ADMIN_PASSWORD environment variables are required")
+	if email == "" || pass == "" {
+		panic("ADMIN_EMAIL and ADMIN_PASSWORD environment variables are required")
 	}
 
-	_ = config.LoadConfig()
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		panic(err)
+	}
+	if err = cfg.RequireSecrets(true, false); err != nil {
+		panic(err)
+	}
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	secretStore, err := cfg.SecretStore(&system.TimeGenerator{})
+	if err != nil {
+		panic(err)
+	}
+	dsn, err := cfg.ResolveDSN(ctx, secretStore)
+	if err != nil {
+		panic(err)
+	}
+	// the admin password is hashed with the pepper the server checks it with
+	passwordHasher := secure.NewPepperedPasswordHasher(secretStore.Key(secrets.PasswordPepper))
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
@@ -49,19 +75,20 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	if *workspaceSlug != "" {
+		ctx = withWorkspace(ctx, db, *workspaceSlug)
+	}
 
 	authRepo, err := authrepo.NewRepository(db)
 	if err != nil {
 		panic(err)
 	}
 	// we don't need jwt secret here or config, because we just assign role
-	authCore, err := auth.NewCore(authRepo, secure.NewTokenCodec(ephemeralKey()), &system.UUIDv7Generator{}, &system.RNDGenerator{}, &system.TimeGenerator{}, secure.NewPasswordHasher(), auth.Config{SessionTTLMinutes: 1, AccessTokenTTLMinutes: 1})
+	authCore, err := auth.NewCore(authRepo, secure.NewTokenCodec(ephemeralKey()), &system.UUIDv7Generator{}, &system.RNDGenerator{}, &system.TimeGenerator{}, passwordHasher, auth.Config{SessionTTLMinutes: 1, RememberMeSessionTTLMinutes: 1, AccessTokenTTLMinutes: 1})
 	if err != nil {
 		panic(err)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	id := createUser(ctx, db, email, pass)
+	id := createUser(ctx, db, cfg, passwordHasher, email, pass)
 	err = authCore.AddUserRole(ctx, auth.UserRole{
 		UserID: id,
 		Role:   auth.RoleAdmin,
@@ -77,17 +104,16 @@ func main() {
 	}
 }
 
-func createUser(ctx context.Context, db *gorm.DB, email, pass string) uuid.UUID {
+func createUser(ctx context.Context, db *gorm.DB, cfg config.Config, passwordHasher *secure.PasswordHasher, email, pass string) uuid.UUID {
 	userRepo, err := userrepo.NewRepository(db)
 	if err != nil {
 		panic(err)
 	}
-	cfg, vCFG := config.GetUserConfigs()
-	validator, err := user.NewValidator(vCFG)
+	validator, err := user.NewValidator(cfg.User.ValidationConfig)
 	if err != nil {
 		panic(err)
 	}
-	core, err := user.NewCore(userRepo, &system.UUIDv7Generator{}, secure.NewPasswordHasher(), validator, cfg)
+	core, err := user.NewCore(userRepo, &system.UUIDv7Generator{}, passwordHasher, validator, cfg.User.Config)
 	if err != nil {
 		panic(err)
 	}
@@ -115,6 +141,24 @@ func createUser(ctx context.Context, db *gorm.DB, email, pass string) uuid.UUID
 	return id
 }
 
+// withWorkspace makes the admin an admin of the workspace with the slug only.
+func withWorkspace(ctx context.Context, db *gorm.DB, slug string) context.Context {
+	workspaceRepo, err := workspacerepo.NewRepository(db)
+	if err != nil {
+		panic(err)
+	}
+	core, err := workspace.NewCore(workspaceRepo, &system.UUIDv7Generator{})
+	if err != nil {
+		panic(err)
+	}
+	ws, err := core.GetBySlug(ctx, slug)
+	if err != nil {
+		panic(err)
+	}
+
+	return contextx.SetWorkspaceID(ctx, ws.ID)
+}
+
 func ephemeralKey() []byte {
 	b := make([]byte, 32)
 	_, err := rand.Read(b)
@@ -12,28 +12,100 @@
 package main
 
 import (
+	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
+	_ "time/tzdata" // profile timezone validation must not depend on the host's zoneinfo
 
 	"github.com/66gu1/easygodocs/config"
 	"github.com/66gu1/easygodocs/docs"
+	adminhttp "github.com/66gu1/easygodocs/internal/app/admin/transport/http"
+	adminusecase "github.com/66gu1/easygodocs/internal/app/admin/usecase"
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	attachmentrepo "github.com/66gu1/easygodocs/internal/app/attachment/repo/gorm"
+	attachmenthttp "github.com/66gu1/easygodocs/internal/app/attachment/transport/http"
+	attachmentusecase "github.com/66gu1/easygodocs/internal/app/attachment/usecase"
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	authrepo "github.com/66gu1/easygodocs/internal/app/auth/repo/gorm"
 	authhttp "github.com/66gu1/easygodocs/internal/app/auth/transport/http"
 	authusecase "github.com/66gu1/easygodocs/internal/app/auth/usecase"
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	backuprepo "github.com/66gu1/easygodocs/internal/app/backup/repo/gorm"
+	backuphttp "github.com/66gu1/easygodocs/internal/app/backup/transport/http"
+	backupusecase "github.com/66gu1/easygodocs/internal/app/backup/usecase"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	dataexportrepo "github.com/66gu1/easygodocs/internal/app/dataexport/repo/gorm"
+	dataexporthttp "github.com/66gu1/easygodocs/internal/app/dataexport/transport/http"
+	dataexportusecase "github.com/66gu1/easygodocs/internal/app/dataexport/usecase"
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	entityrepo "github.com/66gu1/easygodocs/internal/app/entity/repo/gorm"
 	entityhttp "github.com/66gu1/easygodocs/internal/app/entity/transport/http"
 	entityusecase "github.com/66gu1/easygodocs/internal/app/entity/usecase"
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	featurerepo "github.com/66gu1/easygodocs/internal/app/feature/repo/gorm"
+	featurehttp "github.com/66gu1/easygodocs/internal/app/feature/transport/http"
+	featureusecase "github.com/66gu1/easygodocs/internal/app/feature/usecase"
+	gitsyncusecase "github.com/66gu1/easygodocs/internal/app/gitsync/usecase"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	invitationrepo "github.com/66gu1/easygodocs/internal/app/invitation/repo/gorm"
+	invitationhttp "github.com/66gu1/easygodocs/internal/app/invitation/transport/http"
+	invitationusecase "github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	presencehttp "github.com/66gu1/easygodocs/internal/app/presence/transport/http"
+	presenceusecase "github.com/66gu1/easygodocs/internal/app/presence/usecase"
+	"github.com/66gu1/easygodocs/internal/app/public"
+	publicrepo "github.com/66gu1/easygodocs/internal/app/public/repo/gorm"
+	publichttp "github.com/66gu1/easygodocs/internal/app/public/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	quarantinerepo "github.com/66gu1/easygodocs/internal/app/quarantine/repo/gorm"
+	quarantinehttp "github.com/66gu1/easygodocs/internal/app/quarantine/transport/http"
+	quarantineusecase "github.com/66gu1/easygodocs/internal/app/quarantine/usecase"
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	statsrepo "github.com/66gu1/easygodocs/internal/app/stats/repo/gorm"
+	statshttp "github.com/66gu1/easygodocs/internal/app/stats/transport/http"
+	statsusecase "github.com/66gu1/easygodocs/internal/app/stats/usecase"
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	termsrepo "github.com/66gu1/easygodocs/internal/app/terms/repo/gorm"
+	termshttp "github.com/66gu1/easygodocs/internal/app/terms/transport/http"
+	termsusecase "github.com/66gu1/easygodocs/internal/app/terms/usecase"
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	usagerepo "github.com/66gu1/easygodocs/internal/app/usage/repo/gorm"
+	usagehttp "github.com/66gu1/easygodocs/internal/app/usage/transport/http"
+	usageusecase "github.com/66gu1/easygodocs/internal/app/usage/usecase"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	userrepo "github.com/66gu1/easygodocs/internal/app/user/repo/gorm"
 	userhttp "github.com/66gu1/easygodocs/internal/app/user/transport/http"
 	userusecase "github.com/66gu1/easygodocs/internal/app/user/usecase"
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	workspacerepo "github.com/66gu1/easygodocs/internal/app/workspace/repo/gorm"
+	workspacehttp "github.com/66gu1/easygodocs/internal/app/workspace/transport/http"
+	workspaceusecase "github.com/66gu1/easygodocs/internal/app/workspace/usecase"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/buildinfo"
+	"github.com/66gu1/easygodocs/internal/infrastructure/captcha"
+	appdb "github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/errreport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/gitrepo"
 	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/idempotency"
+	"github.com/66gu1/easygodocs/internal/infrastructure/jobs"
+	"github.com/66gu1/easygodocs/internal/infrastructure/ldap"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	applogger "github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/66gu1/easygodocs/internal/infrastructure/pdf"
+	"github.com/66gu1/easygodocs/internal/infrastructure/s3"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
 	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/66gu1/easygodocs/internal/infrastructure/settings"
 	"github.com/66gu1/easygodocs/internal/infrastructure/system"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -47,10 +119,10 @@ import (
 )
 
 func main() {
-	cfg := config.LoadConfig()
+	configPath := flag.String("config", "", "path to the config file (YAML or TOML)")
+	flag.Parse()
 
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	zerolog.SetGlobalLevel(cfg.LogLevel.ZeroLog())
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
 	err := godotenv.Overload(".env")
@@ -58,10 +130,41 @@ func main() {
 		log.Debug().Err(err).Msg("failed to load .env.local file, using environment variables")
 	}
 
-	dsn, ok := os.LookupEnv("DATABASE_DSN")
-	if !ok {
-		panic("DATABASE_DSN environment variable is required")
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load config")
+	}
+	if err = cfg.RequireSecrets(true, true); err != nil {
+		log.Fatal().Err(err).Msg("missing secrets")
+	}
+	zerolog.SetGlobalLevel(cfg.LogLevel.ZeroLog())
+	build := buildinfo.Get(cfg.Profile)
+	buildinfo.Publish(build)
+
+	timeGen := &system.TimeGenerator{}
+	reporter, err := errreport.New(cfg.ErrorReport, &http.Client{Timeout: time.Duration(cfg.ErrorReport.TimeoutSeconds) * time.Second}, timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create error reporter")
+	}
+	applogger.SetReporter(reporter)
+	secretStore, err := cfg.SecretStore(timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create secret store")
+	}
+	startCtx, cancelStart := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelStart()
+	jwtSecret, err := secretStore.Get(startCtx, secrets.JWTSecret)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to fetch jwt_secret")
 	}
+	if jwtSecret == "" {
+		log.Fatal().Msgf("missing secrets: jwt_secret is not set in the %s provider", cfg.Secrets.Provider)
+	}
+	dsn, err := cfg.ResolveDSN(startCtx, secretStore)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to resolve database DSN")
+	}
+
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
@@ -71,133 +174,760 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to get database pool")
+	}
+	appdb.ConfigurePool(sqlDB, cfg.DatabasePool)
+	appdb.PublishPoolStats(sqlDB)
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	jwtCodec := secure.NewTokenCodec([]byte(jwtSecret))
+	txManager, err := appdb.NewTxManager(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create transaction manager")
+	}
+
+	jwtCodec := secure.NewRotatingTokenCodec(secretStore.Key(secrets.JWTSecret)).
+		WithIssuerAudience(cfg.Auth.Issuer, cfg.Auth.Audience, cfg.Auth.AcceptLegacyTokens).
+		WithLeeway(time.Duration(cfg.Auth.LeewaySeconds) * time.Second)
 
 	idGen := &system.UUIDv7Generator{}
-	timeGen := &system.TimeGenerator{}
 	rndGen := &system.RNDGenerator{}
-	passwordHasher := secure.NewPasswordHasher()
+	passwordHasher := secure.NewPepperedPasswordHasher(secretStore.Key(secrets.PasswordPepper))
+	contentCipher := secure.NewContentCipher(secretStore.Key(secrets.ContentKey), secretStore.Key(secrets.ContentKeyPrevious))
+	if cfg.Entity.Encryption.Enabled {
+		if _, err = contentCipher.KeyID(); err != nil {
+			log.Fatal().Err(err).Msg("entity.encryption is enabled but content_key is not available")
+		}
+	}
 
-	userCfg, userValidationCfg := config.GetUserConfigs()
 	userRepo, err := userrepo.NewRepository(db)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create user repository")
 	}
-	userValidator, err := user.NewValidator(userValidationCfg)
+	userValidator, err := user.NewValidator(cfg.User.ValidationConfig)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create user validator")
 	}
-	userCore, err := user.NewCore(userRepo, idGen, passwordHasher, userValidator, userCfg)
+	userCore, err := user.NewCore(userRepo, idGen, passwordHasher, userValidator, cfg.User.Config)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create user core")
 	}
+	blobStore, err := blob.NewLocalStore(cfg.Blob)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create blob store")
+	}
+	avatarCore, err := user.NewAvatarCore(userRepo, blobStore, userValidator, timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create avatar core")
+	}
+	dataExportRepo, err := dataexportrepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create data export repository")
+	}
+	dataExportCore, err := dataexport.NewCore(dataExportRepo, blobStore,
+		dataexport.Generators{ID: idGen, Time: timeGen}, cfg.DataExport)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create data export core")
+	}
+	scanner, err := scan.New(cfg.Scan)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create upload scanner")
+	}
+	quarantineRepo, err := quarantinerepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create quarantine repository")
+	}
+	quarantineCore, err := quarantine.NewCore(quarantineRepo, blobStore, scanner, idGen, timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create quarantine core")
+	}
+	attachmentRepo, err := attachmentrepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create attachment repository")
+	}
+	attachmentCore, err := attachment.NewCore(attachmentRepo, blobStore, timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create attachment core")
+	}
 
-	authCfg := config.GetAuthConfigs()
 	authRepo, err := authrepo.NewRepository(db)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create auth repository")
 	}
-	authCore, err := auth.NewCore(authRepo, jwtCodec, idGen, rndGen, timeGen, passwordHasher, authCfg)
+	authCore, err := auth.NewCore(authRepo, jwtCodec, idGen, rndGen, timeGen, passwordHasher, cfg.Auth)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create auth core")
 	}
 
-	entityCfg, entityValidationCfg := config.GetEntityConfigs()
-	entityRepo, err := entityrepo.NewRepository(db)
+	sanitizer, err := sanitize.New(cfg.Sanitize)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create sanitize policy")
+	}
+
+	entityRepo, err := entityrepo.NewRepository(db, cfg.Entity.Config, contentCipher)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create entity repository")
 	}
-	entityValidator, err := entity.NewValidator(entityValidationCfg)
+	entityValidator, err := entity.NewValidator(cfg.Entity.ValidationConfig)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create entity validator")
 	}
 	entityCore, err := entity.NewCore(entityRepo, entity.Generators{
 		ID:   idGen,
 		Time: timeGen,
-	}, entityValidator, entityCfg)
+	}, entityValidator, cfg.Entity.Config)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create entity core")
 	}
 
-	userService := userusecase.NewService(userCore, authCore, passwordHasher)
+	userService := userusecase.NewService(userCore, avatarCore, quarantineCore, authCore, dataExportCore, passwordHasher, txManager, cfg.User.Registration)
 	userHandler := userhttp.NewHandler(userService)
 
-	authService := authusecase.NewService(authCore, userCore, passwordHasher)
+	directory, err := ldap.New(cfg.LDAP)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create LDAP directory")
+	}
+	authService := authusecase.NewService(authCore, userCore, passwordHasher, directory, cfg.LDAP.LocalFallback, cfg.LDAP.LinkExistingUsers)
 	authHandler := authhttp.NewHandler(authService)
 
-	entityService := entityusecase.NewService(entityCore, entityusecase.NewPermissionChecker(entityCore, authCore))
-	entityHandler := entityhttp.NewHandler(entityService)
+	mailSender, err := mail.New(cfg.Mail)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create mail sender")
+	}
+	entityPermissionChecker := entityusecase.NewPermissionChecker(entityCore, authCore)
+	entityService := entityusecase.NewService(entityCore, entityPermissionChecker, sanitizer, authCore, mailSender, txManager, attachmentCore)
+	if cfg.Lint.Enabled() {
+		linter, err := lint.New(cfg.Lint, &http.Client{Timeout: time.Duration(cfg.Lint.TimeoutSeconds) * time.Second})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create content linter")
+		}
+		entityService.WithLinter(linter)
+	}
+	entityHandler := entityhttp.NewHandler(entityService, sanitizer)
+	if cfg.PDF.Enabled() {
+		pdfConverter, err := pdf.NewConverter(cfg.PDF)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create PDF converter")
+		}
+		entityHandler.WithPDFConverter(pdfConverter)
+	}
+
+	presenceHub, err := presence.NewHub(cfg.Presence, timeGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create presence hub")
+	}
+	presenceService := presenceusecase.NewService(presenceHub, entityPermissionChecker)
+	presenceHandler := presencehttp.NewHandler(presenceService, cfg.Presence)
+
+	// writes that stay open during maintenance: signing in and out, and switching maintenance off again
+	maintenance := httpx.NewMaintenance(cfg.Maintenance,
+		"/api/v1/login", "/api/v1/refresh", "/api/v1/logout", "/api/v1/settings", "/api/v1/admin/maintenance",
+		// a read sent as POST for the size of its body
+		"/api/v1/entities/batch-get")
+
+	settingsRegistry := settings.NewRegistry(cfg.Runtime())
+	settingsRegistry.Subscribe(func(s config.RuntimeSettings) {
+		zerolog.SetGlobalLevel(s.LogLevel.ZeroLog())
+	})
+	settingsRegistry.Subscribe(func(s config.RuntimeSettings) {
+		if err := userValidator.Reload(s.UserValidation); err != nil {
+			log.Error().Err(err).Msg("failed to reload user validation limits")
+		}
+		if err := entityValidator.Reload(s.EntityValidation); err != nil {
+			log.Error().Err(err).Msg("failed to reload entity validation limits")
+		}
+		if err := presenceHub.SetMaxMessagesPerSecond(s.PresenceMaxMessagesPerSecond); err != nil {
+			log.Error().Err(err).Msg("failed to reload presence rate limit")
+		}
+		if s.Maintenance.Enabled != maintenance.State().Enabled {
+			log.Warn().Bool("enabled", s.Maintenance.Enabled).Msg("maintenance mode switched")
+		}
+		maintenance.Set(s.Maintenance)
+	})
+	go reloadOnSIGHUP(*configPath, settingsRegistry, secretStore)
+
+	adminService := adminusecase.NewService(cfg, settingsRegistry)
+	adminHandler := adminhttp.NewHandler(adminService)
+
+	var (
+		backupService *backupusecase.Service
+		backupHandler *backuphttp.Handler
+	)
+	if cfg.Backup.Enabled() {
+		backupRepo, err := backuprepo.NewRepository(db)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create backup repository")
+		}
+		backupCore, err := backup.NewCore(backupRepo, timeGen, cfg.Backup)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create backup core")
+		}
+		backupStore, err := s3.NewStore(cfg.Backup.S3, &http.Client{})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create backup store")
+		}
+		backupService = backupusecase.NewService(backupCore, backupStore, timeGen, cfg.Backup)
+		backupHandler = backuphttp.NewHandler(backupService)
+	}
+
+	workspaceRepo, err := workspacerepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create workspace repository")
+	}
+	workspaceCore, err := workspace.NewCore(workspaceRepo, idGen)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create workspace core")
+	}
+	workspaceService := workspaceusecase.NewService(workspaceCore)
+	workspaceHandler := workspacehttp.NewHandler(workspaceService)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	usageRepo, err := usagerepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create usage repository")
+	}
+	usageCore, err := usage.NewCore(usageRepo, timeGen, cfg.Usage)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create usage core")
+	}
+	usageFlushed := make(chan struct{})
+	go func() {
+		defer close(usageFlushed)
+		usageCore.Run(ctx, func(err error) {
+			log.Error().Err(err).Msg("failed to flush usage")
+		})
+	}()
+	usageService := usageusecase.NewService(usageCore)
+	usageHandler := usagehttp.NewHandler(usageService)
+
+	quarantineService := quarantineusecase.NewService(quarantineCore)
+	quarantineHandler := quarantinehttp.NewHandler(quarantineService)
+
+	attachmentService := attachmentusecase.NewService(attachmentCore, entityPermissionChecker, authCore)
+	attachmentHandler := attachmenthttp.NewHandler(attachmentService)
+
+	invitationRepo, err := invitationrepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create invitation repository")
+	}
+	invitationCore, err := invitation.NewCore(invitationRepo,
+		invitation.Generators{ID: idGen, RND: rndGen, Time: timeGen}, userValidator, cfg.Invitation)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create invitation core")
+	}
+	invitationService := invitationusecase.NewService(invitationCore, userCore, authCore, mailSender, txManager)
+	invitationHandler := invitationhttp.NewHandler(invitationService)
+
+	captchaVerifier, err := captcha.New(cfg.Captcha, &http.Client{Timeout: time.Duration(cfg.Captcha.TimeoutSeconds) * time.Second})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create CAPTCHA verifier")
+	}
+	captchaGuard := captcha.NewGuard(captchaVerifier, cfg.Captcha)
+
+	termsRepo, err := termsrepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create terms repository")
+	}
+	termsCore, err := terms.NewCore(termsRepo, timeGen, cfg.Terms)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create terms core")
+	}
+	termsService := termsusecase.NewService(termsCore)
+	termsHandler := termshttp.NewHandler(termsService)
+
+	featureRepo, err := featurerepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create feature repository")
+	}
+	featureCore, err := feature.NewCore(featureRepo, timeGen, cfg.Feature)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create feature core")
+	}
+	featureService := featureusecase.NewService(featureCore)
+	featureHandler := featurehttp.NewHandler(featureService)
+
+	statsRepo, err := statsrepo.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create stats repository")
+	}
+	statsCore, err := stats.NewCore(statsRepo, timeGen, cfg.Stats)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create stats core")
+	}
+	statsService := statsusecase.NewService(statsCore)
+	statsHandler := statshttp.NewHandler(statsService)
+
+	publicRepo, err := publicrepo.NewRepository(db, contentCipher)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create public repository")
+	}
+	publicCore, err := public.NewCore(publicRepo, timeGen, sanitizer, cfg.Public)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create public core")
+	}
+	publicHandler := publichttp.NewHandler(publicCore, cfg.Public)
+
+	dataExportService := dataexportusecase.NewService(dataExportCore, authCore, userCore, entityCore)
+	dataExportHandler := dataexporthttp.NewHandler(dataExportService)
+
+	idempotencyRepo, err := idempotency.NewRepository(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create idempotency repository")
+	}
+	idempotent := httpx.Idempotency(idempotencyRepo, cfg.Idempotency.TTL())
+	adminOnly := authhttp.RequireRole(authCore, auth.RoleAdmin)
+	operatorOnly := authhttp.RequireOperator(authCore)
+
+	jobRunner := jobs.NewRunner()
+	var poolWaits int64
+	err = jobRunner.Add(jobs.Job{
+		Name:     "db_pool_saturation",
+		Interval: cfg.DatabasePool.CheckInterval(),
+		Run: func(context.Context) error {
+			stats := appdb.ReadPoolStats(sqlDB)
+			waited := stats.WaitCount - poolWaits
+			poolWaits = stats.WaitCount
+			if cfg.DatabasePool.Saturated(stats) || waited > 0 {
+				log.Warn().Int("in_use", stats.InUse).Int("max_open", stats.MaxOpen).Int("idle", stats.Idle).
+					Int64("waits", waited).Msg("database pool saturated")
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to schedule database pool check")
+	}
+	// spaces may set a retention policy of their own, so the job runs even when entity.retention keeps everything
+	if retention := cfg.Entity.Retention; retention.IntervalMinutes > 0 {
+		err = jobRunner.Add(jobs.Job{
+			Name:     "entity_version_retention",
+			Interval: time.Duration(retention.IntervalMinutes) * time.Minute,
+			Run: func(ctx context.Context) error {
+				report, err := entityCore.PruneVersions(ctx, false)
+				if err != nil {
+					return err
+				}
+				log.Info().Int("versions", len(report.Versions)).Msg("entity versions pruned")
+				return nil
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule version retention")
+		}
+	}
+	if trash := cfg.Entity.Trash; trash.Enabled() {
+		err = jobRunner.Add(jobs.Job{
+			Name:     "entity_trash_purge",
+			Interval: time.Duration(trash.IntervalMinutes) * time.Minute,
+			Run: func(ctx context.Context) error {
+				report, err := entityCore.PurgeTrash(ctx, nil, false)
+				if err != nil {
+					return err
+				}
+				log.Info().Int("entities", len(report.Entities)).Int64("bytes", report.ReclaimableBytes).
+					Msg("entity trash purged")
+				return nil
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule trash purge")
+		}
+	}
+	if staleDrafts := cfg.Entity.StaleDrafts; staleDrafts.Enabled() {
+		err = jobRunner.Add(jobs.Job{
+			Name:     "entity_stale_drafts_cleanup",
+			Interval: time.Duration(staleDrafts.IntervalMinutes) * time.Minute,
+			Run: func(ctx context.Context) error {
+				report, err := entityService.CleanupStaleDrafts(ctx)
+				if err != nil {
+					return err
+				}
+				log.Info().Int("reminded", len(report.Reminded)).Int("cleaned_up", len(report.CleanedUp)).
+					Msg("stale drafts cleaned up")
+				return nil
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule stale drafts cleanup")
+		}
+	}
+	if encryption := cfg.Entity.Encryption; encryption.Enabled {
+		err = jobRunner.Add(jobs.Job{
+			Name:     "entity_content_reencryption",
+			Interval: time.Duration(encryption.IntervalMinutes) * time.Minute,
+			Run: func(ctx context.Context) error {
+				report, err := entityCore.ReencryptContent(ctx)
+				if report.Encrypted > 0 {
+					log.Info().Int("rows", report.Encrypted).Msg("entity content re-encrypted")
+				}
+				for _, s := range report.Skipped {
+					log.Warn().Str("table", s.Table).Str("entity_id", s.EntityID.String()).Int("version", s.Version).
+						Str("error", s.Error).Msg("entity content could not be decrypted, left as it is")
+				}
+				return err
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule content re-encryption")
+		}
+	}
+	if cfg.Lint.Enabled() {
+		err = jobRunner.Add(jobs.Job{
+			Name:     "entity_lint",
+			Interval: time.Duration(cfg.Lint.IntervalSeconds) * time.Second,
+			Run: func(ctx context.Context) error {
+				n, err := entityService.RunLint(ctx)
+				if n > 0 {
+					log.Info().Int("versions", n).Msg("entity versions linted")
+				}
+				return err
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule content linting")
+		}
+	}
+	err = jobRunner.Add(jobs.Job{
+		Name:     "entity_expired_locks_cleanup",
+		Interval: time.Duration(cfg.Entity.LockTTLMinutes) * time.Minute,
+		Run: func(ctx context.Context) error {
+			_, err := entityCore.DeleteExpiredLocks(ctx)
+			return err
+		},
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to schedule expired locks cleanup")
+	}
+	err = jobRunner.Add(jobs.Job{
+		Name:     "idempotency_keys_cleanup",
+		Interval: cfg.Idempotency.TTL(),
+		Run: func(ctx context.Context) error {
+			_, err := idempotencyRepo.DeleteExpired(ctx)
+			return err
+		},
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to schedule idempotency keys cleanup")
+	}
+	err = jobRunner.Add(jobs.Job{
+		Name:     "user_data_exports",
+		Interval: time.Duration(cfg.DataExport.IntervalSeconds) * time.Second,
+		Run: func(ctx context.Context) error {
+			n, err := dataExportService.Run(ctx)
+			if n > 0 {
+				log.Info().Int("exports", n).Msg("user data exports made")
+			}
+			return err
+		},
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to schedule user data exports")
+	}
+	if cfg.Sync.Enabled() {
+		syncRepo, err := gitrepo.NewRepo(cfg.Sync.Git)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create sync repository")
+		}
+		syncService, err := gitsyncusecase.NewService(entityCore, syncRepo, cfg.Sync)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create sync service")
+		}
+		err = jobRunner.Add(jobs.Job{
+			Name:     "git_sync",
+			Interval: time.Duration(cfg.Sync.IntervalSeconds) * time.Second,
+			Run: func(ctx context.Context) error {
+				report, err := syncService.Sync(ctx)
+				for _, c := range report.Conflicts {
+					log.Warn().Str("path", c.Path).Int("file_version", c.FileVersion).Int("entity_version", c.EntityVersion).
+						Msg("git sync conflict")
+				}
+				for _, f := range report.Failures {
+					log.Warn().Str("path", f.Path).Str("error", f.Error).Msg("git sync failed to apply file")
+				}
+				if err != nil {
+					return err
+				}
+				if report.Commit != "" {
+					log.Info().Str("commit", report.Commit).Int("updated", len(report.Updated)).Int("created", len(report.Created)).
+						Msg("git sync pushed")
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule git sync")
+		}
+	}
+	jobRunner.Start(ctx)
 
 	docs.SwaggerInfo.BasePath = "/api/v1"
 	// --- set up chi router
 	r := chi.NewRouter()
-	r.Use(middleware.RequestID)
+	r.Use(httpx.RequestID)
 	r.Use(middleware.RealIP)
-	r.Use(middleware.Recoverer)
 	r.Use(httpx.Logger)
+	r.Use(httpx.Instance)
+	r.Use(httpx.Language)
+	r.Use(httpx.RequestCache)
+	r.Use(httpx.Recoverer(nil))
+	r.Use(workspacehttp.Middleware(workspaceCore, cfg.Workspace))
 	r.Use(httpx.MaxBodyBytes(cfg.MaxBodySize))
+	r.Use(maintenance.Middleware)
+	r.NotFound(httpx.NotFound)
+	r.MethodNotAllowed(httpx.MethodNotAllowed)
+
+	// public documents for crawlers and feed readers, at the root where they are looked for
+	r.Get("/sitemap.xml", publicHandler.GetSitemap) // GET /sitemap.xml
+	r.Get("/feed.xml", publicHandler.GetFeed)       // GET /feed.xml
+	r.Get("/healthz", httpx.GetHealth(maintenance)) // GET /healthz
 
 	r.Route("/api/v1", func(r chi.Router) {
 		// with auth
 		r.Group(func(r chi.Router) {
 			r.Use(authhttp.AuthMiddleware(jwtCodec))
-			// --- user routes
-			r.Route("/users", func(r chi.Router) {
-				r.Get("/", userHandler.GetAllUsers) // GET    /users
-
-				r.Route(fmt.Sprintf("/{%s}", userhttp.URLParamUserID), func(r chi.Router) {
-					r.Get("/", userHandler.GetUser)                 // GET    /users/{user_id}
-					r.Put("/", userHandler.UpdateUser)              // PUT    /users/{user_id}
-					r.Delete("/", userHandler.DeleteUser)           // DELETE /users/{user_id}
-					r.Post("/password", userHandler.ChangePassword) // POST   /users/{user_id}/password
-				})
+			r.Use(usagehttp.Middleware(usageCore))
+			r.Post("/logout", authHandler.Logout) // POST /logout
+
+			// --- terms of service, reachable before they are accepted
+			r.Group(func(r chi.Router) {
+				r.Use(authhttp.RequireScope(auth.ScopeUsersAdmin))
+				r.Get("/users/me/terms", termsHandler.GetMyStatus)    // GET  /users/me/terms
+				r.Post("/users/me/accept-terms", termsHandler.Accept) // POST /users/me/accept-terms
 			})
 
-			// --- session routes
-			r.Route("/sessions", func(r chi.Router) {
-				r.Get("/", authHandler.GetSessionsByUserID)       // GET    /sessions?user_id={user_id}
-				r.Delete("/", authHandler.DeleteSessionsByUserID) // DELETE /sessions?user_id={user_id}
+			r.Group(func(r chi.Router) {
+				r.Use(authhttp.RequireScope(auth.ScopeUsersAdmin))
+				r.Use(termshttp.RequireAccepted(termsCore))
+				// --- user routes
+				r.Route("/users", func(r chi.Router) {
+					r.With(adminOnly).Get("/", userHandler.GetAllUsers)      // GET    /users
+					r.With(adminOnly).Post("/", userHandler.AdminCreateUser) // POST   /users
 
-				r.Route(fmt.Sprintf("/{%s}", authhttp.URLParamSessionID), func(r chi.Router) {
-					r.Delete("/", authHandler.DeleteSession) // DELETE /sessions/{session_id}?user_id={user_id}
+					r.Route(fmt.Sprintf("/{%s}", userhttp.URLParamUserID), func(r chi.Router) {
+						r.Get("/", userHandler.GetUser)                                       // GET    /users/{user_id}
+						r.With(idempotent).Put("/", userHandler.UpdateUser)                   // PUT    /users/{user_id}
+						r.With(adminOnly).Delete("/", userHandler.DeleteUser)                 // DELETE /users/{user_id}
+						r.Post("/password", userHandler.ChangePassword)                       // POST   /users/{user_id}/password
+						r.With(idempotent).Patch("/profile", userHandler.UpdateProfile)       // PATCH  /users/{user_id}/profile
+						r.Get("/avatar", userHandler.GetAvatar)                               // GET    /users/{user_id}/avatar
+						r.Put("/avatar", userHandler.UploadAvatar)                            // PUT    /users/{user_id}/avatar
+						r.Delete("/avatar", userHandler.DeleteAvatar)                         // DELETE /users/{user_id}/avatar
+						r.Get("/preferences", userHandler.GetPreferences)                     // GET    /users/{user_id}/preferences
+						r.With(idempotent).Put("/preferences", userHandler.UpdatePreferences) // PUT    /users/{user_id}/preferences
+						r.Get("/login-history", authHandler.GetLoginHistory)                  // GET    /users/{user_id}/login-history?limit={limit}
+						r.Get("/export", dataExportHandler.Request)                           // GET    /users/{user_id}/export
+						r.Get("/export/download", dataExportHandler.Download)                 // GET    /users/{user_id}/export/download
+					})
+				})
+
+				// --- session routes
+				r.Route("/sessions", func(r chi.Router) {
+					r.Get("/", authHandler.GetSessionsByUserID)       // GET    /sessions?user_id={user_id}
+					r.Delete("/", authHandler.DeleteSessionsByUserID) // DELETE /sessions?user_id={user_id}
+
+					r.Route(fmt.Sprintf("/{%s}", authhttp.URLParamSessionID), func(r chi.Router) {
+						r.Delete("/", authHandler.DeleteSession) // DELETE /sessions/{session_id}?user_id={user_id}
+					})
 				})
-			})
 
-			// --- roles routes
-			r.Route("/roles", func(r chi.Router) {
-				r.Get("/", authHandler.ListUserRoles)     // GET /roles
-				r.Post("/", authHandler.AddUserRole)      // POST /roles
-				r.Delete("/", authHandler.DeleteUserRole) // DELETE /roles
+				// --- roles routes
+				r.Route("/roles", func(r chi.Router) {
+					r.Get("/", authHandler.ListUserRoles)                     // GET /roles
+					r.With(adminOnly).Post("/", authHandler.AddUserRole)      // POST /roles
+					r.With(adminOnly).Delete("/", authHandler.DeleteUserRole) // DELETE /roles
+				})
+
+				r.Get("/features", featureHandler.GetMyFlags) // GET /features
+
+				// --- admin routes
+				r.Group(func(r chi.Router) {
+					r.Use(adminOnly)
+					r.Get("/usage", usageHandler.GetTopConsumers)                                                          // GET /usage?hours={hours}&limit={limit}
+					r.Get("/admin/stats", statsHandler.GetStats)                                                           // GET /admin/stats
+					r.Get("/admin/consistency", authHandler.GetConsistencyReport)                                          // GET /admin/consistency
+					r.Post("/admin/consistency/repair", authHandler.RepairGrants)                                          // POST /admin/consistency/repair?dry_run={dry_run}
+					r.Get("/admin/terms", termsHandler.List)                                                               // GET /admin/terms
+					r.Post(fmt.Sprintf("/admin/impersonate/{%s}", userhttp.URLParamUserID), authHandler.Impersonate)       // POST /admin/impersonate/{user_id}
+					r.Post(fmt.Sprintf("/admin/users/{%s}/anonymize", userhttp.URLParamUserID), userHandler.AnonymizeUser) // POST /admin/users/{user_id}/anonymize
+					r.Route("/admin/quarantine", func(r chi.Router) {
+						r.Get("/", quarantineHandler.List)                                                        // GET    /admin/quarantine
+						r.Delete(fmt.Sprintf("/{%s}", quarantinehttp.URLParamUploadID), quarantineHandler.Delete) // DELETE /admin/quarantine/{upload_id}
+					})
+					r.Route("/invitations", func(r chi.Router) {
+						r.Get("/", invitationHandler.List)                                                            // GET    /invitations
+						r.Post("/", invitationHandler.Create)                                                         // POST   /invitations
+						r.Delete(fmt.Sprintf("/{%s}", invitationhttp.URLParamInvitationID), invitationHandler.Revoke) // DELETE /invitations/{invitation_id}
+					})
+					r.Route("/admin/role-presets", func(r chi.Router) {
+						r.Get("/", authHandler.ListPresets)   // GET  /admin/role-presets
+						r.Post("/", authHandler.CreatePreset) // POST /admin/role-presets
+						r.Route(fmt.Sprintf("/{%s}", authhttp.URLParamPresetID), func(r chi.Router) {
+							r.Get("/", authHandler.GetPreset)         // GET    /admin/role-presets/{preset_id}
+							r.Put("/", authHandler.UpdatePreset)      // PUT    /admin/role-presets/{preset_id}
+							r.Delete("/", authHandler.DeletePreset)   // DELETE /admin/role-presets/{preset_id}
+							r.Post("/apply", authHandler.ApplyPreset) // POST   /admin/role-presets/{preset_id}/apply
+						})
+					})
+				})
+
+				// --- operator routes
+				r.Group(func(r chi.Router) {
+					r.Use(operatorOnly)
+					r.Get("/config", adminHandler.GetConfig)                 // GET /config
+					r.Get("/settings", adminHandler.GetSettings)             // GET /settings
+					r.Put("/settings", adminHandler.UpdateSettings)          // PUT /settings
+					r.Put("/admin/maintenance", adminHandler.SetMaintenance) // PUT /admin/maintenance
+					r.Get("/admin/metrics", httpx.GetMetrics)                // GET /admin/metrics
+					r.Route("/admin/features", func(r chi.Router) {
+						r.Get("/", featureHandler.List)                                                         // GET    /admin/features
+						r.Put(fmt.Sprintf("/{%s}", featurehttp.URLParamName), featureHandler.SetOverride)       // PUT    /admin/features/{name}
+						r.Delete(fmt.Sprintf("/{%s}", featurehttp.URLParamName), featureHandler.DeleteOverride) // DELETE /admin/features/{name}
+					})
+					if backupHandler != nil {
+						r.Post("/admin/backups", backupHandler.Start)        // POST /admin/backups
+						r.Get("/admin/backups/status", backupHandler.Status) // GET /admin/backups/status
+					}
+				})
+
+				// --- workspace routes
+				r.Route("/workspaces", func(r chi.Router) {
+					r.Use(operatorOnly)
+					r.Get("/", workspaceHandler.List)    // GET  /workspaces
+					r.Post("/", workspaceHandler.Create) // POST /workspaces
+
+					r.Route(fmt.Sprintf("/{%s}", workspacehttp.URLParamWorkspaceID), func(r chi.Router) {
+						r.Get("/", workspaceHandler.Get)       // GET    /workspaces/{workspace_id}
+						r.Put("/", workspaceHandler.Update)    // PUT    /workspaces/{workspace_id}
+						r.Delete("/", workspaceHandler.Delete) // DELETE /workspaces/{workspace_id}
+					})
+				})
 			})
 
-			// --- entity routes
-			r.Route("/entities", func(r chi.Router) {
-				r.Post("/", entityHandler.Create) // POST /entities
-				r.Get("/", entityHandler.GetTree) // GET /entities
+			r.Group(func(r chi.Router) {
+				r.Use(authhttp.RequireReadWriteScope(auth.ScopeEntitiesRead, auth.ScopeEntitiesWrite))
+				r.Use(termshttp.RequireAccepted(termsCore))
+				r.Get("/drafts", entityHandler.GetDrafts)                                // GET /drafts
+				r.Get("/attachments/"+attachmenthttp.URLParamKey, attachmentHandler.Get) // GET /attachments/{key}
 
-				r.Route(fmt.Sprintf("/{%s}", entityhttp.URLParamEntityID), func(r chi.Router) {
-					r.Get("/", entityHandler.Get)       // GET    /entities/{entity_id}
-					r.Put("/", entityHandler.Update)    // PUT    /entities/{entity_id}
-					r.Delete("/", entityHandler.Delete) // DELETE /entities/{entity_id}
+				// --- entity routes
+				r.Route("/entities", func(r chi.Router) {
+					r.With(idempotent).Post("/", entityHandler.Create) // POST /entities
+					r.Get("/", entityHandler.GetTree)                  // GET /entities
+					r.Get("/list", entityHandler.List)                 // GET /entities/list
+					r.Post("/batch-get", entityHandler.BatchGet)       // POST /entities/batch-get
+
+					// --- workspace reports and maintenance
+					r.Group(func(r chi.Router) {
+						r.Use(adminOnly)
+						r.Get("/broken-links", entityHandler.GetBrokenLinks)                 // GET /entities/broken-links
+						r.Get("/orphaned", entityHandler.GetOrphanedEntities)                // GET /entities/orphaned
+						r.Get("/unsafe-markup", entityHandler.GetUnsafeMarkup)               // GET /entities/unsafe-markup
+						r.Get("/export", entityHandler.ExportAll)                            // GET /entities/export
+						r.Get("/retention/preview", entityHandler.PreviewRetention)          // GET /entities/retention/preview
+						r.Get("/trash/preview", entityHandler.PreviewTrashPurge)             // GET /entities/trash/preview
+						r.Post("/trash/purge", entityHandler.PurgeTrash)                     // POST /entities/trash/purge
+						r.Get("/stale-drafts/preview", entityHandler.PreviewStaleDrafts)     // GET /entities/stale-drafts/preview
+						r.Get("/missing-translations", entityHandler.GetMissingTranslations) // GET /entities/missing-translations
+					})
 
-					r.Route("/versions", func(r chi.Router) {
-						r.Get("/", entityHandler.GetVersionsList) // GET /entities/{entity_id}/versions
+					r.Get(fmt.Sprintf("/by-slug/{%s}", entityhttp.URLParamSlug), entityHandler.GetBySlug) // GET /entities/by-slug/{slug}
+					r.Get("/by-path/"+entityhttp.URLParamPath, entityHandler.GetByPath)                   // GET /entities/by-path/{slug}/...
 
-						r.Route(fmt.Sprintf("/{%s}", entityhttp.URLParamVersion), func(r chi.Router) {
-							r.Get("/", entityHandler.GetVersion) // GET /entities/{entity_id}/versions/{version}
+					r.Route(fmt.Sprintf("/{%s}", entityhttp.URLParamEntityID), func(r chi.Router) {
+						r.Get("/", entityHandler.Get)                             // GET    /entities/{entity_id}
+						r.With(idempotent).Put("/", entityHandler.Update)         // PUT    /entities/{entity_id}
+						r.Delete("/", entityHandler.Delete)                       // DELETE /entities/{entity_id}
+						r.Get("/meta", entityHandler.GetMeta)                     // GET    /entities/{entity_id}/meta
+						r.Get("/backlinks", entityHandler.GetBacklinks)           // GET    /entities/{entity_id}/backlinks
+						r.Get("/children", entityHandler.GetChildren)             // GET    /entities/{entity_id}/children
+						r.Patch("/children/order", entityHandler.ReorderChildren) // PATCH  /entities/{entity_id}/children/order
+						r.Get("/export", entityHandler.Export)                    // GET    /entities/{entity_id}/export
+						r.Get("/toc", entityHandler.GetTOC)                       // GET    /entities/{entity_id}/toc
+						r.Get("/manual", entityHandler.GetManual)                 // GET    /entities/{entity_id}/manual
+						r.Get("/contributors", entityHandler.GetContributors)     // GET    /entities/{entity_id}/contributors
+						r.Get("/activity", entityHandler.GetActivity)             // GET    /entities/{entity_id}/activity
+						r.Get("/history", entityHandler.GetHistory)               // GET    /entities/{entity_id}/history
+						r.Get("/lock", entityHandler.GetLock)                     // GET    /entities/{entity_id}/lock
+						r.Post("/lock", entityHandler.Lock)                       // POST   /entities/{entity_id}/lock
+						r.Post("/unlock", entityHandler.Unlock)                   // POST   /entities/{entity_id}/unlock
+						r.Patch("/draft", entityHandler.Autosave)                 // PATCH  /entities/{entity_id}/draft
+						r.Delete("/draft", entityHandler.DiscardAutosave)         // DELETE /entities/{entity_id}/draft
+						r.Post("/merge", entityHandler.Merge)                     // POST   /entities/{entity_id}/merge
+						r.Put("/owner", entityHandler.TransferOwnership)          // PUT    /entities/{entity_id}/owner
+						r.Patch("/appearance", entityHandler.UpdateAppearance)    // PATCH  /entities/{entity_id}/appearance
+						r.Put("/language", entityHandler.SetLanguage)             // PUT    /entities/{entity_id}/language
+						r.Delete("/language", entityHandler.DeleteLanguage)       // DELETE /entities/{entity_id}/language
+						r.Get("/lint", entityHandler.GetLintReport)               // GET    /entities/{entity_id}/lint
+						r.Put("/lint/settings", entityHandler.SetLintSettings)    // PUT    /entities/{entity_id}/lint/settings
+						r.Get("/settings", entityHandler.GetSpaceSettings)        // GET    /entities/{entity_id}/settings
+						r.Put("/settings", entityHandler.SetSpaceSettings)        // PUT    /entities/{entity_id}/settings
+
+						r.With(adminOnly).Get("/default-permissions", entityHandler.GetDefaultPermissions) // GET    /entities/{entity_id}/default-permissions
+						r.With(adminOnly).Put("/default-permissions", entityHandler.SetDefaultPermissions) // PUT    /entities/{entity_id}/default-permissions
+
+						relation := fmt.Sprintf("/relations/{%s}", entityhttp.URLParamRelatedID)
+						r.Put(relation, entityHandler.PutRelation)       // PUT    /entities/{entity_id}/relations/{related_id}
+						r.Delete(relation, entityHandler.DeleteRelation) // DELETE /entities/{entity_id}/relations/{related_id}
+
+						pin := fmt.Sprintf("/children/{%s}/pin", entityhttp.URLParamChildID)
+						r.Put(pin, entityHandler.PinChild)      // PUT    /entities/{entity_id}/children/{child_id}/pin
+						r.Delete(pin, entityHandler.UnpinChild) // DELETE /entities/{entity_id}/children/{child_id}/pin
+
+						r.Route("/snapshots", func(r chi.Router) {
+							r.Get("/", entityHandler.GetSnapshots)                                           // GET  /entities/{entity_id}/snapshots
+							r.Post("/", entityHandler.CreateSnapshot)                                        // POST /entities/{entity_id}/snapshots
+							r.Get(fmt.Sprintf("/{%s}", entityhttp.URLParamLabel), entityHandler.GetSnapshot) // GET  /entities/{entity_id}/snapshots/{label}
+						})
+
+						r.Route("/variables", func(r chi.Router) {
+							variable := fmt.Sprintf("/{%s}", entityhttp.URLParamKey)
+							r.Get("/", entityHandler.GetVariables)           // GET    /entities/{entity_id}/variables
+							r.Put(variable, entityHandler.SetVariable)       // PUT    /entities/{entity_id}/variables/{key}
+							r.Delete(variable, entityHandler.DeleteVariable) // DELETE /entities/{entity_id}/variables/{key}
+						})
+
+						r.Route("/variants", func(r chi.Router) {
+							r.Get("/", entityHandler.GetVariants)                                               // GET  /entities/{entity_id}/variants
+							r.With(idempotent).Post("/", entityHandler.CreateVariant)                           // POST /entities/{entity_id}/variants
+							r.Put(fmt.Sprintf("/{%s}", entityhttp.URLParamVariantID), entityHandler.PutVariant) // PUT  /entities/{entity_id}/variants/{variant_id}
+						})
+
+						r.Route("/versions", func(r chi.Router) {
+							r.Get("/", entityHandler.GetVersionsList) // GET /entities/{entity_id}/versions
+
+							r.Route(fmt.Sprintf("/{%s}", entityhttp.URLParamVersion), func(r chi.Router) {
+								r.Get("/", entityHandler.GetVersion)               // GET /entities/{entity_id}/versions/{version}
+								r.Get("/content", entityHandler.GetVersionContent) // GET /entities/{entity_id}/versions/{version}/content
+							})
 						})
 					})
 				})
+				r.With(adminOnly).Get("/reports/popular", entityHandler.GetPopular) // GET /reports/popular?period={period}&limit={limit}
 			})
 		})
 
+		// websocket: browsers cannot set headers on the handshake, so the token may come from the query
+		r.Group(func(r chi.Router) {
+			r.Use(authhttp.TokenFromQuery(authhttp.QueryParamAccessToken))
+			r.Use(authhttp.AuthMiddleware(jwtCodec))
+			r.Use(usagehttp.Middleware(usageCore))
+			r.Use(authhttp.RequireScope(auth.ScopeEntitiesRead))
+			r.Use(termshttp.RequireAccepted(termsCore))
+			r.Get("/ws", presenceHandler.Serve) // GET /ws?entity_id={entity_id}
+		})
+
 		// without auth
 		r.Group(func(r chi.Router) {
-			r.Post("/login", authHandler.Login)           // POST /login
-			r.Post("/refresh", authHandler.RefreshTokens) // POST /refresh
-			r.Post("/register", userHandler.CreateUser)   // POST /register
+			r.With(captchaGuard.Login).Post("/login", authHandler.Login)            // POST /login
+			r.Post("/refresh", authHandler.RefreshTokens)                           // POST /refresh
+			r.With(captchaGuard.Register).Post("/register", userHandler.CreateUser) // POST /register
+			r.Get("/problems", httpx.GetProblemTypes)                               // GET  /problems
+			r.Get("/terms", termsHandler.GetTerms)                                  // GET  /terms
+			r.Get("/version", httpx.GetVersion(build))                              // GET  /version
+
+			r.Post(fmt.Sprintf("/register/invite/{%s}", invitationhttp.URLParamToken), invitationHandler.Accept) // POST /register/invite/{token}
 		})
 
 		r.Get("/swagger/*", httpSwagger.Handler(
@@ -212,8 +942,47 @@ func main() {
 		WriteTimeout: 10 * time.Second,
 	}
 
-	log.Info().Msg(fmt.Sprintf("starting server on :%s", cfg.Port))
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("server shutdown error")
+		}
+	}()
+
+	log.Info().Str("version", build.Version).Str("commit", build.Commit).Str("build_date", build.BuildDate).
+		Str("go_version", build.GoVersion).Str("profile", build.Profile).Msg(fmt.Sprintf("starting server on :%s", cfg.Port))
 	if err = srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatal().Err(err).Msg("server error")
 	}
+	// wait for the final usage flush, running jobs and a backup in progress
+	<-usageFlushed
+	jobRunner.Wait()
+	if backupService != nil {
+		backupService.Wait()
+	}
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFlush()
+	if err = reporter.Close(flushCtx); err != nil {
+		log.Error().Err(err).Msg("error reports not sent")
+	}
+}
+
+// reloadOnSIGHUP re-reads the config file and env and applies the runtime settings, and makes
+// the file and vault secrets be fetched again on next use. Other values need a restart; an
+// invalid config is logged and ignored.
+func reloadOnSIGHUP(configPath string, registry *settings.Registry[config.RuntimeSettings], secretStore *secrets.Store) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		secretStore.Refresh()
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			log.Error().Err(err).Msg("SIGHUP: failed to reload config, keeping current settings")
+			continue
+		}
+		registry.Update(cfg.Runtime())
+		log.Info().Msg("SIGHUP: runtime settings reloaded")
+	}
 }
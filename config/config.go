@@ -1,75 +1,559 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
 	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/app/gitsync"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/app/usage"
 	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/captcha"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/errreport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/idempotency"
+	"github.com/66gu1/easygodocs/internal/infrastructure/ldap"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/66gu1/easygodocs/internal/infrastructure/pdf"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
 
+// EnvPrefix is prepended to every environment override, e.g. EASYGODOCS_AUTH_SESSION_TTL_MINUTES.
+const EnvPrefix = "EASYGODOCS"
+
+// Config is the effective configuration of all binaries.
+// Secrets are excluded from JSON so the struct can be shown as is.
 type Config struct {
+	AppName     string   `mapstructure:"app_name" json:"app_name"`
+	Profile     string   `mapstructure:"profile" json:"profile"`
 	Port        string   `mapstructure:"port" json:"port"`
-	DatabaseDSN string   `mapstructure:"database_dsn" json:"database_dsn"`
 	LogLevel    LogLevel `mapstructure:"log_level" json:"log_level"`
 	MaxBodySize int64    `mapstructure:"max_body_size" json:"max_body_size"`
+
+	DatabaseDSN      string `mapstructure:"database_dsn" json:"-"`
+	DatabasePassword string `mapstructure:"database_password" json:"-"`
+	JWTSecret        string `mapstructure:"jwt_secret" json:"-"`
+	PasswordPepper   string `mapstructure:"password_pepper" json:"-"`
+
+	ContentKey         string `mapstructure:"content_key" json:"-"`
+	ContentKeyPrevious string `mapstructure:"content_key_previous" json:"-"`
+
+	DatabasePool db.PoolConfig `mapstructure:"database_pool" json:"database_pool"`
+
+	Maintenance httpx.MaintenanceConfig `mapstructure:"maintenance" json:"maintenance"`
+
+	Auth     auth.Config     `mapstructure:"auth" json:"auth"`
+	User     UserConfig      `mapstructure:"user" json:"user"`
+	Entity   EntityConfig    `mapstructure:"entity" json:"entity"`
+	Presence presence.Config `mapstructure:"presence" json:"presence"`
+	Usage    usage.Config    `mapstructure:"usage" json:"usage"`
+	Terms    terms.Config    `mapstructure:"terms" json:"terms"`
+	Blob     blob.Config     `mapstructure:"blob" json:"blob"`
+	Scan     scan.Config     `mapstructure:"scan" json:"scan"`
+	PDF      pdf.Config      `mapstructure:"pdf" json:"pdf"`
+	Lint     lint.Config     `mapstructure:"lint" json:"lint"`
+	Sanitize sanitize.Config `mapstructure:"sanitize" json:"sanitize"`
+	Stats    stats.Config    `mapstructure:"stats" json:"stats"`
+	Public   public.Config   `mapstructure:"public" json:"public"`
+	Sync     gitsync.Config  `mapstructure:"sync" json:"sync"`
+	Backup   backup.Config   `mapstructure:"backup" json:"backup"`
+	Feature  feature.Config  `mapstructure:"feature" json:"feature"`
+
+	Invitation invitation.Config `mapstructure:"invitation" json:"invitation"`
+	Mail       mail.Config       `mapstructure:"mail" json:"mail"`
+	Captcha    captcha.Config    `mapstructure:"captcha" json:"captcha"`
+	LDAP       ldap.Config       `mapstructure:"ldap" json:"ldap"`
+	DataExport dataexport.Config `mapstructure:"data_export" json:"data_export"`
+
+	Workspace workspace.Config `mapstructure:"workspace" json:"workspace"`
+
+	Idempotency idempotency.Config `mapstructure:"idempotency" json:"idempotency"`
+	Secrets     secrets.Config     `mapstructure:"secrets" json:"secrets"`
+	ErrorReport errreport.Config   `mapstructure:"error_report" json:"error_report"`
+}
+
+type UserConfig struct {
+	user.Config           `mapstructure:",squash"`
+	user.ValidationConfig `mapstructure:",squash"`
+}
+
+type EntityConfig struct {
+	entity.Config           `mapstructure:",squash"`
+	entity.ValidationConfig `mapstructure:",squash"`
+}
+
+var defaults = map[string]any{
+	"app_name":      "EasyGoDocs",
+	"profile":       "default",
+	"port":          "8080",
+	"log_level":     string(logLevelInfo),
+	"max_body_size": 1 << 20,
+	"database_dsn":  "",
+	"jwt_secret":    "",
+
+	"database_password": "",
+	"password_pepper":   "",
+
+	"content_key":          "",
+	"content_key_previous": "",
+
+	"database_pool.max_open_conns":             25,
+	"database_pool.max_idle_conns":             10,
+	"database_pool.conn_max_lifetime_minutes":  30,
+	"database_pool.conn_max_idle_time_minutes": 5,
+	"database_pool.saturation_warn_percent":    80,
+	"database_pool.check_interval_seconds":     30,
+
+	"maintenance.enabled":             false,
+	"maintenance.retry_after_seconds": 300,
+
+	"auth.session_ttl_minutes":             6000,
+	"auth.remember_me_session_ttl_minutes": 43200,
+	"auth.access_token_ttl_minutes":        15,
+
+	"auth.issuer":               "easygodocs",
+	"auth.audience":             "easygodocs",
+	"auth.accept_legacy_tokens": false,
+	"auth.leeway_seconds":       30,
+
+	"auth.impersonation.enabled":           false,
+	"auth.impersonation.token_ttl_minutes": 15,
+
+	"auth.device_binding.mode":     auth.DeviceBindingOff,
+	"auth.device_binding.match_ip": false,
+
+	"auth.claims.roles":     false,
+	"auth.claims.max_roles": 20,
+	"auth.claims.tenant_id": "",
+
+	"user.max_email_length":    254,
+	"user.max_name_length":     30,
+	"user.min_password_length": 8,
+	"user.max_password_length": 50,
+	"user.password_hash_cost":  12,
+	"user.max_bio_length":      500,
+	"user.max_avatar_bytes":    512 << 10,
+
+	"user.name_rules.collapse_spaces": true,
+	"user.name_rules.strip_control":   true,
+	"user.name_rules.nfc":             true,
+	"user.name_rules.forbidden_chars": "",
+
+	"user.password_hash_algorithm": string(secure.AlgorithmBcrypt),
+	"user.argon2.memory_kib":       64 * 1024,
+	"user.argon2.iterations":       3,
+	"user.argon2.parallelism":      4,
+
+	"user.registration.enabled":         true,
+	"user.registration.allowed_domains": []string{},
+
+	"entity.max_hierarchy_depth":  15,
+	"entity.max_name_length":      100,
+	"entity.max_summary_length":   300,
+	"entity.lock_ttl_minutes":     15,
+	"entity.unique_sibling_names": false,
+	"entity.redirect_moved_paths": false,
+	"entity.recursive_hierarchy":  false,
+	"entity.quiet_minor_edits":    true,
+	"entity.max_pinned_children":  5,
+	"entity.cross_space_grants":   "strip",
+
+	"entity.max_content_length":      512 << 10,
+	"entity.content_warning_percent": 80,
+	"entity.reject_broken_links":     []string{},
+	"entity.languages":               []string{},
+
+	"entity.name_rules.collapse_spaces": true,
+	"entity.name_rules.strip_control":   true,
+	"entity.name_rules.nfc":             true,
+	"entity.name_rules.forbidden_chars": "",
+
+	"entity.retention.keep_last_versions": 0,
+	"entity.retention.keep_days":          0,
+	"entity.retention.interval_minutes":   60,
+	"entity.trash.retention_days":         30,
+	"entity.trash.interval_minutes":       60,
+
+	"entity.stale_drafts.notify_days":      0,
+	"entity.stale_drafts.cleanup_days":     0,
+	"entity.stale_drafts.action":           "archive",
+	"entity.stale_drafts.interval_minutes": 60,
+
+	"entity.encryption.enabled":          false,
+	"entity.encryption.interval_minutes": 60,
+	"entity.encryption.batch_size":       100,
+
+	"entity.lint.default_severity": "warning",
+	"entity.lint.severities":       map[string]string{"typos": "error"},
+
+	"presence.send_buffer_size":        32,
+	"presence.max_room_size":           100,
+	"presence.max_message_bytes":       4096,
+	"presence.max_messages_per_second": 20,
+	"presence.ping_interval_seconds":   30,
+	"presence.allowed_origins":         []string{},
+
+	"usage.flush_interval_seconds":  60,
+	"usage.quota_requests_per_hour": 0,
+	"usage.max_report_hours":        24 * 31,
+	"usage.max_report_limit":        100,
+
+	"terms.version": "",
+	"terms.url":     "",
+
+	"blob.dir": "data/blobs",
+
+	"scan.clamav_addr":     "",
+	"scan.timeout_seconds": 30,
+
+	"pdf.command":         []string{},
+	"pdf.timeout_seconds": 60,
+
+	"lint.languagetool_url": "",
+	"lint.language":         "auto",
+	"lint.timeout_seconds":  10,
+	"lint.interval_seconds": 30,
+
+	"sanitize.allowed_tags":       sanitize.DefaultAllowedTags,
+	"sanitize.allowed_attributes": sanitize.DefaultAllowedAttributes,
+	"sanitize.allowed_schemes":    sanitize.DefaultAllowedSchemes,
+
+	"stats.cache_ttl_seconds": 300,
+
+	"public.entity_ids":        []string{},
+	"public.base_url":          "",
+	"public.title":             "EasyGoDocs",
+	"public.feed_size":         50,
+	"public.cache_ttl_seconds": 600,
+
+	"sync.root_id":             "",
+	"sync.author_id":           "",
+	"sync.interval_seconds":    60,
+	"sync.git.dir":             "data/sync",
+	"sync.git.remote":          "",
+	"sync.git.branch":          "main",
+	"sync.git.committer_name":  "EasyGoDocs",
+	"sync.git.committer_email": "easygodocs@localhost",
+
+	"backup.prefix":               "backups",
+	"backup.omit_password_hashes": false,
+	"backup.s3.endpoint":          "",
+	"backup.s3.region":            "us-east-1",
+	"backup.s3.bucket":            "",
+	"backup.s3.path_style":        false,
+	"backup.s3.access_key_id":     "",
+	"backup.s3.secret_access_key": "",
+
+	"feature.refresh_seconds": 30,
+
+	"invitation.ttl_hours":  7 * 24,
+	"invitation.accept_url": "",
+	"invitation.subject":    "You are invited to EasyGoDocs",
+
+	"data_export.interval_seconds": 60,
+	"data_export.ttl_hours":        72,
+
+	"mail.smtp_addr":       "",
+	"mail.username":        "",
+	"mail.password":        "",
+	"mail.from":            "",
+	"mail.timeout_seconds": 10,
+
+	"captcha.provider":             "",
+	"captcha.secret":               "",
+	"captcha.min_score":            0.5,
+	"captcha.timeout_seconds":      5,
+	"captcha.register":             true,
+	"captcha.login.enabled":        false,
+	"captcha.login.after_failures": 3,
+	"captcha.login.window_minutes": 15,
+	"captcha.api_keys":             []string{},
+
+	"ldap.url":                 "",
+	"ldap.start_tls":           false,
+	"ldap.bind_dn":             "",
+	"ldap.bind_password":       "",
+	"ldap.base_dn":             "",
+	"ldap.login_attribute":     "mail",
+	"ldap.attributes.email":    "mail",
+	"ldap.attributes.name":     "cn",
+	"ldap.timeout_seconds":     5,
+	"ldap.local_fallback":      true,
+	"ldap.link_existing_users": false,
+
+	"workspace.base_domain": "",
+
+	"idempotency.ttl_minutes": 24 * 60,
+
+	"secrets.provider":        secrets.ProviderEnv,
+	"secrets.dir":             "/run/secrets",
+	"secrets.refresh_seconds": 0,
+	"secrets.vault.address":   "",
+	"secrets.vault.mount":     "secret",
+	"secrets.vault.path":      "easygodocs",
+
+	"error_report.sentry_dsn":      "",
+	"error_report.environment":     "",
+	"error_report.release":         "",
+	"error_report.timeout_seconds": 5,
+}
+
+// legacyEnv keeps the unprefixed variable names that deployments already use.
+var legacyEnv = map[string]string{
+	"database_dsn": "DATABASE_DSN",
+	"jwt_secret":   "JWT_SECRET",
 }
 
-func LoadConfig() Config {
-	viper.SetConfigName("config")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath("config")
-	viper.AddConfigPath(".")
+// Load reads the config file at path (YAML, TOML or JSON, chosen by extension), applies
+// environment overrides and defaults, and validates the result. An empty path looks for
+// config.{yaml,toml,json} in ./config and the working directory; a missing file is not an error then.
+func Load(path string) (Config, error) {
+	v := viper.New()
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	for key, env := range legacyEnv {
+		if err := v.BindEnv(key, EnvPrefix+"_"+strings.ToUpper(key), env); err != nil {
+			return Config{}, fmt.Errorf("config.Load: %w", err)
+		}
+	}
 
-	if err := viper.ReadInConfig(); err != nil {
-		panic(fmt.Errorf("fatal error config file: %w", err))
+	if path != "" {
+		v.SetConfigFile(path)
+	} else {
+		v.SetConfigName("config")
+		v.AddConfigPath("config")
+		v.AddConfigPath(".")
+	}
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if path != "" || !errors.As(err, &notFound) {
+			return Config{}, fmt.Errorf("config.Load: %w", err)
+		}
 	}
 
-	var Cfg Config
-	if err := viper.Unmarshal(&Cfg); err != nil {
-		panic(fmt.Errorf("fatal error config file: %w", err))
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("config.Load: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("config.Load: %w", err)
 	}
 
-	return Cfg
+	return cfg, nil
 }
 
-func GetUserConfigs() (user.Config, user.ValidationConfig) {
-	var userCfg user.Config
-	if err := viper.Sub("user").Unmarshal(&userCfg); err != nil {
-		panic(fmt.Errorf("fatal error user config: %w", err))
+// Validate checks every section and reports all problems at once.
+// Secrets are not checked here because not every binary needs them.
+func (c Config) Validate() error {
+	var errs []error
+	if port, err := strconv.Atoi(c.Port); err != nil || port <= 0 || port > 65535 {
+		errs = append(errs, fmt.Errorf("port: must be a number between 1 and 65535"))
+	}
+	if err := c.LogLevel.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("log_level: %w", err))
+	}
+	if c.MaxBodySize <= 0 {
+		errs = append(errs, fmt.Errorf("max_body_size: must be positive"))
+	}
+	if err := c.DatabasePool.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("database_pool: %w", err))
+	}
+	if err := c.Maintenance.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("maintenance: %w", err))
+	}
+	if err := c.Auth.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("auth: %w", err))
+	}
+	if err := c.User.Config.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("user: %w", err))
+	}
+	if err := c.User.ValidationConfig.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("user: %w", err))
+	}
+	if c.MaxBodySize > 0 && int64(c.User.MaxAvatarBytes) > c.MaxBodySize {
+		errs = append(errs, fmt.Errorf("user: max_avatar_bytes must not exceed max_body_size"))
+	}
+	if err := c.Entity.Config.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("entity: %w", err))
+	}
+	if err := c.Entity.ValidationConfig.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("entity: %w", err))
+	}
+	// the content travels JSON-encoded next to the other fields, so it has to stay below the body limit
+	if c.MaxBodySize > 0 && int64(c.Entity.LargestContentLimit()) >= c.MaxBodySize {
+		errs = append(errs, fmt.Errorf("entity: max_content_length must be below max_body_size"))
+	}
+	if err := c.Presence.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("presence: %w", err))
+	}
+	if err := c.Usage.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("usage: %w", err))
+	}
+	if err := c.Terms.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("terms: %w", err))
+	}
+	if err := c.Blob.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("blob: %w", err))
+	}
+	if err := c.Scan.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("scan: %w", err))
+	}
+	if err := c.PDF.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("pdf: %w", err))
+	}
+	if err := c.Lint.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("lint: %w", err))
+	}
+	if err := c.Sanitize.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("sanitize: %w", err))
+	}
+	if err := c.Stats.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("stats: %w", err))
+	}
+	if err := c.Public.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("public: %w", err))
+	}
+	if err := c.Sync.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("sync: %w", err))
+	}
+	if err := c.Backup.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("backup: %w", err))
+	}
+	if err := c.Feature.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("feature: %w", err))
+	}
+	if err := c.Invitation.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invitation: %w", err))
+	}
+	if err := c.Mail.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("mail: %w", err))
+	}
+	if err := c.Captcha.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("captcha: %w", err))
+	}
+	if err := c.LDAP.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("ldap: %w", err))
+	}
+	if err := c.DataExport.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("data_export: %w", err))
+	}
+	if err := c.Workspace.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("workspace: %w", err))
+	}
+	if err := c.Idempotency.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("idempotency: %w", err))
+	}
+	if err := c.ErrorReport.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("error_report: %w", err))
+	}
+	if err := c.Secrets.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("secrets: %w", err))
 	}
 
-	var userValCfg user.ValidationConfig
-	if err := viper.Sub("user").Unmarshal(&userValCfg); err != nil {
-		panic(fmt.Errorf("fatal error user validation config: %w", err))
+	return errors.Join(errs...)
+}
+
+// RequireSecrets reports which of the secrets needed by a binary are missing. jwt_secret is only
+// checked here with the env provider; the other providers are asked on first use.
+func (c Config) RequireSecrets(dsn, jwtSecret bool) error {
+	var errs []error
+	if dsn && c.DatabaseDSN == "" {
+		errs = append(errs, fmt.Errorf("database_dsn: set %s_DATABASE_DSN or DATABASE_DSN", EnvPrefix))
+	}
+	if jwtSecret && c.Secrets.Provider == secrets.ProviderEnv && c.JWTSecret == "" {
+		errs = append(errs, fmt.Errorf("jwt_secret: set %s_JWT_SECRET or JWT_SECRET", EnvPrefix))
 	}
 
-	return userCfg, userValCfg
+	return errors.Join(errs...)
 }
 
-func GetAuthConfigs() auth.Config {
-	var authCfg auth.Config
-	if err := viper.Sub("auth").Unmarshal(&authCfg); err != nil {
-		panic(fmt.Errorf("fatal error auth config: %w", err))
+// SecretStore returns the secrets (jwt_secret, database_password, password_pepper, content_key
+// and content_key_previous) from the provider the secrets section chooses. With the env provider
+// they are the values loaded with the config, from the file or the environment.
+func (c Config) SecretStore(timeGen secrets.TimeGenerator) (*secrets.Store, error) {
+	var (
+		provider secrets.Provider
+		err      error
+	)
+	switch c.Secrets.Provider {
+	case secrets.ProviderFile:
+		provider, err = secrets.NewFileProvider(c.Secrets.Dir)
+	case secrets.ProviderVault:
+		provider, err = secrets.NewVaultProvider(c.Secrets.Vault, os.Getenv("VAULT_TOKEN"), &http.Client{Timeout: 10 * time.Second})
+	default:
+		provider = secrets.Map{
+			secrets.JWTSecret:        c.JWTSecret,
+			secrets.DatabasePassword: c.DatabasePassword,
+			secrets.PasswordPepper:   c.PasswordPepper,
+
+			secrets.ContentKey:         c.ContentKey,
+			secrets.ContentKeyPrevious: c.ContentKeyPrevious,
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config.SecretStore: %w", err)
+	}
+	store, err := secrets.NewStore(provider, timeGen, c.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("config.SecretStore: %w", err)
 	}
 
-	return authCfg
+	return store, nil
 }
 
-func GetEntityConfigs() (entity.Config, entity.ValidationConfig) {
-	var entityValidationCfg entity.ValidationConfig
-	if err := viper.Sub("entity").Unmarshal(&entityValidationCfg); err != nil {
-		panic(fmt.Errorf("fatal error entity config: %w", err))
+// ResolveDSN returns database_dsn with the database_password secret as its password, if set.
+// Both the key=value and the URL form of the DSN are supported.
+func (c Config) ResolveDSN(ctx context.Context, store *secrets.Store) (string, error) {
+	password, err := store.Get(ctx, secrets.DatabasePassword)
+	if err != nil {
+		return "", fmt.Errorf("config.ResolveDSN: %w", err)
+	}
+	if password == "" {
+		return c.DatabaseDSN, nil
 	}
 
-	var entityCfg entity.Config
-	if err := viper.Sub("entity").Unmarshal(&entityCfg); err != nil {
-		panic(fmt.Errorf("fatal error entity repo config: %w", err))
+	if strings.HasPrefix(c.DatabaseDSN, "postgres://") || strings.HasPrefix(c.DatabaseDSN, "postgresql://") {
+		u, err := url.Parse(c.DatabaseDSN)
+		if err != nil {
+			return "", fmt.Errorf("config.ResolveDSN: %w", errors.New("database_dsn is not a valid URL"))
+		}
+		u.User = url.UserPassword(u.User.Username(), password)
+		return u.String(), nil
 	}
+	// a later key overrides an earlier one
+	escaped := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(password)
 
-	return entityCfg, entityValidationCfg
+	return strings.TrimSpace(c.DatabaseDSN + " password='" + escaped + "'"), nil
 }
 
 type LogLevel string
@@ -81,6 +565,15 @@ const (
 	logLevelError LogLevel = "error"
 )
 
+func (l LogLevel) Validate() error {
+	switch l {
+	case logLevelDebug, logLevelInfo, logLevelWarn, logLevelError:
+		return nil
+	default:
+		return fmt.Errorf("unknown level %q, expected debug, info, warn or error", l)
+	}
+}
+
 func (l LogLevel) ZeroLog() zerolog.Level {
 	switch l {
 	case logLevelDebug:
@@ -0,0 +1,264 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/infrastructure/errreport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/pdf"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/66gu1/easygodocs/internal/infrastructure/system"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// Tests in this file use t.Setenv and therefore cannot run in parallel.
+
+func TestLoad_YAML(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+port: 9090
+log_level: warn
+auth:
+  session_ttl_minutes: 60
+entity:
+  max_hierarchy_depth: 3
+  max_name_length: 50
+  max_content_length_by_type:
+    department: 1024
+  forbidden_name_chars_by_type:
+    department: "/"
+  reject_broken_links: [article]
+  retention:
+    keep_last_versions: 10
+`)
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, "9090", cfg.Port)
+	require.Equal(t, config.LogLevel("warn"), cfg.LogLevel)
+	require.Equal(t, 60, cfg.Auth.SessionTTLMinutes)
+	require.Equal(t, 3, cfg.Entity.MaxHierarchyDepth)
+	require.Equal(t, 50, cfg.Entity.MaxNameLength)
+	require.Equal(t, 10, cfg.Entity.Retention.KeepLastVersions)
+	require.Equal(t, 60, cfg.Entity.Retention.IntervalMinutes)
+	require.Equal(t, 30, cfg.Entity.Trash.RetentionDays)
+	require.Equal(t, 15, cfg.Entity.LockTTLMinutes)
+	require.Equal(t, 1024, cfg.Entity.ContentLimit(entity.TypeDepartment))
+	require.Equal(t, 512<<10, cfg.Entity.ContentLimit(entity.TypeArticle))
+	require.Equal(t, 80, cfg.Entity.ContentWarningPercent)
+	require.Equal(t, "/", cfg.Entity.ForbiddenNameChars(entity.TypeDepartment))
+	require.Empty(t, cfg.Entity.ForbiddenNameChars(entity.TypeArticle))
+	require.Equal(t, []entity.Type{entity.TypeArticle}, cfg.Entity.RejectBrokenLinks)
+	// defaults for keys missing in the file
+	require.Equal(t, 15, cfg.Auth.AccessTokenTTLMinutes)
+	require.Equal(t, 20, cfg.Auth.Claims.MaxRoles)
+	require.Equal(t, "easygodocs", cfg.Auth.Issuer)
+	require.Equal(t, 30, cfg.Auth.LeewaySeconds)
+	require.Equal(t, int64(1<<20), cfg.MaxBodySize)
+	require.Equal(t, "default", cfg.Profile)
+	require.Equal(t, 25, cfg.DatabasePool.MaxOpenConns)
+	require.Equal(t, 80, cfg.DatabasePool.SaturationWarnPercent)
+	require.Equal(t, 12, cfg.User.PasswordHashCost)
+	require.Equal(t, secure.AlgorithmBcrypt, cfg.User.PasswordHashAlgorithm)
+	require.Equal(t, uint32(64*1024), cfg.User.Argon2.MemoryKiB)
+	require.Equal(t, 512<<10, cfg.User.MaxAvatarBytes)
+	require.Equal(t, text.NameRules{CollapseSpaces: true, StripControl: true, NFC: true}, cfg.User.NameRules)
+	require.True(t, cfg.Entity.NameRules.NFC)
+	require.Equal(t, "data/blobs", cfg.Blob.Dir)
+	require.Equal(t, scan.Config{TimeoutSeconds: 30}, cfg.Scan)
+	require.Equal(t, pdf.Config{Command: []string{}, TimeoutSeconds: 60}, cfg.PDF)
+	require.Equal(t, errreport.Config{TimeoutSeconds: 5}, cfg.ErrorReport)
+	require.Equal(t, sanitize.DefaultAllowedTags, cfg.Sanitize.AllowedTags)
+	require.Equal(t, sanitize.DefaultAllowedSchemes, cfg.Sanitize.AllowedSchemes)
+	require.Equal(t, terms.Config{}, cfg.Terms)
+	require.Equal(t, 300, cfg.Stats.CacheTTLSeconds)
+	require.Equal(t, feature.Config{RefreshSeconds: 30}, cfg.Feature)
+	require.Equal(t, dataexport.Config{IntervalSeconds: 60, TTLHours: 72}, cfg.DataExport)
+	require.Equal(t, 50, cfg.Public.FeedSize)
+	require.Equal(t, 600, cfg.Public.CacheTTLSeconds)
+	require.Equal(t, 24*60, cfg.Idempotency.TTLMinutes)
+	require.Equal(t, secrets.ProviderEnv, cfg.Secrets.Provider)
+	require.Equal(t, "/run/secrets", cfg.Secrets.Dir)
+	require.Zero(t, cfg.Secrets.RefreshSeconds)
+	require.Equal(t, "secret", cfg.Secrets.Vault.Mount)
+}
+
+func TestLoad_TOML(t *testing.T) {
+	path := writeFile(t, "config.toml", `
+port = "7070"
+
+[presence]
+max_room_size = 5
+`)
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, "7070", cfg.Port)
+	require.Equal(t, 5, cfg.Presence.MaxRoomSize)
+}
+
+func TestLoad_EnvOverrides(t *testing.T) {
+	path := writeFile(t, "config.yaml", "port: 9090\nuser:\n  max_name_length: 30\n")
+	t.Setenv("EASYGODOCS_PORT", "6060")
+	t.Setenv("EASYGODOCS_USER_MAX_NAME_LENGTH", "40")
+	t.Setenv("EASYGODOCS_ENTITY_RETENTION_KEEP_DAYS", "30")
+	t.Setenv("EASYGODOCS_PRESENCE_ALLOWED_ORIGINS", "a.example.com,b.example.com")
+	t.Setenv("DATABASE_DSN", "legacy-dsn")
+	t.Setenv("EASYGODOCS_JWT_SECRET", "secret")
+	t.Setenv("EASYGODOCS_PASSWORD_PEPPER", "pepper")
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, "6060", cfg.Port)
+	require.Equal(t, 40, cfg.User.MaxNameLength)
+	require.Equal(t, 30, cfg.Entity.Retention.KeepDays)
+	require.Equal(t, []string{"a.example.com", "b.example.com"}, cfg.Presence.AllowedOrigins)
+	require.Equal(t, "legacy-dsn", cfg.DatabaseDSN)
+	require.Equal(t, "secret", cfg.JWTSecret)
+	require.Equal(t, "pepper", cfg.PasswordPepper)
+	require.NoError(t, cfg.RequireSecrets(true, true))
+}
+
+func TestLoad_Errors(t *testing.T) {
+	t.Run("missing explicit file", func(t *testing.T) {
+		_, err := config.Load(filepath.Join(t.TempDir(), "nope.yaml"))
+		require.Error(t, err)
+	})
+	t.Run("invalid values are all reported", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "port: abc\nlog_level: loud\nauth:\n  session_ttl_minutes: 0\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "port")
+		require.ErrorContains(t, err, "log_level")
+		require.ErrorContains(t, err, "auth")
+	})
+	t.Run("avatar larger than request body", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "max_body_size: 1024\nuser:\n  max_avatar_bytes: 2048\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "max_avatar_bytes")
+	})
+	t.Run("content larger than request body", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "max_body_size: 2048\nentity:\n  max_content_length: 1024\n  max_content_length_by_type:\n    article: 4096\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "max_content_length")
+	})
+	t.Run("missing secrets", func(t *testing.T) {
+		t.Setenv("DATABASE_DSN", "")
+		t.Setenv("JWT_SECRET", "")
+		path := writeFile(t, "config.yaml", "port: 8080\n")
+		cfg, err := config.Load(path)
+		require.NoError(t, err)
+		require.Error(t, cfg.RequireSecrets(true, false))
+		require.NoError(t, cfg.RequireSecrets(false, false))
+
+		// other providers are asked on first use
+		cfg.Secrets.Provider = secrets.ProviderFile
+		require.NoError(t, cfg.RequireSecrets(false, true))
+	})
+	t.Run("sync without remote", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "sync:\n  root_id: 0199a4c2-6f3e-7b1a-8c2d-3e4f5a6b7c8d\n  author_id: 0199a4c2-6f3e-7b1a-8c2d-3e4f5a6b7c8e\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "sync: git: remote is required")
+	})
+	t.Run("backup without credentials", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "backup:\n  s3:\n    endpoint: http://minio:9000\n    bucket: docs\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "backup: s3: access_key_id and secret_access_key are required")
+	})
+	t.Run("invalid secrets provider", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", "secrets:\n  provider: vault\n")
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "secrets")
+	})
+}
+
+func TestConfig_SecretStore(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{JWTSecret: "jwt", PasswordPepper: "pepper", Secrets: secrets.Config{Provider: secrets.ProviderEnv}}
+	store, err := cfg.SecretStore(&system.TimeGenerator{})
+	require.NoError(t, err)
+	got, err := store.Get(t.Context(), secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "jwt", got)
+	got, err = store.Get(t.Context(), secrets.PasswordPepper)
+	require.NoError(t, err)
+	require.Equal(t, "pepper", got)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, secrets.JWTSecret), []byte("from-file\n"), 0o600))
+	cfg.Secrets = secrets.Config{Provider: secrets.ProviderFile, Dir: dir}
+	store, err = cfg.SecretStore(&system.TimeGenerator{})
+	require.NoError(t, err)
+	got, err = store.Get(t.Context(), secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "from-file", got)
+}
+
+func TestConfig_ResolveDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dsn      string
+		password string
+		want     string
+	}{
+		{name: "no password", dsn: "host=db user=app", want: "host=db user=app"},
+		{name: "key value", dsn: "host=db user=app", password: `it's a \ secret`, want: `host=db user=app password='it\'s a \\ secret'`},
+		{name: "url", dsn: "postgres://app@db:5432/docs?sslmode=disable", password: "p@ss/word", want: "postgres://app:p%40ss%2Fword@db:5432/docs?sslmode=disable"},
+		{name: "url with password", dsn: "postgresql://app:old@db/docs", password: "new", want: "postgresql://app:new@db/docs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := config.Config{DatabaseDSN: tt.dsn, DatabasePassword: tt.password, Secrets: secrets.Config{Provider: secrets.ProviderEnv}}
+			store, err := cfg.SecretStore(&system.TimeGenerator{})
+			require.NoError(t, err)
+			got, err := cfg.ResolveDSN(t.Context(), store)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfig_RepoFileIsValid(t *testing.T) {
+	_, err := config.Load("config.yaml")
+	require.NoError(t, err)
+}
+
+func TestRuntimeSettings(t *testing.T) {
+	cfg, err := config.Load("config.yaml")
+	require.NoError(t, err)
+
+	settings := cfg.Runtime()
+	require.NoError(t, settings.Validate())
+	require.Equal(t, cfg, cfg.WithRuntime(settings))
+
+	settings.LogLevel = "error"
+	settings.EntityValidation.MaxNameLength = 7
+	updated := cfg.WithRuntime(settings)
+	require.Equal(t, config.LogLevel("error"), updated.LogLevel)
+	require.Equal(t, 7, updated.Entity.MaxNameLength)
+
+	settings.LogLevel = "loud"
+	settings.PresenceMaxMessagesPerSecond = 0
+	err = settings.Validate()
+	require.ErrorContains(t, err, "log_level")
+	require.ErrorContains(t, err, "presence_max_messages_per_second")
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+)
+
+// RuntimeSettings is the subset of Config that can be changed without a restart,
+// either by SIGHUP (re-reading the config file and env) or via the admin API.
+type RuntimeSettings struct {
+	LogLevel                     LogLevel                `json:"log_level"`
+	UserValidation               user.ValidationConfig   `json:"user_validation"`
+	EntityValidation             entity.ValidationConfig `json:"entity_validation"`
+	PresenceMaxMessagesPerSecond int                     `json:"presence_max_messages_per_second"`
+	Maintenance                  httpx.MaintenanceConfig `json:"maintenance"`
+}
+
+func (c Config) Runtime() RuntimeSettings {
+	return RuntimeSettings{
+		LogLevel:                     c.LogLevel,
+		UserValidation:               c.User.ValidationConfig,
+		EntityValidation:             c.Entity.ValidationConfig,
+		PresenceMaxMessagesPerSecond: c.Presence.MaxMessagesPerSecond,
+		Maintenance:                  c.Maintenance,
+	}
+}
+
+// WithRuntime returns a copy of c with the runtime settings applied.
+func (c Config) WithRuntime(s RuntimeSettings) Config {
+	c.LogLevel = s.LogLevel
+	c.User.ValidationConfig = s.UserValidation
+	c.Entity.ValidationConfig = s.EntityValidation
+	c.Presence.MaxMessagesPerSecond = s.PresenceMaxMessagesPerSecond
+	c.Maintenance = s.Maintenance
+	return c
+}
+
+func (s RuntimeSettings) Validate() error {
+	var errs []error
+	if err := s.LogLevel.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("log_level: %w", err))
+	}
+	if err := s.UserValidation.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("user_validation: %w", err))
+	}
+	if err := s.EntityValidation.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("entity_validation: %w", err))
+	}
+	if s.PresenceMaxMessagesPerSecond <= 0 {
+		errs = append(errs, fmt.Errorf("presence_max_messages_per_second: must be positive"))
+	}
+	if err := s.Maintenance.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("maintenance: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
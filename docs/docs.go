@@ -15,151 +15,202 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/entities": {
+        "/admin/backups": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts writing the users, roles, entities and their versions to an archive in the configured S3-compatible bucket.\nThe backup runs in the background; poll the status endpoint to see how it ends. Only one runs at a time.\nOnly available when backups are configured. Requires admin role in the default workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Start a backup",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/backup.Status"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/backups/status": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the hierarchical tree of all permitted entities.",
+                "description": "Returns the state of the last backup started since the server came up, empty before the first one.\nRequires admin role in the default workspace.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
+                    "admin"
                 ],
-                "summary": "Get full entity tree",
+                "summary": "Get backup status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/entity.Node"
-                            }
+                            "$ref": "#/definitions/backup.Status"
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/consistency": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new entity. Requires write permission for the parent entity. if root entity, requires admin role.",
-                "consumes": [
+                "description": "Lists role grants that give no access of their own: grants of deleted users, grants on deleted entities or on entities below a deleted one, and repeated copies of a grant. Requires admin privileges.",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Report orphaned role grants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.ConsistencyReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/consistency/repair": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the grants GET /admin/consistency lists: grants of deleted users, grants on deleted entities or on entities below a deleted one, and repeated copies of a grant. The grants are removed all or none and returned with repaired set. With dry_run they are only returned. Requires admin privileges.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
+                    "roles"
                 ],
-                "summary": "Create entity",
+                "summary": "Remove orphaned role grants",
                 "parameters": [
                     {
-                        "description": "Create entity payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/usecase.CreateEntityCmd"
-                        }
+                        "type": "boolean",
+                        "description": "Only report the grants that would be removed",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.CreateEntityResp"
+                            "$ref": "#/definitions/auth.ConsistencyReport"
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/entities/{entity_id}": {
+        "/admin/features": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single entity by its ID. Requires read permission.",
+                "description": "Returns every flag declared in the config with the rule in force, its configured default and, if overridden, who changed it and when. Requires operator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
-                ],
-                "summary": "Get entity by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Entity ID",
-                        "name": "entity_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "List feature flags",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.Entity"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/feature.Flag"
+                            }
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/features/{name}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing entity. Requires write permission. If changes parent, requires write permission for the new and old parents as well.",
+                "description": "Replaces the rule of a declared flag on every instance until the override is deleted. The flag is on for everyone if enabled, otherwise for the listed user IDs and a stable percentage of the other users. Requires operator role.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
+                    "admin"
                 ],
-                "summary": "Update entity",
+                "summary": "Override feature flag",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Entity ID",
-                        "name": "entity_id",
+                        "description": "Flag name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update entity payload",
+                        "description": "Rule",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.UpdateEntityInput"
+                            "$ref": "#/definitions/feature.Rule"
                         }
                     }
                 ],
@@ -170,7 +221,7 @@ const docTemplate = `{
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
@@ -181,16 +232,16 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes an entity by ID. Requires write permission for the entity.",
+                "description": "Restores the configured rule of a flag. Requires operator role.",
                 "tags": [
-                    "entities"
+                    "admin"
                 ],
-                "summary": "Delete entity",
+                "summary": "Delete feature flag override",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Entity ID",
-                        "name": "entity_id",
+                        "description": "Flag name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -202,32 +253,32 @@ const docTemplate = `{
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/entities/{entity_id}/versions": {
-            "get": {
+        "/admin/impersonate/{user_id}": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns list of all versions for an entity. Requires read permission.",
+                "description": "Issues a short-lived access token of the user that also names the admin acting as them; requests made with it are logged with both. There is no refresh token. Requires admin privileges and auth.impersonation.enabled.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
+                    "auth"
                 ],
-                "summary": "List entity versions",
+                "summary": "Act as another user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Entity ID",
-                        "name": "entity_id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
@@ -236,225 +287,192 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/entity.Entity"
-                            }
+                            "$ref": "#/definitions/auth.ImpersonationToken"
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/entities/{entity_id}/versions/{version}": {
-            "get": {
+        "/admin/maintenance": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a specific version of an entity. Requires read permission.",
-                "produces": [
+                "description": "Enables or disables read-only maintenance mode, e.g. while migrations run. While it is enabled, requests\nwith mutating methods get 503 with Retry-After, except login, token refresh, logout and the settings endpoints.\nThe state is shown in GET /healthz. It lasts until the next restart or SIGHUP. Requires admin role in the default workspace.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "entities"
+                    "admin"
                 ],
-                "summary": "Get specific entity version",
+                "summary": "Switch maintenance mode",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Entity ID",
-                        "name": "entity_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Version number",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
+                        "description": "Maintenance mode",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/httpx.MaintenanceConfig"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entity.Entity"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Authenticate user and get tokens",
-                "consumes": [
-                    "application/json"
+        "/admin/metrics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns the expvar metrics of the server: build_info, db_pool, http_panics, and the Go runtime memstats.\nRequires admin role in the default workspace.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Login",
-                "parameters": [
-                    {
-                        "description": "credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.LoginInput"
-                        }
-                    }
+                    "meta"
                 ],
+                "summary": "Metrics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/auth.Tokens"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/refresh": {
-            "post": {
-                "description": "Refreshes the access and refresh tokens using a valid refresh token",
-                "consumes": [
-                    "application/json"
+        "/admin/quarantine": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns the uploads the virus scanner rejected, newest first, with the signature found. The files are kept in blob storage under their key until deleted. Requires admin role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Refresh access token",
-                "parameters": [
-                    {
-                        "description": "Refresh token payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.RefreshToken"
-                        }
-                    }
+                    "admin"
                 ],
+                "summary": "List quarantined uploads",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/auth.Tokens"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/quarantine.Upload"
+                            }
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/register": {
-            "post": {
+        "/admin/quarantine/{upload_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes a quarantined upload and its file. Requires admin role.",
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Create user",
+                "summary": "Delete quarantined upload",
                 "parameters": [
                     {
-                        "description": "Create user payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.CreateUserInput"
-                        }
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/roles": {
+        "/admin/role-presets": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a list of roles for the specified user ID. Requires admin privileges or self-access.",
+                "description": "Returns the role presets of the workspace ordered by name. Requires admin privileges.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "roles"
                 ],
-                "summary": "List roles assigned to a user",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
+                "summary": "List role presets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/auth.UserRole"
+                                "$ref": "#/definitions/auth.RolePreset"
                             }
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
@@ -465,113 +483,123 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a role for a user in relation to an entity. Requires admin privileges.",
+                "description": "Creates a named set of grants, such as write on one subtree and read on another. entity_id is empty for the admin role and required for the others; a preset has one role per entity. Requires admin privileges.",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "roles"
                 ],
-                "summary": "Assign role to user",
+                "summary": "Create role preset",
                 "parameters": [
                     {
-                        "description": "User role payload",
+                        "description": "Role preset payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.UserRole"
+                            "$ref": "#/definitions/auth.RolePresetReq"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/auth.RolePreset"
+                        }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/role-presets/{preset_id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a user role assignment for an entity. Requires admin privileges.",
-                "consumes": [
+                "description": "Returns the role preset with its grants. Requires admin privileges.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "roles"
                 ],
-                "summary": "Remove role from user",
+                "summary": "Get role preset",
                 "parameters": [
                     {
-                        "description": "User role payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.UserRole"
-                        }
+                        "type": "string",
+                        "description": "Preset ID",
+                        "name": "preset_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.RolePreset"
+                        }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/sessions": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all active sessions for the specified user. Requires admin privileges or self-access.",
-                "produces": [
+                "description": "Replaces the name and the grants of the preset. Grants already made with the preset are kept. Requires admin privileges.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "sessions"
+                    "roles"
                 ],
-                "summary": "List sessions by user ID",
+                "summary": "Update role preset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Preset ID",
+                        "name": "preset_id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Role preset payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.RolePresetReq"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/auth.Session"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
@@ -582,17 +610,17 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes all active sessions for the specified user. Requires admin privileges or self-access.",
+                "description": "Deletes the preset. Grants already made with the preset are kept. Requires admin privileges.",
                 "tags": [
-                    "sessions"
+                    "roles"
                 ],
-                "summary": "Delete all sessions for user",
+                "summary": "Delete role preset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "Preset ID",
+                        "name": "preset_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -603,140 +631,144 @@ const docTemplate = `{
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/sessions/{session_id}": {
-            "delete": {
+        "/admin/role-presets/{preset_id}/apply": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a specific session for a given user. Requires admin privileges or self-access.",
+                "description": "Grants every role of the preset to every listed user, all or none, and returns the grants made. Grants a user already has are skipped. Requires admin privileges.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "sessions"
+                    "roles"
                 ],
-                "summary": "Delete session by ID",
+                "summary": "Apply role preset to users",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Session ID",
-                        "name": "session_id",
+                        "description": "Preset ID",
+                        "name": "preset_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Users to apply the preset to",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.ApplyPresetReq"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/auth.UserRole"
+                            }
+                        }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/admin/stats": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all users. Requires admin role.",
+                "description": "Returns total users, active sessions, entities by type, documents created and edited per day over the last 30 days, and storage used by uploads.\nThe result is cached for stats.cache_ttl_seconds, see generated_at. Requires admin role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "List users",
+                "summary": "Dashboard stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/user.User"
-                            }
+                            "$ref": "#/definitions/stats.Stats"
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/users/{user_id}": {
+        "/admin/terms": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single user by ID. Requires admin role or self.",
+                "description": "Returns every user of the workspace with the version of the terms of service they accepted last and whether they accepted the current one. Requires admin role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Get user by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Terms of service acceptance",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/terms.Status"
+                            }
                         }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/users/{user_id}/anonymize": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates user's basic fields. Requires admin role or self.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Scrubs the user's email, name and profile while keeping an opaque placeholder for authorship references. Deletes the avatar, the data exports and the IP addresses and user agents of past sign-ins. Revokes sessions and roles. Requires admin role.",
                 "tags": [
                     "users"
                 ],
-                "summary": "Update user",
+                "summary": "Anonymize user",
                 "parameters": [
                     {
                         "type": "string",
@@ -744,15 +776,6 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update user payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.UpdateUserInput"
-                        }
                     }
                 ],
                 "responses": {
@@ -762,265 +785,8246 @@ const docTemplate = `{
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/attachments/{key}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a user by ID. Requires admin role.",
+                "description": "Returns the file stored under the key, such as an icon or a cover set by key, if it was stored in the current workspace. Requires read permission for the entity the attachment belongs to; an attachment that belongs to no entity can only be read by admins. The file is served sandboxed, so HTML and SVG attachments cannot run scripts.",
+                "produces": [
+                    "application/octet-stream"
+                ],
                 "tags": [
-                    "users"
+                    "attachments"
                 ],
-                "summary": "Delete user",
+                "summary": "Download attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Attachment key, e.g. imports/\u003cimport_id\u003e/logo.png",
+                        "name": "key",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/users/{user_id}/password": {
-            "post": {
+        "/config": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Changes password for the specified user (old -\u003e new). Requires admin role or self. If admin changes password, old password is not checked.",
-                "consumes": [
+                "description": "Returns the effective configuration after file, environment overrides, defaults and runtime settings. Secrets are omitted. Requires admin role in the default workspace.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Change user password",
+                "summary": "Get effective config",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/config.Config"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a page of the caller's own drafts across the tree that they can still read, most recently edited first, each with its breadcrumbs from the root.\nPass next_cursor of a page as after to get the next one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "List my drafts",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor: return drafts after this position",
+                        "name": "after",
+                        "in": "query"
                     },
                     {
-                        "description": "Change password payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.ChangePasswordInput"
-                        }
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of drafts, up to 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ListPage"
+                        }
                     },
                     "default": {
                         "description": "Error",
                         "schema": {
-                            "$ref": "#/definitions/apperr.appError"
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apperr.Code": {
-            "type": "string",
-            "enum": [
-                "core/bad_request",
-                "core/unauthorized",
-                "core/forbidden",
-                "core/internal_error"
-            ],
-            "x-enum-varnames": [
-                "CodeBadRequest",
-                "CodeUnauthorized",
-                "CodeForbidden",
-                "CodeInternal"
-            ]
         },
-        "apperr.Field": {
-            "type": "string",
-            "enum": [
-                "request"
-            ],
-            "x-enum-varnames": [
-                "FieldRequest"
-            ]
-        },
-        "apperr.Rule": {
-            "type": "string",
-            "enum": [
-                "required",
-                "too_long",
-                "cycle",
-                "max_hierarchy_depth",
-                "invalid_format",
-                "too_short",
-                "duplicate",
-                "mismatch",
-                "forbidden",
-                "invalid_state",
-                "not_found"
-            ],
-            "x-enum-varnames": [
-                "RuleRequired",
-                "RuleTooLong",
-                "RuleCycle",
-                "RuleMaxHierarchy",
-                "RuleInvalidFormat",
-                "RuleTooShort",
-                "RuleDuplicate",
-                "RuleMismatch",
+        "/entities": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the hierarchical tree of all permitted entities. Every node counts its direct children and all its descendants in the tree, and the caller's own drafts among them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get full entity tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Node"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new entity. Requires write permission for the parent entity. if root entity, requires admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Create entity",
+                "parameters": [
+                    {
+                        "description": "Create entity payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateEntityCmd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateEntityResp"
+                        },
+                        "headers": {
+                            "X-Broken-Links": {
+                                "type": "string",
+                                "description": "Comma-separated IDs of linked entities that do not exist or were deleted"
+                            },
+                            "X-Content-Size-Warning": {
+                                "type": "string",
+                                "description": "Content length and limit in bytes, set when the content is close to the limit"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/batch-get": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Looks up to 100 entities at once, e.g. for link previews, with one permission check. Returns an item per distinct ID in the order requested: its metadata, or the whole entity with include_content, or the problem that kept it out (entity/not_found, core/forbidden). Views are not counted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entities by ID",
+                "parameters": [
+                    {
+                        "description": "Entity IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.BatchGetReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BatchGetResult"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/broken-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns links from entities to entities that do not exist or were deleted. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get broken links report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.BrokenLink"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/by-path/{path}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the entity at a path of slugs from the root, e.g. /entities/by-path/guides/install.\nWith entity.redirect_moved_paths a path the entity was moved or renamed away from answers with\n301, a Location header and the current location in the body. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity by path",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slugs from the root, separated by /",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Entity"
+                        }
+                    },
+                    "301": {
+                        "description": "Moved, see Location",
+                        "schema": {
+                            "$ref": "#/definitions/entity.MovedLocation"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/by-slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single entity by its slug. A former slug of a renamed entity answers with a\n301 redirect to the current one. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Entity"
+                        }
+                    },
+                    "301": {
+                        "description": "Moved to the current slug, see Location"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams every entity of the workspace as JSON Lines, one entity per line, parents before their children. With render=html the content is exported as HTML, as for GET /entities/{entity_id}. Requires admin role.",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Export workspace",
+                "parameters": [
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Content form",
+                        "name": "render",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ExportItem"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a flat page of the entities the caller can read, each with its breadcrumbs from the root and the number of its children and descendants the caller can read.\nPass next_cursor of a page as after, with the same filters and order, to get the next one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "List entities",
+                "parameters": [
+                    {
+                        "enum": [
+                            "article",
+                            "department"
+                        ],
+                        "type": "string",
+                        "description": "Entity type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List only the entities below this one, at any depth",
+                        "name": "parent_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List only the entities updated at or after this RFC 3339 time",
+                        "name": "updated_since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List only the entities created by this user",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "draft",
+                            "published"
+                        ],
+                        "type": "string",
+                        "description": "List only drafts or only published entities",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "updated_at",
+                            "created_at"
+                        ],
+                        "type": "string",
+                        "default": "name",
+                        "description": "Field to order by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Order direction",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor: return entities after this position",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of entities, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ListPage"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/missing-translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the documents lacking a variant in one of the workspace languages, entity.languages, or, if none are configured, in one of the languages variants are written in. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get missing translations report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.MissingTranslation"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/orphaned": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns live entities whose owner was deleted, so ownership can be transferred. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get orphaned entities report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.OrphanedEntity"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/retention/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Dry run of the version retention policy: lists versions the scheduled pruning would delete. Current versions are never deleted. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Preview version retention",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.RetentionReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/stale-drafts/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Dry run of the stale draft policy (entity.stale_drafts): lists the drafts whose creators would be\nreminded now and those that would be archived or deleted. Drafts with children and drafts of users\nwho keep stale drafts in their preferences are left out. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Preview stale draft cleanup",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.StaleDraftsReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/trash/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Dry run of the trash purge: lists deleted entities past entity.trash.retention_days with the bytes\ntheir content and versions would free. Entities with a live descendant are kept. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Preview trash purge",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TrashReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/trash/purge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hard-deletes deleted entities past entity.trash.retention_days (all of them when it is 0) with their\nversions, without waiting for the scheduled purge. With dry_run it only reports them, like the preview.\nRequires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Purge trash now",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only report the entities that would be purged",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TrashReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/unsafe-markup": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns entities whose stored content holds markup the sanitize policy removes when it is exported or served, such as scripts, event handlers or javascript: links. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get unsafe markup report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.UnsafeMarkup"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single entity by its ID. With render=html the content is returned as HTML, rendered from Markdown after the entities it includes with {{include \u003centity_id\u003e}} that the user can read are resolved, its {{variables}} expanded and its markup sanitized. Requires read permission.\nAn entity with language variants is served in the variant the user can read that fits Accept-Language best, unless exact is set; Content-Language names the language served.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Content form",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the entity itself rather than a variant in a preferred language",
+                        "name": "exact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Entity"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language of the entity, if it has one"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing entity. Requires write permission. If changes parent, requires write permission for the new and old parents as well.\nThe optional summary describes the change; it is stored with the new version and shown in the versions\nlist, the history and the activity feed. Drafts create no version, so their summary is dropped.\nWith minor_edit the version is marked as a minor edit and, unless entity.quiet_minor_edits is off, its edit\nis left out of the activity feed.\nA move into another space, under another root or to the top level, fails with 409 unless confirm_cross_space\nis set. The direct grants on the moved entities are then kept, stripped or remapped to the roles held in the\nnew space and the default permissions of the new ancestors as grants says, and the response lists the grants\nrevoked, kept and given. A failed grant change fails the update and the entity is not moved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Update entity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update entity payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateEntityInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Moved into another space",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SpaceMove"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content",
+                        "headers": {
+                            "X-Broken-Links": {
+                                "type": "string",
+                                "description": "Comma-separated IDs of linked entities that do not exist or were deleted"
+                            },
+                            "X-Content-Size-Warning": {
+                                "type": "string",
+                                "description": "Content length and limit in bytes, set when the content is close to the limit"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an entity by ID together with everything below it. Requires write permission for the entity.\nWith dry_run nothing is deleted and the entities that would be are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Delete entity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only report the entities that would be deleted",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dry_run",
+                        "schema": {
+                            "$ref": "#/definitions/entity.DeleteReport"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/activity": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the changes of the entity and its descendants (created, edited, moved, deleted), newest first.\nPass next_cursor of a page as before to get the next one. Drafts of other users are skipped for non-admins. Requires read permission.\nMinor edits are skipped unless include_minor is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity activity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor: next_cursor of the previous page",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of events, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include edits saved as minor edits",
+                        "name": "include_minor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Activity"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/appearance": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the icon and cover image shown in navigation, in the entity, list and tree payloads; omitted fields are left unchanged and an empty string removes one. The icon is an emoji or the key of an attachment stored in the workspace, which always contains a slash; the cover is the key of such an attachment or an http or https image URL. The payloads return icon_url and cover_url to download them from, /attachments/{key} for attachments. Neither is versioned. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Update entity appearance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appearance",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.UpdateAppearanceReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid icon or cover, or no attachment stored under the key",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/backlinks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns readable entities whose content links to this one, via [[entity_id]], an /entities/{entity_id} URL or {{include entity_id}}. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity backlinks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ListItem"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/children": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the direct children of the entity in sibling order: those given a place by PATCH /entities/{entity_id}/children/order first, then the others by name. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity children",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ListItem"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/children/order": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Puts the listed children first, in the given order but after the pinned ones, in the tree and the children list; the children left out follow by name. Every ID must be a live child of the entity. A child moved to another parent loses its place. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Order entity children",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parent entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ordered child IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.ChildrenOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/children/{child_id}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pins the child above its siblings, before the ordered ones, in the tree, the children list and outlines. A parent holds up to entity.max_pinned_children pinned children; a child moved to another parent is unpinned. Requires write permission for the parent.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Pin a child",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parent entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Child entity ID",
+                        "name": "child_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "409": {
+                        "description": "Pin limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the child to its place among its siblings. Requires write permission for the parent.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Unpin a child",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Parent entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Child entity ID",
+                        "name": "child_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/contributors": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the users who authored any version of the entity with their version counts and last contribution time, most recent first. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity contributors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Contributor"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/default-permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the roles granted to users on every entity created below the entity. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity default permissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.DefaultPermission"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the default permissions of the entity. When an entity is created below it, at any depth, each user is granted the read or write role on the new entity, unless an ancestor grant already gives it; the policies of all ancestors apply. Entities created before keep their grants. An empty list removes the policy. Requires admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Set entity default permissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Default permissions",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SetDefaultPermissionsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/draft": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the current user's autosave of the entity. Requires write permission.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Discard entity autosave",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stores the content as the current user's autosave of the entity, without creating a version. Saving the entity removes it; until then the entity is returned with it. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Autosave entity content",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Autosave payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.AutosaveInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Autosave"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the entity and its readable descendants as JSON Lines, one entity per line, parents before their children. With render=html the content is exported as HTML, as for GET /entities/{entity_id}. Requires read permission.",
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Export entity subtree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Content form",
+                        "name": "render",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ExportItem"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the versions of an entity, without content, merged with its moves, permission changes and\nother events, newest first. The type of an item tells whether version or event is set. Pass\nnext_cursor of a page as before to get the next one. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor: return items older than this position",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of items, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.History"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/language": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the language of the entity. An entity without variants starts a document of its own that variants can be linked to; with variants, the new language may not be taken by one of them. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Set entity language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Language",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SetLanguageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "409": {
+                        "description": "Language variant already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the language of the entity, which unlinks it from its variants; they stay linked to each other. Requires write permission.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Remove entity language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Entity has no language",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/lint": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns what the content linter found in a version of the entity, the current one by default. Versions are linted in the background shortly after they are saved, so a new version is pending at first; drafts are not linted. Findings are ranked error, warning or info as configured. The status is disabled when linting is turned off for the entity or an ancestor. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get lint findings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version, the current one if omitted",
+                        "name": "version",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.LintReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Entity or version not found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/lint/settings": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables or enables content linting for the entity and all its descendants. Findings already stored are kept but not shown while linting is disabled. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Set lint settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lint settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.LintSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/lock": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the active edit lock of the entity, 404 when it is not locked. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Lock"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes a soft edit lock, or extends the one the current user already holds. While the lock is active, updates by other users fail with 423 and the lock owner in the error params. Requires write permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Lock entity for editing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Lock"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked by another user",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/manual": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the entity and its readable descendants as one document: the entity as the title, a table of contents, then the descendants depth-first as chapters numbered like 2.1, with their content rendered from Markdown. Links to entities of the manual point to their chapter. format is html, the default, or pdf when a converter is configured. Requires read permission.",
+                "produces": [
+                    "text/html",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity manual",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "description": "Document format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Merges content edited from base_version with a later version of the entity, by default the current one, line by line. Lines only one side changed take that change; lines both changed differently are kept between \"\u003c\u003c\u003c\u003c\u003c\u003c\u003c mine\", \"=======\" and \"\u003e\u003e\u003e\u003e\u003e\u003e\u003e theirs\" markers and counted in conflicts. Nothing is saved. Requires read permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Merge concurrent edits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.MergeInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.MergeResult"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/meta": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns word count, estimated reading time, version count, children count, views and the most recent editors. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Meta"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/owner": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes another user the owner of the entity. The new owner must be an existing user that was not deleted. Requires write permission for the entity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Transfer entity ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New owner",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.TransferOwnershipInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/relations/{related_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks two entities as related pages. The relation is symmetric and shows up in the related list of both; adding it again is a no-op. Requires write permission for both entities.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Relate two entities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related entity ID",
+                        "name": "related_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the relation from both entities, whichever side it was added from. Requires write permission for both entities.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Remove a relation between two entities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related entity ID",
+                        "name": "related_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Entities are not related",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the settings of the space the root entity opens: default language, review requirements, public listing and retention. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get space settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Root entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Space"
+                        }
+                    },
+                    "400": {
+                        "description": "Entity has a parent",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the settings of the space the root entity opens. Requires admin role or ownership of the root.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Set space settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Root entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Space settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SpaceSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SpaceSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Entity has a parent or the settings are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Neither admin nor owner",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/snapshots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the snapshots taken of the entity, newest first, with how many entities each captured. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "List entity snapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Snapshot"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Captures the current version of the entity and of its published descendants under a label, such as a product release. Drafts and the entities below them are left out. Captured versions are kept by retention. Labels are unique per entity and may contain letters, digits, dots, dashes and underscores. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Create entity snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Snapshot label",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.CreateSnapshotReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Snapshot"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/snapshots/{label}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the subtree of the entity as of the snapshot with the label: every captured entity at its captured version, with its name, parent and content at that version, parents before their children. Entities deleted since are included and flagged. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SnapshotContent"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/toc": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the entity and its readable descendants as a nested outline, children in sibling order, each with the section headings of its content: Markdown (# and underlined) and HTML \u003ch1\u003e-\u003ch6\u003e headings outside code blocks, nested by level, with an anchor each. For navigation and printable manuals. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity outline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TOCNode"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Releases the lock held by the current user. Admins can release any lock. Requires write permission.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Release entity lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "423": {
+                        "description": "Locked by another user",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/variables": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the variables content of the entity can refer to as {{key}}, by key: those it defines and those it inherits from the nearest ancestor defining them, see entity_id of each. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity variables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Variable"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/variables/{key}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Defines or changes a variable for the entity and its descendants, overriding one of the same key inherited from an ancestor. Values may refer to other variables. Keys start with a letter or _ and may contain letters, digits, dots, dashes and underscores. Requires write permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Set entity variable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Variable key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variable value",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SetVariableReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Variable"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a variable the entity defines; descendants then inherit the key from an ancestor, if one defines it. Requires write permission.",
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Delete entity variable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Variable key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/variants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the language variants of the entity the user can read, itself included, by language; none if the entity has no language. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity language variants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Variant"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a translation of the entity next to it, with the same type, and links it as the variant in the given language. The entity must have a language. Requires write permission for the entity and its parent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Create entity language variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateVariantCmd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateEntityResp"
+                        },
+                        "headers": {
+                            "X-Broken-Links": {
+                                "type": "string",
+                                "description": "Comma-separated IDs of linked entities that do not exist or were deleted"
+                            },
+                            "X-Content-Size-Warning": {
+                                "type": "string",
+                                "description": "Content length and limit in bytes, set when the content is close to the limit"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Entity has no language",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Language variant already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/variants/{variant_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes an existing entity the variant of the entity in the given language, replacing a language it had. The entity must have a language, and the variant may not belong to another document. Requires write permission for both entities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Link entity language variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Variant entity ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Language of the variant",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SetLanguageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Entity has no language",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Language variant already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the versions of an entity, newest first, without their content unless include_content is set.\nPass next_cursor of a page as before to get the next one. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "List entity versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor: next_cursor of the previous page",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of versions, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the content of every version",
+                        "name": "include_content",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.VersionsPage"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a specific version of an entity. Requires read permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get specific entity version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Entity"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/entities/{entity_id}/versions/{version}/content": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the content of a specific version of an entity as it was stored. Requires read permission.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get entity version content",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Version content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/features": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the names of the feature flags that are on for the current user, ordered by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "features"
+                ],
+                "summary": "My feature flags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.MyFlagsOutput"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the invitations of the workspace, newest first, with their status: pending, accepted or expired. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "List invitations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/invitation.Invitation"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an invitation for the email with optional roles granted on registration, and emails its link when mail and invitation.accept_url are configured. The token is returned only here; a new invitation replaces the pending ones of the same email. Requires admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Invite a user",
+                "parameters": [
+                    {
+                        "description": "Invitation payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/invitation.CreateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/invitation.Created"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations/{invitation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an invitation that is not accepted yet, so its link stops working. Requires admin role.",
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Revoke invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "invitation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticate user and get tokens. scope limits the session, e.g. \"entities:read\"; without it the tokens have every scope. With an LDAP directory configured, email is the directory login, such as a uid, and the user is created on the first sign-in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.LoginInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CAPTCHA token, once captcha.login asks for one",
+                        "name": "X-Captcha-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.Tokens"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends the session of the access token, so its refresh token can no longer be used. The access token itself stays valid until it expires.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/problems": {
+            "get": {
+                "description": "Lists every problem type the API can return, with its title and HTTP status. Error responses are\napplication/problem+json objects whose type is one of these URIs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "Error catalog",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/apperr.ProblemType"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Refreshes the access and refresh tokens using a valid refresh token. scope may narrow the access token to part of the scope requested at login. With device binding, a refresh from another user agent or IP address than the login ends the session (auth/device_mismatch) or flags it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.RefreshInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.Tokens"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an account for the caller. Fails with 403 when user.registration disables self-registration or does not allow the email domain.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register",
+                "parameters": [
+                    {
+                        "description": "Create user payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateUserInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CAPTCHA token, when captcha.register is set",
+                        "name": "X-Captcha-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/register/invite/{token}": {
+            "post": {
+                "description": "Creates the account of the invitee with the email of the invitation and grants its roles, all or none. An invitation is accepted once and until it expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invitations"
+                ],
+                "summary": "Register with an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Account payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_app_invitation_transport_http.AcceptInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.AcceptOutput"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/popular": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the most read entities of the workspace, by views over the period. A user reading an entity several times in one session counts once. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "entities"
+                ],
+                "summary": "Get popular entities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "30d",
+                        "description": "Days or hours to look back, such as 30d or 12h, up to 365d",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of entities, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.PopularReport"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a list of roles for the specified user ID. Requires admin privileges or self-access.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "List roles assigned to a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/auth.UserRole"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a role for a user in relation to an entity. Requires admin privileges.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Assign role to user",
+                "parameters": [
+                    {
+                        "description": "User role payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.UserRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a user role assignment for an entity. Requires admin privileges.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Remove role from user",
+                "parameters": [
+                    {
+                        "description": "User role payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.UserRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all active sessions for the specified user. Requires admin privileges or self-access.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "List sessions by user ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/auth.Session"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes all active sessions for the specified user. Requires admin privileges or self-access.",
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Delete all sessions for user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/sessions/{session_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a specific session for a given user. Requires admin privileges or self-access.",
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "Delete session by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns settings that can be changed without a restart. Requires admin role in the default workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get runtime settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/config.RuntimeSettings"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces runtime settings (log level, validation limits, rate limits) without a restart.\nChanges last until the next restart or SIGHUP, which re-read the config file. Requires admin role in the default workspace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Update runtime settings",
+                "parameters": [
+                    {
+                        "description": "Runtime settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/config.RuntimeSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/terms": {
+            "get": {
+                "description": "Returns the version of the terms of service users must accept and where they are published. The version is empty when there are none.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "terms"
+                ],
+                "summary": "Current terms of service",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/terms.Terms"
+                        }
+                    }
+                }
+            }
+        },
+        "/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns users with the most requests over the last hours, with bytes received and sent.\nUsage is aggregated per hour and flushed periodically, so the latest requests may be missing. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Top API consumers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 24,
+                        "description": "Period in hours, counting the current one",
+                        "name": "hours",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum number of users",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usage.Consumer"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the users, oldest first: all of them or, with limit, a page. The X-Next-Cursor header of a page is the after value of the next one. Deleted users are left out unless include_deleted is set. Requires admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Also return deleted users",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, up to 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "after",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/user.User"
+                            }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page, set unless this is the last page"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a user regardless of user.registration and returns its ID. Requires admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create user",
+                "parameters": [
+                    {
+                        "description": "Create user payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateUserInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateUserResp"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/accept-terms": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that the current user accepted the terms of service. The version must be the current one; until it is accepted, the rest of the API answers 403 terms/not_accepted. Not allowed while impersonating.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "terms"
+                ],
+                "summary": "Accept terms of service",
+                "parameters": [
+                    {
+                        "description": "Accepted version",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_app_terms_transport_http.AcceptInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/terms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the version of the terms of service the current user accepted last and whether they accepted the current one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "terms"
+                ],
+                "summary": "My terms of service status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/terms.Status"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single user by ID. Requires admin role or self.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.User"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates user's basic fields. Requires admin role or self.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateUserInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a user by ID. Requires admin role.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/avatar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's avatar image. Available to any authenticated user.",
+                "produces": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif",
+                    "image/webp"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the user's avatar with the raw request body. PNG, JPEG, GIF and WebP images are accepted. Requires admin role or self.",
+                "consumes": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif",
+                    "image/webp"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Upload user avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Image",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the user's avatar. Requires admin role or self.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the export of everything stored about the user: profile, sessions, role grants and the versions they authored. Without a current export a new one is requested and made in the background; poll until the status is ready, then fetch download_url. Requires admin role or self.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export personal data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ready",
+                        "schema": {
+                            "$ref": "#/definitions/dataexport.Export"
+                        }
+                    },
+                    "202": {
+                        "description": "Pending or running",
+                        "schema": {
+                            "$ref": "#/definitions/dataexport.Export"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/export/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the ready export of the user as a zip of JSON files, described by manifest.json. Requires admin role or self.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Download personal data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/login-history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the latest successful and failed sign-ins of the user, newest first. new_device marks a sign-in from a user agent or IP address the user had not signed in from before. Requires admin privileges or self-access.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sessions"
+                ],
+                "summary": "List sign-in attempts of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of attempts",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/auth.LoginEvent"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes password for the specified user (old -\u003e new). Requires admin role or self. If admin changes password, old password is not checked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change user password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change password payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ChangePasswordInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's preferences in the current schema version, or the defaults if none were saved. Requires admin role or self.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.Preferences"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the user's preferences. Missing fields take their defaults, unknown fields are rejected. Documents of older schema versions are upgraded. Requires admin role or self.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Replace user preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preferences",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.Preferences"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.Preferences"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/profile": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the given profile fields; omitted fields are left unchanged. Timezone is an IANA name, locale a BCP 47 tag. Requires admin role or self.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update profile payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateProfileInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Returns the version, commit and build date of the server, the Go version it was built with and\nthe active config profile.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "Build info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/buildinfo.Info"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all workspaces ordered by slug. Requires admin role in the default workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspaces"
+                ],
+                "summary": "List workspaces",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/workspace.Workspace"
+                            }
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an empty workspace. Its first admin is added with seedadmin or easygodocsctl. Requires admin role in the default workspace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspaces"
+                ],
+                "summary": "Create workspace",
+                "parameters": [
+                    {
+                        "description": "Create workspace payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateWorkspaceInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateWorkspaceResp"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces/{workspace_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a workspace by ID. Requires admin role in the default workspace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspaces"
+                ],
+                "summary": "Get workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/workspace.Workspace"
+                        }
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the name of a workspace; the slug is fixed. Requires admin role in the default workspace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspaces"
+                ],
+                "summary": "Rename workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update workspace payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateWorkspaceInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft-deletes a workspace: its data is kept but no request can reach it. The default workspace cannot be deleted. Requires admin role in the default workspace.",
+                "tags": [
+                    "workspaces"
+                ],
+                "summary": "Delete workspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspace_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upgrades to a WebSocket and joins the presence room of the entity. Requires read permission; switching to the \"editing\" state requires write permission.\nBrowsers may pass the access token in the access_token query parameter.\nClient messages: {\"type\":\"state\",\"state\":\"viewing|editing\"}, {\"type\":\"cursor\",\"position\":N}, {\"type\":\"typing\"}.",
+                "tags": [
+                    "presence"
+                ],
+                "summary": "Join entity presence channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token (alternative to the Authorization header)",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "default": {
+                        "description": "Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apperr.Code": {
+            "type": "string",
+            "enum": [
+                "core/bad_request",
+                "core/unauthorized",
+                "core/forbidden",
+                "core/internal_error",
+                "core/not_found",
+                "core/method_not_allowed",
+                "core/request_too_large",
+                "core/maintenance"
+            ],
+            "x-enum-varnames": [
+                "CodeBadRequest",
+                "CodeUnauthorized",
+                "CodeForbidden",
+                "CodeInternal",
+                "CodeNotFound",
+                "CodeMethodNotAllowed",
+                "CodeTooLarge",
+                "CodeMaintenance"
+            ]
+        },
+        "apperr.Field": {
+            "type": "string",
+            "enum": [
+                "request"
+            ],
+            "x-enum-varnames": [
+                "FieldRequest"
+            ]
+        },
+        "apperr.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/apperr.Code"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apperr.Violation"
+                    }
+                }
+            }
+        },
+        "apperr.ProblemType": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/apperr.Code"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "apperr.Rule": {
+            "type": "string",
+            "enum": [
+                "required",
+                "too_long",
+                "cycle",
+                "max_hierarchy_depth",
+                "invalid_format",
+                "too_short",
+                "duplicate",
+                "mismatch",
+                "forbidden",
+                "invalid_state",
+                "not_found",
+                "out_of_range",
+                "locked"
+            ],
+            "x-enum-varnames": [
+                "RuleRequired",
+                "RuleTooLong",
+                "RuleCycle",
+                "RuleMaxHierarchy",
+                "RuleInvalidFormat",
+                "RuleTooShort",
+                "RuleDuplicate",
+                "RuleMismatch",
                 "RuleForbidden",
                 "RuleInvalidState",
-                "RuleNotFound"
+                "RuleNotFound",
+                "RuleOutOfRange",
+                "RuleLocked"
+            ]
+        },
+        "apperr.Violation": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "$ref": "#/definitions/apperr.Field"
+                },
+                "message": {
+                    "description": "Message is a human-readable description in the client's language, filled by the transport.",
+                    "type": "string"
+                },
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "rule": {
+                    "$ref": "#/definitions/apperr.Rule"
+                }
+            }
+        },
+        "auth.ApplyPresetReq": {
+            "type": "object",
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "auth.ClaimsConfig": {
+            "type": "object",
+            "properties": {
+                "custom": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "max_roles": {
+                    "type": "integer"
+                },
+                "roles": {
+                    "type": "boolean"
+                },
+                "tenant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.Client": {
+            "type": "object",
+            "properties": {
+                "ip": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.Config": {
+            "type": "object",
+            "properties": {
+                "accept_legacy_tokens": {
+                    "type": "boolean"
+                },
+                "access_token_ttl_minutes": {
+                    "type": "integer"
+                },
+                "audience": {
+                    "type": "string"
+                },
+                "claims": {
+                    "$ref": "#/definitions/auth.ClaimsConfig"
+                },
+                "device_binding": {
+                    "$ref": "#/definitions/auth.DeviceBindingConfig"
+                },
+                "impersonation": {
+                    "$ref": "#/definitions/auth.ImpersonationConfig"
+                },
+                "issuer": {
+                    "description": "Issuer and Audience are put in access tokens and checked on every request, so tokens of one\nenvironment are not accepted in another. AcceptLegacyTokens accepts tokens without them, to\nkeep sessions working while the tokens issued before they were set expire.",
+                    "type": "string"
+                },
+                "leeway_seconds": {
+                    "description": "LeewaySeconds is how far the clocks of the servers may be apart when checking the times of tokens.",
+                    "type": "integer"
+                },
+                "remember_me_session_ttl_minutes": {
+                    "description": "RememberMeSessionTTLMinutes is the session TTL of logins with remember_me, meant for personal devices.",
+                    "type": "integer"
+                },
+                "session_ttl_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "auth.ConsistencyReport": {
+            "type": "object",
+            "properties": {
+                "orphaned_grants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.OrphanedGrant"
+                    }
+                },
+                "repaired": {
+                    "description": "Repaired is set when the orphaned grants listed have been removed.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "auth.DeviceBindingConfig": {
+            "type": "object",
+            "properties": {
+                "match_ip": {
+                    "type": "boolean"
+                },
+                "mode": {
+                    "$ref": "#/definitions/auth.DeviceBindingMode"
+                }
+            }
+        },
+        "auth.DeviceBindingMode": {
+            "type": "string",
+            "enum": [
+                "off",
+                "flag",
+                "strict"
+            ],
+            "x-enum-varnames": [
+                "DeviceBindingOff",
+                "DeviceBindingFlag",
+                "DeviceBindingStrict"
+            ]
+        },
+        "auth.ImpersonationConfig": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "token_ttl_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "auth.ImpersonationToken": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginEvent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "new_device": {
+                    "type": "boolean"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_agent": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.OrphanReason": {
+            "type": "string",
+            "enum": [
+                "user_deleted",
+                "entity_deleted",
+                "unreachable",
+                "duplicate"
+            ],
+            "x-enum-varnames": [
+                "OrphanUserDeleted",
+                "OrphanEntityDeleted",
+                "OrphanUnreachable",
+                "OrphanDuplicate"
+            ]
+        },
+        "auth.OrphanedGrant": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/auth.OrphanReason"
+                },
+                "role": {
+                    "$ref": "#/definitions/auth.Role"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.PresetGrant": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/auth.Role"
+                }
+            }
+        },
+        "auth.RefreshToken": {
+            "type": "object",
+            "properties": {
+                "session_id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.Role": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "read",
+                "write"
+            ],
+            "x-enum-varnames": [
+                "RoleAdmin",
+                "RoleRead",
+                "RoleWrite"
+            ]
+        },
+        "auth.RolePreset": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "grants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.PresetGrant"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RolePresetReq": {
+            "type": "object",
+            "properties": {
+                "grants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.PresetGrant"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.Scope": {
+            "type": "string",
+            "enum": [
+                "entities:read",
+                "entities:write",
+                "users:admin"
+            ],
+            "x-enum-varnames": [
+                "ScopeEntitiesRead",
+                "ScopeEntitiesWrite",
+                "ScopeUsersAdmin"
+            ]
+        },
+        "auth.Session": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "description": "Client is where the session was created; empty for sessions older than device binding.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.Client"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "flagged_at": {
+                    "description": "FlaggedAt is when the session was first refreshed from another client, see DeviceBindingConfig.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scopes were requested at login and limit every token of the session; empty is full scope.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.Scope"
+                    }
+                },
+                "session_version": {
+                    "type": "integer"
+                },
+                "ttl_class": {
+                    "description": "TTLClass tells which session TTL applies, at login and on every refresh.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.TTLClass"
+                        }
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.TTLClass": {
+            "type": "string",
+            "enum": [
+                "default",
+                "remember_me"
+            ],
+            "x-enum-varnames": [
+                "TTLClassDefault",
+                "TTLClassRememberMe"
+            ]
+        },
+        "auth.Tokens": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "$ref": "#/definitions/auth.RefreshToken"
+                }
+            }
+        },
+        "auth.UserRole": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/auth.Role"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "backup.Config": {
+            "type": "object",
+            "properties": {
+                "omit_password_hashes": {
+                    "type": "boolean"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "s3": {
+                    "$ref": "#/definitions/s3.Config"
+                }
+            }
+        },
+        "backup.State": {
+            "type": "string",
+            "enum": [
+                "running",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "StateRunning",
+                "StateSucceeded",
+                "StateFailed"
+            ]
+        },
+        "backup.Status": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/backup.State"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backup.Table"
+                    }
+                }
+            }
+        },
+        "backup.Table": {
+            "type": "object",
+            "properties": {
+                "blanked": {
+                    "description": "Blanked are the columns written as empty strings instead of their values.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "blob.Config": {
+            "type": "object",
+            "properties": {
+                "dir": {
+                    "type": "string"
+                }
+            }
+        },
+        "buildinfo.Info": {
+            "type": "object",
+            "properties": {
+                "build_date": {
+                    "type": "string"
+                },
+                "commit": {
+                    "type": "string"
+                },
+                "go_version": {
+                    "type": "string"
+                },
+                "profile": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "captcha.Config": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "$ref": "#/definitions/captcha.LoginConfig"
+                },
+                "min_score": {
+                    "description": "MinScore is the lowest reCAPTCHA v3 score accepted; v2 tokens and other providers have no score.",
+                    "type": "number"
+                },
+                "provider": {
+                    "description": "Provider is recaptcha, hcaptcha or turnstile; empty disables CAPTCHAs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/captcha.Provider"
+                        }
+                    ]
+                },
+                "register": {
+                    "type": "boolean"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "captcha.LoginConfig": {
+            "type": "object",
+            "properties": {
+                "after_failures": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "window_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "captcha.Provider": {
+            "type": "string",
+            "enum": [
+                "recaptcha",
+                "hcaptcha",
+                "turnstile"
+            ],
+            "x-enum-varnames": [
+                "ProviderRecaptcha",
+                "ProviderHCaptcha",
+                "ProviderTurnstile"
+            ]
+        },
+        "config.Config": {
+            "type": "object",
+            "properties": {
+                "app_name": {
+                    "type": "string"
+                },
+                "auth": {
+                    "$ref": "#/definitions/auth.Config"
+                },
+                "backup": {
+                    "$ref": "#/definitions/backup.Config"
+                },
+                "blob": {
+                    "$ref": "#/definitions/blob.Config"
+                },
+                "captcha": {
+                    "$ref": "#/definitions/captcha.Config"
+                },
+                "data_export": {
+                    "$ref": "#/definitions/dataexport.Config"
+                },
+                "database_pool": {
+                    "$ref": "#/definitions/db.PoolConfig"
+                },
+                "entity": {
+                    "$ref": "#/definitions/config.EntityConfig"
+                },
+                "error_report": {
+                    "$ref": "#/definitions/errreport.Config"
+                },
+                "feature": {
+                    "$ref": "#/definitions/feature.Config"
+                },
+                "idempotency": {
+                    "$ref": "#/definitions/idempotency.Config"
+                },
+                "invitation": {
+                    "$ref": "#/definitions/invitation.Config"
+                },
+                "ldap": {
+                    "$ref": "#/definitions/ldap.Config"
+                },
+                "lint": {
+                    "$ref": "#/definitions/lint.Config"
+                },
+                "log_level": {
+                    "$ref": "#/definitions/config.LogLevel"
+                },
+                "mail": {
+                    "$ref": "#/definitions/mail.Config"
+                },
+                "maintenance": {
+                    "$ref": "#/definitions/httpx.MaintenanceConfig"
+                },
+                "max_body_size": {
+                    "type": "integer"
+                },
+                "pdf": {
+                    "$ref": "#/definitions/pdf.Config"
+                },
+                "port": {
+                    "type": "string"
+                },
+                "presence": {
+                    "$ref": "#/definitions/presence.Config"
+                },
+                "profile": {
+                    "type": "string"
+                },
+                "public": {
+                    "$ref": "#/definitions/public.Config"
+                },
+                "sanitize": {
+                    "$ref": "#/definitions/sanitize.Config"
+                },
+                "scan": {
+                    "$ref": "#/definitions/scan.Config"
+                },
+                "secrets": {
+                    "$ref": "#/definitions/secrets.Config"
+                },
+                "stats": {
+                    "$ref": "#/definitions/stats.Config"
+                },
+                "sync": {
+                    "$ref": "#/definitions/gitsync.Config"
+                },
+                "terms": {
+                    "$ref": "#/definitions/terms.Config"
+                },
+                "usage": {
+                    "$ref": "#/definitions/usage.Config"
+                },
+                "user": {
+                    "$ref": "#/definitions/config.UserConfig"
+                },
+                "workspace": {
+                    "$ref": "#/definitions/workspace.Config"
+                }
+            }
+        },
+        "config.EntityConfig": {
+            "type": "object",
+            "properties": {
+                "content_warning_percent": {
+                    "description": "ContentWarningPercent is the share of the limit above which writes still succeed but are flagged; 0 disables it.",
+                    "type": "integer"
+                },
+                "cross_space_grants": {
+                    "description": "CrossSpaceGrants is what a move into another space does with the grants on the moved entities unless\nthe move names a policy itself; empty keeps them.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GrantPolicy"
+                        }
+                    ]
+                },
+                "encryption": {
+                    "$ref": "#/definitions/entity.EncryptionConfig"
+                },
+                "forbidden_name_chars_by_type": {
+                    "description": "ForbiddenNameCharsByType overrides NameRules.ForbiddenChars for some entity types.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "languages": {
+                    "description": "Languages are the languages documents are translated into. Variants may only use them and the missing\ntranslations report checks for them; empty allows any language.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "lint": {
+                    "$ref": "#/definitions/entity.LintConfig"
+                },
+                "lock_ttl_minutes": {
+                    "type": "integer"
+                },
+                "max_content_length": {
+                    "description": "MaxContentLength limits content in bytes; MaxContentLengthByType overrides it for some entity types.",
+                    "type": "integer"
+                },
+                "max_content_length_by_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "max_hierarchy_depth": {
+                    "type": "integer"
+                },
+                "max_name_length": {
+                    "type": "integer"
+                },
+                "max_pinned_children": {
+                    "description": "MaxPinnedChildren caps the children pinned under one parent; 0 means no limit.",
+                    "type": "integer"
+                },
+                "max_summary_length": {
+                    "description": "MaxSummaryLength limits the change summary of an update in characters.",
+                    "type": "integer"
+                },
+                "name_rules": {
+                    "$ref": "#/definitions/text.NameRules"
+                },
+                "quiet_minor_edits": {
+                    "description": "QuietMinorEdits leaves edits saved as minor edits out of the activity feed unless it is asked for them.\nWithout it the flag is only stored with the version.",
+                    "type": "boolean"
+                },
+                "recursive_hierarchy": {
+                    "description": "RecursiveHierarchy walks parent_id with recursive queries instead of reading the materialized paths.\nIt is slower on deep trees but does not rely on the paths, so it is the fallback while they are suspect.",
+                    "type": "boolean"
+                },
+                "redirect_moved_paths": {
+                    "description": "RedirectMovedPaths resolves slug paths an entity was moved or renamed away from to its current path.",
+                    "type": "boolean"
+                },
+                "reject_broken_links": {
+                    "description": "RejectBrokenLinks lists the entity types whose content must not link to missing or deleted\nentities. Other types are saved and the broken links reported.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Type"
+                    }
+                },
+                "retention": {
+                    "$ref": "#/definitions/entity.RetentionConfig"
+                },
+                "stale_drafts": {
+                    "$ref": "#/definitions/entity.StaleDraftsConfig"
+                },
+                "trash": {
+                    "$ref": "#/definitions/entity.TrashConfig"
+                },
+                "unique_sibling_names": {
+                    "description": "UniqueSiblingNames rejects a name already used under the same parent, ignoring case and repeated spaces.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "config.LogLevel": {
+            "type": "string",
+            "enum": [
+                "debug",
+                "info",
+                "warn",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "logLevelDebug",
+                "logLevelInfo",
+                "logLevelWarn",
+                "logLevelError"
+            ]
+        },
+        "config.RuntimeSettings": {
+            "type": "object",
+            "properties": {
+                "entity_validation": {
+                    "$ref": "#/definitions/entity.ValidationConfig"
+                },
+                "log_level": {
+                    "$ref": "#/definitions/config.LogLevel"
+                },
+                "maintenance": {
+                    "$ref": "#/definitions/httpx.MaintenanceConfig"
+                },
+                "presence_max_messages_per_second": {
+                    "type": "integer"
+                },
+                "user_validation": {
+                    "$ref": "#/definitions/user.ValidationConfig"
+                }
+            }
+        },
+        "config.UserConfig": {
+            "type": "object",
+            "properties": {
+                "argon2": {
+                    "$ref": "#/definitions/secure.Argon2Params"
+                },
+                "max_avatar_bytes": {
+                    "type": "integer"
+                },
+                "max_bio_length": {
+                    "type": "integer"
+                },
+                "max_email_length": {
+                    "type": "integer"
+                },
+                "max_name_length": {
+                    "type": "integer"
+                },
+                "max_password_length": {
+                    "type": "integer"
+                },
+                "min_password_length": {
+                    "type": "integer"
+                },
+                "name_rules": {
+                    "description": "NameRules apply to the account name; the display name is only trimmed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/text.NameRules"
+                        }
+                    ]
+                },
+                "password_hash_algorithm": {
+                    "$ref": "#/definitions/secure.Algorithm"
+                },
+                "password_hash_cost": {
+                    "type": "integer"
+                },
+                "registration": {
+                    "$ref": "#/definitions/user.RegistrationConfig"
+                }
+            }
+        },
+        "dataexport.Config": {
+            "type": "object",
+            "properties": {
+                "interval_seconds": {
+                    "type": "integer"
+                },
+                "ttl_hours": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dataexport.Export": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "description": "DownloadURL is where the archive is downloaded from, once it is ready.",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "RequestedBy is the user who asked for it, the user or an admin.",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/dataexport.Status"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dataexport.Status": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "ready",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "StatusPending",
+                "StatusRunning",
+                "StatusReady",
+                "StatusFailed"
+            ]
+        },
+        "db.PoolConfig": {
+            "type": "object",
+            "properties": {
+                "check_interval_seconds": {
+                    "type": "integer"
+                },
+                "conn_max_idle_time_minutes": {
+                    "type": "integer"
+                },
+                "conn_max_lifetime_minutes": {
+                    "type": "integer"
+                },
+                "max_idle_conns": {
+                    "type": "integer"
+                },
+                "max_open_conns": {
+                    "type": "integer"
+                },
+                "saturation_warn_percent": {
+                    "description": "SaturationWarnPercent of MaxOpenConns in use, checked every CheckIntervalSeconds, logs a warning.",
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Activity": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Event"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Autosave": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "saved_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BatchGetItem": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "$ref": "#/definitions/entity.Entity"
+                },
+                "error": {
+                    "$ref": "#/definitions/apperr.Problem"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "item": {
+                    "$ref": "#/definitions/entity.ListItem"
+                }
+            }
+        },
+        "entity.BatchGetReq": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "include_content": {
+                    "description": "IncludeContent returns the full entities; without it only their metadata is returned.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.BatchGetResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.BatchGetItem"
+                    }
+                }
+            }
+        },
+        "entity.Breadcrumb": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BrokenLink": {
+            "type": "object",
+            "properties": {
+                "source_id": {
+                    "type": "string"
+                },
+                "source_name": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ChildrenOrderReq": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entity.Contributor": {
+            "type": "object",
+            "properties": {
+                "last_contributed_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "version_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CreateSnapshotReq": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DefaultPermission": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "Role is read or write; the admin role is global.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.Role"
+                        }
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DeleteReport": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DeletedEntity"
+                    }
+                },
+                "hidden": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.DeletedEntity": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                }
+            }
+        },
+        "entity.Editor": {
+            "type": "object",
+            "properties": {
+                "edited_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.EncryptionConfig": {
+            "type": "object",
+            "properties": {
+                "batch_size": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "interval_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Entity": {
+            "type": "object",
+            "properties": {
+                "autosave": {
+                    "description": "Autosave is the current user's autosave of the entity, for reads of the entity itself.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Autosave"
+                        }
+                    ]
+                },
+                "content": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "current_version": {
+                    "type": "integer"
+                },
+                "icon": {
+                    "description": "Icon and Cover are not versioned either, see UpdateAppearanceReq. IconURL and CoverURL are where\nthey are downloaded from, see AppearanceURL.",
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "description": "Language is the language of the entity and Variants the variants of its document the current user\ncan read, itself included, for reads of the entity itself.",
+                    "type": "string"
+                },
+                "minor_edit": {
+                    "description": "MinorEdit is set on versions saved as minor edits.",
+                    "type": "boolean"
+                },
+                "moved": {
+                    "description": "Moved is set on versions that changed the parent; MovedFrom is the parent before, nil for the root.",
+                    "type": "boolean"
+                },
+                "moved_from": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "description": "OwnerID is the user accountable for the entity, the creator until ownership is transferred.\nIt is not versioned, so versions leave it empty.",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "related": {
+                    "description": "Related are the related pages the current user can read, for reads of the entity itself.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ListItem"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "Summary is the change summary the version was saved with, set on versions.",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Variant"
+                    }
+                }
+            }
+        },
+        "entity.Event": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_name": {
+                    "type": "string"
+                },
+                "from_parent_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "minor_edit": {
+                    "description": "MinorEdit is set on edits of a version saved as a minor edit.",
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "subject_id": {
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "Summary is the change summary of the version the event recorded, if it was given one.",
+                    "type": "string"
+                },
+                "to_parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.EventType"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.EventType": {
+            "type": "string",
+            "enum": [
+                "created",
+                "edited",
+                "moved",
+                "deleted",
+                "role_granted",
+                "role_revoked"
+            ],
+            "x-enum-varnames": [
+                "EventCreated",
+                "EventEdited",
+                "EventMoved",
+                "EventDeleted",
+                "EventRoleGranted",
+                "EventRoleRevoked"
+            ]
+        },
+        "entity.ExportItem": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_draft": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned and SortOrder place the item among its siblings, see ListItem.",
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the current version, zero for drafts.",
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Grant": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/auth.Role"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.GrantPolicy": {
+            "type": "string",
+            "enum": [
+                "keep",
+                "strip",
+                "remap"
+            ],
+            "x-enum-varnames": [
+                "GrantsKeep",
+                "GrantsStrip",
+                "GrantsRemap"
+            ]
+        },
+        "entity.Heading": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "description": "Anchor is the id of an HTML heading, or else derived from the text like GitHub does:\nlower case, spaces to dashes, punctuation dropped, and -1, -2... for repeats in the document.",
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Heading"
+                    }
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.History": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.HistoryItem"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.HistoryItem": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/entity.Event"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.HistoryItemType"
+                },
+                "version": {
+                    "$ref": "#/definitions/entity.Entity"
+                }
+            }
+        },
+        "entity.HistoryItemType": {
+            "type": "string",
+            "enum": [
+                "version",
+                "move",
+                "permission",
+                "audit"
+            ],
+            "x-enum-varnames": [
+                "HistoryVersion",
+                "HistoryMove",
+                "HistoryPermission",
+                "HistoryAudit"
+            ]
+        },
+        "entity.LintConfig": {
+            "type": "object",
+            "properties": {
+                "default_severity": {
+                    "$ref": "#/definitions/entity.LintSeverity"
+                },
+                "severities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.LintSeverity"
+                    }
+                }
+            }
+        },
+        "entity.LintFinding": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "replacements": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/entity.LintSeverity"
+                }
+            }
+        },
+        "entity.LintReport": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.LintFinding"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/entity.LintStatus"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.LintSettings": {
+            "type": "object",
+            "properties": {
+                "disabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.LintSeverity": {
+            "type": "string",
+            "enum": [
+                "error",
+                "warning",
+                "info",
+                "off"
+            ],
+            "x-enum-varnames": [
+                "LintError",
+                "LintWarning",
+                "LintInfo",
+                "LintOff"
+            ]
+        },
+        "entity.LintStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "done",
+                "failed",
+                "disabled"
+            ],
+            "x-enum-varnames": [
+                "LintPending",
+                "LintRunning",
+                "LintDone",
+                "LintFailed",
+                "LintDisabled"
+            ]
+        },
+        "entity.ListEntry": {
+            "type": "object",
+            "properties": {
+                "breadcrumbs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Breadcrumb"
+                    }
+                },
+                "children_count": {
+                    "description": "ChildrenCount and SubtreeSize count the direct children and all descendants the caller can list, as\nNode does in the tree.",
+                    "type": "integer"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "Icon and Cover are set with UpdateAppearance, see UpdateAppearanceReq. IconURL and CoverURL are where\nthey are downloaded from, see AppearanceURL.",
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_draft": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned and SortOrder place the entity among its siblings, see compareSiblings; a SortOrder of 0\nmeans unordered.",
+                    "type": "boolean"
+                },
+                "reading_time_minutes": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "subtree_size": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ListItem": {
+            "type": "object",
+            "properties": {
+                "cover": {
+                    "type": "string"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "Icon and Cover are set with UpdateAppearance, see UpdateAppearanceReq. IconURL and CoverURL are where\nthey are downloaded from, see AppearanceURL.",
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned and SortOrder place the entity among its siblings, see compareSiblings; a SortOrder of 0\nmeans unordered.",
+                    "type": "boolean"
+                },
+                "reading_time_minutes": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ListPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ListEntry"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Lock": {
+            "type": "object",
+            "properties": {
+                "acquired_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.MergeResult": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "theirs_version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Meta": {
+            "type": "object",
+            "properties": {
+                "children_count": {
+                    "type": "integer"
+                },
+                "last_editors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Editor"
+                    }
+                },
+                "reading_time_minutes": {
+                    "type": "integer"
+                },
+                "version_count": {
+                    "type": "integer"
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.MissingTranslation": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "string"
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Variant"
+                    }
+                }
+            }
+        },
+        "entity.MovedLocation": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "parent_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entity.Node": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Node"
+                    }
+                },
+                "children_count": {
+                    "description": "ChildrenCount and SubtreeSize count the direct children and all descendants in the tree, i.e. those\nthe caller can see, so clients can draw expanders without walking Children.",
+                    "type": "integer"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "draft_count": {
+                    "description": "DraftCount counts the caller's own drafts among the descendants, so clients can point to unpublished work.",
+                    "type": "integer"
+                },
+                "icon": {
+                    "description": "Icon and Cover are set with UpdateAppearance, see UpdateAppearanceReq. IconURL and CoverURL are where\nthey are downloaded from, see AppearanceURL.",
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned and SortOrder place the entity among its siblings, see compareSiblings; a SortOrder of 0\nmeans unordered.",
+                    "type": "boolean"
+                },
+                "reading_time_minutes": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "subtree_size": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.OrphanedEntity": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_deleted_at": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PopularEntity": {
+            "type": "object",
+            "properties": {
+                "cover": {
+                    "type": "string"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "Icon and Cover are set with UpdateAppearance, see UpdateAppearanceReq. IconURL and CoverURL are where\nthey are downloaded from, see AppearanceURL.",
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned and SortOrder place the entity among its siblings, see compareSiblings; a SortOrder of 0\nmeans unordered.",
+                    "type": "boolean"
+                },
+                "reading_time_minutes": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "views": {
+                    "type": "integer"
+                },
+                "word_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.PopularReport": {
+            "type": "object",
+            "properties": {
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PopularEntity"
+                    }
+                },
+                "since": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PurgedEntity": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "version_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.RetentionConfig": {
+            "type": "object",
+            "properties": {
+                "interval_minutes": {
+                    "type": "integer"
+                },
+                "keep_days": {
+                    "type": "integer"
+                },
+                "keep_last_versions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.RetentionReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "policy": {
+                    "$ref": "#/definitions/entity.RetentionConfig"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.VersionRef"
+                    }
+                }
+            }
+        },
+        "entity.ReviewSettings": {
+            "type": "object",
+            "properties": {
+                "no_minor_edits": {
+                    "description": "NoMinorEdits rejects updates saved as minor edits, so every change shows up in the activity feed.",
+                    "type": "boolean"
+                },
+                "require_summary": {
+                    "description": "RequireSummary rejects updates without a change summary.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.SetDefaultPermissionsReq": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DefaultPermission"
+                    }
+                }
+            }
+        },
+        "entity.SetLanguageReq": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SetVariableReq": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Snapshot": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "entity_count": {
+                    "description": "EntityCount is how many entities of the subtree the snapshot captured.",
+                    "type": "integer"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SnapshotContent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "entity_count": {
+                    "description": "EntityCount is how many entities of the subtree the snapshot captured.",
+                    "type": "integer"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.SnapshotItem"
+                    }
+                },
+                "label": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SnapshotItem": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "description": "IsDeleted reports that the entity was deleted after the snapshot was taken.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Space": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "settings": {
+                    "$ref": "#/definitions/entity.SpaceSettings"
+                }
+            }
+        },
+        "entity.SpaceMove": {
+            "type": "object",
+            "properties": {
+                "from_space_id": {
+                    "type": "string"
+                },
+                "granted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Grant"
+                    }
+                },
+                "grants": {
+                    "$ref": "#/definitions/entity.GrantPolicy"
+                },
+                "kept": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Grant"
+                    }
+                },
+                "revoked": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Grant"
+                    }
+                },
+                "to_space_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SpaceRetention": {
+            "type": "object",
+            "properties": {
+                "keep_days": {
+                    "type": "integer"
+                },
+                "keep_last_versions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.SpaceSettings": {
+            "type": "object",
+            "properties": {
+                "default_language": {
+                    "description": "DefaultLanguage is the language of content in the space without a language of its own; the linter\nchecks such content in it.",
+                    "type": "string"
+                },
+                "public": {
+                    "description": "Public lists the published entities of the space in the sitemap and the feed, like the roots in\npublic.entity_ids.",
+                    "type": "boolean"
+                },
+                "retention": {
+                    "description": "Retention replaces entity.retention for the versions in the space.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SpaceRetention"
+                        }
+                    ]
+                },
+                "review": {
+                    "$ref": "#/definitions/entity.ReviewSettings"
+                }
+            }
+        },
+        "entity.StaleDraft": {
+            "type": "object",
+            "properties": {
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.StaleDraftAction": {
+            "type": "string",
+            "enum": [
+                "archive",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "StaleDraftArchive",
+                "StaleDraftDelete"
+            ]
+        },
+        "entity.StaleDraftsConfig": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/entity.StaleDraftAction"
+                },
+                "cleanup_days": {
+                    "type": "integer"
+                },
+                "interval_minutes": {
+                    "type": "integer"
+                },
+                "notify_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.StaleDraftsReport": {
+            "type": "object",
+            "properties": {
+                "cleaned_up": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StaleDraft"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "policy": {
+                    "$ref": "#/definitions/entity.StaleDraftsConfig"
+                },
+                "reminded": {
+                    "description": "Reminded are the drafts whose creators were reminded; an email that could not be sent is retried\non the next run, so its drafts are not listed.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StaleDraft"
+                    }
+                }
+            }
+        },
+        "entity.TOCNode": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TOCNode"
+                    }
+                },
+                "headings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Heading"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_draft": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entity.Type"
+                }
+            }
+        },
+        "entity.TrashConfig": {
+            "type": "object",
+            "properties": {
+                "interval_minutes": {
+                    "type": "integer"
+                },
+                "retention_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TrashReport": {
+            "type": "object",
+            "properties": {
+                "cutoff": {
+                    "type": "string"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PurgedEntity"
+                    }
+                },
+                "hidden_drafts": {
+                    "type": "integer"
+                },
+                "reclaimable_bytes": {
+                    "type": "integer"
+                },
+                "retention_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Type": {
+            "type": "string",
+            "enum": [
+                "article",
+                "department"
+            ],
+            "x-enum-varnames": [
+                "TypeArticle",
+                "TypeDepartment"
+            ]
+        },
+        "entity.UnsafeMarkup": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.UpdateAppearanceReq": {
+            "type": "object",
+            "properties": {
+                "cover": {
+                    "description": "Cover is the key of an attachment stored in the workspace or an http or https URL of an image.",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "Icon is an emoji or the key of an attachment stored in the workspace, e.g. imports/\u003cimport_id\u003e/logo.png;\nkeys always contain a slash, emoji never do.",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ValidationConfig": {
+            "type": "object",
+            "properties": {
+                "content_warning_percent": {
+                    "description": "ContentWarningPercent is the share of the limit above which writes still succeed but are flagged; 0 disables it.",
+                    "type": "integer"
+                },
+                "forbidden_name_chars_by_type": {
+                    "description": "ForbiddenNameCharsByType overrides NameRules.ForbiddenChars for some entity types.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "max_content_length": {
+                    "description": "MaxContentLength limits content in bytes; MaxContentLengthByType overrides it for some entity types.",
+                    "type": "integer"
+                },
+                "max_content_length_by_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "max_name_length": {
+                    "type": "integer"
+                },
+                "max_summary_length": {
+                    "description": "MaxSummaryLength limits the change summary of an update in characters.",
+                    "type": "integer"
+                },
+                "name_rules": {
+                    "$ref": "#/definitions/text.NameRules"
+                },
+                "reject_broken_links": {
+                    "description": "RejectBrokenLinks lists the entity types whose content must not link to missing or deleted\nentities. Other types are saved and the broken links reported.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Type"
+                    }
+                }
+            }
+        },
+        "entity.Variable": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "description": "EntityID is the entity that defines the variable, the entity itself or one of its ancestors.",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Variant": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.VersionRef": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.VersionsPage": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Entity"
+                    }
+                }
+            }
+        },
+        "errreport.Config": {
+            "type": "object",
+            "properties": {
+                "environment": {
+                    "type": "string"
+                },
+                "release": {
+                    "description": "Release defaults to the build version, or the VCS revision of a development build.",
+                    "type": "string"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "feature.Config": {
+            "type": "object",
+            "properties": {
+                "flags": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/feature.Rule"
+                    }
+                },
+                "refresh_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "feature.Flag": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "$ref": "#/definitions/feature.Rule"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rule": {
+                    "$ref": "#/definitions/feature.Rule"
+                },
+                "source": {
+                    "$ref": "#/definitions/feature.Source"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "feature.Rule": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "percentage": {
+                    "description": "Percentage of users, from 0 to 100, chosen by a hash of the flag name and the user ID, so a\nuser keeps their place as the percentage grows and flags are rolled out to different users.",
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "feature.Source": {
+            "type": "string",
+            "enum": [
+                "config",
+                "override"
+            ],
+            "x-enum-varnames": [
+                "SourceConfig",
+                "SourceOverride"
+            ]
+        },
+        "gitrepo.Config": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "committer_email": {
+                    "type": "string"
+                },
+                "committer_name": {
+                    "type": "string"
+                },
+                "dir": {
+                    "type": "string"
+                }
+            }
+        },
+        "gitsync.Config": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "git": {
+                    "$ref": "#/definitions/gitrepo.Config"
+                },
+                "interval_seconds": {
+                    "type": "integer"
+                },
+                "root_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.AcceptOutput": {
+            "type": "object",
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.AutosaveInput": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.ChangePasswordInput": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CreateEntityResp": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CreateUserInput": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CreateUserResp": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CreateWorkspaceInput": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CreateWorkspaceResp": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.LoginInput": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "remember_me": {
+                    "description": "RememberMe keeps the session for the longer TTL, for personal devices rather than shared ones.",
+                    "type": "boolean"
+                },
+                "scope": {
+                    "description": "Scope is a space-separated list of scopes, e.g. \"entities:read\"; empty requests every scope.",
+                    "type": "string"
+                }
+            }
+        },
+        "http.MergeInput": {
+            "type": "object",
+            "properties": {
+                "base_version": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "theirs_version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "http.MyFlagsOutput": {
+            "type": "object",
+            "properties": {
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "http.RefreshInput": {
+            "type": "object",
+            "properties": {
+                "scope": {
+                    "description": "Scope narrows the new access token to part of the session's scope; empty keeps all of it.",
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.TransferOwnershipInput": {
+            "type": "object",
+            "properties": {
+                "owner_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.UpdateEntityInput": {
+            "type": "object",
+            "properties": {
+                "confirm_cross_space": {
+                    "description": "ConfirmCrossSpace must be set to move the entity under another root, or to make it a root.",
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "grants": {
+                    "description": "Grants is what such a move does with the grants on the moved entities: keep, strip or remap.\nEmpty uses entity.cross_space_grants.",
+                    "enum": [
+                        "keep",
+                        "strip",
+                        "remap"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GrantPolicy"
+                        }
+                    ]
+                },
+                "is_draft": {
+                    "type": "boolean"
+                },
+                "minor_edit": {
+                    "description": "MinorEdit marks a change such as a typo fix that is left out of the activity feed.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "Summary is an optional description of the change, kept with the version it creates.",
+                    "type": "string"
+                }
+            }
+        },
+        "http.UpdateProfileInput": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.UpdateUserInput": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.UpdateWorkspaceInput": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "httpx.MaintenanceConfig": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "retry_after_seconds": {
+                    "description": "RetryAfterSeconds is sent in the Retry-After header of rejected writes.",
+                    "type": "integer"
+                }
+            }
+        },
+        "idempotency.Config": {
+            "type": "object",
+            "properties": {
+                "ttl_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_app_invitation_transport_http.AcceptInput": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_app_terms_transport_http.AcceptInput": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "invitation.Config": {
+            "type": "object",
+            "properties": {
+                "accept_url": {
+                    "description": "AcceptURL is the page of the web client that accepts invitations; the token is appended to it.",
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "ttl_hours": {
+                    "type": "integer"
+                }
+            }
+        },
+        "invitation.CreateReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/invitation.Role"
+                    }
+                }
+            }
+        },
+        "invitation.Created": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_sent": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/invitation.Role"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/invitation.Status"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID is the user who registered with the invitation.",
+                    "type": "string"
+                }
+            }
+        },
+        "invitation.Invitation": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/invitation.Role"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/invitation.Status"
+                },
+                "user_id": {
+                    "description": "UserID is the user who registered with the invitation.",
+                    "type": "string"
+                }
+            }
+        },
+        "invitation.Role": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/auth.Role"
+                }
+            }
+        },
+        "invitation.Status": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "accepted",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "StatusPending",
+                "StatusAccepted",
+                "StatusExpired"
             ]
         },
-        "apperr.Violation": {
+        "ldap.AttributesConfig": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "ldap.Config": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "$ref": "#/definitions/ldap.AttributesConfig"
+                },
+                "base_dn": {
+                    "type": "string"
+                },
+                "bind_dn": {
+                    "description": "BindDN and BindPassword are the service account entries are searched with; empty binds\nanonymously.",
+                    "type": "string"
+                },
+                "link_existing_users": {
+                    "description": "LinkExistingUsers lets the directory sign in a local user with the same email, except an admin;\notherwise such sign-ins are refused.",
+                    "type": "boolean"
+                },
+                "local_fallback": {
+                    "description": "LocalFallback lets logins the directory does not have sign in with their local password.",
+                    "type": "boolean"
+                },
+                "login_attribute": {
+                    "description": "LoginAttribute is matched against what users type as their login, e.g. mail, uid or\nsAMAccountName.",
+                    "type": "string"
+                },
+                "start_tls": {
+                    "description": "StartTLS upgrades an ldap:// connection before anything is sent.",
+                    "type": "boolean"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                },
+                "url": {
+                    "description": "URL is ldap://host[:port] or ldaps://host[:port]; empty disables the directory.",
+                    "type": "string"
+                }
+            }
+        },
+        "lint.Config": {
+            "type": "object",
+            "properties": {
+                "interval_seconds": {
+                    "type": "integer"
+                },
+                "language": {
+                    "description": "Language is used for content without a language of its own; auto lets the server detect it.",
+                    "type": "string"
+                },
+                "languagetool_url": {
+                    "description": "LanguageToolURL is the base URL of a LanguageTool server, e.g. http://languagetool:8010; empty\ndisables linting.",
+                    "type": "string"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "mail.Config": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "smtp_addr": {
+                    "description": "SMTPAddr is the host:port of the SMTP server mail is sent through; empty disables sending.",
+                    "type": "string"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "Username and Password sign in with PLAIN auth, which is only used over TLS or to localhost.",
+                    "type": "string"
+                }
+            }
+        },
+        "pdf.Config": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "description": "Command is the program and its arguments, e.g. [\"wkhtmltopdf\", \"--quiet\", \"-\", \"-\"].",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presence.Config": {
+            "type": "object",
+            "properties": {
+                "allowed_origins": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "max_message_bytes": {
+                    "type": "integer"
+                },
+                "max_messages_per_second": {
+                    "type": "integer"
+                },
+                "max_room_size": {
+                    "type": "integer"
+                },
+                "ping_interval_seconds": {
+                    "type": "integer"
+                },
+                "send_buffer_size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "public.Config": {
+            "type": "object",
+            "properties": {
+                "base_url": {
+                    "type": "string"
+                },
+                "cache_ttl_seconds": {
+                    "type": "integer"
+                },
+                "entity_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "feed_size": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "quarantine.Source": {
+            "type": "string",
+            "enum": [
+                "avatar",
+                "confluence"
+            ],
+            "x-enum-varnames": [
+                "SourceAvatar",
+                "SourceConfluence"
+            ]
+        },
+        "quarantine.Upload": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quarantined_at": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/quarantine.Source"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "s3.Config": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "path_style": {
+                    "type": "boolean"
+                },
+                "region": {
+                    "type": "string"
+                }
+            }
+        },
+        "sanitize.Config": {
+            "type": "object",
+            "properties": {
+                "allowed_attributes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "allowed_schemes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "allowed_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "scan.Config": {
+            "type": "object",
+            "properties": {
+                "clamav_addr": {
+                    "description": "ClamAVAddr is the host:port clamd listens on; empty disables scanning.",
+                    "type": "string"
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "secrets.Config": {
+            "type": "object",
+            "properties": {
+                "dir": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "refresh_seconds": {
+                    "type": "integer"
+                },
+                "vault": {
+                    "$ref": "#/definitions/secrets.VaultConfig"
+                }
+            }
+        },
+        "secrets.VaultConfig": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "mount": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "secure.Algorithm": {
+            "type": "string",
+            "enum": [
+                "bcrypt",
+                "argon2id"
+            ],
+            "x-enum-varnames": [
+                "AlgorithmBcrypt",
+                "AlgorithmArgon2id"
+            ]
+        },
+        "secure.Argon2Params": {
             "type": "object",
             "properties": {
-                "field": {
-                    "$ref": "#/definitions/apperr.Field"
+                "iterations": {
+                    "type": "integer"
                 },
-                "params": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "memory_kib": {
+                    "type": "integer"
                 },
-                "rule": {
-                    "$ref": "#/definitions/apperr.Rule"
+                "parallelism": {
+                    "type": "integer"
                 }
             }
         },
-        "apperr.appError": {
+        "stats.Config": {
             "type": "object",
             "properties": {
-                "code": {
-                    "$ref": "#/definitions/apperr.Code"
+                "cache_ttl_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.DailyActivity": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
                 },
-                "message": {
-                    "description": "Message for user",
+                "day": {
                     "type": "string"
                 },
-                "violations": {
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "stats.Stats": {
+            "type": "object",
+            "properties": {
+                "active_sessions": {
+                    "type": "integer"
+                },
+                "daily": {
+                    "description": "Daily has one entry per UTC day, oldest first, including days without activity.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/apperr.Violation"
+                        "$ref": "#/definitions/stats.DailyActivity"
+                    }
+                },
+                "entities_by_type": {
+                    "description": "EntitiesByType counts entities that are not deleted, drafts included.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
                     }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "storage_bytes": {
+                    "description": "StorageBytes is the size of uploaded files. Avatars are the only uploads so far.",
+                    "type": "integer"
+                },
+                "total_users": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.RefreshToken": {
+        "terms.Config": {
             "type": "object",
             "properties": {
-                "session_id": {
+                "url": {
                     "type": "string"
                 },
-                "token": {
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "auth.Role": {
-            "type": "string",
-            "enum": [
-                "admin",
-                "read",
-                "write"
-            ],
-            "x-enum-varnames": [
-                "RoleAdmin",
-                "RoleRead",
-                "RoleWrite"
-            ]
-        },
-        "auth.Session": {
+        "terms.Status": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "accepted": {
+                    "description": "Accepted tells whether the user accepted the version in force, which is always the case without terms.",
+                    "type": "boolean"
+                },
+                "accepted_at": {
                     "type": "string"
                 },
-                "expires_at": {
+                "accepted_version": {
+                    "description": "AcceptedVersion is the version the user accepted last, empty if none.",
                     "type": "string"
                 },
-                "id": {
+                "email": {
                     "type": "string"
                 },
-                "session_version": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "auth.Tokens": {
+        "terms.Terms": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "url": {
                     "type": "string"
                 },
-                "refresh_token": {
-                    "$ref": "#/definitions/auth.RefreshToken"
+                "version": {
+                    "type": "string"
                 }
             }
         },
-        "auth.UserRole": {
+        "text.NameRules": {
             "type": "object",
             "properties": {
-                "entity_id": {
+                "collapse_spaces": {
+                    "description": "CollapseSpaces replaces every run of whitespace inside the name by a single space.",
+                    "type": "boolean"
+                },
+                "forbidden_chars": {
+                    "description": "ForbiddenChars are the characters a name must not contain.",
                     "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/auth.Role"
+                "nfc": {
+                    "description": "NFC composes the name to Unicode Normalization Form C, so names typed on different systems\ncompare equal.",
+                    "type": "boolean"
                 },
-                "user_id": {
-                    "type": "string"
+                "strip_control": {
+                    "description": "StripControl removes control characters such as NUL, escapes or line breaks. With CollapseSpaces\nthe whitespace ones become a space first.",
+                    "type": "boolean"
                 }
             }
         },
-        "entity.Entity": {
+        "usage.Config": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string"
+                "flush_interval_seconds": {
+                    "type": "integer"
                 },
-                "created_at": {
-                    "type": "string"
+                "max_report_hours": {
+                    "type": "integer"
                 },
-                "created_by": {
-                    "type": "string"
+                "max_report_limit": {
+                    "type": "integer"
                 },
-                "current_version": {
+                "quota_requests_per_hour": {
+                    "description": "QuotaRequestsPerHour of 0 disables enforcement; usage is tracked anyway.",
+                    "type": "integer"
+                }
+            }
+        },
+        "usage.Consumer": {
+            "type": "object",
+            "properties": {
+                "bytes_in": {
                     "type": "integer"
                 },
-                "id": {
+                "bytes_out": {
+                    "type": "integer"
+                },
+                "requests": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateEntityCmd": {
+            "type": "object",
+            "properties": {
+                "content": {
                     "type": "string"
                 },
+                "is_draft": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1029,151 +9033,204 @@ const docTemplate = `{
                 },
                 "type": {
                     "$ref": "#/definitions/entity.Type"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "updated_by": {
-                    "type": "string"
                 }
             }
         },
-        "entity.Node": {
+        "usecase.CreateVariantCmd": {
             "type": "object",
             "properties": {
-                "children": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Node"
-                    }
-                },
-                "id": {
+                "content": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "is_draft": {
+                    "type": "boolean"
                 },
-                "parent_id": {
+                "language": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/entity.Type"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "entity.Type": {
+        "user.DigestFrequency": {
             "type": "string",
             "enum": [
-                "article",
-                "department"
+                "none",
+                "daily",
+                "weekly"
             ],
             "x-enum-varnames": [
-                "TypeArticle",
-                "TypeDepartment"
+                "DigestNone",
+                "DigestDaily",
+                "DigestWeekly"
             ]
         },
-        "http.ChangePasswordInput": {
+        "user.DraftPreferences": {
             "type": "object",
             "properties": {
-                "new_password": {
-                    "type": "string"
-                },
-                "old_password": {
-                    "type": "string"
+                "keep_stale": {
+                    "description": "KeepStale opts the user's drafts out of the stale draft policy: they are neither reminded about nor\ncleaned up however long they are left untouched.",
+                    "type": "boolean"
                 }
             }
         },
-        "http.CreateEntityResp": {
+        "user.NotificationPreferences": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "comments": {
+                    "type": "boolean"
+                },
+                "digest": {
+                    "$ref": "#/definitions/user.DigestFrequency"
+                },
+                "email": {
+                    "type": "boolean"
+                },
+                "mentions": {
+                    "type": "boolean"
                 }
             }
         },
-        "http.CreateUserInput": {
+        "user.Preferences": {
             "type": "object",
             "properties": {
-                "email": {
+                "default_space_id": {
+                    "description": "root entity opened on start; may no longer exist",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "drafts": {
+                    "$ref": "#/definitions/user.DraftPreferences"
                 },
-                "password": {
-                    "type": "string"
+                "notifications": {
+                    "$ref": "#/definitions/user.NotificationPreferences"
+                },
+                "schema_version": {
+                    "type": "integer"
+                },
+                "theme": {
+                    "$ref": "#/definitions/user.Theme"
                 }
             }
         },
-        "http.LoginInput": {
+        "user.RegistrationConfig": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "allowed_domains": {
+                    "description": "AllowedDomains limits registration to emails on these domains, compared without case.\nSubdomains are not included; empty allows every domain.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "password": {
-                    "type": "string"
+                "enabled": {
+                    "type": "boolean"
                 }
             }
         },
-        "http.UpdateEntityInput": {
+        "user.Theme": {
+            "type": "string",
+            "enum": [
+                "system",
+                "light",
+                "dark"
+            ],
+            "x-enum-varnames": [
+                "ThemeSystem",
+                "ThemeLight",
+                "ThemeDark"
+            ]
+        },
+        "user.User": {
             "type": "object",
             "properties": {
-                "content": {
+                "anonymized_at": {
+                    "description": "AnonymizedAt is when the personal data of the user was scrubbed, see AnonymizedName.",
                     "type": "string"
                 },
-                "is_draft": {
-                    "type": "boolean"
+                "avatar_url": {
+                    "type": "string"
                 },
-                "name": {
+                "bio": {
                     "type": "string"
                 },
-                "parent_id": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "http.UpdateUserInput": {
-            "type": "object",
-            "properties": {
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "BCP 47 tag, e.g. en-US",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "session_version": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA name, e.g. Europe/Berlin",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "usecase.CreateEntityCmd": {
+        "user.ValidationConfig": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string"
+                "max_avatar_bytes": {
+                    "type": "integer"
                 },
-                "is_draft": {
-                    "type": "boolean"
+                "max_bio_length": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "max_email_length": {
+                    "type": "integer"
                 },
-                "parent_id": {
-                    "type": "string"
+                "max_name_length": {
+                    "type": "integer"
                 },
-                "type": {
-                    "$ref": "#/definitions/entity.Type"
+                "max_password_length": {
+                    "type": "integer"
+                },
+                "min_password_length": {
+                    "type": "integer"
+                },
+                "name_rules": {
+                    "description": "NameRules apply to the account name; the display name is only trimmed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/text.NameRules"
+                        }
+                    ]
                 }
             }
         },
-        "user.User": {
+        "workspace.Config": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
+                "base_domain": {
                     "type": "string"
-                },
-                "email": {
+                }
+            }
+        },
+        "workspace.Workspace": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
@@ -1182,8 +9239,8 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "session_version": {
-                    "type": "integer"
+                "slug": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
@@ -0,0 +1,15 @@
+package admin
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const CodeInvalidSettings apperr.Code = "admin/invalid_settings"
+
+func init() {
+	apperr.Register(CodeInvalidSettings, "Invalid settings", apperr.ClassBadRequest)
+}
+
+// ErrInvalidSettings carries the validation problem to the user: the endpoint is admin-only.
+func ErrInvalidSettings(problem string) error {
+	return apperr.New("Invalid settings", CodeInvalidSettings, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithUserMessage("Invalid settings: " + problem)
+}
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type Service interface {
+	GetConfig() config.Config
+	GetSettings() config.RuntimeSettings
+	UpdateSettings(ctx context.Context, req config.RuntimeSettings) error
+	SetMaintenance(ctx context.Context, req httpx.MaintenanceConfig) error
+}
+
+// Handler serves administrative endpoints.
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("admin HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// GetConfig godoc
+// @Summary      Get effective config
+// @Description  Returns the effective configuration after file, environment overrides, defaults and runtime settings. Secrets are omitted. Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} config.Config
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /config [get]
+func (h *Handler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(r.Context(), w, http.StatusOK, h.svc.GetConfig())
+}
+
+// GetSettings godoc
+// @Summary      Get runtime settings
+// @Description  Returns settings that can be changed without a restart. Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} config.RuntimeSettings
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /settings [get]
+func (h *Handler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(r.Context(), w, http.StatusOK, h.svc.GetSettings())
+}
+
+// UpdateSettings godoc
+// @Summary      Update runtime settings
+// @Description  Replaces runtime settings (log level, validation limits, rate limits) without a restart.
+// @Description  Changes last until the next restart or SIGHUP, which re-read the config file. Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Accept       json
+// @Param        request body config.RuntimeSettings true "Runtime settings"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /settings [put]
+func (h *Handler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req config.RuntimeSettings
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("admin.Handler.UpdateSettings: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err := h.svc.UpdateSettings(ctx, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetMaintenance godoc
+// @Summary      Switch maintenance mode
+// @Description  Enables or disables read-only maintenance mode, e.g. while migrations run. While it is enabled, requests
+// @Description  with mutating methods get 503 with Retry-After, except login, token refresh, logout and the settings endpoints.
+// @Description  The state is shown in GET /healthz. It lasts until the next restart or SIGHUP. Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Accept       json
+// @Param        request body httpx.MaintenanceConfig true "Maintenance mode"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/maintenance [put]
+func (h *Handler) SetMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req httpx.MaintenanceConfig
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("admin.Handler.SetMaintenance: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err := h.svc.SetMaintenance(ctx, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,167 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/app/admin"
+	admin_http "github.com/66gu1/easygodocs/internal/app/admin/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/admin/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{Port: "8080", DatabaseDSN: "dsn", JWTSecret: "secret"}
+	svcMock := mocks.NewServiceMock(t)
+	svcMock.GetConfigMock.Return(cfg)
+	h := admin_http.NewHandler(svcMock)
+
+	r := httptest.NewRequest(http.MethodGet, "/config", nil)
+	w := httptest.NewRecorder()
+	h.GetConfig(w, r)
+
+	res := w.Result()
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	require.Equal(t, "8080", body["port"])
+	require.NotContains(t, body, "database_dsn")
+	require.NotContains(t, body, "jwt_secret")
+}
+
+func TestHandler_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := config.RuntimeSettings{LogLevel: "debug", PresenceMaxMessagesPerSecond: 3}
+	body, err := json.Marshal(settings)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		body       []byte
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "ok",
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdateSettingsMock.Expect(minimock.AnyContext, settings).Return(nil)
+			},
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       []byte("{"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown field -> 400",
+			body:       []byte(`{"port":"1"}`),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error",
+			body:       body,
+			wantStatus: http.StatusForbidden,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdateSettingsMock.Expect(minimock.AnyContext, settings).Return(apperr.ErrForbidden())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := admin_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodPut, "/settings", bytes.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.UpdateSettings(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		})
+	}
+}
+
+func TestHandler_SetMaintenance(t *testing.T) {
+	t.Parallel()
+
+	req := httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 60}
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		body       []byte
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "ok",
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.SetMaintenanceMock.Expect(minimock.AnyContext, req).Return(nil)
+			},
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       []byte("{"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid settings -> 400",
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.SetMaintenanceMock.Expect(minimock.AnyContext, req).Return(admin.ErrInvalidSettings("retry_after_seconds must be positive"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svcMock := mocks.NewServiceMock(t)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := admin_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodPut, "/admin/maintenance", bytes.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.SetMaintenance(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,1169 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/admin/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetConfig          func() (c1 config.Config)
+	funcGetConfigOrigin    string
+	inspectFuncGetConfig   func()
+	afterGetConfigCounter  uint64
+	beforeGetConfigCounter uint64
+	GetConfigMock          mServiceMockGetConfig
+
+	funcGetSettings          func() (r1 config.RuntimeSettings)
+	funcGetSettingsOrigin    string
+	inspectFuncGetSettings   func()
+	afterGetSettingsCounter  uint64
+	beforeGetSettingsCounter uint64
+	GetSettingsMock          mServiceMockGetSettings
+
+	funcSetMaintenance          func(ctx context.Context, req httpx.MaintenanceConfig) (err error)
+	funcSetMaintenanceOrigin    string
+	inspectFuncSetMaintenance   func(ctx context.Context, req httpx.MaintenanceConfig)
+	afterSetMaintenanceCounter  uint64
+	beforeSetMaintenanceCounter uint64
+	SetMaintenanceMock          mServiceMockSetMaintenance
+
+	funcUpdateSettings          func(ctx context.Context, req config.RuntimeSettings) (err error)
+	funcUpdateSettingsOrigin    string
+	inspectFuncUpdateSettings   func(ctx context.Context, req config.RuntimeSettings)
+	afterUpdateSettingsCounter  uint64
+	beforeUpdateSettingsCounter uint64
+	UpdateSettingsMock          mServiceMockUpdateSettings
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetConfigMock = mServiceMockGetConfig{mock: m}
+
+	m.GetSettingsMock = mServiceMockGetSettings{mock: m}
+
+	m.SetMaintenanceMock = mServiceMockSetMaintenance{mock: m}
+	m.SetMaintenanceMock.callArgs = []*ServiceMockSetMaintenanceParams{}
+
+	m.UpdateSettingsMock = mServiceMockUpdateSettings{mock: m}
+	m.UpdateSettingsMock.callArgs = []*ServiceMockUpdateSettingsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockGetConfig struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetConfigExpectation
+	expectations       []*ServiceMockGetConfigExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetConfigExpectation specifies expectation struct of the Service.GetConfig
+type ServiceMockGetConfigExpectation struct {
+	mock *ServiceMock
+
+	results      *ServiceMockGetConfigResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ServiceMockGetConfigResults contains results of the Service.GetConfig
+type ServiceMockGetConfigResults struct {
+	c1 config.Config
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetConfig *mServiceMockGetConfig) Optional() *mServiceMockGetConfig {
+	mmGetConfig.optional = true
+	return mmGetConfig
+}
+
+// Expect sets up expected params for Service.GetConfig
+func (mmGetConfig *mServiceMockGetConfig) Expect() *mServiceMockGetConfig {
+	if mmGetConfig.mock.funcGetConfig != nil {
+		mmGetConfig.mock.t.Fatalf("ServiceMock.GetConfig mock is already set by Set")
+	}
+
+	if mmGetConfig.defaultExpectation == nil {
+		mmGetConfig.defaultExpectation = &ServiceMockGetConfigExpectation{}
+	}
+
+	return mmGetConfig
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetConfig
+func (mmGetConfig *mServiceMockGetConfig) Inspect(f func()) *mServiceMockGetConfig {
+	if mmGetConfig.mock.inspectFuncGetConfig != nil {
+		mmGetConfig.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetConfig")
+	}
+
+	mmGetConfig.mock.inspectFuncGetConfig = f
+
+	return mmGetConfig
+}
+
+// Return sets up results that will be returned by Service.GetConfig
+func (mmGetConfig *mServiceMockGetConfig) Return(c1 config.Config) *ServiceMock {
+	if mmGetConfig.mock.funcGetConfig != nil {
+		mmGetConfig.mock.t.Fatalf("ServiceMock.GetConfig mock is already set by Set")
+	}
+
+	if mmGetConfig.defaultExpectation == nil {
+		mmGetConfig.defaultExpectation = &ServiceMockGetConfigExpectation{mock: mmGetConfig.mock}
+	}
+	mmGetConfig.defaultExpectation.results = &ServiceMockGetConfigResults{c1}
+	mmGetConfig.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetConfig.mock
+}
+
+// Set uses given function f to mock the Service.GetConfig method
+func (mmGetConfig *mServiceMockGetConfig) Set(f func() (c1 config.Config)) *ServiceMock {
+	if mmGetConfig.defaultExpectation != nil {
+		mmGetConfig.mock.t.Fatalf("Default expectation is already set for the Service.GetConfig method")
+	}
+
+	if len(mmGetConfig.expectations) > 0 {
+		mmGetConfig.mock.t.Fatalf("Some expectations are already set for the Service.GetConfig method")
+	}
+
+	mmGetConfig.mock.funcGetConfig = f
+	mmGetConfig.mock.funcGetConfigOrigin = minimock.CallerInfo(1)
+	return mmGetConfig.mock
+}
+
+// Times sets number of times Service.GetConfig should be invoked
+func (mmGetConfig *mServiceMockGetConfig) Times(n uint64) *mServiceMockGetConfig {
+	if n == 0 {
+		mmGetConfig.mock.t.Fatalf("Times of ServiceMock.GetConfig mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetConfig.expectedInvocations, n)
+	mmGetConfig.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetConfig
+}
+
+func (mmGetConfig *mServiceMockGetConfig) invocationsDone() bool {
+	if len(mmGetConfig.expectations) == 0 && mmGetConfig.defaultExpectation == nil && mmGetConfig.mock.funcGetConfig == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetConfig.mock.afterGetConfigCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetConfig.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetConfig implements mm_http.Service
+func (mmGetConfig *ServiceMock) GetConfig() (c1 config.Config) {
+	mm_atomic.AddUint64(&mmGetConfig.beforeGetConfigCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetConfig.afterGetConfigCounter, 1)
+
+	mmGetConfig.t.Helper()
+
+	if mmGetConfig.inspectFuncGetConfig != nil {
+		mmGetConfig.inspectFuncGetConfig()
+	}
+
+	if mmGetConfig.GetConfigMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetConfig.GetConfigMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetConfig.GetConfigMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetConfig.t.Fatal("No results are set for the ServiceMock.GetConfig")
+		}
+		return (*mm_results).c1
+	}
+	if mmGetConfig.funcGetConfig != nil {
+		return mmGetConfig.funcGetConfig()
+	}
+	mmGetConfig.t.Fatalf("Unexpected call to ServiceMock.GetConfig.")
+	return
+}
+
+// GetConfigAfterCounter returns a count of finished ServiceMock.GetConfig invocations
+func (mmGetConfig *ServiceMock) GetConfigAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConfig.afterGetConfigCounter)
+}
+
+// GetConfigBeforeCounter returns a count of ServiceMock.GetConfig invocations
+func (mmGetConfig *ServiceMock) GetConfigBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConfig.beforeGetConfigCounter)
+}
+
+// MinimockGetConfigDone returns true if the count of the GetConfig invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetConfigDone() bool {
+	if m.GetConfigMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetConfigMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetConfigMock.invocationsDone()
+}
+
+// MinimockGetConfigInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetConfigInspect() {
+	for _, e := range m.GetConfigMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ServiceMock.GetConfig")
+		}
+	}
+
+	afterGetConfigCounter := mm_atomic.LoadUint64(&m.afterGetConfigCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetConfigMock.defaultExpectation != nil && afterGetConfigCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetConfig at\n%s", m.GetConfigMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetConfig != nil && afterGetConfigCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetConfig at\n%s", m.funcGetConfigOrigin)
+	}
+
+	if !m.GetConfigMock.invocationsDone() && afterGetConfigCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetConfig at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetConfigMock.expectedInvocations), m.GetConfigMock.expectedInvocationsOrigin, afterGetConfigCounter)
+	}
+}
+
+type mServiceMockGetSettings struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetSettingsExpectation
+	expectations       []*ServiceMockGetSettingsExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetSettingsExpectation specifies expectation struct of the Service.GetSettings
+type ServiceMockGetSettingsExpectation struct {
+	mock *ServiceMock
+
+	results      *ServiceMockGetSettingsResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ServiceMockGetSettingsResults contains results of the Service.GetSettings
+type ServiceMockGetSettingsResults struct {
+	r1 config.RuntimeSettings
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSettings *mServiceMockGetSettings) Optional() *mServiceMockGetSettings {
+	mmGetSettings.optional = true
+	return mmGetSettings
+}
+
+// Expect sets up expected params for Service.GetSettings
+func (mmGetSettings *mServiceMockGetSettings) Expect() *mServiceMockGetSettings {
+	if mmGetSettings.mock.funcGetSettings != nil {
+		mmGetSettings.mock.t.Fatalf("ServiceMock.GetSettings mock is already set by Set")
+	}
+
+	if mmGetSettings.defaultExpectation == nil {
+		mmGetSettings.defaultExpectation = &ServiceMockGetSettingsExpectation{}
+	}
+
+	return mmGetSettings
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetSettings
+func (mmGetSettings *mServiceMockGetSettings) Inspect(f func()) *mServiceMockGetSettings {
+	if mmGetSettings.mock.inspectFuncGetSettings != nil {
+		mmGetSettings.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetSettings")
+	}
+
+	mmGetSettings.mock.inspectFuncGetSettings = f
+
+	return mmGetSettings
+}
+
+// Return sets up results that will be returned by Service.GetSettings
+func (mmGetSettings *mServiceMockGetSettings) Return(r1 config.RuntimeSettings) *ServiceMock {
+	if mmGetSettings.mock.funcGetSettings != nil {
+		mmGetSettings.mock.t.Fatalf("ServiceMock.GetSettings mock is already set by Set")
+	}
+
+	if mmGetSettings.defaultExpectation == nil {
+		mmGetSettings.defaultExpectation = &ServiceMockGetSettingsExpectation{mock: mmGetSettings.mock}
+	}
+	mmGetSettings.defaultExpectation.results = &ServiceMockGetSettingsResults{r1}
+	mmGetSettings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSettings.mock
+}
+
+// Set uses given function f to mock the Service.GetSettings method
+func (mmGetSettings *mServiceMockGetSettings) Set(f func() (r1 config.RuntimeSettings)) *ServiceMock {
+	if mmGetSettings.defaultExpectation != nil {
+		mmGetSettings.mock.t.Fatalf("Default expectation is already set for the Service.GetSettings method")
+	}
+
+	if len(mmGetSettings.expectations) > 0 {
+		mmGetSettings.mock.t.Fatalf("Some expectations are already set for the Service.GetSettings method")
+	}
+
+	mmGetSettings.mock.funcGetSettings = f
+	mmGetSettings.mock.funcGetSettingsOrigin = minimock.CallerInfo(1)
+	return mmGetSettings.mock
+}
+
+// Times sets number of times Service.GetSettings should be invoked
+func (mmGetSettings *mServiceMockGetSettings) Times(n uint64) *mServiceMockGetSettings {
+	if n == 0 {
+		mmGetSettings.mock.t.Fatalf("Times of ServiceMock.GetSettings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSettings.expectedInvocations, n)
+	mmGetSettings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSettings
+}
+
+func (mmGetSettings *mServiceMockGetSettings) invocationsDone() bool {
+	if len(mmGetSettings.expectations) == 0 && mmGetSettings.defaultExpectation == nil && mmGetSettings.mock.funcGetSettings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSettings.mock.afterGetSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSettings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSettings implements mm_http.Service
+func (mmGetSettings *ServiceMock) GetSettings() (r1 config.RuntimeSettings) {
+	mm_atomic.AddUint64(&mmGetSettings.beforeGetSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSettings.afterGetSettingsCounter, 1)
+
+	mmGetSettings.t.Helper()
+
+	if mmGetSettings.inspectFuncGetSettings != nil {
+		mmGetSettings.inspectFuncGetSettings()
+	}
+
+	if mmGetSettings.GetSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSettings.GetSettingsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetSettings.GetSettingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSettings.t.Fatal("No results are set for the ServiceMock.GetSettings")
+		}
+		return (*mm_results).r1
+	}
+	if mmGetSettings.funcGetSettings != nil {
+		return mmGetSettings.funcGetSettings()
+	}
+	mmGetSettings.t.Fatalf("Unexpected call to ServiceMock.GetSettings.")
+	return
+}
+
+// GetSettingsAfterCounter returns a count of finished ServiceMock.GetSettings invocations
+func (mmGetSettings *ServiceMock) GetSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSettings.afterGetSettingsCounter)
+}
+
+// GetSettingsBeforeCounter returns a count of ServiceMock.GetSettings invocations
+func (mmGetSettings *ServiceMock) GetSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSettings.beforeGetSettingsCounter)
+}
+
+// MinimockGetSettingsDone returns true if the count of the GetSettings invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetSettingsDone() bool {
+	if m.GetSettingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSettingsMock.invocationsDone()
+}
+
+// MinimockGetSettingsInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetSettingsInspect() {
+	for _, e := range m.GetSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ServiceMock.GetSettings")
+		}
+	}
+
+	afterGetSettingsCounter := mm_atomic.LoadUint64(&m.afterGetSettingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSettingsMock.defaultExpectation != nil && afterGetSettingsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetSettings at\n%s", m.GetSettingsMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSettings != nil && afterGetSettingsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetSettings at\n%s", m.funcGetSettingsOrigin)
+	}
+
+	if !m.GetSettingsMock.invocationsDone() && afterGetSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetSettings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSettingsMock.expectedInvocations), m.GetSettingsMock.expectedInvocationsOrigin, afterGetSettingsCounter)
+	}
+}
+
+type mServiceMockSetMaintenance struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockSetMaintenanceExpectation
+	expectations       []*ServiceMockSetMaintenanceExpectation
+
+	callArgs []*ServiceMockSetMaintenanceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockSetMaintenanceExpectation specifies expectation struct of the Service.SetMaintenance
+type ServiceMockSetMaintenanceExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockSetMaintenanceParams
+	paramPtrs          *ServiceMockSetMaintenanceParamPtrs
+	expectationOrigins ServiceMockSetMaintenanceExpectationOrigins
+	results            *ServiceMockSetMaintenanceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockSetMaintenanceParams contains parameters of the Service.SetMaintenance
+type ServiceMockSetMaintenanceParams struct {
+	ctx context.Context
+	req httpx.MaintenanceConfig
+}
+
+// ServiceMockSetMaintenanceParamPtrs contains pointers to parameters of the Service.SetMaintenance
+type ServiceMockSetMaintenanceParamPtrs struct {
+	ctx *context.Context
+	req *httpx.MaintenanceConfig
+}
+
+// ServiceMockSetMaintenanceResults contains results of the Service.SetMaintenance
+type ServiceMockSetMaintenanceResults struct {
+	err error
+}
+
+// ServiceMockSetMaintenanceOrigins contains origins of expectations of the Service.SetMaintenance
+type ServiceMockSetMaintenanceExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMaintenance *mServiceMockSetMaintenance) Optional() *mServiceMockSetMaintenance {
+	mmSetMaintenance.optional = true
+	return mmSetMaintenance
+}
+
+// Expect sets up expected params for Service.SetMaintenance
+func (mmSetMaintenance *mServiceMockSetMaintenance) Expect(ctx context.Context, req httpx.MaintenanceConfig) *mServiceMockSetMaintenance {
+	if mmSetMaintenance.mock.funcSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Set")
+	}
+
+	if mmSetMaintenance.defaultExpectation == nil {
+		mmSetMaintenance.defaultExpectation = &ServiceMockSetMaintenanceExpectation{}
+	}
+
+	if mmSetMaintenance.defaultExpectation.paramPtrs != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by ExpectParams functions")
+	}
+
+	mmSetMaintenance.defaultExpectation.params = &ServiceMockSetMaintenanceParams{ctx, req}
+	mmSetMaintenance.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMaintenance.expectations {
+		if minimock.Equal(e.params, mmSetMaintenance.defaultExpectation.params) {
+			mmSetMaintenance.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMaintenance.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMaintenance
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.SetMaintenance
+func (mmSetMaintenance *mServiceMockSetMaintenance) ExpectCtxParam1(ctx context.Context) *mServiceMockSetMaintenance {
+	if mmSetMaintenance.mock.funcSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Set")
+	}
+
+	if mmSetMaintenance.defaultExpectation == nil {
+		mmSetMaintenance.defaultExpectation = &ServiceMockSetMaintenanceExpectation{}
+	}
+
+	if mmSetMaintenance.defaultExpectation.params != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Expect")
+	}
+
+	if mmSetMaintenance.defaultExpectation.paramPtrs == nil {
+		mmSetMaintenance.defaultExpectation.paramPtrs = &ServiceMockSetMaintenanceParamPtrs{}
+	}
+	mmSetMaintenance.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMaintenance.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMaintenance
+}
+
+// ExpectReqParam2 sets up expected param req for Service.SetMaintenance
+func (mmSetMaintenance *mServiceMockSetMaintenance) ExpectReqParam2(req httpx.MaintenanceConfig) *mServiceMockSetMaintenance {
+	if mmSetMaintenance.mock.funcSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Set")
+	}
+
+	if mmSetMaintenance.defaultExpectation == nil {
+		mmSetMaintenance.defaultExpectation = &ServiceMockSetMaintenanceExpectation{}
+	}
+
+	if mmSetMaintenance.defaultExpectation.params != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Expect")
+	}
+
+	if mmSetMaintenance.defaultExpectation.paramPtrs == nil {
+		mmSetMaintenance.defaultExpectation.paramPtrs = &ServiceMockSetMaintenanceParamPtrs{}
+	}
+	mmSetMaintenance.defaultExpectation.paramPtrs.req = &req
+	mmSetMaintenance.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmSetMaintenance
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.SetMaintenance
+func (mmSetMaintenance *mServiceMockSetMaintenance) Inspect(f func(ctx context.Context, req httpx.MaintenanceConfig)) *mServiceMockSetMaintenance {
+	if mmSetMaintenance.mock.inspectFuncSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("Inspect function is already set for ServiceMock.SetMaintenance")
+	}
+
+	mmSetMaintenance.mock.inspectFuncSetMaintenance = f
+
+	return mmSetMaintenance
+}
+
+// Return sets up results that will be returned by Service.SetMaintenance
+func (mmSetMaintenance *mServiceMockSetMaintenance) Return(err error) *ServiceMock {
+	if mmSetMaintenance.mock.funcSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Set")
+	}
+
+	if mmSetMaintenance.defaultExpectation == nil {
+		mmSetMaintenance.defaultExpectation = &ServiceMockSetMaintenanceExpectation{mock: mmSetMaintenance.mock}
+	}
+	mmSetMaintenance.defaultExpectation.results = &ServiceMockSetMaintenanceResults{err}
+	mmSetMaintenance.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMaintenance.mock
+}
+
+// Set uses given function f to mock the Service.SetMaintenance method
+func (mmSetMaintenance *mServiceMockSetMaintenance) Set(f func(ctx context.Context, req httpx.MaintenanceConfig) (err error)) *ServiceMock {
+	if mmSetMaintenance.defaultExpectation != nil {
+		mmSetMaintenance.mock.t.Fatalf("Default expectation is already set for the Service.SetMaintenance method")
+	}
+
+	if len(mmSetMaintenance.expectations) > 0 {
+		mmSetMaintenance.mock.t.Fatalf("Some expectations are already set for the Service.SetMaintenance method")
+	}
+
+	mmSetMaintenance.mock.funcSetMaintenance = f
+	mmSetMaintenance.mock.funcSetMaintenanceOrigin = minimock.CallerInfo(1)
+	return mmSetMaintenance.mock
+}
+
+// When sets expectation for the Service.SetMaintenance which will trigger the result defined by the following
+// Then helper
+func (mmSetMaintenance *mServiceMockSetMaintenance) When(ctx context.Context, req httpx.MaintenanceConfig) *ServiceMockSetMaintenanceExpectation {
+	if mmSetMaintenance.mock.funcSetMaintenance != nil {
+		mmSetMaintenance.mock.t.Fatalf("ServiceMock.SetMaintenance mock is already set by Set")
+	}
+
+	expectation := &ServiceMockSetMaintenanceExpectation{
+		mock:               mmSetMaintenance.mock,
+		params:             &ServiceMockSetMaintenanceParams{ctx, req},
+		expectationOrigins: ServiceMockSetMaintenanceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMaintenance.expectations = append(mmSetMaintenance.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.SetMaintenance return parameters for the expectation previously defined by the When method
+func (e *ServiceMockSetMaintenanceExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockSetMaintenanceResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.SetMaintenance should be invoked
+func (mmSetMaintenance *mServiceMockSetMaintenance) Times(n uint64) *mServiceMockSetMaintenance {
+	if n == 0 {
+		mmSetMaintenance.mock.t.Fatalf("Times of ServiceMock.SetMaintenance mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMaintenance.expectedInvocations, n)
+	mmSetMaintenance.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMaintenance
+}
+
+func (mmSetMaintenance *mServiceMockSetMaintenance) invocationsDone() bool {
+	if len(mmSetMaintenance.expectations) == 0 && mmSetMaintenance.defaultExpectation == nil && mmSetMaintenance.mock.funcSetMaintenance == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMaintenance.mock.afterSetMaintenanceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMaintenance.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMaintenance implements mm_http.Service
+func (mmSetMaintenance *ServiceMock) SetMaintenance(ctx context.Context, req httpx.MaintenanceConfig) (err error) {
+	mm_atomic.AddUint64(&mmSetMaintenance.beforeSetMaintenanceCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMaintenance.afterSetMaintenanceCounter, 1)
+
+	mmSetMaintenance.t.Helper()
+
+	if mmSetMaintenance.inspectFuncSetMaintenance != nil {
+		mmSetMaintenance.inspectFuncSetMaintenance(ctx, req)
+	}
+
+	mm_params := ServiceMockSetMaintenanceParams{ctx, req}
+
+	// Record call args
+	mmSetMaintenance.SetMaintenanceMock.mutex.Lock()
+	mmSetMaintenance.SetMaintenanceMock.callArgs = append(mmSetMaintenance.SetMaintenanceMock.callArgs, &mm_params)
+	mmSetMaintenance.SetMaintenanceMock.mutex.Unlock()
+
+	for _, e := range mmSetMaintenance.SetMaintenanceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMaintenance.SetMaintenanceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMaintenance.SetMaintenanceMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMaintenance.SetMaintenanceMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMaintenance.SetMaintenanceMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockSetMaintenanceParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMaintenance.t.Errorf("ServiceMock.SetMaintenance got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMaintenance.SetMaintenanceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmSetMaintenance.t.Errorf("ServiceMock.SetMaintenance got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMaintenance.SetMaintenanceMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMaintenance.t.Errorf("ServiceMock.SetMaintenance got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMaintenance.SetMaintenanceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMaintenance.SetMaintenanceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMaintenance.t.Fatal("No results are set for the ServiceMock.SetMaintenance")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMaintenance.funcSetMaintenance != nil {
+		return mmSetMaintenance.funcSetMaintenance(ctx, req)
+	}
+	mmSetMaintenance.t.Fatalf("Unexpected call to ServiceMock.SetMaintenance. %v %v", ctx, req)
+	return
+}
+
+// SetMaintenanceAfterCounter returns a count of finished ServiceMock.SetMaintenance invocations
+func (mmSetMaintenance *ServiceMock) SetMaintenanceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaintenance.afterSetMaintenanceCounter)
+}
+
+// SetMaintenanceBeforeCounter returns a count of ServiceMock.SetMaintenance invocations
+func (mmSetMaintenance *ServiceMock) SetMaintenanceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaintenance.beforeSetMaintenanceCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.SetMaintenance.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMaintenance *mServiceMockSetMaintenance) Calls() []*ServiceMockSetMaintenanceParams {
+	mmSetMaintenance.mutex.RLock()
+
+	argCopy := make([]*ServiceMockSetMaintenanceParams, len(mmSetMaintenance.callArgs))
+	copy(argCopy, mmSetMaintenance.callArgs)
+
+	mmSetMaintenance.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMaintenanceDone returns true if the count of the SetMaintenance invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockSetMaintenanceDone() bool {
+	if m.SetMaintenanceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMaintenanceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMaintenanceMock.invocationsDone()
+}
+
+// MinimockSetMaintenanceInspect logs each unmet expectation
+func (m *ServiceMock) MinimockSetMaintenanceInspect() {
+	for _, e := range m.SetMaintenanceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.SetMaintenance at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMaintenanceCounter := mm_atomic.LoadUint64(&m.afterSetMaintenanceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMaintenanceMock.defaultExpectation != nil && afterSetMaintenanceCounter < 1 {
+		if m.SetMaintenanceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.SetMaintenance at\n%s", m.SetMaintenanceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.SetMaintenance at\n%s with params: %#v", m.SetMaintenanceMock.defaultExpectation.expectationOrigins.origin, *m.SetMaintenanceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMaintenance != nil && afterSetMaintenanceCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.SetMaintenance at\n%s", m.funcSetMaintenanceOrigin)
+	}
+
+	if !m.SetMaintenanceMock.invocationsDone() && afterSetMaintenanceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.SetMaintenance at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMaintenanceMock.expectedInvocations), m.SetMaintenanceMock.expectedInvocationsOrigin, afterSetMaintenanceCounter)
+	}
+}
+
+type mServiceMockUpdateSettings struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockUpdateSettingsExpectation
+	expectations       []*ServiceMockUpdateSettingsExpectation
+
+	callArgs []*ServiceMockUpdateSettingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockUpdateSettingsExpectation specifies expectation struct of the Service.UpdateSettings
+type ServiceMockUpdateSettingsExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockUpdateSettingsParams
+	paramPtrs          *ServiceMockUpdateSettingsParamPtrs
+	expectationOrigins ServiceMockUpdateSettingsExpectationOrigins
+	results            *ServiceMockUpdateSettingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockUpdateSettingsParams contains parameters of the Service.UpdateSettings
+type ServiceMockUpdateSettingsParams struct {
+	ctx context.Context
+	req config.RuntimeSettings
+}
+
+// ServiceMockUpdateSettingsParamPtrs contains pointers to parameters of the Service.UpdateSettings
+type ServiceMockUpdateSettingsParamPtrs struct {
+	ctx *context.Context
+	req *config.RuntimeSettings
+}
+
+// ServiceMockUpdateSettingsResults contains results of the Service.UpdateSettings
+type ServiceMockUpdateSettingsResults struct {
+	err error
+}
+
+// ServiceMockUpdateSettingsOrigins contains origins of expectations of the Service.UpdateSettings
+type ServiceMockUpdateSettingsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSettings *mServiceMockUpdateSettings) Optional() *mServiceMockUpdateSettings {
+	mmUpdateSettings.optional = true
+	return mmUpdateSettings
+}
+
+// Expect sets up expected params for Service.UpdateSettings
+func (mmUpdateSettings *mServiceMockUpdateSettings) Expect(ctx context.Context, req config.RuntimeSettings) *mServiceMockUpdateSettings {
+	if mmUpdateSettings.mock.funcUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Set")
+	}
+
+	if mmUpdateSettings.defaultExpectation == nil {
+		mmUpdateSettings.defaultExpectation = &ServiceMockUpdateSettingsExpectation{}
+	}
+
+	if mmUpdateSettings.defaultExpectation.paramPtrs != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateSettings.defaultExpectation.params = &ServiceMockUpdateSettingsParams{ctx, req}
+	mmUpdateSettings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSettings.expectations {
+		if minimock.Equal(e.params, mmUpdateSettings.defaultExpectation.params) {
+			mmUpdateSettings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSettings.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateSettings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.UpdateSettings
+func (mmUpdateSettings *mServiceMockUpdateSettings) ExpectCtxParam1(ctx context.Context) *mServiceMockUpdateSettings {
+	if mmUpdateSettings.mock.funcUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Set")
+	}
+
+	if mmUpdateSettings.defaultExpectation == nil {
+		mmUpdateSettings.defaultExpectation = &ServiceMockUpdateSettingsExpectation{}
+	}
+
+	if mmUpdateSettings.defaultExpectation.params != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Expect")
+	}
+
+	if mmUpdateSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateSettings.defaultExpectation.paramPtrs = &ServiceMockUpdateSettingsParamPtrs{}
+	}
+	mmUpdateSettings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSettings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateSettings
+}
+
+// ExpectReqParam2 sets up expected param req for Service.UpdateSettings
+func (mmUpdateSettings *mServiceMockUpdateSettings) ExpectReqParam2(req config.RuntimeSettings) *mServiceMockUpdateSettings {
+	if mmUpdateSettings.mock.funcUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Set")
+	}
+
+	if mmUpdateSettings.defaultExpectation == nil {
+		mmUpdateSettings.defaultExpectation = &ServiceMockUpdateSettingsExpectation{}
+	}
+
+	if mmUpdateSettings.defaultExpectation.params != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Expect")
+	}
+
+	if mmUpdateSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateSettings.defaultExpectation.paramPtrs = &ServiceMockUpdateSettingsParamPtrs{}
+	}
+	mmUpdateSettings.defaultExpectation.paramPtrs.req = &req
+	mmUpdateSettings.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdateSettings
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.UpdateSettings
+func (mmUpdateSettings *mServiceMockUpdateSettings) Inspect(f func(ctx context.Context, req config.RuntimeSettings)) *mServiceMockUpdateSettings {
+	if mmUpdateSettings.mock.inspectFuncUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("Inspect function is already set for ServiceMock.UpdateSettings")
+	}
+
+	mmUpdateSettings.mock.inspectFuncUpdateSettings = f
+
+	return mmUpdateSettings
+}
+
+// Return sets up results that will be returned by Service.UpdateSettings
+func (mmUpdateSettings *mServiceMockUpdateSettings) Return(err error) *ServiceMock {
+	if mmUpdateSettings.mock.funcUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Set")
+	}
+
+	if mmUpdateSettings.defaultExpectation == nil {
+		mmUpdateSettings.defaultExpectation = &ServiceMockUpdateSettingsExpectation{mock: mmUpdateSettings.mock}
+	}
+	mmUpdateSettings.defaultExpectation.results = &ServiceMockUpdateSettingsResults{err}
+	mmUpdateSettings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSettings.mock
+}
+
+// Set uses given function f to mock the Service.UpdateSettings method
+func (mmUpdateSettings *mServiceMockUpdateSettings) Set(f func(ctx context.Context, req config.RuntimeSettings) (err error)) *ServiceMock {
+	if mmUpdateSettings.defaultExpectation != nil {
+		mmUpdateSettings.mock.t.Fatalf("Default expectation is already set for the Service.UpdateSettings method")
+	}
+
+	if len(mmUpdateSettings.expectations) > 0 {
+		mmUpdateSettings.mock.t.Fatalf("Some expectations are already set for the Service.UpdateSettings method")
+	}
+
+	mmUpdateSettings.mock.funcUpdateSettings = f
+	mmUpdateSettings.mock.funcUpdateSettingsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSettings.mock
+}
+
+// When sets expectation for the Service.UpdateSettings which will trigger the result defined by the following
+// Then helper
+func (mmUpdateSettings *mServiceMockUpdateSettings) When(ctx context.Context, req config.RuntimeSettings) *ServiceMockUpdateSettingsExpectation {
+	if mmUpdateSettings.mock.funcUpdateSettings != nil {
+		mmUpdateSettings.mock.t.Fatalf("ServiceMock.UpdateSettings mock is already set by Set")
+	}
+
+	expectation := &ServiceMockUpdateSettingsExpectation{
+		mock:               mmUpdateSettings.mock,
+		params:             &ServiceMockUpdateSettingsParams{ctx, req},
+		expectationOrigins: ServiceMockUpdateSettingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateSettings.expectations = append(mmUpdateSettings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.UpdateSettings return parameters for the expectation previously defined by the When method
+func (e *ServiceMockUpdateSettingsExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockUpdateSettingsResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.UpdateSettings should be invoked
+func (mmUpdateSettings *mServiceMockUpdateSettings) Times(n uint64) *mServiceMockUpdateSettings {
+	if n == 0 {
+		mmUpdateSettings.mock.t.Fatalf("Times of ServiceMock.UpdateSettings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateSettings.expectedInvocations, n)
+	mmUpdateSettings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSettings
+}
+
+func (mmUpdateSettings *mServiceMockUpdateSettings) invocationsDone() bool {
+	if len(mmUpdateSettings.expectations) == 0 && mmUpdateSettings.defaultExpectation == nil && mmUpdateSettings.mock.funcUpdateSettings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSettings.mock.afterUpdateSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSettings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateSettings implements mm_http.Service
+func (mmUpdateSettings *ServiceMock) UpdateSettings(ctx context.Context, req config.RuntimeSettings) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSettings.beforeUpdateSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSettings.afterUpdateSettingsCounter, 1)
+
+	mmUpdateSettings.t.Helper()
+
+	if mmUpdateSettings.inspectFuncUpdateSettings != nil {
+		mmUpdateSettings.inspectFuncUpdateSettings(ctx, req)
+	}
+
+	mm_params := ServiceMockUpdateSettingsParams{ctx, req}
+
+	// Record call args
+	mmUpdateSettings.UpdateSettingsMock.mutex.Lock()
+	mmUpdateSettings.UpdateSettingsMock.callArgs = append(mmUpdateSettings.UpdateSettingsMock.callArgs, &mm_params)
+	mmUpdateSettings.UpdateSettingsMock.mutex.Unlock()
+
+	for _, e := range mmUpdateSettings.UpdateSettingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateSettings.UpdateSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSettings.UpdateSettingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSettings.UpdateSettingsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSettings.UpdateSettingsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockUpdateSettingsParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateSettings.t.Errorf("ServiceMock.UpdateSettings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSettings.UpdateSettingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdateSettings.t.Errorf("ServiceMock.UpdateSettings got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSettings.UpdateSettingsMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateSettings.t.Errorf("ServiceMock.UpdateSettings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSettings.UpdateSettingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateSettings.UpdateSettingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateSettings.t.Fatal("No results are set for the ServiceMock.UpdateSettings")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateSettings.funcUpdateSettings != nil {
+		return mmUpdateSettings.funcUpdateSettings(ctx, req)
+	}
+	mmUpdateSettings.t.Fatalf("Unexpected call to ServiceMock.UpdateSettings. %v %v", ctx, req)
+	return
+}
+
+// UpdateSettingsAfterCounter returns a count of finished ServiceMock.UpdateSettings invocations
+func (mmUpdateSettings *ServiceMock) UpdateSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSettings.afterUpdateSettingsCounter)
+}
+
+// UpdateSettingsBeforeCounter returns a count of ServiceMock.UpdateSettings invocations
+func (mmUpdateSettings *ServiceMock) UpdateSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSettings.beforeUpdateSettingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.UpdateSettings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateSettings *mServiceMockUpdateSettings) Calls() []*ServiceMockUpdateSettingsParams {
+	mmUpdateSettings.mutex.RLock()
+
+	argCopy := make([]*ServiceMockUpdateSettingsParams, len(mmUpdateSettings.callArgs))
+	copy(argCopy, mmUpdateSettings.callArgs)
+
+	mmUpdateSettings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateSettingsDone returns true if the count of the UpdateSettings invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockUpdateSettingsDone() bool {
+	if m.UpdateSettingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateSettingsMock.invocationsDone()
+}
+
+// MinimockUpdateSettingsInspect logs each unmet expectation
+func (m *ServiceMock) MinimockUpdateSettingsInspect() {
+	for _, e := range m.UpdateSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.UpdateSettings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateSettingsCounter := mm_atomic.LoadUint64(&m.afterUpdateSettingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateSettingsMock.defaultExpectation != nil && afterUpdateSettingsCounter < 1 {
+		if m.UpdateSettingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.UpdateSettings at\n%s", m.UpdateSettingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.UpdateSettings at\n%s with params: %#v", m.UpdateSettingsMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSettingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateSettings != nil && afterUpdateSettingsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.UpdateSettings at\n%s", m.funcUpdateSettingsOrigin)
+	}
+
+	if !m.UpdateSettingsMock.invocationsDone() && afterUpdateSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.UpdateSettings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSettingsMock.expectedInvocations), m.UpdateSettingsMock.expectedInvocationsOrigin, afterUpdateSettingsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetConfigInspect()
+
+			m.MinimockGetSettingsInspect()
+
+			m.MinimockSetMaintenanceInspect()
+
+			m.MinimockUpdateSettingsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetConfigDone() &&
+		m.MinimockGetSettingsDone() &&
+		m.MinimockSetMaintenanceDone() &&
+		m.MinimockUpdateSettingsDone()
+}
@@ -0,0 +1,576 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/admin/usecase.SettingsRegistry -o settings_registry_mock.go -n SettingsRegistryMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SettingsRegistryMock implements mm_usecase.SettingsRegistry
+type SettingsRegistryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func() (r1 config.RuntimeSettings)
+	funcGetOrigin    string
+	inspectFuncGet   func()
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mSettingsRegistryMockGet
+
+	funcUpdate          func(s config.RuntimeSettings)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(s config.RuntimeSettings)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mSettingsRegistryMockUpdate
+}
+
+// NewSettingsRegistryMock returns a mock for mm_usecase.SettingsRegistry
+func NewSettingsRegistryMock(t minimock.Tester) *SettingsRegistryMock {
+	m := &SettingsRegistryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mSettingsRegistryMockGet{mock: m}
+
+	m.UpdateMock = mSettingsRegistryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*SettingsRegistryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSettingsRegistryMockGet struct {
+	optional           bool
+	mock               *SettingsRegistryMock
+	defaultExpectation *SettingsRegistryMockGetExpectation
+	expectations       []*SettingsRegistryMockGetExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SettingsRegistryMockGetExpectation specifies expectation struct of the SettingsRegistry.Get
+type SettingsRegistryMockGetExpectation struct {
+	mock *SettingsRegistryMock
+
+	results      *SettingsRegistryMockGetResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// SettingsRegistryMockGetResults contains results of the SettingsRegistry.Get
+type SettingsRegistryMockGetResults struct {
+	r1 config.RuntimeSettings
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mSettingsRegistryMockGet) Optional() *mSettingsRegistryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for SettingsRegistry.Get
+func (mmGet *mSettingsRegistryMockGet) Expect() *mSettingsRegistryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SettingsRegistryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SettingsRegistryMockGetExpectation{}
+	}
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the SettingsRegistry.Get
+func (mmGet *mSettingsRegistryMockGet) Inspect(f func()) *mSettingsRegistryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for SettingsRegistryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by SettingsRegistry.Get
+func (mmGet *mSettingsRegistryMockGet) Return(r1 config.RuntimeSettings) *SettingsRegistryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("SettingsRegistryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &SettingsRegistryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &SettingsRegistryMockGetResults{r1}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the SettingsRegistry.Get method
+func (mmGet *mSettingsRegistryMockGet) Set(f func() (r1 config.RuntimeSettings)) *SettingsRegistryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the SettingsRegistry.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the SettingsRegistry.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Times sets number of times SettingsRegistry.Get should be invoked
+func (mmGet *mSettingsRegistryMockGet) Times(n uint64) *mSettingsRegistryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of SettingsRegistryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mSettingsRegistryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_usecase.SettingsRegistry
+func (mmGet *SettingsRegistryMock) Get() (r1 config.RuntimeSettings) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet()
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the SettingsRegistryMock.Get")
+		}
+		return (*mm_results).r1
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet()
+	}
+	mmGet.t.Fatalf("Unexpected call to SettingsRegistryMock.Get.")
+	return
+}
+
+// GetAfterCounter returns a count of finished SettingsRegistryMock.Get invocations
+func (mmGet *SettingsRegistryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of SettingsRegistryMock.Get invocations
+func (mmGet *SettingsRegistryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *SettingsRegistryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *SettingsRegistryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to SettingsRegistryMock.Get")
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to SettingsRegistryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to SettingsRegistryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to SettingsRegistryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mSettingsRegistryMockUpdate struct {
+	optional           bool
+	mock               *SettingsRegistryMock
+	defaultExpectation *SettingsRegistryMockUpdateExpectation
+	expectations       []*SettingsRegistryMockUpdateExpectation
+
+	callArgs []*SettingsRegistryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SettingsRegistryMockUpdateExpectation specifies expectation struct of the SettingsRegistry.Update
+type SettingsRegistryMockUpdateExpectation struct {
+	mock               *SettingsRegistryMock
+	params             *SettingsRegistryMockUpdateParams
+	paramPtrs          *SettingsRegistryMockUpdateParamPtrs
+	expectationOrigins SettingsRegistryMockUpdateExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// SettingsRegistryMockUpdateParams contains parameters of the SettingsRegistry.Update
+type SettingsRegistryMockUpdateParams struct {
+	s config.RuntimeSettings
+}
+
+// SettingsRegistryMockUpdateParamPtrs contains pointers to parameters of the SettingsRegistry.Update
+type SettingsRegistryMockUpdateParamPtrs struct {
+	s *config.RuntimeSettings
+}
+
+// SettingsRegistryMockUpdateOrigins contains origins of expectations of the SettingsRegistry.Update
+type SettingsRegistryMockUpdateExpectationOrigins struct {
+	origin  string
+	originS string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mSettingsRegistryMockUpdate) Optional() *mSettingsRegistryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for SettingsRegistry.Update
+func (mmUpdate *mSettingsRegistryMockUpdate) Expect(s config.RuntimeSettings) *mSettingsRegistryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &SettingsRegistryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &SettingsRegistryMockUpdateParams{s}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectSParam1 sets up expected param s for SettingsRegistry.Update
+func (mmUpdate *mSettingsRegistryMockUpdate) ExpectSParam1(s config.RuntimeSettings) *mSettingsRegistryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &SettingsRegistryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &SettingsRegistryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.s = &s
+	mmUpdate.defaultExpectation.expectationOrigins.originS = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the SettingsRegistry.Update
+func (mmUpdate *mSettingsRegistryMockUpdate) Inspect(f func(s config.RuntimeSettings)) *mSettingsRegistryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for SettingsRegistryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by SettingsRegistry.Update
+func (mmUpdate *mSettingsRegistryMockUpdate) Return() *SettingsRegistryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &SettingsRegistryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the SettingsRegistry.Update method
+func (mmUpdate *mSettingsRegistryMockUpdate) Set(f func(s config.RuntimeSettings)) *SettingsRegistryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the SettingsRegistry.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the SettingsRegistry.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the SettingsRegistry.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mSettingsRegistryMockUpdate) When(s config.RuntimeSettings) *SettingsRegistryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("SettingsRegistryMock.Update mock is already set by Set")
+	}
+
+	expectation := &SettingsRegistryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &SettingsRegistryMockUpdateParams{s},
+		expectationOrigins: SettingsRegistryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SettingsRegistry.Update return parameters for the expectation previously defined by the When method
+
+func (e *SettingsRegistryMockUpdateExpectation) Then() *SettingsRegistryMock {
+	return e.mock
+}
+
+// Times sets number of times SettingsRegistry.Update should be invoked
+func (mmUpdate *mSettingsRegistryMockUpdate) Times(n uint64) *mSettingsRegistryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of SettingsRegistryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mSettingsRegistryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.SettingsRegistry
+func (mmUpdate *SettingsRegistryMock) Update(s config.RuntimeSettings) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(s)
+	}
+
+	mm_params := SettingsRegistryMockUpdateParams{s}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := SettingsRegistryMockUpdateParams{s}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.s != nil && !minimock.Equal(*mm_want_ptrs.s, mm_got.s) {
+				mmUpdate.t.Errorf("SettingsRegistryMock.Update got unexpected parameter s, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originS, *mm_want_ptrs.s, mm_got.s, minimock.Diff(*mm_want_ptrs.s, mm_got.s))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("SettingsRegistryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmUpdate.funcUpdate != nil {
+		mmUpdate.funcUpdate(s)
+		return
+	}
+	mmUpdate.t.Fatalf("Unexpected call to SettingsRegistryMock.Update. %v", s)
+
+}
+
+// UpdateAfterCounter returns a count of finished SettingsRegistryMock.Update invocations
+func (mmUpdate *SettingsRegistryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of SettingsRegistryMock.Update invocations
+func (mmUpdate *SettingsRegistryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to SettingsRegistryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mSettingsRegistryMockUpdate) Calls() []*SettingsRegistryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*SettingsRegistryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *SettingsRegistryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *SettingsRegistryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SettingsRegistryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SettingsRegistryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SettingsRegistryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to SettingsRegistryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to SettingsRegistryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SettingsRegistryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SettingsRegistryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SettingsRegistryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockUpdateDone()
+}
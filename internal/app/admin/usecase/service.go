@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/app/admin"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type SettingsRegistry interface {
+	Get() config.RuntimeSettings
+	Update(s config.RuntimeSettings)
+}
+
+type service struct {
+	cfg      config.Config
+	settings SettingsRegistry
+}
+
+func NewService(cfg config.Config, settings SettingsRegistry) *service {
+	if settings == nil {
+		panic("admin.NewService: nil dependency")
+	}
+	return &service{cfg: cfg, settings: settings}
+}
+
+// GetConfig returns the effective configuration, including runtime overrides.
+// Secrets are never serialized, see config.Config.
+func (s *service) GetConfig() config.Config {
+	return s.cfg.WithRuntime(s.settings.Get())
+}
+
+func (s *service) GetSettings() config.RuntimeSettings {
+	return s.settings.Get()
+}
+
+// UpdateSettings validates and applies new runtime settings. They last until the next restart or SIGHUP.
+func (s *service) UpdateSettings(ctx context.Context, req config.RuntimeSettings) error {
+	if err := req.Validate(); err != nil {
+		err = admin.ErrInvalidSettings(err.Error())
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("admin.service.UpdateSettings: invalid settings")
+		return fmt.Errorf("admin.service.UpdateSettings: %w", err)
+	}
+
+	s.settings.Update(req)
+	return nil
+}
+
+// SetMaintenance switches read-only maintenance mode and keeps the other runtime settings.
+// Like UpdateSettings, it lasts until the next restart or SIGHUP.
+func (s *service) SetMaintenance(ctx context.Context, req httpx.MaintenanceConfig) error {
+	if err := req.Validate(); err != nil {
+		err = admin.ErrInvalidSettings(err.Error())
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("admin.service.SetMaintenance: invalid settings")
+		return fmt.Errorf("admin.service.SetMaintenance: %w", err)
+	}
+
+	settings := s.settings.Get()
+	settings.Maintenance = req
+	s.settings.Update(settings)
+	return nil
+}
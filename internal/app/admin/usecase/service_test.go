@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/config"
+	"github.com/66gu1/easygodocs/internal/app/admin"
+	"github.com/66gu1/easygodocs/internal/app/admin/usecase"
+	"github.com/66gu1/easygodocs/internal/app/admin/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	settings *mocks.SettingsRegistryMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		settings: mocks.NewSettingsRegistryMock(t),
+	}
+}
+
+func validSettings() config.RuntimeSettings {
+	return config.RuntimeSettings{
+		LogLevel: "warn",
+		UserValidation: user.ValidationConfig{
+			MaxEmailLength:    100,
+			MaxNameLength:     10,
+			MinPasswordLength: 4,
+			MaxPasswordLength: 20,
+			MaxBioLength:      100,
+			MaxAvatarBytes:    1024,
+		},
+		EntityValidation:             entity.ValidationConfig{MaxNameLength: 10, MaxContentLength: 1000, MaxSummaryLength: 20},
+		PresenceMaxMessagesPerSecond: 5,
+		Maintenance:                  httpx.MaintenanceConfig{RetryAfterSeconds: 60},
+	}
+}
+
+func TestService_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{Port: "8080", LogLevel: "info", JWTSecret: "secret"}
+	settings := validSettings()
+	mocks := getMocks(t)
+	mocks.settings.GetMock.Return(settings)
+
+	got := usecase.NewService(cfg, mocks.settings).GetConfig()
+	require.Equal(t, cfg.WithRuntime(settings), got)
+	require.Equal(t, config.LogLevel("warn"), got.LogLevel)
+}
+
+func TestService_GetSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := validSettings()
+	mocks := getMocks(t)
+	mocks.settings.GetMock.Return(settings)
+
+	require.Equal(t, settings, usecase.NewService(config.Config{}, mocks.settings).GetSettings())
+}
+
+func TestService_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		settings = validSettings()
+		invalid  = validSettings()
+	)
+	invalid.PresenceMaxMessagesPerSecond = 0
+
+	tests := []struct {
+		name  string
+		req   config.RuntimeSettings
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  settings,
+			setup: func(mocks mock) {
+				mocks.settings.UpdateMock.Expect(settings).Return()
+			},
+		},
+		{
+			name:  "invalid settings",
+			req:   invalid,
+			setup: func(mocks mock) {},
+			err:   admin.ErrInvalidSettings(""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(config.Config{}, mocks.settings)
+			err := svc.UpdateSettings(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_SetMaintenance(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	t.Run("ok, other settings kept", func(t *testing.T) {
+		t.Parallel()
+
+		settings := validSettings()
+		req := httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 120}
+		want := settings
+		want.Maintenance = req
+
+		mocks := getMocks(t)
+		mocks.settings.GetMock.Return(settings)
+		mocks.settings.UpdateMock.Expect(want).Return()
+
+		require.NoError(t, usecase.NewService(config.Config{}, mocks.settings).SetMaintenance(ctx, req))
+	})
+	t.Run("invalid retry after", func(t *testing.T) {
+		t.Parallel()
+
+		mocks := getMocks(t)
+		err := usecase.NewService(config.Config{}, mocks.settings).SetMaintenance(ctx, httpx.MaintenanceConfig{Enabled: true})
+		require.ErrorIs(t, err, admin.ErrInvalidSettings(""))
+	})
+}
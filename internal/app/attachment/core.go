@@ -0,0 +1,141 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Add(ctx context.Context, attachment Attachment) error
+	// Get returns the attachment stored under key in the workspace of ctx.
+	Get(ctx context.Context, key string) (Attachment, error)
+	SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) error
+}
+
+// BlobStorage keeps the attachment files. Get and Delete return blob.ErrNotFound for missing keys.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// defaultContentType is served for files whose extension has no known type.
+const defaultContentType = "application/octet-stream"
+
+type core struct {
+	repo    Repository
+	storage BlobStorage
+	timeGen TimeGenerator
+}
+
+func NewCore(repo Repository, storage BlobStorage, timeGen TimeGenerator) (*core, error) {
+	if repo == nil || storage == nil || timeGen == nil {
+		return nil, fmt.Errorf("attachment.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+
+	return &core{repo: repo, storage: storage, timeGen: timeGen}, nil
+}
+
+// Store puts the file in blob storage and records it in the workspace of ctx. The file is stored before
+// its row, so a failed insert leaves at most an unreferenced blob that is removed best-effort.
+func (c *core) Store(ctx context.Context, req StoreReq) error {
+	if !IsKey(req.Key) {
+		return fmt.Errorf("attachment.core.Store: %w", ErrInvalidKey())
+	}
+
+	counter := &countingReader{r: req.Content}
+	if err := c.storage.Put(ctx, req.Key, counter); err != nil {
+		return fmt.Errorf("attachment.core.Store: %w", err)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(req.Key))
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	attachment := Attachment{
+		Key:         req.Key,
+		Name:        req.Name,
+		ContentType: contentType,
+		Size:        counter.n,
+		UploadedBy:  req.UploadedBy,
+		CreatedAt:   c.timeGen.Now(),
+	}
+	if err := c.repo.Add(ctx, attachment); err != nil {
+		if delErr := c.storage.Delete(context.WithoutCancel(ctx), req.Key); delErr != nil {
+			logger.Error(ctx, delErr).
+				Str(FieldKey.String(), req.Key).
+				Msg("attachment.core.Store: failed to remove orphaned file")
+		}
+		return fmt.Errorf("attachment.core.Store: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the attachment stored under key, ErrAttachmentNotFound if another workspace stored it.
+func (c *core) Get(ctx context.Context, key string) (Attachment, error) {
+	if !IsKey(key) {
+		return Attachment{}, fmt.Errorf("attachment.core.Get: %w", ErrInvalidKey())
+	}
+	attachment, err := c.repo.Get(ctx, key)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("attachment.core.Get: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// SetEntity records entityID as the entity the attachments stored under keys belong to, so reading them
+// requires read permission for it.
+func (c *core) SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if entityID == uuid.Nil {
+		return fmt.Errorf("attachment.core.SetEntity: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := c.repo.SetEntity(ctx, keys, entityID); err != nil {
+		return fmt.Errorf("attachment.core.SetEntity: %w", err)
+	}
+
+	return nil
+}
+
+// Open returns the content of the attachment stored under key, which the caller closes. It does not
+// look the attachment up, so callers Get it first.
+func (c *core) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	content, err := c.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			err = ErrAttachmentNotFound()
+		}
+		return nil, fmt.Errorf("attachment.core.Open: %w", err)
+	}
+
+	return content, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
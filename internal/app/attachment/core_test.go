@@ -0,0 +1,345 @@
+package attachment_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/app/attachment/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	repo    *mocks.RepositoryMock
+	storage *mocks.BlobStorageMock
+	timeGen *mocks.TimeGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		repo:    mocks.NewRepositoryMock(t),
+		storage: mocks.NewBlobStorageMock(t),
+		timeGen: mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	_, err := attachment.NewCore(m.repo, nil, m.timeGen)
+	require.Error(t, err)
+}
+
+func TestIsKey(t *testing.T) {
+	t.Parallel()
+
+	for key, want := range map[string]bool{
+		"imports/1/logo.png":                  true,
+		"imports/1/a b/logo.png":              true,
+		"logo.png":                            false,
+		"/imports/logo.png":                   false,
+		"imports//logo.png":                   false,
+		"imports/../logo.png":                 false,
+		"imports/./logo.png":                  false,
+		"https://example.com/a":               false,
+		"imports\\1\\logo.png":                false,
+		"imports/1/logo.png?v=1":              false,
+		"imports/1/logo\x00.png":              false,
+		"imports/" + strings.Repeat("a", 512): false,
+	} {
+		require.Equal(t, want, attachment.IsKey(key), key)
+	}
+}
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/api/v1/attachments/imports/1/logo.png", attachment.URL("imports/1/logo.png"))
+	require.Equal(t, "/api/v1/attachments/imports/1/a%20b%3F.png", attachment.URL("imports/1/a b?.png"))
+}
+
+func TestCore_Store(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		now    = time.Now()
+		req    = attachment.StoreReq{Key: "imports/1/logo.png", Name: "attachments/1/logo.png", UploadedBy: &userID}
+		want   = attachment.Attachment{
+			Key: req.Key, Name: req.Name, ContentType: "image/png", Size: 4, UploadedBy: &userID, CreatedAt: now,
+		}
+		errExp = fmt.Errorf("expected error")
+	)
+	readAll := func(_ context.Context, key string, r io.Reader) error {
+		require.Equal(t, req.Key, key)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "data", string(b))
+		return nil
+	}
+
+	tests := []struct {
+		name  string
+		req   attachment.StoreReq
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  req,
+			setup: func(m mock) {
+				m.storage.PutMock.Set(readAll)
+				m.timeGen.NowMock.Return(now)
+				m.repo.AddMock.Expect(ctx, want).Return(nil)
+			},
+		},
+		{
+			name: "unknown extension",
+			req:  attachment.StoreReq{Key: "imports/1/data.unknown-ext", Content: strings.NewReader("")},
+			setup: func(m mock) {
+				m.storage.PutMock.Return(nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.AddMock.Expect(ctx, attachment.Attachment{
+					Key: "imports/1/data.unknown-ext", ContentType: "application/octet-stream", CreatedAt: now,
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "invalid key",
+			req:   attachment.StoreReq{Key: "../logo.png"},
+			setup: func(mock) {},
+			err:   attachment.ErrInvalidKey(),
+		},
+		{
+			name: "put error",
+			req:  req,
+			setup: func(m mock) {
+				m.storage.PutMock.Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "add error removes the file",
+			req:  req,
+			setup: func(m mock) {
+				m.storage.PutMock.Set(readAll)
+				m.timeGen.NowMock.Return(now)
+				m.repo.AddMock.Return(errExp)
+				m.storage.DeleteMock.Expect(minimock.AnyContext, req.Key).Return(nil)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tc.setup(m)
+			c, err := attachment.NewCore(m.repo, m.storage, m.timeGen)
+			require.NoError(t, err)
+
+			if tc.req.Content == nil {
+				tc.req.Content = strings.NewReader("data")
+			}
+			err = c.Store(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_SetEntity(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		keys     = []string{"imports/1/a.png", "imports/1/b.png"}
+		entityID = uuid.New()
+		errExp   = fmt.Errorf("expected error")
+	)
+
+	tests := []struct {
+		name     string
+		keys     []string
+		entityID uuid.UUID
+		setup    func(m mock)
+		err      error
+	}{
+		{
+			name:     "ok",
+			keys:     keys,
+			entityID: entityID,
+			setup: func(m mock) {
+				m.repo.SetEntityMock.Expect(ctx, keys, entityID).Return(nil)
+			},
+		},
+		{
+			name:     "no keys",
+			entityID: entityID,
+			setup:    func(mock) {},
+		},
+		{
+			name:  "nil entity",
+			keys:  keys,
+			setup: func(mock) {},
+			err:   apperr.ErrNilUUID(attachment.FieldEntityID),
+		},
+		{
+			name:     "repo error",
+			keys:     keys,
+			entityID: entityID,
+			setup: func(m mock) {
+				m.repo.SetEntityMock.Return(errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tc.setup(m)
+			c, err := attachment.NewCore(m.repo, m.storage, m.timeGen)
+			require.NoError(t, err)
+
+			err = c.SetEntity(ctx, tc.keys, tc.entityID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_Get(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = t.Context()
+		key = "imports/1/logo.png"
+		a   = attachment.Attachment{Key: key, ContentType: "image/png"}
+	)
+
+	tests := []struct {
+		name  string
+		key   string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			key:  key,
+			setup: func(m mock) {
+				m.repo.GetMock.Expect(ctx, key).Return(a, nil)
+			},
+		},
+		{
+			name:  "invalid key",
+			key:   "logo.png",
+			setup: func(mock) {},
+			err:   attachment.ErrInvalidKey(),
+		},
+		{
+			name: "not in the workspace",
+			key:  key,
+			setup: func(m mock) {
+				m.repo.GetMock.Expect(ctx, key).Return(attachment.Attachment{}, attachment.ErrAttachmentNotFound())
+			},
+			err: attachment.ErrAttachmentNotFound(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tc.setup(m)
+			c, err := attachment.NewCore(m.repo, m.storage, m.timeGen)
+			require.NoError(t, err)
+
+			got, err := c.Get(ctx, tc.key)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, a, got)
+		})
+	}
+}
+
+func TestCore_Open(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		key    = "imports/1/logo.png"
+		errExp = fmt.Errorf("expected error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.storage.GetMock.Expect(ctx, key).Return(io.NopCloser(strings.NewReader("data")), nil)
+			},
+		},
+		{
+			name: "file missing",
+			setup: func(m mock) {
+				m.storage.GetMock.Expect(ctx, key).Return(nil, blob.ErrNotFound)
+			},
+			err: attachment.ErrAttachmentNotFound(),
+		},
+		{
+			name: "storage error",
+			setup: func(m mock) {
+				m.storage.GetMock.Expect(ctx, key).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tc.setup(m)
+			c, err := attachment.NewCore(m.repo, m.storage, m.timeGen)
+			require.NoError(t, err)
+
+			content, err := c.Open(ctx, key)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			b, err := io.ReadAll(content)
+			require.NoError(t, err)
+			require.Equal(t, "data", string(b))
+		})
+	}
+}
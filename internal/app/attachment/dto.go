@@ -0,0 +1,75 @@
+package attachment
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldKey      apperr.Field = "key"
+	FieldEntityID apperr.Field = "entity_id"
+)
+
+// Attachment is a file kept in blob storage under Key and served from URL(Key). EntityID is the entity
+// it belongs to, nil until SetEntity is called or after the entity is purged.
+type Attachment struct {
+	Key         string     `json:"key"`
+	EntityID    *uuid.UUID `json:"entity_id,omitempty"`
+	Name        string     `json:"name"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	UploadedBy  *uuid.UUID `json:"uploaded_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// StoreReq is a file to store under Key. Name is the name it was uploaded with.
+type StoreReq struct {
+	Key        string
+	Name       string
+	UploadedBy *uuid.UUID
+	Content    io.Reader
+}
+
+const (
+	// maxKeyLength bounds the blob key of an attachment.
+	maxKeyLength = 512
+	urlPrefix    = "/api/v1/attachments/"
+)
+
+// URL returns the download URL of the attachment stored under key.
+func URL(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return urlPrefix + strings.Join(segments, "/")
+}
+
+// IsKey reports whether s can be the blob key of an attachment: a relative slash-separated path with a
+// directory, as attachments are stored under a prefix, that stays within the store.
+func IsKey(s string) bool {
+	if len(s) > maxKeyLength || strings.Contains(s, "://") || strings.ContainsAny(s, "\\?#") {
+		return false
+	}
+	segments := strings.Split(s, "/")
+	if len(segments) < 2 {
+		return false
+	}
+	for _, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+		if strings.IndexFunc(segment, unicode.IsControl) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
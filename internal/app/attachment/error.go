@@ -0,0 +1,22 @@
+package attachment
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeValidationFailed   apperr.Code = "attachment/validation_failed"
+	CodeAttachmentNotFound apperr.Code = "attachment/not_found"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid attachment", apperr.ClassBadRequest)
+	apperr.Register(CodeAttachmentNotFound, "Attachment not found", apperr.ClassNotFound)
+}
+
+func ErrInvalidKey() error {
+	return apperr.New("key must be a relative path with a directory", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldKey, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrAttachmentNotFound() error {
+	return apperr.New("Attachment not found", CodeAttachmentNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
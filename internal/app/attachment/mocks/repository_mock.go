@@ -0,0 +1,1160 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/attachment.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_attachment "github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_attachment.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, attachment mm_attachment.Attachment) (err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, attachment mm_attachment.Attachment)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mRepositoryMockAdd
+
+	funcGet          func(ctx context.Context, key string) (a1 mm_attachment.Attachment, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, key string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRepositoryMockGet
+
+	funcSetEntity          func(ctx context.Context, keys []string, entityID uuid.UUID) (err error)
+	funcSetEntityOrigin    string
+	inspectFuncSetEntity   func(ctx context.Context, keys []string, entityID uuid.UUID)
+	afterSetEntityCounter  uint64
+	beforeSetEntityCounter uint64
+	SetEntityMock          mRepositoryMockSetEntity
+}
+
+// NewRepositoryMock returns a mock for mm_attachment.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*RepositoryMockAddParams{}
+
+	m.GetMock = mRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*RepositoryMockGetParams{}
+
+	m.SetEntityMock = mRepositoryMockSetEntity{mock: m}
+	m.SetEntityMock.callArgs = []*RepositoryMockSetEntityParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockAdd struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAddExpectation
+	expectations       []*RepositoryMockAddExpectation
+
+	callArgs []*RepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAddExpectation specifies expectation struct of the Repository.Add
+type RepositoryMockAddExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAddParams
+	paramPtrs          *RepositoryMockAddParamPtrs
+	expectationOrigins RepositoryMockAddExpectationOrigins
+	results            *RepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAddParams contains parameters of the Repository.Add
+type RepositoryMockAddParams struct {
+	ctx        context.Context
+	attachment mm_attachment.Attachment
+}
+
+// RepositoryMockAddParamPtrs contains pointers to parameters of the Repository.Add
+type RepositoryMockAddParamPtrs struct {
+	ctx        *context.Context
+	attachment *mm_attachment.Attachment
+}
+
+// RepositoryMockAddResults contains results of the Repository.Add
+type RepositoryMockAddResults struct {
+	err error
+}
+
+// RepositoryMockAddOrigins contains origins of expectations of the Repository.Add
+type RepositoryMockAddExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAttachment string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mRepositoryMockAdd) Optional() *mRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for Repository.Add
+func (mmAdd *mRepositoryMockAdd) Expect(ctx context.Context, attachment mm_attachment.Attachment) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &RepositoryMockAddParams{ctx, attachment}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Add
+func (mmAdd *mRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &RepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectAttachmentParam2 sets up expected param attachment for Repository.Add
+func (mmAdd *mRepositoryMockAdd) ExpectAttachmentParam2(attachment mm_attachment.Attachment) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &RepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.attachment = &attachment
+	mmAdd.defaultExpectation.expectationOrigins.originAttachment = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Add
+func (mmAdd *mRepositoryMockAdd) Inspect(f func(ctx context.Context, attachment mm_attachment.Attachment)) *mRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by Repository.Add
+func (mmAdd *mRepositoryMockAdd) Return(err error) *RepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &RepositoryMockAddResults{err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the Repository.Add method
+func (mmAdd *mRepositoryMockAdd) Set(f func(ctx context.Context, attachment mm_attachment.Attachment) (err error)) *RepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the Repository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the Repository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the Repository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mRepositoryMockAdd) When(ctx context.Context, attachment mm_attachment.Attachment) *RepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &RepositoryMockAddParams{ctx, attachment},
+		expectationOrigins: RepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Add return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Add should be invoked
+func (mmAdd *mRepositoryMockAdd) Times(n uint64) *mRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of RepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_attachment.Repository
+func (mmAdd *RepositoryMock) Add(ctx context.Context, attachment mm_attachment.Attachment) (err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, attachment)
+	}
+
+	mm_params := RepositoryMockAddParams{ctx, attachment}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddParams{ctx, attachment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.attachment != nil && !minimock.Equal(*mm_want_ptrs.attachment, mm_got.attachment) {
+				mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameter attachment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originAttachment, *mm_want_ptrs.attachment, mm_got.attachment, minimock.Diff(*mm_want_ptrs.attachment, mm_got.attachment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the RepositoryMock.Add")
+		}
+		return (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, attachment)
+	}
+	mmAdd.t.Fatalf("Unexpected call to RepositoryMock.Add. %v %v", ctx, attachment)
+	return
+}
+
+// AddAfterCounter returns a count of finished RepositoryMock.Add invocations
+func (mmAdd *RepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of RepositoryMock.Add invocations
+func (mmAdd *RepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mRepositoryMockAdd) Calls() []*RepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mRepositoryMockGet struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetExpectation
+	expectations       []*RepositoryMockGetExpectation
+
+	callArgs []*RepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetExpectation specifies expectation struct of the Repository.Get
+type RepositoryMockGetExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetParams
+	paramPtrs          *RepositoryMockGetParamPtrs
+	expectationOrigins RepositoryMockGetExpectationOrigins
+	results            *RepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetParams contains parameters of the Repository.Get
+type RepositoryMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// RepositoryMockGetParamPtrs contains pointers to parameters of the Repository.Get
+type RepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// RepositoryMockGetResults contains results of the Repository.Get
+type RepositoryMockGetResults struct {
+	a1  mm_attachment.Attachment
+	err error
+}
+
+// RepositoryMockGetOrigins contains origins of expectations of the Repository.Get
+type RepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRepositoryMockGet) Optional() *mRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Repository.Get
+func (mmGet *mRepositoryMockGet) Expect(ctx context.Context, key string) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RepositoryMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Get
+func (mmGet *mRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for Repository.Get
+func (mmGet *mRepositoryMockGet) ExpectKeyParam2(key string) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Get
+func (mmGet *mRepositoryMockGet) Inspect(f func(ctx context.Context, key string)) *mRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Repository.Get
+func (mmGet *mRepositoryMockGet) Return(a1 mm_attachment.Attachment, err error) *RepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RepositoryMockGetResults{a1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Repository.Get method
+func (mmGet *mRepositoryMockGet) Set(f func(ctx context.Context, key string) (a1 mm_attachment.Attachment, err error)) *RepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Repository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Repository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Repository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRepositoryMockGet) When(ctx context.Context, key string) *RepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &RepositoryMockGetParams{ctx, key},
+		expectationOrigins: RepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Get return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetExpectation) Then(a1 mm_attachment.Attachment, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Get should be invoked
+func (mmGet *mRepositoryMockGet) Times(n uint64) *mRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_attachment.Repository
+func (mmGet *RepositoryMock) Get(ctx context.Context, key string) (a1 mm_attachment.Attachment, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := RepositoryMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("RepositoryMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RepositoryMock.Get")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to RepositoryMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished RepositoryMock.Get invocations
+func (mmGet *RepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RepositoryMock.Get invocations
+func (mmGet *RepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRepositoryMockGet) Calls() []*RepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mRepositoryMockSetEntity struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSetEntityExpectation
+	expectations       []*RepositoryMockSetEntityExpectation
+
+	callArgs []*RepositoryMockSetEntityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSetEntityExpectation specifies expectation struct of the Repository.SetEntity
+type RepositoryMockSetEntityExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSetEntityParams
+	paramPtrs          *RepositoryMockSetEntityParamPtrs
+	expectationOrigins RepositoryMockSetEntityExpectationOrigins
+	results            *RepositoryMockSetEntityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSetEntityParams contains parameters of the Repository.SetEntity
+type RepositoryMockSetEntityParams struct {
+	ctx      context.Context
+	keys     []string
+	entityID uuid.UUID
+}
+
+// RepositoryMockSetEntityParamPtrs contains pointers to parameters of the Repository.SetEntity
+type RepositoryMockSetEntityParamPtrs struct {
+	ctx      *context.Context
+	keys     *[]string
+	entityID *uuid.UUID
+}
+
+// RepositoryMockSetEntityResults contains results of the Repository.SetEntity
+type RepositoryMockSetEntityResults struct {
+	err error
+}
+
+// RepositoryMockSetEntityOrigins contains origins of expectations of the Repository.SetEntity
+type RepositoryMockSetEntityExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originKeys     string
+	originEntityID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetEntity *mRepositoryMockSetEntity) Optional() *mRepositoryMockSetEntity {
+	mmSetEntity.optional = true
+	return mmSetEntity
+}
+
+// Expect sets up expected params for Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) Expect(ctx context.Context, keys []string, entityID uuid.UUID) *mRepositoryMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &RepositoryMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by ExpectParams functions")
+	}
+
+	mmSetEntity.defaultExpectation.params = &RepositoryMockSetEntityParams{ctx, keys, entityID}
+	mmSetEntity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetEntity.expectations {
+		if minimock.Equal(e.params, mmSetEntity.defaultExpectation.params) {
+			mmSetEntity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetEntity.defaultExpectation.params)
+		}
+	}
+
+	return mmSetEntity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &RepositoryMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &RepositoryMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetEntity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// ExpectKeysParam2 sets up expected param keys for Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) ExpectKeysParam2(keys []string) *mRepositoryMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &RepositoryMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &RepositoryMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.keys = &keys
+	mmSetEntity.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// ExpectEntityIDParam3 sets up expected param entityID for Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) ExpectEntityIDParam3(entityID uuid.UUID) *mRepositoryMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &RepositoryMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &RepositoryMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.entityID = &entityID
+	mmSetEntity.defaultExpectation.expectationOrigins.originEntityID = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) Inspect(f func(ctx context.Context, keys []string, entityID uuid.UUID)) *mRepositoryMockSetEntity {
+	if mmSetEntity.mock.inspectFuncSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SetEntity")
+	}
+
+	mmSetEntity.mock.inspectFuncSetEntity = f
+
+	return mmSetEntity
+}
+
+// Return sets up results that will be returned by Repository.SetEntity
+func (mmSetEntity *mRepositoryMockSetEntity) Return(err error) *RepositoryMock {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &RepositoryMockSetEntityExpectation{mock: mmSetEntity.mock}
+	}
+	mmSetEntity.defaultExpectation.results = &RepositoryMockSetEntityResults{err}
+	mmSetEntity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetEntity.mock
+}
+
+// Set uses given function f to mock the Repository.SetEntity method
+func (mmSetEntity *mRepositoryMockSetEntity) Set(f func(ctx context.Context, keys []string, entityID uuid.UUID) (err error)) *RepositoryMock {
+	if mmSetEntity.defaultExpectation != nil {
+		mmSetEntity.mock.t.Fatalf("Default expectation is already set for the Repository.SetEntity method")
+	}
+
+	if len(mmSetEntity.expectations) > 0 {
+		mmSetEntity.mock.t.Fatalf("Some expectations are already set for the Repository.SetEntity method")
+	}
+
+	mmSetEntity.mock.funcSetEntity = f
+	mmSetEntity.mock.funcSetEntityOrigin = minimock.CallerInfo(1)
+	return mmSetEntity.mock
+}
+
+// When sets expectation for the Repository.SetEntity which will trigger the result defined by the following
+// Then helper
+func (mmSetEntity *mRepositoryMockSetEntity) When(ctx context.Context, keys []string, entityID uuid.UUID) *RepositoryMockSetEntityExpectation {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("RepositoryMock.SetEntity mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSetEntityExpectation{
+		mock:               mmSetEntity.mock,
+		params:             &RepositoryMockSetEntityParams{ctx, keys, entityID},
+		expectationOrigins: RepositoryMockSetEntityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetEntity.expectations = append(mmSetEntity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SetEntity return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSetEntityExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSetEntityResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SetEntity should be invoked
+func (mmSetEntity *mRepositoryMockSetEntity) Times(n uint64) *mRepositoryMockSetEntity {
+	if n == 0 {
+		mmSetEntity.mock.t.Fatalf("Times of RepositoryMock.SetEntity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetEntity.expectedInvocations, n)
+	mmSetEntity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetEntity
+}
+
+func (mmSetEntity *mRepositoryMockSetEntity) invocationsDone() bool {
+	if len(mmSetEntity.expectations) == 0 && mmSetEntity.defaultExpectation == nil && mmSetEntity.mock.funcSetEntity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetEntity.mock.afterSetEntityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetEntity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetEntity implements mm_attachment.Repository
+func (mmSetEntity *RepositoryMock) SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmSetEntity.beforeSetEntityCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetEntity.afterSetEntityCounter, 1)
+
+	mmSetEntity.t.Helper()
+
+	if mmSetEntity.inspectFuncSetEntity != nil {
+		mmSetEntity.inspectFuncSetEntity(ctx, keys, entityID)
+	}
+
+	mm_params := RepositoryMockSetEntityParams{ctx, keys, entityID}
+
+	// Record call args
+	mmSetEntity.SetEntityMock.mutex.Lock()
+	mmSetEntity.SetEntityMock.callArgs = append(mmSetEntity.SetEntityMock.callArgs, &mm_params)
+	mmSetEntity.SetEntityMock.mutex.Unlock()
+
+	for _, e := range mmSetEntity.SetEntityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetEntity.SetEntityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetEntity.SetEntityMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetEntity.SetEntityMock.defaultExpectation.params
+		mm_want_ptrs := mmSetEntity.SetEntityMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSetEntityParams{ctx, keys, entityID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetEntity.t.Errorf("RepositoryMock.SetEntity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmSetEntity.t.Errorf("RepositoryMock.SetEntity got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+			if mm_want_ptrs.entityID != nil && !minimock.Equal(*mm_want_ptrs.entityID, mm_got.entityID) {
+				mmSetEntity.t.Errorf("RepositoryMock.SetEntity got unexpected parameter entityID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originEntityID, *mm_want_ptrs.entityID, mm_got.entityID, minimock.Diff(*mm_want_ptrs.entityID, mm_got.entityID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetEntity.t.Errorf("RepositoryMock.SetEntity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetEntity.SetEntityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetEntity.t.Fatal("No results are set for the RepositoryMock.SetEntity")
+		}
+		return (*mm_results).err
+	}
+	if mmSetEntity.funcSetEntity != nil {
+		return mmSetEntity.funcSetEntity(ctx, keys, entityID)
+	}
+	mmSetEntity.t.Fatalf("Unexpected call to RepositoryMock.SetEntity. %v %v %v", ctx, keys, entityID)
+	return
+}
+
+// SetEntityAfterCounter returns a count of finished RepositoryMock.SetEntity invocations
+func (mmSetEntity *RepositoryMock) SetEntityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEntity.afterSetEntityCounter)
+}
+
+// SetEntityBeforeCounter returns a count of RepositoryMock.SetEntity invocations
+func (mmSetEntity *RepositoryMock) SetEntityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEntity.beforeSetEntityCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SetEntity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetEntity *mRepositoryMockSetEntity) Calls() []*RepositoryMockSetEntityParams {
+	mmSetEntity.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSetEntityParams, len(mmSetEntity.callArgs))
+	copy(argCopy, mmSetEntity.callArgs)
+
+	mmSetEntity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetEntityDone returns true if the count of the SetEntity invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSetEntityDone() bool {
+	if m.SetEntityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetEntityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetEntityMock.invocationsDone()
+}
+
+// MinimockSetEntityInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSetEntityInspect() {
+	for _, e := range m.SetEntityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SetEntity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetEntityCounter := mm_atomic.LoadUint64(&m.afterSetEntityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetEntityMock.defaultExpectation != nil && afterSetEntityCounter < 1 {
+		if m.SetEntityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SetEntity at\n%s", m.SetEntityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SetEntity at\n%s with params: %#v", m.SetEntityMock.defaultExpectation.expectationOrigins.origin, *m.SetEntityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetEntity != nil && afterSetEntityCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SetEntity at\n%s", m.funcSetEntityOrigin)
+	}
+
+	if !m.SetEntityMock.invocationsDone() && afterSetEntityCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SetEntity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetEntityMock.expectedInvocations), m.SetEntityMock.expectedInvocationsOrigin, afterSetEntityCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockSetEntityInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockSetEntityDone()
+}
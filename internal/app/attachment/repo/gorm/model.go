@@ -0,0 +1,35 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/google/uuid"
+)
+
+type attachmentModel struct {
+	BlobKey     string `gorm:"primaryKey"`
+	WorkspaceID uuid.UUID
+	EntityID    *uuid.UUID
+	Name        string
+	ContentType string
+	Size        int64
+	UploadedBy  *uuid.UUID
+	CreatedAt   time.Time
+}
+
+func (m *attachmentModel) TableName() string {
+	return "attachments"
+}
+
+func (m *attachmentModel) toDTO() attachment.Attachment {
+	return attachment.Attachment{
+		Key:         m.BlobKey,
+		EntityID:    m.EntityID,
+		Name:        m.Name,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		UploadedBy:  m.UploadedBy,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// Add records the attachment in the workspace of the request, or the default one outside a request.
+func (r *gormRepo) Add(ctx context.Context, a attachment.Attachment) error {
+	model := attachmentModel{
+		BlobKey:     a.Key,
+		WorkspaceID: contextx.WorkspaceID(ctx),
+		EntityID:    a.EntityID,
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return fmt.Errorf("gormRepo.Add: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) Get(ctx context.Context, key string) (attachment.Attachment, error) {
+	var model attachmentModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("blob_key = ?", key).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = attachment.ErrAttachmentNotFound()
+		}
+		return attachment.Attachment{}, fmt.Errorf("gormRepo.Get: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+// SetEntity records entityID as the entity of the attachments stored under keys in the workspace of ctx.
+func (r *gormRepo) SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Model(&attachmentModel{}).Scopes(db.InWorkspace(ctx)).
+		Where("blob_key IN ?", keys).Update("entity_id", entityID).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetEntity: %w", err)
+	}
+
+	return nil
+}
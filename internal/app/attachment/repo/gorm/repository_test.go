@@ -0,0 +1,111 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestAttachments(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	other := uuid.New()
+	require.NoError(t, gdb.Exec(`INSERT INTO workspaces (id, slug, name, created_at, updated_at)
+		VALUES (?, 'other', 'Other', NOW(), NOW())`, other).Error)
+	defaultCtx := contextx.SetWorkspaceID(t.Context(), contextx.DefaultWorkspaceID)
+	otherCtx := contextx.SetWorkspaceID(t.Context(), other)
+
+	userID := createUser(t, gdb)
+	a := attachment.Attachment{
+		Key: "imports/1/logo.png", Name: "attachments/1/logo.png", ContentType: "image/png", Size: 10,
+		UploadedBy: &userID, CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	require.NoError(t, repo.Add(defaultCtx, a))
+	require.Error(t, repo.Add(defaultCtx, a))
+
+	got, err := repo.Get(defaultCtx, a.Key)
+	require.NoError(t, err)
+	require.Equal(t, a.Name, got.Name)
+	require.Equal(t, a.ContentType, got.ContentType)
+	require.Equal(t, a.Size, got.Size)
+	require.Equal(t, &userID, got.UploadedBy)
+	require.True(t, a.CreatedAt.Equal(got.CreatedAt))
+
+	require.Nil(t, got.EntityID)
+
+	entityID := createEntity(t, gdb, userID)
+	require.NoError(t, repo.SetEntity(defaultCtx, []string{a.Key}, entityID))
+	got, err = repo.Get(defaultCtx, a.Key)
+	require.NoError(t, err)
+	require.Equal(t, &entityID, got.EntityID)
+
+	// another workspace does not see it
+	_, err = repo.Get(otherCtx, a.Key)
+	require.ErrorIs(t, err, attachment.ErrAttachmentNotFound())
+	require.NoError(t, repo.SetEntity(otherCtx, []string{a.Key}, uuid.New()))
+	got, err = repo.Get(defaultCtx, a.Key)
+	require.NoError(t, err)
+	require.Equal(t, &entityID, got.EntityID)
+	_, err = repo.Get(defaultCtx, "imports/1/missing.png")
+	require.ErrorIs(t, err, attachment.ErrAttachmentNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.Get(defaultCtx, a.Key)
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	email := uid.String() + "@example.com"
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, email, "Test", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
+
+func createEntity(t *testing.T, gdb *gorm.DB, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	eid := uuid.New()
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,created_by,updated_by,owner_id)
+		 VALUES ($1,'t',NOW(),NOW(),'name',$1::TEXT,'',$2,$2,$2)`,
+		eid, userID,
+	).Error
+	require.NoError(t, err)
+
+	return eid
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// URLParamKey is the wildcard the key is mounted under, as keys contain slashes.
+	URLParamKey = "*"
+
+	// cacheControl lets clients cache attachments; a key is never reused for other content.
+	cacheControl = "private, max-age=86400"
+)
+
+type Service interface {
+	Open(ctx context.Context, key string) (attachment.Attachment, io.ReadCloser, error)
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("attachment HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// Get godoc
+// @Summary      Download attachment
+// @Description  Returns the file stored under the key, such as an icon or a cover set by key, if it was stored in the current workspace. Requires read permission for the entity the attachment belongs to; an attachment that belongs to no entity can only be read by admins. The file is served sandboxed, so HTML and SVG attachments cannot run scripts.
+// @Tags         attachments
+// @Security     BearerAuth
+// @Produce      octet-stream
+// @Param        key path string true "Attachment key, e.g. imports/<import_id>/logo.png"
+// @Success      200 {file} file
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /attachments/{key} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	keyStr := chi.URLParam(r, URLParamKey)
+	key, err := url.PathUnescape(keyStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(attachment.FieldKey.String(), keyStr).
+			Msg("attachment.Handler.Get: invalid key format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	a, content, err := h.svc.Open(ctx, key)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, content); err != nil {
+		logger.Error(ctx, err).
+			Str(attachment.FieldKey.String(), key).
+			Msg("attachment.Handler.Get: failed to write response")
+	}
+}
@@ -0,0 +1,80 @@
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	attachment_http "github.com/66gu1/easygodocs/internal/app/attachment/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/attachment/transport/http/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		path       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "ok -> 200",
+			path: "imports/1/a%20b.png",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.OpenMock.Expect(minimock.AnyContext, "imports/1/a b.png").
+					Return(attachment.Attachment{Key: "imports/1/a b.png", ContentType: "image/png"},
+						io.NopCloser(strings.NewReader("data")), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "data",
+		},
+		{
+			name: "not found -> 404",
+			path: "imports/1/logo.png",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.OpenMock.Expect(minimock.AnyContext, "imports/1/logo.png").
+					Return(attachment.Attachment{}, nil, attachment.ErrAttachmentNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "invalid key -> 400",
+			path: "logo.png",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.OpenMock.Expect(minimock.AnyContext, "logo.png").
+					Return(attachment.Attachment{}, nil, attachment.ErrInvalidKey())
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			tc.setup(mock)
+			h := attachment_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Get("/attachments/"+attachment_http.URLParamKey, h.Get)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/attachments/"+tc.path, nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.Equal(t, tc.wantBody, rr.Body.String())
+				require.Equal(t, "image/png", rr.Header().Get("Content-Type"))
+				require.Equal(t, "sandbox", rr.Header().Get("Content-Security-Policy"))
+			}
+		})
+	}
+}
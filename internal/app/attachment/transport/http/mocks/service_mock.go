@@ -0,0 +1,420 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/attachment/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcOpen          func(ctx context.Context, key string) (a1 attachment.Attachment, r1 io.ReadCloser, err error)
+	funcOpenOrigin    string
+	inspectFuncOpen   func(ctx context.Context, key string)
+	afterOpenCounter  uint64
+	beforeOpenCounter uint64
+	OpenMock          mServiceMockOpen
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.OpenMock = mServiceMockOpen{mock: m}
+	m.OpenMock.callArgs = []*ServiceMockOpenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockOpen struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockOpenExpectation
+	expectations       []*ServiceMockOpenExpectation
+
+	callArgs []*ServiceMockOpenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockOpenExpectation specifies expectation struct of the Service.Open
+type ServiceMockOpenExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockOpenParams
+	paramPtrs          *ServiceMockOpenParamPtrs
+	expectationOrigins ServiceMockOpenExpectationOrigins
+	results            *ServiceMockOpenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockOpenParams contains parameters of the Service.Open
+type ServiceMockOpenParams struct {
+	ctx context.Context
+	key string
+}
+
+// ServiceMockOpenParamPtrs contains pointers to parameters of the Service.Open
+type ServiceMockOpenParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ServiceMockOpenResults contains results of the Service.Open
+type ServiceMockOpenResults struct {
+	a1  attachment.Attachment
+	r1  io.ReadCloser
+	err error
+}
+
+// ServiceMockOpenOrigins contains origins of expectations of the Service.Open
+type ServiceMockOpenExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpen *mServiceMockOpen) Optional() *mServiceMockOpen {
+	mmOpen.optional = true
+	return mmOpen
+}
+
+// Expect sets up expected params for Service.Open
+func (mmOpen *mServiceMockOpen) Expect(ctx context.Context, key string) *mServiceMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &ServiceMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by ExpectParams functions")
+	}
+
+	mmOpen.defaultExpectation.params = &ServiceMockOpenParams{ctx, key}
+	mmOpen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpen.expectations {
+		if minimock.Equal(e.params, mmOpen.defaultExpectation.params) {
+			mmOpen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpen.defaultExpectation.params)
+		}
+	}
+
+	return mmOpen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Open
+func (mmOpen *mServiceMockOpen) ExpectCtxParam1(ctx context.Context) *mServiceMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &ServiceMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &ServiceMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// ExpectKeyParam2 sets up expected param key for Service.Open
+func (mmOpen *mServiceMockOpen) ExpectKeyParam2(key string) *mServiceMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &ServiceMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &ServiceMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.key = &key
+	mmOpen.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Open
+func (mmOpen *mServiceMockOpen) Inspect(f func(ctx context.Context, key string)) *mServiceMockOpen {
+	if mmOpen.mock.inspectFuncOpen != nil {
+		mmOpen.mock.t.Fatalf("Inspect function is already set for ServiceMock.Open")
+	}
+
+	mmOpen.mock.inspectFuncOpen = f
+
+	return mmOpen
+}
+
+// Return sets up results that will be returned by Service.Open
+func (mmOpen *mServiceMockOpen) Return(a1 attachment.Attachment, r1 io.ReadCloser, err error) *ServiceMock {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &ServiceMockOpenExpectation{mock: mmOpen.mock}
+	}
+	mmOpen.defaultExpectation.results = &ServiceMockOpenResults{a1, r1, err}
+	mmOpen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// Set uses given function f to mock the Service.Open method
+func (mmOpen *mServiceMockOpen) Set(f func(ctx context.Context, key string) (a1 attachment.Attachment, r1 io.ReadCloser, err error)) *ServiceMock {
+	if mmOpen.defaultExpectation != nil {
+		mmOpen.mock.t.Fatalf("Default expectation is already set for the Service.Open method")
+	}
+
+	if len(mmOpen.expectations) > 0 {
+		mmOpen.mock.t.Fatalf("Some expectations are already set for the Service.Open method")
+	}
+
+	mmOpen.mock.funcOpen = f
+	mmOpen.mock.funcOpenOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// When sets expectation for the Service.Open which will trigger the result defined by the following
+// Then helper
+func (mmOpen *mServiceMockOpen) When(ctx context.Context, key string) *ServiceMockOpenExpectation {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("ServiceMock.Open mock is already set by Set")
+	}
+
+	expectation := &ServiceMockOpenExpectation{
+		mock:               mmOpen.mock,
+		params:             &ServiceMockOpenParams{ctx, key},
+		expectationOrigins: ServiceMockOpenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpen.expectations = append(mmOpen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Open return parameters for the expectation previously defined by the When method
+func (e *ServiceMockOpenExpectation) Then(a1 attachment.Attachment, r1 io.ReadCloser, err error) *ServiceMock {
+	e.results = &ServiceMockOpenResults{a1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Open should be invoked
+func (mmOpen *mServiceMockOpen) Times(n uint64) *mServiceMockOpen {
+	if n == 0 {
+		mmOpen.mock.t.Fatalf("Times of ServiceMock.Open mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpen.expectedInvocations, n)
+	mmOpen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpen
+}
+
+func (mmOpen *mServiceMockOpen) invocationsDone() bool {
+	if len(mmOpen.expectations) == 0 && mmOpen.defaultExpectation == nil && mmOpen.mock.funcOpen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpen.mock.afterOpenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Open implements mm_http.Service
+func (mmOpen *ServiceMock) Open(ctx context.Context, key string) (a1 attachment.Attachment, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpen.beforeOpenCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpen.afterOpenCounter, 1)
+
+	mmOpen.t.Helper()
+
+	if mmOpen.inspectFuncOpen != nil {
+		mmOpen.inspectFuncOpen(ctx, key)
+	}
+
+	mm_params := ServiceMockOpenParams{ctx, key}
+
+	// Record call args
+	mmOpen.OpenMock.mutex.Lock()
+	mmOpen.OpenMock.callArgs = append(mmOpen.OpenMock.callArgs, &mm_params)
+	mmOpen.OpenMock.mutex.Unlock()
+
+	for _, e := range mmOpen.OpenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpen.OpenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpen.OpenMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpen.OpenMock.defaultExpectation.params
+		mm_want_ptrs := mmOpen.OpenMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockOpenParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpen.t.Errorf("ServiceMock.Open got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmOpen.t.Errorf("ServiceMock.Open got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpen.t.Errorf("ServiceMock.Open got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpen.OpenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpen.OpenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpen.t.Fatal("No results are set for the ServiceMock.Open")
+		}
+		return (*mm_results).a1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpen.funcOpen != nil {
+		return mmOpen.funcOpen(ctx, key)
+	}
+	mmOpen.t.Fatalf("Unexpected call to ServiceMock.Open. %v %v", ctx, key)
+	return
+}
+
+// OpenAfterCounter returns a count of finished ServiceMock.Open invocations
+func (mmOpen *ServiceMock) OpenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.afterOpenCounter)
+}
+
+// OpenBeforeCounter returns a count of ServiceMock.Open invocations
+func (mmOpen *ServiceMock) OpenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.beforeOpenCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Open.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpen *mServiceMockOpen) Calls() []*ServiceMockOpenParams {
+	mmOpen.mutex.RLock()
+
+	argCopy := make([]*ServiceMockOpenParams, len(mmOpen.callArgs))
+	copy(argCopy, mmOpen.callArgs)
+
+	mmOpen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenDone returns true if the count of the Open invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockOpenDone() bool {
+	if m.OpenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenMock.invocationsDone()
+}
+
+// MinimockOpenInspect logs each unmet expectation
+func (m *ServiceMock) MinimockOpenInspect() {
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Open at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenCounter := mm_atomic.LoadUint64(&m.afterOpenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenMock.defaultExpectation != nil && afterOpenCounter < 1 {
+		if m.OpenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Open at\n%s", m.OpenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Open at\n%s with params: %#v", m.OpenMock.defaultExpectation.expectationOrigins.origin, *m.OpenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpen != nil && afterOpenCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Open at\n%s", m.funcOpenOrigin)
+	}
+
+	if !m.OpenMock.invocationsDone() && afterOpenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Open at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenMock.expectedInvocations), m.OpenMock.expectedInvocationsOrigin, afterOpenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockOpenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockOpenDone()
+}
@@ -0,0 +1,385 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/attachment/usecase.AdminChecker -o admin_checker_mock.go -n AdminCheckerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AdminCheckerMock implements mm_usecase.AdminChecker
+type AdminCheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckIsAdmin          func(ctx context.Context) (err error)
+	funcCheckIsAdminOrigin    string
+	inspectFuncCheckIsAdmin   func(ctx context.Context)
+	afterCheckIsAdminCounter  uint64
+	beforeCheckIsAdminCounter uint64
+	CheckIsAdminMock          mAdminCheckerMockCheckIsAdmin
+}
+
+// NewAdminCheckerMock returns a mock for mm_usecase.AdminChecker
+func NewAdminCheckerMock(t minimock.Tester) *AdminCheckerMock {
+	m := &AdminCheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckIsAdminMock = mAdminCheckerMockCheckIsAdmin{mock: m}
+	m.CheckIsAdminMock.callArgs = []*AdminCheckerMockCheckIsAdminParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAdminCheckerMockCheckIsAdmin struct {
+	optional           bool
+	mock               *AdminCheckerMock
+	defaultExpectation *AdminCheckerMockCheckIsAdminExpectation
+	expectations       []*AdminCheckerMockCheckIsAdminExpectation
+
+	callArgs []*AdminCheckerMockCheckIsAdminParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AdminCheckerMockCheckIsAdminExpectation specifies expectation struct of the AdminChecker.CheckIsAdmin
+type AdminCheckerMockCheckIsAdminExpectation struct {
+	mock               *AdminCheckerMock
+	params             *AdminCheckerMockCheckIsAdminParams
+	paramPtrs          *AdminCheckerMockCheckIsAdminParamPtrs
+	expectationOrigins AdminCheckerMockCheckIsAdminExpectationOrigins
+	results            *AdminCheckerMockCheckIsAdminResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AdminCheckerMockCheckIsAdminParams contains parameters of the AdminChecker.CheckIsAdmin
+type AdminCheckerMockCheckIsAdminParams struct {
+	ctx context.Context
+}
+
+// AdminCheckerMockCheckIsAdminParamPtrs contains pointers to parameters of the AdminChecker.CheckIsAdmin
+type AdminCheckerMockCheckIsAdminParamPtrs struct {
+	ctx *context.Context
+}
+
+// AdminCheckerMockCheckIsAdminResults contains results of the AdminChecker.CheckIsAdmin
+type AdminCheckerMockCheckIsAdminResults struct {
+	err error
+}
+
+// AdminCheckerMockCheckIsAdminOrigins contains origins of expectations of the AdminChecker.CheckIsAdmin
+type AdminCheckerMockCheckIsAdminExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Optional() *mAdminCheckerMockCheckIsAdmin {
+	mmCheckIsAdmin.optional = true
+	return mmCheckIsAdmin
+}
+
+// Expect sets up expected params for AdminChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Expect(ctx context.Context) *mAdminCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &AdminCheckerMockCheckIsAdminExpectation{}
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.paramPtrs != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by ExpectParams functions")
+	}
+
+	mmCheckIsAdmin.defaultExpectation.params = &AdminCheckerMockCheckIsAdminParams{ctx}
+	mmCheckIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckIsAdmin.expectations {
+		if minimock.Equal(e.params, mmCheckIsAdmin.defaultExpectation.params) {
+			mmCheckIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIsAdmin.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckIsAdmin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AdminChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) ExpectCtxParam1(ctx context.Context) *mAdminCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &AdminCheckerMockCheckIsAdminExpectation{}
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.params != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by Expect")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.paramPtrs == nil {
+		mmCheckIsAdmin.defaultExpectation.paramPtrs = &AdminCheckerMockCheckIsAdminParamPtrs{}
+	}
+	mmCheckIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckIsAdmin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AdminChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Inspect(f func(ctx context.Context)) *mAdminCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("Inspect function is already set for AdminCheckerMock.CheckIsAdmin")
+	}
+
+	mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin = f
+
+	return mmCheckIsAdmin
+}
+
+// Return sets up results that will be returned by AdminChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Return(err error) *AdminCheckerMock {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &AdminCheckerMockCheckIsAdminExpectation{mock: mmCheckIsAdmin.mock}
+	}
+	mmCheckIsAdmin.defaultExpectation.results = &AdminCheckerMockCheckIsAdminResults{err}
+	mmCheckIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin.mock
+}
+
+// Set uses given function f to mock the AdminChecker.CheckIsAdmin method
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Set(f func(ctx context.Context) (err error)) *AdminCheckerMock {
+	if mmCheckIsAdmin.defaultExpectation != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("Default expectation is already set for the AdminChecker.CheckIsAdmin method")
+	}
+
+	if len(mmCheckIsAdmin.expectations) > 0 {
+		mmCheckIsAdmin.mock.t.Fatalf("Some expectations are already set for the AdminChecker.CheckIsAdmin method")
+	}
+
+	mmCheckIsAdmin.mock.funcCheckIsAdmin = f
+	mmCheckIsAdmin.mock.funcCheckIsAdminOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin.mock
+}
+
+// When sets expectation for the AdminChecker.CheckIsAdmin which will trigger the result defined by the following
+// Then helper
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) When(ctx context.Context) *AdminCheckerMockCheckIsAdminExpectation {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("AdminCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	expectation := &AdminCheckerMockCheckIsAdminExpectation{
+		mock:               mmCheckIsAdmin.mock,
+		params:             &AdminCheckerMockCheckIsAdminParams{ctx},
+		expectationOrigins: AdminCheckerMockCheckIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckIsAdmin.expectations = append(mmCheckIsAdmin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AdminChecker.CheckIsAdmin return parameters for the expectation previously defined by the When method
+func (e *AdminCheckerMockCheckIsAdminExpectation) Then(err error) *AdminCheckerMock {
+	e.results = &AdminCheckerMockCheckIsAdminResults{err}
+	return e.mock
+}
+
+// Times sets number of times AdminChecker.CheckIsAdmin should be invoked
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Times(n uint64) *mAdminCheckerMockCheckIsAdmin {
+	if n == 0 {
+		mmCheckIsAdmin.mock.t.Fatalf("Times of AdminCheckerMock.CheckIsAdmin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckIsAdmin.expectedInvocations, n)
+	mmCheckIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin
+}
+
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) invocationsDone() bool {
+	if len(mmCheckIsAdmin.expectations) == 0 && mmCheckIsAdmin.defaultExpectation == nil && mmCheckIsAdmin.mock.funcCheckIsAdmin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.mock.afterCheckIsAdminCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckIsAdmin implements mm_usecase.AdminChecker
+func (mmCheckIsAdmin *AdminCheckerMock) CheckIsAdmin(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter, 1)
+
+	mmCheckIsAdmin.t.Helper()
+
+	if mmCheckIsAdmin.inspectFuncCheckIsAdmin != nil {
+		mmCheckIsAdmin.inspectFuncCheckIsAdmin(ctx)
+	}
+
+	mm_params := AdminCheckerMockCheckIsAdminParams{ctx}
+
+	// Record call args
+	mmCheckIsAdmin.CheckIsAdminMock.mutex.Lock()
+	mmCheckIsAdmin.CheckIsAdminMock.callArgs = append(mmCheckIsAdmin.CheckIsAdminMock.callArgs, &mm_params)
+	mmCheckIsAdmin.CheckIsAdminMock.mutex.Unlock()
+
+	for _, e := range mmCheckIsAdmin.CheckIsAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.paramPtrs
+
+		mm_got := AdminCheckerMockCheckIsAdminParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckIsAdmin.t.Errorf("AdminCheckerMock.CheckIsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckIsAdmin.t.Errorf("AdminCheckerMock.CheckIsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckIsAdmin.t.Fatal("No results are set for the AdminCheckerMock.CheckIsAdmin")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckIsAdmin.funcCheckIsAdmin != nil {
+		return mmCheckIsAdmin.funcCheckIsAdmin(ctx)
+	}
+	mmCheckIsAdmin.t.Fatalf("Unexpected call to AdminCheckerMock.CheckIsAdmin. %v", ctx)
+	return
+}
+
+// CheckIsAdminAfterCounter returns a count of finished AdminCheckerMock.CheckIsAdmin invocations
+func (mmCheckIsAdmin *AdminCheckerMock) CheckIsAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter)
+}
+
+// CheckIsAdminBeforeCounter returns a count of AdminCheckerMock.CheckIsAdmin invocations
+func (mmCheckIsAdmin *AdminCheckerMock) CheckIsAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to AdminCheckerMock.CheckIsAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckIsAdmin *mAdminCheckerMockCheckIsAdmin) Calls() []*AdminCheckerMockCheckIsAdminParams {
+	mmCheckIsAdmin.mutex.RLock()
+
+	argCopy := make([]*AdminCheckerMockCheckIsAdminParams, len(mmCheckIsAdmin.callArgs))
+	copy(argCopy, mmCheckIsAdmin.callArgs)
+
+	mmCheckIsAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckIsAdminDone returns true if the count of the CheckIsAdmin invocations corresponds
+// the number of defined expectations
+func (m *AdminCheckerMock) MinimockCheckIsAdminDone() bool {
+	if m.CheckIsAdminMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckIsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckIsAdminMock.invocationsDone()
+}
+
+// MinimockCheckIsAdminInspect logs each unmet expectation
+func (m *AdminCheckerMock) MinimockCheckIsAdminInspect() {
+	for _, e := range m.CheckIsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AdminCheckerMock.CheckIsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckIsAdminCounter := mm_atomic.LoadUint64(&m.afterCheckIsAdminCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckIsAdminMock.defaultExpectation != nil && afterCheckIsAdminCounter < 1 {
+		if m.CheckIsAdminMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminCheckerMock.CheckIsAdmin at\n%s", m.CheckIsAdminMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AdminCheckerMock.CheckIsAdmin at\n%s with params: %#v", m.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *m.CheckIsAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckIsAdmin != nil && afterCheckIsAdminCounter < 1 {
+		m.t.Errorf("Expected call to AdminCheckerMock.CheckIsAdmin at\n%s", m.funcCheckIsAdminOrigin)
+	}
+
+	if !m.CheckIsAdminMock.invocationsDone() && afterCheckIsAdminCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminCheckerMock.CheckIsAdmin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckIsAdminMock.expectedInvocations), m.CheckIsAdminMock.expectedInvocationsOrigin, afterCheckIsAdminCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AdminCheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckIsAdminInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AdminCheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AdminCheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckIsAdminDone()
+}
@@ -0,0 +1,775 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/attachment/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func(ctx context.Context, key string) (a1 attachment.Attachment, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, key string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mCoreMockGet
+
+	funcOpen          func(ctx context.Context, key string) (r1 io.ReadCloser, err error)
+	funcOpenOrigin    string
+	inspectFuncOpen   func(ctx context.Context, key string)
+	afterOpenCounter  uint64
+	beforeOpenCounter uint64
+	OpenMock          mCoreMockOpen
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mCoreMockGet{mock: m}
+	m.GetMock.callArgs = []*CoreMockGetParams{}
+
+	m.OpenMock = mCoreMockOpen{mock: m}
+	m.OpenMock.callArgs = []*CoreMockOpenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockGet struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetExpectation
+	expectations       []*CoreMockGetExpectation
+
+	callArgs []*CoreMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetExpectation specifies expectation struct of the Core.Get
+type CoreMockGetExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetParams
+	paramPtrs          *CoreMockGetParamPtrs
+	expectationOrigins CoreMockGetExpectationOrigins
+	results            *CoreMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetParams contains parameters of the Core.Get
+type CoreMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// CoreMockGetParamPtrs contains pointers to parameters of the Core.Get
+type CoreMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// CoreMockGetResults contains results of the Core.Get
+type CoreMockGetResults struct {
+	a1  attachment.Attachment
+	err error
+}
+
+// CoreMockGetOrigins contains origins of expectations of the Core.Get
+type CoreMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mCoreMockGet) Optional() *mCoreMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Core.Get
+func (mmGet *mCoreMockGet) Expect(ctx context.Context, key string) *mCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &CoreMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Get
+func (mmGet *mCoreMockGet) ExpectCtxParam1(ctx context.Context) *mCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for Core.Get
+func (mmGet *mCoreMockGet) ExpectKeyParam2(key string) *mCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Get
+func (mmGet *mCoreMockGet) Inspect(f func(ctx context.Context, key string)) *mCoreMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for CoreMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Core.Get
+func (mmGet *mCoreMockGet) Return(a1 attachment.Attachment, err error) *CoreMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CoreMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &CoreMockGetResults{a1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Core.Get method
+func (mmGet *mCoreMockGet) Set(f func(ctx context.Context, key string) (a1 attachment.Attachment, err error)) *CoreMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Core.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Core.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Core.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mCoreMockGet) When(ctx context.Context, key string) *CoreMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CoreMock.Get mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &CoreMockGetParams{ctx, key},
+		expectationOrigins: CoreMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Get return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetExpectation) Then(a1 attachment.Attachment, err error) *CoreMock {
+	e.results = &CoreMockGetResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Get should be invoked
+func (mmGet *mCoreMockGet) Times(n uint64) *mCoreMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of CoreMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mCoreMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_usecase.Core
+func (mmGet *CoreMock) Get(ctx context.Context, key string) (a1 attachment.Attachment, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := CoreMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("CoreMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("CoreMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("CoreMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the CoreMock.Get")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to CoreMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished CoreMock.Get invocations
+func (mmGet *CoreMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of CoreMock.Get invocations
+func (mmGet *CoreMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mCoreMockGet) Calls() []*CoreMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mCoreMockOpen struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockOpenExpectation
+	expectations       []*CoreMockOpenExpectation
+
+	callArgs []*CoreMockOpenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockOpenExpectation specifies expectation struct of the Core.Open
+type CoreMockOpenExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockOpenParams
+	paramPtrs          *CoreMockOpenParamPtrs
+	expectationOrigins CoreMockOpenExpectationOrigins
+	results            *CoreMockOpenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockOpenParams contains parameters of the Core.Open
+type CoreMockOpenParams struct {
+	ctx context.Context
+	key string
+}
+
+// CoreMockOpenParamPtrs contains pointers to parameters of the Core.Open
+type CoreMockOpenParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// CoreMockOpenResults contains results of the Core.Open
+type CoreMockOpenResults struct {
+	r1  io.ReadCloser
+	err error
+}
+
+// CoreMockOpenOrigins contains origins of expectations of the Core.Open
+type CoreMockOpenExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpen *mCoreMockOpen) Optional() *mCoreMockOpen {
+	mmOpen.optional = true
+	return mmOpen
+}
+
+// Expect sets up expected params for Core.Open
+func (mmOpen *mCoreMockOpen) Expect(ctx context.Context, key string) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by ExpectParams functions")
+	}
+
+	mmOpen.defaultExpectation.params = &CoreMockOpenParams{ctx, key}
+	mmOpen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpen.expectations {
+		if minimock.Equal(e.params, mmOpen.defaultExpectation.params) {
+			mmOpen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpen.defaultExpectation.params)
+		}
+	}
+
+	return mmOpen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Open
+func (mmOpen *mCoreMockOpen) ExpectCtxParam1(ctx context.Context) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &CoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// ExpectKeyParam2 sets up expected param key for Core.Open
+func (mmOpen *mCoreMockOpen) ExpectKeyParam2(key string) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &CoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.key = &key
+	mmOpen.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Open
+func (mmOpen *mCoreMockOpen) Inspect(f func(ctx context.Context, key string)) *mCoreMockOpen {
+	if mmOpen.mock.inspectFuncOpen != nil {
+		mmOpen.mock.t.Fatalf("Inspect function is already set for CoreMock.Open")
+	}
+
+	mmOpen.mock.inspectFuncOpen = f
+
+	return mmOpen
+}
+
+// Return sets up results that will be returned by Core.Open
+func (mmOpen *mCoreMockOpen) Return(r1 io.ReadCloser, err error) *CoreMock {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{mock: mmOpen.mock}
+	}
+	mmOpen.defaultExpectation.results = &CoreMockOpenResults{r1, err}
+	mmOpen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// Set uses given function f to mock the Core.Open method
+func (mmOpen *mCoreMockOpen) Set(f func(ctx context.Context, key string) (r1 io.ReadCloser, err error)) *CoreMock {
+	if mmOpen.defaultExpectation != nil {
+		mmOpen.mock.t.Fatalf("Default expectation is already set for the Core.Open method")
+	}
+
+	if len(mmOpen.expectations) > 0 {
+		mmOpen.mock.t.Fatalf("Some expectations are already set for the Core.Open method")
+	}
+
+	mmOpen.mock.funcOpen = f
+	mmOpen.mock.funcOpenOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// When sets expectation for the Core.Open which will trigger the result defined by the following
+// Then helper
+func (mmOpen *mCoreMockOpen) When(ctx context.Context, key string) *CoreMockOpenExpectation {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	expectation := &CoreMockOpenExpectation{
+		mock:               mmOpen.mock,
+		params:             &CoreMockOpenParams{ctx, key},
+		expectationOrigins: CoreMockOpenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpen.expectations = append(mmOpen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Open return parameters for the expectation previously defined by the When method
+func (e *CoreMockOpenExpectation) Then(r1 io.ReadCloser, err error) *CoreMock {
+	e.results = &CoreMockOpenResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Open should be invoked
+func (mmOpen *mCoreMockOpen) Times(n uint64) *mCoreMockOpen {
+	if n == 0 {
+		mmOpen.mock.t.Fatalf("Times of CoreMock.Open mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpen.expectedInvocations, n)
+	mmOpen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpen
+}
+
+func (mmOpen *mCoreMockOpen) invocationsDone() bool {
+	if len(mmOpen.expectations) == 0 && mmOpen.defaultExpectation == nil && mmOpen.mock.funcOpen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpen.mock.afterOpenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Open implements mm_usecase.Core
+func (mmOpen *CoreMock) Open(ctx context.Context, key string) (r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpen.beforeOpenCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpen.afterOpenCounter, 1)
+
+	mmOpen.t.Helper()
+
+	if mmOpen.inspectFuncOpen != nil {
+		mmOpen.inspectFuncOpen(ctx, key)
+	}
+
+	mm_params := CoreMockOpenParams{ctx, key}
+
+	// Record call args
+	mmOpen.OpenMock.mutex.Lock()
+	mmOpen.OpenMock.callArgs = append(mmOpen.OpenMock.callArgs, &mm_params)
+	mmOpen.OpenMock.mutex.Unlock()
+
+	for _, e := range mmOpen.OpenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpen.OpenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpen.OpenMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpen.OpenMock.defaultExpectation.params
+		mm_want_ptrs := mmOpen.OpenMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockOpenParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpen.t.Errorf("CoreMock.Open got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmOpen.t.Errorf("CoreMock.Open got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpen.t.Errorf("CoreMock.Open got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpen.OpenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpen.OpenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpen.t.Fatal("No results are set for the CoreMock.Open")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpen.funcOpen != nil {
+		return mmOpen.funcOpen(ctx, key)
+	}
+	mmOpen.t.Fatalf("Unexpected call to CoreMock.Open. %v %v", ctx, key)
+	return
+}
+
+// OpenAfterCounter returns a count of finished CoreMock.Open invocations
+func (mmOpen *CoreMock) OpenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.afterOpenCounter)
+}
+
+// OpenBeforeCounter returns a count of CoreMock.Open invocations
+func (mmOpen *CoreMock) OpenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.beforeOpenCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Open.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpen *mCoreMockOpen) Calls() []*CoreMockOpenParams {
+	mmOpen.mutex.RLock()
+
+	argCopy := make([]*CoreMockOpenParams, len(mmOpen.callArgs))
+	copy(argCopy, mmOpen.callArgs)
+
+	mmOpen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenDone returns true if the count of the Open invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockOpenDone() bool {
+	if m.OpenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenMock.invocationsDone()
+}
+
+// MinimockOpenInspect logs each unmet expectation
+func (m *CoreMock) MinimockOpenInspect() {
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenCounter := mm_atomic.LoadUint64(&m.afterOpenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenMock.defaultExpectation != nil && afterOpenCounter < 1 {
+		if m.OpenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s", m.OpenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s with params: %#v", m.OpenMock.defaultExpectation.expectationOrigins.origin, *m.OpenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpen != nil && afterOpenCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Open at\n%s", m.funcOpenOrigin)
+	}
+
+	if !m.OpenMock.invocationsDone() && afterOpenCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Open at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenMock.expectedInvocations), m.OpenMock.expectedInvocationsOrigin, afterOpenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+
+			m.MinimockOpenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockOpenDone()
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	Get(ctx context.Context, key string) (attachment.Attachment, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type PermissionChecker interface {
+	CheckEntityPermission(ctx context.Context, id uuid.UUID, role auth.Role) error
+}
+
+type AdminChecker interface {
+	CheckIsAdmin(ctx context.Context) error
+}
+
+type service struct {
+	core  Core
+	perm  PermissionChecker
+	admin AdminChecker
+}
+
+func NewService(core Core, perm PermissionChecker, admin AdminChecker) *service {
+	if core == nil || perm == nil || admin == nil {
+		panic("attachment.NewService: nil dependency")
+	}
+	return &service{core: core, perm: perm, admin: admin}
+}
+
+// Open returns the attachment stored under key and its content. Requires read permission for the entity
+// the attachment belongs to; an attachment that belongs to no entity can only be read by admins.
+func (s *service) Open(ctx context.Context, key string) (attachment.Attachment, io.ReadCloser, error) {
+	a, err := s.core.Get(ctx, key)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(attachment.FieldKey.String(), key).
+			Msg("attachment.service.Open: failed to get attachment")
+		return attachment.Attachment{}, nil, fmt.Errorf("attachment.service.Open: %w", err)
+	}
+
+	if a.EntityID != nil {
+		err = s.perm.CheckEntityPermission(ctx, *a.EntityID, auth.RoleRead)
+	} else {
+		err = s.admin.CheckIsAdmin(ctx)
+	}
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(attachment.FieldKey.String(), key).
+			Msg("attachment.service.Open: permission check failed")
+		return attachment.Attachment{}, nil, fmt.Errorf("attachment.service.Open: %w", err)
+	}
+
+	content, err := s.core.Open(ctx, key)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(attachment.FieldKey.String(), key).
+			Msg("attachment.service.Open: failed to open attachment")
+		return attachment.Attachment{}, nil, fmt.Errorf("attachment.service.Open: %w", err)
+	}
+
+	return a, content, nil
+}
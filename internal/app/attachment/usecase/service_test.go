@@ -0,0 +1,116 @@
+package usecase_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/app/attachment/usecase"
+	"github.com/66gu1/easygodocs/internal/app/attachment/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type serviceMocks struct {
+	core  *mocks.CoreMock
+	perm  *mocks.PermissionCheckerMock
+	admin *mocks.AdminCheckerMock
+}
+
+func TestService_Open(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		key      = "imports/1/logo.png"
+		entityID = uuid.New()
+		a        = attachment.Attachment{Key: key, EntityID: &entityID, ContentType: "image/png"}
+		unowned  = attachment.Attachment{Key: key, ContentType: "image/png"}
+		errCore  = errors.New("db down")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(m serviceMocks)
+		want  attachment.Attachment
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(a, nil)
+				m.perm.CheckEntityPermissionMock.Expect(ctx, entityID, auth.RoleRead).Return(nil)
+				m.core.OpenMock.Expect(ctx, key).Return(io.NopCloser(strings.NewReader("data")), nil)
+			},
+			want: a,
+		},
+		{
+			name: "no read permission for the entity",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(a, nil)
+				m.perm.CheckEntityPermissionMock.Expect(ctx, entityID, auth.RoleRead).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "no entity, admin",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(unowned, nil)
+				m.admin.CheckIsAdminMock.Expect(ctx).Return(nil)
+				m.core.OpenMock.Expect(ctx, key).Return(io.NopCloser(strings.NewReader("data")), nil)
+			},
+			want: unowned,
+		},
+		{
+			name: "no entity, not an admin",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(unowned, nil)
+				m.admin.CheckIsAdminMock.Expect(ctx).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "get error",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(attachment.Attachment{}, errCore)
+			},
+			err: errCore,
+		},
+		{
+			name: "open error",
+			setup: func(m serviceMocks) {
+				m.core.GetMock.Expect(ctx, key).Return(a, nil)
+				m.perm.CheckEntityPermissionMock.Return(nil)
+				m.core.OpenMock.Expect(ctx, key).Return(nil, errCore)
+			},
+			err: errCore,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := serviceMocks{
+				core:  mocks.NewCoreMock(t),
+				perm:  mocks.NewPermissionCheckerMock(t),
+				admin: mocks.NewAdminCheckerMock(t),
+			}
+			tc.setup(m)
+
+			got, content, err := usecase.NewService(m.core, m.perm, m.admin).Open(ctx, key)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			require.NoError(t, content.Close())
+		})
+	}
+}
@@ -20,6 +20,11 @@ const (
 	FieldUserRole  apperr.Field = "user_role"
 	FieldRole      apperr.Field = "role"
 	FieldEntity    apperr.Field = "entity"
+	FieldLimit     apperr.Field = "limit"
+	FieldPresetID  apperr.Field = "preset_id"
+	FieldName      apperr.Field = "name"
+	FieldGrants    apperr.Field = "grants"
+	FieldUserIDs   apperr.Field = "user_ids"
 )
 
 const (
@@ -27,13 +32,40 @@ const (
 	CodeRoleNotFound     apperr.Code = "auth/role_not_found"
 	CodeValidationFailed apperr.Code = "auth/validation_failed"
 	CodeRoleDuplicate    apperr.Code = "auth/role_duplicate"
+	CodeInvalidLimit     apperr.Code = "auth/invalid_limit"
+	CodeImpersonation    apperr.Code = "auth/impersonation_not_allowed"
+	CodePresetNotFound   apperr.Code = "auth/preset_not_found"
+	CodePresetDuplicate  apperr.Code = "auth/preset_duplicate"
+	CodeDeviceMismatch   apperr.Code = "auth/device_mismatch"
 )
 
+func init() {
+	apperr.Register(CodeSessionNotFound, "Session not found", apperr.ClassNotFound)
+	apperr.Register(CodeRoleNotFound, "Role not found", apperr.ClassNotFound)
+	apperr.Register(CodeValidationFailed, "Invalid role", apperr.ClassBadRequest)
+	apperr.Register(CodeRoleDuplicate, "Role already assigned", apperr.ClassConflict)
+	apperr.Register(CodeInvalidLimit, "Invalid limit", apperr.ClassBadRequest)
+	apperr.Register(CodeImpersonation, "Impersonation not allowed", apperr.ClassForbidden)
+	apperr.Register(CodePresetNotFound, "Role preset not found", apperr.ClassNotFound)
+	apperr.Register(CodePresetDuplicate, "Role preset already exists", apperr.ClassConflict)
+	apperr.Register(CodeDeviceMismatch, "Session used from another device", apperr.ClassUnauthorized)
+}
+
 func ErrDuplicateUserRole() error {
 	return apperr.New("role already assigned to user",
 		CodeRoleDuplicate, apperr.ClassConflict, apperr.LogLevelWarn)
 }
 
+func ErrDeviceMismatch() error {
+	return apperr.New("session was refreshed from another device and has ended",
+		CodeDeviceMismatch, apperr.ClassUnauthorized, apperr.LogLevelWarn)
+}
+
+func ErrImpersonationNotAllowed(reason string) error {
+	return apperr.New("impersonation not allowed", CodeImpersonation, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithDetail(reason)
+}
+
 type Repository interface {
 	CreateSession(ctx context.Context, req Session, rtHash string) error
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Session, error)
@@ -45,6 +77,27 @@ type Repository interface {
 	GetUserRoles(ctx context.Context, userID uuid.UUID, roles []Role) ([]UserRole, error)
 	DeleteUserRole(ctx context.Context, role UserRole) error
 	ListUserRoles(ctx context.Context, userID uuid.UUID) ([]UserRole, error)
+	// DeleteUserRoles revokes every grant of the user and returns how many there were.
+	DeleteUserRoles(ctx context.Context, userID uuid.UUID) (int64, error)
+	// GetOrphanedGrants returns grants of deleted users, grants on deleted or unreachable entities and
+	// repeated grants.
+	GetOrphanedGrants(ctx context.Context) ([]OrphanedGrant, error)
+	// DeleteOrphanedGrants removes the grants GetOrphanedGrants returns, all or none, and returns them.
+	DeleteOrphanedGrants(ctx context.Context) ([]OrphanedGrant, error)
+	CreateLoginEvent(ctx context.Context, event LoginEvent) error
+	// GetKnownClient compares client with the user's earlier successful sign-ins.
+	GetKnownClient(ctx context.Context, userID uuid.UUID, client Client) (KnownClient, error)
+	// GetLoginHistory returns the latest sign-in attempts of the user, newest first.
+	GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]LoginEvent, error)
+	// ListPresets returns the role presets of the workspace by name.
+	ListPresets(ctx context.Context) ([]RolePreset, error)
+	GetPreset(ctx context.Context, id uuid.UUID) (RolePreset, error)
+	CreatePreset(ctx context.Context, preset RolePreset) error
+	// UpdatePreset replaces the name and the grants, keeping CreatedAt.
+	UpdatePreset(ctx context.Context, preset RolePreset) error
+	DeletePreset(ctx context.Context, id uuid.UUID) error
+	// ApplyPreset grants the roles of the preset to the users in one transaction and returns the new grants.
+	ApplyPreset(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) ([]UserRole, error)
 }
 
 type PasswordHasher interface {
@@ -75,8 +128,125 @@ type generators struct {
 }
 
 type Config struct {
-	SessionTTLMinutes     int `mapstructure:"session_ttl_minutes" json:"session_ttl_minutes"`
-	AccessTokenTTLMinutes int `mapstructure:"access_token_ttl_minutes" json:"access_token_ttl_minutes"`
+	SessionTTLMinutes int `mapstructure:"session_ttl_minutes" json:"session_ttl_minutes"`
+	// RememberMeSessionTTLMinutes is the session TTL of logins with remember_me, meant for personal devices.
+	RememberMeSessionTTLMinutes int                 `mapstructure:"remember_me_session_ttl_minutes" json:"remember_me_session_ttl_minutes"`
+	AccessTokenTTLMinutes       int                 `mapstructure:"access_token_ttl_minutes" json:"access_token_ttl_minutes"`
+	Impersonation               ImpersonationConfig `mapstructure:"impersonation" json:"impersonation"`
+	DeviceBinding               DeviceBindingConfig `mapstructure:"device_binding" json:"device_binding"`
+	Claims                      ClaimsConfig        `mapstructure:"claims" json:"claims"`
+
+	// Issuer and Audience are put in access tokens and checked on every request, so tokens of one
+	// environment are not accepted in another. AcceptLegacyTokens accepts tokens without them, to
+	// keep sessions working while the tokens issued before they were set expire.
+	Issuer             string `mapstructure:"issuer" json:"issuer"`
+	Audience           string `mapstructure:"audience" json:"audience"`
+	AcceptLegacyTokens bool   `mapstructure:"accept_legacy_tokens" json:"accept_legacy_tokens"`
+	// LeewaySeconds is how far the clocks of the servers may be apart when checking the times of tokens.
+	LeewaySeconds int `mapstructure:"leeway_seconds" json:"leeway_seconds"`
+}
+
+// ImpersonationConfig lets admins act as another user with a token that lasts TokenTTLMinutes.
+type ImpersonationConfig struct {
+	Enabled         bool `mapstructure:"enabled" json:"enabled"`
+	TokenTTLMinutes int  `mapstructure:"token_ttl_minutes" json:"token_ttl_minutes"`
+}
+
+// DeviceBindingMode says what a refresh from another client than the session's does.
+type DeviceBindingMode string
+
+const (
+	DeviceBindingOff DeviceBindingMode = "off"
+	// DeviceBindingFlag refreshes and flags the session, so the user or an admin can review it.
+	DeviceBindingFlag DeviceBindingMode = "flag"
+	// DeviceBindingStrict ends the session, so the user has to sign in again.
+	DeviceBindingStrict DeviceBindingMode = "strict"
+)
+
+// DeviceBindingConfig binds sessions to the user agent they were created with and, with MatchIP, to
+// the IP address too. Mobile clients change addresses often, so MatchIP suits fixed networks.
+type DeviceBindingConfig struct {
+	Mode    DeviceBindingMode `mapstructure:"mode" json:"mode"`
+	MatchIP bool              `mapstructure:"match_ip" json:"match_ip"`
+}
+
+func (c DeviceBindingConfig) Validate() error {
+	switch c.Mode {
+	case "", DeviceBindingOff, DeviceBindingFlag, DeviceBindingStrict:
+		return nil
+	default:
+		return fmt.Errorf("mode must be off, flag or strict")
+	}
+}
+
+// matches reports whether client may refresh a session created from bound. Sessions without a
+// client predate the binding and match any.
+func (c DeviceBindingConfig) matches(bound, client Client) bool {
+	if c.Mode == "" || c.Mode == DeviceBindingOff || bound == (Client{}) {
+		return true
+	}
+
+	return bound.UserAgent == client.UserAgent && (!c.MatchIP || bound.IP == client.IP)
+}
+
+// maxCustomClaimsBytes caps the custom claims, as the access token is sent with every request.
+const maxCustomClaimsBytes = 1024
+
+// reservedClaims are the names custom claims cannot take.
+var reservedClaims = map[string]struct{}{
+	"iss": {}, "sub": {}, "aud": {}, "exp": {}, "nbf": {}, "iat": {}, "jti": {},
+	"sid": {}, "wid": {}, "act": {}, "scope": {}, "roles": {}, "roles_omitted": {}, "tid": {},
+}
+
+// ClaimsConfig adds claims for downstream services to the access tokens of sessions. With Roles
+// the token lists the grants of the user, unless there are more than MaxRoles of them; TenantID
+// and Custom are copied as they are.
+type ClaimsConfig struct {
+	Roles    bool              `mapstructure:"roles" json:"roles"`
+	MaxRoles int               `mapstructure:"max_roles" json:"max_roles"`
+	TenantID string            `mapstructure:"tenant_id" json:"tenant_id"`
+	Custom   map[string]string `mapstructure:"custom" json:"custom"`
+}
+
+func (c ClaimsConfig) Validate() error {
+	if c.Roles && c.MaxRoles <= 0 {
+		return fmt.Errorf("max_roles must be positive")
+	}
+	size := 0
+	for name, value := range c.Custom {
+		if name == "" {
+			return fmt.Errorf("custom claim names must not be empty")
+		}
+		if _, ok := reservedClaims[name]; ok {
+			return fmt.Errorf("custom claim %q is reserved", name)
+		}
+		size += len(name) + len(value)
+	}
+	if size > maxCustomClaimsBytes {
+		return fmt.Errorf("custom claims must not exceed %d bytes", maxCustomClaimsBytes)
+	}
+
+	return nil
+}
+
+func (c Config) Validate() error {
+	if c.SessionTTLMinutes <= 0 || c.RememberMeSessionTTLMinutes <= 0 || c.AccessTokenTTLMinutes <= 0 {
+		return fmt.Errorf("config TTL values must be positive")
+	}
+	if c.LeewaySeconds < 0 {
+		return fmt.Errorf("leeway_seconds must not be negative")
+	}
+	if c.Impersonation.Enabled && c.Impersonation.TokenTTLMinutes <= 0 {
+		return fmt.Errorf("impersonation.token_ttl_minutes must be positive")
+	}
+	if err := c.DeviceBinding.Validate(); err != nil {
+		return fmt.Errorf("device_binding: %w", err)
+	}
+	if err := c.Claims.Validate(); err != nil {
+		return fmt.Errorf("claims: %w", err)
+	}
+
+	return nil
 }
 
 type core struct {
@@ -88,8 +258,8 @@ type core struct {
 }
 
 func NewCore(repo Repository, codec TokenCodec, idGenerator UUIDGenerator, rndGenerator RNDGenerator, timeGenerator TimeGenerator, passwordHasher PasswordHasher, cfg Config) (*core, error) {
-	if cfg.SessionTTLMinutes <= 0 || cfg.AccessTokenTTLMinutes <= 0 {
-		return nil, fmt.Errorf("auth.NewCore: %w", fmt.Errorf("config TTL values must be positive"))
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("auth.NewCore: %w", err)
 	}
 	if rndGenerator == nil || idGenerator == nil || timeGenerator == nil || repo == nil || codec == nil || passwordHasher == nil {
 		return nil, fmt.Errorf("auth.NewCore: %w", fmt.Errorf("config values must not be nil"))
@@ -104,10 +274,15 @@ func NewCore(repo Repository, codec TokenCodec, idGenerator UUIDGenerator, rndGe
 	}, nil
 }
 
-func (c *core) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int) (Tokens, error) {
+// IssueTokens starts a session of client limited to scopes; empty scopes grant full access. The
+// session lasts as long as ttlClass says and keeps that TTL on every refresh.
+func (c *core) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes Scopes, ttlClass TTLClass, client Client) (Tokens, error) {
 	if userID == uuid.Nil {
 		return Tokens{}, fmt.Errorf("auth.core.IssueTokens: user ID cannot be nil")
 	}
+	if err := ttlClass.Validate(); err != nil {
+		return Tokens{}, fmt.Errorf("auth.core.IssueTokens: %w", err)
+	}
 
 	sessionID, err := c.generators.idGenerator.New()
 	if err != nil {
@@ -115,7 +290,7 @@ func (c *core) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion
 	}
 
 	now := c.generators.timeGenerator.Now()
-	accessToken, refreshToken, rtHash, err := c.generateTokens(userID, sessionID, now)
+	accessToken, refreshToken, rtHash, err := c.generateTokens(ctx, userID, sessionID, scopes, now)
 	if err != nil {
 		return Tokens{}, fmt.Errorf("auth.core.IssueTokens: %w", err)
 	}
@@ -124,8 +299,11 @@ func (c *core) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion
 		ID:             sessionID,
 		UserID:         userID,
 		CreatedAt:      now,
-		ExpiresAt:      now.Add(time.Duration(c.cfg.SessionTTLMinutes) * time.Minute),
+		ExpiresAt:      now.Add(c.sessionTTL(ttlClass)),
 		SessionVersion: sessionVersion,
+		Scopes:         scopes,
+		TTLClass:       ttlClass,
+		Client:         client,
 	}
 	err = c.repo.CreateSession(ctx, session, string(rtHash))
 	if err != nil {
@@ -141,7 +319,10 @@ func (c *core) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion
 	}, nil
 }
 
-func (c *core) RefreshTokens(ctx context.Context, session Session, refreshToken, rtHash string) (Tokens, error) {
+// RefreshTokens rotates the refresh token of the session for client. The access token gets scopes,
+// which must be within the scope of the session; empty scopes keep the scope of the session. A client
+// other than the session's is handled as the device binding config says.
+func (c *core) RefreshTokens(ctx context.Context, session Session, refreshToken, rtHash string, scopes Scopes, client Client) (Tokens, error) {
 	now := c.generators.timeGenerator.Now()
 	if !session.ExpiresAt.After(now) {
 		err := apperr.ErrUnauthorized().WithDetail("session has expired")
@@ -155,7 +336,26 @@ func (c *core) RefreshTokens(ctx context.Context, session Session, refreshToken,
 		return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", err)
 	}
 
-	accessToken, newRefreshToken, newRTHash, err := c.generateTokens(session.UserID, session.ID, now)
+	var flaggedAt *time.Time
+	if !c.cfg.DeviceBinding.matches(session.Client, client) {
+		if c.cfg.DeviceBinding.Mode == DeviceBindingStrict {
+			if err := c.repo.DeleteSessionByIDAndUser(ctx, session.ID, session.UserID); err != nil {
+				return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", err)
+			}
+			return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", ErrDeviceMismatch())
+		}
+		if session.FlaggedAt == nil {
+			flaggedAt = &now
+		}
+	}
+
+	if len(scopes) == 0 {
+		scopes = session.Scopes
+	} else if !scopes.Within(session.Scopes) {
+		return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", ErrScopeNotGranted())
+	}
+
+	accessToken, newRefreshToken, newRTHash, err := c.generateTokens(ctx, session.UserID, session.ID, scopes, now)
 	if err != nil {
 		return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", err)
 	}
@@ -164,8 +364,9 @@ func (c *core) RefreshTokens(ctx context.Context, session Session, refreshToken,
 		SessionID:           session.ID,
 		UserID:              session.UserID,
 		RefreshTokenHash:    string(newRTHash),
-		ExpiresAt:           now.Add(time.Duration(c.cfg.SessionTTLMinutes) * time.Minute),
+		ExpiresAt:           now.Add(c.sessionTTL(session.TTLClass)),
 		OldRefreshTokenHash: rtHash,
+		FlaggedAt:           flaggedAt,
 	}); err != nil {
 		return Tokens{}, fmt.Errorf("auth.core.RefreshTokens: %w", err)
 	}
@@ -179,6 +380,49 @@ func (c *core) RefreshTokens(ctx context.Context, session Session, refreshToken,
 	}, nil
 }
 
+// IssueImpersonationToken issues an access token of subjectID for the current user, who stays in the token
+// as its actor. The token belongs to the actor's session, cannot be refreshed and only reads entities.
+func (c *core) IssueImpersonationToken(ctx context.Context, subjectID uuid.UUID) (ImpersonationToken, error) {
+	if !c.cfg.Impersonation.Enabled {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w",
+			ErrImpersonationNotAllowed("impersonation is disabled"))
+	}
+	if subjectID == uuid.Nil {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if _, err := contextx.GetActorID(ctx); err == nil {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w",
+			ErrImpersonationNotAllowed("already impersonating"))
+	}
+	actorID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w", err)
+	}
+	if actorID == subjectID {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w",
+			ErrImpersonationNotAllowed("cannot impersonate yourself"))
+	}
+	sessionID, err := contextx.GetSessionID(ctx)
+	if err != nil {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w", err)
+	}
+
+	now := c.generators.timeGenerator.Now()
+	expiresAt := now.Add(time.Duration(c.cfg.Impersonation.TokenTTLMinutes) * time.Minute)
+	accessToken, err := c.codec.GenerateToken(AccessTokenClaims{
+		SID:              sessionID.String(),
+		WID:              contextx.WorkspaceID(ctx).String(),
+		ACT:              actorID.String(),
+		Scope:            ScopeEntitiesRead.String(),
+		RegisteredClaims: c.registeredClaims(subjectID, expiresAt, now),
+	})
+	if err != nil {
+		return ImpersonationToken{}, fmt.Errorf("auth.core.IssueImpersonationToken: %w", err)
+	}
+
+	return ImpersonationToken{AccessToken: accessToken, ExpiresAt: expiresAt}, nil
+}
+
 func (c *core) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, string, error) {
 	if id == uuid.Nil {
 		return Session{}, "", fmt.Errorf("auth.core.GetSessionByID: %w", apperr.ErrNilUUID(FieldSessionID))
@@ -229,6 +473,7 @@ func (c *core) AddUserRole(ctx context.Context, userRole UserRole) error {
 	if err := c.repo.AddUserRole(ctx, userRole); err != nil {
 		return fmt.Errorf("auth.core.AddUserRole: %w", err)
 	}
+	contextx.ClearCache(ctx)
 
 	return nil
 }
@@ -237,6 +482,7 @@ func (c *core) DeleteUserRole(ctx context.Context, role UserRole) error {
 	if err := c.repo.DeleteUserRole(ctx, role); err != nil {
 		return fmt.Errorf("auth.core.DeleteUserRole: %w", err)
 	}
+	contextx.ClearCache(ctx)
 
 	return nil
 }
@@ -252,9 +498,58 @@ func (c *core) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]UserRole,
 	return userRoles, nil
 }
 
+// DeleteUserRoles revokes all grants of a user that is being deleted. It joins the caller's transaction.
+func (c *core) DeleteUserRoles(ctx context.Context, userID uuid.UUID) (int64, error) {
+	if userID == uuid.Nil {
+		return 0, fmt.Errorf("auth.core.DeleteUserRoles: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	n, err := c.repo.DeleteUserRoles(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("auth.core.DeleteUserRoles: %w", err)
+	}
+	contextx.ClearCache(ctx)
+
+	return n, nil
+}
+
+func (c *core) GetConsistencyReport(ctx context.Context) (ConsistencyReport, error) {
+	grants, err := c.repo.GetOrphanedGrants(ctx)
+	if err != nil {
+		return ConsistencyReport{}, fmt.Errorf("auth.core.GetConsistencyReport: %w", err)
+	}
+
+	return ConsistencyReport{OrphanedGrants: grants}, nil
+}
+
+// RepairGrants removes the orphaned grants and reports them. With dryRun it only reports them.
+func (c *core) RepairGrants(ctx context.Context, dryRun bool) (ConsistencyReport, error) {
+	if dryRun {
+		report, err := c.GetConsistencyReport(ctx)
+		if err != nil {
+			return ConsistencyReport{}, fmt.Errorf("auth.core.RepairGrants: %w", err)
+		}
+		return report, nil
+	}
+
+	grants, err := c.repo.DeleteOrphanedGrants(ctx)
+	if err != nil {
+		return ConsistencyReport{}, fmt.Errorf("auth.core.RepairGrants: %w", err)
+	}
+	contextx.ClearCache(ctx)
+
+	return ConsistencyReport{OrphanedGrants: grants, Repaired: true}, nil
+}
+
 // Permission check helpers.
 // These methods are intended for internal authorization logic.
 
+// directPermissionsKey caches the grants GetCurrentUserDirectPermissions loads for a request.
+type directPermissionsKey struct {
+	workspaceID uuid.UUID
+	userID      uuid.UUID
+	role        Role
+}
+
 // GetCurrentUserDirectPermissions doesn't return ids if isAdmin is true.
 func (c *core) GetCurrentUserDirectPermissions(ctx context.Context, role Role) (ids []uuid.UUID, isAdmin bool, err error) {
 	currentUserID, err := contextx.GetUserID(ctx)
@@ -265,8 +560,11 @@ func (c *core) GetCurrentUserDirectPermissions(ctx context.Context, role Role) (
 		return nil, false, fmt.Errorf("auth.core.GetCurrentUserDirectPermissions: %w", err)
 	}
 
-	roles := role.GetHierarchy()
-	userRoles, err := c.repo.GetUserRoles(ctx, currentUserID, roles)
+	// handlers and usecases of one request often check the same role, so the grants are loaded once
+	key := directPermissionsKey{workspaceID: contextx.WorkspaceID(ctx), userID: currentUserID, role: role}
+	userRoles, err := contextx.Cached(ctx, key, func() ([]UserRole, error) {
+		return c.repo.GetUserRoles(ctx, currentUserID, role.GetHierarchy())
+	})
 	if err != nil {
 		return nil, false, fmt.Errorf("auth.core.GetCurrentUserDirectPermissions: %w", err)
 	}
@@ -311,6 +609,19 @@ func (c *core) CheckIsAdmin(ctx context.Context) error {
 	return nil
 }
 
+// CheckIsOperator passes admins of the default workspace. Operators manage the deployment itself:
+// its settings and the other workspaces.
+func (c *core) CheckIsOperator(ctx context.Context) error {
+	if err := c.CheckIsAdmin(ctx); err != nil {
+		return fmt.Errorf("auth.core.CheckIsOperator: %w", err)
+	}
+	if contextx.WorkspaceID(ctx) != contextx.DefaultWorkspaceID {
+		return fmt.Errorf("auth.core.CheckIsOperator: %w", apperr.ErrForbidden())
+	}
+
+	return nil
+}
+
 func (c *core) CheckSelf(ctx context.Context, targetUserID uuid.UUID) error {
 	self, err := c.IsSelf(ctx, targetUserID)
 	if err != nil {
@@ -352,7 +663,15 @@ func (c *core) checkAdminRights(ctx context.Context) (bool, error) {
 	return isAdmin, nil
 }
 
-func (c *core) generateTokens(userID, sessionID uuid.UUID, now time.Time) (string, string, []byte, error) {
+func (c *core) sessionTTL(class TTLClass) time.Duration {
+	if class == TTLClassRememberMe {
+		return time.Duration(c.cfg.RememberMeSessionTTLMinutes) * time.Minute
+	}
+
+	return time.Duration(c.cfg.SessionTTLMinutes) * time.Minute
+}
+
+func (c *core) generateTokens(ctx context.Context, userID, sessionID uuid.UUID, scopes Scopes, now time.Time) (string, string, []byte, error) {
 	refreshToken, err := c.generators.rndGenerator.New(32) // 32 bytes = 256 bits of entropy
 	if err != nil {
 		return "", "", nil, fmt.Errorf("generateTokens: %w", err)
@@ -362,17 +681,61 @@ func (c *core) generateTokens(userID, sessionID uuid.UUID, now time.Time) (strin
 		return "", "", nil, fmt.Errorf("generateTokens: %w", err)
 	}
 
-	accessToken, err := c.codec.GenerateToken(AccessTokenClaims{
-		SID: sessionID.String(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject:   userID.String(),
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(c.cfg.AccessTokenTTLMinutes) * time.Minute)),
-			IssuedAt:  jwt.NewNumericDate(now),
-		},
-	})
+	claims := AccessTokenClaims{
+		SID:              sessionID.String(),
+		WID:              contextx.WorkspaceID(ctx).String(),
+		Scope:            scopes.String(),
+		RegisteredClaims: c.registeredClaims(userID, now.Add(time.Duration(c.cfg.AccessTokenTTLMinutes)*time.Minute), now),
+	}
+	if err = c.enrichClaims(ctx, userID, &claims); err != nil {
+		return "", "", nil, fmt.Errorf("generateTokens: %w", err)
+	}
+
+	accessToken, err := c.codec.GenerateToken(claims)
 	if err != nil {
 		return "", "", nil, fmt.Errorf("generateTokens: %w", err)
 	}
 
 	return accessToken, refreshToken, rtHash, nil
 }
+
+func (c *core) registeredClaims(subject uuid.UUID, expiresAt, now time.Time) jwt.RegisteredClaims {
+	claims := jwt.RegisteredClaims{
+		Issuer:    c.cfg.Issuer,
+		Subject:   subject.String(),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		NotBefore: jwt.NewNumericDate(now),
+		IssuedAt:  jwt.NewNumericDate(now),
+	}
+	if c.cfg.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{c.cfg.Audience}
+	}
+
+	return claims
+}
+
+// enrichClaims adds the claims the config asks for. Roles are a snapshot: grants changed later show
+// up on the next refresh.
+func (c *core) enrichClaims(ctx context.Context, userID uuid.UUID, claims *AccessTokenClaims) error {
+	cfg := c.cfg.Claims
+	claims.TID = cfg.TenantID
+	claims.Custom = cfg.Custom
+	if !cfg.Roles {
+		return nil
+	}
+
+	userRoles, err := c.repo.ListUserRoles(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("enrichClaims: %w", err)
+	}
+	if len(userRoles) > cfg.MaxRoles {
+		claims.RolesOmitted = true
+		return nil
+	}
+	claims.Roles = make([]string, 0, len(userRoles))
+	for _, ur := range userRoles {
+		claims.Roles = append(claims.Roles, ur.Summary())
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package auth_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,8 +42,9 @@ func setupMocks(t *testing.T) mock {
 
 func cfg() auth.Config {
 	return auth.Config{
-		SessionTTLMinutes:     1,
-		AccessTokenTTLMinutes: 2,
+		SessionTTLMinutes:           1,
+		RememberMeSessionTTLMinutes: 3,
+		AccessTokenTTLMinutes:       2,
 	}
 }
 
@@ -49,7 +52,8 @@ func TestCore_IssueTokens(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx            = context.Background()
+		workspaceID    = uuid.New()
+		ctx            = contextx.SetWorkspaceID(context.Background(), workspaceID)
 		userID         = uuid.New()
 		sessID         = uuid.New()
 		now            = time.Now()
@@ -57,10 +61,15 @@ func TestCore_IssueTokens(t *testing.T) {
 		accessToken    = "access.token.value"
 		refreshToken   = "refresh.token.value"
 		rtHash         = []byte("refresh.token.hashed")
+		scopes         = auth.Scopes{auth.ScopeEntitiesRead}
+		client         = auth.Client{IP: "203.0.113.7", UserAgent: "Mozilla/5.0"}
 		claims         = auth.AccessTokenClaims{
-			SID: sessID.String(),
+			SID:   sessID.String(),
+			WID:   workspaceID.String(),
+			Scope: "entities:read",
 			RegisteredClaims: jwt.RegisteredClaims{
 				Subject:   userID.String(),
+				NotBefore: jwt.NewNumericDate(now),
 				IssuedAt:  jwt.NewNumericDate(now),
 				ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(cfg().AccessTokenTTLMinutes) * time.Minute)),
 			},
@@ -69,8 +78,11 @@ func TestCore_IssueTokens(t *testing.T) {
 			ID:             sessID,
 			UserID:         userID,
 			CreatedAt:      now,
-			ExpiresAt:      now.Add(time.Duration(cfg().SessionTTLMinutes) * time.Minute),
+			ExpiresAt:      now.Add(time.Duration(cfg().RememberMeSessionTTLMinutes) * time.Minute),
 			SessionVersion: sessionVersion,
+			Scopes:         scopes,
+			TTLClass:       auth.TTLClassRememberMe,
+			Client:         client,
 		}
 		errExp = fmt.Errorf("expected")
 		want   = auth.Tokens{
@@ -180,7 +192,7 @@ func TestCore_IssueTokens(t *testing.T) {
 			)
 			require.NoError(t, err)
 
-			tokens, err := core.IssueTokens(ctx, tt.userID, sessionVersion)
+			tokens, err := core.IssueTokens(ctx, tt.userID, sessionVersion, scopes, auth.TTLClassRememberMe, client)
 			if tt.err != nil || tt.wantErr {
 				require.Error(t, err)
 				if tt.err != nil {
@@ -194,6 +206,299 @@ func TestCore_IssueTokens(t *testing.T) {
 	}
 }
 
+func TestCore_IssueTokens_Claims(t *testing.T) {
+	t.Parallel()
+
+	var (
+		workspaceID = uuid.New()
+		ctx         = contextx.SetWorkspaceID(context.Background(), workspaceID)
+		userID      = uuid.New()
+		sessID      = uuid.New()
+		entityID    = uuid.New()
+		now         = time.Now()
+		errExp      = fmt.Errorf("expected")
+		userRoles   = []auth.UserRole{
+			{UserID: userID, Role: auth.RoleAdmin},
+			{UserID: userID, Role: auth.RoleWrite, EntityID: &entityID},
+		}
+		custom = map[string]string{"department": "docs"}
+	)
+
+	claims := func(modify func(c *auth.AccessTokenClaims)) auth.AccessTokenClaims {
+		c := auth.AccessTokenClaims{
+			SID:    sessID.String(),
+			WID:    workspaceID.String(),
+			TID:    "tenant-1",
+			Custom: custom,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   userID.String(),
+				NotBefore: jwt.NewNumericDate(now),
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(cfg().AccessTokenTTLMinutes) * time.Minute)),
+			},
+		}
+		if modify != nil {
+			modify(&c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		maxRoles int
+		setup    func(mocks mock)
+		err      error
+	}{
+		{
+			name:     "roles",
+			maxRoles: 2,
+			setup: func(mocks mock) {
+				mocks.repo.ListUserRolesMock.Expect(ctx, userID).Return(userRoles, nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims(func(c *auth.AccessTokenClaims) {
+					c.Roles = []string{"admin", "write:" + entityID.String()}
+				})).Return("token", nil)
+				mocks.repo.CreateSessionMock.Return(nil)
+			},
+		},
+		{
+			name:     "more roles than max_roles",
+			maxRoles: 1,
+			setup: func(mocks mock) {
+				mocks.repo.ListUserRolesMock.Expect(ctx, userID).Return(userRoles, nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims(func(c *auth.AccessTokenClaims) {
+					c.RolesOmitted = true
+				})).Return("token", nil)
+				mocks.repo.CreateSessionMock.Return(nil)
+			},
+		},
+		{
+			name:     "repo error",
+			maxRoles: 2,
+			setup: func(mocks mock) {
+				mocks.repo.ListUserRolesMock.Expect(ctx, userID).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			mocks.idGen.NewMock.Return(sessID, nil)
+			mocks.timeGen.NowMock.Return(now)
+			mocks.rndGen.NewMock.Return("refresh", nil)
+			mocks.pswHasher.HashRefreshTokenMock.Return([]byte("hash"), nil)
+			tt.setup(mocks)
+
+			config := cfg()
+			config.Claims = auth.ClaimsConfig{Roles: true, MaxRoles: tt.maxRoles, TenantID: "tenant-1", Custom: custom}
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, config)
+			require.NoError(t, err)
+
+			_, err = core.IssueTokens(ctx, userID, 1, nil, auth.TTLClassDefault, auth.Client{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_IssueTokens_IssuerAudience(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		sessID = uuid.New()
+		now    = time.Now()
+	)
+
+	mocks := setupMocks(t)
+	mocks.idGen.NewMock.Return(sessID, nil)
+	mocks.timeGen.NowMock.Return(now)
+	mocks.rndGen.NewMock.Return("refresh", nil)
+	mocks.pswHasher.HashRefreshTokenMock.Return([]byte("hash"), nil)
+	mocks.tokenCodec.GenerateTokenMock.Expect(auth.AccessTokenClaims{
+		SID: sessID.String(),
+		WID: contextx.DefaultWorkspaceID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "docs-prod",
+			Audience:  jwt.ClaimStrings{"api-prod"},
+			Subject:   userID.String(),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(cfg().AccessTokenTTLMinutes) * time.Minute)),
+		},
+	}).Return("token", nil)
+	mocks.repo.CreateSessionMock.Return(nil)
+
+	config := cfg()
+	config.Issuer = "docs-prod"
+	config.Audience = "api-prod"
+	core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, config)
+	require.NoError(t, err)
+
+	_, err = core.IssueTokens(ctx, userID, 1, nil, auth.TTLClassDefault, auth.Client{})
+	require.NoError(t, err)
+}
+
+func TestClaimsConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     auth.ClaimsConfig
+		wantErr bool
+	}{
+		{name: "off", cfg: auth.ClaimsConfig{}},
+		{name: "roles", cfg: auth.ClaimsConfig{Roles: true, MaxRoles: 10}},
+		{name: "roles without max_roles", cfg: auth.ClaimsConfig{Roles: true}, wantErr: true},
+		{name: "custom", cfg: auth.ClaimsConfig{Custom: map[string]string{"department": "docs"}}},
+		{name: "reserved custom claim", cfg: auth.ClaimsConfig{Custom: map[string]string{"sub": "x"}}, wantErr: true},
+		{name: "empty custom claim name", cfg: auth.ClaimsConfig{Custom: map[string]string{"": "x"}}, wantErr: true},
+		{name: "custom claims too large", cfg: auth.ClaimsConfig{Custom: map[string]string{"big": strings.Repeat("x", 1024)}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAccessTokenClaims_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(auth.AccessTokenClaims{
+		SID:    "sid",
+		WID:    "wid",
+		Roles:  []string{"admin"},
+		Custom: map[string]string{"department": "docs"},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"sid":"sid","wid":"wid","roles":["admin"],"department":"docs"}`, string(data))
+}
+
+func TestCore_IssueImpersonationToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		workspaceID = uuid.New()
+		actorID     = uuid.New()
+		subjectID   = uuid.New()
+		sessID      = uuid.New()
+		ctx         = contextx.SetSessionID(contextx.SetUserID(contextx.SetWorkspaceID(context.Background(), workspaceID), actorID), sessID)
+		now         = time.Now()
+		expiresAt   = now.Add(5 * time.Minute)
+		claims      = auth.AccessTokenClaims{
+			SID:   sessID.String(),
+			WID:   workspaceID.String(),
+			ACT:   actorID.String(),
+			Scope: "entities:read",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   subjectID.String(),
+				NotBefore: jwt.NewNumericDate(now),
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}
+		errExp = fmt.Errorf("expected")
+	)
+	enabled := cfg()
+	enabled.Impersonation = auth.ImpersonationConfig{Enabled: true, TokenTTLMinutes: 5}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		cfg       auth.Config
+		subjectID uuid.UUID
+		setup     func(mocks mock)
+		want      auth.ImpersonationToken
+		err       error
+	}{
+		{
+			name:      "ok",
+			ctx:       ctx,
+			cfg:       enabled,
+			subjectID: subjectID,
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return("access.token.value", nil)
+			},
+			want: auth.ImpersonationToken{AccessToken: "access.token.value", ExpiresAt: expiresAt},
+		},
+		{
+			name:      "disabled",
+			ctx:       ctx,
+			cfg:       cfg(),
+			subjectID: subjectID,
+			err:       auth.ErrImpersonationNotAllowed("impersonation is disabled"),
+		},
+		{
+			name:      "nil subject",
+			ctx:       ctx,
+			cfg:       enabled,
+			subjectID: uuid.Nil,
+			err:       apperr.ErrNilUUID(auth.FieldUserID),
+		},
+		{
+			name:      "already impersonating",
+			ctx:       contextx.SetActorID(ctx, uuid.New()),
+			cfg:       enabled,
+			subjectID: subjectID,
+			err:       auth.ErrImpersonationNotAllowed("already impersonating"),
+		},
+		{
+			name:      "self",
+			ctx:       ctx,
+			cfg:       enabled,
+			subjectID: actorID,
+			err:       auth.ErrImpersonationNotAllowed("cannot impersonate yourself"),
+		},
+		{
+			name:      "token codec error",
+			ctx:       ctx,
+			cfg:       enabled,
+			subjectID: subjectID,
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return("", errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, tt.cfg)
+			require.NoError(t, err)
+
+			got, err := core.IssueImpersonationToken(tt.ctx, tt.subjectID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestCore_RefreshTokens(t *testing.T) {
 	t.Parallel()
 
@@ -210,8 +515,10 @@ func TestCore_RefreshTokens(t *testing.T) {
 		newRTHash       = "new.refresh.token.hashed"
 		claims          = auth.AccessTokenClaims{
 			SID: sessID.String(),
+			WID: contextx.DefaultWorkspaceID.String(),
 			RegisteredClaims: jwt.RegisteredClaims{
 				Subject:   userID.String(),
+				NotBefore: jwt.NewNumericDate(now),
 				IssuedAt:  jwt.NewNumericDate(now),
 				ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(cfg().AccessTokenTTLMinutes) * time.Minute)),
 			},
@@ -240,12 +547,119 @@ func TestCore_RefreshTokens(t *testing.T) {
 		}
 	)
 
+	scopedSession := session
+	scopedSession.Scopes = auth.Scopes{auth.ScopeEntitiesWrite, auth.ScopeUsersAdmin}
+	laptop := auth.Client{IP: "203.0.113.7", UserAgent: "Mozilla/5.0 (X11)"}
+	boundSession := session
+	boundSession.Client = laptop
+	flaggedReq := updateTokenReq
+	flaggedReq.FlaggedAt = &now
+	rememberedSession := session
+	rememberedSession.TTLClass = auth.TTLClassRememberMe
+	scopedClaims := func(scope string) auth.AccessTokenClaims {
+		c := claims
+		c.Scope = scope
+		return c
+	}
+
 	tests := []struct {
 		name    string
 		session auth.Session
+		scopes  auth.Scopes
+		client  auth.Client
+		binding auth.DeviceBindingConfig
 		setup   func(mocks mock)
 		err     error
 	}{
+		{
+			name:    "ok - another device is flagged",
+			session: boundSession,
+			client:  auth.Client{IP: laptop.IP, UserAgent: "curl/8.0"},
+			binding: auth.DeviceBindingConfig{Mode: auth.DeviceBindingFlag},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, flaggedReq).Return(nil)
+			},
+		},
+		{
+			name:    "ok - new IP without match_ip",
+			session: boundSession,
+			client:  auth.Client{IP: "198.51.100.1", UserAgent: laptop.UserAgent},
+			binding: auth.DeviceBindingConfig{Mode: auth.DeviceBindingStrict},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, updateTokenReq).Return(nil)
+			},
+		},
+		{
+			name:    "ok - session without client is not bound",
+			session: session,
+			client:  laptop,
+			binding: auth.DeviceBindingConfig{Mode: auth.DeviceBindingStrict, MatchIP: true},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, updateTokenReq).Return(nil)
+			},
+		},
+		{
+			name:    "new IP with match_ip ends the session",
+			session: boundSession,
+			client:  auth.Client{IP: "198.51.100.1", UserAgent: laptop.UserAgent},
+			binding: auth.DeviceBindingConfig{Mode: auth.DeviceBindingStrict, MatchIP: true},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.repo.DeleteSessionByIDAndUserMock.Expect(ctx, sessID, userID).Return(nil)
+			},
+			err: auth.ErrDeviceMismatch(),
+		},
+		{
+			name:    "ok - narrowed scope",
+			session: scopedSession,
+			scopes:  auth.Scopes{auth.ScopeEntitiesRead},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(scopedClaims("entities:read")).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, updateTokenReq).Return(nil)
+			},
+		},
+		{
+			name:    "ok - session scope kept",
+			session: scopedSession,
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(scopedClaims("entities:write users:admin")).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, updateTokenReq).Return(nil)
+			},
+		},
+		{
+			name:    "scope not granted",
+			session: auth.Session{ID: sessID, UserID: userID, ExpiresAt: session.ExpiresAt, Scopes: auth.Scopes{auth.ScopeEntitiesRead}},
+			scopes:  auth.Scopes{auth.ScopeEntitiesWrite},
+			setup: func(mocks mock) {
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+			},
+			err: auth.ErrScopeNotGranted(),
+		},
 		{
 			name:    "ok",
 			session: session,
@@ -258,6 +672,20 @@ func TestCore_RefreshTokens(t *testing.T) {
 				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, updateTokenReq).Return(nil)
 			},
 		},
+		{
+			name:    "ok - remember me ttl kept",
+			session: rememberedSession,
+			setup: func(mocks mock) {
+				rememberedReq := updateTokenReq
+				rememberedReq.ExpiresAt = now.Add(time.Duration(cfg().RememberMeSessionTTLMinutes) * time.Minute)
+				mocks.timeGen.NowMock.Return(now)
+				mocks.pswHasher.CheckPasswordHashMock.Expect([]byte(rtHash), []byte(refreshToken)).Return(nil)
+				mocks.rndGen.NewMock.Expect(32).Return(newRefreshToken, nil)
+				mocks.pswHasher.HashRefreshTokenMock.Expect([]byte(newRefreshToken)).Return([]byte(newRTHash), nil)
+				mocks.tokenCodec.GenerateTokenMock.Expect(claims).Return(accessToken, nil)
+				mocks.repo.UpdateRefreshTokenMock.Expect(ctx, rememberedReq).Return(nil)
+			},
+		},
 		{
 			name:    "session expired",
 			session: auth.Session{ExpiresAt: now.Add(-time.Minute)},
@@ -336,6 +764,8 @@ func TestCore_RefreshTokens(t *testing.T) {
 			t.Parallel()
 
 			mocks := setupMocks(t)
+			c := cfg()
+			c.DeviceBinding = tt.binding
 			if tt.setup != nil {
 				tt.setup(mocks)
 			}
@@ -347,11 +777,11 @@ func TestCore_RefreshTokens(t *testing.T) {
 				mocks.rndGen,
 				mocks.timeGen,
 				mocks.pswHasher,
-				cfg(),
+				c,
 			)
 			require.NoError(t, err)
 
-			tokens, err := core.RefreshTokens(ctx, tt.session, refreshToken, rtHash)
+			tokens, err := core.RefreshTokens(ctx, tt.session, refreshToken, rtHash, tt.scopes, tt.client)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
@@ -933,6 +1363,37 @@ func TestCore_GetCurrentUserDirectPermissions(t *testing.T) {
 	}
 }
 
+func TestCore_GetCurrentUserDirectPermissions_RequestCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID   = uuid.New()
+		entityID = uuid.New()
+		ctx      = contextx.WithCache(contextx.SetUserID(context.Background(), userID))
+		roles    = []auth.UserRole{{UserID: userID, Role: auth.RoleWrite, EntityID: &entityID}}
+	)
+
+	mocks := setupMocks(t)
+	mocks.repo.GetUserRolesMock.Expect(ctx, userID, auth.RoleWrite.GetHierarchy()).Return(roles, nil)
+	mocks.repo.AddUserRoleMock.Return(nil)
+	core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+	require.NoError(t, err)
+
+	for range 2 {
+		ids, isAdmin, err := core.GetCurrentUserDirectPermissions(ctx, auth.RoleWrite)
+		require.NoError(t, err)
+		require.False(t, isAdmin)
+		require.Equal(t, []uuid.UUID{entityID}, ids)
+	}
+	require.Equal(t, uint64(1), mocks.repo.GetUserRolesAfterCounter())
+
+	// a grant changes the roles, so they are loaded again
+	require.NoError(t, core.AddUserRole(ctx, auth.UserRole{UserID: userID, Role: auth.RoleRead, EntityID: &entityID}))
+	_, _, err = core.GetCurrentUserDirectPermissions(ctx, auth.RoleWrite)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), mocks.repo.GetUserRolesAfterCounter())
+}
+
 func TestCore_IsSelf(t *testing.T) {
 	t.Parallel()
 	userID := uuid.New()
@@ -991,3 +1452,194 @@ func TestCore_IsSelf(t *testing.T) {
 		})
 	}
 }
+
+func TestCore_CheckIsOperator(t *testing.T) {
+	t.Parallel()
+	var (
+		userID     = uuid.New()
+		adminRoles = []auth.UserRole{{UserID: userID, Role: auth.RoleAdmin}}
+		ctx        = contextx.SetUserID(context.Background(), userID)
+		defaultCtx = contextx.SetWorkspaceID(ctx, contextx.DefaultWorkspaceID)
+		otherCtx   = contextx.SetWorkspaceID(ctx, uuid.New())
+	)
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		roles []auth.UserRole
+		err   error
+	}{
+		{name: "admin of the default workspace", ctx: defaultCtx, roles: adminRoles},
+		{name: "no workspace means the default one", ctx: ctx, roles: adminRoles},
+		{name: "admin of another workspace", ctx: otherCtx, roles: adminRoles, err: apperr.ErrForbidden()},
+		{name: "not an admin", ctx: defaultCtx, err: apperr.ErrForbidden()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			mocks.repo.GetUserRolesMock.Expect(tt.ctx, userID, auth.RoleAdmin.GetHierarchy()).Return(tt.roles, nil)
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+			require.NoError(t, err)
+
+			err = core.CheckIsOperator(tt.ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_DeleteUserRoles(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		errExp = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(mocks mock)
+		want   int64
+		err    error
+	}{
+		{
+			name:   "ok",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.DeleteUserRolesMock.Expect(ctx, userID).Return(3, nil)
+			},
+			want: 3,
+		},
+		{
+			name:   "nil user id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(auth.FieldUserID),
+		},
+		{
+			name:   "repo error",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.DeleteUserRolesMock.Expect(ctx, userID).Return(0, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+			require.NoError(t, err)
+			got, err := core.DeleteUserRoles(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_GetConsistencyReport(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = context.Background()
+		entityID = uuid.New()
+		errExp   = fmt.Errorf("expected")
+		grants   = []auth.OrphanedGrant{
+			{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleAdmin}, Reason: auth.OrphanUserDeleted},
+			{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleRead, EntityID: &entityID}, Reason: auth.OrphanEntityDeleted},
+		}
+	)
+
+	mocks := setupMocks(t)
+	core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+	require.NoError(t, err)
+
+	mocks.repo.GetOrphanedGrantsMock.Expect(ctx).Return(grants, nil)
+	got, err := core.GetConsistencyReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, auth.ConsistencyReport{OrphanedGrants: grants}, got)
+
+	mocks = setupMocks(t)
+	core, err = auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+	require.NoError(t, err)
+	mocks.repo.GetOrphanedGrantsMock.Expect(ctx).Return(nil, errExp)
+	_, err = core.GetConsistencyReport(ctx)
+	require.ErrorIs(t, err, errExp)
+}
+
+func TestCore_RepairGrants(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = context.Background()
+		errExp = fmt.Errorf("expected")
+		grants = []auth.OrphanedGrant{
+			{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleAdmin}, Reason: auth.OrphanDuplicate},
+		}
+	)
+	tests := []struct {
+		name   string
+		dryRun bool
+		setup  func(m mock)
+		want   auth.ConsistencyReport
+		err    error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.repo.DeleteOrphanedGrantsMock.Expect(ctx).Return(grants, nil)
+			},
+			want: auth.ConsistencyReport{OrphanedGrants: grants, Repaired: true},
+		},
+		{
+			name:   "dry run only reports",
+			dryRun: true,
+			setup: func(m mock) {
+				m.repo.GetOrphanedGrantsMock.Expect(ctx).Return(grants, nil)
+			},
+			want: auth.ConsistencyReport{OrphanedGrants: grants},
+		},
+		{
+			name: "repo error",
+			setup: func(m mock) {
+				m.repo.DeleteOrphanedGrantsMock.Expect(ctx).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name:   "dry run repo error",
+			dryRun: true,
+			setup: func(m mock) {
+				m.repo.GetOrphanedGrantsMock.Expect(ctx).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mocks := setupMocks(t)
+			tt.setup(mocks)
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+			require.NoError(t, err)
+
+			got, err := core.RepairGrants(ctx, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
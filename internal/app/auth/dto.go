@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -13,6 +15,31 @@ type Session struct {
 	CreatedAt      time.Time `json:"created_at"`
 	ExpiresAt      time.Time `json:"expires_at"`
 	SessionVersion int       `json:"session_version"`
+	// Scopes were requested at login and limit every token of the session; empty is full scope.
+	Scopes Scopes `json:"scopes"`
+	// TTLClass tells which session TTL applies, at login and on every refresh.
+	TTLClass TTLClass `json:"ttl_class"`
+	// Client is where the session was created; empty for sessions older than device binding.
+	Client Client `json:"client"`
+	// FlaggedAt is when the session was first refreshed from another client, see DeviceBindingConfig.
+	FlaggedAt *time.Time `json:"flagged_at,omitempty"`
+}
+
+// TTLClass selects the session TTL: the default one, or the longer one of logins with remember_me.
+type TTLClass string
+
+const (
+	TTLClassDefault    TTLClass = "default"
+	TTLClassRememberMe TTLClass = "remember_me"
+)
+
+func (c TTLClass) Validate() error {
+	switch c {
+	case TTLClassDefault, TTLClassRememberMe:
+		return nil
+	default:
+		return fmt.Errorf("invalid session TTL class %q", c)
+	}
 }
 
 type UserRole struct {
@@ -21,12 +48,73 @@ type UserRole struct {
 	EntityID *uuid.UUID `json:"entity_id"`
 }
 
+// Summary is the grant as put in access tokens: the role, then the entity for entity roles, as in
+// "write:<entity_id>".
+func (ur UserRole) Summary() string {
+	if ur.EntityID == nil {
+		return string(ur.Role)
+	}
+
+	return string(ur.Role) + ":" + ur.EntityID.String()
+}
+
+type OrphanReason string
+
+const (
+	OrphanUserDeleted   OrphanReason = "user_deleted"
+	OrphanEntityDeleted OrphanReason = "entity_deleted"
+	// OrphanUnreachable is a grant on a live entity that no live root leads to: it is below a deleted
+	// or missing entity, or in a cycle of parents.
+	OrphanUnreachable OrphanReason = "unreachable"
+	// OrphanDuplicate is a copy of a grant beyond the first.
+	OrphanDuplicate OrphanReason = "duplicate"
+)
+
+// OrphanedGrant is a role grant that gives no access of its own: its user or its entity is deleted,
+// its entity cannot be reached from the root, or it repeats another grant.
+type OrphanedGrant struct {
+	UserRole
+	Reason OrphanReason `json:"reason"`
+}
+
+type ConsistencyReport struct {
+	OrphanedGrants []OrphanedGrant `json:"orphaned_grants"`
+	// Repaired is set when the orphaned grants listed have been removed.
+	Repaired bool `json:"repaired"`
+}
+
+// Client identifies where a sign-in attempt comes from.
+type Client struct {
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+// LoginEvent is a sign-in attempt. NewDevice is set on a success from a user agent or IP address
+// the user has not signed in from before; the first sign-in of a user is not flagged.
+type LoginEvent struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	Client
+	Success   bool      `json:"success"`
+	NewDevice bool      `json:"new_device"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// KnownClient tells which parts of a client a user has signed in from successfully before.
+type KnownClient struct {
+	Any       bool
+	IP        bool
+	UserAgent bool
+}
+
 type UpdateTokenReq struct {
 	SessionID           uuid.UUID `json:"session_id"`
 	UserID              uuid.UUID `json:"user_id"`
 	RefreshTokenHash    string    `json:"refresh_token_hash"`
 	OldRefreshTokenHash string    `json:"old_refresh_token_hash"`
 	ExpiresAt           time.Time `json:"expires_at"`
+	// FlaggedAt flags the session when set.
+	FlaggedAt *time.Time `json:"flagged_at,omitempty"`
 }
 
 type RefreshToken struct {
@@ -39,7 +127,43 @@ type Tokens struct {
 	RefreshToken RefreshToken `json:"refresh_token"`
 }
 
+// ImpersonationToken is a short-lived access token of another user. It has no refresh token.
+type ImpersonationToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
 type AccessTokenClaims struct {
-	SID string `json:"sid"` // session_id
+	SID string `json:"sid"`           // session_id
+	WID string `json:"wid"`           // workspace_id, the token is accepted only in this workspace
+	ACT string `json:"act,omitempty"` // actor user_id, set when an admin acts as the subject
+	// Scope is space-separated; a token without it has every scope.
+	Scope string `json:"scope,omitempty"`
+	// Roles, TID and Custom are for downstream services, see ClaimsConfig; this service ignores them.
+	Roles        []string          `json:"roles,omitempty"`
+	RolesOmitted bool              `json:"roles_omitted,omitempty"` // the user has more grants than fit
+	TID          string            `json:"tid,omitempty"`           // tenant_id of the deployment
+	Custom       map[string]string `json:"-"`
 	jwt.RegisteredClaims
 }
+
+// MarshalJSON puts the custom claims next to the others.
+func (c AccessTokenClaims) MarshalJSON() ([]byte, error) {
+	type claims AccessTokenClaims
+	data, err := json.Marshal(claims(c))
+	if err != nil || len(c.Custom) == 0 {
+		return data, err
+	}
+
+	merged := make(map[string]any, len(c.Custom))
+	if err = json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for name, value := range c.Custom {
+		if _, ok := merged[name]; !ok {
+			merged[name] = value
+		}
+	}
+
+	return json.Marshal(merged)
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultLoginHistoryLimit = 50
+	MaxLoginHistoryLimit     = 200
+)
+
+func ErrInvalidLimit() error {
+	return apperr.New("limit is out of range", CodeInvalidLimit, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": MaxLoginHistoryLimit},
+		})
+}
+
+// RecordLogin stores a sign-in attempt of an existing user. A success is checked against the
+// user's earlier successful sign-ins before it is stored, so the returned event carries NewDevice.
+func (c *core) RecordLogin(ctx context.Context, userID uuid.UUID, client Client, success bool) (LoginEvent, error) {
+	if userID == uuid.Nil {
+		return LoginEvent{}, fmt.Errorf("auth.core.RecordLogin: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+
+	id, err := c.generators.idGenerator.New()
+	if err != nil {
+		return LoginEvent{}, fmt.Errorf("auth.core.RecordLogin: %w", err)
+	}
+	event := LoginEvent{
+		ID:        id,
+		UserID:    userID,
+		Client:    client,
+		Success:   success,
+		CreatedAt: c.generators.timeGenerator.Now(),
+	}
+	if success {
+		known, err := c.repo.GetKnownClient(ctx, userID, client)
+		if err != nil {
+			return LoginEvent{}, fmt.Errorf("auth.core.RecordLogin: %w", err)
+		}
+		event.NewDevice = known.Any && (!known.IP || !known.UserAgent)
+	}
+
+	if err = c.repo.CreateLoginEvent(ctx, event); err != nil {
+		return LoginEvent{}, fmt.Errorf("auth.core.RecordLogin: %w", err)
+	}
+
+	return event, nil
+}
+
+func (c *core) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]LoginEvent, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("auth.core.GetLoginHistory: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if limit <= 0 || limit > MaxLoginHistoryLimit {
+		return nil, fmt.Errorf("auth.core.GetLoginHistory: %w", ErrInvalidLimit())
+	}
+
+	events, err := c.repo.GetLoginHistory(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("auth.core.GetLoginHistory: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,201 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_RecordLogin(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx     = context.Background()
+		userID  = uuid.New()
+		eventID = uuid.New()
+		now     = time.Now()
+		client  = auth.Client{IP: "203.0.113.7", UserAgent: "Mozilla/5.0"}
+		errExp  = fmt.Errorf("expected")
+	)
+	event := func(success, newDevice bool) auth.LoginEvent {
+		return auth.LoginEvent{ID: eventID, UserID: userID, Client: client, Success: success, NewDevice: newDevice, CreatedAt: now}
+	}
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		success bool
+		setup   func(mocks mock)
+		want    auth.LoginEvent
+		err     error
+	}{
+		{
+			name:   "failure is not compared",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.CreateLoginEventMock.Expect(ctx, event(false, false)).Return(nil)
+			},
+			want: event(false, false),
+		},
+		{
+			name:    "first sign-in",
+			userID:  userID,
+			success: true,
+			setup: func(mocks mock) {
+				mocks.repo.GetKnownClientMock.Expect(ctx, userID, client).Return(auth.KnownClient{}, nil)
+				mocks.repo.CreateLoginEventMock.Expect(ctx, event(true, false)).Return(nil)
+			},
+			want: event(true, false),
+		},
+		{
+			name:    "known device",
+			userID:  userID,
+			success: true,
+			setup: func(mocks mock) {
+				mocks.repo.GetKnownClientMock.Expect(ctx, userID, client).Return(auth.KnownClient{Any: true, IP: true, UserAgent: true}, nil)
+				mocks.repo.CreateLoginEventMock.Expect(ctx, event(true, false)).Return(nil)
+			},
+			want: event(true, false),
+		},
+		{
+			name:    "new location",
+			userID:  userID,
+			success: true,
+			setup: func(mocks mock) {
+				mocks.repo.GetKnownClientMock.Expect(ctx, userID, client).Return(auth.KnownClient{Any: true, UserAgent: true}, nil)
+				mocks.repo.CreateLoginEventMock.Expect(ctx, event(true, true)).Return(nil)
+			},
+			want: event(true, true),
+		},
+		{
+			name:    "new device",
+			userID:  userID,
+			success: true,
+			setup: func(mocks mock) {
+				mocks.repo.GetKnownClientMock.Expect(ctx, userID, client).Return(auth.KnownClient{Any: true, IP: true}, nil)
+				mocks.repo.CreateLoginEventMock.Expect(ctx, event(true, true)).Return(nil)
+			},
+			want: event(true, true),
+		},
+		{
+			name:   "nil user id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(auth.FieldUserID),
+		},
+		{
+			name:    "repo.GetKnownClient error",
+			userID:  userID,
+			success: true,
+			setup: func(mocks mock) {
+				mocks.repo.GetKnownClientMock.Return(auth.KnownClient{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name:   "repo.CreateLoginEvent error",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.CreateLoginEventMock.Return(errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			if tt.userID != uuid.Nil {
+				mocks.idGen.NewMock.Return(eventID, nil)
+				mocks.timeGen.NowMock.Return(now)
+			}
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+			require.NoError(t, err)
+			got, err := core.RecordLogin(ctx, tt.userID, client, tt.success)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_GetLoginHistory(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		events = []auth.LoginEvent{{ID: uuid.New(), UserID: userID, Success: true}}
+		errExp = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		limit  int
+		setup  func(mocks mock)
+		err    error
+	}{
+		{
+			name:   "ok",
+			userID: userID,
+			limit:  auth.MaxLoginHistoryLimit,
+			setup: func(mocks mock) {
+				mocks.repo.GetLoginHistoryMock.Expect(ctx, userID, auth.MaxLoginHistoryLimit).Return(events, nil)
+			},
+		},
+		{
+			name:   "nil user id",
+			userID: uuid.Nil,
+			limit:  1,
+			err:    apperr.ErrNilUUID(auth.FieldUserID),
+		},
+		{
+			name:   "zero limit",
+			userID: userID,
+			err:    auth.ErrInvalidLimit(),
+		},
+		{
+			name:   "limit too large",
+			userID: userID,
+			limit:  auth.MaxLoginHistoryLimit + 1,
+			err:    auth.ErrInvalidLimit(),
+		},
+		{
+			name:   "repo error",
+			userID: userID,
+			limit:  1,
+			setup: func(mocks mock) {
+				mocks.repo.GetLoginHistoryMock.Expect(ctx, userID, 1).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := setupMocks(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+			core, err := auth.NewCore(mocks.repo, mocks.tokenCodec, mocks.idGen, mocks.rndGen, mocks.timeGen, mocks.pswHasher, cfg())
+			require.NoError(t, err)
+			got, err := core.GetLoginHistory(ctx, tt.userID, tt.limit)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, events, got)
+		})
+	}
+}
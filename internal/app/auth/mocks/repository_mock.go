@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -27,6 +27,27 @@ type RepositoryMock struct {
 	beforeAddUserRoleCounter uint64
 	AddUserRoleMock          mRepositoryMockAddUserRole
 
+	funcApplyPreset          func(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) (ua1 []mm_auth.UserRole, err error)
+	funcApplyPresetOrigin    string
+	inspectFuncApplyPreset   func(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID)
+	afterApplyPresetCounter  uint64
+	beforeApplyPresetCounter uint64
+	ApplyPresetMock          mRepositoryMockApplyPreset
+
+	funcCreateLoginEvent          func(ctx context.Context, event mm_auth.LoginEvent) (err error)
+	funcCreateLoginEventOrigin    string
+	inspectFuncCreateLoginEvent   func(ctx context.Context, event mm_auth.LoginEvent)
+	afterCreateLoginEventCounter  uint64
+	beforeCreateLoginEventCounter uint64
+	CreateLoginEventMock          mRepositoryMockCreateLoginEvent
+
+	funcCreatePreset          func(ctx context.Context, preset mm_auth.RolePreset) (err error)
+	funcCreatePresetOrigin    string
+	inspectFuncCreatePreset   func(ctx context.Context, preset mm_auth.RolePreset)
+	afterCreatePresetCounter  uint64
+	beforeCreatePresetCounter uint64
+	CreatePresetMock          mRepositoryMockCreatePreset
+
 	funcCreateSession          func(ctx context.Context, req mm_auth.Session, rtHash string) (err error)
 	funcCreateSessionOrigin    string
 	inspectFuncCreateSession   func(ctx context.Context, req mm_auth.Session, rtHash string)
@@ -34,6 +55,20 @@ type RepositoryMock struct {
 	beforeCreateSessionCounter uint64
 	CreateSessionMock          mRepositoryMockCreateSession
 
+	funcDeleteOrphanedGrants          func(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error)
+	funcDeleteOrphanedGrantsOrigin    string
+	inspectFuncDeleteOrphanedGrants   func(ctx context.Context)
+	afterDeleteOrphanedGrantsCounter  uint64
+	beforeDeleteOrphanedGrantsCounter uint64
+	DeleteOrphanedGrantsMock          mRepositoryMockDeleteOrphanedGrants
+
+	funcDeletePreset          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeletePresetOrigin    string
+	inspectFuncDeletePreset   func(ctx context.Context, id uuid.UUID)
+	afterDeletePresetCounter  uint64
+	beforeDeletePresetCounter uint64
+	DeletePresetMock          mRepositoryMockDeletePreset
+
 	funcDeleteSessionByIDAndUser          func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)
 	funcDeleteSessionByIDAndUserOrigin    string
 	inspectFuncDeleteSessionByIDAndUser   func(ctx context.Context, id uuid.UUID, userID uuid.UUID)
@@ -55,6 +90,41 @@ type RepositoryMock struct {
 	beforeDeleteUserRoleCounter uint64
 	DeleteUserRoleMock          mRepositoryMockDeleteUserRole
 
+	funcDeleteUserRoles          func(ctx context.Context, userID uuid.UUID) (i1 int64, err error)
+	funcDeleteUserRolesOrigin    string
+	inspectFuncDeleteUserRoles   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteUserRolesCounter  uint64
+	beforeDeleteUserRolesCounter uint64
+	DeleteUserRolesMock          mRepositoryMockDeleteUserRoles
+
+	funcGetKnownClient          func(ctx context.Context, userID uuid.UUID, client mm_auth.Client) (k1 mm_auth.KnownClient, err error)
+	funcGetKnownClientOrigin    string
+	inspectFuncGetKnownClient   func(ctx context.Context, userID uuid.UUID, client mm_auth.Client)
+	afterGetKnownClientCounter  uint64
+	beforeGetKnownClientCounter uint64
+	GetKnownClientMock          mRepositoryMockGetKnownClient
+
+	funcGetLoginHistory          func(ctx context.Context, userID uuid.UUID, limit int) (la1 []mm_auth.LoginEvent, err error)
+	funcGetLoginHistoryOrigin    string
+	inspectFuncGetLoginHistory   func(ctx context.Context, userID uuid.UUID, limit int)
+	afterGetLoginHistoryCounter  uint64
+	beforeGetLoginHistoryCounter uint64
+	GetLoginHistoryMock          mRepositoryMockGetLoginHistory
+
+	funcGetOrphanedGrants          func(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error)
+	funcGetOrphanedGrantsOrigin    string
+	inspectFuncGetOrphanedGrants   func(ctx context.Context)
+	afterGetOrphanedGrantsCounter  uint64
+	beforeGetOrphanedGrantsCounter uint64
+	GetOrphanedGrantsMock          mRepositoryMockGetOrphanedGrants
+
+	funcGetPreset          func(ctx context.Context, id uuid.UUID) (r1 mm_auth.RolePreset, err error)
+	funcGetPresetOrigin    string
+	inspectFuncGetPreset   func(ctx context.Context, id uuid.UUID)
+	afterGetPresetCounter  uint64
+	beforeGetPresetCounter uint64
+	GetPresetMock          mRepositoryMockGetPreset
+
 	funcGetSessionByID          func(ctx context.Context, id uuid.UUID) (s1 mm_auth.Session, s2 string, err error)
 	funcGetSessionByIDOrigin    string
 	inspectFuncGetSessionByID   func(ctx context.Context, id uuid.UUID)
@@ -76,6 +146,13 @@ type RepositoryMock struct {
 	beforeGetUserRolesCounter uint64
 	GetUserRolesMock          mRepositoryMockGetUserRoles
 
+	funcListPresets          func(ctx context.Context) (ra1 []mm_auth.RolePreset, err error)
+	funcListPresetsOrigin    string
+	inspectFuncListPresets   func(ctx context.Context)
+	afterListPresetsCounter  uint64
+	beforeListPresetsCounter uint64
+	ListPresetsMock          mRepositoryMockListPresets
+
 	funcListUserRoles          func(ctx context.Context, userID uuid.UUID) (ua1 []mm_auth.UserRole, err error)
 	funcListUserRolesOrigin    string
 	inspectFuncListUserRoles   func(ctx context.Context, userID uuid.UUID)
@@ -83,6 +160,13 @@ type RepositoryMock struct {
 	beforeListUserRolesCounter uint64
 	ListUserRolesMock          mRepositoryMockListUserRoles
 
+	funcUpdatePreset          func(ctx context.Context, preset mm_auth.RolePreset) (err error)
+	funcUpdatePresetOrigin    string
+	inspectFuncUpdatePreset   func(ctx context.Context, preset mm_auth.RolePreset)
+	afterUpdatePresetCounter  uint64
+	beforeUpdatePresetCounter uint64
+	UpdatePresetMock          mRepositoryMockUpdatePreset
+
 	funcUpdateRefreshToken          func(ctx context.Context, req mm_auth.UpdateTokenReq) (err error)
 	funcUpdateRefreshTokenOrigin    string
 	inspectFuncUpdateRefreshToken   func(ctx context.Context, req mm_auth.UpdateTokenReq)
@@ -102,9 +186,24 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.AddUserRoleMock = mRepositoryMockAddUserRole{mock: m}
 	m.AddUserRoleMock.callArgs = []*RepositoryMockAddUserRoleParams{}
 
+	m.ApplyPresetMock = mRepositoryMockApplyPreset{mock: m}
+	m.ApplyPresetMock.callArgs = []*RepositoryMockApplyPresetParams{}
+
+	m.CreateLoginEventMock = mRepositoryMockCreateLoginEvent{mock: m}
+	m.CreateLoginEventMock.callArgs = []*RepositoryMockCreateLoginEventParams{}
+
+	m.CreatePresetMock = mRepositoryMockCreatePreset{mock: m}
+	m.CreatePresetMock.callArgs = []*RepositoryMockCreatePresetParams{}
+
 	m.CreateSessionMock = mRepositoryMockCreateSession{mock: m}
 	m.CreateSessionMock.callArgs = []*RepositoryMockCreateSessionParams{}
 
+	m.DeleteOrphanedGrantsMock = mRepositoryMockDeleteOrphanedGrants{mock: m}
+	m.DeleteOrphanedGrantsMock.callArgs = []*RepositoryMockDeleteOrphanedGrantsParams{}
+
+	m.DeletePresetMock = mRepositoryMockDeletePreset{mock: m}
+	m.DeletePresetMock.callArgs = []*RepositoryMockDeletePresetParams{}
+
 	m.DeleteSessionByIDAndUserMock = mRepositoryMockDeleteSessionByIDAndUser{mock: m}
 	m.DeleteSessionByIDAndUserMock.callArgs = []*RepositoryMockDeleteSessionByIDAndUserParams{}
 
@@ -114,6 +213,21 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.DeleteUserRoleMock = mRepositoryMockDeleteUserRole{mock: m}
 	m.DeleteUserRoleMock.callArgs = []*RepositoryMockDeleteUserRoleParams{}
 
+	m.DeleteUserRolesMock = mRepositoryMockDeleteUserRoles{mock: m}
+	m.DeleteUserRolesMock.callArgs = []*RepositoryMockDeleteUserRolesParams{}
+
+	m.GetKnownClientMock = mRepositoryMockGetKnownClient{mock: m}
+	m.GetKnownClientMock.callArgs = []*RepositoryMockGetKnownClientParams{}
+
+	m.GetLoginHistoryMock = mRepositoryMockGetLoginHistory{mock: m}
+	m.GetLoginHistoryMock.callArgs = []*RepositoryMockGetLoginHistoryParams{}
+
+	m.GetOrphanedGrantsMock = mRepositoryMockGetOrphanedGrants{mock: m}
+	m.GetOrphanedGrantsMock.callArgs = []*RepositoryMockGetOrphanedGrantsParams{}
+
+	m.GetPresetMock = mRepositoryMockGetPreset{mock: m}
+	m.GetPresetMock.callArgs = []*RepositoryMockGetPresetParams{}
+
 	m.GetSessionByIDMock = mRepositoryMockGetSessionByID{mock: m}
 	m.GetSessionByIDMock.callArgs = []*RepositoryMockGetSessionByIDParams{}
 
@@ -123,9 +237,15 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.GetUserRolesMock = mRepositoryMockGetUserRoles{mock: m}
 	m.GetUserRolesMock.callArgs = []*RepositoryMockGetUserRolesParams{}
 
+	m.ListPresetsMock = mRepositoryMockListPresets{mock: m}
+	m.ListPresetsMock.callArgs = []*RepositoryMockListPresetsParams{}
+
 	m.ListUserRolesMock = mRepositoryMockListUserRoles{mock: m}
 	m.ListUserRolesMock.callArgs = []*RepositoryMockListUserRolesParams{}
 
+	m.UpdatePresetMock = mRepositoryMockUpdatePreset{mock: m}
+	m.UpdatePresetMock.callArgs = []*RepositoryMockUpdatePresetParams{}
+
 	m.UpdateRefreshTokenMock = mRepositoryMockUpdateRefreshToken{mock: m}
 	m.UpdateRefreshTokenMock.callArgs = []*RepositoryMockUpdateRefreshTokenParams{}
 
@@ -476,55 +596,56 @@ func (m *RepositoryMock) MinimockAddUserRoleInspect() {
 	}
 }
 
-type mRepositoryMockCreateSession struct {
+type mRepositoryMockApplyPreset struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockCreateSessionExpectation
-	expectations       []*RepositoryMockCreateSessionExpectation
+	defaultExpectation *RepositoryMockApplyPresetExpectation
+	expectations       []*RepositoryMockApplyPresetExpectation
 
-	callArgs []*RepositoryMockCreateSessionParams
+	callArgs []*RepositoryMockApplyPresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockCreateSessionExpectation specifies expectation struct of the Repository.CreateSession
-type RepositoryMockCreateSessionExpectation struct {
+// RepositoryMockApplyPresetExpectation specifies expectation struct of the Repository.ApplyPreset
+type RepositoryMockApplyPresetExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockCreateSessionParams
-	paramPtrs          *RepositoryMockCreateSessionParamPtrs
-	expectationOrigins RepositoryMockCreateSessionExpectationOrigins
-	results            *RepositoryMockCreateSessionResults
+	params             *RepositoryMockApplyPresetParams
+	paramPtrs          *RepositoryMockApplyPresetParamPtrs
+	expectationOrigins RepositoryMockApplyPresetExpectationOrigins
+	results            *RepositoryMockApplyPresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockCreateSessionParams contains parameters of the Repository.CreateSession
-type RepositoryMockCreateSessionParams struct {
-	ctx    context.Context
-	req    mm_auth.Session
-	rtHash string
+// RepositoryMockApplyPresetParams contains parameters of the Repository.ApplyPreset
+type RepositoryMockApplyPresetParams struct {
+	ctx     context.Context
+	id      uuid.UUID
+	userIDs []uuid.UUID
 }
 
-// RepositoryMockCreateSessionParamPtrs contains pointers to parameters of the Repository.CreateSession
-type RepositoryMockCreateSessionParamPtrs struct {
-	ctx    *context.Context
-	req    *mm_auth.Session
-	rtHash *string
+// RepositoryMockApplyPresetParamPtrs contains pointers to parameters of the Repository.ApplyPreset
+type RepositoryMockApplyPresetParamPtrs struct {
+	ctx     *context.Context
+	id      *uuid.UUID
+	userIDs *[]uuid.UUID
 }
 
-// RepositoryMockCreateSessionResults contains results of the Repository.CreateSession
-type RepositoryMockCreateSessionResults struct {
+// RepositoryMockApplyPresetResults contains results of the Repository.ApplyPreset
+type RepositoryMockApplyPresetResults struct {
+	ua1 []mm_auth.UserRole
 	err error
 }
 
-// RepositoryMockCreateSessionOrigins contains origins of expectations of the Repository.CreateSession
-type RepositoryMockCreateSessionExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originReq    string
-	originRtHash string
+// RepositoryMockApplyPresetOrigins contains origins of expectations of the Repository.ApplyPreset
+type RepositoryMockApplyPresetExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originId      string
+	originUserIDs string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -532,372 +653,369 @@ type RepositoryMockCreateSessionExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateSession *mRepositoryMockCreateSession) Optional() *mRepositoryMockCreateSession {
-	mmCreateSession.optional = true
-	return mmCreateSession
+func (mmApplyPreset *mRepositoryMockApplyPreset) Optional() *mRepositoryMockApplyPreset {
+	mmApplyPreset.optional = true
+	return mmApplyPreset
 }
 
-// Expect sets up expected params for Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) Expect(ctx context.Context, req mm_auth.Session, rtHash string) *mRepositoryMockCreateSession {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+// Expect sets up expected params for Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) Expect(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) *mRepositoryMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCreateSession.defaultExpectation == nil {
-		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &RepositoryMockApplyPresetExpectation{}
 	}
 
-	if mmCreateSession.defaultExpectation.paramPtrs != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by ExpectParams functions")
+	if mmApplyPreset.defaultExpectation.paramPtrs != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by ExpectParams functions")
 	}
 
-	mmCreateSession.defaultExpectation.params = &RepositoryMockCreateSessionParams{ctx, req, rtHash}
-	mmCreateSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateSession.expectations {
-		if minimock.Equal(e.params, mmCreateSession.defaultExpectation.params) {
-			mmCreateSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSession.defaultExpectation.params)
+	mmApplyPreset.defaultExpectation.params = &RepositoryMockApplyPresetParams{ctx, id, userIDs}
+	mmApplyPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPreset.expectations {
+		if minimock.Equal(e.params, mmApplyPreset.defaultExpectation.params) {
+			mmApplyPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPreset.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateSession
+	return mmApplyPreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateSession {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) ExpectCtxParam1(ctx context.Context) *mRepositoryMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCreateSession.defaultExpectation == nil {
-		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &RepositoryMockApplyPresetExpectation{}
 	}
 
-	if mmCreateSession.defaultExpectation.params != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmCreateSession.defaultExpectation.paramPtrs == nil {
-		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &RepositoryMockApplyPresetParamPtrs{}
 	}
-	mmCreateSession.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateSession
+	return mmApplyPreset
 }
 
-// ExpectReqParam2 sets up expected param req for Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) ExpectReqParam2(req mm_auth.Session) *mRepositoryMockCreateSession {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) ExpectIdParam2(id uuid.UUID) *mRepositoryMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCreateSession.defaultExpectation == nil {
-		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &RepositoryMockApplyPresetExpectation{}
 	}
 
-	if mmCreateSession.defaultExpectation.params != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmCreateSession.defaultExpectation.paramPtrs == nil {
-		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &RepositoryMockApplyPresetParamPtrs{}
 	}
-	mmCreateSession.defaultExpectation.paramPtrs.req = &req
-	mmCreateSession.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.id = &id
+	mmApplyPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCreateSession
+	return mmApplyPreset
 }
 
-// ExpectRtHashParam3 sets up expected param rtHash for Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) ExpectRtHashParam3(rtHash string) *mRepositoryMockCreateSession {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+// ExpectUserIDsParam3 sets up expected param userIDs for Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) ExpectUserIDsParam3(userIDs []uuid.UUID) *mRepositoryMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCreateSession.defaultExpectation == nil {
-		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &RepositoryMockApplyPresetExpectation{}
 	}
 
-	if mmCreateSession.defaultExpectation.params != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmCreateSession.defaultExpectation.paramPtrs == nil {
-		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &RepositoryMockApplyPresetParamPtrs{}
 	}
-	mmCreateSession.defaultExpectation.paramPtrs.rtHash = &rtHash
-	mmCreateSession.defaultExpectation.expectationOrigins.originRtHash = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.userIDs = &userIDs
+	mmApplyPreset.defaultExpectation.expectationOrigins.originUserIDs = minimock.CallerInfo(1)
 
-	return mmCreateSession
+	return mmApplyPreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) Inspect(f func(ctx context.Context, req mm_auth.Session, rtHash string)) *mRepositoryMockCreateSession {
-	if mmCreateSession.mock.inspectFuncCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateSession")
+// Inspect accepts an inspector function that has same arguments as the Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) Inspect(f func(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID)) *mRepositoryMockApplyPreset {
+	if mmApplyPreset.mock.inspectFuncApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ApplyPreset")
 	}
 
-	mmCreateSession.mock.inspectFuncCreateSession = f
+	mmApplyPreset.mock.inspectFuncApplyPreset = f
 
-	return mmCreateSession
+	return mmApplyPreset
 }
 
-// Return sets up results that will be returned by Repository.CreateSession
-func (mmCreateSession *mRepositoryMockCreateSession) Return(err error) *RepositoryMock {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+// Return sets up results that will be returned by Repository.ApplyPreset
+func (mmApplyPreset *mRepositoryMockApplyPreset) Return(ua1 []mm_auth.UserRole, err error) *RepositoryMock {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCreateSession.defaultExpectation == nil {
-		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{mock: mmCreateSession.mock}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &RepositoryMockApplyPresetExpectation{mock: mmApplyPreset.mock}
 	}
-	mmCreateSession.defaultExpectation.results = &RepositoryMockCreateSessionResults{err}
-	mmCreateSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateSession.mock
+	mmApplyPreset.defaultExpectation.results = &RepositoryMockApplyPresetResults{ua1, err}
+	mmApplyPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// Set uses given function f to mock the Repository.CreateSession method
-func (mmCreateSession *mRepositoryMockCreateSession) Set(f func(ctx context.Context, req mm_auth.Session, rtHash string) (err error)) *RepositoryMock {
-	if mmCreateSession.defaultExpectation != nil {
-		mmCreateSession.mock.t.Fatalf("Default expectation is already set for the Repository.CreateSession method")
+// Set uses given function f to mock the Repository.ApplyPreset method
+func (mmApplyPreset *mRepositoryMockApplyPreset) Set(f func(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) (ua1 []mm_auth.UserRole, err error)) *RepositoryMock {
+	if mmApplyPreset.defaultExpectation != nil {
+		mmApplyPreset.mock.t.Fatalf("Default expectation is already set for the Repository.ApplyPreset method")
 	}
 
-	if len(mmCreateSession.expectations) > 0 {
-		mmCreateSession.mock.t.Fatalf("Some expectations are already set for the Repository.CreateSession method")
+	if len(mmApplyPreset.expectations) > 0 {
+		mmApplyPreset.mock.t.Fatalf("Some expectations are already set for the Repository.ApplyPreset method")
 	}
 
-	mmCreateSession.mock.funcCreateSession = f
-	mmCreateSession.mock.funcCreateSessionOrigin = minimock.CallerInfo(1)
-	return mmCreateSession.mock
+	mmApplyPreset.mock.funcApplyPreset = f
+	mmApplyPreset.mock.funcApplyPresetOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// When sets expectation for the Repository.CreateSession which will trigger the result defined by the following
+// When sets expectation for the Repository.ApplyPreset which will trigger the result defined by the following
 // Then helper
-func (mmCreateSession *mRepositoryMockCreateSession) When(ctx context.Context, req mm_auth.Session, rtHash string) *RepositoryMockCreateSessionExpectation {
-	if mmCreateSession.mock.funcCreateSession != nil {
-		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+func (mmApplyPreset *mRepositoryMockApplyPreset) When(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) *RepositoryMockApplyPresetExpectation {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("RepositoryMock.ApplyPreset mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockCreateSessionExpectation{
-		mock:               mmCreateSession.mock,
-		params:             &RepositoryMockCreateSessionParams{ctx, req, rtHash},
-		expectationOrigins: RepositoryMockCreateSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockApplyPresetExpectation{
+		mock:               mmApplyPreset.mock,
+		params:             &RepositoryMockApplyPresetParams{ctx, id, userIDs},
+		expectationOrigins: RepositoryMockApplyPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateSession.expectations = append(mmCreateSession.expectations, expectation)
+	mmApplyPreset.expectations = append(mmApplyPreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.CreateSession return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockCreateSessionExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockCreateSessionResults{err}
+// Then sets up Repository.ApplyPreset return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockApplyPresetExpectation) Then(ua1 []mm_auth.UserRole, err error) *RepositoryMock {
+	e.results = &RepositoryMockApplyPresetResults{ua1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.CreateSession should be invoked
-func (mmCreateSession *mRepositoryMockCreateSession) Times(n uint64) *mRepositoryMockCreateSession {
+// Times sets number of times Repository.ApplyPreset should be invoked
+func (mmApplyPreset *mRepositoryMockApplyPreset) Times(n uint64) *mRepositoryMockApplyPreset {
 	if n == 0 {
-		mmCreateSession.mock.t.Fatalf("Times of RepositoryMock.CreateSession mock can not be zero")
+		mmApplyPreset.mock.t.Fatalf("Times of RepositoryMock.ApplyPreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateSession.expectedInvocations, n)
-	mmCreateSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateSession
+	mm_atomic.StoreUint64(&mmApplyPreset.expectedInvocations, n)
+	mmApplyPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset
 }
 
-func (mmCreateSession *mRepositoryMockCreateSession) invocationsDone() bool {
-	if len(mmCreateSession.expectations) == 0 && mmCreateSession.defaultExpectation == nil && mmCreateSession.mock.funcCreateSession == nil {
+func (mmApplyPreset *mRepositoryMockApplyPreset) invocationsDone() bool {
+	if len(mmApplyPreset.expectations) == 0 && mmApplyPreset.defaultExpectation == nil && mmApplyPreset.mock.funcApplyPreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateSession.mock.afterCreateSessionCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSession.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPreset.mock.afterApplyPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateSession implements mm_auth.Repository
-func (mmCreateSession *RepositoryMock) CreateSession(ctx context.Context, req mm_auth.Session, rtHash string) (err error) {
-	mm_atomic.AddUint64(&mmCreateSession.beforeCreateSessionCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateSession.afterCreateSessionCounter, 1)
+// ApplyPreset implements mm_auth.Repository
+func (mmApplyPreset *RepositoryMock) ApplyPreset(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) (ua1 []mm_auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmApplyPreset.beforeApplyPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPreset.afterApplyPresetCounter, 1)
 
-	mmCreateSession.t.Helper()
+	mmApplyPreset.t.Helper()
 
-	if mmCreateSession.inspectFuncCreateSession != nil {
-		mmCreateSession.inspectFuncCreateSession(ctx, req, rtHash)
+	if mmApplyPreset.inspectFuncApplyPreset != nil {
+		mmApplyPreset.inspectFuncApplyPreset(ctx, id, userIDs)
 	}
 
-	mm_params := RepositoryMockCreateSessionParams{ctx, req, rtHash}
+	mm_params := RepositoryMockApplyPresetParams{ctx, id, userIDs}
 
 	// Record call args
-	mmCreateSession.CreateSessionMock.mutex.Lock()
-	mmCreateSession.CreateSessionMock.callArgs = append(mmCreateSession.CreateSessionMock.callArgs, &mm_params)
-	mmCreateSession.CreateSessionMock.mutex.Unlock()
+	mmApplyPreset.ApplyPresetMock.mutex.Lock()
+	mmApplyPreset.ApplyPresetMock.callArgs = append(mmApplyPreset.ApplyPresetMock.callArgs, &mm_params)
+	mmApplyPreset.ApplyPresetMock.mutex.Unlock()
 
-	for _, e := range mmCreateSession.CreateSessionMock.expectations {
+	for _, e := range mmApplyPreset.ApplyPresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ua1, e.results.err
 		}
 	}
 
-	if mmCreateSession.CreateSessionMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateSession.CreateSessionMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateSession.CreateSessionMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateSession.CreateSessionMock.defaultExpectation.paramPtrs
+	if mmApplyPreset.ApplyPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPreset.ApplyPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPreset.ApplyPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPreset.ApplyPresetMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockCreateSessionParams{ctx, req, rtHash}
+		mm_got := RepositoryMockApplyPresetParams{ctx, id, userIDs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmApplyPreset.t.Errorf("RepositoryMock.ApplyPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApplyPreset.t.Errorf("RepositoryMock.ApplyPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.rtHash != nil && !minimock.Equal(*mm_want_ptrs.rtHash, mm_got.rtHash) {
-				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter rtHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originRtHash, *mm_want_ptrs.rtHash, mm_got.rtHash, minimock.Diff(*mm_want_ptrs.rtHash, mm_got.rtHash))
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmApplyPreset.t.Errorf("RepositoryMock.ApplyPreset got unexpected parameter userIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originUserIDs, *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmApplyPreset.t.Errorf("RepositoryMock.ApplyPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateSession.CreateSessionMock.defaultExpectation.results
+		mm_results := mmApplyPreset.ApplyPresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateSession.t.Fatal("No results are set for the RepositoryMock.CreateSession")
+			mmApplyPreset.t.Fatal("No results are set for the RepositoryMock.ApplyPreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).ua1, (*mm_results).err
 	}
-	if mmCreateSession.funcCreateSession != nil {
-		return mmCreateSession.funcCreateSession(ctx, req, rtHash)
+	if mmApplyPreset.funcApplyPreset != nil {
+		return mmApplyPreset.funcApplyPreset(ctx, id, userIDs)
 	}
-	mmCreateSession.t.Fatalf("Unexpected call to RepositoryMock.CreateSession. %v %v %v", ctx, req, rtHash)
+	mmApplyPreset.t.Fatalf("Unexpected call to RepositoryMock.ApplyPreset. %v %v %v", ctx, id, userIDs)
 	return
 }
 
-// CreateSessionAfterCounter returns a count of finished RepositoryMock.CreateSession invocations
-func (mmCreateSession *RepositoryMock) CreateSessionAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateSession.afterCreateSessionCounter)
+// ApplyPresetAfterCounter returns a count of finished RepositoryMock.ApplyPreset invocations
+func (mmApplyPreset *RepositoryMock) ApplyPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.afterApplyPresetCounter)
 }
 
-// CreateSessionBeforeCounter returns a count of RepositoryMock.CreateSession invocations
-func (mmCreateSession *RepositoryMock) CreateSessionBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateSession.beforeCreateSessionCounter)
+// ApplyPresetBeforeCounter returns a count of RepositoryMock.ApplyPreset invocations
+func (mmApplyPreset *RepositoryMock) ApplyPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.beforeApplyPresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.CreateSession.
+// Calls returns a list of arguments used in each call to RepositoryMock.ApplyPreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateSession *mRepositoryMockCreateSession) Calls() []*RepositoryMockCreateSessionParams {
-	mmCreateSession.mutex.RLock()
+func (mmApplyPreset *mRepositoryMockApplyPreset) Calls() []*RepositoryMockApplyPresetParams {
+	mmApplyPreset.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockCreateSessionParams, len(mmCreateSession.callArgs))
-	copy(argCopy, mmCreateSession.callArgs)
+	argCopy := make([]*RepositoryMockApplyPresetParams, len(mmApplyPreset.callArgs))
+	copy(argCopy, mmApplyPreset.callArgs)
 
-	mmCreateSession.mutex.RUnlock()
+	mmApplyPreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateSessionDone returns true if the count of the CreateSession invocations corresponds
+// MinimockApplyPresetDone returns true if the count of the ApplyPreset invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockCreateSessionDone() bool {
-	if m.CreateSessionMock.optional {
+func (m *RepositoryMock) MinimockApplyPresetDone() bool {
+	if m.ApplyPresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateSessionMock.expectations {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateSessionMock.invocationsDone()
+	return m.ApplyPresetMock.invocationsDone()
 }
 
-// MinimockCreateSessionInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockCreateSessionInspect() {
-	for _, e := range m.CreateSessionMock.expectations {
+// MinimockApplyPresetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockApplyPresetInspect() {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.ApplyPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateSessionCounter := mm_atomic.LoadUint64(&m.afterCreateSessionCounter)
+	afterApplyPresetCounter := mm_atomic.LoadUint64(&m.afterApplyPresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateSessionMock.defaultExpectation != nil && afterCreateSessionCounter < 1 {
-		if m.CreateSessionMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s", m.CreateSessionMock.defaultExpectation.returnOrigin)
+	if m.ApplyPresetMock.defaultExpectation != nil && afterApplyPresetCounter < 1 {
+		if m.ApplyPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ApplyPreset at\n%s", m.ApplyPresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s with params: %#v", m.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *m.CreateSessionMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.ApplyPreset at\n%s with params: %#v", m.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateSession != nil && afterCreateSessionCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s", m.funcCreateSessionOrigin)
+	if m.funcApplyPreset != nil && afterApplyPresetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ApplyPreset at\n%s", m.funcApplyPresetOrigin)
 	}
 
-	if !m.CreateSessionMock.invocationsDone() && afterCreateSessionCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.CreateSession at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateSessionMock.expectedInvocations), m.CreateSessionMock.expectedInvocationsOrigin, afterCreateSessionCounter)
+	if !m.ApplyPresetMock.invocationsDone() && afterApplyPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ApplyPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPresetMock.expectedInvocations), m.ApplyPresetMock.expectedInvocationsOrigin, afterApplyPresetCounter)
 	}
 }
 
-type mRepositoryMockDeleteSessionByIDAndUser struct {
+type mRepositoryMockCreateLoginEvent struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockDeleteSessionByIDAndUserExpectation
-	expectations       []*RepositoryMockDeleteSessionByIDAndUserExpectation
+	defaultExpectation *RepositoryMockCreateLoginEventExpectation
+	expectations       []*RepositoryMockCreateLoginEventExpectation
 
-	callArgs []*RepositoryMockDeleteSessionByIDAndUserParams
+	callArgs []*RepositoryMockCreateLoginEventParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockDeleteSessionByIDAndUserExpectation specifies expectation struct of the Repository.DeleteSessionByIDAndUser
-type RepositoryMockDeleteSessionByIDAndUserExpectation struct {
+// RepositoryMockCreateLoginEventExpectation specifies expectation struct of the Repository.CreateLoginEvent
+type RepositoryMockCreateLoginEventExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockDeleteSessionByIDAndUserParams
-	paramPtrs          *RepositoryMockDeleteSessionByIDAndUserParamPtrs
-	expectationOrigins RepositoryMockDeleteSessionByIDAndUserExpectationOrigins
-	results            *RepositoryMockDeleteSessionByIDAndUserResults
+	params             *RepositoryMockCreateLoginEventParams
+	paramPtrs          *RepositoryMockCreateLoginEventParamPtrs
+	expectationOrigins RepositoryMockCreateLoginEventExpectationOrigins
+	results            *RepositoryMockCreateLoginEventResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockDeleteSessionByIDAndUserParams contains parameters of the Repository.DeleteSessionByIDAndUser
-type RepositoryMockDeleteSessionByIDAndUserParams struct {
-	ctx    context.Context
-	id     uuid.UUID
-	userID uuid.UUID
+// RepositoryMockCreateLoginEventParams contains parameters of the Repository.CreateLoginEvent
+type RepositoryMockCreateLoginEventParams struct {
+	ctx   context.Context
+	event mm_auth.LoginEvent
 }
 
-// RepositoryMockDeleteSessionByIDAndUserParamPtrs contains pointers to parameters of the Repository.DeleteSessionByIDAndUser
-type RepositoryMockDeleteSessionByIDAndUserParamPtrs struct {
-	ctx    *context.Context
-	id     *uuid.UUID
-	userID *uuid.UUID
+// RepositoryMockCreateLoginEventParamPtrs contains pointers to parameters of the Repository.CreateLoginEvent
+type RepositoryMockCreateLoginEventParamPtrs struct {
+	ctx   *context.Context
+	event *mm_auth.LoginEvent
 }
 
-// RepositoryMockDeleteSessionByIDAndUserResults contains results of the Repository.DeleteSessionByIDAndUser
-type RepositoryMockDeleteSessionByIDAndUserResults struct {
+// RepositoryMockCreateLoginEventResults contains results of the Repository.CreateLoginEvent
+type RepositoryMockCreateLoginEventResults struct {
 	err error
 }
 
-// RepositoryMockDeleteSessionByIDAndUserOrigins contains origins of expectations of the Repository.DeleteSessionByIDAndUser
-type RepositoryMockDeleteSessionByIDAndUserExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originId     string
-	originUserID string
+// RepositoryMockCreateLoginEventOrigins contains origins of expectations of the Repository.CreateLoginEvent
+type RepositoryMockCreateLoginEventExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -905,369 +1023,341 @@ type RepositoryMockDeleteSessionByIDAndUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Optional() *mRepositoryMockDeleteSessionByIDAndUser {
-	mmDeleteSessionByIDAndUser.optional = true
-	return mmDeleteSessionByIDAndUser
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Optional() *mRepositoryMockCreateLoginEvent {
+	mmCreateLoginEvent.optional = true
+	return mmCreateLoginEvent
 }
 
-// Expect sets up expected params for Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+// Expect sets up expected params for Repository.CreateLoginEvent
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Expect(ctx context.Context, event mm_auth.LoginEvent) *mRepositoryMockCreateLoginEvent {
+	if mmCreateLoginEvent.mock.funcCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Set")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	if mmCreateLoginEvent.defaultExpectation == nil {
+		mmCreateLoginEvent.defaultExpectation = &RepositoryMockCreateLoginEventExpectation{}
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by ExpectParams functions")
+	if mmCreateLoginEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSessionByIDAndUser.defaultExpectation.params = &RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
-	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSessionByIDAndUser.expectations {
-		if minimock.Equal(e.params, mmDeleteSessionByIDAndUser.defaultExpectation.params) {
-			mmDeleteSessionByIDAndUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionByIDAndUser.defaultExpectation.params)
+	mmCreateLoginEvent.defaultExpectation.params = &RepositoryMockCreateLoginEventParams{ctx, event}
+	mmCreateLoginEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateLoginEvent.expectations {
+		if minimock.Equal(e.params, mmCreateLoginEvent.defaultExpectation.params) {
+			mmCreateLoginEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateLoginEvent.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSessionByIDAndUser
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSessionByIDAndUser {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
-	}
-
-	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
-	}
-
-	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
-	}
-
-	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
-	}
-	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmDeleteSessionByIDAndUser
+	return mmCreateLoginEvent
 }
 
-// ExpectIdParam2 sets up expected param id for Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectIdParam2(id uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateLoginEvent
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateLoginEvent {
+	if mmCreateLoginEvent.mock.funcCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Set")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	if mmCreateLoginEvent.defaultExpectation == nil {
+		mmCreateLoginEvent.defaultExpectation = &RepositoryMockCreateLoginEventExpectation{}
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
+	if mmCreateLoginEvent.defaultExpectation.params != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Expect")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
+	if mmCreateLoginEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateLoginEvent.defaultExpectation.paramPtrs = &RepositoryMockCreateLoginEventParamPtrs{}
 	}
-	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.id = &id
-	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreateLoginEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateLoginEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSessionByIDAndUser
+	return mmCreateLoginEvent
 }
 
-// ExpectUserIDParam3 sets up expected param userID for Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectUserIDParam3(userID uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+// ExpectEventParam2 sets up expected param event for Repository.CreateLoginEvent
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) ExpectEventParam2(event mm_auth.LoginEvent) *mRepositoryMockCreateLoginEvent {
+	if mmCreateLoginEvent.mock.funcCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Set")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	if mmCreateLoginEvent.defaultExpectation == nil {
+		mmCreateLoginEvent.defaultExpectation = &RepositoryMockCreateLoginEventExpectation{}
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
+	if mmCreateLoginEvent.defaultExpectation.params != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Expect")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
+	if mmCreateLoginEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateLoginEvent.defaultExpectation.paramPtrs = &RepositoryMockCreateLoginEventParamPtrs{}
 	}
-	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmCreateLoginEvent.defaultExpectation.paramPtrs.event = &event
+	mmCreateLoginEvent.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
 
-	return mmDeleteSessionByIDAndUser
+	return mmCreateLoginEvent
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mRepositoryMockDeleteSessionByIDAndUser {
-	if mmDeleteSessionByIDAndUser.mock.inspectFuncDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSessionByIDAndUser")
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateLoginEvent
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Inspect(f func(ctx context.Context, event mm_auth.LoginEvent)) *mRepositoryMockCreateLoginEvent {
+	if mmCreateLoginEvent.mock.inspectFuncCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateLoginEvent")
 	}
 
-	mmDeleteSessionByIDAndUser.mock.inspectFuncDeleteSessionByIDAndUser = f
+	mmCreateLoginEvent.mock.inspectFuncCreateLoginEvent = f
 
-	return mmDeleteSessionByIDAndUser
+	return mmCreateLoginEvent
 }
 
-// Return sets up results that will be returned by Repository.DeleteSessionByIDAndUser
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Return(err error) *RepositoryMock {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+// Return sets up results that will be returned by Repository.CreateLoginEvent
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Return(err error) *RepositoryMock {
+	if mmCreateLoginEvent.mock.funcCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Set")
 	}
 
-	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
-		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{mock: mmDeleteSessionByIDAndUser.mock}
+	if mmCreateLoginEvent.defaultExpectation == nil {
+		mmCreateLoginEvent.defaultExpectation = &RepositoryMockCreateLoginEventExpectation{mock: mmCreateLoginEvent.mock}
 	}
-	mmDeleteSessionByIDAndUser.defaultExpectation.results = &RepositoryMockDeleteSessionByIDAndUserResults{err}
-	mmDeleteSessionByIDAndUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionByIDAndUser.mock
+	mmCreateLoginEvent.defaultExpectation.results = &RepositoryMockCreateLoginEventResults{err}
+	mmCreateLoginEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginEvent.mock
 }
 
-// Set uses given function f to mock the Repository.DeleteSessionByIDAndUser method
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *RepositoryMock {
-	if mmDeleteSessionByIDAndUser.defaultExpectation != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSessionByIDAndUser method")
+// Set uses given function f to mock the Repository.CreateLoginEvent method
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Set(f func(ctx context.Context, event mm_auth.LoginEvent) (err error)) *RepositoryMock {
+	if mmCreateLoginEvent.defaultExpectation != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("Default expectation is already set for the Repository.CreateLoginEvent method")
 	}
 
-	if len(mmDeleteSessionByIDAndUser.expectations) > 0 {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSessionByIDAndUser method")
+	if len(mmCreateLoginEvent.expectations) > 0 {
+		mmCreateLoginEvent.mock.t.Fatalf("Some expectations are already set for the Repository.CreateLoginEvent method")
 	}
 
-	mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser = f
-	mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionByIDAndUser.mock
+	mmCreateLoginEvent.mock.funcCreateLoginEvent = f
+	mmCreateLoginEvent.mock.funcCreateLoginEventOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginEvent.mock
 }
 
-// When sets expectation for the Repository.DeleteSessionByIDAndUser which will trigger the result defined by the following
+// When sets expectation for the Repository.CreateLoginEvent which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *RepositoryMockDeleteSessionByIDAndUserExpectation {
-	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) When(ctx context.Context, event mm_auth.LoginEvent) *RepositoryMockCreateLoginEventExpectation {
+	if mmCreateLoginEvent.mock.funcCreateLoginEvent != nil {
+		mmCreateLoginEvent.mock.t.Fatalf("RepositoryMock.CreateLoginEvent mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockDeleteSessionByIDAndUserExpectation{
-		mock:               mmDeleteSessionByIDAndUser.mock,
-		params:             &RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID},
-		expectationOrigins: RepositoryMockDeleteSessionByIDAndUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockCreateLoginEventExpectation{
+		mock:               mmCreateLoginEvent.mock,
+		params:             &RepositoryMockCreateLoginEventParams{ctx, event},
+		expectationOrigins: RepositoryMockCreateLoginEventExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSessionByIDAndUser.expectations = append(mmDeleteSessionByIDAndUser.expectations, expectation)
+	mmCreateLoginEvent.expectations = append(mmCreateLoginEvent.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteSessionByIDAndUser return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockDeleteSessionByIDAndUserExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockDeleteSessionByIDAndUserResults{err}
+// Then sets up Repository.CreateLoginEvent return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateLoginEventExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateLoginEventResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteSessionByIDAndUser should be invoked
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Times(n uint64) *mRepositoryMockDeleteSessionByIDAndUser {
+// Times sets number of times Repository.CreateLoginEvent should be invoked
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Times(n uint64) *mRepositoryMockCreateLoginEvent {
 	if n == 0 {
-		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Times of RepositoryMock.DeleteSessionByIDAndUser mock can not be zero")
+		mmCreateLoginEvent.mock.t.Fatalf("Times of RepositoryMock.CreateLoginEvent mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSessionByIDAndUser.expectedInvocations, n)
-	mmDeleteSessionByIDAndUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionByIDAndUser
+	mm_atomic.StoreUint64(&mmCreateLoginEvent.expectedInvocations, n)
+	mmCreateLoginEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateLoginEvent
 }
 
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) invocationsDone() bool {
-	if len(mmDeleteSessionByIDAndUser.expectations) == 0 && mmDeleteSessionByIDAndUser.defaultExpectation == nil && mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser == nil {
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) invocationsDone() bool {
+	if len(mmCreateLoginEvent.expectations) == 0 && mmCreateLoginEvent.defaultExpectation == nil && mmCreateLoginEvent.mock.funcCreateLoginEvent == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.mock.afterDeleteSessionByIDAndUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateLoginEvent.mock.afterCreateLoginEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateLoginEvent.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSessionByIDAndUser implements mm_auth.Repository
-func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.beforeDeleteSessionByIDAndUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.afterDeleteSessionByIDAndUserCounter, 1)
+// CreateLoginEvent implements mm_auth.Repository
+func (mmCreateLoginEvent *RepositoryMock) CreateLoginEvent(ctx context.Context, event mm_auth.LoginEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreateLoginEvent.beforeCreateLoginEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateLoginEvent.afterCreateLoginEventCounter, 1)
 
-	mmDeleteSessionByIDAndUser.t.Helper()
+	mmCreateLoginEvent.t.Helper()
 
-	if mmDeleteSessionByIDAndUser.inspectFuncDeleteSessionByIDAndUser != nil {
-		mmDeleteSessionByIDAndUser.inspectFuncDeleteSessionByIDAndUser(ctx, id, userID)
+	if mmCreateLoginEvent.inspectFuncCreateLoginEvent != nil {
+		mmCreateLoginEvent.inspectFuncCreateLoginEvent(ctx, event)
 	}
 
-	mm_params := RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
+	mm_params := RepositoryMockCreateLoginEventParams{ctx, event}
 
 	// Record call args
-	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.mutex.Lock()
-	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.callArgs = append(mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.callArgs, &mm_params)
-	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.mutex.Unlock()
+	mmCreateLoginEvent.CreateLoginEventMock.mutex.Lock()
+	mmCreateLoginEvent.CreateLoginEventMock.callArgs = append(mmCreateLoginEvent.CreateLoginEventMock.callArgs, &mm_params)
+	mmCreateLoginEvent.CreateLoginEventMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.expectations {
+	for _, e := range mmCreateLoginEvent.CreateLoginEventMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.paramPtrs
+	if mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
+		mm_got := RepositoryMockCreateLoginEventParams{ctx, event}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateLoginEvent.t.Errorf("RepositoryMock.CreateLoginEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateLoginEvent.t.Errorf("RepositoryMock.CreateLoginEvent got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateLoginEvent.t.Errorf("RepositoryMock.CreateLoginEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.results
+		mm_results := mmCreateLoginEvent.CreateLoginEventMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSessionByIDAndUser.t.Fatal("No results are set for the RepositoryMock.DeleteSessionByIDAndUser")
+			mmCreateLoginEvent.t.Fatal("No results are set for the RepositoryMock.CreateLoginEvent")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteSessionByIDAndUser.funcDeleteSessionByIDAndUser != nil {
-		return mmDeleteSessionByIDAndUser.funcDeleteSessionByIDAndUser(ctx, id, userID)
+	if mmCreateLoginEvent.funcCreateLoginEvent != nil {
+		return mmCreateLoginEvent.funcCreateLoginEvent(ctx, event)
 	}
-	mmDeleteSessionByIDAndUser.t.Fatalf("Unexpected call to RepositoryMock.DeleteSessionByIDAndUser. %v %v %v", ctx, id, userID)
+	mmCreateLoginEvent.t.Fatalf("Unexpected call to RepositoryMock.CreateLoginEvent. %v %v", ctx, event)
 	return
 }
 
-// DeleteSessionByIDAndUserAfterCounter returns a count of finished RepositoryMock.DeleteSessionByIDAndUser invocations
-func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.afterDeleteSessionByIDAndUserCounter)
+// CreateLoginEventAfterCounter returns a count of finished RepositoryMock.CreateLoginEvent invocations
+func (mmCreateLoginEvent *RepositoryMock) CreateLoginEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLoginEvent.afterCreateLoginEventCounter)
 }
 
-// DeleteSessionByIDAndUserBeforeCounter returns a count of RepositoryMock.DeleteSessionByIDAndUser invocations
-func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.beforeDeleteSessionByIDAndUserCounter)
+// CreateLoginEventBeforeCounter returns a count of RepositoryMock.CreateLoginEvent invocations
+func (mmCreateLoginEvent *RepositoryMock) CreateLoginEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateLoginEvent.beforeCreateLoginEventCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSessionByIDAndUser.
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateLoginEvent.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Calls() []*RepositoryMockDeleteSessionByIDAndUserParams {
-	mmDeleteSessionByIDAndUser.mutex.RLock()
+func (mmCreateLoginEvent *mRepositoryMockCreateLoginEvent) Calls() []*RepositoryMockCreateLoginEventParams {
+	mmCreateLoginEvent.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockDeleteSessionByIDAndUserParams, len(mmDeleteSessionByIDAndUser.callArgs))
-	copy(argCopy, mmDeleteSessionByIDAndUser.callArgs)
+	argCopy := make([]*RepositoryMockCreateLoginEventParams, len(mmCreateLoginEvent.callArgs))
+	copy(argCopy, mmCreateLoginEvent.callArgs)
 
-	mmDeleteSessionByIDAndUser.mutex.RUnlock()
+	mmCreateLoginEvent.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionByIDAndUserDone returns true if the count of the DeleteSessionByIDAndUser invocations corresponds
+// MinimockCreateLoginEventDone returns true if the count of the CreateLoginEvent invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockDeleteSessionByIDAndUserDone() bool {
-	if m.DeleteSessionByIDAndUserMock.optional {
+func (m *RepositoryMock) MinimockCreateLoginEventDone() bool {
+	if m.CreateLoginEventMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionByIDAndUserMock.expectations {
+	for _, e := range m.CreateLoginEventMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionByIDAndUserMock.invocationsDone()
+	return m.CreateLoginEventMock.invocationsDone()
 }
 
-// MinimockDeleteSessionByIDAndUserInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockDeleteSessionByIDAndUserInspect() {
-	for _, e := range m.DeleteSessionByIDAndUserMock.expectations {
+// MinimockCreateLoginEventInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateLoginEventInspect() {
+	for _, e := range m.CreateLoginEventMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.CreateLoginEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionByIDAndUserCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionByIDAndUserCounter)
+	afterCreateLoginEventCounter := mm_atomic.LoadUint64(&m.afterCreateLoginEventCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionByIDAndUserMock.defaultExpectation != nil && afterDeleteSessionByIDAndUserCounter < 1 {
-		if m.DeleteSessionByIDAndUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s", m.DeleteSessionByIDAndUserMock.defaultExpectation.returnOrigin)
+	if m.CreateLoginEventMock.defaultExpectation != nil && afterCreateLoginEventCounter < 1 {
+		if m.CreateLoginEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateLoginEvent at\n%s", m.CreateLoginEventMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s with params: %#v", m.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionByIDAndUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.CreateLoginEvent at\n%s with params: %#v", m.CreateLoginEventMock.defaultExpectation.expectationOrigins.origin, *m.CreateLoginEventMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSessionByIDAndUser != nil && afterDeleteSessionByIDAndUserCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s", m.funcDeleteSessionByIDAndUserOrigin)
+	if m.funcCreateLoginEvent != nil && afterCreateLoginEventCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateLoginEvent at\n%s", m.funcCreateLoginEventOrigin)
 	}
 
-	if !m.DeleteSessionByIDAndUserMock.invocationsDone() && afterDeleteSessionByIDAndUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSessionByIDAndUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionByIDAndUserMock.expectedInvocations), m.DeleteSessionByIDAndUserMock.expectedInvocationsOrigin, afterDeleteSessionByIDAndUserCounter)
+	if !m.CreateLoginEventMock.invocationsDone() && afterCreateLoginEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateLoginEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateLoginEventMock.expectedInvocations), m.CreateLoginEventMock.expectedInvocationsOrigin, afterCreateLoginEventCounter)
 	}
 }
 
-type mRepositoryMockDeleteSessionsByUserID struct {
+type mRepositoryMockCreatePreset struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockDeleteSessionsByUserIDExpectation
-	expectations       []*RepositoryMockDeleteSessionsByUserIDExpectation
+	defaultExpectation *RepositoryMockCreatePresetExpectation
+	expectations       []*RepositoryMockCreatePresetExpectation
 
-	callArgs []*RepositoryMockDeleteSessionsByUserIDParams
+	callArgs []*RepositoryMockCreatePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockDeleteSessionsByUserIDExpectation specifies expectation struct of the Repository.DeleteSessionsByUserID
-type RepositoryMockDeleteSessionsByUserIDExpectation struct {
+// RepositoryMockCreatePresetExpectation specifies expectation struct of the Repository.CreatePreset
+type RepositoryMockCreatePresetExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockDeleteSessionsByUserIDParams
-	paramPtrs          *RepositoryMockDeleteSessionsByUserIDParamPtrs
-	expectationOrigins RepositoryMockDeleteSessionsByUserIDExpectationOrigins
-	results            *RepositoryMockDeleteSessionsByUserIDResults
+	params             *RepositoryMockCreatePresetParams
+	paramPtrs          *RepositoryMockCreatePresetParamPtrs
+	expectationOrigins RepositoryMockCreatePresetExpectationOrigins
+	results            *RepositoryMockCreatePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockDeleteSessionsByUserIDParams contains parameters of the Repository.DeleteSessionsByUserID
-type RepositoryMockDeleteSessionsByUserIDParams struct {
+// RepositoryMockCreatePresetParams contains parameters of the Repository.CreatePreset
+type RepositoryMockCreatePresetParams struct {
 	ctx    context.Context
-	userID uuid.UUID
+	preset mm_auth.RolePreset
 }
 
-// RepositoryMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the Repository.DeleteSessionsByUserID
-type RepositoryMockDeleteSessionsByUserIDParamPtrs struct {
+// RepositoryMockCreatePresetParamPtrs contains pointers to parameters of the Repository.CreatePreset
+type RepositoryMockCreatePresetParamPtrs struct {
 	ctx    *context.Context
-	userID *uuid.UUID
+	preset *mm_auth.RolePreset
 }
 
-// RepositoryMockDeleteSessionsByUserIDResults contains results of the Repository.DeleteSessionsByUserID
-type RepositoryMockDeleteSessionsByUserIDResults struct {
+// RepositoryMockCreatePresetResults contains results of the Repository.CreatePreset
+type RepositoryMockCreatePresetResults struct {
 	err error
 }
 
-// RepositoryMockDeleteSessionsByUserIDOrigins contains origins of expectations of the Repository.DeleteSessionsByUserID
-type RepositoryMockDeleteSessionsByUserIDExpectationOrigins struct {
+// RepositoryMockCreatePresetOrigins contains origins of expectations of the Repository.CreatePreset
+type RepositoryMockCreatePresetExpectationOrigins struct {
 	origin       string
 	originCtx    string
-	originUserID string
+	originPreset string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1275,341 +1365,3829 @@ type RepositoryMockDeleteSessionsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Optional() *mRepositoryMockDeleteSessionsByUserID {
-	mmDeleteSessionsByUserID.optional = true
-	return mmDeleteSessionsByUserID
+func (mmCreatePreset *mRepositoryMockCreatePreset) Optional() *mRepositoryMockCreatePreset {
+	mmCreatePreset.optional = true
+	return mmCreatePreset
 }
 
-// Expect sets up expected params for Repository.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+// Expect sets up expected params for Repository.CreatePreset
+func (mmCreatePreset *mRepositoryMockCreatePreset) Expect(ctx context.Context, preset mm_auth.RolePreset) *mRepositoryMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &RepositoryMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	if mmCreatePreset.defaultExpectation.paramPtrs != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSessionsByUserID.defaultExpectation.params = &RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSessionsByUserID.expectations {
-		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
-			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+	mmCreatePreset.defaultExpectation.params = &RepositoryMockCreatePresetParams{ctx, preset}
+	mmCreatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePreset.expectations {
+		if minimock.Equal(e.params, mmCreatePreset.defaultExpectation.params) {
+			mmCreatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreatePreset
+func (mmCreatePreset *mRepositoryMockCreatePreset) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &RepositoryMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionsByUserIDParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &RepositoryMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectPresetParam2 sets up expected param preset for Repository.CreatePreset
+func (mmCreatePreset *mRepositoryMockCreatePreset) ExpectPresetParam2(preset mm_auth.RolePreset) *mRepositoryMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &RepositoryMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionsByUserIDParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &RepositoryMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.preset = &preset
+	mmCreatePreset.defaultExpectation.expectationOrigins.originPreset = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSessionsByUserID")
+// Inspect accepts an inspector function that has same arguments as the Repository.CreatePreset
+func (mmCreatePreset *mRepositoryMockCreatePreset) Inspect(f func(ctx context.Context, preset mm_auth.RolePreset)) *mRepositoryMockCreatePreset {
+	if mmCreatePreset.mock.inspectFuncCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreatePreset")
 	}
 
-	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+	mmCreatePreset.mock.inspectFuncCreatePreset = f
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// Return sets up results that will be returned by Repository.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Return(err error) *RepositoryMock {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+// Return sets up results that will be returned by Repository.CreatePreset
+func (mmCreatePreset *mRepositoryMockCreatePreset) Return(err error) *RepositoryMock {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Set")
+	}
+
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &RepositoryMockCreatePresetExpectation{mock: mmCreatePreset.mock}
+	}
+	mmCreatePreset.defaultExpectation.results = &RepositoryMockCreatePresetResults{err}
+	mmCreatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
+}
+
+// Set uses given function f to mock the Repository.CreatePreset method
+func (mmCreatePreset *mRepositoryMockCreatePreset) Set(f func(ctx context.Context, preset mm_auth.RolePreset) (err error)) *RepositoryMock {
+	if mmCreatePreset.defaultExpectation != nil {
+		mmCreatePreset.mock.t.Fatalf("Default expectation is already set for the Repository.CreatePreset method")
+	}
+
+	if len(mmCreatePreset.expectations) > 0 {
+		mmCreatePreset.mock.t.Fatalf("Some expectations are already set for the Repository.CreatePreset method")
+	}
+
+	mmCreatePreset.mock.funcCreatePreset = f
+	mmCreatePreset.mock.funcCreatePresetOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
+}
+
+// When sets expectation for the Repository.CreatePreset which will trigger the result defined by the following
+// Then helper
+func (mmCreatePreset *mRepositoryMockCreatePreset) When(ctx context.Context, preset mm_auth.RolePreset) *RepositoryMockCreatePresetExpectation {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("RepositoryMock.CreatePreset mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreatePresetExpectation{
+		mock:               mmCreatePreset.mock,
+		params:             &RepositoryMockCreatePresetParams{ctx, preset},
+		expectationOrigins: RepositoryMockCreatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePreset.expectations = append(mmCreatePreset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreatePreset return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreatePresetExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreatePresetResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreatePreset should be invoked
+func (mmCreatePreset *mRepositoryMockCreatePreset) Times(n uint64) *mRepositoryMockCreatePreset {
+	if n == 0 {
+		mmCreatePreset.mock.t.Fatalf("Times of RepositoryMock.CreatePreset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePreset.expectedInvocations, n)
+	mmCreatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset
+}
+
+func (mmCreatePreset *mRepositoryMockCreatePreset) invocationsDone() bool {
+	if len(mmCreatePreset.expectations) == 0 && mmCreatePreset.defaultExpectation == nil && mmCreatePreset.mock.funcCreatePreset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePreset.mock.afterCreatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePreset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePreset implements mm_auth.Repository
+func (mmCreatePreset *RepositoryMock) CreatePreset(ctx context.Context, preset mm_auth.RolePreset) (err error) {
+	mm_atomic.AddUint64(&mmCreatePreset.beforeCreatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePreset.afterCreatePresetCounter, 1)
+
+	mmCreatePreset.t.Helper()
+
+	if mmCreatePreset.inspectFuncCreatePreset != nil {
+		mmCreatePreset.inspectFuncCreatePreset(ctx, preset)
+	}
+
+	mm_params := RepositoryMockCreatePresetParams{ctx, preset}
+
+	// Record call args
+	mmCreatePreset.CreatePresetMock.mutex.Lock()
+	mmCreatePreset.CreatePresetMock.callArgs = append(mmCreatePreset.CreatePresetMock.callArgs, &mm_params)
+	mmCreatePreset.CreatePresetMock.mutex.Unlock()
+
+	for _, e := range mmCreatePreset.CreatePresetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreatePreset.CreatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePreset.CreatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePreset.CreatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePreset.CreatePresetMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreatePresetParams{ctx, preset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePreset.t.Errorf("RepositoryMock.CreatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.preset != nil && !minimock.Equal(*mm_want_ptrs.preset, mm_got.preset) {
+				mmCreatePreset.t.Errorf("RepositoryMock.CreatePreset got unexpected parameter preset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originPreset, *mm_want_ptrs.preset, mm_got.preset, minimock.Diff(*mm_want_ptrs.preset, mm_got.preset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePreset.t.Errorf("RepositoryMock.CreatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePreset.CreatePresetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePreset.t.Fatal("No results are set for the RepositoryMock.CreatePreset")
+		}
+		return (*mm_results).err
+	}
+	if mmCreatePreset.funcCreatePreset != nil {
+		return mmCreatePreset.funcCreatePreset(ctx, preset)
+	}
+	mmCreatePreset.t.Fatalf("Unexpected call to RepositoryMock.CreatePreset. %v %v", ctx, preset)
+	return
+}
+
+// CreatePresetAfterCounter returns a count of finished RepositoryMock.CreatePreset invocations
+func (mmCreatePreset *RepositoryMock) CreatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.afterCreatePresetCounter)
+}
+
+// CreatePresetBeforeCounter returns a count of RepositoryMock.CreatePreset invocations
+func (mmCreatePreset *RepositoryMock) CreatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.beforeCreatePresetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreatePreset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePreset *mRepositoryMockCreatePreset) Calls() []*RepositoryMockCreatePresetParams {
+	mmCreatePreset.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreatePresetParams, len(mmCreatePreset.callArgs))
+	copy(argCopy, mmCreatePreset.callArgs)
+
+	mmCreatePreset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePresetDone returns true if the count of the CreatePreset invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreatePresetDone() bool {
+	if m.CreatePresetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePresetMock.invocationsDone()
+}
+
+// MinimockCreatePresetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreatePresetInspect() {
+	for _, e := range m.CreatePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePresetCounter := mm_atomic.LoadUint64(&m.afterCreatePresetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePresetMock.defaultExpectation != nil && afterCreatePresetCounter < 1 {
+		if m.CreatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePreset at\n%s", m.CreatePresetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePreset at\n%s with params: %#v", m.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *m.CreatePresetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePreset != nil && afterCreatePresetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreatePreset at\n%s", m.funcCreatePresetOrigin)
+	}
+
+	if !m.CreatePresetMock.invocationsDone() && afterCreatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePresetMock.expectedInvocations), m.CreatePresetMock.expectedInvocationsOrigin, afterCreatePresetCounter)
+	}
+}
+
+type mRepositoryMockCreateSession struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateSessionExpectation
+	expectations       []*RepositoryMockCreateSessionExpectation
+
+	callArgs []*RepositoryMockCreateSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateSessionExpectation specifies expectation struct of the Repository.CreateSession
+type RepositoryMockCreateSessionExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateSessionParams
+	paramPtrs          *RepositoryMockCreateSessionParamPtrs
+	expectationOrigins RepositoryMockCreateSessionExpectationOrigins
+	results            *RepositoryMockCreateSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateSessionParams contains parameters of the Repository.CreateSession
+type RepositoryMockCreateSessionParams struct {
+	ctx    context.Context
+	req    mm_auth.Session
+	rtHash string
+}
+
+// RepositoryMockCreateSessionParamPtrs contains pointers to parameters of the Repository.CreateSession
+type RepositoryMockCreateSessionParamPtrs struct {
+	ctx    *context.Context
+	req    *mm_auth.Session
+	rtHash *string
+}
+
+// RepositoryMockCreateSessionResults contains results of the Repository.CreateSession
+type RepositoryMockCreateSessionResults struct {
+	err error
+}
+
+// RepositoryMockCreateSessionOrigins contains origins of expectations of the Repository.CreateSession
+type RepositoryMockCreateSessionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReq    string
+	originRtHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSession *mRepositoryMockCreateSession) Optional() *mRepositoryMockCreateSession {
+	mmCreateSession.optional = true
+	return mmCreateSession
+}
+
+// Expect sets up expected params for Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) Expect(ctx context.Context, req mm_auth.Session, rtHash string) *mRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSession.defaultExpectation.params = &RepositoryMockCreateSessionParams{ctx, req, rtHash}
+	mmCreateSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSession.expectations {
+		if minimock.Equal(e.params, mmCreateSession.defaultExpectation.params) {
+			mmCreateSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSession.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// ExpectReqParam2 sets up expected param req for Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) ExpectReqParam2(req mm_auth.Session) *mRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.req = &req
+	mmCreateSession.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// ExpectRtHashParam3 sets up expected param rtHash for Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) ExpectRtHashParam3(rtHash string) *mRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Expect")
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &RepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.rtHash = &rtHash
+	mmCreateSession.defaultExpectation.expectationOrigins.originRtHash = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) Inspect(f func(ctx context.Context, req mm_auth.Session, rtHash string)) *mRepositoryMockCreateSession {
+	if mmCreateSession.mock.inspectFuncCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreateSession")
+	}
+
+	mmCreateSession.mock.inspectFuncCreateSession = f
+
+	return mmCreateSession
+}
+
+// Return sets up results that will be returned by Repository.CreateSession
+func (mmCreateSession *mRepositoryMockCreateSession) Return(err error) *RepositoryMock {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &RepositoryMockCreateSessionExpectation{mock: mmCreateSession.mock}
+	}
+	mmCreateSession.defaultExpectation.results = &RepositoryMockCreateSessionResults{err}
+	mmCreateSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// Set uses given function f to mock the Repository.CreateSession method
+func (mmCreateSession *mRepositoryMockCreateSession) Set(f func(ctx context.Context, req mm_auth.Session, rtHash string) (err error)) *RepositoryMock {
+	if mmCreateSession.defaultExpectation != nil {
+		mmCreateSession.mock.t.Fatalf("Default expectation is already set for the Repository.CreateSession method")
+	}
+
+	if len(mmCreateSession.expectations) > 0 {
+		mmCreateSession.mock.t.Fatalf("Some expectations are already set for the Repository.CreateSession method")
+	}
+
+	mmCreateSession.mock.funcCreateSession = f
+	mmCreateSession.mock.funcCreateSessionOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// When sets expectation for the Repository.CreateSession which will trigger the result defined by the following
+// Then helper
+func (mmCreateSession *mRepositoryMockCreateSession) When(ctx context.Context, req mm_auth.Session, rtHash string) *RepositoryMockCreateSessionExpectation {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("RepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateSessionExpectation{
+		mock:               mmCreateSession.mock,
+		params:             &RepositoryMockCreateSessionParams{ctx, req, rtHash},
+		expectationOrigins: RepositoryMockCreateSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSession.expectations = append(mmCreateSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CreateSession return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateSessionExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CreateSession should be invoked
+func (mmCreateSession *mRepositoryMockCreateSession) Times(n uint64) *mRepositoryMockCreateSession {
+	if n == 0 {
+		mmCreateSession.mock.t.Fatalf("Times of RepositoryMock.CreateSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSession.expectedInvocations, n)
+	mmCreateSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSession
+}
+
+func (mmCreateSession *mRepositoryMockCreateSession) invocationsDone() bool {
+	if len(mmCreateSession.expectations) == 0 && mmCreateSession.defaultExpectation == nil && mmCreateSession.mock.funcCreateSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSession.mock.afterCreateSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSession implements mm_auth.Repository
+func (mmCreateSession *RepositoryMock) CreateSession(ctx context.Context, req mm_auth.Session, rtHash string) (err error) {
+	mm_atomic.AddUint64(&mmCreateSession.beforeCreateSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSession.afterCreateSessionCounter, 1)
+
+	mmCreateSession.t.Helper()
+
+	if mmCreateSession.inspectFuncCreateSession != nil {
+		mmCreateSession.inspectFuncCreateSession(ctx, req, rtHash)
+	}
+
+	mm_params := RepositoryMockCreateSessionParams{ctx, req, rtHash}
+
+	// Record call args
+	mmCreateSession.CreateSessionMock.mutex.Lock()
+	mmCreateSession.CreateSessionMock.callArgs = append(mmCreateSession.CreateSessionMock.callArgs, &mm_params)
+	mmCreateSession.CreateSessionMock.mutex.Unlock()
+
+	for _, e := range mmCreateSession.CreateSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateSession.CreateSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSession.CreateSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSession.CreateSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSession.CreateSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateSessionParams{ctx, req, rtHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+			if mm_want_ptrs.rtHash != nil && !minimock.Equal(*mm_want_ptrs.rtHash, mm_got.rtHash) {
+				mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameter rtHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originRtHash, *mm_want_ptrs.rtHash, mm_got.rtHash, minimock.Diff(*mm_want_ptrs.rtHash, mm_got.rtHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSession.t.Errorf("RepositoryMock.CreateSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSession.CreateSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSession.t.Fatal("No results are set for the RepositoryMock.CreateSession")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateSession.funcCreateSession != nil {
+		return mmCreateSession.funcCreateSession(ctx, req, rtHash)
+	}
+	mmCreateSession.t.Fatalf("Unexpected call to RepositoryMock.CreateSession. %v %v %v", ctx, req, rtHash)
+	return
+}
+
+// CreateSessionAfterCounter returns a count of finished RepositoryMock.CreateSession invocations
+func (mmCreateSession *RepositoryMock) CreateSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.afterCreateSessionCounter)
+}
+
+// CreateSessionBeforeCounter returns a count of RepositoryMock.CreateSession invocations
+func (mmCreateSession *RepositoryMock) CreateSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.beforeCreateSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreateSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSession *mRepositoryMockCreateSession) Calls() []*RepositoryMockCreateSessionParams {
+	mmCreateSession.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateSessionParams, len(mmCreateSession.callArgs))
+	copy(argCopy, mmCreateSession.callArgs)
+
+	mmCreateSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSessionDone returns true if the count of the CreateSession invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateSessionDone() bool {
+	if m.CreateSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSessionMock.invocationsDone()
+}
+
+// MinimockCreateSessionInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateSessionInspect() {
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSessionCounter := mm_atomic.LoadUint64(&m.afterCreateSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSessionMock.defaultExpectation != nil && afterCreateSessionCounter < 1 {
+		if m.CreateSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s", m.CreateSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s with params: %#v", m.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *m.CreateSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSession != nil && afterCreateSessionCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreateSession at\n%s", m.funcCreateSessionOrigin)
+	}
+
+	if !m.CreateSessionMock.invocationsDone() && afterCreateSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreateSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSessionMock.expectedInvocations), m.CreateSessionMock.expectedInvocationsOrigin, afterCreateSessionCounter)
+	}
+}
+
+type mRepositoryMockDeleteOrphanedGrants struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteOrphanedGrantsExpectation
+	expectations       []*RepositoryMockDeleteOrphanedGrantsExpectation
+
+	callArgs []*RepositoryMockDeleteOrphanedGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteOrphanedGrantsExpectation specifies expectation struct of the Repository.DeleteOrphanedGrants
+type RepositoryMockDeleteOrphanedGrantsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteOrphanedGrantsParams
+	paramPtrs          *RepositoryMockDeleteOrphanedGrantsParamPtrs
+	expectationOrigins RepositoryMockDeleteOrphanedGrantsExpectationOrigins
+	results            *RepositoryMockDeleteOrphanedGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteOrphanedGrantsParams contains parameters of the Repository.DeleteOrphanedGrants
+type RepositoryMockDeleteOrphanedGrantsParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockDeleteOrphanedGrantsParamPtrs contains pointers to parameters of the Repository.DeleteOrphanedGrants
+type RepositoryMockDeleteOrphanedGrantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockDeleteOrphanedGrantsResults contains results of the Repository.DeleteOrphanedGrants
+type RepositoryMockDeleteOrphanedGrantsResults struct {
+	oa1 []mm_auth.OrphanedGrant
+	err error
+}
+
+// RepositoryMockDeleteOrphanedGrantsOrigins contains origins of expectations of the Repository.DeleteOrphanedGrants
+type RepositoryMockDeleteOrphanedGrantsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Optional() *mRepositoryMockDeleteOrphanedGrants {
+	mmDeleteOrphanedGrants.optional = true
+	return mmDeleteOrphanedGrants
+}
+
+// Expect sets up expected params for Repository.DeleteOrphanedGrants
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Expect(ctx context.Context) *mRepositoryMockDeleteOrphanedGrants {
+	if mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by Set")
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation == nil {
+		mmDeleteOrphanedGrants.defaultExpectation = &RepositoryMockDeleteOrphanedGrantsExpectation{}
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation.paramPtrs != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOrphanedGrants.defaultExpectation.params = &RepositoryMockDeleteOrphanedGrantsParams{ctx}
+	mmDeleteOrphanedGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOrphanedGrants.expectations {
+		if minimock.Equal(e.params, mmDeleteOrphanedGrants.defaultExpectation.params) {
+			mmDeleteOrphanedGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOrphanedGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOrphanedGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteOrphanedGrants
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteOrphanedGrants {
+	if mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by Set")
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation == nil {
+		mmDeleteOrphanedGrants.defaultExpectation = &RepositoryMockDeleteOrphanedGrantsExpectation{}
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation.params != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by Expect")
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrphanedGrants.defaultExpectation.paramPtrs = &RepositoryMockDeleteOrphanedGrantsParamPtrs{}
+	}
+	mmDeleteOrphanedGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOrphanedGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOrphanedGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteOrphanedGrants
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Inspect(f func(ctx context.Context)) *mRepositoryMockDeleteOrphanedGrants {
+	if mmDeleteOrphanedGrants.mock.inspectFuncDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteOrphanedGrants")
+	}
+
+	mmDeleteOrphanedGrants.mock.inspectFuncDeleteOrphanedGrants = f
+
+	return mmDeleteOrphanedGrants
+}
+
+// Return sets up results that will be returned by Repository.DeleteOrphanedGrants
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Return(oa1 []mm_auth.OrphanedGrant, err error) *RepositoryMock {
+	if mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by Set")
+	}
+
+	if mmDeleteOrphanedGrants.defaultExpectation == nil {
+		mmDeleteOrphanedGrants.defaultExpectation = &RepositoryMockDeleteOrphanedGrantsExpectation{mock: mmDeleteOrphanedGrants.mock}
+	}
+	mmDeleteOrphanedGrants.defaultExpectation.results = &RepositoryMockDeleteOrphanedGrantsResults{oa1, err}
+	mmDeleteOrphanedGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrphanedGrants.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteOrphanedGrants method
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Set(f func(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error)) *RepositoryMock {
+	if mmDeleteOrphanedGrants.defaultExpectation != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteOrphanedGrants method")
+	}
+
+	if len(mmDeleteOrphanedGrants.expectations) > 0 {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteOrphanedGrants method")
+	}
+
+	mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants = f
+	mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrantsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrphanedGrants.mock
+}
+
+// When sets expectation for the Repository.DeleteOrphanedGrants which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) When(ctx context.Context) *RepositoryMockDeleteOrphanedGrantsExpectation {
+	if mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("RepositoryMock.DeleteOrphanedGrants mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteOrphanedGrantsExpectation{
+		mock:               mmDeleteOrphanedGrants.mock,
+		params:             &RepositoryMockDeleteOrphanedGrantsParams{ctx},
+		expectationOrigins: RepositoryMockDeleteOrphanedGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOrphanedGrants.expectations = append(mmDeleteOrphanedGrants.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteOrphanedGrants return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteOrphanedGrantsExpectation) Then(oa1 []mm_auth.OrphanedGrant, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteOrphanedGrantsResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteOrphanedGrants should be invoked
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Times(n uint64) *mRepositoryMockDeleteOrphanedGrants {
+	if n == 0 {
+		mmDeleteOrphanedGrants.mock.t.Fatalf("Times of RepositoryMock.DeleteOrphanedGrants mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOrphanedGrants.expectedInvocations, n)
+	mmDeleteOrphanedGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOrphanedGrants
+}
+
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) invocationsDone() bool {
+	if len(mmDeleteOrphanedGrants.expectations) == 0 && mmDeleteOrphanedGrants.defaultExpectation == nil && mmDeleteOrphanedGrants.mock.funcDeleteOrphanedGrants == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOrphanedGrants.mock.afterDeleteOrphanedGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOrphanedGrants.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOrphanedGrants implements mm_auth.Repository
+func (mmDeleteOrphanedGrants *RepositoryMock) DeleteOrphanedGrants(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error) {
+	mm_atomic.AddUint64(&mmDeleteOrphanedGrants.beforeDeleteOrphanedGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOrphanedGrants.afterDeleteOrphanedGrantsCounter, 1)
+
+	mmDeleteOrphanedGrants.t.Helper()
+
+	if mmDeleteOrphanedGrants.inspectFuncDeleteOrphanedGrants != nil {
+		mmDeleteOrphanedGrants.inspectFuncDeleteOrphanedGrants(ctx)
+	}
+
+	mm_params := RepositoryMockDeleteOrphanedGrantsParams{ctx}
+
+	// Record call args
+	mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.mutex.Lock()
+	mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.callArgs = append(mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.callArgs, &mm_params)
+	mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteOrphanedGrantsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOrphanedGrants.t.Errorf("RepositoryMock.DeleteOrphanedGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOrphanedGrants.t.Errorf("RepositoryMock.DeleteOrphanedGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOrphanedGrants.DeleteOrphanedGrantsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOrphanedGrants.t.Fatal("No results are set for the RepositoryMock.DeleteOrphanedGrants")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmDeleteOrphanedGrants.funcDeleteOrphanedGrants != nil {
+		return mmDeleteOrphanedGrants.funcDeleteOrphanedGrants(ctx)
+	}
+	mmDeleteOrphanedGrants.t.Fatalf("Unexpected call to RepositoryMock.DeleteOrphanedGrants. %v", ctx)
+	return
+}
+
+// DeleteOrphanedGrantsAfterCounter returns a count of finished RepositoryMock.DeleteOrphanedGrants invocations
+func (mmDeleteOrphanedGrants *RepositoryMock) DeleteOrphanedGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrphanedGrants.afterDeleteOrphanedGrantsCounter)
+}
+
+// DeleteOrphanedGrantsBeforeCounter returns a count of RepositoryMock.DeleteOrphanedGrants invocations
+func (mmDeleteOrphanedGrants *RepositoryMock) DeleteOrphanedGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOrphanedGrants.beforeDeleteOrphanedGrantsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteOrphanedGrants.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOrphanedGrants *mRepositoryMockDeleteOrphanedGrants) Calls() []*RepositoryMockDeleteOrphanedGrantsParams {
+	mmDeleteOrphanedGrants.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteOrphanedGrantsParams, len(mmDeleteOrphanedGrants.callArgs))
+	copy(argCopy, mmDeleteOrphanedGrants.callArgs)
+
+	mmDeleteOrphanedGrants.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOrphanedGrantsDone returns true if the count of the DeleteOrphanedGrants invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteOrphanedGrantsDone() bool {
+	if m.DeleteOrphanedGrantsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOrphanedGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOrphanedGrantsMock.invocationsDone()
+}
+
+// MinimockDeleteOrphanedGrantsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteOrphanedGrantsInspect() {
+	for _, e := range m.DeleteOrphanedGrantsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOrphanedGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOrphanedGrantsCounter := mm_atomic.LoadUint64(&m.afterDeleteOrphanedGrantsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOrphanedGrantsMock.defaultExpectation != nil && afterDeleteOrphanedGrantsCounter < 1 {
+		if m.DeleteOrphanedGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOrphanedGrants at\n%s", m.DeleteOrphanedGrantsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOrphanedGrants at\n%s with params: %#v", m.DeleteOrphanedGrantsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOrphanedGrantsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOrphanedGrants != nil && afterDeleteOrphanedGrantsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteOrphanedGrants at\n%s", m.funcDeleteOrphanedGrantsOrigin)
+	}
+
+	if !m.DeleteOrphanedGrantsMock.invocationsDone() && afterDeleteOrphanedGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteOrphanedGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOrphanedGrantsMock.expectedInvocations), m.DeleteOrphanedGrantsMock.expectedInvocationsOrigin, afterDeleteOrphanedGrantsCounter)
+	}
+}
+
+type mRepositoryMockDeletePreset struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeletePresetExpectation
+	expectations       []*RepositoryMockDeletePresetExpectation
+
+	callArgs []*RepositoryMockDeletePresetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeletePresetExpectation specifies expectation struct of the Repository.DeletePreset
+type RepositoryMockDeletePresetExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeletePresetParams
+	paramPtrs          *RepositoryMockDeletePresetParamPtrs
+	expectationOrigins RepositoryMockDeletePresetExpectationOrigins
+	results            *RepositoryMockDeletePresetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeletePresetParams contains parameters of the Repository.DeletePreset
+type RepositoryMockDeletePresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RepositoryMockDeletePresetParamPtrs contains pointers to parameters of the Repository.DeletePreset
+type RepositoryMockDeletePresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RepositoryMockDeletePresetResults contains results of the Repository.DeletePreset
+type RepositoryMockDeletePresetResults struct {
+	err error
+}
+
+// RepositoryMockDeletePresetOrigins contains origins of expectations of the Repository.DeletePreset
+type RepositoryMockDeletePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePreset *mRepositoryMockDeletePreset) Optional() *mRepositoryMockDeletePreset {
+	mmDeletePreset.optional = true
+	return mmDeletePreset
+}
+
+// Expect sets up expected params for Repository.DeletePreset
+func (mmDeletePreset *mRepositoryMockDeletePreset) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Set")
+	}
+
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &RepositoryMockDeletePresetExpectation{}
+	}
+
+	if mmDeletePreset.defaultExpectation.paramPtrs != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePreset.defaultExpectation.params = &RepositoryMockDeletePresetParams{ctx, id}
+	mmDeletePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePreset.expectations {
+		if minimock.Equal(e.params, mmDeletePreset.defaultExpectation.params) {
+			mmDeletePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePreset.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePreset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeletePreset
+func (mmDeletePreset *mRepositoryMockDeletePreset) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Set")
+	}
+
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &RepositoryMockDeletePresetExpectation{}
+	}
+
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Expect")
+	}
+
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &RepositoryMockDeletePresetParamPtrs{}
+	}
+	mmDeletePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePreset
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.DeletePreset
+func (mmDeletePreset *mRepositoryMockDeletePreset) ExpectIdParam2(id uuid.UUID) *mRepositoryMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Set")
+	}
+
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &RepositoryMockDeletePresetExpectation{}
+	}
+
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Expect")
+	}
+
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &RepositoryMockDeletePresetParamPtrs{}
+	}
+	mmDeletePreset.defaultExpectation.paramPtrs.id = &id
+	mmDeletePreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeletePreset
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeletePreset
+func (mmDeletePreset *mRepositoryMockDeletePreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockDeletePreset {
+	if mmDeletePreset.mock.inspectFuncDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeletePreset")
+	}
+
+	mmDeletePreset.mock.inspectFuncDeletePreset = f
+
+	return mmDeletePreset
+}
+
+// Return sets up results that will be returned by Repository.DeletePreset
+func (mmDeletePreset *mRepositoryMockDeletePreset) Return(err error) *RepositoryMock {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Set")
+	}
+
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &RepositoryMockDeletePresetExpectation{mock: mmDeletePreset.mock}
+	}
+	mmDeletePreset.defaultExpectation.results = &RepositoryMockDeletePresetResults{err}
+	mmDeletePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
+}
+
+// Set uses given function f to mock the Repository.DeletePreset method
+func (mmDeletePreset *mRepositoryMockDeletePreset) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *RepositoryMock {
+	if mmDeletePreset.defaultExpectation != nil {
+		mmDeletePreset.mock.t.Fatalf("Default expectation is already set for the Repository.DeletePreset method")
+	}
+
+	if len(mmDeletePreset.expectations) > 0 {
+		mmDeletePreset.mock.t.Fatalf("Some expectations are already set for the Repository.DeletePreset method")
+	}
+
+	mmDeletePreset.mock.funcDeletePreset = f
+	mmDeletePreset.mock.funcDeletePresetOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
+}
+
+// When sets expectation for the Repository.DeletePreset which will trigger the result defined by the following
+// Then helper
+func (mmDeletePreset *mRepositoryMockDeletePreset) When(ctx context.Context, id uuid.UUID) *RepositoryMockDeletePresetExpectation {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("RepositoryMock.DeletePreset mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeletePresetExpectation{
+		mock:               mmDeletePreset.mock,
+		params:             &RepositoryMockDeletePresetParams{ctx, id},
+		expectationOrigins: RepositoryMockDeletePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePreset.expectations = append(mmDeletePreset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeletePreset return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeletePresetExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeletePresetResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeletePreset should be invoked
+func (mmDeletePreset *mRepositoryMockDeletePreset) Times(n uint64) *mRepositoryMockDeletePreset {
+	if n == 0 {
+		mmDeletePreset.mock.t.Fatalf("Times of RepositoryMock.DeletePreset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePreset.expectedInvocations, n)
+	mmDeletePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset
+}
+
+func (mmDeletePreset *mRepositoryMockDeletePreset) invocationsDone() bool {
+	if len(mmDeletePreset.expectations) == 0 && mmDeletePreset.defaultExpectation == nil && mmDeletePreset.mock.funcDeletePreset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePreset.mock.afterDeletePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePreset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePreset implements mm_auth.Repository
+func (mmDeletePreset *RepositoryMock) DeletePreset(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeletePreset.beforeDeletePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePreset.afterDeletePresetCounter, 1)
+
+	mmDeletePreset.t.Helper()
+
+	if mmDeletePreset.inspectFuncDeletePreset != nil {
+		mmDeletePreset.inspectFuncDeletePreset(ctx, id)
+	}
+
+	mm_params := RepositoryMockDeletePresetParams{ctx, id}
+
+	// Record call args
+	mmDeletePreset.DeletePresetMock.mutex.Lock()
+	mmDeletePreset.DeletePresetMock.callArgs = append(mmDeletePreset.DeletePresetMock.callArgs, &mm_params)
+	mmDeletePreset.DeletePresetMock.mutex.Unlock()
+
+	for _, e := range mmDeletePreset.DeletePresetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePreset.DeletePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePreset.DeletePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePreset.DeletePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePreset.DeletePresetMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeletePresetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePreset.t.Errorf("RepositoryMock.DeletePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeletePreset.t.Errorf("RepositoryMock.DeletePreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePreset.t.Errorf("RepositoryMock.DeletePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePreset.DeletePresetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePreset.t.Fatal("No results are set for the RepositoryMock.DeletePreset")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePreset.funcDeletePreset != nil {
+		return mmDeletePreset.funcDeletePreset(ctx, id)
+	}
+	mmDeletePreset.t.Fatalf("Unexpected call to RepositoryMock.DeletePreset. %v %v", ctx, id)
+	return
+}
+
+// DeletePresetAfterCounter returns a count of finished RepositoryMock.DeletePreset invocations
+func (mmDeletePreset *RepositoryMock) DeletePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.afterDeletePresetCounter)
+}
+
+// DeletePresetBeforeCounter returns a count of RepositoryMock.DeletePreset invocations
+func (mmDeletePreset *RepositoryMock) DeletePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.beforeDeletePresetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeletePreset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePreset *mRepositoryMockDeletePreset) Calls() []*RepositoryMockDeletePresetParams {
+	mmDeletePreset.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeletePresetParams, len(mmDeletePreset.callArgs))
+	copy(argCopy, mmDeletePreset.callArgs)
+
+	mmDeletePreset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePresetDone returns true if the count of the DeletePreset invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeletePresetDone() bool {
+	if m.DeletePresetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePresetMock.invocationsDone()
+}
+
+// MinimockDeletePresetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeletePresetInspect() {
+	for _, e := range m.DeletePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeletePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePresetCounter := mm_atomic.LoadUint64(&m.afterDeletePresetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePresetMock.defaultExpectation != nil && afterDeletePresetCounter < 1 {
+		if m.DeletePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeletePreset at\n%s", m.DeletePresetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeletePreset at\n%s with params: %#v", m.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *m.DeletePresetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePreset != nil && afterDeletePresetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeletePreset at\n%s", m.funcDeletePresetOrigin)
+	}
+
+	if !m.DeletePresetMock.invocationsDone() && afterDeletePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeletePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePresetMock.expectedInvocations), m.DeletePresetMock.expectedInvocationsOrigin, afterDeletePresetCounter)
+	}
+}
+
+type mRepositoryMockDeleteSessionByIDAndUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteSessionByIDAndUserExpectation
+	expectations       []*RepositoryMockDeleteSessionByIDAndUserExpectation
+
+	callArgs []*RepositoryMockDeleteSessionByIDAndUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteSessionByIDAndUserExpectation specifies expectation struct of the Repository.DeleteSessionByIDAndUser
+type RepositoryMockDeleteSessionByIDAndUserExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteSessionByIDAndUserParams
+	paramPtrs          *RepositoryMockDeleteSessionByIDAndUserParamPtrs
+	expectationOrigins RepositoryMockDeleteSessionByIDAndUserExpectationOrigins
+	results            *RepositoryMockDeleteSessionByIDAndUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteSessionByIDAndUserParams contains parameters of the Repository.DeleteSessionByIDAndUser
+type RepositoryMockDeleteSessionByIDAndUserParams struct {
+	ctx    context.Context
+	id     uuid.UUID
+	userID uuid.UUID
+}
+
+// RepositoryMockDeleteSessionByIDAndUserParamPtrs contains pointers to parameters of the Repository.DeleteSessionByIDAndUser
+type RepositoryMockDeleteSessionByIDAndUserParamPtrs struct {
+	ctx    *context.Context
+	id     *uuid.UUID
+	userID *uuid.UUID
+}
+
+// RepositoryMockDeleteSessionByIDAndUserResults contains results of the Repository.DeleteSessionByIDAndUser
+type RepositoryMockDeleteSessionByIDAndUserResults struct {
+	err error
+}
+
+// RepositoryMockDeleteSessionByIDAndUserOrigins contains origins of expectations of the Repository.DeleteSessionByIDAndUser
+type RepositoryMockDeleteSessionByIDAndUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Optional() *mRepositoryMockDeleteSessionByIDAndUser {
+	mmDeleteSessionByIDAndUser.optional = true
+	return mmDeleteSessionByIDAndUser
+}
+
+// Expect sets up expected params for Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSessionByIDAndUser.defaultExpectation.params = &RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
+	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessionByIDAndUser.expectations {
+		if minimock.Equal(e.params, mmDeleteSessionByIDAndUser.defaultExpectation.params) {
+			mmDeleteSessionByIDAndUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionByIDAndUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSessionByIDAndUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSessionByIDAndUser {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
+	}
+	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSessionByIDAndUser
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectIdParam2(id uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
+	}
+	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteSessionByIDAndUser
+}
+
+// ExpectUserIDParam3 sets up expected param userID for Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) ExpectUserIDParam3(userID uuid.UUID) *mRepositoryMockDeleteSessionByIDAndUser {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{}
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.params != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Expect")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionByIDAndUserParamPtrs{}
+	}
+	mmDeleteSessionByIDAndUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSessionByIDAndUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSessionByIDAndUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mRepositoryMockDeleteSessionByIDAndUser {
+	if mmDeleteSessionByIDAndUser.mock.inspectFuncDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSessionByIDAndUser")
+	}
+
+	mmDeleteSessionByIDAndUser.mock.inspectFuncDeleteSessionByIDAndUser = f
+
+	return mmDeleteSessionByIDAndUser
+}
+
+// Return sets up results that will be returned by Repository.DeleteSessionByIDAndUser
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Return(err error) *RepositoryMock {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	if mmDeleteSessionByIDAndUser.defaultExpectation == nil {
+		mmDeleteSessionByIDAndUser.defaultExpectation = &RepositoryMockDeleteSessionByIDAndUserExpectation{mock: mmDeleteSessionByIDAndUser.mock}
+	}
+	mmDeleteSessionByIDAndUser.defaultExpectation.results = &RepositoryMockDeleteSessionByIDAndUserResults{err}
+	mmDeleteSessionByIDAndUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionByIDAndUser.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteSessionByIDAndUser method
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *RepositoryMock {
+	if mmDeleteSessionByIDAndUser.defaultExpectation != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSessionByIDAndUser method")
+	}
+
+	if len(mmDeleteSessionByIDAndUser.expectations) > 0 {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSessionByIDAndUser method")
+	}
+
+	mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser = f
+	mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionByIDAndUser.mock
+}
+
+// When sets expectation for the Repository.DeleteSessionByIDAndUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *RepositoryMockDeleteSessionByIDAndUserExpectation {
+	if mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("RepositoryMock.DeleteSessionByIDAndUser mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteSessionByIDAndUserExpectation{
+		mock:               mmDeleteSessionByIDAndUser.mock,
+		params:             &RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID},
+		expectationOrigins: RepositoryMockDeleteSessionByIDAndUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessionByIDAndUser.expectations = append(mmDeleteSessionByIDAndUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteSessionByIDAndUser return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteSessionByIDAndUserExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteSessionByIDAndUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteSessionByIDAndUser should be invoked
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Times(n uint64) *mRepositoryMockDeleteSessionByIDAndUser {
+	if n == 0 {
+		mmDeleteSessionByIDAndUser.mock.t.Fatalf("Times of RepositoryMock.DeleteSessionByIDAndUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSessionByIDAndUser.expectedInvocations, n)
+	mmDeleteSessionByIDAndUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionByIDAndUser
+}
+
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) invocationsDone() bool {
+	if len(mmDeleteSessionByIDAndUser.expectations) == 0 && mmDeleteSessionByIDAndUser.defaultExpectation == nil && mmDeleteSessionByIDAndUser.mock.funcDeleteSessionByIDAndUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.mock.afterDeleteSessionByIDAndUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSessionByIDAndUser implements mm_auth.Repository
+func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.beforeDeleteSessionByIDAndUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.afterDeleteSessionByIDAndUserCounter, 1)
+
+	mmDeleteSessionByIDAndUser.t.Helper()
+
+	if mmDeleteSessionByIDAndUser.inspectFuncDeleteSessionByIDAndUser != nil {
+		mmDeleteSessionByIDAndUser.inspectFuncDeleteSessionByIDAndUser(ctx, id, userID)
+	}
+
+	mm_params := RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
+
+	// Record call args
+	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.mutex.Lock()
+	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.callArgs = append(mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.callArgs, &mm_params)
+	mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteSessionByIDAndUserParams{ctx, id, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSessionByIDAndUser.t.Errorf("RepositoryMock.DeleteSessionByIDAndUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSessionByIDAndUser.DeleteSessionByIDAndUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSessionByIDAndUser.t.Fatal("No results are set for the RepositoryMock.DeleteSessionByIDAndUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSessionByIDAndUser.funcDeleteSessionByIDAndUser != nil {
+		return mmDeleteSessionByIDAndUser.funcDeleteSessionByIDAndUser(ctx, id, userID)
+	}
+	mmDeleteSessionByIDAndUser.t.Fatalf("Unexpected call to RepositoryMock.DeleteSessionByIDAndUser. %v %v %v", ctx, id, userID)
+	return
+}
+
+// DeleteSessionByIDAndUserAfterCounter returns a count of finished RepositoryMock.DeleteSessionByIDAndUser invocations
+func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.afterDeleteSessionByIDAndUserCounter)
+}
+
+// DeleteSessionByIDAndUserBeforeCounter returns a count of RepositoryMock.DeleteSessionByIDAndUser invocations
+func (mmDeleteSessionByIDAndUser *RepositoryMock) DeleteSessionByIDAndUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionByIDAndUser.beforeDeleteSessionByIDAndUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSessionByIDAndUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSessionByIDAndUser *mRepositoryMockDeleteSessionByIDAndUser) Calls() []*RepositoryMockDeleteSessionByIDAndUserParams {
+	mmDeleteSessionByIDAndUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteSessionByIDAndUserParams, len(mmDeleteSessionByIDAndUser.callArgs))
+	copy(argCopy, mmDeleteSessionByIDAndUser.callArgs)
+
+	mmDeleteSessionByIDAndUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionByIDAndUserDone returns true if the count of the DeleteSessionByIDAndUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteSessionByIDAndUserDone() bool {
+	if m.DeleteSessionByIDAndUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionByIDAndUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionByIDAndUserMock.invocationsDone()
+}
+
+// MinimockDeleteSessionByIDAndUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteSessionByIDAndUserInspect() {
+	for _, e := range m.DeleteSessionByIDAndUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionByIDAndUserCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionByIDAndUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionByIDAndUserMock.defaultExpectation != nil && afterDeleteSessionByIDAndUserCounter < 1 {
+		if m.DeleteSessionByIDAndUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s", m.DeleteSessionByIDAndUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s with params: %#v", m.DeleteSessionByIDAndUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionByIDAndUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSessionByIDAndUser != nil && afterDeleteSessionByIDAndUserCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteSessionByIDAndUser at\n%s", m.funcDeleteSessionByIDAndUserOrigin)
+	}
+
+	if !m.DeleteSessionByIDAndUserMock.invocationsDone() && afterDeleteSessionByIDAndUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSessionByIDAndUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionByIDAndUserMock.expectedInvocations), m.DeleteSessionByIDAndUserMock.expectedInvocationsOrigin, afterDeleteSessionByIDAndUserCounter)
+	}
+}
+
+type mRepositoryMockDeleteSessionsByUserID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteSessionsByUserIDExpectation
+	expectations       []*RepositoryMockDeleteSessionsByUserIDExpectation
+
+	callArgs []*RepositoryMockDeleteSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteSessionsByUserIDExpectation specifies expectation struct of the Repository.DeleteSessionsByUserID
+type RepositoryMockDeleteSessionsByUserIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteSessionsByUserIDParams
+	paramPtrs          *RepositoryMockDeleteSessionsByUserIDParamPtrs
+	expectationOrigins RepositoryMockDeleteSessionsByUserIDExpectationOrigins
+	results            *RepositoryMockDeleteSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteSessionsByUserIDParams contains parameters of the Repository.DeleteSessionsByUserID
+type RepositoryMockDeleteSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// RepositoryMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the Repository.DeleteSessionsByUserID
+type RepositoryMockDeleteSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// RepositoryMockDeleteSessionsByUserIDResults contains results of the Repository.DeleteSessionsByUserID
+type RepositoryMockDeleteSessionsByUserIDResults struct {
+	err error
+}
+
+// RepositoryMockDeleteSessionsByUserIDOrigins contains origins of expectations of the Repository.DeleteSessionsByUserID
+type RepositoryMockDeleteSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Optional() *mRepositoryMockDeleteSessionsByUserID {
+	mmDeleteSessionsByUserID.optional = true
+	return mmDeleteSessionsByUserID
+}
+
+// Expect sets up expected params for Repository.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSessionsByUserID.defaultExpectation.params = &RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
+			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &RepositoryMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSessionsByUserID")
+	}
+
+	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+
+	return mmDeleteSessionsByUserID
+}
+
+// Return sets up results that will be returned by Repository.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Return(err error) *RepositoryMock {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.results = &RepositoryMockDeleteSessionsByUserIDResults{err}
+	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteSessionsByUserID method
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *RepositoryMock {
+	if mmDeleteSessionsByUserID.defaultExpectation != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSessionsByUserID method")
+	}
+
+	if len(mmDeleteSessionsByUserID.expectations) > 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSessionsByUserID method")
+	}
+
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// When sets expectation for the Repository.DeleteSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *RepositoryMockDeleteSessionsByUserIDExpectation {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteSessionsByUserIDExpectation{
+		mock:               mmDeleteSessionsByUserID.mock,
+		params:             &RepositoryMockDeleteSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: RepositoryMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteSessionsByUserIDExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteSessionsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteSessionsByUserID should be invoked
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Times(n uint64) *mRepositoryMockDeleteSessionsByUserID {
+	if n == 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of RepositoryMock.DeleteSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
+	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID
+}
+
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) invocationsDone() bool {
+	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSessionsByUserID implements mm_auth.Repository
+func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+
+	mmDeleteSessionsByUserID.t.Helper()
+
+	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSessionsByUserID.t.Fatal("No results are set for the RepositoryMock.DeleteSessionsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
+		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	}
+	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to RepositoryMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteSessionsByUserIDAfterCounter returns a count of finished RepositoryMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+}
+
+// DeleteSessionsByUserIDBeforeCounter returns a count of RepositoryMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Calls() []*RepositoryMockDeleteSessionsByUserIDParams {
+	mmDeleteSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
+	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+
+	mmDeleteSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteSessionsByUserIDDone() bool {
+	if m.DeleteSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteSessionsByUserIDInspect() {
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	}
+
+	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	}
+}
+
+type mRepositoryMockDeleteUserRole struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteUserRoleExpectation
+	expectations       []*RepositoryMockDeleteUserRoleExpectation
+
+	callArgs []*RepositoryMockDeleteUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteUserRoleExpectation specifies expectation struct of the Repository.DeleteUserRole
+type RepositoryMockDeleteUserRoleExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteUserRoleParams
+	paramPtrs          *RepositoryMockDeleteUserRoleParamPtrs
+	expectationOrigins RepositoryMockDeleteUserRoleExpectationOrigins
+	results            *RepositoryMockDeleteUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteUserRoleParams contains parameters of the Repository.DeleteUserRole
+type RepositoryMockDeleteUserRoleParams struct {
+	ctx  context.Context
+	role mm_auth.UserRole
+}
+
+// RepositoryMockDeleteUserRoleParamPtrs contains pointers to parameters of the Repository.DeleteUserRole
+type RepositoryMockDeleteUserRoleParamPtrs struct {
+	ctx  *context.Context
+	role *mm_auth.UserRole
+}
+
+// RepositoryMockDeleteUserRoleResults contains results of the Repository.DeleteUserRole
+type RepositoryMockDeleteUserRoleResults struct {
+	err error
+}
+
+// RepositoryMockDeleteUserRoleOrigins contains origins of expectations of the Repository.DeleteUserRole
+type RepositoryMockDeleteUserRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Optional() *mRepositoryMockDeleteUserRole {
+	mmDeleteUserRole.optional = true
+	return mmDeleteUserRole
+}
+
+// Expect sets up expected params for Repository.DeleteUserRole
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Expect(ctx context.Context, role mm_auth.UserRole) *mRepositoryMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRole.defaultExpectation.params = &RepositoryMockDeleteUserRoleParams{ctx, role}
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRole.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
+			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteUserRole
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// ExpectRoleParam2 sets up expected param role for Repository.DeleteUserRole
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) ExpectRoleParam2(role mm_auth.UserRole) *mRepositoryMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteUserRole
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Inspect(f func(ctx context.Context, role mm_auth.UserRole)) *mRepositoryMockDeleteUserRole {
+	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteUserRole")
+	}
+
+	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+
+	return mmDeleteUserRole
+}
+
+// Return sets up results that will be returned by Repository.DeleteUserRole
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Return(err error) *RepositoryMock {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	}
+	mmDeleteUserRole.defaultExpectation.results = &RepositoryMockDeleteUserRoleResults{err}
+	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteUserRole method
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Set(f func(ctx context.Context, role mm_auth.UserRole) (err error)) *RepositoryMock {
+	if mmDeleteUserRole.defaultExpectation != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteUserRole method")
+	}
+
+	if len(mmDeleteUserRole.expectations) > 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteUserRole method")
+	}
+
+	mmDeleteUserRole.mock.funcDeleteUserRole = f
+	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// When sets expectation for the Repository.DeleteUserRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) When(ctx context.Context, role mm_auth.UserRole) *RepositoryMockDeleteUserRoleExpectation {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteUserRoleExpectation{
+		mock:               mmDeleteUserRole.mock,
+		params:             &RepositoryMockDeleteUserRoleParams{ctx, role},
+		expectationOrigins: RepositoryMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteUserRole return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteUserRoleExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteUserRole should be invoked
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Times(n uint64) *mRepositoryMockDeleteUserRole {
+	if n == 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Times of RepositoryMock.DeleteUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
+	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole
+}
+
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) invocationsDone() bool {
+	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRole implements mm_auth.Repository
+func (mmDeleteUserRole *RepositoryMock) DeleteUserRole(ctx context.Context, role mm_auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+
+	mmDeleteUserRole.t.Helper()
+
+	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	}
+
+	mm_params := RepositoryMockDeleteUserRoleParams{ctx, role}
+
+	// Record call args
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
+	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteUserRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRole.t.Fatal("No results are set for the RepositoryMock.DeleteUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserRole.funcDeleteUserRole != nil {
+		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	}
+	mmDeleteUserRole.t.Fatalf("Unexpected call to RepositoryMock.DeleteUserRole. %v %v", ctx, role)
+	return
+}
+
+// DeleteUserRoleAfterCounter returns a count of finished RepositoryMock.DeleteUserRole invocations
+func (mmDeleteUserRole *RepositoryMock) DeleteUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+}
+
+// DeleteUserRoleBeforeCounter returns a count of RepositoryMock.DeleteUserRole invocations
+func (mmDeleteUserRole *RepositoryMock) DeleteUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Calls() []*RepositoryMockDeleteUserRoleParams {
+	mmDeleteUserRole.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
+	copy(argCopy, mmDeleteUserRole.callArgs)
+
+	mmDeleteUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteUserRoleDone() bool {
+	if m.DeleteUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRoleMock.invocationsDone()
+}
+
+// MinimockDeleteUserRoleInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteUserRoleInspect() {
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
+		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	}
+
+	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	}
+}
+
+type mRepositoryMockDeleteUserRoles struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteUserRolesExpectation
+	expectations       []*RepositoryMockDeleteUserRolesExpectation
+
+	callArgs []*RepositoryMockDeleteUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteUserRolesExpectation specifies expectation struct of the Repository.DeleteUserRoles
+type RepositoryMockDeleteUserRolesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteUserRolesParams
+	paramPtrs          *RepositoryMockDeleteUserRolesParamPtrs
+	expectationOrigins RepositoryMockDeleteUserRolesExpectationOrigins
+	results            *RepositoryMockDeleteUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteUserRolesParams contains parameters of the Repository.DeleteUserRoles
+type RepositoryMockDeleteUserRolesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// RepositoryMockDeleteUserRolesParamPtrs contains pointers to parameters of the Repository.DeleteUserRoles
+type RepositoryMockDeleteUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// RepositoryMockDeleteUserRolesResults contains results of the Repository.DeleteUserRoles
+type RepositoryMockDeleteUserRolesResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryMockDeleteUserRolesOrigins contains origins of expectations of the Repository.DeleteUserRoles
+type RepositoryMockDeleteUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Optional() *mRepositoryMockDeleteUserRoles {
+	mmDeleteUserRoles.optional = true
+	return mmDeleteUserRoles
+}
+
+// Expect sets up expected params for Repository.DeleteUserRoles
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &RepositoryMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRoles.defaultExpectation.params = &RepositoryMockDeleteUserRolesParams{ctx, userID}
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRoles.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRoles.defaultExpectation.params) {
+			mmDeleteUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteUserRoles
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &RepositoryMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.params != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Expect")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRoles.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRolesParamPtrs{}
+	}
+	mmDeleteUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteUserRoles
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &RepositoryMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.params != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Expect")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRoles.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRolesParamPtrs{}
+	}
+	mmDeleteUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteUserRoles
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.inspectFuncDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteUserRoles")
+	}
+
+	mmDeleteUserRoles.mock.inspectFuncDeleteUserRoles = f
+
+	return mmDeleteUserRoles
+}
+
+// Return sets up results that will be returned by Repository.DeleteUserRoles
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Return(i1 int64, err error) *RepositoryMock {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &RepositoryMockDeleteUserRolesExpectation{mock: mmDeleteUserRoles.mock}
+	}
+	mmDeleteUserRoles.defaultExpectation.results = &RepositoryMockDeleteUserRolesResults{i1, err}
+	mmDeleteUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteUserRoles method
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (i1 int64, err error)) *RepositoryMock {
+	if mmDeleteUserRoles.defaultExpectation != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteUserRoles method")
+	}
+
+	if len(mmDeleteUserRoles.expectations) > 0 {
+		mmDeleteUserRoles.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteUserRoles method")
+	}
+
+	mmDeleteUserRoles.mock.funcDeleteUserRoles = f
+	mmDeleteUserRoles.mock.funcDeleteUserRolesOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles.mock
+}
+
+// When sets expectation for the Repository.DeleteUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) When(ctx context.Context, userID uuid.UUID) *RepositoryMockDeleteUserRolesExpectation {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("RepositoryMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteUserRolesExpectation{
+		mock:               mmDeleteUserRoles.mock,
+		params:             &RepositoryMockDeleteUserRolesParams{ctx, userID},
+		expectationOrigins: RepositoryMockDeleteUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRoles.expectations = append(mmDeleteUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteUserRoles return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteUserRolesExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteUserRolesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteUserRoles should be invoked
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Times(n uint64) *mRepositoryMockDeleteUserRoles {
+	if n == 0 {
+		mmDeleteUserRoles.mock.t.Fatalf("Times of RepositoryMock.DeleteUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRoles.expectedInvocations, n)
+	mmDeleteUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles
+}
+
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) invocationsDone() bool {
+	if len(mmDeleteUserRoles.expectations) == 0 && mmDeleteUserRoles.defaultExpectation == nil && mmDeleteUserRoles.mock.funcDeleteUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRoles.mock.afterDeleteUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRoles implements mm_auth.Repository
+func (mmDeleteUserRoles *RepositoryMock) DeleteUserRoles(ctx context.Context, userID uuid.UUID) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRoles.beforeDeleteUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRoles.afterDeleteUserRolesCounter, 1)
+
+	mmDeleteUserRoles.t.Helper()
+
+	if mmDeleteUserRoles.inspectFuncDeleteUserRoles != nil {
+		mmDeleteUserRoles.inspectFuncDeleteUserRoles(ctx, userID)
+	}
+
+	mm_params := RepositoryMockDeleteUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserRoles.DeleteUserRolesMock.mutex.Lock()
+	mmDeleteUserRoles.DeleteUserRolesMock.callArgs = append(mmDeleteUserRoles.DeleteUserRolesMock.callArgs, &mm_params)
+	mmDeleteUserRoles.DeleteUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRoles.DeleteUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRoles.t.Errorf("RepositoryMock.DeleteUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserRoles.t.Errorf("RepositoryMock.DeleteUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRoles.t.Errorf("RepositoryMock.DeleteUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRoles.t.Fatal("No results are set for the RepositoryMock.DeleteUserRoles")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteUserRoles.funcDeleteUserRoles != nil {
+		return mmDeleteUserRoles.funcDeleteUserRoles(ctx, userID)
+	}
+	mmDeleteUserRoles.t.Fatalf("Unexpected call to RepositoryMock.DeleteUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserRolesAfterCounter returns a count of finished RepositoryMock.DeleteUserRoles invocations
+func (mmDeleteUserRoles *RepositoryMock) DeleteUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRoles.afterDeleteUserRolesCounter)
+}
+
+// DeleteUserRolesBeforeCounter returns a count of RepositoryMock.DeleteUserRoles invocations
+func (mmDeleteUserRoles *RepositoryMock) DeleteUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRoles.beforeDeleteUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRoles *mRepositoryMockDeleteUserRoles) Calls() []*RepositoryMockDeleteUserRolesParams {
+	mmDeleteUserRoles.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteUserRolesParams, len(mmDeleteUserRoles.callArgs))
+	copy(argCopy, mmDeleteUserRoles.callArgs)
+
+	mmDeleteUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRolesDone returns true if the count of the DeleteUserRoles invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteUserRolesDone() bool {
+	if m.DeleteUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRolesMock.invocationsDone()
+}
+
+// MinimockDeleteUserRolesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteUserRolesInspect() {
+	for _, e := range m.DeleteUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRolesCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRolesMock.defaultExpectation != nil && afterDeleteUserRolesCounter < 1 {
+		if m.DeleteUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRoles at\n%s", m.DeleteUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRoles at\n%s with params: %#v", m.DeleteUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRoles != nil && afterDeleteUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteUserRoles at\n%s", m.funcDeleteUserRolesOrigin)
+	}
+
+	if !m.DeleteUserRolesMock.invocationsDone() && afterDeleteUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRolesMock.expectedInvocations), m.DeleteUserRolesMock.expectedInvocationsOrigin, afterDeleteUserRolesCounter)
+	}
+}
+
+type mRepositoryMockGetKnownClient struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetKnownClientExpectation
+	expectations       []*RepositoryMockGetKnownClientExpectation
+
+	callArgs []*RepositoryMockGetKnownClientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetKnownClientExpectation specifies expectation struct of the Repository.GetKnownClient
+type RepositoryMockGetKnownClientExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetKnownClientParams
+	paramPtrs          *RepositoryMockGetKnownClientParamPtrs
+	expectationOrigins RepositoryMockGetKnownClientExpectationOrigins
+	results            *RepositoryMockGetKnownClientResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetKnownClientParams contains parameters of the Repository.GetKnownClient
+type RepositoryMockGetKnownClientParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	client mm_auth.Client
+}
+
+// RepositoryMockGetKnownClientParamPtrs contains pointers to parameters of the Repository.GetKnownClient
+type RepositoryMockGetKnownClientParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	client *mm_auth.Client
+}
+
+// RepositoryMockGetKnownClientResults contains results of the Repository.GetKnownClient
+type RepositoryMockGetKnownClientResults struct {
+	k1  mm_auth.KnownClient
+	err error
+}
+
+// RepositoryMockGetKnownClientOrigins contains origins of expectations of the Repository.GetKnownClient
+type RepositoryMockGetKnownClientExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originClient string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Optional() *mRepositoryMockGetKnownClient {
+	mmGetKnownClient.optional = true
+	return mmGetKnownClient
+}
+
+// Expect sets up expected params for Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Expect(ctx context.Context, userID uuid.UUID, client mm_auth.Client) *mRepositoryMockGetKnownClient {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	if mmGetKnownClient.defaultExpectation == nil {
+		mmGetKnownClient.defaultExpectation = &RepositoryMockGetKnownClientExpectation{}
+	}
+
+	if mmGetKnownClient.defaultExpectation.paramPtrs != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by ExpectParams functions")
+	}
+
+	mmGetKnownClient.defaultExpectation.params = &RepositoryMockGetKnownClientParams{ctx, userID, client}
+	mmGetKnownClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetKnownClient.expectations {
+		if minimock.Equal(e.params, mmGetKnownClient.defaultExpectation.params) {
+			mmGetKnownClient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetKnownClient.defaultExpectation.params)
+		}
+	}
+
+	return mmGetKnownClient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetKnownClient {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	if mmGetKnownClient.defaultExpectation == nil {
+		mmGetKnownClient.defaultExpectation = &RepositoryMockGetKnownClientExpectation{}
+	}
+
+	if mmGetKnownClient.defaultExpectation.params != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Expect")
+	}
+
+	if mmGetKnownClient.defaultExpectation.paramPtrs == nil {
+		mmGetKnownClient.defaultExpectation.paramPtrs = &RepositoryMockGetKnownClientParamPtrs{}
+	}
+	mmGetKnownClient.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetKnownClient.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetKnownClient
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockGetKnownClient {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	if mmGetKnownClient.defaultExpectation == nil {
+		mmGetKnownClient.defaultExpectation = &RepositoryMockGetKnownClientExpectation{}
+	}
+
+	if mmGetKnownClient.defaultExpectation.params != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Expect")
+	}
+
+	if mmGetKnownClient.defaultExpectation.paramPtrs == nil {
+		mmGetKnownClient.defaultExpectation.paramPtrs = &RepositoryMockGetKnownClientParamPtrs{}
+	}
+	mmGetKnownClient.defaultExpectation.paramPtrs.userID = &userID
+	mmGetKnownClient.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetKnownClient
+}
+
+// ExpectClientParam3 sets up expected param client for Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) ExpectClientParam3(client mm_auth.Client) *mRepositoryMockGetKnownClient {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	if mmGetKnownClient.defaultExpectation == nil {
+		mmGetKnownClient.defaultExpectation = &RepositoryMockGetKnownClientExpectation{}
+	}
+
+	if mmGetKnownClient.defaultExpectation.params != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Expect")
+	}
+
+	if mmGetKnownClient.defaultExpectation.paramPtrs == nil {
+		mmGetKnownClient.defaultExpectation.paramPtrs = &RepositoryMockGetKnownClientParamPtrs{}
+	}
+	mmGetKnownClient.defaultExpectation.paramPtrs.client = &client
+	mmGetKnownClient.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmGetKnownClient
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Inspect(f func(ctx context.Context, userID uuid.UUID, client mm_auth.Client)) *mRepositoryMockGetKnownClient {
+	if mmGetKnownClient.mock.inspectFuncGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetKnownClient")
+	}
+
+	mmGetKnownClient.mock.inspectFuncGetKnownClient = f
+
+	return mmGetKnownClient
+}
+
+// Return sets up results that will be returned by Repository.GetKnownClient
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Return(k1 mm_auth.KnownClient, err error) *RepositoryMock {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	if mmGetKnownClient.defaultExpectation == nil {
+		mmGetKnownClient.defaultExpectation = &RepositoryMockGetKnownClientExpectation{mock: mmGetKnownClient.mock}
+	}
+	mmGetKnownClient.defaultExpectation.results = &RepositoryMockGetKnownClientResults{k1, err}
+	mmGetKnownClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetKnownClient.mock
+}
+
+// Set uses given function f to mock the Repository.GetKnownClient method
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Set(f func(ctx context.Context, userID uuid.UUID, client mm_auth.Client) (k1 mm_auth.KnownClient, err error)) *RepositoryMock {
+	if mmGetKnownClient.defaultExpectation != nil {
+		mmGetKnownClient.mock.t.Fatalf("Default expectation is already set for the Repository.GetKnownClient method")
+	}
+
+	if len(mmGetKnownClient.expectations) > 0 {
+		mmGetKnownClient.mock.t.Fatalf("Some expectations are already set for the Repository.GetKnownClient method")
+	}
+
+	mmGetKnownClient.mock.funcGetKnownClient = f
+	mmGetKnownClient.mock.funcGetKnownClientOrigin = minimock.CallerInfo(1)
+	return mmGetKnownClient.mock
+}
+
+// When sets expectation for the Repository.GetKnownClient which will trigger the result defined by the following
+// Then helper
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) When(ctx context.Context, userID uuid.UUID, client mm_auth.Client) *RepositoryMockGetKnownClientExpectation {
+	if mmGetKnownClient.mock.funcGetKnownClient != nil {
+		mmGetKnownClient.mock.t.Fatalf("RepositoryMock.GetKnownClient mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetKnownClientExpectation{
+		mock:               mmGetKnownClient.mock,
+		params:             &RepositoryMockGetKnownClientParams{ctx, userID, client},
+		expectationOrigins: RepositoryMockGetKnownClientExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetKnownClient.expectations = append(mmGetKnownClient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetKnownClient return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetKnownClientExpectation) Then(k1 mm_auth.KnownClient, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetKnownClientResults{k1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetKnownClient should be invoked
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Times(n uint64) *mRepositoryMockGetKnownClient {
+	if n == 0 {
+		mmGetKnownClient.mock.t.Fatalf("Times of RepositoryMock.GetKnownClient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetKnownClient.expectedInvocations, n)
+	mmGetKnownClient.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetKnownClient
+}
+
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) invocationsDone() bool {
+	if len(mmGetKnownClient.expectations) == 0 && mmGetKnownClient.defaultExpectation == nil && mmGetKnownClient.mock.funcGetKnownClient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetKnownClient.mock.afterGetKnownClientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetKnownClient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetKnownClient implements mm_auth.Repository
+func (mmGetKnownClient *RepositoryMock) GetKnownClient(ctx context.Context, userID uuid.UUID, client mm_auth.Client) (k1 mm_auth.KnownClient, err error) {
+	mm_atomic.AddUint64(&mmGetKnownClient.beforeGetKnownClientCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetKnownClient.afterGetKnownClientCounter, 1)
+
+	mmGetKnownClient.t.Helper()
+
+	if mmGetKnownClient.inspectFuncGetKnownClient != nil {
+		mmGetKnownClient.inspectFuncGetKnownClient(ctx, userID, client)
+	}
+
+	mm_params := RepositoryMockGetKnownClientParams{ctx, userID, client}
+
+	// Record call args
+	mmGetKnownClient.GetKnownClientMock.mutex.Lock()
+	mmGetKnownClient.GetKnownClientMock.callArgs = append(mmGetKnownClient.GetKnownClientMock.callArgs, &mm_params)
+	mmGetKnownClient.GetKnownClientMock.mutex.Unlock()
+
+	for _, e := range mmGetKnownClient.GetKnownClientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.k1, e.results.err
+		}
+	}
+
+	if mmGetKnownClient.GetKnownClientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetKnownClient.GetKnownClientMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetKnownClient.GetKnownClientMock.defaultExpectation.params
+		mm_want_ptrs := mmGetKnownClient.GetKnownClientMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetKnownClientParams{ctx, userID, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetKnownClient.t.Errorf("RepositoryMock.GetKnownClient got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKnownClient.GetKnownClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetKnownClient.t.Errorf("RepositoryMock.GetKnownClient got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKnownClient.GetKnownClientMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmGetKnownClient.t.Errorf("RepositoryMock.GetKnownClient got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKnownClient.GetKnownClientMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetKnownClient.t.Errorf("RepositoryMock.GetKnownClient got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetKnownClient.GetKnownClientMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetKnownClient.GetKnownClientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetKnownClient.t.Fatal("No results are set for the RepositoryMock.GetKnownClient")
+		}
+		return (*mm_results).k1, (*mm_results).err
+	}
+	if mmGetKnownClient.funcGetKnownClient != nil {
+		return mmGetKnownClient.funcGetKnownClient(ctx, userID, client)
+	}
+	mmGetKnownClient.t.Fatalf("Unexpected call to RepositoryMock.GetKnownClient. %v %v %v", ctx, userID, client)
+	return
+}
+
+// GetKnownClientAfterCounter returns a count of finished RepositoryMock.GetKnownClient invocations
+func (mmGetKnownClient *RepositoryMock) GetKnownClientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKnownClient.afterGetKnownClientCounter)
+}
+
+// GetKnownClientBeforeCounter returns a count of RepositoryMock.GetKnownClient invocations
+func (mmGetKnownClient *RepositoryMock) GetKnownClientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKnownClient.beforeGetKnownClientCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetKnownClient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetKnownClient *mRepositoryMockGetKnownClient) Calls() []*RepositoryMockGetKnownClientParams {
+	mmGetKnownClient.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetKnownClientParams, len(mmGetKnownClient.callArgs))
+	copy(argCopy, mmGetKnownClient.callArgs)
+
+	mmGetKnownClient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetKnownClientDone returns true if the count of the GetKnownClient invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetKnownClientDone() bool {
+	if m.GetKnownClientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetKnownClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetKnownClientMock.invocationsDone()
+}
+
+// MinimockGetKnownClientInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetKnownClientInspect() {
+	for _, e := range m.GetKnownClientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetKnownClient at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetKnownClientCounter := mm_atomic.LoadUint64(&m.afterGetKnownClientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetKnownClientMock.defaultExpectation != nil && afterGetKnownClientCounter < 1 {
+		if m.GetKnownClientMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetKnownClient at\n%s", m.GetKnownClientMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetKnownClient at\n%s with params: %#v", m.GetKnownClientMock.defaultExpectation.expectationOrigins.origin, *m.GetKnownClientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetKnownClient != nil && afterGetKnownClientCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetKnownClient at\n%s", m.funcGetKnownClientOrigin)
+	}
+
+	if !m.GetKnownClientMock.invocationsDone() && afterGetKnownClientCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetKnownClient at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetKnownClientMock.expectedInvocations), m.GetKnownClientMock.expectedInvocationsOrigin, afterGetKnownClientCounter)
+	}
+}
+
+type mRepositoryMockGetLoginHistory struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetLoginHistoryExpectation
+	expectations       []*RepositoryMockGetLoginHistoryExpectation
+
+	callArgs []*RepositoryMockGetLoginHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetLoginHistoryExpectation specifies expectation struct of the Repository.GetLoginHistory
+type RepositoryMockGetLoginHistoryExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetLoginHistoryParams
+	paramPtrs          *RepositoryMockGetLoginHistoryParamPtrs
+	expectationOrigins RepositoryMockGetLoginHistoryExpectationOrigins
+	results            *RepositoryMockGetLoginHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetLoginHistoryParams contains parameters of the Repository.GetLoginHistory
+type RepositoryMockGetLoginHistoryParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	limit  int
+}
+
+// RepositoryMockGetLoginHistoryParamPtrs contains pointers to parameters of the Repository.GetLoginHistory
+type RepositoryMockGetLoginHistoryParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	limit  *int
+}
+
+// RepositoryMockGetLoginHistoryResults contains results of the Repository.GetLoginHistory
+type RepositoryMockGetLoginHistoryResults struct {
+	la1 []mm_auth.LoginEvent
+	err error
+}
+
+// RepositoryMockGetLoginHistoryOrigins contains origins of expectations of the Repository.GetLoginHistory
+type RepositoryMockGetLoginHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Optional() *mRepositoryMockGetLoginHistory {
+	mmGetLoginHistory.optional = true
+	return mmGetLoginHistory
+}
+
+// Expect sets up expected params for Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Expect(ctx context.Context, userID uuid.UUID, limit int) *mRepositoryMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &RepositoryMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetLoginHistory.defaultExpectation.params = &RepositoryMockGetLoginHistoryParams{ctx, userID, limit}
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLoginHistory.expectations {
+		if minimock.Equal(e.params, mmGetLoginHistory.defaultExpectation.params) {
+			mmGetLoginHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLoginHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLoginHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &RepositoryMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &RepositoryMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &RepositoryMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &RepositoryMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.userID = &userID
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// ExpectLimitParam3 sets up expected param limit for Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) ExpectLimitParam3(limit int) *mRepositoryMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &RepositoryMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &RepositoryMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.limit = &limit
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Inspect(f func(ctx context.Context, userID uuid.UUID, limit int)) *mRepositoryMockGetLoginHistory {
+	if mmGetLoginHistory.mock.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetLoginHistory")
+	}
+
+	mmGetLoginHistory.mock.inspectFuncGetLoginHistory = f
+
+	return mmGetLoginHistory
+}
+
+// Return sets up results that will be returned by Repository.GetLoginHistory
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Return(la1 []mm_auth.LoginEvent, err error) *RepositoryMock {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &RepositoryMockGetLoginHistoryExpectation{mock: mmGetLoginHistory.mock}
+	}
+	mmGetLoginHistory.defaultExpectation.results = &RepositoryMockGetLoginHistoryResults{la1, err}
+	mmGetLoginHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// Set uses given function f to mock the Repository.GetLoginHistory method
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Set(f func(ctx context.Context, userID uuid.UUID, limit int) (la1 []mm_auth.LoginEvent, err error)) *RepositoryMock {
+	if mmGetLoginHistory.defaultExpectation != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Default expectation is already set for the Repository.GetLoginHistory method")
+	}
+
+	if len(mmGetLoginHistory.expectations) > 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Some expectations are already set for the Repository.GetLoginHistory method")
+	}
+
+	mmGetLoginHistory.mock.funcGetLoginHistory = f
+	mmGetLoginHistory.mock.funcGetLoginHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// When sets expectation for the Repository.GetLoginHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) When(ctx context.Context, userID uuid.UUID, limit int) *RepositoryMockGetLoginHistoryExpectation {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("RepositoryMock.GetLoginHistory mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetLoginHistoryExpectation{
+		mock:               mmGetLoginHistory.mock,
+		params:             &RepositoryMockGetLoginHistoryParams{ctx, userID, limit},
+		expectationOrigins: RepositoryMockGetLoginHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLoginHistory.expectations = append(mmGetLoginHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetLoginHistory return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetLoginHistoryExpectation) Then(la1 []mm_auth.LoginEvent, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetLoginHistoryResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetLoginHistory should be invoked
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Times(n uint64) *mRepositoryMockGetLoginHistory {
+	if n == 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Times of RepositoryMock.GetLoginHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLoginHistory.expectedInvocations, n)
+	mmGetLoginHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory
+}
+
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) invocationsDone() bool {
+	if len(mmGetLoginHistory.expectations) == 0 && mmGetLoginHistory.defaultExpectation == nil && mmGetLoginHistory.mock.funcGetLoginHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.mock.afterGetLoginHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLoginHistory implements mm_auth.Repository
+func (mmGetLoginHistory *RepositoryMock) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) (la1 []mm_auth.LoginEvent, err error) {
+	mm_atomic.AddUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter, 1)
+
+	mmGetLoginHistory.t.Helper()
+
+	if mmGetLoginHistory.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.inspectFuncGetLoginHistory(ctx, userID, limit)
+	}
+
+	mm_params := RepositoryMockGetLoginHistoryParams{ctx, userID, limit}
+
+	// Record call args
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Lock()
+	mmGetLoginHistory.GetLoginHistoryMock.callArgs = append(mmGetLoginHistory.GetLoginHistoryMock.callArgs, &mm_params)
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetLoginHistory.GetLoginHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetLoginHistoryParams{ctx, userID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLoginHistory.t.Errorf("RepositoryMock.GetLoginHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetLoginHistory.t.Errorf("RepositoryMock.GetLoginHistory got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetLoginHistory.t.Errorf("RepositoryMock.GetLoginHistory got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLoginHistory.t.Errorf("RepositoryMock.GetLoginHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLoginHistory.t.Fatal("No results are set for the RepositoryMock.GetLoginHistory")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmGetLoginHistory.funcGetLoginHistory != nil {
+		return mmGetLoginHistory.funcGetLoginHistory(ctx, userID, limit)
+	}
+	mmGetLoginHistory.t.Fatalf("Unexpected call to RepositoryMock.GetLoginHistory. %v %v %v", ctx, userID, limit)
+	return
+}
+
+// GetLoginHistoryAfterCounter returns a count of finished RepositoryMock.GetLoginHistory invocations
+func (mmGetLoginHistory *RepositoryMock) GetLoginHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter)
+}
+
+// GetLoginHistoryBeforeCounter returns a count of RepositoryMock.GetLoginHistory invocations
+func (mmGetLoginHistory *RepositoryMock) GetLoginHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetLoginHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLoginHistory *mRepositoryMockGetLoginHistory) Calls() []*RepositoryMockGetLoginHistoryParams {
+	mmGetLoginHistory.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetLoginHistoryParams, len(mmGetLoginHistory.callArgs))
+	copy(argCopy, mmGetLoginHistory.callArgs)
+
+	mmGetLoginHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLoginHistoryDone returns true if the count of the GetLoginHistory invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetLoginHistoryDone() bool {
+	if m.GetLoginHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLoginHistoryMock.invocationsDone()
+}
+
+// MinimockGetLoginHistoryInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetLoginHistoryInspect() {
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetLoginHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLoginHistoryCounter := mm_atomic.LoadUint64(&m.afterGetLoginHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLoginHistoryMock.defaultExpectation != nil && afterGetLoginHistoryCounter < 1 {
+		if m.GetLoginHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetLoginHistory at\n%s", m.GetLoginHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetLoginHistory at\n%s with params: %#v", m.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetLoginHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLoginHistory != nil && afterGetLoginHistoryCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetLoginHistory at\n%s", m.funcGetLoginHistoryOrigin)
+	}
+
+	if !m.GetLoginHistoryMock.invocationsDone() && afterGetLoginHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetLoginHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLoginHistoryMock.expectedInvocations), m.GetLoginHistoryMock.expectedInvocationsOrigin, afterGetLoginHistoryCounter)
+	}
+}
+
+type mRepositoryMockGetOrphanedGrants struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetOrphanedGrantsExpectation
+	expectations       []*RepositoryMockGetOrphanedGrantsExpectation
+
+	callArgs []*RepositoryMockGetOrphanedGrantsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetOrphanedGrantsExpectation specifies expectation struct of the Repository.GetOrphanedGrants
+type RepositoryMockGetOrphanedGrantsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetOrphanedGrantsParams
+	paramPtrs          *RepositoryMockGetOrphanedGrantsParamPtrs
+	expectationOrigins RepositoryMockGetOrphanedGrantsExpectationOrigins
+	results            *RepositoryMockGetOrphanedGrantsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetOrphanedGrantsParams contains parameters of the Repository.GetOrphanedGrants
+type RepositoryMockGetOrphanedGrantsParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetOrphanedGrantsParamPtrs contains pointers to parameters of the Repository.GetOrphanedGrants
+type RepositoryMockGetOrphanedGrantsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetOrphanedGrantsResults contains results of the Repository.GetOrphanedGrants
+type RepositoryMockGetOrphanedGrantsResults struct {
+	oa1 []mm_auth.OrphanedGrant
+	err error
+}
+
+// RepositoryMockGetOrphanedGrantsOrigins contains origins of expectations of the Repository.GetOrphanedGrants
+type RepositoryMockGetOrphanedGrantsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Optional() *mRepositoryMockGetOrphanedGrants {
+	mmGetOrphanedGrants.optional = true
+	return mmGetOrphanedGrants
+}
+
+// Expect sets up expected params for Repository.GetOrphanedGrants
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Expect(ctx context.Context) *mRepositoryMockGetOrphanedGrants {
+	if mmGetOrphanedGrants.mock.funcGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by Set")
+	}
+
+	if mmGetOrphanedGrants.defaultExpectation == nil {
+		mmGetOrphanedGrants.defaultExpectation = &RepositoryMockGetOrphanedGrantsExpectation{}
+	}
+
+	if mmGetOrphanedGrants.defaultExpectation.paramPtrs != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrphanedGrants.defaultExpectation.params = &RepositoryMockGetOrphanedGrantsParams{ctx}
+	mmGetOrphanedGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrphanedGrants.expectations {
+		if minimock.Equal(e.params, mmGetOrphanedGrants.defaultExpectation.params) {
+			mmGetOrphanedGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrphanedGrants.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrphanedGrants
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetOrphanedGrants
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetOrphanedGrants {
+	if mmGetOrphanedGrants.mock.funcGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by Set")
+	}
+
+	if mmGetOrphanedGrants.defaultExpectation == nil {
+		mmGetOrphanedGrants.defaultExpectation = &RepositoryMockGetOrphanedGrantsExpectation{}
+	}
+
+	if mmGetOrphanedGrants.defaultExpectation.params != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by Expect")
+	}
+
+	if mmGetOrphanedGrants.defaultExpectation.paramPtrs == nil {
+		mmGetOrphanedGrants.defaultExpectation.paramPtrs = &RepositoryMockGetOrphanedGrantsParamPtrs{}
+	}
+	mmGetOrphanedGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrphanedGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrphanedGrants
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetOrphanedGrants
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Inspect(f func(ctx context.Context)) *mRepositoryMockGetOrphanedGrants {
+	if mmGetOrphanedGrants.mock.inspectFuncGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetOrphanedGrants")
+	}
+
+	mmGetOrphanedGrants.mock.inspectFuncGetOrphanedGrants = f
+
+	return mmGetOrphanedGrants
+}
+
+// Return sets up results that will be returned by Repository.GetOrphanedGrants
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Return(oa1 []mm_auth.OrphanedGrant, err error) *RepositoryMock {
+	if mmGetOrphanedGrants.mock.funcGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &RepositoryMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	if mmGetOrphanedGrants.defaultExpectation == nil {
+		mmGetOrphanedGrants.defaultExpectation = &RepositoryMockGetOrphanedGrantsExpectation{mock: mmGetOrphanedGrants.mock}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.results = &RepositoryMockDeleteSessionsByUserIDResults{err}
-	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmGetOrphanedGrants.defaultExpectation.results = &RepositoryMockGetOrphanedGrantsResults{oa1, err}
+	mmGetOrphanedGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrphanedGrants.mock
 }
 
-// Set uses given function f to mock the Repository.DeleteSessionsByUserID method
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *RepositoryMock {
-	if mmDeleteSessionsByUserID.defaultExpectation != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSessionsByUserID method")
+// Set uses given function f to mock the Repository.GetOrphanedGrants method
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Set(f func(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error)) *RepositoryMock {
+	if mmGetOrphanedGrants.defaultExpectation != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("Default expectation is already set for the Repository.GetOrphanedGrants method")
 	}
 
-	if len(mmDeleteSessionsByUserID.expectations) > 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSessionsByUserID method")
+	if len(mmGetOrphanedGrants.expectations) > 0 {
+		mmGetOrphanedGrants.mock.t.Fatalf("Some expectations are already set for the Repository.GetOrphanedGrants method")
 	}
 
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmGetOrphanedGrants.mock.funcGetOrphanedGrants = f
+	mmGetOrphanedGrants.mock.funcGetOrphanedGrantsOrigin = minimock.CallerInfo(1)
+	return mmGetOrphanedGrants.mock
 }
 
-// When sets expectation for the Repository.DeleteSessionsByUserID which will trigger the result defined by the following
+// When sets expectation for the Repository.GetOrphanedGrants which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *RepositoryMockDeleteSessionsByUserIDExpectation {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("RepositoryMock.DeleteSessionsByUserID mock is already set by Set")
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) When(ctx context.Context) *RepositoryMockGetOrphanedGrantsExpectation {
+	if mmGetOrphanedGrants.mock.funcGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.mock.t.Fatalf("RepositoryMock.GetOrphanedGrants mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockDeleteSessionsByUserIDExpectation{
-		mock:               mmDeleteSessionsByUserID.mock,
-		params:             &RepositoryMockDeleteSessionsByUserIDParams{ctx, userID},
-		expectationOrigins: RepositoryMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockGetOrphanedGrantsExpectation{
+		mock:               mmGetOrphanedGrants.mock,
+		params:             &RepositoryMockGetOrphanedGrantsParams{ctx},
+		expectationOrigins: RepositoryMockGetOrphanedGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	mmGetOrphanedGrants.expectations = append(mmGetOrphanedGrants.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockDeleteSessionsByUserIDExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockDeleteSessionsByUserIDResults{err}
+// Then sets up Repository.GetOrphanedGrants return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetOrphanedGrantsExpectation) Then(oa1 []mm_auth.OrphanedGrant, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetOrphanedGrantsResults{oa1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteSessionsByUserID should be invoked
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Times(n uint64) *mRepositoryMockDeleteSessionsByUserID {
+// Times sets number of times Repository.GetOrphanedGrants should be invoked
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Times(n uint64) *mRepositoryMockGetOrphanedGrants {
 	if n == 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of RepositoryMock.DeleteSessionsByUserID mock can not be zero")
+		mmGetOrphanedGrants.mock.t.Fatalf("Times of RepositoryMock.GetOrphanedGrants mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
-	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID
+	mm_atomic.StoreUint64(&mmGetOrphanedGrants.expectedInvocations, n)
+	mmGetOrphanedGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrphanedGrants
 }
 
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) invocationsDone() bool {
-	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) invocationsDone() bool {
+	if len(mmGetOrphanedGrants.expectations) == 0 && mmGetOrphanedGrants.defaultExpectation == nil && mmGetOrphanedGrants.mock.funcGetOrphanedGrants == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrphanedGrants.mock.afterGetOrphanedGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrphanedGrants.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSessionsByUserID implements mm_auth.Repository
-func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+// GetOrphanedGrants implements mm_auth.Repository
+func (mmGetOrphanedGrants *RepositoryMock) GetOrphanedGrants(ctx context.Context) (oa1 []mm_auth.OrphanedGrant, err error) {
+	mm_atomic.AddUint64(&mmGetOrphanedGrants.beforeGetOrphanedGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrphanedGrants.afterGetOrphanedGrantsCounter, 1)
 
-	mmDeleteSessionsByUserID.t.Helper()
+	mmGetOrphanedGrants.t.Helper()
 
-	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	if mmGetOrphanedGrants.inspectFuncGetOrphanedGrants != nil {
+		mmGetOrphanedGrants.inspectFuncGetOrphanedGrants(ctx)
 	}
 
-	mm_params := RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
+	mm_params := RepositoryMockGetOrphanedGrantsParams{ctx}
 
 	// Record call args
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+	mmGetOrphanedGrants.GetOrphanedGrantsMock.mutex.Lock()
+	mmGetOrphanedGrants.GetOrphanedGrantsMock.callArgs = append(mmGetOrphanedGrants.GetOrphanedGrantsMock.callArgs, &mm_params)
+	mmGetOrphanedGrants.GetOrphanedGrantsMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range mmGetOrphanedGrants.GetOrphanedGrantsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.oa1, e.results.err
 		}
 	}
 
-	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+	if mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockDeleteSessionsByUserIDParams{ctx, userID}
+		mm_got := RepositoryMockGetOrphanedGrantsParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmGetOrphanedGrants.t.Errorf("RepositoryMock.GetOrphanedGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSessionsByUserID.t.Errorf("RepositoryMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetOrphanedGrants.t.Errorf("RepositoryMock.GetOrphanedGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		mm_results := mmGetOrphanedGrants.GetOrphanedGrantsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSessionsByUserID.t.Fatal("No results are set for the RepositoryMock.DeleteSessionsByUserID")
+			mmGetOrphanedGrants.t.Fatal("No results are set for the RepositoryMock.GetOrphanedGrants")
 		}
-		return (*mm_results).err
+		return (*mm_results).oa1, (*mm_results).err
 	}
-	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
-		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	if mmGetOrphanedGrants.funcGetOrphanedGrants != nil {
+		return mmGetOrphanedGrants.funcGetOrphanedGrants(ctx)
 	}
-	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to RepositoryMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	mmGetOrphanedGrants.t.Fatalf("Unexpected call to RepositoryMock.GetOrphanedGrants. %v", ctx)
 	return
 }
 
-// DeleteSessionsByUserIDAfterCounter returns a count of finished RepositoryMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+// GetOrphanedGrantsAfterCounter returns a count of finished RepositoryMock.GetOrphanedGrants invocations
+func (mmGetOrphanedGrants *RepositoryMock) GetOrphanedGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrphanedGrants.afterGetOrphanedGrantsCounter)
 }
 
-// DeleteSessionsByUserIDBeforeCounter returns a count of RepositoryMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *RepositoryMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+// GetOrphanedGrantsBeforeCounter returns a count of RepositoryMock.GetOrphanedGrants invocations
+func (mmGetOrphanedGrants *RepositoryMock) GetOrphanedGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrphanedGrants.beforeGetOrphanedGrantsCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSessionsByUserID.
+// Calls returns a list of arguments used in each call to RepositoryMock.GetOrphanedGrants.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSessionsByUserID *mRepositoryMockDeleteSessionsByUserID) Calls() []*RepositoryMockDeleteSessionsByUserIDParams {
-	mmDeleteSessionsByUserID.mutex.RLock()
+func (mmGetOrphanedGrants *mRepositoryMockGetOrphanedGrants) Calls() []*RepositoryMockGetOrphanedGrantsParams {
+	mmGetOrphanedGrants.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
-	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+	argCopy := make([]*RepositoryMockGetOrphanedGrantsParams, len(mmGetOrphanedGrants.callArgs))
+	copy(argCopy, mmGetOrphanedGrants.callArgs)
 
-	mmDeleteSessionsByUserID.mutex.RUnlock()
+	mmGetOrphanedGrants.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// MinimockGetOrphanedGrantsDone returns true if the count of the GetOrphanedGrants invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockDeleteSessionsByUserIDDone() bool {
-	if m.DeleteSessionsByUserIDMock.optional {
+func (m *RepositoryMock) MinimockGetOrphanedGrantsDone() bool {
+	if m.GetOrphanedGrantsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range m.GetOrphanedGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionsByUserIDMock.invocationsDone()
+	return m.GetOrphanedGrantsMock.invocationsDone()
 }
 
-// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockDeleteSessionsByUserIDInspect() {
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+// MinimockGetOrphanedGrantsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetOrphanedGrantsInspect() {
+	for _, e := range m.GetOrphanedGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetOrphanedGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	afterGetOrphanedGrantsCounter := mm_atomic.LoadUint64(&m.afterGetOrphanedGrantsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.GetOrphanedGrantsMock.defaultExpectation != nil && afterGetOrphanedGrantsCounter < 1 {
+		if m.GetOrphanedGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetOrphanedGrants at\n%s", m.GetOrphanedGrantsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetOrphanedGrants at\n%s with params: %#v", m.GetOrphanedGrantsMock.defaultExpectation.expectationOrigins.origin, *m.GetOrphanedGrantsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	if m.funcGetOrphanedGrants != nil && afterGetOrphanedGrantsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetOrphanedGrants at\n%s", m.funcGetOrphanedGrantsOrigin)
 	}
 
-	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSessionsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	if !m.GetOrphanedGrantsMock.invocationsDone() && afterGetOrphanedGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetOrphanedGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrphanedGrantsMock.expectedInvocations), m.GetOrphanedGrantsMock.expectedInvocationsOrigin, afterGetOrphanedGrantsCounter)
 	}
 }
 
-type mRepositoryMockDeleteUserRole struct {
+type mRepositoryMockGetPreset struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockDeleteUserRoleExpectation
-	expectations       []*RepositoryMockDeleteUserRoleExpectation
+	defaultExpectation *RepositoryMockGetPresetExpectation
+	expectations       []*RepositoryMockGetPresetExpectation
 
-	callArgs []*RepositoryMockDeleteUserRoleParams
+	callArgs []*RepositoryMockGetPresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockDeleteUserRoleExpectation specifies expectation struct of the Repository.DeleteUserRole
-type RepositoryMockDeleteUserRoleExpectation struct {
+// RepositoryMockGetPresetExpectation specifies expectation struct of the Repository.GetPreset
+type RepositoryMockGetPresetExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockDeleteUserRoleParams
-	paramPtrs          *RepositoryMockDeleteUserRoleParamPtrs
-	expectationOrigins RepositoryMockDeleteUserRoleExpectationOrigins
-	results            *RepositoryMockDeleteUserRoleResults
+	params             *RepositoryMockGetPresetParams
+	paramPtrs          *RepositoryMockGetPresetParamPtrs
+	expectationOrigins RepositoryMockGetPresetExpectationOrigins
+	results            *RepositoryMockGetPresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockDeleteUserRoleParams contains parameters of the Repository.DeleteUserRole
-type RepositoryMockDeleteUserRoleParams struct {
-	ctx  context.Context
-	role mm_auth.UserRole
+// RepositoryMockGetPresetParams contains parameters of the Repository.GetPreset
+type RepositoryMockGetPresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
 }
 
-// RepositoryMockDeleteUserRoleParamPtrs contains pointers to parameters of the Repository.DeleteUserRole
-type RepositoryMockDeleteUserRoleParamPtrs struct {
-	ctx  *context.Context
-	role *mm_auth.UserRole
+// RepositoryMockGetPresetParamPtrs contains pointers to parameters of the Repository.GetPreset
+type RepositoryMockGetPresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
 }
 
-// RepositoryMockDeleteUserRoleResults contains results of the Repository.DeleteUserRole
-type RepositoryMockDeleteUserRoleResults struct {
+// RepositoryMockGetPresetResults contains results of the Repository.GetPreset
+type RepositoryMockGetPresetResults struct {
+	r1  mm_auth.RolePreset
 	err error
 }
 
-// RepositoryMockDeleteUserRoleOrigins contains origins of expectations of the Repository.DeleteUserRole
-type RepositoryMockDeleteUserRoleExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originRole string
+// RepositoryMockGetPresetOrigins contains origins of expectations of the Repository.GetPreset
+type RepositoryMockGetPresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1617,292 +5195,292 @@ type RepositoryMockDeleteUserRoleExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Optional() *mRepositoryMockDeleteUserRole {
-	mmDeleteUserRole.optional = true
-	return mmDeleteUserRole
+func (mmGetPreset *mRepositoryMockGetPreset) Optional() *mRepositoryMockGetPreset {
+	mmGetPreset.optional = true
+	return mmGetPreset
 }
 
-// Expect sets up expected params for Repository.DeleteUserRole
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Expect(ctx context.Context, role mm_auth.UserRole) *mRepositoryMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+// Expect sets up expected params for Repository.GetPreset
+func (mmGetPreset *mRepositoryMockGetPreset) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &RepositoryMockGetPresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by ExpectParams functions")
+	if mmGetPreset.defaultExpectation.paramPtrs != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUserRole.defaultExpectation.params = &RepositoryMockDeleteUserRoleParams{ctx, role}
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUserRole.expectations {
-		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
-			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+	mmGetPreset.defaultExpectation.params = &RepositoryMockGetPresetParams{ctx, id}
+	mmGetPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreset.expectations {
+		if minimock.Equal(e.params, mmGetPreset.defaultExpectation.params) {
+			mmGetPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUserRole
+	return mmGetPreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteUserRole
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPreset
+func (mmGetPreset *mRepositoryMockGetPreset) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &RepositoryMockGetPresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Expect")
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRoleParamPtrs{}
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &RepositoryMockGetPresetParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmGetPreset
 }
 
-// ExpectRoleParam2 sets up expected param role for Repository.DeleteUserRole
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) ExpectRoleParam2(role mm_auth.UserRole) *mRepositoryMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Repository.GetPreset
+func (mmGetPreset *mRepositoryMockGetPreset) ExpectIdParam2(id uuid.UUID) *mRepositoryMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{}
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &RepositoryMockGetPresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Expect")
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &RepositoryMockDeleteUserRoleParamPtrs{}
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &RepositoryMockGetPresetParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+	mmGetPreset.defaultExpectation.paramPtrs.id = &id
+	mmGetPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmGetPreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteUserRole
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Inspect(f func(ctx context.Context, role mm_auth.UserRole)) *mRepositoryMockDeleteUserRole {
-	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteUserRole")
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPreset
+func (mmGetPreset *mRepositoryMockGetPreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockGetPreset {
+	if mmGetPreset.mock.inspectFuncGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPreset")
 	}
 
-	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+	mmGetPreset.mock.inspectFuncGetPreset = f
 
-	return mmDeleteUserRole
+	return mmGetPreset
 }
 
-// Return sets up results that will be returned by Repository.DeleteUserRole
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Return(err error) *RepositoryMock {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+// Return sets up results that will be returned by Repository.GetPreset
+func (mmGetPreset *mRepositoryMockGetPreset) Return(r1 mm_auth.RolePreset, err error) *RepositoryMock {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &RepositoryMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &RepositoryMockGetPresetExpectation{mock: mmGetPreset.mock}
 	}
-	mmDeleteUserRole.defaultExpectation.results = &RepositoryMockDeleteUserRoleResults{err}
-	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+	mmGetPreset.defaultExpectation.results = &RepositoryMockGetPresetResults{r1, err}
+	mmGetPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
 }
 
-// Set uses given function f to mock the Repository.DeleteUserRole method
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Set(f func(ctx context.Context, role mm_auth.UserRole) (err error)) *RepositoryMock {
-	if mmDeleteUserRole.defaultExpectation != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteUserRole method")
+// Set uses given function f to mock the Repository.GetPreset method
+func (mmGetPreset *mRepositoryMockGetPreset) Set(f func(ctx context.Context, id uuid.UUID) (r1 mm_auth.RolePreset, err error)) *RepositoryMock {
+	if mmGetPreset.defaultExpectation != nil {
+		mmGetPreset.mock.t.Fatalf("Default expectation is already set for the Repository.GetPreset method")
 	}
 
-	if len(mmDeleteUserRole.expectations) > 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteUserRole method")
+	if len(mmGetPreset.expectations) > 0 {
+		mmGetPreset.mock.t.Fatalf("Some expectations are already set for the Repository.GetPreset method")
 	}
 
-	mmDeleteUserRole.mock.funcDeleteUserRole = f
-	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+	mmGetPreset.mock.funcGetPreset = f
+	mmGetPreset.mock.funcGetPresetOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
 }
 
-// When sets expectation for the Repository.DeleteUserRole which will trigger the result defined by the following
+// When sets expectation for the Repository.GetPreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) When(ctx context.Context, role mm_auth.UserRole) *RepositoryMockDeleteUserRoleExpectation {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("RepositoryMock.DeleteUserRole mock is already set by Set")
+func (mmGetPreset *mRepositoryMockGetPreset) When(ctx context.Context, id uuid.UUID) *RepositoryMockGetPresetExpectation {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("RepositoryMock.GetPreset mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockDeleteUserRoleExpectation{
-		mock:               mmDeleteUserRole.mock,
-		params:             &RepositoryMockDeleteUserRoleParams{ctx, role},
-		expectationOrigins: RepositoryMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockGetPresetExpectation{
+		mock:               mmGetPreset.mock,
+		params:             &RepositoryMockGetPresetParams{ctx, id},
+		expectationOrigins: RepositoryMockGetPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	mmGetPreset.expectations = append(mmGetPreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteUserRole return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockDeleteUserRoleExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockDeleteUserRoleResults{err}
+// Then sets up Repository.GetPreset return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPresetExpectation) Then(r1 mm_auth.RolePreset, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPresetResults{r1, err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteUserRole should be invoked
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Times(n uint64) *mRepositoryMockDeleteUserRole {
+// Times sets number of times Repository.GetPreset should be invoked
+func (mmGetPreset *mRepositoryMockGetPreset) Times(n uint64) *mRepositoryMockGetPreset {
 	if n == 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Times of RepositoryMock.DeleteUserRole mock can not be zero")
+		mmGetPreset.mock.t.Fatalf("Times of RepositoryMock.GetPreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
-	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole
+	mm_atomic.StoreUint64(&mmGetPreset.expectedInvocations, n)
+	mmGetPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreset
 }
 
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) invocationsDone() bool {
-	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+func (mmGetPreset *mRepositoryMockGetPreset) invocationsDone() bool {
+	if len(mmGetPreset.expectations) == 0 && mmGetPreset.defaultExpectation == nil && mmGetPreset.mock.funcGetPreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreset.mock.afterGetPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUserRole implements mm_auth.Repository
-func (mmDeleteUserRole *RepositoryMock) DeleteUserRole(ctx context.Context, role mm_auth.UserRole) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+// GetPreset implements mm_auth.Repository
+func (mmGetPreset *RepositoryMock) GetPreset(ctx context.Context, id uuid.UUID) (r1 mm_auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmGetPreset.beforeGetPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreset.afterGetPresetCounter, 1)
 
-	mmDeleteUserRole.t.Helper()
+	mmGetPreset.t.Helper()
 
-	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	if mmGetPreset.inspectFuncGetPreset != nil {
+		mmGetPreset.inspectFuncGetPreset(ctx, id)
 	}
 
-	mm_params := RepositoryMockDeleteUserRoleParams{ctx, role}
+	mm_params := RepositoryMockGetPresetParams{ctx, id}
 
 	// Record call args
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
-	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+	mmGetPreset.GetPresetMock.mutex.Lock()
+	mmGetPreset.GetPresetMock.callArgs = append(mmGetPreset.GetPresetMock.callArgs, &mm_params)
+	mmGetPreset.GetPresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+	for _, e := range mmGetPreset.GetPresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.r1, e.results.err
 		}
 	}
 
-	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+	if mmGetPreset.GetPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreset.GetPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreset.GetPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreset.GetPresetMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockDeleteUserRoleParams{ctx, role}
+		mm_got := RepositoryMockGetPresetParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetPreset.t.Errorf("RepositoryMock.GetPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
-				mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPreset.t.Errorf("RepositoryMock.GetPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUserRole.t.Errorf("RepositoryMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetPreset.t.Errorf("RepositoryMock.GetPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		mm_results := mmGetPreset.GetPresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUserRole.t.Fatal("No results are set for the RepositoryMock.DeleteUserRole")
+			mmGetPreset.t.Fatal("No results are set for the RepositoryMock.GetPreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).r1, (*mm_results).err
 	}
-	if mmDeleteUserRole.funcDeleteUserRole != nil {
-		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	if mmGetPreset.funcGetPreset != nil {
+		return mmGetPreset.funcGetPreset(ctx, id)
 	}
-	mmDeleteUserRole.t.Fatalf("Unexpected call to RepositoryMock.DeleteUserRole. %v %v", ctx, role)
+	mmGetPreset.t.Fatalf("Unexpected call to RepositoryMock.GetPreset. %v %v", ctx, id)
 	return
 }
 
-// DeleteUserRoleAfterCounter returns a count of finished RepositoryMock.DeleteUserRole invocations
-func (mmDeleteUserRole *RepositoryMock) DeleteUserRoleAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+// GetPresetAfterCounter returns a count of finished RepositoryMock.GetPreset invocations
+func (mmGetPreset *RepositoryMock) GetPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.afterGetPresetCounter)
 }
 
-// DeleteUserRoleBeforeCounter returns a count of RepositoryMock.DeleteUserRole invocations
-func (mmDeleteUserRole *RepositoryMock) DeleteUserRoleBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+// GetPresetBeforeCounter returns a count of RepositoryMock.GetPreset invocations
+func (mmGetPreset *RepositoryMock) GetPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.beforeGetPresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.DeleteUserRole.
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUserRole *mRepositoryMockDeleteUserRole) Calls() []*RepositoryMockDeleteUserRoleParams {
-	mmDeleteUserRole.mutex.RLock()
+func (mmGetPreset *mRepositoryMockGetPreset) Calls() []*RepositoryMockGetPresetParams {
+	mmGetPreset.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
-	copy(argCopy, mmDeleteUserRole.callArgs)
+	argCopy := make([]*RepositoryMockGetPresetParams, len(mmGetPreset.callArgs))
+	copy(argCopy, mmGetPreset.callArgs)
 
-	mmDeleteUserRole.mutex.RUnlock()
+	mmGetPreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// MinimockGetPresetDone returns true if the count of the GetPreset invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockDeleteUserRoleDone() bool {
-	if m.DeleteUserRoleMock.optional {
+func (m *RepositoryMock) MinimockGetPresetDone() bool {
+	if m.GetPresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserRoleMock.expectations {
+	for _, e := range m.GetPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserRoleMock.invocationsDone()
+	return m.GetPresetMock.invocationsDone()
 }
 
-// MinimockDeleteUserRoleInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockDeleteUserRoleInspect() {
-	for _, e := range m.DeleteUserRoleMock.expectations {
+// MinimockGetPresetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPresetInspect() {
+	for _, e := range m.GetPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	afterGetPresetCounter := mm_atomic.LoadUint64(&m.afterGetPresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
-		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+	if m.GetPresetMock.defaultExpectation != nil && afterGetPresetCounter < 1 {
+		if m.GetPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPreset at\n%s", m.GetPresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.GetPreset at\n%s with params: %#v", m.GetPresetMock.defaultExpectation.expectationOrigins.origin, *m.GetPresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	if m.funcGetPreset != nil && afterGetPresetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPreset at\n%s", m.funcGetPresetOrigin)
 	}
 
-	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteUserRole at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	if !m.GetPresetMock.invocationsDone() && afterGetPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresetMock.expectedInvocations), m.GetPresetMock.expectedInvocationsOrigin, afterGetPresetCounter)
 	}
 }
 
@@ -2826,144 +6404,456 @@ func (mmGetUserRoles *mRepositoryMockGetUserRoles) invocationsDone() bool {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.mock.afterGetUserRolesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.mock.afterGetUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserRoles implements mm_auth.Repository
+func (mmGetUserRoles *RepositoryMock) GetUserRoles(ctx context.Context, userID uuid.UUID, roles []mm_auth.Role) (ua1 []mm_auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmGetUserRoles.beforeGetUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserRoles.afterGetUserRolesCounter, 1)
+
+	mmGetUserRoles.t.Helper()
+
+	if mmGetUserRoles.inspectFuncGetUserRoles != nil {
+		mmGetUserRoles.inspectFuncGetUserRoles(ctx, userID, roles)
+	}
+
+	mm_params := RepositoryMockGetUserRolesParams{ctx, userID, roles}
+
+	// Record call args
+	mmGetUserRoles.GetUserRolesMock.mutex.Lock()
+	mmGetUserRoles.GetUserRolesMock.callArgs = append(mmGetUserRoles.GetUserRolesMock.callArgs, &mm_params)
+	mmGetUserRoles.GetUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmGetUserRoles.GetUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmGetUserRoles.GetUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserRoles.GetUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserRoles.GetUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserRoles.GetUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetUserRolesParams{ctx, userID, roles}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
+				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserRoles.GetUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserRoles.t.Fatal("No results are set for the RepositoryMock.GetUserRoles")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmGetUserRoles.funcGetUserRoles != nil {
+		return mmGetUserRoles.funcGetUserRoles(ctx, userID, roles)
+	}
+	mmGetUserRoles.t.Fatalf("Unexpected call to RepositoryMock.GetUserRoles. %v %v %v", ctx, userID, roles)
+	return
+}
+
+// GetUserRolesAfterCounter returns a count of finished RepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *RepositoryMock) GetUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.afterGetUserRolesCounter)
+}
+
+// GetUserRolesBeforeCounter returns a count of RepositoryMock.GetUserRoles invocations
+func (mmGetUserRoles *RepositoryMock) GetUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserRoles.beforeGetUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserRoles *mRepositoryMockGetUserRoles) Calls() []*RepositoryMockGetUserRolesParams {
+	mmGetUserRoles.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetUserRolesParams, len(mmGetUserRoles.callArgs))
+	copy(argCopy, mmGetUserRoles.callArgs)
+
+	mmGetUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserRolesDone returns true if the count of the GetUserRoles invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetUserRolesDone() bool {
+	if m.GetUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserRolesMock.invocationsDone()
+}
+
+// MinimockGetUserRolesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetUserRolesInspect() {
+	for _, e := range m.GetUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserRolesCounter := mm_atomic.LoadUint64(&m.afterGetUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserRolesMock.defaultExpectation != nil && afterGetUserRolesCounter < 1 {
+		if m.GetUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s", m.GetUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s with params: %#v", m.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.GetUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserRoles != nil && afterGetUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s", m.funcGetUserRolesOrigin)
+	}
+
+	if !m.GetUserRolesMock.invocationsDone() && afterGetUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserRolesMock.expectedInvocations), m.GetUserRolesMock.expectedInvocationsOrigin, afterGetUserRolesCounter)
+	}
+}
+
+type mRepositoryMockListPresets struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPresetsExpectation
+	expectations       []*RepositoryMockListPresetsExpectation
+
+	callArgs []*RepositoryMockListPresetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListPresetsExpectation specifies expectation struct of the Repository.ListPresets
+type RepositoryMockListPresetsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListPresetsParams
+	paramPtrs          *RepositoryMockListPresetsParamPtrs
+	expectationOrigins RepositoryMockListPresetsExpectationOrigins
+	results            *RepositoryMockListPresetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListPresetsParams contains parameters of the Repository.ListPresets
+type RepositoryMockListPresetsParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockListPresetsParamPtrs contains pointers to parameters of the Repository.ListPresets
+type RepositoryMockListPresetsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockListPresetsResults contains results of the Repository.ListPresets
+type RepositoryMockListPresetsResults struct {
+	ra1 []mm_auth.RolePreset
+	err error
+}
+
+// RepositoryMockListPresetsOrigins contains origins of expectations of the Repository.ListPresets
+type RepositoryMockListPresetsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPresets *mRepositoryMockListPresets) Optional() *mRepositoryMockListPresets {
+	mmListPresets.optional = true
+	return mmListPresets
+}
+
+// Expect sets up expected params for Repository.ListPresets
+func (mmListPresets *mRepositoryMockListPresets) Expect(ctx context.Context) *mRepositoryMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &RepositoryMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by ExpectParams functions")
+	}
+
+	mmListPresets.defaultExpectation.params = &RepositoryMockListPresetsParams{ctx}
+	mmListPresets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPresets.expectations {
+		if minimock.Equal(e.params, mmListPresets.defaultExpectation.params) {
+			mmListPresets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPresets.defaultExpectation.params)
+		}
+	}
+
+	return mmListPresets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListPresets
+func (mmListPresets *mRepositoryMockListPresets) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &RepositoryMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.params != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by Expect")
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs == nil {
+		mmListPresets.defaultExpectation.paramPtrs = &RepositoryMockListPresetsParamPtrs{}
+	}
+	mmListPresets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPresets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPresets
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListPresets
+func (mmListPresets *mRepositoryMockListPresets) Inspect(f func(ctx context.Context)) *mRepositoryMockListPresets {
+	if mmListPresets.mock.inspectFuncListPresets != nil {
+		mmListPresets.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPresets")
+	}
+
+	mmListPresets.mock.inspectFuncListPresets = f
+
+	return mmListPresets
+}
+
+// Return sets up results that will be returned by Repository.ListPresets
+func (mmListPresets *mRepositoryMockListPresets) Return(ra1 []mm_auth.RolePreset, err error) *RepositoryMock {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &RepositoryMockListPresetsExpectation{mock: mmListPresets.mock}
+	}
+	mmListPresets.defaultExpectation.results = &RepositoryMockListPresetsResults{ra1, err}
+	mmListPresets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// Set uses given function f to mock the Repository.ListPresets method
+func (mmListPresets *mRepositoryMockListPresets) Set(f func(ctx context.Context) (ra1 []mm_auth.RolePreset, err error)) *RepositoryMock {
+	if mmListPresets.defaultExpectation != nil {
+		mmListPresets.mock.t.Fatalf("Default expectation is already set for the Repository.ListPresets method")
+	}
+
+	if len(mmListPresets.expectations) > 0 {
+		mmListPresets.mock.t.Fatalf("Some expectations are already set for the Repository.ListPresets method")
+	}
+
+	mmListPresets.mock.funcListPresets = f
+	mmListPresets.mock.funcListPresetsOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// When sets expectation for the Repository.ListPresets which will trigger the result defined by the following
+// Then helper
+func (mmListPresets *mRepositoryMockListPresets) When(ctx context.Context) *RepositoryMockListPresetsExpectation {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("RepositoryMock.ListPresets mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPresetsExpectation{
+		mock:               mmListPresets.mock,
+		params:             &RepositoryMockListPresetsParams{ctx},
+		expectationOrigins: RepositoryMockListPresetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPresets.expectations = append(mmListPresets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListPresets return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPresetsExpectation) Then(ra1 []mm_auth.RolePreset, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPresetsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListPresets should be invoked
+func (mmListPresets *mRepositoryMockListPresets) Times(n uint64) *mRepositoryMockListPresets {
+	if n == 0 {
+		mmListPresets.mock.t.Fatalf("Times of RepositoryMock.ListPresets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPresets.expectedInvocations, n)
+	mmListPresets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPresets
+}
+
+func (mmListPresets *mRepositoryMockListPresets) invocationsDone() bool {
+	if len(mmListPresets.expectations) == 0 && mmListPresets.defaultExpectation == nil && mmListPresets.mock.funcListPresets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPresets.mock.afterListPresetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPresets.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserRoles implements mm_auth.Repository
-func (mmGetUserRoles *RepositoryMock) GetUserRoles(ctx context.Context, userID uuid.UUID, roles []mm_auth.Role) (ua1 []mm_auth.UserRole, err error) {
-	mm_atomic.AddUint64(&mmGetUserRoles.beforeGetUserRolesCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserRoles.afterGetUserRolesCounter, 1)
+// ListPresets implements mm_auth.Repository
+func (mmListPresets *RepositoryMock) ListPresets(ctx context.Context) (ra1 []mm_auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmListPresets.beforeListPresetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPresets.afterListPresetsCounter, 1)
 
-	mmGetUserRoles.t.Helper()
+	mmListPresets.t.Helper()
 
-	if mmGetUserRoles.inspectFuncGetUserRoles != nil {
-		mmGetUserRoles.inspectFuncGetUserRoles(ctx, userID, roles)
+	if mmListPresets.inspectFuncListPresets != nil {
+		mmListPresets.inspectFuncListPresets(ctx)
 	}
 
-	mm_params := RepositoryMockGetUserRolesParams{ctx, userID, roles}
+	mm_params := RepositoryMockListPresetsParams{ctx}
 
 	// Record call args
-	mmGetUserRoles.GetUserRolesMock.mutex.Lock()
-	mmGetUserRoles.GetUserRolesMock.callArgs = append(mmGetUserRoles.GetUserRolesMock.callArgs, &mm_params)
-	mmGetUserRoles.GetUserRolesMock.mutex.Unlock()
+	mmListPresets.ListPresetsMock.mutex.Lock()
+	mmListPresets.ListPresetsMock.callArgs = append(mmListPresets.ListPresetsMock.callArgs, &mm_params)
+	mmListPresets.ListPresetsMock.mutex.Unlock()
 
-	for _, e := range mmGetUserRoles.GetUserRolesMock.expectations {
+	for _, e := range mmListPresets.ListPresetsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.ra1, e.results.err
 		}
 	}
 
-	if mmGetUserRoles.GetUserRolesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserRoles.GetUserRolesMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserRoles.GetUserRolesMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserRoles.GetUserRolesMock.defaultExpectation.paramPtrs
+	if mmListPresets.ListPresetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPresets.ListPresetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPresets.ListPresetsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPresets.ListPresetsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockGetUserRolesParams{ctx, userID, roles}
+		mm_got := RepositoryMockListPresetsParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
-			}
-
-			if mm_want_ptrs.roles != nil && !minimock.Equal(*mm_want_ptrs.roles, mm_got.roles) {
-				mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameter roles, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.originRoles, *mm_want_ptrs.roles, mm_got.roles, minimock.Diff(*mm_want_ptrs.roles, mm_got.roles))
+				mmListPresets.t.Errorf("RepositoryMock.ListPresets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserRoles.t.Errorf("RepositoryMock.GetUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserRoles.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListPresets.t.Errorf("RepositoryMock.ListPresets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserRoles.GetUserRolesMock.defaultExpectation.results
+		mm_results := mmListPresets.ListPresetsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserRoles.t.Fatal("No results are set for the RepositoryMock.GetUserRoles")
+			mmListPresets.t.Fatal("No results are set for the RepositoryMock.ListPresets")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
-	if mmGetUserRoles.funcGetUserRoles != nil {
-		return mmGetUserRoles.funcGetUserRoles(ctx, userID, roles)
+	if mmListPresets.funcListPresets != nil {
+		return mmListPresets.funcListPresets(ctx)
 	}
-	mmGetUserRoles.t.Fatalf("Unexpected call to RepositoryMock.GetUserRoles. %v %v %v", ctx, userID, roles)
+	mmListPresets.t.Fatalf("Unexpected call to RepositoryMock.ListPresets. %v", ctx)
 	return
 }
 
-// GetUserRolesAfterCounter returns a count of finished RepositoryMock.GetUserRoles invocations
-func (mmGetUserRoles *RepositoryMock) GetUserRolesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserRoles.afterGetUserRolesCounter)
+// ListPresetsAfterCounter returns a count of finished RepositoryMock.ListPresets invocations
+func (mmListPresets *RepositoryMock) ListPresetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.afterListPresetsCounter)
 }
 
-// GetUserRolesBeforeCounter returns a count of RepositoryMock.GetUserRoles invocations
-func (mmGetUserRoles *RepositoryMock) GetUserRolesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserRoles.beforeGetUserRolesCounter)
+// ListPresetsBeforeCounter returns a count of RepositoryMock.ListPresets invocations
+func (mmListPresets *RepositoryMock) ListPresetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.beforeListPresetsCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.GetUserRoles.
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPresets.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserRoles *mRepositoryMockGetUserRoles) Calls() []*RepositoryMockGetUserRolesParams {
-	mmGetUserRoles.mutex.RLock()
+func (mmListPresets *mRepositoryMockListPresets) Calls() []*RepositoryMockListPresetsParams {
+	mmListPresets.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockGetUserRolesParams, len(mmGetUserRoles.callArgs))
-	copy(argCopy, mmGetUserRoles.callArgs)
+	argCopy := make([]*RepositoryMockListPresetsParams, len(mmListPresets.callArgs))
+	copy(argCopy, mmListPresets.callArgs)
 
-	mmGetUserRoles.mutex.RUnlock()
+	mmListPresets.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserRolesDone returns true if the count of the GetUserRoles invocations corresponds
+// MinimockListPresetsDone returns true if the count of the ListPresets invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockGetUserRolesDone() bool {
-	if m.GetUserRolesMock.optional {
+func (m *RepositoryMock) MinimockListPresetsDone() bool {
+	if m.ListPresetsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserRolesMock.expectations {
+	for _, e := range m.ListPresetsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserRolesMock.invocationsDone()
+	return m.ListPresetsMock.invocationsDone()
 }
 
-// MinimockGetUserRolesInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockGetUserRolesInspect() {
-	for _, e := range m.GetUserRolesMock.expectations {
+// MinimockListPresetsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPresetsInspect() {
+	for _, e := range m.ListPresetsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListPresets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserRolesCounter := mm_atomic.LoadUint64(&m.afterGetUserRolesCounter)
+	afterListPresetsCounter := mm_atomic.LoadUint64(&m.afterListPresetsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserRolesMock.defaultExpectation != nil && afterGetUserRolesCounter < 1 {
-		if m.GetUserRolesMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s", m.GetUserRolesMock.defaultExpectation.returnOrigin)
+	if m.ListPresetsMock.defaultExpectation != nil && afterListPresetsCounter < 1 {
+		if m.ListPresetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListPresets at\n%s", m.ListPresetsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s with params: %#v", m.GetUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.GetUserRolesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.ListPresets at\n%s with params: %#v", m.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *m.ListPresetsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserRoles != nil && afterGetUserRolesCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.GetUserRoles at\n%s", m.funcGetUserRolesOrigin)
+	if m.funcListPresets != nil && afterListPresetsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListPresets at\n%s", m.funcListPresetsOrigin)
 	}
 
-	if !m.GetUserRolesMock.invocationsDone() && afterGetUserRolesCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.GetUserRoles at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserRolesMock.expectedInvocations), m.GetUserRolesMock.expectedInvocationsOrigin, afterGetUserRolesCounter)
+	if !m.ListPresetsMock.invocationsDone() && afterListPresetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPresets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPresetsMock.expectedInvocations), m.ListPresetsMock.expectedInvocationsOrigin, afterListPresetsCounter)
 	}
 }
 
@@ -3310,6 +7200,348 @@ func (m *RepositoryMock) MinimockListUserRolesInspect() {
 	}
 }
 
+type mRepositoryMockUpdatePreset struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdatePresetExpectation
+	expectations       []*RepositoryMockUpdatePresetExpectation
+
+	callArgs []*RepositoryMockUpdatePresetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdatePresetExpectation specifies expectation struct of the Repository.UpdatePreset
+type RepositoryMockUpdatePresetExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdatePresetParams
+	paramPtrs          *RepositoryMockUpdatePresetParamPtrs
+	expectationOrigins RepositoryMockUpdatePresetExpectationOrigins
+	results            *RepositoryMockUpdatePresetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdatePresetParams contains parameters of the Repository.UpdatePreset
+type RepositoryMockUpdatePresetParams struct {
+	ctx    context.Context
+	preset mm_auth.RolePreset
+}
+
+// RepositoryMockUpdatePresetParamPtrs contains pointers to parameters of the Repository.UpdatePreset
+type RepositoryMockUpdatePresetParamPtrs struct {
+	ctx    *context.Context
+	preset *mm_auth.RolePreset
+}
+
+// RepositoryMockUpdatePresetResults contains results of the Repository.UpdatePreset
+type RepositoryMockUpdatePresetResults struct {
+	err error
+}
+
+// RepositoryMockUpdatePresetOrigins contains origins of expectations of the Repository.UpdatePreset
+type RepositoryMockUpdatePresetExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPreset string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Optional() *mRepositoryMockUpdatePreset {
+	mmUpdatePreset.optional = true
+	return mmUpdatePreset
+}
+
+// Expect sets up expected params for Repository.UpdatePreset
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Expect(ctx context.Context, preset mm_auth.RolePreset) *mRepositoryMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Set")
+	}
+
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &RepositoryMockUpdatePresetExpectation{}
+	}
+
+	if mmUpdatePreset.defaultExpectation.paramPtrs != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePreset.defaultExpectation.params = &RepositoryMockUpdatePresetParams{ctx, preset}
+	mmUpdatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePreset.expectations {
+		if minimock.Equal(e.params, mmUpdatePreset.defaultExpectation.params) {
+			mmUpdatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePreset.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePreset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdatePreset
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Set")
+	}
+
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &RepositoryMockUpdatePresetExpectation{}
+	}
+
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Expect")
+	}
+
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &RepositoryMockUpdatePresetParamPtrs{}
+	}
+	mmUpdatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePreset
+}
+
+// ExpectPresetParam2 sets up expected param preset for Repository.UpdatePreset
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) ExpectPresetParam2(preset mm_auth.RolePreset) *mRepositoryMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Set")
+	}
+
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &RepositoryMockUpdatePresetExpectation{}
+	}
+
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Expect")
+	}
+
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &RepositoryMockUpdatePresetParamPtrs{}
+	}
+	mmUpdatePreset.defaultExpectation.paramPtrs.preset = &preset
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originPreset = minimock.CallerInfo(1)
+
+	return mmUpdatePreset
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdatePreset
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Inspect(f func(ctx context.Context, preset mm_auth.RolePreset)) *mRepositoryMockUpdatePreset {
+	if mmUpdatePreset.mock.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdatePreset")
+	}
+
+	mmUpdatePreset.mock.inspectFuncUpdatePreset = f
+
+	return mmUpdatePreset
+}
+
+// Return sets up results that will be returned by Repository.UpdatePreset
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Return(err error) *RepositoryMock {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Set")
+	}
+
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &RepositoryMockUpdatePresetExpectation{mock: mmUpdatePreset.mock}
+	}
+	mmUpdatePreset.defaultExpectation.results = &RepositoryMockUpdatePresetResults{err}
+	mmUpdatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
+}
+
+// Set uses given function f to mock the Repository.UpdatePreset method
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Set(f func(ctx context.Context, preset mm_auth.RolePreset) (err error)) *RepositoryMock {
+	if mmUpdatePreset.defaultExpectation != nil {
+		mmUpdatePreset.mock.t.Fatalf("Default expectation is already set for the Repository.UpdatePreset method")
+	}
+
+	if len(mmUpdatePreset.expectations) > 0 {
+		mmUpdatePreset.mock.t.Fatalf("Some expectations are already set for the Repository.UpdatePreset method")
+	}
+
+	mmUpdatePreset.mock.funcUpdatePreset = f
+	mmUpdatePreset.mock.funcUpdatePresetOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
+}
+
+// When sets expectation for the Repository.UpdatePreset which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) When(ctx context.Context, preset mm_auth.RolePreset) *RepositoryMockUpdatePresetExpectation {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("RepositoryMock.UpdatePreset mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdatePresetExpectation{
+		mock:               mmUpdatePreset.mock,
+		params:             &RepositoryMockUpdatePresetParams{ctx, preset},
+		expectationOrigins: RepositoryMockUpdatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePreset.expectations = append(mmUpdatePreset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdatePreset return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdatePresetExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdatePresetResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdatePreset should be invoked
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Times(n uint64) *mRepositoryMockUpdatePreset {
+	if n == 0 {
+		mmUpdatePreset.mock.t.Fatalf("Times of RepositoryMock.UpdatePreset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePreset.expectedInvocations, n)
+	mmUpdatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset
+}
+
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) invocationsDone() bool {
+	if len(mmUpdatePreset.expectations) == 0 && mmUpdatePreset.defaultExpectation == nil && mmUpdatePreset.mock.funcUpdatePreset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.mock.afterUpdatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePreset implements mm_auth.Repository
+func (mmUpdatePreset *RepositoryMock) UpdatePreset(ctx context.Context, preset mm_auth.RolePreset) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePreset.beforeUpdatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePreset.afterUpdatePresetCounter, 1)
+
+	mmUpdatePreset.t.Helper()
+
+	if mmUpdatePreset.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.inspectFuncUpdatePreset(ctx, preset)
+	}
+
+	mm_params := RepositoryMockUpdatePresetParams{ctx, preset}
+
+	// Record call args
+	mmUpdatePreset.UpdatePresetMock.mutex.Lock()
+	mmUpdatePreset.UpdatePresetMock.callArgs = append(mmUpdatePreset.UpdatePresetMock.callArgs, &mm_params)
+	mmUpdatePreset.UpdatePresetMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePreset.UpdatePresetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePreset.UpdatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePreset.UpdatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePreset.UpdatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePreset.UpdatePresetMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdatePresetParams{ctx, preset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePreset.t.Errorf("RepositoryMock.UpdatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.preset != nil && !minimock.Equal(*mm_want_ptrs.preset, mm_got.preset) {
+				mmUpdatePreset.t.Errorf("RepositoryMock.UpdatePreset got unexpected parameter preset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originPreset, *mm_want_ptrs.preset, mm_got.preset, minimock.Diff(*mm_want_ptrs.preset, mm_got.preset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePreset.t.Errorf("RepositoryMock.UpdatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePreset.UpdatePresetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePreset.t.Fatal("No results are set for the RepositoryMock.UpdatePreset")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePreset.funcUpdatePreset != nil {
+		return mmUpdatePreset.funcUpdatePreset(ctx, preset)
+	}
+	mmUpdatePreset.t.Fatalf("Unexpected call to RepositoryMock.UpdatePreset. %v %v", ctx, preset)
+	return
+}
+
+// UpdatePresetAfterCounter returns a count of finished RepositoryMock.UpdatePreset invocations
+func (mmUpdatePreset *RepositoryMock) UpdatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.afterUpdatePresetCounter)
+}
+
+// UpdatePresetBeforeCounter returns a count of RepositoryMock.UpdatePreset invocations
+func (mmUpdatePreset *RepositoryMock) UpdatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.beforeUpdatePresetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdatePreset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePreset *mRepositoryMockUpdatePreset) Calls() []*RepositoryMockUpdatePresetParams {
+	mmUpdatePreset.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpdatePresetParams, len(mmUpdatePreset.callArgs))
+	copy(argCopy, mmUpdatePreset.callArgs)
+
+	mmUpdatePreset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePresetDone returns true if the count of the UpdatePreset invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdatePresetDone() bool {
+	if m.UpdatePresetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePresetMock.invocationsDone()
+}
+
+// MinimockUpdatePresetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdatePresetInspect() {
+	for _, e := range m.UpdatePresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdatePresetCounter := mm_atomic.LoadUint64(&m.afterUpdatePresetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePresetMock.defaultExpectation != nil && afterUpdatePresetCounter < 1 {
+		if m.UpdatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePreset at\n%s", m.UpdatePresetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePreset at\n%s with params: %#v", m.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePresetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePreset != nil && afterUpdatePresetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdatePreset at\n%s", m.funcUpdatePresetOrigin)
+	}
+
+	if !m.UpdatePresetMock.invocationsDone() && afterUpdatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePresetMock.expectedInvocations), m.UpdatePresetMock.expectedInvocationsOrigin, afterUpdatePresetCounter)
+	}
+}
+
 type mRepositoryMockUpdateRefreshToken struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -3658,22 +7890,46 @@ func (m *RepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddUserRoleInspect()
 
+			m.MinimockApplyPresetInspect()
+
+			m.MinimockCreateLoginEventInspect()
+
+			m.MinimockCreatePresetInspect()
+
 			m.MinimockCreateSessionInspect()
 
+			m.MinimockDeleteOrphanedGrantsInspect()
+
+			m.MinimockDeletePresetInspect()
+
 			m.MinimockDeleteSessionByIDAndUserInspect()
 
 			m.MinimockDeleteSessionsByUserIDInspect()
 
 			m.MinimockDeleteUserRoleInspect()
 
+			m.MinimockDeleteUserRolesInspect()
+
+			m.MinimockGetKnownClientInspect()
+
+			m.MinimockGetLoginHistoryInspect()
+
+			m.MinimockGetOrphanedGrantsInspect()
+
+			m.MinimockGetPresetInspect()
+
 			m.MinimockGetSessionByIDInspect()
 
 			m.MinimockGetSessionsByUserIDInspect()
 
 			m.MinimockGetUserRolesInspect()
 
+			m.MinimockListPresetsInspect()
+
 			m.MinimockListUserRolesInspect()
 
+			m.MinimockUpdatePresetInspect()
+
 			m.MinimockUpdateRefreshTokenInspect()
 		}
 	})
@@ -3699,13 +7955,25 @@ func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddUserRoleDone() &&
+		m.MinimockApplyPresetDone() &&
+		m.MinimockCreateLoginEventDone() &&
+		m.MinimockCreatePresetDone() &&
 		m.MinimockCreateSessionDone() &&
+		m.MinimockDeleteOrphanedGrantsDone() &&
+		m.MinimockDeletePresetDone() &&
 		m.MinimockDeleteSessionByIDAndUserDone() &&
 		m.MinimockDeleteSessionsByUserIDDone() &&
 		m.MinimockDeleteUserRoleDone() &&
+		m.MinimockDeleteUserRolesDone() &&
+		m.MinimockGetKnownClientDone() &&
+		m.MinimockGetLoginHistoryDone() &&
+		m.MinimockGetOrphanedGrantsDone() &&
+		m.MinimockGetPresetDone() &&
 		m.MinimockGetSessionByIDDone() &&
 		m.MinimockGetSessionsByUserIDDone() &&
 		m.MinimockGetUserRolesDone() &&
+		m.MinimockListPresetsDone() &&
 		m.MinimockListUserRolesDone() &&
+		m.MinimockUpdatePresetDone() &&
 		m.MinimockUpdateRefreshTokenDone()
 }
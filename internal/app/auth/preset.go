@@ -0,0 +1,212 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	MaxPresetNameLength = 100
+	MaxPresetGrants     = 100
+	// MaxPresetUsers caps the users a preset is applied to in one call.
+	MaxPresetUsers = 100
+)
+
+func ErrPresetNotFound() error {
+	return apperr.New("role preset not found", CodePresetNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrDuplicatePresetName() error {
+	return apperr.New("role preset name already taken", CodePresetDuplicate, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleDuplicate})
+}
+
+func ErrInvalidPresetName() error {
+	return apperr.New("role preset name must be 1 to 100 characters", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldName, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min_chars": 1, "max_chars": MaxPresetNameLength},
+		})
+}
+
+func ErrInvalidPresetGrants() error {
+	return apperr.New("number of preset grants is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldGrants, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": MaxPresetGrants},
+		})
+}
+
+func ErrInvalidPresetRole() error {
+	return apperr.New("invalid role", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRole, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrDuplicatePresetGrant is returned when a preset has two grants on one entity, or two admin grants.
+func ErrDuplicatePresetGrant() error {
+	return apperr.New("a preset can have one role per entity", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldGrants, Rule: apperr.RuleDuplicate})
+}
+
+func ErrInvalidPresetUsers() error {
+	return apperr.New("number of users is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldUserIDs, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": MaxPresetUsers},
+		})
+}
+
+// RolePreset is a named set of grants, such as write on one subtree and read on another, that admins
+// apply to users in one call instead of granting each role.
+type RolePreset struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	Grants    []PresetGrant `json:"grants"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// PresetGrant is a grant of a preset; EntityID follows the rules of UserRole.
+type PresetGrant struct {
+	Role     Role       `json:"role"`
+	EntityID *uuid.UUID `json:"entity_id"`
+}
+
+type RolePresetReq struct {
+	Name   string        `json:"name"`
+	Grants []PresetGrant `json:"grants"`
+}
+
+type ApplyPresetReq struct {
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+func (c *core) ListPresets(ctx context.Context) ([]RolePreset, error) {
+	presets, err := c.repo.ListPresets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.core.ListPresets: %w", err)
+	}
+
+	return presets, nil
+}
+
+func (c *core) GetPreset(ctx context.Context, id uuid.UUID) (RolePreset, error) {
+	if id == uuid.Nil {
+		return RolePreset{}, fmt.Errorf("auth.core.GetPreset: %w", apperr.ErrNilUUID(FieldPresetID))
+	}
+	preset, err := c.repo.GetPreset(ctx, id)
+	if err != nil {
+		return RolePreset{}, fmt.Errorf("auth.core.GetPreset: %w", err)
+	}
+
+	return preset, nil
+}
+
+func (c *core) CreatePreset(ctx context.Context, req RolePresetReq) (RolePreset, error) {
+	req, err := validatePreset(req)
+	if err != nil {
+		return RolePreset{}, fmt.Errorf("auth.core.CreatePreset: %w", err)
+	}
+	id, err := c.generators.idGenerator.New()
+	if err != nil {
+		return RolePreset{}, fmt.Errorf("auth.core.CreatePreset: %w", err)
+	}
+
+	now := c.generators.timeGenerator.Now()
+	preset := RolePreset{ID: id, Name: req.Name, Grants: req.Grants, CreatedAt: now, UpdatedAt: now}
+	if err = c.repo.CreatePreset(ctx, preset); err != nil {
+		return RolePreset{}, fmt.Errorf("auth.core.CreatePreset: %w", err)
+	}
+
+	return preset, nil
+}
+
+// UpdatePreset replaces the name and the grants of the preset. Grants made with it are kept.
+func (c *core) UpdatePreset(ctx context.Context, id uuid.UUID, req RolePresetReq) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("auth.core.UpdatePreset: %w", apperr.ErrNilUUID(FieldPresetID))
+	}
+	req, err := validatePreset(req)
+	if err != nil {
+		return fmt.Errorf("auth.core.UpdatePreset: %w", err)
+	}
+
+	preset := RolePreset{ID: id, Name: req.Name, Grants: req.Grants, UpdatedAt: c.generators.timeGenerator.Now()}
+	if err = c.repo.UpdatePreset(ctx, preset); err != nil {
+		return fmt.Errorf("auth.core.UpdatePreset: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePreset deletes the preset. Grants made with it are kept.
+func (c *core) DeletePreset(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("auth.core.DeletePreset: %w", apperr.ErrNilUUID(FieldPresetID))
+	}
+	if err := c.repo.DeletePreset(ctx, id); err != nil {
+		return fmt.Errorf("auth.core.DeletePreset: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyPreset grants every role of the preset to every user, all or none, and returns the grants made.
+// Grants a user already has are skipped.
+func (c *core) ApplyPreset(ctx context.Context, id uuid.UUID, req ApplyPresetReq) ([]UserRole, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("auth.core.ApplyPreset: %w", apperr.ErrNilUUID(FieldPresetID))
+	}
+	userIDs := lo.Uniq(req.UserIDs)
+	if len(userIDs) == 0 || len(userIDs) > MaxPresetUsers {
+		return nil, fmt.Errorf("auth.core.ApplyPreset: %w", ErrInvalidPresetUsers())
+	}
+	if lo.Contains(userIDs, uuid.Nil) {
+		return nil, fmt.Errorf("auth.core.ApplyPreset: %w", apperr.ErrNilUUID(FieldUserIDs))
+	}
+
+	granted, err := c.repo.ApplyPreset(ctx, id, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("auth.core.ApplyPreset: %w", err)
+	}
+	contextx.ClearCache(ctx)
+
+	return granted, nil
+}
+
+// validatePreset returns req with the name trimmed.
+func validatePreset(req RolePresetReq) (RolePresetReq, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || text.Length(req.Name) > MaxPresetNameLength {
+		return RolePresetReq{}, ErrInvalidPresetName()
+	}
+	if len(req.Grants) == 0 || len(req.Grants) > MaxPresetGrants {
+		return RolePresetReq{}, ErrInvalidPresetGrants()
+	}
+
+	// the admin role has no entity, so uuid.Nil stands for it
+	entities := make(map[uuid.UUID]struct{}, len(req.Grants))
+	for _, g := range req.Grants {
+		if err := g.Role.Validate(); err != nil {
+			return RolePresetReq{}, ErrInvalidPresetRole()
+		}
+		if err := g.Role.ValidateEntity(g.EntityID); err != nil {
+			return RolePresetReq{}, err
+		}
+		entityID := lo.FromPtr(g.EntityID)
+		if _, ok := entities[entityID]; ok {
+			return RolePresetReq{}, ErrDuplicatePresetGrant()
+		}
+		entities[entityID] = struct{}{}
+	}
+
+	return req, nil
+}
@@ -0,0 +1,213 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_CreatePreset(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = context.Background()
+		id       = uuid.New()
+		now      = time.Now()
+		a, b     = uuid.New(), uuid.New()
+		errExp   = fmt.Errorf("expected")
+		grants   = []auth.PresetGrant{{Role: auth.RoleWrite, EntityID: &a}, {Role: auth.RoleRead, EntityID: &b}}
+		expected = auth.RolePreset{ID: id, Name: "Docs Editor", Grants: grants, CreatedAt: now, UpdatedAt: now}
+	)
+	tests := []struct {
+		name  string
+		req   auth.RolePresetReq
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok, name trimmed",
+			req:  auth.RolePresetReq{Name: "  Docs Editor ", Grants: grants},
+			setup: func(m mock) {
+				m.idGen.NewMock.Return(id, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreatePresetMock.Expect(ctx, expected).Return(nil)
+			},
+		},
+		{name: "empty name", req: auth.RolePresetReq{Name: " ", Grants: grants}, err: auth.ErrInvalidPresetName()},
+		{
+			name: "name too long", req: auth.RolePresetReq{Name: strings.Repeat("a", auth.MaxPresetNameLength+1), Grants: grants},
+			err: auth.ErrInvalidPresetName(),
+		},
+		{name: "no grants", req: auth.RolePresetReq{Name: "p"}, err: auth.ErrInvalidPresetGrants()},
+		{
+			name: "too many grants", req: auth.RolePresetReq{Name: "p", Grants: make([]auth.PresetGrant, auth.MaxPresetGrants+1)},
+			err: auth.ErrInvalidPresetGrants(),
+		},
+		{
+			name: "invalid role", req: auth.RolePresetReq{Name: "p", Grants: []auth.PresetGrant{{Role: "owner", EntityID: &a}}},
+			err: auth.ErrInvalidPresetRole(),
+		},
+		{
+			name: "entity role without entity", req: auth.RolePresetReq{Name: "p", Grants: []auth.PresetGrant{{Role: auth.RoleRead}}},
+			err: auth.ErrRoleRequiresEntity(),
+		},
+		{
+			name: "admin role with entity", req: auth.RolePresetReq{Name: "p", Grants: []auth.PresetGrant{{Role: auth.RoleAdmin, EntityID: &a}}},
+			err: auth.ErrRoleForbidsEntity(),
+		},
+		{
+			name: "two roles on one entity",
+			req:  auth.RolePresetReq{Name: "p", Grants: []auth.PresetGrant{{Role: auth.RoleRead, EntityID: &a}, {Role: auth.RoleWrite, EntityID: &a}}},
+			err:  auth.ErrDuplicatePresetGrant(),
+		},
+		{
+			name: "id generator error",
+			req:  auth.RolePresetReq{Name: "Docs Editor", Grants: grants},
+			setup: func(m mock) {
+				m.idGen.NewMock.Return(uuid.Nil, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "repo error",
+			req:  auth.RolePresetReq{Name: "Docs Editor", Grants: grants},
+			setup: func(m mock) {
+				m.idGen.NewMock.Return(id, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreatePresetMock.Expect(ctx, expected).Return(auth.ErrDuplicatePresetName())
+			},
+			err: auth.ErrDuplicatePresetName(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			core, err := auth.NewCore(m.repo, m.tokenCodec, m.idGen, m.rndGen, m.timeGen, m.pswHasher, cfg())
+			require.NoError(t, err)
+
+			got, err := core.CreatePreset(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestCore_UpdatePreset(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		now    = time.Now()
+		grants = []auth.PresetGrant{{Role: auth.RoleAdmin}}
+		req    = auth.RolePresetReq{Name: "Admins", Grants: grants}
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		m := setupMocks(t)
+		core, err := auth.NewCore(m.repo, m.tokenCodec, m.idGen, m.rndGen, m.timeGen, m.pswHasher, cfg())
+		require.NoError(t, err)
+
+		m.timeGen.NowMock.Return(now)
+		m.repo.UpdatePresetMock.Expect(ctx, auth.RolePreset{ID: id, Name: "Admins", Grants: grants, UpdatedAt: now}).Return(nil)
+		require.NoError(t, core.UpdatePreset(ctx, id, req))
+	})
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		m := setupMocks(t)
+		core, err := auth.NewCore(m.repo, m.tokenCodec, m.idGen, m.rndGen, m.timeGen, m.pswHasher, cfg())
+		require.NoError(t, err)
+
+		m.timeGen.NowMock.Return(now)
+		m.repo.UpdatePresetMock.Return(auth.ErrPresetNotFound())
+		require.ErrorIs(t, core.UpdatePreset(ctx, id, req), auth.ErrPresetNotFound())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		m := setupMocks(t)
+		core, err := auth.NewCore(m.repo, m.tokenCodec, m.idGen, m.rndGen, m.timeGen, m.pswHasher, cfg())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, core.UpdatePreset(ctx, uuid.Nil, req), apperr.ErrNilUUID(auth.FieldPresetID))
+		require.ErrorIs(t, core.UpdatePreset(ctx, id, auth.RolePresetReq{Name: "Admins"}), auth.ErrInvalidPresetGrants())
+	})
+}
+
+func TestCore_ApplyPreset(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = context.Background()
+		id       = uuid.New()
+		a, b     = uuid.New(), uuid.New()
+		entityID = uuid.New()
+		granted  = []auth.UserRole{{UserID: a, Role: auth.RoleRead, EntityID: &entityID}}
+		errExp   = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name    string
+		id      uuid.UUID
+		userIDs []uuid.UUID
+		setup   func(m mock)
+		err     error
+	}{
+		{
+			name: "ok, repeated users once", id: id, userIDs: []uuid.UUID{a, b, a},
+			setup: func(m mock) {
+				m.repo.ApplyPresetMock.Expect(ctx, id, []uuid.UUID{a, b}).Return(granted, nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, userIDs: []uuid.UUID{a}, err: apperr.ErrNilUUID(auth.FieldPresetID)},
+		{name: "no users", id: id, err: auth.ErrInvalidPresetUsers()},
+		{
+			name: "too many users", id: id, userIDs: func() []uuid.UUID {
+				ids := make([]uuid.UUID, auth.MaxPresetUsers+1)
+				for i := range ids {
+					ids[i] = uuid.New()
+				}
+				return ids
+			}(),
+			err: auth.ErrInvalidPresetUsers(),
+		},
+		{name: "nil user", id: id, userIDs: []uuid.UUID{a, uuid.Nil}, err: apperr.ErrNilUUID(auth.FieldUserIDs)},
+		{
+			name: "repo error", id: id, userIDs: []uuid.UUID{a},
+			setup: func(m mock) {
+				m.repo.ApplyPresetMock.Expect(ctx, id, []uuid.UUID{a}).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			core, err := auth.NewCore(m.repo, m.tokenCodec, m.idGen, m.rndGen, m.timeGen, m.pswHasher, cfg())
+			require.NoError(t, err)
+
+			got, err := core.ApplyPreset(ctx, tt.id, auth.ApplyPresetReq{UserIDs: tt.userIDs})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, granted, got)
+		})
+	}
+}
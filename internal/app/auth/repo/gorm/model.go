@@ -1,19 +1,27 @@
 package gorm
 
 import (
+	"strings"
 	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type userSession struct {
 	ID               uuid.UUID
+	WorkspaceID      uuid.UUID
 	UserID           uuid.UUID
 	RefreshTokenHash string `json:"-"`
 	CreatedAt        time.Time
 	ExpiresAt        time.Time
 	SessionVersion   int
+	Scope            string
+	TTLClass         auth.TTLClass
+	IP               string
+	UserAgent        string
+	FlaggedAt        *time.Time
 }
 
 func (s *userSession) toDTO() auth.Session {
@@ -23,13 +31,18 @@ func (s *userSession) toDTO() auth.Session {
 		CreatedAt:      s.CreatedAt,
 		ExpiresAt:      s.ExpiresAt,
 		SessionVersion: s.SessionVersion,
+		Scopes:         lo.Map(strings.Fields(s.Scope), func(f string, _ int) auth.Scope { return auth.Scope(f) }),
+		TTLClass:       s.TTLClass,
+		Client:         auth.Client{IP: s.IP, UserAgent: s.UserAgent},
+		FlaggedAt:      s.FlaggedAt,
 	}
 }
 
 type userRole struct {
-	UserID   uuid.UUID
-	Role     auth.Role
-	EntityID *uuid.UUID
+	WorkspaceID uuid.UUID
+	UserID      uuid.UUID
+	Role        auth.Role
+	EntityID    *uuid.UUID
 }
 
 func (u *userRole) toDTO() auth.UserRole {
@@ -40,6 +53,20 @@ func (u *userRole) toDTO() auth.UserRole {
 	}
 }
 
+type orphanedGrant struct {
+	UserID   uuid.UUID
+	Role     auth.Role
+	EntityID *uuid.UUID
+	Reason   auth.OrphanReason
+}
+
+func (m *orphanedGrant) toDTO() auth.OrphanedGrant {
+	return auth.OrphanedGrant{
+		UserRole: auth.UserRole{UserID: m.UserID, Role: m.Role, EntityID: m.EntityID},
+		Reason:   m.Reason,
+	}
+}
+
 func userRoleFromDTO(dto auth.UserRole) userRole {
 	return userRole{
 		UserID:   dto.UserID,
@@ -47,3 +74,78 @@ func userRoleFromDTO(dto auth.UserRole) userRole {
 		EntityID: dto.EntityID,
 	}
 }
+
+const (
+	eventRoleGranted = "role_granted"
+	eventRoleRevoked = "role_revoked"
+)
+
+// roleEvent is a row of the entity event log, written when a role on an entity is granted or revoked.
+type roleEvent struct {
+	EntityID  uuid.UUID
+	Type      string
+	ActorID   *uuid.UUID
+	SubjectID uuid.UUID
+	Role      auth.Role
+}
+
+func (m *roleEvent) TableName() string {
+	return "entity_events"
+}
+
+type loginEvent struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	IP        string
+	UserAgent string
+	Success   bool
+	NewDevice bool
+	CreatedAt time.Time
+}
+
+func (m *loginEvent) toDTO() auth.LoginEvent {
+	return auth.LoginEvent{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Client:    auth.Client{IP: m.IP, UserAgent: m.UserAgent},
+		Success:   m.Success,
+		NewDevice: m.NewDevice,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func loginEventFromDTO(dto auth.LoginEvent) loginEvent {
+	return loginEvent{
+		ID:        dto.ID,
+		UserID:    dto.UserID,
+		IP:        dto.IP,
+		UserAgent: dto.UserAgent,
+		Success:   dto.Success,
+		NewDevice: dto.NewDevice,
+		CreatedAt: dto.CreatedAt,
+	}
+}
+
+type rolePreset struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (m *rolePreset) toDTO(grants []auth.PresetGrant) auth.RolePreset {
+	return auth.RolePreset{ID: m.ID, Name: m.Name, Grants: grants, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt}
+}
+
+type rolePresetGrant struct {
+	PresetID uuid.UUID
+	Role     auth.Role
+	EntityID *uuid.UUID
+}
+
+func presetGrantModels(preset auth.RolePreset) []rolePresetGrant {
+	return lo.Map(preset.Grants, func(g auth.PresetGrant, _ int) rolePresetGrant {
+		return rolePresetGrant{PresetID: preset.ID, Role: g.Role, EntityID: g.EntityID}
+	})
+}
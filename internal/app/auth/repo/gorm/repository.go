@@ -7,6 +7,7 @@ import (
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -33,11 +34,16 @@ func NewRepository(db *gorm.DB) (*gormRepo, error) {
 func (r *gormRepo) CreateSession(ctx context.Context, req auth.Session, rtHash string) error {
 	model := &userSession{
 		ID:               req.ID,
+		WorkspaceID:      contextx.WorkspaceID(ctx),
 		UserID:           req.UserID,
 		RefreshTokenHash: rtHash,
 		CreatedAt:        req.CreatedAt,
 		ExpiresAt:        req.ExpiresAt,
 		SessionVersion:   req.SessionVersion,
+		Scope:            req.Scopes.String(),
+		TTLClass:         req.TTLClass,
+		IP:               req.Client.IP,
+		UserAgent:        req.Client.UserAgent,
 	}
 
 	err := r.db.WithContext(ctx).Create(model).Error
@@ -51,7 +57,7 @@ func (r *gormRepo) CreateSession(ctx context.Context, req auth.Session, rtHash s
 func (r *gormRepo) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]auth.Session, error) {
 	models := make([]userSession, 0)
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("user_id = ?", userID).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.GetSessionsByUserID: %w", err)
 	}
@@ -61,7 +67,7 @@ func (r *gormRepo) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([
 
 func (r *gormRepo) GetSessionByID(ctx context.Context, id uuid.UUID) (auth.Session, string, error) {
 	var model userSession
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = ErrSessionNotFound
@@ -75,7 +81,7 @@ func (r *gormRepo) GetSessionByID(ctx context.Context, id uuid.UUID) (auth.Sessi
 func (r *gormRepo) DeleteSessionByIDAndUser(ctx context.Context, id, userID uuid.UUID) error {
 	model := &userSession{ID: id}
 
-	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?",
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ? AND user_id = ?",
 		id, userID).Delete(model)
 	if result.Error != nil {
 		return fmt.Errorf("gormRepo.DeleteSessionByIDAndUser: %w", result.Error)
@@ -90,7 +96,7 @@ func (r *gormRepo) DeleteSessionByIDAndUser(ctx context.Context, id, userID uuid
 func (r *gormRepo) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error {
 	model := &userSession{}
 
-	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(model)
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("user_id = ?", userID).Delete(model)
 	if result.Error != nil {
 		return fmt.Errorf("gormRepo.DeleteSessionsByUserID: %w", result.Error)
 	}
@@ -100,10 +106,14 @@ func (r *gormRepo) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID)
 
 func (r *gormRepo) UpdateRefreshToken(ctx context.Context, req auth.UpdateTokenReq) error {
 	model := &userSession{}
+	updates := map[string]interface{}{"refresh_token_hash": req.RefreshTokenHash, "expires_at": req.ExpiresAt}
+	if req.FlaggedAt != nil {
+		updates["flagged_at"] = *req.FlaggedAt
+	}
 
-	result := r.db.WithContext(ctx).Model(model).Where("id = ? AND refresh_token_hash = ? AND user_id = ?",
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Model(model).Where("id = ? AND refresh_token_hash = ? AND user_id = ?",
 		req.SessionID, req.OldRefreshTokenHash, req.UserID).
-		Updates(map[string]interface{}{"refresh_token_hash": req.RefreshTokenHash, "expires_at": req.ExpiresAt})
+		Updates(updates)
 	if result.Error != nil {
 		return fmt.Errorf("gormRepo.UpdateRefreshToken: %w", result.Error)
 	}
@@ -114,8 +124,42 @@ func (r *gormRepo) UpdateRefreshToken(ctx context.Context, req auth.UpdateTokenR
 	return nil
 }
 
+// AddUserRole grants only users and entities of the workspace of ctx; the foreign keys alone would
+// accept those of any workspace. Grants on an entity are logged in its event log. It joins the
+// transaction of ctx, if any.
 func (r *gormRepo) AddUserRole(ctx context.Context, req auth.UserRole) error {
-	if err := r.db.WithContext(ctx).Create(userRoleFromDTO(req)).Error; err != nil {
+	model := userRoleFromDTO(req)
+	model.WorkspaceID = contextx.WorkspaceID(ctx)
+
+	err := db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Table("users").Where("id = ? AND workspace_id = ?", req.UserID, model.WorkspaceID).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return auth.ErrRoleTargetNotFound()
+		}
+		if req.EntityID != nil {
+			err = tx.Table("entities").Where("id = ? AND workspace_id = ?", *req.EntityID, model.WorkspaceID).Count(&count).Error
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				return auth.ErrRoleTargetNotFound()
+			}
+		}
+
+		if err = tx.Create(&model).Error; err != nil {
+			return err
+		}
+		if req.EntityID != nil {
+			return tx.Create(newRoleEvent(ctx, eventRoleGranted, req)).Error
+		}
+
+		return nil
+	})
+	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
 			return fmt.Errorf("gormRepo.AddUserRole: %w", auth.ErrDuplicateUserRole())
@@ -129,7 +173,7 @@ func (r *gormRepo) AddUserRole(ctx context.Context, req auth.UserRole) error {
 func (r *gormRepo) GetUserRoles(ctx context.Context, userID uuid.UUID, roles []auth.Role) ([]auth.UserRole, error) {
 	models := make([]userRole, 0)
 
-	err := r.db.WithContext(ctx).Where("user_id = ? AND role IN ?", userID, roles).Find(&models).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("user_id = ? AND role IN ?", userID, roles).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.GetUserRoles: %w", err)
 	}
@@ -140,7 +184,7 @@ func (r *gormRepo) GetUserRoles(ctx context.Context, userID uuid.UUID, roles []a
 func (r *gormRepo) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.UserRole, error) {
 	models := make([]userRole, 0)
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("user_id = ?", userID).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.ListUserRoles: %w", err)
 	}
@@ -148,21 +192,405 @@ func (r *gormRepo) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.
 	return lo.Map(models, func(ur userRole, _ int) auth.UserRole { return ur.toDTO() }), nil
 }
 
+// DeleteUserRoles logs the revocation of the roles on entities in their event logs before deleting them.
+func (r *gormRepo) DeleteUserRoles(ctx context.Context, userID uuid.UUID) (int64, error) {
+	conn := db.Conn(ctx, r.db)
+	err := conn.Exec(`
+INSERT INTO entity_events (entity_id, type, actor_id, subject_id, role)
+SELECT entity_id, ?, ?, user_id, role
+FROM user_roles
+WHERE user_id = ? AND entity_id IS NOT NULL AND ?`, eventRoleRevoked, actorID(ctx), userID, db.WorkspaceCond(ctx, "workspace_id")).Error
+	if err != nil {
+		return 0, fmt.Errorf("gormRepo.DeleteUserRoles: %w", err)
+	}
+
+	result := conn.Scopes(db.InWorkspace(ctx)).Where("user_id = ?", userID).Delete(&userRole{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("gormRepo.DeleteUserRoles: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// orphanedGrants gives every grant of the workspace its row_id and the reason it is orphaned, if any.
+// A grant gets one reason, the first that applies in the order user_deleted, entity_deleted,
+// unreachable, duplicate; of repeated grants the first copy is kept. Reachability walks parent_id
+// down from the live roots rather than trusting path, so it also holds after parents were edited
+// by hand, cycles included.
+const orphanedGrants = `
+WITH RECURSIVE
+    reachable AS (
+        SELECT id
+        FROM entities
+        WHERE parent_id ISNULL AND @active AND @entity_workspace
+
+        UNION
+
+        SELECT c.id
+        FROM reachable r
+        JOIN entities c ON c.parent_id = r.id AND @active_child
+    ),
+    ranked AS (
+        SELECT ur.ctid AS row_id, ur.user_id, ur.role, ur.entity_id,
+               ROW_NUMBER() OVER (PARTITION BY ur.workspace_id, ur.user_id, ur.role, ur.entity_id ORDER BY ur.ctid) AS copy
+        FROM user_roles ur
+        WHERE @workspace
+    ),
+    orphaned AS (
+        SELECT r.row_id, r.user_id, r.role, r.entity_id,
+               CASE
+                   WHEN u.id ISNULL OR u.deleted_at IS NOT NULL THEN @user_deleted
+                   WHEN r.entity_id IS NOT NULL AND (e.id ISNULL OR e.deleted_at IS NOT NULL) THEN @entity_deleted
+                   WHEN r.entity_id IS NOT NULL AND x.id ISNULL THEN @unreachable
+                   WHEN r.copy > 1 THEN @duplicate
+               END AS reason
+        FROM ranked r
+        LEFT JOIN users u ON u.id = r.user_id
+        LEFT JOIN entities e ON e.id = r.entity_id
+        LEFT JOIN reachable x ON x.id = r.entity_id
+    )
+`
+
+func orphanedGrantsArgs(ctx context.Context) map[string]any {
+	return map[string]any{
+		"workspace":        db.WorkspaceCond(ctx, "ur.workspace_id"),
+		"entity_workspace": db.WorkspaceCond(ctx, "workspace_id"),
+		"active":           db.ActiveCond("deleted_at"),
+		"active_child":     db.ActiveCond("c.deleted_at"),
+		"user_deleted":     auth.OrphanUserDeleted,
+		"entity_deleted":   auth.OrphanEntityDeleted,
+		"unreachable":      auth.OrphanUnreachable,
+		"duplicate":        auth.OrphanDuplicate,
+	}
+}
+
+func (r *gormRepo) GetOrphanedGrants(ctx context.Context) ([]auth.OrphanedGrant, error) {
+	var models []orphanedGrant
+
+	err := r.db.WithContext(ctx).Raw(orphanedGrants+`
+SELECT user_id, role, entity_id, reason
+FROM orphaned
+WHERE reason IS NOT NULL
+ORDER BY user_id, role, entity_id, reason`, orphanedGrantsArgs(ctx)).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetOrphanedGrants: %w", err)
+	}
+
+	return lo.Map(models, func(m orphanedGrant, _ int) auth.OrphanedGrant { return m.toDTO() }), nil
+}
+
+// DeleteOrphanedGrants finds and deletes the grants in one statement, so a repair racing with grant
+// changes removes exactly the grants it returns. Unlike DeleteUserRole it logs no role_revoked
+// events; the service audits the repair as a whole.
+func (r *gormRepo) DeleteOrphanedGrants(ctx context.Context) ([]auth.OrphanedGrant, error) {
+	var models []orphanedGrant
+
+	err := r.db.WithContext(ctx).Raw(orphanedGrants+`,
+    deleted AS (
+        DELETE FROM user_roles ur
+        USING orphaned o
+        WHERE ur.ctid = o.row_id AND o.reason IS NOT NULL
+        RETURNING o.user_id, o.role, o.entity_id, o.reason
+    )
+SELECT user_id, role, entity_id, reason
+FROM deleted
+ORDER BY user_id, role, entity_id, reason`, orphanedGrantsArgs(ctx)).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.DeleteOrphanedGrants: %w", err)
+	}
+
+	return lo.Map(models, func(m orphanedGrant, _ int) auth.OrphanedGrant { return m.toDTO() }), nil
+}
+
+// DeleteUserRole logs revocations of roles on an entity in its event log. It joins the transaction of
+// ctx, if any.
 func (r *gormRepo) DeleteUserRole(ctx context.Context, req auth.UserRole) error {
-	var result *gorm.DB
-	if req.EntityID == nil {
-		result = r.db.WithContext(ctx).Where("user_id = ? AND role = ? AND entity_id IS NULL",
-			req.UserID, req.Role).Delete(&userRole{})
-	} else {
-		result = r.db.WithContext(ctx).Where("user_id = ? AND role = ? AND entity_id = ?",
-			req.UserID, req.Role, req.EntityID).Delete(&userRole{})
+	err := db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var result *gorm.DB
+		if req.EntityID == nil {
+			result = tx.Scopes(db.InWorkspace(ctx)).Where("user_id = ? AND role = ? AND entity_id IS NULL",
+				req.UserID, req.Role).Delete(&userRole{})
+		} else {
+			result = tx.Scopes(db.InWorkspace(ctx)).Where("user_id = ? AND role = ? AND entity_id = ?",
+				req.UserID, req.Role, req.EntityID).Delete(&userRole{})
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrRoleNotFound
+		}
+		if req.EntityID != nil {
+			return tx.Create(newRoleEvent(ctx, eventRoleRevoked, req)).Error
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			return ErrRoleNotFound
+		}
+		return fmt.Errorf("gormRepo.DeleteUserRole: %w", err)
+	}
+
+	return nil
+}
+
+func newRoleEvent(ctx context.Context, eventType string, req auth.UserRole) *roleEvent {
+	return &roleEvent{
+		EntityID:  *req.EntityID,
+		Type:      eventType,
+		ActorID:   actorID(ctx),
+		SubjectID: req.UserID,
+		Role:      req.Role,
+	}
+}
+
+// actorID is the user making the change, nil outside of requests, as in the CLI.
+func actorID(ctx context.Context) *uuid.UUID {
+	id, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
+
+func (r *gormRepo) CreateLoginEvent(ctx context.Context, event auth.LoginEvent) error {
+	model := loginEventFromDTO(event)
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return fmt.Errorf("gormRepo.CreateLoginEvent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetKnownClient(ctx context.Context, userID uuid.UUID, client auth.Client) (auth.KnownClient, error) {
+	var known struct {
+		HasAny    bool
+		IP        bool
+		UserAgent bool
+	}
+
+	err := r.db.WithContext(ctx).Raw(`
+SELECT COUNT(*) > 0                              AS has_any,
+       COALESCE(BOOL_OR(ip = ?), FALSE)         AS ip,
+       COALESCE(BOOL_OR(user_agent = ?), FALSE) AS user_agent
+FROM login_events
+WHERE user_id = ? AND success`, client.IP, client.UserAgent, userID).Scan(&known).Error
+	if err != nil {
+		return auth.KnownClient{}, fmt.Errorf("gormRepo.GetKnownClient: %w", err)
+	}
+
+	return auth.KnownClient{Any: known.HasAny, IP: known.IP, UserAgent: known.UserAgent}, nil
+}
+
+func (r *gormRepo) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]auth.LoginEvent, error) {
+	models := make([]loginEvent, 0)
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetLoginHistory: %w", err)
+	}
+
+	return lo.Map(models, func(m loginEvent, _ int) auth.LoginEvent { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) ListPresets(ctx context.Context) ([]auth.RolePreset, error) {
+	models := make([]rolePreset, 0)
+	if err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Order("lower(name)").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.ListPresets: %w", err)
+	}
+	grants, err := r.presetGrants(ctx, lo.Map(models, func(m rolePreset, _ int) uuid.UUID { return m.ID }))
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.ListPresets: %w", err)
 	}
+
+	return lo.Map(models, func(m rolePreset, _ int) auth.RolePreset { return m.toDTO(grants[m.ID]) }), nil
+}
+
+func (r *gormRepo) GetPreset(ctx context.Context, id uuid.UUID) (auth.RolePreset, error) {
+	var model rolePreset
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = auth.ErrPresetNotFound()
+		}
+		return auth.RolePreset{}, fmt.Errorf("gormRepo.GetPreset: %w", err)
+	}
+	grants, err := r.presetGrants(ctx, []uuid.UUID{id})
+	if err != nil {
+		return auth.RolePreset{}, fmt.Errorf("gormRepo.GetPreset: %w", err)
+	}
+
+	return model.toDTO(grants[id]), nil
+}
+
+// presetGrants returns the grants of the presets by preset, admin grants first, then by entity.
+func (r *gormRepo) presetGrants(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]auth.PresetGrant, error) {
+	grants := make(map[uuid.UUID][]auth.PresetGrant, len(ids))
+	for _, id := range ids {
+		grants[id] = make([]auth.PresetGrant, 0)
+	}
+	if len(ids) == 0 {
+		return grants, nil
+	}
+
+	var models []rolePresetGrant
+	err := r.db.WithContext(ctx).Where("preset_id IN ?", ids).Order("entity_id NULLS FIRST").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		grants[m.PresetID] = append(grants[m.PresetID], auth.PresetGrant{Role: m.Role, EntityID: m.EntityID})
+	}
+
+	return grants, nil
+}
+
+// CreatePreset accepts only entities of the workspace of ctx, as AddUserRole does.
+func (r *gormRepo) CreatePreset(ctx context.Context, preset auth.RolePreset) error {
+	model := rolePreset{
+		ID: preset.ID, WorkspaceID: contextx.WorkspaceID(ctx), Name: preset.Name,
+		CreatedAt: preset.CreatedAt, UpdatedAt: preset.UpdatedAt,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkPresetEntities(ctx, tx, preset.Grants); err != nil {
+			return err
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(presetGrantModels(preset)).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.CreatePreset: %w", presetError(err))
+	}
+
+	return nil
+}
+
+func (r *gormRepo) UpdatePreset(ctx context.Context, preset auth.RolePreset) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&rolePreset{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", preset.ID).
+			Updates(map[string]any{"name": preset.Name, "updated_at": preset.UpdatedAt})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return auth.ErrPresetNotFound()
+		}
+		if err := checkPresetEntities(ctx, tx, preset.Grants); err != nil {
+			return err
+		}
+		if err := tx.Where("preset_id = ?", preset.ID).Delete(&rolePresetGrant{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(presetGrantModels(preset)).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.UpdatePreset: %w", presetError(err))
+	}
+
+	return nil
+}
+
+func checkPresetEntities(ctx context.Context, tx *gorm.DB, grants []auth.PresetGrant) error {
+	ids := lo.FilterMap(grants, func(g auth.PresetGrant, _ int) (uuid.UUID, bool) { return lo.FromPtr(g.EntityID), g.EntityID != nil })
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var count int64
+	err := tx.Table("entities").Where("id IN ? AND workspace_id = ?", ids, contextx.WorkspaceID(ctx)).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if int(count) != len(ids) {
+		return auth.ErrRoleTargetNotFound()
+	}
+
+	return nil
+}
+
+func presetError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
+		return auth.ErrDuplicatePresetName()
+	}
+
+	return err
+}
+
+func (r *gormRepo) DeletePreset(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Delete(&rolePreset{})
 	if result.Error != nil {
-		return fmt.Errorf("gormRepo.DeleteUserRole: %w", result.Error)
+		return fmt.Errorf("gormRepo.DeletePreset: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return ErrRoleNotFound
+		return fmt.Errorf("gormRepo.DeletePreset: %w", auth.ErrPresetNotFound())
 	}
 
 	return nil
 }
+
+// ApplyPreset grants only users of the workspace of ctx. The grants on entities are logged in their
+// event logs, as AddUserRole does.
+func (r *gormRepo) ApplyPreset(ctx context.Context, id uuid.UUID, userIDs []uuid.UUID) ([]auth.UserRole, error) {
+	workspaceID := contextx.WorkspaceID(ctx)
+	var granted []userRole
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&rolePreset{}).Where("id = ? AND workspace_id = ?", id, workspaceID).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return auth.ErrPresetNotFound()
+		}
+		err = tx.Table("users").Where("id IN ? AND workspace_id = ?", userIDs, workspaceID).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if int(count) != len(userIDs) {
+			return auth.ErrRoleTargetNotFound()
+		}
+
+		err = tx.Raw(`
+INSERT INTO user_roles (workspace_id, user_id, role, entity_id)
+SELECT u.workspace_id, u.id, g.role, g.entity_id
+FROM users u
+CROSS JOIN role_preset_grants g
+WHERE u.id IN ? AND g.preset_id = ?
+ON CONFLICT DO NOTHING
+RETURNING workspace_id, user_id, role, entity_id`, userIDs, id).Scan(&granted).Error
+		if err != nil {
+			return err
+		}
+
+		events := lo.FilterMap(granted, func(m userRole, _ int) (*roleEvent, bool) {
+			if m.EntityID == nil {
+				return nil, false
+			}
+			return newRoleEvent(ctx, eventRoleGranted, m.toDTO()), true
+		})
+		if len(events) == 0 {
+			return nil
+		}
+
+		return tx.Create(events).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.ApplyPreset: %w", err)
+	}
+
+	return lo.Map(granted, func(m userRole, _ int) auth.UserRole { return m.toDTO() }), nil
+}
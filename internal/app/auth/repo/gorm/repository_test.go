@@ -7,6 +7,7 @@ import (
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -45,6 +46,8 @@ func TestCreateAndGetSessionByID(t *testing.T) {
 		CreatedAt:      now,
 		ExpiresAt:      now.Add(24 * time.Hour),
 		SessionVersion: 1,
+		Scopes:         auth.Scopes{auth.ScopeEntitiesRead, auth.ScopeUsersAdmin},
+		TTLClass:       auth.TTLClassRememberMe,
 	}
 
 	require.NoError(t, repo.CreateSession(t.Context(), sess, "hash-1"))
@@ -197,6 +200,7 @@ func TestUpdateRefreshToken(t *testing.T) {
 		CreatedAt:      now,
 		ExpiresAt:      now.Add(30 * time.Minute),
 		SessionVersion: 1,
+		Client:         auth.Client{IP: "203.0.113.7", UserAgent: "Mozilla/5.0"},
 	}
 	require.NoError(t, repo.CreateSession(t.Context(), s, "old-hash"))
 
@@ -214,6 +218,16 @@ func TestUpdateRefreshToken(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "new-hash", rt)
 	require.WithinDuration(t, req.ExpiresAt, got.ExpiresAt, time.Second)
+	require.Equal(t, s.Client, got.Client)
+	require.Nil(t, got.FlaggedAt)
+
+	// flagged
+	req.OldRefreshTokenHash, req.RefreshTokenHash, req.FlaggedAt = "new-hash", "flagged-hash", &now
+	require.NoError(t, repo.UpdateRefreshToken(t.Context(), req))
+	got, _, err = repo.GetSessionByID(t.Context(), sid)
+	require.NoError(t, err)
+	require.NotNil(t, got.FlaggedAt)
+	require.WithinDuration(t, now, *got.FlaggedAt, time.Second)
 
 	// old hash -> NotFound
 	req2 := auth.UpdateTokenReq{
@@ -330,6 +344,24 @@ func TestDeleteUserRole_WithAndWithoutEntity(t *testing.T) {
 
 	// удаляем оставшуюся
 	require.NoError(t, repo.DeleteUserRole(t.Context(), withoutEnt))
+
+	// only the grant on the entity and its revocation are logged, with the user of ctx as actor
+	var events []struct {
+		Type      string
+		ActorID   *uuid.UUID
+		SubjectID uuid.UUID
+		Role      auth.Role
+	}
+	err = gdb.WithContext(t.Context()).Raw(
+		`SELECT type, actor_id, subject_id, role FROM entity_events WHERE entity_id = $1 AND role IS NOT NULL ORDER BY id`, e1,
+	).Scan(&events).Error
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, eventRoleGranted, events[0].Type)
+	require.Equal(t, eventRoleRevoked, events[1].Type)
+	require.Nil(t, events[0].ActorID)
+	require.Equal(t, u, events[1].SubjectID)
+	require.Equal(t, r, events[1].Role)
 }
 
 func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
@@ -352,8 +384,8 @@ func createEntity(t *testing.T, gdb *gorm.DB, userID uuid.UUID) uuid.UUID {
 
 	eid := uuid.New()
 	err := gdb.WithContext(t.Context()).Exec(
-		`INSERT INTO entities(id,type,created_at,updated_at,name,content,created_by,updated_by)
-		 VALUES ($1,'t',NOW(),NOW(),'name','',$2,$2)`,
+		`INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,created_by,updated_by,owner_id)
+		 VALUES ($1,'t',NOW(),NOW(),'name',$1::TEXT,'',$2,$2,$2)`,
 		eid, userID,
 	).Error
 	require.NoError(t, err)
@@ -369,6 +401,266 @@ func compareSessions(t *testing.T, exp, got auth.Session) {
 	require.WithinDuration(t, exp.CreatedAt, got.CreatedAt, time.Second)
 	require.WithinDuration(t, exp.ExpiresAt, got.ExpiresAt, time.Second)
 	require.Equal(t, exp.SessionVersion, got.SessionVersion)
+	require.ElementsMatch(t, exp.Scopes, got.Scopes)
+	require.Equal(t, exp.TTLClass, got.TTLClass)
+}
+
+func TestDeleteUserRoles(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u, other := createUser(t, gdb), createUser(t, gdb)
+	e := createEntity(t, gdb, u)
+	for _, it := range []auth.UserRole{
+		{UserID: u, Role: auth.RoleAdmin},
+		{UserID: u, Role: auth.RoleRead, EntityID: &e},
+		{UserID: other, Role: auth.RoleRead},
+	} {
+		require.NoError(t, repo.AddUserRole(t.Context(), it))
+	}
+
+	n, err := repo.DeleteUserRoles(contextx.SetUserID(t.Context(), other), u)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+	var revokedBy []uuid.UUID
+	err = gdb.WithContext(t.Context()).Raw(
+		`SELECT actor_id FROM entity_events WHERE entity_id = $1 AND type = $2`, e, eventRoleRevoked,
+	).Scan(&revokedBy).Error
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{other}, revokedBy)
+	got, err := repo.ListUserRoles(t.Context(), u)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	got, err = repo.ListUserRoles(t.Context(), other)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	// nothing left
+	n, err = repo.DeleteUserRoles(t.Context(), u)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	cleanup()
+	_, err = repo.DeleteUserRoles(t.Context(), u)
+	require.Error(t, err)
+}
+
+func TestGetOrphanedGrants(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	live, gone := createUser(t, gdb), createUser(t, gdb)
+	liveEntity, goneEntity := createEntity(t, gdb, live), createEntity(t, gdb, live)
+	grants := []auth.UserRole{
+		{UserID: live, Role: auth.RoleAdmin},
+		{UserID: live, Role: auth.RoleRead, EntityID: &liveEntity},
+		{UserID: live, Role: auth.RoleWrite, EntityID: &goneEntity},
+		{UserID: gone, Role: auth.RoleRead, EntityID: &goneEntity},
+	}
+	for _, it := range grants {
+		require.NoError(t, repo.AddUserRole(t.Context(), it))
+	}
+
+	got, err := repo.GetOrphanedGrants(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	require.NoError(t, gdb.Exec(`UPDATE users SET deleted_at = NOW() WHERE id = ?`, gone).Error)
+	require.NoError(t, gdb.Exec(`UPDATE entities SET deleted_at = NOW() WHERE id = ?`, goneEntity).Error)
+
+	got, err = repo.GetOrphanedGrants(t.Context())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []auth.OrphanedGrant{
+		{UserRole: grants[2], Reason: auth.OrphanEntityDeleted},
+		{UserRole: grants[3], Reason: auth.OrphanUserDeleted},
+	}, got)
+
+	cleanup()
+	_, err = repo.GetOrphanedGrants(t.Context())
+	require.Error(t, err)
+}
+
+func TestDeleteOrphanedGrants(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u, gone := createUser(t, gdb), createUser(t, gdb)
+	root, child, deleted, below, cycleA, cycleB := createEntity(t, gdb, u), createEntity(t, gdb, u),
+		createEntity(t, gdb, u), createEntity(t, gdb, u), createEntity(t, gdb, u), createEntity(t, gdb, u)
+	for id, parent := range map[uuid.UUID]uuid.UUID{child: root, below: deleted, cycleA: cycleB, cycleB: cycleA} {
+		require.NoError(t, gdb.Exec(`UPDATE entities SET parent_id = ? WHERE id = ?`, parent, id).Error)
+	}
+	require.NoError(t, gdb.Exec(`UPDATE entities SET deleted_at = NOW() WHERE id = ?`, deleted).Error)
+	require.NoError(t, gdb.Exec(`UPDATE users SET deleted_at = NOW() WHERE id = ?`, gone).Error)
+
+	kept := []auth.UserRole{
+		{UserID: u, Role: auth.RoleAdmin},
+		{UserID: u, Role: auth.RoleRead, EntityID: &child},
+	}
+	orphaned := []auth.OrphanedGrant{
+		{UserRole: auth.UserRole{UserID: gone, Role: auth.RoleRead, EntityID: &root}, Reason: auth.OrphanUserDeleted},
+		{UserRole: auth.UserRole{UserID: u, Role: auth.RoleWrite, EntityID: &deleted}, Reason: auth.OrphanEntityDeleted},
+		{UserRole: auth.UserRole{UserID: u, Role: auth.RoleWrite, EntityID: &below}, Reason: auth.OrphanUnreachable},
+		{UserRole: auth.UserRole{UserID: u, Role: auth.RoleRead, EntityID: &cycleA}, Reason: auth.OrphanUnreachable},
+		{UserRole: kept[1], Reason: auth.OrphanDuplicate},
+	}
+	for _, it := range kept {
+		require.NoError(t, repo.AddUserRole(t.Context(), it))
+	}
+	for _, it := range orphaned[:4] {
+		require.NoError(t, repo.AddUserRole(t.Context(), it.UserRole))
+	}
+	// a copy of a grant, as left by edits made without the unique index
+	require.NoError(t, gdb.Exec(`DROP INDEX uq_user_roles_scoped`).Error)
+	require.NoError(t, gdb.Exec(`INSERT INTO user_roles (user_id, role, entity_id) VALUES (?, ?, ?)`, u, auth.RoleRead, child).Error)
+
+	got, err := repo.GetOrphanedGrants(t.Context())
+	require.NoError(t, err)
+	require.ElementsMatch(t, orphaned, got)
+
+	got, err = repo.DeleteOrphanedGrants(t.Context())
+	require.NoError(t, err)
+	require.ElementsMatch(t, orphaned, got)
+
+	// one copy of the repeated grant is kept
+	left, err := repo.ListUserRoles(t.Context(), u)
+	require.NoError(t, err)
+	require.ElementsMatch(t, kept, left)
+	got, err = repo.DeleteOrphanedGrants(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	cleanup()
+	_, err = repo.DeleteOrphanedGrants(t.Context())
+	require.Error(t, err)
+}
+
+func TestRolePresets(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u, other := createUser(t, gdb), createUser(t, gdb)
+	docs, specs := createEntity(t, gdb, u), createEntity(t, gdb, u)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	preset := auth.RolePreset{
+		ID: uuid.New(), Name: "Docs Editor",
+		Grants:    []auth.PresetGrant{{Role: auth.RoleWrite, EntityID: &docs}, {Role: auth.RoleRead, EntityID: &specs}},
+		CreatedAt: now, UpdatedAt: now,
+	}
+	require.NoError(t, repo.CreatePreset(t.Context(), preset))
+
+	got, err := repo.GetPreset(t.Context(), preset.ID)
+	require.NoError(t, err)
+	require.Equal(t, preset.ID, got.ID)
+	require.ElementsMatch(t, preset.Grants, got.Grants)
+
+	// names are unique per workspace, ignoring case
+	err = repo.CreatePreset(t.Context(), auth.RolePreset{ID: uuid.New(), Name: "docs editor", Grants: preset.Grants, CreatedAt: now, UpdatedAt: now})
+	require.ErrorIs(t, err, auth.ErrDuplicatePresetName())
+
+	// entities of another workspace are rejected
+	otherCtx := contextx.SetWorkspaceID(t.Context(), uuid.New())
+	err = repo.CreatePreset(otherCtx, auth.RolePreset{ID: uuid.New(), Name: "x", Grants: preset.Grants, CreatedAt: now, UpdatedAt: now})
+	require.ErrorIs(t, err, auth.ErrRoleTargetNotFound())
+
+	admins := auth.RolePreset{ID: uuid.New(), Name: "admins", Grants: []auth.PresetGrant{{Role: auth.RoleAdmin}}, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, repo.CreatePreset(t.Context(), admins))
+	list, err := repo.ListPresets(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{admins.ID, preset.ID}, []uuid.UUID{list[0].ID, list[1].ID})
+
+	// apply skips grants a user already has
+	require.NoError(t, repo.AddUserRole(t.Context(), auth.UserRole{UserID: other, Role: auth.RoleWrite, EntityID: &docs}))
+	granted, err := repo.ApplyPreset(t.Context(), preset.ID, []uuid.UUID{u, other})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []auth.UserRole{
+		{UserID: u, Role: auth.RoleWrite, EntityID: &docs},
+		{UserID: u, Role: auth.RoleRead, EntityID: &specs},
+		{UserID: other, Role: auth.RoleRead, EntityID: &specs},
+	}, granted)
+	var events int64
+	require.NoError(t, gdb.Table("entity_events").Where("type = ?", "role_granted").Count(&events).Error)
+	require.EqualValues(t, 4, events)
+
+	granted, err = repo.ApplyPreset(t.Context(), preset.ID, []uuid.UUID{u})
+	require.NoError(t, err)
+	require.Empty(t, granted)
+	_, err = repo.ApplyPreset(t.Context(), preset.ID, []uuid.UUID{u, uuid.New()})
+	require.ErrorIs(t, err, auth.ErrRoleTargetNotFound())
+	_, err = repo.ApplyPreset(t.Context(), uuid.New(), []uuid.UUID{u})
+	require.ErrorIs(t, err, auth.ErrPresetNotFound())
+
+	// editing and deleting the preset keeps the grants made with it
+	preset.Name, preset.Grants = "Readers", []auth.PresetGrant{{Role: auth.RoleRead, EntityID: &docs}}
+	require.NoError(t, repo.UpdatePreset(t.Context(), preset))
+	got, err = repo.GetPreset(t.Context(), preset.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Readers", got.Name)
+	require.Equal(t, preset.Grants, got.Grants)
+	require.NoError(t, repo.DeletePreset(t.Context(), preset.ID))
+	_, err = repo.GetPreset(t.Context(), preset.ID)
+	require.ErrorIs(t, err, auth.ErrPresetNotFound())
+	require.ErrorIs(t, repo.UpdatePreset(t.Context(), preset), auth.ErrPresetNotFound())
+	require.ErrorIs(t, repo.DeletePreset(t.Context(), preset.ID), auth.ErrPresetNotFound())
+	roles, err := repo.ListUserRoles(t.Context(), u)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+
+	cleanup()
+	_, err = repo.ListPresets(t.Context())
+	require.Error(t, err)
+}
+
+func TestLoginEvents(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u, other := createUser(t, gdb), createUser(t, gdb)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	laptop := auth.Client{IP: "203.0.113.7", UserAgent: "Firefox"}
+	phone := auth.Client{IP: "198.51.100.1", UserAgent: "Safari"}
+
+	// no history yet
+	known, err := repo.GetKnownClient(t.Context(), u, laptop)
+	require.NoError(t, err)
+	require.Equal(t, auth.KnownClient{}, known)
+
+	events := []auth.LoginEvent{
+		{ID: uuid.New(), UserID: u, Client: laptop, Success: true, CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: uuid.New(), UserID: u, Client: phone, Success: false, CreatedAt: now.Add(-time.Hour)},
+		{ID: uuid.New(), UserID: u, Client: auth.Client{IP: laptop.IP, UserAgent: "Chrome"}, Success: true, NewDevice: true, CreatedAt: now},
+		{ID: uuid.New(), UserID: other, Client: phone, Success: true, CreatedAt: now},
+	}
+	for _, e := range events {
+		require.NoError(t, repo.CreateLoginEvent(t.Context(), e))
+	}
+
+	// failed attempts do not make a client known
+	known, err = repo.GetKnownClient(t.Context(), u, phone)
+	require.NoError(t, err)
+	require.Equal(t, auth.KnownClient{Any: true}, known)
+	known, err = repo.GetKnownClient(t.Context(), u, auth.Client{IP: laptop.IP, UserAgent: "Edge"})
+	require.NoError(t, err)
+	require.Equal(t, auth.KnownClient{Any: true, IP: true}, known)
+	known, err = repo.GetKnownClient(t.Context(), u, laptop)
+	require.NoError(t, err)
+	require.Equal(t, auth.KnownClient{Any: true, IP: true, UserAgent: true}, known)
+
+	got, err := repo.GetLoginHistory(t.Context(), u, 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i, want := range []auth.LoginEvent{events[2], events[1]} {
+		require.True(t, want.CreatedAt.Equal(got[i].CreatedAt))
+		got[i].CreatedAt = want.CreatedAt
+		require.Equal(t, want, got[i])
+	}
+
+	cleanup()
+	require.Error(t, repo.CreateLoginEvent(t.Context(), events[0]))
+	_, err = repo.GetKnownClient(t.Context(), u, laptop)
+	require.Error(t, err)
+	_, err = repo.GetLoginHistory(t.Context(), u, 1)
+	require.Error(t, err)
 }
 
 func TestNewRepository(t *testing.T) {
@@ -19,6 +19,12 @@ func ErrRoleForbidsEntity() error {
 		WithViolation(apperr.Violation{Field: FieldEntity, Rule: apperr.RuleForbidden})
 }
 
+// ErrRoleTargetNotFound is returned when the user or the entity of a grant is not in the workspace.
+func ErrRoleTargetNotFound() error {
+	return apperr.New("user or entity of the role not found", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldUserRole, Rule: apperr.RuleNotFound})
+}
+
 type Role string
 
 const (
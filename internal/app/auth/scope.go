@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+)
+
+const FieldScope apperr.Field = "scope"
+
+const (
+	CodeInvalidScope      apperr.Code = "auth/invalid_scope"
+	CodeInsufficientScope apperr.Code = "auth/insufficient_scope"
+)
+
+func init() {
+	apperr.Register(CodeInvalidScope, "Invalid scope", apperr.ClassBadRequest)
+	apperr.Register(CodeInsufficientScope, "Insufficient scope", apperr.ClassForbidden)
+}
+
+func ErrInvalidScope() error {
+	return apperr.New("invalid scope", CodeInvalidScope, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldScope, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrScopeNotGranted is returned when a refresh asks for more than the session was granted at login.
+func ErrScopeNotGranted() error {
+	return apperr.New("scope exceeds the scope of the session", CodeInvalidScope, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldScope, Rule: apperr.RuleForbidden})
+}
+
+func ErrInsufficientScope(required Scope) error {
+	return apperr.New("token scope does not allow this request", CodeInsufficientScope, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithDetail("required scope: " + required.String())
+}
+
+// Scope limits what an access token can be used for, on top of the roles of its user.
+type Scope string
+
+const (
+	ScopeEntitiesRead  Scope = "entities:read"
+	ScopeEntitiesWrite Scope = "entities:write"
+	// ScopeUsersAdmin covers accounts, sessions, roles, workspaces and the admin endpoints.
+	ScopeUsersAdmin Scope = "users:admin"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+func (s Scope) Validate() error {
+	switch s {
+	case ScopeEntitiesRead, ScopeEntitiesWrite, ScopeUsersAdmin:
+		return nil
+	default:
+		return ErrInvalidScope()
+	}
+}
+
+// Scopes is the scope of a token or session. Empty means every scope: tokens issued before scopes
+// existed, and logins that ask for no particular scope, keep full access.
+type Scopes []Scope
+
+// ParseScopes reads a space-separated list, as in the scope parameter of OAuth 2.0.
+func ParseScopes(s string) (Scopes, error) {
+	var scopes Scopes
+	for _, f := range strings.Fields(s) {
+		scope := Scope(f)
+		if err := scope.Validate(); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
+
+func (s Scopes) String() string {
+	parts := make([]string, len(s))
+	for i, scope := range s {
+		parts[i] = scope.String()
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Allows tells whether the scope grants required. Writing entities implies reading them.
+func (s Scopes) Allows(required Scope) bool {
+	if len(s) == 0 || slices.Contains(s, required) {
+		return true
+	}
+
+	return required == ScopeEntitiesRead && slices.Contains(s, ScopeEntitiesWrite)
+}
+
+// Within tells whether every scope of s is granted by granted.
+func (s Scopes) Within(granted Scopes) bool {
+	if len(s) == 0 {
+		return len(granted) == 0
+	}
+	for _, scope := range s {
+		if !granted.Allows(scope) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,96 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  auth.Scopes
+		err   error
+	}{
+		{name: "empty", input: ""},
+		{name: "one", input: "entities:read", want: auth.Scopes{auth.ScopeEntitiesRead}},
+		{
+			name:  "several with duplicates",
+			input: " entities:write  users:admin entities:write",
+			want:  auth.Scopes{auth.ScopeEntitiesWrite, auth.ScopeUsersAdmin},
+		},
+		{name: "unknown", input: "entities:read entities:delete", err: auth.ErrInvalidScope()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := auth.ParseScopes(tt.input)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScopes_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		scopes   auth.Scopes
+		required auth.Scope
+		want     bool
+	}{
+		{name: "empty is full scope", required: auth.ScopeUsersAdmin, want: true},
+		{name: "granted", scopes: auth.Scopes{auth.ScopeUsersAdmin}, required: auth.ScopeUsersAdmin, want: true},
+		{name: "write implies read", scopes: auth.Scopes{auth.ScopeEntitiesWrite}, required: auth.ScopeEntitiesRead, want: true},
+		{name: "read does not imply write", scopes: auth.Scopes{auth.ScopeEntitiesRead}, required: auth.ScopeEntitiesWrite},
+		{name: "other scope", scopes: auth.Scopes{auth.ScopeEntitiesWrite}, required: auth.ScopeUsersAdmin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.scopes.Allows(tt.required))
+		})
+	}
+}
+
+func TestScopes_Within(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		scopes  auth.Scopes
+		granted auth.Scopes
+		want    bool
+	}{
+		{name: "full within full", want: true},
+		{name: "part within full", scopes: auth.Scopes{auth.ScopeEntitiesRead}, want: true},
+		{name: "full not within part", granted: auth.Scopes{auth.ScopeEntitiesRead}},
+		{
+			name:    "read within write",
+			scopes:  auth.Scopes{auth.ScopeEntitiesRead},
+			granted: auth.Scopes{auth.ScopeEntitiesWrite},
+			want:    true,
+		},
+		{
+			name:    "wider",
+			scopes:  auth.Scopes{auth.ScopeEntitiesRead, auth.ScopeUsersAdmin},
+			granted: auth.Scopes{auth.ScopeEntitiesWrite},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.scopes.Within(tt.granted))
+		})
+	}
+}
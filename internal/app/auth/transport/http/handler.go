@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/auth/usecase"
@@ -17,22 +18,46 @@ import (
 
 const (
 	URLParamSessionID = "session_id"
+	URLParamPresetID  = "preset_id"
+	QueryParamLimit   = "limit"
+	QueryParamDryRun  = "dry_run"
 )
 
 type AuthService interface {
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]auth.Session, error)
 	DeleteSession(ctx context.Context, userID, id uuid.UUID) error
 	DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error
+	Logout(ctx context.Context) error
+	Impersonate(ctx context.Context, userID uuid.UUID) (auth.ImpersonationToken, error)
 	AddUserRole(ctx context.Context, role auth.UserRole) error
 	DeleteUserRole(ctx context.Context, role auth.UserRole) error
 	ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.UserRole, error)
-	RefreshTokens(ctx context.Context, refreshToken auth.RefreshToken) (auth.Tokens, error)
+	GetConsistencyReport(ctx context.Context) (auth.ConsistencyReport, error)
+	RepairGrants(ctx context.Context, dryRun bool) (auth.ConsistencyReport, error)
+	ListPresets(ctx context.Context) ([]auth.RolePreset, error)
+	GetPreset(ctx context.Context, id uuid.UUID) (auth.RolePreset, error)
+	CreatePreset(ctx context.Context, req auth.RolePresetReq) (auth.RolePreset, error)
+	UpdatePreset(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) error
+	DeletePreset(ctx context.Context, id uuid.UUID) error
+	ApplyPreset(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) ([]auth.UserRole, error)
+	GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]auth.LoginEvent, error)
+	RefreshTokens(ctx context.Context, req usecase.RefreshCmd) (auth.Tokens, error)
 	Login(ctx context.Context, req usecase.LoginCmd) (auth.Tokens, error)
 }
 
 type LoginInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// Scope is a space-separated list of scopes, e.g. "entities:read"; empty requests every scope.
+	Scope string `json:"scope"`
+	// RememberMe keeps the session for the longer TTL, for personal devices rather than shared ones.
+	RememberMe bool `json:"remember_me"`
+}
+
+type RefreshInput struct {
+	auth.RefreshToken
+	// Scope narrows the new access token to part of the session's scope; empty keeps all of it.
+	Scope string `json:"scope"`
 }
 
 // Handler knows how to decode HTTP → service calls and encode responses.
@@ -55,7 +80,7 @@ func NewHandler(svc AuthService) *Handler {
 // @Produce      json
 // @Param        user_id query string true "User ID"
 // @Success      200 {array} auth.Session
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /sessions [get]
 func (h *Handler) GetSessionsByUserID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -87,7 +112,7 @@ func (h *Handler) GetSessionsByUserID(w http.ResponseWriter, r *http.Request) {
 // @Param        session_id path string true "Session ID"
 // @Param        user_id    query string true "User ID"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /sessions/{session_id} [delete]
 func (h *Handler) DeleteSession(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -128,7 +153,7 @@ func (h *Handler) DeleteSession(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        user_id query string true "User ID"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /sessions [delete]
 func (h *Handler) DeleteSessionsByUserID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -159,7 +184,7 @@ func (h *Handler) DeleteSessionsByUserID(w http.ResponseWriter, r *http.Request)
 // @Accept       json
 // @Param        request body auth.UserRole true "User role payload"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /roles [post]
 func (h *Handler) AddUserRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -187,7 +212,7 @@ func (h *Handler) AddUserRole(w http.ResponseWriter, r *http.Request) {
 // @Accept       json
 // @Param        request body auth.UserRole true "User role payload"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /roles [delete]
 func (h *Handler) DeleteUserRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -216,7 +241,7 @@ func (h *Handler) DeleteUserRole(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        user_id query string true "User ID"
 // @Success      200 {array} auth.UserRole
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /roles [get]
 func (h *Handler) ListUserRoles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -240,27 +265,325 @@ func (h *Handler) ListUserRoles(w http.ResponseWriter, r *http.Request) {
 	httpx.WriteJSON(ctx, w, http.StatusOK, roles)
 }
 
+// GetConsistencyReport godoc
+// @Summary      Report orphaned role grants
+// @Description  Lists role grants that give no access of their own: grants of deleted users, grants on deleted entities or on entities below a deleted one, and repeated copies of a grant. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} auth.ConsistencyReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/consistency [get]
+func (h *Handler) GetConsistencyReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := h.svc.GetConsistencyReport(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// RepairGrants godoc
+// @Summary      Remove orphaned role grants
+// @Description  Removes the grants GET /admin/consistency lists: grants of deleted users, grants on deleted entities or on entities below a deleted one, and repeated copies of a grant. The grants are removed all or none and returned with repaired set. With dry_run they are only returned. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        dry_run query bool false "Only report the grants that would be removed"
+// @Success      200 {object} auth.ConsistencyReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/consistency/repair [post]
+func (h *Handler) RepairGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var dryRun bool
+	if v := r.URL.Query().Get(QueryParamDryRun); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamDryRun, v).
+				Msg("auth.Handler.RepairGrants: invalid dry_run")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	report, err := h.svc.RepairGrants(ctx, dryRun)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// ListPresets godoc
+// @Summary      List role presets
+// @Description  Returns the role presets of the workspace ordered by name. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} auth.RolePreset
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets [get]
+func (h *Handler) ListPresets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	presets, err := h.svc.ListPresets(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, presets)
+}
+
+// GetPreset godoc
+// @Summary      Get role preset
+// @Description  Returns the role preset with its grants. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Produce      json
+// @Param        preset_id path string true "Preset ID"
+// @Success      200 {object} auth.RolePreset
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets/{preset_id} [get]
+func (h *Handler) GetPreset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := presetID(w, r, "auth.Handler.GetPreset")
+	if !ok {
+		return
+	}
+
+	preset, err := h.svc.GetPreset(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, preset)
+}
+
+// CreatePreset godoc
+// @Summary      Create role preset
+// @Description  Creates a named set of grants, such as write on one subtree and read on another. entity_id is empty for the admin role and required for the others; a preset has one role per entity. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body auth.RolePresetReq true "Role preset payload"
+// @Success      201 {object} auth.RolePreset
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets [post]
+func (h *Handler) CreatePreset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var input auth.RolePresetReq
+	if err := httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).Msg("auth.Handler.CreatePreset: request json decode failed")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	preset, err := h.svc.CreatePreset(ctx, input)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, preset)
+}
+
+// UpdatePreset godoc
+// @Summary      Update role preset
+// @Description  Replaces the name and the grants of the preset. Grants already made with the preset are kept. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Accept       json
+// @Param        preset_id path string true "Preset ID"
+// @Param        request body auth.RolePresetReq true "Role preset payload"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets/{preset_id} [put]
+func (h *Handler) UpdatePreset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := presetID(w, r, "auth.Handler.UpdatePreset")
+	if !ok {
+		return
+	}
+
+	var input auth.RolePresetReq
+	if err := httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).Msg("auth.Handler.UpdatePreset: request json decode failed")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	if err := h.svc.UpdatePreset(ctx, id, input); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeletePreset godoc
+// @Summary      Delete role preset
+// @Description  Deletes the preset. Grants already made with the preset are kept. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Param        preset_id path string true "Preset ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets/{preset_id} [delete]
+func (h *Handler) DeletePreset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := presetID(w, r, "auth.Handler.DeletePreset")
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeletePreset(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplyPreset godoc
+// @Summary      Apply role preset to users
+// @Description  Grants every role of the preset to every listed user, all or none, and returns the grants made. Grants a user already has are skipped. Requires admin privileges.
+// @Tags         roles
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        preset_id path string true "Preset ID"
+// @Param        request body auth.ApplyPresetReq true "Users to apply the preset to"
+// @Success      200 {array} auth.UserRole
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/role-presets/{preset_id}/apply [post]
+func (h *Handler) ApplyPreset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := presetID(w, r, "auth.Handler.ApplyPreset")
+	if !ok {
+		return
+	}
+
+	var input auth.ApplyPresetReq
+	if err := httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).Msg("auth.Handler.ApplyPreset: request json decode failed")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	granted, err := h.svc.ApplyPreset(ctx, id, input)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, granted)
+}
+
+// presetID parses the preset ID path parameter and writes a bad request when it is malformed.
+func presetID(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamPresetID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(auth.FieldPresetID.String(), idStr).
+			Msg(op + ": invalid preset ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// GetLoginHistory godoc
+// @Summary      List sign-in attempts of a user
+// @Description  Returns the latest successful and failed sign-ins of the user, newest first. new_device marks a sign-in from a user agent or IP address the user had not signed in from before. Requires admin privileges or self-access.
+// @Tags         sessions
+// @Security     BearerAuth
+// @Produce      json
+// @Param        user_id path string true "User ID"
+// @Param        limit query int false "Maximum number of attempts" default(50)
+// @Success      200 {array} auth.LoginEvent
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/login-history [get]
+func (h *Handler) GetLoginHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, user_http.URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(auth.FieldUserID.String(), idStr).
+			Msg("auth.Handler.GetLoginHistory: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	limit := auth.DefaultLoginHistoryLimit
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("auth.Handler.GetLoginHistory: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	events, err := h.svc.GetLoginHistory(ctx, id, limit)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, events)
+}
+
 // RefreshTokens godoc
 // @Summary      Refresh access token
-// @Description  Refreshes the access and refresh tokens using a valid refresh token
+// @Description  Refreshes the access and refresh tokens using a valid refresh token. scope may narrow the access token to part of the scope requested at login. With device binding, a refresh from another user agent or IP address than the login ends the session (auth/device_mismatch) or flags it.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
-// @Param        request body auth.RefreshToken true "Refresh token payload"
+// @Param        request body RefreshInput true "Refresh token payload"
 // @Success      200 {object} auth.Tokens
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /refresh [post]
 func (h *Handler) RefreshTokens(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var input auth.RefreshToken
+	var input RefreshInput
 	if err := httpx.DecodeJSON(r, &input); err != nil {
 		logger.Error(ctx, err).Msg("auth.Handler.RefreshTokens: request json decode failed")
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
+	scopes, err := auth.ParseScopes(input.Scope)
+	if err != nil {
+		logger.Warn(ctx, err).Str(auth.FieldScope.String(), input.Scope).
+			Msg("auth.Handler.RefreshTokens: invalid scope")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
 
-	resp, err := h.svc.RefreshTokens(ctx, input)
+	resp, err := h.svc.RefreshTokens(ctx, usecase.RefreshCmd{
+		RefreshToken: input.RefreshToken,
+		Scopes:       scopes,
+		Client:       auth.Client{IP: httpx.ClientIP(r), UserAgent: r.UserAgent()},
+	})
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
@@ -269,15 +592,67 @@ func (h *Handler) RefreshTokens(w http.ResponseWriter, r *http.Request) {
 	httpx.WriteJSON(ctx, w, http.StatusOK, resp)
 }
 
+// Logout godoc
+// @Summary      Logout
+// @Description  Ends the session of the access token, so its refresh token can no longer be used. The access token itself stays valid until it expires.
+// @Tags         auth
+// @Security     BearerAuth
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /logout [post]
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.svc.Logout(ctx); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Impersonate godoc
+// @Summary      Act as another user
+// @Description  Issues a short-lived access token of the user that also names the admin acting as them; requests made with it are logged with both. There is no refresh token. Requires admin privileges and auth.impersonation.enabled.
+// @Tags         auth
+// @Security     BearerAuth
+// @Produce      json
+// @Param        user_id path string true "User ID"
+// @Success      200 {object} auth.ImpersonationToken
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/impersonate/{user_id} [post]
+func (h *Handler) Impersonate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, user_http.URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(auth.FieldUserID.String(), idStr).
+			Msg("auth.Handler.Impersonate: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	token, err := h.svc.Impersonate(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, token)
+}
+
 // Login godoc
 // @Summary      Login
-// @Description  Authenticate user and get tokens
+// @Description  Authenticate user and get tokens. scope limits the session, e.g. "entities:read"; without it the tokens have every scope. With an LDAP directory configured, email is the directory login, such as a uid, and the user is created on the first sign-in.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        request body LoginInput true "credentials"
+// @Param        X-Captcha-Token header string false "CAPTCHA token, once captcha.login asks for one"
 // @Success      200 {object} auth.Tokens
-// @Failure      400 {object} apperr.appError
+// @Failure      400 {object} apperr.Problem
 // @Router       /login [post]
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -288,9 +663,19 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
+	scopes, err := auth.ParseScopes(input.Scope)
+	if err != nil {
+		logger.Warn(ctx, err).Str(auth.FieldScope.String(), input.Scope).
+			Msg("auth.Handler.Login: invalid scope")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
 	cmd := usecase.LoginCmd{
-		Email:    input.Email,
-		Password: []byte(input.Password),
+		Email:      input.Email,
+		Password:   []byte(input.Password),
+		Client:     auth.Client{IP: httpx.ClientIP(r), UserAgent: r.UserAgent()},
+		Scopes:     scopes,
+		RememberMe: input.RememberMe,
 	}
 	defer secure.ZeroBytes(cmd.Password)
 	input.Password = ""
@@ -7,11 +7,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	auth_http "github.com/66gu1/easygodocs/internal/app/auth/transport/http"
 	"github.com/66gu1/easygodocs/internal/app/auth/transport/http/mocks"
 	"github.com/66gu1/easygodocs/internal/app/auth/usecase"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
@@ -82,8 +85,8 @@ func TestHandler_GetSessionsByUserID(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, sessions, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -155,9 +158,7 @@ func TestHandler_DeleteSession(t *testing.T) {
 
 			require.Equal(t, tc.wantStatus, rr.Code)
 			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -216,14 +217,128 @@ func TestHandler_DeleteSessionsByUserID(t *testing.T) {
 
 			require.Equal(t, tc.wantStatus, rr.Code)
 			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+				requireProblem(t, rr)
 			}
 		})
 	}
 }
 
+func TestHandler_Logout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		wantStatus int
+		setup      func(s *mocks.AuthServiceMock)
+	}{
+		{
+			name: "service error -> 401",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.LogoutMock.Expect(minimock.AnyContext).Return(apperr.ErrUnauthorized())
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "ok -> 204 no content",
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.AuthServiceMock) {
+				s.LogoutMock.Expect(minimock.AnyContext).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+
+			r.Post("/logout", h.Logout)
+
+			req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus != http.StatusNoContent {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_Impersonate(t *testing.T) {
+	t.Parallel()
+
+	validID := uuid.New()
+	token := auth.ImpersonationToken{AccessToken: "access_token", ExpiresAt: time.Now().UTC()}
+	tests := []struct {
+		name       string
+		userIDStr  string
+		wantStatus int
+		setup      func(s *mocks.AuthServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400 and service not called",
+			userIDStr:  "not-a-uuid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "disabled -> 403",
+			userIDStr: validID.String(),
+			setup: func(s *mocks.AuthServiceMock) {
+				s.ImpersonateMock.Expect(minimock.AnyContext, validID).
+					Return(auth.ImpersonationToken{}, auth.ErrImpersonationNotAllowed("impersonation is disabled"))
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "ok -> 200 with token",
+			userIDStr:  validID.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.AuthServiceMock) {
+				s.ImpersonateMock.Expect(minimock.AnyContext, validID).Return(token, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+
+			r.Post("/admin/impersonate/{user_id}", h.Impersonate)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/impersonate/"+tc.userIDStr, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus != http.StatusOK {
+				requireProblem(t, rr)
+				return
+			}
+			var got auth.ImpersonationToken
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+			require.Equal(t, token.AccessToken, got.AccessToken)
+			require.True(t, token.ExpiresAt.Equal(got.ExpiresAt))
+		})
+	}
+}
+
 func TestHandler_AddUserRole(t *testing.T) {
 	t.Parallel()
 
@@ -283,13 +398,12 @@ func TestHandler_AddUserRole(t *testing.T) {
 				bytes.NewReader(tc.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "test-agent")
 			rr := httptest.NewRecorder()
 			r.ServeHTTP(rr, req)
 			require.Equal(t, tc.wantStatus, rr.Code)
 			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -354,13 +468,12 @@ func TestHandler_DeleteUserRole(t *testing.T) {
 				bytes.NewReader(tc.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "test-agent")
 			rr := httptest.NewRecorder()
 			r.ServeHTTP(rr, req)
 			require.Equal(t, tc.wantStatus, rr.Code)
 			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -429,19 +542,309 @@ func TestHandler_ListUserRoles(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, roles, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_GetConsistencyReport(t *testing.T) {
+	t.Parallel()
+
+	report := auth.ConsistencyReport{OrphanedGrants: []auth.OrphanedGrant{
+		{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleRead}, Reason: auth.OrphanUserDeleted},
+	}}
+	tests := []struct {
+		name       string
+		setup      func(s *mocks.AuthServiceMock)
+		wantStatus int
+	}{
+		{
+			name: "service error -> 500",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.GetConsistencyReportMock.Expect(minimock.AnyContext).Return(auth.ConsistencyReport{}, fmt.Errorf("service error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "ok -> 200 with report JSON",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.GetConsistencyReportMock.Expect(minimock.AnyContext).Return(report, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			tc.setup(mock)
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Get("/admin/consistency", h.GetConsistencyReport)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/admin/consistency", nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got auth.ConsistencyReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, report, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_RepairGrants(t *testing.T) {
+	t.Parallel()
+
+	report := auth.ConsistencyReport{OrphanedGrants: []auth.OrphanedGrant{
+		{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleRead}, Reason: auth.OrphanUnreachable},
+	}, Repaired: true}
+	tests := []struct {
+		name       string
+		query      string
+		setup      func(s *mocks.AuthServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "invalid dry_run -> 400",
+			query:      "?dry_run=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error -> 500",
+			query: "",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.RepairGrantsMock.Expect(minimock.AnyContext, false).Return(auth.ConsistencyReport{}, fmt.Errorf("service error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:  "dry run -> 200",
+			query: "?dry_run=true",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.RepairGrantsMock.Expect(minimock.AnyContext, true).Return(auth.ConsistencyReport{OrphanedGrants: report.OrphanedGrants}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "ok -> 200 with report JSON",
+			query: "",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.RepairGrantsMock.Expect(minimock.AnyContext, false).Return(report, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Post("/admin/consistency/repair", h.RepairGrants)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/admin/consistency/repair"+tc.query, nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus != http.StatusOK {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_GetLoginHistory(t *testing.T) {
+	t.Parallel()
+
+	validID := uuid.New()
+	events := []auth.LoginEvent{{ID: uuid.New(), UserID: validID, Client: auth.Client{IP: "192.0.2.1"}, Success: true}}
+	tests := []struct {
+		name       string
+		path       string
+		setup      func(s *mocks.AuthServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "invalid UUID -> 400 and service not called",
+			path:       "/users/not-a-uuid/login-history",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400 and service not called",
+			path:       "/users/" + validID.String() + "/login-history?limit=many",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "service error -> 500",
+			path: "/users/" + validID.String() + "/login-history",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.GetLoginHistoryMock.Expect(minimock.AnyContext, validID, auth.DefaultLoginHistoryLimit).Return(nil, fmt.Errorf("service error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "ok -> 200 with events JSON",
+			path: "/users/" + validID.String() + "/login-history?limit=5",
+			setup: func(s *mocks.AuthServiceMock) {
+				s.GetLoginHistoryMock.Expect(minimock.AnyContext, validID, 5).Return(events, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Get("/users/{user_id}/login-history", h.GetLoginHistory)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got []auth.LoginEvent
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, events, got)
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
 }
 
+func TestHandler_ApplyPreset(t *testing.T) {
+	t.Parallel()
+
+	presetID := uuid.New()
+	entityID := uuid.New()
+	input := auth.ApplyPresetReq{UserIDs: []uuid.UUID{uuid.New()}}
+	granted := []auth.UserRole{{UserID: input.UserIDs[0], Role: auth.RoleRead, EntityID: &entityID}}
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+	tests := []struct {
+		name       string
+		path       string
+		body       []byte
+		setup      func(s *mocks.AuthServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "invalid UUID -> 400 and service not called",
+			path:       "/admin/role-presets/not-a-uuid/apply",
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400 and service not called",
+			path:       "/admin/role-presets/" + presetID.String() + "/apply",
+			body:       []byte("not-a-json"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "service error -> 404",
+			path: "/admin/role-presets/" + presetID.String() + "/apply",
+			body: body,
+			setup: func(s *mocks.AuthServiceMock) {
+				s.ApplyPresetMock.Expect(minimock.AnyContext, presetID, input).Return(nil, auth.ErrPresetNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "ok -> 200 with grants JSON",
+			path: "/admin/role-presets/" + presetID.String() + "/apply",
+			body: body,
+			setup: func(s *mocks.AuthServiceMock) {
+				s.ApplyPresetMock.Expect(minimock.AnyContext, presetID, input).Return(granted, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewAuthServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := auth_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Post("/admin/role-presets/{preset_id}/apply", h.ApplyPreset)
+
+			req := httptest.NewRequest(http.MethodPost, tc.path, bytes.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got []auth.UserRole
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, granted, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_CreatePreset(t *testing.T) {
+	t.Parallel()
+
+	input := auth.RolePresetReq{Name: "Admins", Grants: []auth.PresetGrant{{Role: auth.RoleAdmin}}}
+	preset := auth.RolePreset{ID: uuid.New(), Name: input.Name, Grants: input.Grants}
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	mock := mocks.NewAuthServiceMock(t)
+	mock.CreatePresetMock.Expect(minimock.AnyContext, input).Return(preset, nil)
+	h := auth_http.NewHandler(mock)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/role-presets", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	h.CreatePreset(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var got auth.RolePreset
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(t, preset, got)
+}
+
 func TestHandler_RefreshTokens(t *testing.T) {
 	t.Parallel()
 
-	req := auth.RefreshToken{
-		SessionID: uuid.New(),
-		Token:     "refresh",
+	input := auth_http.RefreshInput{
+		RefreshToken: auth.RefreshToken{SessionID: uuid.New(), Token: "refresh"},
+		Scope:        "entities:read",
+	}
+	req := usecase.RefreshCmd{
+		RefreshToken: input.RefreshToken,
+		Scopes:       auth.Scopes{auth.ScopeEntitiesRead},
+		// httptest.NewRequest comes from 192.0.2.1:1234
+		Client: auth.Client{IP: "192.0.2.1", UserAgent: "test-agent"},
 	}
 	resp := auth.Tokens{
 		AccessToken: "new-access",
@@ -450,7 +853,7 @@ func TestHandler_RefreshTokens(t *testing.T) {
 			Token:     "new-refresh",
 		},
 	}
-	body, err := json.Marshal(req)
+	body, err := json.Marshal(input)
 	require.NoError(t, err)
 	tests := []struct {
 		name       string
@@ -463,6 +866,11 @@ func TestHandler_RefreshTokens(t *testing.T) {
 			body:       []byte("not-a-json"),
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "invalid scope -> 400 and service not called",
+			body:       []byte(`{"session_id":"` + input.SessionID.String() + `","token":"refresh","scope":"entities:delete"}`),
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "service error -> 500",
 			body:       body,
@@ -498,6 +906,7 @@ func TestHandler_RefreshTokens(t *testing.T) {
 				bytes.NewReader(tc.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "test-agent")
 			rr := httptest.NewRecorder()
 			r.ServeHTTP(rr, req)
 			require.Equal(t, tc.wantStatus, rr.Code)
@@ -509,8 +918,8 @@ func TestHandler_RefreshTokens(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, resp, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -520,12 +929,18 @@ func TestHandler_Login(t *testing.T) {
 	t.Parallel()
 
 	input := auth_http.LoginInput{
-		Email:    "mail",
-		Password: "pass",
+		Email:      "mail",
+		Password:   "pass",
+		Scope:      "entities:write users:admin",
+		RememberMe: true,
 	}
 	req := usecase.LoginCmd{
 		Email:    input.Email,
 		Password: []byte(input.Password),
+		// httptest.NewRequest comes from 192.0.2.1:1234
+		Client:     auth.Client{IP: "192.0.2.1", UserAgent: "test-agent"},
+		Scopes:     auth.Scopes{auth.ScopeEntitiesWrite, auth.ScopeUsersAdmin},
+		RememberMe: true,
 	}
 	resp := auth.Tokens{
 		AccessToken: "new-access",
@@ -548,6 +963,11 @@ func TestHandler_Login(t *testing.T) {
 			body:       []byte("not-a-json"),
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "invalid scope -> 400 and service not called",
+			body:       []byte(`{"email":"mail","password":"pass","scope":"everything"}`),
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "service error -> 500",
 			body:       body,
@@ -583,6 +1003,7 @@ func TestHandler_Login(t *testing.T) {
 				bytes.NewReader(tc.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "test-agent")
 			rr := httptest.NewRecorder()
 			r.ServeHTTP(rr, req)
 			require.Equal(t, tc.wantStatus, rr.Code)
@@ -594,9 +1015,19 @@ func TestHandler_Login(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, resp, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
 }
+
+func requireProblem(t *testing.T, rr *httptest.ResponseRecorder) {
+	t.Helper()
+	require.Equal(t, httpx.ProblemContentType, rr.Header().Get("Content-Type"))
+	var problem apperr.Problem
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	require.Equal(t, rr.Code, problem.Status)
+	require.Equal(t, apperr.TypeURI(problem.Code), problem.Type)
+	require.NotEmpty(t, problem.Title)
+}
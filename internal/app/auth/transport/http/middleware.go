@@ -1,6 +1,9 @@
 package http
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -10,12 +13,18 @@ import (
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+const QueryParamAccessToken = "access_token"
+
+type scopesKey struct{}
+
 type TokenCodec interface {
 	ParseToken(tokenStr string, claims jwt.Claims) error
+	Leeway() time.Duration
 }
 
 // AuthMiddleware parses and validates JWT from Authorization header
@@ -37,6 +46,11 @@ func AuthMiddleware(codec TokenCodec) func(http.Handler) http.Handler {
 			if err != nil {
 				logger.Error(ctx, err).
 					Msg("auth.AuthMiddleware: invalid token")
+				// expired and not yet valid tokens keep their codes, so clients know whether to refresh
+				if errors.Is(err, secure.ErrTokenExpired()) || errors.Is(err, secure.ErrTokenNotYetValid()) {
+					httpx.ReturnError(ctx, w, err)
+					return
+				}
 				httpx.ReturnError(ctx, w, apperr.ErrUnauthorized())
 				return
 			}
@@ -72,18 +86,141 @@ func AuthMiddleware(codec TokenCodec) func(http.Handler) http.Handler {
 				return
 			}
 
-			if !claims.ExpiresAt.After(time.Now().UTC()) {
-				err = apperr.ErrUnauthorized().WithDetail("token is expired")
+			// sessions live in one workspace, so a token is not accepted in another
+			if claims.WID != contextx.WorkspaceID(ctx).String() {
+				err = apperr.ErrUnauthorized().WithDetail("token was issued for another workspace")
+				logger.Warn(ctx, err).
+					Str("wid", claims.WID).
+					Msg("auth.AuthMiddleware: invalid token claims.WID")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			if !claims.ExpiresAt.Add(codec.Leeway()).After(time.Now().UTC()) {
+				err = secure.ErrTokenExpired()
 				logger.Error(ctx, err).
 					Msg("auth.AuthMiddleware: token is expired")
 				httpx.ReturnError(ctx, w, err)
 				return
 			}
 
+			scopes, err := auth.ParseScopes(claims.Scope)
+			if err != nil {
+				logger.Warn(ctx, err).
+					Str("scope", claims.Scope).
+					Msg("auth.AuthMiddleware: invalid token claims.Scope")
+				httpx.ReturnError(ctx, w, apperr.ErrUnauthorized())
+				return
+			}
+
 			ctx = contextx.SetUserID(ctx, userID)
 			ctx = contextx.SetSessionID(ctx, sessionID)
+			ctx = context.WithValue(ctx, scopesKey{}, scopes)
+
+			if claims.ACT != "" {
+				actorID, err := uuid.Parse(claims.ACT)
+				if err != nil || actorID == uuid.Nil {
+					logger.Warn(ctx, err).
+						Str("act", claims.ACT).
+						Msg("auth.AuthMiddleware: invalid token claims.ACT")
+					httpx.ReturnError(ctx, w, apperr.ErrUnauthorized())
+					return
+				}
+				ctx = contextx.SetActorID(ctx, actorID)
+			}
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// RequireScope rejects requests whose token was not granted scope. It must run after AuthMiddleware.
+func RequireScope(scope auth.Scope) func(http.Handler) http.Handler {
+	return RequireReadWriteScope(scope, scope)
+}
+
+// RequireReadWriteScope requires read for GET, HEAD and OPTIONS requests and write for the others.
+// It must run after AuthMiddleware.
+func RequireReadWriteScope(read, write auth.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			required := write
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				required = read
+			}
+
+			scopes, ok := ctx.Value(scopesKey{}).(auth.Scopes)
+			if !ok {
+				err := apperr.ErrUnauthorized().WithDetail("token scope not found in context")
+				logger.Error(ctx, err).Msg("auth.RequireReadWriteScope: missing scopes")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+			if !scopes.Allows(required) {
+				err := auth.ErrInsufficientScope(required)
+				logger.Warn(ctx, err).
+					Str("scope", scopes.String()).
+					Msg("auth.RequireReadWriteScope: insufficient scope")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RoleChecker checks the workspace-wide standing of the current user.
+type RoleChecker interface {
+	CheckIsAdmin(ctx context.Context) error
+	CheckIsOperator(ctx context.Context) error
+}
+
+// RequireRole rejects requests of users without role on the whole workspace, so routes declare what
+// they need instead of every service method checking it. Only admin is granted workspace-wide; the
+// other roles are per entity and checked by the services. It must run after AuthMiddleware.
+func RequireRole(checker RoleChecker, role auth.Role) func(http.Handler) http.Handler {
+	if role != auth.RoleAdmin {
+		panic(fmt.Sprintf("auth.RequireRole: %s is granted per entity", role))
+	}
+
+	return requireCheck(checker.CheckIsAdmin, "auth.RequireRole")
+}
+
+// RequireOperator rejects requests of users other than the admins of the default workspace, who
+// manage the deployment itself. It must run after AuthMiddleware.
+func RequireOperator(checker RoleChecker) func(http.Handler) http.Handler {
+	return requireCheck(checker.CheckIsOperator, "auth.RequireOperator")
+}
+
+func requireCheck(check func(ctx context.Context) error, name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if err := check(ctx); err != nil {
+				logger.Error(ctx, err).Msg(name + ": access denied")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TokenFromQuery copies an access token passed as a query parameter into the Authorization header
+// when the header is absent. Browsers cannot set headers on WebSocket handshakes, so such endpoints
+// accept the token this way. It must run before AuthMiddleware.
+func TokenFromQuery(param string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token := r.URL.Query().Get(param); token != "" && r.Header.Get("Authorization") == "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,7 +11,9 @@ import (
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/auth/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/go-chi/chi/v5"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -27,7 +30,9 @@ func TestAuthMiddleware(t *testing.T) {
 		name       string
 		header     string
 		setup      func(mock *mocks.TokenCodecMock)
+		leeway     time.Duration
 		wantStatus int
+		wantCode   apperr.Code
 	}{
 		{
 			name:       "missing Authorization -> 401",
@@ -111,6 +116,24 @@ func TestAuthMiddleware(t *testing.T) {
 			},
 			wantStatus: http.StatusUnauthorized,
 		},
+		{
+			name:   "token of another workspace -> 401",
+			header: "Bearer token",
+			setup: func(mock *mocks.TokenCodecMock) {
+				mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+					c, ok := claims.(*auth.AccessTokenClaims)
+					if !ok {
+						return fmt.Errorf("unexpected claims type %T", claims)
+					}
+					c.Subject = userID.String()
+					c.SID = SID.String()
+					c.WID = uuid.NewString()
+					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(5 * time.Minute))
+					return nil
+				})
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
 		{
 			name:   "expired token -> 401",
 			header: "Bearer token",
@@ -122,11 +145,69 @@ func TestAuthMiddleware(t *testing.T) {
 					}
 					c.Subject = userID.String()
 					c.SID = SID.String()
+					c.WID = contextx.DefaultWorkspaceID.String()
 					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-5 * time.Minute))
 					return nil
 				})
 			},
 			wantStatus: http.StatusUnauthorized,
+			wantCode:   secure.CodeTokenExpired,
+		},
+		{
+			name:   "ParseToken returns expired -> 401 with its code",
+			header: "Bearer token",
+			setup: func(mock *mocks.TokenCodecMock) {
+				mock.ParseTokenMock.Return(secure.ErrTokenExpired())
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   secure.CodeTokenExpired,
+		},
+		{
+			name:   "ParseToken returns not yet valid -> 401 with its code",
+			header: "Bearer token",
+			setup: func(mock *mocks.TokenCodecMock) {
+				mock.ParseTokenMock.Return(secure.ErrTokenNotYetValid())
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   secure.CodeTokenNotYetValid,
+		},
+		{
+			name:   "expired within leeway -> ok",
+			header: "Bearer token",
+			setup: func(mock *mocks.TokenCodecMock) {
+				mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+					c, ok := claims.(*auth.AccessTokenClaims)
+					if !ok {
+						return fmt.Errorf("unexpected claims type %T", claims)
+					}
+					c.Subject = userID.String()
+					c.SID = SID.String()
+					c.WID = contextx.DefaultWorkspaceID.String()
+					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-5 * time.Second))
+					return nil
+				})
+			},
+			leeway:     time.Minute,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "invalid ACT (not uuid) -> 401",
+			header: "Bearer token",
+			setup: func(mock *mocks.TokenCodecMock) {
+				mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+					c, ok := claims.(*auth.AccessTokenClaims)
+					if !ok {
+						return fmt.Errorf("unexpected claims type %T", claims)
+					}
+					c.Subject = userID.String()
+					c.SID = SID.String()
+					c.WID = contextx.DefaultWorkspaceID.String()
+					c.ACT = "not-uuid"
+					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(5 * time.Minute))
+					return nil
+				})
+			},
+			wantStatus: http.StatusUnauthorized,
 		},
 		{
 			name:   "ok -> next called, context has user_id & session_id",
@@ -139,6 +220,7 @@ func TestAuthMiddleware(t *testing.T) {
 					}
 					c.Subject = userID.String()
 					c.SID = SID.String()
+					c.WID = contextx.DefaultWorkspaceID.String()
 					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(5 * time.Minute))
 					return nil
 				})
@@ -162,6 +244,7 @@ func TestAuthMiddleware(t *testing.T) {
 			})
 
 			mock := mocks.NewTokenCodecMock(t)
+			mock.LeewayMock.Optional().Return(tc.leeway)
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
@@ -182,6 +265,238 @@ func TestAuthMiddleware(t *testing.T) {
 			if tc.wantStatus != http.StatusOK {
 				require.NotEmpty(t, strings.TrimSpace(rr.Body.String()))
 			}
+			if tc.wantCode != "" {
+				var problem apperr.Problem
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+				require.Equal(t, tc.wantCode, problem.Code)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_Impersonation(t *testing.T) {
+	t.Parallel()
+
+	userID, actorID := uuid.New(), uuid.New()
+	mock := mocks.NewTokenCodecMock(t)
+	mock.LeewayMock.Return(0)
+	mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+		c, ok := claims.(*auth.AccessTokenClaims)
+		if !ok {
+			return fmt.Errorf("unexpected claims type %T", claims)
+		}
+		c.Subject = userID.String()
+		c.SID = uuid.NewString()
+		c.WID = contextx.DefaultWorkspaceID.String()
+		c.ACT = actorID.String()
+		c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(5 * time.Minute))
+		return nil
+	})
+
+	r := chi.NewRouter()
+	r.Use(AuthMiddleware(mock))
+	r.Get("/protected", func(w http.ResponseWriter, r *http.Request) {
+		got, err := contextx.GetUserID(r.Context())
+		require.NoError(t, err)
+		require.Equal(t, userID, got)
+		got, err = contextx.GetActorID(r.Context())
+		require.NoError(t, err)
+		require.Equal(t, actorID, got)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+
+	r.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestAuthMiddleware_UnknownClaims(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	payload := fmt.Sprintf(`{"sub":%q,"sid":%q,"wid":%q,"exp":%d,"roles":["admin"],"tid":"tenant-1","department":"docs","nested":{"a":1}}`,
+		userID, uuid.New(), contextx.DefaultWorkspaceID, time.Now().Add(5*time.Minute).Unix())
+	mock := mocks.NewTokenCodecMock(t)
+	mock.LeewayMock.Return(0)
+	mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+		return json.Unmarshal([]byte(payload), claims)
+	})
+
+	r := chi.NewRouter()
+	r.Use(AuthMiddleware(mock))
+	r.Get("/protected", func(w http.ResponseWriter, r *http.Request) {
+		got, err := contextx.GetUserID(r.Context())
+		require.NoError(t, err)
+		require.Equal(t, userID, got)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+
+	r.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequireReadWriteScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		scope      string
+		method     string
+		wantStatus int
+	}{
+		{name: "no scope claim is full scope", method: http.MethodPost, wantStatus: http.StatusOK},
+		{name: "read scope, GET", scope: "entities:read", method: http.MethodGet, wantStatus: http.StatusOK},
+		{name: "read scope, POST -> 403", scope: "entities:read", method: http.MethodPost, wantStatus: http.StatusForbidden},
+		{name: "write scope, GET", scope: "entities:write", method: http.MethodGet, wantStatus: http.StatusOK},
+		{name: "write scope, DELETE", scope: "entities:write", method: http.MethodDelete, wantStatus: http.StatusOK},
+		{name: "other scope -> 403", scope: "users:admin", method: http.MethodGet, wantStatus: http.StatusForbidden},
+		{name: "unknown scope claim -> 401", scope: "entities:delete", method: http.MethodGet, wantStatus: http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewTokenCodecMock(t)
+			mock.LeewayMock.Return(0)
+			mock.ParseTokenMock.Set(func(tokenStr string, claims jwt.Claims) error {
+				c, ok := claims.(*auth.AccessTokenClaims)
+				if !ok {
+					return fmt.Errorf("unexpected claims type %T", claims)
+				}
+				c.Subject = uuid.NewString()
+				c.SID = uuid.NewString()
+				c.WID = contextx.DefaultWorkspaceID.String()
+				c.Scope = tc.scope
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(5 * time.Minute))
+				return nil
+			})
+
+			r := chi.NewRouter()
+			r.Use(AuthMiddleware(mock))
+			r.Use(RequireReadWriteScope(auth.ScopeEntitiesRead, auth.ScopeEntitiesWrite))
+			r.HandleFunc("/entities", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tc.method, "/entities", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestRequireScope_WithoutAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	r := chi.NewRouter()
+	r.Use(RequireScope(auth.ScopeUsersAdmin))
+	r.Get("/users", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(h http.Handler) int {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/admin", nil))
+		return rr.Code
+	}
+
+	t.Run("admin", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewRoleCheckerMock(t)
+		checker.CheckIsAdminMock.Return(nil)
+		require.Equal(t, http.StatusOK, serve(RequireRole(checker, auth.RoleAdmin)(ok)))
+	})
+
+	t.Run("not admin -> 403", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewRoleCheckerMock(t)
+		checker.CheckIsAdminMock.Return(apperr.ErrForbidden())
+		require.Equal(t, http.StatusForbidden, serve(RequireRole(checker, auth.RoleAdmin)(ok)))
+	})
+
+	t.Run("entity role panics", func(t *testing.T) {
+		t.Parallel()
+		require.Panics(t, func() { RequireRole(mocks.NewRoleCheckerMock(t), auth.RoleWrite) })
+	})
+
+	t.Run("operator", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewRoleCheckerMock(t)
+		checker.CheckIsOperatorMock.Return(nil)
+		require.Equal(t, http.StatusOK, serve(RequireOperator(checker)(ok)))
+
+		checker = mocks.NewRoleCheckerMock(t)
+		checker.CheckIsOperatorMock.Return(apperr.ErrForbidden())
+		require.Equal(t, http.StatusForbidden, serve(RequireOperator(checker)(ok)))
+	})
+}
+
+func TestTokenFromQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		url        string
+		header     string
+		wantHeader string
+	}{
+		{
+			name:       "token from query",
+			url:        "/ws?access_token=abc",
+			wantHeader: "Bearer abc",
+		},
+		{
+			name:       "header wins over query",
+			url:        "/ws?access_token=abc",
+			header:     "Bearer header",
+			wantHeader: "Bearer header",
+		},
+		{
+			name: "no token",
+			url:  "/ws",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			h := TokenFromQuery(QueryParamAccessToken)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("Authorization")
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			require.Equal(t, tt.wantHeader, got)
 		})
 	}
 }
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -28,6 +28,27 @@ type AuthServiceMock struct {
 	beforeAddUserRoleCounter uint64
 	AddUserRoleMock          mAuthServiceMockAddUserRole
 
+	funcApplyPreset          func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error)
+	funcApplyPresetOrigin    string
+	inspectFuncApplyPreset   func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq)
+	afterApplyPresetCounter  uint64
+	beforeApplyPresetCounter uint64
+	ApplyPresetMock          mAuthServiceMockApplyPreset
+
+	funcCreatePreset          func(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error)
+	funcCreatePresetOrigin    string
+	inspectFuncCreatePreset   func(ctx context.Context, req auth.RolePresetReq)
+	afterCreatePresetCounter  uint64
+	beforeCreatePresetCounter uint64
+	CreatePresetMock          mAuthServiceMockCreatePreset
+
+	funcDeletePreset          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeletePresetOrigin    string
+	inspectFuncDeletePreset   func(ctx context.Context, id uuid.UUID)
+	afterDeletePresetCounter  uint64
+	beforeDeletePresetCounter uint64
+	DeletePresetMock          mAuthServiceMockDeletePreset
+
 	funcDeleteSession          func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (err error)
 	funcDeleteSessionOrigin    string
 	inspectFuncDeleteSession   func(ctx context.Context, userID uuid.UUID, id uuid.UUID)
@@ -49,6 +70,27 @@ type AuthServiceMock struct {
 	beforeDeleteUserRoleCounter uint64
 	DeleteUserRoleMock          mAuthServiceMockDeleteUserRole
 
+	funcGetConsistencyReport          func(ctx context.Context) (c2 auth.ConsistencyReport, err error)
+	funcGetConsistencyReportOrigin    string
+	inspectFuncGetConsistencyReport   func(ctx context.Context)
+	afterGetConsistencyReportCounter  uint64
+	beforeGetConsistencyReportCounter uint64
+	GetConsistencyReportMock          mAuthServiceMockGetConsistencyReport
+
+	funcGetLoginHistory          func(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error)
+	funcGetLoginHistoryOrigin    string
+	inspectFuncGetLoginHistory   func(ctx context.Context, userID uuid.UUID, limit int)
+	afterGetLoginHistoryCounter  uint64
+	beforeGetLoginHistoryCounter uint64
+	GetLoginHistoryMock          mAuthServiceMockGetLoginHistory
+
+	funcGetPreset          func(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error)
+	funcGetPresetOrigin    string
+	inspectFuncGetPreset   func(ctx context.Context, id uuid.UUID)
+	afterGetPresetCounter  uint64
+	beforeGetPresetCounter uint64
+	GetPresetMock          mAuthServiceMockGetPreset
+
 	funcGetSessionsByUserID          func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)
 	funcGetSessionsByUserIDOrigin    string
 	inspectFuncGetSessionsByUserID   func(ctx context.Context, userID uuid.UUID)
@@ -56,6 +98,20 @@ type AuthServiceMock struct {
 	beforeGetSessionsByUserIDCounter uint64
 	GetSessionsByUserIDMock          mAuthServiceMockGetSessionsByUserID
 
+	funcImpersonate          func(ctx context.Context, userID uuid.UUID) (i1 auth.ImpersonationToken, err error)
+	funcImpersonateOrigin    string
+	inspectFuncImpersonate   func(ctx context.Context, userID uuid.UUID)
+	afterImpersonateCounter  uint64
+	beforeImpersonateCounter uint64
+	ImpersonateMock          mAuthServiceMockImpersonate
+
+	funcListPresets          func(ctx context.Context) (ra1 []auth.RolePreset, err error)
+	funcListPresetsOrigin    string
+	inspectFuncListPresets   func(ctx context.Context)
+	afterListPresetsCounter  uint64
+	beforeListPresetsCounter uint64
+	ListPresetsMock          mAuthServiceMockListPresets
+
 	funcListUserRoles          func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)
 	funcListUserRolesOrigin    string
 	inspectFuncListUserRoles   func(ctx context.Context, userID uuid.UUID)
@@ -70,12 +126,33 @@ type AuthServiceMock struct {
 	beforeLoginCounter uint64
 	LoginMock          mAuthServiceMockLogin
 
-	funcRefreshTokens          func(ctx context.Context, refreshToken auth.RefreshToken) (t1 auth.Tokens, err error)
+	funcLogout          func(ctx context.Context) (err error)
+	funcLogoutOrigin    string
+	inspectFuncLogout   func(ctx context.Context)
+	afterLogoutCounter  uint64
+	beforeLogoutCounter uint64
+	LogoutMock          mAuthServiceMockLogout
+
+	funcRefreshTokens          func(ctx context.Context, req usecase.RefreshCmd) (t1 auth.Tokens, err error)
 	funcRefreshTokensOrigin    string
-	inspectFuncRefreshTokens   func(ctx context.Context, refreshToken auth.RefreshToken)
+	inspectFuncRefreshTokens   func(ctx context.Context, req usecase.RefreshCmd)
 	afterRefreshTokensCounter  uint64
 	beforeRefreshTokensCounter uint64
 	RefreshTokensMock          mAuthServiceMockRefreshTokens
+
+	funcRepairGrants          func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)
+	funcRepairGrantsOrigin    string
+	inspectFuncRepairGrants   func(ctx context.Context, dryRun bool)
+	afterRepairGrantsCounter  uint64
+	beforeRepairGrantsCounter uint64
+	RepairGrantsMock          mAuthServiceMockRepairGrants
+
+	funcUpdatePreset          func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error)
+	funcUpdatePresetOrigin    string
+	inspectFuncUpdatePreset   func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq)
+	afterUpdatePresetCounter  uint64
+	beforeUpdatePresetCounter uint64
+	UpdatePresetMock          mAuthServiceMockUpdatePreset
 }
 
 // NewAuthServiceMock returns a mock for mm_http.AuthService
@@ -89,6 +166,15 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 	m.AddUserRoleMock = mAuthServiceMockAddUserRole{mock: m}
 	m.AddUserRoleMock.callArgs = []*AuthServiceMockAddUserRoleParams{}
 
+	m.ApplyPresetMock = mAuthServiceMockApplyPreset{mock: m}
+	m.ApplyPresetMock.callArgs = []*AuthServiceMockApplyPresetParams{}
+
+	m.CreatePresetMock = mAuthServiceMockCreatePreset{mock: m}
+	m.CreatePresetMock.callArgs = []*AuthServiceMockCreatePresetParams{}
+
+	m.DeletePresetMock = mAuthServiceMockDeletePreset{mock: m}
+	m.DeletePresetMock.callArgs = []*AuthServiceMockDeletePresetParams{}
+
 	m.DeleteSessionMock = mAuthServiceMockDeleteSession{mock: m}
 	m.DeleteSessionMock.callArgs = []*AuthServiceMockDeleteSessionParams{}
 
@@ -98,18 +184,42 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 	m.DeleteUserRoleMock = mAuthServiceMockDeleteUserRole{mock: m}
 	m.DeleteUserRoleMock.callArgs = []*AuthServiceMockDeleteUserRoleParams{}
 
+	m.GetConsistencyReportMock = mAuthServiceMockGetConsistencyReport{mock: m}
+	m.GetConsistencyReportMock.callArgs = []*AuthServiceMockGetConsistencyReportParams{}
+
+	m.GetLoginHistoryMock = mAuthServiceMockGetLoginHistory{mock: m}
+	m.GetLoginHistoryMock.callArgs = []*AuthServiceMockGetLoginHistoryParams{}
+
+	m.GetPresetMock = mAuthServiceMockGetPreset{mock: m}
+	m.GetPresetMock.callArgs = []*AuthServiceMockGetPresetParams{}
+
 	m.GetSessionsByUserIDMock = mAuthServiceMockGetSessionsByUserID{mock: m}
 	m.GetSessionsByUserIDMock.callArgs = []*AuthServiceMockGetSessionsByUserIDParams{}
 
+	m.ImpersonateMock = mAuthServiceMockImpersonate{mock: m}
+	m.ImpersonateMock.callArgs = []*AuthServiceMockImpersonateParams{}
+
+	m.ListPresetsMock = mAuthServiceMockListPresets{mock: m}
+	m.ListPresetsMock.callArgs = []*AuthServiceMockListPresetsParams{}
+
 	m.ListUserRolesMock = mAuthServiceMockListUserRoles{mock: m}
 	m.ListUserRolesMock.callArgs = []*AuthServiceMockListUserRolesParams{}
 
 	m.LoginMock = mAuthServiceMockLogin{mock: m}
 	m.LoginMock.callArgs = []*AuthServiceMockLoginParams{}
 
+	m.LogoutMock = mAuthServiceMockLogout{mock: m}
+	m.LogoutMock.callArgs = []*AuthServiceMockLogoutParams{}
+
 	m.RefreshTokensMock = mAuthServiceMockRefreshTokens{mock: m}
 	m.RefreshTokensMock.callArgs = []*AuthServiceMockRefreshTokensParams{}
 
+	m.RepairGrantsMock = mAuthServiceMockRepairGrants{mock: m}
+	m.RepairGrantsMock.callArgs = []*AuthServiceMockRepairGrantsParams{}
+
+	m.UpdatePresetMock = mAuthServiceMockUpdatePreset{mock: m}
+	m.UpdatePresetMock.callArgs = []*AuthServiceMockUpdatePresetParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -457,55 +567,56 @@ func (m *AuthServiceMock) MinimockAddUserRoleInspect() {
 	}
 }
 
-type mAuthServiceMockDeleteSession struct {
+type mAuthServiceMockApplyPreset struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockDeleteSessionExpectation
-	expectations       []*AuthServiceMockDeleteSessionExpectation
+	defaultExpectation *AuthServiceMockApplyPresetExpectation
+	expectations       []*AuthServiceMockApplyPresetExpectation
 
-	callArgs []*AuthServiceMockDeleteSessionParams
+	callArgs []*AuthServiceMockApplyPresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockDeleteSessionExpectation specifies expectation struct of the AuthService.DeleteSession
-type AuthServiceMockDeleteSessionExpectation struct {
+// AuthServiceMockApplyPresetExpectation specifies expectation struct of the AuthService.ApplyPreset
+type AuthServiceMockApplyPresetExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockDeleteSessionParams
-	paramPtrs          *AuthServiceMockDeleteSessionParamPtrs
-	expectationOrigins AuthServiceMockDeleteSessionExpectationOrigins
-	results            *AuthServiceMockDeleteSessionResults
+	params             *AuthServiceMockApplyPresetParams
+	paramPtrs          *AuthServiceMockApplyPresetParamPtrs
+	expectationOrigins AuthServiceMockApplyPresetExpectationOrigins
+	results            *AuthServiceMockApplyPresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockDeleteSessionParams contains parameters of the AuthService.DeleteSession
-type AuthServiceMockDeleteSessionParams struct {
-	ctx    context.Context
-	userID uuid.UUID
-	id     uuid.UUID
+// AuthServiceMockApplyPresetParams contains parameters of the AuthService.ApplyPreset
+type AuthServiceMockApplyPresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+	req auth.ApplyPresetReq
 }
 
-// AuthServiceMockDeleteSessionParamPtrs contains pointers to parameters of the AuthService.DeleteSession
-type AuthServiceMockDeleteSessionParamPtrs struct {
-	ctx    *context.Context
-	userID *uuid.UUID
-	id     *uuid.UUID
+// AuthServiceMockApplyPresetParamPtrs contains pointers to parameters of the AuthService.ApplyPreset
+type AuthServiceMockApplyPresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+	req *auth.ApplyPresetReq
 }
 
-// AuthServiceMockDeleteSessionResults contains results of the AuthService.DeleteSession
-type AuthServiceMockDeleteSessionResults struct {
+// AuthServiceMockApplyPresetResults contains results of the AuthService.ApplyPreset
+type AuthServiceMockApplyPresetResults struct {
+	ua1 []auth.UserRole
 	err error
 }
 
-// AuthServiceMockDeleteSessionOrigins contains origins of expectations of the AuthService.DeleteSession
-type AuthServiceMockDeleteSessionExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originId     string
+// AuthServiceMockApplyPresetOrigins contains origins of expectations of the AuthService.ApplyPreset
+type AuthServiceMockApplyPresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -513,369 +624,370 @@ type AuthServiceMockDeleteSessionExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Optional() *mAuthServiceMockDeleteSession {
-	mmDeleteSession.optional = true
-	return mmDeleteSession
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Optional() *mAuthServiceMockApplyPreset {
+	mmApplyPreset.optional = true
+	return mmApplyPreset
 }
 
-// Expect sets up expected params for AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Expect(ctx context.Context, userID uuid.UUID, id uuid.UUID) *mAuthServiceMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+// Expect sets up expected params for AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Expect(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) *mAuthServiceMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &AuthServiceMockApplyPresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by ExpectParams functions")
+	if mmApplyPreset.defaultExpectation.paramPtrs != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSession.defaultExpectation.params = &AuthServiceMockDeleteSessionParams{ctx, userID, id}
-	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSession.expectations {
-		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
-			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+	mmApplyPreset.defaultExpectation.params = &AuthServiceMockApplyPresetParams{ctx, id, req}
+	mmApplyPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPreset.expectations {
+		if minimock.Equal(e.params, mmApplyPreset.defaultExpectation.params) {
+			mmApplyPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSession
+	return mmApplyPreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &AuthServiceMockApplyPresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &AuthServiceMockApplyPresetParamPtrs{}
 	}
-	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSession
+	return mmApplyPreset
 }
 
-// ExpectUserIDParam2 sets up expected param userID for AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) ExpectIdParam2(id uuid.UUID) *mAuthServiceMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &AuthServiceMockApplyPresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &AuthServiceMockApplyPresetParamPtrs{}
 	}
-	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.id = &id
+	mmApplyPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteSession
+	return mmApplyPreset
 }
 
-// ExpectIdParam3 sets up expected param id for AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectIdParam3(id uuid.UUID) *mAuthServiceMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+// ExpectReqParam3 sets up expected param req for AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) ExpectReqParam3(req auth.ApplyPresetReq) *mAuthServiceMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &AuthServiceMockApplyPresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &AuthServiceMockApplyPresetParamPtrs{}
 	}
-	mmDeleteSession.defaultExpectation.paramPtrs.id = &id
-	mmDeleteSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.req = &req
+	mmApplyPreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmDeleteSession
+	return mmApplyPreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Inspect(f func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *mAuthServiceMockDeleteSession {
-	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteSession")
+// Inspect accepts an inspector function that has same arguments as the AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Inspect(f func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq)) *mAuthServiceMockApplyPreset {
+	if mmApplyPreset.mock.inspectFuncApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ApplyPreset")
 	}
 
-	mmDeleteSession.mock.inspectFuncDeleteSession = f
+	mmApplyPreset.mock.inspectFuncApplyPreset = f
 
-	return mmDeleteSession
+	return mmApplyPreset
 }
 
-// Return sets up results that will be returned by AuthService.DeleteSession
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Return(err error) *AuthServiceMock {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+// Return sets up results that will be returned by AuthService.ApplyPreset
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Return(ua1 []auth.UserRole, err error) *AuthServiceMock {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &AuthServiceMockApplyPresetExpectation{mock: mmApplyPreset.mock}
 	}
-	mmDeleteSession.defaultExpectation.results = &AuthServiceMockDeleteSessionResults{err}
-	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession.mock
+	mmApplyPreset.defaultExpectation.results = &AuthServiceMockApplyPresetResults{ua1, err}
+	mmApplyPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// Set uses given function f to mock the AuthService.DeleteSession method
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Set(f func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (err error)) *AuthServiceMock {
-	if mmDeleteSession.defaultExpectation != nil {
-		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteSession method")
+// Set uses given function f to mock the AuthService.ApplyPreset method
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Set(f func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error)) *AuthServiceMock {
+	if mmApplyPreset.defaultExpectation != nil {
+		mmApplyPreset.mock.t.Fatalf("Default expectation is already set for the AuthService.ApplyPreset method")
 	}
 
-	if len(mmDeleteSession.expectations) > 0 {
-		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteSession method")
+	if len(mmApplyPreset.expectations) > 0 {
+		mmApplyPreset.mock.t.Fatalf("Some expectations are already set for the AuthService.ApplyPreset method")
 	}
 
-	mmDeleteSession.mock.funcDeleteSession = f
-	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession.mock
+	mmApplyPreset.mock.funcApplyPreset = f
+	mmApplyPreset.mock.funcApplyPresetOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// When sets expectation for the AuthService.DeleteSession which will trigger the result defined by the following
+// When sets expectation for the AuthService.ApplyPreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSession *mAuthServiceMockDeleteSession) When(ctx context.Context, userID uuid.UUID, id uuid.UUID) *AuthServiceMockDeleteSessionExpectation {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+func (mmApplyPreset *mAuthServiceMockApplyPreset) When(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) *AuthServiceMockApplyPresetExpectation {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("AuthServiceMock.ApplyPreset mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockDeleteSessionExpectation{
-		mock:               mmDeleteSession.mock,
-		params:             &AuthServiceMockDeleteSessionParams{ctx, userID, id},
-		expectationOrigins: AuthServiceMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockApplyPresetExpectation{
+		mock:               mmApplyPreset.mock,
+		params:             &AuthServiceMockApplyPresetParams{ctx, id, req},
+		expectationOrigins: AuthServiceMockApplyPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	mmApplyPreset.expectations = append(mmApplyPreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.DeleteSession return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockDeleteSessionExpectation) Then(err error) *AuthServiceMock {
-	e.results = &AuthServiceMockDeleteSessionResults{err}
+// Then sets up AuthService.ApplyPreset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockApplyPresetExpectation) Then(ua1 []auth.UserRole, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockApplyPresetResults{ua1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.DeleteSession should be invoked
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Times(n uint64) *mAuthServiceMockDeleteSession {
+// Times sets number of times AuthService.ApplyPreset should be invoked
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Times(n uint64) *mAuthServiceMockApplyPreset {
 	if n == 0 {
-		mmDeleteSession.mock.t.Fatalf("Times of AuthServiceMock.DeleteSession mock can not be zero")
+		mmApplyPreset.mock.t.Fatalf("Times of AuthServiceMock.ApplyPreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
-	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession
+	mm_atomic.StoreUint64(&mmApplyPreset.expectedInvocations, n)
+	mmApplyPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset
 }
 
-func (mmDeleteSession *mAuthServiceMockDeleteSession) invocationsDone() bool {
-	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+func (mmApplyPreset *mAuthServiceMockApplyPreset) invocationsDone() bool {
+	if len(mmApplyPreset.expectations) == 0 && mmApplyPreset.defaultExpectation == nil && mmApplyPreset.mock.funcApplyPreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPreset.mock.afterApplyPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSession implements mm_http.AuthService
-func (mmDeleteSession *AuthServiceMock) DeleteSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+// ApplyPreset implements mm_http.AuthService
+func (mmApplyPreset *AuthServiceMock) ApplyPreset(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmApplyPreset.beforeApplyPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPreset.afterApplyPresetCounter, 1)
 
-	mmDeleteSession.t.Helper()
+	mmApplyPreset.t.Helper()
 
-	if mmDeleteSession.inspectFuncDeleteSession != nil {
-		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, id)
+	if mmApplyPreset.inspectFuncApplyPreset != nil {
+		mmApplyPreset.inspectFuncApplyPreset(ctx, id, req)
 	}
 
-	mm_params := AuthServiceMockDeleteSessionParams{ctx, userID, id}
+	mm_params := AuthServiceMockApplyPresetParams{ctx, id, req}
 
 	// Record call args
-	mmDeleteSession.DeleteSessionMock.mutex.Lock()
-	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
-	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+	mmApplyPreset.ApplyPresetMock.mutex.Lock()
+	mmApplyPreset.ApplyPresetMock.callArgs = append(mmApplyPreset.ApplyPresetMock.callArgs, &mm_params)
+	mmApplyPreset.ApplyPresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+	for _, e := range mmApplyPreset.ApplyPresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ua1, e.results.err
 		}
 	}
 
-	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+	if mmApplyPreset.ApplyPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPreset.ApplyPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPreset.ApplyPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPreset.ApplyPresetMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockDeleteSessionParams{ctx, userID, id}
+		mm_got := AuthServiceMockApplyPresetParams{ctx, id, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmApplyPreset.t.Errorf("AuthServiceMock.ApplyPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApplyPreset.t.Errorf("AuthServiceMock.ApplyPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmApplyPreset.t.Errorf("AuthServiceMock.ApplyPreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmApplyPreset.t.Errorf("AuthServiceMock.ApplyPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		mm_results := mmApplyPreset.ApplyPresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSession.t.Fatal("No results are set for the AuthServiceMock.DeleteSession")
+			mmApplyPreset.t.Fatal("No results are set for the AuthServiceMock.ApplyPreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).ua1, (*mm_results).err
 	}
-	if mmDeleteSession.funcDeleteSession != nil {
-		return mmDeleteSession.funcDeleteSession(ctx, userID, id)
+	if mmApplyPreset.funcApplyPreset != nil {
+		return mmApplyPreset.funcApplyPreset(ctx, id, req)
 	}
-	mmDeleteSession.t.Fatalf("Unexpected call to AuthServiceMock.DeleteSession. %v %v %v", ctx, userID, id)
+	mmApplyPreset.t.Fatalf("Unexpected call to AuthServiceMock.ApplyPreset. %v %v %v", ctx, id, req)
 	return
 }
 
-// DeleteSessionAfterCounter returns a count of finished AuthServiceMock.DeleteSession invocations
-func (mmDeleteSession *AuthServiceMock) DeleteSessionAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+// ApplyPresetAfterCounter returns a count of finished AuthServiceMock.ApplyPreset invocations
+func (mmApplyPreset *AuthServiceMock) ApplyPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.afterApplyPresetCounter)
 }
 
-// DeleteSessionBeforeCounter returns a count of AuthServiceMock.DeleteSession invocations
-func (mmDeleteSession *AuthServiceMock) DeleteSessionBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+// ApplyPresetBeforeCounter returns a count of AuthServiceMock.ApplyPreset invocations
+func (mmApplyPreset *AuthServiceMock) ApplyPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.beforeApplyPresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteSession.
+// Calls returns a list of arguments used in each call to AuthServiceMock.ApplyPreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSession *mAuthServiceMockDeleteSession) Calls() []*AuthServiceMockDeleteSessionParams {
-	mmDeleteSession.mutex.RLock()
+func (mmApplyPreset *mAuthServiceMockApplyPreset) Calls() []*AuthServiceMockApplyPresetParams {
+	mmApplyPreset.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockDeleteSessionParams, len(mmDeleteSession.callArgs))
-	copy(argCopy, mmDeleteSession.callArgs)
+	argCopy := make([]*AuthServiceMockApplyPresetParams, len(mmApplyPreset.callArgs))
+	copy(argCopy, mmApplyPreset.callArgs)
 
-	mmDeleteSession.mutex.RUnlock()
+	mmApplyPreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// MinimockApplyPresetDone returns true if the count of the ApplyPreset invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockDeleteSessionDone() bool {
-	if m.DeleteSessionMock.optional {
+func (m *AuthServiceMock) MinimockApplyPresetDone() bool {
+	if m.ApplyPresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionMock.expectations {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionMock.invocationsDone()
+	return m.ApplyPresetMock.invocationsDone()
 }
 
-// MinimockDeleteSessionInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockDeleteSessionInspect() {
-	for _, e := range m.DeleteSessionMock.expectations {
+// MinimockApplyPresetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockApplyPresetInspect() {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.ApplyPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	afterApplyPresetCounter := mm_atomic.LoadUint64(&m.afterApplyPresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
-		if m.DeleteSessionMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+	if m.ApplyPresetMock.defaultExpectation != nil && afterApplyPresetCounter < 1 {
+		if m.ApplyPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ApplyPreset at\n%s", m.ApplyPresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.ApplyPreset at\n%s with params: %#v", m.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	if m.funcApplyPreset != nil && afterApplyPresetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ApplyPreset at\n%s", m.funcApplyPresetOrigin)
 	}
 
-	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteSession at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	if !m.ApplyPresetMock.invocationsDone() && afterApplyPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ApplyPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPresetMock.expectedInvocations), m.ApplyPresetMock.expectedInvocationsOrigin, afterApplyPresetCounter)
 	}
 }
 
-type mAuthServiceMockDeleteSessionsByUserID struct {
+type mAuthServiceMockCreatePreset struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockDeleteSessionsByUserIDExpectation
-	expectations       []*AuthServiceMockDeleteSessionsByUserIDExpectation
+	defaultExpectation *AuthServiceMockCreatePresetExpectation
+	expectations       []*AuthServiceMockCreatePresetExpectation
 
-	callArgs []*AuthServiceMockDeleteSessionsByUserIDParams
+	callArgs []*AuthServiceMockCreatePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockDeleteSessionsByUserIDExpectation specifies expectation struct of the AuthService.DeleteSessionsByUserID
-type AuthServiceMockDeleteSessionsByUserIDExpectation struct {
+// AuthServiceMockCreatePresetExpectation specifies expectation struct of the AuthService.CreatePreset
+type AuthServiceMockCreatePresetExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockDeleteSessionsByUserIDParams
-	paramPtrs          *AuthServiceMockDeleteSessionsByUserIDParamPtrs
-	expectationOrigins AuthServiceMockDeleteSessionsByUserIDExpectationOrigins
-	results            *AuthServiceMockDeleteSessionsByUserIDResults
+	params             *AuthServiceMockCreatePresetParams
+	paramPtrs          *AuthServiceMockCreatePresetParamPtrs
+	expectationOrigins AuthServiceMockCreatePresetExpectationOrigins
+	results            *AuthServiceMockCreatePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockDeleteSessionsByUserIDParams contains parameters of the AuthService.DeleteSessionsByUserID
-type AuthServiceMockDeleteSessionsByUserIDParams struct {
-	ctx    context.Context
-	userID uuid.UUID
+// AuthServiceMockCreatePresetParams contains parameters of the AuthService.CreatePreset
+type AuthServiceMockCreatePresetParams struct {
+	ctx context.Context
+	req auth.RolePresetReq
 }
 
-// AuthServiceMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the AuthService.DeleteSessionsByUserID
-type AuthServiceMockDeleteSessionsByUserIDParamPtrs struct {
-	ctx    *context.Context
-	userID *uuid.UUID
+// AuthServiceMockCreatePresetParamPtrs contains pointers to parameters of the AuthService.CreatePreset
+type AuthServiceMockCreatePresetParamPtrs struct {
+	ctx *context.Context
+	req *auth.RolePresetReq
 }
 
-// AuthServiceMockDeleteSessionsByUserIDResults contains results of the AuthService.DeleteSessionsByUserID
-type AuthServiceMockDeleteSessionsByUserIDResults struct {
+// AuthServiceMockCreatePresetResults contains results of the AuthService.CreatePreset
+type AuthServiceMockCreatePresetResults struct {
+	r1  auth.RolePreset
 	err error
 }
 
-// AuthServiceMockDeleteSessionsByUserIDOrigins contains origins of expectations of the AuthService.DeleteSessionsByUserID
-type AuthServiceMockDeleteSessionsByUserIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// AuthServiceMockCreatePresetOrigins contains origins of expectations of the AuthService.CreatePreset
+type AuthServiceMockCreatePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -883,341 +995,341 @@ type AuthServiceMockDeleteSessionsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Optional() *mAuthServiceMockDeleteSessionsByUserID {
-	mmDeleteSessionsByUserID.optional = true
-	return mmDeleteSessionsByUserID
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Optional() *mAuthServiceMockCreatePreset {
+	mmCreatePreset.optional = true
+	return mmCreatePreset
 }
 
-// Expect sets up expected params for AuthService.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+// Expect sets up expected params for AuthService.CreatePreset
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Expect(ctx context.Context, req auth.RolePresetReq) *mAuthServiceMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &AuthServiceMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	if mmCreatePreset.defaultExpectation.paramPtrs != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSessionsByUserID.defaultExpectation.params = &AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSessionsByUserID.expectations {
-		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
-			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+	mmCreatePreset.defaultExpectation.params = &AuthServiceMockCreatePresetParams{ctx, req}
+	mmCreatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePreset.expectations {
+		if minimock.Equal(e.params, mmCreatePreset.defaultExpectation.params) {
+			mmCreatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.CreatePreset
+func (mmCreatePreset *mAuthServiceMockCreatePreset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &AuthServiceMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionsByUserIDParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &AuthServiceMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// ExpectUserIDParam2 sets up expected param userID for AuthService.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for AuthService.CreatePreset
+func (mmCreatePreset *mAuthServiceMockCreatePreset) ExpectReqParam2(req auth.RolePresetReq) *mAuthServiceMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &AuthServiceMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionsByUserIDParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &AuthServiceMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.req = &req
+	mmCreatePreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteSessionsByUserID")
+// Inspect accepts an inspector function that has same arguments as the AuthService.CreatePreset
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Inspect(f func(ctx context.Context, req auth.RolePresetReq)) *mAuthServiceMockCreatePreset {
+	if mmCreatePreset.mock.inspectFuncCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.CreatePreset")
 	}
 
-	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+	mmCreatePreset.mock.inspectFuncCreatePreset = f
 
-	return mmDeleteSessionsByUserID
+	return mmCreatePreset
 }
 
-// Return sets up results that will be returned by AuthService.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Return(err error) *AuthServiceMock {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+// Return sets up results that will be returned by AuthService.CreatePreset
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Return(r1 auth.RolePreset, err error) *AuthServiceMock {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &AuthServiceMockCreatePresetExpectation{mock: mmCreatePreset.mock}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.results = &AuthServiceMockDeleteSessionsByUserIDResults{err}
-	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmCreatePreset.defaultExpectation.results = &AuthServiceMockCreatePresetResults{r1, err}
+	mmCreatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
 }
 
-// Set uses given function f to mock the AuthService.DeleteSessionsByUserID method
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *AuthServiceMock {
-	if mmDeleteSessionsByUserID.defaultExpectation != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteSessionsByUserID method")
+// Set uses given function f to mock the AuthService.CreatePreset method
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Set(f func(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error)) *AuthServiceMock {
+	if mmCreatePreset.defaultExpectation != nil {
+		mmCreatePreset.mock.t.Fatalf("Default expectation is already set for the AuthService.CreatePreset method")
 	}
 
-	if len(mmDeleteSessionsByUserID.expectations) > 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteSessionsByUserID method")
+	if len(mmCreatePreset.expectations) > 0 {
+		mmCreatePreset.mock.t.Fatalf("Some expectations are already set for the AuthService.CreatePreset method")
 	}
 
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmCreatePreset.mock.funcCreatePreset = f
+	mmCreatePreset.mock.funcCreatePresetOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
 }
 
-// When sets expectation for the AuthService.DeleteSessionsByUserID which will trigger the result defined by the following
+// When sets expectation for the AuthService.CreatePreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockDeleteSessionsByUserIDExpectation {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+func (mmCreatePreset *mAuthServiceMockCreatePreset) When(ctx context.Context, req auth.RolePresetReq) *AuthServiceMockCreatePresetExpectation {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("AuthServiceMock.CreatePreset mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockDeleteSessionsByUserIDExpectation{
-		mock:               mmDeleteSessionsByUserID.mock,
-		params:             &AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID},
-		expectationOrigins: AuthServiceMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockCreatePresetExpectation{
+		mock:               mmCreatePreset.mock,
+		params:             &AuthServiceMockCreatePresetParams{ctx, req},
+		expectationOrigins: AuthServiceMockCreatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	mmCreatePreset.expectations = append(mmCreatePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockDeleteSessionsByUserIDExpectation) Then(err error) *AuthServiceMock {
-	e.results = &AuthServiceMockDeleteSessionsByUserIDResults{err}
+// Then sets up AuthService.CreatePreset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockCreatePresetExpectation) Then(r1 auth.RolePreset, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockCreatePresetResults{r1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.DeleteSessionsByUserID should be invoked
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Times(n uint64) *mAuthServiceMockDeleteSessionsByUserID {
+// Times sets number of times AuthService.CreatePreset should be invoked
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Times(n uint64) *mAuthServiceMockCreatePreset {
 	if n == 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of AuthServiceMock.DeleteSessionsByUserID mock can not be zero")
+		mmCreatePreset.mock.t.Fatalf("Times of AuthServiceMock.CreatePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
-	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID
+	mm_atomic.StoreUint64(&mmCreatePreset.expectedInvocations, n)
+	mmCreatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset
 }
 
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) invocationsDone() bool {
-	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+func (mmCreatePreset *mAuthServiceMockCreatePreset) invocationsDone() bool {
+	if len(mmCreatePreset.expectations) == 0 && mmCreatePreset.defaultExpectation == nil && mmCreatePreset.mock.funcCreatePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePreset.mock.afterCreatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSessionsByUserID implements mm_http.AuthService
-func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+// CreatePreset implements mm_http.AuthService
+func (mmCreatePreset *AuthServiceMock) CreatePreset(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmCreatePreset.beforeCreatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePreset.afterCreatePresetCounter, 1)
 
-	mmDeleteSessionsByUserID.t.Helper()
+	mmCreatePreset.t.Helper()
 
-	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	if mmCreatePreset.inspectFuncCreatePreset != nil {
+		mmCreatePreset.inspectFuncCreatePreset(ctx, req)
 	}
 
-	mm_params := AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
+	mm_params := AuthServiceMockCreatePresetParams{ctx, req}
 
 	// Record call args
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+	mmCreatePreset.CreatePresetMock.mutex.Lock()
+	mmCreatePreset.CreatePresetMock.callArgs = append(mmCreatePreset.CreatePresetMock.callArgs, &mm_params)
+	mmCreatePreset.CreatePresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range mmCreatePreset.CreatePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.r1, e.results.err
 		}
 	}
 
-	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+	if mmCreatePreset.CreatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePreset.CreatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePreset.CreatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePreset.CreatePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
+		mm_got := AuthServiceMockCreatePresetParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreatePreset.t.Errorf("AuthServiceMock.CreatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreatePreset.t.Errorf("AuthServiceMock.CreatePreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreatePreset.t.Errorf("AuthServiceMock.CreatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		mm_results := mmCreatePreset.CreatePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSessionsByUserID.t.Fatal("No results are set for the AuthServiceMock.DeleteSessionsByUserID")
+			mmCreatePreset.t.Fatal("No results are set for the AuthServiceMock.CreatePreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).r1, (*mm_results).err
 	}
-	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
-		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	if mmCreatePreset.funcCreatePreset != nil {
+		return mmCreatePreset.funcCreatePreset(ctx, req)
 	}
-	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to AuthServiceMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	mmCreatePreset.t.Fatalf("Unexpected call to AuthServiceMock.CreatePreset. %v %v", ctx, req)
 	return
 }
 
-// DeleteSessionsByUserIDAfterCounter returns a count of finished AuthServiceMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+// CreatePresetAfterCounter returns a count of finished AuthServiceMock.CreatePreset invocations
+func (mmCreatePreset *AuthServiceMock) CreatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.afterCreatePresetCounter)
 }
 
-// DeleteSessionsByUserIDBeforeCounter returns a count of AuthServiceMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+// CreatePresetBeforeCounter returns a count of AuthServiceMock.CreatePreset invocations
+func (mmCreatePreset *AuthServiceMock) CreatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.beforeCreatePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteSessionsByUserID.
+// Calls returns a list of arguments used in each call to AuthServiceMock.CreatePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Calls() []*AuthServiceMockDeleteSessionsByUserIDParams {
-	mmDeleteSessionsByUserID.mutex.RLock()
+func (mmCreatePreset *mAuthServiceMockCreatePreset) Calls() []*AuthServiceMockCreatePresetParams {
+	mmCreatePreset.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
-	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+	argCopy := make([]*AuthServiceMockCreatePresetParams, len(mmCreatePreset.callArgs))
+	copy(argCopy, mmCreatePreset.callArgs)
 
-	mmDeleteSessionsByUserID.mutex.RUnlock()
+	mmCreatePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// MinimockCreatePresetDone returns true if the count of the CreatePreset invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockDeleteSessionsByUserIDDone() bool {
-	if m.DeleteSessionsByUserIDMock.optional {
+func (m *AuthServiceMock) MinimockCreatePresetDone() bool {
+	if m.CreatePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range m.CreatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionsByUserIDMock.invocationsDone()
+	return m.CreatePresetMock.invocationsDone()
 }
 
-// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockDeleteSessionsByUserIDInspect() {
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+// MinimockCreatePresetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockCreatePresetInspect() {
+	for _, e := range m.CreatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.CreatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	afterCreatePresetCounter := mm_atomic.LoadUint64(&m.afterCreatePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.CreatePresetMock.defaultExpectation != nil && afterCreatePresetCounter < 1 {
+		if m.CreatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.CreatePreset at\n%s", m.CreatePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.CreatePreset at\n%s with params: %#v", m.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *m.CreatePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	if m.funcCreatePreset != nil && afterCreatePresetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.CreatePreset at\n%s", m.funcCreatePresetOrigin)
 	}
 
-	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteSessionsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	if !m.CreatePresetMock.invocationsDone() && afterCreatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.CreatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePresetMock.expectedInvocations), m.CreatePresetMock.expectedInvocationsOrigin, afterCreatePresetCounter)
 	}
 }
 
-type mAuthServiceMockDeleteUserRole struct {
+type mAuthServiceMockDeletePreset struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockDeleteUserRoleExpectation
-	expectations       []*AuthServiceMockDeleteUserRoleExpectation
+	defaultExpectation *AuthServiceMockDeletePresetExpectation
+	expectations       []*AuthServiceMockDeletePresetExpectation
 
-	callArgs []*AuthServiceMockDeleteUserRoleParams
+	callArgs []*AuthServiceMockDeletePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockDeleteUserRoleExpectation specifies expectation struct of the AuthService.DeleteUserRole
-type AuthServiceMockDeleteUserRoleExpectation struct {
+// AuthServiceMockDeletePresetExpectation specifies expectation struct of the AuthService.DeletePreset
+type AuthServiceMockDeletePresetExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockDeleteUserRoleParams
-	paramPtrs          *AuthServiceMockDeleteUserRoleParamPtrs
-	expectationOrigins AuthServiceMockDeleteUserRoleExpectationOrigins
-	results            *AuthServiceMockDeleteUserRoleResults
+	params             *AuthServiceMockDeletePresetParams
+	paramPtrs          *AuthServiceMockDeletePresetParamPtrs
+	expectationOrigins AuthServiceMockDeletePresetExpectationOrigins
+	results            *AuthServiceMockDeletePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockDeleteUserRoleParams contains parameters of the AuthService.DeleteUserRole
-type AuthServiceMockDeleteUserRoleParams struct {
-	ctx  context.Context
-	role auth.UserRole
+// AuthServiceMockDeletePresetParams contains parameters of the AuthService.DeletePreset
+type AuthServiceMockDeletePresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
 }
 
-// AuthServiceMockDeleteUserRoleParamPtrs contains pointers to parameters of the AuthService.DeleteUserRole
-type AuthServiceMockDeleteUserRoleParamPtrs struct {
-	ctx  *context.Context
-	role *auth.UserRole
+// AuthServiceMockDeletePresetParamPtrs contains pointers to parameters of the AuthService.DeletePreset
+type AuthServiceMockDeletePresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
 }
 
-// AuthServiceMockDeleteUserRoleResults contains results of the AuthService.DeleteUserRole
-type AuthServiceMockDeleteUserRoleResults struct {
+// AuthServiceMockDeletePresetResults contains results of the AuthService.DeletePreset
+type AuthServiceMockDeletePresetResults struct {
 	err error
 }
 
-// AuthServiceMockDeleteUserRoleOrigins contains origins of expectations of the AuthService.DeleteUserRole
-type AuthServiceMockDeleteUserRoleExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originRole string
+// AuthServiceMockDeletePresetOrigins contains origins of expectations of the AuthService.DeletePreset
+type AuthServiceMockDeletePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1225,342 +1337,344 @@ type AuthServiceMockDeleteUserRoleExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Optional() *mAuthServiceMockDeleteUserRole {
-	mmDeleteUserRole.optional = true
-	return mmDeleteUserRole
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Optional() *mAuthServiceMockDeletePreset {
+	mmDeletePreset.optional = true
+	return mmDeletePreset
 }
 
-// Expect sets up expected params for AuthService.DeleteUserRole
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Expect(ctx context.Context, role auth.UserRole) *mAuthServiceMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+// Expect sets up expected params for AuthService.DeletePreset
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Expect(ctx context.Context, id uuid.UUID) *mAuthServiceMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &AuthServiceMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by ExpectParams functions")
+	if mmDeletePreset.defaultExpectation.paramPtrs != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUserRole.defaultExpectation.params = &AuthServiceMockDeleteUserRoleParams{ctx, role}
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUserRole.expectations {
-		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
-			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+	mmDeletePreset.defaultExpectation.params = &AuthServiceMockDeletePresetParams{ctx, id}
+	mmDeletePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePreset.expectations {
+		if minimock.Equal(e.params, mmDeletePreset.defaultExpectation.params) {
+			mmDeletePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUserRole
+	return mmDeletePreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteUserRole
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DeletePreset
+func (mmDeletePreset *mAuthServiceMockDeletePreset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &AuthServiceMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Expect")
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRoleParamPtrs{}
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &AuthServiceMockDeletePresetParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeletePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmDeletePreset
 }
 
-// ExpectRoleParam2 sets up expected param role for AuthService.DeleteUserRole
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) ExpectRoleParam2(role auth.UserRole) *mAuthServiceMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for AuthService.DeletePreset
+func (mmDeletePreset *mAuthServiceMockDeletePreset) ExpectIdParam2(id uuid.UUID) *mAuthServiceMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &AuthServiceMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Expect")
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRoleParamPtrs{}
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &AuthServiceMockDeletePresetParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+	mmDeletePreset.defaultExpectation.paramPtrs.id = &id
+	mmDeletePreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmDeletePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteUserRole
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Inspect(f func(ctx context.Context, role auth.UserRole)) *mAuthServiceMockDeleteUserRole {
-	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteUserRole")
+// Inspect accepts an inspector function that has same arguments as the AuthService.DeletePreset
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mAuthServiceMockDeletePreset {
+	if mmDeletePreset.mock.inspectFuncDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeletePreset")
 	}
 
-	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+	mmDeletePreset.mock.inspectFuncDeletePreset = f
 
-	return mmDeleteUserRole
+	return mmDeletePreset
 }
 
-// Return sets up results that will be returned by AuthService.DeleteUserRole
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Return(err error) *AuthServiceMock {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+// Return sets up results that will be returned by AuthService.DeletePreset
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Return(err error) *AuthServiceMock {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &AuthServiceMockDeletePresetExpectation{mock: mmDeletePreset.mock}
 	}
-	mmDeleteUserRole.defaultExpectation.results = &AuthServiceMockDeleteUserRoleResults{err}
-	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+	mmDeletePreset.defaultExpectation.results = &AuthServiceMockDeletePresetResults{err}
+	mmDeletePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
 }
 
-// Set uses given function f to mock the AuthService.DeleteUserRole method
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Set(f func(ctx context.Context, role auth.UserRole) (err error)) *AuthServiceMock {
-	if mmDeleteUserRole.defaultExpectation != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteUserRole method")
+// Set uses given function f to mock the AuthService.DeletePreset method
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *AuthServiceMock {
+	if mmDeletePreset.defaultExpectation != nil {
+		mmDeletePreset.mock.t.Fatalf("Default expectation is already set for the AuthService.DeletePreset method")
 	}
 
-	if len(mmDeleteUserRole.expectations) > 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteUserRole method")
+	if len(mmDeletePreset.expectations) > 0 {
+		mmDeletePreset.mock.t.Fatalf("Some expectations are already set for the AuthService.DeletePreset method")
 	}
 
-	mmDeleteUserRole.mock.funcDeleteUserRole = f
-	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+	mmDeletePreset.mock.funcDeletePreset = f
+	mmDeletePreset.mock.funcDeletePresetOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
 }
 
-// When sets expectation for the AuthService.DeleteUserRole which will trigger the result defined by the following
+// When sets expectation for the AuthService.DeletePreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) When(ctx context.Context, role auth.UserRole) *AuthServiceMockDeleteUserRoleExpectation {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+func (mmDeletePreset *mAuthServiceMockDeletePreset) When(ctx context.Context, id uuid.UUID) *AuthServiceMockDeletePresetExpectation {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("AuthServiceMock.DeletePreset mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockDeleteUserRoleExpectation{
-		mock:               mmDeleteUserRole.mock,
-		params:             &AuthServiceMockDeleteUserRoleParams{ctx, role},
-		expectationOrigins: AuthServiceMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockDeletePresetExpectation{
+		mock:               mmDeletePreset.mock,
+		params:             &AuthServiceMockDeletePresetParams{ctx, id},
+		expectationOrigins: AuthServiceMockDeletePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	mmDeletePreset.expectations = append(mmDeletePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.DeleteUserRole return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockDeleteUserRoleExpectation) Then(err error) *AuthServiceMock {
-	e.results = &AuthServiceMockDeleteUserRoleResults{err}
+// Then sets up AuthService.DeletePreset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDeletePresetExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDeletePresetResults{err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.DeleteUserRole should be invoked
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Times(n uint64) *mAuthServiceMockDeleteUserRole {
+// Times sets number of times AuthService.DeletePreset should be invoked
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Times(n uint64) *mAuthServiceMockDeletePreset {
 	if n == 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Times of AuthServiceMock.DeleteUserRole mock can not be zero")
+		mmDeletePreset.mock.t.Fatalf("Times of AuthServiceMock.DeletePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
-	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole
+	mm_atomic.StoreUint64(&mmDeletePreset.expectedInvocations, n)
+	mmDeletePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset
 }
 
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) invocationsDone() bool {
-	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+func (mmDeletePreset *mAuthServiceMockDeletePreset) invocationsDone() bool {
+	if len(mmDeletePreset.expectations) == 0 && mmDeletePreset.defaultExpectation == nil && mmDeletePreset.mock.funcDeletePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePreset.mock.afterDeletePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUserRole implements mm_http.AuthService
-func (mmDeleteUserRole *AuthServiceMock) DeleteUserRole(ctx context.Context, role auth.UserRole) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+// DeletePreset implements mm_http.AuthService
+func (mmDeletePreset *AuthServiceMock) DeletePreset(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeletePreset.beforeDeletePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePreset.afterDeletePresetCounter, 1)
 
-	mmDeleteUserRole.t.Helper()
+	mmDeletePreset.t.Helper()
 
-	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	if mmDeletePreset.inspectFuncDeletePreset != nil {
+		mmDeletePreset.inspectFuncDeletePreset(ctx, id)
 	}
 
-	mm_params := AuthServiceMockDeleteUserRoleParams{ctx, role}
+	mm_params := AuthServiceMockDeletePresetParams{ctx, id}
 
 	// Record call args
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
-	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+	mmDeletePreset.DeletePresetMock.mutex.Lock()
+	mmDeletePreset.DeletePresetMock.callArgs = append(mmDeletePreset.DeletePresetMock.callArgs, &mm_params)
+	mmDeletePreset.DeletePresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+	for _, e := range mmDeletePreset.DeletePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+	if mmDeletePreset.DeletePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePreset.DeletePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePreset.DeletePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePreset.DeletePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockDeleteUserRoleParams{ctx, role}
+		mm_got := AuthServiceMockDeletePresetParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeletePreset.t.Errorf("AuthServiceMock.DeletePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
-				mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeletePreset.t.Errorf("AuthServiceMock.DeletePreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeletePreset.t.Errorf("AuthServiceMock.DeletePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		mm_results := mmDeletePreset.DeletePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUserRole.t.Fatal("No results are set for the AuthServiceMock.DeleteUserRole")
+			mmDeletePreset.t.Fatal("No results are set for the AuthServiceMock.DeletePreset")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteUserRole.funcDeleteUserRole != nil {
-		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	if mmDeletePreset.funcDeletePreset != nil {
+		return mmDeletePreset.funcDeletePreset(ctx, id)
 	}
-	mmDeleteUserRole.t.Fatalf("Unexpected call to AuthServiceMock.DeleteUserRole. %v %v", ctx, role)
+	mmDeletePreset.t.Fatalf("Unexpected call to AuthServiceMock.DeletePreset. %v %v", ctx, id)
 	return
 }
 
-// DeleteUserRoleAfterCounter returns a count of finished AuthServiceMock.DeleteUserRole invocations
-func (mmDeleteUserRole *AuthServiceMock) DeleteUserRoleAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+// DeletePresetAfterCounter returns a count of finished AuthServiceMock.DeletePreset invocations
+func (mmDeletePreset *AuthServiceMock) DeletePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.afterDeletePresetCounter)
 }
 
-// DeleteUserRoleBeforeCounter returns a count of AuthServiceMock.DeleteUserRole invocations
-func (mmDeleteUserRole *AuthServiceMock) DeleteUserRoleBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+// DeletePresetBeforeCounter returns a count of AuthServiceMock.DeletePreset invocations
+func (mmDeletePreset *AuthServiceMock) DeletePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.beforeDeletePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteUserRole.
+// Calls returns a list of arguments used in each call to AuthServiceMock.DeletePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Calls() []*AuthServiceMockDeleteUserRoleParams {
-	mmDeleteUserRole.mutex.RLock()
+func (mmDeletePreset *mAuthServiceMockDeletePreset) Calls() []*AuthServiceMockDeletePresetParams {
+	mmDeletePreset.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
-	copy(argCopy, mmDeleteUserRole.callArgs)
+	argCopy := make([]*AuthServiceMockDeletePresetParams, len(mmDeletePreset.callArgs))
+	copy(argCopy, mmDeletePreset.callArgs)
 
-	mmDeleteUserRole.mutex.RUnlock()
+	mmDeletePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// MinimockDeletePresetDone returns true if the count of the DeletePreset invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockDeleteUserRoleDone() bool {
-	if m.DeleteUserRoleMock.optional {
+func (m *AuthServiceMock) MinimockDeletePresetDone() bool {
+	if m.DeletePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserRoleMock.expectations {
+	for _, e := range m.DeletePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserRoleMock.invocationsDone()
+	return m.DeletePresetMock.invocationsDone()
 }
 
-// MinimockDeleteUserRoleInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockDeleteUserRoleInspect() {
-	for _, e := range m.DeleteUserRoleMock.expectations {
+// MinimockDeletePresetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDeletePresetInspect() {
+	for _, e := range m.DeletePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.DeletePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	afterDeletePresetCounter := mm_atomic.LoadUint64(&m.afterDeletePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
-		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+	if m.DeletePresetMock.defaultExpectation != nil && afterDeletePresetCounter < 1 {
+		if m.DeletePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DeletePreset at\n%s", m.DeletePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.DeletePreset at\n%s with params: %#v", m.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *m.DeletePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	if m.funcDeletePreset != nil && afterDeletePresetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DeletePreset at\n%s", m.funcDeletePresetOrigin)
 	}
 
-	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteUserRole at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	if !m.DeletePresetMock.invocationsDone() && afterDeletePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DeletePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePresetMock.expectedInvocations), m.DeletePresetMock.expectedInvocationsOrigin, afterDeletePresetCounter)
 	}
 }
 
-type mAuthServiceMockGetSessionsByUserID struct {
+type mAuthServiceMockDeleteSession struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockGetSessionsByUserIDExpectation
-	expectations       []*AuthServiceMockGetSessionsByUserIDExpectation
+	defaultExpectation *AuthServiceMockDeleteSessionExpectation
+	expectations       []*AuthServiceMockDeleteSessionExpectation
 
-	callArgs []*AuthServiceMockGetSessionsByUserIDParams
+	callArgs []*AuthServiceMockDeleteSessionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockGetSessionsByUserIDExpectation specifies expectation struct of the AuthService.GetSessionsByUserID
-type AuthServiceMockGetSessionsByUserIDExpectation struct {
+// AuthServiceMockDeleteSessionExpectation specifies expectation struct of the AuthService.DeleteSession
+type AuthServiceMockDeleteSessionExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockGetSessionsByUserIDParams
-	paramPtrs          *AuthServiceMockGetSessionsByUserIDParamPtrs
-	expectationOrigins AuthServiceMockGetSessionsByUserIDExpectationOrigins
-	results            *AuthServiceMockGetSessionsByUserIDResults
+	params             *AuthServiceMockDeleteSessionParams
+	paramPtrs          *AuthServiceMockDeleteSessionParamPtrs
+	expectationOrigins AuthServiceMockDeleteSessionExpectationOrigins
+	results            *AuthServiceMockDeleteSessionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockGetSessionsByUserIDParams contains parameters of the AuthService.GetSessionsByUserID
-type AuthServiceMockGetSessionsByUserIDParams struct {
+// AuthServiceMockDeleteSessionParams contains parameters of the AuthService.DeleteSession
+type AuthServiceMockDeleteSessionParams struct {
 	ctx    context.Context
 	userID uuid.UUID
+	id     uuid.UUID
 }
 
-// AuthServiceMockGetSessionsByUserIDParamPtrs contains pointers to parameters of the AuthService.GetSessionsByUserID
-type AuthServiceMockGetSessionsByUserIDParamPtrs struct {
+// AuthServiceMockDeleteSessionParamPtrs contains pointers to parameters of the AuthService.DeleteSession
+type AuthServiceMockDeleteSessionParamPtrs struct {
 	ctx    *context.Context
 	userID *uuid.UUID
+	id     *uuid.UUID
 }
 
-// AuthServiceMockGetSessionsByUserIDResults contains results of the AuthService.GetSessionsByUserID
-type AuthServiceMockGetSessionsByUserIDResults struct {
-	sa1 []auth.Session
+// AuthServiceMockDeleteSessionResults contains results of the AuthService.DeleteSession
+type AuthServiceMockDeleteSessionResults struct {
 	err error
 }
 
-// AuthServiceMockGetSessionsByUserIDOrigins contains origins of expectations of the AuthService.GetSessionsByUserID
-type AuthServiceMockGetSessionsByUserIDExpectationOrigins struct {
+// AuthServiceMockDeleteSessionOrigins contains origins of expectations of the AuthService.DeleteSession
+type AuthServiceMockDeleteSessionExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originUserID string
+	originId     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1568,342 +1682,4078 @@ type AuthServiceMockGetSessionsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Optional() *mAuthServiceMockGetSessionsByUserID {
-	mmGetSessionsByUserID.optional = true
-	return mmGetSessionsByUserID
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Optional() *mAuthServiceMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
 }
 
-// Expect sets up expected params for AuthService.GetSessionsByUserID
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+// Expect sets up expected params for AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Expect(ctx context.Context, userID uuid.UUID, id uuid.UUID) *mAuthServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by ExpectParams functions")
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by ExpectParams functions")
 	}
 
-	mmGetSessionsByUserID.defaultExpectation.params = &AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetSessionsByUserID.expectations {
-		if minimock.Equal(e.params, mmGetSessionsByUserID.defaultExpectation.params) {
-			mmGetSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionsByUserID.defaultExpectation.params)
+	mmDeleteSession.defaultExpectation.params = &AuthServiceMockDeleteSessionParams{ctx, userID, id}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
 		}
 	}
 
-	return mmGetSessionsByUserID
+	return mmDeleteSession
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.GetSessionsByUserID
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.params != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Expect")
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockGetSessionsByUserIDParamPtrs{}
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
 	}
-	mmGetSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetSessionsByUserID
+	return mmDeleteSession
 }
 
-// ExpectUserIDParam2 sets up expected param userID for AuthService.GetSessionsByUserID
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.params != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Expect")
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockGetSessionsByUserIDParamPtrs{}
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
 	}
-	mmGetSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetSessionsByUserID
+	return mmDeleteSession
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.GetSessionsByUserID
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetSessionsByUserID")
+// ExpectIdParam3 sets up expected param id for AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) ExpectIdParam3(id uuid.UUID) *mAuthServiceMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
 	}
 
-	mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID = f
-
-	return mmGetSessionsByUserID
-}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{}
+	}
 
-// Return sets up results that will be returned by AuthService.GetSessionsByUserID
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Return(sa1 []auth.Session, err error) *AuthServiceMock {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Expect")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{mock: mmGetSessionsByUserID.mock}
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionParamPtrs{}
 	}
-	mmGetSessionsByUserID.defaultExpectation.results = &AuthServiceMockGetSessionsByUserIDResults{sa1, err}
-	mmGetSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID.mock
+	mmDeleteSession.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteSession
 }
 
-// Set uses given function f to mock the AuthService.GetSessionsByUserID method
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)) *AuthServiceMock {
-	if mmGetSessionsByUserID.defaultExpectation != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the AuthService.GetSessionsByUserID method")
+// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Inspect(f func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *mAuthServiceMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteSession")
 	}
 
-	if len(mmGetSessionsByUserID.expectations) > 0 {
-		mmGetSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the AuthService.GetSessionsByUserID method")
-	}
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
 
-	mmGetSessionsByUserID.mock.funcGetSessionsByUserID = f
-	mmGetSessionsByUserID.mock.funcGetSessionsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID.mock
+	return mmDeleteSession
 }
 
-// When sets expectation for the AuthService.GetSessionsByUserID which will trigger the result defined by the following
-// Then helper
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockGetSessionsByUserIDExpectation {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+// Return sets up results that will be returned by AuthService.DeleteSession
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Return(err error) *AuthServiceMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockGetSessionsByUserIDExpectation{
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &AuthServiceMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &AuthServiceMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// Set uses given function f to mock the AuthService.DeleteSession method
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Set(f func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (err error)) *AuthServiceMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteSession method")
+	}
+
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteSession method")
+	}
+
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
+}
+
+// When sets expectation for the AuthService.DeleteSession which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSession *mAuthServiceMockDeleteSession) When(ctx context.Context, userID uuid.UUID, id uuid.UUID) *AuthServiceMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("AuthServiceMock.DeleteSession mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &AuthServiceMockDeleteSessionParams{ctx, userID, id},
+		expectationOrigins: AuthServiceMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDeleteSessionExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDeleteSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.DeleteSession should be invoked
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Times(n uint64) *mAuthServiceMockDeleteSession {
+	if n == 0 {
+		mmDeleteSession.mock.t.Fatalf("Times of AuthServiceMock.DeleteSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
+}
+
+func (mmDeleteSession *mAuthServiceMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSession implements mm_http.AuthService
+func (mmDeleteSession *AuthServiceMock) DeleteSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+
+	mmDeleteSession.t.Helper()
+
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, userID, id)
+	}
+
+	mm_params := AuthServiceMockDeleteSessionParams{ctx, userID, id}
+
+	// Record call args
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockDeleteSessionParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSession.t.Errorf("AuthServiceMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSession.t.Fatal("No results are set for the AuthServiceMock.DeleteSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, userID, id)
+	}
+	mmDeleteSession.t.Fatalf("Unexpected call to AuthServiceMock.DeleteSession. %v %v %v", ctx, userID, id)
+	return
+}
+
+// DeleteSessionAfterCounter returns a count of finished AuthServiceMock.DeleteSession invocations
+func (mmDeleteSession *AuthServiceMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+}
+
+// DeleteSessionBeforeCounter returns a count of AuthServiceMock.DeleteSession invocations
+func (mmDeleteSession *AuthServiceMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSession *mAuthServiceMockDeleteSession) Calls() []*AuthServiceMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
+
+	mmDeleteSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionMock.invocationsDone()
+}
+
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	}
+
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	}
+}
+
+type mAuthServiceMockDeleteSessionsByUserID struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockDeleteSessionsByUserIDExpectation
+	expectations       []*AuthServiceMockDeleteSessionsByUserIDExpectation
+
+	callArgs []*AuthServiceMockDeleteSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockDeleteSessionsByUserIDExpectation specifies expectation struct of the AuthService.DeleteSessionsByUserID
+type AuthServiceMockDeleteSessionsByUserIDExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockDeleteSessionsByUserIDParams
+	paramPtrs          *AuthServiceMockDeleteSessionsByUserIDParamPtrs
+	expectationOrigins AuthServiceMockDeleteSessionsByUserIDExpectationOrigins
+	results            *AuthServiceMockDeleteSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockDeleteSessionsByUserIDParams contains parameters of the AuthService.DeleteSessionsByUserID
+type AuthServiceMockDeleteSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthServiceMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the AuthService.DeleteSessionsByUserID
+type AuthServiceMockDeleteSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthServiceMockDeleteSessionsByUserIDResults contains results of the AuthService.DeleteSessionsByUserID
+type AuthServiceMockDeleteSessionsByUserIDResults struct {
+	err error
+}
+
+// AuthServiceMockDeleteSessionsByUserIDOrigins contains origins of expectations of the AuthService.DeleteSessionsByUserID
+type AuthServiceMockDeleteSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Optional() *mAuthServiceMockDeleteSessionsByUserID {
+	mmDeleteSessionsByUserID.optional = true
+	return mmDeleteSessionsByUserID
+}
+
+// Expect sets up expected params for AuthService.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSessionsByUserID.defaultExpectation.params = &AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
+			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{}
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockDeleteSessionsByUserIDParamPtrs{}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteSessionsByUserID")
+	}
+
+	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+
+	return mmDeleteSessionsByUserID
+}
+
+// Return sets up results that will be returned by AuthService.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Return(err error) *AuthServiceMock {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &AuthServiceMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	}
+	mmDeleteSessionsByUserID.defaultExpectation.results = &AuthServiceMockDeleteSessionsByUserIDResults{err}
+	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the AuthService.DeleteSessionsByUserID method
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *AuthServiceMock {
+	if mmDeleteSessionsByUserID.defaultExpectation != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteSessionsByUserID method")
+	}
+
+	if len(mmDeleteSessionsByUserID.expectations) > 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteSessionsByUserID method")
+	}
+
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
+}
+
+// When sets expectation for the AuthService.DeleteSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockDeleteSessionsByUserIDExpectation {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("AuthServiceMock.DeleteSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockDeleteSessionsByUserIDExpectation{
+		mock:               mmDeleteSessionsByUserID.mock,
+		params:             &AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: AuthServiceMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDeleteSessionsByUserIDExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDeleteSessionsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.DeleteSessionsByUserID should be invoked
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Times(n uint64) *mAuthServiceMockDeleteSessionsByUserID {
+	if n == 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of AuthServiceMock.DeleteSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
+	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID
+}
+
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) invocationsDone() bool {
+	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSessionsByUserID implements mm_http.AuthService
+func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+
+	mmDeleteSessionsByUserID.t.Helper()
+
+	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockDeleteSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSessionsByUserID.t.Errorf("AuthServiceMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSessionsByUserID.t.Fatal("No results are set for the AuthServiceMock.DeleteSessionsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
+		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	}
+	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to AuthServiceMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// DeleteSessionsByUserIDAfterCounter returns a count of finished AuthServiceMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+}
+
+// DeleteSessionsByUserIDBeforeCounter returns a count of AuthServiceMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *AuthServiceMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSessionsByUserID *mAuthServiceMockDeleteSessionsByUserID) Calls() []*AuthServiceMockDeleteSessionsByUserIDParams {
+	mmDeleteSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
+	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+
+	mmDeleteSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockDeleteSessionsByUserIDDone() bool {
+	if m.DeleteSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDeleteSessionsByUserIDInspect() {
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	}
+
+	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	}
+}
+
+type mAuthServiceMockDeleteUserRole struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockDeleteUserRoleExpectation
+	expectations       []*AuthServiceMockDeleteUserRoleExpectation
+
+	callArgs []*AuthServiceMockDeleteUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockDeleteUserRoleExpectation specifies expectation struct of the AuthService.DeleteUserRole
+type AuthServiceMockDeleteUserRoleExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockDeleteUserRoleParams
+	paramPtrs          *AuthServiceMockDeleteUserRoleParamPtrs
+	expectationOrigins AuthServiceMockDeleteUserRoleExpectationOrigins
+	results            *AuthServiceMockDeleteUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockDeleteUserRoleParams contains parameters of the AuthService.DeleteUserRole
+type AuthServiceMockDeleteUserRoleParams struct {
+	ctx  context.Context
+	role auth.UserRole
+}
+
+// AuthServiceMockDeleteUserRoleParamPtrs contains pointers to parameters of the AuthService.DeleteUserRole
+type AuthServiceMockDeleteUserRoleParamPtrs struct {
+	ctx  *context.Context
+	role *auth.UserRole
+}
+
+// AuthServiceMockDeleteUserRoleResults contains results of the AuthService.DeleteUserRole
+type AuthServiceMockDeleteUserRoleResults struct {
+	err error
+}
+
+// AuthServiceMockDeleteUserRoleOrigins contains origins of expectations of the AuthService.DeleteUserRole
+type AuthServiceMockDeleteUserRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Optional() *mAuthServiceMockDeleteUserRole {
+	mmDeleteUserRole.optional = true
+	return mmDeleteUserRole
+}
+
+// Expect sets up expected params for AuthService.DeleteUserRole
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Expect(ctx context.Context, role auth.UserRole) *mAuthServiceMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRole.defaultExpectation.params = &AuthServiceMockDeleteUserRoleParams{ctx, role}
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRole.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
+			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteUserRole
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// ExpectRoleParam2 sets up expected param role for AuthService.DeleteUserRole
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) ExpectRoleParam2(role auth.UserRole) *mAuthServiceMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteUserRole
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Inspect(f func(ctx context.Context, role auth.UserRole)) *mAuthServiceMockDeleteUserRole {
+	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteUserRole")
+	}
+
+	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+
+	return mmDeleteUserRole
+}
+
+// Return sets up results that will be returned by AuthService.DeleteUserRole
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Return(err error) *AuthServiceMock {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &AuthServiceMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	}
+	mmDeleteUserRole.defaultExpectation.results = &AuthServiceMockDeleteUserRoleResults{err}
+	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// Set uses given function f to mock the AuthService.DeleteUserRole method
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Set(f func(ctx context.Context, role auth.UserRole) (err error)) *AuthServiceMock {
+	if mmDeleteUserRole.defaultExpectation != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteUserRole method")
+	}
+
+	if len(mmDeleteUserRole.expectations) > 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteUserRole method")
+	}
+
+	mmDeleteUserRole.mock.funcDeleteUserRole = f
+	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// When sets expectation for the AuthService.DeleteUserRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) When(ctx context.Context, role auth.UserRole) *AuthServiceMockDeleteUserRoleExpectation {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("AuthServiceMock.DeleteUserRole mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockDeleteUserRoleExpectation{
+		mock:               mmDeleteUserRole.mock,
+		params:             &AuthServiceMockDeleteUserRoleParams{ctx, role},
+		expectationOrigins: AuthServiceMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.DeleteUserRole return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDeleteUserRoleExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDeleteUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.DeleteUserRole should be invoked
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Times(n uint64) *mAuthServiceMockDeleteUserRole {
+	if n == 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Times of AuthServiceMock.DeleteUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
+	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole
+}
+
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) invocationsDone() bool {
+	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRole implements mm_http.AuthService
+func (mmDeleteUserRole *AuthServiceMock) DeleteUserRole(ctx context.Context, role auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+
+	mmDeleteUserRole.t.Helper()
+
+	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	}
+
+	mm_params := AuthServiceMockDeleteUserRoleParams{ctx, role}
+
+	// Record call args
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
+	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockDeleteUserRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRole.t.Errorf("AuthServiceMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRole.t.Fatal("No results are set for the AuthServiceMock.DeleteUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserRole.funcDeleteUserRole != nil {
+		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	}
+	mmDeleteUserRole.t.Fatalf("Unexpected call to AuthServiceMock.DeleteUserRole. %v %v", ctx, role)
+	return
+}
+
+// DeleteUserRoleAfterCounter returns a count of finished AuthServiceMock.DeleteUserRole invocations
+func (mmDeleteUserRole *AuthServiceMock) DeleteUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+}
+
+// DeleteUserRoleBeforeCounter returns a count of AuthServiceMock.DeleteUserRole invocations
+func (mmDeleteUserRole *AuthServiceMock) DeleteUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRole *mAuthServiceMockDeleteUserRole) Calls() []*AuthServiceMockDeleteUserRoleParams {
+	mmDeleteUserRole.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
+	copy(argCopy, mmDeleteUserRole.callArgs)
+
+	mmDeleteUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockDeleteUserRoleDone() bool {
+	if m.DeleteUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRoleMock.invocationsDone()
+}
+
+// MinimockDeleteUserRoleInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDeleteUserRoleInspect() {
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
+		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	}
+
+	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	}
+}
+
+type mAuthServiceMockGetConsistencyReport struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetConsistencyReportExpectation
+	expectations       []*AuthServiceMockGetConsistencyReportExpectation
+
+	callArgs []*AuthServiceMockGetConsistencyReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetConsistencyReportExpectation specifies expectation struct of the AuthService.GetConsistencyReport
+type AuthServiceMockGetConsistencyReportExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetConsistencyReportParams
+	paramPtrs          *AuthServiceMockGetConsistencyReportParamPtrs
+	expectationOrigins AuthServiceMockGetConsistencyReportExpectationOrigins
+	results            *AuthServiceMockGetConsistencyReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetConsistencyReportParams contains parameters of the AuthService.GetConsistencyReport
+type AuthServiceMockGetConsistencyReportParams struct {
+	ctx context.Context
+}
+
+// AuthServiceMockGetConsistencyReportParamPtrs contains pointers to parameters of the AuthService.GetConsistencyReport
+type AuthServiceMockGetConsistencyReportParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuthServiceMockGetConsistencyReportResults contains results of the AuthService.GetConsistencyReport
+type AuthServiceMockGetConsistencyReportResults struct {
+	c2  auth.ConsistencyReport
+	err error
+}
+
+// AuthServiceMockGetConsistencyReportOrigins contains origins of expectations of the AuthService.GetConsistencyReport
+type AuthServiceMockGetConsistencyReportExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Optional() *mAuthServiceMockGetConsistencyReport {
+	mmGetConsistencyReport.optional = true
+	return mmGetConsistencyReport
+}
+
+// Expect sets up expected params for AuthService.GetConsistencyReport
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Expect(ctx context.Context) *mAuthServiceMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by Set")
+	}
+
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &AuthServiceMockGetConsistencyReportExpectation{}
+	}
+
+	if mmGetConsistencyReport.defaultExpectation.paramPtrs != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by ExpectParams functions")
+	}
+
+	mmGetConsistencyReport.defaultExpectation.params = &AuthServiceMockGetConsistencyReportParams{ctx}
+	mmGetConsistencyReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetConsistencyReport.expectations {
+		if minimock.Equal(e.params, mmGetConsistencyReport.defaultExpectation.params) {
+			mmGetConsistencyReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetConsistencyReport.defaultExpectation.params)
+		}
+	}
+
+	return mmGetConsistencyReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetConsistencyReport
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by Set")
+	}
+
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &AuthServiceMockGetConsistencyReportExpectation{}
+	}
+
+	if mmGetConsistencyReport.defaultExpectation.params != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by Expect")
+	}
+
+	if mmGetConsistencyReport.defaultExpectation.paramPtrs == nil {
+		mmGetConsistencyReport.defaultExpectation.paramPtrs = &AuthServiceMockGetConsistencyReportParamPtrs{}
+	}
+	mmGetConsistencyReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetConsistencyReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetConsistencyReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetConsistencyReport
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Inspect(f func(ctx context.Context)) *mAuthServiceMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.inspectFuncGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetConsistencyReport")
+	}
+
+	mmGetConsistencyReport.mock.inspectFuncGetConsistencyReport = f
+
+	return mmGetConsistencyReport
+}
+
+// Return sets up results that will be returned by AuthService.GetConsistencyReport
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Return(c2 auth.ConsistencyReport, err error) *AuthServiceMock {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by Set")
+	}
+
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &AuthServiceMockGetConsistencyReportExpectation{mock: mmGetConsistencyReport.mock}
+	}
+	mmGetConsistencyReport.defaultExpectation.results = &AuthServiceMockGetConsistencyReportResults{c2, err}
+	mmGetConsistencyReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport.mock
+}
+
+// Set uses given function f to mock the AuthService.GetConsistencyReport method
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Set(f func(ctx context.Context) (c2 auth.ConsistencyReport, err error)) *AuthServiceMock {
+	if mmGetConsistencyReport.defaultExpectation != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("Default expectation is already set for the AuthService.GetConsistencyReport method")
+	}
+
+	if len(mmGetConsistencyReport.expectations) > 0 {
+		mmGetConsistencyReport.mock.t.Fatalf("Some expectations are already set for the AuthService.GetConsistencyReport method")
+	}
+
+	mmGetConsistencyReport.mock.funcGetConsistencyReport = f
+	mmGetConsistencyReport.mock.funcGetConsistencyReportOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport.mock
+}
+
+// When sets expectation for the AuthService.GetConsistencyReport which will trigger the result defined by the following
+// Then helper
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) When(ctx context.Context) *AuthServiceMockGetConsistencyReportExpectation {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("AuthServiceMock.GetConsistencyReport mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetConsistencyReportExpectation{
+		mock:               mmGetConsistencyReport.mock,
+		params:             &AuthServiceMockGetConsistencyReportParams{ctx},
+		expectationOrigins: AuthServiceMockGetConsistencyReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetConsistencyReport.expectations = append(mmGetConsistencyReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetConsistencyReport return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetConsistencyReportExpectation) Then(c2 auth.ConsistencyReport, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetConsistencyReportResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetConsistencyReport should be invoked
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Times(n uint64) *mAuthServiceMockGetConsistencyReport {
+	if n == 0 {
+		mmGetConsistencyReport.mock.t.Fatalf("Times of AuthServiceMock.GetConsistencyReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetConsistencyReport.expectedInvocations, n)
+	mmGetConsistencyReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport
+}
+
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) invocationsDone() bool {
+	if len(mmGetConsistencyReport.expectations) == 0 && mmGetConsistencyReport.defaultExpectation == nil && mmGetConsistencyReport.mock.funcGetConsistencyReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetConsistencyReport.mock.afterGetConsistencyReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetConsistencyReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetConsistencyReport implements mm_http.AuthService
+func (mmGetConsistencyReport *AuthServiceMock) GetConsistencyReport(ctx context.Context) (c2 auth.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmGetConsistencyReport.beforeGetConsistencyReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetConsistencyReport.afterGetConsistencyReportCounter, 1)
+
+	mmGetConsistencyReport.t.Helper()
+
+	if mmGetConsistencyReport.inspectFuncGetConsistencyReport != nil {
+		mmGetConsistencyReport.inspectFuncGetConsistencyReport(ctx)
+	}
+
+	mm_params := AuthServiceMockGetConsistencyReportParams{ctx}
+
+	// Record call args
+	mmGetConsistencyReport.GetConsistencyReportMock.mutex.Lock()
+	mmGetConsistencyReport.GetConsistencyReportMock.callArgs = append(mmGetConsistencyReport.GetConsistencyReportMock.callArgs, &mm_params)
+	mmGetConsistencyReport.GetConsistencyReportMock.mutex.Unlock()
+
+	for _, e := range mmGetConsistencyReport.GetConsistencyReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.params
+		mm_want_ptrs := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetConsistencyReportParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetConsistencyReport.t.Errorf("AuthServiceMock.GetConsistencyReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetConsistencyReport.t.Errorf("AuthServiceMock.GetConsistencyReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetConsistencyReport.t.Fatal("No results are set for the AuthServiceMock.GetConsistencyReport")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetConsistencyReport.funcGetConsistencyReport != nil {
+		return mmGetConsistencyReport.funcGetConsistencyReport(ctx)
+	}
+	mmGetConsistencyReport.t.Fatalf("Unexpected call to AuthServiceMock.GetConsistencyReport. %v", ctx)
+	return
+}
+
+// GetConsistencyReportAfterCounter returns a count of finished AuthServiceMock.GetConsistencyReport invocations
+func (mmGetConsistencyReport *AuthServiceMock) GetConsistencyReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConsistencyReport.afterGetConsistencyReportCounter)
+}
+
+// GetConsistencyReportBeforeCounter returns a count of AuthServiceMock.GetConsistencyReport invocations
+func (mmGetConsistencyReport *AuthServiceMock) GetConsistencyReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConsistencyReport.beforeGetConsistencyReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetConsistencyReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetConsistencyReport *mAuthServiceMockGetConsistencyReport) Calls() []*AuthServiceMockGetConsistencyReportParams {
+	mmGetConsistencyReport.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetConsistencyReportParams, len(mmGetConsistencyReport.callArgs))
+	copy(argCopy, mmGetConsistencyReport.callArgs)
+
+	mmGetConsistencyReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetConsistencyReportDone returns true if the count of the GetConsistencyReport invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetConsistencyReportDone() bool {
+	if m.GetConsistencyReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetConsistencyReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetConsistencyReportMock.invocationsDone()
+}
+
+// MinimockGetConsistencyReportInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetConsistencyReportInspect() {
+	for _, e := range m.GetConsistencyReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetConsistencyReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetConsistencyReportCounter := mm_atomic.LoadUint64(&m.afterGetConsistencyReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetConsistencyReportMock.defaultExpectation != nil && afterGetConsistencyReportCounter < 1 {
+		if m.GetConsistencyReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetConsistencyReport at\n%s", m.GetConsistencyReportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetConsistencyReport at\n%s with params: %#v", m.GetConsistencyReportMock.defaultExpectation.expectationOrigins.origin, *m.GetConsistencyReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetConsistencyReport != nil && afterGetConsistencyReportCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetConsistencyReport at\n%s", m.funcGetConsistencyReportOrigin)
+	}
+
+	if !m.GetConsistencyReportMock.invocationsDone() && afterGetConsistencyReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetConsistencyReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetConsistencyReportMock.expectedInvocations), m.GetConsistencyReportMock.expectedInvocationsOrigin, afterGetConsistencyReportCounter)
+	}
+}
+
+type mAuthServiceMockGetLoginHistory struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetLoginHistoryExpectation
+	expectations       []*AuthServiceMockGetLoginHistoryExpectation
+
+	callArgs []*AuthServiceMockGetLoginHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetLoginHistoryExpectation specifies expectation struct of the AuthService.GetLoginHistory
+type AuthServiceMockGetLoginHistoryExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetLoginHistoryParams
+	paramPtrs          *AuthServiceMockGetLoginHistoryParamPtrs
+	expectationOrigins AuthServiceMockGetLoginHistoryExpectationOrigins
+	results            *AuthServiceMockGetLoginHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetLoginHistoryParams contains parameters of the AuthService.GetLoginHistory
+type AuthServiceMockGetLoginHistoryParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	limit  int
+}
+
+// AuthServiceMockGetLoginHistoryParamPtrs contains pointers to parameters of the AuthService.GetLoginHistory
+type AuthServiceMockGetLoginHistoryParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	limit  *int
+}
+
+// AuthServiceMockGetLoginHistoryResults contains results of the AuthService.GetLoginHistory
+type AuthServiceMockGetLoginHistoryResults struct {
+	la1 []auth.LoginEvent
+	err error
+}
+
+// AuthServiceMockGetLoginHistoryOrigins contains origins of expectations of the AuthService.GetLoginHistory
+type AuthServiceMockGetLoginHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Optional() *mAuthServiceMockGetLoginHistory {
+	mmGetLoginHistory.optional = true
+	return mmGetLoginHistory
+}
+
+// Expect sets up expected params for AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Expect(ctx context.Context, userID uuid.UUID, limit int) *mAuthServiceMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &AuthServiceMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetLoginHistory.defaultExpectation.params = &AuthServiceMockGetLoginHistoryParams{ctx, userID, limit}
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLoginHistory.expectations {
+		if minimock.Equal(e.params, mmGetLoginHistory.defaultExpectation.params) {
+			mmGetLoginHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLoginHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLoginHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &AuthServiceMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &AuthServiceMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &AuthServiceMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &AuthServiceMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.userID = &userID
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// ExpectLimitParam3 sets up expected param limit for AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) ExpectLimitParam3(limit int) *mAuthServiceMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &AuthServiceMockGetLoginHistoryExpectation{}
+	}
+
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Expect")
+	}
+
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &AuthServiceMockGetLoginHistoryParamPtrs{}
+	}
+	mmGetLoginHistory.defaultExpectation.paramPtrs.limit = &limit
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetLoginHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Inspect(f func(ctx context.Context, userID uuid.UUID, limit int)) *mAuthServiceMockGetLoginHistory {
+	if mmGetLoginHistory.mock.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetLoginHistory")
+	}
+
+	mmGetLoginHistory.mock.inspectFuncGetLoginHistory = f
+
+	return mmGetLoginHistory
+}
+
+// Return sets up results that will be returned by AuthService.GetLoginHistory
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Return(la1 []auth.LoginEvent, err error) *AuthServiceMock {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &AuthServiceMockGetLoginHistoryExpectation{mock: mmGetLoginHistory.mock}
+	}
+	mmGetLoginHistory.defaultExpectation.results = &AuthServiceMockGetLoginHistoryResults{la1, err}
+	mmGetLoginHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// Set uses given function f to mock the AuthService.GetLoginHistory method
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Set(f func(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error)) *AuthServiceMock {
+	if mmGetLoginHistory.defaultExpectation != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Default expectation is already set for the AuthService.GetLoginHistory method")
+	}
+
+	if len(mmGetLoginHistory.expectations) > 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Some expectations are already set for the AuthService.GetLoginHistory method")
+	}
+
+	mmGetLoginHistory.mock.funcGetLoginHistory = f
+	mmGetLoginHistory.mock.funcGetLoginHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// When sets expectation for the AuthService.GetLoginHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) When(ctx context.Context, userID uuid.UUID, limit int) *AuthServiceMockGetLoginHistoryExpectation {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("AuthServiceMock.GetLoginHistory mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetLoginHistoryExpectation{
+		mock:               mmGetLoginHistory.mock,
+		params:             &AuthServiceMockGetLoginHistoryParams{ctx, userID, limit},
+		expectationOrigins: AuthServiceMockGetLoginHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLoginHistory.expectations = append(mmGetLoginHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetLoginHistory return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetLoginHistoryExpectation) Then(la1 []auth.LoginEvent, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetLoginHistoryResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetLoginHistory should be invoked
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Times(n uint64) *mAuthServiceMockGetLoginHistory {
+	if n == 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Times of AuthServiceMock.GetLoginHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLoginHistory.expectedInvocations, n)
+	mmGetLoginHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory
+}
+
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) invocationsDone() bool {
+	if len(mmGetLoginHistory.expectations) == 0 && mmGetLoginHistory.defaultExpectation == nil && mmGetLoginHistory.mock.funcGetLoginHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.mock.afterGetLoginHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLoginHistory implements mm_http.AuthService
+func (mmGetLoginHistory *AuthServiceMock) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error) {
+	mm_atomic.AddUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter, 1)
+
+	mmGetLoginHistory.t.Helper()
+
+	if mmGetLoginHistory.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.inspectFuncGetLoginHistory(ctx, userID, limit)
+	}
+
+	mm_params := AuthServiceMockGetLoginHistoryParams{ctx, userID, limit}
+
+	// Record call args
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Lock()
+	mmGetLoginHistory.GetLoginHistoryMock.callArgs = append(mmGetLoginHistory.GetLoginHistoryMock.callArgs, &mm_params)
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetLoginHistory.GetLoginHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetLoginHistoryParams{ctx, userID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLoginHistory.t.Errorf("AuthServiceMock.GetLoginHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetLoginHistory.t.Errorf("AuthServiceMock.GetLoginHistory got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetLoginHistory.t.Errorf("AuthServiceMock.GetLoginHistory got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLoginHistory.t.Errorf("AuthServiceMock.GetLoginHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLoginHistory.t.Fatal("No results are set for the AuthServiceMock.GetLoginHistory")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmGetLoginHistory.funcGetLoginHistory != nil {
+		return mmGetLoginHistory.funcGetLoginHistory(ctx, userID, limit)
+	}
+	mmGetLoginHistory.t.Fatalf("Unexpected call to AuthServiceMock.GetLoginHistory. %v %v %v", ctx, userID, limit)
+	return
+}
+
+// GetLoginHistoryAfterCounter returns a count of finished AuthServiceMock.GetLoginHistory invocations
+func (mmGetLoginHistory *AuthServiceMock) GetLoginHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter)
+}
+
+// GetLoginHistoryBeforeCounter returns a count of AuthServiceMock.GetLoginHistory invocations
+func (mmGetLoginHistory *AuthServiceMock) GetLoginHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetLoginHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLoginHistory *mAuthServiceMockGetLoginHistory) Calls() []*AuthServiceMockGetLoginHistoryParams {
+	mmGetLoginHistory.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetLoginHistoryParams, len(mmGetLoginHistory.callArgs))
+	copy(argCopy, mmGetLoginHistory.callArgs)
+
+	mmGetLoginHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLoginHistoryDone returns true if the count of the GetLoginHistory invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetLoginHistoryDone() bool {
+	if m.GetLoginHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLoginHistoryMock.invocationsDone()
+}
+
+// MinimockGetLoginHistoryInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetLoginHistoryInspect() {
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetLoginHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLoginHistoryCounter := mm_atomic.LoadUint64(&m.afterGetLoginHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLoginHistoryMock.defaultExpectation != nil && afterGetLoginHistoryCounter < 1 {
+		if m.GetLoginHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetLoginHistory at\n%s", m.GetLoginHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetLoginHistory at\n%s with params: %#v", m.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetLoginHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLoginHistory != nil && afterGetLoginHistoryCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetLoginHistory at\n%s", m.funcGetLoginHistoryOrigin)
+	}
+
+	if !m.GetLoginHistoryMock.invocationsDone() && afterGetLoginHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetLoginHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLoginHistoryMock.expectedInvocations), m.GetLoginHistoryMock.expectedInvocationsOrigin, afterGetLoginHistoryCounter)
+	}
+}
+
+type mAuthServiceMockGetPreset struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetPresetExpectation
+	expectations       []*AuthServiceMockGetPresetExpectation
+
+	callArgs []*AuthServiceMockGetPresetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetPresetExpectation specifies expectation struct of the AuthService.GetPreset
+type AuthServiceMockGetPresetExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetPresetParams
+	paramPtrs          *AuthServiceMockGetPresetParamPtrs
+	expectationOrigins AuthServiceMockGetPresetExpectationOrigins
+	results            *AuthServiceMockGetPresetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetPresetParams contains parameters of the AuthService.GetPreset
+type AuthServiceMockGetPresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// AuthServiceMockGetPresetParamPtrs contains pointers to parameters of the AuthService.GetPreset
+type AuthServiceMockGetPresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// AuthServiceMockGetPresetResults contains results of the AuthService.GetPreset
+type AuthServiceMockGetPresetResults struct {
+	r1  auth.RolePreset
+	err error
+}
+
+// AuthServiceMockGetPresetOrigins contains origins of expectations of the AuthService.GetPreset
+type AuthServiceMockGetPresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPreset *mAuthServiceMockGetPreset) Optional() *mAuthServiceMockGetPreset {
+	mmGetPreset.optional = true
+	return mmGetPreset
+}
+
+// Expect sets up expected params for AuthService.GetPreset
+func (mmGetPreset *mAuthServiceMockGetPreset) Expect(ctx context.Context, id uuid.UUID) *mAuthServiceMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &AuthServiceMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by ExpectParams functions")
+	}
+
+	mmGetPreset.defaultExpectation.params = &AuthServiceMockGetPresetParams{ctx, id}
+	mmGetPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreset.expectations {
+		if minimock.Equal(e.params, mmGetPreset.defaultExpectation.params) {
+			mmGetPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreset.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPreset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetPreset
+func (mmGetPreset *mAuthServiceMockGetPreset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &AuthServiceMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Expect")
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &AuthServiceMockGetPresetParamPtrs{}
+	}
+	mmGetPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPreset
+}
+
+// ExpectIdParam2 sets up expected param id for AuthService.GetPreset
+func (mmGetPreset *mAuthServiceMockGetPreset) ExpectIdParam2(id uuid.UUID) *mAuthServiceMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &AuthServiceMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Expect")
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &AuthServiceMockGetPresetParamPtrs{}
+	}
+	mmGetPreset.defaultExpectation.paramPtrs.id = &id
+	mmGetPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetPreset
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetPreset
+func (mmGetPreset *mAuthServiceMockGetPreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mAuthServiceMockGetPreset {
+	if mmGetPreset.mock.inspectFuncGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetPreset")
+	}
+
+	mmGetPreset.mock.inspectFuncGetPreset = f
+
+	return mmGetPreset
+}
+
+// Return sets up results that will be returned by AuthService.GetPreset
+func (mmGetPreset *mAuthServiceMockGetPreset) Return(r1 auth.RolePreset, err error) *AuthServiceMock {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &AuthServiceMockGetPresetExpectation{mock: mmGetPreset.mock}
+	}
+	mmGetPreset.defaultExpectation.results = &AuthServiceMockGetPresetResults{r1, err}
+	mmGetPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
+}
+
+// Set uses given function f to mock the AuthService.GetPreset method
+func (mmGetPreset *mAuthServiceMockGetPreset) Set(f func(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error)) *AuthServiceMock {
+	if mmGetPreset.defaultExpectation != nil {
+		mmGetPreset.mock.t.Fatalf("Default expectation is already set for the AuthService.GetPreset method")
+	}
+
+	if len(mmGetPreset.expectations) > 0 {
+		mmGetPreset.mock.t.Fatalf("Some expectations are already set for the AuthService.GetPreset method")
+	}
+
+	mmGetPreset.mock.funcGetPreset = f
+	mmGetPreset.mock.funcGetPresetOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
+}
+
+// When sets expectation for the AuthService.GetPreset which will trigger the result defined by the following
+// Then helper
+func (mmGetPreset *mAuthServiceMockGetPreset) When(ctx context.Context, id uuid.UUID) *AuthServiceMockGetPresetExpectation {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("AuthServiceMock.GetPreset mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetPresetExpectation{
+		mock:               mmGetPreset.mock,
+		params:             &AuthServiceMockGetPresetParams{ctx, id},
+		expectationOrigins: AuthServiceMockGetPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPreset.expectations = append(mmGetPreset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetPreset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetPresetExpectation) Then(r1 auth.RolePreset, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetPresetResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetPreset should be invoked
+func (mmGetPreset *mAuthServiceMockGetPreset) Times(n uint64) *mAuthServiceMockGetPreset {
+	if n == 0 {
+		mmGetPreset.mock.t.Fatalf("Times of AuthServiceMock.GetPreset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPreset.expectedInvocations, n)
+	mmGetPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreset
+}
+
+func (mmGetPreset *mAuthServiceMockGetPreset) invocationsDone() bool {
+	if len(mmGetPreset.expectations) == 0 && mmGetPreset.defaultExpectation == nil && mmGetPreset.mock.funcGetPreset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreset.mock.afterGetPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPreset implements mm_http.AuthService
+func (mmGetPreset *AuthServiceMock) GetPreset(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmGetPreset.beforeGetPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreset.afterGetPresetCounter, 1)
+
+	mmGetPreset.t.Helper()
+
+	if mmGetPreset.inspectFuncGetPreset != nil {
+		mmGetPreset.inspectFuncGetPreset(ctx, id)
+	}
+
+	mm_params := AuthServiceMockGetPresetParams{ctx, id}
+
+	// Record call args
+	mmGetPreset.GetPresetMock.mutex.Lock()
+	mmGetPreset.GetPresetMock.callArgs = append(mmGetPreset.GetPresetMock.callArgs, &mm_params)
+	mmGetPreset.GetPresetMock.mutex.Unlock()
+
+	for _, e := range mmGetPreset.GetPresetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetPreset.GetPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreset.GetPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreset.GetPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreset.GetPresetMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetPresetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPreset.t.Errorf("AuthServiceMock.GetPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPreset.t.Errorf("AuthServiceMock.GetPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPreset.t.Errorf("AuthServiceMock.GetPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPreset.GetPresetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPreset.t.Fatal("No results are set for the AuthServiceMock.GetPreset")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetPreset.funcGetPreset != nil {
+		return mmGetPreset.funcGetPreset(ctx, id)
+	}
+	mmGetPreset.t.Fatalf("Unexpected call to AuthServiceMock.GetPreset. %v %v", ctx, id)
+	return
+}
+
+// GetPresetAfterCounter returns a count of finished AuthServiceMock.GetPreset invocations
+func (mmGetPreset *AuthServiceMock) GetPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.afterGetPresetCounter)
+}
+
+// GetPresetBeforeCounter returns a count of AuthServiceMock.GetPreset invocations
+func (mmGetPreset *AuthServiceMock) GetPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.beforeGetPresetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetPreset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPreset *mAuthServiceMockGetPreset) Calls() []*AuthServiceMockGetPresetParams {
+	mmGetPreset.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetPresetParams, len(mmGetPreset.callArgs))
+	copy(argCopy, mmGetPreset.callArgs)
+
+	mmGetPreset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresetDone returns true if the count of the GetPreset invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetPresetDone() bool {
+	if m.GetPresetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresetMock.invocationsDone()
+}
+
+// MinimockGetPresetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetPresetInspect() {
+	for _, e := range m.GetPresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPresetCounter := mm_atomic.LoadUint64(&m.afterGetPresetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresetMock.defaultExpectation != nil && afterGetPresetCounter < 1 {
+		if m.GetPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetPreset at\n%s", m.GetPresetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetPreset at\n%s with params: %#v", m.GetPresetMock.defaultExpectation.expectationOrigins.origin, *m.GetPresetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPreset != nil && afterGetPresetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetPreset at\n%s", m.funcGetPresetOrigin)
+	}
+
+	if !m.GetPresetMock.invocationsDone() && afterGetPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresetMock.expectedInvocations), m.GetPresetMock.expectedInvocationsOrigin, afterGetPresetCounter)
+	}
+}
+
+type mAuthServiceMockGetSessionsByUserID struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetSessionsByUserIDExpectation
+	expectations       []*AuthServiceMockGetSessionsByUserIDExpectation
+
+	callArgs []*AuthServiceMockGetSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetSessionsByUserIDExpectation specifies expectation struct of the AuthService.GetSessionsByUserID
+type AuthServiceMockGetSessionsByUserIDExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetSessionsByUserIDParams
+	paramPtrs          *AuthServiceMockGetSessionsByUserIDParamPtrs
+	expectationOrigins AuthServiceMockGetSessionsByUserIDExpectationOrigins
+	results            *AuthServiceMockGetSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetSessionsByUserIDParams contains parameters of the AuthService.GetSessionsByUserID
+type AuthServiceMockGetSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthServiceMockGetSessionsByUserIDParamPtrs contains pointers to parameters of the AuthService.GetSessionsByUserID
+type AuthServiceMockGetSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthServiceMockGetSessionsByUserIDResults contains results of the AuthService.GetSessionsByUserID
+type AuthServiceMockGetSessionsByUserIDResults struct {
+	sa1 []auth.Session
+	err error
+}
+
+// AuthServiceMockGetSessionsByUserIDOrigins contains origins of expectations of the AuthService.GetSessionsByUserID
+type AuthServiceMockGetSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Optional() *mAuthServiceMockGetSessionsByUserID {
+	mmGetSessionsByUserID.optional = true
+	return mmGetSessionsByUserID
+}
+
+// Expect sets up expected params for AuthService.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetSessionsByUserID.defaultExpectation.params = &AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmGetSessionsByUserID.defaultExpectation.params) {
+			mmGetSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthServiceMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetSessionsByUserID")
+	}
+
+	mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID = f
+
+	return mmGetSessionsByUserID
+}
+
+// Return sets up results that will be returned by AuthService.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Return(sa1 []auth.Session, err error) *AuthServiceMock {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthServiceMockGetSessionsByUserIDExpectation{mock: mmGetSessionsByUserID.mock}
+	}
+	mmGetSessionsByUserID.defaultExpectation.results = &AuthServiceMockGetSessionsByUserIDResults{sa1, err}
+	mmGetSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the AuthService.GetSessionsByUserID method
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)) *AuthServiceMock {
+	if mmGetSessionsByUserID.defaultExpectation != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the AuthService.GetSessionsByUserID method")
+	}
+
+	if len(mmGetSessionsByUserID.expectations) > 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the AuthService.GetSessionsByUserID method")
+	}
+
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserID = f
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// When sets expectation for the AuthService.GetSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockGetSessionsByUserIDExpectation {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthServiceMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetSessionsByUserIDExpectation{
 		mock:               mmGetSessionsByUserID.mock,
 		params:             &AuthServiceMockGetSessionsByUserIDParams{ctx, userID},
 		expectationOrigins: AuthServiceMockGetSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetSessionsByUserID.expectations = append(mmGetSessionsByUserID.expectations, expectation)
+	mmGetSessionsByUserID.expectations = append(mmGetSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetSessionsByUserIDExpectation) Then(sa1 []auth.Session, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetSessionsByUserIDResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetSessionsByUserID should be invoked
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Times(n uint64) *mAuthServiceMockGetSessionsByUserID {
+	if n == 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Times of AuthServiceMock.GetSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSessionsByUserID.expectedInvocations, n)
+	mmGetSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID
+}
+
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) invocationsDone() bool {
+	if len(mmGetSessionsByUserID.expectations) == 0 && mmGetSessionsByUserID.defaultExpectation == nil && mmGetSessionsByUserID.mock.funcGetSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.mock.afterGetSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSessionsByUserID implements mm_http.AuthService
+func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error) {
+	mm_atomic.AddUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter, 1)
+
+	mmGetSessionsByUserID.t.Helper()
+
+	if mmGetSessionsByUserID.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.inspectFuncGetSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Lock()
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs = append(mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs, &mm_params)
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetSessionsByUserID.GetSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSessionsByUserID.t.Fatal("No results are set for the AuthServiceMock.GetSessionsByUserID")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetSessionsByUserID.funcGetSessionsByUserID != nil {
+		return mmGetSessionsByUserID.funcGetSessionsByUserID(ctx, userID)
+	}
+	mmGetSessionsByUserID.t.Fatalf("Unexpected call to AuthServiceMock.GetSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// GetSessionsByUserIDAfterCounter returns a count of finished AuthServiceMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter)
+}
+
+// GetSessionsByUserIDBeforeCounter returns a count of AuthServiceMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Calls() []*AuthServiceMockGetSessionsByUserIDParams {
+	mmGetSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetSessionsByUserIDParams, len(mmGetSessionsByUserID.callArgs))
+	copy(argCopy, mmGetSessionsByUserID.callArgs)
+
+	mmGetSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionsByUserIDDone returns true if the count of the GetSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetSessionsByUserIDDone() bool {
+	if m.GetSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockGetSessionsByUserIDInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetSessionsByUserIDInspect() {
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionsByUserIDMock.defaultExpectation != nil && afterGetSessionsByUserIDCounter < 1 {
+		if m.GetSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s", m.GetSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s with params: %#v", m.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSessionsByUserID != nil && afterGetSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s", m.funcGetSessionsByUserIDOrigin)
+	}
+
+	if !m.GetSessionsByUserIDMock.invocationsDone() && afterGetSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionsByUserIDMock.expectedInvocations), m.GetSessionsByUserIDMock.expectedInvocationsOrigin, afterGetSessionsByUserIDCounter)
+	}
+}
+
+type mAuthServiceMockImpersonate struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockImpersonateExpectation
+	expectations       []*AuthServiceMockImpersonateExpectation
+
+	callArgs []*AuthServiceMockImpersonateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockImpersonateExpectation specifies expectation struct of the AuthService.Impersonate
+type AuthServiceMockImpersonateExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockImpersonateParams
+	paramPtrs          *AuthServiceMockImpersonateParamPtrs
+	expectationOrigins AuthServiceMockImpersonateExpectationOrigins
+	results            *AuthServiceMockImpersonateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockImpersonateParams contains parameters of the AuthService.Impersonate
+type AuthServiceMockImpersonateParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthServiceMockImpersonateParamPtrs contains pointers to parameters of the AuthService.Impersonate
+type AuthServiceMockImpersonateParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthServiceMockImpersonateResults contains results of the AuthService.Impersonate
+type AuthServiceMockImpersonateResults struct {
+	i1  auth.ImpersonationToken
+	err error
+}
+
+// AuthServiceMockImpersonateOrigins contains origins of expectations of the AuthService.Impersonate
+type AuthServiceMockImpersonateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImpersonate *mAuthServiceMockImpersonate) Optional() *mAuthServiceMockImpersonate {
+	mmImpersonate.optional = true
+	return mmImpersonate
+}
+
+// Expect sets up expected params for AuthService.Impersonate
+func (mmImpersonate *mAuthServiceMockImpersonate) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockImpersonate {
+	if mmImpersonate.mock.funcImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Set")
+	}
+
+	if mmImpersonate.defaultExpectation == nil {
+		mmImpersonate.defaultExpectation = &AuthServiceMockImpersonateExpectation{}
+	}
+
+	if mmImpersonate.defaultExpectation.paramPtrs != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by ExpectParams functions")
+	}
+
+	mmImpersonate.defaultExpectation.params = &AuthServiceMockImpersonateParams{ctx, userID}
+	mmImpersonate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImpersonate.expectations {
+		if minimock.Equal(e.params, mmImpersonate.defaultExpectation.params) {
+			mmImpersonate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImpersonate.defaultExpectation.params)
+		}
+	}
+
+	return mmImpersonate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Impersonate
+func (mmImpersonate *mAuthServiceMockImpersonate) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockImpersonate {
+	if mmImpersonate.mock.funcImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Set")
+	}
+
+	if mmImpersonate.defaultExpectation == nil {
+		mmImpersonate.defaultExpectation = &AuthServiceMockImpersonateExpectation{}
+	}
+
+	if mmImpersonate.defaultExpectation.params != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Expect")
+	}
+
+	if mmImpersonate.defaultExpectation.paramPtrs == nil {
+		mmImpersonate.defaultExpectation.paramPtrs = &AuthServiceMockImpersonateParamPtrs{}
+	}
+	mmImpersonate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImpersonate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImpersonate
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.Impersonate
+func (mmImpersonate *mAuthServiceMockImpersonate) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockImpersonate {
+	if mmImpersonate.mock.funcImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Set")
+	}
+
+	if mmImpersonate.defaultExpectation == nil {
+		mmImpersonate.defaultExpectation = &AuthServiceMockImpersonateExpectation{}
+	}
+
+	if mmImpersonate.defaultExpectation.params != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Expect")
+	}
+
+	if mmImpersonate.defaultExpectation.paramPtrs == nil {
+		mmImpersonate.defaultExpectation.paramPtrs = &AuthServiceMockImpersonateParamPtrs{}
+	}
+	mmImpersonate.defaultExpectation.paramPtrs.userID = &userID
+	mmImpersonate.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmImpersonate
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Impersonate
+func (mmImpersonate *mAuthServiceMockImpersonate) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockImpersonate {
+	if mmImpersonate.mock.inspectFuncImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Impersonate")
+	}
+
+	mmImpersonate.mock.inspectFuncImpersonate = f
+
+	return mmImpersonate
+}
+
+// Return sets up results that will be returned by AuthService.Impersonate
+func (mmImpersonate *mAuthServiceMockImpersonate) Return(i1 auth.ImpersonationToken, err error) *AuthServiceMock {
+	if mmImpersonate.mock.funcImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Set")
+	}
+
+	if mmImpersonate.defaultExpectation == nil {
+		mmImpersonate.defaultExpectation = &AuthServiceMockImpersonateExpectation{mock: mmImpersonate.mock}
+	}
+	mmImpersonate.defaultExpectation.results = &AuthServiceMockImpersonateResults{i1, err}
+	mmImpersonate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImpersonate.mock
+}
+
+// Set uses given function f to mock the AuthService.Impersonate method
+func (mmImpersonate *mAuthServiceMockImpersonate) Set(f func(ctx context.Context, userID uuid.UUID) (i1 auth.ImpersonationToken, err error)) *AuthServiceMock {
+	if mmImpersonate.defaultExpectation != nil {
+		mmImpersonate.mock.t.Fatalf("Default expectation is already set for the AuthService.Impersonate method")
+	}
+
+	if len(mmImpersonate.expectations) > 0 {
+		mmImpersonate.mock.t.Fatalf("Some expectations are already set for the AuthService.Impersonate method")
+	}
+
+	mmImpersonate.mock.funcImpersonate = f
+	mmImpersonate.mock.funcImpersonateOrigin = minimock.CallerInfo(1)
+	return mmImpersonate.mock
+}
+
+// When sets expectation for the AuthService.Impersonate which will trigger the result defined by the following
+// Then helper
+func (mmImpersonate *mAuthServiceMockImpersonate) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockImpersonateExpectation {
+	if mmImpersonate.mock.funcImpersonate != nil {
+		mmImpersonate.mock.t.Fatalf("AuthServiceMock.Impersonate mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockImpersonateExpectation{
+		mock:               mmImpersonate.mock,
+		params:             &AuthServiceMockImpersonateParams{ctx, userID},
+		expectationOrigins: AuthServiceMockImpersonateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImpersonate.expectations = append(mmImpersonate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Impersonate return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockImpersonateExpectation) Then(i1 auth.ImpersonationToken, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockImpersonateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Impersonate should be invoked
+func (mmImpersonate *mAuthServiceMockImpersonate) Times(n uint64) *mAuthServiceMockImpersonate {
+	if n == 0 {
+		mmImpersonate.mock.t.Fatalf("Times of AuthServiceMock.Impersonate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImpersonate.expectedInvocations, n)
+	mmImpersonate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImpersonate
+}
+
+func (mmImpersonate *mAuthServiceMockImpersonate) invocationsDone() bool {
+	if len(mmImpersonate.expectations) == 0 && mmImpersonate.defaultExpectation == nil && mmImpersonate.mock.funcImpersonate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImpersonate.mock.afterImpersonateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImpersonate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Impersonate implements mm_http.AuthService
+func (mmImpersonate *AuthServiceMock) Impersonate(ctx context.Context, userID uuid.UUID) (i1 auth.ImpersonationToken, err error) {
+	mm_atomic.AddUint64(&mmImpersonate.beforeImpersonateCounter, 1)
+	defer mm_atomic.AddUint64(&mmImpersonate.afterImpersonateCounter, 1)
+
+	mmImpersonate.t.Helper()
+
+	if mmImpersonate.inspectFuncImpersonate != nil {
+		mmImpersonate.inspectFuncImpersonate(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockImpersonateParams{ctx, userID}
+
+	// Record call args
+	mmImpersonate.ImpersonateMock.mutex.Lock()
+	mmImpersonate.ImpersonateMock.callArgs = append(mmImpersonate.ImpersonateMock.callArgs, &mm_params)
+	mmImpersonate.ImpersonateMock.mutex.Unlock()
+
+	for _, e := range mmImpersonate.ImpersonateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmImpersonate.ImpersonateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImpersonate.ImpersonateMock.defaultExpectation.Counter, 1)
+		mm_want := mmImpersonate.ImpersonateMock.defaultExpectation.params
+		mm_want_ptrs := mmImpersonate.ImpersonateMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockImpersonateParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImpersonate.t.Errorf("AuthServiceMock.Impersonate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImpersonate.ImpersonateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmImpersonate.t.Errorf("AuthServiceMock.Impersonate got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImpersonate.ImpersonateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImpersonate.t.Errorf("AuthServiceMock.Impersonate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImpersonate.ImpersonateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImpersonate.ImpersonateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImpersonate.t.Fatal("No results are set for the AuthServiceMock.Impersonate")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmImpersonate.funcImpersonate != nil {
+		return mmImpersonate.funcImpersonate(ctx, userID)
+	}
+	mmImpersonate.t.Fatalf("Unexpected call to AuthServiceMock.Impersonate. %v %v", ctx, userID)
+	return
+}
+
+// ImpersonateAfterCounter returns a count of finished AuthServiceMock.Impersonate invocations
+func (mmImpersonate *AuthServiceMock) ImpersonateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImpersonate.afterImpersonateCounter)
+}
+
+// ImpersonateBeforeCounter returns a count of AuthServiceMock.Impersonate invocations
+func (mmImpersonate *AuthServiceMock) ImpersonateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImpersonate.beforeImpersonateCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Impersonate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImpersonate *mAuthServiceMockImpersonate) Calls() []*AuthServiceMockImpersonateParams {
+	mmImpersonate.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockImpersonateParams, len(mmImpersonate.callArgs))
+	copy(argCopy, mmImpersonate.callArgs)
+
+	mmImpersonate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImpersonateDone returns true if the count of the Impersonate invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockImpersonateDone() bool {
+	if m.ImpersonateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImpersonateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImpersonateMock.invocationsDone()
+}
+
+// MinimockImpersonateInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockImpersonateInspect() {
+	for _, e := range m.ImpersonateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Impersonate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImpersonateCounter := mm_atomic.LoadUint64(&m.afterImpersonateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImpersonateMock.defaultExpectation != nil && afterImpersonateCounter < 1 {
+		if m.ImpersonateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Impersonate at\n%s", m.ImpersonateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Impersonate at\n%s with params: %#v", m.ImpersonateMock.defaultExpectation.expectationOrigins.origin, *m.ImpersonateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImpersonate != nil && afterImpersonateCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Impersonate at\n%s", m.funcImpersonateOrigin)
+	}
+
+	if !m.ImpersonateMock.invocationsDone() && afterImpersonateCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Impersonate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImpersonateMock.expectedInvocations), m.ImpersonateMock.expectedInvocationsOrigin, afterImpersonateCounter)
+	}
+}
+
+type mAuthServiceMockListPresets struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockListPresetsExpectation
+	expectations       []*AuthServiceMockListPresetsExpectation
+
+	callArgs []*AuthServiceMockListPresetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockListPresetsExpectation specifies expectation struct of the AuthService.ListPresets
+type AuthServiceMockListPresetsExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockListPresetsParams
+	paramPtrs          *AuthServiceMockListPresetsParamPtrs
+	expectationOrigins AuthServiceMockListPresetsExpectationOrigins
+	results            *AuthServiceMockListPresetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockListPresetsParams contains parameters of the AuthService.ListPresets
+type AuthServiceMockListPresetsParams struct {
+	ctx context.Context
+}
+
+// AuthServiceMockListPresetsParamPtrs contains pointers to parameters of the AuthService.ListPresets
+type AuthServiceMockListPresetsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuthServiceMockListPresetsResults contains results of the AuthService.ListPresets
+type AuthServiceMockListPresetsResults struct {
+	ra1 []auth.RolePreset
+	err error
+}
+
+// AuthServiceMockListPresetsOrigins contains origins of expectations of the AuthService.ListPresets
+type AuthServiceMockListPresetsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPresets *mAuthServiceMockListPresets) Optional() *mAuthServiceMockListPresets {
+	mmListPresets.optional = true
+	return mmListPresets
+}
+
+// Expect sets up expected params for AuthService.ListPresets
+func (mmListPresets *mAuthServiceMockListPresets) Expect(ctx context.Context) *mAuthServiceMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &AuthServiceMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by ExpectParams functions")
+	}
+
+	mmListPresets.defaultExpectation.params = &AuthServiceMockListPresetsParams{ctx}
+	mmListPresets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPresets.expectations {
+		if minimock.Equal(e.params, mmListPresets.defaultExpectation.params) {
+			mmListPresets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPresets.defaultExpectation.params)
+		}
+	}
+
+	return mmListPresets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ListPresets
+func (mmListPresets *mAuthServiceMockListPresets) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &AuthServiceMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.params != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by Expect")
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs == nil {
+		mmListPresets.defaultExpectation.paramPtrs = &AuthServiceMockListPresetsParamPtrs{}
+	}
+	mmListPresets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPresets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPresets
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ListPresets
+func (mmListPresets *mAuthServiceMockListPresets) Inspect(f func(ctx context.Context)) *mAuthServiceMockListPresets {
+	if mmListPresets.mock.inspectFuncListPresets != nil {
+		mmListPresets.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ListPresets")
+	}
+
+	mmListPresets.mock.inspectFuncListPresets = f
+
+	return mmListPresets
+}
+
+// Return sets up results that will be returned by AuthService.ListPresets
+func (mmListPresets *mAuthServiceMockListPresets) Return(ra1 []auth.RolePreset, err error) *AuthServiceMock {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &AuthServiceMockListPresetsExpectation{mock: mmListPresets.mock}
+	}
+	mmListPresets.defaultExpectation.results = &AuthServiceMockListPresetsResults{ra1, err}
+	mmListPresets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// Set uses given function f to mock the AuthService.ListPresets method
+func (mmListPresets *mAuthServiceMockListPresets) Set(f func(ctx context.Context) (ra1 []auth.RolePreset, err error)) *AuthServiceMock {
+	if mmListPresets.defaultExpectation != nil {
+		mmListPresets.mock.t.Fatalf("Default expectation is already set for the AuthService.ListPresets method")
+	}
+
+	if len(mmListPresets.expectations) > 0 {
+		mmListPresets.mock.t.Fatalf("Some expectations are already set for the AuthService.ListPresets method")
+	}
+
+	mmListPresets.mock.funcListPresets = f
+	mmListPresets.mock.funcListPresetsOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// When sets expectation for the AuthService.ListPresets which will trigger the result defined by the following
+// Then helper
+func (mmListPresets *mAuthServiceMockListPresets) When(ctx context.Context) *AuthServiceMockListPresetsExpectation {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("AuthServiceMock.ListPresets mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockListPresetsExpectation{
+		mock:               mmListPresets.mock,
+		params:             &AuthServiceMockListPresetsParams{ctx},
+		expectationOrigins: AuthServiceMockListPresetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPresets.expectations = append(mmListPresets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ListPresets return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockListPresetsExpectation) Then(ra1 []auth.RolePreset, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockListPresetsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ListPresets should be invoked
+func (mmListPresets *mAuthServiceMockListPresets) Times(n uint64) *mAuthServiceMockListPresets {
+	if n == 0 {
+		mmListPresets.mock.t.Fatalf("Times of AuthServiceMock.ListPresets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPresets.expectedInvocations, n)
+	mmListPresets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPresets
+}
+
+func (mmListPresets *mAuthServiceMockListPresets) invocationsDone() bool {
+	if len(mmListPresets.expectations) == 0 && mmListPresets.defaultExpectation == nil && mmListPresets.mock.funcListPresets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPresets.mock.afterListPresetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPresets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPresets implements mm_http.AuthService
+func (mmListPresets *AuthServiceMock) ListPresets(ctx context.Context) (ra1 []auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmListPresets.beforeListPresetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPresets.afterListPresetsCounter, 1)
+
+	mmListPresets.t.Helper()
+
+	if mmListPresets.inspectFuncListPresets != nil {
+		mmListPresets.inspectFuncListPresets(ctx)
+	}
+
+	mm_params := AuthServiceMockListPresetsParams{ctx}
+
+	// Record call args
+	mmListPresets.ListPresetsMock.mutex.Lock()
+	mmListPresets.ListPresetsMock.callArgs = append(mmListPresets.ListPresetsMock.callArgs, &mm_params)
+	mmListPresets.ListPresetsMock.mutex.Unlock()
+
+	for _, e := range mmListPresets.ListPresetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListPresets.ListPresetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPresets.ListPresetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPresets.ListPresetsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPresets.ListPresetsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockListPresetsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPresets.t.Errorf("AuthServiceMock.ListPresets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPresets.t.Errorf("AuthServiceMock.ListPresets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPresets.ListPresetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPresets.t.Fatal("No results are set for the AuthServiceMock.ListPresets")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListPresets.funcListPresets != nil {
+		return mmListPresets.funcListPresets(ctx)
+	}
+	mmListPresets.t.Fatalf("Unexpected call to AuthServiceMock.ListPresets. %v", ctx)
+	return
+}
+
+// ListPresetsAfterCounter returns a count of finished AuthServiceMock.ListPresets invocations
+func (mmListPresets *AuthServiceMock) ListPresetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.afterListPresetsCounter)
+}
+
+// ListPresetsBeforeCounter returns a count of AuthServiceMock.ListPresets invocations
+func (mmListPresets *AuthServiceMock) ListPresetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.beforeListPresetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ListPresets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPresets *mAuthServiceMockListPresets) Calls() []*AuthServiceMockListPresetsParams {
+	mmListPresets.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockListPresetsParams, len(mmListPresets.callArgs))
+	copy(argCopy, mmListPresets.callArgs)
+
+	mmListPresets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPresetsDone returns true if the count of the ListPresets invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockListPresetsDone() bool {
+	if m.ListPresetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPresetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPresetsMock.invocationsDone()
+}
+
+// MinimockListPresetsInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockListPresetsInspect() {
+	for _, e := range m.ListPresetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ListPresets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPresetsCounter := mm_atomic.LoadUint64(&m.afterListPresetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPresetsMock.defaultExpectation != nil && afterListPresetsCounter < 1 {
+		if m.ListPresetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ListPresets at\n%s", m.ListPresetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ListPresets at\n%s with params: %#v", m.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *m.ListPresetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPresets != nil && afterListPresetsCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ListPresets at\n%s", m.funcListPresetsOrigin)
+	}
+
+	if !m.ListPresetsMock.invocationsDone() && afterListPresetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ListPresets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPresetsMock.expectedInvocations), m.ListPresetsMock.expectedInvocationsOrigin, afterListPresetsCounter)
+	}
+}
+
+type mAuthServiceMockListUserRoles struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockListUserRolesExpectation
+	expectations       []*AuthServiceMockListUserRolesExpectation
+
+	callArgs []*AuthServiceMockListUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockListUserRolesExpectation specifies expectation struct of the AuthService.ListUserRoles
+type AuthServiceMockListUserRolesExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockListUserRolesParams
+	paramPtrs          *AuthServiceMockListUserRolesParamPtrs
+	expectationOrigins AuthServiceMockListUserRolesExpectationOrigins
+	results            *AuthServiceMockListUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockListUserRolesParams contains parameters of the AuthService.ListUserRoles
+type AuthServiceMockListUserRolesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthServiceMockListUserRolesParamPtrs contains pointers to parameters of the AuthService.ListUserRoles
+type AuthServiceMockListUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthServiceMockListUserRolesResults contains results of the AuthService.ListUserRoles
+type AuthServiceMockListUserRolesResults struct {
+	ua1 []auth.UserRole
+	err error
+}
+
+// AuthServiceMockListUserRolesOrigins contains origins of expectations of the AuthService.ListUserRoles
+type AuthServiceMockListUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Optional() *mAuthServiceMockListUserRoles {
+	mmListUserRoles.optional = true
+	return mmListUserRoles
+}
+
+// Expect sets up expected params for AuthService.ListUserRoles
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListUserRoles.defaultExpectation.params = &AuthServiceMockListUserRolesParams{ctx, userID}
+	mmListUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUserRoles.expectations {
+		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
+			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ListUserRoles
+func (mmListUserRoles *mAuthServiceMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.ListUserRoles
+func (mmListUserRoles *mAuthServiceMockListUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmListUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ListUserRoles
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockListUserRoles {
+	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ListUserRoles")
+	}
+
+	mmListUserRoles.mock.inspectFuncListUserRoles = f
+
+	return mmListUserRoles
+}
+
+// Return sets up results that will be returned by AuthService.ListUserRoles
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Return(ua1 []auth.UserRole, err error) *AuthServiceMock {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	}
+	mmListUserRoles.defaultExpectation.results = &AuthServiceMockListUserRolesResults{ua1, err}
+	mmListUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// Set uses given function f to mock the AuthService.ListUserRoles method
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)) *AuthServiceMock {
+	if mmListUserRoles.defaultExpectation != nil {
+		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the AuthService.ListUserRoles method")
+	}
+
+	if len(mmListUserRoles.expectations) > 0 {
+		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the AuthService.ListUserRoles method")
+	}
+
+	mmListUserRoles.mock.funcListUserRoles = f
+	mmListUserRoles.mock.funcListUserRolesOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// When sets expectation for the AuthService.ListUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmListUserRoles *mAuthServiceMockListUserRoles) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockListUserRolesExpectation {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockListUserRolesExpectation{
+		mock:               mmListUserRoles.mock,
+		params:             &AuthServiceMockListUserRolesParams{ctx, userID},
+		expectationOrigins: AuthServiceMockListUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ListUserRoles return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockListUserRolesExpectation) Then(ua1 []auth.UserRole, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockListUserRolesResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ListUserRoles should be invoked
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Times(n uint64) *mAuthServiceMockListUserRoles {
+	if n == 0 {
+		mmListUserRoles.mock.t.Fatalf("Times of AuthServiceMock.ListUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUserRoles.expectedInvocations, n)
+	mmListUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles
+}
+
+func (mmListUserRoles *mAuthServiceMockListUserRoles) invocationsDone() bool {
+	if len(mmListUserRoles.expectations) == 0 && mmListUserRoles.defaultExpectation == nil && mmListUserRoles.mock.funcListUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUserRoles.mock.afterListUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUserRoles implements mm_http.AuthService
+func (mmListUserRoles *AuthServiceMock) ListUserRoles(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+
+	mmListUserRoles.t.Helper()
+
+	if mmListUserRoles.inspectFuncListUserRoles != nil {
+		mmListUserRoles.inspectFuncListUserRoles(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockListUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmListUserRoles.ListUserRolesMock.mutex.Lock()
+	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
+	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockListUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserRoles.t.Fatal("No results are set for the AuthServiceMock.ListUserRoles")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmListUserRoles.funcListUserRoles != nil {
+		return mmListUserRoles.funcListUserRoles(ctx, userID)
+	}
+	mmListUserRoles.t.Fatalf("Unexpected call to AuthServiceMock.ListUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// ListUserRolesAfterCounter returns a count of finished AuthServiceMock.ListUserRoles invocations
+func (mmListUserRoles *AuthServiceMock) ListUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+}
+
+// ListUserRolesBeforeCounter returns a count of AuthServiceMock.ListUserRoles invocations
+func (mmListUserRoles *AuthServiceMock) ListUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ListUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserRoles *mAuthServiceMockListUserRoles) Calls() []*AuthServiceMockListUserRolesParams {
+	mmListUserRoles.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockListUserRolesParams, len(mmListUserRoles.callArgs))
+	copy(argCopy, mmListUserRoles.callArgs)
+
+	mmListUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockListUserRolesDone() bool {
+	if m.ListUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUserRolesMock.invocationsDone()
+}
+
+// MinimockListUserRolesInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockListUserRolesInspect() {
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUserRolesCounter := mm_atomic.LoadUint64(&m.afterListUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserRolesMock.defaultExpectation != nil && afterListUserRolesCounter < 1 {
+		if m.ListUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s", m.ListUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s with params: %#v", m.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserRoles != nil && afterListUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s", m.funcListUserRolesOrigin)
+	}
+
+	if !m.ListUserRolesMock.invocationsDone() && afterListUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ListUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUserRolesMock.expectedInvocations), m.ListUserRolesMock.expectedInvocationsOrigin, afterListUserRolesCounter)
+	}
+}
+
+type mAuthServiceMockLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLoginExpectation
+	expectations       []*AuthServiceMockLoginExpectation
+
+	callArgs []*AuthServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
+type AuthServiceMockLoginExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLoginParams
+	paramPtrs          *AuthServiceMockLoginParamPtrs
+	expectationOrigins AuthServiceMockLoginExpectationOrigins
+	results            *AuthServiceMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLoginParams contains parameters of the AuthService.Login
+type AuthServiceMockLoginParams struct {
+	ctx context.Context
+	req usecase.LoginCmd
+}
+
+// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
+type AuthServiceMockLoginParamPtrs struct {
+	ctx *context.Context
+	req *usecase.LoginCmd
+}
+
+// AuthServiceMockLoginResults contains results of the AuthService.Login
+type AuthServiceMockLoginResults struct {
+	t1  auth.Tokens
+	err error
+}
+
+// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
+type AuthServiceMockLoginExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, req usecase.LoginCmd) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, req}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectReqParam2 sets up expected param req for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectReqParam2(req usecase.LoginCmd) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.req = &req
+	mmLogin.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, req usecase.LoginCmd)) *mAuthServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Return(t1 auth.Tokens, err error) *AuthServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{t1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.Login method
+func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, req usecase.LoginCmd) (t1 auth.Tokens, err error)) *AuthServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, req usecase.LoginCmd) *AuthServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &AuthServiceMockLoginParams{ctx, req},
+		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.GetSessionsByUserID return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockGetSessionsByUserIDExpectation) Then(sa1 []auth.Session, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockGetSessionsByUserIDResults{sa1, err}
+// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLoginExpectation) Then(t1 auth.Tokens, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLoginResults{t1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.GetSessionsByUserID should be invoked
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Times(n uint64) *mAuthServiceMockGetSessionsByUserID {
+// Times sets number of times AuthService.Login should be invoked
+func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
 	if n == 0 {
-		mmGetSessionsByUserID.mock.t.Fatalf("Times of AuthServiceMock.GetSessionsByUserID mock can not be zero")
+		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetSessionsByUserID.expectedInvocations, n)
-	mmGetSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
 }
 
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) invocationsDone() bool {
-	if len(mmGetSessionsByUserID.expectations) == 0 && mmGetSessionsByUserID.defaultExpectation == nil && mmGetSessionsByUserID.mock.funcGetSessionsByUserID == nil {
+func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_http.AuthService
+func (mmLogin *AuthServiceMock) Login(ctx context.Context, req usecase.LoginCmd) (t1 auth.Tokens, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, req)
+	}
+
+	mm_params := AuthServiceMockLoginParams{ctx, req}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLoginParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, req)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v", ctx, req)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mAuthServiceMockLogout struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLogoutExpectation
+	expectations       []*AuthServiceMockLogoutExpectation
+
+	callArgs []*AuthServiceMockLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLogoutExpectation specifies expectation struct of the AuthService.Logout
+type AuthServiceMockLogoutExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLogoutParams
+	paramPtrs          *AuthServiceMockLogoutParamPtrs
+	expectationOrigins AuthServiceMockLogoutExpectationOrigins
+	results            *AuthServiceMockLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLogoutParams contains parameters of the AuthService.Logout
+type AuthServiceMockLogoutParams struct {
+	ctx context.Context
+}
+
+// AuthServiceMockLogoutParamPtrs contains pointers to parameters of the AuthService.Logout
+type AuthServiceMockLogoutParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuthServiceMockLogoutResults contains results of the AuthService.Logout
+type AuthServiceMockLogoutResults struct {
+	err error
+}
+
+// AuthServiceMockLogoutOrigins contains origins of expectations of the AuthService.Logout
+type AuthServiceMockLogoutExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogout *mAuthServiceMockLogout) Optional() *mAuthServiceMockLogout {
+	mmLogout.optional = true
+	return mmLogout
+}
+
+// Expect sets up expected params for AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Expect(ctx context.Context) *mAuthServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by ExpectParams functions")
+	}
+
+	mmLogout.defaultExpectation.params = &AuthServiceMockLogoutParams{ctx}
+	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogout.expectations {
+		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
+			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &AuthServiceMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Inspect(f func(ctx context.Context)) *mAuthServiceMockLogout {
+	if mmLogout.mock.inspectFuncLogout != nil {
+		mmLogout.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Logout")
+	}
+
+	mmLogout.mock.inspectFuncLogout = f
+
+	return mmLogout
+}
+
+// Return sets up results that will be returned by AuthService.Logout
+func (mmLogout *mAuthServiceMockLogout) Return(err error) *AuthServiceMock {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &AuthServiceMockLogoutExpectation{mock: mmLogout.mock}
+	}
+	mmLogout.defaultExpectation.results = &AuthServiceMockLogoutResults{err}
+	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// Set uses given function f to mock the AuthService.Logout method
+func (mmLogout *mAuthServiceMockLogout) Set(f func(ctx context.Context) (err error)) *AuthServiceMock {
+	if mmLogout.defaultExpectation != nil {
+		mmLogout.mock.t.Fatalf("Default expectation is already set for the AuthService.Logout method")
+	}
+
+	if len(mmLogout.expectations) > 0 {
+		mmLogout.mock.t.Fatalf("Some expectations are already set for the AuthService.Logout method")
+	}
+
+	mmLogout.mock.funcLogout = f
+	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// When sets expectation for the AuthService.Logout which will trigger the result defined by the following
+// Then helper
+func (mmLogout *mAuthServiceMockLogout) When(ctx context.Context) *AuthServiceMockLogoutExpectation {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("AuthServiceMock.Logout mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLogoutExpectation{
+		mock:               mmLogout.mock,
+		params:             &AuthServiceMockLogoutParams{ctx},
+		expectationOrigins: AuthServiceMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Logout return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLogoutExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Logout should be invoked
+func (mmLogout *mAuthServiceMockLogout) Times(n uint64) *mAuthServiceMockLogout {
+	if n == 0 {
+		mmLogout.mock.t.Fatalf("Times of AuthServiceMock.Logout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
+	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogout
+}
+
+func (mmLogout *mAuthServiceMockLogout) invocationsDone() bool {
+	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.mock.afterGetSessionsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetSessionsByUserID implements mm_http.AuthService
-func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error) {
-	mm_atomic.AddUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter, 1)
+// Logout implements mm_http.AuthService
+func (mmLogout *AuthServiceMock) Logout(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
 
-	mmGetSessionsByUserID.t.Helper()
+	mmLogout.t.Helper()
 
-	if mmGetSessionsByUserID.inspectFuncGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.inspectFuncGetSessionsByUserID(ctx, userID)
+	if mmLogout.inspectFuncLogout != nil {
+		mmLogout.inspectFuncLogout(ctx)
 	}
 
-	mm_params := AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
+	mm_params := AuthServiceMockLogoutParams{ctx}
 
 	// Record call args
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Lock()
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs = append(mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs, &mm_params)
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Unlock()
+	mmLogout.LogoutMock.mutex.Lock()
+	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
+	mmLogout.LogoutMock.mutex.Unlock()
 
-	for _, e := range mmGetSessionsByUserID.GetSessionsByUserIDMock.expectations {
+	for _, e := range mmLogout.LogoutMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.paramPtrs
+	if mmLogout.LogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogout.LogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockGetSessionsByUserIDParams{ctx, userID}
+		mm_got := AuthServiceMockLogoutParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetSessionsByUserID.t.Errorf("AuthServiceMock.GetSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmLogout.t.Errorf("AuthServiceMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.results
+		mm_results := mmLogout.LogoutMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSessionsByUserID.t.Fatal("No results are set for the AuthServiceMock.GetSessionsByUserID")
+			mmLogout.t.Fatal("No results are set for the AuthServiceMock.Logout")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetSessionsByUserID.funcGetSessionsByUserID != nil {
-		return mmGetSessionsByUserID.funcGetSessionsByUserID(ctx, userID)
+	if mmLogout.funcLogout != nil {
+		return mmLogout.funcLogout(ctx)
 	}
-	mmGetSessionsByUserID.t.Fatalf("Unexpected call to AuthServiceMock.GetSessionsByUserID. %v %v", ctx, userID)
+	mmLogout.t.Fatalf("Unexpected call to AuthServiceMock.Logout. %v", ctx)
 	return
 }
 
-// GetSessionsByUserIDAfterCounter returns a count of finished AuthServiceMock.GetSessionsByUserID invocations
-func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter)
+// LogoutAfterCounter returns a count of finished AuthServiceMock.Logout invocations
+func (mmLogout *AuthServiceMock) LogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
 }
 
-// GetSessionsByUserIDBeforeCounter returns a count of AuthServiceMock.GetSessionsByUserID invocations
-func (mmGetSessionsByUserID *AuthServiceMock) GetSessionsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter)
+// LogoutBeforeCounter returns a count of AuthServiceMock.Logout invocations
+func (mmLogout *AuthServiceMock) LogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.GetSessionsByUserID.
+// Calls returns a list of arguments used in each call to AuthServiceMock.Logout.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetSessionsByUserID *mAuthServiceMockGetSessionsByUserID) Calls() []*AuthServiceMockGetSessionsByUserIDParams {
-	mmGetSessionsByUserID.mutex.RLock()
+func (mmLogout *mAuthServiceMockLogout) Calls() []*AuthServiceMockLogoutParams {
+	mmLogout.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockGetSessionsByUserIDParams, len(mmGetSessionsByUserID.callArgs))
-	copy(argCopy, mmGetSessionsByUserID.callArgs)
+	argCopy := make([]*AuthServiceMockLogoutParams, len(mmLogout.callArgs))
+	copy(argCopy, mmLogout.callArgs)
 
-	mmGetSessionsByUserID.mutex.RUnlock()
+	mmLogout.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetSessionsByUserIDDone returns true if the count of the GetSessionsByUserID invocations corresponds
+// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockGetSessionsByUserIDDone() bool {
-	if m.GetSessionsByUserIDMock.optional {
+func (m *AuthServiceMock) MinimockLogoutDone() bool {
+	if m.LogoutMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetSessionsByUserIDMock.expectations {
+	for _, e := range m.LogoutMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetSessionsByUserIDMock.invocationsDone()
+	return m.LogoutMock.invocationsDone()
 }
 
-// MinimockGetSessionsByUserIDInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockGetSessionsByUserIDInspect() {
-	for _, e := range m.GetSessionsByUserIDMock.expectations {
+// MinimockLogoutInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLogoutInspect() {
+	for _, e := range m.LogoutMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionsByUserIDCounter)
+	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSessionsByUserIDMock.defaultExpectation != nil && afterGetSessionsByUserIDCounter < 1 {
-		if m.GetSessionsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s", m.GetSessionsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
+		if m.LogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s with params: %#v", m.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSessionsByUserID != nil && afterGetSessionsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.GetSessionsByUserID at\n%s", m.funcGetSessionsByUserIDOrigin)
+	if m.funcLogout != nil && afterLogoutCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Logout at\n%s", m.funcLogoutOrigin)
 	}
 
-	if !m.GetSessionsByUserIDMock.invocationsDone() && afterGetSessionsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.GetSessionsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetSessionsByUserIDMock.expectedInvocations), m.GetSessionsByUserIDMock.expectedInvocationsOrigin, afterGetSessionsByUserIDCounter)
+	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Logout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
 	}
 }
 
-type mAuthServiceMockListUserRoles struct {
+type mAuthServiceMockRefreshTokens struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockListUserRolesExpectation
-	expectations       []*AuthServiceMockListUserRolesExpectation
+	defaultExpectation *AuthServiceMockRefreshTokensExpectation
+	expectations       []*AuthServiceMockRefreshTokensExpectation
 
-	callArgs []*AuthServiceMockListUserRolesParams
+	callArgs []*AuthServiceMockRefreshTokensParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockListUserRolesExpectation specifies expectation struct of the AuthService.ListUserRoles
-type AuthServiceMockListUserRolesExpectation struct {
+// AuthServiceMockRefreshTokensExpectation specifies expectation struct of the AuthService.RefreshTokens
+type AuthServiceMockRefreshTokensExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockListUserRolesParams
-	paramPtrs          *AuthServiceMockListUserRolesParamPtrs
-	expectationOrigins AuthServiceMockListUserRolesExpectationOrigins
-	results            *AuthServiceMockListUserRolesResults
+	params             *AuthServiceMockRefreshTokensParams
+	paramPtrs          *AuthServiceMockRefreshTokensParamPtrs
+	expectationOrigins AuthServiceMockRefreshTokensExpectationOrigins
+	results            *AuthServiceMockRefreshTokensResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockListUserRolesParams contains parameters of the AuthService.ListUserRoles
-type AuthServiceMockListUserRolesParams struct {
-	ctx    context.Context
-	userID uuid.UUID
+// AuthServiceMockRefreshTokensParams contains parameters of the AuthService.RefreshTokens
+type AuthServiceMockRefreshTokensParams struct {
+	ctx context.Context
+	req usecase.RefreshCmd
 }
 
-// AuthServiceMockListUserRolesParamPtrs contains pointers to parameters of the AuthService.ListUserRoles
-type AuthServiceMockListUserRolesParamPtrs struct {
-	ctx    *context.Context
-	userID *uuid.UUID
+// AuthServiceMockRefreshTokensParamPtrs contains pointers to parameters of the AuthService.RefreshTokens
+type AuthServiceMockRefreshTokensParamPtrs struct {
+	ctx *context.Context
+	req *usecase.RefreshCmd
 }
 
-// AuthServiceMockListUserRolesResults contains results of the AuthService.ListUserRoles
-type AuthServiceMockListUserRolesResults struct {
-	ua1 []auth.UserRole
+// AuthServiceMockRefreshTokensResults contains results of the AuthService.RefreshTokens
+type AuthServiceMockRefreshTokensResults struct {
+	t1  auth.Tokens
 	err error
 }
 
-// AuthServiceMockListUserRolesOrigins contains origins of expectations of the AuthService.ListUserRoles
-type AuthServiceMockListUserRolesExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// AuthServiceMockRefreshTokensOrigins contains origins of expectations of the AuthService.RefreshTokens
+type AuthServiceMockRefreshTokensExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1911,342 +5761,342 @@ type AuthServiceMockListUserRolesExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Optional() *mAuthServiceMockListUserRoles {
-	mmListUserRoles.optional = true
-	return mmListUserRoles
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Optional() *mAuthServiceMockRefreshTokens {
+	mmRefreshTokens.optional = true
+	return mmRefreshTokens
 }
 
-// Expect sets up expected params for AuthService.ListUserRoles
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+// Expect sets up expected params for AuthService.RefreshTokens
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Expect(ctx context.Context, req usecase.RefreshCmd) *mAuthServiceMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by ExpectParams functions")
+	if mmRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by ExpectParams functions")
 	}
 
-	mmListUserRoles.defaultExpectation.params = &AuthServiceMockListUserRolesParams{ctx, userID}
-	mmListUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListUserRoles.expectations {
-		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
-			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+	mmRefreshTokens.defaultExpectation.params = &AuthServiceMockRefreshTokensParams{ctx, req}
+	mmRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmRefreshTokens.defaultExpectation.params) {
+			mmRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshTokens.defaultExpectation.params)
 		}
 	}
 
-	return mmListUserRoles
+	return mmRefreshTokens
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.ListUserRoles
-func (mmListUserRoles *mAuthServiceMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RefreshTokens
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.params != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Expect")
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Expect")
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
-		mmListUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockListUserRolesParamPtrs{}
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokensParamPtrs{}
 	}
-	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListUserRoles
+	return mmRefreshTokens
 }
 
-// ExpectUserIDParam2 sets up expected param userID for AuthService.ListUserRoles
-func (mmListUserRoles *mAuthServiceMockListUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for AuthService.RefreshTokens
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) ExpectReqParam2(req usecase.RefreshCmd) *mAuthServiceMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{}
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.params != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Expect")
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Expect")
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
-		mmListUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockListUserRolesParamPtrs{}
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokensParamPtrs{}
 	}
-	mmListUserRoles.defaultExpectation.paramPtrs.userID = &userID
-	mmListUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmRefreshTokens.defaultExpectation.paramPtrs.req = &req
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmListUserRoles
+	return mmRefreshTokens
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.ListUserRoles
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockListUserRoles {
-	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ListUserRoles")
+// Inspect accepts an inspector function that has same arguments as the AuthService.RefreshTokens
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Inspect(f func(ctx context.Context, req usecase.RefreshCmd)) *mAuthServiceMockRefreshTokens {
+	if mmRefreshTokens.mock.inspectFuncRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RefreshTokens")
 	}
 
-	mmListUserRoles.mock.inspectFuncListUserRoles = f
+	mmRefreshTokens.mock.inspectFuncRefreshTokens = f
 
-	return mmListUserRoles
+	return mmRefreshTokens
 }
 
-// Return sets up results that will be returned by AuthService.ListUserRoles
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Return(ua1 []auth.UserRole, err error) *AuthServiceMock {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+// Return sets up results that will be returned by AuthService.RefreshTokens
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Return(t1 auth.Tokens, err error) *AuthServiceMock {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &AuthServiceMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{mock: mmRefreshTokens.mock}
 	}
-	mmListUserRoles.defaultExpectation.results = &AuthServiceMockListUserRolesResults{ua1, err}
-	mmListUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles.mock
+	mmRefreshTokens.defaultExpectation.results = &AuthServiceMockRefreshTokensResults{t1, err}
+	mmRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens.mock
 }
 
-// Set uses given function f to mock the AuthService.ListUserRoles method
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)) *AuthServiceMock {
-	if mmListUserRoles.defaultExpectation != nil {
-		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the AuthService.ListUserRoles method")
+// Set uses given function f to mock the AuthService.RefreshTokens method
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Set(f func(ctx context.Context, req usecase.RefreshCmd) (t1 auth.Tokens, err error)) *AuthServiceMock {
+	if mmRefreshTokens.defaultExpectation != nil {
+		mmRefreshTokens.mock.t.Fatalf("Default expectation is already set for the AuthService.RefreshTokens method")
 	}
 
-	if len(mmListUserRoles.expectations) > 0 {
-		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the AuthService.ListUserRoles method")
+	if len(mmRefreshTokens.expectations) > 0 {
+		mmRefreshTokens.mock.t.Fatalf("Some expectations are already set for the AuthService.RefreshTokens method")
 	}
 
-	mmListUserRoles.mock.funcListUserRoles = f
-	mmListUserRoles.mock.funcListUserRolesOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles.mock
+	mmRefreshTokens.mock.funcRefreshTokens = f
+	mmRefreshTokens.mock.funcRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens.mock
 }
 
-// When sets expectation for the AuthService.ListUserRoles which will trigger the result defined by the following
+// When sets expectation for the AuthService.RefreshTokens which will trigger the result defined by the following
 // Then helper
-func (mmListUserRoles *mAuthServiceMockListUserRoles) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockListUserRolesExpectation {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("AuthServiceMock.ListUserRoles mock is already set by Set")
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) When(ctx context.Context, req usecase.RefreshCmd) *AuthServiceMockRefreshTokensExpectation {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockListUserRolesExpectation{
-		mock:               mmListUserRoles.mock,
-		params:             &AuthServiceMockListUserRolesParams{ctx, userID},
-		expectationOrigins: AuthServiceMockListUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockRefreshTokensExpectation{
+		mock:               mmRefreshTokens.mock,
+		params:             &AuthServiceMockRefreshTokensParams{ctx, req},
+		expectationOrigins: AuthServiceMockRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	mmRefreshTokens.expectations = append(mmRefreshTokens.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.ListUserRoles return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockListUserRolesExpectation) Then(ua1 []auth.UserRole, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockListUserRolesResults{ua1, err}
+// Then sets up AuthService.RefreshTokens return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRefreshTokensExpectation) Then(t1 auth.Tokens, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRefreshTokensResults{t1, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.ListUserRoles should be invoked
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Times(n uint64) *mAuthServiceMockListUserRoles {
+// Times sets number of times AuthService.RefreshTokens should be invoked
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Times(n uint64) *mAuthServiceMockRefreshTokens {
 	if n == 0 {
-		mmListUserRoles.mock.t.Fatalf("Times of AuthServiceMock.ListUserRoles mock can not be zero")
+		mmRefreshTokens.mock.t.Fatalf("Times of AuthServiceMock.RefreshTokens mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListUserRoles.expectedInvocations, n)
-	mmListUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles
+	mm_atomic.StoreUint64(&mmRefreshTokens.expectedInvocations, n)
+	mmRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens
 }
 
-func (mmListUserRoles *mAuthServiceMockListUserRoles) invocationsDone() bool {
-	if len(mmListUserRoles.expectations) == 0 && mmListUserRoles.defaultExpectation == nil && mmListUserRoles.mock.funcListUserRoles == nil {
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) invocationsDone() bool {
+	if len(mmRefreshTokens.expectations) == 0 && mmRefreshTokens.defaultExpectation == nil && mmRefreshTokens.mock.funcRefreshTokens == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListUserRoles.mock.afterListUserRolesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListUserRoles.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.mock.afterRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListUserRoles implements mm_http.AuthService
-func (mmListUserRoles *AuthServiceMock) ListUserRoles(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error) {
-	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+// RefreshTokens implements mm_http.AuthService
+func (mmRefreshTokens *AuthServiceMock) RefreshTokens(ctx context.Context, req usecase.RefreshCmd) (t1 auth.Tokens, err error) {
+	mm_atomic.AddUint64(&mmRefreshTokens.beforeRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshTokens.afterRefreshTokensCounter, 1)
 
-	mmListUserRoles.t.Helper()
+	mmRefreshTokens.t.Helper()
 
-	if mmListUserRoles.inspectFuncListUserRoles != nil {
-		mmListUserRoles.inspectFuncListUserRoles(ctx, userID)
+	if mmRefreshTokens.inspectFuncRefreshTokens != nil {
+		mmRefreshTokens.inspectFuncRefreshTokens(ctx, req)
 	}
 
-	mm_params := AuthServiceMockListUserRolesParams{ctx, userID}
+	mm_params := AuthServiceMockRefreshTokensParams{ctx, req}
 
 	// Record call args
-	mmListUserRoles.ListUserRolesMock.mutex.Lock()
-	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
-	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+	mmRefreshTokens.RefreshTokensMock.mutex.Lock()
+	mmRefreshTokens.RefreshTokensMock.callArgs = append(mmRefreshTokens.RefreshTokensMock.callArgs, &mm_params)
+	mmRefreshTokens.RefreshTokensMock.mutex.Unlock()
 
-	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+	for _, e := range mmRefreshTokens.RefreshTokensMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.t1, e.results.err
 		}
 	}
 
-	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
-		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+	if mmRefreshTokens.RefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshTokens.RefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshTokens.RefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshTokens.RefreshTokensMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockListUserRolesParams{ctx, userID}
+		mm_got := AuthServiceMockRefreshTokensParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListUserRoles.t.Errorf("AuthServiceMock.ListUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		mm_results := mmRefreshTokens.RefreshTokensMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListUserRoles.t.Fatal("No results are set for the AuthServiceMock.ListUserRoles")
+			mmRefreshTokens.t.Fatal("No results are set for the AuthServiceMock.RefreshTokens")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).t1, (*mm_results).err
 	}
-	if mmListUserRoles.funcListUserRoles != nil {
-		return mmListUserRoles.funcListUserRoles(ctx, userID)
+	if mmRefreshTokens.funcRefreshTokens != nil {
+		return mmRefreshTokens.funcRefreshTokens(ctx, req)
 	}
-	mmListUserRoles.t.Fatalf("Unexpected call to AuthServiceMock.ListUserRoles. %v %v", ctx, userID)
+	mmRefreshTokens.t.Fatalf("Unexpected call to AuthServiceMock.RefreshTokens. %v %v", ctx, req)
 	return
 }
 
-// ListUserRolesAfterCounter returns a count of finished AuthServiceMock.ListUserRoles invocations
-func (mmListUserRoles *AuthServiceMock) ListUserRolesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+// RefreshTokensAfterCounter returns a count of finished AuthServiceMock.RefreshTokens invocations
+func (mmRefreshTokens *AuthServiceMock) RefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshTokens.afterRefreshTokensCounter)
 }
 
-// ListUserRolesBeforeCounter returns a count of AuthServiceMock.ListUserRoles invocations
-func (mmListUserRoles *AuthServiceMock) ListUserRolesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+// RefreshTokensBeforeCounter returns a count of AuthServiceMock.RefreshTokens invocations
+func (mmRefreshTokens *AuthServiceMock) RefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshTokens.beforeRefreshTokensCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.ListUserRoles.
+// Calls returns a list of arguments used in each call to AuthServiceMock.RefreshTokens.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListUserRoles *mAuthServiceMockListUserRoles) Calls() []*AuthServiceMockListUserRolesParams {
-	mmListUserRoles.mutex.RLock()
+func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Calls() []*AuthServiceMockRefreshTokensParams {
+	mmRefreshTokens.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockListUserRolesParams, len(mmListUserRoles.callArgs))
-	copy(argCopy, mmListUserRoles.callArgs)
+	argCopy := make([]*AuthServiceMockRefreshTokensParams, len(mmRefreshTokens.callArgs))
+	copy(argCopy, mmRefreshTokens.callArgs)
 
-	mmListUserRoles.mutex.RUnlock()
+	mmRefreshTokens.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// MinimockRefreshTokensDone returns true if the count of the RefreshTokens invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockListUserRolesDone() bool {
-	if m.ListUserRolesMock.optional {
+func (m *AuthServiceMock) MinimockRefreshTokensDone() bool {
+	if m.RefreshTokensMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListUserRolesMock.expectations {
+	for _, e := range m.RefreshTokensMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListUserRolesMock.invocationsDone()
+	return m.RefreshTokensMock.invocationsDone()
 }
 
-// MinimockListUserRolesInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockListUserRolesInspect() {
-	for _, e := range m.ListUserRolesMock.expectations {
+// MinimockRefreshTokensInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRefreshTokensInspect() {
+	for _, e := range m.RefreshTokensMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListUserRolesCounter := mm_atomic.LoadUint64(&m.afterListUserRolesCounter)
+	afterRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterRefreshTokensCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListUserRolesMock.defaultExpectation != nil && afterListUserRolesCounter < 1 {
-		if m.ListUserRolesMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s", m.ListUserRolesMock.defaultExpectation.returnOrigin)
+	if m.RefreshTokensMock.defaultExpectation != nil && afterRefreshTokensCounter < 1 {
+		if m.RefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s", m.RefreshTokensMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s with params: %#v", m.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListUserRolesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s with params: %#v", m.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.RefreshTokensMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListUserRoles != nil && afterListUserRolesCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.ListUserRoles at\n%s", m.funcListUserRolesOrigin)
+	if m.funcRefreshTokens != nil && afterRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s", m.funcRefreshTokensOrigin)
 	}
 
-	if !m.ListUserRolesMock.invocationsDone() && afterListUserRolesCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.ListUserRoles at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListUserRolesMock.expectedInvocations), m.ListUserRolesMock.expectedInvocationsOrigin, afterListUserRolesCounter)
+	if !m.RefreshTokensMock.invocationsDone() && afterRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshTokensMock.expectedInvocations), m.RefreshTokensMock.expectedInvocationsOrigin, afterRefreshTokensCounter)
 	}
 }
 
-type mAuthServiceMockLogin struct {
+type mAuthServiceMockRepairGrants struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockLoginExpectation
-	expectations       []*AuthServiceMockLoginExpectation
+	defaultExpectation *AuthServiceMockRepairGrantsExpectation
+	expectations       []*AuthServiceMockRepairGrantsExpectation
 
-	callArgs []*AuthServiceMockLoginParams
+	callArgs []*AuthServiceMockRepairGrantsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
-type AuthServiceMockLoginExpectation struct {
+// AuthServiceMockRepairGrantsExpectation specifies expectation struct of the AuthService.RepairGrants
+type AuthServiceMockRepairGrantsExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockLoginParams
-	paramPtrs          *AuthServiceMockLoginParamPtrs
-	expectationOrigins AuthServiceMockLoginExpectationOrigins
-	results            *AuthServiceMockLoginResults
+	params             *AuthServiceMockRepairGrantsParams
+	paramPtrs          *AuthServiceMockRepairGrantsParamPtrs
+	expectationOrigins AuthServiceMockRepairGrantsExpectationOrigins
+	results            *AuthServiceMockRepairGrantsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockLoginParams contains parameters of the AuthService.Login
-type AuthServiceMockLoginParams struct {
-	ctx context.Context
-	req usecase.LoginCmd
+// AuthServiceMockRepairGrantsParams contains parameters of the AuthService.RepairGrants
+type AuthServiceMockRepairGrantsParams struct {
+	ctx    context.Context
+	dryRun bool
 }
 
-// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
-type AuthServiceMockLoginParamPtrs struct {
-	ctx *context.Context
-	req *usecase.LoginCmd
+// AuthServiceMockRepairGrantsParamPtrs contains pointers to parameters of the AuthService.RepairGrants
+type AuthServiceMockRepairGrantsParamPtrs struct {
+	ctx    *context.Context
+	dryRun *bool
 }
 
-// AuthServiceMockLoginResults contains results of the AuthService.Login
-type AuthServiceMockLoginResults struct {
-	t1  auth.Tokens
+// AuthServiceMockRepairGrantsResults contains results of the AuthService.RepairGrants
+type AuthServiceMockRepairGrantsResults struct {
+	c2  auth.ConsistencyReport
 	err error
 }
 
-// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
-type AuthServiceMockLoginExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originReq string
+// AuthServiceMockRepairGrantsOrigins contains origins of expectations of the AuthService.RepairGrants
+type AuthServiceMockRepairGrantsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originDryRun string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2254,342 +6104,344 @@ type AuthServiceMockLoginExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
-	mmLogin.optional = true
-	return mmLogin
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Optional() *mAuthServiceMockRepairGrants {
+	mmRepairGrants.optional = true
+	return mmRepairGrants
 }
 
-// Expect sets up expected params for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, req usecase.LoginCmd) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// Expect sets up expected params for AuthService.RepairGrants
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Expect(ctx context.Context, dryRun bool) *mAuthServiceMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthServiceMockRepairGrantsExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	if mmRepairGrants.defaultExpectation.paramPtrs != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by ExpectParams functions")
 	}
 
-	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, req}
-	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogin.expectations {
-		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
-			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+	mmRepairGrants.defaultExpectation.params = &AuthServiceMockRepairGrantsParams{ctx, dryRun}
+	mmRepairGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRepairGrants.expectations {
+		if minimock.Equal(e.params, mmRepairGrants.defaultExpectation.params) {
+			mmRepairGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRepairGrants.defaultExpectation.params)
 		}
 	}
 
-	return mmLogin
+	return mmRepairGrants
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RepairGrants
+func (mmRepairGrants *mAuthServiceMockRepairGrants) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthServiceMockRepairGrantsExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &AuthServiceMockRepairGrantsParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmRepairGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRepairGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmRepairGrants
 }
 
-// ExpectReqParam2 sets up expected param req for AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) ExpectReqParam2(req usecase.LoginCmd) *mAuthServiceMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// ExpectDryRunParam2 sets up expected param dryRun for AuthService.RepairGrants
+func (mmRepairGrants *mAuthServiceMockRepairGrants) ExpectDryRunParam2(dryRun bool) *mAuthServiceMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthServiceMockRepairGrantsExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &AuthServiceMockRepairGrantsParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.req = &req
-	mmLogin.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmRepairGrants.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmRepairGrants.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmRepairGrants
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, req usecase.LoginCmd)) *mAuthServiceMockLogin {
-	if mmLogin.mock.inspectFuncLogin != nil {
-		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+// Inspect accepts an inspector function that has same arguments as the AuthService.RepairGrants
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Inspect(f func(ctx context.Context, dryRun bool)) *mAuthServiceMockRepairGrants {
+	if mmRepairGrants.mock.inspectFuncRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RepairGrants")
 	}
 
-	mmLogin.mock.inspectFuncLogin = f
+	mmRepairGrants.mock.inspectFuncRepairGrants = f
 
-	return mmLogin
+	return mmRepairGrants
 }
 
-// Return sets up results that will be returned by AuthService.Login
-func (mmLogin *mAuthServiceMockLogin) Return(t1 auth.Tokens, err error) *AuthServiceMock {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+// Return sets up results that will be returned by AuthService.RepairGrants
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Return(c2 auth.ConsistencyReport, err error) *AuthServiceMock {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &AuthServiceMockRepairGrantsExpectation{mock: mmRepairGrants.mock}
 	}
-	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{t1, err}
-	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmRepairGrants.defaultExpectation.results = &AuthServiceMockRepairGrantsResults{c2, err}
+	mmRepairGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
 }
 
-// Set uses given function f to mock the AuthService.Login method
-func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, req usecase.LoginCmd) (t1 auth.Tokens, err error)) *AuthServiceMock {
-	if mmLogin.defaultExpectation != nil {
-		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+// Set uses given function f to mock the AuthService.RepairGrants method
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Set(f func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)) *AuthServiceMock {
+	if mmRepairGrants.defaultExpectation != nil {
+		mmRepairGrants.mock.t.Fatalf("Default expectation is already set for the AuthService.RepairGrants method")
 	}
 
-	if len(mmLogin.expectations) > 0 {
-		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	if len(mmRepairGrants.expectations) > 0 {
+		mmRepairGrants.mock.t.Fatalf("Some expectations are already set for the AuthService.RepairGrants method")
 	}
 
-	mmLogin.mock.funcLogin = f
-	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmRepairGrants.mock.funcRepairGrants = f
+	mmRepairGrants.mock.funcRepairGrantsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
 }
 
-// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// When sets expectation for the AuthService.RepairGrants which will trigger the result defined by the following
 // Then helper
-func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, req usecase.LoginCmd) *AuthServiceMockLoginExpectation {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+func (mmRepairGrants *mAuthServiceMockRepairGrants) When(ctx context.Context, dryRun bool) *AuthServiceMockRepairGrantsExpectation {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("AuthServiceMock.RepairGrants mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockLoginExpectation{
-		mock:               mmLogin.mock,
-		params:             &AuthServiceMockLoginParams{ctx, req},
-		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockRepairGrantsExpectation{
+		mock:               mmRepairGrants.mock,
+		params:             &AuthServiceMockRepairGrantsParams{ctx, dryRun},
+		expectationOrigins: AuthServiceMockRepairGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	mmRepairGrants.expectations = append(mmRepairGrants.expectations, expectation)
 	return expectation
 }
-
-// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockLoginExpectation) Then(t1 auth.Tokens, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockLoginResults{t1, err}
+
+// Then sets up AuthService.RepairGrants return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRepairGrantsExpectation) Then(c2 auth.ConsistencyReport, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRepairGrantsResults{c2, err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.Login should be invoked
-func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+// Times sets number of times AuthService.RepairGrants should be invoked
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Times(n uint64) *mAuthServiceMockRepairGrants {
 	if n == 0 {
-		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+		mmRepairGrants.mock.t.Fatalf("Times of AuthServiceMock.RepairGrants mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
-	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogin
+	mm_atomic.StoreUint64(&mmRepairGrants.expectedInvocations, n)
+	mmRepairGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants
 }
 
-func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
-	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+func (mmRepairGrants *mAuthServiceMockRepairGrants) invocationsDone() bool {
+	if len(mmRepairGrants.expectations) == 0 && mmRepairGrants.defaultExpectation == nil && mmRepairGrants.mock.funcRepairGrants == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRepairGrants.mock.afterRepairGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRepairGrants.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Login implements mm_http.AuthService
-func (mmLogin *AuthServiceMock) Login(ctx context.Context, req usecase.LoginCmd) (t1 auth.Tokens, err error) {
-	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+// RepairGrants implements mm_http.AuthService
+func (mmRepairGrants *AuthServiceMock) RepairGrants(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmRepairGrants.beforeRepairGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRepairGrants.afterRepairGrantsCounter, 1)
 
-	mmLogin.t.Helper()
+	mmRepairGrants.t.Helper()
 
-	if mmLogin.inspectFuncLogin != nil {
-		mmLogin.inspectFuncLogin(ctx, req)
+	if mmRepairGrants.inspectFuncRepairGrants != nil {
+		mmRepairGrants.inspectFuncRepairGrants(ctx, dryRun)
 	}
 
-	mm_params := AuthServiceMockLoginParams{ctx, req}
+	mm_params := AuthServiceMockRepairGrantsParams{ctx, dryRun}
 
 	// Record call args
-	mmLogin.LoginMock.mutex.Lock()
-	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
-	mmLogin.LoginMock.mutex.Unlock()
+	mmRepairGrants.RepairGrantsMock.mutex.Lock()
+	mmRepairGrants.RepairGrantsMock.callArgs = append(mmRepairGrants.RepairGrantsMock.callArgs, &mm_params)
+	mmRepairGrants.RepairGrantsMock.mutex.Unlock()
 
-	for _, e := range mmLogin.LoginMock.expectations {
+	for _, e := range mmRepairGrants.RepairGrantsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.t1, e.results.err
+			return e.results.c2, e.results.err
 		}
 	}
 
-	if mmLogin.LoginMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogin.LoginMock.defaultExpectation.params
-		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+	if mmRepairGrants.RepairGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRepairGrants.RepairGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRepairGrants.RepairGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmRepairGrants.RepairGrantsMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockLoginParams{ctx, req}
+		mm_got := AuthServiceMockRepairGrantsParams{ctx, dryRun}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRepairGrants.t.Errorf("AuthServiceMock.RepairGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmRepairGrants.t.Errorf("AuthServiceMock.RepairGrants got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRepairGrants.t.Errorf("AuthServiceMock.RepairGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		mm_results := mmRepairGrants.RepairGrantsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+			mmRepairGrants.t.Fatal("No results are set for the AuthServiceMock.RepairGrants")
 		}
-		return (*mm_results).t1, (*mm_results).err
+		return (*mm_results).c2, (*mm_results).err
 	}
-	if mmLogin.funcLogin != nil {
-		return mmLogin.funcLogin(ctx, req)
+	if mmRepairGrants.funcRepairGrants != nil {
+		return mmRepairGrants.funcRepairGrants(ctx, dryRun)
 	}
-	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v", ctx, req)
+	mmRepairGrants.t.Fatalf("Unexpected call to AuthServiceMock.RepairGrants. %v %v", ctx, dryRun)
 	return
 }
 
-// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
-func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+// RepairGrantsAfterCounter returns a count of finished AuthServiceMock.RepairGrants invocations
+func (mmRepairGrants *AuthServiceMock) RepairGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.afterRepairGrantsCounter)
 }
 
-// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
-func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+// RepairGrantsBeforeCounter returns a count of AuthServiceMock.RepairGrants invocations
+func (mmRepairGrants *AuthServiceMock) RepairGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.beforeRepairGrantsCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// Calls returns a list of arguments used in each call to AuthServiceMock.RepairGrants.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
-	mmLogin.mutex.RLock()
+func (mmRepairGrants *mAuthServiceMockRepairGrants) Calls() []*AuthServiceMockRepairGrantsParams {
+	mmRepairGrants.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
-	copy(argCopy, mmLogin.callArgs)
+	argCopy := make([]*AuthServiceMockRepairGrantsParams, len(mmRepairGrants.callArgs))
+	copy(argCopy, mmRepairGrants.callArgs)
 
-	mmLogin.mutex.RUnlock()
+	mmRepairGrants.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// MinimockRepairGrantsDone returns true if the count of the RepairGrants invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockLoginDone() bool {
-	if m.LoginMock.optional {
+func (m *AuthServiceMock) MinimockRepairGrantsDone() bool {
+	if m.RepairGrantsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LoginMock.expectations {
+	for _, e := range m.RepairGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoginMock.invocationsDone()
+	return m.RepairGrantsMock.invocationsDone()
 }
 
-// MinimockLoginInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockLoginInspect() {
-	for _, e := range m.LoginMock.expectations {
+// MinimockRepairGrantsInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRepairGrantsInspect() {
+	for _, e := range m.RepairGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.RepairGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	afterRepairGrantsCounter := mm_atomic.LoadUint64(&m.afterRepairGrantsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
-		if m.LoginMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+	if m.RepairGrantsMock.defaultExpectation != nil && afterRepairGrantsCounter < 1 {
+		if m.RepairGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RepairGrants at\n%s", m.RepairGrantsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.RepairGrants at\n%s with params: %#v", m.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *m.RepairGrantsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogin != nil && afterLoginCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	if m.funcRepairGrants != nil && afterRepairGrantsCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RepairGrants at\n%s", m.funcRepairGrantsOrigin)
 	}
 
-	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	if !m.RepairGrantsMock.invocationsDone() && afterRepairGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RepairGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RepairGrantsMock.expectedInvocations), m.RepairGrantsMock.expectedInvocationsOrigin, afterRepairGrantsCounter)
 	}
 }
 
-type mAuthServiceMockRefreshTokens struct {
+type mAuthServiceMockUpdatePreset struct {
 	optional           bool
 	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockRefreshTokensExpectation
-	expectations       []*AuthServiceMockRefreshTokensExpectation
+	defaultExpectation *AuthServiceMockUpdatePresetExpectation
+	expectations       []*AuthServiceMockUpdatePresetExpectation
 
-	callArgs []*AuthServiceMockRefreshTokensParams
+	callArgs []*AuthServiceMockUpdatePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthServiceMockRefreshTokensExpectation specifies expectation struct of the AuthService.RefreshTokens
-type AuthServiceMockRefreshTokensExpectation struct {
+// AuthServiceMockUpdatePresetExpectation specifies expectation struct of the AuthService.UpdatePreset
+type AuthServiceMockUpdatePresetExpectation struct {
 	mock               *AuthServiceMock
-	params             *AuthServiceMockRefreshTokensParams
-	paramPtrs          *AuthServiceMockRefreshTokensParamPtrs
-	expectationOrigins AuthServiceMockRefreshTokensExpectationOrigins
-	results            *AuthServiceMockRefreshTokensResults
+	params             *AuthServiceMockUpdatePresetParams
+	paramPtrs          *AuthServiceMockUpdatePresetParamPtrs
+	expectationOrigins AuthServiceMockUpdatePresetExpectationOrigins
+	results            *AuthServiceMockUpdatePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthServiceMockRefreshTokensParams contains parameters of the AuthService.RefreshTokens
-type AuthServiceMockRefreshTokensParams struct {
-	ctx          context.Context
-	refreshToken auth.RefreshToken
+// AuthServiceMockUpdatePresetParams contains parameters of the AuthService.UpdatePreset
+type AuthServiceMockUpdatePresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+	req auth.RolePresetReq
 }
 
-// AuthServiceMockRefreshTokensParamPtrs contains pointers to parameters of the AuthService.RefreshTokens
-type AuthServiceMockRefreshTokensParamPtrs struct {
-	ctx          *context.Context
-	refreshToken *auth.RefreshToken
+// AuthServiceMockUpdatePresetParamPtrs contains pointers to parameters of the AuthService.UpdatePreset
+type AuthServiceMockUpdatePresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+	req *auth.RolePresetReq
 }
 
-// AuthServiceMockRefreshTokensResults contains results of the AuthService.RefreshTokens
-type AuthServiceMockRefreshTokensResults struct {
-	t1  auth.Tokens
+// AuthServiceMockUpdatePresetResults contains results of the AuthService.UpdatePreset
+type AuthServiceMockUpdatePresetResults struct {
 	err error
 }
 
-// AuthServiceMockRefreshTokensOrigins contains origins of expectations of the AuthService.RefreshTokens
-type AuthServiceMockRefreshTokensExpectationOrigins struct {
-	origin             string
-	originCtx          string
-	originRefreshToken string
+// AuthServiceMockUpdatePresetOrigins contains origins of expectations of the AuthService.UpdatePreset
+type AuthServiceMockUpdatePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2597,292 +6449,320 @@ type AuthServiceMockRefreshTokensExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Optional() *mAuthServiceMockRefreshTokens {
-	mmRefreshTokens.optional = true
-	return mmRefreshTokens
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Optional() *mAuthServiceMockUpdatePreset {
+	mmUpdatePreset.optional = true
+	return mmUpdatePreset
 }
 
-// Expect sets up expected params for AuthService.RefreshTokens
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Expect(ctx context.Context, refreshToken auth.RefreshToken) *mAuthServiceMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
+// Expect sets up expected params for AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Expect(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) *mAuthServiceMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &AuthServiceMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by ExpectParams functions")
+	if mmUpdatePreset.defaultExpectation.paramPtrs != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by ExpectParams functions")
 	}
 
-	mmRefreshTokens.defaultExpectation.params = &AuthServiceMockRefreshTokensParams{ctx, refreshToken}
-	mmRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRefreshTokens.expectations {
-		if minimock.Equal(e.params, mmRefreshTokens.defaultExpectation.params) {
-			mmRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshTokens.defaultExpectation.params)
+	mmUpdatePreset.defaultExpectation.params = &AuthServiceMockUpdatePresetParams{ctx, id, req}
+	mmUpdatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePreset.expectations {
+		if minimock.Equal(e.params, mmUpdatePreset.defaultExpectation.params) {
+			mmUpdatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthService.RefreshTokens
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &AuthServiceMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Expect")
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Expect")
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokensParamPtrs{}
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &AuthServiceMockUpdatePresetParamPtrs{}
 	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.RefreshTokens
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) ExpectRefreshTokenParam2(refreshToken auth.RefreshToken) *mAuthServiceMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) ExpectIdParam2(id uuid.UUID) *mAuthServiceMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &AuthServiceMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Expect")
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Expect")
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokensParamPtrs{}
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &AuthServiceMockUpdatePresetParamPtrs{}
 	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.refreshToken = &refreshToken
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+	mmUpdatePreset.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthService.RefreshTokens
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Inspect(f func(ctx context.Context, refreshToken auth.RefreshToken)) *mAuthServiceMockRefreshTokens {
-	if mmRefreshTokens.mock.inspectFuncRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RefreshTokens")
+// ExpectReqParam3 sets up expected param req for AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) ExpectReqParam3(req auth.RolePresetReq) *mAuthServiceMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
 
-	mmRefreshTokens.mock.inspectFuncRefreshTokens = f
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &AuthServiceMockUpdatePresetExpectation{}
+	}
 
-	return mmRefreshTokens
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Expect")
+	}
+
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &AuthServiceMockUpdatePresetParamPtrs{}
+	}
+	mmUpdatePreset.defaultExpectation.paramPtrs.req = &req
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdatePreset
 }
 
-// Return sets up results that will be returned by AuthService.RefreshTokens
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Return(t1 auth.Tokens, err error) *AuthServiceMock {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Inspect(f func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq)) *mAuthServiceMockUpdatePreset {
+	if mmUpdatePreset.mock.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.UpdatePreset")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &AuthServiceMockRefreshTokensExpectation{mock: mmRefreshTokens.mock}
+	mmUpdatePreset.mock.inspectFuncUpdatePreset = f
+
+	return mmUpdatePreset
+}
+
+// Return sets up results that will be returned by AuthService.UpdatePreset
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Return(err error) *AuthServiceMock {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
-	mmRefreshTokens.defaultExpectation.results = &AuthServiceMockRefreshTokensResults{t1, err}
-	mmRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens.mock
+
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &AuthServiceMockUpdatePresetExpectation{mock: mmUpdatePreset.mock}
+	}
+	mmUpdatePreset.defaultExpectation.results = &AuthServiceMockUpdatePresetResults{err}
+	mmUpdatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
 }
 
-// Set uses given function f to mock the AuthService.RefreshTokens method
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Set(f func(ctx context.Context, refreshToken auth.RefreshToken) (t1 auth.Tokens, err error)) *AuthServiceMock {
-	if mmRefreshTokens.defaultExpectation != nil {
-		mmRefreshTokens.mock.t.Fatalf("Default expectation is already set for the AuthService.RefreshTokens method")
+// Set uses given function f to mock the AuthService.UpdatePreset method
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Set(f func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error)) *AuthServiceMock {
+	if mmUpdatePreset.defaultExpectation != nil {
+		mmUpdatePreset.mock.t.Fatalf("Default expectation is already set for the AuthService.UpdatePreset method")
 	}
 
-	if len(mmRefreshTokens.expectations) > 0 {
-		mmRefreshTokens.mock.t.Fatalf("Some expectations are already set for the AuthService.RefreshTokens method")
+	if len(mmUpdatePreset.expectations) > 0 {
+		mmUpdatePreset.mock.t.Fatalf("Some expectations are already set for the AuthService.UpdatePreset method")
 	}
 
-	mmRefreshTokens.mock.funcRefreshTokens = f
-	mmRefreshTokens.mock.funcRefreshTokensOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens.mock
+	mmUpdatePreset.mock.funcUpdatePreset = f
+	mmUpdatePreset.mock.funcUpdatePresetOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
 }
 
-// When sets expectation for the AuthService.RefreshTokens which will trigger the result defined by the following
+// When sets expectation for the AuthService.UpdatePreset which will trigger the result defined by the following
 // Then helper
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) When(ctx context.Context, refreshToken auth.RefreshToken) *AuthServiceMockRefreshTokensExpectation {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("AuthServiceMock.RefreshTokens mock is already set by Set")
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) When(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) *AuthServiceMockUpdatePresetExpectation {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("AuthServiceMock.UpdatePreset mock is already set by Set")
 	}
 
-	expectation := &AuthServiceMockRefreshTokensExpectation{
-		mock:               mmRefreshTokens.mock,
-		params:             &AuthServiceMockRefreshTokensParams{ctx, refreshToken},
-		expectationOrigins: AuthServiceMockRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthServiceMockUpdatePresetExpectation{
+		mock:               mmUpdatePreset.mock,
+		params:             &AuthServiceMockUpdatePresetParams{ctx, id, req},
+		expectationOrigins: AuthServiceMockUpdatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRefreshTokens.expectations = append(mmRefreshTokens.expectations, expectation)
+	mmUpdatePreset.expectations = append(mmUpdatePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthService.RefreshTokens return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockRefreshTokensExpectation) Then(t1 auth.Tokens, err error) *AuthServiceMock {
-	e.results = &AuthServiceMockRefreshTokensResults{t1, err}
+// Then sets up AuthService.UpdatePreset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockUpdatePresetExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockUpdatePresetResults{err}
 	return e.mock
 }
 
-// Times sets number of times AuthService.RefreshTokens should be invoked
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Times(n uint64) *mAuthServiceMockRefreshTokens {
+// Times sets number of times AuthService.UpdatePreset should be invoked
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Times(n uint64) *mAuthServiceMockUpdatePreset {
 	if n == 0 {
-		mmRefreshTokens.mock.t.Fatalf("Times of AuthServiceMock.RefreshTokens mock can not be zero")
+		mmUpdatePreset.mock.t.Fatalf("Times of AuthServiceMock.UpdatePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRefreshTokens.expectedInvocations, n)
-	mmRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens
+	mm_atomic.StoreUint64(&mmUpdatePreset.expectedInvocations, n)
+	mmUpdatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset
 }
 
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) invocationsDone() bool {
-	if len(mmRefreshTokens.expectations) == 0 && mmRefreshTokens.defaultExpectation == nil && mmRefreshTokens.mock.funcRefreshTokens == nil {
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) invocationsDone() bool {
+	if len(mmUpdatePreset.expectations) == 0 && mmUpdatePreset.defaultExpectation == nil && mmUpdatePreset.mock.funcUpdatePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.mock.afterRefreshTokensCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.mock.afterUpdatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RefreshTokens implements mm_http.AuthService
-func (mmRefreshTokens *AuthServiceMock) RefreshTokens(ctx context.Context, refreshToken auth.RefreshToken) (t1 auth.Tokens, err error) {
-	mm_atomic.AddUint64(&mmRefreshTokens.beforeRefreshTokensCounter, 1)
-	defer mm_atomic.AddUint64(&mmRefreshTokens.afterRefreshTokensCounter, 1)
+// UpdatePreset implements mm_http.AuthService
+func (mmUpdatePreset *AuthServiceMock) UpdatePreset(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePreset.beforeUpdatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePreset.afterUpdatePresetCounter, 1)
 
-	mmRefreshTokens.t.Helper()
+	mmUpdatePreset.t.Helper()
 
-	if mmRefreshTokens.inspectFuncRefreshTokens != nil {
-		mmRefreshTokens.inspectFuncRefreshTokens(ctx, refreshToken)
+	if mmUpdatePreset.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.inspectFuncUpdatePreset(ctx, id, req)
 	}
 
-	mm_params := AuthServiceMockRefreshTokensParams{ctx, refreshToken}
+	mm_params := AuthServiceMockUpdatePresetParams{ctx, id, req}
 
 	// Record call args
-	mmRefreshTokens.RefreshTokensMock.mutex.Lock()
-	mmRefreshTokens.RefreshTokensMock.callArgs = append(mmRefreshTokens.RefreshTokensMock.callArgs, &mm_params)
-	mmRefreshTokens.RefreshTokensMock.mutex.Unlock()
+	mmUpdatePreset.UpdatePresetMock.mutex.Lock()
+	mmUpdatePreset.UpdatePresetMock.callArgs = append(mmUpdatePreset.UpdatePresetMock.callArgs, &mm_params)
+	mmUpdatePreset.UpdatePresetMock.mutex.Unlock()
 
-	for _, e := range mmRefreshTokens.RefreshTokensMock.expectations {
+	for _, e := range mmUpdatePreset.UpdatePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.t1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmRefreshTokens.RefreshTokensMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRefreshTokens.RefreshTokensMock.defaultExpectation.Counter, 1)
-		mm_want := mmRefreshTokens.RefreshTokensMock.defaultExpectation.params
-		mm_want_ptrs := mmRefreshTokens.RefreshTokensMock.defaultExpectation.paramPtrs
+	if mmUpdatePreset.UpdatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePreset.UpdatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePreset.UpdatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePreset.UpdatePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthServiceMockRefreshTokensParams{ctx, refreshToken}
+		mm_got := AuthServiceMockUpdatePresetParams{ctx, id, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdatePreset.t.Errorf("AuthServiceMock.UpdatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePreset.t.Errorf("AuthServiceMock.UpdatePreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
-				mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdatePreset.t.Errorf("AuthServiceMock.UpdatePreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRefreshTokens.t.Errorf("AuthServiceMock.RefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePreset.t.Errorf("AuthServiceMock.UpdatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRefreshTokens.RefreshTokensMock.defaultExpectation.results
+		mm_results := mmUpdatePreset.UpdatePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRefreshTokens.t.Fatal("No results are set for the AuthServiceMock.RefreshTokens")
+			mmUpdatePreset.t.Fatal("No results are set for the AuthServiceMock.UpdatePreset")
 		}
-		return (*mm_results).t1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmRefreshTokens.funcRefreshTokens != nil {
-		return mmRefreshTokens.funcRefreshTokens(ctx, refreshToken)
+	if mmUpdatePreset.funcUpdatePreset != nil {
+		return mmUpdatePreset.funcUpdatePreset(ctx, id, req)
 	}
-	mmRefreshTokens.t.Fatalf("Unexpected call to AuthServiceMock.RefreshTokens. %v %v", ctx, refreshToken)
+	mmUpdatePreset.t.Fatalf("Unexpected call to AuthServiceMock.UpdatePreset. %v %v %v", ctx, id, req)
 	return
 }
 
-// RefreshTokensAfterCounter returns a count of finished AuthServiceMock.RefreshTokens invocations
-func (mmRefreshTokens *AuthServiceMock) RefreshTokensAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefreshTokens.afterRefreshTokensCounter)
+// UpdatePresetAfterCounter returns a count of finished AuthServiceMock.UpdatePreset invocations
+func (mmUpdatePreset *AuthServiceMock) UpdatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.afterUpdatePresetCounter)
 }
 
-// RefreshTokensBeforeCounter returns a count of AuthServiceMock.RefreshTokens invocations
-func (mmRefreshTokens *AuthServiceMock) RefreshTokensBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefreshTokens.beforeRefreshTokensCounter)
+// UpdatePresetBeforeCounter returns a count of AuthServiceMock.UpdatePreset invocations
+func (mmUpdatePreset *AuthServiceMock) UpdatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.beforeUpdatePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthServiceMock.RefreshTokens.
+// Calls returns a list of arguments used in each call to AuthServiceMock.UpdatePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRefreshTokens *mAuthServiceMockRefreshTokens) Calls() []*AuthServiceMockRefreshTokensParams {
-	mmRefreshTokens.mutex.RLock()
+func (mmUpdatePreset *mAuthServiceMockUpdatePreset) Calls() []*AuthServiceMockUpdatePresetParams {
+	mmUpdatePreset.mutex.RLock()
 
-	argCopy := make([]*AuthServiceMockRefreshTokensParams, len(mmRefreshTokens.callArgs))
-	copy(argCopy, mmRefreshTokens.callArgs)
+	argCopy := make([]*AuthServiceMockUpdatePresetParams, len(mmUpdatePreset.callArgs))
+	copy(argCopy, mmUpdatePreset.callArgs)
 
-	mmRefreshTokens.mutex.RUnlock()
+	mmUpdatePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRefreshTokensDone returns true if the count of the RefreshTokens invocations corresponds
+// MinimockUpdatePresetDone returns true if the count of the UpdatePreset invocations corresponds
 // the number of defined expectations
-func (m *AuthServiceMock) MinimockRefreshTokensDone() bool {
-	if m.RefreshTokensMock.optional {
+func (m *AuthServiceMock) MinimockUpdatePresetDone() bool {
+	if m.UpdatePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RefreshTokensMock.expectations {
+	for _, e := range m.UpdatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RefreshTokensMock.invocationsDone()
+	return m.UpdatePresetMock.invocationsDone()
 }
 
-// MinimockRefreshTokensInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockRefreshTokensInspect() {
-	for _, e := range m.RefreshTokensMock.expectations {
+// MinimockUpdatePresetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockUpdatePresetInspect() {
+	for _, e := range m.UpdatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthServiceMock.UpdatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterRefreshTokensCounter)
+	afterUpdatePresetCounter := mm_atomic.LoadUint64(&m.afterUpdatePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RefreshTokensMock.defaultExpectation != nil && afterRefreshTokensCounter < 1 {
-		if m.RefreshTokensMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s", m.RefreshTokensMock.defaultExpectation.returnOrigin)
+	if m.UpdatePresetMock.defaultExpectation != nil && afterUpdatePresetCounter < 1 {
+		if m.UpdatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.UpdatePreset at\n%s", m.UpdatePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s with params: %#v", m.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.RefreshTokensMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthServiceMock.UpdatePreset at\n%s with params: %#v", m.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRefreshTokens != nil && afterRefreshTokensCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.RefreshTokens at\n%s", m.funcRefreshTokensOrigin)
+	if m.funcUpdatePreset != nil && afterUpdatePresetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.UpdatePreset at\n%s", m.funcUpdatePresetOrigin)
 	}
 
-	if !m.RefreshTokensMock.invocationsDone() && afterRefreshTokensCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.RefreshTokens at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RefreshTokensMock.expectedInvocations), m.RefreshTokensMock.expectedInvocationsOrigin, afterRefreshTokensCounter)
+	if !m.UpdatePresetMock.invocationsDone() && afterUpdatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.UpdatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePresetMock.expectedInvocations), m.UpdatePresetMock.expectedInvocationsOrigin, afterUpdatePresetCounter)
 	}
 }
 
@@ -2892,19 +6772,41 @@ func (m *AuthServiceMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddUserRoleInspect()
 
+			m.MinimockApplyPresetInspect()
+
+			m.MinimockCreatePresetInspect()
+
+			m.MinimockDeletePresetInspect()
+
 			m.MinimockDeleteSessionInspect()
 
 			m.MinimockDeleteSessionsByUserIDInspect()
 
 			m.MinimockDeleteUserRoleInspect()
 
+			m.MinimockGetConsistencyReportInspect()
+
+			m.MinimockGetLoginHistoryInspect()
+
+			m.MinimockGetPresetInspect()
+
 			m.MinimockGetSessionsByUserIDInspect()
 
+			m.MinimockImpersonateInspect()
+
+			m.MinimockListPresetsInspect()
+
 			m.MinimockListUserRolesInspect()
 
 			m.MinimockLoginInspect()
 
+			m.MinimockLogoutInspect()
+
 			m.MinimockRefreshTokensInspect()
+
+			m.MinimockRepairGrantsInspect()
+
+			m.MinimockUpdatePresetInspect()
 		}
 	})
 }
@@ -2929,11 +6831,22 @@ func (m *AuthServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddUserRoleDone() &&
+		m.MinimockApplyPresetDone() &&
+		m.MinimockCreatePresetDone() &&
+		m.MinimockDeletePresetDone() &&
 		m.MinimockDeleteSessionDone() &&
 		m.MinimockDeleteSessionsByUserIDDone() &&
 		m.MinimockDeleteUserRoleDone() &&
+		m.MinimockGetConsistencyReportDone() &&
+		m.MinimockGetLoginHistoryDone() &&
+		m.MinimockGetPresetDone() &&
 		m.MinimockGetSessionsByUserIDDone() &&
+		m.MinimockImpersonateDone() &&
+		m.MinimockListPresetsDone() &&
 		m.MinimockListUserRolesDone() &&
 		m.MinimockLoginDone() &&
-		m.MinimockRefreshTokensDone()
+		m.MinimockLogoutDone() &&
+		m.MinimockRefreshTokensDone() &&
+		m.MinimockRepairGrantsDone() &&
+		m.MinimockUpdatePresetDone()
 }
@@ -0,0 +1,709 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/auth/transport/http.RoleChecker -o role_checker_mock.go -n RoleCheckerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RoleCheckerMock implements mm_http.RoleChecker
+type RoleCheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckIsAdmin          func(ctx context.Context) (err error)
+	funcCheckIsAdminOrigin    string
+	inspectFuncCheckIsAdmin   func(ctx context.Context)
+	afterCheckIsAdminCounter  uint64
+	beforeCheckIsAdminCounter uint64
+	CheckIsAdminMock          mRoleCheckerMockCheckIsAdmin
+
+	funcCheckIsOperator          func(ctx context.Context) (err error)
+	funcCheckIsOperatorOrigin    string
+	inspectFuncCheckIsOperator   func(ctx context.Context)
+	afterCheckIsOperatorCounter  uint64
+	beforeCheckIsOperatorCounter uint64
+	CheckIsOperatorMock          mRoleCheckerMockCheckIsOperator
+}
+
+// NewRoleCheckerMock returns a mock for mm_http.RoleChecker
+func NewRoleCheckerMock(t minimock.Tester) *RoleCheckerMock {
+	m := &RoleCheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckIsAdminMock = mRoleCheckerMockCheckIsAdmin{mock: m}
+	m.CheckIsAdminMock.callArgs = []*RoleCheckerMockCheckIsAdminParams{}
+
+	m.CheckIsOperatorMock = mRoleCheckerMockCheckIsOperator{mock: m}
+	m.CheckIsOperatorMock.callArgs = []*RoleCheckerMockCheckIsOperatorParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRoleCheckerMockCheckIsAdmin struct {
+	optional           bool
+	mock               *RoleCheckerMock
+	defaultExpectation *RoleCheckerMockCheckIsAdminExpectation
+	expectations       []*RoleCheckerMockCheckIsAdminExpectation
+
+	callArgs []*RoleCheckerMockCheckIsAdminParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleCheckerMockCheckIsAdminExpectation specifies expectation struct of the RoleChecker.CheckIsAdmin
+type RoleCheckerMockCheckIsAdminExpectation struct {
+	mock               *RoleCheckerMock
+	params             *RoleCheckerMockCheckIsAdminParams
+	paramPtrs          *RoleCheckerMockCheckIsAdminParamPtrs
+	expectationOrigins RoleCheckerMockCheckIsAdminExpectationOrigins
+	results            *RoleCheckerMockCheckIsAdminResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleCheckerMockCheckIsAdminParams contains parameters of the RoleChecker.CheckIsAdmin
+type RoleCheckerMockCheckIsAdminParams struct {
+	ctx context.Context
+}
+
+// RoleCheckerMockCheckIsAdminParamPtrs contains pointers to parameters of the RoleChecker.CheckIsAdmin
+type RoleCheckerMockCheckIsAdminParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleCheckerMockCheckIsAdminResults contains results of the RoleChecker.CheckIsAdmin
+type RoleCheckerMockCheckIsAdminResults struct {
+	err error
+}
+
+// RoleCheckerMockCheckIsAdminOrigins contains origins of expectations of the RoleChecker.CheckIsAdmin
+type RoleCheckerMockCheckIsAdminExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Optional() *mRoleCheckerMockCheckIsAdmin {
+	mmCheckIsAdmin.optional = true
+	return mmCheckIsAdmin
+}
+
+// Expect sets up expected params for RoleChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Expect(ctx context.Context) *mRoleCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &RoleCheckerMockCheckIsAdminExpectation{}
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.paramPtrs != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by ExpectParams functions")
+	}
+
+	mmCheckIsAdmin.defaultExpectation.params = &RoleCheckerMockCheckIsAdminParams{ctx}
+	mmCheckIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckIsAdmin.expectations {
+		if minimock.Equal(e.params, mmCheckIsAdmin.defaultExpectation.params) {
+			mmCheckIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIsAdmin.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckIsAdmin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) ExpectCtxParam1(ctx context.Context) *mRoleCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &RoleCheckerMockCheckIsAdminExpectation{}
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.params != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by Expect")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation.paramPtrs == nil {
+		mmCheckIsAdmin.defaultExpectation.paramPtrs = &RoleCheckerMockCheckIsAdminParamPtrs{}
+	}
+	mmCheckIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckIsAdmin
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Inspect(f func(ctx context.Context)) *mRoleCheckerMockCheckIsAdmin {
+	if mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("Inspect function is already set for RoleCheckerMock.CheckIsAdmin")
+	}
+
+	mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin = f
+
+	return mmCheckIsAdmin
+}
+
+// Return sets up results that will be returned by RoleChecker.CheckIsAdmin
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Return(err error) *RoleCheckerMock {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	if mmCheckIsAdmin.defaultExpectation == nil {
+		mmCheckIsAdmin.defaultExpectation = &RoleCheckerMockCheckIsAdminExpectation{mock: mmCheckIsAdmin.mock}
+	}
+	mmCheckIsAdmin.defaultExpectation.results = &RoleCheckerMockCheckIsAdminResults{err}
+	mmCheckIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin.mock
+}
+
+// Set uses given function f to mock the RoleChecker.CheckIsAdmin method
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Set(f func(ctx context.Context) (err error)) *RoleCheckerMock {
+	if mmCheckIsAdmin.defaultExpectation != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("Default expectation is already set for the RoleChecker.CheckIsAdmin method")
+	}
+
+	if len(mmCheckIsAdmin.expectations) > 0 {
+		mmCheckIsAdmin.mock.t.Fatalf("Some expectations are already set for the RoleChecker.CheckIsAdmin method")
+	}
+
+	mmCheckIsAdmin.mock.funcCheckIsAdmin = f
+	mmCheckIsAdmin.mock.funcCheckIsAdminOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin.mock
+}
+
+// When sets expectation for the RoleChecker.CheckIsAdmin which will trigger the result defined by the following
+// Then helper
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) When(ctx context.Context) *RoleCheckerMockCheckIsAdminExpectation {
+	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
+		mmCheckIsAdmin.mock.t.Fatalf("RoleCheckerMock.CheckIsAdmin mock is already set by Set")
+	}
+
+	expectation := &RoleCheckerMockCheckIsAdminExpectation{
+		mock:               mmCheckIsAdmin.mock,
+		params:             &RoleCheckerMockCheckIsAdminParams{ctx},
+		expectationOrigins: RoleCheckerMockCheckIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckIsAdmin.expectations = append(mmCheckIsAdmin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleChecker.CheckIsAdmin return parameters for the expectation previously defined by the When method
+func (e *RoleCheckerMockCheckIsAdminExpectation) Then(err error) *RoleCheckerMock {
+	e.results = &RoleCheckerMockCheckIsAdminResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleChecker.CheckIsAdmin should be invoked
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Times(n uint64) *mRoleCheckerMockCheckIsAdmin {
+	if n == 0 {
+		mmCheckIsAdmin.mock.t.Fatalf("Times of RoleCheckerMock.CheckIsAdmin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckIsAdmin.expectedInvocations, n)
+	mmCheckIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckIsAdmin
+}
+
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) invocationsDone() bool {
+	if len(mmCheckIsAdmin.expectations) == 0 && mmCheckIsAdmin.defaultExpectation == nil && mmCheckIsAdmin.mock.funcCheckIsAdmin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.mock.afterCheckIsAdminCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckIsAdmin implements mm_http.RoleChecker
+func (mmCheckIsAdmin *RoleCheckerMock) CheckIsAdmin(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter, 1)
+
+	mmCheckIsAdmin.t.Helper()
+
+	if mmCheckIsAdmin.inspectFuncCheckIsAdmin != nil {
+		mmCheckIsAdmin.inspectFuncCheckIsAdmin(ctx)
+	}
+
+	mm_params := RoleCheckerMockCheckIsAdminParams{ctx}
+
+	// Record call args
+	mmCheckIsAdmin.CheckIsAdminMock.mutex.Lock()
+	mmCheckIsAdmin.CheckIsAdminMock.callArgs = append(mmCheckIsAdmin.CheckIsAdminMock.callArgs, &mm_params)
+	mmCheckIsAdmin.CheckIsAdminMock.mutex.Unlock()
+
+	for _, e := range mmCheckIsAdmin.CheckIsAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleCheckerMockCheckIsAdminParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckIsAdmin.t.Errorf("RoleCheckerMock.CheckIsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckIsAdmin.t.Errorf("RoleCheckerMock.CheckIsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckIsAdmin.t.Fatal("No results are set for the RoleCheckerMock.CheckIsAdmin")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckIsAdmin.funcCheckIsAdmin != nil {
+		return mmCheckIsAdmin.funcCheckIsAdmin(ctx)
+	}
+	mmCheckIsAdmin.t.Fatalf("Unexpected call to RoleCheckerMock.CheckIsAdmin. %v", ctx)
+	return
+}
+
+// CheckIsAdminAfterCounter returns a count of finished RoleCheckerMock.CheckIsAdmin invocations
+func (mmCheckIsAdmin *RoleCheckerMock) CheckIsAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter)
+}
+
+// CheckIsAdminBeforeCounter returns a count of RoleCheckerMock.CheckIsAdmin invocations
+func (mmCheckIsAdmin *RoleCheckerMock) CheckIsAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleCheckerMock.CheckIsAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckIsAdmin *mRoleCheckerMockCheckIsAdmin) Calls() []*RoleCheckerMockCheckIsAdminParams {
+	mmCheckIsAdmin.mutex.RLock()
+
+	argCopy := make([]*RoleCheckerMockCheckIsAdminParams, len(mmCheckIsAdmin.callArgs))
+	copy(argCopy, mmCheckIsAdmin.callArgs)
+
+	mmCheckIsAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckIsAdminDone returns true if the count of the CheckIsAdmin invocations corresponds
+// the number of defined expectations
+func (m *RoleCheckerMock) MinimockCheckIsAdminDone() bool {
+	if m.CheckIsAdminMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckIsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckIsAdminMock.invocationsDone()
+}
+
+// MinimockCheckIsAdminInspect logs each unmet expectation
+func (m *RoleCheckerMock) MinimockCheckIsAdminInspect() {
+	for _, e := range m.CheckIsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckIsAdminCounter := mm_atomic.LoadUint64(&m.afterCheckIsAdminCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckIsAdminMock.defaultExpectation != nil && afterCheckIsAdminCounter < 1 {
+		if m.CheckIsAdminMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsAdmin at\n%s", m.CheckIsAdminMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsAdmin at\n%s with params: %#v", m.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *m.CheckIsAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckIsAdmin != nil && afterCheckIsAdminCounter < 1 {
+		m.t.Errorf("Expected call to RoleCheckerMock.CheckIsAdmin at\n%s", m.funcCheckIsAdminOrigin)
+	}
+
+	if !m.CheckIsAdminMock.invocationsDone() && afterCheckIsAdminCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleCheckerMock.CheckIsAdmin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckIsAdminMock.expectedInvocations), m.CheckIsAdminMock.expectedInvocationsOrigin, afterCheckIsAdminCounter)
+	}
+}
+
+type mRoleCheckerMockCheckIsOperator struct {
+	optional           bool
+	mock               *RoleCheckerMock
+	defaultExpectation *RoleCheckerMockCheckIsOperatorExpectation
+	expectations       []*RoleCheckerMockCheckIsOperatorExpectation
+
+	callArgs []*RoleCheckerMockCheckIsOperatorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleCheckerMockCheckIsOperatorExpectation specifies expectation struct of the RoleChecker.CheckIsOperator
+type RoleCheckerMockCheckIsOperatorExpectation struct {
+	mock               *RoleCheckerMock
+	params             *RoleCheckerMockCheckIsOperatorParams
+	paramPtrs          *RoleCheckerMockCheckIsOperatorParamPtrs
+	expectationOrigins RoleCheckerMockCheckIsOperatorExpectationOrigins
+	results            *RoleCheckerMockCheckIsOperatorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleCheckerMockCheckIsOperatorParams contains parameters of the RoleChecker.CheckIsOperator
+type RoleCheckerMockCheckIsOperatorParams struct {
+	ctx context.Context
+}
+
+// RoleCheckerMockCheckIsOperatorParamPtrs contains pointers to parameters of the RoleChecker.CheckIsOperator
+type RoleCheckerMockCheckIsOperatorParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleCheckerMockCheckIsOperatorResults contains results of the RoleChecker.CheckIsOperator
+type RoleCheckerMockCheckIsOperatorResults struct {
+	err error
+}
+
+// RoleCheckerMockCheckIsOperatorOrigins contains origins of expectations of the RoleChecker.CheckIsOperator
+type RoleCheckerMockCheckIsOperatorExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Optional() *mRoleCheckerMockCheckIsOperator {
+	mmCheckIsOperator.optional = true
+	return mmCheckIsOperator
+}
+
+// Expect sets up expected params for RoleChecker.CheckIsOperator
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Expect(ctx context.Context) *mRoleCheckerMockCheckIsOperator {
+	if mmCheckIsOperator.mock.funcCheckIsOperator != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by Set")
+	}
+
+	if mmCheckIsOperator.defaultExpectation == nil {
+		mmCheckIsOperator.defaultExpectation = &RoleCheckerMockCheckIsOperatorExpectation{}
+	}
+
+	if mmCheckIsOperator.defaultExpectation.paramPtrs != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by ExpectParams functions")
+	}
+
+	mmCheckIsOperator.defaultExpectation.params = &RoleCheckerMockCheckIsOperatorParams{ctx}
+	mmCheckIsOperator.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckIsOperator.expectations {
+		if minimock.Equal(e.params, mmCheckIsOperator.defaultExpectation.params) {
+			mmCheckIsOperator.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIsOperator.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckIsOperator
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleChecker.CheckIsOperator
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) ExpectCtxParam1(ctx context.Context) *mRoleCheckerMockCheckIsOperator {
+	if mmCheckIsOperator.mock.funcCheckIsOperator != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by Set")
+	}
+
+	if mmCheckIsOperator.defaultExpectation == nil {
+		mmCheckIsOperator.defaultExpectation = &RoleCheckerMockCheckIsOperatorExpectation{}
+	}
+
+	if mmCheckIsOperator.defaultExpectation.params != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by Expect")
+	}
+
+	if mmCheckIsOperator.defaultExpectation.paramPtrs == nil {
+		mmCheckIsOperator.defaultExpectation.paramPtrs = &RoleCheckerMockCheckIsOperatorParamPtrs{}
+	}
+	mmCheckIsOperator.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckIsOperator.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckIsOperator
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleChecker.CheckIsOperator
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Inspect(f func(ctx context.Context)) *mRoleCheckerMockCheckIsOperator {
+	if mmCheckIsOperator.mock.inspectFuncCheckIsOperator != nil {
+		mmCheckIsOperator.mock.t.Fatalf("Inspect function is already set for RoleCheckerMock.CheckIsOperator")
+	}
+
+	mmCheckIsOperator.mock.inspectFuncCheckIsOperator = f
+
+	return mmCheckIsOperator
+}
+
+// Return sets up results that will be returned by RoleChecker.CheckIsOperator
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Return(err error) *RoleCheckerMock {
+	if mmCheckIsOperator.mock.funcCheckIsOperator != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by Set")
+	}
+
+	if mmCheckIsOperator.defaultExpectation == nil {
+		mmCheckIsOperator.defaultExpectation = &RoleCheckerMockCheckIsOperatorExpectation{mock: mmCheckIsOperator.mock}
+	}
+	mmCheckIsOperator.defaultExpectation.results = &RoleCheckerMockCheckIsOperatorResults{err}
+	mmCheckIsOperator.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckIsOperator.mock
+}
+
+// Set uses given function f to mock the RoleChecker.CheckIsOperator method
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Set(f func(ctx context.Context) (err error)) *RoleCheckerMock {
+	if mmCheckIsOperator.defaultExpectation != nil {
+		mmCheckIsOperator.mock.t.Fatalf("Default expectation is already set for the RoleChecker.CheckIsOperator method")
+	}
+
+	if len(mmCheckIsOperator.expectations) > 0 {
+		mmCheckIsOperator.mock.t.Fatalf("Some expectations are already set for the RoleChecker.CheckIsOperator method")
+	}
+
+	mmCheckIsOperator.mock.funcCheckIsOperator = f
+	mmCheckIsOperator.mock.funcCheckIsOperatorOrigin = minimock.CallerInfo(1)
+	return mmCheckIsOperator.mock
+}
+
+// When sets expectation for the RoleChecker.CheckIsOperator which will trigger the result defined by the following
+// Then helper
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) When(ctx context.Context) *RoleCheckerMockCheckIsOperatorExpectation {
+	if mmCheckIsOperator.mock.funcCheckIsOperator != nil {
+		mmCheckIsOperator.mock.t.Fatalf("RoleCheckerMock.CheckIsOperator mock is already set by Set")
+	}
+
+	expectation := &RoleCheckerMockCheckIsOperatorExpectation{
+		mock:               mmCheckIsOperator.mock,
+		params:             &RoleCheckerMockCheckIsOperatorParams{ctx},
+		expectationOrigins: RoleCheckerMockCheckIsOperatorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckIsOperator.expectations = append(mmCheckIsOperator.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleChecker.CheckIsOperator return parameters for the expectation previously defined by the When method
+func (e *RoleCheckerMockCheckIsOperatorExpectation) Then(err error) *RoleCheckerMock {
+	e.results = &RoleCheckerMockCheckIsOperatorResults{err}
+	return e.mock
+}
+
+// Times sets number of times RoleChecker.CheckIsOperator should be invoked
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Times(n uint64) *mRoleCheckerMockCheckIsOperator {
+	if n == 0 {
+		mmCheckIsOperator.mock.t.Fatalf("Times of RoleCheckerMock.CheckIsOperator mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckIsOperator.expectedInvocations, n)
+	mmCheckIsOperator.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckIsOperator
+}
+
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) invocationsDone() bool {
+	if len(mmCheckIsOperator.expectations) == 0 && mmCheckIsOperator.defaultExpectation == nil && mmCheckIsOperator.mock.funcCheckIsOperator == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckIsOperator.mock.afterCheckIsOperatorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIsOperator.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckIsOperator implements mm_http.RoleChecker
+func (mmCheckIsOperator *RoleCheckerMock) CheckIsOperator(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmCheckIsOperator.beforeCheckIsOperatorCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckIsOperator.afterCheckIsOperatorCounter, 1)
+
+	mmCheckIsOperator.t.Helper()
+
+	if mmCheckIsOperator.inspectFuncCheckIsOperator != nil {
+		mmCheckIsOperator.inspectFuncCheckIsOperator(ctx)
+	}
+
+	mm_params := RoleCheckerMockCheckIsOperatorParams{ctx}
+
+	// Record call args
+	mmCheckIsOperator.CheckIsOperatorMock.mutex.Lock()
+	mmCheckIsOperator.CheckIsOperatorMock.callArgs = append(mmCheckIsOperator.CheckIsOperatorMock.callArgs, &mm_params)
+	mmCheckIsOperator.CheckIsOperatorMock.mutex.Unlock()
+
+	for _, e := range mmCheckIsOperator.CheckIsOperatorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleCheckerMockCheckIsOperatorParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckIsOperator.t.Errorf("RoleCheckerMock.CheckIsOperator got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckIsOperator.t.Errorf("RoleCheckerMock.CheckIsOperator got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckIsOperator.CheckIsOperatorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckIsOperator.t.Fatal("No results are set for the RoleCheckerMock.CheckIsOperator")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckIsOperator.funcCheckIsOperator != nil {
+		return mmCheckIsOperator.funcCheckIsOperator(ctx)
+	}
+	mmCheckIsOperator.t.Fatalf("Unexpected call to RoleCheckerMock.CheckIsOperator. %v", ctx)
+	return
+}
+
+// CheckIsOperatorAfterCounter returns a count of finished RoleCheckerMock.CheckIsOperator invocations
+func (mmCheckIsOperator *RoleCheckerMock) CheckIsOperatorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsOperator.afterCheckIsOperatorCounter)
+}
+
+// CheckIsOperatorBeforeCounter returns a count of RoleCheckerMock.CheckIsOperator invocations
+func (mmCheckIsOperator *RoleCheckerMock) CheckIsOperatorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckIsOperator.beforeCheckIsOperatorCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleCheckerMock.CheckIsOperator.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckIsOperator *mRoleCheckerMockCheckIsOperator) Calls() []*RoleCheckerMockCheckIsOperatorParams {
+	mmCheckIsOperator.mutex.RLock()
+
+	argCopy := make([]*RoleCheckerMockCheckIsOperatorParams, len(mmCheckIsOperator.callArgs))
+	copy(argCopy, mmCheckIsOperator.callArgs)
+
+	mmCheckIsOperator.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckIsOperatorDone returns true if the count of the CheckIsOperator invocations corresponds
+// the number of defined expectations
+func (m *RoleCheckerMock) MinimockCheckIsOperatorDone() bool {
+	if m.CheckIsOperatorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckIsOperatorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckIsOperatorMock.invocationsDone()
+}
+
+// MinimockCheckIsOperatorInspect logs each unmet expectation
+func (m *RoleCheckerMock) MinimockCheckIsOperatorInspect() {
+	for _, e := range m.CheckIsOperatorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsOperator at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckIsOperatorCounter := mm_atomic.LoadUint64(&m.afterCheckIsOperatorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckIsOperatorMock.defaultExpectation != nil && afterCheckIsOperatorCounter < 1 {
+		if m.CheckIsOperatorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsOperator at\n%s", m.CheckIsOperatorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleCheckerMock.CheckIsOperator at\n%s with params: %#v", m.CheckIsOperatorMock.defaultExpectation.expectationOrigins.origin, *m.CheckIsOperatorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckIsOperator != nil && afterCheckIsOperatorCounter < 1 {
+		m.t.Errorf("Expected call to RoleCheckerMock.CheckIsOperator at\n%s", m.funcCheckIsOperatorOrigin)
+	}
+
+	if !m.CheckIsOperatorMock.invocationsDone() && afterCheckIsOperatorCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleCheckerMock.CheckIsOperator at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckIsOperatorMock.expectedInvocations), m.CheckIsOperatorMock.expectedInvocationsOrigin, afterCheckIsOperatorCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RoleCheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckIsAdminInspect()
+
+			m.MinimockCheckIsOperatorInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RoleCheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RoleCheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckIsAdminDone() &&
+		m.MinimockCheckIsOperatorDone()
+}
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -7,6 +7,7 @@ package mocks
 import (
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -18,6 +19,13 @@ type TokenCodecMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcLeeway          func() (d1 time.Duration)
+	funcLeewayOrigin    string
+	inspectFuncLeeway   func()
+	afterLeewayCounter  uint64
+	beforeLeewayCounter uint64
+	LeewayMock          mTokenCodecMockLeeway
+
 	funcParseToken          func(tokenStr string, claims jwt.Claims) (err error)
 	funcParseTokenOrigin    string
 	inspectFuncParseToken   func(tokenStr string, claims jwt.Claims)
@@ -34,6 +42,8 @@ func NewTokenCodecMock(t minimock.Tester) *TokenCodecMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.LeewayMock = mTokenCodecMockLeeway{mock: m}
+
 	m.ParseTokenMock = mTokenCodecMockParseToken{mock: m}
 	m.ParseTokenMock.callArgs = []*TokenCodecMockParseTokenParams{}
 
@@ -42,6 +52,192 @@ func NewTokenCodecMock(t minimock.Tester) *TokenCodecMock {
 	return m
 }
 
+type mTokenCodecMockLeeway struct {
+	optional           bool
+	mock               *TokenCodecMock
+	defaultExpectation *TokenCodecMockLeewayExpectation
+	expectations       []*TokenCodecMockLeewayExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TokenCodecMockLeewayExpectation specifies expectation struct of the TokenCodec.Leeway
+type TokenCodecMockLeewayExpectation struct {
+	mock *TokenCodecMock
+
+	results      *TokenCodecMockLeewayResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// TokenCodecMockLeewayResults contains results of the TokenCodec.Leeway
+type TokenCodecMockLeewayResults struct {
+	d1 time.Duration
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeeway *mTokenCodecMockLeeway) Optional() *mTokenCodecMockLeeway {
+	mmLeeway.optional = true
+	return mmLeeway
+}
+
+// Expect sets up expected params for TokenCodec.Leeway
+func (mmLeeway *mTokenCodecMockLeeway) Expect() *mTokenCodecMockLeeway {
+	if mmLeeway.mock.funcLeeway != nil {
+		mmLeeway.mock.t.Fatalf("TokenCodecMock.Leeway mock is already set by Set")
+	}
+
+	if mmLeeway.defaultExpectation == nil {
+		mmLeeway.defaultExpectation = &TokenCodecMockLeewayExpectation{}
+	}
+
+	return mmLeeway
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenCodec.Leeway
+func (mmLeeway *mTokenCodecMockLeeway) Inspect(f func()) *mTokenCodecMockLeeway {
+	if mmLeeway.mock.inspectFuncLeeway != nil {
+		mmLeeway.mock.t.Fatalf("Inspect function is already set for TokenCodecMock.Leeway")
+	}
+
+	mmLeeway.mock.inspectFuncLeeway = f
+
+	return mmLeeway
+}
+
+// Return sets up results that will be returned by TokenCodec.Leeway
+func (mmLeeway *mTokenCodecMockLeeway) Return(d1 time.Duration) *TokenCodecMock {
+	if mmLeeway.mock.funcLeeway != nil {
+		mmLeeway.mock.t.Fatalf("TokenCodecMock.Leeway mock is already set by Set")
+	}
+
+	if mmLeeway.defaultExpectation == nil {
+		mmLeeway.defaultExpectation = &TokenCodecMockLeewayExpectation{mock: mmLeeway.mock}
+	}
+	mmLeeway.defaultExpectation.results = &TokenCodecMockLeewayResults{d1}
+	mmLeeway.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeeway.mock
+}
+
+// Set uses given function f to mock the TokenCodec.Leeway method
+func (mmLeeway *mTokenCodecMockLeeway) Set(f func() (d1 time.Duration)) *TokenCodecMock {
+	if mmLeeway.defaultExpectation != nil {
+		mmLeeway.mock.t.Fatalf("Default expectation is already set for the TokenCodec.Leeway method")
+	}
+
+	if len(mmLeeway.expectations) > 0 {
+		mmLeeway.mock.t.Fatalf("Some expectations are already set for the TokenCodec.Leeway method")
+	}
+
+	mmLeeway.mock.funcLeeway = f
+	mmLeeway.mock.funcLeewayOrigin = minimock.CallerInfo(1)
+	return mmLeeway.mock
+}
+
+// Times sets number of times TokenCodec.Leeway should be invoked
+func (mmLeeway *mTokenCodecMockLeeway) Times(n uint64) *mTokenCodecMockLeeway {
+	if n == 0 {
+		mmLeeway.mock.t.Fatalf("Times of TokenCodecMock.Leeway mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeeway.expectedInvocations, n)
+	mmLeeway.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeeway
+}
+
+func (mmLeeway *mTokenCodecMockLeeway) invocationsDone() bool {
+	if len(mmLeeway.expectations) == 0 && mmLeeway.defaultExpectation == nil && mmLeeway.mock.funcLeeway == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeeway.mock.afterLeewayCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeeway.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Leeway implements mm_http.TokenCodec
+func (mmLeeway *TokenCodecMock) Leeway() (d1 time.Duration) {
+	mm_atomic.AddUint64(&mmLeeway.beforeLeewayCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeeway.afterLeewayCounter, 1)
+
+	mmLeeway.t.Helper()
+
+	if mmLeeway.inspectFuncLeeway != nil {
+		mmLeeway.inspectFuncLeeway()
+	}
+
+	if mmLeeway.LeewayMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeeway.LeewayMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmLeeway.LeewayMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeeway.t.Fatal("No results are set for the TokenCodecMock.Leeway")
+		}
+		return (*mm_results).d1
+	}
+	if mmLeeway.funcLeeway != nil {
+		return mmLeeway.funcLeeway()
+	}
+	mmLeeway.t.Fatalf("Unexpected call to TokenCodecMock.Leeway.")
+	return
+}
+
+// LeewayAfterCounter returns a count of finished TokenCodecMock.Leeway invocations
+func (mmLeeway *TokenCodecMock) LeewayAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeeway.afterLeewayCounter)
+}
+
+// LeewayBeforeCounter returns a count of TokenCodecMock.Leeway invocations
+func (mmLeeway *TokenCodecMock) LeewayBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeeway.beforeLeewayCounter)
+}
+
+// MinimockLeewayDone returns true if the count of the Leeway invocations corresponds
+// the number of defined expectations
+func (m *TokenCodecMock) MinimockLeewayDone() bool {
+	if m.LeewayMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeewayMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeewayMock.invocationsDone()
+}
+
+// MinimockLeewayInspect logs each unmet expectation
+func (m *TokenCodecMock) MinimockLeewayInspect() {
+	for _, e := range m.LeewayMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to TokenCodecMock.Leeway")
+		}
+	}
+
+	afterLeewayCounter := mm_atomic.LoadUint64(&m.afterLeewayCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeewayMock.defaultExpectation != nil && afterLeewayCounter < 1 {
+		m.t.Errorf("Expected call to TokenCodecMock.Leeway at\n%s", m.LeewayMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeeway != nil && afterLeewayCounter < 1 {
+		m.t.Errorf("Expected call to TokenCodecMock.Leeway at\n%s", m.funcLeewayOrigin)
+	}
+
+	if !m.LeewayMock.invocationsDone() && afterLeewayCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenCodecMock.Leeway at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeewayMock.expectedInvocations), m.LeewayMock.expectedInvocationsOrigin, afterLeewayCounter)
+	}
+}
+
 type mTokenCodecMockParseToken struct {
 	optional           bool
 	mock               *TokenCodecMock
@@ -388,6 +584,8 @@ func (m *TokenCodecMock) MinimockParseTokenInspect() {
 func (m *TokenCodecMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockLeewayInspect()
+
 			m.MinimockParseTokenInspect()
 		}
 	})
@@ -412,5 +610,6 @@ func (m *TokenCodecMock) MinimockWait(timeout mm_time.Duration) {
 func (m *TokenCodecMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockLeewayDone() &&
 		m.MinimockParseTokenDone()
 }
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -27,12 +27,12 @@ type CoreMock struct {
 	beforeAddUserRoleCounter uint64
 	AddUserRoleMock          mCoreMockAddUserRole
 
-	funcCheckIsAdmin          func(ctx context.Context) (err error)
-	funcCheckIsAdminOrigin    string
-	inspectFuncCheckIsAdmin   func(ctx context.Context)
-	afterCheckIsAdminCounter  uint64
-	beforeCheckIsAdminCounter uint64
-	CheckIsAdminMock          mCoreMockCheckIsAdmin
+	funcApplyPreset          func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error)
+	funcApplyPresetOrigin    string
+	inspectFuncApplyPreset   func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq)
+	afterApplyPresetCounter  uint64
+	beforeApplyPresetCounter uint64
+	ApplyPresetMock          mCoreMockApplyPreset
 
 	funcCheckSelfOrAdmin          func(ctx context.Context, targetUserID uuid.UUID) (err error)
 	funcCheckSelfOrAdminOrigin    string
@@ -41,6 +41,20 @@ type CoreMock struct {
 	beforeCheckSelfOrAdminCounter uint64
 	CheckSelfOrAdminMock          mCoreMockCheckSelfOrAdmin
 
+	funcCreatePreset          func(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error)
+	funcCreatePresetOrigin    string
+	inspectFuncCreatePreset   func(ctx context.Context, req auth.RolePresetReq)
+	afterCreatePresetCounter  uint64
+	beforeCreatePresetCounter uint64
+	CreatePresetMock          mCoreMockCreatePreset
+
+	funcDeletePreset          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeletePresetOrigin    string
+	inspectFuncDeletePreset   func(ctx context.Context, id uuid.UUID)
+	afterDeletePresetCounter  uint64
+	beforeDeletePresetCounter uint64
+	DeletePresetMock          mCoreMockDeletePreset
+
 	funcDeleteSession          func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)
 	funcDeleteSessionOrigin    string
 	inspectFuncDeleteSession   func(ctx context.Context, id uuid.UUID, userID uuid.UUID)
@@ -62,6 +76,27 @@ type CoreMock struct {
 	beforeDeleteUserRoleCounter uint64
 	DeleteUserRoleMock          mCoreMockDeleteUserRole
 
+	funcGetConsistencyReport          func(ctx context.Context) (c2 auth.ConsistencyReport, err error)
+	funcGetConsistencyReportOrigin    string
+	inspectFuncGetConsistencyReport   func(ctx context.Context)
+	afterGetConsistencyReportCounter  uint64
+	beforeGetConsistencyReportCounter uint64
+	GetConsistencyReportMock          mCoreMockGetConsistencyReport
+
+	funcGetLoginHistory          func(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error)
+	funcGetLoginHistoryOrigin    string
+	inspectFuncGetLoginHistory   func(ctx context.Context, userID uuid.UUID, limit int)
+	afterGetLoginHistoryCounter  uint64
+	beforeGetLoginHistoryCounter uint64
+	GetLoginHistoryMock          mCoreMockGetLoginHistory
+
+	funcGetPreset          func(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error)
+	funcGetPresetOrigin    string
+	inspectFuncGetPreset   func(ctx context.Context, id uuid.UUID)
+	afterGetPresetCounter  uint64
+	beforeGetPresetCounter uint64
+	GetPresetMock          mCoreMockGetPreset
+
 	funcGetSessionByID          func(ctx context.Context, id uuid.UUID) (s1 auth.Session, s2 string, err error)
 	funcGetSessionByIDOrigin    string
 	inspectFuncGetSessionByID   func(ctx context.Context, id uuid.UUID)
@@ -83,13 +118,27 @@ type CoreMock struct {
 	beforeIsAdminCounter uint64
 	IsAdminMock          mCoreMockIsAdmin
 
-	funcIssueTokens          func(ctx context.Context, userID uuid.UUID, sessionVersion int) (t1 auth.Tokens, err error)
+	funcIssueImpersonationToken          func(ctx context.Context, subjectID uuid.UUID) (i1 auth.ImpersonationToken, err error)
+	funcIssueImpersonationTokenOrigin    string
+	inspectFuncIssueImpersonationToken   func(ctx context.Context, subjectID uuid.UUID)
+	afterIssueImpersonationTokenCounter  uint64
+	beforeIssueImpersonationTokenCounter uint64
+	IssueImpersonationTokenMock          mCoreMockIssueImpersonationToken
+
+	funcIssueTokens          func(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) (t1 auth.Tokens, err error)
 	funcIssueTokensOrigin    string
-	inspectFuncIssueTokens   func(ctx context.Context, userID uuid.UUID, sessionVersion int)
+	inspectFuncIssueTokens   func(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client)
 	afterIssueTokensCounter  uint64
 	beforeIssueTokensCounter uint64
 	IssueTokensMock          mCoreMockIssueTokens
 
+	funcListPresets          func(ctx context.Context) (ra1 []auth.RolePreset, err error)
+	funcListPresetsOrigin    string
+	inspectFuncListPresets   func(ctx context.Context)
+	afterListPresetsCounter  uint64
+	beforeListPresetsCounter uint64
+	ListPresetsMock          mCoreMockListPresets
+
 	funcListUserRoles          func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)
 	funcListUserRolesOrigin    string
 	inspectFuncListUserRoles   func(ctx context.Context, userID uuid.UUID)
@@ -97,12 +146,33 @@ type CoreMock struct {
 	beforeListUserRolesCounter uint64
 	ListUserRolesMock          mCoreMockListUserRoles
 
-	funcRefreshTokens          func(ctx context.Context, session auth.Session, refreshToken string, rtHash string) (t1 auth.Tokens, err error)
+	funcRecordLogin          func(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) (l1 auth.LoginEvent, err error)
+	funcRecordLoginOrigin    string
+	inspectFuncRecordLogin   func(ctx context.Context, userID uuid.UUID, client auth.Client, success bool)
+	afterRecordLoginCounter  uint64
+	beforeRecordLoginCounter uint64
+	RecordLoginMock          mCoreMockRecordLogin
+
+	funcRefreshTokens          func(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client) (t1 auth.Tokens, err error)
 	funcRefreshTokensOrigin    string
-	inspectFuncRefreshTokens   func(ctx context.Context, session auth.Session, refreshToken string, rtHash string)
+	inspectFuncRefreshTokens   func(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client)
 	afterRefreshTokensCounter  uint64
 	beforeRefreshTokensCounter uint64
 	RefreshTokensMock          mCoreMockRefreshTokens
+
+	funcRepairGrants          func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)
+	funcRepairGrantsOrigin    string
+	inspectFuncRepairGrants   func(ctx context.Context, dryRun bool)
+	afterRepairGrantsCounter  uint64
+	beforeRepairGrantsCounter uint64
+	RepairGrantsMock          mCoreMockRepairGrants
+
+	funcUpdatePreset          func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error)
+	funcUpdatePresetOrigin    string
+	inspectFuncUpdatePreset   func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq)
+	afterUpdatePresetCounter  uint64
+	beforeUpdatePresetCounter uint64
+	UpdatePresetMock          mCoreMockUpdatePreset
 }
 
 // NewCoreMock returns a mock for mm_usecase.Core
@@ -116,12 +186,18 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 	m.AddUserRoleMock = mCoreMockAddUserRole{mock: m}
 	m.AddUserRoleMock.callArgs = []*CoreMockAddUserRoleParams{}
 
-	m.CheckIsAdminMock = mCoreMockCheckIsAdmin{mock: m}
-	m.CheckIsAdminMock.callArgs = []*CoreMockCheckIsAdminParams{}
+	m.ApplyPresetMock = mCoreMockApplyPreset{mock: m}
+	m.ApplyPresetMock.callArgs = []*CoreMockApplyPresetParams{}
 
 	m.CheckSelfOrAdminMock = mCoreMockCheckSelfOrAdmin{mock: m}
 	m.CheckSelfOrAdminMock.callArgs = []*CoreMockCheckSelfOrAdminParams{}
 
+	m.CreatePresetMock = mCoreMockCreatePreset{mock: m}
+	m.CreatePresetMock.callArgs = []*CoreMockCreatePresetParams{}
+
+	m.DeletePresetMock = mCoreMockDeletePreset{mock: m}
+	m.DeletePresetMock.callArgs = []*CoreMockDeletePresetParams{}
+
 	m.DeleteSessionMock = mCoreMockDeleteSession{mock: m}
 	m.DeleteSessionMock.callArgs = []*CoreMockDeleteSessionParams{}
 
@@ -131,6 +207,15 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 	m.DeleteUserRoleMock = mCoreMockDeleteUserRole{mock: m}
 	m.DeleteUserRoleMock.callArgs = []*CoreMockDeleteUserRoleParams{}
 
+	m.GetConsistencyReportMock = mCoreMockGetConsistencyReport{mock: m}
+	m.GetConsistencyReportMock.callArgs = []*CoreMockGetConsistencyReportParams{}
+
+	m.GetLoginHistoryMock = mCoreMockGetLoginHistory{mock: m}
+	m.GetLoginHistoryMock.callArgs = []*CoreMockGetLoginHistoryParams{}
+
+	m.GetPresetMock = mCoreMockGetPreset{mock: m}
+	m.GetPresetMock.callArgs = []*CoreMockGetPresetParams{}
+
 	m.GetSessionByIDMock = mCoreMockGetSessionByID{mock: m}
 	m.GetSessionByIDMock.callArgs = []*CoreMockGetSessionByIDParams{}
 
@@ -140,15 +225,30 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 	m.IsAdminMock = mCoreMockIsAdmin{mock: m}
 	m.IsAdminMock.callArgs = []*CoreMockIsAdminParams{}
 
+	m.IssueImpersonationTokenMock = mCoreMockIssueImpersonationToken{mock: m}
+	m.IssueImpersonationTokenMock.callArgs = []*CoreMockIssueImpersonationTokenParams{}
+
 	m.IssueTokensMock = mCoreMockIssueTokens{mock: m}
 	m.IssueTokensMock.callArgs = []*CoreMockIssueTokensParams{}
 
+	m.ListPresetsMock = mCoreMockListPresets{mock: m}
+	m.ListPresetsMock.callArgs = []*CoreMockListPresetsParams{}
+
 	m.ListUserRolesMock = mCoreMockListUserRoles{mock: m}
 	m.ListUserRolesMock.callArgs = []*CoreMockListUserRolesParams{}
 
+	m.RecordLoginMock = mCoreMockRecordLogin{mock: m}
+	m.RecordLoginMock.callArgs = []*CoreMockRecordLoginParams{}
+
 	m.RefreshTokensMock = mCoreMockRefreshTokens{mock: m}
 	m.RefreshTokensMock.callArgs = []*CoreMockRefreshTokensParams{}
 
+	m.RepairGrantsMock = mCoreMockRepairGrants{mock: m}
+	m.RepairGrantsMock.callArgs = []*CoreMockRepairGrantsParams{}
+
+	m.UpdatePresetMock = mCoreMockUpdatePreset{mock: m}
+	m.UpdatePresetMock.callArgs = []*CoreMockUpdatePresetParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -496,49 +596,56 @@ func (m *CoreMock) MinimockAddUserRoleInspect() {
 	}
 }
 
-type mCoreMockCheckIsAdmin struct {
+type mCoreMockApplyPreset struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockCheckIsAdminExpectation
-	expectations       []*CoreMockCheckIsAdminExpectation
+	defaultExpectation *CoreMockApplyPresetExpectation
+	expectations       []*CoreMockApplyPresetExpectation
 
-	callArgs []*CoreMockCheckIsAdminParams
+	callArgs []*CoreMockApplyPresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockCheckIsAdminExpectation specifies expectation struct of the Core.CheckIsAdmin
-type CoreMockCheckIsAdminExpectation struct {
+// CoreMockApplyPresetExpectation specifies expectation struct of the Core.ApplyPreset
+type CoreMockApplyPresetExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockCheckIsAdminParams
-	paramPtrs          *CoreMockCheckIsAdminParamPtrs
-	expectationOrigins CoreMockCheckIsAdminExpectationOrigins
-	results            *CoreMockCheckIsAdminResults
+	params             *CoreMockApplyPresetParams
+	paramPtrs          *CoreMockApplyPresetParamPtrs
+	expectationOrigins CoreMockApplyPresetExpectationOrigins
+	results            *CoreMockApplyPresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockCheckIsAdminParams contains parameters of the Core.CheckIsAdmin
-type CoreMockCheckIsAdminParams struct {
+// CoreMockApplyPresetParams contains parameters of the Core.ApplyPreset
+type CoreMockApplyPresetParams struct {
 	ctx context.Context
+	id  uuid.UUID
+	req auth.ApplyPresetReq
 }
 
-// CoreMockCheckIsAdminParamPtrs contains pointers to parameters of the Core.CheckIsAdmin
-type CoreMockCheckIsAdminParamPtrs struct {
+// CoreMockApplyPresetParamPtrs contains pointers to parameters of the Core.ApplyPreset
+type CoreMockApplyPresetParamPtrs struct {
 	ctx *context.Context
+	id  *uuid.UUID
+	req *auth.ApplyPresetReq
 }
 
-// CoreMockCheckIsAdminResults contains results of the Core.CheckIsAdmin
-type CoreMockCheckIsAdminResults struct {
+// CoreMockApplyPresetResults contains results of the Core.ApplyPreset
+type CoreMockApplyPresetResults struct {
+	ua1 []auth.UserRole
 	err error
 }
 
-// CoreMockCheckIsAdminOrigins contains origins of expectations of the Core.CheckIsAdmin
-type CoreMockCheckIsAdminExpectationOrigins struct {
+// CoreMockApplyPresetOrigins contains origins of expectations of the Core.ApplyPreset
+type CoreMockApplyPresetExpectationOrigins struct {
 	origin    string
 	originCtx string
+	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -546,264 +653,320 @@ type CoreMockCheckIsAdminExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Optional() *mCoreMockCheckIsAdmin {
-	mmCheckIsAdmin.optional = true
-	return mmCheckIsAdmin
+func (mmApplyPreset *mCoreMockApplyPreset) Optional() *mCoreMockApplyPreset {
+	mmApplyPreset.optional = true
+	return mmApplyPreset
 }
 
-// Expect sets up expected params for Core.CheckIsAdmin
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Expect(ctx context.Context) *mCoreMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by Set")
+// Expect sets up expected params for Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) Expect(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) *mCoreMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &CoreMockCheckIsAdminExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &CoreMockApplyPresetExpectation{}
 	}
 
-	if mmCheckIsAdmin.defaultExpectation.paramPtrs != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by ExpectParams functions")
+	if mmApplyPreset.defaultExpectation.paramPtrs != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by ExpectParams functions")
 	}
 
-	mmCheckIsAdmin.defaultExpectation.params = &CoreMockCheckIsAdminParams{ctx}
-	mmCheckIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCheckIsAdmin.expectations {
-		if minimock.Equal(e.params, mmCheckIsAdmin.defaultExpectation.params) {
-			mmCheckIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIsAdmin.defaultExpectation.params)
+	mmApplyPreset.defaultExpectation.params = &CoreMockApplyPresetParams{ctx, id, req}
+	mmApplyPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPreset.expectations {
+		if minimock.Equal(e.params, mmApplyPreset.defaultExpectation.params) {
+			mmApplyPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPreset.defaultExpectation.params)
 		}
 	}
 
-	return mmCheckIsAdmin
+	return mmApplyPreset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) ExpectCtxParam1(ctx context.Context) *mCoreMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
+	}
+
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &CoreMockApplyPresetExpectation{}
+	}
+
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Expect")
+	}
+
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &CoreMockApplyPresetParamPtrs{}
+	}
+	mmApplyPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyPreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.CheckIsAdmin
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) ExpectCtxParam1(ctx context.Context) *mCoreMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) ExpectIdParam2(id uuid.UUID) *mCoreMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &CoreMockCheckIsAdminExpectation{}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &CoreMockApplyPresetExpectation{}
 	}
 
-	if mmCheckIsAdmin.defaultExpectation.params != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by Expect")
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Expect")
 	}
 
-	if mmCheckIsAdmin.defaultExpectation.paramPtrs == nil {
-		mmCheckIsAdmin.defaultExpectation.paramPtrs = &CoreMockCheckIsAdminParamPtrs{}
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &CoreMockApplyPresetParamPtrs{}
 	}
-	mmCheckIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCheckIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmApplyPreset.defaultExpectation.paramPtrs.id = &id
+	mmApplyPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCheckIsAdmin
+	return mmApplyPreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.CheckIsAdmin
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Inspect(f func(ctx context.Context)) *mCoreMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("Inspect function is already set for CoreMock.CheckIsAdmin")
+// ExpectReqParam3 sets up expected param req for Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) ExpectReqParam3(req auth.ApplyPresetReq) *mCoreMockApplyPreset {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
 	}
 
-	mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin = f
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &CoreMockApplyPresetExpectation{}
+	}
+
+	if mmApplyPreset.defaultExpectation.params != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Expect")
+	}
+
+	if mmApplyPreset.defaultExpectation.paramPtrs == nil {
+		mmApplyPreset.defaultExpectation.paramPtrs = &CoreMockApplyPresetParamPtrs{}
+	}
+	mmApplyPreset.defaultExpectation.paramPtrs.req = &req
+	mmApplyPreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmApplyPreset
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) Inspect(f func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq)) *mCoreMockApplyPreset {
+	if mmApplyPreset.mock.inspectFuncApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("Inspect function is already set for CoreMock.ApplyPreset")
+	}
+
+	mmApplyPreset.mock.inspectFuncApplyPreset = f
 
-	return mmCheckIsAdmin
+	return mmApplyPreset
 }
 
-// Return sets up results that will be returned by Core.CheckIsAdmin
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Return(err error) *CoreMock {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by Set")
+// Return sets up results that will be returned by Core.ApplyPreset
+func (mmApplyPreset *mCoreMockApplyPreset) Return(ua1 []auth.UserRole, err error) *CoreMock {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
 	}
 
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &CoreMockCheckIsAdminExpectation{mock: mmCheckIsAdmin.mock}
+	if mmApplyPreset.defaultExpectation == nil {
+		mmApplyPreset.defaultExpectation = &CoreMockApplyPresetExpectation{mock: mmApplyPreset.mock}
 	}
-	mmCheckIsAdmin.defaultExpectation.results = &CoreMockCheckIsAdminResults{err}
-	mmCheckIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin.mock
+	mmApplyPreset.defaultExpectation.results = &CoreMockApplyPresetResults{ua1, err}
+	mmApplyPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// Set uses given function f to mock the Core.CheckIsAdmin method
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Set(f func(ctx context.Context) (err error)) *CoreMock {
-	if mmCheckIsAdmin.defaultExpectation != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("Default expectation is already set for the Core.CheckIsAdmin method")
+// Set uses given function f to mock the Core.ApplyPreset method
+func (mmApplyPreset *mCoreMockApplyPreset) Set(f func(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error)) *CoreMock {
+	if mmApplyPreset.defaultExpectation != nil {
+		mmApplyPreset.mock.t.Fatalf("Default expectation is already set for the Core.ApplyPreset method")
 	}
 
-	if len(mmCheckIsAdmin.expectations) > 0 {
-		mmCheckIsAdmin.mock.t.Fatalf("Some expectations are already set for the Core.CheckIsAdmin method")
+	if len(mmApplyPreset.expectations) > 0 {
+		mmApplyPreset.mock.t.Fatalf("Some expectations are already set for the Core.ApplyPreset method")
 	}
 
-	mmCheckIsAdmin.mock.funcCheckIsAdmin = f
-	mmCheckIsAdmin.mock.funcCheckIsAdminOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin.mock
+	mmApplyPreset.mock.funcApplyPreset = f
+	mmApplyPreset.mock.funcApplyPresetOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset.mock
 }
 
-// When sets expectation for the Core.CheckIsAdmin which will trigger the result defined by the following
+// When sets expectation for the Core.ApplyPreset which will trigger the result defined by the following
 // Then helper
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) When(ctx context.Context) *CoreMockCheckIsAdminExpectation {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("CoreMock.CheckIsAdmin mock is already set by Set")
+func (mmApplyPreset *mCoreMockApplyPreset) When(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) *CoreMockApplyPresetExpectation {
+	if mmApplyPreset.mock.funcApplyPreset != nil {
+		mmApplyPreset.mock.t.Fatalf("CoreMock.ApplyPreset mock is already set by Set")
 	}
 
-	expectation := &CoreMockCheckIsAdminExpectation{
-		mock:               mmCheckIsAdmin.mock,
-		params:             &CoreMockCheckIsAdminParams{ctx},
-		expectationOrigins: CoreMockCheckIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockApplyPresetExpectation{
+		mock:               mmApplyPreset.mock,
+		params:             &CoreMockApplyPresetParams{ctx, id, req},
+		expectationOrigins: CoreMockApplyPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCheckIsAdmin.expectations = append(mmCheckIsAdmin.expectations, expectation)
+	mmApplyPreset.expectations = append(mmApplyPreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.CheckIsAdmin return parameters for the expectation previously defined by the When method
-func (e *CoreMockCheckIsAdminExpectation) Then(err error) *CoreMock {
-	e.results = &CoreMockCheckIsAdminResults{err}
+// Then sets up Core.ApplyPreset return parameters for the expectation previously defined by the When method
+func (e *CoreMockApplyPresetExpectation) Then(ua1 []auth.UserRole, err error) *CoreMock {
+	e.results = &CoreMockApplyPresetResults{ua1, err}
 	return e.mock
 }
 
-// Times sets number of times Core.CheckIsAdmin should be invoked
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Times(n uint64) *mCoreMockCheckIsAdmin {
+// Times sets number of times Core.ApplyPreset should be invoked
+func (mmApplyPreset *mCoreMockApplyPreset) Times(n uint64) *mCoreMockApplyPreset {
 	if n == 0 {
-		mmCheckIsAdmin.mock.t.Fatalf("Times of CoreMock.CheckIsAdmin mock can not be zero")
+		mmApplyPreset.mock.t.Fatalf("Times of CoreMock.ApplyPreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCheckIsAdmin.expectedInvocations, n)
-	mmCheckIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin
+	mm_atomic.StoreUint64(&mmApplyPreset.expectedInvocations, n)
+	mmApplyPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPreset
 }
 
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) invocationsDone() bool {
-	if len(mmCheckIsAdmin.expectations) == 0 && mmCheckIsAdmin.defaultExpectation == nil && mmCheckIsAdmin.mock.funcCheckIsAdmin == nil {
+func (mmApplyPreset *mCoreMockApplyPreset) invocationsDone() bool {
+	if len(mmApplyPreset.expectations) == 0 && mmApplyPreset.defaultExpectation == nil && mmApplyPreset.mock.funcApplyPreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.mock.afterCheckIsAdminCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPreset.mock.afterApplyPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CheckIsAdmin implements mm_usecase.Core
-func (mmCheckIsAdmin *CoreMock) CheckIsAdmin(ctx context.Context) (err error) {
-	mm_atomic.AddUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter, 1)
-	defer mm_atomic.AddUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter, 1)
+// ApplyPreset implements mm_usecase.Core
+func (mmApplyPreset *CoreMock) ApplyPreset(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) (ua1 []auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmApplyPreset.beforeApplyPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPreset.afterApplyPresetCounter, 1)
 
-	mmCheckIsAdmin.t.Helper()
+	mmApplyPreset.t.Helper()
 
-	if mmCheckIsAdmin.inspectFuncCheckIsAdmin != nil {
-		mmCheckIsAdmin.inspectFuncCheckIsAdmin(ctx)
+	if mmApplyPreset.inspectFuncApplyPreset != nil {
+		mmApplyPreset.inspectFuncApplyPreset(ctx, id, req)
 	}
 
-	mm_params := CoreMockCheckIsAdminParams{ctx}
+	mm_params := CoreMockApplyPresetParams{ctx, id, req}
 
 	// Record call args
-	mmCheckIsAdmin.CheckIsAdminMock.mutex.Lock()
-	mmCheckIsAdmin.CheckIsAdminMock.callArgs = append(mmCheckIsAdmin.CheckIsAdminMock.callArgs, &mm_params)
-	mmCheckIsAdmin.CheckIsAdminMock.mutex.Unlock()
+	mmApplyPreset.ApplyPresetMock.mutex.Lock()
+	mmApplyPreset.ApplyPresetMock.callArgs = append(mmApplyPreset.ApplyPresetMock.callArgs, &mm_params)
+	mmApplyPreset.ApplyPresetMock.mutex.Unlock()
 
-	for _, e := range mmCheckIsAdmin.CheckIsAdminMock.expectations {
+	for _, e := range mmApplyPreset.ApplyPresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ua1, e.results.err
 		}
 	}
 
-	if mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.Counter, 1)
-		mm_want := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.params
-		mm_want_ptrs := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.paramPtrs
+	if mmApplyPreset.ApplyPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPreset.ApplyPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPreset.ApplyPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPreset.ApplyPresetMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockCheckIsAdminParams{ctx}
+		mm_got := CoreMockApplyPresetParams{ctx, id, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCheckIsAdmin.t.Errorf("CoreMock.CheckIsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmApplyPreset.t.Errorf("CoreMock.ApplyPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmApplyPreset.t.Errorf("CoreMock.ApplyPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmApplyPreset.t.Errorf("CoreMock.ApplyPreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCheckIsAdmin.t.Errorf("CoreMock.CheckIsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmApplyPreset.t.Errorf("CoreMock.ApplyPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPreset.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.results
+		mm_results := mmApplyPreset.ApplyPresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCheckIsAdmin.t.Fatal("No results are set for the CoreMock.CheckIsAdmin")
+			mmApplyPreset.t.Fatal("No results are set for the CoreMock.ApplyPreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).ua1, (*mm_results).err
 	}
-	if mmCheckIsAdmin.funcCheckIsAdmin != nil {
-		return mmCheckIsAdmin.funcCheckIsAdmin(ctx)
+	if mmApplyPreset.funcApplyPreset != nil {
+		return mmApplyPreset.funcApplyPreset(ctx, id, req)
 	}
-	mmCheckIsAdmin.t.Fatalf("Unexpected call to CoreMock.CheckIsAdmin. %v", ctx)
+	mmApplyPreset.t.Fatalf("Unexpected call to CoreMock.ApplyPreset. %v %v %v", ctx, id, req)
 	return
 }
 
-// CheckIsAdminAfterCounter returns a count of finished CoreMock.CheckIsAdmin invocations
-func (mmCheckIsAdmin *CoreMock) CheckIsAdminAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter)
+// ApplyPresetAfterCounter returns a count of finished CoreMock.ApplyPreset invocations
+func (mmApplyPreset *CoreMock) ApplyPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.afterApplyPresetCounter)
 }
 
-// CheckIsAdminBeforeCounter returns a count of CoreMock.CheckIsAdmin invocations
-func (mmCheckIsAdmin *CoreMock) CheckIsAdminBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter)
+// ApplyPresetBeforeCounter returns a count of CoreMock.ApplyPreset invocations
+func (mmApplyPreset *CoreMock) ApplyPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPreset.beforeApplyPresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.CheckIsAdmin.
+// Calls returns a list of arguments used in each call to CoreMock.ApplyPreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCheckIsAdmin *mCoreMockCheckIsAdmin) Calls() []*CoreMockCheckIsAdminParams {
-	mmCheckIsAdmin.mutex.RLock()
+func (mmApplyPreset *mCoreMockApplyPreset) Calls() []*CoreMockApplyPresetParams {
+	mmApplyPreset.mutex.RLock()
 
-	argCopy := make([]*CoreMockCheckIsAdminParams, len(mmCheckIsAdmin.callArgs))
-	copy(argCopy, mmCheckIsAdmin.callArgs)
+	argCopy := make([]*CoreMockApplyPresetParams, len(mmApplyPreset.callArgs))
+	copy(argCopy, mmApplyPreset.callArgs)
 
-	mmCheckIsAdmin.mutex.RUnlock()
+	mmApplyPreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCheckIsAdminDone returns true if the count of the CheckIsAdmin invocations corresponds
+// MinimockApplyPresetDone returns true if the count of the ApplyPreset invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockCheckIsAdminDone() bool {
-	if m.CheckIsAdminMock.optional {
+func (m *CoreMock) MinimockApplyPresetDone() bool {
+	if m.ApplyPresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CheckIsAdminMock.expectations {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CheckIsAdminMock.invocationsDone()
+	return m.ApplyPresetMock.invocationsDone()
 }
 
-// MinimockCheckIsAdminInspect logs each unmet expectation
-func (m *CoreMock) MinimockCheckIsAdminInspect() {
-	for _, e := range m.CheckIsAdminMock.expectations {
+// MinimockApplyPresetInspect logs each unmet expectation
+func (m *CoreMock) MinimockApplyPresetInspect() {
+	for _, e := range m.ApplyPresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.CheckIsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.ApplyPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCheckIsAdminCounter := mm_atomic.LoadUint64(&m.afterCheckIsAdminCounter)
+	afterApplyPresetCounter := mm_atomic.LoadUint64(&m.afterApplyPresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CheckIsAdminMock.defaultExpectation != nil && afterCheckIsAdminCounter < 1 {
-		if m.CheckIsAdminMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.CheckIsAdmin at\n%s", m.CheckIsAdminMock.defaultExpectation.returnOrigin)
+	if m.ApplyPresetMock.defaultExpectation != nil && afterApplyPresetCounter < 1 {
+		if m.ApplyPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.ApplyPreset at\n%s", m.ApplyPresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.CheckIsAdmin at\n%s with params: %#v", m.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *m.CheckIsAdminMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.ApplyPreset at\n%s with params: %#v", m.ApplyPresetMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCheckIsAdmin != nil && afterCheckIsAdminCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.CheckIsAdmin at\n%s", m.funcCheckIsAdminOrigin)
+	if m.funcApplyPreset != nil && afterApplyPresetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.ApplyPreset at\n%s", m.funcApplyPresetOrigin)
 	}
 
-	if !m.CheckIsAdminMock.invocationsDone() && afterCheckIsAdminCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.CheckIsAdmin at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CheckIsAdminMock.expectedInvocations), m.CheckIsAdminMock.expectedInvocationsOrigin, afterCheckIsAdminCounter)
+	if !m.ApplyPresetMock.invocationsDone() && afterApplyPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.ApplyPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPresetMock.expectedInvocations), m.ApplyPresetMock.expectedInvocationsOrigin, afterApplyPresetCounter)
 	}
 }
 
@@ -1149,55 +1312,53 @@ func (m *CoreMock) MinimockCheckSelfOrAdminInspect() {
 	}
 }
 
-type mCoreMockDeleteSession struct {
+type mCoreMockCreatePreset struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockDeleteSessionExpectation
-	expectations       []*CoreMockDeleteSessionExpectation
+	defaultExpectation *CoreMockCreatePresetExpectation
+	expectations       []*CoreMockCreatePresetExpectation
 
-	callArgs []*CoreMockDeleteSessionParams
+	callArgs []*CoreMockCreatePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockDeleteSessionExpectation specifies expectation struct of the Core.DeleteSession
-type CoreMockDeleteSessionExpectation struct {
+// CoreMockCreatePresetExpectation specifies expectation struct of the Core.CreatePreset
+type CoreMockCreatePresetExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockDeleteSessionParams
-	paramPtrs          *CoreMockDeleteSessionParamPtrs
-	expectationOrigins CoreMockDeleteSessionExpectationOrigins
-	results            *CoreMockDeleteSessionResults
+	params             *CoreMockCreatePresetParams
+	paramPtrs          *CoreMockCreatePresetParamPtrs
+	expectationOrigins CoreMockCreatePresetExpectationOrigins
+	results            *CoreMockCreatePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockDeleteSessionParams contains parameters of the Core.DeleteSession
-type CoreMockDeleteSessionParams struct {
-	ctx    context.Context
-	id     uuid.UUID
-	userID uuid.UUID
+// CoreMockCreatePresetParams contains parameters of the Core.CreatePreset
+type CoreMockCreatePresetParams struct {
+	ctx context.Context
+	req auth.RolePresetReq
 }
 
-// CoreMockDeleteSessionParamPtrs contains pointers to parameters of the Core.DeleteSession
-type CoreMockDeleteSessionParamPtrs struct {
-	ctx    *context.Context
-	id     *uuid.UUID
-	userID *uuid.UUID
+// CoreMockCreatePresetParamPtrs contains pointers to parameters of the Core.CreatePreset
+type CoreMockCreatePresetParamPtrs struct {
+	ctx *context.Context
+	req *auth.RolePresetReq
 }
 
-// CoreMockDeleteSessionResults contains results of the Core.DeleteSession
-type CoreMockDeleteSessionResults struct {
+// CoreMockCreatePresetResults contains results of the Core.CreatePreset
+type CoreMockCreatePresetResults struct {
+	r1  auth.RolePreset
 	err error
 }
 
-// CoreMockDeleteSessionOrigins contains origins of expectations of the Core.DeleteSession
-type CoreMockDeleteSessionExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originId     string
-	originUserID string
+// CoreMockCreatePresetOrigins contains origins of expectations of the Core.CreatePreset
+type CoreMockCreatePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1205,369 +1366,341 @@ type CoreMockDeleteSessionExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSession *mCoreMockDeleteSession) Optional() *mCoreMockDeleteSession {
-	mmDeleteSession.optional = true
-	return mmDeleteSession
+func (mmCreatePreset *mCoreMockCreatePreset) Optional() *mCoreMockCreatePreset {
+	mmCreatePreset.optional = true
+	return mmCreatePreset
 }
 
-// Expect sets up expected params for Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mCoreMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
+// Expect sets up expected params for Core.CreatePreset
+func (mmCreatePreset *mCoreMockCreatePreset) Expect(ctx context.Context, req auth.RolePresetReq) *mCoreMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &CoreMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by ExpectParams functions")
+	if mmCreatePreset.defaultExpectation.paramPtrs != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSession.defaultExpectation.params = &CoreMockDeleteSessionParams{ctx, id, userID}
-	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSession.expectations {
-		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
-			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
+	mmCreatePreset.defaultExpectation.params = &CoreMockCreatePresetParams{ctx, req}
+	mmCreatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePreset.expectations {
+		if minimock.Equal(e.params, mmCreatePreset.defaultExpectation.params) {
+			mmCreatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSession
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
-	}
-
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
-	}
-
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
-	}
-
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
-	}
-	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmDeleteSession
+	return mmCreatePreset
 }
 
-// ExpectIdParam2 sets up expected param id for Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) ExpectIdParam2(id uuid.UUID) *mCoreMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.CreatePreset
+func (mmCreatePreset *mCoreMockCreatePreset) ExpectCtxParam1(ctx context.Context) *mCoreMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &CoreMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &CoreMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSession.defaultExpectation.paramPtrs.id = &id
-	mmDeleteSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSession
+	return mmCreatePreset
 }
 
-// ExpectUserIDParam3 sets up expected param userID for Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) ExpectUserIDParam3(userID uuid.UUID) *mCoreMockDeleteSession {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Core.CreatePreset
+func (mmCreatePreset *mCoreMockCreatePreset) ExpectReqParam2(req auth.RolePresetReq) *mCoreMockCreatePreset {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &CoreMockCreatePresetExpectation{}
 	}
 
-	if mmDeleteSession.defaultExpectation.params != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
+	if mmCreatePreset.defaultExpectation.params != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
-		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
+	if mmCreatePreset.defaultExpectation.paramPtrs == nil {
+		mmCreatePreset.defaultExpectation.paramPtrs = &CoreMockCreatePresetParamPtrs{}
 	}
-	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmCreatePreset.defaultExpectation.paramPtrs.req = &req
+	mmCreatePreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmDeleteSession
+	return mmCreatePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mCoreMockDeleteSession {
-	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteSession")
+// Inspect accepts an inspector function that has same arguments as the Core.CreatePreset
+func (mmCreatePreset *mCoreMockCreatePreset) Inspect(f func(ctx context.Context, req auth.RolePresetReq)) *mCoreMockCreatePreset {
+	if mmCreatePreset.mock.inspectFuncCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("Inspect function is already set for CoreMock.CreatePreset")
 	}
 
-	mmDeleteSession.mock.inspectFuncDeleteSession = f
+	mmCreatePreset.mock.inspectFuncCreatePreset = f
 
-	return mmDeleteSession
+	return mmCreatePreset
 }
 
-// Return sets up results that will be returned by Core.DeleteSession
-func (mmDeleteSession *mCoreMockDeleteSession) Return(err error) *CoreMock {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
+// Return sets up results that will be returned by Core.CreatePreset
+func (mmCreatePreset *mCoreMockCreatePreset) Return(r1 auth.RolePreset, err error) *CoreMock {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Set")
 	}
 
-	if mmDeleteSession.defaultExpectation == nil {
-		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	if mmCreatePreset.defaultExpectation == nil {
+		mmCreatePreset.defaultExpectation = &CoreMockCreatePresetExpectation{mock: mmCreatePreset.mock}
 	}
-	mmDeleteSession.defaultExpectation.results = &CoreMockDeleteSessionResults{err}
-	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession.mock
+	mmCreatePreset.defaultExpectation.results = &CoreMockCreatePresetResults{r1, err}
+	mmCreatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
 }
 
-// Set uses given function f to mock the Core.DeleteSession method
-func (mmDeleteSession *mCoreMockDeleteSession) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *CoreMock {
-	if mmDeleteSession.defaultExpectation != nil {
-		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the Core.DeleteSession method")
+// Set uses given function f to mock the Core.CreatePreset method
+func (mmCreatePreset *mCoreMockCreatePreset) Set(f func(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error)) *CoreMock {
+	if mmCreatePreset.defaultExpectation != nil {
+		mmCreatePreset.mock.t.Fatalf("Default expectation is already set for the Core.CreatePreset method")
 	}
 
-	if len(mmDeleteSession.expectations) > 0 {
-		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the Core.DeleteSession method")
+	if len(mmCreatePreset.expectations) > 0 {
+		mmCreatePreset.mock.t.Fatalf("Some expectations are already set for the Core.CreatePreset method")
 	}
 
-	mmDeleteSession.mock.funcDeleteSession = f
-	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession.mock
+	mmCreatePreset.mock.funcCreatePreset = f
+	mmCreatePreset.mock.funcCreatePresetOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset.mock
 }
 
-// When sets expectation for the Core.DeleteSession which will trigger the result defined by the following
+// When sets expectation for the Core.CreatePreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSession *mCoreMockDeleteSession) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *CoreMockDeleteSessionExpectation {
-	if mmDeleteSession.mock.funcDeleteSession != nil {
-		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
+func (mmCreatePreset *mCoreMockCreatePreset) When(ctx context.Context, req auth.RolePresetReq) *CoreMockCreatePresetExpectation {
+	if mmCreatePreset.mock.funcCreatePreset != nil {
+		mmCreatePreset.mock.t.Fatalf("CoreMock.CreatePreset mock is already set by Set")
 	}
 
-	expectation := &CoreMockDeleteSessionExpectation{
-		mock:               mmDeleteSession.mock,
-		params:             &CoreMockDeleteSessionParams{ctx, id, userID},
-		expectationOrigins: CoreMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockCreatePresetExpectation{
+		mock:               mmCreatePreset.mock,
+		params:             &CoreMockCreatePresetParams{ctx, req},
+		expectationOrigins: CoreMockCreatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
+	mmCreatePreset.expectations = append(mmCreatePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.DeleteSession return parameters for the expectation previously defined by the When method
-func (e *CoreMockDeleteSessionExpectation) Then(err error) *CoreMock {
-	e.results = &CoreMockDeleteSessionResults{err}
+// Then sets up Core.CreatePreset return parameters for the expectation previously defined by the When method
+func (e *CoreMockCreatePresetExpectation) Then(r1 auth.RolePreset, err error) *CoreMock {
+	e.results = &CoreMockCreatePresetResults{r1, err}
 	return e.mock
 }
 
-// Times sets number of times Core.DeleteSession should be invoked
-func (mmDeleteSession *mCoreMockDeleteSession) Times(n uint64) *mCoreMockDeleteSession {
+// Times sets number of times Core.CreatePreset should be invoked
+func (mmCreatePreset *mCoreMockCreatePreset) Times(n uint64) *mCoreMockCreatePreset {
 	if n == 0 {
-		mmDeleteSession.mock.t.Fatalf("Times of CoreMock.DeleteSession mock can not be zero")
+		mmCreatePreset.mock.t.Fatalf("Times of CoreMock.CreatePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
-	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSession
+	mm_atomic.StoreUint64(&mmCreatePreset.expectedInvocations, n)
+	mmCreatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePreset
 }
 
-func (mmDeleteSession *mCoreMockDeleteSession) invocationsDone() bool {
-	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
+func (mmCreatePreset *mCoreMockCreatePreset) invocationsDone() bool {
+	if len(mmCreatePreset.expectations) == 0 && mmCreatePreset.defaultExpectation == nil && mmCreatePreset.mock.funcCreatePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePreset.mock.afterCreatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSession implements mm_usecase.Core
-func (mmDeleteSession *CoreMock) DeleteSession(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
+// CreatePreset implements mm_usecase.Core
+func (mmCreatePreset *CoreMock) CreatePreset(ctx context.Context, req auth.RolePresetReq) (r1 auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmCreatePreset.beforeCreatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePreset.afterCreatePresetCounter, 1)
 
-	mmDeleteSession.t.Helper()
+	mmCreatePreset.t.Helper()
 
-	if mmDeleteSession.inspectFuncDeleteSession != nil {
-		mmDeleteSession.inspectFuncDeleteSession(ctx, id, userID)
+	if mmCreatePreset.inspectFuncCreatePreset != nil {
+		mmCreatePreset.inspectFuncCreatePreset(ctx, req)
 	}
 
-	mm_params := CoreMockDeleteSessionParams{ctx, id, userID}
+	mm_params := CoreMockCreatePresetParams{ctx, req}
 
 	// Record call args
-	mmDeleteSession.DeleteSessionMock.mutex.Lock()
-	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
-	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
+	mmCreatePreset.CreatePresetMock.mutex.Lock()
+	mmCreatePreset.CreatePresetMock.callArgs = append(mmCreatePreset.CreatePresetMock.callArgs, &mm_params)
+	mmCreatePreset.CreatePresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
+	for _, e := range mmCreatePreset.CreatePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.r1, e.results.err
 		}
 	}
 
-	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
+	if mmCreatePreset.CreatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePreset.CreatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePreset.CreatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePreset.CreatePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockDeleteSessionParams{ctx, id, userID}
+		mm_got := CoreMockCreatePresetParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmCreatePreset.t.Errorf("CoreMock.CreatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreatePreset.t.Errorf("CoreMock.CreatePreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreatePreset.t.Errorf("CoreMock.CreatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePreset.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
+		mm_results := mmCreatePreset.CreatePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSession.t.Fatal("No results are set for the CoreMock.DeleteSession")
+			mmCreatePreset.t.Fatal("No results are set for the CoreMock.CreatePreset")
 		}
-		return (*mm_results).err
+		return (*mm_results).r1, (*mm_results).err
 	}
-	if mmDeleteSession.funcDeleteSession != nil {
-		return mmDeleteSession.funcDeleteSession(ctx, id, userID)
+	if mmCreatePreset.funcCreatePreset != nil {
+		return mmCreatePreset.funcCreatePreset(ctx, req)
 	}
-	mmDeleteSession.t.Fatalf("Unexpected call to CoreMock.DeleteSession. %v %v %v", ctx, id, userID)
+	mmCreatePreset.t.Fatalf("Unexpected call to CoreMock.CreatePreset. %v %v", ctx, req)
 	return
 }
 
-// DeleteSessionAfterCounter returns a count of finished CoreMock.DeleteSession invocations
-func (mmDeleteSession *CoreMock) DeleteSessionAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
+// CreatePresetAfterCounter returns a count of finished CoreMock.CreatePreset invocations
+func (mmCreatePreset *CoreMock) CreatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.afterCreatePresetCounter)
 }
 
-// DeleteSessionBeforeCounter returns a count of CoreMock.DeleteSession invocations
-func (mmDeleteSession *CoreMock) DeleteSessionBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
+// CreatePresetBeforeCounter returns a count of CoreMock.CreatePreset invocations
+func (mmCreatePreset *CoreMock) CreatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePreset.beforeCreatePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.DeleteSession.
+// Calls returns a list of arguments used in each call to CoreMock.CreatePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSession *mCoreMockDeleteSession) Calls() []*CoreMockDeleteSessionParams {
-	mmDeleteSession.mutex.RLock()
+func (mmCreatePreset *mCoreMockCreatePreset) Calls() []*CoreMockCreatePresetParams {
+	mmCreatePreset.mutex.RLock()
 
-	argCopy := make([]*CoreMockDeleteSessionParams, len(mmDeleteSession.callArgs))
-	copy(argCopy, mmDeleteSession.callArgs)
+	argCopy := make([]*CoreMockCreatePresetParams, len(mmCreatePreset.callArgs))
+	copy(argCopy, mmCreatePreset.callArgs)
 
-	mmDeleteSession.mutex.RUnlock()
+	mmCreatePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
+// MinimockCreatePresetDone returns true if the count of the CreatePreset invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockDeleteSessionDone() bool {
-	if m.DeleteSessionMock.optional {
+func (m *CoreMock) MinimockCreatePresetDone() bool {
+	if m.CreatePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionMock.expectations {
+	for _, e := range m.CreatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionMock.invocationsDone()
+	return m.CreatePresetMock.invocationsDone()
 }
 
-// MinimockDeleteSessionInspect logs each unmet expectation
-func (m *CoreMock) MinimockDeleteSessionInspect() {
-	for _, e := range m.DeleteSessionMock.expectations {
+// MinimockCreatePresetInspect logs each unmet expectation
+func (m *CoreMock) MinimockCreatePresetInspect() {
+	for _, e := range m.CreatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.CreatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
+	afterCreatePresetCounter := mm_atomic.LoadUint64(&m.afterCreatePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
-		if m.DeleteSessionMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
+	if m.CreatePresetMock.defaultExpectation != nil && afterCreatePresetCounter < 1 {
+		if m.CreatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.CreatePreset at\n%s", m.CreatePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.CreatePreset at\n%s with params: %#v", m.CreatePresetMock.defaultExpectation.expectationOrigins.origin, *m.CreatePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
+	if m.funcCreatePreset != nil && afterCreatePresetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.CreatePreset at\n%s", m.funcCreatePresetOrigin)
 	}
 
-	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.DeleteSession at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
+	if !m.CreatePresetMock.invocationsDone() && afterCreatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.CreatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePresetMock.expectedInvocations), m.CreatePresetMock.expectedInvocationsOrigin, afterCreatePresetCounter)
 	}
 }
 
-type mCoreMockDeleteSessionsByUserID struct {
+type mCoreMockDeletePreset struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockDeleteSessionsByUserIDExpectation
-	expectations       []*CoreMockDeleteSessionsByUserIDExpectation
+	defaultExpectation *CoreMockDeletePresetExpectation
+	expectations       []*CoreMockDeletePresetExpectation
 
-	callArgs []*CoreMockDeleteSessionsByUserIDParams
+	callArgs []*CoreMockDeletePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockDeleteSessionsByUserIDExpectation specifies expectation struct of the Core.DeleteSessionsByUserID
-type CoreMockDeleteSessionsByUserIDExpectation struct {
+// CoreMockDeletePresetExpectation specifies expectation struct of the Core.DeletePreset
+type CoreMockDeletePresetExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockDeleteSessionsByUserIDParams
-	paramPtrs          *CoreMockDeleteSessionsByUserIDParamPtrs
-	expectationOrigins CoreMockDeleteSessionsByUserIDExpectationOrigins
-	results            *CoreMockDeleteSessionsByUserIDResults
+	params             *CoreMockDeletePresetParams
+	paramPtrs          *CoreMockDeletePresetParamPtrs
+	expectationOrigins CoreMockDeletePresetExpectationOrigins
+	results            *CoreMockDeletePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockDeleteSessionsByUserIDParams contains parameters of the Core.DeleteSessionsByUserID
-type CoreMockDeleteSessionsByUserIDParams struct {
-	ctx    context.Context
-	userID uuid.UUID
+// CoreMockDeletePresetParams contains parameters of the Core.DeletePreset
+type CoreMockDeletePresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
 }
 
-// CoreMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the Core.DeleteSessionsByUserID
-type CoreMockDeleteSessionsByUserIDParamPtrs struct {
-	ctx    *context.Context
-	userID *uuid.UUID
+// CoreMockDeletePresetParamPtrs contains pointers to parameters of the Core.DeletePreset
+type CoreMockDeletePresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
 }
 
-// CoreMockDeleteSessionsByUserIDResults contains results of the Core.DeleteSessionsByUserID
-type CoreMockDeleteSessionsByUserIDResults struct {
+// CoreMockDeletePresetResults contains results of the Core.DeletePreset
+type CoreMockDeletePresetResults struct {
 	err error
 }
 
-// CoreMockDeleteSessionsByUserIDOrigins contains origins of expectations of the Core.DeleteSessionsByUserID
-type CoreMockDeleteSessionsByUserIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// CoreMockDeletePresetOrigins contains origins of expectations of the Core.DeletePreset
+type CoreMockDeletePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1575,341 +1708,344 @@ type CoreMockDeleteSessionsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Optional() *mCoreMockDeleteSessionsByUserID {
-	mmDeleteSessionsByUserID.optional = true
-	return mmDeleteSessionsByUserID
+func (mmDeletePreset *mCoreMockDeletePreset) Optional() *mCoreMockDeletePreset {
+	mmDeletePreset.optional = true
+	return mmDeletePreset
 }
 
-// Expect sets up expected params for Core.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
+// Expect sets up expected params for Core.DeletePreset
+func (mmDeletePreset *mCoreMockDeletePreset) Expect(ctx context.Context, id uuid.UUID) *mCoreMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &CoreMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
+	if mmDeletePreset.defaultExpectation.paramPtrs != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteSessionsByUserID.defaultExpectation.params = &CoreMockDeleteSessionsByUserIDParams{ctx, userID}
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteSessionsByUserID.expectations {
-		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
-			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
+	mmDeletePreset.defaultExpectation.params = &CoreMockDeletePresetParams{ctx, id}
+	mmDeletePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePreset.expectations {
+		if minimock.Equal(e.params, mmDeletePreset.defaultExpectation.params) {
+			mmDeletePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteSessionsByUserID
+	return mmDeletePreset
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.DeletePreset
+func (mmDeletePreset *mCoreMockDeletePreset) ExpectCtxParam1(ctx context.Context) *mCoreMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &CoreMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockDeleteSessionsByUserIDParamPtrs{}
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &CoreMockDeletePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeletePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmDeletePreset
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Core.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Core.DeletePreset
+func (mmDeletePreset *mCoreMockDeletePreset) ExpectIdParam2(id uuid.UUID) *mCoreMockDeletePreset {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &CoreMockDeletePresetExpectation{}
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Expect")
+	if mmDeletePreset.defaultExpectation.params != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Expect")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockDeleteSessionsByUserIDParamPtrs{}
+	if mmDeletePreset.defaultExpectation.paramPtrs == nil {
+		mmDeletePreset.defaultExpectation.paramPtrs = &CoreMockDeletePresetParamPtrs{}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmDeletePreset.defaultExpectation.paramPtrs.id = &id
+	mmDeletePreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteSessionsByUserID
+	return mmDeletePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockDeleteSessionsByUserID {
-	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteSessionsByUserID")
+// Inspect accepts an inspector function that has same arguments as the Core.DeletePreset
+func (mmDeletePreset *mCoreMockDeletePreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockDeletePreset {
+	if mmDeletePreset.mock.inspectFuncDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("Inspect function is already set for CoreMock.DeletePreset")
 	}
 
-	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
+	mmDeletePreset.mock.inspectFuncDeletePreset = f
 
-	return mmDeleteSessionsByUserID
+	return mmDeletePreset
 }
 
-// Return sets up results that will be returned by Core.DeleteSessionsByUserID
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Return(err error) *CoreMock {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
+// Return sets up results that will be returned by Core.DeletePreset
+func (mmDeletePreset *mCoreMockDeletePreset) Return(err error) *CoreMock {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Set")
 	}
 
-	if mmDeleteSessionsByUserID.defaultExpectation == nil {
-		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
+	if mmDeletePreset.defaultExpectation == nil {
+		mmDeletePreset.defaultExpectation = &CoreMockDeletePresetExpectation{mock: mmDeletePreset.mock}
 	}
-	mmDeleteSessionsByUserID.defaultExpectation.results = &CoreMockDeleteSessionsByUserIDResults{err}
-	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmDeletePreset.defaultExpectation.results = &CoreMockDeletePresetResults{err}
+	mmDeletePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
 }
 
-// Set uses given function f to mock the Core.DeleteSessionsByUserID method
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *CoreMock {
-	if mmDeleteSessionsByUserID.defaultExpectation != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Core.DeleteSessionsByUserID method")
+// Set uses given function f to mock the Core.DeletePreset method
+func (mmDeletePreset *mCoreMockDeletePreset) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *CoreMock {
+	if mmDeletePreset.defaultExpectation != nil {
+		mmDeletePreset.mock.t.Fatalf("Default expectation is already set for the Core.DeletePreset method")
 	}
 
-	if len(mmDeleteSessionsByUserID.expectations) > 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Core.DeleteSessionsByUserID method")
+	if len(mmDeletePreset.expectations) > 0 {
+		mmDeletePreset.mock.t.Fatalf("Some expectations are already set for the Core.DeletePreset method")
 	}
 
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
-	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID.mock
+	mmDeletePreset.mock.funcDeletePreset = f
+	mmDeletePreset.mock.funcDeletePresetOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset.mock
 }
 
-// When sets expectation for the Core.DeleteSessionsByUserID which will trigger the result defined by the following
+// When sets expectation for the Core.DeletePreset which will trigger the result defined by the following
 // Then helper
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *CoreMockDeleteSessionsByUserIDExpectation {
-	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
+func (mmDeletePreset *mCoreMockDeletePreset) When(ctx context.Context, id uuid.UUID) *CoreMockDeletePresetExpectation {
+	if mmDeletePreset.mock.funcDeletePreset != nil {
+		mmDeletePreset.mock.t.Fatalf("CoreMock.DeletePreset mock is already set by Set")
 	}
 
-	expectation := &CoreMockDeleteSessionsByUserIDExpectation{
-		mock:               mmDeleteSessionsByUserID.mock,
-		params:             &CoreMockDeleteSessionsByUserIDParams{ctx, userID},
-		expectationOrigins: CoreMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockDeletePresetExpectation{
+		mock:               mmDeletePreset.mock,
+		params:             &CoreMockDeletePresetParams{ctx, id},
+		expectationOrigins: CoreMockDeletePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	mmDeletePreset.expectations = append(mmDeletePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
-func (e *CoreMockDeleteSessionsByUserIDExpectation) Then(err error) *CoreMock {
-	e.results = &CoreMockDeleteSessionsByUserIDResults{err}
+// Then sets up Core.DeletePreset return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeletePresetExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockDeletePresetResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.DeleteSessionsByUserID should be invoked
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Times(n uint64) *mCoreMockDeleteSessionsByUserID {
+// Times sets number of times Core.DeletePreset should be invoked
+func (mmDeletePreset *mCoreMockDeletePreset) Times(n uint64) *mCoreMockDeletePreset {
 	if n == 0 {
-		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of CoreMock.DeleteSessionsByUserID mock can not be zero")
+		mmDeletePreset.mock.t.Fatalf("Times of CoreMock.DeletePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
-	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteSessionsByUserID
+	mm_atomic.StoreUint64(&mmDeletePreset.expectedInvocations, n)
+	mmDeletePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePreset
 }
 
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) invocationsDone() bool {
-	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
+func (mmDeletePreset *mCoreMockDeletePreset) invocationsDone() bool {
+	if len(mmDeletePreset.expectations) == 0 && mmDeletePreset.defaultExpectation == nil && mmDeletePreset.mock.funcDeletePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePreset.mock.afterDeletePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteSessionsByUserID implements mm_usecase.Core
-func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
+// DeletePreset implements mm_usecase.Core
+func (mmDeletePreset *CoreMock) DeletePreset(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeletePreset.beforeDeletePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePreset.afterDeletePresetCounter, 1)
 
-	mmDeleteSessionsByUserID.t.Helper()
+	mmDeletePreset.t.Helper()
 
-	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
-		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
+	if mmDeletePreset.inspectFuncDeletePreset != nil {
+		mmDeletePreset.inspectFuncDeletePreset(ctx, id)
 	}
 
-	mm_params := CoreMockDeleteSessionsByUserIDParams{ctx, userID}
+	mm_params := CoreMockDeletePresetParams{ctx, id}
 
 	// Record call args
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
-	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
+	mmDeletePreset.DeletePresetMock.mutex.Lock()
+	mmDeletePreset.DeletePresetMock.callArgs = append(mmDeletePreset.DeletePresetMock.callArgs, &mm_params)
+	mmDeletePreset.DeletePresetMock.mutex.Unlock()
 
-	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range mmDeletePreset.DeletePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
+	if mmDeletePreset.DeletePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePreset.DeletePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePreset.DeletePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePreset.DeletePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockDeleteSessionsByUserIDParams{ctx, userID}
+		mm_got := CoreMockDeletePresetParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeletePreset.t.Errorf("CoreMock.DeletePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeletePreset.t.Errorf("CoreMock.DeletePreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeletePreset.t.Errorf("CoreMock.DeletePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePreset.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
+		mm_results := mmDeletePreset.DeletePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteSessionsByUserID.t.Fatal("No results are set for the CoreMock.DeleteSessionsByUserID")
+			mmDeletePreset.t.Fatal("No results are set for the CoreMock.DeletePreset")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
-		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
+	if mmDeletePreset.funcDeletePreset != nil {
+		return mmDeletePreset.funcDeletePreset(ctx, id)
 	}
-	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to CoreMock.DeleteSessionsByUserID. %v %v", ctx, userID)
+	mmDeletePreset.t.Fatalf("Unexpected call to CoreMock.DeletePreset. %v %v", ctx, id)
 	return
 }
 
-// DeleteSessionsByUserIDAfterCounter returns a count of finished CoreMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
+// DeletePresetAfterCounter returns a count of finished CoreMock.DeletePreset invocations
+func (mmDeletePreset *CoreMock) DeletePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.afterDeletePresetCounter)
 }
 
-// DeleteSessionsByUserIDBeforeCounter returns a count of CoreMock.DeleteSessionsByUserID invocations
-func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
+// DeletePresetBeforeCounter returns a count of CoreMock.DeletePreset invocations
+func (mmDeletePreset *CoreMock) DeletePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePreset.beforeDeletePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.DeleteSessionsByUserID.
+// Calls returns a list of arguments used in each call to CoreMock.DeletePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Calls() []*CoreMockDeleteSessionsByUserIDParams {
-	mmDeleteSessionsByUserID.mutex.RLock()
+func (mmDeletePreset *mCoreMockDeletePreset) Calls() []*CoreMockDeletePresetParams {
+	mmDeletePreset.mutex.RLock()
 
-	argCopy := make([]*CoreMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
-	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
+	argCopy := make([]*CoreMockDeletePresetParams, len(mmDeletePreset.callArgs))
+	copy(argCopy, mmDeletePreset.callArgs)
 
-	mmDeleteSessionsByUserID.mutex.RUnlock()
+	mmDeletePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
+// MinimockDeletePresetDone returns true if the count of the DeletePreset invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockDeleteSessionsByUserIDDone() bool {
-	if m.DeleteSessionsByUserIDMock.optional {
+func (m *CoreMock) MinimockDeletePresetDone() bool {
+	if m.DeletePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+	for _, e := range m.DeletePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteSessionsByUserIDMock.invocationsDone()
+	return m.DeletePresetMock.invocationsDone()
 }
 
-// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
-func (m *CoreMock) MinimockDeleteSessionsByUserIDInspect() {
-	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
+// MinimockDeletePresetInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeletePresetInspect() {
+	for _, e := range m.DeletePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.DeletePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
+	afterDeletePresetCounter := mm_atomic.LoadUint64(&m.afterDeletePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.DeletePresetMock.defaultExpectation != nil && afterDeletePresetCounter < 1 {
+		if m.DeletePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeletePreset at\n%s", m.DeletePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.DeletePreset at\n%s with params: %#v", m.DeletePresetMock.defaultExpectation.expectationOrigins.origin, *m.DeletePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
+	if m.funcDeletePreset != nil && afterDeletePresetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeletePreset at\n%s", m.funcDeletePresetOrigin)
 	}
 
-	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.DeleteSessionsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
+	if !m.DeletePresetMock.invocationsDone() && afterDeletePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeletePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePresetMock.expectedInvocations), m.DeletePresetMock.expectedInvocationsOrigin, afterDeletePresetCounter)
 	}
 }
 
-type mCoreMockDeleteUserRole struct {
+type mCoreMockDeleteSession struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockDeleteUserRoleExpectation
-	expectations       []*CoreMockDeleteUserRoleExpectation
+	defaultExpectation *CoreMockDeleteSessionExpectation
+	expectations       []*CoreMockDeleteSessionExpectation
 
-	callArgs []*CoreMockDeleteUserRoleParams
+	callArgs []*CoreMockDeleteSessionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockDeleteUserRoleExpectation specifies expectation struct of the Core.DeleteUserRole
-type CoreMockDeleteUserRoleExpectation struct {
+// CoreMockDeleteSessionExpectation specifies expectation struct of the Core.DeleteSession
+type CoreMockDeleteSessionExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockDeleteUserRoleParams
-	paramPtrs          *CoreMockDeleteUserRoleParamPtrs
-	expectationOrigins CoreMockDeleteUserRoleExpectationOrigins
-	results            *CoreMockDeleteUserRoleResults
+	params             *CoreMockDeleteSessionParams
+	paramPtrs          *CoreMockDeleteSessionParamPtrs
+	expectationOrigins CoreMockDeleteSessionExpectationOrigins
+	results            *CoreMockDeleteSessionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockDeleteUserRoleParams contains parameters of the Core.DeleteUserRole
-type CoreMockDeleteUserRoleParams struct {
-	ctx  context.Context
-	role auth.UserRole
+// CoreMockDeleteSessionParams contains parameters of the Core.DeleteSession
+type CoreMockDeleteSessionParams struct {
+	ctx    context.Context
+	id     uuid.UUID
+	userID uuid.UUID
 }
 
-// CoreMockDeleteUserRoleParamPtrs contains pointers to parameters of the Core.DeleteUserRole
-type CoreMockDeleteUserRoleParamPtrs struct {
-	ctx  *context.Context
-	role *auth.UserRole
+// CoreMockDeleteSessionParamPtrs contains pointers to parameters of the Core.DeleteSession
+type CoreMockDeleteSessionParamPtrs struct {
+	ctx    *context.Context
+	id     *uuid.UUID
+	userID *uuid.UUID
 }
 
-// CoreMockDeleteUserRoleResults contains results of the Core.DeleteUserRole
-type CoreMockDeleteUserRoleResults struct {
+// CoreMockDeleteSessionResults contains results of the Core.DeleteSession
+type CoreMockDeleteSessionResults struct {
 	err error
 }
 
-// CoreMockDeleteUserRoleOrigins contains origins of expectations of the Core.DeleteUserRole
-type CoreMockDeleteUserRoleExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originRole string
+// CoreMockDeleteSessionOrigins contains origins of expectations of the Core.DeleteSession
+type CoreMockDeleteSessionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1917,343 +2053,369 @@ type CoreMockDeleteUserRoleExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Optional() *mCoreMockDeleteUserRole {
-	mmDeleteUserRole.optional = true
-	return mmDeleteUserRole
+func (mmDeleteSession *mCoreMockDeleteSession) Optional() *mCoreMockDeleteSession {
+	mmDeleteSession.optional = true
+	return mmDeleteSession
 }
 
-// Expect sets up expected params for Core.DeleteUserRole
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Expect(ctx context.Context, role auth.UserRole) *mCoreMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
+// Expect sets up expected params for Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by ExpectParams functions")
+	if mmDeleteSession.defaultExpectation.paramPtrs != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUserRole.defaultExpectation.params = &CoreMockDeleteUserRoleParams{ctx, role}
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUserRole.expectations {
-		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
-			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+	mmDeleteSession.defaultExpectation.params = &CoreMockDeleteSessionParams{ctx, id, userID}
+	mmDeleteSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSession.expectations {
+		if minimock.Equal(e.params, mmDeleteSession.defaultExpectation.params) {
+			mmDeleteSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSession.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUserRole
+	return mmDeleteSession
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.DeleteUserRole
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Expect")
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &CoreMockDeleteUserRoleParamPtrs{}
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmDeleteSession
 }
 
-// ExpectRoleParam2 sets up expected param role for Core.DeleteUserRole
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) ExpectRoleParam2(role auth.UserRole) *mCoreMockDeleteUserRole {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) ExpectIdParam2(id uuid.UUID) *mCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
 	}
 
-	if mmDeleteUserRole.defaultExpectation.params != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Expect")
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
 	}
 
-	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
-		mmDeleteUserRole.defaultExpectation.paramPtrs = &CoreMockDeleteUserRoleParamPtrs{}
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
 	}
-	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
-	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+	mmDeleteSession.defaultExpectation.paramPtrs.id = &id
+	mmDeleteSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmDeleteUserRole
+	return mmDeleteSession
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.DeleteUserRole
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Inspect(f func(ctx context.Context, role auth.UserRole)) *mCoreMockDeleteUserRole {
-	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteUserRole")
+// ExpectUserIDParam3 sets up expected param userID for Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) ExpectUserIDParam3(userID uuid.UUID) *mCoreMockDeleteSession {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
 
-	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{}
+	}
 
-	return mmDeleteUserRole
+	if mmDeleteSession.defaultExpectation.params != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Expect")
+	}
+
+	if mmDeleteSession.defaultExpectation.paramPtrs == nil {
+		mmDeleteSession.defaultExpectation.paramPtrs = &CoreMockDeleteSessionParamPtrs{}
+	}
+	mmDeleteSession.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSession.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSession
 }
 
-// Return sets up results that will be returned by Core.DeleteUserRole
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Return(err error) *CoreMock {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mCoreMockDeleteSession {
+	if mmDeleteSession.mock.inspectFuncDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteSession")
 	}
 
-	if mmDeleteUserRole.defaultExpectation == nil {
-		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	mmDeleteSession.mock.inspectFuncDeleteSession = f
+
+	return mmDeleteSession
+}
+
+// Return sets up results that will be returned by Core.DeleteSession
+func (mmDeleteSession *mCoreMockDeleteSession) Return(err error) *CoreMock {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
-	mmDeleteUserRole.defaultExpectation.results = &CoreMockDeleteUserRoleResults{err}
-	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+
+	if mmDeleteSession.defaultExpectation == nil {
+		mmDeleteSession.defaultExpectation = &CoreMockDeleteSessionExpectation{mock: mmDeleteSession.mock}
+	}
+	mmDeleteSession.defaultExpectation.results = &CoreMockDeleteSessionResults{err}
+	mmDeleteSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
 }
 
-// Set uses given function f to mock the Core.DeleteUserRole method
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Set(f func(ctx context.Context, role auth.UserRole) (err error)) *CoreMock {
-	if mmDeleteUserRole.defaultExpectation != nil {
-		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the Core.DeleteUserRole method")
+// Set uses given function f to mock the Core.DeleteSession method
+func (mmDeleteSession *mCoreMockDeleteSession) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *CoreMock {
+	if mmDeleteSession.defaultExpectation != nil {
+		mmDeleteSession.mock.t.Fatalf("Default expectation is already set for the Core.DeleteSession method")
 	}
 
-	if len(mmDeleteUserRole.expectations) > 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the Core.DeleteUserRole method")
+	if len(mmDeleteSession.expectations) > 0 {
+		mmDeleteSession.mock.t.Fatalf("Some expectations are already set for the Core.DeleteSession method")
 	}
 
-	mmDeleteUserRole.mock.funcDeleteUserRole = f
-	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole.mock
+	mmDeleteSession.mock.funcDeleteSession = f
+	mmDeleteSession.mock.funcDeleteSessionOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession.mock
 }
 
-// When sets expectation for the Core.DeleteUserRole which will trigger the result defined by the following
+// When sets expectation for the Core.DeleteSession which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) When(ctx context.Context, role auth.UserRole) *CoreMockDeleteUserRoleExpectation {
-	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
-		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
+func (mmDeleteSession *mCoreMockDeleteSession) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *CoreMockDeleteSessionExpectation {
+	if mmDeleteSession.mock.funcDeleteSession != nil {
+		mmDeleteSession.mock.t.Fatalf("CoreMock.DeleteSession mock is already set by Set")
 	}
 
-	expectation := &CoreMockDeleteUserRoleExpectation{
-		mock:               mmDeleteUserRole.mock,
-		params:             &CoreMockDeleteUserRoleParams{ctx, role},
-		expectationOrigins: CoreMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockDeleteSessionExpectation{
+		mock:               mmDeleteSession.mock,
+		params:             &CoreMockDeleteSessionParams{ctx, id, userID},
+		expectationOrigins: CoreMockDeleteSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	mmDeleteSession.expectations = append(mmDeleteSession.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.DeleteUserRole return parameters for the expectation previously defined by the When method
-func (e *CoreMockDeleteUserRoleExpectation) Then(err error) *CoreMock {
-	e.results = &CoreMockDeleteUserRoleResults{err}
+// Then sets up Core.DeleteSession return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeleteSessionExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockDeleteSessionResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.DeleteUserRole should be invoked
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Times(n uint64) *mCoreMockDeleteUserRole {
+// Times sets number of times Core.DeleteSession should be invoked
+func (mmDeleteSession *mCoreMockDeleteSession) Times(n uint64) *mCoreMockDeleteSession {
 	if n == 0 {
-		mmDeleteUserRole.mock.t.Fatalf("Times of CoreMock.DeleteUserRole mock can not be zero")
+		mmDeleteSession.mock.t.Fatalf("Times of CoreMock.DeleteSession mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
-	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUserRole
+	mm_atomic.StoreUint64(&mmDeleteSession.expectedInvocations, n)
+	mmDeleteSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSession
 }
 
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) invocationsDone() bool {
-	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+func (mmDeleteSession *mCoreMockDeleteSession) invocationsDone() bool {
+	if len(mmDeleteSession.expectations) == 0 && mmDeleteSession.defaultExpectation == nil && mmDeleteSession.mock.funcDeleteSession == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSession.mock.afterDeleteSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSession.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUserRole implements mm_usecase.Core
-func (mmDeleteUserRole *CoreMock) DeleteUserRole(ctx context.Context, role auth.UserRole) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+// DeleteSession implements mm_usecase.Core
+func (mmDeleteSession *CoreMock) DeleteSession(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSession.beforeDeleteSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSession.afterDeleteSessionCounter, 1)
 
-	mmDeleteUserRole.t.Helper()
+	mmDeleteSession.t.Helper()
 
-	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
-		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
+	if mmDeleteSession.inspectFuncDeleteSession != nil {
+		mmDeleteSession.inspectFuncDeleteSession(ctx, id, userID)
 	}
 
-	mm_params := CoreMockDeleteUserRoleParams{ctx, role}
+	mm_params := CoreMockDeleteSessionParams{ctx, id, userID}
 
 	// Record call args
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
-	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
-	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+	mmDeleteSession.DeleteSessionMock.mutex.Lock()
+	mmDeleteSession.DeleteSessionMock.callArgs = append(mmDeleteSession.DeleteSessionMock.callArgs, &mm_params)
+	mmDeleteSession.DeleteSessionMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+	for _, e := range mmDeleteSession.DeleteSessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+	if mmDeleteSession.DeleteSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSession.DeleteSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSession.DeleteSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSession.DeleteSessionMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockDeleteUserRoleParams{ctx, role}
+		mm_got := CoreMockDeleteSessionParams{ctx, id, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
-				mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteSession.t.Errorf("CoreMock.DeleteSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSession.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		mm_results := mmDeleteSession.DeleteSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUserRole.t.Fatal("No results are set for the CoreMock.DeleteUserRole")
+			mmDeleteSession.t.Fatal("No results are set for the CoreMock.DeleteSession")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteUserRole.funcDeleteUserRole != nil {
-		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
+	if mmDeleteSession.funcDeleteSession != nil {
+		return mmDeleteSession.funcDeleteSession(ctx, id, userID)
 	}
-	mmDeleteUserRole.t.Fatalf("Unexpected call to CoreMock.DeleteUserRole. %v %v", ctx, role)
+	mmDeleteSession.t.Fatalf("Unexpected call to CoreMock.DeleteSession. %v %v %v", ctx, id, userID)
 	return
 }
 
-// DeleteUserRoleAfterCounter returns a count of finished CoreMock.DeleteUserRole invocations
-func (mmDeleteUserRole *CoreMock) DeleteUserRoleAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+// DeleteSessionAfterCounter returns a count of finished CoreMock.DeleteSession invocations
+func (mmDeleteSession *CoreMock) DeleteSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.afterDeleteSessionCounter)
 }
 
-// DeleteUserRoleBeforeCounter returns a count of CoreMock.DeleteUserRole invocations
-func (mmDeleteUserRole *CoreMock) DeleteUserRoleBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+// DeleteSessionBeforeCounter returns a count of CoreMock.DeleteSession invocations
+func (mmDeleteSession *CoreMock) DeleteSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSession.beforeDeleteSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.DeleteUserRole.
+// Calls returns a list of arguments used in each call to CoreMock.DeleteSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUserRole *mCoreMockDeleteUserRole) Calls() []*CoreMockDeleteUserRoleParams {
-	mmDeleteUserRole.mutex.RLock()
+func (mmDeleteSession *mCoreMockDeleteSession) Calls() []*CoreMockDeleteSessionParams {
+	mmDeleteSession.mutex.RLock()
 
-	argCopy := make([]*CoreMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
-	copy(argCopy, mmDeleteUserRole.callArgs)
+	argCopy := make([]*CoreMockDeleteSessionParams, len(mmDeleteSession.callArgs))
+	copy(argCopy, mmDeleteSession.callArgs)
 
-	mmDeleteUserRole.mutex.RUnlock()
+	mmDeleteSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// MinimockDeleteSessionDone returns true if the count of the DeleteSession invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockDeleteUserRoleDone() bool {
-	if m.DeleteUserRoleMock.optional {
+func (m *CoreMock) MinimockDeleteSessionDone() bool {
+	if m.DeleteSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserRoleMock.expectations {
+	for _, e := range m.DeleteSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserRoleMock.invocationsDone()
+	return m.DeleteSessionMock.invocationsDone()
 }
 
-// MinimockDeleteUserRoleInspect logs each unmet expectation
-func (m *CoreMock) MinimockDeleteUserRoleInspect() {
-	for _, e := range m.DeleteUserRoleMock.expectations {
+// MinimockDeleteSessionInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeleteSessionInspect() {
+	for _, e := range m.DeleteSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	afterDeleteSessionCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
-		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+	if m.DeleteSessionMock.defaultExpectation != nil && afterDeleteSessionCounter < 1 {
+		if m.DeleteSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s", m.DeleteSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s with params: %#v", m.DeleteSessionMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	if m.funcDeleteSession != nil && afterDeleteSessionCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeleteSession at\n%s", m.funcDeleteSessionOrigin)
 	}
 
-	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.DeleteUserRole at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	if !m.DeleteSessionMock.invocationsDone() && afterDeleteSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeleteSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionMock.expectedInvocations), m.DeleteSessionMock.expectedInvocationsOrigin, afterDeleteSessionCounter)
 	}
 }
 
-type mCoreMockGetSessionByID struct {
+type mCoreMockDeleteSessionsByUserID struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockGetSessionByIDExpectation
-	expectations       []*CoreMockGetSessionByIDExpectation
+	defaultExpectation *CoreMockDeleteSessionsByUserIDExpectation
+	expectations       []*CoreMockDeleteSessionsByUserIDExpectation
 
-	callArgs []*CoreMockGetSessionByIDParams
+	callArgs []*CoreMockDeleteSessionsByUserIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockGetSessionByIDExpectation specifies expectation struct of the Core.GetSessionByID
-type CoreMockGetSessionByIDExpectation struct {
+// CoreMockDeleteSessionsByUserIDExpectation specifies expectation struct of the Core.DeleteSessionsByUserID
+type CoreMockDeleteSessionsByUserIDExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockGetSessionByIDParams
-	paramPtrs          *CoreMockGetSessionByIDParamPtrs
-	expectationOrigins CoreMockGetSessionByIDExpectationOrigins
-	results            *CoreMockGetSessionByIDResults
+	params             *CoreMockDeleteSessionsByUserIDParams
+	paramPtrs          *CoreMockDeleteSessionsByUserIDParamPtrs
+	expectationOrigins CoreMockDeleteSessionsByUserIDExpectationOrigins
+	results            *CoreMockDeleteSessionsByUserIDResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockGetSessionByIDParams contains parameters of the Core.GetSessionByID
-type CoreMockGetSessionByIDParams struct {
-	ctx context.Context
-	id  uuid.UUID
+// CoreMockDeleteSessionsByUserIDParams contains parameters of the Core.DeleteSessionsByUserID
+type CoreMockDeleteSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
 }
 
-// CoreMockGetSessionByIDParamPtrs contains pointers to parameters of the Core.GetSessionByID
-type CoreMockGetSessionByIDParamPtrs struct {
-	ctx *context.Context
-	id  *uuid.UUID
+// CoreMockDeleteSessionsByUserIDParamPtrs contains pointers to parameters of the Core.DeleteSessionsByUserID
+type CoreMockDeleteSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
 }
 
-// CoreMockGetSessionByIDResults contains results of the Core.GetSessionByID
-type CoreMockGetSessionByIDResults struct {
-	s1  auth.Session
-	s2  string
+// CoreMockDeleteSessionsByUserIDResults contains results of the Core.DeleteSessionsByUserID
+type CoreMockDeleteSessionsByUserIDResults struct {
 	err error
 }
 
-// CoreMockGetSessionByIDOrigins contains origins of expectations of the Core.GetSessionByID
-type CoreMockGetSessionByIDExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// CoreMockDeleteSessionsByUserIDOrigins contains origins of expectations of the Core.DeleteSessionsByUserID
+type CoreMockDeleteSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2261,342 +2423,341 @@ type CoreMockGetSessionByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetSessionByID *mCoreMockGetSessionByID) Optional() *mCoreMockGetSessionByID {
-	mmGetSessionByID.optional = true
-	return mmGetSessionByID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Optional() *mCoreMockDeleteSessionsByUserID {
+	mmDeleteSessionsByUserID.optional = true
+	return mmDeleteSessionsByUserID
 }
 
-// Expect sets up expected params for Core.GetSessionByID
-func (mmGetSessionByID *mCoreMockGetSessionByID) Expect(ctx context.Context, id uuid.UUID) *mCoreMockGetSessionByID {
-	if mmGetSessionByID.mock.funcGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+// Expect sets up expected params for Core.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
 	}
 
-	if mmGetSessionByID.defaultExpectation == nil {
-		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
 	}
 
-	if mmGetSessionByID.defaultExpectation.paramPtrs != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by ExpectParams functions")
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by ExpectParams functions")
 	}
 
-	mmGetSessionByID.defaultExpectation.params = &CoreMockGetSessionByIDParams{ctx, id}
-	mmGetSessionByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetSessionByID.expectations {
-		if minimock.Equal(e.params, mmGetSessionByID.defaultExpectation.params) {
-			mmGetSessionByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionByID.defaultExpectation.params)
+	mmDeleteSessionsByUserID.defaultExpectation.params = &CoreMockDeleteSessionsByUserIDParams{ctx, userID}
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteSessionsByUserID.defaultExpectation.params) {
+			mmDeleteSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessionsByUserID.defaultExpectation.params)
 		}
 	}
 
-	return mmGetSessionByID
+	return mmDeleteSessionsByUserID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.GetSessionByID
-func (mmGetSessionByID *mCoreMockGetSessionByID) ExpectCtxParam1(ctx context.Context) *mCoreMockGetSessionByID {
-	if mmGetSessionByID.mock.funcGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
 	}
 
-	if mmGetSessionByID.defaultExpectation == nil {
-		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
 	}
 
-	if mmGetSessionByID.defaultExpectation.params != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Expect")
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Expect")
 	}
 
-	if mmGetSessionByID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionByID.defaultExpectation.paramPtrs = &CoreMockGetSessionByIDParamPtrs{}
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockDeleteSessionsByUserIDParamPtrs{}
 	}
-	mmGetSessionByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetSessionByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetSessionByID
+	return mmDeleteSessionsByUserID
 }
 
-// ExpectIdParam2 sets up expected param id for Core.GetSessionByID
-func (mmGetSessionByID *mCoreMockGetSessionByID) ExpectIdParam2(id uuid.UUID) *mCoreMockGetSessionByID {
-	if mmGetSessionByID.mock.funcGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Core.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
 	}
 
-	if mmGetSessionByID.defaultExpectation == nil {
-		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{}
 	}
 
-	if mmGetSessionByID.defaultExpectation.params != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Expect")
+	if mmDeleteSessionsByUserID.defaultExpectation.params != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Expect")
 	}
 
-	if mmGetSessionByID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionByID.defaultExpectation.paramPtrs = &CoreMockGetSessionByIDParamPtrs{}
+	if mmDeleteSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockDeleteSessionsByUserIDParamPtrs{}
 	}
-	mmGetSessionByID.defaultExpectation.paramPtrs.id = &id
-	mmGetSessionByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmDeleteSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetSessionByID
+	return mmDeleteSessionsByUserID
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.GetSessionByID
-func (mmGetSessionByID *mCoreMockGetSessionByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockGetSessionByID {
-	if mmGetSessionByID.mock.inspectFuncGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("Inspect function is already set for CoreMock.GetSessionByID")
+// Inspect accepts an inspector function that has same arguments as the Core.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockDeleteSessionsByUserID {
+	if mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteSessionsByUserID")
 	}
 
-	mmGetSessionByID.mock.inspectFuncGetSessionByID = f
+	mmDeleteSessionsByUserID.mock.inspectFuncDeleteSessionsByUserID = f
 
-	return mmGetSessionByID
+	return mmDeleteSessionsByUserID
 }
 
-// Return sets up results that will be returned by Core.GetSessionByID
-func (mmGetSessionByID *mCoreMockGetSessionByID) Return(s1 auth.Session, s2 string, err error) *CoreMock {
-	if mmGetSessionByID.mock.funcGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+// Return sets up results that will be returned by Core.DeleteSessionsByUserID
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Return(err error) *CoreMock {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
 	}
 
-	if mmGetSessionByID.defaultExpectation == nil {
-		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{mock: mmGetSessionByID.mock}
+	if mmDeleteSessionsByUserID.defaultExpectation == nil {
+		mmDeleteSessionsByUserID.defaultExpectation = &CoreMockDeleteSessionsByUserIDExpectation{mock: mmDeleteSessionsByUserID.mock}
 	}
-	mmGetSessionByID.defaultExpectation.results = &CoreMockGetSessionByIDResults{s1, s2, err}
-	mmGetSessionByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetSessionByID.mock
+	mmDeleteSessionsByUserID.defaultExpectation.results = &CoreMockDeleteSessionsByUserIDResults{err}
+	mmDeleteSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
 }
 
-// Set uses given function f to mock the Core.GetSessionByID method
-func (mmGetSessionByID *mCoreMockGetSessionByID) Set(f func(ctx context.Context, id uuid.UUID) (s1 auth.Session, s2 string, err error)) *CoreMock {
-	if mmGetSessionByID.defaultExpectation != nil {
-		mmGetSessionByID.mock.t.Fatalf("Default expectation is already set for the Core.GetSessionByID method")
+// Set uses given function f to mock the Core.DeleteSessionsByUserID method
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *CoreMock {
+	if mmDeleteSessionsByUserID.defaultExpectation != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Core.DeleteSessionsByUserID method")
 	}
 
-	if len(mmGetSessionByID.expectations) > 0 {
-		mmGetSessionByID.mock.t.Fatalf("Some expectations are already set for the Core.GetSessionByID method")
+	if len(mmDeleteSessionsByUserID.expectations) > 0 {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Core.DeleteSessionsByUserID method")
 	}
 
-	mmGetSessionByID.mock.funcGetSessionByID = f
-	mmGetSessionByID.mock.funcGetSessionByIDOrigin = minimock.CallerInfo(1)
-	return mmGetSessionByID.mock
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID = f
+	mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID.mock
 }
 
-// When sets expectation for the Core.GetSessionByID which will trigger the result defined by the following
+// When sets expectation for the Core.DeleteSessionsByUserID which will trigger the result defined by the following
 // Then helper
-func (mmGetSessionByID *mCoreMockGetSessionByID) When(ctx context.Context, id uuid.UUID) *CoreMockGetSessionByIDExpectation {
-	if mmGetSessionByID.mock.funcGetSessionByID != nil {
-		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *CoreMockDeleteSessionsByUserIDExpectation {
+	if mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.mock.t.Fatalf("CoreMock.DeleteSessionsByUserID mock is already set by Set")
 	}
 
-	expectation := &CoreMockGetSessionByIDExpectation{
-		mock:               mmGetSessionByID.mock,
-		params:             &CoreMockGetSessionByIDParams{ctx, id},
-		expectationOrigins: CoreMockGetSessionByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
-	}
-	mmGetSessionByID.expectations = append(mmGetSessionByID.expectations, expectation)
-	return expectation
+	expectation := &CoreMockDeleteSessionsByUserIDExpectation{
+		mock:               mmDeleteSessionsByUserID.mock,
+		params:             &CoreMockDeleteSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: CoreMockDeleteSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessionsByUserID.expectations = append(mmDeleteSessionsByUserID.expectations, expectation)
+	return expectation
 }
 
-// Then sets up Core.GetSessionByID return parameters for the expectation previously defined by the When method
-func (e *CoreMockGetSessionByIDExpectation) Then(s1 auth.Session, s2 string, err error) *CoreMock {
-	e.results = &CoreMockGetSessionByIDResults{s1, s2, err}
+// Then sets up Core.DeleteSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeleteSessionsByUserIDExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockDeleteSessionsByUserIDResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.GetSessionByID should be invoked
-func (mmGetSessionByID *mCoreMockGetSessionByID) Times(n uint64) *mCoreMockGetSessionByID {
+// Times sets number of times Core.DeleteSessionsByUserID should be invoked
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Times(n uint64) *mCoreMockDeleteSessionsByUserID {
 	if n == 0 {
-		mmGetSessionByID.mock.t.Fatalf("Times of CoreMock.GetSessionByID mock can not be zero")
+		mmDeleteSessionsByUserID.mock.t.Fatalf("Times of CoreMock.DeleteSessionsByUserID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetSessionByID.expectedInvocations, n)
-	mmGetSessionByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetSessionByID
+	mm_atomic.StoreUint64(&mmDeleteSessionsByUserID.expectedInvocations, n)
+	mmDeleteSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessionsByUserID
 }
 
-func (mmGetSessionByID *mCoreMockGetSessionByID) invocationsDone() bool {
-	if len(mmGetSessionByID.expectations) == 0 && mmGetSessionByID.defaultExpectation == nil && mmGetSessionByID.mock.funcGetSessionByID == nil {
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) invocationsDone() bool {
+	if len(mmDeleteSessionsByUserID.expectations) == 0 && mmDeleteSessionsByUserID.defaultExpectation == nil && mmDeleteSessionsByUserID.mock.funcDeleteSessionsByUserID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionByID.mock.afterGetSessionByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.mock.afterDeleteSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetSessionByID implements mm_usecase.Core
-func (mmGetSessionByID *CoreMock) GetSessionByID(ctx context.Context, id uuid.UUID) (s1 auth.Session, s2 string, err error) {
-	mm_atomic.AddUint64(&mmGetSessionByID.beforeGetSessionByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSessionByID.afterGetSessionByIDCounter, 1)
+// DeleteSessionsByUserID implements mm_usecase.Core
+func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter, 1)
 
-	mmGetSessionByID.t.Helper()
+	mmDeleteSessionsByUserID.t.Helper()
 
-	if mmGetSessionByID.inspectFuncGetSessionByID != nil {
-		mmGetSessionByID.inspectFuncGetSessionByID(ctx, id)
+	if mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID != nil {
+		mmDeleteSessionsByUserID.inspectFuncDeleteSessionsByUserID(ctx, userID)
 	}
 
-	mm_params := CoreMockGetSessionByIDParams{ctx, id}
+	mm_params := CoreMockDeleteSessionsByUserIDParams{ctx, userID}
 
 	// Record call args
-	mmGetSessionByID.GetSessionByIDMock.mutex.Lock()
-	mmGetSessionByID.GetSessionByIDMock.callArgs = append(mmGetSessionByID.GetSessionByIDMock.callArgs, &mm_params)
-	mmGetSessionByID.GetSessionByIDMock.mutex.Unlock()
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Lock()
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs = append(mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.callArgs, &mm_params)
+	mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.mutex.Unlock()
 
-	for _, e := range mmGetSessionByID.GetSessionByIDMock.expectations {
+	for _, e := range mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.s2, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetSessionByID.GetSessionByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSessionByID.GetSessionByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.paramPtrs
+	if mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockGetSessionByIDParams{ctx, id}
+		mm_got := CoreMockDeleteSessionsByUserIDParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteSessionsByUserID.t.Errorf("CoreMock.DeleteSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.results
+		mm_results := mmDeleteSessionsByUserID.DeleteSessionsByUserIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSessionByID.t.Fatal("No results are set for the CoreMock.GetSessionByID")
+			mmDeleteSessionsByUserID.t.Fatal("No results are set for the CoreMock.DeleteSessionsByUserID")
 		}
-		return (*mm_results).s1, (*mm_results).s2, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetSessionByID.funcGetSessionByID != nil {
-		return mmGetSessionByID.funcGetSessionByID(ctx, id)
+	if mmDeleteSessionsByUserID.funcDeleteSessionsByUserID != nil {
+		return mmDeleteSessionsByUserID.funcDeleteSessionsByUserID(ctx, userID)
 	}
-	mmGetSessionByID.t.Fatalf("Unexpected call to CoreMock.GetSessionByID. %v %v", ctx, id)
+	mmDeleteSessionsByUserID.t.Fatalf("Unexpected call to CoreMock.DeleteSessionsByUserID. %v %v", ctx, userID)
 	return
 }
 
-// GetSessionByIDAfterCounter returns a count of finished CoreMock.GetSessionByID invocations
-func (mmGetSessionByID *CoreMock) GetSessionByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionByID.afterGetSessionByIDCounter)
+// DeleteSessionsByUserIDAfterCounter returns a count of finished CoreMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.afterDeleteSessionsByUserIDCounter)
 }
 
-// GetSessionByIDBeforeCounter returns a count of CoreMock.GetSessionByID invocations
-func (mmGetSessionByID *CoreMock) GetSessionByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionByID.beforeGetSessionByIDCounter)
+// DeleteSessionsByUserIDBeforeCounter returns a count of CoreMock.DeleteSessionsByUserID invocations
+func (mmDeleteSessionsByUserID *CoreMock) DeleteSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessionsByUserID.beforeDeleteSessionsByUserIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.GetSessionByID.
+// Calls returns a list of arguments used in each call to CoreMock.DeleteSessionsByUserID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetSessionByID *mCoreMockGetSessionByID) Calls() []*CoreMockGetSessionByIDParams {
-	mmGetSessionByID.mutex.RLock()
+func (mmDeleteSessionsByUserID *mCoreMockDeleteSessionsByUserID) Calls() []*CoreMockDeleteSessionsByUserIDParams {
+	mmDeleteSessionsByUserID.mutex.RLock()
 
-	argCopy := make([]*CoreMockGetSessionByIDParams, len(mmGetSessionByID.callArgs))
-	copy(argCopy, mmGetSessionByID.callArgs)
+	argCopy := make([]*CoreMockDeleteSessionsByUserIDParams, len(mmDeleteSessionsByUserID.callArgs))
+	copy(argCopy, mmDeleteSessionsByUserID.callArgs)
 
-	mmGetSessionByID.mutex.RUnlock()
+	mmDeleteSessionsByUserID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetSessionByIDDone returns true if the count of the GetSessionByID invocations corresponds
+// MinimockDeleteSessionsByUserIDDone returns true if the count of the DeleteSessionsByUserID invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockGetSessionByIDDone() bool {
-	if m.GetSessionByIDMock.optional {
+func (m *CoreMock) MinimockDeleteSessionsByUserIDDone() bool {
+	if m.DeleteSessionsByUserIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetSessionByIDMock.expectations {
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetSessionByIDMock.invocationsDone()
+	return m.DeleteSessionsByUserIDMock.invocationsDone()
 }
 
-// MinimockGetSessionByIDInspect logs each unmet expectation
-func (m *CoreMock) MinimockGetSessionByIDInspect() {
-	for _, e := range m.GetSessionByIDMock.expectations {
+// MinimockDeleteSessionsByUserIDInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeleteSessionsByUserIDInspect() {
+	for _, e := range m.DeleteSessionsByUserIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetSessionByIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionByIDCounter)
+	afterDeleteSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsByUserIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSessionByIDMock.defaultExpectation != nil && afterGetSessionByIDCounter < 1 {
-		if m.GetSessionByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s", m.GetSessionByIDMock.defaultExpectation.returnOrigin)
+	if m.DeleteSessionsByUserIDMock.defaultExpectation != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		if m.DeleteSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s", m.DeleteSessionsByUserIDMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s with params: %#v", m.GetSessionByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s with params: %#v", m.DeleteSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsByUserIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSessionByID != nil && afterGetSessionByIDCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s", m.funcGetSessionByIDOrigin)
+	if m.funcDeleteSessionsByUserID != nil && afterDeleteSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeleteSessionsByUserID at\n%s", m.funcDeleteSessionsByUserIDOrigin)
 	}
 
-	if !m.GetSessionByIDMock.invocationsDone() && afterGetSessionByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.GetSessionByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetSessionByIDMock.expectedInvocations), m.GetSessionByIDMock.expectedInvocationsOrigin, afterGetSessionByIDCounter)
+	if !m.DeleteSessionsByUserIDMock.invocationsDone() && afterDeleteSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeleteSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionsByUserIDMock.expectedInvocations), m.DeleteSessionsByUserIDMock.expectedInvocationsOrigin, afterDeleteSessionsByUserIDCounter)
 	}
 }
 
-type mCoreMockGetSessionsByUserID struct {
+type mCoreMockDeleteUserRole struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockGetSessionsByUserIDExpectation
-	expectations       []*CoreMockGetSessionsByUserIDExpectation
+	defaultExpectation *CoreMockDeleteUserRoleExpectation
+	expectations       []*CoreMockDeleteUserRoleExpectation
 
-	callArgs []*CoreMockGetSessionsByUserIDParams
+	callArgs []*CoreMockDeleteUserRoleParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockGetSessionsByUserIDExpectation specifies expectation struct of the Core.GetSessionsByUserID
-type CoreMockGetSessionsByUserIDExpectation struct {
+// CoreMockDeleteUserRoleExpectation specifies expectation struct of the Core.DeleteUserRole
+type CoreMockDeleteUserRoleExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockGetSessionsByUserIDParams
-	paramPtrs          *CoreMockGetSessionsByUserIDParamPtrs
-	expectationOrigins CoreMockGetSessionsByUserIDExpectationOrigins
-	results            *CoreMockGetSessionsByUserIDResults
+	params             *CoreMockDeleteUserRoleParams
+	paramPtrs          *CoreMockDeleteUserRoleParamPtrs
+	expectationOrigins CoreMockDeleteUserRoleExpectationOrigins
+	results            *CoreMockDeleteUserRoleResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockGetSessionsByUserIDParams contains parameters of the Core.GetSessionsByUserID
-type CoreMockGetSessionsByUserIDParams struct {
-	ctx    context.Context
-	userID uuid.UUID
+// CoreMockDeleteUserRoleParams contains parameters of the Core.DeleteUserRole
+type CoreMockDeleteUserRoleParams struct {
+	ctx  context.Context
+	role auth.UserRole
 }
 
-// CoreMockGetSessionsByUserIDParamPtrs contains pointers to parameters of the Core.GetSessionsByUserID
-type CoreMockGetSessionsByUserIDParamPtrs struct {
-	ctx    *context.Context
-	userID *uuid.UUID
+// CoreMockDeleteUserRoleParamPtrs contains pointers to parameters of the Core.DeleteUserRole
+type CoreMockDeleteUserRoleParamPtrs struct {
+	ctx  *context.Context
+	role *auth.UserRole
 }
 
-// CoreMockGetSessionsByUserIDResults contains results of the Core.GetSessionsByUserID
-type CoreMockGetSessionsByUserIDResults struct {
-	sa1 []auth.Session
+// CoreMockDeleteUserRoleResults contains results of the Core.DeleteUserRole
+type CoreMockDeleteUserRoleResults struct {
 	err error
 }
 
-// CoreMockGetSessionsByUserIDOrigins contains origins of expectations of the Core.GetSessionsByUserID
-type CoreMockGetSessionsByUserIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// CoreMockDeleteUserRoleOrigins contains origins of expectations of the Core.DeleteUserRole
+type CoreMockDeleteUserRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2604,337 +2765,337 @@ type CoreMockGetSessionsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Optional() *mCoreMockGetSessionsByUserID {
-	mmGetSessionsByUserID.optional = true
-	return mmGetSessionsByUserID
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Optional() *mCoreMockDeleteUserRole {
+	mmDeleteUserRole.optional = true
+	return mmDeleteUserRole
 }
 
-// Expect sets up expected params for Core.GetSessionsByUserID
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+// Expect sets up expected params for Core.DeleteUserRole
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Expect(ctx context.Context, role auth.UserRole) *mCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by ExpectParams functions")
+	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by ExpectParams functions")
 	}
 
-	mmGetSessionsByUserID.defaultExpectation.params = &CoreMockGetSessionsByUserIDParams{ctx, userID}
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetSessionsByUserID.expectations {
-		if minimock.Equal(e.params, mmGetSessionsByUserID.defaultExpectation.params) {
-			mmGetSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionsByUserID.defaultExpectation.params)
+	mmDeleteUserRole.defaultExpectation.params = &CoreMockDeleteUserRoleParams{ctx, role}
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRole.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
+			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
 		}
 	}
 
-	return mmGetSessionsByUserID
+	return mmDeleteUserRole
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.GetSessionsByUserID
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mCoreMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.DeleteUserRole
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.params != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Expect")
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Expect")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockGetSessionsByUserIDParamPtrs{}
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &CoreMockDeleteUserRoleParamPtrs{}
 	}
-	mmGetSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetSessionsByUserID
+	return mmDeleteUserRole
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Core.GetSessionsByUserID
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+// ExpectRoleParam2 sets up expected param role for Core.DeleteUserRole
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) ExpectRoleParam2(role auth.UserRole) *mCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{}
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.params != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Expect")
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Expect")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockGetSessionsByUserIDParamPtrs{}
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &CoreMockDeleteUserRoleParamPtrs{}
 	}
-	mmGetSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmDeleteUserRole.defaultExpectation.paramPtrs.role = &role
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
 
-	return mmGetSessionsByUserID
+	return mmDeleteUserRole
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.GetSessionsByUserID
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockGetSessionsByUserID {
-	if mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("Inspect function is already set for CoreMock.GetSessionsByUserID")
+// Inspect accepts an inspector function that has same arguments as the Core.DeleteUserRole
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Inspect(f func(ctx context.Context, role auth.UserRole)) *mCoreMockDeleteUserRole {
+	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteUserRole")
 	}
 
-	mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID = f
+	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
 
-	return mmGetSessionsByUserID
+	return mmDeleteUserRole
 }
 
-// Return sets up results that will be returned by Core.GetSessionsByUserID
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Return(sa1 []auth.Session, err error) *CoreMock {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+// Return sets up results that will be returned by Core.DeleteUserRole
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Return(err error) *CoreMock {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
 	}
 
-	if mmGetSessionsByUserID.defaultExpectation == nil {
-		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{mock: mmGetSessionsByUserID.mock}
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &CoreMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
 	}
-	mmGetSessionsByUserID.defaultExpectation.results = &CoreMockGetSessionsByUserIDResults{sa1, err}
-	mmGetSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID.mock
+	mmDeleteUserRole.defaultExpectation.results = &CoreMockDeleteUserRoleResults{err}
+	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
 }
 
-// Set uses given function f to mock the Core.GetSessionsByUserID method
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)) *CoreMock {
-	if mmGetSessionsByUserID.defaultExpectation != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Core.GetSessionsByUserID method")
+// Set uses given function f to mock the Core.DeleteUserRole method
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Set(f func(ctx context.Context, role auth.UserRole) (err error)) *CoreMock {
+	if mmDeleteUserRole.defaultExpectation != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the Core.DeleteUserRole method")
 	}
 
-	if len(mmGetSessionsByUserID.expectations) > 0 {
-		mmGetSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Core.GetSessionsByUserID method")
+	if len(mmDeleteUserRole.expectations) > 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the Core.DeleteUserRole method")
 	}
 
-	mmGetSessionsByUserID.mock.funcGetSessionsByUserID = f
-	mmGetSessionsByUserID.mock.funcGetSessionsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID.mock
+	mmDeleteUserRole.mock.funcDeleteUserRole = f
+	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
 }
 
-// When sets expectation for the Core.GetSessionsByUserID which will trigger the result defined by the following
+// When sets expectation for the Core.DeleteUserRole which will trigger the result defined by the following
 // Then helper
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *CoreMockGetSessionsByUserIDExpectation {
-	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) When(ctx context.Context, role auth.UserRole) *CoreMockDeleteUserRoleExpectation {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("CoreMock.DeleteUserRole mock is already set by Set")
 	}
 
-	expectation := &CoreMockGetSessionsByUserIDExpectation{
-		mock:               mmGetSessionsByUserID.mock,
-		params:             &CoreMockGetSessionsByUserIDParams{ctx, userID},
-		expectationOrigins: CoreMockGetSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockDeleteUserRoleExpectation{
+		mock:               mmDeleteUserRole.mock,
+		params:             &CoreMockDeleteUserRoleParams{ctx, role},
+		expectationOrigins: CoreMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetSessionsByUserID.expectations = append(mmGetSessionsByUserID.expectations, expectation)
+	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.GetSessionsByUserID return parameters for the expectation previously defined by the When method
-func (e *CoreMockGetSessionsByUserIDExpectation) Then(sa1 []auth.Session, err error) *CoreMock {
-	e.results = &CoreMockGetSessionsByUserIDResults{sa1, err}
+// Then sets up Core.DeleteUserRole return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeleteUserRoleExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockDeleteUserRoleResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.GetSessionsByUserID should be invoked
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Times(n uint64) *mCoreMockGetSessionsByUserID {
+// Times sets number of times Core.DeleteUserRole should be invoked
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Times(n uint64) *mCoreMockDeleteUserRole {
 	if n == 0 {
-		mmGetSessionsByUserID.mock.t.Fatalf("Times of CoreMock.GetSessionsByUserID mock can not be zero")
+		mmDeleteUserRole.mock.t.Fatalf("Times of CoreMock.DeleteUserRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetSessionsByUserID.expectedInvocations, n)
-	mmGetSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetSessionsByUserID
+	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
+	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole
 }
 
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) invocationsDone() bool {
-	if len(mmGetSessionsByUserID.expectations) == 0 && mmGetSessionsByUserID.defaultExpectation == nil && mmGetSessionsByUserID.mock.funcGetSessionsByUserID == nil {
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) invocationsDone() bool {
+	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.mock.afterGetSessionsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetSessionsByUserID implements mm_usecase.Core
-func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error) {
-	mm_atomic.AddUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter, 1)
+// DeleteUserRole implements mm_usecase.Core
+func (mmDeleteUserRole *CoreMock) DeleteUserRole(ctx context.Context, role auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
 
-	mmGetSessionsByUserID.t.Helper()
+	mmDeleteUserRole.t.Helper()
 
-	if mmGetSessionsByUserID.inspectFuncGetSessionsByUserID != nil {
-		mmGetSessionsByUserID.inspectFuncGetSessionsByUserID(ctx, userID)
+	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, role)
 	}
 
-	mm_params := CoreMockGetSessionsByUserIDParams{ctx, userID}
+	mm_params := CoreMockDeleteUserRoleParams{ctx, role}
 
 	// Record call args
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Lock()
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs = append(mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs, &mm_params)
-	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Unlock()
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
+	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
 
-	for _, e := range mmGetSessionsByUserID.GetSessionsByUserIDMock.expectations {
+	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.paramPtrs
+	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockGetSessionsByUserIDParams{ctx, userID}
+		mm_got := CoreMockDeleteUserRoleParams{ctx, role}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDeleteUserRole.t.Errorf("CoreMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.results
+		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSessionsByUserID.t.Fatal("No results are set for the CoreMock.GetSessionsByUserID")
+			mmDeleteUserRole.t.Fatal("No results are set for the CoreMock.DeleteUserRole")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetSessionsByUserID.funcGetSessionsByUserID != nil {
-		return mmGetSessionsByUserID.funcGetSessionsByUserID(ctx, userID)
+	if mmDeleteUserRole.funcDeleteUserRole != nil {
+		return mmDeleteUserRole.funcDeleteUserRole(ctx, role)
 	}
-	mmGetSessionsByUserID.t.Fatalf("Unexpected call to CoreMock.GetSessionsByUserID. %v %v", ctx, userID)
+	mmDeleteUserRole.t.Fatalf("Unexpected call to CoreMock.DeleteUserRole. %v %v", ctx, role)
 	return
 }
 
-// GetSessionsByUserIDAfterCounter returns a count of finished CoreMock.GetSessionsByUserID invocations
-func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter)
+// DeleteUserRoleAfterCounter returns a count of finished CoreMock.DeleteUserRole invocations
+func (mmDeleteUserRole *CoreMock) DeleteUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
 }
 
-// GetSessionsByUserIDBeforeCounter returns a count of CoreMock.GetSessionsByUserID invocations
-func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter)
+// DeleteUserRoleBeforeCounter returns a count of CoreMock.DeleteUserRole invocations
+func (mmDeleteUserRole *CoreMock) DeleteUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.GetSessionsByUserID.
+// Calls returns a list of arguments used in each call to CoreMock.DeleteUserRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Calls() []*CoreMockGetSessionsByUserIDParams {
-	mmGetSessionsByUserID.mutex.RLock()
+func (mmDeleteUserRole *mCoreMockDeleteUserRole) Calls() []*CoreMockDeleteUserRoleParams {
+	mmDeleteUserRole.mutex.RLock()
 
-	argCopy := make([]*CoreMockGetSessionsByUserIDParams, len(mmGetSessionsByUserID.callArgs))
-	copy(argCopy, mmGetSessionsByUserID.callArgs)
+	argCopy := make([]*CoreMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
+	copy(argCopy, mmDeleteUserRole.callArgs)
 
-	mmGetSessionsByUserID.mutex.RUnlock()
+	mmDeleteUserRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetSessionsByUserIDDone returns true if the count of the GetSessionsByUserID invocations corresponds
+// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockGetSessionsByUserIDDone() bool {
-	if m.GetSessionsByUserIDMock.optional {
+func (m *CoreMock) MinimockDeleteUserRoleDone() bool {
+	if m.DeleteUserRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetSessionsByUserIDMock.expectations {
+	for _, e := range m.DeleteUserRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetSessionsByUserIDMock.invocationsDone()
+	return m.DeleteUserRoleMock.invocationsDone()
 }
 
-// MinimockGetSessionsByUserIDInspect logs each unmet expectation
-func (m *CoreMock) MinimockGetSessionsByUserIDInspect() {
-	for _, e := range m.GetSessionsByUserIDMock.expectations {
+// MinimockDeleteUserRoleInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeleteUserRoleInspect() {
+	for _, e := range m.DeleteUserRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionsByUserIDCounter)
+	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSessionsByUserIDMock.defaultExpectation != nil && afterGetSessionsByUserIDCounter < 1 {
-		if m.GetSessionsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s", m.GetSessionsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
+		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s with params: %#v", m.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSessionsByUserID != nil && afterGetSessionsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s", m.funcGetSessionsByUserIDOrigin)
+	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
 	}
 
-	if !m.GetSessionsByUserIDMock.invocationsDone() && afterGetSessionsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.GetSessionsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetSessionsByUserIDMock.expectedInvocations), m.GetSessionsByUserIDMock.expectedInvocationsOrigin, afterGetSessionsByUserIDCounter)
+	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeleteUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
 	}
 }
 
-type mCoreMockIsAdmin struct {
+type mCoreMockGetConsistencyReport struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockIsAdminExpectation
-	expectations       []*CoreMockIsAdminExpectation
+	defaultExpectation *CoreMockGetConsistencyReportExpectation
+	expectations       []*CoreMockGetConsistencyReportExpectation
 
-	callArgs []*CoreMockIsAdminParams
+	callArgs []*CoreMockGetConsistencyReportParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockIsAdminExpectation specifies expectation struct of the Core.IsAdmin
-type CoreMockIsAdminExpectation struct {
+// CoreMockGetConsistencyReportExpectation specifies expectation struct of the Core.GetConsistencyReport
+type CoreMockGetConsistencyReportExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockIsAdminParams
-	paramPtrs          *CoreMockIsAdminParamPtrs
-	expectationOrigins CoreMockIsAdminExpectationOrigins
-	results            *CoreMockIsAdminResults
+	params             *CoreMockGetConsistencyReportParams
+	paramPtrs          *CoreMockGetConsistencyReportParamPtrs
+	expectationOrigins CoreMockGetConsistencyReportExpectationOrigins
+	results            *CoreMockGetConsistencyReportResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockIsAdminParams contains parameters of the Core.IsAdmin
-type CoreMockIsAdminParams struct {
+// CoreMockGetConsistencyReportParams contains parameters of the Core.GetConsistencyReport
+type CoreMockGetConsistencyReportParams struct {
 	ctx context.Context
 }
 
-// CoreMockIsAdminParamPtrs contains pointers to parameters of the Core.IsAdmin
-type CoreMockIsAdminParamPtrs struct {
+// CoreMockGetConsistencyReportParamPtrs contains pointers to parameters of the Core.GetConsistencyReport
+type CoreMockGetConsistencyReportParamPtrs struct {
 	ctx *context.Context
 }
 
-// CoreMockIsAdminResults contains results of the Core.IsAdmin
-type CoreMockIsAdminResults struct {
-	b1  bool
+// CoreMockGetConsistencyReportResults contains results of the Core.GetConsistencyReport
+type CoreMockGetConsistencyReportResults struct {
+	c2  auth.ConsistencyReport
 	err error
 }
 
-// CoreMockIsAdminOrigins contains origins of expectations of the Core.IsAdmin
-type CoreMockIsAdminExpectationOrigins struct {
+// CoreMockGetConsistencyReportOrigins contains origins of expectations of the Core.GetConsistencyReport
+type CoreMockGetConsistencyReportExpectationOrigins struct {
 	origin    string
 	originCtx string
 }
@@ -2944,317 +3105,317 @@ type CoreMockIsAdminExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmIsAdmin *mCoreMockIsAdmin) Optional() *mCoreMockIsAdmin {
-	mmIsAdmin.optional = true
-	return mmIsAdmin
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Optional() *mCoreMockGetConsistencyReport {
+	mmGetConsistencyReport.optional = true
+	return mmGetConsistencyReport
 }
 
-// Expect sets up expected params for Core.IsAdmin
-func (mmIsAdmin *mCoreMockIsAdmin) Expect(ctx context.Context) *mCoreMockIsAdmin {
-	if mmIsAdmin.mock.funcIsAdmin != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+// Expect sets up expected params for Core.GetConsistencyReport
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Expect(ctx context.Context) *mCoreMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by Set")
 	}
 
-	if mmIsAdmin.defaultExpectation == nil {
-		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{}
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &CoreMockGetConsistencyReportExpectation{}
 	}
 
-	if mmIsAdmin.defaultExpectation.paramPtrs != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by ExpectParams functions")
+	if mmGetConsistencyReport.defaultExpectation.paramPtrs != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by ExpectParams functions")
 	}
 
-	mmIsAdmin.defaultExpectation.params = &CoreMockIsAdminParams{ctx}
-	mmIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmIsAdmin.expectations {
-		if minimock.Equal(e.params, mmIsAdmin.defaultExpectation.params) {
-			mmIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsAdmin.defaultExpectation.params)
+	mmGetConsistencyReport.defaultExpectation.params = &CoreMockGetConsistencyReportParams{ctx}
+	mmGetConsistencyReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetConsistencyReport.expectations {
+		if minimock.Equal(e.params, mmGetConsistencyReport.defaultExpectation.params) {
+			mmGetConsistencyReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetConsistencyReport.defaultExpectation.params)
 		}
 	}
 
-	return mmIsAdmin
+	return mmGetConsistencyReport
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.IsAdmin
-func (mmIsAdmin *mCoreMockIsAdmin) ExpectCtxParam1(ctx context.Context) *mCoreMockIsAdmin {
-	if mmIsAdmin.mock.funcIsAdmin != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.GetConsistencyReport
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) ExpectCtxParam1(ctx context.Context) *mCoreMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by Set")
 	}
 
-	if mmIsAdmin.defaultExpectation == nil {
-		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{}
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &CoreMockGetConsistencyReportExpectation{}
 	}
 
-	if mmIsAdmin.defaultExpectation.params != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Expect")
+	if mmGetConsistencyReport.defaultExpectation.params != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by Expect")
 	}
 
-	if mmIsAdmin.defaultExpectation.paramPtrs == nil {
-		mmIsAdmin.defaultExpectation.paramPtrs = &CoreMockIsAdminParamPtrs{}
+	if mmGetConsistencyReport.defaultExpectation.paramPtrs == nil {
+		mmGetConsistencyReport.defaultExpectation.paramPtrs = &CoreMockGetConsistencyReportParamPtrs{}
 	}
-	mmIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetConsistencyReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetConsistencyReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmIsAdmin
+	return mmGetConsistencyReport
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.IsAdmin
-func (mmIsAdmin *mCoreMockIsAdmin) Inspect(f func(ctx context.Context)) *mCoreMockIsAdmin {
-	if mmIsAdmin.mock.inspectFuncIsAdmin != nil {
-		mmIsAdmin.mock.t.Fatalf("Inspect function is already set for CoreMock.IsAdmin")
+// Inspect accepts an inspector function that has same arguments as the Core.GetConsistencyReport
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Inspect(f func(ctx context.Context)) *mCoreMockGetConsistencyReport {
+	if mmGetConsistencyReport.mock.inspectFuncGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("Inspect function is already set for CoreMock.GetConsistencyReport")
 	}
 
-	mmIsAdmin.mock.inspectFuncIsAdmin = f
+	mmGetConsistencyReport.mock.inspectFuncGetConsistencyReport = f
 
-	return mmIsAdmin
+	return mmGetConsistencyReport
 }
 
-// Return sets up results that will be returned by Core.IsAdmin
-func (mmIsAdmin *mCoreMockIsAdmin) Return(b1 bool, err error) *CoreMock {
-	if mmIsAdmin.mock.funcIsAdmin != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+// Return sets up results that will be returned by Core.GetConsistencyReport
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Return(c2 auth.ConsistencyReport, err error) *CoreMock {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by Set")
 	}
 
-	if mmIsAdmin.defaultExpectation == nil {
-		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{mock: mmIsAdmin.mock}
+	if mmGetConsistencyReport.defaultExpectation == nil {
+		mmGetConsistencyReport.defaultExpectation = &CoreMockGetConsistencyReportExpectation{mock: mmGetConsistencyReport.mock}
 	}
-	mmIsAdmin.defaultExpectation.results = &CoreMockIsAdminResults{b1, err}
-	mmIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmIsAdmin.mock
+	mmGetConsistencyReport.defaultExpectation.results = &CoreMockGetConsistencyReportResults{c2, err}
+	mmGetConsistencyReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport.mock
 }
 
-// Set uses given function f to mock the Core.IsAdmin method
-func (mmIsAdmin *mCoreMockIsAdmin) Set(f func(ctx context.Context) (b1 bool, err error)) *CoreMock {
-	if mmIsAdmin.defaultExpectation != nil {
-		mmIsAdmin.mock.t.Fatalf("Default expectation is already set for the Core.IsAdmin method")
+// Set uses given function f to mock the Core.GetConsistencyReport method
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Set(f func(ctx context.Context) (c2 auth.ConsistencyReport, err error)) *CoreMock {
+	if mmGetConsistencyReport.defaultExpectation != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("Default expectation is already set for the Core.GetConsistencyReport method")
 	}
 
-	if len(mmIsAdmin.expectations) > 0 {
-		mmIsAdmin.mock.t.Fatalf("Some expectations are already set for the Core.IsAdmin method")
+	if len(mmGetConsistencyReport.expectations) > 0 {
+		mmGetConsistencyReport.mock.t.Fatalf("Some expectations are already set for the Core.GetConsistencyReport method")
 	}
 
-	mmIsAdmin.mock.funcIsAdmin = f
-	mmIsAdmin.mock.funcIsAdminOrigin = minimock.CallerInfo(1)
-	return mmIsAdmin.mock
+	mmGetConsistencyReport.mock.funcGetConsistencyReport = f
+	mmGetConsistencyReport.mock.funcGetConsistencyReportOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport.mock
 }
 
-// When sets expectation for the Core.IsAdmin which will trigger the result defined by the following
+// When sets expectation for the Core.GetConsistencyReport which will trigger the result defined by the following
 // Then helper
-func (mmIsAdmin *mCoreMockIsAdmin) When(ctx context.Context) *CoreMockIsAdminExpectation {
-	if mmIsAdmin.mock.funcIsAdmin != nil {
-		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) When(ctx context.Context) *CoreMockGetConsistencyReportExpectation {
+	if mmGetConsistencyReport.mock.funcGetConsistencyReport != nil {
+		mmGetConsistencyReport.mock.t.Fatalf("CoreMock.GetConsistencyReport mock is already set by Set")
 	}
 
-	expectation := &CoreMockIsAdminExpectation{
-		mock:               mmIsAdmin.mock,
-		params:             &CoreMockIsAdminParams{ctx},
-		expectationOrigins: CoreMockIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockGetConsistencyReportExpectation{
+		mock:               mmGetConsistencyReport.mock,
+		params:             &CoreMockGetConsistencyReportParams{ctx},
+		expectationOrigins: CoreMockGetConsistencyReportExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmIsAdmin.expectations = append(mmIsAdmin.expectations, expectation)
+	mmGetConsistencyReport.expectations = append(mmGetConsistencyReport.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.IsAdmin return parameters for the expectation previously defined by the When method
-func (e *CoreMockIsAdminExpectation) Then(b1 bool, err error) *CoreMock {
-	e.results = &CoreMockIsAdminResults{b1, err}
+// Then sets up Core.GetConsistencyReport return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetConsistencyReportExpectation) Then(c2 auth.ConsistencyReport, err error) *CoreMock {
+	e.results = &CoreMockGetConsistencyReportResults{c2, err}
 	return e.mock
 }
 
-// Times sets number of times Core.IsAdmin should be invoked
-func (mmIsAdmin *mCoreMockIsAdmin) Times(n uint64) *mCoreMockIsAdmin {
+// Times sets number of times Core.GetConsistencyReport should be invoked
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Times(n uint64) *mCoreMockGetConsistencyReport {
 	if n == 0 {
-		mmIsAdmin.mock.t.Fatalf("Times of CoreMock.IsAdmin mock can not be zero")
+		mmGetConsistencyReport.mock.t.Fatalf("Times of CoreMock.GetConsistencyReport mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmIsAdmin.expectedInvocations, n)
-	mmIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmIsAdmin
+	mm_atomic.StoreUint64(&mmGetConsistencyReport.expectedInvocations, n)
+	mmGetConsistencyReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetConsistencyReport
 }
 
-func (mmIsAdmin *mCoreMockIsAdmin) invocationsDone() bool {
-	if len(mmIsAdmin.expectations) == 0 && mmIsAdmin.defaultExpectation == nil && mmIsAdmin.mock.funcIsAdmin == nil {
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) invocationsDone() bool {
+	if len(mmGetConsistencyReport.expectations) == 0 && mmGetConsistencyReport.defaultExpectation == nil && mmGetConsistencyReport.mock.funcGetConsistencyReport == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmIsAdmin.mock.afterIsAdminCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmIsAdmin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetConsistencyReport.mock.afterGetConsistencyReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetConsistencyReport.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// IsAdmin implements mm_usecase.Core
-func (mmIsAdmin *CoreMock) IsAdmin(ctx context.Context) (b1 bool, err error) {
-	mm_atomic.AddUint64(&mmIsAdmin.beforeIsAdminCounter, 1)
-	defer mm_atomic.AddUint64(&mmIsAdmin.afterIsAdminCounter, 1)
+// GetConsistencyReport implements mm_usecase.Core
+func (mmGetConsistencyReport *CoreMock) GetConsistencyReport(ctx context.Context) (c2 auth.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmGetConsistencyReport.beforeGetConsistencyReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetConsistencyReport.afterGetConsistencyReportCounter, 1)
 
-	mmIsAdmin.t.Helper()
+	mmGetConsistencyReport.t.Helper()
 
-	if mmIsAdmin.inspectFuncIsAdmin != nil {
-		mmIsAdmin.inspectFuncIsAdmin(ctx)
+	if mmGetConsistencyReport.inspectFuncGetConsistencyReport != nil {
+		mmGetConsistencyReport.inspectFuncGetConsistencyReport(ctx)
 	}
 
-	mm_params := CoreMockIsAdminParams{ctx}
+	mm_params := CoreMockGetConsistencyReportParams{ctx}
 
 	// Record call args
-	mmIsAdmin.IsAdminMock.mutex.Lock()
-	mmIsAdmin.IsAdminMock.callArgs = append(mmIsAdmin.IsAdminMock.callArgs, &mm_params)
-	mmIsAdmin.IsAdminMock.mutex.Unlock()
+	mmGetConsistencyReport.GetConsistencyReportMock.mutex.Lock()
+	mmGetConsistencyReport.GetConsistencyReportMock.callArgs = append(mmGetConsistencyReport.GetConsistencyReportMock.callArgs, &mm_params)
+	mmGetConsistencyReport.GetConsistencyReportMock.mutex.Unlock()
 
-	for _, e := range mmIsAdmin.IsAdminMock.expectations {
+	for _, e := range mmGetConsistencyReport.GetConsistencyReportMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.b1, e.results.err
+			return e.results.c2, e.results.err
 		}
 	}
 
-	if mmIsAdmin.IsAdminMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmIsAdmin.IsAdminMock.defaultExpectation.Counter, 1)
-		mm_want := mmIsAdmin.IsAdminMock.defaultExpectation.params
-		mm_want_ptrs := mmIsAdmin.IsAdminMock.defaultExpectation.paramPtrs
+	if mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.params
+		mm_want_ptrs := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockIsAdminParams{ctx}
+		mm_got := CoreMockGetConsistencyReportParams{ctx}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmIsAdmin.t.Errorf("CoreMock.IsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmIsAdmin.IsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetConsistencyReport.t.Errorf("CoreMock.GetConsistencyReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmIsAdmin.t.Errorf("CoreMock.IsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmIsAdmin.IsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetConsistencyReport.t.Errorf("CoreMock.GetConsistencyReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmIsAdmin.IsAdminMock.defaultExpectation.results
+		mm_results := mmGetConsistencyReport.GetConsistencyReportMock.defaultExpectation.results
 		if mm_results == nil {
-			mmIsAdmin.t.Fatal("No results are set for the CoreMock.IsAdmin")
+			mmGetConsistencyReport.t.Fatal("No results are set for the CoreMock.GetConsistencyReport")
 		}
-		return (*mm_results).b1, (*mm_results).err
+		return (*mm_results).c2, (*mm_results).err
 	}
-	if mmIsAdmin.funcIsAdmin != nil {
-		return mmIsAdmin.funcIsAdmin(ctx)
+	if mmGetConsistencyReport.funcGetConsistencyReport != nil {
+		return mmGetConsistencyReport.funcGetConsistencyReport(ctx)
 	}
-	mmIsAdmin.t.Fatalf("Unexpected call to CoreMock.IsAdmin. %v", ctx)
+	mmGetConsistencyReport.t.Fatalf("Unexpected call to CoreMock.GetConsistencyReport. %v", ctx)
 	return
 }
 
-// IsAdminAfterCounter returns a count of finished CoreMock.IsAdmin invocations
-func (mmIsAdmin *CoreMock) IsAdminAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmIsAdmin.afterIsAdminCounter)
+// GetConsistencyReportAfterCounter returns a count of finished CoreMock.GetConsistencyReport invocations
+func (mmGetConsistencyReport *CoreMock) GetConsistencyReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConsistencyReport.afterGetConsistencyReportCounter)
 }
 
-// IsAdminBeforeCounter returns a count of CoreMock.IsAdmin invocations
-func (mmIsAdmin *CoreMock) IsAdminBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmIsAdmin.beforeIsAdminCounter)
+// GetConsistencyReportBeforeCounter returns a count of CoreMock.GetConsistencyReport invocations
+func (mmGetConsistencyReport *CoreMock) GetConsistencyReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetConsistencyReport.beforeGetConsistencyReportCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.IsAdmin.
+// Calls returns a list of arguments used in each call to CoreMock.GetConsistencyReport.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmIsAdmin *mCoreMockIsAdmin) Calls() []*CoreMockIsAdminParams {
-	mmIsAdmin.mutex.RLock()
+func (mmGetConsistencyReport *mCoreMockGetConsistencyReport) Calls() []*CoreMockGetConsistencyReportParams {
+	mmGetConsistencyReport.mutex.RLock()
 
-	argCopy := make([]*CoreMockIsAdminParams, len(mmIsAdmin.callArgs))
-	copy(argCopy, mmIsAdmin.callArgs)
+	argCopy := make([]*CoreMockGetConsistencyReportParams, len(mmGetConsistencyReport.callArgs))
+	copy(argCopy, mmGetConsistencyReport.callArgs)
 
-	mmIsAdmin.mutex.RUnlock()
+	mmGetConsistencyReport.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockIsAdminDone returns true if the count of the IsAdmin invocations corresponds
+// MinimockGetConsistencyReportDone returns true if the count of the GetConsistencyReport invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockIsAdminDone() bool {
-	if m.IsAdminMock.optional {
+func (m *CoreMock) MinimockGetConsistencyReportDone() bool {
+	if m.GetConsistencyReportMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.IsAdminMock.expectations {
+	for _, e := range m.GetConsistencyReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.IsAdminMock.invocationsDone()
+	return m.GetConsistencyReportMock.invocationsDone()
 }
 
-// MinimockIsAdminInspect logs each unmet expectation
-func (m *CoreMock) MinimockIsAdminInspect() {
-	for _, e := range m.IsAdminMock.expectations {
+// MinimockGetConsistencyReportInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetConsistencyReportInspect() {
+	for _, e := range m.GetConsistencyReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.GetConsistencyReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterIsAdminCounter := mm_atomic.LoadUint64(&m.afterIsAdminCounter)
+	afterGetConsistencyReportCounter := mm_atomic.LoadUint64(&m.afterGetConsistencyReportCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.IsAdminMock.defaultExpectation != nil && afterIsAdminCounter < 1 {
-		if m.IsAdminMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s", m.IsAdminMock.defaultExpectation.returnOrigin)
+	if m.GetConsistencyReportMock.defaultExpectation != nil && afterGetConsistencyReportCounter < 1 {
+		if m.GetConsistencyReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetConsistencyReport at\n%s", m.GetConsistencyReportMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s with params: %#v", m.IsAdminMock.defaultExpectation.expectationOrigins.origin, *m.IsAdminMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.GetConsistencyReport at\n%s with params: %#v", m.GetConsistencyReportMock.defaultExpectation.expectationOrigins.origin, *m.GetConsistencyReportMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcIsAdmin != nil && afterIsAdminCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s", m.funcIsAdminOrigin)
+	if m.funcGetConsistencyReport != nil && afterGetConsistencyReportCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetConsistencyReport at\n%s", m.funcGetConsistencyReportOrigin)
 	}
 
-	if !m.IsAdminMock.invocationsDone() && afterIsAdminCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.IsAdmin at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.IsAdminMock.expectedInvocations), m.IsAdminMock.expectedInvocationsOrigin, afterIsAdminCounter)
+	if !m.GetConsistencyReportMock.invocationsDone() && afterGetConsistencyReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetConsistencyReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetConsistencyReportMock.expectedInvocations), m.GetConsistencyReportMock.expectedInvocationsOrigin, afterGetConsistencyReportCounter)
 	}
 }
 
-type mCoreMockIssueTokens struct {
+type mCoreMockGetLoginHistory struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockIssueTokensExpectation
-	expectations       []*CoreMockIssueTokensExpectation
+	defaultExpectation *CoreMockGetLoginHistoryExpectation
+	expectations       []*CoreMockGetLoginHistoryExpectation
 
-	callArgs []*CoreMockIssueTokensParams
+	callArgs []*CoreMockGetLoginHistoryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockIssueTokensExpectation specifies expectation struct of the Core.IssueTokens
-type CoreMockIssueTokensExpectation struct {
+// CoreMockGetLoginHistoryExpectation specifies expectation struct of the Core.GetLoginHistory
+type CoreMockGetLoginHistoryExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockIssueTokensParams
-	paramPtrs          *CoreMockIssueTokensParamPtrs
-	expectationOrigins CoreMockIssueTokensExpectationOrigins
-	results            *CoreMockIssueTokensResults
+	params             *CoreMockGetLoginHistoryParams
+	paramPtrs          *CoreMockGetLoginHistoryParamPtrs
+	expectationOrigins CoreMockGetLoginHistoryExpectationOrigins
+	results            *CoreMockGetLoginHistoryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockIssueTokensParams contains parameters of the Core.IssueTokens
-type CoreMockIssueTokensParams struct {
-	ctx            context.Context
-	userID         uuid.UUID
-	sessionVersion int
+// CoreMockGetLoginHistoryParams contains parameters of the Core.GetLoginHistory
+type CoreMockGetLoginHistoryParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	limit  int
 }
 
-// CoreMockIssueTokensParamPtrs contains pointers to parameters of the Core.IssueTokens
-type CoreMockIssueTokensParamPtrs struct {
-	ctx            *context.Context
-	userID         *uuid.UUID
-	sessionVersion *int
+// CoreMockGetLoginHistoryParamPtrs contains pointers to parameters of the Core.GetLoginHistory
+type CoreMockGetLoginHistoryParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	limit  *int
 }
 
-// CoreMockIssueTokensResults contains results of the Core.IssueTokens
-type CoreMockIssueTokensResults struct {
-	t1  auth.Tokens
+// CoreMockGetLoginHistoryResults contains results of the Core.GetLoginHistory
+type CoreMockGetLoginHistoryResults struct {
+	la1 []auth.LoginEvent
 	err error
 }
 
-// CoreMockIssueTokensOrigins contains origins of expectations of the Core.IssueTokens
-type CoreMockIssueTokensExpectationOrigins struct {
-	origin               string
-	originCtx            string
-	originUserID         string
-	originSessionVersion string
+// CoreMockGetLoginHistoryOrigins contains origins of expectations of the Core.GetLoginHistory
+type CoreMockGetLoginHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originLimit  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -3262,370 +3423,4049 @@ type CoreMockIssueTokensExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmIssueTokens *mCoreMockIssueTokens) Optional() *mCoreMockIssueTokens {
-	mmIssueTokens.optional = true
-	return mmIssueTokens
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Optional() *mCoreMockGetLoginHistory {
+	mmGetLoginHistory.optional = true
+	return mmGetLoginHistory
 }
 
-// Expect sets up expected params for Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) Expect(ctx context.Context, userID uuid.UUID, sessionVersion int) *mCoreMockIssueTokens {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+// Expect sets up expected params for Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Expect(ctx context.Context, userID uuid.UUID, limit int) *mCoreMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
 	}
 
-	if mmIssueTokens.defaultExpectation == nil {
-		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &CoreMockGetLoginHistoryExpectation{}
 	}
 
-	if mmIssueTokens.defaultExpectation.paramPtrs != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by ExpectParams functions")
+	if mmGetLoginHistory.defaultExpectation.paramPtrs != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by ExpectParams functions")
 	}
 
-	mmIssueTokens.defaultExpectation.params = &CoreMockIssueTokensParams{ctx, userID, sessionVersion}
-	mmIssueTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmIssueTokens.expectations {
-		if minimock.Equal(e.params, mmIssueTokens.defaultExpectation.params) {
-			mmIssueTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueTokens.defaultExpectation.params)
+	mmGetLoginHistory.defaultExpectation.params = &CoreMockGetLoginHistoryParams{ctx, userID, limit}
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLoginHistory.expectations {
+		if minimock.Equal(e.params, mmGetLoginHistory.defaultExpectation.params) {
+			mmGetLoginHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLoginHistory.defaultExpectation.params)
 		}
 	}
 
-	return mmIssueTokens
+	return mmGetLoginHistory
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) ExpectCtxParam1(ctx context.Context) *mCoreMockIssueTokens {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) ExpectCtxParam1(ctx context.Context) *mCoreMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
 	}
 
-	if mmIssueTokens.defaultExpectation == nil {
-		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &CoreMockGetLoginHistoryExpectation{}
 	}
 
-	if mmIssueTokens.defaultExpectation.params != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Expect")
 	}
 
-	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
-		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &CoreMockGetLoginHistoryParamPtrs{}
 	}
-	mmIssueTokens.defaultExpectation.paramPtrs.ctx = &ctx
-	mmIssueTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetLoginHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmIssueTokens
+	return mmGetLoginHistory
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockIssueTokens {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
 	}
 
-	if mmIssueTokens.defaultExpectation == nil {
-		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &CoreMockGetLoginHistoryExpectation{}
 	}
 
-	if mmIssueTokens.defaultExpectation.params != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Expect")
 	}
 
-	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
-		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &CoreMockGetLoginHistoryParamPtrs{}
 	}
-	mmIssueTokens.defaultExpectation.paramPtrs.userID = &userID
-	mmIssueTokens.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmGetLoginHistory.defaultExpectation.paramPtrs.userID = &userID
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmIssueTokens
+	return mmGetLoginHistory
 }
 
-// ExpectSessionVersionParam3 sets up expected param sessionVersion for Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) ExpectSessionVersionParam3(sessionVersion int) *mCoreMockIssueTokens {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+// ExpectLimitParam3 sets up expected param limit for Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) ExpectLimitParam3(limit int) *mCoreMockGetLoginHistory {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
 	}
 
-	if mmIssueTokens.defaultExpectation == nil {
-		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &CoreMockGetLoginHistoryExpectation{}
 	}
 
-	if mmIssueTokens.defaultExpectation.params != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	if mmGetLoginHistory.defaultExpectation.params != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Expect")
 	}
 
-	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
-		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	if mmGetLoginHistory.defaultExpectation.paramPtrs == nil {
+		mmGetLoginHistory.defaultExpectation.paramPtrs = &CoreMockGetLoginHistoryParamPtrs{}
 	}
-	mmIssueTokens.defaultExpectation.paramPtrs.sessionVersion = &sessionVersion
-	mmIssueTokens.defaultExpectation.expectationOrigins.originSessionVersion = minimock.CallerInfo(1)
+	mmGetLoginHistory.defaultExpectation.paramPtrs.limit = &limit
+	mmGetLoginHistory.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
 
-	return mmIssueTokens
+	return mmGetLoginHistory
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) Inspect(f func(ctx context.Context, userID uuid.UUID, sessionVersion int)) *mCoreMockIssueTokens {
-	if mmIssueTokens.mock.inspectFuncIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("Inspect function is already set for CoreMock.IssueTokens")
+// Inspect accepts an inspector function that has same arguments as the Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Inspect(f func(ctx context.Context, userID uuid.UUID, limit int)) *mCoreMockGetLoginHistory {
+	if mmGetLoginHistory.mock.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Inspect function is already set for CoreMock.GetLoginHistory")
 	}
 
-	mmIssueTokens.mock.inspectFuncIssueTokens = f
+	mmGetLoginHistory.mock.inspectFuncGetLoginHistory = f
 
-	return mmIssueTokens
+	return mmGetLoginHistory
 }
 
-// Return sets up results that will be returned by Core.IssueTokens
-func (mmIssueTokens *mCoreMockIssueTokens) Return(t1 auth.Tokens, err error) *CoreMock {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+// Return sets up results that will be returned by Core.GetLoginHistory
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Return(la1 []auth.LoginEvent, err error) *CoreMock {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
 	}
 
-	if mmIssueTokens.defaultExpectation == nil {
-		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{mock: mmIssueTokens.mock}
+	if mmGetLoginHistory.defaultExpectation == nil {
+		mmGetLoginHistory.defaultExpectation = &CoreMockGetLoginHistoryExpectation{mock: mmGetLoginHistory.mock}
 	}
-	mmIssueTokens.defaultExpectation.results = &CoreMockIssueTokensResults{t1, err}
-	mmIssueTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmIssueTokens.mock
+	mmGetLoginHistory.defaultExpectation.results = &CoreMockGetLoginHistoryResults{la1, err}
+	mmGetLoginHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// Set uses given function f to mock the Core.GetLoginHistory method
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Set(f func(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error)) *CoreMock {
+	if mmGetLoginHistory.defaultExpectation != nil {
+		mmGetLoginHistory.mock.t.Fatalf("Default expectation is already set for the Core.GetLoginHistory method")
+	}
+
+	if len(mmGetLoginHistory.expectations) > 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Some expectations are already set for the Core.GetLoginHistory method")
+	}
+
+	mmGetLoginHistory.mock.funcGetLoginHistory = f
+	mmGetLoginHistory.mock.funcGetLoginHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory.mock
+}
+
+// When sets expectation for the Core.GetLoginHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) When(ctx context.Context, userID uuid.UUID, limit int) *CoreMockGetLoginHistoryExpectation {
+	if mmGetLoginHistory.mock.funcGetLoginHistory != nil {
+		mmGetLoginHistory.mock.t.Fatalf("CoreMock.GetLoginHistory mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetLoginHistoryExpectation{
+		mock:               mmGetLoginHistory.mock,
+		params:             &CoreMockGetLoginHistoryParams{ctx, userID, limit},
+		expectationOrigins: CoreMockGetLoginHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLoginHistory.expectations = append(mmGetLoginHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetLoginHistory return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetLoginHistoryExpectation) Then(la1 []auth.LoginEvent, err error) *CoreMock {
+	e.results = &CoreMockGetLoginHistoryResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetLoginHistory should be invoked
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Times(n uint64) *mCoreMockGetLoginHistory {
+	if n == 0 {
+		mmGetLoginHistory.mock.t.Fatalf("Times of CoreMock.GetLoginHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLoginHistory.expectedInvocations, n)
+	mmGetLoginHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLoginHistory
+}
+
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) invocationsDone() bool {
+	if len(mmGetLoginHistory.expectations) == 0 && mmGetLoginHistory.defaultExpectation == nil && mmGetLoginHistory.mock.funcGetLoginHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.mock.afterGetLoginHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLoginHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLoginHistory implements mm_usecase.Core
+func (mmGetLoginHistory *CoreMock) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) (la1 []auth.LoginEvent, err error) {
+	mm_atomic.AddUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter, 1)
+
+	mmGetLoginHistory.t.Helper()
+
+	if mmGetLoginHistory.inspectFuncGetLoginHistory != nil {
+		mmGetLoginHistory.inspectFuncGetLoginHistory(ctx, userID, limit)
+	}
+
+	mm_params := CoreMockGetLoginHistoryParams{ctx, userID, limit}
+
+	// Record call args
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Lock()
+	mmGetLoginHistory.GetLoginHistoryMock.callArgs = append(mmGetLoginHistory.GetLoginHistoryMock.callArgs, &mm_params)
+	mmGetLoginHistory.GetLoginHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetLoginHistory.GetLoginHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetLoginHistoryParams{ctx, userID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLoginHistory.t.Errorf("CoreMock.GetLoginHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetLoginHistory.t.Errorf("CoreMock.GetLoginHistory got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetLoginHistory.t.Errorf("CoreMock.GetLoginHistory got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLoginHistory.t.Errorf("CoreMock.GetLoginHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLoginHistory.GetLoginHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLoginHistory.t.Fatal("No results are set for the CoreMock.GetLoginHistory")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmGetLoginHistory.funcGetLoginHistory != nil {
+		return mmGetLoginHistory.funcGetLoginHistory(ctx, userID, limit)
+	}
+	mmGetLoginHistory.t.Fatalf("Unexpected call to CoreMock.GetLoginHistory. %v %v %v", ctx, userID, limit)
+	return
+}
+
+// GetLoginHistoryAfterCounter returns a count of finished CoreMock.GetLoginHistory invocations
+func (mmGetLoginHistory *CoreMock) GetLoginHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.afterGetLoginHistoryCounter)
+}
+
+// GetLoginHistoryBeforeCounter returns a count of CoreMock.GetLoginHistory invocations
+func (mmGetLoginHistory *CoreMock) GetLoginHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLoginHistory.beforeGetLoginHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetLoginHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLoginHistory *mCoreMockGetLoginHistory) Calls() []*CoreMockGetLoginHistoryParams {
+	mmGetLoginHistory.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetLoginHistoryParams, len(mmGetLoginHistory.callArgs))
+	copy(argCopy, mmGetLoginHistory.callArgs)
+
+	mmGetLoginHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLoginHistoryDone returns true if the count of the GetLoginHistory invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetLoginHistoryDone() bool {
+	if m.GetLoginHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLoginHistoryMock.invocationsDone()
+}
+
+// MinimockGetLoginHistoryInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetLoginHistoryInspect() {
+	for _, e := range m.GetLoginHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetLoginHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLoginHistoryCounter := mm_atomic.LoadUint64(&m.afterGetLoginHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLoginHistoryMock.defaultExpectation != nil && afterGetLoginHistoryCounter < 1 {
+		if m.GetLoginHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetLoginHistory at\n%s", m.GetLoginHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetLoginHistory at\n%s with params: %#v", m.GetLoginHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetLoginHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLoginHistory != nil && afterGetLoginHistoryCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetLoginHistory at\n%s", m.funcGetLoginHistoryOrigin)
+	}
+
+	if !m.GetLoginHistoryMock.invocationsDone() && afterGetLoginHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetLoginHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLoginHistoryMock.expectedInvocations), m.GetLoginHistoryMock.expectedInvocationsOrigin, afterGetLoginHistoryCounter)
+	}
+}
+
+type mCoreMockGetPreset struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetPresetExpectation
+	expectations       []*CoreMockGetPresetExpectation
+
+	callArgs []*CoreMockGetPresetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetPresetExpectation specifies expectation struct of the Core.GetPreset
+type CoreMockGetPresetExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetPresetParams
+	paramPtrs          *CoreMockGetPresetParamPtrs
+	expectationOrigins CoreMockGetPresetExpectationOrigins
+	results            *CoreMockGetPresetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetPresetParams contains parameters of the Core.GetPreset
+type CoreMockGetPresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockGetPresetParamPtrs contains pointers to parameters of the Core.GetPreset
+type CoreMockGetPresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockGetPresetResults contains results of the Core.GetPreset
+type CoreMockGetPresetResults struct {
+	r1  auth.RolePreset
+	err error
+}
+
+// CoreMockGetPresetOrigins contains origins of expectations of the Core.GetPreset
+type CoreMockGetPresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPreset *mCoreMockGetPreset) Optional() *mCoreMockGetPreset {
+	mmGetPreset.optional = true
+	return mmGetPreset
+}
+
+// Expect sets up expected params for Core.GetPreset
+func (mmGetPreset *mCoreMockGetPreset) Expect(ctx context.Context, id uuid.UUID) *mCoreMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &CoreMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by ExpectParams functions")
+	}
+
+	mmGetPreset.defaultExpectation.params = &CoreMockGetPresetParams{ctx, id}
+	mmGetPreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreset.expectations {
+		if minimock.Equal(e.params, mmGetPreset.defaultExpectation.params) {
+			mmGetPreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreset.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPreset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetPreset
+func (mmGetPreset *mCoreMockGetPreset) ExpectCtxParam1(ctx context.Context) *mCoreMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &CoreMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Expect")
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &CoreMockGetPresetParamPtrs{}
+	}
+	mmGetPreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPreset
+}
+
+// ExpectIdParam2 sets up expected param id for Core.GetPreset
+func (mmGetPreset *mCoreMockGetPreset) ExpectIdParam2(id uuid.UUID) *mCoreMockGetPreset {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &CoreMockGetPresetExpectation{}
+	}
+
+	if mmGetPreset.defaultExpectation.params != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Expect")
+	}
+
+	if mmGetPreset.defaultExpectation.paramPtrs == nil {
+		mmGetPreset.defaultExpectation.paramPtrs = &CoreMockGetPresetParamPtrs{}
+	}
+	mmGetPreset.defaultExpectation.paramPtrs.id = &id
+	mmGetPreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetPreset
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetPreset
+func (mmGetPreset *mCoreMockGetPreset) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockGetPreset {
+	if mmGetPreset.mock.inspectFuncGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("Inspect function is already set for CoreMock.GetPreset")
+	}
+
+	mmGetPreset.mock.inspectFuncGetPreset = f
+
+	return mmGetPreset
+}
+
+// Return sets up results that will be returned by Core.GetPreset
+func (mmGetPreset *mCoreMockGetPreset) Return(r1 auth.RolePreset, err error) *CoreMock {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Set")
+	}
+
+	if mmGetPreset.defaultExpectation == nil {
+		mmGetPreset.defaultExpectation = &CoreMockGetPresetExpectation{mock: mmGetPreset.mock}
+	}
+	mmGetPreset.defaultExpectation.results = &CoreMockGetPresetResults{r1, err}
+	mmGetPreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
+}
+
+// Set uses given function f to mock the Core.GetPreset method
+func (mmGetPreset *mCoreMockGetPreset) Set(f func(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error)) *CoreMock {
+	if mmGetPreset.defaultExpectation != nil {
+		mmGetPreset.mock.t.Fatalf("Default expectation is already set for the Core.GetPreset method")
+	}
+
+	if len(mmGetPreset.expectations) > 0 {
+		mmGetPreset.mock.t.Fatalf("Some expectations are already set for the Core.GetPreset method")
+	}
+
+	mmGetPreset.mock.funcGetPreset = f
+	mmGetPreset.mock.funcGetPresetOrigin = minimock.CallerInfo(1)
+	return mmGetPreset.mock
+}
+
+// When sets expectation for the Core.GetPreset which will trigger the result defined by the following
+// Then helper
+func (mmGetPreset *mCoreMockGetPreset) When(ctx context.Context, id uuid.UUID) *CoreMockGetPresetExpectation {
+	if mmGetPreset.mock.funcGetPreset != nil {
+		mmGetPreset.mock.t.Fatalf("CoreMock.GetPreset mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetPresetExpectation{
+		mock:               mmGetPreset.mock,
+		params:             &CoreMockGetPresetParams{ctx, id},
+		expectationOrigins: CoreMockGetPresetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPreset.expectations = append(mmGetPreset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetPreset return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetPresetExpectation) Then(r1 auth.RolePreset, err error) *CoreMock {
+	e.results = &CoreMockGetPresetResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetPreset should be invoked
+func (mmGetPreset *mCoreMockGetPreset) Times(n uint64) *mCoreMockGetPreset {
+	if n == 0 {
+		mmGetPreset.mock.t.Fatalf("Times of CoreMock.GetPreset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPreset.expectedInvocations, n)
+	mmGetPreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreset
+}
+
+func (mmGetPreset *mCoreMockGetPreset) invocationsDone() bool {
+	if len(mmGetPreset.expectations) == 0 && mmGetPreset.defaultExpectation == nil && mmGetPreset.mock.funcGetPreset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreset.mock.afterGetPresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPreset implements mm_usecase.Core
+func (mmGetPreset *CoreMock) GetPreset(ctx context.Context, id uuid.UUID) (r1 auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmGetPreset.beforeGetPresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreset.afterGetPresetCounter, 1)
+
+	mmGetPreset.t.Helper()
+
+	if mmGetPreset.inspectFuncGetPreset != nil {
+		mmGetPreset.inspectFuncGetPreset(ctx, id)
+	}
+
+	mm_params := CoreMockGetPresetParams{ctx, id}
+
+	// Record call args
+	mmGetPreset.GetPresetMock.mutex.Lock()
+	mmGetPreset.GetPresetMock.callArgs = append(mmGetPreset.GetPresetMock.callArgs, &mm_params)
+	mmGetPreset.GetPresetMock.mutex.Unlock()
+
+	for _, e := range mmGetPreset.GetPresetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetPreset.GetPresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreset.GetPresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreset.GetPresetMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreset.GetPresetMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetPresetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPreset.t.Errorf("CoreMock.GetPreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPreset.t.Errorf("CoreMock.GetPreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPreset.t.Errorf("CoreMock.GetPreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreset.GetPresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPreset.GetPresetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPreset.t.Fatal("No results are set for the CoreMock.GetPreset")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetPreset.funcGetPreset != nil {
+		return mmGetPreset.funcGetPreset(ctx, id)
+	}
+	mmGetPreset.t.Fatalf("Unexpected call to CoreMock.GetPreset. %v %v", ctx, id)
+	return
+}
+
+// GetPresetAfterCounter returns a count of finished CoreMock.GetPreset invocations
+func (mmGetPreset *CoreMock) GetPresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.afterGetPresetCounter)
+}
+
+// GetPresetBeforeCounter returns a count of CoreMock.GetPreset invocations
+func (mmGetPreset *CoreMock) GetPresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreset.beforeGetPresetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetPreset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPreset *mCoreMockGetPreset) Calls() []*CoreMockGetPresetParams {
+	mmGetPreset.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetPresetParams, len(mmGetPreset.callArgs))
+	copy(argCopy, mmGetPreset.callArgs)
+
+	mmGetPreset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresetDone returns true if the count of the GetPreset invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetPresetDone() bool {
+	if m.GetPresetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresetMock.invocationsDone()
+}
+
+// MinimockGetPresetInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetPresetInspect() {
+	for _, e := range m.GetPresetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetPreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPresetCounter := mm_atomic.LoadUint64(&m.afterGetPresetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresetMock.defaultExpectation != nil && afterGetPresetCounter < 1 {
+		if m.GetPresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetPreset at\n%s", m.GetPresetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetPreset at\n%s with params: %#v", m.GetPresetMock.defaultExpectation.expectationOrigins.origin, *m.GetPresetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPreset != nil && afterGetPresetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetPreset at\n%s", m.funcGetPresetOrigin)
+	}
+
+	if !m.GetPresetMock.invocationsDone() && afterGetPresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetPreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresetMock.expectedInvocations), m.GetPresetMock.expectedInvocationsOrigin, afterGetPresetCounter)
+	}
+}
+
+type mCoreMockGetSessionByID struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetSessionByIDExpectation
+	expectations       []*CoreMockGetSessionByIDExpectation
+
+	callArgs []*CoreMockGetSessionByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetSessionByIDExpectation specifies expectation struct of the Core.GetSessionByID
+type CoreMockGetSessionByIDExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetSessionByIDParams
+	paramPtrs          *CoreMockGetSessionByIDParamPtrs
+	expectationOrigins CoreMockGetSessionByIDExpectationOrigins
+	results            *CoreMockGetSessionByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetSessionByIDParams contains parameters of the Core.GetSessionByID
+type CoreMockGetSessionByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockGetSessionByIDParamPtrs contains pointers to parameters of the Core.GetSessionByID
+type CoreMockGetSessionByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockGetSessionByIDResults contains results of the Core.GetSessionByID
+type CoreMockGetSessionByIDResults struct {
+	s1  auth.Session
+	s2  string
+	err error
+}
+
+// CoreMockGetSessionByIDOrigins contains origins of expectations of the Core.GetSessionByID
+type CoreMockGetSessionByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSessionByID *mCoreMockGetSessionByID) Optional() *mCoreMockGetSessionByID {
+	mmGetSessionByID.optional = true
+	return mmGetSessionByID
+}
+
+// Expect sets up expected params for Core.GetSessionByID
+func (mmGetSessionByID *mCoreMockGetSessionByID) Expect(ctx context.Context, id uuid.UUID) *mCoreMockGetSessionByID {
+	if mmGetSessionByID.mock.funcGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+	}
+
+	if mmGetSessionByID.defaultExpectation == nil {
+		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	}
+
+	if mmGetSessionByID.defaultExpectation.paramPtrs != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetSessionByID.defaultExpectation.params = &CoreMockGetSessionByIDParams{ctx, id}
+	mmGetSessionByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSessionByID.expectations {
+		if minimock.Equal(e.params, mmGetSessionByID.defaultExpectation.params) {
+			mmGetSessionByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSessionByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetSessionByID
+func (mmGetSessionByID *mCoreMockGetSessionByID) ExpectCtxParam1(ctx context.Context) *mCoreMockGetSessionByID {
+	if mmGetSessionByID.mock.funcGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+	}
+
+	if mmGetSessionByID.defaultExpectation == nil {
+		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	}
+
+	if mmGetSessionByID.defaultExpectation.params != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Expect")
+	}
+
+	if mmGetSessionByID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionByID.defaultExpectation.paramPtrs = &CoreMockGetSessionByIDParamPtrs{}
+	}
+	mmGetSessionByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSessionByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSessionByID
+}
+
+// ExpectIdParam2 sets up expected param id for Core.GetSessionByID
+func (mmGetSessionByID *mCoreMockGetSessionByID) ExpectIdParam2(id uuid.UUID) *mCoreMockGetSessionByID {
+	if mmGetSessionByID.mock.funcGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+	}
+
+	if mmGetSessionByID.defaultExpectation == nil {
+		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{}
+	}
+
+	if mmGetSessionByID.defaultExpectation.params != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Expect")
+	}
+
+	if mmGetSessionByID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionByID.defaultExpectation.paramPtrs = &CoreMockGetSessionByIDParamPtrs{}
+	}
+	mmGetSessionByID.defaultExpectation.paramPtrs.id = &id
+	mmGetSessionByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetSessionByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetSessionByID
+func (mmGetSessionByID *mCoreMockGetSessionByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockGetSessionByID {
+	if mmGetSessionByID.mock.inspectFuncGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("Inspect function is already set for CoreMock.GetSessionByID")
+	}
+
+	mmGetSessionByID.mock.inspectFuncGetSessionByID = f
+
+	return mmGetSessionByID
+}
+
+// Return sets up results that will be returned by Core.GetSessionByID
+func (mmGetSessionByID *mCoreMockGetSessionByID) Return(s1 auth.Session, s2 string, err error) *CoreMock {
+	if mmGetSessionByID.mock.funcGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+	}
+
+	if mmGetSessionByID.defaultExpectation == nil {
+		mmGetSessionByID.defaultExpectation = &CoreMockGetSessionByIDExpectation{mock: mmGetSessionByID.mock}
+	}
+	mmGetSessionByID.defaultExpectation.results = &CoreMockGetSessionByIDResults{s1, s2, err}
+	mmGetSessionByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSessionByID.mock
+}
+
+// Set uses given function f to mock the Core.GetSessionByID method
+func (mmGetSessionByID *mCoreMockGetSessionByID) Set(f func(ctx context.Context, id uuid.UUID) (s1 auth.Session, s2 string, err error)) *CoreMock {
+	if mmGetSessionByID.defaultExpectation != nil {
+		mmGetSessionByID.mock.t.Fatalf("Default expectation is already set for the Core.GetSessionByID method")
+	}
+
+	if len(mmGetSessionByID.expectations) > 0 {
+		mmGetSessionByID.mock.t.Fatalf("Some expectations are already set for the Core.GetSessionByID method")
+	}
+
+	mmGetSessionByID.mock.funcGetSessionByID = f
+	mmGetSessionByID.mock.funcGetSessionByIDOrigin = minimock.CallerInfo(1)
+	return mmGetSessionByID.mock
+}
+
+// When sets expectation for the Core.GetSessionByID which will trigger the result defined by the following
+// Then helper
+func (mmGetSessionByID *mCoreMockGetSessionByID) When(ctx context.Context, id uuid.UUID) *CoreMockGetSessionByIDExpectation {
+	if mmGetSessionByID.mock.funcGetSessionByID != nil {
+		mmGetSessionByID.mock.t.Fatalf("CoreMock.GetSessionByID mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetSessionByIDExpectation{
+		mock:               mmGetSessionByID.mock,
+		params:             &CoreMockGetSessionByIDParams{ctx, id},
+		expectationOrigins: CoreMockGetSessionByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSessionByID.expectations = append(mmGetSessionByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetSessionByID return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetSessionByIDExpectation) Then(s1 auth.Session, s2 string, err error) *CoreMock {
+	e.results = &CoreMockGetSessionByIDResults{s1, s2, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetSessionByID should be invoked
+func (mmGetSessionByID *mCoreMockGetSessionByID) Times(n uint64) *mCoreMockGetSessionByID {
+	if n == 0 {
+		mmGetSessionByID.mock.t.Fatalf("Times of CoreMock.GetSessionByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSessionByID.expectedInvocations, n)
+	mmGetSessionByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSessionByID
+}
+
+func (mmGetSessionByID *mCoreMockGetSessionByID) invocationsDone() bool {
+	if len(mmGetSessionByID.expectations) == 0 && mmGetSessionByID.defaultExpectation == nil && mmGetSessionByID.mock.funcGetSessionByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionByID.mock.afterGetSessionByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSessionByID implements mm_usecase.Core
+func (mmGetSessionByID *CoreMock) GetSessionByID(ctx context.Context, id uuid.UUID) (s1 auth.Session, s2 string, err error) {
+	mm_atomic.AddUint64(&mmGetSessionByID.beforeGetSessionByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSessionByID.afterGetSessionByIDCounter, 1)
+
+	mmGetSessionByID.t.Helper()
+
+	if mmGetSessionByID.inspectFuncGetSessionByID != nil {
+		mmGetSessionByID.inspectFuncGetSessionByID(ctx, id)
+	}
+
+	mm_params := CoreMockGetSessionByIDParams{ctx, id}
+
+	// Record call args
+	mmGetSessionByID.GetSessionByIDMock.mutex.Lock()
+	mmGetSessionByID.GetSessionByIDMock.callArgs = append(mmGetSessionByID.GetSessionByIDMock.callArgs, &mm_params)
+	mmGetSessionByID.GetSessionByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetSessionByID.GetSessionByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.s2, e.results.err
+		}
+	}
+
+	if mmGetSessionByID.GetSessionByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSessionByID.GetSessionByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetSessionByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSessionByID.t.Errorf("CoreMock.GetSessionByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSessionByID.GetSessionByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSessionByID.GetSessionByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSessionByID.t.Fatal("No results are set for the CoreMock.GetSessionByID")
+		}
+		return (*mm_results).s1, (*mm_results).s2, (*mm_results).err
+	}
+	if mmGetSessionByID.funcGetSessionByID != nil {
+		return mmGetSessionByID.funcGetSessionByID(ctx, id)
+	}
+	mmGetSessionByID.t.Fatalf("Unexpected call to CoreMock.GetSessionByID. %v %v", ctx, id)
+	return
+}
+
+// GetSessionByIDAfterCounter returns a count of finished CoreMock.GetSessionByID invocations
+func (mmGetSessionByID *CoreMock) GetSessionByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionByID.afterGetSessionByIDCounter)
+}
+
+// GetSessionByIDBeforeCounter returns a count of CoreMock.GetSessionByID invocations
+func (mmGetSessionByID *CoreMock) GetSessionByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionByID.beforeGetSessionByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetSessionByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSessionByID *mCoreMockGetSessionByID) Calls() []*CoreMockGetSessionByIDParams {
+	mmGetSessionByID.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetSessionByIDParams, len(mmGetSessionByID.callArgs))
+	copy(argCopy, mmGetSessionByID.callArgs)
+
+	mmGetSessionByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionByIDDone returns true if the count of the GetSessionByID invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetSessionByIDDone() bool {
+	if m.GetSessionByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionByIDMock.invocationsDone()
+}
+
+// MinimockGetSessionByIDInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetSessionByIDInspect() {
+	for _, e := range m.GetSessionByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionByIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionByIDMock.defaultExpectation != nil && afterGetSessionByIDCounter < 1 {
+		if m.GetSessionByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s", m.GetSessionByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s with params: %#v", m.GetSessionByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSessionByID != nil && afterGetSessionByIDCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetSessionByID at\n%s", m.funcGetSessionByIDOrigin)
+	}
+
+	if !m.GetSessionByIDMock.invocationsDone() && afterGetSessionByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetSessionByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionByIDMock.expectedInvocations), m.GetSessionByIDMock.expectedInvocationsOrigin, afterGetSessionByIDCounter)
+	}
+}
+
+type mCoreMockGetSessionsByUserID struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetSessionsByUserIDExpectation
+	expectations       []*CoreMockGetSessionsByUserIDExpectation
+
+	callArgs []*CoreMockGetSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetSessionsByUserIDExpectation specifies expectation struct of the Core.GetSessionsByUserID
+type CoreMockGetSessionsByUserIDExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetSessionsByUserIDParams
+	paramPtrs          *CoreMockGetSessionsByUserIDParamPtrs
+	expectationOrigins CoreMockGetSessionsByUserIDExpectationOrigins
+	results            *CoreMockGetSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetSessionsByUserIDParams contains parameters of the Core.GetSessionsByUserID
+type CoreMockGetSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CoreMockGetSessionsByUserIDParamPtrs contains pointers to parameters of the Core.GetSessionsByUserID
+type CoreMockGetSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockGetSessionsByUserIDResults contains results of the Core.GetSessionsByUserID
+type CoreMockGetSessionsByUserIDResults struct {
+	sa1 []auth.Session
+	err error
+}
+
+// CoreMockGetSessionsByUserIDOrigins contains origins of expectations of the Core.GetSessionsByUserID
+type CoreMockGetSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Optional() *mCoreMockGetSessionsByUserID {
+	mmGetSessionsByUserID.optional = true
+	return mmGetSessionsByUserID
+}
+
+// Expect sets up expected params for Core.GetSessionsByUserID
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetSessionsByUserID.defaultExpectation.params = &CoreMockGetSessionsByUserIDParams{ctx, userID}
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmGetSessionsByUserID.defaultExpectation.params) {
+			mmGetSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetSessionsByUserID
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.GetSessionsByUserID
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &CoreMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetSessionsByUserID
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Inspect function is already set for CoreMock.GetSessionsByUserID")
+	}
+
+	mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID = f
+
+	return mmGetSessionsByUserID
+}
+
+// Return sets up results that will be returned by Core.GetSessionsByUserID
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Return(sa1 []auth.Session, err error) *CoreMock {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &CoreMockGetSessionsByUserIDExpectation{mock: mmGetSessionsByUserID.mock}
+	}
+	mmGetSessionsByUserID.defaultExpectation.results = &CoreMockGetSessionsByUserIDResults{sa1, err}
+	mmGetSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the Core.GetSessionsByUserID method
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)) *CoreMock {
+	if mmGetSessionsByUserID.defaultExpectation != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the Core.GetSessionsByUserID method")
+	}
+
+	if len(mmGetSessionsByUserID.expectations) > 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the Core.GetSessionsByUserID method")
+	}
+
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserID = f
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// When sets expectation for the Core.GetSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *CoreMockGetSessionsByUserIDExpectation {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("CoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetSessionsByUserIDExpectation{
+		mock:               mmGetSessionsByUserID.mock,
+		params:             &CoreMockGetSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: CoreMockGetSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSessionsByUserID.expectations = append(mmGetSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetSessionsByUserIDExpectation) Then(sa1 []auth.Session, err error) *CoreMock {
+	e.results = &CoreMockGetSessionsByUserIDResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetSessionsByUserID should be invoked
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Times(n uint64) *mCoreMockGetSessionsByUserID {
+	if n == 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Times of CoreMock.GetSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSessionsByUserID.expectedInvocations, n)
+	mmGetSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID
+}
+
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) invocationsDone() bool {
+	if len(mmGetSessionsByUserID.expectations) == 0 && mmGetSessionsByUserID.defaultExpectation == nil && mmGetSessionsByUserID.mock.funcGetSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.mock.afterGetSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSessionsByUserID implements mm_usecase.Core
+func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error) {
+	mm_atomic.AddUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter, 1)
+
+	mmGetSessionsByUserID.t.Helper()
+
+	if mmGetSessionsByUserID.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.inspectFuncGetSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := CoreMockGetSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Lock()
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs = append(mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs, &mm_params)
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetSessionsByUserID.GetSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSessionsByUserID.t.Errorf("CoreMock.GetSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSessionsByUserID.t.Fatal("No results are set for the CoreMock.GetSessionsByUserID")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetSessionsByUserID.funcGetSessionsByUserID != nil {
+		return mmGetSessionsByUserID.funcGetSessionsByUserID(ctx, userID)
+	}
+	mmGetSessionsByUserID.t.Fatalf("Unexpected call to CoreMock.GetSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// GetSessionsByUserIDAfterCounter returns a count of finished CoreMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter)
+}
+
+// GetSessionsByUserIDBeforeCounter returns a count of CoreMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *CoreMock) GetSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSessionsByUserID *mCoreMockGetSessionsByUserID) Calls() []*CoreMockGetSessionsByUserIDParams {
+	mmGetSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetSessionsByUserIDParams, len(mmGetSessionsByUserID.callArgs))
+	copy(argCopy, mmGetSessionsByUserID.callArgs)
+
+	mmGetSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionsByUserIDDone returns true if the count of the GetSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetSessionsByUserIDDone() bool {
+	if m.GetSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockGetSessionsByUserIDInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetSessionsByUserIDInspect() {
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionsByUserIDMock.defaultExpectation != nil && afterGetSessionsByUserIDCounter < 1 {
+		if m.GetSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s", m.GetSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s with params: %#v", m.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSessionsByUserID != nil && afterGetSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetSessionsByUserID at\n%s", m.funcGetSessionsByUserIDOrigin)
+	}
+
+	if !m.GetSessionsByUserIDMock.invocationsDone() && afterGetSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionsByUserIDMock.expectedInvocations), m.GetSessionsByUserIDMock.expectedInvocationsOrigin, afterGetSessionsByUserIDCounter)
+	}
+}
+
+type mCoreMockIsAdmin struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockIsAdminExpectation
+	expectations       []*CoreMockIsAdminExpectation
+
+	callArgs []*CoreMockIsAdminParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockIsAdminExpectation specifies expectation struct of the Core.IsAdmin
+type CoreMockIsAdminExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockIsAdminParams
+	paramPtrs          *CoreMockIsAdminParamPtrs
+	expectationOrigins CoreMockIsAdminExpectationOrigins
+	results            *CoreMockIsAdminResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockIsAdminParams contains parameters of the Core.IsAdmin
+type CoreMockIsAdminParams struct {
+	ctx context.Context
+}
+
+// CoreMockIsAdminParamPtrs contains pointers to parameters of the Core.IsAdmin
+type CoreMockIsAdminParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockIsAdminResults contains results of the Core.IsAdmin
+type CoreMockIsAdminResults struct {
+	b1  bool
+	err error
+}
+
+// CoreMockIsAdminOrigins contains origins of expectations of the Core.IsAdmin
+type CoreMockIsAdminExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsAdmin *mCoreMockIsAdmin) Optional() *mCoreMockIsAdmin {
+	mmIsAdmin.optional = true
+	return mmIsAdmin
+}
+
+// Expect sets up expected params for Core.IsAdmin
+func (mmIsAdmin *mCoreMockIsAdmin) Expect(ctx context.Context) *mCoreMockIsAdmin {
+	if mmIsAdmin.mock.funcIsAdmin != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+	}
+
+	if mmIsAdmin.defaultExpectation == nil {
+		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{}
+	}
+
+	if mmIsAdmin.defaultExpectation.paramPtrs != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by ExpectParams functions")
+	}
+
+	mmIsAdmin.defaultExpectation.params = &CoreMockIsAdminParams{ctx}
+	mmIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsAdmin.expectations {
+		if minimock.Equal(e.params, mmIsAdmin.defaultExpectation.params) {
+			mmIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsAdmin.defaultExpectation.params)
+		}
+	}
+
+	return mmIsAdmin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.IsAdmin
+func (mmIsAdmin *mCoreMockIsAdmin) ExpectCtxParam1(ctx context.Context) *mCoreMockIsAdmin {
+	if mmIsAdmin.mock.funcIsAdmin != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+	}
+
+	if mmIsAdmin.defaultExpectation == nil {
+		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{}
+	}
+
+	if mmIsAdmin.defaultExpectation.params != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Expect")
+	}
+
+	if mmIsAdmin.defaultExpectation.paramPtrs == nil {
+		mmIsAdmin.defaultExpectation.paramPtrs = &CoreMockIsAdminParamPtrs{}
+	}
+	mmIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsAdmin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.IsAdmin
+func (mmIsAdmin *mCoreMockIsAdmin) Inspect(f func(ctx context.Context)) *mCoreMockIsAdmin {
+	if mmIsAdmin.mock.inspectFuncIsAdmin != nil {
+		mmIsAdmin.mock.t.Fatalf("Inspect function is already set for CoreMock.IsAdmin")
+	}
+
+	mmIsAdmin.mock.inspectFuncIsAdmin = f
+
+	return mmIsAdmin
+}
+
+// Return sets up results that will be returned by Core.IsAdmin
+func (mmIsAdmin *mCoreMockIsAdmin) Return(b1 bool, err error) *CoreMock {
+	if mmIsAdmin.mock.funcIsAdmin != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+	}
+
+	if mmIsAdmin.defaultExpectation == nil {
+		mmIsAdmin.defaultExpectation = &CoreMockIsAdminExpectation{mock: mmIsAdmin.mock}
+	}
+	mmIsAdmin.defaultExpectation.results = &CoreMockIsAdminResults{b1, err}
+	mmIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsAdmin.mock
+}
+
+// Set uses given function f to mock the Core.IsAdmin method
+func (mmIsAdmin *mCoreMockIsAdmin) Set(f func(ctx context.Context) (b1 bool, err error)) *CoreMock {
+	if mmIsAdmin.defaultExpectation != nil {
+		mmIsAdmin.mock.t.Fatalf("Default expectation is already set for the Core.IsAdmin method")
+	}
+
+	if len(mmIsAdmin.expectations) > 0 {
+		mmIsAdmin.mock.t.Fatalf("Some expectations are already set for the Core.IsAdmin method")
+	}
+
+	mmIsAdmin.mock.funcIsAdmin = f
+	mmIsAdmin.mock.funcIsAdminOrigin = minimock.CallerInfo(1)
+	return mmIsAdmin.mock
+}
+
+// When sets expectation for the Core.IsAdmin which will trigger the result defined by the following
+// Then helper
+func (mmIsAdmin *mCoreMockIsAdmin) When(ctx context.Context) *CoreMockIsAdminExpectation {
+	if mmIsAdmin.mock.funcIsAdmin != nil {
+		mmIsAdmin.mock.t.Fatalf("CoreMock.IsAdmin mock is already set by Set")
+	}
+
+	expectation := &CoreMockIsAdminExpectation{
+		mock:               mmIsAdmin.mock,
+		params:             &CoreMockIsAdminParams{ctx},
+		expectationOrigins: CoreMockIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsAdmin.expectations = append(mmIsAdmin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.IsAdmin return parameters for the expectation previously defined by the When method
+func (e *CoreMockIsAdminExpectation) Then(b1 bool, err error) *CoreMock {
+	e.results = &CoreMockIsAdminResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.IsAdmin should be invoked
+func (mmIsAdmin *mCoreMockIsAdmin) Times(n uint64) *mCoreMockIsAdmin {
+	if n == 0 {
+		mmIsAdmin.mock.t.Fatalf("Times of CoreMock.IsAdmin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsAdmin.expectedInvocations, n)
+	mmIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsAdmin
+}
+
+func (mmIsAdmin *mCoreMockIsAdmin) invocationsDone() bool {
+	if len(mmIsAdmin.expectations) == 0 && mmIsAdmin.defaultExpectation == nil && mmIsAdmin.mock.funcIsAdmin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsAdmin.mock.afterIsAdminCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsAdmin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsAdmin implements mm_usecase.Core
+func (mmIsAdmin *CoreMock) IsAdmin(ctx context.Context) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsAdmin.beforeIsAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsAdmin.afterIsAdminCounter, 1)
+
+	mmIsAdmin.t.Helper()
+
+	if mmIsAdmin.inspectFuncIsAdmin != nil {
+		mmIsAdmin.inspectFuncIsAdmin(ctx)
+	}
+
+	mm_params := CoreMockIsAdminParams{ctx}
+
+	// Record call args
+	mmIsAdmin.IsAdminMock.mutex.Lock()
+	mmIsAdmin.IsAdminMock.callArgs = append(mmIsAdmin.IsAdminMock.callArgs, &mm_params)
+	mmIsAdmin.IsAdminMock.mutex.Unlock()
+
+	for _, e := range mmIsAdmin.IsAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsAdmin.IsAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsAdmin.IsAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsAdmin.IsAdminMock.defaultExpectation.params
+		mm_want_ptrs := mmIsAdmin.IsAdminMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockIsAdminParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsAdmin.t.Errorf("CoreMock.IsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsAdmin.IsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsAdmin.t.Errorf("CoreMock.IsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsAdmin.IsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsAdmin.IsAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsAdmin.t.Fatal("No results are set for the CoreMock.IsAdmin")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsAdmin.funcIsAdmin != nil {
+		return mmIsAdmin.funcIsAdmin(ctx)
+	}
+	mmIsAdmin.t.Fatalf("Unexpected call to CoreMock.IsAdmin. %v", ctx)
+	return
+}
+
+// IsAdminAfterCounter returns a count of finished CoreMock.IsAdmin invocations
+func (mmIsAdmin *CoreMock) IsAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsAdmin.afterIsAdminCounter)
+}
+
+// IsAdminBeforeCounter returns a count of CoreMock.IsAdmin invocations
+func (mmIsAdmin *CoreMock) IsAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsAdmin.beforeIsAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.IsAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsAdmin *mCoreMockIsAdmin) Calls() []*CoreMockIsAdminParams {
+	mmIsAdmin.mutex.RLock()
+
+	argCopy := make([]*CoreMockIsAdminParams, len(mmIsAdmin.callArgs))
+	copy(argCopy, mmIsAdmin.callArgs)
+
+	mmIsAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsAdminDone returns true if the count of the IsAdmin invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockIsAdminDone() bool {
+	if m.IsAdminMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsAdminMock.invocationsDone()
+}
+
+// MinimockIsAdminInspect logs each unmet expectation
+func (m *CoreMock) MinimockIsAdminInspect() {
+	for _, e := range m.IsAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsAdminCounter := mm_atomic.LoadUint64(&m.afterIsAdminCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsAdminMock.defaultExpectation != nil && afterIsAdminCounter < 1 {
+		if m.IsAdminMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s", m.IsAdminMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s with params: %#v", m.IsAdminMock.defaultExpectation.expectationOrigins.origin, *m.IsAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsAdmin != nil && afterIsAdminCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.IsAdmin at\n%s", m.funcIsAdminOrigin)
+	}
+
+	if !m.IsAdminMock.invocationsDone() && afterIsAdminCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.IsAdmin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsAdminMock.expectedInvocations), m.IsAdminMock.expectedInvocationsOrigin, afterIsAdminCounter)
+	}
+}
+
+type mCoreMockIssueImpersonationToken struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockIssueImpersonationTokenExpectation
+	expectations       []*CoreMockIssueImpersonationTokenExpectation
+
+	callArgs []*CoreMockIssueImpersonationTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockIssueImpersonationTokenExpectation specifies expectation struct of the Core.IssueImpersonationToken
+type CoreMockIssueImpersonationTokenExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockIssueImpersonationTokenParams
+	paramPtrs          *CoreMockIssueImpersonationTokenParamPtrs
+	expectationOrigins CoreMockIssueImpersonationTokenExpectationOrigins
+	results            *CoreMockIssueImpersonationTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockIssueImpersonationTokenParams contains parameters of the Core.IssueImpersonationToken
+type CoreMockIssueImpersonationTokenParams struct {
+	ctx       context.Context
+	subjectID uuid.UUID
+}
+
+// CoreMockIssueImpersonationTokenParamPtrs contains pointers to parameters of the Core.IssueImpersonationToken
+type CoreMockIssueImpersonationTokenParamPtrs struct {
+	ctx       *context.Context
+	subjectID *uuid.UUID
+}
+
+// CoreMockIssueImpersonationTokenResults contains results of the Core.IssueImpersonationToken
+type CoreMockIssueImpersonationTokenResults struct {
+	i1  auth.ImpersonationToken
+	err error
+}
+
+// CoreMockIssueImpersonationTokenOrigins contains origins of expectations of the Core.IssueImpersonationToken
+type CoreMockIssueImpersonationTokenExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSubjectID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Optional() *mCoreMockIssueImpersonationToken {
+	mmIssueImpersonationToken.optional = true
+	return mmIssueImpersonationToken
+}
+
+// Expect sets up expected params for Core.IssueImpersonationToken
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Expect(ctx context.Context, subjectID uuid.UUID) *mCoreMockIssueImpersonationToken {
+	if mmIssueImpersonationToken.mock.funcIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Set")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation == nil {
+		mmIssueImpersonationToken.defaultExpectation = &CoreMockIssueImpersonationTokenExpectation{}
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation.paramPtrs != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by ExpectParams functions")
+	}
+
+	mmIssueImpersonationToken.defaultExpectation.params = &CoreMockIssueImpersonationTokenParams{ctx, subjectID}
+	mmIssueImpersonationToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIssueImpersonationToken.expectations {
+		if minimock.Equal(e.params, mmIssueImpersonationToken.defaultExpectation.params) {
+			mmIssueImpersonationToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueImpersonationToken.defaultExpectation.params)
+		}
+	}
+
+	return mmIssueImpersonationToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.IssueImpersonationToken
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) ExpectCtxParam1(ctx context.Context) *mCoreMockIssueImpersonationToken {
+	if mmIssueImpersonationToken.mock.funcIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Set")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation == nil {
+		mmIssueImpersonationToken.defaultExpectation = &CoreMockIssueImpersonationTokenExpectation{}
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation.params != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Expect")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation.paramPtrs == nil {
+		mmIssueImpersonationToken.defaultExpectation.paramPtrs = &CoreMockIssueImpersonationTokenParamPtrs{}
+	}
+	mmIssueImpersonationToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIssueImpersonationToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIssueImpersonationToken
+}
+
+// ExpectSubjectIDParam2 sets up expected param subjectID for Core.IssueImpersonationToken
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) ExpectSubjectIDParam2(subjectID uuid.UUID) *mCoreMockIssueImpersonationToken {
+	if mmIssueImpersonationToken.mock.funcIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Set")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation == nil {
+		mmIssueImpersonationToken.defaultExpectation = &CoreMockIssueImpersonationTokenExpectation{}
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation.params != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Expect")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation.paramPtrs == nil {
+		mmIssueImpersonationToken.defaultExpectation.paramPtrs = &CoreMockIssueImpersonationTokenParamPtrs{}
+	}
+	mmIssueImpersonationToken.defaultExpectation.paramPtrs.subjectID = &subjectID
+	mmIssueImpersonationToken.defaultExpectation.expectationOrigins.originSubjectID = minimock.CallerInfo(1)
+
+	return mmIssueImpersonationToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.IssueImpersonationToken
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Inspect(f func(ctx context.Context, subjectID uuid.UUID)) *mCoreMockIssueImpersonationToken {
+	if mmIssueImpersonationToken.mock.inspectFuncIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("Inspect function is already set for CoreMock.IssueImpersonationToken")
+	}
+
+	mmIssueImpersonationToken.mock.inspectFuncIssueImpersonationToken = f
+
+	return mmIssueImpersonationToken
+}
+
+// Return sets up results that will be returned by Core.IssueImpersonationToken
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Return(i1 auth.ImpersonationToken, err error) *CoreMock {
+	if mmIssueImpersonationToken.mock.funcIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Set")
+	}
+
+	if mmIssueImpersonationToken.defaultExpectation == nil {
+		mmIssueImpersonationToken.defaultExpectation = &CoreMockIssueImpersonationTokenExpectation{mock: mmIssueImpersonationToken.mock}
+	}
+	mmIssueImpersonationToken.defaultExpectation.results = &CoreMockIssueImpersonationTokenResults{i1, err}
+	mmIssueImpersonationToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIssueImpersonationToken.mock
+}
+
+// Set uses given function f to mock the Core.IssueImpersonationToken method
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Set(f func(ctx context.Context, subjectID uuid.UUID) (i1 auth.ImpersonationToken, err error)) *CoreMock {
+	if mmIssueImpersonationToken.defaultExpectation != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("Default expectation is already set for the Core.IssueImpersonationToken method")
+	}
+
+	if len(mmIssueImpersonationToken.expectations) > 0 {
+		mmIssueImpersonationToken.mock.t.Fatalf("Some expectations are already set for the Core.IssueImpersonationToken method")
+	}
+
+	mmIssueImpersonationToken.mock.funcIssueImpersonationToken = f
+	mmIssueImpersonationToken.mock.funcIssueImpersonationTokenOrigin = minimock.CallerInfo(1)
+	return mmIssueImpersonationToken.mock
+}
+
+// When sets expectation for the Core.IssueImpersonationToken which will trigger the result defined by the following
+// Then helper
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) When(ctx context.Context, subjectID uuid.UUID) *CoreMockIssueImpersonationTokenExpectation {
+	if mmIssueImpersonationToken.mock.funcIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.mock.t.Fatalf("CoreMock.IssueImpersonationToken mock is already set by Set")
+	}
+
+	expectation := &CoreMockIssueImpersonationTokenExpectation{
+		mock:               mmIssueImpersonationToken.mock,
+		params:             &CoreMockIssueImpersonationTokenParams{ctx, subjectID},
+		expectationOrigins: CoreMockIssueImpersonationTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIssueImpersonationToken.expectations = append(mmIssueImpersonationToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.IssueImpersonationToken return parameters for the expectation previously defined by the When method
+func (e *CoreMockIssueImpersonationTokenExpectation) Then(i1 auth.ImpersonationToken, err error) *CoreMock {
+	e.results = &CoreMockIssueImpersonationTokenResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.IssueImpersonationToken should be invoked
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Times(n uint64) *mCoreMockIssueImpersonationToken {
+	if n == 0 {
+		mmIssueImpersonationToken.mock.t.Fatalf("Times of CoreMock.IssueImpersonationToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIssueImpersonationToken.expectedInvocations, n)
+	mmIssueImpersonationToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIssueImpersonationToken
+}
+
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) invocationsDone() bool {
+	if len(mmIssueImpersonationToken.expectations) == 0 && mmIssueImpersonationToken.defaultExpectation == nil && mmIssueImpersonationToken.mock.funcIssueImpersonationToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIssueImpersonationToken.mock.afterIssueImpersonationTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIssueImpersonationToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IssueImpersonationToken implements mm_usecase.Core
+func (mmIssueImpersonationToken *CoreMock) IssueImpersonationToken(ctx context.Context, subjectID uuid.UUID) (i1 auth.ImpersonationToken, err error) {
+	mm_atomic.AddUint64(&mmIssueImpersonationToken.beforeIssueImpersonationTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmIssueImpersonationToken.afterIssueImpersonationTokenCounter, 1)
+
+	mmIssueImpersonationToken.t.Helper()
+
+	if mmIssueImpersonationToken.inspectFuncIssueImpersonationToken != nil {
+		mmIssueImpersonationToken.inspectFuncIssueImpersonationToken(ctx, subjectID)
+	}
+
+	mm_params := CoreMockIssueImpersonationTokenParams{ctx, subjectID}
+
+	// Record call args
+	mmIssueImpersonationToken.IssueImpersonationTokenMock.mutex.Lock()
+	mmIssueImpersonationToken.IssueImpersonationTokenMock.callArgs = append(mmIssueImpersonationToken.IssueImpersonationTokenMock.callArgs, &mm_params)
+	mmIssueImpersonationToken.IssueImpersonationTokenMock.mutex.Unlock()
+
+	for _, e := range mmIssueImpersonationToken.IssueImpersonationTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockIssueImpersonationTokenParams{ctx, subjectID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIssueImpersonationToken.t.Errorf("CoreMock.IssueImpersonationToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.subjectID != nil && !minimock.Equal(*mm_want_ptrs.subjectID, mm_got.subjectID) {
+				mmIssueImpersonationToken.t.Errorf("CoreMock.IssueImpersonationToken got unexpected parameter subjectID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.expectationOrigins.originSubjectID, *mm_want_ptrs.subjectID, mm_got.subjectID, minimock.Diff(*mm_want_ptrs.subjectID, mm_got.subjectID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIssueImpersonationToken.t.Errorf("CoreMock.IssueImpersonationToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIssueImpersonationToken.IssueImpersonationTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIssueImpersonationToken.t.Fatal("No results are set for the CoreMock.IssueImpersonationToken")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmIssueImpersonationToken.funcIssueImpersonationToken != nil {
+		return mmIssueImpersonationToken.funcIssueImpersonationToken(ctx, subjectID)
+	}
+	mmIssueImpersonationToken.t.Fatalf("Unexpected call to CoreMock.IssueImpersonationToken. %v %v", ctx, subjectID)
+	return
+}
+
+// IssueImpersonationTokenAfterCounter returns a count of finished CoreMock.IssueImpersonationToken invocations
+func (mmIssueImpersonationToken *CoreMock) IssueImpersonationTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueImpersonationToken.afterIssueImpersonationTokenCounter)
+}
+
+// IssueImpersonationTokenBeforeCounter returns a count of CoreMock.IssueImpersonationToken invocations
+func (mmIssueImpersonationToken *CoreMock) IssueImpersonationTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueImpersonationToken.beforeIssueImpersonationTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.IssueImpersonationToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIssueImpersonationToken *mCoreMockIssueImpersonationToken) Calls() []*CoreMockIssueImpersonationTokenParams {
+	mmIssueImpersonationToken.mutex.RLock()
+
+	argCopy := make([]*CoreMockIssueImpersonationTokenParams, len(mmIssueImpersonationToken.callArgs))
+	copy(argCopy, mmIssueImpersonationToken.callArgs)
+
+	mmIssueImpersonationToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIssueImpersonationTokenDone returns true if the count of the IssueImpersonationToken invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockIssueImpersonationTokenDone() bool {
+	if m.IssueImpersonationTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IssueImpersonationTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IssueImpersonationTokenMock.invocationsDone()
+}
+
+// MinimockIssueImpersonationTokenInspect logs each unmet expectation
+func (m *CoreMock) MinimockIssueImpersonationTokenInspect() {
+	for _, e := range m.IssueImpersonationTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.IssueImpersonationToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIssueImpersonationTokenCounter := mm_atomic.LoadUint64(&m.afterIssueImpersonationTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IssueImpersonationTokenMock.defaultExpectation != nil && afterIssueImpersonationTokenCounter < 1 {
+		if m.IssueImpersonationTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.IssueImpersonationToken at\n%s", m.IssueImpersonationTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.IssueImpersonationToken at\n%s with params: %#v", m.IssueImpersonationTokenMock.defaultExpectation.expectationOrigins.origin, *m.IssueImpersonationTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIssueImpersonationToken != nil && afterIssueImpersonationTokenCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.IssueImpersonationToken at\n%s", m.funcIssueImpersonationTokenOrigin)
+	}
+
+	if !m.IssueImpersonationTokenMock.invocationsDone() && afterIssueImpersonationTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.IssueImpersonationToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IssueImpersonationTokenMock.expectedInvocations), m.IssueImpersonationTokenMock.expectedInvocationsOrigin, afterIssueImpersonationTokenCounter)
+	}
+}
+
+type mCoreMockIssueTokens struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockIssueTokensExpectation
+	expectations       []*CoreMockIssueTokensExpectation
+
+	callArgs []*CoreMockIssueTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockIssueTokensExpectation specifies expectation struct of the Core.IssueTokens
+type CoreMockIssueTokensExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockIssueTokensParams
+	paramPtrs          *CoreMockIssueTokensParamPtrs
+	expectationOrigins CoreMockIssueTokensExpectationOrigins
+	results            *CoreMockIssueTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockIssueTokensParams contains parameters of the Core.IssueTokens
+type CoreMockIssueTokensParams struct {
+	ctx            context.Context
+	userID         uuid.UUID
+	sessionVersion int
+	scopes         auth.Scopes
+	ttlClass       auth.TTLClass
+	client         auth.Client
+}
+
+// CoreMockIssueTokensParamPtrs contains pointers to parameters of the Core.IssueTokens
+type CoreMockIssueTokensParamPtrs struct {
+	ctx            *context.Context
+	userID         *uuid.UUID
+	sessionVersion *int
+	scopes         *auth.Scopes
+	ttlClass       *auth.TTLClass
+	client         *auth.Client
+}
+
+// CoreMockIssueTokensResults contains results of the Core.IssueTokens
+type CoreMockIssueTokensResults struct {
+	t1  auth.Tokens
+	err error
+}
+
+// CoreMockIssueTokensOrigins contains origins of expectations of the Core.IssueTokens
+type CoreMockIssueTokensExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originUserID         string
+	originSessionVersion string
+	originScopes         string
+	originTtlClass       string
+	originClient         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIssueTokens *mCoreMockIssueTokens) Optional() *mCoreMockIssueTokens {
+	mmIssueTokens.optional = true
+	return mmIssueTokens
+}
+
+// Expect sets up expected params for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) Expect(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by ExpectParams functions")
+	}
+
+	mmIssueTokens.defaultExpectation.params = &CoreMockIssueTokensParams{ctx, userID, sessionVersion, scopes, ttlClass, client}
+	mmIssueTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIssueTokens.expectations {
+		if minimock.Equal(e.params, mmIssueTokens.defaultExpectation.params) {
+			mmIssueTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmIssueTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectCtxParam1(ctx context.Context) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIssueTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.userID = &userID
+	mmIssueTokens.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// ExpectSessionVersionParam3 sets up expected param sessionVersion for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectSessionVersionParam3(sessionVersion int) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.sessionVersion = &sessionVersion
+	mmIssueTokens.defaultExpectation.expectationOrigins.originSessionVersion = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// ExpectScopesParam4 sets up expected param scopes for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectScopesParam4(scopes auth.Scopes) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.scopes = &scopes
+	mmIssueTokens.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// ExpectTtlClassParam5 sets up expected param ttlClass for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectTtlClassParam5(ttlClass auth.TTLClass) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.ttlClass = &ttlClass
+	mmIssueTokens.defaultExpectation.expectationOrigins.originTtlClass = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// ExpectClientParam6 sets up expected param client for Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) ExpectClientParam6(client auth.Client) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{}
+	}
+
+	if mmIssueTokens.defaultExpectation.params != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Expect")
+	}
+
+	if mmIssueTokens.defaultExpectation.paramPtrs == nil {
+		mmIssueTokens.defaultExpectation.paramPtrs = &CoreMockIssueTokensParamPtrs{}
+	}
+	mmIssueTokens.defaultExpectation.paramPtrs.client = &client
+	mmIssueTokens.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmIssueTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) Inspect(f func(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client)) *mCoreMockIssueTokens {
+	if mmIssueTokens.mock.inspectFuncIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("Inspect function is already set for CoreMock.IssueTokens")
+	}
+
+	mmIssueTokens.mock.inspectFuncIssueTokens = f
+
+	return mmIssueTokens
+}
+
+// Return sets up results that will be returned by Core.IssueTokens
+func (mmIssueTokens *mCoreMockIssueTokens) Return(t1 auth.Tokens, err error) *CoreMock {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	if mmIssueTokens.defaultExpectation == nil {
+		mmIssueTokens.defaultExpectation = &CoreMockIssueTokensExpectation{mock: mmIssueTokens.mock}
+	}
+	mmIssueTokens.defaultExpectation.results = &CoreMockIssueTokensResults{t1, err}
+	mmIssueTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIssueTokens.mock
 }
 
 // Set uses given function f to mock the Core.IssueTokens method
-func (mmIssueTokens *mCoreMockIssueTokens) Set(f func(ctx context.Context, userID uuid.UUID, sessionVersion int) (t1 auth.Tokens, err error)) *CoreMock {
+func (mmIssueTokens *mCoreMockIssueTokens) Set(f func(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) (t1 auth.Tokens, err error)) *CoreMock {
 	if mmIssueTokens.defaultExpectation != nil {
 		mmIssueTokens.mock.t.Fatalf("Default expectation is already set for the Core.IssueTokens method")
 	}
 
-	if len(mmIssueTokens.expectations) > 0 {
-		mmIssueTokens.mock.t.Fatalf("Some expectations are already set for the Core.IssueTokens method")
+	if len(mmIssueTokens.expectations) > 0 {
+		mmIssueTokens.mock.t.Fatalf("Some expectations are already set for the Core.IssueTokens method")
+	}
+
+	mmIssueTokens.mock.funcIssueTokens = f
+	mmIssueTokens.mock.funcIssueTokensOrigin = minimock.CallerInfo(1)
+	return mmIssueTokens.mock
+}
+
+// When sets expectation for the Core.IssueTokens which will trigger the result defined by the following
+// Then helper
+func (mmIssueTokens *mCoreMockIssueTokens) When(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) *CoreMockIssueTokensExpectation {
+	if mmIssueTokens.mock.funcIssueTokens != nil {
+		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+	}
+
+	expectation := &CoreMockIssueTokensExpectation{
+		mock:               mmIssueTokens.mock,
+		params:             &CoreMockIssueTokensParams{ctx, userID, sessionVersion, scopes, ttlClass, client},
+		expectationOrigins: CoreMockIssueTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIssueTokens.expectations = append(mmIssueTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.IssueTokens return parameters for the expectation previously defined by the When method
+func (e *CoreMockIssueTokensExpectation) Then(t1 auth.Tokens, err error) *CoreMock {
+	e.results = &CoreMockIssueTokensResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.IssueTokens should be invoked
+func (mmIssueTokens *mCoreMockIssueTokens) Times(n uint64) *mCoreMockIssueTokens {
+	if n == 0 {
+		mmIssueTokens.mock.t.Fatalf("Times of CoreMock.IssueTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIssueTokens.expectedInvocations, n)
+	mmIssueTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIssueTokens
+}
+
+func (mmIssueTokens *mCoreMockIssueTokens) invocationsDone() bool {
+	if len(mmIssueTokens.expectations) == 0 && mmIssueTokens.defaultExpectation == nil && mmIssueTokens.mock.funcIssueTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIssueTokens.mock.afterIssueTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIssueTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IssueTokens implements mm_usecase.Core
+func (mmIssueTokens *CoreMock) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) (t1 auth.Tokens, err error) {
+	mm_atomic.AddUint64(&mmIssueTokens.beforeIssueTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmIssueTokens.afterIssueTokensCounter, 1)
+
+	mmIssueTokens.t.Helper()
+
+	if mmIssueTokens.inspectFuncIssueTokens != nil {
+		mmIssueTokens.inspectFuncIssueTokens(ctx, userID, sessionVersion, scopes, ttlClass, client)
+	}
+
+	mm_params := CoreMockIssueTokensParams{ctx, userID, sessionVersion, scopes, ttlClass, client}
+
+	// Record call args
+	mmIssueTokens.IssueTokensMock.mutex.Lock()
+	mmIssueTokens.IssueTokensMock.callArgs = append(mmIssueTokens.IssueTokensMock.callArgs, &mm_params)
+	mmIssueTokens.IssueTokensMock.mutex.Unlock()
+
+	for _, e := range mmIssueTokens.IssueTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmIssueTokens.IssueTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIssueTokens.IssueTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmIssueTokens.IssueTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmIssueTokens.IssueTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockIssueTokensParams{ctx, userID, sessionVersion, scopes, ttlClass, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sessionVersion != nil && !minimock.Equal(*mm_want_ptrs.sessionVersion, mm_got.sessionVersion) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter sessionVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originSessionVersion, *mm_want_ptrs.sessionVersion, mm_got.sessionVersion, minimock.Diff(*mm_want_ptrs.sessionVersion, mm_got.sessionVersion))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+			if mm_want_ptrs.ttlClass != nil && !minimock.Equal(*mm_want_ptrs.ttlClass, mm_got.ttlClass) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter ttlClass, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originTtlClass, *mm_want_ptrs.ttlClass, mm_got.ttlClass, minimock.Diff(*mm_want_ptrs.ttlClass, mm_got.ttlClass))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIssueTokens.IssueTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIssueTokens.t.Fatal("No results are set for the CoreMock.IssueTokens")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmIssueTokens.funcIssueTokens != nil {
+		return mmIssueTokens.funcIssueTokens(ctx, userID, sessionVersion, scopes, ttlClass, client)
+	}
+	mmIssueTokens.t.Fatalf("Unexpected call to CoreMock.IssueTokens. %v %v %v %v %v %v", ctx, userID, sessionVersion, scopes, ttlClass, client)
+	return
+}
+
+// IssueTokensAfterCounter returns a count of finished CoreMock.IssueTokens invocations
+func (mmIssueTokens *CoreMock) IssueTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueTokens.afterIssueTokensCounter)
+}
+
+// IssueTokensBeforeCounter returns a count of CoreMock.IssueTokens invocations
+func (mmIssueTokens *CoreMock) IssueTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueTokens.beforeIssueTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.IssueTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIssueTokens *mCoreMockIssueTokens) Calls() []*CoreMockIssueTokensParams {
+	mmIssueTokens.mutex.RLock()
+
+	argCopy := make([]*CoreMockIssueTokensParams, len(mmIssueTokens.callArgs))
+	copy(argCopy, mmIssueTokens.callArgs)
+
+	mmIssueTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIssueTokensDone returns true if the count of the IssueTokens invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockIssueTokensDone() bool {
+	if m.IssueTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IssueTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IssueTokensMock.invocationsDone()
+}
+
+// MinimockIssueTokensInspect logs each unmet expectation
+func (m *CoreMock) MinimockIssueTokensInspect() {
+	for _, e := range m.IssueTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIssueTokensCounter := mm_atomic.LoadUint64(&m.afterIssueTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IssueTokensMock.defaultExpectation != nil && afterIssueTokensCounter < 1 {
+		if m.IssueTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s", m.IssueTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s with params: %#v", m.IssueTokensMock.defaultExpectation.expectationOrigins.origin, *m.IssueTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIssueTokens != nil && afterIssueTokensCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s", m.funcIssueTokensOrigin)
+	}
+
+	if !m.IssueTokensMock.invocationsDone() && afterIssueTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.IssueTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IssueTokensMock.expectedInvocations), m.IssueTokensMock.expectedInvocationsOrigin, afterIssueTokensCounter)
+	}
+}
+
+type mCoreMockListPresets struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockListPresetsExpectation
+	expectations       []*CoreMockListPresetsExpectation
+
+	callArgs []*CoreMockListPresetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockListPresetsExpectation specifies expectation struct of the Core.ListPresets
+type CoreMockListPresetsExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockListPresetsParams
+	paramPtrs          *CoreMockListPresetsParamPtrs
+	expectationOrigins CoreMockListPresetsExpectationOrigins
+	results            *CoreMockListPresetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockListPresetsParams contains parameters of the Core.ListPresets
+type CoreMockListPresetsParams struct {
+	ctx context.Context
+}
+
+// CoreMockListPresetsParamPtrs contains pointers to parameters of the Core.ListPresets
+type CoreMockListPresetsParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockListPresetsResults contains results of the Core.ListPresets
+type CoreMockListPresetsResults struct {
+	ra1 []auth.RolePreset
+	err error
+}
+
+// CoreMockListPresetsOrigins contains origins of expectations of the Core.ListPresets
+type CoreMockListPresetsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPresets *mCoreMockListPresets) Optional() *mCoreMockListPresets {
+	mmListPresets.optional = true
+	return mmListPresets
+}
+
+// Expect sets up expected params for Core.ListPresets
+func (mmListPresets *mCoreMockListPresets) Expect(ctx context.Context) *mCoreMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &CoreMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by ExpectParams functions")
+	}
+
+	mmListPresets.defaultExpectation.params = &CoreMockListPresetsParams{ctx}
+	mmListPresets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPresets.expectations {
+		if minimock.Equal(e.params, mmListPresets.defaultExpectation.params) {
+			mmListPresets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPresets.defaultExpectation.params)
+		}
+	}
+
+	return mmListPresets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.ListPresets
+func (mmListPresets *mCoreMockListPresets) ExpectCtxParam1(ctx context.Context) *mCoreMockListPresets {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &CoreMockListPresetsExpectation{}
+	}
+
+	if mmListPresets.defaultExpectation.params != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by Expect")
+	}
+
+	if mmListPresets.defaultExpectation.paramPtrs == nil {
+		mmListPresets.defaultExpectation.paramPtrs = &CoreMockListPresetsParamPtrs{}
+	}
+	mmListPresets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPresets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPresets
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.ListPresets
+func (mmListPresets *mCoreMockListPresets) Inspect(f func(ctx context.Context)) *mCoreMockListPresets {
+	if mmListPresets.mock.inspectFuncListPresets != nil {
+		mmListPresets.mock.t.Fatalf("Inspect function is already set for CoreMock.ListPresets")
+	}
+
+	mmListPresets.mock.inspectFuncListPresets = f
+
+	return mmListPresets
+}
+
+// Return sets up results that will be returned by Core.ListPresets
+func (mmListPresets *mCoreMockListPresets) Return(ra1 []auth.RolePreset, err error) *CoreMock {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by Set")
+	}
+
+	if mmListPresets.defaultExpectation == nil {
+		mmListPresets.defaultExpectation = &CoreMockListPresetsExpectation{mock: mmListPresets.mock}
+	}
+	mmListPresets.defaultExpectation.results = &CoreMockListPresetsResults{ra1, err}
+	mmListPresets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// Set uses given function f to mock the Core.ListPresets method
+func (mmListPresets *mCoreMockListPresets) Set(f func(ctx context.Context) (ra1 []auth.RolePreset, err error)) *CoreMock {
+	if mmListPresets.defaultExpectation != nil {
+		mmListPresets.mock.t.Fatalf("Default expectation is already set for the Core.ListPresets method")
+	}
+
+	if len(mmListPresets.expectations) > 0 {
+		mmListPresets.mock.t.Fatalf("Some expectations are already set for the Core.ListPresets method")
+	}
+
+	mmListPresets.mock.funcListPresets = f
+	mmListPresets.mock.funcListPresetsOrigin = minimock.CallerInfo(1)
+	return mmListPresets.mock
+}
+
+// When sets expectation for the Core.ListPresets which will trigger the result defined by the following
+// Then helper
+func (mmListPresets *mCoreMockListPresets) When(ctx context.Context) *CoreMockListPresetsExpectation {
+	if mmListPresets.mock.funcListPresets != nil {
+		mmListPresets.mock.t.Fatalf("CoreMock.ListPresets mock is already set by Set")
+	}
+
+	expectation := &CoreMockListPresetsExpectation{
+		mock:               mmListPresets.mock,
+		params:             &CoreMockListPresetsParams{ctx},
+		expectationOrigins: CoreMockListPresetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPresets.expectations = append(mmListPresets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.ListPresets return parameters for the expectation previously defined by the When method
+func (e *CoreMockListPresetsExpectation) Then(ra1 []auth.RolePreset, err error) *CoreMock {
+	e.results = &CoreMockListPresetsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.ListPresets should be invoked
+func (mmListPresets *mCoreMockListPresets) Times(n uint64) *mCoreMockListPresets {
+	if n == 0 {
+		mmListPresets.mock.t.Fatalf("Times of CoreMock.ListPresets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPresets.expectedInvocations, n)
+	mmListPresets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPresets
+}
+
+func (mmListPresets *mCoreMockListPresets) invocationsDone() bool {
+	if len(mmListPresets.expectations) == 0 && mmListPresets.defaultExpectation == nil && mmListPresets.mock.funcListPresets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPresets.mock.afterListPresetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPresets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPresets implements mm_usecase.Core
+func (mmListPresets *CoreMock) ListPresets(ctx context.Context) (ra1 []auth.RolePreset, err error) {
+	mm_atomic.AddUint64(&mmListPresets.beforeListPresetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPresets.afterListPresetsCounter, 1)
+
+	mmListPresets.t.Helper()
+
+	if mmListPresets.inspectFuncListPresets != nil {
+		mmListPresets.inspectFuncListPresets(ctx)
+	}
+
+	mm_params := CoreMockListPresetsParams{ctx}
+
+	// Record call args
+	mmListPresets.ListPresetsMock.mutex.Lock()
+	mmListPresets.ListPresetsMock.callArgs = append(mmListPresets.ListPresetsMock.callArgs, &mm_params)
+	mmListPresets.ListPresetsMock.mutex.Unlock()
+
+	for _, e := range mmListPresets.ListPresetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListPresets.ListPresetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPresets.ListPresetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPresets.ListPresetsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPresets.ListPresetsMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockListPresetsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPresets.t.Errorf("CoreMock.ListPresets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPresets.t.Errorf("CoreMock.ListPresets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPresets.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPresets.ListPresetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPresets.t.Fatal("No results are set for the CoreMock.ListPresets")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListPresets.funcListPresets != nil {
+		return mmListPresets.funcListPresets(ctx)
+	}
+	mmListPresets.t.Fatalf("Unexpected call to CoreMock.ListPresets. %v", ctx)
+	return
+}
+
+// ListPresetsAfterCounter returns a count of finished CoreMock.ListPresets invocations
+func (mmListPresets *CoreMock) ListPresetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.afterListPresetsCounter)
+}
+
+// ListPresetsBeforeCounter returns a count of CoreMock.ListPresets invocations
+func (mmListPresets *CoreMock) ListPresetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresets.beforeListPresetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.ListPresets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPresets *mCoreMockListPresets) Calls() []*CoreMockListPresetsParams {
+	mmListPresets.mutex.RLock()
+
+	argCopy := make([]*CoreMockListPresetsParams, len(mmListPresets.callArgs))
+	copy(argCopy, mmListPresets.callArgs)
+
+	mmListPresets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPresetsDone returns true if the count of the ListPresets invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockListPresetsDone() bool {
+	if m.ListPresetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPresetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPresetsMock.invocationsDone()
+}
+
+// MinimockListPresetsInspect logs each unmet expectation
+func (m *CoreMock) MinimockListPresetsInspect() {
+	for _, e := range m.ListPresetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.ListPresets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPresetsCounter := mm_atomic.LoadUint64(&m.afterListPresetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPresetsMock.defaultExpectation != nil && afterListPresetsCounter < 1 {
+		if m.ListPresetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.ListPresets at\n%s", m.ListPresetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.ListPresets at\n%s with params: %#v", m.ListPresetsMock.defaultExpectation.expectationOrigins.origin, *m.ListPresetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPresets != nil && afterListPresetsCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.ListPresets at\n%s", m.funcListPresetsOrigin)
+	}
+
+	if !m.ListPresetsMock.invocationsDone() && afterListPresetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.ListPresets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPresetsMock.expectedInvocations), m.ListPresetsMock.expectedInvocationsOrigin, afterListPresetsCounter)
+	}
+}
+
+type mCoreMockListUserRoles struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockListUserRolesExpectation
+	expectations       []*CoreMockListUserRolesExpectation
+
+	callArgs []*CoreMockListUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockListUserRolesExpectation specifies expectation struct of the Core.ListUserRoles
+type CoreMockListUserRolesExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockListUserRolesParams
+	paramPtrs          *CoreMockListUserRolesParamPtrs
+	expectationOrigins CoreMockListUserRolesExpectationOrigins
+	results            *CoreMockListUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockListUserRolesParams contains parameters of the Core.ListUserRoles
+type CoreMockListUserRolesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CoreMockListUserRolesParamPtrs contains pointers to parameters of the Core.ListUserRoles
+type CoreMockListUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockListUserRolesResults contains results of the Core.ListUserRoles
+type CoreMockListUserRolesResults struct {
+	ua1 []auth.UserRole
+	err error
+}
+
+// CoreMockListUserRolesOrigins contains origins of expectations of the Core.ListUserRoles
+type CoreMockListUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUserRoles *mCoreMockListUserRoles) Optional() *mCoreMockListUserRoles {
+	mmListUserRoles.optional = true
+	return mmListUserRoles
+}
+
+// Expect sets up expected params for Core.ListUserRoles
+func (mmListUserRoles *mCoreMockListUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListUserRoles.defaultExpectation.params = &CoreMockListUserRolesParams{ctx, userID}
+	mmListUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUserRoles.expectations {
+		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
+			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.ListUserRoles
+func (mmListUserRoles *mCoreMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &CoreMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.ListUserRoles
+func (mmListUserRoles *mCoreMockListUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &CoreMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmListUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.ListUserRoles
+func (mmListUserRoles *mCoreMockListUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockListUserRoles {
+	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for CoreMock.ListUserRoles")
+	}
+
+	mmListUserRoles.mock.inspectFuncListUserRoles = f
+
+	return mmListUserRoles
+}
+
+// Return sets up results that will be returned by Core.ListUserRoles
+func (mmListUserRoles *mCoreMockListUserRoles) Return(ua1 []auth.UserRole, err error) *CoreMock {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	}
+	mmListUserRoles.defaultExpectation.results = &CoreMockListUserRolesResults{ua1, err}
+	mmListUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// Set uses given function f to mock the Core.ListUserRoles method
+func (mmListUserRoles *mCoreMockListUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)) *CoreMock {
+	if mmListUserRoles.defaultExpectation != nil {
+		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the Core.ListUserRoles method")
+	}
+
+	if len(mmListUserRoles.expectations) > 0 {
+		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the Core.ListUserRoles method")
+	}
+
+	mmListUserRoles.mock.funcListUserRoles = f
+	mmListUserRoles.mock.funcListUserRolesOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// When sets expectation for the Core.ListUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmListUserRoles *mCoreMockListUserRoles) When(ctx context.Context, userID uuid.UUID) *CoreMockListUserRolesExpectation {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	expectation := &CoreMockListUserRolesExpectation{
+		mock:               mmListUserRoles.mock,
+		params:             &CoreMockListUserRolesParams{ctx, userID},
+		expectationOrigins: CoreMockListUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.ListUserRoles return parameters for the expectation previously defined by the When method
+func (e *CoreMockListUserRolesExpectation) Then(ua1 []auth.UserRole, err error) *CoreMock {
+	e.results = &CoreMockListUserRolesResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.ListUserRoles should be invoked
+func (mmListUserRoles *mCoreMockListUserRoles) Times(n uint64) *mCoreMockListUserRoles {
+	if n == 0 {
+		mmListUserRoles.mock.t.Fatalf("Times of CoreMock.ListUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUserRoles.expectedInvocations, n)
+	mmListUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles
+}
+
+func (mmListUserRoles *mCoreMockListUserRoles) invocationsDone() bool {
+	if len(mmListUserRoles.expectations) == 0 && mmListUserRoles.defaultExpectation == nil && mmListUserRoles.mock.funcListUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUserRoles.mock.afterListUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUserRoles implements mm_usecase.Core
+func (mmListUserRoles *CoreMock) ListUserRoles(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+
+	mmListUserRoles.t.Helper()
+
+	if mmListUserRoles.inspectFuncListUserRoles != nil {
+		mmListUserRoles.inspectFuncListUserRoles(ctx, userID)
+	}
+
+	mm_params := CoreMockListUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmListUserRoles.ListUserRolesMock.mutex.Lock()
+	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
+	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockListUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserRoles.t.Fatal("No results are set for the CoreMock.ListUserRoles")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmListUserRoles.funcListUserRoles != nil {
+		return mmListUserRoles.funcListUserRoles(ctx, userID)
+	}
+	mmListUserRoles.t.Fatalf("Unexpected call to CoreMock.ListUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// ListUserRolesAfterCounter returns a count of finished CoreMock.ListUserRoles invocations
+func (mmListUserRoles *CoreMock) ListUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+}
+
+// ListUserRolesBeforeCounter returns a count of CoreMock.ListUserRoles invocations
+func (mmListUserRoles *CoreMock) ListUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.ListUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserRoles *mCoreMockListUserRoles) Calls() []*CoreMockListUserRolesParams {
+	mmListUserRoles.mutex.RLock()
+
+	argCopy := make([]*CoreMockListUserRolesParams, len(mmListUserRoles.callArgs))
+	copy(argCopy, mmListUserRoles.callArgs)
+
+	mmListUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockListUserRolesDone() bool {
+	if m.ListUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUserRolesMock.invocationsDone()
+}
+
+// MinimockListUserRolesInspect logs each unmet expectation
+func (m *CoreMock) MinimockListUserRolesInspect() {
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUserRolesCounter := mm_atomic.LoadUint64(&m.afterListUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserRolesMock.defaultExpectation != nil && afterListUserRolesCounter < 1 {
+		if m.ListUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s", m.ListUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s with params: %#v", m.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserRoles != nil && afterListUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s", m.funcListUserRolesOrigin)
+	}
+
+	if !m.ListUserRolesMock.invocationsDone() && afterListUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.ListUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUserRolesMock.expectedInvocations), m.ListUserRolesMock.expectedInvocationsOrigin, afterListUserRolesCounter)
+	}
+}
+
+type mCoreMockRecordLogin struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockRecordLoginExpectation
+	expectations       []*CoreMockRecordLoginExpectation
+
+	callArgs []*CoreMockRecordLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockRecordLoginExpectation specifies expectation struct of the Core.RecordLogin
+type CoreMockRecordLoginExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockRecordLoginParams
+	paramPtrs          *CoreMockRecordLoginParamPtrs
+	expectationOrigins CoreMockRecordLoginExpectationOrigins
+	results            *CoreMockRecordLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockRecordLoginParams contains parameters of the Core.RecordLogin
+type CoreMockRecordLoginParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	client  auth.Client
+	success bool
+}
+
+// CoreMockRecordLoginParamPtrs contains pointers to parameters of the Core.RecordLogin
+type CoreMockRecordLoginParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	client  *auth.Client
+	success *bool
+}
+
+// CoreMockRecordLoginResults contains results of the Core.RecordLogin
+type CoreMockRecordLoginResults struct {
+	l1  auth.LoginEvent
+	err error
+}
+
+// CoreMockRecordLoginOrigins contains origins of expectations of the Core.RecordLogin
+type CoreMockRecordLoginExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originClient  string
+	originSuccess string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordLogin *mCoreMockRecordLogin) Optional() *mCoreMockRecordLogin {
+	mmRecordLogin.optional = true
+	return mmRecordLogin
+}
+
+// Expect sets up expected params for Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) Expect(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{}
+	}
+
+	if mmRecordLogin.defaultExpectation.paramPtrs != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by ExpectParams functions")
+	}
+
+	mmRecordLogin.defaultExpectation.params = &CoreMockRecordLoginParams{ctx, userID, client, success}
+	mmRecordLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordLogin.expectations {
+		if minimock.Equal(e.params, mmRecordLogin.defaultExpectation.params) {
+			mmRecordLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) ExpectCtxParam1(ctx context.Context) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{}
+	}
+
+	if mmRecordLogin.defaultExpectation.params != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Expect")
+	}
+
+	if mmRecordLogin.defaultExpectation.paramPtrs == nil {
+		mmRecordLogin.defaultExpectation.paramPtrs = &CoreMockRecordLoginParamPtrs{}
+	}
+	mmRecordLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordLogin
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{}
+	}
+
+	if mmRecordLogin.defaultExpectation.params != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Expect")
+	}
+
+	if mmRecordLogin.defaultExpectation.paramPtrs == nil {
+		mmRecordLogin.defaultExpectation.paramPtrs = &CoreMockRecordLoginParamPtrs{}
+	}
+	mmRecordLogin.defaultExpectation.paramPtrs.userID = &userID
+	mmRecordLogin.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRecordLogin
+}
+
+// ExpectClientParam3 sets up expected param client for Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) ExpectClientParam3(client auth.Client) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{}
+	}
+
+	if mmRecordLogin.defaultExpectation.params != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Expect")
+	}
+
+	if mmRecordLogin.defaultExpectation.paramPtrs == nil {
+		mmRecordLogin.defaultExpectation.paramPtrs = &CoreMockRecordLoginParamPtrs{}
+	}
+	mmRecordLogin.defaultExpectation.paramPtrs.client = &client
+	mmRecordLogin.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmRecordLogin
+}
+
+// ExpectSuccessParam4 sets up expected param success for Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) ExpectSuccessParam4(success bool) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{}
+	}
+
+	if mmRecordLogin.defaultExpectation.params != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Expect")
+	}
+
+	if mmRecordLogin.defaultExpectation.paramPtrs == nil {
+		mmRecordLogin.defaultExpectation.paramPtrs = &CoreMockRecordLoginParamPtrs{}
+	}
+	mmRecordLogin.defaultExpectation.paramPtrs.success = &success
+	mmRecordLogin.defaultExpectation.expectationOrigins.originSuccess = minimock.CallerInfo(1)
+
+	return mmRecordLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) Inspect(f func(ctx context.Context, userID uuid.UUID, client auth.Client, success bool)) *mCoreMockRecordLogin {
+	if mmRecordLogin.mock.inspectFuncRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("Inspect function is already set for CoreMock.RecordLogin")
+	}
+
+	mmRecordLogin.mock.inspectFuncRecordLogin = f
+
+	return mmRecordLogin
+}
+
+// Return sets up results that will be returned by Core.RecordLogin
+func (mmRecordLogin *mCoreMockRecordLogin) Return(l1 auth.LoginEvent, err error) *CoreMock {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	if mmRecordLogin.defaultExpectation == nil {
+		mmRecordLogin.defaultExpectation = &CoreMockRecordLoginExpectation{mock: mmRecordLogin.mock}
+	}
+	mmRecordLogin.defaultExpectation.results = &CoreMockRecordLoginResults{l1, err}
+	mmRecordLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordLogin.mock
+}
+
+// Set uses given function f to mock the Core.RecordLogin method
+func (mmRecordLogin *mCoreMockRecordLogin) Set(f func(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) (l1 auth.LoginEvent, err error)) *CoreMock {
+	if mmRecordLogin.defaultExpectation != nil {
+		mmRecordLogin.mock.t.Fatalf("Default expectation is already set for the Core.RecordLogin method")
+	}
+
+	if len(mmRecordLogin.expectations) > 0 {
+		mmRecordLogin.mock.t.Fatalf("Some expectations are already set for the Core.RecordLogin method")
+	}
+
+	mmRecordLogin.mock.funcRecordLogin = f
+	mmRecordLogin.mock.funcRecordLoginOrigin = minimock.CallerInfo(1)
+	return mmRecordLogin.mock
+}
+
+// When sets expectation for the Core.RecordLogin which will trigger the result defined by the following
+// Then helper
+func (mmRecordLogin *mCoreMockRecordLogin) When(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) *CoreMockRecordLoginExpectation {
+	if mmRecordLogin.mock.funcRecordLogin != nil {
+		mmRecordLogin.mock.t.Fatalf("CoreMock.RecordLogin mock is already set by Set")
+	}
+
+	expectation := &CoreMockRecordLoginExpectation{
+		mock:               mmRecordLogin.mock,
+		params:             &CoreMockRecordLoginParams{ctx, userID, client, success},
+		expectationOrigins: CoreMockRecordLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordLogin.expectations = append(mmRecordLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.RecordLogin return parameters for the expectation previously defined by the When method
+func (e *CoreMockRecordLoginExpectation) Then(l1 auth.LoginEvent, err error) *CoreMock {
+	e.results = &CoreMockRecordLoginResults{l1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.RecordLogin should be invoked
+func (mmRecordLogin *mCoreMockRecordLogin) Times(n uint64) *mCoreMockRecordLogin {
+	if n == 0 {
+		mmRecordLogin.mock.t.Fatalf("Times of CoreMock.RecordLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordLogin.expectedInvocations, n)
+	mmRecordLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordLogin
+}
+
+func (mmRecordLogin *mCoreMockRecordLogin) invocationsDone() bool {
+	if len(mmRecordLogin.expectations) == 0 && mmRecordLogin.defaultExpectation == nil && mmRecordLogin.mock.funcRecordLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordLogin.mock.afterRecordLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordLogin implements mm_usecase.Core
+func (mmRecordLogin *CoreMock) RecordLogin(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) (l1 auth.LoginEvent, err error) {
+	mm_atomic.AddUint64(&mmRecordLogin.beforeRecordLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordLogin.afterRecordLoginCounter, 1)
+
+	mmRecordLogin.t.Helper()
+
+	if mmRecordLogin.inspectFuncRecordLogin != nil {
+		mmRecordLogin.inspectFuncRecordLogin(ctx, userID, client, success)
+	}
+
+	mm_params := CoreMockRecordLoginParams{ctx, userID, client, success}
+
+	// Record call args
+	mmRecordLogin.RecordLoginMock.mutex.Lock()
+	mmRecordLogin.RecordLoginMock.callArgs = append(mmRecordLogin.RecordLoginMock.callArgs, &mm_params)
+	mmRecordLogin.RecordLoginMock.mutex.Unlock()
+
+	for _, e := range mmRecordLogin.RecordLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.l1, e.results.err
+		}
+	}
+
+	if mmRecordLogin.RecordLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordLogin.RecordLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordLogin.RecordLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordLogin.RecordLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockRecordLoginParams{ctx, userID, client, success}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordLogin.t.Errorf("CoreMock.RecordLogin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordLogin.RecordLoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRecordLogin.t.Errorf("CoreMock.RecordLogin got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordLogin.RecordLoginMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmRecordLogin.t.Errorf("CoreMock.RecordLogin got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordLogin.RecordLoginMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.success != nil && !minimock.Equal(*mm_want_ptrs.success, mm_got.success) {
+				mmRecordLogin.t.Errorf("CoreMock.RecordLogin got unexpected parameter success, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordLogin.RecordLoginMock.defaultExpectation.expectationOrigins.originSuccess, *mm_want_ptrs.success, mm_got.success, minimock.Diff(*mm_want_ptrs.success, mm_got.success))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordLogin.t.Errorf("CoreMock.RecordLogin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordLogin.RecordLoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordLogin.RecordLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordLogin.t.Fatal("No results are set for the CoreMock.RecordLogin")
+		}
+		return (*mm_results).l1, (*mm_results).err
+	}
+	if mmRecordLogin.funcRecordLogin != nil {
+		return mmRecordLogin.funcRecordLogin(ctx, userID, client, success)
+	}
+	mmRecordLogin.t.Fatalf("Unexpected call to CoreMock.RecordLogin. %v %v %v %v", ctx, userID, client, success)
+	return
+}
+
+// RecordLoginAfterCounter returns a count of finished CoreMock.RecordLogin invocations
+func (mmRecordLogin *CoreMock) RecordLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordLogin.afterRecordLoginCounter)
+}
+
+// RecordLoginBeforeCounter returns a count of CoreMock.RecordLogin invocations
+func (mmRecordLogin *CoreMock) RecordLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordLogin.beforeRecordLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.RecordLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordLogin *mCoreMockRecordLogin) Calls() []*CoreMockRecordLoginParams {
+	mmRecordLogin.mutex.RLock()
+
+	argCopy := make([]*CoreMockRecordLoginParams, len(mmRecordLogin.callArgs))
+	copy(argCopy, mmRecordLogin.callArgs)
+
+	mmRecordLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordLoginDone returns true if the count of the RecordLogin invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockRecordLoginDone() bool {
+	if m.RecordLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordLoginMock.invocationsDone()
+}
+
+// MinimockRecordLoginInspect logs each unmet expectation
+func (m *CoreMock) MinimockRecordLoginInspect() {
+	for _, e := range m.RecordLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.RecordLogin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordLoginCounter := mm_atomic.LoadUint64(&m.afterRecordLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordLoginMock.defaultExpectation != nil && afterRecordLoginCounter < 1 {
+		if m.RecordLoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.RecordLogin at\n%s", m.RecordLoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.RecordLogin at\n%s with params: %#v", m.RecordLoginMock.defaultExpectation.expectationOrigins.origin, *m.RecordLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordLogin != nil && afterRecordLoginCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.RecordLogin at\n%s", m.funcRecordLoginOrigin)
+	}
+
+	if !m.RecordLoginMock.invocationsDone() && afterRecordLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.RecordLogin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordLoginMock.expectedInvocations), m.RecordLoginMock.expectedInvocationsOrigin, afterRecordLoginCounter)
+	}
+}
+
+type mCoreMockRefreshTokens struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockRefreshTokensExpectation
+	expectations       []*CoreMockRefreshTokensExpectation
+
+	callArgs []*CoreMockRefreshTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockRefreshTokensExpectation specifies expectation struct of the Core.RefreshTokens
+type CoreMockRefreshTokensExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockRefreshTokensParams
+	paramPtrs          *CoreMockRefreshTokensParamPtrs
+	expectationOrigins CoreMockRefreshTokensExpectationOrigins
+	results            *CoreMockRefreshTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockRefreshTokensParams contains parameters of the Core.RefreshTokens
+type CoreMockRefreshTokensParams struct {
+	ctx          context.Context
+	session      auth.Session
+	refreshToken string
+	rtHash       string
+	scopes       auth.Scopes
+	client       auth.Client
+}
+
+// CoreMockRefreshTokensParamPtrs contains pointers to parameters of the Core.RefreshTokens
+type CoreMockRefreshTokensParamPtrs struct {
+	ctx          *context.Context
+	session      *auth.Session
+	refreshToken *string
+	rtHash       *string
+	scopes       *auth.Scopes
+	client       *auth.Client
+}
+
+// CoreMockRefreshTokensResults contains results of the Core.RefreshTokens
+type CoreMockRefreshTokensResults struct {
+	t1  auth.Tokens
+	err error
+}
+
+// CoreMockRefreshTokensOrigins contains origins of expectations of the Core.RefreshTokens
+type CoreMockRefreshTokensExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originSession      string
+	originRefreshToken string
+	originRtHash       string
+	originScopes       string
+	originClient       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshTokens *mCoreMockRefreshTokens) Optional() *mCoreMockRefreshTokens {
+	mmRefreshTokens.optional = true
+	return mmRefreshTokens
+}
+
+// Expect sets up expected params for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) Expect(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
 	}
 
-	mmIssueTokens.mock.funcIssueTokens = f
-	mmIssueTokens.mock.funcIssueTokensOrigin = minimock.CallerInfo(1)
-	return mmIssueTokens.mock
+	if mmRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by ExpectParams functions")
+	}
+
+	mmRefreshTokens.defaultExpectation.params = &CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash, scopes, client}
+	mmRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmRefreshTokens.defaultExpectation.params) {
+			mmRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmRefreshTokens
 }
 
-// When sets expectation for the Core.IssueTokens which will trigger the result defined by the following
+// ExpectCtxParam1 sets up expected param ctx for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectCtxParam1(ctx context.Context) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// ExpectSessionParam2 sets up expected param session for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectSessionParam2(session auth.Session) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.session = &session
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// ExpectRefreshTokenParam3 sets up expected param refreshToken for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectRefreshTokenParam3(refreshToken string) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// ExpectRtHashParam4 sets up expected param rtHash for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectRtHashParam4(rtHash string) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.rtHash = &rtHash
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originRtHash = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// ExpectScopesParam5 sets up expected param scopes for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectScopesParam5(scopes auth.Scopes) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.scopes = &scopes
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originScopes = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// ExpectClientParam6 sets up expected param client for Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectClientParam6(client auth.Client) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	}
+
+	if mmRefreshTokens.defaultExpectation.params != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	}
+
+	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	}
+	mmRefreshTokens.defaultExpectation.paramPtrs.client = &client
+	mmRefreshTokens.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmRefreshTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) Inspect(f func(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client)) *mCoreMockRefreshTokens {
+	if mmRefreshTokens.mock.inspectFuncRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("Inspect function is already set for CoreMock.RefreshTokens")
+	}
+
+	mmRefreshTokens.mock.inspectFuncRefreshTokens = f
+
+	return mmRefreshTokens
+}
+
+// Return sets up results that will be returned by Core.RefreshTokens
+func (mmRefreshTokens *mCoreMockRefreshTokens) Return(t1 auth.Tokens, err error) *CoreMock {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+	}
+
+	if mmRefreshTokens.defaultExpectation == nil {
+		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{mock: mmRefreshTokens.mock}
+	}
+	mmRefreshTokens.defaultExpectation.results = &CoreMockRefreshTokensResults{t1, err}
+	mmRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens.mock
+}
+
+// Set uses given function f to mock the Core.RefreshTokens method
+func (mmRefreshTokens *mCoreMockRefreshTokens) Set(f func(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client) (t1 auth.Tokens, err error)) *CoreMock {
+	if mmRefreshTokens.defaultExpectation != nil {
+		mmRefreshTokens.mock.t.Fatalf("Default expectation is already set for the Core.RefreshTokens method")
+	}
+
+	if len(mmRefreshTokens.expectations) > 0 {
+		mmRefreshTokens.mock.t.Fatalf("Some expectations are already set for the Core.RefreshTokens method")
+	}
+
+	mmRefreshTokens.mock.funcRefreshTokens = f
+	mmRefreshTokens.mock.funcRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens.mock
+}
+
+// When sets expectation for the Core.RefreshTokens which will trigger the result defined by the following
 // Then helper
-func (mmIssueTokens *mCoreMockIssueTokens) When(ctx context.Context, userID uuid.UUID, sessionVersion int) *CoreMockIssueTokensExpectation {
-	if mmIssueTokens.mock.funcIssueTokens != nil {
-		mmIssueTokens.mock.t.Fatalf("CoreMock.IssueTokens mock is already set by Set")
+func (mmRefreshTokens *mCoreMockRefreshTokens) When(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client) *CoreMockRefreshTokensExpectation {
+	if mmRefreshTokens.mock.funcRefreshTokens != nil {
+		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
 	}
 
-	expectation := &CoreMockIssueTokensExpectation{
-		mock:               mmIssueTokens.mock,
-		params:             &CoreMockIssueTokensParams{ctx, userID, sessionVersion},
-		expectationOrigins: CoreMockIssueTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockRefreshTokensExpectation{
+		mock:               mmRefreshTokens.mock,
+		params:             &CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash, scopes, client},
+		expectationOrigins: CoreMockRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmIssueTokens.expectations = append(mmIssueTokens.expectations, expectation)
+	mmRefreshTokens.expectations = append(mmRefreshTokens.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.IssueTokens return parameters for the expectation previously defined by the When method
-func (e *CoreMockIssueTokensExpectation) Then(t1 auth.Tokens, err error) *CoreMock {
-	e.results = &CoreMockIssueTokensResults{t1, err}
+// Then sets up Core.RefreshTokens return parameters for the expectation previously defined by the When method
+func (e *CoreMockRefreshTokensExpectation) Then(t1 auth.Tokens, err error) *CoreMock {
+	e.results = &CoreMockRefreshTokensResults{t1, err}
 	return e.mock
 }
 
-// Times sets number of times Core.IssueTokens should be invoked
-func (mmIssueTokens *mCoreMockIssueTokens) Times(n uint64) *mCoreMockIssueTokens {
+// Times sets number of times Core.RefreshTokens should be invoked
+func (mmRefreshTokens *mCoreMockRefreshTokens) Times(n uint64) *mCoreMockRefreshTokens {
 	if n == 0 {
-		mmIssueTokens.mock.t.Fatalf("Times of CoreMock.IssueTokens mock can not be zero")
+		mmRefreshTokens.mock.t.Fatalf("Times of CoreMock.RefreshTokens mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmIssueTokens.expectedInvocations, n)
-	mmIssueTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmIssueTokens
+	mm_atomic.StoreUint64(&mmRefreshTokens.expectedInvocations, n)
+	mmRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefreshTokens
 }
 
-func (mmIssueTokens *mCoreMockIssueTokens) invocationsDone() bool {
-	if len(mmIssueTokens.expectations) == 0 && mmIssueTokens.defaultExpectation == nil && mmIssueTokens.mock.funcIssueTokens == nil {
+func (mmRefreshTokens *mCoreMockRefreshTokens) invocationsDone() bool {
+	if len(mmRefreshTokens.expectations) == 0 && mmRefreshTokens.defaultExpectation == nil && mmRefreshTokens.mock.funcRefreshTokens == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmIssueTokens.mock.afterIssueTokensCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmIssueTokens.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.mock.afterRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// IssueTokens implements mm_usecase.Core
-func (mmIssueTokens *CoreMock) IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int) (t1 auth.Tokens, err error) {
-	mm_atomic.AddUint64(&mmIssueTokens.beforeIssueTokensCounter, 1)
-	defer mm_atomic.AddUint64(&mmIssueTokens.afterIssueTokensCounter, 1)
+// RefreshTokens implements mm_usecase.Core
+func (mmRefreshTokens *CoreMock) RefreshTokens(ctx context.Context, session auth.Session, refreshToken string, rtHash string, scopes auth.Scopes, client auth.Client) (t1 auth.Tokens, err error) {
+	mm_atomic.AddUint64(&mmRefreshTokens.beforeRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshTokens.afterRefreshTokensCounter, 1)
 
-	mmIssueTokens.t.Helper()
+	mmRefreshTokens.t.Helper()
 
-	if mmIssueTokens.inspectFuncIssueTokens != nil {
-		mmIssueTokens.inspectFuncIssueTokens(ctx, userID, sessionVersion)
+	if mmRefreshTokens.inspectFuncRefreshTokens != nil {
+		mmRefreshTokens.inspectFuncRefreshTokens(ctx, session, refreshToken, rtHash, scopes, client)
 	}
 
-	mm_params := CoreMockIssueTokensParams{ctx, userID, sessionVersion}
+	mm_params := CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash, scopes, client}
 
 	// Record call args
-	mmIssueTokens.IssueTokensMock.mutex.Lock()
-	mmIssueTokens.IssueTokensMock.callArgs = append(mmIssueTokens.IssueTokensMock.callArgs, &mm_params)
-	mmIssueTokens.IssueTokensMock.mutex.Unlock()
+	mmRefreshTokens.RefreshTokensMock.mutex.Lock()
+	mmRefreshTokens.RefreshTokensMock.callArgs = append(mmRefreshTokens.RefreshTokensMock.callArgs, &mm_params)
+	mmRefreshTokens.RefreshTokensMock.mutex.Unlock()
 
-	for _, e := range mmIssueTokens.IssueTokensMock.expectations {
+	for _, e := range mmRefreshTokens.RefreshTokensMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.t1, e.results.err
 		}
 	}
 
-	if mmIssueTokens.IssueTokensMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmIssueTokens.IssueTokensMock.defaultExpectation.Counter, 1)
-		mm_want := mmIssueTokens.IssueTokensMock.defaultExpectation.params
-		mm_want_ptrs := mmIssueTokens.IssueTokensMock.defaultExpectation.paramPtrs
-
-		mm_got := CoreMockIssueTokensParams{ctx, userID, sessionVersion}
+	if mmRefreshTokens.RefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshTokens.RefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshTokens.RefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshTokens.RefreshTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash, scopes, client}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
 			}
 
-			if mm_want_ptrs.sessionVersion != nil && !minimock.Equal(*mm_want_ptrs.sessionVersion, mm_got.sessionVersion) {
-				mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameter sessionVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.originSessionVersion, *mm_want_ptrs.sessionVersion, mm_got.sessionVersion, minimock.Diff(*mm_want_ptrs.sessionVersion, mm_got.sessionVersion))
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+			if mm_want_ptrs.rtHash != nil && !minimock.Equal(*mm_want_ptrs.rtHash, mm_got.rtHash) {
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter rtHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originRtHash, *mm_want_ptrs.rtHash, mm_got.rtHash, minimock.Diff(*mm_want_ptrs.rtHash, mm_got.rtHash))
+			}
+
+			if mm_want_ptrs.scopes != nil && !minimock.Equal(*mm_want_ptrs.scopes, mm_got.scopes) {
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter scopes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originScopes, *mm_want_ptrs.scopes, mm_got.scopes, minimock.Diff(*mm_want_ptrs.scopes, mm_got.scopes))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmIssueTokens.t.Errorf("CoreMock.IssueTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmIssueTokens.IssueTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmIssueTokens.IssueTokensMock.defaultExpectation.results
+		mm_results := mmRefreshTokens.RefreshTokensMock.defaultExpectation.results
 		if mm_results == nil {
-			mmIssueTokens.t.Fatal("No results are set for the CoreMock.IssueTokens")
+			mmRefreshTokens.t.Fatal("No results are set for the CoreMock.RefreshTokens")
 		}
 		return (*mm_results).t1, (*mm_results).err
 	}
-	if mmIssueTokens.funcIssueTokens != nil {
-		return mmIssueTokens.funcIssueTokens(ctx, userID, sessionVersion)
+	if mmRefreshTokens.funcRefreshTokens != nil {
+		return mmRefreshTokens.funcRefreshTokens(ctx, session, refreshToken, rtHash, scopes, client)
 	}
-	mmIssueTokens.t.Fatalf("Unexpected call to CoreMock.IssueTokens. %v %v %v", ctx, userID, sessionVersion)
+	mmRefreshTokens.t.Fatalf("Unexpected call to CoreMock.RefreshTokens. %v %v %v %v %v %v", ctx, session, refreshToken, rtHash, scopes, client)
 	return
 }
 
-// IssueTokensAfterCounter returns a count of finished CoreMock.IssueTokens invocations
-func (mmIssueTokens *CoreMock) IssueTokensAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmIssueTokens.afterIssueTokensCounter)
+// RefreshTokensAfterCounter returns a count of finished CoreMock.RefreshTokens invocations
+func (mmRefreshTokens *CoreMock) RefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshTokens.afterRefreshTokensCounter)
 }
 
-// IssueTokensBeforeCounter returns a count of CoreMock.IssueTokens invocations
-func (mmIssueTokens *CoreMock) IssueTokensBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmIssueTokens.beforeIssueTokensCounter)
+// RefreshTokensBeforeCounter returns a count of CoreMock.RefreshTokens invocations
+func (mmRefreshTokens *CoreMock) RefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshTokens.beforeRefreshTokensCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.IssueTokens.
+// Calls returns a list of arguments used in each call to CoreMock.RefreshTokens.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmIssueTokens *mCoreMockIssueTokens) Calls() []*CoreMockIssueTokensParams {
-	mmIssueTokens.mutex.RLock()
+func (mmRefreshTokens *mCoreMockRefreshTokens) Calls() []*CoreMockRefreshTokensParams {
+	mmRefreshTokens.mutex.RLock()
 
-	argCopy := make([]*CoreMockIssueTokensParams, len(mmIssueTokens.callArgs))
-	copy(argCopy, mmIssueTokens.callArgs)
+	argCopy := make([]*CoreMockRefreshTokensParams, len(mmRefreshTokens.callArgs))
+	copy(argCopy, mmRefreshTokens.callArgs)
 
-	mmIssueTokens.mutex.RUnlock()
+	mmRefreshTokens.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockIssueTokensDone returns true if the count of the IssueTokens invocations corresponds
+// MinimockRefreshTokensDone returns true if the count of the RefreshTokens invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockIssueTokensDone() bool {
-	if m.IssueTokensMock.optional {
+func (m *CoreMock) MinimockRefreshTokensDone() bool {
+	if m.RefreshTokensMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.IssueTokensMock.expectations {
+	for _, e := range m.RefreshTokensMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.IssueTokensMock.invocationsDone()
+	return m.RefreshTokensMock.invocationsDone()
 }
 
-// MinimockIssueTokensInspect logs each unmet expectation
-func (m *CoreMock) MinimockIssueTokensInspect() {
-	for _, e := range m.IssueTokensMock.expectations {
+// MinimockRefreshTokensInspect logs each unmet expectation
+func (m *CoreMock) MinimockRefreshTokensInspect() {
+	for _, e := range m.RefreshTokensMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterIssueTokensCounter := mm_atomic.LoadUint64(&m.afterIssueTokensCounter)
+	afterRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterRefreshTokensCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.IssueTokensMock.defaultExpectation != nil && afterIssueTokensCounter < 1 {
-		if m.IssueTokensMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s", m.IssueTokensMock.defaultExpectation.returnOrigin)
+	if m.RefreshTokensMock.defaultExpectation != nil && afterRefreshTokensCounter < 1 {
+		if m.RefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s", m.RefreshTokensMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s with params: %#v", m.IssueTokensMock.defaultExpectation.expectationOrigins.origin, *m.IssueTokensMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s with params: %#v", m.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.RefreshTokensMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcIssueTokens != nil && afterIssueTokensCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.IssueTokens at\n%s", m.funcIssueTokensOrigin)
+	if m.funcRefreshTokens != nil && afterRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s", m.funcRefreshTokensOrigin)
 	}
 
-	if !m.IssueTokensMock.invocationsDone() && afterIssueTokensCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.IssueTokens at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.IssueTokensMock.expectedInvocations), m.IssueTokensMock.expectedInvocationsOrigin, afterIssueTokensCounter)
+	if !m.RefreshTokensMock.invocationsDone() && afterRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.RefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshTokensMock.expectedInvocations), m.RefreshTokensMock.expectedInvocationsOrigin, afterRefreshTokensCounter)
 	}
 }
 
-type mCoreMockListUserRoles struct {
+type mCoreMockRepairGrants struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockListUserRolesExpectation
-	expectations       []*CoreMockListUserRolesExpectation
+	defaultExpectation *CoreMockRepairGrantsExpectation
+	expectations       []*CoreMockRepairGrantsExpectation
 
-	callArgs []*CoreMockListUserRolesParams
+	callArgs []*CoreMockRepairGrantsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockListUserRolesExpectation specifies expectation struct of the Core.ListUserRoles
-type CoreMockListUserRolesExpectation struct {
+// CoreMockRepairGrantsExpectation specifies expectation struct of the Core.RepairGrants
+type CoreMockRepairGrantsExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockListUserRolesParams
-	paramPtrs          *CoreMockListUserRolesParamPtrs
-	expectationOrigins CoreMockListUserRolesExpectationOrigins
-	results            *CoreMockListUserRolesResults
+	params             *CoreMockRepairGrantsParams
+	paramPtrs          *CoreMockRepairGrantsParamPtrs
+	expectationOrigins CoreMockRepairGrantsExpectationOrigins
+	results            *CoreMockRepairGrantsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockListUserRolesParams contains parameters of the Core.ListUserRoles
-type CoreMockListUserRolesParams struct {
+// CoreMockRepairGrantsParams contains parameters of the Core.RepairGrants
+type CoreMockRepairGrantsParams struct {
 	ctx    context.Context
-	userID uuid.UUID
+	dryRun bool
 }
 
-// CoreMockListUserRolesParamPtrs contains pointers to parameters of the Core.ListUserRoles
-type CoreMockListUserRolesParamPtrs struct {
+// CoreMockRepairGrantsParamPtrs contains pointers to parameters of the Core.RepairGrants
+type CoreMockRepairGrantsParamPtrs struct {
 	ctx    *context.Context
-	userID *uuid.UUID
+	dryRun *bool
 }
 
-// CoreMockListUserRolesResults contains results of the Core.ListUserRoles
-type CoreMockListUserRolesResults struct {
-	ua1 []auth.UserRole
+// CoreMockRepairGrantsResults contains results of the Core.RepairGrants
+type CoreMockRepairGrantsResults struct {
+	c2  auth.ConsistencyReport
 	err error
 }
 
-// CoreMockListUserRolesOrigins contains origins of expectations of the Core.ListUserRoles
-type CoreMockListUserRolesExpectationOrigins struct {
+// CoreMockRepairGrantsOrigins contains origins of expectations of the Core.RepairGrants
+type CoreMockRepairGrantsExpectationOrigins struct {
 	origin       string
 	originCtx    string
-	originUserID string
+	originDryRun string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -3633,348 +7473,344 @@ type CoreMockListUserRolesExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListUserRoles *mCoreMockListUserRoles) Optional() *mCoreMockListUserRoles {
-	mmListUserRoles.optional = true
-	return mmListUserRoles
+func (mmRepairGrants *mCoreMockRepairGrants) Optional() *mCoreMockRepairGrants {
+	mmRepairGrants.optional = true
+	return mmRepairGrants
 }
 
-// Expect sets up expected params for Core.ListUserRoles
-func (mmListUserRoles *mCoreMockListUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+// Expect sets up expected params for Core.RepairGrants
+func (mmRepairGrants *mCoreMockRepairGrants) Expect(ctx context.Context, dryRun bool) *mCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &CoreMockRepairGrantsExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by ExpectParams functions")
+	if mmRepairGrants.defaultExpectation.paramPtrs != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by ExpectParams functions")
 	}
 
-	mmListUserRoles.defaultExpectation.params = &CoreMockListUserRolesParams{ctx, userID}
-	mmListUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListUserRoles.expectations {
-		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
-			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+	mmRepairGrants.defaultExpectation.params = &CoreMockRepairGrantsParams{ctx, dryRun}
+	mmRepairGrants.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRepairGrants.expectations {
+		if minimock.Equal(e.params, mmRepairGrants.defaultExpectation.params) {
+			mmRepairGrants.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRepairGrants.defaultExpectation.params)
 		}
 	}
 
-	return mmListUserRoles
+	return mmRepairGrants
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.ListUserRoles
-func (mmListUserRoles *mCoreMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mCoreMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.RepairGrants
+func (mmRepairGrants *mCoreMockRepairGrants) ExpectCtxParam1(ctx context.Context) *mCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &CoreMockRepairGrantsExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.params != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Expect")
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Expect")
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
-		mmListUserRoles.defaultExpectation.paramPtrs = &CoreMockListUserRolesParamPtrs{}
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &CoreMockRepairGrantsParamPtrs{}
 	}
-	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmRepairGrants.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRepairGrants.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListUserRoles
+	return mmRepairGrants
 }
 
-// ExpectUserIDParam2 sets up expected param userID for Core.ListUserRoles
-func (mmListUserRoles *mCoreMockListUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockListUserRoles {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+// ExpectDryRunParam2 sets up expected param dryRun for Core.RepairGrants
+func (mmRepairGrants *mCoreMockRepairGrants) ExpectDryRunParam2(dryRun bool) *mCoreMockRepairGrants {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &CoreMockRepairGrantsExpectation{}
 	}
 
-	if mmListUserRoles.defaultExpectation.params != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Expect")
+	if mmRepairGrants.defaultExpectation.params != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Expect")
 	}
 
-	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
-		mmListUserRoles.defaultExpectation.paramPtrs = &CoreMockListUserRolesParamPtrs{}
+	if mmRepairGrants.defaultExpectation.paramPtrs == nil {
+		mmRepairGrants.defaultExpectation.paramPtrs = &CoreMockRepairGrantsParamPtrs{}
 	}
-	mmListUserRoles.defaultExpectation.paramPtrs.userID = &userID
-	mmListUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmRepairGrants.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmRepairGrants.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
 
-	return mmListUserRoles
+	return mmRepairGrants
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.ListUserRoles
-func (mmListUserRoles *mCoreMockListUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockListUserRoles {
-	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for CoreMock.ListUserRoles")
+// Inspect accepts an inspector function that has same arguments as the Core.RepairGrants
+func (mmRepairGrants *mCoreMockRepairGrants) Inspect(f func(ctx context.Context, dryRun bool)) *mCoreMockRepairGrants {
+	if mmRepairGrants.mock.inspectFuncRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("Inspect function is already set for CoreMock.RepairGrants")
 	}
 
-	mmListUserRoles.mock.inspectFuncListUserRoles = f
+	mmRepairGrants.mock.inspectFuncRepairGrants = f
 
-	return mmListUserRoles
+	return mmRepairGrants
 }
 
-// Return sets up results that will be returned by Core.ListUserRoles
-func (mmListUserRoles *mCoreMockListUserRoles) Return(ua1 []auth.UserRole, err error) *CoreMock {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+// Return sets up results that will be returned by Core.RepairGrants
+func (mmRepairGrants *mCoreMockRepairGrants) Return(c2 auth.ConsistencyReport, err error) *CoreMock {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Set")
 	}
 
-	if mmListUserRoles.defaultExpectation == nil {
-		mmListUserRoles.defaultExpectation = &CoreMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	if mmRepairGrants.defaultExpectation == nil {
+		mmRepairGrants.defaultExpectation = &CoreMockRepairGrantsExpectation{mock: mmRepairGrants.mock}
 	}
-	mmListUserRoles.defaultExpectation.results = &CoreMockListUserRolesResults{ua1, err}
-	mmListUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles.mock
+	mmRepairGrants.defaultExpectation.results = &CoreMockRepairGrantsResults{c2, err}
+	mmRepairGrants.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
 }
 
-// Set uses given function f to mock the Core.ListUserRoles method
-func (mmListUserRoles *mCoreMockListUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)) *CoreMock {
-	if mmListUserRoles.defaultExpectation != nil {
-		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the Core.ListUserRoles method")
+// Set uses given function f to mock the Core.RepairGrants method
+func (mmRepairGrants *mCoreMockRepairGrants) Set(f func(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error)) *CoreMock {
+	if mmRepairGrants.defaultExpectation != nil {
+		mmRepairGrants.mock.t.Fatalf("Default expectation is already set for the Core.RepairGrants method")
 	}
 
-	if len(mmListUserRoles.expectations) > 0 {
-		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the Core.ListUserRoles method")
+	if len(mmRepairGrants.expectations) > 0 {
+		mmRepairGrants.mock.t.Fatalf("Some expectations are already set for the Core.RepairGrants method")
 	}
 
-	mmListUserRoles.mock.funcListUserRoles = f
-	mmListUserRoles.mock.funcListUserRolesOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles.mock
+	mmRepairGrants.mock.funcRepairGrants = f
+	mmRepairGrants.mock.funcRepairGrantsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants.mock
 }
 
-// When sets expectation for the Core.ListUserRoles which will trigger the result defined by the following
+// When sets expectation for the Core.RepairGrants which will trigger the result defined by the following
 // Then helper
-func (mmListUserRoles *mCoreMockListUserRoles) When(ctx context.Context, userID uuid.UUID) *CoreMockListUserRolesExpectation {
-	if mmListUserRoles.mock.funcListUserRoles != nil {
-		mmListUserRoles.mock.t.Fatalf("CoreMock.ListUserRoles mock is already set by Set")
+func (mmRepairGrants *mCoreMockRepairGrants) When(ctx context.Context, dryRun bool) *CoreMockRepairGrantsExpectation {
+	if mmRepairGrants.mock.funcRepairGrants != nil {
+		mmRepairGrants.mock.t.Fatalf("CoreMock.RepairGrants mock is already set by Set")
 	}
 
-	expectation := &CoreMockListUserRolesExpectation{
-		mock:               mmListUserRoles.mock,
-		params:             &CoreMockListUserRolesParams{ctx, userID},
-		expectationOrigins: CoreMockListUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockRepairGrantsExpectation{
+		mock:               mmRepairGrants.mock,
+		params:             &CoreMockRepairGrantsParams{ctx, dryRun},
+		expectationOrigins: CoreMockRepairGrantsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	mmRepairGrants.expectations = append(mmRepairGrants.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.ListUserRoles return parameters for the expectation previously defined by the When method
-func (e *CoreMockListUserRolesExpectation) Then(ua1 []auth.UserRole, err error) *CoreMock {
-	e.results = &CoreMockListUserRolesResults{ua1, err}
+// Then sets up Core.RepairGrants return parameters for the expectation previously defined by the When method
+func (e *CoreMockRepairGrantsExpectation) Then(c2 auth.ConsistencyReport, err error) *CoreMock {
+	e.results = &CoreMockRepairGrantsResults{c2, err}
 	return e.mock
 }
 
-// Times sets number of times Core.ListUserRoles should be invoked
-func (mmListUserRoles *mCoreMockListUserRoles) Times(n uint64) *mCoreMockListUserRoles {
+// Times sets number of times Core.RepairGrants should be invoked
+func (mmRepairGrants *mCoreMockRepairGrants) Times(n uint64) *mCoreMockRepairGrants {
 	if n == 0 {
-		mmListUserRoles.mock.t.Fatalf("Times of CoreMock.ListUserRoles mock can not be zero")
+		mmRepairGrants.mock.t.Fatalf("Times of CoreMock.RepairGrants mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListUserRoles.expectedInvocations, n)
-	mmListUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListUserRoles
+	mm_atomic.StoreUint64(&mmRepairGrants.expectedInvocations, n)
+	mmRepairGrants.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRepairGrants
 }
 
-func (mmListUserRoles *mCoreMockListUserRoles) invocationsDone() bool {
-	if len(mmListUserRoles.expectations) == 0 && mmListUserRoles.defaultExpectation == nil && mmListUserRoles.mock.funcListUserRoles == nil {
+func (mmRepairGrants *mCoreMockRepairGrants) invocationsDone() bool {
+	if len(mmRepairGrants.expectations) == 0 && mmRepairGrants.defaultExpectation == nil && mmRepairGrants.mock.funcRepairGrants == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListUserRoles.mock.afterListUserRolesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListUserRoles.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRepairGrants.mock.afterRepairGrantsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRepairGrants.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListUserRoles implements mm_usecase.Core
-func (mmListUserRoles *CoreMock) ListUserRoles(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error) {
-	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+// RepairGrants implements mm_usecase.Core
+func (mmRepairGrants *CoreMock) RepairGrants(ctx context.Context, dryRun bool) (c2 auth.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmRepairGrants.beforeRepairGrantsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRepairGrants.afterRepairGrantsCounter, 1)
 
-	mmListUserRoles.t.Helper()
+	mmRepairGrants.t.Helper()
 
-	if mmListUserRoles.inspectFuncListUserRoles != nil {
-		mmListUserRoles.inspectFuncListUserRoles(ctx, userID)
+	if mmRepairGrants.inspectFuncRepairGrants != nil {
+		mmRepairGrants.inspectFuncRepairGrants(ctx, dryRun)
 	}
 
-	mm_params := CoreMockListUserRolesParams{ctx, userID}
+	mm_params := CoreMockRepairGrantsParams{ctx, dryRun}
 
 	// Record call args
-	mmListUserRoles.ListUserRolesMock.mutex.Lock()
-	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
-	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+	mmRepairGrants.RepairGrantsMock.mutex.Lock()
+	mmRepairGrants.RepairGrantsMock.callArgs = append(mmRepairGrants.RepairGrantsMock.callArgs, &mm_params)
+	mmRepairGrants.RepairGrantsMock.mutex.Unlock()
 
-	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+	for _, e := range mmRepairGrants.RepairGrantsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.c2, e.results.err
 		}
 	}
 
-	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
-		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+	if mmRepairGrants.RepairGrantsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRepairGrants.RepairGrantsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRepairGrants.RepairGrantsMock.defaultExpectation.params
+		mm_want_ptrs := mmRepairGrants.RepairGrantsMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockListUserRolesParams{ctx, userID}
+		mm_got := CoreMockRepairGrantsParams{ctx, dryRun}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRepairGrants.t.Errorf("CoreMock.RepairGrants got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmRepairGrants.t.Errorf("CoreMock.RepairGrants got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListUserRoles.t.Errorf("CoreMock.ListUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRepairGrants.t.Errorf("CoreMock.RepairGrants got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRepairGrants.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		mm_results := mmRepairGrants.RepairGrantsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListUserRoles.t.Fatal("No results are set for the CoreMock.ListUserRoles")
+			mmRepairGrants.t.Fatal("No results are set for the CoreMock.RepairGrants")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).c2, (*mm_results).err
 	}
-	if mmListUserRoles.funcListUserRoles != nil {
-		return mmListUserRoles.funcListUserRoles(ctx, userID)
+	if mmRepairGrants.funcRepairGrants != nil {
+		return mmRepairGrants.funcRepairGrants(ctx, dryRun)
 	}
-	mmListUserRoles.t.Fatalf("Unexpected call to CoreMock.ListUserRoles. %v %v", ctx, userID)
+	mmRepairGrants.t.Fatalf("Unexpected call to CoreMock.RepairGrants. %v %v", ctx, dryRun)
 	return
 }
 
-// ListUserRolesAfterCounter returns a count of finished CoreMock.ListUserRoles invocations
-func (mmListUserRoles *CoreMock) ListUserRolesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+// RepairGrantsAfterCounter returns a count of finished CoreMock.RepairGrants invocations
+func (mmRepairGrants *CoreMock) RepairGrantsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.afterRepairGrantsCounter)
 }
 
-// ListUserRolesBeforeCounter returns a count of CoreMock.ListUserRoles invocations
-func (mmListUserRoles *CoreMock) ListUserRolesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+// RepairGrantsBeforeCounter returns a count of CoreMock.RepairGrants invocations
+func (mmRepairGrants *CoreMock) RepairGrantsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRepairGrants.beforeRepairGrantsCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.ListUserRoles.
+// Calls returns a list of arguments used in each call to CoreMock.RepairGrants.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListUserRoles *mCoreMockListUserRoles) Calls() []*CoreMockListUserRolesParams {
-	mmListUserRoles.mutex.RLock()
-
-	argCopy := make([]*CoreMockListUserRolesParams, len(mmListUserRoles.callArgs))
-	copy(argCopy, mmListUserRoles.callArgs)
+func (mmRepairGrants *mCoreMockRepairGrants) Calls() []*CoreMockRepairGrantsParams {
+	mmRepairGrants.mutex.RLock()
 
-	mmListUserRoles.mutex.RUnlock()
+	argCopy := make([]*CoreMockRepairGrantsParams, len(mmRepairGrants.callArgs))
+	copy(argCopy, mmRepairGrants.callArgs)
+
+	mmRepairGrants.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// MinimockRepairGrantsDone returns true if the count of the RepairGrants invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockListUserRolesDone() bool {
-	if m.ListUserRolesMock.optional {
+func (m *CoreMock) MinimockRepairGrantsDone() bool {
+	if m.RepairGrantsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListUserRolesMock.expectations {
+	for _, e := range m.RepairGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListUserRolesMock.invocationsDone()
+	return m.RepairGrantsMock.invocationsDone()
 }
 
-// MinimockListUserRolesInspect logs each unmet expectation
-func (m *CoreMock) MinimockListUserRolesInspect() {
-	for _, e := range m.ListUserRolesMock.expectations {
+// MinimockRepairGrantsInspect logs each unmet expectation
+func (m *CoreMock) MinimockRepairGrantsInspect() {
+	for _, e := range m.RepairGrantsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.RepairGrants at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListUserRolesCounter := mm_atomic.LoadUint64(&m.afterListUserRolesCounter)
+	afterRepairGrantsCounter := mm_atomic.LoadUint64(&m.afterRepairGrantsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListUserRolesMock.defaultExpectation != nil && afterListUserRolesCounter < 1 {
-		if m.ListUserRolesMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s", m.ListUserRolesMock.defaultExpectation.returnOrigin)
+	if m.RepairGrantsMock.defaultExpectation != nil && afterRepairGrantsCounter < 1 {
+		if m.RepairGrantsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.RepairGrants at\n%s", m.RepairGrantsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s with params: %#v", m.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListUserRolesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.RepairGrants at\n%s with params: %#v", m.RepairGrantsMock.defaultExpectation.expectationOrigins.origin, *m.RepairGrantsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListUserRoles != nil && afterListUserRolesCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.ListUserRoles at\n%s", m.funcListUserRolesOrigin)
+	if m.funcRepairGrants != nil && afterRepairGrantsCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.RepairGrants at\n%s", m.funcRepairGrantsOrigin)
 	}
 
-	if !m.ListUserRolesMock.invocationsDone() && afterListUserRolesCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.ListUserRoles at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListUserRolesMock.expectedInvocations), m.ListUserRolesMock.expectedInvocationsOrigin, afterListUserRolesCounter)
+	if !m.RepairGrantsMock.invocationsDone() && afterRepairGrantsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.RepairGrants at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RepairGrantsMock.expectedInvocations), m.RepairGrantsMock.expectedInvocationsOrigin, afterRepairGrantsCounter)
 	}
 }
 
-type mCoreMockRefreshTokens struct {
+type mCoreMockUpdatePreset struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockRefreshTokensExpectation
-	expectations       []*CoreMockRefreshTokensExpectation
+	defaultExpectation *CoreMockUpdatePresetExpectation
+	expectations       []*CoreMockUpdatePresetExpectation
 
-	callArgs []*CoreMockRefreshTokensParams
+	callArgs []*CoreMockUpdatePresetParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockRefreshTokensExpectation specifies expectation struct of the Core.RefreshTokens
-type CoreMockRefreshTokensExpectation struct {
+// CoreMockUpdatePresetExpectation specifies expectation struct of the Core.UpdatePreset
+type CoreMockUpdatePresetExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockRefreshTokensParams
-	paramPtrs          *CoreMockRefreshTokensParamPtrs
-	expectationOrigins CoreMockRefreshTokensExpectationOrigins
-	results            *CoreMockRefreshTokensResults
+	params             *CoreMockUpdatePresetParams
+	paramPtrs          *CoreMockUpdatePresetParamPtrs
+	expectationOrigins CoreMockUpdatePresetExpectationOrigins
+	results            *CoreMockUpdatePresetResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockRefreshTokensParams contains parameters of the Core.RefreshTokens
-type CoreMockRefreshTokensParams struct {
-	ctx          context.Context
-	session      auth.Session
-	refreshToken string
-	rtHash       string
+// CoreMockUpdatePresetParams contains parameters of the Core.UpdatePreset
+type CoreMockUpdatePresetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+	req auth.RolePresetReq
 }
 
-// CoreMockRefreshTokensParamPtrs contains pointers to parameters of the Core.RefreshTokens
-type CoreMockRefreshTokensParamPtrs struct {
-	ctx          *context.Context
-	session      *auth.Session
-	refreshToken *string
-	rtHash       *string
+// CoreMockUpdatePresetParamPtrs contains pointers to parameters of the Core.UpdatePreset
+type CoreMockUpdatePresetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+	req *auth.RolePresetReq
 }
 
-// CoreMockRefreshTokensResults contains results of the Core.RefreshTokens
-type CoreMockRefreshTokensResults struct {
-	t1  auth.Tokens
+// CoreMockUpdatePresetResults contains results of the Core.UpdatePreset
+type CoreMockUpdatePresetResults struct {
 	err error
 }
 
-// CoreMockRefreshTokensOrigins contains origins of expectations of the Core.RefreshTokens
-type CoreMockRefreshTokensExpectationOrigins struct {
-	origin             string
-	originCtx          string
-	originSession      string
-	originRefreshToken string
-	originRtHash       string
+// CoreMockUpdatePresetOrigins contains origins of expectations of the Core.UpdatePreset
+type CoreMockUpdatePresetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -3982,348 +7818,320 @@ type CoreMockRefreshTokensExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRefreshTokens *mCoreMockRefreshTokens) Optional() *mCoreMockRefreshTokens {
-	mmRefreshTokens.optional = true
-	return mmRefreshTokens
+func (mmUpdatePreset *mCoreMockUpdatePreset) Optional() *mCoreMockUpdatePreset {
+	mmUpdatePreset.optional = true
+	return mmUpdatePreset
 }
 
-// Expect sets up expected params for Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) Expect(ctx context.Context, session auth.Session, refreshToken string, rtHash string) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+// Expect sets up expected params for Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) Expect(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) *mCoreMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &CoreMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by ExpectParams functions")
+	if mmUpdatePreset.defaultExpectation.paramPtrs != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by ExpectParams functions")
 	}
 
-	mmRefreshTokens.defaultExpectation.params = &CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash}
-	mmRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRefreshTokens.expectations {
-		if minimock.Equal(e.params, mmRefreshTokens.defaultExpectation.params) {
-			mmRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshTokens.defaultExpectation.params)
+	mmUpdatePreset.defaultExpectation.params = &CoreMockUpdatePresetParams{ctx, id, req}
+	mmUpdatePreset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePreset.expectations {
+		if minimock.Equal(e.params, mmUpdatePreset.defaultExpectation.params) {
+			mmUpdatePreset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePreset.defaultExpectation.params)
 		}
 	}
 
-	return mmRefreshTokens
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectCtxParam1(ctx context.Context) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
-	}
-
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
-	}
-
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
-	}
-
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
-	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// ExpectSessionParam2 sets up expected param session for Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectSessionParam2(session auth.Session) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) ExpectCtxParam1(ctx context.Context) *mCoreMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &CoreMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Expect")
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &CoreMockUpdatePresetParamPtrs{}
 	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.session = &session
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+	mmUpdatePreset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// ExpectRefreshTokenParam3 sets up expected param refreshToken for Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectRefreshTokenParam3(refreshToken string) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) ExpectIdParam2(id uuid.UUID) *mCoreMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &CoreMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Expect")
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &CoreMockUpdatePresetParamPtrs{}
 	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.refreshToken = &refreshToken
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+	mmUpdatePreset.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// ExpectRtHashParam4 sets up expected param rtHash for Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) ExpectRtHashParam4(rtHash string) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+// ExpectReqParam3 sets up expected param req for Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) ExpectReqParam3(req auth.RolePresetReq) *mCoreMockUpdatePreset {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &CoreMockUpdatePresetExpectation{}
 	}
 
-	if mmRefreshTokens.defaultExpectation.params != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Expect")
+	if mmUpdatePreset.defaultExpectation.params != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Expect")
 	}
 
-	if mmRefreshTokens.defaultExpectation.paramPtrs == nil {
-		mmRefreshTokens.defaultExpectation.paramPtrs = &CoreMockRefreshTokensParamPtrs{}
+	if mmUpdatePreset.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreset.defaultExpectation.paramPtrs = &CoreMockUpdatePresetParamPtrs{}
 	}
-	mmRefreshTokens.defaultExpectation.paramPtrs.rtHash = &rtHash
-	mmRefreshTokens.defaultExpectation.expectationOrigins.originRtHash = minimock.CallerInfo(1)
+	mmUpdatePreset.defaultExpectation.paramPtrs.req = &req
+	mmUpdatePreset.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) Inspect(f func(ctx context.Context, session auth.Session, refreshToken string, rtHash string)) *mCoreMockRefreshTokens {
-	if mmRefreshTokens.mock.inspectFuncRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("Inspect function is already set for CoreMock.RefreshTokens")
+// Inspect accepts an inspector function that has same arguments as the Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) Inspect(f func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq)) *mCoreMockUpdatePreset {
+	if mmUpdatePreset.mock.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("Inspect function is already set for CoreMock.UpdatePreset")
 	}
 
-	mmRefreshTokens.mock.inspectFuncRefreshTokens = f
+	mmUpdatePreset.mock.inspectFuncUpdatePreset = f
 
-	return mmRefreshTokens
+	return mmUpdatePreset
 }
 
-// Return sets up results that will be returned by Core.RefreshTokens
-func (mmRefreshTokens *mCoreMockRefreshTokens) Return(t1 auth.Tokens, err error) *CoreMock {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+// Return sets up results that will be returned by Core.UpdatePreset
+func (mmUpdatePreset *mCoreMockUpdatePreset) Return(err error) *CoreMock {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	if mmRefreshTokens.defaultExpectation == nil {
-		mmRefreshTokens.defaultExpectation = &CoreMockRefreshTokensExpectation{mock: mmRefreshTokens.mock}
+	if mmUpdatePreset.defaultExpectation == nil {
+		mmUpdatePreset.defaultExpectation = &CoreMockUpdatePresetExpectation{mock: mmUpdatePreset.mock}
 	}
-	mmRefreshTokens.defaultExpectation.results = &CoreMockRefreshTokensResults{t1, err}
-	mmRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens.mock
+	mmUpdatePreset.defaultExpectation.results = &CoreMockUpdatePresetResults{err}
+	mmUpdatePreset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
 }
 
-// Set uses given function f to mock the Core.RefreshTokens method
-func (mmRefreshTokens *mCoreMockRefreshTokens) Set(f func(ctx context.Context, session auth.Session, refreshToken string, rtHash string) (t1 auth.Tokens, err error)) *CoreMock {
-	if mmRefreshTokens.defaultExpectation != nil {
-		mmRefreshTokens.mock.t.Fatalf("Default expectation is already set for the Core.RefreshTokens method")
+// Set uses given function f to mock the Core.UpdatePreset method
+func (mmUpdatePreset *mCoreMockUpdatePreset) Set(f func(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error)) *CoreMock {
+	if mmUpdatePreset.defaultExpectation != nil {
+		mmUpdatePreset.mock.t.Fatalf("Default expectation is already set for the Core.UpdatePreset method")
 	}
 
-	if len(mmRefreshTokens.expectations) > 0 {
-		mmRefreshTokens.mock.t.Fatalf("Some expectations are already set for the Core.RefreshTokens method")
+	if len(mmUpdatePreset.expectations) > 0 {
+		mmUpdatePreset.mock.t.Fatalf("Some expectations are already set for the Core.UpdatePreset method")
 	}
 
-	mmRefreshTokens.mock.funcRefreshTokens = f
-	mmRefreshTokens.mock.funcRefreshTokensOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens.mock
+	mmUpdatePreset.mock.funcUpdatePreset = f
+	mmUpdatePreset.mock.funcUpdatePresetOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset.mock
 }
 
-// When sets expectation for the Core.RefreshTokens which will trigger the result defined by the following
+// When sets expectation for the Core.UpdatePreset which will trigger the result defined by the following
 // Then helper
-func (mmRefreshTokens *mCoreMockRefreshTokens) When(ctx context.Context, session auth.Session, refreshToken string, rtHash string) *CoreMockRefreshTokensExpectation {
-	if mmRefreshTokens.mock.funcRefreshTokens != nil {
-		mmRefreshTokens.mock.t.Fatalf("CoreMock.RefreshTokens mock is already set by Set")
+func (mmUpdatePreset *mCoreMockUpdatePreset) When(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) *CoreMockUpdatePresetExpectation {
+	if mmUpdatePreset.mock.funcUpdatePreset != nil {
+		mmUpdatePreset.mock.t.Fatalf("CoreMock.UpdatePreset mock is already set by Set")
 	}
 
-	expectation := &CoreMockRefreshTokensExpectation{
-		mock:               mmRefreshTokens.mock,
-		params:             &CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash},
-		expectationOrigins: CoreMockRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockUpdatePresetExpectation{
+		mock:               mmUpdatePreset.mock,
+		params:             &CoreMockUpdatePresetParams{ctx, id, req},
+		expectationOrigins: CoreMockUpdatePresetExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRefreshTokens.expectations = append(mmRefreshTokens.expectations, expectation)
+	mmUpdatePreset.expectations = append(mmUpdatePreset.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.RefreshTokens return parameters for the expectation previously defined by the When method
-func (e *CoreMockRefreshTokensExpectation) Then(t1 auth.Tokens, err error) *CoreMock {
-	e.results = &CoreMockRefreshTokensResults{t1, err}
+// Then sets up Core.UpdatePreset return parameters for the expectation previously defined by the When method
+func (e *CoreMockUpdatePresetExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockUpdatePresetResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.RefreshTokens should be invoked
-func (mmRefreshTokens *mCoreMockRefreshTokens) Times(n uint64) *mCoreMockRefreshTokens {
+// Times sets number of times Core.UpdatePreset should be invoked
+func (mmUpdatePreset *mCoreMockUpdatePreset) Times(n uint64) *mCoreMockUpdatePreset {
 	if n == 0 {
-		mmRefreshTokens.mock.t.Fatalf("Times of CoreMock.RefreshTokens mock can not be zero")
+		mmUpdatePreset.mock.t.Fatalf("Times of CoreMock.UpdatePreset mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRefreshTokens.expectedInvocations, n)
-	mmRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRefreshTokens
+	mm_atomic.StoreUint64(&mmUpdatePreset.expectedInvocations, n)
+	mmUpdatePreset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreset
 }
 
-func (mmRefreshTokens *mCoreMockRefreshTokens) invocationsDone() bool {
-	if len(mmRefreshTokens.expectations) == 0 && mmRefreshTokens.defaultExpectation == nil && mmRefreshTokens.mock.funcRefreshTokens == nil {
+func (mmUpdatePreset *mCoreMockUpdatePreset) invocationsDone() bool {
+	if len(mmUpdatePreset.expectations) == 0 && mmUpdatePreset.defaultExpectation == nil && mmUpdatePreset.mock.funcUpdatePreset == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.mock.afterRefreshTokensCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshTokens.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.mock.afterUpdatePresetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePreset.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RefreshTokens implements mm_usecase.Core
-func (mmRefreshTokens *CoreMock) RefreshTokens(ctx context.Context, session auth.Session, refreshToken string, rtHash string) (t1 auth.Tokens, err error) {
-	mm_atomic.AddUint64(&mmRefreshTokens.beforeRefreshTokensCounter, 1)
-	defer mm_atomic.AddUint64(&mmRefreshTokens.afterRefreshTokensCounter, 1)
+// UpdatePreset implements mm_usecase.Core
+func (mmUpdatePreset *CoreMock) UpdatePreset(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePreset.beforeUpdatePresetCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePreset.afterUpdatePresetCounter, 1)
 
-	mmRefreshTokens.t.Helper()
+	mmUpdatePreset.t.Helper()
 
-	if mmRefreshTokens.inspectFuncRefreshTokens != nil {
-		mmRefreshTokens.inspectFuncRefreshTokens(ctx, session, refreshToken, rtHash)
+	if mmUpdatePreset.inspectFuncUpdatePreset != nil {
+		mmUpdatePreset.inspectFuncUpdatePreset(ctx, id, req)
 	}
 
-	mm_params := CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash}
+	mm_params := CoreMockUpdatePresetParams{ctx, id, req}
 
 	// Record call args
-	mmRefreshTokens.RefreshTokensMock.mutex.Lock()
-	mmRefreshTokens.RefreshTokensMock.callArgs = append(mmRefreshTokens.RefreshTokensMock.callArgs, &mm_params)
-	mmRefreshTokens.RefreshTokensMock.mutex.Unlock()
+	mmUpdatePreset.UpdatePresetMock.mutex.Lock()
+	mmUpdatePreset.UpdatePresetMock.callArgs = append(mmUpdatePreset.UpdatePresetMock.callArgs, &mm_params)
+	mmUpdatePreset.UpdatePresetMock.mutex.Unlock()
 
-	for _, e := range mmRefreshTokens.RefreshTokensMock.expectations {
+	for _, e := range mmUpdatePreset.UpdatePresetMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.t1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmRefreshTokens.RefreshTokensMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRefreshTokens.RefreshTokensMock.defaultExpectation.Counter, 1)
-		mm_want := mmRefreshTokens.RefreshTokensMock.defaultExpectation.params
-		mm_want_ptrs := mmRefreshTokens.RefreshTokensMock.defaultExpectation.paramPtrs
+	if mmUpdatePreset.UpdatePresetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePreset.UpdatePresetMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePreset.UpdatePresetMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePreset.UpdatePresetMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockRefreshTokensParams{ctx, session, refreshToken, rtHash}
+		mm_got := CoreMockUpdatePresetParams{ctx, id, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
-				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+				mmUpdatePreset.t.Errorf("CoreMock.UpdatePreset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
-				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePreset.t.Errorf("CoreMock.UpdatePreset got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.rtHash != nil && !minimock.Equal(*mm_want_ptrs.rtHash, mm_got.rtHash) {
-				mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameter rtHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.originRtHash, *mm_want_ptrs.rtHash, mm_got.rtHash, minimock.Diff(*mm_want_ptrs.rtHash, mm_got.rtHash))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdatePreset.t.Errorf("CoreMock.UpdatePreset got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRefreshTokens.t.Errorf("CoreMock.RefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRefreshTokens.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePreset.t.Errorf("CoreMock.UpdatePreset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePreset.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRefreshTokens.RefreshTokensMock.defaultExpectation.results
+		mm_results := mmUpdatePreset.UpdatePresetMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRefreshTokens.t.Fatal("No results are set for the CoreMock.RefreshTokens")
+			mmUpdatePreset.t.Fatal("No results are set for the CoreMock.UpdatePreset")
 		}
-		return (*mm_results).t1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmRefreshTokens.funcRefreshTokens != nil {
-		return mmRefreshTokens.funcRefreshTokens(ctx, session, refreshToken, rtHash)
+	if mmUpdatePreset.funcUpdatePreset != nil {
+		return mmUpdatePreset.funcUpdatePreset(ctx, id, req)
 	}
-	mmRefreshTokens.t.Fatalf("Unexpected call to CoreMock.RefreshTokens. %v %v %v %v", ctx, session, refreshToken, rtHash)
+	mmUpdatePreset.t.Fatalf("Unexpected call to CoreMock.UpdatePreset. %v %v %v", ctx, id, req)
 	return
 }
 
-// RefreshTokensAfterCounter returns a count of finished CoreMock.RefreshTokens invocations
-func (mmRefreshTokens *CoreMock) RefreshTokensAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefreshTokens.afterRefreshTokensCounter)
+// UpdatePresetAfterCounter returns a count of finished CoreMock.UpdatePreset invocations
+func (mmUpdatePreset *CoreMock) UpdatePresetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.afterUpdatePresetCounter)
 }
 
-// RefreshTokensBeforeCounter returns a count of CoreMock.RefreshTokens invocations
-func (mmRefreshTokens *CoreMock) RefreshTokensBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefreshTokens.beforeRefreshTokensCounter)
+// UpdatePresetBeforeCounter returns a count of CoreMock.UpdatePreset invocations
+func (mmUpdatePreset *CoreMock) UpdatePresetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreset.beforeUpdatePresetCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.RefreshTokens.
+// Calls returns a list of arguments used in each call to CoreMock.UpdatePreset.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRefreshTokens *mCoreMockRefreshTokens) Calls() []*CoreMockRefreshTokensParams {
-	mmRefreshTokens.mutex.RLock()
+func (mmUpdatePreset *mCoreMockUpdatePreset) Calls() []*CoreMockUpdatePresetParams {
+	mmUpdatePreset.mutex.RLock()
 
-	argCopy := make([]*CoreMockRefreshTokensParams, len(mmRefreshTokens.callArgs))
-	copy(argCopy, mmRefreshTokens.callArgs)
+	argCopy := make([]*CoreMockUpdatePresetParams, len(mmUpdatePreset.callArgs))
+	copy(argCopy, mmUpdatePreset.callArgs)
 
-	mmRefreshTokens.mutex.RUnlock()
+	mmUpdatePreset.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRefreshTokensDone returns true if the count of the RefreshTokens invocations corresponds
+// MinimockUpdatePresetDone returns true if the count of the UpdatePreset invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockRefreshTokensDone() bool {
-	if m.RefreshTokensMock.optional {
+func (m *CoreMock) MinimockUpdatePresetDone() bool {
+	if m.UpdatePresetMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RefreshTokensMock.expectations {
+	for _, e := range m.UpdatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RefreshTokensMock.invocationsDone()
+	return m.UpdatePresetMock.invocationsDone()
 }
 
-// MinimockRefreshTokensInspect logs each unmet expectation
-func (m *CoreMock) MinimockRefreshTokensInspect() {
-	for _, e := range m.RefreshTokensMock.expectations {
+// MinimockUpdatePresetInspect logs each unmet expectation
+func (m *CoreMock) MinimockUpdatePresetInspect() {
+	for _, e := range m.UpdatePresetMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.UpdatePreset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterRefreshTokensCounter)
+	afterUpdatePresetCounter := mm_atomic.LoadUint64(&m.afterUpdatePresetCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RefreshTokensMock.defaultExpectation != nil && afterRefreshTokensCounter < 1 {
-		if m.RefreshTokensMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s", m.RefreshTokensMock.defaultExpectation.returnOrigin)
+	if m.UpdatePresetMock.defaultExpectation != nil && afterUpdatePresetCounter < 1 {
+		if m.UpdatePresetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.UpdatePreset at\n%s", m.UpdatePresetMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s with params: %#v", m.RefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.RefreshTokensMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.UpdatePreset at\n%s with params: %#v", m.UpdatePresetMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePresetMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRefreshTokens != nil && afterRefreshTokensCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.RefreshTokens at\n%s", m.funcRefreshTokensOrigin)
+	if m.funcUpdatePreset != nil && afterUpdatePresetCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.UpdatePreset at\n%s", m.funcUpdatePresetOrigin)
 	}
 
-	if !m.RefreshTokensMock.invocationsDone() && afterRefreshTokensCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.RefreshTokens at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RefreshTokensMock.expectedInvocations), m.RefreshTokensMock.expectedInvocationsOrigin, afterRefreshTokensCounter)
+	if !m.UpdatePresetMock.invocationsDone() && afterUpdatePresetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.UpdatePreset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePresetMock.expectedInvocations), m.UpdatePresetMock.expectedInvocationsOrigin, afterUpdatePresetCounter)
 	}
 }
 
@@ -4333,27 +8141,47 @@ func (m *CoreMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddUserRoleInspect()
 
-			m.MinimockCheckIsAdminInspect()
+			m.MinimockApplyPresetInspect()
 
 			m.MinimockCheckSelfOrAdminInspect()
 
+			m.MinimockCreatePresetInspect()
+
+			m.MinimockDeletePresetInspect()
+
 			m.MinimockDeleteSessionInspect()
 
 			m.MinimockDeleteSessionsByUserIDInspect()
 
 			m.MinimockDeleteUserRoleInspect()
 
+			m.MinimockGetConsistencyReportInspect()
+
+			m.MinimockGetLoginHistoryInspect()
+
+			m.MinimockGetPresetInspect()
+
 			m.MinimockGetSessionByIDInspect()
 
 			m.MinimockGetSessionsByUserIDInspect()
 
 			m.MinimockIsAdminInspect()
 
+			m.MinimockIssueImpersonationTokenInspect()
+
 			m.MinimockIssueTokensInspect()
 
+			m.MinimockListPresetsInspect()
+
 			m.MinimockListUserRolesInspect()
 
+			m.MinimockRecordLoginInspect()
+
 			m.MinimockRefreshTokensInspect()
+
+			m.MinimockRepairGrantsInspect()
+
+			m.MinimockUpdatePresetInspect()
 		}
 	})
 }
@@ -4378,15 +8206,25 @@ func (m *CoreMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddUserRoleDone() &&
-		m.MinimockCheckIsAdminDone() &&
+		m.MinimockApplyPresetDone() &&
 		m.MinimockCheckSelfOrAdminDone() &&
+		m.MinimockCreatePresetDone() &&
+		m.MinimockDeletePresetDone() &&
 		m.MinimockDeleteSessionDone() &&
 		m.MinimockDeleteSessionsByUserIDDone() &&
 		m.MinimockDeleteUserRoleDone() &&
+		m.MinimockGetConsistencyReportDone() &&
+		m.MinimockGetLoginHistoryDone() &&
+		m.MinimockGetPresetDone() &&
 		m.MinimockGetSessionByIDDone() &&
 		m.MinimockGetSessionsByUserIDDone() &&
 		m.MinimockIsAdminDone() &&
+		m.MinimockIssueImpersonationTokenDone() &&
 		m.MinimockIssueTokensDone() &&
+		m.MinimockListPresetsDone() &&
 		m.MinimockListUserRolesDone() &&
-		m.MinimockRefreshTokensDone()
+		m.MinimockRecordLoginDone() &&
+		m.MinimockRefreshTokensDone() &&
+		m.MinimockRepairGrantsDone() &&
+		m.MinimockUpdatePresetDone()
 }
@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/auth/usecase.Directory -o directory_mock.go -n DirectoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/ldap"
+	"github.com/gojuno/minimock/v3"
+)
+
+// DirectoryMock implements mm_usecase.Directory
+type DirectoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, login string, password []byte) (i1 ldap.Identity, err error)
+	funcAuthenticateOrigin    string
+	inspectFuncAuthenticate   func(ctx context.Context, login string, password []byte)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mDirectoryMockAuthenticate
+}
+
+// NewDirectoryMock returns a mock for mm_usecase.Directory
+func NewDirectoryMock(t minimock.Tester) *DirectoryMock {
+	m := &DirectoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mDirectoryMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*DirectoryMockAuthenticateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDirectoryMockAuthenticate struct {
+	optional           bool
+	mock               *DirectoryMock
+	defaultExpectation *DirectoryMockAuthenticateExpectation
+	expectations       []*DirectoryMockAuthenticateExpectation
+
+	callArgs []*DirectoryMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DirectoryMockAuthenticateExpectation specifies expectation struct of the Directory.Authenticate
+type DirectoryMockAuthenticateExpectation struct {
+	mock               *DirectoryMock
+	params             *DirectoryMockAuthenticateParams
+	paramPtrs          *DirectoryMockAuthenticateParamPtrs
+	expectationOrigins DirectoryMockAuthenticateExpectationOrigins
+	results            *DirectoryMockAuthenticateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DirectoryMockAuthenticateParams contains parameters of the Directory.Authenticate
+type DirectoryMockAuthenticateParams struct {
+	ctx      context.Context
+	login    string
+	password []byte
+}
+
+// DirectoryMockAuthenticateParamPtrs contains pointers to parameters of the Directory.Authenticate
+type DirectoryMockAuthenticateParamPtrs struct {
+	ctx      *context.Context
+	login    *string
+	password *[]byte
+}
+
+// DirectoryMockAuthenticateResults contains results of the Directory.Authenticate
+type DirectoryMockAuthenticateResults struct {
+	i1  ldap.Identity
+	err error
+}
+
+// DirectoryMockAuthenticateOrigins contains origins of expectations of the Directory.Authenticate
+type DirectoryMockAuthenticateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originLogin    string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mDirectoryMockAuthenticate) Optional() *mDirectoryMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) Expect(ctx context.Context, login string, password []byte) *mDirectoryMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &DirectoryMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &DirectoryMockAuthenticateParams{ctx, login, password}
+	mmAuthenticate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mDirectoryMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &DirectoryMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &DirectoryMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectLoginParam2 sets up expected param login for Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) ExpectLoginParam2(login string) *mDirectoryMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &DirectoryMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &DirectoryMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.login = &login
+	mmAuthenticate.defaultExpectation.expectationOrigins.originLogin = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectPasswordParam3 sets up expected param password for Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) ExpectPasswordParam3(password []byte) *mDirectoryMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &DirectoryMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &DirectoryMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.password = &password
+	mmAuthenticate.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) Inspect(f func(ctx context.Context, login string, password []byte)) *mDirectoryMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for DirectoryMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by Directory.Authenticate
+func (mmAuthenticate *mDirectoryMockAuthenticate) Return(i1 ldap.Identity, err error) *DirectoryMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &DirectoryMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &DirectoryMockAuthenticateResults{i1, err}
+	mmAuthenticate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the Directory.Authenticate method
+func (mmAuthenticate *mDirectoryMockAuthenticate) Set(f func(ctx context.Context, login string, password []byte) (i1 ldap.Identity, err error)) *DirectoryMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the Directory.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the Directory.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	mmAuthenticate.mock.funcAuthenticateOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the Directory.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mDirectoryMockAuthenticate) When(ctx context.Context, login string, password []byte) *DirectoryMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("DirectoryMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &DirectoryMockAuthenticateExpectation{
+		mock:               mmAuthenticate.mock,
+		params:             &DirectoryMockAuthenticateParams{ctx, login, password},
+		expectationOrigins: DirectoryMockAuthenticateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Directory.Authenticate return parameters for the expectation previously defined by the When method
+func (e *DirectoryMockAuthenticateExpectation) Then(i1 ldap.Identity, err error) *DirectoryMock {
+	e.results = &DirectoryMockAuthenticateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Directory.Authenticate should be invoked
+func (mmAuthenticate *mDirectoryMockAuthenticate) Times(n uint64) *mDirectoryMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of DirectoryMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	mmAuthenticate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mDirectoryMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements mm_usecase.Directory
+func (mmAuthenticate *DirectoryMock) Authenticate(ctx context.Context, login string, password []byte) (i1 ldap.Identity, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	mmAuthenticate.t.Helper()
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, login, password)
+	}
+
+	mm_params := DirectoryMockAuthenticateParams{ctx, login, password}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := DirectoryMockAuthenticateParams{ctx, login, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("DirectoryMock.Authenticate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.login != nil && !minimock.Equal(*mm_want_ptrs.login, mm_got.login) {
+				mmAuthenticate.t.Errorf("DirectoryMock.Authenticate got unexpected parameter login, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originLogin, *mm_want_ptrs.login, mm_got.login, minimock.Diff(*mm_want_ptrs.login, mm_got.login))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmAuthenticate.t.Errorf("DirectoryMock.Authenticate got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("DirectoryMock.Authenticate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the DirectoryMock.Authenticate")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, login, password)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to DirectoryMock.Authenticate. %v %v %v", ctx, login, password)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished DirectoryMock.Authenticate invocations
+func (mmAuthenticate *DirectoryMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of DirectoryMock.Authenticate invocations
+func (mmAuthenticate *DirectoryMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to DirectoryMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mDirectoryMockAuthenticate) Calls() []*DirectoryMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*DirectoryMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *DirectoryMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *DirectoryMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DirectoryMock.Authenticate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DirectoryMock.Authenticate at\n%s", m.AuthenticateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DirectoryMock.Authenticate at\n%s with params: %#v", m.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Errorf("Expected call to DirectoryMock.Authenticate at\n%s", m.funcAuthenticateOrigin)
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to DirectoryMock.Authenticate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), m.AuthenticateMock.expectedInvocationsOrigin, afterAuthenticateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DirectoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DirectoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DirectoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone()
+}
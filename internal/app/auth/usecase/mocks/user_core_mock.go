@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -20,6 +20,13 @@ type UserCoreMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCreateExternalUser          func(ctx context.Context, email string, name string) (u1 uuid.UUID, err error)
+	funcCreateExternalUserOrigin    string
+	inspectFuncCreateExternalUser   func(ctx context.Context, email string, name string)
+	afterCreateExternalUserCounter  uint64
+	beforeCreateExternalUserCounter uint64
+	CreateExternalUserMock          mUserCoreMockCreateExternalUser
+
 	funcGetUser          func(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id uuid.UUID)
@@ -33,6 +40,20 @@ type UserCoreMock struct {
 	afterGetUserByEmailCounter  uint64
 	beforeGetUserByEmailCounter uint64
 	GetUserByEmailMock          mUserCoreMockGetUserByEmail
+
+	funcLinkExternalUser          func(ctx context.Context, id uuid.UUID) (err error)
+	funcLinkExternalUserOrigin    string
+	inspectFuncLinkExternalUser   func(ctx context.Context, id uuid.UUID)
+	afterLinkExternalUserCounter  uint64
+	beforeLinkExternalUserCounter uint64
+	LinkExternalUserMock          mUserCoreMockLinkExternalUser
+
+	funcUpgradePasswordHash          func(ctx context.Context, id uuid.UUID, password []byte, hash string) (err error)
+	funcUpgradePasswordHashOrigin    string
+	inspectFuncUpgradePasswordHash   func(ctx context.Context, id uuid.UUID, password []byte, hash string)
+	afterUpgradePasswordHashCounter  uint64
+	beforeUpgradePasswordHashCounter uint64
+	UpgradePasswordHashMock          mUserCoreMockUpgradePasswordHash
 }
 
 // NewUserCoreMock returns a mock for mm_usecase.UserCore
@@ -43,17 +64,400 @@ func NewUserCoreMock(t minimock.Tester) *UserCoreMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateExternalUserMock = mUserCoreMockCreateExternalUser{mock: m}
+	m.CreateExternalUserMock.callArgs = []*UserCoreMockCreateExternalUserParams{}
+
 	m.GetUserMock = mUserCoreMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserCoreMockGetUserParams{}
 
 	m.GetUserByEmailMock = mUserCoreMockGetUserByEmail{mock: m}
 	m.GetUserByEmailMock.callArgs = []*UserCoreMockGetUserByEmailParams{}
 
+	m.LinkExternalUserMock = mUserCoreMockLinkExternalUser{mock: m}
+	m.LinkExternalUserMock.callArgs = []*UserCoreMockLinkExternalUserParams{}
+
+	m.UpgradePasswordHashMock = mUserCoreMockUpgradePasswordHash{mock: m}
+	m.UpgradePasswordHashMock.callArgs = []*UserCoreMockUpgradePasswordHashParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mUserCoreMockCreateExternalUser struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockCreateExternalUserExpectation
+	expectations       []*UserCoreMockCreateExternalUserExpectation
+
+	callArgs []*UserCoreMockCreateExternalUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockCreateExternalUserExpectation specifies expectation struct of the UserCore.CreateExternalUser
+type UserCoreMockCreateExternalUserExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockCreateExternalUserParams
+	paramPtrs          *UserCoreMockCreateExternalUserParamPtrs
+	expectationOrigins UserCoreMockCreateExternalUserExpectationOrigins
+	results            *UserCoreMockCreateExternalUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockCreateExternalUserParams contains parameters of the UserCore.CreateExternalUser
+type UserCoreMockCreateExternalUserParams struct {
+	ctx   context.Context
+	email string
+	name  string
+}
+
+// UserCoreMockCreateExternalUserParamPtrs contains pointers to parameters of the UserCore.CreateExternalUser
+type UserCoreMockCreateExternalUserParamPtrs struct {
+	ctx   *context.Context
+	email *string
+	name  *string
+}
+
+// UserCoreMockCreateExternalUserResults contains results of the UserCore.CreateExternalUser
+type UserCoreMockCreateExternalUserResults struct {
+	u1  uuid.UUID
+	err error
+}
+
+// UserCoreMockCreateExternalUserOrigins contains origins of expectations of the UserCore.CreateExternalUser
+type UserCoreMockCreateExternalUserExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+	originName  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Optional() *mUserCoreMockCreateExternalUser {
+	mmCreateExternalUser.optional = true
+	return mmCreateExternalUser
+}
+
+// Expect sets up expected params for UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Expect(ctx context.Context, email string, name string) *mUserCoreMockCreateExternalUser {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	if mmCreateExternalUser.defaultExpectation == nil {
+		mmCreateExternalUser.defaultExpectation = &UserCoreMockCreateExternalUserExpectation{}
+	}
+
+	if mmCreateExternalUser.defaultExpectation.paramPtrs != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateExternalUser.defaultExpectation.params = &UserCoreMockCreateExternalUserParams{ctx, email, name}
+	mmCreateExternalUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateExternalUser.expectations {
+		if minimock.Equal(e.params, mmCreateExternalUser.defaultExpectation.params) {
+			mmCreateExternalUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateExternalUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateExternalUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) ExpectCtxParam1(ctx context.Context) *mUserCoreMockCreateExternalUser {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	if mmCreateExternalUser.defaultExpectation == nil {
+		mmCreateExternalUser.defaultExpectation = &UserCoreMockCreateExternalUserExpectation{}
+	}
+
+	if mmCreateExternalUser.defaultExpectation.params != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Expect")
+	}
+
+	if mmCreateExternalUser.defaultExpectation.paramPtrs == nil {
+		mmCreateExternalUser.defaultExpectation.paramPtrs = &UserCoreMockCreateExternalUserParamPtrs{}
+	}
+	mmCreateExternalUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateExternalUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateExternalUser
+}
+
+// ExpectEmailParam2 sets up expected param email for UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) ExpectEmailParam2(email string) *mUserCoreMockCreateExternalUser {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	if mmCreateExternalUser.defaultExpectation == nil {
+		mmCreateExternalUser.defaultExpectation = &UserCoreMockCreateExternalUserExpectation{}
+	}
+
+	if mmCreateExternalUser.defaultExpectation.params != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Expect")
+	}
+
+	if mmCreateExternalUser.defaultExpectation.paramPtrs == nil {
+		mmCreateExternalUser.defaultExpectation.paramPtrs = &UserCoreMockCreateExternalUserParamPtrs{}
+	}
+	mmCreateExternalUser.defaultExpectation.paramPtrs.email = &email
+	mmCreateExternalUser.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmCreateExternalUser
+}
+
+// ExpectNameParam3 sets up expected param name for UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) ExpectNameParam3(name string) *mUserCoreMockCreateExternalUser {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	if mmCreateExternalUser.defaultExpectation == nil {
+		mmCreateExternalUser.defaultExpectation = &UserCoreMockCreateExternalUserExpectation{}
+	}
+
+	if mmCreateExternalUser.defaultExpectation.params != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Expect")
+	}
+
+	if mmCreateExternalUser.defaultExpectation.paramPtrs == nil {
+		mmCreateExternalUser.defaultExpectation.paramPtrs = &UserCoreMockCreateExternalUserParamPtrs{}
+	}
+	mmCreateExternalUser.defaultExpectation.paramPtrs.name = &name
+	mmCreateExternalUser.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateExternalUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Inspect(f func(ctx context.Context, email string, name string)) *mUserCoreMockCreateExternalUser {
+	if mmCreateExternalUser.mock.inspectFuncCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("Inspect function is already set for UserCoreMock.CreateExternalUser")
+	}
+
+	mmCreateExternalUser.mock.inspectFuncCreateExternalUser = f
+
+	return mmCreateExternalUser
+}
+
+// Return sets up results that will be returned by UserCore.CreateExternalUser
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Return(u1 uuid.UUID, err error) *UserCoreMock {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	if mmCreateExternalUser.defaultExpectation == nil {
+		mmCreateExternalUser.defaultExpectation = &UserCoreMockCreateExternalUserExpectation{mock: mmCreateExternalUser.mock}
+	}
+	mmCreateExternalUser.defaultExpectation.results = &UserCoreMockCreateExternalUserResults{u1, err}
+	mmCreateExternalUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateExternalUser.mock
+}
+
+// Set uses given function f to mock the UserCore.CreateExternalUser method
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Set(f func(ctx context.Context, email string, name string) (u1 uuid.UUID, err error)) *UserCoreMock {
+	if mmCreateExternalUser.defaultExpectation != nil {
+		mmCreateExternalUser.mock.t.Fatalf("Default expectation is already set for the UserCore.CreateExternalUser method")
+	}
+
+	if len(mmCreateExternalUser.expectations) > 0 {
+		mmCreateExternalUser.mock.t.Fatalf("Some expectations are already set for the UserCore.CreateExternalUser method")
+	}
+
+	mmCreateExternalUser.mock.funcCreateExternalUser = f
+	mmCreateExternalUser.mock.funcCreateExternalUserOrigin = minimock.CallerInfo(1)
+	return mmCreateExternalUser.mock
+}
+
+// When sets expectation for the UserCore.CreateExternalUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) When(ctx context.Context, email string, name string) *UserCoreMockCreateExternalUserExpectation {
+	if mmCreateExternalUser.mock.funcCreateExternalUser != nil {
+		mmCreateExternalUser.mock.t.Fatalf("UserCoreMock.CreateExternalUser mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockCreateExternalUserExpectation{
+		mock:               mmCreateExternalUser.mock,
+		params:             &UserCoreMockCreateExternalUserParams{ctx, email, name},
+		expectationOrigins: UserCoreMockCreateExternalUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateExternalUser.expectations = append(mmCreateExternalUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCore.CreateExternalUser return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockCreateExternalUserExpectation) Then(u1 uuid.UUID, err error) *UserCoreMock {
+	e.results = &UserCoreMockCreateExternalUserResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times UserCore.CreateExternalUser should be invoked
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Times(n uint64) *mUserCoreMockCreateExternalUser {
+	if n == 0 {
+		mmCreateExternalUser.mock.t.Fatalf("Times of UserCoreMock.CreateExternalUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateExternalUser.expectedInvocations, n)
+	mmCreateExternalUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateExternalUser
+}
+
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) invocationsDone() bool {
+	if len(mmCreateExternalUser.expectations) == 0 && mmCreateExternalUser.defaultExpectation == nil && mmCreateExternalUser.mock.funcCreateExternalUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateExternalUser.mock.afterCreateExternalUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateExternalUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateExternalUser implements mm_usecase.UserCore
+func (mmCreateExternalUser *UserCoreMock) CreateExternalUser(ctx context.Context, email string, name string) (u1 uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmCreateExternalUser.beforeCreateExternalUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateExternalUser.afterCreateExternalUserCounter, 1)
+
+	mmCreateExternalUser.t.Helper()
+
+	if mmCreateExternalUser.inspectFuncCreateExternalUser != nil {
+		mmCreateExternalUser.inspectFuncCreateExternalUser(ctx, email, name)
+	}
+
+	mm_params := UserCoreMockCreateExternalUserParams{ctx, email, name}
+
+	// Record call args
+	mmCreateExternalUser.CreateExternalUserMock.mutex.Lock()
+	mmCreateExternalUser.CreateExternalUserMock.callArgs = append(mmCreateExternalUser.CreateExternalUserMock.callArgs, &mm_params)
+	mmCreateExternalUser.CreateExternalUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateExternalUser.CreateExternalUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateExternalUser.CreateExternalUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockCreateExternalUserParams{ctx, email, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateExternalUser.t.Errorf("UserCoreMock.CreateExternalUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmCreateExternalUser.t.Errorf("UserCoreMock.CreateExternalUser got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateExternalUser.t.Errorf("UserCoreMock.CreateExternalUser got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateExternalUser.t.Errorf("UserCoreMock.CreateExternalUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateExternalUser.CreateExternalUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateExternalUser.t.Fatal("No results are set for the UserCoreMock.CreateExternalUser")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateExternalUser.funcCreateExternalUser != nil {
+		return mmCreateExternalUser.funcCreateExternalUser(ctx, email, name)
+	}
+	mmCreateExternalUser.t.Fatalf("Unexpected call to UserCoreMock.CreateExternalUser. %v %v %v", ctx, email, name)
+	return
+}
+
+// CreateExternalUserAfterCounter returns a count of finished UserCoreMock.CreateExternalUser invocations
+func (mmCreateExternalUser *UserCoreMock) CreateExternalUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateExternalUser.afterCreateExternalUserCounter)
+}
+
+// CreateExternalUserBeforeCounter returns a count of UserCoreMock.CreateExternalUser invocations
+func (mmCreateExternalUser *UserCoreMock) CreateExternalUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateExternalUser.beforeCreateExternalUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.CreateExternalUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateExternalUser *mUserCoreMockCreateExternalUser) Calls() []*UserCoreMockCreateExternalUserParams {
+	mmCreateExternalUser.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockCreateExternalUserParams, len(mmCreateExternalUser.callArgs))
+	copy(argCopy, mmCreateExternalUser.callArgs)
+
+	mmCreateExternalUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateExternalUserDone returns true if the count of the CreateExternalUser invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockCreateExternalUserDone() bool {
+	if m.CreateExternalUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateExternalUserMock.invocationsDone()
+}
+
+// MinimockCreateExternalUserInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockCreateExternalUserInspect() {
+	for _, e := range m.CreateExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.CreateExternalUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateExternalUserCounter := mm_atomic.LoadUint64(&m.afterCreateExternalUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateExternalUserMock.defaultExpectation != nil && afterCreateExternalUserCounter < 1 {
+		if m.CreateExternalUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.CreateExternalUser at\n%s", m.CreateExternalUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.CreateExternalUser at\n%s with params: %#v", m.CreateExternalUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateExternalUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateExternalUser != nil && afterCreateExternalUserCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.CreateExternalUser at\n%s", m.funcCreateExternalUserOrigin)
+	}
+
+	if !m.CreateExternalUserMock.invocationsDone() && afterCreateExternalUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.CreateExternalUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateExternalUserMock.expectedInvocations), m.CreateExternalUserMock.expectedInvocationsOrigin, afterCreateExternalUserCounter)
+	}
+}
+
 type mUserCoreMockGetUser struct {
 	optional           bool
 	mock               *UserCoreMock
@@ -742,13 +1146,765 @@ func (m *UserCoreMock) MinimockGetUserByEmailInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *UserCoreMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetUserInspect()
+type mUserCoreMockLinkExternalUser struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockLinkExternalUserExpectation
+	expectations       []*UserCoreMockLinkExternalUserExpectation
 
-			m.MinimockGetUserByEmailInspect()
+	callArgs []*UserCoreMockLinkExternalUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockLinkExternalUserExpectation specifies expectation struct of the UserCore.LinkExternalUser
+type UserCoreMockLinkExternalUserExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockLinkExternalUserParams
+	paramPtrs          *UserCoreMockLinkExternalUserParamPtrs
+	expectationOrigins UserCoreMockLinkExternalUserExpectationOrigins
+	results            *UserCoreMockLinkExternalUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockLinkExternalUserParams contains parameters of the UserCore.LinkExternalUser
+type UserCoreMockLinkExternalUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// UserCoreMockLinkExternalUserParamPtrs contains pointers to parameters of the UserCore.LinkExternalUser
+type UserCoreMockLinkExternalUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// UserCoreMockLinkExternalUserResults contains results of the UserCore.LinkExternalUser
+type UserCoreMockLinkExternalUserResults struct {
+	err error
+}
+
+// UserCoreMockLinkExternalUserOrigins contains origins of expectations of the UserCore.LinkExternalUser
+type UserCoreMockLinkExternalUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Optional() *mUserCoreMockLinkExternalUser {
+	mmLinkExternalUser.optional = true
+	return mmLinkExternalUser
+}
+
+// Expect sets up expected params for UserCore.LinkExternalUser
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Expect(ctx context.Context, id uuid.UUID) *mUserCoreMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Set")
+	}
+
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &UserCoreMockLinkExternalUserExpectation{}
+	}
+
+	if mmLinkExternalUser.defaultExpectation.paramPtrs != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by ExpectParams functions")
+	}
+
+	mmLinkExternalUser.defaultExpectation.params = &UserCoreMockLinkExternalUserParams{ctx, id}
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLinkExternalUser.expectations {
+		if minimock.Equal(e.params, mmLinkExternalUser.defaultExpectation.params) {
+			mmLinkExternalUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLinkExternalUser.defaultExpectation.params)
+		}
+	}
+
+	return mmLinkExternalUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCore.LinkExternalUser
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) ExpectCtxParam1(ctx context.Context) *mUserCoreMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Set")
+	}
+
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &UserCoreMockLinkExternalUserExpectation{}
+	}
+
+	if mmLinkExternalUser.defaultExpectation.params != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Expect")
+	}
+
+	if mmLinkExternalUser.defaultExpectation.paramPtrs == nil {
+		mmLinkExternalUser.defaultExpectation.paramPtrs = &UserCoreMockLinkExternalUserParamPtrs{}
+	}
+	mmLinkExternalUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLinkExternalUser
+}
+
+// ExpectIdParam2 sets up expected param id for UserCore.LinkExternalUser
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) ExpectIdParam2(id uuid.UUID) *mUserCoreMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Set")
+	}
+
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &UserCoreMockLinkExternalUserExpectation{}
+	}
+
+	if mmLinkExternalUser.defaultExpectation.params != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Expect")
+	}
+
+	if mmLinkExternalUser.defaultExpectation.paramPtrs == nil {
+		mmLinkExternalUser.defaultExpectation.paramPtrs = &UserCoreMockLinkExternalUserParamPtrs{}
+	}
+	mmLinkExternalUser.defaultExpectation.paramPtrs.id = &id
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmLinkExternalUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCore.LinkExternalUser
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mUserCoreMockLinkExternalUser {
+	if mmLinkExternalUser.mock.inspectFuncLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("Inspect function is already set for UserCoreMock.LinkExternalUser")
+	}
+
+	mmLinkExternalUser.mock.inspectFuncLinkExternalUser = f
+
+	return mmLinkExternalUser
+}
+
+// Return sets up results that will be returned by UserCore.LinkExternalUser
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Return(err error) *UserCoreMock {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Set")
+	}
+
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &UserCoreMockLinkExternalUserExpectation{mock: mmLinkExternalUser.mock}
+	}
+	mmLinkExternalUser.defaultExpectation.results = &UserCoreMockLinkExternalUserResults{err}
+	mmLinkExternalUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser.mock
+}
+
+// Set uses given function f to mock the UserCore.LinkExternalUser method
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *UserCoreMock {
+	if mmLinkExternalUser.defaultExpectation != nil {
+		mmLinkExternalUser.mock.t.Fatalf("Default expectation is already set for the UserCore.LinkExternalUser method")
+	}
+
+	if len(mmLinkExternalUser.expectations) > 0 {
+		mmLinkExternalUser.mock.t.Fatalf("Some expectations are already set for the UserCore.LinkExternalUser method")
+	}
+
+	mmLinkExternalUser.mock.funcLinkExternalUser = f
+	mmLinkExternalUser.mock.funcLinkExternalUserOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser.mock
+}
+
+// When sets expectation for the UserCore.LinkExternalUser which will trigger the result defined by the following
+// Then helper
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) When(ctx context.Context, id uuid.UUID) *UserCoreMockLinkExternalUserExpectation {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("UserCoreMock.LinkExternalUser mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockLinkExternalUserExpectation{
+		mock:               mmLinkExternalUser.mock,
+		params:             &UserCoreMockLinkExternalUserParams{ctx, id},
+		expectationOrigins: UserCoreMockLinkExternalUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLinkExternalUser.expectations = append(mmLinkExternalUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCore.LinkExternalUser return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockLinkExternalUserExpectation) Then(err error) *UserCoreMock {
+	e.results = &UserCoreMockLinkExternalUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserCore.LinkExternalUser should be invoked
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Times(n uint64) *mUserCoreMockLinkExternalUser {
+	if n == 0 {
+		mmLinkExternalUser.mock.t.Fatalf("Times of UserCoreMock.LinkExternalUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLinkExternalUser.expectedInvocations, n)
+	mmLinkExternalUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser
+}
+
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) invocationsDone() bool {
+	if len(mmLinkExternalUser.expectations) == 0 && mmLinkExternalUser.defaultExpectation == nil && mmLinkExternalUser.mock.funcLinkExternalUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLinkExternalUser.mock.afterLinkExternalUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLinkExternalUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LinkExternalUser implements mm_usecase.UserCore
+func (mmLinkExternalUser *UserCoreMock) LinkExternalUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmLinkExternalUser.beforeLinkExternalUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmLinkExternalUser.afterLinkExternalUserCounter, 1)
+
+	mmLinkExternalUser.t.Helper()
+
+	if mmLinkExternalUser.inspectFuncLinkExternalUser != nil {
+		mmLinkExternalUser.inspectFuncLinkExternalUser(ctx, id)
+	}
+
+	mm_params := UserCoreMockLinkExternalUserParams{ctx, id}
+
+	// Record call args
+	mmLinkExternalUser.LinkExternalUserMock.mutex.Lock()
+	mmLinkExternalUser.LinkExternalUserMock.callArgs = append(mmLinkExternalUser.LinkExternalUserMock.callArgs, &mm_params)
+	mmLinkExternalUser.LinkExternalUserMock.mutex.Unlock()
+
+	for _, e := range mmLinkExternalUser.LinkExternalUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLinkExternalUser.LinkExternalUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.params
+		mm_want_ptrs := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockLinkExternalUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLinkExternalUser.t.Errorf("UserCoreMock.LinkExternalUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLinkExternalUser.t.Errorf("UserCoreMock.LinkExternalUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLinkExternalUser.t.Errorf("UserCoreMock.LinkExternalUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLinkExternalUser.t.Fatal("No results are set for the UserCoreMock.LinkExternalUser")
+		}
+		return (*mm_results).err
+	}
+	if mmLinkExternalUser.funcLinkExternalUser != nil {
+		return mmLinkExternalUser.funcLinkExternalUser(ctx, id)
+	}
+	mmLinkExternalUser.t.Fatalf("Unexpected call to UserCoreMock.LinkExternalUser. %v %v", ctx, id)
+	return
+}
+
+// LinkExternalUserAfterCounter returns a count of finished UserCoreMock.LinkExternalUser invocations
+func (mmLinkExternalUser *UserCoreMock) LinkExternalUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkExternalUser.afterLinkExternalUserCounter)
+}
+
+// LinkExternalUserBeforeCounter returns a count of UserCoreMock.LinkExternalUser invocations
+func (mmLinkExternalUser *UserCoreMock) LinkExternalUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkExternalUser.beforeLinkExternalUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.LinkExternalUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLinkExternalUser *mUserCoreMockLinkExternalUser) Calls() []*UserCoreMockLinkExternalUserParams {
+	mmLinkExternalUser.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockLinkExternalUserParams, len(mmLinkExternalUser.callArgs))
+	copy(argCopy, mmLinkExternalUser.callArgs)
+
+	mmLinkExternalUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLinkExternalUserDone returns true if the count of the LinkExternalUser invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockLinkExternalUserDone() bool {
+	if m.LinkExternalUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LinkExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LinkExternalUserMock.invocationsDone()
+}
+
+// MinimockLinkExternalUserInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockLinkExternalUserInspect() {
+	for _, e := range m.LinkExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.LinkExternalUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLinkExternalUserCounter := mm_atomic.LoadUint64(&m.afterLinkExternalUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LinkExternalUserMock.defaultExpectation != nil && afterLinkExternalUserCounter < 1 {
+		if m.LinkExternalUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.LinkExternalUser at\n%s", m.LinkExternalUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.LinkExternalUser at\n%s with params: %#v", m.LinkExternalUserMock.defaultExpectation.expectationOrigins.origin, *m.LinkExternalUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLinkExternalUser != nil && afterLinkExternalUserCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.LinkExternalUser at\n%s", m.funcLinkExternalUserOrigin)
+	}
+
+	if !m.LinkExternalUserMock.invocationsDone() && afterLinkExternalUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.LinkExternalUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LinkExternalUserMock.expectedInvocations), m.LinkExternalUserMock.expectedInvocationsOrigin, afterLinkExternalUserCounter)
+	}
+}
+
+type mUserCoreMockUpgradePasswordHash struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockUpgradePasswordHashExpectation
+	expectations       []*UserCoreMockUpgradePasswordHashExpectation
+
+	callArgs []*UserCoreMockUpgradePasswordHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockUpgradePasswordHashExpectation specifies expectation struct of the UserCore.UpgradePasswordHash
+type UserCoreMockUpgradePasswordHashExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockUpgradePasswordHashParams
+	paramPtrs          *UserCoreMockUpgradePasswordHashParamPtrs
+	expectationOrigins UserCoreMockUpgradePasswordHashExpectationOrigins
+	results            *UserCoreMockUpgradePasswordHashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockUpgradePasswordHashParams contains parameters of the UserCore.UpgradePasswordHash
+type UserCoreMockUpgradePasswordHashParams struct {
+	ctx      context.Context
+	id       uuid.UUID
+	password []byte
+	hash     string
+}
+
+// UserCoreMockUpgradePasswordHashParamPtrs contains pointers to parameters of the UserCore.UpgradePasswordHash
+type UserCoreMockUpgradePasswordHashParamPtrs struct {
+	ctx      *context.Context
+	id       *uuid.UUID
+	password *[]byte
+	hash     *string
+}
+
+// UserCoreMockUpgradePasswordHashResults contains results of the UserCore.UpgradePasswordHash
+type UserCoreMockUpgradePasswordHashResults struct {
+	err error
+}
+
+// UserCoreMockUpgradePasswordHashOrigins contains origins of expectations of the UserCore.UpgradePasswordHash
+type UserCoreMockUpgradePasswordHashExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originPassword string
+	originHash     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Optional() *mUserCoreMockUpgradePasswordHash {
+	mmUpgradePasswordHash.optional = true
+	return mmUpgradePasswordHash
+}
+
+// Expect sets up expected params for UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Expect(ctx context.Context, id uuid.UUID, password []byte, hash string) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{}
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.paramPtrs != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by ExpectParams functions")
+	}
+
+	mmUpgradePasswordHash.defaultExpectation.params = &UserCoreMockUpgradePasswordHashParams{ctx, id, password, hash}
+	mmUpgradePasswordHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpgradePasswordHash.expectations {
+		if minimock.Equal(e.params, mmUpgradePasswordHash.defaultExpectation.params) {
+			mmUpgradePasswordHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpgradePasswordHash.defaultExpectation.params)
+		}
+	}
+
+	return mmUpgradePasswordHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) ExpectCtxParam1(ctx context.Context) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{}
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.params != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpgradePasswordHash.defaultExpectation.paramPtrs = &UserCoreMockUpgradePasswordHashParamPtrs{}
+	}
+	mmUpgradePasswordHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpgradePasswordHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpgradePasswordHash
+}
+
+// ExpectIdParam2 sets up expected param id for UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) ExpectIdParam2(id uuid.UUID) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{}
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.params != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpgradePasswordHash.defaultExpectation.paramPtrs = &UserCoreMockUpgradePasswordHashParamPtrs{}
+	}
+	mmUpgradePasswordHash.defaultExpectation.paramPtrs.id = &id
+	mmUpgradePasswordHash.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpgradePasswordHash
+}
+
+// ExpectPasswordParam3 sets up expected param password for UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) ExpectPasswordParam3(password []byte) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{}
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.params != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpgradePasswordHash.defaultExpectation.paramPtrs = &UserCoreMockUpgradePasswordHashParamPtrs{}
+	}
+	mmUpgradePasswordHash.defaultExpectation.paramPtrs.password = &password
+	mmUpgradePasswordHash.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmUpgradePasswordHash
+}
+
+// ExpectHashParam4 sets up expected param hash for UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) ExpectHashParam4(hash string) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{}
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.params != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpgradePasswordHash.defaultExpectation.paramPtrs = &UserCoreMockUpgradePasswordHashParamPtrs{}
+	}
+	mmUpgradePasswordHash.defaultExpectation.paramPtrs.hash = &hash
+	mmUpgradePasswordHash.defaultExpectation.expectationOrigins.originHash = minimock.CallerInfo(1)
+
+	return mmUpgradePasswordHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Inspect(f func(ctx context.Context, id uuid.UUID, password []byte, hash string)) *mUserCoreMockUpgradePasswordHash {
+	if mmUpgradePasswordHash.mock.inspectFuncUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("Inspect function is already set for UserCoreMock.UpgradePasswordHash")
+	}
+
+	mmUpgradePasswordHash.mock.inspectFuncUpgradePasswordHash = f
+
+	return mmUpgradePasswordHash
+}
+
+// Return sets up results that will be returned by UserCore.UpgradePasswordHash
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Return(err error) *UserCoreMock {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	if mmUpgradePasswordHash.defaultExpectation == nil {
+		mmUpgradePasswordHash.defaultExpectation = &UserCoreMockUpgradePasswordHashExpectation{mock: mmUpgradePasswordHash.mock}
+	}
+	mmUpgradePasswordHash.defaultExpectation.results = &UserCoreMockUpgradePasswordHashResults{err}
+	mmUpgradePasswordHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpgradePasswordHash.mock
+}
+
+// Set uses given function f to mock the UserCore.UpgradePasswordHash method
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Set(f func(ctx context.Context, id uuid.UUID, password []byte, hash string) (err error)) *UserCoreMock {
+	if mmUpgradePasswordHash.defaultExpectation != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("Default expectation is already set for the UserCore.UpgradePasswordHash method")
+	}
+
+	if len(mmUpgradePasswordHash.expectations) > 0 {
+		mmUpgradePasswordHash.mock.t.Fatalf("Some expectations are already set for the UserCore.UpgradePasswordHash method")
+	}
+
+	mmUpgradePasswordHash.mock.funcUpgradePasswordHash = f
+	mmUpgradePasswordHash.mock.funcUpgradePasswordHashOrigin = minimock.CallerInfo(1)
+	return mmUpgradePasswordHash.mock
+}
+
+// When sets expectation for the UserCore.UpgradePasswordHash which will trigger the result defined by the following
+// Then helper
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) When(ctx context.Context, id uuid.UUID, password []byte, hash string) *UserCoreMockUpgradePasswordHashExpectation {
+	if mmUpgradePasswordHash.mock.funcUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.mock.t.Fatalf("UserCoreMock.UpgradePasswordHash mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockUpgradePasswordHashExpectation{
+		mock:               mmUpgradePasswordHash.mock,
+		params:             &UserCoreMockUpgradePasswordHashParams{ctx, id, password, hash},
+		expectationOrigins: UserCoreMockUpgradePasswordHashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpgradePasswordHash.expectations = append(mmUpgradePasswordHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCore.UpgradePasswordHash return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockUpgradePasswordHashExpectation) Then(err error) *UserCoreMock {
+	e.results = &UserCoreMockUpgradePasswordHashResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserCore.UpgradePasswordHash should be invoked
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Times(n uint64) *mUserCoreMockUpgradePasswordHash {
+	if n == 0 {
+		mmUpgradePasswordHash.mock.t.Fatalf("Times of UserCoreMock.UpgradePasswordHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpgradePasswordHash.expectedInvocations, n)
+	mmUpgradePasswordHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpgradePasswordHash
+}
+
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) invocationsDone() bool {
+	if len(mmUpgradePasswordHash.expectations) == 0 && mmUpgradePasswordHash.defaultExpectation == nil && mmUpgradePasswordHash.mock.funcUpgradePasswordHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpgradePasswordHash.mock.afterUpgradePasswordHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpgradePasswordHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpgradePasswordHash implements mm_usecase.UserCore
+func (mmUpgradePasswordHash *UserCoreMock) UpgradePasswordHash(ctx context.Context, id uuid.UUID, password []byte, hash string) (err error) {
+	mm_atomic.AddUint64(&mmUpgradePasswordHash.beforeUpgradePasswordHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpgradePasswordHash.afterUpgradePasswordHashCounter, 1)
+
+	mmUpgradePasswordHash.t.Helper()
+
+	if mmUpgradePasswordHash.inspectFuncUpgradePasswordHash != nil {
+		mmUpgradePasswordHash.inspectFuncUpgradePasswordHash(ctx, id, password, hash)
+	}
+
+	mm_params := UserCoreMockUpgradePasswordHashParams{ctx, id, password, hash}
+
+	// Record call args
+	mmUpgradePasswordHash.UpgradePasswordHashMock.mutex.Lock()
+	mmUpgradePasswordHash.UpgradePasswordHashMock.callArgs = append(mmUpgradePasswordHash.UpgradePasswordHashMock.callArgs, &mm_params)
+	mmUpgradePasswordHash.UpgradePasswordHashMock.mutex.Unlock()
+
+	for _, e := range mmUpgradePasswordHash.UpgradePasswordHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.params
+		mm_want_ptrs := mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockUpgradePasswordHashParams{ctx, id, password, hash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpgradePasswordHash.t.Errorf("UserCoreMock.UpgradePasswordHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpgradePasswordHash.t.Errorf("UserCoreMock.UpgradePasswordHash got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmUpgradePasswordHash.t.Errorf("UserCoreMock.UpgradePasswordHash got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmUpgradePasswordHash.t.Errorf("UserCoreMock.UpgradePasswordHash got unexpected parameter hash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.originHash, *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpgradePasswordHash.t.Errorf("UserCoreMock.UpgradePasswordHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpgradePasswordHash.UpgradePasswordHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpgradePasswordHash.t.Fatal("No results are set for the UserCoreMock.UpgradePasswordHash")
+		}
+		return (*mm_results).err
+	}
+	if mmUpgradePasswordHash.funcUpgradePasswordHash != nil {
+		return mmUpgradePasswordHash.funcUpgradePasswordHash(ctx, id, password, hash)
+	}
+	mmUpgradePasswordHash.t.Fatalf("Unexpected call to UserCoreMock.UpgradePasswordHash. %v %v %v %v", ctx, id, password, hash)
+	return
+}
+
+// UpgradePasswordHashAfterCounter returns a count of finished UserCoreMock.UpgradePasswordHash invocations
+func (mmUpgradePasswordHash *UserCoreMock) UpgradePasswordHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpgradePasswordHash.afterUpgradePasswordHashCounter)
+}
+
+// UpgradePasswordHashBeforeCounter returns a count of UserCoreMock.UpgradePasswordHash invocations
+func (mmUpgradePasswordHash *UserCoreMock) UpgradePasswordHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpgradePasswordHash.beforeUpgradePasswordHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.UpgradePasswordHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpgradePasswordHash *mUserCoreMockUpgradePasswordHash) Calls() []*UserCoreMockUpgradePasswordHashParams {
+	mmUpgradePasswordHash.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockUpgradePasswordHashParams, len(mmUpgradePasswordHash.callArgs))
+	copy(argCopy, mmUpgradePasswordHash.callArgs)
+
+	mmUpgradePasswordHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpgradePasswordHashDone returns true if the count of the UpgradePasswordHash invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockUpgradePasswordHashDone() bool {
+	if m.UpgradePasswordHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpgradePasswordHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpgradePasswordHashMock.invocationsDone()
+}
+
+// MinimockUpgradePasswordHashInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockUpgradePasswordHashInspect() {
+	for _, e := range m.UpgradePasswordHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.UpgradePasswordHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpgradePasswordHashCounter := mm_atomic.LoadUint64(&m.afterUpgradePasswordHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpgradePasswordHashMock.defaultExpectation != nil && afterUpgradePasswordHashCounter < 1 {
+		if m.UpgradePasswordHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.UpgradePasswordHash at\n%s", m.UpgradePasswordHashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.UpgradePasswordHash at\n%s with params: %#v", m.UpgradePasswordHashMock.defaultExpectation.expectationOrigins.origin, *m.UpgradePasswordHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpgradePasswordHash != nil && afterUpgradePasswordHashCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.UpgradePasswordHash at\n%s", m.funcUpgradePasswordHashOrigin)
+	}
+
+	if !m.UpgradePasswordHashMock.invocationsDone() && afterUpgradePasswordHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.UpgradePasswordHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpgradePasswordHashMock.expectedInvocations), m.UpgradePasswordHashMock.expectedInvocationsOrigin, afterUpgradePasswordHashCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateExternalUserInspect()
+
+			m.MinimockGetUserInspect()
+
+			m.MinimockGetUserByEmailInspect()
+
+			m.MinimockLinkExternalUserInspect()
+
+			m.MinimockUpgradePasswordHashInspect()
 		}
 	})
 }
@@ -772,6 +1928,9 @@ func (m *UserCoreMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserCoreMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateExternalUserDone() &&
 		m.MinimockGetUserDone() &&
-		m.MinimockGetUserByEmailDone()
+		m.MinimockGetUserByEmailDone() &&
+		m.MinimockLinkExternalUserDone() &&
+		m.MinimockUpgradePasswordHashDone()
 }
@@ -4,33 +4,60 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/ldap"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
 	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/google/uuid"
 )
 
-const CodeInvalidCredentials apperr.Code = "user/invalid_credentials" //nolint:gosec
+const (
+	CodeInvalidCredentials apperr.Code = "user/invalid_credentials" //nolint:gosec
+	CodeAccountNotLinked   apperr.Code = "user/account_not_linked"
+)
+
+func init() {
+	apperr.Register(CodeInvalidCredentials, "Invalid credentials", apperr.ClassUnauthorized)
+	apperr.Register(CodeAccountNotLinked, "Account not linked to the directory", apperr.ClassForbidden)
+}
 
 func ErrInvalidPasswordOrEmail() error {
 	return apperr.New("invalid password or email", CodeInvalidCredentials, apperr.ClassUnauthorized, apperr.LogLevelWarn)
 }
 
+func ErrAccountNotLinked() error {
+	return apperr.New("A local account with this email exists and is not linked to the directory, ask an admin",
+		CodeAccountNotLinked, apperr.ClassForbidden, apperr.LogLevelWarn)
+}
+
 type Core interface {
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]auth.Session, error)
 	GetSessionByID(ctx context.Context, id uuid.UUID) (auth.Session, string, error)
 	DeleteSession(ctx context.Context, id, userID uuid.UUID) error
 	DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error
-	RefreshTokens(ctx context.Context, session auth.Session, refreshToken, rtHash string) (auth.Tokens, error)
-	IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int) (auth.Tokens, error)
+	RefreshTokens(ctx context.Context, session auth.Session, refreshToken, rtHash string, scopes auth.Scopes, client auth.Client) (auth.Tokens, error)
+	IssueTokens(ctx context.Context, userID uuid.UUID, sessionVersion int, scopes auth.Scopes, ttlClass auth.TTLClass, client auth.Client) (auth.Tokens, error)
+	IssueImpersonationToken(ctx context.Context, subjectID uuid.UUID) (auth.ImpersonationToken, error)
 	AddUserRole(ctx context.Context, role auth.UserRole) error
 	ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.UserRole, error)
 	DeleteUserRole(ctx context.Context, role auth.UserRole) error
+	GetConsistencyReport(ctx context.Context) (auth.ConsistencyReport, error)
+	RepairGrants(ctx context.Context, dryRun bool) (auth.ConsistencyReport, error)
+	ListPresets(ctx context.Context) ([]auth.RolePreset, error)
+	GetPreset(ctx context.Context, id uuid.UUID) (auth.RolePreset, error)
+	CreatePreset(ctx context.Context, req auth.RolePresetReq) (auth.RolePreset, error)
+	UpdatePreset(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) error
+	DeletePreset(ctx context.Context, id uuid.UUID) error
+	ApplyPreset(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) ([]auth.UserRole, error)
+	RecordLogin(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) (auth.LoginEvent, error)
+	GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]auth.LoginEvent, error)
 	CheckSelfOrAdmin(ctx context.Context, targetUserID uuid.UUID) error
-	CheckIsAdmin(ctx context.Context) error
 	IsAdmin(ctx context.Context) (bool, error)
 }
 
@@ -41,27 +68,56 @@ type PasswordHasher interface {
 type UserCore interface {
 	GetUser(ctx context.Context, id uuid.UUID) (user.User, string, error)
 	GetUserByEmail(ctx context.Context, email string) (user.User, string, error)
+	UpgradePasswordHash(ctx context.Context, id uuid.UUID, password []byte, hash string) error
+	CreateExternalUser(ctx context.Context, email, name string) (uuid.UUID, error)
+	LinkExternalUser(ctx context.Context, id uuid.UUID) error
+}
+
+// Directory checks credentials against an external directory such as LDAP, see ldap.Directory.
+type Directory interface {
+	Authenticate(ctx context.Context, login string, password []byte) (ldap.Identity, error)
 }
 
 type LoginCmd struct {
 	Email    string
 	Password []byte `json:"-"`
+	Client   auth.Client
+	// Scopes limit the tokens of the session; empty grants every scope.
+	Scopes auth.Scopes
+	// RememberMe selects the longer session TTL, meant for personal devices.
+	RememberMe bool
+}
+
+// RefreshCmd rotates a refresh token. Scopes narrow the new access token; empty keeps the session's.
+type RefreshCmd struct {
+	auth.RefreshToken
+	Scopes auth.Scopes
+	// Client is checked against the one the session was created from.
+	Client auth.Client
 }
 
 type Service struct {
 	core           Core
 	userCore       UserCore
 	passwordHasher PasswordHasher
+	directory      Directory
+	// localFallback lets logins the directory does not have sign in with their local password.
+	localFallback bool
+	// linkExisting lets the directory sign in a local user with the same email, except an admin.
+	linkExisting bool
 }
 
-func NewService(core Core, userCore UserCore, passwordHasher PasswordHasher) *Service {
-	if core == nil || userCore == nil || passwordHasher == nil {
+func NewService(core Core, userCore UserCore, passwordHasher PasswordHasher, directory Directory, localFallback, linkExisting bool) *Service {
+	if core == nil || userCore == nil || passwordHasher == nil || directory == nil {
 		panic("nil dependency")
 	}
 	return &Service{
 		core:           core,
 		userCore:       userCore,
 		passwordHasher: passwordHasher,
+		directory:      directory,
+		localFallback:  localFallback,
+		linkExisting:   linkExisting,
 	}
 }
 
@@ -119,14 +175,63 @@ func (s *Service) DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID)
 	return nil
 }
 
-func (s *Service) AddUserRole(ctx context.Context, userRole auth.UserRole) error {
-	if err := s.core.CheckIsAdmin(ctx); err != nil {
+// Logout ends the session the access token was issued for. Its refresh token stops working,
+// the access token stays valid until it expires. An impersonation token ends the session of its actor.
+func (s *Service) Logout(ctx context.Context) error {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("auth.service.Logout.contextx.GetUserID")
+		return fmt.Errorf("auth.service.Logout: %w", err)
+	}
+	if actorID, err := contextx.GetActorID(ctx); err == nil {
+		userID = actorID
+	}
+	sessionID, err := contextx.GetSessionID(ctx)
+	if err != nil {
+		err = apperr.ErrUnauthorized().WithDetail("current session ID not found in context")
 		logger.Error(ctx, err).
-			Interface(auth.FieldUserRole.String(), userRole).
-			Msg("auth.service.AddUserRole.core.CheckIsAdmin")
-		return fmt.Errorf("auth.service.AddUserRole: %w", err)
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.Logout.contextx.GetSessionID")
+		return fmt.Errorf("auth.service.Logout: %w", err)
 	}
 
+	if err = s.core.DeleteSession(ctx, sessionID, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Str(auth.FieldSessionID.String(), sessionID.String()).
+			Msg("auth.service.Logout.core.DeleteSession")
+		return fmt.Errorf("auth.service.Logout: %w", err)
+	}
+	return nil
+}
+
+// Impersonate lets an admin act as another user, e.g. to see what the user sees. The token carries
+// both identities, so everything done with it is logged with the admin as actor. The route requires
+// admin role.
+func (s *Service) Impersonate(ctx context.Context, userID uuid.UUID) (auth.ImpersonationToken, error) {
+	if _, _, err := s.userCore.GetUser(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.Impersonate.userCore.GetUser")
+		return auth.ImpersonationToken{}, fmt.Errorf("auth.service.Impersonate: %w", err)
+	}
+
+	token, err := s.core.IssueImpersonationToken(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.Impersonate.core.IssueImpersonationToken")
+		return auth.ImpersonationToken{}, fmt.Errorf("auth.service.Impersonate: %w", err)
+	}
+
+	logger.Audit(ctx, "auth.impersonation.started").
+		Str(auth.FieldUserID.String(), userID.String()).
+		Time("expires_at", token.ExpiresAt).
+		Msg("admin started acting as another user")
+	return token, nil
+}
+
+func (s *Service) AddUserRole(ctx context.Context, userRole auth.UserRole) error {
 	if err := s.core.AddUserRole(ctx, userRole); err != nil {
 		if errors.Is(err, auth.ErrInvalidRole) {
 			err = apperr.New("invalid role", auth.CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
@@ -141,13 +246,6 @@ func (s *Service) AddUserRole(ctx context.Context, userRole auth.UserRole) error
 }
 
 func (s *Service) DeleteUserRole(ctx context.Context, role auth.UserRole) error {
-	if err := s.core.CheckIsAdmin(ctx); err != nil {
-		logger.Error(ctx, err).
-			Interface(auth.FieldUserRole.String(), role).
-			Msg("auth.service.DeleteUserRole.core.CheckIsAdmin")
-		return fmt.Errorf("auth.service.DeleteUserRole: %w", err)
-	}
-
 	if err := s.core.DeleteUserRole(ctx, role); err != nil {
 		logger.Error(ctx, err).
 			Interface(auth.FieldUserRole.String(), role).
@@ -175,7 +273,103 @@ func (s *Service) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.U
 	return roles, nil
 }
 
-func (s *Service) RefreshTokens(ctx context.Context, refreshToken auth.RefreshToken) (auth.Tokens, error) {
+func (s *Service) GetConsistencyReport(ctx context.Context) (auth.ConsistencyReport, error) {
+	report, err := s.core.GetConsistencyReport(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("auth.service.GetConsistencyReport.core.GetConsistencyReport")
+		return auth.ConsistencyReport{}, fmt.Errorf("auth.service.GetConsistencyReport: %w", err)
+	}
+	return report, nil
+}
+
+// RepairGrants removes the grants the consistency report lists, or with dryRun only lists them.
+func (s *Service) RepairGrants(ctx context.Context, dryRun bool) (auth.ConsistencyReport, error) {
+	report, err := s.core.RepairGrants(ctx, dryRun)
+	if err != nil {
+		logger.Error(ctx, err).Bool("dry_run", dryRun).Msg("auth.service.RepairGrants.core.RepairGrants")
+		return auth.ConsistencyReport{}, fmt.Errorf("auth.service.RepairGrants: %w", err)
+	}
+
+	if report.Repaired && len(report.OrphanedGrants) > 0 {
+		logger.Audit(ctx, "auth.grants.repaired").
+			Int("removed", len(report.OrphanedGrants)).
+			Msg("orphaned role grants removed")
+	}
+	return report, nil
+}
+
+func (s *Service) ListPresets(ctx context.Context) ([]auth.RolePreset, error) {
+	presets, err := s.core.ListPresets(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("auth.service.ListPresets.core.ListPresets")
+		return nil, fmt.Errorf("auth.service.ListPresets: %w", err)
+	}
+	return presets, nil
+}
+
+func (s *Service) GetPreset(ctx context.Context, id uuid.UUID) (auth.RolePreset, error) {
+	preset, err := s.core.GetPreset(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldPresetID.String(), id.String()).
+			Msg("auth.service.GetPreset.core.GetPreset")
+		return auth.RolePreset{}, fmt.Errorf("auth.service.GetPreset: %w", err)
+	}
+	return preset, nil
+}
+
+func (s *Service) CreatePreset(ctx context.Context, req auth.RolePresetReq) (auth.RolePreset, error) {
+	preset, err := s.core.CreatePreset(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("auth.service.CreatePreset.core.CreatePreset")
+		return auth.RolePreset{}, fmt.Errorf("auth.service.CreatePreset: %w", err)
+	}
+	return preset, nil
+}
+
+func (s *Service) UpdatePreset(ctx context.Context, id uuid.UUID, req auth.RolePresetReq) error {
+	if err := s.core.UpdatePreset(ctx, id, req); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldPresetID.String(), id.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("auth.service.UpdatePreset.core.UpdatePreset")
+		return fmt.Errorf("auth.service.UpdatePreset: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) DeletePreset(ctx context.Context, id uuid.UUID) error {
+	if err := s.core.DeletePreset(ctx, id); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldPresetID.String(), id.String()).
+			Msg("auth.service.DeletePreset.core.DeletePreset")
+		return fmt.Errorf("auth.service.DeletePreset: %w", err)
+	}
+	return nil
+}
+
+// ApplyPreset grants the roles of the preset to the users and returns the grants made.
+func (s *Service) ApplyPreset(ctx context.Context, id uuid.UUID, req auth.ApplyPresetReq) ([]auth.UserRole, error) {
+	granted, err := s.core.ApplyPreset(ctx, id, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldPresetID.String(), id.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("auth.service.ApplyPreset.core.ApplyPreset")
+		return nil, fmt.Errorf("auth.service.ApplyPreset: %w", err)
+	}
+
+	logger.Audit(ctx, "auth.preset.applied").
+		Str(auth.FieldPresetID.String(), id.String()).
+		Int("users", len(req.UserIDs)).
+		Int("granted", len(granted)).
+		Msg("role preset applied")
+	return granted, nil
+}
+
+func (s *Service) RefreshTokens(ctx context.Context, refreshToken RefreshCmd) (auth.Tokens, error) {
 	if refreshToken.Token == "" {
 		err := apperr.ErrBadRequest()
 		logger.Error(ctx, err).
@@ -186,6 +380,9 @@ func (s *Service) RefreshTokens(ctx context.Context, refreshToken auth.RefreshTo
 
 	session, rtHash, err := s.core.GetSessionByID(ctx, refreshToken.SessionID)
 	if err != nil {
+		if apperr.CodeOf(err) == auth.CodeSessionNotFound {
+			err = apperr.ErrUnauthorized().WithDetail("session has ended")
+		}
 		logger.Error(ctx, err).
 			Str(auth.FieldSessionID.String(), refreshToken.SessionID.String()).
 			Msg("auth.service.RefreshTokens.core.GetSessionByID")
@@ -210,7 +407,7 @@ func (s *Service) RefreshTokens(ctx context.Context, refreshToken auth.RefreshTo
 		return auth.Tokens{}, fmt.Errorf("auth.service.RefreshTokens: %w", err)
 	}
 
-	tokens, err := s.core.RefreshTokens(ctx, session, refreshToken.Token, rtHash)
+	tokens, err := s.core.RefreshTokens(ctx, session, refreshToken.Token, rtHash, refreshToken.Scopes, refreshToken.Client)
 	if err != nil {
 		logger.Error(ctx, err).
 			Str(auth.FieldSessionID.String(), refreshToken.SessionID.String()).
@@ -225,6 +422,54 @@ func (s *Service) RefreshTokens(ctx context.Context, refreshToken auth.RefreshTo
 func (s *Service) Login(ctx context.Context, req LoginCmd) (auth.Tokens, error) {
 	defer secure.ZeroBytes(req.Password)
 
+	usr, err := s.checkCredentials(ctx, req)
+	if err != nil {
+		return auth.Tokens{}, fmt.Errorf("auth.service.Login: %w", err)
+	}
+
+	ttlClass := auth.TTLClassDefault
+	if req.RememberMe {
+		ttlClass = auth.TTLClassRememberMe
+	}
+	tokens, err := s.core.IssueTokens(ctx, usr.ID, usr.SessionVersion, req.Scopes, ttlClass, req.Client)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldEmail.String(), req.Email).
+			Interface(user.FieldUser.String(), usr).
+			Msg("auth.service.Login.core.IssueTokens")
+		return auth.Tokens{}, fmt.Errorf("auth.service.Login: %w", err)
+	}
+	s.recordLogin(ctx, usr.ID, req.Client, true)
+
+	return tokens, nil
+}
+
+// checkCredentials returns the user req signs in as. The directory decides for the logins it has,
+// creating their user on the first sign-in; other logins use the local password when there is no
+// directory or localFallback allows it.
+func (s *Service) checkCredentials(ctx context.Context, req LoginCmd) (user.User, error) {
+	identity, err := s.directory.Authenticate(ctx, req.Email, req.Password)
+	switch {
+	case err == nil:
+		return s.provision(ctx, identity)
+	case errors.Is(err, ldap.ErrDisabled), errors.Is(err, ldap.ErrNotFound) && s.localFallback:
+		return s.checkPassword(ctx, req)
+	case errors.Is(err, ldap.ErrInvalidCredentials):
+		if usr, _, getErr := s.userCore.GetUserByEmail(ctx, identity.Email); getErr == nil {
+			s.recordLogin(ctx, usr.ID, req.Client, false)
+		}
+		err = ErrInvalidPasswordOrEmail()
+	case errors.Is(err, ldap.ErrNotFound):
+		err = ErrInvalidPasswordOrEmail()
+	}
+	logger.Error(ctx, err).
+		Str(user.FieldEmail.String(), req.Email).
+		Msg("auth.service.Login.directory.Authenticate")
+
+	return user.User{}, err
+}
+
+func (s *Service) checkPassword(ctx context.Context, req LoginCmd) (user.User, error) {
 	usr, passwordHash, err := s.userCore.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, user.ErrUserNotFound()) {
@@ -233,28 +478,144 @@ func (s *Service) Login(ctx context.Context, req LoginCmd) (auth.Tokens, error)
 		logger.Error(ctx, err).
 			Str(user.FieldEmail.String(), req.Email).
 			Msg("auth.service.Login.userCore.GetAuthByEmail")
-		return auth.Tokens{}, fmt.Errorf("auth.service.Login: %w", err)
+		return user.User{}, err
 	}
 
 	if err = s.passwordHasher.CheckPasswordHash([]byte(passwordHash), req.Password); err != nil {
 		if errors.Is(err, secure.ErrMismatchedHashAndPassword) {
 			err = ErrInvalidPasswordOrEmail()
+			s.recordLogin(ctx, usr.ID, req.Client, false)
 		}
 		logger.Error(ctx, err).
 			Str(user.FieldEmail.String(), req.Email).
 			Interface(user.FieldUser.String(), usr).
 			Msg("auth.service.Login.passwordHasher.CheckPasswordHash")
-		return auth.Tokens{}, fmt.Errorf("auth.service.Login: %w", err)
+		return user.User{}, err
 	}
-
-	tokens, err := s.core.IssueTokens(ctx, usr.ID, usr.SessionVersion)
-	if err != nil {
+	// Best-effort: the stored hash keeps working if it cannot be upgraded now.
+	if err = s.userCore.UpgradePasswordHash(context.WithoutCancel(ctx), usr.ID, req.Password, passwordHash); err != nil {
 		logger.Error(ctx, err).
 			Str(user.FieldEmail.String(), req.Email).
 			Interface(user.FieldUser.String(), usr).
-			Msg("auth.service.Login.core.IssueTokens")
-		return auth.Tokens{}, fmt.Errorf("auth.service.Login: %w", err)
+			Msg("auth.service.Login.userCore.UpgradePasswordHash")
 	}
 
-	return tokens, nil
+	return usr, nil
+}
+
+// provision returns the user with the email of the directory identity, creating it when there is
+// none. A local user with that email is linked to the directory only when linkExisting allows it.
+func (s *Service) provision(ctx context.Context, identity ldap.Identity) (user.User, error) {
+	usr, _, err := s.userCore.GetUserByEmail(ctx, identity.Email)
+	if err == nil {
+		if usr.External {
+			return usr, nil
+		}
+		return s.link(ctx, usr, identity)
+	}
+	if !errors.Is(err, user.ErrUserNotFound()) {
+		logger.Error(ctx, err).
+			Str(user.FieldEmail.String(), identity.Email).
+			Msg("auth.service.Login.userCore.GetUserByEmail")
+		return user.User{}, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+	id, err := s.userCore.CreateExternalUser(ctx, identity.Email, name)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldEmail.String(), identity.Email).
+			Str("dn", identity.DN).
+			Msg("auth.service.Login.userCore.CreateExternalUser")
+		return user.User{}, err
+	}
+	if usr, _, err = s.userCore.GetUser(ctx, id); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), id.String()).
+			Msg("auth.service.Login.userCore.GetUser")
+		return user.User{}, err
+	}
+	logger.Audit(ctx, "user.provisioned").
+		Str(auth.FieldUserID.String(), id.String()).
+		Str("dn", identity.DN).
+		Msg("user created from the directory on first sign-in")
+
+	return usr, nil
+}
+
+// link makes the local user usr sign in with the directory from now on. Without linkExisting, and
+// always for an admin, the sign-in is refused instead, so a directory entry cannot take over an
+// account it did not create.
+func (s *Service) link(ctx context.Context, usr user.User, identity ldap.Identity) (user.User, error) {
+	reason := "linking disabled"
+	if s.linkExisting {
+		roles, err := s.core.ListUserRoles(ctx, usr.ID)
+		if err != nil {
+			logger.Error(ctx, err).
+				Str(auth.FieldUserID.String(), usr.ID.String()).
+				Msg("auth.service.Login.core.ListUserRoles")
+			return user.User{}, err
+		}
+		reason = "admin"
+		if !slices.ContainsFunc(roles, func(ur auth.UserRole) bool { return ur.Role == auth.RoleAdmin }) {
+			if err = s.userCore.LinkExternalUser(ctx, usr.ID); err != nil {
+				logger.Error(ctx, err).
+					Str(auth.FieldUserID.String(), usr.ID.String()).
+					Msg("auth.service.Login.userCore.LinkExternalUser")
+				return user.User{}, err
+			}
+			logger.Audit(ctx, "user.linked").
+				Str(auth.FieldUserID.String(), usr.ID.String()).
+				Str("dn", identity.DN).
+				Msg("local user linked to the directory on sign-in")
+			usr.External = true
+			return usr, nil
+		}
+	}
+
+	logger.Audit(ctx, "auth.login.not_linked").
+		Str(auth.FieldUserID.String(), usr.ID.String()).
+		Str("dn", identity.DN).
+		Str("reason", reason).
+		Msg("directory sign-in refused for a local user with the same email")
+	return user.User{}, ErrAccountNotLinked()
+}
+
+// recordLogin is best-effort: a sign-in is not refused because its history could not be written.
+func (s *Service) recordLogin(ctx context.Context, userID uuid.UUID, client auth.Client, success bool) {
+	event, err := s.core.RecordLogin(context.WithoutCancel(ctx), userID, client, success)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.Login.core.RecordLogin")
+		return
+	}
+	if event.NewDevice {
+		logger.Audit(ctx, "auth.login.new_device").
+			Str(auth.FieldUserID.String(), userID.String()).
+			Str("ip", client.IP).
+			Str("user_agent", client.UserAgent).
+			Msg("sign-in from a new device or location")
+	}
+}
+
+func (s *Service) GetLoginHistory(ctx context.Context, userID uuid.UUID, limit int) ([]auth.LoginEvent, error) {
+	if err := s.core.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.GetLoginHistory.core.CheckSelfOrAdmin")
+		return nil, fmt.Errorf("auth.service.GetLoginHistory: %w", err)
+	}
+
+	events, err := s.core.GetLoginHistory(ctx, userID, limit)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(auth.FieldUserID.String(), userID.String()).
+			Msg("auth.service.GetLoginHistory.core.GetLoginHistory")
+		return nil, fmt.Errorf("auth.service.GetLoginHistory: %w", err)
+	}
+	return events, nil
 }
@@ -1,6 +1,8 @@
 package usecase_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -10,8 +12,11 @@ import (
 	"github.com/66gu1/easygodocs/internal/app/auth/usecase/mocks"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/ldap"
 	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +26,7 @@ type mock struct {
 	core           *mocks.CoreMock
 	userCore       *mocks.UserCoreMock
 	passwordHasher *mocks.PasswordHasherMock
+	directory      *mocks.DirectoryMock
 }
 
 func newMock(t *testing.T) *mock {
@@ -29,6 +35,7 @@ func newMock(t *testing.T) *mock {
 		core:           mocks.NewCoreMock(t),
 		userCore:       mocks.NewUserCoreMock(t),
 		passwordHasher: mocks.NewPasswordHasherMock(t),
+		directory:      mocks.NewDirectoryMock(t),
 	}
 }
 
@@ -91,7 +98,7 @@ func TestService_GetSessionsByUserID(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			got, err := s.GetSessionsByUserID(ctx, userID)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -147,7 +154,7 @@ func TestService_DeleteSession(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			err := s.DeleteSession(ctx, userID, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -201,7 +208,7 @@ func TestService_DeleteSessionsByUserID(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			err := s.DeleteSessionsByUserID(ctx, userID)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -212,6 +219,129 @@ func TestService_DeleteSessionsByUserID(t *testing.T) {
 	}
 }
 
+func TestService_Logout(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = uuid.New()
+		actorID   = uuid.New()
+		sessionID = uuid.New()
+		ctx       = contextx.SetSessionID(contextx.SetUserID(t.Context(), userID), sessionID)
+		errExp    = fmt.Errorf("expired")
+	)
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			ctx:  ctx,
+			setup: func(m mock) {
+				m.core.DeleteSessionMock.Expect(ctx, sessionID, userID).Return(nil)
+			},
+		},
+		{
+			name: "ok - impersonation ends the actor's session",
+			ctx:  contextx.SetActorID(ctx, actorID),
+			setup: func(m mock) {
+				m.core.DeleteSessionMock.Expect(contextx.SetActorID(ctx, actorID), sessionID, actorID).Return(nil)
+			},
+		},
+		{
+			name: "error - no user",
+			ctx:  t.Context(),
+			err:  apperr.ErrUnauthorized(),
+		},
+		{
+			name: "error - no session",
+			ctx:  contextx.SetUserID(t.Context(), userID),
+			err:  apperr.ErrUnauthorized(),
+		},
+		{
+			name: "error - core.DeleteSession",
+			ctx:  ctx,
+			setup: func(m mock) {
+				m.core.DeleteSessionMock.Expect(ctx, sessionID, userID).Return(errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			if tt.setup != nil {
+				tt.setup(*m)
+			}
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			err := s.Logout(tt.ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_Impersonate(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		token  = auth.ImpersonationToken{AccessToken: "access_token", ExpiresAt: time.Now()}
+		errExp = fmt.Errorf("expired")
+	)
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.userCore.GetUserMock.Expect(ctx, userID).Return(user.User{ID: userID}, "", nil)
+				m.core.IssueImpersonationTokenMock.Expect(ctx, userID).Return(token, nil)
+			},
+		},
+		{
+			name: "error - userCore.GetUser",
+			setup: func(m mock) {
+				m.userCore.GetUserMock.Expect(ctx, userID).Return(user.User{}, "", errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "error - core.IssueImpersonationToken",
+			setup: func(m mock) {
+				m.userCore.GetUserMock.Expect(ctx, userID).Return(user.User{ID: userID}, "", nil)
+				m.core.IssueImpersonationTokenMock.Expect(ctx, userID).Return(auth.ImpersonationToken{}, errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			if tt.setup != nil {
+				tt.setup(*m)
+			}
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			got, err := s.Impersonate(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, token, got)
+			}
+		})
+	}
+}
+
 func TestService_AddUserRole(t *testing.T) {
 	t.Parallel()
 	var (
@@ -230,25 +360,16 @@ func TestService_AddUserRole(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(nil)
 				m.core.AddUserRoleMock.Expect(ctx, userRole).Return(nil)
 			},
 		},
 		{
 			name: "error - userCore.AddRole",
 			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(nil)
 				m.core.AddUserRoleMock.Expect(ctx, userRole).Return(errExp)
 			},
 			err: errExp,
 		},
-		{
-			name: "error - core.CheckIsAdmin",
-			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(errExp)
-			},
-			err: errExp,
-		},
 	}
 
 	for _, tt := range tests {
@@ -258,7 +379,7 @@ func TestService_AddUserRole(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			err := s.AddUserRole(ctx, userRole)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -287,25 +408,16 @@ func TestService_DeleteUserRole(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(nil)
 				m.core.DeleteUserRoleMock.Expect(ctx, userRole).Return(nil)
 			},
 		},
 		{
 			name: "error - core.DeleteUserRole",
 			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(nil)
 				m.core.DeleteUserRoleMock.Expect(ctx, userRole).Return(errExp)
 			},
 			err: errExp,
 		},
-		{
-			name: "error - core.CheckIsAdmin",
-			setup: func(m mock) {
-				m.core.CheckIsAdminMock.Expect(ctx).Return(errExp)
-			},
-			err: errExp,
-		},
 	}
 
 	for _, tt := range tests {
@@ -315,7 +427,7 @@ func TestService_DeleteUserRole(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			err := s.DeleteUserRole(ctx, userRole)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -379,7 +491,7 @@ func TestService_ListUserRoles(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			got, err := s.ListUserRoles(ctx, userID)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -391,6 +503,240 @@ func TestService_ListUserRoles(t *testing.T) {
 	}
 }
 
+func TestService_GetConsistencyReport(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		report = auth.ConsistencyReport{OrphanedGrants: []auth.OrphanedGrant{
+			{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleRead}, Reason: auth.OrphanUserDeleted},
+		}}
+		errExp = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.core.GetConsistencyReportMock.Expect(ctx).Return(report, nil)
+			},
+		},
+		{
+			name: "error - core.GetConsistencyReport",
+			setup: func(m mock) {
+				m.core.GetConsistencyReportMock.Expect(ctx).Return(auth.ConsistencyReport{}, errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			if tt.setup != nil {
+				tt.setup(*m)
+			}
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			got, err := s.GetConsistencyReport(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, report, got)
+			}
+		})
+	}
+}
+
+func TestService_RepairGrants(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		report = auth.ConsistencyReport{OrphanedGrants: []auth.OrphanedGrant{
+			{UserRole: auth.UserRole{UserID: uuid.New(), Role: auth.RoleRead}, Reason: auth.OrphanDuplicate},
+		}, Repaired: true}
+		errExp = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name   string
+		dryRun bool
+		setup  func(m mock)
+		err    error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.core.RepairGrantsMock.Expect(ctx, false).Return(report, nil)
+			},
+		},
+		{
+			name:   "ok - dry run",
+			dryRun: true,
+			setup: func(m mock) {
+				m.core.RepairGrantsMock.Expect(ctx, true).Return(report, nil)
+			},
+		},
+		{
+			name: "error - core.RepairGrants",
+			setup: func(m mock) {
+				m.core.RepairGrantsMock.Expect(ctx, false).Return(auth.ConsistencyReport{}, errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			if tt.setup != nil {
+				tt.setup(*m)
+			}
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			got, err := s.RepairGrants(ctx, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, report, got)
+			}
+		})
+	}
+}
+
+func TestService_ApplyPreset(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		entityID = uuid.New()
+		req      = auth.ApplyPresetReq{UserIDs: []uuid.UUID{uuid.New()}}
+		granted  = []auth.UserRole{{UserID: req.UserIDs[0], Role: auth.RoleWrite, EntityID: &entityID}}
+		errExp   = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.core.ApplyPresetMock.Expect(ctx, id, req).Return(granted, nil)
+			},
+		},
+		{
+			name: "error - core.ApplyPreset",
+			setup: func(m mock) {
+				m.core.ApplyPresetMock.Expect(ctx, id, req).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			tt.setup(*m)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			got, err := s.ApplyPreset(ctx, id, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, granted, got)
+			}
+		})
+	}
+}
+
+func TestService_CreatePreset(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		req    = auth.RolePresetReq{Name: "Admins", Grants: []auth.PresetGrant{{Role: auth.RoleAdmin}}}
+		preset = auth.RolePreset{ID: uuid.New(), Name: req.Name, Grants: req.Grants}
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		m := newMock(t)
+		m.core.CreatePresetMock.Expect(ctx, req).Return(preset, nil)
+		s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+		got, err := s.CreatePreset(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, preset, got)
+	})
+	t.Run("error - core.CreatePreset", func(t *testing.T) {
+		t.Parallel()
+		m := newMock(t)
+		m.core.CreatePresetMock.Expect(ctx, req).Return(auth.RolePreset{}, auth.ErrDuplicatePresetName())
+		s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+		_, err := s.CreatePreset(ctx, req)
+		require.ErrorIs(t, err, auth.ErrDuplicatePresetName())
+	})
+}
+
+func TestService_GetLoginHistory(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		events = []auth.LoginEvent{{ID: uuid.New(), UserID: userID, Success: true}}
+		errExp = fmt.Errorf("expected")
+	)
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(m mock) {
+				m.core.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				m.core.GetLoginHistoryMock.Expect(ctx, userID, 10).Return(events, nil)
+			},
+		},
+		{
+			name: "error - core.GetLoginHistory",
+			setup: func(m mock) {
+				m.core.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				m.core.GetLoginHistoryMock.Expect(ctx, userID, 10).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "error - core.CheckSelfOrAdmin",
+			setup: func(m mock) {
+				m.core.CheckSelfOrAdminMock.Expect(ctx, userID).Return(errExp)
+			},
+			err: errExp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			if tt.setup != nil {
+				tt.setup(*m)
+			}
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
+			got, err := s.GetLoginHistory(ctx, userID, 10)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, events, got)
+			}
+		})
+	}
+}
+
 func TestService_RefreshTokens(t *testing.T) {
 	t.Parallel()
 	var (
@@ -398,9 +744,9 @@ func TestService_RefreshTokens(t *testing.T) {
 		sessionID      = uuid.New()
 		userID         = uuid.New()
 		sessionVersion = 1
-		refreshToken   = auth.RefreshToken{
-			SessionID: sessionID,
-			Token:     "refresh_token",
+		refreshToken   = usecase.RefreshCmd{
+			RefreshToken: auth.RefreshToken{SessionID: sessionID, Token: "refresh_token"},
+			Scopes:       auth.Scopes{auth.ScopeEntitiesRead},
 		}
 		rtHash  = "hashed_rt"
 		session = auth.Session{
@@ -424,7 +770,7 @@ func TestService_RefreshTokens(t *testing.T) {
 	)
 	tests := []struct {
 		name  string
-		req   auth.RefreshToken
+		req   usecase.RefreshCmd
 		setup func(m mock)
 		err   error
 	}{
@@ -434,7 +780,7 @@ func TestService_RefreshTokens(t *testing.T) {
 			setup: func(m mock) {
 				m.core.GetSessionByIDMock.Expect(ctx, sessionID).Return(session, rtHash, nil)
 				m.userCore.GetUserMock.Expect(ctx, userID).Return(usr, "", nil)
-				m.core.RefreshTokensMock.Expect(ctx, session, refreshToken.Token, rtHash).Return(tokensExp, nil)
+				m.core.RefreshTokensMock.Expect(ctx, session, refreshToken.Token, rtHash, refreshToken.Scopes, refreshToken.Client).Return(tokensExp, nil)
 			},
 		},
 		{
@@ -443,7 +789,7 @@ func TestService_RefreshTokens(t *testing.T) {
 			setup: func(m mock) {
 				m.core.GetSessionByIDMock.Expect(ctx, sessionID).Return(session, rtHash, nil)
 				m.userCore.GetUserMock.Expect(ctx, userID).Return(usr, "", nil)
-				m.core.RefreshTokensMock.Expect(ctx, session, refreshToken.Token, rtHash).Return(auth.Tokens{}, errExp)
+				m.core.RefreshTokensMock.Expect(ctx, session, refreshToken.Token, rtHash, refreshToken.Scopes, refreshToken.Client).Return(auth.Tokens{}, errExp)
 			},
 			err: errExp,
 		},
@@ -473,9 +819,18 @@ func TestService_RefreshTokens(t *testing.T) {
 			},
 			err: errExp,
 		},
+		{
+			name: "session ended",
+			req:  refreshToken,
+			setup: func(m mock) {
+				m.core.GetSessionByIDMock.Expect(ctx, sessionID).Return(auth.Session{}, "",
+					apperr.New("session not found", auth.CodeSessionNotFound, apperr.ClassNotFound, apperr.LogLevelWarn))
+			},
+			err: apperr.ErrUnauthorized(),
+		},
 		{
 			name: "error - empty refresh token",
-			req:  auth.RefreshToken{SessionID: sessionID, Token: ""},
+			req:  usecase.RefreshCmd{RefreshToken: auth.RefreshToken{SessionID: sessionID, Token: ""}},
 			err:  apperr.ErrBadRequest(),
 		},
 	}
@@ -486,7 +841,7 @@ func TestService_RefreshTokens(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			got, err := s.RefreshTokens(ctx, tt.req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -521,6 +876,8 @@ func TestService_Login(t *testing.T) {
 			},
 		}
 		errExp = fmt.Errorf("expired")
+		client = auth.Client{IP: "203.0.113.7", UserAgent: "Mozilla/5.0"}
+		bgCtx  = context.WithoutCancel(ctx)
 	)
 	tests := []struct {
 		name  string
@@ -530,23 +887,62 @@ func TestService_Login(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
 				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
-				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion).Return(tokensExp, nil)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+		},
+		{
+			name: "ok - new device",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
+				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true, NewDevice: true}, nil)
+			},
+		},
+		{
+			name: "ok - core.RecordLogin error is ignored",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
+				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{}, errExp)
+			},
+		},
+		{
+			name: "ok - userCore.UpgradePasswordHash error is ignored",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
+				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(errExp)
+				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
 			},
 		},
 		{
 			name: "error - core.IssueTokens",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
 				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
-				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion).Return(auth.Tokens{}, errExp)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, sessionVersion, nil, auth.TTLClassDefault, client).Return(auth.Tokens{}, errExp)
 			},
 			err: errExp,
 		},
 		{
 			name: "error - passwordHasher.CheckPasswordHash",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
 				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(errExp)
 			},
@@ -555,14 +951,17 @@ func TestService_Login(t *testing.T) {
 		{
 			name: "wrong password",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
 				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(secure.ErrMismatchedHashAndPassword)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, false).Return(auth.LoginEvent{}, nil)
 			},
 			err: usecase.ErrInvalidPasswordOrEmail(),
 		},
 		{
 			name: "error - userCore.GetUserByEmail",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(user.User{}, "", errExp)
 			},
 			err: errExp,
@@ -570,6 +969,7 @@ func TestService_Login(t *testing.T) {
 		{
 			name: "error - userCore.GetUserByEmail user not found",
 			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, email, []byte(password)).Return(ldap.Identity{}, ldap.ErrDisabled)
 				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(user.User{}, "", user.ErrUserNotFound())
 			},
 			err: usecase.ErrInvalidPasswordOrEmail(),
@@ -582,10 +982,11 @@ func TestService_Login(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup(*m)
 			}
-			s := usecase.NewService(m.core, m.userCore, m.passwordHasher)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, false)
 			got, err := s.Login(ctx, usecase.LoginCmd{
 				Email:    email,
 				Password: []byte(password),
+				Client:   client,
 			})
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -596,3 +997,220 @@ func TestService_Login(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Login_Directory(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx            = t.Context()
+		login          = "ann"
+		email          = "ann@example.org"
+		password       = "password"
+		hashedPassword = "hashed_password"
+		userID         = uuid.New()
+		usr            = user.User{ID: userID, Email: email, SessionVersion: 1}
+		external       = user.User{ID: userID, Email: email, SessionVersion: 1, External: true}
+		identity       = ldap.Identity{DN: "uid=ann,dc=example,dc=org", Email: email, Name: "Ann Lee"}
+		tokensExp      = auth.Tokens{AccessToken: "access_token"}
+		errExp         = fmt.Errorf("unreachable")
+		client         = auth.Client{IP: "203.0.113.7"}
+		bgCtx          = context.WithoutCancel(ctx)
+	)
+	tests := []struct {
+		name          string
+		localFallback bool
+		setup         func(m mock)
+		err           error
+	}{
+		{
+			name: "ok - existing user",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(external, hashedPassword, nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, 1, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+		},
+		{
+			name: "ok - first sign-in creates the user",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(user.User{}, "", user.ErrUserNotFound())
+				m.userCore.CreateExternalUserMock.Expect(ctx, email, "Ann Lee").Return(userID, nil)
+				m.userCore.GetUserMock.Expect(ctx, userID).Return(usr, hashedPassword, nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, 1, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+		},
+		{
+			name: "ok - name defaults to the email's local part",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(ldap.Identity{DN: identity.DN, Email: email}, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(user.User{}, "", user.ErrUserNotFound())
+				m.userCore.CreateExternalUserMock.Expect(ctx, email, "ann").Return(userID, nil)
+				m.userCore.GetUserMock.Expect(ctx, userID).Return(usr, hashedPassword, nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, 1, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+		},
+		{
+			name:          "ok - local fallback for logins the directory does not have",
+			localFallback: true,
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(ldap.Identity{}, ldap.ErrNotFound)
+				m.userCore.GetUserByEmailMock.Expect(ctx, login).Return(usr, hashedPassword, nil)
+				m.passwordHasher.CheckPasswordHashMock.Expect([]byte(hashedPassword), []byte(password)).Return(nil)
+				m.userCore.UpgradePasswordHashMock.Expect(bgCtx, userID, []byte(password), hashedPassword).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, 1, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+		},
+		{
+			name: "not in the directory without local fallback",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(ldap.Identity{}, ldap.ErrNotFound)
+			},
+			err: usecase.ErrInvalidPasswordOrEmail(),
+		},
+		{
+			name:          "wrong directory password is not retried locally",
+			localFallback: true,
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, ldap.ErrInvalidCredentials)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, hashedPassword, nil)
+				m.core.RecordLoginMock.Expect(bgCtx, userID, client, false).Return(auth.LoginEvent{}, nil)
+			},
+			err: usecase.ErrInvalidPasswordOrEmail(),
+		},
+		{
+			name:          "error - directory unreachable",
+			localFallback: true,
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(ldap.Identity{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "error - userCore.CreateExternalUser",
+			setup: func(m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(user.User{}, "", user.ErrUserNotFound())
+				m.userCore.CreateExternalUserMock.Expect(ctx, email, "Ann Lee").Return(uuid.Nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newMock(t)
+			tt.setup(*m)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, tt.localFallback, false)
+			got, err := s.Login(ctx, usecase.LoginCmd{Email: login, Password: []byte(password), Client: client})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tokensExp, got)
+			}
+		})
+	}
+}
+
+func TestService_Login_Directory_LocalUser(t *testing.T) {
+	t.Parallel()
+	var (
+		login     = "ann"
+		email     = "ann@example.org"
+		password  = "password"
+		userID    = uuid.New()
+		usr       = user.User{ID: userID, Email: email, SessionVersion: 1}
+		identity  = ldap.Identity{DN: "uid=ann,dc=example,dc=org", Email: email, Name: "Ann Lee"}
+		tokensExp = auth.Tokens{AccessToken: "access_token"}
+		errExp    = fmt.Errorf("test error")
+		client    = auth.Client{IP: "203.0.113.7"}
+		editor    = []auth.UserRole{{UserID: userID, Role: auth.RoleWrite}}
+		admin     = []auth.UserRole{{UserID: userID, Role: auth.RoleAdmin}}
+	)
+	tests := []struct {
+		name         string
+		linkExisting bool
+		setup        func(ctx context.Context, m mock)
+		audit        string
+		err          error
+	}{
+		{
+			name: "refused without linking",
+			setup: func(ctx context.Context, m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, "hash", nil)
+			},
+			audit: `"audit":"auth.login.not_linked"`,
+			err:   usecase.ErrAccountNotLinked(),
+		},
+		{
+			name:         "refused for an admin",
+			linkExisting: true,
+			setup: func(ctx context.Context, m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, "hash", nil)
+				m.core.ListUserRolesMock.Expect(ctx, userID).Return(admin, nil)
+			},
+			audit: `"audit":"auth.login.not_linked"`,
+			err:   usecase.ErrAccountNotLinked(),
+		},
+		{
+			name:         "ok - linked",
+			linkExisting: true,
+			setup: func(ctx context.Context, m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, "hash", nil)
+				m.core.ListUserRolesMock.Expect(ctx, userID).Return(editor, nil)
+				m.userCore.LinkExternalUserMock.Expect(ctx, userID).Return(nil)
+				m.core.IssueTokensMock.Expect(ctx, userID, 1, nil, auth.TTLClassDefault, client).Return(tokensExp, nil)
+				m.core.RecordLoginMock.Expect(context.WithoutCancel(ctx), userID, client, true).Return(auth.LoginEvent{Success: true}, nil)
+			},
+			audit: `"audit":"user.linked"`,
+		},
+		{
+			name:         "error - userCore.LinkExternalUser",
+			linkExisting: true,
+			setup: func(ctx context.Context, m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, "hash", nil)
+				m.core.ListUserRolesMock.Expect(ctx, userID).Return(editor, nil)
+				m.userCore.LinkExternalUserMock.Expect(ctx, userID).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name:         "error - core.ListUserRoles",
+			linkExisting: true,
+			setup: func(ctx context.Context, m mock) {
+				m.directory.AuthenticateMock.Expect(ctx, login, []byte(password)).Return(identity, nil)
+				m.userCore.GetUserByEmailMock.Expect(ctx, email).Return(usr, "hash", nil)
+				m.core.ListUserRolesMock.Expect(ctx, userID).Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var logs bytes.Buffer
+			ctx := zerolog.New(&logs).WithContext(t.Context())
+			m := newMock(t)
+			tt.setup(ctx, *m)
+			s := usecase.NewService(m.core, m.userCore, m.passwordHasher, m.directory, false, tt.linkExisting)
+			got, err := s.Login(ctx, usecase.LoginCmd{Email: login, Password: []byte(password), Client: client})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tokensExp, got)
+			}
+			if tt.audit != "" {
+				require.Contains(t, logs.String(), tt.audit)
+			}
+		})
+	}
+}
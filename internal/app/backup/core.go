@@ -0,0 +1,262 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+type Repository interface {
+	// SchemaVersion is the last migration applied to the database.
+	SchemaVersion(ctx context.Context) (int64, error)
+	// Columns lists the columns of the table in their order, or none when it does not exist.
+	Columns(ctx context.Context, table string) ([]string, error)
+	// IsEmpty reports whether the database has neither users nor entities.
+	IsEmpty(ctx context.Context) (bool, error)
+	// Export runs fn on a consistent snapshot of the database.
+	Export(ctx context.Context, fn func(copyOut CopyOut) error) error
+	// Import runs fn in a transaction that first removes the workspaces a fresh database is created
+	// with, so the restored ones take their place.
+	Import(ctx context.Context, fn func(copyIn CopyIn) error) error
+}
+
+// CopyOut writes the rows of the table to w in the text format of COPY and returns their number.
+type CopyOut func(table Table, w io.Writer) (int64, error)
+
+// CopyIn loads rows written by CopyOut into the table and returns their number.
+type CopyIn func(table Table, r io.Reader) (int64, error)
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+const (
+	tableExt = ".copy"
+	// maxManifestBytes bounds what is read of the manifest of an archive.
+	maxManifestBytes = 1 << 20
+)
+
+type core struct {
+	repo               Repository
+	timeGen            TimeGenerator
+	omitPasswordHashes bool
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil {
+		return nil, fmt.Errorf("backup.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+
+	return &core{repo: repo, timeGen: timeGen, omitPasswordHashes: cfg.OmitPasswordHashes}, nil
+}
+
+// Write writes a gzipped tar archive of the backed up tables to w: the manifest first, then the rows
+// of every table. The tables are staged in temporary files, as an entry of the archive needs its size
+// up front.
+func (c *core) Write(ctx context.Context, w io.Writer) (Manifest, error) {
+	version, err := c.repo.SchemaVersion(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Write: %w", err)
+	}
+	manifest := Manifest{
+		FormatVersion:         FormatVersion,
+		CreatedAt:             c.timeGen.Now().UTC(),
+		SchemaVersion:         version,
+		PasswordHashesOmitted: c.omitPasswordHashes,
+	}
+	for _, name := range Tables {
+		columns, err := c.repo.Columns(ctx, name)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("backup.core.Write: %w", err)
+		}
+		if len(columns) == 0 {
+			return Manifest{}, fmt.Errorf("backup.core.Write: table %s does not exist", name)
+		}
+		table := Table{Name: name, Columns: columns}
+		if c.omitPasswordHashes && name == passwordHashTable && slices.Contains(columns, passwordHashColumn) {
+			table.Blanked = []string{passwordHashColumn}
+		}
+		manifest.Tables = append(manifest.Tables, table)
+	}
+
+	dir, err := os.MkdirTemp("", "easygodocs-backup-*")
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Write: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = c.repo.Export(ctx, func(copyOut CopyOut) error {
+		for i, table := range manifest.Tables {
+			if err := copyToFile(copyOut, &manifest.Tables[i], filepath.Join(dir, table.Name)); err != nil {
+				return fmt.Errorf("%s: %w", table.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Write: %w", err)
+	}
+
+	if err = writeArchive(w, manifest, dir); err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Write: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func copyToFile(copyOut CopyOut, table *Table, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	table.Rows, err = copyOut(*table, f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func writeArchive(w io.Writer, manifest Manifest, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: ManifestName, Mode: 0o600, Size: int64(len(data)), ModTime: manifest.CreatedAt})
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(data); err != nil {
+		return err
+	}
+	for _, table := range manifest.Tables {
+		if err = writeEntry(tw, table.Name+tableExt, filepath.Join(dir, table.Name), manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func writeEntry(tw *tar.Writer, name, path string, modTime time.Time) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: info.Size(), ModTime: modTime}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+
+	return err
+}
+
+// Restore loads an archive written by Write into a database that was migrated to the same schema
+// version and has no content yet. Everything is loaded in one transaction: a restore that fails
+// leaves the database as it was.
+func (c *core) Restore(ctx context.Context, r io.Reader) (Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w: %w", ErrInvalidArchive, err)
+	}
+	tr := tar.NewReader(gz)
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w", err)
+	}
+
+	version, err := c.repo.SchemaVersion(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w", err)
+	}
+	if version != manifest.SchemaVersion {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w: the backup has %d, the database %d",
+			ErrSchemaMismatch, manifest.SchemaVersion, version)
+	}
+	empty, err := c.repo.IsEmpty(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w", err)
+	}
+	if !empty {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w", ErrNotEmpty)
+	}
+
+	err = c.repo.Import(ctx, func(copyIn CopyIn) error {
+		for _, table := range manifest.Tables {
+			hdr, err := tr.Next()
+			if err != nil {
+				return fmt.Errorf("%w: %s: %w", ErrInvalidArchive, table.Name, err)
+			}
+			if hdr.Name != table.Name+tableExt {
+				return fmt.Errorf("%w: expected %s, found %s", ErrInvalidArchive, table.Name+tableExt, hdr.Name)
+			}
+			rows, err := copyIn(table, tr)
+			if err != nil {
+				return fmt.Errorf("%s: %w", table.Name, err)
+			}
+			if rows != table.Rows {
+				return fmt.Errorf("%w: %s has %d rows, the manifest lists %d", ErrInvalidArchive, table.Name, rows, table.Rows)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup.core.Restore: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// readManifest reads the manifest at the start of the archive and checks it lists only tables a
+// backup holds, each at most once, so nothing else can be written by a crafted archive.
+func readManifest(tr *tar.Reader) (Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return Manifest{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if hdr.Name != ManifestName {
+		return Manifest{}, fmt.Errorf("%w: %s is not the first file", ErrInvalidArchive, ManifestName)
+	}
+	var manifest Manifest
+	if err = json.NewDecoder(io.LimitReader(tr, maxManifestBytes)).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("%w: %s: %w", ErrInvalidArchive, ManifestName, err)
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return Manifest{}, fmt.Errorf("%w: unsupported format version %d", ErrInvalidArchive, manifest.FormatVersion)
+	}
+	seen := make(map[string]struct{}, len(manifest.Tables))
+	for _, table := range manifest.Tables {
+		if !slices.Contains(Tables, table.Name) {
+			return Manifest{}, fmt.Errorf("%w: unexpected table %q", ErrInvalidArchive, table.Name)
+		}
+		if _, ok := seen[table.Name]; ok {
+			return Manifest{}, fmt.Errorf("%w: table %s is listed twice", ErrInvalidArchive, table.Name)
+		}
+		seen[table.Name] = struct{}{}
+		if len(table.Columns) == 0 {
+			return Manifest{}, fmt.Errorf("%w: table %s has no columns", ErrInvalidArchive, table.Name)
+		}
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,204 @@
+package backup_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/app/backup/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := backup.NewCore(nil, mocks.NewTimeGeneratorMock(t), backup.Config{})
+	require.Error(t, err)
+
+	_, err = backup.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), backup.Config{})
+	require.NoError(t, err)
+}
+
+// rowsOf is the COPY output of every table in the fake database: one line per row.
+func rowsOf(table string) string {
+	return fmt.Sprintf("1\t%s\n2\t%s\n", table, table)
+}
+
+func TestCore_WriteRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		now    = time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+		expErr = fmt.Errorf("test error")
+	)
+
+	write := func(t *testing.T, omit bool) ([]byte, backup.Manifest) {
+		t.Helper()
+		repo := mocks.NewRepositoryMock(t)
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Return(now)
+		repo.SchemaVersionMock.Expect(minimock.AnyContext).Return(42, nil)
+		repo.ColumnsMock.Set(func(_ context.Context, table string) ([]string, error) {
+			if table == "users" {
+				return []string{"id", "password_hash"}, nil
+			}
+			return []string{"id", "name"}, nil
+		})
+		repo.ExportMock.Set(func(_ context.Context, fn func(copyOut backup.CopyOut) error) error {
+			return fn(func(table backup.Table, w io.Writer) (int64, error) {
+				_, err := io.WriteString(w, rowsOf(table.Name))
+				return 2, err
+			})
+		})
+		c, err := backup.NewCore(repo, timeGen, backup.Config{OmitPasswordHashes: omit})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		manifest, err := c.Write(ctx, &buf)
+		require.NoError(t, err)
+		return buf.Bytes(), manifest
+	}
+
+	t.Run("round_trip", func(t *testing.T) {
+		t.Parallel()
+		archive, manifest := write(t, true)
+		require.Equal(t, 1, manifest.FormatVersion)
+		require.Equal(t, now, manifest.CreatedAt)
+		require.Equal(t, int64(42), manifest.SchemaVersion)
+		require.True(t, manifest.PasswordHashesOmitted)
+		require.Len(t, manifest.Tables, len(backup.Tables))
+		for i, table := range manifest.Tables {
+			require.Equal(t, backup.Tables[i], table.Name)
+			require.Equal(t, int64(2), table.Rows)
+			if table.Name == "users" {
+				require.Equal(t, []string{"password_hash"}, table.Blanked)
+			} else {
+				require.Empty(t, table.Blanked)
+			}
+		}
+
+		repo := mocks.NewRepositoryMock(t)
+		repo.SchemaVersionMock.Return(42, nil)
+		repo.IsEmptyMock.Return(true, nil)
+		loaded := make(map[string]string)
+		repo.ImportMock.Set(func(_ context.Context, fn func(copyIn backup.CopyIn) error) error {
+			return fn(func(table backup.Table, r io.Reader) (int64, error) {
+				data, err := io.ReadAll(r)
+				loaded[table.Name] = string(data)
+				return int64(strings.Count(string(data), "\n")), err
+			})
+		})
+		c, err := backup.NewCore(repo, mocks.NewTimeGeneratorMock(t), backup.Config{})
+		require.NoError(t, err)
+
+		restored, err := c.Restore(ctx, bytes.NewReader(archive))
+		require.NoError(t, err)
+		require.Equal(t, manifest, restored)
+		for _, name := range backup.Tables {
+			require.Equal(t, rowsOf(name), loaded[name])
+		}
+	})
+
+	t.Run("error/export", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Return(now)
+		repo.SchemaVersionMock.Return(42, nil)
+		repo.ColumnsMock.Return([]string{"id"}, nil)
+		repo.ExportMock.Set(func(_ context.Context, fn func(copyOut backup.CopyOut) error) error {
+			return fn(func(backup.Table, io.Writer) (int64, error) { return 0, expErr })
+		})
+		c, err := backup.NewCore(repo, timeGen, backup.Config{})
+		require.NoError(t, err)
+
+		_, err = c.Write(ctx, io.Discard)
+		require.ErrorIs(t, err, expErr)
+	})
+
+	t.Run("error/missing_table", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Return(now)
+		repo.SchemaVersionMock.Return(42, nil)
+		repo.ColumnsMock.Return(nil, nil)
+		c, err := backup.NewCore(repo, timeGen, backup.Config{})
+		require.NoError(t, err)
+
+		_, err = c.Write(ctx, io.Discard)
+		require.ErrorContains(t, err, "does not exist")
+	})
+
+	archive, _ := write(t, false)
+	restoreTests := []struct {
+		name    string
+		archive []byte
+		version int64
+		empty   bool
+		rows    int64
+		err     error
+	}{
+		{name: "error/schema_mismatch", archive: archive, version: 43, err: backup.ErrSchemaMismatch},
+		{name: "error/not_empty", archive: archive, version: 42, err: backup.ErrNotEmpty},
+		{name: "error/not_gzip", archive: []byte("plain text"), err: backup.ErrInvalidArchive},
+		{name: "error/row_count", archive: archive, version: 42, empty: true, rows: 1, err: backup.ErrInvalidArchive},
+		{
+			name:    "error/unexpected_table",
+			archive: archiveOf(t, backup.Manifest{FormatVersion: 1, Tables: []backup.Table{{Name: "user_sessions", Columns: []string{"id"}}}}),
+			err:     backup.ErrInvalidArchive,
+		},
+		{
+			name:    "error/format_version",
+			archive: archiveOf(t, backup.Manifest{FormatVersion: 2}),
+			err:     backup.ErrInvalidArchive,
+		},
+	}
+	for _, tt := range restoreTests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			repo.SchemaVersionMock.Optional().Return(tt.version, nil)
+			repo.IsEmptyMock.Optional().Return(tt.empty, nil)
+			repo.ImportMock.Optional().Set(func(_ context.Context, fn func(copyIn backup.CopyIn) error) error {
+				return fn(func(_ backup.Table, r io.Reader) (int64, error) {
+					_, err := io.Copy(io.Discard, r)
+					return tt.rows, err
+				})
+			})
+			c, err := backup.NewCore(repo, mocks.NewTimeGeneratorMock(t), backup.Config{})
+			require.NoError(t, err)
+
+			_, err = c.Restore(ctx, bytes.NewReader(tt.archive))
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+// archiveOf builds an archive holding only the manifest.
+func archiveOf(t *testing.T, manifest backup.Manifest) []byte {
+	t.Helper()
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: backup.ManifestName, Mode: 0o600, Size: int64(len(data))}))
+	_, err = tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,108 @@
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/s3"
+)
+
+// FormatVersion is the version of the archive layout, bumped when a restore could no longer read
+// archives written before.
+const FormatVersion = 1
+
+// ManifestName is the first file of an archive; every table follows in a file named after it.
+const ManifestName = "manifest.json"
+
+// Tables are the tables a backup holds, in an order that restores them without breaking foreign
+// keys. Sessions, locks, views and usage counters are left out: they are transient and a restored
+// instance starts without them.
+var Tables = []string{
+	"workspaces",
+	"users",
+	"user_preferences",
+	"user_terms_acceptances",
+	"entities",
+	"entity_versions",
+	"entity_slugs",
+	"entity_links",
+	"entity_relations",
+	"entity_events",
+	"user_roles",
+	"entity_default_permissions",
+	"entity_snapshots",
+	"entity_snapshot_versions",
+	"entity_variables",
+	"entity_variants",
+	"entity_lint_results",
+	"role_presets",
+	"role_preset_grants",
+	"invitations",
+	"invitation_roles",
+}
+
+// The password hashes are blanked in backups taken with omit_password_hashes; users restored from
+// such a backup need a new password set by an admin.
+const (
+	passwordHashTable  = "users"
+	passwordHashColumn = "password_hash"
+)
+
+type Config struct {
+	S3                 s3.Config `mapstructure:"s3" json:"s3"`
+	Prefix             string    `mapstructure:"prefix" json:"prefix"`
+	OmitPasswordHashes bool      `mapstructure:"omit_password_hashes" json:"omit_password_hashes"`
+}
+
+// Enabled reports whether backups have a bucket to go to.
+func (c Config) Enabled() bool {
+	return c.S3.Bucket != ""
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if err := c.S3.Validate(); err != nil {
+		return fmt.Errorf("s3: %w", err)
+	}
+
+	return nil
+}
+
+// Manifest describes an archive. The columns are recorded per table, so a restore does not depend on
+// the column order of the target database.
+type Manifest struct {
+	FormatVersion         int       `json:"format_version"`
+	CreatedAt             time.Time `json:"created_at"`
+	SchemaVersion         int64     `json:"schema_version"`
+	PasswordHashesOmitted bool      `json:"password_hashes_omitted"`
+	Tables                []Table   `json:"tables"`
+}
+
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	// Blanked are the columns written as empty strings instead of their values.
+	Blanked []string `json:"blanked,omitempty"`
+	Rows    int64    `json:"rows"`
+}
+
+type State string
+
+const (
+	StateRunning   State = "running"
+	StateSucceeded State = "succeeded"
+	StateFailed    State = "failed"
+)
+
+// Status is the state of the last backup started since the server came up; it is empty before the
+// first one.
+type Status struct {
+	State      State      `json:"state,omitempty"`
+	Key        string     `json:"key,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	Tables     []Table    `json:"tables,omitempty"`
+}
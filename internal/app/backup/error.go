@@ -0,0 +1,23 @@
+package backup
+
+import (
+	"errors"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+)
+
+var (
+	ErrInvalidArchive = errors.New("not a valid backup archive")
+	ErrSchemaMismatch = errors.New("the database schema version differs from the backup's")
+	ErrNotEmpty       = errors.New("the database already has users or entities")
+)
+
+const CodeInProgress apperr.Code = "backup/in_progress"
+
+func init() {
+	apperr.Register(CodeInProgress, "Backup in progress", apperr.ClassConflict)
+}
+
+func ErrInProgress() error {
+	return apperr.New("A backup is already running", CodeInProgress, apperr.ClassConflict, apperr.LogLevelWarn)
+}
@@ -0,0 +1,1778 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/backup.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_backup "github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_backup.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcColumns          func(ctx context.Context, table string) (sa1 []string, err error)
+	funcColumnsOrigin    string
+	inspectFuncColumns   func(ctx context.Context, table string)
+	afterColumnsCounter  uint64
+	beforeColumnsCounter uint64
+	ColumnsMock          mRepositoryMockColumns
+
+	funcExport          func(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error) (err error)
+	funcExportOrigin    string
+	inspectFuncExport   func(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mRepositoryMockExport
+
+	funcImport          func(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error) (err error)
+	funcImportOrigin    string
+	inspectFuncImport   func(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error)
+	afterImportCounter  uint64
+	beforeImportCounter uint64
+	ImportMock          mRepositoryMockImport
+
+	funcIsEmpty          func(ctx context.Context) (b1 bool, err error)
+	funcIsEmptyOrigin    string
+	inspectFuncIsEmpty   func(ctx context.Context)
+	afterIsEmptyCounter  uint64
+	beforeIsEmptyCounter uint64
+	IsEmptyMock          mRepositoryMockIsEmpty
+
+	funcSchemaVersion          func(ctx context.Context) (i1 int64, err error)
+	funcSchemaVersionOrigin    string
+	inspectFuncSchemaVersion   func(ctx context.Context)
+	afterSchemaVersionCounter  uint64
+	beforeSchemaVersionCounter uint64
+	SchemaVersionMock          mRepositoryMockSchemaVersion
+}
+
+// NewRepositoryMock returns a mock for mm_backup.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ColumnsMock = mRepositoryMockColumns{mock: m}
+	m.ColumnsMock.callArgs = []*RepositoryMockColumnsParams{}
+
+	m.ExportMock = mRepositoryMockExport{mock: m}
+	m.ExportMock.callArgs = []*RepositoryMockExportParams{}
+
+	m.ImportMock = mRepositoryMockImport{mock: m}
+	m.ImportMock.callArgs = []*RepositoryMockImportParams{}
+
+	m.IsEmptyMock = mRepositoryMockIsEmpty{mock: m}
+	m.IsEmptyMock.callArgs = []*RepositoryMockIsEmptyParams{}
+
+	m.SchemaVersionMock = mRepositoryMockSchemaVersion{mock: m}
+	m.SchemaVersionMock.callArgs = []*RepositoryMockSchemaVersionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockColumns struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockColumnsExpectation
+	expectations       []*RepositoryMockColumnsExpectation
+
+	callArgs []*RepositoryMockColumnsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockColumnsExpectation specifies expectation struct of the Repository.Columns
+type RepositoryMockColumnsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockColumnsParams
+	paramPtrs          *RepositoryMockColumnsParamPtrs
+	expectationOrigins RepositoryMockColumnsExpectationOrigins
+	results            *RepositoryMockColumnsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockColumnsParams contains parameters of the Repository.Columns
+type RepositoryMockColumnsParams struct {
+	ctx   context.Context
+	table string
+}
+
+// RepositoryMockColumnsParamPtrs contains pointers to parameters of the Repository.Columns
+type RepositoryMockColumnsParamPtrs struct {
+	ctx   *context.Context
+	table *string
+}
+
+// RepositoryMockColumnsResults contains results of the Repository.Columns
+type RepositoryMockColumnsResults struct {
+	sa1 []string
+	err error
+}
+
+// RepositoryMockColumnsOrigins contains origins of expectations of the Repository.Columns
+type RepositoryMockColumnsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originTable string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmColumns *mRepositoryMockColumns) Optional() *mRepositoryMockColumns {
+	mmColumns.optional = true
+	return mmColumns
+}
+
+// Expect sets up expected params for Repository.Columns
+func (mmColumns *mRepositoryMockColumns) Expect(ctx context.Context, table string) *mRepositoryMockColumns {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &RepositoryMockColumnsExpectation{}
+	}
+
+	if mmColumns.defaultExpectation.paramPtrs != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by ExpectParams functions")
+	}
+
+	mmColumns.defaultExpectation.params = &RepositoryMockColumnsParams{ctx, table}
+	mmColumns.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmColumns.expectations {
+		if minimock.Equal(e.params, mmColumns.defaultExpectation.params) {
+			mmColumns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmColumns.defaultExpectation.params)
+		}
+	}
+
+	return mmColumns
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Columns
+func (mmColumns *mRepositoryMockColumns) ExpectCtxParam1(ctx context.Context) *mRepositoryMockColumns {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &RepositoryMockColumnsExpectation{}
+	}
+
+	if mmColumns.defaultExpectation.params != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Expect")
+	}
+
+	if mmColumns.defaultExpectation.paramPtrs == nil {
+		mmColumns.defaultExpectation.paramPtrs = &RepositoryMockColumnsParamPtrs{}
+	}
+	mmColumns.defaultExpectation.paramPtrs.ctx = &ctx
+	mmColumns.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmColumns
+}
+
+// ExpectTableParam2 sets up expected param table for Repository.Columns
+func (mmColumns *mRepositoryMockColumns) ExpectTableParam2(table string) *mRepositoryMockColumns {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &RepositoryMockColumnsExpectation{}
+	}
+
+	if mmColumns.defaultExpectation.params != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Expect")
+	}
+
+	if mmColumns.defaultExpectation.paramPtrs == nil {
+		mmColumns.defaultExpectation.paramPtrs = &RepositoryMockColumnsParamPtrs{}
+	}
+	mmColumns.defaultExpectation.paramPtrs.table = &table
+	mmColumns.defaultExpectation.expectationOrigins.originTable = minimock.CallerInfo(1)
+
+	return mmColumns
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Columns
+func (mmColumns *mRepositoryMockColumns) Inspect(f func(ctx context.Context, table string)) *mRepositoryMockColumns {
+	if mmColumns.mock.inspectFuncColumns != nil {
+		mmColumns.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Columns")
+	}
+
+	mmColumns.mock.inspectFuncColumns = f
+
+	return mmColumns
+}
+
+// Return sets up results that will be returned by Repository.Columns
+func (mmColumns *mRepositoryMockColumns) Return(sa1 []string, err error) *RepositoryMock {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &RepositoryMockColumnsExpectation{mock: mmColumns.mock}
+	}
+	mmColumns.defaultExpectation.results = &RepositoryMockColumnsResults{sa1, err}
+	mmColumns.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmColumns.mock
+}
+
+// Set uses given function f to mock the Repository.Columns method
+func (mmColumns *mRepositoryMockColumns) Set(f func(ctx context.Context, table string) (sa1 []string, err error)) *RepositoryMock {
+	if mmColumns.defaultExpectation != nil {
+		mmColumns.mock.t.Fatalf("Default expectation is already set for the Repository.Columns method")
+	}
+
+	if len(mmColumns.expectations) > 0 {
+		mmColumns.mock.t.Fatalf("Some expectations are already set for the Repository.Columns method")
+	}
+
+	mmColumns.mock.funcColumns = f
+	mmColumns.mock.funcColumnsOrigin = minimock.CallerInfo(1)
+	return mmColumns.mock
+}
+
+// When sets expectation for the Repository.Columns which will trigger the result defined by the following
+// Then helper
+func (mmColumns *mRepositoryMockColumns) When(ctx context.Context, table string) *RepositoryMockColumnsExpectation {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("RepositoryMock.Columns mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockColumnsExpectation{
+		mock:               mmColumns.mock,
+		params:             &RepositoryMockColumnsParams{ctx, table},
+		expectationOrigins: RepositoryMockColumnsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmColumns.expectations = append(mmColumns.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Columns return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockColumnsExpectation) Then(sa1 []string, err error) *RepositoryMock {
+	e.results = &RepositoryMockColumnsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Columns should be invoked
+func (mmColumns *mRepositoryMockColumns) Times(n uint64) *mRepositoryMockColumns {
+	if n == 0 {
+		mmColumns.mock.t.Fatalf("Times of RepositoryMock.Columns mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmColumns.expectedInvocations, n)
+	mmColumns.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmColumns
+}
+
+func (mmColumns *mRepositoryMockColumns) invocationsDone() bool {
+	if len(mmColumns.expectations) == 0 && mmColumns.defaultExpectation == nil && mmColumns.mock.funcColumns == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmColumns.mock.afterColumnsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmColumns.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Columns implements mm_backup.Repository
+func (mmColumns *RepositoryMock) Columns(ctx context.Context, table string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmColumns.beforeColumnsCounter, 1)
+	defer mm_atomic.AddUint64(&mmColumns.afterColumnsCounter, 1)
+
+	mmColumns.t.Helper()
+
+	if mmColumns.inspectFuncColumns != nil {
+		mmColumns.inspectFuncColumns(ctx, table)
+	}
+
+	mm_params := RepositoryMockColumnsParams{ctx, table}
+
+	// Record call args
+	mmColumns.ColumnsMock.mutex.Lock()
+	mmColumns.ColumnsMock.callArgs = append(mmColumns.ColumnsMock.callArgs, &mm_params)
+	mmColumns.ColumnsMock.mutex.Unlock()
+
+	for _, e := range mmColumns.ColumnsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmColumns.ColumnsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmColumns.ColumnsMock.defaultExpectation.Counter, 1)
+		mm_want := mmColumns.ColumnsMock.defaultExpectation.params
+		mm_want_ptrs := mmColumns.ColumnsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockColumnsParams{ctx, table}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmColumns.t.Errorf("RepositoryMock.Columns got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmColumns.ColumnsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.table != nil && !minimock.Equal(*mm_want_ptrs.table, mm_got.table) {
+				mmColumns.t.Errorf("RepositoryMock.Columns got unexpected parameter table, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmColumns.ColumnsMock.defaultExpectation.expectationOrigins.originTable, *mm_want_ptrs.table, mm_got.table, minimock.Diff(*mm_want_ptrs.table, mm_got.table))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmColumns.t.Errorf("RepositoryMock.Columns got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmColumns.ColumnsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmColumns.ColumnsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmColumns.t.Fatal("No results are set for the RepositoryMock.Columns")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmColumns.funcColumns != nil {
+		return mmColumns.funcColumns(ctx, table)
+	}
+	mmColumns.t.Fatalf("Unexpected call to RepositoryMock.Columns. %v %v", ctx, table)
+	return
+}
+
+// ColumnsAfterCounter returns a count of finished RepositoryMock.Columns invocations
+func (mmColumns *RepositoryMock) ColumnsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmColumns.afterColumnsCounter)
+}
+
+// ColumnsBeforeCounter returns a count of RepositoryMock.Columns invocations
+func (mmColumns *RepositoryMock) ColumnsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmColumns.beforeColumnsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Columns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmColumns *mRepositoryMockColumns) Calls() []*RepositoryMockColumnsParams {
+	mmColumns.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockColumnsParams, len(mmColumns.callArgs))
+	copy(argCopy, mmColumns.callArgs)
+
+	mmColumns.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockColumnsDone returns true if the count of the Columns invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockColumnsDone() bool {
+	if m.ColumnsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ColumnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ColumnsMock.invocationsDone()
+}
+
+// MinimockColumnsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockColumnsInspect() {
+	for _, e := range m.ColumnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Columns at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterColumnsCounter := mm_atomic.LoadUint64(&m.afterColumnsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ColumnsMock.defaultExpectation != nil && afterColumnsCounter < 1 {
+		if m.ColumnsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Columns at\n%s", m.ColumnsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Columns at\n%s with params: %#v", m.ColumnsMock.defaultExpectation.expectationOrigins.origin, *m.ColumnsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcColumns != nil && afterColumnsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Columns at\n%s", m.funcColumnsOrigin)
+	}
+
+	if !m.ColumnsMock.invocationsDone() && afterColumnsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Columns at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ColumnsMock.expectedInvocations), m.ColumnsMock.expectedInvocationsOrigin, afterColumnsCounter)
+	}
+}
+
+type mRepositoryMockExport struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockExportExpectation
+	expectations       []*RepositoryMockExportExpectation
+
+	callArgs []*RepositoryMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockExportExpectation specifies expectation struct of the Repository.Export
+type RepositoryMockExportExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockExportParams
+	paramPtrs          *RepositoryMockExportParamPtrs
+	expectationOrigins RepositoryMockExportExpectationOrigins
+	results            *RepositoryMockExportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockExportParams contains parameters of the Repository.Export
+type RepositoryMockExportParams struct {
+	ctx context.Context
+	fn  func(copyOut mm_backup.CopyOut) error
+}
+
+// RepositoryMockExportParamPtrs contains pointers to parameters of the Repository.Export
+type RepositoryMockExportParamPtrs struct {
+	ctx *context.Context
+	fn  *func(copyOut mm_backup.CopyOut) error
+}
+
+// RepositoryMockExportResults contains results of the Repository.Export
+type RepositoryMockExportResults struct {
+	err error
+}
+
+// RepositoryMockExportOrigins contains origins of expectations of the Repository.Export
+type RepositoryMockExportExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mRepositoryMockExport) Optional() *mRepositoryMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for Repository.Export
+func (mmExport *mRepositoryMockExport) Expect(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error) *mRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &RepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &RepositoryMockExportParams{ctx, fn}
+	mmExport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Export
+func (mmExport *mRepositoryMockExport) ExpectCtxParam1(ctx context.Context) *mRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &RepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &RepositoryMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectFnParam2 sets up expected param fn for Repository.Export
+func (mmExport *mRepositoryMockExport) ExpectFnParam2(fn func(copyOut mm_backup.CopyOut) error) *mRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &RepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &RepositoryMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.fn = &fn
+	mmExport.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Export
+func (mmExport *mRepositoryMockExport) Inspect(f func(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error)) *mRepositoryMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by Repository.Export
+func (mmExport *mRepositoryMockExport) Return(err error) *RepositoryMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &RepositoryMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &RepositoryMockExportResults{err}
+	mmExport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the Repository.Export method
+func (mmExport *mRepositoryMockExport) Set(f func(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error) (err error)) *RepositoryMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the Repository.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the Repository.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	mmExport.mock.funcExportOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// When sets expectation for the Repository.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mRepositoryMockExport) When(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error) *RepositoryMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("RepositoryMock.Export mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockExportExpectation{
+		mock:               mmExport.mock,
+		params:             &RepositoryMockExportParams{ctx, fn},
+		expectationOrigins: RepositoryMockExportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Export return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockExportExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockExportResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Export should be invoked
+func (mmExport *mRepositoryMockExport) Times(n uint64) *mRepositoryMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of RepositoryMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	mmExport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExport
+}
+
+func (mmExport *mRepositoryMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements mm_backup.Repository
+func (mmExport *RepositoryMock) Export(ctx context.Context, fn func(copyOut mm_backup.CopyOut) error) (err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	mmExport.t.Helper()
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, fn)
+	}
+
+	mm_params := RepositoryMockExportParams{ctx, fn}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockExportParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("RepositoryMock.Export got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmExport.t.Errorf("RepositoryMock.Export got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("RepositoryMock.Export got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExport.ExportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the RepositoryMock.Export")
+		}
+		return (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, fn)
+	}
+	mmExport.t.Fatalf("Unexpected call to RepositoryMock.Export. %v %v", ctx, fn)
+	return
+}
+
+// ExportAfterCounter returns a count of finished RepositoryMock.Export invocations
+func (mmExport *RepositoryMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of RepositoryMock.Export invocations
+func (mmExport *RepositoryMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mRepositoryMockExport) Calls() []*RepositoryMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Export at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Export at\n%s", m.ExportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Export at\n%s with params: %#v", m.ExportMock.defaultExpectation.expectationOrigins.origin, *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Export at\n%s", m.funcExportOrigin)
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Export at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), m.ExportMock.expectedInvocationsOrigin, afterExportCounter)
+	}
+}
+
+type mRepositoryMockImport struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockImportExpectation
+	expectations       []*RepositoryMockImportExpectation
+
+	callArgs []*RepositoryMockImportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockImportExpectation specifies expectation struct of the Repository.Import
+type RepositoryMockImportExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockImportParams
+	paramPtrs          *RepositoryMockImportParamPtrs
+	expectationOrigins RepositoryMockImportExpectationOrigins
+	results            *RepositoryMockImportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockImportParams contains parameters of the Repository.Import
+type RepositoryMockImportParams struct {
+	ctx context.Context
+	fn  func(copyIn mm_backup.CopyIn) error
+}
+
+// RepositoryMockImportParamPtrs contains pointers to parameters of the Repository.Import
+type RepositoryMockImportParamPtrs struct {
+	ctx *context.Context
+	fn  *func(copyIn mm_backup.CopyIn) error
+}
+
+// RepositoryMockImportResults contains results of the Repository.Import
+type RepositoryMockImportResults struct {
+	err error
+}
+
+// RepositoryMockImportOrigins contains origins of expectations of the Repository.Import
+type RepositoryMockImportExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImport *mRepositoryMockImport) Optional() *mRepositoryMockImport {
+	mmImport.optional = true
+	return mmImport
+}
+
+// Expect sets up expected params for Repository.Import
+func (mmImport *mRepositoryMockImport) Expect(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error) *mRepositoryMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &RepositoryMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.paramPtrs != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by ExpectParams functions")
+	}
+
+	mmImport.defaultExpectation.params = &RepositoryMockImportParams{ctx, fn}
+	mmImport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImport.expectations {
+		if minimock.Equal(e.params, mmImport.defaultExpectation.params) {
+			mmImport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImport.defaultExpectation.params)
+		}
+	}
+
+	return mmImport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Import
+func (mmImport *mRepositoryMockImport) ExpectCtxParam1(ctx context.Context) *mRepositoryMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &RepositoryMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &RepositoryMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectFnParam2 sets up expected param fn for Repository.Import
+func (mmImport *mRepositoryMockImport) ExpectFnParam2(fn func(copyIn mm_backup.CopyIn) error) *mRepositoryMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &RepositoryMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &RepositoryMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.fn = &fn
+	mmImport.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Import
+func (mmImport *mRepositoryMockImport) Inspect(f func(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error)) *mRepositoryMockImport {
+	if mmImport.mock.inspectFuncImport != nil {
+		mmImport.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Import")
+	}
+
+	mmImport.mock.inspectFuncImport = f
+
+	return mmImport
+}
+
+// Return sets up results that will be returned by Repository.Import
+func (mmImport *mRepositoryMockImport) Return(err error) *RepositoryMock {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &RepositoryMockImportExpectation{mock: mmImport.mock}
+	}
+	mmImport.defaultExpectation.results = &RepositoryMockImportResults{err}
+	mmImport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImport.mock
+}
+
+// Set uses given function f to mock the Repository.Import method
+func (mmImport *mRepositoryMockImport) Set(f func(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error) (err error)) *RepositoryMock {
+	if mmImport.defaultExpectation != nil {
+		mmImport.mock.t.Fatalf("Default expectation is already set for the Repository.Import method")
+	}
+
+	if len(mmImport.expectations) > 0 {
+		mmImport.mock.t.Fatalf("Some expectations are already set for the Repository.Import method")
+	}
+
+	mmImport.mock.funcImport = f
+	mmImport.mock.funcImportOrigin = minimock.CallerInfo(1)
+	return mmImport.mock
+}
+
+// When sets expectation for the Repository.Import which will trigger the result defined by the following
+// Then helper
+func (mmImport *mRepositoryMockImport) When(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error) *RepositoryMockImportExpectation {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("RepositoryMock.Import mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockImportExpectation{
+		mock:               mmImport.mock,
+		params:             &RepositoryMockImportParams{ctx, fn},
+		expectationOrigins: RepositoryMockImportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImport.expectations = append(mmImport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Import return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockImportExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockImportResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Import should be invoked
+func (mmImport *mRepositoryMockImport) Times(n uint64) *mRepositoryMockImport {
+	if n == 0 {
+		mmImport.mock.t.Fatalf("Times of RepositoryMock.Import mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImport.expectedInvocations, n)
+	mmImport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImport
+}
+
+func (mmImport *mRepositoryMockImport) invocationsDone() bool {
+	if len(mmImport.expectations) == 0 && mmImport.defaultExpectation == nil && mmImport.mock.funcImport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImport.mock.afterImportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Import implements mm_backup.Repository
+func (mmImport *RepositoryMock) Import(ctx context.Context, fn func(copyIn mm_backup.CopyIn) error) (err error) {
+	mm_atomic.AddUint64(&mmImport.beforeImportCounter, 1)
+	defer mm_atomic.AddUint64(&mmImport.afterImportCounter, 1)
+
+	mmImport.t.Helper()
+
+	if mmImport.inspectFuncImport != nil {
+		mmImport.inspectFuncImport(ctx, fn)
+	}
+
+	mm_params := RepositoryMockImportParams{ctx, fn}
+
+	// Record call args
+	mmImport.ImportMock.mutex.Lock()
+	mmImport.ImportMock.callArgs = append(mmImport.ImportMock.callArgs, &mm_params)
+	mmImport.ImportMock.mutex.Unlock()
+
+	for _, e := range mmImport.ImportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmImport.ImportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImport.ImportMock.defaultExpectation.Counter, 1)
+		mm_want := mmImport.ImportMock.defaultExpectation.params
+		mm_want_ptrs := mmImport.ImportMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockImportParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImport.t.Errorf("RepositoryMock.Import got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmImport.t.Errorf("RepositoryMock.Import got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImport.t.Errorf("RepositoryMock.Import got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImport.ImportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImport.ImportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImport.t.Fatal("No results are set for the RepositoryMock.Import")
+		}
+		return (*mm_results).err
+	}
+	if mmImport.funcImport != nil {
+		return mmImport.funcImport(ctx, fn)
+	}
+	mmImport.t.Fatalf("Unexpected call to RepositoryMock.Import. %v %v", ctx, fn)
+	return
+}
+
+// ImportAfterCounter returns a count of finished RepositoryMock.Import invocations
+func (mmImport *RepositoryMock) ImportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImport.afterImportCounter)
+}
+
+// ImportBeforeCounter returns a count of RepositoryMock.Import invocations
+func (mmImport *RepositoryMock) ImportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImport.beforeImportCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Import.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImport *mRepositoryMockImport) Calls() []*RepositoryMockImportParams {
+	mmImport.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockImportParams, len(mmImport.callArgs))
+	copy(argCopy, mmImport.callArgs)
+
+	mmImport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportDone returns true if the count of the Import invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockImportDone() bool {
+	if m.ImportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportMock.invocationsDone()
+}
+
+// MinimockImportInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockImportInspect() {
+	for _, e := range m.ImportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Import at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportCounter := mm_atomic.LoadUint64(&m.afterImportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportMock.defaultExpectation != nil && afterImportCounter < 1 {
+		if m.ImportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Import at\n%s", m.ImportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Import at\n%s with params: %#v", m.ImportMock.defaultExpectation.expectationOrigins.origin, *m.ImportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImport != nil && afterImportCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Import at\n%s", m.funcImportOrigin)
+	}
+
+	if !m.ImportMock.invocationsDone() && afterImportCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Import at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportMock.expectedInvocations), m.ImportMock.expectedInvocationsOrigin, afterImportCounter)
+	}
+}
+
+type mRepositoryMockIsEmpty struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockIsEmptyExpectation
+	expectations       []*RepositoryMockIsEmptyExpectation
+
+	callArgs []*RepositoryMockIsEmptyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockIsEmptyExpectation specifies expectation struct of the Repository.IsEmpty
+type RepositoryMockIsEmptyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockIsEmptyParams
+	paramPtrs          *RepositoryMockIsEmptyParamPtrs
+	expectationOrigins RepositoryMockIsEmptyExpectationOrigins
+	results            *RepositoryMockIsEmptyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockIsEmptyParams contains parameters of the Repository.IsEmpty
+type RepositoryMockIsEmptyParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockIsEmptyParamPtrs contains pointers to parameters of the Repository.IsEmpty
+type RepositoryMockIsEmptyParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockIsEmptyResults contains results of the Repository.IsEmpty
+type RepositoryMockIsEmptyResults struct {
+	b1  bool
+	err error
+}
+
+// RepositoryMockIsEmptyOrigins contains origins of expectations of the Repository.IsEmpty
+type RepositoryMockIsEmptyExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsEmpty *mRepositoryMockIsEmpty) Optional() *mRepositoryMockIsEmpty {
+	mmIsEmpty.optional = true
+	return mmIsEmpty
+}
+
+// Expect sets up expected params for Repository.IsEmpty
+func (mmIsEmpty *mRepositoryMockIsEmpty) Expect(ctx context.Context) *mRepositoryMockIsEmpty {
+	if mmIsEmpty.mock.funcIsEmpty != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by Set")
+	}
+
+	if mmIsEmpty.defaultExpectation == nil {
+		mmIsEmpty.defaultExpectation = &RepositoryMockIsEmptyExpectation{}
+	}
+
+	if mmIsEmpty.defaultExpectation.paramPtrs != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by ExpectParams functions")
+	}
+
+	mmIsEmpty.defaultExpectation.params = &RepositoryMockIsEmptyParams{ctx}
+	mmIsEmpty.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsEmpty.expectations {
+		if minimock.Equal(e.params, mmIsEmpty.defaultExpectation.params) {
+			mmIsEmpty.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsEmpty.defaultExpectation.params)
+		}
+	}
+
+	return mmIsEmpty
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.IsEmpty
+func (mmIsEmpty *mRepositoryMockIsEmpty) ExpectCtxParam1(ctx context.Context) *mRepositoryMockIsEmpty {
+	if mmIsEmpty.mock.funcIsEmpty != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by Set")
+	}
+
+	if mmIsEmpty.defaultExpectation == nil {
+		mmIsEmpty.defaultExpectation = &RepositoryMockIsEmptyExpectation{}
+	}
+
+	if mmIsEmpty.defaultExpectation.params != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by Expect")
+	}
+
+	if mmIsEmpty.defaultExpectation.paramPtrs == nil {
+		mmIsEmpty.defaultExpectation.paramPtrs = &RepositoryMockIsEmptyParamPtrs{}
+	}
+	mmIsEmpty.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsEmpty.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsEmpty
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.IsEmpty
+func (mmIsEmpty *mRepositoryMockIsEmpty) Inspect(f func(ctx context.Context)) *mRepositoryMockIsEmpty {
+	if mmIsEmpty.mock.inspectFuncIsEmpty != nil {
+		mmIsEmpty.mock.t.Fatalf("Inspect function is already set for RepositoryMock.IsEmpty")
+	}
+
+	mmIsEmpty.mock.inspectFuncIsEmpty = f
+
+	return mmIsEmpty
+}
+
+// Return sets up results that will be returned by Repository.IsEmpty
+func (mmIsEmpty *mRepositoryMockIsEmpty) Return(b1 bool, err error) *RepositoryMock {
+	if mmIsEmpty.mock.funcIsEmpty != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by Set")
+	}
+
+	if mmIsEmpty.defaultExpectation == nil {
+		mmIsEmpty.defaultExpectation = &RepositoryMockIsEmptyExpectation{mock: mmIsEmpty.mock}
+	}
+	mmIsEmpty.defaultExpectation.results = &RepositoryMockIsEmptyResults{b1, err}
+	mmIsEmpty.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsEmpty.mock
+}
+
+// Set uses given function f to mock the Repository.IsEmpty method
+func (mmIsEmpty *mRepositoryMockIsEmpty) Set(f func(ctx context.Context) (b1 bool, err error)) *RepositoryMock {
+	if mmIsEmpty.defaultExpectation != nil {
+		mmIsEmpty.mock.t.Fatalf("Default expectation is already set for the Repository.IsEmpty method")
+	}
+
+	if len(mmIsEmpty.expectations) > 0 {
+		mmIsEmpty.mock.t.Fatalf("Some expectations are already set for the Repository.IsEmpty method")
+	}
+
+	mmIsEmpty.mock.funcIsEmpty = f
+	mmIsEmpty.mock.funcIsEmptyOrigin = minimock.CallerInfo(1)
+	return mmIsEmpty.mock
+}
+
+// When sets expectation for the Repository.IsEmpty which will trigger the result defined by the following
+// Then helper
+func (mmIsEmpty *mRepositoryMockIsEmpty) When(ctx context.Context) *RepositoryMockIsEmptyExpectation {
+	if mmIsEmpty.mock.funcIsEmpty != nil {
+		mmIsEmpty.mock.t.Fatalf("RepositoryMock.IsEmpty mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockIsEmptyExpectation{
+		mock:               mmIsEmpty.mock,
+		params:             &RepositoryMockIsEmptyParams{ctx},
+		expectationOrigins: RepositoryMockIsEmptyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsEmpty.expectations = append(mmIsEmpty.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.IsEmpty return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockIsEmptyExpectation) Then(b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockIsEmptyResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.IsEmpty should be invoked
+func (mmIsEmpty *mRepositoryMockIsEmpty) Times(n uint64) *mRepositoryMockIsEmpty {
+	if n == 0 {
+		mmIsEmpty.mock.t.Fatalf("Times of RepositoryMock.IsEmpty mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsEmpty.expectedInvocations, n)
+	mmIsEmpty.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsEmpty
+}
+
+func (mmIsEmpty *mRepositoryMockIsEmpty) invocationsDone() bool {
+	if len(mmIsEmpty.expectations) == 0 && mmIsEmpty.defaultExpectation == nil && mmIsEmpty.mock.funcIsEmpty == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsEmpty.mock.afterIsEmptyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsEmpty.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsEmpty implements mm_backup.Repository
+func (mmIsEmpty *RepositoryMock) IsEmpty(ctx context.Context) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsEmpty.beforeIsEmptyCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsEmpty.afterIsEmptyCounter, 1)
+
+	mmIsEmpty.t.Helper()
+
+	if mmIsEmpty.inspectFuncIsEmpty != nil {
+		mmIsEmpty.inspectFuncIsEmpty(ctx)
+	}
+
+	mm_params := RepositoryMockIsEmptyParams{ctx}
+
+	// Record call args
+	mmIsEmpty.IsEmptyMock.mutex.Lock()
+	mmIsEmpty.IsEmptyMock.callArgs = append(mmIsEmpty.IsEmptyMock.callArgs, &mm_params)
+	mmIsEmpty.IsEmptyMock.mutex.Unlock()
+
+	for _, e := range mmIsEmpty.IsEmptyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsEmpty.IsEmptyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsEmpty.IsEmptyMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsEmpty.IsEmptyMock.defaultExpectation.params
+		mm_want_ptrs := mmIsEmpty.IsEmptyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockIsEmptyParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsEmpty.t.Errorf("RepositoryMock.IsEmpty got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsEmpty.IsEmptyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsEmpty.t.Errorf("RepositoryMock.IsEmpty got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsEmpty.IsEmptyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsEmpty.IsEmptyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsEmpty.t.Fatal("No results are set for the RepositoryMock.IsEmpty")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsEmpty.funcIsEmpty != nil {
+		return mmIsEmpty.funcIsEmpty(ctx)
+	}
+	mmIsEmpty.t.Fatalf("Unexpected call to RepositoryMock.IsEmpty. %v", ctx)
+	return
+}
+
+// IsEmptyAfterCounter returns a count of finished RepositoryMock.IsEmpty invocations
+func (mmIsEmpty *RepositoryMock) IsEmptyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsEmpty.afterIsEmptyCounter)
+}
+
+// IsEmptyBeforeCounter returns a count of RepositoryMock.IsEmpty invocations
+func (mmIsEmpty *RepositoryMock) IsEmptyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsEmpty.beforeIsEmptyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.IsEmpty.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsEmpty *mRepositoryMockIsEmpty) Calls() []*RepositoryMockIsEmptyParams {
+	mmIsEmpty.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockIsEmptyParams, len(mmIsEmpty.callArgs))
+	copy(argCopy, mmIsEmpty.callArgs)
+
+	mmIsEmpty.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsEmptyDone returns true if the count of the IsEmpty invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockIsEmptyDone() bool {
+	if m.IsEmptyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsEmptyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsEmptyMock.invocationsDone()
+}
+
+// MinimockIsEmptyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockIsEmptyInspect() {
+	for _, e := range m.IsEmptyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.IsEmpty at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsEmptyCounter := mm_atomic.LoadUint64(&m.afterIsEmptyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsEmptyMock.defaultExpectation != nil && afterIsEmptyCounter < 1 {
+		if m.IsEmptyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.IsEmpty at\n%s", m.IsEmptyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.IsEmpty at\n%s with params: %#v", m.IsEmptyMock.defaultExpectation.expectationOrigins.origin, *m.IsEmptyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsEmpty != nil && afterIsEmptyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.IsEmpty at\n%s", m.funcIsEmptyOrigin)
+	}
+
+	if !m.IsEmptyMock.invocationsDone() && afterIsEmptyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.IsEmpty at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsEmptyMock.expectedInvocations), m.IsEmptyMock.expectedInvocationsOrigin, afterIsEmptyCounter)
+	}
+}
+
+type mRepositoryMockSchemaVersion struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSchemaVersionExpectation
+	expectations       []*RepositoryMockSchemaVersionExpectation
+
+	callArgs []*RepositoryMockSchemaVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSchemaVersionExpectation specifies expectation struct of the Repository.SchemaVersion
+type RepositoryMockSchemaVersionExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSchemaVersionParams
+	paramPtrs          *RepositoryMockSchemaVersionParamPtrs
+	expectationOrigins RepositoryMockSchemaVersionExpectationOrigins
+	results            *RepositoryMockSchemaVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSchemaVersionParams contains parameters of the Repository.SchemaVersion
+type RepositoryMockSchemaVersionParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockSchemaVersionParamPtrs contains pointers to parameters of the Repository.SchemaVersion
+type RepositoryMockSchemaVersionParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockSchemaVersionResults contains results of the Repository.SchemaVersion
+type RepositoryMockSchemaVersionResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryMockSchemaVersionOrigins contains origins of expectations of the Repository.SchemaVersion
+type RepositoryMockSchemaVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Optional() *mRepositoryMockSchemaVersion {
+	mmSchemaVersion.optional = true
+	return mmSchemaVersion
+}
+
+// Expect sets up expected params for Repository.SchemaVersion
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Expect(ctx context.Context) *mRepositoryMockSchemaVersion {
+	if mmSchemaVersion.mock.funcSchemaVersion != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by Set")
+	}
+
+	if mmSchemaVersion.defaultExpectation == nil {
+		mmSchemaVersion.defaultExpectation = &RepositoryMockSchemaVersionExpectation{}
+	}
+
+	if mmSchemaVersion.defaultExpectation.paramPtrs != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by ExpectParams functions")
+	}
+
+	mmSchemaVersion.defaultExpectation.params = &RepositoryMockSchemaVersionParams{ctx}
+	mmSchemaVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSchemaVersion.expectations {
+		if minimock.Equal(e.params, mmSchemaVersion.defaultExpectation.params) {
+			mmSchemaVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSchemaVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmSchemaVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SchemaVersion
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSchemaVersion {
+	if mmSchemaVersion.mock.funcSchemaVersion != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by Set")
+	}
+
+	if mmSchemaVersion.defaultExpectation == nil {
+		mmSchemaVersion.defaultExpectation = &RepositoryMockSchemaVersionExpectation{}
+	}
+
+	if mmSchemaVersion.defaultExpectation.params != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by Expect")
+	}
+
+	if mmSchemaVersion.defaultExpectation.paramPtrs == nil {
+		mmSchemaVersion.defaultExpectation.paramPtrs = &RepositoryMockSchemaVersionParamPtrs{}
+	}
+	mmSchemaVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSchemaVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSchemaVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SchemaVersion
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Inspect(f func(ctx context.Context)) *mRepositoryMockSchemaVersion {
+	if mmSchemaVersion.mock.inspectFuncSchemaVersion != nil {
+		mmSchemaVersion.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SchemaVersion")
+	}
+
+	mmSchemaVersion.mock.inspectFuncSchemaVersion = f
+
+	return mmSchemaVersion
+}
+
+// Return sets up results that will be returned by Repository.SchemaVersion
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Return(i1 int64, err error) *RepositoryMock {
+	if mmSchemaVersion.mock.funcSchemaVersion != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by Set")
+	}
+
+	if mmSchemaVersion.defaultExpectation == nil {
+		mmSchemaVersion.defaultExpectation = &RepositoryMockSchemaVersionExpectation{mock: mmSchemaVersion.mock}
+	}
+	mmSchemaVersion.defaultExpectation.results = &RepositoryMockSchemaVersionResults{i1, err}
+	mmSchemaVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSchemaVersion.mock
+}
+
+// Set uses given function f to mock the Repository.SchemaVersion method
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Set(f func(ctx context.Context) (i1 int64, err error)) *RepositoryMock {
+	if mmSchemaVersion.defaultExpectation != nil {
+		mmSchemaVersion.mock.t.Fatalf("Default expectation is already set for the Repository.SchemaVersion method")
+	}
+
+	if len(mmSchemaVersion.expectations) > 0 {
+		mmSchemaVersion.mock.t.Fatalf("Some expectations are already set for the Repository.SchemaVersion method")
+	}
+
+	mmSchemaVersion.mock.funcSchemaVersion = f
+	mmSchemaVersion.mock.funcSchemaVersionOrigin = minimock.CallerInfo(1)
+	return mmSchemaVersion.mock
+}
+
+// When sets expectation for the Repository.SchemaVersion which will trigger the result defined by the following
+// Then helper
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) When(ctx context.Context) *RepositoryMockSchemaVersionExpectation {
+	if mmSchemaVersion.mock.funcSchemaVersion != nil {
+		mmSchemaVersion.mock.t.Fatalf("RepositoryMock.SchemaVersion mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSchemaVersionExpectation{
+		mock:               mmSchemaVersion.mock,
+		params:             &RepositoryMockSchemaVersionParams{ctx},
+		expectationOrigins: RepositoryMockSchemaVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSchemaVersion.expectations = append(mmSchemaVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SchemaVersion return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSchemaVersionExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockSchemaVersionResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SchemaVersion should be invoked
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Times(n uint64) *mRepositoryMockSchemaVersion {
+	if n == 0 {
+		mmSchemaVersion.mock.t.Fatalf("Times of RepositoryMock.SchemaVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSchemaVersion.expectedInvocations, n)
+	mmSchemaVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSchemaVersion
+}
+
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) invocationsDone() bool {
+	if len(mmSchemaVersion.expectations) == 0 && mmSchemaVersion.defaultExpectation == nil && mmSchemaVersion.mock.funcSchemaVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSchemaVersion.mock.afterSchemaVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSchemaVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SchemaVersion implements mm_backup.Repository
+func (mmSchemaVersion *RepositoryMock) SchemaVersion(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmSchemaVersion.beforeSchemaVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSchemaVersion.afterSchemaVersionCounter, 1)
+
+	mmSchemaVersion.t.Helper()
+
+	if mmSchemaVersion.inspectFuncSchemaVersion != nil {
+		mmSchemaVersion.inspectFuncSchemaVersion(ctx)
+	}
+
+	mm_params := RepositoryMockSchemaVersionParams{ctx}
+
+	// Record call args
+	mmSchemaVersion.SchemaVersionMock.mutex.Lock()
+	mmSchemaVersion.SchemaVersionMock.callArgs = append(mmSchemaVersion.SchemaVersionMock.callArgs, &mm_params)
+	mmSchemaVersion.SchemaVersionMock.mutex.Unlock()
+
+	for _, e := range mmSchemaVersion.SchemaVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSchemaVersion.SchemaVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSchemaVersion.SchemaVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSchemaVersion.SchemaVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmSchemaVersion.SchemaVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSchemaVersionParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSchemaVersion.t.Errorf("RepositoryMock.SchemaVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchemaVersion.SchemaVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSchemaVersion.t.Errorf("RepositoryMock.SchemaVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSchemaVersion.SchemaVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSchemaVersion.SchemaVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSchemaVersion.t.Fatal("No results are set for the RepositoryMock.SchemaVersion")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSchemaVersion.funcSchemaVersion != nil {
+		return mmSchemaVersion.funcSchemaVersion(ctx)
+	}
+	mmSchemaVersion.t.Fatalf("Unexpected call to RepositoryMock.SchemaVersion. %v", ctx)
+	return
+}
+
+// SchemaVersionAfterCounter returns a count of finished RepositoryMock.SchemaVersion invocations
+func (mmSchemaVersion *RepositoryMock) SchemaVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchemaVersion.afterSchemaVersionCounter)
+}
+
+// SchemaVersionBeforeCounter returns a count of RepositoryMock.SchemaVersion invocations
+func (mmSchemaVersion *RepositoryMock) SchemaVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchemaVersion.beforeSchemaVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SchemaVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSchemaVersion *mRepositoryMockSchemaVersion) Calls() []*RepositoryMockSchemaVersionParams {
+	mmSchemaVersion.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSchemaVersionParams, len(mmSchemaVersion.callArgs))
+	copy(argCopy, mmSchemaVersion.callArgs)
+
+	mmSchemaVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSchemaVersionDone returns true if the count of the SchemaVersion invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSchemaVersionDone() bool {
+	if m.SchemaVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SchemaVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SchemaVersionMock.invocationsDone()
+}
+
+// MinimockSchemaVersionInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSchemaVersionInspect() {
+	for _, e := range m.SchemaVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SchemaVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSchemaVersionCounter := mm_atomic.LoadUint64(&m.afterSchemaVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SchemaVersionMock.defaultExpectation != nil && afterSchemaVersionCounter < 1 {
+		if m.SchemaVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SchemaVersion at\n%s", m.SchemaVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SchemaVersion at\n%s with params: %#v", m.SchemaVersionMock.defaultExpectation.expectationOrigins.origin, *m.SchemaVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSchemaVersion != nil && afterSchemaVersionCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SchemaVersion at\n%s", m.funcSchemaVersionOrigin)
+	}
+
+	if !m.SchemaVersionMock.invocationsDone() && afterSchemaVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SchemaVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SchemaVersionMock.expectedInvocations), m.SchemaVersionMock.expectedInvocationsOrigin, afterSchemaVersionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockColumnsInspect()
+
+			m.MinimockExportInspect()
+
+			m.MinimockImportInspect()
+
+			m.MinimockIsEmptyInspect()
+
+			m.MinimockSchemaVersionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockColumnsDone() &&
+		m.MinimockExportDone() &&
+		m.MinimockImportDone() &&
+		m.MinimockIsEmptyDone() &&
+		m.MinimockSchemaVersionDone()
+}
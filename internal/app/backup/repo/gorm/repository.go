@@ -0,0 +1,187 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+func (r *gormRepo) SchemaVersion(ctx context.Context) (int64, error) {
+	var version int64
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied`).
+		Scan(&version).Error
+	if err != nil {
+		return 0, fmt.Errorf("gormRepo.SchemaVersion: %w", err)
+	}
+
+	return version, nil
+}
+
+func (r *gormRepo) Columns(ctx context.Context, table string) ([]string, error) {
+	var columns []string
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = ?
+		ORDER BY ordinal_position`, table).
+		Scan(&columns).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.Columns: %w", err)
+	}
+
+	return columns, nil
+}
+
+func (r *gormRepo) IsEmpty(ctx context.Context) (bool, error) {
+	var empty bool
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT NOT EXISTS (SELECT 1 FROM users) AND NOT EXISTS (SELECT 1 FROM entities)`).
+		Scan(&empty).Error
+	if err != nil {
+		return false, fmt.Errorf("gormRepo.IsEmpty: %w", err)
+	}
+
+	return empty, nil
+}
+
+// Export copies the tables in a read-only repeatable read transaction, so they all come from the
+// same snapshot and the foreign keys between them hold.
+func (r *gormRepo) Export(ctx context.Context, fn func(copyOut backup.CopyOut) error) error {
+	err := r.withConn(ctx, func(conn *pgx.Conn) error {
+		opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+		return pgx.BeginTxFunc(ctx, conn, opts, func(tx pgx.Tx) error {
+			return fn(func(table backup.Table, w io.Writer) (int64, error) {
+				tag, err := tx.Conn().PgConn().CopyTo(ctx, w, copyOutSQL(table))
+				return tag.RowsAffected(), err
+			})
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.Export: %w", err)
+	}
+
+	return nil
+}
+
+// Import loads the tables in one transaction, then moves the serial sequences of the backed up tables
+// past the restored ids.
+func (r *gormRepo) Import(ctx context.Context, fn func(copyIn backup.CopyIn) error) error {
+	err := r.withConn(ctx, func(conn *pgx.Conn) error {
+		return pgx.BeginTxFunc(ctx, conn, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, `DELETE FROM workspaces`); err != nil {
+				return err
+			}
+			err := fn(func(table backup.Table, r io.Reader) (int64, error) {
+				tag, err := tx.Conn().PgConn().CopyFrom(ctx, r, copyInSQL(table))
+				return tag.RowsAffected(), err
+			})
+			if err != nil {
+				return err
+			}
+			return resetSequences(ctx, tx)
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.Import: %w", err)
+	}
+
+	return nil
+}
+
+func resetSequences(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, `
+		SELECT table_name, column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema()
+		  AND table_name = ANY($1)
+		  AND column_default LIKE 'nextval(%'`, backup.Tables)
+	if err != nil {
+		return err
+	}
+	type serial struct{ table, column string }
+	serials, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (serial, error) {
+		var s serial
+		err := row.Scan(&s.table, &s.column)
+		return s, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range serials {
+		_, err = tx.Exec(ctx, fmt.Sprintf(
+			`SELECT setval(pg_get_serial_sequence($1, $2), COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)`,
+			quote(s.column), quote(s.table)), s.table, s.column)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withConn runs fn on a connection of the pool, whose driver connection gives access to COPY.
+func (r *gormRepo) withConn(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T", driverConn)
+		}
+		return fn(c.Conn())
+	})
+}
+
+// copyOutSQL selects the columns of the table, with the blanked ones as empty strings.
+func copyOutSQL(table backup.Table) string {
+	columns := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = quote(column)
+		if slices.Contains(table.Blanked, column) {
+			columns[i] = "'' AS " + quote(column)
+		}
+	}
+
+	return fmt.Sprintf(`COPY (SELECT %s FROM %s) TO STDOUT`, strings.Join(columns, ", "), quote(table.Name))
+}
+
+func copyInSQL(table backup.Table) string {
+	columns := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = quote(column)
+	}
+
+	return fmt.Sprintf(`COPY %s (%s) FROM STDIN`, quote(table.Name), strings.Join(columns, ", "))
+}
+
+func quote(identifier string) string {
+	return pgx.Identifier{identifier}.Sanitize()
+}
@@ -0,0 +1,153 @@
+package gorm
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestSchemaVersionColumnsIsEmpty(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+	ctx := t.Context()
+
+	version, err := repo.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Positive(t, version)
+
+	columns, err := repo.Columns(ctx, "workspaces")
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "slug", "name", "created_at", "updated_at"}, columns[:5])
+	columns, err = repo.Columns(ctx, "missing")
+	require.NoError(t, err)
+	require.Empty(t, columns)
+
+	empty, err := repo.IsEmpty(ctx)
+	require.NoError(t, err)
+	require.True(t, empty)
+	createUser(t, gdb)
+	empty, err = repo.IsEmpty(ctx)
+	require.NoError(t, err)
+	require.False(t, empty)
+
+	// err
+	cleanup()
+	_, err = repo.SchemaVersion(ctx)
+	require.Error(t, err)
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+	src, srcDB, _ := newRepo(t)
+	dst, dstDB, _ := newRepo(t)
+	ctx := t.Context()
+
+	userID := createUser(t, srcDB)
+	entityID := uuid.New()
+	exec(t, srcDB, `INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,created_by,updated_by,owner_id)
+		VALUES (?,'department',NOW(),NOW(),'name',?,'',?,?,?)`, entityID, entityID.String(), userID, userID, userID)
+	exec(t, srcDB, `INSERT INTO entity_events(entity_id,type,actor_id) VALUES (?,'created',?), (?,'updated',?)`,
+		entityID, userID, entityID, userID)
+
+	tables := make([]backup.Table, 0, len(backup.Tables))
+	for _, name := range backup.Tables {
+		columns, err := src.Columns(ctx, name)
+		require.NoError(t, err)
+		table := backup.Table{Name: name, Columns: columns}
+		if name == "users" {
+			table.Blanked = []string{"password_hash"}
+		}
+		tables = append(tables, table)
+	}
+	data := make(map[string]*bytes.Buffer)
+	err := src.Export(ctx, func(copyOut backup.CopyOut) error {
+		for i, table := range tables {
+			data[table.Name] = &bytes.Buffer{}
+			n, err := copyOut(table, data[table.Name])
+			if err != nil {
+				return err
+			}
+			tables[i].Rows = n
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), tables[1].Rows)
+	require.NotContains(t, data["users"].String(), "hash")
+
+	err = dst.Import(ctx, func(copyIn backup.CopyIn) error {
+		for _, table := range tables {
+			n, err := copyIn(table, bytes.NewReader(data[table.Name].Bytes()))
+			if err != nil {
+				return err
+			}
+			require.Equal(t, table.Rows, n)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var hash string
+	require.NoError(t, dstDB.Raw(`SELECT password_hash FROM users WHERE id = ?`, userID).Scan(&hash).Error)
+	require.Empty(t, hash)
+	var workspaces int64
+	require.NoError(t, dstDB.Raw(`SELECT COUNT(*) FROM workspaces`).Scan(&workspaces).Error)
+	require.Equal(t, int64(1), workspaces)
+	// the sequence continues after the restored events
+	exec(t, dstDB, `INSERT INTO entity_events(entity_id,type) VALUES (?,'viewed')`, entityID)
+	var maxID int64
+	require.NoError(t, dstDB.Raw(`SELECT MAX(id) FROM entity_events`).Scan(&maxID).Error)
+	require.Equal(t, int64(3), maxID)
+
+	// a failed import leaves nothing behind
+	_, emptyDB, _ := newRepo(t)
+	failing, err := NewRepository(emptyDB)
+	require.NoError(t, err)
+	err = failing.Import(ctx, func(copyIn backup.CopyIn) error {
+		_, err := copyIn(tables[0], strings.NewReader("not\ta\trow\n"))
+		return err
+	})
+	require.Error(t, err)
+	require.NoError(t, emptyDB.Raw(`SELECT COUNT(*) FROM workspaces`).Scan(&workspaces).Error)
+	require.Equal(t, int64(1), workspaces)
+}
+
+func exec(t *testing.T, gdb *gorm.DB, sql string, args ...any) {
+	t.Helper()
+	require.NoError(t, gdb.WithContext(t.Context()).Exec(sql, args...).Error)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	exec(t, gdb, `INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+		VALUES (?,?,'Test','hash',NOW(),NOW(),0)`, uid, uid.String()+"@example.com")
+
+	return uid
+}
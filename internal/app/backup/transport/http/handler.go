@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+)
+
+type Service interface {
+	Start(ctx context.Context) (backup.Status, error)
+	Status() backup.Status
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("backup HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// Start godoc
+// @Summary      Start a backup
+// @Description  Starts writing the users, roles, entities and their versions to an archive in the configured S3-compatible bucket.
+// @Description  The backup runs in the background; poll the status endpoint to see how it ends. Only one runs at a time.
+// @Description  Only available when backups are configured. Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      202 {object} backup.Status
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/backups [post]
+func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	status, err := h.svc.Start(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusAccepted, status)
+}
+
+// Status godoc
+// @Summary      Get backup status
+// @Description  Returns the state of the last backup started since the server came up, empty before the first one.
+// @Description  Requires admin role in the default workspace.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} backup.Status
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/backups/status [get]
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(r.Context(), w, http.StatusOK, h.svc.Status())
+}
@@ -0,0 +1,69 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	backup_http "github.com/66gu1/easygodocs/internal/app/backup/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/backup/transport/http/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_Start(t *testing.T) {
+	t.Parallel()
+
+	status := backup.Status{State: backup.StateRunning, Key: "backups/20251001T120000Z.tar.gz"}
+
+	tests := []struct {
+		name       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "ok",
+			wantStatus: http.StatusAccepted,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.StartMock.Expect(minimock.AnyContext).Return(status, nil)
+			},
+		},
+		{
+			name:       "in progress -> 409",
+			wantStatus: http.StatusConflict,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.StartMock.Expect(minimock.AnyContext).Return(backup.Status{}, backup.ErrInProgress())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			tt.setup(svcMock)
+
+			h := backup_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodPost, "/admin/backups", nil)
+			w := httptest.NewRecorder()
+			h.Start(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantStatus != http.StatusAccepted {
+				return
+			}
+			var got backup.Status
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+			require.Equal(t, status, got)
+		})
+	}
+}
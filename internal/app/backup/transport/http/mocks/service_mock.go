@@ -0,0 +1,585 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/backup/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcStart          func(ctx context.Context) (s1 backup.Status, err error)
+	funcStartOrigin    string
+	inspectFuncStart   func(ctx context.Context)
+	afterStartCounter  uint64
+	beforeStartCounter uint64
+	StartMock          mServiceMockStart
+
+	funcStatus          func() (s1 backup.Status)
+	funcStatusOrigin    string
+	inspectFuncStatus   func()
+	afterStatusCounter  uint64
+	beforeStatusCounter uint64
+	StatusMock          mServiceMockStatus
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.StartMock = mServiceMockStart{mock: m}
+	m.StartMock.callArgs = []*ServiceMockStartParams{}
+
+	m.StatusMock = mServiceMockStatus{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockStart struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockStartExpectation
+	expectations       []*ServiceMockStartExpectation
+
+	callArgs []*ServiceMockStartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockStartExpectation specifies expectation struct of the Service.Start
+type ServiceMockStartExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockStartParams
+	paramPtrs          *ServiceMockStartParamPtrs
+	expectationOrigins ServiceMockStartExpectationOrigins
+	results            *ServiceMockStartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockStartParams contains parameters of the Service.Start
+type ServiceMockStartParams struct {
+	ctx context.Context
+}
+
+// ServiceMockStartParamPtrs contains pointers to parameters of the Service.Start
+type ServiceMockStartParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockStartResults contains results of the Service.Start
+type ServiceMockStartResults struct {
+	s1  backup.Status
+	err error
+}
+
+// ServiceMockStartOrigins contains origins of expectations of the Service.Start
+type ServiceMockStartExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStart *mServiceMockStart) Optional() *mServiceMockStart {
+	mmStart.optional = true
+	return mmStart
+}
+
+// Expect sets up expected params for Service.Start
+func (mmStart *mServiceMockStart) Expect(ctx context.Context) *mServiceMockStart {
+	if mmStart.mock.funcStart != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	if mmStart.defaultExpectation == nil {
+		mmStart.defaultExpectation = &ServiceMockStartExpectation{}
+	}
+
+	if mmStart.defaultExpectation.paramPtrs != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by ExpectParams functions")
+	}
+
+	mmStart.defaultExpectation.params = &ServiceMockStartParams{ctx}
+	mmStart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStart.expectations {
+		if minimock.Equal(e.params, mmStart.defaultExpectation.params) {
+			mmStart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStart.defaultExpectation.params)
+		}
+	}
+
+	return mmStart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Start
+func (mmStart *mServiceMockStart) ExpectCtxParam1(ctx context.Context) *mServiceMockStart {
+	if mmStart.mock.funcStart != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	if mmStart.defaultExpectation == nil {
+		mmStart.defaultExpectation = &ServiceMockStartExpectation{}
+	}
+
+	if mmStart.defaultExpectation.params != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by Expect")
+	}
+
+	if mmStart.defaultExpectation.paramPtrs == nil {
+		mmStart.defaultExpectation.paramPtrs = &ServiceMockStartParamPtrs{}
+	}
+	mmStart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Start
+func (mmStart *mServiceMockStart) Inspect(f func(ctx context.Context)) *mServiceMockStart {
+	if mmStart.mock.inspectFuncStart != nil {
+		mmStart.mock.t.Fatalf("Inspect function is already set for ServiceMock.Start")
+	}
+
+	mmStart.mock.inspectFuncStart = f
+
+	return mmStart
+}
+
+// Return sets up results that will be returned by Service.Start
+func (mmStart *mServiceMockStart) Return(s1 backup.Status, err error) *ServiceMock {
+	if mmStart.mock.funcStart != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	if mmStart.defaultExpectation == nil {
+		mmStart.defaultExpectation = &ServiceMockStartExpectation{mock: mmStart.mock}
+	}
+	mmStart.defaultExpectation.results = &ServiceMockStartResults{s1, err}
+	mmStart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStart.mock
+}
+
+// Set uses given function f to mock the Service.Start method
+func (mmStart *mServiceMockStart) Set(f func(ctx context.Context) (s1 backup.Status, err error)) *ServiceMock {
+	if mmStart.defaultExpectation != nil {
+		mmStart.mock.t.Fatalf("Default expectation is already set for the Service.Start method")
+	}
+
+	if len(mmStart.expectations) > 0 {
+		mmStart.mock.t.Fatalf("Some expectations are already set for the Service.Start method")
+	}
+
+	mmStart.mock.funcStart = f
+	mmStart.mock.funcStartOrigin = minimock.CallerInfo(1)
+	return mmStart.mock
+}
+
+// When sets expectation for the Service.Start which will trigger the result defined by the following
+// Then helper
+func (mmStart *mServiceMockStart) When(ctx context.Context) *ServiceMockStartExpectation {
+	if mmStart.mock.funcStart != nil {
+		mmStart.mock.t.Fatalf("ServiceMock.Start mock is already set by Set")
+	}
+
+	expectation := &ServiceMockStartExpectation{
+		mock:               mmStart.mock,
+		params:             &ServiceMockStartParams{ctx},
+		expectationOrigins: ServiceMockStartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStart.expectations = append(mmStart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Start return parameters for the expectation previously defined by the When method
+func (e *ServiceMockStartExpectation) Then(s1 backup.Status, err error) *ServiceMock {
+	e.results = &ServiceMockStartResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Start should be invoked
+func (mmStart *mServiceMockStart) Times(n uint64) *mServiceMockStart {
+	if n == 0 {
+		mmStart.mock.t.Fatalf("Times of ServiceMock.Start mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStart.expectedInvocations, n)
+	mmStart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStart
+}
+
+func (mmStart *mServiceMockStart) invocationsDone() bool {
+	if len(mmStart.expectations) == 0 && mmStart.defaultExpectation == nil && mmStart.mock.funcStart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStart.mock.afterStartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Start implements mm_http.Service
+func (mmStart *ServiceMock) Start(ctx context.Context) (s1 backup.Status, err error) {
+	mm_atomic.AddUint64(&mmStart.beforeStartCounter, 1)
+	defer mm_atomic.AddUint64(&mmStart.afterStartCounter, 1)
+
+	mmStart.t.Helper()
+
+	if mmStart.inspectFuncStart != nil {
+		mmStart.inspectFuncStart(ctx)
+	}
+
+	mm_params := ServiceMockStartParams{ctx}
+
+	// Record call args
+	mmStart.StartMock.mutex.Lock()
+	mmStart.StartMock.callArgs = append(mmStart.StartMock.callArgs, &mm_params)
+	mmStart.StartMock.mutex.Unlock()
+
+	for _, e := range mmStart.StartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmStart.StartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStart.StartMock.defaultExpectation.Counter, 1)
+		mm_want := mmStart.StartMock.defaultExpectation.params
+		mm_want_ptrs := mmStart.StartMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockStartParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStart.t.Errorf("ServiceMock.Start got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStart.StartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStart.t.Errorf("ServiceMock.Start got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStart.StartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStart.StartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStart.t.Fatal("No results are set for the ServiceMock.Start")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmStart.funcStart != nil {
+		return mmStart.funcStart(ctx)
+	}
+	mmStart.t.Fatalf("Unexpected call to ServiceMock.Start. %v", ctx)
+	return
+}
+
+// StartAfterCounter returns a count of finished ServiceMock.Start invocations
+func (mmStart *ServiceMock) StartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStart.afterStartCounter)
+}
+
+// StartBeforeCounter returns a count of ServiceMock.Start invocations
+func (mmStart *ServiceMock) StartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStart.beforeStartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Start.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStart *mServiceMockStart) Calls() []*ServiceMockStartParams {
+	mmStart.mutex.RLock()
+
+	argCopy := make([]*ServiceMockStartParams, len(mmStart.callArgs))
+	copy(argCopy, mmStart.callArgs)
+
+	mmStart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartDone returns true if the count of the Start invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockStartDone() bool {
+	if m.StartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartMock.invocationsDone()
+}
+
+// MinimockStartInspect logs each unmet expectation
+func (m *ServiceMock) MinimockStartInspect() {
+	for _, e := range m.StartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Start at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartCounter := mm_atomic.LoadUint64(&m.afterStartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartMock.defaultExpectation != nil && afterStartCounter < 1 {
+		if m.StartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Start at\n%s", m.StartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Start at\n%s with params: %#v", m.StartMock.defaultExpectation.expectationOrigins.origin, *m.StartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStart != nil && afterStartCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Start at\n%s", m.funcStartOrigin)
+	}
+
+	if !m.StartMock.invocationsDone() && afterStartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Start at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartMock.expectedInvocations), m.StartMock.expectedInvocationsOrigin, afterStartCounter)
+	}
+}
+
+type mServiceMockStatus struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockStatusExpectation
+	expectations       []*ServiceMockStatusExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockStatusExpectation specifies expectation struct of the Service.Status
+type ServiceMockStatusExpectation struct {
+	mock *ServiceMock
+
+	results      *ServiceMockStatusResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ServiceMockStatusResults contains results of the Service.Status
+type ServiceMockStatusResults struct {
+	s1 backup.Status
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStatus *mServiceMockStatus) Optional() *mServiceMockStatus {
+	mmStatus.optional = true
+	return mmStatus
+}
+
+// Expect sets up expected params for Service.Status
+func (mmStatus *mServiceMockStatus) Expect() *mServiceMockStatus {
+	if mmStatus.mock.funcStatus != nil {
+		mmStatus.mock.t.Fatalf("ServiceMock.Status mock is already set by Set")
+	}
+
+	if mmStatus.defaultExpectation == nil {
+		mmStatus.defaultExpectation = &ServiceMockStatusExpectation{}
+	}
+
+	return mmStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Status
+func (mmStatus *mServiceMockStatus) Inspect(f func()) *mServiceMockStatus {
+	if mmStatus.mock.inspectFuncStatus != nil {
+		mmStatus.mock.t.Fatalf("Inspect function is already set for ServiceMock.Status")
+	}
+
+	mmStatus.mock.inspectFuncStatus = f
+
+	return mmStatus
+}
+
+// Return sets up results that will be returned by Service.Status
+func (mmStatus *mServiceMockStatus) Return(s1 backup.Status) *ServiceMock {
+	if mmStatus.mock.funcStatus != nil {
+		mmStatus.mock.t.Fatalf("ServiceMock.Status mock is already set by Set")
+	}
+
+	if mmStatus.defaultExpectation == nil {
+		mmStatus.defaultExpectation = &ServiceMockStatusExpectation{mock: mmStatus.mock}
+	}
+	mmStatus.defaultExpectation.results = &ServiceMockStatusResults{s1}
+	mmStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStatus.mock
+}
+
+// Set uses given function f to mock the Service.Status method
+func (mmStatus *mServiceMockStatus) Set(f func() (s1 backup.Status)) *ServiceMock {
+	if mmStatus.defaultExpectation != nil {
+		mmStatus.mock.t.Fatalf("Default expectation is already set for the Service.Status method")
+	}
+
+	if len(mmStatus.expectations) > 0 {
+		mmStatus.mock.t.Fatalf("Some expectations are already set for the Service.Status method")
+	}
+
+	mmStatus.mock.funcStatus = f
+	mmStatus.mock.funcStatusOrigin = minimock.CallerInfo(1)
+	return mmStatus.mock
+}
+
+// Times sets number of times Service.Status should be invoked
+func (mmStatus *mServiceMockStatus) Times(n uint64) *mServiceMockStatus {
+	if n == 0 {
+		mmStatus.mock.t.Fatalf("Times of ServiceMock.Status mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStatus.expectedInvocations, n)
+	mmStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStatus
+}
+
+func (mmStatus *mServiceMockStatus) invocationsDone() bool {
+	if len(mmStatus.expectations) == 0 && mmStatus.defaultExpectation == nil && mmStatus.mock.funcStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStatus.mock.afterStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Status implements mm_http.Service
+func (mmStatus *ServiceMock) Status() (s1 backup.Status) {
+	mm_atomic.AddUint64(&mmStatus.beforeStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmStatus.afterStatusCounter, 1)
+
+	mmStatus.t.Helper()
+
+	if mmStatus.inspectFuncStatus != nil {
+		mmStatus.inspectFuncStatus()
+	}
+
+	if mmStatus.StatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStatus.StatusMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmStatus.StatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStatus.t.Fatal("No results are set for the ServiceMock.Status")
+		}
+		return (*mm_results).s1
+	}
+	if mmStatus.funcStatus != nil {
+		return mmStatus.funcStatus()
+	}
+	mmStatus.t.Fatalf("Unexpected call to ServiceMock.Status.")
+	return
+}
+
+// StatusAfterCounter returns a count of finished ServiceMock.Status invocations
+func (mmStatus *ServiceMock) StatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStatus.afterStatusCounter)
+}
+
+// StatusBeforeCounter returns a count of ServiceMock.Status invocations
+func (mmStatus *ServiceMock) StatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStatus.beforeStatusCounter)
+}
+
+// MinimockStatusDone returns true if the count of the Status invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockStatusDone() bool {
+	if m.StatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StatusMock.invocationsDone()
+}
+
+// MinimockStatusInspect logs each unmet expectation
+func (m *ServiceMock) MinimockStatusInspect() {
+	for _, e := range m.StatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ServiceMock.Status")
+		}
+	}
+
+	afterStatusCounter := mm_atomic.LoadUint64(&m.afterStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatusMock.defaultExpectation != nil && afterStatusCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Status at\n%s", m.StatusMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStatus != nil && afterStatusCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Status at\n%s", m.funcStatusOrigin)
+	}
+
+	if !m.StatusMock.invocationsDone() && afterStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Status at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StatusMock.expectedInvocations), m.StatusMock.expectedInvocationsOrigin, afterStatusCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockStartInspect()
+
+			m.MinimockStatusInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockStartDone() &&
+		m.MinimockStatusDone()
+}
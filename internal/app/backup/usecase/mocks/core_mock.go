@@ -0,0 +1,419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/backup/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcWrite          func(ctx context.Context, w io.Writer) (m1 backup.Manifest, err error)
+	funcWriteOrigin    string
+	inspectFuncWrite   func(ctx context.Context, w io.Writer)
+	afterWriteCounter  uint64
+	beforeWriteCounter uint64
+	WriteMock          mCoreMockWrite
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.WriteMock = mCoreMockWrite{mock: m}
+	m.WriteMock.callArgs = []*CoreMockWriteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockWrite struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockWriteExpectation
+	expectations       []*CoreMockWriteExpectation
+
+	callArgs []*CoreMockWriteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockWriteExpectation specifies expectation struct of the Core.Write
+type CoreMockWriteExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockWriteParams
+	paramPtrs          *CoreMockWriteParamPtrs
+	expectationOrigins CoreMockWriteExpectationOrigins
+	results            *CoreMockWriteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockWriteParams contains parameters of the Core.Write
+type CoreMockWriteParams struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+// CoreMockWriteParamPtrs contains pointers to parameters of the Core.Write
+type CoreMockWriteParamPtrs struct {
+	ctx *context.Context
+	w   *io.Writer
+}
+
+// CoreMockWriteResults contains results of the Core.Write
+type CoreMockWriteResults struct {
+	m1  backup.Manifest
+	err error
+}
+
+// CoreMockWriteOrigins contains origins of expectations of the Core.Write
+type CoreMockWriteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originW   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWrite *mCoreMockWrite) Optional() *mCoreMockWrite {
+	mmWrite.optional = true
+	return mmWrite
+}
+
+// Expect sets up expected params for Core.Write
+func (mmWrite *mCoreMockWrite) Expect(ctx context.Context, w io.Writer) *mCoreMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &CoreMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by ExpectParams functions")
+	}
+
+	mmWrite.defaultExpectation.params = &CoreMockWriteParams{ctx, w}
+	mmWrite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWrite.expectations {
+		if minimock.Equal(e.params, mmWrite.defaultExpectation.params) {
+			mmWrite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWrite.defaultExpectation.params)
+		}
+	}
+
+	return mmWrite
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Write
+func (mmWrite *mCoreMockWrite) ExpectCtxParam1(ctx context.Context) *mCoreMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &CoreMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.params != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Expect")
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs == nil {
+		mmWrite.defaultExpectation.paramPtrs = &CoreMockWriteParamPtrs{}
+	}
+	mmWrite.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWrite.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWrite
+}
+
+// ExpectWParam2 sets up expected param w for Core.Write
+func (mmWrite *mCoreMockWrite) ExpectWParam2(w io.Writer) *mCoreMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &CoreMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.params != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Expect")
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs == nil {
+		mmWrite.defaultExpectation.paramPtrs = &CoreMockWriteParamPtrs{}
+	}
+	mmWrite.defaultExpectation.paramPtrs.w = &w
+	mmWrite.defaultExpectation.expectationOrigins.originW = minimock.CallerInfo(1)
+
+	return mmWrite
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Write
+func (mmWrite *mCoreMockWrite) Inspect(f func(ctx context.Context, w io.Writer)) *mCoreMockWrite {
+	if mmWrite.mock.inspectFuncWrite != nil {
+		mmWrite.mock.t.Fatalf("Inspect function is already set for CoreMock.Write")
+	}
+
+	mmWrite.mock.inspectFuncWrite = f
+
+	return mmWrite
+}
+
+// Return sets up results that will be returned by Core.Write
+func (mmWrite *mCoreMockWrite) Return(m1 backup.Manifest, err error) *CoreMock {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &CoreMockWriteExpectation{mock: mmWrite.mock}
+	}
+	mmWrite.defaultExpectation.results = &CoreMockWriteResults{m1, err}
+	mmWrite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWrite.mock
+}
+
+// Set uses given function f to mock the Core.Write method
+func (mmWrite *mCoreMockWrite) Set(f func(ctx context.Context, w io.Writer) (m1 backup.Manifest, err error)) *CoreMock {
+	if mmWrite.defaultExpectation != nil {
+		mmWrite.mock.t.Fatalf("Default expectation is already set for the Core.Write method")
+	}
+
+	if len(mmWrite.expectations) > 0 {
+		mmWrite.mock.t.Fatalf("Some expectations are already set for the Core.Write method")
+	}
+
+	mmWrite.mock.funcWrite = f
+	mmWrite.mock.funcWriteOrigin = minimock.CallerInfo(1)
+	return mmWrite.mock
+}
+
+// When sets expectation for the Core.Write which will trigger the result defined by the following
+// Then helper
+func (mmWrite *mCoreMockWrite) When(ctx context.Context, w io.Writer) *CoreMockWriteExpectation {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("CoreMock.Write mock is already set by Set")
+	}
+
+	expectation := &CoreMockWriteExpectation{
+		mock:               mmWrite.mock,
+		params:             &CoreMockWriteParams{ctx, w},
+		expectationOrigins: CoreMockWriteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWrite.expectations = append(mmWrite.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Write return parameters for the expectation previously defined by the When method
+func (e *CoreMockWriteExpectation) Then(m1 backup.Manifest, err error) *CoreMock {
+	e.results = &CoreMockWriteResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Write should be invoked
+func (mmWrite *mCoreMockWrite) Times(n uint64) *mCoreMockWrite {
+	if n == 0 {
+		mmWrite.mock.t.Fatalf("Times of CoreMock.Write mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWrite.expectedInvocations, n)
+	mmWrite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWrite
+}
+
+func (mmWrite *mCoreMockWrite) invocationsDone() bool {
+	if len(mmWrite.expectations) == 0 && mmWrite.defaultExpectation == nil && mmWrite.mock.funcWrite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWrite.mock.afterWriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWrite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Write implements mm_usecase.Core
+func (mmWrite *CoreMock) Write(ctx context.Context, w io.Writer) (m1 backup.Manifest, err error) {
+	mm_atomic.AddUint64(&mmWrite.beforeWriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmWrite.afterWriteCounter, 1)
+
+	mmWrite.t.Helper()
+
+	if mmWrite.inspectFuncWrite != nil {
+		mmWrite.inspectFuncWrite(ctx, w)
+	}
+
+	mm_params := CoreMockWriteParams{ctx, w}
+
+	// Record call args
+	mmWrite.WriteMock.mutex.Lock()
+	mmWrite.WriteMock.callArgs = append(mmWrite.WriteMock.callArgs, &mm_params)
+	mmWrite.WriteMock.mutex.Unlock()
+
+	for _, e := range mmWrite.WriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmWrite.WriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWrite.WriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmWrite.WriteMock.defaultExpectation.params
+		mm_want_ptrs := mmWrite.WriteMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockWriteParams{ctx, w}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWrite.t.Errorf("CoreMock.Write got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWrite.WriteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.w != nil && !minimock.Equal(*mm_want_ptrs.w, mm_got.w) {
+				mmWrite.t.Errorf("CoreMock.Write got unexpected parameter w, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWrite.WriteMock.defaultExpectation.expectationOrigins.originW, *mm_want_ptrs.w, mm_got.w, minimock.Diff(*mm_want_ptrs.w, mm_got.w))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWrite.t.Errorf("CoreMock.Write got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWrite.WriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWrite.WriteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWrite.t.Fatal("No results are set for the CoreMock.Write")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmWrite.funcWrite != nil {
+		return mmWrite.funcWrite(ctx, w)
+	}
+	mmWrite.t.Fatalf("Unexpected call to CoreMock.Write. %v %v", ctx, w)
+	return
+}
+
+// WriteAfterCounter returns a count of finished CoreMock.Write invocations
+func (mmWrite *CoreMock) WriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.afterWriteCounter)
+}
+
+// WriteBeforeCounter returns a count of CoreMock.Write invocations
+func (mmWrite *CoreMock) WriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.beforeWriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Write.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWrite *mCoreMockWrite) Calls() []*CoreMockWriteParams {
+	mmWrite.mutex.RLock()
+
+	argCopy := make([]*CoreMockWriteParams, len(mmWrite.callArgs))
+	copy(argCopy, mmWrite.callArgs)
+
+	mmWrite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteDone returns true if the count of the Write invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockWriteDone() bool {
+	if m.WriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteMock.invocationsDone()
+}
+
+// MinimockWriteInspect logs each unmet expectation
+func (m *CoreMock) MinimockWriteInspect() {
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Write at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteCounter := mm_atomic.LoadUint64(&m.afterWriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteMock.defaultExpectation != nil && afterWriteCounter < 1 {
+		if m.WriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Write at\n%s", m.WriteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Write at\n%s with params: %#v", m.WriteMock.defaultExpectation.expectationOrigins.origin, *m.WriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWrite != nil && afterWriteCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Write at\n%s", m.funcWriteOrigin)
+	}
+
+	if !m.WriteMock.invocationsDone() && afterWriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Write at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteMock.expectedInvocations), m.WriteMock.expectedInvocationsOrigin, afterWriteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockWriteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockWriteDone()
+}
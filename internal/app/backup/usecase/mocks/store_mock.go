@@ -0,0 +1,448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/backup/usecase.Store -o store_mock.go -n StoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// StoreMock implements mm_usecase.Store
+type StoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPut          func(ctx context.Context, key string, body io.ReadSeeker) (err error)
+	funcPutOrigin    string
+	inspectFuncPut   func(ctx context.Context, key string, body io.ReadSeeker)
+	afterPutCounter  uint64
+	beforePutCounter uint64
+	PutMock          mStoreMockPut
+}
+
+// NewStoreMock returns a mock for mm_usecase.Store
+func NewStoreMock(t minimock.Tester) *StoreMock {
+	m := &StoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PutMock = mStoreMockPut{mock: m}
+	m.PutMock.callArgs = []*StoreMockPutParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mStoreMockPut struct {
+	optional           bool
+	mock               *StoreMock
+	defaultExpectation *StoreMockPutExpectation
+	expectations       []*StoreMockPutExpectation
+
+	callArgs []*StoreMockPutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StoreMockPutExpectation specifies expectation struct of the Store.Put
+type StoreMockPutExpectation struct {
+	mock               *StoreMock
+	params             *StoreMockPutParams
+	paramPtrs          *StoreMockPutParamPtrs
+	expectationOrigins StoreMockPutExpectationOrigins
+	results            *StoreMockPutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StoreMockPutParams contains parameters of the Store.Put
+type StoreMockPutParams struct {
+	ctx  context.Context
+	key  string
+	body io.ReadSeeker
+}
+
+// StoreMockPutParamPtrs contains pointers to parameters of the Store.Put
+type StoreMockPutParamPtrs struct {
+	ctx  *context.Context
+	key  *string
+	body *io.ReadSeeker
+}
+
+// StoreMockPutResults contains results of the Store.Put
+type StoreMockPutResults struct {
+	err error
+}
+
+// StoreMockPutOrigins contains origins of expectations of the Store.Put
+type StoreMockPutExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKey  string
+	originBody string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPut *mStoreMockPut) Optional() *mStoreMockPut {
+	mmPut.optional = true
+	return mmPut
+}
+
+// Expect sets up expected params for Store.Put
+func (mmPut *mStoreMockPut) Expect(ctx context.Context, key string, body io.ReadSeeker) *mStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &StoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.paramPtrs != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by ExpectParams functions")
+	}
+
+	mmPut.defaultExpectation.params = &StoreMockPutParams{ctx, key, body}
+	mmPut.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPut.expectations {
+		if minimock.Equal(e.params, mmPut.defaultExpectation.params) {
+			mmPut.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPut.defaultExpectation.params)
+		}
+	}
+
+	return mmPut
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Store.Put
+func (mmPut *mStoreMockPut) ExpectCtxParam1(ctx context.Context) *mStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &StoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &StoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPut.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectKeyParam2 sets up expected param key for Store.Put
+func (mmPut *mStoreMockPut) ExpectKeyParam2(key string) *mStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &StoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &StoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.key = &key
+	mmPut.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectBodyParam3 sets up expected param body for Store.Put
+func (mmPut *mStoreMockPut) ExpectBodyParam3(body io.ReadSeeker) *mStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &StoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &StoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.body = &body
+	mmPut.defaultExpectation.expectationOrigins.originBody = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// Inspect accepts an inspector function that has same arguments as the Store.Put
+func (mmPut *mStoreMockPut) Inspect(f func(ctx context.Context, key string, body io.ReadSeeker)) *mStoreMockPut {
+	if mmPut.mock.inspectFuncPut != nil {
+		mmPut.mock.t.Fatalf("Inspect function is already set for StoreMock.Put")
+	}
+
+	mmPut.mock.inspectFuncPut = f
+
+	return mmPut
+}
+
+// Return sets up results that will be returned by Store.Put
+func (mmPut *mStoreMockPut) Return(err error) *StoreMock {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &StoreMockPutExpectation{mock: mmPut.mock}
+	}
+	mmPut.defaultExpectation.results = &StoreMockPutResults{err}
+	mmPut.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// Set uses given function f to mock the Store.Put method
+func (mmPut *mStoreMockPut) Set(f func(ctx context.Context, key string, body io.ReadSeeker) (err error)) *StoreMock {
+	if mmPut.defaultExpectation != nil {
+		mmPut.mock.t.Fatalf("Default expectation is already set for the Store.Put method")
+	}
+
+	if len(mmPut.expectations) > 0 {
+		mmPut.mock.t.Fatalf("Some expectations are already set for the Store.Put method")
+	}
+
+	mmPut.mock.funcPut = f
+	mmPut.mock.funcPutOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// When sets expectation for the Store.Put which will trigger the result defined by the following
+// Then helper
+func (mmPut *mStoreMockPut) When(ctx context.Context, key string, body io.ReadSeeker) *StoreMockPutExpectation {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("StoreMock.Put mock is already set by Set")
+	}
+
+	expectation := &StoreMockPutExpectation{
+		mock:               mmPut.mock,
+		params:             &StoreMockPutParams{ctx, key, body},
+		expectationOrigins: StoreMockPutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPut.expectations = append(mmPut.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Store.Put return parameters for the expectation previously defined by the When method
+func (e *StoreMockPutExpectation) Then(err error) *StoreMock {
+	e.results = &StoreMockPutResults{err}
+	return e.mock
+}
+
+// Times sets number of times Store.Put should be invoked
+func (mmPut *mStoreMockPut) Times(n uint64) *mStoreMockPut {
+	if n == 0 {
+		mmPut.mock.t.Fatalf("Times of StoreMock.Put mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPut.expectedInvocations, n)
+	mmPut.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPut
+}
+
+func (mmPut *mStoreMockPut) invocationsDone() bool {
+	if len(mmPut.expectations) == 0 && mmPut.defaultExpectation == nil && mmPut.mock.funcPut == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPut.mock.afterPutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPut.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Put implements mm_usecase.Store
+func (mmPut *StoreMock) Put(ctx context.Context, key string, body io.ReadSeeker) (err error) {
+	mm_atomic.AddUint64(&mmPut.beforePutCounter, 1)
+	defer mm_atomic.AddUint64(&mmPut.afterPutCounter, 1)
+
+	mmPut.t.Helper()
+
+	if mmPut.inspectFuncPut != nil {
+		mmPut.inspectFuncPut(ctx, key, body)
+	}
+
+	mm_params := StoreMockPutParams{ctx, key, body}
+
+	// Record call args
+	mmPut.PutMock.mutex.Lock()
+	mmPut.PutMock.callArgs = append(mmPut.PutMock.callArgs, &mm_params)
+	mmPut.PutMock.mutex.Unlock()
+
+	for _, e := range mmPut.PutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPut.PutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPut.PutMock.defaultExpectation.Counter, 1)
+		mm_want := mmPut.PutMock.defaultExpectation.params
+		mm_want_ptrs := mmPut.PutMock.defaultExpectation.paramPtrs
+
+		mm_got := StoreMockPutParams{ctx, key, body}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPut.t.Errorf("StoreMock.Put got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmPut.t.Errorf("StoreMock.Put got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.body != nil && !minimock.Equal(*mm_want_ptrs.body, mm_got.body) {
+				mmPut.t.Errorf("StoreMock.Put got unexpected parameter body, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originBody, *mm_want_ptrs.body, mm_got.body, minimock.Diff(*mm_want_ptrs.body, mm_got.body))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPut.t.Errorf("StoreMock.Put got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPut.PutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPut.PutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPut.t.Fatal("No results are set for the StoreMock.Put")
+		}
+		return (*mm_results).err
+	}
+	if mmPut.funcPut != nil {
+		return mmPut.funcPut(ctx, key, body)
+	}
+	mmPut.t.Fatalf("Unexpected call to StoreMock.Put. %v %v %v", ctx, key, body)
+	return
+}
+
+// PutAfterCounter returns a count of finished StoreMock.Put invocations
+func (mmPut *StoreMock) PutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.afterPutCounter)
+}
+
+// PutBeforeCounter returns a count of StoreMock.Put invocations
+func (mmPut *StoreMock) PutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.beforePutCounter)
+}
+
+// Calls returns a list of arguments used in each call to StoreMock.Put.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPut *mStoreMockPut) Calls() []*StoreMockPutParams {
+	mmPut.mutex.RLock()
+
+	argCopy := make([]*StoreMockPutParams, len(mmPut.callArgs))
+	copy(argCopy, mmPut.callArgs)
+
+	mmPut.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPutDone returns true if the count of the Put invocations corresponds
+// the number of defined expectations
+func (m *StoreMock) MinimockPutDone() bool {
+	if m.PutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PutMock.invocationsDone()
+}
+
+// MinimockPutInspect logs each unmet expectation
+func (m *StoreMock) MinimockPutInspect() {
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StoreMock.Put at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPutCounter := mm_atomic.LoadUint64(&m.afterPutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PutMock.defaultExpectation != nil && afterPutCounter < 1 {
+		if m.PutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StoreMock.Put at\n%s", m.PutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StoreMock.Put at\n%s with params: %#v", m.PutMock.defaultExpectation.expectationOrigins.origin, *m.PutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPut != nil && afterPutCounter < 1 {
+		m.t.Errorf("Expected call to StoreMock.Put at\n%s", m.funcPutOrigin)
+	}
+
+	if !m.PutMock.invocationsDone() && afterPutCounter > 0 {
+		m.t.Errorf("Expected %d calls to StoreMock.Put at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PutMock.expectedInvocations), m.PutMock.expectedInvocationsOrigin, afterPutCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPutInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPutDone()
+}
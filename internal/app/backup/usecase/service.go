@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type Core interface {
+	Write(ctx context.Context, w io.Writer) (backup.Manifest, error)
+}
+
+type Store interface {
+	Put(ctx context.Context, key string, body io.ReadSeeker) error
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// keyTimeFormat names the archives so that they sort by the time they were taken.
+const keyTimeFormat = "20060102T150405Z"
+
+// Service takes backups in the background, one at a time, and uploads them to the store.
+type Service struct {
+	core    Core
+	store   Store
+	timeGen TimeGenerator
+	prefix  string
+
+	mu     sync.Mutex
+	status backup.Status
+	wg     sync.WaitGroup
+}
+
+func NewService(core Core, store Store, timeGen TimeGenerator, cfg backup.Config) *Service {
+	if core == nil || store == nil || timeGen == nil {
+		panic("backup.NewService: nil dependency")
+	}
+	return &Service{core: core, store: store, timeGen: timeGen, prefix: cfg.Prefix}
+}
+
+// Start begins a backup and returns its status right away; Status reports how it ends. The backup
+// outlives the request that started it.
+func (s *Service) Start(ctx context.Context) (backup.Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status.State == backup.StateRunning {
+		err := backup.ErrInProgress()
+		logger.Error(ctx, err).Msg("backup.service.Start: backup already running")
+		return backup.Status{}, fmt.Errorf("backup.service.Start: %w", err)
+	}
+	now := s.timeGen.Now().UTC()
+	s.status = backup.Status{
+		State:     backup.StateRunning,
+		Key:       path.Join(s.prefix, now.Format(keyTimeFormat)+".tar.gz"),
+		StartedAt: &now,
+	}
+	logger.Audit(ctx, "backup.started").Str("key", s.status.Key).Msg("backup started")
+
+	s.wg.Add(1)
+	go func(ctx context.Context, key string) {
+		defer s.wg.Done()
+		manifest, err := s.create(ctx, key)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		finished := s.timeGen.Now().UTC()
+		s.status.FinishedAt = &finished
+		if err != nil {
+			logger.Error(ctx, err).Str("key", key).Msg("backup.service.Start: backup failed")
+			s.status.State, s.status.Error = backup.StateFailed, err.Error()
+			return
+		}
+		s.status.State, s.status.Tables = backup.StateSucceeded, manifest.Tables
+		logger.Audit(ctx, "backup.finished").Str("key", key).Msg("backup finished")
+	}(context.WithoutCancel(ctx), s.status.Key)
+
+	return s.status, nil
+}
+
+func (s *Service) Status() backup.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+// Wait blocks until the running backup, if any, has finished.
+func (s *Service) Wait() {
+	s.wg.Wait()
+}
+
+// create writes the archive to a temporary file, as the store needs to read it twice.
+func (s *Service) create(ctx context.Context, key string) (backup.Manifest, error) {
+	f, err := os.CreateTemp("", "easygodocs-backup-*.tar.gz")
+	if err != nil {
+		return backup.Manifest{}, fmt.Errorf("backup.service.create: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	manifest, err := s.core.Write(ctx, f)
+	if err != nil {
+		return backup.Manifest{}, fmt.Errorf("backup.service.create: %w", err)
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return backup.Manifest{}, fmt.Errorf("backup.service.create: %w", err)
+	}
+	if err = s.store.Put(ctx, key, f); err != nil {
+		return backup.Manifest{}, fmt.Errorf("backup.service.create: %w", err)
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/backup"
+	"github.com/66gu1/easygodocs/internal/app/backup/usecase"
+	"github.com/66gu1/easygodocs/internal/app/backup/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core    *mocks.CoreMock
+	store   *mocks.StoreMock
+	timeGen *mocks.TimeGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core:    mocks.NewCoreMock(t),
+		store:   mocks.NewStoreMock(t),
+		timeGen: mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+func (m mock) service() *usecase.Service {
+	return usecase.NewService(m.core, m.store, m.timeGen, backup.Config{Prefix: "backups"})
+}
+
+func TestService_Start(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		started  = time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+		finished = started.Add(time.Minute)
+		key      = "backups/20251001T120000Z.tar.gz"
+		tables   = []backup.Table{{Name: "users", Columns: []string{"id"}, Rows: 1}}
+		expErr   = fmt.Errorf("test error")
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.timeGen.NowMock.Set(func() time.Time { return started })
+		release := make(chan struct{})
+		m.core.WriteMock.Set(func(_ context.Context, w io.Writer) (backup.Manifest, error) {
+			<-release
+			_, err := io.WriteString(w, "archive")
+			return backup.Manifest{Tables: tables}, err
+		})
+		m.store.PutMock.Set(func(ctx context.Context, gotKey string, body io.ReadSeeker) error {
+			require.NoError(t, ctx.Err())
+			require.Equal(t, key, gotKey)
+			data, err := io.ReadAll(body)
+			require.NoError(t, err)
+			require.Equal(t, "archive", string(data))
+			return nil
+		})
+		svc := m.service()
+
+		// the backup goes on after the request that started it ends
+		reqCtx, cancel := context.WithCancel(ctx)
+		status, err := svc.Start(reqCtx)
+		cancel()
+		require.NoError(t, err)
+		require.Equal(t, backup.Status{State: backup.StateRunning, Key: key, StartedAt: &started}, status)
+
+		_, err = svc.Start(ctx)
+		require.Equal(t, backup.CodeInProgress, apperr.CodeOf(err))
+
+		m.timeGen.NowMock.Set(func() time.Time { return finished })
+		close(release)
+		svc.Wait()
+		require.Equal(t, backup.Status{
+			State: backup.StateSucceeded, Key: key, StartedAt: &started, FinishedAt: &finished, Tables: tables,
+		}, svc.Status())
+	})
+
+	t.Run("error/store", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.timeGen.NowMock.Return(started)
+		m.core.WriteMock.Return(backup.Manifest{Tables: tables}, nil)
+		m.store.PutMock.Return(expErr)
+		svc := m.service()
+
+		_, err := svc.Start(ctx)
+		require.NoError(t, err)
+		svc.Wait()
+		status := svc.Status()
+		require.Equal(t, backup.StateFailed, status.State)
+		require.Contains(t, status.Error, expErr.Error())
+
+		// a failed backup does not block the next one
+		m.store.PutMock.Return(nil)
+		_, err = svc.Start(ctx)
+		require.NoError(t, err)
+		svc.Wait()
+	})
+}
@@ -0,0 +1,349 @@
+package confluence
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Links maps link targets of page content. Page returns the entity a page file was imported as,
+// Attachment the blob key an attachment was stored under.
+type Links struct {
+	Page       func(file string) (uuid.UUID, bool)
+	Attachment func(path string) (string, bool)
+}
+
+// Conversion is page content in the storage format of entities: Markdown, with links to other entities
+// written as [[<entity_id>|label]]. Unresolved lists the page files of links Links.Page could not map;
+// their labels are kept as plain text.
+type Conversion struct {
+	Content    string
+	Unresolved []string
+	Warnings   []string
+}
+
+var (
+	whitespace   = regexp.MustCompile(`\s+`)
+	markdownChar = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "`", "\\`")
+	brushParam   = regexp.MustCompile(`brush:\s*([\w+#-]+)`)
+)
+
+// ToMarkdown converts the main content of a page. Elements without a Markdown form keep their text.
+func ToMarkdown(content *html.Node, links Links) Conversion {
+	c := &converter{links: links}
+	c.conv.Content = strings.Join(c.blocks(content), "\n\n")
+
+	return c.conv
+}
+
+type converter struct {
+	links Links
+	conv  Conversion
+}
+
+func (c *converter) warn(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if !slices.Contains(c.conv.Warnings, msg) {
+		c.conv.Warnings = append(c.conv.Warnings, msg)
+	}
+}
+
+// blocks renders the children of n as Markdown blocks. Runs of inline children form paragraphs.
+func (c *converter) blocks(n *html.Node) []string {
+	var (
+		out    []string
+		inline strings.Builder
+	)
+	flush := func() {
+		if p := strings.TrimSpace(inline.String()); p != "" {
+			out = append(out, p)
+		}
+		inline.Reset()
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if !isBlock(child) {
+			inline.WriteString(c.inline(child))
+			continue
+		}
+		flush()
+		out = append(out, c.block(child)...)
+	}
+	flush()
+
+	return out
+}
+
+func isBlock(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	switch n.DataAtom {
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Main, atom.Body,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+		atom.Ul, atom.Ol, atom.Pre, atom.Blockquote, atom.Table, atom.Hr,
+		atom.Script, atom.Style:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *converter) block(n *html.Node) []string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		if text := strings.TrimSpace(c.inlineChildren(n)); text != "" {
+			level := int(n.Data[1] - '0')
+			return []string{strings.Repeat("#", level) + " " + text}
+		}
+		return nil
+	case atom.Ul, atom.Ol:
+		if list := c.list(n); list != "" {
+			return []string{list}
+		}
+		return nil
+	case atom.Pre:
+		return []string{codeBlock(n, "")}
+	case atom.Blockquote:
+		return quote(c.blocks(n))
+	case atom.Table:
+		if table := c.table(n); table != "" {
+			return []string{table}
+		}
+		return nil
+	case atom.Hr:
+		return []string{"---"}
+	case atom.Script, atom.Style:
+		return nil
+	case atom.Div:
+		// code macros keep the language in the parameters of the syntax highlighter
+		if hasClass(n, "code") {
+			if pre := findElement(n, func(e *html.Node) bool { return e.DataAtom == atom.Pre }); pre != nil {
+				lang := ""
+				if m := brushParam.FindStringSubmatch(attr(pre, "data-syntaxhighlighter-params")); m != nil {
+					lang = m[1]
+				}
+				return []string{codeBlock(pre, lang)}
+			}
+		}
+		// info, note, tip and warning macros
+		if hasClass(n, "confluence-information-macro") {
+			return quote(c.blocks(n))
+		}
+	}
+
+	return c.blocks(n)
+}
+
+func codeBlock(pre *html.Node, lang string) string {
+	return "```" + lang + "\n" + strings.Trim(textContent(pre), "\n") + "\n```"
+}
+
+func quote(blocks []string) []string {
+	if len(blocks) == 0 {
+		return nil
+	}
+	lines := strings.Split(strings.Join(blocks, "\n\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+
+	return []string{strings.Join(lines, "\n")}
+}
+
+// list renders a list; the blocks of an item after the first, nested lists among them, are indented
+// under its marker.
+func (c *converter) list(n *html.Node) string {
+	var items []string
+	number := 1
+	if start, err := strconv.Atoi(attr(n, "start")); err == nil {
+		number = start
+	}
+	for li := n.FirstChild; li != nil; li = li.NextSibling {
+		if li.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		blocks := c.blocks(li)
+		if len(blocks) == 0 {
+			blocks = []string{""}
+		}
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(strings.Join(blocks, "\n"), "\n")
+		for i := range lines {
+			if i > 0 && lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// table renders a pipe table with the first row as header. Merged cells are split up.
+func (c *converter) table(n *html.Node) string {
+	var rows [][]string
+	walk(n, func(tr *html.Node) {
+		if tr.DataAtom != atom.Tr {
+			return
+		}
+		var row []string
+		for cell := tr.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.DataAtom != atom.Td && cell.DataAtom != atom.Th {
+				continue
+			}
+			if attr(cell, "colspan") != "" || attr(cell, "rowspan") != "" {
+				c.warn("merged table cells were split")
+			}
+			text := strings.Join(c.blocks(cell), "<br>")
+			row = append(row, strings.ReplaceAll(strings.ReplaceAll(text, "\n", "<br>"), "|", `\|`))
+		}
+		if len(row) > 0 {
+			rows = append(rows, row)
+		}
+	})
+	if len(rows) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", width))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (c *converter) inlineChildren(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(c.inline(child))
+	}
+
+	return b.String()
+}
+
+func (c *converter) inline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return markdownChar.Replace(whitespace.ReplaceAllString(n.Data, " "))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	if isBlock(n) {
+		return " " + strings.Join(c.block(n), " ") + " "
+	}
+	switch n.DataAtom {
+	case atom.Br:
+		return "  \n"
+	case atom.Strong, atom.B:
+		return wrap(c.inlineChildren(n), "**")
+	case atom.Em, atom.I:
+		return wrap(c.inlineChildren(n), "_")
+	case atom.S, atom.Del, atom.Strike:
+		return wrap(c.inlineChildren(n), "~~")
+	case atom.Code, atom.Tt, atom.Kbd:
+		if text := whitespace.ReplaceAllString(textContent(n), " "); strings.TrimSpace(text) != "" {
+			return "`" + text + "`"
+		}
+		return ""
+	case atom.A:
+		return c.link(n)
+	case atom.Img:
+		return c.image(n)
+	default:
+		return c.inlineChildren(n)
+	}
+}
+
+// wrap puts a marker around text, outside of its leading and trailing spaces, so that Markdown sees it.
+func wrap(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+func (c *converter) link(n *html.Node) string {
+	label := strings.TrimSpace(c.inlineChildren(n))
+	href := attr(n, "href")
+	switch {
+	case href == "" || strings.HasPrefix(href, "#"):
+		return label
+	case isPageFile(href):
+		file, _, _ := strings.Cut(href, "#")
+		file = path.Clean(file)
+		id, ok := c.links.Page(file)
+		if !ok {
+			if !slices.Contains(c.conv.Unresolved, file) {
+				c.conv.Unresolved = append(c.conv.Unresolved, file)
+			}
+			return label
+		}
+		if label == "" {
+			return "[[" + id.String() + "]]"
+		}
+		return "[[" + id.String() + "|" + label + "]]"
+	}
+	if p, ok := attachmentPath(href); ok {
+		key, ok := c.links.Attachment(p)
+		if !ok {
+			c.warn("link to attachment %s that was not stored", p)
+			return label
+		}
+		href = key
+	}
+	if label == "" {
+		label = markdownChar.Replace(href)
+	}
+
+	return "[" + label + "](" + href + ")"
+}
+
+func (c *converter) image(n *html.Node) string {
+	alt := markdownChar.Replace(attr(n, "alt"))
+	// emoticons are images of the Confluence server
+	if hasClass(n, "emoticon") {
+		return alt
+	}
+	src := attr(n, "src")
+	if p, ok := attachmentPath(src); ok {
+		key, ok := c.links.Attachment(p)
+		if !ok {
+			c.warn("image %s that was not stored", p)
+			return alt
+		}
+		src = key
+	} else if !strings.Contains(src, "://") {
+		c.warn("image %s outside of the attachments", src)
+		return alt
+	}
+
+	return "![" + alt + "](" + src + ")"
+}
@@ -0,0 +1,86 @@
+package confluence_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/confluence"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+func TestToMarkdown(t *testing.T) {
+	t.Parallel()
+
+	pageID := uuid.New()
+	links := confluence.Links{
+		Page: func(file string) (uuid.UUID, bool) {
+			return pageID, file == "known.html"
+		},
+		Attachment: func(path string) (string, bool) {
+			return "imports/x/" + path, path == "attachments/1/a.png"
+		},
+	}
+
+	tests := []struct {
+		name       string
+		html       string
+		want       string
+		unresolved []string
+		warnings   []string
+	}{
+		{
+			name: "headings_and_inline",
+			html: `<h1>Title</h1><p>Some <strong>bold</strong>, <em>italic </em>and <code>x*y</code> text_with*marks</p>`,
+			want: "# Title\n\nSome **bold**, _italic_ and `x*y` text\\_with\\*marks",
+		},
+		{
+			name: "lists",
+			html: `<ul><li>One<ul><li>Nested</li></ul></li><li>Two</li></ul><ol start="3"><li>Three</li></ol>`,
+			want: "- One\n  - Nested\n- Two\n\n3. Three",
+		},
+		{
+			name: "code_macro",
+			html: `<div class="code panel"><div class="codeContent"><pre data-syntaxhighlighter-params="brush: go; gutter: false">func main() {}
+</pre></div></div>`,
+			want: "```go\nfunc main() {}\n```",
+		},
+		{
+			name: "info_macro",
+			html: `<div class="confluence-information-macro"><div class="confluence-information-macro-body"><p>Careful</p><p>Really</p></div></div>`,
+			want: "> Careful\n>\n> Really",
+		},
+		{
+			name:     "table",
+			html:     `<table><tbody><tr><th>A</th><th>B</th></tr><tr><td colspan="2">a|b</td></tr></tbody></table>`,
+			want:     "| A | B |\n| --- | --- |\n| a\\|b |  |",
+			warnings: []string{"merged table cells were split"},
+		},
+		{
+			name:       "page_links",
+			html:       `<p><a href="known.html#section">Known</a> and <a href="unknown.html">Unknown</a> and <a href="https://example.com">site</a></p>`,
+			want:       "[[" + pageID.String() + "|Known]] and Unknown and [site](https://example.com)",
+			unresolved: []string{"unknown.html"},
+		},
+		{
+			name:     "attachments",
+			html:     `<p><img src="attachments/1/a.png?version=1" alt="diagram"><img class="emoticon" src="/images/smile.svg" alt="(smile)"><a href="attachments/1/b.pdf">b.pdf</a></p>`,
+			want:     "![diagram](imports/x/attachments/1/a.png)(smile)b.pdf",
+			warnings: []string{"link to attachment attachments/1/b.pdf that was not stored"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			doc, err := html.Parse(strings.NewReader(tt.html))
+			require.NoError(t, err)
+			body := doc.FirstChild.LastChild
+
+			got := confluence.ToMarkdown(body, links)
+			require.Equal(t, tt.want, got.Content)
+			require.Equal(t, tt.unresolved, got.Unresolved)
+			require.Equal(t, tt.warnings, got.Warnings)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package confluence
+
+import (
+	"io/fs"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/html"
+)
+
+// Space is a parsed Confluence space export. Files is the directory of the export, holding index.html,
+// the page files and the attachments.
+type Space struct {
+	Name  string
+	Pages []*Page
+	Files fs.FS
+}
+
+// Page is a page of the page tree of a space. File is the name of its HTML file in the export; Content
+// is the main content of the page, nil if the file is missing. Attachments are the paths of the files
+// the page links to under attachments/.
+type Page struct {
+	File        string
+	Title       string
+	Content     *html.Node
+	Attachments []string
+	Children    []*Page
+}
+
+type PageStatus string
+
+const (
+	PageImported PageStatus = "imported"
+	PageFailed   PageStatus = "failed"
+	// PageSkipped is the status of the descendants of a page that failed.
+	PageSkipped PageStatus = "skipped"
+)
+
+// AttachmentResult tells where an attachment was stored, or why it was not.
+type AttachmentResult struct {
+	Source string `json:"source"`
+	Key    string `json:"key,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// PageResult maps a page of the export to the entity it was imported as. Warnings list content that
+// could not be converted as is.
+type PageResult struct {
+	File        string             `json:"file"`
+	Title       string             `json:"title"`
+	ParentFile  string             `json:"parent_file,omitempty"`
+	Status      PageStatus         `json:"status"`
+	EntityID    *uuid.UUID         `json:"entity_id,omitempty"`
+	Attachments []AttachmentResult `json:"attachments,omitempty"`
+	Warnings    []string           `json:"warnings,omitempty"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// Report is the outcome of an import, with a result per page in page tree order. Attachments of the
+// import are stored under the blob key prefix imports/<import_id>/.
+type Report struct {
+	ImportID uuid.UUID    `json:"import_id"`
+	Space    string       `json:"space"`
+	Imported int          `json:"imported"`
+	Failed   int          `json:"failed"`
+	Skipped  int          `json:"skipped"`
+	Pages    []PageResult `json:"pages"`
+}
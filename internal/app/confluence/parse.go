@@ -0,0 +1,251 @@
+package confluence
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	indexFile = "index.html"
+	// AttachmentsDir is the directory of the export holding attachments, one subdirectory per page.
+	AttachmentsDir = "attachments"
+)
+
+var ErrNotAnExport = errors.New("no index.html with a page tree found")
+
+// ParseExport reads a Confluence space export in HTML format: index.html with the page tree, one file
+// per page and the attachments. The export may sit in a single top-level directory, as it does in the
+// zip file Confluence produces.
+func ParseExport(fsys fs.FS) (Space, error) {
+	root, err := exportRoot(fsys)
+	if err != nil {
+		return Space{}, fmt.Errorf("confluence.ParseExport: %w", err)
+	}
+	index, err := parseFile(root, indexFile)
+	if err != nil {
+		return Space{}, fmt.Errorf("confluence.ParseExport: %w", err)
+	}
+	tree := pageTree(index)
+	if tree == nil {
+		return Space{}, fmt.Errorf("confluence.ParseExport: %w", ErrNotAnExport)
+	}
+
+	space := Space{Name: spaceName(index), Files: root}
+	if space.Pages, err = parsePages(root, tree); err != nil {
+		return Space{}, fmt.Errorf("confluence.ParseExport: %w", err)
+	}
+
+	return space, nil
+}
+
+func exportRoot(fsys fs.FS) (fs.FS, error) {
+	if _, err := fs.Stat(fsys, indexFile); err == nil {
+		return fsys, nil
+	}
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	dirs := slices.DeleteFunc(entries, func(e fs.DirEntry) bool { return !e.IsDir() })
+	if len(dirs) != 1 {
+		return nil, ErrNotAnExport
+	}
+	root, err := fs.Sub(fsys, dirs[0].Name())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = fs.Stat(root, indexFile); err != nil {
+		return nil, ErrNotAnExport
+	}
+
+	return root, nil
+}
+
+func parseFile(fsys fs.FS, name string) (*html.Node, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := html.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	return doc, nil
+}
+
+// spaceName is the title of index.html, which Confluence sets to the name of the space.
+func spaceName(index *html.Node) string {
+	if title := findElement(index, func(n *html.Node) bool { return n.DataAtom == atom.Title }); title != nil {
+		return strings.TrimSpace(textContent(title))
+	}
+
+	return ""
+}
+
+// pageTree is the list following the "Available Pages" heading of index.html, or else the first list
+// linking to a page.
+func pageTree(index *html.Node) *html.Node {
+	heading := findElement(index, func(n *html.Node) bool {
+		return n.DataAtom == atom.H2 && strings.Contains(textContent(n), "Available Pages")
+	})
+	if heading != nil {
+		for n := heading.NextSibling; n != nil; n = n.NextSibling {
+			if n.DataAtom == atom.Ul {
+				return n
+			}
+		}
+	}
+
+	return findElement(index, func(n *html.Node) bool {
+		if n.DataAtom != atom.Ul {
+			return false
+		}
+		link := findElement(n, func(a *html.Node) bool { return a.DataAtom == atom.A })
+		return link != nil && isPageFile(attr(link, "href"))
+	})
+}
+
+// parsePages reads the pages of a list of the page tree. Nested lists hold the children of an item.
+func parsePages(fsys fs.FS, list *html.Node) ([]*Page, error) {
+	var pages []*Page
+	for li := list.FirstChild; li != nil; li = li.NextSibling {
+		if li.DataAtom != atom.Li {
+			continue
+		}
+		var (
+			page     *Page
+			children *html.Node
+		)
+		for n := li.FirstChild; n != nil; n = n.NextSibling {
+			switch {
+			case n.DataAtom == atom.A && page == nil && isPageFile(attr(n, "href")):
+				page = &Page{File: path.Clean(attr(n, "href")), Title: strings.TrimSpace(textContent(n))}
+			case n.DataAtom == atom.Ul:
+				children = n
+			}
+		}
+		if page == nil {
+			continue
+		}
+		if err := parsePage(fsys, page); err != nil {
+			return nil, err
+		}
+		if children != nil {
+			var err error
+			if page.Children, err = parsePages(fsys, children); err != nil {
+				return nil, err
+			}
+		}
+		pages = append(pages, page)
+	}
+
+	return pages, nil
+}
+
+// parsePage reads the main content of the page and the attachments linked from anywhere in its file,
+// including the attachments section below the content. A missing file leaves Content nil.
+func parsePage(fsys fs.FS, page *Page) error {
+	doc, err := parseFile(fsys, page.File)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	page.Content = findElement(doc, func(n *html.Node) bool { return attr(n, "id") == "main-content" })
+	if page.Content == nil {
+		page.Content = findElement(doc, func(n *html.Node) bool { return n.DataAtom == atom.Body })
+	}
+	walk(doc, func(n *html.Node) {
+		for _, key := range []string{"href", "src"} {
+			if p, ok := attachmentPath(attr(n, key)); ok && !slices.Contains(page.Attachments, p) {
+				page.Attachments = append(page.Attachments, p)
+			}
+		}
+	})
+
+	return nil
+}
+
+// isPageFile tells whether href points to a page of the export rather than to an attachment or a site.
+func isPageFile(href string) bool {
+	file, _, _ := strings.Cut(href, "#")
+	return strings.HasSuffix(file, ".html") && !strings.Contains(file, ":") && !strings.HasPrefix(file, "/")
+}
+
+// attachmentPath is the path of the attachment href points to, without the query Confluence appends.
+func attachmentPath(href string) (string, bool) {
+	href, _, _ = strings.Cut(href, "?")
+	if !strings.HasPrefix(href, AttachmentsDir+"/") {
+		return "", false
+	}
+	p := path.Clean(href)
+	if !fs.ValidPath(p) {
+		return "", false
+	}
+
+	return p, true
+}
+
+func findElement(n *html.Node, match func(*html.Node) bool) *html.Node {
+	if n.Type == html.ElementNode && match(n) {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, match); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func walk(n *html.Node, fn func(*html.Node)) {
+	if n.Type == html.ElementNode {
+		fn(n)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	return slices.Contains(strings.Fields(attr(n, "class")), class)
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(n)
+
+	return b.String()
+}
@@ -0,0 +1,70 @@
+package confluence_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/66gu1/easygodocs/internal/app/confluence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExport(t *testing.T) {
+	t.Parallel()
+
+	export := fstest.MapFS{
+		"index.html": {Data: []byte(`<html><head><title> Docs </title></head><body>
+			<ul><li><a href="https://example.com/other.html">Elsewhere</a></li></ul>
+			<h2>Available Pages:</h2>
+			<ul>
+				<li><a href="Home_1.html">Home</a>
+					<ul>
+						<li><a href="./Child_2.html">Child</a></li>
+						<li><a href="Gone_3.html">Gone</a></li>
+					</ul>
+				</li>
+			</ul>
+		</body></html>`)},
+		"Home_1.html": {Data: []byte(`<html><body>
+			<div id="header">Breadcrumbs</div>
+			<div id="main-content"><p>Home <img src="attachments/1/a.png?version=2&api=v2"></p></div>
+			<div class="pageSection"><a href="attachments/1/a.png">a.png</a><a href="attachments/1/b.pdf">b.pdf</a></div>
+		</body></html>`)},
+		"Child_2.html": {Data: []byte(`<html><body><p>No main content</p></body></html>`)},
+	}
+
+	space, err := confluence.ParseExport(export)
+	require.NoError(t, err)
+	require.Equal(t, "Docs", space.Name)
+	require.Len(t, space.Pages, 1)
+
+	home := space.Pages[0]
+	require.Equal(t, "Home_1.html", home.File)
+	require.Equal(t, "Home", home.Title)
+	require.NotNil(t, home.Content)
+	require.Equal(t, []string{"attachments/1/a.png", "attachments/1/b.pdf"}, home.Attachments)
+	require.Len(t, home.Children, 2)
+
+	child, gone := home.Children[0], home.Children[1]
+	require.Equal(t, "Child_2.html", child.File)
+	require.NotNil(t, child.Content)
+	require.Equal(t, "Gone_3.html", gone.File)
+	require.Nil(t, gone.Content)
+}
+
+func TestParseExport_TopLevelDir(t *testing.T) {
+	t.Parallel()
+
+	space, err := confluence.ParseExport(fstest.MapFS{
+		"DOCS/index.html": {Data: []byte(`<ul><li><a href="a.html">A</a></li></ul>`)},
+		"DOCS/a.html":     {Data: []byte(`<p>A</p>`)},
+	})
+	require.NoError(t, err)
+	require.Len(t, space.Pages, 1)
+	require.NotNil(t, space.Pages[0].Content)
+
+	_, err = confluence.ParseExport(fstest.MapFS{"a/index.html": {}, "b/index.html": {}})
+	require.ErrorIs(t, err, confluence.ErrNotAnExport)
+
+	_, err = confluence.ParseExport(fstest.MapFS{"index.html": {Data: []byte(`<p>No pages</p>`)}})
+	require.ErrorIs(t, err, confluence.ErrNotAnExport)
+}
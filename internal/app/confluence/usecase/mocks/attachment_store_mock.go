@@ -0,0 +1,804 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/confluence/usecase.AttachmentStore -o attachment_store_mock.go -n AttachmentStoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// AttachmentStoreMock implements mm_usecase.AttachmentStore
+type AttachmentStoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSetEntity          func(ctx context.Context, keys []string, entityID uuid.UUID) (err error)
+	funcSetEntityOrigin    string
+	inspectFuncSetEntity   func(ctx context.Context, keys []string, entityID uuid.UUID)
+	afterSetEntityCounter  uint64
+	beforeSetEntityCounter uint64
+	SetEntityMock          mAttachmentStoreMockSetEntity
+
+	funcStore          func(ctx context.Context, req attachment.StoreReq) (err error)
+	funcStoreOrigin    string
+	inspectFuncStore   func(ctx context.Context, req attachment.StoreReq)
+	afterStoreCounter  uint64
+	beforeStoreCounter uint64
+	StoreMock          mAttachmentStoreMockStore
+}
+
+// NewAttachmentStoreMock returns a mock for mm_usecase.AttachmentStore
+func NewAttachmentStoreMock(t minimock.Tester) *AttachmentStoreMock {
+	m := &AttachmentStoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SetEntityMock = mAttachmentStoreMockSetEntity{mock: m}
+	m.SetEntityMock.callArgs = []*AttachmentStoreMockSetEntityParams{}
+
+	m.StoreMock = mAttachmentStoreMockStore{mock: m}
+	m.StoreMock.callArgs = []*AttachmentStoreMockStoreParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAttachmentStoreMockSetEntity struct {
+	optional           bool
+	mock               *AttachmentStoreMock
+	defaultExpectation *AttachmentStoreMockSetEntityExpectation
+	expectations       []*AttachmentStoreMockSetEntityExpectation
+
+	callArgs []*AttachmentStoreMockSetEntityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentStoreMockSetEntityExpectation specifies expectation struct of the AttachmentStore.SetEntity
+type AttachmentStoreMockSetEntityExpectation struct {
+	mock               *AttachmentStoreMock
+	params             *AttachmentStoreMockSetEntityParams
+	paramPtrs          *AttachmentStoreMockSetEntityParamPtrs
+	expectationOrigins AttachmentStoreMockSetEntityExpectationOrigins
+	results            *AttachmentStoreMockSetEntityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentStoreMockSetEntityParams contains parameters of the AttachmentStore.SetEntity
+type AttachmentStoreMockSetEntityParams struct {
+	ctx      context.Context
+	keys     []string
+	entityID uuid.UUID
+}
+
+// AttachmentStoreMockSetEntityParamPtrs contains pointers to parameters of the AttachmentStore.SetEntity
+type AttachmentStoreMockSetEntityParamPtrs struct {
+	ctx      *context.Context
+	keys     *[]string
+	entityID *uuid.UUID
+}
+
+// AttachmentStoreMockSetEntityResults contains results of the AttachmentStore.SetEntity
+type AttachmentStoreMockSetEntityResults struct {
+	err error
+}
+
+// AttachmentStoreMockSetEntityOrigins contains origins of expectations of the AttachmentStore.SetEntity
+type AttachmentStoreMockSetEntityExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originKeys     string
+	originEntityID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Optional() *mAttachmentStoreMockSetEntity {
+	mmSetEntity.optional = true
+	return mmSetEntity
+}
+
+// Expect sets up expected params for AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Expect(ctx context.Context, keys []string, entityID uuid.UUID) *mAttachmentStoreMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &AttachmentStoreMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by ExpectParams functions")
+	}
+
+	mmSetEntity.defaultExpectation.params = &AttachmentStoreMockSetEntityParams{ctx, keys, entityID}
+	mmSetEntity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetEntity.expectations {
+		if minimock.Equal(e.params, mmSetEntity.defaultExpectation.params) {
+			mmSetEntity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetEntity.defaultExpectation.params)
+		}
+	}
+
+	return mmSetEntity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) ExpectCtxParam1(ctx context.Context) *mAttachmentStoreMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &AttachmentStoreMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &AttachmentStoreMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetEntity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// ExpectKeysParam2 sets up expected param keys for AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) ExpectKeysParam2(keys []string) *mAttachmentStoreMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &AttachmentStoreMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &AttachmentStoreMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.keys = &keys
+	mmSetEntity.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// ExpectEntityIDParam3 sets up expected param entityID for AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) ExpectEntityIDParam3(entityID uuid.UUID) *mAttachmentStoreMockSetEntity {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &AttachmentStoreMockSetEntityExpectation{}
+	}
+
+	if mmSetEntity.defaultExpectation.params != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Expect")
+	}
+
+	if mmSetEntity.defaultExpectation.paramPtrs == nil {
+		mmSetEntity.defaultExpectation.paramPtrs = &AttachmentStoreMockSetEntityParamPtrs{}
+	}
+	mmSetEntity.defaultExpectation.paramPtrs.entityID = &entityID
+	mmSetEntity.defaultExpectation.expectationOrigins.originEntityID = minimock.CallerInfo(1)
+
+	return mmSetEntity
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Inspect(f func(ctx context.Context, keys []string, entityID uuid.UUID)) *mAttachmentStoreMockSetEntity {
+	if mmSetEntity.mock.inspectFuncSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("Inspect function is already set for AttachmentStoreMock.SetEntity")
+	}
+
+	mmSetEntity.mock.inspectFuncSetEntity = f
+
+	return mmSetEntity
+}
+
+// Return sets up results that will be returned by AttachmentStore.SetEntity
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Return(err error) *AttachmentStoreMock {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	if mmSetEntity.defaultExpectation == nil {
+		mmSetEntity.defaultExpectation = &AttachmentStoreMockSetEntityExpectation{mock: mmSetEntity.mock}
+	}
+	mmSetEntity.defaultExpectation.results = &AttachmentStoreMockSetEntityResults{err}
+	mmSetEntity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetEntity.mock
+}
+
+// Set uses given function f to mock the AttachmentStore.SetEntity method
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Set(f func(ctx context.Context, keys []string, entityID uuid.UUID) (err error)) *AttachmentStoreMock {
+	if mmSetEntity.defaultExpectation != nil {
+		mmSetEntity.mock.t.Fatalf("Default expectation is already set for the AttachmentStore.SetEntity method")
+	}
+
+	if len(mmSetEntity.expectations) > 0 {
+		mmSetEntity.mock.t.Fatalf("Some expectations are already set for the AttachmentStore.SetEntity method")
+	}
+
+	mmSetEntity.mock.funcSetEntity = f
+	mmSetEntity.mock.funcSetEntityOrigin = minimock.CallerInfo(1)
+	return mmSetEntity.mock
+}
+
+// When sets expectation for the AttachmentStore.SetEntity which will trigger the result defined by the following
+// Then helper
+func (mmSetEntity *mAttachmentStoreMockSetEntity) When(ctx context.Context, keys []string, entityID uuid.UUID) *AttachmentStoreMockSetEntityExpectation {
+	if mmSetEntity.mock.funcSetEntity != nil {
+		mmSetEntity.mock.t.Fatalf("AttachmentStoreMock.SetEntity mock is already set by Set")
+	}
+
+	expectation := &AttachmentStoreMockSetEntityExpectation{
+		mock:               mmSetEntity.mock,
+		params:             &AttachmentStoreMockSetEntityParams{ctx, keys, entityID},
+		expectationOrigins: AttachmentStoreMockSetEntityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetEntity.expectations = append(mmSetEntity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentStore.SetEntity return parameters for the expectation previously defined by the When method
+func (e *AttachmentStoreMockSetEntityExpectation) Then(err error) *AttachmentStoreMock {
+	e.results = &AttachmentStoreMockSetEntityResults{err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentStore.SetEntity should be invoked
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Times(n uint64) *mAttachmentStoreMockSetEntity {
+	if n == 0 {
+		mmSetEntity.mock.t.Fatalf("Times of AttachmentStoreMock.SetEntity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetEntity.expectedInvocations, n)
+	mmSetEntity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetEntity
+}
+
+func (mmSetEntity *mAttachmentStoreMockSetEntity) invocationsDone() bool {
+	if len(mmSetEntity.expectations) == 0 && mmSetEntity.defaultExpectation == nil && mmSetEntity.mock.funcSetEntity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetEntity.mock.afterSetEntityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetEntity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetEntity implements mm_usecase.AttachmentStore
+func (mmSetEntity *AttachmentStoreMock) SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmSetEntity.beforeSetEntityCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetEntity.afterSetEntityCounter, 1)
+
+	mmSetEntity.t.Helper()
+
+	if mmSetEntity.inspectFuncSetEntity != nil {
+		mmSetEntity.inspectFuncSetEntity(ctx, keys, entityID)
+	}
+
+	mm_params := AttachmentStoreMockSetEntityParams{ctx, keys, entityID}
+
+	// Record call args
+	mmSetEntity.SetEntityMock.mutex.Lock()
+	mmSetEntity.SetEntityMock.callArgs = append(mmSetEntity.SetEntityMock.callArgs, &mm_params)
+	mmSetEntity.SetEntityMock.mutex.Unlock()
+
+	for _, e := range mmSetEntity.SetEntityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetEntity.SetEntityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetEntity.SetEntityMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetEntity.SetEntityMock.defaultExpectation.params
+		mm_want_ptrs := mmSetEntity.SetEntityMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentStoreMockSetEntityParams{ctx, keys, entityID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetEntity.t.Errorf("AttachmentStoreMock.SetEntity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmSetEntity.t.Errorf("AttachmentStoreMock.SetEntity got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+			if mm_want_ptrs.entityID != nil && !minimock.Equal(*mm_want_ptrs.entityID, mm_got.entityID) {
+				mmSetEntity.t.Errorf("AttachmentStoreMock.SetEntity got unexpected parameter entityID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.originEntityID, *mm_want_ptrs.entityID, mm_got.entityID, minimock.Diff(*mm_want_ptrs.entityID, mm_got.entityID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetEntity.t.Errorf("AttachmentStoreMock.SetEntity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetEntity.SetEntityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetEntity.SetEntityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetEntity.t.Fatal("No results are set for the AttachmentStoreMock.SetEntity")
+		}
+		return (*mm_results).err
+	}
+	if mmSetEntity.funcSetEntity != nil {
+		return mmSetEntity.funcSetEntity(ctx, keys, entityID)
+	}
+	mmSetEntity.t.Fatalf("Unexpected call to AttachmentStoreMock.SetEntity. %v %v %v", ctx, keys, entityID)
+	return
+}
+
+// SetEntityAfterCounter returns a count of finished AttachmentStoreMock.SetEntity invocations
+func (mmSetEntity *AttachmentStoreMock) SetEntityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEntity.afterSetEntityCounter)
+}
+
+// SetEntityBeforeCounter returns a count of AttachmentStoreMock.SetEntity invocations
+func (mmSetEntity *AttachmentStoreMock) SetEntityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetEntity.beforeSetEntityCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentStoreMock.SetEntity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetEntity *mAttachmentStoreMockSetEntity) Calls() []*AttachmentStoreMockSetEntityParams {
+	mmSetEntity.mutex.RLock()
+
+	argCopy := make([]*AttachmentStoreMockSetEntityParams, len(mmSetEntity.callArgs))
+	copy(argCopy, mmSetEntity.callArgs)
+
+	mmSetEntity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetEntityDone returns true if the count of the SetEntity invocations corresponds
+// the number of defined expectations
+func (m *AttachmentStoreMock) MinimockSetEntityDone() bool {
+	if m.SetEntityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetEntityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetEntityMock.invocationsDone()
+}
+
+// MinimockSetEntityInspect logs each unmet expectation
+func (m *AttachmentStoreMock) MinimockSetEntityInspect() {
+	for _, e := range m.SetEntityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentStoreMock.SetEntity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetEntityCounter := mm_atomic.LoadUint64(&m.afterSetEntityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetEntityMock.defaultExpectation != nil && afterSetEntityCounter < 1 {
+		if m.SetEntityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentStoreMock.SetEntity at\n%s", m.SetEntityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentStoreMock.SetEntity at\n%s with params: %#v", m.SetEntityMock.defaultExpectation.expectationOrigins.origin, *m.SetEntityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetEntity != nil && afterSetEntityCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentStoreMock.SetEntity at\n%s", m.funcSetEntityOrigin)
+	}
+
+	if !m.SetEntityMock.invocationsDone() && afterSetEntityCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentStoreMock.SetEntity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetEntityMock.expectedInvocations), m.SetEntityMock.expectedInvocationsOrigin, afterSetEntityCounter)
+	}
+}
+
+type mAttachmentStoreMockStore struct {
+	optional           bool
+	mock               *AttachmentStoreMock
+	defaultExpectation *AttachmentStoreMockStoreExpectation
+	expectations       []*AttachmentStoreMockStoreExpectation
+
+	callArgs []*AttachmentStoreMockStoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentStoreMockStoreExpectation specifies expectation struct of the AttachmentStore.Store
+type AttachmentStoreMockStoreExpectation struct {
+	mock               *AttachmentStoreMock
+	params             *AttachmentStoreMockStoreParams
+	paramPtrs          *AttachmentStoreMockStoreParamPtrs
+	expectationOrigins AttachmentStoreMockStoreExpectationOrigins
+	results            *AttachmentStoreMockStoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentStoreMockStoreParams contains parameters of the AttachmentStore.Store
+type AttachmentStoreMockStoreParams struct {
+	ctx context.Context
+	req attachment.StoreReq
+}
+
+// AttachmentStoreMockStoreParamPtrs contains pointers to parameters of the AttachmentStore.Store
+type AttachmentStoreMockStoreParamPtrs struct {
+	ctx *context.Context
+	req *attachment.StoreReq
+}
+
+// AttachmentStoreMockStoreResults contains results of the AttachmentStore.Store
+type AttachmentStoreMockStoreResults struct {
+	err error
+}
+
+// AttachmentStoreMockStoreOrigins contains origins of expectations of the AttachmentStore.Store
+type AttachmentStoreMockStoreExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStore *mAttachmentStoreMockStore) Optional() *mAttachmentStoreMockStore {
+	mmStore.optional = true
+	return mmStore
+}
+
+// Expect sets up expected params for AttachmentStore.Store
+func (mmStore *mAttachmentStoreMockStore) Expect(ctx context.Context, req attachment.StoreReq) *mAttachmentStoreMockStore {
+	if mmStore.mock.funcStore != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Set")
+	}
+
+	if mmStore.defaultExpectation == nil {
+		mmStore.defaultExpectation = &AttachmentStoreMockStoreExpectation{}
+	}
+
+	if mmStore.defaultExpectation.paramPtrs != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by ExpectParams functions")
+	}
+
+	mmStore.defaultExpectation.params = &AttachmentStoreMockStoreParams{ctx, req}
+	mmStore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStore.expectations {
+		if minimock.Equal(e.params, mmStore.defaultExpectation.params) {
+			mmStore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStore.defaultExpectation.params)
+		}
+	}
+
+	return mmStore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentStore.Store
+func (mmStore *mAttachmentStoreMockStore) ExpectCtxParam1(ctx context.Context) *mAttachmentStoreMockStore {
+	if mmStore.mock.funcStore != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Set")
+	}
+
+	if mmStore.defaultExpectation == nil {
+		mmStore.defaultExpectation = &AttachmentStoreMockStoreExpectation{}
+	}
+
+	if mmStore.defaultExpectation.params != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Expect")
+	}
+
+	if mmStore.defaultExpectation.paramPtrs == nil {
+		mmStore.defaultExpectation.paramPtrs = &AttachmentStoreMockStoreParamPtrs{}
+	}
+	mmStore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStore
+}
+
+// ExpectReqParam2 sets up expected param req for AttachmentStore.Store
+func (mmStore *mAttachmentStoreMockStore) ExpectReqParam2(req attachment.StoreReq) *mAttachmentStoreMockStore {
+	if mmStore.mock.funcStore != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Set")
+	}
+
+	if mmStore.defaultExpectation == nil {
+		mmStore.defaultExpectation = &AttachmentStoreMockStoreExpectation{}
+	}
+
+	if mmStore.defaultExpectation.params != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Expect")
+	}
+
+	if mmStore.defaultExpectation.paramPtrs == nil {
+		mmStore.defaultExpectation.paramPtrs = &AttachmentStoreMockStoreParamPtrs{}
+	}
+	mmStore.defaultExpectation.paramPtrs.req = &req
+	mmStore.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmStore
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentStore.Store
+func (mmStore *mAttachmentStoreMockStore) Inspect(f func(ctx context.Context, req attachment.StoreReq)) *mAttachmentStoreMockStore {
+	if mmStore.mock.inspectFuncStore != nil {
+		mmStore.mock.t.Fatalf("Inspect function is already set for AttachmentStoreMock.Store")
+	}
+
+	mmStore.mock.inspectFuncStore = f
+
+	return mmStore
+}
+
+// Return sets up results that will be returned by AttachmentStore.Store
+func (mmStore *mAttachmentStoreMockStore) Return(err error) *AttachmentStoreMock {
+	if mmStore.mock.funcStore != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Set")
+	}
+
+	if mmStore.defaultExpectation == nil {
+		mmStore.defaultExpectation = &AttachmentStoreMockStoreExpectation{mock: mmStore.mock}
+	}
+	mmStore.defaultExpectation.results = &AttachmentStoreMockStoreResults{err}
+	mmStore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStore.mock
+}
+
+// Set uses given function f to mock the AttachmentStore.Store method
+func (mmStore *mAttachmentStoreMockStore) Set(f func(ctx context.Context, req attachment.StoreReq) (err error)) *AttachmentStoreMock {
+	if mmStore.defaultExpectation != nil {
+		mmStore.mock.t.Fatalf("Default expectation is already set for the AttachmentStore.Store method")
+	}
+
+	if len(mmStore.expectations) > 0 {
+		mmStore.mock.t.Fatalf("Some expectations are already set for the AttachmentStore.Store method")
+	}
+
+	mmStore.mock.funcStore = f
+	mmStore.mock.funcStoreOrigin = minimock.CallerInfo(1)
+	return mmStore.mock
+}
+
+// When sets expectation for the AttachmentStore.Store which will trigger the result defined by the following
+// Then helper
+func (mmStore *mAttachmentStoreMockStore) When(ctx context.Context, req attachment.StoreReq) *AttachmentStoreMockStoreExpectation {
+	if mmStore.mock.funcStore != nil {
+		mmStore.mock.t.Fatalf("AttachmentStoreMock.Store mock is already set by Set")
+	}
+
+	expectation := &AttachmentStoreMockStoreExpectation{
+		mock:               mmStore.mock,
+		params:             &AttachmentStoreMockStoreParams{ctx, req},
+		expectationOrigins: AttachmentStoreMockStoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStore.expectations = append(mmStore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentStore.Store return parameters for the expectation previously defined by the When method
+func (e *AttachmentStoreMockStoreExpectation) Then(err error) *AttachmentStoreMock {
+	e.results = &AttachmentStoreMockStoreResults{err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentStore.Store should be invoked
+func (mmStore *mAttachmentStoreMockStore) Times(n uint64) *mAttachmentStoreMockStore {
+	if n == 0 {
+		mmStore.mock.t.Fatalf("Times of AttachmentStoreMock.Store mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStore.expectedInvocations, n)
+	mmStore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStore
+}
+
+func (mmStore *mAttachmentStoreMockStore) invocationsDone() bool {
+	if len(mmStore.expectations) == 0 && mmStore.defaultExpectation == nil && mmStore.mock.funcStore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStore.mock.afterStoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Store implements mm_usecase.AttachmentStore
+func (mmStore *AttachmentStoreMock) Store(ctx context.Context, req attachment.StoreReq) (err error) {
+	mm_atomic.AddUint64(&mmStore.beforeStoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmStore.afterStoreCounter, 1)
+
+	mmStore.t.Helper()
+
+	if mmStore.inspectFuncStore != nil {
+		mmStore.inspectFuncStore(ctx, req)
+	}
+
+	mm_params := AttachmentStoreMockStoreParams{ctx, req}
+
+	// Record call args
+	mmStore.StoreMock.mutex.Lock()
+	mmStore.StoreMock.callArgs = append(mmStore.StoreMock.callArgs, &mm_params)
+	mmStore.StoreMock.mutex.Unlock()
+
+	for _, e := range mmStore.StoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStore.StoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStore.StoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmStore.StoreMock.defaultExpectation.params
+		mm_want_ptrs := mmStore.StoreMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentStoreMockStoreParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStore.t.Errorf("AttachmentStoreMock.Store got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStore.StoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmStore.t.Errorf("AttachmentStoreMock.Store got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStore.StoreMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStore.t.Errorf("AttachmentStoreMock.Store got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStore.StoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStore.StoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStore.t.Fatal("No results are set for the AttachmentStoreMock.Store")
+		}
+		return (*mm_results).err
+	}
+	if mmStore.funcStore != nil {
+		return mmStore.funcStore(ctx, req)
+	}
+	mmStore.t.Fatalf("Unexpected call to AttachmentStoreMock.Store. %v %v", ctx, req)
+	return
+}
+
+// StoreAfterCounter returns a count of finished AttachmentStoreMock.Store invocations
+func (mmStore *AttachmentStoreMock) StoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStore.afterStoreCounter)
+}
+
+// StoreBeforeCounter returns a count of AttachmentStoreMock.Store invocations
+func (mmStore *AttachmentStoreMock) StoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStore.beforeStoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentStoreMock.Store.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStore *mAttachmentStoreMockStore) Calls() []*AttachmentStoreMockStoreParams {
+	mmStore.mutex.RLock()
+
+	argCopy := make([]*AttachmentStoreMockStoreParams, len(mmStore.callArgs))
+	copy(argCopy, mmStore.callArgs)
+
+	mmStore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStoreDone returns true if the count of the Store invocations corresponds
+// the number of defined expectations
+func (m *AttachmentStoreMock) MinimockStoreDone() bool {
+	if m.StoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StoreMock.invocationsDone()
+}
+
+// MinimockStoreInspect logs each unmet expectation
+func (m *AttachmentStoreMock) MinimockStoreInspect() {
+	for _, e := range m.StoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentStoreMock.Store at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStoreCounter := mm_atomic.LoadUint64(&m.afterStoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StoreMock.defaultExpectation != nil && afterStoreCounter < 1 {
+		if m.StoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentStoreMock.Store at\n%s", m.StoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentStoreMock.Store at\n%s with params: %#v", m.StoreMock.defaultExpectation.expectationOrigins.origin, *m.StoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStore != nil && afterStoreCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentStoreMock.Store at\n%s", m.funcStoreOrigin)
+	}
+
+	if !m.StoreMock.invocationsDone() && afterStoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentStoreMock.Store at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StoreMock.expectedInvocations), m.StoreMock.expectedInvocationsOrigin, afterStoreCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AttachmentStoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSetEntityInspect()
+
+			m.MinimockStoreInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AttachmentStoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AttachmentStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSetEntityDone() &&
+		m.MinimockStoreDone()
+}
@@ -0,0 +1,776 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/confluence/usecase.EntityCore -o entity_core_mock.go -n EntityCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// EntityCoreMock implements mm_usecase.EntityCore
+type EntityCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, req entity.CreateEntityReq)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mEntityCoreMockCreate
+
+	funcUpdate          func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, req entity.UpdateEntityReq)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mEntityCoreMockUpdate
+}
+
+// NewEntityCoreMock returns a mock for mm_usecase.EntityCore
+func NewEntityCoreMock(t minimock.Tester) *EntityCoreMock {
+	m := &EntityCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mEntityCoreMockCreate{mock: m}
+	m.CreateMock.callArgs = []*EntityCoreMockCreateParams{}
+
+	m.UpdateMock = mEntityCoreMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*EntityCoreMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEntityCoreMockCreate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockCreateExpectation
+	expectations       []*EntityCoreMockCreateExpectation
+
+	callArgs []*EntityCoreMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockCreateExpectation specifies expectation struct of the EntityCore.Create
+type EntityCoreMockCreateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockCreateParams
+	paramPtrs          *EntityCoreMockCreateParamPtrs
+	expectationOrigins EntityCoreMockCreateExpectationOrigins
+	results            *EntityCoreMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockCreateParams contains parameters of the EntityCore.Create
+type EntityCoreMockCreateParams struct {
+	ctx context.Context
+	req entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateParamPtrs contains pointers to parameters of the EntityCore.Create
+type EntityCoreMockCreateParamPtrs struct {
+	ctx *context.Context
+	req *entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateResults contains results of the EntityCore.Create
+type EntityCoreMockCreateResults struct {
+	u1  uuid.UUID
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockCreateOrigins contains origins of expectations of the EntityCore.Create
+type EntityCoreMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mEntityCoreMockCreate) Optional() *mEntityCoreMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Expect(ctx context.Context, req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &EntityCoreMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectReqParam2(req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Inspect(f func(ctx context.Context, req entity.CreateEntityReq)) *mEntityCoreMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Return(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &EntityCoreMockCreateResults{u1, c2, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the EntityCore.Create method
+func (mmCreate *mEntityCoreMockCreate) Set(f func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the EntityCore.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the EntityCore.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the EntityCore.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mEntityCoreMockCreate) When(ctx context.Context, req entity.CreateEntityReq) *EntityCoreMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &EntityCoreMockCreateParams{ctx, req},
+		expectationOrigins: EntityCoreMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Create return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockCreateExpectation) Then(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockCreateResults{u1, c2, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Create should be invoked
+func (mmCreate *mEntityCoreMockCreate) Times(n uint64) *mEntityCoreMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of EntityCoreMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mEntityCoreMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.EntityCore
+func (mmCreate *EntityCoreMock) Create(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the EntityCoreMock.Create")
+		}
+		return (*mm_results).u1, (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to EntityCoreMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mEntityCoreMockCreate) Calls() []*EntityCoreMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mEntityCoreMockUpdate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockUpdateExpectation
+	expectations       []*EntityCoreMockUpdateExpectation
+
+	callArgs []*EntityCoreMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockUpdateExpectation specifies expectation struct of the EntityCore.Update
+type EntityCoreMockUpdateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockUpdateParams
+	paramPtrs          *EntityCoreMockUpdateParamPtrs
+	expectationOrigins EntityCoreMockUpdateExpectationOrigins
+	results            *EntityCoreMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockUpdateParams contains parameters of the EntityCore.Update
+type EntityCoreMockUpdateParams struct {
+	ctx context.Context
+	req entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateParamPtrs contains pointers to parameters of the EntityCore.Update
+type EntityCoreMockUpdateParamPtrs struct {
+	ctx *context.Context
+	req *entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateResults contains results of the EntityCore.Update
+type EntityCoreMockUpdateResults struct {
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockUpdateOrigins contains origins of expectations of the EntityCore.Update
+type EntityCoreMockUpdateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mEntityCoreMockUpdate) Optional() *mEntityCoreMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Expect(ctx context.Context, req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &EntityCoreMockUpdateParams{ctx, req}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectReqParam2 sets up expected param req for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectReqParam2(req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.req = &req
+	mmUpdate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Inspect(f func(ctx context.Context, req entity.UpdateEntityReq)) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Return(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &EntityCoreMockUpdateResults{c2, err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the EntityCore.Update method
+func (mmUpdate *mEntityCoreMockUpdate) Set(f func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the EntityCore.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the EntityCore.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the EntityCore.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mEntityCoreMockUpdate) When(ctx context.Context, req entity.UpdateEntityReq) *EntityCoreMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &EntityCoreMockUpdateParams{ctx, req},
+		expectationOrigins: EntityCoreMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Update return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockUpdateExpectation) Then(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockUpdateResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Update should be invoked
+func (mmUpdate *mEntityCoreMockUpdate) Times(n uint64) *mEntityCoreMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of EntityCoreMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mEntityCoreMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.EntityCore
+func (mmUpdate *EntityCoreMock) Update(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockUpdateParams{ctx, req}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockUpdateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the EntityCoreMock.Update")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, req)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to EntityCoreMock.Update. %v %v", ctx, req)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mEntityCoreMockUpdate) Calls() []*EntityCoreMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EntityCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EntityCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EntityCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/app/confluence"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/google/uuid"
+)
+
+type EntityCore interface {
+	Create(ctx context.Context, req entity.CreateEntityReq) (uuid.UUID, entity.ContentUsage, error)
+	Update(ctx context.Context, req entity.UpdateEntityReq) (entity.ContentUsage, error)
+}
+
+// AttachmentStore stores the files attachments are served from, recording them in the workspace, and
+// records the pages they belong to.
+type AttachmentStore interface {
+	Store(ctx context.Context, req attachment.StoreReq) error
+	SetEntity(ctx context.Context, keys []string, entityID uuid.UUID) error
+}
+
+// UploadScanner rejects infected files, keeping them in quarantine.
+type UploadScanner interface {
+	Check(ctx context.Context, req quarantine.CheckReq) error
+}
+
+// Sanitizer removes the markup the sanitize policy does not allow, returning what it removed.
+type Sanitizer interface {
+	Sanitize(content string) (string, []string)
+}
+
+type IDGenerator interface {
+	New() (uuid.UUID, error)
+}
+
+// ImportCmd imports the space export in Export under ParentID, or at the top level if it is nil.
+// AuthorID is recorded as the author of the imported entities.
+type ImportCmd struct {
+	Export   fs.FS
+	ParentID *uuid.UUID
+	AuthorID uuid.UUID
+}
+
+// Service imports Confluence space exports. It runs on behalf of an operator, from the CLI, so it
+// checks no permissions; the entity core validates everything it creates.
+type Service struct {
+	entities    EntityCore
+	attachments AttachmentStore
+	scanner     UploadScanner
+	sanitizer   Sanitizer
+	ids         IDGenerator
+}
+
+func NewService(entities EntityCore, attachments AttachmentStore, scanner UploadScanner, sanitizer Sanitizer, ids IDGenerator) *Service {
+	if entities == nil || attachments == nil || scanner == nil || sanitizer == nil || ids == nil {
+		panic("confluence.NewService: nil dependency")
+	}
+	return &Service{entities: entities, attachments: attachments, scanner: scanner, sanitizer: sanitizer, ids: ids}
+}
+
+// Import creates an article for every page of the export, keeping the page tree, and stores the
+// attachments in the blob store. A page that fails is reported and its descendants are skipped; the
+// other pages are imported. Links to pages imported later are resolved by updating the linking page
+// once every page exists. An error is returned only when the export cannot be read or ctx ends,
+// along with the report so far.
+func (s *Service) Import(ctx context.Context, cmd ImportCmd) (confluence.Report, error) {
+	if cmd.AuthorID == uuid.Nil {
+		return confluence.Report{}, fmt.Errorf("confluence.service.Import: author ID cannot be nil")
+	}
+	space, err := confluence.ParseExport(cmd.Export)
+	if err != nil {
+		return confluence.Report{}, fmt.Errorf("confluence.service.Import: %w", err)
+	}
+	importID, err := s.ids.New()
+	if err != nil {
+		return confluence.Report{}, fmt.Errorf("confluence.service.Import: %w", err)
+	}
+
+	run := &importRun{
+		svc:      s,
+		cmd:      cmd,
+		space:    space,
+		report:   confluence.Report{ImportID: importID, Space: space.Name, Pages: []confluence.PageResult{}},
+		entities: make(map[string]uuid.UUID),
+		files:    make(map[string]struct{}),
+	}
+	collectFiles(space.Pages, run.files)
+
+	err = run.importPages(ctx, space.Pages, cmd.ParentID, "")
+	if err == nil {
+		err = run.resolveForwardLinks(ctx)
+	}
+	for _, page := range run.report.Pages {
+		switch page.Status {
+		case confluence.PageImported:
+			run.report.Imported++
+		case confluence.PageFailed:
+			run.report.Failed++
+		case confluence.PageSkipped:
+			run.report.Skipped++
+		}
+	}
+	if err != nil {
+		return run.report, fmt.Errorf("confluence.service.Import: %w", err)
+	}
+
+	return run.report, nil
+}
+
+type importRun struct {
+	svc    *Service
+	cmd    ImportCmd
+	space  confluence.Space
+	report confluence.Report
+	// entities maps page files to the entities they were imported as
+	entities map[string]uuid.UUID
+	files    map[string]struct{}
+	// forward are the imported pages linking to pages that did not exist yet
+	forward []forwardLinks
+}
+
+type forwardLinks struct {
+	result   int
+	page     *confluence.Page
+	parentID *uuid.UUID
+	keys     map[string]string
+}
+
+func collectFiles(pages []*confluence.Page, files map[string]struct{}) {
+	for _, page := range pages {
+		files[page.File] = struct{}{}
+		collectFiles(page.Children, files)
+	}
+}
+
+func (r *importRun) importPages(ctx context.Context, pages []*confluence.Page, parentID *uuid.UUID, parentFile string) error {
+	for _, page := range pages {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result := confluence.PageResult{File: page.File, Title: page.Title, ParentFile: parentFile}
+		if page.Content == nil {
+			result.Status, result.Error = confluence.PageFailed, "page file is missing from the export"
+			r.report.Pages = append(r.report.Pages, result)
+			r.skip(page.Children, page.File)
+			continue
+		}
+
+		keys := r.storeAttachments(ctx, page, &result)
+		conv := r.convert(page, keys)
+		id, _, err := r.svc.entities.Create(ctx, entity.CreateEntityReq{
+			Type:     entity.TypeArticle,
+			Name:     pageName(page),
+			Content:  conv.Content,
+			ParentID: parentID,
+			UserID:   r.cmd.AuthorID,
+		})
+		if err != nil {
+			result.Status, result.Error = confluence.PageFailed, err.Error()
+			r.report.Pages = append(r.report.Pages, result)
+			r.skip(page.Children, page.File)
+			continue
+		}
+		r.entities[page.File] = id
+		result.Status, result.EntityID = confluence.PageImported, &id
+		if len(keys) > 0 {
+			if err = r.svc.attachments.SetEntity(ctx, slices.Sorted(maps.Values(keys)), id); err != nil {
+				result.Warnings = append(result.Warnings, "attachments were not linked to the page, so only admins can download them")
+			}
+		}
+		result.Warnings = append(result.Warnings, conv.Warnings...)
+		for _, file := range conv.Unresolved {
+			if _, ok := r.files[file]; !ok {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("link to page %s that is not in the export", file))
+			}
+		}
+		if slices.ContainsFunc(conv.Unresolved, func(file string) bool { _, ok := r.files[file]; return ok }) {
+			r.forward = append(r.forward, forwardLinks{result: len(r.report.Pages), page: page, parentID: parentID, keys: keys})
+		}
+		r.report.Pages = append(r.report.Pages, result)
+
+		if err = r.importPages(ctx, page.Children, &id, page.File); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *importRun) skip(pages []*confluence.Page, parentFile string) {
+	for _, page := range pages {
+		r.report.Pages = append(r.report.Pages, confluence.PageResult{
+			File: page.File, Title: page.Title, ParentFile: parentFile,
+			Status: confluence.PageSkipped, Error: "parent page was not imported",
+		})
+		r.skip(page.Children, page.File)
+	}
+}
+
+// storeAttachments stores the attachments of the page and returns their keys by path.
+// An attachment that cannot be stored is reported and its links are left out of the content.
+func (r *importRun) storeAttachments(ctx context.Context, page *confluence.Page, result *confluence.PageResult) map[string]string {
+	keys := make(map[string]string, len(page.Attachments))
+	for _, p := range page.Attachments {
+		stored := confluence.AttachmentResult{Source: p}
+		key := path.Join("imports", r.report.ImportID.String(), p)
+		if err := r.putAttachment(ctx, p, key); err != nil {
+			stored.Error = err.Error()
+			result.Warnings = append(result.Warnings, fmt.Sprintf("attachment %s was not stored", p))
+		} else {
+			stored.Key, keys[p] = key, key
+		}
+		result.Attachments = append(result.Attachments, stored)
+	}
+
+	return keys
+}
+
+// putAttachment stores the attachment once the scanner passed it.
+func (r *importRun) putAttachment(ctx context.Context, p, key string) error {
+	open := func() (io.ReadCloser, error) { return r.space.Files.Open(p) }
+	err := r.svc.scanner.Check(ctx, quarantine.CheckReq{
+		Source:     quarantine.SourceConfluence,
+		Name:       p,
+		UploadedBy: &r.cmd.AuthorID,
+		Open:       open,
+	})
+	if err != nil {
+		return err
+	}
+
+	f, err := open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return r.svc.attachments.Store(ctx, attachment.StoreReq{Key: key, Name: p, UploadedBy: &r.cmd.AuthorID, Content: f})
+}
+
+func (r *importRun) links(keys map[string]string) confluence.Links {
+	return confluence.Links{
+		Page: func(file string) (uuid.UUID, bool) {
+			id, ok := r.entities[file]
+			return id, ok
+		},
+		Attachment: func(p string) (string, bool) {
+			key, ok := keys[p]
+			return key, ok
+		},
+	}
+}
+
+// resolveForwardLinks converts the pages linking to later pages again, now that those exist. Links to
+// pages that failed stay plain text.
+func (r *importRun) resolveForwardLinks(ctx context.Context) error {
+	for _, fwd := range r.forward {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result := &r.report.Pages[fwd.result]
+		conv := r.convert(fwd.page, fwd.keys)
+		for _, file := range conv.Unresolved {
+			if _, ok := r.files[file]; ok {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("link to page %s that was not imported", file))
+			}
+		}
+		_, err := r.svc.entities.Update(ctx, entity.UpdateEntityReq{
+			ID:         *result.EntityID,
+			Name:       pageName(fwd.page),
+			Content:    conv.Content,
+			ParentID:   fwd.parentID,
+			UserID:     r.cmd.AuthorID,
+			EntityType: entity.TypeArticle,
+		})
+		if err != nil {
+			result.Warnings = append(result.Warnings, "links to pages imported later were not resolved: "+err.Error())
+		}
+	}
+
+	return nil
+}
+
+// convert converts the page to Markdown without the markup the sanitize policy does not allow, which
+// a page may hold as text: Confluence escapes it, the conversion does not.
+func (r *importRun) convert(page *confluence.Page, keys map[string]string) confluence.Conversion {
+	conv := confluence.ToMarkdown(page.Content, r.links(keys))
+	var removed []string
+	if conv.Content, removed = r.svc.sanitizer.Sanitize(conv.Content); len(removed) > 0 {
+		conv.Warnings = append(conv.Warnings, "removed unsafe markup: "+strings.Join(removed, ", "))
+	}
+
+	return conv
+}
+
+// pageName is the title of the page, or its file name when the page tree lists it without one.
+func pageName(page *confluence.Page) string {
+	if page.Title != "" {
+		return page.Title
+	}
+
+	return strings.TrimSuffix(path.Base(page.File), ".html")
+}
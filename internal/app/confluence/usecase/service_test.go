@@ -0,0 +1,220 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"testing/fstest"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/app/confluence"
+	"github.com/66gu1/easygodocs/internal/app/confluence/usecase"
+	"github.com/66gu1/easygodocs/internal/app/confluence/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	entities    *mocks.EntityCoreMock
+	attachments *mocks.AttachmentStoreMock
+	scanner     *mocks.UploadScannerMock
+	ids         *mocks.IDGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		entities:    mocks.NewEntityCoreMock(t),
+		attachments: mocks.NewAttachmentStoreMock(t),
+		scanner:     mocks.NewUploadScannerMock(t),
+		ids:         mocks.NewIDGeneratorMock(t),
+	}
+}
+
+func sanitizer(t *testing.T) *sanitize.Policy {
+	t.Helper()
+	p, err := sanitize.New(sanitize.Config{
+		AllowedTags:       sanitize.DefaultAllowedTags,
+		AllowedAttributes: sanitize.DefaultAllowedAttributes,
+		AllowedSchemes:    sanitize.DefaultAllowedSchemes,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func page(body string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(`<html><body><div id="main-content">` + body + `</div></body></html>`)}
+}
+
+func TestService_Import(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		importID = uuid.New()
+		authorID = uuid.New()
+		parentID = uuid.New()
+		homeID   = uuid.New()
+		childID  = uuid.New()
+		imageKey = "imports/" + importID.String() + "/attachments/1/a.png"
+		expErr   = fmt.Errorf("test error")
+		export   = fstest.MapFS{
+			"Space/index.html": {Data: []byte(`<html><head><title>Docs</title></head><body>
+				<h2>Available Pages:</h2>
+				<ul>
+					<li><a href="home.html">Home</a>
+						<ul><li><a href="child.html">Child</a></li></ul>
+					</li>
+					<li><a href="missing.html">Missing</a>
+						<ul><li><a href="orphan.html">Orphan</a></li></ul>
+					</li>
+					<li><a href="broken.html">Broken</a></li>
+				</ul>
+			</body></html>`)},
+			"Space/home.html":               page(`<p>See <a href="child.html">child</a> and <a href="other.html">other</a></p><img src="attachments/1/a.png?version=1"><img src="attachments/1/eicar.png">`),
+			"Space/child.html":              page(`<p>Back <a href="home.html">home</a> &lt;img src=x onerror=alert(1)&gt;</p>`),
+			"Space/orphan.html":             page(`<p>Orphan</p>`),
+			"Space/broken.html":             page(`<p>Broken</p>`),
+			"Space/attachments/1/a.png":     {Data: []byte("png")},
+			"Space/attachments/1/eicar.png": {Data: []byte("EICAR")},
+			"Space/attachments/1/ignored":   {Data: []byte("not linked")},
+			"Space/attachments/2/file.txt":  {Data: []byte("not linked")},
+		}
+	)
+
+	tests := []struct {
+		name   string
+		cmd    usecase.ImportCmd
+		setup  func(m mock)
+		want   confluence.Report
+		err    error
+		errMsg string
+	}{
+		{
+			name: "success",
+			cmd:  usecase.ImportCmd{Export: export, ParentID: &parentID, AuthorID: authorID},
+			setup: func(m mock) {
+				m.ids.NewMock.Return(importID, nil)
+				m.scanner.CheckMock.Set(func(_ context.Context, req quarantine.CheckReq) error {
+					require.Equal(t, quarantine.SourceConfluence, req.Source)
+					require.Equal(t, &authorID, req.UploadedBy)
+					if req.Name == "attachments/1/eicar.png" {
+						return quarantine.ErrInfected()
+					}
+					require.Equal(t, "attachments/1/a.png", req.Name)
+					return nil
+				})
+				m.attachments.StoreMock.Set(func(_ context.Context, req attachment.StoreReq) error {
+					require.Equal(t, imageKey, req.Key)
+					require.Equal(t, "attachments/1/a.png", req.Name)
+					require.Equal(t, &authorID, req.UploadedBy)
+					data, err := io.ReadAll(req.Content)
+					require.NoError(t, err)
+					require.Equal(t, "png", string(data))
+					return nil
+				})
+				m.attachments.SetEntityMock.Expect(ctx, []string{imageKey}, homeID).Return(nil)
+				m.entities.CreateMock.Set(func(_ context.Context, req entity.CreateEntityReq) (uuid.UUID, entity.ContentUsage, error) {
+					require.Equal(t, entity.TypeArticle, req.Type)
+					require.Equal(t, authorID, req.UserID)
+					switch req.Name {
+					case "Home":
+						require.Equal(t, &parentID, req.ParentID)
+						require.Equal(t, "See child and other\n\n![]("+imageKey+")", req.Content)
+						return homeID, entity.ContentUsage{}, nil
+					case "Child":
+						require.Equal(t, &homeID, req.ParentID)
+						require.Equal(t, "Back [["+homeID.String()+"|home]] <img src=\"x\">", req.Content)
+						return childID, entity.ContentUsage{}, nil
+					case "Broken":
+						return uuid.Nil, entity.ContentUsage{}, expErr
+					}
+					t.Fatalf("unexpected page %q", req.Name)
+					return uuid.Nil, entity.ContentUsage{}, nil
+				})
+				m.entities.UpdateMock.Expect(ctx, entity.UpdateEntityReq{
+					ID:         homeID,
+					Name:       "Home",
+					Content:    "See [[" + childID.String() + "|child]] and other\n\n![](" + imageKey + ")",
+					ParentID:   &parentID,
+					UserID:     authorID,
+					EntityType: entity.TypeArticle,
+				}).Return(entity.ContentUsage{}, nil)
+			},
+			want: confluence.Report{
+				ImportID: importID,
+				Space:    "Docs",
+				Imported: 2,
+				Failed:   2,
+				Skipped:  1,
+				Pages: []confluence.PageResult{
+					{
+						File: "home.html", Title: "Home", Status: confluence.PageImported, EntityID: &homeID,
+						Attachments: []confluence.AttachmentResult{
+							{Source: "attachments/1/a.png", Key: imageKey},
+							{Source: "attachments/1/eicar.png", Error: quarantine.ErrInfected().Error()},
+						},
+						Warnings: []string{
+							"attachment attachments/1/eicar.png was not stored",
+							"image attachments/1/eicar.png that was not stored",
+							"link to page other.html that is not in the export",
+						},
+					},
+					{
+						File: "child.html", Title: "Child", ParentFile: "home.html", Status: confluence.PageImported, EntityID: &childID,
+						Warnings: []string{"removed unsafe markup: onerror="},
+					},
+					{File: "missing.html", Title: "Missing", Status: confluence.PageFailed, Error: "page file is missing from the export"},
+					{File: "orphan.html", Title: "Orphan", ParentFile: "missing.html", Status: confluence.PageSkipped, Error: "parent page was not imported"},
+					{File: "broken.html", Title: "Broken", Status: confluence.PageFailed, Error: expErr.Error()},
+				},
+			},
+		},
+		{
+			name:   "error/nil_author",
+			cmd:    usecase.ImportCmd{Export: export},
+			errMsg: "author ID cannot be nil",
+		},
+		{
+			name: "error/not_an_export",
+			cmd:  usecase.ImportCmd{Export: fstest.MapFS{"a/index.html": {}, "b/index.html": {}}, AuthorID: authorID},
+			err:  confluence.ErrNotAnExport,
+		},
+		{
+			name: "error/id_generator",
+			cmd:  usecase.ImportCmd{Export: export, AuthorID: authorID},
+			setup: func(m mock) {
+				m.ids.NewMock.Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			svc := usecase.NewService(m.entities, m.attachments, m.scanner, sanitizer(t), m.ids)
+
+			got, err := svc.Import(ctx, tt.cmd)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, export Export) error
+	// GetLatest returns the newest export of the user, ErrNotFound without one.
+	GetLatest(ctx context.Context, userID uuid.UUID) (Export, error)
+	// Claim marks the oldest pending export, or one running since before staleBefore, as running since
+	// startedAt and returns it; false when there is none. Concurrent claims never return the same export.
+	Claim(ctx context.Context, startedAt, staleBefore time.Time) (Export, bool, error)
+	// Finish moves a running export to status, ready or failed, kept until expiresAt.
+	Finish(ctx context.Context, id uuid.UUID, status Status, completedAt, expiresAt time.Time) error
+	// DeleteExpired deletes the exports that expired before now and returns their IDs.
+	DeleteExpired(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	// DeleteByUser deletes every export of the user and returns their IDs.
+	DeleteByUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// BlobStorage keeps the archives. Get and Delete return blob.ErrNotFound for missing keys.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type IDGenerator interface {
+	New() (uuid.UUID, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+type Generators struct {
+	ID   IDGenerator
+	Time TimeGenerator
+}
+
+// Config sets how often pending exports are made and how long an archive can be downloaded.
+type Config struct {
+	IntervalSeconds int `mapstructure:"interval_seconds" json:"interval_seconds"`
+	TTLHours        int `mapstructure:"ttl_hours" json:"ttl_hours"`
+}
+
+func (c Config) Validate() error {
+	if c.IntervalSeconds <= 0 {
+		return fmt.Errorf("interval_seconds must be positive")
+	}
+	if c.TTLHours <= 0 {
+		return fmt.Errorf("ttl_hours must be positive")
+	}
+
+	return nil
+}
+
+const (
+	// FormatVersion is the layout of the archive, see Manifest.
+	FormatVersion = 1
+	ManifestName  = "manifest.json"
+
+	// staleAfter is how long an export may run before it is claimed again, its server presumed gone.
+	staleAfter = time.Hour
+)
+
+type core struct {
+	repo    Repository
+	storage BlobStorage
+	gen     Generators
+	cfg     Config
+}
+
+func NewCore(repo Repository, storage BlobStorage, gen Generators, cfg Config) (*core, error) {
+	if repo == nil || storage == nil || gen.ID == nil || gen.Time == nil {
+		return nil, fmt.Errorf("dataexport.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("dataexport.NewCore: %w", err)
+	}
+
+	return &core{repo: repo, storage: storage, gen: gen, cfg: cfg}, nil
+}
+
+// Request returns the export of the user that is being made or can be downloaded, and otherwise
+// requests a new one on behalf of the caller. created tells whether the export is new.
+func (c *core) Request(ctx context.Context, userID uuid.UUID) (export Export, created bool, err error) {
+	if userID == uuid.Nil {
+		return Export{}, false, fmt.Errorf("dataexport.core.Request: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	latest, err := c.repo.GetLatest(ctx, userID)
+	switch {
+	case err == nil && c.current(latest):
+		return latest, false, nil
+	case err != nil && !errors.Is(err, ErrNotFound()):
+		return Export{}, false, fmt.Errorf("dataexport.core.Request: %w", err)
+	}
+
+	id, err := c.gen.ID.New()
+	if err != nil {
+		return Export{}, false, fmt.Errorf("dataexport.core.Request: %w", err)
+	}
+	export = Export{ID: id, UserID: userID, Status: StatusPending, CreatedAt: c.gen.Time.Now()}
+	if requestedBy, err := contextx.GetUserID(ctx); err == nil {
+		export.RequestedBy = &requestedBy
+	}
+	if err = c.repo.Create(ctx, export); err != nil {
+		return Export{}, false, fmt.Errorf("dataexport.core.Request: %w", err)
+	}
+
+	return export, true, nil
+}
+
+// current reports whether export is still being made or can be downloaded, so a request returns it.
+func (c *core) current(export Export) bool {
+	switch export.Status {
+	case StatusPending, StatusRunning:
+		return true
+	case StatusReady:
+		return export.ExpiresAt != nil && c.gen.Time.Now().Before(*export.ExpiresAt)
+	default:
+		return false
+	}
+}
+
+// Open returns the latest export of the user with its archive, which the caller closes.
+func (c *core) Open(ctx context.Context, userID uuid.UUID) (Export, io.ReadCloser, error) {
+	if userID == uuid.Nil {
+		return Export{}, nil, fmt.Errorf("dataexport.core.Open: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	export, err := c.repo.GetLatest(ctx, userID)
+	if err != nil {
+		return Export{}, nil, fmt.Errorf("dataexport.core.Open: %w", err)
+	}
+	if export.Status != StatusReady {
+		return Export{}, nil, fmt.Errorf("dataexport.core.Open: %w", ErrNotReady())
+	}
+	if !c.current(export) {
+		return Export{}, nil, fmt.Errorf("dataexport.core.Open: %w", ErrNotFound())
+	}
+
+	rc, err := c.storage.Get(ctx, archiveKey(export.ID))
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			err = ErrNotFound()
+		}
+		return Export{}, nil, fmt.Errorf("dataexport.core.Open: %w", err)
+	}
+
+	return export, rc, nil
+}
+
+// Claim takes the next export to make, false when none is pending.
+func (c *core) Claim(ctx context.Context) (Export, bool, error) {
+	now := c.gen.Time.Now()
+	export, ok, err := c.repo.Claim(ctx, now, now.Add(-staleAfter))
+	if err != nil {
+		return Export{}, false, fmt.Errorf("dataexport.core.Claim: %w", err)
+	}
+
+	return export, ok, nil
+}
+
+// Complete stores the archive of data and makes the export downloadable for TTLHours.
+func (c *core) Complete(ctx context.Context, export Export, data Data) error {
+	now := c.gen.Time.Now()
+	var buf bytes.Buffer
+	if err := writeArchive(&buf, export.UserID, now, data); err != nil {
+		return fmt.Errorf("dataexport.core.Complete: %w", err)
+	}
+	if err := c.storage.Put(ctx, archiveKey(export.ID), &buf); err != nil {
+		return fmt.Errorf("dataexport.core.Complete: %w", err)
+	}
+	if err := c.repo.Finish(ctx, export.ID, StatusReady, now, c.expiresAt(now)); err != nil {
+		return fmt.Errorf("dataexport.core.Complete: %w", err)
+	}
+
+	return nil
+}
+
+// Fail marks an export that could not be made; the next request makes a new one.
+func (c *core) Fail(ctx context.Context, id uuid.UUID) error {
+	now := c.gen.Time.Now()
+	if err := c.repo.Finish(ctx, id, StatusFailed, now, c.expiresAt(now)); err != nil {
+		return fmt.Errorf("dataexport.core.Fail: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes the expired exports with their archives and returns how many there were.
+func (c *core) DeleteExpired(ctx context.Context) (int, error) {
+	ids, err := c.repo.DeleteExpired(ctx, c.gen.Time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("dataexport.core.DeleteExpired: %w", err)
+	}
+	if err = c.deleteArchives(ctx, ids); err != nil {
+		return 0, fmt.Errorf("dataexport.core.DeleteExpired: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+// DeleteByUser deletes the exports of the user with their archives and returns how many there were.
+func (c *core) DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	if userID == uuid.Nil {
+		return 0, fmt.Errorf("dataexport.core.DeleteByUser: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	ids, err := c.repo.DeleteByUser(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("dataexport.core.DeleteByUser: %w", err)
+	}
+	if err = c.deleteArchives(ctx, ids); err != nil {
+		return 0, fmt.Errorf("dataexport.core.DeleteByUser: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+func (c *core) deleteArchives(ctx context.Context, ids []uuid.UUID) error {
+	for _, id := range ids {
+		// pending and failed exports have no archive
+		if err := c.storage.Delete(ctx, archiveKey(id)); err != nil && !errors.Is(err, blob.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *core) expiresAt(now time.Time) time.Time {
+	return now.Add(time.Duration(c.cfg.TTLHours) * time.Hour)
+}
+
+func archiveKey(id uuid.UUID) string {
+	return "data-exports/" + id.String() + ".zip"
+}
+
+// writeArchive writes a zip of the manifest followed by one JSON file per part of data.
+func writeArchive(w io.Writer, userID uuid.UUID, createdAt time.Time, data Data) error {
+	files := []struct {
+		name string
+		v    any
+	}{
+		{"profile.json", data.Profile},
+		{"sessions.json", data.Sessions},
+		{"roles.json", data.Roles},
+		{"versions.json", data.Versions},
+	}
+	manifest := Manifest{FormatVersion: FormatVersion, UserID: userID, CreatedAt: createdAt.UTC()}
+	for _, f := range files {
+		manifest.Files = append(manifest.Files, f.name)
+	}
+
+	zw := zip.NewWriter(w)
+	if err := writeJSON(zw, ManifestName, createdAt, manifest); err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := writeJSON(zw, f.name, createdAt, f.v); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeJSON(zw *zip.Writer, name string, modified time.Time, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+
+	return err
+}
@@ -0,0 +1,409 @@
+package dataexport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/app/dataexport/mocks"
+	"github.com/66gu1/easygodocs/internal/app/dataexport/usecase"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	repo    *mocks.RepositoryMock
+	storage *mocks.BlobStorageMock
+	idGen   *mocks.IDGeneratorMock
+	timeGen *mocks.TimeGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		repo:    mocks.NewRepositoryMock(t),
+		storage: mocks.NewBlobStorageMock(t),
+		idGen:   mocks.NewIDGeneratorMock(t),
+		timeGen: mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+func cfg() dataexport.Config {
+	return dataexport.Config{IntervalSeconds: 60, TTLHours: 24}
+}
+
+func newCore(t *testing.T, m mock) usecase.Core {
+	t.Helper()
+	c, err := dataexport.NewCore(m.repo, m.storage, dataexport.Generators{ID: m.idGen, Time: m.timeGen}, cfg())
+	require.NoError(t, err)
+	return c
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	gen := dataexport.Generators{ID: m.idGen, Time: m.timeGen}
+	_, err := dataexport.NewCore(m.repo, m.storage, gen, cfg())
+	require.NoError(t, err)
+	_, err = dataexport.NewCore(m.repo, nil, gen, cfg())
+	require.Error(t, err)
+	_, err = dataexport.NewCore(m.repo, m.storage, gen, dataexport.Config{IntervalSeconds: 60})
+	require.Error(t, err)
+}
+
+func TestCore_Request(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.New()
+		adminID = uuid.New()
+		ctx     = contextx.SetUserID(context.Background(), adminID)
+		id      = uuid.New()
+		now     = time.Now()
+		later   = now.Add(time.Hour)
+		running = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusRunning}
+		ready   = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusReady, ExpiresAt: &later}
+		created = dataexport.Export{ID: id, UserID: userID, RequestedBy: &adminID, Status: dataexport.StatusPending, CreatedAt: now}
+		expErr  = errors.New("expected error")
+	)
+	expired := ready
+	expired.ExpiresAt = &now
+	failed := running
+	failed.Status = dataexport.StatusFailed
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		setup   func(m mock)
+		want    dataexport.Export
+		created bool
+		err     error
+	}{
+		{
+			name:   "existing/running",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(running, nil)
+			},
+			want: running,
+		},
+		{
+			name:   "existing/ready",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(ready, nil)
+				m.timeGen.NowMock.Return(now)
+			},
+			want: ready,
+		},
+		{
+			name:   "new/expired",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(expired, nil)
+				m.timeGen.NowMock.Return(now)
+				m.idGen.NewMock.Return(id, nil)
+				m.repo.CreateMock.Expect(ctx, created).Return(nil)
+			},
+			want:    created,
+			created: true,
+		},
+		{
+			name:   "new/failed",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(failed, nil)
+				m.timeGen.NowMock.Return(now)
+				m.idGen.NewMock.Return(id, nil)
+				m.repo.CreateMock.Expect(ctx, created).Return(nil)
+			},
+			want:    created,
+			created: true,
+		},
+		{
+			name:   "new/first",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(dataexport.Export{}, dataexport.ErrNotFound())
+				m.idGen.NewMock.Return(id, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreateMock.Expect(ctx, created).Return(nil)
+			},
+			want:    created,
+			created: true,
+		},
+		{
+			name:   "error/nil_id",
+			userID: uuid.Nil,
+			setup:  func(m mock) {},
+			err:    apperr.ErrNilUUID(dataexport.FieldUserID),
+		},
+		{
+			name:   "error/get_latest",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(dataexport.Export{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "error/create",
+			userID: userID,
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(dataexport.Export{}, dataexport.ErrNotFound())
+				m.idGen.NewMock.Return(id, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreateMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(m)
+			c := newCore(t, m)
+
+			got, created, err := c.Request(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.created, created)
+		})
+	}
+}
+
+func TestCore_Open(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = uuid.New()
+		now     = time.Now()
+		later   = now.Add(time.Hour)
+		ready   = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusReady, ExpiresAt: &later}
+		pending = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusPending}
+		key     = "data-exports/" + ready.ID.String() + ".zip"
+	)
+	expired := ready
+	expired.ExpiresAt = &now
+
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "success",
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(ready, nil)
+				m.timeGen.NowMock.Return(now)
+				m.storage.GetMock.Expect(ctx, key).Return(io.NopCloser(strings.NewReader("zip")), nil)
+			},
+		},
+		{
+			name: "error/not_ready",
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(pending, nil)
+			},
+			err: dataexport.ErrNotReady(),
+		},
+		{
+			name: "error/expired",
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(expired, nil)
+				m.timeGen.NowMock.Return(now)
+			},
+			err: dataexport.ErrNotFound(),
+		},
+		{
+			name: "error/no_export",
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(dataexport.Export{}, dataexport.ErrNotFound())
+			},
+			err: dataexport.ErrNotFound(),
+		},
+		{
+			name: "error/no_archive",
+			setup: func(m mock) {
+				m.repo.GetLatestMock.Expect(ctx, userID).Return(ready, nil)
+				m.timeGen.NowMock.Return(now)
+				m.storage.GetMock.Expect(ctx, key).Return(nil, blob.ErrNotFound)
+			},
+			err: dataexport.ErrNotFound(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(m)
+			c := newCore(t, m)
+
+			got, rc, err := c.Open(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ready, got)
+			require.NoError(t, rc.Close())
+		})
+	}
+}
+
+func TestCore_Complete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		now    = time.Date(2025, 10, 5, 10, 0, 0, 0, time.UTC)
+		export = dataexport.Export{ID: uuid.New(), UserID: uuid.New(), Status: dataexport.StatusRunning}
+		data   = dataexport.Data{
+			Profile:  user.User{ID: export.UserID, Email: "ann@example.com", Name: "Ann"},
+			Sessions: []auth.Session{{ID: uuid.New(), UserID: export.UserID}},
+			Roles:    []auth.UserRole{{UserID: export.UserID, Role: auth.RoleAdmin}},
+			Versions: []entity.AuthoredVersion{{EntityID: uuid.New(), Name: "Page", Version: 1}},
+		}
+	)
+
+	m := getMocks(t)
+	m.timeGen.NowMock.Return(now)
+	var archive []byte
+	m.storage.PutMock.Set(func(_ context.Context, key string, r io.Reader) error {
+		require.Equal(t, "data-exports/"+export.ID.String()+".zip", key)
+		var err error
+		archive, err = io.ReadAll(r)
+		return err
+	})
+	m.repo.FinishMock.Expect(ctx, export.ID, dataexport.StatusReady, now, now.Add(24*time.Hour)).Return(nil)
+	c := newCore(t, m)
+
+	require.NoError(t, c.Complete(ctx, export, data))
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{dataexport.ManifestName, "profile.json", "sessions.json", "roles.json", "versions.json"}, names)
+
+	var manifest dataexport.Manifest
+	readJSON(t, zr.File[0], &manifest)
+	require.Equal(t, dataexport.Manifest{
+		FormatVersion: dataexport.FormatVersion,
+		UserID:        export.UserID,
+		CreatedAt:     now,
+		Files:         names[1:],
+	}, manifest)
+	var profile user.User
+	readJSON(t, zr.File[1], &profile)
+	require.Equal(t, data.Profile.Email, profile.Email)
+	var versions []entity.AuthoredVersion
+	readJSON(t, zr.File[4], &versions)
+	require.Equal(t, data.Versions, versions)
+
+	// a failed upload leaves the export running
+	m = getMocks(t)
+	m.timeGen.NowMock.Return(now)
+	m.storage.PutMock.Return(errors.New("expected error"))
+	require.Error(t, newCore(t, m).Complete(ctx, export, data))
+}
+
+func readJSON(t *testing.T, f *zip.File, v any) {
+	t.Helper()
+	rc, err := f.Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	require.NoError(t, json.NewDecoder(rc).Decode(v))
+}
+
+func TestCore_DeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		now = time.Now()
+		ids = []uuid.UUID{uuid.New(), uuid.New()}
+	)
+
+	m := getMocks(t)
+	m.timeGen.NowMock.Return(now)
+	m.repo.DeleteExpiredMock.Expect(ctx, now).Return(ids, nil)
+	m.storage.DeleteMock.When(ctx, "data-exports/"+ids[0].String()+".zip").Then(nil)
+	// a failed export has no archive
+	m.storage.DeleteMock.When(ctx, "data-exports/"+ids[1].String()+".zip").Then(blob.ErrNotFound)
+	n, err := newCore(t, m).DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	m = getMocks(t)
+	m.timeGen.NowMock.Return(now)
+	m.repo.DeleteExpiredMock.Expect(ctx, now).Return(ids[:1], nil)
+	m.storage.DeleteMock.Expect(minimock.AnyContext, "data-exports/"+ids[0].String()+".zip").Return(errors.New("expected error"))
+	_, err = newCore(t, m).DeleteExpired(ctx)
+	require.Error(t, err)
+}
+
+func TestCore_DeleteByUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		ids    = []uuid.UUID{uuid.New(), uuid.New()}
+	)
+	// user.Service removes the exports when it anonymizes the user, so DeleteByUser is not on usecase.Core
+	newUserCore := func(m mock) interface {
+		DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	} {
+		c, err := dataexport.NewCore(m.repo, m.storage, dataexport.Generators{ID: m.idGen, Time: m.timeGen}, cfg())
+		require.NoError(t, err)
+		return c
+	}
+
+	m := getMocks(t)
+	m.repo.DeleteByUserMock.Expect(ctx, userID).Return(ids, nil)
+	m.storage.DeleteMock.When(ctx, "data-exports/"+ids[0].String()+".zip").Then(nil)
+	// a pending export has no archive yet
+	m.storage.DeleteMock.When(ctx, "data-exports/"+ids[1].String()+".zip").Then(blob.ErrNotFound)
+	n, err := newUserCore(m).DeleteByUser(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	m = getMocks(t)
+	m.repo.DeleteByUserMock.Expect(ctx, userID).Return(ids[:1], nil)
+	m.storage.DeleteMock.Expect(minimock.AnyContext, "data-exports/"+ids[0].String()+".zip").Return(errors.New("expected error"))
+	_, err = newUserCore(m).DeleteByUser(ctx, userID)
+	require.Error(t, err)
+
+	m = getMocks(t)
+	m.repo.DeleteByUserMock.Expect(ctx, userID).Return(nil, errors.New("expected error"))
+	_, err = newUserCore(m).DeleteByUser(ctx, userID)
+	require.Error(t, err)
+
+	_, err = newUserCore(getMocks(t)).DeleteByUser(ctx, uuid.Nil)
+	require.ErrorIs(t, err, apperr.ErrNilUUID(dataexport.FieldUserID))
+}
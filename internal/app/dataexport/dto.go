@@ -0,0 +1,69 @@
+package dataexport
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldExportID apperr.Field = "export_id"
+	FieldUserID   apperr.Field = "user_id"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusReady   Status = "ready"
+	StatusFailed  Status = "failed"
+)
+
+// Export is a request for the archive of what is stored about a user. It is made in the background;
+// once ready the archive can be downloaded until ExpiresAt.
+type Export struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// RequestedBy is the user who asked for it, the user or an admin.
+	RequestedBy *uuid.UUID `json:"requested_by"`
+	Status      Status     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"-"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	// DownloadURL is where the archive is downloaded from, once it is ready.
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// downloadURLFormat is the download route of the latest export of a user.
+const downloadURLFormat = "/api/v1/users/%s/export/download"
+
+// DownloadURL returns where the archive of the export is downloaded from, or "" until it is ready.
+func DownloadURL(userID uuid.UUID, status Status) string {
+	if status != StatusReady {
+		return ""
+	}
+	return fmt.Sprintf(downloadURLFormat, userID)
+}
+
+// Data is what the archive holds about the user. Every part is a JSON file of the archive.
+type Data struct {
+	Profile  user.User                `json:"profile"`
+	Sessions []auth.Session           `json:"sessions"`
+	Roles    []auth.UserRole          `json:"roles"`
+	Versions []entity.AuthoredVersion `json:"versions"`
+}
+
+// Manifest is the first file of the archive and describes the others.
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	UserID        uuid.UUID `json:"user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	Files         []string  `json:"files"`
+}
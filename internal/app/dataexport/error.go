@@ -0,0 +1,23 @@
+package dataexport
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeNotFound apperr.Code = "data_export/not_found"
+	CodeNotReady apperr.Code = "data_export/not_ready"
+)
+
+func init() {
+	apperr.Register(CodeNotFound, "Data export not found", apperr.ClassNotFound)
+	apperr.Register(CodeNotReady, "Data export is not ready yet", apperr.ClassConflict)
+}
+
+func ErrNotFound() error {
+	return apperr.New("data export not found", CodeNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+// ErrNotReady is returned for the download of an export that is still being made or has failed.
+func ErrNotReady() error {
+	return apperr.New("data export is not ready", CodeNotReady, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldExportID, Rule: apperr.RuleInvalidState})
+}
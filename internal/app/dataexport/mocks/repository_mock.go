@@ -0,0 +1,2323 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/dataexport.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_dataexport "github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_dataexport.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaim          func(ctx context.Context, startedAt time.Time, staleBefore time.Time) (e1 mm_dataexport.Export, b1 bool, err error)
+	funcClaimOrigin    string
+	inspectFuncClaim   func(ctx context.Context, startedAt time.Time, staleBefore time.Time)
+	afterClaimCounter  uint64
+	beforeClaimCounter uint64
+	ClaimMock          mRepositoryMockClaim
+
+	funcCreate          func(ctx context.Context, export mm_dataexport.Export) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, export mm_dataexport.Export)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRepositoryMockCreate
+
+	funcDeleteByUser          func(ctx context.Context, userID uuid.UUID) (ua1 []uuid.UUID, err error)
+	funcDeleteByUserOrigin    string
+	inspectFuncDeleteByUser   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteByUserCounter  uint64
+	beforeDeleteByUserCounter uint64
+	DeleteByUserMock          mRepositoryMockDeleteByUser
+
+	funcDeleteExpired          func(ctx context.Context, now time.Time) (ua1 []uuid.UUID, err error)
+	funcDeleteExpiredOrigin    string
+	inspectFuncDeleteExpired   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredCounter  uint64
+	beforeDeleteExpiredCounter uint64
+	DeleteExpiredMock          mRepositoryMockDeleteExpired
+
+	funcFinish          func(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time) (err error)
+	funcFinishOrigin    string
+	inspectFuncFinish   func(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time)
+	afterFinishCounter  uint64
+	beforeFinishCounter uint64
+	FinishMock          mRepositoryMockFinish
+
+	funcGetLatest          func(ctx context.Context, userID uuid.UUID) (e1 mm_dataexport.Export, err error)
+	funcGetLatestOrigin    string
+	inspectFuncGetLatest   func(ctx context.Context, userID uuid.UUID)
+	afterGetLatestCounter  uint64
+	beforeGetLatestCounter uint64
+	GetLatestMock          mRepositoryMockGetLatest
+}
+
+// NewRepositoryMock returns a mock for mm_dataexport.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimMock = mRepositoryMockClaim{mock: m}
+	m.ClaimMock.callArgs = []*RepositoryMockClaimParams{}
+
+	m.CreateMock = mRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RepositoryMockCreateParams{}
+
+	m.DeleteByUserMock = mRepositoryMockDeleteByUser{mock: m}
+	m.DeleteByUserMock.callArgs = []*RepositoryMockDeleteByUserParams{}
+
+	m.DeleteExpiredMock = mRepositoryMockDeleteExpired{mock: m}
+	m.DeleteExpiredMock.callArgs = []*RepositoryMockDeleteExpiredParams{}
+
+	m.FinishMock = mRepositoryMockFinish{mock: m}
+	m.FinishMock.callArgs = []*RepositoryMockFinishParams{}
+
+	m.GetLatestMock = mRepositoryMockGetLatest{mock: m}
+	m.GetLatestMock.callArgs = []*RepositoryMockGetLatestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockClaim struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockClaimExpectation
+	expectations       []*RepositoryMockClaimExpectation
+
+	callArgs []*RepositoryMockClaimParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockClaimExpectation specifies expectation struct of the Repository.Claim
+type RepositoryMockClaimExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockClaimParams
+	paramPtrs          *RepositoryMockClaimParamPtrs
+	expectationOrigins RepositoryMockClaimExpectationOrigins
+	results            *RepositoryMockClaimResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockClaimParams contains parameters of the Repository.Claim
+type RepositoryMockClaimParams struct {
+	ctx         context.Context
+	startedAt   time.Time
+	staleBefore time.Time
+}
+
+// RepositoryMockClaimParamPtrs contains pointers to parameters of the Repository.Claim
+type RepositoryMockClaimParamPtrs struct {
+	ctx         *context.Context
+	startedAt   *time.Time
+	staleBefore *time.Time
+}
+
+// RepositoryMockClaimResults contains results of the Repository.Claim
+type RepositoryMockClaimResults struct {
+	e1  mm_dataexport.Export
+	b1  bool
+	err error
+}
+
+// RepositoryMockClaimOrigins contains origins of expectations of the Repository.Claim
+type RepositoryMockClaimExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originStartedAt   string
+	originStaleBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaim *mRepositoryMockClaim) Optional() *mRepositoryMockClaim {
+	mmClaim.optional = true
+	return mmClaim
+}
+
+// Expect sets up expected params for Repository.Claim
+func (mmClaim *mRepositoryMockClaim) Expect(ctx context.Context, startedAt time.Time, staleBefore time.Time) *mRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &RepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by ExpectParams functions")
+	}
+
+	mmClaim.defaultExpectation.params = &RepositoryMockClaimParams{ctx, startedAt, staleBefore}
+	mmClaim.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaim.expectations {
+		if minimock.Equal(e.params, mmClaim.defaultExpectation.params) {
+			mmClaim.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaim.defaultExpectation.params)
+		}
+	}
+
+	return mmClaim
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Claim
+func (mmClaim *mRepositoryMockClaim) ExpectCtxParam1(ctx context.Context) *mRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &RepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &RepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaim.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// ExpectStartedAtParam2 sets up expected param startedAt for Repository.Claim
+func (mmClaim *mRepositoryMockClaim) ExpectStartedAtParam2(startedAt time.Time) *mRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &RepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &RepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.startedAt = &startedAt
+	mmClaim.defaultExpectation.expectationOrigins.originStartedAt = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// ExpectStaleBeforeParam3 sets up expected param staleBefore for Repository.Claim
+func (mmClaim *mRepositoryMockClaim) ExpectStaleBeforeParam3(staleBefore time.Time) *mRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &RepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &RepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.staleBefore = &staleBefore
+	mmClaim.defaultExpectation.expectationOrigins.originStaleBefore = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Claim
+func (mmClaim *mRepositoryMockClaim) Inspect(f func(ctx context.Context, startedAt time.Time, staleBefore time.Time)) *mRepositoryMockClaim {
+	if mmClaim.mock.inspectFuncClaim != nil {
+		mmClaim.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Claim")
+	}
+
+	mmClaim.mock.inspectFuncClaim = f
+
+	return mmClaim
+}
+
+// Return sets up results that will be returned by Repository.Claim
+func (mmClaim *mRepositoryMockClaim) Return(e1 mm_dataexport.Export, b1 bool, err error) *RepositoryMock {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &RepositoryMockClaimExpectation{mock: mmClaim.mock}
+	}
+	mmClaim.defaultExpectation.results = &RepositoryMockClaimResults{e1, b1, err}
+	mmClaim.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// Set uses given function f to mock the Repository.Claim method
+func (mmClaim *mRepositoryMockClaim) Set(f func(ctx context.Context, startedAt time.Time, staleBefore time.Time) (e1 mm_dataexport.Export, b1 bool, err error)) *RepositoryMock {
+	if mmClaim.defaultExpectation != nil {
+		mmClaim.mock.t.Fatalf("Default expectation is already set for the Repository.Claim method")
+	}
+
+	if len(mmClaim.expectations) > 0 {
+		mmClaim.mock.t.Fatalf("Some expectations are already set for the Repository.Claim method")
+	}
+
+	mmClaim.mock.funcClaim = f
+	mmClaim.mock.funcClaimOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// When sets expectation for the Repository.Claim which will trigger the result defined by the following
+// Then helper
+func (mmClaim *mRepositoryMockClaim) When(ctx context.Context, startedAt time.Time, staleBefore time.Time) *RepositoryMockClaimExpectation {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("RepositoryMock.Claim mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockClaimExpectation{
+		mock:               mmClaim.mock,
+		params:             &RepositoryMockClaimParams{ctx, startedAt, staleBefore},
+		expectationOrigins: RepositoryMockClaimExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaim.expectations = append(mmClaim.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Claim return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockClaimExpectation) Then(e1 mm_dataexport.Export, b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockClaimResults{e1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Claim should be invoked
+func (mmClaim *mRepositoryMockClaim) Times(n uint64) *mRepositoryMockClaim {
+	if n == 0 {
+		mmClaim.mock.t.Fatalf("Times of RepositoryMock.Claim mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaim.expectedInvocations, n)
+	mmClaim.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaim
+}
+
+func (mmClaim *mRepositoryMockClaim) invocationsDone() bool {
+	if len(mmClaim.expectations) == 0 && mmClaim.defaultExpectation == nil && mmClaim.mock.funcClaim == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaim.mock.afterClaimCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaim.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Claim implements mm_dataexport.Repository
+func (mmClaim *RepositoryMock) Claim(ctx context.Context, startedAt time.Time, staleBefore time.Time) (e1 mm_dataexport.Export, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmClaim.beforeClaimCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaim.afterClaimCounter, 1)
+
+	mmClaim.t.Helper()
+
+	if mmClaim.inspectFuncClaim != nil {
+		mmClaim.inspectFuncClaim(ctx, startedAt, staleBefore)
+	}
+
+	mm_params := RepositoryMockClaimParams{ctx, startedAt, staleBefore}
+
+	// Record call args
+	mmClaim.ClaimMock.mutex.Lock()
+	mmClaim.ClaimMock.callArgs = append(mmClaim.ClaimMock.callArgs, &mm_params)
+	mmClaim.ClaimMock.mutex.Unlock()
+
+	for _, e := range mmClaim.ClaimMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmClaim.ClaimMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaim.ClaimMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaim.ClaimMock.defaultExpectation.params
+		mm_want_ptrs := mmClaim.ClaimMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockClaimParams{ctx, startedAt, staleBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaim.t.Errorf("RepositoryMock.Claim got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.startedAt != nil && !minimock.Equal(*mm_want_ptrs.startedAt, mm_got.startedAt) {
+				mmClaim.t.Errorf("RepositoryMock.Claim got unexpected parameter startedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originStartedAt, *mm_want_ptrs.startedAt, mm_got.startedAt, minimock.Diff(*mm_want_ptrs.startedAt, mm_got.startedAt))
+			}
+
+			if mm_want_ptrs.staleBefore != nil && !minimock.Equal(*mm_want_ptrs.staleBefore, mm_got.staleBefore) {
+				mmClaim.t.Errorf("RepositoryMock.Claim got unexpected parameter staleBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originStaleBefore, *mm_want_ptrs.staleBefore, mm_got.staleBefore, minimock.Diff(*mm_want_ptrs.staleBefore, mm_got.staleBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaim.t.Errorf("RepositoryMock.Claim got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaim.ClaimMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaim.ClaimMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaim.t.Fatal("No results are set for the RepositoryMock.Claim")
+		}
+		return (*mm_results).e1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmClaim.funcClaim != nil {
+		return mmClaim.funcClaim(ctx, startedAt, staleBefore)
+	}
+	mmClaim.t.Fatalf("Unexpected call to RepositoryMock.Claim. %v %v %v", ctx, startedAt, staleBefore)
+	return
+}
+
+// ClaimAfterCounter returns a count of finished RepositoryMock.Claim invocations
+func (mmClaim *RepositoryMock) ClaimAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.afterClaimCounter)
+}
+
+// ClaimBeforeCounter returns a count of RepositoryMock.Claim invocations
+func (mmClaim *RepositoryMock) ClaimBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.beforeClaimCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Claim.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaim *mRepositoryMockClaim) Calls() []*RepositoryMockClaimParams {
+	mmClaim.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockClaimParams, len(mmClaim.callArgs))
+	copy(argCopy, mmClaim.callArgs)
+
+	mmClaim.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDone returns true if the count of the Claim invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockClaimDone() bool {
+	if m.ClaimMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimMock.invocationsDone()
+}
+
+// MinimockClaimInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockClaimInspect() {
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Claim at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimCounter := mm_atomic.LoadUint64(&m.afterClaimCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimMock.defaultExpectation != nil && afterClaimCounter < 1 {
+		if m.ClaimMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Claim at\n%s", m.ClaimMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Claim at\n%s with params: %#v", m.ClaimMock.defaultExpectation.expectationOrigins.origin, *m.ClaimMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaim != nil && afterClaimCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Claim at\n%s", m.funcClaimOrigin)
+	}
+
+	if !m.ClaimMock.invocationsDone() && afterClaimCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Claim at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimMock.expectedInvocations), m.ClaimMock.expectedInvocationsOrigin, afterClaimCounter)
+	}
+}
+
+type mRepositoryMockCreate struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateExpectation
+	expectations       []*RepositoryMockCreateExpectation
+
+	callArgs []*RepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateExpectation specifies expectation struct of the Repository.Create
+type RepositoryMockCreateExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateParams
+	paramPtrs          *RepositoryMockCreateParamPtrs
+	expectationOrigins RepositoryMockCreateExpectationOrigins
+	results            *RepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateParams contains parameters of the Repository.Create
+type RepositoryMockCreateParams struct {
+	ctx    context.Context
+	export mm_dataexport.Export
+}
+
+// RepositoryMockCreateParamPtrs contains pointers to parameters of the Repository.Create
+type RepositoryMockCreateParamPtrs struct {
+	ctx    *context.Context
+	export *mm_dataexport.Export
+}
+
+// RepositoryMockCreateResults contains results of the Repository.Create
+type RepositoryMockCreateResults struct {
+	err error
+}
+
+// RepositoryMockCreateOrigins contains origins of expectations of the Repository.Create
+type RepositoryMockCreateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originExport string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRepositoryMockCreate) Optional() *mRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for Repository.Create
+func (mmCreate *mRepositoryMockCreate) Expect(ctx context.Context, export mm_dataexport.Export) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RepositoryMockCreateParams{ctx, export}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Create
+func (mmCreate *mRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectExportParam2 sets up expected param export for Repository.Create
+func (mmCreate *mRepositoryMockCreate) ExpectExportParam2(export mm_dataexport.Export) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.export = &export
+	mmCreate.defaultExpectation.expectationOrigins.originExport = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Create
+func (mmCreate *mRepositoryMockCreate) Inspect(f func(ctx context.Context, export mm_dataexport.Export)) *mRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by Repository.Create
+func (mmCreate *mRepositoryMockCreate) Return(err error) *RepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the Repository.Create method
+func (mmCreate *mRepositoryMockCreate) Set(f func(ctx context.Context, export mm_dataexport.Export) (err error)) *RepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the Repository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the Repository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the Repository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRepositoryMockCreate) When(ctx context.Context, export mm_dataexport.Export) *RepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &RepositoryMockCreateParams{ctx, export},
+		expectationOrigins: RepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Create return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Create should be invoked
+func (mmCreate *mRepositoryMockCreate) Times(n uint64) *mRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_dataexport.Repository
+func (mmCreate *RepositoryMock) Create(ctx context.Context, export mm_dataexport.Export) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, export)
+	}
+
+	mm_params := RepositoryMockCreateParams{ctx, export}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateParams{ctx, export}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.export != nil && !minimock.Equal(*mm_want_ptrs.export, mm_got.export) {
+				mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameter export, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originExport, *mm_want_ptrs.export, mm_got.export, minimock.Diff(*mm_want_ptrs.export, mm_got.export))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, export)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RepositoryMock.Create. %v %v", ctx, export)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RepositoryMock.Create invocations
+func (mmCreate *RepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RepositoryMock.Create invocations
+func (mmCreate *RepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRepositoryMockCreate) Calls() []*RepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mRepositoryMockDeleteByUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteByUserExpectation
+	expectations       []*RepositoryMockDeleteByUserExpectation
+
+	callArgs []*RepositoryMockDeleteByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteByUserExpectation specifies expectation struct of the Repository.DeleteByUser
+type RepositoryMockDeleteByUserExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteByUserParams
+	paramPtrs          *RepositoryMockDeleteByUserParamPtrs
+	expectationOrigins RepositoryMockDeleteByUserExpectationOrigins
+	results            *RepositoryMockDeleteByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteByUserParams contains parameters of the Repository.DeleteByUser
+type RepositoryMockDeleteByUserParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// RepositoryMockDeleteByUserParamPtrs contains pointers to parameters of the Repository.DeleteByUser
+type RepositoryMockDeleteByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// RepositoryMockDeleteByUserResults contains results of the Repository.DeleteByUser
+type RepositoryMockDeleteByUserResults struct {
+	ua1 []uuid.UUID
+	err error
+}
+
+// RepositoryMockDeleteByUserOrigins contains origins of expectations of the Repository.DeleteByUser
+type RepositoryMockDeleteByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Optional() *mRepositoryMockDeleteByUser {
+	mmDeleteByUser.optional = true
+	return mmDeleteByUser
+}
+
+// Expect sets up expected params for Repository.DeleteByUser
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &RepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteByUser.defaultExpectation.params = &RepositoryMockDeleteByUserParams{ctx, userID}
+	mmDeleteByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteByUser.expectations {
+		if minimock.Equal(e.params, mmDeleteByUser.defaultExpectation.params) {
+			mmDeleteByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteByUser
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &RepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteByUser
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &RepositoryMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &RepositoryMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteByUser
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockDeleteByUser {
+	if mmDeleteByUser.mock.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteByUser")
+	}
+
+	mmDeleteByUser.mock.inspectFuncDeleteByUser = f
+
+	return mmDeleteByUser
+}
+
+// Return sets up results that will be returned by Repository.DeleteByUser
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Return(ua1 []uuid.UUID, err error) *RepositoryMock {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &RepositoryMockDeleteByUserExpectation{mock: mmDeleteByUser.mock}
+	}
+	mmDeleteByUser.defaultExpectation.results = &RepositoryMockDeleteByUserResults{ua1, err}
+	mmDeleteByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteByUser method
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []uuid.UUID, err error)) *RepositoryMock {
+	if mmDeleteByUser.defaultExpectation != nil {
+		mmDeleteByUser.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteByUser method")
+	}
+
+	if len(mmDeleteByUser.expectations) > 0 {
+		mmDeleteByUser.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteByUser method")
+	}
+
+	mmDeleteByUser.mock.funcDeleteByUser = f
+	mmDeleteByUser.mock.funcDeleteByUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// When sets expectation for the Repository.DeleteByUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) When(ctx context.Context, userID uuid.UUID) *RepositoryMockDeleteByUserExpectation {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("RepositoryMock.DeleteByUser mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteByUserExpectation{
+		mock:               mmDeleteByUser.mock,
+		params:             &RepositoryMockDeleteByUserParams{ctx, userID},
+		expectationOrigins: RepositoryMockDeleteByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteByUser.expectations = append(mmDeleteByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteByUser return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteByUserExpectation) Then(ua1 []uuid.UUID, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteByUserResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteByUser should be invoked
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Times(n uint64) *mRepositoryMockDeleteByUser {
+	if n == 0 {
+		mmDeleteByUser.mock.t.Fatalf("Times of RepositoryMock.DeleteByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteByUser.expectedInvocations, n)
+	mmDeleteByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser
+}
+
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) invocationsDone() bool {
+	if len(mmDeleteByUser.expectations) == 0 && mmDeleteByUser.defaultExpectation == nil && mmDeleteByUser.mock.funcDeleteByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.mock.afterDeleteByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteByUser implements mm_dataexport.Repository
+func (mmDeleteByUser *RepositoryMock) DeleteByUser(ctx context.Context, userID uuid.UUID) (ua1 []uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmDeleteByUser.beforeDeleteByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteByUser.afterDeleteByUserCounter, 1)
+
+	mmDeleteByUser.t.Helper()
+
+	if mmDeleteByUser.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.inspectFuncDeleteByUser(ctx, userID)
+	}
+
+	mm_params := RepositoryMockDeleteByUserParams{ctx, userID}
+
+	// Record call args
+	mmDeleteByUser.DeleteByUserMock.mutex.Lock()
+	mmDeleteByUser.DeleteByUserMock.callArgs = append(mmDeleteByUser.DeleteByUserMock.callArgs, &mm_params)
+	mmDeleteByUser.DeleteByUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteByUser.DeleteByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmDeleteByUser.DeleteByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteByUser.DeleteByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteByUser.DeleteByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteByUser.DeleteByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteByUser.t.Errorf("RepositoryMock.DeleteByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteByUser.t.Errorf("RepositoryMock.DeleteByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteByUser.t.Errorf("RepositoryMock.DeleteByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteByUser.DeleteByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteByUser.t.Fatal("No results are set for the RepositoryMock.DeleteByUser")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmDeleteByUser.funcDeleteByUser != nil {
+		return mmDeleteByUser.funcDeleteByUser(ctx, userID)
+	}
+	mmDeleteByUser.t.Fatalf("Unexpected call to RepositoryMock.DeleteByUser. %v %v", ctx, userID)
+	return
+}
+
+// DeleteByUserAfterCounter returns a count of finished RepositoryMock.DeleteByUser invocations
+func (mmDeleteByUser *RepositoryMock) DeleteByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.afterDeleteByUserCounter)
+}
+
+// DeleteByUserBeforeCounter returns a count of RepositoryMock.DeleteByUser invocations
+func (mmDeleteByUser *RepositoryMock) DeleteByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.beforeDeleteByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteByUser *mRepositoryMockDeleteByUser) Calls() []*RepositoryMockDeleteByUserParams {
+	mmDeleteByUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteByUserParams, len(mmDeleteByUser.callArgs))
+	copy(argCopy, mmDeleteByUser.callArgs)
+
+	mmDeleteByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteByUserDone returns true if the count of the DeleteByUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteByUserDone() bool {
+	if m.DeleteByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteByUserMock.invocationsDone()
+}
+
+// MinimockDeleteByUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteByUserInspect() {
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteByUserCounter := mm_atomic.LoadUint64(&m.afterDeleteByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteByUserMock.defaultExpectation != nil && afterDeleteByUserCounter < 1 {
+		if m.DeleteByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteByUser at\n%s", m.DeleteByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteByUser at\n%s with params: %#v", m.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteByUser != nil && afterDeleteByUserCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteByUser at\n%s", m.funcDeleteByUserOrigin)
+	}
+
+	if !m.DeleteByUserMock.invocationsDone() && afterDeleteByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteByUserMock.expectedInvocations), m.DeleteByUserMock.expectedInvocationsOrigin, afterDeleteByUserCounter)
+	}
+}
+
+type mRepositoryMockDeleteExpired struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteExpiredExpectation
+	expectations       []*RepositoryMockDeleteExpiredExpectation
+
+	callArgs []*RepositoryMockDeleteExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteExpiredExpectation specifies expectation struct of the Repository.DeleteExpired
+type RepositoryMockDeleteExpiredExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteExpiredParams
+	paramPtrs          *RepositoryMockDeleteExpiredParamPtrs
+	expectationOrigins RepositoryMockDeleteExpiredExpectationOrigins
+	results            *RepositoryMockDeleteExpiredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteExpiredParams contains parameters of the Repository.DeleteExpired
+type RepositoryMockDeleteExpiredParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// RepositoryMockDeleteExpiredParamPtrs contains pointers to parameters of the Repository.DeleteExpired
+type RepositoryMockDeleteExpiredParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// RepositoryMockDeleteExpiredResults contains results of the Repository.DeleteExpired
+type RepositoryMockDeleteExpiredResults struct {
+	ua1 []uuid.UUID
+	err error
+}
+
+// RepositoryMockDeleteExpiredOrigins contains origins of expectations of the Repository.DeleteExpired
+type RepositoryMockDeleteExpiredExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originNow string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Optional() *mRepositoryMockDeleteExpired {
+	mmDeleteExpired.optional = true
+	return mmDeleteExpired
+}
+
+// Expect sets up expected params for Repository.DeleteExpired
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Expect(ctx context.Context, now time.Time) *mRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpired.defaultExpectation.params = &RepositoryMockDeleteExpiredParams{ctx, now}
+	mmDeleteExpired.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpired.expectations {
+		if minimock.Equal(e.params, mmDeleteExpired.defaultExpectation.params) {
+			mmDeleteExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteExpired
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &RepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpired.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpired
+}
+
+// ExpectNowParam2 sets up expected param now for Repository.DeleteExpired
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) ExpectNowParam2(now time.Time) *mRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RepositoryMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &RepositoryMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.now = &now
+	mmDeleteExpired.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmDeleteExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteExpired
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Inspect(f func(ctx context.Context, now time.Time)) *mRepositoryMockDeleteExpired {
+	if mmDeleteExpired.mock.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteExpired")
+	}
+
+	mmDeleteExpired.mock.inspectFuncDeleteExpired = f
+
+	return mmDeleteExpired
+}
+
+// Return sets up results that will be returned by Repository.DeleteExpired
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Return(ua1 []uuid.UUID, err error) *RepositoryMock {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &RepositoryMockDeleteExpiredExpectation{mock: mmDeleteExpired.mock}
+	}
+	mmDeleteExpired.defaultExpectation.results = &RepositoryMockDeleteExpiredResults{ua1, err}
+	mmDeleteExpired.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteExpired method
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Set(f func(ctx context.Context, now time.Time) (ua1 []uuid.UUID, err error)) *RepositoryMock {
+	if mmDeleteExpired.defaultExpectation != nil {
+		mmDeleteExpired.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteExpired method")
+	}
+
+	if len(mmDeleteExpired.expectations) > 0 {
+		mmDeleteExpired.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteExpired method")
+	}
+
+	mmDeleteExpired.mock.funcDeleteExpired = f
+	mmDeleteExpired.mock.funcDeleteExpiredOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired.mock
+}
+
+// When sets expectation for the Repository.DeleteExpired which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) When(ctx context.Context, now time.Time) *RepositoryMockDeleteExpiredExpectation {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("RepositoryMock.DeleteExpired mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteExpiredExpectation{
+		mock:               mmDeleteExpired.mock,
+		params:             &RepositoryMockDeleteExpiredParams{ctx, now},
+		expectationOrigins: RepositoryMockDeleteExpiredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpired.expectations = append(mmDeleteExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteExpired return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteExpiredExpectation) Then(ua1 []uuid.UUID, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteExpiredResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteExpired should be invoked
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Times(n uint64) *mRepositoryMockDeleteExpired {
+	if n == 0 {
+		mmDeleteExpired.mock.t.Fatalf("Times of RepositoryMock.DeleteExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpired.expectedInvocations, n)
+	mmDeleteExpired.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired
+}
+
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) invocationsDone() bool {
+	if len(mmDeleteExpired.expectations) == 0 && mmDeleteExpired.defaultExpectation == nil && mmDeleteExpired.mock.funcDeleteExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.mock.afterDeleteExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpired implements mm_dataexport.Repository
+func (mmDeleteExpired *RepositoryMock) DeleteExpired(ctx context.Context, now time.Time) (ua1 []uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpired.beforeDeleteExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpired.afterDeleteExpiredCounter, 1)
+
+	mmDeleteExpired.t.Helper()
+
+	if mmDeleteExpired.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.inspectFuncDeleteExpired(ctx, now)
+	}
+
+	mm_params := RepositoryMockDeleteExpiredParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpired.DeleteExpiredMock.mutex.Lock()
+	mmDeleteExpired.DeleteExpiredMock.callArgs = append(mmDeleteExpired.DeleteExpiredMock.callArgs, &mm_params)
+	mmDeleteExpired.DeleteExpiredMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpired.DeleteExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmDeleteExpired.DeleteExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpired.DeleteExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteExpiredParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpired.t.Errorf("RepositoryMock.DeleteExpired got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpired.DeleteExpiredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmDeleteExpired.t.Errorf("RepositoryMock.DeleteExpired got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpired.DeleteExpiredMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpired.t.Errorf("RepositoryMock.DeleteExpired got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpired.DeleteExpiredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpired.t.Fatal("No results are set for the RepositoryMock.DeleteExpired")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmDeleteExpired.funcDeleteExpired != nil {
+		return mmDeleteExpired.funcDeleteExpired(ctx, now)
+	}
+	mmDeleteExpired.t.Fatalf("Unexpected call to RepositoryMock.DeleteExpired. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredAfterCounter returns a count of finished RepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *RepositoryMock) DeleteExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.afterDeleteExpiredCounter)
+}
+
+// DeleteExpiredBeforeCounter returns a count of RepositoryMock.DeleteExpired invocations
+func (mmDeleteExpired *RepositoryMock) DeleteExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.beforeDeleteExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpired *mRepositoryMockDeleteExpired) Calls() []*RepositoryMockDeleteExpiredParams {
+	mmDeleteExpired.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteExpiredParams, len(mmDeleteExpired.callArgs))
+	copy(argCopy, mmDeleteExpired.callArgs)
+
+	mmDeleteExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredDone returns true if the count of the DeleteExpired invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteExpiredDone() bool {
+	if m.DeleteExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteExpiredInspect() {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteExpired at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && afterDeleteExpiredCounter < 1 {
+		if m.DeleteExpiredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteExpired at\n%s", m.DeleteExpiredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteExpired at\n%s with params: %#v", m.DeleteExpiredMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && afterDeleteExpiredCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteExpired at\n%s", m.funcDeleteExpiredOrigin)
+	}
+
+	if !m.DeleteExpiredMock.invocationsDone() && afterDeleteExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteExpired at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredMock.expectedInvocations), m.DeleteExpiredMock.expectedInvocationsOrigin, afterDeleteExpiredCounter)
+	}
+}
+
+type mRepositoryMockFinish struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockFinishExpectation
+	expectations       []*RepositoryMockFinishExpectation
+
+	callArgs []*RepositoryMockFinishParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockFinishExpectation specifies expectation struct of the Repository.Finish
+type RepositoryMockFinishExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockFinishParams
+	paramPtrs          *RepositoryMockFinishParamPtrs
+	expectationOrigins RepositoryMockFinishExpectationOrigins
+	results            *RepositoryMockFinishResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockFinishParams contains parameters of the Repository.Finish
+type RepositoryMockFinishParams struct {
+	ctx         context.Context
+	id          uuid.UUID
+	status      mm_dataexport.Status
+	completedAt time.Time
+	expiresAt   time.Time
+}
+
+// RepositoryMockFinishParamPtrs contains pointers to parameters of the Repository.Finish
+type RepositoryMockFinishParamPtrs struct {
+	ctx         *context.Context
+	id          *uuid.UUID
+	status      *mm_dataexport.Status
+	completedAt *time.Time
+	expiresAt   *time.Time
+}
+
+// RepositoryMockFinishResults contains results of the Repository.Finish
+type RepositoryMockFinishResults struct {
+	err error
+}
+
+// RepositoryMockFinishOrigins contains origins of expectations of the Repository.Finish
+type RepositoryMockFinishExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originId          string
+	originStatus      string
+	originCompletedAt string
+	originExpiresAt   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinish *mRepositoryMockFinish) Optional() *mRepositoryMockFinish {
+	mmFinish.optional = true
+	return mmFinish
+}
+
+// Expect sets up expected params for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) Expect(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by ExpectParams functions")
+	}
+
+	mmFinish.defaultExpectation.params = &RepositoryMockFinishParams{ctx, id, status, completedAt, expiresAt}
+	mmFinish.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinish.expectations {
+		if minimock.Equal(e.params, mmFinish.defaultExpectation.params) {
+			mmFinish.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinish.defaultExpectation.params)
+		}
+	}
+
+	return mmFinish
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) ExpectCtxParam1(ctx context.Context) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.params != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Expect")
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs == nil {
+		mmFinish.defaultExpectation.paramPtrs = &RepositoryMockFinishParamPtrs{}
+	}
+	mmFinish.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinish.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinish
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) ExpectIdParam2(id uuid.UUID) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.params != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Expect")
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs == nil {
+		mmFinish.defaultExpectation.paramPtrs = &RepositoryMockFinishParamPtrs{}
+	}
+	mmFinish.defaultExpectation.paramPtrs.id = &id
+	mmFinish.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFinish
+}
+
+// ExpectStatusParam3 sets up expected param status for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) ExpectStatusParam3(status mm_dataexport.Status) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.params != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Expect")
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs == nil {
+		mmFinish.defaultExpectation.paramPtrs = &RepositoryMockFinishParamPtrs{}
+	}
+	mmFinish.defaultExpectation.paramPtrs.status = &status
+	mmFinish.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmFinish
+}
+
+// ExpectCompletedAtParam4 sets up expected param completedAt for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) ExpectCompletedAtParam4(completedAt time.Time) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.params != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Expect")
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs == nil {
+		mmFinish.defaultExpectation.paramPtrs = &RepositoryMockFinishParamPtrs{}
+	}
+	mmFinish.defaultExpectation.paramPtrs.completedAt = &completedAt
+	mmFinish.defaultExpectation.expectationOrigins.originCompletedAt = minimock.CallerInfo(1)
+
+	return mmFinish
+}
+
+// ExpectExpiresAtParam5 sets up expected param expiresAt for Repository.Finish
+func (mmFinish *mRepositoryMockFinish) ExpectExpiresAtParam5(expiresAt time.Time) *mRepositoryMockFinish {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{}
+	}
+
+	if mmFinish.defaultExpectation.params != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Expect")
+	}
+
+	if mmFinish.defaultExpectation.paramPtrs == nil {
+		mmFinish.defaultExpectation.paramPtrs = &RepositoryMockFinishParamPtrs{}
+	}
+	mmFinish.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmFinish.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmFinish
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Finish
+func (mmFinish *mRepositoryMockFinish) Inspect(f func(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time)) *mRepositoryMockFinish {
+	if mmFinish.mock.inspectFuncFinish != nil {
+		mmFinish.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Finish")
+	}
+
+	mmFinish.mock.inspectFuncFinish = f
+
+	return mmFinish
+}
+
+// Return sets up results that will be returned by Repository.Finish
+func (mmFinish *mRepositoryMockFinish) Return(err error) *RepositoryMock {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	if mmFinish.defaultExpectation == nil {
+		mmFinish.defaultExpectation = &RepositoryMockFinishExpectation{mock: mmFinish.mock}
+	}
+	mmFinish.defaultExpectation.results = &RepositoryMockFinishResults{err}
+	mmFinish.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinish.mock
+}
+
+// Set uses given function f to mock the Repository.Finish method
+func (mmFinish *mRepositoryMockFinish) Set(f func(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time) (err error)) *RepositoryMock {
+	if mmFinish.defaultExpectation != nil {
+		mmFinish.mock.t.Fatalf("Default expectation is already set for the Repository.Finish method")
+	}
+
+	if len(mmFinish.expectations) > 0 {
+		mmFinish.mock.t.Fatalf("Some expectations are already set for the Repository.Finish method")
+	}
+
+	mmFinish.mock.funcFinish = f
+	mmFinish.mock.funcFinishOrigin = minimock.CallerInfo(1)
+	return mmFinish.mock
+}
+
+// When sets expectation for the Repository.Finish which will trigger the result defined by the following
+// Then helper
+func (mmFinish *mRepositoryMockFinish) When(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time) *RepositoryMockFinishExpectation {
+	if mmFinish.mock.funcFinish != nil {
+		mmFinish.mock.t.Fatalf("RepositoryMock.Finish mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockFinishExpectation{
+		mock:               mmFinish.mock,
+		params:             &RepositoryMockFinishParams{ctx, id, status, completedAt, expiresAt},
+		expectationOrigins: RepositoryMockFinishExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinish.expectations = append(mmFinish.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Finish return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockFinishExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockFinishResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Finish should be invoked
+func (mmFinish *mRepositoryMockFinish) Times(n uint64) *mRepositoryMockFinish {
+	if n == 0 {
+		mmFinish.mock.t.Fatalf("Times of RepositoryMock.Finish mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinish.expectedInvocations, n)
+	mmFinish.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinish
+}
+
+func (mmFinish *mRepositoryMockFinish) invocationsDone() bool {
+	if len(mmFinish.expectations) == 0 && mmFinish.defaultExpectation == nil && mmFinish.mock.funcFinish == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinish.mock.afterFinishCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinish.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Finish implements mm_dataexport.Repository
+func (mmFinish *RepositoryMock) Finish(ctx context.Context, id uuid.UUID, status mm_dataexport.Status, completedAt time.Time, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmFinish.beforeFinishCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinish.afterFinishCounter, 1)
+
+	mmFinish.t.Helper()
+
+	if mmFinish.inspectFuncFinish != nil {
+		mmFinish.inspectFuncFinish(ctx, id, status, completedAt, expiresAt)
+	}
+
+	mm_params := RepositoryMockFinishParams{ctx, id, status, completedAt, expiresAt}
+
+	// Record call args
+	mmFinish.FinishMock.mutex.Lock()
+	mmFinish.FinishMock.callArgs = append(mmFinish.FinishMock.callArgs, &mm_params)
+	mmFinish.FinishMock.mutex.Unlock()
+
+	for _, e := range mmFinish.FinishMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFinish.FinishMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinish.FinishMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinish.FinishMock.defaultExpectation.params
+		mm_want_ptrs := mmFinish.FinishMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockFinishParams{ctx, id, status, completedAt, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinish.FinishMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinish.FinishMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinish.FinishMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.completedAt != nil && !minimock.Equal(*mm_want_ptrs.completedAt, mm_got.completedAt) {
+				mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameter completedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinish.FinishMock.defaultExpectation.expectationOrigins.originCompletedAt, *mm_want_ptrs.completedAt, mm_got.completedAt, minimock.Diff(*mm_want_ptrs.completedAt, mm_got.completedAt))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinish.FinishMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinish.t.Errorf("RepositoryMock.Finish got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinish.FinishMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinish.FinishMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinish.t.Fatal("No results are set for the RepositoryMock.Finish")
+		}
+		return (*mm_results).err
+	}
+	if mmFinish.funcFinish != nil {
+		return mmFinish.funcFinish(ctx, id, status, completedAt, expiresAt)
+	}
+	mmFinish.t.Fatalf("Unexpected call to RepositoryMock.Finish. %v %v %v %v %v", ctx, id, status, completedAt, expiresAt)
+	return
+}
+
+// FinishAfterCounter returns a count of finished RepositoryMock.Finish invocations
+func (mmFinish *RepositoryMock) FinishAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinish.afterFinishCounter)
+}
+
+// FinishBeforeCounter returns a count of RepositoryMock.Finish invocations
+func (mmFinish *RepositoryMock) FinishBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinish.beforeFinishCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Finish.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinish *mRepositoryMockFinish) Calls() []*RepositoryMockFinishParams {
+	mmFinish.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockFinishParams, len(mmFinish.callArgs))
+	copy(argCopy, mmFinish.callArgs)
+
+	mmFinish.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishDone returns true if the count of the Finish invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockFinishDone() bool {
+	if m.FinishMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishMock.invocationsDone()
+}
+
+// MinimockFinishInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockFinishInspect() {
+	for _, e := range m.FinishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Finish at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishCounter := mm_atomic.LoadUint64(&m.afterFinishCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishMock.defaultExpectation != nil && afterFinishCounter < 1 {
+		if m.FinishMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Finish at\n%s", m.FinishMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Finish at\n%s with params: %#v", m.FinishMock.defaultExpectation.expectationOrigins.origin, *m.FinishMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinish != nil && afterFinishCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Finish at\n%s", m.funcFinishOrigin)
+	}
+
+	if !m.FinishMock.invocationsDone() && afterFinishCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Finish at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishMock.expectedInvocations), m.FinishMock.expectedInvocationsOrigin, afterFinishCounter)
+	}
+}
+
+type mRepositoryMockGetLatest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetLatestExpectation
+	expectations       []*RepositoryMockGetLatestExpectation
+
+	callArgs []*RepositoryMockGetLatestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetLatestExpectation specifies expectation struct of the Repository.GetLatest
+type RepositoryMockGetLatestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetLatestParams
+	paramPtrs          *RepositoryMockGetLatestParamPtrs
+	expectationOrigins RepositoryMockGetLatestExpectationOrigins
+	results            *RepositoryMockGetLatestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetLatestParams contains parameters of the Repository.GetLatest
+type RepositoryMockGetLatestParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// RepositoryMockGetLatestParamPtrs contains pointers to parameters of the Repository.GetLatest
+type RepositoryMockGetLatestParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// RepositoryMockGetLatestResults contains results of the Repository.GetLatest
+type RepositoryMockGetLatestResults struct {
+	e1  mm_dataexport.Export
+	err error
+}
+
+// RepositoryMockGetLatestOrigins contains origins of expectations of the Repository.GetLatest
+type RepositoryMockGetLatestExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLatest *mRepositoryMockGetLatest) Optional() *mRepositoryMockGetLatest {
+	mmGetLatest.optional = true
+	return mmGetLatest
+}
+
+// Expect sets up expected params for Repository.GetLatest
+func (mmGetLatest *mRepositoryMockGetLatest) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockGetLatest {
+	if mmGetLatest.mock.funcGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Set")
+	}
+
+	if mmGetLatest.defaultExpectation == nil {
+		mmGetLatest.defaultExpectation = &RepositoryMockGetLatestExpectation{}
+	}
+
+	if mmGetLatest.defaultExpectation.paramPtrs != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by ExpectParams functions")
+	}
+
+	mmGetLatest.defaultExpectation.params = &RepositoryMockGetLatestParams{ctx, userID}
+	mmGetLatest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLatest.expectations {
+		if minimock.Equal(e.params, mmGetLatest.defaultExpectation.params) {
+			mmGetLatest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLatest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLatest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetLatest
+func (mmGetLatest *mRepositoryMockGetLatest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetLatest {
+	if mmGetLatest.mock.funcGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Set")
+	}
+
+	if mmGetLatest.defaultExpectation == nil {
+		mmGetLatest.defaultExpectation = &RepositoryMockGetLatestExpectation{}
+	}
+
+	if mmGetLatest.defaultExpectation.params != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Expect")
+	}
+
+	if mmGetLatest.defaultExpectation.paramPtrs == nil {
+		mmGetLatest.defaultExpectation.paramPtrs = &RepositoryMockGetLatestParamPtrs{}
+	}
+	mmGetLatest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLatest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLatest
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetLatest
+func (mmGetLatest *mRepositoryMockGetLatest) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockGetLatest {
+	if mmGetLatest.mock.funcGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Set")
+	}
+
+	if mmGetLatest.defaultExpectation == nil {
+		mmGetLatest.defaultExpectation = &RepositoryMockGetLatestExpectation{}
+	}
+
+	if mmGetLatest.defaultExpectation.params != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Expect")
+	}
+
+	if mmGetLatest.defaultExpectation.paramPtrs == nil {
+		mmGetLatest.defaultExpectation.paramPtrs = &RepositoryMockGetLatestParamPtrs{}
+	}
+	mmGetLatest.defaultExpectation.paramPtrs.userID = &userID
+	mmGetLatest.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetLatest
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetLatest
+func (mmGetLatest *mRepositoryMockGetLatest) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockGetLatest {
+	if mmGetLatest.mock.inspectFuncGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetLatest")
+	}
+
+	mmGetLatest.mock.inspectFuncGetLatest = f
+
+	return mmGetLatest
+}
+
+// Return sets up results that will be returned by Repository.GetLatest
+func (mmGetLatest *mRepositoryMockGetLatest) Return(e1 mm_dataexport.Export, err error) *RepositoryMock {
+	if mmGetLatest.mock.funcGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Set")
+	}
+
+	if mmGetLatest.defaultExpectation == nil {
+		mmGetLatest.defaultExpectation = &RepositoryMockGetLatestExpectation{mock: mmGetLatest.mock}
+	}
+	mmGetLatest.defaultExpectation.results = &RepositoryMockGetLatestResults{e1, err}
+	mmGetLatest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLatest.mock
+}
+
+// Set uses given function f to mock the Repository.GetLatest method
+func (mmGetLatest *mRepositoryMockGetLatest) Set(f func(ctx context.Context, userID uuid.UUID) (e1 mm_dataexport.Export, err error)) *RepositoryMock {
+	if mmGetLatest.defaultExpectation != nil {
+		mmGetLatest.mock.t.Fatalf("Default expectation is already set for the Repository.GetLatest method")
+	}
+
+	if len(mmGetLatest.expectations) > 0 {
+		mmGetLatest.mock.t.Fatalf("Some expectations are already set for the Repository.GetLatest method")
+	}
+
+	mmGetLatest.mock.funcGetLatest = f
+	mmGetLatest.mock.funcGetLatestOrigin = minimock.CallerInfo(1)
+	return mmGetLatest.mock
+}
+
+// When sets expectation for the Repository.GetLatest which will trigger the result defined by the following
+// Then helper
+func (mmGetLatest *mRepositoryMockGetLatest) When(ctx context.Context, userID uuid.UUID) *RepositoryMockGetLatestExpectation {
+	if mmGetLatest.mock.funcGetLatest != nil {
+		mmGetLatest.mock.t.Fatalf("RepositoryMock.GetLatest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetLatestExpectation{
+		mock:               mmGetLatest.mock,
+		params:             &RepositoryMockGetLatestParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetLatestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLatest.expectations = append(mmGetLatest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetLatest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetLatestExpectation) Then(e1 mm_dataexport.Export, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetLatestResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetLatest should be invoked
+func (mmGetLatest *mRepositoryMockGetLatest) Times(n uint64) *mRepositoryMockGetLatest {
+	if n == 0 {
+		mmGetLatest.mock.t.Fatalf("Times of RepositoryMock.GetLatest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLatest.expectedInvocations, n)
+	mmGetLatest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLatest
+}
+
+func (mmGetLatest *mRepositoryMockGetLatest) invocationsDone() bool {
+	if len(mmGetLatest.expectations) == 0 && mmGetLatest.defaultExpectation == nil && mmGetLatest.mock.funcGetLatest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLatest.mock.afterGetLatestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLatest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLatest implements mm_dataexport.Repository
+func (mmGetLatest *RepositoryMock) GetLatest(ctx context.Context, userID uuid.UUID) (e1 mm_dataexport.Export, err error) {
+	mm_atomic.AddUint64(&mmGetLatest.beforeGetLatestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLatest.afterGetLatestCounter, 1)
+
+	mmGetLatest.t.Helper()
+
+	if mmGetLatest.inspectFuncGetLatest != nil {
+		mmGetLatest.inspectFuncGetLatest(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetLatestParams{ctx, userID}
+
+	// Record call args
+	mmGetLatest.GetLatestMock.mutex.Lock()
+	mmGetLatest.GetLatestMock.callArgs = append(mmGetLatest.GetLatestMock.callArgs, &mm_params)
+	mmGetLatest.GetLatestMock.mutex.Unlock()
+
+	for _, e := range mmGetLatest.GetLatestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmGetLatest.GetLatestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLatest.GetLatestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLatest.GetLatestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLatest.GetLatestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetLatestParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLatest.t.Errorf("RepositoryMock.GetLatest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLatest.GetLatestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetLatest.t.Errorf("RepositoryMock.GetLatest got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLatest.GetLatestMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLatest.t.Errorf("RepositoryMock.GetLatest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLatest.GetLatestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLatest.GetLatestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLatest.t.Fatal("No results are set for the RepositoryMock.GetLatest")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmGetLatest.funcGetLatest != nil {
+		return mmGetLatest.funcGetLatest(ctx, userID)
+	}
+	mmGetLatest.t.Fatalf("Unexpected call to RepositoryMock.GetLatest. %v %v", ctx, userID)
+	return
+}
+
+// GetLatestAfterCounter returns a count of finished RepositoryMock.GetLatest invocations
+func (mmGetLatest *RepositoryMock) GetLatestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLatest.afterGetLatestCounter)
+}
+
+// GetLatestBeforeCounter returns a count of RepositoryMock.GetLatest invocations
+func (mmGetLatest *RepositoryMock) GetLatestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLatest.beforeGetLatestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetLatest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLatest *mRepositoryMockGetLatest) Calls() []*RepositoryMockGetLatestParams {
+	mmGetLatest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetLatestParams, len(mmGetLatest.callArgs))
+	copy(argCopy, mmGetLatest.callArgs)
+
+	mmGetLatest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLatestDone returns true if the count of the GetLatest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetLatestDone() bool {
+	if m.GetLatestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLatestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLatestMock.invocationsDone()
+}
+
+// MinimockGetLatestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetLatestInspect() {
+	for _, e := range m.GetLatestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetLatest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLatestCounter := mm_atomic.LoadUint64(&m.afterGetLatestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLatestMock.defaultExpectation != nil && afterGetLatestCounter < 1 {
+		if m.GetLatestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetLatest at\n%s", m.GetLatestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetLatest at\n%s with params: %#v", m.GetLatestMock.defaultExpectation.expectationOrigins.origin, *m.GetLatestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLatest != nil && afterGetLatestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetLatest at\n%s", m.funcGetLatestOrigin)
+	}
+
+	if !m.GetLatestMock.invocationsDone() && afterGetLatestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetLatest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLatestMock.expectedInvocations), m.GetLatestMock.expectedInvocationsOrigin, afterGetLatestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteByUserInspect()
+
+			m.MinimockDeleteExpiredInspect()
+
+			m.MinimockFinishInspect()
+
+			m.MinimockGetLatestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteByUserDone() &&
+		m.MinimockDeleteExpiredDone() &&
+		m.MinimockFinishDone() &&
+		m.MinimockGetLatestDone()
+}
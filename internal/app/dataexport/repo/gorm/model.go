@@ -0,0 +1,37 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/google/uuid"
+)
+
+type exportModel struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	UserID      uuid.UUID
+	RequestedBy *uuid.UUID
+	Status      dataexport.Status
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+func (m *exportModel) TableName() string {
+	return "user_data_exports"
+}
+
+func (m *exportModel) toDTO() dataexport.Export {
+	return dataexport.Export{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		RequestedBy: m.RequestedBy,
+		Status:      m.Status,
+		CreatedAt:   m.CreatedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		ExpiresAt:   m.ExpiresAt,
+		DownloadURL: dataexport.DownloadURL(m.UserID, m.Status),
+	}
+}
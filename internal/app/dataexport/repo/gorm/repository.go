@@ -0,0 +1,116 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+func (r *gormRepo) Create(ctx context.Context, export dataexport.Export) error {
+	model := exportModel{
+		ID:          export.ID,
+		UserID:      export.UserID,
+		RequestedBy: export.RequestedBy,
+		Status:      export.Status,
+		CreatedAt:   export.CreatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return fmt.Errorf("gormRepo.Create: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetLatest(ctx context.Context, userID uuid.UUID) (dataexport.Export, error) {
+	var model exportModel
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC, id DESC").First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = dataexport.ErrNotFound()
+		}
+		return dataexport.Export{}, fmt.Errorf("gormRepo.GetLatest: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+// Claim locks the candidate with SKIP LOCKED, so servers claiming at the same time take different exports.
+func (r *gormRepo) Claim(ctx context.Context, startedAt, staleBefore time.Time) (dataexport.Export, bool, error) {
+	var model exportModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND started_at < ?)", dataexport.StatusPending, dataexport.StatusRunning, staleBefore).
+			Order("created_at, id").First(&model).Error
+		if err != nil {
+			return err
+		}
+		model.Status = dataexport.StatusRunning
+		model.StartedAt = &startedAt
+
+		return tx.Model(&exportModel{}).Where("id = ?", model.ID).
+			Updates(map[string]any{"status": model.Status, "started_at": startedAt}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dataexport.Export{}, false, nil
+		}
+		return dataexport.Export{}, false, fmt.Errorf("gormRepo.Claim: %w", err)
+	}
+
+	return model.toDTO(), true, nil
+}
+
+func (r *gormRepo) Finish(ctx context.Context, id uuid.UUID, status dataexport.Status, completedAt, expiresAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&exportModel{}).Where("id = ? AND status = ?", id, dataexport.StatusRunning).
+		Updates(map[string]any{"status": status, "completed_at": completedAt, "expires_at": expiresAt})
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.Finish: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.Finish: %w", dataexport.ErrNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteExpired(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var models []exportModel
+	err := r.db.WithContext(ctx).Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("expires_at < ?", now).Delete(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.DeleteExpired: %w", err)
+	}
+
+	return lo.Map(models, func(m exportModel, _ int) uuid.UUID { return m.ID }), nil
+}
+
+// DeleteByUser joins the transaction in ctx, so the exports go with the rest of the user's data.
+func (r *gormRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	var models []exportModel
+	err := db.Conn(ctx, r.db).Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("user_id = ?", userID).Delete(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.DeleteByUser: %w", err)
+	}
+
+	return lo.Map(models, func(m exportModel, _ int) uuid.UUID { return m.ID }), nil
+}
@@ -0,0 +1,122 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestExports(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+	ctx := t.Context()
+
+	ann := createUser(t, gdb)
+	bob := createUser(t, gdb)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	_, err := repo.GetLatest(ctx, ann)
+	require.ErrorIs(t, err, dataexport.ErrNotFound())
+
+	first := dataexport.Export{ID: uuid.New(), UserID: ann, RequestedBy: &ann, Status: dataexport.StatusPending, CreatedAt: now.Add(-time.Hour)}
+	second := dataexport.Export{ID: uuid.New(), UserID: bob, Status: dataexport.StatusPending, CreatedAt: now}
+	require.NoError(t, repo.Create(ctx, first))
+	require.NoError(t, repo.Create(ctx, second))
+
+	got, err := repo.GetLatest(ctx, ann)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, got.ID)
+	require.Equal(t, &ann, got.RequestedBy)
+	require.Equal(t, dataexport.StatusPending, got.Status)
+
+	// the oldest pending export is claimed first, and only once
+	claimed, ok, err := repo.Claim(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, first.ID, claimed.ID)
+	require.Equal(t, dataexport.StatusRunning, claimed.Status)
+	claimed, ok, err = repo.Claim(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, second.ID, claimed.ID)
+	_, ok, err = repo.Claim(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// a running export is claimed again once it is stale
+	claimed, ok, err = repo.Claim(ctx, now.Add(2*time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, first.ID, claimed.ID)
+
+	require.NoError(t, repo.Finish(ctx, first.ID, dataexport.StatusReady, now, now.Add(time.Hour)))
+	require.ErrorIs(t, repo.Finish(ctx, first.ID, dataexport.StatusFailed, now, now), dataexport.ErrNotFound())
+	require.NoError(t, repo.Finish(ctx, second.ID, dataexport.StatusFailed, now, now.Add(-time.Minute)))
+
+	got, err = repo.GetLatest(ctx, ann)
+	require.NoError(t, err)
+	require.Equal(t, dataexport.StatusReady, got.Status)
+	require.True(t, now.Equal(*got.CompletedAt))
+	require.True(t, now.Add(time.Hour).Equal(*got.ExpiresAt))
+	require.Equal(t, "/api/v1/users/"+ann.String()+"/export/download", got.DownloadURL)
+
+	ids, err := repo.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{second.ID}, ids)
+	_, err = repo.GetLatest(ctx, bob)
+	require.ErrorIs(t, err, dataexport.ErrNotFound())
+
+	ids, err = repo.DeleteByUser(ctx, ann)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{first.ID}, ids)
+	_, err = repo.GetLatest(ctx, ann)
+	require.ErrorIs(t, err, dataexport.ErrNotFound())
+	ids, err = repo.DeleteByUser(ctx, ann)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetLatest(ctx, ann)
+	require.Error(t, err)
+	_, _, err = repo.Claim(ctx, now, now)
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, uid.String()+"@example.com", "Test", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
@@ -0,0 +1,115 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const URLParamUserID = "user_id"
+
+type Service interface {
+	Request(ctx context.Context, userID uuid.UUID) (dataexport.Export, error)
+	Download(ctx context.Context, userID uuid.UUID) (dataexport.Export, io.ReadCloser, error)
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("dataexport HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// Request godoc
+// @Summary      Export personal data
+// @Description  Returns the export of everything stored about the user: profile, sessions, role grants and the versions they authored. Without a current export a new one is requested and made in the background; poll until the status is ready, then fetch download_url. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Produce      json
+// @Param        user_id path string true "User ID"
+// @Success      200 {object} dataexport.Export "Ready"
+// @Success      202 {object} dataexport.Export "Pending or running"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/export [get]
+func (h *Handler) Request(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := parseUserID(w, r, "dataexport.Handler.Request")
+	if !ok {
+		return
+	}
+
+	export, err := h.svc.Request(ctx, userID)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	status := http.StatusAccepted
+	if export.Status == dataexport.StatusReady {
+		status = http.StatusOK
+	}
+	httpx.WriteJSON(ctx, w, status, export)
+}
+
+// Download godoc
+// @Summary      Download personal data export
+// @Description  Returns the ready export of the user as a zip of JSON files, described by manifest.json. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Produce      application/zip
+// @Param        user_id path string true "User ID"
+// @Success      200 {file} binary
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/export/download [get]
+func (h *Handler) Download(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := parseUserID(w, r, "dataexport.Handler.Download")
+	if !ok {
+		return
+	}
+
+	export, content, err := h.svc.Download(ctx, userID)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="data-export-`+export.ID.String()+`.zip"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, content); err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldExportID.String(), export.ID.String()).
+			Msg("dataexport.Handler.Download: failed to write response")
+	}
+}
+
+func parseUserID(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, bool) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(dataexport.FieldUserID.String(), idStr).
+			Msg(op + ": invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
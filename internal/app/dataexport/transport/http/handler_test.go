@@ -0,0 +1,159 @@
+package http_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	dataexport_http "github.com/66gu1/easygodocs/internal/app/dataexport/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/dataexport/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_Request(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	pending := dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusPending}
+	ready := dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusReady,
+		DownloadURL: dataexport.DownloadURL(userID, dataexport.StatusReady)}
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		want       dataexport.Export
+	}{
+		{
+			name:   "pending -> 202",
+			userID: userID.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.RequestMock.Expect(minimock.AnyContext, userID).Return(pending, nil)
+			},
+			wantStatus: http.StatusAccepted,
+			want:       pending,
+		},
+		{
+			name:   "ready -> 200",
+			userID: userID.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.RequestMock.Expect(minimock.AnyContext, userID).Return(ready, nil)
+			},
+			wantStatus: http.StatusOK,
+			want:       ready,
+		},
+		{
+			name:       "invalid user id -> 400",
+			userID:     "bad",
+			setup:      func(mock *mocks.ServiceMock) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "forbidden -> 403",
+			userID: userID.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.RequestMock.Expect(minimock.AnyContext, userID).Return(dataexport.Export{}, apperr.ErrForbidden())
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			tt.setup(svcMock)
+
+			h := dataexport_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Get("/users/{"+dataexport_http.URLParamUserID+"}/export", h.Request)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID+"/export", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantStatus >= http.StatusBadRequest {
+				return
+			}
+			var got dataexport.Export
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+			require.Equal(t, tt.want.ID, got.ID)
+			require.Equal(t, tt.want.Status, got.Status)
+			require.Equal(t, tt.want.DownloadURL, got.DownloadURL)
+		})
+	}
+}
+
+func TestHandler_Download(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	export := dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusReady}
+
+	tests := []struct {
+		name       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name: "ok",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DownloadMock.Expect(minimock.AnyContext, userID).Return(export, io.NopCloser(strings.NewReader("zip")), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "not ready -> 409",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DownloadMock.Expect(minimock.AnyContext, userID).Return(dataexport.Export{}, nil, dataexport.ErrNotReady())
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			tt.setup(svcMock)
+
+			h := dataexport_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Get("/users/{"+dataexport_http.URLParamUserID+"}/export/download", h.Download)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+userID.String()+"/export/download", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			require.Equal(t, "application/zip", res.Header.Get("Content-Type"))
+			require.Contains(t, res.Header.Get("Content-Disposition"), export.ID.String())
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, "zip", string(body))
+		})
+	}
+}
@@ -0,0 +1,777 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/dataexport/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDownload          func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error)
+	funcDownloadOrigin    string
+	inspectFuncDownload   func(ctx context.Context, userID uuid.UUID)
+	afterDownloadCounter  uint64
+	beforeDownloadCounter uint64
+	DownloadMock          mServiceMockDownload
+
+	funcRequest          func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, err error)
+	funcRequestOrigin    string
+	inspectFuncRequest   func(ctx context.Context, userID uuid.UUID)
+	afterRequestCounter  uint64
+	beforeRequestCounter uint64
+	RequestMock          mServiceMockRequest
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DownloadMock = mServiceMockDownload{mock: m}
+	m.DownloadMock.callArgs = []*ServiceMockDownloadParams{}
+
+	m.RequestMock = mServiceMockRequest{mock: m}
+	m.RequestMock.callArgs = []*ServiceMockRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockDownload struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDownloadExpectation
+	expectations       []*ServiceMockDownloadExpectation
+
+	callArgs []*ServiceMockDownloadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDownloadExpectation specifies expectation struct of the Service.Download
+type ServiceMockDownloadExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDownloadParams
+	paramPtrs          *ServiceMockDownloadParamPtrs
+	expectationOrigins ServiceMockDownloadExpectationOrigins
+	results            *ServiceMockDownloadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDownloadParams contains parameters of the Service.Download
+type ServiceMockDownloadParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// ServiceMockDownloadParamPtrs contains pointers to parameters of the Service.Download
+type ServiceMockDownloadParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// ServiceMockDownloadResults contains results of the Service.Download
+type ServiceMockDownloadResults struct {
+	e1  dataexport.Export
+	r1  io.ReadCloser
+	err error
+}
+
+// ServiceMockDownloadOrigins contains origins of expectations of the Service.Download
+type ServiceMockDownloadExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDownload *mServiceMockDownload) Optional() *mServiceMockDownload {
+	mmDownload.optional = true
+	return mmDownload
+}
+
+// Expect sets up expected params for Service.Download
+func (mmDownload *mServiceMockDownload) Expect(ctx context.Context, userID uuid.UUID) *mServiceMockDownload {
+	if mmDownload.mock.funcDownload != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Set")
+	}
+
+	if mmDownload.defaultExpectation == nil {
+		mmDownload.defaultExpectation = &ServiceMockDownloadExpectation{}
+	}
+
+	if mmDownload.defaultExpectation.paramPtrs != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by ExpectParams functions")
+	}
+
+	mmDownload.defaultExpectation.params = &ServiceMockDownloadParams{ctx, userID}
+	mmDownload.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDownload.expectations {
+		if minimock.Equal(e.params, mmDownload.defaultExpectation.params) {
+			mmDownload.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDownload.defaultExpectation.params)
+		}
+	}
+
+	return mmDownload
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Download
+func (mmDownload *mServiceMockDownload) ExpectCtxParam1(ctx context.Context) *mServiceMockDownload {
+	if mmDownload.mock.funcDownload != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Set")
+	}
+
+	if mmDownload.defaultExpectation == nil {
+		mmDownload.defaultExpectation = &ServiceMockDownloadExpectation{}
+	}
+
+	if mmDownload.defaultExpectation.params != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Expect")
+	}
+
+	if mmDownload.defaultExpectation.paramPtrs == nil {
+		mmDownload.defaultExpectation.paramPtrs = &ServiceMockDownloadParamPtrs{}
+	}
+	mmDownload.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDownload.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDownload
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.Download
+func (mmDownload *mServiceMockDownload) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockDownload {
+	if mmDownload.mock.funcDownload != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Set")
+	}
+
+	if mmDownload.defaultExpectation == nil {
+		mmDownload.defaultExpectation = &ServiceMockDownloadExpectation{}
+	}
+
+	if mmDownload.defaultExpectation.params != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Expect")
+	}
+
+	if mmDownload.defaultExpectation.paramPtrs == nil {
+		mmDownload.defaultExpectation.paramPtrs = &ServiceMockDownloadParamPtrs{}
+	}
+	mmDownload.defaultExpectation.paramPtrs.userID = &userID
+	mmDownload.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDownload
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Download
+func (mmDownload *mServiceMockDownload) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mServiceMockDownload {
+	if mmDownload.mock.inspectFuncDownload != nil {
+		mmDownload.mock.t.Fatalf("Inspect function is already set for ServiceMock.Download")
+	}
+
+	mmDownload.mock.inspectFuncDownload = f
+
+	return mmDownload
+}
+
+// Return sets up results that will be returned by Service.Download
+func (mmDownload *mServiceMockDownload) Return(e1 dataexport.Export, r1 io.ReadCloser, err error) *ServiceMock {
+	if mmDownload.mock.funcDownload != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Set")
+	}
+
+	if mmDownload.defaultExpectation == nil {
+		mmDownload.defaultExpectation = &ServiceMockDownloadExpectation{mock: mmDownload.mock}
+	}
+	mmDownload.defaultExpectation.results = &ServiceMockDownloadResults{e1, r1, err}
+	mmDownload.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDownload.mock
+}
+
+// Set uses given function f to mock the Service.Download method
+func (mmDownload *mServiceMockDownload) Set(f func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error)) *ServiceMock {
+	if mmDownload.defaultExpectation != nil {
+		mmDownload.mock.t.Fatalf("Default expectation is already set for the Service.Download method")
+	}
+
+	if len(mmDownload.expectations) > 0 {
+		mmDownload.mock.t.Fatalf("Some expectations are already set for the Service.Download method")
+	}
+
+	mmDownload.mock.funcDownload = f
+	mmDownload.mock.funcDownloadOrigin = minimock.CallerInfo(1)
+	return mmDownload.mock
+}
+
+// When sets expectation for the Service.Download which will trigger the result defined by the following
+// Then helper
+func (mmDownload *mServiceMockDownload) When(ctx context.Context, userID uuid.UUID) *ServiceMockDownloadExpectation {
+	if mmDownload.mock.funcDownload != nil {
+		mmDownload.mock.t.Fatalf("ServiceMock.Download mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDownloadExpectation{
+		mock:               mmDownload.mock,
+		params:             &ServiceMockDownloadParams{ctx, userID},
+		expectationOrigins: ServiceMockDownloadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDownload.expectations = append(mmDownload.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Download return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDownloadExpectation) Then(e1 dataexport.Export, r1 io.ReadCloser, err error) *ServiceMock {
+	e.results = &ServiceMockDownloadResults{e1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Download should be invoked
+func (mmDownload *mServiceMockDownload) Times(n uint64) *mServiceMockDownload {
+	if n == 0 {
+		mmDownload.mock.t.Fatalf("Times of ServiceMock.Download mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDownload.expectedInvocations, n)
+	mmDownload.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDownload
+}
+
+func (mmDownload *mServiceMockDownload) invocationsDone() bool {
+	if len(mmDownload.expectations) == 0 && mmDownload.defaultExpectation == nil && mmDownload.mock.funcDownload == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDownload.mock.afterDownloadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDownload.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Download implements mm_http.Service
+func (mmDownload *ServiceMock) Download(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmDownload.beforeDownloadCounter, 1)
+	defer mm_atomic.AddUint64(&mmDownload.afterDownloadCounter, 1)
+
+	mmDownload.t.Helper()
+
+	if mmDownload.inspectFuncDownload != nil {
+		mmDownload.inspectFuncDownload(ctx, userID)
+	}
+
+	mm_params := ServiceMockDownloadParams{ctx, userID}
+
+	// Record call args
+	mmDownload.DownloadMock.mutex.Lock()
+	mmDownload.DownloadMock.callArgs = append(mmDownload.DownloadMock.callArgs, &mm_params)
+	mmDownload.DownloadMock.mutex.Unlock()
+
+	for _, e := range mmDownload.DownloadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmDownload.DownloadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDownload.DownloadMock.defaultExpectation.Counter, 1)
+		mm_want := mmDownload.DownloadMock.defaultExpectation.params
+		mm_want_ptrs := mmDownload.DownloadMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDownloadParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDownload.t.Errorf("ServiceMock.Download got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDownload.DownloadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDownload.t.Errorf("ServiceMock.Download got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDownload.DownloadMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDownload.t.Errorf("ServiceMock.Download got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDownload.DownloadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDownload.DownloadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDownload.t.Fatal("No results are set for the ServiceMock.Download")
+		}
+		return (*mm_results).e1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmDownload.funcDownload != nil {
+		return mmDownload.funcDownload(ctx, userID)
+	}
+	mmDownload.t.Fatalf("Unexpected call to ServiceMock.Download. %v %v", ctx, userID)
+	return
+}
+
+// DownloadAfterCounter returns a count of finished ServiceMock.Download invocations
+func (mmDownload *ServiceMock) DownloadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDownload.afterDownloadCounter)
+}
+
+// DownloadBeforeCounter returns a count of ServiceMock.Download invocations
+func (mmDownload *ServiceMock) DownloadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDownload.beforeDownloadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Download.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDownload *mServiceMockDownload) Calls() []*ServiceMockDownloadParams {
+	mmDownload.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDownloadParams, len(mmDownload.callArgs))
+	copy(argCopy, mmDownload.callArgs)
+
+	mmDownload.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDownloadDone returns true if the count of the Download invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDownloadDone() bool {
+	if m.DownloadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DownloadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DownloadMock.invocationsDone()
+}
+
+// MinimockDownloadInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDownloadInspect() {
+	for _, e := range m.DownloadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Download at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDownloadCounter := mm_atomic.LoadUint64(&m.afterDownloadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DownloadMock.defaultExpectation != nil && afterDownloadCounter < 1 {
+		if m.DownloadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Download at\n%s", m.DownloadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Download at\n%s with params: %#v", m.DownloadMock.defaultExpectation.expectationOrigins.origin, *m.DownloadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDownload != nil && afterDownloadCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Download at\n%s", m.funcDownloadOrigin)
+	}
+
+	if !m.DownloadMock.invocationsDone() && afterDownloadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Download at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DownloadMock.expectedInvocations), m.DownloadMock.expectedInvocationsOrigin, afterDownloadCounter)
+	}
+}
+
+type mServiceMockRequest struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockRequestExpectation
+	expectations       []*ServiceMockRequestExpectation
+
+	callArgs []*ServiceMockRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockRequestExpectation specifies expectation struct of the Service.Request
+type ServiceMockRequestExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockRequestParams
+	paramPtrs          *ServiceMockRequestParamPtrs
+	expectationOrigins ServiceMockRequestExpectationOrigins
+	results            *ServiceMockRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockRequestParams contains parameters of the Service.Request
+type ServiceMockRequestParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// ServiceMockRequestParamPtrs contains pointers to parameters of the Service.Request
+type ServiceMockRequestParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// ServiceMockRequestResults contains results of the Service.Request
+type ServiceMockRequestResults struct {
+	e1  dataexport.Export
+	err error
+}
+
+// ServiceMockRequestOrigins contains origins of expectations of the Service.Request
+type ServiceMockRequestExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequest *mServiceMockRequest) Optional() *mServiceMockRequest {
+	mmRequest.optional = true
+	return mmRequest
+}
+
+// Expect sets up expected params for Service.Request
+func (mmRequest *mServiceMockRequest) Expect(ctx context.Context, userID uuid.UUID) *mServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &ServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by ExpectParams functions")
+	}
+
+	mmRequest.defaultExpectation.params = &ServiceMockRequestParams{ctx, userID}
+	mmRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequest.expectations {
+		if minimock.Equal(e.params, mmRequest.defaultExpectation.params) {
+			mmRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Request
+func (mmRequest *mServiceMockRequest) ExpectCtxParam1(ctx context.Context) *mServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &ServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &ServiceMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequest
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.Request
+func (mmRequest *mServiceMockRequest) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &ServiceMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &ServiceMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.userID = &userID
+	mmRequest.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Request
+func (mmRequest *mServiceMockRequest) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mServiceMockRequest {
+	if mmRequest.mock.inspectFuncRequest != nil {
+		mmRequest.mock.t.Fatalf("Inspect function is already set for ServiceMock.Request")
+	}
+
+	mmRequest.mock.inspectFuncRequest = f
+
+	return mmRequest
+}
+
+// Return sets up results that will be returned by Service.Request
+func (mmRequest *mServiceMockRequest) Return(e1 dataexport.Export, err error) *ServiceMock {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &ServiceMockRequestExpectation{mock: mmRequest.mock}
+	}
+	mmRequest.defaultExpectation.results = &ServiceMockRequestResults{e1, err}
+	mmRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequest.mock
+}
+
+// Set uses given function f to mock the Service.Request method
+func (mmRequest *mServiceMockRequest) Set(f func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, err error)) *ServiceMock {
+	if mmRequest.defaultExpectation != nil {
+		mmRequest.mock.t.Fatalf("Default expectation is already set for the Service.Request method")
+	}
+
+	if len(mmRequest.expectations) > 0 {
+		mmRequest.mock.t.Fatalf("Some expectations are already set for the Service.Request method")
+	}
+
+	mmRequest.mock.funcRequest = f
+	mmRequest.mock.funcRequestOrigin = minimock.CallerInfo(1)
+	return mmRequest.mock
+}
+
+// When sets expectation for the Service.Request which will trigger the result defined by the following
+// Then helper
+func (mmRequest *mServiceMockRequest) When(ctx context.Context, userID uuid.UUID) *ServiceMockRequestExpectation {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("ServiceMock.Request mock is already set by Set")
+	}
+
+	expectation := &ServiceMockRequestExpectation{
+		mock:               mmRequest.mock,
+		params:             &ServiceMockRequestParams{ctx, userID},
+		expectationOrigins: ServiceMockRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequest.expectations = append(mmRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Request return parameters for the expectation previously defined by the When method
+func (e *ServiceMockRequestExpectation) Then(e1 dataexport.Export, err error) *ServiceMock {
+	e.results = &ServiceMockRequestResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Request should be invoked
+func (mmRequest *mServiceMockRequest) Times(n uint64) *mServiceMockRequest {
+	if n == 0 {
+		mmRequest.mock.t.Fatalf("Times of ServiceMock.Request mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequest.expectedInvocations, n)
+	mmRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequest
+}
+
+func (mmRequest *mServiceMockRequest) invocationsDone() bool {
+	if len(mmRequest.expectations) == 0 && mmRequest.defaultExpectation == nil && mmRequest.mock.funcRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequest.mock.afterRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Request implements mm_http.Service
+func (mmRequest *ServiceMock) Request(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, err error) {
+	mm_atomic.AddUint64(&mmRequest.beforeRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequest.afterRequestCounter, 1)
+
+	mmRequest.t.Helper()
+
+	if mmRequest.inspectFuncRequest != nil {
+		mmRequest.inspectFuncRequest(ctx, userID)
+	}
+
+	mm_params := ServiceMockRequestParams{ctx, userID}
+
+	// Record call args
+	mmRequest.RequestMock.mutex.Lock()
+	mmRequest.RequestMock.callArgs = append(mmRequest.RequestMock.callArgs, &mm_params)
+	mmRequest.RequestMock.mutex.Unlock()
+
+	for _, e := range mmRequest.RequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmRequest.RequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequest.RequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequest.RequestMock.defaultExpectation.params
+		mm_want_ptrs := mmRequest.RequestMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockRequestParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequest.t.Errorf("ServiceMock.Request got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequest.RequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRequest.t.Errorf("ServiceMock.Request got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequest.RequestMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequest.t.Errorf("ServiceMock.Request got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequest.RequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequest.RequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequest.t.Fatal("No results are set for the ServiceMock.Request")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmRequest.funcRequest != nil {
+		return mmRequest.funcRequest(ctx, userID)
+	}
+	mmRequest.t.Fatalf("Unexpected call to ServiceMock.Request. %v %v", ctx, userID)
+	return
+}
+
+// RequestAfterCounter returns a count of finished ServiceMock.Request invocations
+func (mmRequest *ServiceMock) RequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.afterRequestCounter)
+}
+
+// RequestBeforeCounter returns a count of ServiceMock.Request invocations
+func (mmRequest *ServiceMock) RequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.beforeRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Request.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequest *mServiceMockRequest) Calls() []*ServiceMockRequestParams {
+	mmRequest.mutex.RLock()
+
+	argCopy := make([]*ServiceMockRequestParams, len(mmRequest.callArgs))
+	copy(argCopy, mmRequest.callArgs)
+
+	mmRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestDone returns true if the count of the Request invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockRequestDone() bool {
+	if m.RequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestMock.invocationsDone()
+}
+
+// MinimockRequestInspect logs each unmet expectation
+func (m *ServiceMock) MinimockRequestInspect() {
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Request at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestCounter := mm_atomic.LoadUint64(&m.afterRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestMock.defaultExpectation != nil && afterRequestCounter < 1 {
+		if m.RequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Request at\n%s", m.RequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Request at\n%s with params: %#v", m.RequestMock.defaultExpectation.expectationOrigins.origin, *m.RequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequest != nil && afterRequestCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Request at\n%s", m.funcRequestOrigin)
+	}
+
+	if !m.RequestMock.invocationsDone() && afterRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Request at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestMock.expectedInvocations), m.RequestMock.expectedInvocationsOrigin, afterRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDownloadInspect()
+
+			m.MinimockRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDownloadDone() &&
+		m.MinimockRequestDone()
+}
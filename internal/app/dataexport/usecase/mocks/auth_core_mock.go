@@ -0,0 +1,1130 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/dataexport/usecase.AuthCore -o auth_core_mock.go -n AuthCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// AuthCoreMock implements mm_usecase.AuthCore
+type AuthCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckSelfOrAdmin          func(ctx context.Context, targetUserID uuid.UUID) (err error)
+	funcCheckSelfOrAdminOrigin    string
+	inspectFuncCheckSelfOrAdmin   func(ctx context.Context, targetUserID uuid.UUID)
+	afterCheckSelfOrAdminCounter  uint64
+	beforeCheckSelfOrAdminCounter uint64
+	CheckSelfOrAdminMock          mAuthCoreMockCheckSelfOrAdmin
+
+	funcGetSessionsByUserID          func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)
+	funcGetSessionsByUserIDOrigin    string
+	inspectFuncGetSessionsByUserID   func(ctx context.Context, userID uuid.UUID)
+	afterGetSessionsByUserIDCounter  uint64
+	beforeGetSessionsByUserIDCounter uint64
+	GetSessionsByUserIDMock          mAuthCoreMockGetSessionsByUserID
+
+	funcListUserRoles          func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)
+	funcListUserRolesOrigin    string
+	inspectFuncListUserRoles   func(ctx context.Context, userID uuid.UUID)
+	afterListUserRolesCounter  uint64
+	beforeListUserRolesCounter uint64
+	ListUserRolesMock          mAuthCoreMockListUserRoles
+}
+
+// NewAuthCoreMock returns a mock for mm_usecase.AuthCore
+func NewAuthCoreMock(t minimock.Tester) *AuthCoreMock {
+	m := &AuthCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckSelfOrAdminMock = mAuthCoreMockCheckSelfOrAdmin{mock: m}
+	m.CheckSelfOrAdminMock.callArgs = []*AuthCoreMockCheckSelfOrAdminParams{}
+
+	m.GetSessionsByUserIDMock = mAuthCoreMockGetSessionsByUserID{mock: m}
+	m.GetSessionsByUserIDMock.callArgs = []*AuthCoreMockGetSessionsByUserIDParams{}
+
+	m.ListUserRolesMock = mAuthCoreMockListUserRoles{mock: m}
+	m.ListUserRolesMock.callArgs = []*AuthCoreMockListUserRolesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthCoreMockCheckSelfOrAdmin struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockCheckSelfOrAdminExpectation
+	expectations       []*AuthCoreMockCheckSelfOrAdminExpectation
+
+	callArgs []*AuthCoreMockCheckSelfOrAdminParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockCheckSelfOrAdminExpectation specifies expectation struct of the AuthCore.CheckSelfOrAdmin
+type AuthCoreMockCheckSelfOrAdminExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockCheckSelfOrAdminParams
+	paramPtrs          *AuthCoreMockCheckSelfOrAdminParamPtrs
+	expectationOrigins AuthCoreMockCheckSelfOrAdminExpectationOrigins
+	results            *AuthCoreMockCheckSelfOrAdminResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockCheckSelfOrAdminParams contains parameters of the AuthCore.CheckSelfOrAdmin
+type AuthCoreMockCheckSelfOrAdminParams struct {
+	ctx          context.Context
+	targetUserID uuid.UUID
+}
+
+// AuthCoreMockCheckSelfOrAdminParamPtrs contains pointers to parameters of the AuthCore.CheckSelfOrAdmin
+type AuthCoreMockCheckSelfOrAdminParamPtrs struct {
+	ctx          *context.Context
+	targetUserID *uuid.UUID
+}
+
+// AuthCoreMockCheckSelfOrAdminResults contains results of the AuthCore.CheckSelfOrAdmin
+type AuthCoreMockCheckSelfOrAdminResults struct {
+	err error
+}
+
+// AuthCoreMockCheckSelfOrAdminOrigins contains origins of expectations of the AuthCore.CheckSelfOrAdmin
+type AuthCoreMockCheckSelfOrAdminExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originTargetUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Optional() *mAuthCoreMockCheckSelfOrAdmin {
+	mmCheckSelfOrAdmin.optional = true
+	return mmCheckSelfOrAdmin
+}
+
+// Expect sets up expected params for AuthCore.CheckSelfOrAdmin
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Expect(ctx context.Context, targetUserID uuid.UUID) *mAuthCoreMockCheckSelfOrAdmin {
+	if mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Set")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation == nil {
+		mmCheckSelfOrAdmin.defaultExpectation = &AuthCoreMockCheckSelfOrAdminExpectation{}
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation.paramPtrs != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by ExpectParams functions")
+	}
+
+	mmCheckSelfOrAdmin.defaultExpectation.params = &AuthCoreMockCheckSelfOrAdminParams{ctx, targetUserID}
+	mmCheckSelfOrAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckSelfOrAdmin.expectations {
+		if minimock.Equal(e.params, mmCheckSelfOrAdmin.defaultExpectation.params) {
+			mmCheckSelfOrAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckSelfOrAdmin.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckSelfOrAdmin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthCore.CheckSelfOrAdmin
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockCheckSelfOrAdmin {
+	if mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Set")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation == nil {
+		mmCheckSelfOrAdmin.defaultExpectation = &AuthCoreMockCheckSelfOrAdminExpectation{}
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation.params != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Expect")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation.paramPtrs == nil {
+		mmCheckSelfOrAdmin.defaultExpectation.paramPtrs = &AuthCoreMockCheckSelfOrAdminParamPtrs{}
+	}
+	mmCheckSelfOrAdmin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckSelfOrAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckSelfOrAdmin
+}
+
+// ExpectTargetUserIDParam2 sets up expected param targetUserID for AuthCore.CheckSelfOrAdmin
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) ExpectTargetUserIDParam2(targetUserID uuid.UUID) *mAuthCoreMockCheckSelfOrAdmin {
+	if mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Set")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation == nil {
+		mmCheckSelfOrAdmin.defaultExpectation = &AuthCoreMockCheckSelfOrAdminExpectation{}
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation.params != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Expect")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation.paramPtrs == nil {
+		mmCheckSelfOrAdmin.defaultExpectation.paramPtrs = &AuthCoreMockCheckSelfOrAdminParamPtrs{}
+	}
+	mmCheckSelfOrAdmin.defaultExpectation.paramPtrs.targetUserID = &targetUserID
+	mmCheckSelfOrAdmin.defaultExpectation.expectationOrigins.originTargetUserID = minimock.CallerInfo(1)
+
+	return mmCheckSelfOrAdmin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthCore.CheckSelfOrAdmin
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Inspect(f func(ctx context.Context, targetUserID uuid.UUID)) *mAuthCoreMockCheckSelfOrAdmin {
+	if mmCheckSelfOrAdmin.mock.inspectFuncCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.CheckSelfOrAdmin")
+	}
+
+	mmCheckSelfOrAdmin.mock.inspectFuncCheckSelfOrAdmin = f
+
+	return mmCheckSelfOrAdmin
+}
+
+// Return sets up results that will be returned by AuthCore.CheckSelfOrAdmin
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Return(err error) *AuthCoreMock {
+	if mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Set")
+	}
+
+	if mmCheckSelfOrAdmin.defaultExpectation == nil {
+		mmCheckSelfOrAdmin.defaultExpectation = &AuthCoreMockCheckSelfOrAdminExpectation{mock: mmCheckSelfOrAdmin.mock}
+	}
+	mmCheckSelfOrAdmin.defaultExpectation.results = &AuthCoreMockCheckSelfOrAdminResults{err}
+	mmCheckSelfOrAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckSelfOrAdmin.mock
+}
+
+// Set uses given function f to mock the AuthCore.CheckSelfOrAdmin method
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Set(f func(ctx context.Context, targetUserID uuid.UUID) (err error)) *AuthCoreMock {
+	if mmCheckSelfOrAdmin.defaultExpectation != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("Default expectation is already set for the AuthCore.CheckSelfOrAdmin method")
+	}
+
+	if len(mmCheckSelfOrAdmin.expectations) > 0 {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("Some expectations are already set for the AuthCore.CheckSelfOrAdmin method")
+	}
+
+	mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin = f
+	mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdminOrigin = minimock.CallerInfo(1)
+	return mmCheckSelfOrAdmin.mock
+}
+
+// When sets expectation for the AuthCore.CheckSelfOrAdmin which will trigger the result defined by the following
+// Then helper
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) When(ctx context.Context, targetUserID uuid.UUID) *AuthCoreMockCheckSelfOrAdminExpectation {
+	if mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("AuthCoreMock.CheckSelfOrAdmin mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockCheckSelfOrAdminExpectation{
+		mock:               mmCheckSelfOrAdmin.mock,
+		params:             &AuthCoreMockCheckSelfOrAdminParams{ctx, targetUserID},
+		expectationOrigins: AuthCoreMockCheckSelfOrAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckSelfOrAdmin.expectations = append(mmCheckSelfOrAdmin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthCore.CheckSelfOrAdmin return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockCheckSelfOrAdminExpectation) Then(err error) *AuthCoreMock {
+	e.results = &AuthCoreMockCheckSelfOrAdminResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthCore.CheckSelfOrAdmin should be invoked
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Times(n uint64) *mAuthCoreMockCheckSelfOrAdmin {
+	if n == 0 {
+		mmCheckSelfOrAdmin.mock.t.Fatalf("Times of AuthCoreMock.CheckSelfOrAdmin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckSelfOrAdmin.expectedInvocations, n)
+	mmCheckSelfOrAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckSelfOrAdmin
+}
+
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) invocationsDone() bool {
+	if len(mmCheckSelfOrAdmin.expectations) == 0 && mmCheckSelfOrAdmin.defaultExpectation == nil && mmCheckSelfOrAdmin.mock.funcCheckSelfOrAdmin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckSelfOrAdmin.mock.afterCheckSelfOrAdminCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckSelfOrAdmin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckSelfOrAdmin implements mm_usecase.AuthCore
+func (mmCheckSelfOrAdmin *AuthCoreMock) CheckSelfOrAdmin(ctx context.Context, targetUserID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmCheckSelfOrAdmin.beforeCheckSelfOrAdminCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckSelfOrAdmin.afterCheckSelfOrAdminCounter, 1)
+
+	mmCheckSelfOrAdmin.t.Helper()
+
+	if mmCheckSelfOrAdmin.inspectFuncCheckSelfOrAdmin != nil {
+		mmCheckSelfOrAdmin.inspectFuncCheckSelfOrAdmin(ctx, targetUserID)
+	}
+
+	mm_params := AuthCoreMockCheckSelfOrAdminParams{ctx, targetUserID}
+
+	// Record call args
+	mmCheckSelfOrAdmin.CheckSelfOrAdminMock.mutex.Lock()
+	mmCheckSelfOrAdmin.CheckSelfOrAdminMock.callArgs = append(mmCheckSelfOrAdmin.CheckSelfOrAdminMock.callArgs, &mm_params)
+	mmCheckSelfOrAdmin.CheckSelfOrAdminMock.mutex.Unlock()
+
+	for _, e := range mmCheckSelfOrAdmin.CheckSelfOrAdminMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockCheckSelfOrAdminParams{ctx, targetUserID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckSelfOrAdmin.t.Errorf("AuthCoreMock.CheckSelfOrAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.targetUserID != nil && !minimock.Equal(*mm_want_ptrs.targetUserID, mm_got.targetUserID) {
+				mmCheckSelfOrAdmin.t.Errorf("AuthCoreMock.CheckSelfOrAdmin got unexpected parameter targetUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.expectationOrigins.originTargetUserID, *mm_want_ptrs.targetUserID, mm_got.targetUserID, minimock.Diff(*mm_want_ptrs.targetUserID, mm_got.targetUserID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckSelfOrAdmin.t.Errorf("AuthCoreMock.CheckSelfOrAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckSelfOrAdmin.CheckSelfOrAdminMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckSelfOrAdmin.t.Fatal("No results are set for the AuthCoreMock.CheckSelfOrAdmin")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckSelfOrAdmin.funcCheckSelfOrAdmin != nil {
+		return mmCheckSelfOrAdmin.funcCheckSelfOrAdmin(ctx, targetUserID)
+	}
+	mmCheckSelfOrAdmin.t.Fatalf("Unexpected call to AuthCoreMock.CheckSelfOrAdmin. %v %v", ctx, targetUserID)
+	return
+}
+
+// CheckSelfOrAdminAfterCounter returns a count of finished AuthCoreMock.CheckSelfOrAdmin invocations
+func (mmCheckSelfOrAdmin *AuthCoreMock) CheckSelfOrAdminAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckSelfOrAdmin.afterCheckSelfOrAdminCounter)
+}
+
+// CheckSelfOrAdminBeforeCounter returns a count of AuthCoreMock.CheckSelfOrAdmin invocations
+func (mmCheckSelfOrAdmin *AuthCoreMock) CheckSelfOrAdminBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckSelfOrAdmin.beforeCheckSelfOrAdminCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.CheckSelfOrAdmin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckSelfOrAdmin *mAuthCoreMockCheckSelfOrAdmin) Calls() []*AuthCoreMockCheckSelfOrAdminParams {
+	mmCheckSelfOrAdmin.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockCheckSelfOrAdminParams, len(mmCheckSelfOrAdmin.callArgs))
+	copy(argCopy, mmCheckSelfOrAdmin.callArgs)
+
+	mmCheckSelfOrAdmin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckSelfOrAdminDone returns true if the count of the CheckSelfOrAdmin invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockCheckSelfOrAdminDone() bool {
+	if m.CheckSelfOrAdminMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckSelfOrAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckSelfOrAdminMock.invocationsDone()
+}
+
+// MinimockCheckSelfOrAdminInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockCheckSelfOrAdminInspect() {
+	for _, e := range m.CheckSelfOrAdminMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.CheckSelfOrAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckSelfOrAdminCounter := mm_atomic.LoadUint64(&m.afterCheckSelfOrAdminCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckSelfOrAdminMock.defaultExpectation != nil && afterCheckSelfOrAdminCounter < 1 {
+		if m.CheckSelfOrAdminMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.CheckSelfOrAdmin at\n%s", m.CheckSelfOrAdminMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.CheckSelfOrAdmin at\n%s with params: %#v", m.CheckSelfOrAdminMock.defaultExpectation.expectationOrigins.origin, *m.CheckSelfOrAdminMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckSelfOrAdmin != nil && afterCheckSelfOrAdminCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.CheckSelfOrAdmin at\n%s", m.funcCheckSelfOrAdminOrigin)
+	}
+
+	if !m.CheckSelfOrAdminMock.invocationsDone() && afterCheckSelfOrAdminCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.CheckSelfOrAdmin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckSelfOrAdminMock.expectedInvocations), m.CheckSelfOrAdminMock.expectedInvocationsOrigin, afterCheckSelfOrAdminCounter)
+	}
+}
+
+type mAuthCoreMockGetSessionsByUserID struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockGetSessionsByUserIDExpectation
+	expectations       []*AuthCoreMockGetSessionsByUserIDExpectation
+
+	callArgs []*AuthCoreMockGetSessionsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockGetSessionsByUserIDExpectation specifies expectation struct of the AuthCore.GetSessionsByUserID
+type AuthCoreMockGetSessionsByUserIDExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockGetSessionsByUserIDParams
+	paramPtrs          *AuthCoreMockGetSessionsByUserIDParamPtrs
+	expectationOrigins AuthCoreMockGetSessionsByUserIDExpectationOrigins
+	results            *AuthCoreMockGetSessionsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockGetSessionsByUserIDParams contains parameters of the AuthCore.GetSessionsByUserID
+type AuthCoreMockGetSessionsByUserIDParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthCoreMockGetSessionsByUserIDParamPtrs contains pointers to parameters of the AuthCore.GetSessionsByUserID
+type AuthCoreMockGetSessionsByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthCoreMockGetSessionsByUserIDResults contains results of the AuthCore.GetSessionsByUserID
+type AuthCoreMockGetSessionsByUserIDResults struct {
+	sa1 []auth.Session
+	err error
+}
+
+// AuthCoreMockGetSessionsByUserIDOrigins contains origins of expectations of the AuthCore.GetSessionsByUserID
+type AuthCoreMockGetSessionsByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Optional() *mAuthCoreMockGetSessionsByUserID {
+	mmGetSessionsByUserID.optional = true
+	return mmGetSessionsByUserID
+}
+
+// Expect sets up expected params for AuthCore.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Expect(ctx context.Context, userID uuid.UUID) *mAuthCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthCoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetSessionsByUserID.defaultExpectation.params = &AuthCoreMockGetSessionsByUserIDParams{ctx, userID}
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSessionsByUserID.expectations {
+		if minimock.Equal(e.params, mmGetSessionsByUserID.defaultExpectation.params) {
+			mmGetSessionsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSessionsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthCore.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthCoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthCoreMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthCore.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) ExpectUserIDParam2(userID uuid.UUID) *mAuthCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthCoreMockGetSessionsByUserIDExpectation{}
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.params != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Expect")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetSessionsByUserID.defaultExpectation.paramPtrs = &AuthCoreMockGetSessionsByUserIDParamPtrs{}
+	}
+	mmGetSessionsByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSessionsByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSessionsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthCore.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthCoreMockGetSessionsByUserID {
+	if mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.GetSessionsByUserID")
+	}
+
+	mmGetSessionsByUserID.mock.inspectFuncGetSessionsByUserID = f
+
+	return mmGetSessionsByUserID
+}
+
+// Return sets up results that will be returned by AuthCore.GetSessionsByUserID
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Return(sa1 []auth.Session, err error) *AuthCoreMock {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	if mmGetSessionsByUserID.defaultExpectation == nil {
+		mmGetSessionsByUserID.defaultExpectation = &AuthCoreMockGetSessionsByUserIDExpectation{mock: mmGetSessionsByUserID.mock}
+	}
+	mmGetSessionsByUserID.defaultExpectation.results = &AuthCoreMockGetSessionsByUserIDResults{sa1, err}
+	mmGetSessionsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// Set uses given function f to mock the AuthCore.GetSessionsByUserID method
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Set(f func(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error)) *AuthCoreMock {
+	if mmGetSessionsByUserID.defaultExpectation != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("Default expectation is already set for the AuthCore.GetSessionsByUserID method")
+	}
+
+	if len(mmGetSessionsByUserID.expectations) > 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Some expectations are already set for the AuthCore.GetSessionsByUserID method")
+	}
+
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserID = f
+	mmGetSessionsByUserID.mock.funcGetSessionsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID.mock
+}
+
+// When sets expectation for the AuthCore.GetSessionsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) When(ctx context.Context, userID uuid.UUID) *AuthCoreMockGetSessionsByUserIDExpectation {
+	if mmGetSessionsByUserID.mock.funcGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.mock.t.Fatalf("AuthCoreMock.GetSessionsByUserID mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockGetSessionsByUserIDExpectation{
+		mock:               mmGetSessionsByUserID.mock,
+		params:             &AuthCoreMockGetSessionsByUserIDParams{ctx, userID},
+		expectationOrigins: AuthCoreMockGetSessionsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSessionsByUserID.expectations = append(mmGetSessionsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthCore.GetSessionsByUserID return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockGetSessionsByUserIDExpectation) Then(sa1 []auth.Session, err error) *AuthCoreMock {
+	e.results = &AuthCoreMockGetSessionsByUserIDResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthCore.GetSessionsByUserID should be invoked
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Times(n uint64) *mAuthCoreMockGetSessionsByUserID {
+	if n == 0 {
+		mmGetSessionsByUserID.mock.t.Fatalf("Times of AuthCoreMock.GetSessionsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSessionsByUserID.expectedInvocations, n)
+	mmGetSessionsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSessionsByUserID
+}
+
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) invocationsDone() bool {
+	if len(mmGetSessionsByUserID.expectations) == 0 && mmGetSessionsByUserID.defaultExpectation == nil && mmGetSessionsByUserID.mock.funcGetSessionsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.mock.afterGetSessionsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSessionsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSessionsByUserID implements mm_usecase.AuthCore
+func (mmGetSessionsByUserID *AuthCoreMock) GetSessionsByUserID(ctx context.Context, userID uuid.UUID) (sa1 []auth.Session, err error) {
+	mm_atomic.AddUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter, 1)
+
+	mmGetSessionsByUserID.t.Helper()
+
+	if mmGetSessionsByUserID.inspectFuncGetSessionsByUserID != nil {
+		mmGetSessionsByUserID.inspectFuncGetSessionsByUserID(ctx, userID)
+	}
+
+	mm_params := AuthCoreMockGetSessionsByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Lock()
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs = append(mmGetSessionsByUserID.GetSessionsByUserIDMock.callArgs, &mm_params)
+	mmGetSessionsByUserID.GetSessionsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetSessionsByUserID.GetSessionsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockGetSessionsByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSessionsByUserID.t.Errorf("AuthCoreMock.GetSessionsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSessionsByUserID.t.Errorf("AuthCoreMock.GetSessionsByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSessionsByUserID.t.Errorf("AuthCoreMock.GetSessionsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSessionsByUserID.GetSessionsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSessionsByUserID.t.Fatal("No results are set for the AuthCoreMock.GetSessionsByUserID")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetSessionsByUserID.funcGetSessionsByUserID != nil {
+		return mmGetSessionsByUserID.funcGetSessionsByUserID(ctx, userID)
+	}
+	mmGetSessionsByUserID.t.Fatalf("Unexpected call to AuthCoreMock.GetSessionsByUserID. %v %v", ctx, userID)
+	return
+}
+
+// GetSessionsByUserIDAfterCounter returns a count of finished AuthCoreMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *AuthCoreMock) GetSessionsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.afterGetSessionsByUserIDCounter)
+}
+
+// GetSessionsByUserIDBeforeCounter returns a count of AuthCoreMock.GetSessionsByUserID invocations
+func (mmGetSessionsByUserID *AuthCoreMock) GetSessionsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSessionsByUserID.beforeGetSessionsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.GetSessionsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSessionsByUserID *mAuthCoreMockGetSessionsByUserID) Calls() []*AuthCoreMockGetSessionsByUserIDParams {
+	mmGetSessionsByUserID.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockGetSessionsByUserIDParams, len(mmGetSessionsByUserID.callArgs))
+	copy(argCopy, mmGetSessionsByUserID.callArgs)
+
+	mmGetSessionsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionsByUserIDDone returns true if the count of the GetSessionsByUserID invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockGetSessionsByUserIDDone() bool {
+	if m.GetSessionsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionsByUserIDMock.invocationsDone()
+}
+
+// MinimockGetSessionsByUserIDInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockGetSessionsByUserIDInspect() {
+	for _, e := range m.GetSessionsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.GetSessionsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetSessionsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionsByUserIDMock.defaultExpectation != nil && afterGetSessionsByUserIDCounter < 1 {
+		if m.GetSessionsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.GetSessionsByUserID at\n%s", m.GetSessionsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.GetSessionsByUserID at\n%s with params: %#v", m.GetSessionsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSessionsByUserID != nil && afterGetSessionsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.GetSessionsByUserID at\n%s", m.funcGetSessionsByUserIDOrigin)
+	}
+
+	if !m.GetSessionsByUserIDMock.invocationsDone() && afterGetSessionsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.GetSessionsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionsByUserIDMock.expectedInvocations), m.GetSessionsByUserIDMock.expectedInvocationsOrigin, afterGetSessionsByUserIDCounter)
+	}
+}
+
+type mAuthCoreMockListUserRoles struct {
+	optional           bool
+	mock               *AuthCoreMock
+	defaultExpectation *AuthCoreMockListUserRolesExpectation
+	expectations       []*AuthCoreMockListUserRolesExpectation
+
+	callArgs []*AuthCoreMockListUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthCoreMockListUserRolesExpectation specifies expectation struct of the AuthCore.ListUserRoles
+type AuthCoreMockListUserRolesExpectation struct {
+	mock               *AuthCoreMock
+	params             *AuthCoreMockListUserRolesParams
+	paramPtrs          *AuthCoreMockListUserRolesParamPtrs
+	expectationOrigins AuthCoreMockListUserRolesExpectationOrigins
+	results            *AuthCoreMockListUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthCoreMockListUserRolesParams contains parameters of the AuthCore.ListUserRoles
+type AuthCoreMockListUserRolesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthCoreMockListUserRolesParamPtrs contains pointers to parameters of the AuthCore.ListUserRoles
+type AuthCoreMockListUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthCoreMockListUserRolesResults contains results of the AuthCore.ListUserRoles
+type AuthCoreMockListUserRolesResults struct {
+	ua1 []auth.UserRole
+	err error
+}
+
+// AuthCoreMockListUserRolesOrigins contains origins of expectations of the AuthCore.ListUserRoles
+type AuthCoreMockListUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Optional() *mAuthCoreMockListUserRoles {
+	mmListUserRoles.optional = true
+	return mmListUserRoles
+}
+
+// Expect sets up expected params for AuthCore.ListUserRoles
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mAuthCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthCoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListUserRoles.defaultExpectation.params = &AuthCoreMockListUserRolesParams{ctx, userID}
+	mmListUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUserRoles.expectations {
+		if minimock.Equal(e.params, mmListUserRoles.defaultExpectation.params) {
+			mmListUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthCore.ListUserRoles
+func (mmListUserRoles *mAuthCoreMockListUserRoles) ExpectCtxParam1(ctx context.Context) *mAuthCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthCoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &AuthCoreMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthCore.ListUserRoles
+func (mmListUserRoles *mAuthCoreMockListUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mAuthCoreMockListUserRoles {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthCoreMockListUserRolesExpectation{}
+	}
+
+	if mmListUserRoles.defaultExpectation.params != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Expect")
+	}
+
+	if mmListUserRoles.defaultExpectation.paramPtrs == nil {
+		mmListUserRoles.defaultExpectation.paramPtrs = &AuthCoreMockListUserRolesParamPtrs{}
+	}
+	mmListUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmListUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthCore.ListUserRoles
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthCoreMockListUserRoles {
+	if mmListUserRoles.mock.inspectFuncListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("Inspect function is already set for AuthCoreMock.ListUserRoles")
+	}
+
+	mmListUserRoles.mock.inspectFuncListUserRoles = f
+
+	return mmListUserRoles
+}
+
+// Return sets up results that will be returned by AuthCore.ListUserRoles
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Return(ua1 []auth.UserRole, err error) *AuthCoreMock {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	if mmListUserRoles.defaultExpectation == nil {
+		mmListUserRoles.defaultExpectation = &AuthCoreMockListUserRolesExpectation{mock: mmListUserRoles.mock}
+	}
+	mmListUserRoles.defaultExpectation.results = &AuthCoreMockListUserRolesResults{ua1, err}
+	mmListUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// Set uses given function f to mock the AuthCore.ListUserRoles method
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error)) *AuthCoreMock {
+	if mmListUserRoles.defaultExpectation != nil {
+		mmListUserRoles.mock.t.Fatalf("Default expectation is already set for the AuthCore.ListUserRoles method")
+	}
+
+	if len(mmListUserRoles.expectations) > 0 {
+		mmListUserRoles.mock.t.Fatalf("Some expectations are already set for the AuthCore.ListUserRoles method")
+	}
+
+	mmListUserRoles.mock.funcListUserRoles = f
+	mmListUserRoles.mock.funcListUserRolesOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles.mock
+}
+
+// When sets expectation for the AuthCore.ListUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmListUserRoles *mAuthCoreMockListUserRoles) When(ctx context.Context, userID uuid.UUID) *AuthCoreMockListUserRolesExpectation {
+	if mmListUserRoles.mock.funcListUserRoles != nil {
+		mmListUserRoles.mock.t.Fatalf("AuthCoreMock.ListUserRoles mock is already set by Set")
+	}
+
+	expectation := &AuthCoreMockListUserRolesExpectation{
+		mock:               mmListUserRoles.mock,
+		params:             &AuthCoreMockListUserRolesParams{ctx, userID},
+		expectationOrigins: AuthCoreMockListUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUserRoles.expectations = append(mmListUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthCore.ListUserRoles return parameters for the expectation previously defined by the When method
+func (e *AuthCoreMockListUserRolesExpectation) Then(ua1 []auth.UserRole, err error) *AuthCoreMock {
+	e.results = &AuthCoreMockListUserRolesResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthCore.ListUserRoles should be invoked
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Times(n uint64) *mAuthCoreMockListUserRoles {
+	if n == 0 {
+		mmListUserRoles.mock.t.Fatalf("Times of AuthCoreMock.ListUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUserRoles.expectedInvocations, n)
+	mmListUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUserRoles
+}
+
+func (mmListUserRoles *mAuthCoreMockListUserRoles) invocationsDone() bool {
+	if len(mmListUserRoles.expectations) == 0 && mmListUserRoles.defaultExpectation == nil && mmListUserRoles.mock.funcListUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUserRoles.mock.afterListUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUserRoles implements mm_usecase.AuthCore
+func (mmListUserRoles *AuthCoreMock) ListUserRoles(ctx context.Context, userID uuid.UUID) (ua1 []auth.UserRole, err error) {
+	mm_atomic.AddUint64(&mmListUserRoles.beforeListUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserRoles.afterListUserRolesCounter, 1)
+
+	mmListUserRoles.t.Helper()
+
+	if mmListUserRoles.inspectFuncListUserRoles != nil {
+		mmListUserRoles.inspectFuncListUserRoles(ctx, userID)
+	}
+
+	mm_params := AuthCoreMockListUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmListUserRoles.ListUserRolesMock.mutex.Lock()
+	mmListUserRoles.ListUserRolesMock.callArgs = append(mmListUserRoles.ListUserRolesMock.callArgs, &mm_params)
+	mmListUserRoles.ListUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmListUserRoles.ListUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmListUserRoles.ListUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserRoles.ListUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserRoles.ListUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListUserRoles.ListUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthCoreMockListUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUserRoles.t.Errorf("AuthCoreMock.ListUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListUserRoles.t.Errorf("AuthCoreMock.ListUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserRoles.t.Errorf("AuthCoreMock.ListUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUserRoles.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserRoles.ListUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserRoles.t.Fatal("No results are set for the AuthCoreMock.ListUserRoles")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmListUserRoles.funcListUserRoles != nil {
+		return mmListUserRoles.funcListUserRoles(ctx, userID)
+	}
+	mmListUserRoles.t.Fatalf("Unexpected call to AuthCoreMock.ListUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// ListUserRolesAfterCounter returns a count of finished AuthCoreMock.ListUserRoles invocations
+func (mmListUserRoles *AuthCoreMock) ListUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.afterListUserRolesCounter)
+}
+
+// ListUserRolesBeforeCounter returns a count of AuthCoreMock.ListUserRoles invocations
+func (mmListUserRoles *AuthCoreMock) ListUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserRoles.beforeListUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthCoreMock.ListUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserRoles *mAuthCoreMockListUserRoles) Calls() []*AuthCoreMockListUserRolesParams {
+	mmListUserRoles.mutex.RLock()
+
+	argCopy := make([]*AuthCoreMockListUserRolesParams, len(mmListUserRoles.callArgs))
+	copy(argCopy, mmListUserRoles.callArgs)
+
+	mmListUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserRolesDone returns true if the count of the ListUserRoles invocations corresponds
+// the number of defined expectations
+func (m *AuthCoreMock) MinimockListUserRolesDone() bool {
+	if m.ListUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUserRolesMock.invocationsDone()
+}
+
+// MinimockListUserRolesInspect logs each unmet expectation
+func (m *AuthCoreMock) MinimockListUserRolesInspect() {
+	for _, e := range m.ListUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthCoreMock.ListUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUserRolesCounter := mm_atomic.LoadUint64(&m.afterListUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserRolesMock.defaultExpectation != nil && afterListUserRolesCounter < 1 {
+		if m.ListUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthCoreMock.ListUserRoles at\n%s", m.ListUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthCoreMock.ListUserRoles at\n%s with params: %#v", m.ListUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserRoles != nil && afterListUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to AuthCoreMock.ListUserRoles at\n%s", m.funcListUserRolesOrigin)
+	}
+
+	if !m.ListUserRolesMock.invocationsDone() && afterListUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthCoreMock.ListUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUserRolesMock.expectedInvocations), m.ListUserRolesMock.expectedInvocationsOrigin, afterListUserRolesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckSelfOrAdminInspect()
+
+			m.MinimockGetSessionsByUserIDInspect()
+
+			m.MinimockListUserRolesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckSelfOrAdminDone() &&
+		m.MinimockGetSessionsByUserIDDone() &&
+		m.MinimockListUserRolesDone()
+}
@@ -0,0 +1,2170 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/dataexport/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaim          func(ctx context.Context) (e1 dataexport.Export, b1 bool, err error)
+	funcClaimOrigin    string
+	inspectFuncClaim   func(ctx context.Context)
+	afterClaimCounter  uint64
+	beforeClaimCounter uint64
+	ClaimMock          mCoreMockClaim
+
+	funcComplete          func(ctx context.Context, export dataexport.Export, data dataexport.Data) (err error)
+	funcCompleteOrigin    string
+	inspectFuncComplete   func(ctx context.Context, export dataexport.Export, data dataexport.Data)
+	afterCompleteCounter  uint64
+	beforeCompleteCounter uint64
+	CompleteMock          mCoreMockComplete
+
+	funcDeleteExpired          func(ctx context.Context) (i1 int, err error)
+	funcDeleteExpiredOrigin    string
+	inspectFuncDeleteExpired   func(ctx context.Context)
+	afterDeleteExpiredCounter  uint64
+	beforeDeleteExpiredCounter uint64
+	DeleteExpiredMock          mCoreMockDeleteExpired
+
+	funcFail          func(ctx context.Context, id uuid.UUID) (err error)
+	funcFailOrigin    string
+	inspectFuncFail   func(ctx context.Context, id uuid.UUID)
+	afterFailCounter  uint64
+	beforeFailCounter uint64
+	FailMock          mCoreMockFail
+
+	funcOpen          func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error)
+	funcOpenOrigin    string
+	inspectFuncOpen   func(ctx context.Context, userID uuid.UUID)
+	afterOpenCounter  uint64
+	beforeOpenCounter uint64
+	OpenMock          mCoreMockOpen
+
+	funcRequest          func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, b1 bool, err error)
+	funcRequestOrigin    string
+	inspectFuncRequest   func(ctx context.Context, userID uuid.UUID)
+	afterRequestCounter  uint64
+	beforeRequestCounter uint64
+	RequestMock          mCoreMockRequest
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimMock = mCoreMockClaim{mock: m}
+	m.ClaimMock.callArgs = []*CoreMockClaimParams{}
+
+	m.CompleteMock = mCoreMockComplete{mock: m}
+	m.CompleteMock.callArgs = []*CoreMockCompleteParams{}
+
+	m.DeleteExpiredMock = mCoreMockDeleteExpired{mock: m}
+	m.DeleteExpiredMock.callArgs = []*CoreMockDeleteExpiredParams{}
+
+	m.FailMock = mCoreMockFail{mock: m}
+	m.FailMock.callArgs = []*CoreMockFailParams{}
+
+	m.OpenMock = mCoreMockOpen{mock: m}
+	m.OpenMock.callArgs = []*CoreMockOpenParams{}
+
+	m.RequestMock = mCoreMockRequest{mock: m}
+	m.RequestMock.callArgs = []*CoreMockRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockClaim struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockClaimExpectation
+	expectations       []*CoreMockClaimExpectation
+
+	callArgs []*CoreMockClaimParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockClaimExpectation specifies expectation struct of the Core.Claim
+type CoreMockClaimExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockClaimParams
+	paramPtrs          *CoreMockClaimParamPtrs
+	expectationOrigins CoreMockClaimExpectationOrigins
+	results            *CoreMockClaimResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockClaimParams contains parameters of the Core.Claim
+type CoreMockClaimParams struct {
+	ctx context.Context
+}
+
+// CoreMockClaimParamPtrs contains pointers to parameters of the Core.Claim
+type CoreMockClaimParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockClaimResults contains results of the Core.Claim
+type CoreMockClaimResults struct {
+	e1  dataexport.Export
+	b1  bool
+	err error
+}
+
+// CoreMockClaimOrigins contains origins of expectations of the Core.Claim
+type CoreMockClaimExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaim *mCoreMockClaim) Optional() *mCoreMockClaim {
+	mmClaim.optional = true
+	return mmClaim
+}
+
+// Expect sets up expected params for Core.Claim
+func (mmClaim *mCoreMockClaim) Expect(ctx context.Context) *mCoreMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by ExpectParams functions")
+	}
+
+	mmClaim.defaultExpectation.params = &CoreMockClaimParams{ctx}
+	mmClaim.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaim.expectations {
+		if minimock.Equal(e.params, mmClaim.defaultExpectation.params) {
+			mmClaim.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaim.defaultExpectation.params)
+		}
+	}
+
+	return mmClaim
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Claim
+func (mmClaim *mCoreMockClaim) ExpectCtxParam1(ctx context.Context) *mCoreMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &CoreMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaim.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Claim
+func (mmClaim *mCoreMockClaim) Inspect(f func(ctx context.Context)) *mCoreMockClaim {
+	if mmClaim.mock.inspectFuncClaim != nil {
+		mmClaim.mock.t.Fatalf("Inspect function is already set for CoreMock.Claim")
+	}
+
+	mmClaim.mock.inspectFuncClaim = f
+
+	return mmClaim
+}
+
+// Return sets up results that will be returned by Core.Claim
+func (mmClaim *mCoreMockClaim) Return(e1 dataexport.Export, b1 bool, err error) *CoreMock {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{mock: mmClaim.mock}
+	}
+	mmClaim.defaultExpectation.results = &CoreMockClaimResults{e1, b1, err}
+	mmClaim.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// Set uses given function f to mock the Core.Claim method
+func (mmClaim *mCoreMockClaim) Set(f func(ctx context.Context) (e1 dataexport.Export, b1 bool, err error)) *CoreMock {
+	if mmClaim.defaultExpectation != nil {
+		mmClaim.mock.t.Fatalf("Default expectation is already set for the Core.Claim method")
+	}
+
+	if len(mmClaim.expectations) > 0 {
+		mmClaim.mock.t.Fatalf("Some expectations are already set for the Core.Claim method")
+	}
+
+	mmClaim.mock.funcClaim = f
+	mmClaim.mock.funcClaimOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// When sets expectation for the Core.Claim which will trigger the result defined by the following
+// Then helper
+func (mmClaim *mCoreMockClaim) When(ctx context.Context) *CoreMockClaimExpectation {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	expectation := &CoreMockClaimExpectation{
+		mock:               mmClaim.mock,
+		params:             &CoreMockClaimParams{ctx},
+		expectationOrigins: CoreMockClaimExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaim.expectations = append(mmClaim.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Claim return parameters for the expectation previously defined by the When method
+func (e *CoreMockClaimExpectation) Then(e1 dataexport.Export, b1 bool, err error) *CoreMock {
+	e.results = &CoreMockClaimResults{e1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Claim should be invoked
+func (mmClaim *mCoreMockClaim) Times(n uint64) *mCoreMockClaim {
+	if n == 0 {
+		mmClaim.mock.t.Fatalf("Times of CoreMock.Claim mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaim.expectedInvocations, n)
+	mmClaim.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaim
+}
+
+func (mmClaim *mCoreMockClaim) invocationsDone() bool {
+	if len(mmClaim.expectations) == 0 && mmClaim.defaultExpectation == nil && mmClaim.mock.funcClaim == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaim.mock.afterClaimCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaim.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Claim implements mm_usecase.Core
+func (mmClaim *CoreMock) Claim(ctx context.Context) (e1 dataexport.Export, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmClaim.beforeClaimCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaim.afterClaimCounter, 1)
+
+	mmClaim.t.Helper()
+
+	if mmClaim.inspectFuncClaim != nil {
+		mmClaim.inspectFuncClaim(ctx)
+	}
+
+	mm_params := CoreMockClaimParams{ctx}
+
+	// Record call args
+	mmClaim.ClaimMock.mutex.Lock()
+	mmClaim.ClaimMock.callArgs = append(mmClaim.ClaimMock.callArgs, &mm_params)
+	mmClaim.ClaimMock.mutex.Unlock()
+
+	for _, e := range mmClaim.ClaimMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmClaim.ClaimMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaim.ClaimMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaim.ClaimMock.defaultExpectation.params
+		mm_want_ptrs := mmClaim.ClaimMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockClaimParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaim.t.Errorf("CoreMock.Claim got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaim.t.Errorf("CoreMock.Claim got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaim.ClaimMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaim.ClaimMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaim.t.Fatal("No results are set for the CoreMock.Claim")
+		}
+		return (*mm_results).e1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmClaim.funcClaim != nil {
+		return mmClaim.funcClaim(ctx)
+	}
+	mmClaim.t.Fatalf("Unexpected call to CoreMock.Claim. %v", ctx)
+	return
+}
+
+// ClaimAfterCounter returns a count of finished CoreMock.Claim invocations
+func (mmClaim *CoreMock) ClaimAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.afterClaimCounter)
+}
+
+// ClaimBeforeCounter returns a count of CoreMock.Claim invocations
+func (mmClaim *CoreMock) ClaimBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.beforeClaimCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Claim.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaim *mCoreMockClaim) Calls() []*CoreMockClaimParams {
+	mmClaim.mutex.RLock()
+
+	argCopy := make([]*CoreMockClaimParams, len(mmClaim.callArgs))
+	copy(argCopy, mmClaim.callArgs)
+
+	mmClaim.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDone returns true if the count of the Claim invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockClaimDone() bool {
+	if m.ClaimMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimMock.invocationsDone()
+}
+
+// MinimockClaimInspect logs each unmet expectation
+func (m *CoreMock) MinimockClaimInspect() {
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimCounter := mm_atomic.LoadUint64(&m.afterClaimCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimMock.defaultExpectation != nil && afterClaimCounter < 1 {
+		if m.ClaimMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s", m.ClaimMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s with params: %#v", m.ClaimMock.defaultExpectation.expectationOrigins.origin, *m.ClaimMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaim != nil && afterClaimCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Claim at\n%s", m.funcClaimOrigin)
+	}
+
+	if !m.ClaimMock.invocationsDone() && afterClaimCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Claim at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimMock.expectedInvocations), m.ClaimMock.expectedInvocationsOrigin, afterClaimCounter)
+	}
+}
+
+type mCoreMockComplete struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockCompleteExpectation
+	expectations       []*CoreMockCompleteExpectation
+
+	callArgs []*CoreMockCompleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockCompleteExpectation specifies expectation struct of the Core.Complete
+type CoreMockCompleteExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockCompleteParams
+	paramPtrs          *CoreMockCompleteParamPtrs
+	expectationOrigins CoreMockCompleteExpectationOrigins
+	results            *CoreMockCompleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockCompleteParams contains parameters of the Core.Complete
+type CoreMockCompleteParams struct {
+	ctx    context.Context
+	export dataexport.Export
+	data   dataexport.Data
+}
+
+// CoreMockCompleteParamPtrs contains pointers to parameters of the Core.Complete
+type CoreMockCompleteParamPtrs struct {
+	ctx    *context.Context
+	export *dataexport.Export
+	data   *dataexport.Data
+}
+
+// CoreMockCompleteResults contains results of the Core.Complete
+type CoreMockCompleteResults struct {
+	err error
+}
+
+// CoreMockCompleteOrigins contains origins of expectations of the Core.Complete
+type CoreMockCompleteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originExport string
+	originData   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmComplete *mCoreMockComplete) Optional() *mCoreMockComplete {
+	mmComplete.optional = true
+	return mmComplete
+}
+
+// Expect sets up expected params for Core.Complete
+func (mmComplete *mCoreMockComplete) Expect(ctx context.Context, export dataexport.Export, data dataexport.Data) *mCoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &CoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by ExpectParams functions")
+	}
+
+	mmComplete.defaultExpectation.params = &CoreMockCompleteParams{ctx, export, data}
+	mmComplete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmComplete.expectations {
+		if minimock.Equal(e.params, mmComplete.defaultExpectation.params) {
+			mmComplete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmComplete.defaultExpectation.params)
+		}
+	}
+
+	return mmComplete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Complete
+func (mmComplete *mCoreMockComplete) ExpectCtxParam1(ctx context.Context) *mCoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &CoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &CoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmComplete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectExportParam2 sets up expected param export for Core.Complete
+func (mmComplete *mCoreMockComplete) ExpectExportParam2(export dataexport.Export) *mCoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &CoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &CoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.export = &export
+	mmComplete.defaultExpectation.expectationOrigins.originExport = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectDataParam3 sets up expected param data for Core.Complete
+func (mmComplete *mCoreMockComplete) ExpectDataParam3(data dataexport.Data) *mCoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &CoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &CoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.data = &data
+	mmComplete.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Complete
+func (mmComplete *mCoreMockComplete) Inspect(f func(ctx context.Context, export dataexport.Export, data dataexport.Data)) *mCoreMockComplete {
+	if mmComplete.mock.inspectFuncComplete != nil {
+		mmComplete.mock.t.Fatalf("Inspect function is already set for CoreMock.Complete")
+	}
+
+	mmComplete.mock.inspectFuncComplete = f
+
+	return mmComplete
+}
+
+// Return sets up results that will be returned by Core.Complete
+func (mmComplete *mCoreMockComplete) Return(err error) *CoreMock {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &CoreMockCompleteExpectation{mock: mmComplete.mock}
+	}
+	mmComplete.defaultExpectation.results = &CoreMockCompleteResults{err}
+	mmComplete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// Set uses given function f to mock the Core.Complete method
+func (mmComplete *mCoreMockComplete) Set(f func(ctx context.Context, export dataexport.Export, data dataexport.Data) (err error)) *CoreMock {
+	if mmComplete.defaultExpectation != nil {
+		mmComplete.mock.t.Fatalf("Default expectation is already set for the Core.Complete method")
+	}
+
+	if len(mmComplete.expectations) > 0 {
+		mmComplete.mock.t.Fatalf("Some expectations are already set for the Core.Complete method")
+	}
+
+	mmComplete.mock.funcComplete = f
+	mmComplete.mock.funcCompleteOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// When sets expectation for the Core.Complete which will trigger the result defined by the following
+// Then helper
+func (mmComplete *mCoreMockComplete) When(ctx context.Context, export dataexport.Export, data dataexport.Data) *CoreMockCompleteExpectation {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("CoreMock.Complete mock is already set by Set")
+	}
+
+	expectation := &CoreMockCompleteExpectation{
+		mock:               mmComplete.mock,
+		params:             &CoreMockCompleteParams{ctx, export, data},
+		expectationOrigins: CoreMockCompleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmComplete.expectations = append(mmComplete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Complete return parameters for the expectation previously defined by the When method
+func (e *CoreMockCompleteExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockCompleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.Complete should be invoked
+func (mmComplete *mCoreMockComplete) Times(n uint64) *mCoreMockComplete {
+	if n == 0 {
+		mmComplete.mock.t.Fatalf("Times of CoreMock.Complete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmComplete.expectedInvocations, n)
+	mmComplete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmComplete
+}
+
+func (mmComplete *mCoreMockComplete) invocationsDone() bool {
+	if len(mmComplete.expectations) == 0 && mmComplete.defaultExpectation == nil && mmComplete.mock.funcComplete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmComplete.mock.afterCompleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmComplete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Complete implements mm_usecase.Core
+func (mmComplete *CoreMock) Complete(ctx context.Context, export dataexport.Export, data dataexport.Data) (err error) {
+	mm_atomic.AddUint64(&mmComplete.beforeCompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmComplete.afterCompleteCounter, 1)
+
+	mmComplete.t.Helper()
+
+	if mmComplete.inspectFuncComplete != nil {
+		mmComplete.inspectFuncComplete(ctx, export, data)
+	}
+
+	mm_params := CoreMockCompleteParams{ctx, export, data}
+
+	// Record call args
+	mmComplete.CompleteMock.mutex.Lock()
+	mmComplete.CompleteMock.callArgs = append(mmComplete.CompleteMock.callArgs, &mm_params)
+	mmComplete.CompleteMock.mutex.Unlock()
+
+	for _, e := range mmComplete.CompleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmComplete.CompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmComplete.CompleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmComplete.CompleteMock.defaultExpectation.params
+		mm_want_ptrs := mmComplete.CompleteMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockCompleteParams{ctx, export, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmComplete.t.Errorf("CoreMock.Complete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.export != nil && !minimock.Equal(*mm_want_ptrs.export, mm_got.export) {
+				mmComplete.t.Errorf("CoreMock.Complete got unexpected parameter export, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originExport, *mm_want_ptrs.export, mm_got.export, minimock.Diff(*mm_want_ptrs.export, mm_got.export))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmComplete.t.Errorf("CoreMock.Complete got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmComplete.t.Errorf("CoreMock.Complete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmComplete.CompleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmComplete.CompleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmComplete.t.Fatal("No results are set for the CoreMock.Complete")
+		}
+		return (*mm_results).err
+	}
+	if mmComplete.funcComplete != nil {
+		return mmComplete.funcComplete(ctx, export, data)
+	}
+	mmComplete.t.Fatalf("Unexpected call to CoreMock.Complete. %v %v %v", ctx, export, data)
+	return
+}
+
+// CompleteAfterCounter returns a count of finished CoreMock.Complete invocations
+func (mmComplete *CoreMock) CompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.afterCompleteCounter)
+}
+
+// CompleteBeforeCounter returns a count of CoreMock.Complete invocations
+func (mmComplete *CoreMock) CompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.beforeCompleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Complete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmComplete *mCoreMockComplete) Calls() []*CoreMockCompleteParams {
+	mmComplete.mutex.RLock()
+
+	argCopy := make([]*CoreMockCompleteParams, len(mmComplete.callArgs))
+	copy(argCopy, mmComplete.callArgs)
+
+	mmComplete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteDone returns true if the count of the Complete invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockCompleteDone() bool {
+	if m.CompleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteMock.invocationsDone()
+}
+
+// MinimockCompleteInspect logs each unmet expectation
+func (m *CoreMock) MinimockCompleteInspect() {
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Complete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteCounter := mm_atomic.LoadUint64(&m.afterCompleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteMock.defaultExpectation != nil && afterCompleteCounter < 1 {
+		if m.CompleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Complete at\n%s", m.CompleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Complete at\n%s with params: %#v", m.CompleteMock.defaultExpectation.expectationOrigins.origin, *m.CompleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcComplete != nil && afterCompleteCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Complete at\n%s", m.funcCompleteOrigin)
+	}
+
+	if !m.CompleteMock.invocationsDone() && afterCompleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Complete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteMock.expectedInvocations), m.CompleteMock.expectedInvocationsOrigin, afterCompleteCounter)
+	}
+}
+
+type mCoreMockDeleteExpired struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockDeleteExpiredExpectation
+	expectations       []*CoreMockDeleteExpiredExpectation
+
+	callArgs []*CoreMockDeleteExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockDeleteExpiredExpectation specifies expectation struct of the Core.DeleteExpired
+type CoreMockDeleteExpiredExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockDeleteExpiredParams
+	paramPtrs          *CoreMockDeleteExpiredParamPtrs
+	expectationOrigins CoreMockDeleteExpiredExpectationOrigins
+	results            *CoreMockDeleteExpiredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockDeleteExpiredParams contains parameters of the Core.DeleteExpired
+type CoreMockDeleteExpiredParams struct {
+	ctx context.Context
+}
+
+// CoreMockDeleteExpiredParamPtrs contains pointers to parameters of the Core.DeleteExpired
+type CoreMockDeleteExpiredParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockDeleteExpiredResults contains results of the Core.DeleteExpired
+type CoreMockDeleteExpiredResults struct {
+	i1  int
+	err error
+}
+
+// CoreMockDeleteExpiredOrigins contains origins of expectations of the Core.DeleteExpired
+type CoreMockDeleteExpiredExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpired *mCoreMockDeleteExpired) Optional() *mCoreMockDeleteExpired {
+	mmDeleteExpired.optional = true
+	return mmDeleteExpired
+}
+
+// Expect sets up expected params for Core.DeleteExpired
+func (mmDeleteExpired *mCoreMockDeleteExpired) Expect(ctx context.Context) *mCoreMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &CoreMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpired.defaultExpectation.params = &CoreMockDeleteExpiredParams{ctx}
+	mmDeleteExpired.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpired.expectations {
+		if minimock.Equal(e.params, mmDeleteExpired.defaultExpectation.params) {
+			mmDeleteExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.DeleteExpired
+func (mmDeleteExpired *mCoreMockDeleteExpired) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteExpired {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &CoreMockDeleteExpiredExpectation{}
+	}
+
+	if mmDeleteExpired.defaultExpectation.params != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by Expect")
+	}
+
+	if mmDeleteExpired.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpired.defaultExpectation.paramPtrs = &CoreMockDeleteExpiredParamPtrs{}
+	}
+	mmDeleteExpired.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpired.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.DeleteExpired
+func (mmDeleteExpired *mCoreMockDeleteExpired) Inspect(f func(ctx context.Context)) *mCoreMockDeleteExpired {
+	if mmDeleteExpired.mock.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteExpired")
+	}
+
+	mmDeleteExpired.mock.inspectFuncDeleteExpired = f
+
+	return mmDeleteExpired
+}
+
+// Return sets up results that will be returned by Core.DeleteExpired
+func (mmDeleteExpired *mCoreMockDeleteExpired) Return(i1 int, err error) *CoreMock {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by Set")
+	}
+
+	if mmDeleteExpired.defaultExpectation == nil {
+		mmDeleteExpired.defaultExpectation = &CoreMockDeleteExpiredExpectation{mock: mmDeleteExpired.mock}
+	}
+	mmDeleteExpired.defaultExpectation.results = &CoreMockDeleteExpiredResults{i1, err}
+	mmDeleteExpired.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired.mock
+}
+
+// Set uses given function f to mock the Core.DeleteExpired method
+func (mmDeleteExpired *mCoreMockDeleteExpired) Set(f func(ctx context.Context) (i1 int, err error)) *CoreMock {
+	if mmDeleteExpired.defaultExpectation != nil {
+		mmDeleteExpired.mock.t.Fatalf("Default expectation is already set for the Core.DeleteExpired method")
+	}
+
+	if len(mmDeleteExpired.expectations) > 0 {
+		mmDeleteExpired.mock.t.Fatalf("Some expectations are already set for the Core.DeleteExpired method")
+	}
+
+	mmDeleteExpired.mock.funcDeleteExpired = f
+	mmDeleteExpired.mock.funcDeleteExpiredOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired.mock
+}
+
+// When sets expectation for the Core.DeleteExpired which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpired *mCoreMockDeleteExpired) When(ctx context.Context) *CoreMockDeleteExpiredExpectation {
+	if mmDeleteExpired.mock.funcDeleteExpired != nil {
+		mmDeleteExpired.mock.t.Fatalf("CoreMock.DeleteExpired mock is already set by Set")
+	}
+
+	expectation := &CoreMockDeleteExpiredExpectation{
+		mock:               mmDeleteExpired.mock,
+		params:             &CoreMockDeleteExpiredParams{ctx},
+		expectationOrigins: CoreMockDeleteExpiredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpired.expectations = append(mmDeleteExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.DeleteExpired return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeleteExpiredExpectation) Then(i1 int, err error) *CoreMock {
+	e.results = &CoreMockDeleteExpiredResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.DeleteExpired should be invoked
+func (mmDeleteExpired *mCoreMockDeleteExpired) Times(n uint64) *mCoreMockDeleteExpired {
+	if n == 0 {
+		mmDeleteExpired.mock.t.Fatalf("Times of CoreMock.DeleteExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpired.expectedInvocations, n)
+	mmDeleteExpired.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpired
+}
+
+func (mmDeleteExpired *mCoreMockDeleteExpired) invocationsDone() bool {
+	if len(mmDeleteExpired.expectations) == 0 && mmDeleteExpired.defaultExpectation == nil && mmDeleteExpired.mock.funcDeleteExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.mock.afterDeleteExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpired implements mm_usecase.Core
+func (mmDeleteExpired *CoreMock) DeleteExpired(ctx context.Context) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpired.beforeDeleteExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpired.afterDeleteExpiredCounter, 1)
+
+	mmDeleteExpired.t.Helper()
+
+	if mmDeleteExpired.inspectFuncDeleteExpired != nil {
+		mmDeleteExpired.inspectFuncDeleteExpired(ctx)
+	}
+
+	mm_params := CoreMockDeleteExpiredParams{ctx}
+
+	// Record call args
+	mmDeleteExpired.DeleteExpiredMock.mutex.Lock()
+	mmDeleteExpired.DeleteExpiredMock.callArgs = append(mmDeleteExpired.DeleteExpiredMock.callArgs, &mm_params)
+	mmDeleteExpired.DeleteExpiredMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpired.DeleteExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpired.DeleteExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpired.DeleteExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockDeleteExpiredParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpired.t.Errorf("CoreMock.DeleteExpired got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpired.DeleteExpiredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpired.t.Errorf("CoreMock.DeleteExpired got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpired.DeleteExpiredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpired.DeleteExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpired.t.Fatal("No results are set for the CoreMock.DeleteExpired")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpired.funcDeleteExpired != nil {
+		return mmDeleteExpired.funcDeleteExpired(ctx)
+	}
+	mmDeleteExpired.t.Fatalf("Unexpected call to CoreMock.DeleteExpired. %v", ctx)
+	return
+}
+
+// DeleteExpiredAfterCounter returns a count of finished CoreMock.DeleteExpired invocations
+func (mmDeleteExpired *CoreMock) DeleteExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.afterDeleteExpiredCounter)
+}
+
+// DeleteExpiredBeforeCounter returns a count of CoreMock.DeleteExpired invocations
+func (mmDeleteExpired *CoreMock) DeleteExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpired.beforeDeleteExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.DeleteExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpired *mCoreMockDeleteExpired) Calls() []*CoreMockDeleteExpiredParams {
+	mmDeleteExpired.mutex.RLock()
+
+	argCopy := make([]*CoreMockDeleteExpiredParams, len(mmDeleteExpired.callArgs))
+	copy(argCopy, mmDeleteExpired.callArgs)
+
+	mmDeleteExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredDone returns true if the count of the DeleteExpired invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockDeleteExpiredDone() bool {
+	if m.DeleteExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeleteExpiredInspect() {
+	for _, e := range m.DeleteExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.DeleteExpired at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMock.defaultExpectation != nil && afterDeleteExpiredCounter < 1 {
+		if m.DeleteExpiredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeleteExpired at\n%s", m.DeleteExpiredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.DeleteExpired at\n%s with params: %#v", m.DeleteExpiredMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpired != nil && afterDeleteExpiredCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeleteExpired at\n%s", m.funcDeleteExpiredOrigin)
+	}
+
+	if !m.DeleteExpiredMock.invocationsDone() && afterDeleteExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeleteExpired at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredMock.expectedInvocations), m.DeleteExpiredMock.expectedInvocationsOrigin, afterDeleteExpiredCounter)
+	}
+}
+
+type mCoreMockFail struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockFailExpectation
+	expectations       []*CoreMockFailExpectation
+
+	callArgs []*CoreMockFailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockFailExpectation specifies expectation struct of the Core.Fail
+type CoreMockFailExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockFailParams
+	paramPtrs          *CoreMockFailParamPtrs
+	expectationOrigins CoreMockFailExpectationOrigins
+	results            *CoreMockFailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockFailParams contains parameters of the Core.Fail
+type CoreMockFailParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockFailParamPtrs contains pointers to parameters of the Core.Fail
+type CoreMockFailParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockFailResults contains results of the Core.Fail
+type CoreMockFailResults struct {
+	err error
+}
+
+// CoreMockFailOrigins contains origins of expectations of the Core.Fail
+type CoreMockFailExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFail *mCoreMockFail) Optional() *mCoreMockFail {
+	mmFail.optional = true
+	return mmFail
+}
+
+// Expect sets up expected params for Core.Fail
+func (mmFail *mCoreMockFail) Expect(ctx context.Context, id uuid.UUID) *mCoreMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &CoreMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.paramPtrs != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by ExpectParams functions")
+	}
+
+	mmFail.defaultExpectation.params = &CoreMockFailParams{ctx, id}
+	mmFail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFail.expectations {
+		if minimock.Equal(e.params, mmFail.defaultExpectation.params) {
+			mmFail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFail.defaultExpectation.params)
+		}
+	}
+
+	return mmFail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Fail
+func (mmFail *mCoreMockFail) ExpectCtxParam1(ctx context.Context) *mCoreMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &CoreMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.params != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Expect")
+	}
+
+	if mmFail.defaultExpectation.paramPtrs == nil {
+		mmFail.defaultExpectation.paramPtrs = &CoreMockFailParamPtrs{}
+	}
+	mmFail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFail
+}
+
+// ExpectIdParam2 sets up expected param id for Core.Fail
+func (mmFail *mCoreMockFail) ExpectIdParam2(id uuid.UUID) *mCoreMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &CoreMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.params != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Expect")
+	}
+
+	if mmFail.defaultExpectation.paramPtrs == nil {
+		mmFail.defaultExpectation.paramPtrs = &CoreMockFailParamPtrs{}
+	}
+	mmFail.defaultExpectation.paramPtrs.id = &id
+	mmFail.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Fail
+func (mmFail *mCoreMockFail) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockFail {
+	if mmFail.mock.inspectFuncFail != nil {
+		mmFail.mock.t.Fatalf("Inspect function is already set for CoreMock.Fail")
+	}
+
+	mmFail.mock.inspectFuncFail = f
+
+	return mmFail
+}
+
+// Return sets up results that will be returned by Core.Fail
+func (mmFail *mCoreMockFail) Return(err error) *CoreMock {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &CoreMockFailExpectation{mock: mmFail.mock}
+	}
+	mmFail.defaultExpectation.results = &CoreMockFailResults{err}
+	mmFail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFail.mock
+}
+
+// Set uses given function f to mock the Core.Fail method
+func (mmFail *mCoreMockFail) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *CoreMock {
+	if mmFail.defaultExpectation != nil {
+		mmFail.mock.t.Fatalf("Default expectation is already set for the Core.Fail method")
+	}
+
+	if len(mmFail.expectations) > 0 {
+		mmFail.mock.t.Fatalf("Some expectations are already set for the Core.Fail method")
+	}
+
+	mmFail.mock.funcFail = f
+	mmFail.mock.funcFailOrigin = minimock.CallerInfo(1)
+	return mmFail.mock
+}
+
+// When sets expectation for the Core.Fail which will trigger the result defined by the following
+// Then helper
+func (mmFail *mCoreMockFail) When(ctx context.Context, id uuid.UUID) *CoreMockFailExpectation {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("CoreMock.Fail mock is already set by Set")
+	}
+
+	expectation := &CoreMockFailExpectation{
+		mock:               mmFail.mock,
+		params:             &CoreMockFailParams{ctx, id},
+		expectationOrigins: CoreMockFailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFail.expectations = append(mmFail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Fail return parameters for the expectation previously defined by the When method
+func (e *CoreMockFailExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockFailResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.Fail should be invoked
+func (mmFail *mCoreMockFail) Times(n uint64) *mCoreMockFail {
+	if n == 0 {
+		mmFail.mock.t.Fatalf("Times of CoreMock.Fail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFail.expectedInvocations, n)
+	mmFail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFail
+}
+
+func (mmFail *mCoreMockFail) invocationsDone() bool {
+	if len(mmFail.expectations) == 0 && mmFail.defaultExpectation == nil && mmFail.mock.funcFail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFail.mock.afterFailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Fail implements mm_usecase.Core
+func (mmFail *CoreMock) Fail(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmFail.beforeFailCounter, 1)
+	defer mm_atomic.AddUint64(&mmFail.afterFailCounter, 1)
+
+	mmFail.t.Helper()
+
+	if mmFail.inspectFuncFail != nil {
+		mmFail.inspectFuncFail(ctx, id)
+	}
+
+	mm_params := CoreMockFailParams{ctx, id}
+
+	// Record call args
+	mmFail.FailMock.mutex.Lock()
+	mmFail.FailMock.callArgs = append(mmFail.FailMock.callArgs, &mm_params)
+	mmFail.FailMock.mutex.Unlock()
+
+	for _, e := range mmFail.FailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFail.FailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFail.FailMock.defaultExpectation.Counter, 1)
+		mm_want := mmFail.FailMock.defaultExpectation.params
+		mm_want_ptrs := mmFail.FailMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockFailParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFail.t.Errorf("CoreMock.Fail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFail.FailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFail.t.Errorf("CoreMock.Fail got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFail.FailMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFail.t.Errorf("CoreMock.Fail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFail.FailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFail.FailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFail.t.Fatal("No results are set for the CoreMock.Fail")
+		}
+		return (*mm_results).err
+	}
+	if mmFail.funcFail != nil {
+		return mmFail.funcFail(ctx, id)
+	}
+	mmFail.t.Fatalf("Unexpected call to CoreMock.Fail. %v %v", ctx, id)
+	return
+}
+
+// FailAfterCounter returns a count of finished CoreMock.Fail invocations
+func (mmFail *CoreMock) FailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFail.afterFailCounter)
+}
+
+// FailBeforeCounter returns a count of CoreMock.Fail invocations
+func (mmFail *CoreMock) FailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFail.beforeFailCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Fail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFail *mCoreMockFail) Calls() []*CoreMockFailParams {
+	mmFail.mutex.RLock()
+
+	argCopy := make([]*CoreMockFailParams, len(mmFail.callArgs))
+	copy(argCopy, mmFail.callArgs)
+
+	mmFail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFailDone returns true if the count of the Fail invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockFailDone() bool {
+	if m.FailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FailMock.invocationsDone()
+}
+
+// MinimockFailInspect logs each unmet expectation
+func (m *CoreMock) MinimockFailInspect() {
+	for _, e := range m.FailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Fail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFailCounter := mm_atomic.LoadUint64(&m.afterFailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FailMock.defaultExpectation != nil && afterFailCounter < 1 {
+		if m.FailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Fail at\n%s", m.FailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Fail at\n%s with params: %#v", m.FailMock.defaultExpectation.expectationOrigins.origin, *m.FailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFail != nil && afterFailCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Fail at\n%s", m.funcFailOrigin)
+	}
+
+	if !m.FailMock.invocationsDone() && afterFailCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Fail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FailMock.expectedInvocations), m.FailMock.expectedInvocationsOrigin, afterFailCounter)
+	}
+}
+
+type mCoreMockOpen struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockOpenExpectation
+	expectations       []*CoreMockOpenExpectation
+
+	callArgs []*CoreMockOpenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockOpenExpectation specifies expectation struct of the Core.Open
+type CoreMockOpenExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockOpenParams
+	paramPtrs          *CoreMockOpenParamPtrs
+	expectationOrigins CoreMockOpenExpectationOrigins
+	results            *CoreMockOpenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockOpenParams contains parameters of the Core.Open
+type CoreMockOpenParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CoreMockOpenParamPtrs contains pointers to parameters of the Core.Open
+type CoreMockOpenParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockOpenResults contains results of the Core.Open
+type CoreMockOpenResults struct {
+	e1  dataexport.Export
+	r1  io.ReadCloser
+	err error
+}
+
+// CoreMockOpenOrigins contains origins of expectations of the Core.Open
+type CoreMockOpenExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpen *mCoreMockOpen) Optional() *mCoreMockOpen {
+	mmOpen.optional = true
+	return mmOpen
+}
+
+// Expect sets up expected params for Core.Open
+func (mmOpen *mCoreMockOpen) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by ExpectParams functions")
+	}
+
+	mmOpen.defaultExpectation.params = &CoreMockOpenParams{ctx, userID}
+	mmOpen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpen.expectations {
+		if minimock.Equal(e.params, mmOpen.defaultExpectation.params) {
+			mmOpen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpen.defaultExpectation.params)
+		}
+	}
+
+	return mmOpen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Open
+func (mmOpen *mCoreMockOpen) ExpectCtxParam1(ctx context.Context) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &CoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.Open
+func (mmOpen *mCoreMockOpen) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &CoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.userID = &userID
+	mmOpen.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Open
+func (mmOpen *mCoreMockOpen) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockOpen {
+	if mmOpen.mock.inspectFuncOpen != nil {
+		mmOpen.mock.t.Fatalf("Inspect function is already set for CoreMock.Open")
+	}
+
+	mmOpen.mock.inspectFuncOpen = f
+
+	return mmOpen
+}
+
+// Return sets up results that will be returned by Core.Open
+func (mmOpen *mCoreMockOpen) Return(e1 dataexport.Export, r1 io.ReadCloser, err error) *CoreMock {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &CoreMockOpenExpectation{mock: mmOpen.mock}
+	}
+	mmOpen.defaultExpectation.results = &CoreMockOpenResults{e1, r1, err}
+	mmOpen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// Set uses given function f to mock the Core.Open method
+func (mmOpen *mCoreMockOpen) Set(f func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error)) *CoreMock {
+	if mmOpen.defaultExpectation != nil {
+		mmOpen.mock.t.Fatalf("Default expectation is already set for the Core.Open method")
+	}
+
+	if len(mmOpen.expectations) > 0 {
+		mmOpen.mock.t.Fatalf("Some expectations are already set for the Core.Open method")
+	}
+
+	mmOpen.mock.funcOpen = f
+	mmOpen.mock.funcOpenOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// When sets expectation for the Core.Open which will trigger the result defined by the following
+// Then helper
+func (mmOpen *mCoreMockOpen) When(ctx context.Context, userID uuid.UUID) *CoreMockOpenExpectation {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("CoreMock.Open mock is already set by Set")
+	}
+
+	expectation := &CoreMockOpenExpectation{
+		mock:               mmOpen.mock,
+		params:             &CoreMockOpenParams{ctx, userID},
+		expectationOrigins: CoreMockOpenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpen.expectations = append(mmOpen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Open return parameters for the expectation previously defined by the When method
+func (e *CoreMockOpenExpectation) Then(e1 dataexport.Export, r1 io.ReadCloser, err error) *CoreMock {
+	e.results = &CoreMockOpenResults{e1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Open should be invoked
+func (mmOpen *mCoreMockOpen) Times(n uint64) *mCoreMockOpen {
+	if n == 0 {
+		mmOpen.mock.t.Fatalf("Times of CoreMock.Open mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpen.expectedInvocations, n)
+	mmOpen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpen
+}
+
+func (mmOpen *mCoreMockOpen) invocationsDone() bool {
+	if len(mmOpen.expectations) == 0 && mmOpen.defaultExpectation == nil && mmOpen.mock.funcOpen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpen.mock.afterOpenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Open implements mm_usecase.Core
+func (mmOpen *CoreMock) Open(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpen.beforeOpenCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpen.afterOpenCounter, 1)
+
+	mmOpen.t.Helper()
+
+	if mmOpen.inspectFuncOpen != nil {
+		mmOpen.inspectFuncOpen(ctx, userID)
+	}
+
+	mm_params := CoreMockOpenParams{ctx, userID}
+
+	// Record call args
+	mmOpen.OpenMock.mutex.Lock()
+	mmOpen.OpenMock.callArgs = append(mmOpen.OpenMock.callArgs, &mm_params)
+	mmOpen.OpenMock.mutex.Unlock()
+
+	for _, e := range mmOpen.OpenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpen.OpenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpen.OpenMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpen.OpenMock.defaultExpectation.params
+		mm_want_ptrs := mmOpen.OpenMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockOpenParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpen.t.Errorf("CoreMock.Open got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmOpen.t.Errorf("CoreMock.Open got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpen.t.Errorf("CoreMock.Open got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpen.OpenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpen.OpenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpen.t.Fatal("No results are set for the CoreMock.Open")
+		}
+		return (*mm_results).e1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpen.funcOpen != nil {
+		return mmOpen.funcOpen(ctx, userID)
+	}
+	mmOpen.t.Fatalf("Unexpected call to CoreMock.Open. %v %v", ctx, userID)
+	return
+}
+
+// OpenAfterCounter returns a count of finished CoreMock.Open invocations
+func (mmOpen *CoreMock) OpenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.afterOpenCounter)
+}
+
+// OpenBeforeCounter returns a count of CoreMock.Open invocations
+func (mmOpen *CoreMock) OpenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.beforeOpenCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Open.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpen *mCoreMockOpen) Calls() []*CoreMockOpenParams {
+	mmOpen.mutex.RLock()
+
+	argCopy := make([]*CoreMockOpenParams, len(mmOpen.callArgs))
+	copy(argCopy, mmOpen.callArgs)
+
+	mmOpen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenDone returns true if the count of the Open invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockOpenDone() bool {
+	if m.OpenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenMock.invocationsDone()
+}
+
+// MinimockOpenInspect logs each unmet expectation
+func (m *CoreMock) MinimockOpenInspect() {
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenCounter := mm_atomic.LoadUint64(&m.afterOpenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenMock.defaultExpectation != nil && afterOpenCounter < 1 {
+		if m.OpenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s", m.OpenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Open at\n%s with params: %#v", m.OpenMock.defaultExpectation.expectationOrigins.origin, *m.OpenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpen != nil && afterOpenCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Open at\n%s", m.funcOpenOrigin)
+	}
+
+	if !m.OpenMock.invocationsDone() && afterOpenCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Open at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenMock.expectedInvocations), m.OpenMock.expectedInvocationsOrigin, afterOpenCounter)
+	}
+}
+
+type mCoreMockRequest struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockRequestExpectation
+	expectations       []*CoreMockRequestExpectation
+
+	callArgs []*CoreMockRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockRequestExpectation specifies expectation struct of the Core.Request
+type CoreMockRequestExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockRequestParams
+	paramPtrs          *CoreMockRequestParamPtrs
+	expectationOrigins CoreMockRequestExpectationOrigins
+	results            *CoreMockRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockRequestParams contains parameters of the Core.Request
+type CoreMockRequestParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CoreMockRequestParamPtrs contains pointers to parameters of the Core.Request
+type CoreMockRequestParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockRequestResults contains results of the Core.Request
+type CoreMockRequestResults struct {
+	e1  dataexport.Export
+	b1  bool
+	err error
+}
+
+// CoreMockRequestOrigins contains origins of expectations of the Core.Request
+type CoreMockRequestExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequest *mCoreMockRequest) Optional() *mCoreMockRequest {
+	mmRequest.optional = true
+	return mmRequest
+}
+
+// Expect sets up expected params for Core.Request
+func (mmRequest *mCoreMockRequest) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &CoreMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by ExpectParams functions")
+	}
+
+	mmRequest.defaultExpectation.params = &CoreMockRequestParams{ctx, userID}
+	mmRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequest.expectations {
+		if minimock.Equal(e.params, mmRequest.defaultExpectation.params) {
+			mmRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Request
+func (mmRequest *mCoreMockRequest) ExpectCtxParam1(ctx context.Context) *mCoreMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &CoreMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &CoreMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequest
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.Request
+func (mmRequest *mCoreMockRequest) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockRequest {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &CoreMockRequestExpectation{}
+	}
+
+	if mmRequest.defaultExpectation.params != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Expect")
+	}
+
+	if mmRequest.defaultExpectation.paramPtrs == nil {
+		mmRequest.defaultExpectation.paramPtrs = &CoreMockRequestParamPtrs{}
+	}
+	mmRequest.defaultExpectation.paramPtrs.userID = &userID
+	mmRequest.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Request
+func (mmRequest *mCoreMockRequest) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockRequest {
+	if mmRequest.mock.inspectFuncRequest != nil {
+		mmRequest.mock.t.Fatalf("Inspect function is already set for CoreMock.Request")
+	}
+
+	mmRequest.mock.inspectFuncRequest = f
+
+	return mmRequest
+}
+
+// Return sets up results that will be returned by Core.Request
+func (mmRequest *mCoreMockRequest) Return(e1 dataexport.Export, b1 bool, err error) *CoreMock {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Set")
+	}
+
+	if mmRequest.defaultExpectation == nil {
+		mmRequest.defaultExpectation = &CoreMockRequestExpectation{mock: mmRequest.mock}
+	}
+	mmRequest.defaultExpectation.results = &CoreMockRequestResults{e1, b1, err}
+	mmRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequest.mock
+}
+
+// Set uses given function f to mock the Core.Request method
+func (mmRequest *mCoreMockRequest) Set(f func(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, b1 bool, err error)) *CoreMock {
+	if mmRequest.defaultExpectation != nil {
+		mmRequest.mock.t.Fatalf("Default expectation is already set for the Core.Request method")
+	}
+
+	if len(mmRequest.expectations) > 0 {
+		mmRequest.mock.t.Fatalf("Some expectations are already set for the Core.Request method")
+	}
+
+	mmRequest.mock.funcRequest = f
+	mmRequest.mock.funcRequestOrigin = minimock.CallerInfo(1)
+	return mmRequest.mock
+}
+
+// When sets expectation for the Core.Request which will trigger the result defined by the following
+// Then helper
+func (mmRequest *mCoreMockRequest) When(ctx context.Context, userID uuid.UUID) *CoreMockRequestExpectation {
+	if mmRequest.mock.funcRequest != nil {
+		mmRequest.mock.t.Fatalf("CoreMock.Request mock is already set by Set")
+	}
+
+	expectation := &CoreMockRequestExpectation{
+		mock:               mmRequest.mock,
+		params:             &CoreMockRequestParams{ctx, userID},
+		expectationOrigins: CoreMockRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequest.expectations = append(mmRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Request return parameters for the expectation previously defined by the When method
+func (e *CoreMockRequestExpectation) Then(e1 dataexport.Export, b1 bool, err error) *CoreMock {
+	e.results = &CoreMockRequestResults{e1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Request should be invoked
+func (mmRequest *mCoreMockRequest) Times(n uint64) *mCoreMockRequest {
+	if n == 0 {
+		mmRequest.mock.t.Fatalf("Times of CoreMock.Request mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequest.expectedInvocations, n)
+	mmRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequest
+}
+
+func (mmRequest *mCoreMockRequest) invocationsDone() bool {
+	if len(mmRequest.expectations) == 0 && mmRequest.defaultExpectation == nil && mmRequest.mock.funcRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequest.mock.afterRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Request implements mm_usecase.Core
+func (mmRequest *CoreMock) Request(ctx context.Context, userID uuid.UUID) (e1 dataexport.Export, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRequest.beforeRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequest.afterRequestCounter, 1)
+
+	mmRequest.t.Helper()
+
+	if mmRequest.inspectFuncRequest != nil {
+		mmRequest.inspectFuncRequest(ctx, userID)
+	}
+
+	mm_params := CoreMockRequestParams{ctx, userID}
+
+	// Record call args
+	mmRequest.RequestMock.mutex.Lock()
+	mmRequest.RequestMock.callArgs = append(mmRequest.RequestMock.callArgs, &mm_params)
+	mmRequest.RequestMock.mutex.Unlock()
+
+	for _, e := range mmRequest.RequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmRequest.RequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequest.RequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequest.RequestMock.defaultExpectation.params
+		mm_want_ptrs := mmRequest.RequestMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockRequestParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequest.t.Errorf("CoreMock.Request got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequest.RequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRequest.t.Errorf("CoreMock.Request got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequest.RequestMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequest.t.Errorf("CoreMock.Request got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequest.RequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequest.RequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequest.t.Fatal("No results are set for the CoreMock.Request")
+		}
+		return (*mm_results).e1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmRequest.funcRequest != nil {
+		return mmRequest.funcRequest(ctx, userID)
+	}
+	mmRequest.t.Fatalf("Unexpected call to CoreMock.Request. %v %v", ctx, userID)
+	return
+}
+
+// RequestAfterCounter returns a count of finished CoreMock.Request invocations
+func (mmRequest *CoreMock) RequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.afterRequestCounter)
+}
+
+// RequestBeforeCounter returns a count of CoreMock.Request invocations
+func (mmRequest *CoreMock) RequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequest.beforeRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Request.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequest *mCoreMockRequest) Calls() []*CoreMockRequestParams {
+	mmRequest.mutex.RLock()
+
+	argCopy := make([]*CoreMockRequestParams, len(mmRequest.callArgs))
+	copy(argCopy, mmRequest.callArgs)
+
+	mmRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestDone returns true if the count of the Request invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockRequestDone() bool {
+	if m.RequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestMock.invocationsDone()
+}
+
+// MinimockRequestInspect logs each unmet expectation
+func (m *CoreMock) MinimockRequestInspect() {
+	for _, e := range m.RequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Request at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestCounter := mm_atomic.LoadUint64(&m.afterRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestMock.defaultExpectation != nil && afterRequestCounter < 1 {
+		if m.RequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Request at\n%s", m.RequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Request at\n%s with params: %#v", m.RequestMock.defaultExpectation.expectationOrigins.origin, *m.RequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequest != nil && afterRequestCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Request at\n%s", m.funcRequestOrigin)
+	}
+
+	if !m.RequestMock.invocationsDone() && afterRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Request at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestMock.expectedInvocations), m.RequestMock.expectedInvocationsOrigin, afterRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimInspect()
+
+			m.MinimockCompleteInspect()
+
+			m.MinimockDeleteExpiredInspect()
+
+			m.MinimockFailInspect()
+
+			m.MinimockOpenInspect()
+
+			m.MinimockRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDone() &&
+		m.MinimockCompleteDone() &&
+		m.MinimockDeleteExpiredDone() &&
+		m.MinimockFailDone() &&
+		m.MinimockOpenDone() &&
+		m.MinimockRequestDone()
+}
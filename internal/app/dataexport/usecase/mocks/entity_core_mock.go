@@ -0,0 +1,419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/dataexport/usecase.EntityCore -o entity_core_mock.go -n EntityCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// EntityCoreMock implements mm_usecase.EntityCore
+type EntityCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetAuthoredVersions          func(ctx context.Context, userID uuid.UUID) (aa1 []entity.AuthoredVersion, err error)
+	funcGetAuthoredVersionsOrigin    string
+	inspectFuncGetAuthoredVersions   func(ctx context.Context, userID uuid.UUID)
+	afterGetAuthoredVersionsCounter  uint64
+	beforeGetAuthoredVersionsCounter uint64
+	GetAuthoredVersionsMock          mEntityCoreMockGetAuthoredVersions
+}
+
+// NewEntityCoreMock returns a mock for mm_usecase.EntityCore
+func NewEntityCoreMock(t minimock.Tester) *EntityCoreMock {
+	m := &EntityCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetAuthoredVersionsMock = mEntityCoreMockGetAuthoredVersions{mock: m}
+	m.GetAuthoredVersionsMock.callArgs = []*EntityCoreMockGetAuthoredVersionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEntityCoreMockGetAuthoredVersions struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetAuthoredVersionsExpectation
+	expectations       []*EntityCoreMockGetAuthoredVersionsExpectation
+
+	callArgs []*EntityCoreMockGetAuthoredVersionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetAuthoredVersionsExpectation specifies expectation struct of the EntityCore.GetAuthoredVersions
+type EntityCoreMockGetAuthoredVersionsExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetAuthoredVersionsParams
+	paramPtrs          *EntityCoreMockGetAuthoredVersionsParamPtrs
+	expectationOrigins EntityCoreMockGetAuthoredVersionsExpectationOrigins
+	results            *EntityCoreMockGetAuthoredVersionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetAuthoredVersionsParams contains parameters of the EntityCore.GetAuthoredVersions
+type EntityCoreMockGetAuthoredVersionsParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// EntityCoreMockGetAuthoredVersionsParamPtrs contains pointers to parameters of the EntityCore.GetAuthoredVersions
+type EntityCoreMockGetAuthoredVersionsParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// EntityCoreMockGetAuthoredVersionsResults contains results of the EntityCore.GetAuthoredVersions
+type EntityCoreMockGetAuthoredVersionsResults struct {
+	aa1 []entity.AuthoredVersion
+	err error
+}
+
+// EntityCoreMockGetAuthoredVersionsOrigins contains origins of expectations of the EntityCore.GetAuthoredVersions
+type EntityCoreMockGetAuthoredVersionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Optional() *mEntityCoreMockGetAuthoredVersions {
+	mmGetAuthoredVersions.optional = true
+	return mmGetAuthoredVersions
+}
+
+// Expect sets up expected params for EntityCore.GetAuthoredVersions
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Expect(ctx context.Context, userID uuid.UUID) *mEntityCoreMockGetAuthoredVersions {
+	if mmGetAuthoredVersions.mock.funcGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Set")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation == nil {
+		mmGetAuthoredVersions.defaultExpectation = &EntityCoreMockGetAuthoredVersionsExpectation{}
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation.paramPtrs != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by ExpectParams functions")
+	}
+
+	mmGetAuthoredVersions.defaultExpectation.params = &EntityCoreMockGetAuthoredVersionsParams{ctx, userID}
+	mmGetAuthoredVersions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAuthoredVersions.expectations {
+		if minimock.Equal(e.params, mmGetAuthoredVersions.defaultExpectation.params) {
+			mmGetAuthoredVersions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAuthoredVersions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAuthoredVersions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.GetAuthoredVersions
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGetAuthoredVersions {
+	if mmGetAuthoredVersions.mock.funcGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Set")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation == nil {
+		mmGetAuthoredVersions.defaultExpectation = &EntityCoreMockGetAuthoredVersionsExpectation{}
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation.params != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Expect")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation.paramPtrs == nil {
+		mmGetAuthoredVersions.defaultExpectation.paramPtrs = &EntityCoreMockGetAuthoredVersionsParamPtrs{}
+	}
+	mmGetAuthoredVersions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAuthoredVersions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAuthoredVersions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EntityCore.GetAuthoredVersions
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) ExpectUserIDParam2(userID uuid.UUID) *mEntityCoreMockGetAuthoredVersions {
+	if mmGetAuthoredVersions.mock.funcGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Set")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation == nil {
+		mmGetAuthoredVersions.defaultExpectation = &EntityCoreMockGetAuthoredVersionsExpectation{}
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation.params != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Expect")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation.paramPtrs == nil {
+		mmGetAuthoredVersions.defaultExpectation.paramPtrs = &EntityCoreMockGetAuthoredVersionsParamPtrs{}
+	}
+	mmGetAuthoredVersions.defaultExpectation.paramPtrs.userID = &userID
+	mmGetAuthoredVersions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetAuthoredVersions
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.GetAuthoredVersions
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mEntityCoreMockGetAuthoredVersions {
+	if mmGetAuthoredVersions.mock.inspectFuncGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.GetAuthoredVersions")
+	}
+
+	mmGetAuthoredVersions.mock.inspectFuncGetAuthoredVersions = f
+
+	return mmGetAuthoredVersions
+}
+
+// Return sets up results that will be returned by EntityCore.GetAuthoredVersions
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Return(aa1 []entity.AuthoredVersion, err error) *EntityCoreMock {
+	if mmGetAuthoredVersions.mock.funcGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Set")
+	}
+
+	if mmGetAuthoredVersions.defaultExpectation == nil {
+		mmGetAuthoredVersions.defaultExpectation = &EntityCoreMockGetAuthoredVersionsExpectation{mock: mmGetAuthoredVersions.mock}
+	}
+	mmGetAuthoredVersions.defaultExpectation.results = &EntityCoreMockGetAuthoredVersionsResults{aa1, err}
+	mmGetAuthoredVersions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAuthoredVersions.mock
+}
+
+// Set uses given function f to mock the EntityCore.GetAuthoredVersions method
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Set(f func(ctx context.Context, userID uuid.UUID) (aa1 []entity.AuthoredVersion, err error)) *EntityCoreMock {
+	if mmGetAuthoredVersions.defaultExpectation != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("Default expectation is already set for the EntityCore.GetAuthoredVersions method")
+	}
+
+	if len(mmGetAuthoredVersions.expectations) > 0 {
+		mmGetAuthoredVersions.mock.t.Fatalf("Some expectations are already set for the EntityCore.GetAuthoredVersions method")
+	}
+
+	mmGetAuthoredVersions.mock.funcGetAuthoredVersions = f
+	mmGetAuthoredVersions.mock.funcGetAuthoredVersionsOrigin = minimock.CallerInfo(1)
+	return mmGetAuthoredVersions.mock
+}
+
+// When sets expectation for the EntityCore.GetAuthoredVersions which will trigger the result defined by the following
+// Then helper
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) When(ctx context.Context, userID uuid.UUID) *EntityCoreMockGetAuthoredVersionsExpectation {
+	if mmGetAuthoredVersions.mock.funcGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.mock.t.Fatalf("EntityCoreMock.GetAuthoredVersions mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetAuthoredVersionsExpectation{
+		mock:               mmGetAuthoredVersions.mock,
+		params:             &EntityCoreMockGetAuthoredVersionsParams{ctx, userID},
+		expectationOrigins: EntityCoreMockGetAuthoredVersionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAuthoredVersions.expectations = append(mmGetAuthoredVersions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.GetAuthoredVersions return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetAuthoredVersionsExpectation) Then(aa1 []entity.AuthoredVersion, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetAuthoredVersionsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.GetAuthoredVersions should be invoked
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Times(n uint64) *mEntityCoreMockGetAuthoredVersions {
+	if n == 0 {
+		mmGetAuthoredVersions.mock.t.Fatalf("Times of EntityCoreMock.GetAuthoredVersions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAuthoredVersions.expectedInvocations, n)
+	mmGetAuthoredVersions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAuthoredVersions
+}
+
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) invocationsDone() bool {
+	if len(mmGetAuthoredVersions.expectations) == 0 && mmGetAuthoredVersions.defaultExpectation == nil && mmGetAuthoredVersions.mock.funcGetAuthoredVersions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAuthoredVersions.mock.afterGetAuthoredVersionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAuthoredVersions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAuthoredVersions implements mm_usecase.EntityCore
+func (mmGetAuthoredVersions *EntityCoreMock) GetAuthoredVersions(ctx context.Context, userID uuid.UUID) (aa1 []entity.AuthoredVersion, err error) {
+	mm_atomic.AddUint64(&mmGetAuthoredVersions.beforeGetAuthoredVersionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAuthoredVersions.afterGetAuthoredVersionsCounter, 1)
+
+	mmGetAuthoredVersions.t.Helper()
+
+	if mmGetAuthoredVersions.inspectFuncGetAuthoredVersions != nil {
+		mmGetAuthoredVersions.inspectFuncGetAuthoredVersions(ctx, userID)
+	}
+
+	mm_params := EntityCoreMockGetAuthoredVersionsParams{ctx, userID}
+
+	// Record call args
+	mmGetAuthoredVersions.GetAuthoredVersionsMock.mutex.Lock()
+	mmGetAuthoredVersions.GetAuthoredVersionsMock.callArgs = append(mmGetAuthoredVersions.GetAuthoredVersionsMock.callArgs, &mm_params)
+	mmGetAuthoredVersions.GetAuthoredVersionsMock.mutex.Unlock()
+
+	for _, e := range mmGetAuthoredVersions.GetAuthoredVersionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetAuthoredVersionsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAuthoredVersions.t.Errorf("EntityCoreMock.GetAuthoredVersions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetAuthoredVersions.t.Errorf("EntityCoreMock.GetAuthoredVersions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAuthoredVersions.t.Errorf("EntityCoreMock.GetAuthoredVersions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAuthoredVersions.GetAuthoredVersionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAuthoredVersions.t.Fatal("No results are set for the EntityCoreMock.GetAuthoredVersions")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmGetAuthoredVersions.funcGetAuthoredVersions != nil {
+		return mmGetAuthoredVersions.funcGetAuthoredVersions(ctx, userID)
+	}
+	mmGetAuthoredVersions.t.Fatalf("Unexpected call to EntityCoreMock.GetAuthoredVersions. %v %v", ctx, userID)
+	return
+}
+
+// GetAuthoredVersionsAfterCounter returns a count of finished EntityCoreMock.GetAuthoredVersions invocations
+func (mmGetAuthoredVersions *EntityCoreMock) GetAuthoredVersionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuthoredVersions.afterGetAuthoredVersionsCounter)
+}
+
+// GetAuthoredVersionsBeforeCounter returns a count of EntityCoreMock.GetAuthoredVersions invocations
+func (mmGetAuthoredVersions *EntityCoreMock) GetAuthoredVersionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuthoredVersions.beforeGetAuthoredVersionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.GetAuthoredVersions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAuthoredVersions *mEntityCoreMockGetAuthoredVersions) Calls() []*EntityCoreMockGetAuthoredVersionsParams {
+	mmGetAuthoredVersions.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetAuthoredVersionsParams, len(mmGetAuthoredVersions.callArgs))
+	copy(argCopy, mmGetAuthoredVersions.callArgs)
+
+	mmGetAuthoredVersions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAuthoredVersionsDone returns true if the count of the GetAuthoredVersions invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetAuthoredVersionsDone() bool {
+	if m.GetAuthoredVersionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAuthoredVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAuthoredVersionsMock.invocationsDone()
+}
+
+// MinimockGetAuthoredVersionsInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetAuthoredVersionsInspect() {
+	for _, e := range m.GetAuthoredVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.GetAuthoredVersions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAuthoredVersionsCounter := mm_atomic.LoadUint64(&m.afterGetAuthoredVersionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAuthoredVersionsMock.defaultExpectation != nil && afterGetAuthoredVersionsCounter < 1 {
+		if m.GetAuthoredVersionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.GetAuthoredVersions at\n%s", m.GetAuthoredVersionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.GetAuthoredVersions at\n%s with params: %#v", m.GetAuthoredVersionsMock.defaultExpectation.expectationOrigins.origin, *m.GetAuthoredVersionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAuthoredVersions != nil && afterGetAuthoredVersionsCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.GetAuthoredVersions at\n%s", m.funcGetAuthoredVersionsOrigin)
+	}
+
+	if !m.GetAuthoredVersionsMock.invocationsDone() && afterGetAuthoredVersionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.GetAuthoredVersions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAuthoredVersionsMock.expectedInvocations), m.GetAuthoredVersionsMock.expectedInvocationsOrigin, afterGetAuthoredVersionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EntityCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAuthoredVersionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EntityCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EntityCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetAuthoredVersionsDone()
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type Core interface {
+	Request(ctx context.Context, userID uuid.UUID) (dataexport.Export, bool, error)
+	Open(ctx context.Context, userID uuid.UUID) (dataexport.Export, io.ReadCloser, error)
+	Claim(ctx context.Context) (dataexport.Export, bool, error)
+	Complete(ctx context.Context, export dataexport.Export, data dataexport.Data) error
+	Fail(ctx context.Context, id uuid.UUID) error
+	DeleteExpired(ctx context.Context) (int, error)
+}
+
+type AuthCore interface {
+	CheckSelfOrAdmin(ctx context.Context, targetUserID uuid.UUID) error
+	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]auth.Session, error)
+	ListUserRoles(ctx context.Context, userID uuid.UUID) ([]auth.UserRole, error)
+}
+
+type UserCore interface {
+	GetUser(ctx context.Context, id uuid.UUID) (user.User, string, error)
+}
+
+type EntityCore interface {
+	GetAuthoredVersions(ctx context.Context, userID uuid.UUID) ([]entity.AuthoredVersion, error)
+}
+
+type service struct {
+	core       Core
+	authCore   AuthCore
+	userCore   UserCore
+	entityCore EntityCore
+}
+
+func NewService(core Core, authCore AuthCore, userCore UserCore, entityCore EntityCore) *service {
+	if core == nil || authCore == nil || userCore == nil || entityCore == nil {
+		panic("dataexport.NewService: nil dependency")
+	}
+	return &service{core: core, authCore: authCore, userCore: userCore, entityCore: entityCore}
+}
+
+// Request returns the current export of the user, requesting a new one when there is none.
+// Requires admin role or self.
+func (s *service) Request(ctx context.Context, userID uuid.UUID) (dataexport.Export, error) {
+	if err := s.authCore.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Msg("dataexport.service.Request.authCore.CheckSelfOrAdmin")
+		return dataexport.Export{}, fmt.Errorf("dataexport.service.Request: %w", err)
+	}
+	// admins can only export users of their workspace
+	if _, _, err := s.userCore.GetUser(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Msg("dataexport.service.Request.userCore.GetUser")
+		return dataexport.Export{}, fmt.Errorf("dataexport.service.Request: %w", err)
+	}
+
+	export, created, err := s.core.Request(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Msg("dataexport.service.Request: failed to request export")
+		return dataexport.Export{}, fmt.Errorf("dataexport.service.Request: %w", err)
+	}
+	if created {
+		logger.Audit(ctx, "data_export.requested").
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Str(dataexport.FieldExportID.String(), export.ID.String()).
+			Msg("personal data export requested")
+	}
+
+	return export, nil
+}
+
+// Download returns the ready export of the user with its archive, which the caller closes.
+// Requires admin role or self.
+func (s *service) Download(ctx context.Context, userID uuid.UUID) (dataexport.Export, io.ReadCloser, error) {
+	if err := s.authCore.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Msg("dataexport.service.Download.authCore.CheckSelfOrAdmin")
+		return dataexport.Export{}, nil, fmt.Errorf("dataexport.service.Download: %w", err)
+	}
+
+	export, rc, err := s.core.Open(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(dataexport.FieldUserID.String(), userID.String()).
+			Msg("dataexport.service.Download: failed to open export")
+		return dataexport.Export{}, nil, fmt.Errorf("dataexport.service.Download: %w", err)
+	}
+
+	logger.Audit(ctx, "data_export.downloaded").
+		Str(dataexport.FieldUserID.String(), userID.String()).
+		Str(dataexport.FieldExportID.String(), export.ID.String()).
+		Msg("personal data export downloaded")
+	return export, rc, nil
+}
+
+// Run deletes the expired exports, then makes the pending ones one by one and returns how many were
+// made. It runs in the background: an export whose data cannot be read is marked failed and the next
+// one is made.
+func (s *service) Run(ctx context.Context) (int, error) {
+	if _, err := s.core.DeleteExpired(ctx); err != nil {
+		return 0, fmt.Errorf("dataexport.service.Run: %w", err)
+	}
+
+	made := 0
+	for {
+		export, ok, err := s.core.Claim(ctx)
+		if err != nil {
+			return made, fmt.Errorf("dataexport.service.Run: %w", err)
+		}
+		if !ok {
+			return made, nil
+		}
+
+		err = s.make(ctx, export)
+		if err == nil {
+			made++
+			continue
+		}
+		log.Error().Err(err).Str(dataexport.FieldExportID.String(), export.ID.String()).
+			Msg("dataexport.service.Run: failed to make export")
+		if err = s.core.Fail(ctx, export.ID); err != nil {
+			return made, fmt.Errorf("dataexport.service.Run: %w", err)
+		}
+	}
+}
+
+func (s *service) make(ctx context.Context, export dataexport.Export) error {
+	var (
+		data dataexport.Data
+		err  error
+	)
+	if data.Profile, _, err = s.userCore.GetUser(ctx, export.UserID); err != nil {
+		return err
+	}
+	if data.Sessions, err = s.authCore.GetSessionsByUserID(ctx, export.UserID); err != nil {
+		return err
+	}
+	if data.Roles, err = s.authCore.ListUserRoles(ctx, export.UserID); err != nil {
+		return err
+	}
+	if data.Versions, err = s.entityCore.GetAuthoredVersions(ctx, export.UserID); err != nil {
+		return err
+	}
+
+	return s.core.Complete(ctx, export, data)
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/dataexport"
+	"github.com/66gu1/easygodocs/internal/app/dataexport/usecase"
+	"github.com/66gu1/easygodocs/internal/app/dataexport/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core       *mocks.CoreMock
+	authCore   *mocks.AuthCoreMock
+	userCore   *mocks.UserCoreMock
+	entityCore *mocks.EntityCoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core:       mocks.NewCoreMock(t),
+		authCore:   mocks.NewAuthCoreMock(t),
+		userCore:   mocks.NewUserCoreMock(t),
+		entityCore: mocks.NewEntityCoreMock(t),
+	}
+}
+
+func TestService_Request(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		export = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusPending}
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.authCore.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+		m.userCore.GetUserMock.Expect(ctx, userID).Return(user.User{ID: userID}, "hash", nil)
+		m.core.RequestMock.Expect(ctx, userID).Return(export, true, nil)
+
+		got, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Request(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, export, got)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.authCore.CheckSelfOrAdminMock.Expect(ctx, userID).Return(apperr.ErrForbidden())
+
+		_, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Request(ctx, userID)
+		require.ErrorIs(t, err, apperr.ErrForbidden())
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.authCore.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+		m.userCore.GetUserMock.Expect(ctx, userID).Return(user.User{}, "", user.ErrUserNotFound())
+
+		_, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Request(ctx, userID)
+		require.ErrorIs(t, err, user.ErrUserNotFound())
+	})
+}
+
+func TestService_Download(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		export = dataexport.Export{ID: uuid.New(), UserID: userID, Status: dataexport.StatusReady}
+	)
+
+	m := getMocks(t)
+	m.authCore.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+	m.core.OpenMock.Expect(ctx, userID).Return(export, io.NopCloser(strings.NewReader("zip")), nil)
+	got, rc, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Download(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, export, got)
+	require.NoError(t, rc.Close())
+
+	m = getMocks(t)
+	m.authCore.CheckSelfOrAdminMock.Expect(ctx, userID).Return(apperr.ErrForbidden())
+	_, _, err = usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Download(ctx, userID)
+	require.ErrorIs(t, err, apperr.ErrForbidden())
+}
+
+func TestService_Run(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		ann    = dataexport.Export{ID: uuid.New(), UserID: uuid.New(), Status: dataexport.StatusRunning}
+		bob    = dataexport.Export{ID: uuid.New(), UserID: uuid.New(), Status: dataexport.StatusRunning}
+		expErr = errors.New("expected error")
+		data   = dataexport.Data{
+			Profile:  user.User{ID: ann.UserID},
+			Sessions: []auth.Session{{ID: uuid.New(), UserID: ann.UserID}},
+			Roles:    []auth.UserRole{{UserID: ann.UserID, Role: auth.RoleAdmin}},
+			Versions: []entity.AuthoredVersion{{EntityID: uuid.New(), Name: "Page", Version: 1}},
+		}
+	)
+
+	t.Run("makes pending exports", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.core.DeleteExpiredMock.Expect(ctx).Return(1, nil)
+		claims := []dataexport.Export{ann, bob}
+		m.core.ClaimMock.Set(func(_ context.Context) (dataexport.Export, bool, error) {
+			if len(claims) == 0 {
+				return dataexport.Export{}, false, nil
+			}
+			next := claims[0]
+			claims = claims[1:]
+			return next, true, nil
+		})
+		m.userCore.GetUserMock.When(ctx, ann.UserID).Then(data.Profile, "hash", nil)
+		m.authCore.GetSessionsByUserIDMock.Expect(ctx, ann.UserID).Return(data.Sessions, nil)
+		m.authCore.ListUserRolesMock.Expect(ctx, ann.UserID).Return(data.Roles, nil)
+		m.entityCore.GetAuthoredVersionsMock.Expect(ctx, ann.UserID).Return(data.Versions, nil)
+		m.core.CompleteMock.Expect(ctx, ann, data).Return(nil)
+		// bob's data cannot be read: his export fails and the run goes on
+		m.userCore.GetUserMock.When(ctx, bob.UserID).Then(user.User{}, "", expErr)
+		m.core.FailMock.Expect(ctx, bob.ID).Return(nil)
+
+		made, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Run(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, made)
+	})
+
+	t.Run("claim error", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.core.DeleteExpiredMock.Expect(ctx).Return(0, nil)
+		m.core.ClaimMock.Expect(ctx).Return(dataexport.Export{}, false, expErr)
+
+		_, err := usecase.NewService(m.core, m.authCore, m.userCore, m.entityCore).Run(ctx)
+		require.ErrorIs(t, err, expErr)
+	})
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	maxCoverURLLength = 2048
+	// maxEmojiRunes allows the longest common sequences, such as a family joined with zero width joiners.
+	maxEmojiRunes = 12
+)
+
+// UpdateAppearanceReq changes the given fields; an empty string removes the icon or the cover.
+type UpdateAppearanceReq struct {
+	// Icon is an emoji or the key of an attachment stored in the workspace, e.g. imports/<import_id>/logo.png;
+	// keys always contain a slash, emoji never do.
+	Icon *string `json:"icon,omitempty"`
+	// Cover is the key of an attachment stored in the workspace or an http or https URL of an image.
+	Cover *string `json:"cover,omitempty"`
+}
+
+func (r UpdateAppearanceReq) IsEmpty() bool {
+	return r.Icon == nil && r.Cover == nil
+}
+
+// UpdateAppearance sets the icon and cover image of id. They are not versioned. Attachment keys are only
+// checked for their format here; the service resolves them in the workspace of the request.
+func (c *core) UpdateAppearance(ctx context.Context, id uuid.UUID, req UpdateAppearanceReq) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.UpdateAppearance: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.IsEmpty() {
+		return fmt.Errorf("entity.core.UpdateAppearance: %w", ErrEmptyAppearanceUpdate())
+	}
+	if req.Icon != nil {
+		icon := strings.TrimSpace(*req.Icon)
+		if icon != "" && !isEmoji(icon) && !attachment.IsKey(icon) {
+			return fmt.Errorf("entity.core.UpdateAppearance: %w", ErrInvalidIcon())
+		}
+		req.Icon = &icon
+	}
+	if req.Cover != nil {
+		cover := strings.TrimSpace(*req.Cover)
+		if cover != "" && !attachment.IsKey(cover) && !isImageURL(cover) {
+			return fmt.Errorf("entity.core.UpdateAppearance: %w", ErrInvalidCover())
+		}
+		req.Cover = &cover
+	}
+
+	if err := c.repo.UpdateAppearance(ctx, id, req); err != nil {
+		return fmt.Errorf("entity.core.UpdateAppearance: %w", err)
+	}
+
+	return nil
+}
+
+// isEmoji reports whether s is a single emoji: a pictograph or a flag, optionally with skin tone
+// modifiers, variation selectors, tag sequences and further pictographs joined by zero width joiners,
+// or a keycap, a digit, # or * followed by U+20E3.
+func isEmoji(s string) bool {
+	runes := []rune(s)
+	if len(runes) == 0 || len(runes) > maxEmojiRunes || !utf8.ValidString(s) {
+		return false
+	}
+	if len(runes) >= 2 && strings.ContainsRune("0123456789#*", runes[0]) {
+		rest := runes[1:]
+		if rest[0] == '\uFE0F' {
+			rest = rest[1:]
+		}
+		return len(rest) == 1 && rest[0] == '\u20E3'
+	}
+	if isRegionalIndicator(runes[0]) {
+		return len(runes) == 2 && isRegionalIndicator(runes[1])
+	}
+
+	joined := false
+	for i, r := range runes {
+		switch {
+		case unicode.Is(unicode.So, r):
+			if i > 0 && !joined {
+				return false
+			}
+			joined = false
+		case r == '\u200D':
+			if i == 0 || joined {
+				return false
+			}
+			joined = true
+		case r == '\uFE0F', r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+			if i == 0 || joined {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return !joined
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// AppearanceURL returns where the icon or cover v is downloaded from: the attachment endpoint for attachment
+// keys, the URL itself for cover URLs and "" for emoji and empty values.
+func AppearanceURL(v string) string {
+	switch {
+	case attachment.IsKey(v):
+		return attachment.URL(v)
+	case isImageURL(v):
+		return v
+	default:
+		return ""
+	}
+}
+
+func isImageURL(s string) bool {
+	if len(s) > maxCoverURLLength {
+		return false
+	}
+	u, err := url.Parse(s)
+
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,100 @@
+package entity_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_UpdateAppearance(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = fmt.Errorf("test error")
+	)
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name string
+		req  entity.UpdateAppearanceReq
+		want *entity.UpdateAppearanceReq
+		err  error
+		code apperr.Code
+	}{
+		{name: "emoji", req: entity.UpdateAppearanceReq{Icon: ptr("🚀")}, want: &entity.UpdateAppearanceReq{Icon: ptr("🚀")}},
+		{name: "emoji with variation selector", req: entity.UpdateAppearanceReq{Icon: ptr("❤️")}, want: &entity.UpdateAppearanceReq{Icon: ptr("❤️")}},
+		{name: "emoji with skin tone", req: entity.UpdateAppearanceReq{Icon: ptr("👍🏽")}, want: &entity.UpdateAppearanceReq{Icon: ptr("👍🏽")}},
+		{name: "zwj sequence", req: entity.UpdateAppearanceReq{Icon: ptr("👩‍💻")}, want: &entity.UpdateAppearanceReq{Icon: ptr("👩‍💻")}},
+		{name: "flag", req: entity.UpdateAppearanceReq{Icon: ptr("🇩🇪")}, want: &entity.UpdateAppearanceReq{Icon: ptr("🇩🇪")}},
+		{name: "keycap", req: entity.UpdateAppearanceReq{Icon: ptr("1️⃣")}, want: &entity.UpdateAppearanceReq{Icon: ptr("1️⃣")}},
+		{
+			name: "attachment icon and cover URL",
+			req:  entity.UpdateAppearanceReq{Icon: ptr(" imports/4f1c/logo.png "), Cover: ptr("https://cdn.example.com/cover.jpg")},
+			want: &entity.UpdateAppearanceReq{Icon: ptr("imports/4f1c/logo.png"), Cover: ptr("https://cdn.example.com/cover.jpg")},
+		},
+		{name: "attachment cover", req: entity.UpdateAppearanceReq{Cover: ptr("imports/4f1c/My cover.png")}, want: &entity.UpdateAppearanceReq{Cover: ptr("imports/4f1c/My cover.png")}},
+		{name: "remove both", req: entity.UpdateAppearanceReq{Icon: ptr(""), Cover: ptr(" ")}, want: &entity.UpdateAppearanceReq{Icon: ptr(""), Cover: ptr("")}},
+		{name: "empty update", req: entity.UpdateAppearanceReq{}, code: entity.CodeValidationFailed},
+		{name: "text icon", req: entity.UpdateAppearanceReq{Icon: ptr("rocket")}, code: entity.CodeValidationFailed},
+		{name: "two emoji", req: entity.UpdateAppearanceReq{Icon: ptr("🚀🚀")}, code: entity.CodeValidationFailed},
+		{name: "emoji with text", req: entity.UpdateAppearanceReq{Icon: ptr("🚀 docs")}, code: entity.CodeValidationFailed},
+		{name: "dangling joiner", req: entity.UpdateAppearanceReq{Icon: ptr("👩‍")}, code: entity.CodeValidationFailed},
+		{name: "half a flag", req: entity.UpdateAppearanceReq{Icon: ptr("🇩")}, code: entity.CodeValidationFailed},
+		{name: "icon key escaping the store", req: entity.UpdateAppearanceReq{Icon: ptr("imports/../../etc/passwd")}, code: entity.CodeValidationFailed},
+		{name: "icon absolute key", req: entity.UpdateAppearanceReq{Icon: ptr("/imports/logo.png")}, code: entity.CodeValidationFailed},
+		{name: "icon URL", req: entity.UpdateAppearanceReq{Icon: ptr("https://example.com/logo.png")}, code: entity.CodeValidationFailed},
+		{name: "cover javascript URL", req: entity.UpdateAppearanceReq{Cover: ptr("javascript:alert(1)")}, code: entity.CodeValidationFailed},
+		{name: "cover emoji", req: entity.UpdateAppearanceReq{Cover: ptr("🚀")}, code: entity.CodeValidationFailed},
+		{name: "cover key too long", req: entity.UpdateAppearanceReq{Cover: ptr("imports/" + strings.Repeat("a", 512))}, code: entity.CodeValidationFailed},
+		{name: "repo error", req: entity.UpdateAppearanceReq{Icon: ptr("🚀")}, err: expErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			switch {
+			case tt.want != nil:
+				repo.UpdateAppearanceMock.Expect(ctx, id, *tt.want).Return(nil)
+			case tt.err != nil:
+				repo.UpdateAppearanceMock.Return(tt.err)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.UpdateAppearance(ctx, id, tt.req)
+			switch {
+			case tt.code != "":
+				require.Equal(t, tt.code, apperr.CodeOf(err))
+			case tt.err != nil:
+				require.ErrorIs(t, err, tt.err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("nil id", func(t *testing.T) {
+		t.Parallel()
+		c, err := entity.NewCore(mocks.NewRepositoryMock(t), entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+			mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+		require.Error(t, c.UpdateAppearance(ctx, uuid.Nil, entity.UpdateAppearanceReq{Icon: ptr("🚀")}))
+	})
+}
+
+func TestAppearanceURL(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/api/v1/attachments/imports/1/logo.png", entity.AppearanceURL("imports/1/logo.png"))
+	require.Equal(t, "https://example.com/cover.png", entity.AppearanceURL("https://example.com/cover.png"))
+	require.Empty(t, entity.AppearanceURL("🚀"))
+	require.Empty(t, entity.AppearanceURL(""))
+}
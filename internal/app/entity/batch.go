@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxBatchGetIDs caps the number of entities looked up by one batch get.
+const MaxBatchGetIDs = 100
+
+// BatchGetReq looks up several entities at once, e.g. for link previews.
+type BatchGetReq struct {
+	IDs []uuid.UUID `json:"ids"`
+	// IncludeContent returns the full entities; without it only their metadata is returned.
+	IncludeContent bool `json:"include_content"`
+}
+
+// BatchGetItem is the result for one requested ID: its metadata, or the entity with IncludeContent,
+// or the problem that kept it out, e.g. entity/not_found or core/forbidden.
+type BatchGetItem struct {
+	ID     uuid.UUID       `json:"id"`
+	Item   *ListItem       `json:"item,omitempty"`
+	Entity *Entity         `json:"entity,omitempty"`
+	Error  *apperr.Problem `json:"error,omitempty"`
+}
+
+type BatchGetResult struct {
+	Items []BatchGetItem `json:"items"`
+}
+
+// BatchGet looks up the requested entities in one query and returns a result per distinct ID, in the
+// order requested. IDs outside permittedIDs are not looked up unless isAdmin. A missing or forbidden
+// entity fails its own item only.
+func (c *core) BatchGet(ctx context.Context, req BatchGetReq, permittedIDs []uuid.UUID, isAdmin bool) (BatchGetResult, error) {
+	ids := lo.Uniq(req.IDs)
+	if len(ids) == 0 || len(ids) > MaxBatchGetIDs {
+		return BatchGetResult{}, fmt.Errorf("entity.core.BatchGet: %w", ErrInvalidBatchSize(MaxBatchGetIDs))
+	}
+	if slices.Contains(ids, uuid.Nil) {
+		return BatchGetResult{}, fmt.Errorf("entity.core.BatchGet: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+
+	lookup := ids
+	if !isAdmin {
+		lookup = make([]uuid.UUID, 0, len(ids))
+		for _, id := range ids {
+			if slices.Contains(permittedIDs, id) {
+				lookup = append(lookup, id)
+			}
+		}
+	}
+
+	found := make(map[uuid.UUID]BatchGetItem, len(lookup))
+	if req.IncludeContent {
+		entities, err := c.repo.GetMany(ctx, lookup)
+		if err != nil {
+			return BatchGetResult{}, fmt.Errorf("entity.core.BatchGet: %w", err)
+		}
+		for _, e := range entities {
+			found[e.ID] = BatchGetItem{ID: e.ID, Entity: &e}
+		}
+	} else {
+		items, err := c.repo.GetListItems(ctx, lookup)
+		if err != nil {
+			return BatchGetResult{}, fmt.Errorf("entity.core.BatchGet: %w", err)
+		}
+		for _, item := range items {
+			found[item.ID] = BatchGetItem{ID: item.ID, Item: &item}
+		}
+	}
+
+	result := BatchGetResult{Items: make([]BatchGetItem, 0, len(ids))}
+	for _, id := range ids {
+		item, ok := found[id]
+		switch {
+		case ok:
+		case !isAdmin && !slices.Contains(permittedIDs, id):
+			item = BatchGetItem{ID: id, Error: batchGetProblem(apperr.ErrForbidden())}
+		default:
+			item = BatchGetItem{ID: id, Error: batchGetProblem(ErrEntityNotFound())}
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+func batchGetProblem(err error) *apperr.Problem {
+	problem := apperr.ToProblem(err)
+	return &problem
+}
@@ -0,0 +1,121 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_BatchGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		found     = uuid.New()
+		missing   = uuid.New()
+		forbidden = uuid.New()
+		item      = entity.ListItem{ID: found, Name: "a"}
+		ent       = entity.Entity{ID: found, Name: "a", Content: "body"}
+		notFound  = apperr.ToProblem(entity.ErrEntityNotFound())
+		denied    = apperr.ToProblem(apperr.ErrForbidden())
+		expErr    = fmt.Errorf("test error")
+	)
+	tooMany := make([]uuid.UUID, entity.MaxBatchGetIDs+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+
+	tests := []struct {
+		name      string
+		req       entity.BatchGetReq
+		permitted []uuid.UUID
+		isAdmin   bool
+		setup     func(repo *mocks.RepositoryMock)
+		want      entity.BatchGetResult
+		err       error
+	}{
+		{
+			name:      "success/metadata, per-id errors in request order",
+			req:       entity.BatchGetReq{IDs: []uuid.UUID{forbidden, found, missing, found}},
+			permitted: []uuid.UUID{found, missing},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{found, missing}).Return([]entity.ListItem{item}, nil)
+			},
+			want: entity.BatchGetResult{Items: []entity.BatchGetItem{
+				{ID: forbidden, Error: &denied},
+				{ID: found, Item: &item},
+				{ID: missing, Error: &notFound},
+			}},
+		},
+		{
+			name:    "success/admin with content",
+			req:     entity.BatchGetReq{IDs: []uuid.UUID{found, missing}, IncludeContent: true},
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetManyMock.Expect(ctx, []uuid.UUID{found, missing}).Return([]entity.Entity{ent}, nil)
+			},
+			want: entity.BatchGetResult{Items: []entity.BatchGetItem{
+				{ID: found, Entity: &ent},
+				{ID: missing, Error: &notFound},
+			}},
+		},
+		{
+			name: "success/nothing permitted",
+			req:  entity.BatchGetReq{IDs: []uuid.UUID{forbidden}},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{}).Return([]entity.ListItem{}, nil)
+			},
+			want: entity.BatchGetResult{Items: []entity.BatchGetItem{{ID: forbidden, Error: &denied}}},
+		},
+		{
+			name: "error/no ids",
+			req:  entity.BatchGetReq{},
+			err:  entity.ErrInvalidBatchSize(entity.MaxBatchGetIDs),
+		},
+		{
+			name:    "error/too many ids",
+			req:     entity.BatchGetReq{IDs: tooMany},
+			isAdmin: true,
+			err:     entity.ErrInvalidBatchSize(entity.MaxBatchGetIDs),
+		},
+		{
+			name:    "error/nil id",
+			req:     entity.BatchGetReq{IDs: []uuid.UUID{uuid.Nil}},
+			isAdmin: true,
+			err:     apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name:    "error/repo",
+			req:     entity.BatchGetReq{IDs: []uuid.UUID{found}, IncludeContent: true},
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetManyMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.BatchGet(ctx, tt.req, tt.permitted, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
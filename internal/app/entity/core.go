@@ -2,12 +2,18 @@ package entity
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
 	"github.com/google/uuid"
 )
 
@@ -15,14 +21,168 @@ type Repository interface {
 	GetHierarchy(ctx context.Context, ids []uuid.UUID, maxDepth int, userID *uuid.UUID, hType HierarchyType) ([]ListItem, error)
 	Get(ctx context.Context, id uuid.UUID) (Entity, error)
 	GetVersion(ctx context.Context, id uuid.UUID, version int) (Entity, error)
-	GetVersionsList(ctx context.Context, id uuid.UUID) ([]Entity, error)
+	// GetVersionsList returns up to limit versions below before (0: no bound), newest first.
+	// Unless includeContent, their content is not read.
+	GetVersionsList(ctx context.Context, id uuid.UUID, before, limit int, includeContent bool) ([]Entity, error)
+	// GetAuthoredVersions returns the versions userID created, of deleted entities too, oldest first.
+	GetAuthoredVersions(ctx context.Context, userID uuid.UUID) ([]AuthoredVersion, error)
 	Create(ctx context.Context, req CreateEntityReq, id uuid.UUID, createdAt time.Time) error
 	CreateDraft(ctx context.Context, req CreateEntityReq, id uuid.UUID) error
 	Update(ctx context.Context, req UpdateEntityReq, updatedAt time.Time) error
 	UpdateDraft(ctx context.Context, req UpdateEntityReq) error
-	Delete(ctx context.Context, ids []uuid.UUID) error
+	// Delete soft-deletes the entities and records a deleted event for each on behalf of userID.
+	Delete(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) error
 	GetAll(ctx context.Context) ([]ListItem, error)
 	GetListItem(ctx context.Context, id uuid.UUID) (ListItem, error)
+	// GetListItems returns the live entities among ids in one query; ids that are not found are skipped.
+	GetListItems(ctx context.Context, ids []uuid.UUID) ([]ListItem, error)
+	// GetMany returns the live entities among ids in one query; ids that are not found are skipped.
+	GetMany(ctx context.Context, ids []uuid.UUID) ([]Entity, error)
+	GetMeta(ctx context.Context, id uuid.UUID, lastEditorsLimit int) (Meta, error)
+	GetContributors(ctx context.Context, id uuid.UUID) ([]Contributor, error)
+	// GetActivity returns up to limit events of id and its descendants, deleted ones included, with ids below
+	// before (0: no bound), newest first. If userID is set, events of other users' drafts are skipped;
+	// unless includeMinor, so are edits of versions saved as minor edits.
+	GetActivity(ctx context.Context, id uuid.UUID, before int64, limit, maxDepth int, userID *uuid.UUID, includeMinor bool) ([]Event, error)
+	GetBacklinks(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]ListItem, error)
+	// GetDraftIDs returns the live drafts userID created.
+	GetDraftIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	GetBrokenLinks(ctx context.Context) ([]BrokenLink, error)
+	// AddRelation relates two live entities in both directions; an existing relation is kept as is.
+	AddRelation(ctx context.Context, id, relatedID uuid.UUID, createdAt time.Time) error
+	// DeleteRelation removes the relation in both directions.
+	DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error
+	// GetRelated returns the live entities related to id, by name. If userID is set, drafts of other users are skipped.
+	GetRelated(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]ListItem, error)
+	// SetLanguage changes the language of id within its group, or puts it in a new group groupID if it has none.
+	SetLanguage(ctx context.Context, id, groupID uuid.UUID, language string, createdAt time.Time) error
+	// LinkVariant adds variantID, a live entity, to the group of id; variantID already in that group only
+	// changes language.
+	LinkVariant(ctx context.Context, id, variantID uuid.UUID, language string, createdAt time.Time) error
+	DeleteVariant(ctx context.Context, id uuid.UUID) error
+	// GetVariants returns the live variants in the group of id, by language. If userID is set, drafts of
+	// other users are skipped.
+	GetVariants(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]Variant, error)
+	// GetAllVariants returns the live variants of the workspace, by group and language.
+	GetAllVariants(ctx context.Context) ([]Variant, error)
+	// ClaimLint claims up to limit current versions of live entities for linting: those not linted yet and
+	// those whose lint started before staleBefore. Entities in a subtree with linting disabled are skipped,
+	// and concurrent claims never return the same version.
+	ClaimLint(ctx context.Context, startedAt, staleBefore time.Time, limit int) ([]LintTarget, error)
+	// FinishLint stores the outcome of linting a claimed version.
+	FinishLint(ctx context.Context, entityID uuid.UUID, version int, status LintStatus, findings []LintFinding, checkedAt time.Time) error
+	// GetLintReport returns the lint outcome of a version of id, the current one if version is 0. Status
+	// is empty if it was never claimed, and LintDisabled if linting is disabled for id or an ancestor.
+	GetLintReport(ctx context.Context, id uuid.UUID, version int) (LintReport, error)
+	SetLintDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	UpdateAppearance(ctx context.Context, id uuid.UUID, req UpdateAppearanceReq) error
+	// GetChildren returns the live children of id in sibling order. If userID is set, drafts of other users are skipped.
+	GetChildren(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]ListItem, error)
+	// ReorderChildren numbers the live children of parentID in the order of ids, from 1, and resets the
+	// others to 0. It fails with ErrNotChildren if an ID is not a live child.
+	ReorderChildren(ctx context.Context, parentID uuid.UUID, ids []uuid.UUID) error
+	// SetPinned pins or unpins childID, a live child of parentID. Pinning fails once maxPinned children of
+	// the parent are pinned, unless maxPinned is 0.
+	SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool, maxPinned int) error
+	// GetSpace returns the settings of the live root entity id, defaults if none were set. It fails with
+	// ErrNotSpace if id has a parent.
+	GetSpace(ctx context.Context, id uuid.UUID) (Space, error)
+	// SetSpaceSettings replaces the settings of the live root entity id, see GetSpace.
+	SetSpaceSettings(ctx context.Context, id uuid.UUID, settings SpaceSettings) error
+	// GetSpaceSettings returns the settings of the space id is in, defaults if there are none.
+	GetSpaceSettings(ctx context.Context, id uuid.UUID) (SpaceSettings, error)
+	// GetSpaceID returns the root of the live entity id, id itself for a root.
+	GetSpaceID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// GetSubtreeGrants returns the direct grants on id and its descendants, deleted ones included.
+	GetSubtreeGrants(ctx context.Context, id uuid.UUID) ([]Grant, error)
+	// GetAccess returns the strongest role per user on the live entity id, granted on it or an ancestor or
+	// given by their default permissions. Global roles are left out.
+	GetAccess(ctx context.Context, id uuid.UUID) ([]DefaultPermission, error)
+	GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]DefaultPermission, error)
+	// SetDefaultPermissions replaces the default permissions of a live entity. It fails with
+	// ErrDefaultPermissionUserNotFound if a user does not exist or was deleted.
+	SetDefaultPermissions(ctx context.Context, id uuid.UUID, permissions []DefaultPermission, createdAt time.Time) error
+	// GetPendingDefaultPermissions returns the default permissions of the ancestors of id, the strongest
+	// role per live user, without those the user's grants on the ancestors already give.
+	GetPendingDefaultPermissions(ctx context.Context, id uuid.UUID) ([]DefaultPermission, error)
+	// PruneVersions deletes versions beyond the newest keepLast (0: no count limit) that were created more
+	// than keepDays before now (0: no age limit), or by the SpaceRetention of their space if it has one.
+	// Nothing is deleted where both are 0. The current version and versions in a snapshot are never deleted.
+	PruneVersions(ctx context.Context, keepLast, keepDays int, now time.Time, dryRun bool) ([]VersionRef, error)
+	// PurgeDeleted hard-deletes entities soft-deleted before cutoff, with everything that cascades from them.
+	// An entity with a descendant that is live or was deleted later is kept, as the delete would cascade to it.
+	PurgeDeleted(ctx context.Context, cutoff time.Time, dryRun bool) ([]PurgedEntity, error)
+	// GetDraftsToRemind returns, by creator, the live drafts untouched since before that their creator was not
+	// reminded about since they were last touched. Drafts of users who keep stale drafts are left out.
+	GetDraftsToRemind(ctx context.Context, before time.Time) ([]DraftReminder, error)
+	// MarkDraftsReminded records that the creators of ids were reminded about them at remindedAt.
+	MarkDraftsReminded(ctx context.Context, ids []uuid.UUID, remindedAt time.Time) error
+	// CleanupStaleDrafts archives, or with hard deletes, the live drafts without children untouched since
+	// before, and with remindedBefore only those whose creator was reminded about them since they were
+	// last touched and before it. Drafts of users who keep stale drafts are left out.
+	CleanupStaleDrafts(ctx context.Context, before time.Time, remindedBefore *time.Time, hard, dryRun bool) ([]StaleDraft, error)
+	// ReencryptContent encrypts up to limit rows of content of every workspace, entities, versions and
+	// autosaves, that are plain or encrypted with another than the current key, leaving out the skip rows.
+	// It returns how many it encrypted and the rows it could not decrypt, which count towards limit.
+	ReencryptContent(ctx context.Context, limit int, skip []SkippedContent) (int, []SkippedContent, error)
+	// AcquireLock takes or extends the lock for userID unless another user holds an active one,
+	// in which case that lock is returned with acquired false. Expiry is checked against database time.
+	AcquireLock(ctx context.Context, id, userID uuid.UUID, ttl time.Duration) (lock Lock, acquired bool, err error)
+	GetLock(ctx context.Context, id uuid.UUID) (Lock, error)
+	ReleaseLock(ctx context.Context, id uuid.UUID) error
+	// SaveAutosave replaces the autosave of userID for a live entity.
+	SaveAutosave(ctx context.Context, id, userID uuid.UUID, content string, savedAt time.Time) error
+	GetAutosave(ctx context.Context, id, userID uuid.UUID) (Autosave, error)
+	DeleteAutosave(ctx context.Context, id, userID uuid.UUID) error
+	DeleteExpiredLocks(ctx context.Context) (int64, error)
+	// SiblingNameExists reports whether a live child of parentID (nil: root) other than excludeID has the
+	// normalized name. Drafts count only for the user who last updated them.
+	SiblingNameExists(ctx context.Context, parentID *uuid.UUID, normalizedName string, excludeID, userID uuid.UUID) (bool, error)
+	// GetIDBySlug resolves a current or former slug of a live entity.
+	GetIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	// GetTakenSlugs returns the slugs equal to base or starting with "base-" that belong, now or in
+	// the past, to live entities other than excludeID.
+	GetTakenSlugs(ctx context.Context, base string, excludeID uuid.UUID) ([]string, error)
+	// HasMovedFrom reports whether the entity was ever moved away from parentID (nil: root).
+	HasMovedFrom(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (bool, error)
+	// RecordView stores the view unless the session already viewed the entity.
+	RecordView(ctx context.Context, view View) error
+	// GetPopular returns up to limit live entities by their views since, most viewed first. If ids is set
+	// only those are counted; if userID is set, drafts of other users are skipped.
+	GetPopular(ctx context.Context, since time.Time, limit int, ids []uuid.UUID, userID *uuid.UUID) ([]PopularEntity, error)
+	// SetOwner makes ownerID the owner of a live entity. The owner must be a user that was not deleted.
+	SetOwner(ctx context.Context, id, ownerID uuid.UUID) error
+	// GetOrphanedEntities returns the live entities whose owner was deleted, by name.
+	GetOrphanedEntities(ctx context.Context) ([]OrphanedEntity, error)
+	// GetExportPage returns up to limit live entities after the cursor in (depth, id) order, from the subtree
+	// of rootID or, if it is nil, the whole workspace. Entities behind a deleted ancestor are skipped and,
+	// if userID is set, so are those behind or being a draft of another user.
+	GetExportPage(ctx context.Context, rootID *uuid.UUID, after ExportCursor, limit int, userID *uuid.UUID) ([]ExportItem, error)
+	// GetHistory returns up to limit versions and events of the entity older than before (nil: the newest),
+	// newest first. Edit events are skipped.
+	GetHistory(ctx context.Context, id uuid.UUID, before *HistoryCursor, limit int) ([]HistoryItem, error)
+	// List returns up to limit live entities matching filter after its cursor, in its order. Entities behind
+	// a deleted ancestor are skipped and, if filter.UserID is set, so are those behind or being a draft of
+	// another user.
+	List(ctx context.Context, filter ListFilter, limit int) ([]ListEntry, error)
+	// CreateSnapshot stores the snapshot of a live, published entity with the current versions of its subtree,
+	// skipping drafts and the entities behind them, and returns how many it captured. It fails with
+	// ErrSnapshotLabelTaken if the entity already has a snapshot with the label.
+	CreateSnapshot(ctx context.Context, snapshot Snapshot) (int, error)
+	// GetSnapshots returns the snapshots of the entity, newest first.
+	GetSnapshots(ctx context.Context, id uuid.UUID) ([]Snapshot, error)
+	// GetSnapshot returns the snapshot of the entity by label with the versions it captured, by depth.
+	GetSnapshot(ctx context.Context, id uuid.UUID, label string) (SnapshotContent, error)
+	// GetVariables returns the variables in the scope of id by key: for each key, the definition of id
+	// or of its nearest ancestor.
+	GetVariables(ctx context.Context, id uuid.UUID) ([]Variable, error)
+	// SetVariable defines or replaces the variable of a live entity. It fails with ErrTooManyVariables if
+	// the entity would define more than maxVariables.
+	SetVariable(ctx context.Context, v Variable, maxVariables int) error
+	// DeleteVariable fails with ErrVariableNotFound if the entity does not define the key.
+	DeleteVariable(ctx context.Context, id uuid.UUID, key string) error
+	// GetScopeVariables returns the variables in the scope of each of ids that has any.
+	GetScopeVariables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]Variables, error)
 }
 
 type IDGenerator interface {
@@ -35,9 +195,25 @@ type TimeGenerator interface {
 
 type Validator interface {
 	NormalizeName(name string) string
-	ValidateName(name string) error
+	ValidateName(entityType Type, name string) error
+	ValidateContent(entityType Type, content string) (ContentUsage, error)
+	// ValidateLinks checks the links from content of the entity type to entities that do not exist.
+	ValidateLinks(entityType Type, broken []uuid.UUID) error
+	ValidateSummary(summary string) error
 }
 
+// metaLastEditorsLimit is how many distinct recent editors Meta lists.
+const metaLastEditorsLimit = 5
+
+// MaxActivityLimit caps the page size of the activity feed.
+const MaxActivityLimit = 100
+
+// MaxVersionsLimit caps the page size of the versions list.
+const MaxVersionsLimit = 100
+
+// ExportPageSize is how many entities an export reads, and hands to its writer, at a time.
+const ExportPageSize = 200
+
 type HierarchyType int
 
 const (
@@ -52,8 +228,174 @@ type Generators struct {
 }
 
 type Config struct {
-	MaxHierarchyDepth int `mapstructure:"max_hierarchy_depth" json:"max_hierarchy_depth"`
+	MaxHierarchyDepth int               `mapstructure:"max_hierarchy_depth" json:"max_hierarchy_depth"`
+	LockTTLMinutes    int               `mapstructure:"lock_ttl_minutes" json:"lock_ttl_minutes"`
+	Retention         RetentionConfig   `mapstructure:"retention" json:"retention"`
+	Trash             TrashConfig       `mapstructure:"trash" json:"trash"`
+	StaleDrafts       StaleDraftsConfig `mapstructure:"stale_drafts" json:"stale_drafts"`
+	// UniqueSiblingNames rejects a name already used under the same parent, ignoring case and repeated spaces.
+	UniqueSiblingNames bool `mapstructure:"unique_sibling_names" json:"unique_sibling_names"`
+	// RedirectMovedPaths resolves slug paths an entity was moved or renamed away from to its current path.
+	RedirectMovedPaths bool `mapstructure:"redirect_moved_paths" json:"redirect_moved_paths"`
+	// RecursiveHierarchy walks parent_id with recursive queries instead of reading the materialized paths.
+	// It is slower on deep trees but does not rely on the paths, so it is the fallback while they are suspect.
+	RecursiveHierarchy bool `mapstructure:"recursive_hierarchy" json:"recursive_hierarchy"`
+	// QuietMinorEdits leaves edits saved as minor edits out of the activity feed unless it is asked for them.
+	// Without it the flag is only stored with the version.
+	QuietMinorEdits bool `mapstructure:"quiet_minor_edits" json:"quiet_minor_edits"`
+	// MaxPinnedChildren caps the children pinned under one parent; 0 means no limit.
+	MaxPinnedChildren int `mapstructure:"max_pinned_children" json:"max_pinned_children"`
+	// Languages are the languages documents are translated into. Variants may only use them and the missing
+	// translations report checks for them; empty allows any language.
+	Languages  []string         `mapstructure:"languages" json:"languages"`
+	Encryption EncryptionConfig `mapstructure:"encryption" json:"encryption"`
+	Lint       LintConfig       `mapstructure:"lint" json:"lint"`
+	// CrossSpaceGrants is what a move into another space does with the grants on the moved entities unless
+	// the move names a policy itself; empty keeps them.
+	CrossSpaceGrants GrantPolicy `mapstructure:"cross_space_grants" json:"cross_space_grants"`
+}
+
+func (c Config) Validate() error {
+	if c.MaxHierarchyDepth <= 0 {
+		return fmt.Errorf("Config.MaxHierarchyDepth must be positive")
+	}
+	if c.LockTTLMinutes <= 0 {
+		return fmt.Errorf("Config.LockTTLMinutes must be positive")
+	}
+	if c.MaxPinnedChildren < 0 {
+		return fmt.Errorf("Config.MaxPinnedChildren must not be negative")
+	}
+	if err := c.Retention.Validate(); err != nil {
+		return fmt.Errorf("Config.Retention: %w", err)
+	}
+	if err := c.Trash.Validate(); err != nil {
+		return fmt.Errorf("Config.Trash: %w", err)
+	}
+	if err := c.StaleDrafts.Validate(); err != nil {
+		return fmt.Errorf("Config.StaleDrafts: %w", err)
+	}
+	for _, lang := range c.Languages {
+		if _, err := NormalizeLanguage(lang); err != nil {
+			return fmt.Errorf("Config.Languages: %q is not a language tag", lang)
+		}
+	}
+	if err := c.Encryption.Validate(); err != nil {
+		return fmt.Errorf("Config.Encryption: %w", err)
+	}
+	if err := c.Lint.Validate(); err != nil {
+		return fmt.Errorf("Config.Lint: %w", err)
+	}
+	if c.CrossSpaceGrants != "" && c.CrossSpaceGrants.Validate() != nil {
+		return fmt.Errorf("Config.CrossSpaceGrants must be keep, strip or remap")
+	}
+
+	return nil
+}
+
+// RetentionConfig limits stored versions. A version is kept while it is one of the last
+// KeepLastVersions or newer than KeepDays; a zero value disables that rule, both zero keep everything.
+type RetentionConfig struct {
+	KeepLastVersions int `mapstructure:"keep_last_versions" json:"keep_last_versions"`
+	KeepDays         int `mapstructure:"keep_days" json:"keep_days"`
+	IntervalMinutes  int `mapstructure:"interval_minutes" json:"interval_minutes"`
+}
+
+func (c RetentionConfig) Validate() error {
+	if c.KeepLastVersions < 0 || c.KeepDays < 0 {
+		return fmt.Errorf("keep_last_versions and keep_days must not be negative")
+	}
+	if c.Enabled() && c.IntervalMinutes <= 0 {
+		return fmt.Errorf("interval_minutes must be positive when retention is enabled")
+	}
+
+	return nil
+}
+
+func (c RetentionConfig) Enabled() bool {
+	return c.KeepLastVersions > 0 || c.KeepDays > 0
+}
+
+// TrashConfig limits how long soft-deleted entities are kept. Every IntervalMinutes those deleted more
+// than RetentionDays ago are purged; zero RetentionDays disables the schedule, and a manual purge then
+// empties the whole trash.
+type TrashConfig struct {
+	RetentionDays   int `mapstructure:"retention_days" json:"retention_days"`
+	IntervalMinutes int `mapstructure:"interval_minutes" json:"interval_minutes"`
+}
+
+func (c TrashConfig) Validate() error {
+	if c.RetentionDays < 0 {
+		return fmt.Errorf("retention_days must not be negative")
+	}
+	if c.Enabled() && c.IntervalMinutes <= 0 {
+		return fmt.Errorf("interval_minutes must be positive when the trash is purged")
+	}
+
+	return nil
+}
+
+func (c TrashConfig) Enabled() bool {
+	return c.RetentionDays > 0
+}
+
+// StaleDraftAction is what the stale draft policy does with the drafts past CleanupDays.
+type StaleDraftAction string
+
+const (
+	// StaleDraftArchive moves them to the trash, where they can be restored until it is purged.
+	StaleDraftArchive StaleDraftAction = "archive"
+	StaleDraftDelete  StaleDraftAction = "delete"
+)
+
+// StaleDraftsConfig cleans up abandoned drafts. Every IntervalMinutes the creators of drafts untouched
+// for NotifyDays are reminded once, and drafts untouched for CleanupDays are archived or deleted as Action
+// says, though only CleanupDays-NotifyDays after their creator was reminded. A zero value disables that
+// step. Drafts with children and those of users who opted out in their preferences are left alone.
+type StaleDraftsConfig struct {
+	NotifyDays      int              `mapstructure:"notify_days" json:"notify_days"`
+	CleanupDays     int              `mapstructure:"cleanup_days" json:"cleanup_days"`
+	Action          StaleDraftAction `mapstructure:"action" json:"action"`
+	IntervalMinutes int              `mapstructure:"interval_minutes" json:"interval_minutes"`
+}
+
+func (c StaleDraftsConfig) Validate() error {
+	if c.NotifyDays < 0 || c.CleanupDays < 0 {
+		return fmt.Errorf("notify_days and cleanup_days must not be negative")
+	}
+	if c.NotifyDays > 0 && c.CleanupDays > 0 && c.CleanupDays <= c.NotifyDays {
+		return fmt.Errorf("cleanup_days must be greater than notify_days")
+	}
+	if c.CleanupDays > 0 && c.Action != StaleDraftArchive && c.Action != StaleDraftDelete {
+		return fmt.Errorf("action must be %q or %q", StaleDraftArchive, StaleDraftDelete)
+	}
+	if c.Enabled() && c.IntervalMinutes <= 0 {
+		return fmt.Errorf("interval_minutes must be positive when stale drafts are cleaned up")
+	}
+
+	return nil
+}
+
+func (c StaleDraftsConfig) Enabled() bool {
+	return c.NotifyDays > 0 || c.CleanupDays > 0
+}
+
+// EncryptionConfig encrypts content at rest with the content_key secret. Content written before it was
+// enabled, or with a previous key, is encrypted with the current key every IntervalMinutes, BatchSize
+// rows at a time. Encrypted content stays readable when it is disabled again, as long as the key is set.
+type EncryptionConfig struct {
+	Enabled         bool `mapstructure:"enabled" json:"enabled"`
+	IntervalMinutes int  `mapstructure:"interval_minutes" json:"interval_minutes"`
+	BatchSize       int  `mapstructure:"batch_size" json:"batch_size"`
+}
+
+func (c EncryptionConfig) Validate() error {
+	if c.Enabled && (c.IntervalMinutes <= 0 || c.BatchSize <= 0) {
+		return fmt.Errorf("interval_minutes and batch_size must be positive when encryption is enabled")
+	}
+
+	return nil
 }
+
 type core struct {
 	repo      Repository
 	gen       Generators
@@ -65,8 +407,8 @@ func NewCore(repo Repository, generators Generators, validator Validator, cfg Co
 	if repo == nil || generators.ID == nil || generators.Time == nil || validator == nil {
 		return nil, fmt.Errorf("entity.NewCore: %w", fmt.Errorf("nil dependency"))
 	}
-	if cfg.MaxHierarchyDepth <= 0 {
-		return nil, fmt.Errorf("entity.NewCore: %w", fmt.Errorf("Config.MaxHierarchyDepth must be positive"))
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("entity.NewCore: %w", err)
 	}
 	return &core{
 		repo:      repo,
@@ -88,6 +430,88 @@ func (c *core) Get(ctx context.Context, id uuid.UUID) (Entity, error) {
 	return entity, nil
 }
 
+// GetIDBySlug resolves a slug to its entity. Former slugs resolve too; compare with Entity.Slug to tell them apart.
+func (c *core) GetIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	if slug == "" {
+		return uuid.Nil, fmt.Errorf("entity.core.GetIDBySlug: %w", ErrEntityNotFound())
+	}
+	id, err := c.repo.GetIDBySlug(ctx, slug)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("entity.core.GetIDBySlug: %w", err)
+	}
+
+	return id, nil
+}
+
+// ResolvePath resolves a path of slugs from the root. Unless isAdmin, drafts of other users are hidden.
+// With RedirectMovedPaths a stale path resolves with Moved set when its last segment is a current or
+// former slug of the entity and the one before names its current or a former parent; the segments
+// above are not checked, so paths under a moved ancestor resolve too.
+func (c *core) ResolvePath(ctx context.Context, path []string, isAdmin bool) (PathResolution, error) {
+	if len(path) == 0 || slices.Contains(path, "") {
+		return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", ErrEntityNotFound())
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", err)
+		}
+		userID = &uid
+	}
+
+	id, err := c.repo.GetIDBySlug(ctx, path[len(path)-1])
+	if err != nil {
+		return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", err)
+	}
+	items, err := c.repo.GetHierarchy(ctx, []uuid.UUID{id}, c.cfg.MaxHierarchyDepth+1, userID, HierarchyTypeParentsOnly)
+	if err != nil {
+		return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", err)
+	}
+	if len(items) == 0 {
+		return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", ErrEntityNotFound())
+	}
+	slices.SortFunc(items, func(a, b ListItem) int { return b.Depth - a.Depth })
+	res := PathResolution{ID: id, Path: make([]string, len(items))}
+	for i, item := range items {
+		res.Path[i] = item.Slug
+	}
+	if slices.Equal(path, res.Path) {
+		return res, nil
+	}
+	if !c.cfg.RedirectMovedPaths {
+		return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", ErrEntityNotFound())
+	}
+
+	var parentID *uuid.UUID
+	if len(path) > 1 {
+		pid, err := c.repo.GetIDBySlug(ctx, path[len(path)-2])
+		if err != nil {
+			return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", err)
+		}
+		parentID = &pid
+	}
+	if current := items[len(items)-1].ParentID; !equalParents(parentID, current) {
+		moved, err := c.repo.HasMovedFrom(ctx, id, parentID)
+		if err != nil {
+			return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", err)
+		}
+		if !moved {
+			return PathResolution{}, fmt.Errorf("entity.core.ResolvePath: %w", ErrEntityNotFound())
+		}
+	}
+	res.Moved = true
+
+	return res, nil
+}
+
+func equalParents(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (c *core) GetListItem(ctx context.Context, id uuid.UUID) (ListItem, error) {
 	if id == uuid.Nil {
 		return ListItem{}, fmt.Errorf("entity.core.GetListItem: %w", apperr.ErrNilUUID(FieldEntityID))
@@ -100,29 +524,407 @@ func (c *core) GetListItem(ctx context.Context, id uuid.UUID) (ListItem, error)
 	return item, nil
 }
 
+// GetListItems looks up several entities at once. Missing ones are left out rather than failing the
+// call, so the caller decides which of them it needs.
+func (c *core) GetListItems(ctx context.Context, ids []uuid.UUID) ([]ListItem, error) {
+	if slices.Contains(ids, uuid.Nil) {
+		return nil, fmt.Errorf("entity.core.GetListItems: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	items, err := c.repo.GetListItems(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetListItems: %w", err)
+	}
+
+	return items, nil
+}
+
+func (c *core) GetMeta(ctx context.Context, id uuid.UUID) (Meta, error) {
+	if id == uuid.Nil {
+		return Meta{}, fmt.Errorf("entity.core.GetMeta: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	meta, err := c.repo.GetMeta(ctx, id, metaLastEditorsLimit)
+	if err != nil {
+		return Meta{}, fmt.Errorf("entity.core.GetMeta: %w", err)
+	}
+
+	return meta, nil
+}
+
+// GetContributors returns the authors of the entity's versions, most recent first.
+// Draft edits create no version and are not counted.
+func (c *core) GetContributors(ctx context.Context, id uuid.UUID) ([]Contributor, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetContributors: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	contributors, err := c.repo.GetContributors(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetContributors: %w", err)
+	}
+
+	return contributors, nil
+}
+
+// GetActivity returns a page of the activity feed of the entity subtree. Unless isAdmin,
+// events of drafts of other users are skipped. With QuietMinorEdits, minor edits are skipped unless
+// req.IncludeMinor.
+func (c *core) GetActivity(ctx context.Context, req GetActivityReq, isAdmin bool) (Activity, error) {
+	if req.ID == uuid.Nil {
+		return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.Limit <= 0 || req.Limit > MaxActivityLimit {
+		return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", ErrInvalidActivityLimit(MaxActivityLimit))
+	}
+	// zero starts from the newest event
+	var before int64
+	if req.Before != "" {
+		values, err := db.DecodeCursor(req.Before, 1)
+		if err != nil {
+			return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", err)
+		}
+		if before, err = strconv.ParseInt(values[0], 10, 64); err != nil || before <= 0 {
+			return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", db.ErrInvalidCursor())
+		}
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", err)
+		}
+		userID = &uid
+	}
+
+	// one extra row tells whether another page exists
+	includeMinor := req.IncludeMinor || !c.cfg.QuietMinorEdits
+	events, err := c.repo.GetActivity(ctx, req.ID, before, req.Limit+1, c.cfg.MaxHierarchyDepth, userID, includeMinor)
+	if err != nil {
+		return Activity{}, fmt.Errorf("entity.core.GetActivity: %w", err)
+	}
+	activity := Activity{Events: events}
+	if len(events) > req.Limit {
+		activity.Events = events[:req.Limit]
+		activity.NextCursor = db.EncodeCursor(strconv.FormatInt(activity.Events[req.Limit-1].ID, 10))
+	}
+
+	return activity, nil
+}
+
+// GetBacklinks returns entities linking to id. Unless isAdmin, drafts of other users are skipped.
+func (c *core) GetBacklinks(ctx context.Context, id uuid.UUID, isAdmin bool) ([]ListItem, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetBacklinks: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.GetBacklinks: %w", err)
+		}
+		userID = &uid
+	}
+	items, err := c.repo.GetBacklinks(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetBacklinks: %w", err)
+	}
+
+	return items, nil
+}
+
+// Export passes the subtree of rootID, or the whole workspace if it is nil, to write one page at a time,
+// so memory use does not grow with the size of the export. Unless isAdmin, drafts of other users are
+// skipped. It stops at the first error of write or when ctx is done.
+func (c *core) Export(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]ExportItem) error) error {
+	if rootID != nil && *rootID == uuid.Nil {
+		return fmt.Errorf("entity.core.Export: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return fmt.Errorf("entity.core.Export: %w", err)
+		}
+		userID = &uid
+	}
+
+	var cursor ExportCursor
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("entity.core.Export: %w", err)
+		}
+		items, err := c.repo.GetExportPage(ctx, rootID, cursor, ExportPageSize, userID)
+		if err != nil {
+			return fmt.Errorf("entity.core.Export: %w", err)
+		}
+		if len(items) == 0 {
+			if rootID != nil && cursor == (ExportCursor{}) {
+				return fmt.Errorf("entity.core.Export: %w", ErrEntityNotFound())
+			}
+			return nil
+		}
+		if err = write(items); err != nil {
+			return fmt.Errorf("entity.core.Export: %w", err)
+		}
+		if len(items) < ExportPageSize {
+			return nil
+		}
+		last := items[len(items)-1]
+		cursor = ExportCursor{Depth: last.Depth, ID: last.ID}
+	}
+}
+
+func (c *core) GetBrokenLinks(ctx context.Context) ([]BrokenLink, error) {
+	links, err := c.repo.GetBrokenLinks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetBrokenLinks: %w", err)
+	}
+
+	return links, nil
+}
+
+// PruneVersions applies the retention policy, or the one of a space where it sets its own, so it runs even
+// when the policy keeps everything. With dryRun nothing is deleted and the report lists the versions that
+// would be removed.
+func (c *core) PruneVersions(ctx context.Context, dryRun bool) (RetentionReport, error) {
+	policy := c.cfg.Retention
+	report := RetentionReport{Policy: policy, DryRun: dryRun, Versions: []VersionRef{}}
+
+	versions, err := c.repo.PruneVersions(ctx, policy.KeepLastVersions, policy.KeepDays, c.gen.Time.Now(), dryRun)
+	if err != nil {
+		return RetentionReport{}, fmt.Errorf("entity.core.PruneVersions: %w", err)
+	}
+	report.Versions = versions
+
+	return report, nil
+}
+
+// PurgeTrash hard-deletes the entities deleted more than Trash.RetentionDays ago, their versions included.
+// With dryRun nothing is deleted and the report lists what would be removed. With userID, the drafts of
+// other users are counted rather than listed.
+func (c *core) PurgeTrash(ctx context.Context, userID *uuid.UUID, dryRun bool) (TrashReport, error) {
+	cutoff := c.gen.Time.Now().AddDate(0, 0, -c.cfg.Trash.RetentionDays)
+	purged, err := c.repo.PurgeDeleted(ctx, cutoff, dryRun)
+	if err != nil {
+		return TrashReport{}, fmt.Errorf("entity.core.PurgeTrash: %w", err)
+	}
+
+	report := TrashReport{RetentionDays: c.cfg.Trash.RetentionDays, Cutoff: cutoff, DryRun: dryRun, Entities: make([]PurgedEntity, 0, len(purged))}
+	for _, e := range purged {
+		report.ReclaimableBytes += e.Bytes
+		if userID != nil && e.Draft && e.UpdatedBy != *userID {
+			report.HiddenDrafts++
+			continue
+		}
+		report.Entities = append(report.Entities, e)
+	}
+
+	return report, nil
+}
+
+// ReencryptContent encrypts the content that is plain or encrypted with a previous key with the current
+// one, Encryption.BatchSize rows at a time. Rows that cannot be decrypted are skipped and reported, so
+// one of them does not hold up the others. Without encryption it does nothing.
+func (c *core) ReencryptContent(ctx context.Context) (ReencryptReport, error) {
+	var report ReencryptReport
+	if !c.cfg.Encryption.Enabled {
+		return report, nil
+	}
+
+	for {
+		n, skipped, err := c.repo.ReencryptContent(ctx, c.cfg.Encryption.BatchSize, report.Skipped)
+		report.Encrypted += n
+		report.Skipped = append(report.Skipped, skipped...)
+		if err != nil {
+			return report, fmt.Errorf("entity.core.ReencryptContent: %w", err)
+		}
+		if n+len(skipped) < c.cfg.Encryption.BatchSize {
+			return report, nil
+		}
+	}
+}
+
+// Lock takes the edit lock for userID or extends the one already held, for LockTTLMinutes.
+func (c *core) Lock(ctx context.Context, id, userID uuid.UUID) (Lock, error) {
+	if id == uuid.Nil {
+		return Lock{}, fmt.Errorf("entity.core.Lock: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if userID == uuid.Nil {
+		return Lock{}, fmt.Errorf("entity.core.Lock: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	lock, acquired, err := c.repo.AcquireLock(ctx, id, userID, time.Duration(c.cfg.LockTTLMinutes)*time.Minute)
+	if err != nil {
+		return Lock{}, fmt.Errorf("entity.core.Lock: %w", err)
+	}
+	if !acquired {
+		return Lock{}, fmt.Errorf("entity.core.Lock: %w", ErrEntityLocked(lock))
+	}
+
+	return lock, nil
+}
+
+// Unlock releases the lock held by userID. With force the lock of any user is released.
+func (c *core) Unlock(ctx context.Context, id, userID uuid.UUID, force bool) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.Unlock: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	lock, err := c.repo.GetLock(ctx, id)
+	if err != nil {
+		return fmt.Errorf("entity.core.Unlock: %w", err)
+	}
+	if lock.UserID != userID && !force {
+		return fmt.Errorf("entity.core.Unlock: %w", ErrEntityLocked(lock))
+	}
+	if err = c.repo.ReleaseLock(ctx, id); err != nil {
+		return fmt.Errorf("entity.core.Unlock: %w", err)
+	}
+
+	return nil
+}
+
+// GetLock returns the active lock of the entity or ErrLockNotFound.
+func (c *core) GetLock(ctx context.Context, id uuid.UUID) (Lock, error) {
+	if id == uuid.Nil {
+		return Lock{}, fmt.Errorf("entity.core.GetLock: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	lock, err := c.repo.GetLock(ctx, id)
+	if err != nil {
+		return Lock{}, fmt.Errorf("entity.core.GetLock: %w", err)
+	}
+
+	return lock, nil
+}
+
+// Autosave stores content as the autosave of userID, checked against the content limit of the entity
+// type. It creates no version and leaves the entity as it is.
+func (c *core) Autosave(ctx context.Context, id, userID uuid.UUID, content string) (Autosave, error) {
+	if id == uuid.Nil {
+		return Autosave{}, fmt.Errorf("entity.core.Autosave: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if userID == uuid.Nil {
+		return Autosave{}, fmt.Errorf("entity.core.Autosave: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	item, err := c.repo.GetListItem(ctx, id)
+	if err != nil {
+		return Autosave{}, fmt.Errorf("entity.core.Autosave: %w", err)
+	}
+	if _, err = c.validator.ValidateContent(item.Type, content); err != nil {
+		return Autosave{}, fmt.Errorf("entity.core.Autosave: %w", err)
+	}
+	autosave := Autosave{Content: content, SavedAt: c.gen.Time.Now()}
+	if err = c.repo.SaveAutosave(ctx, id, userID, autosave.Content, autosave.SavedAt); err != nil {
+		return Autosave{}, fmt.Errorf("entity.core.Autosave: %w", err)
+	}
+
+	return autosave, nil
+}
+
+func (c *core) GetAutosave(ctx context.Context, id, userID uuid.UUID) (Autosave, error) {
+	if id == uuid.Nil {
+		return Autosave{}, fmt.Errorf("entity.core.GetAutosave: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	autosave, err := c.repo.GetAutosave(ctx, id, userID)
+	if err != nil {
+		return Autosave{}, fmt.Errorf("entity.core.GetAutosave: %w", err)
+	}
+
+	return autosave, nil
+}
+
+// DiscardAutosave removes the autosave of userID.
+func (c *core) DiscardAutosave(ctx context.Context, id, userID uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.DiscardAutosave: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := c.repo.DeleteAutosave(ctx, id, userID); err != nil {
+		return fmt.Errorf("entity.core.DiscardAutosave: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredLocks removes expired lock rows. They are already ignored, this only keeps the table small.
+func (c *core) DeleteExpiredLocks(ctx context.Context) (int64, error) {
+	n, err := c.repo.DeleteExpiredLocks(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("entity.core.DeleteExpiredLocks: %w", err)
+	}
+
+	return n, nil
+}
+
+// assignSlug keeps the current slug while it still fits the name, so renames that only change
+// case or punctuation do not move the entity. Otherwise the first free slug for the name is picked.
+func (c *core) assignSlug(ctx context.Context, name string, id uuid.UUID, current string) (string, error) {
+	base := Slugify(name)
+	if current != "" && slugHasBase(current, base) {
+		return current, nil
+	}
+	taken, err := c.repo.GetTakenSlugs(ctx, base, id)
+	if err != nil {
+		return "", err
+	}
+
+	return PickSlug(base, taken), nil
+}
+
+// checkSiblingName fails when UniqueSiblingNames is on and another entity under parentID has the same name.
+func (c *core) checkSiblingName(ctx context.Context, parentID *uuid.UUID, name string, excludeID, userID uuid.UUID) error {
+	if !c.cfg.UniqueSiblingNames {
+		return nil
+	}
+	exists, err := c.repo.SiblingNameExists(ctx, parentID, NormalizeSiblingName(name), excludeID, userID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrDuplicateSiblingName()
+	}
+
+	return nil
+}
+
+// checkLock fails when another user holds an active lock on the entity.
+func (c *core) checkLock(ctx context.Context, id, userID uuid.UUID) error {
+	lock, err := c.repo.GetLock(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrLockNotFound()) {
+			return nil
+		}
+		return err
+	}
+	if lock.UserID != userID {
+		return ErrEntityLocked(lock)
+	}
+
+	return nil
+}
+
 func (c *core) GetTree(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (Tree, error) {
-	var (
-		err       error
-		permitted []ListItem
-	)
+	if !isAdmin && len(permissions) == 0 {
+		return Tree{}, nil
+	}
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Service.GetTree: %w", err)
+	}
+
+	var permitted []ListItem
 	if isAdmin {
 		permitted, err = c.repo.GetAll(ctx)
 	} else {
-		if len(permissions) == 0 {
-			return Tree{}, nil
-		}
-		var userID uuid.UUID
-		userID, err = contextx.GetUserID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("entity.Service.GetTree: %w", err)
-		}
 		permitted, err = c.repo.GetHierarchy(ctx, permissions, c.cfg.MaxHierarchyDepth, &userID, HierarchyTypeChildrenAndParents)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("entity.Service.GetTree: %w", err)
 	}
+	draftIDs, err := c.repo.GetDraftIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Service.GetTree: %w", err)
+	}
 
-	return BuildTree(ctx, permitted), nil
+	tree := BuildTree(ctx, permitted)
+	tree.countDrafts(draftIDs)
+
+	return tree, nil
 }
 
 func (c *core) GetPermittedIDs(ctx context.Context, directPermissions []uuid.UUID, hType HierarchyType) ([]uuid.UUID, error) {
@@ -162,37 +964,81 @@ func (c *core) GetVersion(ctx context.Context, id uuid.UUID, version int) (Entit
 	return entity, nil
 }
 
-func (c *core) GetVersionsList(ctx context.Context, id uuid.UUID) ([]Entity, error) {
-	if id == uuid.Nil {
-		return nil, fmt.Errorf("entity.core.GetVersionsList: %w", apperr.ErrNilUUID(FieldEntityID))
+// GetAuthoredVersions lists the versions the user created, without their content.
+func (c *core) GetAuthoredVersions(ctx context.Context, userID uuid.UUID) ([]AuthoredVersion, error) {
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetAuthoredVersions: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	versions, err := c.repo.GetAuthoredVersions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetAuthoredVersions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (c *core) GetVersionsList(ctx context.Context, req GetVersionsReq) (VersionsPage, error) {
+	if req.ID == uuid.Nil {
+		return VersionsPage{}, fmt.Errorf("entity.core.GetVersionsList: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.Limit <= 0 || req.Limit > MaxVersionsLimit {
+		return VersionsPage{}, fmt.Errorf("entity.core.GetVersionsList: %w", ErrInvalidVersionsLimit(MaxVersionsLimit))
+	}
+	// zero starts from the newest version
+	var before int
+	if req.Before != "" {
+		values, err := db.DecodeCursor(req.Before, 1)
+		if err != nil {
+			return VersionsPage{}, fmt.Errorf("entity.core.GetVersionsList: %w", err)
+		}
+		if before, err = strconv.Atoi(values[0]); err != nil || before <= 0 {
+			return VersionsPage{}, fmt.Errorf("entity.core.GetVersionsList: %w", db.ErrInvalidCursor())
+		}
 	}
-	entities, err := c.repo.GetVersionsList(ctx, id)
+
+	// one extra row tells whether another page exists
+	versions, err := c.repo.GetVersionsList(ctx, req.ID, before, req.Limit+1, req.IncludeContent)
 	if err != nil {
-		return nil, fmt.Errorf("entity.core.GetVersionsList: %w", err)
+		return VersionsPage{}, fmt.Errorf("entity.core.GetVersionsList: %w", err)
+	}
+	page := VersionsPage{Versions: versions}
+	if len(versions) > req.Limit {
+		page.Versions = versions[:req.Limit]
+		page.NextCursor = db.EncodeCursor(strconv.Itoa(*page.Versions[req.Limit-1].CurrentVersion))
 	}
 
-	return entities, nil
+	return page, nil
 }
 
-func (c *core) Create(ctx context.Context, req CreateEntityReq) (uuid.UUID, error) {
+// Create stores a new entity and reports how much of the content limit it uses.
+func (c *core) Create(ctx context.Context, req CreateEntityReq) (uuid.UUID, ContentUsage, error) {
 	if req.UserID == uuid.Nil {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", apperr.ErrNilUUID(FieldUserID))
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", apperr.ErrNilUUID(FieldUserID))
 	}
 	if err := req.Type.CheckIsValid(); err != nil {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 	}
 	req.Name = c.validator.NormalizeName(req.Name)
-	if err := c.validator.ValidateName(req.Name); err != nil {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+	if err := c.validator.ValidateName(req.Type, req.Name); err != nil {
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
+	}
+	usage, err := c.validator.ValidateContent(req.Type, req.Content)
+	if err != nil {
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
+	}
+	if req.VariantOf != nil {
+		if req.Language, err = c.validateLanguage(req.Language); err != nil {
+			return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
+		}
 	}
 
 	if req.ParentID != nil {
 		list, err := c.repo.GetHierarchy(ctx, []uuid.UUID{*req.ParentID}, c.cfg.MaxHierarchyDepth+1, nil, HierarchyTypeParentsOnly)
 		if err != nil {
-			return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+			return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 		}
 		if len(list)+1 > c.cfg.MaxHierarchyDepth {
-			return uuid.Nil, fmt.Errorf("entity.core.Create: %w", ErrMaxHierarchyDepthExceeded(c.cfg.MaxHierarchyDepth))
+			return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", ErrMaxHierarchyDepthExceeded(c.cfg.MaxHierarchyDepth))
 		}
 		var (
 			parent ListItem
@@ -206,19 +1052,30 @@ func (c *core) Create(ctx context.Context, req CreateEntityReq) (uuid.UUID, erro
 			}
 		}
 		if !found {
-			return uuid.Nil, fmt.Errorf("entity.core.Create: %w", ErrParentNotFound())
+			return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", ErrParentNotFound())
 		}
 		if err = req.Type.ValidateParentTypeCompatibility(parent.Type); err != nil {
-			return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+			return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 		}
 	} else if req.Type == TypeArticle {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", ErrParentRequired())
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", ErrParentRequired())
+	}
+	if err = c.checkSiblingName(ctx, req.ParentID, req.Name, uuid.Nil, req.UserID); err != nil {
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
+	}
+	req.Stats = ComputeContentStats(req.Content)
+	if req.Slug, err = c.assignSlug(ctx, req.Name, uuid.Nil, ""); err != nil {
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 	}
 
 	now := c.gen.Time.Now()
 	id, err := c.gen.ID.New()
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
+	}
+	req.Links = ExtractLinks(req.Content, id)
+	if usage.BrokenLinks, err = c.checkLinks(ctx, req.Type, req.Links); err != nil {
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 	}
 	if req.IsDraft {
 		err = c.repo.CreateDraft(ctx, req, id)
@@ -226,65 +1083,131 @@ func (c *core) Create(ctx context.Context, req CreateEntityReq) (uuid.UUID, erro
 		err = c.repo.Create(ctx, req, id, now)
 	}
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("entity.core.Create: %w", err)
+		return uuid.Nil, ContentUsage{}, fmt.Errorf("entity.core.Create: %w", err)
 	}
 
-	return id, nil
+	return id, usage, nil
 }
 
-func (c *core) Update(ctx context.Context, req UpdateEntityReq) error {
+// Update stores the new state of an entity and reports how much of the content limit it uses.
+func (c *core) Update(ctx context.Context, req UpdateEntityReq) (ContentUsage, error) {
 	if req.ID == uuid.Nil {
-		return fmt.Errorf("entity.core.Update: %w", apperr.ErrNilUUID(FieldEntityID))
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", apperr.ErrNilUUID(FieldEntityID))
 	}
 	if req.UserID == uuid.Nil {
-		return fmt.Errorf("entity.core.Update: %w", apperr.ErrNilUUID(FieldUserID))
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", apperr.ErrNilUUID(FieldUserID))
 	}
 	req.Name = c.validator.NormalizeName(req.Name)
-	if err := c.validator.ValidateName(req.Name); err != nil {
-		return fmt.Errorf("entity.core.Update: %w", err)
+	if err := c.validator.ValidateName(req.EntityType, req.Name); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	usage, err := c.validator.ValidateContent(req.EntityType, req.Content)
+	if err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	req.Summary = strings.TrimSpace(req.Summary)
+	if req.Summary != "" {
+		if err = c.validator.ValidateSummary(req.Summary); err != nil {
+			return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+		}
 	}
 	var (
 		hasChildren         bool
 		hasChildrenComputed bool
-		err                 error
 	)
 	if req.ParentChanged {
 		hasChildren, err = c.validateNewParentForUpdate(ctx, req)
 		if err != nil {
-			return fmt.Errorf("entity.core.Update: %w", err)
+			return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
 		}
 		hasChildrenComputed = true
 	}
+	if err = c.checkSiblingName(ctx, req.ParentID, req.Name, req.ID, req.UserID); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	req.Stats = ComputeContentStats(req.Content)
+	req.Links = ExtractLinks(req.Content, req.ID)
+	if usage.BrokenLinks, err = c.checkLinks(ctx, req.EntityType, req.Links); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	if err = c.checkReview(ctx, req); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	current, err := c.repo.GetListItem(ctx, req.ID)
+	if err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+	if req.Slug, err = c.assignSlug(ctx, req.Name, req.ID, current.Slug); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
 
 	if req.IsDraft {
 		if !hasChildrenComputed {
 			list, err := c.repo.GetHierarchy(ctx, []uuid.UUID{req.ID}, 2, nil, HierarchyTypeChildrenOnly)
 			if err != nil {
-				return fmt.Errorf("entity.core.Update: %w", err)
+				return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
 			}
 			hasChildren = len(list) > 1
 		}
 		if hasChildren {
-			return fmt.Errorf("entity.core.Update: %w", ErrCannotDraftEntityWithChildren())
+			return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", ErrCannotDraftEntityWithChildren())
 		}
+	}
+	if err = c.checkLock(ctx, req.ID, req.UserID); err != nil {
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
+	}
+
+	if req.IsDraft {
 		err = c.repo.UpdateDraft(ctx, req)
 	} else {
 		now := c.gen.Time.Now()
 		err = c.repo.Update(ctx, req, now)
 	}
 	if err != nil {
-		return fmt.Errorf("entity.core.Update: %w", err)
+		return ContentUsage{}, fmt.Errorf("entity.core.Update: %w", err)
 	}
-	return nil
+	return usage, nil
+}
+
+// checkLinks returns the links to entities that do not exist or were deleted, or an error if the
+// entity type must not have any.
+func (c *core) checkLinks(ctx context.Context, entityType Type, links []uuid.UUID) ([]uuid.UUID, error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+	items, err := c.repo.GetListItems(ctx, links)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[uuid.UUID]struct{}, len(items))
+	for _, item := range items {
+		found[item.ID] = struct{}{}
+	}
+	var broken []uuid.UUID
+	for _, id := range links {
+		if _, ok := found[id]; !ok {
+			broken = append(broken, id)
+		}
+	}
+	if err = c.validator.ValidateLinks(entityType, broken); err != nil {
+		return nil, err
+	}
+
+	return broken, nil
 }
 
-func (c *core) Delete(ctx context.Context, id uuid.UUID) error {
+// Delete deletes the entity with its subtree and reports the entities deleted. With dryRun it only
+// reports them.
+func (c *core) Delete(ctx context.Context, id, userID uuid.UUID, dryRun bool) (DeleteReport, error) {
+	if userID == uuid.Nil {
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", apperr.ErrNilUUID(FieldUserID))
+	}
 	list, err := c.repo.GetHierarchy(ctx, []uuid.UUID{id}, c.cfg.MaxHierarchyDepth+1, nil, HierarchyTypeChildrenOnly)
 	if err != nil {
-		return fmt.Errorf("entity.core.Delete: %w", err)
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", err)
 	}
 	if len(list) == 0 {
-		return fmt.Errorf("entity.core.Delete: %w", ErrEntityNotFound())
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", ErrEntityNotFound())
 	}
 	ids := make([]uuid.UUID, 0, len(list))
 	maxDepth := 0
@@ -295,14 +1218,27 @@ func (c *core) Delete(ctx context.Context, id uuid.UUID) error {
 		ids = append(ids, item.ID)
 	}
 	if maxDepth > c.cfg.MaxHierarchyDepth {
-		return fmt.Errorf("entity.core.Delete: %w", ErrMaxHierarchyDepthExceeded(c.cfg.MaxHierarchyDepth))
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", ErrMaxHierarchyDepthExceeded(c.cfg.MaxHierarchyDepth))
 	}
 
-	if err = c.repo.Delete(ctx, ids); err != nil {
-		return fmt.Errorf("entity.core.Delete: %w", err)
+	// the report only names what the user can see
+	visible, err := c.repo.GetHierarchy(ctx, []uuid.UUID{id}, c.cfg.MaxHierarchyDepth+1, &userID, HierarchyTypeChildrenOnly)
+	if err != nil {
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", err)
+	}
+	report := DeleteReport{DryRun: dryRun, Entities: make([]DeletedEntity, 0, len(visible)), Hidden: len(list) - len(visible), Count: len(list)}
+	for _, item := range visible {
+		report.Entities = append(report.Entities, DeletedEntity{ID: item.ID, Type: item.Type, Name: item.Name, ParentID: item.ParentID})
+	}
+	if dryRun {
+		return report, nil
 	}
 
-	return nil
+	if err = c.repo.Delete(ctx, ids, userID); err != nil {
+		return DeleteReport{}, fmt.Errorf("entity.core.Delete: %w", err)
+	}
+
+	return report, nil
 }
 
 func (c *core) validateNewParentForUpdate(ctx context.Context, req UpdateEntityReq) (bool, error) {
@@ -360,30 +1296,155 @@ func (c *core) validateNewParentForUpdate(ctx context.Context, req UpdateEntityR
 }
 
 type ValidationConfig struct {
-	MaxNameLength int `mapstructure:"max_name_length" json:"max_name_length"`
+	MaxNameLength int            `mapstructure:"max_name_length" json:"max_name_length"`
+	NameRules     text.NameRules `mapstructure:"name_rules" json:"name_rules"`
+	// ForbiddenNameCharsByType overrides NameRules.ForbiddenChars for some entity types.
+	ForbiddenNameCharsByType map[Type]string `mapstructure:"forbidden_name_chars_by_type" json:"forbidden_name_chars_by_type,omitempty"`
+	// MaxContentLength limits content in bytes; MaxContentLengthByType overrides it for some entity types.
+	MaxContentLength       int          `mapstructure:"max_content_length" json:"max_content_length"`
+	MaxContentLengthByType map[Type]int `mapstructure:"max_content_length_by_type" json:"max_content_length_by_type,omitempty"`
+	// ContentWarningPercent is the share of the limit above which writes still succeed but are flagged; 0 disables it.
+	ContentWarningPercent int `mapstructure:"content_warning_percent" json:"content_warning_percent"`
+	// RejectBrokenLinks lists the entity types whose content must not link to missing or deleted
+	// entities. Other types are saved and the broken links reported.
+	RejectBrokenLinks []Type `mapstructure:"reject_broken_links" json:"reject_broken_links,omitempty"`
+	// MaxSummaryLength limits the change summary of an update in characters.
+	MaxSummaryLength int `mapstructure:"max_summary_length" json:"max_summary_length"`
+}
+
+func (c ValidationConfig) Validate() error {
+	if c.MaxNameLength <= 0 {
+		return fmt.Errorf("max name length must be positive")
+	}
+	if err := c.NameRules.Validate(); err != nil {
+		return fmt.Errorf("name rules: %w", err)
+	}
+	for t, chars := range c.ForbiddenNameCharsByType {
+		if err := t.CheckIsValid(); err != nil {
+			return fmt.Errorf("forbidden name chars by type: unknown entity type %q", t)
+		}
+		if err := text.ValidateForbiddenChars(chars); err != nil {
+			return fmt.Errorf("forbidden name chars for %s: %w", t, err)
+		}
+	}
+	if c.MaxContentLength <= 0 {
+		return fmt.Errorf("max content length must be positive")
+	}
+	for t, limit := range c.MaxContentLengthByType {
+		if err := t.CheckIsValid(); err != nil {
+			return fmt.Errorf("max content length by type: unknown entity type %q", t)
+		}
+		if limit <= 0 {
+			return fmt.Errorf("max content length for %s must be positive", t)
+		}
+	}
+	if c.ContentWarningPercent < 0 || c.ContentWarningPercent > 100 {
+		return fmt.Errorf("content warning percent must be between 0 and 100")
+	}
+	for _, t := range c.RejectBrokenLinks {
+		if err := t.CheckIsValid(); err != nil {
+			return fmt.Errorf("reject broken links: unknown entity type %q", t)
+		}
+	}
+	if c.MaxSummaryLength <= 0 {
+		return fmt.Errorf("max summary length must be positive")
+	}
+
+	return nil
+}
+
+// ContentLimit returns the content limit in bytes for the entity type.
+func (c ValidationConfig) ContentLimit(t Type) int {
+	if limit, ok := c.MaxContentLengthByType[t]; ok {
+		return limit
+	}
+	return c.MaxContentLength
+}
+
+// ForbiddenNameChars returns the characters names of the entity type must not contain.
+func (c ValidationConfig) ForbiddenNameChars(t Type) string {
+	if chars, ok := c.ForbiddenNameCharsByType[t]; ok {
+		return chars
+	}
+	return c.NameRules.ForbiddenChars
 }
 
+// LargestContentLimit returns the highest content limit over all entity types.
+func (c ValidationConfig) LargestContentLimit() int {
+	largest := c.MaxContentLength
+	for _, limit := range c.MaxContentLengthByType {
+		largest = max(largest, limit)
+	}
+	return largest
+}
+
+// validator limits can be swapped at runtime with Reload.
 type validator struct {
-	cfg ValidationConfig
+	cfg atomic.Pointer[ValidationConfig]
 }
 
 func NewValidator(cfg ValidationConfig) (*validator, error) {
-	if cfg.MaxNameLength <= 0 {
-		return nil, fmt.Errorf("entity.NewValidator: %w", fmt.Errorf("max name length must be positive"))
+	v := &validator{}
+	if err := v.Reload(cfg); err != nil {
+		return nil, fmt.Errorf("entity.NewValidator: %w", err)
+	}
+	return v, nil
+}
+
+// Reload replaces the limits. An invalid config is rejected and the current one is kept.
+func (c *validator) Reload(cfg ValidationConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("entity.validator.Reload: %w", err)
 	}
-	return &validator{cfg: cfg}, nil
+	c.cfg.Store(&cfg)
+
+	return nil
 }
 
 func (c *validator) NormalizeName(name string) string {
-	return strings.TrimSpace(name)
+	return c.cfg.Load().NameRules.Normalize(name)
 }
 
-func (c *validator) ValidateName(name string) error {
+func (c *validator) ValidateName(entityType Type, name string) error {
+	cfg := c.cfg.Load()
 	if name == "" {
 		return fmt.Errorf("validateName: %w", ErrNameRequired())
 	}
-	if len(name) > c.cfg.MaxNameLength {
-		return fmt.Errorf("validateName: %w", ErrNameTooLong(c.cfg.MaxNameLength))
+	if text.Length(name) > cfg.MaxNameLength {
+		return fmt.Errorf("validateName: %w", ErrNameTooLong(cfg.MaxNameLength))
+	}
+	if ch, ok := text.FirstForbidden(name, cfg.ForbiddenNameChars(entityType)); ok {
+		return fmt.Errorf("validateName: %w", ErrNameForbiddenChar(ch))
+	}
+
+	return nil
+}
+
+func (c *validator) ValidateContent(entityType Type, content string) (ContentUsage, error) {
+	cfg := c.cfg.Load()
+	usage := ContentUsage{Length: len(content), Limit: cfg.ContentLimit(entityType)}
+	if usage.Length > usage.Limit {
+		return ContentUsage{}, fmt.Errorf("validateContent: %w", ErrContentTooLong(usage.Limit))
+	}
+	// compared in int64 so that large limits cannot overflow
+	usage.Warning = cfg.ContentWarningPercent > 0 &&
+		int64(usage.Length)*100 > int64(usage.Limit)*int64(cfg.ContentWarningPercent)
+
+	return usage, nil
+}
+
+func (c *validator) ValidateLinks(entityType Type, broken []uuid.UUID) error {
+	if len(broken) > 0 && slices.Contains(c.cfg.Load().RejectBrokenLinks, entityType) {
+		return fmt.Errorf("validateLinks: %w", ErrBrokenLinks(broken))
+	}
+
+	return nil
+}
+
+func (c *validator) ValidateSummary(summary string) error {
+	maxLength := c.cfg.Load().MaxSummaryLength
+	if text.Length(summary) > maxLength {
+		return fmt.Errorf("validateSummary: %w", ErrSummaryTooLong(maxLength))
 	}
 
 	return nil
@@ -3,6 +3,8 @@ package entity_test
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
@@ -17,7 +21,7 @@ import (
 //go:generate minimock -o ./mocks -s _mock.go
 
 func Cfg() entity.Config {
-	return entity.Config{MaxHierarchyDepth: 1}
+	return entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15}
 }
 
 func TestNewCore(t *testing.T) {
@@ -76,7 +80,7 @@ func TestNewCore(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			_, err := entity.NewCore(tt.repo, tt.gen, tt.validator, entity.Config{MaxHierarchyDepth: 1})
+			_, err := entity.NewCore(tt.repo, tt.gen, tt.validator, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15})
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -163,6 +167,1341 @@ func TestCore_Get(t *testing.T) {
 	}
 }
 
+func TestCore_GetMeta(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		id   = uuid.New()
+		want = entity.Meta{
+			ContentStats:  entity.ContentStats{WordCount: 450, ReadingTimeMinutes: 3},
+			VersionCount:  2,
+			ChildrenCount: 1,
+			LastEditors:   []entity.Editor{{UserID: uuid.New(), EditedAt: time.Now()}},
+		}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		setup func(repo *mocks.RepositoryMock)
+		want  entity.Meta
+		err   error
+	}{
+		{
+			name: "success",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetMetaMock.Expect(ctx, id, 5).Return(want, nil)
+			},
+			want: want,
+		},
+		{
+			name: "error/nil_id",
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/repo_error",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetMetaMock.Expect(ctx, id, 5).Return(entity.Meta{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetMeta(ctx, tt.id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_GetContributors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		want   = []entity.Contributor{{UserID: uuid.New(), VersionCount: 2, LastContributedAt: time.Now()}}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		setup func(repo *mocks.RepositoryMock)
+		want  []entity.Contributor
+		err   error
+	}{
+		{
+			name: "success",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetContributorsMock.Expect(ctx, id).Return(want, nil)
+			},
+			want: want,
+		},
+		{
+			name: "error/nil_id",
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/repo_error",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetContributorsMock.Expect(ctx, id).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetContributors(ctx, tt.id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_GetActivity(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = uuid.New()
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(context.Background(), userID)
+		events = []entity.Event{{ID: 9, EntityID: id}, {ID: 7, EntityID: id}, {ID: 4, EntityID: id}}
+		expErr = fmt.Errorf("test error")
+		maxDep = Cfg().MaxHierarchyDepth
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     entity.GetActivityReq
+		isAdmin bool
+		quiet   bool
+		setup   func(repo *mocks.RepositoryMock)
+		want    entity.Activity
+		err     error
+	}{
+		{
+			name: "success/next page exists",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Expect(ctx, id, 0, 3, maxDep, &userID, true).Return(events, nil)
+			},
+			want: entity.Activity{Events: events[:2], NextCursor: db.EncodeCursor(strconv.FormatInt(events[1].ID, 10))},
+		},
+		{
+			name: "success/last page",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Before: db.EncodeCursor("9"), Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Expect(ctx, id, 9, 3, maxDep, &userID, true).Return(events[1:], nil)
+			},
+			want: entity.Activity{Events: events[1:]},
+		},
+		{
+			name:    "success/admin sees drafts",
+			ctx:     context.Background(),
+			req:     entity.GetActivityReq{ID: id, Limit: 5},
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Expect(context.Background(), id, 0, 6, maxDep, nil, true).Return(events, nil)
+			},
+			want: entity.Activity{Events: events},
+		},
+		{
+			name:  "success/quiet minor edits",
+			ctx:   ctx,
+			req:   entity.GetActivityReq{ID: id, Limit: 5},
+			quiet: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Expect(ctx, id, 0, 6, maxDep, &userID, false).Return(events, nil)
+			},
+			want: entity.Activity{Events: events},
+		},
+		{
+			name:  "success/quiet minor edits included",
+			ctx:   ctx,
+			req:   entity.GetActivityReq{ID: id, Limit: 5, IncludeMinor: true},
+			quiet: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Expect(ctx, id, 0, 6, maxDep, &userID, true).Return(events, nil)
+			},
+			want: entity.Activity{Events: events},
+		},
+		{
+			name: "error/nil_id",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{Limit: 5},
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/limit too small",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id},
+			err:  entity.ErrInvalidActivityLimit(entity.MaxActivityLimit),
+		},
+		{
+			name: "error/limit too large",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Limit: entity.MaxActivityLimit + 1},
+			err:  entity.ErrInvalidActivityLimit(entity.MaxActivityLimit),
+		},
+		{
+			name: "error/malformed cursor",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Before: "9", Limit: 5},
+			err:  db.ErrInvalidCursor(),
+		},
+		{
+			name: "error/negative cursor",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Before: db.EncodeCursor("-1"), Limit: 5},
+			err:  db.ErrInvalidCursor(),
+		},
+		{
+			name: "error/no_user_in_context",
+			ctx:  context.Background(),
+			req:  entity.GetActivityReq{ID: id, Limit: 5},
+			err:  apperr.ErrUnauthorized(),
+		},
+		{
+			name: "error/repo_error",
+			ctx:  ctx,
+			req:  entity.GetActivityReq{ID: id, Limit: 5},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetActivityMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			cfg := Cfg()
+			cfg.QuietMinorEdits = tt.quiet
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.GetActivity(tt.ctx, tt.req, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComputeContentStats(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, entity.ContentStats{}, entity.ComputeContentStats(" \n\t "))
+	require.Equal(t, entity.ContentStats{WordCount: 3, ReadingTimeMinutes: 1}, entity.ComputeContentStats("one  two\nthree"))
+	require.Equal(t, entity.ContentStats{WordCount: 201, ReadingTimeMinutes: 2}, entity.ComputeContentStats(strings.Repeat("word ", 201)))
+}
+
+func TestExtractLinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		self = uuid.New()
+		a    = uuid.New()
+		b    = uuid.New()
+		c    = uuid.New()
+	)
+	content := fmt.Sprintf("See [[%s]] and [[%s|the other]], again [[%s]], "+
+		"https://docs.example.com/api/v1/entities/%s/versions, itself [[%s]], broken [[not-an-id]], {{ include %s }}.",
+		a, strings.ToUpper(b.String()), a, b, self, c)
+
+	require.Equal(t, []uuid.UUID{a, b, c}, entity.ExtractLinks(content, self))
+	require.Nil(t, entity.ExtractLinks("no links here", self))
+}
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "simple", in: "Getting Started", want: "getting-started"},
+		{name: "punctuation", in: "  C++ / Go: a tour! ", want: "c-go-a-tour"},
+		{name: "non_ascii", in: "Руководство пользователя", want: "руководство-пользователя"},
+		{name: "no_letters", in: "!!! ---", want: "entity"},
+		{name: "truncated", in: strings.Repeat("a", 79) + " bcd", want: strings.Repeat("a", 79)},
+		{name: "truncated_runes", in: strings.Repeat("я", 100), want: strings.Repeat("я", entity.MaxSlugLength)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, entity.Slugify(tt.in))
+		})
+	}
+}
+
+func TestPickSlug(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "intro", entity.PickSlug("intro", nil))
+	require.Equal(t, "intro", entity.PickSlug("intro", []string{"intro-2", "intro-guide"}))
+	require.Equal(t, "intro-2", entity.PickSlug("intro", []string{"intro", "intro-guide"}))
+	require.Equal(t, "intro-4", entity.PickSlug("intro", []string{"intro-3", "intro", "intro-2"}))
+}
+
+func TestCore_GetIDBySlug(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		slug  string
+		setup func(repo *mocks.RepositoryMock)
+		err   error
+	}{
+		{
+			name: "success",
+			slug: "intro",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "intro").Return(id, nil)
+			},
+		},
+		{
+			name: "error/empty",
+			err:  entity.ErrEntityNotFound(),
+		},
+		{
+			name: "error/repo",
+			slug: "intro",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "intro").Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), entity.Config{MaxHierarchyDepth: 4, LockTTLMinutes: 15})
+			require.NoError(t, err)
+
+			got, err := c.GetIDBySlug(ctx, tt.slug)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, got)
+		})
+	}
+}
+
+func TestCore_ResolvePath(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.New()
+		ctx       = contextx.SetUserID(context.Background(), userID)
+		id        = uuid.New()
+		rootID    = uuid.New()
+		oldID     = uuid.New()
+		maxDepth  = Cfg().MaxHierarchyDepth + 1
+		hierarchy = []entity.ListItem{
+			{ID: id, Slug: "install", ParentID: &rootID, Depth: 1},
+			{ID: rootID, Slug: "guides", Depth: 2},
+		}
+		current = []string{"guides", "install"}
+		expErr  = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name     string
+		path     []string
+		isAdmin  bool
+		redirect bool
+		setup    func(repo *mocks.RepositoryMock)
+		moved    bool
+		err      error
+	}{
+		{
+			name: "current path",
+			path: current,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, maxDepth, &userID, entity.HierarchyTypeParentsOnly).Return(hierarchy, nil)
+			},
+		},
+		{
+			name:    "admin sees drafts",
+			path:    current,
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, maxDepth, nil, entity.HierarchyTypeParentsOnly).Return(hierarchy, nil)
+			},
+		},
+		{
+			name:     "former slug under the current parent",
+			path:     []string{"guides", "setup"},
+			redirect: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.When(ctx, "setup").Then(id, nil)
+				repo.GetIDBySlugMock.When(ctx, "guides").Then(rootID, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+			},
+			moved: true,
+		},
+		{
+			name:     "moved away from a parent",
+			path:     []string{"old", "install"},
+			redirect: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.When(ctx, "install").Then(id, nil)
+				repo.GetIDBySlugMock.When(ctx, "old").Then(oldID, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+				repo.HasMovedFromMock.Expect(ctx, id, &oldID).Return(true, nil)
+			},
+			moved: true,
+		},
+		{
+			name:     "moved away from the root",
+			path:     []string{"install"},
+			redirect: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+				repo.HasMovedFromMock.Expect(ctx, id, nil).Return(true, nil)
+			},
+			moved: true,
+		},
+		{
+			name:     "error/never under the parent",
+			path:     []string{"old", "install"},
+			redirect: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.When(ctx, "install").Then(id, nil)
+				repo.GetIDBySlugMock.When(ctx, "old").Then(oldID, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+				repo.HasMovedFromMock.Expect(ctx, id, &oldID).Return(false, nil)
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name: "error/stale path without redirects",
+			path: []string{"old", "install"},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name: "error/empty segment",
+			path: []string{"guides", ""},
+			err:  entity.ErrEntityNotFound(),
+		},
+		{
+			name: "error/hidden draft",
+			path: current,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Return([]entity.ListItem{}, nil)
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name: "error/GetIDBySlug",
+			path: current,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/GetHierarchy",
+			path: current,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:     "error/HasMovedFrom",
+			path:     []string{"install"},
+			redirect: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetIDBySlugMock.Expect(ctx, "install").Return(id, nil)
+				repo.GetHierarchyMock.Return(hierarchy, nil)
+				repo.HasMovedFromMock.Return(false, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			cfg := Cfg()
+			cfg.RedirectMovedPaths = tt.redirect
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.ResolvePath(ctx, tt.path, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entity.PathResolution{ID: id, Path: current, Moved: tt.moved}, got)
+		})
+	}
+}
+
+func TestCore_GetBacklinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = uuid.New()
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(context.Background(), userID)
+		items  = []entity.ListItem{{ID: uuid.New(), Name: "linking"}}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		id      uuid.UUID
+		isAdmin bool
+		setup   func(repo *mocks.RepositoryMock)
+		err     error
+	}{
+		{
+			name: "success/user",
+			ctx:  ctx,
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetBacklinksMock.Expect(ctx, id, &userID).Return(items, nil)
+			},
+		},
+		{
+			name:    "success/admin sees drafts",
+			ctx:     context.Background(),
+			id:      id,
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetBacklinksMock.Expect(context.Background(), id, nil).Return(items, nil)
+			},
+		},
+		{
+			name: "error/nil_id",
+			ctx:  ctx,
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/no_user_in_context",
+			ctx:  context.Background(),
+			id:   id,
+			err:  apperr.ErrUnauthorized(),
+		},
+		{
+			name: "error/repo_error",
+			ctx:  ctx,
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetBacklinksMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetBacklinks(tt.ctx, tt.id, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, items, got)
+		})
+	}
+}
+
+func TestCore_Export(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID = uuid.New()
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(context.Background(), userID)
+		expErr = fmt.Errorf("test error")
+		full   = make([]entity.ExportItem, entity.ExportPageSize)
+		last   = []entity.ExportItem{{ID: uuid.New(), Depth: 3}}
+	)
+	for i := range full {
+		full[i] = entity.ExportItem{ID: uuid.New(), Depth: 2}
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		rootID   *uuid.UUID
+		isAdmin  bool
+		setup    func(repo *mocks.RepositoryMock)
+		writeErr error
+		pages    int
+		err      error
+	}{
+		{
+			name:   "success/pages follow the cursor",
+			ctx:    ctx,
+			rootID: &rootID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetExportPageMock.When(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, &userID).Then(full, nil)
+				repo.GetExportPageMock.When(ctx, &rootID, entity.ExportCursor{Depth: 2, ID: full[len(full)-1].ID}, entity.ExportPageSize, &userID).
+					Then(last, nil)
+			},
+			pages: 2,
+		},
+		{
+			name:    "success/admin exports the empty workspace",
+			ctx:     context.Background(),
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetExportPageMock.Expect(context.Background(), nil, entity.ExportCursor{}, entity.ExportPageSize, nil).Return(nil, nil)
+			},
+		},
+		{
+			name:   "error/root not found",
+			ctx:    ctx,
+			rootID: &rootID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetExportPageMock.Return(nil, nil)
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name:   "error/nil_id",
+			ctx:    ctx,
+			rootID: &uuid.Nil,
+			err:    apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name:   "error/no_user_in_context",
+			ctx:    context.Background(),
+			rootID: &rootID,
+			err:    apperr.ErrUnauthorized(),
+		},
+		{
+			name:   "error/context done",
+			ctx:    cancelled,
+			rootID: &rootID,
+			err:    context.Canceled,
+		},
+		{
+			name:   "error/repo_error",
+			ctx:    ctx,
+			rootID: &rootID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetExportPageMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "error/write stops the export",
+			ctx:    ctx,
+			rootID: &rootID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetExportPageMock.Return(full, nil)
+			},
+			writeErr: expErr,
+			pages:    1,
+			err:      expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			pages := 0
+			err = c.Export(tt.ctx, tt.rootID, tt.isAdmin, func(items []entity.ExportItem) error {
+				pages++
+				require.NotEmpty(t, items)
+				return tt.writeErr
+			})
+			require.Equal(t, tt.pages, pages)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetBrokenLinks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	links := []entity.BrokenLink{{SourceID: uuid.New(), SourceName: "doc", TargetID: uuid.New()}}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	repo.GetBrokenLinksMock.Expect(ctx).Return(links, nil)
+	got, err := c.GetBrokenLinks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, links, got)
+}
+
+func TestCore_Lock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		userID = uuid.New()
+		ttl    = 15 * time.Minute
+		now    = time.Now()
+		own    = entity.Lock{EntityID: id, UserID: userID, AcquiredAt: now, ExpiresAt: now.Add(ttl)}
+		other  = entity.Lock{EntityID: id, UserID: uuid.New(), AcquiredAt: now, ExpiresAt: now.Add(ttl)}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		id     uuid.UUID
+		userID uuid.UUID
+		setup  func(repo *mocks.RepositoryMock)
+		want   entity.Lock
+		err    error
+	}{
+		{
+			name:   "success",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.AcquireLockMock.Expect(ctx, id, userID, ttl).Return(own, true, nil)
+			},
+			want: own,
+		},
+		{
+			name:   "error/locked_by_other",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.AcquireLockMock.Expect(ctx, id, userID, ttl).Return(other, false, nil)
+			},
+			err: entity.ErrEntityLocked(other),
+		},
+		{
+			name:   "error/nil_id",
+			id:     uuid.Nil,
+			userID: userID,
+			err:    apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name:   "error/nil_user_id",
+			id:     id,
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(entity.FieldUserID),
+		},
+		{
+			name:   "error/repo",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.AcquireLockMock.Expect(ctx, id, userID, ttl).Return(entity.Lock{}, false, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.Lock(ctx, tt.id, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_Unlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		userID = uuid.New()
+		own    = entity.Lock{EntityID: id, UserID: userID}
+		other  = entity.Lock{EntityID: id, UserID: uuid.New()}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		force bool
+		setup func(repo *mocks.RepositoryMock)
+		err   error
+	}{
+		{
+			name: "success/own",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetLockMock.Expect(ctx, id).Return(own, nil)
+				repo.ReleaseLockMock.Expect(ctx, id).Return(nil)
+			},
+		},
+		{
+			name:  "success/force",
+			id:    id,
+			force: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetLockMock.Expect(ctx, id).Return(other, nil)
+				repo.ReleaseLockMock.Expect(ctx, id).Return(nil)
+			},
+		},
+		{
+			name: "error/locked_by_other",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetLockMock.Expect(ctx, id).Return(other, nil)
+			},
+			err: entity.ErrEntityLocked(other),
+		},
+		{
+			name: "error/not_locked",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+			},
+			err: entity.ErrLockNotFound(),
+		},
+		{
+			name: "error/nil_id",
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/repo/release",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetLockMock.Expect(ctx, id).Return(own, nil)
+				repo.ReleaseLockMock.Expect(ctx, id).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.Unlock(ctx, tt.id, userID, tt.force)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lock := entity.Lock{EntityID: uuid.New(), UserID: uuid.New()}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	_, err = c.GetLock(ctx, uuid.Nil)
+	require.ErrorIs(t, err, apperr.ErrNilUUID(entity.FieldEntityID))
+
+	repo.GetLockMock.Expect(ctx, lock.EntityID).Return(lock, nil)
+	got, err := c.GetLock(ctx, lock.EntityID)
+	require.NoError(t, err)
+	require.Equal(t, lock, got)
+}
+
+func TestCore_Autosave(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		id      = uuid.New()
+		userID  = uuid.New()
+		now     = time.Now()
+		content = "unsaved"
+		item    = entity.ListItem{ID: id, Type: entity.TypeArticle}
+		expErr  = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		id     uuid.UUID
+		userID uuid.UUID
+		setup  func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock)
+		err    error
+	}{
+		{
+			name:   "success",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Expect(ctx, id).Return(item, nil)
+				validator.ValidateContentMock.Expect(entity.TypeArticle, content).Return(entity.ContentUsage{}, nil)
+				timeGen.NowMock.Return(now)
+				repo.SaveAutosaveMock.Expect(ctx, id, userID, content, now).Return(nil)
+			},
+		},
+		{
+			name:   "error/nil_id",
+			userID: userID,
+			err:    apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/nil_user_id",
+			id:   id,
+			err:  apperr.ErrNilUUID(entity.FieldUserID),
+		},
+		{
+			name:   "error/content_too_long",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Return(item, nil)
+				validator.ValidateContentMock.Return(entity.ContentUsage{}, entity.ErrContentTooLong(1))
+			},
+			err: entity.ErrContentTooLong(1),
+		},
+		{
+			name:   "error/repo",
+			id:     id,
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Return(item, nil)
+				validator.ValidateContentMock.Return(entity.ContentUsage{}, nil)
+				timeGen.NowMock.Return(now)
+				repo.SaveAutosaveMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			validator := mocks.NewValidatorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, timeGen, validator)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, validator, Cfg())
+			require.NoError(t, err)
+
+			got, err := c.Autosave(ctx, tt.id, tt.userID, content)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entity.Autosave{Content: content, SavedAt: now}, got)
+		})
+	}
+}
+
+func TestCore_DiscardAutosave(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id, userID := uuid.New(), uuid.New()
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	require.ErrorIs(t, c.DiscardAutosave(ctx, uuid.Nil, userID), apperr.ErrNilUUID(entity.FieldEntityID))
+
+	repo.DeleteAutosaveMock.Expect(ctx, id, userID).Return(entity.ErrAutosaveNotFound())
+	require.ErrorIs(t, c.DiscardAutosave(ctx, id, userID), entity.ErrAutosaveNotFound())
+}
+
+func TestConfig_Validate_LockTTL(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 1}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1}.Validate())
+}
+
+func TestConfig_Validate_MaxPinnedChildren(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 1, MaxPinnedChildren: 5}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 1, MaxPinnedChildren: -1}.Validate())
+}
+
+func TestRetentionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.RetentionConfig{}.Validate())
+	require.NoError(t, entity.RetentionConfig{KeepDays: 30, IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.RetentionConfig{KeepLastVersions: -1}.Validate())
+	require.Error(t, entity.RetentionConfig{KeepLastVersions: 5}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, Retention: entity.RetentionConfig{KeepDays: -1}}.Validate())
+}
+
+func TestTrashConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.TrashConfig{}.Validate())
+	require.NoError(t, entity.TrashConfig{RetentionDays: 30, IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.TrashConfig{RetentionDays: -1}.Validate())
+	require.Error(t, entity.TrashConfig{RetentionDays: 30}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, Trash: entity.TrashConfig{RetentionDays: -1}}.Validate())
+}
+
+func TestStaleDraftsConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.StaleDraftsConfig{}.Validate())
+	require.NoError(t, entity.StaleDraftsConfig{NotifyDays: 30, IntervalMinutes: 60}.Validate())
+	require.NoError(t, entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 60, Action: entity.StaleDraftDelete, IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.StaleDraftsConfig{NotifyDays: -1}.Validate())
+	require.Error(t, entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 30, Action: entity.StaleDraftArchive, IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.StaleDraftsConfig{CleanupDays: 60, Action: "burn", IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.StaleDraftsConfig{CleanupDays: 60, Action: entity.StaleDraftArchive}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, StaleDrafts: entity.StaleDraftsConfig{CleanupDays: -1}}.Validate())
+}
+
+func TestEncryptionConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.EncryptionConfig{}.Validate())
+	require.NoError(t, entity.EncryptionConfig{Enabled: true, IntervalMinutes: 60, BatchSize: 100}.Validate())
+	require.Error(t, entity.EncryptionConfig{Enabled: true, IntervalMinutes: 60}.Validate())
+	require.Error(t, entity.EncryptionConfig{Enabled: true, BatchSize: 100}.Validate())
+	require.Error(t, entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, Encryption: entity.EncryptionConfig{Enabled: true}}.Validate())
+}
+
+func TestCore_PruneVersions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		now      = time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)
+		versions = []entity.VersionRef{{EntityID: uuid.New(), Version: 1, CreatedAt: now.AddDate(0, 0, -31)}}
+		expErr   = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		policy entity.RetentionConfig
+		dryRun bool
+		setup  func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock)
+		want   []entity.VersionRef
+		err    error
+	}{
+		{
+			name: "disabled, spaces may still prune",
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.PruneVersionsMock.Expect(ctx, 0, 0, now, false).Return([]entity.VersionRef{}, nil)
+			},
+			want: []entity.VersionRef{},
+		},
+		{
+			name:   "keep last only",
+			policy: entity.RetentionConfig{KeepLastVersions: 3, IntervalMinutes: 1},
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.PruneVersionsMock.Expect(ctx, 3, 0, now, false).Return(versions, nil)
+			},
+			want: versions,
+		},
+		{
+			name:   "keep last or newer than days, dry run",
+			policy: entity.RetentionConfig{KeepLastVersions: 3, KeepDays: 30, IntervalMinutes: 1},
+			dryRun: true,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.PruneVersionsMock.Expect(ctx, 3, 30, now, true).Return(versions, nil)
+			},
+			want: versions,
+		},
+		{
+			name:   "repo error",
+			policy: entity.RetentionConfig{KeepDays: 30, IntervalMinutes: 1},
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.PruneVersionsMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, timeGen)
+			}
+			cfg := entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, Retention: tt.policy}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.PruneVersions(ctx, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entity.RetentionReport{Policy: tt.policy, DryRun: tt.dryRun, Versions: tt.want}, got)
+		})
+	}
+}
+
+func TestCore_PurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		now    = time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)
+		userID = uuid.New()
+		purged = []entity.PurgedEntity{
+			{ID: uuid.New(), Name: "a", VersionCount: 2, Bytes: 100},
+			{ID: uuid.New(), Name: "b", VersionCount: 1, Bytes: 20},
+			{ID: uuid.New(), Name: "own draft", Bytes: 3, Draft: true, UpdatedBy: userID},
+			{ID: uuid.New(), Name: "other draft", Bytes: 4, Draft: true, UpdatedBy: uuid.New()},
+		}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		days   int
+		userID *uuid.UUID
+		dryRun bool
+		setup  func(repo *mocks.RepositoryMock)
+		want   entity.TrashReport
+		err    error
+	}{
+		{
+			name: "purge",
+			days: 30,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.PurgeDeletedMock.Expect(ctx, now.AddDate(0, 0, -30), false).Return(purged, nil)
+			},
+			want: entity.TrashReport{RetentionDays: 30, Cutoff: now.AddDate(0, 0, -30), Entities: purged, ReclaimableBytes: 127},
+		},
+		{
+			name:   "drafts of other users are counted",
+			days:   30,
+			userID: &userID,
+			dryRun: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.PurgeDeletedMock.Expect(ctx, now.AddDate(0, 0, -30), true).Return(purged, nil)
+			},
+			want: entity.TrashReport{RetentionDays: 30, Cutoff: now.AddDate(0, 0, -30), DryRun: true, Entities: purged[:3],
+				HiddenDrafts: 1, ReclaimableBytes: 127},
+		},
+		{
+			name:   "no retention empties the trash, dry run",
+			dryRun: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.PurgeDeletedMock.Expect(ctx, now, true).Return([]entity.PurgedEntity{}, nil)
+			},
+			want: entity.TrashReport{Cutoff: now, DryRun: true, Entities: []entity.PurgedEntity{}},
+		},
+		{
+			name: "repo error",
+			days: 30,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.PurgeDeletedMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Return(now)
+			tt.setup(repo)
+			cfg := entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15, Trash: entity.TrashConfig{RetentionDays: tt.days, IntervalMinutes: 60}}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.PurgeTrash(ctx, tt.userID, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_ReencryptContent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		expErr = fmt.Errorf("test error")
+		bad    = entity.SkippedContent{Table: "entity_versions", EntityID: uuid.New(), Version: 2, Error: "unknown key"}
+	)
+
+	type batch struct {
+		n       int
+		skipped []entity.SkippedContent
+	}
+	tests := []struct {
+		name     string
+		disabled bool
+		batches  []batch
+		repoErr  error
+		want     entity.ReencryptReport
+		err      error
+	}{
+		{name: "full batches until a short one", batches: []batch{{n: 2}, {n: 2}, {n: 1}}, want: entity.ReencryptReport{Encrypted: 5}},
+		{name: "nothing left", batches: []batch{{}}, want: entity.ReencryptReport{}},
+		{name: "disabled", disabled: true},
+		{
+			name:    "undecryptable rows are skipped and left out of the next batches",
+			batches: []batch{{n: 1, skipped: []entity.SkippedContent{bad}}, {n: 1}},
+			want:    entity.ReencryptReport{Encrypted: 2, Skipped: []entity.SkippedContent{bad}},
+		},
+		{name: "repo error", batches: []batch{{n: 2}, {n: 1}}, repoErr: expErr, want: entity.ReencryptReport{Encrypted: 3}, err: expErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			calls := 0
+			var skip []entity.SkippedContent
+			if !tt.disabled {
+				repo.ReencryptContentMock.Set(func(_ context.Context, limit int, gotSkip []entity.SkippedContent) (int, []entity.SkippedContent, error) {
+					require.Equal(t, 2, limit)
+					require.Equal(t, skip, gotSkip)
+					b := tt.batches[calls]
+					skip = append(skip, b.skipped...)
+					calls++
+					if calls == len(tt.batches) {
+						return b.n, b.skipped, tt.repoErr
+					}
+					return b.n, b.skipped, nil
+				})
+			}
+			cfg := entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15,
+				Encryption: entity.EncryptionConfig{Enabled: !tt.disabled, IntervalMinutes: 60, BatchSize: 2}}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.ReencryptContent(ctx)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, len(tt.batches), calls)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCore_GetListItem(t *testing.T) {
 	t.Parallel()
 
@@ -234,6 +1573,169 @@ func TestCore_GetListItem(t *testing.T) {
 	}
 }
 
+func TestCore_GetAuthoredVersions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		want   = []entity.AuthoredVersion{{EntityID: uuid.New(), Name: "name", Version: 2, CreatedAt: time.Now()}}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(repo *mocks.RepositoryMock)
+		want   []entity.AuthoredVersion
+		err    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetAuthoredVersionsMock.Expect(ctx, userID).Return(want, nil)
+			},
+			want: want,
+		},
+		{
+			name:   "error/nil_id",
+			userID: uuid.Nil,
+			setup:  func(repo *mocks.RepositoryMock) {},
+			err:    apperr.ErrNilUUID(entity.FieldUserID),
+		},
+		{
+			name:   "error/repo_error",
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetAuthoredVersionsMock.Expect(ctx, userID).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			tt.setup(repo)
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetAuthoredVersions(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_GetListItems(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		ids    = []uuid.UUID{uuid.New(), uuid.New()}
+		want   = []entity.ListItem{{ID: ids[0], Type: "type", Name: "name"}}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		ids   []uuid.UUID
+		setup func(repo *mocks.RepositoryMock)
+		want  []entity.ListItem
+		err   error
+	}{
+		{
+			name: "success, missing ids skipped",
+			ids:  ids,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetListItemsMock.Expect(ctx, ids).Return(want, nil)
+			},
+			want: want,
+		},
+		{
+			name: "error/nil_id",
+			ids:  []uuid.UUID{ids[0], uuid.Nil},
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/repo_error",
+			ids:  ids,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetListItemsMock.Expect(ctx, ids).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetListItems(ctx, tt.ids)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildTree_Counts(t *testing.T) {
+	t.Parallel()
+
+	root, child, grandchild, leaf := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tree := entity.BuildTree(t.Context(), []entity.ListItem{
+		{ID: grandchild, Name: "c", ParentID: &child},
+		{ID: root, Name: "a"},
+		{ID: leaf, Name: "d", ParentID: &root},
+		{ID: child, Name: "b", ParentID: &root},
+	})
+
+	require.Len(t, tree, 1)
+	require.Equal(t, 2, tree[0].ChildrenCount)
+	require.Equal(t, 3, tree[0].SubtreeSize)
+	require.Equal(t, child, tree[0].Children[0].ID)
+	require.Equal(t, 1, tree[0].Children[0].ChildrenCount)
+	require.Equal(t, 1, tree[0].Children[0].SubtreeSize)
+	require.Zero(t, tree[0].Children[1].ChildrenCount)
+	require.Zero(t, tree[0].Children[1].SubtreeSize)
+}
+
+func TestBuildTree_SortOrder(t *testing.T) {
+	t.Parallel()
+
+	root := uuid.New()
+	pinned, first, second, byNameA, byNameB := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tree := entity.BuildTree(t.Context(), []entity.ListItem{
+		{ID: root, Name: "root"},
+		{ID: byNameB, Name: "b", ParentID: &root},
+		{ID: pinned, Name: "zz", ParentID: &root, Pinned: true, SortOrder: 3},
+		{ID: second, Name: "a", ParentID: &root, SortOrder: 2},
+		{ID: byNameA, Name: "a", ParentID: &root},
+		{ID: first, Name: "z", ParentID: &root, SortOrder: 1},
+	})
+
+	require.Len(t, tree, 1)
+	got := make([]uuid.UUID, 0, len(tree[0].Children))
+	for _, child := range tree[0].Children {
+		got = append(got, child.ID)
+	}
+	require.Equal(t, []uuid.UUID{pinned, first, second, byNameA, byNameB}, got)
+}
+
 func TestCore_GetTree(t *testing.T) {
 	t.Parallel()
 
@@ -250,6 +1752,8 @@ func TestCore_GetTree(t *testing.T) {
 					Name:     "name1",
 					ParentID: nil,
 				},
+				ChildrenCount: 1,
+				SubtreeSize:   1,
 				Children: []*entity.Node{
 					{
 						ListItem: entity.ListItem{
@@ -273,7 +1777,7 @@ func TestCore_GetTree(t *testing.T) {
 		expErr = fmt.Errorf("test error")
 		userID = uuid.New()
 		hType  = entity.HierarchyTypeChildrenAndParents
-		cfg    = entity.Config{MaxHierarchyDepth: 1}
+		cfg    = entity.Config{MaxHierarchyDepth: 1, LockTTLMinutes: 15}
 	)
 
 	ctx = contextx.SetUserID(ctx, userID)
@@ -295,6 +1799,7 @@ func TestCore_GetTree(t *testing.T) {
 					want[0].Children[0].ListItem,
 					want[1].ListItem,
 				}, nil)
+				repo.GetDraftIDsMock.Expect(ctx, userID).Return(nil, nil)
 			},
 			want: want,
 		},
@@ -308,9 +1813,44 @@ func TestCore_GetTree(t *testing.T) {
 					want[0].Children[0].ListItem,
 					want[1].ListItem,
 				}, nil)
+				repo.GetDraftIDsMock.Expect(ctx, userID).Return(nil, nil)
 			},
 			want: want,
 		},
+		{
+			name:    "success/draft_counts",
+			perms:   permissions,
+			isAdmin: false,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock) {
+				repo.GetHierarchyMock.Return([]entity.ListItem{
+					want[0].ListItem,
+					want[0].Children[0].ListItem,
+					want[1].ListItem,
+				}, nil)
+				// a draft outside the tree is not counted
+				repo.GetDraftIDsMock.Expect(ctx, userID).Return([]uuid.UUID{want[0].Children[0].ID, want[1].ID, uuid.New()}, nil)
+			},
+			want: entity.Tree{
+				{
+					ListItem:      want[0].ListItem,
+					ChildrenCount: 1,
+					SubtreeSize:   1,
+					DraftCount:    1,
+					Children:      []*entity.Node{{ListItem: want[0].Children[0].ListItem}},
+				},
+				{ListItem: want[1].ListItem},
+			},
+		},
+		{
+			name:    "repo_error/draft_ids",
+			perms:   permissions,
+			isAdmin: false,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock) {
+				repo.GetHierarchyMock.Return([]entity.ListItem{want[1].ListItem}, nil)
+				repo.GetDraftIDsMock.Expect(ctx, userID).Return(nil, expErr)
+			},
+			err: expErr,
+		},
 		{
 			name:    "success/no_permissions",
 			perms:   nil,
@@ -370,7 +1910,7 @@ func TestCore_GetPermittedIDs(t *testing.T) {
 		items       = []entity.ListItem{{ID: want[0]}, {ID: want[1]}, {ID: want[2]}}
 		userID      = uuid.New()
 		hType       = entity.HierarchyTypeChildrenOnly
-		cfg         = entity.Config{MaxHierarchyDepth: 7}
+		cfg         = entity.Config{MaxHierarchyDepth: 7, LockTTLMinutes: 15}
 
 		expErr = fmt.Errorf("test error")
 	)
@@ -555,32 +2095,57 @@ func TestCore_GetVersionsList(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		id    uuid.UUID
-		setup func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock)
-		want  []entity.Entity
+		req   entity.GetVersionsReq
+		setup func(repo *mocks.RepositoryMock)
+		want  entity.VersionsPage
 		err   error
 	}{
 		{
-			name: "success",
-			id:   id,
-			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock) {
-				repo.GetVersionsListMock.Expect(ctx, id).Return(want, nil)
+			name: "success/last page",
+			req:  entity.GetVersionsReq{ID: id, Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionsListMock.Expect(ctx, id, 0, 3, false).Return(want, nil)
+			},
+			want: entity.VersionsPage{Versions: want},
+		},
+		{
+			name: "success/more pages",
+			req:  entity.GetVersionsReq{ID: id, Before: db.EncodeCursor("5"), Limit: 1, IncludeContent: true},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionsListMock.Expect(ctx, id, 5, 2, true).Return(want, nil)
 			},
-			want: want,
-			err:  nil,
+			want: entity.VersionsPage{Versions: want[:1], NextCursor: db.EncodeCursor("1")},
 		},
 		{
 			name: "error/nil_id",
-			id:   uuid.Nil,
-			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock) {
-			},
-			err: apperr.ErrNilUUID(entity.FieldEntityID),
+			req:  entity.GetVersionsReq{Limit: 2},
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/limit_zero",
+			req:  entity.GetVersionsReq{ID: id},
+			err:  entity.ErrInvalidVersionsLimit(entity.MaxVersionsLimit),
+		},
+		{
+			name: "error/limit_too_large",
+			req:  entity.GetVersionsReq{ID: id, Limit: entity.MaxVersionsLimit + 1},
+			err:  entity.ErrInvalidVersionsLimit(entity.MaxVersionsLimit),
+		},
+		{
+			name: "error/malformed_cursor",
+			req:  entity.GetVersionsReq{ID: id, Before: "!", Limit: 2},
+			err:  db.ErrInvalidCursor(),
+		},
+		{
+			name: "error/negative_cursor",
+			req:  entity.GetVersionsReq{ID: id, Before: db.EncodeCursor("-1"), Limit: 2},
+			err:  db.ErrInvalidCursor(),
 		},
 		{
 			name: "error/repo_error",
-			id:   id,
-			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock) {
-				repo.GetVersionsListMock.Expect(ctx, id).Return(nil, expErr)
+			req:  entity.GetVersionsReq{ID: id, Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionsListMock.Expect(ctx, id, 0, 3, false).Return(nil, expErr)
 			},
 			err: expErr,
 		},
@@ -589,16 +2154,13 @@ func TestCore_GetVersionsList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			repo := mocks.NewRepositoryMock(t)
-			idGen := mocks.NewIDGeneratorMock(t)
-			timeGen := mocks.NewTimeGeneratorMock(t)
-			validator := mocks.NewValidatorMock(t)
 			if tt.setup != nil {
-				tt.setup(repo, idGen, timeGen)
+				tt.setup(repo)
 			}
-			c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: timeGen}, validator, Cfg())
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
 			require.NoError(t, err)
 
-			got, err := c.GetVersionsList(ctx, tt.id)
+			got, err := c.GetVersionsList(ctx, tt.req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
@@ -618,10 +2180,11 @@ func TestCore_Create(t *testing.T) {
 		userID         = uuid.New()
 		now            = time.Now()
 		normalizedName = "n_name"
+		linkedID       = uuid.New()
 		req            = entity.CreateEntityReq{
 			Type:    entity.TypeDepartment,
 			Name:    normalizedName,
-			Content: "content",
+			Content: "content linking [[" + linkedID.String() + "]]",
 			IsDraft: false,
 			UserID:  userID,
 		}
@@ -649,9 +2212,11 @@ func TestCore_Create(t *testing.T) {
 			Name:     "parent",
 			ParentID: nil,
 		}
-		cfg    = entity.Config{MaxHierarchyDepth: 4}
-		list   = []entity.ListItem{parent, {}, {}}
-		expErr = fmt.Errorf("test error")
+		cfg        = entity.Config{MaxHierarchyDepth: 4, LockTTLMinutes: 15}
+		list       = []entity.ListItem{parent, {}, {}}
+		usage      = entity.ContentUsage{Length: len(req.Content), Limit: 100, Warning: true}
+		takenSlugs = []string{"n-name", "n-name-3"}
+		expErr     = fmt.Errorf("test error")
 	)
 
 	tests := []struct {
@@ -659,30 +2224,70 @@ func TestCore_Create(t *testing.T) {
 		req    entity.CreateEntityReq
 		parent entity.ListItem
 		setup  func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock)
+		broken []uuid.UUID
 		err    error
 	}{
 		{
 			name: "success/no_parent/not_draft/normalize",
 			req:  notNormalizedReq,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
 				validator.NormalizeNameMock.Expect(notNormalizedReq.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Expect(req.Type, req.Content).Return(usage, nil)
 				timeGen.NowMock.Expect().Return(now)
 				idGen.NewMock.Expect().Return(id, nil)
-				repo.CreateMock.Expect(ctx, req, id, now).Return(nil)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return([]entity.ListItem{{ID: linkedID}}, nil)
+				validator.ValidateLinksMock.Expect(req.Type, nil).Return(nil)
+				repo.CreateMock.Expect(ctx, createAsStored(req, id, "n-name-2"), id, now).Return(nil)
 			},
 		},
 		{
 			name: "success/with_parent/draft",
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return(list, nil)
 				timeGen.NowMock.Expect().Return(now)
 				idGen.NewMock.Expect().Return(id, nil)
-				repo.CreateDraftMock.Expect(ctx, requestWithParent, id).Return(nil)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return([]entity.ListItem{{ID: linkedID}}, nil)
+				validator.ValidateLinksMock.Expect(req.Type, nil).Return(nil)
+				repo.CreateDraftMock.Expect(ctx, createAsStored(requestWithParent, id, "n-name-2"), id).Return(nil)
+			},
+		},
+		{
+			name: "success/broken_link",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
+				validator.NormalizeNameMock.Return(normalizedName)
+				validator.ValidateNameMock.Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				timeGen.NowMock.Expect().Return(now)
+				idGen.NewMock.Expect().Return(id, nil)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return(nil, nil)
+				validator.ValidateLinksMock.Expect(req.Type, []uuid.UUID{linkedID}).Return(nil)
+				repo.CreateMock.Expect(ctx, createAsStored(req, id, "n-name-2"), id, now).Return(nil)
+			},
+			broken: []uuid.UUID{linkedID},
+		},
+		{
+			name: "error/broken_link_rejected",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
+				validator.NormalizeNameMock.Return(normalizedName)
+				validator.ValidateNameMock.Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				timeGen.NowMock.Expect().Return(now)
+				idGen.NewMock.Expect().Return(id, nil)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return(nil, nil)
+				validator.ValidateLinksMock.Return(entity.ErrBrokenLinks([]uuid.UUID{linkedID}))
 			},
+			err: entity.ErrBrokenLinks([]uuid.UUID{linkedID}),
 		},
 		{
 			name: "error/validation/nil_user_id",
@@ -711,16 +2316,27 @@ func TestCore_Create(t *testing.T) {
 			req:  req,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(expErr)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(expErr)
 			},
 			err: expErr,
 		},
+		{
+			name: "error/validation/content_too_long",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Expect(req.Type, req.Content).Return(entity.ContentUsage{}, entity.ErrContentTooLong(10))
+			},
+			err: entity.ErrContentTooLong(10),
+		},
 		{
 			name: "error/repo/get_parent",
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return(nil, expErr)
 			},
 			err: expErr,
@@ -730,7 +2346,8 @@ func TestCore_Create(t *testing.T) {
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return([]entity.ListItem{{}, {}, {}, {}}, nil)
 			},
 			err: entity.ErrMaxHierarchyDepthExceeded(cfg.MaxHierarchyDepth),
@@ -740,7 +2357,8 @@ func TestCore_Create(t *testing.T) {
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return([]entity.ListItem{}, nil)
 			},
 			err: entity.ErrParentNotFound(),
@@ -750,7 +2368,8 @@ func TestCore_Create(t *testing.T) {
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return([]entity.ListItem{
 					{
 						ID:       parentID,
@@ -773,7 +2392,8 @@ func TestCore_Create(t *testing.T) {
 			},
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 			},
 			err: entity.ErrParentRequired(),
 		},
@@ -781,22 +2401,39 @@ func TestCore_Create(t *testing.T) {
 			name: "error/id_gen",
 			req:  req,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				timeGen.NowMock.Expect().Return(now)
 				idGen.NewMock.Expect().Return(uuid.UUID{}, expErr)
 			},
 			err: expErr,
 		},
+		{
+			name: "error/repo/get_taken_slugs",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(nil, expErr)
+			},
+			err: expErr,
+		},
 		{
 			name: "error/repo/create",
 			req:  req,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				timeGen.NowMock.Expect().Return(now)
 				idGen.NewMock.Expect().Return(id, nil)
-				repo.CreateMock.Expect(ctx, req, id, now).Return(expErr)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return([]entity.ListItem{{ID: linkedID}}, nil)
+				validator.ValidateLinksMock.Expect(req.Type, nil).Return(nil)
+				repo.CreateMock.Expect(ctx, createAsStored(req, id, "n-name-2"), id, now).Return(expErr)
 			},
 			err: expErr,
 		},
@@ -804,12 +2441,16 @@ func TestCore_Create(t *testing.T) {
 			name: "error/repo/create_draft",
 			req:  requestWithParent,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", uuid.Nil).Return(takenSlugs, nil)
 				validator.NormalizeNameMock.Expect(requestWithParent.Name).Return(requestWithParent.Name)
-				validator.ValidateNameMock.Expect(requestWithParent.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(requestWithParent.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return(list, nil)
 				timeGen.NowMock.Expect().Return(now)
 				idGen.NewMock.Expect().Return(id, nil)
-				repo.CreateDraftMock.Expect(ctx, requestWithParent, id).Return(expErr)
+				repo.GetListItemsMock.Expect(ctx, []uuid.UUID{linkedID}).Return([]entity.ListItem{{ID: linkedID}}, nil)
+				validator.ValidateLinksMock.Expect(req.Type, nil).Return(nil)
+				repo.CreateDraftMock.Expect(ctx, createAsStored(requestWithParent, id, "n-name-2"), id).Return(expErr)
 			},
 			err: expErr,
 		},
@@ -827,17 +2468,25 @@ func TestCore_Create(t *testing.T) {
 			c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: timeGen}, validator, cfg)
 			require.NoError(t, err)
 
-			gotID, err := c.Create(ctx, tt.req)
+			gotID, gotUsage, err := c.Create(ctx, tt.req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
 			require.Equal(t, id, gotID)
+			want := usage
+			want.BrokenLinks = tt.broken
+			require.Equal(t, want, gotUsage)
 		})
 	}
 }
 
+func withSummary(req entity.UpdateEntityReq, summary string) entity.UpdateEntityReq {
+	req.Summary = summary
+	return req
+}
+
 func TestCore_Update(t *testing.T) {
 	t.Parallel()
 
@@ -889,7 +2538,10 @@ func TestCore_Update(t *testing.T) {
 			ParentID: nil,
 		}
 		parentList = []entity.ListItem{parentItem, {}, {}}
-		cfg        = entity.Config{MaxHierarchyDepth: 5}
+		cfg        = entity.Config{MaxHierarchyDepth: 5, LockTTLMinutes: 15}
+		otherLock  = entity.Lock{EntityID: id, UserID: uuid.New(), ExpiresAt: now.Add(time.Minute)}
+		usage      = entity.ContentUsage{Length: len(req.Content), Limit: 100}
+		current    = entity.ListItem{ID: id, Slug: "n-name-2"}
 		expErr     = fmt.Errorf("test error")
 	)
 
@@ -904,21 +2556,105 @@ func TestCore_Update(t *testing.T) {
 			name: "success/parent_not_changed/not_draft/normalize",
 			req:  notNormalizedReq,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
 				validator.NormalizeNameMock.Expect(notNormalizedReq.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+				timeGen.NowMock.Expect().Return(now)
+				repo.UpdateMock.Expect(ctx, updateAsStored(req, current.Slug), now).Return(nil)
+			},
+		},
+		{
+			name: "success/locked_by_self",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{EntityID: id, UserID: userID}, nil)
 				timeGen.NowMock.Expect().Return(now)
-				repo.UpdateMock.Expect(ctx, req, now).Return(nil)
+				repo.UpdateMock.Expect(ctx, updateAsStored(req, current.Slug), now).Return(nil)
 			},
 		},
+		{
+			name: "success/summary_trimmed",
+			req:  withSummary(req, "  Fix typo \n"),
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				validator.ValidateSummaryMock.Expect("Fix typo").Return(nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+				timeGen.NowMock.Expect().Return(now)
+				repo.UpdateMock.Expect(ctx, updateAsStored(withSummary(req, "Fix typo"), current.Slug), now).Return(nil)
+			},
+		},
+		{
+			name: "error/validation/summary_too_long",
+			req:  withSummary(req, "Rewrite"),
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				validator.ValidateSummaryMock.Expect("Rewrite").Return(entity.ErrSummaryTooLong(5))
+			},
+			err: entity.ErrSummaryTooLong(5),
+		},
+		{
+			name: "error/validation/content_too_long",
+			req:  reqParentChanged,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				validator.NormalizeNameMock.Expect(reqParentChanged.Name).Return(reqParentChanged.Name)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentChanged.Name).Return(nil)
+				validator.ValidateContentMock.Expect(reqParentChanged.EntityType, reqParentChanged.Content).
+					Return(entity.ContentUsage{}, entity.ErrContentTooLong(5))
+			},
+			err: entity.ErrContentTooLong(5),
+		},
+		{
+			name: "error/locked_by_other",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(otherLock, nil)
+			},
+			err: entity.ErrEntityLocked(otherLock),
+		},
+		{
+			name: "error/repo/get_lock",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, expErr)
+			},
+			err: expErr,
+		},
 		{
 			name: "success/parent_changed/draft",
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
 				validator.NormalizeNameMock.Expect(reqParentChanged.Name).Return(reqParentChanged.Name)
-				validator.ValidateNameMock.Expect(reqParentChanged.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentChanged.Name).Return(nil)
+				validator.ValidateContentMock.Expect(reqParentChanged.EntityType, reqParentChanged.Content).Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Then(parentList, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(nil, nil)
-				repo.UpdateDraftMock.Expect(ctx, reqParentChanged).Return(nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+				repo.UpdateDraftMock.Expect(ctx, updateAsStored(reqParentChanged, current.Slug)).Return(nil)
 			},
 		},
 		{
@@ -948,7 +2684,7 @@ func TestCore_Update(t *testing.T) {
 			req:  req,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(expErr)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(expErr)
 			},
 			err: expErr,
 		},
@@ -966,7 +2702,8 @@ func TestCore_Update(t *testing.T) {
 			},
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(reqParentRemoved.Name)
-				validator.ValidateNameMock.Expect(req.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(req.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 			},
 			err: entity.ErrParentCycle(),
 		},
@@ -975,7 +2712,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(reqParentChanged.Name).Return(reqParentChanged.Name)
-				validator.ValidateNameMock.Expect(reqParentChanged.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentChanged.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Then([]entity.ListItem{
 					{
 						ID:   req.ID,
@@ -991,7 +2729,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(reqParentChanged.Name).Return(reqParentChanged.Name)
-				validator.ValidateNameMock.Expect(reqParentChanged.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentChanged.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Then([]entity.ListItem{}, nil)
 			},
 			err: entity.ErrParentNotFound(),
@@ -1001,7 +2740,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(reqParentChanged.Name).Return(reqParentChanged.Name)
-				validator.ValidateNameMock.Expect(reqParentChanged.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentChanged.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Then(parentList, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then([]entity.ListItem{{Depth: 3}}, nil)
 			},
@@ -1012,7 +2752,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(reqParentRemoved.Name).Return(reqParentRemoved.Name)
-				validator.ValidateNameMock.Expect(reqParentRemoved.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentRemoved.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return(nil, expErr)
 			},
 			err: expErr,
@@ -1022,7 +2763,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(req.Name)
-				validator.ValidateNameMock.Expect(req.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(req.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Then(parentList, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(nil, expErr)
 			},
@@ -1033,7 +2775,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentChanged,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(req.Name)
-				validator.ValidateNameMock.Expect(req.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(req.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{parentID}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeParentsOnly).Return([]entity.ListItem{
 					{
 						ID:       parentID,
@@ -1050,7 +2793,8 @@ func TestCore_Update(t *testing.T) {
 			req:  reqParentRemoved,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
 				validator.NormalizeNameMock.Expect(req.Name).Return(req.Name)
-				validator.ValidateNameMock.Expect(req.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(req.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 			},
 			err: entity.ErrParentRequired(),
 		},
@@ -1063,8 +2807,10 @@ func TestCore_Update(t *testing.T) {
 				IsDraft: reqParentChanged.IsDraft,
 			},
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
 				validator.NormalizeNameMock.Expect(reqParentRemoved.Name).Return(reqParentRemoved.Name)
-				validator.ValidateNameMock.Expect(reqParentRemoved.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentRemoved.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, 2, nil, entity.HierarchyTypeChildrenOnly).Then([]entity.ListItem{{}, {}}, nil)
 			},
 			err: entity.ErrCannotDraftEntityWithChildren(),
@@ -1078,20 +2824,66 @@ func TestCore_Update(t *testing.T) {
 				IsDraft: reqParentChanged.IsDraft,
 			},
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
 				validator.NormalizeNameMock.Expect(reqParentRemoved.Name).Return(reqParentRemoved.Name)
-				validator.ValidateNameMock.Expect(reqParentRemoved.Name).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(reqParentRemoved.Name).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
 				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, 2, nil, entity.HierarchyTypeChildrenOnly).Then(nil, expErr)
 			},
 			err: expErr,
 		},
+		{
+			name: "success/renamed/new_slug",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(entity.ListItem{ID: id, Slug: "old-name"}, nil)
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", id).Return([]string{}, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+				timeGen.NowMock.Expect().Return(now)
+				repo.UpdateMock.Expect(ctx, updateAsStored(req, "n-name"), now).Return(nil)
+			},
+		},
+		{
+			name: "error/repo/get_list_item",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(entity.ListItem{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/repo/get_taken_slugs",
+			req:  req,
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(entity.ListItem{ID: id, Slug: "old-name"}, nil)
+				repo.GetTakenSlugsMock.Expect(ctx, "n-name", id).Return(nil, expErr)
+			},
+			err: expErr,
+		},
 		{
 			name: "error/repo/update",
 			req:  req,
 			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, timeGen *mocks.TimeGeneratorMock, validator *mocks.ValidatorMock) {
+				repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Expect(ctx, id).Return(current, nil)
 				validator.NormalizeNameMock.Expect(req.Name).Return(normalizedName)
-				validator.ValidateNameMock.Expect(normalizedName).Return(nil)
+				validator.ValidateNameMock.ExpectNameParam2(normalizedName).Return(nil)
+				validator.ValidateContentMock.Return(usage, nil)
+				repo.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
 				timeGen.NowMock.Expect().Return(now)
-				repo.UpdateMock.Expect(ctx, req, now).Return(expErr)
+				repo.UpdateMock.Expect(ctx, updateAsStored(req, current.Slug), now).Return(expErr)
 			},
 			err: expErr,
 		},
@@ -1109,7 +2901,91 @@ func TestCore_Update(t *testing.T) {
 			c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: timeGen}, validator, cfg)
 			require.NoError(t, err)
 
-			err = c.Update(ctx, tt.req)
+			gotUsage, err := c.Update(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, usage, gotUsage)
+		})
+	}
+}
+
+func TestCore_UniqueSiblingNames(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		id        = uuid.New()
+		userID    = uuid.New()
+		parentID  = uuid.New()
+		now       = time.Now()
+		cfg       = entity.Config{MaxHierarchyDepth: 4, LockTTLMinutes: 15, UniqueSiblingNames: true}
+		list      = []entity.ListItem{{ID: parentID, Type: entity.TypeDepartment}}
+		createReq = entity.CreateEntityReq{Type: entity.TypeArticle, Name: "Getting  Started", ParentID: &parentID, UserID: userID}
+		updateReq = entity.UpdateEntityReq{ID: id, Name: "Getting  Started", ParentID: &parentID, UserID: userID}
+		expErr    = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name   string
+		exists bool
+		repErr error
+		err    error
+	}{
+		{name: "unique"},
+		{name: "duplicate", exists: true, err: entity.ErrDuplicateSiblingName()},
+		{name: "repo_error", repErr: expErr, err: expErr},
+	}
+	for _, tt := range tests {
+		t.Run("create/"+tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			idGen := mocks.NewIDGeneratorMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			validator := mocks.NewValidatorMock(t)
+			validator.NormalizeNameMock.Return(createReq.Name)
+			validator.ValidateNameMock.Return(nil)
+			validator.ValidateContentMock.Return(entity.ContentUsage{}, nil)
+			repo.GetHierarchyMock.Return(list, nil)
+			repo.SiblingNameExistsMock.Expect(ctx, &parentID, "getting started", uuid.Nil, userID).Return(tt.exists, tt.repErr)
+			if tt.err == nil {
+				repo.GetTakenSlugsMock.Return(nil, nil)
+				timeGen.NowMock.Return(now)
+				idGen.NewMock.Return(id, nil)
+				repo.CreateMock.Return(nil)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: timeGen}, validator, cfg)
+			require.NoError(t, err)
+
+			_, _, err = c.Create(ctx, createReq)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+		t.Run("update/"+tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			validator := mocks.NewValidatorMock(t)
+			validator.NormalizeNameMock.Return(updateReq.Name)
+			validator.ValidateNameMock.Return(nil)
+			validator.ValidateContentMock.Return(entity.ContentUsage{}, nil)
+			repo.SiblingNameExistsMock.Expect(ctx, &parentID, "getting started", id, userID).Return(tt.exists, tt.repErr)
+			if tt.err == nil {
+				repo.GetSpaceSettingsMock.Expect(ctx, parentID).Return(entity.SpaceSettings{}, nil)
+				repo.GetListItemMock.Return(entity.ListItem{ID: id, Slug: "getting-started"}, nil)
+				repo.GetLockMock.Return(entity.Lock{}, entity.ErrLockNotFound())
+				timeGen.NowMock.Return(now)
+				repo.UpdateMock.Return(nil)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, validator, cfg)
+			require.NoError(t, err)
+
+			_, err = c.Update(ctx, updateReq)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
@@ -1119,55 +2995,102 @@ func TestCore_Update(t *testing.T) {
 	}
 }
 
+func TestNormalizeSiblingName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "getting started", entity.NormalizeSiblingName("  Getting \t Started "))
+	require.Equal(t, "", entity.NormalizeSiblingName("   "))
+}
+
 func TestCore_Delete(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
-		id  = uuid.New()
+		ctx    = context.Background()
+		id     = uuid.New()
+		userID = uuid.New()
 
-		ids    = []uuid.UUID{id, uuid.New(), uuid.New()}
-		list   = []entity.ListItem{{ID: id}, {ID: ids[1]}, {ID: ids[2]}}
-		cfg    = entity.Config{MaxHierarchyDepth: 5}
-		expErr = fmt.Errorf("test error")
+		ids  = []uuid.UUID{id, uuid.New(), uuid.New()}
+		list = []entity.ListItem{{ID: id, Name: "root"}, {ID: ids[1], ParentID: &id}, {ID: ids[2], ParentID: &id}}
+		// the last one is a draft of another user
+		visible = list[:2]
+		report  = entity.DeleteReport{Entities: []entity.DeletedEntity{{ID: id, Name: "root"}, {ID: ids[1], ParentID: &id}}, Hidden: 1, Count: 3}
+		cfg     = entity.Config{MaxHierarchyDepth: 5, LockTTLMinutes: 15}
+		expErr  = fmt.Errorf("test error")
 	)
 
 	tests := []struct {
-		name  string
-		setup func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock)
-		err   error
+		name   string
+		setup  func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock)
+		userID uuid.UUID
+		dryRun bool
+		want   entity.DeleteReport
+		err    error
 	}{
 		{
-			name: "success",
+			name:   "success",
+			userID: userID,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(list, nil)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, &userID, entity.HierarchyTypeChildrenOnly).Then(visible, nil)
+				repo.DeleteMock.Expect(ctx, ids, userID).Return(nil)
+			},
+			want: report,
+		},
+		{
+			name:   "dry run",
+			userID: userID,
+			dryRun: true,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(list, nil)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, &userID, entity.HierarchyTypeChildrenOnly).Then(visible, nil)
+			},
+			want: entity.DeleteReport{DryRun: true, Entities: report.Entities, Hidden: 1, Count: 3},
+		},
+		{
+			name:   "error/repo/GetHierarchy of the visible entities",
+			userID: userID,
+			dryRun: true,
 			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
-				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Return(list, nil)
-				repo.DeleteMock.Expect(ctx, ids).Return(nil)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(list, nil)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, &userID, entity.HierarchyTypeChildrenOnly).Then(nil, expErr)
 			},
+			err: expErr,
 		},
 		{
-			name: "error/repo/GetHierarchyMock",
+			name:   "error/repo/GetHierarchyMock",
+			userID: userID,
 			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Return(nil, expErr)
 			},
 			err: expErr,
 		},
 		{
-			name: "error/repo/Delete",
+			name:   "error/repo/Delete",
+			userID: userID,
 			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
-				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Return(list, nil)
-				repo.DeleteMock.Expect(ctx, ids).Return(expErr)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Then(list, nil)
+				repo.GetHierarchyMock.When(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, &userID, entity.HierarchyTypeChildrenOnly).Then(visible, nil)
+				repo.DeleteMock.Expect(ctx, ids, userID).Return(expErr)
 			},
 			err: expErr,
 		},
 		{
-			name: "error/not found",
+			name:   "error/not found",
+			userID: userID,
 			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Return([]entity.ListItem{}, nil)
 			},
 			err: entity.ErrEntityNotFound(),
 		},
 		{
-			name: "error/max hierarchy depth exceeded",
+			name:   "error/nil user id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(entity.FieldUserID),
+		},
+		{
+			name:   "error/max hierarchy depth exceeded",
+			userID: userID,
 			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
 				repo.GetHierarchyMock.Expect(ctx, []uuid.UUID{id}, cfg.MaxHierarchyDepth+1, nil, entity.HierarchyTypeChildrenOnly).Return([]entity.ListItem{{Depth: 6}}, nil)
 			},
@@ -1187,12 +3110,13 @@ func TestCore_Delete(t *testing.T) {
 			c, err := entity.NewCore(repo, entity.Generators{Time: timeGen, ID: idGen}, validator, cfg)
 			require.NoError(t, err)
 
-			err = c.Delete(ctx, id)
+			got, err := c.Delete(ctx, id, tt.userID, tt.dryRun)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -1200,56 +3124,265 @@ func TestCore_Delete(t *testing.T) {
 func TestNewValidator(t *testing.T) {
 	t.Parallel()
 
-	cfg := entity.ValidationConfig{
-		MaxNameLength: 50,
+	valid := entity.ValidationConfig{
+		MaxNameLength:          50,
+		MaxContentLength:       1000,
+		MaxSummaryLength:       20,
+		MaxContentLengthByType: map[entity.Type]int{entity.TypeDepartment: 100},
+		ContentWarningPercent:  80,
 	}
-	_, err := entity.NewValidator(cfg)
+	_, err := entity.NewValidator(valid)
 	require.NoError(t, err)
 
-	cfg.MaxNameLength = 0
-	_, err = entity.NewValidator(cfg)
-	require.Error(t, err)
+	tests := []struct {
+		name   string
+		modify func(cfg *entity.ValidationConfig)
+	}{
+		{name: "max_name_length", modify: func(cfg *entity.ValidationConfig) { cfg.MaxNameLength = 0 }},
+		{name: "max_content_length", modify: func(cfg *entity.ValidationConfig) { cfg.MaxContentLength = 0 }},
+		{name: "max_summary_length", modify: func(cfg *entity.ValidationConfig) { cfg.MaxSummaryLength = 0 }},
+		{name: "by_type/unknown_type", modify: func(cfg *entity.ValidationConfig) {
+			cfg.MaxContentLengthByType = map[entity.Type]int{"page": 100}
+		}},
+		{name: "by_type/not_positive", modify: func(cfg *entity.ValidationConfig) {
+			cfg.MaxContentLengthByType = map[entity.Type]int{entity.TypeArticle: 0}
+		}},
+		{name: "warning_percent/negative", modify: func(cfg *entity.ValidationConfig) { cfg.ContentWarningPercent = -1 }},
+		{name: "warning_percent/above_100", modify: func(cfg *entity.ValidationConfig) { cfg.ContentWarningPercent = 101 }},
+		{name: "name_rules/space", modify: func(cfg *entity.ValidationConfig) { cfg.NameRules.ForbiddenChars = "/ " }},
+		{name: "forbidden_by_type/unknown_type", modify: func(cfg *entity.ValidationConfig) {
+			cfg.ForbiddenNameCharsByType = map[entity.Type]string{"page": "/"}
+		}},
+		{name: "reject_broken_links/unknown_type", modify: func(cfg *entity.ValidationConfig) {
+			cfg.RejectBrokenLinks = []entity.Type{"page"}
+		}},
+		{name: "forbidden_by_type/invalid", modify: func(cfg *entity.ValidationConfig) {
+			cfg.ForbiddenNameCharsByType = map[entity.Type]string{entity.TypeArticle: "\t"}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := valid
+			tt.modify(&cfg)
+			_, err := entity.NewValidator(cfg)
+			require.Error(t, err)
+		})
+	}
 }
 
 func TestValidator_NormalizeName(t *testing.T) {
 	t.Parallel()
-	validator, err := entity.NewValidator(entity.ValidationConfig{MaxNameLength: 50})
+	validator, err := entity.NewValidator(entity.ValidationConfig{MaxNameLength: 50, MaxContentLength: 100, MaxSummaryLength: 20})
 	require.NoError(t, err)
 
 	require.Equal(t, "name", validator.NormalizeName(" name "))
+	require.Equal(t, "a \t b", validator.NormalizeName(" a \t b "))
+
+	require.NoError(t, validator.Reload(entity.ValidationConfig{
+		MaxNameLength:    50,
+		MaxContentLength: 100,
+		MaxSummaryLength: 20,
+		NameRules:        text.NameRules{CollapseSpaces: true, StripControl: true, NFC: true},
+	}))
+	require.Equal(t, "Caf\u00e9 menu", validator.NormalizeName(" Cafe\u0301\x00 \t menu "))
 }
 
 func TestValidator_ValidateName(t *testing.T) {
 	t.Parallel()
-	validator, err := entity.NewValidator(entity.ValidationConfig{MaxNameLength: 10})
+	validator, err := entity.NewValidator(entity.ValidationConfig{
+		MaxNameLength:            10,
+		MaxContentLength:         100,
+		MaxSummaryLength:         20,
+		NameRules:                text.NameRules{ForbiddenChars: "<>"},
+		ForbiddenNameCharsByType: map[entity.Type]string{entity.TypeDepartment: "/"},
+	})
 	require.NoError(t, err)
 
 	tests := []struct {
-		name string
-		err  error
+		name       string
+		entityType entity.Type
+		err        error
 	}{
 		{
-			name: "valid",
-			err:  nil,
+			name:       "valid",
+			entityType: entity.TypeArticle,
+			err:        nil,
+		},
+		{
+			name:       "",
+			entityType: entity.TypeArticle,
+			err:        entity.ErrNameRequired(),
+		},
+		{
+			name:       "Статья \U0001f1fa\U0001f1e6",
+			entityType: entity.TypeArticle,
+			err:        nil,
+		},
+		{
+			name:       "a_very_long_name_exceeding_the_maximum_length_set_in_validation_config",
+			entityType: entity.TypeArticle,
+			err:        entity.ErrNameTooLong(10),
+		},
+		{
+			name:       "a<b>",
+			entityType: entity.TypeArticle,
+			err:        entity.ErrNameForbiddenChar('<'),
+		},
+		{
+			name:       "a/b",
+			entityType: entity.TypeArticle,
+			err:        nil,
+		},
+		{
+			name:       "a/b",
+			entityType: entity.TypeDepartment,
+			err:        entity.ErrNameForbiddenChar('/'),
+		},
+		{
+			name:       "a<b>",
+			entityType: entity.TypeDepartment,
+			err:        nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.entityType)+"/"+tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validator.ValidateName(tt.entityType, tt.name)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidator_Reload(t *testing.T) {
+	t.Parallel()
+	validator, err := entity.NewValidator(entity.ValidationConfig{MaxNameLength: 3, MaxContentLength: 100, MaxSummaryLength: 20})
+	require.NoError(t, err)
+	require.ErrorIs(t, validator.ValidateName(entity.TypeArticle, "name"), entity.ErrNameTooLong(3))
+
+	require.NoError(t, validator.Reload(entity.ValidationConfig{MaxNameLength: 10, MaxContentLength: 100, MaxSummaryLength: 20}))
+	require.NoError(t, validator.ValidateName(entity.TypeArticle, "name"))
+
+	require.Error(t, validator.Reload(entity.ValidationConfig{MaxNameLength: 0, MaxContentLength: 100, MaxSummaryLength: 20}))
+	require.NoError(t, validator.ValidateName(entity.TypeArticle, "name"), "invalid config must not be applied")
+}
+
+func TestValidator_ValidateLinks(t *testing.T) {
+	t.Parallel()
+	validator, err := entity.NewValidator(entity.ValidationConfig{
+		MaxNameLength:     10,
+		MaxContentLength:  100,
+		MaxSummaryLength:  20,
+		RejectBrokenLinks: []entity.Type{entity.TypeArticle},
+	})
+	require.NoError(t, err)
+	broken := []uuid.UUID{uuid.New()}
+
+	require.NoError(t, validator.ValidateLinks(entity.TypeArticle, nil))
+	require.NoError(t, validator.ValidateLinks(entity.TypeDepartment, broken))
+	require.ErrorIs(t, validator.ValidateLinks(entity.TypeArticle, broken), entity.ErrBrokenLinks(broken))
+}
+
+func TestValidator_ValidateSummary(t *testing.T) {
+	t.Parallel()
+	validator, err := entity.NewValidator(entity.ValidationConfig{MaxNameLength: 10, MaxContentLength: 100, MaxSummaryLength: 5})
+	require.NoError(t, err)
+
+	require.NoError(t, validator.ValidateSummary("typo"))
+	// counted in characters, not bytes
+	require.NoError(t, validator.ValidateSummary("опечт"))
+	require.ErrorIs(t, validator.ValidateSummary("typos!"), entity.ErrSummaryTooLong(5))
+}
+
+func TestValidator_ValidateContent(t *testing.T) {
+	t.Parallel()
+	validator, err := entity.NewValidator(entity.ValidationConfig{
+		MaxNameLength:          10,
+		MaxContentLength:       100,
+		MaxSummaryLength:       20,
+		MaxContentLengthByType: map[entity.Type]int{entity.TypeDepartment: 20},
+		ContentWarningPercent:  80,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		entityType entity.Type
+		content    string
+		want       entity.ContentUsage
+		err        error
+	}{
+		{
+			name:       "empty",
+			entityType: entity.TypeArticle,
+			want:       entity.ContentUsage{Length: 0, Limit: 100},
+		},
+		{
+			name:       "at_warning_threshold",
+			entityType: entity.TypeArticle,
+			content:    strings.Repeat("a", 80),
+			want:       entity.ContentUsage{Length: 80, Limit: 100},
+		},
+		{
+			name:       "above_warning_threshold",
+			entityType: entity.TypeArticle,
+			content:    strings.Repeat("a", 81),
+			want:       entity.ContentUsage{Length: 81, Limit: 100, Warning: true},
+		},
+		{
+			name:       "at_limit",
+			entityType: entity.TypeArticle,
+			content:    strings.Repeat("a", 100),
+			want:       entity.ContentUsage{Length: 100, Limit: 100, Warning: true},
+		},
+		{
+			name:       "too_long",
+			entityType: entity.TypeArticle,
+			content:    strings.Repeat("a", 101),
+			err:        entity.ErrContentTooLong(100),
 		},
 		{
-			name: "",
-			err:  entity.ErrNameRequired(),
+			name:       "bytes_not_runes",
+			entityType: entity.TypeDepartment,
+			content:    strings.Repeat("я", 11),
+			err:        entity.ErrContentTooLong(20),
 		},
 		{
-			name: "a_very_long_name_exceeding_the_maximum_length_set_in_validation_config",
-			err:  entity.ErrNameTooLong(10),
+			name:       "type_override",
+			entityType: entity.TypeDepartment,
+			content:    strings.Repeat("a", 10),
+			want:       entity.ContentUsage{Length: 10, Limit: 20},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			err := validator.ValidateName(tt.name)
+			got, err := validator.ValidateContent(tt.entityType, tt.content)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
+
+// createAsStored returns the request as the core passes it to the repository.
+func createAsStored(req entity.CreateEntityReq, id uuid.UUID, slug string) entity.CreateEntityReq {
+	req.Stats = entity.ComputeContentStats(req.Content)
+	req.Links = entity.ExtractLinks(req.Content, id)
+	req.Slug = slug
+	return req
+}
+
+func updateAsStored(req entity.UpdateEntityReq, slug string) entity.UpdateEntityReq {
+	req.Stats = entity.ComputeContentStats(req.Content)
+	req.Links = entity.ExtractLinks(req.Content, req.ID)
+	req.Slug = slug
+	return req
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+// MaxDefaultPermissions caps the default permissions of an entity.
+const MaxDefaultPermissions = 100
+
+// DefaultPermission gives a user a role on every entity created below the entity holding it, at any
+// depth. It is not applied where the user already has the role through a grant on an ancestor.
+type DefaultPermission struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Role is read or write; the admin role is global.
+	Role auth.Role `json:"role"`
+}
+
+// SetDefaultPermissionsReq replaces the default permissions of an entity; an empty list removes them.
+type SetDefaultPermissionsReq struct {
+	Permissions []DefaultPermission `json:"permissions"`
+}
+
+func (c *core) GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]DefaultPermission, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetDefaultPermissions: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	permissions, err := c.repo.GetDefaultPermissions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetDefaultPermissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+func (c *core) SetDefaultPermissions(ctx context.Context, id uuid.UUID, req SetDefaultPermissionsReq) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.SetDefaultPermissions: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if len(req.Permissions) > MaxDefaultPermissions {
+		return fmt.Errorf("entity.core.SetDefaultPermissions: %w", ErrTooManyDefaultPermissions(MaxDefaultPermissions))
+	}
+	users := make(map[uuid.UUID]struct{}, len(req.Permissions))
+	for _, p := range req.Permissions {
+		if p.UserID == uuid.Nil {
+			return fmt.Errorf("entity.core.SetDefaultPermissions: %w", apperr.ErrNilUUID(FieldUserID))
+		}
+		if p.Role != auth.RoleRead && p.Role != auth.RoleWrite {
+			return fmt.Errorf("entity.core.SetDefaultPermissions: %w", ErrInvalidDefaultRole())
+		}
+		if _, ok := users[p.UserID]; ok {
+			return fmt.Errorf("entity.core.SetDefaultPermissions: %w", ErrDuplicateDefaultPermission())
+		}
+		users[p.UserID] = struct{}{}
+	}
+	if err := c.repo.SetDefaultPermissions(ctx, id, req.Permissions, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("entity.core.SetDefaultPermissions: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingDefaultPermissions returns the default permissions of the ancestors of id that its creator
+// should grant on it: the strongest role per user, without the users holding it through an ancestor.
+func (c *core) GetPendingDefaultPermissions(ctx context.Context, id uuid.UUID) ([]DefaultPermission, error) {
+	permissions, err := c.repo.GetPendingDefaultPermissions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetPendingDefaultPermissions: %w", err)
+	}
+
+	return permissions, nil
+}
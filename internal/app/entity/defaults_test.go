@@ -0,0 +1,126 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_GetDefaultPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = t.Context()
+		id          = uuid.New()
+		permissions = []entity.DefaultPermission{{UserID: uuid.New(), Role: auth.RoleRead}}
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetDefaultPermissionsMock.Expect(ctx, id).Return(permissions, nil)
+		got, err := c.GetDefaultPermissions(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, permissions, got)
+	})
+	t.Run("nil id", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		_, err = c.GetDefaultPermissions(ctx, uuid.Nil)
+		require.ErrorIs(t, err, apperr.ErrNilUUID(entity.FieldEntityID))
+	})
+}
+
+func TestCore_SetDefaultPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		a, b   = uuid.New(), uuid.New()
+		now    = time.Now()
+		expErr = fmt.Errorf("test error")
+		valid  = []entity.DefaultPermission{{UserID: a, Role: auth.RoleRead}, {UserID: b, Role: auth.RoleWrite}}
+	)
+
+	tests := []struct {
+		name        string
+		id          uuid.UUID
+		permissions []entity.DefaultPermission
+		setup       func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock)
+		err         error
+	}{
+		{
+			name: "ok", id: id, permissions: valid,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.SetDefaultPermissionsMock.Expect(ctx, id, valid, now).Return(nil)
+			},
+		},
+		{
+			name: "empty clears", id: id,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.SetDefaultPermissionsMock.Expect(ctx, id, nil, now).Return(nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, permissions: valid, err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{
+			name: "too many", id: id, permissions: make([]entity.DefaultPermission, entity.MaxDefaultPermissions+1),
+			err: entity.ErrTooManyDefaultPermissions(entity.MaxDefaultPermissions),
+		},
+		{
+			name: "nil user", id: id, permissions: []entity.DefaultPermission{{Role: auth.RoleRead}},
+			err: apperr.ErrNilUUID(entity.FieldUserID),
+		},
+		{
+			name: "admin role", id: id, permissions: []entity.DefaultPermission{{UserID: a, Role: auth.RoleAdmin}},
+			err: entity.ErrInvalidDefaultRole(),
+		},
+		{
+			name: "duplicate user", id: id,
+			permissions: []entity.DefaultPermission{{UserID: a, Role: auth.RoleRead}, {UserID: a, Role: auth.RoleWrite}},
+			err:         entity.ErrDuplicateDefaultPermission(),
+		},
+		{
+			name: "repo error", id: id, permissions: valid,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.SetDefaultPermissionsMock.Expect(ctx, id, valid, now).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, timeGen)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.SetDefaultPermissions(ctx, tt.id, entity.SetDefaultPermissionsReq{Permissions: tt.permissions})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
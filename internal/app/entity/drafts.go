@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+// GetDrafts returns a page of the current user's drafts across the tree, most recently edited first,
+// with their breadcrumbs. It is the flat list filtered to them, so unless isAdmin only permittedIDs are listed.
+func (c *core) GetDrafts(ctx context.Context, req DraftsReq, permittedIDs []uuid.UUID, isAdmin bool) (ListPage, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("entity.core.GetDrafts: %w", err)
+	}
+
+	page, err := c.List(ctx, ListReq{
+		AuthorID: &userID, Status: ListStatusDraft, Sort: ListSortUpdatedAt, Desc: true,
+		After: req.After, Limit: req.Limit,
+	}, permittedIDs, isAdmin)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("entity.core.GetDrafts: %w", err)
+	}
+
+	return page, nil
+}
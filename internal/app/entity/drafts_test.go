@@ -0,0 +1,77 @@
+package entity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_GetDrafts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.New()
+		ctx       = contextx.SetUserID(t.Context(), userID)
+		permitted = []uuid.UUID{uuid.New(), uuid.New()}
+		now       = time.Now().UTC()
+		entries   = []entity.ListEntry{
+			{ListItem: entity.ListItem{ID: permitted[0]}, IsDraft: true, UpdatedAt: now},
+			{ListItem: entity.ListItem{ID: permitted[1]}, IsDraft: true, UpdatedAt: now.Add(-time.Hour)},
+		}
+	)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		req   entity.DraftsReq
+		setup func(repo *mocks.RepositoryMock)
+		want  entity.ListPage
+		err   error
+	}{
+		{
+			name: "ok, most recently edited first",
+			ctx:  ctx,
+			req:  entity.DraftsReq{Limit: 1},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ListMock.Expect(ctx, entity.ListFilter{
+					AuthorID: &userID, Status: entity.ListStatusDraft, Sort: entity.ListSortUpdatedAt, Desc: true,
+					IDs: permitted, UserID: &userID,
+				}, 2).Return(entries, nil)
+			},
+			want: entity.ListPage{
+				Items:      entries[:1],
+				NextCursor: entity.ListCursor{Time: now, ID: permitted[0]}.String(entity.ListSortUpdatedAt),
+			},
+		},
+		{name: "invalid limit", ctx: ctx, req: entity.DraftsReq{Limit: entity.MaxListLimit + 1}, err: entity.ErrInvalidListLimit(entity.MaxListLimit)},
+		{name: "invalid cursor", ctx: ctx, req: entity.DraftsReq{After: "x", Limit: 1}, err: db.ErrInvalidCursor()},
+		{name: "no user", ctx: t.Context(), req: entity.DraftsReq{Limit: 1}, err: apperr.ErrUnauthorized()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetDrafts(tt.ctx, tt.req, permitted, false)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
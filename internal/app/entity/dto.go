@@ -1,9 +1,11 @@
 package entity
 
 import (
+	"cmp"
 	"context"
 	"fmt"
-	"sort"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
@@ -14,6 +16,10 @@ import (
 const (
 	FieldVersion apperr.Field = "version"
 	FieldNode    apperr.Field = "node"
+	FieldLimit   apperr.Field = "limit"
+	FieldPeriod  apperr.Field = "period"
+	FieldSort    apperr.Field = "sort"
+	FieldStatus  apperr.Field = "status"
 )
 
 type Type string
@@ -41,24 +47,489 @@ func (t Type) ValidateParentTypeCompatibility(parentType Type) error {
 }
 
 type Entity struct {
-	ID             uuid.UUID  `json:"id"`
-	Type           Type       `json:"type"`
-	Name           string     `json:"name"`
-	Content        string     `json:"content"`
-	ParentID       *uuid.UUID `json:"parent_id,omitempty"`
-	CreatedBy      uuid.UUID  `json:"created_by"`
-	UpdatedBy      uuid.UUID  `json:"updated_by"`
-	CurrentVersion *int       `json:"current_version,omitempty"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      time.Time  `json:"updated_at"`
+	ID        uuid.UUID  `json:"id"`
+	Type      Type       `json:"type"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug,omitempty"`
+	Content   string     `json:"content"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	CreatedBy uuid.UUID  `json:"created_by"`
+	UpdatedBy uuid.UUID  `json:"updated_by"`
+	// OwnerID is the user accountable for the entity, the creator until ownership is transferred.
+	// It is not versioned, so versions leave it empty.
+	OwnerID uuid.UUID `json:"owner_id"`
+	// Icon and Cover are not versioned either, see UpdateAppearanceReq. IconURL and CoverURL are where
+	// they are downloaded from, see AppearanceURL.
+	Icon           string    `json:"icon,omitempty"`
+	IconURL        string    `json:"icon_url,omitempty"`
+	Cover          string    `json:"cover,omitempty"`
+	CoverURL       string    `json:"cover_url,omitempty"`
+	CurrentVersion *int      `json:"current_version,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	// Moved is set on versions that changed the parent; MovedFrom is the parent before, nil for the root.
+	Moved     bool       `json:"moved,omitempty"`
+	MovedFrom *uuid.UUID `json:"moved_from,omitempty"`
+	// Summary is the change summary the version was saved with, set on versions.
+	Summary string `json:"summary,omitempty"`
+	// MinorEdit is set on versions saved as minor edits.
+	MinorEdit bool `json:"minor_edit,omitempty"`
+	// Autosave is the current user's autosave of the entity, for reads of the entity itself.
+	Autosave *Autosave `json:"autosave,omitempty"`
+	// Related are the related pages the current user can read, for reads of the entity itself.
+	Related []ListItem `json:"related,omitempty"`
+	// Language is the language of the entity and Variants the variants of its document the current user
+	// can read, itself included, for reads of the entity itself.
+	Language string    `json:"language,omitempty"`
+	Variants []Variant `json:"variants,omitempty"`
+	// WorkspaceID is not exposed: requests only ever see the entities of their own workspace.
+	WorkspaceID uuid.UUID `json:"-"`
 }
 
-type ListItem struct {
+// VersionRef identifies a stored version.
+type VersionRef struct {
+	EntityID  uuid.UUID `json:"entity_id"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuthoredVersion is a version a user created, named as it was then.
+type AuthoredVersion struct {
+	EntityID  uuid.UUID `json:"entity_id"`
+	Name      string    `json:"name"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PurgedEntity is a soft-deleted entity removed by a trash purge. Bytes is the size of its content
+// and the content of its versions. Draft and UpdatedBy decide who may see it, as for the tree.
+type PurgedEntity struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	DeletedAt    time.Time `json:"deleted_at"`
+	VersionCount int       `json:"version_count"`
+	Bytes        int64     `json:"bytes"`
+	Draft        bool      `json:"-"`
+	UpdatedBy    uuid.UUID `json:"-"`
+}
+
+// DeletedEntity is an entity removed by a delete, the entity itself or one below it.
+type DeletedEntity struct {
 	ID       uuid.UUID  `json:"id"`
 	Type     Type       `json:"type"`
 	Name     string     `json:"name"`
 	ParentID *uuid.UUID `json:"parent_id,omitempty"`
-	Depth    int        `json:"-"`
+}
+
+// DeleteReport lists what a delete removes. With DryRun nothing was deleted. Entities are those the
+// user can see; Hidden counts the others, drafts of other users and the entities below them, which are
+// deleted all the same. Count includes them.
+type DeleteReport struct {
+	DryRun   bool            `json:"dry_run"`
+	Entities []DeletedEntity `json:"entities"`
+	Hidden   int             `json:"hidden"`
+	Count    int             `json:"count"`
+}
+
+// TrashReport lists what a trash purge removes. Drafts of other users than the one asking are only
+// counted in HiddenDrafts; ReclaimableBytes includes them.
+type TrashReport struct {
+	RetentionDays    int            `json:"retention_days"`
+	Cutoff           time.Time      `json:"cutoff"`
+	DryRun           bool           `json:"dry_run"`
+	Entities         []PurgedEntity `json:"entities"`
+	HiddenDrafts     int            `json:"hidden_drafts"`
+	ReclaimableBytes int64          `json:"reclaimable_bytes"`
+}
+
+// StaleDraft is a draft left untouched by the stale draft policy's deadlines. UpdatedAt is when it was
+// last touched: saved or autosaved.
+type StaleDraft struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SkippedContent is a row of content ReencryptContent could not decrypt and left as it is, e.g. one
+// encrypted with a key that is no longer set. Version is set for entities and versions, UserID for
+// autosaves.
+type SkippedContent struct {
+	Table    string     `json:"table"`
+	EntityID uuid.UUID  `json:"entity_id"`
+	Version  int        `json:"version,omitempty"`
+	UserID   *uuid.UUID `json:"user_id,omitempty"`
+	Error    string     `json:"error"`
+}
+
+// ReencryptReport tells how many rows ReencryptContent encrypted and which it skipped.
+type ReencryptReport struct {
+	Encrypted int
+	Skipped   []SkippedContent
+}
+
+// DraftReminder is the reminder of one user about their stale drafts. Email is empty when the user
+// turned email notifications off or was deleted; the reminder is still recorded.
+type DraftReminder struct {
+	UserID uuid.UUID
+	Email  string
+	Drafts []StaleDraft
+}
+
+type StaleDraftsReport struct {
+	Policy StaleDraftsConfig `json:"policy"`
+	DryRun bool              `json:"dry_run"`
+	// Reminded are the drafts whose creators were reminded; an email that could not be sent is retried
+	// on the next run, so its drafts are not listed.
+	Reminded  []StaleDraft `json:"reminded"`
+	CleanedUp []StaleDraft `json:"cleaned_up"`
+}
+
+// Autosave is content an editor has not saved yet. It is kept apart from the versions, one per user
+// and entity, and removed when that user saves the entity.
+type Autosave struct {
+	Content string    `json:"content"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// Lock is a soft edit lock: while it is active only its holder may update the entity.
+type Lock struct {
+	EntityID   uuid.UUID `json:"entity_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type RetentionReport struct {
+	Policy   RetentionConfig `json:"policy"`
+	DryRun   bool            `json:"dry_run"`
+	Versions []VersionRef    `json:"versions"`
+}
+
+type ListItem struct {
+	ID                 uuid.UUID  `json:"id"`
+	Type               Type       `json:"type"`
+	Name               string     `json:"name"`
+	Slug               string     `json:"slug"`
+	ParentID           *uuid.UUID `json:"parent_id,omitempty"`
+	OwnerID            uuid.UUID  `json:"owner_id"`
+	WordCount          int        `json:"word_count"`
+	ReadingTimeMinutes int        `json:"reading_time_minutes"`
+	// Icon and Cover are set with UpdateAppearance, see UpdateAppearanceReq. IconURL and CoverURL are where
+	// they are downloaded from, see AppearanceURL.
+	Icon     string `json:"icon,omitempty"`
+	IconURL  string `json:"icon_url,omitempty"`
+	Cover    string `json:"cover,omitempty"`
+	CoverURL string `json:"cover_url,omitempty"`
+	// Pinned and SortOrder place the entity among its siblings, see compareSiblings; a SortOrder of 0
+	// means unordered.
+	Pinned    bool `json:"pinned,omitempty"`
+	SortOrder int  `json:"sort_order,omitempty"`
+	Depth     int  `json:"-"`
+}
+
+// readingWordsPerMinute is the reading speed used for the estimate.
+const readingWordsPerMinute = 200
+
+// ContentStats is computed from the content on every write and cached on the entity row.
+type ContentStats struct {
+	WordCount          int `json:"word_count"`
+	ReadingTimeMinutes int `json:"reading_time_minutes"`
+}
+
+func ComputeContentStats(content string) ContentStats {
+	words := len(strings.Fields(content))
+	return ContentStats{
+		WordCount:          words,
+		ReadingTimeMinutes: (words + readingWordsPerMinute - 1) / readingWordsPerMinute,
+	}
+}
+
+// NormalizeSiblingName is the form names are compared in when sibling names must be unique:
+// lower case with runs of whitespace collapsed to one space. The repository applies the same rule in SQL.
+func NormalizeSiblingName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// ContentUsage reports the content size of a write against the limit for its entity type.
+// Warning is set once the size passes the configured share of the limit. BrokenLinks lists the
+// linked entities that do not exist or were deleted, for the types allowed to keep such links.
+type ContentUsage struct {
+	Length      int
+	Limit       int
+	Warning     bool
+	BrokenLinks []uuid.UUID
+	// SpaceMove is set by an update that moved the entity into another space.
+	SpaceMove *SpaceMove
+}
+
+type Editor struct {
+	UserID   uuid.UUID `json:"user_id"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// Contributor is a user who authored at least one version of an entity.
+type Contributor struct {
+	UserID            uuid.UUID `json:"user_id"`
+	VersionCount      int       `json:"version_count"`
+	LastContributedAt time.Time `json:"last_contributed_at"`
+}
+
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventEdited  EventType = "edited"
+	EventMoved   EventType = "moved"
+	EventDeleted EventType = "deleted"
+	// EventRoleGranted and EventRoleRevoked are logged by auth when a role on the entity changes.
+	// They are left out of the activity feed.
+	EventRoleGranted EventType = "role_granted"
+	EventRoleRevoked EventType = "role_revoked"
+)
+
+// Event is a change of an entity. ActorID is nil for events recovered from data older than the event log
+// and for changes made outside of requests. Version is the version the change produced; FromParentID and
+// ToParentID are set for moves, SubjectID and Role for role changes.
+type Event struct {
+	ID           int64      `json:"id"`
+	EntityID     uuid.UUID  `json:"entity_id"`
+	EntityName   string     `json:"entity_name"`
+	Type         EventType  `json:"type"`
+	ActorID      *uuid.UUID `json:"actor_id,omitempty"`
+	Version      *int       `json:"version,omitempty"`
+	FromParentID *uuid.UUID `json:"from_parent_id,omitempty"`
+	ToParentID   *uuid.UUID `json:"to_parent_id,omitempty"`
+	SubjectID    *uuid.UUID `json:"subject_id,omitempty"`
+	Role         string     `json:"role,omitempty"`
+	// Summary is the change summary of the version the event recorded, if it was given one.
+	Summary string `json:"summary,omitempty"`
+	// MinorEdit is set on edits of a version saved as a minor edit.
+	MinorEdit bool      `json:"minor_edit,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetActivityReq pages through the events of an entity and its descendants, newest first.
+// Before is the NextCursor of the previous page, empty for the first one.
+type GetActivityReq struct {
+	ID     uuid.UUID `json:"id"`
+	Before string    `json:"before"`
+	Limit  int       `json:"limit"`
+	// IncludeMinor keeps minor edits in the feed; they are left out by default.
+	IncludeMinor bool `json:"include_minor"`
+}
+
+// Activity is a page of events. NextCursor is set when older events exist.
+type Activity struct {
+	Events     []Event `json:"events"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+// GetVersionsReq pages through the versions of an entity, newest first.
+// Before is the NextCursor of the previous page, empty for the first one.
+type GetVersionsReq struct {
+	ID     uuid.UUID `json:"id"`
+	Before string    `json:"before"`
+	Limit  int       `json:"limit"`
+	// IncludeContent returns the body of every version; without it Content is left empty.
+	IncludeContent bool `json:"include_content"`
+}
+
+// VersionsPage is a page of versions, newest first. NextCursor is set when older versions exist.
+type VersionsPage struct {
+	Versions   []Entity `json:"versions"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// PathResolution is the entity a slug path points to. Path is its current location, from the root.
+// Moved is set when the requested path was a former location.
+type PathResolution struct {
+	ID    uuid.UUID
+	Path  []string
+	Moved bool
+}
+
+// MovedLocation answers a request for a former location of an entity.
+type MovedLocation struct {
+	EntityID   uuid.UUID `json:"entity_id"`
+	Location   string    `json:"location"`
+	ParentPath []string  `json:"parent_path"`
+}
+
+// Meta is the computed metadata of an entity. Views counts reads, once per session.
+type Meta struct {
+	ContentStats
+	VersionCount  int      `json:"version_count"`
+	ChildrenCount int      `json:"children_count"`
+	Views         int64    `json:"views"`
+	LastEditors   []Editor `json:"last_editors"`
+}
+
+// View is a read of an entity. Reads in the same session count once, at the first one.
+type View struct {
+	EntityID  uuid.UUID
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	ViewedAt  time.Time
+}
+
+// GetPopularReq asks for the Limit most viewed entities over the last Period.
+type GetPopularReq struct {
+	Period time.Duration `json:"period"`
+	Limit  int           `json:"limit"`
+}
+
+// PopularEntity is an entity with the number of its views since PopularReport.Since.
+type PopularEntity struct {
+	ListItem
+	Views int64 `json:"views"`
+}
+
+type PopularReport struct {
+	Since    time.Time       `json:"since"`
+	Entities []PopularEntity `json:"entities"`
+}
+
+// OrphanedEntity is a live entity whose owner was deleted.
+type OrphanedEntity struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	Slug           string    `json:"slug"`
+	OwnerID        uuid.UUID `json:"owner_id"`
+	OwnerDeletedAt time.Time `json:"owner_deleted_at"`
+}
+
+// UnsafeMarkup is an entity whose content holds markup the sanitize policy removes: Removed lists it,
+// as "<tag>", "attr=" or "scheme:".
+type UnsafeMarkup struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Slug    string    `json:"slug"`
+	Removed []string  `json:"removed"`
+}
+
+// ExportItem is one line of an export. Items come level by level, so a parent precedes its children.
+type ExportItem struct {
+	ID       uuid.UUID  `json:"id"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Type     Type       `json:"type"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+	Content  string     `json:"content"`
+	IsDraft  bool       `json:"is_draft"`
+	// Version is the current version, zero for drafts.
+	Version   int       `json:"version,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Pinned and SortOrder place the item among its siblings, see ListItem.
+	Pinned    bool `json:"pinned,omitempty"`
+	SortOrder int  `json:"sort_order,omitempty"`
+	// Depth is the number of entities on the path from the top level down to the item.
+	Depth int `json:"-"`
+}
+
+type HistoryItemType string
+
+const (
+	HistoryVersion    HistoryItemType = "version"
+	HistoryMove       HistoryItemType = "move"
+	HistoryPermission HistoryItemType = "permission"
+	HistoryAudit      HistoryItemType = "audit"
+)
+
+// HistoryItem is an entry of the history of an entity. Type tells which field is set: Version, without
+// content, for versions; Event for moves, permission changes and the other events of the entity.
+type HistoryItem struct {
+	Type      HistoryItemType `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Version   *Entity         `json:"version,omitempty"`
+	Event     *Event          `json:"event,omitempty"`
+	// Cursor is the position of the item in the history.
+	Cursor HistoryCursor `json:"-"`
+}
+
+// GetHistoryReq pages through the history of an entity, newest first.
+// Before is the NextCursor of the previous page, empty for the first one.
+type GetHistoryReq struct {
+	ID     uuid.UUID `json:"id"`
+	Before string    `json:"before"`
+	Limit  int       `json:"limit"`
+}
+
+// History is a page of the history of an entity. NextCursor is set when older items exist.
+type History struct {
+	Items      []HistoryItem `json:"items"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// ExportCursor is the position after the last exported item; the zero value starts from the beginning.
+type ExportCursor struct {
+	Depth int
+	ID    uuid.UUID
+}
+
+// ListReq filters, orders and pages the flat list of entities. Zero fields do not filter, Sort defaults
+// to ListSortName. After is the NextCursor of the previous page, empty for the first one.
+type ListReq struct {
+	Type Type `json:"type,omitempty"`
+	// ParentID lists the entities below it, at any depth.
+	ParentID     *uuid.UUID `json:"parent_id,omitempty"`
+	UpdatedSince *time.Time `json:"updated_since,omitempty"`
+	// AuthorID lists the entities it created.
+	AuthorID *uuid.UUID `json:"author_id,omitempty"`
+	Status   ListStatus `json:"status,omitempty"`
+	Sort     ListSort   `json:"sort,omitempty"`
+	Desc     bool       `json:"desc,omitempty"`
+	After    string     `json:"after,omitempty"`
+	Limit    int        `json:"limit"`
+}
+
+// ListFilter is a validated ListReq as the repository applies it. IDs, if not nil, restricts the list to
+// those entities and UserID, if set, hides the drafts of other users.
+type ListFilter struct {
+	Type         Type
+	ParentID     *uuid.UUID
+	UpdatedSince *time.Time
+	AuthorID     *uuid.UUID
+	Status       ListStatus
+	Sort         ListSort
+	Desc         bool
+	After        *ListCursor
+	IDs          []uuid.UUID
+	UserID       *uuid.UUID
+}
+
+// DraftsReq pages through the current user's drafts. After is the NextCursor of the previous page, empty
+// for the first one.
+type DraftsReq struct {
+	After string `json:"after,omitempty"`
+	Limit int    `json:"limit"`
+}
+
+// Breadcrumb is an entity above a list entry.
+type Breadcrumb struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+}
+
+// ListEntry is a row of the flat list. Breadcrumbs are the entities above it, from the root down.
+type ListEntry struct {
+	ListItem
+	IsDraft     bool         `json:"is_draft"`
+	CreatedBy   uuid.UUID    `json:"created_by"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	Breadcrumbs []Breadcrumb `json:"breadcrumbs"`
+	// ChildrenCount and SubtreeSize count the direct children and all descendants the caller can list, as
+	// Node does in the tree.
+	ChildrenCount int `json:"children_count"`
+	SubtreeSize   int `json:"subtree_size"`
+}
+
+// ListPage is a page of the flat list. NextCursor is set when more entries follow.
+type ListPage struct {
+	Items      []ListEntry `json:"items"`
+	NextCursor string      `json:"next_cursor,omitempty"`
 }
 
 type CreateEntityReq struct {
@@ -68,6 +539,13 @@ type CreateEntityReq struct {
 	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	IsDraft  bool       `json:"is_draft"`
 	UserID   uuid.UUID  `json:"user_id"`
+	// VariantOf makes the new entity the variant of another one, which has a language, in Language.
+	VariantOf *uuid.UUID `json:"variant_of,omitempty"`
+	Language  string     `json:"language,omitempty"`
+	// Stats, Links and Slug are filled by the core.
+	Stats ContentStats `json:"-"`
+	Links []uuid.UUID  `json:"-"`
+	Slug  string       `json:"-"`
 }
 
 type UpdateEntityReq struct {
@@ -79,13 +557,28 @@ type UpdateEntityReq struct {
 	UserID        uuid.UUID  `json:"user_id"`
 	ParentChanged bool       `json:"parent_changed"`
 	EntityType    Type       `json:"entity_type"`
+	// Summary describes the change; it is stored with the version, so drafts drop it.
+	Summary string `json:"summary,omitempty"`
+	// MinorEdit marks a change not worth following, such as a typo fix. It is stored with the version
+	// and keeps its edit out of the activity feed.
+	MinorEdit bool `json:"minor_edit,omitempty"`
+	// Stats, Links and Slug are filled by the core.
+	Stats ContentStats `json:"-"`
+	Links []uuid.UUID  `json:"-"`
+	Slug  string       `json:"-"`
 }
 
 type Tree []*Node
 
 type Node struct {
 	ListItem
-	Children []*Node `json:"children,omitempty"`
+	// ChildrenCount and SubtreeSize count the direct children and all descendants in the tree, i.e. those
+	// the caller can see, so clients can draw expanders without walking Children.
+	ChildrenCount int `json:"children_count"`
+	SubtreeSize   int `json:"subtree_size"`
+	// DraftCount counts the caller's own drafts among the descendants, so clients can point to unpublished work.
+	DraftCount int     `json:"draft_count"`
+	Children   []*Node `json:"children,omitempty"`
 }
 
 func BuildTree(ctx context.Context, entities []ListItem) Tree {
@@ -111,20 +604,58 @@ func BuildTree(ctx context.Context, entities []ListItem) Tree {
 	}
 	if roots != nil {
 		roots.sort()
+		roots.count()
 	}
 
 	return roots
 }
 
+// count fills ChildrenCount and SubtreeSize from the built tree. The hierarchy queries are not asked for
+// the counts, as the permission checks run the same queries and need the IDs only.
+func (t *Tree) count() {
+	var countSubtree func(node *Node) int
+	countSubtree = func(node *Node) int {
+		node.ChildrenCount = len(node.Children)
+		node.SubtreeSize = len(node.Children)
+		for _, child := range node.Children {
+			node.SubtreeSize += countSubtree(child)
+		}
+		return node.SubtreeSize
+	}
+	for _, root := range *t {
+		countSubtree(root)
+	}
+}
+
+// countDrafts fills DraftCount from the IDs of the caller's drafts.
+func (t *Tree) countDrafts(draftIDs []uuid.UUID) {
+	if len(draftIDs) == 0 {
+		return
+	}
+	drafts := make(map[uuid.UUID]struct{}, len(draftIDs))
+	for _, id := range draftIDs {
+		drafts[id] = struct{}{}
+	}
+	var countSubtree func(node *Node) int
+	countSubtree = func(node *Node) int {
+		node.DraftCount = 0
+		for _, child := range node.Children {
+			if _, ok := drafts[child.ID]; ok {
+				node.DraftCount++
+			}
+			node.DraftCount += countSubtree(child)
+		}
+		return node.DraftCount
+	}
+	for _, root := range *t {
+		countSubtree(root)
+	}
+}
+
 func (t *Tree) sort() {
 	var sortChildren func(nodes []*Node)
 	sortChildren = func(nodes []*Node) {
-		sort.Slice(nodes, func(i, j int) bool {
-			if nodes[i].Name == nodes[j].Name {
-				return nodes[i].ID.String() < nodes[j].ID.String()
-			}
-			return nodes[i].Name < nodes[j].Name
-		})
+		slices.SortFunc(nodes, func(a, b *Node) int { return compareSiblings(a.ListItem, b.ListItem) })
 		for _, node := range nodes {
 			if node.Children != nil {
 				sortChildren(node.Children)
@@ -133,3 +664,29 @@ func (t *Tree) sort() {
 	}
 	sortChildren(*t)
 }
+
+// compareSiblings orders the children of a parent: the pinned ones first, then those with a SortOrder, by
+// it, then the unordered ones by name. The ID breaks ties, so the order is stable. GetChildren orders the
+// same way in SQL.
+func compareSiblings(a, b ListItem) int {
+	if a.Pinned != b.Pinned {
+		if a.Pinned {
+			return -1
+		}
+		return 1
+	}
+	if (a.SortOrder == 0) != (b.SortOrder == 0) {
+		if a.SortOrder == 0 {
+			return 1
+		}
+		return -1
+	}
+	if c := cmp.Compare(a.SortOrder, b.SortOrder); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.Name, b.Name); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.ID.String(), b.ID.String())
+}
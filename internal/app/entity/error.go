@@ -1,6 +1,11 @@
 package entity
 
-import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+import (
+	"strconv"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
 
 func ErrEntityNotFound() error {
 	return apperr.New("Entity not found", CodeNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
@@ -28,19 +33,97 @@ func ErrCannotDraftEntityWithChildren() error {
 		})
 }
 
+func ErrAutosaveNotFound() error {
+	return apperr.New("Entity has no autosave", CodeAutosaveNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrLockNotFound() error {
+	return apperr.New("Entity is not locked", CodeLockNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+// ErrEntityLocked reports the active lock of another user so the client can show who holds it.
+func ErrEntityLocked(lock Lock) error {
+	return apperr.New("Entity is locked by another user", CodeLocked, apperr.ClassLocked, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldEntityID, Rule: apperr.RuleLocked,
+			Params: map[string]any{"user_id": lock.UserID, "expires_at": lock.ExpiresAt},
+		})
+}
+
 const (
 	CodeValidationFailed apperr.Code = "entity/validation_failed"
 	CodeNotFound         apperr.Code = "entity/not_found"
 	CodeParentCycle      apperr.Code = "entity/parent_cycle"
 	CodeMaxDepthExceeded apperr.Code = "entity/max_depth_exceeded"
+	CodeLocked           apperr.Code = "entity/locked"
+	CodeLockNotFound     apperr.Code = "entity/lock_not_found"
+	CodeAutosaveNotFound apperr.Code = "entity/autosave_not_found"
+	CodeContentTooLong   apperr.Code = "entity/content_too_long"
+	CodeDuplicateName    apperr.Code = "entity/duplicate_name"
+	CodeSlugTaken        apperr.Code = "entity/slug_taken"
+	CodeRelationNotFound apperr.Code = "entity/relation_not_found"
+	CodeSnapshotNotFound apperr.Code = "entity/snapshot_not_found"
+	CodeSnapshotTaken    apperr.Code = "entity/snapshot_label_taken"
+	CodeVariableNotFound apperr.Code = "entity/variable_not_found"
+	CodeVariantNotFound  apperr.Code = "entity/variant_not_found"
+	CodeLanguageTaken    apperr.Code = "entity/language_taken"
+	CodePinLimitReached  apperr.Code = "entity/pin_limit_reached"
+	CodeSpaceMove        apperr.Code = "entity/space_move_unconfirmed"
 )
 
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid entity", apperr.ClassBadRequest)
+	apperr.Register(CodeNotFound, "Entity not found", apperr.ClassNotFound)
+	apperr.Register(CodeParentCycle, "Parent cycle detected", apperr.ClassBadRequest)
+	apperr.Register(CodeMaxDepthExceeded, "Maximum hierarchy depth exceeded", apperr.ClassBadRequest)
+	apperr.Register(CodeLocked, "Entity is locked", apperr.ClassLocked)
+	apperr.Register(CodeLockNotFound, "Entity is not locked", apperr.ClassNotFound)
+	apperr.Register(CodeAutosaveNotFound, "Entity has no autosave", apperr.ClassNotFound)
+	apperr.Register(CodeContentTooLong, "Content is too long", apperr.ClassTooLarge)
+	apperr.Register(CodeDuplicateName, "Name already used by a sibling", apperr.ClassConflict)
+	apperr.Register(CodeSlugTaken, "Slug already taken", apperr.ClassConflict)
+	apperr.Register(CodeRelationNotFound, "Entities are not related", apperr.ClassNotFound)
+	apperr.Register(CodeSnapshotNotFound, "Snapshot not found", apperr.ClassNotFound)
+	apperr.Register(CodeSnapshotTaken, "Snapshot label already used", apperr.ClassConflict)
+	apperr.Register(CodeVariableNotFound, "Variable not found", apperr.ClassNotFound)
+	apperr.Register(CodeVariantNotFound, "Entity has no language", apperr.ClassNotFound)
+	apperr.Register(CodeLanguageTaken, "Language variant already exists", apperr.ClassConflict)
+	apperr.Register(CodePinLimitReached, "Pin limit reached", apperr.ClassConflict)
+	apperr.Register(CodeSpaceMove, "Move to another space not confirmed", apperr.ClassConflict)
+}
+
 const (
 	FieldName     apperr.Field = "name"
+	FieldContent  apperr.Field = "content"
 	FieldType     apperr.Field = "type"
 	FieldParentID apperr.Field = "parent_id"
 	FieldEntityID apperr.Field = "entity_id"
 	FieldUserID   apperr.Field = "user_id"
+	FieldSlug     apperr.Field = "slug"
+	FieldOwnerID  apperr.Field = "owner_id"
+	FieldRelated  apperr.Field = "related_id"
+	FieldIDs      apperr.Field = "ids"
+	FieldLabel    apperr.Field = "label"
+	FieldFormat   apperr.Field = "format"
+	FieldKey      apperr.Field = "key"
+	FieldValue    apperr.Field = "value"
+	FieldRender   apperr.Field = "render"
+	FieldSummary  apperr.Field = "summary"
+	FieldLanguage apperr.Field = "language"
+	FieldVariant  apperr.Field = "variant_id"
+	FieldIcon     apperr.Field = "icon"
+	FieldCover    apperr.Field = "cover"
+	FieldChildID  apperr.Field = "child_id"
+	// FieldDefaultPermissions is the list of SetDefaultPermissionsReq.
+	FieldDefaultPermissions apperr.Field = "permissions"
+	// FieldAppearance is the whole of UpdateAppearanceReq.
+	FieldAppearance apperr.Field = "appearance"
+	// FieldMinorEdit is the flag of UpdateEntityReq, FieldRetention the policy of SpaceSettings.
+	FieldMinorEdit apperr.Field = "minor_edit"
+	FieldRetention apperr.Field = "retention"
+	// FieldConfirmSpaceMove and FieldGrants are the flag and the GrantPolicy of a move into another space.
+	FieldConfirmSpaceMove apperr.Field = "confirm_cross_space"
+	FieldGrants           apperr.Field = "grants"
 )
 
 func ErrNameRequired() error {
@@ -50,7 +133,39 @@ func ErrNameRequired() error {
 
 func ErrNameTooLong(max int) error {
 	return apperr.New("name is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
-		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleTooLong, Params: map[string]any{"max": max}})
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrSummaryTooLong(max int) error {
+	return apperr.New("change summary is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldSummary, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrNameForbiddenChar(c rune) error {
+	return apperr.New("name contains a forbidden character", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleInvalidFormat, Params: map[string]any{"char": string(c)}})
+}
+
+func ErrContentTooLong(maxBytes int) error {
+	return apperr.New("content is too long", CodeContentTooLong, apperr.ClassTooLarge, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldContent, Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": maxBytes}})
+}
+
+func ErrBrokenLinks(ids []uuid.UUID) error {
+	return apperr.New("Content links to entities that do not exist", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldContent, Rule: apperr.RuleNotFound, Params: map[string]any{"entity_ids": ids}})
+}
+
+func ErrDuplicateSiblingName() error {
+	return apperr.New("An entity with this name already exists under the same parent", CodeDuplicateName,
+		apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleDuplicate})
+}
+
+// ErrSlugTaken is returned when a concurrent write claimed the picked slug first; retrying picks the next one.
+func ErrSlugTaken() error {
+	return apperr.New("The slug was taken by a concurrent change, please retry", CodeSlugTaken,
+		apperr.ClassConflict, apperr.LogLevelWarn)
 }
 
 func ErrParentRequired() error {
@@ -63,6 +178,12 @@ func ErrParentNotFound() error {
 		WithViolation(apperr.Violation{Field: FieldParentID, Rule: apperr.RuleNotFound})
 }
 
+// ErrOwnerNotFound is returned when ownership is transferred to a user that does not exist or was deleted.
+func ErrOwnerNotFound() error {
+	return apperr.New("owner not found", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldOwnerID, Rule: apperr.RuleNotFound})
+}
+
 func ErrInvalidVersion() error {
 	return apperr.New("version must be positive", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{Field: FieldVersion, Rule: apperr.RuleInvalidFormat})
@@ -81,3 +202,311 @@ func ErrIncompatibleParentType() error {
 			Field: FieldParentID, Rule: apperr.RuleInvalidFormat,
 		})
 }
+
+func ErrInvalidActivityLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
+func ErrInvalidVersionsLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
+func ErrInvalidHistoryLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
+func ErrInvalidListLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
+func ErrInvalidBatchSize(maxIDs int) error {
+	return apperr.New("Number of IDs is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldIDs, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxIDs},
+		})
+}
+
+func ErrDuplicateChildren() error {
+	return apperr.New("IDs must not repeat", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldIDs, Rule: apperr.RuleDuplicate})
+}
+
+// ErrNotChildren is returned when an ID to order is not a live child of the parent.
+func ErrNotChildren() error {
+	return apperr.New("IDs must be children of the parent", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldIDs, Rule: apperr.RuleInvalidState})
+}
+
+// ErrNotChild is returned when the entity to pin is not a live child of the parent.
+func ErrNotChild() error {
+	return apperr.New("entity must be a child of the parent", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldChildID, Rule: apperr.RuleInvalidState})
+}
+
+func ErrPinLimitReached(maxPinned int) error {
+	return apperr.New("The parent already has the maximum number of pinned children", CodePinLimitReached,
+		apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldChildID, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"max": maxPinned},
+		})
+}
+
+// ErrNotSpace is returned for settings of an entity with a parent; only roots have them.
+func ErrNotSpace() error {
+	return apperr.New("only entities without a parent have space settings", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldEntityID, Rule: apperr.RuleInvalidState})
+}
+
+func ErrInvalidSpaceRetention() error {
+	return apperr.New("keep_last_versions and keep_days must not be negative", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRetention, Rule: apperr.RuleOutOfRange, Params: map[string]any{"min": 0}})
+}
+
+func ErrSummaryRequired() error {
+	return apperr.New("The space requires a change summary", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldSummary, Rule: apperr.RuleRequired})
+}
+
+func ErrMinorEditNotAllowed() error {
+	return apperr.New("The space does not allow minor edits", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldMinorEdit, Rule: apperr.RuleForbidden})
+}
+
+// ErrSpaceMoveNotConfirmed names both spaces so the client can ask before it retries with the confirmation.
+func ErrSpaceMoveNotConfirmed(fromSpaceID, toSpaceID uuid.UUID) error {
+	return apperr.New("The entity would move to another space", CodeSpaceMove, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldConfirmSpaceMove, Rule: apperr.RuleRequired,
+			Params: map[string]any{"from_space_id": fromSpaceID, "to_space_id": toSpaceID},
+		})
+}
+
+func ErrInvalidGrantPolicy() error {
+	return apperr.New("grants must be keep, strip or remap", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldGrants, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrTooManyDefaultPermissions(maxPermissions int) error {
+	return apperr.New("too many default permissions", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldDefaultPermissions, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"max": maxPermissions},
+		})
+}
+
+func ErrInvalidDefaultRole() error {
+	return apperr.New("default role must be read or write", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldDefaultPermissions, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrDuplicateDefaultPermission() error {
+	return apperr.New("a user can have one default permission", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldDefaultPermissions, Rule: apperr.RuleDuplicate})
+}
+
+// ErrDefaultPermissionUserNotFound is returned for a default permission of a user that does not exist or was deleted.
+func ErrDefaultPermissionUserNotFound() error {
+	return apperr.New("user of a default permission not found", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldDefaultPermissions, Rule: apperr.RuleNotFound})
+}
+
+func ErrInvalidListSort() error {
+	return apperr.New("sort must be name, updated_at or created_at", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldSort, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrInvalidListStatus() error {
+	return apperr.New("status must be draft or published", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldStatus, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrInvalidPopularLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
+// ErrInvalidPeriod is returned for a period that is not a number of days or hours, such as "30d"
+// or "12h", between one hour and maxDays.
+func ErrInvalidPeriod(maxDays int) error {
+	return apperr.New("period must be a number of days or hours, such as 30d or 12h", CodeValidationFailed,
+		apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldPeriod, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": "1h", "max": strconv.Itoa(maxDays) + "d"},
+		})
+}
+
+func ErrRelationNotFound() error {
+	return apperr.New("Entities are not related", CodeRelationNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrSelfRelation() error {
+	return apperr.New("an entity cannot be related to itself", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRelated, Rule: apperr.RuleInvalidState})
+}
+
+func ErrSnapshotNotFound() error {
+	return apperr.New("Snapshot not found", CodeSnapshotNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrSnapshotLabelTaken() error {
+	return apperr.New("A snapshot with this label already exists for the entity", CodeSnapshotTaken,
+		apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLabel, Rule: apperr.RuleDuplicate})
+}
+
+func ErrSnapshotLabelRequired() error {
+	return apperr.New("label is required", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLabel, Rule: apperr.RuleRequired})
+}
+
+func ErrSnapshotLabelTooLong(max int) error {
+	return apperr.New("label is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLabel, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrInvalidSnapshotLabel() error {
+	return apperr.New("label may only contain letters, digits, ., - and _", CodeValidationFailed,
+		apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLabel, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrSnapshotOfDraft is returned for a snapshot of a draft, which has no version to capture.
+func ErrSnapshotOfDraft() error {
+	return apperr.New("cannot take a snapshot of a draft", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldEntityID, Rule: apperr.RuleInvalidState})
+}
+
+func ErrInvalidManualFormat() error {
+	return apperr.New("format must be html or pdf", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldFormat, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrPDFUnavailable is returned for a PDF manual when no converter is configured, see pdf.Config.
+func ErrPDFUnavailable() error {
+	return apperr.New("PDF output is not configured", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldFormat, Rule: apperr.RuleInvalidState})
+}
+
+func ErrVariableNotFound() error {
+	return apperr.New("Variable not found", CodeVariableNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrVariableKeyTooLong(max int) error {
+	return apperr.New("key is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldKey, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrInvalidVariableKey() error {
+	return apperr.New("key may only contain letters, digits, ., - and _", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldKey, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrVariableValueTooLong(max int) error {
+	return apperr.New("value is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldValue, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrTooManyVariables(max int) error {
+	return apperr.New("too many variables", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldKey, Rule: apperr.RuleOutOfRange, Params: map[string]any{"max": max}})
+}
+
+// ErrVariablesTooLarge is returned when expanding the variables of a content takes more than
+// MaxVariableReferences references or makes it longer than MaxRenderedContentLength, e.g. with values
+// that reference each other many times over.
+func ErrVariablesTooLarge(maxBytes, maxReferences int) error {
+	return apperr.New("content is too long with its variables", CodeContentTooLong, apperr.ClassTooLarge, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldContent, Rule: apperr.RuleTooLong,
+			Params: map[string]any{"max_bytes": maxBytes, "max_references": maxReferences},
+		})
+}
+
+func ErrInvalidRender() error {
+	return apperr.New("render must be html", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRender, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrIncludesTooLarge is returned when resolving the includes of a content makes it longer than
+// MaxRenderedContentLength.
+func ErrIncludesTooLarge(maxBytes int) error {
+	return apperr.New("content is too long with its includes", CodeContentTooLong, apperr.ClassTooLarge, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldContent, Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": maxBytes}})
+}
+
+// ErrNoLanguage is returned for an entity that was given no language, so it has no variants either.
+func ErrNoLanguage() error {
+	return apperr.New("Entity has no language", CodeVariantNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrLanguageTaken() error {
+	return apperr.New("The document already has a variant in this language", CodeLanguageTaken,
+		apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLanguage, Rule: apperr.RuleDuplicate})
+}
+
+func ErrInvalidLanguage() error {
+	return apperr.New("language must be a language tag such as en or pt-BR", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLanguage, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrUnsupportedLanguage(languages []string) error {
+	return apperr.New("language is not one of the workspace languages", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldLanguage, Rule: apperr.RuleOutOfRange, Params: map[string]any{"languages": languages}})
+}
+
+func ErrSelfVariant() error {
+	return apperr.New("an entity cannot be a variant of itself", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldVariant, Rule: apperr.RuleInvalidState})
+}
+
+// ErrVariantOfOther is returned when linking an entity that already is a variant of another document;
+// it has to be unlinked first.
+func ErrVariantOfOther() error {
+	return apperr.New("the entity is a variant of another document", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldVariant, Rule: apperr.RuleInvalidState})
+}
+
+func ErrEmptyAppearanceUpdate() error {
+	return apperr.New("No appearance fields to update", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldAppearance, Rule: apperr.RuleRequired})
+}
+
+func ErrInvalidIcon() error {
+	return apperr.New("icon must be an emoji or an attachment key", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldIcon, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrInvalidCover() error {
+	return apperr.New("cover must be an attachment key or an http or https URL", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldCover, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrAttachmentNotFound is returned when the icon or cover in field refers to a key no attachment of the
+// workspace is stored under.
+func ErrAttachmentNotFound(field apperr.Field) error {
+	return apperr.New("no attachment is stored under the key", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: field, Rule: apperr.RuleNotFound})
+}
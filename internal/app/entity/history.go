@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+)
+
+// MaxHistoryLimit caps the page size of the history of an entity.
+const MaxHistoryLimit = 100
+
+// HistorySource tells which table a history item comes from. Items created at the same time are
+// ordered by source, then by their sequence number within it.
+type HistorySource int
+
+const (
+	HistorySourceVersions HistorySource = iota
+	HistorySourceEvents
+)
+
+// HistoryCursor is the position of a history item: its time, source and version or event ID.
+type HistoryCursor struct {
+	CreatedAt time.Time
+	Source    HistorySource
+	Seq       int64
+}
+
+// String encodes the cursor for clients with db.EncodeCursor: microseconds since the epoch, source and
+// sequence number.
+func (c HistoryCursor) String() string {
+	return db.EncodeCursor(strconv.FormatInt(c.CreatedAt.UnixMicro(), 10), strconv.Itoa(int(c.Source)),
+		strconv.FormatInt(c.Seq, 10))
+}
+
+// ParseHistoryCursor reads a cursor encoded by HistoryCursor.String.
+func ParseHistoryCursor(s string) (HistoryCursor, error) {
+	values, err := db.DecodeCursor(s, 3)
+	if err != nil {
+		return HistoryCursor{}, fmt.Errorf("entity.ParseHistoryCursor: %w", err)
+	}
+	micros, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return HistoryCursor{}, fmt.Errorf("entity.ParseHistoryCursor: %w", db.ErrInvalidCursor())
+	}
+	source, err := strconv.Atoi(values[1])
+	if err != nil || (HistorySource(source) != HistorySourceVersions && HistorySource(source) != HistorySourceEvents) {
+		return HistoryCursor{}, fmt.Errorf("entity.ParseHistoryCursor: %w", db.ErrInvalidCursor())
+	}
+	seq, err := strconv.ParseInt(values[2], 10, 64)
+	if err != nil || seq < 0 {
+		return HistoryCursor{}, fmt.Errorf("entity.ParseHistoryCursor: %w", db.ErrInvalidCursor())
+	}
+
+	return HistoryCursor{CreatedAt: time.UnixMicro(micros).UTC(), Source: HistorySource(source), Seq: seq}, nil
+}
+
+// HistoryItemType tells how an event of the entity shows up in its history.
+func (t EventType) HistoryItemType() HistoryItemType {
+	switch t {
+	case EventMoved:
+		return HistoryMove
+	case EventRoleGranted, EventRoleRevoked:
+		return HistoryPermission
+	default:
+		return HistoryAudit
+	}
+}
+
+// GetHistory merges the versions of an entity with its moves, role changes and other events, newest first.
+// Edit events are left out, the versions they produced stand for them.
+func (c *core) GetHistory(ctx context.Context, req GetHistoryReq) (History, error) {
+	if req.ID == uuid.Nil {
+		return History{}, fmt.Errorf("entity.core.GetHistory: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.Limit <= 0 || req.Limit > MaxHistoryLimit {
+		return History{}, fmt.Errorf("entity.core.GetHistory: %w", ErrInvalidHistoryLimit(MaxHistoryLimit))
+	}
+	var before *HistoryCursor
+	if req.Before != "" {
+		cursor, err := ParseHistoryCursor(req.Before)
+		if err != nil {
+			return History{}, fmt.Errorf("entity.core.GetHistory: %w", err)
+		}
+		before = &cursor
+	}
+
+	// one extra row tells whether another page exists
+	items, err := c.repo.GetHistory(ctx, req.ID, before, req.Limit+1)
+	if err != nil {
+		return History{}, fmt.Errorf("entity.core.GetHistory: %w", err)
+	}
+	history := History{Items: items}
+	if len(items) > req.Limit {
+		history.Items = items[:req.Limit]
+		history.NextCursor = history.Items[req.Limit-1].Cursor.String()
+	}
+
+	return history, nil
+}
@@ -0,0 +1,144 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHistoryCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := entity.HistoryCursor{
+		CreatedAt: time.Date(2025, 9, 22, 10, 0, 0, 123456000, time.UTC),
+		Source:    entity.HistorySourceEvents,
+		Seq:       42,
+	}
+	got, err := entity.ParseHistoryCursor(cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, cursor, got)
+
+	for _, in := range []string{
+		"", "!", db.EncodeCursor("1", "2"), db.EncodeCursor("1", "0", "2", "3"), db.EncodeCursor("x", "0", "1"),
+		db.EncodeCursor("1", "x", "1"), db.EncodeCursor("1", "2", "1"), db.EncodeCursor("1", "0", "x"), db.EncodeCursor("1", "0", "-1"),
+	} {
+		_, err = entity.ParseHistoryCursor(in)
+		require.ErrorIs(t, err, db.ErrInvalidCursor(), in)
+	}
+}
+
+func TestEventType_HistoryItemType(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, entity.HistoryMove, entity.EventMoved.HistoryItemType())
+	require.Equal(t, entity.HistoryPermission, entity.EventRoleGranted.HistoryItemType())
+	require.Equal(t, entity.HistoryPermission, entity.EventRoleRevoked.HistoryItemType())
+	require.Equal(t, entity.HistoryAudit, entity.EventCreated.HistoryItemType())
+	require.Equal(t, entity.HistoryAudit, entity.EventDeleted.HistoryItemType())
+}
+
+func TestCore_GetHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		now    = time.Now().UTC().Truncate(time.Microsecond)
+		before = entity.HistoryCursor{CreatedAt: now, Source: entity.HistorySourceVersions, Seq: 3}
+		items  = []entity.HistoryItem{
+			{
+				Type:      entity.HistoryMove,
+				CreatedAt: now.Add(-time.Minute),
+				Event:     &entity.Event{ID: 7, EntityID: id, Type: entity.EventMoved},
+				Cursor:    entity.HistoryCursor{CreatedAt: now.Add(-time.Minute), Source: entity.HistorySourceEvents, Seq: 7},
+			},
+			{
+				Type:      entity.HistoryVersion,
+				CreatedAt: now.Add(-time.Minute),
+				Version:   &entity.Entity{ID: id, CurrentVersion: &[]int{2}[0]},
+				Cursor:    entity.HistoryCursor{CreatedAt: now.Add(-time.Minute), Source: entity.HistorySourceVersions, Seq: 2},
+			},
+		}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		req   entity.GetHistoryReq
+		setup func(repo *mocks.RepositoryMock)
+		want  entity.History
+		err   error
+	}{
+		{
+			name: "success/last page",
+			req:  entity.GetHistoryReq{ID: id, Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetHistoryMock.Expect(ctx, id, nil, 3).Return(items, nil)
+			},
+			want: entity.History{Items: items},
+		},
+		{
+			name: "success/more pages",
+			req:  entity.GetHistoryReq{ID: id, Before: before.String(), Limit: 1},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetHistoryMock.Expect(ctx, id, &before, 2).Return(items, nil)
+			},
+			want: entity.History{Items: items[:1], NextCursor: items[0].Cursor.String()},
+		},
+		{
+			name: "error/nil_id",
+			req:  entity.GetHistoryReq{Limit: 2},
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/limit_zero",
+			req:  entity.GetHistoryReq{ID: id},
+			err:  entity.ErrInvalidHistoryLimit(entity.MaxHistoryLimit),
+		},
+		{
+			name: "error/limit_too_large",
+			req:  entity.GetHistoryReq{ID: id, Limit: entity.MaxHistoryLimit + 1},
+			err:  entity.ErrInvalidHistoryLimit(entity.MaxHistoryLimit),
+		},
+		{
+			name: "error/malformed_cursor",
+			req:  entity.GetHistoryReq{ID: id, Before: "5", Limit: 2},
+			err:  db.ErrInvalidCursor(),
+		},
+		{
+			name: "error/repo_error",
+			req:  entity.GetHistoryReq{ID: id, Limit: 2},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetHistoryMock.Expect(ctx, id, nil, 3).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetHistory(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxIncludeNesting is how deep included content may include other entities; deeper includes stay as written.
+const MaxIncludeNesting = 4
+
+// includePattern matches an include directive, {{include <entity_id>}}, spaces inside the braces allowed.
+// The directive is also an internal link, see linkPattern, so the included entity lists the including one
+// among its backlinks.
+var includePattern = regexp.MustCompile(`\{\{\s*include\s+([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})\s*\}\}`)
+
+// ExtractIncludes returns the distinct entity IDs included by content, in order of appearance.
+func ExtractIncludes(content string) []uuid.UUID {
+	matches := includePattern.FindAllStringSubmatch(content, -1)
+	ids := make([]uuid.UUID, 0, len(matches))
+	for _, m := range matches {
+		if id, err := uuid.Parse(m[1]); err == nil && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// ResolveIncludes replaces the include directives in contents, by entity, with the content of the included
+// entities, which may include others in turn up to MaxIncludeNesting deep. Unless isAdmin, only entities
+// among permittedIDs are included. Directives of entities that are not permitted, missing or deleted, that
+// would include an entity being included, which would be a cycle, and beyond that depth stay as written.
+func (c *core) ResolveIncludes(ctx context.Context, contents map[uuid.UUID]string, permittedIDs []uuid.UUID, isAdmin bool) (map[uuid.UUID]string, error) {
+	// the including entities are readable and can include one another without being read again
+	bodies := maps.Clone(contents)
+	pending := lo.Uniq(lo.FlatMap(lo.Values(contents), func(content string, _ int) []uuid.UUID { return ExtractIncludes(content) }))
+	// the content of each level of includes is read in one query
+	for depth := 0; depth < MaxIncludeNesting && len(pending) > 0; depth++ {
+		lookup := lo.Filter(pending, func(id uuid.UUID, _ int) bool {
+			_, loaded := bodies[id]
+			return !loaded && (isAdmin || slices.Contains(permittedIDs, id))
+		})
+		if len(lookup) == 0 {
+			break
+		}
+		entities, err := c.repo.GetMany(ctx, lookup)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.ResolveIncludes: %w", err)
+		}
+		pending = nil
+		for _, e := range entities {
+			bodies[e.ID] = e.Content
+			pending = append(pending, ExtractIncludes(e.Content)...)
+		}
+		pending = lo.Uniq(pending)
+	}
+
+	resolved := make(map[uuid.UUID]string, len(contents))
+	for id, content := range contents {
+		in := includer{bodies: bodies, ids: []uuid.UUID{id}}
+		if err := in.include(content); err != nil {
+			return nil, fmt.Errorf("entity.core.ResolveIncludes: %w", err)
+		}
+		resolved[id] = in.b.String()
+	}
+
+	return resolved, nil
+}
+
+type includer struct {
+	bodies map[uuid.UUID]string
+	b      strings.Builder
+	// ids are the entities being included, the including entity first and the innermost last
+	ids []uuid.UUID
+}
+
+func (in *includer) include(s string) error {
+	last := 0
+	for _, m := range includePattern.FindAllStringSubmatchIndex(s, -1) {
+		in.b.WriteString(s[last:m[0]])
+		last = m[1]
+
+		id, err := uuid.Parse(s[m[2]:m[3]])
+		body, ok := in.bodies[id]
+		if err != nil || !ok || len(in.ids) > MaxIncludeNesting || slices.Contains(in.ids, id) {
+			in.b.WriteString(s[m[0]:m[1]])
+			continue
+		}
+		in.ids = append(in.ids, id)
+		err = in.include(body)
+		in.ids = in.ids[:len(in.ids)-1]
+		if err != nil {
+			return err
+		}
+		if in.b.Len() > MaxRenderedContentLength {
+			return ErrIncludesTooLarge(MaxRenderedContentLength)
+		}
+	}
+	in.b.WriteString(s[last:])
+	if in.b.Len() > MaxRenderedContentLength {
+		return ErrIncludesTooLarge(MaxRenderedContentLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractIncludes(t *testing.T) {
+	t.Parallel()
+
+	a, b := uuid.New(), uuid.New()
+	content := fmt.Sprintf("{{include %s}} {{ include %s }} {{include %s}} [[%s]] {{include nope}}", a, strings.ToUpper(b.String()), a, b)
+
+	require.Equal(t, []uuid.UUID{a, b}, entity.ExtractIncludes(content))
+	require.Empty(t, entity.ExtractIncludes("no includes"))
+}
+
+func TestCore_ResolveIncludes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		pageID    = uuid.New()
+		snippetID = uuid.New()
+		noteID    = uuid.New()
+		secretID  = uuid.New()
+		goneID    = uuid.New()
+		expErr    = fmt.Errorf("test error")
+	)
+	include := func(id uuid.UUID) string { return "{{include " + id.String() + "}}" }
+	resolve := func(repo *mocks.RepositoryMock, contents map[uuid.UUID]string, permittedIDs []uuid.UUID, isAdmin bool) (map[uuid.UUID]string, error) {
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+		return c.ResolveIncludes(ctx, contents, permittedIDs, isAdmin)
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetManyMock.Set(func(_ context.Context, ids []uuid.UUID) ([]entity.Entity, error) {
+			switch {
+			case len(ids) == 2 && ids[0] == snippetID && ids[1] == goneID:
+				// the snippet includes the note and, back, the page
+				return []entity.Entity{{ID: snippetID, Content: "snippet " + include(noteID) + " " + include(pageID)}}, nil
+			case len(ids) == 1 && ids[0] == noteID:
+				return []entity.Entity{{ID: noteID, Content: "note " + include(snippetID)}}, nil
+			}
+			return nil, fmt.Errorf("unexpected ids %v", ids)
+		})
+
+		got, err := resolve(repo, map[uuid.UUID]string{
+			pageID: "page: " + include(snippetID) + ", " + include(secretID) + ", " + include(goneID),
+		}, []uuid.UUID{pageID, snippetID, noteID, goneID}, false)
+		require.NoError(t, err)
+		// the cycles back to the page and to the snippet, the entity that is not permitted and the one that
+		// is gone stay as written
+		require.Equal(t, map[uuid.UUID]string{
+			pageID: "page: snippet note " + include(snippetID) + " " + include(pageID) + ", " + include(secretID) + ", " + include(goneID),
+		}, got)
+	})
+	t.Run("no includes", func(t *testing.T) {
+		t.Parallel()
+		got, err := resolve(mocks.NewRepositoryMock(t), map[uuid.UUID]string{pageID: "page"}, nil, true)
+		require.NoError(t, err)
+		require.Equal(t, map[uuid.UUID]string{pageID: "page"}, got)
+	})
+	t.Run("nesting limit", func(t *testing.T) {
+		t.Parallel()
+		chain := make([]uuid.UUID, entity.MaxIncludeNesting+1)
+		for i := range chain {
+			chain[i] = uuid.New()
+		}
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetManyMock.Set(func(_ context.Context, ids []uuid.UUID) ([]entity.Entity, error) {
+			i := slices.Index(chain, ids[0])
+			return []entity.Entity{{ID: chain[i], Content: fmt.Sprint(i) + include(chain[min(i+1, len(chain)-1)])}}, nil
+		})
+
+		got, err := resolve(repo, map[uuid.UUID]string{pageID: include(chain[0])}, nil, true)
+		require.NoError(t, err)
+		require.Equal(t, "0123"+include(chain[entity.MaxIncludeNesting]), got[pageID])
+	})
+	t.Run("too long", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetManyMock.Return([]entity.Entity{{ID: snippetID, Content: strings.Repeat("x", 1<<20)}}, nil)
+
+		_, err := resolve(repo, map[uuid.UUID]string{pageID: strings.Repeat(include(snippetID), 5)}, nil, true)
+		require.ErrorIs(t, err, entity.ErrIncludesTooLarge(entity.MaxRenderedContentLength))
+	})
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetManyMock.Return(nil, expErr)
+
+		_, err := resolve(repo, map[uuid.UUID]string{pageID: include(snippetID)}, nil, true)
+		require.ErrorIs(t, err, expErr)
+	})
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// linkPattern matches internal links: wiki-style [[<entity_id>]] or [[<entity_id>|label]],
+// URLs containing /entities/<entity_id> and include directives, {{include <entity_id>}}.
+// Keep in sync with the backfill in migrations/20250903100000_add_entity_links_table.sql, which
+// predates include directives.
+var linkPattern = regexp.MustCompile(`(?:\[\[|/entities/|\{\{\s*include\s+)([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+// ExtractLinks returns the distinct entity IDs linked from content, in order of appearance, except self.
+func ExtractLinks(content string, self uuid.UUID) []uuid.UUID {
+	matches := linkPattern.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(matches))
+	links := make([]uuid.UUID, 0, len(matches))
+	for _, m := range matches {
+		id, err := uuid.Parse(m[1])
+		if err != nil || id == self {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		links = append(links, id)
+	}
+
+	return links
+}
+
+// BrokenLink is a link to an entity that does not exist or was deleted.
+type BrokenLink struct {
+	SourceID   uuid.UUID `json:"source_id"`
+	SourceName string    `json:"source_name"`
+	TargetID   uuid.UUID `json:"target_id"`
+}
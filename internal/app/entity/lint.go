@@ -0,0 +1,199 @@
+package entity
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	"github.com/google/uuid"
+)
+
+const (
+	// lintBatchSize is how many versions one claim hands to the linter.
+	lintBatchSize = 20
+	// lintStaleAfter is how long a claimed version may take before another run claims it again.
+	lintStaleAfter = 10 * time.Minute
+)
+
+// LintSeverity ranks a lint finding. LintOff drops findings instead.
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+	LintInfo    LintSeverity = "info"
+	LintOff     LintSeverity = "off"
+)
+
+func (s LintSeverity) Valid() bool {
+	switch s {
+	case LintError, LintWarning, LintInfo, LintOff:
+		return true
+	}
+	return false
+}
+
+type LintStatus string
+
+const (
+	LintPending LintStatus = "pending"
+	LintRunning LintStatus = "running"
+	LintDone    LintStatus = "done"
+	LintFailed  LintStatus = "failed"
+	// LintDisabled means linting is disabled for the entity or an ancestor.
+	LintDisabled LintStatus = "disabled"
+)
+
+// LintFinding is an issue the linter found in a version. Offset and Length locate it in the content.
+type LintFinding struct {
+	Offset       int          `json:"offset"`
+	Length       int          `json:"length"`
+	Message      string       `json:"message"`
+	Rule         string       `json:"rule"`
+	Category     string       `json:"category,omitempty"`
+	Severity     LintSeverity `json:"severity"`
+	Replacements []string     `json:"replacements,omitempty"`
+}
+
+type LintReport struct {
+	EntityID  uuid.UUID     `json:"entity_id"`
+	Version   int           `json:"version"`
+	Status    LintStatus    `json:"status"`
+	CheckedAt *time.Time    `json:"checked_at,omitempty"`
+	Findings  []LintFinding `json:"findings"`
+}
+
+// LintSettings applies to the entity and its descendants.
+type LintSettings struct {
+	Disabled bool `json:"disabled"`
+}
+
+// LintTarget is a version claimed for linting.
+type LintTarget struct {
+	EntityID uuid.UUID
+	Version  int
+	Content  string
+	// Language is the language of the entity's variant or else the default language of its space, empty to let
+	// the linter pick it.
+	Language string
+}
+
+// LintConfig ranks what the linter finds. Severities maps a rule ID, a category or an issue type, checked
+// in that order and ignoring case, to a severity; the rest get DefaultSeverity, warning if it is empty.
+type LintConfig struct {
+	DefaultSeverity LintSeverity            `mapstructure:"default_severity" json:"default_severity"`
+	Severities      map[string]LintSeverity `mapstructure:"severities" json:"severities"`
+}
+
+func (c LintConfig) Validate() error {
+	if c.DefaultSeverity != "" && !c.DefaultSeverity.Valid() {
+		return fmt.Errorf("default_severity must be error, warning, info or off")
+	}
+	for key, severity := range c.Severities {
+		if !severity.Valid() {
+			return fmt.Errorf("severities: %s must be error, warning, info or off", key)
+		}
+	}
+
+	return nil
+}
+
+func (c LintConfig) severity(m lint.Match) LintSeverity {
+	for _, key := range []string{m.Rule, m.Category, m.IssueType} {
+		if severity, ok := c.Severities[strings.ToLower(key)]; ok && key != "" {
+			return severity
+		}
+	}
+	if c.DefaultSeverity == "" {
+		return LintWarning
+	}
+
+	return c.DefaultSeverity
+}
+
+// ClaimLint claims the next batch of versions to lint.
+func (c *core) ClaimLint(ctx context.Context) ([]LintTarget, error) {
+	now := c.gen.Time.Now()
+	targets, err := c.repo.ClaimLint(ctx, now, now.Add(-lintStaleAfter), lintBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.ClaimLint: %w", err)
+	}
+
+	return targets, nil
+}
+
+// CompleteLint stores what the linter found in a claimed version, ranked by the configured severities.
+func (c *core) CompleteLint(ctx context.Context, target LintTarget, matches []lint.Match) error {
+	findings := make([]LintFinding, 0, len(matches))
+	for _, m := range matches {
+		severity := c.cfg.Lint.severity(m)
+		if severity == LintOff {
+			continue
+		}
+		findings = append(findings, LintFinding{
+			Offset:       m.Offset,
+			Length:       m.Length,
+			Message:      m.Message,
+			Rule:         m.Rule,
+			Category:     m.Category,
+			Severity:     severity,
+			Replacements: m.Replacements,
+		})
+	}
+	slices.SortStableFunc(findings, func(a, b LintFinding) int { return cmp.Compare(a.Offset, b.Offset) })
+	err := c.repo.FinishLint(ctx, target.EntityID, target.Version, LintDone, findings, c.gen.Time.Now())
+	if err != nil {
+		return fmt.Errorf("entity.core.CompleteLint: %w", err)
+	}
+
+	return nil
+}
+
+// FailLint records that a claimed version could not be linted; it is not retried until it changes.
+func (c *core) FailLint(ctx context.Context, target LintTarget) error {
+	err := c.repo.FinishLint(ctx, target.EntityID, target.Version, LintFailed, nil, c.gen.Time.Now())
+	if err != nil {
+		return fmt.Errorf("entity.core.FailLint: %w", err)
+	}
+
+	return nil
+}
+
+// GetLintReport returns what the linter found in a version of id, the current one if version is 0.
+func (c *core) GetLintReport(ctx context.Context, id uuid.UUID, version int) (LintReport, error) {
+	if id == uuid.Nil {
+		return LintReport{}, fmt.Errorf("entity.core.GetLintReport: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if version < 0 {
+		return LintReport{}, fmt.Errorf("entity.core.GetLintReport: %w", ErrInvalidVersion())
+	}
+	report, err := c.repo.GetLintReport(ctx, id, version)
+	if err != nil {
+		return LintReport{}, fmt.Errorf("entity.core.GetLintReport: %w", err)
+	}
+	if report.Status == "" {
+		report.Status = LintPending
+	}
+	if report.Findings == nil {
+		report.Findings = []LintFinding{}
+	}
+
+	return report, nil
+}
+
+// SetLintSettings disables or enables linting for id and its descendants.
+func (c *core) SetLintSettings(ctx context.Context, id uuid.UUID, req LintSettings) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.SetLintSettings: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := c.repo.SetLintDisabled(ctx, id, req.Disabled); err != nil {
+		return fmt.Errorf("entity.core.SetLintSettings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_Lint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		id      = uuid.New()
+		now     = time.Date(2025, 10, 13, 12, 0, 0, 0, time.UTC)
+		target  = entity.LintTarget{EntityID: id, Version: 4, Content: "Thiss are fine.", Language: "en"}
+		matches = []lint.Match{
+			{Offset: 6, Length: 3, Message: "Agreement", Rule: "THIS_NNS", Category: "GRAMMAR", IssueType: "grammar"},
+			{Offset: 0, Length: 5, Message: "Spelling", Rule: "MORFOLOGIK_RULE_EN_US", Category: "TYPOS", IssueType: "misspelling",
+				Replacements: []string{"This"}},
+			{Offset: 10, Length: 5, Message: "Style", Rule: "EN_PLAIN_ENGLISH", Category: "STYLE", IssueType: "style"},
+			{Offset: 12, Length: 1, Message: "Whitespace", Rule: "WHITESPACE_RULE", Category: "TYPOGRAPHY", IssueType: "whitespace"},
+		}
+		expErr = fmt.Errorf("test error")
+	)
+	cfg := Cfg()
+	cfg.Lint = entity.LintConfig{
+		DefaultSeverity: entity.LintInfo,
+		Severities: map[string]entity.LintSeverity{
+			"typos": entity.LintError, "grammar": entity.LintWarning, "whitespace_rule": entity.LintOff,
+		},
+	}
+	newCore := func(repo *mocks.RepositoryMock, cfg entity.Config) interface {
+		ClaimLint(ctx context.Context) ([]entity.LintTarget, error)
+		CompleteLint(ctx context.Context, target entity.LintTarget, matches []lint.Match) error
+		FailLint(ctx context.Context, target entity.LintTarget) error
+		GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error)
+		SetLintSettings(ctx context.Context, id uuid.UUID, req entity.LintSettings) error
+	} {
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Optional().Return(now)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), cfg)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("claim", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.ClaimLintMock.Expect(ctx, now, now.Add(-10*time.Minute), 20).Return([]entity.LintTarget{target}, nil)
+
+		got, err := newCore(repo, cfg).ClaimLint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []entity.LintTarget{target}, got)
+	})
+	t.Run("complete ranks findings", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.FinishLintMock.Expect(ctx, id, 4, entity.LintDone, []entity.LintFinding{
+			{Offset: 0, Length: 5, Message: "Spelling", Rule: "MORFOLOGIK_RULE_EN_US", Category: "TYPOS", Severity: entity.LintError,
+				Replacements: []string{"This"}},
+			{Offset: 6, Length: 3, Message: "Agreement", Rule: "THIS_NNS", Category: "GRAMMAR", Severity: entity.LintWarning},
+			{Offset: 10, Length: 5, Message: "Style", Rule: "EN_PLAIN_ENGLISH", Category: "STYLE", Severity: entity.LintInfo},
+		}, now).Return(nil)
+
+		require.NoError(t, newCore(repo, cfg).CompleteLint(ctx, target, matches))
+	})
+	t.Run("complete with default severities", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.FinishLintMock.Set(func(_ context.Context, _ uuid.UUID, _ int, _ entity.LintStatus, findings []entity.LintFinding, _ time.Time) error {
+			require.Len(t, findings, len(matches))
+			for _, f := range findings {
+				require.Equal(t, entity.LintWarning, f.Severity)
+			}
+			return nil
+		})
+
+		require.NoError(t, newCore(repo, Cfg()).CompleteLint(ctx, target, matches))
+	})
+	t.Run("complete repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.FinishLintMock.Return(expErr)
+
+		require.ErrorIs(t, newCore(repo, cfg).CompleteLint(ctx, target, nil), expErr)
+	})
+	t.Run("fail", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.FinishLintMock.Expect(ctx, id, 4, entity.LintFailed, nil, now).Return(nil)
+
+		require.NoError(t, newCore(repo, cfg).FailLint(ctx, target))
+	})
+	t.Run("report never claimed is pending", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetLintReportMock.Expect(ctx, id, 0).Return(entity.LintReport{EntityID: id, Version: 4}, nil)
+
+		got, err := newCore(repo, cfg).GetLintReport(ctx, id, 0)
+		require.NoError(t, err)
+		require.Equal(t, entity.LintReport{EntityID: id, Version: 4, Status: entity.LintPending, Findings: []entity.LintFinding{}}, got)
+	})
+	t.Run("report", func(t *testing.T) {
+		t.Parallel()
+		report := entity.LintReport{EntityID: id, Version: 2, Status: entity.LintDone, CheckedAt: &now,
+			Findings: []entity.LintFinding{{Rule: "THIS_NNS", Severity: entity.LintWarning}}}
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetLintReportMock.Expect(ctx, id, 2).Return(report, nil)
+
+		got, err := newCore(repo, cfg).GetLintReport(ctx, id, 2)
+		require.NoError(t, err)
+		require.Equal(t, report, got)
+	})
+	t.Run("report invalid request", func(t *testing.T) {
+		t.Parallel()
+		c := newCore(mocks.NewRepositoryMock(t), cfg)
+		_, err := c.GetLintReport(ctx, id, -1)
+		require.Equal(t, entity.CodeValidationFailed, apperr.CodeOf(err))
+		_, err = c.GetLintReport(ctx, uuid.Nil, 0)
+		require.Error(t, err)
+	})
+	t.Run("report repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetLintReportMock.Return(entity.LintReport{}, entity.ErrEntityNotFound())
+
+		_, err := newCore(repo, cfg).GetLintReport(ctx, id, 0)
+		require.Equal(t, entity.CodeNotFound, apperr.CodeOf(err))
+	})
+	t.Run("settings", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.SetLintDisabledMock.Expect(ctx, id, true).Return(nil)
+
+		c := newCore(repo, cfg)
+		require.NoError(t, c.SetLintSettings(ctx, id, entity.LintSettings{Disabled: true}))
+		require.Error(t, c.SetLintSettings(ctx, uuid.Nil, entity.LintSettings{}))
+	})
+}
+
+func TestLintConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entity.LintConfig{}.Validate())
+	require.NoError(t, entity.LintConfig{DefaultSeverity: entity.LintOff, Severities: map[string]entity.LintSeverity{"typos": entity.LintError}}.Validate())
+	require.Error(t, entity.LintConfig{DefaultSeverity: "fatal"}.Validate())
+	require.Error(t, entity.LintConfig{Severities: map[string]entity.LintSeverity{"typos": "critical"}}.Validate())
+}
@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultListLimit applies when the flat list is requested without a limit.
+	DefaultListLimit = 50
+	// MaxListLimit caps the page size of the flat list.
+	MaxListLimit = 100
+)
+
+// ListSort is the field the flat list is ordered by. Entries with the same value are ordered by ID.
+type ListSort string
+
+const (
+	ListSortName      ListSort = "name"
+	ListSortUpdatedAt ListSort = "updated_at"
+	ListSortCreatedAt ListSort = "created_at"
+)
+
+func (s ListSort) CheckIsValid() error {
+	switch s {
+	case ListSortName, ListSortUpdatedAt, ListSortCreatedAt:
+		return nil
+	default:
+		return ErrInvalidListSort()
+	}
+}
+
+// ListStatus narrows the flat list to drafts or to published entities. The empty status lists both.
+type ListStatus string
+
+const (
+	ListStatusAny       ListStatus = ""
+	ListStatusDraft     ListStatus = "draft"
+	ListStatusPublished ListStatus = "published"
+)
+
+func (s ListStatus) CheckIsValid() error {
+	switch s {
+	case ListStatusAny, ListStatusDraft, ListStatusPublished:
+		return nil
+	default:
+		return ErrInvalidListStatus()
+	}
+}
+
+// ListCursor is the position of a list entry: its value of the sort field, Name or Time, and its ID.
+type ListCursor struct {
+	Name string
+	Time time.Time
+	ID   uuid.UUID
+}
+
+func newListCursor(entry ListEntry, sort ListSort) ListCursor {
+	switch sort {
+	case ListSortUpdatedAt:
+		return ListCursor{Time: entry.UpdatedAt, ID: entry.ID}
+	case ListSortCreatedAt:
+		return ListCursor{Time: entry.CreatedAt, ID: entry.ID}
+	default:
+		return ListCursor{Name: entry.Name, ID: entry.ID}
+	}
+}
+
+// String encodes the cursor for clients with db.EncodeCursor: the sort value, the name or the time in
+// microseconds since the epoch, and the ID.
+func (c ListCursor) String(sort ListSort) string {
+	if sort == ListSortName {
+		return db.EncodeCursor(c.Name, c.ID.String())
+	}
+	return db.EncodeCursor(strconv.FormatInt(c.Time.UnixMicro(), 10), c.ID.String())
+}
+
+// ParseListCursor reads a cursor encoded by ListCursor.String for the same sort.
+func ParseListCursor(s string, sort ListSort) (ListCursor, error) {
+	values, err := db.DecodeCursor(s, 2)
+	if err != nil {
+		return ListCursor{}, fmt.Errorf("entity.ParseListCursor: %w", err)
+	}
+	id, err := uuid.Parse(values[1])
+	if err != nil {
+		return ListCursor{}, fmt.Errorf("entity.ParseListCursor: %w", db.ErrInvalidCursor())
+	}
+	if sort == ListSortName {
+		return ListCursor{Name: values[0], ID: id}, nil
+	}
+	micros, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return ListCursor{}, fmt.Errorf("entity.ParseListCursor: %w", db.ErrInvalidCursor())
+	}
+
+	return ListCursor{Time: time.UnixMicro(micros).UTC(), ID: id}, nil
+}
+
+// List returns a page of the live entities matching req, with their breadcrumbs. Unless isAdmin only
+// permittedIDs are listed and drafts of other users are skipped.
+func (c *core) List(ctx context.Context, req ListReq, permittedIDs []uuid.UUID, isAdmin bool) (ListPage, error) {
+	if req.Limit <= 0 || req.Limit > MaxListLimit {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", ErrInvalidListLimit(MaxListLimit))
+	}
+	if req.Type != "" {
+		if err := req.Type.CheckIsValid(); err != nil {
+			return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+		}
+	}
+	if req.ParentID != nil && *req.ParentID == uuid.Nil {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", apperr.ErrNilUUID(FieldParentID))
+	}
+	if req.AuthorID != nil && *req.AuthorID == uuid.Nil {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if err := req.Status.CheckIsValid(); err != nil {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+	}
+	if req.Sort == "" {
+		req.Sort = ListSortName
+	}
+	if err := req.Sort.CheckIsValid(); err != nil {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+	}
+
+	filter := ListFilter{
+		Type: req.Type, ParentID: req.ParentID, UpdatedSince: req.UpdatedSince, AuthorID: req.AuthorID,
+		Status: req.Status, Sort: req.Sort, Desc: req.Desc,
+	}
+	if req.After != "" {
+		cursor, err := ParseListCursor(req.After, req.Sort)
+		if err != nil {
+			return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+		}
+		filter.After = &cursor
+	}
+	if !isAdmin {
+		if len(permittedIDs) == 0 {
+			return ListPage{Items: []ListEntry{}}, nil
+		}
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+		}
+		filter.IDs, filter.UserID = permittedIDs, &uid
+	}
+
+	// one extra row tells whether another page exists
+	entries, err := c.repo.List(ctx, filter, req.Limit+1)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("entity.core.List: %w", err)
+	}
+	page := ListPage{Items: entries}
+	if len(entries) > req.Limit {
+		page.Items = entries[:req.Limit]
+		page.NextCursor = newListCursor(page.Items[req.Limit-1], req.Sort).String(req.Sort)
+	}
+
+	return page, nil
+}
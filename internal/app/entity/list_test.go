@@ -0,0 +1,173 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListCursor(t *testing.T) {
+	t.Parallel()
+
+	byName := entity.ListCursor{Name: "Q3 plan. Draft", ID: uuid.New()}
+	got, err := entity.ParseListCursor(byName.String(entity.ListSortName), entity.ListSortName)
+	require.NoError(t, err)
+	require.Equal(t, byName, got)
+
+	byTime := entity.ListCursor{Time: time.Date(2025, 9, 22, 10, 0, 0, 123456000, time.UTC), ID: uuid.New()}
+	got, err = entity.ParseListCursor(byTime.String(entity.ListSortUpdatedAt), entity.ListSortUpdatedAt)
+	require.NoError(t, err)
+	require.Equal(t, byTime, got)
+
+	for _, in := range []string{"", "!", db.EncodeCursor("1"), db.EncodeCursor("1", "x"), db.EncodeCursor("x", uuid.NewString())} {
+		_, err = entity.ParseListCursor(in, entity.ListSortCreatedAt)
+		require.ErrorIs(t, err, db.ErrInvalidCursor(), in)
+	}
+	_, err = entity.ParseListCursor(db.EncodeCursor("a"), entity.ListSortName)
+	require.ErrorIs(t, err, db.ErrInvalidCursor())
+}
+
+func TestCore_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.New()
+		ctx       = contextx.SetUserID(context.Background(), userID)
+		parentID  = uuid.New()
+		permitted = []uuid.UUID{uuid.New(), uuid.New()}
+		now       = time.Now().UTC().Truncate(time.Microsecond)
+		entries   = []entity.ListEntry{
+			{ListItem: entity.ListItem{ID: permitted[0], Name: "a"}, UpdatedAt: now},
+			{ListItem: entity.ListItem{ID: permitted[1], Name: "b"}, UpdatedAt: now.Add(-time.Minute)},
+		}
+		after  = entity.ListCursor{Time: now.Add(time.Minute), ID: uuid.New()}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		req       entity.ListReq
+		permitted []uuid.UUID
+		isAdmin   bool
+		setup     func(repo *mocks.RepositoryMock)
+		want      entity.ListPage
+		err       error
+	}{
+		{
+			name:      "success/user, last page sorted by name",
+			ctx:       ctx,
+			req:       entity.ListReq{Type: entity.TypeArticle, ParentID: &parentID, Status: entity.ListStatusDraft, Limit: 2},
+			permitted: permitted,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ListMock.Expect(ctx, entity.ListFilter{
+					Type: entity.TypeArticle, ParentID: &parentID, Status: entity.ListStatusDraft, Sort: entity.ListSortName,
+					IDs: permitted, UserID: &userID,
+				}, 3).Return(entries, nil)
+			},
+			want: entity.ListPage{Items: entries},
+		},
+		{
+			name:    "success/admin, more pages",
+			ctx:     context.Background(),
+			req:     entity.ListReq{Sort: entity.ListSortUpdatedAt, Desc: true, After: after.String(entity.ListSortUpdatedAt), Limit: 1},
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ListMock.Expect(context.Background(), entity.ListFilter{
+					Sort: entity.ListSortUpdatedAt, Desc: true, After: &after,
+				}, 2).Return(entries, nil)
+			},
+			want: entity.ListPage{
+				Items:      entries[:1],
+				NextCursor: entity.ListCursor{Time: now, ID: permitted[0]}.String(entity.ListSortUpdatedAt),
+			},
+		},
+		{
+			name: "success/nothing readable",
+			ctx:  ctx,
+			req:  entity.ListReq{Limit: 2},
+			want: entity.ListPage{Items: []entity.ListEntry{}},
+		},
+		{
+			name: "error/limit",
+			ctx:  ctx,
+			req:  entity.ListReq{Limit: entity.MaxListLimit + 1},
+			err:  entity.ErrInvalidListLimit(entity.MaxListLimit),
+		},
+		{
+			name: "error/type",
+			ctx:  ctx,
+			req:  entity.ListReq{Type: "folder", Limit: 2},
+			err:  entity.ErrInvalidType(),
+		},
+		{
+			name: "error/nil_parent_id",
+			ctx:  ctx,
+			req:  entity.ListReq{ParentID: &uuid.Nil, Limit: 2},
+			err:  apperr.ErrNilUUID(entity.FieldParentID),
+		},
+		{
+			name: "error/status",
+			ctx:  ctx,
+			req:  entity.ListReq{Status: "archived", Limit: 2},
+			err:  entity.ErrInvalidListStatus(),
+		},
+		{
+			name: "error/sort",
+			ctx:  ctx,
+			req:  entity.ListReq{Sort: "views", Limit: 2},
+			err:  entity.ErrInvalidListSort(),
+		},
+		{
+			name: "error/cursor of another sort",
+			ctx:  ctx,
+			req:  entity.ListReq{Sort: entity.ListSortCreatedAt, After: entity.ListCursor{Name: "a", ID: uuid.New()}.String(entity.ListSortName), Limit: 2},
+			err:  db.ErrInvalidCursor(),
+		},
+		{
+			name:      "error/no_user_in_context",
+			ctx:       context.Background(),
+			req:       entity.ListReq{Limit: 2},
+			permitted: permitted,
+			err:       apperr.ErrUnauthorized(),
+		},
+		{
+			name:    "error/repo_error",
+			ctx:     ctx,
+			req:     entity.ListReq{Limit: 2},
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ListMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.List(tt.ctx, tt.req, tt.permitted, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ManualFormat is the document type a manual is rendered to.
+type ManualFormat string
+
+const (
+	ManualFormatHTML ManualFormat = "html"
+	ManualFormatPDF  ManualFormat = "pdf"
+)
+
+// ParseManualFormat reads the format of a manual; empty means HTML.
+func ParseManualFormat(s string) (ManualFormat, error) {
+	switch f := ManualFormat(s); f {
+	case "":
+		return ManualFormatHTML, nil
+	case ManualFormatHTML, ManualFormatPDF:
+		return f, nil
+	default:
+		return "", ErrInvalidManualFormat()
+	}
+}
+
+// ManualChapter is an entity of a manual. The root is the title and has no number; its descendants are
+// numbered like "2.1" by their place among their siblings.
+type ManualChapter struct {
+	ID     uuid.UUID
+	Type   Type
+	Name   string
+	Number string
+	// Level is the depth below the root, 0 for the root itself.
+	Level int
+}
+
+// ManualSection is a chapter with its content.
+type ManualSection struct {
+	ManualChapter
+	Content string
+}
+
+// ManualWriter receives a manual: its outline first, then the sections in the order of the outline, a page
+// at a time, so the document can be written out while the rest is still being read.
+type ManualWriter interface {
+	WriteOutline(chapters []ManualChapter) error
+	WriteSections(sections []ManualSection) error
+}
+
+// Manual writes the subtree of rootID as one document, depth-first with children in sibling order. The
+// outline is read like GetTOC, so unless isAdmin, drafts of other users and the entities below them are
+// left out; the content follows ExportPageSize entities at a time with the entities among permittedIDs it
+// includes resolved and its variables expanded. Entities deleted meanwhile are skipped.
+func (c *core) Manual(ctx context.Context, rootID uuid.UUID, permittedIDs []uuid.UUID, isAdmin bool, w ManualWriter) error {
+	toc, err := c.GetTOC(ctx, rootID, isAdmin)
+	if err != nil {
+		return fmt.Errorf("entity.core.Manual: %w", err)
+	}
+	chapters := NumberChapters(toc)
+	if err = w.WriteOutline(chapters); err != nil {
+		return fmt.Errorf("entity.core.Manual: %w", err)
+	}
+
+	for page := range slices.Chunk(chapters, ExportPageSize) {
+		if err = ctx.Err(); err != nil {
+			return fmt.Errorf("entity.core.Manual: %w", err)
+		}
+		entities, err := c.repo.GetMany(ctx, lo.Map(page, func(ch ManualChapter, _ int) uuid.UUID { return ch.ID }))
+		if err != nil {
+			return fmt.Errorf("entity.core.Manual: %w", err)
+		}
+		contents, err := c.ResolveIncludes(ctx, lo.SliceToMap(entities, func(e Entity) (uuid.UUID, string) { return e.ID, e.Content }), permittedIDs, isAdmin)
+		if err != nil {
+			return fmt.Errorf("entity.core.Manual: %w", err)
+		}
+		if contents, err = c.ExpandVariables(ctx, contents); err != nil {
+			return fmt.Errorf("entity.core.Manual: %w", err)
+		}
+
+		sections := make([]ManualSection, 0, len(page))
+		for _, ch := range page {
+			if content, ok := contents[ch.ID]; ok {
+				sections = append(sections, ManualSection{ManualChapter: ch, Content: content})
+			}
+		}
+		if err = w.WriteSections(sections); err != nil {
+			return fmt.Errorf("entity.core.Manual: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NumberChapters flattens an outline depth-first and numbers its entities below the root.
+func NumberChapters(root *TOCNode) []ManualChapter {
+	var chapters []ManualChapter
+	var walk func(node *TOCNode, number string, level int)
+	walk = func(node *TOCNode, number string, level int) {
+		chapters = append(chapters, ManualChapter{ID: node.ID, Type: node.Type, Name: node.Name, Number: number, Level: level})
+		for i, child := range node.Children {
+			n := strconv.Itoa(i + 1)
+			if number != "" {
+				n = number + "." + n
+			}
+			walk(child, n, level+1)
+		}
+	}
+	if root != nil {
+		walk(root, "", 0)
+	}
+
+	return chapters
+}
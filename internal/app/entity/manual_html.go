@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// manualStyle lays the manual out for reading and printing: every chapter starts a new page.
+const manualStyle = `body{font-family:sans-serif;line-height:1.5;max-width:50em;margin:0 auto;padding:1em}
+nav ol{list-style:none;padding:0}
+nav a{text-decoration:none;color:inherit}
+section.chapter{break-before:page}
+pre{white-space:pre-wrap}
+img{max-width:100%}
+@page{margin:2cm}
+`
+
+var (
+	// wikiLink matches the wiki-style internal links of linkPattern with their optional label.
+	wikiLink = regexp.MustCompile(`\[\[([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})(?:\|([^\]]*))?\]\]`)
+	// entityHref matches a rendered link to a URL containing /entities/<entity_id>.
+	entityHref = regexp.MustCompile(`href="[^"]*/entities/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})[^"]*"`)
+	// markdownSpecial are the characters escaped in text put into Markdown.
+	markdownSpecial = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`", `<`, `\<`)
+)
+
+// HTMLSanitizer removes the markup the sanitize policy does not allow from HTML.
+type HTMLSanitizer interface {
+	SanitizeHTML(html string) string
+}
+
+// HTMLManual renders a manual as one HTML page styled for print: the root as the title, a table of
+// contents and the numbered chapters with their content rendered from Markdown, which may hold HTML,
+// and sanitized. Internal links to entities of the manual point to their chapter.
+type HTMLManual struct {
+	w         io.Writer
+	sanitizer HTMLSanitizer
+	chapters  map[uuid.UUID]ManualChapter
+}
+
+func NewHTMLManual(w io.Writer, sanitizer HTMLSanitizer) *HTMLManual {
+	return &HTMLManual{w: w, sanitizer: sanitizer}
+}
+
+// WriteOutline starts the document with the table of contents.
+func (m *HTMLManual) WriteOutline(chapters []ManualChapter) error {
+	m.chapters = make(map[uuid.UUID]ManualChapter, len(chapters))
+	title := ""
+	for _, ch := range chapters {
+		m.chapters[ch.ID] = ch
+		if ch.Level == 0 {
+			title = ch.Name
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s</style>\n</head>\n<body>\n",
+		html.EscapeString(title), manualStyle)
+	b.WriteString("<nav>\n<h2>Contents</h2>\n<ol>\n")
+	for _, ch := range chapters {
+		if ch.Level == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "<li style=\"padding-left:%.1fem\"><a href=\"#%s\">%s</a></li>\n",
+			1.5*float64(ch.Level-1), chapterAnchor(ch.ID), html.EscapeString(chapterTitle(ch)))
+	}
+	b.WriteString("</ol>\n</nav>\n")
+
+	_, err := io.WriteString(m.w, b.String())
+	return err
+}
+
+// WriteSections writes the chapters: the root under the title heading, the others under a heading of
+// their level, h2 for the children of the root down to h6.
+func (m *HTMLManual) WriteSections(sections []ManualSection) error {
+	var b strings.Builder
+	for _, s := range sections {
+		level := min(s.Level+1, 6)
+		class := "chapter"
+		if s.Level == 0 {
+			class = "title"
+		}
+		fmt.Fprintf(&b, "<section class=\"%s\" id=\"%s\">\n<h%d>%s</h%d>\n", class, chapterAnchor(s.ID), level,
+			html.EscapeString(chapterTitle(s.ManualChapter)), level)
+		b.Write(m.render(s.Content))
+		b.WriteString("</section>\n")
+	}
+
+	_, err := io.WriteString(m.w, b.String())
+	return err
+}
+
+// Close ends the document.
+func (m *HTMLManual) Close() error {
+	_, err := io.WriteString(m.w, "</body>\n</html>\n")
+	return err
+}
+
+// render turns content into HTML with its internal links resolved: wiki links become links to the
+// chapter, or their label if the entity is not in the manual, and URLs of entities in the manual are
+// replaced by their chapter.
+func (m *HTMLManual) render(content string) []byte {
+	content = wikiLink.ReplaceAllStringFunc(content, func(link string) string {
+		match := wikiLink.FindStringSubmatch(link)
+		id, _ := uuid.Parse(match[1])
+		label := match[2]
+		ch, ok := m.chapters[id]
+		if !ok {
+			if label == "" {
+				return match[1]
+			}
+			return label
+		}
+		if label == "" {
+			label = markdownSpecial.Replace(chapterTitle(ch))
+		}
+		return fmt.Sprintf("[%s](#%s)", label, chapterAnchor(id))
+	})
+
+	out := []byte(m.sanitizer.SanitizeHTML(MarkdownToHTML(content)))
+
+	return entityHref.ReplaceAllFunc(out, func(href []byte) []byte {
+		id, err := uuid.Parse(string(entityHref.FindSubmatch(href)[1]))
+		if err != nil {
+			return href
+		}
+		if _, ok := m.chapters[id]; !ok {
+			return href
+		}
+		return []byte(`href="#` + chapterAnchor(id) + `"`)
+	})
+}
+
+func chapterAnchor(id uuid.UUID) string {
+	return "chapter-" + id.String()
+}
+
+// chapterTitle is the name of the chapter after its number.
+func chapterTitle(ch ManualChapter) string {
+	if ch.Number == "" {
+		return ch.Name
+	}
+	return ch.Number + " " + ch.Name
+}
@@ -0,0 +1,196 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManualFormat(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]entity.ManualFormat{"": entity.ManualFormatHTML, "html": entity.ManualFormatHTML, "pdf": entity.ManualFormatPDF} {
+		got, err := entity.ParseManualFormat(in)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := entity.ParseManualFormat("docx")
+	require.ErrorIs(t, err, entity.ErrInvalidManualFormat())
+}
+
+func TestNumberChapters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID = uuid.New()
+		a      = uuid.New()
+		a1     = uuid.New()
+		a2     = uuid.New()
+		b      = uuid.New()
+	)
+	root := &entity.TOCNode{ID: rootID, Name: "Guide", Children: []*entity.TOCNode{
+		{ID: a, Name: "Setup", Children: []*entity.TOCNode{{ID: a1, Name: "Install"}, {ID: a2, Name: "Configure"}}},
+		{ID: b, Name: "Usage"},
+	}}
+
+	require.Equal(t, []entity.ManualChapter{
+		{ID: rootID, Name: "Guide"},
+		{ID: a, Name: "Setup", Number: "1", Level: 1},
+		{ID: a1, Name: "Install", Number: "1.1", Level: 2},
+		{ID: a2, Name: "Configure", Number: "1.2", Level: 2},
+		{ID: b, Name: "Usage", Number: "2", Level: 1},
+	}, entity.NumberChapters(root))
+	require.Empty(t, entity.NumberChapters(nil))
+}
+
+// manualRecorder keeps what a manual writer is given.
+type manualRecorder struct {
+	outline []entity.ManualChapter
+	pages   [][]entity.ManualSection
+}
+
+func (r *manualRecorder) WriteOutline(chapters []entity.ManualChapter) error {
+	r.outline = chapters
+	return nil
+}
+
+func (r *manualRecorder) WriteSections(sections []entity.ManualSection) error {
+	r.pages = append(r.pages, sections)
+	return nil
+}
+
+func TestCore_Manual(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.New()
+		ctx     = contextx.SetUserID(t.Context(), userID)
+		rootID  = uuid.New()
+		childID = uuid.New()
+		goneID  = uuid.New()
+		expErr  = fmt.Errorf("test error")
+	)
+	page := []entity.ExportItem{
+		{ID: rootID, Type: entity.TypeDepartment, Name: "Guide", Depth: 1},
+		{ID: childID, ParentID: &rootID, Type: entity.TypeArticle, Name: "Setup", Depth: 2},
+		{ID: goneID, ParentID: &rootID, Type: entity.TypeArticle, Name: "Old", SortOrder: 1, Depth: 2},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetExportPageMock.Expect(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, &userID).Return(page, nil)
+		// goneID was deleted after the outline was read
+		repo.GetManyMock.Expect(ctx, []uuid.UUID{rootID, goneID, childID}).Return([]entity.Entity{
+			{ID: childID, Content: "install {{product}}"}, {ID: rootID, Content: "intro"},
+		}, nil)
+		repo.GetScopeVariablesMock.Set(func(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]entity.Variables, error) {
+			require.ElementsMatch(t, []uuid.UUID{rootID, childID}, ids)
+			return map[uuid.UUID]entity.Variables{childID: {"product": "EasyGoDocs"}}, nil
+		})
+
+		var rec manualRecorder
+		require.NoError(t, c.Manual(ctx, rootID, []uuid.UUID{rootID}, false, &rec))
+		require.Equal(t, []entity.ManualChapter{
+			{ID: rootID, Type: entity.TypeDepartment, Name: "Guide"},
+			{ID: goneID, Type: entity.TypeArticle, Name: "Old", Number: "1", Level: 1},
+			{ID: childID, Type: entity.TypeArticle, Name: "Setup", Number: "2", Level: 1},
+		}, rec.outline)
+		require.Equal(t, [][]entity.ManualSection{{
+			{ManualChapter: rec.outline[0], Content: "intro"},
+			{ManualChapter: rec.outline[2], Content: "install EasyGoDocs"},
+		}}, rec.pages)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetExportPageMock.Expect(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, nil).Return(page, nil)
+		repo.GetManyMock.Return(nil, expErr)
+
+		var rec manualRecorder
+		require.ErrorIs(t, c.Manual(ctx, rootID, nil, true, &rec), expErr)
+		require.Empty(t, rec.pages)
+	})
+}
+
+func TestHTMLManual(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID    = uuid.New()
+		childID   = uuid.New()
+		outsideID = uuid.New()
+	)
+	chapters := []entity.ManualChapter{
+		{ID: rootID, Name: "Guide & more"},
+		{ID: childID, Name: "Setup", Number: "1", Level: 1},
+	}
+
+	var b strings.Builder
+	m := entity.NewHTMLManual(&b, defaultSanitizer(t))
+	require.NoError(t, m.WriteOutline(chapters))
+	require.NoError(t, m.WriteSections([]entity.ManualSection{
+		{ManualChapter: chapters[0], Content: fmt.Sprintf("See [[%s]], [[%s|the old page]] and [setup](/entities/%s).", childID, outsideID, childID)},
+		{ManualChapter: chapters[1], Content: "**Run** it."},
+	}))
+	require.NoError(t, m.Close())
+	out := b.String()
+
+	require.Contains(t, out, "<title>Guide &amp; more</title>")
+	require.Contains(t, out, fmt.Sprintf(`<a href="#chapter-%s">1 Setup</a>`, childID))
+	require.Contains(t, out, fmt.Sprintf(`<section class="title" id="chapter-%s">`+"\n<h1>Guide &amp; more</h1>", rootID))
+	require.Contains(t, out, fmt.Sprintf(`<section class="chapter" id="chapter-%s">`+"\n<h2>1 Setup</h2>", childID))
+	require.Contains(t, out, fmt.Sprintf(`See <a href="#chapter-%s">1 Setup</a>, the old page and <a href="#chapter-%s">setup</a>.`, childID, childID))
+	require.Contains(t, out, "<strong>Run</strong> it.")
+	require.True(t, strings.HasSuffix(out, "</html>\n"))
+}
+
+func defaultSanitizer(t *testing.T) *sanitize.Policy {
+	t.Helper()
+	p, err := sanitize.New(sanitize.Config{
+		AllowedTags:       sanitize.DefaultAllowedTags,
+		AllowedAttributes: sanitize.DefaultAllowedAttributes,
+		AllowedSchemes:    sanitize.DefaultAllowedSchemes,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestMarkdownToHTML_Sanitized(t *testing.T) {
+	t.Parallel()
+
+	p := defaultSanitizer(t)
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "link/tab", in: "[x](java\tscript:alert\\(document.domain\\))", want: "<p>x</p>\n"},
+		{name: "link/newline", in: "[x](java\nscript:alert\\(document.domain\\))", want: "<p>x</p>\n"},
+		{name: "image/tab", in: "![x](java\tscript:alert\\(document.domain\\))", want: "<p><img alt=\"x\"/></p>\n"},
+		{name: "raw_html", in: "<img src=x onerror=alert(1)>", want: "<p><img src=\"x\"></p>\n"},
+		{name: "safe_link", in: "[x](https://example.com)", want: "<p><a href=\"https://example.com\">x</a></p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := p.SanitizeHTML(entity.MarkdownToHTML(tt.in))
+			require.NotContains(t, strings.ToLower(got), "script:")
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
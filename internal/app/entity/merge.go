@@ -0,0 +1,212 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+// Conflict markers around the regions both sides changed differently, mine first.
+const (
+	ConflictMarkerMine   = "<<<<<<< mine\n"
+	ConflictMarkerSep    = "=======\n"
+	ConflictMarkerTheirs = ">>>>>>> theirs\n"
+)
+
+// maxMergeCells bounds the table lines are matched with. Past it the lines between the common prefix
+// and suffix are taken as changed as a whole, which only makes conflicts coarser.
+const maxMergeCells = 4 << 20
+
+// MergeReq asks to merge Mine, edited from BaseVersion, with theirs: the version TheirsVersion, or
+// the current content if it is nil.
+type MergeReq struct {
+	ID            uuid.UUID
+	BaseVersion   int
+	Mine          string
+	TheirsVersion *int
+}
+
+// MergeResult is the merged content. TheirsVersion is the version merged with, nil for a draft.
+type MergeResult struct {
+	Content       string `json:"content"`
+	Conflicts     int    `json:"conflicts"`
+	TheirsVersion *int   `json:"theirs_version,omitempty"`
+}
+
+// Merge merges two edits of the content with MergeText. Nothing is stored.
+func (c *core) Merge(ctx context.Context, req MergeReq) (MergeResult, error) {
+	if req.ID == uuid.Nil {
+		return MergeResult{}, fmt.Errorf("entity.core.Merge: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.BaseVersion <= 0 || (req.TheirsVersion != nil && *req.TheirsVersion <= 0) {
+		return MergeResult{}, fmt.Errorf("entity.core.Merge: %w", ErrInvalidVersion())
+	}
+	base, err := c.repo.GetVersion(ctx, req.ID, req.BaseVersion)
+	if err != nil {
+		return MergeResult{}, fmt.Errorf("entity.core.Merge: %w", err)
+	}
+	var (
+		theirs        Entity
+		theirsVersion = req.TheirsVersion
+	)
+	if theirsVersion != nil {
+		theirs, err = c.repo.GetVersion(ctx, req.ID, *theirsVersion)
+	} else {
+		theirs, err = c.repo.Get(ctx, req.ID)
+		theirsVersion = theirs.CurrentVersion
+	}
+	if err != nil {
+		return MergeResult{}, fmt.Errorf("entity.core.Merge: %w", err)
+	}
+
+	content, conflicts := MergeText(base.Content, req.Mine, theirs.Content)
+
+	return MergeResult{Content: content, Conflicts: conflicts, TheirsVersion: theirsVersion}, nil
+}
+
+// MergeText merges the changes mine and theirs made to base line by line, like diff3. A region only
+// one side changed takes that change, and so does one both changed alike; the others are written
+// between conflict markers and counted.
+func MergeText(base, mine, theirs string) (string, int) {
+	o, a, b := splitLines(base), splitLines(mine), splitLines(theirs)
+	toA, toB := matchLines(o, a), matchLines(o, b)
+
+	var (
+		out       strings.Builder
+		conflicts int
+		i, j, k   int
+	)
+	write := func(lines []string) {
+		for _, line := range lines {
+			out.WriteString(line)
+		}
+	}
+	for i < len(o) || j < len(a) || k < len(b) {
+		// lines kept by both sides
+		n := 0
+		for i+n < len(o) && toA[i+n] == j+n && toB[i+n] == k+n {
+			n++
+		}
+		if n > 0 {
+			write(o[i : i+n])
+			i, j, k = i+n, j+n, k+n
+			continue
+		}
+
+		// the next base line both sides kept closes the region they changed
+		next, nextA, nextB := i, len(a), len(b)
+		for ; next < len(o); next++ {
+			if toA[next] >= 0 && toB[next] >= 0 {
+				nextA, nextB = toA[next], toB[next]
+				break
+			}
+		}
+		chunkO, chunkA, chunkB := o[i:next], a[j:nextA], b[k:nextB]
+		switch {
+		case equalLines(chunkA, chunkO):
+			write(chunkB)
+		case equalLines(chunkB, chunkO), equalLines(chunkA, chunkB):
+			write(chunkA)
+		default:
+			conflicts++
+			out.WriteString(ConflictMarkerMine)
+			writeBlock(&out, chunkA)
+			out.WriteString(ConflictMarkerSep)
+			writeBlock(&out, chunkB)
+			out.WriteString(ConflictMarkerTheirs)
+		}
+		i, j, k = next, nextA, nextB
+	}
+
+	return out.String(), conflicts
+}
+
+// writeBlock writes lines inside conflict markers, which must start on a line of their own.
+func writeBlock(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		out.WriteByte('\n')
+	}
+}
+
+// splitLines splits s after each line break, so joining the lines gives s back.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchLines pairs the lines of a with lines of b along a longest common subsequence. It returns the
+// index in b of every line of a, or -1 for the lines b does not keep.
+func matchLines(a, b []string) []int {
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		match[prefix] = prefix
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		match[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	n, m := len(midA), len(midB)
+	if n == 0 || m == 0 || (n+1)*(m+1) > maxMergeCells {
+		return match
+	}
+	// lcs[x*(m+1)+y] is the length of the longest common subsequence of midA[x:] and midB[y:]
+	lcs := make([]int32, (n+1)*(m+1))
+	for x := n - 1; x >= 0; x-- {
+		for y := m - 1; y >= 0; y-- {
+			switch {
+			case midA[x] == midB[y]:
+				lcs[x*(m+1)+y] = lcs[(x+1)*(m+1)+y+1] + 1
+			case lcs[(x+1)*(m+1)+y] >= lcs[x*(m+1)+y+1]:
+				lcs[x*(m+1)+y] = lcs[(x+1)*(m+1)+y]
+			default:
+				lcs[x*(m+1)+y] = lcs[x*(m+1)+y+1]
+			}
+		}
+	}
+	for x, y := 0, 0; x < n && y < m; {
+		switch {
+		case midA[x] == midB[y]:
+			match[prefix+x] = prefix + y
+			x++
+			y++
+		case lcs[(x+1)*(m+1)+y] >= lcs[x*(m+1)+y+1]:
+			x++
+		default:
+			y++
+		}
+	}
+
+	return match
+}
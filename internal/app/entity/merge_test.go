@@ -0,0 +1,218 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeText(t *testing.T) {
+	t.Parallel()
+
+	const base = "one\ntwo\nthree\nfour\n"
+
+	tests := []struct {
+		name      string
+		base      string
+		mine      string
+		theirs    string
+		want      string
+		conflicts int
+	}{
+		{
+			name:   "unchanged",
+			base:   base,
+			mine:   base,
+			theirs: base,
+			want:   base,
+		},
+		{
+			name:   "only_mine",
+			base:   base,
+			mine:   "one\n2\nthree\nfour\n",
+			theirs: base,
+			want:   "one\n2\nthree\nfour\n",
+		},
+		{
+			name:   "only_theirs",
+			base:   base,
+			mine:   base,
+			theirs: "one\ntwo\nthree\n",
+			want:   "one\ntwo\nthree\n",
+		},
+		{
+			name:   "both/apart",
+			base:   base,
+			mine:   "zero\none\ntwo\nthree\nfour\n",
+			theirs: "one\ntwo\nthree\n4\n",
+			want:   "zero\none\ntwo\nthree\n4\n",
+		},
+		{
+			name:   "both/same_change",
+			base:   base,
+			mine:   "one\n2\nthree\nfour\n",
+			theirs: "one\n2\nthree\nfour\n",
+			want:   "one\n2\nthree\nfour\n",
+		},
+		{
+			name:   "conflict",
+			base:   base,
+			mine:   "one\n2\nthree\n4\n",
+			theirs: "one\nTwo\nthree\nfour\n",
+			want: "one\n" + entity.ConflictMarkerMine + "2\n" + entity.ConflictMarkerSep + "Two\n" + entity.ConflictMarkerTheirs +
+				"three\n4\n",
+			conflicts: 1,
+		},
+		{
+			name:   "conflict/delete_and_edit",
+			base:   base,
+			mine:   "one\nthree\nfour\n",
+			theirs: "one\nTwo\nthree\nfour\n",
+			want: "one\n" + entity.ConflictMarkerMine + entity.ConflictMarkerSep + "Two\n" + entity.ConflictMarkerTheirs +
+				"three\nfour\n",
+			conflicts: 1,
+		},
+		{
+			name:      "conflict/no_final_newline",
+			base:      "a",
+			mine:      "b",
+			theirs:    "c",
+			want:      entity.ConflictMarkerMine + "b\n" + entity.ConflictMarkerSep + "c\n" + entity.ConflictMarkerTheirs,
+			conflicts: 1,
+		},
+		{
+			name:      "empty_base",
+			base:      "",
+			mine:      "mine\n",
+			theirs:    "theirs\n",
+			want:      entity.ConflictMarkerMine + "mine\n" + entity.ConflictMarkerSep + "theirs\n" + entity.ConflictMarkerTheirs,
+			conflicts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, conflicts := entity.MergeText(tt.base, tt.mine, tt.theirs)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.conflicts, conflicts)
+		})
+	}
+
+	t.Run("large", func(t *testing.T) {
+		t.Parallel()
+		lines := make([]string, 3000)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("line %d\n", i)
+		}
+		base := strings.Join(lines, "")
+		mine := strings.Replace(base, "line 10\n", "line ten\n", 1)
+		theirs := strings.Replace(base, "line 2990\n", "line 2990\nadded\n", 1)
+
+		got, conflicts := entity.MergeText(base, mine, theirs)
+		require.Zero(t, conflicts)
+		require.Equal(t, strings.Replace(theirs, "line 10\n", "line ten\n", 1), got)
+	})
+}
+
+func TestCore_Merge(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		id      = uuid.New()
+		current = 3
+		older   = 2
+		base    = entity.Entity{ID: id, Content: "a\nb\nc\n"}
+		theirs  = entity.Entity{ID: id, Content: "a\nb\nC\n", CurrentVersion: &current}
+		expErr  = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		req   entity.MergeReq
+		setup func(repo *mocks.RepositoryMock)
+		want  entity.MergeResult
+		err   error
+	}{
+		{
+			name: "success/current",
+			req:  entity.MergeReq{ID: id, BaseVersion: 1, Mine: "A\nb\nc\n"},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionMock.Expect(ctx, id, 1).Return(base, nil)
+				repo.GetMock.Expect(ctx, id).Return(theirs, nil)
+			},
+			want: entity.MergeResult{Content: "A\nb\nC\n", TheirsVersion: &current},
+		},
+		{
+			name: "success/version",
+			req:  entity.MergeReq{ID: id, BaseVersion: 1, Mine: "a\nB\nc\n", TheirsVersion: &older},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionMock.When(ctx, id, 1).Then(base, nil)
+				repo.GetVersionMock.When(ctx, id, older).Then(entity.Entity{Content: "a\nbee\nc\n"}, nil)
+			},
+			want: entity.MergeResult{
+				Content:       "a\n" + entity.ConflictMarkerMine + "B\n" + entity.ConflictMarkerSep + "bee\n" + entity.ConflictMarkerTheirs + "c\n",
+				Conflicts:     1,
+				TheirsVersion: &older,
+			},
+		},
+		{
+			name: "error/nil_id",
+			req:  entity.MergeReq{BaseVersion: 1},
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/invalid_base_version",
+			req:  entity.MergeReq{ID: id},
+			err:  entity.ErrInvalidVersion(),
+		},
+		{
+			name: "error/invalid_theirs_version",
+			req:  entity.MergeReq{ID: id, BaseVersion: 1, TheirsVersion: new(int)},
+			err:  entity.ErrInvalidVersion(),
+		},
+		{
+			name: "error/base",
+			req:  entity.MergeReq{ID: id, BaseVersion: 1},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionMock.Expect(ctx, id, 1).Return(entity.Entity{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/theirs",
+			req:  entity.MergeReq{ID: id, BaseVersion: 1},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetVersionMock.Expect(ctx, id, 1).Return(base, nil)
+				repo.GetMock.Expect(ctx, id).Return(entity.Entity{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.Merge(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
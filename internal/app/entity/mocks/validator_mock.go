@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -9,7 +9,9 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	mm_entity "github.com/66gu1/easygodocs/internal/app/entity"
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 )
 
 // ValidatorMock implements mm_entity.Validator
@@ -24,12 +26,33 @@ type ValidatorMock struct {
 	beforeNormalizeNameCounter uint64
 	NormalizeNameMock          mValidatorMockNormalizeName
 
-	funcValidateName          func(name string) (err error)
+	funcValidateContent          func(entityType mm_entity.Type, content string) (c1 mm_entity.ContentUsage, err error)
+	funcValidateContentOrigin    string
+	inspectFuncValidateContent   func(entityType mm_entity.Type, content string)
+	afterValidateContentCounter  uint64
+	beforeValidateContentCounter uint64
+	ValidateContentMock          mValidatorMockValidateContent
+
+	funcValidateLinks          func(entityType mm_entity.Type, broken []uuid.UUID) (err error)
+	funcValidateLinksOrigin    string
+	inspectFuncValidateLinks   func(entityType mm_entity.Type, broken []uuid.UUID)
+	afterValidateLinksCounter  uint64
+	beforeValidateLinksCounter uint64
+	ValidateLinksMock          mValidatorMockValidateLinks
+
+	funcValidateName          func(entityType mm_entity.Type, name string) (err error)
 	funcValidateNameOrigin    string
-	inspectFuncValidateName   func(name string)
+	inspectFuncValidateName   func(entityType mm_entity.Type, name string)
 	afterValidateNameCounter  uint64
 	beforeValidateNameCounter uint64
 	ValidateNameMock          mValidatorMockValidateName
+
+	funcValidateSummary          func(summary string) (err error)
+	funcValidateSummaryOrigin    string
+	inspectFuncValidateSummary   func(summary string)
+	afterValidateSummaryCounter  uint64
+	beforeValidateSummaryCounter uint64
+	ValidateSummaryMock          mValidatorMockValidateSummary
 }
 
 // NewValidatorMock returns a mock for mm_entity.Validator
@@ -43,9 +66,18 @@ func NewValidatorMock(t minimock.Tester) *ValidatorMock {
 	m.NormalizeNameMock = mValidatorMockNormalizeName{mock: m}
 	m.NormalizeNameMock.callArgs = []*ValidatorMockNormalizeNameParams{}
 
+	m.ValidateContentMock = mValidatorMockValidateContent{mock: m}
+	m.ValidateContentMock.callArgs = []*ValidatorMockValidateContentParams{}
+
+	m.ValidateLinksMock = mValidatorMockValidateLinks{mock: m}
+	m.ValidateLinksMock.callArgs = []*ValidatorMockValidateLinksParams{}
+
 	m.ValidateNameMock = mValidatorMockValidateName{mock: m}
 	m.ValidateNameMock.callArgs = []*ValidatorMockValidateNameParams{}
 
+	m.ValidateSummaryMock = mValidatorMockValidateSummary{mock: m}
+	m.ValidateSummaryMock.callArgs = []*ValidatorMockValidateSummaryParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -362,49 +394,53 @@ func (m *ValidatorMock) MinimockNormalizeNameInspect() {
 	}
 }
 
-type mValidatorMockValidateName struct {
+type mValidatorMockValidateContent struct {
 	optional           bool
 	mock               *ValidatorMock
-	defaultExpectation *ValidatorMockValidateNameExpectation
-	expectations       []*ValidatorMockValidateNameExpectation
+	defaultExpectation *ValidatorMockValidateContentExpectation
+	expectations       []*ValidatorMockValidateContentExpectation
 
-	callArgs []*ValidatorMockValidateNameParams
+	callArgs []*ValidatorMockValidateContentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ValidatorMockValidateNameExpectation specifies expectation struct of the Validator.ValidateName
-type ValidatorMockValidateNameExpectation struct {
+// ValidatorMockValidateContentExpectation specifies expectation struct of the Validator.ValidateContent
+type ValidatorMockValidateContentExpectation struct {
 	mock               *ValidatorMock
-	params             *ValidatorMockValidateNameParams
-	paramPtrs          *ValidatorMockValidateNameParamPtrs
-	expectationOrigins ValidatorMockValidateNameExpectationOrigins
-	results            *ValidatorMockValidateNameResults
+	params             *ValidatorMockValidateContentParams
+	paramPtrs          *ValidatorMockValidateContentParamPtrs
+	expectationOrigins ValidatorMockValidateContentExpectationOrigins
+	results            *ValidatorMockValidateContentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ValidatorMockValidateNameParams contains parameters of the Validator.ValidateName
-type ValidatorMockValidateNameParams struct {
-	name string
+// ValidatorMockValidateContentParams contains parameters of the Validator.ValidateContent
+type ValidatorMockValidateContentParams struct {
+	entityType mm_entity.Type
+	content    string
 }
 
-// ValidatorMockValidateNameParamPtrs contains pointers to parameters of the Validator.ValidateName
-type ValidatorMockValidateNameParamPtrs struct {
-	name *string
+// ValidatorMockValidateContentParamPtrs contains pointers to parameters of the Validator.ValidateContent
+type ValidatorMockValidateContentParamPtrs struct {
+	entityType *mm_entity.Type
+	content    *string
 }
 
-// ValidatorMockValidateNameResults contains results of the Validator.ValidateName
-type ValidatorMockValidateNameResults struct {
+// ValidatorMockValidateContentResults contains results of the Validator.ValidateContent
+type ValidatorMockValidateContentResults struct {
+	c1  mm_entity.ContentUsage
 	err error
 }
 
-// ValidatorMockValidateNameOrigins contains origins of expectations of the Validator.ValidateName
-type ValidatorMockValidateNameExpectationOrigins struct {
-	origin     string
-	originName string
+// ValidatorMockValidateContentOrigins contains origins of expectations of the Validator.ValidateContent
+type ValidatorMockValidateContentExpectationOrigins struct {
+	origin           string
+	originEntityType string
+	originContent    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -412,274 +448,1303 @@ type ValidatorMockValidateNameExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmValidateName *mValidatorMockValidateName) Optional() *mValidatorMockValidateName {
-	mmValidateName.optional = true
-	return mmValidateName
+func (mmValidateContent *mValidatorMockValidateContent) Optional() *mValidatorMockValidateContent {
+	mmValidateContent.optional = true
+	return mmValidateContent
 }
 
-// Expect sets up expected params for Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) Expect(name string) *mValidatorMockValidateName {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+// Expect sets up expected params for Validator.ValidateContent
+func (mmValidateContent *mValidatorMockValidateContent) Expect(entityType mm_entity.Type, content string) *mValidatorMockValidateContent {
+	if mmValidateContent.mock.funcValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Set")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	if mmValidateContent.defaultExpectation == nil {
+		mmValidateContent.defaultExpectation = &ValidatorMockValidateContentExpectation{}
 	}
 
-	if mmValidateName.defaultExpectation.paramPtrs != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by ExpectParams functions")
+	if mmValidateContent.defaultExpectation.paramPtrs != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by ExpectParams functions")
 	}
 
-	mmValidateName.defaultExpectation.params = &ValidatorMockValidateNameParams{name}
-	mmValidateName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmValidateName.expectations {
-		if minimock.Equal(e.params, mmValidateName.defaultExpectation.params) {
-			mmValidateName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateName.defaultExpectation.params)
+	mmValidateContent.defaultExpectation.params = &ValidatorMockValidateContentParams{entityType, content}
+	mmValidateContent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateContent.expectations {
+		if minimock.Equal(e.params, mmValidateContent.defaultExpectation.params) {
+			mmValidateContent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateContent.defaultExpectation.params)
 		}
 	}
 
-	return mmValidateName
+	return mmValidateContent
 }
 
-// ExpectNameParam1 sets up expected param name for Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) ExpectNameParam1(name string) *mValidatorMockValidateName {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+// ExpectEntityTypeParam1 sets up expected param entityType for Validator.ValidateContent
+func (mmValidateContent *mValidatorMockValidateContent) ExpectEntityTypeParam1(entityType mm_entity.Type) *mValidatorMockValidateContent {
+	if mmValidateContent.mock.funcValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Set")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	if mmValidateContent.defaultExpectation == nil {
+		mmValidateContent.defaultExpectation = &ValidatorMockValidateContentExpectation{}
 	}
 
-	if mmValidateName.defaultExpectation.params != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Expect")
+	if mmValidateContent.defaultExpectation.params != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Expect")
 	}
 
-	if mmValidateName.defaultExpectation.paramPtrs == nil {
-		mmValidateName.defaultExpectation.paramPtrs = &ValidatorMockValidateNameParamPtrs{}
+	if mmValidateContent.defaultExpectation.paramPtrs == nil {
+		mmValidateContent.defaultExpectation.paramPtrs = &ValidatorMockValidateContentParamPtrs{}
 	}
-	mmValidateName.defaultExpectation.paramPtrs.name = &name
-	mmValidateName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+	mmValidateContent.defaultExpectation.paramPtrs.entityType = &entityType
+	mmValidateContent.defaultExpectation.expectationOrigins.originEntityType = minimock.CallerInfo(1)
 
-	return mmValidateName
+	return mmValidateContent
 }
 
-// Inspect accepts an inspector function that has same arguments as the Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) Inspect(f func(name string)) *mValidatorMockValidateName {
-	if mmValidateName.mock.inspectFuncValidateName != nil {
-		mmValidateName.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateName")
+// ExpectContentParam2 sets up expected param content for Validator.ValidateContent
+func (mmValidateContent *mValidatorMockValidateContent) ExpectContentParam2(content string) *mValidatorMockValidateContent {
+	if mmValidateContent.mock.funcValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Set")
 	}
 
-	mmValidateName.mock.inspectFuncValidateName = f
+	if mmValidateContent.defaultExpectation == nil {
+		mmValidateContent.defaultExpectation = &ValidatorMockValidateContentExpectation{}
+	}
 
-	return mmValidateName
+	if mmValidateContent.defaultExpectation.params != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Expect")
+	}
+
+	if mmValidateContent.defaultExpectation.paramPtrs == nil {
+		mmValidateContent.defaultExpectation.paramPtrs = &ValidatorMockValidateContentParamPtrs{}
+	}
+	mmValidateContent.defaultExpectation.paramPtrs.content = &content
+	mmValidateContent.defaultExpectation.expectationOrigins.originContent = minimock.CallerInfo(1)
+
+	return mmValidateContent
 }
 
-// Return sets up results that will be returned by Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) Return(err error) *ValidatorMock {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateContent
+func (mmValidateContent *mValidatorMockValidateContent) Inspect(f func(entityType mm_entity.Type, content string)) *mValidatorMockValidateContent {
+	if mmValidateContent.mock.inspectFuncValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateContent")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{mock: mmValidateName.mock}
+	mmValidateContent.mock.inspectFuncValidateContent = f
+
+	return mmValidateContent
+}
+
+// Return sets up results that will be returned by Validator.ValidateContent
+func (mmValidateContent *mValidatorMockValidateContent) Return(c1 mm_entity.ContentUsage, err error) *ValidatorMock {
+	if mmValidateContent.mock.funcValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Set")
 	}
-	mmValidateName.defaultExpectation.results = &ValidatorMockValidateNameResults{err}
-	mmValidateName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmValidateName.mock
+
+	if mmValidateContent.defaultExpectation == nil {
+		mmValidateContent.defaultExpectation = &ValidatorMockValidateContentExpectation{mock: mmValidateContent.mock}
+	}
+	mmValidateContent.defaultExpectation.results = &ValidatorMockValidateContentResults{c1, err}
+	mmValidateContent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateContent.mock
 }
 
-// Set uses given function f to mock the Validator.ValidateName method
-func (mmValidateName *mValidatorMockValidateName) Set(f func(name string) (err error)) *ValidatorMock {
-	if mmValidateName.defaultExpectation != nil {
-		mmValidateName.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateName method")
+// Set uses given function f to mock the Validator.ValidateContent method
+func (mmValidateContent *mValidatorMockValidateContent) Set(f func(entityType mm_entity.Type, content string) (c1 mm_entity.ContentUsage, err error)) *ValidatorMock {
+	if mmValidateContent.defaultExpectation != nil {
+		mmValidateContent.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateContent method")
 	}
 
-	if len(mmValidateName.expectations) > 0 {
-		mmValidateName.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateName method")
+	if len(mmValidateContent.expectations) > 0 {
+		mmValidateContent.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateContent method")
 	}
 
-	mmValidateName.mock.funcValidateName = f
-	mmValidateName.mock.funcValidateNameOrigin = minimock.CallerInfo(1)
-	return mmValidateName.mock
+	mmValidateContent.mock.funcValidateContent = f
+	mmValidateContent.mock.funcValidateContentOrigin = minimock.CallerInfo(1)
+	return mmValidateContent.mock
 }
 
-// When sets expectation for the Validator.ValidateName which will trigger the result defined by the following
+// When sets expectation for the Validator.ValidateContent which will trigger the result defined by the following
 // Then helper
-func (mmValidateName *mValidatorMockValidateName) When(name string) *ValidatorMockValidateNameExpectation {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+func (mmValidateContent *mValidatorMockValidateContent) When(entityType mm_entity.Type, content string) *ValidatorMockValidateContentExpectation {
+	if mmValidateContent.mock.funcValidateContent != nil {
+		mmValidateContent.mock.t.Fatalf("ValidatorMock.ValidateContent mock is already set by Set")
 	}
 
-	expectation := &ValidatorMockValidateNameExpectation{
-		mock:               mmValidateName.mock,
-		params:             &ValidatorMockValidateNameParams{name},
-		expectationOrigins: ValidatorMockValidateNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ValidatorMockValidateContentExpectation{
+		mock:               mmValidateContent.mock,
+		params:             &ValidatorMockValidateContentParams{entityType, content},
+		expectationOrigins: ValidatorMockValidateContentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmValidateName.expectations = append(mmValidateName.expectations, expectation)
+	mmValidateContent.expectations = append(mmValidateContent.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Validator.ValidateName return parameters for the expectation previously defined by the When method
-func (e *ValidatorMockValidateNameExpectation) Then(err error) *ValidatorMock {
-	e.results = &ValidatorMockValidateNameResults{err}
+// Then sets up Validator.ValidateContent return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateContentExpectation) Then(c1 mm_entity.ContentUsage, err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateContentResults{c1, err}
 	return e.mock
 }
 
-// Times sets number of times Validator.ValidateName should be invoked
-func (mmValidateName *mValidatorMockValidateName) Times(n uint64) *mValidatorMockValidateName {
+// Times sets number of times Validator.ValidateContent should be invoked
+func (mmValidateContent *mValidatorMockValidateContent) Times(n uint64) *mValidatorMockValidateContent {
 	if n == 0 {
-		mmValidateName.mock.t.Fatalf("Times of ValidatorMock.ValidateName mock can not be zero")
+		mmValidateContent.mock.t.Fatalf("Times of ValidatorMock.ValidateContent mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmValidateName.expectedInvocations, n)
-	mmValidateName.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmValidateName
+	mm_atomic.StoreUint64(&mmValidateContent.expectedInvocations, n)
+	mmValidateContent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateContent
 }
 
-func (mmValidateName *mValidatorMockValidateName) invocationsDone() bool {
-	if len(mmValidateName.expectations) == 0 && mmValidateName.defaultExpectation == nil && mmValidateName.mock.funcValidateName == nil {
+func (mmValidateContent *mValidatorMockValidateContent) invocationsDone() bool {
+	if len(mmValidateContent.expectations) == 0 && mmValidateContent.defaultExpectation == nil && mmValidateContent.mock.funcValidateContent == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmValidateName.mock.afterValidateNameCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmValidateName.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateContent.mock.afterValidateContentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateContent.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ValidateName implements mm_entity.Validator
-func (mmValidateName *ValidatorMock) ValidateName(name string) (err error) {
-	mm_atomic.AddUint64(&mmValidateName.beforeValidateNameCounter, 1)
-	defer mm_atomic.AddUint64(&mmValidateName.afterValidateNameCounter, 1)
+// ValidateContent implements mm_entity.Validator
+func (mmValidateContent *ValidatorMock) ValidateContent(entityType mm_entity.Type, content string) (c1 mm_entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmValidateContent.beforeValidateContentCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateContent.afterValidateContentCounter, 1)
 
-	mmValidateName.t.Helper()
+	mmValidateContent.t.Helper()
 
-	if mmValidateName.inspectFuncValidateName != nil {
-		mmValidateName.inspectFuncValidateName(name)
+	if mmValidateContent.inspectFuncValidateContent != nil {
+		mmValidateContent.inspectFuncValidateContent(entityType, content)
 	}
 
-	mm_params := ValidatorMockValidateNameParams{name}
+	mm_params := ValidatorMockValidateContentParams{entityType, content}
 
 	// Record call args
-	mmValidateName.ValidateNameMock.mutex.Lock()
-	mmValidateName.ValidateNameMock.callArgs = append(mmValidateName.ValidateNameMock.callArgs, &mm_params)
-	mmValidateName.ValidateNameMock.mutex.Unlock()
+	mmValidateContent.ValidateContentMock.mutex.Lock()
+	mmValidateContent.ValidateContentMock.callArgs = append(mmValidateContent.ValidateContentMock.callArgs, &mm_params)
+	mmValidateContent.ValidateContentMock.mutex.Unlock()
 
-	for _, e := range mmValidateName.ValidateNameMock.expectations {
+	for _, e := range mmValidateContent.ValidateContentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.c1, e.results.err
 		}
 	}
 
-	if mmValidateName.ValidateNameMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmValidateName.ValidateNameMock.defaultExpectation.Counter, 1)
-		mm_want := mmValidateName.ValidateNameMock.defaultExpectation.params
-		mm_want_ptrs := mmValidateName.ValidateNameMock.defaultExpectation.paramPtrs
+	if mmValidateContent.ValidateContentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateContent.ValidateContentMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateContent.ValidateContentMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateContent.ValidateContentMock.defaultExpectation.paramPtrs
 
-		mm_got := ValidatorMockValidateNameParams{name}
+		mm_got := ValidatorMockValidateContentParams{entityType, content}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
-				mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			if mm_want_ptrs.entityType != nil && !minimock.Equal(*mm_want_ptrs.entityType, mm_got.entityType) {
+				mmValidateContent.t.Errorf("ValidatorMock.ValidateContent got unexpected parameter entityType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateContent.ValidateContentMock.defaultExpectation.expectationOrigins.originEntityType, *mm_want_ptrs.entityType, mm_got.entityType, minimock.Diff(*mm_want_ptrs.entityType, mm_got.entityType))
+			}
+
+			if mm_want_ptrs.content != nil && !minimock.Equal(*mm_want_ptrs.content, mm_got.content) {
+				mmValidateContent.t.Errorf("ValidatorMock.ValidateContent got unexpected parameter content, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateContent.ValidateContentMock.defaultExpectation.expectationOrigins.originContent, *mm_want_ptrs.content, mm_got.content, minimock.Diff(*mm_want_ptrs.content, mm_got.content))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmValidateContent.t.Errorf("ValidatorMock.ValidateContent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateContent.ValidateContentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmValidateName.ValidateNameMock.defaultExpectation.results
+		mm_results := mmValidateContent.ValidateContentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmValidateName.t.Fatal("No results are set for the ValidatorMock.ValidateName")
+			mmValidateContent.t.Fatal("No results are set for the ValidatorMock.ValidateContent")
 		}
-		return (*mm_results).err
+		return (*mm_results).c1, (*mm_results).err
 	}
-	if mmValidateName.funcValidateName != nil {
-		return mmValidateName.funcValidateName(name)
+	if mmValidateContent.funcValidateContent != nil {
+		return mmValidateContent.funcValidateContent(entityType, content)
 	}
-	mmValidateName.t.Fatalf("Unexpected call to ValidatorMock.ValidateName. %v", name)
+	mmValidateContent.t.Fatalf("Unexpected call to ValidatorMock.ValidateContent. %v %v", entityType, content)
 	return
 }
 
-// ValidateNameAfterCounter returns a count of finished ValidatorMock.ValidateName invocations
-func (mmValidateName *ValidatorMock) ValidateNameAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateName.afterValidateNameCounter)
+// ValidateContentAfterCounter returns a count of finished ValidatorMock.ValidateContent invocations
+func (mmValidateContent *ValidatorMock) ValidateContentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateContent.afterValidateContentCounter)
 }
 
-// ValidateNameBeforeCounter returns a count of ValidatorMock.ValidateName invocations
-func (mmValidateName *ValidatorMock) ValidateNameBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateName.beforeValidateNameCounter)
+// ValidateContentBeforeCounter returns a count of ValidatorMock.ValidateContent invocations
+func (mmValidateContent *ValidatorMock) ValidateContentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateContent.beforeValidateContentCounter)
 }
 
-// Calls returns a list of arguments used in each call to ValidatorMock.ValidateName.
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateContent.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmValidateName *mValidatorMockValidateName) Calls() []*ValidatorMockValidateNameParams {
-	mmValidateName.mutex.RLock()
+func (mmValidateContent *mValidatorMockValidateContent) Calls() []*ValidatorMockValidateContentParams {
+	mmValidateContent.mutex.RLock()
 
-	argCopy := make([]*ValidatorMockValidateNameParams, len(mmValidateName.callArgs))
-	copy(argCopy, mmValidateName.callArgs)
+	argCopy := make([]*ValidatorMockValidateContentParams, len(mmValidateContent.callArgs))
+	copy(argCopy, mmValidateContent.callArgs)
 
-	mmValidateName.mutex.RUnlock()
+	mmValidateContent.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockValidateNameDone returns true if the count of the ValidateName invocations corresponds
+// MinimockValidateContentDone returns true if the count of the ValidateContent invocations corresponds
 // the number of defined expectations
-func (m *ValidatorMock) MinimockValidateNameDone() bool {
-	if m.ValidateNameMock.optional {
+func (m *ValidatorMock) MinimockValidateContentDone() bool {
+	if m.ValidateContentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ValidateNameMock.expectations {
+	for _, e := range m.ValidateContentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ValidateNameMock.invocationsDone()
+	return m.ValidateContentMock.invocationsDone()
 }
 
-// MinimockValidateNameInspect logs each unmet expectation
-func (m *ValidatorMock) MinimockValidateNameInspect() {
-	for _, e := range m.ValidateNameMock.expectations {
+// MinimockValidateContentInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateContentInspect() {
+	for _, e := range m.ValidateContentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidateContent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterValidateNameCounter := mm_atomic.LoadUint64(&m.afterValidateNameCounter)
+	afterValidateContentCounter := mm_atomic.LoadUint64(&m.afterValidateContentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ValidateNameMock.defaultExpectation != nil && afterValidateNameCounter < 1 {
-		if m.ValidateNameMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.ValidateNameMock.defaultExpectation.returnOrigin)
+	if m.ValidateContentMock.defaultExpectation != nil && afterValidateContentCounter < 1 {
+		if m.ValidateContentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateContent at\n%s", m.ValidateContentMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", m.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *m.ValidateNameMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidateContent at\n%s with params: %#v", m.ValidateContentMock.defaultExpectation.expectationOrigins.origin, *m.ValidateContentMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcValidateName != nil && afterValidateNameCounter < 1 {
-		m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.funcValidateNameOrigin)
+	if m.funcValidateContent != nil && afterValidateContentCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateContent at\n%s", m.funcValidateContentOrigin)
 	}
 
-	if !m.ValidateNameMock.invocationsDone() && afterValidateNameCounter > 0 {
-		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateName at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ValidateNameMock.expectedInvocations), m.ValidateNameMock.expectedInvocationsOrigin, afterValidateNameCounter)
+	if !m.ValidateContentMock.invocationsDone() && afterValidateContentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateContent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateContentMock.expectedInvocations), m.ValidateContentMock.expectedInvocationsOrigin, afterValidateContentCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ValidatorMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockNormalizeNameInspect()
+type mValidatorMockValidateLinks struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateLinksExpectation
+	expectations       []*ValidatorMockValidateLinksExpectation
 
-			m.MinimockValidateNameInspect()
+	callArgs []*ValidatorMockValidateLinksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidateLinksExpectation specifies expectation struct of the Validator.ValidateLinks
+type ValidatorMockValidateLinksExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidateLinksParams
+	paramPtrs          *ValidatorMockValidateLinksParamPtrs
+	expectationOrigins ValidatorMockValidateLinksExpectationOrigins
+	results            *ValidatorMockValidateLinksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidateLinksParams contains parameters of the Validator.ValidateLinks
+type ValidatorMockValidateLinksParams struct {
+	entityType mm_entity.Type
+	broken     []uuid.UUID
+}
+
+// ValidatorMockValidateLinksParamPtrs contains pointers to parameters of the Validator.ValidateLinks
+type ValidatorMockValidateLinksParamPtrs struct {
+	entityType *mm_entity.Type
+	broken     *[]uuid.UUID
+}
+
+// ValidatorMockValidateLinksResults contains results of the Validator.ValidateLinks
+type ValidatorMockValidateLinksResults struct {
+	err error
+}
+
+// ValidatorMockValidateLinksOrigins contains origins of expectations of the Validator.ValidateLinks
+type ValidatorMockValidateLinksExpectationOrigins struct {
+	origin           string
+	originEntityType string
+	originBroken     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateLinks *mValidatorMockValidateLinks) Optional() *mValidatorMockValidateLinks {
+	mmValidateLinks.optional = true
+	return mmValidateLinks
+}
+
+// Expect sets up expected params for Validator.ValidateLinks
+func (mmValidateLinks *mValidatorMockValidateLinks) Expect(entityType mm_entity.Type, broken []uuid.UUID) *mValidatorMockValidateLinks {
+	if mmValidateLinks.mock.funcValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Set")
+	}
+
+	if mmValidateLinks.defaultExpectation == nil {
+		mmValidateLinks.defaultExpectation = &ValidatorMockValidateLinksExpectation{}
+	}
+
+	if mmValidateLinks.defaultExpectation.paramPtrs != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by ExpectParams functions")
+	}
+
+	mmValidateLinks.defaultExpectation.params = &ValidatorMockValidateLinksParams{entityType, broken}
+	mmValidateLinks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateLinks.expectations {
+		if minimock.Equal(e.params, mmValidateLinks.defaultExpectation.params) {
+			mmValidateLinks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateLinks.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateLinks
+}
+
+// ExpectEntityTypeParam1 sets up expected param entityType for Validator.ValidateLinks
+func (mmValidateLinks *mValidatorMockValidateLinks) ExpectEntityTypeParam1(entityType mm_entity.Type) *mValidatorMockValidateLinks {
+	if mmValidateLinks.mock.funcValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Set")
+	}
+
+	if mmValidateLinks.defaultExpectation == nil {
+		mmValidateLinks.defaultExpectation = &ValidatorMockValidateLinksExpectation{}
+	}
+
+	if mmValidateLinks.defaultExpectation.params != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Expect")
+	}
+
+	if mmValidateLinks.defaultExpectation.paramPtrs == nil {
+		mmValidateLinks.defaultExpectation.paramPtrs = &ValidatorMockValidateLinksParamPtrs{}
+	}
+	mmValidateLinks.defaultExpectation.paramPtrs.entityType = &entityType
+	mmValidateLinks.defaultExpectation.expectationOrigins.originEntityType = minimock.CallerInfo(1)
+
+	return mmValidateLinks
+}
+
+// ExpectBrokenParam2 sets up expected param broken for Validator.ValidateLinks
+func (mmValidateLinks *mValidatorMockValidateLinks) ExpectBrokenParam2(broken []uuid.UUID) *mValidatorMockValidateLinks {
+	if mmValidateLinks.mock.funcValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Set")
+	}
+
+	if mmValidateLinks.defaultExpectation == nil {
+		mmValidateLinks.defaultExpectation = &ValidatorMockValidateLinksExpectation{}
+	}
+
+	if mmValidateLinks.defaultExpectation.params != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Expect")
+	}
+
+	if mmValidateLinks.defaultExpectation.paramPtrs == nil {
+		mmValidateLinks.defaultExpectation.paramPtrs = &ValidatorMockValidateLinksParamPtrs{}
+	}
+	mmValidateLinks.defaultExpectation.paramPtrs.broken = &broken
+	mmValidateLinks.defaultExpectation.expectationOrigins.originBroken = minimock.CallerInfo(1)
+
+	return mmValidateLinks
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateLinks
+func (mmValidateLinks *mValidatorMockValidateLinks) Inspect(f func(entityType mm_entity.Type, broken []uuid.UUID)) *mValidatorMockValidateLinks {
+	if mmValidateLinks.mock.inspectFuncValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateLinks")
+	}
+
+	mmValidateLinks.mock.inspectFuncValidateLinks = f
+
+	return mmValidateLinks
+}
+
+// Return sets up results that will be returned by Validator.ValidateLinks
+func (mmValidateLinks *mValidatorMockValidateLinks) Return(err error) *ValidatorMock {
+	if mmValidateLinks.mock.funcValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Set")
+	}
+
+	if mmValidateLinks.defaultExpectation == nil {
+		mmValidateLinks.defaultExpectation = &ValidatorMockValidateLinksExpectation{mock: mmValidateLinks.mock}
+	}
+	mmValidateLinks.defaultExpectation.results = &ValidatorMockValidateLinksResults{err}
+	mmValidateLinks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateLinks.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateLinks method
+func (mmValidateLinks *mValidatorMockValidateLinks) Set(f func(entityType mm_entity.Type, broken []uuid.UUID) (err error)) *ValidatorMock {
+	if mmValidateLinks.defaultExpectation != nil {
+		mmValidateLinks.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateLinks method")
+	}
+
+	if len(mmValidateLinks.expectations) > 0 {
+		mmValidateLinks.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateLinks method")
+	}
+
+	mmValidateLinks.mock.funcValidateLinks = f
+	mmValidateLinks.mock.funcValidateLinksOrigin = minimock.CallerInfo(1)
+	return mmValidateLinks.mock
+}
+
+// When sets expectation for the Validator.ValidateLinks which will trigger the result defined by the following
+// Then helper
+func (mmValidateLinks *mValidatorMockValidateLinks) When(entityType mm_entity.Type, broken []uuid.UUID) *ValidatorMockValidateLinksExpectation {
+	if mmValidateLinks.mock.funcValidateLinks != nil {
+		mmValidateLinks.mock.t.Fatalf("ValidatorMock.ValidateLinks mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateLinksExpectation{
+		mock:               mmValidateLinks.mock,
+		params:             &ValidatorMockValidateLinksParams{entityType, broken},
+		expectationOrigins: ValidatorMockValidateLinksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateLinks.expectations = append(mmValidateLinks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateLinks return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateLinksExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateLinksResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateLinks should be invoked
+func (mmValidateLinks *mValidatorMockValidateLinks) Times(n uint64) *mValidatorMockValidateLinks {
+	if n == 0 {
+		mmValidateLinks.mock.t.Fatalf("Times of ValidatorMock.ValidateLinks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateLinks.expectedInvocations, n)
+	mmValidateLinks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateLinks
+}
+
+func (mmValidateLinks *mValidatorMockValidateLinks) invocationsDone() bool {
+	if len(mmValidateLinks.expectations) == 0 && mmValidateLinks.defaultExpectation == nil && mmValidateLinks.mock.funcValidateLinks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateLinks.mock.afterValidateLinksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateLinks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateLinks implements mm_entity.Validator
+func (mmValidateLinks *ValidatorMock) ValidateLinks(entityType mm_entity.Type, broken []uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmValidateLinks.beforeValidateLinksCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateLinks.afterValidateLinksCounter, 1)
+
+	mmValidateLinks.t.Helper()
+
+	if mmValidateLinks.inspectFuncValidateLinks != nil {
+		mmValidateLinks.inspectFuncValidateLinks(entityType, broken)
+	}
+
+	mm_params := ValidatorMockValidateLinksParams{entityType, broken}
+
+	// Record call args
+	mmValidateLinks.ValidateLinksMock.mutex.Lock()
+	mmValidateLinks.ValidateLinksMock.callArgs = append(mmValidateLinks.ValidateLinksMock.callArgs, &mm_params)
+	mmValidateLinks.ValidateLinksMock.mutex.Unlock()
+
+	for _, e := range mmValidateLinks.ValidateLinksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateLinks.ValidateLinksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateLinks.ValidateLinksMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateLinks.ValidateLinksMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateLinks.ValidateLinksMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateLinksParams{entityType, broken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.entityType != nil && !minimock.Equal(*mm_want_ptrs.entityType, mm_got.entityType) {
+				mmValidateLinks.t.Errorf("ValidatorMock.ValidateLinks got unexpected parameter entityType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLinks.ValidateLinksMock.defaultExpectation.expectationOrigins.originEntityType, *mm_want_ptrs.entityType, mm_got.entityType, minimock.Diff(*mm_want_ptrs.entityType, mm_got.entityType))
+			}
+
+			if mm_want_ptrs.broken != nil && !minimock.Equal(*mm_want_ptrs.broken, mm_got.broken) {
+				mmValidateLinks.t.Errorf("ValidatorMock.ValidateLinks got unexpected parameter broken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateLinks.ValidateLinksMock.defaultExpectation.expectationOrigins.originBroken, *mm_want_ptrs.broken, mm_got.broken, minimock.Diff(*mm_want_ptrs.broken, mm_got.broken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateLinks.t.Errorf("ValidatorMock.ValidateLinks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateLinks.ValidateLinksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateLinks.ValidateLinksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateLinks.t.Fatal("No results are set for the ValidatorMock.ValidateLinks")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateLinks.funcValidateLinks != nil {
+		return mmValidateLinks.funcValidateLinks(entityType, broken)
+	}
+	mmValidateLinks.t.Fatalf("Unexpected call to ValidatorMock.ValidateLinks. %v %v", entityType, broken)
+	return
+}
+
+// ValidateLinksAfterCounter returns a count of finished ValidatorMock.ValidateLinks invocations
+func (mmValidateLinks *ValidatorMock) ValidateLinksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateLinks.afterValidateLinksCounter)
+}
+
+// ValidateLinksBeforeCounter returns a count of ValidatorMock.ValidateLinks invocations
+func (mmValidateLinks *ValidatorMock) ValidateLinksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateLinks.beforeValidateLinksCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateLinks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateLinks *mValidatorMockValidateLinks) Calls() []*ValidatorMockValidateLinksParams {
+	mmValidateLinks.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateLinksParams, len(mmValidateLinks.callArgs))
+	copy(argCopy, mmValidateLinks.callArgs)
+
+	mmValidateLinks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateLinksDone returns true if the count of the ValidateLinks invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateLinksDone() bool {
+	if m.ValidateLinksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateLinksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateLinksMock.invocationsDone()
+}
+
+// MinimockValidateLinksInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateLinksInspect() {
+	for _, e := range m.ValidateLinksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateLinks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateLinksCounter := mm_atomic.LoadUint64(&m.afterValidateLinksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateLinksMock.defaultExpectation != nil && afterValidateLinksCounter < 1 {
+		if m.ValidateLinksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateLinks at\n%s", m.ValidateLinksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateLinks at\n%s with params: %#v", m.ValidateLinksMock.defaultExpectation.expectationOrigins.origin, *m.ValidateLinksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateLinks != nil && afterValidateLinksCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateLinks at\n%s", m.funcValidateLinksOrigin)
+	}
+
+	if !m.ValidateLinksMock.invocationsDone() && afterValidateLinksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateLinks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateLinksMock.expectedInvocations), m.ValidateLinksMock.expectedInvocationsOrigin, afterValidateLinksCounter)
+	}
+}
+
+type mValidatorMockValidateName struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateNameExpectation
+	expectations       []*ValidatorMockValidateNameExpectation
+
+	callArgs []*ValidatorMockValidateNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidateNameExpectation specifies expectation struct of the Validator.ValidateName
+type ValidatorMockValidateNameExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidateNameParams
+	paramPtrs          *ValidatorMockValidateNameParamPtrs
+	expectationOrigins ValidatorMockValidateNameExpectationOrigins
+	results            *ValidatorMockValidateNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidateNameParams contains parameters of the Validator.ValidateName
+type ValidatorMockValidateNameParams struct {
+	entityType mm_entity.Type
+	name       string
+}
+
+// ValidatorMockValidateNameParamPtrs contains pointers to parameters of the Validator.ValidateName
+type ValidatorMockValidateNameParamPtrs struct {
+	entityType *mm_entity.Type
+	name       *string
+}
+
+// ValidatorMockValidateNameResults contains results of the Validator.ValidateName
+type ValidatorMockValidateNameResults struct {
+	err error
+}
+
+// ValidatorMockValidateNameOrigins contains origins of expectations of the Validator.ValidateName
+type ValidatorMockValidateNameExpectationOrigins struct {
+	origin           string
+	originEntityType string
+	originName       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateName *mValidatorMockValidateName) Optional() *mValidatorMockValidateName {
+	mmValidateName.optional = true
+	return mmValidateName
+}
+
+// Expect sets up expected params for Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) Expect(entityType mm_entity.Type, name string) *mValidatorMockValidateName {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	}
+
+	if mmValidateName.defaultExpectation.paramPtrs != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by ExpectParams functions")
+	}
+
+	mmValidateName.defaultExpectation.params = &ValidatorMockValidateNameParams{entityType, name}
+	mmValidateName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateName.expectations {
+		if minimock.Equal(e.params, mmValidateName.defaultExpectation.params) {
+			mmValidateName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateName.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateName
+}
+
+// ExpectEntityTypeParam1 sets up expected param entityType for Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) ExpectEntityTypeParam1(entityType mm_entity.Type) *mValidatorMockValidateName {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	}
+
+	if mmValidateName.defaultExpectation.params != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Expect")
+	}
+
+	if mmValidateName.defaultExpectation.paramPtrs == nil {
+		mmValidateName.defaultExpectation.paramPtrs = &ValidatorMockValidateNameParamPtrs{}
+	}
+	mmValidateName.defaultExpectation.paramPtrs.entityType = &entityType
+	mmValidateName.defaultExpectation.expectationOrigins.originEntityType = minimock.CallerInfo(1)
+
+	return mmValidateName
+}
+
+// ExpectNameParam2 sets up expected param name for Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) ExpectNameParam2(name string) *mValidatorMockValidateName {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	}
+
+	if mmValidateName.defaultExpectation.params != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Expect")
+	}
+
+	if mmValidateName.defaultExpectation.paramPtrs == nil {
+		mmValidateName.defaultExpectation.paramPtrs = &ValidatorMockValidateNameParamPtrs{}
+	}
+	mmValidateName.defaultExpectation.paramPtrs.name = &name
+	mmValidateName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmValidateName
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) Inspect(f func(entityType mm_entity.Type, name string)) *mValidatorMockValidateName {
+	if mmValidateName.mock.inspectFuncValidateName != nil {
+		mmValidateName.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateName")
+	}
+
+	mmValidateName.mock.inspectFuncValidateName = f
+
+	return mmValidateName
+}
+
+// Return sets up results that will be returned by Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) Return(err error) *ValidatorMock {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{mock: mmValidateName.mock}
+	}
+	mmValidateName.defaultExpectation.results = &ValidatorMockValidateNameResults{err}
+	mmValidateName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateName.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateName method
+func (mmValidateName *mValidatorMockValidateName) Set(f func(entityType mm_entity.Type, name string) (err error)) *ValidatorMock {
+	if mmValidateName.defaultExpectation != nil {
+		mmValidateName.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateName method")
+	}
+
+	if len(mmValidateName.expectations) > 0 {
+		mmValidateName.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateName method")
+	}
+
+	mmValidateName.mock.funcValidateName = f
+	mmValidateName.mock.funcValidateNameOrigin = minimock.CallerInfo(1)
+	return mmValidateName.mock
+}
+
+// When sets expectation for the Validator.ValidateName which will trigger the result defined by the following
+// Then helper
+func (mmValidateName *mValidatorMockValidateName) When(entityType mm_entity.Type, name string) *ValidatorMockValidateNameExpectation {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateNameExpectation{
+		mock:               mmValidateName.mock,
+		params:             &ValidatorMockValidateNameParams{entityType, name},
+		expectationOrigins: ValidatorMockValidateNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateName.expectations = append(mmValidateName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateName return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateNameExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateNameResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateName should be invoked
+func (mmValidateName *mValidatorMockValidateName) Times(n uint64) *mValidatorMockValidateName {
+	if n == 0 {
+		mmValidateName.mock.t.Fatalf("Times of ValidatorMock.ValidateName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateName.expectedInvocations, n)
+	mmValidateName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateName
+}
+
+func (mmValidateName *mValidatorMockValidateName) invocationsDone() bool {
+	if len(mmValidateName.expectations) == 0 && mmValidateName.defaultExpectation == nil && mmValidateName.mock.funcValidateName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateName.mock.afterValidateNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateName implements mm_entity.Validator
+func (mmValidateName *ValidatorMock) ValidateName(entityType mm_entity.Type, name string) (err error) {
+	mm_atomic.AddUint64(&mmValidateName.beforeValidateNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateName.afterValidateNameCounter, 1)
+
+	mmValidateName.t.Helper()
+
+	if mmValidateName.inspectFuncValidateName != nil {
+		mmValidateName.inspectFuncValidateName(entityType, name)
+	}
+
+	mm_params := ValidatorMockValidateNameParams{entityType, name}
+
+	// Record call args
+	mmValidateName.ValidateNameMock.mutex.Lock()
+	mmValidateName.ValidateNameMock.callArgs = append(mmValidateName.ValidateNameMock.callArgs, &mm_params)
+	mmValidateName.ValidateNameMock.mutex.Unlock()
+
+	for _, e := range mmValidateName.ValidateNameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateName.ValidateNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateName.ValidateNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateName.ValidateNameMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateName.ValidateNameMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateNameParams{entityType, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.entityType != nil && !minimock.Equal(*mm_want_ptrs.entityType, mm_got.entityType) {
+				mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameter entityType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.originEntityType, *mm_want_ptrs.entityType, mm_got.entityType, minimock.Diff(*mm_want_ptrs.entityType, mm_got.entityType))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateName.ValidateNameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateName.t.Fatal("No results are set for the ValidatorMock.ValidateName")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateName.funcValidateName != nil {
+		return mmValidateName.funcValidateName(entityType, name)
+	}
+	mmValidateName.t.Fatalf("Unexpected call to ValidatorMock.ValidateName. %v %v", entityType, name)
+	return
+}
+
+// ValidateNameAfterCounter returns a count of finished ValidatorMock.ValidateName invocations
+func (mmValidateName *ValidatorMock) ValidateNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateName.afterValidateNameCounter)
+}
+
+// ValidateNameBeforeCounter returns a count of ValidatorMock.ValidateName invocations
+func (mmValidateName *ValidatorMock) ValidateNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateName.beforeValidateNameCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateName.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateName *mValidatorMockValidateName) Calls() []*ValidatorMockValidateNameParams {
+	mmValidateName.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateNameParams, len(mmValidateName.callArgs))
+	copy(argCopy, mmValidateName.callArgs)
+
+	mmValidateName.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateNameDone returns true if the count of the ValidateName invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateNameDone() bool {
+	if m.ValidateNameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateNameMock.invocationsDone()
+}
+
+// MinimockValidateNameInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateNameInspect() {
+	for _, e := range m.ValidateNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateNameCounter := mm_atomic.LoadUint64(&m.afterValidateNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateNameMock.defaultExpectation != nil && afterValidateNameCounter < 1 {
+		if m.ValidateNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.ValidateNameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", m.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *m.ValidateNameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateName != nil && afterValidateNameCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.funcValidateNameOrigin)
+	}
+
+	if !m.ValidateNameMock.invocationsDone() && afterValidateNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateNameMock.expectedInvocations), m.ValidateNameMock.expectedInvocationsOrigin, afterValidateNameCounter)
+	}
+}
+
+type mValidatorMockValidateSummary struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateSummaryExpectation
+	expectations       []*ValidatorMockValidateSummaryExpectation
+
+	callArgs []*ValidatorMockValidateSummaryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidateSummaryExpectation specifies expectation struct of the Validator.ValidateSummary
+type ValidatorMockValidateSummaryExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidateSummaryParams
+	paramPtrs          *ValidatorMockValidateSummaryParamPtrs
+	expectationOrigins ValidatorMockValidateSummaryExpectationOrigins
+	results            *ValidatorMockValidateSummaryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidateSummaryParams contains parameters of the Validator.ValidateSummary
+type ValidatorMockValidateSummaryParams struct {
+	summary string
+}
+
+// ValidatorMockValidateSummaryParamPtrs contains pointers to parameters of the Validator.ValidateSummary
+type ValidatorMockValidateSummaryParamPtrs struct {
+	summary *string
+}
+
+// ValidatorMockValidateSummaryResults contains results of the Validator.ValidateSummary
+type ValidatorMockValidateSummaryResults struct {
+	err error
+}
+
+// ValidatorMockValidateSummaryOrigins contains origins of expectations of the Validator.ValidateSummary
+type ValidatorMockValidateSummaryExpectationOrigins struct {
+	origin        string
+	originSummary string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateSummary *mValidatorMockValidateSummary) Optional() *mValidatorMockValidateSummary {
+	mmValidateSummary.optional = true
+	return mmValidateSummary
+}
+
+// Expect sets up expected params for Validator.ValidateSummary
+func (mmValidateSummary *mValidatorMockValidateSummary) Expect(summary string) *mValidatorMockValidateSummary {
+	if mmValidateSummary.mock.funcValidateSummary != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by Set")
+	}
+
+	if mmValidateSummary.defaultExpectation == nil {
+		mmValidateSummary.defaultExpectation = &ValidatorMockValidateSummaryExpectation{}
+	}
+
+	if mmValidateSummary.defaultExpectation.paramPtrs != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by ExpectParams functions")
+	}
+
+	mmValidateSummary.defaultExpectation.params = &ValidatorMockValidateSummaryParams{summary}
+	mmValidateSummary.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateSummary.expectations {
+		if minimock.Equal(e.params, mmValidateSummary.defaultExpectation.params) {
+			mmValidateSummary.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateSummary.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateSummary
+}
+
+// ExpectSummaryParam1 sets up expected param summary for Validator.ValidateSummary
+func (mmValidateSummary *mValidatorMockValidateSummary) ExpectSummaryParam1(summary string) *mValidatorMockValidateSummary {
+	if mmValidateSummary.mock.funcValidateSummary != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by Set")
+	}
+
+	if mmValidateSummary.defaultExpectation == nil {
+		mmValidateSummary.defaultExpectation = &ValidatorMockValidateSummaryExpectation{}
+	}
+
+	if mmValidateSummary.defaultExpectation.params != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by Expect")
+	}
+
+	if mmValidateSummary.defaultExpectation.paramPtrs == nil {
+		mmValidateSummary.defaultExpectation.paramPtrs = &ValidatorMockValidateSummaryParamPtrs{}
+	}
+	mmValidateSummary.defaultExpectation.paramPtrs.summary = &summary
+	mmValidateSummary.defaultExpectation.expectationOrigins.originSummary = minimock.CallerInfo(1)
+
+	return mmValidateSummary
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateSummary
+func (mmValidateSummary *mValidatorMockValidateSummary) Inspect(f func(summary string)) *mValidatorMockValidateSummary {
+	if mmValidateSummary.mock.inspectFuncValidateSummary != nil {
+		mmValidateSummary.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateSummary")
+	}
+
+	mmValidateSummary.mock.inspectFuncValidateSummary = f
+
+	return mmValidateSummary
+}
+
+// Return sets up results that will be returned by Validator.ValidateSummary
+func (mmValidateSummary *mValidatorMockValidateSummary) Return(err error) *ValidatorMock {
+	if mmValidateSummary.mock.funcValidateSummary != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by Set")
+	}
+
+	if mmValidateSummary.defaultExpectation == nil {
+		mmValidateSummary.defaultExpectation = &ValidatorMockValidateSummaryExpectation{mock: mmValidateSummary.mock}
+	}
+	mmValidateSummary.defaultExpectation.results = &ValidatorMockValidateSummaryResults{err}
+	mmValidateSummary.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateSummary.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateSummary method
+func (mmValidateSummary *mValidatorMockValidateSummary) Set(f func(summary string) (err error)) *ValidatorMock {
+	if mmValidateSummary.defaultExpectation != nil {
+		mmValidateSummary.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateSummary method")
+	}
+
+	if len(mmValidateSummary.expectations) > 0 {
+		mmValidateSummary.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateSummary method")
+	}
+
+	mmValidateSummary.mock.funcValidateSummary = f
+	mmValidateSummary.mock.funcValidateSummaryOrigin = minimock.CallerInfo(1)
+	return mmValidateSummary.mock
+}
+
+// When sets expectation for the Validator.ValidateSummary which will trigger the result defined by the following
+// Then helper
+func (mmValidateSummary *mValidatorMockValidateSummary) When(summary string) *ValidatorMockValidateSummaryExpectation {
+	if mmValidateSummary.mock.funcValidateSummary != nil {
+		mmValidateSummary.mock.t.Fatalf("ValidatorMock.ValidateSummary mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateSummaryExpectation{
+		mock:               mmValidateSummary.mock,
+		params:             &ValidatorMockValidateSummaryParams{summary},
+		expectationOrigins: ValidatorMockValidateSummaryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateSummary.expectations = append(mmValidateSummary.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateSummary return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateSummaryExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateSummaryResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateSummary should be invoked
+func (mmValidateSummary *mValidatorMockValidateSummary) Times(n uint64) *mValidatorMockValidateSummary {
+	if n == 0 {
+		mmValidateSummary.mock.t.Fatalf("Times of ValidatorMock.ValidateSummary mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateSummary.expectedInvocations, n)
+	mmValidateSummary.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateSummary
+}
+
+func (mmValidateSummary *mValidatorMockValidateSummary) invocationsDone() bool {
+	if len(mmValidateSummary.expectations) == 0 && mmValidateSummary.defaultExpectation == nil && mmValidateSummary.mock.funcValidateSummary == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateSummary.mock.afterValidateSummaryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateSummary.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateSummary implements mm_entity.Validator
+func (mmValidateSummary *ValidatorMock) ValidateSummary(summary string) (err error) {
+	mm_atomic.AddUint64(&mmValidateSummary.beforeValidateSummaryCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateSummary.afterValidateSummaryCounter, 1)
+
+	mmValidateSummary.t.Helper()
+
+	if mmValidateSummary.inspectFuncValidateSummary != nil {
+		mmValidateSummary.inspectFuncValidateSummary(summary)
+	}
+
+	mm_params := ValidatorMockValidateSummaryParams{summary}
+
+	// Record call args
+	mmValidateSummary.ValidateSummaryMock.mutex.Lock()
+	mmValidateSummary.ValidateSummaryMock.callArgs = append(mmValidateSummary.ValidateSummaryMock.callArgs, &mm_params)
+	mmValidateSummary.ValidateSummaryMock.mutex.Unlock()
+
+	for _, e := range mmValidateSummary.ValidateSummaryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateSummary.ValidateSummaryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateSummary.ValidateSummaryMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateSummary.ValidateSummaryMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateSummary.ValidateSummaryMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateSummaryParams{summary}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.summary != nil && !minimock.Equal(*mm_want_ptrs.summary, mm_got.summary) {
+				mmValidateSummary.t.Errorf("ValidatorMock.ValidateSummary got unexpected parameter summary, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateSummary.ValidateSummaryMock.defaultExpectation.expectationOrigins.originSummary, *mm_want_ptrs.summary, mm_got.summary, minimock.Diff(*mm_want_ptrs.summary, mm_got.summary))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateSummary.t.Errorf("ValidatorMock.ValidateSummary got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateSummary.ValidateSummaryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateSummary.ValidateSummaryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateSummary.t.Fatal("No results are set for the ValidatorMock.ValidateSummary")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateSummary.funcValidateSummary != nil {
+		return mmValidateSummary.funcValidateSummary(summary)
+	}
+	mmValidateSummary.t.Fatalf("Unexpected call to ValidatorMock.ValidateSummary. %v", summary)
+	return
+}
+
+// ValidateSummaryAfterCounter returns a count of finished ValidatorMock.ValidateSummary invocations
+func (mmValidateSummary *ValidatorMock) ValidateSummaryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSummary.afterValidateSummaryCounter)
+}
+
+// ValidateSummaryBeforeCounter returns a count of ValidatorMock.ValidateSummary invocations
+func (mmValidateSummary *ValidatorMock) ValidateSummaryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateSummary.beforeValidateSummaryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateSummary.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateSummary *mValidatorMockValidateSummary) Calls() []*ValidatorMockValidateSummaryParams {
+	mmValidateSummary.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateSummaryParams, len(mmValidateSummary.callArgs))
+	copy(argCopy, mmValidateSummary.callArgs)
+
+	mmValidateSummary.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateSummaryDone returns true if the count of the ValidateSummary invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateSummaryDone() bool {
+	if m.ValidateSummaryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateSummaryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateSummaryMock.invocationsDone()
+}
+
+// MinimockValidateSummaryInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateSummaryInspect() {
+	for _, e := range m.ValidateSummaryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateSummary at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateSummaryCounter := mm_atomic.LoadUint64(&m.afterValidateSummaryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateSummaryMock.defaultExpectation != nil && afterValidateSummaryCounter < 1 {
+		if m.ValidateSummaryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateSummary at\n%s", m.ValidateSummaryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateSummary at\n%s with params: %#v", m.ValidateSummaryMock.defaultExpectation.expectationOrigins.origin, *m.ValidateSummaryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateSummary != nil && afterValidateSummaryCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateSummary at\n%s", m.funcValidateSummaryOrigin)
+	}
+
+	if !m.ValidateSummaryMock.invocationsDone() && afterValidateSummaryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateSummary at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateSummaryMock.expectedInvocations), m.ValidateSummaryMock.expectedInvocationsOrigin, afterValidateSummaryCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ValidatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockNormalizeNameInspect()
+
+			m.MinimockValidateContentInspect()
+
+			m.MinimockValidateLinksInspect()
+
+			m.MinimockValidateNameInspect()
+
+			m.MinimockValidateSummaryInspect()
 		}
 	})
 }
@@ -704,5 +1769,8 @@ func (m *ValidatorMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockNormalizeNameDone() &&
-		m.MinimockValidateNameDone()
+		m.MinimockValidateContentDone() &&
+		m.MinimockValidateLinksDone() &&
+		m.MinimockValidateNameDone() &&
+		m.MinimockValidateSummaryDone()
 }
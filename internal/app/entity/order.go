@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxChildrenOrderIDs caps the number of children ordered by one request.
+const MaxChildrenOrderIDs = 1000
+
+// ChildrenOrderReq puts the listed children of a parent first, in the given order. The children left out
+// follow them by name, so a writer can order some pages without knowing about the drafts of others.
+type ChildrenOrderReq struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+// GetChildren returns the live children of id in sibling order. Unless isAdmin, drafts of other users are skipped.
+func (c *core) GetChildren(ctx context.Context, id uuid.UUID, isAdmin bool) ([]ListItem, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetChildren: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.GetChildren: %w", err)
+		}
+		userID = &uid
+	}
+	items, err := c.repo.GetChildren(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetChildren: %w", err)
+	}
+
+	return items, nil
+}
+
+// ReorderChildren resequences the children of parentID, see ChildrenOrderReq. Every ID must be a live child.
+func (c *core) ReorderChildren(ctx context.Context, parentID uuid.UUID, req ChildrenOrderReq) error {
+	if parentID == uuid.Nil {
+		return fmt.Errorf("entity.core.ReorderChildren: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if len(req.IDs) == 0 || len(req.IDs) > MaxChildrenOrderIDs {
+		return fmt.Errorf("entity.core.ReorderChildren: %w", ErrInvalidBatchSize(MaxChildrenOrderIDs))
+	}
+	if slices.Contains(req.IDs, uuid.Nil) {
+		return fmt.Errorf("entity.core.ReorderChildren: %w", apperr.ErrNilUUID(FieldIDs))
+	}
+	if len(lo.Uniq(req.IDs)) != len(req.IDs) {
+		return fmt.Errorf("entity.core.ReorderChildren: %w", ErrDuplicateChildren())
+	}
+	if err := c.repo.ReorderChildren(ctx, parentID, req.IDs); err != nil {
+		return fmt.Errorf("entity.core.ReorderChildren: %w", err)
+	}
+
+	return nil
+}
+
+// SetPinned pins childID above the other children of parentID, or unpins it. Config.MaxPinnedChildren
+// limits the pinned children of a parent; unpinning always succeeds.
+func (c *core) SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool) error {
+	if parentID == uuid.Nil {
+		return fmt.Errorf("entity.core.SetPinned: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if childID == uuid.Nil {
+		return fmt.Errorf("entity.core.SetPinned: %w", apperr.ErrNilUUID(FieldChildID))
+	}
+	if err := c.repo.SetPinned(ctx, parentID, childID, pinned, c.cfg.MaxPinnedChildren); err != nil {
+		return fmt.Errorf("entity.core.SetPinned: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_GetChildren(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		items  = []entity.ListItem{{ID: uuid.New(), Name: "child", ParentID: &id, SortOrder: 1}}
+	)
+
+	t.Run("drafts of other users skipped", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetChildrenMock.Expect(ctx, id, &userID).Return(items, nil)
+		got, err := c.GetChildren(ctx, id, false)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+	t.Run("admin sees all", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetChildrenMock.Expect(ctx, id, nil).Return(items, nil)
+		got, err := c.GetChildren(ctx, id, true)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+	t.Run("nil id", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		_, err = c.GetChildren(ctx, uuid.Nil, false)
+		require.ErrorIs(t, err, apperr.ErrNilUUID(entity.FieldEntityID))
+	})
+}
+
+func TestCore_ReorderChildren(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		parentID = uuid.New()
+		a, b     = uuid.New(), uuid.New()
+		expErr   = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name     string
+		parentID uuid.UUID
+		ids      []uuid.UUID
+		setup    func(repo *mocks.RepositoryMock)
+		err      error
+	}{
+		{
+			name: "ok", parentID: parentID, ids: []uuid.UUID{b, a},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ReorderChildrenMock.Expect(ctx, parentID, []uuid.UUID{b, a}).Return(nil)
+			},
+		},
+		{name: "nil parent", parentID: uuid.Nil, ids: []uuid.UUID{a}, err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "empty", parentID: parentID, err: entity.ErrInvalidBatchSize(entity.MaxChildrenOrderIDs)},
+		{
+			name: "too many", parentID: parentID, ids: make([]uuid.UUID, entity.MaxChildrenOrderIDs+1),
+			err: entity.ErrInvalidBatchSize(entity.MaxChildrenOrderIDs),
+		},
+		{name: "nil id", parentID: parentID, ids: []uuid.UUID{a, uuid.Nil}, err: apperr.ErrNilUUID(entity.FieldIDs)},
+		{name: "duplicate", parentID: parentID, ids: []uuid.UUID{a, b, a}, err: entity.ErrDuplicateChildren()},
+		{
+			name: "not children", parentID: parentID, ids: []uuid.UUID{a},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ReorderChildrenMock.Expect(ctx, parentID, []uuid.UUID{a}).Return(entity.ErrNotChildren())
+			},
+			err: entity.ErrNotChildren(),
+		},
+		{
+			name: "repo error", parentID: parentID, ids: []uuid.UUID{a},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.ReorderChildrenMock.Expect(ctx, parentID, []uuid.UUID{a}).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.ReorderChildren(ctx, tt.parentID, entity.ChildrenOrderReq{IDs: tt.ids})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_SetPinned(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		parentID = uuid.New()
+		childID  = uuid.New()
+		expErr   = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name     string
+		parentID uuid.UUID
+		childID  uuid.UUID
+		pinned   bool
+		setup    func(repo *mocks.RepositoryMock)
+		err      error
+	}{
+		{
+			name: "pin", parentID: parentID, childID: childID, pinned: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetPinnedMock.Expect(ctx, parentID, childID, true, 3).Return(nil)
+			},
+		},
+		{
+			name: "unpin", parentID: parentID, childID: childID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetPinnedMock.Expect(ctx, parentID, childID, false, 3).Return(nil)
+			},
+		},
+		{name: "nil parent", parentID: uuid.Nil, childID: childID, pinned: true, err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "nil child", parentID: parentID, childID: uuid.Nil, pinned: true, err: apperr.ErrNilUUID(entity.FieldChildID)},
+		{
+			name: "limit reached", parentID: parentID, childID: childID, pinned: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetPinnedMock.Return(entity.ErrPinLimitReached(3))
+			},
+			err: entity.ErrPinLimitReached(3),
+		},
+		{
+			name: "repo error", parentID: parentID, childID: childID, pinned: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetPinnedMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			cfg := Cfg()
+			cfg.MaxPinnedChildren = 3
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			err = c.SetPinned(ctx, tt.parentID, tt.childID, tt.pinned)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
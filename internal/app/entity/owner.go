@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+// TransferOwnership makes ownerID the owner of the entity.
+func (c *core) TransferOwnership(ctx context.Context, id, ownerID uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.TransferOwnership: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if ownerID == uuid.Nil {
+		return fmt.Errorf("entity.core.TransferOwnership: %w", apperr.ErrNilUUID(FieldOwnerID))
+	}
+	if err := c.repo.SetOwner(ctx, id, ownerID); err != nil {
+		return fmt.Errorf("entity.core.TransferOwnership: %w", err)
+	}
+
+	return nil
+}
+
+// GetOrphanedEntities reports the live entities left without an accountable owner.
+func (c *core) GetOrphanedEntities(ctx context.Context) ([]OrphanedEntity, error) {
+	entities, err := c.repo.GetOrphanedEntities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetOrphanedEntities: %w", err)
+	}
+
+	return entities, nil
+}
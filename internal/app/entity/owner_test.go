@@ -0,0 +1,82 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_TransferOwnership(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		id      = uuid.New()
+		ownerID = uuid.New()
+		expErr  = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name    string
+		id      uuid.UUID
+		ownerID uuid.UUID
+		setup   func(repo *mocks.RepositoryMock)
+		err     error
+	}{
+		{
+			name: "ok", id: id, ownerID: ownerID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetOwnerMock.Expect(ctx, id, ownerID).Return(nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, ownerID: ownerID, err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "nil owner", id: id, ownerID: uuid.Nil, err: apperr.ErrNilUUID(entity.FieldOwnerID)},
+		{
+			name: "repo error", id: id, ownerID: ownerID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.SetOwnerMock.Expect(ctx, id, ownerID).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.TransferOwnership(ctx, tt.id, tt.ownerID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetOrphanedEntities(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	orphaned := []entity.OrphanedEntity{{ID: uuid.New(), Name: "doc", OwnerID: uuid.New(), OwnerDeletedAt: time.Now()}}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	repo.GetOrphanedEntitiesMock.Expect(ctx).Return(orphaned, nil)
+	got, err := c.GetOrphanedEntities(ctx)
+	require.NoError(t, err)
+	require.Equal(t, orphaned, got)
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+// AddRelation marks id and relatedID as related pages. Relations are symmetric and adding one twice is a no-op.
+func (c *core) AddRelation(ctx context.Context, id, relatedID uuid.UUID) error {
+	if err := validateRelation(id, relatedID); err != nil {
+		return fmt.Errorf("entity.core.AddRelation: %w", err)
+	}
+	if err := c.repo.AddRelation(ctx, id, relatedID, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("entity.core.AddRelation: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRelation removes the relation between id and relatedID, whichever side it was added from.
+func (c *core) DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error {
+	if err := validateRelation(id, relatedID); err != nil {
+		return fmt.Errorf("entity.core.DeleteRelation: %w", err)
+	}
+	if err := c.repo.DeleteRelation(ctx, id, relatedID); err != nil {
+		return fmt.Errorf("entity.core.DeleteRelation: %w", err)
+	}
+
+	return nil
+}
+
+// GetRelated returns the entities related to id. Unless isAdmin, drafts of other users are skipped.
+func (c *core) GetRelated(ctx context.Context, id uuid.UUID, isAdmin bool) ([]ListItem, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetRelated: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.GetRelated: %w", err)
+		}
+		userID = &uid
+	}
+	items, err := c.repo.GetRelated(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetRelated: %w", err)
+	}
+
+	return items, nil
+}
+
+func validateRelation(id, relatedID uuid.UUID) error {
+	if id == uuid.Nil {
+		return apperr.ErrNilUUID(FieldEntityID)
+	}
+	if relatedID == uuid.Nil {
+		return apperr.ErrNilUUID(FieldRelated)
+	}
+	if id == relatedID {
+		return ErrSelfRelation()
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_AddRelation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		relatedID = uuid.New()
+		now       = time.Now()
+		expErr    = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		relatedID uuid.UUID
+		setup     func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock)
+		err       error
+	}{
+		{
+			name: "ok", id: id, relatedID: relatedID,
+			setup: func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock) {
+				tg.NowMock.Return(now)
+				repo.AddRelationMock.Expect(ctx, id, relatedID, now).Return(nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, relatedID: relatedID, err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "nil related id", id: id, relatedID: uuid.Nil, err: apperr.ErrNilUUID(entity.FieldRelated)},
+		{name: "self", id: id, relatedID: id, err: entity.ErrSelfRelation()},
+		{
+			name: "repo error", id: id, relatedID: relatedID,
+			setup: func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock) {
+				tg.NowMock.Return(now)
+				repo.AddRelationMock.Expect(ctx, id, relatedID, now).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			tg := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, tg)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: tg}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.AddRelation(ctx, tt.id, tt.relatedID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_DeleteRelation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		relatedID = uuid.New()
+	)
+
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		relatedID uuid.UUID
+		setup     func(repo *mocks.RepositoryMock)
+		err       error
+	}{
+		{
+			name: "ok", id: id, relatedID: relatedID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.DeleteRelationMock.Expect(ctx, id, relatedID).Return(nil)
+			},
+		},
+		{name: "self", id: id, relatedID: id, err: entity.ErrSelfRelation()},
+		{
+			name: "not related", id: id, relatedID: relatedID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.DeleteRelationMock.Expect(ctx, id, relatedID).Return(entity.ErrRelationNotFound())
+			},
+			err: entity.ErrRelationNotFound(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.DeleteRelation(ctx, tt.id, tt.relatedID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetRelated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		items  = []entity.ListItem{{ID: uuid.New(), Name: "related"}}
+	)
+
+	t.Run("drafts of other users skipped", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetRelatedMock.Expect(ctx, id, &userID).Return(items, nil)
+		got, err := c.GetRelated(ctx, id, false)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+	t.Run("admin sees all", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetRelatedMock.Expect(ctx, id, nil).Return(items, nil)
+		got, err := c.GetRelated(ctx, id, true)
+		require.NoError(t, err)
+		require.Equal(t, items, got)
+	})
+	t.Run("nil id", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		_, err = c.GetRelated(ctx, uuid.Nil, false)
+		require.ErrorIs(t, err, apperr.ErrNilUUID(entity.FieldEntityID))
+	})
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"github.com/russross/blackfriday/v2"
+)
+
+// Render is the form content is served in: as stored, or as HTML with its variables expanded.
+type Render string
+
+const (
+	RenderRaw  Render = ""
+	RenderHTML Render = "html"
+)
+
+func ParseRender(s string) (Render, error) {
+	switch r := Render(s); r {
+	case RenderRaw, RenderHTML:
+		return r, nil
+	default:
+		return "", ErrInvalidRender()
+	}
+}
+
+// MarkdownToHTML renders Markdown content, which may hold HTML, with the common extensions such as
+// tables and fenced code. The HTML is not sanitized, it is served through an HTMLSanitizer.
+func MarkdownToHTML(content string) string {
+	return string(blackfriday.Run([]byte(content), blackfriday.WithExtensions(blackfriday.CommonExtensions)))
+}
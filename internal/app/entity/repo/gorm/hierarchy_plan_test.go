@@ -0,0 +1,96 @@
+//go:build explain
+
+package gorm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// TestEntity_GetHierarchyPlan checks that the hierarchy queries keep using their indexes. Plans depend on
+// the server and on statistics, so it only runs with: go test -tags testutil,explain.
+func TestEntity_GetHierarchyPlan(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newEntityRepo(t)
+	recursive, err := NewRepository(gdb, entity.Config{RecursiveHierarchy: true}, nil)
+	require.NoError(t, err)
+	userID := createUserForEntity(t, gdb)
+
+	// a chain of 50 entities with 10 children each at the bottom
+	var parent *uuid.UUID
+	var leaf uuid.UUID
+	for i := range 60 {
+		id := uuid.New()
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: id.String(), Type: "t", Name: id.String(), ParentID: parent, UserID: userID,
+		}, id, time.Now().UTC()))
+		if i < 50 {
+			parent, leaf = &id, id
+		}
+	}
+	require.NoError(t, gdb.Exec("ANALYZE entities").Error)
+
+	tests := []struct {
+		name    string
+		repo    *gormRepo
+		hType   entity.HierarchyType
+		indexes []string
+	}{
+		{name: "recursive children", repo: recursive, hType: entity.HierarchyTypeChildrenOnly, indexes: []string{"idx_entities_live_children"}},
+		{name: "recursive parents", repo: recursive, hType: entity.HierarchyTypeParentsOnly, indexes: []string{"idx_entities_live_parents"}},
+		{name: "path children", repo: repo, hType: entity.HierarchyTypeChildrenOnly, indexes: []string{"idx_entities_path"}},
+		{name: "path parents", repo: repo, hType: entity.HierarchyTypeParentsOnly, indexes: []string{"idx_entities_live_parents", "entities_pkey"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args, err := tt.repo.hierarchyQuery(t.Context(), tt.hType, 100, []uuid.UUID{leaf}, nil)
+			require.NoError(t, err)
+
+			var plan string
+			err = gdb.Transaction(func(tx *gorm.DB) error {
+				// the table is small enough for a sequential scan to win otherwise
+				if err := tx.Exec("SET LOCAL enable_seqscan = off").Error; err != nil {
+					return err
+				}
+				return tx.Raw("EXPLAIN (FORMAT JSON) "+query, args...).Scan(&plan).Error
+			})
+			require.NoError(t, err)
+
+			var nodes []map[string]any
+			require.NoError(t, json.Unmarshal([]byte(plan), &nodes))
+			used := map[string]bool{}
+			for _, node := range nodes {
+				collectPlanIndexes(t, node["Plan"].(map[string]any), used)
+			}
+			require.Condition(t, func() bool {
+				for _, index := range tt.indexes {
+					if used[index] {
+						return true
+					}
+				}
+				return false
+			}, "want one of %v, plan uses %v:\n%s", tt.indexes, used, plan)
+		})
+	}
+}
+
+// collectPlanIndexes adds the indexes the plan node and its children scan, and fails on a sequential scan
+// of entities.
+func collectPlanIndexes(t *testing.T, node map[string]any, used map[string]bool) {
+	t.Helper()
+	require.False(t, node["Node Type"] == "Seq Scan" && node["Relation Name"] == "entities", "sequential scan of entities")
+	if index, ok := node["Index Name"].(string); ok {
+		used[index] = true
+	}
+	children, _ := node["Plans"].([]any)
+	for _, child := range children {
+		collectPlanIndexes(t, child.(map[string]any), used)
+	}
+}
@@ -1,23 +1,38 @@
 package gorm
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type entityModel struct {
 	db.Base
 	ID             uuid.UUID
+	WorkspaceID    uuid.UUID
 	Type           entity.Type
 	Name           string
+	Slug           string
 	Content        string
+	ContentKeyID   *string
 	ParentID       *uuid.UUID
 	CreatedBy      uuid.UUID
 	UpdatedBy      uuid.UUID
+	OwnerID        uuid.UUID
+	Icon           string
+	Cover          string
 	CurrentVersion *int
+	// cached content stats, see entity.ContentStats
+	WordCount          int
+	ReadingTimeMinutes int
+	VersionCount       int
 }
 
 func (m *entityModel) TableName() string {
@@ -27,12 +42,19 @@ func (m *entityModel) TableName() string {
 func (m *entityModel) toDTO() entity.Entity {
 	return entity.Entity{
 		ID:             m.ID,
+		WorkspaceID:    m.WorkspaceID,
 		Type:           m.Type,
 		Name:           m.Name,
+		Slug:           m.Slug,
 		Content:        m.Content,
 		ParentID:       m.ParentID,
 		CreatedBy:      m.CreatedBy,
 		UpdatedBy:      m.UpdatedBy,
+		OwnerID:        m.OwnerID,
+		Icon:           m.Icon,
+		IconURL:        entity.AppearanceURL(m.Icon),
+		Cover:          m.Cover,
+		CoverURL:       entity.AppearanceURL(m.Cover),
 		CurrentVersion: m.CurrentVersion,
 		CreatedAt:      m.CreatedAt,
 		UpdatedAt:      m.UpdatedAt,
@@ -40,13 +62,18 @@ func (m *entityModel) toDTO() entity.Entity {
 }
 
 type versionModel struct {
-	EntityID  uuid.UUID
-	Name      string
-	Content   string
-	ParentID  *uuid.UUID
-	CreatedBy uuid.UUID
-	CreatedAt time.Time
-	Version   int
+	EntityID     uuid.UUID
+	Name         string
+	Content      string
+	ContentKeyID *string
+	ParentID     *uuid.UUID
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+	Version      int
+	Summary      string
+	MinorEdit    bool
+	Moved        bool       `gorm:"->;-:migration"`
+	MovedFrom    *uuid.UUID `gorm:"->;-:migration"`
 }
 
 func (m *versionModel) TableName() string {
@@ -64,16 +91,28 @@ func (m *versionModel) toDTO() entity.Entity {
 		CurrentVersion: &m.Version,
 		CreatedAt:      m.CreatedAt,
 		UpdatedAt:      m.CreatedAt,
+		Moved:          m.Moved,
+		MovedFrom:      m.MovedFrom,
+		Summary:        m.Summary,
+		MinorEdit:      m.MinorEdit,
 	}
 }
 
 type entityListItemModel struct {
 	db.Base
-	ID       uuid.UUID
-	Type     entity.Type
-	Name     string
-	ParentID *uuid.UUID
-	Depth    int
+	ID                 uuid.UUID
+	Type               entity.Type
+	Name               string
+	Slug               string
+	ParentID           *uuid.UUID
+	OwnerID            uuid.UUID
+	WordCount          int
+	ReadingTimeMinutes int
+	Icon               string
+	Cover              string
+	Pinned             bool
+	SortOrder          int
+	Depth              int
 }
 
 func (m *entityListItemModel) TableName() string {
@@ -82,10 +121,506 @@ func (m *entityListItemModel) TableName() string {
 
 func (m entityListItemModel) toDTO() entity.ListItem {
 	return entity.ListItem{
-		ID:       m.ID,
-		Type:     m.Type,
-		Name:     m.Name,
-		ParentID: m.ParentID,
-		Depth:    m.Depth,
+		ID:                 m.ID,
+		Type:               m.Type,
+		Name:               m.Name,
+		Slug:               m.Slug,
+		ParentID:           m.ParentID,
+		OwnerID:            m.OwnerID,
+		WordCount:          m.WordCount,
+		ReadingTimeMinutes: m.ReadingTimeMinutes,
+		Icon:               m.Icon,
+		IconURL:            entity.AppearanceURL(m.Icon),
+		Cover:              m.Cover,
+		CoverURL:           entity.AppearanceURL(m.Cover),
+		Pinned:             m.Pinned,
+		SortOrder:          m.SortOrder,
+		Depth:              m.Depth,
+	}
+}
+
+type linkModel struct {
+	SourceID uuid.UUID
+	TargetID uuid.UUID
+}
+
+func (m *linkModel) TableName() string {
+	return "entity_links"
+}
+
+// relationModel is one direction of a relation; every relation is stored as two rows.
+type relationModel struct {
+	EntityID  uuid.UUID
+	RelatedID uuid.UUID
+	CreatedAt time.Time
+}
+
+func (m *relationModel) TableName() string {
+	return "entity_relations"
+}
+
+type variantModel struct {
+	EntityID  uuid.UUID
+	GroupID   uuid.UUID
+	Language  string
+	CreatedAt time.Time
+	Name      string `gorm:"->;-:migration"`
+}
+
+func (m *variantModel) TableName() string {
+	return "entity_variants"
+}
+
+func (m variantModel) toDTO() entity.Variant {
+	return entity.Variant{
+		EntityID:  m.EntityID,
+		GroupID:   m.GroupID,
+		Language:  m.Language,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type snapshotModel struct {
+	ID          uuid.UUID
+	EntityID    uuid.UUID
+	Label       string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	EntityCount int `gorm:"->;-:migration"`
+}
+
+func (m *snapshotModel) TableName() string {
+	return "entity_snapshots"
+}
+
+func (m *snapshotModel) toDTO() entity.Snapshot {
+	return entity.Snapshot{
+		ID:          m.ID,
+		EntityID:    m.EntityID,
+		Label:       m.Label,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		EntityCount: m.EntityCount,
+	}
+}
+
+type variableModel struct {
+	EntityID  uuid.UUID
+	Key       string
+	Value     string
+	UpdatedBy uuid.UUID
+	UpdatedAt time.Time
+}
+
+func (m *variableModel) TableName() string {
+	return "entity_variables"
+}
+
+func (m *variableModel) toDTO() entity.Variable {
+	return entity.Variable{
+		EntityID:  m.EntityID,
+		Key:       m.Key,
+		Value:     m.Value,
+		UpdatedBy: m.UpdatedBy,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// snapshotItemModel is a snapshot item read with the key of its content.
+type snapshotItemModel struct {
+	entity.SnapshotItem
+	ContentKeyID *string
+}
+
+type defaultPermissionModel struct {
+	EntityID  uuid.UUID
+	UserID    uuid.UUID
+	Role      auth.Role
+	CreatedAt time.Time
+}
+
+func (m *defaultPermissionModel) TableName() string {
+	return "entity_default_permissions"
+}
+
+type spaceModel struct {
+	db.Base
+	ID            uuid.UUID
+	ParentID      *uuid.UUID
+	OwnerID       uuid.UUID
+	SpaceSettings spaceSettingsColumn
+}
+
+func (m *spaceModel) TableName() string {
+	return "entities"
+}
+
+func (m spaceModel) toDTO() entity.Space {
+	return entity.Space{
+		EntityID: m.ID,
+		OwnerID:  m.OwnerID,
+		Settings: entity.SpaceSettings(m.SpaceSettings),
+	}
+}
+
+type lockModel struct {
+	EntityID   uuid.UUID
+	UserID     uuid.UUID
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+func (m *lockModel) TableName() string {
+	return "entity_locks"
+}
+
+func (m *lockModel) toDTO() entity.Lock {
+	return entity.Lock{
+		EntityID:   m.EntityID,
+		UserID:     m.UserID,
+		AcquiredAt: m.AcquiredAt,
+		ExpiresAt:  m.ExpiresAt,
+	}
+}
+
+type autosaveModel struct {
+	EntityID     uuid.UUID
+	UserID       uuid.UUID
+	Content      string
+	ContentKeyID *string
+	SavedAt      time.Time
+}
+
+func (m *autosaveModel) TableName() string {
+	return "entity_autosaves"
+}
+
+func (m *autosaveModel) toDTO() entity.Autosave {
+	return entity.Autosave{Content: m.Content, SavedAt: m.SavedAt}
+}
+
+type eventModel struct {
+	ID           int64 `gorm:"primaryKey"`
+	EntityID     uuid.UUID
+	Type         entity.EventType
+	ActorID      *uuid.UUID
+	Version      *int
+	FromParentID *uuid.UUID
+	ToParentID   *uuid.UUID
+	SubjectID    *uuid.UUID
+	Role         *string
+	CreatedAt    time.Time
+	EntityName   string `gorm:"->;-:migration"`
+	Summary      string `gorm:"->;-:migration"`
+	MinorEdit    bool   `gorm:"->;-:migration"`
+}
+
+func (m *eventModel) TableName() string {
+	return "entity_events"
+}
+
+func (m eventModel) toDTO() entity.Event {
+	return entity.Event{
+		ID:           m.ID,
+		EntityID:     m.EntityID,
+		EntityName:   m.EntityName,
+		Type:         m.Type,
+		ActorID:      m.ActorID,
+		Version:      m.Version,
+		FromParentID: m.FromParentID,
+		ToParentID:   m.ToParentID,
+		SubjectID:    m.SubjectID,
+		Role:         lo.FromPtr(m.Role),
+		Summary:      m.Summary,
+		MinorEdit:    m.MinorEdit,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+// historyModel is a row of the history of an entity: a version, or an event when Source says so.
+type historyModel struct {
+	Source       entity.HistorySource
+	Seq          int64
+	CreatedAt    time.Time
+	EntityID     uuid.UUID
+	EntityName   string
+	ParentID     *uuid.UUID
+	ActorID      *uuid.UUID
+	Version      *int
+	Type         entity.EventType
+	FromParentID *uuid.UUID
+	ToParentID   *uuid.UUID
+	SubjectID    *uuid.UUID
+	Role         *string
+}
+
+func (m historyModel) toDTO() entity.HistoryItem {
+	item := entity.HistoryItem{
+		CreatedAt: m.CreatedAt,
+		Cursor:    entity.HistoryCursor{CreatedAt: m.CreatedAt, Source: m.Source, Seq: m.Seq},
+	}
+	if m.Source == entity.HistorySourceVersions {
+		version := versionModel{
+			EntityID:  m.EntityID,
+			Name:      m.EntityName,
+			ParentID:  m.ParentID,
+			CreatedBy: lo.FromPtr(m.ActorID),
+			CreatedAt: m.CreatedAt,
+			Version:   lo.FromPtr(m.Version),
+		}
+		dto := version.toDTO()
+		item.Type, item.Version = entity.HistoryVersion, &dto
+		return item
+	}
+
+	event := eventModel{
+		ID:           m.Seq,
+		EntityID:     m.EntityID,
+		Type:         m.Type,
+		ActorID:      m.ActorID,
+		Version:      m.Version,
+		FromParentID: m.FromParentID,
+		ToParentID:   m.ToParentID,
+		SubjectID:    m.SubjectID,
+		Role:         m.Role,
+		CreatedAt:    m.CreatedAt,
+		EntityName:   m.EntityName,
+	}
+	dto := event.toDTO()
+	item.Type, item.Event = m.Type.HistoryItemType(), &dto
+
+	return item
+}
+
+type viewModel struct {
+	EntityID  uuid.UUID
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	ViewedAt  time.Time
+}
+
+func (m *viewModel) TableName() string {
+	return "entity_views"
+}
+
+type popularModel struct {
+	ID                 uuid.UUID
+	Type               entity.Type
+	Name               string
+	Slug               string
+	ParentID           *uuid.UUID
+	OwnerID            uuid.UUID
+	WordCount          int
+	ReadingTimeMinutes int
+	Icon               string
+	Cover              string
+	Views              int64
+}
+
+func (m popularModel) toDTO() entity.PopularEntity {
+	return entity.PopularEntity{
+		ListItem: entity.ListItem{
+			ID:                 m.ID,
+			Type:               m.Type,
+			Name:               m.Name,
+			Slug:               m.Slug,
+			ParentID:           m.ParentID,
+			OwnerID:            m.OwnerID,
+			WordCount:          m.WordCount,
+			ReadingTimeMinutes: m.ReadingTimeMinutes,
+			Icon:               m.Icon,
+			IconURL:            entity.AppearanceURL(m.Icon),
+			Cover:              m.Cover,
+			CoverURL:           entity.AppearanceURL(m.Cover),
+		},
+		Views: m.Views,
+	}
+}
+
+type orphanedModel struct {
+	ID             uuid.UUID
+	Name           string
+	Slug           string
+	OwnerID        uuid.UUID
+	OwnerDeletedAt time.Time
+}
+
+func (m orphanedModel) toDTO() entity.OrphanedEntity {
+	return entity.OrphanedEntity{
+		ID:             m.ID,
+		Name:           m.Name,
+		Slug:           m.Slug,
+		OwnerID:        m.OwnerID,
+		OwnerDeletedAt: m.OwnerDeletedAt,
+	}
+}
+
+// staleDraftModel is a stale draft read with what its creator is reminded through.
+type staleDraftModel struct {
+	entity.StaleDraft
+	Email        *string
+	EmailEnabled bool
+}
+
+// exportItemModel is an export item read with the key of its content.
+type exportItemModel struct {
+	entity.ExportItem
+	ContentKeyID *string
+}
+
+// contentRowModel is a row of a table holding content, with the columns that key it in that table.
+type contentRowModel struct {
+	EntityID     uuid.UUID
+	Version      int
+	UserID       uuid.UUID
+	Content      string
+	ContentKeyID *string
+}
+
+type listEntryModel struct {
+	ID                 uuid.UUID
+	Type               entity.Type
+	Name               string
+	Slug               string
+	ParentID           *uuid.UUID
+	OwnerID            uuid.UUID
+	WordCount          int
+	ReadingTimeMinutes int
+	Icon               string
+	Cover              string
+	IsDraft            bool
+	CreatedBy          uuid.UUID
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Breadcrumbs        breadcrumbsColumn
+	ChildrenCount      int
+	SubtreeSize        int
+}
+
+// breadcrumbsColumn reads the JSON array of {id, name, slug} objects the list query builds.
+type breadcrumbsColumn []entity.Breadcrumb
+
+func (c *breadcrumbsColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("breadcrumbsColumn.Scan: unsupported type %T", src)
+	}
+}
+
+func (m listEntryModel) toDTO() entity.ListEntry {
+	return entity.ListEntry{
+		ListItem: entity.ListItem{
+			ID:                 m.ID,
+			Type:               m.Type,
+			Name:               m.Name,
+			Slug:               m.Slug,
+			ParentID:           m.ParentID,
+			OwnerID:            m.OwnerID,
+			WordCount:          m.WordCount,
+			ReadingTimeMinutes: m.ReadingTimeMinutes,
+			Icon:               m.Icon,
+			IconURL:            entity.AppearanceURL(m.Icon),
+			Cover:              m.Cover,
+			CoverURL:           entity.AppearanceURL(m.Cover),
+		},
+		IsDraft:       m.IsDraft,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+		Breadcrumbs:   m.Breadcrumbs,
+		ChildrenCount: m.ChildrenCount,
+		SubtreeSize:   m.SubtreeSize,
+	}
+}
+
+type lintTargetModel struct {
+	EntityID     uuid.UUID
+	Version      int
+	Content      string
+	ContentKeyID *string
+	Language     string
+}
+
+type lintReportModel struct {
+	EntityID  uuid.UUID
+	Version   int
+	Status    entity.LintStatus
+	Findings  findingsColumn
+	CheckedAt *time.Time
+	Disabled  bool
+}
+
+func (m lintReportModel) toDTO() entity.LintReport {
+	report := entity.LintReport{
+		EntityID:  m.EntityID,
+		Version:   m.Version,
+		Status:    m.Status,
+		CheckedAt: m.CheckedAt,
+		Findings:  m.Findings,
+	}
+	if m.Disabled {
+		report.Status, report.CheckedAt, report.Findings = entity.LintDisabled, nil, nil
+	}
+
+	return report
+}
+
+// findingsColumn stores the findings of a lint as a JSON array.
+type findingsColumn []entity.LintFinding
+
+func (c findingsColumn) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]entity.LintFinding(c))
+	if err != nil {
+		return nil, fmt.Errorf("findingsColumn.Value: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (c *findingsColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("findingsColumn.Scan: unsupported type %T", src)
+	}
+}
+
+// spaceSettingsColumn stores the settings of a space as a JSON object; NULL reads as the defaults.
+type spaceSettingsColumn entity.SpaceSettings
+
+func (c spaceSettingsColumn) Value() (driver.Value, error) {
+	b, err := json.Marshal(entity.SpaceSettings(c))
+	if err != nil {
+		return nil, fmt.Errorf("spaceSettingsColumn.Value: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (c *spaceSettingsColumn) Scan(src any) error {
+	*c = spaceSettingsColumn{}
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("spaceSettingsColumn.Scan: unsupported type %T", src)
 	}
 }
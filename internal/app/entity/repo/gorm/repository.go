@@ -4,12 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // buildVisibilityFilter if userID is nil, show all entities, otherwise show only published entities and drafts created by the user.
@@ -20,27 +27,100 @@ func buildVisibilityFilter(userID *uuid.UUID) (string, []any) {
 	return "(current_version IS NOT NULL OR updated_by = ?)", []any{*userID}
 }
 
+// inWorkspace restricts column, holding entity IDs, to the entities of the workspace of ctx.
+// Tables keyed by entity have no workspace of their own.
+func inWorkspace(ctx context.Context, column string) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		entities := tx.Session(&gorm.Session{NewDB: true}).Table("entities").Select("id").Where(db.WorkspaceCond(ctx, "workspace_id"))
+		return tx.Where(column+" IN (?)", entities)
+	}
+}
+
+// ContentCipher encrypts content at rest, see entity.EncryptionConfig.
+type ContentCipher interface {
+	// KeyID returns the id of the key content is encrypted with now.
+	KeyID() (string, error)
+	Encrypt(plaintext string, aad []byte) (ciphertext, keyID string, err error)
+	Decrypt(ciphertext, keyID string, aad []byte) (string, error)
+	// Rewrap moves ciphertext encrypted with keyID to the current key.
+	Rewrap(ciphertext, keyID string) (string, string, error)
+}
+
 type gormRepo struct {
 	db *gorm.DB
+	// recursiveHierarchy walks parent_id instead of reading the materialized paths, see entity.Config.
+	recursiveHierarchy bool
+	// hierarchyQueries holds the text of the hierarchy queries by hierarchyQueryKey.
+	hierarchyQueries sync.Map
+	// cipher decrypts encrypted content, and encrypts what is written if encrypt is set; nil without a content key.
+	cipher  ContentCipher
+	encrypt bool
 }
 
-func NewRepository(db *gorm.DB) (*gormRepo, error) {
+func NewRepository(db *gorm.DB, cfg entity.Config, cipher ContentCipher) (*gormRepo, error) {
 	if db == nil {
 		return nil, errors.New("db is nil")
 	}
-	return &gormRepo{db: db}, nil
+	if cfg.Encryption.Enabled && cipher == nil {
+		return nil, errors.New("encryption is enabled without a cipher")
+	}
+	return &gormRepo{db: db, recursiveHierarchy: cfg.RecursiveHierarchy, cipher: cipher, encrypt: cfg.Encryption.Enabled}, nil
+}
+
+// seal returns content as it is stored in the row aad names: encrypted if encryption is enabled, with
+// the id of its key, or as it is with a nil id.
+func (r *gormRepo) seal(content string, aad []byte) (string, *string, error) {
+	if !r.encrypt {
+		return content, nil, nil
+	}
+	ciphertext, keyID, err := r.cipher.Encrypt(content, aad)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return ciphertext, &keyID, nil
+}
+
+// open returns content stored in the row aad names decrypted with the key keyID, or as it is if keyID
+// is nil.
+func (r *gormRepo) open(content string, keyID *string, aad []byte) (string, error) {
+	if keyID == nil {
+		return content, nil
+	}
+	if r.cipher == nil {
+		return "", errors.New("content is encrypted but no content key is set")
+	}
+
+	return r.cipher.Decrypt(content, *keyID, aad)
+}
+
+// entityAAD, versionAAD and autosaveAAD name the row content is stored in, see secure.ContentAAD. The
+// content of an entity is bound to its current version, zero for a draft, and an autosave to its user.
+func entityAAD(id uuid.UUID, version int) []byte {
+	return secure.ContentAAD("entities", id, version)
+}
+
+func versionAAD(entityID uuid.UUID, version int) []byte {
+	return secure.ContentAAD("entity_versions", entityID, version)
+}
+
+func autosaveAAD(entityID, userID uuid.UUID) []byte {
+	return secure.ContentAAD("entity_autosaves", entityID, userID)
 }
 
 func (r *gormRepo) Get(ctx context.Context, id uuid.UUID) (entity.Entity, error) {
 	var model entityModel
 
-	err := r.db.WithContext(ctx).Where("id = $1", id).First(&model).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = entity.ErrEntityNotFound()
 		}
 		return entity.Entity{}, fmt.Errorf("gormRepo.Get: %w", err)
 	}
+	if model.Content, err = r.open(model.Content, model.ContentKeyID, entityAAD(model.ID, lo.FromPtr(model.CurrentVersion))); err != nil {
+		return entity.Entity{}, fmt.Errorf("gormRepo.Get: %w", err)
+	}
 
 	return model.toDTO(), nil
 }
@@ -48,7 +128,7 @@ func (r *gormRepo) Get(ctx context.Context, id uuid.UUID) (entity.Entity, error)
 func (r *gormRepo) GetListItem(ctx context.Context, id uuid.UUID) (entity.ListItem, error) {
 	var model entityListItemModel
 
-	err := r.db.WithContext(ctx).Where("id = $1", id).First(&model).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = entity.ErrEntityNotFound()
@@ -59,10 +139,44 @@ func (r *gormRepo) GetListItem(ctx context.Context, id uuid.UUID) (entity.ListIt
 	return model.toDTO(), nil
 }
 
+func (r *gormRepo) GetListItems(ctx context.Context, ids []uuid.UUID) ([]entity.ListItem, error) {
+	if len(ids) == 0 {
+		return []entity.ListItem{}, nil
+	}
+	var models []entityListItemModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id IN ?", ids).Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetListItems: %w", err)
+	}
+
+	return lo.Map(models, func(m entityListItemModel, _ int) entity.ListItem { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) GetMany(ctx context.Context, ids []uuid.UUID) ([]entity.Entity, error) {
+	if len(ids) == 0 {
+		return []entity.Entity{}, nil
+	}
+	var models []entityModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id IN ?", ids).Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetMany: %w", err)
+	}
+	for i := range models {
+		m := &models[i]
+		if m.Content, err = r.open(m.Content, m.ContentKeyID, entityAAD(m.ID, lo.FromPtr(m.CurrentVersion))); err != nil {
+			return nil, fmt.Errorf("gormRepo.GetMany: %w", err)
+		}
+	}
+
+	return lo.Map(models, func(m entityModel, _ int) entity.Entity { return m.toDTO() }), nil
+}
+
 func (r *gormRepo) GetAll(ctx context.Context) ([]entity.ListItem, error) {
 	var models []entityListItemModel
 
-	err := r.db.WithContext(ctx).Find(&models).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.GetAll: %w", err)
 	}
@@ -77,21 +191,11 @@ func (r *gormRepo) GetHierarchy(ctx context.Context, ids []uuid.UUID, maxDepth i
 	}
 	var models []entityListItemModel
 
-	recursiveQuery, args := r.getRecursiveQuery(hType, maxDepth, ids, userID)
-	childrenResult := " SELECT * FROM children "
-	parentsResult := " SELECT * FROM parents "
-	switch hType {
-	case entity.HierarchyTypeChildrenOnly:
-		recursiveQuery += childrenResult
-	case entity.HierarchyTypeParentsOnly:
-		recursiveQuery += parentsResult
-	case entity.HierarchyTypeChildrenAndParents:
-		recursiveQuery += childrenResult + " UNION " + parentsResult
-	default:
-		return nil, fmt.Errorf("gormRepo.GetHierarchy: %w", fmt.Errorf("invalid hierarchy type: %v", hType))
+	query, args, err := r.hierarchyQuery(ctx, hType, maxDepth, ids, userID)
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetHierarchy: %w", err)
 	}
-
-	err := r.db.WithContext(ctx).Raw(recursiveQuery, args...).Scan(&models).Error
+	err = r.db.WithContext(ctx).Raw(query, args...).Scan(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.GetHierarchy: %w", err)
 	}
@@ -99,43 +203,281 @@ func (r *gormRepo) GetHierarchy(ctx context.Context, ids []uuid.UUID, maxDepth i
 	return lo.Map(models, func(m entityListItemModel, _ int) entity.ListItem { return m.toDTO() }), nil
 }
 
+// GetMeta reads the stats cached on the entity row; children, views and editors are counted from indexed rows.
+// Children include drafts of other users.
+func (r *gormRepo) GetMeta(ctx context.Context, id uuid.UUID, lastEditorsLimit int) (entity.Meta, error) {
+	var model entityModel
+
+	err := r.db.WithContext(ctx).
+		Select("word_count", "reading_time_minutes", "version_count").
+		Scopes(db.InWorkspace(ctx)).
+		Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = entity.ErrEntityNotFound()
+		}
+		return entity.Meta{}, fmt.Errorf("gormRepo.GetMeta: %w", err)
+	}
+
+	var views int64
+	err = r.db.WithContext(ctx).Model(&viewModel{}).Where("entity_id = ?", id).Count(&views).Error
+	if err != nil {
+		return entity.Meta{}, fmt.Errorf("gormRepo.GetMeta: %w", err)
+	}
+
+	var childrenCount int64
+	err = r.db.WithContext(ctx).Model(&entityModel{}).Where("parent_id = ?", id).Count(&childrenCount).Error
+	if err != nil {
+		return entity.Meta{}, fmt.Errorf("gormRepo.GetMeta: %w", err)
+	}
+
+	editors := make([]entity.Editor, 0)
+	err = r.db.WithContext(ctx).
+		Model(&versionModel{}).
+		Select("created_by AS user_id, MAX(created_at) AS edited_at").
+		Where("entity_id = ?", id).
+		Group("created_by").
+		Order("edited_at DESC").
+		Limit(lastEditorsLimit).
+		Scan(&editors).Error
+	if err != nil {
+		return entity.Meta{}, fmt.Errorf("gormRepo.GetMeta: %w", err)
+	}
+
+	return entity.Meta{
+		ContentStats: entity.ContentStats{
+			WordCount:          model.WordCount,
+			ReadingTimeMinutes: model.ReadingTimeMinutes,
+		},
+		VersionCount:  model.VersionCount,
+		ChildrenCount: int(childrenCount),
+		Views:         views,
+		LastEditors:   editors,
+	}, nil
+}
+
+// GetContributors aggregates entity_versions, served by idx_entity_versions_contributors.
+func (r *gormRepo) GetContributors(ctx context.Context, id uuid.UUID) ([]entity.Contributor, error) {
+	contributors := make([]entity.Contributor, 0)
+
+	err := r.db.WithContext(ctx).
+		Model(&versionModel{}).
+		Select("created_by AS user_id, COUNT(*) AS version_count, MAX(created_at) AS last_contributed_at").
+		Scopes(inWorkspace(ctx, "entity_id")).
+		Where("entity_id = ?", id).
+		Group("created_by").
+		Order("last_contributed_at DESC, user_id").
+		Scan(&contributors).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetContributors: %w", err)
+	}
+
+	return contributors, nil
+}
+
+// GetActivity walks the subtree through deleted entities too, so their events stay in the feed.
+// Entities moved out of the subtree take their history with them.
+func (r *gormRepo) GetActivity(ctx context.Context, id uuid.UUID, before int64, limit, maxDepth int, userID *uuid.UUID, includeMinor bool) ([]entity.Event, error) {
+	vFilter, vArgs := buildVisibilityFilter(userID)
+
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Where(vFilter, vArgs...).Count(&count).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetActivity: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("gormRepo.GetActivity: %w", entity.ErrEntityNotFound())
+	}
+
+	query := fmt.Sprintf(`
+WITH RECURSIVE subtree AS (
+    SELECT id, 1 AS depth
+    FROM entities
+    WHERE id = ?
+
+    UNION ALL
+
+    SELECT e.id, s.depth + 1
+    FROM subtree s
+    JOIN entities e ON e.parent_id = s.id AND %s
+    WHERE s.depth < ?
+)
+SELECT ev.id, ev.entity_id, e.name AS entity_name, ev.type, ev.actor_id, ev.version,
+       ev.from_parent_id, ev.to_parent_id, COALESCE(v.summary, '') AS summary,
+       ev.type = ? AND COALESCE(v.minor_edit, FALSE) AS minor_edit, ev.created_at
+FROM entity_events ev
+JOIN subtree s ON s.id = ev.entity_id
+JOIN entities e ON e.id = ev.entity_id
+LEFT JOIN entity_versions v ON v.entity_id = ev.entity_id AND v.version = ev.version
+WHERE (? = 0 OR ev.id < ?) AND ev.type NOT IN ?
+  AND (? OR ev.type <> ? OR NOT COALESCE(v.minor_edit, FALSE))
+ORDER BY ev.id DESC
+LIMIT ?
+`, vFilter)
+	args := make([]any, 0, 10+len(vArgs))
+	args = append(args, id)
+	args = append(args, vArgs...)
+	args = append(args, maxDepth, entity.EventEdited, before, before, []entity.EventType{entity.EventRoleGranted, entity.EventRoleRevoked},
+		includeMinor, entity.EventEdited, limit)
+
+	var models []eventModel
+	if err = r.db.WithContext(ctx).Raw(query, args...).Scan(&models).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetActivity: %w", err)
+	}
+
+	return lo.Map(models, func(m eventModel, _ int) entity.Event { return m.toDTO() }), nil
+}
+
+// GetHistory orders by time, then source and sequence number, so items created together keep their
+// order across pages. Versions carry the name the entity had then, events the name it has now.
+func (r *gormRepo) GetHistory(ctx context.Context, id uuid.UUID, before *entity.HistoryCursor, limit int) ([]entity.HistoryItem, error) {
+	cursorCond := gorm.Expr("TRUE")
+	if before != nil {
+		cursorCond = gorm.Expr("(h.created_at, h.source, h.seq) < (?, ?::INT, ?)", before.CreatedAt, before.Source, before.Seq)
+	}
+
+	var models []historyModel
+	err := r.db.WithContext(ctx).Raw(`
+SELECT h.*
+FROM (SELECT ?::INT AS source, v.version::BIGINT AS seq, v.created_at, v.entity_id, v.name AS entity_name,
+             v.parent_id, v.created_by AS actor_id, v.version, '' AS type,
+             NULL::UUID AS from_parent_id, NULL::UUID AS to_parent_id, NULL::UUID AS subject_id, NULL::TEXT AS role
+      FROM entity_versions v
+      WHERE v.entity_id = ?
+
+      UNION ALL
+
+      SELECT ?::INT, ev.id, ev.created_at, ev.entity_id, e.name,
+             NULL, ev.actor_id, ev.version, ev.type,
+             ev.from_parent_id, ev.to_parent_id, ev.subject_id, ev.role
+      FROM entity_events ev
+      JOIN entities e ON e.id = ev.entity_id
+      WHERE ev.entity_id = ? AND ev.type <> ?) h
+WHERE h.entity_id IN (SELECT id FROM entities WHERE ?) AND ?
+ORDER BY h.created_at DESC, h.source DESC, h.seq DESC
+LIMIT ?`,
+		entity.HistorySourceVersions, id, entity.HistorySourceEvents, id, entity.EventEdited,
+		db.WorkspaceCond(ctx, "workspace_id"), cursorCond, limit).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetHistory: %w", err)
+	}
+
+	return lo.Map(models, func(m historyModel, _ int) entity.HistoryItem { return m.toDTO() }), nil
+}
+
 func (r *gormRepo) GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error) {
 	var model versionModel
 
-	err := r.db.WithContext(ctx).Where("entity_id = $1 AND version = $2", id, version).First(&model).Error
+	err := r.versions(ctx, true).Where("v.entity_id = ? AND v.version = ?", id, version).Take(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = entity.ErrEntityNotFound()
 		}
 		return entity.Entity{}, fmt.Errorf("gormRepo.GetVersion: %w", err)
 	}
+	if model.Content, err = r.open(model.Content, model.ContentKeyID, versionAAD(model.EntityID, model.Version)); err != nil {
+		return entity.Entity{}, fmt.Errorf("gormRepo.GetVersion: %w", err)
+	}
 
 	return model.toDTO(), nil
 }
 
-func (r *gormRepo) GetVersionsList(ctx context.Context, id uuid.UUID) ([]entity.Entity, error) {
+func (r *gormRepo) GetVersionsList(ctx context.Context, id uuid.UUID, before, limit int, includeContent bool) ([]entity.Entity, error) {
 	var models []versionModel
 
-	err := r.db.WithContext(ctx).Where("entity_id = $1", id).Order("version DESC").Find(&models).Error
+	keyset := db.Keyset{Columns: []string{"v.version"}, Desc: true}
+	query := r.versions(ctx, includeContent).Where("v.entity_id = ?", id)
+	if before > 0 {
+		query = query.Scopes(keyset.After(before))
+	}
+	err := query.Scopes(keyset.Order()).Limit(limit).Find(&models).Error
 	if err != nil {
 		return nil, fmt.Errorf("gormRepo.GetVersionsList: %w", err)
 	}
+	for i := range models {
+		m := &models[i]
+		if m.Content, err = r.open(m.Content, m.ContentKeyID, versionAAD(m.EntityID, m.Version)); err != nil {
+			return nil, fmt.Errorf("gormRepo.GetVersionsList: %w", err)
+		}
+	}
 
 	return lo.Map(models, func(m versionModel, _ int) entity.Entity { return m.toDTO() }), nil
 }
 
+func (r *gormRepo) GetAuthoredVersions(ctx context.Context, userID uuid.UUID) ([]entity.AuthoredVersion, error) {
+	versions := make([]entity.AuthoredVersion, 0)
+
+	err := r.db.WithContext(ctx).Table("entity_versions v").
+		Select("v.entity_id, v.name, v.version, v.created_at").
+		Scopes(inWorkspace(ctx, "v.entity_id")).
+		Where("v.created_by = ?", userID).
+		Order("v.created_at, v.entity_id, v.version").
+		Scan(&versions).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetAuthoredVersions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// versions selects entity versions of the workspace with the parent they moved away from, taken from
+// the move event recorded with the version. Without withContent, the content column is not read.
+func (r *gormRepo) versions(ctx context.Context, withContent bool) *gorm.DB {
+	columns := "v.entity_id, v.name, v.parent_id, v.created_by, v.created_at, v.version, v.summary, v.minor_edit"
+	if withContent {
+		columns = "v.*"
+	}
+
+	return r.db.WithContext(ctx).Table("entity_versions v").
+		Select(columns+", ev.id IS NOT NULL AS moved, ev.from_parent_id AS moved_from").
+		Joins("LEFT JOIN entity_events ev ON ev.entity_id = v.entity_id AND ev.version = v.version AND ev.type = ?", entity.EventMoved).
+		Scopes(inWorkspace(ctx, "v.entity_id"))
+}
+
 func (r *gormRepo) CreateDraft(ctx context.Context, req entity.CreateEntityReq, id uuid.UUID) error {
+	content, keyID, err := r.seal(req.Content, entityAAD(id, 0))
+	if err != nil {
+		return fmt.Errorf("gormRepo.CreateDraft: %w", err)
+	}
 	model := &entityModel{
-		ID:        id,
-		Type:      req.Type,
-		Name:      req.Name,
-		Content:   req.Content,
-		ParentID:  req.ParentID,
-		CreatedBy: req.UserID,
-		UpdatedBy: req.UserID,
+		ID:           id,
+		WorkspaceID:  contextx.WorkspaceID(ctx),
+		Type:         req.Type,
+		Name:         req.Name,
+		Slug:         req.Slug,
+		Content:      content,
+		ContentKeyID: keyID,
+		ParentID:     req.ParentID,
+		CreatedBy:    req.UserID,
+		UpdatedBy:    req.UserID,
+		OwnerID:      req.UserID,
+
+		WordCount:          req.Stats.WordCount,
+		ReadingTimeMinutes: req.Stats.ReadingTimeMinutes,
 	}
 
-	err := r.db.WithContext(ctx).Create(model).Error
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		if err := setPath(tx, id, req.ParentID); err != nil {
+			return err
+		}
+		if err := claimSlug(tx, id, req.Slug); err != nil {
+			return err
+		}
+		event := &eventModel{EntityID: id, Type: entity.EventCreated, ActorID: &req.UserID}
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		if req.VariantOf != nil {
+			if err := joinVariant(tx, id, *req.VariantOf, req.Language, model.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		return replaceLinks(tx, id, req.Links)
+	})
 	if err != nil {
 		return fmt.Errorf("gormRepo.CreateDraft: %w", err)
 	}
@@ -146,49 +488,118 @@ func (r *gormRepo) CreateDraft(ctx context.Context, req entity.CreateEntityReq,
 func (r *gormRepo) Create(ctx context.Context, req entity.CreateEntityReq, id uuid.UUID, createdAt time.Time) error {
 	const sqlCTE = `
 WITH ins AS (
-  INSERT INTO entities (id, type, name, content, parent_id, created_by, updated_by, current_version, created_at, updated_at)
-  VALUES ($1,$2,$3,$4,$5,$6,$6,1,$7,$7)
+  INSERT INTO entities (id, type, name, content, parent_id, created_by, updated_by, owner_id, current_version, created_at,
+                        updated_at, word_count, reading_time_minutes, version_count, slug, workspace_id, content_key_id)
+  VALUES ($1,$2,$3,$4,$5,$6,$6,$6,1,$7,$7,$8,$9,1,$10,$11,$12)
 )
-INSERT INTO entity_versions (entity_id, name, content, parent_id, created_by, created_at, version)
-VALUES ($1, $3, $4, $5, $6, $7, 1)
+INSERT INTO entity_versions (entity_id, name, content, parent_id, created_by, created_at, version, content_key_id)
+VALUES ($1, $3, $13, $5, $6, $7, 1, $14)
 `
+	content, keyID, err := r.seal(req.Content, entityAAD(id, 1))
+	if err != nil {
+		return fmt.Errorf("entity.create: %w", err)
+	}
+	versionContent, versionKeyID, err := r.seal(req.Content, versionAAD(id, 1))
+	if err != nil {
+		return fmt.Errorf("entity.create: %w", err)
+	}
 
-	res := r.db.WithContext(ctx).
-		Exec(sqlCTE,
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(sqlCTE,
 			id,
 			req.Type,
 			req.Name,
-			req.Content,
+			content,
 			req.ParentID,
 			req.UserID,
 			createdAt,
+			req.Stats.WordCount,
+			req.Stats.ReadingTimeMinutes,
+			req.Slug,
+			contextx.WorkspaceID(ctx),
+			keyID,
+			versionContent,
+			versionKeyID,
 		)
+		if res.Error != nil {
+			return res.Error
+		}
+		if err := setPath(tx, id, req.ParentID); err != nil {
+			return err
+		}
+		if err := claimSlug(tx, id, req.Slug); err != nil {
+			return err
+		}
+		event := &eventModel{
+			EntityID:  id,
+			Type:      entity.EventCreated,
+			ActorID:   &req.UserID,
+			Version:   lo.ToPtr(1),
+			CreatedAt: createdAt,
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		if req.VariantOf != nil {
+			if err := joinVariant(tx, id, *req.VariantOf, req.Language, createdAt); err != nil {
+				return err
+			}
+		}
 
-	if res.Error != nil {
-		return fmt.Errorf("entity.create: %w", res.Error)
+		return replaceLinks(tx, id, req.Links)
+	})
+	if err != nil {
+		return fmt.Errorf("entity.create: %w", err)
 	}
 
 	return nil
 }
 
+// UpdateDraft joins the transaction of ctx, if any.
 func (r *gormRepo) UpdateDraft(ctx context.Context, req entity.UpdateEntityReq) error {
+	content, keyID, err := r.seal(req.Content, entityAAD(req.ID, 0))
+	if err != nil {
+		return fmt.Errorf("gormRepo.UpdateDraft: %w", err)
+	}
 	updates := map[string]interface{}{
 		"name":            req.Name,
-		"content":         req.Content,
+		"slug":            req.Slug,
+		"content":         content,
+		"content_key_id":  keyID,
 		"parent_id":       req.ParentID,
 		"updated_by":      req.UserID,
 		"current_version": gorm.Expr("NULL"),
+
+		"word_count":           req.Stats.WordCount,
+		"reading_time_minutes": req.Stats.ReadingTimeMinutes,
 	}
-	result := r.db.WithContext(ctx).Model(&entityModel{}).Where("id = ?", req.ID).Updates(&updates)
-	if result.Error != nil {
-		return fmt.Errorf("gormRepo.UpdateDraft: %w", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("gormRepo.UpdateDraft: %w", entity.ErrEntityNotFound())
+	err = db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", req.ID).Updates(&updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrEntityNotFound()
+		}
+		if err := setPath(tx, req.ID, req.ParentID); err != nil {
+			return err
+		}
+		if err := claimSlug(tx, req.ID, req.Slug); err != nil {
+			return err
+		}
+		if err := deleteAutosave(tx, req.ID, req.UserID); err != nil {
+			return err
+		}
+
+		return replaceLinks(tx, req.ID, req.Links)
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.UpdateDraft: %w", err)
 	}
 	return nil
 }
 
+// Update joins the transaction of ctx, if any, so a move commits with the grants it changes.
 func (r *gormRepo) Update(ctx context.Context, req entity.UpdateEntityReq, updatedAt time.Time) error {
 	const sqlCTE = `
 WITH bumped AS (
@@ -199,111 +610,2148 @@ WITH bumped AS (
     parent_id       = $3,
     updated_by      = $4,
     updated_at      = $5,
-    current_version = COALESCE((
-      SELECT MAX(version)
-      FROM entity_versions
-      WHERE entity_id = $6
-    ), 0) + 1
+    current_version = $13,
+    version_count        = version_count + 1,
+    word_count           = $7,
+    reading_time_minutes = $8,
+    slug                 = $9,
+    content_key_id       = $10
   WHERE id = $6
   RETURNING id, current_version
 )
 INSERT INTO entity_versions (
   entity_id, name, content, parent_id,
-  created_by, created_at, version
+  created_by, created_at, version, content_key_id, summary, minor_edit
 )
 SELECT
-  id, $1, $2, $3,
-  $4,     $5,       current_version
+  id, $1, $14, $3,
+  $4,     $5,       current_version, $15, $11, $12
 FROM bumped;
 `
+	err := db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// the previous state decides which events the update produces; the lookup also keeps the
+		// update in the workspace
+		var old entityModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(db.InWorkspace(ctx)).
+			Select("name", "content", "content_key_id", "parent_id", "current_version").
+			Where("id = ?", req.ID).Take(&old).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrEntityNotFound()
+			}
+			return err
+		}
+		if old.Content, err = r.open(old.Content, old.ContentKeyID, entityAAD(req.ID, lo.FromPtr(old.CurrentVersion))); err != nil {
+			return err
+		}
+
+		// the content is sealed for the version it becomes, which the lock above keeps
+		var version int
+		err = tx.Model(&versionModel{}).Select("COALESCE(MAX(version), 0) + 1").Where("entity_id = ?", req.ID).Scan(&version).Error
+		if err != nil {
+			return err
+		}
+		content, keyID, err := r.seal(req.Content, entityAAD(req.ID, version))
+		if err != nil {
+			return err
+		}
+		versionContent, versionKeyID, err := r.seal(req.Content, versionAAD(req.ID, version))
+		if err != nil {
+			return err
+		}
 
-	res := r.db.
-		WithContext(ctx).
-		Exec(sqlCTE,
+		res := tx.Exec(sqlCTE,
 			req.Name,
-			req.Content,
+			content,
 			req.ParentID,
 			req.UserID,
 			updatedAt,
 			req.ID,
+			req.Stats.WordCount,
+			req.Stats.ReadingTimeMinutes,
+			req.Slug,
+			keyID,
+			req.Summary,
+			req.MinorEdit,
+			version,
+			versionContent,
+			versionKeyID,
 		)
-	if res.Error != nil {
-		return fmt.Errorf("entity.update: %w", res.Error)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return entity.ErrEntityNotFound()
+		}
+		if lo.FromPtr(old.ParentID) != lo.FromPtr(req.ParentID) {
+			if err = setPath(tx, req.ID, req.ParentID); err != nil {
+				return err
+			}
+		}
+		if err = claimSlug(tx, req.ID, req.Slug); err != nil {
+			return err
+		}
+
+		events := make([]eventModel, 0, 2)
+		if lo.FromPtr(old.ParentID) != lo.FromPtr(req.ParentID) {
+			events = append(events, eventModel{
+				EntityID: req.ID, Type: entity.EventMoved, ActorID: &req.UserID, Version: &version,
+				FromParentID: old.ParentID, ToParentID: req.ParentID, CreatedAt: updatedAt,
+			})
+		}
+		if len(events) == 0 || old.Name != req.Name || old.Content != req.Content {
+			events = append(events, eventModel{
+				EntityID: req.ID, Type: entity.EventEdited, ActorID: &req.UserID, Version: &version, CreatedAt: updatedAt,
+			})
+		}
+		if err = tx.Create(&events).Error; err != nil {
+			return err
+		}
+		if err = deleteAutosave(tx, req.ID, req.UserID); err != nil {
+			return err
+		}
+
+		return replaceLinks(tx, req.ID, req.Links)
+	})
+	if err != nil {
+		return fmt.Errorf("entity.update: %w", err)
 	}
-	if res.RowsAffected == 0 {
-		return fmt.Errorf("entity.update: %w", entity.ErrEntityNotFound())
+
+	return nil
+}
+
+func (r *gormRepo) Delete(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var deleted []uuid.UUID
+		err := tx.Model(&entityModel{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(db.InWorkspace(ctx)).
+			Where("id IN ?", ids).Pluck("id", &deleted).Error
+		if err != nil {
+			return err
+		}
+		if len(deleted) == 0 {
+			return entity.ErrEntityNotFound()
+		}
+		if err = tx.Where("id IN ?", deleted).Delete(&entityModel{}).Error; err != nil {
+			return err
+		}
+		events := lo.Map(deleted, func(id uuid.UUID, _ int) eventModel {
+			return eventModel{EntityID: id, Type: entity.EventDeleted, ActorID: &userID}
+		})
+
+		return tx.Create(&events).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// GetBacklinks if userID is nil, show all linking entities, otherwise show only published entities and drafts created by the user.
+func (r *gormRepo) GetBacklinks(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]entity.ListItem, error) {
+	var models []entityListItemModel
+
+	vFilter, vArgs := buildVisibilityFilter(userID)
+	err := r.db.WithContext(ctx).
+		Where("id IN (?)", r.db.Model(&linkModel{}).Select("source_id").Where("target_id = ?", id)).
+		Scopes(db.InWorkspace(ctx)).
+		Where(vFilter, vArgs...).
+		Order("name, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetBacklinks: %w", err)
+	}
+
+	return lo.Map(models, func(m entityListItemModel, _ int) entity.ListItem { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) GetDraftIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0)
+	err := r.db.WithContext(ctx).Model(&entityModel{}).
+		Scopes(db.InWorkspace(ctx)).
+		Where("created_by = ? AND current_version ISNULL", userID).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetDraftIDs: %w", err)
+	}
+
+	return ids, nil
+}
+
+// AddRelation stores the relation in both directions, so either side can be read with one lookup.
+func (r *gormRepo) AddRelation(ctx context.Context, id, relatedID uuid.UUID, createdAt time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var live int64
+		err := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id IN ?", []uuid.UUID{id, relatedID}).Count(&live).Error
+		if err != nil {
+			return err
+		}
+		if live != 2 {
+			return entity.ErrEntityNotFound()
+		}
+
+		relations := []relationModel{
+			{EntityID: id, RelatedID: relatedID, CreatedAt: createdAt},
+			{EntityID: relatedID, RelatedID: id, CreatedAt: createdAt},
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&relations).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.AddRelation: %w", err)
 	}
 
 	return nil
 }
 
-func (r *gormRepo) Delete(ctx context.Context, ids []uuid.UUID) error {
-	resp := r.db.WithContext(ctx).Model(&entityModel{}).Where("id IN ?", ids).Delete(&entityModel{})
-	if resp.Error != nil {
-		return fmt.Errorf("gormRepo.Delete: %w", resp.Error)
+func (r *gormRepo) DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error {
+	res := r.db.WithContext(ctx).
+		Where("(entity_id = ? AND related_id = ?) OR (entity_id = ? AND related_id = ?)", id, relatedID, relatedID, id).
+		Where("entity_id IN (?)", r.db.Unscoped().Model(&entityModel{}).Select("id").Scopes(db.InWorkspace(ctx))).
+		Delete(&relationModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.DeleteRelation: %w", res.Error)
 	}
-	if resp.RowsAffected == 0 {
-		return fmt.Errorf("gormRepo.Delete: %w", entity.ErrEntityNotFound())
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.DeleteRelation: %w", entity.ErrRelationNotFound())
 	}
 
 	return nil
 }
 
-func (r *gormRepo) getRecursiveQuery(hType entity.HierarchyType, maxDepth int, ids []uuid.UUID, userID *uuid.UUID) (string, []any) {
+// GetRelated if userID is nil, show all related entities, otherwise show only published entities and drafts created by the user.
+func (r *gormRepo) GetRelated(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]entity.ListItem, error) {
+	var models []entityListItemModel
+
 	vFilter, vArgs := buildVisibilityFilter(userID)
-	args := make([]any, 0, 3+len(vArgs)*3)
+	err := r.db.WithContext(ctx).
+		Where("id IN (?)", r.db.Model(&relationModel{}).Select("related_id").Where("entity_id = ?", id)).
+		Scopes(db.InWorkspace(ctx)).
+		Where(vFilter, vArgs...).
+		Order("name, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetRelated: %w", err)
+	}
 
-	args = append(args, ids)
-	args = append(args, vArgs...)
-	base := fmt.Sprintf(`
-WITH RECURSIVE
-    base AS (
-        SELECT id, type, parent_id, name, 1 as depth
-        FROM entities 
-        WHERE id IN (?) AND deleted_at ISNULL AND %s
-    )
-`, vFilter)
+	return lo.Map(models, func(m entityListItemModel, _ int) entity.ListItem { return m.toDTO() }), nil
+}
 
-	childrenQuery := fmt.Sprintf(`,
-    children AS (
-        SELECT *
-        FROM base
+// SetLanguage keeps the group of an entity that has a language, so changing it does not unlink its variants.
+func (r *gormRepo) SetLanguage(ctx context.Context, id, groupID uuid.UUID, language string, createdAt time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var live int64
+		if err := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Count(&live).Error; err != nil {
+			return err
+		}
+		if live == 0 {
+			return entity.ErrEntityNotFound()
+		}
+		var groups []uuid.UUID
+		if err := tx.Model(&variantModel{}).Where("entity_id = ?", id).Pluck("group_id", &groups).Error; err != nil {
+			return err
+		}
+		if len(groups) > 0 {
+			groupID = groups[0]
+		}
 
-        UNION ALL
+		return putVariant(tx, variantModel{EntityID: id, GroupID: groupID, Language: language, CreatedAt: createdAt})
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetLanguage: %w", err)
+	}
 
-        SELECT e.id, e.type, e.parent_id, e.name, c.depth + 1 as depth
-        FROM children c
-        JOIN entities e ON c.id = e.parent_id AND e.deleted_at ISNULL  AND %s
-		WHERE c.depth < ?
-    )
-`, vFilter)
+	return nil
+}
 
-	parentsQuery := fmt.Sprintf(`,
-    parents AS (
-        SELECT *
-        FROM base
+// LinkVariant moves variantID out of a group it is alone in; one it shares with live variants has to be
+// left first.
+func (r *gormRepo) LinkVariant(ctx context.Context, id, variantID uuid.UUID, language string, createdAt time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var live int64
+		err := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id IN ?", []uuid.UUID{id, variantID}).Count(&live).Error
+		if err != nil {
+			return err
+		}
+		if live != 2 {
+			return entity.ErrEntityNotFound()
+		}
+		groupID, err := variantGroup(tx, id)
+		if err != nil {
+			return err
+		}
 
-        UNION ALL
+		var others int64
+		err = tx.Table("entity_variants v").
+			Joins("JOIN entities e ON e.id = v.entity_id AND ?", db.ActiveCond("e.deleted_at")).
+			Where("v.group_id = (SELECT group_id FROM entity_variants WHERE entity_id = ?)", variantID).
+			Where("v.group_id <> ? AND v.entity_id <> ?", groupID, variantID).
+			Count(&others).Error
+		if err != nil {
+			return err
+		}
+		if others > 0 {
+			return entity.ErrVariantOfOther()
+		}
 
-        SELECT e.id, e.type, e.parent_id, e.name, p.depth + 1 as depth
-        FROM parents p
-        JOIN entities e ON p.parent_id = e.id AND e.deleted_at ISNULL AND %s
-		WHERE p.depth < ?
-    )
-`, vFilter)
+		return putVariant(tx, variantModel{EntityID: variantID, GroupID: groupID, Language: language, CreatedAt: createdAt})
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.LinkVariant: %w", err)
+	}
 
-	args = append(args, vArgs...)
-	args = append(args, maxDepth)
-	switch hType {
-	case entity.HierarchyTypeChildrenOnly:
-		return base + childrenQuery, args
-	case entity.HierarchyTypeParentsOnly:
-		return base + parentsQuery, args
-	case entity.HierarchyTypeChildrenAndParents:
+	return nil
+}
+
+func (r *gormRepo) DeleteVariant(ctx context.Context, id uuid.UUID) error {
+	res := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).Where("entity_id = ?", id).Delete(&variantModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.DeleteVariant: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.DeleteVariant: %w", entity.ErrNoLanguage())
+	}
+
+	return nil
+}
+
+// GetVariants if userID is nil, show all variants, otherwise show only published entities and drafts created by the user.
+func (r *gormRepo) GetVariants(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]entity.Variant, error) {
+	var models []variantModel
+
+	vFilter, vArgs := buildVisibilityFilter(userID)
+	err := r.variants(ctx).
+		Where("v.group_id = (SELECT group_id FROM entity_variants WHERE entity_id = ?)", id).
+		Where(vFilter, vArgs...).
+		Order("v.language").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetVariants: %w", err)
+	}
+
+	return lo.Map(models, func(m variantModel, _ int) entity.Variant { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) GetAllVariants(ctx context.Context) ([]entity.Variant, error) {
+	var models []variantModel
+
+	if err := r.variants(ctx).Order("v.group_id, v.language").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetAllVariants: %w", err)
+	}
+
+	return lo.Map(models, func(m variantModel, _ int) entity.Variant { return m.toDTO() }), nil
+}
+
+// variants selects the variants of live entities of the workspace.
+func (r *gormRepo) variants(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("entity_variants v").
+		Select("v.entity_id, v.group_id, v.language, v.created_at, e.name").
+		Joins("JOIN entities e ON e.id = v.entity_id AND ?", db.ActiveCond("e.deleted_at")).
+		Where(db.WorkspaceCond(ctx, "e.workspace_id"))
+}
+
+// ClaimLint first takes over stale claims, then inserts running results for versions not linted yet. The
+// insert skips versions another server claimed meanwhile, so concurrent claims stay apart.
+func (r *gormRepo) ClaimLint(ctx context.Context, startedAt, staleBefore time.Time, limit int) ([]entity.LintTarget, error) {
+	const reclaim = `
+UPDATE entity_lint_results SET started_at = @started_at
+WHERE (entity_id, version) IN (
+    SELECT l.entity_id, l.version
+    FROM entity_lint_results l
+    JOIN entities e ON e.id = l.entity_id AND @workspace
+    WHERE l.status = @running AND l.started_at < @stale_before
+    ORDER BY l.started_at
+    LIMIT @limit
+    FOR UPDATE OF l SKIP LOCKED
+)
+RETURNING entity_id, version
+`
+	const claim = `
+INSERT INTO entity_lint_results (entity_id, version, status, started_at)
+SELECT e.id, e.current_version, @running, @started_at
+FROM entities e
+WHERE @active AND e.current_version IS NOT NULL AND @workspace
+  AND NOT EXISTS (SELECT 1 FROM entity_lint_results l WHERE l.entity_id = e.id AND l.version = e.current_version)
+  AND NOT EXISTS (SELECT 1 FROM entities a WHERE a.id = ANY (e.path) AND a.lint_disabled)
+ORDER BY e.updated_at, e.id
+LIMIT @limit
+ON CONFLICT DO NOTHING
+RETURNING entity_id, version
+`
+	var models []lintTargetModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		args := map[string]any{
+			"started_at": startedAt, "stale_before": staleBefore, "limit": limit,
+			"running": entity.LintRunning, "workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+			"active": db.ActiveCond("e.deleted_at"),
+		}
+		var claimed []lintTargetModel
+		if err := tx.Raw(reclaim, args).Scan(&claimed).Error; err != nil {
+			return err
+		}
+		if len(claimed) < limit {
+			args["limit"] = limit - len(claimed)
+			var inserted []lintTargetModel
+			if err := tx.Raw(claim, args).Scan(&inserted).Error; err != nil {
+				return err
+			}
+			claimed = append(claimed, inserted...)
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+
+		keys := lo.Map(claimed, func(m lintTargetModel, _ int) []any { return []any{m.EntityID, m.Version} })
+		return tx.Table("entity_versions v").
+			Select("v.entity_id, v.version, v.content, v.content_key_id, "+
+				"COALESCE(ev.language, root.space_settings ->> 'default_language', '') AS language").
+			Joins("LEFT JOIN entity_variants ev ON ev.entity_id = v.entity_id").
+			Joins("JOIN entities e ON e.id = v.entity_id").
+			Joins("LEFT JOIN entities root ON root.id = e.path[1]").
+			Where("(v.entity_id, v.version) IN ?", keys).
+			Order("v.entity_id, v.version").
+			Find(&models).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.ClaimLint: %w", err)
+	}
+
+	targets := make([]entity.LintTarget, 0, len(models))
+	for _, m := range models {
+		content, err := r.open(m.Content, m.ContentKeyID, versionAAD(m.EntityID, m.Version))
+		if err != nil {
+			return nil, fmt.Errorf("gormRepo.ClaimLint: %w", err)
+		}
+		targets = append(targets, entity.LintTarget{EntityID: m.EntityID, Version: m.Version, Content: content, Language: m.Language})
+	}
+
+	return targets, nil
+}
+
+func (r *gormRepo) FinishLint(ctx context.Context, entityID uuid.UUID, version int, status entity.LintStatus,
+	findings []entity.LintFinding, checkedAt time.Time,
+) error {
+	err := r.db.WithContext(ctx).Table("entity_lint_results").
+		Where("entity_id = ? AND version = ?", entityID, version).
+		Updates(map[string]any{"status": status, "findings": findingsColumn(findings), "checked_at": checkedAt}).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.FinishLint: %w", err)
+	}
+
+	return nil
+}
+
+// GetLintReport reports a draft, which has no current version, as not found.
+func (r *gormRepo) GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error) {
+	const query = `
+SELECT v.entity_id, v.version, COALESCE(l.status, '') AS status, COALESCE(l.findings, '[]') AS findings, l.checked_at,
+       EXISTS (SELECT 1 FROM entities a WHERE a.id = ANY (e.path) AND a.lint_disabled) AS disabled
+FROM entities e
+JOIN entity_versions v ON v.entity_id = e.id AND v.version = COALESCE(NULLIF(@version, 0), e.current_version)
+LEFT JOIN entity_lint_results l ON l.entity_id = v.entity_id AND l.version = v.version
+WHERE e.id = @id AND @active AND @workspace
+`
+	var models []lintReportModel
+
+	err := r.db.WithContext(ctx).Raw(query, map[string]any{
+		"id": id, "version": version, "workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+		"active": db.ActiveCond("e.deleted_at"),
+	}).Scan(&models).Error
+	if err != nil {
+		return entity.LintReport{}, fmt.Errorf("gormRepo.GetLintReport: %w", err)
+	}
+	if len(models) == 0 {
+		return entity.LintReport{}, fmt.Errorf("gormRepo.GetLintReport: %w", entity.ErrEntityNotFound())
+	}
+
+	return models[0].toDTO(), nil
+}
+
+// SetLintDisabled leaves updated_at as it is, as the setting does not change the content.
+func (r *gormRepo) SetLintDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	result := r.db.WithContext(ctx).Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).
+		UpdateColumn("lint_disabled", disabled)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.SetLintDisabled: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.SetLintDisabled: %w", entity.ErrEntityNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetSpace(ctx context.Context, id uuid.UUID) (entity.Space, error) {
+	var model spaceModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = entity.ErrEntityNotFound()
+		}
+		return entity.Space{}, fmt.Errorf("gormRepo.GetSpace: %w", err)
+	}
+	if model.ParentID != nil {
+		return entity.Space{}, fmt.Errorf("gormRepo.GetSpace: %w", entity.ErrNotSpace())
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) SetSpaceSettings(ctx context.Context, id uuid.UUID, settings entity.SpaceSettings) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model spaceModel
+		err := tx.Scopes(db.InWorkspace(ctx)).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).Take(&model).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrEntityNotFound()
+			}
+			return err
+		}
+		if model.ParentID != nil {
+			return entity.ErrNotSpace()
+		}
+
+		return tx.Model(&spaceModel{}).Where("id = ?", id).
+			UpdateColumn("space_settings", spaceSettingsColumn(settings)).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetSpaceSettings: %w", err)
+	}
+
+	return nil
+}
+
+// GetSpaceSettings reads the settings from the root of id's path, so it works for any entity in the space.
+func (r *gormRepo) GetSpaceSettings(ctx context.Context, id uuid.UUID) (entity.SpaceSettings, error) {
+	var settings []spaceSettingsColumn
+
+	err := r.db.WithContext(ctx).Raw(`
+SELECT root.space_settings
+FROM entities e
+JOIN entities root ON root.id = e.path[1]
+WHERE e.id = @id AND @workspace`, map[string]any{
+		"id":        id,
+		"workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+	}).Scan(&settings).Error
+	if err != nil {
+		return entity.SpaceSettings{}, fmt.Errorf("gormRepo.GetSpaceSettings: %w", err)
+	}
+	if len(settings) == 0 {
+		return entity.SpaceSettings{}, nil
+	}
+
+	return entity.SpaceSettings(settings[0]), nil
+}
+
+func (r *gormRepo) GetSpaceID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	err := r.db.WithContext(ctx).Raw(`
+SELECT path[1]
+FROM entities
+WHERE id = @id AND @active AND @workspace`, map[string]any{
+		"id":        id,
+		"active":    db.ActiveCond("deleted_at"),
+		"workspace": db.WorkspaceCond(ctx, "workspace_id"),
+	}).Scan(&ids).Error
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("gormRepo.GetSpaceID: %w", err)
+	}
+	if len(ids) == 0 {
+		return uuid.Nil, fmt.Errorf("gormRepo.GetSpaceID: %w", entity.ErrEntityNotFound())
+	}
+
+	return ids[0], nil
+}
+
+// GetSubtreeGrants lists the grants top-down, those on id first.
+func (r *gormRepo) GetSubtreeGrants(ctx context.Context, id uuid.UUID) ([]entity.Grant, error) {
+	grants := make([]entity.Grant, 0)
+
+	err := r.db.WithContext(ctx).Raw(`
+SELECT g.user_id, g.role, g.entity_id
+FROM user_roles g
+JOIN entities e ON e.id = g.entity_id
+WHERE e.path @> ARRAY[CAST(@id AS UUID)] AND @workspace
+ORDER BY cardinality(e.path), g.entity_id, g.user_id, g.role`, map[string]any{
+		"id":        id,
+		"workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+	}).Scan(&grants).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetSubtreeGrants: %w", err)
+	}
+
+	return grants, nil
+}
+
+// GetAccess reads the transaction of ctx, if any; admin covers write, which covers read.
+func (r *gormRepo) GetAccess(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error) {
+	const query = `
+WITH
+    node AS (
+        SELECT workspace_id, path
+        FROM entities
+        WHERE id = @id AND @active AND @workspace
+    ),
+    access AS (
+        SELECT g.user_id, g.role
+        FROM node n
+        JOIN user_roles g ON g.workspace_id = n.workspace_id AND g.entity_id = ANY(n.path)
+        UNION ALL
+        SELECT d.user_id, d.role
+        FROM node n
+        JOIN entity_default_permissions d ON d.entity_id = ANY(n.path)
+        JOIN users u ON u.id = d.user_id AND @active_user
+    )
+SELECT DISTINCT ON (user_id) user_id, role
+FROM access
+ORDER BY user_id, role = 'admin' DESC, role = 'write' DESC
+`
+	access := make([]entity.DefaultPermission, 0)
+	err := db.Conn(ctx, r.db).Raw(query, map[string]any{
+		"id":          id,
+		"active":      db.ActiveCond("deleted_at"),
+		"active_user": db.ActiveCond("u.deleted_at"),
+		"workspace":   db.WorkspaceCond(ctx, "workspace_id"),
+	}).Scan(&access).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetAccess: %w", err)
+	}
+
+	return access, nil
+}
+
+// GetChildren if userID is nil, show all children, otherwise show only published entities and drafts created by the user.
+// Pinned children come first, then the ordered ones by sort_order, then the others by name, as in entity.BuildTree.
+func (r *gormRepo) GetChildren(ctx context.Context, id uuid.UUID, userID *uuid.UUID) ([]entity.ListItem, error) {
+	var models []entityListItemModel
+
+	vFilter, vArgs := buildVisibilityFilter(userID)
+	err := r.db.WithContext(ctx).
+		Where("parent_id = ?", id).
+		Scopes(db.InWorkspace(ctx)).
+		Where(vFilter, vArgs...).
+		Order("NOT pinned, sort_order = 0, sort_order, name, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetChildren: %w", err)
+	}
+
+	return lo.Map(models, func(m entityListItemModel, _ int) entity.ListItem { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) ReorderChildren(ctx context.Context, parentID uuid.UUID, ids []uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// locking the parent makes concurrent reorders of its children apply one after the other and
+		// waits for moves into it, which share-lock it in setPath
+		var parent entityModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(db.InWorkspace(ctx)).
+			Select("id").
+			Where("id = ?", parentID).Take(&parent).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrEntityNotFound()
+			}
+			return err
+		}
+
+		var children int64
+		err = tx.Model(&entityModel{}).
+			Where("parent_id = ? AND id = ANY(?::uuid[])", parentID, uuidArray(ids)).
+			Count(&children).Error
+		if err != nil {
+			return err
+		}
+		if int(children) != len(ids) {
+			return entity.ErrNotChildren()
+		}
+
+		// the order is not an edit of the children, so their updated_at is kept
+		return tx.Model(&entityModel{}).
+			Where("parent_id = ?", parentID).
+			UpdateColumn("sort_order", gorm.Expr("COALESCE(array_position(?::uuid[], id), 0)", uuidArray(ids))).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.ReorderChildren: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool, maxPinned int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// locking the parent, as ReorderChildren does, keeps concurrent pins from passing the limit together
+		var parent entityModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(db.InWorkspace(ctx)).
+			Select("id").
+			Where("id = ?", parentID).Take(&parent).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrEntityNotFound()
+			}
+			return err
+		}
+
+		if pinned && maxPinned > 0 {
+			var others int64
+			err = tx.Model(&entityModel{}).
+				Where("parent_id = ? AND pinned AND id <> ?", parentID, childID).
+				Count(&others).Error
+			if err != nil {
+				return err
+			}
+			if int(others) >= maxPinned {
+				return entity.ErrPinLimitReached(maxPinned)
+			}
+		}
+
+		// like the order, a pin is not an edit of the child, so its updated_at is kept
+		res := tx.Model(&entityModel{}).
+			Where("id = ? AND parent_id = ?", childID, parentID).
+			UpdateColumn("pinned", pinned)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return entity.ErrNotChild()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetPinned: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error) {
+	var models []defaultPermissionModel
+	err := r.db.WithContext(ctx).
+		Where("entity_id = ?", id).
+		Scopes(inWorkspace(ctx, "entity_id")).
+		Order("user_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetDefaultPermissions: %w", err)
+	}
+
+	return lo.Map(models, func(m defaultPermissionModel, _ int) entity.DefaultPermission {
+		return entity.DefaultPermission{UserID: m.UserID, Role: m.Role}
+	}), nil
+}
+
+// SetDefaultPermissions accepts only users of the workspace of ctx, like auth grants.
+func (r *gormRepo) SetDefaultPermissions(ctx context.Context, id uuid.UUID, permissions []entity.DefaultPermission, createdAt time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var live int64
+		if err := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Count(&live).Error; err != nil {
+			return err
+		}
+		if live == 0 {
+			return entity.ErrEntityNotFound()
+		}
+		if len(permissions) > 0 {
+			userIDs := lo.Map(permissions, func(p entity.DefaultPermission, _ int) uuid.UUID { return p.UserID })
+			var users int64
+			err := tx.Table("users").Scopes(db.ActiveOnly("deleted_at")).
+				Where("id IN ?", userIDs).
+				Where(db.WorkspaceCond(ctx, "workspace_id")).
+				Count(&users).Error
+			if err != nil {
+				return err
+			}
+			if int(users) != len(userIDs) {
+				return entity.ErrDefaultPermissionUserNotFound()
+			}
+		}
+
+		if err := tx.Where("entity_id = ?", id).Delete(&defaultPermissionModel{}).Error; err != nil {
+			return err
+		}
+		if len(permissions) == 0 {
+			return nil
+		}
+		models := lo.Map(permissions, func(p entity.DefaultPermission, _ int) defaultPermissionModel {
+			return defaultPermissionModel{EntityID: id, UserID: p.UserID, Role: p.Role, CreatedAt: createdAt}
+		})
+
+		return tx.Create(&models).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetDefaultPermissions: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingDefaultPermissions reads the transaction of ctx, if any, to see a move made in it.
+func (r *gormRepo) GetPendingDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error) {
+	// a write grant on an ancestor covers both roles, a read grant only read; admins need none
+	const query = `
+WITH
+    node AS (
+        SELECT workspace_id, path[:array_length(path, 1) - 1] AS ancestors
+        FROM entities
+        WHERE id = ? AND ?
+    ),
+    strongest AS (
+        SELECT DISTINCT ON (d.user_id) d.user_id, d.role
+        FROM node n
+        JOIN entity_default_permissions d ON d.entity_id = ANY(n.ancestors)
+        ORDER BY d.user_id, d.role = 'write' DESC
+    )
+SELECT s.user_id, s.role
+FROM strongest s
+JOIN users u ON u.id = s.user_id AND ?
+CROSS JOIN node n
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM user_roles g
+    WHERE g.user_id = s.user_id AND g.workspace_id = n.workspace_id
+      AND (g.role = 'admin' OR (g.entity_id = ANY(n.ancestors) AND (g.role = 'write' OR s.role = 'read')))
+)
+ORDER BY s.user_id
+`
+	permissions := make([]entity.DefaultPermission, 0)
+	if err := db.Conn(ctx, r.db).Raw(query, id, db.WorkspaceCond(ctx, "workspace_id"), db.ActiveCond("u.deleted_at")).Scan(&permissions).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetPendingDefaultPermissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// GetBrokenLinks returns links from live entities to entities that are missing or deleted.
+func (r *gormRepo) GetBrokenLinks(ctx context.Context) ([]entity.BrokenLink, error) {
+	const query = `
+SELECT l.source_id, s.name AS source_name, l.target_id
+FROM entity_links l
+JOIN entities s ON s.id = l.source_id AND ?
+LEFT JOIN entities t ON t.id = l.target_id AND ?
+WHERE t.id ISNULL AND ?
+ORDER BY s.name, l.source_id, l.target_id
+`
+	links := make([]entity.BrokenLink, 0)
+
+	err := r.db.WithContext(ctx).Raw(query, db.ActiveCond("s.deleted_at"), db.ActiveCond("t.deleted_at"), db.WorkspaceCond(ctx, "s.workspace_id")).Scan(&links).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetBrokenLinks: %w", err)
+	}
+
+	return links, nil
+}
+
+// PruneVersions reads the retention policy of a space from the settings of its root, the first entity of the path.
+func (r *gormRepo) PruneVersions(ctx context.Context, keepLast, keepDays int, now time.Time, dryRun bool) ([]entity.VersionRef, error) {
+	const doomed = `
+WITH ranked AS (
+    SELECT entity_id, version, created_at,
+           ROW_NUMBER() OVER (PARTITION BY entity_id ORDER BY version DESC) AS rn
+    FROM entity_versions
+),
+doomed AS (
+    SELECT r.entity_id, r.version, r.created_at
+    FROM ranked r
+    JOIN entities e ON e.id = r.entity_id
+    LEFT JOIN entities root ON root.id = e.path[1]
+    CROSS JOIN LATERAL (
+        SELECT COALESCE(CAST(root.space_settings -> 'retention' ->> 'keep_last_versions' AS INT), @keep_last) AS keep_last,
+               COALESCE(CAST(root.space_settings -> 'retention' ->> 'keep_days' AS INT), @keep_days) AS keep_days
+    ) p
+    WHERE (e.current_version ISNULL OR r.version <> e.current_version)
+      AND NOT EXISTS (
+          SELECT 1 FROM entity_snapshot_versions s WHERE s.entity_id = r.entity_id AND s.version = r.version
+      )
+      AND @workspace
+      AND (p.keep_last > 0 OR p.keep_days > 0)
+      AND (p.keep_last = 0 OR r.rn > p.keep_last)
+      AND (p.keep_days = 0 OR r.created_at < CAST(@now AS TIMESTAMPTZ) - make_interval(days => p.keep_days))
+)
+`
+	args := map[string]any{
+		"keep_last": keepLast, "keep_days": keepDays, "now": now, "workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+	}
+	versions := make([]entity.VersionRef, 0)
+
+	if dryRun {
+		err := r.db.WithContext(ctx).
+			Raw(doomed+"SELECT * FROM doomed ORDER BY entity_id, version", args).
+			Scan(&versions).Error
+		if err != nil {
+			return nil, fmt.Errorf("gormRepo.PruneVersions: %w", err)
+		}
+		return versions, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(doomed+`
+DELETE FROM entity_versions v
+USING doomed d
+WHERE v.entity_id = d.entity_id AND v.version = d.version
+RETURNING v.entity_id, v.version, v.created_at`, args).Scan(&versions).Error
+		if err != nil {
+			return err
+		}
+		if len(versions) == 0 {
+			return nil
+		}
+		ids := lo.Uniq(lo.Map(versions, func(v entity.VersionRef, _ int) uuid.UUID { return v.EntityID }))
+
+		return tx.Exec(`
+UPDATE entities e
+SET version_count = (SELECT COUNT(*) FROM entity_versions v WHERE v.entity_id = e.id)
+WHERE e.id IN ?`, ids).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.PruneVersions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// PurgeDeleted walks up from every entity that stays, so an ancestor of a live entity is never removed:
+// parent_id cascades. The delete re-evaluates that walk, so a concurrent move under a purged entity is safe.
+func (r *gormRepo) PurgeDeleted(ctx context.Context, cutoff time.Time, dryRun bool) ([]entity.PurgedEntity, error) {
+	const doomed = `
+WITH RECURSIVE kept AS (
+    SELECT id, parent_id
+    FROM entities
+    WHERE @active OR deleted_at >= @cutoff
+
+    UNION
+
+    SELECT p.id, p.parent_id
+    FROM kept k
+    JOIN entities p ON p.id = k.parent_id
+),
+doomed AS (
+    SELECT id
+    FROM entities
+    WHERE deleted_at < @cutoff AND @workspace AND id NOT IN (SELECT id FROM kept)
+)
+`
+	args := map[string]any{"cutoff": cutoff, "active": db.ActiveCond("deleted_at"), "workspace": db.WorkspaceCond(ctx, "workspace_id")}
+	purged := make([]entity.PurgedEntity, 0)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(doomed+`
+SELECT e.id, e.name, e.deleted_at, COUNT(v.version) AS version_count,
+       OCTET_LENGTH(e.content) + COALESCE(SUM(OCTET_LENGTH(v.content)), 0) AS bytes,
+       e.current_version ISNULL AS draft, e.updated_by
+FROM entities e
+JOIN doomed d ON d.id = e.id
+LEFT JOIN entity_versions v ON v.entity_id = e.id
+GROUP BY e.id
+ORDER BY e.deleted_at, e.id`, args).Scan(&purged).Error
+		if err != nil || dryRun || len(purged) == 0 {
+			return err
+		}
+
+		var deleted []uuid.UUID
+		args["ids"] = lo.Map(purged, func(e entity.PurgedEntity, _ int) uuid.UUID { return e.ID })
+		err = tx.Raw(doomed+`
+DELETE FROM entities e
+USING doomed d
+WHERE e.id = d.id AND e.id IN @ids
+RETURNING e.id`, args).Scan(&deleted).Error
+		if err != nil {
+			return err
+		}
+		purged = lo.Filter(purged, func(e entity.PurgedEntity, _ int) bool { return lo.Contains(deleted, e.ID) })
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.PurgeDeleted: %w", err)
+	}
+
+	return purged, nil
+}
+
+// staleDrafts are the live drafts of users who do not keep stale drafts, touched when they were last saved
+// or autosaved.
+const staleDrafts = `
+WITH stale AS (
+    SELECT e.id, e.name, e.created_by, GREATEST(e.updated_at, MAX(a.saved_at)) AS updated_at,
+           e.draft_reminded_at, COALESCE((p.data -> 'notifications' ->> 'email')::BOOLEAN, TRUE) AS email_enabled
+    FROM entities e
+    LEFT JOIN entity_autosaves a ON a.entity_id = e.id
+    LEFT JOIN user_preferences p ON p.user_id = e.created_by
+    WHERE @active AND e.current_version ISNULL AND @workspace
+      AND NOT COALESCE((p.data -> 'drafts' ->> 'keep_stale')::BOOLEAN, FALSE)
+    GROUP BY e.id, p.data
+)
+`
+
+func (r *gormRepo) GetDraftsToRemind(ctx context.Context, before time.Time) ([]entity.DraftReminder, error) {
+	const query = staleDrafts + `
+SELECT s.id, s.name, s.created_by, s.updated_at, u.email, s.email_enabled
+FROM stale s
+LEFT JOIN users u ON u.id = s.created_by AND @active_user
+WHERE s.updated_at < @before AND (s.draft_reminded_at ISNULL OR s.draft_reminded_at < s.updated_at)
+ORDER BY s.created_by, s.updated_at, s.id
+`
+	var models []staleDraftModel
+
+	err := r.db.WithContext(ctx).Raw(query, map[string]any{
+		"before": before, "workspace": db.WorkspaceCond(ctx, "e.workspace_id"),
+		"active": db.ActiveCond("e.deleted_at"), "active_user": db.ActiveCond("u.deleted_at"),
+	}).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetDraftsToRemind: %w", err)
+	}
+
+	reminders := make([]entity.DraftReminder, 0)
+	for _, m := range models {
+		if len(reminders) == 0 || reminders[len(reminders)-1].UserID != m.CreatedBy {
+			reminder := entity.DraftReminder{UserID: m.CreatedBy}
+			if m.Email != nil && m.EmailEnabled {
+				reminder.Email = *m.Email
+			}
+			reminders = append(reminders, reminder)
+		}
+		last := &reminders[len(reminders)-1]
+		last.Drafts = append(last.Drafts, m.StaleDraft)
+	}
+
+	return reminders, nil
+}
+
+// MarkDraftsReminded leaves updated_at as it is, as a reminder does not touch the draft.
+func (r *gormRepo) MarkDraftsReminded(ctx context.Context, ids []uuid.UUID, remindedAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entityModel{}).
+		Scopes(db.InWorkspace(ctx)).
+		Where("id IN ?", ids).
+		UpdateColumn("draft_reminded_at", remindedAt).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.MarkDraftsReminded: %w", err)
+	}
+
+	return nil
+}
+
+// CleanupStaleDrafts re-evaluates the drafts in the statement that archives or deletes them, so a draft
+// touched or given a child meanwhile is kept. Archived drafts are deleted by no one in their events.
+func (r *gormRepo) CleanupStaleDrafts(ctx context.Context, before time.Time, remindedBefore *time.Time, hard, dryRun bool) ([]entity.StaleDraft, error) {
+	doomed := staleDrafts + `,
+doomed AS (
+    SELECT s.id, s.name, s.created_by, s.updated_at
+    FROM stale s
+    WHERE s.updated_at < @before AND NOT EXISTS (SELECT 1 FROM entities c WHERE c.parent_id = s.id)
+`
+	args := map[string]any{"before": before, "active": db.ActiveCond("e.deleted_at"), "workspace": db.WorkspaceCond(ctx, "e.workspace_id")}
+	if remindedBefore != nil {
+		doomed += "      AND s.draft_reminded_at >= s.updated_at AND s.draft_reminded_at < @reminded_before\n"
+		args["reminded_before"] = *remindedBefore
+	}
+	doomed += ")\n"
+	cleaned := make([]entity.StaleDraft, 0)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(doomed+"SELECT * FROM doomed ORDER BY updated_at, id", args).Scan(&cleaned).Error
+		if err != nil || dryRun || len(cleaned) == 0 {
+			return err
+		}
+
+		var ids []uuid.UUID
+		args["ids"] = lo.Map(cleaned, func(d entity.StaleDraft, _ int) uuid.UUID { return d.ID })
+		if hard {
+			err = tx.Raw(doomed+`
+DELETE FROM entities e
+USING doomed d
+WHERE e.id = d.id AND e.id IN @ids
+RETURNING e.id`, args).Scan(&ids).Error
+		} else {
+			err = tx.Raw(doomed+`
+UPDATE entities e
+SET deleted_at = NOW()
+FROM doomed d
+WHERE e.id = d.id AND e.id IN @ids
+RETURNING e.id`, args).Scan(&ids).Error
+		}
+		if err != nil {
+			return err
+		}
+		cleaned = lo.Filter(cleaned, func(d entity.StaleDraft, _ int) bool { return lo.Contains(ids, d.ID) })
+		if hard || len(ids) == 0 {
+			return nil
+		}
+		events := lo.Map(ids, func(id uuid.UUID, _ int) eventModel {
+			return eventModel{EntityID: id, Type: entity.EventDeleted}
+		})
+
+		return tx.Create(&events).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.CleanupStaleDrafts: %w", err)
+	}
+
+	return cleaned, nil
+}
+
+// contentTables are the tables holding content, with the columns read for a row, the condition
+// matching it and the additional data its content is sealed with.
+var contentTables = []struct {
+	name    string
+	columns string
+	where   func(row contentRowModel) (string, []any)
+	aad     func(row contentRowModel) []byte
+}{
+	{
+		"entities", "id, id AS entity_id, COALESCE(current_version, 0) AS version, content, content_key_id",
+		func(row contentRowModel) (string, []any) { return "id = ?", []any{row.EntityID} },
+		func(row contentRowModel) []byte { return entityAAD(row.EntityID, row.Version) },
+	},
+	{
+		"entity_versions", "entity_id, version, content, content_key_id",
+		func(row contentRowModel) (string, []any) {
+			return "entity_id = ? AND version = ?", []any{row.EntityID, row.Version}
+		},
+		func(row contentRowModel) []byte { return versionAAD(row.EntityID, row.Version) },
+	},
+	{
+		"entity_autosaves", "entity_id, user_id, content, content_key_id",
+		func(row contentRowModel) (string, []any) {
+			return "entity_id = ? AND user_id = ?", []any{row.EntityID, row.UserID}
+		},
+		func(row contentRowModel) []byte { return autosaveAAD(row.EntityID, row.UserID) },
+	},
+}
+
+// ReencryptContent goes through the tables in turn, each in its own transaction. Rows locked by a
+// concurrent write are skipped; the write encrypts them anyway. A row that cannot be decrypted is
+// reported and the batch goes on.
+func (r *gormRepo) ReencryptContent(ctx context.Context, limit int, skip []entity.SkippedContent) (int, []entity.SkippedContent, error) {
+	if r.cipher == nil {
+		return 0, nil, fmt.Errorf("gormRepo.ReencryptContent: %w", errors.New("no content key is set"))
+	}
+	keyID, err := r.cipher.KeyID()
+	if err != nil {
+		return 0, nil, fmt.Errorf("gormRepo.ReencryptContent: %w", err)
+	}
+
+	done := 0
+	var skipped []entity.SkippedContent
+	for _, table := range contentTables {
+		if done+len(skipped) == limit {
+			break
+		}
+		var encrypted int
+		var failed []entity.SkippedContent
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			query := tx.Table(table.name).Select(table.columns).
+				Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("content_key_id IS DISTINCT FROM ?", keyID)
+			for _, s := range skip {
+				if s.Table == table.name {
+					cond, args := table.where(contentRowModel{EntityID: s.EntityID, Version: s.Version, UserID: lo.FromPtr(s.UserID)})
+					query = query.Where("NOT ("+cond+")", args...)
+				}
+			}
+			var rows []contentRowModel
+			err := query.Limit(limit - done - len(skipped)).Find(&rows).Error
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				// plain content is encrypted, content of another key only has its data key rewrapped
+				var content, rowKeyID string
+				if row.ContentKeyID == nil {
+					content, rowKeyID, err = r.cipher.Encrypt(row.Content, table.aad(row))
+				} else {
+					content, rowKeyID, err = r.cipher.Rewrap(row.Content, *row.ContentKeyID)
+				}
+				if err != nil {
+					s := entity.SkippedContent{Table: table.name, EntityID: row.EntityID, Version: row.Version, Error: err.Error()}
+					if row.UserID != uuid.Nil {
+						s.UserID = &row.UserID
+					}
+					failed = append(failed, s)
+					continue
+				}
+				cond, args := table.where(row)
+				err = tx.Table(table.name).Where(cond, args...).
+					UpdateColumns(map[string]any{"content": content, "content_key_id": rowKeyID}).Error
+				if err != nil {
+					return err
+				}
+				encrypted++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return done, skipped, fmt.Errorf("gormRepo.ReencryptContent: %s: %w", table.name, err)
+		}
+		done += encrypted
+		skipped = append(skipped, failed...)
+	}
+
+	return done, skipped, nil
+}
+
+// AcquireLock runs in one transaction, so NOW() is the same for the upsert and the lookup of the holder.
+// Re-locking by the holder extends the expiry and keeps acquired_at.
+func (r *gormRepo) AcquireLock(ctx context.Context, id, userID uuid.UUID, ttl time.Duration) (entity.Lock, bool, error) {
+	const upsert = `
+INSERT INTO entity_locks (entity_id, user_id, acquired_at, expires_at)
+VALUES (@entity_id, @user_id, NOW(), NOW() + make_interval(secs => @ttl_seconds))
+ON CONFLICT (entity_id) DO UPDATE
+SET user_id     = EXCLUDED.user_id,
+    acquired_at = CASE
+                      WHEN entity_locks.user_id = EXCLUDED.user_id AND entity_locks.expires_at > NOW()
+                          THEN entity_locks.acquired_at
+                      ELSE EXCLUDED.acquired_at
+                  END,
+    expires_at  = EXCLUDED.expires_at
+WHERE entity_locks.user_id = EXCLUDED.user_id OR entity_locks.expires_at <= NOW()
+RETURNING entity_id, user_id, acquired_at, expires_at
+`
+	var (
+		models   []lockModel
+		acquired bool
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return entity.ErrEntityNotFound()
+		}
+
+		err := tx.Raw(upsert, map[string]any{
+			"entity_id":   id,
+			"user_id":     userID,
+			"ttl_seconds": ttl.Seconds(),
+		}).Scan(&models).Error
+		if err != nil {
+			return err
+		}
+		if len(models) > 0 {
+			acquired = true
+			return nil
+		}
+
+		return tx.Where("entity_id = ? AND expires_at > NOW()", id).Limit(1).Find(&models).Error
+	})
+	if err != nil {
+		return entity.Lock{}, false, fmt.Errorf("gormRepo.AcquireLock: %w", err)
+	}
+	if len(models) == 0 {
+		// released between the two statements
+		return entity.Lock{}, false, fmt.Errorf("gormRepo.AcquireLock: %w", entity.ErrLockNotFound())
+	}
+
+	return models[0].toDTO(), acquired, nil
+}
+
+// GetLock returns only an active lock, expired rows are ignored.
+func (r *gormRepo) GetLock(ctx context.Context, id uuid.UUID) (entity.Lock, error) {
+	var model lockModel
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).Where("entity_id = ? AND expires_at > NOW()", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = entity.ErrLockNotFound()
+		}
+		return entity.Lock{}, fmt.Errorf("gormRepo.GetLock: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) ReleaseLock(ctx context.Context, id uuid.UUID) error {
+	res := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).Where("entity_id = ?", id).Delete(&lockModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.ReleaseLock: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.ReleaseLock: %w", entity.ErrLockNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) SaveAutosave(ctx context.Context, id, userID uuid.UUID, content string, savedAt time.Time) error {
+	const upsert = `
+INSERT INTO entity_autosaves (entity_id, user_id, content, content_key_id, saved_at)
+SELECT id, @user_id, @content, @content_key_id, @saved_at
+FROM entities
+WHERE id = @entity_id AND @active AND @workspace
+ON CONFLICT (entity_id, user_id) DO UPDATE
+SET content        = EXCLUDED.content,
+    content_key_id = EXCLUDED.content_key_id,
+    saved_at       = EXCLUDED.saved_at
+`
+	content, keyID, err := r.seal(content, autosaveAAD(id, userID))
+	if err != nil {
+		return fmt.Errorf("gormRepo.SaveAutosave: %w", err)
+	}
+	res := r.db.WithContext(ctx).Exec(upsert, map[string]any{
+		"entity_id":      id,
+		"user_id":        userID,
+		"content":        content,
+		"content_key_id": keyID,
+		"saved_at":       savedAt,
+		"workspace":      db.WorkspaceCond(ctx, "workspace_id"),
+	})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.SaveAutosave: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.SaveAutosave: %w", entity.ErrEntityNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetAutosave(ctx context.Context, id, userID uuid.UUID) (entity.Autosave, error) {
+	var model autosaveModel
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).
+		Where("entity_id = ? AND user_id = ?", id, userID).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = entity.ErrAutosaveNotFound()
+		}
+		return entity.Autosave{}, fmt.Errorf("gormRepo.GetAutosave: %w", err)
+	}
+	if model.Content, err = r.open(model.Content, model.ContentKeyID, autosaveAAD(model.EntityID, model.UserID)); err != nil {
+		return entity.Autosave{}, fmt.Errorf("gormRepo.GetAutosave: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) DeleteAutosave(ctx context.Context, id, userID uuid.UUID) error {
+	res := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).
+		Where("entity_id = ? AND user_id = ?", id, userID).Delete(&autosaveModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.DeleteAutosave: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.DeleteAutosave: %w", entity.ErrAutosaveNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteExpiredLocks(ctx context.Context) (int64, error) {
+	res := r.db.WithContext(ctx).Where("expires_at <= NOW()").Delete(&lockModel{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("gormRepo.DeleteExpiredLocks: %w", res.Error)
+	}
+
+	return res.RowsAffected, nil
+}
+
+// deleteAutosave drops the autosave the user's save supersedes.
+func deleteAutosave(tx *gorm.DB, id, userID uuid.UUID) error {
+	return tx.Where("entity_id = ? AND user_id = ?", id, userID).Delete(&autosaveModel{}).Error
+}
+
+// siblingNameExpr mirrors entity.NormalizeSiblingName and matches the idx_entities_sibling_name index.
+const siblingNameExpr = `LOWER(REGEXP_REPLACE(BTRIM(name), '\s+', ' ', 'g'))`
+
+// SiblingNameExists counts drafts only when userID last updated them, the same rule the listings use.
+func (r *gormRepo) SiblingNameExists(ctx context.Context, parentID *uuid.UUID, normalizedName string, excludeID, userID uuid.UUID) (bool, error) {
+	var count int64
+
+	vFilter, vArgs := buildVisibilityFilter(&userID)
+	q := r.db.WithContext(ctx).Model(&entityModel{}).
+		Scopes(db.InWorkspace(ctx)).
+		Where(siblingNameExpr+" = ?", normalizedName).
+		Where("id <> ?", excludeID).
+		Where(vFilter, vArgs...)
+	if parentID == nil {
+		q = q.Where("parent_id IS NULL")
+	} else {
+		q = q.Where("parent_id = ?", *parentID)
+	}
+	if err := q.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("gormRepo.SiblingNameExists: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *gormRepo) GetIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	err := r.db.WithContext(ctx).Table("entity_slugs s").
+		Joins("JOIN entities e ON e.id = s.entity_id AND ?", db.ActiveCond("e.deleted_at")).
+		Where(db.WorkspaceCond(ctx, "s.workspace_id")).
+		Where("s.slug = ?", slug).
+		Pluck("s.entity_id", &ids).Error
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("gormRepo.GetIDBySlug: %w", err)
+	}
+	if len(ids) == 0 {
+		return uuid.Nil, fmt.Errorf("gormRepo.GetIDBySlug: %w", entity.ErrEntityNotFound())
+	}
+
+	return ids[0], nil
+}
+
+func (r *gormRepo) GetTakenSlugs(ctx context.Context, base string, excludeID uuid.UUID) ([]string, error) {
+	taken := make([]string, 0)
+
+	// slugs hold only letters, digits and dashes, so base needs no LIKE escaping
+	err := r.db.WithContext(ctx).Table("entity_slugs s").
+		Joins("JOIN entities e ON e.id = s.entity_id AND ?", db.ActiveCond("e.deleted_at")).
+		Where(db.WorkspaceCond(ctx, "s.workspace_id")).
+		Where("(s.slug = ? OR s.slug LIKE ?) AND s.entity_id <> ?", base, base+"-%", excludeID).
+		Pluck("s.slug", &taken).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetTakenSlugs: %w", err)
+	}
+
+	return taken, nil
+}
+
+func (r *gormRepo) HasMovedFrom(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.db.WithContext(ctx).Raw(`
+SELECT EXISTS (
+    SELECT 1
+    FROM entity_events
+    WHERE entity_id = ? AND type = ? AND from_parent_id IS NOT DISTINCT FROM ?
+)`, id, entity.EventMoved, parentID).Scan(&exists).Error
+	if err != nil {
+		return false, fmt.Errorf("gormRepo.HasMovedFrom: %w", err)
+	}
+
+	return exists, nil
+}
+
+// RecordView relies on the (entity_id, session_id) key: a repeated view in a session is ignored.
+func (r *gormRepo) RecordView(ctx context.Context, view entity.View) error {
+	model := viewModel{
+		EntityID:  view.EntityID,
+		UserID:    view.UserID,
+		SessionID: view.SessionID,
+		ViewedAt:  view.ViewedAt,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.RecordView: %w", err)
+	}
+
+	return nil
+}
+
+// GetPopular counts the views from idx_entity_views_viewed_at; views of deleted entities are skipped.
+func (r *gormRepo) GetPopular(ctx context.Context, since time.Time, limit int, ids []uuid.UUID, userID *uuid.UUID) ([]entity.PopularEntity, error) {
+	var models []popularModel
+
+	views := r.db.Model(&viewModel{}).
+		Select("entity_id, COUNT(*) AS views").
+		Where("viewed_at >= ?", since).
+		Group("entity_id")
+	if ids != nil {
+		views = views.Where("entity_id IN ?", ids)
+	}
+	vFilter, vArgs := buildVisibilityFilter(userID)
+	err := r.db.WithContext(ctx).
+		Model(&entityListItemModel{}).
+		Select("entities.id, entities.type, entities.name, entities.slug, entities.parent_id, entities.owner_id, "+
+			"entities.word_count, entities.reading_time_minutes, entities.icon, entities.cover, v.views").
+		Joins("JOIN (?) v ON v.entity_id = entities.id", views).
+		Where(db.WorkspaceCond(ctx, "entities.workspace_id")).
+		Where(vFilter, vArgs...).
+		Order("v.views DESC, entities.name, entities.id").
+		Limit(limit).
+		Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetPopular: %w", err)
+	}
+
+	return lo.Map(models, func(m popularModel, _ int) entity.PopularEntity { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) SetOwner(ctx context.Context, id, ownerID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var owners int64
+		err := tx.Table("users").Scopes(db.InWorkspace(ctx), db.ActiveOnly("deleted_at")).Where("id = ?", ownerID).Count(&owners).Error
+		if err != nil {
+			return err
+		}
+		if owners == 0 {
+			return entity.ErrOwnerNotFound()
+		}
+
+		res := tx.Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).UpdateColumn("owner_id", ownerID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return entity.ErrEntityNotFound()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetOwner: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAppearance leaves updated_at as it is, as the appearance is not versioned.
+func (r *gormRepo) UpdateAppearance(ctx context.Context, id uuid.UUID, req entity.UpdateAppearanceReq) error {
+	updates := make(map[string]any, 2)
+	if req.Icon != nil {
+		updates["icon"] = *req.Icon
+	}
+	if req.Cover != nil {
+		updates["cover"] = *req.Cover
+	}
+
+	result := r.db.WithContext(ctx).Model(&entityModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).UpdateColumns(updates)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.UpdateAppearance: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.UpdateAppearance: %w", entity.ErrEntityNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetOrphanedEntities(ctx context.Context) ([]entity.OrphanedEntity, error) {
+	const query = `
+SELECT e.id, e.name, e.slug, e.owner_id, u.deleted_at AS owner_deleted_at
+FROM entities e
+JOIN users u ON u.id = e.owner_id
+WHERE ? AND u.deleted_at IS NOT NULL AND ?
+ORDER BY e.name, e.id
+`
+	var models []orphanedModel
+
+	err := r.db.WithContext(ctx).Raw(query, db.ActiveCond("e.deleted_at"), db.WorkspaceCond(ctx, "e.workspace_id")).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetOrphanedEntities: %w", err)
+	}
+
+	return lo.Map(models, func(m orphanedModel, _ int) entity.OrphanedEntity { return m.toDTO() }), nil
+}
+
+// GetExportPage seeks by (depth, id) on the materialized paths, so every page costs the same however deep
+// into the export it is. Like getPathQuery, a row is skipped if an entity between it and the root is hidden.
+func (r *gormRepo) GetExportPage(ctx context.Context, rootID *uuid.UUID, after entity.ExportCursor, limit int, userID *uuid.UUID) ([]entity.ExportItem, error) {
+	vFilter, vArgs := buildVisibilityFilter(userID)
+	subtree, from := "TRUE", "1"
+	var rootArgs []any
+	if rootID != nil {
+		subtree, from = "e.path @> ARRAY[CAST(? AS UUID)]", "array_position(e.path, CAST(? AS UUID))"
+		rootArgs = []any{*rootID}
+	}
+	query := fmt.Sprintf(`
+SELECT e.id, e.parent_id, e.type, e.name, e.slug, e.content, e.content_key_id, e.current_version ISNULL AS is_draft,
+       COALESCE(e.current_version, 0) AS version, e.updated_at, e.pinned, e.sort_order, array_length(e.path, 1) AS depth
+FROM entities e
+WHERE ? AND ? AND %s AND %s
+  AND (array_length(e.path, 1), e.id) > (?, ?)
+  AND NOT EXISTS (
+      SELECT 1
+      FROM entities h
+      WHERE h.id = ANY(e.path[%s:]) AND (h.deleted_at IS NOT NULL OR NOT %s)
+  )
+ORDER BY array_length(e.path, 1), e.id
+LIMIT ?
+`, subtree, vFilter, from, vFilter)
+
+	args := make([]any, 0, 7+len(rootArgs)*2+len(vArgs)*2)
+	args = append(args, db.ActiveCond("e.deleted_at"), db.WorkspaceCond(ctx, "e.workspace_id"))
+	args = append(args, rootArgs...)
+	args = append(args, vArgs...)
+	args = append(args, after.Depth, after.ID)
+	args = append(args, rootArgs...)
+	args = append(args, vArgs...)
+	args = append(args, limit)
+
+	rows := make([]exportItemModel, 0, limit)
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetExportPage: %w", err)
+	}
+	items := make([]entity.ExportItem, 0, len(rows))
+	for _, row := range rows {
+		content, err := r.open(row.Content, row.ContentKeyID, entityAAD(row.ID, row.Version))
+		if err != nil {
+			return nil, fmt.Errorf("gormRepo.GetExportPage: %w", err)
+		}
+		row.Content = content
+		items = append(items, row.ExportItem)
+	}
+
+	return items, nil
+}
+
+// listSortColumns are the columns of the entity.ListSort values.
+var listSortColumns = map[entity.ListSort]string{
+	entity.ListSortName:      "e.name",
+	entity.ListSortUpdatedAt: "e.updated_at",
+	entity.ListSortCreatedAt: "e.created_at",
+}
+
+// List reads the breadcrumbs from the materialized path. Like GetExportPage, a row is skipped if an entity
+// above it, or the row itself, is deleted or hidden. The children and descendants are counted by the same
+// rule, so the counts match the entries the caller can list below the row.
+func (r *gormRepo) List(ctx context.Context, filter entity.ListFilter, limit int) ([]entity.ListEntry, error) {
+	column, ok := listSortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("gormRepo.List: %w", entity.ErrInvalidListSort())
+	}
+	vFilter, vArgs := buildVisibilityFilter(filter.UserID)
+	countArgs := append([]any{db.ActiveCond("c.deleted_at")}, vArgs...)
+	countArgs = append(countArgs, vArgs...)
+	q := r.db.WithContext(ctx).Table("entities e").
+		Select(fmt.Sprintf(`e.id, e.type, e.name, e.slug, e.parent_id, e.owner_id, e.word_count, e.reading_time_minutes, e.icon, e.cover,
+       e.current_version ISNULL AS is_draft, e.created_by, e.created_at, e.updated_at,
+       (SELECT COALESCE(json_agg(json_build_object('id', a.id, 'name', a.name, 'slug', a.slug)
+                                 ORDER BY array_position(e.path, a.id)), '[]'::json)
+        FROM entities a
+        WHERE a.id = ANY(e.path[:array_length(e.path, 1) - 1])) AS breadcrumbs,
+       (SELECT COUNT(*) FROM entities c WHERE c.parent_id = e.id AND ? AND %[1]s) AS children_count,
+       (SELECT COUNT(*)
+        FROM entities d
+        WHERE d.path @> ARRAY[e.id] AND d.id <> e.id
+          AND NOT EXISTS (
+              SELECT 1
+              FROM entities h
+              WHERE h.id = ANY(d.path[array_position(d.path, e.id):]) AND (h.deleted_at IS NOT NULL OR NOT %[1]s)
+          )) AS subtree_size`, vFilter), countArgs...).
+		Where(db.WorkspaceCond(ctx, "e.workspace_id")).
+		Scopes(db.ActiveOnly("e.deleted_at")).
+		Where(fmt.Sprintf(`NOT EXISTS (
+    SELECT 1
+    FROM entities h
+    WHERE h.id = ANY(e.path) AND (h.deleted_at IS NOT NULL OR NOT %s)
+)`, vFilter), vArgs...)
+
+	if filter.IDs != nil {
+		q = q.Where("e.id = ANY(?::uuid[])", uuidArray(filter.IDs))
+	}
+	if filter.Type != "" {
+		q = q.Where("e.type = ?", filter.Type)
+	}
+	if filter.ParentID != nil {
+		q = q.Where("e.path @> ARRAY[CAST(? AS UUID)] AND e.id <> ?", *filter.ParentID, *filter.ParentID)
+	}
+	if filter.UpdatedSince != nil {
+		q = q.Where("e.updated_at >= ?", *filter.UpdatedSince)
+	}
+	if filter.AuthorID != nil {
+		q = q.Where("e.created_by = ?", *filter.AuthorID)
+	}
+	switch filter.Status {
+	case entity.ListStatusDraft:
+		q = q.Where("e.current_version ISNULL")
+	case entity.ListStatusPublished:
+		q = q.Where("e.current_version IS NOT NULL")
+	}
+
+	keyset := db.Keyset{Columns: []string{column, "e.id"}, Desc: filter.Desc}
+	if filter.After != nil {
+		var value any = filter.After.Time
+		if filter.Sort == entity.ListSortName {
+			value = filter.After.Name
+		}
+		q = q.Scopes(keyset.After(value, filter.After.ID))
+	}
+
+	var models []listEntryModel
+	err := q.Scopes(keyset.Order()).Limit(limit).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+
+	return lo.Map(models, func(m listEntryModel, _ int) entity.ListEntry { return m.toDTO() }), nil
+}
+
+// CreateSnapshot captures the subtree on the materialized paths, like GetExportPage: a row is skipped if
+// an entity between it and the root is deleted or a draft.
+func (r *gormRepo) CreateSnapshot(ctx context.Context, snapshot entity.Snapshot) (int, error) {
+	const capture = `
+INSERT INTO entity_snapshot_versions (snapshot_id, entity_id, version, depth)
+SELECT @snapshot, e.id, e.current_version, array_length(e.path, 1) - array_position(e.path, CAST(@root AS UUID))
+FROM entities e
+WHERE @active AND e.current_version IS NOT NULL AND e.path @> ARRAY[CAST(@root AS UUID)]
+  AND NOT EXISTS (
+      SELECT 1
+      FROM entities h
+      WHERE h.id = ANY(e.path[array_position(e.path, CAST(@root AS UUID)):])
+        AND (h.deleted_at IS NOT NULL OR h.current_version ISNULL)
+  )
+`
+	var captured int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var root entityModel
+		err := tx.Scopes(db.InWorkspace(ctx)).Where("id = ?", snapshot.EntityID).Take(&root).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				err = entity.ErrEntityNotFound()
+			}
+			return err
+		}
+		if root.CurrentVersion == nil {
+			return entity.ErrSnapshotOfDraft()
+		}
+
+		model := snapshotModel{
+			ID: snapshot.ID, EntityID: snapshot.EntityID, Label: snapshot.Label,
+			CreatedBy: snapshot.CreatedBy, CreatedAt: snapshot.CreatedAt,
+		}
+		if err = tx.Create(&model).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
+				err = entity.ErrSnapshotLabelTaken()
+			}
+			return err
+		}
+		res := tx.Exec(capture, map[string]any{"snapshot": snapshot.ID, "root": snapshot.EntityID})
+		captured = res.RowsAffected
+
+		return res.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("gormRepo.CreateSnapshot: %w", err)
+	}
+
+	return int(captured), nil
+}
+
+func (r *gormRepo) GetSnapshots(ctx context.Context, id uuid.UUID) ([]entity.Snapshot, error) {
+	var models []snapshotModel
+
+	err := r.snapshots(ctx).Where("s.entity_id = ?", id).Order("s.created_at DESC, s.id").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetSnapshots: %w", err)
+	}
+
+	return lo.Map(models, func(m snapshotModel, _ int) entity.Snapshot { return m.toDTO() }), nil
+}
+
+// GetSnapshot reads the captured versions, with the type of their entity, deleted entities included.
+func (r *gormRepo) GetSnapshot(ctx context.Context, id uuid.UUID, label string) (entity.SnapshotContent, error) {
+	var model snapshotModel
+
+	err := r.snapshots(ctx).Where("s.entity_id = ? AND s.label = ?", id, label).Take(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = entity.ErrSnapshotNotFound()
+		}
+		return entity.SnapshotContent{}, fmt.Errorf("gormRepo.GetSnapshot: %w", err)
+	}
+
+	rows := make([]snapshotItemModel, 0, model.EntityCount)
+	err = r.db.WithContext(ctx).Table("entity_snapshot_versions sv").
+		Select("v.entity_id AS id, v.parent_id, e.type, v.name, v.content, v.content_key_id, v.version, "+
+			"e.deleted_at IS NOT NULL AS is_deleted").
+		Joins("JOIN entity_versions v ON v.entity_id = sv.entity_id AND v.version = sv.version").
+		Joins("JOIN entities e ON e.id = sv.entity_id").
+		Where("sv.snapshot_id = ?", model.ID).
+		Order("sv.depth, sv.entity_id").
+		Scan(&rows).Error
+	if err != nil {
+		return entity.SnapshotContent{}, fmt.Errorf("gormRepo.GetSnapshot: %w", err)
+	}
+	content := entity.SnapshotContent{Snapshot: model.toDTO(), Items: make([]entity.SnapshotItem, 0, len(rows))}
+	for _, row := range rows {
+		if row.Content, err = r.open(row.Content, row.ContentKeyID, versionAAD(row.ID, row.Version)); err != nil {
+			return entity.SnapshotContent{}, fmt.Errorf("gormRepo.GetSnapshot: %w", err)
+		}
+		content.Items = append(content.Items, row.SnapshotItem)
+	}
+
+	return content, nil
+}
+
+// snapshots selects the snapshots of the workspace with how many versions they hold.
+func (r *gormRepo) snapshots(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("entity_snapshots s").
+		Select("s.*, (SELECT COUNT(*) FROM entity_snapshot_versions sv WHERE sv.snapshot_id = s.id) AS entity_count").
+		Scopes(inWorkspace(ctx, "s.entity_id"))
+}
+
+// GetVariables reads the definitions along the path of id, nearest last, and keeps the nearest of each key.
+func (r *gormRepo) GetVariables(ctx context.Context, id uuid.UUID) ([]entity.Variable, error) {
+	const query = `
+SELECT DISTINCT ON (v.key) v.*
+FROM entities n
+JOIN entity_variables v ON v.entity_id = ANY(n.path)
+WHERE n.id = ? AND ?
+ORDER BY v.key, array_position(n.path, v.entity_id) DESC
+`
+	var models []variableModel
+	if err := r.db.WithContext(ctx).Raw(query, id, db.WorkspaceCond(ctx, "n.workspace_id")).Scan(&models).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetVariables: %w", err)
+	}
+
+	return lo.Map(models, func(m variableModel, _ int) entity.Variable { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) SetVariable(ctx context.Context, v entity.Variable, maxVariables int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// locking the entity makes concurrent writes of its variables count one after the other
+		var owner entityModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(db.InWorkspace(ctx)).
+			Select("id").
+			Where("id = ?", v.EntityID).Take(&owner).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrEntityNotFound()
+			}
+			return err
+		}
+		var others int64
+		err = tx.Model(&variableModel{}).Where("entity_id = ? AND key <> ?", v.EntityID, v.Key).Count(&others).Error
+		if err != nil {
+			return err
+		}
+		if int(others) >= maxVariables {
+			return entity.ErrTooManyVariables(maxVariables)
+		}
+
+		model := variableModel{EntityID: v.EntityID, Key: v.Key, Value: v.Value, UpdatedBy: v.UpdatedBy, UpdatedAt: v.UpdatedAt}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_id"}, {Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_by", "updated_at"}),
+		}).Create(&model).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.SetVariable: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteVariable(ctx context.Context, id uuid.UUID, key string) error {
+	res := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "entity_id")).
+		Where("entity_id = ? AND key = ?", id, key).
+		Delete(&variableModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.DeleteVariable: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.DeleteVariable: %w", entity.ErrVariableNotFound())
+	}
+
+	return nil
+}
+
+// GetScopeVariables resolves the scopes of all ids in one query, like GetVariables does for one.
+func (r *gormRepo) GetScopeVariables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]entity.Variables, error) {
+	const query = `
+SELECT DISTINCT ON (n.id, v.key) n.id AS scope_id, v.key, v.value
+FROM entities n
+JOIN entity_variables v ON v.entity_id = ANY(n.path)
+WHERE n.id IN ? AND ?
+ORDER BY n.id, v.key, array_position(n.path, v.entity_id) DESC
+`
+	scopes := make(map[uuid.UUID]entity.Variables)
+	if len(ids) == 0 {
+		return scopes, nil
+	}
+	var rows []struct {
+		ScopeID uuid.UUID
+		Key     string
+		Value   string
+	}
+	if err := r.db.WithContext(ctx).Raw(query, ids, db.WorkspaceCond(ctx, "n.workspace_id")).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetScopeVariables: %w", err)
+	}
+	for _, row := range rows {
+		if scopes[row.ScopeID] == nil {
+			scopes[row.ScopeID] = make(entity.Variables)
+		}
+		scopes[row.ScopeID][row.Key] = row.Value
+	}
+
+	return scopes, nil
+}
+
+// claimSlug records slug in the history of id, in the workspace of the entity. A slug left behind by
+// a deleted entity is taken over; one held by a live entity means a concurrent writer got it first.
+func claimSlug(tx *gorm.DB, id uuid.UUID, slug string) error {
+	const query = `
+INSERT INTO entity_slugs (workspace_id, slug, entity_id, created_at)
+SELECT workspace_id, ?, id, NOW()
+FROM entities
+WHERE id = ?
+ON CONFLICT (workspace_id, slug) DO UPDATE SET entity_id = EXCLUDED.entity_id, created_at = EXCLUDED.created_at
+WHERE entity_slugs.entity_id = EXCLUDED.entity_id
+   OR NOT EXISTS (SELECT 1 FROM entities e WHERE e.id = entity_slugs.entity_id AND ?)
+`
+	res := tx.Exec(query, slug, id, db.ActiveCond("e.deleted_at"))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrSlugTaken()
+	}
+
+	return nil
+}
+
+// variantGroup returns the group of id, which must have a language.
+func variantGroup(tx *gorm.DB, id uuid.UUID) (uuid.UUID, error) {
+	var groups []uuid.UUID
+	if err := tx.Model(&variantModel{}).Where("entity_id = ?", id).Pluck("group_id", &groups).Error; err != nil {
+		return uuid.Nil, err
+	}
+	if len(groups) == 0 {
+		return uuid.Nil, entity.ErrNoLanguage()
+	}
+
+	return groups[0], nil
+}
+
+// joinVariant puts a new entity id in the group of variantOf, which must have a language.
+func joinVariant(tx *gorm.DB, id, variantOf uuid.UUID, language string, createdAt time.Time) error {
+	var live int64
+	err := tx.Model(&entityModel{}).
+		Where("id = ? AND workspace_id = (SELECT workspace_id FROM entities WHERE id = ?)", variantOf, id).
+		Count(&live).Error
+	if err != nil {
+		return err
+	}
+	if live == 0 {
+		return entity.ErrEntityNotFound()
+	}
+	groupID, err := variantGroup(tx, variantOf)
+	if err != nil {
+		return err
+	}
+
+	return putVariant(tx, variantModel{EntityID: id, GroupID: groupID, Language: language, CreatedAt: createdAt})
+}
+
+// putVariant stores the group and language of an entity. A deleted entity holding the language in the
+// group gives it up, so it may be used again; restoring that entity leaves it without a language.
+func putVariant(tx *gorm.DB, v variantModel) error {
+	err := tx.Exec(`
+DELETE FROM entity_variants v
+USING entities e
+WHERE e.id = v.entity_id AND e.deleted_at IS NOT NULL AND v.group_id = ? AND v.language = ?`,
+		v.GroupID, v.Language).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"group_id", "language"}),
+	}).Create(&v).Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
+		return entity.ErrLanguageTaken()
+	}
+
+	return err
+}
+
+// setPath stores the materialized path of id, the IDs from the root down to id itself, below parentID
+// and rewrites the paths of its descendants when it moved. The parent row is share-locked, so a move
+// of the parent running concurrently is waited for instead of leaving a stale path behind. A moved entity
+// has no place among its new siblings yet, so its sort_order and pin are reset.
+func setPath(tx *gorm.DB, id uuid.UUID, parentID *uuid.UUID) error {
+	const query = `
+WITH
+    parent AS (
+        SELECT path FROM entities WHERE id = @parent FOR SHARE
+    ),
+    node AS (
+        SELECT id, path AS old, COALESCE((SELECT path FROM parent), '{}') || id AS new
+        FROM entities
+        WHERE id = @id
+    )
+UPDATE entities e
+SET path = node.new || e.path[COALESCE(array_length(node.old, 1), 0) + 1:],
+    sort_order = CASE WHEN e.id = node.id THEN 0 ELSE e.sort_order END,
+    pinned = CASE WHEN e.id = node.id THEN FALSE ELSE e.pinned END
+FROM node
+WHERE (e.id = node.id OR e.path @> ARRAY[node.id]) AND node.new IS DISTINCT FROM node.old
+`
+
+	return tx.Exec(query, map[string]any{"id": id, "parent": parentID}).Error
+}
+
+// replaceLinks stores the outgoing links of source, dropping the previous ones.
+func replaceLinks(tx *gorm.DB, sourceID uuid.UUID, targets []uuid.UUID) error {
+	if err := tx.Where("source_id = ?", sourceID).Delete(&linkModel{}).Error; err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	models := lo.Map(targets, func(target uuid.UUID, _ int) linkModel {
+		return linkModel{SourceID: sourceID, TargetID: target}
+	})
+
+	return tx.Create(&models).Error
+}
+
+// hierarchyQueryKey tells the hierarchy queries apart. The text depends on nothing else, so it is built
+// once per key, and pgx, which caches a prepared statement per connection and query text, prepares it
+// once per connection. The IDs go in as one array for the text not to vary with their number.
+type hierarchyQueryKey struct {
+	hType     entity.HierarchyType
+	recursive bool
+	filtered  bool
+}
+
+// hierarchyQuery returns the query text for the arguments and the arguments in the order of its placeholders.
+func (r *gormRepo) hierarchyQuery(ctx context.Context, hType entity.HierarchyType, maxDepth int, ids []uuid.UUID, userID *uuid.UUID) (string, []any, error) {
+	key := hierarchyQueryKey{hType: hType, recursive: r.recursiveHierarchy, filtered: userID != nil}
+	query, ok := r.hierarchyQueries.Load(key)
+	if !ok {
+		built, err := buildHierarchyQuery(key)
+		if err != nil {
+			return "", nil, err
+		}
+		query, _ = r.hierarchyQueries.LoadOrStore(key, built)
+	}
+
+	_, vArgs := buildVisibilityFilter(userID)
+	args := make([]any, 0, 7+len(vArgs)*5)
+	args = append(args, uuidArray(ids), db.ActiveCond("deleted_at"), db.WorkspaceCond(ctx, "workspace_id"))
+	args = append(args, vArgs...)
+	parts := 1
+	if hType == entity.HierarchyTypeChildrenAndParents {
+		parts = 2
+	}
+	for range parts {
+		args = append(args, db.ActiveCond("e.deleted_at"))
 		args = append(args, vArgs...)
 		args = append(args, maxDepth)
-		return base + childrenQuery + parentsQuery, args
+		if !r.recursiveHierarchy {
+			args = append(args, vArgs...)
+		}
+	}
+
+	return query.(string), args, nil
+}
+
+func buildHierarchyQuery(key hierarchyQueryKey) (string, error) {
+	vFilter := "TRUE"
+	if key.filtered {
+		vFilter, _ = buildVisibilityFilter(&uuid.Nil)
+	}
+	base, children, parents := pathQueries(vFilter)
+	if key.recursive {
+		base, children, parents = recursiveQueries(vFilter)
+	}
+
+	switch key.hType {
+	case entity.HierarchyTypeChildrenOnly:
+		return base + children + " SELECT * FROM children ", nil
+	case entity.HierarchyTypeParentsOnly:
+		return base + parents + " SELECT * FROM parents ", nil
+	case entity.HierarchyTypeChildrenAndParents:
+		return base + children + parents + " SELECT * FROM children UNION SELECT * FROM parents ", nil
 	default:
-		return "", nil
+		return "", fmt.Errorf("invalid hierarchy type: %v", key.hType)
 	}
 }
+
+// uuidArray formats ids as an array literal, passed as a single uuid[] parameter where gorm would expand a
+// slice into a list.
+func uuidArray(ids []uuid.UUID) string {
+	return "{" + strings.Join(lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() }), ",") + "}"
+}
+
+// pathQueries select the same rows as recursiveQueries from the materialized paths. A row is reached
+// only if every entity between it and the base is live and visible, as the recursive walk would stop there.
+func pathQueries(vFilter string) (base, children, parents string) {
+	base = fmt.Sprintf(`
+WITH
+    base AS (
+        SELECT id, path
+        FROM entities
+        WHERE id = ANY(?::uuid[]) AND ? AND ? AND %s
+    )
+`, vFilter)
+
+	children = fmt.Sprintf(`,
+    children AS (
+        SELECT e.id, e.type, e.parent_id, e.name, e.slug, e.owner_id, e.word_count, e.reading_time_minutes, e.icon, e.cover, e.pinned, e.sort_order,
+               array_length(e.path, 1) - array_position(e.path, b.id) + 1 AS depth
+        FROM base b
+        JOIN entities e ON e.path @> ARRAY[b.id] AND ? AND %s
+        WHERE array_length(e.path, 1) - array_position(e.path, b.id) < ?
+          AND NOT EXISTS (
+              SELECT 1
+              FROM entities h
+              WHERE h.id = ANY(e.path[array_position(e.path, b.id):]) AND (h.deleted_at IS NOT NULL OR NOT %s)
+          )
+    )
+`, vFilter, vFilter)
+
+	parents = fmt.Sprintf(`,
+    parents AS (
+        SELECT e.id, e.type, e.parent_id, e.name, e.slug, e.owner_id, e.word_count, e.reading_time_minutes, e.icon, e.cover, e.pinned, e.sort_order,
+               array_length(b.path, 1) - array_position(b.path, e.id) + 1 AS depth
+        FROM base b
+        JOIN entities e ON e.id = ANY(b.path) AND ? AND %s
+        WHERE array_length(b.path, 1) - array_position(b.path, e.id) < ?
+          AND NOT EXISTS (
+              SELECT 1
+              FROM entities h
+              WHERE h.id = ANY(b.path[array_position(b.path, e.id):]) AND (h.deleted_at IS NOT NULL OR NOT %s)
+          )
+    )
+`, vFilter, vFilter)
+
+	return base, children, parents
+}
+
+// recursiveQueries walk parent_id; they do not depend on the materialized paths. Each step is served by
+// idx_entities_live_children or idx_entities_live_parents alone.
+func recursiveQueries(vFilter string) (base, children, parents string) {
+	base = fmt.Sprintf(`
+WITH RECURSIVE
+    base AS (
+        SELECT id, type, parent_id, name, slug, owner_id, word_count, reading_time_minutes, icon, cover, pinned, sort_order, 1 as depth
+        FROM entities 
+        WHERE id = ANY(?::uuid[]) AND ? AND ? AND %s
+    )
+`, vFilter)
+
+	children = fmt.Sprintf(`,
+    children AS (
+        SELECT *
+        FROM base
+
+        UNION ALL
+
+        SELECT e.id, e.type, e.parent_id, e.name, e.slug, e.owner_id, e.word_count, e.reading_time_minutes, e.icon, e.cover, e.pinned, e.sort_order, c.depth + 1 as depth
+        FROM children c
+        JOIN entities e ON c.id = e.parent_id AND ? AND %s
+		WHERE c.depth < ?
+    )
+`, vFilter)
+
+	parents = fmt.Sprintf(`,
+    parents AS (
+        SELECT *
+        FROM base
+
+        UNION ALL
+
+        SELECT e.id, e.type, e.parent_id, e.name, e.slug, e.owner_id, e.word_count, e.reading_time_minutes, e.icon, e.cover, e.pinned, e.sort_order, p.depth + 1 as depth
+        FROM parents p
+        JOIN entities e ON p.parent_id = e.id AND ? AND %s
+		WHERE p.depth < ?
+    )
+`, vFilter)
+
+	return base, children, parents
+}
@@ -1,13 +1,18 @@
 package gorm
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
@@ -25,7 +30,7 @@ func TestMain(m *testing.M) {
 func newEntityRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
 	gdb, _, cleanup := shared.CreateIsolatedDB(t)
 	t.Cleanup(cleanup)
-	repo, err := NewRepository(gdb)
+	repo, err := NewRepository(gdb, entity.Config{}, nil)
 	require.NoError(t, err)
 	return repo, gdb, cleanup
 }
@@ -70,6 +75,7 @@ func TestEntity_Create_Get_Versions_Update(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	id := uuid.New()
 	req := entity.CreateEntityReq{
+		Slug:     uuid.NewString(),
 		Type:     entity.Type("t"),
 		Name:     "root",
 		Content:  "v1",
@@ -82,17 +88,21 @@ func TestEntity_Create_Get_Versions_Update(t *testing.T) {
 	dto, err := repo.Get(t.Context(), id)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, req.Type, req.Name, req.Content, id, userID, userID, req.ParentID, &[]int{1}[0])
+	require.Equal(t, userID, dto.OwnerID)
+	require.Equal(t, contextx.DefaultWorkspaceID, dto.WorkspaceID)
 	dto, err = repo.GetVersion(t.Context(), id, 1)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, "", req.Name, req.Content, id, userID, userID, req.ParentID, &[]int{1}[0])
 
 	// Update -> version 2
 	reqUp := entity.UpdateEntityReq{
+		Slug:     uuid.NewString(),
 		ID:       id,
 		Name:     "root-2",
 		Content:  "v2",
 		ParentID: nil,
 		UserID:   userID2,
+		Summary:  "Rename the root",
 	}
 	require.NoError(t, repo.Update(t.Context(), reqUp, now.Add(time.Minute)))
 
@@ -100,16 +110,30 @@ func TestEntity_Create_Get_Versions_Update(t *testing.T) {
 	dto, err = repo.Get(t.Context(), id)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, req.Type, reqUp.Name, reqUp.Content, id, userID, userID2, reqUp.ParentID, &[]int{2}[0])
+	require.Equal(t, userID, dto.OwnerID) // edits keep the owner
 	dto, err = repo.GetVersion(t.Context(), id, 2)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, "", reqUp.Name, reqUp.Content, id, userID2, userID2, reqUp.ParentID, &[]int{2}[0])
 
 	// Versions list: [2,1]
-	vs, err := repo.GetVersionsList(t.Context(), id)
+	vs, err := repo.GetVersionsList(t.Context(), id, 0, 10, true)
 	require.NoError(t, err)
 	require.Len(t, vs, 2)
 	compareEntityDTO(t, vs[0], "", reqUp.Name, reqUp.Content, id, userID2, userID2, reqUp.ParentID, &[]int{2}[0])
 	compareEntityDTO(t, vs[1], "", req.Name, req.Content, id, userID, userID, req.ParentID, &[]int{1}[0])
+	require.Equal(t, reqUp.Summary, vs[0].Summary)
+	require.Empty(t, vs[1].Summary)
+
+	// paged, without content
+	vs, err = repo.GetVersionsList(t.Context(), id, 0, 1, false)
+	require.NoError(t, err)
+	require.Len(t, vs, 1)
+	compareEntityDTO(t, vs[0], "", reqUp.Name, "", id, userID2, userID2, reqUp.ParentID, &[]int{2}[0])
+	require.Equal(t, reqUp.Summary, vs[0].Summary)
+	vs, err = repo.GetVersionsList(t.Context(), id, 2, 1, false)
+	require.NoError(t, err)
+	require.Len(t, vs, 1)
+	compareEntityDTO(t, vs[0], "", req.Name, "", id, userID, userID, req.ParentID, &[]int{1}[0])
 
 	// not found
 	_, err = repo.Get(t.Context(), uuid.New())
@@ -123,11 +147,11 @@ func TestEntity_Create_Get_Versions_Update(t *testing.T) {
 	cleanup()
 	_, err = repo.Get(t.Context(), id)
 	require.Error(t, err)
-	err = repo.Delete(t.Context(), []uuid.UUID{id})
+	err = repo.Delete(t.Context(), []uuid.UUID{id}, userID)
 	require.Error(t, err)
 	_, err = repo.GetVersion(t.Context(), id, 1)
 	require.Error(t, err)
-	_, err = repo.GetVersionsList(t.Context(), id)
+	_, err = repo.GetVersionsList(t.Context(), id, 0, 10, false)
 	require.Error(t, err)
 	err = repo.Update(t.Context(), reqUp, time.Now().UTC())
 	require.Error(t, err)
@@ -144,6 +168,7 @@ func TestEntity_CreateDraft_And_UpdateDraft(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	id := uuid.New()
 	req := entity.CreateEntityReq{
+		Slug:    uuid.NewString(),
 		Type:    "t",
 		Name:    "draft",
 		Content: "d0",
@@ -155,12 +180,14 @@ func TestEntity_CreateDraft_And_UpdateDraft(t *testing.T) {
 	dto, err := repo.Get(t.Context(), id)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, req.Type, req.Name, req.Content, id, userID, userID, req.ParentID, nil)
-	vs, err := repo.GetVersionsList(t.Context(), id)
+	require.Equal(t, userID, dto.OwnerID)
+	vs, err := repo.GetVersionsList(t.Context(), id, 0, 10, false)
 	require.NoError(t, err)
 	require.Len(t, vs, 0)
 
 	// update, version = 1
 	reqUpd := entity.UpdateEntityReq{
+		Slug:    uuid.NewString(),
 		ID:      id,
 		Name:    "draft-1",
 		Content: "d1",
@@ -170,12 +197,13 @@ func TestEntity_CreateDraft_And_UpdateDraft(t *testing.T) {
 	dto, err = repo.Get(t.Context(), id)
 	require.NoError(t, err)
 	require.Equal(t, &[]int{1}[0], dto.CurrentVersion)
-	vs, err = repo.GetVersionsList(t.Context(), id)
+	vs, err = repo.GetVersionsList(t.Context(), id, 0, 10, false)
 	require.NoError(t, err)
 	require.Len(t, vs, 1)
 
 	// UpdateDraft, version = nil
 	reqUpd = entity.UpdateEntityReq{
+		Slug:     uuid.NewString(),
 		ID:       id,
 		Name:     "draft-2",
 		Content:  "d1",
@@ -186,7 +214,7 @@ func TestEntity_CreateDraft_And_UpdateDraft(t *testing.T) {
 	dto, err = repo.Get(t.Context(), id)
 	require.NoError(t, err)
 	compareEntityDTO(t, dto, req.Type, reqUpd.Name, reqUpd.Content, id, userID, userID, reqUpd.ParentID, nil)
-	vs, err = repo.GetVersionsList(t.Context(), id)
+	vs, err = repo.GetVersionsList(t.Context(), id, 0, 10, false)
 	require.NoError(t, err)
 	require.Len(t, vs, 1)
 
@@ -209,18 +237,20 @@ func TestEntity_GetListItem_And_GetAll(t *testing.T) {
 	userID := createUserForEntity(t, gdb)
 
 	req1 := entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: "t", Name: "A", Content: "c1", UserID: userID,
 	}
 	id1 := uuid.New()
 	require.NoError(t, repo.Create(t.Context(), req1, id1, time.Now().UTC()))
 	id2 := uuid.New()
 	req2 := entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: entity.Type("t"), Name: "B", Content: "c2", UserID: userID,
 	}
 	require.NoError(t, repo.Create(t.Context(), req2, id2, time.Now().UTC()))
 
-	exp1 := entity.ListItem{ID: id1, Type: req1.Type, Name: req1.Name, ParentID: req1.ParentID}
-	exp2 := entity.ListItem{ID: id2, Type: req2.Type, Name: req2.Name, ParentID: req2.ParentID}
+	exp1 := entity.ListItem{ID: id1, Type: req1.Type, Name: req1.Name, Slug: req1.Slug, ParentID: req1.ParentID, OwnerID: userID}
+	exp2 := entity.ListItem{ID: id2, Type: req2.Type, Name: req2.Name, Slug: req2.Slug, ParentID: req2.ParentID, OwnerID: userID}
 	li, err := repo.GetListItem(t.Context(), id1)
 	require.NoError(t, err)
 	require.Equal(t, exp1, li)
@@ -234,12 +264,68 @@ func TestEntity_GetListItem_And_GetAll(t *testing.T) {
 	_, err = repo.GetListItem(t.Context(), uuid.New())
 	require.ErrorIs(t, err, entity.ErrEntityNotFound())
 
+	// batched, missing ids skipped
+	items, err := repo.GetListItems(t.Context(), []uuid.UUID{id1, uuid.New(), id2})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expSlice, items)
+	items, err = repo.GetListItems(t.Context(), nil)
+	require.NoError(t, err)
+	require.Empty(t, items)
+	entities, err := repo.GetMany(t.Context(), []uuid.UUID{id1, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+	require.Equal(t, id1, entities[0].ID)
+	require.Equal(t, req1.Content, entities[0].Content)
+
 	// негатив
 	cleanup()
 	_, err = repo.GetListItem(t.Context(), id1)
 	require.Error(t, err)
 	_, err = repo.GetAll(t.Context())
 	require.Error(t, err)
+	_, err = repo.GetListItems(t.Context(), []uuid.UUID{id1})
+	require.Error(t, err)
+	_, err = repo.GetMany(t.Context(), []uuid.UUID{id1})
+	require.Error(t, err)
+}
+
+// BenchmarkEntity_GetListItems compares looking up a batch one entity at a time with one query:
+//
+//	go test -tags testutil -run '^$' -bench GetListItems ./internal/app/entity/repo/gorm
+func BenchmarkEntity_GetListItems(b *testing.B) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(b)
+	b.Cleanup(cleanup)
+	repo, err := NewRepository(gdb, entity.Config{}, nil)
+	require.NoError(b, err)
+
+	userID := uuid.New()
+	require.NoError(b, gdb.Exec(`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+		VALUES (?,?,'name','hash',NOW(),NOW(),0)`, userID, userID.String()).Error)
+	ids := make([]uuid.UUID, 500)
+	for i := range ids {
+		ids[i] = uuid.New()
+		req := entity.CreateEntityReq{Slug: ids[i].String(), Type: entity.TypeDepartment, Name: ids[i].String(), UserID: userID}
+		require.NoError(b, repo.Create(b.Context(), req, ids[i], time.Now()))
+	}
+
+	for _, size := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("one_by_one/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				for _, id := range ids[:size] {
+					if _, err := repo.GetListItem(b.Context(), id); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batched/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := repo.GetListItems(b.Context(), ids[:size]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
 }
 
 func TestEntity_GetHierarchy(t *testing.T) {
@@ -250,60 +336,67 @@ func TestEntity_GetHierarchy(t *testing.T) {
 
 	// root -> c1 -> gc1 ; root -> c2
 	root := uuid.New()
-	rootItem := entity.ListItem{ID: root, Type: "t", Name: "root", Depth: 2}
+	rootItem := entity.ListItem{ID: root, Type: "t", Name: "root", Slug: "root", OwnerID: userID, Depth: 2}
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: rootItem.Slug,
 		Type: rootItem.Type, Name: rootItem.Name, Content: "", UserID: userID,
 	}, root, time.Now().UTC()))
 	c1 := uuid.New()
-	c1Item := entity.ListItem{ID: c1, Type: "t", Name: "c1", ParentID: &root, Depth: 1}
+	c1Item := entity.ListItem{ID: c1, Type: "t", Name: "c1", Slug: "c1", ParentID: &root, OwnerID: userID, Depth: 1}
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: c1Item.Slug,
 		Type: c1Item.Type, Name: c1Item.Name, Content: "", ParentID: c1Item.ParentID, UserID: userID,
 	}, c1, time.Now().UTC()))
 	gc1 := uuid.New()
-	gc1Item := entity.ListItem{ID: gc1, Type: "t", Name: "gc1", ParentID: &c1, Depth: 2}
+	gc1Item := entity.ListItem{ID: gc1, Type: "t", Name: "gc1", Slug: "gc1", ParentID: &c1, OwnerID: userID2, Depth: 2}
 	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: gc1Item.Slug,
 		Type: gc1Item.Type, Name: gc1Item.Name, Content: "", ParentID: gc1Item.ParentID, UserID: userID2,
 	}, gc1))
 	c2 := uuid.New()
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: "t", Name: "c2", Content: "", ParentID: &root, UserID: userID,
 	}, c2, time.Now().UTC()))
 
+	// the paths and the recursive walk must agree
+	getHierarchy := hierarchyGetter(t, repo, gdb)
+
 	// empty permissions
-	res, err := repo.GetHierarchy(t.Context(), []uuid.UUID{}, 1, nil, entity.HierarchyTypeChildrenAndParents)
+	res, err := getHierarchy([]uuid.UUID{}, 1, nil, entity.HierarchyTypeChildrenAndParents)
 	require.NoError(t, err)
 	require.Equal(t, []entity.ListItem{}, res)
 	// children and parents
 	// permissions = [c1] → {root, c1, gc1}
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 5, nil, entity.HierarchyTypeChildrenAndParents)
+	res, err = getHierarchy([]uuid.UUID{c1}, 5, nil, entity.HierarchyTypeChildrenAndParents)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{rootItem, c1Item, gc1Item}, res)
 
 	// children only
 	// permissions = [c1] → {c1, gc1}
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 5, nil, entity.HierarchyTypeChildrenOnly)
+	res, err = getHierarchy([]uuid.UUID{c1}, 5, nil, entity.HierarchyTypeChildrenOnly)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{c1Item, gc1Item}, res)
 
 	// parents only
 	// permissions = [c1] → {root, c1}
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 5, nil, entity.HierarchyTypeParentsOnly)
+	res, err = getHierarchy([]uuid.UUID{c1}, 5, nil, entity.HierarchyTypeParentsOnly)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{c1Item, rootItem}, res)
 
 	// userID not nil
 	// not own draft gc1 must be excluded [c1] → {root, c1}
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 5, &userID, entity.HierarchyTypeChildrenAndParents)
+	res, err = getHierarchy([]uuid.UUID{c1}, 5, &userID, entity.HierarchyTypeChildrenAndParents)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{rootItem, c1Item}, res)
 
 	// own draft gc1 must be included [c1] → {root, c1, gc1}
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 5, &userID2, entity.HierarchyTypeChildrenAndParents)
+	res, err = getHierarchy([]uuid.UUID{c1}, 5, &userID2, entity.HierarchyTypeChildrenAndParents)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{rootItem, c1Item, gc1Item}, res)
 
 	// maxDepth = 2 - only item
-	res, err = repo.GetHierarchy(t.Context(), []uuid.UUID{c1}, 1, nil, entity.HierarchyTypeChildrenAndParents)
+	res, err = getHierarchy([]uuid.UUID{c1}, 1, nil, entity.HierarchyTypeChildrenAndParents)
 	require.NoError(t, err)
 	require.ElementsMatch(t, []entity.ListItem{c1Item}, res)
 
@@ -317,6 +410,247 @@ func TestEntity_GetHierarchy(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestEntity_Paths(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newEntityRepo(t)
+	getHierarchy := hierarchyGetter(t, repo, gdb)
+	user := createUserForEntity(t, gdb)
+
+	// a -> b -> c ; d
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "a", Slug: "a", UserID: user}, a, time.Now()))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "b", Slug: "b", ParentID: &a, UserID: user}, b, time.Now()))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{Type: entity.TypeArticle, Name: "c", Slug: "c", ParentID: &b, UserID: user}, c))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "d", Slug: "d", UserID: user}, d, time.Now()))
+
+	ids := func(items []entity.ListItem) []uuid.UUID {
+		return lo.Map(items, func(item entity.ListItem, _ int) uuid.UUID { return item.ID })
+	}
+	res, err := getHierarchy([]uuid.UUID{c}, 5, nil, entity.HierarchyTypeParentsOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{c, b, a}, ids(res))
+
+	// b moves below d and takes c with it
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: b, Name: "b", Slug: "b", ParentID: &d, UserID: user}, time.Now()))
+	res, err = getHierarchy([]uuid.UUID{c}, 5, nil, entity.HierarchyTypeParentsOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{c, b, d}, ids(res))
+	res, err = getHierarchy([]uuid.UUID{a}, 5, nil, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{a}, ids(res))
+	res, err = getHierarchy([]uuid.UUID{d}, 5, nil, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.ListItem{
+		{ID: d, Type: entity.TypeDepartment, Name: "d", Slug: "d", OwnerID: user, Depth: 1},
+		{ID: b, Type: entity.TypeDepartment, Name: "b", Slug: "b", ParentID: &d, OwnerID: user, Depth: 2},
+		{ID: c, Type: entity.TypeArticle, Name: "c", Slug: "c", ParentID: &b, OwnerID: user, Depth: 3},
+	}, res)
+
+	// a draft moves too
+	require.NoError(t, repo.UpdateDraft(t.Context(), entity.UpdateEntityReq{ID: c, Name: "c", Slug: "c", ParentID: &a, UserID: user}))
+	res, err = getHierarchy([]uuid.UUID{c}, 5, nil, entity.HierarchyTypeParentsOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{c, a}, ids(res))
+
+	// another user does not see the draft c, nor anything reached through it
+	e := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeArticle, Name: "e", Slug: "e", ParentID: &c, UserID: user}, e, time.Now()))
+	other := createUserForEntity(t, gdb)
+	res, err = getHierarchy([]uuid.UUID{a}, 5, &other, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{a}, ids(res))
+	res, err = getHierarchy([]uuid.UUID{e}, 5, &other, entity.HierarchyTypeParentsOnly)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{e}, ids(res))
+}
+
+// hierarchyGetter returns GetHierarchy of repo, checked against the recursive lookup on the same data.
+func hierarchyGetter(t *testing.T, repo *gormRepo, gdb *gorm.DB) func(ids []uuid.UUID, maxDepth int, userID *uuid.UUID, hType entity.HierarchyType) ([]entity.ListItem, error) {
+	t.Helper()
+	recursive, err := NewRepository(gdb, entity.Config{RecursiveHierarchy: true}, nil)
+	require.NoError(t, err)
+
+	return func(ids []uuid.UUID, maxDepth int, userID *uuid.UUID, hType entity.HierarchyType) ([]entity.ListItem, error) {
+		res, err := repo.GetHierarchy(t.Context(), ids, maxDepth, userID, hType)
+		if err != nil {
+			return nil, err
+		}
+		exp, err := recursive.GetHierarchy(t.Context(), ids, maxDepth, userID, hType)
+		require.NoError(t, err)
+		require.ElementsMatch(t, exp, res)
+
+		return res, nil
+	}
+}
+
+func TestEntity_GetExportPage(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+	user := createUserForEntity(t, gdb)
+	other := createUserForEntity(t, gdb)
+
+	// a -> b -> c (draft) -> e ; a -> f (deleted) -> g ; d
+	a, b, c, d, e, f, g := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	create := func(id uuid.UUID, parentID *uuid.UUID, name string) {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Type: entity.TypeArticle, Name: name, Slug: name, Content: name + " content", ParentID: parentID, UserID: user,
+		}, id, time.Now()))
+	}
+	create(a, nil, "a")
+	create(b, &a, "b")
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{Type: entity.TypeArticle, Name: "c", Slug: "c", ParentID: &b, UserID: user}, c))
+	create(e, &c, "e")
+	create(f, &a, "f")
+	create(g, &f, "g")
+	create(d, nil, "d")
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{f}, user))
+
+	ids := func(items []entity.ExportItem) []uuid.UUID {
+		return lo.Map(items, func(item entity.ExportItem, _ int) uuid.UUID { return item.ID })
+	}
+	// walks the export one item per page
+	export := func(rootID, userID *uuid.UUID) []entity.ExportItem {
+		var (
+			all    []entity.ExportItem
+			cursor entity.ExportCursor
+		)
+		for {
+			items, err := repo.GetExportPage(t.Context(), rootID, cursor, 1, userID)
+			require.NoError(t, err)
+			if len(items) == 0 {
+				return all
+			}
+			all = append(all, items...)
+			cursor = entity.ExportCursor{Depth: items[0].Depth, ID: items[0].ID}
+		}
+	}
+
+	// level by level, so parents come first
+	res := export(&a, nil)
+	require.Equal(t, []uuid.UUID{a, b, c, e}, ids(res))
+	require.Equal(t, entity.ExportItem{
+		ID: b, ParentID: &a, Type: entity.TypeArticle, Name: "b", Slug: "b", Content: "b content", Version: 1, UpdatedAt: res[1].UpdatedAt, Depth: 2,
+	}, res[1])
+	require.True(t, res[2].IsDraft)
+
+	res = export(nil, nil)
+	require.ElementsMatch(t, []uuid.UUID{a, d}, ids(res[:2]))
+	require.Equal(t, []uuid.UUID{b, c, e}, ids(res[2:]))
+
+	// another user does not get the draft c, nor anything reached through it
+	res = export(&a, &other)
+	require.Equal(t, []uuid.UUID{a, b}, ids(res))
+	res = export(&c, &other)
+	require.Empty(t, res)
+	res = export(&c, &user)
+	require.Equal(t, []uuid.UUID{c, e}, ids(res))
+
+	// err
+	cleanup()
+	_, err := repo.GetExportPage(t.Context(), nil, entity.ExportCursor{}, 1, nil)
+	require.Error(t, err)
+}
+
+func TestEntity_List(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	base := time.Now().UTC().Truncate(time.Microsecond)
+
+	// root -> a -> b (draft of user2) ; root -> c (by user2) ; root -> d (deleted) -> e
+	create := func(name string, eType entity.Type, parentID *uuid.UUID, userID uuid.UUID, at time.Time) uuid.UUID {
+		id := uuid.New()
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: name, Type: eType, Name: name, ParentID: parentID, UserID: userID,
+		}, id, at))
+		return id
+	}
+	root := create("root", entity.TypeDepartment, nil, user1, base)
+	a := create("a", entity.TypeArticle, &root, user1, base.Add(time.Minute))
+	b := uuid.New()
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: "b", Type: entity.TypeArticle, Name: "b", ParentID: &a, UserID: user2,
+	}, b))
+	c := create("c", entity.TypeArticle, &root, user2, base.Add(2*time.Minute))
+	d := create("d", entity.TypeArticle, &root, user1, base)
+	create("e", entity.TypeArticle, &d, user1, base)
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{d}, user1))
+
+	list := func(filter entity.ListFilter, limit int) []entity.ListEntry {
+		t.Helper()
+		if filter.Sort == "" {
+			filter.Sort = entity.ListSortName
+		}
+		entries, err := repo.List(t.Context(), filter, limit)
+		require.NoError(t, err)
+		return entries
+	}
+	names := func(entries []entity.ListEntry) []string {
+		return lo.Map(entries, func(e entity.ListEntry, _ int) string { return e.Name })
+	}
+
+	// everything live, e is behind a deleted ancestor
+	all := list(entity.ListFilter{}, 10)
+	require.Equal(t, []string{"a", "b", "c", "root"}, names(all))
+	require.Equal(t, []string{"root", "c", "b", "a"}, names(list(entity.ListFilter{Desc: true}, 10)))
+
+	// breadcrumbs from the root down, the row itself excluded
+	byName := lo.KeyBy(all, func(e entity.ListEntry) string { return e.Name })
+	require.Equal(t, []entity.Breadcrumb{{ID: root, Name: "root", Slug: "root"}, {ID: a, Name: "a", Slug: "a"}}, byName["b"].Breadcrumbs)
+	require.Equal(t, []entity.Breadcrumb{}, byName["root"].Breadcrumbs)
+	require.True(t, byName["b"].IsDraft)
+	require.Equal(t, user2, byName["b"].CreatedBy)
+	require.Equal(t, base.Add(2*time.Minute), byName["c"].CreatedAt.UTC())
+
+	// counts of the live children and descendants; d and e are gone
+	require.Equal(t, [2]int{2, 3}, [2]int{byName["root"].ChildrenCount, byName["root"].SubtreeSize})
+	require.Equal(t, [2]int{1, 1}, [2]int{byName["a"].ChildrenCount, byName["a"].SubtreeSize})
+	require.Equal(t, [2]int{0, 0}, [2]int{byName["c"].ChildrenCount, byName["c"].SubtreeSize})
+
+	// pages continue after the cursor, in both directions
+	after := entity.ListCursor{Name: "b", ID: b}
+	require.Equal(t, []string{"c", "root"}, names(list(entity.ListFilter{After: &after}, 10)))
+	require.Equal(t, []string{"a"}, names(list(entity.ListFilter{After: &after, Desc: true}, 10)))
+	afterTime := entity.ListCursor{Time: base.Add(time.Minute), ID: a}
+	require.Equal(t, []string{"c"}, names(list(entity.ListFilter{
+		Sort: entity.ListSortCreatedAt, Status: entity.ListStatusPublished, After: &afterTime,
+	}, 10)))
+	require.Equal(t, []string{"root"}, names(list(entity.ListFilter{
+		Sort: entity.ListSortCreatedAt, Status: entity.ListStatusPublished, After: &afterTime, Desc: true,
+	}, 10)))
+	require.Len(t, list(entity.ListFilter{}, 2), 2)
+
+	// filters
+	require.Equal(t, []string{"a", "b", "c"}, names(list(entity.ListFilter{ParentID: &root}, 10)))
+	require.Equal(t, []string{"b"}, names(list(entity.ListFilter{ParentID: &a}, 10)))
+	require.Equal(t, []string{"root"}, names(list(entity.ListFilter{Type: entity.TypeDepartment}, 10)))
+	require.Equal(t, []string{"b"}, names(list(entity.ListFilter{Status: entity.ListStatusDraft}, 10)))
+	require.Equal(t, []string{"b", "c"}, names(list(entity.ListFilter{AuthorID: &user2}, 10)))
+	since := base.Add(90 * time.Second)
+	require.Equal(t, []string{"c"}, names(list(entity.ListFilter{
+		UpdatedSince: &since, Status: entity.ListStatusPublished,
+	}, 10)))
+
+	// permissions: only the given IDs, without the drafts of other users
+	visible := list(entity.ListFilter{IDs: []uuid.UUID{root, a, b}, UserID: &user1}, 10)
+	require.Equal(t, []string{"a", "root"}, names(visible))
+	// the draft b of user2 is not counted either
+	require.Equal(t, [2]int{0, 0}, [2]int{visible[0].ChildrenCount, visible[0].SubtreeSize})
+	require.Equal(t, [2]int{2, 2}, [2]int{visible[1].ChildrenCount, visible[1].SubtreeSize})
+	require.Equal(t, []string{"a", "b"}, names(list(entity.ListFilter{IDs: []uuid.UUID{a, b}, UserID: &user2}, 10)))
+	require.Empty(t, list(entity.ListFilter{IDs: []uuid.UUID{c}, ParentID: &a}, 10))
+
+	// invalid sort
+	_, err := repo.List(t.Context(), entity.ListFilter{Sort: "views"}, 10)
+	require.ErrorIs(t, err, entity.ErrInvalidListSort())
+
+	// err
+	cleanup()
+	_, err = repo.List(t.Context(), entity.ListFilter{Sort: entity.ListSortName}, 10)
+	require.Error(t, err)
+}
+
 func TestEntity_Delete(t *testing.T) {
 	t.Parallel()
 	repo, gdb, _ := newEntityRepo(t)
@@ -324,19 +658,22 @@ func TestEntity_Delete(t *testing.T) {
 
 	root := uuid.New()
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: "t", Name: "root", Content: "", UserID: userID,
 	}, root, time.Now().UTC()))
 	child := uuid.New()
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: "t", Name: "child", Content: "", ParentID: &root, UserID: userID,
 	}, child, time.Now().UTC()))
 	grandChild := uuid.New()
 	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
 		Type: "t", Name: "grandChild", Content: "", ParentID: &child, UserID: userID,
 	}, grandChild, time.Now().UTC()))
 
 	// delete child and grandChild
-	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{child, grandChild}))
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{child, grandChild}, userID))
 
 	var cnt int
 	err := gdb.WithContext(t.Context()).
@@ -346,13 +683,1992 @@ func TestEntity_Delete(t *testing.T) {
 	require.Equal(t, 2, cnt)
 
 	// not found
-	err = repo.Delete(t.Context(), []uuid.UUID{child})
+	err = repo.Delete(t.Context(), []uuid.UUID{child}, userID)
 	require.ErrorIs(t, err, entity.ErrEntityNotFound())
 }
 
-func TestNewRepository(t *testing.T) {
+func TestEntity_DeletedNeverLeak(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newEntityRepo(t)
+	userID := createUserForEntity(t, gdb)
+
+	// root -> child (deleted) -> grandChild (deleted) ; root -> kept
+	create := func(name string, parentID *uuid.UUID) uuid.UUID {
+		id := uuid.New()
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: name, Type: "t", Name: name, ParentID: parentID, UserID: userID,
+		}, id, time.Now().UTC()))
+		return id
+	}
+	root := create("root", nil)
+	child := create("child", &root)
+	grandChild := create("grand-child", &child)
+	kept := create("kept", &root)
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{child, grandChild}, userID))
+	deleted := []uuid.UUID{child, grandChild}
+
+	ids := func(items []entity.ListItem) []uuid.UUID {
+		return lo.Map(items, func(item entity.ListItem, _ int) uuid.UUID { return item.ID })
+	}
+
+	// tree
+	getHierarchy := hierarchyGetter(t, repo, gdb)
+	for _, viewer := range []*uuid.UUID{nil, &userID} {
+		res, err := getHierarchy([]uuid.UUID{root}, 5, viewer, entity.HierarchyTypeChildrenOnly)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{root, kept}, ids(res))
+		page, err := repo.GetExportPage(t.Context(), &root, entity.ExportCursor{}, 10, viewer)
+		require.NoError(t, err)
+		for _, item := range page {
+			require.NotContains(t, deleted, item.ID)
+		}
+	}
+	all, err := repo.GetAll(t.Context())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{root, kept}, ids(all))
+
+	// permissions walk the ancestors of the entity asked for; a deleted one grants nothing
+	res, err := getHierarchy(deleted, 5, nil, entity.HierarchyTypeParentsOnly)
+	require.NoError(t, err)
+	require.Empty(t, res)
+	res, err = getHierarchy(deleted, 5, nil, entity.HierarchyTypeChildrenAndParents)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// lookups by id and slug
+	_, err = repo.Get(t.Context(), child)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	items, err := repo.GetListItems(t.Context(), deleted)
+	require.NoError(t, err)
+	require.Empty(t, items)
+	_, err = repo.GetIDBySlug(t.Context(), "child")
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	taken, err := repo.GetTakenSlugs(t.Context(), "child", uuid.Nil)
+	require.NoError(t, err)
+	require.Empty(t, taken)
+	exists, err := repo.SiblingNameExists(t.Context(), &root, "child", uuid.Nil, userID)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestEntity_GetMeta(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rootID, childID := uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "root", Content: "one two", UserID: user1,
+		Stats: entity.ContentStats{WordCount: 2, ReadingTimeMinutes: 1},
+	}, rootID, now))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeArticle, Name: "child", ParentID: &rootID, UserID: user2,
+	}, childID))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   rootID, Name: "root", Content: "one two three", UserID: user2,
+		Stats: entity.ContentStats{WordCount: 3, ReadingTimeMinutes: 1},
+	}, now.Add(time.Minute)))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   rootID, Name: "root", Content: "one", UserID: user1,
+		Stats: entity.ContentStats{WordCount: 1, ReadingTimeMinutes: 1},
+	}, now.Add(2*time.Minute)))
+
+	meta, err := repo.GetMeta(t.Context(), rootID, 5)
+	require.NoError(t, err)
+	require.Equal(t, entity.ContentStats{WordCount: 1, ReadingTimeMinutes: 1}, meta.ContentStats)
+	require.Equal(t, 3, meta.VersionCount)
+	require.Equal(t, 1, meta.ChildrenCount)
+	require.Len(t, meta.LastEditors, 2)
+	require.Equal(t, user1, meta.LastEditors[0].UserID)
+	require.True(t, meta.LastEditors[0].EditedAt.Equal(now.Add(2*time.Minute)))
+	require.Equal(t, user2, meta.LastEditors[1].UserID)
+
+	meta, err = repo.GetMeta(t.Context(), rootID, 1)
+	require.NoError(t, err)
+	require.Len(t, meta.LastEditors, 1)
+
+	// cached stats are visible in list items
+	item, err := repo.GetListItem(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Equal(t, 1, item.WordCount)
+
+	_, err = repo.GetMeta(t.Context(), uuid.New(), 5)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetMeta(t.Context(), rootID, 5)
+	require.Error(t, err)
+}
+
+func TestEntity_GetContributors(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	id := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "doc", UserID: user1}, id, now))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: id, Name: "doc", UserID: user1}, now.Add(time.Minute)))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: id, Name: "doc", UserID: user2}, now.Add(2*time.Minute)))
+
+	got, err := repo.GetContributors(t.Context(), id)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, user2, got[0].UserID)
+	require.Equal(t, 1, got[0].VersionCount)
+	require.True(t, got[0].LastContributedAt.Equal(now.Add(2*time.Minute)))
+	require.Equal(t, user1, got[1].UserID)
+	require.Equal(t, 2, got[1].VersionCount)
+	require.True(t, got[1].LastContributedAt.Equal(now.Add(time.Minute)))
+
+	got, err = repo.GetContributors(t.Context(), uuid.New())
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetContributors(t.Context(), id)
+	require.Error(t, err)
+}
+
+func TestEntity_GetActivity(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rootID, childID, otherID, draftID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "root", UserID: user1,
+	}, rootID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "other", UserID: user1,
+	}, otherID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeArticle, Name: "child", ParentID: &otherID, UserID: user1,
+	}, childID, now))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeArticle, Name: "draft", ParentID: &rootID, UserID: user2,
+	}, draftID))
+
+	// a move without content changes produces a single event
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   childID, Name: "child", ParentID: &rootID, UserID: user2,
+	}, now.Add(time.Minute)))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   childID, Name: "child", Content: "text", ParentID: &rootID, UserID: user1, Summary: "Add text",
+	}, now.Add(2*time.Minute)))
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{childID}, user2))
+
+	type row struct {
+		EntityID uuid.UUID
+		Type     entity.EventType
+		Actor    uuid.UUID
+	}
+	toRows := func(events []entity.Event) []row {
+		return lo.Map(events, func(e entity.Event, _ int) row {
+			return row{EntityID: e.EntityID, Type: e.Type, Actor: lo.FromPtr(e.ActorID)}
+		})
+	}
+
+	events, err := repo.GetActivity(t.Context(), rootID, 0, 10, 5, &user1, true)
+	require.NoError(t, err)
+	require.Equal(t, []row{
+		{childID, entity.EventDeleted, user2},
+		{childID, entity.EventEdited, user1},
+		{childID, entity.EventMoved, user2},
+		{childID, entity.EventCreated, user1},
+		{rootID, entity.EventCreated, user1},
+	}, toRows(events))
+	require.Equal(t, "child", events[0].EntityName)
+	require.Equal(t, &otherID, events[2].FromParentID)
+	require.Equal(t, &rootID, events[2].ToParentID)
+	require.Equal(t, lo.ToPtr(2), events[2].Version)
+	require.Equal(t, lo.ToPtr(3), events[1].Version)
+	require.Equal(t, "Add text", events[1].Summary)
+	require.Empty(t, events[2].Summary)
+
+	// the draft author and admins see the draft
+	events, err = repo.GetActivity(t.Context(), rootID, 0, 10, 5, &user2, true)
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+	events, err = repo.GetActivity(t.Context(), rootID, 0, 10, 5, nil, true)
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+
+	// paging
+	page, err := repo.GetActivity(t.Context(), rootID, 0, 2, 5, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, events[:2], page)
+	page, err = repo.GetActivity(t.Context(), rootID, page[1].ID, 10, 5, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, events[2:], page)
+
+	// depth limit
+	events, err = repo.GetActivity(t.Context(), rootID, 0, 10, 1, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, []row{{rootID, entity.EventCreated, user1}}, toRows(events))
+
+	// not found: missing, deleted or someone else's draft
+	_, err = repo.GetActivity(t.Context(), uuid.New(), 0, 10, 5, nil, true)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	_, err = repo.GetActivity(t.Context(), childID, 0, 10, 5, nil, true)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	_, err = repo.GetActivity(t.Context(), draftID, 0, 10, 5, &user1, true)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// minor edits are left out unless asked for
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   rootID, Name: "root", Content: "typo fixed", UserID: user1, MinorEdit: true,
+	}, now.Add(3*time.Minute)))
+	events, err = repo.GetActivity(t.Context(), rootID, 0, 10, 5, nil, false)
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+	require.False(t, events[0].MinorEdit)
+	events, err = repo.GetActivity(t.Context(), rootID, 0, 10, 5, nil, true)
+	require.NoError(t, err)
+	require.Len(t, events, 7)
+	require.Equal(t, row{rootID, entity.EventEdited, user1}, toRows(events)[0])
+	require.True(t, events[0].MinorEdit)
+	vs, err := repo.GetVersionsList(t.Context(), rootID, 0, 1, false)
+	require.NoError(t, err)
+	require.True(t, vs[0].MinorEdit)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetActivity(t.Context(), rootID, 0, 10, 5, nil, true)
+	require.Error(t, err)
+}
+
+func TestEntity_Links(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	targetID, sourceID, draftID, missingID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "target", UserID: user1,
+	}, targetID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "source", UserID: user1,
+		Links: []uuid.UUID{targetID, missingID},
+	}, sourceID, now))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "draft", UserID: user2,
+		Links: []uuid.UUID{targetID},
+	}, draftID))
+
+	// other users do not see the draft
+	items, err := repo.GetBacklinks(t.Context(), targetID, &user1)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{sourceID}, lo.Map(items, func(i entity.ListItem, _ int) uuid.UUID { return i.ID }))
+	items, err = repo.GetBacklinks(t.Context(), targetID, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	broken, err := repo.GetBrokenLinks(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []entity.BrokenLink{{SourceID: sourceID, SourceName: "source", TargetID: missingID}}, broken)
+
+	// update replaces links; deleting the target breaks the remaining ones
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(),
+		ID:   sourceID, Name: "source", UserID: user1, Links: []uuid.UUID{targetID},
+	}, now))
+	require.NoError(t, repo.UpdateDraft(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: draftID, Name: "draft", UserID: user2}))
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{targetID}, user1))
+	broken, err = repo.GetBrokenLinks(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []entity.BrokenLink{{SourceID: sourceID, SourceName: "source", TargetID: targetID}}, broken)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetBacklinks(t.Context(), targetID, nil)
+	require.Error(t, err)
+	_, err = repo.GetBrokenLinks(t.Context())
+	require.Error(t, err)
+}
+
+func TestEntity_Relations(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	pageID, otherID, draftID, deletedID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	for id, name := range map[uuid.UUID]string{pageID: "page", otherID: "other", deletedID: "deleted"} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(),
+			Type: entity.TypeDepartment, Name: name, UserID: user1,
+		}, id, now))
+	}
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "draft", UserID: user2,
+	}, draftID))
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{deletedID}, user1))
+
+	ids := func(items []entity.ListItem) []uuid.UUID {
+		return lo.Map(items, func(i entity.ListItem, _ int) uuid.UUID { return i.ID })
+	}
+
+	// stored in both directions, adding twice is a no-op
+	require.NoError(t, repo.AddRelation(t.Context(), pageID, otherID, now))
+	require.NoError(t, repo.AddRelation(t.Context(), otherID, pageID, now))
+	require.NoError(t, repo.AddRelation(t.Context(), pageID, draftID, now))
+	items, err := repo.GetRelated(t.Context(), otherID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{pageID}, ids(items))
+
+	// other users do not see the draft
+	items, err = repo.GetRelated(t.Context(), pageID, &user1)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{otherID}, ids(items))
+	items, err = repo.GetRelated(t.Context(), pageID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{draftID, otherID}, ids(items))
+
+	// deleted or missing entities cannot be related
+	err = repo.AddRelation(t.Context(), pageID, deletedID, now)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	err = repo.AddRelation(t.Context(), pageID, uuid.New(), now)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// deleting from either side removes both directions
+	require.NoError(t, repo.DeleteRelation(t.Context(), otherID, pageID))
+	items, err = repo.GetRelated(t.Context(), pageID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{draftID}, ids(items))
+	err = repo.DeleteRelation(t.Context(), pageID, otherID)
+	require.ErrorIs(t, err, entity.ErrRelationNotFound())
+
+	// a deleted entity drops out of the related list
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{draftID}, user2))
+	items, err = repo.GetRelated(t.Context(), pageID, nil)
+	require.NoError(t, err)
+	require.Empty(t, items)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetRelated(t.Context(), pageID, nil)
+	require.Error(t, err)
+	require.Error(t, repo.AddRelation(t.Context(), pageID, otherID, now))
+	require.Error(t, repo.DeleteRelation(t.Context(), pageID, otherID))
+}
+
+func TestEntity_Variants(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	enID, deID, otherID := uuid.New(), uuid.New(), uuid.New()
+	for id, name := range map[uuid.UUID]string{enID: "Install", deID: "Installation", otherID: "Other"} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(),
+			Type: entity.TypeDepartment, Name: name, UserID: user1,
+		}, id, now))
+	}
+	languages := func(variants []entity.Variant) []string {
+		return lo.Map(variants, func(v entity.Variant, _ int) string { return v.Language })
+	}
+
+	// an entity without a language has no variants and cannot get any
+	variants, err := repo.GetVariants(t.Context(), enID, nil)
+	require.NoError(t, err)
+	require.Empty(t, variants)
+	require.ErrorIs(t, repo.LinkVariant(t.Context(), enID, deID, "de", now), entity.ErrNoLanguage())
+	require.ErrorIs(t, repo.DeleteVariant(t.Context(), enID), entity.ErrNoLanguage())
+
+	groupID := uuid.New()
+	require.NoError(t, repo.SetLanguage(t.Context(), enID, groupID, "en", now))
+	require.NoError(t, repo.LinkVariant(t.Context(), enID, deID, "de", now))
+	variants, err = repo.GetVariants(t.Context(), deID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en"}, languages(variants))
+	require.Equal(t, groupID, variants[0].GroupID)
+	require.Equal(t, "Installation", variants[0].Name)
+
+	// a language is taken once per group, and changing it keeps the group
+	require.ErrorIs(t, repo.SetLanguage(t.Context(), deID, uuid.New(), "en", now), entity.ErrLanguageTaken())
+	require.NoError(t, repo.SetLanguage(t.Context(), deID, uuid.New(), "de-AT", now))
+	variants, err = repo.GetVariants(t.Context(), enID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"de-AT", "en"}, languages(variants))
+
+	// a variant of another document has to leave it first
+	require.NoError(t, repo.SetLanguage(t.Context(), otherID, uuid.New(), "en", now))
+	require.ErrorIs(t, repo.LinkVariant(t.Context(), otherID, deID, "de", now), entity.ErrVariantOfOther())
+	require.NoError(t, repo.DeleteVariant(t.Context(), deID))
+	require.NoError(t, repo.LinkVariant(t.Context(), otherID, deID, "de", now))
+	require.ErrorIs(t, repo.LinkVariant(t.Context(), enID, deID, "de", now), entity.ErrVariantOfOther())
+	require.NoError(t, repo.DeleteVariant(t.Context(), otherID))
+	require.NoError(t, repo.LinkVariant(t.Context(), enID, deID, "de", now))
+
+	// a new entity joins the group in the same write, drafts only show to their creator
+	ruID := uuid.New()
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "Установка", UserID: user2,
+		VariantOf: &enID, Language: "ru",
+	}, ruID))
+	variants, err = repo.GetVariants(t.Context(), enID, &user1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en"}, languages(variants))
+	variants, err = repo.GetVariants(t.Context(), enID, &user2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en", "ru"}, languages(variants))
+	err = repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "Install again", UserID: user1,
+		VariantOf: &enID, Language: "en",
+	}, uuid.New(), now)
+	require.ErrorIs(t, err, entity.ErrLanguageTaken())
+
+	// a deleted variant drops out and gives up its language
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{ruID}, user2))
+	all, err := repo.GetAllVariants(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en"}, languages(all))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(),
+		Type: entity.TypeDepartment, Name: "Установка", UserID: user1,
+		VariantOf: &enID, Language: "ru",
+	}, uuid.New(), now))
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetVariants(t.Context(), enID, nil)
+	require.Error(t, err)
+	_, err = repo.GetAllVariants(t.Context())
+	require.Error(t, err)
+	require.Error(t, repo.SetLanguage(t.Context(), enID, groupID, "en", now))
+	require.Error(t, repo.LinkVariant(t.Context(), enID, deID, "de", now))
+	require.Error(t, repo.DeleteVariant(t.Context(), enID))
+}
+
+func TestEntity_Snapshots(t *testing.T) {
 	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rootID, pageID, draftID, belowDraftID, deletedID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	create := func(id uuid.UUID, parentID *uuid.UUID, name string) {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: name, Content: name + " v1",
+			ParentID: parentID, UserID: userID,
+		}, id, now))
+	}
+	create(rootID, nil, "root")
+	create(pageID, &rootID, "page")
+	create(deletedID, &rootID, "deleted")
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "draft", ParentID: &rootID, UserID: userID,
+	}, draftID))
+	create(belowDraftID, &draftID, "below draft")
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{deletedID}, userID))
+
+	snapshot := entity.Snapshot{ID: uuid.New(), EntityID: rootID, Label: "v2.3", CreatedBy: userID, CreatedAt: now}
+	count, err := repo.CreateSnapshot(t.Context(), snapshot)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// the label is unique per entity
+	_, err = repo.CreateSnapshot(t.Context(), entity.Snapshot{ID: uuid.New(), EntityID: rootID, Label: "v2.3", CreatedBy: userID, CreatedAt: now})
+	require.ErrorIs(t, err, entity.ErrSnapshotLabelTaken())
+	_, err = repo.CreateSnapshot(t.Context(), entity.Snapshot{ID: uuid.New(), EntityID: draftID, Label: "v2.3", CreatedBy: userID, CreatedAt: now})
+	require.ErrorIs(t, err, entity.ErrSnapshotOfDraft())
+	_, err = repo.CreateSnapshot(t.Context(), entity.Snapshot{ID: uuid.New(), EntityID: deletedID, Label: "v2.3", CreatedBy: userID, CreatedAt: now})
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// later edits and deletes do not change what the snapshot holds
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(), ID: pageID, Name: "page renamed", Content: "page v2", ParentID: &rootID, UserID: userID,
+	}, now.Add(time.Minute)))
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{pageID}, userID))
 
-	_, err := NewRepository(nil)
+	got, err := repo.GetSnapshot(t.Context(), rootID, "v2.3")
+	require.NoError(t, err)
+	require.Equal(t, snapshot.ID, got.ID)
+	require.Equal(t, 2, got.EntityCount)
+	require.Equal(t, []entity.SnapshotItem{
+		{ID: rootID, Type: entity.TypeDepartment, Name: "root", Content: "root v1", Version: 1},
+		{ID: pageID, ParentID: &rootID, Type: entity.TypeDepartment, Name: "page", Content: "page v1", Version: 1, IsDeleted: true},
+	}, got.Items)
+
+	_, err = repo.GetSnapshot(t.Context(), rootID, "v2.4")
+	require.ErrorIs(t, err, entity.ErrSnapshotNotFound())
+
+	snapshots, err := repo.GetSnapshots(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, "v2.3", snapshots[0].Label)
+	require.Equal(t, 2, snapshots[0].EntityCount)
+
+	// retention keeps the versions of a snapshot
+	pruned, err := repo.PruneVersions(t.Context(), 1, 0, now, false)
+	require.NoError(t, err)
+	require.Empty(t, pruned)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.CreateSnapshot(t.Context(), entity.Snapshot{ID: uuid.New(), EntityID: rootID, Label: "v2.4", CreatedBy: userID, CreatedAt: now})
+	require.Error(t, err)
+	_, err = repo.GetSnapshots(t.Context(), rootID)
+	require.Error(t, err)
+	_, err = repo.GetSnapshot(t.Context(), rootID, "v2.3")
+	require.Error(t, err)
+}
+
+func TestEntity_ChildrenOrder(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	parentID, otherParentID := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{parentID, otherParentID} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "parent", UserID: user1,
+		}, id, now))
+	}
+	a, b, c, draftID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	for id, name := range map[uuid.UUID]string{a: "a", b: "b", c: "c"} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeArticle, Name: name, ParentID: &parentID, UserID: user1,
+		}, id, now))
+	}
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "d", ParentID: &parentID, UserID: user2,
+	}, draftID))
+
+	ids := func(items []entity.ListItem) []uuid.UUID {
+		return lo.Map(items, func(i entity.ListItem, _ int) uuid.UUID { return i.ID })
+	}
+
+	// unordered children come by name
+	items, err := repo.GetChildren(t.Context(), parentID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{a, b, c, draftID}, ids(items))
+
+	// the listed children come first, the others keep coming by name
+	before, err := repo.Get(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{c, a}))
+	items, err = repo.GetChildren(t.Context(), parentID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{c, a, b, draftID}, ids(items))
+	require.Equal(t, []int{1, 2, 0, 0}, lo.Map(items, func(i entity.ListItem, _ int) int { return i.SortOrder }))
+	after, err := repo.Get(t.Context(), c)
+	require.NoError(t, err)
+	require.Equal(t, before.UpdatedAt, after.UpdatedAt)
+
+	// other users do not see the draft
+	items, err = repo.GetChildren(t.Context(), parentID, &user1)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{c, a, b}, ids(items))
+
+	// the hierarchy carries the order for the tree
+	items, err = repo.GetHierarchy(t.Context(), []uuid.UUID{parentID}, 2, nil, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	order := lo.SliceToMap(items, func(i entity.ListItem) (uuid.UUID, int) { return i.ID, i.SortOrder })
+	require.Equal(t, 1, order[c])
+	require.Equal(t, 2, order[a])
+
+	// reordering again resets the children left out
+	require.NoError(t, repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{b}))
+	items, err = repo.GetChildren(t.Context(), parentID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{b, a, c, draftID}, ids(items))
+
+	// a moved child loses its place
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(), ID: b, Name: "b", ParentID: &otherParentID, UserID: user1,
+	}, now.Add(time.Minute)))
+	items, err = repo.GetChildren(t.Context(), otherParentID, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Zero(t, items[0].SortOrder)
+
+	// every ID must be a live child of the parent
+	err = repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{a, b})
+	require.ErrorIs(t, err, entity.ErrNotChildren())
+	err = repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{a, uuid.New()})
+	require.ErrorIs(t, err, entity.ErrNotChildren())
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{c}, user1))
+	err = repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{c})
+	require.ErrorIs(t, err, entity.ErrNotChildren())
+	err = repo.ReorderChildren(t.Context(), uuid.New(), []uuid.UUID{a})
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetChildren(t.Context(), parentID, nil)
+	require.Error(t, err)
+	require.Error(t, repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{a}))
+}
+
+func TestEntity_PinnedChildren(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	parentID, otherParentID := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{parentID, otherParentID} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "parent", UserID: userID,
+		}, id, now))
+	}
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	for id, name := range map[uuid.UUID]string{a: "a", b: "b", c: "c"} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeArticle, Name: name, ParentID: &parentID, UserID: userID,
+		}, id, now))
+	}
+
+	ids := func(items []entity.ListItem) []uuid.UUID {
+		return lo.Map(items, func(i entity.ListItem, _ int) uuid.UUID { return i.ID })
+	}
+
+	// pinned children come before the ordered ones
+	require.NoError(t, repo.ReorderChildren(t.Context(), parentID, []uuid.UUID{b, a}))
+	before, err := repo.Get(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, repo.SetPinned(t.Context(), parentID, c, true, 2))
+	items, err := repo.GetChildren(t.Context(), parentID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{c, b, a}, ids(items))
+	require.True(t, items[0].Pinned)
+	after, err := repo.Get(t.Context(), c)
+	require.NoError(t, err)
+	require.Equal(t, before.UpdatedAt, after.UpdatedAt)
+
+	// the hierarchy and the export carry the pin for the tree and the outline
+	items, err = repo.GetHierarchy(t.Context(), []uuid.UUID{parentID}, 2, nil, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	pinned := lo.SliceToMap(items, func(i entity.ListItem) (uuid.UUID, bool) { return i.ID, i.Pinned })
+	require.True(t, pinned[c])
+	require.False(t, pinned[a])
+	page, err := repo.GetExportPage(t.Context(), &parentID, entity.ExportCursor{}, 10, nil)
+	require.NoError(t, err)
+	exported := lo.SliceToMap(page, func(i entity.ExportItem) (uuid.UUID, bool) { return i.ID, i.Pinned })
+	require.True(t, exported[c])
+
+	// pinning again is not counted twice, a third pin passes the limit
+	require.NoError(t, repo.SetPinned(t.Context(), parentID, c, true, 2))
+	require.NoError(t, repo.SetPinned(t.Context(), parentID, a, true, 2))
+	err = repo.SetPinned(t.Context(), parentID, b, true, 2)
+	require.ErrorIs(t, err, entity.ErrPinLimitReached(2))
+	require.NoError(t, repo.SetPinned(t.Context(), parentID, b, true, 0))
+
+	// unpinning returns the child to its place
+	require.NoError(t, repo.SetPinned(t.Context(), parentID, b, false, 2))
+	items, err = repo.GetChildren(t.Context(), parentID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{a, c, b}, ids(items))
+
+	// a moved child is unpinned
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(), ID: a, Name: "a", ParentID: &otherParentID, UserID: userID,
+	}, now.Add(time.Minute)))
+	items, err = repo.GetChildren(t.Context(), otherParentID, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.False(t, items[0].Pinned)
+
+	// the child must be a live child of the parent
+	err = repo.SetPinned(t.Context(), parentID, a, true, 2)
+	require.ErrorIs(t, err, entity.ErrNotChild())
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{b}, userID))
+	err = repo.SetPinned(t.Context(), parentID, b, true, 2)
+	require.ErrorIs(t, err, entity.ErrNotChild())
+	err = repo.SetPinned(t.Context(), uuid.New(), c, true, 2)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// pool closed error
+	cleanup()
+	require.Error(t, repo.SetPinned(t.Context(), parentID, c, false, 2))
+}
+
+func TestEntity_SpaceSettings(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	rootID, childID, grandchildID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "root", UserID: userID,
+	}, rootID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "child", ParentID: &rootID, UserID: userID,
+	}, childID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "grandchild", ParentID: &childID, UserID: userID,
+	}, grandchildID, now))
+
+	// a space without settings reads as the defaults
+	space, err := repo.GetSpace(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Equal(t, entity.Space{EntityID: rootID, OwnerID: userID}, space)
+	settings, err := repo.GetSpaceSettings(t.Context(), grandchildID)
+	require.NoError(t, err)
+	require.Equal(t, entity.SpaceSettings{}, settings)
+
+	// the settings of the root apply to the whole space
+	want := entity.SpaceSettings{DefaultLanguage: "de", Review: entity.ReviewSettings{NoMinorEdits: true}, Public: true,
+		Retention: &entity.SpaceRetention{KeepDays: 90}}
+	require.NoError(t, repo.SetSpaceSettings(t.Context(), rootID, want))
+	space, err = repo.GetSpace(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Equal(t, want, space.Settings)
+	for _, id := range []uuid.UUID{rootID, grandchildID} {
+		settings, err = repo.GetSpaceSettings(t.Context(), id)
+		require.NoError(t, err)
+		require.Equal(t, want, settings)
+	}
+
+	// only roots have settings
+	_, err = repo.GetSpace(t.Context(), childID)
+	require.ErrorIs(t, err, entity.ErrNotSpace())
+	err = repo.SetSpaceSettings(t.Context(), childID, want)
+	require.ErrorIs(t, err, entity.ErrNotSpace())
+	_, err = repo.GetSpace(t.Context(), uuid.New())
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	err = repo.SetSpaceSettings(t.Context(), uuid.New(), want)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	settings, err = repo.GetSpaceSettings(t.Context(), uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, entity.SpaceSettings{}, settings)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetSpace(t.Context(), rootID)
+	require.Error(t, err)
+	require.Error(t, repo.SetSpaceSettings(t.Context(), rootID, want))
+	_, err = repo.GetSpaceSettings(t.Context(), rootID)
+	require.Error(t, err)
+}
+
+func TestEntity_SpaceMoveGrants(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	reader := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	rootID, childID, otherID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "root", UserID: userID,
+	}, rootID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "child", ParentID: &rootID, UserID: userID,
+	}, childID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "other", UserID: userID,
+	}, otherID, now))
+
+	// every entity belongs to the space of its root
+	for id, want := range map[uuid.UUID]uuid.UUID{rootID: rootID, childID: rootID, otherID: otherID} {
+		spaceID, err := repo.GetSpaceID(t.Context(), id)
+		require.NoError(t, err)
+		require.Equal(t, want, spaceID)
+	}
+	_, err := repo.GetSpaceID(t.Context(), uuid.New())
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// the grants of the subtree, top-down
+	for _, g := range []entity.Grant{
+		{UserID: reader, Role: auth.RoleRead, EntityID: childID},
+		{UserID: userID, Role: auth.RoleAdmin, EntityID: rootID},
+		{UserID: reader, Role: auth.RoleRead, EntityID: otherID},
+	} {
+		require.NoError(t, gdb.Exec(`INSERT INTO user_roles (user_id, role, entity_id) VALUES (?, ?, ?)`, g.UserID, g.Role, g.EntityID).Error)
+	}
+	grants, err := repo.GetSubtreeGrants(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Grant{
+		{UserID: userID, Role: auth.RoleAdmin, EntityID: rootID},
+		{UserID: reader, Role: auth.RoleRead, EntityID: childID},
+	}, grants)
+	grants, err = repo.GetSubtreeGrants(t.Context(), uuid.New())
+	require.NoError(t, err)
+	require.Empty(t, grants)
+
+	// the roles held on an entity through it and its ancestors, and through default permissions
+	writer := createUserForEntity(t, gdb)
+	require.NoError(t, repo.SetDefaultPermissions(t.Context(), rootID, []entity.DefaultPermission{
+		{UserID: reader, Role: auth.RoleWrite},
+		{UserID: writer, Role: auth.RoleWrite},
+	}, now))
+	access, err := repo.GetAccess(t.Context(), childID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.DefaultPermission{
+		{UserID: userID, Role: auth.RoleAdmin},
+		{UserID: reader, Role: auth.RoleWrite},
+		{UserID: writer, Role: auth.RoleWrite},
+	}, access)
+	access, err = repo.GetAccess(t.Context(), otherID)
+	require.NoError(t, err)
+	require.Equal(t, []entity.DefaultPermission{{UserID: reader, Role: auth.RoleRead}}, access)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetSpaceID(t.Context(), rootID)
+	require.Error(t, err)
+	_, err = repo.GetSubtreeGrants(t.Context(), rootID)
+	require.Error(t, err)
+	_, err = repo.GetAccess(t.Context(), rootID)
+	require.Error(t, err)
+}
+
+func TestEntity_GetDraftIDs(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	otherID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rootID, draftID, deletedID, othersID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "root", UserID: userID,
+	}, rootID, now))
+	draft := func(id, by uuid.UUID) {
+		require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeArticle, Name: id.String(), ParentID: &rootID, UserID: by,
+		}, id))
+	}
+	draft(draftID, userID)
+	draft(deletedID, userID)
+	draft(othersID, otherID)
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{deletedID}, userID))
+
+	ids, err := repo.GetDraftIDs(t.Context(), userID)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{draftID}, ids)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetDraftIDs(t.Context(), userID)
+	require.Error(t, err)
+}
+
+func TestEntity_Variables(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rootID, pageID, otherID := uuid.New(), uuid.New(), uuid.New()
+	create := func(id uuid.UUID, parentID *uuid.UUID, name string) {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: name, ParentID: parentID, UserID: userID,
+		}, id, now))
+	}
+	create(rootID, nil, "root")
+	create(pageID, &rootID, "page")
+	create(otherID, nil, "other")
+
+	set := func(id uuid.UUID, key, value string) entity.Variable {
+		v := entity.Variable{EntityID: id, Key: key, Value: value, UpdatedBy: userID, UpdatedAt: now}
+		require.NoError(t, repo.SetVariable(t.Context(), v, 2))
+		return v
+	}
+	// timestamps come back in the local zone of the connection
+	utc := func(vars []entity.Variable) []entity.Variable {
+		for i := range vars {
+			vars[i].UpdatedAt = vars[i].UpdatedAt.UTC()
+		}
+		return vars
+	}
+	product := set(rootID, "product", "EasyGoDocs")
+	version := set(rootID, "version", "2.2")
+	// setting an existing key again changes it
+	version = set(rootID, "version", "2.3")
+	pageVersion := set(pageID, "version", "2.4-beta")
+
+	// a third key is over the limit, changing one of the two is not
+	err := repo.SetVariable(t.Context(), entity.Variable{EntityID: rootID, Key: "date", UpdatedBy: userID, UpdatedAt: now}, 2)
+	require.ErrorIs(t, err, entity.ErrTooManyVariables(2))
+	err = repo.SetVariable(t.Context(), entity.Variable{EntityID: uuid.New(), Key: "date", UpdatedBy: userID, UpdatedAt: now}, 2)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	got, err := repo.GetVariables(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Variable{product, version}, utc(got))
+	// the page inherits product and overrides version
+	got, err = repo.GetVariables(t.Context(), pageID)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Variable{product, pageVersion}, utc(got))
+
+	scopes, err := repo.GetScopeVariables(t.Context(), []uuid.UUID{rootID, pageID, otherID})
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]entity.Variables{
+		rootID: {"product": "EasyGoDocs", "version": "2.3"},
+		pageID: {"product": "EasyGoDocs", "version": "2.4-beta"},
+	}, scopes)
+
+	// deleting the override brings back the inherited value
+	require.NoError(t, repo.DeleteVariable(t.Context(), pageID, "version"))
+	require.ErrorIs(t, repo.DeleteVariable(t.Context(), pageID, "version"), entity.ErrVariableNotFound())
+	require.ErrorIs(t, repo.DeleteVariable(t.Context(), pageID, "product"), entity.ErrVariableNotFound())
+	got, err = repo.GetVariables(t.Context(), pageID)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Variable{product, version}, utc(got))
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetVariables(t.Context(), rootID)
+	require.Error(t, err)
+	require.Error(t, repo.SetVariable(t.Context(), product, 2))
+	require.Error(t, repo.DeleteVariable(t.Context(), rootID, "product"))
+	_, err = repo.GetScopeVariables(t.Context(), []uuid.UUID{rootID})
+	require.Error(t, err)
+}
+
+func TestEntity_DefaultPermissions(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	owner := createUserForEntity(t, gdb)
+	reader := createUserForEntity(t, gdb)
+	writer := createUserForEntity(t, gdb)
+	granted := createUserForEntity(t, gdb)
+	now := time.Now().UTC()
+
+	rootID, midID, leafID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "root", UserID: owner,
+	}, rootID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "mid", ParentID: &rootID, UserID: owner,
+	}, midID, now))
+
+	// replacing the policy drops the users left out
+	rootPerms := []entity.DefaultPermission{
+		{UserID: reader, Role: auth.RoleRead},
+		{UserID: writer, Role: auth.RoleRead},
+		{UserID: granted, Role: auth.RoleWrite},
+	}
+	require.NoError(t, repo.SetDefaultPermissions(t.Context(), rootID, []entity.DefaultPermission{{UserID: owner, Role: auth.RoleWrite}}, now))
+	require.NoError(t, repo.SetDefaultPermissions(t.Context(), rootID, rootPerms, now))
+	require.NoError(t, repo.SetDefaultPermissions(t.Context(), midID, []entity.DefaultPermission{{UserID: writer, Role: auth.RoleWrite}}, now))
+	got, err := repo.GetDefaultPermissions(t.Context(), rootID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, rootPerms, got)
+
+	// a write grant on an ancestor covers the default write role
+	err = gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO user_roles(user_id,role,entity_id) VALUES ($1,'write',$2)`, granted, rootID,
+	).Error
+	require.NoError(t, err)
+
+	// the policies of all ancestors apply, the strongest role per user
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "leaf", ParentID: &midID, UserID: owner,
+	}, leafID, now))
+	pending, err := repo.GetPendingDefaultPermissions(t.Context(), leafID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.DefaultPermission{
+		{UserID: reader, Role: auth.RoleRead},
+		{UserID: writer, Role: auth.RoleWrite},
+	}, pending)
+
+	// the policy of the entity itself does not apply to it
+	pending, err = repo.GetPendingDefaultPermissions(t.Context(), rootID)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// an empty list removes the policy
+	require.NoError(t, repo.SetDefaultPermissions(t.Context(), midID, nil, now))
+	got, err = repo.GetDefaultPermissions(t.Context(), midID)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// unknown entity or user
+	err = repo.SetDefaultPermissions(t.Context(), uuid.New(), rootPerms, now)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	err = repo.SetDefaultPermissions(t.Context(), midID, []entity.DefaultPermission{{UserID: uuid.New(), Role: auth.RoleRead}}, now)
+	require.ErrorIs(t, err, entity.ErrDefaultPermissionUserNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetDefaultPermissions(t.Context(), rootID)
+	require.Error(t, err)
+	require.Error(t, repo.SetDefaultPermissions(t.Context(), rootID, nil, now))
+	_, err = repo.GetPendingDefaultPermissions(t.Context(), leafID)
+	require.Error(t, err)
+}
+
+func TestEntity_PruneVersions(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+	old := now.AddDate(0, 0, -60)
+
+	// entity with versions 1..4 created 60 days ago and 5 now; 5 is current
+	id := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "doc", UserID: userID}, id, old))
+	for i := 2; i <= 4; i++ {
+		require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: id, Name: "doc", UserID: userID}, old.Add(time.Duration(i)*time.Minute)))
+	}
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: id, Name: "doc", UserID: userID}, now))
+	// draft with one old version: the only version is not current but is kept by keep_last
+	draftID := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "draft", UserID: userID}, draftID, old))
+	require.NoError(t, repo.UpdateDraft(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: draftID, Name: "draft", UserID: userID}))
+
+	versionsOf := func(refs []entity.VersionRef) []int {
+		return lo.Map(refs, func(v entity.VersionRef, _ int) int { return v.Version })
+	}
+
+	// keep last 2 or newer than 30 days: versions 1..3 of doc go
+	got, err := repo.PruneVersions(t.Context(), 2, 30, now, true)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, versionsOf(got))
+
+	// age only: everything old except current versions, including the draft's only version
+	got, err = repo.PruneVersions(t.Context(), 0, 30, now, true)
+	require.NoError(t, err)
+	require.Len(t, got, 5)
+
+	// dry run deleted nothing
+	vs, err := repo.GetVersionsList(t.Context(), id, 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, vs, 5)
+
+	got, err = repo.PruneVersions(t.Context(), 2, 30, now, false)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, versionsOf(got))
+	vs, err = repo.GetVersionsList(t.Context(), id, 0, 10, false)
+	require.NoError(t, err)
+	require.Equal(t, []int{5, 4}, lo.Map(vs, func(e entity.Entity, _ int) int { return *e.CurrentVersion }))
+	meta, err := repo.GetMeta(t.Context(), id, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, meta.VersionCount)
+
+	// keep last 1 never removes the current version
+	got, err = repo.PruneVersions(t.Context(), 1, 0, now, false)
+	require.NoError(t, err)
+	require.Equal(t, []int{4}, versionsOf(got))
+	ent, err := repo.Get(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, 5, *ent.CurrentVersion)
+
+	// a space with a retention of its own prunes even when the policy keeps everything
+	spaceID := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "space", UserID: userID}, spaceID, old))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{Slug: uuid.NewString(), ID: spaceID, Name: "space", UserID: userID}, now))
+	require.NoError(t, repo.SetSpaceSettings(t.Context(), spaceID, entity.SpaceSettings{Retention: &entity.SpaceRetention{KeepLastVersions: 1}}))
+	got, err = repo.PruneVersions(t.Context(), 0, 0, now, true)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, spaceID, got[0].EntityID)
+	require.Equal(t, 1, got[0].Version)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.PruneVersions(t.Context(), 1, 0, now, true)
+	require.Error(t, err)
+}
+
+func TestEntity_Locks(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	id := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "doc", UserID: user1}, id, time.Now()))
+
+	_, err := repo.GetLock(t.Context(), id)
+	require.ErrorIs(t, err, entity.ErrLockNotFound())
+
+	// missing entity
+	_, _, err = repo.AcquireLock(t.Context(), uuid.New(), user1, time.Minute)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	lock, acquired, err := repo.AcquireLock(t.Context(), id, user1, time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	require.Equal(t, user1, lock.UserID)
+	require.True(t, lock.ExpiresAt.After(lock.AcquiredAt))
+
+	// the holder extends, acquired_at is kept
+	extended, acquired, err := repo.AcquireLock(t.Context(), id, user1, time.Hour)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	require.True(t, lock.AcquiredAt.Equal(extended.AcquiredAt))
+	require.True(t, extended.ExpiresAt.After(lock.ExpiresAt))
+
+	// another user gets the current holder
+	held, acquired, err := repo.AcquireLock(t.Context(), id, user2, time.Minute)
+	require.NoError(t, err)
+	require.False(t, acquired)
+	require.Equal(t, user1, held.UserID)
+
+	// an expired lock is ignored and can be taken over
+	require.NoError(t, gdb.Exec(`UPDATE entity_locks SET expires_at = NOW() - INTERVAL '1 minute' WHERE entity_id = ?`, id).Error)
+	_, err = repo.GetLock(t.Context(), id)
+	require.ErrorIs(t, err, entity.ErrLockNotFound())
+	lock, acquired, err = repo.AcquireLock(t.Context(), id, user2, time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	require.Equal(t, user2, lock.UserID)
+
+	got, err := repo.GetLock(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, user2, got.UserID)
+
+	require.NoError(t, repo.ReleaseLock(t.Context(), id))
+	require.ErrorIs(t, repo.ReleaseLock(t.Context(), id), entity.ErrLockNotFound())
+
+	// expired rows are cleaned up
+	_, _, err = repo.AcquireLock(t.Context(), id, user1, time.Minute)
+	require.NoError(t, err)
+	n, err := repo.DeleteExpiredLocks(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.NoError(t, gdb.Exec(`UPDATE entity_locks SET expires_at = NOW() - INTERVAL '1 minute' WHERE entity_id = ?`, id).Error)
+	n, err = repo.DeleteExpiredLocks(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetLock(t.Context(), id)
+	require.Error(t, err)
+}
+
+func TestEntity_Autosaves(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	id := uuid.New()
+	slug := uuid.NewString()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: slug, Type: entity.TypeDepartment, Name: "doc", UserID: user1}, id, time.Now()))
+
+	_, err := repo.GetAutosave(t.Context(), id, user1)
+	require.ErrorIs(t, err, entity.ErrAutosaveNotFound())
+	require.ErrorIs(t, repo.SaveAutosave(t.Context(), uuid.New(), user1, "x", time.Now()), entity.ErrEntityNotFound())
+
+	// a second autosave replaces the first, each user has their own
+	savedAt := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.SaveAutosave(t.Context(), id, user1, "first", savedAt.Add(-time.Minute)))
+	require.NoError(t, repo.SaveAutosave(t.Context(), id, user1, "second", savedAt))
+	require.NoError(t, repo.SaveAutosave(t.Context(), id, user2, "other", savedAt))
+	got, err := repo.GetAutosave(t.Context(), id, user1)
+	require.NoError(t, err)
+	require.Equal(t, "second", got.Content)
+	require.True(t, savedAt.Equal(got.SavedAt))
+
+	// no version is created
+	ent, err := repo.Get(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, 1, *ent.CurrentVersion)
+
+	// saving the entity drops the autosave of the saving user only
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "doc", Content: "second", UserID: user1, Slug: slug}, time.Now()))
+	_, err = repo.GetAutosave(t.Context(), id, user1)
+	require.ErrorIs(t, err, entity.ErrAutosaveNotFound())
+	_, err = repo.GetAutosave(t.Context(), id, user2)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteAutosave(t.Context(), id, user2))
+	require.ErrorIs(t, repo.DeleteAutosave(t.Context(), id, user2), entity.ErrAutosaveNotFound())
+
+	// a deleted entity takes no autosave
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{id}, user1))
+	require.ErrorIs(t, repo.SaveAutosave(t.Context(), id, user1, "x", time.Now()), entity.ErrEntityNotFound())
+}
+
+func TestEntity_GetAuthoredVersions(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	id := uuid.New()
+	slug := uuid.NewString()
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: slug, Type: entity.TypeArticle, Name: "first", UserID: user1}, id, createdAt))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "second", UserID: user2, Slug: slug}, createdAt.Add(time.Minute)))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "third", UserID: user1, Slug: slug}, createdAt.Add(2*time.Minute)))
+	// versions of deleted entities are listed too
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{id}, user1))
+
+	got, err := repo.GetAuthoredVersions(t.Context(), user1)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, entity.AuthoredVersion{EntityID: id, Name: "first", Version: 1, CreatedAt: got[0].CreatedAt}, got[0])
+	require.True(t, createdAt.Equal(got[0].CreatedAt))
+	require.Equal(t, "third", got[1].Name)
+	require.Equal(t, 3, got[1].Version)
+
+	got, err = repo.GetAuthoredVersions(t.Context(), uuid.New())
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+type contentKeys struct {
+	current  []byte
+	previous [][]byte
+}
+
+func (k *contentKeys) Keys() ([]byte, [][]byte, error) {
+	return k.current, k.previous, nil
+}
+
+func TestEntity_Encryption(t *testing.T) {
+	t.Parallel()
+	plain, gdb, _ := newEntityRepo(t)
+	keys := &contentKeys{current: []byte("key1")}
+	cfg := entity.Config{Encryption: entity.EncryptionConfig{Enabled: true, IntervalMinutes: 60, BatchSize: 2}}
+	repo, err := NewRepository(gdb, cfg, secure.NewContentCipher(keys))
+	require.NoError(t, err)
+	_, err = NewRepository(gdb, cfg, nil)
+	require.Error(t, err)
+
+	user := createUserForEntity(t, gdb)
+	plainID, id := uuid.New(), uuid.New()
+	require.NoError(t, plain.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "plain", Content: "plain text", UserID: user}, plainID, time.Now()))
+	slug := uuid.NewString()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: slug, Type: entity.TypeArticle, Name: "doc", Content: "secret v1", UserID: user}, id, time.Now()))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "doc", Content: "secret v2", UserID: user, Slug: slug}, time.Now()))
+	require.NoError(t, repo.SaveAutosave(t.Context(), id, user, "secret draft", time.Now()))
+
+	// stored encrypted, read decrypted
+	var stored []string
+	require.NoError(t, gdb.Raw(`SELECT content FROM entities WHERE id = ? UNION ALL SELECT content FROM entity_versions WHERE entity_id = ?
+UNION ALL SELECT content FROM entity_autosaves WHERE entity_id = ?`, id, id, id).Scan(&stored).Error)
+	require.Len(t, stored, 4)
+	for _, c := range stored {
+		require.NotContains(t, c, "secret")
+	}
+	got, err := repo.Get(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, "secret v2", got.Content)
+	got, err = repo.GetVersion(t.Context(), id, 1)
+	require.NoError(t, err)
+	require.Equal(t, "secret v1", got.Content)
+	autosave, err := repo.GetAutosave(t.Context(), id, user)
+	require.NoError(t, err)
+	require.Equal(t, "secret draft", autosave.Content)
+	got, err = repo.Get(t.Context(), plainID)
+	require.NoError(t, err)
+	require.Equal(t, "plain text", got.Content)
+	// without the key encrypted content cannot be read
+	_, err = plain.Get(t.Context(), id)
+	require.Error(t, err)
+
+	// content is bound to its row: version 1 copied over version 2 does not open
+	var v2 contentRowModel
+	require.NoError(t, gdb.Table("entity_versions").Where("entity_id = ? AND version = 2", id).Take(&v2).Error)
+	require.NoError(t, gdb.Exec(`UPDATE entity_versions SET content = v1.content, content_key_id = v1.content_key_id
+FROM entity_versions v1 WHERE v1.entity_id = entity_versions.entity_id AND v1.version = 1
+  AND entity_versions.entity_id = ? AND entity_versions.version = 2`, id).Error)
+	_, err = repo.GetVersion(t.Context(), id, 2)
+	require.Error(t, err)
+	require.NoError(t, gdb.Exec(`UPDATE entity_versions SET content = ?, content_key_id = ? WHERE entity_id = ? AND version = 2`,
+		v2.Content, v2.ContentKeyID, id).Error)
+
+	// rotated: the plain rows and those of the previous key move to the new key, two at a time
+	keys.current, keys.previous = []byte("key2"), [][]byte{[]byte("key1")}
+	n, skipped, err := repo.ReencryptContent(t.Context(), 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Empty(t, skipped)
+	n, _, err = repo.ReencryptContent(t.Context(), 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	n, _, err = repo.ReencryptContent(t.Context(), 10, nil)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	// a row of an unknown key is reported and left out of the next batches
+	require.NoError(t, gdb.Exec(`UPDATE entity_versions SET content_key_id = 'unknown1' WHERE entity_id = ? AND version = 1`, id).Error)
+	n, skipped, err = repo.ReencryptContent(t.Context(), 10, nil)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Len(t, skipped, 1)
+	require.Equal(t, "entity_versions", skipped[0].Table)
+	require.Equal(t, id, skipped[0].EntityID)
+	require.Equal(t, 1, skipped[0].Version)
+	require.Nil(t, skipped[0].UserID)
+	require.Contains(t, skipped[0].Error, secure.ErrUnknownContentKey.Error())
+	n, skipped, err = repo.ReencryptContent(t.Context(), 10, skipped)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Empty(t, skipped)
+
+	keys.previous = nil
+	got, err = repo.GetVersion(t.Context(), id, 2)
+	require.NoError(t, err)
+	require.Equal(t, "secret v2", got.Content)
+	got, err = repo.Get(t.Context(), plainID)
+	require.NoError(t, err)
+	require.Equal(t, "plain text", got.Content)
+	var plainLeft int64
+	require.NoError(t, gdb.Raw(`SELECT COUNT(*) FROM entities WHERE id = ? AND content = ?`, plainID, "plain text").Scan(&plainLeft).Error)
+	require.Zero(t, plainLeft)
+}
+
+func TestEntity_SiblingNameExists(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	rootID := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "Root", UserID: user1}, rootID, time.Now()))
+	childID := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "  Getting   Started ", ParentID: &rootID, UserID: user1}, childID, time.Now()))
+	draftID := uuid.New()
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "Draft", ParentID: &rootID, UserID: user1}, draftID))
+
+	tests := []struct {
+		name      string
+		parentID  *uuid.UUID
+		value     string
+		excludeID uuid.UUID
+		userID    uuid.UUID
+		want      bool
+	}{
+		{name: "normalized match", parentID: &rootID, value: "getting started", userID: user2, want: true},
+		{name: "root level", value: "root", userID: user2, want: true},
+		{name: "other parent", parentID: &childID, value: "getting started", userID: user2},
+		{name: "excluded self", parentID: &rootID, value: "getting started", excludeID: childID, userID: user2},
+		{name: "own draft", parentID: &rootID, value: "draft", userID: user1, want: true},
+		{name: "foreign draft", parentID: &rootID, value: "draft", userID: user2},
+	}
+	for _, tt := range tests {
+		got, err := repo.SiblingNameExists(t.Context(), tt.parentID, tt.value, tt.excludeID, tt.userID)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, got, tt.name)
+	}
+
+	// deleted entities free the name
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{childID}, user1))
+	got, err := repo.SiblingNameExists(t.Context(), &rootID, "getting started", uuid.Nil, user1)
+	require.NoError(t, err)
+	require.False(t, got)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.SiblingNameExists(t.Context(), &rootID, "root", uuid.Nil, user1)
+	require.Error(t, err)
+}
+
+func TestEntity_Slugs(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user := createUserForEntity(t, gdb)
+	id, otherID := uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "Intro", Slug: "intro", UserID: user}, id, time.Now()))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "Intro", Slug: "intro-2", UserID: user}, otherID))
+
+	got, err := repo.GetIDBySlug(t.Context(), "intro")
+	require.NoError(t, err)
+	require.Equal(t, id, got)
+	_, err = repo.GetIDBySlug(t.Context(), "missing")
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	taken, err := repo.GetTakenSlugs(t.Context(), "intro", uuid.Nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"intro", "intro-2"}, taken)
+	taken, err = repo.GetTakenSlugs(t.Context(), "intro", id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"intro-2"}, taken)
+
+	// a rename moves the entity, the old slug keeps resolving and stays taken
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Guide", Slug: "guide", UserID: user}, time.Now()))
+	dto, err := repo.Get(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, "guide", dto.Slug)
+	got, err = repo.GetIDBySlug(t.Context(), "intro")
+	require.NoError(t, err)
+	require.Equal(t, id, got)
+	taken, err = repo.GetTakenSlugs(t.Context(), "intro", otherID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"intro"}, taken)
+
+	// a slug of another live entity cannot be claimed
+	err = repo.UpdateDraft(t.Context(), entity.UpdateEntityReq{ID: otherID, Name: "Guide", Slug: "guide", UserID: user})
+	require.ErrorIs(t, err, entity.ErrSlugTaken())
+
+	// slugs of deleted entities are released and can be taken over
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{id}, user))
+	_, err = repo.GetIDBySlug(t.Context(), "guide")
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	require.NoError(t, repo.UpdateDraft(t.Context(), entity.UpdateEntityReq{ID: otherID, Name: "Intro", Slug: "intro", UserID: user}))
+	got, err = repo.GetIDBySlug(t.Context(), "intro")
+	require.NoError(t, err)
+	require.Equal(t, otherID, got)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetIDBySlug(t.Context(), "intro")
+	require.Error(t, err)
+	_, err = repo.GetTakenSlugs(t.Context(), "intro", id)
+	require.Error(t, err)
+}
+
+func TestEntity_Moves(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user := createUserForEntity(t, gdb)
+	parentID, id := uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "Guides", Slug: "guides", UserID: user}, parentID, time.Now()))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeArticle, Name: "Install", Slug: "install", UserID: user}, id, time.Now()))
+
+	moved, err := repo.HasMovedFrom(t.Context(), id, nil)
+	require.NoError(t, err)
+	require.False(t, moved)
+
+	// root -> guides, then an edit in place
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Install", Slug: "install", ParentID: &parentID, UserID: user}, time.Now()))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Install", Content: "steps", Slug: "install", ParentID: &parentID, UserID: user}, time.Now()))
+
+	moved, err = repo.HasMovedFrom(t.Context(), id, nil)
+	require.NoError(t, err)
+	require.True(t, moved)
+	moved, err = repo.HasMovedFrom(t.Context(), id, &parentID)
+	require.NoError(t, err)
+	require.False(t, moved)
+
+	versions, err := repo.GetVersionsList(t.Context(), id, 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	require.False(t, versions[0].Moved)
+	require.True(t, versions[1].Moved)
+	require.Nil(t, versions[1].MovedFrom)
+	require.False(t, versions[2].Moved)
+
+	// guides -> root
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Install", Content: "steps", Slug: "install", UserID: user}, time.Now()))
+	version, err := repo.GetVersion(t.Context(), id, 4)
+	require.NoError(t, err)
+	require.True(t, version.Moved)
+	require.Equal(t, &parentID, version.MovedFrom)
+	moved, err = repo.HasMovedFrom(t.Context(), id, &parentID)
+	require.NoError(t, err)
+	require.True(t, moved)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.HasMovedFrom(t.Context(), id, nil)
+	require.Error(t, err)
+}
+
+func TestEntity_History(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user, grantee := createUserForEntity(t, gdb), createUserForEntity(t, gdb)
+	parentID, id := uuid.New(), uuid.New()
+	t0 := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeDepartment, Name: "Guides", Slug: "guides", UserID: user}, parentID, t0))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{Type: entity.TypeArticle, Name: "Install", Slug: "install", UserID: user}, id, t0))
+	// a move, then an edit in place whose edit event is left out
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Install", Slug: "install", ParentID: &parentID, UserID: user}, t0.Add(time.Minute)))
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{ID: id, Name: "Setup", Content: "steps", Slug: "setup", ParentID: &parentID, UserID: user}, t0.Add(2*time.Minute)))
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO entity_events(entity_id,type,actor_id,subject_id,role,created_at) VALUES ($1,$2,$3,$4,'read',$5)`,
+		id, entity.EventRoleGranted, user, grantee, t0.Add(3*time.Minute),
+	).Error
+	require.NoError(t, err)
+
+	items, err := repo.GetHistory(t.Context(), id, nil, 10)
+	require.NoError(t, err)
+	types := lo.Map(items, func(it entity.HistoryItem, _ int) entity.HistoryItemType { return it.Type })
+	require.Equal(t, []entity.HistoryItemType{
+		entity.HistoryPermission, entity.HistoryVersion, entity.HistoryMove, entity.HistoryVersion, entity.HistoryAudit, entity.HistoryVersion,
+	}, types)
+	require.Equal(t, &grantee, items[0].Event.SubjectID)
+	require.Equal(t, "read", items[0].Event.Role)
+	require.Equal(t, "Setup", items[0].Event.EntityName)
+	require.Equal(t, "Setup", items[1].Version.Name)
+	require.Equal(t, 3, *items[1].Version.CurrentVersion)
+	require.Equal(t, &parentID, items[2].Event.ToParentID)
+	require.Equal(t, entity.EventCreated, items[4].Event.Type)
+	require.Equal(t, "Install", items[5].Version.Name)
+
+	// the page after the move starts with the version the move produced
+	page, err := repo.GetHistory(t.Context(), id, &items[2].Cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, items[3:5], page)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetHistory(t.Context(), id, nil, 10)
+	require.Error(t, err)
+}
+
+func TestEntity_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user := createUserForEntity(t, gdb)
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -30)
+	create := func(name, content string, parentID *uuid.UUID) uuid.UUID {
+		id := uuid.New()
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Type: entity.TypeDepartment, Name: name, Content: content, Slug: uuid.NewString(), ParentID: parentID, UserID: user,
+		}, id, now))
+		return id
+	}
+	trash := func(deletedAt time.Time, ids ...uuid.UUID) {
+		require.NoError(t, gdb.Exec("UPDATE entities SET deleted_at = ? WHERE id IN ?", deletedAt, ids).Error)
+	}
+
+	// old parent with old child: both go
+	parent := create("parent", "abc", nil)
+	child := create("child", "de", &parent)
+	trash(cutoff.Add(-time.Hour), parent, child)
+	// old parent with a live child: kept, the delete would cascade
+	keptParent := create("kept parent", "", nil)
+	create("live child", "", &keptParent)
+	trash(cutoff.Add(-time.Hour), keptParent)
+	// recently deleted
+	recent := create("recent", "", nil)
+	trash(now, recent)
+
+	preview, err := repo.PurgeDeleted(t.Context(), cutoff, true)
+	require.NoError(t, err)
+	require.Len(t, preview, 2)
+	require.ElementsMatch(t, []uuid.UUID{parent, child}, []uuid.UUID{preview[0].ID, preview[1].ID})
+	// content of the entity and of its single version
+	wantBytes := map[uuid.UUID]int64{parent: 6, child: 4}
+	for _, e := range preview {
+		require.Equal(t, 1, e.VersionCount)
+		require.Equal(t, wantBytes[e.ID], e.Bytes)
+		require.False(t, e.Draft)
+		require.Equal(t, user, e.UpdatedBy)
+	}
+	_, err = repo.Get(t.Context(), parent)
+	require.NoError(t, err)
+
+	purged, err := repo.PurgeDeleted(t.Context(), cutoff, false)
+	require.NoError(t, err)
+	require.Equal(t, preview, purged)
+	var count int64
+	require.NoError(t, gdb.Table("entities").Where("id IN ?", []uuid.UUID{parent, child}).Count(&count).Error)
+	require.Zero(t, count)
+	require.NoError(t, gdb.Table("entity_versions").Where("entity_id IN ?", []uuid.UUID{parent, child}).Count(&count).Error)
+	require.Zero(t, count)
+	require.NoError(t, gdb.Table("entities").Where("id IN ?", []uuid.UUID{keptParent, recent}).Count(&count).Error)
+	require.Equal(t, int64(2), count)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.PurgeDeleted(t.Context(), cutoff, true)
+	require.Error(t, err)
+}
+
+func TestEntity_StaleDrafts(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	keeperID := createUserForEntity(t, gdb)
+	quietID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+	before := now.AddDate(0, 0, -30)
+	require.NoError(t, gdb.Exec(`INSERT INTO user_preferences (user_id, data, updated_at) VALUES (?, ?, ?), (?, ?, ?)`,
+		keeperID, `{"drafts":{"keep_stale":true}}`, now, quietID, `{"notifications":{"email":false}}`, now).Error)
+
+	rootID := uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "root", UserID: userID,
+	}, rootID, now))
+	draft := func(name string, by uuid.UUID, parentID uuid.UUID, updatedAt time.Time) uuid.UUID {
+		id := uuid.New()
+		require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeArticle, Name: name, ParentID: &parentID, UserID: by,
+		}, id))
+		require.NoError(t, gdb.Exec("UPDATE entities SET updated_at = ? WHERE id = ?", updatedAt, id).Error)
+		return id
+	}
+	old := before.Add(-time.Hour)
+	staleID := draft("stale", userID, rootID, old)
+	parentID := draft("parent", userID, rootID, old)
+	childID := draft("child", userID, parentID, old)
+	autosavedID := draft("autosaved", userID, rootID, old)
+	require.NoError(t, gdb.Exec(`INSERT INTO entity_autosaves (entity_id, user_id, content, saved_at) VALUES (?, ?, '', ?)`,
+		autosavedID, userID, now).Error)
+	draft("recent", userID, rootID, now)
+	draft("kept", keeperID, rootID, old)
+	quietDraftID := draft("quiet", quietID, rootID, old)
+
+	reminders, err := repo.GetDraftsToRemind(t.Context(), before)
+	require.NoError(t, err)
+	byUser := lo.SliceToMap(reminders, func(r entity.DraftReminder) (uuid.UUID, entity.DraftReminder) { return r.UserID, r })
+	require.Len(t, byUser, 2)
+	require.NotEmpty(t, byUser[userID].Email)
+	require.ElementsMatch(t, []uuid.UUID{staleID, parentID, childID},
+		lo.Map(byUser[userID].Drafts, func(d entity.StaleDraft, _ int) uuid.UUID { return d.ID }))
+	// the reminder of a user without email notifications has no address
+	require.Empty(t, byUser[quietID].Email)
+	require.Equal(t, quietDraftID, byUser[quietID].Drafts[0].ID)
+
+	// only reminded drafts are cleaned up when reminders are on
+	remindedBefore := now.Add(-time.Minute)
+	cleaned, err := repo.CleanupStaleDrafts(t.Context(), before, &remindedBefore, false, true)
+	require.NoError(t, err)
+	require.Empty(t, cleaned)
+
+	require.NoError(t, repo.MarkDraftsReminded(t.Context(), []uuid.UUID{staleID, parentID, childID}, now.Add(-time.Hour)))
+	reminders, err = repo.GetDraftsToRemind(t.Context(), before)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	require.Equal(t, quietID, reminders[0].UserID)
+
+	// the parent of a draft is kept, as the delete would cascade to it
+	preview, err := repo.CleanupStaleDrafts(t.Context(), before, &remindedBefore, false, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{staleID, childID}, lo.Map(preview, func(d entity.StaleDraft, _ int) uuid.UUID { return d.ID }))
+	_, err = repo.Get(t.Context(), staleID)
+	require.NoError(t, err)
+
+	archived, err := repo.CleanupStaleDrafts(t.Context(), before, &remindedBefore, false, false)
+	require.NoError(t, err)
+	require.Equal(t, preview, archived)
+	_, err = repo.Get(t.Context(), staleID)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	var count int64
+	require.NoError(t, gdb.Table("entity_events").Where("entity_id = ? AND type = ? AND actor_id ISNULL", staleID, entity.EventDeleted).Count(&count).Error)
+	require.Equal(t, int64(1), count)
+
+	// without reminders the quiet draft goes too; the parent stays while its child is in the trash
+	deleted, err := repo.CleanupStaleDrafts(t.Context(), before, nil, true, false)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, quietDraftID, deleted[0].ID)
+	require.NoError(t, gdb.Table("entities").Where("id = ?", quietDraftID).Count(&count).Error)
+	require.Zero(t, count)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetDraftsToRemind(t.Context(), before)
+	require.Error(t, err)
+	require.Error(t, repo.MarkDraftsReminded(t.Context(), []uuid.UUID{staleID}, now))
+	_, err = repo.CleanupStaleDrafts(t.Context(), before, nil, true, true)
+	require.Error(t, err)
+}
+
+func TestEntity_Views(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	user1 := createUserForEntity(t, gdb)
+	user2 := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Second)
+	since := now.Add(-24 * time.Hour)
+
+	a, b, draft, deleted := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	for id, name := range map[uuid.UUID]string{a: "a", b: "b", deleted: "deleted"} {
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Slug: uuid.NewString(), Type: entity.TypeArticle, Name: name, UserID: user1,
+		}, id, now))
+	}
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeArticle, Name: "draft", UserID: user1,
+	}, draft))
+
+	s1, s2, s3 := uuid.New(), uuid.New(), uuid.New()
+	view := func(id, userID, sessionID uuid.UUID, at time.Time) {
+		require.NoError(t, repo.RecordView(t.Context(), entity.View{EntityID: id, UserID: userID, SessionID: sessionID, ViewedAt: at}))
+	}
+	view(a, user1, s1, now)
+	view(a, user1, s1, now.Add(time.Minute)) // same session: counted once
+	view(a, user2, s2, now)
+	view(a, user2, s3, since.Add(-time.Hour)) // before the period
+	view(b, user1, s1, now)
+	view(b, user2, s2, now)
+	view(draft, user1, s1, now)
+	view(deleted, user1, s1, now)
+	view(deleted, user2, s2, now)
+	view(deleted, user2, s3, now)
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{deleted}, user1))
+
+	meta, err := repo.GetMeta(t.Context(), a, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), meta.Views)
+
+	// ties are ordered by name; the draft is visible to its author only
+	got, err := repo.GetPopular(t.Context(), since, 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	require.Equal(t, a, got[0].ID)
+	require.Equal(t, int64(2), got[0].Views)
+	require.Equal(t, "a", got[0].Name)
+	require.Equal(t, b, got[1].ID)
+	require.Equal(t, draft, got[2].ID)
+	require.Equal(t, int64(1), got[2].Views)
+
+	got, err = repo.GetPopular(t.Context(), since, 10, nil, &user2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	got, err = repo.GetPopular(t.Context(), since, 1, []uuid.UUID{b, draft}, &user1)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, b, got[0].ID)
+
+	got, err = repo.GetPopular(t.Context(), since, 10, []uuid.UUID{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// pool closed error
+	cleanup()
+	require.Error(t, repo.RecordView(t.Context(), entity.View{EntityID: a, UserID: user1, SessionID: uuid.New(), ViewedAt: now}))
+	_, err = repo.GetPopular(t.Context(), since, 10, nil, nil)
+	require.Error(t, err)
+}
+
+func TestEntity_Owner(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	creator := createUserForEntity(t, gdb)
+	owner := createUserForEntity(t, gdb)
+	gone := createUserForEntity(t, gdb)
+	require.NoError(t, gdb.Exec("UPDATE users SET deleted_at = NOW() WHERE id = ?", gone).Error)
+
+	create := func(name string) uuid.UUID {
+		id := uuid.New()
+		require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+			Type: entity.TypeDepartment, Name: name, Slug: uuid.NewString(), UserID: creator,
+		}, id, time.Now()))
+		return id
+	}
+	a, b, deleted := create("a"), create("b"), create("deleted")
+
+	require.NoError(t, repo.SetOwner(t.Context(), a, owner))
+	item, err := repo.GetListItem(t.Context(), a)
+	require.NoError(t, err)
+	require.Equal(t, owner, item.OwnerID)
+
+	// deleted or missing owner
+	err = repo.SetOwner(t.Context(), a, gone)
+	require.ErrorIs(t, err, entity.ErrOwnerNotFound())
+	err = repo.SetOwner(t.Context(), a, uuid.New())
+	require.ErrorIs(t, err, entity.ErrOwnerNotFound())
+	// deleted or missing entity
+	require.NoError(t, repo.Delete(t.Context(), []uuid.UUID{deleted}, creator))
+	err = repo.SetOwner(t.Context(), deleted, owner)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	err = repo.SetOwner(t.Context(), uuid.New(), owner)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// ownership of deleted users, as it was before they left
+	require.NoError(t, gdb.Exec("UPDATE entities SET owner_id = ? WHERE id IN ?", gone, []uuid.UUID{b, deleted}).Error)
+	orphaned, err := repo.GetOrphanedEntities(t.Context())
+	require.NoError(t, err)
+	require.Len(t, orphaned, 1)
+	require.Equal(t, b, orphaned[0].ID)
+	require.Equal(t, "b", orphaned[0].Name)
+	require.Equal(t, gone, orphaned[0].OwnerID)
+	require.NotZero(t, orphaned[0].OwnerDeletedAt)
+
+	// pool closed error
+	cleanup()
+	require.Error(t, repo.SetOwner(t.Context(), a, owner))
+	_, err = repo.GetOrphanedEntities(t.Context())
+	require.Error(t, err)
+}
+
+func TestEntity_Appearance(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	parent, child := uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Type: entity.TypeDepartment, Name: "parent", Slug: uuid.NewString(), UserID: userID,
+	}, parent, time.Now()))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Type: entity.TypeArticle, Name: "child", Slug: uuid.NewString(), UserID: userID, ParentID: &parent,
+	}, child, time.Now()))
+
+	icon, cover := "🚀", "imports/4f1c/cover.png"
+	require.NoError(t, repo.UpdateAppearance(t.Context(), child, entity.UpdateAppearanceReq{Icon: &icon, Cover: &cover}))
+	got, err := repo.Get(t.Context(), child)
+	require.NoError(t, err)
+	require.Equal(t, icon, got.Icon)
+	require.Equal(t, cover, got.Cover)
+	require.Empty(t, got.IconURL)
+	require.Equal(t, "/api/v1/attachments/imports/4f1c/cover.png", got.CoverURL)
+	require.Equal(t, 1, got.CurrentVersion)
+	children, err := repo.GetChildren(t.Context(), parent, nil)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	require.Equal(t, icon, children[0].Icon)
+	require.Equal(t, cover, children[0].Cover)
+	require.Equal(t, got.CoverURL, children[0].CoverURL)
+	tree, err := repo.GetHierarchy(t.Context(), []uuid.UUID{parent}, 5, nil, entity.HierarchyTypeChildrenOnly)
+	require.NoError(t, err)
+	for _, item := range tree {
+		if item.ID == child {
+			require.Equal(t, icon, item.Icon)
+			require.Equal(t, cover, item.Cover)
+		}
+	}
+
+	// only the given fields change, an empty one removes it
+	empty := ""
+	require.NoError(t, repo.UpdateAppearance(t.Context(), child, entity.UpdateAppearanceReq{Icon: &empty}))
+	item, err := repo.GetListItem(t.Context(), child)
+	require.NoError(t, err)
+	require.Empty(t, item.Icon)
+	require.Equal(t, cover, item.Cover)
+
+	// missing entity
+	err = repo.UpdateAppearance(t.Context(), uuid.New(), entity.UpdateAppearanceReq{Icon: &icon})
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// pool closed error
+	cleanup()
+	require.Error(t, repo.UpdateAppearance(t.Context(), child, entity.UpdateAppearanceReq{Icon: &icon}))
+}
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRepository(nil, entity.Config{}, nil)
+	require.Error(t, err)
+}
+
+func TestEntity_Lint(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newEntityRepo(t)
+
+	userID := createUserForEntity(t, gdb)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	rootID, childID, draftID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "Root", Content: "Thiss is root.", UserID: userID,
+	}, rootID, now))
+	require.NoError(t, repo.Create(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "Child", Content: "Child.", UserID: userID, ParentID: &rootID,
+	}, childID, now))
+	require.NoError(t, repo.CreateDraft(t.Context(), entity.CreateEntityReq{
+		Slug: uuid.NewString(), Type: entity.TypeDepartment, Name: "Draft", Content: "Draft.", UserID: userID, ParentID: &rootID,
+	}, draftID))
+	require.NoError(t, repo.SetLanguage(t.Context(), rootID, uuid.New(), "en", now))
+
+	// a version not claimed yet is reported without a status, drafts are not found
+	report, err := repo.GetLintReport(t.Context(), rootID, 0)
+	require.NoError(t, err)
+	require.Equal(t, entity.LintReport{EntityID: rootID, Version: 1, Findings: []entity.LintFinding{}}, report)
+	_, err = repo.GetLintReport(t.Context(), draftID, 0)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	_, err = repo.GetLintReport(t.Context(), rootID, 2)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound())
+
+	// current versions of live entities are claimed once, with their language
+	targets, err := repo.ClaimLint(t.Context(), now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.LintTarget{
+		{EntityID: rootID, Version: 1, Content: "Thiss is root.", Language: "en"},
+		{EntityID: childID, Version: 1, Content: "Child."},
+	}, targets)
+	targets, err = repo.ClaimLint(t.Context(), now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Empty(t, targets)
+	report, err = repo.GetLintReport(t.Context(), rootID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entity.LintRunning, report.Status)
+
+	// a claim that ran too long is taken over
+	targets, err = repo.ClaimLint(t.Context(), now.Add(time.Hour), now.Add(time.Minute), 1)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+
+	findings := []entity.LintFinding{{Offset: 0, Length: 5, Message: "Spelling", Rule: "MORFOLOGIK_RULE_EN_US", Severity: entity.LintError}}
+	require.NoError(t, repo.FinishLint(t.Context(), rootID, 1, entity.LintDone, findings, now))
+	require.NoError(t, repo.FinishLint(t.Context(), childID, 1, entity.LintFailed, nil, now))
+	report, err = repo.GetLintReport(t.Context(), rootID, 0)
+	require.NoError(t, err)
+	require.Equal(t, entity.LintReport{EntityID: rootID, Version: 1, Status: entity.LintDone, CheckedAt: &now, Findings: findings}, report)
+	report, err = repo.GetLintReport(t.Context(), childID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entity.LintFailed, report.Status)
+	require.Empty(t, report.Findings)
+
+	// a new version is claimed again unless linting is disabled for an ancestor
+	require.NoError(t, repo.Update(t.Context(), entity.UpdateEntityReq{
+		Slug: uuid.NewString(), ID: childID, Name: "Child", Content: "Child 2.", ParentID: &rootID, UserID: userID,
+	}, now.Add(time.Minute)))
+	require.NoError(t, repo.SetLintDisabled(t.Context(), rootID, true))
+	targets, err = repo.ClaimLint(t.Context(), now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Empty(t, targets)
+	report, err = repo.GetLintReport(t.Context(), rootID, 0)
+	require.NoError(t, err)
+	require.Equal(t, entity.LintReport{EntityID: rootID, Version: 1, Status: entity.LintDisabled}, report)
+
+	require.NoError(t, repo.SetLintDisabled(t.Context(), rootID, false))
+	targets, err = repo.ClaimLint(t.Context(), now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Equal(t, []entity.LintTarget{{EntityID: childID, Version: 2, Content: "Child 2."}}, targets)
+
+	require.ErrorIs(t, repo.SetLintDisabled(t.Context(), uuid.New(), true), entity.ErrEntityNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.ClaimLint(t.Context(), now, now, 10)
+	require.Error(t, err)
+	_, err = repo.GetLintReport(t.Context(), rootID, 0)
 	require.Error(t, err)
+	require.Error(t, repo.FinishLint(t.Context(), rootID, 1, entity.LintDone, nil, now))
+	require.Error(t, repo.SetLintDisabled(t.Context(), rootID, true))
 }
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxSlugLength caps the slug derived from a name, in runes; collision suffixes come on top.
+	MaxSlugLength = 80
+	// fallbackSlug is used for names without letters or digits.
+	fallbackSlug = "entity"
+)
+
+// Slugify derives the base slug of a name: lower-case letters and digits, every other run of
+// characters replaced by a single dash. Letters outside ASCII are kept.
+// Keep in sync with the backfill in migrations/20250913100000_add_entity_slugs.sql.
+func Slugify(name string) string {
+	var (
+		b       strings.Builder
+		n       int
+		pending bool
+	)
+	for _, r := range strings.ToLower(name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pending = b.Len() > 0
+			continue
+		}
+		if n == MaxSlugLength {
+			break
+		}
+		if pending {
+			if n+1 == MaxSlugLength {
+				break
+			}
+			b.WriteByte('-')
+			n++
+			pending = false
+		}
+		b.WriteRune(r)
+		n++
+	}
+	if b.Len() == 0 {
+		return fallbackSlug
+	}
+
+	return b.String()
+}
+
+// PickSlug returns base if it is free, otherwise base-2, base-3... whichever is the first free one.
+// The result depends only on the taken slugs, so concurrent writers agree on it.
+func PickSlug(base string, taken []string) string {
+	used := make(map[string]struct{}, len(taken))
+	for _, s := range taken {
+		used[s] = struct{}{}
+	}
+	if _, ok := used[base]; !ok {
+		return base
+	}
+	for i := 2; ; i++ {
+		candidate := base + "-" + strconv.Itoa(i)
+		if _, ok := used[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// slugHasBase reports whether slug is base itself or base with a collision suffix,
+// i.e. whether it still fits a name whose base slug is base.
+func slugHasBase(slug, base string) bool {
+	if slug == base {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(slug, base+"-")
+	if !ok {
+		return false
+	}
+	n, err := strconv.Atoi(suffix)
+
+	return err == nil && n >= 2 && strconv.Itoa(n) == suffix
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+// MaxSnapshotLabelLength caps snapshot labels, which are part of the URL of the snapshot.
+const MaxSnapshotLabelLength = 100
+
+// snapshotLabelPattern allows labels such as "2.3", "v2.3.1" or "release_2.3-rc1".
+var snapshotLabelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+type CreateSnapshotReq struct {
+	Label string `json:"label"`
+}
+
+// Snapshot names the versions a subtree had when it was taken, such as the pages of a product release.
+type Snapshot struct {
+	ID        uuid.UUID `json:"id"`
+	EntityID  uuid.UUID `json:"entity_id"`
+	Label     string    `json:"label"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	// EntityCount is how many entities of the subtree the snapshot captured.
+	EntityCount int `json:"entity_count"`
+}
+
+// SnapshotItem is an entity as it was at the version a snapshot captured.
+type SnapshotItem struct {
+	ID       uuid.UUID  `json:"id"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	Type     Type       `json:"type"`
+	Name     string     `json:"name"`
+	Content  string     `json:"content"`
+	Version  int        `json:"version"`
+	// IsDeleted reports that the entity was deleted after the snapshot was taken.
+	IsDeleted bool `json:"is_deleted"`
+}
+
+// SnapshotContent is a snapshot with the entities it captured, parents before their children.
+type SnapshotContent struct {
+	Snapshot
+	Items []SnapshotItem `json:"items"`
+}
+
+// CreateSnapshot captures the current version of every published entity of the subtree of id under
+// label. Drafts and the entities below them are left out, as they have no version to capture.
+func (c *core) CreateSnapshot(ctx context.Context, id uuid.UUID, req CreateSnapshotReq) (Snapshot, error) {
+	if id == uuid.Nil {
+		return Snapshot{}, fmt.Errorf("entity.core.CreateSnapshot: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	req.Label = strings.TrimSpace(req.Label)
+	if err := validateSnapshotLabel(req.Label); err != nil {
+		return Snapshot{}, fmt.Errorf("entity.core.CreateSnapshot: %w", err)
+	}
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("entity.core.CreateSnapshot: %w", err)
+	}
+	snapshotID, err := c.gen.ID.New()
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("entity.core.CreateSnapshot: %w", err)
+	}
+
+	snapshot := Snapshot{ID: snapshotID, EntityID: id, Label: req.Label, CreatedBy: userID, CreatedAt: c.gen.Time.Now()}
+	if snapshot.EntityCount, err = c.repo.CreateSnapshot(ctx, snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("entity.core.CreateSnapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// GetSnapshots returns the snapshots taken of the subtree of id, newest first.
+func (c *core) GetSnapshots(ctx context.Context, id uuid.UUID) ([]Snapshot, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetSnapshots: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	snapshots, err := c.repo.GetSnapshots(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetSnapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// GetSnapshot returns the subtree of id as it was when the snapshot labeled label was taken.
+func (c *core) GetSnapshot(ctx context.Context, id uuid.UUID, label string) (SnapshotContent, error) {
+	if id == uuid.Nil {
+		return SnapshotContent{}, fmt.Errorf("entity.core.GetSnapshot: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := validateSnapshotLabel(label); err != nil {
+		return SnapshotContent{}, fmt.Errorf("entity.core.GetSnapshot: %w", err)
+	}
+	snapshot, err := c.repo.GetSnapshot(ctx, id, label)
+	if err != nil {
+		return SnapshotContent{}, fmt.Errorf("entity.core.GetSnapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func validateSnapshotLabel(label string) error {
+	if label == "" {
+		return ErrSnapshotLabelRequired()
+	}
+	if len(label) > MaxSnapshotLabelLength {
+		return ErrSnapshotLabelTooLong(MaxSnapshotLabelLength)
+	}
+	if !snapshotLabelPattern.MatchString(label) {
+		return ErrInvalidSnapshotLabel()
+	}
+
+	return nil
+}
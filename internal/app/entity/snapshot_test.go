@@ -0,0 +1,176 @@
+package entity_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_CreateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID     = uuid.New()
+		ctx        = contextx.SetUserID(t.Context(), userID)
+		id         = uuid.New()
+		snapshotID = uuid.New()
+		now        = time.Now()
+		expErr     = fmt.Errorf("test error")
+		want       = entity.Snapshot{ID: snapshotID, EntityID: id, Label: "v2.3", CreatedBy: userID, CreatedAt: now}
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		label string
+		setup func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, tg *mocks.TimeGeneratorMock)
+		err   error
+	}{
+		{
+			name: "ok", id: id, label: " v2.3 ",
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, tg *mocks.TimeGeneratorMock) {
+				idGen.NewMock.Return(snapshotID, nil)
+				tg.NowMock.Return(now)
+				repo.CreateSnapshotMock.Expect(ctx, want).Return(3, nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, label: "v2.3", err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "no label", id: id, label: "  ", err: entity.ErrSnapshotLabelRequired()},
+		{name: "label too long", id: id, label: strings.Repeat("a", entity.MaxSnapshotLabelLength+1), err: entity.ErrSnapshotLabelTooLong(entity.MaxSnapshotLabelLength)},
+		{name: "label with slash", id: id, label: "release/2.3", err: entity.ErrInvalidSnapshotLabel()},
+		{name: "label starting with dot", id: id, label: ".v2", err: entity.ErrInvalidSnapshotLabel()},
+		{
+			name: "id error", id: id, label: "v2.3",
+			setup: func(_ *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, _ *mocks.TimeGeneratorMock) {
+				idGen.NewMock.Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "label taken", id: id, label: "v2.3",
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, tg *mocks.TimeGeneratorMock) {
+				idGen.NewMock.Return(snapshotID, nil)
+				tg.NowMock.Return(now)
+				repo.CreateSnapshotMock.Expect(ctx, want).Return(0, entity.ErrSnapshotLabelTaken())
+			},
+			err: entity.ErrSnapshotLabelTaken(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			idGen := mocks.NewIDGeneratorMock(t)
+			tg := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, idGen, tg)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: tg}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.CreateSnapshot(ctx, tt.id, entity.CreateSnapshotReq{Label: tt.label})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			exp := want
+			exp.EntityCount = 3
+			require.Equal(t, exp, got)
+		})
+	}
+}
+
+func TestCore_CreateSnapshot_NoUser(t *testing.T) {
+	t.Parallel()
+
+	c, err := entity.NewCore(mocks.NewRepositoryMock(t), entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	_, err = c.CreateSnapshot(t.Context(), uuid.New(), entity.CreateSnapshotReq{Label: "v2.3"})
+	require.Error(t, err)
+}
+
+func TestCore_GetSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		snapshot = entity.SnapshotContent{
+			Snapshot: entity.Snapshot{ID: uuid.New(), EntityID: id, Label: "v2.3", EntityCount: 1},
+			Items:    []entity.SnapshotItem{{ID: id, Type: entity.TypeDepartment, Name: "Guide", Version: 4}},
+		}
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		label string
+		setup func(repo *mocks.RepositoryMock)
+		err   error
+	}{
+		{
+			name: "ok", id: id, label: "v2.3",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSnapshotMock.Expect(ctx, id, "v2.3").Return(snapshot, nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, label: "v2.3", err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "invalid label", id: id, label: "v 2", err: entity.ErrInvalidSnapshotLabel()},
+		{
+			name: "not found", id: id, label: "v2.4",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSnapshotMock.Expect(ctx, id, "v2.4").Return(entity.SnapshotContent{}, entity.ErrSnapshotNotFound())
+			},
+			err: entity.ErrSnapshotNotFound(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetSnapshot(ctx, tt.id, tt.label)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, snapshot, got)
+		})
+	}
+}
+
+func TestCore_GetSnapshots(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	id := uuid.New()
+	snapshots := []entity.Snapshot{{ID: uuid.New(), EntityID: id, Label: "v2.3"}}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	_, err = c.GetSnapshots(ctx, uuid.Nil)
+	require.ErrorIs(t, err, apperr.ErrNilUUID(entity.FieldEntityID))
+
+	repo.GetSnapshotsMock.Expect(ctx, id).Return(snapshots, nil)
+	got, err := c.GetSnapshots(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, snapshots, got)
+}
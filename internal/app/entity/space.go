@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+// SpaceSettings configure a space, the subtree of a root entity. Unset fields leave the workspace
+// defaults in place.
+type SpaceSettings struct {
+	// DefaultLanguage is the language of content in the space without a language of its own; the linter
+	// checks such content in it.
+	DefaultLanguage string         `json:"default_language,omitempty"`
+	Review          ReviewSettings `json:"review"`
+	// Public lists the published entities of the space in the sitemap and the feed, like the roots in
+	// public.entity_ids.
+	Public bool `json:"public"`
+	// Retention replaces entity.retention for the versions in the space.
+	Retention *SpaceRetention `json:"retention,omitempty"`
+}
+
+// ReviewSettings are what published updates in a space must give reviewers. Drafts are not checked.
+type ReviewSettings struct {
+	// RequireSummary rejects updates without a change summary.
+	RequireSummary bool `json:"require_summary"`
+	// NoMinorEdits rejects updates saved as minor edits, so every change shows up in the activity feed.
+	NoMinorEdits bool `json:"no_minor_edits"`
+}
+
+// SpaceRetention is a retention policy for one space, read like RetentionConfig: a zero value disables
+// that rule, both zero keep every version.
+type SpaceRetention struct {
+	KeepLastVersions int `json:"keep_last_versions"`
+	KeepDays         int `json:"keep_days"`
+}
+
+// Space is a root entity with its settings.
+type Space struct {
+	EntityID uuid.UUID     `json:"entity_id"`
+	OwnerID  uuid.UUID     `json:"owner_id"`
+	Settings SpaceSettings `json:"settings"`
+}
+
+// GrantPolicy is what a move into another space does with the direct grants on the moved entities.
+type GrantPolicy string
+
+const (
+	// GrantsKeep moves the grants along, as a move within a space does.
+	GrantsKeep GrantPolicy = "keep"
+	// GrantsStrip revokes them, so the moved entities are reached through the grants of the new space only.
+	GrantsStrip GrantPolicy = "strip"
+	// GrantsRemap keeps the grants the users hold in the new space anyway, on the new parent or through its
+	// default permissions, revokes the others and grants the default permissions of the new ancestors, as
+	// for a new entity.
+	GrantsRemap GrantPolicy = "remap"
+)
+
+func (p GrantPolicy) Validate() error {
+	switch p {
+	case GrantsKeep, GrantsStrip, GrantsRemap:
+		return nil
+	default:
+		return ErrInvalidGrantPolicy()
+	}
+}
+
+// Grant is a direct role of a user on an entity.
+type Grant struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Role     auth.Role `json:"role"`
+	EntityID uuid.UUID `json:"entity_id"`
+}
+
+// SpaceMove is a move of an entity into another space with the grants it changed. Revoked holds the
+// grants on the entity and its descendants the policy takes away, Kept those a remap leaves in place and
+// Granted the default permissions of the new ancestors given instead.
+type SpaceMove struct {
+	FromSpaceID uuid.UUID   `json:"from_space_id"`
+	ToSpaceID   uuid.UUID   `json:"to_space_id"`
+	Grants      GrantPolicy `json:"grants"`
+	Revoked     []Grant     `json:"revoked"`
+	Kept        []Grant     `json:"kept"`
+	Granted     []Grant     `json:"granted"`
+}
+
+// GetSpace returns the settings of the root entity id.
+func (c *core) GetSpace(ctx context.Context, id uuid.UUID) (Space, error) {
+	if id == uuid.Nil {
+		return Space{}, fmt.Errorf("entity.core.GetSpace: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	space, err := c.repo.GetSpace(ctx, id)
+	if err != nil {
+		return Space{}, fmt.Errorf("entity.core.GetSpace: %w", err)
+	}
+
+	return space, nil
+}
+
+// SetSpaceSettings replaces the settings of the root entity id.
+func (c *core) SetSpaceSettings(ctx context.Context, id uuid.UUID, req SpaceSettings) (SpaceSettings, error) {
+	if id == uuid.Nil {
+		return SpaceSettings{}, fmt.Errorf("entity.core.SetSpaceSettings: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if req.DefaultLanguage != "" {
+		lang, err := c.validateLanguage(req.DefaultLanguage)
+		if err != nil {
+			return SpaceSettings{}, fmt.Errorf("entity.core.SetSpaceSettings: %w", err)
+		}
+		req.DefaultLanguage = lang
+	}
+	if r := req.Retention; r != nil && (r.KeepLastVersions < 0 || r.KeepDays < 0) {
+		return SpaceSettings{}, fmt.Errorf("entity.core.SetSpaceSettings: %w", ErrInvalidSpaceRetention())
+	}
+	if err := c.repo.SetSpaceSettings(ctx, id, req); err != nil {
+		return SpaceSettings{}, fmt.Errorf("entity.core.SetSpaceSettings: %w", err)
+	}
+
+	return req, nil
+}
+
+// checkReview enforces the review settings of the space an update lands in: the one of its new parent,
+// or its own if it has none.
+func (c *core) checkReview(ctx context.Context, req UpdateEntityReq) error {
+	if req.IsDraft {
+		return nil
+	}
+	in := req.ID
+	if req.ParentID != nil {
+		in = *req.ParentID
+	}
+	settings, err := c.repo.GetSpaceSettings(ctx, in)
+	if err != nil {
+		return err
+	}
+	if settings.Review.RequireSummary && req.Summary == "" {
+		return ErrSummaryRequired()
+	}
+	if settings.Review.NoMinorEdits && req.MinorEdit {
+		return ErrMinorEditNotAllowed()
+	}
+
+	return nil
+}
+
+// PlanSpaceMove returns the SpaceMove of moving id under parentID, or nil if it stays in its space. An empty
+// policy is the configured one; Revoked and Kept are filled in unless the grants are kept, Granted is left
+// to the caller.
+func (c *core) PlanSpaceMove(ctx context.Context, id uuid.UUID, parentID *uuid.UUID, policy GrantPolicy) (*SpaceMove, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if policy == "" {
+		policy = c.cfg.CrossSpaceGrants
+	}
+	if policy == "" {
+		policy = GrantsKeep
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", err)
+	}
+	from, err := c.repo.GetSpaceID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", err)
+	}
+	// without a parent the entity opens a space of its own
+	to := id
+	if parentID != nil {
+		if to, err = c.repo.GetSpaceID(ctx, *parentID); err != nil {
+			return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", err)
+		}
+	}
+	if from == to {
+		return nil, nil
+	}
+
+	move := &SpaceMove{FromSpaceID: from, ToSpaceID: to, Grants: policy, Revoked: []Grant{}, Kept: []Grant{}, Granted: []Grant{}}
+	if policy == GrantsKeep {
+		return move, nil
+	}
+	if move.Revoked, err = c.repo.GetSubtreeGrants(ctx, id); err != nil {
+		return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", err)
+	}
+	// a new root has no ancestors to hold the grants through
+	if policy == GrantsRemap && parentID != nil {
+		access, err := c.repo.GetAccess(ctx, *parentID)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.PlanSpaceMove: %w", err)
+		}
+		held := make(map[uuid.UUID]auth.Role, len(access))
+		for _, a := range access {
+			held[a.UserID] = a.Role
+		}
+		grants := move.Revoked
+		move.Revoked = make([]Grant, 0, len(grants))
+		for _, g := range grants {
+			// a grant is only held anyway if the role on the new parent covers it
+			if role, ok := held[g.UserID]; ok && slices.Contains(g.Role.GetHierarchy(), role) {
+				move.Kept = append(move.Kept, g)
+				continue
+			}
+			move.Revoked = append(move.Revoked, g)
+		}
+	}
+
+	return move, nil
+}
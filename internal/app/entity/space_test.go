@@ -0,0 +1,351 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_SetSpaceSettings(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = fmt.Errorf("test error")
+	)
+	tests := []struct {
+		name      string
+		languages []string
+		req       entity.SpaceSettings
+		want      *entity.SpaceSettings
+		err       error
+		code      apperr.Code
+	}{
+		{
+			name: "all settings",
+			req: entity.SpaceSettings{DefaultLanguage: "pt-br", Review: entity.ReviewSettings{RequireSummary: true}, Public: true,
+				Retention: &entity.SpaceRetention{KeepLastVersions: 10}},
+			want: &entity.SpaceSettings{DefaultLanguage: "pt-BR", Review: entity.ReviewSettings{RequireSummary: true}, Public: true,
+				Retention: &entity.SpaceRetention{KeepLastVersions: 10}},
+		},
+		{name: "defaults", req: entity.SpaceSettings{}, want: &entity.SpaceSettings{}},
+		{name: "allowed language", languages: []string{"en", "ru"}, req: entity.SpaceSettings{DefaultLanguage: "ru"}, want: &entity.SpaceSettings{DefaultLanguage: "ru"}},
+		{name: "unsupported language", languages: []string{"en"}, req: entity.SpaceSettings{DefaultLanguage: "ru"}, code: entity.CodeValidationFailed},
+		{name: "invalid language", req: entity.SpaceSettings{DefaultLanguage: "not a tag"}, code: entity.CodeValidationFailed},
+		{name: "negative retention", req: entity.SpaceSettings{Retention: &entity.SpaceRetention{KeepDays: -1}}, code: entity.CodeValidationFailed},
+		{name: "repo error", req: entity.SpaceSettings{Public: true}, err: expErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			switch {
+			case tt.want != nil:
+				repo.SetSpaceSettingsMock.Expect(ctx, id, *tt.want).Return(nil)
+			case tt.err != nil:
+				repo.SetSpaceSettingsMock.Return(tt.err)
+			}
+			cfg := Cfg()
+			cfg.Languages = tt.languages
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.SetSpaceSettings(ctx, id, tt.req)
+			switch {
+			case tt.code != "":
+				require.Equal(t, tt.code, apperr.CodeOf(err))
+			case tt.err != nil:
+				require.ErrorIs(t, err, tt.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, *tt.want, got)
+			}
+		})
+	}
+
+	t.Run("nil id", func(t *testing.T) {
+		t.Parallel()
+		c, err := entity.NewCore(mocks.NewRepositoryMock(t), entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+			mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+		_, err = c.SetSpaceSettings(ctx, uuid.Nil, entity.SpaceSettings{})
+		require.Error(t, err)
+	})
+}
+
+func TestCore_GetSpace(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	space := entity.Space{EntityID: uuid.New(), OwnerID: uuid.New(), Settings: entity.SpaceSettings{Public: true}}
+	repo := mocks.NewRepositoryMock(t)
+	repo.GetSpaceMock.Expect(ctx, space.EntityID).Return(space, nil)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+		mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	got, err := c.GetSpace(ctx, space.EntityID)
+	require.NoError(t, err)
+	require.Equal(t, space, got)
+
+	_, err = c.GetSpace(ctx, uuid.Nil)
+	require.Error(t, err)
+}
+
+func TestCore_Update_Review(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		parentID = uuid.New()
+		req      = entity.UpdateEntityReq{ID: id, Name: "name", Content: "content", UserID: uuid.New()}
+		expErr   = fmt.Errorf("test error")
+	)
+	minor := req
+	minor.MinorEdit = true
+	summarized := req
+	summarized.Summary = "Fix typo"
+	moved := req
+	moved.ParentID = &parentID
+
+	tests := []struct {
+		name     string
+		req      entity.UpdateEntityReq
+		in       uuid.UUID
+		settings entity.SpaceSettings
+		repoErr  error
+		err      error
+	}{
+		{name: "summary required", req: req, in: id, settings: entity.SpaceSettings{Review: entity.ReviewSettings{RequireSummary: true}}, err: entity.ErrSummaryRequired()},
+		{name: "minor edits forbidden", req: minor, in: id, settings: entity.SpaceSettings{Review: entity.ReviewSettings{NoMinorEdits: true}}, err: entity.ErrMinorEditNotAllowed()},
+		{name: "space of the new parent", req: moved, in: parentID, settings: entity.SpaceSettings{Review: entity.ReviewSettings{RequireSummary: true}}, err: entity.ErrSummaryRequired()},
+		{name: "repo error", req: req, in: id, repoErr: expErr, err: expErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			repo.GetSpaceSettingsMock.Expect(ctx, tt.in).Return(tt.settings, tt.repoErr)
+			validator := mocks.NewValidatorMock(t)
+			validator.NormalizeNameMock.Return(tt.req.Name)
+			validator.ValidateNameMock.Return(nil)
+			validator.ValidateContentMock.Return(entity.ContentUsage{}, nil)
+			cfg := Cfg()
+			cfg.MaxHierarchyDepth = 5
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				validator, cfg)
+			require.NoError(t, err)
+
+			_, err = c.Update(ctx, tt.req)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("summary given", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetSpaceSettingsMock.Expect(ctx, id).Return(entity.SpaceSettings{Review: entity.ReviewSettings{RequireSummary: true}}, nil)
+		repo.GetListItemMock.Return(entity.ListItem{}, expErr)
+		validator := mocks.NewValidatorMock(t)
+		validator.NormalizeNameMock.Return(summarized.Name)
+		validator.ValidateNameMock.Return(nil)
+		validator.ValidateContentMock.Return(entity.ContentUsage{}, nil)
+		validator.ValidateSummaryMock.Return(nil)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+			validator, Cfg())
+		require.NoError(t, err)
+
+		// the review passes, so the update goes on to the repository
+		_, err = c.Update(ctx, summarized)
+		require.ErrorIs(t, err, expErr)
+	})
+}
+
+func TestCore_PlanSpaceMove(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		parentID = uuid.New()
+		from     = uuid.New()
+		to       = uuid.New()
+		grants   = []entity.Grant{{UserID: uuid.New(), Role: auth.RoleRead, EntityID: id}}
+		childID  = uuid.New()
+		reader   = entity.Grant{UserID: uuid.New(), Role: auth.RoleRead, EntityID: id}
+		writer   = entity.Grant{UserID: uuid.New(), Role: auth.RoleWrite, EntityID: childID}
+		admin    = entity.Grant{UserID: uuid.New(), Role: auth.RoleAdmin, EntityID: childID}
+		outsider = entity.Grant{UserID: uuid.New(), Role: auth.RoleRead, EntityID: childID}
+		subtree  = []entity.Grant{reader, writer, outsider}
+		expErr   = fmt.Errorf("test error")
+	)
+	tests := []struct {
+		name       string
+		parentID   *uuid.UUID
+		policy     entity.GrantPolicy
+		configured entity.GrantPolicy
+		setup      func(repo *mocks.RepositoryMock)
+		want       *entity.SpaceMove
+		err        error
+		code       apperr.Code
+	}{
+		{
+			name:     "same space",
+			parentID: &parentID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(from, nil)
+			},
+		},
+		{
+			name:       "configured policy",
+			parentID:   &parentID,
+			configured: entity.GrantsStrip,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+				repo.GetSubtreeGrantsMock.Expect(ctx, id).Return(grants, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: to, Grants: entity.GrantsStrip, Revoked: grants, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{
+			name:       "requested policy keeps grants",
+			parentID:   &parentID,
+			policy:     entity.GrantsKeep,
+			configured: entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: to, Grants: entity.GrantsKeep, Revoked: []entity.Grant{}, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{
+			name: "to the top level keeps grants by default",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.Expect(ctx, id).Return(from, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: id, Grants: entity.GrantsKeep, Revoked: []entity.Grant{}, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{
+			name:     "remap keeps grants held in the new space",
+			parentID: &parentID,
+			policy:   entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+				repo.GetSubtreeGrantsMock.Expect(ctx, id).Return(subtree, nil)
+				repo.GetAccessMock.Expect(ctx, parentID).Return([]entity.DefaultPermission{
+					{UserID: reader.UserID, Role: auth.RoleWrite},
+					{UserID: writer.UserID, Role: auth.RoleRead},
+				}, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: to, Grants: entity.GrantsRemap,
+				Revoked: []entity.Grant{writer, outsider}, Kept: []entity.Grant{reader}, Granted: []entity.Grant{}},
+		},
+		{
+			name:     "remap revokes grants stronger than the role held",
+			parentID: &parentID,
+			policy:   entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+				repo.GetSubtreeGrantsMock.Expect(ctx, id).Return([]entity.Grant{writer, admin}, nil)
+				repo.GetAccessMock.Expect(ctx, parentID).Return([]entity.DefaultPermission{
+					{UserID: writer.UserID, Role: auth.RoleAdmin},
+					{UserID: admin.UserID, Role: auth.RoleWrite},
+				}, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: to, Grants: entity.GrantsRemap,
+				Revoked: []entity.Grant{admin}, Kept: []entity.Grant{writer}, Granted: []entity.Grant{}},
+		},
+		{
+			name:   "remap to the top level revokes all grants",
+			policy: entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.Expect(ctx, id).Return(from, nil)
+				repo.GetSubtreeGrantsMock.Expect(ctx, id).Return(subtree, nil)
+			},
+			want: &entity.SpaceMove{FromSpaceID: from, ToSpaceID: id, Grants: entity.GrantsRemap,
+				Revoked: subtree, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{name: "invalid policy", parentID: &parentID, policy: "drop", code: entity.CodeValidationFailed},
+		{
+			name:     "parent not found",
+			parentID: &parentID,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(uuid.Nil, entity.ErrEntityNotFound())
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name:     "repo error",
+			parentID: &parentID,
+			policy:   entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+				repo.GetSubtreeGrantsMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:     "access error",
+			parentID: &parentID,
+			policy:   entity.GrantsRemap,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetSpaceIDMock.When(ctx, id).Then(from, nil)
+				repo.GetSpaceIDMock.When(ctx, parentID).Then(to, nil)
+				repo.GetSubtreeGrantsMock.Return(subtree, nil)
+				repo.GetAccessMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			cfg := Cfg()
+			cfg.CrossSpaceGrants = tt.configured
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), cfg)
+			require.NoError(t, err)
+
+			got, err := c.PlanSpaceMove(ctx, id, tt.parentID, tt.policy)
+			switch {
+			case tt.code != "":
+				require.Equal(t, tt.code, apperr.CodeOf(err))
+			case tt.err != nil:
+				require.ErrorIs(t, err, tt.err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate_CrossSpaceGrants(t *testing.T) {
+	t.Parallel()
+
+	cfg := Cfg()
+	for _, policy := range []entity.GrantPolicy{"", entity.GrantsKeep, entity.GrantsStrip, entity.GrantsRemap} {
+		cfg.CrossSpaceGrants = policy
+		require.NoError(t, cfg.Validate())
+	}
+	cfg.CrossSpaceGrants = "drop"
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/google/uuid"
+)
+
+// GetDraftReminders returns, by creator, the drafts untouched for StaleDrafts.NotifyDays that their creator
+// was not reminded about yet. Without NotifyDays there are none.
+func (c *core) GetDraftReminders(ctx context.Context) ([]DraftReminder, error) {
+	if c.cfg.StaleDrafts.NotifyDays == 0 {
+		return []DraftReminder{}, nil
+	}
+
+	before := c.gen.Time.Now().AddDate(0, 0, -c.cfg.StaleDrafts.NotifyDays)
+	reminders, err := c.repo.GetDraftsToRemind(ctx, before)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetDraftReminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// MarkDraftsReminded records that the creators of ids were reminded about them now, so they are not
+// reminded again until the drafts are touched and left again.
+func (c *core) MarkDraftsReminded(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := c.repo.MarkDraftsReminded(ctx, ids, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("entity.core.MarkDraftsReminded: %w", err)
+	}
+
+	return nil
+}
+
+// DraftReminderEmail is the email reminding the user of reminder about their stale drafts; there is none
+// without an address.
+func (c *core) DraftReminderEmail(reminder DraftReminder) (mail.Message, bool) {
+	if reminder.Email == "" {
+		return mail.Message{}, false
+	}
+
+	policy := c.cfg.StaleDrafts
+	var b strings.Builder
+	fmt.Fprintf(&b, "You have drafts nobody has edited for %d days or more:\n\n", policy.NotifyDays)
+	for _, d := range reminder.Drafts {
+		fmt.Fprintf(&b, "- %s (last edited %s)\n", d.Name, d.UpdatedAt.UTC().Format(time.DateOnly))
+	}
+	if policy.CleanupDays > 0 {
+		action := "moved to the trash"
+		if policy.Action == StaleDraftDelete {
+			action = "deleted"
+		}
+		fmt.Fprintf(&b, "\nUnless they are edited, they will be %s in %d days.\n", action, policy.CleanupDays-policy.NotifyDays)
+	}
+	b.WriteString("\nTo keep your drafts however long they are left, turn on keep stale drafts in your preferences.\n")
+
+	return mail.Message{To: reminder.Email, Subject: "Your drafts have not been edited for a while", Body: b.String()}, true
+}
+
+// CleanupStaleDrafts archives or deletes, as StaleDrafts.Action says, the drafts without children untouched
+// for StaleDrafts.CleanupDays. With NotifyDays, a draft is only cleaned up once CleanupDays-NotifyDays
+// have passed since its creator was reminded about it. With dryRun nothing changes and the report lists
+// what would be cleaned up.
+func (c *core) CleanupStaleDrafts(ctx context.Context, dryRun bool) (StaleDraftsReport, error) {
+	policy := c.cfg.StaleDrafts
+	report := StaleDraftsReport{Policy: policy, DryRun: dryRun, Reminded: []StaleDraft{}, CleanedUp: []StaleDraft{}}
+	if policy.CleanupDays == 0 {
+		return report, nil
+	}
+
+	now := c.gen.Time.Now()
+	var remindedBefore *time.Time
+	if policy.NotifyDays > 0 {
+		t := now.AddDate(0, 0, policy.NotifyDays-policy.CleanupDays)
+		remindedBefore = &t
+	}
+	cleaned, err := c.repo.CleanupStaleDrafts(ctx, now.AddDate(0, 0, -policy.CleanupDays), remindedBefore,
+		policy.Action == StaleDraftDelete, dryRun)
+	if err != nil {
+		return StaleDraftsReport{}, fmt.Errorf("entity.core.CleanupStaleDrafts: %w", err)
+	}
+	report.CleanedUp = cleaned
+
+	return report, nil
+}
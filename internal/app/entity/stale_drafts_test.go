@@ -0,0 +1,190 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func staleDraftsCfg(policy entity.StaleDraftsConfig) entity.Config {
+	cfg := Cfg()
+	cfg.StaleDrafts = policy
+	return cfg
+}
+
+func TestCore_DraftReminders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		now       = time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC)
+		ids       = []uuid.UUID{uuid.New(), uuid.New()}
+		reminders = []entity.DraftReminder{{UserID: uuid.New(), Email: "a@example.com", Drafts: []entity.StaleDraft{{ID: ids[0]}}}}
+		policy    = entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 45, Action: entity.StaleDraftArchive, IntervalMinutes: 60}
+		expErr    = fmt.Errorf("test error")
+	)
+	newCore := func(repo *mocks.RepositoryMock, policy entity.StaleDraftsConfig) interface {
+		GetDraftReminders(ctx context.Context) ([]entity.DraftReminder, error)
+		MarkDraftsReminded(ctx context.Context, ids []uuid.UUID) error
+	} {
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Optional().Return(now)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), staleDraftsCfg(policy))
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("get", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetDraftsToRemindMock.Expect(ctx, now.AddDate(0, 0, -30)).Return(reminders, nil)
+
+		got, err := newCore(repo, policy).GetDraftReminders(ctx)
+		require.NoError(t, err)
+		require.Equal(t, reminders, got)
+	})
+	t.Run("get without reminders", func(t *testing.T) {
+		t.Parallel()
+		got, err := newCore(mocks.NewRepositoryMock(t), entity.StaleDraftsConfig{}).GetDraftReminders(ctx)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+	t.Run("get repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetDraftsToRemindMock.Return(nil, expErr)
+
+		_, err := newCore(repo, policy).GetDraftReminders(ctx)
+		require.ErrorIs(t, err, expErr)
+	})
+	t.Run("mark", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.MarkDraftsRemindedMock.Expect(ctx, ids, now).Return(nil)
+
+		require.NoError(t, newCore(repo, policy).MarkDraftsReminded(ctx, ids))
+		require.NoError(t, newCore(mocks.NewRepositoryMock(t), policy).MarkDraftsReminded(ctx, nil))
+	})
+	t.Run("mark repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.MarkDraftsRemindedMock.Return(expErr)
+
+		require.ErrorIs(t, newCore(repo, policy).MarkDraftsReminded(ctx, ids), expErr)
+	})
+}
+
+func TestCore_DraftReminderEmail(t *testing.T) {
+	t.Parallel()
+
+	reminder := entity.DraftReminder{
+		UserID: uuid.New(),
+		Email:  "a@example.com",
+		Drafts: []entity.StaleDraft{{Name: "Plan", UpdatedAt: time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)}},
+	}
+	email := func(policy entity.StaleDraftsConfig, reminder entity.DraftReminder) (mail.Message, bool) {
+		c, err := entity.NewCore(mocks.NewRepositoryMock(t), entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+			mocks.NewValidatorMock(t), staleDraftsCfg(policy))
+		require.NoError(t, err)
+		return c.DraftReminderEmail(reminder)
+	}
+
+	msg, ok := email(entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 44, Action: entity.StaleDraftDelete, IntervalMinutes: 60}, reminder)
+	require.True(t, ok)
+	require.Equal(t, "a@example.com", msg.To)
+	require.Contains(t, msg.Body, "- Plan (last edited 2025-08-01)")
+	require.Contains(t, msg.Body, "deleted in 14 days")
+
+	msg, ok = email(entity.StaleDraftsConfig{NotifyDays: 30, IntervalMinutes: 60}, reminder)
+	require.True(t, ok)
+	require.NotContains(t, msg.Body, "Unless")
+
+	reminder.Email = ""
+	_, ok = email(entity.StaleDraftsConfig{NotifyDays: 30, IntervalMinutes: 60}, reminder)
+	require.False(t, ok)
+}
+
+func TestCore_CleanupStaleDrafts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		now     = time.Date(2025, 10, 9, 12, 0, 0, 0, time.UTC)
+		cleaned = []entity.StaleDraft{{ID: uuid.New(), Name: "a"}, {ID: uuid.New(), Name: "b"}}
+		expErr  = fmt.Errorf("test error")
+	)
+	remindedBefore := now.AddDate(0, 0, -15)
+
+	tests := []struct {
+		name   string
+		policy entity.StaleDraftsConfig
+		dryRun bool
+		setup  func(repo *mocks.RepositoryMock)
+		want   func(policy entity.StaleDraftsConfig) entity.StaleDraftsReport
+		err    error
+	}{
+		{
+			name:   "archive reminded drafts",
+			policy: entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 45, Action: entity.StaleDraftArchive, IntervalMinutes: 60},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.CleanupStaleDraftsMock.Expect(ctx, now.AddDate(0, 0, -45), &remindedBefore, false, false).Return(cleaned, nil)
+			},
+			want: func(policy entity.StaleDraftsConfig) entity.StaleDraftsReport {
+				return entity.StaleDraftsReport{Policy: policy, Reminded: []entity.StaleDraft{}, CleanedUp: cleaned}
+			},
+		},
+		{
+			name:   "delete without reminders, dry run",
+			policy: entity.StaleDraftsConfig{CleanupDays: 45, Action: entity.StaleDraftDelete, IntervalMinutes: 60},
+			dryRun: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.CleanupStaleDraftsMock.Expect(ctx, now.AddDate(0, 0, -45), nil, true, true).Return(cleaned, nil)
+			},
+			want: func(policy entity.StaleDraftsConfig) entity.StaleDraftsReport {
+				return entity.StaleDraftsReport{Policy: policy, DryRun: true, Reminded: []entity.StaleDraft{}, CleanedUp: cleaned}
+			},
+		},
+		{
+			name:   "no cleanup",
+			policy: entity.StaleDraftsConfig{NotifyDays: 30, IntervalMinutes: 60},
+			setup:  func(*mocks.RepositoryMock) {},
+			want: func(policy entity.StaleDraftsConfig) entity.StaleDraftsReport {
+				return entity.StaleDraftsReport{Policy: policy, Reminded: []entity.StaleDraft{}, CleanedUp: []entity.StaleDraft{}}
+			},
+		},
+		{
+			name:   "repo error",
+			policy: entity.StaleDraftsConfig{CleanupDays: 45, Action: entity.StaleDraftArchive, IntervalMinutes: 60},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.CleanupStaleDraftsMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Optional().Return(now)
+			tt.setup(repo)
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), staleDraftsCfg(tt.policy))
+			require.NoError(t, err)
+
+			got, err := c.CleanupStaleDrafts(ctx, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want(tt.policy), got)
+		})
+	}
+}
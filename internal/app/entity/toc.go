@@ -0,0 +1,280 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/html"
+)
+
+// TOCNode is an entity of the outline of a subtree: its section headings and its children in sibling order.
+type TOCNode struct {
+	ID       uuid.UUID  `json:"id"`
+	Type     Type       `json:"type"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+	IsDraft  bool       `json:"is_draft"`
+	Headings []*Heading `json:"headings"`
+	Children []*TOCNode `json:"children"`
+
+	pinned    bool
+	sortOrder int
+}
+
+// Heading is a section heading of a document, with the headings of lower levels that follow it nested below.
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	// Anchor is the id of an HTML heading, or else derived from the text like GitHub does:
+	// lower case, spaces to dashes, punctuation dropped, and -1, -2... for repeats in the document.
+	Anchor   string     `json:"anchor"`
+	Children []*Heading `json:"children,omitempty"`
+}
+
+// GetTOC returns the outline of the subtree of rootID. It reads the subtree like Export, so unless
+// isAdmin, drafts of other users and the entities below them are left out.
+func (c *core) GetTOC(ctx context.Context, rootID uuid.UUID, isAdmin bool) (*TOCNode, error) {
+	nodes := make(map[uuid.UUID]*TOCNode)
+	var items []ExportItem
+	err := c.Export(ctx, &rootID, isAdmin, func(page []ExportItem) error {
+		for _, item := range page {
+			nodes[item.ID] = &TOCNode{
+				ID: item.ID, Type: item.Type, Name: item.Name, Slug: item.Slug, IsDraft: item.IsDraft,
+				Headings: NestHeadings(ExtractHeadings(item.Content)), Children: []*TOCNode{},
+				pinned: item.Pinned, sortOrder: item.SortOrder,
+			}
+			item.Content = ""
+			items = append(items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetTOC: %w", err)
+	}
+
+	// parents come before their children, so every parent of a node below the root is known
+	for _, item := range items {
+		if item.ID == rootID || item.ParentID == nil {
+			continue
+		}
+		if parent, ok := nodes[*item.ParentID]; ok {
+			parent.Children = append(parent.Children, nodes[item.ID])
+		}
+	}
+	for _, node := range nodes {
+		slices.SortFunc(node.Children, func(a, b *TOCNode) int {
+			return compareSiblings(
+				ListItem{ID: a.ID, Name: a.Name, Pinned: a.pinned, SortOrder: a.sortOrder},
+				ListItem{ID: b.ID, Name: b.Name, Pinned: b.pinned, SortOrder: b.sortOrder},
+			)
+		})
+	}
+
+	return nodes[rootID], nil
+}
+
+var (
+	// atxHeading matches a Markdown heading line such as "## Title ##".
+	atxHeading = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))??(?:[ \t]+#+)?[ \t]*$`)
+	// setextUnderline matches the line under a Markdown heading: === for level 1, --- for level 2.
+	setextUnderline = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	// codeFence matches the line opening or closing a fenced code block.
+	codeFence = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	// inlineLink matches a Markdown link or image, keeping its text.
+	inlineLink = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+)
+
+// ExtractHeadings returns the headings of content in document order: Markdown ATX ("## Title") and
+// setext (a line underlined with === or ---) headings, and HTML <h1> to <h6> elements. Fenced code
+// blocks are skipped. Markup is removed from the text, and headings without text are left out.
+func ExtractHeadings(content string) []Heading {
+	var (
+		headings []Heading
+		anchors  = make(map[string]int)
+		// segment holds the lines since the last Markdown heading or code block, searched for HTML headings
+		segment []string
+		fence   string
+	)
+	add := func(level int, text, id string) {
+		text = strings.Join(strings.Fields(text), " ")
+		if text == "" {
+			return
+		}
+		headings = append(headings, Heading{Level: level, Text: text, Anchor: anchor(anchors, text, id)})
+	}
+	flush := func() {
+		for _, h := range htmlHeadings(strings.Join(segment, "\n")) {
+			add(h.Level, h.Text, h.Anchor)
+		}
+		segment = segment[:0]
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if fence != "" {
+			// the fence closes with a line of at least as many of the same characters and nothing else
+			m := codeFence.FindStringSubmatch(line)
+			if m != nil && m[1][0] == fence[0] && len(m[1]) >= len(fence) &&
+				strings.TrimSpace(strings.TrimLeft(line, " ")[len(m[1]):]) == "" {
+				fence = ""
+			}
+			continue
+		}
+		if m := codeFence.FindStringSubmatch(line); m != nil {
+			flush()
+			fence = m[1]
+			continue
+		}
+		if m := atxHeading.FindStringSubmatch(line); m != nil {
+			flush()
+			add(len(m[1]), inlineText(m[2]), "")
+			continue
+		}
+		if m := setextUnderline.FindStringSubmatch(line); m != nil {
+			// the paragraph right above, if any, is the heading
+			start := len(segment)
+			for start > 0 && strings.TrimSpace(segment[start-1]) != "" {
+				start--
+			}
+			if start < len(segment) && !strings.HasPrefix(strings.TrimSpace(segment[start]), "<") {
+				text := strings.Join(segment[start:], " ")
+				segment = segment[:start]
+				flush()
+				level := 1
+				if m[1][0] == '-' {
+					level = 2
+				}
+				add(level, inlineText(text), "")
+				continue
+			}
+		}
+		segment = append(segment, line)
+	}
+	if fence == "" {
+		flush()
+	}
+
+	return headings
+}
+
+// htmlHeadings returns the <h1> to <h6> elements of fragment with their id in Anchor.
+func htmlHeadings(fragment string) []Heading {
+	if !strings.Contains(fragment, "<") {
+		return nil
+	}
+
+	var (
+		headings []Heading
+		current  *Heading
+		text     strings.Builder
+	)
+	z := html.NewTokenizer(strings.NewReader(fragment))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return headings
+		case html.StartTagToken:
+			name, hasAttr := z.TagName()
+			if level := headingLevel(name); level > 0 && current == nil {
+				current = &Heading{Level: level}
+				text.Reset()
+				for hasAttr {
+					var key, val []byte
+					key, val, hasAttr = z.TagAttr()
+					if string(key) == "id" {
+						current.Anchor = string(val)
+					}
+				}
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if current != nil && headingLevel(name) == current.Level {
+				current.Text = text.String()
+				headings = append(headings, *current)
+				current = nil
+			}
+		case html.TextToken:
+			if current != nil {
+				text.Write(z.Text())
+			}
+		}
+	}
+}
+
+func headingLevel(tag []byte) int {
+	if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+		return int(tag[1] - '0')
+	}
+
+	return 0
+}
+
+// inlineText strips the inline markup of a Markdown heading: links keep their text, and code span
+// backticks, asterisks and HTML tags are removed.
+func inlineText(s string) string {
+	s = inlineLink.ReplaceAllString(s, "$1")
+	s = strings.NewReplacer("`", "", "*", "").Replace(s)
+
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return b.String()
+		case html.TextToken:
+			b.Write(z.Text())
+		}
+	}
+}
+
+// anchor returns id if set, otherwise the GitHub style anchor of text, unique among those seen.
+func anchor(seen map[string]int, text, id string) string {
+	if id != "" {
+		seen[id]++
+		return id
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteByte('-')
+		}
+	}
+	base := b.String()
+	n := seen[base]
+	seen[base]++
+	if n == 0 {
+		return base
+	}
+
+	return base + "-" + strconv.Itoa(n)
+}
+
+// NestHeadings nests each heading under the closest heading before it with a lower level.
+func NestHeadings(headings []Heading) []*Heading {
+	roots := make([]*Heading, 0)
+	var stack []*Heading
+	for i := range headings {
+		h := &headings[i]
+		for len(stack) > 0 && stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, h)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, h)
+		}
+		stack = append(stack, h)
+	}
+
+	return roots
+}
@@ -0,0 +1,160 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractHeadings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    []entity.Heading
+	}{
+		{name: "empty", content: ""},
+		{
+			name:    "atx",
+			content: "# Intro\ntext\n## Set up ##\n#not a heading\n####### too deep\n###",
+			want: []entity.Heading{
+				{Level: 1, Text: "Intro", Anchor: "intro"},
+				{Level: 2, Text: "Set up", Anchor: "set-up"},
+			},
+		},
+		{
+			name:    "setext",
+			content: "Title\n=====\n\nSection\n---\n\n---\n",
+			want: []entity.Heading{
+				{Level: 1, Text: "Title", Anchor: "title"},
+				{Level: 2, Text: "Section", Anchor: "section"},
+			},
+		},
+		{
+			name:    "html",
+			content: "<h1 id=\"top\">Top</h1>\n<p>text</p>\n<h3>\n  Deep <em>one</em>\n</h3>",
+			want: []entity.Heading{
+				{Level: 1, Text: "Top", Anchor: "top"},
+				{Level: 3, Text: "Deep one", Anchor: "deep-one"},
+			},
+		},
+		{
+			name:    "document order across markdown and html",
+			content: "<h2>First</h2>\n# Second\n<h2>Third</h2>",
+			want: []entity.Heading{
+				{Level: 2, Text: "First", Anchor: "first"},
+				{Level: 1, Text: "Second", Anchor: "second"},
+				{Level: 2, Text: "Third", Anchor: "third"},
+			},
+		},
+		{
+			name:    "code blocks skipped",
+			content: "```go\n# comment\n<h1>no</h1>\n```\n~~~~\n# x\n~~~\n~~~~\n# After",
+			want:    []entity.Heading{{Level: 1, Text: "After", Anchor: "after"}},
+		},
+		{
+			name:    "markup removed, repeats numbered",
+			content: "## Use `go test` with [flags](https://go.dev) & **care**\n## FAQ\n## FAQ\n## C#",
+			want: []entity.Heading{
+				{Level: 2, Text: "Use go test with flags & care", Anchor: "use-go-test-with-flags--care"},
+				{Level: 2, Text: "FAQ", Anchor: "faq"},
+				{Level: 2, Text: "FAQ", Anchor: "faq-1"},
+				{Level: 2, Text: "C#", Anchor: "c"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, entity.ExtractHeadings(tt.content))
+		})
+	}
+}
+
+func TestNestHeadings(t *testing.T) {
+	t.Parallel()
+
+	got := entity.NestHeadings([]entity.Heading{
+		{Level: 2, Text: "a"}, {Level: 3, Text: "a.1"}, {Level: 4, Text: "a.1.1"}, {Level: 3, Text: "a.2"},
+		{Level: 1, Text: "b"}, {Level: 3, Text: "b.1"},
+	})
+
+	require.Equal(t, []*entity.Heading{
+		{Level: 2, Text: "a", Children: []*entity.Heading{
+			{Level: 3, Text: "a.1", Children: []*entity.Heading{{Level: 4, Text: "a.1.1"}}},
+			{Level: 3, Text: "a.2"},
+		}},
+		{Level: 1, Text: "b", Children: []*entity.Heading{{Level: 3, Text: "b.1"}}},
+	}, got)
+	require.Empty(t, entity.NestHeadings(nil))
+}
+
+func TestCore_GetTOC(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.New()
+		ctx     = contextx.SetUserID(t.Context(), userID)
+		rootID  = uuid.New()
+		first   = uuid.New()
+		second  = uuid.New()
+		grandID = uuid.New()
+		expErr  = fmt.Errorf("test error")
+	)
+	page := []entity.ExportItem{
+		{ID: rootID, Type: entity.TypeDepartment, Name: "Manual", Content: "# Overview\n## Scope", Depth: 1},
+		{ID: second, ParentID: &rootID, Type: entity.TypeArticle, Name: "a", Depth: 2},
+		{ID: first, ParentID: &rootID, Type: entity.TypeArticle, Name: "z", SortOrder: 1, IsDraft: true, Depth: 2},
+		{ID: grandID, ParentID: &first, Type: entity.TypeArticle, Name: "leaf", Content: "<h2>Details</h2>", Depth: 3},
+	}
+
+	t.Run("outline in sibling order", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetExportPageMock.Expect(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, &userID).Return(page, nil)
+		got, err := c.GetTOC(ctx, rootID, false)
+		require.NoError(t, err)
+
+		require.Equal(t, rootID, got.ID)
+		require.Equal(t, []*entity.Heading{{Level: 1, Text: "Overview", Anchor: "overview", Children: []*entity.Heading{
+			{Level: 2, Text: "Scope", Anchor: "scope"},
+		}}}, got.Headings)
+		require.Len(t, got.Children, 2)
+		require.Equal(t, first, got.Children[0].ID)
+		require.True(t, got.Children[0].IsDraft)
+		require.Equal(t, second, got.Children[1].ID)
+		require.Empty(t, got.Children[1].Headings)
+		require.Empty(t, got.Children[1].Children)
+		require.Len(t, got.Children[0].Children, 1)
+		require.Equal(t, "Details", got.Children[0].Children[0].Headings[0].Text)
+	})
+	t.Run("admin, root not found", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetExportPageMock.Expect(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, nil).Return(nil, nil)
+		_, err = c.GetTOC(ctx, rootID, true)
+		require.ErrorIs(t, err, entity.ErrEntityNotFound())
+	})
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+		require.NoError(t, err)
+
+		repo.GetExportPageMock.Expect(ctx, &rootID, entity.ExportCursor{}, entity.ExportPageSize, &userID).Return(nil, expErr)
+		_, err = c.GetTOC(ctx, rootID, false)
+		require.ErrorIs(t, err, expErr)
+	})
+}
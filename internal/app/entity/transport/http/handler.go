@@ -2,21 +2,75 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	"github.com/66gu1/easygodocs/internal/app/entity/usecase"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 )
 
 const (
-	URLParamEntityID = "entity_id"
-	URLParamVersion  = "version"
+	URLParamEntityID  = "entity_id"
+	URLParamRelatedID = "related_id"
+	URLParamChildID   = "child_id"
+	URLParamVariantID = "variant_id"
+	URLParamVersion   = "version"
+	URLParamSlug      = "slug"
+	URLParamLabel     = "label"
+	URLParamKey       = "key"
+	URLParamPath      = "*"
+
+	QueryParamBefore = "before"
+	QueryParamLimit  = "limit"
+	QueryParamPeriod = "period"
+
+	QueryParamIncludeContent = "include_content"
+	QueryParamIncludeMinor   = "include_minor"
+
+	QueryParamType         = "type"
+	QueryParamParentID     = "parent_id"
+	QueryParamUpdatedSince = "updated_since"
+	QueryParamAuthorID     = "author_id"
+	QueryParamStatus       = "status"
+	QueryParamSort         = "sort"
+	QueryParamOrder        = "order"
+	QueryParamAfter        = "after"
+
+	QueryParamFormat = "format"
+	QueryParamRender = "render"
+	QueryParamExact  = "exact"
+
+	QueryParamVersion = "version"
+	QueryParamDryRun  = "dry_run"
+
+	defaultActivityLimit = 50
+	defaultVersionsLimit = 50
+	defaultHistoryLimit  = 50
+
+	// exportWriteTimeout replaces the server write timeout before each page of an export is written.
+	exportWriteTimeout = 30 * time.Second
+
+	// HeaderContentSizeWarning is set on writes whose content passes the warning share of its limit,
+	// as "<length>/<limit>" in bytes.
+	HeaderContentSizeWarning = "X-Content-Size-Warning"
+	// HeaderBrokenLinks is set on writes whose content links to entities that do not exist, as their
+	// comma-separated IDs.
+	HeaderBrokenLinks = "X-Broken-Links"
+	// HeaderAcceptLanguage picks the language variant Get serves; HeaderContentLanguage names the one served.
+	HeaderAcceptLanguage  = "Accept-Language"
+	HeaderContentLanguage = "Content-Language"
 )
 
 type CreateEntityResp struct {
@@ -28,39 +82,132 @@ type UpdateEntityInput struct {
 	Content  string     `json:"content"`
 	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	IsDraft  bool       `json:"is_draft,omitempty"`
+	// Summary is an optional description of the change, kept with the version it creates.
+	Summary string `json:"summary,omitempty"`
+	// MinorEdit marks a change such as a typo fix that is left out of the activity feed.
+	MinorEdit bool `json:"minor_edit,omitempty"`
+	// ConfirmCrossSpace must be set to move the entity under another root, or to make it a root.
+	ConfirmCrossSpace bool `json:"confirm_cross_space,omitempty"`
+	// Grants is what such a move does with the grants on the moved entities: keep, strip or remap.
+	// Empty uses entity.cross_space_grants.
+	Grants entity.GrantPolicy `json:"grants,omitempty" enums:"keep,strip,remap"`
+}
+
+type AutosaveInput struct {
+	Content string `json:"content"`
+}
+
+type MergeInput struct {
+	BaseVersion   int    `json:"base_version"`
+	Content       string `json:"content"`
+	TheirsVersion *int   `json:"theirs_version,omitempty"`
+}
+
+type TransferOwnershipInput struct {
+	OwnerID uuid.UUID `json:"owner_id"`
 }
 
 // Handler knows how to decode HTTP → service calls and encode responses.
 type Handler struct {
-	svc Service
+	svc       Service
+	sanitizer entity.HTMLSanitizer
+	pdf       PDFConverter
+}
+
+// PDFConverter turns the HTML render writes into PDF written to dst.
+type PDFConverter interface {
+	Convert(ctx context.Context, dst io.Writer, render func(w io.Writer) error) error
 }
 
 //go:generate minimock -i github.com/66gu1/easygodocs/internal/app/entity.EntityService -o ./mock -s _mock.go
 type Service interface {
 	GetTree(ctx context.Context) (entity.Tree, error)
 	Get(ctx context.Context, id uuid.UUID) (entity.Entity, error)
+	GetRendered(ctx context.Context, id uuid.UUID) (entity.Entity, error)
+	GetBySlug(ctx context.Context, slug string) (entity.Entity, error)
+	GetByPath(ctx context.Context, path []string) (entity.Entity, entity.PathResolution, error)
+	GetMeta(ctx context.Context, id uuid.UUID) (entity.Meta, error)
+	GetContributors(ctx context.Context, id uuid.UUID) ([]entity.Contributor, error)
+	GetActivity(ctx context.Context, req entity.GetActivityReq) (entity.Activity, error)
+	GetBacklinks(ctx context.Context, id uuid.UUID) ([]entity.ListItem, error)
+	GetChildren(ctx context.Context, id uuid.UUID) ([]entity.ListItem, error)
+	ReorderChildren(ctx context.Context, parentID uuid.UUID, req entity.ChildrenOrderReq) error
+	SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool) error
+	GetSpace(ctx context.Context, id uuid.UUID) (entity.Space, error)
+	SetSpaceSettings(ctx context.Context, id uuid.UUID, req entity.SpaceSettings) (entity.SpaceSettings, error)
+	GetTOC(ctx context.Context, id uuid.UUID) (*entity.TOCNode, error)
+	GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error)
+	SetDefaultPermissions(ctx context.Context, id uuid.UUID, req entity.SetDefaultPermissionsReq) error
+	Export(ctx context.Context, rootID *uuid.UUID, render entity.Render, write func([]entity.ExportItem) error) error
+	GetBrokenLinks(ctx context.Context) ([]entity.BrokenLink, error)
+	PreviewRetention(ctx context.Context) (entity.RetentionReport, error)
+	PurgeTrash(ctx context.Context, dryRun bool) (entity.TrashReport, error)
+	PreviewStaleDrafts(ctx context.Context) (entity.StaleDraftsReport, error)
 	GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error)
-	GetVersionsList(ctx context.Context, id uuid.UUID) ([]entity.Entity, error)
-	Create(ctx context.Context, req usecase.CreateEntityCmd) (uuid.UUID, error)
-	Update(ctx context.Context, req usecase.UpdateEntityCmd) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetVersionsList(ctx context.Context, req entity.GetVersionsReq) (entity.VersionsPage, error)
+	GetHistory(ctx context.Context, req entity.GetHistoryReq) (entity.History, error)
+	Merge(ctx context.Context, req entity.MergeReq) (entity.MergeResult, error)
+	Create(ctx context.Context, req usecase.CreateEntityCmd) (uuid.UUID, entity.ContentUsage, error)
+	Update(ctx context.Context, req usecase.UpdateEntityCmd) (entity.ContentUsage, error)
+	Delete(ctx context.Context, id uuid.UUID, dryRun bool) (entity.DeleteReport, error)
+	Lock(ctx context.Context, id uuid.UUID) (entity.Lock, error)
+	Unlock(ctx context.Context, id uuid.UUID) error
+	GetLock(ctx context.Context, id uuid.UUID) (entity.Lock, error)
+	Autosave(ctx context.Context, id uuid.UUID, content string) (entity.Autosave, error)
+	DiscardAutosave(ctx context.Context, id uuid.UUID) error
+	GetPopular(ctx context.Context, req entity.GetPopularReq) (entity.PopularReport, error)
+	List(ctx context.Context, req entity.ListReq) (entity.ListPage, error)
+	GetDrafts(ctx context.Context, req entity.DraftsReq) (entity.ListPage, error)
+	BatchGet(ctx context.Context, req entity.BatchGetReq) (entity.BatchGetResult, error)
+	TransferOwnership(ctx context.Context, id, ownerID uuid.UUID) error
+	UpdateAppearance(ctx context.Context, id uuid.UUID, req entity.UpdateAppearanceReq) error
+	AddRelation(ctx context.Context, id, relatedID uuid.UUID) error
+	DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error
+	GetOrphanedEntities(ctx context.Context) ([]entity.OrphanedEntity, error)
+	GetUnsafeMarkup(ctx context.Context) ([]entity.UnsafeMarkup, error)
+	CreateSnapshot(ctx context.Context, id uuid.UUID, req entity.CreateSnapshotReq) (entity.Snapshot, error)
+	GetSnapshots(ctx context.Context, id uuid.UUID) ([]entity.Snapshot, error)
+	GetSnapshot(ctx context.Context, id uuid.UUID, label string) (entity.SnapshotContent, error)
+	Manual(ctx context.Context, id uuid.UUID, w entity.ManualWriter) error
+	GetVariables(ctx context.Context, id uuid.UUID) ([]entity.Variable, error)
+	SetVariable(ctx context.Context, id uuid.UUID, key string, req entity.SetVariableReq) (entity.Variable, error)
+	DeleteVariable(ctx context.Context, id uuid.UUID, key string) error
+	GetVariants(ctx context.Context, id uuid.UUID) ([]entity.Variant, error)
+	ResolveVariant(ctx context.Context, id uuid.UUID, acceptLanguage string) uuid.UUID
+	SetLanguage(ctx context.Context, id uuid.UUID, req entity.SetLanguageReq) error
+	UnlinkVariant(ctx context.Context, id uuid.UUID) error
+	LinkVariant(ctx context.Context, id, variantID uuid.UUID, req entity.SetLanguageReq) error
+	CreateVariant(ctx context.Context, id uuid.UUID, cmd usecase.CreateVariantCmd) (uuid.UUID, entity.ContentUsage, error)
+	GetMissingTranslations(ctx context.Context) ([]entity.MissingTranslation, error)
+	GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error)
+	SetLintSettings(ctx context.Context, id uuid.UUID, req entity.LintSettings) error
 }
 
-func NewHandler(svc Service) *Handler {
+// NewHandler takes the sanitizer manuals are rendered with.
+func NewHandler(svc Service, sanitizer entity.HTMLSanitizer) *Handler {
 	if svc == nil {
 		panic("entity HTTP handler: nil service")
 	}
-	return &Handler{svc: svc}
+	if sanitizer == nil {
+		panic("entity HTTP handler: nil sanitizer")
+	}
+	return &Handler{svc: svc, sanitizer: sanitizer}
+}
+
+// WithPDFConverter enables manuals in PDF; without a converter they are HTML only.
+func (h *Handler) WithPDFConverter(conv PDFConverter) *Handler {
+	h.pdf = conv
+	return h
 }
 
 // GetTree godoc
 // @Summary      Get full entity tree
-// @Description  Returns the hierarchical tree of all permitted entities.
+// @Description  Returns the hierarchical tree of all permitted entities. Every node counts its direct children and all its descendants in the tree, and the caller's own drafts among them.
 // @Tags         entities
 // @Security     BearerAuth
 // @Produce      json
 // @Success      200 {object} entity.Tree
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /entities [get]
 func (h *Handler) GetTree(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -76,13 +223,18 @@ func (h *Handler) GetTree(w http.ResponseWriter, r *http.Request) {
 
 // Get godoc
 // @Summary      Get entity by ID
-// @Description  Returns a single entity by its ID. Requires read permission.
+// @Description  Returns a single entity by its ID. With render=html the content is returned as HTML, rendered from Markdown after the entities it includes with {{include <entity_id>}} that the user can read are resolved, its {{variables}} expanded and its markup sanitized. Requires read permission.
+// @Description  An entity with language variants is served in the variant the user can read that fits Accept-Language best, unless exact is set; Content-Language names the language served.
 // @Tags         entities
 // @Security     BearerAuth
 // @Produce      json
 // @Param        entity_id path string true "Entity ID"
+// @Param        render query string false "Content form" Enums(html)
+// @Param        exact query bool false "Return the entity itself rather than a variant in a preferred language"
+// @Param        Accept-Language header string false "Preferred languages"
 // @Success      200 {object} entity.Entity
-// @Failure      default {object} apperr.appError "Error"
+// @Header       200 {string} Content-Language "Language of the entity, if it has one"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /entities/{entity_id} [get]
 func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -97,27 +249,150 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ent, err := h.svc.Get(ctx, id)
+	render, err := entity.ParseRender(r.URL.Query().Get(QueryParamRender))
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(QueryParamRender, r.URL.Query().Get(QueryParamRender)).
+			Msg("entity.Handler.Get: invalid render")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	var exact bool
+	if v := r.URL.Query().Get(QueryParamExact); v != "" {
+		if exact, err = strconv.ParseBool(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamExact, v).
+				Msg("entity.Handler.Get: invalid exact")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+	if acceptLanguage := r.Header.Get(HeaderAcceptLanguage); acceptLanguage != "" && !exact {
+		id = h.svc.ResolveVariant(ctx, id, acceptLanguage)
+	}
+
+	var ent entity.Entity
+	if render == entity.RenderHTML {
+		ent, err = h.svc.GetRendered(ctx, id)
+	} else {
+		ent, err = h.svc.Get(ctx, id)
+	}
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
+	if ent.Language != "" {
+		w.Header().Set(HeaderContentLanguage, ent.Language)
+	}
 
 	httpx.WriteJSON(ctx, w, http.StatusOK, ent)
 }
 
-// GetVersion godoc
-// @Summary      Get specific entity version
-// @Description  Returns a specific version of an entity. Requires read permission.
+// GetBySlug godoc
+// @Summary      Get entity by slug
+// @Description  Returns a single entity by its slug. A former slug of a renamed entity answers with a
+// @Description  301 redirect to the current one. Requires read permission.
 // @Tags         entities
 // @Security     BearerAuth
 // @Produce      json
-// @Param        entity_id path string true "Entity ID"
-// @Param        version   path int    true "Version number"
+// @Param        slug path string true "Entity slug"
 // @Success      200 {object} entity.Entity
-// @Failure      default {object} apperr.appError "Error"
-// @Router       /entities/{entity_id}/versions/{version} [get]
-func (h *Handler) GetVersion(w http.ResponseWriter, r *http.Request) {
+// @Success      301 "Moved to the current slug, see Location"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/by-slug/{slug} [get]
+func (h *Handler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	slug, err := url.PathUnescape(chi.URLParam(r, URLParamSlug))
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldSlug.String(), chi.URLParam(r, URLParamSlug)).
+			Msg("entity.Handler.GetBySlug: invalid slug")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	ent, err := h.svc.GetBySlug(ctx, slug)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	if ent.Slug != slug {
+		path := r.URL.EscapedPath()
+		location := path[:strings.LastIndexByte(path, '/')+1] + url.PathEscape(ent.Slug)
+		http.Redirect(w, r, location, http.StatusMovedPermanently)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, ent)
+}
+
+// GetByPath godoc
+// @Summary      Get entity by path
+// @Description  Returns the entity at a path of slugs from the root, e.g. /entities/by-path/guides/install.
+// @Description  With entity.redirect_moved_paths a path the entity was moved or renamed away from answers with
+// @Description  301, a Location header and the current location in the body. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        path path string true "Slugs from the root, separated by /"
+// @Success      200 {object} entity.Entity
+// @Success      301 {object} entity.MovedLocation "Moved, see Location"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/by-path/{path} [get]
+func (h *Handler) GetByPath(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	raw := chi.URLParam(r, URLParamPath)
+	path := strings.Split(raw, "/")
+	for i, segment := range path {
+		slug, err := url.PathUnescape(segment)
+		if err != nil {
+			logger.Warn(ctx, err).
+				Str(entity.FieldSlug.String(), raw).
+				Msg("entity.Handler.GetByPath: invalid path")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+		path[i] = slug
+	}
+
+	ent, res, err := h.svc.GetByPath(ctx, path)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	if res.Moved {
+		escaped := make([]string, len(res.Path))
+		for i, slug := range res.Path {
+			escaped[i] = url.PathEscape(slug)
+		}
+		// the route has no parameters before the wildcard, so its pattern is the path prefix
+		prefix := strings.TrimSuffix(chi.RouteContext(ctx).RoutePattern(), URLParamPath)
+		location := prefix + strings.Join(escaped, "/")
+
+		w.Header().Set("Location", location)
+		httpx.WriteJSON(ctx, w, http.StatusMovedPermanently, entity.MovedLocation{
+			EntityID:   res.ID,
+			Location:   location,
+			ParentPath: res.Path[:len(res.Path)-1],
+		})
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, ent)
+}
+
+// GetMeta godoc
+// @Summary      Get entity metadata
+// @Description  Returns word count, estimated reading time, version count, children count, views and the most recent editors. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {object} entity.Meta
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/meta [get]
+func (h *Handler) GetMeta(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	idStr := chi.URLParam(r, URLParamEntityID)
@@ -125,42 +400,68 @@ func (h *Handler) GetVersion(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Warn(ctx, err).
 			Str(entity.FieldEntityID.String(), idStr).
-			Msg("entity.Handler.GetVersion: invalid entity ID format")
+			Msg("entity.Handler.GetMeta: invalid entity ID format")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	versionStr := chi.URLParam(r, URLParamVersion)
-	version, err := strconv.Atoi(versionStr)
+	meta, err := h.svc.GetMeta(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, meta)
+}
+
+// GetContributors godoc
+// @Summary      Get entity contributors
+// @Description  Returns the users who authored any version of the entity with their version counts and last contribution time, most recent first. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.Contributor
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/contributors [get]
+func (h *Handler) GetContributors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		logger.Warn(ctx, err).
 			Str(entity.FieldEntityID.String(), idStr).
-			Str(entity.FieldVersion.String(), versionStr).
-			Msg("entity.Handler.GetVersion: invalid version format")
+			Msg("entity.Handler.GetContributors: invalid entity ID format")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	ent, err := h.svc.GetVersion(ctx, id, version)
+	contributors, err := h.svc.GetContributors(ctx, id)
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
 
-	httpx.WriteJSON(ctx, w, http.StatusOK, ent)
+	httpx.WriteJSON(ctx, w, http.StatusOK, contributors)
 }
 
-// GetVersionsList godoc
-// @Summary      List entity versions
-// @Description  Returns list of all versions for an entity. Requires read permission.
+// GetActivity godoc
+// @Summary      Get entity activity
+// @Description  Returns the changes of the entity and its descendants (created, edited, moved, deleted), newest first.
+// @Description  Pass next_cursor of a page as before to get the next one. Drafts of other users are skipped for non-admins. Requires read permission.
+// @Description  Minor edits are skipped unless include_minor is set.
 // @Tags         entities
 // @Security     BearerAuth
 // @Produce      json
 // @Param        entity_id path string true "Entity ID"
-// @Success      200 {array} entity.Entity
-// @Failure      default {object} apperr.appError "Error"
-// @Router       /entities/{entity_id}/versions [get]
-func (h *Handler) GetVersionsList(w http.ResponseWriter, r *http.Request) {
+// @Param        before query string false "Cursor: next_cursor of the previous page"
+// @Param        limit query int false "Maximum number of events, up to 100" default(50)
+// @Param        include_minor query bool false "Include edits saved as minor edits"
+// @Success      200 {object} entity.Activity
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/activity [get]
+func (h *Handler) GetActivity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	idStr := chi.URLParam(r, URLParamEntityID)
@@ -168,65 +469,322 @@ func (h *Handler) GetVersionsList(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Warn(ctx, err).
 			Str(entity.FieldEntityID.String(), idStr).
-			Msg("entity.Handler.GetVersionsList: invalid entity ID format")
+			Msg("entity.Handler.GetActivity: invalid entity ID format")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	versions, err := h.svc.GetVersionsList(ctx, id)
+	req := entity.GetActivityReq{ID: id, Before: r.URL.Query().Get(QueryParamBefore), Limit: defaultActivityLimit}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("entity.Handler.GetActivity: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+	if v := r.URL.Query().Get(QueryParamIncludeMinor); v != "" {
+		if req.IncludeMinor, err = strconv.ParseBool(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamIncludeMinor, v).
+				Msg("entity.Handler.GetActivity: invalid include_minor")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	activity, err := h.svc.GetActivity(ctx, req)
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
 
-	httpx.WriteJSON(ctx, w, http.StatusOK, versions)
+	httpx.WriteJSON(ctx, w, http.StatusOK, activity)
 }
 
-// Create godoc
-// @Summary      Create entity
-// @Description  Creates a new entity. Requires write permission for the parent entity. if root entity, requires admin role.
+// GetPopular godoc
+// @Summary      Get popular entities
+// @Description  Returns the most read entities of the workspace, by views over the period. A user reading an entity several times in one session counts once. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        period query string false "Days or hours to look back, such as 30d or 12h, up to 365d" default(30d)
+// @Param        limit query int false "Maximum number of entities, up to 100" default(20)
+// @Success      200 {object} entity.PopularReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /reports/popular [get]
+func (h *Handler) GetPopular(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	period := entity.DefaultPopularPeriod
+	if v := r.URL.Query().Get(QueryParamPeriod); v != "" {
+		period = v
+	}
+	req := entity.GetPopularReq{Limit: entity.DefaultPopularLimit}
+	var err error
+	if req.Period, err = entity.ParsePeriod(period); err != nil {
+		logger.Warn(ctx, err).Str(QueryParamPeriod, period).
+			Msg("entity.Handler.GetPopular: invalid period")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("entity.Handler.GetPopular: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	report, err := h.svc.GetPopular(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// BatchGet godoc
+// @Summary      Get entities by ID
+// @Description  Looks up to 100 entities at once, e.g. for link previews, with one permission check. Returns an item per distinct ID in the order requested: its metadata, or the whole entity with include_content, or the problem that kept it out (entity/not_found, core/forbidden). Views are not counted.
 // @Tags         entities
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        request body usecase.CreateEntityCmd true "Create entity payload"
-// @Success      201 {object} CreateEntityResp
-// @Failure      default {object} apperr.appError "Error"
-// @Router       /entities [post]
-func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+// @Param        request body entity.BatchGetReq true "Entity IDs"
+// @Success      200 {object} entity.BatchGetResult
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/batch-get [post]
+func (h *Handler) BatchGet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var cmd usecase.CreateEntityCmd
-	if err := httpx.DecodeJSON(r, &cmd); err != nil {
+	var req entity.BatchGetReq
+	if err := httpx.DecodeJSON(r, &req); err != nil {
 		logger.Error(ctx, err).
-			Msg("entity.Handler.Create.DecodeJSON")
+			Msg("entity.Handler.BatchGet: failed to decode JSON")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	id, err := h.svc.Create(ctx, cmd)
+	result, err := h.svc.BatchGet(ctx, req)
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
+	for _, item := range result.Items {
+		if item.Error != nil {
+			i18n.LocalizeProblem(ctx, item.Error)
+		}
+	}
 
-	w.Header().Set("Location", "/entities/"+id.String())
+	httpx.WriteJSON(ctx, w, http.StatusOK, result)
+}
 
-	httpx.WriteJSON(ctx, w, http.StatusCreated, CreateEntityResp{ID: id})
+// List godoc
+// @Summary      List entities
+// @Description  Returns a flat page of the entities the caller can read, each with its breadcrumbs from the root and the number of its children and descendants the caller can read.
+// @Description  Pass next_cursor of a page as after, with the same filters and order, to get the next one.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        type query string false "Entity type" Enums(article, department)
+// @Param        parent_id query string false "List only the entities below this one, at any depth"
+// @Param        updated_since query string false "List only the entities updated at or after this RFC 3339 time"
+// @Param        author_id query string false "List only the entities created by this user"
+// @Param        status query string false "List only drafts or only published entities" Enums(draft, published)
+// @Param        sort query string false "Field to order by" Enums(name, updated_at, created_at) default(name)
+// @Param        order query string false "Order direction" Enums(asc, desc) default(asc)
+// @Param        after query string false "Cursor: return entities after this position"
+// @Param        limit query int false "Maximum number of entities, up to 100" default(50)
+// @Success      200 {object} entity.ListPage
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/list [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := parseListReq(r)
+	if err != nil {
+		logger.Warn(ctx, err).Str("query", r.URL.RawQuery).
+			Msg("entity.Handler.List: invalid query")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	page, err := h.svc.List(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, page)
 }
 
-// Update godoc
-// @Summary      Update entity
-// @Description  Updates an existing entity. Requires write permission. If changes parent, requires write permission for the new and old parents as well.
+// parseListReq reads the query of a List request. Values are checked for their format only, the core
+// validates them.
+func parseListReq(r *http.Request) (entity.ListReq, error) {
+	query := r.URL.Query()
+	req := entity.ListReq{
+		Type:   entity.Type(query.Get(QueryParamType)),
+		Status: entity.ListStatus(query.Get(QueryParamStatus)),
+		Sort:   entity.ListSort(query.Get(QueryParamSort)),
+		After:  query.Get(QueryParamAfter),
+		Limit:  entity.DefaultListLimit,
+	}
+	parseID := func(param string) (*uuid.UUID, error) {
+		v := query.Get(param)
+		if v == "" {
+			return nil, nil
+		}
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", param, err)
+		}
+		return &id, nil
+	}
+
+	var err error
+	if req.ParentID, err = parseID(QueryParamParentID); err != nil {
+		return entity.ListReq{}, err
+	}
+	if req.AuthorID, err = parseID(QueryParamAuthorID); err != nil {
+		return entity.ListReq{}, err
+	}
+	if v := query.Get(QueryParamUpdatedSince); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return entity.ListReq{}, fmt.Errorf("%s: %w", QueryParamUpdatedSince, err)
+		}
+		req.UpdatedSince = &since
+	}
+	switch v := query.Get(QueryParamOrder); v {
+	case "", "asc":
+	case "desc":
+		req.Desc = true
+	default:
+		return entity.ListReq{}, fmt.Errorf("%s: unknown order %q", QueryParamOrder, v)
+	}
+	if v := query.Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			return entity.ListReq{}, fmt.Errorf("%s: %w", QueryParamLimit, err)
+		}
+	}
+
+	return req, nil
+}
+
+// GetDrafts godoc
+// @Summary      List my drafts
+// @Description  Returns a page of the caller's own drafts across the tree that they can still read, most recently edited first, each with its breadcrumbs from the root.
+// @Description  Pass next_cursor of a page as after to get the next one.
 // @Tags         entities
 // @Security     BearerAuth
-// @Accept       json
+// @Produce      json
+// @Param        after query string false "Cursor: return drafts after this position"
+// @Param        limit query int false "Maximum number of drafts, up to 100" default(50)
+// @Success      200 {object} entity.ListPage
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /drafts [get]
+func (h *Handler) GetDrafts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := entity.DraftsReq{After: r.URL.Query().Get(QueryParamAfter), Limit: entity.DefaultListLimit}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			logger.Warn(ctx, err).Str("query", r.URL.RawQuery).
+				Msg("entity.Handler.GetDrafts: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+		req.Limit = limit
+	}
+
+	page, err := h.svc.GetDrafts(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, page)
+}
+
+// GetBacklinks godoc
+// @Summary      Get entity backlinks
+// @Description  Returns readable entities whose content links to this one, via [[entity_id]], an /entities/{entity_id} URL or {{include entity_id}}. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
 // @Param        entity_id path string true "Entity ID"
-// @Param        request body UpdateEntityInput true "Update entity payload"
+// @Success      200 {array} entity.ListItem
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/backlinks [get]
+func (h *Handler) GetBacklinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetBacklinks: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	items, err := h.svc.GetBacklinks(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, items)
+}
+
+// GetChildren godoc
+// @Summary      Get entity children
+// @Description  Returns the direct children of the entity in sibling order: those given a place by PATCH /entities/{entity_id}/children/order first, then the others by name. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.ListItem
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/children [get]
+func (h *Handler) GetChildren(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetChildren: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	items, err := h.svc.GetChildren(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, items)
+}
+
+// ReorderChildren godoc
+// @Summary      Order entity children
+// @Description  Puts the listed children first, in the given order but after the pinned ones, in the tree and the children list; the children left out follow by name. Every ID must be a live child of the entity. A child moved to another parent loses its place. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Parent entity ID"
+// @Param        request body entity.ChildrenOrderReq true "Ordered child IDs"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
-// @Router       /entities/{entity_id} [put]
-func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/children/order [patch]
+func (h *Handler) ReorderChildren(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	idStr := chi.URLParam(r, URLParamEntityID)
@@ -234,26 +792,20 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Warn(ctx, err).
 			Str(entity.FieldEntityID.String(), idStr).
-			Msg("entity.Handler.Update: invalid entity ID format")
+			Msg("entity.Handler.ReorderChildren: invalid entity ID format")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	var input UpdateEntityInput
-	if err = httpx.DecodeJSON(r, &input); err != nil {
+	var req entity.ChildrenOrderReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
 		logger.Error(ctx, err).
-			Msg("entity.Handler.Update: failed to decode JSON")
+			Msg("entity.Handler.ReorderChildren: failed to decode JSON")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	if err = h.svc.Update(ctx, usecase.UpdateEntityCmd{
-		ID:       id,
-		Name:     input.Name,
-		Content:  input.Content,
-		ParentID: input.ParentID,
-		IsDraft:  input.IsDraft,
-	}); err != nil {
+	if err = h.svc.ReorderChildren(ctx, id, req); err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
@@ -261,16 +813,36 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// Delete godoc
-// @Summary      Delete entity
-// @Description  Deletes an entity by ID. Requires write permission for the entity.
+// PinChild godoc
+// @Summary      Pin a child
+// @Description  Pins the child above its siblings, before the ordered ones, in the tree, the children list and outlines. A parent holds up to entity.max_pinned_children pinned children; a child moved to another parent is unpinned. Requires write permission for the parent.
 // @Tags         entities
 // @Security     BearerAuth
-// @Param        entity_id path string true "Entity ID"
+// @Param        entity_id path string true "Parent entity ID"
+// @Param        child_id path string true "Child entity ID"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
-// @Router       /entities/{entity_id} [delete]
-func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+// @Failure      409 {object} apperr.Problem "Pin limit reached"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/children/{child_id}/pin [put]
+func (h *Handler) PinChild(w http.ResponseWriter, r *http.Request) {
+	h.setPinned(w, r, true)
+}
+
+// UnpinChild godoc
+// @Summary      Unpin a child
+// @Description  Returns the child to its place among its siblings. Requires write permission for the parent.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Parent entity ID"
+// @Param        child_id path string true "Child entity ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/children/{child_id}/pin [delete]
+func (h *Handler) UnpinChild(w http.ResponseWriter, r *http.Request) {
+	h.setPinned(w, r, false)
+}
+
+func (h *Handler) setPinned(w http.ResponseWriter, r *http.Request, pinned bool) {
 	ctx := r.Context()
 
 	idStr := chi.URLParam(r, URLParamEntityID)
@@ -278,15 +850,1814 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logger.Warn(ctx, err).
 			Str(entity.FieldEntityID.String(), idStr).
-			Msg("entity.Handler.Delete: invalid entity ID format")
+			Msg("entity.Handler.setPinned: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	childStr := chi.URLParam(r, URLParamChildID)
+	childID, err := uuid.Parse(childStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldChildID.String(), childStr).
+			Msg("entity.Handler.setPinned: invalid child ID format")
 		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
 		return
 	}
 
-	if err = h.svc.Delete(ctx, id); err != nil {
+	if err = h.svc.SetPinned(ctx, id, childID, pinned); err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// GetTOC godoc
+// @Summary      Get entity outline
+// @Description  Returns the entity and its readable descendants as a nested outline, children in sibling order, each with the section headings of its content: Markdown (# and underlined) and HTML <h1>-<h6> headings outside code blocks, nested by level, with an anchor each. For navigation and printable manuals. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {object} entity.TOCNode
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/toc [get]
+func (h *Handler) GetTOC(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetTOC: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	toc, err := h.svc.GetTOC(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, toc)
+}
+
+// GetManual godoc
+// @Summary      Get entity manual
+// @Description  Streams the entity and its readable descendants as one document: the entity as the title, a table of contents, then the descendants depth-first as chapters numbered like 2.1, with their content rendered from Markdown. Links to entities of the manual point to their chapter. format is html, the default, or pdf when a converter is configured. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      text/html
+// @Produce      application/pdf
+// @Param        entity_id path string true "Entity ID"
+// @Param        format query string false "Document format" Enums(html, pdf)
+// @Success      200 {file} file
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/manual [get]
+func (h *Handler) GetManual(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetManual: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	format, err := entity.ParseManualFormat(r.URL.Query().Get(QueryParamFormat))
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(QueryParamFormat, r.URL.Query().Get(QueryParamFormat)).
+			Msg("entity.Handler.GetManual: invalid format")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	resp := &manualResponse{w: w, rc: http.NewResponseController(w), format: format}
+	html := func(out io.Writer) error {
+		m := entity.NewHTMLManual(out, h.sanitizer)
+		if err := h.svc.Manual(ctx, id, m); err != nil {
+			return err
+		}
+		return m.Close()
+	}
+	switch format {
+	case entity.ManualFormatPDF:
+		if h.pdf == nil {
+			err = entity.ErrPDFUnavailable()
+			break
+		}
+		err = h.pdf.Convert(ctx, resp, html)
+	default:
+		err = html(resp)
+	}
+	if err != nil {
+		// as for export, a response already started can only be cut short
+		if resp.started {
+			panic(http.ErrAbortHandler)
+		}
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+}
+
+// manualResponse sends the headers of a manual with its first bytes, so errors before them still get
+// a problem response, and flushes every write.
+type manualResponse struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	format  entity.ManualFormat
+	started bool
+}
+
+func (m *manualResponse) Write(p []byte) (int, error) {
+	// not every writer supports deadlines, such writers have no timeout to extend
+	_ = m.rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+	if !m.started {
+		contentType := "text/html; charset=utf-8"
+		if m.format == entity.ManualFormatPDF {
+			contentType = "application/pdf"
+		}
+		m.w.Header().Set("Content-Type", contentType)
+		m.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="manual.%s"`, m.format))
+		m.w.WriteHeader(http.StatusOK)
+		m.started = true
+	}
+	n, err := m.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+
+	return n, m.rc.Flush()
+}
+
+// CreateSnapshot godoc
+// @Summary      Create entity snapshot
+// @Description  Captures the current version of the entity and of its published descendants under a label, such as a product release. Drafts and the entities below them are left out. Captured versions are kept by retention. Labels are unique per entity and may contain letters, digits, dots, dashes and underscores. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body entity.CreateSnapshotReq true "Snapshot label"
+// @Success      201 {object} entity.Snapshot
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/snapshots [post]
+func (h *Handler) CreateSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.CreateSnapshot: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.CreateSnapshotReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.CreateSnapshot: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	snapshot, err := h.svc.CreateSnapshot(ctx, id, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, snapshot)
+}
+
+// GetSnapshots godoc
+// @Summary      List entity snapshots
+// @Description  Returns the snapshots taken of the entity, newest first, with how many entities each captured. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.Snapshot
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/snapshots [get]
+func (h *Handler) GetSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetSnapshots: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	snapshots, err := h.svc.GetSnapshots(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, snapshots)
+}
+
+// GetSnapshot godoc
+// @Summary      Get entity snapshot
+// @Description  Returns the subtree of the entity as of the snapshot with the label: every captured entity at its captured version, with its name, parent and content at that version, parents before their children. Entities deleted since are included and flagged. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        label path string true "Snapshot label"
+// @Success      200 {object} entity.SnapshotContent
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/snapshots/{label} [get]
+func (h *Handler) GetSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetSnapshot: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	snapshot, err := h.svc.GetSnapshot(ctx, id, chi.URLParam(r, URLParamLabel))
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, snapshot)
+}
+
+// GetVariables godoc
+// @Summary      Get entity variables
+// @Description  Returns the variables content of the entity can refer to as {{key}}, by key: those it defines and those it inherits from the nearest ancestor defining them, see entity_id of each. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.Variable
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variables [get]
+func (h *Handler) GetVariables(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetVariables: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	vars, err := h.svc.GetVariables(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, vars)
+}
+
+// SetVariable godoc
+// @Summary      Set entity variable
+// @Description  Defines or changes a variable for the entity and its descendants, overriding one of the same key inherited from an ancestor. Values may refer to other variables. Keys start with a letter or _ and may contain letters, digits, dots, dashes and underscores. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        key path string true "Variable key"
+// @Param        request body entity.SetVariableReq true "Variable value"
+// @Success      200 {object} entity.Variable
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variables/{key} [put]
+func (h *Handler) SetVariable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.SetVariable: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.SetVariableReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.SetVariable: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	v, err := h.svc.SetVariable(ctx, id, chi.URLParam(r, URLParamKey), req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, v)
+}
+
+// DeleteVariable godoc
+// @Summary      Delete entity variable
+// @Description  Removes a variable the entity defines; descendants then inherit the key from an ancestor, if one defines it. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Param        key path string true "Variable key"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variables/{key} [delete]
+func (h *Handler) DeleteVariable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.DeleteVariable: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.DeleteVariable(ctx, id, chi.URLParam(r, URLParamKey)); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetVariants godoc
+// @Summary      Get entity language variants
+// @Description  Returns the language variants of the entity the user can read, itself included, by language; none if the entity has no language. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.Variant
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variants [get]
+func (h *Handler) GetVariants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetVariants: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	variants, err := h.svc.GetVariants(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, variants)
+}
+
+// CreateVariant godoc
+// @Summary      Create entity language variant
+// @Description  Creates a translation of the entity next to it, with the same type, and links it as the variant in the given language. The entity must have a language. Requires write permission for the entity and its parent.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body usecase.CreateVariantCmd true "Variant payload"
+// @Success      201 {object} CreateEntityResp
+// @Header       201 {string} X-Content-Size-Warning "Content length and limit in bytes, set when the content is close to the limit"
+// @Header       201 {string} X-Broken-Links "Comma-separated IDs of linked entities that do not exist or were deleted"
+// @Failure      404 {object} apperr.Problem "Entity has no language"
+// @Failure      409 {object} apperr.Problem "Language variant already exists"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variants [post]
+func (h *Handler) CreateVariant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.CreateVariant: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var cmd usecase.CreateVariantCmd
+	if err = httpx.DecodeJSON(r, &cmd); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.CreateVariant: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	variantID, usage, err := h.svc.CreateVariant(ctx, id, cmd)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/entities/"+variantID.String())
+	setContentWarnings(w, usage)
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, CreateEntityResp{ID: variantID})
+}
+
+// PutVariant godoc
+// @Summary      Link entity language variant
+// @Description  Makes an existing entity the variant of the entity in the given language, replacing a language it had. The entity must have a language, and the variant may not belong to another document. Requires write permission for both entities.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        variant_id path string true "Variant entity ID"
+// @Param        request body entity.SetLanguageReq true "Language of the variant"
+// @Success      204 "No Content"
+// @Failure      404 {object} apperr.Problem "Entity has no language"
+// @Failure      409 {object} apperr.Problem "Language variant already exists"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/variants/{variant_id} [put]
+func (h *Handler) PutVariant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.PutVariant: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	variantStr := chi.URLParam(r, URLParamVariantID)
+	variantID, err := uuid.Parse(variantStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldVariant.String(), variantStr).
+			Msg("entity.Handler.PutVariant: invalid variant ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.SetLanguageReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.PutVariant: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.LinkVariant(ctx, id, variantID, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetLanguage godoc
+// @Summary      Set entity language
+// @Description  Sets the language of the entity. An entity without variants starts a document of its own that variants can be linked to; with variants, the new language may not be taken by one of them. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body entity.SetLanguageReq true "Language"
+// @Success      204 "No Content"
+// @Failure      409 {object} apperr.Problem "Language variant already exists"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/language [put]
+func (h *Handler) SetLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.SetLanguage: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.SetLanguageReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.SetLanguage: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.SetLanguage(ctx, id, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteLanguage godoc
+// @Summary      Remove entity language
+// @Description  Removes the language of the entity, which unlinks it from its variants; they stay linked to each other. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Success      204 "No Content"
+// @Failure      404 {object} apperr.Problem "Entity has no language"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/language [delete]
+func (h *Handler) DeleteLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.DeleteLanguage: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.UnlinkVariant(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDefaultPermissions godoc
+// @Summary      Get entity default permissions
+// @Description  Returns the roles granted to users on every entity created below the entity. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {array} entity.DefaultPermission
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/default-permissions [get]
+func (h *Handler) GetDefaultPermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetDefaultPermissions: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	permissions, err := h.svc.GetDefaultPermissions(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, permissions)
+}
+
+// SetDefaultPermissions godoc
+// @Summary      Set entity default permissions
+// @Description  Replaces the default permissions of the entity. When an entity is created below it, at any depth, each user is granted the read or write role on the new entity, unless an ancestor grant already gives it; the policies of all ancestors apply. Entities created before keep their grants. An empty list removes the policy. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body entity.SetDefaultPermissionsReq true "Default permissions"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/default-permissions [put]
+func (h *Handler) SetDefaultPermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.SetDefaultPermissions: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.SetDefaultPermissionsReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.SetDefaultPermissions: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.SetDefaultPermissions(ctx, id, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Export godoc
+// @Summary      Export entity subtree
+// @Description  Streams the entity and its readable descendants as JSON Lines, one entity per line, parents before their children. With render=html the content is exported as HTML, as for GET /entities/{entity_id}. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      application/x-ndjson
+// @Param        entity_id path string true "Entity ID"
+// @Param        render query string false "Content form" Enums(html)
+// @Success      200 {array} entity.ExportItem
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/export [get]
+func (h *Handler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Export: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	h.export(w, r, &id)
+}
+
+// ExportAll godoc
+// @Summary      Export workspace
+// @Description  Streams every entity of the workspace as JSON Lines, one entity per line, parents before their children. With render=html the content is exported as HTML, as for GET /entities/{entity_id}. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      application/x-ndjson
+// @Param        render query string false "Content form" Enums(html)
+// @Success      200 {array} entity.ExportItem
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/export [get]
+func (h *Handler) ExportAll(w http.ResponseWriter, r *http.Request) {
+	h.export(w, r, nil)
+}
+
+// export writes and flushes the items page by page. Errors before the first page get a problem response;
+// once the status is sent, the connection is aborted instead, so the client sees a truncated body.
+func (h *Handler) export(w http.ResponseWriter, r *http.Request, rootID *uuid.UUID) {
+	ctx := r.Context()
+
+	render, err := entity.ParseRender(r.URL.Query().Get(QueryParamRender))
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(QueryParamRender, r.URL.Query().Get(QueryParamRender)).
+			Msg("entity.Handler.export: invalid render")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	enc := json.NewEncoder(w)
+	started := false
+	err = h.svc.Export(ctx, rootID, render, func(items []entity.ExportItem) error {
+		// not every writer supports deadlines, such writers have no timeout to extend
+		_ = rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+		if !started {
+			writeExportHeader(w)
+			started = true
+		}
+		for _, item := range items {
+			if err := enc.Encode(item); err != nil {
+				return err
+			}
+		}
+
+		return rc.Flush()
+	})
+	if err != nil {
+		if started {
+			panic(http.ErrAbortHandler)
+		}
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	if !started {
+		writeExportHeader(w)
+	}
+}
+
+func writeExportHeader(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="export.jsonl"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetBrokenLinks godoc
+// @Summary      Get broken links report
+// @Description  Returns links from entities to entities that do not exist or were deleted. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} entity.BrokenLink
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/broken-links [get]
+func (h *Handler) GetBrokenLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	links, err := h.svc.GetBrokenLinks(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, links)
+}
+
+// GetMissingTranslations godoc
+// @Summary      Get missing translations report
+// @Description  Returns the documents lacking a variant in one of the workspace languages, entity.languages, or, if none are configured, in one of the languages variants are written in. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} entity.MissingTranslation
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/missing-translations [get]
+func (h *Handler) GetMissingTranslations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := h.svc.GetMissingTranslations(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// UpdateAppearance godoc
+// @Summary      Update entity appearance
+// @Description  Sets the icon and cover image shown in navigation, in the entity, list and tree payloads; omitted fields are left unchanged and an empty string removes one. The icon is an emoji or the key of an attachment stored in the workspace, which always contains a slash; the cover is the key of such an attachment or an http or https image URL. The payloads return icon_url and cover_url to download them from, /attachments/{key} for attachments. Neither is versioned. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body entity.UpdateAppearanceReq true "Appearance"
+// @Success      204 "No Content"
+// @Failure      400 {object} apperr.Problem "Invalid icon or cover, or no attachment stored under the key"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/appearance [patch]
+func (h *Handler) UpdateAppearance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.UpdateAppearance: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.UpdateAppearanceReq
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.UpdateAppearance: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.UpdateAppearance(ctx, id, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLintReport godoc
+// @Summary      Get lint findings
+// @Description  Returns what the content linter found in a version of the entity, the current one by default. Versions are linted in the background shortly after they are saved, so a new version is pending at first; drafts are not linted. Findings are ranked error, warning or info as configured. The status is disabled when linting is turned off for the entity or an ancestor. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        version query int false "Version, the current one if omitted"
+// @Success      200 {object} entity.LintReport
+// @Failure      404 {object} apperr.Problem "Entity or version not found"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/lint [get]
+func (h *Handler) GetLintReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetLintReport: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	var version int
+	if v := r.URL.Query().Get(QueryParamVersion); v != "" {
+		if version, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamVersion, v).
+				Msg("entity.Handler.GetLintReport: invalid version")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	report, err := h.svc.GetLintReport(ctx, id, version)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// SetLintSettings godoc
+// @Summary      Set lint settings
+// @Description  Disables or enables content linting for the entity and all its descendants. Findings already stored are kept but not shown while linting is disabled. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body entity.LintSettings true "Lint settings"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/lint/settings [put]
+func (h *Handler) SetLintSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.SetLintSettings: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.LintSettings
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.SetLintSettings: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.SetLintSettings(ctx, id, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSpaceSettings godoc
+// @Summary      Get space settings
+// @Description  Returns the settings of the space the root entity opens: default language, review requirements, public listing and retention. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Root entity ID"
+// @Success      200 {object} entity.Space
+// @Failure      400 {object} apperr.Problem "Entity has a parent"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/settings [get]
+func (h *Handler) GetSpaceSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetSpaceSettings: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	space, err := h.svc.GetSpace(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, space)
+}
+
+// SetSpaceSettings godoc
+// @Summary      Set space settings
+// @Description  Replaces the settings of the space the root entity opens. Requires admin role or ownership of the root.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Root entity ID"
+// @Param        request body entity.SpaceSettings true "Space settings"
+// @Success      200 {object} entity.SpaceSettings
+// @Failure      400 {object} apperr.Problem "Entity has a parent or the settings are invalid"
+// @Failure      403 {object} apperr.Problem "Neither admin nor owner"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/settings [put]
+func (h *Handler) SetSpaceSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.SetSpaceSettings: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var req entity.SpaceSettings
+	if err = httpx.DecodeJSON(r, &req); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.SetSpaceSettings: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	settings, err := h.svc.SetSpaceSettings(ctx, id, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, settings)
+}
+
+// GetOrphanedEntities godoc
+// @Summary      Get orphaned entities report
+// @Description  Returns live entities whose owner was deleted, so ownership can be transferred. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} entity.OrphanedEntity
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/orphaned [get]
+func (h *Handler) GetOrphanedEntities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	entities, err := h.svc.GetOrphanedEntities(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, entities)
+}
+
+// GetUnsafeMarkup godoc
+// @Summary      Get unsafe markup report
+// @Description  Returns entities whose stored content holds markup the sanitize policy removes when it is exported or served, such as scripts, event handlers or javascript: links. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} entity.UnsafeMarkup
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/unsafe-markup [get]
+func (h *Handler) GetUnsafeMarkup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := h.svc.GetUnsafeMarkup(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// PreviewRetention godoc
+// @Summary      Preview version retention
+// @Description  Dry run of the version retention policy: lists versions the scheduled pruning would delete. Current versions are never deleted. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} entity.RetentionReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/retention/preview [get]
+func (h *Handler) PreviewRetention(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := h.svc.PreviewRetention(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// PreviewTrashPurge godoc
+// @Summary      Preview trash purge
+// @Description  Dry run of the trash purge: lists deleted entities past entity.trash.retention_days with the bytes
+// @Description  their content and versions would free. Entities with a live descendant are kept. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} entity.TrashReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/trash/preview [get]
+func (h *Handler) PreviewTrashPurge(w http.ResponseWriter, r *http.Request) {
+	h.purgeTrash(w, r, true)
+}
+
+// PurgeTrash godoc
+// @Summary      Purge trash now
+// @Description  Hard-deletes deleted entities past entity.trash.retention_days (all of them when it is 0) with their
+// @Description  versions, without waiting for the scheduled purge. With dry_run it only reports them, like the preview.
+// @Description  Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        dry_run query bool false "Only report the entities that would be purged"
+// @Success      200 {object} entity.TrashReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/trash/purge [post]
+func (h *Handler) PurgeTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dryRun, err := parseDryRun(r)
+	if err != nil {
+		logger.Warn(ctx, err).Str(QueryParamDryRun, r.URL.Query().Get(QueryParamDryRun)).
+			Msg("entity.Handler.PurgeTrash: invalid dry_run")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	h.purgeTrash(w, r, dryRun)
+}
+
+func (h *Handler) purgeTrash(w http.ResponseWriter, r *http.Request, dryRun bool) {
+	ctx := r.Context()
+
+	report, err := h.svc.PurgeTrash(ctx, dryRun)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// PreviewStaleDrafts godoc
+// @Summary      Preview stale draft cleanup
+// @Description  Dry run of the stale draft policy (entity.stale_drafts): lists the drafts whose creators would be
+// @Description  reminded now and those that would be archived or deleted. Drafts with children and drafts of users
+// @Description  who keep stale drafts in their preferences are left out. Requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} entity.StaleDraftsReport
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/stale-drafts/preview [get]
+func (h *Handler) PreviewStaleDrafts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := h.svc.PreviewStaleDrafts(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, report)
+}
+
+// GetVersion godoc
+// @Summary      Get specific entity version
+// @Description  Returns a specific version of an entity. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        version   path int    true "Version number"
+// @Success      200 {object} entity.Entity
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/versions/{version} [get]
+func (h *Handler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetVersion: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	versionStr := chi.URLParam(r, URLParamVersion)
+	version, err := strconv.Atoi(versionStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Str(entity.FieldVersion.String(), versionStr).
+			Msg("entity.Handler.GetVersion: invalid version format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	ent, err := h.svc.GetVersion(ctx, id, version)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, ent)
+}
+
+// GetVersionContent godoc
+// @Summary      Get entity version content
+// @Description  Returns the content of a specific version of an entity as it was stored. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      plain
+// @Param        entity_id path string true "Entity ID"
+// @Param        version   path int    true "Version number"
+// @Success      200 {string} string "Version content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/versions/{version}/content [get]
+func (h *Handler) GetVersionContent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetVersionContent: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	versionStr := chi.URLParam(r, URLParamVersion)
+	version, err := strconv.Atoi(versionStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Str(entity.FieldVersion.String(), versionStr).
+			Msg("entity.Handler.GetVersionContent: invalid version format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	ent, err := h.svc.GetVersion(ctx, id, version)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write([]byte(ent.Content)); err != nil {
+		logger.Error(ctx, err).Msg("entity.Handler.GetVersionContent: write")
+	}
+}
+
+// GetVersionsList godoc
+// @Summary      List entity versions
+// @Description  Returns the versions of an entity, newest first, without their content unless include_content is set.
+// @Description  Pass next_cursor of a page as before to get the next one. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        before query string false "Cursor: next_cursor of the previous page"
+// @Param        limit query int false "Maximum number of versions, up to 100" default(50)
+// @Param        include_content query bool false "Return the content of every version"
+// @Success      200 {object} entity.VersionsPage
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/versions [get]
+func (h *Handler) GetVersionsList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetVersionsList: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	req := entity.GetVersionsReq{ID: id, Before: r.URL.Query().Get(QueryParamBefore), Limit: defaultVersionsLimit}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("entity.Handler.GetVersionsList: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+	if v := r.URL.Query().Get(QueryParamIncludeContent); v != "" {
+		if req.IncludeContent, err = strconv.ParseBool(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamIncludeContent, v).
+				Msg("entity.Handler.GetVersionsList: invalid include_content")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	page, err := h.svc.GetVersionsList(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, page)
+}
+
+// GetHistory godoc
+// @Summary      Get entity history
+// @Description  Returns the versions of an entity, without content, merged with its moves, permission changes and
+// @Description  other events, newest first. The type of an item tells whether version or event is set. Pass
+// @Description  next_cursor of a page as before to get the next one. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        before query string false "Cursor: return items older than this position"
+// @Param        limit query int false "Maximum number of items, up to 100" default(50)
+// @Success      200 {object} entity.History
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/history [get]
+func (h *Handler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetHistory: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	req := entity.GetHistoryReq{ID: id, Before: r.URL.Query().Get(QueryParamBefore), Limit: defaultHistoryLimit}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("entity.Handler.GetHistory: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	history, err := h.svc.GetHistory(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, history)
+}
+
+// Create godoc
+// @Summary      Create entity
+// @Description  Creates a new entity. Requires write permission for the parent entity. if root entity, requires admin role.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body usecase.CreateEntityCmd true "Create entity payload"
+// @Success      201 {object} CreateEntityResp
+// @Header       201 {string} X-Content-Size-Warning "Content length and limit in bytes, set when the content is close to the limit"
+// @Header       201 {string} X-Broken-Links "Comma-separated IDs of linked entities that do not exist or were deleted"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var cmd usecase.CreateEntityCmd
+	if err := httpx.DecodeJSON(r, &cmd); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.Create.DecodeJSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	id, usage, err := h.svc.Create(ctx, cmd)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/entities/"+id.String())
+	setContentWarnings(w, usage)
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, CreateEntityResp{ID: id})
+}
+
+// Update godoc
+// @Summary      Update entity
+// @Description  Updates an existing entity. Requires write permission. If changes parent, requires write permission for the new and old parents as well.
+// @Description  The optional summary describes the change; it is stored with the new version and shown in the versions
+// @Description  list, the history and the activity feed. Drafts create no version, so their summary is dropped.
+// @Description  With minor_edit the version is marked as a minor edit and, unless entity.quiet_minor_edits is off, its edit
+// @Description  is left out of the activity feed.
+// @Description  A move into another space, under another root or to the top level, fails with 409 unless confirm_cross_space
+// @Description  is set. The direct grants on the moved entities are then kept, stripped or remapped to the roles held in the
+// @Description  new space and the default permissions of the new ancestors as grants says, and the response lists the grants
+// @Description  revoked, kept and given. A failed grant change fails the update and the entity is not moved.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body UpdateEntityInput true "Update entity payload"
+// @Success      200 {object} entity.SpaceMove "Moved into another space"
+// @Success      204 "No Content"
+// @Header       204 {string} X-Content-Size-Warning "Content length and limit in bytes, set when the content is close to the limit"
+// @Header       204 {string} X-Broken-Links "Comma-separated IDs of linked entities that do not exist or were deleted"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Update: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var input UpdateEntityInput
+	if err = httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.Update: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	usage, err := h.svc.Update(ctx, usecase.UpdateEntityCmd{
+		ID:                id,
+		Name:              input.Name,
+		Content:           input.Content,
+		ParentID:          input.ParentID,
+		IsDraft:           input.IsDraft,
+		Summary:           input.Summary,
+		MinorEdit:         input.MinorEdit,
+		ConfirmCrossSpace: input.ConfirmCrossSpace,
+		Grants:            input.Grants,
+	})
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	setContentWarnings(w, usage)
+	if usage.SpaceMove != nil {
+		httpx.WriteJSON(ctx, w, http.StatusOK, usage.SpaceMove)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func setContentWarnings(w http.ResponseWriter, usage entity.ContentUsage) {
+	if usage.Warning {
+		w.Header().Set(HeaderContentSizeWarning, strconv.Itoa(usage.Length)+"/"+strconv.Itoa(usage.Limit))
+	}
+	if len(usage.BrokenLinks) > 0 {
+		ids := make([]string, 0, len(usage.BrokenLinks))
+		for _, id := range usage.BrokenLinks {
+			ids = append(ids, id.String())
+		}
+		w.Header().Set(HeaderBrokenLinks, strings.Join(ids, ","))
+	}
+}
+
+// Delete godoc
+// @Summary      Delete entity
+// @Description  Deletes an entity by ID together with everything below it. Requires write permission for the entity.
+// @Description  With dry_run nothing is deleted and the entities that would be are returned.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        dry_run   query bool  false "Only report the entities that would be deleted"
+// @Success      200 {object} entity.DeleteReport "dry_run"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Delete: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	dryRun, err := parseDryRun(r)
+	if err != nil {
+		logger.Warn(ctx, err).Str(QueryParamDryRun, r.URL.Query().Get(QueryParamDryRun)).
+			Msg("entity.Handler.Delete: invalid dry_run")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	report, err := h.svc.Delete(ctx, id, dryRun)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	if dryRun {
+		httpx.WriteJSON(ctx, w, http.StatusOK, report)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TransferOwnership godoc
+// @Summary      Transfer entity ownership
+// @Description  Makes another user the owner of the entity. The new owner must be an existing user that was not deleted. Requires write permission for the entity.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body TransferOwnershipInput true "New owner"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/owner [put]
+func (h *Handler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.TransferOwnership: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var input TransferOwnershipInput
+	if err = httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.TransferOwnership: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.TransferOwnership(ctx, id, input.OwnerID); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PutRelation godoc
+// @Summary      Relate two entities
+// @Description  Marks two entities as related pages. The relation is symmetric and shows up in the related list of both; adding it again is a no-op. Requires write permission for both entities.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Param        related_id path string true "Related entity ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/relations/{related_id} [put]
+func (h *Handler) PutRelation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.PutRelation: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	relatedStr := chi.URLParam(r, URLParamRelatedID)
+	relatedID, err := uuid.Parse(relatedStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldRelated.String(), relatedStr).
+			Msg("entity.Handler.PutRelation: invalid related ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.AddRelation(ctx, id, relatedID); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteRelation godoc
+// @Summary      Remove a relation between two entities
+// @Description  Removes the relation from both entities, whichever side it was added from. Requires write permission for both entities.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Param        related_id path string true "Related entity ID"
+// @Success      204 "No Content"
+// @Failure      404 {object} apperr.Problem "Entities are not related"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/relations/{related_id} [delete]
+func (h *Handler) DeleteRelation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.DeleteRelation: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+	relatedStr := chi.URLParam(r, URLParamRelatedID)
+	relatedID, err := uuid.Parse(relatedStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldRelated.String(), relatedStr).
+			Msg("entity.Handler.DeleteRelation: invalid related ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.DeleteRelation(ctx, id, relatedID); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Lock godoc
+// @Summary      Lock entity for editing
+// @Description  Takes a soft edit lock, or extends the one the current user already holds. While the lock is active, updates by other users fail with 423 and the lock owner in the error params. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {object} entity.Lock
+// @Failure      423 {object} apperr.Problem "Locked by another user"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/lock [post]
+func (h *Handler) Lock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Lock: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	lock, err := h.svc.Lock(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, lock)
+}
+
+// Unlock godoc
+// @Summary      Release entity lock
+// @Description  Releases the lock held by the current user. Admins can release any lock. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Success      204 "No Content"
+// @Failure      423 {object} apperr.Problem "Locked by another user"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/unlock [post]
+func (h *Handler) Unlock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Unlock: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.Unlock(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLock godoc
+// @Summary      Get entity lock
+// @Description  Returns the active edit lock of the entity, 404 when it is not locked. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Success      200 {object} entity.Lock
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/lock [get]
+func (h *Handler) GetLock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.GetLock: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	lock, err := h.svc.GetLock(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, lock)
+}
+
+// Autosave godoc
+// @Summary      Autosave entity content
+// @Description  Stores the content as the current user's autosave of the entity, without creating a version. Saving the entity removes it; until then the entity is returned with it. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body AutosaveInput true "Autosave payload"
+// @Success      200 {object} entity.Autosave
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/draft [patch]
+func (h *Handler) Autosave(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Autosave: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var input AutosaveInput
+	if err = httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.Autosave: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	autosave, err := h.svc.Autosave(ctx, id, input.Content)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, autosave)
+}
+
+// DiscardAutosave godoc
+// @Summary      Discard entity autosave
+// @Description  Removes the current user's autosave of the entity. Requires write permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Param        entity_id path string true "Entity ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/draft [delete]
+func (h *Handler) DiscardAutosave(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.DiscardAutosave: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.DiscardAutosave(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Merge godoc
+// @Summary      Merge concurrent edits
+// @Description  Merges content edited from base_version with a later version of the entity, by default the current one, line by line. Lines only one side changed take that change; lines both changed differently are kept between "<<<<<<< mine", "=======" and ">>>>>>> theirs" markers and counted in conflicts. Nothing is saved. Requires read permission.
+// @Tags         entities
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        entity_id path string true "Entity ID"
+// @Param        request body MergeInput true "Merge payload"
+// @Success      200 {object} entity.MergeResult
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /entities/{entity_id}/merge [post]
+func (h *Handler) Merge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(entity.FieldEntityID.String(), idStr).
+			Msg("entity.Handler.Merge: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var input MergeInput
+	if err = httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).
+			Msg("entity.Handler.Merge: failed to decode JSON")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	result, err := h.svc.Merge(ctx, entity.MergeReq{
+		ID:            id,
+		BaseVersion:   input.BaseVersion,
+		Mine:          input.Content,
+		TheirsVersion: input.TheirsVersion,
+	})
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, result)
+}
+
+// parseDryRun reads the optional dry_run query parameter.
+func parseDryRun(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get(QueryParamDryRun)
+	if v == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", QueryParamDryRun, err)
+	}
+
+	return dryRun, nil
+}
@@ -2,16 +2,27 @@ package http_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	entity_http "github.com/66gu1/easygodocs/internal/app/entity/transport/http"
 	"github.com/66gu1/easygodocs/internal/app/entity/transport/http/mocks"
 	entity_usecase "github.com/66gu1/easygodocs/internal/app/entity/usecase"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
@@ -104,7 +115,7 @@ func TestHandler_GetTree(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
 			r.Get("/tree", h.GetTree)
@@ -123,8 +134,8 @@ func TestHandler_GetTree(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, tree, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
@@ -133,18 +144,21 @@ func TestHandler_GetTree(t *testing.T) {
 func TestHandler_Get(t *testing.T) {
 	t.Parallel()
 
-	id := uuid.New()
+	id, variantID := uuid.New(), uuid.New()
 	ent := entity.Entity{
-		ID:      id,
-		Type:    "type",
-		Name:    "Doc 1",
-		Content: "Content 1",
+		ID:       id,
+		Type:     "type",
+		Name:     "Doc 1",
+		Content:  "Content 1",
+		Language: "en",
 	}
 	tests := []struct {
-		name       string
-		entityID   string
-		wantStatus int
-		setup      func(s *mocks.ServiceMock)
+		name           string
+		entityID       string
+		query          string
+		acceptLanguage string
+		wantStatus     int
+		setup          func(s *mocks.ServiceMock)
 	}{
 		{
 			name:       "invalid UUID -> 400",
@@ -167,6 +181,47 @@ func TestHandler_Get(t *testing.T) {
 				s.GetMock.Expect(minimock.AnyContext, id).Return(ent, nil)
 			},
 		},
+		{
+			name:       "invalid render -> 400",
+			entityID:   id.String(),
+			query:      "?render=pdf",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "rendered -> 200 with entity JSON",
+			entityID:   id.String(),
+			query:      "?render=html",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetRenderedMock.Expect(minimock.AnyContext, id).Return(ent, nil)
+			},
+		},
+		{
+			name:           "accept-language -> 200 with the variant",
+			entityID:       id.String(),
+			acceptLanguage: "ru, en;q=0.5",
+			wantStatus:     http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.ResolveVariantMock.Expect(minimock.AnyContext, id, "ru, en;q=0.5").Return(variantID)
+				s.GetMock.Expect(minimock.AnyContext, variantID).Return(ent, nil)
+			},
+		},
+		{
+			name:           "exact -> 200 with the entity itself",
+			entityID:       id.String(),
+			query:          "?exact=true",
+			acceptLanguage: "ru",
+			wantStatus:     http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetMock.Expect(minimock.AnyContext, id).Return(ent, nil)
+			},
+		},
+		{
+			name:       "invalid exact -> 400",
+			entityID:   id.String(),
+			query:      "?exact=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -176,12 +231,15 @@ func TestHandler_Get(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
 			r.Get("/entity/{"+entity_http.URLParamEntityID+"}", h.Get)
 
-			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID, nil)
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+tc.query, nil)
+			if tc.acceptLanguage != "" {
+				req.Header.Set(entity_http.HeaderAcceptLanguage, tc.acceptLanguage)
+			}
 			rr := httptest.NewRecorder()
 
 			r.ServeHTTP(rr, req)
@@ -195,59 +253,131 @@ func TestHandler_Get(t *testing.T) {
 				err := json.Unmarshal(rr.Body.Bytes(), &got)
 				require.NoError(t, err)
 				require.Equal(t, ent, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+				require.Equal(t, "en", rr.Header().Get(entity_http.HeaderContentLanguage))
+			} else {
+				requireProblem(t, rr)
 			}
 		})
 	}
 }
 
-func TestHandler_GetVersion(t *testing.T) {
+func TestHandler_GetBySlug(t *testing.T) {
 	t.Parallel()
 
-	id := uuid.New()
-	version := 2
-	ent := entity.Entity{
-		ID:      id,
-		Type:    "type",
-		Name:    "Doc 1",
-		Content: "Content 1 v2",
+	ent := entity.Entity{ID: uuid.New(), Type: entity.TypeArticle, Name: "Руководство", Slug: "руководство"}
+	tests := []struct {
+		name         string
+		path         string
+		wantStatus   int
+		wantLocation string
+		setup        func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "handler error -> 404",
+			path:       "/entities/by-slug/missing",
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetBySlugMock.Expect(minimock.AnyContext, "missing").Return(entity.Entity{}, entity.ErrEntityNotFound())
+			},
+		},
+		{
+			name:       "current slug -> 200",
+			path:       "/entities/by-slug/%D1%80%D1%83%D0%BA%D0%BE%D0%B2%D0%BE%D0%B4%D1%81%D1%82%D0%B2%D0%BE",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetBySlugMock.Expect(minimock.AnyContext, ent.Slug).Return(ent, nil)
+			},
+		},
+		{
+			name:         "former slug -> 301",
+			path:         "/entities/by-slug/guide",
+			wantStatus:   http.StatusMovedPermanently,
+			wantLocation: "/entities/by-slug/%D1%80%D1%83%D0%BA%D0%BE%D0%B2%D0%BE%D0%B4%D1%81%D1%82%D0%B2%D0%BE",
+			setup: func(s *mocks.ServiceMock) {
+				s.GetBySlugMock.Expect(minimock.AnyContext, "guide").Return(ent, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entities/by-slug/{"+entity_http.URLParamSlug+"}", h.GetBySlug)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			switch tc.wantStatus {
+			case http.StatusOK:
+				var got entity.Entity
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, ent, got)
+			case http.StatusMovedPermanently:
+				require.Equal(t, tc.wantLocation, rr.Header().Get("Location"))
+			default:
+				requireProblem(t, rr)
+			}
+		})
 	}
+}
+
+func TestHandler_GetByPath(t *testing.T) {
+	t.Parallel()
+
+	ent := entity.Entity{ID: uuid.New(), Type: entity.TypeArticle, Name: "Установка", Slug: "установка"}
+	current := []string{"guides", ent.Slug}
 	tests := []struct {
 		name       string
-		entityID   string
-		version    string
+		path       string
 		wantStatus int
+		wantMoved  entity.MovedLocation
 		setup      func(s *mocks.ServiceMock)
 	}{
 		{
-			name:       "invalid UUID -> 400",
-			entityID:   "invalid",
-			version:    "1",
-			wantStatus: http.StatusBadRequest,
+			name:       "service error -> 404",
+			path:       "/api/v1/entities/by-path/guides/missing",
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetByPathMock.Expect(minimock.AnyContext, []string{"guides", "missing"}).
+					Return(entity.Entity{}, entity.PathResolution{}, entity.ErrEntityNotFound())
+			},
 		},
 		{
-			name:       "invalid version -> 400",
-			entityID:   id.String(),
-			version:    "invalid",
+			name:       "invalid escape -> 400",
+			path:       "/api/v1/entities/by-path/guides/%25zz",
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:       "handler error -> 500",
-			entityID:   id.String(),
-			version:    fmt.Sprintf("%d", version),
-			wantStatus: http.StatusInternalServerError,
+			name:       "current path -> 200",
+			path:       "/api/v1/entities/by-path/guides/%D1%83%D1%81%D1%82%D0%B0%D0%BD%D0%BE%D0%B2%D0%BA%D0%B0",
+			wantStatus: http.StatusOK,
 			setup: func(s *mocks.ServiceMock) {
-				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(entity.Entity{}, fmt.Errorf("handler error"))
+				s.GetByPathMock.Expect(minimock.AnyContext, current).
+					Return(ent, entity.PathResolution{ID: ent.ID, Path: current}, nil)
 			},
 		},
 		{
-			name:       "ok -> 200 with entity JSON",
-			entityID:   id.String(),
-			version:    fmt.Sprintf("%d", version),
-			wantStatus: http.StatusOK,
+			name:       "former location -> 301",
+			path:       "/api/v1/entities/by-path/old/install",
+			wantStatus: http.StatusMovedPermanently,
+			wantMoved: entity.MovedLocation{
+				EntityID:   ent.ID,
+				Location:   "/api/v1/entities/by-path/guides/%D1%83%D1%81%D1%82%D0%B0%D0%BD%D0%BE%D0%B2%D0%BA%D0%B0",
+				ParentPath: []string{"guides"},
+			},
 			setup: func(s *mocks.ServiceMock) {
-				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(ent, nil)
+				s.GetByPathMock.Expect(minimock.AnyContext, []string{"old", "install"}).
+					Return(entity.Entity{}, entity.PathResolution{ID: ent.ID, Path: current, Moved: true}, nil)
 			},
 		},
 	}
@@ -259,49 +389,45 @@ func TestHandler_GetVersion(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
-			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/version/{"+entity_http.URLParamVersion+"}", h.GetVersion)
+			r.Route("/api/v1/entities", func(r chi.Router) {
+				r.Get("/by-path/"+entity_http.URLParamPath, h.GetByPath)
+			})
 
-			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/version/"+tc.version, nil)
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
 			rr := httptest.NewRecorder()
 
 			r.ServeHTTP(rr, req)
 
 			require.Equal(t, tc.wantStatus, rr.Code)
-			if tc.wantStatus == http.StatusOK {
-				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
-					t.Fatalf("content-type = %q; want application/json", ct)
-				}
+			switch tc.wantStatus {
+			case http.StatusOK:
 				var got entity.Entity
-				err := json.Unmarshal(rr.Body.Bytes(), &got)
-				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
 				require.Equal(t, ent, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			case http.StatusMovedPermanently:
+				var got entity.MovedLocation
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, tc.wantMoved, got)
+				require.Equal(t, tc.wantMoved.Location, rr.Header().Get("Location"))
+			default:
+				requireProblem(t, rr)
 			}
 		})
 	}
 }
 
-func TestHandler_GetVersionsList(t *testing.T) {
+func TestHandler_GetMeta(t *testing.T) {
 	t.Parallel()
 
 	id := uuid.New()
-	versions := []entity.Entity{
-		{
-			ID:      id,
-			Type:    "type",
-			Name:    "Doc 1",
-			Content: "Content 1 v1",
-		},
-		{
-			ID:      id,
-			Type:    "type",
-			Name:    "Doc 1",
-			Content: "Content 1 v2",
-		},
+	meta := entity.Meta{
+		ContentStats:  entity.ContentStats{WordCount: 420, ReadingTimeMinutes: 3},
+		VersionCount:  4,
+		ChildrenCount: 2,
+		LastEditors:   []entity.Editor{{UserID: uuid.New()}},
 	}
 	tests := []struct {
 		name       string
@@ -319,15 +445,15 @@ func TestHandler_GetVersionsList(t *testing.T) {
 			entityID:   id.String(),
 			wantStatus: http.StatusInternalServerError,
 			setup: func(s *mocks.ServiceMock) {
-				s.GetVersionsListMock.Expect(minimock.AnyContext, id).Return(nil, fmt.Errorf("handler error"))
+				s.GetMetaMock.Expect(minimock.AnyContext, id).Return(entity.Meta{}, fmt.Errorf("handler error"))
 			},
 		},
 		{
-			name:       "ok -> 200 with versions list JSON",
+			name:       "ok -> 200 with meta JSON",
 			entityID:   id.String(),
 			wantStatus: http.StatusOK,
 			setup: func(s *mocks.ServiceMock) {
-				s.GetVersionsListMock.Expect(minimock.AnyContext, id).Return(versions, nil)
+				s.GetMetaMock.Expect(minimock.AnyContext, id).Return(meta, nil)
 			},
 		},
 	}
@@ -339,69 +465,58 @@ func TestHandler_GetVersionsList(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
-			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/versions", h.GetVersionsList)
-			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/versions", nil)
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/meta", h.GetMeta)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/meta", nil)
 			rr := httptest.NewRecorder()
+
 			r.ServeHTTP(rr, req)
+
 			require.Equal(t, tc.wantStatus, rr.Code)
 			if tc.wantStatus == http.StatusOK {
-				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
-					t.Fatalf("content-type = %q; want application/json", ct)
-				}
-				var got []entity.Entity
-				err := json.Unmarshal(rr.Body.Bytes(), &got)
-				require.NoError(t, err)
-				require.Equal(t, versions, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+				var got entity.Meta
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, meta, got)
 			}
 		})
 	}
 }
 
-func TestHandler_Create(t *testing.T) {
+func TestHandler_GetContributors(t *testing.T) {
 	t.Parallel()
 
 	id := uuid.New()
-	parentID := uuid.New()
-	ent := entity_usecase.CreateEntityCmd{
-		Type:     "type",
-		Name:     "Doc 1",
-		Content:  "Content 1",
-		ParentID: &parentID,
-		IsDraft:  true,
+	contributors := []entity.Contributor{
+		{UserID: uuid.New(), VersionCount: 3, LastContributedAt: time.Date(2025, 9, 5, 10, 0, 0, 0, time.UTC)},
 	}
-	want := entity_http.CreateEntityResp{ID: id}
-	body, err := json.Marshal(ent)
-	require.NoError(t, err)
 	tests := []struct {
 		name       string
-		body       []byte
+		entityID   string
 		wantStatus int
 		setup      func(s *mocks.ServiceMock)
 	}{
 		{
-			name:       "invalid JSON -> 400",
-			body:       []byte("invalid"),
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
 			wantStatus: http.StatusBadRequest,
 		},
 		{
 			name:       "handler error -> 500",
-			body:       body,
+			entityID:   id.String(),
 			wantStatus: http.StatusInternalServerError,
 			setup: func(s *mocks.ServiceMock) {
-				s.CreateMock.Expect(minimock.AnyContext, ent).Return(uuid.Nil, fmt.Errorf("handler error"))
+				s.GetContributorsMock.Expect(minimock.AnyContext, id).Return(nil, fmt.Errorf("handler error"))
 			},
 		},
 		{
-			name:       "ok -> 201 with Location header and entity ID JSON",
-			body:       body,
-			wantStatus: http.StatusCreated,
+			name:       "ok -> 200 with contributors JSON",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
 			setup: func(s *mocks.ServiceMock) {
-				s.CreateMock.Expect(minimock.AnyContext, ent).Return(id, nil)
+				s.GetContributorsMock.Expect(minimock.AnyContext, id).Return(contributors, nil)
 			},
 		},
 	}
@@ -413,86 +528,94 @@ func TestHandler_Create(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
-			r.Post("/entity", h.Create)
-
-			req := httptest.NewRequest(http.MethodPost, "/entity", bytes.NewReader(tc.body))
-			req.Header.Set("Content-Type", "application/json")
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/contributors", h.GetContributors)
 
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/contributors", nil)
 			rr := httptest.NewRecorder()
 
 			r.ServeHTTP(rr, req)
 
 			require.Equal(t, tc.wantStatus, rr.Code)
-			if tc.wantStatus == http.StatusCreated {
-				if loc := rr.Header().Get("Location"); loc != "/entities/"+id.String() {
-					t.Fatalf("Location header = %q; want /entities/%s", loc, id.String())
-				}
-				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
-					t.Fatalf("content-type = %q; want application/json", ct)
-				}
-				var got entity_http.CreateEntityResp
-				err = json.Unmarshal(rr.Body.Bytes(), &got)
-				require.NoError(t, err)
-				require.Equal(t, want, got)
-			} else if rr.Body.Len() == 0 {
-				t.Fatalf("error response body is empty; want some payload")
+			if tc.wantStatus == http.StatusOK {
+				var got []entity.Contributor
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, contributors, got)
 			}
 		})
 	}
 }
 
-func TestHandler_Update(t *testing.T) {
+func TestHandler_GetActivity(t *testing.T) {
 	t.Parallel()
+
 	id := uuid.New()
-	input := entity_http.UpdateEntityInput{
-		Name:    "Doc 1 Updated",
-		Content: "Content 1 Updated",
-	}
-	cmd := entity_usecase.UpdateEntityCmd{
-		ID:      id,
-		Name:    input.Name,
-		Content: input.Content,
+	actorID := uuid.New()
+	activity := entity.Activity{
+		Events: []entity.Event{
+			{ID: 8, EntityID: id, EntityName: "doc", Type: entity.EventEdited, ActorID: &actorID, CreatedAt: time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)},
+		},
+		NextCursor: "Nw",
 	}
-	body, err := json.Marshal(input)
-	require.NoError(t, err)
 	tests := []struct {
 		name       string
 		entityID   string
-		body       []byte
+		query      string
 		wantStatus int
 		setup      func(s *mocks.ServiceMock)
 	}{
 		{
 			name:       "invalid UUID -> 400",
 			entityID:   "invalid",
-			body:       body,
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:       "invalid JSON -> 400",
+			name:       "invalid limit -> 400",
 			entityID:   id.String(),
-			body:       []byte("invalid"),
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid include_minor -> 400",
+			entityID:   id.String(),
+			query:      "?include_minor=maybe",
 			wantStatus: http.StatusBadRequest,
 		},
 		{
 			name:       "handler error -> 500",
 			entityID:   id.String(),
-			body:       body,
 			wantStatus: http.StatusInternalServerError,
 			setup: func(s *mocks.ServiceMock) {
-				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(fmt.Errorf("handler error"))
+				s.GetActivityMock.Expect(minimock.AnyContext, entity.GetActivityReq{ID: id, Limit: 50}).
+					Return(entity.Activity{}, fmt.Errorf("handler error"))
 			},
 		},
 		{
-			name:       "ok -> 204 No Content",
+			name:       "ok -> 200 with default limit",
 			entityID:   id.String(),
-			body:       body,
-			wantStatus: http.StatusNoContent,
+			wantStatus: http.StatusOK,
 			setup: func(s *mocks.ServiceMock) {
-				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(nil)
+				s.GetActivityMock.Expect(minimock.AnyContext, entity.GetActivityReq{ID: id, Limit: 50}).Return(activity, nil)
+			},
+		},
+		{
+			name:       "ok -> 200 with cursor and limit",
+			entityID:   id.String(),
+			query:      "?before=OQ&limit=1",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetActivityMock.Expect(minimock.AnyContext, entity.GetActivityReq{ID: id, Before: "OQ", Limit: 1}).Return(activity, nil)
+			},
+		},
+		{
+			name:       "ok -> 200 with minor edits",
+			entityID:   id.String(),
+			query:      "?include_minor=true",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetActivityMock.Expect(minimock.AnyContext, entity.GetActivityReq{ID: id, Limit: 50, IncludeMinor: true}).Return(activity, nil)
 			},
 		},
 	}
@@ -504,57 +627,100 @@ func TestHandler_Update(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
-			r.Put("/entity/{"+entity_http.URLParamEntityID+"}", h.Update)
-
-			req := httptest.NewRequest(http.MethodPut, "/entity/"+tc.entityID, bytes.NewReader(tc.body))
-			req.Header.Set("Content-Type", "application/json")
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/activity", h.GetActivity)
 
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/activity"+tc.query, nil)
 			rr := httptest.NewRecorder()
 
 			r.ServeHTTP(rr, req)
 
 			require.Equal(t, tc.wantStatus, rr.Code)
-			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+			if tc.wantStatus == http.StatusOK {
+				var got entity.Activity
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, activity, got)
 			}
 		})
 	}
 }
 
-func TestHandler_Delete(t *testing.T) {
+func TestHandler_List(t *testing.T) {
 	t.Parallel()
 
-	id := uuid.New()
+	var (
+		parentID = uuid.New()
+		authorID = uuid.New()
+		since    = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		page     = entity.ListPage{
+			Items: []entity.ListEntry{{
+				ListItem:    entity.ListItem{ID: uuid.New(), Type: entity.TypeArticle, Name: "doc", Slug: "doc", ParentID: &parentID},
+				CreatedAt:   since,
+				UpdatedAt:   since,
+				Breadcrumbs: []entity.Breadcrumb{{ID: parentID, Name: "root", Slug: "root"}},
+			}},
+			NextCursor: "next",
+		}
+	)
 	tests := []struct {
 		name       string
-		entityID   string
+		query      string
 		wantStatus int
 		setup      func(s *mocks.ServiceMock)
 	}{
 		{
-			name:       "invalid UUID -> 400",
-			entityID:   "invalid",
+			name:       "invalid parent_id -> 400",
+			query:      "?parent_id=abc",
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:       "handler error -> 500",
-			entityID:   id.String(),
-			wantStatus: http.StatusInternalServerError,
+			name:       "invalid author_id -> 400",
+			query:      "?author_id=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid updated_since -> 400",
+			query:      "?updated_since=yesterday",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid order -> 400",
+			query:      "?order=random",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400",
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "service error -> 400",
+			query:      "?sort=views",
+			wantStatus: http.StatusBadRequest,
 			setup: func(s *mocks.ServiceMock) {
-				s.DeleteMock.Expect(minimock.AnyContext, id).Return(fmt.Errorf("handler error"))
+				s.ListMock.Expect(minimock.AnyContext, entity.ListReq{Sort: "views", Limit: entity.DefaultListLimit}).
+					Return(entity.ListPage{}, entity.ErrInvalidListSort())
 			},
 		},
 		{
-			name:       "ok -> 204 No Content",
-			entityID:   id.String(),
-			wantStatus: http.StatusNoContent,
+			name:       "ok -> 200 with defaults",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.ListMock.Expect(minimock.AnyContext, entity.ListReq{Limit: entity.DefaultListLimit}).Return(page, nil)
+			},
+		},
+		{
+			name: "ok -> 200 with filters",
+			query: "?type=article&parent_id=" + parentID.String() + "&updated_since=2025-09-01T00:00:00Z&author_id=" + authorID.String() +
+				"&status=draft&sort=updated_at&order=desc&after=cursor&limit=10",
+			wantStatus: http.StatusOK,
 			setup: func(s *mocks.ServiceMock) {
-				s.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
+				s.ListMock.Expect(minimock.AnyContext, entity.ListReq{
+					Type: entity.TypeArticle, ParentID: &parentID, UpdatedSince: &since, AuthorID: &authorID,
+					Status: entity.ListStatusDraft, Sort: entity.ListSortUpdatedAt, Desc: true, After: "cursor", Limit: 10,
+				}).Return(page, nil)
 			},
 		},
 	}
@@ -566,22 +732,3091 @@ func TestHandler_Delete(t *testing.T) {
 			if tc.setup != nil {
 				tc.setup(mock)
 			}
-			h := entity_http.NewHandler(mock)
+			h := entity_http.NewHandler(mock, testSanitizer(t))
 			r := chi.NewRouter()
 
-			r.Delete("/entity/{"+entity_http.URLParamEntityID+"}", h.Delete)
-
-			req := httptest.NewRequest(http.MethodDelete, "/entity/"+tc.entityID, nil)
+			r.Get("/entities/list", h.List)
 
+			req := httptest.NewRequest(http.MethodGet, "/entities/list"+tc.query, nil)
 			rr := httptest.NewRecorder()
 
 			r.ServeHTTP(rr, req)
 
 			require.Equal(t, tc.wantStatus, rr.Code)
-			if tc.wantStatus != http.StatusNoContent {
-				if rr.Body.Len() == 0 {
-					t.Fatalf("error response body is empty; want some payload")
-				}
+			if tc.wantStatus == http.StatusOK {
+				var got entity.ListPage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, page, got)
+			}
+		})
+	}
+}
+
+func TestHandler_GetDrafts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID = uuid.New()
+		edited   = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		page     = entity.ListPage{
+			Items: []entity.ListEntry{{
+				ListItem:    entity.ListItem{ID: uuid.New(), Type: entity.TypeArticle, Name: "doc", Slug: "doc", ParentID: &parentID},
+				IsDraft:     true,
+				CreatedAt:   edited,
+				UpdatedAt:   edited,
+				Breadcrumbs: []entity.Breadcrumb{{ID: parentID, Name: "root", Slug: "root"}},
+			}},
+			NextCursor: "next",
+		}
+	)
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid limit -> 400",
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "service error -> 400",
+			query:      "?after=x",
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetDraftsMock.Expect(minimock.AnyContext, entity.DraftsReq{After: "x", Limit: entity.DefaultListLimit}).
+					Return(entity.ListPage{}, db.ErrInvalidCursor())
+			},
+		},
+		{
+			name:       "ok -> 200",
+			query:      "?after=cursor&limit=10",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetDraftsMock.Expect(minimock.AnyContext, entity.DraftsReq{After: "cursor", Limit: 10}).Return(page, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/drafts", h.GetDrafts)
+
+			req := httptest.NewRequest(http.MethodGet, "/drafts"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got entity.ListPage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, page, got)
+			}
+		})
+	}
+}
+
+func TestHandler_GetPopular(t *testing.T) {
+	t.Parallel()
+
+	report := entity.PopularReport{
+		Since: time.Date(2025, 8, 16, 10, 0, 0, 0, time.UTC),
+		Entities: []entity.PopularEntity{
+			{ListItem: entity.ListItem{ID: uuid.New(), Type: entity.TypeArticle, Name: "doc", Slug: "doc"}, Views: 12},
+		},
+	}
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid period -> 400",
+			query:      "?period=month",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400",
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetPopularMock.Return(entity.PopularReport{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok -> 200 with defaults",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetPopularMock.Expect(minimock.AnyContext, entity.GetPopularReq{Period: 30 * 24 * time.Hour, Limit: 20}).Return(report, nil)
+			},
+		},
+		{
+			name:       "ok -> 200 with period and limit",
+			query:      "?period=12h&limit=5",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetPopularMock.Expect(minimock.AnyContext, entity.GetPopularReq{Period: 12 * time.Hour, Limit: 5}).Return(report, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/reports/popular", h.GetPopular)
+
+			req := httptest.NewRequest(http.MethodGet, "/reports/popular"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got entity.PopularReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, report, got)
+			}
+		})
+	}
+}
+
+func TestHandler_GetBacklinks(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	items := []entity.ListItem{{ID: uuid.New(), Type: entity.TypeArticle, Name: "linking"}}
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden -> 403",
+			entityID:   id.String(),
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetBacklinksMock.Expect(minimock.AnyContext, id).Return(nil, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "ok -> 200",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetBacklinksMock.Expect(minimock.AnyContext, id).Return(items, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/backlinks", h.GetBacklinks)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/backlinks", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got []entity.ListItem
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, items, got)
+			}
+		})
+	}
+}
+
+func TestHandler_GetChildren(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	items := []entity.ListItem{{ID: uuid.New(), Type: entity.TypeArticle, Name: "child", ParentID: &id, SortOrder: 1}}
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden -> 403",
+			entityID:   id.String(),
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetChildrenMock.Expect(minimock.AnyContext, id).Return(nil, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "ok -> 200",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetChildrenMock.Expect(minimock.AnyContext, id).Return(items, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/children", h.GetChildren)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/children", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got []entity.ListItem
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, items, got)
+			}
+		})
+	}
+}
+
+func TestHandler_GetTOC(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	toc := &entity.TOCNode{
+		ID: id, Type: entity.TypeDepartment, Name: "manual",
+		Headings: []*entity.Heading{{Level: 1, Text: "Intro", Anchor: "intro"}},
+		Children: []*entity.TOCNode{},
+	}
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not found -> 404",
+			entityID:   id.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetTOCMock.Expect(minimock.AnyContext, id).Return(nil, entity.ErrEntityNotFound())
+			},
+		},
+		{
+			name:       "ok -> 200",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetTOCMock.Expect(minimock.AnyContext, id).Return(toc, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/toc", h.GetTOC)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/toc", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got *entity.TOCNode
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, toc, got)
+			}
+		})
+	}
+}
+
+// fakePDF converts by prefixing the HTML with a marker.
+type fakePDF struct{}
+
+func (fakePDF) Convert(_ context.Context, dst io.Writer, render func(w io.Writer) error) error {
+	if _, err := io.WriteString(dst, "%PDF "); err != nil {
+		return err
+	}
+	return render(dst)
+}
+
+func TestHandler_GetManual(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	writeManual := func(_ context.Context, _ uuid.UUID, w entity.ManualWriter) error {
+		chapters := []entity.ManualChapter{{ID: id, Name: "Guide"}}
+		if err := w.WriteOutline(chapters); err != nil {
+			return err
+		}
+		return w.WriteSections([]entity.ManualSection{{ManualChapter: chapters[0], Content: "intro"}})
+	}
+	tests := []struct {
+		name        string
+		path        string
+		pdf         entity_http.PDFConverter
+		wantStatus  int
+		wantAbort   bool
+		contentType string
+		setup       func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			path:       "/entity/invalid/manual",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid format -> 400",
+			path:       "/entity/" + id.String() + "/manual?format=docx",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "pdf not configured -> 400",
+			path:       "/entity/" + id.String() + "/manual?format=pdf",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden -> 403",
+			path:       "/entity/" + id.String() + "/manual",
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.ManualMock.ExpectIdParam2(id).Return(apperr.ErrForbidden())
+			},
+		},
+		{
+			name:        "html -> 200",
+			path:        "/entity/" + id.String() + "/manual",
+			wantStatus:  http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			setup: func(s *mocks.ServiceMock) {
+				s.ManualMock.Set(writeManual)
+			},
+		},
+		{
+			name:        "pdf -> 200",
+			path:        "/entity/" + id.String() + "/manual?format=pdf",
+			pdf:         fakePDF{},
+			wantStatus:  http.StatusOK,
+			contentType: "application/pdf",
+			setup: func(s *mocks.ServiceMock) {
+				s.ManualMock.Set(writeManual)
+			},
+		},
+		{
+			name:      "error after the outline -> aborted",
+			path:      "/entity/" + id.String() + "/manual",
+			wantAbort: true,
+			setup: func(s *mocks.ServiceMock) {
+				s.ManualMock.Set(func(_ context.Context, _ uuid.UUID, w entity.ManualWriter) error {
+					require.NoError(t, w.WriteOutline(nil))
+					return context.Canceled
+				})
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			if tc.pdf != nil {
+				h.WithPDFConverter(tc.pdf)
+			}
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/manual", h.GetManual)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			if tc.wantAbort {
+				require.PanicsWithValue(t, http.ErrAbortHandler, func() { r.ServeHTTP(rr, req) })
+				require.Equal(t, http.StatusOK, rr.Code)
+				return
+			}
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			require.Equal(t, tc.contentType, rr.Header().Get("Content-Type"))
+			require.True(t, rr.Flushed)
+			require.Contains(t, rr.Body.String(), "<p>intro</p>")
+			require.True(t, strings.HasSuffix(rr.Body.String(), "</html>\n"))
+			if tc.pdf != nil {
+				require.True(t, strings.HasPrefix(rr.Body.String(), "%PDF "))
+			}
+		})
+	}
+}
+
+func TestHandler_Export(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	pages := [][]entity.ExportItem{
+		{{ID: id, Type: entity.TypeArticle, Name: "root", Content: "# Root", UpdatedAt: time.Unix(0, 0).UTC()}},
+		{{ID: uuid.New(), ParentID: &id, Type: entity.TypeArticle, Name: "child", IsDraft: true, UpdatedAt: time.Unix(0, 0).UTC()}},
+	}
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantAbort  bool
+		want       []entity.ExportItem
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			path:       "/entity/invalid/export",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden -> 403",
+			path:       "/entity/" + id.String() + "/export",
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.ExportMock.ExpectRootIDParam2(&id).Return(apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "ok -> 200, one line per item",
+			path:       "/entity/" + id.String() + "/export",
+			wantStatus: http.StatusOK,
+			want:       slices.Concat(pages...),
+			setup: func(s *mocks.ServiceMock) {
+				s.ExportMock.Set(func(_ context.Context, rootID *uuid.UUID, _ entity.Render, write func([]entity.ExportItem) error) error {
+					require.Equal(t, id, *rootID)
+					for _, page := range pages {
+						if err := write(page); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:       "workspace, empty -> 200",
+			path:       "/entity/export",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.ExportMock.ExpectRootIDParam2(nil).ExpectRenderParam3(entity.RenderRaw).Return(nil)
+			},
+		},
+		{
+			name:       "rendered -> 200",
+			path:       "/entity/export?render=html",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.ExportMock.ExpectRootIDParam2(nil).ExpectRenderParam3(entity.RenderHTML).Return(nil)
+			},
+		},
+		{
+			name:       "invalid render -> 400",
+			path:       "/entity/export?render=pdf",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "error after the first page -> aborted",
+			path:      "/entity/" + id.String() + "/export",
+			wantAbort: true,
+			setup: func(s *mocks.ServiceMock) {
+				s.ExportMock.Set(func(_ context.Context, _ *uuid.UUID, _ entity.Render, write func([]entity.ExportItem) error) error {
+					require.NoError(t, write(pages[0]))
+					return context.Canceled
+				})
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/export", h.ExportAll)
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/export", h.Export)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rr := httptest.NewRecorder()
+
+			if tc.wantAbort {
+				require.PanicsWithValue(t, http.ErrAbortHandler, func() { r.ServeHTTP(rr, req) })
+				require.Equal(t, http.StatusOK, rr.Code)
+				return
+			}
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+			require.True(t, rr.Flushed || rr.Body.Len() == 0)
+
+			var got []entity.ExportItem
+			dec := json.NewDecoder(rr.Body)
+			for dec.More() {
+				var item entity.ExportItem
+				require.NoError(t, dec.Decode(&item))
+				got = append(got, item)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHandler_GetBrokenLinks(t *testing.T) {
+	t.Parallel()
+
+	links := []entity.BrokenLink{{SourceID: uuid.New(), SourceName: "doc", TargetID: uuid.New()}}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetBrokenLinksMock.Expect(minimock.AnyContext).Return(links, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetBrokenLinks(rr, httptest.NewRequest(http.MethodGet, "/entities/broken-links", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got []entity.BrokenLink
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, links, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetBrokenLinksMock.Expect(minimock.AnyContext).Return(nil, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetBrokenLinks(rr, httptest.NewRequest(http.MethodGet, "/entities/broken-links", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_GetOrphanedEntities(t *testing.T) {
+	t.Parallel()
+
+	orphaned := []entity.OrphanedEntity{{ID: uuid.New(), Name: "doc", Slug: "doc", OwnerID: uuid.New(), OwnerDeletedAt: time.Now().UTC()}}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetOrphanedEntitiesMock.Expect(minimock.AnyContext).Return(orphaned, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetOrphanedEntities(rr, httptest.NewRequest(http.MethodGet, "/entities/orphaned", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got []entity.OrphanedEntity
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Len(t, got, 1)
+		require.True(t, orphaned[0].OwnerDeletedAt.Equal(got[0].OwnerDeletedAt))
+		got[0].OwnerDeletedAt = orphaned[0].OwnerDeletedAt
+		require.Equal(t, orphaned, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetOrphanedEntitiesMock.Expect(minimock.AnyContext).Return(nil, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetOrphanedEntities(rr, httptest.NewRequest(http.MethodGet, "/entities/orphaned", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_GetUnsafeMarkup(t *testing.T) {
+	t.Parallel()
+
+	report := []entity.UnsafeMarkup{{ID: uuid.New(), Name: "doc", Slug: "doc", Removed: []string{"<script>", "onerror="}}}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetUnsafeMarkupMock.Expect(minimock.AnyContext).Return(report, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetUnsafeMarkup(rr, httptest.NewRequest(http.MethodGet, "/entities/unsafe-markup", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got []entity.UnsafeMarkup
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, report, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetUnsafeMarkupMock.Expect(minimock.AnyContext).Return(nil, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetUnsafeMarkup(rr, httptest.NewRequest(http.MethodGet, "/entities/unsafe-markup", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_PreviewRetention(t *testing.T) {
+	t.Parallel()
+
+	report := entity.RetentionReport{
+		Policy:   entity.RetentionConfig{KeepDays: 30, IntervalMinutes: 60},
+		DryRun:   true,
+		Versions: []entity.VersionRef{{EntityID: uuid.New(), Version: 2}},
+	}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PreviewRetentionMock.Expect(minimock.AnyContext).Return(report, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PreviewRetention(rr, httptest.NewRequest(http.MethodGet, "/entities/retention/preview", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got entity.RetentionReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, report, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PreviewRetentionMock.Expect(minimock.AnyContext).Return(entity.RetentionReport{}, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PreviewRetention(rr, httptest.NewRequest(http.MethodGet, "/entities/retention/preview", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_PurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	report := entity.TrashReport{
+		RetentionDays:    30,
+		Cutoff:           time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		Entities:         []entity.PurgedEntity{{ID: uuid.New(), Name: "old", VersionCount: 3, Bytes: 42}},
+		ReclaimableBytes: 42,
+	}
+
+	t.Run("preview -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		preview := report
+		preview.DryRun = true
+		mock.PurgeTrashMock.Expect(minimock.AnyContext, true).Return(preview, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PreviewTrashPurge(rr, httptest.NewRequest(http.MethodGet, "/entities/trash/preview", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got entity.TrashReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, preview, got)
+	})
+	t.Run("purge -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PurgeTrashMock.Expect(minimock.AnyContext, false).Return(report, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PurgeTrash(rr, httptest.NewRequest(http.MethodPost, "/entities/trash/purge", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got entity.TrashReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, report, got)
+	})
+	t.Run("purge with dry_run -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		preview := report
+		preview.DryRun = true
+		mock.PurgeTrashMock.Expect(minimock.AnyContext, true).Return(preview, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PurgeTrash(rr, httptest.NewRequest(http.MethodPost, "/entities/trash/purge?dry_run=true", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got entity.TrashReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, preview, got)
+	})
+	t.Run("invalid dry_run -> 400", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PurgeTrash(rr, httptest.NewRequest(http.MethodPost, "/entities/trash/purge?dry_run=maybe", nil))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		requireProblem(t, rr)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PurgeTrashMock.Expect(minimock.AnyContext, false).Return(entity.TrashReport{}, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PurgeTrash(rr, httptest.NewRequest(http.MethodPost, "/entities/trash/purge", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_PreviewStaleDrafts(t *testing.T) {
+	t.Parallel()
+
+	report := entity.StaleDraftsReport{
+		Policy:    entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 45, Action: entity.StaleDraftArchive, IntervalMinutes: 60},
+		DryRun:    true,
+		Reminded:  []entity.StaleDraft{{ID: uuid.New(), Name: "idle", CreatedBy: uuid.New(), UpdatedAt: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)}},
+		CleanedUp: []entity.StaleDraft{},
+	}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PreviewStaleDraftsMock.Expect(minimock.AnyContext).Return(report, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PreviewStaleDrafts(rr, httptest.NewRequest(http.MethodGet, "/entities/stale-drafts/preview", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got entity.StaleDraftsReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, report, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.PreviewStaleDraftsMock.Expect(minimock.AnyContext).Return(entity.StaleDraftsReport{}, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).PreviewStaleDrafts(rr, httptest.NewRequest(http.MethodGet, "/entities/stale-drafts/preview", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_GetVersion(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	version := 2
+	ent := entity.Entity{
+		ID:      id,
+		Type:    "type",
+		Name:    "Doc 1",
+		Content: "Content 1 v2",
+	}
+	tests := []struct {
+		name       string
+		entityID   string
+		version    string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			version:    "1",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid version -> 400",
+			entityID:   id.String(),
+			version:    "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			entityID:   id.String(),
+			version:    fmt.Sprintf("%d", version),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(entity.Entity{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok -> 200 with entity JSON",
+			entityID:   id.String(),
+			version:    fmt.Sprintf("%d", version),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(ent, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/version/{"+entity_http.URLParamVersion+"}", h.GetVersion)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/version/"+tc.version, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
+					t.Fatalf("content-type = %q; want application/json", ct)
+				}
+				var got entity.Entity
+				err := json.Unmarshal(rr.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, ent, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_GetVersionContent(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	version := 2
+	tests := []struct {
+		name       string
+		entityID   string
+		version    string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			version:    "1",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid version -> 400",
+			entityID:   id.String(),
+			version:    "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not found -> 404",
+			entityID:   id.String(),
+			version:    fmt.Sprintf("%d", version),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(entity.Entity{}, entity.ErrEntityNotFound())
+			},
+		},
+		{
+			name:       "ok -> 200 with the raw content",
+			entityID:   id.String(),
+			version:    fmt.Sprintf("%d", version),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionMock.Expect(minimock.AnyContext, id, version).Return(entity.Entity{ID: id, Content: "# Doc\n\"quoted\""}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/version/{"+entity_http.URLParamVersion+"}/content", h.GetVersionContent)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/version/"+tc.version+"/content", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				require.Equal(t, "text/plain; charset=utf-8", rr.Header().Get("Content-Type"))
+				require.Equal(t, "# Doc\n\"quoted\"", rr.Body.String())
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_GetVersionsList(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	page := entity.VersionsPage{
+		Versions: []entity.Entity{
+			{ID: id, Type: "type", Name: "Doc 1", CurrentVersion: &[]int{2}[0]},
+			{ID: id, Type: "type", Name: "Doc 1", CurrentVersion: &[]int{1}[0]},
+		},
+		NextCursor: "MQ",
+	}
+	tests := []struct {
+		name       string
+		entityID   string
+		query      string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400",
+			entityID:   id.String(),
+			query:      "?limit=x",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid include_content -> 400",
+			entityID:   id.String(),
+			query:      "?include_content=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			entityID:   id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionsListMock.Expect(minimock.AnyContext, entity.GetVersionsReq{ID: id, Limit: 50}).
+					Return(entity.VersionsPage{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok, defaults -> 200",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionsListMock.Expect(minimock.AnyContext, entity.GetVersionsReq{ID: id, Limit: 50}).Return(page, nil)
+			},
+		},
+		{
+			name:       "ok, paged with content -> 200",
+			entityID:   id.String(),
+			query:      "?before=Mw&limit=2&include_content=true",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVersionsListMock.Expect(minimock.AnyContext, entity.GetVersionsReq{ID: id, Before: "Mw", Limit: 2, IncludeContent: true}).
+					Return(page, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/versions", h.GetVersionsList)
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/versions"+tc.query, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
+					t.Fatalf("content-type = %q; want application/json", ct)
+				}
+				var got entity.VersionsPage
+				err := json.Unmarshal(rr.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, page, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_GetHistory(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	createdAt := time.Date(2025, 9, 22, 10, 0, 0, 0, time.UTC)
+	history := entity.History{
+		Items: []entity.HistoryItem{
+			{
+				Type:      entity.HistoryMove,
+				CreatedAt: createdAt,
+				Event:     &entity.Event{ID: 3, EntityID: id, Type: entity.EventMoved, CreatedAt: createdAt},
+			},
+			{
+				Type:      entity.HistoryVersion,
+				CreatedAt: createdAt,
+				Version:   &entity.Entity{ID: id, Name: "Doc 1", CurrentVersion: &[]int{2}[0], CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+		},
+		NextCursor: "1758535200000000.0.2",
+	}
+	tests := []struct {
+		name       string
+		entityID   string
+		query      string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400",
+			entityID:   id.String(),
+			query:      "?limit=x",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			entityID:   id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetHistoryMock.Expect(minimock.AnyContext, entity.GetHistoryReq{ID: id, Limit: 50}).
+					Return(entity.History{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok, defaults -> 200",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetHistoryMock.Expect(minimock.AnyContext, entity.GetHistoryReq{ID: id, Limit: 50}).Return(history, nil)
+			},
+		},
+		{
+			name:       "ok, paged -> 200",
+			entityID:   id.String(),
+			query:      "?before=1758535200000000.1.7&limit=2",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetHistoryMock.Expect(minimock.AnyContext, entity.GetHistoryReq{ID: id, Before: "1758535200000000.1.7", Limit: 2}).
+					Return(history, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/history", h.GetHistory)
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/history"+tc.query, nil)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got entity.History
+				err := json.Unmarshal(rr.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, history, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_Create(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	parentID := uuid.New()
+	ent := entity_usecase.CreateEntityCmd{
+		Type:     "type",
+		Name:     "Doc 1",
+		Content:  "Content 1",
+		ParentID: &parentID,
+		IsDraft:  true,
+	}
+	want := entity_http.CreateEntityResp{ID: id}
+	body, err := json.Marshal(ent)
+	require.NoError(t, err)
+	tests := []struct {
+		name        string
+		body        []byte
+		wantStatus  int
+		wantWarning string
+		setup       func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid JSON -> 400",
+			body:       []byte("invalid"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			body:       body,
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Expect(minimock.AnyContext, ent).Return(uuid.Nil, entity.ContentUsage{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok -> 201 with Location header and entity ID JSON",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Expect(minimock.AnyContext, ent).Return(id, entity.ContentUsage{Length: 9, Limit: 100}, nil)
+			},
+		},
+		{
+			name:        "ok -> 201 with content size warning",
+			body:        body,
+			wantStatus:  http.StatusCreated,
+			wantWarning: "90/100",
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Expect(minimock.AnyContext, ent).Return(id, entity.ContentUsage{Length: 90, Limit: 100, Warning: true}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Post("/entity", h.Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/entity", bytes.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			require.Equal(t, tc.wantWarning, rr.Header().Get(entity_http.HeaderContentSizeWarning))
+			if tc.wantStatus == http.StatusCreated {
+				if loc := rr.Header().Get("Location"); loc != "/entities/"+id.String() {
+					t.Fatalf("Location header = %q; want /entities/%s", loc, id.String())
+				}
+				if ct := rr.Header().Get("Content-Type"); ct == "" || ct[:16] != "application/json" {
+					t.Fatalf("content-type = %q; want application/json", ct)
+				}
+				var got entity_http.CreateEntityResp
+				err = json.Unmarshal(rr.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			} else {
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_Update(t *testing.T) {
+	t.Parallel()
+	id := uuid.New()
+	input := entity_http.UpdateEntityInput{
+		Name:    "Doc 1 Updated",
+		Content: "Content 1 Updated",
+	}
+	cmd := entity_usecase.UpdateEntityCmd{
+		ID:      id,
+		Name:    input.Name,
+		Content: input.Content,
+	}
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+	move := entity.SpaceMove{FromSpaceID: uuid.New(), ToSpaceID: uuid.New(), Grants: entity.GrantsRemap,
+		Revoked: []entity.Grant{{UserID: uuid.New(), Role: auth.RoleRead, EntityID: id}},
+		Granted: []entity.Grant{{UserID: uuid.New(), Role: auth.RoleWrite, EntityID: id}}}
+	tests := []struct {
+		name        string
+		entityID    string
+		body        []byte
+		wantStatus  int
+		wantWarning string
+		wantBroken  string
+		wantMove    *entity.SpaceMove
+		setup       func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       []byte("invalid"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(entity.ContentUsage{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(entity.ContentUsage{Length: 17, Limit: 100}, nil)
+			},
+		},
+		{
+			name:       "ok with change summary -> 204",
+			entityID:   id.String(),
+			body:       []byte(`{"name":"Doc 1 Updated","content":"Content 1 Updated","summary":"Fix typo"}`),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				withSummary := cmd
+				withSummary.Summary = "Fix typo"
+				s.UpdateMock.Expect(minimock.AnyContext, withSummary).Return(entity.ContentUsage{Length: 17, Limit: 100}, nil)
+			},
+		},
+		{
+			name:       "ok minor edit -> 204",
+			entityID:   id.String(),
+			body:       []byte(`{"name":"Doc 1 Updated","content":"Content 1 Updated","minor_edit":true}`),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				minor := cmd
+				minor.MinorEdit = true
+				s.UpdateMock.Expect(minimock.AnyContext, minor).Return(entity.ContentUsage{Length: 17, Limit: 100}, nil)
+			},
+		},
+		{
+			name:        "ok -> 204 with content size warning",
+			entityID:    id.String(),
+			body:        body,
+			wantStatus:  http.StatusNoContent,
+			wantWarning: "95/100",
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(entity.ContentUsage{Length: 95, Limit: 100, Warning: true}, nil)
+			},
+		},
+		{
+			name:       "ok -> 204 with broken links",
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			wantBroken: id.String() + "," + id.String(),
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(entity.ContentUsage{Length: 17, Limit: 100, BrokenLinks: []uuid.UUID{id, id}}, nil)
+			},
+		},
+		{
+			name:       "space move not confirmed -> 409",
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateMock.Expect(minimock.AnyContext, cmd).Return(entity.ContentUsage{}, entity.ErrSpaceMoveNotConfirmed(uuid.New(), id))
+			},
+		},
+		{
+			name:       "space move -> 200 with the grants changed",
+			entityID:   id.String(),
+			body:       []byte(`{"name":"Doc 1 Updated","content":"Content 1 Updated","confirm_cross_space":true,"grants":"remap"}`),
+			wantStatus: http.StatusOK,
+			wantMove:   &move,
+			setup: func(s *mocks.ServiceMock) {
+				confirmed := cmd
+				confirmed.ConfirmCrossSpace = true
+				confirmed.Grants = entity.GrantsRemap
+				s.UpdateMock.Expect(minimock.AnyContext, confirmed).Return(entity.ContentUsage{Length: 17, Limit: 100, SpaceMove: &move}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}", h.Update)
+
+			req := httptest.NewRequest(http.MethodPut, "/entity/"+tc.entityID, bytes.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			require.Equal(t, tc.wantWarning, rr.Header().Get(entity_http.HeaderContentSizeWarning))
+			require.Equal(t, tc.wantBroken, rr.Header().Get(entity_http.HeaderBrokenLinks))
+			switch {
+			case tc.wantMove != nil:
+				var got entity.SpaceMove
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, *tc.wantMove, got)
+			case tc.wantStatus != http.StatusNoContent:
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	report := entity.DeleteReport{DryRun: true, Entities: []entity.DeletedEntity{{ID: id, Type: entity.TypeArticle, Name: "doc"}}, Count: 1}
+	tests := []struct {
+		name       string
+		entityID   string
+		query      string
+		wantStatus int
+		wantReport *entity.DeleteReport
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error -> 500",
+			entityID:   id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteMock.Expect(minimock.AnyContext, id, false).Return(entity.DeleteReport{}, fmt.Errorf("handler error"))
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteMock.Expect(minimock.AnyContext, id, false).Return(entity.DeleteReport{Count: 1}, nil)
+			},
+		},
+		{
+			name:       "invalid dry_run -> 400",
+			entityID:   id.String(),
+			query:      "?dry_run=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "dry run -> 200 with the report",
+			entityID:   id.String(),
+			query:      "?dry_run=true",
+			wantStatus: http.StatusOK,
+			wantReport: &report,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteMock.Expect(minimock.AnyContext, id, true).Return(report, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Delete("/entity/{"+entity_http.URLParamEntityID+"}", h.Delete)
+
+			req := httptest.NewRequest(http.MethodDelete, "/entity/"+tc.entityID+tc.query, nil)
+
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			switch {
+			case tc.wantReport != nil:
+				var got entity.DeleteReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, *tc.wantReport, got)
+			case tc.wantStatus != http.StatusNoContent:
+				requireProblem(t, rr)
+			}
+		})
+	}
+}
+
+func TestHandler_Lock(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	lock := entity.Lock{
+		EntityID:   id,
+		UserID:     uuid.New(),
+		AcquiredAt: time.Date(2025, 9, 4, 10, 0, 0, 0, time.UTC),
+		ExpiresAt:  time.Date(2025, 9, 4, 10, 15, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "locked by another user -> 423",
+			entityID:   id.String(),
+			wantStatus: http.StatusLocked,
+			setup: func(s *mocks.ServiceMock) {
+				s.LockMock.Expect(minimock.AnyContext, id).Return(entity.Lock{}, entity.ErrEntityLocked(lock))
+			},
+		},
+		{
+			name:       "ok -> 200 with lock JSON",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.LockMock.Expect(minimock.AnyContext, id).Return(lock, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Post("/entity/{"+entity_http.URLParamEntityID+"}/lock", h.Lock)
+
+			req := httptest.NewRequest(http.MethodPost, "/entity/"+tc.entityID+"/lock", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			switch tc.wantStatus {
+			case http.StatusOK:
+				var got entity.Lock
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, lock, got)
+			case http.StatusLocked:
+				require.Contains(t, rr.Body.String(), lock.UserID.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Unlock(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not locked -> 404",
+			entityID:   id.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.UnlockMock.Expect(minimock.AnyContext, id).Return(entity.ErrLockNotFound())
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.UnlockMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Post("/entity/{"+entity_http.URLParamEntityID+"}/unlock", h.Unlock)
+
+			req := httptest.NewRequest(http.MethodPost, "/entity/"+tc.entityID+"/unlock", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_TransferOwnership(t *testing.T) {
+	t.Parallel()
+
+	id, ownerID := uuid.New(), uuid.New()
+	tests := []struct {
+		name       string
+		entityID   string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       `{"owner_id":"` + ownerID.String() + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       `{"owner_id":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "owner not found -> 400",
+			entityID:   id.String(),
+			body:       `{"owner_id":"` + ownerID.String() + `"}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.TransferOwnershipMock.Expect(minimock.AnyContext, id, ownerID).Return(entity.ErrOwnerNotFound())
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			body:       `{"owner_id":"` + ownerID.String() + `"}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.TransferOwnershipMock.Expect(minimock.AnyContext, id, ownerID).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/owner", h.TransferOwnership)
+
+			req := httptest.NewRequest(http.MethodPut, "/entity/"+tc.entityID+"/owner", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_UpdateAppearance(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	icon, cover := "🚀", ""
+	tests := []struct {
+		name       string
+		entityID   string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       `{"icon":"🚀"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       `{"icon":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid icon -> 400",
+			entityID:   id.String(),
+			body:       `{"icon":"rocket"}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateAppearanceMock.Return(entity.ErrInvalidIcon())
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			body:       `{"icon":"🚀","cover":""}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.UpdateAppearanceMock.Expect(minimock.AnyContext, id, entity.UpdateAppearanceReq{Icon: &icon, Cover: &cover}).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Patch("/entity/{"+entity_http.URLParamEntityID+"}/appearance", h.UpdateAppearance)
+
+			req := httptest.NewRequest(http.MethodPatch, "/entity/"+tc.entityID+"/appearance", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_ReorderChildren(t *testing.T) {
+	t.Parallel()
+
+	id, childID := uuid.New(), uuid.New()
+	tests := []struct {
+		name       string
+		entityID   string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       `{"ids":["` + childID.String() + `"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       `{"ids":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not children -> 400",
+			entityID:   id.String(),
+			body:       `{"ids":["` + childID.String() + `"]}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.ReorderChildrenMock.Expect(minimock.AnyContext, id, entity.ChildrenOrderReq{IDs: []uuid.UUID{childID}}).
+					Return(entity.ErrNotChildren())
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			body:       `{"ids":["` + childID.String() + `"]}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.ReorderChildrenMock.Expect(minimock.AnyContext, id, entity.ChildrenOrderReq{IDs: []uuid.UUID{childID}}).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Patch("/entity/{"+entity_http.URLParamEntityID+"}/children/order", h.ReorderChildren)
+
+			req := httptest.NewRequest(http.MethodPatch, "/entity/"+tc.entityID+"/children/order", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Snapshots(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	snapshot := entity.Snapshot{ID: uuid.New(), EntityID: id, Label: "v2.3", EntityCount: 2}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "create: invalid UUID -> 400",
+			method:     http.MethodPost,
+			path:       "/entity/invalid/snapshots",
+			body:       `{"label":"v2.3"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "create: invalid JSON -> 400",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/snapshots",
+			body:       `{"label":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "create: label taken -> 409",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/snapshots",
+			body:       `{"label":"v2.3"}`,
+			wantStatus: http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateSnapshotMock.Expect(minimock.AnyContext, id, entity.CreateSnapshotReq{Label: "v2.3"}).
+					Return(entity.Snapshot{}, entity.ErrSnapshotLabelTaken())
+			},
+		},
+		{
+			name:       "create: ok -> 201",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/snapshots",
+			body:       `{"label":"v2.3"}`,
+			wantStatus: http.StatusCreated,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateSnapshotMock.Expect(minimock.AnyContext, id, entity.CreateSnapshotReq{Label: "v2.3"}).Return(snapshot, nil)
+			},
+		},
+		{
+			name:       "list: ok -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/snapshots",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetSnapshotsMock.Expect(minimock.AnyContext, id).Return([]entity.Snapshot{snapshot}, nil)
+			},
+		},
+		{
+			name:       "get: invalid UUID -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/invalid/snapshots/v2.3",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "get: not found -> 404",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/snapshots/v2.4",
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetSnapshotMock.Expect(minimock.AnyContext, id, "v2.4").Return(entity.SnapshotContent{}, entity.ErrSnapshotNotFound())
+			},
+		},
+		{
+			name:       "get: ok -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/snapshots/v2.3",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetSnapshotMock.Expect(minimock.AnyContext, id, "v2.3").Return(entity.SnapshotContent{Snapshot: snapshot}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Post("/entity/{"+entity_http.URLParamEntityID+"}/snapshots", h.CreateSnapshot)
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/snapshots", h.GetSnapshots)
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/snapshots/{"+entity_http.URLParamLabel+"}", h.GetSnapshot)
+
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Variables(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	v := entity.Variable{EntityID: id, Key: "product", Value: "EasyGoDocs"}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "list: invalid UUID -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/invalid/variables",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "list: ok -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/variables",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVariablesMock.Expect(minimock.AnyContext, id).Return([]entity.Variable{v}, nil)
+			},
+		},
+		{
+			name:       "set: invalid JSON -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variables/product",
+			body:       `{"value":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "set: invalid key -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variables/1st",
+			body:       `{"value":"x"}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetVariableMock.Expect(minimock.AnyContext, id, "1st", entity.SetVariableReq{Value: "x"}).
+					Return(entity.Variable{}, entity.ErrInvalidVariableKey())
+			},
+		},
+		{
+			name:       "set: ok -> 200",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variables/product",
+			body:       `{"value":"EasyGoDocs"}`,
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetVariableMock.Expect(minimock.AnyContext, id, "product", entity.SetVariableReq{Value: "EasyGoDocs"}).Return(v, nil)
+			},
+		},
+		{
+			name:       "delete: not found -> 404",
+			method:     http.MethodDelete,
+			path:       "/entity/" + id.String() + "/variables/product",
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteVariableMock.Expect(minimock.AnyContext, id, "product").Return(entity.ErrVariableNotFound())
+			},
+		},
+		{
+			name:       "delete: ok -> 204",
+			method:     http.MethodDelete,
+			path:       "/entity/" + id.String() + "/variables/product",
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteVariableMock.Expect(minimock.AnyContext, id, "product").Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/variables", h.GetVariables)
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/variables/{"+entity_http.URLParamKey+"}", h.SetVariable)
+			r.Delete("/entity/{"+entity_http.URLParamEntityID+"}/variables/{"+entity_http.URLParamKey+"}", h.DeleteVariable)
+
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Variants(t *testing.T) {
+	t.Parallel()
+
+	id, variantID := uuid.New(), uuid.New()
+	variants := []entity.Variant{{EntityID: id, Language: "en"}, {EntityID: variantID, Language: "ru"}}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "list: invalid UUID -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/invalid/variants",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "list: ok -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/variants",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetVariantsMock.Expect(minimock.AnyContext, id).Return(variants, nil)
+			},
+		},
+		{
+			name:       "create: invalid JSON -> 400",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/variants",
+			body:       `{"language":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "create: no language -> 404",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/variants",
+			body:       `{"language":"ru","name":"Doc"}`,
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateVariantMock.Expect(minimock.AnyContext, id, entity_usecase.CreateVariantCmd{Language: "ru", Name: "Doc"}).
+					Return(uuid.Nil, entity.ContentUsage{}, entity.ErrNoLanguage())
+			},
+		},
+		{
+			name:       "create: ok -> 201",
+			method:     http.MethodPost,
+			path:       "/entity/" + id.String() + "/variants",
+			body:       `{"language":"ru","name":"Doc"}`,
+			wantStatus: http.StatusCreated,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateVariantMock.Expect(minimock.AnyContext, id, entity_usecase.CreateVariantCmd{Language: "ru", Name: "Doc"}).
+					Return(variantID, entity.ContentUsage{}, nil)
+			},
+		},
+		{
+			name:       "link: invalid variant UUID -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variants/invalid",
+			body:       `{"language":"ru"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "link: language taken -> 409",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variants/" + variantID.String(),
+			body:       `{"language":"ru"}`,
+			wantStatus: http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.LinkVariantMock.Expect(minimock.AnyContext, id, variantID, entity.SetLanguageReq{Language: "ru"}).
+					Return(entity.ErrLanguageTaken())
+			},
+		},
+		{
+			name:       "link: ok -> 204",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/variants/" + variantID.String(),
+			body:       `{"language":"ru"}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.LinkVariantMock.Expect(minimock.AnyContext, id, variantID, entity.SetLanguageReq{Language: "ru"}).Return(nil)
+			},
+		},
+		{
+			name:       "set language: invalid language -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/language",
+			body:       `{"language":"english"}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetLanguageMock.Expect(minimock.AnyContext, id, entity.SetLanguageReq{Language: "english"}).
+					Return(entity.ErrInvalidLanguage())
+			},
+		},
+		{
+			name:       "set language: ok -> 204",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/language",
+			body:       `{"language":"en"}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetLanguageMock.Expect(minimock.AnyContext, id, entity.SetLanguageReq{Language: "en"}).Return(nil)
+			},
+		},
+		{
+			name:       "delete language: ok -> 204",
+			method:     http.MethodDelete,
+			path:       "/entity/" + id.String() + "/language",
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.UnlinkVariantMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/variants", h.GetVariants)
+			r.Post("/entity/{"+entity_http.URLParamEntityID+"}/variants", h.CreateVariant)
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/variants/{"+entity_http.URLParamVariantID+"}", h.PutVariant)
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/language", h.SetLanguage)
+			r.Delete("/entity/{"+entity_http.URLParamEntityID+"}/language", h.DeleteLanguage)
+
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusCreated {
+				require.Equal(t, "/entities/"+variantID.String(), rr.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestHandler_GetMissingTranslations(t *testing.T) {
+	t.Parallel()
+
+	report := []entity.MissingTranslation{{GroupID: uuid.New(), Variants: []entity.Variant{{EntityID: uuid.New(), Language: "en"}}, Missing: []string{"ru"}}}
+
+	t.Run("ok -> 200", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetMissingTranslationsMock.Expect(minimock.AnyContext).Return(report, nil)
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetMissingTranslations(rr, httptest.NewRequest(http.MethodGet, "/entities/missing-translations", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var got []entity.MissingTranslation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+		require.Equal(t, report, got)
+	})
+	t.Run("forbidden -> 403", func(t *testing.T) {
+		t.Parallel()
+		mock := mocks.NewServiceMock(t)
+		mock.GetMissingTranslationsMock.Expect(minimock.AnyContext).Return(nil, apperr.ErrForbidden())
+
+		rr := httptest.NewRecorder()
+		entity_http.NewHandler(mock, testSanitizer(t)).GetMissingTranslations(rr, httptest.NewRequest(http.MethodGet, "/entities/missing-translations", nil))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandler_DefaultPermissions(t *testing.T) {
+	t.Parallel()
+
+	id, userID := uuid.New(), uuid.New()
+	body := `{"permissions":[{"user_id":"` + userID.String() + `","role":"write"}]}`
+	setReq := entity.SetDefaultPermissionsReq{Permissions: []entity.DefaultPermission{{UserID: userID, Role: auth.RoleWrite}}}
+	tests := []struct {
+		name       string
+		method     string
+		entityID   string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "get: invalid UUID -> 400",
+			method:     http.MethodGet,
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "get: forbidden -> 403",
+			method:     http.MethodGet,
+			entityID:   id.String(),
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetDefaultPermissionsMock.Expect(minimock.AnyContext, id).Return(nil, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "get: ok -> 200",
+			method:     http.MethodGet,
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetDefaultPermissionsMock.Expect(minimock.AnyContext, id).Return(setReq.Permissions, nil)
+			},
+		},
+		{
+			name:       "put: invalid UUID -> 400",
+			method:     http.MethodPut,
+			entityID:   "invalid",
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: invalid JSON -> 400",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			body:       `{"permissions":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: invalid role -> 400",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetDefaultPermissionsMock.Expect(minimock.AnyContext, id, setReq).Return(entity.ErrInvalidDefaultRole())
+			},
+		},
+		{
+			name:       "put: ok -> 204 No Content",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetDefaultPermissionsMock.Expect(minimock.AnyContext, id, setReq).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			route := "/entity/{" + entity_http.URLParamEntityID + "}/default-permissions"
+			r.Get(route, h.GetDefaultPermissions)
+			r.Put(route, h.SetDefaultPermissions)
+
+			req := httptest.NewRequest(tc.method, "/entity/"+tc.entityID+"/default-permissions", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Pin(t *testing.T) {
+	t.Parallel()
+
+	id, childID := uuid.New(), uuid.New()
+	tests := []struct {
+		name       string
+		method     string
+		entityID   string
+		childID    string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "put: invalid entity UUID -> 400",
+			method:     http.MethodPut,
+			entityID:   "invalid",
+			childID:    childID.String(),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: invalid child UUID -> 400",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			childID:    "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: limit reached -> 409",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			childID:    childID.String(),
+			wantStatus: http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetPinnedMock.Expect(minimock.AnyContext, id, childID, true).Return(entity.ErrPinLimitReached(5))
+			},
+		},
+		{
+			name:       "put: ok -> 204 No Content",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			childID:    childID.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetPinnedMock.Expect(minimock.AnyContext, id, childID, true).Return(nil)
+			},
+		},
+		{
+			name:       "delete: not a child -> 400",
+			method:     http.MethodDelete,
+			entityID:   id.String(),
+			childID:    childID.String(),
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetPinnedMock.Expect(minimock.AnyContext, id, childID, false).Return(entity.ErrNotChild())
+			},
+		},
+		{
+			name:       "delete: ok -> 204 No Content",
+			method:     http.MethodDelete,
+			entityID:   id.String(),
+			childID:    childID.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetPinnedMock.Expect(minimock.AnyContext, id, childID, false).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			route := "/entity/{" + entity_http.URLParamEntityID + "}/children/{" + entity_http.URLParamChildID + "}/pin"
+			r.Put(route, h.PinChild)
+			r.Delete(route, h.UnpinChild)
+
+			req := httptest.NewRequest(tc.method, "/entity/"+tc.entityID+"/children/"+tc.childID+"/pin", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Relations(t *testing.T) {
+	t.Parallel()
+
+	id, relatedID := uuid.New(), uuid.New()
+	tests := []struct {
+		name       string
+		method     string
+		entityID   string
+		relatedID  string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "put: invalid entity UUID -> 400",
+			method:     http.MethodPut,
+			entityID:   "invalid",
+			relatedID:  relatedID.String(),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: invalid related UUID -> 400",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			relatedID:  "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "put: forbidden -> 403",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			relatedID:  relatedID.String(),
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.AddRelationMock.Expect(minimock.AnyContext, id, relatedID).Return(apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "put: ok -> 204 No Content",
+			method:     http.MethodPut,
+			entityID:   id.String(),
+			relatedID:  relatedID.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.AddRelationMock.Expect(minimock.AnyContext, id, relatedID).Return(nil)
+			},
+		},
+		{
+			name:       "delete: invalid related UUID -> 400",
+			method:     http.MethodDelete,
+			entityID:   id.String(),
+			relatedID:  "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "delete: not related -> 404",
+			method:     http.MethodDelete,
+			entityID:   id.String(),
+			relatedID:  relatedID.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteRelationMock.Expect(minimock.AnyContext, id, relatedID).Return(entity.ErrRelationNotFound())
+			},
+		},
+		{
+			name:       "delete: ok -> 204 No Content",
+			method:     http.MethodDelete,
+			entityID:   id.String(),
+			relatedID:  relatedID.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteRelationMock.Expect(minimock.AnyContext, id, relatedID).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			route := "/entity/{" + entity_http.URLParamEntityID + "}/relations/{" + entity_http.URLParamRelatedID + "}"
+			r.Put(route, h.PutRelation)
+			r.Delete(route, h.DeleteRelation)
+
+			req := httptest.NewRequest(tc.method, "/entity/"+tc.entityID+"/relations/"+tc.relatedID, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_GetLock(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	lock := entity.Lock{EntityID: id, UserID: uuid.New(), ExpiresAt: time.Date(2025, 9, 4, 10, 15, 0, 0, time.UTC)}
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not locked -> 404",
+			entityID:   id.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetLockMock.Expect(minimock.AnyContext, id).Return(entity.Lock{}, entity.ErrLockNotFound())
+			},
+		},
+		{
+			name:       "ok -> 200 with lock JSON",
+			entityID:   id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetLockMock.Expect(minimock.AnyContext, id).Return(lock, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/lock", h.GetLock)
+
+			req := httptest.NewRequest(http.MethodGet, "/entity/"+tc.entityID+"/lock", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got entity.Lock
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, lock, got)
+			}
+		})
+	}
+}
+
+func requireProblem(t *testing.T, rr *httptest.ResponseRecorder) {
+	t.Helper()
+	require.Equal(t, httpx.ProblemContentType, rr.Header().Get("Content-Type"))
+	var problem apperr.Problem
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	require.Equal(t, rr.Code, problem.Status)
+	require.Equal(t, apperr.TypeURI(problem.Code), problem.Type)
+	require.NotEmpty(t, problem.Title)
+}
+
+// testSanitizer is the default sanitize policy.
+func testSanitizer(t *testing.T) *sanitize.Policy {
+	t.Helper()
+	p, err := sanitize.New(sanitize.Config{
+		AllowedTags:       sanitize.DefaultAllowedTags,
+		AllowedAttributes: sanitize.DefaultAllowedAttributes,
+		AllowedSchemes:    sanitize.DefaultAllowedSchemes,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestHandler_Autosave(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	autosave := entity.Autosave{Content: "unsaved", SavedAt: time.Date(2025, 9, 23, 10, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name       string
+		entityID   string
+		body       string
+		wantStatus int
+		wantBody   string
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       `{"content":"unsaved"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "too long -> 413",
+			entityID:   id.String(),
+			body:       `{"content":"unsaved"}`,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			setup: func(s *mocks.ServiceMock) {
+				s.AutosaveMock.Return(entity.Autosave{}, entity.ErrContentTooLong(1))
+			},
+		},
+		{
+			name:       "ok -> 200",
+			entityID:   id.String(),
+			body:       `{"content":"unsaved"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"content":"unsaved","saved_at":"2025-09-23T10:00:00Z"}`,
+			setup: func(s *mocks.ServiceMock) {
+				s.AutosaveMock.Expect(minimock.AnyContext, id, "unsaved").Return(autosave, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Patch("/entity/{"+entity_http.URLParamEntityID+"}/draft", h.Autosave)
+
+			req := httptest.NewRequest(http.MethodPatch, "/entity/"+tc.entityID+"/draft", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Merge(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	theirs := 3
+	tests := []struct {
+		name       string
+		entityID   string
+		body       string
+		wantStatus int
+		wantBody   string
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			body:       `{"base_version":1,"content":"mine"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON -> 400",
+			entityID:   id.String(),
+			body:       "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not found -> 404",
+			entityID:   id.String(),
+			body:       `{"base_version":9,"content":"mine"}`,
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.MergeMock.Return(entity.MergeResult{}, entity.ErrEntityNotFound())
+			},
+		},
+		{
+			name:       "ok -> 200",
+			entityID:   id.String(),
+			body:       `{"base_version":1,"content":"mine","theirs_version":3}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"content":"merged","conflicts":1,"theirs_version":3}`,
+			setup: func(s *mocks.ServiceMock) {
+				s.MergeMock.Expect(minimock.AnyContext, entity.MergeReq{ID: id, BaseVersion: 1, Mine: "mine", TheirsVersion: &theirs}).
+					Return(entity.MergeResult{Content: "merged", Conflicts: 1, TheirsVersion: &theirs}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Post("/entity/{"+entity_http.URLParamEntityID+"}/merge", h.Merge)
+
+			req := httptest.NewRequest(http.MethodPost, "/entity/"+tc.entityID+"/merge", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_DiscardAutosave(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name       string
+		entityID   string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			entityID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no autosave -> 404",
+			entityID:   id.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.DiscardAutosaveMock.Expect(minimock.AnyContext, id).Return(entity.ErrAutosaveNotFound())
+			},
+		},
+		{
+			name:       "ok -> 204 No Content",
+			entityID:   id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.DiscardAutosaveMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Delete("/entity/{"+entity_http.URLParamEntityID+"}/draft", h.DiscardAutosave)
+
+			req := httptest.NewRequest(http.MethodDelete, "/entity/"+tc.entityID+"/draft", nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_BatchGet(t *testing.T) {
+	t.Parallel()
+
+	found, missing := uuid.New(), uuid.New()
+	notFound := apperr.ToProblem(entity.ErrEntityNotFound())
+	tests := []struct {
+		name       string
+		body       string
+		lang       string
+		wantStatus int
+		wantBody   string
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid JSON -> 400",
+			body:       "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no ids -> 400",
+			body:       `{"ids":[]}`,
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.BatchGetMock.Return(entity.BatchGetResult{}, entity.ErrInvalidBatchSize(entity.MaxBatchGetIDs))
+			},
+		},
+		{
+			name:       "ok, per-id errors localized -> 200",
+			body:       `{"ids":["` + found.String() + `","` + missing.String() + `"]}`,
+			lang:       "ru",
+			wantStatus: http.StatusOK,
+			wantBody: `{"items":[{"id":"` + found.String() + `","item":{"id":"` + found.String() + `","type":"article","name":"a",` +
+				`"slug":"a","owner_id":"00000000-0000-0000-0000-000000000000","word_count":0,"reading_time_minutes":0}},` +
+				`{"id":"` + missing.String() + `","error":{"type":"urn:easygodocs:problem:entity/not_found","title":"Сущность не найдена",` +
+				`"status":404,"detail":"Сущность не найдена","code":"entity/not_found"}}]}`,
+			setup: func(s *mocks.ServiceMock) {
+				s.BatchGetMock.Expect(minimock.AnyContext, entity.BatchGetReq{IDs: []uuid.UUID{found, missing}}).
+					Return(entity.BatchGetResult{Items: []entity.BatchGetItem{
+						{ID: found, Item: &entity.ListItem{ID: found, Type: entity.TypeArticle, Name: "a", Slug: "a"}},
+						{ID: missing, Error: &notFound},
+					}}, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+
+			req := httptest.NewRequest(http.MethodPost, "/entities/batch-get", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			ctx := req.Context()
+			if tc.lang != "" {
+				ctx = i18n.WithLanguage(ctx, i18n.Negotiate(tc.lang))
+			}
+			rr := httptest.NewRecorder()
+
+			h.BatchGet(rr, req.WithContext(ctx))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Lint(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	report := entity.LintReport{EntityID: id, Version: 3, Status: entity.LintDone,
+		Findings: []entity.LintFinding{{Offset: 0, Length: 5, Message: "Spelling", Rule: "MORFOLOGIK_RULE_EN_US", Severity: entity.LintError}}}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "report: invalid UUID -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/invalid/lint",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "report: invalid version -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/lint?version=latest",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "report: current version -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/lint",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetLintReportMock.Expect(minimock.AnyContext, id, 0).Return(report, nil)
+			},
+		},
+		{
+			name:       "report: version -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/lint?version=3",
+			wantStatus: http.StatusOK,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetLintReportMock.Expect(minimock.AnyContext, id, 3).Return(report, nil)
+			},
+		},
+		{
+			name:       "report: not found -> 404",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/lint?version=9",
+			wantStatus: http.StatusNotFound,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetLintReportMock.Return(entity.LintReport{}, entity.ErrEntityNotFound())
+			},
+		},
+		{
+			name:       "settings: invalid JSON -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/lint/settings",
+			body:       `{"disabled":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "settings: forbidden -> 403",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/lint/settings",
+			body:       `{"disabled":true}`,
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetLintSettingsMock.Expect(minimock.AnyContext, id, entity.LintSettings{Disabled: true}).Return(apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "settings: ok -> 204",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/lint/settings",
+			body:       `{"disabled":true}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetLintSettingsMock.Expect(minimock.AnyContext, id, entity.LintSettings{Disabled: true}).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/lint", h.GetLintReport)
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/lint/settings", h.SetLintSettings)
+
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusOK {
+				var got entity.LintReport
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Equal(t, report, got)
+			}
+		})
+	}
+}
+
+func TestHandler_SpaceSettings(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	settings := entity.SpaceSettings{DefaultLanguage: "en", Review: entity.ReviewSettings{RequireSummary: true},
+		Retention: &entity.SpaceRetention{KeepLastVersions: 5}}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "get: invalid UUID -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/invalid/settings",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "get: not a space -> 400",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/settings",
+			wantStatus: http.StatusBadRequest,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetSpaceMock.Expect(minimock.AnyContext, id).Return(entity.Space{}, entity.ErrNotSpace())
+			},
+		},
+		{
+			name:       "get: ok -> 200",
+			method:     http.MethodGet,
+			path:       "/entity/" + id.String() + "/settings",
+			wantStatus: http.StatusOK,
+			wantBody: `{"entity_id":"` + id.String() + `","owner_id":"` + uuid.Nil.String() + `","settings":{"default_language":"en",` +
+				`"review":{"require_summary":true,"no_minor_edits":false},"public":false,"retention":{"keep_last_versions":5,"keep_days":0}}}`,
+			setup: func(s *mocks.ServiceMock) {
+				s.GetSpaceMock.Expect(minimock.AnyContext, id).Return(entity.Space{EntityID: id, Settings: settings}, nil)
+			},
+		},
+		{
+			name:       "set: invalid UUID -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/invalid/settings",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "set: invalid JSON -> 400",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/settings",
+			body:       `{"public":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "set: forbidden -> 403",
+			method:     http.MethodPut,
+			path:       "/entity/" + id.String() + "/settings",
+			body:       `{"public":true}`,
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetSpaceSettingsMock.Expect(minimock.AnyContext, id, entity.SpaceSettings{Public: true}).Return(entity.SpaceSettings{}, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:   "set: ok -> 200",
+			method: http.MethodPut,
+			path:   "/entity/" + id.String() + "/settings",
+			body: `{"default_language":"en","review":{"require_summary":true},` +
+				`"retention":{"keep_last_versions":5}}`,
+			wantStatus: http.StatusOK,
+			wantBody: `{"default_language":"en","review":{"require_summary":true,"no_minor_edits":false},"public":false,` +
+				`"retention":{"keep_last_versions":5,"keep_days":0}}`,
+			setup: func(s *mocks.ServiceMock) {
+				s.SetSpaceSettingsMock.Expect(minimock.AnyContext, id, settings).Return(settings, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := entity_http.NewHandler(mock, testSanitizer(t))
+			r := chi.NewRouter()
+
+			r.Get("/entity/{"+entity_http.URLParamEntityID+"}/settings", h.GetSpaceSettings)
+			r.Put("/entity/{"+entity_http.URLParamEntityID+"}/settings", h.SetSpaceSettings)
+
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
 			}
 		})
 	}
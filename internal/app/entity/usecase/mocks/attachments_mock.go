@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/entity/usecase.Attachments -o attachments_mock.go -n AttachmentsMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/attachment"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AttachmentsMock implements mm_usecase.Attachments
+type AttachmentsMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func(ctx context.Context, key string) (a1 attachment.Attachment, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, key string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mAttachmentsMockGet
+}
+
+// NewAttachmentsMock returns a mock for mm_usecase.Attachments
+func NewAttachmentsMock(t minimock.Tester) *AttachmentsMock {
+	m := &AttachmentsMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mAttachmentsMockGet{mock: m}
+	m.GetMock.callArgs = []*AttachmentsMockGetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAttachmentsMockGet struct {
+	optional           bool
+	mock               *AttachmentsMock
+	defaultExpectation *AttachmentsMockGetExpectation
+	expectations       []*AttachmentsMockGetExpectation
+
+	callArgs []*AttachmentsMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentsMockGetExpectation specifies expectation struct of the Attachments.Get
+type AttachmentsMockGetExpectation struct {
+	mock               *AttachmentsMock
+	params             *AttachmentsMockGetParams
+	paramPtrs          *AttachmentsMockGetParamPtrs
+	expectationOrigins AttachmentsMockGetExpectationOrigins
+	results            *AttachmentsMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentsMockGetParams contains parameters of the Attachments.Get
+type AttachmentsMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// AttachmentsMockGetParamPtrs contains pointers to parameters of the Attachments.Get
+type AttachmentsMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// AttachmentsMockGetResults contains results of the Attachments.Get
+type AttachmentsMockGetResults struct {
+	a1  attachment.Attachment
+	err error
+}
+
+// AttachmentsMockGetOrigins contains origins of expectations of the Attachments.Get
+type AttachmentsMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mAttachmentsMockGet) Optional() *mAttachmentsMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Attachments.Get
+func (mmGet *mAttachmentsMockGet) Expect(ctx context.Context, key string) *mAttachmentsMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AttachmentsMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &AttachmentsMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Attachments.Get
+func (mmGet *mAttachmentsMockGet) ExpectCtxParam1(ctx context.Context) *mAttachmentsMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AttachmentsMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AttachmentsMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for Attachments.Get
+func (mmGet *mAttachmentsMockGet) ExpectKeyParam2(key string) *mAttachmentsMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AttachmentsMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AttachmentsMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Attachments.Get
+func (mmGet *mAttachmentsMockGet) Inspect(f func(ctx context.Context, key string)) *mAttachmentsMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for AttachmentsMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Attachments.Get
+func (mmGet *mAttachmentsMockGet) Return(a1 attachment.Attachment, err error) *AttachmentsMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AttachmentsMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &AttachmentsMockGetResults{a1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Attachments.Get method
+func (mmGet *mAttachmentsMockGet) Set(f func(ctx context.Context, key string) (a1 attachment.Attachment, err error)) *AttachmentsMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Attachments.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Attachments.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Attachments.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mAttachmentsMockGet) When(ctx context.Context, key string) *AttachmentsMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AttachmentsMock.Get mock is already set by Set")
+	}
+
+	expectation := &AttachmentsMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &AttachmentsMockGetParams{ctx, key},
+		expectationOrigins: AttachmentsMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Attachments.Get return parameters for the expectation previously defined by the When method
+func (e *AttachmentsMockGetExpectation) Then(a1 attachment.Attachment, err error) *AttachmentsMock {
+	e.results = &AttachmentsMockGetResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times Attachments.Get should be invoked
+func (mmGet *mAttachmentsMockGet) Times(n uint64) *mAttachmentsMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of AttachmentsMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mAttachmentsMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_usecase.Attachments
+func (mmGet *AttachmentsMock) Get(ctx context.Context, key string) (a1 attachment.Attachment, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := AttachmentsMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentsMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("AttachmentsMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("AttachmentsMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("AttachmentsMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the AttachmentsMock.Get")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to AttachmentsMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished AttachmentsMock.Get invocations
+func (mmGet *AttachmentsMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of AttachmentsMock.Get invocations
+func (mmGet *AttachmentsMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentsMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mAttachmentsMockGet) Calls() []*AttachmentsMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*AttachmentsMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *AttachmentsMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *AttachmentsMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentsMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentsMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentsMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentsMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentsMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AttachmentsMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AttachmentsMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AttachmentsMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone()
+}
@@ -0,0 +1,772 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/entity/usecase.Granter -o granter_mock.go -n GranterMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/gojuno/minimock/v3"
+)
+
+// GranterMock implements mm_usecase.Granter
+type GranterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddUserRole          func(ctx context.Context, userRole auth.UserRole) (err error)
+	funcAddUserRoleOrigin    string
+	inspectFuncAddUserRole   func(ctx context.Context, userRole auth.UserRole)
+	afterAddUserRoleCounter  uint64
+	beforeAddUserRoleCounter uint64
+	AddUserRoleMock          mGranterMockAddUserRole
+
+	funcDeleteUserRole          func(ctx context.Context, userRole auth.UserRole) (err error)
+	funcDeleteUserRoleOrigin    string
+	inspectFuncDeleteUserRole   func(ctx context.Context, userRole auth.UserRole)
+	afterDeleteUserRoleCounter  uint64
+	beforeDeleteUserRoleCounter uint64
+	DeleteUserRoleMock          mGranterMockDeleteUserRole
+}
+
+// NewGranterMock returns a mock for mm_usecase.Granter
+func NewGranterMock(t minimock.Tester) *GranterMock {
+	m := &GranterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddUserRoleMock = mGranterMockAddUserRole{mock: m}
+	m.AddUserRoleMock.callArgs = []*GranterMockAddUserRoleParams{}
+
+	m.DeleteUserRoleMock = mGranterMockDeleteUserRole{mock: m}
+	m.DeleteUserRoleMock.callArgs = []*GranterMockDeleteUserRoleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGranterMockAddUserRole struct {
+	optional           bool
+	mock               *GranterMock
+	defaultExpectation *GranterMockAddUserRoleExpectation
+	expectations       []*GranterMockAddUserRoleExpectation
+
+	callArgs []*GranterMockAddUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GranterMockAddUserRoleExpectation specifies expectation struct of the Granter.AddUserRole
+type GranterMockAddUserRoleExpectation struct {
+	mock               *GranterMock
+	params             *GranterMockAddUserRoleParams
+	paramPtrs          *GranterMockAddUserRoleParamPtrs
+	expectationOrigins GranterMockAddUserRoleExpectationOrigins
+	results            *GranterMockAddUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GranterMockAddUserRoleParams contains parameters of the Granter.AddUserRole
+type GranterMockAddUserRoleParams struct {
+	ctx      context.Context
+	userRole auth.UserRole
+}
+
+// GranterMockAddUserRoleParamPtrs contains pointers to parameters of the Granter.AddUserRole
+type GranterMockAddUserRoleParamPtrs struct {
+	ctx      *context.Context
+	userRole *auth.UserRole
+}
+
+// GranterMockAddUserRoleResults contains results of the Granter.AddUserRole
+type GranterMockAddUserRoleResults struct {
+	err error
+}
+
+// GranterMockAddUserRoleOrigins contains origins of expectations of the Granter.AddUserRole
+type GranterMockAddUserRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddUserRole *mGranterMockAddUserRole) Optional() *mGranterMockAddUserRole {
+	mmAddUserRole.optional = true
+	return mmAddUserRole
+}
+
+// Expect sets up expected params for Granter.AddUserRole
+func (mmAddUserRole *mGranterMockAddUserRole) Expect(ctx context.Context, userRole auth.UserRole) *mGranterMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &GranterMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmAddUserRole.defaultExpectation.params = &GranterMockAddUserRoleParams{ctx, userRole}
+	mmAddUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddUserRole.expectations {
+		if minimock.Equal(e.params, mmAddUserRole.defaultExpectation.params) {
+			mmAddUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmAddUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Granter.AddUserRole
+func (mmAddUserRole *mGranterMockAddUserRole) ExpectCtxParam1(ctx context.Context) *mGranterMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &GranterMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.params != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Expect")
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs == nil {
+		mmAddUserRole.defaultExpectation.paramPtrs = &GranterMockAddUserRoleParamPtrs{}
+	}
+	mmAddUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddUserRole
+}
+
+// ExpectUserRoleParam2 sets up expected param userRole for Granter.AddUserRole
+func (mmAddUserRole *mGranterMockAddUserRole) ExpectUserRoleParam2(userRole auth.UserRole) *mGranterMockAddUserRole {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &GranterMockAddUserRoleExpectation{}
+	}
+
+	if mmAddUserRole.defaultExpectation.params != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Expect")
+	}
+
+	if mmAddUserRole.defaultExpectation.paramPtrs == nil {
+		mmAddUserRole.defaultExpectation.paramPtrs = &GranterMockAddUserRoleParamPtrs{}
+	}
+	mmAddUserRole.defaultExpectation.paramPtrs.userRole = &userRole
+	mmAddUserRole.defaultExpectation.expectationOrigins.originUserRole = minimock.CallerInfo(1)
+
+	return mmAddUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the Granter.AddUserRole
+func (mmAddUserRole *mGranterMockAddUserRole) Inspect(f func(ctx context.Context, userRole auth.UserRole)) *mGranterMockAddUserRole {
+	if mmAddUserRole.mock.inspectFuncAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("Inspect function is already set for GranterMock.AddUserRole")
+	}
+
+	mmAddUserRole.mock.inspectFuncAddUserRole = f
+
+	return mmAddUserRole
+}
+
+// Return sets up results that will be returned by Granter.AddUserRole
+func (mmAddUserRole *mGranterMockAddUserRole) Return(err error) *GranterMock {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Set")
+	}
+
+	if mmAddUserRole.defaultExpectation == nil {
+		mmAddUserRole.defaultExpectation = &GranterMockAddUserRoleExpectation{mock: mmAddUserRole.mock}
+	}
+	mmAddUserRole.defaultExpectation.results = &GranterMockAddUserRoleResults{err}
+	mmAddUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole.mock
+}
+
+// Set uses given function f to mock the Granter.AddUserRole method
+func (mmAddUserRole *mGranterMockAddUserRole) Set(f func(ctx context.Context, userRole auth.UserRole) (err error)) *GranterMock {
+	if mmAddUserRole.defaultExpectation != nil {
+		mmAddUserRole.mock.t.Fatalf("Default expectation is already set for the Granter.AddUserRole method")
+	}
+
+	if len(mmAddUserRole.expectations) > 0 {
+		mmAddUserRole.mock.t.Fatalf("Some expectations are already set for the Granter.AddUserRole method")
+	}
+
+	mmAddUserRole.mock.funcAddUserRole = f
+	mmAddUserRole.mock.funcAddUserRoleOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole.mock
+}
+
+// When sets expectation for the Granter.AddUserRole which will trigger the result defined by the following
+// Then helper
+func (mmAddUserRole *mGranterMockAddUserRole) When(ctx context.Context, userRole auth.UserRole) *GranterMockAddUserRoleExpectation {
+	if mmAddUserRole.mock.funcAddUserRole != nil {
+		mmAddUserRole.mock.t.Fatalf("GranterMock.AddUserRole mock is already set by Set")
+	}
+
+	expectation := &GranterMockAddUserRoleExpectation{
+		mock:               mmAddUserRole.mock,
+		params:             &GranterMockAddUserRoleParams{ctx, userRole},
+		expectationOrigins: GranterMockAddUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddUserRole.expectations = append(mmAddUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Granter.AddUserRole return parameters for the expectation previously defined by the When method
+func (e *GranterMockAddUserRoleExpectation) Then(err error) *GranterMock {
+	e.results = &GranterMockAddUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times Granter.AddUserRole should be invoked
+func (mmAddUserRole *mGranterMockAddUserRole) Times(n uint64) *mGranterMockAddUserRole {
+	if n == 0 {
+		mmAddUserRole.mock.t.Fatalf("Times of GranterMock.AddUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddUserRole.expectedInvocations, n)
+	mmAddUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddUserRole
+}
+
+func (mmAddUserRole *mGranterMockAddUserRole) invocationsDone() bool {
+	if len(mmAddUserRole.expectations) == 0 && mmAddUserRole.defaultExpectation == nil && mmAddUserRole.mock.funcAddUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddUserRole.mock.afterAddUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddUserRole implements mm_usecase.Granter
+func (mmAddUserRole *GranterMock) AddUserRole(ctx context.Context, userRole auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmAddUserRole.beforeAddUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddUserRole.afterAddUserRoleCounter, 1)
+
+	mmAddUserRole.t.Helper()
+
+	if mmAddUserRole.inspectFuncAddUserRole != nil {
+		mmAddUserRole.inspectFuncAddUserRole(ctx, userRole)
+	}
+
+	mm_params := GranterMockAddUserRoleParams{ctx, userRole}
+
+	// Record call args
+	mmAddUserRole.AddUserRoleMock.mutex.Lock()
+	mmAddUserRole.AddUserRoleMock.callArgs = append(mmAddUserRole.AddUserRoleMock.callArgs, &mm_params)
+	mmAddUserRole.AddUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmAddUserRole.AddUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddUserRole.AddUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddUserRole.AddUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddUserRole.AddUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmAddUserRole.AddUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := GranterMockAddUserRoleParams{ctx, userRole}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddUserRole.t.Errorf("GranterMock.AddUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userRole != nil && !minimock.Equal(*mm_want_ptrs.userRole, mm_got.userRole) {
+				mmAddUserRole.t.Errorf("GranterMock.AddUserRole got unexpected parameter userRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.originUserRole, *mm_want_ptrs.userRole, mm_got.userRole, minimock.Diff(*mm_want_ptrs.userRole, mm_got.userRole))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddUserRole.t.Errorf("GranterMock.AddUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddUserRole.AddUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddUserRole.AddUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddUserRole.t.Fatal("No results are set for the GranterMock.AddUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmAddUserRole.funcAddUserRole != nil {
+		return mmAddUserRole.funcAddUserRole(ctx, userRole)
+	}
+	mmAddUserRole.t.Fatalf("Unexpected call to GranterMock.AddUserRole. %v %v", ctx, userRole)
+	return
+}
+
+// AddUserRoleAfterCounter returns a count of finished GranterMock.AddUserRole invocations
+func (mmAddUserRole *GranterMock) AddUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUserRole.afterAddUserRoleCounter)
+}
+
+// AddUserRoleBeforeCounter returns a count of GranterMock.AddUserRole invocations
+func (mmAddUserRole *GranterMock) AddUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUserRole.beforeAddUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to GranterMock.AddUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddUserRole *mGranterMockAddUserRole) Calls() []*GranterMockAddUserRoleParams {
+	mmAddUserRole.mutex.RLock()
+
+	argCopy := make([]*GranterMockAddUserRoleParams, len(mmAddUserRole.callArgs))
+	copy(argCopy, mmAddUserRole.callArgs)
+
+	mmAddUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddUserRoleDone returns true if the count of the AddUserRole invocations corresponds
+// the number of defined expectations
+func (m *GranterMock) MinimockAddUserRoleDone() bool {
+	if m.AddUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddUserRoleMock.invocationsDone()
+}
+
+// MinimockAddUserRoleInspect logs each unmet expectation
+func (m *GranterMock) MinimockAddUserRoleInspect() {
+	for _, e := range m.AddUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GranterMock.AddUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddUserRoleCounter := mm_atomic.LoadUint64(&m.afterAddUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddUserRoleMock.defaultExpectation != nil && afterAddUserRoleCounter < 1 {
+		if m.AddUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GranterMock.AddUserRole at\n%s", m.AddUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GranterMock.AddUserRole at\n%s with params: %#v", m.AddUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.AddUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddUserRole != nil && afterAddUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to GranterMock.AddUserRole at\n%s", m.funcAddUserRoleOrigin)
+	}
+
+	if !m.AddUserRoleMock.invocationsDone() && afterAddUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to GranterMock.AddUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddUserRoleMock.expectedInvocations), m.AddUserRoleMock.expectedInvocationsOrigin, afterAddUserRoleCounter)
+	}
+}
+
+type mGranterMockDeleteUserRole struct {
+	optional           bool
+	mock               *GranterMock
+	defaultExpectation *GranterMockDeleteUserRoleExpectation
+	expectations       []*GranterMockDeleteUserRoleExpectation
+
+	callArgs []*GranterMockDeleteUserRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GranterMockDeleteUserRoleExpectation specifies expectation struct of the Granter.DeleteUserRole
+type GranterMockDeleteUserRoleExpectation struct {
+	mock               *GranterMock
+	params             *GranterMockDeleteUserRoleParams
+	paramPtrs          *GranterMockDeleteUserRoleParamPtrs
+	expectationOrigins GranterMockDeleteUserRoleExpectationOrigins
+	results            *GranterMockDeleteUserRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GranterMockDeleteUserRoleParams contains parameters of the Granter.DeleteUserRole
+type GranterMockDeleteUserRoleParams struct {
+	ctx      context.Context
+	userRole auth.UserRole
+}
+
+// GranterMockDeleteUserRoleParamPtrs contains pointers to parameters of the Granter.DeleteUserRole
+type GranterMockDeleteUserRoleParamPtrs struct {
+	ctx      *context.Context
+	userRole *auth.UserRole
+}
+
+// GranterMockDeleteUserRoleResults contains results of the Granter.DeleteUserRole
+type GranterMockDeleteUserRoleResults struct {
+	err error
+}
+
+// GranterMockDeleteUserRoleOrigins contains origins of expectations of the Granter.DeleteUserRole
+type GranterMockDeleteUserRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Optional() *mGranterMockDeleteUserRole {
+	mmDeleteUserRole.optional = true
+	return mmDeleteUserRole
+}
+
+// Expect sets up expected params for Granter.DeleteUserRole
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Expect(ctx context.Context, userRole auth.UserRole) *mGranterMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &GranterMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRole.defaultExpectation.params = &GranterMockDeleteUserRoleParams{ctx, userRole}
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRole.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRole.defaultExpectation.params) {
+			mmDeleteUserRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRole.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Granter.DeleteUserRole
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) ExpectCtxParam1(ctx context.Context) *mGranterMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &GranterMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &GranterMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// ExpectUserRoleParam2 sets up expected param userRole for Granter.DeleteUserRole
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) ExpectUserRoleParam2(userRole auth.UserRole) *mGranterMockDeleteUserRole {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &GranterMockDeleteUserRoleExpectation{}
+	}
+
+	if mmDeleteUserRole.defaultExpectation.params != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Expect")
+	}
+
+	if mmDeleteUserRole.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRole.defaultExpectation.paramPtrs = &GranterMockDeleteUserRoleParamPtrs{}
+	}
+	mmDeleteUserRole.defaultExpectation.paramPtrs.userRole = &userRole
+	mmDeleteUserRole.defaultExpectation.expectationOrigins.originUserRole = minimock.CallerInfo(1)
+
+	return mmDeleteUserRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the Granter.DeleteUserRole
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Inspect(f func(ctx context.Context, userRole auth.UserRole)) *mGranterMockDeleteUserRole {
+	if mmDeleteUserRole.mock.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Inspect function is already set for GranterMock.DeleteUserRole")
+	}
+
+	mmDeleteUserRole.mock.inspectFuncDeleteUserRole = f
+
+	return mmDeleteUserRole
+}
+
+// Return sets up results that will be returned by Granter.DeleteUserRole
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Return(err error) *GranterMock {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Set")
+	}
+
+	if mmDeleteUserRole.defaultExpectation == nil {
+		mmDeleteUserRole.defaultExpectation = &GranterMockDeleteUserRoleExpectation{mock: mmDeleteUserRole.mock}
+	}
+	mmDeleteUserRole.defaultExpectation.results = &GranterMockDeleteUserRoleResults{err}
+	mmDeleteUserRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// Set uses given function f to mock the Granter.DeleteUserRole method
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Set(f func(ctx context.Context, userRole auth.UserRole) (err error)) *GranterMock {
+	if mmDeleteUserRole.defaultExpectation != nil {
+		mmDeleteUserRole.mock.t.Fatalf("Default expectation is already set for the Granter.DeleteUserRole method")
+	}
+
+	if len(mmDeleteUserRole.expectations) > 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Some expectations are already set for the Granter.DeleteUserRole method")
+	}
+
+	mmDeleteUserRole.mock.funcDeleteUserRole = f
+	mmDeleteUserRole.mock.funcDeleteUserRoleOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole.mock
+}
+
+// When sets expectation for the Granter.DeleteUserRole which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) When(ctx context.Context, userRole auth.UserRole) *GranterMockDeleteUserRoleExpectation {
+	if mmDeleteUserRole.mock.funcDeleteUserRole != nil {
+		mmDeleteUserRole.mock.t.Fatalf("GranterMock.DeleteUserRole mock is already set by Set")
+	}
+
+	expectation := &GranterMockDeleteUserRoleExpectation{
+		mock:               mmDeleteUserRole.mock,
+		params:             &GranterMockDeleteUserRoleParams{ctx, userRole},
+		expectationOrigins: GranterMockDeleteUserRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRole.expectations = append(mmDeleteUserRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Granter.DeleteUserRole return parameters for the expectation previously defined by the When method
+func (e *GranterMockDeleteUserRoleExpectation) Then(err error) *GranterMock {
+	e.results = &GranterMockDeleteUserRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times Granter.DeleteUserRole should be invoked
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Times(n uint64) *mGranterMockDeleteUserRole {
+	if n == 0 {
+		mmDeleteUserRole.mock.t.Fatalf("Times of GranterMock.DeleteUserRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRole.expectedInvocations, n)
+	mmDeleteUserRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRole
+}
+
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) invocationsDone() bool {
+	if len(mmDeleteUserRole.expectations) == 0 && mmDeleteUserRole.defaultExpectation == nil && mmDeleteUserRole.mock.funcDeleteUserRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.mock.afterDeleteUserRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRole implements mm_usecase.Granter
+func (mmDeleteUserRole *GranterMock) DeleteUserRole(ctx context.Context, userRole auth.UserRole) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter, 1)
+
+	mmDeleteUserRole.t.Helper()
+
+	if mmDeleteUserRole.inspectFuncDeleteUserRole != nil {
+		mmDeleteUserRole.inspectFuncDeleteUserRole(ctx, userRole)
+	}
+
+	mm_params := GranterMockDeleteUserRoleParams{ctx, userRole}
+
+	// Record call args
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Lock()
+	mmDeleteUserRole.DeleteUserRoleMock.callArgs = append(mmDeleteUserRole.DeleteUserRoleMock.callArgs, &mm_params)
+	mmDeleteUserRole.DeleteUserRoleMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRole.DeleteUserRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := GranterMockDeleteUserRoleParams{ctx, userRole}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRole.t.Errorf("GranterMock.DeleteUserRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userRole != nil && !minimock.Equal(*mm_want_ptrs.userRole, mm_got.userRole) {
+				mmDeleteUserRole.t.Errorf("GranterMock.DeleteUserRole got unexpected parameter userRole, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.originUserRole, *mm_want_ptrs.userRole, mm_got.userRole, minimock.Diff(*mm_want_ptrs.userRole, mm_got.userRole))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRole.t.Errorf("GranterMock.DeleteUserRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRole.DeleteUserRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRole.t.Fatal("No results are set for the GranterMock.DeleteUserRole")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUserRole.funcDeleteUserRole != nil {
+		return mmDeleteUserRole.funcDeleteUserRole(ctx, userRole)
+	}
+	mmDeleteUserRole.t.Fatalf("Unexpected call to GranterMock.DeleteUserRole. %v %v", ctx, userRole)
+	return
+}
+
+// DeleteUserRoleAfterCounter returns a count of finished GranterMock.DeleteUserRole invocations
+func (mmDeleteUserRole *GranterMock) DeleteUserRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.afterDeleteUserRoleCounter)
+}
+
+// DeleteUserRoleBeforeCounter returns a count of GranterMock.DeleteUserRole invocations
+func (mmDeleteUserRole *GranterMock) DeleteUserRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRole.beforeDeleteUserRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to GranterMock.DeleteUserRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRole *mGranterMockDeleteUserRole) Calls() []*GranterMockDeleteUserRoleParams {
+	mmDeleteUserRole.mutex.RLock()
+
+	argCopy := make([]*GranterMockDeleteUserRoleParams, len(mmDeleteUserRole.callArgs))
+	copy(argCopy, mmDeleteUserRole.callArgs)
+
+	mmDeleteUserRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRoleDone returns true if the count of the DeleteUserRole invocations corresponds
+// the number of defined expectations
+func (m *GranterMock) MinimockDeleteUserRoleDone() bool {
+	if m.DeleteUserRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRoleMock.invocationsDone()
+}
+
+// MinimockDeleteUserRoleInspect logs each unmet expectation
+func (m *GranterMock) MinimockDeleteUserRoleInspect() {
+	for _, e := range m.DeleteUserRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GranterMock.DeleteUserRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRoleCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRoleMock.defaultExpectation != nil && afterDeleteUserRoleCounter < 1 {
+		if m.DeleteUserRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GranterMock.DeleteUserRole at\n%s", m.DeleteUserRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GranterMock.DeleteUserRole at\n%s with params: %#v", m.DeleteUserRoleMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRole != nil && afterDeleteUserRoleCounter < 1 {
+		m.t.Errorf("Expected call to GranterMock.DeleteUserRole at\n%s", m.funcDeleteUserRoleOrigin)
+	}
+
+	if !m.DeleteUserRoleMock.invocationsDone() && afterDeleteUserRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to GranterMock.DeleteUserRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRoleMock.expectedInvocations), m.DeleteUserRoleMock.expectedInvocationsOrigin, afterDeleteUserRoleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GranterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddUserRoleInspect()
+
+			m.MinimockDeleteUserRoleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GranterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GranterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddUserRoleDone() &&
+		m.MinimockDeleteUserRoleDone()
+}
@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/entity/usecase.Linter -o linter_mock.go -n LinterMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LinterMock implements mm_usecase.Linter
+type LinterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcLint          func(ctx context.Context, text string, language string) (ma1 []lint.Match, err error)
+	funcLintOrigin    string
+	inspectFuncLint   func(ctx context.Context, text string, language string)
+	afterLintCounter  uint64
+	beforeLintCounter uint64
+	LintMock          mLinterMockLint
+}
+
+// NewLinterMock returns a mock for mm_usecase.Linter
+func NewLinterMock(t minimock.Tester) *LinterMock {
+	m := &LinterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.LintMock = mLinterMockLint{mock: m}
+	m.LintMock.callArgs = []*LinterMockLintParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLinterMockLint struct {
+	optional           bool
+	mock               *LinterMock
+	defaultExpectation *LinterMockLintExpectation
+	expectations       []*LinterMockLintExpectation
+
+	callArgs []*LinterMockLintParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LinterMockLintExpectation specifies expectation struct of the Linter.Lint
+type LinterMockLintExpectation struct {
+	mock               *LinterMock
+	params             *LinterMockLintParams
+	paramPtrs          *LinterMockLintParamPtrs
+	expectationOrigins LinterMockLintExpectationOrigins
+	results            *LinterMockLintResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LinterMockLintParams contains parameters of the Linter.Lint
+type LinterMockLintParams struct {
+	ctx      context.Context
+	text     string
+	language string
+}
+
+// LinterMockLintParamPtrs contains pointers to parameters of the Linter.Lint
+type LinterMockLintParamPtrs struct {
+	ctx      *context.Context
+	text     *string
+	language *string
+}
+
+// LinterMockLintResults contains results of the Linter.Lint
+type LinterMockLintResults struct {
+	ma1 []lint.Match
+	err error
+}
+
+// LinterMockLintOrigins contains origins of expectations of the Linter.Lint
+type LinterMockLintExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originText     string
+	originLanguage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLint *mLinterMockLint) Optional() *mLinterMockLint {
+	mmLint.optional = true
+	return mmLint
+}
+
+// Expect sets up expected params for Linter.Lint
+func (mmLint *mLinterMockLint) Expect(ctx context.Context, text string, language string) *mLinterMockLint {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	if mmLint.defaultExpectation == nil {
+		mmLint.defaultExpectation = &LinterMockLintExpectation{}
+	}
+
+	if mmLint.defaultExpectation.paramPtrs != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by ExpectParams functions")
+	}
+
+	mmLint.defaultExpectation.params = &LinterMockLintParams{ctx, text, language}
+	mmLint.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLint.expectations {
+		if minimock.Equal(e.params, mmLint.defaultExpectation.params) {
+			mmLint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLint.defaultExpectation.params)
+		}
+	}
+
+	return mmLint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Linter.Lint
+func (mmLint *mLinterMockLint) ExpectCtxParam1(ctx context.Context) *mLinterMockLint {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	if mmLint.defaultExpectation == nil {
+		mmLint.defaultExpectation = &LinterMockLintExpectation{}
+	}
+
+	if mmLint.defaultExpectation.params != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Expect")
+	}
+
+	if mmLint.defaultExpectation.paramPtrs == nil {
+		mmLint.defaultExpectation.paramPtrs = &LinterMockLintParamPtrs{}
+	}
+	mmLint.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLint.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLint
+}
+
+// ExpectTextParam2 sets up expected param text for Linter.Lint
+func (mmLint *mLinterMockLint) ExpectTextParam2(text string) *mLinterMockLint {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	if mmLint.defaultExpectation == nil {
+		mmLint.defaultExpectation = &LinterMockLintExpectation{}
+	}
+
+	if mmLint.defaultExpectation.params != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Expect")
+	}
+
+	if mmLint.defaultExpectation.paramPtrs == nil {
+		mmLint.defaultExpectation.paramPtrs = &LinterMockLintParamPtrs{}
+	}
+	mmLint.defaultExpectation.paramPtrs.text = &text
+	mmLint.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmLint
+}
+
+// ExpectLanguageParam3 sets up expected param language for Linter.Lint
+func (mmLint *mLinterMockLint) ExpectLanguageParam3(language string) *mLinterMockLint {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	if mmLint.defaultExpectation == nil {
+		mmLint.defaultExpectation = &LinterMockLintExpectation{}
+	}
+
+	if mmLint.defaultExpectation.params != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Expect")
+	}
+
+	if mmLint.defaultExpectation.paramPtrs == nil {
+		mmLint.defaultExpectation.paramPtrs = &LinterMockLintParamPtrs{}
+	}
+	mmLint.defaultExpectation.paramPtrs.language = &language
+	mmLint.defaultExpectation.expectationOrigins.originLanguage = minimock.CallerInfo(1)
+
+	return mmLint
+}
+
+// Inspect accepts an inspector function that has same arguments as the Linter.Lint
+func (mmLint *mLinterMockLint) Inspect(f func(ctx context.Context, text string, language string)) *mLinterMockLint {
+	if mmLint.mock.inspectFuncLint != nil {
+		mmLint.mock.t.Fatalf("Inspect function is already set for LinterMock.Lint")
+	}
+
+	mmLint.mock.inspectFuncLint = f
+
+	return mmLint
+}
+
+// Return sets up results that will be returned by Linter.Lint
+func (mmLint *mLinterMockLint) Return(ma1 []lint.Match, err error) *LinterMock {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	if mmLint.defaultExpectation == nil {
+		mmLint.defaultExpectation = &LinterMockLintExpectation{mock: mmLint.mock}
+	}
+	mmLint.defaultExpectation.results = &LinterMockLintResults{ma1, err}
+	mmLint.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLint.mock
+}
+
+// Set uses given function f to mock the Linter.Lint method
+func (mmLint *mLinterMockLint) Set(f func(ctx context.Context, text string, language string) (ma1 []lint.Match, err error)) *LinterMock {
+	if mmLint.defaultExpectation != nil {
+		mmLint.mock.t.Fatalf("Default expectation is already set for the Linter.Lint method")
+	}
+
+	if len(mmLint.expectations) > 0 {
+		mmLint.mock.t.Fatalf("Some expectations are already set for the Linter.Lint method")
+	}
+
+	mmLint.mock.funcLint = f
+	mmLint.mock.funcLintOrigin = minimock.CallerInfo(1)
+	return mmLint.mock
+}
+
+// When sets expectation for the Linter.Lint which will trigger the result defined by the following
+// Then helper
+func (mmLint *mLinterMockLint) When(ctx context.Context, text string, language string) *LinterMockLintExpectation {
+	if mmLint.mock.funcLint != nil {
+		mmLint.mock.t.Fatalf("LinterMock.Lint mock is already set by Set")
+	}
+
+	expectation := &LinterMockLintExpectation{
+		mock:               mmLint.mock,
+		params:             &LinterMockLintParams{ctx, text, language},
+		expectationOrigins: LinterMockLintExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLint.expectations = append(mmLint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Linter.Lint return parameters for the expectation previously defined by the When method
+func (e *LinterMockLintExpectation) Then(ma1 []lint.Match, err error) *LinterMock {
+	e.results = &LinterMockLintResults{ma1, err}
+	return e.mock
+}
+
+// Times sets number of times Linter.Lint should be invoked
+func (mmLint *mLinterMockLint) Times(n uint64) *mLinterMockLint {
+	if n == 0 {
+		mmLint.mock.t.Fatalf("Times of LinterMock.Lint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLint.expectedInvocations, n)
+	mmLint.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLint
+}
+
+func (mmLint *mLinterMockLint) invocationsDone() bool {
+	if len(mmLint.expectations) == 0 && mmLint.defaultExpectation == nil && mmLint.mock.funcLint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLint.mock.afterLintCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Lint implements mm_usecase.Linter
+func (mmLint *LinterMock) Lint(ctx context.Context, text string, language string) (ma1 []lint.Match, err error) {
+	mm_atomic.AddUint64(&mmLint.beforeLintCounter, 1)
+	defer mm_atomic.AddUint64(&mmLint.afterLintCounter, 1)
+
+	mmLint.t.Helper()
+
+	if mmLint.inspectFuncLint != nil {
+		mmLint.inspectFuncLint(ctx, text, language)
+	}
+
+	mm_params := LinterMockLintParams{ctx, text, language}
+
+	// Record call args
+	mmLint.LintMock.mutex.Lock()
+	mmLint.LintMock.callArgs = append(mmLint.LintMock.callArgs, &mm_params)
+	mmLint.LintMock.mutex.Unlock()
+
+	for _, e := range mmLint.LintMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ma1, e.results.err
+		}
+	}
+
+	if mmLint.LintMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLint.LintMock.defaultExpectation.Counter, 1)
+		mm_want := mmLint.LintMock.defaultExpectation.params
+		mm_want_ptrs := mmLint.LintMock.defaultExpectation.paramPtrs
+
+		mm_got := LinterMockLintParams{ctx, text, language}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLint.t.Errorf("LinterMock.Lint got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLint.LintMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmLint.t.Errorf("LinterMock.Lint got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLint.LintMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.language != nil && !minimock.Equal(*mm_want_ptrs.language, mm_got.language) {
+				mmLint.t.Errorf("LinterMock.Lint got unexpected parameter language, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLint.LintMock.defaultExpectation.expectationOrigins.originLanguage, *mm_want_ptrs.language, mm_got.language, minimock.Diff(*mm_want_ptrs.language, mm_got.language))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLint.t.Errorf("LinterMock.Lint got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLint.LintMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLint.LintMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLint.t.Fatal("No results are set for the LinterMock.Lint")
+		}
+		return (*mm_results).ma1, (*mm_results).err
+	}
+	if mmLint.funcLint != nil {
+		return mmLint.funcLint(ctx, text, language)
+	}
+	mmLint.t.Fatalf("Unexpected call to LinterMock.Lint. %v %v %v", ctx, text, language)
+	return
+}
+
+// LintAfterCounter returns a count of finished LinterMock.Lint invocations
+func (mmLint *LinterMock) LintAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLint.afterLintCounter)
+}
+
+// LintBeforeCounter returns a count of LinterMock.Lint invocations
+func (mmLint *LinterMock) LintBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLint.beforeLintCounter)
+}
+
+// Calls returns a list of arguments used in each call to LinterMock.Lint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLint *mLinterMockLint) Calls() []*LinterMockLintParams {
+	mmLint.mutex.RLock()
+
+	argCopy := make([]*LinterMockLintParams, len(mmLint.callArgs))
+	copy(argCopy, mmLint.callArgs)
+
+	mmLint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLintDone returns true if the count of the Lint invocations corresponds
+// the number of defined expectations
+func (m *LinterMock) MinimockLintDone() bool {
+	if m.LintMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LintMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LintMock.invocationsDone()
+}
+
+// MinimockLintInspect logs each unmet expectation
+func (m *LinterMock) MinimockLintInspect() {
+	for _, e := range m.LintMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LinterMock.Lint at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLintCounter := mm_atomic.LoadUint64(&m.afterLintCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LintMock.defaultExpectation != nil && afterLintCounter < 1 {
+		if m.LintMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LinterMock.Lint at\n%s", m.LintMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LinterMock.Lint at\n%s with params: %#v", m.LintMock.defaultExpectation.expectationOrigins.origin, *m.LintMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLint != nil && afterLintCounter < 1 {
+		m.t.Errorf("Expected call to LinterMock.Lint at\n%s", m.funcLintOrigin)
+	}
+
+	if !m.LintMock.invocationsDone() && afterLintCounter > 0 {
+		m.t.Errorf("Expected %d calls to LinterMock.Lint at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LintMock.expectedInvocations), m.LintMock.expectedInvocationsOrigin, afterLintCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LinterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockLintInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LinterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LinterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockLintDone()
+}
@@ -2,27 +2,95 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
+	"github.com/66gu1/easygodocs/internal/app/attachment"
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type Core interface {
 	GetTree(ctx context.Context, permissions []uuid.UUID, isAdmin bool) (entity.Tree, error)
+	GetDrafts(ctx context.Context, req entity.DraftsReq, permittedIDs []uuid.UUID, isAdmin bool) (entity.ListPage, error)
 	GetPermittedIDs(ctx context.Context, directPermissions []uuid.UUID, hType entity.HierarchyType) ([]uuid.UUID, error)
 	Get(ctx context.Context, id uuid.UUID) (entity.Entity, error)
+	GetIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	ResolvePath(ctx context.Context, path []string, isAdmin bool) (entity.PathResolution, error)
+	GetMeta(ctx context.Context, id uuid.UUID) (entity.Meta, error)
+	GetContributors(ctx context.Context, id uuid.UUID) ([]entity.Contributor, error)
+	GetActivity(ctx context.Context, req entity.GetActivityReq, isAdmin bool) (entity.Activity, error)
+	GetBacklinks(ctx context.Context, id uuid.UUID, isAdmin bool) ([]entity.ListItem, error)
+	AddRelation(ctx context.Context, id, relatedID uuid.UUID) error
+	DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error
+	GetRelated(ctx context.Context, id uuid.UUID, isAdmin bool) ([]entity.ListItem, error)
+	GetChildren(ctx context.Context, id uuid.UUID, isAdmin bool) ([]entity.ListItem, error)
+	ReorderChildren(ctx context.Context, parentID uuid.UUID, req entity.ChildrenOrderReq) error
+	SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool) error
+	GetSpace(ctx context.Context, id uuid.UUID) (entity.Space, error)
+	SetSpaceSettings(ctx context.Context, id uuid.UUID, req entity.SpaceSettings) (entity.SpaceSettings, error)
+	PlanSpaceMove(ctx context.Context, id uuid.UUID, parentID *uuid.UUID, policy entity.GrantPolicy) (*entity.SpaceMove, error)
+	GetTOC(ctx context.Context, rootID uuid.UUID, isAdmin bool) (*entity.TOCNode, error)
+	GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error)
+	SetDefaultPermissions(ctx context.Context, id uuid.UUID, req entity.SetDefaultPermissionsReq) error
+	GetPendingDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error)
+	Export(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) error
+	Manual(ctx context.Context, rootID uuid.UUID, permittedIDs []uuid.UUID, isAdmin bool, w entity.ManualWriter) error
+	GetBrokenLinks(ctx context.Context) ([]entity.BrokenLink, error)
+	PruneVersions(ctx context.Context, dryRun bool) (entity.RetentionReport, error)
+	PurgeTrash(ctx context.Context, userID *uuid.UUID, dryRun bool) (entity.TrashReport, error)
+	GetDraftReminders(ctx context.Context) ([]entity.DraftReminder, error)
+	MarkDraftsReminded(ctx context.Context, ids []uuid.UUID) error
+	DraftReminderEmail(reminder entity.DraftReminder) (mail.Message, bool)
+	CleanupStaleDrafts(ctx context.Context, dryRun bool) (entity.StaleDraftsReport, error)
 	GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error)
-	GetVersionsList(ctx context.Context, id uuid.UUID) ([]entity.Entity, error)
-	Create(ctx context.Context, req entity.CreateEntityReq) (uuid.UUID, error)
-	GetListItem(ctx context.Context, id uuid.UUID) (entity.ListItem, error)
-	Update(ctx context.Context, req entity.UpdateEntityReq) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetVersionsList(ctx context.Context, req entity.GetVersionsReq) (entity.VersionsPage, error)
+	GetHistory(ctx context.Context, req entity.GetHistoryReq) (entity.History, error)
+	Merge(ctx context.Context, req entity.MergeReq) (entity.MergeResult, error)
+	Create(ctx context.Context, req entity.CreateEntityReq) (uuid.UUID, entity.ContentUsage, error)
+	GetListItems(ctx context.Context, ids []uuid.UUID) ([]entity.ListItem, error)
+	Update(ctx context.Context, req entity.UpdateEntityReq) (entity.ContentUsage, error)
+	Delete(ctx context.Context, id, userID uuid.UUID, dryRun bool) (entity.DeleteReport, error)
+	Lock(ctx context.Context, id, userID uuid.UUID) (entity.Lock, error)
+	Unlock(ctx context.Context, id, userID uuid.UUID, force bool) error
+	GetLock(ctx context.Context, id uuid.UUID) (entity.Lock, error)
+	Autosave(ctx context.Context, id, userID uuid.UUID, content string) (entity.Autosave, error)
+	GetAutosave(ctx context.Context, id, userID uuid.UUID) (entity.Autosave, error)
+	DiscardAutosave(ctx context.Context, id, userID uuid.UUID) error
+	RecordView(ctx context.Context, id, userID, sessionID uuid.UUID) error
+	GetPopular(ctx context.Context, req entity.GetPopularReq, permittedIDs []uuid.UUID, isAdmin bool) (entity.PopularReport, error)
+	List(ctx context.Context, req entity.ListReq, permittedIDs []uuid.UUID, isAdmin bool) (entity.ListPage, error)
+	BatchGet(ctx context.Context, req entity.BatchGetReq, permittedIDs []uuid.UUID, isAdmin bool) (entity.BatchGetResult, error)
+	TransferOwnership(ctx context.Context, id, ownerID uuid.UUID) error
+	UpdateAppearance(ctx context.Context, id uuid.UUID, req entity.UpdateAppearanceReq) error
+	GetOrphanedEntities(ctx context.Context) ([]entity.OrphanedEntity, error)
+	CreateSnapshot(ctx context.Context, id uuid.UUID, req entity.CreateSnapshotReq) (entity.Snapshot, error)
+	GetSnapshots(ctx context.Context, id uuid.UUID) ([]entity.Snapshot, error)
+	GetSnapshot(ctx context.Context, id uuid.UUID, label string) (entity.SnapshotContent, error)
+	GetVariables(ctx context.Context, id uuid.UUID) ([]entity.Variable, error)
+	SetVariable(ctx context.Context, id uuid.UUID, key string, req entity.SetVariableReq) (entity.Variable, error)
+	DeleteVariable(ctx context.Context, id uuid.UUID, key string) error
+	ExpandVariables(ctx context.Context, contents map[uuid.UUID]string) (map[uuid.UUID]string, error)
+	ResolveIncludes(ctx context.Context, contents map[uuid.UUID]string, permittedIDs []uuid.UUID, isAdmin bool) (map[uuid.UUID]string, error)
+	SetLanguage(ctx context.Context, id uuid.UUID, req entity.SetLanguageReq) error
+	LinkVariant(ctx context.Context, id, variantID uuid.UUID, req entity.SetLanguageReq) error
+	UnlinkVariant(ctx context.Context, id uuid.UUID) error
+	GetVariants(ctx context.Context, id uuid.UUID, isAdmin bool) ([]entity.Variant, error)
+	GetMissingTranslations(ctx context.Context) ([]entity.MissingTranslation, error)
+	ClaimLint(ctx context.Context) ([]entity.LintTarget, error)
+	CompleteLint(ctx context.Context, target entity.LintTarget, matches []lint.Match) error
+	FailLint(ctx context.Context, target entity.LintTarget) error
+	GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error)
+	SetLintSettings(ctx context.Context, id uuid.UUID, req entity.LintSettings) error
 }
 
 type AuthCore interface {
@@ -35,6 +103,39 @@ type PermissionChecker interface {
 	GetDirectPermissions(ctx context.Context, role auth.Role) ([]uuid.UUID, bool, error)
 }
 
+// Granter gives users roles on entities and takes them away; auth implements it.
+type Granter interface {
+	AddUserRole(ctx context.Context, userRole auth.UserRole) error
+	DeleteUserRole(ctx context.Context, userRole auth.UserRole) error
+}
+
+// Sender emails the reminders of the stale draft policy.
+type Sender interface {
+	Send(ctx context.Context, msg mail.Message) error
+}
+
+// TxManager runs a move and the grant changes of its space move in one transaction.
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Attachments resolves the attachment keys icons and covers refer to in the workspace of the request.
+type Attachments interface {
+	Get(ctx context.Context, key string) (attachment.Attachment, error)
+}
+
+// Linter checks content for spelling, grammar and style issues; lint implements it.
+type Linter interface {
+	Lint(ctx context.Context, text, language string) ([]lint.Match, error)
+}
+
+// Sanitizer removes the markup the sanitize policy does not allow from HTML rendered from content, and from
+// Markdown content that is exported or checked for the unsafe markup report, returning what it removed.
+type Sanitizer interface {
+	Sanitize(content string) (string, []string)
+	SanitizeHTML(html string) string
+}
+
 type CreateEntityCmd struct {
 	Type     entity.Type `json:"type"`
 	Name     string      `json:"name"`
@@ -43,216 +144,1846 @@ type CreateEntityCmd struct {
 	IsDraft  bool        `json:"is_draft"`
 }
 
-type UpdateEntityCmd struct {
-	ID       uuid.UUID  `json:"id"`
-	Name     string     `json:"name"`
-	Content  string     `json:"content"`
-	ParentID *uuid.UUID `json:"parent_id,omitempty"`
-	IsDraft  bool       `json:"is_draft,omitempty"`
+// CreateVariantCmd is the translation of an entity into Language.
+type CreateVariantCmd struct {
+	Language string `json:"language"`
+	Name     string `json:"name"`
+	Content  string `json:"content"`
+	IsDraft  bool   `json:"is_draft,omitempty"`
+}
+
+type UpdateEntityCmd struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Content   string     `json:"content"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	IsDraft   bool       `json:"is_draft,omitempty"`
+	Summary   string     `json:"summary,omitempty"`
+	MinorEdit bool       `json:"minor_edit,omitempty"`
+	// ConfirmCrossSpace allows a move into another space, Grants overrides the configured GrantPolicy of it.
+	ConfirmCrossSpace bool               `json:"confirm_cross_space,omitempty"`
+	Grants            entity.GrantPolicy `json:"grants,omitempty"`
+}
+
+type service struct {
+	core        Core
+	perm        PermissionChecker
+	sanitizer   Sanitizer
+	granter     Granter
+	sender      Sender
+	tx          TxManager
+	attachments Attachments
+	linter      Linter
+}
+
+func NewService(repo Core, perm PermissionChecker, sanitizer Sanitizer, granter Granter, sender Sender, tx TxManager,
+	attachments Attachments) *service {
+	if perm == nil || repo == nil || sanitizer == nil || granter == nil || sender == nil || tx == nil || attachments == nil {
+		panic("entity.NewService: nil core, perm, sanitizer, granter, sender, tx or attachments")
+	}
+	return &service{core: repo, perm: perm, sanitizer: sanitizer, granter: granter, sender: sender, tx: tx, attachments: attachments}
+}
+
+// WithLinter enables content linting; without a linter RunLint does nothing.
+func (s *service) WithLinter(linter Linter) *service {
+	s.linter = linter
+	return s
+}
+
+func (s *service) GetTree(ctx context.Context) (entity.Tree, error) {
+	ids, isAdmin, err := s.perm.GetDirectPermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.GetTree: getUserPermissions")
+		return entity.Tree{}, fmt.Errorf("entity.service.GetTree: %w", err)
+	}
+	tree, err := s.core.GetTree(ctx, ids, isAdmin)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.GetTree: GetTree")
+		return entity.Tree{}, fmt.Errorf("entity.service.GetTree: %w", err)
+	}
+
+	return tree, nil
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (entity.Entity, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Get: checkEntityPermission")
+		return entity.Entity{}, fmt.Errorf("entity.service.Get: %w", err)
+	}
+
+	ent, err := s.core.Get(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Get: Get")
+		return entity.Entity{}, fmt.Errorf("entity.service.Get: %w", err)
+	}
+	ent.Autosave = s.getAutosave(ctx, id)
+	if permissions, ok := s.getReadPermissions(ctx, id); ok {
+		ent.Related = s.filterRelated(ctx, id, permissions)
+		s.setVariants(ctx, &ent, id, permissions)
+	}
+	s.recordView(ctx, id)
+
+	return ent, nil
+}
+
+// GetRendered returns the entity as Get does, with its content as HTML: the entities it includes that
+// the user can read resolved and its variables expanded, then sanitized as for Export and rendered from
+// Markdown.
+func (s *service) GetRendered(ctx context.Context, id uuid.UUID) (entity.Entity, error) {
+	ent, err := s.Get(ctx, id)
+	if err != nil {
+		return entity.Entity{}, fmt.Errorf("entity.service.GetRendered: %w", err)
+	}
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetRendered: getEffectivePermissions")
+		return entity.Entity{}, fmt.Errorf("entity.service.GetRendered: %w", err)
+	}
+
+	contents, err := s.expand(ctx, map[uuid.UUID]string{id: ent.Content}, permissions)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetRendered: expand")
+		return entity.Entity{}, fmt.Errorf("entity.service.GetRendered: %w", err)
+	}
+	ent.Content = s.renderHTML(contents[id])
+
+	return ent, nil
+}
+
+// expand resolves the includes of contents the user can read, then the variables, which take their
+// values from the scope of the including entity.
+func (s *service) expand(ctx context.Context, contents map[uuid.UUID]string, permissions EffectivePermissions) (map[uuid.UUID]string, error) {
+	contents, err := s.core.ResolveIncludes(ctx, contents, permissions.IDs, permissions.IsAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("ResolveIncludes: %w", err)
+	}
+	if contents, err = s.core.ExpandVariables(ctx, contents); err != nil {
+		return nil, fmt.Errorf("ExpandVariables: %w", err)
+	}
+
+	return contents, nil
+}
+
+func (s *service) renderHTML(content string) string {
+	return s.sanitizer.SanitizeHTML(entity.MarkdownToHTML(content))
+}
+
+// GetBySlug returns the entity a current or former slug points to, with the same permission check as Get.
+func (s *service) GetBySlug(ctx context.Context, slug string) (entity.Entity, error) {
+	id, err := s.core.GetIDBySlug(ctx, slug)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldSlug.String(), slug).
+			Msg("entity.service.GetBySlug: GetIDBySlug")
+		return entity.Entity{}, fmt.Errorf("entity.service.GetBySlug: %w", err)
+	}
+
+	ent, err := s.Get(ctx, id)
+	if err != nil {
+		return entity.Entity{}, fmt.Errorf("entity.service.GetBySlug: %w", err)
+	}
+
+	return ent, nil
+}
+
+// GetByPath returns the entity a slug path points to, with the same permission check as Get.
+// For a former location the entity is not read and only the resolution is returned.
+func (s *service) GetByPath(ctx context.Context, path []string) (entity.Entity, entity.PathResolution, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Strs(entity.FieldSlug.String(), path).
+			Msg("entity.service.GetByPath: getEffectivePermissions")
+		return entity.Entity{}, entity.PathResolution{}, fmt.Errorf("entity.service.GetByPath: %w", err)
+	}
+
+	res, err := s.core.ResolvePath(ctx, path, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Strs(entity.FieldSlug.String(), path).
+			Msg("entity.service.GetByPath: ResolvePath")
+		return entity.Entity{}, entity.PathResolution{}, fmt.Errorf("entity.service.GetByPath: %w", err)
+	}
+	if err = permissions.CheckID(res.ID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), res.ID.String()).
+			Msg("entity.service.GetByPath: checkID")
+		return entity.Entity{}, entity.PathResolution{}, fmt.Errorf("entity.service.GetByPath: %w", err)
+	}
+	if res.Moved {
+		return entity.Entity{}, res, nil
+	}
+
+	ent, err := s.core.Get(ctx, res.ID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), res.ID.String()).
+			Msg("entity.service.GetByPath: Get")
+		return entity.Entity{}, entity.PathResolution{}, fmt.Errorf("entity.service.GetByPath: %w", err)
+	}
+	ent.Autosave = s.getAutosave(ctx, res.ID)
+	ent.Related = s.filterRelated(ctx, res.ID, permissions)
+	s.setVariants(ctx, &ent, res.ID, permissions)
+	s.recordView(ctx, res.ID)
+
+	return ent, res, nil
+}
+
+// getAutosave returns the autosave of the current user so that the client can offer to recover it.
+// Like recordView it is best effort, and reads outside a session get none.
+func (s *service) getAutosave(ctx context.Context, id uuid.UUID) *entity.Autosave {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil
+	}
+	autosave, err := s.core.GetAutosave(ctx, id, userID)
+	if err != nil {
+		if apperr.CodeOf(err) != entity.CodeAutosaveNotFound {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), id.String()).
+				Msg("entity.service.getAutosave: GetAutosave")
+		}
+		return nil
+	}
+
+	return &autosave
+}
+
+// getReadPermissions returns the permissions the related pages and variants of a read are filtered by. Like
+// getAutosave it is best effort: a failure is logged and the read goes on without them, as do reads
+// outside a session.
+func (s *service) getReadPermissions(ctx context.Context, id uuid.UUID) (EffectivePermissions, bool) {
+	if _, err := contextx.GetUserID(ctx); err != nil {
+		return EffectivePermissions{}, false
+	}
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.getReadPermissions: getEffectivePermissions")
+		return EffectivePermissions{}, false
+	}
+
+	return permissions, true
+}
+
+func (s *service) filterRelated(ctx context.Context, id uuid.UUID, permissions EffectivePermissions) []entity.ListItem {
+	items, err := s.core.GetRelated(ctx, id, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.filterRelated: GetRelated")
+		return nil
+	}
+
+	var permitted []entity.ListItem
+	for _, item := range items {
+		if permissions.CheckID(item.ID) == nil {
+			permitted = append(permitted, item)
+		}
+	}
+
+	return permitted
+}
+
+// setVariants sets the language of ent, which is id, and the variants of its document the user can read.
+// Like filterRelated it is best effort.
+func (s *service) setVariants(ctx context.Context, ent *entity.Entity, id uuid.UUID, permissions EffectivePermissions) {
+	variants, err := s.filterVariants(ctx, id, permissions)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.setVariants: filterVariants")
+		return
+	}
+	if self, ok := lo.Find(variants, func(v entity.Variant) bool { return v.EntityID == id }); ok {
+		ent.Language = self.Language
+		ent.Variants = variants
+	}
+}
+
+func (s *service) filterVariants(ctx context.Context, id uuid.UUID, permissions EffectivePermissions) ([]entity.Variant, error) {
+	variants, err := s.core.GetVariants(ctx, id, permissions.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(variants, func(v entity.Variant, _ int) bool { return permissions.CheckID(v.EntityID) == nil }), nil
+}
+
+// recordView counts the read for the popular report. It is best effort: a failure is logged and
+// the read succeeds. Reads outside a session, e.g. by internal callers, are not counted.
+func (s *service) recordView(ctx context.Context, id uuid.UUID) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	sessionID, err := contextx.GetSessionID(ctx)
+	if err != nil {
+		return
+	}
+	if err = s.core.RecordView(ctx, id, userID, sessionID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.recordView: RecordView")
+	}
+}
+
+// GetPopular reports the most viewed entities of the workspace. The route requires admin role, so every
+// entity is counted.
+func (s *service) GetPopular(ctx context.Context, req entity.GetPopularReq) (entity.PopularReport, error) {
+	report, err := s.core.GetPopular(ctx, req, nil, true)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetPopular: GetPopular")
+		return entity.PopularReport{}, fmt.Errorf("entity.service.GetPopular: %w", err)
+	}
+
+	return report, nil
+}
+
+// List returns a page of the flat list of the entities the current user can read.
+func (s *service) List(ctx context.Context, req entity.ListReq) (entity.ListPage, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.List: getEffectivePermissions")
+		return entity.ListPage{}, fmt.Errorf("entity.service.List: %w", err)
+	}
+
+	page, err := s.core.List(ctx, req, permissions.IDs, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.List: List")
+		return entity.ListPage{}, fmt.Errorf("entity.service.List: %w", err)
+	}
+
+	return page, nil
+}
+
+// GetDrafts returns a page of the current user's drafts they can still read.
+func (s *service) GetDrafts(ctx context.Context, req entity.DraftsReq) (entity.ListPage, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetDrafts: getEffectivePermissions")
+		return entity.ListPage{}, fmt.Errorf("entity.service.GetDrafts: %w", err)
+	}
+
+	page, err := s.core.GetDrafts(ctx, req, permissions.IDs, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetDrafts: GetDrafts")
+		return entity.ListPage{}, fmt.Errorf("entity.service.GetDrafts: %w", err)
+	}
+
+	return page, nil
+}
+
+// BatchGet looks up several entities with one permission check for all of them. Entities the current
+// user cannot read or that do not exist are reported per item. Views are not recorded.
+func (s *service) BatchGet(ctx context.Context, req entity.BatchGetReq) (entity.BatchGetResult, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.BatchGet: getEffectivePermissions")
+		return entity.BatchGetResult{}, fmt.Errorf("entity.service.BatchGet: %w", err)
+	}
+
+	result, err := s.core.BatchGet(ctx, req, permissions.IDs, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.BatchGet: BatchGet")
+		return entity.BatchGetResult{}, fmt.Errorf("entity.service.BatchGet: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *service) GetMeta(ctx context.Context, id uuid.UUID) (entity.Meta, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetMeta: checkEntityPermission")
+		return entity.Meta{}, fmt.Errorf("entity.service.GetMeta: %w", err)
+	}
+
+	meta, err := s.core.GetMeta(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetMeta: GetMeta")
+		return entity.Meta{}, fmt.Errorf("entity.service.GetMeta: %w", err)
+	}
+
+	return meta, nil
+}
+
+func (s *service) GetContributors(ctx context.Context, id uuid.UUID) ([]entity.Contributor, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetContributors: checkEntityPermission")
+		return nil, fmt.Errorf("entity.service.GetContributors: %w", err)
+	}
+
+	contributors, err := s.core.GetContributors(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetContributors: GetContributors")
+		return nil, fmt.Errorf("entity.service.GetContributors: %w", err)
+	}
+
+	return contributors, nil
+}
+
+// GetBacklinks returns entities linking to id that the current user can read.
+// GetActivity requires read permission on the entity, which covers its descendants.
+func (s *service) GetActivity(ctx context.Context, req entity.GetActivityReq) (entity.Activity, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Msg("entity.service.GetActivity: getEffectivePermissions")
+		return entity.Activity{}, fmt.Errorf("entity.service.GetActivity: %w", err)
+	}
+	if err = permissions.CheckID(req.ID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Msg("entity.service.GetActivity: checkID")
+		return entity.Activity{}, fmt.Errorf("entity.service.GetActivity: %w", err)
+	}
+
+	activity, err := s.core.GetActivity(ctx, req, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetActivity: GetActivity")
+		return entity.Activity{}, fmt.Errorf("entity.service.GetActivity: %w", err)
+	}
+
+	return activity, nil
+}
+
+func (s *service) GetBacklinks(ctx context.Context, id uuid.UUID) ([]entity.ListItem, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetBacklinks: getEffectivePermissions")
+		return nil, fmt.Errorf("entity.service.GetBacklinks: %w", err)
+	}
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetBacklinks: checkID")
+		return nil, fmt.Errorf("entity.service.GetBacklinks: %w", err)
+	}
+
+	items, err := s.core.GetBacklinks(ctx, id, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetBacklinks: GetBacklinks")
+		return nil, fmt.Errorf("entity.service.GetBacklinks: %w", err)
+	}
+
+	permitted := make([]entity.ListItem, 0, len(items))
+	for _, item := range items {
+		if permissions.CheckID(item.ID) == nil {
+			permitted = append(permitted, item)
+		}
+	}
+
+	return permitted, nil
+}
+
+// GetChildren returns the children of id in sibling order. Read permission on id covers its children.
+func (s *service) GetChildren(ctx context.Context, id uuid.UUID) ([]entity.ListItem, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetChildren: getEffectivePermissions")
+		return nil, fmt.Errorf("entity.service.GetChildren: %w", err)
+	}
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetChildren: checkID")
+		return nil, fmt.Errorf("entity.service.GetChildren: %w", err)
+	}
+
+	items, err := s.core.GetChildren(ctx, id, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetChildren: GetChildren")
+		return nil, fmt.Errorf("entity.service.GetChildren: %w", err)
+	}
+
+	return items, nil
+}
+
+// ReorderChildren sets the order of the children of parentID. Requires write permission for the parent.
+func (s *service) ReorderChildren(ctx context.Context, parentID uuid.UUID, req entity.ChildrenOrderReq) error {
+	if err := s.perm.CheckEntityPermission(ctx, parentID, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), parentID.String()).
+			Msg("entity.service.ReorderChildren: checkEntityPermission")
+		return fmt.Errorf("entity.service.ReorderChildren: %w", err)
+	}
+
+	if err := s.core.ReorderChildren(ctx, parentID, req); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), parentID.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.ReorderChildren: ReorderChildren")
+		return fmt.Errorf("entity.service.ReorderChildren: %w", err)
+	}
+
+	return nil
+}
+
+// SetPinned pins childID above the other children of parentID, or unpins it. Like the order of the
+// children, it requires write permission for the parent.
+func (s *service) SetPinned(ctx context.Context, parentID, childID uuid.UUID, pinned bool) error {
+	if err := s.perm.CheckEntityPermission(ctx, parentID, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), parentID.String()).
+			Msg("entity.service.SetPinned: checkEntityPermission")
+		return fmt.Errorf("entity.service.SetPinned: %w", err)
+	}
+
+	if err := s.core.SetPinned(ctx, parentID, childID, pinned); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), parentID.String()).
+			Str(entity.FieldChildID.String(), childID.String()).
+			Bool("pinned", pinned).
+			Msg("entity.service.SetPinned: SetPinned")
+		return fmt.Errorf("entity.service.SetPinned: %w", err)
+	}
+
+	return nil
+}
+
+// GetSpace returns the settings of the space rootID opens to its readers.
+func (s *service) GetSpace(ctx context.Context, rootID uuid.UUID) (entity.Space, error) {
+	if err := s.perm.CheckEntityPermission(ctx, rootID, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), rootID.String()).
+			Msg("entity.service.GetSpace: checkEntityPermission")
+		return entity.Space{}, fmt.Errorf("entity.service.GetSpace: %w", err)
+	}
+
+	space, err := s.core.GetSpace(ctx, rootID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), rootID.String()).
+			Msg("entity.service.GetSpace: GetSpace")
+		return entity.Space{}, fmt.Errorf("entity.service.GetSpace: %w", err)
+	}
+
+	return space, nil
+}
+
+// SetSpaceSettings replaces the settings of the space rootID opens. They reach further than a write to
+// the root, so only admins and the owner of the root may change them.
+func (s *service) SetSpaceSettings(ctx context.Context, rootID uuid.UUID, req entity.SpaceSettings) (entity.SpaceSettings, error) {
+	_, isAdmin, err := s.perm.GetDirectPermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.SetSpaceSettings: getDirectPermissions")
+		return entity.SpaceSettings{}, fmt.Errorf("entity.service.SetSpaceSettings: %w", err)
+	}
+	if !isAdmin {
+		userID, err := contextx.GetUserID(ctx)
+		if err != nil {
+			logger.Error(ctx, err).Msg("entity.service.SetSpaceSettings: getUserID")
+			return entity.SpaceSettings{}, fmt.Errorf("entity.service.SetSpaceSettings: %w", err)
+		}
+		space, err := s.core.GetSpace(ctx, rootID)
+		if err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), rootID.String()).
+				Msg("entity.service.SetSpaceSettings: GetSpace")
+			return entity.SpaceSettings{}, fmt.Errorf("entity.service.SetSpaceSettings: %w", err)
+		}
+		if space.OwnerID != userID {
+			err = apperr.ErrForbidden()
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), rootID.String()).
+				Msg("entity.service.SetSpaceSettings: not owner")
+			return entity.SpaceSettings{}, fmt.Errorf("entity.service.SetSpaceSettings: %w", err)
+		}
+	}
+
+	settings, err := s.core.SetSpaceSettings(ctx, rootID, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), rootID.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.SetSpaceSettings: SetSpaceSettings")
+		return entity.SpaceSettings{}, fmt.Errorf("entity.service.SetSpaceSettings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// Export writes the subtree of rootID page by page; read permission on the root covers its descendants.
+// The whole workspace, with a nil rootID, is exported on a route that requires admin role. With RenderHTML
+// the content is exported as for GetRendered.
+func (s *service) Export(ctx context.Context, rootID *uuid.UUID, render entity.Render, write func([]entity.ExportItem) error) error {
+	var permissions EffectivePermissions
+	if rootID == nil {
+		permissions.IsAdmin = true
+	} else {
+		var err error
+		permissions, err = s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+		if err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), rootID.String()).
+				Msg("entity.service.Export: getEffectivePermissions")
+			return fmt.Errorf("entity.service.Export: %w", err)
+		}
+		if err = permissions.CheckID(*rootID); err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), rootID.String()).
+				Msg("entity.service.Export: checkID")
+			return fmt.Errorf("entity.service.Export: %w", err)
+		}
+	}
+
+	sanitized := func(items []entity.ExportItem) error {
+		if render == entity.RenderHTML {
+			contents, err := s.expand(ctx, lo.SliceToMap(items, func(item entity.ExportItem) (uuid.UUID, string) {
+				return item.ID, item.Content
+			}), permissions)
+			if err != nil {
+				return err
+			}
+			for i := range items {
+				items[i].Content = s.renderHTML(contents[items[i].ID])
+			}
+			return write(items)
+		}
+		for i := range items {
+			items[i].Content, _ = s.sanitizer.Sanitize(items[i].Content)
+		}
+		return write(items)
+	}
+	if err := s.core.Export(ctx, rootID, permissions.IsAdmin, sanitized); err != nil {
+		logger.Error(ctx, err).
+			Interface(entity.FieldEntityID.String(), rootID).
+			Msg("entity.service.Export: Export")
+		return fmt.Errorf("entity.service.Export: %w", err)
+	}
+
+	return nil
+}
+
+// GetTOC returns the outline of the subtree of id; read permission on id covers its descendants, as for Export.
+func (s *service) GetTOC(ctx context.Context, id uuid.UUID) (*entity.TOCNode, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetTOC: getEffectivePermissions")
+		return nil, fmt.Errorf("entity.service.GetTOC: %w", err)
+	}
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetTOC: checkID")
+		return nil, fmt.Errorf("entity.service.GetTOC: %w", err)
+	}
+
+	toc, err := s.core.GetTOC(ctx, id, permissions.IsAdmin)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetTOC: GetTOC")
+		return nil, fmt.Errorf("entity.service.GetTOC: %w", err)
+	}
+
+	return toc, nil
+}
+
+// Manual writes the subtree of id as one document; the writer sanitizes the HTML it renders, see
+// entity.HTMLManual. Read permission on id covers its descendants, entities outside it are only included
+// if the user can read them.
+func (s *service) Manual(ctx context.Context, id uuid.UUID, w entity.ManualWriter) error {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Manual: getEffectivePermissions")
+		return fmt.Errorf("entity.service.Manual: %w", err)
+	}
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Manual: checkID")
+		return fmt.Errorf("entity.service.Manual: %w", err)
+	}
+
+	if err = s.core.Manual(ctx, id, permissions.IDs, permissions.IsAdmin, w); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Manual: Manual")
+		return fmt.Errorf("entity.service.Manual: %w", err)
+	}
+
+	return nil
+}
+
+// GetDefaultPermissions returns the default permissions of the entity. The route requires admin role, as for grants.
+func (s *service) GetDefaultPermissions(ctx context.Context, id uuid.UUID) ([]entity.DefaultPermission, error) {
+	permissions, err := s.core.GetDefaultPermissions(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetDefaultPermissions: GetDefaultPermissions")
+		return nil, fmt.Errorf("entity.service.GetDefaultPermissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+// SetDefaultPermissions replaces the default permissions of the entity. They apply to entities created
+// afterwards; existing ones keep their grants. The route requires admin role, as for grants.
+func (s *service) SetDefaultPermissions(ctx context.Context, id uuid.UUID, req entity.SetDefaultPermissionsReq) error {
+	if err := s.core.SetDefaultPermissions(ctx, id, req); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.SetDefaultPermissions: SetDefaultPermissions")
+		return fmt.Errorf("entity.service.SetDefaultPermissions: %w", err)
+	}
+
+	logger.Audit(ctx, "entity.default_permissions_set").
+		Str(entity.FieldEntityID.String(), id.String()).
+		Interface(entity.FieldDefaultPermissions.String(), req.Permissions).
+		Msg("default permissions set")
+	return nil
+}
+
+// GetUnsafeMarkup lists the entities whose stored content holds markup the sanitize policy removes when
+// it is exported or served. The route requires admin role.
+func (s *service) GetUnsafeMarkup(ctx context.Context) ([]entity.UnsafeMarkup, error) {
+	report := []entity.UnsafeMarkup{}
+	err := s.core.Export(ctx, nil, true, func(items []entity.ExportItem) error {
+		for _, item := range items {
+			if _, removed := s.sanitizer.Sanitize(item.Content); len(removed) > 0 {
+				report = append(report, entity.UnsafeMarkup{ID: item.ID, Name: item.Name, Slug: item.Slug, Removed: removed})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.GetUnsafeMarkup: Export")
+		return nil, fmt.Errorf("entity.service.GetUnsafeMarkup: %w", err)
+	}
+
+	return report, nil
+}
+
+// GetBrokenLinks reports links to missing or deleted entities. The route requires admin role.
+func (s *service) GetBrokenLinks(ctx context.Context) ([]entity.BrokenLink, error) {
+	links, err := s.core.GetBrokenLinks(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.GetBrokenLinks: GetBrokenLinks")
+		return nil, fmt.Errorf("entity.service.GetBrokenLinks: %w", err)
+	}
+
+	return links, nil
+}
+
+// GetOrphanedEntities reports live entities whose owner was deleted. The route requires admin role.
+func (s *service) GetOrphanedEntities(ctx context.Context) ([]entity.OrphanedEntity, error) {
+	entities, err := s.core.GetOrphanedEntities(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.GetOrphanedEntities: GetOrphanedEntities")
+		return nil, fmt.Errorf("entity.service.GetOrphanedEntities: %w", err)
+	}
+
+	return entities, nil
+}
+
+// PreviewRetention lists the versions the retention policy would delete on its next run. The route requires
+// admin role.
+func (s *service) PreviewRetention(ctx context.Context) (entity.RetentionReport, error) {
+	report, err := s.core.PruneVersions(ctx, true)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.PreviewRetention: PruneVersions")
+		return entity.RetentionReport{}, fmt.Errorf("entity.service.PreviewRetention: %w", err)
+	}
+
+	return report, nil
+}
+
+// PurgeTrash hard-deletes the entities past the trash retention now; with dryRun it only reports them.
+// The route requires admin role.
+func (s *service) PurgeTrash(ctx context.Context, dryRun bool) (entity.TrashReport, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.PurgeTrash: GetUserID")
+		return entity.TrashReport{}, fmt.Errorf("entity.service.PurgeTrash: %w", err)
+	}
+	report, err := s.core.PurgeTrash(ctx, &userID, dryRun)
+	if err != nil {
+		logger.Error(ctx, err).Bool("dry_run", dryRun).Msg("entity.service.PurgeTrash: PurgeTrash")
+		return entity.TrashReport{}, fmt.Errorf("entity.service.PurgeTrash: %w", err)
+	}
+
+	return report, nil
+}
+
+// CleanupStaleDrafts applies the stale draft policy: it cleans up the drafts past their deadline and
+// reminds the creators of drafts going stale. The job runs it for every workspace, so there is no
+// permission to check. A reminder that cannot be emailed is retried on the next run; with mail disabled
+// it is recorded as sent.
+func (s *service) CleanupStaleDrafts(ctx context.Context) (entity.StaleDraftsReport, error) {
+	return s.staleDrafts(ctx, false)
+}
+
+// PreviewStaleDrafts reports what the stale draft policy would do now without doing it. The route requires
+// admin role.
+func (s *service) PreviewStaleDrafts(ctx context.Context) (entity.StaleDraftsReport, error) {
+	return s.staleDrafts(ctx, true)
+}
+
+func (s *service) staleDrafts(ctx context.Context, dryRun bool) (entity.StaleDraftsReport, error) {
+	// drafts past their deadline were reminded about on an earlier run, so they go first
+	report, err := s.core.CleanupStaleDrafts(ctx, dryRun)
+	if err != nil {
+		logger.Error(ctx, err).Bool("dry_run", dryRun).Msg("entity.service.staleDrafts: CleanupStaleDrafts")
+		return entity.StaleDraftsReport{}, fmt.Errorf("entity.service.staleDrafts: %w", err)
+	}
+	reminders, err := s.core.GetDraftReminders(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("entity.service.staleDrafts: GetDraftReminders")
+		return entity.StaleDraftsReport{}, fmt.Errorf("entity.service.staleDrafts: %w", err)
+	}
+
+	for _, reminder := range reminders {
+		if msg, ok := s.core.DraftReminderEmail(reminder); ok && !dryRun {
+			if err = s.sender.Send(ctx, msg); err != nil && !errors.Is(err, mail.ErrDisabled) {
+				logger.Error(ctx, err).
+					Str(entity.FieldUserID.String(), reminder.UserID.String()).
+					Msg("entity.service.staleDrafts: failed to send draft reminder")
+				continue
+			}
+		}
+		report.Reminded = append(report.Reminded, reminder.Drafts...)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	ids := lo.Map(report.Reminded, func(d entity.StaleDraft, _ int) uuid.UUID { return d.ID })
+	if err = s.core.MarkDraftsReminded(ctx, ids); err != nil {
+		logger.Error(ctx, err).Msg("entity.service.staleDrafts: MarkDraftsReminded")
+		return entity.StaleDraftsReport{}, fmt.Errorf("entity.service.staleDrafts: %w", err)
+	}
+
+	return report, nil
+}
+
+func (s *service) GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Int(entity.FieldVersion.String(), version).
+			Msg("entity.service.GetVersion: checkEntityPermission")
+		return entity.Entity{}, fmt.Errorf("entity.service.GetVersion: %w", err)
+	}
+
+	ent, err := s.core.GetVersion(ctx, id, version)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Int(entity.FieldVersion.String(), version).
+			Msg("entity.service.GetVersion: GetVersion")
+		return entity.Entity{}, fmt.Errorf("entity.service.GetVersion: %w", err)
+	}
+
+	return ent, nil
+}
+
+// Merge merges the caller's edit of a version with a later one. Requires read permission.
+func (s *service) Merge(ctx context.Context, req entity.MergeReq) (entity.MergeResult, error) {
+	if err := s.perm.CheckEntityPermission(ctx, req.ID, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Msg("entity.service.Merge: checkEntityPermission")
+		return entity.MergeResult{}, fmt.Errorf("entity.service.Merge: %w", err)
+	}
+
+	result, err := s.core.Merge(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Int(entity.FieldVersion.String(), req.BaseVersion).
+			Msg("entity.service.Merge: Merge")
+		return entity.MergeResult{}, fmt.Errorf("entity.service.Merge: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *service) GetVersionsList(ctx context.Context, req entity.GetVersionsReq) (entity.VersionsPage, error) {
+	if err := s.perm.CheckEntityPermission(ctx, req.ID, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Msg("entity.service.GetVersionsList: checkEntityPermission")
+		return entity.VersionsPage{}, fmt.Errorf("entity.service.GetVersionsList: %w", err)
+	}
+
+	page, err := s.core.GetVersionsList(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetVersionsList: GetVersionsList")
+		return entity.VersionsPage{}, fmt.Errorf("entity.service.GetVersionsList: %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *service) GetHistory(ctx context.Context, req entity.GetHistoryReq) (entity.History, error) {
+	if err := s.perm.CheckEntityPermission(ctx, req.ID, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), req.ID.String()).
+			Msg("entity.service.GetHistory: checkEntityPermission")
+		return entity.History{}, fmt.Errorf("entity.service.GetHistory: %w", err)
+	}
+
+	history, err := s.core.GetHistory(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.GetHistory: GetHistory")
+		return entity.History{}, fmt.Errorf("entity.service.GetHistory: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *service) Create(ctx context.Context, cmd CreateEntityCmd) (uuid.UUID, entity.ContentUsage, error) {
+	return s.create(ctx, cmd, nil, "")
+}
+
+// create creates the entity of cmd, a variant of variantOf in language if it is set.
+func (s *service) create(ctx context.Context, cmd CreateEntityCmd, variantOf *uuid.UUID, language string) (uuid.UUID, entity.ContentUsage, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleWrite)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Create: getEffectivePermissions")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.Create: %w", err)
+	}
+	if err = permissions.CheckParentIDs([]*uuid.UUID{cmd.ParentID}); err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Create: checkParentIDs")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.Create: %w", err)
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Create: GetUserID")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.Create: %w", err)
+	}
+	req := entity.CreateEntityReq{
+		Type:      cmd.Type,
+		Name:      cmd.Name,
+		Content:   cmd.Content,
+		ParentID:  cmd.ParentID,
+		IsDraft:   cmd.IsDraft,
+		UserID:    userID,
+		VariantOf: variantOf,
+		Language:  language,
+	}
+	id, usage, err := s.core.Create(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.Create: Create")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.Create: %w", err)
+	}
+	if cmd.ParentID != nil {
+		s.applyDefaultPermissions(ctx, id)
+	}
+
+	return id, usage, nil
+}
+
+// applyDefaultPermissions grants the default permissions of the ancestors on a new entity and returns the
+// grants made. The entity is created already, so like filterRelated it is best effort: failures are logged
+// and the other grants go on.
+func (s *service) applyDefaultPermissions(ctx context.Context, id uuid.UUID) []entity.Grant {
+	granted := make([]entity.Grant, 0)
+	pending, err := s.core.GetPendingDefaultPermissions(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.applyDefaultPermissions: GetPendingDefaultPermissions")
+		return granted
+	}
+	for _, p := range pending {
+		role := auth.UserRole{UserID: p.UserID, Role: p.Role, EntityID: &id}
+		if err = s.granter.AddUserRole(ctx, role); err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), id.String()).
+				Interface(apperr.FieldRequest.String(), role).
+				Msg("entity.service.applyDefaultPermissions: AddUserRole")
+			continue
+		}
+		granted = append(granted, entity.Grant{UserID: p.UserID, Role: p.Role, EntityID: id})
+	}
+
+	return granted
+}
+
+// applySpaceMove changes the grants of an entity moved into another space as move plans it, filling in
+// move.Granted. It runs in the transaction of the move, so a failed grant change rolls the move back.
+func (s *service) applySpaceMove(ctx context.Context, id uuid.UUID, move *entity.SpaceMove) error {
+	for _, g := range move.Revoked {
+		role := auth.UserRole{UserID: g.UserID, Role: g.Role, EntityID: &g.EntityID}
+		if err := s.granter.DeleteUserRole(ctx, role); err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), id.String()).
+				Interface(apperr.FieldRequest.String(), role).
+				Msg("entity.service.applySpaceMove: DeleteUserRole")
+			return fmt.Errorf("entity.service.applySpaceMove: %w", err)
+		}
+	}
+	if move.Grants != entity.GrantsRemap {
+		return nil
+	}
+	pending, err := s.core.GetPendingDefaultPermissions(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.applySpaceMove: GetPendingDefaultPermissions")
+		return fmt.Errorf("entity.service.applySpaceMove: %w", err)
+	}
+	for _, p := range pending {
+		role := auth.UserRole{UserID: p.UserID, Role: p.Role, EntityID: &id}
+		if err = s.granter.AddUserRole(ctx, role); err != nil {
+			logger.Error(ctx, err).
+				Str(entity.FieldEntityID.String(), id.String()).
+				Interface(apperr.FieldRequest.String(), role).
+				Msg("entity.service.applySpaceMove: AddUserRole")
+			return fmt.Errorf("entity.service.applySpaceMove: %w", err)
+		}
+		move.Granted = append(move.Granted, entity.Grant{UserID: p.UserID, Role: p.Role, EntityID: id})
+	}
+
+	return nil
+}
+
+func (s *service) Update(ctx context.Context, cmd UpdateEntityCmd) (entity.ContentUsage, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleWrite)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Update: getEffectivePermissions")
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+	if err = permissions.CheckID(cmd.ID); err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Update: checkID")
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+
+	// the entity and the parent it may move to are read in one query
+	lookup := []uuid.UUID{cmd.ID}
+	if cmd.ParentID != nil {
+		lookup = append(lookup, *cmd.ParentID)
+	}
+	items, err := s.core.GetListItems(ctx, lookup)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Update: GetListItems")
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+	oldEntity, found := findListItem(items, cmd.ID)
+	if !found {
+		err = entity.ErrEntityNotFound()
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Update: entity not found")
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+	parentChanged := !equalUUIDPtr(oldEntity.ParentID, cmd.ParentID)
+	var move *entity.SpaceMove
+	if parentChanged {
+		if err = permissions.CheckParentIDs([]*uuid.UUID{cmd.ParentID, oldEntity.ParentID}); err != nil {
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), cmd).
+				Msg("entity.service.Update: checkParentIDs")
+			return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+		}
+		if _, found = findListItem(items, lo.FromPtr(cmd.ParentID)); cmd.ParentID != nil && !found {
+			err = entity.ErrParentNotFound()
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), cmd).
+				Msg("entity.service.Update: parent not found")
+			return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+		}
+		if move, err = s.core.PlanSpaceMove(ctx, cmd.ID, cmd.ParentID, cmd.Grants); err != nil {
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), cmd).
+				Msg("entity.service.Update: PlanSpaceMove")
+			return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+		}
+		if move != nil && !cmd.ConfirmCrossSpace {
+			err = entity.ErrSpaceMoveNotConfirmed(move.FromSpaceID, move.ToSpaceID)
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), cmd).
+				Msg("entity.service.Update: space move not confirmed")
+			return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+		}
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), cmd).
+			Msg("entity.service.Update: GetUserID")
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+
+	req := entity.UpdateEntityReq{
+		ID:            cmd.ID,
+		Name:          cmd.Name,
+		Content:       cmd.Content,
+		ParentID:      cmd.ParentID,
+		IsDraft:       cmd.IsDraft,
+		UserID:        userID,
+		ParentChanged: parentChanged,
+		EntityType:    oldEntity.Type,
+		Summary:       cmd.Summary,
+		MinorEdit:     cmd.MinorEdit,
+	}
+
+	if move == nil {
+		usage, err := s.core.Update(ctx, req)
+		if err != nil {
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), req).
+				Msg("entity.service.Update: Update")
+			return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+		}
+		return usage, nil
+	}
+
+	// the grants change with the move or not at all
+	var usage entity.ContentUsage
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if usage, err = s.core.Update(ctx, req); err != nil {
+			logger.Error(ctx, err).
+				Interface(apperr.FieldRequest.String(), req).
+				Msg("entity.service.Update: Update")
+			return err
+		}
+		return s.applySpaceMove(ctx, cmd.ID, move)
+	})
+	if err != nil {
+		return entity.ContentUsage{}, fmt.Errorf("entity.service.Update: %w", err)
+	}
+	logger.Audit(ctx, "entity.moved_across_spaces").
+		Str(entity.FieldEntityID.String(), cmd.ID.String()).
+		Str("from_space_id", move.FromSpaceID.String()).
+		Str("to_space_id", move.ToSpaceID.String()).
+		Str(entity.FieldGrants.String(), string(move.Grants)).
+		Int("revoked", len(move.Revoked)).
+		Int("kept", len(move.Kept)).
+		Int("granted", len(move.Granted)).
+		Msg("entity moved to another space")
+	usage.SpaceMove = move
+
+	return usage, nil
+}
+
+// Delete deletes the entity with its subtree; with dryRun it only reports the entities it would delete.
+func (s *service) Delete(ctx context.Context, id uuid.UUID, dryRun bool) (entity.DeleteReport, error) {
+	err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Delete: checkEntityPermission")
+		return entity.DeleteReport{}, fmt.Errorf("entity.service.Delete: %w", err)
+	}
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Delete: GetUserID")
+		return entity.DeleteReport{}, fmt.Errorf("entity.service.Delete: %w", err)
+	}
+	report, err := s.core.Delete(ctx, id, userID, dryRun)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Bool("dry_run", dryRun).
+			Msg("entity.service.Delete: Delete")
+		return entity.DeleteReport{}, fmt.Errorf("entity.service.Delete: %w", err)
+	}
+
+	return report, nil
+}
+
+// TransferOwnership makes ownerID the owner of the entity. Requires write permission for the entity.
+func (s *service) TransferOwnership(ctx context.Context, id, ownerID uuid.UUID) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.TransferOwnership: checkEntityPermission")
+		return fmt.Errorf("entity.service.TransferOwnership: %w", err)
+	}
+
+	if err := s.core.TransferOwnership(ctx, id, ownerID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldOwnerID.String(), ownerID.String()).
+			Msg("entity.service.TransferOwnership: TransferOwnership")
+		return fmt.Errorf("entity.service.TransferOwnership: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAppearance sets the icon and cover image of the entity shown in navigation. Requires write permission.
+// An icon or cover set to an attachment key must refer to an attachment of the workspace.
+func (s *service) UpdateAppearance(ctx context.Context, id uuid.UUID, req entity.UpdateAppearanceReq) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.UpdateAppearance: checkEntityPermission")
+		return fmt.Errorf("entity.service.UpdateAppearance: %w", err)
+	}
+	err := s.resolveAttachment(ctx, entity.FieldIcon, req.Icon)
+	if err == nil {
+		err = s.resolveAttachment(ctx, entity.FieldCover, req.Cover)
+	}
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.UpdateAppearance: resolveAttachment")
+		return fmt.Errorf("entity.service.UpdateAppearance: %w", err)
+	}
+
+	if err = s.core.UpdateAppearance(ctx, id, req); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.UpdateAppearance: UpdateAppearance")
+		return fmt.Errorf("entity.service.UpdateAppearance: %w", err)
+	}
+
+	return nil
+}
+
+// resolveAttachment looks up value in the workspace if it is an attachment key, so an entity cannot show
+// a file stored in another workspace or not stored at all. Other values are validated by the core.
+func (s *service) resolveAttachment(ctx context.Context, field apperr.Field, value *string) error {
+	if value == nil {
+		return nil
+	}
+	key := strings.TrimSpace(*value)
+	if !attachment.IsKey(key) {
+		return nil
+	}
+	if _, err := s.attachments.Get(ctx, key); err != nil {
+		if errors.Is(err, attachment.ErrAttachmentNotFound()) {
+			return entity.ErrAttachmentNotFound(field)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// CreateSnapshot captures the current versions of the subtree of id under a label. Requires write
+// permission for the root, which covers its descendants.
+func (s *service) CreateSnapshot(ctx context.Context, id uuid.UUID, req entity.CreateSnapshotReq) (entity.Snapshot, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.CreateSnapshot: checkEntityPermission")
+		return entity.Snapshot{}, fmt.Errorf("entity.service.CreateSnapshot: %w", err)
+	}
+
+	snapshot, err := s.core.CreateSnapshot(ctx, id, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("entity.service.CreateSnapshot: CreateSnapshot")
+		return entity.Snapshot{}, fmt.Errorf("entity.service.CreateSnapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// GetSnapshots lists the snapshots of the entity. Requires read permission.
+func (s *service) GetSnapshots(ctx context.Context, id uuid.UUID) ([]entity.Snapshot, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetSnapshots: checkEntityPermission")
+		return nil, fmt.Errorf("entity.service.GetSnapshots: %w", err)
+	}
+
+	snapshots, err := s.core.GetSnapshots(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetSnapshots: GetSnapshots")
+		return nil, fmt.Errorf("entity.service.GetSnapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// GetSnapshot returns the subtree of id as of the snapshot with the label. Read permission on the root
+// covers its descendants, as for Export.
+func (s *service) GetSnapshot(ctx context.Context, id uuid.UUID, label string) (entity.SnapshotContent, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetSnapshot: checkEntityPermission")
+		return entity.SnapshotContent{}, fmt.Errorf("entity.service.GetSnapshot: %w", err)
+	}
+
+	snapshot, err := s.core.GetSnapshot(ctx, id, label)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldLabel.String(), label).
+			Msg("entity.service.GetSnapshot: GetSnapshot")
+		return entity.SnapshotContent{}, fmt.Errorf("entity.service.GetSnapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// GetVariables returns the variables in the scope of the entity, inherited ones included. Requires read permission.
+func (s *service) GetVariables(ctx context.Context, id uuid.UUID) ([]entity.Variable, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetVariables: checkEntityPermission")
+		return nil, fmt.Errorf("entity.service.GetVariables: %w", err)
+	}
+
+	vars, err := s.core.GetVariables(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetVariables: GetVariables")
+		return nil, fmt.Errorf("entity.service.GetVariables: %w", err)
+	}
+
+	return vars, nil
 }
 
-type service struct {
-	core Core
-	perm PermissionChecker
+// SetVariable defines a variable for the subtree of the entity. Requires write permission.
+func (s *service) SetVariable(ctx context.Context, id uuid.UUID, key string, req entity.SetVariableReq) (entity.Variable, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.SetVariable: checkEntityPermission")
+		return entity.Variable{}, fmt.Errorf("entity.service.SetVariable: %w", err)
+	}
+
+	v, err := s.core.SetVariable(ctx, id, key, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldKey.String(), key).
+			Msg("entity.service.SetVariable: SetVariable")
+		return entity.Variable{}, fmt.Errorf("entity.service.SetVariable: %w", err)
+	}
+
+	return v, nil
+}
+
+// DeleteVariable removes a variable the entity defines. Requires write permission.
+func (s *service) DeleteVariable(ctx context.Context, id uuid.UUID, key string) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.DeleteVariable: checkEntityPermission")
+		return fmt.Errorf("entity.service.DeleteVariable: %w", err)
+	}
+
+	if err := s.core.DeleteVariable(ctx, id, key); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldKey.String(), key).
+			Msg("entity.service.DeleteVariable: DeleteVariable")
+		return fmt.Errorf("entity.service.DeleteVariable: %w", err)
+	}
+
+	return nil
+}
+
+// AddRelation marks two entities as related pages. Requires write permission for both of them.
+func (s *service) AddRelation(ctx context.Context, id, relatedID uuid.UUID) error {
+	if err := s.checkRelationPermission(ctx, id, relatedID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldRelated.String(), relatedID.String()).
+			Msg("entity.service.AddRelation: checkRelationPermission")
+		return fmt.Errorf("entity.service.AddRelation: %w", err)
+	}
+
+	if err := s.core.AddRelation(ctx, id, relatedID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldRelated.String(), relatedID.String()).
+			Msg("entity.service.AddRelation: AddRelation")
+		return fmt.Errorf("entity.service.AddRelation: %w", err)
+	}
+
+	return nil
 }
 
-func NewService(repo Core, perm PermissionChecker) *service {
-	if perm == nil || repo == nil {
-		panic("entity.NewService: nil core or perm")
+// DeleteRelation removes the relation between two entities. Requires write permission for both of them.
+func (s *service) DeleteRelation(ctx context.Context, id, relatedID uuid.UUID) error {
+	if err := s.checkRelationPermission(ctx, id, relatedID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldRelated.String(), relatedID.String()).
+			Msg("entity.service.DeleteRelation: checkRelationPermission")
+		return fmt.Errorf("entity.service.DeleteRelation: %w", err)
+	}
+
+	if err := s.core.DeleteRelation(ctx, id, relatedID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldRelated.String(), relatedID.String()).
+			Msg("entity.service.DeleteRelation: DeleteRelation")
+		return fmt.Errorf("entity.service.DeleteRelation: %w", err)
 	}
-	return &service{core: repo, perm: perm}
+
+	return nil
 }
 
-func (s *service) GetTree(ctx context.Context) (entity.Tree, error) {
-	ids, isAdmin, err := s.perm.GetDirectPermissions(ctx, auth.RoleRead)
+// checkRelationPermission requires write permission on both sides, as a relation shows up on both pages.
+func (s *service) checkRelationPermission(ctx context.Context, id, relatedID uuid.UUID) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		return err
+	}
+
+	return s.perm.CheckEntityPermission(ctx, relatedID, auth.RoleWrite)
+}
+
+// GetVariants returns the language variants of the entity the user can read, itself included. Requires read permission.
+func (s *service) GetVariants(ctx context.Context, id uuid.UUID) ([]entity.Variant, error) {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
 	if err != nil {
-		logger.Error(ctx, err).Msg("entity.service.GetTree: getUserPermissions")
-		return entity.Tree{}, fmt.Errorf("entity.service.GetTree: %w", err)
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetVariants: getEffectivePermissions")
+		return nil, fmt.Errorf("entity.service.GetVariants: %w", err)
 	}
-	tree, err := s.core.GetTree(ctx, ids, isAdmin)
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetVariants: checkID")
+		return nil, fmt.Errorf("entity.service.GetVariants: %w", err)
+	}
+
+	variants, err := s.filterVariants(ctx, id, permissions)
 	if err != nil {
-		logger.Error(ctx, err).Msg("entity.service.GetTree: GetTree")
-		return entity.Tree{}, fmt.Errorf("entity.service.GetTree: %w", err)
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetVariants: GetVariants")
+		return nil, fmt.Errorf("entity.service.GetVariants: %w", err)
 	}
 
-	return tree, nil
+	return variants, nil
 }
 
-func (s *service) Get(ctx context.Context, id uuid.UUID) (entity.Entity, error) {
-	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+// ResolveVariant returns the variant of the entity the user can read whose language fits acceptLanguage
+// best, the entity itself if none fits better. It is best effort: on a failure the entity is returned,
+// and reading it reports what is wrong.
+func (s *service) ResolveVariant(ctx context.Context, id uuid.UUID, acceptLanguage string) uuid.UUID {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleRead)
+	if err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.Get: checkEntityPermission")
-		return entity.Entity{}, fmt.Errorf("entity.service.Get: %w", err)
+			Msg("entity.service.ResolveVariant: getEffectivePermissions")
+		return id
 	}
-
-	ent, err := s.core.Get(ctx, id)
+	if permissions.CheckID(id) != nil {
+		return id
+	}
+	variants, err := s.filterVariants(ctx, id, permissions)
 	if err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.Get: Get")
-		return entity.Entity{}, fmt.Errorf("entity.service.Get: %w", err)
+			Msg("entity.service.ResolveVariant: GetVariants")
+		return id
+	}
+	v, ok := entity.MatchVariant(variants, id, acceptLanguage)
+	if !ok {
+		return id
 	}
 
-	return ent, nil
+	return v.EntityID
 }
 
-func (s *service) GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error) {
-	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+// SetLanguage sets the language of the entity. Requires write permission.
+func (s *service) SetLanguage(ctx context.Context, id uuid.UUID, req entity.SetLanguageReq) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Int(entity.FieldVersion.String(), version).
-			Msg("entity.service.GetVersion: checkEntityPermission")
-		return entity.Entity{}, fmt.Errorf("entity.service.GetVersion: %w", err)
+			Msg("entity.service.SetLanguage: checkEntityPermission")
+		return fmt.Errorf("entity.service.SetLanguage: %w", err)
 	}
 
-	ent, err := s.core.GetVersion(ctx, id, version)
-	if err != nil {
+	if err := s.core.SetLanguage(ctx, id, req); err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Int(entity.FieldVersion.String(), version).
-			Msg("entity.service.GetVersion: GetVersion")
-		return entity.Entity{}, fmt.Errorf("entity.service.GetVersion: %w", err)
+			Str(entity.FieldLanguage.String(), req.Language).
+			Msg("entity.service.SetLanguage: SetLanguage")
+		return fmt.Errorf("entity.service.SetLanguage: %w", err)
 	}
 
-	return ent, nil
+	return nil
 }
 
-func (s *service) GetVersionsList(ctx context.Context, id uuid.UUID) ([]entity.Entity, error) {
-	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+// UnlinkVariant removes the language of the entity, unlinking it from its variants. Requires write permission.
+func (s *service) UnlinkVariant(ctx context.Context, id uuid.UUID) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.GetVersionsList: checkEntityPermission")
-		return nil, fmt.Errorf("entity.service.GetVersionsList: %w", err)
+			Msg("entity.service.UnlinkVariant: checkEntityPermission")
+		return fmt.Errorf("entity.service.UnlinkVariant: %w", err)
 	}
 
-	entities, err := s.core.GetVersionsList(ctx, id)
-	if err != nil {
+	if err := s.core.UnlinkVariant(ctx, id); err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.GetVersionsList: GetVersionsList")
-		return nil, fmt.Errorf("entity.service.GetVersionsList: %w", err)
+			Msg("entity.service.UnlinkVariant: UnlinkVariant")
+		return fmt.Errorf("entity.service.UnlinkVariant: %w", err)
 	}
 
-	return entities, nil
+	return nil
 }
 
-func (s *service) Create(ctx context.Context, cmd CreateEntityCmd) (uuid.UUID, error) {
-	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleWrite)
-	if err != nil {
+// LinkVariant makes another entity a language variant of the entity. Like a relation it shows up on both
+// pages, so it requires write permission for both of them.
+func (s *service) LinkVariant(ctx context.Context, id, variantID uuid.UUID, req entity.SetLanguageReq) error {
+	if err := s.checkRelationPermission(ctx, id, variantID); err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Create: getEffectivePermissions")
-		return uuid.Nil, fmt.Errorf("entity.service.Create: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldVariant.String(), variantID.String()).
+			Msg("entity.service.LinkVariant: checkRelationPermission")
+		return fmt.Errorf("entity.service.LinkVariant: %w", err)
 	}
-	if err = permissions.CheckParentIDs([]*uuid.UUID{cmd.ParentID}); err != nil {
+
+	if err := s.core.LinkVariant(ctx, id, variantID, req); err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Create: checkParentIDs")
-		return uuid.Nil, fmt.Errorf("entity.service.Create: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Str(entity.FieldVariant.String(), variantID.String()).
+			Str(entity.FieldLanguage.String(), req.Language).
+			Msg("entity.service.LinkVariant: LinkVariant")
+		return fmt.Errorf("entity.service.LinkVariant: %w", err)
 	}
 
-	userID, err := contextx.GetUserID(ctx)
+	return nil
+}
+
+// CreateVariant creates a translation of the entity next to it, with the same type, as Create does.
+// Requires write permission for the entity and for its parent.
+func (s *service) CreateVariant(ctx context.Context, id uuid.UUID, cmd CreateVariantCmd) (uuid.UUID, entity.ContentUsage, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.CreateVariant: checkEntityPermission")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.CreateVariant: %w", err)
+	}
+	items, err := s.core.GetListItems(ctx, []uuid.UUID{id})
 	if err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Create: GetUserID")
-		return uuid.Nil, fmt.Errorf("entity.service.Create: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.CreateVariant: GetListItems")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.CreateVariant: %w", err)
 	}
-	req := entity.CreateEntityReq{
-		Type:     cmd.Type,
+	source, found := findListItem(items, id)
+	if !found {
+		err = entity.ErrEntityNotFound()
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.CreateVariant: entity not found")
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.CreateVariant: %w", err)
+	}
+
+	variantID, usage, err := s.create(ctx, CreateEntityCmd{
+		Type:     source.Type,
 		Name:     cmd.Name,
 		Content:  cmd.Content,
-		ParentID: cmd.ParentID,
+		ParentID: source.ParentID,
 		IsDraft:  cmd.IsDraft,
-		UserID:   userID,
+	}, &id, cmd.Language)
+	if err != nil {
+		return uuid.Nil, entity.ContentUsage{}, fmt.Errorf("entity.service.CreateVariant: %w", err)
 	}
-	id, err := s.core.Create(ctx, req)
+
+	return variantID, usage, nil
+}
+
+// GetMissingTranslations lists the documents lacking a variant in one of the workspace languages. The route
+// requires admin role.
+func (s *service) GetMissingTranslations(ctx context.Context) ([]entity.MissingTranslation, error) {
+	report, err := s.core.GetMissingTranslations(ctx)
 	if err != nil {
-		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), req).
-			Msg("entity.service.Create: Create")
-		return uuid.Nil, fmt.Errorf("entity.service.Create: %w", err)
+		logger.Error(ctx, err).Msg("entity.service.GetMissingTranslations: GetMissingTranslations")
+		return nil, fmt.Errorf("entity.service.GetMissingTranslations: %w", err)
 	}
 
-	return id, nil
+	return report, nil
 }
 
-func (s *service) Update(ctx context.Context, cmd UpdateEntityCmd) error {
-	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleWrite)
+// RunLint lints the versions saved since the last run and returns how many it linted. The job runs it
+// for every workspace, so there is no permission to check. A version the linter fails on is recorded as
+// failed and not retried until it changes.
+func (s *service) RunLint(ctx context.Context) (int, error) {
+	if s.linter == nil {
+		return 0, nil
+	}
+
+	linted := 0
+	for {
+		targets, err := s.core.ClaimLint(ctx)
+		if err != nil {
+			logger.Error(ctx, err).Msg("entity.service.RunLint: ClaimLint")
+			return linted, fmt.Errorf("entity.service.RunLint: %w", err)
+		}
+		if len(targets) == 0 {
+			return linted, nil
+		}
+
+		for _, target := range targets {
+			matches, err := s.linter.Lint(ctx, target.Content, target.Language)
+			if err != nil {
+				logger.Error(ctx, err).
+					Str(entity.FieldEntityID.String(), target.EntityID.String()).
+					Int(entity.FieldVersion.String(), target.Version).
+					Msg("entity.service.RunLint: Lint")
+				if err = s.core.FailLint(ctx, target); err != nil {
+					logger.Error(ctx, err).
+						Str(entity.FieldEntityID.String(), target.EntityID.String()).
+						Int(entity.FieldVersion.String(), target.Version).
+						Msg("entity.service.RunLint: FailLint")
+					return linted, fmt.Errorf("entity.service.RunLint: %w", err)
+				}
+				continue
+			}
+			if err = s.core.CompleteLint(ctx, target, matches); err != nil {
+				logger.Error(ctx, err).
+					Str(entity.FieldEntityID.String(), target.EntityID.String()).
+					Int(entity.FieldVersion.String(), target.Version).
+					Msg("entity.service.RunLint: CompleteLint")
+				return linted, fmt.Errorf("entity.service.RunLint: %w", err)
+			}
+			linted++
+		}
+	}
+}
+
+// GetLintReport returns what the linter found in a version of the entity, the current one if version is 0.
+// Requires read permission.
+func (s *service) GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetLintReport: checkEntityPermission")
+		return entity.LintReport{}, fmt.Errorf("entity.service.GetLintReport: %w", err)
+	}
+
+	report, err := s.core.GetLintReport(ctx, id, version)
 	if err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Update: getEffectivePermissions")
-		return fmt.Errorf("entity.service.Update: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Int(entity.FieldVersion.String(), version).
+			Msg("entity.service.GetLintReport: GetLintReport")
+		return entity.LintReport{}, fmt.Errorf("entity.service.GetLintReport: %w", err)
 	}
-	if err = permissions.CheckID(cmd.ID); err != nil {
+
+	return report, nil
+}
+
+// SetLintSettings disables or enables linting for the entity and its descendants. Requires write permission.
+func (s *service) SetLintSettings(ctx context.Context, id uuid.UUID, req entity.LintSettings) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Update: checkID")
-		return fmt.Errorf("entity.service.Update: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.SetLintSettings: checkEntityPermission")
+		return fmt.Errorf("entity.service.SetLintSettings: %w", err)
+	}
+
+	if err := s.core.SetLintSettings(ctx, id, req); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.SetLintSettings: SetLintSettings")
+		return fmt.Errorf("entity.service.SetLintSettings: %w", err)
 	}
 
-	oldEntity, err := s.core.GetListItem(ctx, cmd.ID)
+	return nil
+}
+
+func (s *service) Lock(ctx context.Context, id uuid.UUID) (entity.Lock, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Lock: checkEntityPermission")
+		return entity.Lock{}, fmt.Errorf("entity.service.Lock: %w", err)
+	}
+	userID, err := contextx.GetUserID(ctx)
 	if err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Update: GetListItem")
-		return fmt.Errorf("entity.service.Update: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Lock: GetUserID")
+		return entity.Lock{}, fmt.Errorf("entity.service.Lock: %w", err)
 	}
-	parentChanged := !equalUUIDPtr(oldEntity.ParentID, cmd.ParentID)
-	if parentChanged {
-		if err = permissions.CheckParentIDs([]*uuid.UUID{cmd.ParentID, oldEntity.ParentID}); err != nil {
-			logger.Error(ctx, err).
-				Interface(apperr.FieldRequest.String(), cmd).
-				Msg("entity.service.Update: checkParentIDs")
-			return fmt.Errorf("entity.service.Update: %w", err)
-		}
+
+	lock, err := s.core.Lock(ctx, id, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Lock: Lock")
+		return entity.Lock{}, fmt.Errorf("entity.service.Lock: %w", err)
 	}
 
+	return lock, nil
+}
+
+// Unlock releases the lock of the current user. Admins can release any lock.
+func (s *service) Unlock(ctx context.Context, id uuid.UUID) error {
+	permissions, err := s.perm.GetEffectivePermissions(ctx, auth.RoleWrite)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Unlock: getEffectivePermissions")
+		return fmt.Errorf("entity.service.Unlock: %w", err)
+	}
+	if err = permissions.CheckID(id); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Unlock: checkID")
+		return fmt.Errorf("entity.service.Unlock: %w", err)
+	}
 	userID, err := contextx.GetUserID(ctx)
 	if err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), cmd).
-			Msg("entity.service.Update: GetUserID")
-		return fmt.Errorf("entity.service.Update: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Unlock: GetUserID")
+		return fmt.Errorf("entity.service.Unlock: %w", err)
 	}
 
-	req := entity.UpdateEntityReq{
-		ID:            cmd.ID,
-		Name:          cmd.Name,
-		Content:       cmd.Content,
-		ParentID:      cmd.ParentID,
-		IsDraft:       cmd.IsDraft,
-		UserID:        userID,
-		ParentChanged: parentChanged,
-		EntityType:    oldEntity.Type,
+	if err = s.core.Unlock(ctx, id, userID, permissions.IsAdmin); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Unlock: Unlock")
+		return fmt.Errorf("entity.service.Unlock: %w", err)
 	}
 
-	if err = s.core.Update(ctx, req); err != nil {
+	return nil
+}
+
+func (s *service) GetLock(ctx context.Context, id uuid.UUID) (entity.Lock, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleRead); err != nil {
 		logger.Error(ctx, err).
-			Interface(apperr.FieldRequest.String(), req).
-			Msg("entity.service.Update: Update")
-		return fmt.Errorf("entity.service.Update: %w", err)
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetLock: checkEntityPermission")
+		return entity.Lock{}, fmt.Errorf("entity.service.GetLock: %w", err)
 	}
 
-	return nil
+	lock, err := s.core.GetLock(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.GetLock: GetLock")
+		return entity.Lock{}, fmt.Errorf("entity.service.GetLock: %w", err)
+	}
+
+	return lock, nil
 }
 
-func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
-	err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite)
+// Autosave stores content as the current user's autosave of the entity. Requires write permission.
+func (s *service) Autosave(ctx context.Context, id uuid.UUID, content string) (entity.Autosave, error) {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.Autosave: checkEntityPermission")
+		return entity.Autosave{}, fmt.Errorf("entity.service.Autosave: %w", err)
+	}
+	userID, err := contextx.GetUserID(ctx)
 	if err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.Delete: checkEntityPermission")
-		return fmt.Errorf("entity.service.Delete: %w", err)
+			Msg("entity.service.Autosave: GetUserID")
+		return entity.Autosave{}, fmt.Errorf("entity.service.Autosave: %w", err)
 	}
-	err = s.core.Delete(ctx, id)
+
+	autosave, err := s.core.Autosave(ctx, id, userID, content)
 	if err != nil {
 		logger.Error(ctx, err).
 			Str(entity.FieldEntityID.String(), id.String()).
-			Msg("entity.service.Delete: Delete")
-		return fmt.Errorf("entity.service.Delete: %w", err)
+			Msg("entity.service.Autosave: Autosave")
+		return entity.Autosave{}, fmt.Errorf("entity.service.Autosave: %w", err)
+	}
+
+	return autosave, nil
+}
+
+// DiscardAutosave removes the current user's autosave of the entity. Requires write permission.
+func (s *service) DiscardAutosave(ctx context.Context, id uuid.UUID) error {
+	if err := s.perm.CheckEntityPermission(ctx, id, auth.RoleWrite); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.DiscardAutosave: checkEntityPermission")
+		return fmt.Errorf("entity.service.DiscardAutosave: %w", err)
+	}
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.DiscardAutosave: GetUserID")
+		return fmt.Errorf("entity.service.DiscardAutosave: %w", err)
+	}
+
+	if err = s.core.DiscardAutosave(ctx, id, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(entity.FieldEntityID.String(), id.String()).
+			Msg("entity.service.DiscardAutosave: DiscardAutosave")
+		return fmt.Errorf("entity.service.DiscardAutosave: %w", err)
 	}
 
 	return nil
@@ -346,6 +2077,10 @@ func (ep *EffectivePermissions) CheckParentIDs(parentIDs []*uuid.UUID) error {
 	return nil
 }
 
+func findListItem(items []entity.ListItem, id uuid.UUID) (entity.ListItem, bool) {
+	return lo.Find(items, func(item entity.ListItem) bool { return item.ID == id })
+}
+
 func equalUUIDPtr(a, b *uuid.UUID) bool {
 	switch {
 	case a == nil && b == nil:
@@ -3,15 +3,19 @@ package usecase_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/66gu1/easygodocs/internal/app/attachment"
 	"github.com/66gu1/easygodocs/internal/app/auth"
 	"github.com/66gu1/easygodocs/internal/app/entity"
 	"github.com/66gu1/easygodocs/internal/app/entity/usecase"
 	"github.com/66gu1/easygodocs/internal/app/entity/usecase/mocks"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/lint"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
@@ -19,15 +23,29 @@ import (
 //go:generate minimock -o ./mocks -s _mock.go
 
 type serviceMocks struct {
-	core *mocks.CoreMock
-	perm *mocks.PermissionCheckerMock
+	core        *mocks.CoreMock
+	perm        *mocks.PermissionCheckerMock
+	sanitizer   *mocks.SanitizerMock
+	granter     *mocks.GranterMock
+	sender      *mocks.SenderMock
+	tx          *mocks.TxManagerMock
+	attachments *mocks.AttachmentsMock
 }
 
 func newServiceMocks(t *testing.T) serviceMocks {
 	t.Helper()
+	tx := mocks.NewTxManagerMock(t)
+	tx.DoMock.Optional().Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
 	return serviceMocks{
-		core: mocks.NewCoreMock(t),
-		perm: mocks.NewPermissionCheckerMock(t),
+		core:        mocks.NewCoreMock(t),
+		perm:        mocks.NewPermissionCheckerMock(t),
+		sanitizer:   mocks.NewSanitizerMock(t),
+		granter:     mocks.NewGranterMock(t),
+		sender:      mocks.NewSenderMock(t),
+		tx:          tx,
+		attachments: mocks.NewAttachmentsMock(t),
 	}
 }
 
@@ -77,7 +95,7 @@ func TestService_GetTree(t *testing.T) {
 				tt.setup(m)
 			}
 
-			s := usecase.NewService(m.core, m.perm)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
 			_, err := s.GetTree(ctx)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -146,7 +164,7 @@ func TestService_Get(t *testing.T) {
 				tt.setup(m)
 			}
 
-			s := usecase.NewService(m.core, m.perm)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
 			got, err := s.Get(ctx, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -158,25 +176,285 @@ func TestService_Get(t *testing.T) {
 	}
 }
 
-func TestService_GetVersion(t *testing.T) {
+func TestService_GetRendered(t *testing.T) {
 	t.Parallel()
+
 	var (
-		ctx     = t.Context()
-		id      = uuid.New()
-		version = 1
-		want    = entity.Entity{
-			ID:             id,
-			Type:           "type",
-			Name:           "name",
-			Content:        "content",
-			CreatedBy:      uuid.New(),
-			UpdatedBy:      uuid.New(),
-			CurrentVersion: &[]int{1}[0],
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-		}
+		ctx         = t.Context()
+		id          = uuid.New()
+		snippetID   = uuid.New()
+		permissions = usecase.EffectivePermissions{IDs: []uuid.UUID{id, snippetID}}
+		expErr      = fmt.Errorf("exp")
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+		m.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).Return(permissions, nil)
+		m.core.GetMock.Expect(ctx, id).Return(entity.Entity{ID: id, Content: "{{include " + snippetID.String() + "}}"}, nil)
+		m.core.ResolveIncludesMock.Expect(ctx, map[uuid.UUID]string{id: "{{include " + snippetID.String() + "}}"}, permissions.IDs, false).
+			Return(map[uuid.UUID]string{id: "*{{product}}*"}, nil)
+		m.core.ExpandVariablesMock.Expect(ctx, map[uuid.UUID]string{id: "*{{product}}*"}).
+			Return(map[uuid.UUID]string{id: "*<i onclick=x>Docs</i>*"}, nil)
+		m.sanitizer.SanitizeHTMLMock.Expect("<p><em><i onclick=x>Docs</i></em></p>\n").Return("<p><em><i>Docs</i></em></p>\n")
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).GetRendered(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, entity.Entity{ID: id, Content: "<p><em><i>Docs</i></em></p>\n"}, got)
+	})
+	t.Run("include error", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+		m.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).Return(permissions, nil)
+		m.core.GetMock.Expect(ctx, id).Return(entity.Entity{ID: id}, nil)
+		m.core.ResolveIncludesMock.Return(nil, entity.ErrIncludesTooLarge(entity.MaxRenderedContentLength))
+
+		_, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).GetRendered(ctx, id)
+		require.ErrorIs(t, err, entity.ErrIncludesTooLarge(entity.MaxRenderedContentLength))
+	})
+	t.Run("expand error", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+		m.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).Return(permissions, nil)
+		m.core.GetMock.Expect(ctx, id).Return(entity.Entity{ID: id}, nil)
+		m.core.ResolveIncludesMock.Return(map[uuid.UUID]string{id: ""}, nil)
+		m.core.ExpandVariablesMock.Return(nil, expErr)
+
+		_, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).GetRendered(ctx, id)
+		require.ErrorIs(t, err, expErr)
+	})
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(apperr.ErrForbidden())
+
+		_, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).GetRendered(ctx, id)
+		require.ErrorIs(t, err, apperr.ErrForbidden())
+	})
+}
+
+func TestService_Get_RecordsView(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id        = uuid.New()
+		userID    = uuid.New()
+		sessionID = uuid.New()
+		ctx       = contextx.SetSessionID(contextx.SetUserID(t.Context(), userID), sessionID)
+		want      = entity.Entity{ID: id, Name: "name"}
+	)
+
+	t.Run("recorded once per session by the repository", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+		m.core.GetMock.Expect(ctx, id).Return(want, nil)
+		m.core.GetAutosaveMock.Return(entity.Autosave{}, entity.ErrAutosaveNotFound())
+		m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+		m.core.GetRelatedMock.Return(nil, nil)
+		m.core.GetVariantsMock.Return(nil, nil)
+		m.core.RecordViewMock.Expect(ctx, id, userID, sessionID).Return(nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+	t.Run("a failed record does not fail the read", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+		m.core.GetMock.Expect(ctx, id).Return(want, nil)
+		m.core.GetAutosaveMock.Return(entity.Autosave{}, entity.ErrAutosaveNotFound())
+		m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+		m.core.GetRelatedMock.Return(nil, nil)
+		m.core.GetVariantsMock.Return(nil, nil)
+		m.core.RecordViewMock.Return(fmt.Errorf("exp"))
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+}
+
+func TestService_Get_Autosave(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id       = uuid.New()
+		userID   = uuid.New()
+		ctx      = contextx.SetUserID(t.Context(), userID)
+		ent      = entity.Entity{ID: id, Name: "name"}
+		autosave = entity.Autosave{Content: "unsaved", SavedAt: time.Now()}
+	)
+
+	t.Run("returned with the entity", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Return(nil)
+		m.core.GetMock.Return(ent, nil)
+		m.core.GetAutosaveMock.Expect(ctx, id, userID).Return(autosave, nil)
+		m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+		m.core.GetRelatedMock.Return(nil, nil)
+		m.core.GetVariantsMock.Return(nil, nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		want := ent
+		want.Autosave = &autosave
+		require.Equal(t, want, got)
+	})
+	t.Run("a failed lookup does not fail the read", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Return(nil)
+		m.core.GetMock.Return(ent, nil)
+		m.core.GetAutosaveMock.Return(entity.Autosave{}, fmt.Errorf("exp"))
+		m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+		m.core.GetRelatedMock.Return(nil, nil)
+		m.core.GetVariantsMock.Return(nil, nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, ent, got)
+	})
+}
+
+func TestService_Get_Related(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id       = uuid.New()
+		userID   = uuid.New()
+		ctx      = contextx.SetUserID(t.Context(), userID)
+		ent      = entity.Entity{ID: id, Name: "name"}
+		readable = entity.ListItem{ID: uuid.New(), Name: "readable"}
+		hidden   = entity.ListItem{ID: uuid.New(), Name: "hidden"}
+	)
+
+	t.Run("only readable pages are returned", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Return(nil)
+		m.core.GetMock.Return(ent, nil)
+		m.core.GetAutosaveMock.Return(entity.Autosave{}, entity.ErrAutosaveNotFound())
+		m.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+			Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, readable.ID}}, nil)
+		m.core.GetRelatedMock.Expect(ctx, id, false).Return([]entity.ListItem{readable, hidden}, nil)
+		m.core.GetVariantsMock.Return(nil, nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		want := ent
+		want.Related = []entity.ListItem{readable}
+		require.Equal(t, want, got)
+	})
+	t.Run("a failed lookup does not fail the read", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Return(nil)
+		m.core.GetMock.Return(ent, nil)
+		m.core.GetAutosaveMock.Return(entity.Autosave{}, entity.ErrAutosaveNotFound())
+		m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+		m.core.GetRelatedMock.Return(nil, fmt.Errorf("exp"))
+		m.core.GetVariantsMock.Return(nil, nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, ent, got)
+	})
+	t.Run("none outside a session", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		m.perm.CheckEntityPermissionMock.Return(nil)
+		m.core.GetMock.Return(ent, nil)
+
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Get(t.Context(), id)
+		require.NoError(t, err)
+		require.Equal(t, ent, got)
+	})
+}
+
+func TestService_Autosave(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id       = uuid.New()
+		userID   = uuid.New()
+		ctx      = contextx.SetUserID(t.Context(), userID)
+		autosave = entity.Autosave{Content: "unsaved", SavedAt: time.Now()}
+		expErr   = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.AutosaveMock.Expect(ctx, id, userID, autosave.Content).Return(autosave, nil)
+			},
+		},
+		{
+			name: "permission error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "no user",
+			ctx:  t.Context(),
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+			},
+			err: apperr.ErrUnauthorized(),
+		},
+		{
+			name: "core error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+				mock.core.AutosaveMock.Return(entity.Autosave{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Autosave(tt.ctx, id, autosave.Content)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, autosave, got)
+		})
+	}
+}
+
+func TestService_GetBySlug(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		want   = entity.Entity{ID: id, Type: entity.TypeDepartment, Name: "Intro", Slug: "intro"}
 		expErr = fmt.Errorf("exp")
 	)
+
 	tests := []struct {
 		name  string
 		setup func(mock serviceMocks)
@@ -185,21 +463,22 @@ func TestService_GetVersion(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(mock serviceMocks) {
+				mock.core.GetIDBySlugMock.Expect(ctx, "intro").Return(id, nil)
 				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
-				mock.core.GetVersionMock.Expect(ctx, id, version).Return(want, nil)
+				mock.core.GetMock.Expect(ctx, id).Return(want, nil)
 			},
 		},
 		{
-			name: "core.GetVersion error",
+			name: "core.GetIDBySlug error",
 			setup: func(mock serviceMocks) {
-				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
-				mock.core.GetVersionMock.Expect(ctx, id, version).Return(entity.Entity{}, expErr)
+				mock.core.GetIDBySlugMock.Expect(ctx, "intro").Return(uuid.Nil, expErr)
 			},
 			err: expErr,
 		},
 		{
 			name: "perm.CheckEntityPermissionMock error",
 			setup: func(mock serviceMocks) {
+				mock.core.GetIDBySlugMock.Expect(ctx, "intro").Return(id, nil)
 				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(expErr)
 			},
 			err: expErr,
@@ -213,8 +492,8 @@ func TestService_GetVersion(t *testing.T) {
 				tt.setup(m)
 			}
 
-			s := usecase.NewService(m.core, m.perm)
-			got, err := s.GetVersion(ctx, id, version)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetBySlug(ctx, "intro")
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 			} else {
@@ -225,37 +504,124 @@ func TestService_GetVersion(t *testing.T) {
 	}
 }
 
-func TestService_GetVersionsList(t *testing.T) {
+func TestService_GetByPath(t *testing.T) {
 	t.Parallel()
+
 	var (
-		ctx  = t.Context()
-		id   = uuid.New()
-		want = []entity.Entity{
-			{
-				ID:             id,
-				Type:           "type",
-				Name:           "name",
-				Content:        "content",
-				CreatedBy:      uuid.New(),
-				UpdatedBy:      uuid.New(),
-				CurrentVersion: &[]int{1}[0],
-				CreatedAt:      time.Now(),
-				UpdatedAt:      time.Now(),
+		ctx     = t.Context()
+		id      = uuid.New()
+		path    = []string{"guides", "install"}
+		current = entity.PathResolution{ID: id, Path: path}
+		moved   = entity.PathResolution{ID: id, Path: path, Moved: true}
+		ent     = entity.Entity{ID: id, Type: entity.TypeArticle, Name: "Install", Slug: "install"}
+		related = entity.ListItem{ID: uuid.New(), Name: "Upgrade"}
+		// the second variant is not readable
+		variants = []entity.Variant{{EntityID: id, Language: "en"}, {EntityID: uuid.New(), Language: "ru"}}
+		expErr   = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name    string
+		setup   func(mock serviceMocks)
+		want    entity.Entity
+		wantRes entity.PathResolution
+		err     error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, related.ID}}, nil)
+				mock.core.ResolvePathMock.Expect(ctx, path, false).Return(current, nil)
+				mock.core.GetMock.Expect(ctx, id).Return(ent, nil)
+				mock.core.GetRelatedMock.Expect(ctx, id, false).
+					Return([]entity.ListItem{related, {ID: uuid.New(), Name: "Hidden"}}, nil)
+				mock.core.GetVariantsMock.Expect(ctx, id, false).Return(variants, nil)
 			},
-			{
-				ID:             id,
-				Type:           "type",
-				Name:           "name",
-				Content:        "content",
-				CreatedBy:      uuid.New(),
-				UpdatedBy:      uuid.New(),
-				CurrentVersion: &[]int{2}[0],
-				CreatedAt:      time.Now(),
-				UpdatedAt:      time.Now(),
+			want: func() entity.Entity {
+				want := ent
+				want.Related = []entity.ListItem{related}
+				want.Language = "en"
+				want.Variants = variants[:1]
+				return want
+			}(),
+			wantRes: current,
+		},
+		{
+			name: "ok, moved: entity not read",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ResolvePathMock.Expect(ctx, path, true).Return(moved, nil)
 			},
-		}
+			wantRes: moved,
+		},
+		{
+			name: "not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{uuid.New()}}, nil)
+				mock.core.ResolvePathMock.Expect(ctx, path, false).Return(moved, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.ResolvePath error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ResolvePathMock.Expect(ctx, path, true).Return(entity.PathResolution{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.Get error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ResolvePathMock.Expect(ctx, path, true).Return(current, nil)
+				mock.core.GetMock.Expect(ctx, id).Return(entity.Entity{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, res, err := s.GetByPath(ctx, path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantRes, res)
+		})
+	}
+}
+
+func TestService_GetMeta(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		want   = entity.Meta{ContentStats: entity.ContentStats{WordCount: 10, ReadingTimeMinutes: 1}, VersionCount: 3}
 		expErr = fmt.Errorf("exp")
 	)
+
 	tests := []struct {
 		name  string
 		setup func(mock serviceMocks)
@@ -265,14 +631,14 @@ func TestService_GetVersionsList(t *testing.T) {
 			name: "ok",
 			setup: func(mock serviceMocks) {
 				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
-				mock.core.GetVersionsListMock.Expect(ctx, id).Return(want, nil)
+				mock.core.GetMetaMock.Expect(ctx, id).Return(want, nil)
 			},
 		},
 		{
-			name: "core.GetVersionsList error",
+			name: "core.GetMeta error",
 			setup: func(mock serviceMocks) {
 				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
-				mock.core.GetVersionsListMock.Expect(ctx, id).Return(nil, expErr)
+				mock.core.GetMetaMock.Expect(ctx, id).Return(entity.Meta{}, expErr)
 			},
 			err: expErr,
 		},
@@ -288,12 +654,10 @@ func TestService_GetVersionsList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			m := newServiceMocks(t)
-			if tt.setup != nil {
-				tt.setup(m)
-			}
+			tt.setup(m)
 
-			s := usecase.NewService(m.core, m.perm)
-			got, err := s.GetVersionsList(ctx, id)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetMeta(ctx, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 			} else {
@@ -304,288 +668,3193 @@ func TestService_GetVersionsList(t *testing.T) {
 	}
 }
 
-func TestService_Create(t *testing.T) {
+func TestService_GetContributors(t *testing.T) {
 	t.Parallel()
+
 	var (
-		ctx      = t.Context()
-		parentID = uuid.New()
-		cmd      = usecase.CreateEntityCmd{
-			Type:     "type",
-			Name:     "name",
-			Content:  "content",
-			ParentID: &parentID,
-			IsDraft:  true,
-		}
-		userID = uuid.New()
-		req    = entity.CreateEntityReq{
-			Type:     cmd.Type,
-			Name:     cmd.Name,
-			Content:  cmd.Content,
-			ParentID: cmd.ParentID,
-			IsDraft:  cmd.IsDraft,
-			UserID:   userID,
-		}
-		permissions = usecase.EffectivePermissions{
-			IsAdmin: false,
-			IDs:     []uuid.UUID{parentID},
-		}
+		ctx    = t.Context()
+		id     = uuid.New()
+		want   = []entity.Contributor{{UserID: uuid.New(), VersionCount: 1}}
 		expErr = fmt.Errorf("exp")
 	)
-	ctx = contextx.SetUserID(ctx, userID)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetContributorsMock.Expect(ctx, id).Return(want, nil)
+			},
+		},
+		{
+			name: "core.GetContributors error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetContributorsMock.Expect(ctx, id).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetContributors(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestService_GetBacklinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		readable  = entity.ListItem{ID: uuid.New(), Name: "readable"}
+		forbidden = entity.ListItem{ID: uuid.New(), Name: "forbidden"}
+		expErr    = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  []entity.ListItem
+		err   error
+	}{
+		{
+			name: "ok, unreadable sources filtered",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, readable.ID}}, nil)
+				mock.core.GetBacklinksMock.Expect(ctx, id, false).Return([]entity.ListItem{readable, forbidden}, nil)
+			},
+			want: []entity.ListItem{readable},
+		},
+		{
+			name: "ok, admin",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetBacklinksMock.Expect(ctx, id, true).Return([]entity.ListItem{readable, forbidden}, nil)
+			},
+			want: []entity.ListItem{readable, forbidden},
+		},
+		{
+			name: "target not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{readable.ID}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetBacklinksMock.Expect(ctx, id, true).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetBacklinks(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_GetChildren(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		children = []entity.ListItem{{ID: uuid.New(), Name: "child", ParentID: &id}}
+		expErr   = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  []entity.ListItem
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, children[0].ID}}, nil)
+				mock.core.GetChildrenMock.Expect(ctx, id, false).Return(children, nil)
+			},
+			want: children,
+		},
+		{
+			name: "parent not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{children[0].ID}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetChildrenMock.Expect(ctx, id, true).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetChildren(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_GetTOC(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		toc    = &entity.TOCNode{ID: id, Name: "manual"}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  *entity.TOCNode
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+				mock.core.GetTOCMock.Expect(ctx, id, false).Return(toc, nil)
+			},
+			want: toc,
+		},
+		{
+			name: "root not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{uuid.New()}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetTOCMock.Expect(ctx, id, true).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetTOC(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// manualSections keeps the sections a manual writer is given.
+type manualSections []entity.ManualSection
+
+func (*manualSections) WriteOutline([]entity.ManualChapter) error { return nil }
+
+func (m *manualSections) WriteSections(sections []entity.ManualSection) error {
+	*m = append(*m, sections...)
+	return nil
+}
+
+func TestService_Manual(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  manualSections
+		err   error
+	}{
+		{
+			name: "ok, content passed on as stored",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+				mock.core.ManualMock.Set(func(_ context.Context, rootID uuid.UUID, permittedIDs []uuid.UUID, isAdmin bool, w entity.ManualWriter) error {
+					require.Equal(t, id, rootID)
+					require.Equal(t, []uuid.UUID{id}, permittedIDs)
+					require.False(t, isAdmin)
+					return w.WriteSections([]entity.ManualSection{{ManualChapter: entity.ManualChapter{ID: id}, Content: "<b onclick=x>a</b>"}})
+				})
+			},
+			// the manual writer sanitizes the HTML it renders
+			want: manualSections{{ManualChapter: entity.ManualChapter{ID: id}, Content: "<b onclick=x>a</b>"}},
+		},
+		{
+			name: "root not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{uuid.New()}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ManualMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			var got manualSections
+			err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Manual(ctx, id, &got)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_GetPopular(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		ids    = []uuid.UUID{uuid.New()}
+		req    = entity.GetPopularReq{Period: time.Hour, Limit: 5}
+		report = entity.PopularReport{Entities: []entity.PopularEntity{{ListItem: entity.ListItem{ID: ids[0]}, Views: 2}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.GetPopularMock.Expect(ctx, req, nil, true).Return(report, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.GetPopularMock.Return(entity.PopularReport{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetPopular(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, report, got)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		ids    = []uuid.UUID{uuid.New()}
+		req    = entity.ListReq{Status: entity.ListStatusPublished, Limit: 5}
+		page   = entity.ListPage{Items: []entity.ListEntry{{ListItem: entity.ListItem{ID: ids[0]}}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok, readable entities",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: ids}, nil)
+				mock.core.ListMock.Expect(ctx, req, ids, false).Return(page, nil)
+			},
+		},
+		{
+			name: "ok, admin",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ListMock.Expect(ctx, req, nil, true).Return(page, nil)
+			},
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: ids}, nil)
+				mock.core.ListMock.Return(entity.ListPage{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.List(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, page, got)
+		})
+	}
+}
+
+func TestService_GetDrafts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		ids    = []uuid.UUID{uuid.New()}
+		req    = entity.DraftsReq{Limit: 5}
+		page   = entity.ListPage{Items: []entity.ListEntry{{ListItem: entity.ListItem{ID: ids[0]}, IsDraft: true}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: ids}, nil)
+				mock.core.GetDraftsMock.Expect(ctx, req, ids, false).Return(page, nil)
+			},
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetDraftsMock.Expect(ctx, req, nil, true).Return(entity.ListPage{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).GetDrafts(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, page, got)
+		})
+	}
+}
+
+func TestService_BatchGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		ids    = []uuid.UUID{uuid.New()}
+		req    = entity.BatchGetReq{IDs: ids}
+		result = entity.BatchGetResult{Items: []entity.BatchGetItem{{ID: ids[0], Item: &entity.ListItem{ID: ids[0]}}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok, one permission pass",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: ids}, nil)
+				mock.core.BatchGetMock.Expect(ctx, req, ids, false).Return(result, nil)
+			},
+		},
+		{
+			name: "ok, admin",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.BatchGetMock.Expect(ctx, req, nil, true).Return(result, nil)
+			},
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: ids}, nil)
+				mock.core.BatchGetMock.Return(entity.BatchGetResult{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.BatchGet(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, result, got)
+		})
+	}
+}
+
+func TestService_GetActivity(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		req      = entity.GetActivityReq{ID: id, Limit: 10}
+		activity = entity.Activity{Events: []entity.Event{{ID: 1, EntityID: id, Type: entity.EventCreated}}}
+		expErr   = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  entity.Activity
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+				mock.core.GetActivityMock.Expect(ctx, req, false).Return(activity, nil)
+			},
+			want: activity,
+		},
+		{
+			name: "ok, admin",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetActivityMock.Expect(ctx, req, true).Return(activity, nil)
+			},
+			want: activity,
+		},
+		{
+			name: "entity not readable",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{uuid.New()}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "permissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetActivityMock.Expect(ctx, req, true).Return(entity.Activity{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetActivity(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_Export(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		rootID = uuid.New()
+		write  = func([]entity.ExportItem) error { return nil }
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name   string
+		rootID *uuid.UUID
+		setup  func(mock serviceMocks)
+		err    error
+	}{
+		{
+			name:   "ok, subtree",
+			rootID: &rootID,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{rootID}}, nil)
+				mock.core.ExportMock.ExpectCtxParam1(ctx).ExpectRootIDParam2(&rootID).ExpectIsAdminParam3(false).Return(nil)
+			},
+		},
+		{
+			name: "ok, workspace",
+			setup: func(mock serviceMocks) {
+				mock.core.ExportMock.ExpectCtxParam1(ctx).ExpectRootIDParam2(nil).ExpectIsAdminParam3(true).Return(nil)
+			},
+		},
+		{
+			name:   "root not readable",
+			rootID: &rootID,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{uuid.New()}}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name:   "permissions error",
+			rootID: &rootID,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "core error",
+			rootID: &rootID,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.ExportMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.Export(ctx, tt.rootID, entity.RenderRaw, write)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_Export_Sanitizes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		rootID = uuid.New()
+		items  = []entity.ExportItem{{ID: rootID, Content: "<b onclick=x>a</b>"}}
+		got    []entity.ExportItem
+	)
+	m := newServiceMocks(t)
+	m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: []uuid.UUID{rootID}}, nil)
+	m.core.ExportMock.Set(func(_ context.Context, _ *uuid.UUID, _ bool, write func([]entity.ExportItem) error) error {
+		return write(items)
+	})
+	m.sanitizer.SanitizeMock.Expect("<b onclick=x>a</b>").Return("<b>a</b>", []string{"onclick="})
+
+	err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Export(ctx, &rootID, entity.RenderRaw, func(page []entity.ExportItem) error {
+		got = append(got, page...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []entity.ExportItem{{ID: rootID, Content: "<b>a</b>"}}, got)
+}
+
+func TestService_Export_Rendered(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		rootID = uuid.New()
+		items  = []entity.ExportItem{{ID: rootID, Content: "# {{product}}"}}
+		got    []entity.ExportItem
+	)
+	m := newServiceMocks(t)
+	m.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: []uuid.UUID{rootID}}, nil)
+	m.core.ExportMock.Set(func(_ context.Context, _ *uuid.UUID, _ bool, write func([]entity.ExportItem) error) error {
+		return write(items)
+	})
+	m.core.ResolveIncludesMock.Expect(ctx, map[uuid.UUID]string{rootID: "# {{product}}"}, []uuid.UUID{rootID}, false).
+		Return(map[uuid.UUID]string{rootID: "# {{product}}"}, nil)
+	m.core.ExpandVariablesMock.Expect(ctx, map[uuid.UUID]string{rootID: "# {{product}}"}).
+		Return(map[uuid.UUID]string{rootID: "# Docs <script>"}, nil)
+	m.sanitizer.SanitizeHTMLMock.Expect("<h1>Docs <script></h1>\n").Return("<h1>Docs</h1>\n")
+
+	err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).Export(ctx, &rootID, entity.RenderHTML, func(page []entity.ExportItem) error {
+		got = append(got, page...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []entity.ExportItem{{ID: rootID, Content: "<h1>Docs</h1>\n"}}, got)
+}
+
+func TestService_GetBrokenLinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		links  = []entity.BrokenLink{{SourceID: uuid.New(), SourceName: "doc", TargetID: uuid.New()}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.GetBrokenLinksMock.Expect(ctx).Return(links, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.GetBrokenLinksMock.Expect(ctx).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetBrokenLinks(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, links, got)
+		})
+	}
+}
+
+func TestService_GetUnsafeMarkup(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		items  = []entity.ExportItem{{ID: uuid.New(), Name: "a", Slug: "a", Content: "<script>x</script>"}, {ID: uuid.New(), Content: "b"}}
+		want   = []entity.UnsafeMarkup{{ID: items[0].ID, Name: "a", Slug: "a", Removed: []string{"<script>"}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  []entity.UnsafeMarkup
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.ExportMock.Set(func(_ context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) error {
+					require.Nil(t, rootID)
+					require.True(t, isAdmin)
+					return write(items)
+				})
+				mock.sanitizer.SanitizeMock.When(items[0].Content).Then("", []string{"<script>"})
+				mock.sanitizer.SanitizeMock.When(items[1].Content).Then("b", nil)
+			},
+			want: want,
+		},
+		{
+			name: "ok, empty",
+			setup: func(mock serviceMocks) {
+				mock.core.ExportMock.Return(nil)
+			},
+			want: []entity.UnsafeMarkup{},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.ExportMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetUnsafeMarkup(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_GetOrphanedEntities(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		orphaned = []entity.OrphanedEntity{{ID: uuid.New(), Name: "doc", OwnerID: uuid.New()}}
+		expErr   = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.GetOrphanedEntitiesMock.Expect(ctx).Return(orphaned, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.GetOrphanedEntitiesMock.Expect(ctx).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetOrphanedEntities(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, orphaned, got)
+		})
+	}
+}
+
+func TestService_GetDefaultPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = t.Context()
+		id          = uuid.New()
+		permissions = []entity.DefaultPermission{{UserID: uuid.New(), Role: auth.RoleWrite}}
+		expErr      = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.GetDefaultPermissionsMock.Expect(ctx, id).Return(permissions, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.GetDefaultPermissionsMock.Expect(ctx, id).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetDefaultPermissions(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, permissions, got)
+		})
+	}
+}
+
+func TestService_SetDefaultPermissions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		req    = entity.SetDefaultPermissionsReq{Permissions: []entity.DefaultPermission{{UserID: uuid.New(), Role: auth.RoleRead}}}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.SetDefaultPermissionsMock.Expect(ctx, id, req).Return(nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.SetDefaultPermissionsMock.Expect(ctx, id, req).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.SetDefaultPermissions(ctx, id, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_PreviewRetention(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		report = entity.RetentionReport{
+			Policy:   entity.RetentionConfig{KeepLastVersions: 2, IntervalMinutes: 60},
+			DryRun:   true,
+			Versions: []entity.VersionRef{{EntityID: uuid.New(), Version: 1}},
+		}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.PruneVersionsMock.Expect(ctx, true).Return(report, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.PruneVersionsMock.Expect(ctx, true).Return(entity.RetentionReport{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.PreviewRetention(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, report, got)
+		})
+	}
+}
+
+func TestService_PurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		report = entity.TrashReport{
+			RetentionDays:    30,
+			Entities:         []entity.PurgedEntity{{ID: uuid.New(), Name: "old", Bytes: 10}},
+			ReclaimableBytes: 10,
+		}
+		expErr = fmt.Errorf("exp")
+	)
+
+	tests := []struct {
+		name   string
+		dryRun bool
+		setup  func(mock serviceMocks)
+		err    error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.core.PurgeTrashMock.Expect(ctx, &userID, false).Return(report, nil)
+			},
+		},
+		{
+			name:   "ok, dry run",
+			dryRun: true,
+			setup: func(mock serviceMocks) {
+				mock.core.PurgeTrashMock.Expect(ctx, &userID, true).Return(report, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mock serviceMocks) {
+				mock.core.PurgeTrashMock.Expect(ctx, &userID, false).Return(entity.TrashReport{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.PurgeTrash(ctx, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, report, got)
+		})
+	}
+}
+
+func TestService_StaleDrafts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		policy  = entity.StaleDraftsConfig{NotifyDays: 30, CleanupDays: 45, Action: entity.StaleDraftArchive, IntervalMinutes: 60}
+		cleaned = []entity.StaleDraft{{ID: uuid.New(), Name: "old"}}
+		sent    = entity.DraftReminder{UserID: uuid.New(), Email: "a@example.com", Drafts: []entity.StaleDraft{{ID: uuid.New()}}}
+		quiet   = entity.DraftReminder{UserID: uuid.New(), Drafts: []entity.StaleDraft{{ID: uuid.New()}}}
+		failed  = entity.DraftReminder{UserID: uuid.New(), Email: "b@example.com", Drafts: []entity.StaleDraft{{ID: uuid.New()}}}
+		sentMsg = mail.Message{To: sent.Email, Subject: "s", Body: "a"}
+		failMsg = mail.Message{To: failed.Email, Subject: "s", Body: "b"}
+		expErr  = fmt.Errorf("exp")
+	)
+	report := func(dryRun bool) entity.StaleDraftsReport {
+		return entity.StaleDraftsReport{Policy: policy, DryRun: dryRun, Reminded: []entity.StaleDraft{}, CleanedUp: cleaned}
+	}
+	emails := func(mock serviceMocks) {
+		mock.core.DraftReminderEmailMock.Set(func(r entity.DraftReminder) (mail.Message, bool) {
+			switch r.UserID {
+			case sent.UserID:
+				return sentMsg, true
+			case failed.UserID:
+				return failMsg, true
+			}
+			return mail.Message{}, false
+		})
+	}
+	type staleDraftsService interface {
+		CleanupStaleDrafts(ctx context.Context) (entity.StaleDraftsReport, error)
+		PreviewStaleDrafts(ctx context.Context) (entity.StaleDraftsReport, error)
+	}
+
+	tests := []struct {
+		name    string
+		preview bool
+		setup   func(mock serviceMocks)
+		want    entity.StaleDraftsReport
+		err     error
+	}{
+		{
+			name: "cleanup, a failed email is retried next time",
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, false).Return(report(false), nil)
+				mock.core.GetDraftRemindersMock.Expect(ctx).Return([]entity.DraftReminder{sent, quiet, failed}, nil)
+				emails(mock)
+				mock.sender.SendMock.Set(func(_ context.Context, msg mail.Message) error {
+					if msg == failMsg {
+						return expErr
+					}
+					return nil
+				})
+				mock.core.MarkDraftsRemindedMock.Expect(ctx, []uuid.UUID{sent.Drafts[0].ID, quiet.Drafts[0].ID}).Return(nil)
+			},
+			want: entity.StaleDraftsReport{Policy: policy, Reminded: append(slices.Clone(sent.Drafts), quiet.Drafts...), CleanedUp: cleaned},
+		},
+		{
+			name: "cleanup, mail disabled",
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, false).Return(report(false), nil)
+				mock.core.GetDraftRemindersMock.Expect(ctx).Return([]entity.DraftReminder{sent}, nil)
+				emails(mock)
+				mock.sender.SendMock.Expect(ctx, sentMsg).Return(mail.ErrDisabled)
+				mock.core.MarkDraftsRemindedMock.Expect(ctx, []uuid.UUID{sent.Drafts[0].ID}).Return(nil)
+			},
+			want: entity.StaleDraftsReport{Policy: policy, Reminded: sent.Drafts, CleanedUp: cleaned},
+		},
+		{
+			name:    "preview sends and marks nothing",
+			preview: true,
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, true).Return(report(true), nil)
+				mock.core.GetDraftRemindersMock.Expect(ctx).Return([]entity.DraftReminder{sent, failed}, nil)
+				emails(mock)
+			},
+			want: entity.StaleDraftsReport{Policy: policy, DryRun: true, Reminded: append(slices.Clone(sent.Drafts), failed.Drafts...), CleanedUp: cleaned},
+		},
+		{
+			name: "cleanup error",
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, false).Return(entity.StaleDraftsReport{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "reminders error",
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, false).Return(report(false), nil)
+				mock.core.GetDraftRemindersMock.Expect(ctx).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "mark error",
+			setup: func(mock serviceMocks) {
+				mock.core.CleanupStaleDraftsMock.Expect(ctx, false).Return(report(false), nil)
+				mock.core.GetDraftRemindersMock.Expect(ctx).Return([]entity.DraftReminder{quiet}, nil)
+				emails(mock)
+				mock.core.MarkDraftsRemindedMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			var s staleDraftsService = usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			run := s.CleanupStaleDrafts
+			if tt.preview {
+				run = s.PreviewStaleDrafts
+			}
+			got, err := run(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+func TestService_GetVersion(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx     = t.Context()
+		id      = uuid.New()
+		version = 1
+		want    = entity.Entity{
+			ID:             id,
+			Type:           "type",
+			Name:           "name",
+			Content:        "content",
+			CreatedBy:      uuid.New(),
+			UpdatedBy:      uuid.New(),
+			CurrentVersion: &[]int{1}[0],
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+		expErr = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetVersionMock.Expect(ctx, id, version).Return(want, nil)
+			},
+		},
+		{
+			name: "core.GetVersion error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetVersionMock.Expect(ctx, id, version).Return(entity.Entity{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.CheckEntityPermissionMock error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetVersion(ctx, id, version)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestService_Merge(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		req    = entity.MergeReq{ID: uuid.New(), BaseVersion: 1, Mine: "mine"}
+		want   = entity.MergeResult{Content: "merged"}
+		expErr = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, req.ID, auth.RoleRead).Return(nil)
+				mock.core.MergeMock.Expect(ctx, req).Return(want, nil)
+			},
+		},
+		{
+			name: "core.Merge error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, req.ID, auth.RoleRead).Return(nil)
+				mock.core.MergeMock.Expect(ctx, req).Return(entity.MergeResult{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.CheckEntityPermissionMock error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, req.ID, auth.RoleRead).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.Merge(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestService_GetVersionsList(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = t.Context()
+		id   = uuid.New()
+		req  = entity.GetVersionsReq{ID: id, Limit: 10}
+		want = entity.VersionsPage{Versions: []entity.Entity{
+			{
+				ID:             id,
+				Type:           "type",
+				Name:           "name",
+				Content:        "content",
+				CreatedBy:      uuid.New(),
+				UpdatedBy:      uuid.New(),
+				CurrentVersion: &[]int{1}[0],
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			},
+			{
+				ID:             id,
+				Type:           "type",
+				Name:           "name",
+				Content:        "content",
+				CreatedBy:      uuid.New(),
+				UpdatedBy:      uuid.New(),
+				CurrentVersion: &[]int{2}[0],
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			},
+		}}
+		expErr = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetVersionsListMock.Expect(ctx, req).Return(want, nil)
+			},
+		},
+		{
+			name: "core.GetVersionsList error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetVersionsListMock.Expect(ctx, req).Return(entity.VersionsPage{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.CheckEntityPermissionMock error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetVersionsList(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestService_GetHistory(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = t.Context()
+		id   = uuid.New()
+		req  = entity.GetHistoryReq{ID: id, Limit: 10}
+		want = entity.History{Items: []entity.HistoryItem{
+			{
+				Type:      entity.HistoryPermission,
+				CreatedAt: time.Now(),
+				Event:     &entity.Event{ID: 2, EntityID: id, Type: entity.EventRoleGranted, Role: "read"},
+			},
+			{
+				Type:      entity.HistoryVersion,
+				CreatedAt: time.Now(),
+				Version:   &entity.Entity{ID: id, Name: "name", CurrentVersion: &[]int{1}[0]},
+			},
+		}}
+		expErr = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetHistoryMock.Expect(ctx, req).Return(want, nil)
+			},
+		},
+		{
+			name: "core.GetHistory error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetHistoryMock.Expect(ctx, req).Return(entity.History{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.CheckEntityPermissionMock error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetHistory(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		parentID = uuid.New()
+		cmd      = usecase.CreateEntityCmd{
+			Type:     "type",
+			Name:     "name",
+			Content:  "content",
+			ParentID: &parentID,
+			IsDraft:  true,
+		}
+		userID = uuid.New()
+		req    = entity.CreateEntityReq{
+			Type:     cmd.Type,
+			Name:     cmd.Name,
+			Content:  cmd.Content,
+			ParentID: cmd.ParentID,
+			IsDraft:  cmd.IsDraft,
+			UserID:   userID,
+		}
+		permissions = usecase.EffectivePermissions{
+			IsAdmin: false,
+			IDs:     []uuid.UUID{parentID},
+		}
+		id      = uuid.New()
+		pending = []entity.DefaultPermission{{UserID: uuid.New(), Role: auth.RoleRead}}
+		expErr  = fmt.Errorf("exp")
+	)
+	ctx = contextx.SetUserID(ctx, userID)
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.CreateMock.Expect(ctx, req).Return(id, entity.ContentUsage{}, nil)
+				mock.core.GetPendingDefaultPermissionsMock.Expect(ctx, id).Return(pending, nil)
+				mock.granter.AddUserRoleMock.Expect(ctx, auth.UserRole{UserID: pending[0].UserID, Role: auth.RoleRead, EntityID: &id}).Return(nil)
+			},
+		},
+		{
+			name: "default permissions errors are only logged",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.CreateMock.Expect(ctx, req).Return(id, entity.ContentUsage{}, nil)
+				mock.core.GetPendingDefaultPermissionsMock.Expect(ctx, id).Return(pending, nil)
+				mock.granter.AddUserRoleMock.Return(expErr)
+			},
+		},
+		{
+			name: "core.GetPendingDefaultPermissions error is only logged",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.CreateMock.Expect(ctx, req).Return(id, entity.ContentUsage{}, nil)
+				mock.core.GetPendingDefaultPermissionsMock.Expect(ctx, id).Return(nil, expErr)
+			},
+		},
+		{
+			name: "core.Create error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.CreateMock.Expect(ctx, req).Return(uuid.Nil, entity.ContentUsage{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "no user id in context",
+			ctx:  t.Context(),
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(t.Context(), auth.RoleWrite).Return(permissions, nil)
+			},
+			err: apperr.ErrUnauthorized(),
+		},
+		{
+			name: "no write permission",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
+					IsAdmin: false,
+					IDs:     []uuid.UUID{},
+				}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "perm.GetEffectivePermissions error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			_, _, err := s.Create(tt.ctx, cmd)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx         = t.Context()
+		id          = uuid.New()
+		parentID    = uuid.New()
+		oldParentID = uuid.New()
+
+		cmd = usecase.UpdateEntityCmd{
+			ID:        id,
+			Name:      "name",
+			Content:   "content",
+			IsDraft:   true,
+			ParentID:  &parentID,
+			Summary:   "Move under parent",
+			MinorEdit: true,
+		}
+		userID   = uuid.New()
+		listItem = entity.ListItem{
+			ID:       id,
+			Type:     "type",
+			Name:     "name",
+			ParentID: &oldParentID,
+		}
+		lookup = []uuid.UUID{id, parentID}
+		items  = []entity.ListItem{listItem, {ID: parentID, Type: "type", Name: "parent"}}
+		req    = entity.UpdateEntityReq{
+			ID:            cmd.ID,
+			Name:          cmd.Name,
+			Content:       cmd.Content,
+			ParentID:      &parentID,
+			IsDraft:       cmd.IsDraft,
+			UserID:        userID,
+			ParentChanged: true,
+			EntityType:    listItem.Type,
+			Summary:       cmd.Summary,
+			MinorEdit:     cmd.MinorEdit,
+		}
+		permissions = usecase.EffectivePermissions{
+			IsAdmin: false,
+			IDs:     []uuid.UUID{parentID, id, oldParentID},
+		}
+
+		expErr = fmt.Errorf("exp")
+	)
+	ctx = contextx.SetUserID(ctx, userID)
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items, nil)
+				mock.core.PlanSpaceMoveMock.Expect(ctx, id, &parentID, entity.GrantPolicy("")).Return(nil, nil)
+				mock.core.UpdateMock.Expect(ctx, req).Return(entity.ContentUsage{}, nil)
+			},
+		},
+		{
+			name: "core.Update error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items, nil)
+				mock.core.PlanSpaceMoveMock.Expect(ctx, id, &parentID, entity.GrantPolicy("")).Return(nil, nil)
+				mock.core.UpdateMock.Expect(ctx, req).Return(entity.ContentUsage{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "no user id in context",
+			ctx:  t.Context(),
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(t.Context(), auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(t.Context(), lookup).Return(items, nil)
+				mock.core.PlanSpaceMoveMock.Expect(t.Context(), id, &parentID, entity.GrantPolicy("")).Return(nil, nil)
+			},
+			err: apperr.ErrUnauthorized(),
+		},
+		{
+			name: "no parent permission",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
+					IsAdmin: false,
+					IDs:     []uuid.UUID{id, oldParentID},
+				}, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "no entity permission",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
+					IsAdmin: false,
+					IDs:     []uuid.UUID{parentID, oldParentID},
+				}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "entity not found",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items[1:], nil)
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name: "parent not found",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items[:1], nil)
+			},
+			err: entity.ErrParentNotFound(),
+		},
+		{
+			name: "core.GetListItems error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "space move not confirmed",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items, nil)
+				mock.core.PlanSpaceMoveMock.Return(&entity.SpaceMove{FromSpaceID: oldParentID, ToSpaceID: parentID}, nil)
+			},
+			err: entity.ErrSpaceMoveNotConfirmed(oldParentID, parentID),
+		},
+		{
+			name: "core.PlanSpaceMove error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
+				mock.core.GetListItemsMock.Expect(ctx, lookup).Return(items, nil)
+				mock.core.PlanSpaceMoveMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "perm.GetEffectivePermissions error",
+			ctx:  ctx,
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			_, err := s.Update(tt.ctx, cmd)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_Update_SpaceMove(t *testing.T) {
+	t.Parallel()
+	var (
+		userID      = uuid.New()
+		ctx         = contextx.SetUserID(t.Context(), userID)
+		id          = uuid.New()
+		parentID    = uuid.New()
+		oldParentID = uuid.New()
+		readerID    = uuid.New()
+		cmd         = usecase.UpdateEntityCmd{ID: id, Name: "name", ParentID: &parentID, ConfirmCrossSpace: true}
+		items       = []entity.ListItem{{ID: id, ParentID: &oldParentID}, {ID: parentID}}
+		permissions = usecase.EffectivePermissions{IDs: []uuid.UUID{parentID, id, oldParentID}}
+		stale       = entity.Grant{UserID: readerID, Role: auth.RoleRead, EntityID: id}
+		other       = entity.Grant{UserID: uuid.New(), Role: auth.RoleWrite, EntityID: uuid.New()}
+		kept        = entity.Grant{UserID: uuid.New(), Role: auth.RoleRead, EntityID: uuid.New()}
+		errExp      = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name   string
+		policy entity.GrantPolicy
+		setup  func(mock serviceMocks)
+		want   entity.SpaceMove
+		err    error
+	}{
+		{
+			name:   "keep",
+			policy: entity.GrantsKeep,
+			want:   entity.SpaceMove{FromSpaceID: oldParentID, ToSpaceID: parentID, Grants: entity.GrantsKeep, Revoked: []entity.Grant{}, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{
+			name:   "strip",
+			policy: entity.GrantsStrip,
+			setup: func(mock serviceMocks) {
+				mock.granter.DeleteUserRoleMock.When(ctx, auth.UserRole{UserID: stale.UserID, Role: stale.Role, EntityID: &stale.EntityID}).Then(nil)
+				mock.granter.DeleteUserRoleMock.When(ctx, auth.UserRole{UserID: other.UserID, Role: other.Role, EntityID: &other.EntityID}).Then(nil)
+			},
+			want: entity.SpaceMove{FromSpaceID: oldParentID, ToSpaceID: parentID, Grants: entity.GrantsStrip, Revoked: []entity.Grant{stale, other}, Kept: []entity.Grant{}, Granted: []entity.Grant{}},
+		},
+		{
+			name:   "strip, revoke fails",
+			policy: entity.GrantsStrip,
+			setup: func(mock serviceMocks) {
+				mock.granter.DeleteUserRoleMock.Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name:   "remap",
+			policy: entity.GrantsRemap,
+			setup: func(mock serviceMocks) {
+				mock.granter.DeleteUserRoleMock.Return(nil)
+				mock.core.GetPendingDefaultPermissionsMock.Expect(ctx, id).
+					Return([]entity.DefaultPermission{{UserID: readerID, Role: auth.RoleWrite}}, nil)
+				mock.granter.AddUserRoleMock.Expect(ctx, auth.UserRole{UserID: readerID, Role: auth.RoleWrite, EntityID: &id}).Return(nil)
+			},
+			want: entity.SpaceMove{FromSpaceID: oldParentID, ToSpaceID: parentID, Grants: entity.GrantsRemap, Revoked: []entity.Grant{stale, other},
+				Kept: []entity.Grant{kept}, Granted: []entity.Grant{{UserID: readerID, Role: auth.RoleWrite, EntityID: id}}},
+		},
+		{
+			name:   "remap, grant fails",
+			policy: entity.GrantsRemap,
+			setup: func(mock serviceMocks) {
+				mock.granter.DeleteUserRoleMock.Return(nil)
+				mock.core.GetPendingDefaultPermissionsMock.Return([]entity.DefaultPermission{{UserID: readerID, Role: auth.RoleWrite}}, nil)
+				mock.granter.AddUserRoleMock.Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name:   "remap, core.GetPendingDefaultPermissions error",
+			policy: entity.GrantsRemap,
+			setup: func(mock serviceMocks) {
+				mock.granter.DeleteUserRoleMock.Return(nil)
+				mock.core.GetPendingDefaultPermissionsMock.Return(nil, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			m.perm.GetEffectivePermissionsMock.Return(permissions, nil)
+			m.core.GetListItemsMock.Return(items, nil)
+			cmd := cmd
+			cmd.Grants = tt.policy
+			plan := &entity.SpaceMove{FromSpaceID: oldParentID, ToSpaceID: parentID, Grants: tt.policy, Revoked: []entity.Grant{}, Kept: []entity.Grant{}, Granted: []entity.Grant{}}
+			if tt.policy != entity.GrantsKeep {
+				plan.Revoked = []entity.Grant{stale, other}
+			}
+			if tt.policy == entity.GrantsRemap {
+				plan.Kept = []entity.Grant{kept}
+			}
+			m.core.PlanSpaceMoveMock.Expect(ctx, id, &parentID, tt.policy).Return(plan, nil)
+			m.core.UpdateMock.Return(entity.ContentUsage{}, nil)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			usage, err := s.Update(ctx, cmd)
+			if tt.err != nil {
+				// the error reaches the transaction, which rolls the move back
+				require.ErrorIs(t, err, tt.err)
+				require.Equal(t, uint64(1), m.tx.DoAfterCounter())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), m.tx.DoAfterCounter())
+			require.NotNil(t, usage.SpaceMove)
+			require.Equal(t, tt.want, *usage.SpaceMove)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		errExp = fmt.Errorf("exp")
+		report = entity.DeleteReport{DryRun: true, Entities: []entity.DeletedEntity{{ID: id, Name: "doc"}}, Count: 1}
+	)
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		dryRun bool
+		setup  func(mock serviceMocks)
+		want   entity.DeleteReport
+		err    error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.DeleteMock.Expect(ctx, id, userID, false).Return(entity.DeleteReport{Count: 1}, nil)
+			},
+			want: entity.DeleteReport{Count: 1},
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.DeleteMock.Expect(ctx, id, userID, true).Return(report, nil)
+			},
+			want: report,
+		},
+		{
+			name: "core.Delete error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.DeleteMock.Expect(ctx, id, userID, false).Return(entity.DeleteReport{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "no user in context",
+			ctx:  t.Context(),
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(t.Context(), id, auth.RoleWrite).Return(nil)
+			},
+			err: apperr.ErrUnauthorized(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			c := ctx
+			if tt.ctx != nil {
+				c = tt.ctx
+			}
+			got, err := s.Delete(c, id, tt.dryRun)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_TransferOwnership(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx     = t.Context()
+		id      = uuid.New()
+		ownerID = uuid.New()
+		errExp  = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.TransferOwnershipMock.Expect(ctx, id, ownerID).Return(nil)
+			},
+		},
+		{
+			name: "core.TransferOwnership error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.TransferOwnershipMock.Expect(ctx, id, ownerID).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.TransferOwnership(ctx, id, ownerID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_UpdateAppearance(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		icon     = "🚀"
+		key      = "imports/1/logo.png"
+		cover    = " imports/1/cover.png "
+		req      = entity.UpdateAppearanceReq{Icon: &icon}
+		keysReq  = entity.UpdateAppearanceReq{Icon: &key, Cover: &cover}
+		errExp   = fmt.Errorf("exp")
+		resolved = attachment.Attachment{Key: key}
+	)
+	tests := []struct {
+		name  string
+		req   entity.UpdateAppearanceReq
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  req,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.UpdateAppearanceMock.Expect(ctx, id, req).Return(nil)
+			},
+		},
+		{
+			name: "attachment keys resolved",
+			req:  keysReq,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.attachments.GetMock.When(ctx, key).Then(resolved, nil)
+				mock.attachments.GetMock.When(ctx, "imports/1/cover.png").Then(resolved, nil)
+				mock.core.UpdateAppearanceMock.Expect(ctx, id, keysReq).Return(nil)
+			},
+		},
+		{
+			name: "icon not stored in the workspace",
+			req:  keysReq,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.attachments.GetMock.Expect(ctx, key).Return(attachment.Attachment{}, attachment.ErrAttachmentNotFound())
+			},
+			err: entity.ErrAttachmentNotFound(entity.FieldIcon),
+		},
+		{
+			name: "cover not stored in the workspace",
+			req:  entity.UpdateAppearanceReq{Cover: &cover},
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.attachments.GetMock.Expect(ctx, "imports/1/cover.png").Return(attachment.Attachment{}, attachment.ErrAttachmentNotFound())
+			},
+			err: entity.ErrAttachmentNotFound(entity.FieldCover),
+		},
+		{
+			name: "attachments.Get error",
+			req:  keysReq,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.attachments.GetMock.Expect(ctx, key).Return(attachment.Attachment{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "core.UpdateAppearance error",
+			req:  req,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.UpdateAppearanceMock.Expect(ctx, id, req).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			req:  keysReq,
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.UpdateAppearance(ctx, id, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_CreateSnapshot(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		req      = entity.CreateSnapshotReq{Label: "v2.3"}
+		snapshot = entity.Snapshot{ID: uuid.New(), EntityID: id, Label: "v2.3", EntityCount: 2}
+		errExp   = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.CreateSnapshotMock.Expect(ctx, id, req).Return(snapshot, nil)
+			},
+		},
+		{
+			name: "core.CreateSnapshot error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.CreateSnapshotMock.Expect(ctx, id, req).Return(entity.Snapshot{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.CreateSnapshot(ctx, id, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, snapshot, got)
+			}
+		})
+	}
+}
+
+func TestService_GetSnapshot(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		snapshot = entity.SnapshotContent{Snapshot: entity.Snapshot{ID: uuid.New(), EntityID: id, Label: "v2.3"}}
+		errExp   = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetSnapshotMock.Expect(ctx, id, "v2.3").Return(snapshot, nil)
+			},
+		},
+		{
+			name: "core.GetSnapshot error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetSnapshotMock.Expect(ctx, id, "v2.3").Return(entity.SnapshotContent{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetSnapshot(ctx, id, "v2.3")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, snapshot, got)
+			}
+		})
+	}
+}
+
+type variableService interface {
+	GetVariables(ctx context.Context, id uuid.UUID) ([]entity.Variable, error)
+	SetVariable(ctx context.Context, id uuid.UUID, key string, req entity.SetVariableReq) (entity.Variable, error)
+	DeleteVariable(ctx context.Context, id uuid.UUID, key string) error
+}
+
+func TestService_Variables(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		v      = entity.Variable{EntityID: id, Key: "product", Value: "EasyGoDocs"}
+		req    = entity.SetVariableReq{Value: "EasyGoDocs"}
+		errExp = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		call  func(s variableService) error
+		err   error
+	}{
+		{
+			name: "get: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetVariablesMock.Expect(ctx, id).Return([]entity.Variable{v}, nil)
+			},
+			call: func(s variableService) error {
+				got, err := s.GetVariables(ctx, id)
+				require.Equal(t, []entity.Variable{v}, got)
+				return err
+			},
+		},
+		{
+			name: "get: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(apperr.ErrForbidden())
+			},
+			call: func(s variableService) error {
+				_, err := s.GetVariables(ctx, id)
+				return err
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "set: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.SetVariableMock.Expect(ctx, id, "product", req).Return(v, nil)
+			},
+			call: func(s variableService) error {
+				got, err := s.SetVariable(ctx, id, "product", req)
+				require.Equal(t, v, got)
+				return err
+			},
+		},
+		{
+			name: "set: core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.SetVariableMock.Expect(ctx, id, "product", req).Return(entity.Variable{}, errExp)
+			},
+			call: func(s variableService) error {
+				_, err := s.SetVariable(ctx, id, "product", req)
+				return err
+			},
+			err: errExp,
+		},
+		{
+			name: "delete: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.DeleteVariableMock.Expect(ctx, id, "product").Return(nil)
+			},
+			call: func(s variableService) error {
+				return s.DeleteVariable(ctx, id, "product")
+			},
+		},
+		{
+			name: "delete: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			call: func(s variableService) error {
+				return s.DeleteVariable(ctx, id, "product")
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			err := tt.call(usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ReorderChildren(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		parentID = uuid.New()
+		req      = entity.ChildrenOrderReq{IDs: []uuid.UUID{uuid.New(), uuid.New()}}
+		errExp   = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(nil)
+				mock.core.ReorderChildrenMock.Expect(ctx, parentID, req).Return(nil)
+			},
+		},
+		{
+			name: "core.ReorderChildren error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(nil)
+				mock.core.ReorderChildrenMock.Expect(ctx, parentID, req).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.ReorderChildren(ctx, parentID, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_SetPinned(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		parentID = uuid.New()
+		childID  = uuid.New()
+		errExp   = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(nil)
+				mock.core.SetPinnedMock.Expect(ctx, parentID, childID, true).Return(nil)
+			},
+		},
+		{
+			name: "core.SetPinned error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(nil)
+				mock.core.SetPinnedMock.Expect(ctx, parentID, childID, true).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, parentID, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.SetPinned(ctx, parentID, childID, true)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_GetSpace(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		space  = entity.Space{EntityID: id, OwnerID: uuid.New()}
+		errExp = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetSpaceMock.Expect(ctx, id).Return(space, nil)
+			},
+		},
+		{
+			name: "core.GetSpace error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetSpaceMock.Expect(ctx, id).Return(entity.Space{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.CheckEntityPermission error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetSpace(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, space, got)
+			}
+		})
+	}
+}
+
+func TestService_SetSpaceSettings(t *testing.T) {
+	t.Parallel()
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		req    = entity.SpaceSettings{Public: true}
+		errExp = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "admin",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return(nil, true, nil)
+				mock.core.SetSpaceSettingsMock.Expect(ctx, id, req).Return(req, nil)
+			},
+		},
+		{
+			name: "owner",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return(nil, false, nil)
+				mock.core.GetSpaceMock.Expect(ctx, id).Return(entity.Space{EntityID: id, OwnerID: userID}, nil)
+				mock.core.SetSpaceSettingsMock.Expect(ctx, id, req).Return(req, nil)
+			},
+		},
+		{
+			name: "neither admin nor owner",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return([]uuid.UUID{id}, false, nil)
+				mock.core.GetSpaceMock.Expect(ctx, id).Return(entity.Space{EntityID: id, OwnerID: uuid.New()}, nil)
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "core.GetSpace error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return(nil, false, nil)
+				mock.core.GetSpaceMock.Expect(ctx, id).Return(entity.Space{}, entity.ErrNotSpace())
+			},
+			err: entity.ErrNotSpace(),
+		},
+		{
+			name: "core.SetSpaceSettings error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return(nil, true, nil)
+				mock.core.SetSpaceSettingsMock.Expect(ctx, id, req).Return(entity.SpaceSettings{}, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "perm.GetDirectPermissions error",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetDirectPermissionsMock.Expect(ctx, auth.RoleRead).Return(nil, false, errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.SetSpaceSettings(ctx, id, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, req, got)
+			}
+		})
+	}
+}
+
+func TestService_AddRelation(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		relatedID = uuid.New()
+		errExp    = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, relatedID, auth.RoleWrite).Then(nil)
+				mock.core.AddRelationMock.Expect(ctx, id, relatedID).Return(nil)
+			},
+		},
+		{
+			name: "core.AddRelation error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+				mock.core.AddRelationMock.Expect(ctx, id, relatedID).Return(errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "no write permission for the related entity",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, relatedID, auth.RoleWrite).Then(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "no write permission for the entity",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.AddRelation(ctx, id, relatedID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_DeleteRelation(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		relatedID = uuid.New()
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, relatedID, auth.RoleWrite).Then(nil)
+				mock.core.DeleteRelationMock.Expect(ctx, id, relatedID).Return(nil)
+			},
+		},
+		{
+			name: "core.DeleteRelation error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+				mock.core.DeleteRelationMock.Expect(ctx, id, relatedID).Return(entity.ErrRelationNotFound())
+			},
+			err: entity.ErrRelationNotFound(),
+		},
+		{
+			name: "no write permission for the related entity",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, relatedID, auth.RoleWrite).Then(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.DeleteRelation(ctx, id, relatedID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type variantService interface {
+	GetVariants(ctx context.Context, id uuid.UUID) ([]entity.Variant, error)
+	SetLanguage(ctx context.Context, id uuid.UUID, req entity.SetLanguageReq) error
+	UnlinkVariant(ctx context.Context, id uuid.UUID) error
+	LinkVariant(ctx context.Context, id, variantID uuid.UUID, req entity.SetLanguageReq) error
+	CreateVariant(ctx context.Context, id uuid.UUID, cmd usecase.CreateVariantCmd) (uuid.UUID, entity.ContentUsage, error)
+	GetMissingTranslations(ctx context.Context) ([]entity.MissingTranslation, error)
+}
+
+func TestService_Variants(t *testing.T) {
+	t.Parallel()
+	var (
+		userID    = uuid.New()
+		ctx       = contextx.SetUserID(t.Context(), userID)
+		id        = uuid.New()
+		variantID = uuid.New()
+		parentID  = uuid.New()
+		hiddenID  = uuid.New()
+		variants  = []entity.Variant{{EntityID: id, Language: "en"}, {EntityID: hiddenID, Language: "de"}, {EntityID: variantID, Language: "ru"}}
+		req       = entity.SetLanguageReq{Language: "ru"}
+		cmd       = usecase.CreateVariantCmd{Language: "ru", Name: "Установка", Content: "content"}
+		report    = []entity.MissingTranslation{{GroupID: uuid.New(), Variants: variants[:1], Missing: []string{"ru"}}}
+		errExp    = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		call  func(s variantService) error
+		err   error
+	}{
+		{
+			name: "get: only readable variants",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, variantID}}, nil)
+				mock.core.GetVariantsMock.Expect(ctx, id, false).Return(variants, nil)
+			},
+			call: func(s variantService) error {
+				got, err := s.GetVariants(ctx, id)
+				require.Equal(t, []entity.Variant{variants[0], variants[2]}, got)
+				return err
+			},
+		},
+		{
+			name: "get: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: []uuid.UUID{variantID}}, nil)
+			},
+			call: func(s variantService) error {
+				_, err := s.GetVariants(ctx, id)
+				return err
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "set language: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.SetLanguageMock.Expect(ctx, id, req).Return(nil)
+			},
+			call: func(s variantService) error { return s.SetLanguage(ctx, id, req) },
+		},
+		{
+			name: "set language: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			call: func(s variantService) error { return s.SetLanguage(ctx, id, req) },
+			err:  apperr.ErrForbidden(),
+		},
+		{
+			name: "unlink: core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.UnlinkVariantMock.Expect(ctx, id).Return(entity.ErrNoLanguage())
+			},
+			call: func(s variantService) error { return s.UnlinkVariant(ctx, id) },
+			err:  entity.ErrNoLanguage(),
+		},
+		{
+			name: "link: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, variantID, auth.RoleWrite).Then(nil)
+				mock.core.LinkVariantMock.Expect(ctx, id, variantID, req).Return(nil)
+			},
+			call: func(s variantService) error { return s.LinkVariant(ctx, id, variantID, req) },
+		},
+		{
+			name: "link: no write permission for the variant",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.When(ctx, id, auth.RoleWrite).Then(nil)
+				mock.perm.CheckEntityPermissionMock.When(ctx, variantID, auth.RoleWrite).Then(apperr.ErrForbidden())
+			},
+			call: func(s variantService) error { return s.LinkVariant(ctx, id, variantID, req) },
+			err:  apperr.ErrForbidden(),
+		},
+		{
+			name: "create: next to the entity",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.GetListItemsMock.Expect(ctx, []uuid.UUID{id}).
+					Return([]entity.ListItem{{ID: id, Type: entity.TypeArticle, ParentID: &parentID}}, nil)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, parentID}}, nil)
+				mock.core.CreateMock.Expect(ctx, entity.CreateEntityReq{
+					Type:      entity.TypeArticle,
+					Name:      cmd.Name,
+					Content:   cmd.Content,
+					ParentID:  &parentID,
+					UserID:    userID,
+					VariantOf: &id,
+					Language:  "ru",
+				}).Return(variantID, entity.ContentUsage{}, nil)
+				mock.core.GetPendingDefaultPermissionsMock.Expect(ctx, variantID).Return(nil, nil)
+			},
+			call: func(s variantService) error {
+				got, _, err := s.CreateVariant(ctx, id, cmd)
+				require.Equal(t, variantID, got)
+				return err
+			},
+		},
+		{
+			name: "create: entity not found",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.GetListItemsMock.Return(nil, nil)
+			},
+			call: func(s variantService) error {
+				_, _, err := s.CreateVariant(ctx, id, cmd)
+				return err
+			},
+			err: entity.ErrEntityNotFound(),
+		},
+		{
+			name: "create: no write permission for the parent",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.GetListItemsMock.Return([]entity.ListItem{{ID: id, Type: entity.TypeArticle, ParentID: &parentID}}, nil)
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+			},
+			call: func(s variantService) error {
+				_, _, err := s.CreateVariant(ctx, id, cmd)
+				return err
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "missing translations: ok",
+			setup: func(mock serviceMocks) {
+				mock.core.GetMissingTranslationsMock.Expect(ctx).Return(report, nil)
+			},
+			call: func(s variantService) error {
+				got, err := s.GetMissingTranslations(ctx)
+				require.Equal(t, report, got)
+				return err
+			},
+		},
+		{
+			name: "missing translations: core error",
+			setup: func(mock serviceMocks) {
+				mock.core.GetMissingTranslationsMock.Return(nil, errExp)
+			},
+			call: func(s variantService) error {
+				_, err := s.GetMissingTranslations(ctx)
+				return err
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			err := tt.call(usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ResolveVariant(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		ruID     = uuid.New()
+		deID     = uuid.New()
+		variants = []entity.Variant{{EntityID: deID, Language: "de"}, {EntityID: id, Language: "en"}, {EntityID: ruID, Language: "ru"}}
+	)
 	tests := []struct {
 		name  string
-		ctx   context.Context
 		setup func(mock serviceMocks)
-		err   error
+		want  uuid.UUID
 	}{
 		{
-			name: "ok",
-			ctx:  ctx,
+			name: "best readable variant",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
-				mock.core.CreateMock.Expect(ctx, req).Return(uuid.New(), nil)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleRead).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id, ruID}}, nil)
+				mock.core.GetVariantsMock.Expect(ctx, id, false).Return(variants, nil)
 			},
+			want: ruID,
 		},
 		{
-			name: "core.Create error",
-			ctx:  ctx,
+			name: "a failed lookup serves the entity",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
-				mock.core.CreateMock.Expect(ctx, req).Return(uuid.Nil, expErr)
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.GetVariantsMock.Return(nil, fmt.Errorf("exp"))
 			},
-			err: expErr,
+			want: id,
 		},
 		{
-			name: "no user id in context",
-			ctx:  t.Context(),
+			name: "unreadable entity is left to the read",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(t.Context(), auth.RoleWrite).Return(permissions, nil)
+				mock.perm.GetEffectivePermissionsMock.Return(usecase.EffectivePermissions{IDs: []uuid.UUID{ruID}}, nil)
 			},
-			err: apperr.ErrUnauthorized(),
+			want: id,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			require.Equal(t, tt.want, s.ResolveVariant(ctx, id, "de;q=0.5, ru, en;q=0.8"))
+		})
+	}
+}
+
+func TestService_Lock(t *testing.T) {
+	t.Parallel()
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		lock   = entity.Lock{EntityID: id, UserID: userID, ExpiresAt: time.Now()}
+		errExp = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		want  entity.Lock
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.LockMock.Expect(ctx, id, userID).Return(lock, nil)
+			},
+			want: lock,
 		},
 		{
-			name: "no write permission",
-			ctx:  ctx,
+			name: "perm.CheckEntityPermission error",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
-					IsAdmin: false,
-					IDs:     []uuid.UUID{},
-				}, nil)
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(errExp)
 			},
-			err: apperr.ErrForbidden(),
+			err: errExp,
 		},
 		{
-			name: "perm.GetEffectivePermissions error",
-			ctx:  ctx,
+			name: "core.Lock error",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{}, expErr)
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.LockMock.Expect(ctx, id, userID).Return(entity.Lock{}, errExp)
 			},
-			err: expErr,
+			err: errExp,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			m := newServiceMocks(t)
-			if tt.setup != nil {
-				tt.setup(m)
-			}
+			tt.setup(m)
 
-			s := usecase.NewService(m.core, m.perm)
-			_, err := s.Create(tt.ctx, cmd)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.Lock(ctx, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
-			} else {
-				require.NoError(t, err)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestService_Update(t *testing.T) {
+func TestService_Unlock(t *testing.T) {
 	t.Parallel()
 	var (
-		ctx         = t.Context()
-		id          = uuid.New()
-		parentID    = uuid.New()
-		oldParentID = uuid.New()
-
-		cmd = usecase.UpdateEntityCmd{
-			ID:       id,
-			Name:     "name",
-			Content:  "content",
-			IsDraft:  true,
-			ParentID: &parentID,
-		}
-		userID   = uuid.New()
-		listItem = entity.ListItem{
-			ID:       id,
-			Type:     "type",
-			Name:     "name",
-			ParentID: &oldParentID,
-		}
-		req = entity.UpdateEntityReq{
-			ID:            cmd.ID,
-			Name:          cmd.Name,
-			Content:       cmd.Content,
-			ParentID:      &parentID,
-			IsDraft:       cmd.IsDraft,
-			UserID:        userID,
-			ParentChanged: true,
-			EntityType:    listItem.Type,
-		}
-		permissions = usecase.EffectivePermissions{
-			IsAdmin: false,
-			IDs:     []uuid.UUID{parentID, id, oldParentID},
-		}
-
-		expErr = fmt.Errorf("exp")
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		errExp = fmt.Errorf("exp")
 	)
-	ctx = contextx.SetUserID(ctx, userID)
 	tests := []struct {
 		name  string
-		ctx   context.Context
 		setup func(mock serviceMocks)
 		err   error
 	}{
 		{
 			name: "ok",
-			ctx:  ctx,
-			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
-				mock.core.GetListItemMock.Expect(ctx, req.ID).Return(listItem, nil)
-				mock.core.UpdateMock.Expect(ctx, req).Return(nil)
-			},
-		},
-		{
-			name: "core.Update error",
-			ctx:  ctx,
-			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
-				mock.core.GetListItemMock.Expect(ctx, req.ID).Return(listItem, nil)
-				mock.core.UpdateMock.Expect(ctx, req).Return(expErr)
-			},
-			err: expErr,
-		},
-		{
-			name: "no user id in context",
-			ctx:  t.Context(),
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(t.Context(), auth.RoleWrite).Return(permissions, nil)
-				mock.core.GetListItemMock.Expect(t.Context(), req.ID).Return(listItem, nil)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+				mock.core.UnlockMock.Expect(ctx, id, userID, false).Return(nil)
 			},
-			err: apperr.ErrUnauthorized(),
 		},
 		{
-			name: "no parent permission",
-			ctx:  ctx,
+			name: "ok, admin forces",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
-					IsAdmin: false,
-					IDs:     []uuid.UUID{id, oldParentID},
-				}, nil)
-				mock.core.GetListItemMock.Expect(ctx, req.ID).Return(listItem, nil)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{IsAdmin: true}, nil)
+				mock.core.UnlockMock.Expect(ctx, id, userID, true).Return(nil)
 			},
-			err: apperr.ErrForbidden(),
 		},
 		{
-			name: "no entity permission",
-			ctx:  ctx,
+			name: "no write permission",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{
-					IsAdmin: false,
-					IDs:     []uuid.UUID{parentID, oldParentID},
-				}, nil)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{}, nil)
 			},
 			err: apperr.ErrForbidden(),
 		},
 		{
-			name: "core.GetListItem error",
-			ctx:  ctx,
+			name: "permissions error",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(permissions, nil)
-				mock.core.GetListItemMock.Expect(ctx, req.ID).Return(entity.ListItem{}, expErr)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{}, errExp)
 			},
-			err: expErr,
+			err: errExp,
 		},
 		{
-			name: "perm.GetEffectivePermissions error",
-			ctx:  ctx,
+			name: "core.Unlock error",
 			setup: func(mock serviceMocks) {
-				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).Return(usecase.EffectivePermissions{}, expErr)
+				mock.perm.GetEffectivePermissionsMock.Expect(ctx, auth.RoleWrite).
+					Return(usecase.EffectivePermissions{IDs: []uuid.UUID{id}}, nil)
+				mock.core.UnlockMock.Expect(ctx, id, userID, false).Return(errExp)
 			},
-			err: expErr,
+			err: errExp,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			m := newServiceMocks(t)
-			if tt.setup != nil {
-				tt.setup(m)
-			}
+			tt.setup(m)
 
-			s := usecase.NewService(m.core, m.perm)
-			err := s.Update(tt.ctx, cmd)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			err := s.Unlock(ctx, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
-			} else {
-				require.NoError(t, err)
+				return
 			}
+			require.NoError(t, err)
 		})
 	}
 }
 
-func TestService_Delete(t *testing.T) {
+func TestService_GetLock(t *testing.T) {
 	t.Parallel()
 	var (
 		ctx    = t.Context()
 		id     = uuid.New()
+		lock   = entity.Lock{EntityID: id, UserID: uuid.New()}
 		errExp = fmt.Errorf("exp")
 	)
 	tests := []struct {
 		name  string
 		setup func(mock serviceMocks)
+		want  entity.Lock
 		err   error
 	}{
 		{
 			name: "ok",
 			setup: func(mock serviceMocks) {
-				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
-				mock.core.DeleteMock.Expect(ctx, id).Return(nil)
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetLockMock.Expect(ctx, id).Return(lock, nil)
 			},
+			want: lock,
 		},
 		{
-			name: "core.Delete error",
+			name: "perm.CheckEntityPermission error",
 			setup: func(mock serviceMocks) {
-				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
-				mock.core.DeleteMock.Expect(ctx, id).Return(errExp)
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(errExp)
 			},
 			err: errExp,
 		},
 		{
-			name: "perm.CheckEntityPermission error",
+			name: "core.GetLock error",
 			setup: func(mock serviceMocks) {
-				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(errExp)
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetLockMock.Expect(ctx, id).Return(entity.Lock{}, entity.ErrLockNotFound())
 			},
-			err: errExp,
+			err: entity.ErrLockNotFound(),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			m := newServiceMocks(t)
-			if tt.setup != nil {
-				tt.setup(m)
-			}
+			tt.setup(m)
 
-			s := usecase.NewService(m.core, m.perm)
-			err := s.Delete(ctx, id)
+			s := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments)
+			got, err := s.GetLock(ctx, id)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
-			} else {
-				require.NoError(t, err)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -860,3 +4129,192 @@ func TestEffectivePermissions_CheckParentIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RunLint(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx     = t.Context()
+		targets = []entity.LintTarget{
+			{EntityID: uuid.New(), Version: 3, Content: "Thiss is fine.", Language: "en"},
+			{EntityID: uuid.New(), Version: 1, Content: "Fine."},
+		}
+		matches = []lint.Match{{Offset: 0, Length: 5, Rule: "MORFOLOGIK_RULE_EN_US"}}
+		errExp  = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks, linter *mocks.LinterMock)
+		want  int
+		err   error
+	}{
+		{
+			name: "lints every batch",
+			setup: func(mock serviceMocks, linter *mocks.LinterMock) {
+				mock.core.ClaimLintMock.Set(func(context.Context) ([]entity.LintTarget, error) {
+					if mock.core.ClaimLintAfterCounter() == 0 {
+						return targets, nil
+					}
+					return nil, nil
+				})
+				linter.LintMock.When(ctx, targets[0].Content, "en").Then(matches, nil)
+				linter.LintMock.When(ctx, targets[1].Content, "").Then(nil, nil)
+				mock.core.CompleteLintMock.When(ctx, targets[0], matches).Then(nil)
+				mock.core.CompleteLintMock.When(ctx, targets[1], nil).Then(nil)
+			},
+			want: 2,
+		},
+		{
+			name: "linter error fails the version",
+			setup: func(mock serviceMocks, linter *mocks.LinterMock) {
+				mock.core.ClaimLintMock.Set(func(context.Context) ([]entity.LintTarget, error) {
+					if mock.core.ClaimLintAfterCounter() == 0 {
+						return targets, nil
+					}
+					return nil, nil
+				})
+				linter.LintMock.When(ctx, targets[0].Content, "en").Then(nil, errExp)
+				linter.LintMock.When(ctx, targets[1].Content, "").Then(nil, nil)
+				mock.core.FailLintMock.Expect(ctx, targets[0]).Return(nil)
+				mock.core.CompleteLintMock.Expect(ctx, targets[1], nil).Return(nil)
+			},
+			want: 1,
+		},
+		{
+			name: "claim error",
+			setup: func(mock serviceMocks, _ *mocks.LinterMock) {
+				mock.core.ClaimLintMock.Return(nil, errExp)
+			},
+			err: errExp,
+		},
+		{
+			name: "complete error",
+			setup: func(mock serviceMocks, linter *mocks.LinterMock) {
+				mock.core.ClaimLintMock.Return(targets[:1], nil)
+				linter.LintMock.Return(matches, nil)
+				mock.core.CompleteLintMock.Return(errExp)
+			},
+			err: errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			linter := mocks.NewLinterMock(t)
+			tt.setup(m, linter)
+
+			got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).WithLinter(linter).RunLint(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("without a linter", func(t *testing.T) {
+		t.Parallel()
+		m := newServiceMocks(t)
+		got, err := usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments).RunLint(ctx)
+		require.NoError(t, err)
+		require.Zero(t, got)
+	})
+}
+
+type lintService interface {
+	GetLintReport(ctx context.Context, id uuid.UUID, version int) (entity.LintReport, error)
+	SetLintSettings(ctx context.Context, id uuid.UUID, req entity.LintSettings) error
+}
+
+func TestService_Lint(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		report = entity.LintReport{EntityID: id, Version: 2, Status: entity.LintDone, Findings: []entity.LintFinding{}}
+		req    = entity.LintSettings{Disabled: true}
+		errExp = fmt.Errorf("exp")
+	)
+	tests := []struct {
+		name  string
+		setup func(mock serviceMocks)
+		call  func(s lintService) error
+		err   error
+	}{
+		{
+			name: "get report: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleRead).Return(nil)
+				mock.core.GetLintReportMock.Expect(ctx, id, 2).Return(report, nil)
+			},
+			call: func(s lintService) error {
+				got, err := s.GetLintReport(ctx, id, 2)
+				require.Equal(t, report, got)
+				return err
+			},
+		},
+		{
+			name: "get report: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(apperr.ErrForbidden())
+			},
+			call: func(s lintService) error {
+				_, err := s.GetLintReport(ctx, id, 0)
+				return err
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name: "get report: core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+				mock.core.GetLintReportMock.Return(entity.LintReport{}, errExp)
+			},
+			call: func(s lintService) error {
+				_, err := s.GetLintReport(ctx, id, 0)
+				return err
+			},
+			err: errExp,
+		},
+		{
+			name: "set settings: ok",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(nil)
+				mock.core.SetLintSettingsMock.Expect(ctx, id, req).Return(nil)
+			},
+			call: func(s lintService) error { return s.SetLintSettings(ctx, id, req) },
+		},
+		{
+			name: "set settings: forbidden",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Expect(ctx, id, auth.RoleWrite).Return(apperr.ErrForbidden())
+			},
+			call: func(s lintService) error { return s.SetLintSettings(ctx, id, req) },
+			err:  apperr.ErrForbidden(),
+		},
+		{
+			name: "set settings: core error",
+			setup: func(mock serviceMocks) {
+				mock.perm.CheckEntityPermissionMock.Return(nil)
+				mock.core.SetLintSettingsMock.Return(errExp)
+			},
+			call: func(s lintService) error { return s.SetLintSettings(ctx, id, req) },
+			err:  errExp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newServiceMocks(t)
+			tt.setup(m)
+
+			err := tt.call(usecase.NewService(m.core, m.perm, m.sanitizer, m.granter, m.sender, m.tx, m.attachments))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	MaxVariableKeyLength   = 64
+	MaxVariableValueLength = 1024
+	MaxVariablesPerEntity  = 100
+	// MaxVariableNesting is how deep values may reference other variables; deeper references stay as written.
+	MaxVariableNesting = 8
+	// MaxVariableReferences and MaxRenderedContentLength bound the work and the output of expanding the
+	// variables of one content, which values referencing each other can multiply.
+	MaxVariableReferences    = 10000
+	MaxRenderedContentLength = 4 << 20
+)
+
+var (
+	// variableKeyPattern allows keys such as "product", "product.version" or "release_date".
+	variableKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9._-]*$`)
+	// variableRef matches a reference like {{product.version}}, spaces inside the braces allowed.
+	variableRef = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9._-]*)\s*\}\}`)
+)
+
+type SetVariableReq struct {
+	Value string `json:"value"`
+}
+
+// Variable is a value content of the entity and of its descendants refers to as {{key}}. A descendant
+// defining the same key overrides it for its own subtree.
+type Variable struct {
+	// EntityID is the entity that defines the variable, the entity itself or one of its ancestors.
+	EntityID  uuid.UUID `json:"entity_id"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+	UpdatedBy uuid.UUID `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Variables are the values in the scope of an entity by key.
+type Variables map[string]string
+
+// Expand replaces the references in content with their values. Values may reference other variables of
+// the scope, up to MaxVariableNesting deep; references to unknown variables, to variables being expanded,
+// which would be a cycle, and beyond that depth stay as written.
+func (v Variables) Expand(content string) (string, error) {
+	if len(v) == 0 || !strings.Contains(content, "{{") {
+		return content, nil
+	}
+	e := expander{vars: v}
+	if err := e.expand(content); err != nil {
+		return "", err
+	}
+
+	return e.b.String(), nil
+}
+
+type expander struct {
+	vars Variables
+	b    strings.Builder
+	// keys are the variables being expanded, the innermost last
+	keys []string
+	refs int
+}
+
+func (e *expander) expand(s string) error {
+	last := 0
+	for _, m := range variableRef.FindAllStringSubmatchIndex(s, -1) {
+		e.b.WriteString(s[last:m[0]])
+		last = m[1]
+
+		key := s[m[2]:m[3]]
+		value, ok := e.vars[key]
+		if !ok || len(e.keys) >= MaxVariableNesting || slices.Contains(e.keys, key) {
+			e.b.WriteString(s[m[0]:m[1]])
+			continue
+		}
+		if e.refs++; e.refs > MaxVariableReferences {
+			return ErrVariablesTooLarge(MaxRenderedContentLength, MaxVariableReferences)
+		}
+		e.keys = append(e.keys, key)
+		err := e.expand(value)
+		e.keys = e.keys[:len(e.keys)-1]
+		if err != nil {
+			return err
+		}
+		if e.b.Len() > MaxRenderedContentLength {
+			return ErrVariablesTooLarge(MaxRenderedContentLength, MaxVariableReferences)
+		}
+	}
+	e.b.WriteString(s[last:])
+	if e.b.Len() > MaxRenderedContentLength {
+		return ErrVariablesTooLarge(MaxRenderedContentLength, MaxVariableReferences)
+	}
+
+	return nil
+}
+
+// GetVariables returns the variables in the scope of id by key: those it defines and those it inherits
+// from the nearest ancestor defining them.
+func (c *core) GetVariables(ctx context.Context, id uuid.UUID) ([]Variable, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetVariables: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	vars, err := c.repo.GetVariables(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetVariables: %w", err)
+	}
+
+	return vars, nil
+}
+
+// SetVariable defines or changes a variable of id.
+func (c *core) SetVariable(ctx context.Context, id uuid.UUID, key string, req SetVariableReq) (Variable, error) {
+	if id == uuid.Nil {
+		return Variable{}, fmt.Errorf("entity.core.SetVariable: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := validateVariableKey(key); err != nil {
+		return Variable{}, fmt.Errorf("entity.core.SetVariable: %w", err)
+	}
+	if utf8.RuneCountInString(req.Value) > MaxVariableValueLength {
+		return Variable{}, fmt.Errorf("entity.core.SetVariable: %w", ErrVariableValueTooLong(MaxVariableValueLength))
+	}
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return Variable{}, fmt.Errorf("entity.core.SetVariable: %w", err)
+	}
+
+	v := Variable{EntityID: id, Key: key, Value: req.Value, UpdatedBy: userID, UpdatedAt: c.gen.Time.Now()}
+	if err = c.repo.SetVariable(ctx, v, MaxVariablesPerEntity); err != nil {
+		return Variable{}, fmt.Errorf("entity.core.SetVariable: %w", err)
+	}
+
+	return v, nil
+}
+
+// DeleteVariable removes a variable id defines; one it inherits is not affected.
+func (c *core) DeleteVariable(ctx context.Context, id uuid.UUID, key string) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.DeleteVariable: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := validateVariableKey(key); err != nil {
+		return fmt.Errorf("entity.core.DeleteVariable: %w", err)
+	}
+	if err := c.repo.DeleteVariable(ctx, id, key); err != nil {
+		return fmt.Errorf("entity.core.DeleteVariable: %w", err)
+	}
+
+	return nil
+}
+
+// ExpandVariables expands the variables in contents, by entity, each with the variables in its scope.
+func (c *core) ExpandVariables(ctx context.Context, contents map[uuid.UUID]string) (map[uuid.UUID]string, error) {
+	ids := lo.Keys(contents)
+	scopes, err := c.repo.GetScopeVariables(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.ExpandVariables: %w", err)
+	}
+
+	expanded := make(map[uuid.UUID]string, len(contents))
+	for id, content := range contents {
+		if expanded[id], err = scopes[id].Expand(content); err != nil {
+			return nil, fmt.Errorf("entity.core.ExpandVariables: %w", err)
+		}
+	}
+
+	return expanded, nil
+}
+
+func validateVariableKey(key string) error {
+	if utf8.RuneCountInString(key) > MaxVariableKeyLength {
+		return ErrVariableKeyTooLong(MaxVariableKeyLength)
+	}
+	if !variableKeyPattern.MatchString(key) {
+		return ErrInvalidVariableKey()
+	}
+
+	return nil
+}
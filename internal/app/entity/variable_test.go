@@ -0,0 +1,188 @@
+package entity_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariables_Expand(t *testing.T) {
+	t.Parallel()
+
+	vars := entity.Variables{
+		"product":         "EasyGoDocs",
+		"product.version": "2.3",
+		"title":           "{{product}} {{ product.version }}",
+		"a":               "{{b}}",
+		"b":               "[{{a}}]",
+	}
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "plain", content: "no references", want: "no references"},
+		{name: "reference", content: "Install {{product}} now", want: "Install EasyGoDocs now"},
+		{name: "spaces inside braces", content: "v{{ product.version }}", want: "v2.3"},
+		{name: "nested", content: "# {{title}}", want: "# EasyGoDocs 2.3"},
+		{name: "unknown stays", content: "{{missing}} and {{product}}", want: "{{missing}} and EasyGoDocs"},
+		{name: "not a reference", content: "{{ two words }} {{1st}}", want: "{{ two words }} {{1st}}"},
+		{name: "cycle stays", content: "{{a}}", want: "[{{a}}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := vars.Expand(tt.content)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("no variables", func(t *testing.T) {
+		t.Parallel()
+		got, err := entity.Variables(nil).Expand("{{product}}")
+		require.NoError(t, err)
+		require.Equal(t, "{{product}}", got)
+	})
+	t.Run("nesting limit", func(t *testing.T) {
+		t.Parallel()
+		chain := entity.Variables{}
+		for i := range entity.MaxVariableNesting + 1 {
+			chain[fmt.Sprintf("v%d", i)] = fmt.Sprintf("{{v%d}}", i+1)
+		}
+		got, err := chain.Expand("{{v0}}")
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("{{v%d}}", entity.MaxVariableNesting), got)
+	})
+	t.Run("too long", func(t *testing.T) {
+		t.Parallel()
+		long := entity.Variables{"v": strings.Repeat("x", entity.MaxVariableValueLength)}
+		content := strings.Repeat("{{v}}", entity.MaxRenderedContentLength/entity.MaxVariableValueLength+1)
+		_, err := long.Expand(content)
+		require.ErrorIs(t, err, entity.ErrVariablesTooLarge(entity.MaxRenderedContentLength, entity.MaxVariableReferences))
+	})
+	t.Run("too many references", func(t *testing.T) {
+		t.Parallel()
+		// empty values add nothing to the output, only references
+		fanOut := entity.Variables{"v8": ""}
+		for i := range 8 {
+			fanOut[fmt.Sprintf("v%d", i)] = strings.Repeat(fmt.Sprintf("{{v%d}}", i+1), 10)
+		}
+		_, err := fanOut.Expand("{{v0}}")
+		require.ErrorIs(t, err, entity.ErrVariablesTooLarge(entity.MaxRenderedContentLength, entity.MaxVariableReferences))
+	})
+}
+
+func TestCore_SetVariable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		ctx    = contextx.SetUserID(t.Context(), userID)
+		id     = uuid.New()
+		now    = time.Now()
+		want   = entity.Variable{EntityID: id, Key: "product.version", Value: "2.3", UpdatedBy: userID, UpdatedAt: now}
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		key   string
+		value string
+		setup func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock)
+		err   error
+	}{
+		{
+			name: "ok", id: id, key: "product.version", value: "2.3",
+			setup: func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock) {
+				tg.NowMock.Return(now)
+				repo.SetVariableMock.Expect(ctx, want, entity.MaxVariablesPerEntity).Return(nil)
+			},
+		},
+		{name: "nil id", id: uuid.Nil, key: "product", err: apperr.ErrNilUUID(entity.FieldEntityID)},
+		{name: "empty key", id: id, key: "", err: entity.ErrInvalidVariableKey()},
+		{name: "key starting with digit", id: id, key: "1st", err: entity.ErrInvalidVariableKey()},
+		{name: "key with space", id: id, key: "product version", err: entity.ErrInvalidVariableKey()},
+		{name: "key too long", id: id, key: strings.Repeat("k", entity.MaxVariableKeyLength+1), err: entity.ErrVariableKeyTooLong(entity.MaxVariableKeyLength)},
+		{name: "value too long", id: id, key: "product", value: strings.Repeat("v", entity.MaxVariableValueLength+1), err: entity.ErrVariableValueTooLong(entity.MaxVariableValueLength)},
+		{
+			name: "too many", id: id, key: "product.version", value: "2.3",
+			setup: func(repo *mocks.RepositoryMock, tg *mocks.TimeGeneratorMock) {
+				tg.NowMock.Return(now)
+				repo.SetVariableMock.Return(entity.ErrTooManyVariables(entity.MaxVariablesPerEntity))
+			},
+			err: entity.ErrTooManyVariables(entity.MaxVariablesPerEntity),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			tg := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, tg)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: tg}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.SetVariable(ctx, tt.id, tt.key, entity.SetVariableReq{Value: tt.value})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestCore_DeleteVariable(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	id := uuid.New()
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	require.ErrorIs(t, c.DeleteVariable(ctx, uuid.Nil, "product"), apperr.ErrNilUUID(entity.FieldEntityID))
+	require.ErrorIs(t, c.DeleteVariable(ctx, id, "no key"), entity.ErrInvalidVariableKey())
+
+	repo.DeleteVariableMock.Expect(ctx, id, "product").Return(entity.ErrVariableNotFound())
+	require.ErrorIs(t, c.DeleteVariable(ctx, id, "product"), entity.ErrVariableNotFound())
+}
+
+func TestCore_ExpandVariables(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		first  = uuid.New()
+		second = uuid.New()
+		expErr = fmt.Errorf("test error")
+	)
+	contents := map[uuid.UUID]string{first: "{{product}}", second: "{{product}}"}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)}, mocks.NewValidatorMock(t), Cfg())
+	require.NoError(t, err)
+
+	// second has no variables in its scope
+	repo.GetScopeVariablesMock.Return(map[uuid.UUID]entity.Variables{first: {"product": "EasyGoDocs"}}, nil)
+	got, err := c.ExpandVariables(ctx, contents)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]string{first: "EasyGoDocs", second: "{{product}}"}, got)
+
+	repo.GetScopeVariablesMock.Return(nil, expErr)
+	_, err = c.ExpandVariables(ctx, contents)
+	require.ErrorIs(t, err, expErr)
+}
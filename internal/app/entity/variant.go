@@ -0,0 +1,216 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+// Variant is an entity holding a document in one language. The variants of a document share GroupID,
+// and a group has at most one variant per language.
+type Variant struct {
+	EntityID  uuid.UUID `json:"entity_id"`
+	GroupID   uuid.UUID `json:"group_id"`
+	Language  string    `json:"language"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SetLanguageReq struct {
+	Language string `json:"language"`
+}
+
+// MissingTranslation is a document lacking variants in Missing, languages the workspace translates into.
+type MissingTranslation struct {
+	GroupID  uuid.UUID `json:"group_id"`
+	Variants []Variant `json:"variants"`
+	Missing  []string  `json:"missing"`
+}
+
+// NormalizeLanguage returns the canonical form of a language tag such as en, ru or pt-BR.
+func NormalizeLanguage(lang string) (string, error) {
+	tag, err := language.Parse(lang)
+	if err != nil || lang == "" {
+		return "", ErrInvalidLanguage()
+	}
+
+	return tag.String(), nil
+}
+
+// MatchVariant picks the variant that fits an Accept-Language header value best. On a tie, and when no
+// variant fits, the one with preferred as its entity wins; ok is false if that is none of them.
+func MatchVariant(variants []Variant, preferred uuid.UUID, acceptLanguage string) (Variant, bool) {
+	i := slices.IndexFunc(variants, func(v Variant) bool { return v.EntityID == preferred })
+	if i < 0 {
+		return Variant{}, false
+	}
+	// the matcher falls back to the first tag, so the preferred variant goes first
+	ordered := append([]Variant{variants[i]}, slices.Delete(slices.Clone(variants), i, i+1)...)
+	accepted, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(accepted) == 0 {
+		return ordered[0], true
+	}
+	tags := make([]language.Tag, 0, len(ordered))
+	for _, v := range ordered {
+		tags = append(tags, language.Make(v.Language))
+	}
+	_, idx, confidence := language.NewMatcher(tags).Match(accepted...)
+	if confidence == language.No {
+		return ordered[0], true
+	}
+
+	return ordered[idx], true
+}
+
+// SetLanguage sets the language of id. An entity without variants starts a group of its own.
+func (c *core) SetLanguage(ctx context.Context, id uuid.UUID, req SetLanguageReq) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.SetLanguage: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	lang, err := c.validateLanguage(req.Language)
+	if err != nil {
+		return fmt.Errorf("entity.core.SetLanguage: %w", err)
+	}
+	groupID, err := c.gen.ID.New()
+	if err != nil {
+		return fmt.Errorf("entity.core.SetLanguage: %w", err)
+	}
+	if err = c.repo.SetLanguage(ctx, id, groupID, lang, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("entity.core.SetLanguage: %w", err)
+	}
+
+	return nil
+}
+
+// LinkVariant makes variantID the variant of id in the given language. id must have a language; variantID
+// may not be a variant of another document yet.
+func (c *core) LinkVariant(ctx context.Context, id, variantID uuid.UUID, req SetLanguageReq) error {
+	if err := validateVariant(id, variantID); err != nil {
+		return fmt.Errorf("entity.core.LinkVariant: %w", err)
+	}
+	lang, err := c.validateLanguage(req.Language)
+	if err != nil {
+		return fmt.Errorf("entity.core.LinkVariant: %w", err)
+	}
+	if err = c.repo.LinkVariant(ctx, id, variantID, lang, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("entity.core.LinkVariant: %w", err)
+	}
+
+	return nil
+}
+
+// UnlinkVariant removes the language of id, which leaves its group; the other variants stay linked.
+func (c *core) UnlinkVariant(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.UnlinkVariant: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	if err := c.repo.DeleteVariant(ctx, id); err != nil {
+		return fmt.Errorf("entity.core.UnlinkVariant: %w", err)
+	}
+
+	return nil
+}
+
+// GetVariants returns the variants of the document id belongs to, id included, by language; none if it
+// has no language. Unless isAdmin, drafts of other users are skipped.
+func (c *core) GetVariants(ctx context.Context, id uuid.UUID, isAdmin bool) ([]Variant, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("entity.core.GetVariants: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	var userID *uuid.UUID
+	if !isAdmin {
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entity.core.GetVariants: %w", err)
+		}
+		userID = &uid
+	}
+	variants, err := c.repo.GetVariants(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetVariants: %w", err)
+	}
+
+	return variants, nil
+}
+
+// GetMissingTranslations returns the documents lacking a variant in one of the configured languages, or,
+// without any, in one of the languages variants are written in.
+func (c *core) GetMissingTranslations(ctx context.Context) ([]MissingTranslation, error) {
+	variants, err := c.repo.GetAllVariants(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entity.core.GetMissingTranslations: %w", err)
+	}
+
+	languages := make([]string, 0, len(c.cfg.Languages))
+	for _, lang := range c.cfg.Languages {
+		// Config.Validate made sure they parse
+		normalized, _ := NormalizeLanguage(lang)
+		languages = append(languages, normalized)
+	}
+	if len(languages) == 0 {
+		for _, v := range variants {
+			languages = append(languages, v.Language)
+		}
+	}
+	slices.Sort(languages)
+	languages = slices.Compact(languages)
+
+	report := make([]MissingTranslation, 0)
+	// variants come ordered by group, so a group is a run of them
+	for start := 0; start < len(variants); {
+		end := start + 1
+		for end < len(variants) && variants[end].GroupID == variants[start].GroupID {
+			end++
+		}
+		group := variants[start:end]
+		var missing []string
+		for _, lang := range languages {
+			if !slices.ContainsFunc(group, func(v Variant) bool { return v.Language == lang }) {
+				missing = append(missing, lang)
+			}
+		}
+		if len(missing) > 0 {
+			report = append(report, MissingTranslation{GroupID: group[0].GroupID, Variants: group, Missing: missing})
+		}
+		start = end
+	}
+
+	return report, nil
+}
+
+func (c *core) validateLanguage(lang string) (string, error) {
+	normalized, err := NormalizeLanguage(lang)
+	if err != nil {
+		return "", err
+	}
+	if len(c.cfg.Languages) == 0 {
+		return normalized, nil
+	}
+	for _, allowed := range c.cfg.Languages {
+		if a, _ := NormalizeLanguage(allowed); a == normalized {
+			return normalized, nil
+		}
+	}
+
+	return "", ErrUnsupportedLanguage(c.cfg.Languages)
+}
+
+func validateVariant(id, variantID uuid.UUID) error {
+	if id == uuid.Nil {
+		return apperr.ErrNilUUID(FieldEntityID)
+	}
+	if variantID == uuid.Nil {
+		return apperr.ErrNilUUID(FieldVariant)
+	}
+	if id == variantID {
+		return ErrSelfVariant()
+	}
+
+	return nil
+}
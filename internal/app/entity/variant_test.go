@@ -0,0 +1,258 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func languagesCfg(languages ...string) entity.Config {
+	cfg := Cfg()
+	cfg.Languages = languages
+	return cfg
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	t.Parallel()
+
+	for lang, want := range map[string]string{"en": "en", "EN": "en", "pt_br": "pt-BR", "zh-hant-tw": "zh-Hant-TW"} {
+		got, err := entity.NormalizeLanguage(lang)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	for _, lang := range []string{"", "english language", "x"} {
+		_, err := entity.NormalizeLanguage(lang)
+		require.Equal(t, entity.CodeValidationFailed, apperr.CodeOf(err), lang)
+	}
+}
+
+func TestMatchVariant(t *testing.T) {
+	t.Parallel()
+
+	var (
+		en       = entity.Variant{EntityID: uuid.New(), Language: "en"}
+		ru       = entity.Variant{EntityID: uuid.New(), Language: "ru"}
+		ptBR     = entity.Variant{EntityID: uuid.New(), Language: "pt-BR"}
+		variants = []entity.Variant{en, ptBR, ru}
+	)
+	tests := []struct {
+		name           string
+		preferred      uuid.UUID
+		acceptLanguage string
+		want           entity.Variant
+		ok             bool
+	}{
+		{name: "exact", preferred: en.EntityID, acceptLanguage: "ru", want: ru, ok: true},
+		{name: "by quality", preferred: en.EntityID, acceptLanguage: "de;q=0.9, ru;q=0.5, pt-BR;q=0.8", want: ptBR, ok: true},
+		{name: "region falls back to base", preferred: en.EntityID, acceptLanguage: "ru-RU", want: ru, ok: true},
+		{name: "preferred on a match", preferred: ru.EntityID, acceptLanguage: "en, ru", want: en, ok: true},
+		{name: "preferred without a match", preferred: ru.EntityID, acceptLanguage: "ja", want: ru, ok: true},
+		{name: "preferred on a malformed header", preferred: ptBR.EntityID, acceptLanguage: ";;;", want: ptBR, ok: true},
+		{name: "preferred not a variant", preferred: uuid.New(), acceptLanguage: "ru"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := entity.MatchVariant(variants, tt.preferred, tt.acceptLanguage)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_Variants(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		id        = uuid.New()
+		variantID = uuid.New()
+		groupID   = uuid.New()
+		userID    = uuid.New()
+		now       = time.Date(2025, 10, 12, 12, 0, 0, 0, time.UTC)
+		variants  = []entity.Variant{{EntityID: id, GroupID: groupID, Language: "en"}, {EntityID: variantID, GroupID: groupID, Language: "ru"}}
+		expErr    = fmt.Errorf("test error")
+	)
+	newCore := func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock, cfg entity.Config) interface {
+		SetLanguage(ctx context.Context, id uuid.UUID, req entity.SetLanguageReq) error
+		LinkVariant(ctx context.Context, id, variantID uuid.UUID, req entity.SetLanguageReq) error
+		UnlinkVariant(ctx context.Context, id uuid.UUID) error
+		GetVariants(ctx context.Context, id uuid.UUID, isAdmin bool) ([]entity.Variant, error)
+	} {
+		timeGen := mocks.NewTimeGeneratorMock(t)
+		timeGen.NowMock.Optional().Return(now)
+		c, err := entity.NewCore(repo, entity.Generators{ID: idGen, Time: timeGen}, mocks.NewValidatorMock(t), cfg)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("set language", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		idGen := mocks.NewIDGeneratorMock(t)
+		idGen.NewMock.Return(groupID, nil)
+		repo.SetLanguageMock.Expect(ctx, id, groupID, "pt-BR", now).Return(nil)
+
+		require.NoError(t, newCore(repo, idGen, Cfg()).SetLanguage(ctx, id, entity.SetLanguageReq{Language: "pt_br"}))
+	})
+	t.Run("set language not in the workspace languages", func(t *testing.T) {
+		t.Parallel()
+		c := newCore(mocks.NewRepositoryMock(t), mocks.NewIDGeneratorMock(t), languagesCfg("en", "ru"))
+		err := c.SetLanguage(ctx, id, entity.SetLanguageReq{Language: "de"})
+		require.Equal(t, entity.CodeValidationFailed, apperr.CodeOf(err))
+	})
+	t.Run("set language repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		idGen := mocks.NewIDGeneratorMock(t)
+		idGen.NewMock.Return(groupID, nil)
+		repo.SetLanguageMock.Return(expErr)
+
+		require.ErrorIs(t, newCore(repo, idGen, languagesCfg("en")).SetLanguage(ctx, id, entity.SetLanguageReq{Language: "en"}), expErr)
+	})
+	t.Run("link", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.LinkVariantMock.Expect(ctx, id, variantID, "ru", now).Return(nil)
+
+		require.NoError(t, newCore(repo, mocks.NewIDGeneratorMock(t), languagesCfg("en", "ru")).
+			LinkVariant(ctx, id, variantID, entity.SetLanguageReq{Language: "RU"}))
+	})
+	t.Run("link itself", func(t *testing.T) {
+		t.Parallel()
+		err := newCore(mocks.NewRepositoryMock(t), mocks.NewIDGeneratorMock(t), Cfg()).
+			LinkVariant(ctx, id, id, entity.SetLanguageReq{Language: "ru"})
+		require.Equal(t, entity.CodeValidationFailed, apperr.CodeOf(err))
+	})
+	t.Run("link invalid language", func(t *testing.T) {
+		t.Parallel()
+		err := newCore(mocks.NewRepositoryMock(t), mocks.NewIDGeneratorMock(t), Cfg()).
+			LinkVariant(ctx, id, variantID, entity.SetLanguageReq{})
+		require.Equal(t, entity.CodeValidationFailed, apperr.CodeOf(err))
+	})
+	t.Run("link taken language", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.LinkVariantMock.Return(entity.ErrLanguageTaken())
+
+		err := newCore(repo, mocks.NewIDGeneratorMock(t), Cfg()).LinkVariant(ctx, id, variantID, entity.SetLanguageReq{Language: "ru"})
+		require.Equal(t, entity.CodeLanguageTaken, apperr.CodeOf(err))
+	})
+	t.Run("unlink", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.DeleteVariantMock.Expect(ctx, id).Return(nil)
+
+		require.NoError(t, newCore(repo, mocks.NewIDGeneratorMock(t), Cfg()).UnlinkVariant(ctx, id))
+		require.Error(t, newCore(mocks.NewRepositoryMock(t), mocks.NewIDGeneratorMock(t), Cfg()).UnlinkVariant(ctx, uuid.Nil))
+	})
+	t.Run("get skips drafts of others", func(t *testing.T) {
+		t.Parallel()
+		userCtx := contextx.SetUserID(ctx, userID)
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetVariantsMock.Expect(userCtx, id, &userID).Return(variants, nil)
+
+		got, err := newCore(repo, mocks.NewIDGeneratorMock(t), Cfg()).GetVariants(userCtx, id, false)
+		require.NoError(t, err)
+		require.Equal(t, variants, got)
+	})
+	t.Run("get as admin", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.GetVariantsMock.Expect(ctx, id, nil).Return(variants, nil)
+
+		got, err := newCore(repo, mocks.NewIDGeneratorMock(t), Cfg()).GetVariants(ctx, id, true)
+		require.NoError(t, err)
+		require.Equal(t, variants, got)
+	})
+	t.Run("get without user", func(t *testing.T) {
+		t.Parallel()
+		_, err := newCore(mocks.NewRepositoryMock(t), mocks.NewIDGeneratorMock(t), Cfg()).GetVariants(ctx, id, false)
+		require.Error(t, err)
+	})
+}
+
+func TestCore_GetMissingTranslations(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		full    = uuid.New()
+		partial = uuid.New()
+		all     = []entity.Variant{
+			{GroupID: full, EntityID: uuid.New(), Language: "de"},
+			{GroupID: full, EntityID: uuid.New(), Language: "en"},
+			{GroupID: full, EntityID: uuid.New(), Language: "ru"},
+			{GroupID: partial, EntityID: uuid.New(), Language: "en"},
+		}
+		expErr = fmt.Errorf("test error")
+	)
+	tests := []struct {
+		name  string
+		cfg   entity.Config
+		setup func(repo *mocks.RepositoryMock)
+		want  []entity.MissingTranslation
+		err   error
+	}{
+		{
+			name:  "languages in use",
+			cfg:   Cfg(),
+			setup: func(repo *mocks.RepositoryMock) { repo.GetAllVariantsMock.Expect(ctx).Return(all, nil) },
+			want:  []entity.MissingTranslation{{GroupID: partial, Variants: all[3:], Missing: []string{"de", "ru"}}},
+		},
+		{
+			name:  "configured languages",
+			cfg:   languagesCfg("EN", "fr"),
+			setup: func(repo *mocks.RepositoryMock) { repo.GetAllVariantsMock.Return(all, nil) },
+			want: []entity.MissingTranslation{
+				{GroupID: full, Variants: all[:3], Missing: []string{"fr"}},
+				{GroupID: partial, Variants: all[3:], Missing: []string{"fr"}},
+			},
+		},
+		{
+			name:  "nothing missing",
+			cfg:   languagesCfg("en"),
+			setup: func(repo *mocks.RepositoryMock) { repo.GetAllVariantsMock.Return(all, nil) },
+			want:  []entity.MissingTranslation{},
+		},
+		{
+			name:  "repo error",
+			cfg:   Cfg(),
+			setup: func(repo *mocks.RepositoryMock) { repo.GetAllVariantsMock.Return(nil, expErr) },
+			err:   expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			tt.setup(repo)
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: mocks.NewTimeGeneratorMock(t)},
+				mocks.NewValidatorMock(t), tt.cfg)
+			require.NoError(t, err)
+
+			got, err := c.GetMissingTranslations(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfig_Validate_Languages(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, languagesCfg("en", "pt-BR").Validate())
+	require.Error(t, languagesCfg("en", "not a language").Validate())
+}
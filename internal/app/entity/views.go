@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPopularPeriod and DefaultPopularLimit apply when the popular report is requested without them.
+	DefaultPopularPeriod = "30d"
+	DefaultPopularLimit  = 20
+	// MaxPopularLimit caps the size of the popular report.
+	MaxPopularLimit = 100
+	// MaxPopularPeriodDays caps how far back the popular report looks.
+	MaxPopularPeriodDays = 365
+)
+
+// ParsePeriod parses a number of days or hours such as "30d" or "12h".
+func ParsePeriod(s string) (time.Duration, error) {
+	unit := time.Hour
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "h"):
+	default:
+		return 0, fmt.Errorf("entity.ParsePeriod: %w", ErrInvalidPeriod(MaxPopularPeriodDays))
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 || n > MaxPopularPeriodDays*24 {
+		return 0, fmt.Errorf("entity.ParsePeriod: %w", ErrInvalidPeriod(MaxPopularPeriodDays))
+	}
+
+	return time.Duration(n) * unit, nil
+}
+
+// RecordView counts a read of the entity by userID. Only the first read in a session counts.
+func (c *core) RecordView(ctx context.Context, id, userID, sessionID uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("entity.core.RecordView: %w", apperr.ErrNilUUID(FieldEntityID))
+	}
+	view := View{EntityID: id, UserID: userID, SessionID: sessionID, ViewedAt: c.gen.Time.Now()}
+	if err := c.repo.RecordView(ctx, view); err != nil {
+		return fmt.Errorf("entity.core.RecordView: %w", err)
+	}
+
+	return nil
+}
+
+// GetPopular returns the most viewed entities over the last req.Period. Unless isAdmin only
+// permittedIDs are considered and drafts of other users are skipped.
+func (c *core) GetPopular(ctx context.Context, req GetPopularReq, permittedIDs []uuid.UUID, isAdmin bool) (PopularReport, error) {
+	if req.Limit <= 0 || req.Limit > MaxPopularLimit {
+		return PopularReport{}, fmt.Errorf("entity.core.GetPopular: %w", ErrInvalidPopularLimit(MaxPopularLimit))
+	}
+	if req.Period < time.Hour || req.Period > MaxPopularPeriodDays*24*time.Hour {
+		return PopularReport{}, fmt.Errorf("entity.core.GetPopular: %w", ErrInvalidPeriod(MaxPopularPeriodDays))
+	}
+
+	report := PopularReport{Since: c.gen.Time.Now().Add(-req.Period), Entities: []PopularEntity{}}
+	var (
+		ids    []uuid.UUID
+		userID *uuid.UUID
+	)
+	if !isAdmin {
+		if len(permittedIDs) == 0 {
+			return report, nil
+		}
+		uid, err := contextx.GetUserID(ctx)
+		if err != nil {
+			return PopularReport{}, fmt.Errorf("entity.core.GetPopular: %w", err)
+		}
+		ids, userID = permittedIDs, &uid
+	}
+
+	entities, err := c.repo.GetPopular(ctx, report.Since, req.Limit, ids, userID)
+	if err != nil {
+		return PopularReport{}, fmt.Errorf("entity.core.GetPopular: %w", err)
+	}
+	report.Entities = entities
+
+	return report, nil
+}
@@ -0,0 +1,215 @@
+package entity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/entity/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{in: "30d", want: 30 * 24 * time.Hour, ok: true},
+		{in: "12h", want: 12 * time.Hour, ok: true},
+		{in: "365d", want: 365 * 24 * time.Hour, ok: true},
+		{in: "0d"},
+		{in: "-1d"},
+		{in: "d"},
+		{in: "30"},
+		{in: "1w"},
+		{in: "1.5d"},
+		{in: ""},
+	}
+	for _, tt := range tests {
+		got, err := entity.ParsePeriod(tt.in)
+		if !tt.ok {
+			require.ErrorIs(t, err, entity.ErrInvalidPeriod(entity.MaxPopularPeriodDays), tt.in)
+			continue
+		}
+		require.NoError(t, err, tt.in)
+		require.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestCore_RecordView(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id        = uuid.New()
+		userID    = uuid.New()
+		sessionID = uuid.New()
+		now       = time.Date(2025, 9, 15, 10, 0, 0, 0, time.UTC)
+		expErr    = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		setup func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock)
+		err   error
+	}{
+		{
+			name: "success",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.RecordViewMock.Expect(context.Background(), entity.View{EntityID: id, UserID: userID, SessionID: sessionID, ViewedAt: now}).Return(nil)
+			},
+		},
+		{
+			name: "error/nil_id",
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(entity.FieldEntityID),
+		},
+		{
+			name: "error/repo_error",
+			id:   id,
+			setup: func(repo *mocks.RepositoryMock, timeGen *mocks.TimeGeneratorMock) {
+				timeGen.NowMock.Return(now)
+				repo.RecordViewMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, timeGen)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			err = c.RecordView(context.Background(), tt.id, userID, sessionID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetPopular(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.New()
+		ctx       = contextx.SetUserID(context.Background(), userID)
+		now       = time.Date(2025, 9, 15, 10, 0, 0, 0, time.UTC)
+		since     = now.Add(-30 * 24 * time.Hour)
+		permitted = []uuid.UUID{uuid.New(), uuid.New()}
+		popular   = []entity.PopularEntity{{ListItem: entity.ListItem{ID: permitted[1], Name: "read"}, Views: 3}}
+		req       = entity.GetPopularReq{Period: 30 * 24 * time.Hour, Limit: 10}
+		expErr    = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		req       entity.GetPopularReq
+		permitted []uuid.UUID
+		isAdmin   bool
+		setup     func(repo *mocks.RepositoryMock)
+		want      []entity.PopularEntity
+		err       error
+	}{
+		{
+			name:      "success/user",
+			ctx:       ctx,
+			req:       req,
+			permitted: permitted,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetPopularMock.Expect(ctx, since, 10, permitted, &userID).Return(popular, nil)
+			},
+			want: popular,
+		},
+		{
+			name:    "success/admin counts everything",
+			ctx:     context.Background(),
+			req:     req,
+			isAdmin: true,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetPopularMock.Expect(context.Background(), since, 10, nil, nil).Return(popular, nil)
+			},
+			want: popular,
+		},
+		{
+			name: "success/nothing readable",
+			ctx:  ctx,
+			req:  req,
+			want: []entity.PopularEntity{},
+		},
+		{
+			name: "error/limit",
+			ctx:  ctx,
+			req:  entity.GetPopularReq{Period: req.Period, Limit: entity.MaxPopularLimit + 1},
+			err:  entity.ErrInvalidPopularLimit(entity.MaxPopularLimit),
+		},
+		{
+			name: "error/period too short",
+			ctx:  ctx,
+			req:  entity.GetPopularReq{Period: time.Minute, Limit: 10},
+			err:  entity.ErrInvalidPeriod(entity.MaxPopularPeriodDays),
+		},
+		{
+			name: "error/period too long",
+			ctx:  ctx,
+			req:  entity.GetPopularReq{Period: 400 * 24 * time.Hour, Limit: 10},
+			err:  entity.ErrInvalidPeriod(entity.MaxPopularPeriodDays),
+		},
+		{
+			name:      "error/no_user_in_context",
+			ctx:       context.Background(),
+			req:       req,
+			permitted: permitted,
+			err:       apperr.ErrUnauthorized(),
+		},
+		{
+			name:      "error/repo_error",
+			ctx:       ctx,
+			req:       req,
+			permitted: permitted,
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetPopularMock.Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Optional().Return(now)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := entity.NewCore(repo, entity.Generators{ID: mocks.NewIDGeneratorMock(t), Time: timeGen}, mocks.NewValidatorMock(t), Cfg())
+			require.NoError(t, err)
+
+			got, err := c.GetPopular(tt.ctx, tt.req, tt.permitted, tt.isAdmin)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entity.PopularReport{Since: since, Entities: tt.want}, got)
+		})
+	}
+}
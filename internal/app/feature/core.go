@@ -0,0 +1,312 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	GetOverrides(ctx context.Context) ([]Override, error)
+	// SaveOverride inserts or replaces the override of the flag.
+	SaveOverride(ctx context.Context, override Override) error
+	// DeleteOverride removes the override of the flag; it reports whether there was one.
+	DeleteOverride(ctx context.Context, name string) (bool, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// namePattern keeps flag names usable as config keys, which viper splits on dots and lowercases.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+// Config declares the known flags with their default rules. Overrides stored by admins replace a
+// rule until they are deleted, and are re-read every refresh_seconds so all instances pick them up.
+type Config struct {
+	Flags          map[string]Rule `mapstructure:"flags" json:"flags"`
+	RefreshSeconds int             `mapstructure:"refresh_seconds" json:"refresh_seconds"`
+}
+
+func (c Config) Validate() error {
+	if c.RefreshSeconds <= 0 {
+		return fmt.Errorf("refresh_seconds must be positive")
+	}
+	for name, rule := range c.Flags {
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("flags: invalid name %q", name)
+		}
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("flags.%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Rule decides for whom a flag is on. The conditions add up: the flag is on for everyone if Enabled,
+// otherwise for the listed users and for a stable Percentage of the others. The zero rule turns it off.
+type Rule struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// Percentage of users, from 0 to 100, chosen by a hash of the flag name and the user ID, so a
+	// user keeps their place as the percentage grows and flags are rolled out to different users.
+	Percentage int      `mapstructure:"percentage" json:"percentage"`
+	Users      []string `mapstructure:"users" json:"users,omitempty"`
+}
+
+func (r Rule) Validate() error {
+	if r.Percentage < 0 || r.Percentage > 100 {
+		return fmt.Errorf("percentage must be between 0 and 100")
+	}
+	for _, s := range r.Users {
+		if _, err := uuid.Parse(s); err != nil {
+			return fmt.Errorf("users: invalid id %q", s)
+		}
+	}
+
+	return nil
+}
+
+// compiledRule is a validated Rule with the users parsed for lookup.
+type compiledRule struct {
+	Rule
+	users map[uuid.UUID]struct{}
+}
+
+func compile(r Rule) compiledRule {
+	users := make(map[uuid.UUID]struct{}, len(r.Users))
+	for _, s := range r.Users {
+		users[uuid.MustParse(s)] = struct{}{}
+	}
+
+	return compiledRule{Rule: r, users: users}
+}
+
+func (r compiledRule) enabledFor(name string, userID *uuid.UUID) bool {
+	if r.Enabled {
+		return true
+	}
+	if userID == nil {
+		return false
+	}
+	if _, ok := r.users[*userID]; ok {
+		return true
+	}
+
+	return bucket(name, *userID) < r.Percentage
+}
+
+// bucket places the user in one of 100 buckets of the flag.
+func bucket(name string, userID uuid.UUID) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	_, _ = h.Write(userID[:])
+
+	return int(h.Sum32() % 100)
+}
+
+// core evaluates flags on hot paths, so it keeps the overrides in memory and re-reads them when they
+// are older than Config.RefreshSeconds. A failed refresh keeps the previous overrides.
+type core struct {
+	repo    Repository
+	timeGen TimeGenerator
+	cfg     Config
+	flags   map[string]compiledRule
+
+	mu        sync.RWMutex
+	overrides map[string]compiledOverride
+	loadedAt  time.Time
+}
+
+type compiledOverride struct {
+	Override
+	rule compiledRule
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil {
+		return nil, fmt.Errorf("feature.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("feature.NewCore: %w", err)
+	}
+
+	flags := make(map[string]compiledRule, len(cfg.Flags))
+	for name, rule := range cfg.Flags {
+		flags[name] = compile(rule)
+	}
+
+	return &core{repo: repo, timeGen: timeGen, cfg: cfg, flags: flags}, nil
+}
+
+// Checker is what the usecase layer depends on to branch on a flag. The core implements it.
+type Checker interface {
+	IsEnabled(ctx context.Context, name string) (bool, error)
+}
+
+// Enabled is Checker.IsEnabled for use in a branch: an error is logged and the flag counts as off,
+// so a feature never turns on because its flag could not be read.
+func Enabled(ctx context.Context, checker Checker, name string) bool {
+	enabled, err := checker.IsEnabled(ctx, name)
+	if err != nil {
+		logger.Error(ctx, err).Str(FieldName.String(), name).Msg("feature.Enabled: IsEnabled")
+		return false
+	}
+
+	return enabled
+}
+
+// IsEnabled reports whether the flag is on for the current user, or for anonymous callers if there is
+// none. Unknown flags are off, so code can check a flag before it is declared.
+func (c *core) IsEnabled(ctx context.Context, name string) (bool, error) {
+	var userID *uuid.UUID
+	if id, err := contextx.GetUserID(ctx); err == nil {
+		userID = &id
+	}
+
+	return c.IsEnabledFor(ctx, name, userID)
+}
+
+// IsEnabledFor reports whether the flag is on for userID (nil: anonymous).
+func (c *core) IsEnabledFor(ctx context.Context, name string, userID *uuid.UUID) (bool, error) {
+	overrides, err := c.getOverrides(ctx)
+	if err != nil {
+		return false, fmt.Errorf("feature.core.IsEnabledFor: %w", err)
+	}
+	if o, ok := overrides[name]; ok {
+		return o.rule.enabledFor(name, userID), nil
+	}
+	rule, ok := c.flags[name]
+	if !ok {
+		return false, nil
+	}
+
+	return rule.enabledFor(name, userID), nil
+}
+
+// GetEnabled returns the names of the flags that are on for userID, ordered by name.
+func (c *core) GetEnabled(ctx context.Context, userID *uuid.UUID) ([]string, error) {
+	flags, err := c.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("feature.core.GetEnabled: %w", err)
+	}
+
+	enabled := make([]string, 0, len(flags))
+	for _, f := range flags {
+		if compile(f.Rule).enabledFor(f.Name, userID) {
+			enabled = append(enabled, f.Name)
+		}
+	}
+
+	return enabled, nil
+}
+
+// List returns the declared flags with the rules in force, ordered by name.
+func (c *core) List(ctx context.Context) ([]Flag, error) {
+	overrides, err := c.getOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("feature.core.List: %w", err)
+	}
+
+	flags := make([]Flag, 0, len(c.flags))
+	for name, rule := range c.flags {
+		flag := Flag{Name: name, Rule: rule.Rule, Default: rule.Rule, Source: SourceConfig}
+		if o, ok := overrides[name]; ok {
+			flag.Rule, flag.Source = o.Rule, SourceOverride
+			flag.UpdatedBy, flag.UpdatedAt = o.UpdatedBy, &o.UpdatedAt
+		}
+		flags = append(flags, flag)
+	}
+	slices.SortFunc(flags, func(a, b Flag) int { return strings.Compare(a.Name, b.Name) })
+
+	return flags, nil
+}
+
+// SetOverride replaces the rule of a declared flag until the override is deleted.
+func (c *core) SetOverride(ctx context.Context, name string, rule Rule, userID uuid.UUID) error {
+	if _, ok := c.flags[name]; !ok {
+		return fmt.Errorf("feature.core.SetOverride: %w", ErrFlagNotFound())
+	}
+	if err := rule.Validate(); err != nil {
+		return fmt.Errorf("feature.core.SetOverride: %w", ErrInvalidRule(err.Error()))
+	}
+
+	override := Override{Name: name, Rule: rule, UpdatedBy: &userID, UpdatedAt: c.timeGen.Now().UTC()}
+	if err := c.repo.SaveOverride(ctx, override); err != nil {
+		return fmt.Errorf("feature.core.SetOverride: %w", err)
+	}
+	c.invalidate()
+
+	return nil
+}
+
+// DeleteOverride restores the configured rule of the flag.
+func (c *core) DeleteOverride(ctx context.Context, name string) error {
+	if _, ok := c.flags[name]; !ok {
+		return fmt.Errorf("feature.core.DeleteOverride: %w", ErrFlagNotFound())
+	}
+
+	deleted, err := c.repo.DeleteOverride(ctx, name)
+	if err != nil {
+		return fmt.Errorf("feature.core.DeleteOverride: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("feature.core.DeleteOverride: %w", ErrOverrideNotFound())
+	}
+	c.invalidate()
+
+	return nil
+}
+
+func (c *core) getOverrides(ctx context.Context) (map[string]compiledOverride, error) {
+	now := c.timeGen.Now()
+
+	c.mu.RLock()
+	overrides, loadedAt := c.overrides, c.loadedAt
+	c.mu.RUnlock()
+	if overrides != nil && now.Sub(loadedAt) < time.Duration(c.cfg.RefreshSeconds)*time.Second {
+		return overrides, nil
+	}
+
+	stored, err := c.repo.GetOverrides(ctx)
+	if err != nil {
+		if overrides == nil {
+			return nil, fmt.Errorf("feature.core.getOverrides: %w", err)
+		}
+		logger.Error(ctx, err).Msg("feature.core.getOverrides: refresh failed, keeping the previous overrides")
+		c.mu.Lock()
+		c.loadedAt = now
+		c.mu.Unlock()
+		return overrides, nil
+	}
+	overrides = make(map[string]compiledOverride, len(stored))
+	for _, o := range stored {
+		// a stored rule that no longer validates, or of a flag no longer declared, is ignored
+		if _, ok := c.flags[o.Name]; !ok || o.Rule.Validate() != nil {
+			continue
+		}
+		overrides[o.Name] = compiledOverride{Override: o, rule: compile(o.Rule)}
+	}
+
+	c.mu.Lock()
+	c.overrides, c.loadedAt = overrides, now
+	c.mu.Unlock()
+
+	return overrides, nil
+}
+
+// invalidate makes the next evaluation re-read the overrides, so a change applies on this instance at once.
+func (c *core) invalidate() {
+	c.mu.Lock()
+	c.overrides = nil
+	c.mu.Unlock()
+}
@@ -0,0 +1,332 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/app/feature/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+var (
+	listedUser = uuid.MustParse("6f1c2a9e-3b4d-4e5f-8a7b-9c0d1e2f3a4b")
+	now        = time.Date(2025, 9, 28, 10, 0, 0, 0, time.UTC)
+)
+
+func cfg() feature.Config {
+	return feature.Config{
+		Flags: map[string]feature.Rule{
+			"on":      {Enabled: true},
+			"off":     {},
+			"listed":  {Users: []string{listedUser.String()}},
+			"half":    {Percentage: 50},
+			"rollout": {Percentage: 100},
+		},
+		RefreshSeconds: 30,
+	}
+}
+
+// flagCore is the method set of the unexported core.
+type flagCore interface {
+	feature.Checker
+	IsEnabledFor(ctx context.Context, name string, userID *uuid.UUID) (bool, error)
+	GetEnabled(ctx context.Context, userID *uuid.UUID) ([]string, error)
+	List(ctx context.Context) ([]feature.Flag, error)
+	SetOverride(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) error
+	DeleteOverride(ctx context.Context, name string) error
+}
+
+func newCore(t *testing.T, config feature.Config) (*mocks.RepositoryMock, *mocks.TimeGeneratorMock, flagCore) {
+	t.Helper()
+	repo := mocks.NewRepositoryMock(t)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	c, err := feature.NewCore(repo, timeGen, config)
+	require.NoError(t, err)
+	return repo, timeGen, c
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(c *feature.Config)
+		ok     bool
+	}{
+		{name: "ok", modify: func(c *feature.Config) {}, ok: true},
+		{name: "no flags", modify: func(c *feature.Config) { c.Flags = nil }, ok: true},
+		{name: "no refresh interval", modify: func(c *feature.Config) { c.RefreshSeconds = 0 }},
+		{name: "invalid name", modify: func(c *feature.Config) { c.Flags["New.Editor"] = feature.Rule{} }},
+		{name: "percentage above 100", modify: func(c *feature.Config) { c.Flags["half"] = feature.Rule{Percentage: 101} }},
+		{name: "negative percentage", modify: func(c *feature.Config) { c.Flags["half"] = feature.Rule{Percentage: -1} }},
+		{name: "invalid user", modify: func(c *feature.Config) { c.Flags["listed"] = feature.Rule{Users: []string{"ann"}} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := cfg()
+			tt.modify(&c)
+			err := c.Validate()
+			if tt.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := feature.NewCore(nil, mocks.NewTimeGeneratorMock(t), cfg())
+	require.Error(t, err)
+	_, err = feature.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), feature.Config{})
+	require.Error(t, err)
+}
+
+func TestCore_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	other := uuid.MustParse("0a1b2c3d-4e5f-4a6b-8c7d-8e9f0a1b2c3d")
+	tests := []struct {
+		name   string
+		flag   string
+		userID *uuid.UUID
+		want   bool
+	}{
+		{name: "enabled for everyone", flag: "on", want: true},
+		{name: "off", flag: "off", userID: &listedUser},
+		{name: "unknown flag is off", flag: "unknown", userID: &listedUser},
+		{name: "listed user", flag: "listed", userID: &listedUser, want: true},
+		{name: "user not listed", flag: "listed", userID: &other},
+		{name: "anonymous not listed", flag: "listed"},
+		{name: "full rollout", flag: "rollout", userID: &other, want: true},
+		{name: "rollout skips anonymous", flag: "rollout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo, timeGen, c := newCore(t, cfg())
+			timeGen.NowMock.Return(now)
+			repo.GetOverridesMock.Return(nil, nil)
+
+			ctx := t.Context()
+			if tt.userID != nil {
+				ctx = contextx.SetUserID(ctx, *tt.userID)
+			}
+			got, err := c.IsEnabled(ctx, tt.flag)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_IsEnabledFor_Percentage(t *testing.T) {
+	t.Parallel()
+
+	repo, timeGen, c := newCore(t, cfg())
+	timeGen.NowMock.Return(now)
+	repo.GetOverridesMock.Return(nil, nil)
+
+	var on int
+	for range 1000 {
+		userID := uuid.New()
+		first, err := c.IsEnabledFor(t.Context(), "half", &userID)
+		require.NoError(t, err)
+		again, err := c.IsEnabledFor(t.Context(), "half", &userID)
+		require.NoError(t, err)
+		require.Equal(t, first, again, "a user keeps their bucket")
+		if first {
+			on++
+		}
+	}
+	require.InDelta(t, 500, on, 100)
+}
+
+func TestCore_Overrides(t *testing.T) {
+	t.Parallel()
+
+	ctx := contextx.SetUserID(t.Context(), listedUser)
+	override := feature.Override{Name: "on", Rule: feature.Rule{}, UpdatedAt: now}
+
+	t.Run("replace the configured rule and are cached", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Return(now)
+		repo.GetOverridesMock.Times(1).Return([]feature.Override{override}, nil)
+
+		for range 2 {
+			got, err := c.IsEnabled(ctx, "on")
+			require.NoError(t, err)
+			require.False(t, got)
+		}
+	})
+	t.Run("re-read after the refresh interval", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Set(func() time.Time { return now.Add(time.Duration(timeGen.NowAfterCounter()) * 20 * time.Second) })
+		repo.GetOverridesMock.Times(2).Return(nil, nil)
+
+		for range 3 {
+			_, err := c.IsEnabled(ctx, "on")
+			require.NoError(t, err)
+		}
+	})
+	t.Run("overrides of undeclared flags are ignored", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Return(now)
+		repo.GetOverridesMock.Return([]feature.Override{{Name: "removed", Rule: feature.Rule{Enabled: true}}}, nil)
+
+		got, err := c.IsEnabled(ctx, "removed")
+		require.NoError(t, err)
+		require.False(t, got)
+	})
+	t.Run("first load error", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Return(now)
+		repo.GetOverridesMock.Return(nil, errors.New("exp"))
+
+		_, err := c.IsEnabled(ctx, "on")
+		require.Error(t, err)
+		require.False(t, feature.Enabled(ctx, c, "on"))
+	})
+	t.Run("failed refresh keeps the previous overrides", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Set(func() time.Time { return now.Add(time.Duration(timeGen.NowAfterCounter()) * time.Minute) })
+		repo.GetOverridesMock.Set(func(_ context.Context) ([]feature.Override, error) {
+			if repo.GetOverridesAfterCounter() == 0 {
+				return []feature.Override{override}, nil
+			}
+			return nil, errors.New("exp")
+		})
+
+		for range 2 {
+			got, err := c.IsEnabled(ctx, "on")
+			require.NoError(t, err)
+			require.False(t, got)
+		}
+	})
+}
+
+func TestCore_SetOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	rule := feature.Rule{Percentage: 20, Users: []string{listedUser.String()}}
+
+	t.Run("ok, applied at once", func(t *testing.T) {
+		t.Parallel()
+		repo, timeGen, c := newCore(t, cfg())
+		timeGen.NowMock.Return(now)
+		repo.GetOverridesMock.Return(nil, nil)
+
+		got, err := c.IsEnabled(contextx.SetUserID(ctx, listedUser), "off")
+		require.NoError(t, err)
+		require.False(t, got)
+
+		repo.SaveOverrideMock.Expect(minimock.AnyContext, feature.Override{Name: "off", Rule: rule, UpdatedBy: &listedUser, UpdatedAt: now}).Return(nil)
+		require.NoError(t, c.SetOverride(ctx, "off", rule, listedUser))
+
+		repo.GetOverridesMock.Return([]feature.Override{{Name: "off", Rule: rule}}, nil)
+		got, err = c.IsEnabled(contextx.SetUserID(ctx, listedUser), "off")
+		require.NoError(t, err)
+		require.True(t, got)
+	})
+	t.Run("undeclared flag", func(t *testing.T) {
+		t.Parallel()
+		_, _, c := newCore(t, cfg())
+		require.ErrorIs(t, c.SetOverride(ctx, "unknown", rule, listedUser), feature.ErrFlagNotFound())
+	})
+	t.Run("invalid rule", func(t *testing.T) {
+		t.Parallel()
+		_, _, c := newCore(t, cfg())
+		err := c.SetOverride(ctx, "off", feature.Rule{Percentage: 200}, listedUser)
+		require.ErrorIs(t, err, feature.ErrInvalidRule(""))
+	})
+}
+
+func TestCore_DeleteOverride(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		flag    string
+		setup   func(repo *mocks.RepositoryMock)
+		wantErr error
+	}{
+		{
+			name: "ok",
+			flag: "on",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.DeleteOverrideMock.Expect(minimock.AnyContext, "on").Return(true, nil)
+			},
+		},
+		{name: "undeclared flag", flag: "unknown", setup: func(*mocks.RepositoryMock) {}, wantErr: feature.ErrFlagNotFound()},
+		{
+			name:    "no override",
+			flag:    "on",
+			setup:   func(repo *mocks.RepositoryMock) { repo.DeleteOverrideMock.Return(false, nil) },
+			wantErr: feature.ErrOverrideNotFound(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo, _, c := newCore(t, cfg())
+			tt.setup(repo)
+
+			err := c.DeleteOverride(t.Context(), tt.flag)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_List(t *testing.T) {
+	t.Parallel()
+
+	repo, timeGen, c := newCore(t, feature.Config{
+		Flags:          map[string]feature.Rule{"b": {Enabled: true}, "a": {Percentage: 10}},
+		RefreshSeconds: 30,
+	})
+	timeGen.NowMock.Return(now)
+	repo.GetOverridesMock.Return([]feature.Override{{Name: "b", UpdatedBy: &listedUser, UpdatedAt: now}}, nil)
+
+	got, err := c.List(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []feature.Flag{
+		{Name: "a", Rule: feature.Rule{Percentage: 10}, Default: feature.Rule{Percentage: 10}, Source: feature.SourceConfig},
+		{Name: "b", Default: feature.Rule{Enabled: true}, Source: feature.SourceOverride, UpdatedBy: &listedUser, UpdatedAt: &now},
+	}, got)
+
+	enabled, err := c.GetEnabled(t.Context(), &listedUser)
+	require.NoError(t, err)
+	require.Empty(t, enabled)
+}
+
+func TestEnabled(t *testing.T) {
+	t.Parallel()
+
+	checker := mocks.NewCheckerMock(t)
+	checker.IsEnabledMock.Expect(minimock.AnyContext, "on").Return(true, nil)
+	require.True(t, feature.Enabled(t.Context(), checker, "on"))
+
+	checker = mocks.NewCheckerMock(t)
+	checker.IsEnabledMock.Return(true, errors.New("exp"))
+	require.False(t, feature.Enabled(t.Context(), checker, "on"))
+}
@@ -0,0 +1,39 @@
+package feature
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldName apperr.Field = "name"
+	FieldRule apperr.Field = "rule"
+)
+
+// Source tells where the rule in force comes from.
+type Source string
+
+const (
+	SourceConfig   Source = "config"
+	SourceOverride Source = "override"
+)
+
+// Override is a rule stored by an admin that replaces the configured one.
+type Override struct {
+	Name      string
+	Rule      Rule
+	UpdatedBy *uuid.UUID
+	UpdatedAt time.Time
+}
+
+// Flag is a declared flag with the rule in force and, if it was overridden, the configured Default.
+type Flag struct {
+	Name      string     `json:"name"`
+	Rule      Rule       `json:"rule"`
+	Default   Rule       `json:"default"`
+	Source    Source     `json:"source"`
+	UpdatedBy *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
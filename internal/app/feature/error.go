@@ -0,0 +1,31 @@
+package feature
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeValidationFailed apperr.Code = "feature/validation_failed"
+	CodeFlagNotFound     apperr.Code = "feature/flag_not_found"
+	CodeOverrideNotFound apperr.Code = "feature/override_not_found"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid feature flag rule", apperr.ClassBadRequest)
+	apperr.Register(CodeFlagNotFound, "Feature flag not found", apperr.ClassNotFound)
+	apperr.Register(CodeOverrideNotFound, "Feature flag not overridden", apperr.ClassNotFound)
+}
+
+// ErrFlagNotFound is returned for a flag that is not declared in the config: only declared flags can be overridden.
+func ErrFlagNotFound() error {
+	return apperr.New("Feature flag not found", CodeFlagNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrOverrideNotFound() error {
+	return apperr.New("The feature flag uses its configured rule", CodeOverrideNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+// ErrInvalidRule carries the validation problem to the user: overrides are set by admins only.
+func ErrInvalidRule(problem string) error {
+	return apperr.New("Invalid feature flag rule", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithUserMessage("Invalid feature flag rule: " + problem).
+		WithViolation(apperr.Violation{Field: FieldRule, Rule: apperr.RuleInvalidFormat})
+}
@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/feature.Checker -o checker_mock.go -n CheckerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CheckerMock implements mm_feature.Checker
+type CheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcIsEnabled          func(ctx context.Context, name string) (b1 bool, err error)
+	funcIsEnabledOrigin    string
+	inspectFuncIsEnabled   func(ctx context.Context, name string)
+	afterIsEnabledCounter  uint64
+	beforeIsEnabledCounter uint64
+	IsEnabledMock          mCheckerMockIsEnabled
+}
+
+// NewCheckerMock returns a mock for mm_feature.Checker
+func NewCheckerMock(t minimock.Tester) *CheckerMock {
+	m := &CheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.IsEnabledMock = mCheckerMockIsEnabled{mock: m}
+	m.IsEnabledMock.callArgs = []*CheckerMockIsEnabledParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCheckerMockIsEnabled struct {
+	optional           bool
+	mock               *CheckerMock
+	defaultExpectation *CheckerMockIsEnabledExpectation
+	expectations       []*CheckerMockIsEnabledExpectation
+
+	callArgs []*CheckerMockIsEnabledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CheckerMockIsEnabledExpectation specifies expectation struct of the Checker.IsEnabled
+type CheckerMockIsEnabledExpectation struct {
+	mock               *CheckerMock
+	params             *CheckerMockIsEnabledParams
+	paramPtrs          *CheckerMockIsEnabledParamPtrs
+	expectationOrigins CheckerMockIsEnabledExpectationOrigins
+	results            *CheckerMockIsEnabledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CheckerMockIsEnabledParams contains parameters of the Checker.IsEnabled
+type CheckerMockIsEnabledParams struct {
+	ctx  context.Context
+	name string
+}
+
+// CheckerMockIsEnabledParamPtrs contains pointers to parameters of the Checker.IsEnabled
+type CheckerMockIsEnabledParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// CheckerMockIsEnabledResults contains results of the Checker.IsEnabled
+type CheckerMockIsEnabledResults struct {
+	b1  bool
+	err error
+}
+
+// CheckerMockIsEnabledOrigins contains origins of expectations of the Checker.IsEnabled
+type CheckerMockIsEnabledExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsEnabled *mCheckerMockIsEnabled) Optional() *mCheckerMockIsEnabled {
+	mmIsEnabled.optional = true
+	return mmIsEnabled
+}
+
+// Expect sets up expected params for Checker.IsEnabled
+func (mmIsEnabled *mCheckerMockIsEnabled) Expect(ctx context.Context, name string) *mCheckerMockIsEnabled {
+	if mmIsEnabled.mock.funcIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Set")
+	}
+
+	if mmIsEnabled.defaultExpectation == nil {
+		mmIsEnabled.defaultExpectation = &CheckerMockIsEnabledExpectation{}
+	}
+
+	if mmIsEnabled.defaultExpectation.paramPtrs != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by ExpectParams functions")
+	}
+
+	mmIsEnabled.defaultExpectation.params = &CheckerMockIsEnabledParams{ctx, name}
+	mmIsEnabled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsEnabled.expectations {
+		if minimock.Equal(e.params, mmIsEnabled.defaultExpectation.params) {
+			mmIsEnabled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsEnabled.defaultExpectation.params)
+		}
+	}
+
+	return mmIsEnabled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Checker.IsEnabled
+func (mmIsEnabled *mCheckerMockIsEnabled) ExpectCtxParam1(ctx context.Context) *mCheckerMockIsEnabled {
+	if mmIsEnabled.mock.funcIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Set")
+	}
+
+	if mmIsEnabled.defaultExpectation == nil {
+		mmIsEnabled.defaultExpectation = &CheckerMockIsEnabledExpectation{}
+	}
+
+	if mmIsEnabled.defaultExpectation.params != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Expect")
+	}
+
+	if mmIsEnabled.defaultExpectation.paramPtrs == nil {
+		mmIsEnabled.defaultExpectation.paramPtrs = &CheckerMockIsEnabledParamPtrs{}
+	}
+	mmIsEnabled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsEnabled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsEnabled
+}
+
+// ExpectNameParam2 sets up expected param name for Checker.IsEnabled
+func (mmIsEnabled *mCheckerMockIsEnabled) ExpectNameParam2(name string) *mCheckerMockIsEnabled {
+	if mmIsEnabled.mock.funcIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Set")
+	}
+
+	if mmIsEnabled.defaultExpectation == nil {
+		mmIsEnabled.defaultExpectation = &CheckerMockIsEnabledExpectation{}
+	}
+
+	if mmIsEnabled.defaultExpectation.params != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Expect")
+	}
+
+	if mmIsEnabled.defaultExpectation.paramPtrs == nil {
+		mmIsEnabled.defaultExpectation.paramPtrs = &CheckerMockIsEnabledParamPtrs{}
+	}
+	mmIsEnabled.defaultExpectation.paramPtrs.name = &name
+	mmIsEnabled.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmIsEnabled
+}
+
+// Inspect accepts an inspector function that has same arguments as the Checker.IsEnabled
+func (mmIsEnabled *mCheckerMockIsEnabled) Inspect(f func(ctx context.Context, name string)) *mCheckerMockIsEnabled {
+	if mmIsEnabled.mock.inspectFuncIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("Inspect function is already set for CheckerMock.IsEnabled")
+	}
+
+	mmIsEnabled.mock.inspectFuncIsEnabled = f
+
+	return mmIsEnabled
+}
+
+// Return sets up results that will be returned by Checker.IsEnabled
+func (mmIsEnabled *mCheckerMockIsEnabled) Return(b1 bool, err error) *CheckerMock {
+	if mmIsEnabled.mock.funcIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Set")
+	}
+
+	if mmIsEnabled.defaultExpectation == nil {
+		mmIsEnabled.defaultExpectation = &CheckerMockIsEnabledExpectation{mock: mmIsEnabled.mock}
+	}
+	mmIsEnabled.defaultExpectation.results = &CheckerMockIsEnabledResults{b1, err}
+	mmIsEnabled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsEnabled.mock
+}
+
+// Set uses given function f to mock the Checker.IsEnabled method
+func (mmIsEnabled *mCheckerMockIsEnabled) Set(f func(ctx context.Context, name string) (b1 bool, err error)) *CheckerMock {
+	if mmIsEnabled.defaultExpectation != nil {
+		mmIsEnabled.mock.t.Fatalf("Default expectation is already set for the Checker.IsEnabled method")
+	}
+
+	if len(mmIsEnabled.expectations) > 0 {
+		mmIsEnabled.mock.t.Fatalf("Some expectations are already set for the Checker.IsEnabled method")
+	}
+
+	mmIsEnabled.mock.funcIsEnabled = f
+	mmIsEnabled.mock.funcIsEnabledOrigin = minimock.CallerInfo(1)
+	return mmIsEnabled.mock
+}
+
+// When sets expectation for the Checker.IsEnabled which will trigger the result defined by the following
+// Then helper
+func (mmIsEnabled *mCheckerMockIsEnabled) When(ctx context.Context, name string) *CheckerMockIsEnabledExpectation {
+	if mmIsEnabled.mock.funcIsEnabled != nil {
+		mmIsEnabled.mock.t.Fatalf("CheckerMock.IsEnabled mock is already set by Set")
+	}
+
+	expectation := &CheckerMockIsEnabledExpectation{
+		mock:               mmIsEnabled.mock,
+		params:             &CheckerMockIsEnabledParams{ctx, name},
+		expectationOrigins: CheckerMockIsEnabledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsEnabled.expectations = append(mmIsEnabled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Checker.IsEnabled return parameters for the expectation previously defined by the When method
+func (e *CheckerMockIsEnabledExpectation) Then(b1 bool, err error) *CheckerMock {
+	e.results = &CheckerMockIsEnabledResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Checker.IsEnabled should be invoked
+func (mmIsEnabled *mCheckerMockIsEnabled) Times(n uint64) *mCheckerMockIsEnabled {
+	if n == 0 {
+		mmIsEnabled.mock.t.Fatalf("Times of CheckerMock.IsEnabled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsEnabled.expectedInvocations, n)
+	mmIsEnabled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsEnabled
+}
+
+func (mmIsEnabled *mCheckerMockIsEnabled) invocationsDone() bool {
+	if len(mmIsEnabled.expectations) == 0 && mmIsEnabled.defaultExpectation == nil && mmIsEnabled.mock.funcIsEnabled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsEnabled.mock.afterIsEnabledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsEnabled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsEnabled implements mm_feature.Checker
+func (mmIsEnabled *CheckerMock) IsEnabled(ctx context.Context, name string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsEnabled.beforeIsEnabledCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsEnabled.afterIsEnabledCounter, 1)
+
+	mmIsEnabled.t.Helper()
+
+	if mmIsEnabled.inspectFuncIsEnabled != nil {
+		mmIsEnabled.inspectFuncIsEnabled(ctx, name)
+	}
+
+	mm_params := CheckerMockIsEnabledParams{ctx, name}
+
+	// Record call args
+	mmIsEnabled.IsEnabledMock.mutex.Lock()
+	mmIsEnabled.IsEnabledMock.callArgs = append(mmIsEnabled.IsEnabledMock.callArgs, &mm_params)
+	mmIsEnabled.IsEnabledMock.mutex.Unlock()
+
+	for _, e := range mmIsEnabled.IsEnabledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsEnabled.IsEnabledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsEnabled.IsEnabledMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsEnabled.IsEnabledMock.defaultExpectation.params
+		mm_want_ptrs := mmIsEnabled.IsEnabledMock.defaultExpectation.paramPtrs
+
+		mm_got := CheckerMockIsEnabledParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsEnabled.t.Errorf("CheckerMock.IsEnabled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsEnabled.IsEnabledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmIsEnabled.t.Errorf("CheckerMock.IsEnabled got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsEnabled.IsEnabledMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsEnabled.t.Errorf("CheckerMock.IsEnabled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsEnabled.IsEnabledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsEnabled.IsEnabledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsEnabled.t.Fatal("No results are set for the CheckerMock.IsEnabled")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsEnabled.funcIsEnabled != nil {
+		return mmIsEnabled.funcIsEnabled(ctx, name)
+	}
+	mmIsEnabled.t.Fatalf("Unexpected call to CheckerMock.IsEnabled. %v %v", ctx, name)
+	return
+}
+
+// IsEnabledAfterCounter returns a count of finished CheckerMock.IsEnabled invocations
+func (mmIsEnabled *CheckerMock) IsEnabledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsEnabled.afterIsEnabledCounter)
+}
+
+// IsEnabledBeforeCounter returns a count of CheckerMock.IsEnabled invocations
+func (mmIsEnabled *CheckerMock) IsEnabledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsEnabled.beforeIsEnabledCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckerMock.IsEnabled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsEnabled *mCheckerMockIsEnabled) Calls() []*CheckerMockIsEnabledParams {
+	mmIsEnabled.mutex.RLock()
+
+	argCopy := make([]*CheckerMockIsEnabledParams, len(mmIsEnabled.callArgs))
+	copy(argCopy, mmIsEnabled.callArgs)
+
+	mmIsEnabled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsEnabledDone returns true if the count of the IsEnabled invocations corresponds
+// the number of defined expectations
+func (m *CheckerMock) MinimockIsEnabledDone() bool {
+	if m.IsEnabledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsEnabledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsEnabledMock.invocationsDone()
+}
+
+// MinimockIsEnabledInspect logs each unmet expectation
+func (m *CheckerMock) MinimockIsEnabledInspect() {
+	for _, e := range m.IsEnabledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckerMock.IsEnabled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsEnabledCounter := mm_atomic.LoadUint64(&m.afterIsEnabledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsEnabledMock.defaultExpectation != nil && afterIsEnabledCounter < 1 {
+		if m.IsEnabledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CheckerMock.IsEnabled at\n%s", m.IsEnabledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CheckerMock.IsEnabled at\n%s with params: %#v", m.IsEnabledMock.defaultExpectation.expectationOrigins.origin, *m.IsEnabledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsEnabled != nil && afterIsEnabledCounter < 1 {
+		m.t.Errorf("Expected call to CheckerMock.IsEnabled at\n%s", m.funcIsEnabledOrigin)
+	}
+
+	if !m.IsEnabledMock.invocationsDone() && afterIsEnabledCounter > 0 {
+		m.t.Errorf("Expected %d calls to CheckerMock.IsEnabled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsEnabledMock.expectedInvocations), m.IsEnabledMock.expectedInvocationsOrigin, afterIsEnabledCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockIsEnabledInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockIsEnabledDone()
+}
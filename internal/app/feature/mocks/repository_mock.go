@@ -0,0 +1,1098 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/feature.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_feature "github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_feature.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteOverride          func(ctx context.Context, name string) (b1 bool, err error)
+	funcDeleteOverrideOrigin    string
+	inspectFuncDeleteOverride   func(ctx context.Context, name string)
+	afterDeleteOverrideCounter  uint64
+	beforeDeleteOverrideCounter uint64
+	DeleteOverrideMock          mRepositoryMockDeleteOverride
+
+	funcGetOverrides          func(ctx context.Context) (oa1 []mm_feature.Override, err error)
+	funcGetOverridesOrigin    string
+	inspectFuncGetOverrides   func(ctx context.Context)
+	afterGetOverridesCounter  uint64
+	beforeGetOverridesCounter uint64
+	GetOverridesMock          mRepositoryMockGetOverrides
+
+	funcSaveOverride          func(ctx context.Context, override mm_feature.Override) (err error)
+	funcSaveOverrideOrigin    string
+	inspectFuncSaveOverride   func(ctx context.Context, override mm_feature.Override)
+	afterSaveOverrideCounter  uint64
+	beforeSaveOverrideCounter uint64
+	SaveOverrideMock          mRepositoryMockSaveOverride
+}
+
+// NewRepositoryMock returns a mock for mm_feature.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteOverrideMock = mRepositoryMockDeleteOverride{mock: m}
+	m.DeleteOverrideMock.callArgs = []*RepositoryMockDeleteOverrideParams{}
+
+	m.GetOverridesMock = mRepositoryMockGetOverrides{mock: m}
+	m.GetOverridesMock.callArgs = []*RepositoryMockGetOverridesParams{}
+
+	m.SaveOverrideMock = mRepositoryMockSaveOverride{mock: m}
+	m.SaveOverrideMock.callArgs = []*RepositoryMockSaveOverrideParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockDeleteOverride struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteOverrideExpectation
+	expectations       []*RepositoryMockDeleteOverrideExpectation
+
+	callArgs []*RepositoryMockDeleteOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteOverrideExpectation specifies expectation struct of the Repository.DeleteOverride
+type RepositoryMockDeleteOverrideExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteOverrideParams
+	paramPtrs          *RepositoryMockDeleteOverrideParamPtrs
+	expectationOrigins RepositoryMockDeleteOverrideExpectationOrigins
+	results            *RepositoryMockDeleteOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteOverrideParams contains parameters of the Repository.DeleteOverride
+type RepositoryMockDeleteOverrideParams struct {
+	ctx  context.Context
+	name string
+}
+
+// RepositoryMockDeleteOverrideParamPtrs contains pointers to parameters of the Repository.DeleteOverride
+type RepositoryMockDeleteOverrideParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// RepositoryMockDeleteOverrideResults contains results of the Repository.DeleteOverride
+type RepositoryMockDeleteOverrideResults struct {
+	b1  bool
+	err error
+}
+
+// RepositoryMockDeleteOverrideOrigins contains origins of expectations of the Repository.DeleteOverride
+type RepositoryMockDeleteOverrideExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Optional() *mRepositoryMockDeleteOverride {
+	mmDeleteOverride.optional = true
+	return mmDeleteOverride
+}
+
+// Expect sets up expected params for Repository.DeleteOverride
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Expect(ctx context.Context, name string) *mRepositoryMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &RepositoryMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOverride.defaultExpectation.params = &RepositoryMockDeleteOverrideParams{ctx, name}
+	mmDeleteOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOverride.expectations {
+		if minimock.Equal(e.params, mmDeleteOverride.defaultExpectation.params) {
+			mmDeleteOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteOverride
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &RepositoryMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &RepositoryMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// ExpectNameParam2 sets up expected param name for Repository.DeleteOverride
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) ExpectNameParam2(name string) *mRepositoryMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &RepositoryMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &RepositoryMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.name = &name
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteOverride
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Inspect(f func(ctx context.Context, name string)) *mRepositoryMockDeleteOverride {
+	if mmDeleteOverride.mock.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteOverride")
+	}
+
+	mmDeleteOverride.mock.inspectFuncDeleteOverride = f
+
+	return mmDeleteOverride
+}
+
+// Return sets up results that will be returned by Repository.DeleteOverride
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Return(b1 bool, err error) *RepositoryMock {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &RepositoryMockDeleteOverrideExpectation{mock: mmDeleteOverride.mock}
+	}
+	mmDeleteOverride.defaultExpectation.results = &RepositoryMockDeleteOverrideResults{b1, err}
+	mmDeleteOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteOverride method
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Set(f func(ctx context.Context, name string) (b1 bool, err error)) *RepositoryMock {
+	if mmDeleteOverride.defaultExpectation != nil {
+		mmDeleteOverride.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteOverride method")
+	}
+
+	if len(mmDeleteOverride.expectations) > 0 {
+		mmDeleteOverride.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteOverride method")
+	}
+
+	mmDeleteOverride.mock.funcDeleteOverride = f
+	mmDeleteOverride.mock.funcDeleteOverrideOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// When sets expectation for the Repository.DeleteOverride which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) When(ctx context.Context, name string) *RepositoryMockDeleteOverrideExpectation {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("RepositoryMock.DeleteOverride mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteOverrideExpectation{
+		mock:               mmDeleteOverride.mock,
+		params:             &RepositoryMockDeleteOverrideParams{ctx, name},
+		expectationOrigins: RepositoryMockDeleteOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOverride.expectations = append(mmDeleteOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteOverride return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteOverrideExpectation) Then(b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteOverrideResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteOverride should be invoked
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Times(n uint64) *mRepositoryMockDeleteOverride {
+	if n == 0 {
+		mmDeleteOverride.mock.t.Fatalf("Times of RepositoryMock.DeleteOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOverride.expectedInvocations, n)
+	mmDeleteOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride
+}
+
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) invocationsDone() bool {
+	if len(mmDeleteOverride.expectations) == 0 && mmDeleteOverride.defaultExpectation == nil && mmDeleteOverride.mock.funcDeleteOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.mock.afterDeleteOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOverride implements mm_feature.Repository
+func (mmDeleteOverride *RepositoryMock) DeleteOverride(ctx context.Context, name string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeleteOverride.beforeDeleteOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOverride.afterDeleteOverrideCounter, 1)
+
+	mmDeleteOverride.t.Helper()
+
+	if mmDeleteOverride.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.inspectFuncDeleteOverride(ctx, name)
+	}
+
+	mm_params := RepositoryMockDeleteOverrideParams{ctx, name}
+
+	// Record call args
+	mmDeleteOverride.DeleteOverrideMock.mutex.Lock()
+	mmDeleteOverride.DeleteOverrideMock.callArgs = append(mmDeleteOverride.DeleteOverrideMock.callArgs, &mm_params)
+	mmDeleteOverride.DeleteOverrideMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOverride.DeleteOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeleteOverride.DeleteOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOverride.DeleteOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteOverrideParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOverride.t.Errorf("RepositoryMock.DeleteOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteOverride.t.Errorf("RepositoryMock.DeleteOverride got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOverride.t.Errorf("RepositoryMock.DeleteOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOverride.t.Fatal("No results are set for the RepositoryMock.DeleteOverride")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeleteOverride.funcDeleteOverride != nil {
+		return mmDeleteOverride.funcDeleteOverride(ctx, name)
+	}
+	mmDeleteOverride.t.Fatalf("Unexpected call to RepositoryMock.DeleteOverride. %v %v", ctx, name)
+	return
+}
+
+// DeleteOverrideAfterCounter returns a count of finished RepositoryMock.DeleteOverride invocations
+func (mmDeleteOverride *RepositoryMock) DeleteOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.afterDeleteOverrideCounter)
+}
+
+// DeleteOverrideBeforeCounter returns a count of RepositoryMock.DeleteOverride invocations
+func (mmDeleteOverride *RepositoryMock) DeleteOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.beforeDeleteOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOverride *mRepositoryMockDeleteOverride) Calls() []*RepositoryMockDeleteOverrideParams {
+	mmDeleteOverride.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteOverrideParams, len(mmDeleteOverride.callArgs))
+	copy(argCopy, mmDeleteOverride.callArgs)
+
+	mmDeleteOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOverrideDone returns true if the count of the DeleteOverride invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteOverrideDone() bool {
+	if m.DeleteOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOverrideMock.invocationsDone()
+}
+
+// MinimockDeleteOverrideInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteOverrideInspect() {
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOverrideCounter := mm_atomic.LoadUint64(&m.afterDeleteOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOverrideMock.defaultExpectation != nil && afterDeleteOverrideCounter < 1 {
+		if m.DeleteOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOverride at\n%s", m.DeleteOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteOverride at\n%s with params: %#v", m.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOverride != nil && afterDeleteOverrideCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteOverride at\n%s", m.funcDeleteOverrideOrigin)
+	}
+
+	if !m.DeleteOverrideMock.invocationsDone() && afterDeleteOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOverrideMock.expectedInvocations), m.DeleteOverrideMock.expectedInvocationsOrigin, afterDeleteOverrideCounter)
+	}
+}
+
+type mRepositoryMockGetOverrides struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetOverridesExpectation
+	expectations       []*RepositoryMockGetOverridesExpectation
+
+	callArgs []*RepositoryMockGetOverridesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetOverridesExpectation specifies expectation struct of the Repository.GetOverrides
+type RepositoryMockGetOverridesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetOverridesParams
+	paramPtrs          *RepositoryMockGetOverridesParamPtrs
+	expectationOrigins RepositoryMockGetOverridesExpectationOrigins
+	results            *RepositoryMockGetOverridesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetOverridesParams contains parameters of the Repository.GetOverrides
+type RepositoryMockGetOverridesParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetOverridesParamPtrs contains pointers to parameters of the Repository.GetOverrides
+type RepositoryMockGetOverridesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetOverridesResults contains results of the Repository.GetOverrides
+type RepositoryMockGetOverridesResults struct {
+	oa1 []mm_feature.Override
+	err error
+}
+
+// RepositoryMockGetOverridesOrigins contains origins of expectations of the Repository.GetOverrides
+type RepositoryMockGetOverridesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOverrides *mRepositoryMockGetOverrides) Optional() *mRepositoryMockGetOverrides {
+	mmGetOverrides.optional = true
+	return mmGetOverrides
+}
+
+// Expect sets up expected params for Repository.GetOverrides
+func (mmGetOverrides *mRepositoryMockGetOverrides) Expect(ctx context.Context) *mRepositoryMockGetOverrides {
+	if mmGetOverrides.mock.funcGetOverrides != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by Set")
+	}
+
+	if mmGetOverrides.defaultExpectation == nil {
+		mmGetOverrides.defaultExpectation = &RepositoryMockGetOverridesExpectation{}
+	}
+
+	if mmGetOverrides.defaultExpectation.paramPtrs != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by ExpectParams functions")
+	}
+
+	mmGetOverrides.defaultExpectation.params = &RepositoryMockGetOverridesParams{ctx}
+	mmGetOverrides.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOverrides.expectations {
+		if minimock.Equal(e.params, mmGetOverrides.defaultExpectation.params) {
+			mmGetOverrides.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOverrides.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOverrides
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetOverrides
+func (mmGetOverrides *mRepositoryMockGetOverrides) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetOverrides {
+	if mmGetOverrides.mock.funcGetOverrides != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by Set")
+	}
+
+	if mmGetOverrides.defaultExpectation == nil {
+		mmGetOverrides.defaultExpectation = &RepositoryMockGetOverridesExpectation{}
+	}
+
+	if mmGetOverrides.defaultExpectation.params != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by Expect")
+	}
+
+	if mmGetOverrides.defaultExpectation.paramPtrs == nil {
+		mmGetOverrides.defaultExpectation.paramPtrs = &RepositoryMockGetOverridesParamPtrs{}
+	}
+	mmGetOverrides.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOverrides.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOverrides
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetOverrides
+func (mmGetOverrides *mRepositoryMockGetOverrides) Inspect(f func(ctx context.Context)) *mRepositoryMockGetOverrides {
+	if mmGetOverrides.mock.inspectFuncGetOverrides != nil {
+		mmGetOverrides.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetOverrides")
+	}
+
+	mmGetOverrides.mock.inspectFuncGetOverrides = f
+
+	return mmGetOverrides
+}
+
+// Return sets up results that will be returned by Repository.GetOverrides
+func (mmGetOverrides *mRepositoryMockGetOverrides) Return(oa1 []mm_feature.Override, err error) *RepositoryMock {
+	if mmGetOverrides.mock.funcGetOverrides != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by Set")
+	}
+
+	if mmGetOverrides.defaultExpectation == nil {
+		mmGetOverrides.defaultExpectation = &RepositoryMockGetOverridesExpectation{mock: mmGetOverrides.mock}
+	}
+	mmGetOverrides.defaultExpectation.results = &RepositoryMockGetOverridesResults{oa1, err}
+	mmGetOverrides.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOverrides.mock
+}
+
+// Set uses given function f to mock the Repository.GetOverrides method
+func (mmGetOverrides *mRepositoryMockGetOverrides) Set(f func(ctx context.Context) (oa1 []mm_feature.Override, err error)) *RepositoryMock {
+	if mmGetOverrides.defaultExpectation != nil {
+		mmGetOverrides.mock.t.Fatalf("Default expectation is already set for the Repository.GetOverrides method")
+	}
+
+	if len(mmGetOverrides.expectations) > 0 {
+		mmGetOverrides.mock.t.Fatalf("Some expectations are already set for the Repository.GetOverrides method")
+	}
+
+	mmGetOverrides.mock.funcGetOverrides = f
+	mmGetOverrides.mock.funcGetOverridesOrigin = minimock.CallerInfo(1)
+	return mmGetOverrides.mock
+}
+
+// When sets expectation for the Repository.GetOverrides which will trigger the result defined by the following
+// Then helper
+func (mmGetOverrides *mRepositoryMockGetOverrides) When(ctx context.Context) *RepositoryMockGetOverridesExpectation {
+	if mmGetOverrides.mock.funcGetOverrides != nil {
+		mmGetOverrides.mock.t.Fatalf("RepositoryMock.GetOverrides mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetOverridesExpectation{
+		mock:               mmGetOverrides.mock,
+		params:             &RepositoryMockGetOverridesParams{ctx},
+		expectationOrigins: RepositoryMockGetOverridesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOverrides.expectations = append(mmGetOverrides.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetOverrides return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetOverridesExpectation) Then(oa1 []mm_feature.Override, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetOverridesResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetOverrides should be invoked
+func (mmGetOverrides *mRepositoryMockGetOverrides) Times(n uint64) *mRepositoryMockGetOverrides {
+	if n == 0 {
+		mmGetOverrides.mock.t.Fatalf("Times of RepositoryMock.GetOverrides mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOverrides.expectedInvocations, n)
+	mmGetOverrides.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOverrides
+}
+
+func (mmGetOverrides *mRepositoryMockGetOverrides) invocationsDone() bool {
+	if len(mmGetOverrides.expectations) == 0 && mmGetOverrides.defaultExpectation == nil && mmGetOverrides.mock.funcGetOverrides == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOverrides.mock.afterGetOverridesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOverrides.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOverrides implements mm_feature.Repository
+func (mmGetOverrides *RepositoryMock) GetOverrides(ctx context.Context) (oa1 []mm_feature.Override, err error) {
+	mm_atomic.AddUint64(&mmGetOverrides.beforeGetOverridesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOverrides.afterGetOverridesCounter, 1)
+
+	mmGetOverrides.t.Helper()
+
+	if mmGetOverrides.inspectFuncGetOverrides != nil {
+		mmGetOverrides.inspectFuncGetOverrides(ctx)
+	}
+
+	mm_params := RepositoryMockGetOverridesParams{ctx}
+
+	// Record call args
+	mmGetOverrides.GetOverridesMock.mutex.Lock()
+	mmGetOverrides.GetOverridesMock.callArgs = append(mmGetOverrides.GetOverridesMock.callArgs, &mm_params)
+	mmGetOverrides.GetOverridesMock.mutex.Unlock()
+
+	for _, e := range mmGetOverrides.GetOverridesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetOverrides.GetOverridesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOverrides.GetOverridesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOverrides.GetOverridesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOverrides.GetOverridesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetOverridesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOverrides.t.Errorf("RepositoryMock.GetOverrides got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOverrides.GetOverridesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOverrides.t.Errorf("RepositoryMock.GetOverrides got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOverrides.GetOverridesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOverrides.GetOverridesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOverrides.t.Fatal("No results are set for the RepositoryMock.GetOverrides")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetOverrides.funcGetOverrides != nil {
+		return mmGetOverrides.funcGetOverrides(ctx)
+	}
+	mmGetOverrides.t.Fatalf("Unexpected call to RepositoryMock.GetOverrides. %v", ctx)
+	return
+}
+
+// GetOverridesAfterCounter returns a count of finished RepositoryMock.GetOverrides invocations
+func (mmGetOverrides *RepositoryMock) GetOverridesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOverrides.afterGetOverridesCounter)
+}
+
+// GetOverridesBeforeCounter returns a count of RepositoryMock.GetOverrides invocations
+func (mmGetOverrides *RepositoryMock) GetOverridesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOverrides.beforeGetOverridesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetOverrides.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOverrides *mRepositoryMockGetOverrides) Calls() []*RepositoryMockGetOverridesParams {
+	mmGetOverrides.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetOverridesParams, len(mmGetOverrides.callArgs))
+	copy(argCopy, mmGetOverrides.callArgs)
+
+	mmGetOverrides.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOverridesDone returns true if the count of the GetOverrides invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetOverridesDone() bool {
+	if m.GetOverridesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOverridesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOverridesMock.invocationsDone()
+}
+
+// MinimockGetOverridesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetOverridesInspect() {
+	for _, e := range m.GetOverridesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetOverrides at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOverridesCounter := mm_atomic.LoadUint64(&m.afterGetOverridesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOverridesMock.defaultExpectation != nil && afterGetOverridesCounter < 1 {
+		if m.GetOverridesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetOverrides at\n%s", m.GetOverridesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetOverrides at\n%s with params: %#v", m.GetOverridesMock.defaultExpectation.expectationOrigins.origin, *m.GetOverridesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOverrides != nil && afterGetOverridesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetOverrides at\n%s", m.funcGetOverridesOrigin)
+	}
+
+	if !m.GetOverridesMock.invocationsDone() && afterGetOverridesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetOverrides at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOverridesMock.expectedInvocations), m.GetOverridesMock.expectedInvocationsOrigin, afterGetOverridesCounter)
+	}
+}
+
+type mRepositoryMockSaveOverride struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSaveOverrideExpectation
+	expectations       []*RepositoryMockSaveOverrideExpectation
+
+	callArgs []*RepositoryMockSaveOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSaveOverrideExpectation specifies expectation struct of the Repository.SaveOverride
+type RepositoryMockSaveOverrideExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSaveOverrideParams
+	paramPtrs          *RepositoryMockSaveOverrideParamPtrs
+	expectationOrigins RepositoryMockSaveOverrideExpectationOrigins
+	results            *RepositoryMockSaveOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSaveOverrideParams contains parameters of the Repository.SaveOverride
+type RepositoryMockSaveOverrideParams struct {
+	ctx      context.Context
+	override mm_feature.Override
+}
+
+// RepositoryMockSaveOverrideParamPtrs contains pointers to parameters of the Repository.SaveOverride
+type RepositoryMockSaveOverrideParamPtrs struct {
+	ctx      *context.Context
+	override *mm_feature.Override
+}
+
+// RepositoryMockSaveOverrideResults contains results of the Repository.SaveOverride
+type RepositoryMockSaveOverrideResults struct {
+	err error
+}
+
+// RepositoryMockSaveOverrideOrigins contains origins of expectations of the Repository.SaveOverride
+type RepositoryMockSaveOverrideExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originOverride string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveOverride *mRepositoryMockSaveOverride) Optional() *mRepositoryMockSaveOverride {
+	mmSaveOverride.optional = true
+	return mmSaveOverride
+}
+
+// Expect sets up expected params for Repository.SaveOverride
+func (mmSaveOverride *mRepositoryMockSaveOverride) Expect(ctx context.Context, override mm_feature.Override) *mRepositoryMockSaveOverride {
+	if mmSaveOverride.mock.funcSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Set")
+	}
+
+	if mmSaveOverride.defaultExpectation == nil {
+		mmSaveOverride.defaultExpectation = &RepositoryMockSaveOverrideExpectation{}
+	}
+
+	if mmSaveOverride.defaultExpectation.paramPtrs != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by ExpectParams functions")
+	}
+
+	mmSaveOverride.defaultExpectation.params = &RepositoryMockSaveOverrideParams{ctx, override}
+	mmSaveOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveOverride.expectations {
+		if minimock.Equal(e.params, mmSaveOverride.defaultExpectation.params) {
+			mmSaveOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SaveOverride
+func (mmSaveOverride *mRepositoryMockSaveOverride) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSaveOverride {
+	if mmSaveOverride.mock.funcSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Set")
+	}
+
+	if mmSaveOverride.defaultExpectation == nil {
+		mmSaveOverride.defaultExpectation = &RepositoryMockSaveOverrideExpectation{}
+	}
+
+	if mmSaveOverride.defaultExpectation.params != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Expect")
+	}
+
+	if mmSaveOverride.defaultExpectation.paramPtrs == nil {
+		mmSaveOverride.defaultExpectation.paramPtrs = &RepositoryMockSaveOverrideParamPtrs{}
+	}
+	mmSaveOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveOverride
+}
+
+// ExpectOverrideParam2 sets up expected param override for Repository.SaveOverride
+func (mmSaveOverride *mRepositoryMockSaveOverride) ExpectOverrideParam2(override mm_feature.Override) *mRepositoryMockSaveOverride {
+	if mmSaveOverride.mock.funcSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Set")
+	}
+
+	if mmSaveOverride.defaultExpectation == nil {
+		mmSaveOverride.defaultExpectation = &RepositoryMockSaveOverrideExpectation{}
+	}
+
+	if mmSaveOverride.defaultExpectation.params != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Expect")
+	}
+
+	if mmSaveOverride.defaultExpectation.paramPtrs == nil {
+		mmSaveOverride.defaultExpectation.paramPtrs = &RepositoryMockSaveOverrideParamPtrs{}
+	}
+	mmSaveOverride.defaultExpectation.paramPtrs.override = &override
+	mmSaveOverride.defaultExpectation.expectationOrigins.originOverride = minimock.CallerInfo(1)
+
+	return mmSaveOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SaveOverride
+func (mmSaveOverride *mRepositoryMockSaveOverride) Inspect(f func(ctx context.Context, override mm_feature.Override)) *mRepositoryMockSaveOverride {
+	if mmSaveOverride.mock.inspectFuncSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SaveOverride")
+	}
+
+	mmSaveOverride.mock.inspectFuncSaveOverride = f
+
+	return mmSaveOverride
+}
+
+// Return sets up results that will be returned by Repository.SaveOverride
+func (mmSaveOverride *mRepositoryMockSaveOverride) Return(err error) *RepositoryMock {
+	if mmSaveOverride.mock.funcSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Set")
+	}
+
+	if mmSaveOverride.defaultExpectation == nil {
+		mmSaveOverride.defaultExpectation = &RepositoryMockSaveOverrideExpectation{mock: mmSaveOverride.mock}
+	}
+	mmSaveOverride.defaultExpectation.results = &RepositoryMockSaveOverrideResults{err}
+	mmSaveOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveOverride.mock
+}
+
+// Set uses given function f to mock the Repository.SaveOverride method
+func (mmSaveOverride *mRepositoryMockSaveOverride) Set(f func(ctx context.Context, override mm_feature.Override) (err error)) *RepositoryMock {
+	if mmSaveOverride.defaultExpectation != nil {
+		mmSaveOverride.mock.t.Fatalf("Default expectation is already set for the Repository.SaveOverride method")
+	}
+
+	if len(mmSaveOverride.expectations) > 0 {
+		mmSaveOverride.mock.t.Fatalf("Some expectations are already set for the Repository.SaveOverride method")
+	}
+
+	mmSaveOverride.mock.funcSaveOverride = f
+	mmSaveOverride.mock.funcSaveOverrideOrigin = minimock.CallerInfo(1)
+	return mmSaveOverride.mock
+}
+
+// When sets expectation for the Repository.SaveOverride which will trigger the result defined by the following
+// Then helper
+func (mmSaveOverride *mRepositoryMockSaveOverride) When(ctx context.Context, override mm_feature.Override) *RepositoryMockSaveOverrideExpectation {
+	if mmSaveOverride.mock.funcSaveOverride != nil {
+		mmSaveOverride.mock.t.Fatalf("RepositoryMock.SaveOverride mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSaveOverrideExpectation{
+		mock:               mmSaveOverride.mock,
+		params:             &RepositoryMockSaveOverrideParams{ctx, override},
+		expectationOrigins: RepositoryMockSaveOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveOverride.expectations = append(mmSaveOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SaveOverride return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSaveOverrideExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSaveOverrideResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SaveOverride should be invoked
+func (mmSaveOverride *mRepositoryMockSaveOverride) Times(n uint64) *mRepositoryMockSaveOverride {
+	if n == 0 {
+		mmSaveOverride.mock.t.Fatalf("Times of RepositoryMock.SaveOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveOverride.expectedInvocations, n)
+	mmSaveOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveOverride
+}
+
+func (mmSaveOverride *mRepositoryMockSaveOverride) invocationsDone() bool {
+	if len(mmSaveOverride.expectations) == 0 && mmSaveOverride.defaultExpectation == nil && mmSaveOverride.mock.funcSaveOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveOverride.mock.afterSaveOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveOverride implements mm_feature.Repository
+func (mmSaveOverride *RepositoryMock) SaveOverride(ctx context.Context, override mm_feature.Override) (err error) {
+	mm_atomic.AddUint64(&mmSaveOverride.beforeSaveOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveOverride.afterSaveOverrideCounter, 1)
+
+	mmSaveOverride.t.Helper()
+
+	if mmSaveOverride.inspectFuncSaveOverride != nil {
+		mmSaveOverride.inspectFuncSaveOverride(ctx, override)
+	}
+
+	mm_params := RepositoryMockSaveOverrideParams{ctx, override}
+
+	// Record call args
+	mmSaveOverride.SaveOverrideMock.mutex.Lock()
+	mmSaveOverride.SaveOverrideMock.callArgs = append(mmSaveOverride.SaveOverrideMock.callArgs, &mm_params)
+	mmSaveOverride.SaveOverrideMock.mutex.Unlock()
+
+	for _, e := range mmSaveOverride.SaveOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveOverride.SaveOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveOverride.SaveOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveOverride.SaveOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveOverride.SaveOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSaveOverrideParams{ctx, override}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveOverride.t.Errorf("RepositoryMock.SaveOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOverride.SaveOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.override != nil && !minimock.Equal(*mm_want_ptrs.override, mm_got.override) {
+				mmSaveOverride.t.Errorf("RepositoryMock.SaveOverride got unexpected parameter override, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveOverride.SaveOverrideMock.defaultExpectation.expectationOrigins.originOverride, *mm_want_ptrs.override, mm_got.override, minimock.Diff(*mm_want_ptrs.override, mm_got.override))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveOverride.t.Errorf("RepositoryMock.SaveOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveOverride.SaveOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveOverride.SaveOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveOverride.t.Fatal("No results are set for the RepositoryMock.SaveOverride")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveOverride.funcSaveOverride != nil {
+		return mmSaveOverride.funcSaveOverride(ctx, override)
+	}
+	mmSaveOverride.t.Fatalf("Unexpected call to RepositoryMock.SaveOverride. %v %v", ctx, override)
+	return
+}
+
+// SaveOverrideAfterCounter returns a count of finished RepositoryMock.SaveOverride invocations
+func (mmSaveOverride *RepositoryMock) SaveOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOverride.afterSaveOverrideCounter)
+}
+
+// SaveOverrideBeforeCounter returns a count of RepositoryMock.SaveOverride invocations
+func (mmSaveOverride *RepositoryMock) SaveOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveOverride.beforeSaveOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SaveOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveOverride *mRepositoryMockSaveOverride) Calls() []*RepositoryMockSaveOverrideParams {
+	mmSaveOverride.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSaveOverrideParams, len(mmSaveOverride.callArgs))
+	copy(argCopy, mmSaveOverride.callArgs)
+
+	mmSaveOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveOverrideDone returns true if the count of the SaveOverride invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSaveOverrideDone() bool {
+	if m.SaveOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveOverrideMock.invocationsDone()
+}
+
+// MinimockSaveOverrideInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSaveOverrideInspect() {
+	for _, e := range m.SaveOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SaveOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveOverrideCounter := mm_atomic.LoadUint64(&m.afterSaveOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveOverrideMock.defaultExpectation != nil && afterSaveOverrideCounter < 1 {
+		if m.SaveOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SaveOverride at\n%s", m.SaveOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SaveOverride at\n%s with params: %#v", m.SaveOverrideMock.defaultExpectation.expectationOrigins.origin, *m.SaveOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveOverride != nil && afterSaveOverrideCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SaveOverride at\n%s", m.funcSaveOverrideOrigin)
+	}
+
+	if !m.SaveOverrideMock.invocationsDone() && afterSaveOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SaveOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveOverrideMock.expectedInvocations), m.SaveOverrideMock.expectedInvocationsOrigin, afterSaveOverrideCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteOverrideInspect()
+
+			m.MinimockGetOverridesInspect()
+
+			m.MinimockSaveOverrideInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteOverrideDone() &&
+		m.MinimockGetOverridesDone() &&
+		m.MinimockSaveOverrideDone()
+}
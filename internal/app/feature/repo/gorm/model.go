@@ -0,0 +1,70 @@
+package gorm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/google/uuid"
+)
+
+type overrideModel struct {
+	Name       string `gorm:"primaryKey"`
+	Enabled    bool
+	Percentage int
+	Users      usersColumn
+	UpdatedBy  *uuid.UUID
+	UpdatedAt  time.Time
+}
+
+func (m *overrideModel) TableName() string {
+	return "feature_flag_overrides"
+}
+
+func (m overrideModel) toDTO() feature.Override {
+	return feature.Override{
+		Name:      m.Name,
+		Rule:      feature.Rule{Enabled: m.Enabled, Percentage: m.Percentage, Users: m.Users},
+		UpdatedBy: m.UpdatedBy,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func newOverrideModel(o feature.Override) overrideModel {
+	return overrideModel{
+		Name:       o.Name,
+		Enabled:    o.Rule.Enabled,
+		Percentage: o.Rule.Percentage,
+		Users:      o.Rule.Users,
+		UpdatedBy:  o.UpdatedBy,
+		UpdatedAt:  o.UpdatedAt,
+	}
+}
+
+// usersColumn stores the user IDs of a rule as a JSON array.
+type usersColumn []string
+
+func (c usersColumn) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]string(c))
+	if err != nil {
+		return nil, fmt.Errorf("usersColumn.Value: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (c *usersColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("usersColumn.Scan: unsupported type %T", src)
+	}
+}
@@ -0,0 +1,53 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+func (r *gormRepo) GetOverrides(ctx context.Context) ([]feature.Override, error) {
+	var models []overrideModel
+	if err := r.db.WithContext(ctx).Order("name").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("gormRepo.GetOverrides: %w", err)
+	}
+
+	return lo.Map(models, func(m overrideModel, _ int) feature.Override { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) SaveOverride(ctx context.Context, override feature.Override) error {
+	model := newOverrideModel(override)
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "percentage", "users", "updated_by", "updated_at"}),
+	}).Create(&model).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.SaveOverride: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteOverride(ctx context.Context, name string) (bool, error) {
+	res := r.db.WithContext(ctx).Where("name = ?", name).Delete(&overrideModel{})
+	if res.Error != nil {
+		return false, fmt.Errorf("gormRepo.DeleteOverride: %w", res.Error)
+	}
+
+	return res.RowsAffected > 0, nil
+}
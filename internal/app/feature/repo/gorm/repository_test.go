@@ -0,0 +1,94 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	admin := createUser(t, gdb)
+	at := time.Now().UTC().Truncate(time.Second)
+	beta := feature.Override{
+		Name:      "beta",
+		Rule:      feature.Rule{Percentage: 10, Users: []string{uuid.NewString()}},
+		UpdatedBy: &admin,
+		UpdatedAt: at,
+	}
+	alpha := feature.Override{Name: "alpha", Rule: feature.Rule{Enabled: true}, UpdatedAt: at}
+
+	require.NoError(t, repo.SaveOverride(t.Context(), beta))
+	require.NoError(t, repo.SaveOverride(t.Context(), alpha))
+	got, err := repo.GetOverrides(t.Context())
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "alpha", got[0].Name)
+	require.Empty(t, got[0].Rule.Users)
+	require.Equal(t, beta.Rule, got[1].Rule)
+	require.Equal(t, &admin, got[1].UpdatedBy)
+	require.True(t, at.Equal(got[1].UpdatedAt))
+
+	// saving again replaces the rule
+	beta.Rule = feature.Rule{Percentage: 50}
+	require.NoError(t, repo.SaveOverride(t.Context(), beta))
+	got, err = repo.GetOverrides(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 50, got[1].Rule.Percentage)
+	require.Empty(t, got[1].Rule.Users)
+
+	deleted, err := repo.DeleteOverride(t.Context(), "beta")
+	require.NoError(t, err)
+	require.True(t, deleted)
+	deleted, err = repo.DeleteOverride(t.Context(), "beta")
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetOverrides(t.Context())
+	require.Error(t, err)
+	require.Error(t, repo.SaveOverride(t.Context(), alpha))
+	_, err = repo.DeleteOverride(t.Context(), "alpha")
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, uid.String()+"@example.com", "admin", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+const URLParamName = "name"
+
+type Service interface {
+	GetMyFlags(ctx context.Context) ([]string, error)
+	List(ctx context.Context) ([]feature.Flag, error)
+	SetOverride(ctx context.Context, name string, rule feature.Rule) error
+	DeleteOverride(ctx context.Context, name string) error
+}
+
+type MyFlagsOutput struct {
+	Flags []string `json:"flags"`
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("feature HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// GetMyFlags godoc
+// @Summary      My feature flags
+// @Description  Returns the names of the feature flags that are on for the current user, ordered by name.
+// @Tags         features
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} MyFlagsOutput
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /features [get]
+func (h *Handler) GetMyFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	flags, err := h.svc.GetMyFlags(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, MyFlagsOutput{Flags: flags})
+}
+
+// List godoc
+// @Summary      List feature flags
+// @Description  Returns every flag declared in the config with the rule in force, its configured default and, if overridden, who changed it and when. Requires operator role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} feature.Flag
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/features [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	flags, err := h.svc.List(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, flags)
+}
+
+// SetOverride godoc
+// @Summary      Override feature flag
+// @Description  Replaces the rule of a declared flag on every instance until the override is deleted. The flag is on for everyone if enabled, otherwise for the listed user IDs and a stable percentage of the other users. Requires operator role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Accept       json
+// @Param        name path string true "Flag name"
+// @Param        request body feature.Rule true "Rule"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/features/{name} [put]
+func (h *Handler) SetOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var rule feature.Rule
+	if err := httpx.DecodeJSON(r, &rule); err != nil {
+		logger.Error(ctx, err).
+			Msg("feature.Handler.SetOverride: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err := h.svc.SetOverride(ctx, chi.URLParam(r, URLParamName), rule); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteOverride godoc
+// @Summary      Delete feature flag override
+// @Description  Restores the configured rule of a flag. Requires operator role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Param        name path string true "Flag name"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/features/{name} [delete]
+func (h *Handler) DeleteOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.svc.DeleteOverride(ctx, chi.URLParam(r, URLParamName)); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
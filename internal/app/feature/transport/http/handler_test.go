@@ -0,0 +1,132 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	feature_http "github.com/66gu1/easygodocs/internal/app/feature/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/feature/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_GetMyFlags(t *testing.T) {
+	t.Parallel()
+
+	svc := mocks.NewServiceMock(t)
+	svc.GetMyFlagsMock.Return([]string{"new-editor"}, nil)
+	w := httptest.NewRecorder()
+	feature_http.NewHandler(svc).GetMyFlags(w, httptest.NewRequest(http.MethodGet, "/features", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"flags":["new-editor"]}`, w.Body.String())
+
+	svc = mocks.NewServiceMock(t)
+	svc.GetMyFlagsMock.Return(nil, apperr.ErrUnauthorized())
+	w = httptest.NewRecorder()
+	feature_http.NewHandler(svc).GetMyFlags(w, httptest.NewRequest(http.MethodGet, "/features", nil))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_List(t *testing.T) {
+	t.Parallel()
+
+	svc := mocks.NewServiceMock(t)
+	svc.ListMock.Return([]feature.Flag{{
+		Name: "new-editor", Rule: feature.Rule{Percentage: 10}, Source: feature.SourceConfig,
+	}}, nil)
+	w := httptest.NewRecorder()
+	feature_http.NewHandler(svc).List(w, httptest.NewRequest(http.MethodGet, "/admin/features", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{"name":"new-editor","rule":{"enabled":false,"percentage":10},`+
+		`"default":{"enabled":false,"percentage":0},"source":"config"}]`, w.Body.String())
+}
+
+func TestHandler_SetOverride(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name: "ok -> 204",
+			body: `{"enabled":true}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.SetOverrideMock.Expect(minimock.AnyContext, "new-editor", feature.Rule{Enabled: true}).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid rule -> 400",
+			body: `{"percentage":101}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.SetOverrideMock.Return(feature.ErrInvalidRule("percentage must be between 0 and 100"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "undeclared flag -> 404",
+			body: `{}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.SetOverrideMock.Return(feature.ErrFlagNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			r := chi.NewRouter()
+			r.Put("/admin/features/{"+feature_http.URLParamName+"}", feature_http.NewHandler(mock).SetOverride)
+
+			req := httptest.NewRequest(http.MethodPut, "/admin/features/new-editor", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_DeleteOverride(t *testing.T) {
+	t.Parallel()
+
+	mock := mocks.NewServiceMock(t)
+	mock.DeleteOverrideMock.Set(func(_ context.Context, name string) error {
+		if name == "new-editor" {
+			return nil
+		}
+		return feature.ErrOverrideNotFound()
+	})
+	r := chi.NewRouter()
+	r.Delete("/admin/features/{"+feature_http.URLParamName+"}", feature_http.NewHandler(mock).DeleteOverride)
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/admin/features/new-editor", nil))
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/admin/features/old-editor", nil))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
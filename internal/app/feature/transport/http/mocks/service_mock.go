@@ -0,0 +1,1453 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/feature/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteOverride          func(ctx context.Context, name string) (err error)
+	funcDeleteOverrideOrigin    string
+	inspectFuncDeleteOverride   func(ctx context.Context, name string)
+	afterDeleteOverrideCounter  uint64
+	beforeDeleteOverrideCounter uint64
+	DeleteOverrideMock          mServiceMockDeleteOverride
+
+	funcGetMyFlags          func(ctx context.Context) (sa1 []string, err error)
+	funcGetMyFlagsOrigin    string
+	inspectFuncGetMyFlags   func(ctx context.Context)
+	afterGetMyFlagsCounter  uint64
+	beforeGetMyFlagsCounter uint64
+	GetMyFlagsMock          mServiceMockGetMyFlags
+
+	funcList          func(ctx context.Context) (fa1 []feature.Flag, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mServiceMockList
+
+	funcSetOverride          func(ctx context.Context, name string, rule feature.Rule) (err error)
+	funcSetOverrideOrigin    string
+	inspectFuncSetOverride   func(ctx context.Context, name string, rule feature.Rule)
+	afterSetOverrideCounter  uint64
+	beforeSetOverrideCounter uint64
+	SetOverrideMock          mServiceMockSetOverride
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteOverrideMock = mServiceMockDeleteOverride{mock: m}
+	m.DeleteOverrideMock.callArgs = []*ServiceMockDeleteOverrideParams{}
+
+	m.GetMyFlagsMock = mServiceMockGetMyFlags{mock: m}
+	m.GetMyFlagsMock.callArgs = []*ServiceMockGetMyFlagsParams{}
+
+	m.ListMock = mServiceMockList{mock: m}
+	m.ListMock.callArgs = []*ServiceMockListParams{}
+
+	m.SetOverrideMock = mServiceMockSetOverride{mock: m}
+	m.SetOverrideMock.callArgs = []*ServiceMockSetOverrideParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockDeleteOverride struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteOverrideExpectation
+	expectations       []*ServiceMockDeleteOverrideExpectation
+
+	callArgs []*ServiceMockDeleteOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteOverrideExpectation specifies expectation struct of the Service.DeleteOverride
+type ServiceMockDeleteOverrideExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteOverrideParams
+	paramPtrs          *ServiceMockDeleteOverrideParamPtrs
+	expectationOrigins ServiceMockDeleteOverrideExpectationOrigins
+	results            *ServiceMockDeleteOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteOverrideParams contains parameters of the Service.DeleteOverride
+type ServiceMockDeleteOverrideParams struct {
+	ctx  context.Context
+	name string
+}
+
+// ServiceMockDeleteOverrideParamPtrs contains pointers to parameters of the Service.DeleteOverride
+type ServiceMockDeleteOverrideParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// ServiceMockDeleteOverrideResults contains results of the Service.DeleteOverride
+type ServiceMockDeleteOverrideResults struct {
+	err error
+}
+
+// ServiceMockDeleteOverrideOrigins contains origins of expectations of the Service.DeleteOverride
+type ServiceMockDeleteOverrideExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOverride *mServiceMockDeleteOverride) Optional() *mServiceMockDeleteOverride {
+	mmDeleteOverride.optional = true
+	return mmDeleteOverride
+}
+
+// Expect sets up expected params for Service.DeleteOverride
+func (mmDeleteOverride *mServiceMockDeleteOverride) Expect(ctx context.Context, name string) *mServiceMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &ServiceMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOverride.defaultExpectation.params = &ServiceMockDeleteOverrideParams{ctx, name}
+	mmDeleteOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOverride.expectations {
+		if minimock.Equal(e.params, mmDeleteOverride.defaultExpectation.params) {
+			mmDeleteOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteOverride
+func (mmDeleteOverride *mServiceMockDeleteOverride) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &ServiceMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &ServiceMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// ExpectNameParam2 sets up expected param name for Service.DeleteOverride
+func (mmDeleteOverride *mServiceMockDeleteOverride) ExpectNameParam2(name string) *mServiceMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &ServiceMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &ServiceMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.name = &name
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteOverride
+func (mmDeleteOverride *mServiceMockDeleteOverride) Inspect(f func(ctx context.Context, name string)) *mServiceMockDeleteOverride {
+	if mmDeleteOverride.mock.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteOverride")
+	}
+
+	mmDeleteOverride.mock.inspectFuncDeleteOverride = f
+
+	return mmDeleteOverride
+}
+
+// Return sets up results that will be returned by Service.DeleteOverride
+func (mmDeleteOverride *mServiceMockDeleteOverride) Return(err error) *ServiceMock {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &ServiceMockDeleteOverrideExpectation{mock: mmDeleteOverride.mock}
+	}
+	mmDeleteOverride.defaultExpectation.results = &ServiceMockDeleteOverrideResults{err}
+	mmDeleteOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// Set uses given function f to mock the Service.DeleteOverride method
+func (mmDeleteOverride *mServiceMockDeleteOverride) Set(f func(ctx context.Context, name string) (err error)) *ServiceMock {
+	if mmDeleteOverride.defaultExpectation != nil {
+		mmDeleteOverride.mock.t.Fatalf("Default expectation is already set for the Service.DeleteOverride method")
+	}
+
+	if len(mmDeleteOverride.expectations) > 0 {
+		mmDeleteOverride.mock.t.Fatalf("Some expectations are already set for the Service.DeleteOverride method")
+	}
+
+	mmDeleteOverride.mock.funcDeleteOverride = f
+	mmDeleteOverride.mock.funcDeleteOverrideOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// When sets expectation for the Service.DeleteOverride which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOverride *mServiceMockDeleteOverride) When(ctx context.Context, name string) *ServiceMockDeleteOverrideExpectation {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("ServiceMock.DeleteOverride mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDeleteOverrideExpectation{
+		mock:               mmDeleteOverride.mock,
+		params:             &ServiceMockDeleteOverrideParams{ctx, name},
+		expectationOrigins: ServiceMockDeleteOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOverride.expectations = append(mmDeleteOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteOverride return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteOverrideExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteOverrideResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteOverride should be invoked
+func (mmDeleteOverride *mServiceMockDeleteOverride) Times(n uint64) *mServiceMockDeleteOverride {
+	if n == 0 {
+		mmDeleteOverride.mock.t.Fatalf("Times of ServiceMock.DeleteOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOverride.expectedInvocations, n)
+	mmDeleteOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride
+}
+
+func (mmDeleteOverride *mServiceMockDeleteOverride) invocationsDone() bool {
+	if len(mmDeleteOverride.expectations) == 0 && mmDeleteOverride.defaultExpectation == nil && mmDeleteOverride.mock.funcDeleteOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.mock.afterDeleteOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOverride implements mm_http.Service
+func (mmDeleteOverride *ServiceMock) DeleteOverride(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOverride.beforeDeleteOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOverride.afterDeleteOverrideCounter, 1)
+
+	mmDeleteOverride.t.Helper()
+
+	if mmDeleteOverride.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.inspectFuncDeleteOverride(ctx, name)
+	}
+
+	mm_params := ServiceMockDeleteOverrideParams{ctx, name}
+
+	// Record call args
+	mmDeleteOverride.DeleteOverrideMock.mutex.Lock()
+	mmDeleteOverride.DeleteOverrideMock.callArgs = append(mmDeleteOverride.DeleteOverrideMock.callArgs, &mm_params)
+	mmDeleteOverride.DeleteOverrideMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOverride.DeleteOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOverride.DeleteOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOverride.DeleteOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteOverrideParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOverride.t.Errorf("ServiceMock.DeleteOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteOverride.t.Errorf("ServiceMock.DeleteOverride got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOverride.t.Errorf("ServiceMock.DeleteOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOverride.t.Fatal("No results are set for the ServiceMock.DeleteOverride")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOverride.funcDeleteOverride != nil {
+		return mmDeleteOverride.funcDeleteOverride(ctx, name)
+	}
+	mmDeleteOverride.t.Fatalf("Unexpected call to ServiceMock.DeleteOverride. %v %v", ctx, name)
+	return
+}
+
+// DeleteOverrideAfterCounter returns a count of finished ServiceMock.DeleteOverride invocations
+func (mmDeleteOverride *ServiceMock) DeleteOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.afterDeleteOverrideCounter)
+}
+
+// DeleteOverrideBeforeCounter returns a count of ServiceMock.DeleteOverride invocations
+func (mmDeleteOverride *ServiceMock) DeleteOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.beforeDeleteOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOverride *mServiceMockDeleteOverride) Calls() []*ServiceMockDeleteOverrideParams {
+	mmDeleteOverride.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteOverrideParams, len(mmDeleteOverride.callArgs))
+	copy(argCopy, mmDeleteOverride.callArgs)
+
+	mmDeleteOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOverrideDone returns true if the count of the DeleteOverride invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteOverrideDone() bool {
+	if m.DeleteOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOverrideMock.invocationsDone()
+}
+
+// MinimockDeleteOverrideInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteOverrideInspect() {
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOverrideCounter := mm_atomic.LoadUint64(&m.afterDeleteOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOverrideMock.defaultExpectation != nil && afterDeleteOverrideCounter < 1 {
+		if m.DeleteOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteOverride at\n%s", m.DeleteOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteOverride at\n%s with params: %#v", m.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOverride != nil && afterDeleteOverrideCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteOverride at\n%s", m.funcDeleteOverrideOrigin)
+	}
+
+	if !m.DeleteOverrideMock.invocationsDone() && afterDeleteOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOverrideMock.expectedInvocations), m.DeleteOverrideMock.expectedInvocationsOrigin, afterDeleteOverrideCounter)
+	}
+}
+
+type mServiceMockGetMyFlags struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetMyFlagsExpectation
+	expectations       []*ServiceMockGetMyFlagsExpectation
+
+	callArgs []*ServiceMockGetMyFlagsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetMyFlagsExpectation specifies expectation struct of the Service.GetMyFlags
+type ServiceMockGetMyFlagsExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetMyFlagsParams
+	paramPtrs          *ServiceMockGetMyFlagsParamPtrs
+	expectationOrigins ServiceMockGetMyFlagsExpectationOrigins
+	results            *ServiceMockGetMyFlagsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetMyFlagsParams contains parameters of the Service.GetMyFlags
+type ServiceMockGetMyFlagsParams struct {
+	ctx context.Context
+}
+
+// ServiceMockGetMyFlagsParamPtrs contains pointers to parameters of the Service.GetMyFlags
+type ServiceMockGetMyFlagsParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockGetMyFlagsResults contains results of the Service.GetMyFlags
+type ServiceMockGetMyFlagsResults struct {
+	sa1 []string
+	err error
+}
+
+// ServiceMockGetMyFlagsOrigins contains origins of expectations of the Service.GetMyFlags
+type ServiceMockGetMyFlagsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMyFlags *mServiceMockGetMyFlags) Optional() *mServiceMockGetMyFlags {
+	mmGetMyFlags.optional = true
+	return mmGetMyFlags
+}
+
+// Expect sets up expected params for Service.GetMyFlags
+func (mmGetMyFlags *mServiceMockGetMyFlags) Expect(ctx context.Context) *mServiceMockGetMyFlags {
+	if mmGetMyFlags.mock.funcGetMyFlags != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by Set")
+	}
+
+	if mmGetMyFlags.defaultExpectation == nil {
+		mmGetMyFlags.defaultExpectation = &ServiceMockGetMyFlagsExpectation{}
+	}
+
+	if mmGetMyFlags.defaultExpectation.paramPtrs != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by ExpectParams functions")
+	}
+
+	mmGetMyFlags.defaultExpectation.params = &ServiceMockGetMyFlagsParams{ctx}
+	mmGetMyFlags.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMyFlags.expectations {
+		if minimock.Equal(e.params, mmGetMyFlags.defaultExpectation.params) {
+			mmGetMyFlags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMyFlags.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMyFlags
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetMyFlags
+func (mmGetMyFlags *mServiceMockGetMyFlags) ExpectCtxParam1(ctx context.Context) *mServiceMockGetMyFlags {
+	if mmGetMyFlags.mock.funcGetMyFlags != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by Set")
+	}
+
+	if mmGetMyFlags.defaultExpectation == nil {
+		mmGetMyFlags.defaultExpectation = &ServiceMockGetMyFlagsExpectation{}
+	}
+
+	if mmGetMyFlags.defaultExpectation.params != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by Expect")
+	}
+
+	if mmGetMyFlags.defaultExpectation.paramPtrs == nil {
+		mmGetMyFlags.defaultExpectation.paramPtrs = &ServiceMockGetMyFlagsParamPtrs{}
+	}
+	mmGetMyFlags.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMyFlags.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMyFlags
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetMyFlags
+func (mmGetMyFlags *mServiceMockGetMyFlags) Inspect(f func(ctx context.Context)) *mServiceMockGetMyFlags {
+	if mmGetMyFlags.mock.inspectFuncGetMyFlags != nil {
+		mmGetMyFlags.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetMyFlags")
+	}
+
+	mmGetMyFlags.mock.inspectFuncGetMyFlags = f
+
+	return mmGetMyFlags
+}
+
+// Return sets up results that will be returned by Service.GetMyFlags
+func (mmGetMyFlags *mServiceMockGetMyFlags) Return(sa1 []string, err error) *ServiceMock {
+	if mmGetMyFlags.mock.funcGetMyFlags != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by Set")
+	}
+
+	if mmGetMyFlags.defaultExpectation == nil {
+		mmGetMyFlags.defaultExpectation = &ServiceMockGetMyFlagsExpectation{mock: mmGetMyFlags.mock}
+	}
+	mmGetMyFlags.defaultExpectation.results = &ServiceMockGetMyFlagsResults{sa1, err}
+	mmGetMyFlags.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMyFlags.mock
+}
+
+// Set uses given function f to mock the Service.GetMyFlags method
+func (mmGetMyFlags *mServiceMockGetMyFlags) Set(f func(ctx context.Context) (sa1 []string, err error)) *ServiceMock {
+	if mmGetMyFlags.defaultExpectation != nil {
+		mmGetMyFlags.mock.t.Fatalf("Default expectation is already set for the Service.GetMyFlags method")
+	}
+
+	if len(mmGetMyFlags.expectations) > 0 {
+		mmGetMyFlags.mock.t.Fatalf("Some expectations are already set for the Service.GetMyFlags method")
+	}
+
+	mmGetMyFlags.mock.funcGetMyFlags = f
+	mmGetMyFlags.mock.funcGetMyFlagsOrigin = minimock.CallerInfo(1)
+	return mmGetMyFlags.mock
+}
+
+// When sets expectation for the Service.GetMyFlags which will trigger the result defined by the following
+// Then helper
+func (mmGetMyFlags *mServiceMockGetMyFlags) When(ctx context.Context) *ServiceMockGetMyFlagsExpectation {
+	if mmGetMyFlags.mock.funcGetMyFlags != nil {
+		mmGetMyFlags.mock.t.Fatalf("ServiceMock.GetMyFlags mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetMyFlagsExpectation{
+		mock:               mmGetMyFlags.mock,
+		params:             &ServiceMockGetMyFlagsParams{ctx},
+		expectationOrigins: ServiceMockGetMyFlagsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMyFlags.expectations = append(mmGetMyFlags.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetMyFlags return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetMyFlagsExpectation) Then(sa1 []string, err error) *ServiceMock {
+	e.results = &ServiceMockGetMyFlagsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetMyFlags should be invoked
+func (mmGetMyFlags *mServiceMockGetMyFlags) Times(n uint64) *mServiceMockGetMyFlags {
+	if n == 0 {
+		mmGetMyFlags.mock.t.Fatalf("Times of ServiceMock.GetMyFlags mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMyFlags.expectedInvocations, n)
+	mmGetMyFlags.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMyFlags
+}
+
+func (mmGetMyFlags *mServiceMockGetMyFlags) invocationsDone() bool {
+	if len(mmGetMyFlags.expectations) == 0 && mmGetMyFlags.defaultExpectation == nil && mmGetMyFlags.mock.funcGetMyFlags == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMyFlags.mock.afterGetMyFlagsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMyFlags.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMyFlags implements mm_http.Service
+func (mmGetMyFlags *ServiceMock) GetMyFlags(ctx context.Context) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetMyFlags.beforeGetMyFlagsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMyFlags.afterGetMyFlagsCounter, 1)
+
+	mmGetMyFlags.t.Helper()
+
+	if mmGetMyFlags.inspectFuncGetMyFlags != nil {
+		mmGetMyFlags.inspectFuncGetMyFlags(ctx)
+	}
+
+	mm_params := ServiceMockGetMyFlagsParams{ctx}
+
+	// Record call args
+	mmGetMyFlags.GetMyFlagsMock.mutex.Lock()
+	mmGetMyFlags.GetMyFlagsMock.callArgs = append(mmGetMyFlags.GetMyFlagsMock.callArgs, &mm_params)
+	mmGetMyFlags.GetMyFlagsMock.mutex.Unlock()
+
+	for _, e := range mmGetMyFlags.GetMyFlagsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetMyFlags.GetMyFlagsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMyFlags.GetMyFlagsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMyFlags.GetMyFlagsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMyFlags.GetMyFlagsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetMyFlagsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMyFlags.t.Errorf("ServiceMock.GetMyFlags got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMyFlags.GetMyFlagsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMyFlags.t.Errorf("ServiceMock.GetMyFlags got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMyFlags.GetMyFlagsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMyFlags.GetMyFlagsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMyFlags.t.Fatal("No results are set for the ServiceMock.GetMyFlags")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetMyFlags.funcGetMyFlags != nil {
+		return mmGetMyFlags.funcGetMyFlags(ctx)
+	}
+	mmGetMyFlags.t.Fatalf("Unexpected call to ServiceMock.GetMyFlags. %v", ctx)
+	return
+}
+
+// GetMyFlagsAfterCounter returns a count of finished ServiceMock.GetMyFlags invocations
+func (mmGetMyFlags *ServiceMock) GetMyFlagsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyFlags.afterGetMyFlagsCounter)
+}
+
+// GetMyFlagsBeforeCounter returns a count of ServiceMock.GetMyFlags invocations
+func (mmGetMyFlags *ServiceMock) GetMyFlagsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyFlags.beforeGetMyFlagsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetMyFlags.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMyFlags *mServiceMockGetMyFlags) Calls() []*ServiceMockGetMyFlagsParams {
+	mmGetMyFlags.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetMyFlagsParams, len(mmGetMyFlags.callArgs))
+	copy(argCopy, mmGetMyFlags.callArgs)
+
+	mmGetMyFlags.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMyFlagsDone returns true if the count of the GetMyFlags invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetMyFlagsDone() bool {
+	if m.GetMyFlagsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMyFlagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMyFlagsMock.invocationsDone()
+}
+
+// MinimockGetMyFlagsInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetMyFlagsInspect() {
+	for _, e := range m.GetMyFlagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetMyFlags at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMyFlagsCounter := mm_atomic.LoadUint64(&m.afterGetMyFlagsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMyFlagsMock.defaultExpectation != nil && afterGetMyFlagsCounter < 1 {
+		if m.GetMyFlagsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetMyFlags at\n%s", m.GetMyFlagsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetMyFlags at\n%s with params: %#v", m.GetMyFlagsMock.defaultExpectation.expectationOrigins.origin, *m.GetMyFlagsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMyFlags != nil && afterGetMyFlagsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetMyFlags at\n%s", m.funcGetMyFlagsOrigin)
+	}
+
+	if !m.GetMyFlagsMock.invocationsDone() && afterGetMyFlagsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetMyFlags at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMyFlagsMock.expectedInvocations), m.GetMyFlagsMock.expectedInvocationsOrigin, afterGetMyFlagsCounter)
+	}
+}
+
+type mServiceMockList struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockListExpectation
+	expectations       []*ServiceMockListExpectation
+
+	callArgs []*ServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockListExpectation specifies expectation struct of the Service.List
+type ServiceMockListExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockListParams
+	paramPtrs          *ServiceMockListParamPtrs
+	expectationOrigins ServiceMockListExpectationOrigins
+	results            *ServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockListParams contains parameters of the Service.List
+type ServiceMockListParams struct {
+	ctx context.Context
+}
+
+// ServiceMockListParamPtrs contains pointers to parameters of the Service.List
+type ServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockListResults contains results of the Service.List
+type ServiceMockListResults struct {
+	fa1 []feature.Flag
+	err error
+}
+
+// ServiceMockListOrigins contains origins of expectations of the Service.List
+type ServiceMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mServiceMockList) Optional() *mServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Service.List
+func (mmList *mServiceMockList) Expect(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ServiceMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.List
+func (mmList *mServiceMockList) ExpectCtxParam1(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.List
+func (mmList *mServiceMockList) Inspect(f func(ctx context.Context)) *mServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Service.List
+func (mmList *mServiceMockList) Return(fa1 []feature.Flag, err error) *ServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ServiceMockListResults{fa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Service.List method
+func (mmList *mServiceMockList) Set(f func(ctx context.Context) (fa1 []feature.Flag, err error)) *ServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Service.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Service.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Service.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mServiceMockList) When(ctx context.Context) *ServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &ServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &ServiceMockListParams{ctx},
+		expectationOrigins: ServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.List return parameters for the expectation previously defined by the When method
+func (e *ServiceMockListExpectation) Then(fa1 []feature.Flag, err error) *ServiceMock {
+	e.results = &ServiceMockListResults{fa1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.List should be invoked
+func (mmList *mServiceMockList) Times(n uint64) *mServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_http.Service
+func (mmList *ServiceMock) List(ctx context.Context) (fa1 []feature.Flag, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := ServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ServiceMock.List")
+		}
+		return (*mm_results).fa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to ServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished ServiceMock.List invocations
+func (mmList *ServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ServiceMock.List invocations
+func (mmList *ServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mServiceMockList) Calls() []*ServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mServiceMockSetOverride struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockSetOverrideExpectation
+	expectations       []*ServiceMockSetOverrideExpectation
+
+	callArgs []*ServiceMockSetOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockSetOverrideExpectation specifies expectation struct of the Service.SetOverride
+type ServiceMockSetOverrideExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockSetOverrideParams
+	paramPtrs          *ServiceMockSetOverrideParamPtrs
+	expectationOrigins ServiceMockSetOverrideExpectationOrigins
+	results            *ServiceMockSetOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockSetOverrideParams contains parameters of the Service.SetOverride
+type ServiceMockSetOverrideParams struct {
+	ctx  context.Context
+	name string
+	rule feature.Rule
+}
+
+// ServiceMockSetOverrideParamPtrs contains pointers to parameters of the Service.SetOverride
+type ServiceMockSetOverrideParamPtrs struct {
+	ctx  *context.Context
+	name *string
+	rule *feature.Rule
+}
+
+// ServiceMockSetOverrideResults contains results of the Service.SetOverride
+type ServiceMockSetOverrideResults struct {
+	err error
+}
+
+// ServiceMockSetOverrideOrigins contains origins of expectations of the Service.SetOverride
+type ServiceMockSetOverrideExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+	originRule string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetOverride *mServiceMockSetOverride) Optional() *mServiceMockSetOverride {
+	mmSetOverride.optional = true
+	return mmSetOverride
+}
+
+// Expect sets up expected params for Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) Expect(ctx context.Context, name string, rule feature.Rule) *mServiceMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &ServiceMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by ExpectParams functions")
+	}
+
+	mmSetOverride.defaultExpectation.params = &ServiceMockSetOverrideParams{ctx, name, rule}
+	mmSetOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetOverride.expectations {
+		if minimock.Equal(e.params, mmSetOverride.defaultExpectation.params) {
+			mmSetOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmSetOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) ExpectCtxParam1(ctx context.Context) *mServiceMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &ServiceMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &ServiceMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// ExpectNameParam2 sets up expected param name for Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) ExpectNameParam2(name string) *mServiceMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &ServiceMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &ServiceMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.name = &name
+	mmSetOverride.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// ExpectRuleParam3 sets up expected param rule for Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) ExpectRuleParam3(rule feature.Rule) *mServiceMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &ServiceMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &ServiceMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.rule = &rule
+	mmSetOverride.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) Inspect(f func(ctx context.Context, name string, rule feature.Rule)) *mServiceMockSetOverride {
+	if mmSetOverride.mock.inspectFuncSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("Inspect function is already set for ServiceMock.SetOverride")
+	}
+
+	mmSetOverride.mock.inspectFuncSetOverride = f
+
+	return mmSetOverride
+}
+
+// Return sets up results that will be returned by Service.SetOverride
+func (mmSetOverride *mServiceMockSetOverride) Return(err error) *ServiceMock {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &ServiceMockSetOverrideExpectation{mock: mmSetOverride.mock}
+	}
+	mmSetOverride.defaultExpectation.results = &ServiceMockSetOverrideResults{err}
+	mmSetOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetOverride.mock
+}
+
+// Set uses given function f to mock the Service.SetOverride method
+func (mmSetOverride *mServiceMockSetOverride) Set(f func(ctx context.Context, name string, rule feature.Rule) (err error)) *ServiceMock {
+	if mmSetOverride.defaultExpectation != nil {
+		mmSetOverride.mock.t.Fatalf("Default expectation is already set for the Service.SetOverride method")
+	}
+
+	if len(mmSetOverride.expectations) > 0 {
+		mmSetOverride.mock.t.Fatalf("Some expectations are already set for the Service.SetOverride method")
+	}
+
+	mmSetOverride.mock.funcSetOverride = f
+	mmSetOverride.mock.funcSetOverrideOrigin = minimock.CallerInfo(1)
+	return mmSetOverride.mock
+}
+
+// When sets expectation for the Service.SetOverride which will trigger the result defined by the following
+// Then helper
+func (mmSetOverride *mServiceMockSetOverride) When(ctx context.Context, name string, rule feature.Rule) *ServiceMockSetOverrideExpectation {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("ServiceMock.SetOverride mock is already set by Set")
+	}
+
+	expectation := &ServiceMockSetOverrideExpectation{
+		mock:               mmSetOverride.mock,
+		params:             &ServiceMockSetOverrideParams{ctx, name, rule},
+		expectationOrigins: ServiceMockSetOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetOverride.expectations = append(mmSetOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.SetOverride return parameters for the expectation previously defined by the When method
+func (e *ServiceMockSetOverrideExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockSetOverrideResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.SetOverride should be invoked
+func (mmSetOverride *mServiceMockSetOverride) Times(n uint64) *mServiceMockSetOverride {
+	if n == 0 {
+		mmSetOverride.mock.t.Fatalf("Times of ServiceMock.SetOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetOverride.expectedInvocations, n)
+	mmSetOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetOverride
+}
+
+func (mmSetOverride *mServiceMockSetOverride) invocationsDone() bool {
+	if len(mmSetOverride.expectations) == 0 && mmSetOverride.defaultExpectation == nil && mmSetOverride.mock.funcSetOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetOverride.mock.afterSetOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetOverride implements mm_http.Service
+func (mmSetOverride *ServiceMock) SetOverride(ctx context.Context, name string, rule feature.Rule) (err error) {
+	mm_atomic.AddUint64(&mmSetOverride.beforeSetOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetOverride.afterSetOverrideCounter, 1)
+
+	mmSetOverride.t.Helper()
+
+	if mmSetOverride.inspectFuncSetOverride != nil {
+		mmSetOverride.inspectFuncSetOverride(ctx, name, rule)
+	}
+
+	mm_params := ServiceMockSetOverrideParams{ctx, name, rule}
+
+	// Record call args
+	mmSetOverride.SetOverrideMock.mutex.Lock()
+	mmSetOverride.SetOverrideMock.callArgs = append(mmSetOverride.SetOverrideMock.callArgs, &mm_params)
+	mmSetOverride.SetOverrideMock.mutex.Unlock()
+
+	for _, e := range mmSetOverride.SetOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetOverride.SetOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetOverride.SetOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetOverride.SetOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmSetOverride.SetOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockSetOverrideParams{ctx, name, rule}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetOverride.t.Errorf("ServiceMock.SetOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmSetOverride.t.Errorf("ServiceMock.SetOverride got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmSetOverride.t.Errorf("ServiceMock.SetOverride got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetOverride.t.Errorf("ServiceMock.SetOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetOverride.SetOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetOverride.t.Fatal("No results are set for the ServiceMock.SetOverride")
+		}
+		return (*mm_results).err
+	}
+	if mmSetOverride.funcSetOverride != nil {
+		return mmSetOverride.funcSetOverride(ctx, name, rule)
+	}
+	mmSetOverride.t.Fatalf("Unexpected call to ServiceMock.SetOverride. %v %v %v", ctx, name, rule)
+	return
+}
+
+// SetOverrideAfterCounter returns a count of finished ServiceMock.SetOverride invocations
+func (mmSetOverride *ServiceMock) SetOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOverride.afterSetOverrideCounter)
+}
+
+// SetOverrideBeforeCounter returns a count of ServiceMock.SetOverride invocations
+func (mmSetOverride *ServiceMock) SetOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOverride.beforeSetOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.SetOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetOverride *mServiceMockSetOverride) Calls() []*ServiceMockSetOverrideParams {
+	mmSetOverride.mutex.RLock()
+
+	argCopy := make([]*ServiceMockSetOverrideParams, len(mmSetOverride.callArgs))
+	copy(argCopy, mmSetOverride.callArgs)
+
+	mmSetOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetOverrideDone returns true if the count of the SetOverride invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockSetOverrideDone() bool {
+	if m.SetOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetOverrideMock.invocationsDone()
+}
+
+// MinimockSetOverrideInspect logs each unmet expectation
+func (m *ServiceMock) MinimockSetOverrideInspect() {
+	for _, e := range m.SetOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.SetOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetOverrideCounter := mm_atomic.LoadUint64(&m.afterSetOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetOverrideMock.defaultExpectation != nil && afterSetOverrideCounter < 1 {
+		if m.SetOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.SetOverride at\n%s", m.SetOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.SetOverride at\n%s with params: %#v", m.SetOverrideMock.defaultExpectation.expectationOrigins.origin, *m.SetOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetOverride != nil && afterSetOverrideCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.SetOverride at\n%s", m.funcSetOverrideOrigin)
+	}
+
+	if !m.SetOverrideMock.invocationsDone() && afterSetOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.SetOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetOverrideMock.expectedInvocations), m.SetOverrideMock.expectedInvocationsOrigin, afterSetOverrideCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteOverrideInspect()
+
+			m.MinimockGetMyFlagsInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockSetOverrideInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteOverrideDone() &&
+		m.MinimockGetMyFlagsDone() &&
+		m.MinimockListDone() &&
+		m.MinimockSetOverrideDone()
+}
@@ -0,0 +1,1516 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/feature/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteOverride          func(ctx context.Context, name string) (err error)
+	funcDeleteOverrideOrigin    string
+	inspectFuncDeleteOverride   func(ctx context.Context, name string)
+	afterDeleteOverrideCounter  uint64
+	beforeDeleteOverrideCounter uint64
+	DeleteOverrideMock          mCoreMockDeleteOverride
+
+	funcGetEnabled          func(ctx context.Context, userID *uuid.UUID) (sa1 []string, err error)
+	funcGetEnabledOrigin    string
+	inspectFuncGetEnabled   func(ctx context.Context, userID *uuid.UUID)
+	afterGetEnabledCounter  uint64
+	beforeGetEnabledCounter uint64
+	GetEnabledMock          mCoreMockGetEnabled
+
+	funcList          func(ctx context.Context) (fa1 []feature.Flag, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mCoreMockList
+
+	funcSetOverride          func(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) (err error)
+	funcSetOverrideOrigin    string
+	inspectFuncSetOverride   func(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID)
+	afterSetOverrideCounter  uint64
+	beforeSetOverrideCounter uint64
+	SetOverrideMock          mCoreMockSetOverride
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteOverrideMock = mCoreMockDeleteOverride{mock: m}
+	m.DeleteOverrideMock.callArgs = []*CoreMockDeleteOverrideParams{}
+
+	m.GetEnabledMock = mCoreMockGetEnabled{mock: m}
+	m.GetEnabledMock.callArgs = []*CoreMockGetEnabledParams{}
+
+	m.ListMock = mCoreMockList{mock: m}
+	m.ListMock.callArgs = []*CoreMockListParams{}
+
+	m.SetOverrideMock = mCoreMockSetOverride{mock: m}
+	m.SetOverrideMock.callArgs = []*CoreMockSetOverrideParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockDeleteOverride struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockDeleteOverrideExpectation
+	expectations       []*CoreMockDeleteOverrideExpectation
+
+	callArgs []*CoreMockDeleteOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockDeleteOverrideExpectation specifies expectation struct of the Core.DeleteOverride
+type CoreMockDeleteOverrideExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockDeleteOverrideParams
+	paramPtrs          *CoreMockDeleteOverrideParamPtrs
+	expectationOrigins CoreMockDeleteOverrideExpectationOrigins
+	results            *CoreMockDeleteOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockDeleteOverrideParams contains parameters of the Core.DeleteOverride
+type CoreMockDeleteOverrideParams struct {
+	ctx  context.Context
+	name string
+}
+
+// CoreMockDeleteOverrideParamPtrs contains pointers to parameters of the Core.DeleteOverride
+type CoreMockDeleteOverrideParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// CoreMockDeleteOverrideResults contains results of the Core.DeleteOverride
+type CoreMockDeleteOverrideResults struct {
+	err error
+}
+
+// CoreMockDeleteOverrideOrigins contains origins of expectations of the Core.DeleteOverride
+type CoreMockDeleteOverrideExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteOverride *mCoreMockDeleteOverride) Optional() *mCoreMockDeleteOverride {
+	mmDeleteOverride.optional = true
+	return mmDeleteOverride
+}
+
+// Expect sets up expected params for Core.DeleteOverride
+func (mmDeleteOverride *mCoreMockDeleteOverride) Expect(ctx context.Context, name string) *mCoreMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &CoreMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteOverride.defaultExpectation.params = &CoreMockDeleteOverrideParams{ctx, name}
+	mmDeleteOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteOverride.expectations {
+		if minimock.Equal(e.params, mmDeleteOverride.defaultExpectation.params) {
+			mmDeleteOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.DeleteOverride
+func (mmDeleteOverride *mCoreMockDeleteOverride) ExpectCtxParam1(ctx context.Context) *mCoreMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &CoreMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &CoreMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// ExpectNameParam2 sets up expected param name for Core.DeleteOverride
+func (mmDeleteOverride *mCoreMockDeleteOverride) ExpectNameParam2(name string) *mCoreMockDeleteOverride {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &CoreMockDeleteOverrideExpectation{}
+	}
+
+	if mmDeleteOverride.defaultExpectation.params != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Expect")
+	}
+
+	if mmDeleteOverride.defaultExpectation.paramPtrs == nil {
+		mmDeleteOverride.defaultExpectation.paramPtrs = &CoreMockDeleteOverrideParamPtrs{}
+	}
+	mmDeleteOverride.defaultExpectation.paramPtrs.name = &name
+	mmDeleteOverride.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.DeleteOverride
+func (mmDeleteOverride *mCoreMockDeleteOverride) Inspect(f func(ctx context.Context, name string)) *mCoreMockDeleteOverride {
+	if mmDeleteOverride.mock.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("Inspect function is already set for CoreMock.DeleteOverride")
+	}
+
+	mmDeleteOverride.mock.inspectFuncDeleteOverride = f
+
+	return mmDeleteOverride
+}
+
+// Return sets up results that will be returned by Core.DeleteOverride
+func (mmDeleteOverride *mCoreMockDeleteOverride) Return(err error) *CoreMock {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Set")
+	}
+
+	if mmDeleteOverride.defaultExpectation == nil {
+		mmDeleteOverride.defaultExpectation = &CoreMockDeleteOverrideExpectation{mock: mmDeleteOverride.mock}
+	}
+	mmDeleteOverride.defaultExpectation.results = &CoreMockDeleteOverrideResults{err}
+	mmDeleteOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// Set uses given function f to mock the Core.DeleteOverride method
+func (mmDeleteOverride *mCoreMockDeleteOverride) Set(f func(ctx context.Context, name string) (err error)) *CoreMock {
+	if mmDeleteOverride.defaultExpectation != nil {
+		mmDeleteOverride.mock.t.Fatalf("Default expectation is already set for the Core.DeleteOverride method")
+	}
+
+	if len(mmDeleteOverride.expectations) > 0 {
+		mmDeleteOverride.mock.t.Fatalf("Some expectations are already set for the Core.DeleteOverride method")
+	}
+
+	mmDeleteOverride.mock.funcDeleteOverride = f
+	mmDeleteOverride.mock.funcDeleteOverrideOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride.mock
+}
+
+// When sets expectation for the Core.DeleteOverride which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOverride *mCoreMockDeleteOverride) When(ctx context.Context, name string) *CoreMockDeleteOverrideExpectation {
+	if mmDeleteOverride.mock.funcDeleteOverride != nil {
+		mmDeleteOverride.mock.t.Fatalf("CoreMock.DeleteOverride mock is already set by Set")
+	}
+
+	expectation := &CoreMockDeleteOverrideExpectation{
+		mock:               mmDeleteOverride.mock,
+		params:             &CoreMockDeleteOverrideParams{ctx, name},
+		expectationOrigins: CoreMockDeleteOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteOverride.expectations = append(mmDeleteOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.DeleteOverride return parameters for the expectation previously defined by the When method
+func (e *CoreMockDeleteOverrideExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockDeleteOverrideResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.DeleteOverride should be invoked
+func (mmDeleteOverride *mCoreMockDeleteOverride) Times(n uint64) *mCoreMockDeleteOverride {
+	if n == 0 {
+		mmDeleteOverride.mock.t.Fatalf("Times of CoreMock.DeleteOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteOverride.expectedInvocations, n)
+	mmDeleteOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteOverride
+}
+
+func (mmDeleteOverride *mCoreMockDeleteOverride) invocationsDone() bool {
+	if len(mmDeleteOverride.expectations) == 0 && mmDeleteOverride.defaultExpectation == nil && mmDeleteOverride.mock.funcDeleteOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.mock.afterDeleteOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteOverride implements mm_usecase.Core
+func (mmDeleteOverride *CoreMock) DeleteOverride(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteOverride.beforeDeleteOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteOverride.afterDeleteOverrideCounter, 1)
+
+	mmDeleteOverride.t.Helper()
+
+	if mmDeleteOverride.inspectFuncDeleteOverride != nil {
+		mmDeleteOverride.inspectFuncDeleteOverride(ctx, name)
+	}
+
+	mm_params := CoreMockDeleteOverrideParams{ctx, name}
+
+	// Record call args
+	mmDeleteOverride.DeleteOverrideMock.mutex.Lock()
+	mmDeleteOverride.DeleteOverrideMock.callArgs = append(mmDeleteOverride.DeleteOverrideMock.callArgs, &mm_params)
+	mmDeleteOverride.DeleteOverrideMock.mutex.Unlock()
+
+	for _, e := range mmDeleteOverride.DeleteOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteOverride.DeleteOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteOverride.DeleteOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockDeleteOverrideParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteOverride.t.Errorf("CoreMock.DeleteOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteOverride.t.Errorf("CoreMock.DeleteOverride got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteOverride.t.Errorf("CoreMock.DeleteOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteOverride.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteOverride.DeleteOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOverride.t.Fatal("No results are set for the CoreMock.DeleteOverride")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteOverride.funcDeleteOverride != nil {
+		return mmDeleteOverride.funcDeleteOverride(ctx, name)
+	}
+	mmDeleteOverride.t.Fatalf("Unexpected call to CoreMock.DeleteOverride. %v %v", ctx, name)
+	return
+}
+
+// DeleteOverrideAfterCounter returns a count of finished CoreMock.DeleteOverride invocations
+func (mmDeleteOverride *CoreMock) DeleteOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.afterDeleteOverrideCounter)
+}
+
+// DeleteOverrideBeforeCounter returns a count of CoreMock.DeleteOverride invocations
+func (mmDeleteOverride *CoreMock) DeleteOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteOverride.beforeDeleteOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.DeleteOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteOverride *mCoreMockDeleteOverride) Calls() []*CoreMockDeleteOverrideParams {
+	mmDeleteOverride.mutex.RLock()
+
+	argCopy := make([]*CoreMockDeleteOverrideParams, len(mmDeleteOverride.callArgs))
+	copy(argCopy, mmDeleteOverride.callArgs)
+
+	mmDeleteOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteOverrideDone returns true if the count of the DeleteOverride invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockDeleteOverrideDone() bool {
+	if m.DeleteOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteOverrideMock.invocationsDone()
+}
+
+// MinimockDeleteOverrideInspect logs each unmet expectation
+func (m *CoreMock) MinimockDeleteOverrideInspect() {
+	for _, e := range m.DeleteOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.DeleteOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteOverrideCounter := mm_atomic.LoadUint64(&m.afterDeleteOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteOverrideMock.defaultExpectation != nil && afterDeleteOverrideCounter < 1 {
+		if m.DeleteOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.DeleteOverride at\n%s", m.DeleteOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.DeleteOverride at\n%s with params: %#v", m.DeleteOverrideMock.defaultExpectation.expectationOrigins.origin, *m.DeleteOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteOverride != nil && afterDeleteOverrideCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.DeleteOverride at\n%s", m.funcDeleteOverrideOrigin)
+	}
+
+	if !m.DeleteOverrideMock.invocationsDone() && afterDeleteOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.DeleteOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteOverrideMock.expectedInvocations), m.DeleteOverrideMock.expectedInvocationsOrigin, afterDeleteOverrideCounter)
+	}
+}
+
+type mCoreMockGetEnabled struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetEnabledExpectation
+	expectations       []*CoreMockGetEnabledExpectation
+
+	callArgs []*CoreMockGetEnabledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetEnabledExpectation specifies expectation struct of the Core.GetEnabled
+type CoreMockGetEnabledExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetEnabledParams
+	paramPtrs          *CoreMockGetEnabledParamPtrs
+	expectationOrigins CoreMockGetEnabledExpectationOrigins
+	results            *CoreMockGetEnabledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetEnabledParams contains parameters of the Core.GetEnabled
+type CoreMockGetEnabledParams struct {
+	ctx    context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockGetEnabledParamPtrs contains pointers to parameters of the Core.GetEnabled
+type CoreMockGetEnabledParamPtrs struct {
+	ctx    *context.Context
+	userID **uuid.UUID
+}
+
+// CoreMockGetEnabledResults contains results of the Core.GetEnabled
+type CoreMockGetEnabledResults struct {
+	sa1 []string
+	err error
+}
+
+// CoreMockGetEnabledOrigins contains origins of expectations of the Core.GetEnabled
+type CoreMockGetEnabledExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetEnabled *mCoreMockGetEnabled) Optional() *mCoreMockGetEnabled {
+	mmGetEnabled.optional = true
+	return mmGetEnabled
+}
+
+// Expect sets up expected params for Core.GetEnabled
+func (mmGetEnabled *mCoreMockGetEnabled) Expect(ctx context.Context, userID *uuid.UUID) *mCoreMockGetEnabled {
+	if mmGetEnabled.mock.funcGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Set")
+	}
+
+	if mmGetEnabled.defaultExpectation == nil {
+		mmGetEnabled.defaultExpectation = &CoreMockGetEnabledExpectation{}
+	}
+
+	if mmGetEnabled.defaultExpectation.paramPtrs != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by ExpectParams functions")
+	}
+
+	mmGetEnabled.defaultExpectation.params = &CoreMockGetEnabledParams{ctx, userID}
+	mmGetEnabled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetEnabled.expectations {
+		if minimock.Equal(e.params, mmGetEnabled.defaultExpectation.params) {
+			mmGetEnabled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEnabled.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEnabled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetEnabled
+func (mmGetEnabled *mCoreMockGetEnabled) ExpectCtxParam1(ctx context.Context) *mCoreMockGetEnabled {
+	if mmGetEnabled.mock.funcGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Set")
+	}
+
+	if mmGetEnabled.defaultExpectation == nil {
+		mmGetEnabled.defaultExpectation = &CoreMockGetEnabledExpectation{}
+	}
+
+	if mmGetEnabled.defaultExpectation.params != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Expect")
+	}
+
+	if mmGetEnabled.defaultExpectation.paramPtrs == nil {
+		mmGetEnabled.defaultExpectation.paramPtrs = &CoreMockGetEnabledParamPtrs{}
+	}
+	mmGetEnabled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetEnabled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetEnabled
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.GetEnabled
+func (mmGetEnabled *mCoreMockGetEnabled) ExpectUserIDParam2(userID *uuid.UUID) *mCoreMockGetEnabled {
+	if mmGetEnabled.mock.funcGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Set")
+	}
+
+	if mmGetEnabled.defaultExpectation == nil {
+		mmGetEnabled.defaultExpectation = &CoreMockGetEnabledExpectation{}
+	}
+
+	if mmGetEnabled.defaultExpectation.params != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Expect")
+	}
+
+	if mmGetEnabled.defaultExpectation.paramPtrs == nil {
+		mmGetEnabled.defaultExpectation.paramPtrs = &CoreMockGetEnabledParamPtrs{}
+	}
+	mmGetEnabled.defaultExpectation.paramPtrs.userID = &userID
+	mmGetEnabled.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetEnabled
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetEnabled
+func (mmGetEnabled *mCoreMockGetEnabled) Inspect(f func(ctx context.Context, userID *uuid.UUID)) *mCoreMockGetEnabled {
+	if mmGetEnabled.mock.inspectFuncGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("Inspect function is already set for CoreMock.GetEnabled")
+	}
+
+	mmGetEnabled.mock.inspectFuncGetEnabled = f
+
+	return mmGetEnabled
+}
+
+// Return sets up results that will be returned by Core.GetEnabled
+func (mmGetEnabled *mCoreMockGetEnabled) Return(sa1 []string, err error) *CoreMock {
+	if mmGetEnabled.mock.funcGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Set")
+	}
+
+	if mmGetEnabled.defaultExpectation == nil {
+		mmGetEnabled.defaultExpectation = &CoreMockGetEnabledExpectation{mock: mmGetEnabled.mock}
+	}
+	mmGetEnabled.defaultExpectation.results = &CoreMockGetEnabledResults{sa1, err}
+	mmGetEnabled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetEnabled.mock
+}
+
+// Set uses given function f to mock the Core.GetEnabled method
+func (mmGetEnabled *mCoreMockGetEnabled) Set(f func(ctx context.Context, userID *uuid.UUID) (sa1 []string, err error)) *CoreMock {
+	if mmGetEnabled.defaultExpectation != nil {
+		mmGetEnabled.mock.t.Fatalf("Default expectation is already set for the Core.GetEnabled method")
+	}
+
+	if len(mmGetEnabled.expectations) > 0 {
+		mmGetEnabled.mock.t.Fatalf("Some expectations are already set for the Core.GetEnabled method")
+	}
+
+	mmGetEnabled.mock.funcGetEnabled = f
+	mmGetEnabled.mock.funcGetEnabledOrigin = minimock.CallerInfo(1)
+	return mmGetEnabled.mock
+}
+
+// When sets expectation for the Core.GetEnabled which will trigger the result defined by the following
+// Then helper
+func (mmGetEnabled *mCoreMockGetEnabled) When(ctx context.Context, userID *uuid.UUID) *CoreMockGetEnabledExpectation {
+	if mmGetEnabled.mock.funcGetEnabled != nil {
+		mmGetEnabled.mock.t.Fatalf("CoreMock.GetEnabled mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetEnabledExpectation{
+		mock:               mmGetEnabled.mock,
+		params:             &CoreMockGetEnabledParams{ctx, userID},
+		expectationOrigins: CoreMockGetEnabledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetEnabled.expectations = append(mmGetEnabled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetEnabled return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetEnabledExpectation) Then(sa1 []string, err error) *CoreMock {
+	e.results = &CoreMockGetEnabledResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetEnabled should be invoked
+func (mmGetEnabled *mCoreMockGetEnabled) Times(n uint64) *mCoreMockGetEnabled {
+	if n == 0 {
+		mmGetEnabled.mock.t.Fatalf("Times of CoreMock.GetEnabled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetEnabled.expectedInvocations, n)
+	mmGetEnabled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetEnabled
+}
+
+func (mmGetEnabled *mCoreMockGetEnabled) invocationsDone() bool {
+	if len(mmGetEnabled.expectations) == 0 && mmGetEnabled.defaultExpectation == nil && mmGetEnabled.mock.funcGetEnabled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetEnabled.mock.afterGetEnabledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetEnabled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetEnabled implements mm_usecase.Core
+func (mmGetEnabled *CoreMock) GetEnabled(ctx context.Context, userID *uuid.UUID) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetEnabled.beforeGetEnabledCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEnabled.afterGetEnabledCounter, 1)
+
+	mmGetEnabled.t.Helper()
+
+	if mmGetEnabled.inspectFuncGetEnabled != nil {
+		mmGetEnabled.inspectFuncGetEnabled(ctx, userID)
+	}
+
+	mm_params := CoreMockGetEnabledParams{ctx, userID}
+
+	// Record call args
+	mmGetEnabled.GetEnabledMock.mutex.Lock()
+	mmGetEnabled.GetEnabledMock.callArgs = append(mmGetEnabled.GetEnabledMock.callArgs, &mm_params)
+	mmGetEnabled.GetEnabledMock.mutex.Unlock()
+
+	for _, e := range mmGetEnabled.GetEnabledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetEnabled.GetEnabledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEnabled.GetEnabledMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEnabled.GetEnabledMock.defaultExpectation.params
+		mm_want_ptrs := mmGetEnabled.GetEnabledMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetEnabledParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetEnabled.t.Errorf("CoreMock.GetEnabled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEnabled.GetEnabledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetEnabled.t.Errorf("CoreMock.GetEnabled got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEnabled.GetEnabledMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEnabled.t.Errorf("CoreMock.GetEnabled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetEnabled.GetEnabledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEnabled.GetEnabledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEnabled.t.Fatal("No results are set for the CoreMock.GetEnabled")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetEnabled.funcGetEnabled != nil {
+		return mmGetEnabled.funcGetEnabled(ctx, userID)
+	}
+	mmGetEnabled.t.Fatalf("Unexpected call to CoreMock.GetEnabled. %v %v", ctx, userID)
+	return
+}
+
+// GetEnabledAfterCounter returns a count of finished CoreMock.GetEnabled invocations
+func (mmGetEnabled *CoreMock) GetEnabledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEnabled.afterGetEnabledCounter)
+}
+
+// GetEnabledBeforeCounter returns a count of CoreMock.GetEnabled invocations
+func (mmGetEnabled *CoreMock) GetEnabledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEnabled.beforeGetEnabledCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetEnabled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEnabled *mCoreMockGetEnabled) Calls() []*CoreMockGetEnabledParams {
+	mmGetEnabled.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetEnabledParams, len(mmGetEnabled.callArgs))
+	copy(argCopy, mmGetEnabled.callArgs)
+
+	mmGetEnabled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEnabledDone returns true if the count of the GetEnabled invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetEnabledDone() bool {
+	if m.GetEnabledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetEnabledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetEnabledMock.invocationsDone()
+}
+
+// MinimockGetEnabledInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetEnabledInspect() {
+	for _, e := range m.GetEnabledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetEnabled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetEnabledCounter := mm_atomic.LoadUint64(&m.afterGetEnabledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEnabledMock.defaultExpectation != nil && afterGetEnabledCounter < 1 {
+		if m.GetEnabledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetEnabled at\n%s", m.GetEnabledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetEnabled at\n%s with params: %#v", m.GetEnabledMock.defaultExpectation.expectationOrigins.origin, *m.GetEnabledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEnabled != nil && afterGetEnabledCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetEnabled at\n%s", m.funcGetEnabledOrigin)
+	}
+
+	if !m.GetEnabledMock.invocationsDone() && afterGetEnabledCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetEnabled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetEnabledMock.expectedInvocations), m.GetEnabledMock.expectedInvocationsOrigin, afterGetEnabledCounter)
+	}
+}
+
+type mCoreMockList struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockListExpectation
+	expectations       []*CoreMockListExpectation
+
+	callArgs []*CoreMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockListExpectation specifies expectation struct of the Core.List
+type CoreMockListExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockListParams
+	paramPtrs          *CoreMockListParamPtrs
+	expectationOrigins CoreMockListExpectationOrigins
+	results            *CoreMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockListParams contains parameters of the Core.List
+type CoreMockListParams struct {
+	ctx context.Context
+}
+
+// CoreMockListParamPtrs contains pointers to parameters of the Core.List
+type CoreMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockListResults contains results of the Core.List
+type CoreMockListResults struct {
+	fa1 []feature.Flag
+	err error
+}
+
+// CoreMockListOrigins contains origins of expectations of the Core.List
+type CoreMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mCoreMockList) Optional() *mCoreMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Core.List
+func (mmList *mCoreMockList) Expect(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &CoreMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.List
+func (mmList *mCoreMockList) ExpectCtxParam1(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &CoreMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.List
+func (mmList *mCoreMockList) Inspect(f func(ctx context.Context)) *mCoreMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for CoreMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Core.List
+func (mmList *mCoreMockList) Return(fa1 []feature.Flag, err error) *CoreMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &CoreMockListResults{fa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Core.List method
+func (mmList *mCoreMockList) Set(f func(ctx context.Context) (fa1 []feature.Flag, err error)) *CoreMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Core.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Core.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Core.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mCoreMockList) When(ctx context.Context) *CoreMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	expectation := &CoreMockListExpectation{
+		mock:               mmList.mock,
+		params:             &CoreMockListParams{ctx},
+		expectationOrigins: CoreMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.List return parameters for the expectation previously defined by the When method
+func (e *CoreMockListExpectation) Then(fa1 []feature.Flag, err error) *CoreMock {
+	e.results = &CoreMockListResults{fa1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.List should be invoked
+func (mmList *mCoreMockList) Times(n uint64) *mCoreMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of CoreMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mCoreMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_usecase.Core
+func (mmList *CoreMock) List(ctx context.Context) (fa1 []feature.Flag, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := CoreMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("CoreMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("CoreMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the CoreMock.List")
+		}
+		return (*mm_results).fa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to CoreMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished CoreMock.List invocations
+func (mmList *CoreMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of CoreMock.List invocations
+func (mmList *CoreMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mCoreMockList) Calls() []*CoreMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*CoreMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *CoreMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mCoreMockSetOverride struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockSetOverrideExpectation
+	expectations       []*CoreMockSetOverrideExpectation
+
+	callArgs []*CoreMockSetOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockSetOverrideExpectation specifies expectation struct of the Core.SetOverride
+type CoreMockSetOverrideExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockSetOverrideParams
+	paramPtrs          *CoreMockSetOverrideParamPtrs
+	expectationOrigins CoreMockSetOverrideExpectationOrigins
+	results            *CoreMockSetOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockSetOverrideParams contains parameters of the Core.SetOverride
+type CoreMockSetOverrideParams struct {
+	ctx    context.Context
+	name   string
+	rule   feature.Rule
+	userID uuid.UUID
+}
+
+// CoreMockSetOverrideParamPtrs contains pointers to parameters of the Core.SetOverride
+type CoreMockSetOverrideParamPtrs struct {
+	ctx    *context.Context
+	name   *string
+	rule   *feature.Rule
+	userID *uuid.UUID
+}
+
+// CoreMockSetOverrideResults contains results of the Core.SetOverride
+type CoreMockSetOverrideResults struct {
+	err error
+}
+
+// CoreMockSetOverrideOrigins contains origins of expectations of the Core.SetOverride
+type CoreMockSetOverrideExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originName   string
+	originRule   string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetOverride *mCoreMockSetOverride) Optional() *mCoreMockSetOverride {
+	mmSetOverride.optional = true
+	return mmSetOverride
+}
+
+// Expect sets up expected params for Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) Expect(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) *mCoreMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by ExpectParams functions")
+	}
+
+	mmSetOverride.defaultExpectation.params = &CoreMockSetOverrideParams{ctx, name, rule, userID}
+	mmSetOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetOverride.expectations {
+		if minimock.Equal(e.params, mmSetOverride.defaultExpectation.params) {
+			mmSetOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmSetOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) ExpectCtxParam1(ctx context.Context) *mCoreMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &CoreMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// ExpectNameParam2 sets up expected param name for Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) ExpectNameParam2(name string) *mCoreMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &CoreMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.name = &name
+	mmSetOverride.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// ExpectRuleParam3 sets up expected param rule for Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) ExpectRuleParam3(rule feature.Rule) *mCoreMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &CoreMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.rule = &rule
+	mmSetOverride.defaultExpectation.expectationOrigins.originRule = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// ExpectUserIDParam4 sets up expected param userID for Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) ExpectUserIDParam4(userID uuid.UUID) *mCoreMockSetOverride {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{}
+	}
+
+	if mmSetOverride.defaultExpectation.params != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Expect")
+	}
+
+	if mmSetOverride.defaultExpectation.paramPtrs == nil {
+		mmSetOverride.defaultExpectation.paramPtrs = &CoreMockSetOverrideParamPtrs{}
+	}
+	mmSetOverride.defaultExpectation.paramPtrs.userID = &userID
+	mmSetOverride.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) Inspect(f func(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID)) *mCoreMockSetOverride {
+	if mmSetOverride.mock.inspectFuncSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("Inspect function is already set for CoreMock.SetOverride")
+	}
+
+	mmSetOverride.mock.inspectFuncSetOverride = f
+
+	return mmSetOverride
+}
+
+// Return sets up results that will be returned by Core.SetOverride
+func (mmSetOverride *mCoreMockSetOverride) Return(err error) *CoreMock {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	if mmSetOverride.defaultExpectation == nil {
+		mmSetOverride.defaultExpectation = &CoreMockSetOverrideExpectation{mock: mmSetOverride.mock}
+	}
+	mmSetOverride.defaultExpectation.results = &CoreMockSetOverrideResults{err}
+	mmSetOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetOverride.mock
+}
+
+// Set uses given function f to mock the Core.SetOverride method
+func (mmSetOverride *mCoreMockSetOverride) Set(f func(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) (err error)) *CoreMock {
+	if mmSetOverride.defaultExpectation != nil {
+		mmSetOverride.mock.t.Fatalf("Default expectation is already set for the Core.SetOverride method")
+	}
+
+	if len(mmSetOverride.expectations) > 0 {
+		mmSetOverride.mock.t.Fatalf("Some expectations are already set for the Core.SetOverride method")
+	}
+
+	mmSetOverride.mock.funcSetOverride = f
+	mmSetOverride.mock.funcSetOverrideOrigin = minimock.CallerInfo(1)
+	return mmSetOverride.mock
+}
+
+// When sets expectation for the Core.SetOverride which will trigger the result defined by the following
+// Then helper
+func (mmSetOverride *mCoreMockSetOverride) When(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) *CoreMockSetOverrideExpectation {
+	if mmSetOverride.mock.funcSetOverride != nil {
+		mmSetOverride.mock.t.Fatalf("CoreMock.SetOverride mock is already set by Set")
+	}
+
+	expectation := &CoreMockSetOverrideExpectation{
+		mock:               mmSetOverride.mock,
+		params:             &CoreMockSetOverrideParams{ctx, name, rule, userID},
+		expectationOrigins: CoreMockSetOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetOverride.expectations = append(mmSetOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.SetOverride return parameters for the expectation previously defined by the When method
+func (e *CoreMockSetOverrideExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockSetOverrideResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.SetOverride should be invoked
+func (mmSetOverride *mCoreMockSetOverride) Times(n uint64) *mCoreMockSetOverride {
+	if n == 0 {
+		mmSetOverride.mock.t.Fatalf("Times of CoreMock.SetOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetOverride.expectedInvocations, n)
+	mmSetOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetOverride
+}
+
+func (mmSetOverride *mCoreMockSetOverride) invocationsDone() bool {
+	if len(mmSetOverride.expectations) == 0 && mmSetOverride.defaultExpectation == nil && mmSetOverride.mock.funcSetOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetOverride.mock.afterSetOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetOverride implements mm_usecase.Core
+func (mmSetOverride *CoreMock) SetOverride(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmSetOverride.beforeSetOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetOverride.afterSetOverrideCounter, 1)
+
+	mmSetOverride.t.Helper()
+
+	if mmSetOverride.inspectFuncSetOverride != nil {
+		mmSetOverride.inspectFuncSetOverride(ctx, name, rule, userID)
+	}
+
+	mm_params := CoreMockSetOverrideParams{ctx, name, rule, userID}
+
+	// Record call args
+	mmSetOverride.SetOverrideMock.mutex.Lock()
+	mmSetOverride.SetOverrideMock.callArgs = append(mmSetOverride.SetOverrideMock.callArgs, &mm_params)
+	mmSetOverride.SetOverrideMock.mutex.Unlock()
+
+	for _, e := range mmSetOverride.SetOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetOverride.SetOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetOverride.SetOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetOverride.SetOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmSetOverride.SetOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockSetOverrideParams{ctx, name, rule, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetOverride.t.Errorf("CoreMock.SetOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmSetOverride.t.Errorf("CoreMock.SetOverride got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.rule != nil && !minimock.Equal(*mm_want_ptrs.rule, mm_got.rule) {
+				mmSetOverride.t.Errorf("CoreMock.SetOverride got unexpected parameter rule, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originRule, *mm_want_ptrs.rule, mm_got.rule, minimock.Diff(*mm_want_ptrs.rule, mm_got.rule))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetOverride.t.Errorf("CoreMock.SetOverride got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetOverride.t.Errorf("CoreMock.SetOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetOverride.SetOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetOverride.SetOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetOverride.t.Fatal("No results are set for the CoreMock.SetOverride")
+		}
+		return (*mm_results).err
+	}
+	if mmSetOverride.funcSetOverride != nil {
+		return mmSetOverride.funcSetOverride(ctx, name, rule, userID)
+	}
+	mmSetOverride.t.Fatalf("Unexpected call to CoreMock.SetOverride. %v %v %v %v", ctx, name, rule, userID)
+	return
+}
+
+// SetOverrideAfterCounter returns a count of finished CoreMock.SetOverride invocations
+func (mmSetOverride *CoreMock) SetOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOverride.afterSetOverrideCounter)
+}
+
+// SetOverrideBeforeCounter returns a count of CoreMock.SetOverride invocations
+func (mmSetOverride *CoreMock) SetOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOverride.beforeSetOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.SetOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetOverride *mCoreMockSetOverride) Calls() []*CoreMockSetOverrideParams {
+	mmSetOverride.mutex.RLock()
+
+	argCopy := make([]*CoreMockSetOverrideParams, len(mmSetOverride.callArgs))
+	copy(argCopy, mmSetOverride.callArgs)
+
+	mmSetOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetOverrideDone returns true if the count of the SetOverride invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockSetOverrideDone() bool {
+	if m.SetOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetOverrideMock.invocationsDone()
+}
+
+// MinimockSetOverrideInspect logs each unmet expectation
+func (m *CoreMock) MinimockSetOverrideInspect() {
+	for _, e := range m.SetOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.SetOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetOverrideCounter := mm_atomic.LoadUint64(&m.afterSetOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetOverrideMock.defaultExpectation != nil && afterSetOverrideCounter < 1 {
+		if m.SetOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.SetOverride at\n%s", m.SetOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.SetOverride at\n%s with params: %#v", m.SetOverrideMock.defaultExpectation.expectationOrigins.origin, *m.SetOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetOverride != nil && afterSetOverrideCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.SetOverride at\n%s", m.funcSetOverrideOrigin)
+	}
+
+	if !m.SetOverrideMock.invocationsDone() && afterSetOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.SetOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetOverrideMock.expectedInvocations), m.SetOverrideMock.expectedInvocationsOrigin, afterSetOverrideCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteOverrideInspect()
+
+			m.MinimockGetEnabledInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockSetOverrideInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteOverrideDone() &&
+		m.MinimockGetEnabledDone() &&
+		m.MinimockListDone() &&
+		m.MinimockSetOverrideDone()
+}
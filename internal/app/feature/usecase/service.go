@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	GetEnabled(ctx context.Context, userID *uuid.UUID) ([]string, error)
+	List(ctx context.Context) ([]feature.Flag, error)
+	SetOverride(ctx context.Context, name string, rule feature.Rule, userID uuid.UUID) error
+	DeleteOverride(ctx context.Context, name string) error
+}
+
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("feature.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+// GetMyFlags returns the names of the flags that are on for the current user, so clients can
+// show or hide features the same way the server does.
+func (s *service) GetMyFlags(ctx context.Context) ([]string, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("feature.service.GetMyFlags.contextx.GetUserID")
+		return nil, fmt.Errorf("feature.service.GetMyFlags: %w", err)
+	}
+
+	flags, err := s.core.GetEnabled(ctx, &userID)
+	if err != nil {
+		logger.Error(ctx, err).Msg("feature.service.GetMyFlags: failed to get enabled flags")
+		return nil, fmt.Errorf("feature.service.GetMyFlags: %w", err)
+	}
+
+	return flags, nil
+}
+
+// List returns every declared flag with the rule in force. The route requires operator role.
+func (s *service) List(ctx context.Context) ([]feature.Flag, error) {
+	flags, err := s.core.List(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("feature.service.List: failed to list flags")
+		return nil, fmt.Errorf("feature.service.List: %w", err)
+	}
+
+	return flags, nil
+}
+
+// SetOverride replaces the rule of a flag on every instance until the override is deleted.
+// The route requires operator role.
+func (s *service) SetOverride(ctx context.Context, name string, rule feature.Rule) error {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("feature.service.SetOverride.contextx.GetUserID")
+		return fmt.Errorf("feature.service.SetOverride: %w", err)
+	}
+
+	if err = s.core.SetOverride(ctx, name, rule, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(feature.FieldName.String(), name).
+			Msg("feature.service.SetOverride: failed to set override")
+		return fmt.Errorf("feature.service.SetOverride: %w", err)
+	}
+
+	logger.Audit(ctx, "feature.override_set").
+		Str(feature.FieldName.String(), name).
+		Interface(feature.FieldRule.String(), rule).
+		Msg("feature flag overridden")
+	return nil
+}
+
+// DeleteOverride restores the configured rule of a flag. The route requires operator role.
+func (s *service) DeleteOverride(ctx context.Context, name string) error {
+	if err := s.core.DeleteOverride(ctx, name); err != nil {
+		logger.Error(ctx, err).
+			Str(feature.FieldName.String(), name).
+			Msg("feature.service.DeleteOverride: failed to delete override")
+		return fmt.Errorf("feature.service.DeleteOverride: %w", err)
+	}
+
+	logger.Audit(ctx, "feature.override_deleted").
+		Str(feature.FieldName.String(), name).
+		Msg("feature flag override deleted")
+	return nil
+}
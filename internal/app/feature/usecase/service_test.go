@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/feature"
+	"github.com/66gu1/easygodocs/internal/app/feature/usecase"
+	"github.com/66gu1/easygodocs/internal/app/feature/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core *mocks.CoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core: mocks.NewCoreMock(t),
+	}
+}
+
+func TestService_GetMyFlags(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	ctx := contextx.SetUserID(t.Context(), userID)
+
+	mocks := getMocks(t)
+	mocks.core.GetEnabledMock.Expect(ctx, &userID).Return([]string{"new-editor"}, nil)
+	svc := usecase.NewService(mocks.core)
+
+	got, err := svc.GetMyFlags(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"new-editor"}, got)
+
+	_, err = svc.GetMyFlags(t.Context())
+	require.Error(t, err)
+}
+
+func TestService_SetOverride(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.New()
+		ctx     = contextx.SetUserID(t.Context(), userID)
+		rule    = feature.Rule{Percentage: 25}
+		errCore = errors.New("db down")
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		mocks.core.SetOverrideMock.Expect(minimock.AnyContext, "new-editor", rule, userID).Return(nil)
+		require.NoError(t, usecase.NewService(mocks.core).SetOverride(ctx, "new-editor", rule))
+	})
+
+	t.Run("core error", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		mocks.core.SetOverrideMock.Return(errCore)
+		require.ErrorIs(t, usecase.NewService(mocks.core).SetOverride(ctx, "new-editor", rule), errCore)
+	})
+
+	t.Run("no user", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		require.Error(t, usecase.NewService(mocks.core).SetOverride(t.Context(), "new-editor", rule))
+	})
+}
+
+func TestService_DeleteOverride(t *testing.T) {
+	t.Parallel()
+
+	mocks := getMocks(t)
+	mocks.core.DeleteOverrideMock.Expect(minimock.AnyContext, "new-editor").Return(nil)
+	require.NoError(t, usecase.NewService(mocks.core).DeleteOverride(t.Context(), "new-editor"))
+
+	mocks = getMocks(t)
+	mocks.core.DeleteOverrideMock.Return(feature.ErrOverrideNotFound())
+	require.ErrorIs(t, usecase.NewService(mocks.core).DeleteOverride(t.Context(), "new-editor"), feature.ErrOverrideNotFound())
+}
@@ -0,0 +1,118 @@
+package gitsync
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/google/uuid"
+)
+
+// Ext is the extension of document files; other files of the repository are left alone.
+const Ext = ".md"
+
+const frontMatterFence = "---"
+
+var ErrInvalidDocument = errors.New("invalid front matter")
+
+// Document is an entity as a Markdown file: a front matter block between --- lines, then the content.
+// Version is the version of the entity the file was written from. A file without front matter is a
+// new document, named after the file.
+type Document struct {
+	ID      uuid.UUID
+	Type    entity.Type
+	Name    string
+	Version int
+	Content string
+}
+
+func (d Document) Marshal() []byte {
+	var b strings.Builder
+	b.WriteString(frontMatterFence + "\n")
+	b.WriteString("id: " + d.ID.String() + "\n")
+	b.WriteString("type: " + string(d.Type) + "\n")
+	b.WriteString("name: " + strconv.Quote(d.Name) + "\n")
+	b.WriteString("version: " + strconv.Itoa(d.Version) + "\n")
+	b.WriteString(frontMatterFence + "\n")
+	// the file ends with a newline, which ParseDocument takes off again
+	b.WriteString(d.Content + "\n")
+
+	return []byte(b.String())
+}
+
+// ParseDocument reads a document file. Unknown front matter keys are ignored.
+func ParseDocument(data []byte) (Document, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.TrimSuffix(text, "\n")
+	if !strings.HasPrefix(text, frontMatterFence+"\n") {
+		return Document{Content: text}, nil
+	}
+	header, content, ok := strings.Cut(text[len(frontMatterFence)+1:], "\n"+frontMatterFence)
+	if !ok || (content != "" && content[0] != '\n') {
+		return Document{}, fmt.Errorf("gitsync.ParseDocument: %w: no closing %s", ErrInvalidDocument, frontMatterFence)
+	}
+
+	doc := Document{Content: strings.TrimPrefix(content, "\n")}
+	for _, line := range strings.Split(header, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		var err error
+		switch strings.TrimSpace(key) {
+		case "id":
+			doc.ID, err = uuid.Parse(value)
+		case "type":
+			doc.Type = entity.Type(value)
+		case "name":
+			doc.Name = value
+			if strings.HasPrefix(value, `"`) {
+				doc.Name, err = strconv.Unquote(value)
+			}
+		case "version":
+			doc.Version, err = strconv.Atoi(value)
+		}
+		if err != nil {
+			return Document{}, fmt.Errorf("gitsync.ParseDocument: %w: %s", ErrInvalidDocument, strings.TrimSpace(key))
+		}
+	}
+	if doc.ID != uuid.Nil && doc.Version <= 0 {
+		return Document{}, fmt.Errorf("gitsync.ParseDocument: %w: version is required with an id", ErrInvalidDocument)
+	}
+
+	return doc, nil
+}
+
+// Layout places the published entities of an export, root first, in the repository: an entity is
+// <slug>.md in the directory of its parent, which is named after the slug of the parent. Drafts are
+// left out, and so is everything below them.
+func Layout(items []entity.ExportItem) map[uuid.UUID]string {
+	paths := make(map[uuid.UUID]string, len(items))
+	for i, item := range items {
+		if item.IsDraft {
+			continue
+		}
+		dir := ""
+		if i > 0 {
+			if item.ParentID == nil {
+				continue
+			}
+			parent, ok := paths[*item.ParentID]
+			if !ok {
+				continue
+			}
+			dir = strings.TrimSuffix(parent, Ext)
+		}
+		name := item.Slug
+		if name == "" {
+			name = item.ID.String()
+		}
+		paths[item.ID] = path.Join(dir, name+Ext)
+	}
+
+	return paths
+}
@@ -0,0 +1,64 @@
+package gitsync_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/gitsync"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	t.Parallel()
+
+	doc := gitsync.Document{
+		ID:      uuid.New(),
+		Type:    entity.TypeArticle,
+		Name:    `Setup: "first" steps`,
+		Version: 3,
+		Content: "# Setup\n\n---\n\nDone.\n",
+	}
+	got, err := gitsync.ParseDocument(doc.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, doc, got)
+
+	// edited by hand, with CRLF line ends and an unquoted name
+	got, err = gitsync.ParseDocument([]byte("---\r\nid: " + doc.ID.String() + "\r\nname: Setup\r\nversion: 3\r\nextra: ignored\r\n---\r\nText\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, gitsync.Document{ID: doc.ID, Name: "Setup", Version: 3, Content: "Text"}, got)
+
+	// no front matter is a new document
+	got, err = gitsync.ParseDocument([]byte("Just text\n"))
+	require.NoError(t, err)
+	require.Equal(t, gitsync.Document{Content: "Just text"}, got)
+
+	for _, in := range []string{
+		"---\nid: 1\nversion: 1\n---\n",
+		"---\nid: " + doc.ID.String() + "\n---\n",
+		"---\nid: " + doc.ID.String() + "\nversion: x\n---\n",
+		"---\nname: \"unterminated\n---\n",
+		"---\nname: a\n",
+	} {
+		_, err = gitsync.ParseDocument([]byte(in))
+		require.ErrorIs(t, err, gitsync.ErrInvalidDocument, in)
+	}
+}
+
+func TestLayout(t *testing.T) {
+	t.Parallel()
+
+	root, child, draft, underDraft, noSlug := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	paths := gitsync.Layout([]entity.ExportItem{
+		{ID: root, ParentID: &[]uuid.UUID{uuid.New()}[0], Slug: "guide"},
+		{ID: child, ParentID: &root, Slug: "install"},
+		{ID: draft, ParentID: &root, Slug: "draft", IsDraft: true},
+		{ID: noSlug, ParentID: &child},
+		{ID: underDraft, ParentID: &draft, Slug: "hidden"},
+	})
+	require.Equal(t, map[uuid.UUID]string{
+		root:   "guide.md",
+		child:  "guide/install.md",
+		noSlug: "guide/install/" + noSlug.String() + ".md",
+	}, paths)
+}
@@ -0,0 +1,67 @@
+package gitsync
+
+import (
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/gitrepo"
+	"github.com/google/uuid"
+)
+
+// Config mirrors the subtree of root_id to git. Changes of entities in the repository are stored on
+// behalf of author_id. An empty root_id disables the sync.
+type Config struct {
+	RootID          string         `mapstructure:"root_id" json:"root_id"`
+	AuthorID        string         `mapstructure:"author_id" json:"author_id"`
+	IntervalSeconds int            `mapstructure:"interval_seconds" json:"interval_seconds"`
+	Git             gitrepo.Config `mapstructure:"git" json:"git"`
+}
+
+func (c Config) Enabled() bool {
+	return c.RootID != ""
+}
+
+func (c Config) Validate() error {
+	if c.IntervalSeconds <= 0 {
+		return fmt.Errorf("interval_seconds must be positive")
+	}
+	if !c.Enabled() {
+		return nil
+	}
+	if _, err := uuid.Parse(c.RootID); err != nil {
+		return fmt.Errorf("root_id: invalid id %q", c.RootID)
+	}
+	if _, err := uuid.Parse(c.AuthorID); err != nil {
+		return fmt.Errorf("author_id: invalid id %q", c.AuthorID)
+	}
+	if err := c.Git.Validate(); err != nil {
+		return fmt.Errorf("git: %w", err)
+	}
+
+	return nil
+}
+
+// Conflict is a file of the repository that was not applied because its entity changed since the
+// version the file was written from. The file is kept as is until someone resolves it, by setting
+// its version to EntityVersion to overwrite the entity or by reverting it to take the entity.
+type Conflict struct {
+	Path          string    `json:"path"`
+	EntityID      uuid.UUID `json:"entity_id"`
+	FileVersion   int       `json:"file_version"`
+	EntityVersion int       `json:"entity_version"`
+}
+
+// Failure is a file of the repository that could not be applied, e.g. because the content is invalid.
+type Failure struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// Report is the outcome of a sync. Commit is the commit pushed with the entities, empty when the
+// repository already matched them.
+type Report struct {
+	Commit    string      `json:"commit,omitempty"`
+	Updated   []uuid.UUID `json:"updated,omitempty"`
+	Created   []uuid.UUID `json:"created,omitempty"`
+	Conflicts []Conflict  `json:"conflicts,omitempty"`
+	Failures  []Failure   `json:"failures,omitempty"`
+}
@@ -0,0 +1,2323 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/gitsync/usecase.EntityCore -o entity_core_mock.go -n EntityCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// EntityCoreMock implements mm_usecase.EntityCore
+type EntityCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, req entity.CreateEntityReq)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mEntityCoreMockCreate
+
+	funcExport          func(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) (err error)
+	funcExportOrigin    string
+	inspectFuncExport   func(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mEntityCoreMockExport
+
+	funcGet          func(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id uuid.UUID)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mEntityCoreMockGet
+
+	funcGetActivity          func(ctx context.Context, req entity.GetActivityReq, isAdmin bool) (a1 entity.Activity, err error)
+	funcGetActivityOrigin    string
+	inspectFuncGetActivity   func(ctx context.Context, req entity.GetActivityReq, isAdmin bool)
+	afterGetActivityCounter  uint64
+	beforeGetActivityCounter uint64
+	GetActivityMock          mEntityCoreMockGetActivity
+
+	funcGetVersion          func(ctx context.Context, id uuid.UUID, version int) (e1 entity.Entity, err error)
+	funcGetVersionOrigin    string
+	inspectFuncGetVersion   func(ctx context.Context, id uuid.UUID, version int)
+	afterGetVersionCounter  uint64
+	beforeGetVersionCounter uint64
+	GetVersionMock          mEntityCoreMockGetVersion
+
+	funcUpdate          func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, req entity.UpdateEntityReq)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mEntityCoreMockUpdate
+}
+
+// NewEntityCoreMock returns a mock for mm_usecase.EntityCore
+func NewEntityCoreMock(t minimock.Tester) *EntityCoreMock {
+	m := &EntityCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mEntityCoreMockCreate{mock: m}
+	m.CreateMock.callArgs = []*EntityCoreMockCreateParams{}
+
+	m.ExportMock = mEntityCoreMockExport{mock: m}
+	m.ExportMock.callArgs = []*EntityCoreMockExportParams{}
+
+	m.GetMock = mEntityCoreMockGet{mock: m}
+	m.GetMock.callArgs = []*EntityCoreMockGetParams{}
+
+	m.GetActivityMock = mEntityCoreMockGetActivity{mock: m}
+	m.GetActivityMock.callArgs = []*EntityCoreMockGetActivityParams{}
+
+	m.GetVersionMock = mEntityCoreMockGetVersion{mock: m}
+	m.GetVersionMock.callArgs = []*EntityCoreMockGetVersionParams{}
+
+	m.UpdateMock = mEntityCoreMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*EntityCoreMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEntityCoreMockCreate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockCreateExpectation
+	expectations       []*EntityCoreMockCreateExpectation
+
+	callArgs []*EntityCoreMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockCreateExpectation specifies expectation struct of the EntityCore.Create
+type EntityCoreMockCreateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockCreateParams
+	paramPtrs          *EntityCoreMockCreateParamPtrs
+	expectationOrigins EntityCoreMockCreateExpectationOrigins
+	results            *EntityCoreMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockCreateParams contains parameters of the EntityCore.Create
+type EntityCoreMockCreateParams struct {
+	ctx context.Context
+	req entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateParamPtrs contains pointers to parameters of the EntityCore.Create
+type EntityCoreMockCreateParamPtrs struct {
+	ctx *context.Context
+	req *entity.CreateEntityReq
+}
+
+// EntityCoreMockCreateResults contains results of the EntityCore.Create
+type EntityCoreMockCreateResults struct {
+	u1  uuid.UUID
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockCreateOrigins contains origins of expectations of the EntityCore.Create
+type EntityCoreMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mEntityCoreMockCreate) Optional() *mEntityCoreMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Expect(ctx context.Context, req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &EntityCoreMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) ExpectReqParam2(req entity.CreateEntityReq) *mEntityCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EntityCoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Inspect(f func(ctx context.Context, req entity.CreateEntityReq)) *mEntityCoreMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by EntityCore.Create
+func (mmCreate *mEntityCoreMockCreate) Return(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EntityCoreMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &EntityCoreMockCreateResults{u1, c2, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the EntityCore.Create method
+func (mmCreate *mEntityCoreMockCreate) Set(f func(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the EntityCore.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the EntityCore.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the EntityCore.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mEntityCoreMockCreate) When(ctx context.Context, req entity.CreateEntityReq) *EntityCoreMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EntityCoreMock.Create mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &EntityCoreMockCreateParams{ctx, req},
+		expectationOrigins: EntityCoreMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Create return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockCreateExpectation) Then(u1 uuid.UUID, c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockCreateResults{u1, c2, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Create should be invoked
+func (mmCreate *mEntityCoreMockCreate) Times(n uint64) *mEntityCoreMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of EntityCoreMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mEntityCoreMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.EntityCore
+func (mmCreate *EntityCoreMock) Create(ctx context.Context, req entity.CreateEntityReq) (u1 uuid.UUID, c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("EntityCoreMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the EntityCoreMock.Create")
+		}
+		return (*mm_results).u1, (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to EntityCoreMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of EntityCoreMock.Create invocations
+func (mmCreate *EntityCoreMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mEntityCoreMockCreate) Calls() []*EntityCoreMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mEntityCoreMockExport struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockExportExpectation
+	expectations       []*EntityCoreMockExportExpectation
+
+	callArgs []*EntityCoreMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockExportExpectation specifies expectation struct of the EntityCore.Export
+type EntityCoreMockExportExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockExportParams
+	paramPtrs          *EntityCoreMockExportParamPtrs
+	expectationOrigins EntityCoreMockExportExpectationOrigins
+	results            *EntityCoreMockExportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockExportParams contains parameters of the EntityCore.Export
+type EntityCoreMockExportParams struct {
+	ctx     context.Context
+	rootID  *uuid.UUID
+	isAdmin bool
+	write   func([]entity.ExportItem) error
+}
+
+// EntityCoreMockExportParamPtrs contains pointers to parameters of the EntityCore.Export
+type EntityCoreMockExportParamPtrs struct {
+	ctx     *context.Context
+	rootID  **uuid.UUID
+	isAdmin *bool
+	write   *func([]entity.ExportItem) error
+}
+
+// EntityCoreMockExportResults contains results of the EntityCore.Export
+type EntityCoreMockExportResults struct {
+	err error
+}
+
+// EntityCoreMockExportOrigins contains origins of expectations of the EntityCore.Export
+type EntityCoreMockExportExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRootID  string
+	originIsAdmin string
+	originWrite   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mEntityCoreMockExport) Optional() *mEntityCoreMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for EntityCore.Export
+func (mmExport *mEntityCoreMockExport) Expect(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) *mEntityCoreMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &EntityCoreMockExportParams{ctx, rootID, isAdmin, write}
+	mmExport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Export
+func (mmExport *mEntityCoreMockExport) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &EntityCoreMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for EntityCore.Export
+func (mmExport *mEntityCoreMockExport) ExpectRootIDParam2(rootID *uuid.UUID) *mEntityCoreMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &EntityCoreMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.rootID = &rootID
+	mmExport.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectIsAdminParam3 sets up expected param isAdmin for EntityCore.Export
+func (mmExport *mEntityCoreMockExport) ExpectIsAdminParam3(isAdmin bool) *mEntityCoreMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &EntityCoreMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.isAdmin = &isAdmin
+	mmExport.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectWriteParam4 sets up expected param write for EntityCore.Export
+func (mmExport *mEntityCoreMockExport) ExpectWriteParam4(write func([]entity.ExportItem) error) *mEntityCoreMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &EntityCoreMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.write = &write
+	mmExport.defaultExpectation.expectationOrigins.originWrite = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Export
+func (mmExport *mEntityCoreMockExport) Inspect(f func(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error)) *mEntityCoreMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by EntityCore.Export
+func (mmExport *mEntityCoreMockExport) Return(err error) *EntityCoreMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &EntityCoreMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &EntityCoreMockExportResults{err}
+	mmExport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the EntityCore.Export method
+func (mmExport *mEntityCoreMockExport) Set(f func(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) (err error)) *EntityCoreMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the EntityCore.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the EntityCore.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	mmExport.mock.funcExportOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// When sets expectation for the EntityCore.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mEntityCoreMockExport) When(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) *EntityCoreMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("EntityCoreMock.Export mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockExportExpectation{
+		mock:               mmExport.mock,
+		params:             &EntityCoreMockExportParams{ctx, rootID, isAdmin, write},
+		expectationOrigins: EntityCoreMockExportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Export return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockExportExpectation) Then(err error) *EntityCoreMock {
+	e.results = &EntityCoreMockExportResults{err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Export should be invoked
+func (mmExport *mEntityCoreMockExport) Times(n uint64) *mEntityCoreMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of EntityCoreMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	mmExport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExport
+}
+
+func (mmExport *mEntityCoreMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements mm_usecase.EntityCore
+func (mmExport *EntityCoreMock) Export(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) (err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	mmExport.t.Helper()
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, rootID, isAdmin, write)
+	}
+
+	mm_params := EntityCoreMockExportParams{ctx, rootID, isAdmin, write}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockExportParams{ctx, rootID, isAdmin, write}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("EntityCoreMock.Export got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmExport.t.Errorf("EntityCoreMock.Export got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
+				mmExport.t.Errorf("EntityCoreMock.Export got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			}
+
+			if mm_want_ptrs.write != nil && !minimock.Equal(*mm_want_ptrs.write, mm_got.write) {
+				mmExport.t.Errorf("EntityCoreMock.Export got unexpected parameter write, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originWrite, *mm_want_ptrs.write, mm_got.write, minimock.Diff(*mm_want_ptrs.write, mm_got.write))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("EntityCoreMock.Export got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExport.ExportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the EntityCoreMock.Export")
+		}
+		return (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, rootID, isAdmin, write)
+	}
+	mmExport.t.Fatalf("Unexpected call to EntityCoreMock.Export. %v %v %v %v", ctx, rootID, isAdmin, write)
+	return
+}
+
+// ExportAfterCounter returns a count of finished EntityCoreMock.Export invocations
+func (mmExport *EntityCoreMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of EntityCoreMock.Export invocations
+func (mmExport *EntityCoreMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mEntityCoreMockExport) Calls() []*EntityCoreMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Export at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Export at\n%s", m.ExportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Export at\n%s with params: %#v", m.ExportMock.defaultExpectation.expectationOrigins.origin, *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Export at\n%s", m.funcExportOrigin)
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Export at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), m.ExportMock.expectedInvocationsOrigin, afterExportCounter)
+	}
+}
+
+type mEntityCoreMockGet struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetExpectation
+	expectations       []*EntityCoreMockGetExpectation
+
+	callArgs []*EntityCoreMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetExpectation specifies expectation struct of the EntityCore.Get
+type EntityCoreMockGetExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetParams
+	paramPtrs          *EntityCoreMockGetParamPtrs
+	expectationOrigins EntityCoreMockGetExpectationOrigins
+	results            *EntityCoreMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetParams contains parameters of the EntityCore.Get
+type EntityCoreMockGetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// EntityCoreMockGetParamPtrs contains pointers to parameters of the EntityCore.Get
+type EntityCoreMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// EntityCoreMockGetResults contains results of the EntityCore.Get
+type EntityCoreMockGetResults struct {
+	e1  entity.Entity
+	err error
+}
+
+// EntityCoreMockGetOrigins contains origins of expectations of the EntityCore.Get
+type EntityCoreMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mEntityCoreMockGet) Optional() *mEntityCoreMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for EntityCore.Get
+func (mmGet *mEntityCoreMockGet) Expect(ctx context.Context, id uuid.UUID) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &EntityCoreMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Get
+func (mmGet *mEntityCoreMockGet) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &EntityCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for EntityCore.Get
+func (mmGet *mEntityCoreMockGet) ExpectIdParam2(id uuid.UUID) *mEntityCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &EntityCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Get
+func (mmGet *mEntityCoreMockGet) Inspect(f func(ctx context.Context, id uuid.UUID)) *mEntityCoreMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by EntityCore.Get
+func (mmGet *mEntityCoreMockGet) Return(e1 entity.Entity, err error) *EntityCoreMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &EntityCoreMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &EntityCoreMockGetResults{e1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the EntityCore.Get method
+func (mmGet *mEntityCoreMockGet) Set(f func(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error)) *EntityCoreMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the EntityCore.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the EntityCore.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the EntityCore.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mEntityCoreMockGet) When(ctx context.Context, id uuid.UUID) *EntityCoreMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("EntityCoreMock.Get mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &EntityCoreMockGetParams{ctx, id},
+		expectationOrigins: EntityCoreMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Get return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetExpectation) Then(e1 entity.Entity, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Get should be invoked
+func (mmGet *mEntityCoreMockGet) Times(n uint64) *mEntityCoreMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of EntityCoreMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mEntityCoreMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_usecase.EntityCore
+func (mmGet *EntityCoreMock) Get(ctx context.Context, id uuid.UUID) (e1 entity.Entity, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := EntityCoreMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("EntityCoreMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the EntityCoreMock.Get")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to EntityCoreMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished EntityCoreMock.Get invocations
+func (mmGet *EntityCoreMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of EntityCoreMock.Get invocations
+func (mmGet *EntityCoreMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mEntityCoreMockGet) Calls() []*EntityCoreMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mEntityCoreMockGetActivity struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetActivityExpectation
+	expectations       []*EntityCoreMockGetActivityExpectation
+
+	callArgs []*EntityCoreMockGetActivityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetActivityExpectation specifies expectation struct of the EntityCore.GetActivity
+type EntityCoreMockGetActivityExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetActivityParams
+	paramPtrs          *EntityCoreMockGetActivityParamPtrs
+	expectationOrigins EntityCoreMockGetActivityExpectationOrigins
+	results            *EntityCoreMockGetActivityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetActivityParams contains parameters of the EntityCore.GetActivity
+type EntityCoreMockGetActivityParams struct {
+	ctx     context.Context
+	req     entity.GetActivityReq
+	isAdmin bool
+}
+
+// EntityCoreMockGetActivityParamPtrs contains pointers to parameters of the EntityCore.GetActivity
+type EntityCoreMockGetActivityParamPtrs struct {
+	ctx     *context.Context
+	req     *entity.GetActivityReq
+	isAdmin *bool
+}
+
+// EntityCoreMockGetActivityResults contains results of the EntityCore.GetActivity
+type EntityCoreMockGetActivityResults struct {
+	a1  entity.Activity
+	err error
+}
+
+// EntityCoreMockGetActivityOrigins contains origins of expectations of the EntityCore.GetActivity
+type EntityCoreMockGetActivityExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originReq     string
+	originIsAdmin string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetActivity *mEntityCoreMockGetActivity) Optional() *mEntityCoreMockGetActivity {
+	mmGetActivity.optional = true
+	return mmGetActivity
+}
+
+// Expect sets up expected params for EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) Expect(ctx context.Context, req entity.GetActivityReq, isAdmin bool) *mEntityCoreMockGetActivity {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	if mmGetActivity.defaultExpectation == nil {
+		mmGetActivity.defaultExpectation = &EntityCoreMockGetActivityExpectation{}
+	}
+
+	if mmGetActivity.defaultExpectation.paramPtrs != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by ExpectParams functions")
+	}
+
+	mmGetActivity.defaultExpectation.params = &EntityCoreMockGetActivityParams{ctx, req, isAdmin}
+	mmGetActivity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetActivity.expectations {
+		if minimock.Equal(e.params, mmGetActivity.defaultExpectation.params) {
+			mmGetActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetActivity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetActivity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGetActivity {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	if mmGetActivity.defaultExpectation == nil {
+		mmGetActivity.defaultExpectation = &EntityCoreMockGetActivityExpectation{}
+	}
+
+	if mmGetActivity.defaultExpectation.params != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Expect")
+	}
+
+	if mmGetActivity.defaultExpectation.paramPtrs == nil {
+		mmGetActivity.defaultExpectation.paramPtrs = &EntityCoreMockGetActivityParamPtrs{}
+	}
+	mmGetActivity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetActivity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetActivity
+}
+
+// ExpectReqParam2 sets up expected param req for EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) ExpectReqParam2(req entity.GetActivityReq) *mEntityCoreMockGetActivity {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	if mmGetActivity.defaultExpectation == nil {
+		mmGetActivity.defaultExpectation = &EntityCoreMockGetActivityExpectation{}
+	}
+
+	if mmGetActivity.defaultExpectation.params != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Expect")
+	}
+
+	if mmGetActivity.defaultExpectation.paramPtrs == nil {
+		mmGetActivity.defaultExpectation.paramPtrs = &EntityCoreMockGetActivityParamPtrs{}
+	}
+	mmGetActivity.defaultExpectation.paramPtrs.req = &req
+	mmGetActivity.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetActivity
+}
+
+// ExpectIsAdminParam3 sets up expected param isAdmin for EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) ExpectIsAdminParam3(isAdmin bool) *mEntityCoreMockGetActivity {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	if mmGetActivity.defaultExpectation == nil {
+		mmGetActivity.defaultExpectation = &EntityCoreMockGetActivityExpectation{}
+	}
+
+	if mmGetActivity.defaultExpectation.params != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Expect")
+	}
+
+	if mmGetActivity.defaultExpectation.paramPtrs == nil {
+		mmGetActivity.defaultExpectation.paramPtrs = &EntityCoreMockGetActivityParamPtrs{}
+	}
+	mmGetActivity.defaultExpectation.paramPtrs.isAdmin = &isAdmin
+	mmGetActivity.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+
+	return mmGetActivity
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) Inspect(f func(ctx context.Context, req entity.GetActivityReq, isAdmin bool)) *mEntityCoreMockGetActivity {
+	if mmGetActivity.mock.inspectFuncGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.GetActivity")
+	}
+
+	mmGetActivity.mock.inspectFuncGetActivity = f
+
+	return mmGetActivity
+}
+
+// Return sets up results that will be returned by EntityCore.GetActivity
+func (mmGetActivity *mEntityCoreMockGetActivity) Return(a1 entity.Activity, err error) *EntityCoreMock {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	if mmGetActivity.defaultExpectation == nil {
+		mmGetActivity.defaultExpectation = &EntityCoreMockGetActivityExpectation{mock: mmGetActivity.mock}
+	}
+	mmGetActivity.defaultExpectation.results = &EntityCoreMockGetActivityResults{a1, err}
+	mmGetActivity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetActivity.mock
+}
+
+// Set uses given function f to mock the EntityCore.GetActivity method
+func (mmGetActivity *mEntityCoreMockGetActivity) Set(f func(ctx context.Context, req entity.GetActivityReq, isAdmin bool) (a1 entity.Activity, err error)) *EntityCoreMock {
+	if mmGetActivity.defaultExpectation != nil {
+		mmGetActivity.mock.t.Fatalf("Default expectation is already set for the EntityCore.GetActivity method")
+	}
+
+	if len(mmGetActivity.expectations) > 0 {
+		mmGetActivity.mock.t.Fatalf("Some expectations are already set for the EntityCore.GetActivity method")
+	}
+
+	mmGetActivity.mock.funcGetActivity = f
+	mmGetActivity.mock.funcGetActivityOrigin = minimock.CallerInfo(1)
+	return mmGetActivity.mock
+}
+
+// When sets expectation for the EntityCore.GetActivity which will trigger the result defined by the following
+// Then helper
+func (mmGetActivity *mEntityCoreMockGetActivity) When(ctx context.Context, req entity.GetActivityReq, isAdmin bool) *EntityCoreMockGetActivityExpectation {
+	if mmGetActivity.mock.funcGetActivity != nil {
+		mmGetActivity.mock.t.Fatalf("EntityCoreMock.GetActivity mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetActivityExpectation{
+		mock:               mmGetActivity.mock,
+		params:             &EntityCoreMockGetActivityParams{ctx, req, isAdmin},
+		expectationOrigins: EntityCoreMockGetActivityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetActivity.expectations = append(mmGetActivity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.GetActivity return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetActivityExpectation) Then(a1 entity.Activity, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetActivityResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.GetActivity should be invoked
+func (mmGetActivity *mEntityCoreMockGetActivity) Times(n uint64) *mEntityCoreMockGetActivity {
+	if n == 0 {
+		mmGetActivity.mock.t.Fatalf("Times of EntityCoreMock.GetActivity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetActivity.expectedInvocations, n)
+	mmGetActivity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetActivity
+}
+
+func (mmGetActivity *mEntityCoreMockGetActivity) invocationsDone() bool {
+	if len(mmGetActivity.expectations) == 0 && mmGetActivity.defaultExpectation == nil && mmGetActivity.mock.funcGetActivity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetActivity.mock.afterGetActivityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetActivity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetActivity implements mm_usecase.EntityCore
+func (mmGetActivity *EntityCoreMock) GetActivity(ctx context.Context, req entity.GetActivityReq, isAdmin bool) (a1 entity.Activity, err error) {
+	mm_atomic.AddUint64(&mmGetActivity.beforeGetActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetActivity.afterGetActivityCounter, 1)
+
+	mmGetActivity.t.Helper()
+
+	if mmGetActivity.inspectFuncGetActivity != nil {
+		mmGetActivity.inspectFuncGetActivity(ctx, req, isAdmin)
+	}
+
+	mm_params := EntityCoreMockGetActivityParams{ctx, req, isAdmin}
+
+	// Record call args
+	mmGetActivity.GetActivityMock.mutex.Lock()
+	mmGetActivity.GetActivityMock.callArgs = append(mmGetActivity.GetActivityMock.callArgs, &mm_params)
+	mmGetActivity.GetActivityMock.mutex.Unlock()
+
+	for _, e := range mmGetActivity.GetActivityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetActivity.GetActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetActivity.GetActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetActivity.GetActivityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetActivity.GetActivityMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetActivityParams{ctx, req, isAdmin}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetActivity.t.Errorf("EntityCoreMock.GetActivity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActivity.GetActivityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetActivity.t.Errorf("EntityCoreMock.GetActivity got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActivity.GetActivityMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
+				mmGetActivity.t.Errorf("EntityCoreMock.GetActivity got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetActivity.GetActivityMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetActivity.t.Errorf("EntityCoreMock.GetActivity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetActivity.GetActivityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetActivity.GetActivityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetActivity.t.Fatal("No results are set for the EntityCoreMock.GetActivity")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetActivity.funcGetActivity != nil {
+		return mmGetActivity.funcGetActivity(ctx, req, isAdmin)
+	}
+	mmGetActivity.t.Fatalf("Unexpected call to EntityCoreMock.GetActivity. %v %v %v", ctx, req, isAdmin)
+	return
+}
+
+// GetActivityAfterCounter returns a count of finished EntityCoreMock.GetActivity invocations
+func (mmGetActivity *EntityCoreMock) GetActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActivity.afterGetActivityCounter)
+}
+
+// GetActivityBeforeCounter returns a count of EntityCoreMock.GetActivity invocations
+func (mmGetActivity *EntityCoreMock) GetActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActivity.beforeGetActivityCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.GetActivity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetActivity *mEntityCoreMockGetActivity) Calls() []*EntityCoreMockGetActivityParams {
+	mmGetActivity.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetActivityParams, len(mmGetActivity.callArgs))
+	copy(argCopy, mmGetActivity.callArgs)
+
+	mmGetActivity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetActivityDone returns true if the count of the GetActivity invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetActivityDone() bool {
+	if m.GetActivityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetActivityMock.invocationsDone()
+}
+
+// MinimockGetActivityInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetActivityInspect() {
+	for _, e := range m.GetActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.GetActivity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetActivityCounter := mm_atomic.LoadUint64(&m.afterGetActivityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActivityMock.defaultExpectation != nil && afterGetActivityCounter < 1 {
+		if m.GetActivityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.GetActivity at\n%s", m.GetActivityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.GetActivity at\n%s with params: %#v", m.GetActivityMock.defaultExpectation.expectationOrigins.origin, *m.GetActivityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActivity != nil && afterGetActivityCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.GetActivity at\n%s", m.funcGetActivityOrigin)
+	}
+
+	if !m.GetActivityMock.invocationsDone() && afterGetActivityCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.GetActivity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetActivityMock.expectedInvocations), m.GetActivityMock.expectedInvocationsOrigin, afterGetActivityCounter)
+	}
+}
+
+type mEntityCoreMockGetVersion struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockGetVersionExpectation
+	expectations       []*EntityCoreMockGetVersionExpectation
+
+	callArgs []*EntityCoreMockGetVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockGetVersionExpectation specifies expectation struct of the EntityCore.GetVersion
+type EntityCoreMockGetVersionExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockGetVersionParams
+	paramPtrs          *EntityCoreMockGetVersionParamPtrs
+	expectationOrigins EntityCoreMockGetVersionExpectationOrigins
+	results            *EntityCoreMockGetVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockGetVersionParams contains parameters of the EntityCore.GetVersion
+type EntityCoreMockGetVersionParams struct {
+	ctx     context.Context
+	id      uuid.UUID
+	version int
+}
+
+// EntityCoreMockGetVersionParamPtrs contains pointers to parameters of the EntityCore.GetVersion
+type EntityCoreMockGetVersionParamPtrs struct {
+	ctx     *context.Context
+	id      *uuid.UUID
+	version *int
+}
+
+// EntityCoreMockGetVersionResults contains results of the EntityCore.GetVersion
+type EntityCoreMockGetVersionResults struct {
+	e1  entity.Entity
+	err error
+}
+
+// EntityCoreMockGetVersionOrigins contains origins of expectations of the EntityCore.GetVersion
+type EntityCoreMockGetVersionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originId      string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVersion *mEntityCoreMockGetVersion) Optional() *mEntityCoreMockGetVersion {
+	mmGetVersion.optional = true
+	return mmGetVersion
+}
+
+// Expect sets up expected params for EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) Expect(ctx context.Context, id uuid.UUID, version int) *mEntityCoreMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &EntityCoreMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVersion.defaultExpectation.params = &EntityCoreMockGetVersionParams{ctx, id, version}
+	mmGetVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVersion.expectations {
+		if minimock.Equal(e.params, mmGetVersion.defaultExpectation.params) {
+			mmGetVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &EntityCoreMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &EntityCoreMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectIdParam2 sets up expected param id for EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) ExpectIdParam2(id uuid.UUID) *mEntityCoreMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &EntityCoreMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &EntityCoreMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.id = &id
+	mmGetVersion.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectVersionParam3 sets up expected param version for EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) ExpectVersionParam3(version int) *mEntityCoreMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &EntityCoreMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &EntityCoreMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.version = &version
+	mmGetVersion.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) Inspect(f func(ctx context.Context, id uuid.UUID, version int)) *mEntityCoreMockGetVersion {
+	if mmGetVersion.mock.inspectFuncGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.GetVersion")
+	}
+
+	mmGetVersion.mock.inspectFuncGetVersion = f
+
+	return mmGetVersion
+}
+
+// Return sets up results that will be returned by EntityCore.GetVersion
+func (mmGetVersion *mEntityCoreMockGetVersion) Return(e1 entity.Entity, err error) *EntityCoreMock {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &EntityCoreMockGetVersionExpectation{mock: mmGetVersion.mock}
+	}
+	mmGetVersion.defaultExpectation.results = &EntityCoreMockGetVersionResults{e1, err}
+	mmGetVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// Set uses given function f to mock the EntityCore.GetVersion method
+func (mmGetVersion *mEntityCoreMockGetVersion) Set(f func(ctx context.Context, id uuid.UUID, version int) (e1 entity.Entity, err error)) *EntityCoreMock {
+	if mmGetVersion.defaultExpectation != nil {
+		mmGetVersion.mock.t.Fatalf("Default expectation is already set for the EntityCore.GetVersion method")
+	}
+
+	if len(mmGetVersion.expectations) > 0 {
+		mmGetVersion.mock.t.Fatalf("Some expectations are already set for the EntityCore.GetVersion method")
+	}
+
+	mmGetVersion.mock.funcGetVersion = f
+	mmGetVersion.mock.funcGetVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// When sets expectation for the EntityCore.GetVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVersion *mEntityCoreMockGetVersion) When(ctx context.Context, id uuid.UUID, version int) *EntityCoreMockGetVersionExpectation {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("EntityCoreMock.GetVersion mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockGetVersionExpectation{
+		mock:               mmGetVersion.mock,
+		params:             &EntityCoreMockGetVersionParams{ctx, id, version},
+		expectationOrigins: EntityCoreMockGetVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVersion.expectations = append(mmGetVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.GetVersion return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockGetVersionExpectation) Then(e1 entity.Entity, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockGetVersionResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.GetVersion should be invoked
+func (mmGetVersion *mEntityCoreMockGetVersion) Times(n uint64) *mEntityCoreMockGetVersion {
+	if n == 0 {
+		mmGetVersion.mock.t.Fatalf("Times of EntityCoreMock.GetVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVersion.expectedInvocations, n)
+	mmGetVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVersion
+}
+
+func (mmGetVersion *mEntityCoreMockGetVersion) invocationsDone() bool {
+	if len(mmGetVersion.expectations) == 0 && mmGetVersion.defaultExpectation == nil && mmGetVersion.mock.funcGetVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVersion.mock.afterGetVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVersion implements mm_usecase.EntityCore
+func (mmGetVersion *EntityCoreMock) GetVersion(ctx context.Context, id uuid.UUID, version int) (e1 entity.Entity, err error) {
+	mm_atomic.AddUint64(&mmGetVersion.beforeGetVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVersion.afterGetVersionCounter, 1)
+
+	mmGetVersion.t.Helper()
+
+	if mmGetVersion.inspectFuncGetVersion != nil {
+		mmGetVersion.inspectFuncGetVersion(ctx, id, version)
+	}
+
+	mm_params := EntityCoreMockGetVersionParams{ctx, id, version}
+
+	// Record call args
+	mmGetVersion.GetVersionMock.mutex.Lock()
+	mmGetVersion.GetVersionMock.callArgs = append(mmGetVersion.GetVersionMock.callArgs, &mm_params)
+	mmGetVersion.GetVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVersion.GetVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmGetVersion.GetVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVersion.GetVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVersion.GetVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVersion.GetVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockGetVersionParams{ctx, id, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVersion.t.Errorf("EntityCoreMock.GetVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetVersion.t.Errorf("EntityCoreMock.GetVersion got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetVersion.t.Errorf("EntityCoreMock.GetVersion got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVersion.t.Errorf("EntityCoreMock.GetVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVersion.GetVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVersion.t.Fatal("No results are set for the EntityCoreMock.GetVersion")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmGetVersion.funcGetVersion != nil {
+		return mmGetVersion.funcGetVersion(ctx, id, version)
+	}
+	mmGetVersion.t.Fatalf("Unexpected call to EntityCoreMock.GetVersion. %v %v %v", ctx, id, version)
+	return
+}
+
+// GetVersionAfterCounter returns a count of finished EntityCoreMock.GetVersion invocations
+func (mmGetVersion *EntityCoreMock) GetVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.afterGetVersionCounter)
+}
+
+// GetVersionBeforeCounter returns a count of EntityCoreMock.GetVersion invocations
+func (mmGetVersion *EntityCoreMock) GetVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.beforeGetVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.GetVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVersion *mEntityCoreMockGetVersion) Calls() []*EntityCoreMockGetVersionParams {
+	mmGetVersion.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockGetVersionParams, len(mmGetVersion.callArgs))
+	copy(argCopy, mmGetVersion.callArgs)
+
+	mmGetVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVersionDone returns true if the count of the GetVersion invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockGetVersionDone() bool {
+	if m.GetVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVersionMock.invocationsDone()
+}
+
+// MinimockGetVersionInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockGetVersionInspect() {
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.GetVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVersionCounter := mm_atomic.LoadUint64(&m.afterGetVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVersionMock.defaultExpectation != nil && afterGetVersionCounter < 1 {
+		if m.GetVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.GetVersion at\n%s", m.GetVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.GetVersion at\n%s with params: %#v", m.GetVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVersion != nil && afterGetVersionCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.GetVersion at\n%s", m.funcGetVersionOrigin)
+	}
+
+	if !m.GetVersionMock.invocationsDone() && afterGetVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.GetVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVersionMock.expectedInvocations), m.GetVersionMock.expectedInvocationsOrigin, afterGetVersionCounter)
+	}
+}
+
+type mEntityCoreMockUpdate struct {
+	optional           bool
+	mock               *EntityCoreMock
+	defaultExpectation *EntityCoreMockUpdateExpectation
+	expectations       []*EntityCoreMockUpdateExpectation
+
+	callArgs []*EntityCoreMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EntityCoreMockUpdateExpectation specifies expectation struct of the EntityCore.Update
+type EntityCoreMockUpdateExpectation struct {
+	mock               *EntityCoreMock
+	params             *EntityCoreMockUpdateParams
+	paramPtrs          *EntityCoreMockUpdateParamPtrs
+	expectationOrigins EntityCoreMockUpdateExpectationOrigins
+	results            *EntityCoreMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EntityCoreMockUpdateParams contains parameters of the EntityCore.Update
+type EntityCoreMockUpdateParams struct {
+	ctx context.Context
+	req entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateParamPtrs contains pointers to parameters of the EntityCore.Update
+type EntityCoreMockUpdateParamPtrs struct {
+	ctx *context.Context
+	req *entity.UpdateEntityReq
+}
+
+// EntityCoreMockUpdateResults contains results of the EntityCore.Update
+type EntityCoreMockUpdateResults struct {
+	c2  entity.ContentUsage
+	err error
+}
+
+// EntityCoreMockUpdateOrigins contains origins of expectations of the EntityCore.Update
+type EntityCoreMockUpdateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mEntityCoreMockUpdate) Optional() *mEntityCoreMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Expect(ctx context.Context, req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &EntityCoreMockUpdateParams{ctx, req}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectCtxParam1(ctx context.Context) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectReqParam2 sets up expected param req for EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) ExpectReqParam2(req entity.UpdateEntityReq) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &EntityCoreMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.req = &req
+	mmUpdate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Inspect(f func(ctx context.Context, req entity.UpdateEntityReq)) *mEntityCoreMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for EntityCoreMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by EntityCore.Update
+func (mmUpdate *mEntityCoreMockUpdate) Return(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &EntityCoreMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &EntityCoreMockUpdateResults{c2, err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the EntityCore.Update method
+func (mmUpdate *mEntityCoreMockUpdate) Set(f func(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error)) *EntityCoreMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the EntityCore.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the EntityCore.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the EntityCore.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mEntityCoreMockUpdate) When(ctx context.Context, req entity.UpdateEntityReq) *EntityCoreMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("EntityCoreMock.Update mock is already set by Set")
+	}
+
+	expectation := &EntityCoreMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &EntityCoreMockUpdateParams{ctx, req},
+		expectationOrigins: EntityCoreMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EntityCore.Update return parameters for the expectation previously defined by the When method
+func (e *EntityCoreMockUpdateExpectation) Then(c2 entity.ContentUsage, err error) *EntityCoreMock {
+	e.results = &EntityCoreMockUpdateResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times EntityCore.Update should be invoked
+func (mmUpdate *mEntityCoreMockUpdate) Times(n uint64) *mEntityCoreMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of EntityCoreMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mEntityCoreMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_usecase.EntityCore
+func (mmUpdate *EntityCoreMock) Update(ctx context.Context, req entity.UpdateEntityReq) (c2 entity.ContentUsage, err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, req)
+	}
+
+	mm_params := EntityCoreMockUpdateParams{ctx, req}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := EntityCoreMockUpdateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("EntityCoreMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the EntityCoreMock.Update")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, req)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to EntityCoreMock.Update. %v %v", ctx, req)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of EntityCoreMock.Update invocations
+func (mmUpdate *EntityCoreMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EntityCoreMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mEntityCoreMockUpdate) Calls() []*EntityCoreMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*EntityCoreMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *EntityCoreMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *EntityCoreMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to EntityCoreMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EntityCoreMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EntityCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockExportInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetActivityInspect()
+
+			m.MinimockGetVersionInspect()
+
+			m.MinimockUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EntityCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EntityCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockExportDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetActivityDone() &&
+		m.MinimockGetVersionDone() &&
+		m.MinimockUpdateDone()
+}
@@ -0,0 +1,1264 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/gitsync/usecase.Git -o git_mock.go -n GitMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// GitMock implements mm_usecase.Git
+type GitMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCommitAll          func(ctx context.Context, message string) (s1 string, err error)
+	funcCommitAllOrigin    string
+	inspectFuncCommitAll   func(ctx context.Context, message string)
+	afterCommitAllCounter  uint64
+	beforeCommitAllCounter uint64
+	CommitAllMock          mGitMockCommitAll
+
+	funcDir          func() (s1 string)
+	funcDirOrigin    string
+	inspectFuncDir   func()
+	afterDirCounter  uint64
+	beforeDirCounter uint64
+	DirMock          mGitMockDir
+
+	funcPull          func(ctx context.Context) (s1 string, err error)
+	funcPullOrigin    string
+	inspectFuncPull   func(ctx context.Context)
+	afterPullCounter  uint64
+	beforePullCounter uint64
+	PullMock          mGitMockPull
+
+	funcPush          func(ctx context.Context) (err error)
+	funcPushOrigin    string
+	inspectFuncPush   func(ctx context.Context)
+	afterPushCounter  uint64
+	beforePushCounter uint64
+	PushMock          mGitMockPush
+}
+
+// NewGitMock returns a mock for mm_usecase.Git
+func NewGitMock(t minimock.Tester) *GitMock {
+	m := &GitMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CommitAllMock = mGitMockCommitAll{mock: m}
+	m.CommitAllMock.callArgs = []*GitMockCommitAllParams{}
+
+	m.DirMock = mGitMockDir{mock: m}
+
+	m.PullMock = mGitMockPull{mock: m}
+	m.PullMock.callArgs = []*GitMockPullParams{}
+
+	m.PushMock = mGitMockPush{mock: m}
+	m.PushMock.callArgs = []*GitMockPushParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mGitMockCommitAll struct {
+	optional           bool
+	mock               *GitMock
+	defaultExpectation *GitMockCommitAllExpectation
+	expectations       []*GitMockCommitAllExpectation
+
+	callArgs []*GitMockCommitAllParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GitMockCommitAllExpectation specifies expectation struct of the Git.CommitAll
+type GitMockCommitAllExpectation struct {
+	mock               *GitMock
+	params             *GitMockCommitAllParams
+	paramPtrs          *GitMockCommitAllParamPtrs
+	expectationOrigins GitMockCommitAllExpectationOrigins
+	results            *GitMockCommitAllResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GitMockCommitAllParams contains parameters of the Git.CommitAll
+type GitMockCommitAllParams struct {
+	ctx     context.Context
+	message string
+}
+
+// GitMockCommitAllParamPtrs contains pointers to parameters of the Git.CommitAll
+type GitMockCommitAllParamPtrs struct {
+	ctx     *context.Context
+	message *string
+}
+
+// GitMockCommitAllResults contains results of the Git.CommitAll
+type GitMockCommitAllResults struct {
+	s1  string
+	err error
+}
+
+// GitMockCommitAllOrigins contains origins of expectations of the Git.CommitAll
+type GitMockCommitAllExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCommitAll *mGitMockCommitAll) Optional() *mGitMockCommitAll {
+	mmCommitAll.optional = true
+	return mmCommitAll
+}
+
+// Expect sets up expected params for Git.CommitAll
+func (mmCommitAll *mGitMockCommitAll) Expect(ctx context.Context, message string) *mGitMockCommitAll {
+	if mmCommitAll.mock.funcCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Set")
+	}
+
+	if mmCommitAll.defaultExpectation == nil {
+		mmCommitAll.defaultExpectation = &GitMockCommitAllExpectation{}
+	}
+
+	if mmCommitAll.defaultExpectation.paramPtrs != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by ExpectParams functions")
+	}
+
+	mmCommitAll.defaultExpectation.params = &GitMockCommitAllParams{ctx, message}
+	mmCommitAll.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCommitAll.expectations {
+		if minimock.Equal(e.params, mmCommitAll.defaultExpectation.params) {
+			mmCommitAll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCommitAll.defaultExpectation.params)
+		}
+	}
+
+	return mmCommitAll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Git.CommitAll
+func (mmCommitAll *mGitMockCommitAll) ExpectCtxParam1(ctx context.Context) *mGitMockCommitAll {
+	if mmCommitAll.mock.funcCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Set")
+	}
+
+	if mmCommitAll.defaultExpectation == nil {
+		mmCommitAll.defaultExpectation = &GitMockCommitAllExpectation{}
+	}
+
+	if mmCommitAll.defaultExpectation.params != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Expect")
+	}
+
+	if mmCommitAll.defaultExpectation.paramPtrs == nil {
+		mmCommitAll.defaultExpectation.paramPtrs = &GitMockCommitAllParamPtrs{}
+	}
+	mmCommitAll.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCommitAll.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCommitAll
+}
+
+// ExpectMessageParam2 sets up expected param message for Git.CommitAll
+func (mmCommitAll *mGitMockCommitAll) ExpectMessageParam2(message string) *mGitMockCommitAll {
+	if mmCommitAll.mock.funcCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Set")
+	}
+
+	if mmCommitAll.defaultExpectation == nil {
+		mmCommitAll.defaultExpectation = &GitMockCommitAllExpectation{}
+	}
+
+	if mmCommitAll.defaultExpectation.params != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Expect")
+	}
+
+	if mmCommitAll.defaultExpectation.paramPtrs == nil {
+		mmCommitAll.defaultExpectation.paramPtrs = &GitMockCommitAllParamPtrs{}
+	}
+	mmCommitAll.defaultExpectation.paramPtrs.message = &message
+	mmCommitAll.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmCommitAll
+}
+
+// Inspect accepts an inspector function that has same arguments as the Git.CommitAll
+func (mmCommitAll *mGitMockCommitAll) Inspect(f func(ctx context.Context, message string)) *mGitMockCommitAll {
+	if mmCommitAll.mock.inspectFuncCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("Inspect function is already set for GitMock.CommitAll")
+	}
+
+	mmCommitAll.mock.inspectFuncCommitAll = f
+
+	return mmCommitAll
+}
+
+// Return sets up results that will be returned by Git.CommitAll
+func (mmCommitAll *mGitMockCommitAll) Return(s1 string, err error) *GitMock {
+	if mmCommitAll.mock.funcCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Set")
+	}
+
+	if mmCommitAll.defaultExpectation == nil {
+		mmCommitAll.defaultExpectation = &GitMockCommitAllExpectation{mock: mmCommitAll.mock}
+	}
+	mmCommitAll.defaultExpectation.results = &GitMockCommitAllResults{s1, err}
+	mmCommitAll.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCommitAll.mock
+}
+
+// Set uses given function f to mock the Git.CommitAll method
+func (mmCommitAll *mGitMockCommitAll) Set(f func(ctx context.Context, message string) (s1 string, err error)) *GitMock {
+	if mmCommitAll.defaultExpectation != nil {
+		mmCommitAll.mock.t.Fatalf("Default expectation is already set for the Git.CommitAll method")
+	}
+
+	if len(mmCommitAll.expectations) > 0 {
+		mmCommitAll.mock.t.Fatalf("Some expectations are already set for the Git.CommitAll method")
+	}
+
+	mmCommitAll.mock.funcCommitAll = f
+	mmCommitAll.mock.funcCommitAllOrigin = minimock.CallerInfo(1)
+	return mmCommitAll.mock
+}
+
+// When sets expectation for the Git.CommitAll which will trigger the result defined by the following
+// Then helper
+func (mmCommitAll *mGitMockCommitAll) When(ctx context.Context, message string) *GitMockCommitAllExpectation {
+	if mmCommitAll.mock.funcCommitAll != nil {
+		mmCommitAll.mock.t.Fatalf("GitMock.CommitAll mock is already set by Set")
+	}
+
+	expectation := &GitMockCommitAllExpectation{
+		mock:               mmCommitAll.mock,
+		params:             &GitMockCommitAllParams{ctx, message},
+		expectationOrigins: GitMockCommitAllExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCommitAll.expectations = append(mmCommitAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Git.CommitAll return parameters for the expectation previously defined by the When method
+func (e *GitMockCommitAllExpectation) Then(s1 string, err error) *GitMock {
+	e.results = &GitMockCommitAllResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Git.CommitAll should be invoked
+func (mmCommitAll *mGitMockCommitAll) Times(n uint64) *mGitMockCommitAll {
+	if n == 0 {
+		mmCommitAll.mock.t.Fatalf("Times of GitMock.CommitAll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCommitAll.expectedInvocations, n)
+	mmCommitAll.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCommitAll
+}
+
+func (mmCommitAll *mGitMockCommitAll) invocationsDone() bool {
+	if len(mmCommitAll.expectations) == 0 && mmCommitAll.defaultExpectation == nil && mmCommitAll.mock.funcCommitAll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCommitAll.mock.afterCommitAllCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCommitAll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CommitAll implements mm_usecase.Git
+func (mmCommitAll *GitMock) CommitAll(ctx context.Context, message string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmCommitAll.beforeCommitAllCounter, 1)
+	defer mm_atomic.AddUint64(&mmCommitAll.afterCommitAllCounter, 1)
+
+	mmCommitAll.t.Helper()
+
+	if mmCommitAll.inspectFuncCommitAll != nil {
+		mmCommitAll.inspectFuncCommitAll(ctx, message)
+	}
+
+	mm_params := GitMockCommitAllParams{ctx, message}
+
+	// Record call args
+	mmCommitAll.CommitAllMock.mutex.Lock()
+	mmCommitAll.CommitAllMock.callArgs = append(mmCommitAll.CommitAllMock.callArgs, &mm_params)
+	mmCommitAll.CommitAllMock.mutex.Unlock()
+
+	for _, e := range mmCommitAll.CommitAllMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCommitAll.CommitAllMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCommitAll.CommitAllMock.defaultExpectation.Counter, 1)
+		mm_want := mmCommitAll.CommitAllMock.defaultExpectation.params
+		mm_want_ptrs := mmCommitAll.CommitAllMock.defaultExpectation.paramPtrs
+
+		mm_got := GitMockCommitAllParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCommitAll.t.Errorf("GitMock.CommitAll got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCommitAll.CommitAllMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmCommitAll.t.Errorf("GitMock.CommitAll got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCommitAll.CommitAllMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCommitAll.t.Errorf("GitMock.CommitAll got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCommitAll.CommitAllMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCommitAll.CommitAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCommitAll.t.Fatal("No results are set for the GitMock.CommitAll")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCommitAll.funcCommitAll != nil {
+		return mmCommitAll.funcCommitAll(ctx, message)
+	}
+	mmCommitAll.t.Fatalf("Unexpected call to GitMock.CommitAll. %v %v", ctx, message)
+	return
+}
+
+// CommitAllAfterCounter returns a count of finished GitMock.CommitAll invocations
+func (mmCommitAll *GitMock) CommitAllAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCommitAll.afterCommitAllCounter)
+}
+
+// CommitAllBeforeCounter returns a count of GitMock.CommitAll invocations
+func (mmCommitAll *GitMock) CommitAllBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCommitAll.beforeCommitAllCounter)
+}
+
+// Calls returns a list of arguments used in each call to GitMock.CommitAll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCommitAll *mGitMockCommitAll) Calls() []*GitMockCommitAllParams {
+	mmCommitAll.mutex.RLock()
+
+	argCopy := make([]*GitMockCommitAllParams, len(mmCommitAll.callArgs))
+	copy(argCopy, mmCommitAll.callArgs)
+
+	mmCommitAll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCommitAllDone returns true if the count of the CommitAll invocations corresponds
+// the number of defined expectations
+func (m *GitMock) MinimockCommitAllDone() bool {
+	if m.CommitAllMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CommitAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CommitAllMock.invocationsDone()
+}
+
+// MinimockCommitAllInspect logs each unmet expectation
+func (m *GitMock) MinimockCommitAllInspect() {
+	for _, e := range m.CommitAllMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GitMock.CommitAll at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCommitAllCounter := mm_atomic.LoadUint64(&m.afterCommitAllCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CommitAllMock.defaultExpectation != nil && afterCommitAllCounter < 1 {
+		if m.CommitAllMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GitMock.CommitAll at\n%s", m.CommitAllMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GitMock.CommitAll at\n%s with params: %#v", m.CommitAllMock.defaultExpectation.expectationOrigins.origin, *m.CommitAllMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCommitAll != nil && afterCommitAllCounter < 1 {
+		m.t.Errorf("Expected call to GitMock.CommitAll at\n%s", m.funcCommitAllOrigin)
+	}
+
+	if !m.CommitAllMock.invocationsDone() && afterCommitAllCounter > 0 {
+		m.t.Errorf("Expected %d calls to GitMock.CommitAll at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CommitAllMock.expectedInvocations), m.CommitAllMock.expectedInvocationsOrigin, afterCommitAllCounter)
+	}
+}
+
+type mGitMockDir struct {
+	optional           bool
+	mock               *GitMock
+	defaultExpectation *GitMockDirExpectation
+	expectations       []*GitMockDirExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GitMockDirExpectation specifies expectation struct of the Git.Dir
+type GitMockDirExpectation struct {
+	mock *GitMock
+
+	results      *GitMockDirResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// GitMockDirResults contains results of the Git.Dir
+type GitMockDirResults struct {
+	s1 string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDir *mGitMockDir) Optional() *mGitMockDir {
+	mmDir.optional = true
+	return mmDir
+}
+
+// Expect sets up expected params for Git.Dir
+func (mmDir *mGitMockDir) Expect() *mGitMockDir {
+	if mmDir.mock.funcDir != nil {
+		mmDir.mock.t.Fatalf("GitMock.Dir mock is already set by Set")
+	}
+
+	if mmDir.defaultExpectation == nil {
+		mmDir.defaultExpectation = &GitMockDirExpectation{}
+	}
+
+	return mmDir
+}
+
+// Inspect accepts an inspector function that has same arguments as the Git.Dir
+func (mmDir *mGitMockDir) Inspect(f func()) *mGitMockDir {
+	if mmDir.mock.inspectFuncDir != nil {
+		mmDir.mock.t.Fatalf("Inspect function is already set for GitMock.Dir")
+	}
+
+	mmDir.mock.inspectFuncDir = f
+
+	return mmDir
+}
+
+// Return sets up results that will be returned by Git.Dir
+func (mmDir *mGitMockDir) Return(s1 string) *GitMock {
+	if mmDir.mock.funcDir != nil {
+		mmDir.mock.t.Fatalf("GitMock.Dir mock is already set by Set")
+	}
+
+	if mmDir.defaultExpectation == nil {
+		mmDir.defaultExpectation = &GitMockDirExpectation{mock: mmDir.mock}
+	}
+	mmDir.defaultExpectation.results = &GitMockDirResults{s1}
+	mmDir.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDir.mock
+}
+
+// Set uses given function f to mock the Git.Dir method
+func (mmDir *mGitMockDir) Set(f func() (s1 string)) *GitMock {
+	if mmDir.defaultExpectation != nil {
+		mmDir.mock.t.Fatalf("Default expectation is already set for the Git.Dir method")
+	}
+
+	if len(mmDir.expectations) > 0 {
+		mmDir.mock.t.Fatalf("Some expectations are already set for the Git.Dir method")
+	}
+
+	mmDir.mock.funcDir = f
+	mmDir.mock.funcDirOrigin = minimock.CallerInfo(1)
+	return mmDir.mock
+}
+
+// Times sets number of times Git.Dir should be invoked
+func (mmDir *mGitMockDir) Times(n uint64) *mGitMockDir {
+	if n == 0 {
+		mmDir.mock.t.Fatalf("Times of GitMock.Dir mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDir.expectedInvocations, n)
+	mmDir.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDir
+}
+
+func (mmDir *mGitMockDir) invocationsDone() bool {
+	if len(mmDir.expectations) == 0 && mmDir.defaultExpectation == nil && mmDir.mock.funcDir == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDir.mock.afterDirCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDir.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Dir implements mm_usecase.Git
+func (mmDir *GitMock) Dir() (s1 string) {
+	mm_atomic.AddUint64(&mmDir.beforeDirCounter, 1)
+	defer mm_atomic.AddUint64(&mmDir.afterDirCounter, 1)
+
+	mmDir.t.Helper()
+
+	if mmDir.inspectFuncDir != nil {
+		mmDir.inspectFuncDir()
+	}
+
+	if mmDir.DirMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDir.DirMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmDir.DirMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDir.t.Fatal("No results are set for the GitMock.Dir")
+		}
+		return (*mm_results).s1
+	}
+	if mmDir.funcDir != nil {
+		return mmDir.funcDir()
+	}
+	mmDir.t.Fatalf("Unexpected call to GitMock.Dir.")
+	return
+}
+
+// DirAfterCounter returns a count of finished GitMock.Dir invocations
+func (mmDir *GitMock) DirAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDir.afterDirCounter)
+}
+
+// DirBeforeCounter returns a count of GitMock.Dir invocations
+func (mmDir *GitMock) DirBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDir.beforeDirCounter)
+}
+
+// MinimockDirDone returns true if the count of the Dir invocations corresponds
+// the number of defined expectations
+func (m *GitMock) MinimockDirDone() bool {
+	if m.DirMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DirMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DirMock.invocationsDone()
+}
+
+// MinimockDirInspect logs each unmet expectation
+func (m *GitMock) MinimockDirInspect() {
+	for _, e := range m.DirMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to GitMock.Dir")
+		}
+	}
+
+	afterDirCounter := mm_atomic.LoadUint64(&m.afterDirCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DirMock.defaultExpectation != nil && afterDirCounter < 1 {
+		m.t.Errorf("Expected call to GitMock.Dir at\n%s", m.DirMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDir != nil && afterDirCounter < 1 {
+		m.t.Errorf("Expected call to GitMock.Dir at\n%s", m.funcDirOrigin)
+	}
+
+	if !m.DirMock.invocationsDone() && afterDirCounter > 0 {
+		m.t.Errorf("Expected %d calls to GitMock.Dir at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DirMock.expectedInvocations), m.DirMock.expectedInvocationsOrigin, afterDirCounter)
+	}
+}
+
+type mGitMockPull struct {
+	optional           bool
+	mock               *GitMock
+	defaultExpectation *GitMockPullExpectation
+	expectations       []*GitMockPullExpectation
+
+	callArgs []*GitMockPullParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GitMockPullExpectation specifies expectation struct of the Git.Pull
+type GitMockPullExpectation struct {
+	mock               *GitMock
+	params             *GitMockPullParams
+	paramPtrs          *GitMockPullParamPtrs
+	expectationOrigins GitMockPullExpectationOrigins
+	results            *GitMockPullResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GitMockPullParams contains parameters of the Git.Pull
+type GitMockPullParams struct {
+	ctx context.Context
+}
+
+// GitMockPullParamPtrs contains pointers to parameters of the Git.Pull
+type GitMockPullParamPtrs struct {
+	ctx *context.Context
+}
+
+// GitMockPullResults contains results of the Git.Pull
+type GitMockPullResults struct {
+	s1  string
+	err error
+}
+
+// GitMockPullOrigins contains origins of expectations of the Git.Pull
+type GitMockPullExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPull *mGitMockPull) Optional() *mGitMockPull {
+	mmPull.optional = true
+	return mmPull
+}
+
+// Expect sets up expected params for Git.Pull
+func (mmPull *mGitMockPull) Expect(ctx context.Context) *mGitMockPull {
+	if mmPull.mock.funcPull != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by Set")
+	}
+
+	if mmPull.defaultExpectation == nil {
+		mmPull.defaultExpectation = &GitMockPullExpectation{}
+	}
+
+	if mmPull.defaultExpectation.paramPtrs != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by ExpectParams functions")
+	}
+
+	mmPull.defaultExpectation.params = &GitMockPullParams{ctx}
+	mmPull.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPull.expectations {
+		if minimock.Equal(e.params, mmPull.defaultExpectation.params) {
+			mmPull.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPull.defaultExpectation.params)
+		}
+	}
+
+	return mmPull
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Git.Pull
+func (mmPull *mGitMockPull) ExpectCtxParam1(ctx context.Context) *mGitMockPull {
+	if mmPull.mock.funcPull != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by Set")
+	}
+
+	if mmPull.defaultExpectation == nil {
+		mmPull.defaultExpectation = &GitMockPullExpectation{}
+	}
+
+	if mmPull.defaultExpectation.params != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by Expect")
+	}
+
+	if mmPull.defaultExpectation.paramPtrs == nil {
+		mmPull.defaultExpectation.paramPtrs = &GitMockPullParamPtrs{}
+	}
+	mmPull.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPull.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPull
+}
+
+// Inspect accepts an inspector function that has same arguments as the Git.Pull
+func (mmPull *mGitMockPull) Inspect(f func(ctx context.Context)) *mGitMockPull {
+	if mmPull.mock.inspectFuncPull != nil {
+		mmPull.mock.t.Fatalf("Inspect function is already set for GitMock.Pull")
+	}
+
+	mmPull.mock.inspectFuncPull = f
+
+	return mmPull
+}
+
+// Return sets up results that will be returned by Git.Pull
+func (mmPull *mGitMockPull) Return(s1 string, err error) *GitMock {
+	if mmPull.mock.funcPull != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by Set")
+	}
+
+	if mmPull.defaultExpectation == nil {
+		mmPull.defaultExpectation = &GitMockPullExpectation{mock: mmPull.mock}
+	}
+	mmPull.defaultExpectation.results = &GitMockPullResults{s1, err}
+	mmPull.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPull.mock
+}
+
+// Set uses given function f to mock the Git.Pull method
+func (mmPull *mGitMockPull) Set(f func(ctx context.Context) (s1 string, err error)) *GitMock {
+	if mmPull.defaultExpectation != nil {
+		mmPull.mock.t.Fatalf("Default expectation is already set for the Git.Pull method")
+	}
+
+	if len(mmPull.expectations) > 0 {
+		mmPull.mock.t.Fatalf("Some expectations are already set for the Git.Pull method")
+	}
+
+	mmPull.mock.funcPull = f
+	mmPull.mock.funcPullOrigin = minimock.CallerInfo(1)
+	return mmPull.mock
+}
+
+// When sets expectation for the Git.Pull which will trigger the result defined by the following
+// Then helper
+func (mmPull *mGitMockPull) When(ctx context.Context) *GitMockPullExpectation {
+	if mmPull.mock.funcPull != nil {
+		mmPull.mock.t.Fatalf("GitMock.Pull mock is already set by Set")
+	}
+
+	expectation := &GitMockPullExpectation{
+		mock:               mmPull.mock,
+		params:             &GitMockPullParams{ctx},
+		expectationOrigins: GitMockPullExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPull.expectations = append(mmPull.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Git.Pull return parameters for the expectation previously defined by the When method
+func (e *GitMockPullExpectation) Then(s1 string, err error) *GitMock {
+	e.results = &GitMockPullResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Git.Pull should be invoked
+func (mmPull *mGitMockPull) Times(n uint64) *mGitMockPull {
+	if n == 0 {
+		mmPull.mock.t.Fatalf("Times of GitMock.Pull mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPull.expectedInvocations, n)
+	mmPull.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPull
+}
+
+func (mmPull *mGitMockPull) invocationsDone() bool {
+	if len(mmPull.expectations) == 0 && mmPull.defaultExpectation == nil && mmPull.mock.funcPull == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPull.mock.afterPullCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPull.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Pull implements mm_usecase.Git
+func (mmPull *GitMock) Pull(ctx context.Context) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmPull.beforePullCounter, 1)
+	defer mm_atomic.AddUint64(&mmPull.afterPullCounter, 1)
+
+	mmPull.t.Helper()
+
+	if mmPull.inspectFuncPull != nil {
+		mmPull.inspectFuncPull(ctx)
+	}
+
+	mm_params := GitMockPullParams{ctx}
+
+	// Record call args
+	mmPull.PullMock.mutex.Lock()
+	mmPull.PullMock.callArgs = append(mmPull.PullMock.callArgs, &mm_params)
+	mmPull.PullMock.mutex.Unlock()
+
+	for _, e := range mmPull.PullMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmPull.PullMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPull.PullMock.defaultExpectation.Counter, 1)
+		mm_want := mmPull.PullMock.defaultExpectation.params
+		mm_want_ptrs := mmPull.PullMock.defaultExpectation.paramPtrs
+
+		mm_got := GitMockPullParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPull.t.Errorf("GitMock.Pull got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPull.PullMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPull.t.Errorf("GitMock.Pull got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPull.PullMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPull.PullMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPull.t.Fatal("No results are set for the GitMock.Pull")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmPull.funcPull != nil {
+		return mmPull.funcPull(ctx)
+	}
+	mmPull.t.Fatalf("Unexpected call to GitMock.Pull. %v", ctx)
+	return
+}
+
+// PullAfterCounter returns a count of finished GitMock.Pull invocations
+func (mmPull *GitMock) PullAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPull.afterPullCounter)
+}
+
+// PullBeforeCounter returns a count of GitMock.Pull invocations
+func (mmPull *GitMock) PullBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPull.beforePullCounter)
+}
+
+// Calls returns a list of arguments used in each call to GitMock.Pull.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPull *mGitMockPull) Calls() []*GitMockPullParams {
+	mmPull.mutex.RLock()
+
+	argCopy := make([]*GitMockPullParams, len(mmPull.callArgs))
+	copy(argCopy, mmPull.callArgs)
+
+	mmPull.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPullDone returns true if the count of the Pull invocations corresponds
+// the number of defined expectations
+func (m *GitMock) MinimockPullDone() bool {
+	if m.PullMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PullMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PullMock.invocationsDone()
+}
+
+// MinimockPullInspect logs each unmet expectation
+func (m *GitMock) MinimockPullInspect() {
+	for _, e := range m.PullMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GitMock.Pull at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPullCounter := mm_atomic.LoadUint64(&m.afterPullCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PullMock.defaultExpectation != nil && afterPullCounter < 1 {
+		if m.PullMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GitMock.Pull at\n%s", m.PullMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GitMock.Pull at\n%s with params: %#v", m.PullMock.defaultExpectation.expectationOrigins.origin, *m.PullMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPull != nil && afterPullCounter < 1 {
+		m.t.Errorf("Expected call to GitMock.Pull at\n%s", m.funcPullOrigin)
+	}
+
+	if !m.PullMock.invocationsDone() && afterPullCounter > 0 {
+		m.t.Errorf("Expected %d calls to GitMock.Pull at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PullMock.expectedInvocations), m.PullMock.expectedInvocationsOrigin, afterPullCounter)
+	}
+}
+
+type mGitMockPush struct {
+	optional           bool
+	mock               *GitMock
+	defaultExpectation *GitMockPushExpectation
+	expectations       []*GitMockPushExpectation
+
+	callArgs []*GitMockPushParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// GitMockPushExpectation specifies expectation struct of the Git.Push
+type GitMockPushExpectation struct {
+	mock               *GitMock
+	params             *GitMockPushParams
+	paramPtrs          *GitMockPushParamPtrs
+	expectationOrigins GitMockPushExpectationOrigins
+	results            *GitMockPushResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// GitMockPushParams contains parameters of the Git.Push
+type GitMockPushParams struct {
+	ctx context.Context
+}
+
+// GitMockPushParamPtrs contains pointers to parameters of the Git.Push
+type GitMockPushParamPtrs struct {
+	ctx *context.Context
+}
+
+// GitMockPushResults contains results of the Git.Push
+type GitMockPushResults struct {
+	err error
+}
+
+// GitMockPushOrigins contains origins of expectations of the Git.Push
+type GitMockPushExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPush *mGitMockPush) Optional() *mGitMockPush {
+	mmPush.optional = true
+	return mmPush
+}
+
+// Expect sets up expected params for Git.Push
+func (mmPush *mGitMockPush) Expect(ctx context.Context) *mGitMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &GitMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.paramPtrs != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by ExpectParams functions")
+	}
+
+	mmPush.defaultExpectation.params = &GitMockPushParams{ctx}
+	mmPush.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPush.expectations {
+		if minimock.Equal(e.params, mmPush.defaultExpectation.params) {
+			mmPush.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPush.defaultExpectation.params)
+		}
+	}
+
+	return mmPush
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Git.Push
+func (mmPush *mGitMockPush) ExpectCtxParam1(ctx context.Context) *mGitMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &GitMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.params != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by Expect")
+	}
+
+	if mmPush.defaultExpectation.paramPtrs == nil {
+		mmPush.defaultExpectation.paramPtrs = &GitMockPushParamPtrs{}
+	}
+	mmPush.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPush.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPush
+}
+
+// Inspect accepts an inspector function that has same arguments as the Git.Push
+func (mmPush *mGitMockPush) Inspect(f func(ctx context.Context)) *mGitMockPush {
+	if mmPush.mock.inspectFuncPush != nil {
+		mmPush.mock.t.Fatalf("Inspect function is already set for GitMock.Push")
+	}
+
+	mmPush.mock.inspectFuncPush = f
+
+	return mmPush
+}
+
+// Return sets up results that will be returned by Git.Push
+func (mmPush *mGitMockPush) Return(err error) *GitMock {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &GitMockPushExpectation{mock: mmPush.mock}
+	}
+	mmPush.defaultExpectation.results = &GitMockPushResults{err}
+	mmPush.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPush.mock
+}
+
+// Set uses given function f to mock the Git.Push method
+func (mmPush *mGitMockPush) Set(f func(ctx context.Context) (err error)) *GitMock {
+	if mmPush.defaultExpectation != nil {
+		mmPush.mock.t.Fatalf("Default expectation is already set for the Git.Push method")
+	}
+
+	if len(mmPush.expectations) > 0 {
+		mmPush.mock.t.Fatalf("Some expectations are already set for the Git.Push method")
+	}
+
+	mmPush.mock.funcPush = f
+	mmPush.mock.funcPushOrigin = minimock.CallerInfo(1)
+	return mmPush.mock
+}
+
+// When sets expectation for the Git.Push which will trigger the result defined by the following
+// Then helper
+func (mmPush *mGitMockPush) When(ctx context.Context) *GitMockPushExpectation {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("GitMock.Push mock is already set by Set")
+	}
+
+	expectation := &GitMockPushExpectation{
+		mock:               mmPush.mock,
+		params:             &GitMockPushParams{ctx},
+		expectationOrigins: GitMockPushExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPush.expectations = append(mmPush.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Git.Push return parameters for the expectation previously defined by the When method
+func (e *GitMockPushExpectation) Then(err error) *GitMock {
+	e.results = &GitMockPushResults{err}
+	return e.mock
+}
+
+// Times sets number of times Git.Push should be invoked
+func (mmPush *mGitMockPush) Times(n uint64) *mGitMockPush {
+	if n == 0 {
+		mmPush.mock.t.Fatalf("Times of GitMock.Push mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPush.expectedInvocations, n)
+	mmPush.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPush
+}
+
+func (mmPush *mGitMockPush) invocationsDone() bool {
+	if len(mmPush.expectations) == 0 && mmPush.defaultExpectation == nil && mmPush.mock.funcPush == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPush.mock.afterPushCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPush.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Push implements mm_usecase.Git
+func (mmPush *GitMock) Push(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmPush.beforePushCounter, 1)
+	defer mm_atomic.AddUint64(&mmPush.afterPushCounter, 1)
+
+	mmPush.t.Helper()
+
+	if mmPush.inspectFuncPush != nil {
+		mmPush.inspectFuncPush(ctx)
+	}
+
+	mm_params := GitMockPushParams{ctx}
+
+	// Record call args
+	mmPush.PushMock.mutex.Lock()
+	mmPush.PushMock.callArgs = append(mmPush.PushMock.callArgs, &mm_params)
+	mmPush.PushMock.mutex.Unlock()
+
+	for _, e := range mmPush.PushMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPush.PushMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPush.PushMock.defaultExpectation.Counter, 1)
+		mm_want := mmPush.PushMock.defaultExpectation.params
+		mm_want_ptrs := mmPush.PushMock.defaultExpectation.paramPtrs
+
+		mm_got := GitMockPushParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPush.t.Errorf("GitMock.Push got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPush.PushMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPush.t.Errorf("GitMock.Push got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPush.PushMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPush.PushMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPush.t.Fatal("No results are set for the GitMock.Push")
+		}
+		return (*mm_results).err
+	}
+	if mmPush.funcPush != nil {
+		return mmPush.funcPush(ctx)
+	}
+	mmPush.t.Fatalf("Unexpected call to GitMock.Push. %v", ctx)
+	return
+}
+
+// PushAfterCounter returns a count of finished GitMock.Push invocations
+func (mmPush *GitMock) PushAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPush.afterPushCounter)
+}
+
+// PushBeforeCounter returns a count of GitMock.Push invocations
+func (mmPush *GitMock) PushBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPush.beforePushCounter)
+}
+
+// Calls returns a list of arguments used in each call to GitMock.Push.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPush *mGitMockPush) Calls() []*GitMockPushParams {
+	mmPush.mutex.RLock()
+
+	argCopy := make([]*GitMockPushParams, len(mmPush.callArgs))
+	copy(argCopy, mmPush.callArgs)
+
+	mmPush.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPushDone returns true if the count of the Push invocations corresponds
+// the number of defined expectations
+func (m *GitMock) MinimockPushDone() bool {
+	if m.PushMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PushMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PushMock.invocationsDone()
+}
+
+// MinimockPushInspect logs each unmet expectation
+func (m *GitMock) MinimockPushInspect() {
+	for _, e := range m.PushMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GitMock.Push at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPushCounter := mm_atomic.LoadUint64(&m.afterPushCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PushMock.defaultExpectation != nil && afterPushCounter < 1 {
+		if m.PushMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to GitMock.Push at\n%s", m.PushMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to GitMock.Push at\n%s with params: %#v", m.PushMock.defaultExpectation.expectationOrigins.origin, *m.PushMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPush != nil && afterPushCounter < 1 {
+		m.t.Errorf("Expected call to GitMock.Push at\n%s", m.funcPushOrigin)
+	}
+
+	if !m.PushMock.invocationsDone() && afterPushCounter > 0 {
+		m.t.Errorf("Expected %d calls to GitMock.Push at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PushMock.expectedInvocations), m.PushMock.expectedInvocationsOrigin, afterPushCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GitMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCommitAllInspect()
+
+			m.MinimockDirInspect()
+
+			m.MinimockPullInspect()
+
+			m.MinimockPushInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GitMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GitMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCommitAllDone() &&
+		m.MinimockDirDone() &&
+		m.MinimockPullDone() &&
+		m.MinimockPushDone()
+}
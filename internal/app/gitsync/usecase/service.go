@@ -0,0 +1,344 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/gitsync"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+type EntityCore interface {
+	Get(ctx context.Context, id uuid.UUID) (entity.Entity, error)
+	Export(ctx context.Context, rootID *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) error
+	GetActivity(ctx context.Context, req entity.GetActivityReq, isAdmin bool) (entity.Activity, error)
+	GetVersion(ctx context.Context, id uuid.UUID, version int) (entity.Entity, error)
+	Create(ctx context.Context, req entity.CreateEntityReq) (uuid.UUID, entity.ContentUsage, error)
+	Update(ctx context.Context, req entity.UpdateEntityReq) (entity.ContentUsage, error)
+}
+
+// Git is a working copy of the branch the subtree is mirrored to.
+type Git interface {
+	Dir() string
+	Pull(ctx context.Context) (string, error)
+	CommitAll(ctx context.Context, message string) (string, error)
+	Push(ctx context.Context) error
+}
+
+const commitMessage = "Update documents from EasyGoDocs"
+
+// Service mirrors a subtree to git in both directions. The entities stay the source of truth: the
+// repository is rebuilt from them on every sync, after the changes made in the repository since the
+// last one were applied to them.
+type Service struct {
+	core     EntityCore
+	git      Git
+	rootID   uuid.UUID
+	authorID uuid.UUID
+
+	mu sync.Mutex
+	// head and eventID are the states of both sides after the last sync
+	synced  bool
+	head    string
+	eventID int64
+}
+
+func NewService(core EntityCore, git Git, cfg gitsync.Config) (*Service, error) {
+	if core == nil || git == nil {
+		panic("gitsync.NewService: nil dependency")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("gitsync.NewService: %w", err)
+	}
+	rootID, err := uuid.Parse(cfg.RootID)
+	if err != nil {
+		return nil, fmt.Errorf("gitsync.NewService: root_id: %w", err)
+	}
+	authorID, err := uuid.Parse(cfg.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("gitsync.NewService: author_id: %w", err)
+	}
+
+	return &Service{core: core, git: git, rootID: rootID, authorID: authorID}, nil
+}
+
+// Sync applies the commits pushed since the last sync to the entities, then writes the subtree to
+// the repository and pushes it. Nothing is done when neither the branch nor the event log of the
+// subtree moved since the last sync. Files that conflict or fail are reported and left in the
+// repository as they are. It runs in the workspace of the root, so the documents added in the
+// repository are created there.
+func (s *Service) Sync(ctx context.Context) (gitsync.Report, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	root, err := s.core.Get(ctx, s.rootID)
+	if err != nil {
+		return gitsync.Report{}, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	ctx = contextx.SetWorkspaceID(ctx, root.WorkspaceID)
+
+	head, err := s.git.Pull(ctx)
+	if err != nil {
+		return gitsync.Report{}, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	// minor edits change the files too
+	activity, err := s.core.GetActivity(ctx, entity.GetActivityReq{ID: s.rootID, Limit: 1, IncludeMinor: true}, true)
+	if err != nil {
+		return gitsync.Report{}, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	var eventID int64
+	if len(activity.Events) > 0 {
+		eventID = activity.Events[0].ID
+	}
+	if s.synced && head == s.head && eventID == s.eventID {
+		return gitsync.Report{}, nil
+	}
+
+	items, err := s.export(ctx)
+	if err != nil {
+		return gitsync.Report{}, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	files, err := s.readFiles()
+	if err != nil {
+		return gitsync.Report{}, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	var report gitsync.Report
+	keep, err := s.apply(ctx, items, files, &report)
+	if err != nil {
+		return report, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	if len(report.Updated) > 0 || len(report.Created) > 0 {
+		if items, err = s.export(ctx); err != nil {
+			return report, fmt.Errorf("gitsync.service.Sync: %w", err)
+		}
+	}
+	if err = s.writeFiles(items, files, keep); err != nil {
+		return report, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+
+	commit, err := s.git.CommitAll(ctx, commitMessage)
+	if err != nil {
+		return report, fmt.Errorf("gitsync.service.Sync: %w", err)
+	}
+	if commit != "" {
+		if err = s.git.Push(ctx); err != nil {
+			return report, fmt.Errorf("gitsync.service.Sync: %w", err)
+		}
+		report.Commit, head = commit, commit
+	}
+	s.synced, s.head, s.eventID = true, head, eventID
+
+	return report, nil
+}
+
+func (s *Service) export(ctx context.Context) ([]entity.ExportItem, error) {
+	var items []entity.ExportItem
+	err := s.core.Export(ctx, &s.rootID, true, func(page []entity.ExportItem) error {
+		items = append(items, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// readFiles returns the contents of the document files of the working copy by slash-separated path.
+func (s *Service) readFiles() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(s.git.Dir(), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(d.Name(), gitsync.Ext) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.git.Dir(), p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// apply stores the documents changed in the repository. A document whose version is the current one
+// of its entity overwrites it; an older version must still match what the entity had then, or the
+// entity changed on both sides. A document without id is created under the entity owning its
+// directory. It returns the paths of the files to leave as they are.
+func (s *Service) apply(ctx context.Context, items []entity.ExportItem, files map[string][]byte, report *gitsync.Report) (map[string]struct{}, error) {
+	layout := gitsync.Layout(items)
+	byID := make(map[uuid.UUID]entity.ExportItem, len(items))
+	dirs := make(map[string]entity.ExportItem, len(items))
+	for _, item := range items {
+		if p, ok := layout[item.ID]; ok {
+			byID[item.ID] = item
+			dirs[strings.TrimSuffix(p, gitsync.Ext)] = item
+		}
+	}
+
+	keep := make(map[string]struct{})
+	fail := func(p string, err error) {
+		report.Failures = append(report.Failures, gitsync.Failure{Path: p, Error: err.Error()})
+		keep[p] = struct{}{}
+	}
+	seen := make(map[uuid.UUID]string)
+	for _, p := range slices.Sorted(maps.Keys(files)) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		doc, err := gitsync.ParseDocument(files[p])
+		if err != nil {
+			fail(p, err)
+			continue
+		}
+
+		if doc.ID == uuid.Nil {
+			parent, ok := dirs[path.Dir(p)]
+			if !ok {
+				fail(p, errors.New("no entity owns the directory of the file"))
+				continue
+			}
+			if doc.Name == "" {
+				doc.Name = strings.TrimSuffix(path.Base(p), gitsync.Ext)
+			}
+			if doc.Type == "" {
+				doc.Type = entity.TypeArticle
+			}
+			id, _, err := s.core.Create(ctx, entity.CreateEntityReq{
+				Type:     doc.Type,
+				Name:     doc.Name,
+				Content:  doc.Content,
+				ParentID: &parent.ID,
+				UserID:   s.authorID,
+			})
+			if err != nil {
+				fail(p, err)
+				continue
+			}
+			report.Created = append(report.Created, id)
+			continue
+		}
+
+		// the entity left the subtree or was deleted; its file goes too
+		item, ok := byID[doc.ID]
+		if !ok {
+			continue
+		}
+		if other, ok := seen[doc.ID]; ok {
+			fail(p, fmt.Errorf("%s has the same id", other))
+			continue
+		}
+		seen[doc.ID] = p
+		if doc.Name == "" {
+			doc.Name = item.Name
+		}
+
+		conflict := gitsync.Conflict{Path: p, EntityID: doc.ID, FileVersion: doc.Version, EntityVersion: item.Version}
+		switch {
+		case doc.Version > item.Version:
+			report.Conflicts = append(report.Conflicts, conflict)
+			keep[p] = struct{}{}
+			continue
+		case doc.Version < item.Version:
+			old, err := s.core.GetVersion(ctx, doc.ID, doc.Version)
+			// a version dropped by the retention cannot be compared, so the change is not taken
+			if err != nil || old.Name != doc.Name || old.Content != doc.Content {
+				report.Conflicts = append(report.Conflicts, conflict)
+				keep[p] = struct{}{}
+			}
+			continue
+		}
+		if doc.Name == item.Name && doc.Content == item.Content {
+			continue
+		}
+		_, err = s.core.Update(ctx, entity.UpdateEntityReq{
+			ID:         doc.ID,
+			Name:       doc.Name,
+			Content:    doc.Content,
+			ParentID:   item.ParentID,
+			UserID:     s.authorID,
+			EntityType: item.Type,
+		})
+		if err != nil {
+			fail(p, err)
+			continue
+		}
+		report.Updated = append(report.Updated, doc.ID)
+	}
+
+	return keep, nil
+}
+
+// writeFiles makes the working copy match the entities, except for the files in keep.
+func (s *Service) writeFiles(items []entity.ExportItem, files map[string][]byte, keep map[string]struct{}) error {
+	layout := gitsync.Layout(items)
+	wanted := make(map[string]struct{}, len(layout))
+	for _, item := range items {
+		p, ok := layout[item.ID]
+		if !ok {
+			continue
+		}
+		if _, ok = keep[p]; ok {
+			continue
+		}
+		wanted[p] = struct{}{}
+		data := gitsync.Document{
+			ID:      item.ID,
+			Type:    item.Type,
+			Name:    item.Name,
+			Version: item.Version,
+			Content: item.Content,
+		}.Marshal()
+		if string(files[p]) == string(data) {
+			continue
+		}
+		full := filepath.Join(s.git.Dir(), filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(full), 0o750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(full, data, 0o640); err != nil {
+			return err
+		}
+	}
+
+	for p := range files {
+		_, isWanted := wanted[p]
+		_, isKept := keep[p]
+		if isWanted || isKept {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.git.Dir(), filepath.FromSlash(p))); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
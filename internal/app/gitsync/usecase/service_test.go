@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/entity"
+	"github.com/66gu1/easygodocs/internal/app/gitsync"
+	"github.com/66gu1/easygodocs/internal/app/gitsync/usecase"
+	"github.com/66gu1/easygodocs/internal/app/gitsync/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/gitrepo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core *mocks.EntityCoreMock
+	git  *mocks.GitMock
+	dir  string
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	m := mock{
+		core: mocks.NewEntityCoreMock(t),
+		git:  mocks.NewGitMock(t),
+		dir:  t.TempDir(),
+	}
+	m.git.DirMock.Optional().Return(m.dir)
+	return m
+}
+
+func (m mock) write(t *testing.T, path string, data []byte) {
+	t.Helper()
+	full := filepath.Join(m.dir, filepath.FromSlash(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o750))
+	require.NoError(t, os.WriteFile(full, data, 0o600))
+}
+
+func (m mock) files(t *testing.T) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	require.NoError(t, filepath.WalkDir(m.dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(m.dir, p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		files[filepath.ToSlash(rel)] = string(data)
+		return err
+	}))
+	return files
+}
+
+func exportOf(items ...entity.ExportItem) func(context.Context, *uuid.UUID, bool, func([]entity.ExportItem) error) error {
+	return func(_ context.Context, _ *uuid.UUID, _ bool, write func([]entity.ExportItem) error) error {
+		return write(items)
+	}
+}
+
+func doc(item entity.ExportItem) string {
+	return string(gitsync.Document{ID: item.ID, Type: item.Type, Name: item.Name, Version: item.Version, Content: item.Content}.Marshal())
+}
+
+func TestService_Sync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = t.Context()
+		rootID      = uuid.New()
+		workspaceID = uuid.New()
+		wsCtx       = contextx.SetWorkspaceID(ctx, workspaceID)
+		authorID    = uuid.New()
+		cfg         = gitsync.Config{RootID: rootID.String(), AuthorID: authorID.String(), IntervalSeconds: 1, Git: gitRepoCfg()}
+		root        = entity.ExportItem{ID: rootID, Type: entity.TypeArticle, Name: "Guide", Slug: "guide", Content: "Root", Version: 2}
+		child       = entity.ExportItem{ID: uuid.New(), ParentID: &rootID, Type: entity.TypeArticle, Name: "Install", Slug: "install", Content: "Steps", Version: 1}
+		activity    = entity.Activity{Events: []entity.Event{{ID: 5}}}
+		expErr      = fmt.Errorf("test error")
+	)
+	// the sync runs in the workspace of the root
+	inWorkspace := func(m mock) {
+		m.core.GetMock.Expect(ctx, rootID).Return(entity.Entity{ID: rootID, WorkspaceID: workspaceID}, nil)
+	}
+
+	t.Run("export", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		inWorkspace(m)
+		m.write(t, "README.txt", []byte("not a document"))
+		m.write(t, "old.md", []byte(doc(entity.ExportItem{ID: uuid.New(), Name: "Gone", Version: 1})))
+		m.git.PullMock.Return("", nil)
+		m.core.GetActivityMock.Expect(wsCtx, entity.GetActivityReq{ID: rootID, Limit: 1, IncludeMinor: true}, true).Return(activity, nil)
+		m.core.ExportMock.Times(1).Set(exportOf(root, child))
+		m.git.CommitAllMock.Times(1).Return("c1", nil)
+		m.git.PushMock.Times(1).Return(nil)
+		svc, err := usecase.NewService(m.core, m.git, cfg)
+		require.NoError(t, err)
+
+		report, err := svc.Sync(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitsync.Report{Commit: "c1"}, report)
+		require.Equal(t, map[string]string{
+			"README.txt":       "not a document",
+			"guide.md":         doc(root),
+			"guide/install.md": doc(child),
+		}, m.files(t))
+
+		// neither side moved since
+		m.git.PullMock.Return("c1", nil)
+		report, err = svc.Sync(ctx)
+		require.NoError(t, err)
+		require.Empty(t, report)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		inWorkspace(m)
+		var (
+			edited    = root
+			conflict  = child
+			createdID = uuid.New()
+		)
+		edited.Name, edited.Content = "Guide", "Root edited in git"
+		conflict.Content = "Steps edited in git"
+		m.write(t, "guide.md", []byte(doc(edited)))
+		m.write(t, "guide/install.md", []byte(doc(conflict)))
+		m.write(t, "guide/faq.md", []byte("Questions"))
+		m.write(t, "guide/broken.md", []byte("---\nid: 1\n"))
+		m.write(t, "stray.md", []byte(doc(entity.ExportItem{ID: uuid.New(), Name: "Stray", Version: 4})))
+
+		// the entity moved on to version 2 in the meantime
+		current := child
+		current.Content, current.Version = "Steps edited in the app", 2
+		updated := root
+		updated.Content, updated.Version = edited.Content, 3
+		faq := entity.ExportItem{ID: createdID, ParentID: &rootID, Type: entity.TypeArticle, Name: "faq", Slug: "faq", Content: "Questions", Version: 1}
+		calls := 0
+		m.git.PullMock.Return("remote", nil)
+		m.core.GetActivityMock.Return(activity, nil)
+		m.core.ExportMock.Times(2).Set(func(ctx context.Context, id *uuid.UUID, isAdmin bool, write func([]entity.ExportItem) error) error {
+			calls++
+			if calls == 1 {
+				return exportOf(root, current)(ctx, id, isAdmin, write)
+			}
+			return exportOf(updated, current, faq)(ctx, id, isAdmin, write)
+		})
+		m.core.UpdateMock.Expect(wsCtx, entity.UpdateEntityReq{
+			ID: rootID, Name: "Guide", Content: edited.Content, UserID: authorID, EntityType: entity.TypeArticle,
+		}).Return(entity.ContentUsage{}, nil)
+		m.core.GetVersionMock.Expect(wsCtx, child.ID, 1).Return(entity.Entity{Name: child.Name, Content: child.Content}, nil)
+		m.core.CreateMock.Expect(wsCtx, entity.CreateEntityReq{
+			Type: entity.TypeArticle, Name: "faq", Content: "Questions", ParentID: &rootID, UserID: authorID,
+		}).Return(createdID, entity.ContentUsage{}, nil)
+		m.git.CommitAllMock.Return("c2", nil)
+		m.git.PushMock.Return(nil)
+		svc, err := usecase.NewService(m.core, m.git, cfg)
+		require.NoError(t, err)
+
+		report, err := svc.Sync(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Failures, 1)
+		require.Equal(t, "guide/broken.md", report.Failures[0].Path)
+		report.Failures = nil
+		require.Equal(t, gitsync.Report{
+			Commit:  "c2",
+			Updated: []uuid.UUID{rootID},
+			Created: []uuid.UUID{createdID},
+			Conflicts: []gitsync.Conflict{
+				{Path: "guide/install.md", EntityID: child.ID, FileVersion: 1, EntityVersion: 2},
+			},
+		}, report)
+		require.Equal(t, map[string]string{
+			"guide.md":         doc(updated),
+			"guide/install.md": doc(conflict),
+			"guide/faq.md":     doc(faq),
+			"guide/broken.md":  "---\nid: 1\n",
+		}, m.files(t))
+	})
+
+	t.Run("error/pull", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		inWorkspace(m)
+		m.git.PullMock.Return("", expErr)
+		svc, err := usecase.NewService(m.core, m.git, cfg)
+		require.NoError(t, err)
+
+		_, err = svc.Sync(ctx)
+		require.ErrorIs(t, err, expErr)
+	})
+
+	t.Run("error/root", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		m.core.GetMock.Return(entity.Entity{}, expErr)
+		svc, err := usecase.NewService(m.core, m.git, cfg)
+		require.NoError(t, err)
+
+		_, err = svc.Sync(ctx)
+		require.ErrorIs(t, err, expErr)
+	})
+
+	t.Run("error/push", func(t *testing.T) {
+		t.Parallel()
+		m := getMocks(t)
+		inWorkspace(m)
+		m.git.PullMock.Return("", nil)
+		m.core.GetActivityMock.Return(activity, nil)
+		m.core.ExportMock.Set(exportOf(root))
+		m.git.CommitAllMock.Return("c1", nil)
+		m.git.PushMock.Return(expErr)
+		svc, err := usecase.NewService(m.core, m.git, cfg)
+		require.NoError(t, err)
+
+		_, err = svc.Sync(ctx)
+		require.ErrorIs(t, err, expErr)
+	})
+}
+
+func TestNewService(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	_, err := usecase.NewService(m.core, m.git, gitsync.Config{RootID: "x", AuthorID: uuid.NewString(), IntervalSeconds: 1, Git: gitRepoCfg()})
+	require.Error(t, err)
+}
+
+func gitRepoCfg() gitrepo.Config {
+	return gitrepo.Config{Dir: "unused", Remote: "unused", Branch: "main", CommitterName: "Sync", CommitterEmail: "sync@example.com"}
+}
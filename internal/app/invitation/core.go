@@ -0,0 +1,260 @@
+package invitation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type Repository interface {
+	// Create stores the invitation in the workspace of ctx, replacing the pending invitations of the
+	// same email there. It returns user.ErrUserWithEmailAlreadyExists when the email is in use and
+	// ErrEntityNotFound for a role on an entity the workspace does not have.
+	Create(ctx context.Context, inv Invitation, tokenHash string) error
+	// List returns the invitations of the workspace, newest first.
+	List(ctx context.Context) ([]Invitation, error)
+	// Delete deletes an invitation that is not accepted yet.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetByTokenHash looks in every workspace and locks the invitation in the transaction of ctx.
+	GetByTokenHash(ctx context.Context, tokenHash string) (Invitation, error)
+	MarkAccepted(ctx context.Context, id, userID uuid.UUID, at time.Time) error
+}
+
+// EmailValidator normalizes and checks addresses as user registration does.
+type EmailValidator interface {
+	NormalizeEmail(address string) string
+	ValidateEmail(address string, validateLength bool) error
+}
+
+type IDGenerator interface {
+	New() (uuid.UUID, error)
+}
+
+type RNDGenerator interface {
+	New(n int) (string, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+type Generators struct {
+	ID   IDGenerator
+	RND  RNDGenerator
+	Time TimeGenerator
+}
+
+// Config sets how long invitations last and where they are accepted. Without accept_url no email is
+// sent; the admin passes the token on instead.
+type Config struct {
+	TTLHours int `mapstructure:"ttl_hours" json:"ttl_hours"`
+	// AcceptURL is the page of the web client that accepts invitations; the token is appended to it.
+	AcceptURL string `mapstructure:"accept_url" json:"accept_url"`
+	Subject   string `mapstructure:"subject" json:"subject"`
+}
+
+func (c Config) Validate() error {
+	if c.TTLHours <= 0 {
+		return fmt.Errorf("ttl_hours must be positive")
+	}
+	if c.AcceptURL != "" {
+		u, err := url.Parse(c.AcceptURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("accept_url must be an absolute http(s) URL")
+		}
+	}
+	if c.Subject == "" {
+		return fmt.Errorf("subject must not be empty")
+	}
+
+	return nil
+}
+
+// URL returns the link accepting the invitation with token, or "" without AcceptURL.
+func (c Config) URL(token string) string {
+	if c.AcceptURL == "" {
+		return ""
+	}
+
+	return c.AcceptURL + url.PathEscape(token)
+}
+
+// tokenBytes is the entropy of a token, as for refresh tokens.
+const tokenBytes = 32
+
+type core struct {
+	repo      Repository
+	gen       Generators
+	validator EmailValidator
+	cfg       Config
+}
+
+func NewCore(repo Repository, gen Generators, validator EmailValidator, cfg Config) (*core, error) {
+	if repo == nil || gen.ID == nil || gen.RND == nil || gen.Time == nil || validator == nil {
+		return nil, fmt.Errorf("invitation.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invitation.NewCore: %w", err)
+	}
+
+	return &core{repo: repo, gen: gen, validator: validator, cfg: cfg}, nil
+}
+
+// Create makes an invitation by the caller for the email. The token is returned once and only its
+// hash is kept.
+func (c *core) Create(ctx context.Context, req CreateReq) (Created, error) {
+	email := c.validator.NormalizeEmail(req.Email)
+	if err := c.validator.ValidateEmail(email, true); err != nil {
+		return Created{}, fmt.Errorf("invitation.core.Create: %w", err)
+	}
+	if err := validateRoles(req.Roles); err != nil {
+		return Created{}, fmt.Errorf("invitation.core.Create: %w", err)
+	}
+
+	id, err := c.gen.ID.New()
+	if err != nil {
+		return Created{}, fmt.Errorf("invitation.core.Create: %w", err)
+	}
+	token, err := c.gen.RND.New(tokenBytes)
+	if err != nil {
+		return Created{}, fmt.Errorf("invitation.core.Create: %w", err)
+	}
+	var invitedBy *uuid.UUID
+	if userID, err := contextx.GetUserID(ctx); err == nil {
+		invitedBy = &userID
+	}
+
+	now := c.gen.Time.Now()
+	inv := Invitation{
+		ID:        id,
+		Email:     email,
+		Roles:     lo.Ternary(req.Roles == nil, []Role{}, req.Roles),
+		Status:    StatusPending,
+		InvitedBy: invitedBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(c.cfg.TTLHours) * time.Hour),
+	}
+	if err = c.repo.Create(ctx, inv, hashToken(token)); err != nil {
+		return Created{}, fmt.Errorf("invitation.core.Create: %w", err)
+	}
+
+	return Created{Invitation: inv, Token: token, URL: c.cfg.URL(token)}, nil
+}
+
+// List returns the invitations of the workspace, newest first, with their status now.
+func (c *core) List(ctx context.Context) ([]Invitation, error) {
+	invitations, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invitation.core.List: %w", err)
+	}
+	now := c.gen.Time.Now()
+	for i := range invitations {
+		invitations[i].Status = status(invitations[i], now)
+	}
+
+	return invitations, nil
+}
+
+// Revoke deletes an invitation that is not accepted yet, so its token stops working.
+func (c *core) Revoke(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("invitation.core.Revoke: %w", apperr.ErrNilUUID(FieldInvitationID))
+	}
+	if err := c.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("invitation.core.Revoke: %w", err)
+	}
+
+	return nil
+}
+
+// Claim returns the pending invitation of token, locked until the transaction of ctx ends so that
+// it is accepted once.
+func (c *core) Claim(ctx context.Context, token string) (Invitation, error) {
+	if token == "" {
+		return Invitation{}, fmt.Errorf("invitation.core.Claim: %w", ErrNotFound())
+	}
+	inv, err := c.repo.GetByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return Invitation{}, fmt.Errorf("invitation.core.Claim: %w", err)
+	}
+
+	inv.Status = status(inv, c.gen.Time.Now())
+	switch inv.Status {
+	case StatusAccepted:
+		return Invitation{}, fmt.Errorf("invitation.core.Claim: %w", ErrAccepted())
+	case StatusExpired:
+		return Invitation{}, fmt.Errorf("invitation.core.Claim: %w", ErrExpired())
+	}
+
+	return inv, nil
+}
+
+// MarkAccepted records that the user registered with the invitation.
+func (c *core) MarkAccepted(ctx context.Context, id, userID uuid.UUID) error {
+	if err := c.repo.MarkAccepted(ctx, id, userID, c.gen.Time.Now()); err != nil {
+		return fmt.Errorf("invitation.core.MarkAccepted: %w", err)
+	}
+
+	return nil
+}
+
+// Email returns the message inviting the invitee, or false when there is no link to send.
+func (c *core) Email(created Created) (mail.Message, bool) {
+	if created.URL == "" {
+		return mail.Message{}, false
+	}
+
+	body := fmt.Sprintf("You have been invited to join.\n\nTo create your account, open:\n%s\n\nThe link expires on %s.\n",
+		created.URL, created.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"))
+	return mail.Message{To: created.Email, Subject: c.cfg.Subject, Body: body}, true
+}
+
+func status(inv Invitation, now time.Time) Status {
+	switch {
+	case inv.AcceptedAt != nil:
+		return StatusAccepted
+	case !now.Before(inv.ExpiresAt):
+		return StatusExpired
+	default:
+		return StatusPending
+	}
+}
+
+func validateRoles(roles []Role) error {
+	if len(roles) > MaxRoles {
+		return ErrTooManyRoles()
+	}
+
+	// the admin role has no entity, so uuid.Nil stands for it
+	entities := make(map[uuid.UUID]struct{}, len(roles))
+	for _, r := range roles {
+		if err := r.Role.Validate(); err != nil {
+			return ErrInvalidRole()
+		}
+		if err := r.Role.ValidateEntity(r.EntityID); err != nil {
+			return err
+		}
+		entityID := lo.FromPtr(r.EntityID)
+		if _, ok := entities[entityID]; ok {
+			return ErrDuplicateRole()
+		}
+		entities[entityID] = struct{}{}
+	}
+
+	return nil
+}
+
+// hashToken makes the stored form of a token. Tokens are random, so an unsalted hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
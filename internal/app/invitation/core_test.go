@@ -0,0 +1,348 @@
+package invitation_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/invitation/mocks"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	repo      *mocks.RepositoryMock
+	validator *mocks.EmailValidatorMock
+	idGen     *mocks.IDGeneratorMock
+	rndGen    *mocks.RNDGeneratorMock
+	timeGen   *mocks.TimeGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		repo:      mocks.NewRepositoryMock(t),
+		validator: mocks.NewEmailValidatorMock(t),
+		idGen:     mocks.NewIDGeneratorMock(t),
+		rndGen:    mocks.NewRNDGeneratorMock(t),
+		timeGen:   mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+var cfg = invitation.Config{TTLHours: 24, AcceptURL: "https://docs.example.com/invite/", Subject: "Join us"}
+
+func newCore(t *testing.T, m mock, cfg invitation.Config) usecase.Core {
+	t.Helper()
+	c, err := invitation.NewCore(m.repo, invitation.Generators{ID: m.idGen, RND: m.rndGen, Time: m.timeGen}, m.validator, cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	gen := invitation.Generators{ID: m.idGen, RND: m.rndGen, Time: m.timeGen}
+	_, err := invitation.NewCore(m.repo, invitation.Generators{ID: m.idGen}, m.validator, cfg)
+	require.Error(t, err)
+	_, err = invitation.NewCore(m.repo, gen, m.validator, invitation.Config{TTLHours: 24, AcceptURL: "/invite/", Subject: "Join us"})
+	require.Error(t, err)
+	_, err = invitation.NewCore(m.repo, gen, m.validator, invitation.Config{Subject: "Join us"})
+	require.Error(t, err)
+}
+
+func TestCore_Create(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID   = uuid.New()
+		ctx      = contextx.SetUserID(t.Context(), userID)
+		id       = uuid.New()
+		entityID = uuid.New()
+		now      = time.Date(2025, 10, 2, 10, 0, 0, 0, time.UTC)
+		token    = "token"
+		roles    = []invitation.Role{{Role: auth.RoleAdmin}, {Role: auth.RoleWrite, EntityID: &entityID}}
+		inv      = invitation.Invitation{
+			ID: id, Email: "ann@example.com", Roles: roles, Status: invitation.StatusPending, InvitedBy: &userID,
+			CreatedAt: now, ExpiresAt: now.Add(24 * time.Hour),
+		}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		req   invitation.CreateReq
+		cfg   invitation.Config
+		setup func(m mock)
+		want  invitation.Created
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  invitation.CreateReq{Email: " Ann@Example.com ", Roles: roles},
+			cfg:  cfg,
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Expect(" Ann@Example.com ").Return("ann@example.com")
+				m.validator.ValidateEmailMock.Expect("ann@example.com", true).Return(nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.rndGen.NewMock.Expect(32).Return(token, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreateMock.Expect(ctx, inv, hash(token)).Return(nil)
+			},
+			want: invitation.Created{Invitation: inv, Token: token, URL: "https://docs.example.com/invite/token"},
+		},
+		{
+			name: "ok/no_roles_no_url",
+			req:  invitation.CreateReq{Email: "ann@example.com"},
+			cfg:  invitation.Config{TTLHours: 24, Subject: "Join us"},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.rndGen.NewMock.Return(token, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreateMock.Set(func(_ context.Context, got invitation.Invitation, _ string) error {
+					require.Equal(t, []invitation.Role{}, got.Roles)
+					return nil
+				})
+			},
+			want: func() invitation.Created {
+				inv := inv
+				inv.Roles = []invitation.Role{}
+				return invitation.Created{Invitation: inv, Token: token}
+			}(),
+		},
+		{
+			name: "error/invalid_email",
+			req:  invitation.CreateReq{Email: "ann"},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann")
+				m.validator.ValidateEmailMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/invalid_role",
+			req:  invitation.CreateReq{Email: "ann@example.com", Roles: []invitation.Role{{Role: "owner"}}},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+			},
+			err: invitation.ErrInvalidRole(),
+		},
+		{
+			name: "error/role_without_entity",
+			req:  invitation.CreateReq{Email: "ann@example.com", Roles: []invitation.Role{{Role: auth.RoleRead}}},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+			},
+			err: auth.ErrRoleRequiresEntity(),
+		},
+		{
+			name: "error/duplicate_role",
+			req: invitation.CreateReq{Email: "ann@example.com", Roles: []invitation.Role{
+				{Role: auth.RoleRead, EntityID: &entityID}, {Role: auth.RoleWrite, EntityID: &entityID},
+			}},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+			},
+			err: invitation.ErrDuplicateRole(),
+		},
+		{
+			name: "error/too_many_roles",
+			req:  invitation.CreateReq{Email: "ann@example.com", Roles: make([]invitation.Role, invitation.MaxRoles+1)},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+			},
+			err: invitation.ErrTooManyRoles(),
+		},
+		{
+			name: "error/repo",
+			req:  invitation.CreateReq{Email: "ann@example.com"},
+			setup: func(m mock) {
+				m.validator.NormalizeEmailMock.Return("ann@example.com")
+				m.validator.ValidateEmailMock.Return(nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.rndGen.NewMock.Return(token, nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.CreateMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(m)
+			if tt.cfg == (invitation.Config{}) {
+				tt.cfg = cfg
+			}
+
+			got, err := newCore(t, m, tt.cfg).Create(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = t.Context()
+		now        = time.Date(2025, 10, 2, 10, 0, 0, 0, time.UTC)
+		acceptedAt = now.Add(-time.Hour)
+		pending    = invitation.Invitation{ID: uuid.New(), ExpiresAt: now.Add(time.Hour)}
+		expired    = invitation.Invitation{ID: uuid.New(), ExpiresAt: now}
+		accepted   = invitation.Invitation{ID: uuid.New(), ExpiresAt: now.Add(-time.Minute), AcceptedAt: &acceptedAt}
+	)
+
+	m := getMocks(t)
+	m.repo.ListMock.Expect(ctx).Return([]invitation.Invitation{pending, expired, accepted}, nil)
+	m.timeGen.NowMock.Return(now)
+
+	got, err := newCore(t, m, cfg).List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []invitation.Status{invitation.StatusPending, invitation.StatusExpired, invitation.StatusAccepted},
+		[]invitation.Status{got[0].Status, got[1].Status, got[2].Status})
+}
+
+func TestCore_Revoke(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = t.Context()
+		id  = uuid.New()
+	)
+
+	m := getMocks(t)
+	m.repo.DeleteMock.Expect(ctx, id).Return(invitation.ErrAccepted())
+	c := newCore(t, m, cfg)
+
+	require.ErrorIs(t, c.Revoke(ctx, id), invitation.ErrAccepted())
+	require.ErrorIs(t, c.Revoke(ctx, uuid.Nil), apperr.ErrNilUUID(invitation.FieldInvitationID))
+}
+
+func TestCore_Claim(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = t.Context()
+		now        = time.Date(2025, 10, 2, 10, 0, 0, 0, time.UTC)
+		acceptedAt = now.Add(-time.Hour)
+		token      = "token"
+		inv        = invitation.Invitation{ID: uuid.New(), Email: "ann@example.com", ExpiresAt: now.Add(time.Hour)}
+	)
+
+	tests := []struct {
+		name  string
+		token string
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name:  "ok",
+			token: token,
+			setup: func(m mock) {
+				m.repo.GetByTokenHashMock.Expect(ctx, hash(token)).Return(inv, nil)
+				m.timeGen.NowMock.Return(now)
+			},
+		},
+		{
+			name:  "error/empty_token",
+			setup: func(mock) {},
+			err:   invitation.ErrNotFound(),
+		},
+		{
+			name:  "error/not_found",
+			token: token,
+			setup: func(m mock) {
+				m.repo.GetByTokenHashMock.Return(invitation.Invitation{}, invitation.ErrNotFound())
+			},
+			err: invitation.ErrNotFound(),
+		},
+		{
+			name:  "error/expired",
+			token: token,
+			setup: func(m mock) {
+				expired := inv
+				expired.ExpiresAt = now
+				m.repo.GetByTokenHashMock.Return(expired, nil)
+				m.timeGen.NowMock.Return(now)
+			},
+			err: invitation.ErrExpired(),
+		},
+		{
+			name:  "error/accepted",
+			token: token,
+			setup: func(m mock) {
+				accepted := inv
+				accepted.AcceptedAt = &acceptedAt
+				m.repo.GetByTokenHashMock.Return(accepted, nil)
+				m.timeGen.NowMock.Return(now)
+			},
+			err: invitation.ErrAccepted(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(m)
+
+			got, err := newCore(t, m, cfg).Claim(ctx, tt.token)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			want := inv
+			want.Status = invitation.StatusPending
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestCore_Email(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	created := invitation.Created{
+		Invitation: invitation.Invitation{Email: "ann@example.com", ExpiresAt: time.Date(2025, 10, 3, 10, 0, 0, 0, time.UTC)},
+		URL:        "https://docs.example.com/invite/token",
+	}
+
+	msg, ok := newCore(t, m, cfg).Email(created)
+	require.True(t, ok)
+	require.Equal(t, "ann@example.com", msg.To)
+	require.Equal(t, "Join us", msg.Subject)
+	require.Contains(t, msg.Body, created.URL)
+	require.Contains(t, msg.Body, "2025-10-03 10:00 UTC")
+
+	created.URL = ""
+	_, ok = newCore(t, m, cfg).Email(created)
+	require.False(t, ok)
+}
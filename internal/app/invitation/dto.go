@@ -0,0 +1,66 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldInvitationID apperr.Field = "invitation_id"
+	FieldEmail        apperr.Field = "email"
+	FieldToken        apperr.Field = "token"
+	FieldRoles        apperr.Field = "roles"
+	FieldRole         apperr.Field = "role"
+	FieldEntityID     apperr.Field = "entity_id"
+)
+
+// MaxRoles caps the roles given with an invitation.
+const MaxRoles = 20
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusExpired  Status = "expired"
+)
+
+// Role is granted to the invited user when the invitation is accepted; EntityID follows the rules
+// of auth.UserRole.
+type Role struct {
+	Role     auth.Role  `json:"role"`
+	EntityID *uuid.UUID `json:"entity_id"`
+}
+
+// Invitation lets the owner of Email register in the workspace it was made in. Only a hash of its
+// token is stored, so the token is known only to the invitee and the admin who made it.
+type Invitation struct {
+	ID          uuid.UUID  `json:"id"`
+	WorkspaceID uuid.UUID  `json:"-"`
+	Email       string     `json:"email"`
+	Roles       []Role     `json:"roles"`
+	Status      Status     `json:"status"`
+	InvitedBy   *uuid.UUID `json:"invited_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	AcceptedAt  *time.Time `json:"accepted_at,omitempty"`
+	// UserID is the user who registered with the invitation.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+}
+
+type CreateReq struct {
+	Email string `json:"email"`
+	Roles []Role `json:"roles"`
+}
+
+// Created is a new invitation with its token, which is returned only here. URL is where the
+// invitee accepts it, empty without Config.AcceptURL.
+type Created struct {
+	Invitation
+	Token     string `json:"token"`
+	URL       string `json:"url,omitempty"`
+	EmailSent bool   `json:"email_sent"`
+}
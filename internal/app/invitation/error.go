@@ -0,0 +1,55 @@
+package invitation
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeValidationFailed apperr.Code = "invitation/validation_failed"
+	CodeNotFound         apperr.Code = "invitation/not_found"
+	CodeExpired          apperr.Code = "invitation/expired"
+	CodeAccepted         apperr.Code = "invitation/accepted"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid invitation", apperr.ClassBadRequest)
+	apperr.Register(CodeNotFound, "Invitation not found", apperr.ClassNotFound)
+	apperr.Register(CodeExpired, "Invitation expired", apperr.ClassConflict)
+	apperr.Register(CodeAccepted, "Invitation already accepted", apperr.ClassConflict)
+}
+
+// ErrNotFound is also returned for an unknown token, so tokens cannot be told from invitations.
+func ErrNotFound() error {
+	return apperr.New("invitation not found", CodeNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrExpired() error {
+	return apperr.New("invitation expired", CodeExpired, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldToken, Rule: apperr.RuleInvalidState})
+}
+
+func ErrAccepted() error {
+	return apperr.New("invitation already accepted", CodeAccepted, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldToken, Rule: apperr.RuleInvalidState})
+}
+
+func ErrTooManyRoles() error {
+	return apperr.New("too many invitation roles", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldRoles, Rule: apperr.RuleOutOfRange, Params: map[string]any{"max": MaxRoles},
+		})
+}
+
+func ErrInvalidRole() error {
+	return apperr.New("invalid role", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRole, Rule: apperr.RuleInvalidFormat})
+}
+
+// ErrDuplicateRole is returned when an invitation has two roles on one entity, or two admin roles.
+func ErrDuplicateRole() error {
+	return apperr.New("an invitation can have one role per entity", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldRoles, Rule: apperr.RuleDuplicate})
+}
+
+func ErrEntityNotFound() error {
+	return apperr.New("entity of an invitation role not found", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldEntityID, Rule: apperr.RuleNotFound})
+}
@@ -0,0 +1,739 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation.EmailValidator -o email_validator_mock.go -n EmailValidatorMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// EmailValidatorMock implements mm_invitation.EmailValidator
+type EmailValidatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcNormalizeEmail          func(address string) (s1 string)
+	funcNormalizeEmailOrigin    string
+	inspectFuncNormalizeEmail   func(address string)
+	afterNormalizeEmailCounter  uint64
+	beforeNormalizeEmailCounter uint64
+	NormalizeEmailMock          mEmailValidatorMockNormalizeEmail
+
+	funcValidateEmail          func(address string, validateLength bool) (err error)
+	funcValidateEmailOrigin    string
+	inspectFuncValidateEmail   func(address string, validateLength bool)
+	afterValidateEmailCounter  uint64
+	beforeValidateEmailCounter uint64
+	ValidateEmailMock          mEmailValidatorMockValidateEmail
+}
+
+// NewEmailValidatorMock returns a mock for mm_invitation.EmailValidator
+func NewEmailValidatorMock(t minimock.Tester) *EmailValidatorMock {
+	m := &EmailValidatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NormalizeEmailMock = mEmailValidatorMockNormalizeEmail{mock: m}
+	m.NormalizeEmailMock.callArgs = []*EmailValidatorMockNormalizeEmailParams{}
+
+	m.ValidateEmailMock = mEmailValidatorMockValidateEmail{mock: m}
+	m.ValidateEmailMock.callArgs = []*EmailValidatorMockValidateEmailParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEmailValidatorMockNormalizeEmail struct {
+	optional           bool
+	mock               *EmailValidatorMock
+	defaultExpectation *EmailValidatorMockNormalizeEmailExpectation
+	expectations       []*EmailValidatorMockNormalizeEmailExpectation
+
+	callArgs []*EmailValidatorMockNormalizeEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailValidatorMockNormalizeEmailExpectation specifies expectation struct of the EmailValidator.NormalizeEmail
+type EmailValidatorMockNormalizeEmailExpectation struct {
+	mock               *EmailValidatorMock
+	params             *EmailValidatorMockNormalizeEmailParams
+	paramPtrs          *EmailValidatorMockNormalizeEmailParamPtrs
+	expectationOrigins EmailValidatorMockNormalizeEmailExpectationOrigins
+	results            *EmailValidatorMockNormalizeEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailValidatorMockNormalizeEmailParams contains parameters of the EmailValidator.NormalizeEmail
+type EmailValidatorMockNormalizeEmailParams struct {
+	address string
+}
+
+// EmailValidatorMockNormalizeEmailParamPtrs contains pointers to parameters of the EmailValidator.NormalizeEmail
+type EmailValidatorMockNormalizeEmailParamPtrs struct {
+	address *string
+}
+
+// EmailValidatorMockNormalizeEmailResults contains results of the EmailValidator.NormalizeEmail
+type EmailValidatorMockNormalizeEmailResults struct {
+	s1 string
+}
+
+// EmailValidatorMockNormalizeEmailOrigins contains origins of expectations of the EmailValidator.NormalizeEmail
+type EmailValidatorMockNormalizeEmailExpectationOrigins struct {
+	origin        string
+	originAddress string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Optional() *mEmailValidatorMockNormalizeEmail {
+	mmNormalizeEmail.optional = true
+	return mmNormalizeEmail
+}
+
+// Expect sets up expected params for EmailValidator.NormalizeEmail
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Expect(address string) *mEmailValidatorMockNormalizeEmail {
+	if mmNormalizeEmail.mock.funcNormalizeEmail != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by Set")
+	}
+
+	if mmNormalizeEmail.defaultExpectation == nil {
+		mmNormalizeEmail.defaultExpectation = &EmailValidatorMockNormalizeEmailExpectation{}
+	}
+
+	if mmNormalizeEmail.defaultExpectation.paramPtrs != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by ExpectParams functions")
+	}
+
+	mmNormalizeEmail.defaultExpectation.params = &EmailValidatorMockNormalizeEmailParams{address}
+	mmNormalizeEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNormalizeEmail.expectations {
+		if minimock.Equal(e.params, mmNormalizeEmail.defaultExpectation.params) {
+			mmNormalizeEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNormalizeEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmNormalizeEmail
+}
+
+// ExpectAddressParam1 sets up expected param address for EmailValidator.NormalizeEmail
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) ExpectAddressParam1(address string) *mEmailValidatorMockNormalizeEmail {
+	if mmNormalizeEmail.mock.funcNormalizeEmail != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by Set")
+	}
+
+	if mmNormalizeEmail.defaultExpectation == nil {
+		mmNormalizeEmail.defaultExpectation = &EmailValidatorMockNormalizeEmailExpectation{}
+	}
+
+	if mmNormalizeEmail.defaultExpectation.params != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by Expect")
+	}
+
+	if mmNormalizeEmail.defaultExpectation.paramPtrs == nil {
+		mmNormalizeEmail.defaultExpectation.paramPtrs = &EmailValidatorMockNormalizeEmailParamPtrs{}
+	}
+	mmNormalizeEmail.defaultExpectation.paramPtrs.address = &address
+	mmNormalizeEmail.defaultExpectation.expectationOrigins.originAddress = minimock.CallerInfo(1)
+
+	return mmNormalizeEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailValidator.NormalizeEmail
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Inspect(f func(address string)) *mEmailValidatorMockNormalizeEmail {
+	if mmNormalizeEmail.mock.inspectFuncNormalizeEmail != nil {
+		mmNormalizeEmail.mock.t.Fatalf("Inspect function is already set for EmailValidatorMock.NormalizeEmail")
+	}
+
+	mmNormalizeEmail.mock.inspectFuncNormalizeEmail = f
+
+	return mmNormalizeEmail
+}
+
+// Return sets up results that will be returned by EmailValidator.NormalizeEmail
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Return(s1 string) *EmailValidatorMock {
+	if mmNormalizeEmail.mock.funcNormalizeEmail != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by Set")
+	}
+
+	if mmNormalizeEmail.defaultExpectation == nil {
+		mmNormalizeEmail.defaultExpectation = &EmailValidatorMockNormalizeEmailExpectation{mock: mmNormalizeEmail.mock}
+	}
+	mmNormalizeEmail.defaultExpectation.results = &EmailValidatorMockNormalizeEmailResults{s1}
+	mmNormalizeEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNormalizeEmail.mock
+}
+
+// Set uses given function f to mock the EmailValidator.NormalizeEmail method
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Set(f func(address string) (s1 string)) *EmailValidatorMock {
+	if mmNormalizeEmail.defaultExpectation != nil {
+		mmNormalizeEmail.mock.t.Fatalf("Default expectation is already set for the EmailValidator.NormalizeEmail method")
+	}
+
+	if len(mmNormalizeEmail.expectations) > 0 {
+		mmNormalizeEmail.mock.t.Fatalf("Some expectations are already set for the EmailValidator.NormalizeEmail method")
+	}
+
+	mmNormalizeEmail.mock.funcNormalizeEmail = f
+	mmNormalizeEmail.mock.funcNormalizeEmailOrigin = minimock.CallerInfo(1)
+	return mmNormalizeEmail.mock
+}
+
+// When sets expectation for the EmailValidator.NormalizeEmail which will trigger the result defined by the following
+// Then helper
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) When(address string) *EmailValidatorMockNormalizeEmailExpectation {
+	if mmNormalizeEmail.mock.funcNormalizeEmail != nil {
+		mmNormalizeEmail.mock.t.Fatalf("EmailValidatorMock.NormalizeEmail mock is already set by Set")
+	}
+
+	expectation := &EmailValidatorMockNormalizeEmailExpectation{
+		mock:               mmNormalizeEmail.mock,
+		params:             &EmailValidatorMockNormalizeEmailParams{address},
+		expectationOrigins: EmailValidatorMockNormalizeEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNormalizeEmail.expectations = append(mmNormalizeEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailValidator.NormalizeEmail return parameters for the expectation previously defined by the When method
+func (e *EmailValidatorMockNormalizeEmailExpectation) Then(s1 string) *EmailValidatorMock {
+	e.results = &EmailValidatorMockNormalizeEmailResults{s1}
+	return e.mock
+}
+
+// Times sets number of times EmailValidator.NormalizeEmail should be invoked
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Times(n uint64) *mEmailValidatorMockNormalizeEmail {
+	if n == 0 {
+		mmNormalizeEmail.mock.t.Fatalf("Times of EmailValidatorMock.NormalizeEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNormalizeEmail.expectedInvocations, n)
+	mmNormalizeEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNormalizeEmail
+}
+
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) invocationsDone() bool {
+	if len(mmNormalizeEmail.expectations) == 0 && mmNormalizeEmail.defaultExpectation == nil && mmNormalizeEmail.mock.funcNormalizeEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNormalizeEmail.mock.afterNormalizeEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNormalizeEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NormalizeEmail implements mm_invitation.EmailValidator
+func (mmNormalizeEmail *EmailValidatorMock) NormalizeEmail(address string) (s1 string) {
+	mm_atomic.AddUint64(&mmNormalizeEmail.beforeNormalizeEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmNormalizeEmail.afterNormalizeEmailCounter, 1)
+
+	mmNormalizeEmail.t.Helper()
+
+	if mmNormalizeEmail.inspectFuncNormalizeEmail != nil {
+		mmNormalizeEmail.inspectFuncNormalizeEmail(address)
+	}
+
+	mm_params := EmailValidatorMockNormalizeEmailParams{address}
+
+	// Record call args
+	mmNormalizeEmail.NormalizeEmailMock.mutex.Lock()
+	mmNormalizeEmail.NormalizeEmailMock.callArgs = append(mmNormalizeEmail.NormalizeEmailMock.callArgs, &mm_params)
+	mmNormalizeEmail.NormalizeEmailMock.mutex.Unlock()
+
+	for _, e := range mmNormalizeEmail.NormalizeEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1
+		}
+	}
+
+	if mmNormalizeEmail.NormalizeEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailValidatorMockNormalizeEmailParams{address}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.address != nil && !minimock.Equal(*mm_want_ptrs.address, mm_got.address) {
+				mmNormalizeEmail.t.Errorf("EmailValidatorMock.NormalizeEmail got unexpected parameter address, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.expectationOrigins.originAddress, *mm_want_ptrs.address, mm_got.address, minimock.Diff(*mm_want_ptrs.address, mm_got.address))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNormalizeEmail.t.Errorf("EmailValidatorMock.NormalizeEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNormalizeEmail.NormalizeEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNormalizeEmail.t.Fatal("No results are set for the EmailValidatorMock.NormalizeEmail")
+		}
+		return (*mm_results).s1
+	}
+	if mmNormalizeEmail.funcNormalizeEmail != nil {
+		return mmNormalizeEmail.funcNormalizeEmail(address)
+	}
+	mmNormalizeEmail.t.Fatalf("Unexpected call to EmailValidatorMock.NormalizeEmail. %v", address)
+	return
+}
+
+// NormalizeEmailAfterCounter returns a count of finished EmailValidatorMock.NormalizeEmail invocations
+func (mmNormalizeEmail *EmailValidatorMock) NormalizeEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNormalizeEmail.afterNormalizeEmailCounter)
+}
+
+// NormalizeEmailBeforeCounter returns a count of EmailValidatorMock.NormalizeEmail invocations
+func (mmNormalizeEmail *EmailValidatorMock) NormalizeEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNormalizeEmail.beforeNormalizeEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailValidatorMock.NormalizeEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNormalizeEmail *mEmailValidatorMockNormalizeEmail) Calls() []*EmailValidatorMockNormalizeEmailParams {
+	mmNormalizeEmail.mutex.RLock()
+
+	argCopy := make([]*EmailValidatorMockNormalizeEmailParams, len(mmNormalizeEmail.callArgs))
+	copy(argCopy, mmNormalizeEmail.callArgs)
+
+	mmNormalizeEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNormalizeEmailDone returns true if the count of the NormalizeEmail invocations corresponds
+// the number of defined expectations
+func (m *EmailValidatorMock) MinimockNormalizeEmailDone() bool {
+	if m.NormalizeEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NormalizeEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NormalizeEmailMock.invocationsDone()
+}
+
+// MinimockNormalizeEmailInspect logs each unmet expectation
+func (m *EmailValidatorMock) MinimockNormalizeEmailInspect() {
+	for _, e := range m.NormalizeEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailValidatorMock.NormalizeEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNormalizeEmailCounter := mm_atomic.LoadUint64(&m.afterNormalizeEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NormalizeEmailMock.defaultExpectation != nil && afterNormalizeEmailCounter < 1 {
+		if m.NormalizeEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailValidatorMock.NormalizeEmail at\n%s", m.NormalizeEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailValidatorMock.NormalizeEmail at\n%s with params: %#v", m.NormalizeEmailMock.defaultExpectation.expectationOrigins.origin, *m.NormalizeEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNormalizeEmail != nil && afterNormalizeEmailCounter < 1 {
+		m.t.Errorf("Expected call to EmailValidatorMock.NormalizeEmail at\n%s", m.funcNormalizeEmailOrigin)
+	}
+
+	if !m.NormalizeEmailMock.invocationsDone() && afterNormalizeEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailValidatorMock.NormalizeEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NormalizeEmailMock.expectedInvocations), m.NormalizeEmailMock.expectedInvocationsOrigin, afterNormalizeEmailCounter)
+	}
+}
+
+type mEmailValidatorMockValidateEmail struct {
+	optional           bool
+	mock               *EmailValidatorMock
+	defaultExpectation *EmailValidatorMockValidateEmailExpectation
+	expectations       []*EmailValidatorMockValidateEmailExpectation
+
+	callArgs []*EmailValidatorMockValidateEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailValidatorMockValidateEmailExpectation specifies expectation struct of the EmailValidator.ValidateEmail
+type EmailValidatorMockValidateEmailExpectation struct {
+	mock               *EmailValidatorMock
+	params             *EmailValidatorMockValidateEmailParams
+	paramPtrs          *EmailValidatorMockValidateEmailParamPtrs
+	expectationOrigins EmailValidatorMockValidateEmailExpectationOrigins
+	results            *EmailValidatorMockValidateEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailValidatorMockValidateEmailParams contains parameters of the EmailValidator.ValidateEmail
+type EmailValidatorMockValidateEmailParams struct {
+	address        string
+	validateLength bool
+}
+
+// EmailValidatorMockValidateEmailParamPtrs contains pointers to parameters of the EmailValidator.ValidateEmail
+type EmailValidatorMockValidateEmailParamPtrs struct {
+	address        *string
+	validateLength *bool
+}
+
+// EmailValidatorMockValidateEmailResults contains results of the EmailValidator.ValidateEmail
+type EmailValidatorMockValidateEmailResults struct {
+	err error
+}
+
+// EmailValidatorMockValidateEmailOrigins contains origins of expectations of the EmailValidator.ValidateEmail
+type EmailValidatorMockValidateEmailExpectationOrigins struct {
+	origin               string
+	originAddress        string
+	originValidateLength string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Optional() *mEmailValidatorMockValidateEmail {
+	mmValidateEmail.optional = true
+	return mmValidateEmail
+}
+
+// Expect sets up expected params for EmailValidator.ValidateEmail
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Expect(address string, validateLength bool) *mEmailValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &EmailValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by ExpectParams functions")
+	}
+
+	mmValidateEmail.defaultExpectation.params = &EmailValidatorMockValidateEmailParams{address, validateLength}
+	mmValidateEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateEmail.expectations {
+		if minimock.Equal(e.params, mmValidateEmail.defaultExpectation.params) {
+			mmValidateEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateEmail
+}
+
+// ExpectAddressParam1 sets up expected param address for EmailValidator.ValidateEmail
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) ExpectAddressParam1(address string) *mEmailValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &EmailValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.params != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Expect")
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
+		mmValidateEmail.defaultExpectation.paramPtrs = &EmailValidatorMockValidateEmailParamPtrs{}
+	}
+	mmValidateEmail.defaultExpectation.paramPtrs.address = &address
+	mmValidateEmail.defaultExpectation.expectationOrigins.originAddress = minimock.CallerInfo(1)
+
+	return mmValidateEmail
+}
+
+// ExpectValidateLengthParam2 sets up expected param validateLength for EmailValidator.ValidateEmail
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) ExpectValidateLengthParam2(validateLength bool) *mEmailValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &EmailValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.params != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Expect")
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
+		mmValidateEmail.defaultExpectation.paramPtrs = &EmailValidatorMockValidateEmailParamPtrs{}
+	}
+	mmValidateEmail.defaultExpectation.paramPtrs.validateLength = &validateLength
+	mmValidateEmail.defaultExpectation.expectationOrigins.originValidateLength = minimock.CallerInfo(1)
+
+	return mmValidateEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailValidator.ValidateEmail
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Inspect(f func(address string, validateLength bool)) *mEmailValidatorMockValidateEmail {
+	if mmValidateEmail.mock.inspectFuncValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("Inspect function is already set for EmailValidatorMock.ValidateEmail")
+	}
+
+	mmValidateEmail.mock.inspectFuncValidateEmail = f
+
+	return mmValidateEmail
+}
+
+// Return sets up results that will be returned by EmailValidator.ValidateEmail
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Return(err error) *EmailValidatorMock {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &EmailValidatorMockValidateEmailExpectation{mock: mmValidateEmail.mock}
+	}
+	mmValidateEmail.defaultExpectation.results = &EmailValidatorMockValidateEmailResults{err}
+	mmValidateEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail.mock
+}
+
+// Set uses given function f to mock the EmailValidator.ValidateEmail method
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Set(f func(address string, validateLength bool) (err error)) *EmailValidatorMock {
+	if mmValidateEmail.defaultExpectation != nil {
+		mmValidateEmail.mock.t.Fatalf("Default expectation is already set for the EmailValidator.ValidateEmail method")
+	}
+
+	if len(mmValidateEmail.expectations) > 0 {
+		mmValidateEmail.mock.t.Fatalf("Some expectations are already set for the EmailValidator.ValidateEmail method")
+	}
+
+	mmValidateEmail.mock.funcValidateEmail = f
+	mmValidateEmail.mock.funcValidateEmailOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail.mock
+}
+
+// When sets expectation for the EmailValidator.ValidateEmail which will trigger the result defined by the following
+// Then helper
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) When(address string, validateLength bool) *EmailValidatorMockValidateEmailExpectation {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("EmailValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	expectation := &EmailValidatorMockValidateEmailExpectation{
+		mock:               mmValidateEmail.mock,
+		params:             &EmailValidatorMockValidateEmailParams{address, validateLength},
+		expectationOrigins: EmailValidatorMockValidateEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateEmail.expectations = append(mmValidateEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailValidator.ValidateEmail return parameters for the expectation previously defined by the When method
+func (e *EmailValidatorMockValidateEmailExpectation) Then(err error) *EmailValidatorMock {
+	e.results = &EmailValidatorMockValidateEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmailValidator.ValidateEmail should be invoked
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Times(n uint64) *mEmailValidatorMockValidateEmail {
+	if n == 0 {
+		mmValidateEmail.mock.t.Fatalf("Times of EmailValidatorMock.ValidateEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateEmail.expectedInvocations, n)
+	mmValidateEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail
+}
+
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) invocationsDone() bool {
+	if len(mmValidateEmail.expectations) == 0 && mmValidateEmail.defaultExpectation == nil && mmValidateEmail.mock.funcValidateEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateEmail.mock.afterValidateEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateEmail implements mm_invitation.EmailValidator
+func (mmValidateEmail *EmailValidatorMock) ValidateEmail(address string, validateLength bool) (err error) {
+	mm_atomic.AddUint64(&mmValidateEmail.beforeValidateEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateEmail.afterValidateEmailCounter, 1)
+
+	mmValidateEmail.t.Helper()
+
+	if mmValidateEmail.inspectFuncValidateEmail != nil {
+		mmValidateEmail.inspectFuncValidateEmail(address, validateLength)
+	}
+
+	mm_params := EmailValidatorMockValidateEmailParams{address, validateLength}
+
+	// Record call args
+	mmValidateEmail.ValidateEmailMock.mutex.Lock()
+	mmValidateEmail.ValidateEmailMock.callArgs = append(mmValidateEmail.ValidateEmailMock.callArgs, &mm_params)
+	mmValidateEmail.ValidateEmailMock.mutex.Unlock()
+
+	for _, e := range mmValidateEmail.ValidateEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateEmail.ValidateEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateEmail.ValidateEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateEmail.ValidateEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateEmail.ValidateEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailValidatorMockValidateEmailParams{address, validateLength}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.address != nil && !minimock.Equal(*mm_want_ptrs.address, mm_got.address) {
+				mmValidateEmail.t.Errorf("EmailValidatorMock.ValidateEmail got unexpected parameter address, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originAddress, *mm_want_ptrs.address, mm_got.address, minimock.Diff(*mm_want_ptrs.address, mm_got.address))
+			}
+
+			if mm_want_ptrs.validateLength != nil && !minimock.Equal(*mm_want_ptrs.validateLength, mm_got.validateLength) {
+				mmValidateEmail.t.Errorf("EmailValidatorMock.ValidateEmail got unexpected parameter validateLength, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originValidateLength, *mm_want_ptrs.validateLength, mm_got.validateLength, minimock.Diff(*mm_want_ptrs.validateLength, mm_got.validateLength))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateEmail.t.Errorf("EmailValidatorMock.ValidateEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateEmail.ValidateEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateEmail.t.Fatal("No results are set for the EmailValidatorMock.ValidateEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateEmail.funcValidateEmail != nil {
+		return mmValidateEmail.funcValidateEmail(address, validateLength)
+	}
+	mmValidateEmail.t.Fatalf("Unexpected call to EmailValidatorMock.ValidateEmail. %v %v", address, validateLength)
+	return
+}
+
+// ValidateEmailAfterCounter returns a count of finished EmailValidatorMock.ValidateEmail invocations
+func (mmValidateEmail *EmailValidatorMock) ValidateEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateEmail.afterValidateEmailCounter)
+}
+
+// ValidateEmailBeforeCounter returns a count of EmailValidatorMock.ValidateEmail invocations
+func (mmValidateEmail *EmailValidatorMock) ValidateEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateEmail.beforeValidateEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailValidatorMock.ValidateEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateEmail *mEmailValidatorMockValidateEmail) Calls() []*EmailValidatorMockValidateEmailParams {
+	mmValidateEmail.mutex.RLock()
+
+	argCopy := make([]*EmailValidatorMockValidateEmailParams, len(mmValidateEmail.callArgs))
+	copy(argCopy, mmValidateEmail.callArgs)
+
+	mmValidateEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateEmailDone returns true if the count of the ValidateEmail invocations corresponds
+// the number of defined expectations
+func (m *EmailValidatorMock) MinimockValidateEmailDone() bool {
+	if m.ValidateEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateEmailMock.invocationsDone()
+}
+
+// MinimockValidateEmailInspect logs each unmet expectation
+func (m *EmailValidatorMock) MinimockValidateEmailInspect() {
+	for _, e := range m.ValidateEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailValidatorMock.ValidateEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateEmailCounter := mm_atomic.LoadUint64(&m.afterValidateEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateEmailMock.defaultExpectation != nil && afterValidateEmailCounter < 1 {
+		if m.ValidateEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailValidatorMock.ValidateEmail at\n%s", m.ValidateEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailValidatorMock.ValidateEmail at\n%s with params: %#v", m.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *m.ValidateEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateEmail != nil && afterValidateEmailCounter < 1 {
+		m.t.Errorf("Expected call to EmailValidatorMock.ValidateEmail at\n%s", m.funcValidateEmailOrigin)
+	}
+
+	if !m.ValidateEmailMock.invocationsDone() && afterValidateEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailValidatorMock.ValidateEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateEmailMock.expectedInvocations), m.ValidateEmailMock.expectedInvocationsOrigin, afterValidateEmailCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EmailValidatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockNormalizeEmailInspect()
+
+			m.MinimockValidateEmailInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EmailValidatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EmailValidatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNormalizeEmailDone() &&
+		m.MinimockValidateEmailDone()
+}
@@ -0,0 +1,1903 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_invitation "github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_invitation.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, inv mm_invitation.Invitation, tokenHash string) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, inv mm_invitation.Invitation, tokenHash string)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id uuid.UUID)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRepositoryMockDelete
+
+	funcGetByTokenHash          func(ctx context.Context, tokenHash string) (i1 mm_invitation.Invitation, err error)
+	funcGetByTokenHashOrigin    string
+	inspectFuncGetByTokenHash   func(ctx context.Context, tokenHash string)
+	afterGetByTokenHashCounter  uint64
+	beforeGetByTokenHashCounter uint64
+	GetByTokenHashMock          mRepositoryMockGetByTokenHash
+
+	funcList          func(ctx context.Context) (ia1 []mm_invitation.Invitation, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRepositoryMockList
+
+	funcMarkAccepted          func(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) (err error)
+	funcMarkAcceptedOrigin    string
+	inspectFuncMarkAccepted   func(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time)
+	afterMarkAcceptedCounter  uint64
+	beforeMarkAcceptedCounter uint64
+	MarkAcceptedMock          mRepositoryMockMarkAccepted
+}
+
+// NewRepositoryMock returns a mock for mm_invitation.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*RepositoryMockCreateParams{}
+
+	m.DeleteMock = mRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RepositoryMockDeleteParams{}
+
+	m.GetByTokenHashMock = mRepositoryMockGetByTokenHash{mock: m}
+	m.GetByTokenHashMock.callArgs = []*RepositoryMockGetByTokenHashParams{}
+
+	m.ListMock = mRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RepositoryMockListParams{}
+
+	m.MarkAcceptedMock = mRepositoryMockMarkAccepted{mock: m}
+	m.MarkAcceptedMock.callArgs = []*RepositoryMockMarkAcceptedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockCreate struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreateExpectation
+	expectations       []*RepositoryMockCreateExpectation
+
+	callArgs []*RepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreateExpectation specifies expectation struct of the Repository.Create
+type RepositoryMockCreateExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreateParams
+	paramPtrs          *RepositoryMockCreateParamPtrs
+	expectationOrigins RepositoryMockCreateExpectationOrigins
+	results            *RepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreateParams contains parameters of the Repository.Create
+type RepositoryMockCreateParams struct {
+	ctx       context.Context
+	inv       mm_invitation.Invitation
+	tokenHash string
+}
+
+// RepositoryMockCreateParamPtrs contains pointers to parameters of the Repository.Create
+type RepositoryMockCreateParamPtrs struct {
+	ctx       *context.Context
+	inv       *mm_invitation.Invitation
+	tokenHash *string
+}
+
+// RepositoryMockCreateResults contains results of the Repository.Create
+type RepositoryMockCreateResults struct {
+	err error
+}
+
+// RepositoryMockCreateOrigins contains origins of expectations of the Repository.Create
+type RepositoryMockCreateExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originInv       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mRepositoryMockCreate) Optional() *mRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for Repository.Create
+func (mmCreate *mRepositoryMockCreate) Expect(ctx context.Context, inv mm_invitation.Invitation, tokenHash string) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &RepositoryMockCreateParams{ctx, inv, tokenHash}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Create
+func (mmCreate *mRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectInvParam2 sets up expected param inv for Repository.Create
+func (mmCreate *mRepositoryMockCreate) ExpectInvParam2(inv mm_invitation.Invitation) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.inv = &inv
+	mmCreate.defaultExpectation.expectationOrigins.originInv = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectTokenHashParam3 sets up expected param tokenHash for Repository.Create
+func (mmCreate *mRepositoryMockCreate) ExpectTokenHashParam3(tokenHash string) *mRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &RepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmCreate.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Create
+func (mmCreate *mRepositoryMockCreate) Inspect(f func(ctx context.Context, inv mm_invitation.Invitation, tokenHash string)) *mRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by Repository.Create
+func (mmCreate *mRepositoryMockCreate) Return(err error) *RepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &RepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &RepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the Repository.Create method
+func (mmCreate *mRepositoryMockCreate) Set(f func(ctx context.Context, inv mm_invitation.Invitation, tokenHash string) (err error)) *RepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the Repository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the Repository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the Repository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mRepositoryMockCreate) When(ctx context.Context, inv mm_invitation.Invitation, tokenHash string) *RepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("RepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &RepositoryMockCreateParams{ctx, inv, tokenHash},
+		expectationOrigins: RepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Create return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreateExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Create should be invoked
+func (mmCreate *mRepositoryMockCreate) Times(n uint64) *mRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of RepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_invitation.Repository
+func (mmCreate *RepositoryMock) Create(ctx context.Context, inv mm_invitation.Invitation, tokenHash string) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, inv, tokenHash)
+	}
+
+	mm_params := RepositoryMockCreateParams{ctx, inv, tokenHash}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreateParams{ctx, inv, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.inv != nil && !minimock.Equal(*mm_want_ptrs.inv, mm_got.inv) {
+				mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameter inv, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originInv, *mm_want_ptrs.inv, mm_got.inv, minimock.Diff(*mm_want_ptrs.inv, mm_got.inv))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("RepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the RepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, inv, tokenHash)
+	}
+	mmCreate.t.Fatalf("Unexpected call to RepositoryMock.Create. %v %v %v", ctx, inv, tokenHash)
+	return
+}
+
+// CreateAfterCounter returns a count of finished RepositoryMock.Create invocations
+func (mmCreate *RepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of RepositoryMock.Create invocations
+func (mmCreate *RepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mRepositoryMockCreate) Calls() []*RepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mRepositoryMockDelete struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteExpectation
+	expectations       []*RepositoryMockDeleteExpectation
+
+	callArgs []*RepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteExpectation specifies expectation struct of the Repository.Delete
+type RepositoryMockDeleteExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteParams
+	paramPtrs          *RepositoryMockDeleteParamPtrs
+	expectationOrigins RepositoryMockDeleteExpectationOrigins
+	results            *RepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteParams contains parameters of the Repository.Delete
+type RepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RepositoryMockDeleteParamPtrs contains pointers to parameters of the Repository.Delete
+type RepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RepositoryMockDeleteResults contains results of the Repository.Delete
+type RepositoryMockDeleteResults struct {
+	err error
+}
+
+// RepositoryMockDeleteOrigins contains origins of expectations of the Repository.Delete
+type RepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRepositoryMockDelete) Optional() *mRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) ExpectIdParam2(id uuid.UUID) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Return(err error) *RepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the Repository.Delete method
+func (mmDelete *mRepositoryMockDelete) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *RepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the Repository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the Repository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the Repository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRepositoryMockDelete) When(ctx context.Context, id uuid.UUID) *RepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &RepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: RepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Delete return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Delete should be invoked
+func (mmDelete *mRepositoryMockDelete) Times(n uint64) *mRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_invitation.Repository
+func (mmDelete *RepositoryMock) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := RepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RepositoryMock.Delete invocations
+func (mmDelete *RepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RepositoryMock.Delete invocations
+func (mmDelete *RepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRepositoryMockDelete) Calls() []*RepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mRepositoryMockGetByTokenHash struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetByTokenHashExpectation
+	expectations       []*RepositoryMockGetByTokenHashExpectation
+
+	callArgs []*RepositoryMockGetByTokenHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetByTokenHashExpectation specifies expectation struct of the Repository.GetByTokenHash
+type RepositoryMockGetByTokenHashExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetByTokenHashParams
+	paramPtrs          *RepositoryMockGetByTokenHashParamPtrs
+	expectationOrigins RepositoryMockGetByTokenHashExpectationOrigins
+	results            *RepositoryMockGetByTokenHashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetByTokenHashParams contains parameters of the Repository.GetByTokenHash
+type RepositoryMockGetByTokenHashParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// RepositoryMockGetByTokenHashParamPtrs contains pointers to parameters of the Repository.GetByTokenHash
+type RepositoryMockGetByTokenHashParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// RepositoryMockGetByTokenHashResults contains results of the Repository.GetByTokenHash
+type RepositoryMockGetByTokenHashResults struct {
+	i1  mm_invitation.Invitation
+	err error
+}
+
+// RepositoryMockGetByTokenHashOrigins contains origins of expectations of the Repository.GetByTokenHash
+type RepositoryMockGetByTokenHashExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Optional() *mRepositoryMockGetByTokenHash {
+	mmGetByTokenHash.optional = true
+	return mmGetByTokenHash
+}
+
+// Expect sets up expected params for Repository.GetByTokenHash
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Expect(ctx context.Context, tokenHash string) *mRepositoryMockGetByTokenHash {
+	if mmGetByTokenHash.mock.funcGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Set")
+	}
+
+	if mmGetByTokenHash.defaultExpectation == nil {
+		mmGetByTokenHash.defaultExpectation = &RepositoryMockGetByTokenHashExpectation{}
+	}
+
+	if mmGetByTokenHash.defaultExpectation.paramPtrs != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetByTokenHash.defaultExpectation.params = &RepositoryMockGetByTokenHashParams{ctx, tokenHash}
+	mmGetByTokenHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByTokenHash.expectations {
+		if minimock.Equal(e.params, mmGetByTokenHash.defaultExpectation.params) {
+			mmGetByTokenHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByTokenHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByTokenHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetByTokenHash
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetByTokenHash {
+	if mmGetByTokenHash.mock.funcGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Set")
+	}
+
+	if mmGetByTokenHash.defaultExpectation == nil {
+		mmGetByTokenHash.defaultExpectation = &RepositoryMockGetByTokenHashExpectation{}
+	}
+
+	if mmGetByTokenHash.defaultExpectation.params != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetByTokenHash.defaultExpectation.paramPtrs = &RepositoryMockGetByTokenHashParamPtrs{}
+	}
+	mmGetByTokenHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByTokenHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetByTokenHash
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for Repository.GetByTokenHash
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) ExpectTokenHashParam2(tokenHash string) *mRepositoryMockGetByTokenHash {
+	if mmGetByTokenHash.mock.funcGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Set")
+	}
+
+	if mmGetByTokenHash.defaultExpectation == nil {
+		mmGetByTokenHash.defaultExpectation = &RepositoryMockGetByTokenHashExpectation{}
+	}
+
+	if mmGetByTokenHash.defaultExpectation.params != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetByTokenHash.defaultExpectation.paramPtrs = &RepositoryMockGetByTokenHashParamPtrs{}
+	}
+	mmGetByTokenHash.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmGetByTokenHash.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmGetByTokenHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetByTokenHash
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Inspect(f func(ctx context.Context, tokenHash string)) *mRepositoryMockGetByTokenHash {
+	if mmGetByTokenHash.mock.inspectFuncGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetByTokenHash")
+	}
+
+	mmGetByTokenHash.mock.inspectFuncGetByTokenHash = f
+
+	return mmGetByTokenHash
+}
+
+// Return sets up results that will be returned by Repository.GetByTokenHash
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Return(i1 mm_invitation.Invitation, err error) *RepositoryMock {
+	if mmGetByTokenHash.mock.funcGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Set")
+	}
+
+	if mmGetByTokenHash.defaultExpectation == nil {
+		mmGetByTokenHash.defaultExpectation = &RepositoryMockGetByTokenHashExpectation{mock: mmGetByTokenHash.mock}
+	}
+	mmGetByTokenHash.defaultExpectation.results = &RepositoryMockGetByTokenHashResults{i1, err}
+	mmGetByTokenHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByTokenHash.mock
+}
+
+// Set uses given function f to mock the Repository.GetByTokenHash method
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Set(f func(ctx context.Context, tokenHash string) (i1 mm_invitation.Invitation, err error)) *RepositoryMock {
+	if mmGetByTokenHash.defaultExpectation != nil {
+		mmGetByTokenHash.mock.t.Fatalf("Default expectation is already set for the Repository.GetByTokenHash method")
+	}
+
+	if len(mmGetByTokenHash.expectations) > 0 {
+		mmGetByTokenHash.mock.t.Fatalf("Some expectations are already set for the Repository.GetByTokenHash method")
+	}
+
+	mmGetByTokenHash.mock.funcGetByTokenHash = f
+	mmGetByTokenHash.mock.funcGetByTokenHashOrigin = minimock.CallerInfo(1)
+	return mmGetByTokenHash.mock
+}
+
+// When sets expectation for the Repository.GetByTokenHash which will trigger the result defined by the following
+// Then helper
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) When(ctx context.Context, tokenHash string) *RepositoryMockGetByTokenHashExpectation {
+	if mmGetByTokenHash.mock.funcGetByTokenHash != nil {
+		mmGetByTokenHash.mock.t.Fatalf("RepositoryMock.GetByTokenHash mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetByTokenHashExpectation{
+		mock:               mmGetByTokenHash.mock,
+		params:             &RepositoryMockGetByTokenHashParams{ctx, tokenHash},
+		expectationOrigins: RepositoryMockGetByTokenHashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetByTokenHash.expectations = append(mmGetByTokenHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetByTokenHash return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetByTokenHashExpectation) Then(i1 mm_invitation.Invitation, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetByTokenHashResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetByTokenHash should be invoked
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Times(n uint64) *mRepositoryMockGetByTokenHash {
+	if n == 0 {
+		mmGetByTokenHash.mock.t.Fatalf("Times of RepositoryMock.GetByTokenHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByTokenHash.expectedInvocations, n)
+	mmGetByTokenHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByTokenHash
+}
+
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) invocationsDone() bool {
+	if len(mmGetByTokenHash.expectations) == 0 && mmGetByTokenHash.defaultExpectation == nil && mmGetByTokenHash.mock.funcGetByTokenHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByTokenHash.mock.afterGetByTokenHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByTokenHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByTokenHash implements mm_invitation.Repository
+func (mmGetByTokenHash *RepositoryMock) GetByTokenHash(ctx context.Context, tokenHash string) (i1 mm_invitation.Invitation, err error) {
+	mm_atomic.AddUint64(&mmGetByTokenHash.beforeGetByTokenHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByTokenHash.afterGetByTokenHashCounter, 1)
+
+	mmGetByTokenHash.t.Helper()
+
+	if mmGetByTokenHash.inspectFuncGetByTokenHash != nil {
+		mmGetByTokenHash.inspectFuncGetByTokenHash(ctx, tokenHash)
+	}
+
+	mm_params := RepositoryMockGetByTokenHashParams{ctx, tokenHash}
+
+	// Record call args
+	mmGetByTokenHash.GetByTokenHashMock.mutex.Lock()
+	mmGetByTokenHash.GetByTokenHashMock.callArgs = append(mmGetByTokenHash.GetByTokenHashMock.callArgs, &mm_params)
+	mmGetByTokenHash.GetByTokenHashMock.mutex.Unlock()
+
+	for _, e := range mmGetByTokenHash.GetByTokenHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetByTokenHash.GetByTokenHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetByTokenHashParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByTokenHash.t.Errorf("RepositoryMock.GetByTokenHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmGetByTokenHash.t.Errorf("RepositoryMock.GetByTokenHash got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByTokenHash.t.Errorf("RepositoryMock.GetByTokenHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByTokenHash.GetByTokenHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByTokenHash.t.Fatal("No results are set for the RepositoryMock.GetByTokenHash")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetByTokenHash.funcGetByTokenHash != nil {
+		return mmGetByTokenHash.funcGetByTokenHash(ctx, tokenHash)
+	}
+	mmGetByTokenHash.t.Fatalf("Unexpected call to RepositoryMock.GetByTokenHash. %v %v", ctx, tokenHash)
+	return
+}
+
+// GetByTokenHashAfterCounter returns a count of finished RepositoryMock.GetByTokenHash invocations
+func (mmGetByTokenHash *RepositoryMock) GetByTokenHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByTokenHash.afterGetByTokenHashCounter)
+}
+
+// GetByTokenHashBeforeCounter returns a count of RepositoryMock.GetByTokenHash invocations
+func (mmGetByTokenHash *RepositoryMock) GetByTokenHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByTokenHash.beforeGetByTokenHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetByTokenHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByTokenHash *mRepositoryMockGetByTokenHash) Calls() []*RepositoryMockGetByTokenHashParams {
+	mmGetByTokenHash.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetByTokenHashParams, len(mmGetByTokenHash.callArgs))
+	copy(argCopy, mmGetByTokenHash.callArgs)
+
+	mmGetByTokenHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByTokenHashDone returns true if the count of the GetByTokenHash invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetByTokenHashDone() bool {
+	if m.GetByTokenHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByTokenHashMock.invocationsDone()
+}
+
+// MinimockGetByTokenHashInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetByTokenHashInspect() {
+	for _, e := range m.GetByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetByTokenHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByTokenHashCounter := mm_atomic.LoadUint64(&m.afterGetByTokenHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByTokenHashMock.defaultExpectation != nil && afterGetByTokenHashCounter < 1 {
+		if m.GetByTokenHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetByTokenHash at\n%s", m.GetByTokenHashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetByTokenHash at\n%s with params: %#v", m.GetByTokenHashMock.defaultExpectation.expectationOrigins.origin, *m.GetByTokenHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByTokenHash != nil && afterGetByTokenHashCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetByTokenHash at\n%s", m.funcGetByTokenHashOrigin)
+	}
+
+	if !m.GetByTokenHashMock.invocationsDone() && afterGetByTokenHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetByTokenHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByTokenHashMock.expectedInvocations), m.GetByTokenHashMock.expectedInvocationsOrigin, afterGetByTokenHashCounter)
+	}
+}
+
+type mRepositoryMockList struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListExpectation
+	expectations       []*RepositoryMockListExpectation
+
+	callArgs []*RepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListExpectation specifies expectation struct of the Repository.List
+type RepositoryMockListExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListParams
+	paramPtrs          *RepositoryMockListParamPtrs
+	expectationOrigins RepositoryMockListExpectationOrigins
+	results            *RepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListParams contains parameters of the Repository.List
+type RepositoryMockListParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockListParamPtrs contains pointers to parameters of the Repository.List
+type RepositoryMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockListResults contains results of the Repository.List
+type RepositoryMockListResults struct {
+	ia1 []mm_invitation.Invitation
+	err error
+}
+
+// RepositoryMockListOrigins contains origins of expectations of the Repository.List
+type RepositoryMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRepositoryMockList) Optional() *mRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Repository.List
+func (mmList *mRepositoryMockList) Expect(ctx context.Context) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RepositoryMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.List
+func (mmList *mRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.List
+func (mmList *mRepositoryMockList) Inspect(f func(ctx context.Context)) *mRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Repository.List
+func (mmList *mRepositoryMockList) Return(ia1 []mm_invitation.Invitation, err error) *RepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RepositoryMockListResults{ia1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Repository.List method
+func (mmList *mRepositoryMockList) Set(f func(ctx context.Context) (ia1 []mm_invitation.Invitation, err error)) *RepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Repository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Repository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Repository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRepositoryMockList) When(ctx context.Context) *RepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &RepositoryMockListParams{ctx},
+		expectationOrigins: RepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.List return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListExpectation) Then(ia1 []mm_invitation.Invitation, err error) *RepositoryMock {
+	e.results = &RepositoryMockListResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.List should be invoked
+func (mmList *mRepositoryMockList) Times(n uint64) *mRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_invitation.Repository
+func (mmList *RepositoryMock) List(ctx context.Context) (ia1 []mm_invitation.Invitation, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := RepositoryMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RepositoryMock.List")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to RepositoryMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRepositoryMockList) Calls() []*RepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mRepositoryMockMarkAccepted struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockMarkAcceptedExpectation
+	expectations       []*RepositoryMockMarkAcceptedExpectation
+
+	callArgs []*RepositoryMockMarkAcceptedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockMarkAcceptedExpectation specifies expectation struct of the Repository.MarkAccepted
+type RepositoryMockMarkAcceptedExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockMarkAcceptedParams
+	paramPtrs          *RepositoryMockMarkAcceptedParamPtrs
+	expectationOrigins RepositoryMockMarkAcceptedExpectationOrigins
+	results            *RepositoryMockMarkAcceptedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockMarkAcceptedParams contains parameters of the Repository.MarkAccepted
+type RepositoryMockMarkAcceptedParams struct {
+	ctx    context.Context
+	id     uuid.UUID
+	userID uuid.UUID
+	at     time.Time
+}
+
+// RepositoryMockMarkAcceptedParamPtrs contains pointers to parameters of the Repository.MarkAccepted
+type RepositoryMockMarkAcceptedParamPtrs struct {
+	ctx    *context.Context
+	id     *uuid.UUID
+	userID *uuid.UUID
+	at     *time.Time
+}
+
+// RepositoryMockMarkAcceptedResults contains results of the Repository.MarkAccepted
+type RepositoryMockMarkAcceptedResults struct {
+	err error
+}
+
+// RepositoryMockMarkAcceptedOrigins contains origins of expectations of the Repository.MarkAccepted
+type RepositoryMockMarkAcceptedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUserID string
+	originAt     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Optional() *mRepositoryMockMarkAccepted {
+	mmMarkAccepted.optional = true
+	return mmMarkAccepted
+}
+
+// Expect sets up expected params for Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by ExpectParams functions")
+	}
+
+	mmMarkAccepted.defaultExpectation.params = &RepositoryMockMarkAcceptedParams{ctx, id, userID, at}
+	mmMarkAccepted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkAccepted.expectations {
+		if minimock.Equal(e.params, mmMarkAccepted.defaultExpectation.params) {
+			mmMarkAccepted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkAccepted.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkAccepted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) ExpectCtxParam1(ctx context.Context) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &RepositoryMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) ExpectIdParam2(id uuid.UUID) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &RepositoryMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.id = &id
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// ExpectUserIDParam3 sets up expected param userID for Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) ExpectUserIDParam3(userID uuid.UUID) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &RepositoryMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// ExpectAtParam4 sets up expected param at for Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) ExpectAtParam4(at time.Time) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &RepositoryMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.at = &at
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time)) *mRepositoryMockMarkAccepted {
+	if mmMarkAccepted.mock.inspectFuncMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("Inspect function is already set for RepositoryMock.MarkAccepted")
+	}
+
+	mmMarkAccepted.mock.inspectFuncMarkAccepted = f
+
+	return mmMarkAccepted
+}
+
+// Return sets up results that will be returned by Repository.MarkAccepted
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Return(err error) *RepositoryMock {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &RepositoryMockMarkAcceptedExpectation{mock: mmMarkAccepted.mock}
+	}
+	mmMarkAccepted.defaultExpectation.results = &RepositoryMockMarkAcceptedResults{err}
+	mmMarkAccepted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted.mock
+}
+
+// Set uses given function f to mock the Repository.MarkAccepted method
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) (err error)) *RepositoryMock {
+	if mmMarkAccepted.defaultExpectation != nil {
+		mmMarkAccepted.mock.t.Fatalf("Default expectation is already set for the Repository.MarkAccepted method")
+	}
+
+	if len(mmMarkAccepted.expectations) > 0 {
+		mmMarkAccepted.mock.t.Fatalf("Some expectations are already set for the Repository.MarkAccepted method")
+	}
+
+	mmMarkAccepted.mock.funcMarkAccepted = f
+	mmMarkAccepted.mock.funcMarkAcceptedOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted.mock
+}
+
+// When sets expectation for the Repository.MarkAccepted which will trigger the result defined by the following
+// Then helper
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) When(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) *RepositoryMockMarkAcceptedExpectation {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("RepositoryMock.MarkAccepted mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockMarkAcceptedExpectation{
+		mock:               mmMarkAccepted.mock,
+		params:             &RepositoryMockMarkAcceptedParams{ctx, id, userID, at},
+		expectationOrigins: RepositoryMockMarkAcceptedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkAccepted.expectations = append(mmMarkAccepted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MarkAccepted return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockMarkAcceptedExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockMarkAcceptedResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MarkAccepted should be invoked
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Times(n uint64) *mRepositoryMockMarkAccepted {
+	if n == 0 {
+		mmMarkAccepted.mock.t.Fatalf("Times of RepositoryMock.MarkAccepted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkAccepted.expectedInvocations, n)
+	mmMarkAccepted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted
+}
+
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) invocationsDone() bool {
+	if len(mmMarkAccepted.expectations) == 0 && mmMarkAccepted.defaultExpectation == nil && mmMarkAccepted.mock.funcMarkAccepted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkAccepted.mock.afterMarkAcceptedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkAccepted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkAccepted implements mm_invitation.Repository
+func (mmMarkAccepted *RepositoryMock) MarkAccepted(ctx context.Context, id uuid.UUID, userID uuid.UUID, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkAccepted.beforeMarkAcceptedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkAccepted.afterMarkAcceptedCounter, 1)
+
+	mmMarkAccepted.t.Helper()
+
+	if mmMarkAccepted.inspectFuncMarkAccepted != nil {
+		mmMarkAccepted.inspectFuncMarkAccepted(ctx, id, userID, at)
+	}
+
+	mm_params := RepositoryMockMarkAcceptedParams{ctx, id, userID, at}
+
+	// Record call args
+	mmMarkAccepted.MarkAcceptedMock.mutex.Lock()
+	mmMarkAccepted.MarkAcceptedMock.callArgs = append(mmMarkAccepted.MarkAcceptedMock.callArgs, &mm_params)
+	mmMarkAccepted.MarkAcceptedMock.mutex.Unlock()
+
+	for _, e := range mmMarkAccepted.MarkAcceptedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkAccepted.MarkAcceptedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkAccepted.MarkAcceptedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockMarkAcceptedParams{ctx, id, userID, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkAccepted.t.Errorf("RepositoryMock.MarkAccepted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkAccepted.t.Errorf("RepositoryMock.MarkAccepted got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkAccepted.t.Errorf("RepositoryMock.MarkAccepted got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmMarkAccepted.t.Errorf("RepositoryMock.MarkAccepted got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkAccepted.t.Errorf("RepositoryMock.MarkAccepted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkAccepted.t.Fatal("No results are set for the RepositoryMock.MarkAccepted")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkAccepted.funcMarkAccepted != nil {
+		return mmMarkAccepted.funcMarkAccepted(ctx, id, userID, at)
+	}
+	mmMarkAccepted.t.Fatalf("Unexpected call to RepositoryMock.MarkAccepted. %v %v %v %v", ctx, id, userID, at)
+	return
+}
+
+// MarkAcceptedAfterCounter returns a count of finished RepositoryMock.MarkAccepted invocations
+func (mmMarkAccepted *RepositoryMock) MarkAcceptedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAccepted.afterMarkAcceptedCounter)
+}
+
+// MarkAcceptedBeforeCounter returns a count of RepositoryMock.MarkAccepted invocations
+func (mmMarkAccepted *RepositoryMock) MarkAcceptedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAccepted.beforeMarkAcceptedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.MarkAccepted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkAccepted *mRepositoryMockMarkAccepted) Calls() []*RepositoryMockMarkAcceptedParams {
+	mmMarkAccepted.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockMarkAcceptedParams, len(mmMarkAccepted.callArgs))
+	copy(argCopy, mmMarkAccepted.callArgs)
+
+	mmMarkAccepted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkAcceptedDone returns true if the count of the MarkAccepted invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockMarkAcceptedDone() bool {
+	if m.MarkAcceptedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkAcceptedMock.invocationsDone()
+}
+
+// MinimockMarkAcceptedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockMarkAcceptedInspect() {
+	for _, e := range m.MarkAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAccepted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkAcceptedCounter := mm_atomic.LoadUint64(&m.afterMarkAcceptedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkAcceptedMock.defaultExpectation != nil && afterMarkAcceptedCounter < 1 {
+		if m.MarkAcceptedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAccepted at\n%s", m.MarkAcceptedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAccepted at\n%s with params: %#v", m.MarkAcceptedMock.defaultExpectation.expectationOrigins.origin, *m.MarkAcceptedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkAccepted != nil && afterMarkAcceptedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.MarkAccepted at\n%s", m.funcMarkAcceptedOrigin)
+	}
+
+	if !m.MarkAcceptedMock.invocationsDone() && afterMarkAcceptedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.MarkAccepted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkAcceptedMock.expectedInvocations), m.MarkAcceptedMock.expectedInvocationsOrigin, afterMarkAcceptedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetByTokenHashInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockMarkAcceptedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetByTokenHashDone() &&
+		m.MinimockListDone() &&
+		m.MinimockMarkAcceptedDone()
+}
@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type invitationModel struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	WorkspaceID uuid.UUID
+	Email       string
+	TokenHash   string
+	InvitedBy   *uuid.UUID
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	AcceptedAt  *time.Time
+	UserID      *uuid.UUID
+}
+
+func (m *invitationModel) TableName() string {
+	return "invitations"
+}
+
+func (m *invitationModel) toDTO(roles []invitation.Role) invitation.Invitation {
+	return invitation.Invitation{
+		ID:          m.ID,
+		WorkspaceID: m.WorkspaceID,
+		Email:       m.Email,
+		Roles:       roles,
+		InvitedBy:   m.InvitedBy,
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+		AcceptedAt:  m.AcceptedAt,
+		UserID:      m.UserID,
+	}
+}
+
+type roleModel struct {
+	InvitationID uuid.UUID
+	Role         auth.Role
+	EntityID     *uuid.UUID
+}
+
+func (m *roleModel) TableName() string {
+	return "invitation_roles"
+}
+
+func roleModels(inv invitation.Invitation) []roleModel {
+	return lo.Map(inv.Roles, func(r invitation.Role, _ int) roleModel {
+		return roleModel{InvitationID: inv.ID, Role: r.Role, EntityID: r.EntityID}
+	})
+}
@@ -0,0 +1,185 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// Create checks the email and the entities in the same transaction as the insert, so an invitation
+// is never made for a user who registered in the meantime.
+func (r *gormRepo) Create(ctx context.Context, inv invitation.Invitation, tokenHash string) error {
+	workspaceID := contextx.WorkspaceID(ctx)
+	model := invitationModel{
+		ID:          inv.ID,
+		WorkspaceID: workspaceID,
+		Email:       inv.Email,
+		TokenHash:   tokenHash,
+		InvitedBy:   inv.InvitedBy,
+		CreatedAt:   inv.CreatedAt,
+		ExpiresAt:   inv.ExpiresAt,
+	}
+
+	err := db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Table("users").Scopes(db.ActiveOnly("deleted_at")).
+			Where("workspace_id = ? AND lower(email) = lower(?)", workspaceID, inv.Email).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return user.ErrUserWithEmailAlreadyExists()
+		}
+		if err = checkEntities(tx, workspaceID, inv.Roles); err != nil {
+			return err
+		}
+
+		err = tx.Where("workspace_id = ? AND lower(email) = lower(?) AND accepted_at IS NULL", workspaceID, inv.Email).
+			Delete(&invitationModel{}).Error
+		if err != nil {
+			return err
+		}
+		if err = tx.Create(&model).Error; err != nil {
+			return err
+		}
+		if len(inv.Roles) == 0 {
+			return nil
+		}
+
+		return tx.Create(roleModels(inv)).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.Create: %w", err)
+	}
+
+	return nil
+}
+
+func checkEntities(tx *gorm.DB, workspaceID uuid.UUID, roles []invitation.Role) error {
+	ids := lo.FilterMap(roles, func(r invitation.Role, _ int) (uuid.UUID, bool) { return lo.FromPtr(r.EntityID), r.EntityID != nil })
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var count int64
+	err := tx.Table("entities").Scopes(db.ActiveOnly("deleted_at")).Where("id IN ? AND workspace_id = ?", ids, workspaceID).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if int(count) != len(ids) {
+		return invitation.ErrEntityNotFound()
+	}
+
+	return nil
+}
+
+func (r *gormRepo) List(ctx context.Context) ([]invitation.Invitation, error) {
+	var models []invitationModel
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Order("created_at DESC, id").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+	roles, err := r.roles(r.db.WithContext(ctx), lo.Map(models, func(m invitationModel, _ int) uuid.UUID { return m.ID }))
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+
+	return lo.Map(models, func(m invitationModel, _ int) invitation.Invitation { return m.toDTO(roles[m.ID]) }), nil
+}
+
+// roles returns the roles of the invitations by invitation, admin roles first, then by entity.
+func (r *gormRepo) roles(conn *gorm.DB, ids []uuid.UUID) (map[uuid.UUID][]invitation.Role, error) {
+	roles := make(map[uuid.UUID][]invitation.Role, len(ids))
+	for _, id := range ids {
+		roles[id] = make([]invitation.Role, 0)
+	}
+	if len(ids) == 0 {
+		return roles, nil
+	}
+
+	var models []roleModel
+	if err := conn.Where("invitation_id IN ?", ids).Order("entity_id NULLS FIRST").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		roles[m.InvitationID] = append(roles[m.InvitationID], invitation.Role{Role: m.Role, EntityID: m.EntityID})
+	}
+
+	return roles, nil
+}
+
+func (r *gormRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ? AND accepted_at IS NULL", id).
+		Delete(&invitationModel{})
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.Delete: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&invitationModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("gormRepo.Delete: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("gormRepo.Delete: %w", invitation.ErrAccepted())
+	}
+
+	return fmt.Errorf("gormRepo.Delete: %w", invitation.ErrNotFound())
+}
+
+// GetByTokenHash is not scoped to a workspace: the invitee is not signed in, and the invitation
+// tells which workspace they join.
+func (r *gormRepo) GetByTokenHash(ctx context.Context, tokenHash string) (invitation.Invitation, error) {
+	conn := db.Conn(ctx, r.db)
+
+	var model invitationModel
+	err := conn.Clauses(clause.Locking{Strength: "UPDATE"}).Where("token_hash = ?", tokenHash).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = invitation.ErrNotFound()
+		}
+		return invitation.Invitation{}, fmt.Errorf("gormRepo.GetByTokenHash: %w", err)
+	}
+	roles, err := r.roles(conn, []uuid.UUID{model.ID})
+	if err != nil {
+		return invitation.Invitation{}, fmt.Errorf("gormRepo.GetByTokenHash: %w", err)
+	}
+
+	return model.toDTO(roles[model.ID]), nil
+}
+
+func (r *gormRepo) MarkAccepted(ctx context.Context, id, userID uuid.UUID, at time.Time) error {
+	result := db.Conn(ctx, r.db).Model(&invitationModel{}).Where("id = ? AND accepted_at IS NULL", id).
+		Updates(map[string]any{"accepted_at": at, "user_id": userID})
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.MarkAccepted: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.MarkAccepted: %w", invitation.ErrAccepted())
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestInvitations(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+	ctx := t.Context()
+
+	adminID, _ := createUser(t, gdb)
+	entityID := createEntity(t, gdb, adminID)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	inv := invitation.Invitation{
+		ID: uuid.New(), Email: "ann@example.com", InvitedBy: &adminID, CreatedAt: now.Add(-time.Hour),
+		ExpiresAt: now.Add(time.Hour),
+		Roles:     []invitation.Role{{Role: auth.RoleAdmin}, {Role: auth.RoleWrite, EntityID: &entityID}},
+	}
+	require.NoError(t, repo.Create(ctx, inv, "hash-1"))
+
+	got, err := repo.GetByTokenHash(ctx, "hash-1")
+	require.NoError(t, err)
+	require.Equal(t, inv.ID, got.ID)
+	require.Equal(t, contextx.DefaultWorkspaceID, got.WorkspaceID)
+	require.Equal(t, inv.Roles, got.Roles)
+	_, err = repo.GetByTokenHash(ctx, "unknown")
+	require.ErrorIs(t, err, invitation.ErrNotFound())
+
+	// a new invitation of the same email replaces the pending one
+	again := invitation.Invitation{ID: uuid.New(), Email: "ANN@example.com", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, repo.Create(ctx, again, "hash-2"))
+	_, err = repo.GetByTokenHash(ctx, "hash-1")
+	require.ErrorIs(t, err, invitation.ErrNotFound())
+
+	// an email in use and an unknown entity are rejected
+	_, email := createUser(t, gdb)
+	err = repo.Create(ctx, invitation.Invitation{ID: uuid.New(), Email: email, CreatedAt: now, ExpiresAt: now}, "hash-3")
+	require.ErrorIs(t, err, user.ErrUserWithEmailAlreadyExists())
+	unknown := uuid.New()
+	err = repo.Create(ctx, invitation.Invitation{
+		ID: uuid.New(), Email: "bob@example.com", CreatedAt: now, ExpiresAt: now,
+		Roles: []invitation.Role{{Role: auth.RoleRead, EntityID: &unknown}},
+	}, "hash-3")
+	require.ErrorIs(t, err, invitation.ErrEntityNotFound())
+
+	bob := invitation.Invitation{ID: uuid.New(), Email: "bob@example.com", CreatedAt: now.Add(time.Minute), ExpiresAt: now.Add(time.Hour)}
+	require.NoError(t, repo.Create(ctx, bob, "hash-4"))
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{bob.ID, again.ID}, []uuid.UUID{list[0].ID, list[1].ID})
+	require.Equal(t, []invitation.Role{}, list[0].Roles)
+
+	// accepted invitations are accepted once and cannot be revoked
+	userID, _ := createUser(t, gdb)
+	require.NoError(t, repo.MarkAccepted(ctx, again.ID, userID, now))
+	require.ErrorIs(t, repo.MarkAccepted(ctx, again.ID, userID, now), invitation.ErrAccepted())
+	got, err = repo.GetByTokenHash(ctx, "hash-2")
+	require.NoError(t, err)
+	require.Equal(t, &userID, got.UserID)
+	require.True(t, now.Equal(*got.AcceptedAt))
+	require.ErrorIs(t, repo.Delete(ctx, again.ID), invitation.ErrAccepted())
+
+	require.NoError(t, repo.Delete(ctx, bob.ID))
+	require.ErrorIs(t, repo.Delete(ctx, bob.ID), invitation.ErrNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.List(ctx)
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) (uuid.UUID, string) {
+	t.Helper()
+
+	uid := uuid.New()
+	email := uid.String() + "@example.com"
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, email, "Test", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid, email
+}
+
+func createEntity(t *testing.T, gdb *gorm.DB, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	eid := uuid.New()
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,created_by,updated_by,owner_id)
+		 VALUES ($1,'t',NOW(),NOW(),'name',$1::TEXT,'',$2,$2,$2)`,
+		eid, userID,
+	).Error
+	require.NoError(t, err)
+
+	return eid
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	URLParamInvitationID = "invitation_id"
+	URLParamToken        = "token"
+)
+
+type Service interface {
+	Create(ctx context.Context, req invitation.CreateReq) (invitation.Created, error)
+	List(ctx context.Context) ([]invitation.Invitation, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Accept(ctx context.Context, cmd usecase.AcceptCmd) (uuid.UUID, error)
+}
+
+type AcceptInput struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type AcceptOutput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("invitation HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// Create godoc
+// @Summary      Invite a user
+// @Description  Creates an invitation for the email with optional roles granted on registration, and emails its link when mail and invitation.accept_url are configured. The token is returned only here; a new invitation replaces the pending ones of the same email. Requires admin role.
+// @Tags         invitations
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body invitation.CreateReq true "Invitation payload"
+// @Success      201 {object} invitation.Created
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /invitations [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var input invitation.CreateReq
+	if err := httpx.DecodeJSON(r, &input); err != nil {
+		logger.Error(ctx, err).Msg("invitation.Handler.Create: request json decode failed")
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	created, err := h.svc.Create(ctx, input)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, created)
+}
+
+// List godoc
+// @Summary      List invitations
+// @Description  Returns the invitations of the workspace, newest first, with their status: pending, accepted or expired. Requires admin role.
+// @Tags         invitations
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} invitation.Invitation
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /invitations [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	invitations, err := h.svc.List(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, invitations)
+}
+
+// Revoke godoc
+// @Summary      Revoke invitation
+// @Description  Deletes an invitation that is not accepted yet, so its link stops working. Requires admin role.
+// @Tags         invitations
+// @Security     BearerAuth
+// @Param        invitation_id path string true "Invitation ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /invitations/{invitation_id} [delete]
+func (h *Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamInvitationID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(invitation.FieldInvitationID.String(), idStr).
+			Msg("invitation.Handler.Revoke: invalid invitation ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.Revoke(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Accept godoc
+// @Summary      Register with an invitation
+// @Description  Creates the account of the invitee with the email of the invitation and grants its roles, all or none. An invitation is accepted once and until it expires.
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Param        token path string true "Invitation token"
+// @Param        request body AcceptInput true "Account payload"
+// @Success      201 {object} AcceptOutput
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /register/invite/{token} [post]
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var in AcceptInput
+	if err := httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).Msg("invitation.Handler.Accept: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	cmd := usecase.AcceptCmd{
+		Token:    chi.URLParam(r, URLParamToken),
+		Name:     in.Name,
+		Password: []byte(in.Password),
+	}
+	defer secure.ZeroBytes(cmd.Password)
+	in.Password = ""
+
+	userID, err := h.svc.Accept(ctx, cmd)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, AcceptOutput{UserID: userID})
+}
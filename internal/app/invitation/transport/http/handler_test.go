@@ -0,0 +1,206 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	invitation_http "github.com/66gu1/easygodocs/internal/app/invitation/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/invitation/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_Create(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id  = uuid.New()
+		req = invitation.CreateReq{Email: "ann@example.com", Roles: []invitation.Role{{Role: "admin"}}}
+	)
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "ok -> 201",
+			body: `{"email":"ann@example.com","roles":[{"role":"admin"}]}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.CreateMock.Expect(minimock.AnyContext, req).Return(invitation.Created{
+					Invitation: invitation.Invitation{
+						ID: id, Email: "ann@example.com", Roles: req.Roles, Status: invitation.StatusPending,
+					},
+					Token: "token",
+				}, nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody: `{"id":"` + id.String() + `","email":"ann@example.com","roles":[{"role":"admin","entity_id":null}],` +
+				`"status":"pending","invited_by":null,"created_at":"0001-01-01T00:00:00Z","expires_at":"0001-01-01T00:00:00Z",` +
+				`"token":"token","email_sent":false}`,
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       "{",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "too many roles -> 400",
+			body: `{"email":"ann@example.com","roles":[{"role":"admin"}]}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.CreateMock.Return(invitation.Created{}, invitation.ErrTooManyRoles())
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := invitation_http.NewHandler(mock)
+
+			rr := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/invitations", strings.NewReader(tc.body))
+			r.Header.Set("Content-Type", "application/json")
+			h.Create(rr, r)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Revoke(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name         string
+		invitationID string
+		setup        func(mock *mocks.ServiceMock)
+		wantStatus   int
+	}{
+		{
+			name:         "invalid UUID -> 400",
+			invitationID: "invalid",
+			wantStatus:   http.StatusBadRequest,
+		},
+		{
+			name:         "accepted -> 409",
+			invitationID: id.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.RevokeMock.Expect(minimock.AnyContext, id).Return(invitation.ErrAccepted())
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:         "ok -> 204",
+			invitationID: id.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.RevokeMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := invitation_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Delete("/invitations/{"+invitation_http.URLParamInvitationID+"}", h.Revoke)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/invitations/"+tc.invitationID, nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_Accept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		cmd    = usecase.AcceptCmd{Token: "token", Name: "Ann", Password: []byte("secret123")}
+		body   = `{"name":"Ann","password":"secret123"}`
+	)
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "ok -> 201",
+			body: body,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AcceptMock.Expect(minimock.AnyContext, cmd).Return(userID, nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"user_id":"` + userID.String() + `"}`,
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       "{",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "expired -> 409",
+			body: body,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AcceptMock.Return(uuid.Nil, invitation.ErrExpired())
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "unknown token -> 404",
+			body: body,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AcceptMock.Return(uuid.Nil, invitation.ErrNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := invitation_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Post("/register/invite/{"+invitation_http.URLParamToken+"}", h.Accept)
+
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/register/invite/token", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,1456 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAccept          func(ctx context.Context, cmd usecase.AcceptCmd) (u1 uuid.UUID, err error)
+	funcAcceptOrigin    string
+	inspectFuncAccept   func(ctx context.Context, cmd usecase.AcceptCmd)
+	afterAcceptCounter  uint64
+	beforeAcceptCounter uint64
+	AcceptMock          mServiceMockAccept
+
+	funcCreate          func(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, req invitation.CreateReq)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mServiceMockCreate
+
+	funcList          func(ctx context.Context) (ia1 []invitation.Invitation, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mServiceMockList
+
+	funcRevoke          func(ctx context.Context, id uuid.UUID) (err error)
+	funcRevokeOrigin    string
+	inspectFuncRevoke   func(ctx context.Context, id uuid.UUID)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mServiceMockRevoke
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcceptMock = mServiceMockAccept{mock: m}
+	m.AcceptMock.callArgs = []*ServiceMockAcceptParams{}
+
+	m.CreateMock = mServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*ServiceMockCreateParams{}
+
+	m.ListMock = mServiceMockList{mock: m}
+	m.ListMock.callArgs = []*ServiceMockListParams{}
+
+	m.RevokeMock = mServiceMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*ServiceMockRevokeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockAccept struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockAcceptExpectation
+	expectations       []*ServiceMockAcceptExpectation
+
+	callArgs []*ServiceMockAcceptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockAcceptExpectation specifies expectation struct of the Service.Accept
+type ServiceMockAcceptExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockAcceptParams
+	paramPtrs          *ServiceMockAcceptParamPtrs
+	expectationOrigins ServiceMockAcceptExpectationOrigins
+	results            *ServiceMockAcceptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockAcceptParams contains parameters of the Service.Accept
+type ServiceMockAcceptParams struct {
+	ctx context.Context
+	cmd usecase.AcceptCmd
+}
+
+// ServiceMockAcceptParamPtrs contains pointers to parameters of the Service.Accept
+type ServiceMockAcceptParamPtrs struct {
+	ctx *context.Context
+	cmd *usecase.AcceptCmd
+}
+
+// ServiceMockAcceptResults contains results of the Service.Accept
+type ServiceMockAcceptResults struct {
+	u1  uuid.UUID
+	err error
+}
+
+// ServiceMockAcceptOrigins contains origins of expectations of the Service.Accept
+type ServiceMockAcceptExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originCmd string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAccept *mServiceMockAccept) Optional() *mServiceMockAccept {
+	mmAccept.optional = true
+	return mmAccept
+}
+
+// Expect sets up expected params for Service.Accept
+func (mmAccept *mServiceMockAccept) Expect(ctx context.Context, cmd usecase.AcceptCmd) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by ExpectParams functions")
+	}
+
+	mmAccept.defaultExpectation.params = &ServiceMockAcceptParams{ctx, cmd}
+	mmAccept.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAccept.expectations {
+		if minimock.Equal(e.params, mmAccept.defaultExpectation.params) {
+			mmAccept.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAccept.defaultExpectation.params)
+		}
+	}
+
+	return mmAccept
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Accept
+func (mmAccept *mServiceMockAccept) ExpectCtxParam1(ctx context.Context) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &ServiceMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAccept.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectCmdParam2 sets up expected param cmd for Service.Accept
+func (mmAccept *mServiceMockAccept) ExpectCmdParam2(cmd usecase.AcceptCmd) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &ServiceMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.cmd = &cmd
+	mmAccept.defaultExpectation.expectationOrigins.originCmd = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Accept
+func (mmAccept *mServiceMockAccept) Inspect(f func(ctx context.Context, cmd usecase.AcceptCmd)) *mServiceMockAccept {
+	if mmAccept.mock.inspectFuncAccept != nil {
+		mmAccept.mock.t.Fatalf("Inspect function is already set for ServiceMock.Accept")
+	}
+
+	mmAccept.mock.inspectFuncAccept = f
+
+	return mmAccept
+}
+
+// Return sets up results that will be returned by Service.Accept
+func (mmAccept *mServiceMockAccept) Return(u1 uuid.UUID, err error) *ServiceMock {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{mock: mmAccept.mock}
+	}
+	mmAccept.defaultExpectation.results = &ServiceMockAcceptResults{u1, err}
+	mmAccept.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// Set uses given function f to mock the Service.Accept method
+func (mmAccept *mServiceMockAccept) Set(f func(ctx context.Context, cmd usecase.AcceptCmd) (u1 uuid.UUID, err error)) *ServiceMock {
+	if mmAccept.defaultExpectation != nil {
+		mmAccept.mock.t.Fatalf("Default expectation is already set for the Service.Accept method")
+	}
+
+	if len(mmAccept.expectations) > 0 {
+		mmAccept.mock.t.Fatalf("Some expectations are already set for the Service.Accept method")
+	}
+
+	mmAccept.mock.funcAccept = f
+	mmAccept.mock.funcAcceptOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// When sets expectation for the Service.Accept which will trigger the result defined by the following
+// Then helper
+func (mmAccept *mServiceMockAccept) When(ctx context.Context, cmd usecase.AcceptCmd) *ServiceMockAcceptExpectation {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	expectation := &ServiceMockAcceptExpectation{
+		mock:               mmAccept.mock,
+		params:             &ServiceMockAcceptParams{ctx, cmd},
+		expectationOrigins: ServiceMockAcceptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAccept.expectations = append(mmAccept.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Accept return parameters for the expectation previously defined by the When method
+func (e *ServiceMockAcceptExpectation) Then(u1 uuid.UUID, err error) *ServiceMock {
+	e.results = &ServiceMockAcceptResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Accept should be invoked
+func (mmAccept *mServiceMockAccept) Times(n uint64) *mServiceMockAccept {
+	if n == 0 {
+		mmAccept.mock.t.Fatalf("Times of ServiceMock.Accept mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAccept.expectedInvocations, n)
+	mmAccept.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAccept
+}
+
+func (mmAccept *mServiceMockAccept) invocationsDone() bool {
+	if len(mmAccept.expectations) == 0 && mmAccept.defaultExpectation == nil && mmAccept.mock.funcAccept == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAccept.mock.afterAcceptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAccept.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Accept implements mm_http.Service
+func (mmAccept *ServiceMock) Accept(ctx context.Context, cmd usecase.AcceptCmd) (u1 uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmAccept.beforeAcceptCounter, 1)
+	defer mm_atomic.AddUint64(&mmAccept.afterAcceptCounter, 1)
+
+	mmAccept.t.Helper()
+
+	if mmAccept.inspectFuncAccept != nil {
+		mmAccept.inspectFuncAccept(ctx, cmd)
+	}
+
+	mm_params := ServiceMockAcceptParams{ctx, cmd}
+
+	// Record call args
+	mmAccept.AcceptMock.mutex.Lock()
+	mmAccept.AcceptMock.callArgs = append(mmAccept.AcceptMock.callArgs, &mm_params)
+	mmAccept.AcceptMock.mutex.Unlock()
+
+	for _, e := range mmAccept.AcceptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmAccept.AcceptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAccept.AcceptMock.defaultExpectation.Counter, 1)
+		mm_want := mmAccept.AcceptMock.defaultExpectation.params
+		mm_want_ptrs := mmAccept.AcceptMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockAcceptParams{ctx, cmd}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cmd != nil && !minimock.Equal(*mm_want_ptrs.cmd, mm_got.cmd) {
+				mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameter cmd, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originCmd, *mm_want_ptrs.cmd, mm_got.cmd, minimock.Diff(*mm_want_ptrs.cmd, mm_got.cmd))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAccept.AcceptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAccept.AcceptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAccept.t.Fatal("No results are set for the ServiceMock.Accept")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmAccept.funcAccept != nil {
+		return mmAccept.funcAccept(ctx, cmd)
+	}
+	mmAccept.t.Fatalf("Unexpected call to ServiceMock.Accept. %v %v", ctx, cmd)
+	return
+}
+
+// AcceptAfterCounter returns a count of finished ServiceMock.Accept invocations
+func (mmAccept *ServiceMock) AcceptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.afterAcceptCounter)
+}
+
+// AcceptBeforeCounter returns a count of ServiceMock.Accept invocations
+func (mmAccept *ServiceMock) AcceptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.beforeAcceptCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Accept.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAccept *mServiceMockAccept) Calls() []*ServiceMockAcceptParams {
+	mmAccept.mutex.RLock()
+
+	argCopy := make([]*ServiceMockAcceptParams, len(mmAccept.callArgs))
+	copy(argCopy, mmAccept.callArgs)
+
+	mmAccept.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcceptDone returns true if the count of the Accept invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockAcceptDone() bool {
+	if m.AcceptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcceptMock.invocationsDone()
+}
+
+// MinimockAcceptInspect logs each unmet expectation
+func (m *ServiceMock) MinimockAcceptInspect() {
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAcceptCounter := mm_atomic.LoadUint64(&m.afterAcceptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcceptMock.defaultExpectation != nil && afterAcceptCounter < 1 {
+		if m.AcceptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s", m.AcceptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s with params: %#v", m.AcceptMock.defaultExpectation.expectationOrigins.origin, *m.AcceptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAccept != nil && afterAcceptCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Accept at\n%s", m.funcAcceptOrigin)
+	}
+
+	if !m.AcceptMock.invocationsDone() && afterAcceptCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Accept at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AcceptMock.expectedInvocations), m.AcceptMock.expectedInvocationsOrigin, afterAcceptCounter)
+	}
+}
+
+type mServiceMockCreate struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockCreateExpectation
+	expectations       []*ServiceMockCreateExpectation
+
+	callArgs []*ServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockCreateExpectation specifies expectation struct of the Service.Create
+type ServiceMockCreateExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockCreateParams
+	paramPtrs          *ServiceMockCreateParamPtrs
+	expectationOrigins ServiceMockCreateExpectationOrigins
+	results            *ServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockCreateParams contains parameters of the Service.Create
+type ServiceMockCreateParams struct {
+	ctx context.Context
+	req invitation.CreateReq
+}
+
+// ServiceMockCreateParamPtrs contains pointers to parameters of the Service.Create
+type ServiceMockCreateParamPtrs struct {
+	ctx *context.Context
+	req *invitation.CreateReq
+}
+
+// ServiceMockCreateResults contains results of the Service.Create
+type ServiceMockCreateResults struct {
+	c2  invitation.Created
+	err error
+}
+
+// ServiceMockCreateOrigins contains origins of expectations of the Service.Create
+type ServiceMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mServiceMockCreate) Optional() *mServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for Service.Create
+func (mmCreate *mServiceMockCreate) Expect(ctx context.Context, req invitation.CreateReq) *mServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ServiceMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Create
+func (mmCreate *mServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for Service.Create
+func (mmCreate *mServiceMockCreate) ExpectReqParam2(req invitation.CreateReq) *mServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Create
+func (mmCreate *mServiceMockCreate) Inspect(f func(ctx context.Context, req invitation.CreateReq)) *mServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by Service.Create
+func (mmCreate *mServiceMockCreate) Return(c2 invitation.Created, err error) *ServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ServiceMockCreateResults{c2, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the Service.Create method
+func (mmCreate *mServiceMockCreate) Set(f func(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error)) *ServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the Service.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the Service.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the Service.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mServiceMockCreate) When(ctx context.Context, req invitation.CreateReq) *ServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &ServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ServiceMockCreateParams{ctx, req},
+		expectationOrigins: ServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Create return parameters for the expectation previously defined by the When method
+func (e *ServiceMockCreateExpectation) Then(c2 invitation.Created, err error) *ServiceMock {
+	e.results = &ServiceMockCreateResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Create should be invoked
+func (mmCreate *mServiceMockCreate) Times(n uint64) *mServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_http.Service
+func (mmCreate *ServiceMock) Create(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := ServiceMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("ServiceMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ServiceMock.Create")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ServiceMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ServiceMock.Create invocations
+func (mmCreate *ServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ServiceMock.Create invocations
+func (mmCreate *ServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mServiceMockCreate) Calls() []*ServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mServiceMockList struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockListExpectation
+	expectations       []*ServiceMockListExpectation
+
+	callArgs []*ServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockListExpectation specifies expectation struct of the Service.List
+type ServiceMockListExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockListParams
+	paramPtrs          *ServiceMockListParamPtrs
+	expectationOrigins ServiceMockListExpectationOrigins
+	results            *ServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockListParams contains parameters of the Service.List
+type ServiceMockListParams struct {
+	ctx context.Context
+}
+
+// ServiceMockListParamPtrs contains pointers to parameters of the Service.List
+type ServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockListResults contains results of the Service.List
+type ServiceMockListResults struct {
+	ia1 []invitation.Invitation
+	err error
+}
+
+// ServiceMockListOrigins contains origins of expectations of the Service.List
+type ServiceMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mServiceMockList) Optional() *mServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Service.List
+func (mmList *mServiceMockList) Expect(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ServiceMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.List
+func (mmList *mServiceMockList) ExpectCtxParam1(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.List
+func (mmList *mServiceMockList) Inspect(f func(ctx context.Context)) *mServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Service.List
+func (mmList *mServiceMockList) Return(ia1 []invitation.Invitation, err error) *ServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ServiceMockListResults{ia1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Service.List method
+func (mmList *mServiceMockList) Set(f func(ctx context.Context) (ia1 []invitation.Invitation, err error)) *ServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Service.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Service.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Service.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mServiceMockList) When(ctx context.Context) *ServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &ServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &ServiceMockListParams{ctx},
+		expectationOrigins: ServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.List return parameters for the expectation previously defined by the When method
+func (e *ServiceMockListExpectation) Then(ia1 []invitation.Invitation, err error) *ServiceMock {
+	e.results = &ServiceMockListResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.List should be invoked
+func (mmList *mServiceMockList) Times(n uint64) *mServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_http.Service
+func (mmList *ServiceMock) List(ctx context.Context) (ia1 []invitation.Invitation, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := ServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ServiceMock.List")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to ServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished ServiceMock.List invocations
+func (mmList *ServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ServiceMock.List invocations
+func (mmList *ServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mServiceMockList) Calls() []*ServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mServiceMockRevoke struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockRevokeExpectation
+	expectations       []*ServiceMockRevokeExpectation
+
+	callArgs []*ServiceMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockRevokeExpectation specifies expectation struct of the Service.Revoke
+type ServiceMockRevokeExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockRevokeParams
+	paramPtrs          *ServiceMockRevokeParamPtrs
+	expectationOrigins ServiceMockRevokeExpectationOrigins
+	results            *ServiceMockRevokeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockRevokeParams contains parameters of the Service.Revoke
+type ServiceMockRevokeParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// ServiceMockRevokeParamPtrs contains pointers to parameters of the Service.Revoke
+type ServiceMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// ServiceMockRevokeResults contains results of the Service.Revoke
+type ServiceMockRevokeResults struct {
+	err error
+}
+
+// ServiceMockRevokeOrigins contains origins of expectations of the Service.Revoke
+type ServiceMockRevokeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mServiceMockRevoke) Optional() *mServiceMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for Service.Revoke
+func (mmRevoke *mServiceMockRevoke) Expect(ctx context.Context, id uuid.UUID) *mServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &ServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &ServiceMockRevokeParams{ctx, id}
+	mmRevoke.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Revoke
+func (mmRevoke *mServiceMockRevoke) ExpectCtxParam1(ctx context.Context) *mServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &ServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &ServiceMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevoke.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for Service.Revoke
+func (mmRevoke *mServiceMockRevoke) ExpectIdParam2(id uuid.UUID) *mServiceMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &ServiceMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &ServiceMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+	mmRevoke.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Revoke
+func (mmRevoke *mServiceMockRevoke) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for ServiceMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by Service.Revoke
+func (mmRevoke *mServiceMockRevoke) Return(err error) *ServiceMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &ServiceMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &ServiceMockRevokeResults{err}
+	mmRevoke.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the Service.Revoke method
+func (mmRevoke *mServiceMockRevoke) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *ServiceMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the Service.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the Service.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	mmRevoke.mock.funcRevokeOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// When sets expectation for the Service.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mServiceMockRevoke) When(ctx context.Context, id uuid.UUID) *ServiceMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("ServiceMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &ServiceMockRevokeExpectation{
+		mock:               mmRevoke.mock,
+		params:             &ServiceMockRevokeParams{ctx, id},
+		expectationOrigins: ServiceMockRevokeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Revoke return parameters for the expectation previously defined by the When method
+func (e *ServiceMockRevokeExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.Revoke should be invoked
+func (mmRevoke *mServiceMockRevoke) Times(n uint64) *mServiceMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of ServiceMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	mmRevoke.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevoke
+}
+
+func (mmRevoke *mServiceMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements mm_http.Service
+func (mmRevoke *ServiceMock) Revoke(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	mmRevoke.t.Helper()
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := ServiceMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("ServiceMock.Revoke got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("ServiceMock.Revoke got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("ServiceMock.Revoke got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the ServiceMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to ServiceMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished ServiceMock.Revoke invocations
+func (mmRevoke *ServiceMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of ServiceMock.Revoke invocations
+func (mmRevoke *ServiceMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mServiceMockRevoke) Calls() []*ServiceMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*ServiceMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *ServiceMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Revoke at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Revoke at\n%s", m.RevokeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Revoke at\n%s with params: %#v", m.RevokeMock.defaultExpectation.expectationOrigins.origin, *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Revoke at\n%s", m.funcRevokeOrigin)
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Revoke at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), m.RevokeMock.expectedInvocationsOrigin, afterRevokeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcceptInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockRevokeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcceptDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockListDone() &&
+		m.MinimockRevokeDone()
+}
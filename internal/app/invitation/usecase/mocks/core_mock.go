@@ -0,0 +1,2167 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaim          func(ctx context.Context, token string) (i1 invitation.Invitation, err error)
+	funcClaimOrigin    string
+	inspectFuncClaim   func(ctx context.Context, token string)
+	afterClaimCounter  uint64
+	beforeClaimCounter uint64
+	ClaimMock          mCoreMockClaim
+
+	funcCreate          func(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, req invitation.CreateReq)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mCoreMockCreate
+
+	funcEmail          func(created invitation.Created) (m1 mail.Message, b1 bool)
+	funcEmailOrigin    string
+	inspectFuncEmail   func(created invitation.Created)
+	afterEmailCounter  uint64
+	beforeEmailCounter uint64
+	EmailMock          mCoreMockEmail
+
+	funcList          func(ctx context.Context) (ia1 []invitation.Invitation, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mCoreMockList
+
+	funcMarkAccepted          func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)
+	funcMarkAcceptedOrigin    string
+	inspectFuncMarkAccepted   func(ctx context.Context, id uuid.UUID, userID uuid.UUID)
+	afterMarkAcceptedCounter  uint64
+	beforeMarkAcceptedCounter uint64
+	MarkAcceptedMock          mCoreMockMarkAccepted
+
+	funcRevoke          func(ctx context.Context, id uuid.UUID) (err error)
+	funcRevokeOrigin    string
+	inspectFuncRevoke   func(ctx context.Context, id uuid.UUID)
+	afterRevokeCounter  uint64
+	beforeRevokeCounter uint64
+	RevokeMock          mCoreMockRevoke
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimMock = mCoreMockClaim{mock: m}
+	m.ClaimMock.callArgs = []*CoreMockClaimParams{}
+
+	m.CreateMock = mCoreMockCreate{mock: m}
+	m.CreateMock.callArgs = []*CoreMockCreateParams{}
+
+	m.EmailMock = mCoreMockEmail{mock: m}
+	m.EmailMock.callArgs = []*CoreMockEmailParams{}
+
+	m.ListMock = mCoreMockList{mock: m}
+	m.ListMock.callArgs = []*CoreMockListParams{}
+
+	m.MarkAcceptedMock = mCoreMockMarkAccepted{mock: m}
+	m.MarkAcceptedMock.callArgs = []*CoreMockMarkAcceptedParams{}
+
+	m.RevokeMock = mCoreMockRevoke{mock: m}
+	m.RevokeMock.callArgs = []*CoreMockRevokeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockClaim struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockClaimExpectation
+	expectations       []*CoreMockClaimExpectation
+
+	callArgs []*CoreMockClaimParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockClaimExpectation specifies expectation struct of the Core.Claim
+type CoreMockClaimExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockClaimParams
+	paramPtrs          *CoreMockClaimParamPtrs
+	expectationOrigins CoreMockClaimExpectationOrigins
+	results            *CoreMockClaimResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockClaimParams contains parameters of the Core.Claim
+type CoreMockClaimParams struct {
+	ctx   context.Context
+	token string
+}
+
+// CoreMockClaimParamPtrs contains pointers to parameters of the Core.Claim
+type CoreMockClaimParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// CoreMockClaimResults contains results of the Core.Claim
+type CoreMockClaimResults struct {
+	i1  invitation.Invitation
+	err error
+}
+
+// CoreMockClaimOrigins contains origins of expectations of the Core.Claim
+type CoreMockClaimExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaim *mCoreMockClaim) Optional() *mCoreMockClaim {
+	mmClaim.optional = true
+	return mmClaim
+}
+
+// Expect sets up expected params for Core.Claim
+func (mmClaim *mCoreMockClaim) Expect(ctx context.Context, token string) *mCoreMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by ExpectParams functions")
+	}
+
+	mmClaim.defaultExpectation.params = &CoreMockClaimParams{ctx, token}
+	mmClaim.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaim.expectations {
+		if minimock.Equal(e.params, mmClaim.defaultExpectation.params) {
+			mmClaim.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaim.defaultExpectation.params)
+		}
+	}
+
+	return mmClaim
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Claim
+func (mmClaim *mCoreMockClaim) ExpectCtxParam1(ctx context.Context) *mCoreMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &CoreMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaim.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// ExpectTokenParam2 sets up expected param token for Core.Claim
+func (mmClaim *mCoreMockClaim) ExpectTokenParam2(token string) *mCoreMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &CoreMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.token = &token
+	mmClaim.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Claim
+func (mmClaim *mCoreMockClaim) Inspect(f func(ctx context.Context, token string)) *mCoreMockClaim {
+	if mmClaim.mock.inspectFuncClaim != nil {
+		mmClaim.mock.t.Fatalf("Inspect function is already set for CoreMock.Claim")
+	}
+
+	mmClaim.mock.inspectFuncClaim = f
+
+	return mmClaim
+}
+
+// Return sets up results that will be returned by Core.Claim
+func (mmClaim *mCoreMockClaim) Return(i1 invitation.Invitation, err error) *CoreMock {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &CoreMockClaimExpectation{mock: mmClaim.mock}
+	}
+	mmClaim.defaultExpectation.results = &CoreMockClaimResults{i1, err}
+	mmClaim.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// Set uses given function f to mock the Core.Claim method
+func (mmClaim *mCoreMockClaim) Set(f func(ctx context.Context, token string) (i1 invitation.Invitation, err error)) *CoreMock {
+	if mmClaim.defaultExpectation != nil {
+		mmClaim.mock.t.Fatalf("Default expectation is already set for the Core.Claim method")
+	}
+
+	if len(mmClaim.expectations) > 0 {
+		mmClaim.mock.t.Fatalf("Some expectations are already set for the Core.Claim method")
+	}
+
+	mmClaim.mock.funcClaim = f
+	mmClaim.mock.funcClaimOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// When sets expectation for the Core.Claim which will trigger the result defined by the following
+// Then helper
+func (mmClaim *mCoreMockClaim) When(ctx context.Context, token string) *CoreMockClaimExpectation {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("CoreMock.Claim mock is already set by Set")
+	}
+
+	expectation := &CoreMockClaimExpectation{
+		mock:               mmClaim.mock,
+		params:             &CoreMockClaimParams{ctx, token},
+		expectationOrigins: CoreMockClaimExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaim.expectations = append(mmClaim.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Claim return parameters for the expectation previously defined by the When method
+func (e *CoreMockClaimExpectation) Then(i1 invitation.Invitation, err error) *CoreMock {
+	e.results = &CoreMockClaimResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Claim should be invoked
+func (mmClaim *mCoreMockClaim) Times(n uint64) *mCoreMockClaim {
+	if n == 0 {
+		mmClaim.mock.t.Fatalf("Times of CoreMock.Claim mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaim.expectedInvocations, n)
+	mmClaim.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaim
+}
+
+func (mmClaim *mCoreMockClaim) invocationsDone() bool {
+	if len(mmClaim.expectations) == 0 && mmClaim.defaultExpectation == nil && mmClaim.mock.funcClaim == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaim.mock.afterClaimCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaim.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Claim implements mm_usecase.Core
+func (mmClaim *CoreMock) Claim(ctx context.Context, token string) (i1 invitation.Invitation, err error) {
+	mm_atomic.AddUint64(&mmClaim.beforeClaimCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaim.afterClaimCounter, 1)
+
+	mmClaim.t.Helper()
+
+	if mmClaim.inspectFuncClaim != nil {
+		mmClaim.inspectFuncClaim(ctx, token)
+	}
+
+	mm_params := CoreMockClaimParams{ctx, token}
+
+	// Record call args
+	mmClaim.ClaimMock.mutex.Lock()
+	mmClaim.ClaimMock.callArgs = append(mmClaim.ClaimMock.callArgs, &mm_params)
+	mmClaim.ClaimMock.mutex.Unlock()
+
+	for _, e := range mmClaim.ClaimMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmClaim.ClaimMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaim.ClaimMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaim.ClaimMock.defaultExpectation.params
+		mm_want_ptrs := mmClaim.ClaimMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockClaimParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaim.t.Errorf("CoreMock.Claim got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmClaim.t.Errorf("CoreMock.Claim got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaim.t.Errorf("CoreMock.Claim got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaim.ClaimMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaim.ClaimMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaim.t.Fatal("No results are set for the CoreMock.Claim")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmClaim.funcClaim != nil {
+		return mmClaim.funcClaim(ctx, token)
+	}
+	mmClaim.t.Fatalf("Unexpected call to CoreMock.Claim. %v %v", ctx, token)
+	return
+}
+
+// ClaimAfterCounter returns a count of finished CoreMock.Claim invocations
+func (mmClaim *CoreMock) ClaimAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.afterClaimCounter)
+}
+
+// ClaimBeforeCounter returns a count of CoreMock.Claim invocations
+func (mmClaim *CoreMock) ClaimBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.beforeClaimCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Claim.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaim *mCoreMockClaim) Calls() []*CoreMockClaimParams {
+	mmClaim.mutex.RLock()
+
+	argCopy := make([]*CoreMockClaimParams, len(mmClaim.callArgs))
+	copy(argCopy, mmClaim.callArgs)
+
+	mmClaim.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDone returns true if the count of the Claim invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockClaimDone() bool {
+	if m.ClaimMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimMock.invocationsDone()
+}
+
+// MinimockClaimInspect logs each unmet expectation
+func (m *CoreMock) MinimockClaimInspect() {
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimCounter := mm_atomic.LoadUint64(&m.afterClaimCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimMock.defaultExpectation != nil && afterClaimCounter < 1 {
+		if m.ClaimMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s", m.ClaimMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Claim at\n%s with params: %#v", m.ClaimMock.defaultExpectation.expectationOrigins.origin, *m.ClaimMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaim != nil && afterClaimCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Claim at\n%s", m.funcClaimOrigin)
+	}
+
+	if !m.ClaimMock.invocationsDone() && afterClaimCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Claim at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimMock.expectedInvocations), m.ClaimMock.expectedInvocationsOrigin, afterClaimCounter)
+	}
+}
+
+type mCoreMockCreate struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockCreateExpectation
+	expectations       []*CoreMockCreateExpectation
+
+	callArgs []*CoreMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockCreateExpectation specifies expectation struct of the Core.Create
+type CoreMockCreateExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockCreateParams
+	paramPtrs          *CoreMockCreateParamPtrs
+	expectationOrigins CoreMockCreateExpectationOrigins
+	results            *CoreMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockCreateParams contains parameters of the Core.Create
+type CoreMockCreateParams struct {
+	ctx context.Context
+	req invitation.CreateReq
+}
+
+// CoreMockCreateParamPtrs contains pointers to parameters of the Core.Create
+type CoreMockCreateParamPtrs struct {
+	ctx *context.Context
+	req *invitation.CreateReq
+}
+
+// CoreMockCreateResults contains results of the Core.Create
+type CoreMockCreateResults struct {
+	c2  invitation.Created
+	err error
+}
+
+// CoreMockCreateOrigins contains origins of expectations of the Core.Create
+type CoreMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mCoreMockCreate) Optional() *mCoreMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for Core.Create
+func (mmCreate *mCoreMockCreate) Expect(ctx context.Context, req invitation.CreateReq) *mCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &CoreMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Create
+func (mmCreate *mCoreMockCreate) ExpectCtxParam1(ctx context.Context) *mCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for Core.Create
+func (mmCreate *mCoreMockCreate) ExpectReqParam2(req invitation.CreateReq) *mCoreMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CoreMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &CoreMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Create
+func (mmCreate *mCoreMockCreate) Inspect(f func(ctx context.Context, req invitation.CreateReq)) *mCoreMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for CoreMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by Core.Create
+func (mmCreate *mCoreMockCreate) Return(c2 invitation.Created, err error) *CoreMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &CoreMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &CoreMockCreateResults{c2, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the Core.Create method
+func (mmCreate *mCoreMockCreate) Set(f func(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error)) *CoreMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the Core.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the Core.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the Core.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mCoreMockCreate) When(ctx context.Context, req invitation.CreateReq) *CoreMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("CoreMock.Create mock is already set by Set")
+	}
+
+	expectation := &CoreMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &CoreMockCreateParams{ctx, req},
+		expectationOrigins: CoreMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Create return parameters for the expectation previously defined by the When method
+func (e *CoreMockCreateExpectation) Then(c2 invitation.Created, err error) *CoreMock {
+	e.results = &CoreMockCreateResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times Core.Create should be invoked
+func (mmCreate *mCoreMockCreate) Times(n uint64) *mCoreMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of CoreMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mCoreMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_usecase.Core
+func (mmCreate *CoreMock) Create(ctx context.Context, req invitation.CreateReq) (c2 invitation.Created, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := CoreMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("CoreMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("CoreMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("CoreMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the CoreMock.Create")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to CoreMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished CoreMock.Create invocations
+func (mmCreate *CoreMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of CoreMock.Create invocations
+func (mmCreate *CoreMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mCoreMockCreate) Calls() []*CoreMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*CoreMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *CoreMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mCoreMockEmail struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockEmailExpectation
+	expectations       []*CoreMockEmailExpectation
+
+	callArgs []*CoreMockEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockEmailExpectation specifies expectation struct of the Core.Email
+type CoreMockEmailExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockEmailParams
+	paramPtrs          *CoreMockEmailParamPtrs
+	expectationOrigins CoreMockEmailExpectationOrigins
+	results            *CoreMockEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockEmailParams contains parameters of the Core.Email
+type CoreMockEmailParams struct {
+	created invitation.Created
+}
+
+// CoreMockEmailParamPtrs contains pointers to parameters of the Core.Email
+type CoreMockEmailParamPtrs struct {
+	created *invitation.Created
+}
+
+// CoreMockEmailResults contains results of the Core.Email
+type CoreMockEmailResults struct {
+	m1 mail.Message
+	b1 bool
+}
+
+// CoreMockEmailOrigins contains origins of expectations of the Core.Email
+type CoreMockEmailExpectationOrigins struct {
+	origin        string
+	originCreated string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEmail *mCoreMockEmail) Optional() *mCoreMockEmail {
+	mmEmail.optional = true
+	return mmEmail
+}
+
+// Expect sets up expected params for Core.Email
+func (mmEmail *mCoreMockEmail) Expect(created invitation.Created) *mCoreMockEmail {
+	if mmEmail.mock.funcEmail != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by Set")
+	}
+
+	if mmEmail.defaultExpectation == nil {
+		mmEmail.defaultExpectation = &CoreMockEmailExpectation{}
+	}
+
+	if mmEmail.defaultExpectation.paramPtrs != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by ExpectParams functions")
+	}
+
+	mmEmail.defaultExpectation.params = &CoreMockEmailParams{created}
+	mmEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEmail.expectations {
+		if minimock.Equal(e.params, mmEmail.defaultExpectation.params) {
+			mmEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmEmail
+}
+
+// ExpectCreatedParam1 sets up expected param created for Core.Email
+func (mmEmail *mCoreMockEmail) ExpectCreatedParam1(created invitation.Created) *mCoreMockEmail {
+	if mmEmail.mock.funcEmail != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by Set")
+	}
+
+	if mmEmail.defaultExpectation == nil {
+		mmEmail.defaultExpectation = &CoreMockEmailExpectation{}
+	}
+
+	if mmEmail.defaultExpectation.params != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by Expect")
+	}
+
+	if mmEmail.defaultExpectation.paramPtrs == nil {
+		mmEmail.defaultExpectation.paramPtrs = &CoreMockEmailParamPtrs{}
+	}
+	mmEmail.defaultExpectation.paramPtrs.created = &created
+	mmEmail.defaultExpectation.expectationOrigins.originCreated = minimock.CallerInfo(1)
+
+	return mmEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Email
+func (mmEmail *mCoreMockEmail) Inspect(f func(created invitation.Created)) *mCoreMockEmail {
+	if mmEmail.mock.inspectFuncEmail != nil {
+		mmEmail.mock.t.Fatalf("Inspect function is already set for CoreMock.Email")
+	}
+
+	mmEmail.mock.inspectFuncEmail = f
+
+	return mmEmail
+}
+
+// Return sets up results that will be returned by Core.Email
+func (mmEmail *mCoreMockEmail) Return(m1 mail.Message, b1 bool) *CoreMock {
+	if mmEmail.mock.funcEmail != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by Set")
+	}
+
+	if mmEmail.defaultExpectation == nil {
+		mmEmail.defaultExpectation = &CoreMockEmailExpectation{mock: mmEmail.mock}
+	}
+	mmEmail.defaultExpectation.results = &CoreMockEmailResults{m1, b1}
+	mmEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEmail.mock
+}
+
+// Set uses given function f to mock the Core.Email method
+func (mmEmail *mCoreMockEmail) Set(f func(created invitation.Created) (m1 mail.Message, b1 bool)) *CoreMock {
+	if mmEmail.defaultExpectation != nil {
+		mmEmail.mock.t.Fatalf("Default expectation is already set for the Core.Email method")
+	}
+
+	if len(mmEmail.expectations) > 0 {
+		mmEmail.mock.t.Fatalf("Some expectations are already set for the Core.Email method")
+	}
+
+	mmEmail.mock.funcEmail = f
+	mmEmail.mock.funcEmailOrigin = minimock.CallerInfo(1)
+	return mmEmail.mock
+}
+
+// When sets expectation for the Core.Email which will trigger the result defined by the following
+// Then helper
+func (mmEmail *mCoreMockEmail) When(created invitation.Created) *CoreMockEmailExpectation {
+	if mmEmail.mock.funcEmail != nil {
+		mmEmail.mock.t.Fatalf("CoreMock.Email mock is already set by Set")
+	}
+
+	expectation := &CoreMockEmailExpectation{
+		mock:               mmEmail.mock,
+		params:             &CoreMockEmailParams{created},
+		expectationOrigins: CoreMockEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEmail.expectations = append(mmEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Email return parameters for the expectation previously defined by the When method
+func (e *CoreMockEmailExpectation) Then(m1 mail.Message, b1 bool) *CoreMock {
+	e.results = &CoreMockEmailResults{m1, b1}
+	return e.mock
+}
+
+// Times sets number of times Core.Email should be invoked
+func (mmEmail *mCoreMockEmail) Times(n uint64) *mCoreMockEmail {
+	if n == 0 {
+		mmEmail.mock.t.Fatalf("Times of CoreMock.Email mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEmail.expectedInvocations, n)
+	mmEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEmail
+}
+
+func (mmEmail *mCoreMockEmail) invocationsDone() bool {
+	if len(mmEmail.expectations) == 0 && mmEmail.defaultExpectation == nil && mmEmail.mock.funcEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEmail.mock.afterEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Email implements mm_usecase.Core
+func (mmEmail *CoreMock) Email(created invitation.Created) (m1 mail.Message, b1 bool) {
+	mm_atomic.AddUint64(&mmEmail.beforeEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmEmail.afterEmailCounter, 1)
+
+	mmEmail.t.Helper()
+
+	if mmEmail.inspectFuncEmail != nil {
+		mmEmail.inspectFuncEmail(created)
+	}
+
+	mm_params := CoreMockEmailParams{created}
+
+	// Record call args
+	mmEmail.EmailMock.mutex.Lock()
+	mmEmail.EmailMock.callArgs = append(mmEmail.EmailMock.callArgs, &mm_params)
+	mmEmail.EmailMock.mutex.Unlock()
+
+	for _, e := range mmEmail.EmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.b1
+		}
+	}
+
+	if mmEmail.EmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEmail.EmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmEmail.EmailMock.defaultExpectation.params
+		mm_want_ptrs := mmEmail.EmailMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockEmailParams{created}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.created != nil && !minimock.Equal(*mm_want_ptrs.created, mm_got.created) {
+				mmEmail.t.Errorf("CoreMock.Email got unexpected parameter created, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEmail.EmailMock.defaultExpectation.expectationOrigins.originCreated, *mm_want_ptrs.created, mm_got.created, minimock.Diff(*mm_want_ptrs.created, mm_got.created))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEmail.t.Errorf("CoreMock.Email got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEmail.EmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEmail.EmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEmail.t.Fatal("No results are set for the CoreMock.Email")
+		}
+		return (*mm_results).m1, (*mm_results).b1
+	}
+	if mmEmail.funcEmail != nil {
+		return mmEmail.funcEmail(created)
+	}
+	mmEmail.t.Fatalf("Unexpected call to CoreMock.Email. %v", created)
+	return
+}
+
+// EmailAfterCounter returns a count of finished CoreMock.Email invocations
+func (mmEmail *CoreMock) EmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEmail.afterEmailCounter)
+}
+
+// EmailBeforeCounter returns a count of CoreMock.Email invocations
+func (mmEmail *CoreMock) EmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEmail.beforeEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Email.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEmail *mCoreMockEmail) Calls() []*CoreMockEmailParams {
+	mmEmail.mutex.RLock()
+
+	argCopy := make([]*CoreMockEmailParams, len(mmEmail.callArgs))
+	copy(argCopy, mmEmail.callArgs)
+
+	mmEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEmailDone returns true if the count of the Email invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockEmailDone() bool {
+	if m.EmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EmailMock.invocationsDone()
+}
+
+// MinimockEmailInspect logs each unmet expectation
+func (m *CoreMock) MinimockEmailInspect() {
+	for _, e := range m.EmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Email at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEmailCounter := mm_atomic.LoadUint64(&m.afterEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EmailMock.defaultExpectation != nil && afterEmailCounter < 1 {
+		if m.EmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Email at\n%s", m.EmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Email at\n%s with params: %#v", m.EmailMock.defaultExpectation.expectationOrigins.origin, *m.EmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEmail != nil && afterEmailCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Email at\n%s", m.funcEmailOrigin)
+	}
+
+	if !m.EmailMock.invocationsDone() && afterEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Email at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EmailMock.expectedInvocations), m.EmailMock.expectedInvocationsOrigin, afterEmailCounter)
+	}
+}
+
+type mCoreMockList struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockListExpectation
+	expectations       []*CoreMockListExpectation
+
+	callArgs []*CoreMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockListExpectation specifies expectation struct of the Core.List
+type CoreMockListExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockListParams
+	paramPtrs          *CoreMockListParamPtrs
+	expectationOrigins CoreMockListExpectationOrigins
+	results            *CoreMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockListParams contains parameters of the Core.List
+type CoreMockListParams struct {
+	ctx context.Context
+}
+
+// CoreMockListParamPtrs contains pointers to parameters of the Core.List
+type CoreMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockListResults contains results of the Core.List
+type CoreMockListResults struct {
+	ia1 []invitation.Invitation
+	err error
+}
+
+// CoreMockListOrigins contains origins of expectations of the Core.List
+type CoreMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mCoreMockList) Optional() *mCoreMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Core.List
+func (mmList *mCoreMockList) Expect(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &CoreMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.List
+func (mmList *mCoreMockList) ExpectCtxParam1(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &CoreMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.List
+func (mmList *mCoreMockList) Inspect(f func(ctx context.Context)) *mCoreMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for CoreMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Core.List
+func (mmList *mCoreMockList) Return(ia1 []invitation.Invitation, err error) *CoreMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &CoreMockListResults{ia1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Core.List method
+func (mmList *mCoreMockList) Set(f func(ctx context.Context) (ia1 []invitation.Invitation, err error)) *CoreMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Core.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Core.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Core.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mCoreMockList) When(ctx context.Context) *CoreMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	expectation := &CoreMockListExpectation{
+		mock:               mmList.mock,
+		params:             &CoreMockListParams{ctx},
+		expectationOrigins: CoreMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.List return parameters for the expectation previously defined by the When method
+func (e *CoreMockListExpectation) Then(ia1 []invitation.Invitation, err error) *CoreMock {
+	e.results = &CoreMockListResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.List should be invoked
+func (mmList *mCoreMockList) Times(n uint64) *mCoreMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of CoreMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mCoreMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_usecase.Core
+func (mmList *CoreMock) List(ctx context.Context) (ia1 []invitation.Invitation, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := CoreMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("CoreMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("CoreMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the CoreMock.List")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to CoreMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished CoreMock.List invocations
+func (mmList *CoreMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of CoreMock.List invocations
+func (mmList *CoreMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mCoreMockList) Calls() []*CoreMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*CoreMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *CoreMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mCoreMockMarkAccepted struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockMarkAcceptedExpectation
+	expectations       []*CoreMockMarkAcceptedExpectation
+
+	callArgs []*CoreMockMarkAcceptedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockMarkAcceptedExpectation specifies expectation struct of the Core.MarkAccepted
+type CoreMockMarkAcceptedExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockMarkAcceptedParams
+	paramPtrs          *CoreMockMarkAcceptedParamPtrs
+	expectationOrigins CoreMockMarkAcceptedExpectationOrigins
+	results            *CoreMockMarkAcceptedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockMarkAcceptedParams contains parameters of the Core.MarkAccepted
+type CoreMockMarkAcceptedParams struct {
+	ctx    context.Context
+	id     uuid.UUID
+	userID uuid.UUID
+}
+
+// CoreMockMarkAcceptedParamPtrs contains pointers to parameters of the Core.MarkAccepted
+type CoreMockMarkAcceptedParamPtrs struct {
+	ctx    *context.Context
+	id     *uuid.UUID
+	userID *uuid.UUID
+}
+
+// CoreMockMarkAcceptedResults contains results of the Core.MarkAccepted
+type CoreMockMarkAcceptedResults struct {
+	err error
+}
+
+// CoreMockMarkAcceptedOrigins contains origins of expectations of the Core.MarkAccepted
+type CoreMockMarkAcceptedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkAccepted *mCoreMockMarkAccepted) Optional() *mCoreMockMarkAccepted {
+	mmMarkAccepted.optional = true
+	return mmMarkAccepted
+}
+
+// Expect sets up expected params for Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) Expect(ctx context.Context, id uuid.UUID, userID uuid.UUID) *mCoreMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &CoreMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by ExpectParams functions")
+	}
+
+	mmMarkAccepted.defaultExpectation.params = &CoreMockMarkAcceptedParams{ctx, id, userID}
+	mmMarkAccepted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkAccepted.expectations {
+		if minimock.Equal(e.params, mmMarkAccepted.defaultExpectation.params) {
+			mmMarkAccepted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkAccepted.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkAccepted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) ExpectCtxParam1(ctx context.Context) *mCoreMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &CoreMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &CoreMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// ExpectIdParam2 sets up expected param id for Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) ExpectIdParam2(id uuid.UUID) *mCoreMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &CoreMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &CoreMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.id = &id
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// ExpectUserIDParam3 sets up expected param userID for Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) ExpectUserIDParam3(userID uuid.UUID) *mCoreMockMarkAccepted {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &CoreMockMarkAcceptedExpectation{}
+	}
+
+	if mmMarkAccepted.defaultExpectation.params != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Expect")
+	}
+
+	if mmMarkAccepted.defaultExpectation.paramPtrs == nil {
+		mmMarkAccepted.defaultExpectation.paramPtrs = &CoreMockMarkAcceptedParamPtrs{}
+	}
+	mmMarkAccepted.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkAccepted.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkAccepted
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) Inspect(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *mCoreMockMarkAccepted {
+	if mmMarkAccepted.mock.inspectFuncMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("Inspect function is already set for CoreMock.MarkAccepted")
+	}
+
+	mmMarkAccepted.mock.inspectFuncMarkAccepted = f
+
+	return mmMarkAccepted
+}
+
+// Return sets up results that will be returned by Core.MarkAccepted
+func (mmMarkAccepted *mCoreMockMarkAccepted) Return(err error) *CoreMock {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	if mmMarkAccepted.defaultExpectation == nil {
+		mmMarkAccepted.defaultExpectation = &CoreMockMarkAcceptedExpectation{mock: mmMarkAccepted.mock}
+	}
+	mmMarkAccepted.defaultExpectation.results = &CoreMockMarkAcceptedResults{err}
+	mmMarkAccepted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted.mock
+}
+
+// Set uses given function f to mock the Core.MarkAccepted method
+func (mmMarkAccepted *mCoreMockMarkAccepted) Set(f func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error)) *CoreMock {
+	if mmMarkAccepted.defaultExpectation != nil {
+		mmMarkAccepted.mock.t.Fatalf("Default expectation is already set for the Core.MarkAccepted method")
+	}
+
+	if len(mmMarkAccepted.expectations) > 0 {
+		mmMarkAccepted.mock.t.Fatalf("Some expectations are already set for the Core.MarkAccepted method")
+	}
+
+	mmMarkAccepted.mock.funcMarkAccepted = f
+	mmMarkAccepted.mock.funcMarkAcceptedOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted.mock
+}
+
+// When sets expectation for the Core.MarkAccepted which will trigger the result defined by the following
+// Then helper
+func (mmMarkAccepted *mCoreMockMarkAccepted) When(ctx context.Context, id uuid.UUID, userID uuid.UUID) *CoreMockMarkAcceptedExpectation {
+	if mmMarkAccepted.mock.funcMarkAccepted != nil {
+		mmMarkAccepted.mock.t.Fatalf("CoreMock.MarkAccepted mock is already set by Set")
+	}
+
+	expectation := &CoreMockMarkAcceptedExpectation{
+		mock:               mmMarkAccepted.mock,
+		params:             &CoreMockMarkAcceptedParams{ctx, id, userID},
+		expectationOrigins: CoreMockMarkAcceptedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkAccepted.expectations = append(mmMarkAccepted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.MarkAccepted return parameters for the expectation previously defined by the When method
+func (e *CoreMockMarkAcceptedExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockMarkAcceptedResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.MarkAccepted should be invoked
+func (mmMarkAccepted *mCoreMockMarkAccepted) Times(n uint64) *mCoreMockMarkAccepted {
+	if n == 0 {
+		mmMarkAccepted.mock.t.Fatalf("Times of CoreMock.MarkAccepted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkAccepted.expectedInvocations, n)
+	mmMarkAccepted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkAccepted
+}
+
+func (mmMarkAccepted *mCoreMockMarkAccepted) invocationsDone() bool {
+	if len(mmMarkAccepted.expectations) == 0 && mmMarkAccepted.defaultExpectation == nil && mmMarkAccepted.mock.funcMarkAccepted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkAccepted.mock.afterMarkAcceptedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkAccepted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkAccepted implements mm_usecase.Core
+func (mmMarkAccepted *CoreMock) MarkAccepted(ctx context.Context, id uuid.UUID, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmMarkAccepted.beforeMarkAcceptedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkAccepted.afterMarkAcceptedCounter, 1)
+
+	mmMarkAccepted.t.Helper()
+
+	if mmMarkAccepted.inspectFuncMarkAccepted != nil {
+		mmMarkAccepted.inspectFuncMarkAccepted(ctx, id, userID)
+	}
+
+	mm_params := CoreMockMarkAcceptedParams{ctx, id, userID}
+
+	// Record call args
+	mmMarkAccepted.MarkAcceptedMock.mutex.Lock()
+	mmMarkAccepted.MarkAcceptedMock.callArgs = append(mmMarkAccepted.MarkAcceptedMock.callArgs, &mm_params)
+	mmMarkAccepted.MarkAcceptedMock.mutex.Unlock()
+
+	for _, e := range mmMarkAccepted.MarkAcceptedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkAccepted.MarkAcceptedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkAccepted.MarkAcceptedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockMarkAcceptedParams{ctx, id, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkAccepted.t.Errorf("CoreMock.MarkAccepted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkAccepted.t.Errorf("CoreMock.MarkAccepted got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkAccepted.t.Errorf("CoreMock.MarkAccepted got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkAccepted.t.Errorf("CoreMock.MarkAccepted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkAccepted.MarkAcceptedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkAccepted.MarkAcceptedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkAccepted.t.Fatal("No results are set for the CoreMock.MarkAccepted")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkAccepted.funcMarkAccepted != nil {
+		return mmMarkAccepted.funcMarkAccepted(ctx, id, userID)
+	}
+	mmMarkAccepted.t.Fatalf("Unexpected call to CoreMock.MarkAccepted. %v %v %v", ctx, id, userID)
+	return
+}
+
+// MarkAcceptedAfterCounter returns a count of finished CoreMock.MarkAccepted invocations
+func (mmMarkAccepted *CoreMock) MarkAcceptedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAccepted.afterMarkAcceptedCounter)
+}
+
+// MarkAcceptedBeforeCounter returns a count of CoreMock.MarkAccepted invocations
+func (mmMarkAccepted *CoreMock) MarkAcceptedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAccepted.beforeMarkAcceptedCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.MarkAccepted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkAccepted *mCoreMockMarkAccepted) Calls() []*CoreMockMarkAcceptedParams {
+	mmMarkAccepted.mutex.RLock()
+
+	argCopy := make([]*CoreMockMarkAcceptedParams, len(mmMarkAccepted.callArgs))
+	copy(argCopy, mmMarkAccepted.callArgs)
+
+	mmMarkAccepted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkAcceptedDone returns true if the count of the MarkAccepted invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockMarkAcceptedDone() bool {
+	if m.MarkAcceptedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkAcceptedMock.invocationsDone()
+}
+
+// MinimockMarkAcceptedInspect logs each unmet expectation
+func (m *CoreMock) MinimockMarkAcceptedInspect() {
+	for _, e := range m.MarkAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.MarkAccepted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkAcceptedCounter := mm_atomic.LoadUint64(&m.afterMarkAcceptedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkAcceptedMock.defaultExpectation != nil && afterMarkAcceptedCounter < 1 {
+		if m.MarkAcceptedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.MarkAccepted at\n%s", m.MarkAcceptedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.MarkAccepted at\n%s with params: %#v", m.MarkAcceptedMock.defaultExpectation.expectationOrigins.origin, *m.MarkAcceptedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkAccepted != nil && afterMarkAcceptedCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.MarkAccepted at\n%s", m.funcMarkAcceptedOrigin)
+	}
+
+	if !m.MarkAcceptedMock.invocationsDone() && afterMarkAcceptedCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.MarkAccepted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkAcceptedMock.expectedInvocations), m.MarkAcceptedMock.expectedInvocationsOrigin, afterMarkAcceptedCounter)
+	}
+}
+
+type mCoreMockRevoke struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockRevokeExpectation
+	expectations       []*CoreMockRevokeExpectation
+
+	callArgs []*CoreMockRevokeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockRevokeExpectation specifies expectation struct of the Core.Revoke
+type CoreMockRevokeExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockRevokeParams
+	paramPtrs          *CoreMockRevokeParamPtrs
+	expectationOrigins CoreMockRevokeExpectationOrigins
+	results            *CoreMockRevokeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockRevokeParams contains parameters of the Core.Revoke
+type CoreMockRevokeParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockRevokeParamPtrs contains pointers to parameters of the Core.Revoke
+type CoreMockRevokeParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockRevokeResults contains results of the Core.Revoke
+type CoreMockRevokeResults struct {
+	err error
+}
+
+// CoreMockRevokeOrigins contains origins of expectations of the Core.Revoke
+type CoreMockRevokeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevoke *mCoreMockRevoke) Optional() *mCoreMockRevoke {
+	mmRevoke.optional = true
+	return mmRevoke
+}
+
+// Expect sets up expected params for Core.Revoke
+func (mmRevoke *mCoreMockRevoke) Expect(ctx context.Context, id uuid.UUID) *mCoreMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &CoreMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by ExpectParams functions")
+	}
+
+	mmRevoke.defaultExpectation.params = &CoreMockRevokeParams{ctx, id}
+	mmRevoke.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevoke.expectations {
+		if minimock.Equal(e.params, mmRevoke.defaultExpectation.params) {
+			mmRevoke.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevoke.defaultExpectation.params)
+		}
+	}
+
+	return mmRevoke
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Revoke
+func (mmRevoke *mCoreMockRevoke) ExpectCtxParam1(ctx context.Context) *mCoreMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &CoreMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &CoreMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevoke.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// ExpectIdParam2 sets up expected param id for Core.Revoke
+func (mmRevoke *mCoreMockRevoke) ExpectIdParam2(id uuid.UUID) *mCoreMockRevoke {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &CoreMockRevokeExpectation{}
+	}
+
+	if mmRevoke.defaultExpectation.params != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Expect")
+	}
+
+	if mmRevoke.defaultExpectation.paramPtrs == nil {
+		mmRevoke.defaultExpectation.paramPtrs = &CoreMockRevokeParamPtrs{}
+	}
+	mmRevoke.defaultExpectation.paramPtrs.id = &id
+	mmRevoke.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevoke
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Revoke
+func (mmRevoke *mCoreMockRevoke) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockRevoke {
+	if mmRevoke.mock.inspectFuncRevoke != nil {
+		mmRevoke.mock.t.Fatalf("Inspect function is already set for CoreMock.Revoke")
+	}
+
+	mmRevoke.mock.inspectFuncRevoke = f
+
+	return mmRevoke
+}
+
+// Return sets up results that will be returned by Core.Revoke
+func (mmRevoke *mCoreMockRevoke) Return(err error) *CoreMock {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Set")
+	}
+
+	if mmRevoke.defaultExpectation == nil {
+		mmRevoke.defaultExpectation = &CoreMockRevokeExpectation{mock: mmRevoke.mock}
+	}
+	mmRevoke.defaultExpectation.results = &CoreMockRevokeResults{err}
+	mmRevoke.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// Set uses given function f to mock the Core.Revoke method
+func (mmRevoke *mCoreMockRevoke) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *CoreMock {
+	if mmRevoke.defaultExpectation != nil {
+		mmRevoke.mock.t.Fatalf("Default expectation is already set for the Core.Revoke method")
+	}
+
+	if len(mmRevoke.expectations) > 0 {
+		mmRevoke.mock.t.Fatalf("Some expectations are already set for the Core.Revoke method")
+	}
+
+	mmRevoke.mock.funcRevoke = f
+	mmRevoke.mock.funcRevokeOrigin = minimock.CallerInfo(1)
+	return mmRevoke.mock
+}
+
+// When sets expectation for the Core.Revoke which will trigger the result defined by the following
+// Then helper
+func (mmRevoke *mCoreMockRevoke) When(ctx context.Context, id uuid.UUID) *CoreMockRevokeExpectation {
+	if mmRevoke.mock.funcRevoke != nil {
+		mmRevoke.mock.t.Fatalf("CoreMock.Revoke mock is already set by Set")
+	}
+
+	expectation := &CoreMockRevokeExpectation{
+		mock:               mmRevoke.mock,
+		params:             &CoreMockRevokeParams{ctx, id},
+		expectationOrigins: CoreMockRevokeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevoke.expectations = append(mmRevoke.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Revoke return parameters for the expectation previously defined by the When method
+func (e *CoreMockRevokeExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockRevokeResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.Revoke should be invoked
+func (mmRevoke *mCoreMockRevoke) Times(n uint64) *mCoreMockRevoke {
+	if n == 0 {
+		mmRevoke.mock.t.Fatalf("Times of CoreMock.Revoke mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevoke.expectedInvocations, n)
+	mmRevoke.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevoke
+}
+
+func (mmRevoke *mCoreMockRevoke) invocationsDone() bool {
+	if len(mmRevoke.expectations) == 0 && mmRevoke.defaultExpectation == nil && mmRevoke.mock.funcRevoke == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevoke.mock.afterRevokeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevoke.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Revoke implements mm_usecase.Core
+func (mmRevoke *CoreMock) Revoke(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmRevoke.beforeRevokeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevoke.afterRevokeCounter, 1)
+
+	mmRevoke.t.Helper()
+
+	if mmRevoke.inspectFuncRevoke != nil {
+		mmRevoke.inspectFuncRevoke(ctx, id)
+	}
+
+	mm_params := CoreMockRevokeParams{ctx, id}
+
+	// Record call args
+	mmRevoke.RevokeMock.mutex.Lock()
+	mmRevoke.RevokeMock.callArgs = append(mmRevoke.RevokeMock.callArgs, &mm_params)
+	mmRevoke.RevokeMock.mutex.Unlock()
+
+	for _, e := range mmRevoke.RevokeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevoke.RevokeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevoke.RevokeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevoke.RevokeMock.defaultExpectation.params
+		mm_want_ptrs := mmRevoke.RevokeMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockRevokeParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevoke.t.Errorf("CoreMock.Revoke got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevoke.t.Errorf("CoreMock.Revoke got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevoke.t.Errorf("CoreMock.Revoke got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevoke.RevokeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevoke.RevokeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevoke.t.Fatal("No results are set for the CoreMock.Revoke")
+		}
+		return (*mm_results).err
+	}
+	if mmRevoke.funcRevoke != nil {
+		return mmRevoke.funcRevoke(ctx, id)
+	}
+	mmRevoke.t.Fatalf("Unexpected call to CoreMock.Revoke. %v %v", ctx, id)
+	return
+}
+
+// RevokeAfterCounter returns a count of finished CoreMock.Revoke invocations
+func (mmRevoke *CoreMock) RevokeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.afterRevokeCounter)
+}
+
+// RevokeBeforeCounter returns a count of CoreMock.Revoke invocations
+func (mmRevoke *CoreMock) RevokeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevoke.beforeRevokeCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Revoke.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevoke *mCoreMockRevoke) Calls() []*CoreMockRevokeParams {
+	mmRevoke.mutex.RLock()
+
+	argCopy := make([]*CoreMockRevokeParams, len(mmRevoke.callArgs))
+	copy(argCopy, mmRevoke.callArgs)
+
+	mmRevoke.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeDone returns true if the count of the Revoke invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockRevokeDone() bool {
+	if m.RevokeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeMock.invocationsDone()
+}
+
+// MinimockRevokeInspect logs each unmet expectation
+func (m *CoreMock) MinimockRevokeInspect() {
+	for _, e := range m.RevokeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Revoke at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeCounter := mm_atomic.LoadUint64(&m.afterRevokeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeMock.defaultExpectation != nil && afterRevokeCounter < 1 {
+		if m.RevokeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Revoke at\n%s", m.RevokeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Revoke at\n%s with params: %#v", m.RevokeMock.defaultExpectation.expectationOrigins.origin, *m.RevokeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevoke != nil && afterRevokeCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Revoke at\n%s", m.funcRevokeOrigin)
+	}
+
+	if !m.RevokeMock.invocationsDone() && afterRevokeCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Revoke at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeMock.expectedInvocations), m.RevokeMock.expectedInvocationsOrigin, afterRevokeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockEmailInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockMarkAcceptedInspect()
+
+			m.MinimockRevokeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockEmailDone() &&
+		m.MinimockListDone() &&
+		m.MinimockMarkAcceptedDone() &&
+		m.MinimockRevokeDone()
+}
@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation/usecase.Sender -o sender_mock.go -n SenderMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SenderMock implements mm_usecase.Sender
+type SenderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSend          func(ctx context.Context, msg mail.Message) (err error)
+	funcSendOrigin    string
+	inspectFuncSend   func(ctx context.Context, msg mail.Message)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mSenderMockSend
+}
+
+// NewSenderMock returns a mock for mm_usecase.Sender
+func NewSenderMock(t minimock.Tester) *SenderMock {
+	m := &SenderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SendMock = mSenderMockSend{mock: m}
+	m.SendMock.callArgs = []*SenderMockSendParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSenderMockSend struct {
+	optional           bool
+	mock               *SenderMock
+	defaultExpectation *SenderMockSendExpectation
+	expectations       []*SenderMockSendExpectation
+
+	callArgs []*SenderMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SenderMockSendExpectation specifies expectation struct of the Sender.Send
+type SenderMockSendExpectation struct {
+	mock               *SenderMock
+	params             *SenderMockSendParams
+	paramPtrs          *SenderMockSendParamPtrs
+	expectationOrigins SenderMockSendExpectationOrigins
+	results            *SenderMockSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SenderMockSendParams contains parameters of the Sender.Send
+type SenderMockSendParams struct {
+	ctx context.Context
+	msg mail.Message
+}
+
+// SenderMockSendParamPtrs contains pointers to parameters of the Sender.Send
+type SenderMockSendParamPtrs struct {
+	ctx *context.Context
+	msg *mail.Message
+}
+
+// SenderMockSendResults contains results of the Sender.Send
+type SenderMockSendResults struct {
+	err error
+}
+
+// SenderMockSendOrigins contains origins of expectations of the Sender.Send
+type SenderMockSendExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mSenderMockSend) Optional() *mSenderMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for Sender.Send
+func (mmSend *mSenderMockSend) Expect(ctx context.Context, msg mail.Message) *mSenderMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SenderMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &SenderMockSendParams{ctx, msg}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Sender.Send
+func (mmSend *mSenderMockSend) ExpectCtxParam1(ctx context.Context) *mSenderMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SenderMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &SenderMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// ExpectMsgParam2 sets up expected param msg for Sender.Send
+func (mmSend *mSenderMockSend) ExpectMsgParam2(msg mail.Message) *mSenderMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SenderMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &SenderMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.msg = &msg
+	mmSend.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the Sender.Send
+func (mmSend *mSenderMockSend) Inspect(f func(ctx context.Context, msg mail.Message)) *mSenderMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for SenderMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by Sender.Send
+func (mmSend *mSenderMockSend) Return(err error) *SenderMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SenderMockSendExpectation{mock: mmSend.mock}
+	}
+	mmSend.defaultExpectation.results = &SenderMockSendResults{err}
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the Sender.Send method
+func (mmSend *mSenderMockSend) Set(f func(ctx context.Context, msg mail.Message) (err error)) *SenderMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the Sender.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the Sender.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the Sender.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mSenderMockSend) When(ctx context.Context, msg mail.Message) *SenderMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SenderMock.Send mock is already set by Set")
+	}
+
+	expectation := &SenderMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &SenderMockSendParams{ctx, msg},
+		expectationOrigins: SenderMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Sender.Send return parameters for the expectation previously defined by the When method
+func (e *SenderMockSendExpectation) Then(err error) *SenderMock {
+	e.results = &SenderMockSendResults{err}
+	return e.mock
+}
+
+// Times sets number of times Sender.Send should be invoked
+func (mmSend *mSenderMockSend) Times(n uint64) *mSenderMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of SenderMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mSenderMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements mm_usecase.Sender
+func (mmSend *SenderMock) Send(ctx context.Context, msg mail.Message) (err error) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(ctx, msg)
+	}
+
+	mm_params := SenderMockSendParams{ctx, msg}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := SenderMockSendParams{ctx, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSend.t.Errorf("SenderMock.Send got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmSend.t.Errorf("SenderMock.Send got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("SenderMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSend.SendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSend.t.Fatal("No results are set for the SenderMock.Send")
+		}
+		return (*mm_results).err
+	}
+	if mmSend.funcSend != nil {
+		return mmSend.funcSend(ctx, msg)
+	}
+	mmSend.t.Fatalf("Unexpected call to SenderMock.Send. %v %v", ctx, msg)
+	return
+}
+
+// SendAfterCounter returns a count of finished SenderMock.Send invocations
+func (mmSend *SenderMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of SenderMock.Send invocations
+func (mmSend *SenderMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to SenderMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mSenderMockSend) Calls() []*SenderMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*SenderMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *SenderMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *SenderMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SenderMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SenderMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SenderMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to SenderMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to SenderMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SenderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSendInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SenderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SenderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSendDone()
+}
@@ -0,0 +1,419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/invitation/usecase.UserCore -o user_core_mock.go -n UserCoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// UserCoreMock implements mm_usecase.UserCore
+type UserCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateUser          func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)
+	funcCreateUserOrigin    string
+	inspectFuncCreateUser   func(ctx context.Context, req user.CreateUserReq)
+	afterCreateUserCounter  uint64
+	beforeCreateUserCounter uint64
+	CreateUserMock          mUserCoreMockCreateUser
+}
+
+// NewUserCoreMock returns a mock for mm_usecase.UserCore
+func NewUserCoreMock(t minimock.Tester) *UserCoreMock {
+	m := &UserCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateUserMock = mUserCoreMockCreateUser{mock: m}
+	m.CreateUserMock.callArgs = []*UserCoreMockCreateUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserCoreMockCreateUser struct {
+	optional           bool
+	mock               *UserCoreMock
+	defaultExpectation *UserCoreMockCreateUserExpectation
+	expectations       []*UserCoreMockCreateUserExpectation
+
+	callArgs []*UserCoreMockCreateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserCoreMockCreateUserExpectation specifies expectation struct of the UserCore.CreateUser
+type UserCoreMockCreateUserExpectation struct {
+	mock               *UserCoreMock
+	params             *UserCoreMockCreateUserParams
+	paramPtrs          *UserCoreMockCreateUserParamPtrs
+	expectationOrigins UserCoreMockCreateUserExpectationOrigins
+	results            *UserCoreMockCreateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserCoreMockCreateUserParams contains parameters of the UserCore.CreateUser
+type UserCoreMockCreateUserParams struct {
+	ctx context.Context
+	req user.CreateUserReq
+}
+
+// UserCoreMockCreateUserParamPtrs contains pointers to parameters of the UserCore.CreateUser
+type UserCoreMockCreateUserParamPtrs struct {
+	ctx *context.Context
+	req *user.CreateUserReq
+}
+
+// UserCoreMockCreateUserResults contains results of the UserCore.CreateUser
+type UserCoreMockCreateUserResults struct {
+	u1  uuid.UUID
+	err error
+}
+
+// UserCoreMockCreateUserOrigins contains origins of expectations of the UserCore.CreateUser
+type UserCoreMockCreateUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mUserCoreMockCreateUser) Optional() *mUserCoreMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for UserCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Expect(ctx context.Context, req user.CreateUserReq) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &UserCoreMockCreateUserParams{ctx, req}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserCoreMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectReqParam2 sets up expected param req for UserCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) ExpectReqParam2(req user.CreateUserReq) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UserCoreMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.req = &req
+	mmCreateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Inspect(f func(ctx context.Context, req user.CreateUserReq)) *mUserCoreMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UserCoreMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by UserCore.CreateUser
+func (mmCreateUser *mUserCoreMockCreateUser) Return(u1 uuid.UUID, err error) *UserCoreMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UserCoreMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &UserCoreMockCreateUserResults{u1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the UserCore.CreateUser method
+func (mmCreateUser *mUserCoreMockCreateUser) Set(f func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)) *UserCoreMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UserCore.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UserCore.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the UserCore.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mUserCoreMockCreateUser) When(ctx context.Context, req user.CreateUserReq) *UserCoreMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UserCoreMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &UserCoreMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &UserCoreMockCreateUserParams{ctx, req},
+		expectationOrigins: UserCoreMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCore.CreateUser return parameters for the expectation previously defined by the When method
+func (e *UserCoreMockCreateUserExpectation) Then(u1 uuid.UUID, err error) *UserCoreMock {
+	e.results = &UserCoreMockCreateUserResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times UserCore.CreateUser should be invoked
+func (mmCreateUser *mUserCoreMockCreateUser) Times(n uint64) *mUserCoreMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of UserCoreMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mUserCoreMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_usecase.UserCore
+func (mmCreateUser *UserCoreMock) CreateUser(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, req)
+	}
+
+	mm_params := UserCoreMockCreateUserParams{ctx, req}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCoreMockCreateUserParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("UserCoreMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the UserCoreMock.CreateUser")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, req)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to UserCoreMock.CreateUser. %v %v", ctx, req)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished UserCoreMock.CreateUser invocations
+func (mmCreateUser *UserCoreMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of UserCoreMock.CreateUser invocations
+func (mmCreateUser *UserCoreMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCoreMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mUserCoreMockCreateUser) Calls() []*UserCoreMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*UserCoreMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *UserCoreMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *UserCoreMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to UserCoreMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCoreMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateUserDone()
+}
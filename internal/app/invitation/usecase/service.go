@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	Create(ctx context.Context, req invitation.CreateReq) (invitation.Created, error)
+	List(ctx context.Context) ([]invitation.Invitation, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Claim(ctx context.Context, token string) (invitation.Invitation, error)
+	MarkAccepted(ctx context.Context, id, userID uuid.UUID) error
+	Email(created invitation.Created) (mail.Message, bool)
+}
+
+// UserCore creates the account of an invitee; user implements it.
+type UserCore interface {
+	CreateUser(ctx context.Context, req user.CreateUserReq) (uuid.UUID, error)
+}
+
+// Granter gives the invitee the roles of the invitation; auth implements it.
+type Granter interface {
+	AddUserRole(ctx context.Context, role auth.UserRole) error
+}
+
+type Sender interface {
+	Send(ctx context.Context, msg mail.Message) error
+}
+
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// AcceptCmd registers the invitee of Token. The email is the one the invitation was made for.
+type AcceptCmd struct {
+	Token    string
+	Name     string
+	Password []byte
+}
+
+type service struct {
+	core    Core
+	users   UserCore
+	granter Granter
+	sender  Sender
+	tx      TxManager
+}
+
+func NewService(core Core, users UserCore, granter Granter, sender Sender, tx TxManager) *service {
+	if core == nil || users == nil || granter == nil || sender == nil || tx == nil {
+		panic("invitation.NewService: nil dependency")
+	}
+	return &service{core: core, users: users, granter: granter, sender: sender, tx: tx}
+}
+
+// Create makes an invitation and emails its link to the invitee. An email that cannot be sent leaves
+// the invitation in place with EmailSent unset, so the admin can pass the link on. The route requires
+// admin role.
+func (s *service) Create(ctx context.Context, req invitation.CreateReq) (invitation.Created, error) {
+	created, err := s.core.Create(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(invitation.FieldEmail.String(), req.Email).
+			Msg("invitation.service.Create: failed to create invitation")
+		return invitation.Created{}, fmt.Errorf("invitation.service.Create: %w", err)
+	}
+	logger.Audit(ctx, "invitation.created").
+		Str(invitation.FieldInvitationID.String(), created.ID.String()).
+		Int("roles", len(created.Roles)).
+		Msg("invitation created")
+
+	if msg, ok := s.core.Email(created); ok {
+		err = s.sender.Send(ctx, msg)
+		switch {
+		case err == nil:
+			created.EmailSent = true
+		case !errors.Is(err, mail.ErrDisabled):
+			logger.Error(ctx, err).
+				Str(invitation.FieldInvitationID.String(), created.ID.String()).
+				Msg("invitation.service.Create: failed to send invitation email")
+		}
+	}
+
+	return created, nil
+}
+
+// List returns the invitations of the workspace, newest first. The route requires admin role.
+func (s *service) List(ctx context.Context) ([]invitation.Invitation, error) {
+	invitations, err := s.core.List(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("invitation.service.List: failed to list invitations")
+		return nil, fmt.Errorf("invitation.service.List: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// Revoke deletes a pending or expired invitation. The route requires admin role.
+func (s *service) Revoke(ctx context.Context, id uuid.UUID) error {
+	if err := s.core.Revoke(ctx, id); err != nil {
+		logger.Error(ctx, err).
+			Str(invitation.FieldInvitationID.String(), id.String()).
+			Msg("invitation.service.Revoke: failed to revoke invitation")
+		return fmt.Errorf("invitation.service.Revoke: %w", err)
+	}
+	logger.Audit(ctx, "invitation.revoked").
+		Str(invitation.FieldInvitationID.String(), id.String()).
+		Msg("invitation revoked")
+
+	return nil
+}
+
+// Accept creates the account of the invitee in the workspace of the invitation and grants its roles,
+// all or none, and returns the ID of the new user. The route needs no authentication.
+func (s *service) Accept(ctx context.Context, cmd AcceptCmd) (uuid.UUID, error) {
+	var (
+		inv    invitation.Invitation
+		userID uuid.UUID
+	)
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if inv, err = s.core.Claim(ctx, cmd.Token); err != nil {
+			return err
+		}
+		ctx = contextx.SetWorkspaceID(ctx, inv.WorkspaceID)
+
+		userID, err = s.users.CreateUser(ctx, user.CreateUserReq{Email: inv.Email, Name: cmd.Name, Password: cmd.Password})
+		if err != nil {
+			return err
+		}
+		for _, r := range inv.Roles {
+			if err = s.granter.AddUserRole(ctx, auth.UserRole{UserID: userID, Role: r.Role, EntityID: r.EntityID}); err != nil {
+				return err
+			}
+		}
+
+		return s.core.MarkAccepted(ctx, inv.ID, userID)
+	})
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(invitation.FieldInvitationID.String(), inv.ID.String()).
+			Msg("invitation.service.Accept: failed to accept invitation")
+		return uuid.Nil, fmt.Errorf("invitation.service.Accept: %w", err)
+	}
+	logger.Audit(ctx, "invitation.accepted").
+		Str(invitation.FieldInvitationID.String(), inv.ID.String()).
+		Str(user.FieldUserID.String(), userID.String()).
+		Int("roles", len(inv.Roles)).
+		Msg("invitation accepted")
+
+	return userID, nil
+}
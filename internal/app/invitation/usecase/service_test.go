@@ -0,0 +1,230 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/invitation"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase"
+	"github.com/66gu1/easygodocs/internal/app/invitation/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/mail"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core    *mocks.CoreMock
+	users   *mocks.UserCoreMock
+	granter *mocks.GranterMock
+	sender  *mocks.SenderMock
+	tx      *mocks.TxManagerMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core:    mocks.NewCoreMock(t),
+		users:   mocks.NewUserCoreMock(t),
+		granter: mocks.NewGranterMock(t),
+		sender:  mocks.NewSenderMock(t),
+		tx:      mocks.NewTxManagerMock(t),
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		req     = invitation.CreateReq{Email: "ann@example.com"}
+		created = invitation.Created{
+			Invitation: invitation.Invitation{ID: uuid.New(), Email: "ann@example.com"},
+			Token:      "token",
+			URL:        "https://docs.example.com/invite/token",
+		}
+		msg    = mail.Message{To: "ann@example.com", Subject: "Join us", Body: created.URL}
+		expErr = errors.New("test error")
+	)
+	sent := created
+	sent.EmailSent = true
+
+	tests := []struct {
+		name  string
+		setup func(m mock)
+		want  invitation.Created
+		err   error
+	}{
+		{
+			name: "ok/sent",
+			setup: func(m mock) {
+				m.core.CreateMock.Expect(ctx, req).Return(created, nil)
+				m.core.EmailMock.Expect(created).Return(msg, true)
+				m.sender.SendMock.Expect(ctx, msg).Return(nil)
+			},
+			want: sent,
+		},
+		{
+			name: "ok/no_url",
+			setup: func(m mock) {
+				m.core.CreateMock.Return(created, nil)
+				m.core.EmailMock.Return(mail.Message{}, false)
+			},
+			want: created,
+		},
+		{
+			name: "ok/mail_disabled",
+			setup: func(m mock) {
+				m.core.CreateMock.Return(created, nil)
+				m.core.EmailMock.Return(msg, true)
+				m.sender.SendMock.Return(mail.ErrDisabled)
+			},
+			want: created,
+		},
+		{
+			name: "ok/send_failed",
+			setup: func(m mock) {
+				m.core.CreateMock.Return(created, nil)
+				m.core.EmailMock.Return(msg, true)
+				m.sender.SendMock.Return(expErr)
+			},
+			want: created,
+		},
+		{
+			name: "error/core",
+			setup: func(m mock) {
+				m.core.CreateMock.Return(invitation.Created{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(m)
+
+			got, err := usecase.NewService(m.core, m.users, m.granter, m.sender, m.tx).Create(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_Accept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = t.Context()
+		workspaceID = uuid.New()
+		userID      = uuid.New()
+		entityID    = uuid.New()
+		inv         = invitation.Invitation{
+			ID: uuid.New(), WorkspaceID: workspaceID, Email: "ann@example.com",
+			Roles: []invitation.Role{{Role: auth.RoleAdmin}, {Role: auth.RoleRead, EntityID: &entityID}},
+		}
+		cmd    = usecase.AcceptCmd{Token: "token", Name: "Ann", Password: []byte("secret123")}
+		expErr = errors.New("test error")
+	)
+
+	inTx := func(m mock) {
+		m.tx.DoMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+	}
+	inWorkspace := func(t *testing.T) func(ctx context.Context) {
+		return func(ctx context.Context) {
+			require.Equal(t, workspaceID, contextx.WorkspaceID(ctx))
+		}
+	}
+
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(t *testing.T, m mock) {
+				inTx(m)
+				m.core.ClaimMock.Expect(ctx, cmd.Token).Return(inv, nil)
+				m.users.CreateUserMock.
+					Expect(minimock.AnyContext, user.CreateUserReq{Email: inv.Email, Name: cmd.Name, Password: cmd.Password}).
+					Inspect(func(ctx context.Context, _ user.CreateUserReq) { inWorkspace(t)(ctx) }).
+					Return(userID, nil)
+				m.granter.AddUserRoleMock.When(minimock.AnyContext, auth.UserRole{UserID: userID, Role: auth.RoleAdmin}).Then(nil)
+				m.granter.AddUserRoleMock.
+					When(minimock.AnyContext, auth.UserRole{UserID: userID, Role: auth.RoleRead, EntityID: &entityID}).Then(nil)
+				m.core.MarkAcceptedMock.Expect(minimock.AnyContext, inv.ID, userID).Return(nil)
+			},
+		},
+		{
+			name: "error/claim",
+			setup: func(_ *testing.T, m mock) {
+				inTx(m)
+				m.core.ClaimMock.Return(invitation.Invitation{}, invitation.ErrExpired())
+			},
+			err: invitation.ErrExpired(),
+		},
+		{
+			name: "error/create_user",
+			setup: func(_ *testing.T, m mock) {
+				inTx(m)
+				m.core.ClaimMock.Return(inv, nil)
+				m.users.CreateUserMock.Return(uuid.Nil, user.ErrUserWithEmailAlreadyExists())
+			},
+			err: user.ErrUserWithEmailAlreadyExists(),
+		},
+		{
+			name: "error/grant",
+			setup: func(_ *testing.T, m mock) {
+				inTx(m)
+				m.core.ClaimMock.Return(inv, nil)
+				m.users.CreateUserMock.Return(userID, nil)
+				m.granter.AddUserRoleMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/mark_accepted",
+			setup: func(_ *testing.T, m mock) {
+				inTx(m)
+				m.core.ClaimMock.Return(inv, nil)
+				m.users.CreateUserMock.Return(userID, nil)
+				m.granter.AddUserRoleMock.Return(nil)
+				m.core.MarkAcceptedMock.Return(invitation.ErrAccepted())
+			},
+			err: invitation.ErrAccepted(),
+		},
+		{
+			name: "error/tx",
+			setup: func(_ *testing.T, m mock) {
+				m.tx.DoMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			tt.setup(t, m)
+
+			got, err := usecase.NewService(m.core, m.users, m.granter, m.sender, m.tx).Accept(ctx, cmd)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, userID, got)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package presence
+
+import (
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldEntityID apperr.Field = "entity_id"
+	FieldUserID   apperr.Field = "user_id"
+	FieldMessage  apperr.Field = "message"
+	FieldState    apperr.Field = "state"
+)
+
+type State string
+
+const (
+	StateViewing State = "viewing"
+	StateEditing State = "editing"
+)
+
+func (s State) Validate() error {
+	switch s {
+	case StateViewing, StateEditing:
+		return nil
+	default:
+		return ErrInvalidState()
+	}
+}
+
+type MessageType string
+
+const (
+	MessageTypeState    MessageType = "state"
+	MessageTypeCursor   MessageType = "cursor"
+	MessageTypeTyping   MessageType = "typing"
+	MessageTypePresence MessageType = "presence"
+	MessageTypeError    MessageType = "error"
+)
+
+// InboundMessage is a message sent by a client to the room it joined.
+type InboundMessage struct {
+	Type     MessageType `json:"type"`
+	State    State       `json:"state,omitempty"`
+	Position *int        `json:"position,omitempty"`
+}
+
+// PresenceMessage is broadcast to every room member whenever membership or member state changes.
+type PresenceMessage struct {
+	Type     MessageType `json:"type"`
+	EntityID uuid.UUID   `json:"entity_id"`
+	Viewing  int         `json:"viewing"`
+	Editing  int         `json:"editing"`
+	Users    []Member    `json:"users"`
+}
+
+type Member struct {
+	UserID uuid.UUID `json:"user_id"`
+	State  State     `json:"state"`
+}
+
+// SignalMessage carries lightweight cursor/typing signals; delivery is best-effort.
+type SignalMessage struct {
+	Type     MessageType `json:"type"`
+	UserID   uuid.UUID   `json:"user_id"`
+	Position *int        `json:"position,omitempty"`
+}
+
+// ErrorMessage wraps the same problem details that HTTP endpoints return.
+type ErrorMessage struct {
+	Type    MessageType    `json:"type"`
+	Problem apperr.Problem `json:"problem"`
+}
@@ -0,0 +1,44 @@
+package presence
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeValidationFailed apperr.Code = "presence/validation_failed"
+	CodeRoomFull         apperr.Code = "presence/room_full"
+	CodeRateLimited      apperr.Code = "presence/rate_limited"
+	CodeEditForbidden    apperr.Code = "presence/edit_forbidden"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid presence message", apperr.ClassBadRequest)
+	apperr.Register(CodeRoomFull, "Room is full", apperr.ClassTooManyRequests)
+	apperr.Register(CodeRateLimited, "Too many messages", apperr.ClassTooManyRequests)
+	apperr.Register(CodeEditForbidden, "Write permission required", apperr.ClassForbidden)
+}
+
+func ErrRoomFull(maxSize int) error {
+	return apperr.New("Too many clients in this room", CodeRoomFull, apperr.ClassTooManyRequests, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldEntityID, Rule: apperr.RuleTooLong,
+			Params: map[string]any{"max": maxSize},
+		})
+}
+
+func ErrRateLimited() error {
+	return apperr.New("Too many messages", CodeRateLimited, apperr.ClassTooManyRequests, apperr.LogLevelWarn)
+}
+
+func ErrEditForbidden() error {
+	return apperr.New("Write permission is required to edit", CodeEditForbidden, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldState, Rule: apperr.RuleForbidden})
+}
+
+func ErrInvalidState() error {
+	return apperr.New("invalid presence state", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldState, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrInvalidMessage() error {
+	return apperr.New("invalid message", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldMessage, Rule: apperr.RuleInvalidFormat})
+}
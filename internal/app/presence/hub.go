@@ -0,0 +1,280 @@
+package presence
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+type Config struct {
+	SendBufferSize       int      `mapstructure:"send_buffer_size" json:"send_buffer_size"`
+	MaxRoomSize          int      `mapstructure:"max_room_size" json:"max_room_size"`
+	MaxMessageBytes      int64    `mapstructure:"max_message_bytes" json:"max_message_bytes"`
+	MaxMessagesPerSecond int      `mapstructure:"max_messages_per_second" json:"max_messages_per_second"`
+	PingIntervalSeconds  int      `mapstructure:"ping_interval_seconds" json:"ping_interval_seconds"`
+	AllowedOrigins       []string `mapstructure:"allowed_origins" json:"allowed_origins"`
+}
+
+func (c Config) Validate() error {
+	if c.SendBufferSize <= 0 || c.MaxRoomSize <= 0 || c.MaxMessageBytes <= 0 ||
+		c.MaxMessagesPerSecond <= 0 || c.PingIntervalSeconds <= 0 {
+		return fmt.Errorf("config values must be positive")
+	}
+
+	return nil
+}
+
+// Client is a single connection joined to an entity room.
+// Outgoing messages are queued into a bounded buffer; a client that cannot keep up
+// with presence updates is kicked (Done is closed) instead of blocking the room.
+type Client struct {
+	EntityID uuid.UUID
+	UserID   uuid.UUID
+	CanEdit  bool
+
+	state     State
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+
+	tokens     float64
+	lastRefill time.Time
+}
+
+func (c *Client) Send() <-chan []byte {
+	return c.send
+}
+
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *Client) kick() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// trySend never blocks. It reports false when the client buffer is full.
+func (c *Client) trySend(msg []byte) bool {
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+type Hub struct {
+	mu      sync.Mutex
+	rooms   map[uuid.UUID]map[*Client]struct{}
+	cfg     Config
+	timeGen TimeGenerator
+}
+
+func NewHub(cfg Config, timeGen TimeGenerator) (*Hub, error) {
+	if timeGen == nil {
+		return nil, fmt.Errorf("presence.NewHub: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("presence.NewHub: %w", err)
+	}
+	return &Hub{
+		rooms:   make(map[uuid.UUID]map[*Client]struct{}),
+		cfg:     cfg,
+		timeGen: timeGen,
+	}, nil
+}
+
+// SetMaxMessagesPerSecond changes the per-client rate limit for all current and future clients.
+func (h *Hub) SetMaxMessagesPerSecond(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("presence.Hub.SetMaxMessagesPerSecond: %w", fmt.Errorf("value must be positive"))
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.cfg.MaxMessagesPerSecond = n
+	return nil
+}
+
+func (h *Hub) Join(entityID, userID uuid.UUID, canEdit bool) (*Client, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[entityID]
+	if !ok {
+		room = make(map[*Client]struct{})
+		h.rooms[entityID] = room
+	}
+	if len(room) >= h.cfg.MaxRoomSize {
+		return nil, fmt.Errorf("presence.Hub.Join: %w", ErrRoomFull(h.cfg.MaxRoomSize))
+	}
+
+	c := &Client{
+		EntityID:   entityID,
+		UserID:     userID,
+		CanEdit:    canEdit,
+		state:      StateViewing,
+		send:       make(chan []byte, h.cfg.SendBufferSize),
+		done:       make(chan struct{}),
+		tokens:     float64(h.cfg.MaxMessagesPerSecond),
+		lastRefill: h.timeGen.Now(),
+	}
+	room[c] = struct{}{}
+	if err := h.broadcastPresence(entityID); err != nil {
+		delete(room, c)
+		return nil, fmt.Errorf("presence.Hub.Join: %w", err)
+	}
+
+	return c, nil
+}
+
+func (h *Hub) Leave(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	c.kick()
+	room, ok := h.rooms[c.EntityID]
+	if !ok {
+		return
+	}
+	if _, ok = room[c]; !ok {
+		return
+	}
+	delete(room, c)
+	if len(room) == 0 {
+		delete(h.rooms, c.EntityID)
+		return
+	}
+	_ = h.broadcastPresence(c.EntityID) //nolint:errcheck // marshaling a snapshot of known types cannot fail
+}
+
+func (h *Hub) Handle(c *Client, msg InboundMessage) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.allow(c) {
+		return fmt.Errorf("presence.Hub.Handle: %w", ErrRateLimited())
+	}
+
+	switch msg.Type {
+	case MessageTypeState:
+		if err := msg.State.Validate(); err != nil {
+			return fmt.Errorf("presence.Hub.Handle: %w", err)
+		}
+		if msg.State == StateEditing && !c.CanEdit {
+			return fmt.Errorf("presence.Hub.Handle: %w", ErrEditForbidden())
+		}
+		if c.state == msg.State {
+			return nil
+		}
+		c.state = msg.State
+		if err := h.broadcastPresence(c.EntityID); err != nil {
+			return fmt.Errorf("presence.Hub.Handle: %w", err)
+		}
+	case MessageTypeCursor:
+		if msg.Position == nil || *msg.Position < 0 {
+			return fmt.Errorf("presence.Hub.Handle: %w", ErrInvalidMessage())
+		}
+		if err := h.broadcastSignal(c, SignalMessage{Type: MessageTypeCursor, UserID: c.UserID, Position: msg.Position}); err != nil {
+			return fmt.Errorf("presence.Hub.Handle: %w", err)
+		}
+	case MessageTypeTyping:
+		if err := h.broadcastSignal(c, SignalMessage{Type: MessageTypeTyping, UserID: c.UserID}); err != nil {
+			return fmt.Errorf("presence.Hub.Handle: %w", err)
+		}
+	default:
+		return fmt.Errorf("presence.Hub.Handle: %w", ErrInvalidMessage())
+	}
+
+	return nil
+}
+
+// Snapshot returns the current presence of a room. It is safe to call for rooms nobody joined.
+func (h *Hub) Snapshot(entityID uuid.UUID) PresenceMessage {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.snapshot(entityID)
+}
+
+func (h *Hub) snapshot(entityID uuid.UUID) PresenceMessage {
+	// A user with several open tabs is counted once; editing wins over viewing.
+	states := make(map[uuid.UUID]State)
+	for c := range h.rooms[entityID] {
+		if states[c.UserID] != StateEditing {
+			states[c.UserID] = c.state
+		}
+	}
+
+	msg := PresenceMessage{
+		Type:     MessageTypePresence,
+		EntityID: entityID,
+		Users:    make([]Member, 0, len(states)),
+	}
+	for userID, state := range states {
+		if state == StateEditing {
+			msg.Editing++
+		} else {
+			msg.Viewing++
+		}
+		msg.Users = append(msg.Users, Member{UserID: userID, State: state})
+	}
+	sort.Slice(msg.Users, func(i, j int) bool {
+		return msg.Users[i].UserID.String() < msg.Users[j].UserID.String()
+	})
+
+	return msg
+}
+
+// broadcastPresence must be called with h.mu held. Presence updates are not lossy:
+// a member whose buffer is full is kicked so that it reconnects and gets a fresh snapshot.
+func (h *Hub) broadcastPresence(entityID uuid.UUID) error {
+	data, err := json.Marshal(h.snapshot(entityID))
+	if err != nil {
+		return fmt.Errorf("broadcastPresence: %w", err)
+	}
+	for c := range h.rooms[entityID] {
+		if !c.trySend(data) {
+			c.kick()
+		}
+	}
+	return nil
+}
+
+// broadcastSignal must be called with h.mu held. Signals are dropped for slow members.
+func (h *Hub) broadcastSignal(from *Client, msg SignalMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("broadcastSignal: %w", err)
+	}
+	for c := range h.rooms[from.EntityID] {
+		if c != from {
+			c.trySend(data)
+		}
+	}
+	return nil
+}
+
+// allow is a token bucket refilled at MaxMessagesPerSecond. Must be called with h.mu held.
+func (h *Hub) allow(c *Client) bool {
+	now := h.timeGen.Now()
+	limit := float64(h.cfg.MaxMessagesPerSecond)
+	c.tokens += now.Sub(c.lastRefill).Seconds() * limit
+	if c.tokens > limit {
+		c.tokens = limit
+	}
+	c.lastRefill = now
+	if c.tokens < 1 {
+		return false
+	}
+	c.tokens--
+	return true
+}
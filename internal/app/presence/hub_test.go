@@ -0,0 +1,289 @@
+package presence_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/66gu1/easygodocs/internal/app/presence/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func cfg() presence.Config {
+	return presence.Config{
+		SendBufferSize:       4,
+		MaxRoomSize:          3,
+		MaxMessageBytes:      1024,
+		MaxMessagesPerSecond: 2,
+		PingIntervalSeconds:  30,
+	}
+}
+
+func newHub(t *testing.T, now time.Time) *presence.Hub {
+	t.Helper()
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Optional().Return(now)
+	hub, err := presence.NewHub(cfg(), timeGen)
+	require.NoError(t, err)
+	return hub
+}
+
+func readPresence(t *testing.T, c *presence.Client) presence.PresenceMessage {
+	t.Helper()
+	select {
+	case data := <-c.Send():
+		var msg presence.PresenceMessage
+		require.NoError(t, json.Unmarshal(data, &msg))
+		require.Equal(t, presence.MessageTypePresence, msg.Type)
+		return msg
+	default:
+		t.Fatal("expected a queued presence message")
+		return presence.PresenceMessage{}
+	}
+}
+
+func TestNewHub(t *testing.T) {
+	t.Parallel()
+
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	_, err := presence.NewHub(cfg(), timeGen)
+	require.NoError(t, err)
+
+	_, err = presence.NewHub(cfg(), nil)
+	require.Error(t, err)
+
+	bad := cfg()
+	bad.MaxRoomSize = 0
+	_, err = presence.NewHub(bad, timeGen)
+	require.Error(t, err)
+}
+
+func TestHub_JoinLeave(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hub      = newHub(t, time.Now())
+		entityID = uuid.New()
+		user1    = uuid.New()
+		user2    = uuid.New()
+	)
+
+	c1, err := hub.Join(entityID, user1, true)
+	require.NoError(t, err)
+	msg := readPresence(t, c1)
+	require.Equal(t, 1, msg.Viewing)
+	require.Equal(t, entityID, msg.EntityID)
+
+	c2, err := hub.Join(entityID, user2, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, readPresence(t, c1).Viewing)
+	require.Equal(t, 2, readPresence(t, c2).Viewing)
+
+	// second tab of the same user is counted once
+	c3, err := hub.Join(entityID, user2, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, readPresence(t, c1).Viewing)
+	readPresence(t, c2)
+	readPresence(t, c3)
+
+	// room is full
+	_, err = hub.Join(entityID, uuid.New(), false)
+	require.ErrorIs(t, err, presence.ErrRoomFull(cfg().MaxRoomSize))
+
+	hub.Leave(c3)
+	select {
+	case <-c3.Done():
+	default:
+		t.Fatal("left client must be done")
+	}
+	require.Equal(t, 2, readPresence(t, c1).Viewing)
+
+	hub.Leave(c2)
+	hub.Leave(c2) // idempotent
+	require.Equal(t, 1, readPresence(t, c1).Viewing)
+
+	hub.Leave(c1)
+	require.Empty(t, hub.Snapshot(entityID).Users)
+}
+
+func TestHub_Handle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		entityID = uuid.New()
+		position = 10
+		negative = -1
+	)
+
+	tests := []struct {
+		name   string
+		msg    presence.InboundMessage
+		editor bool
+		err    error
+		check  func(t *testing.T, sender, other *presence.Client)
+	}{
+		{
+			name:   "state editing",
+			msg:    presence.InboundMessage{Type: presence.MessageTypeState, State: presence.StateEditing},
+			editor: true,
+			check: func(t *testing.T, sender, other *presence.Client) {
+				msg := readPresence(t, other)
+				require.Equal(t, 1, msg.Editing)
+				require.Equal(t, 1, msg.Viewing)
+				readPresence(t, sender)
+			},
+		},
+		{
+			name: "state editing without write permission",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeState, State: presence.StateEditing},
+			err:  presence.ErrEditForbidden(),
+		},
+		{
+			name: "invalid state",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeState, State: "sleeping"},
+			err:  presence.ErrInvalidState(),
+		},
+		{
+			name: "cursor is sent to others only",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeCursor, Position: &position},
+			check: func(t *testing.T, sender, other *presence.Client) {
+				var msg presence.SignalMessage
+				require.NoError(t, json.Unmarshal(<-other.Send(), &msg))
+				require.Equal(t, presence.MessageTypeCursor, msg.Type)
+				require.Equal(t, sender.UserID, msg.UserID)
+				require.Equal(t, position, *msg.Position)
+				require.Empty(t, sender.Send())
+			},
+		},
+		{
+			name: "cursor without position",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeCursor},
+			err:  presence.ErrInvalidMessage(),
+		},
+		{
+			name: "cursor with negative position",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeCursor, Position: &negative},
+			err:  presence.ErrInvalidMessage(),
+		},
+		{
+			name: "typing",
+			msg:  presence.InboundMessage{Type: presence.MessageTypeTyping},
+			check: func(t *testing.T, sender, other *presence.Client) {
+				var msg presence.SignalMessage
+				require.NoError(t, json.Unmarshal(<-other.Send(), &msg))
+				require.Equal(t, presence.MessageTypeTyping, msg.Type)
+			},
+		},
+		{
+			name: "unknown type",
+			msg:  presence.InboundMessage{Type: "unknown"},
+			err:  presence.ErrInvalidMessage(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hub := newHub(t, time.Now())
+			sender, err := hub.Join(entityID, uuid.New(), tt.editor)
+			require.NoError(t, err)
+			other, err := hub.Join(entityID, uuid.New(), false)
+			require.NoError(t, err)
+			readPresence(t, sender)
+			readPresence(t, sender)
+			readPresence(t, other)
+
+			err = hub.Handle(sender, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, sender, other)
+			}
+		})
+	}
+}
+
+func TestHub_Handle_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now      = time.Now()
+		timeGen  = mocks.NewTimeGeneratorMock(t)
+		entityID = uuid.New()
+		typing   = presence.InboundMessage{Type: presence.MessageTypeTyping}
+	)
+	timeGen.NowMock.Return(now)
+	hub, err := presence.NewHub(cfg(), timeGen)
+	require.NoError(t, err)
+	c, err := hub.Join(entityID, uuid.New(), false)
+	require.NoError(t, err)
+
+	require.NoError(t, hub.Handle(c, typing))
+	require.NoError(t, hub.Handle(c, typing))
+	require.ErrorIs(t, hub.Handle(c, typing), presence.ErrRateLimited())
+
+	timeGen.NowMock.Return(now.Add(time.Second))
+	require.NoError(t, hub.Handle(c, typing))
+}
+
+func TestHub_SlowClient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hub      = newHub(t, time.Now())
+		entityID = uuid.New()
+		position = 1
+	)
+
+	slow, err := hub.Join(entityID, uuid.New(), true)
+	require.NoError(t, err)
+	fast, err := hub.Join(entityID, uuid.New(), true)
+	require.NoError(t, err)
+
+	// signals are dropped for a full buffer without kicking the client
+	require.NoError(t, hub.Handle(fast, presence.InboundMessage{Type: presence.MessageTypeCursor, Position: &position}))
+	require.NoError(t, hub.Handle(fast, presence.InboundMessage{Type: presence.MessageTypeCursor, Position: &position}))
+	require.Len(t, slow.Send(), cfg().SendBufferSize)
+	select {
+	case <-slow.Done():
+		t.Fatal("slow client must not be kicked for dropped signals")
+	default:
+	}
+
+	// presence updates kick a client that cannot receive them
+	_, err = hub.Join(entityID, uuid.New(), false)
+	require.NoError(t, err)
+	select {
+	case <-slow.Done():
+	default:
+		t.Fatal("slow client must be kicked")
+	}
+}
+
+func TestHub_SetMaxMessagesPerSecond(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Now()
+		timeGen = mocks.NewTimeGeneratorMock(t)
+		typing  = presence.InboundMessage{Type: presence.MessageTypeTyping}
+	)
+	timeGen.NowMock.Return(now)
+	hub, err := presence.NewHub(cfg(), timeGen)
+	require.NoError(t, err)
+	c, err := hub.Join(uuid.New(), uuid.New(), false)
+	require.NoError(t, err)
+
+	require.Error(t, hub.SetMaxMessagesPerSecond(0))
+	require.NoError(t, hub.SetMaxMessagesPerSecond(1))
+
+	// the bucket is capped by the new limit on the next refill
+	require.NoError(t, hub.Handle(c, typing))
+	require.ErrorIs(t, hub.Handle(c, typing), presence.ErrRateLimited())
+}
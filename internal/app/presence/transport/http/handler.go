@@ -0,0 +1,169 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+)
+
+const (
+	QueryParamEntityID = "entity_id"
+
+	writeTimeout = 5 * time.Second
+)
+
+type Service interface {
+	Join(ctx context.Context, entityID uuid.UUID) (*presence.Client, error)
+	Leave(ctx context.Context, c *presence.Client)
+	Handle(ctx context.Context, c *presence.Client, msg presence.InboundMessage) error
+}
+
+// Handler upgrades HTTP requests to WebSocket connections and pumps messages between them and the hub.
+type Handler struct {
+	svc Service
+	cfg presence.Config
+}
+
+func NewHandler(svc Service, cfg presence.Config) *Handler {
+	if svc == nil {
+		panic("presence HTTP handler: nil service")
+	}
+	return &Handler{svc: svc, cfg: cfg}
+}
+
+// Serve godoc
+// @Summary      Join entity presence channel
+// @Description  Upgrades to a WebSocket and joins the presence room of the entity. Requires read permission; switching to the "editing" state requires write permission.
+// @Description  Browsers may pass the access token in the access_token query parameter.
+// @Description  Client messages: {"type":"state","state":"viewing|editing"}, {"type":"cursor","position":N}, {"type":"typing"}.
+// @Tags         presence
+// @Security     BearerAuth
+// @Param        entity_id    query string true  "Entity ID"
+// @Param        access_token query string false "Access token (alternative to the Authorization header)"
+// @Success      101 "Switching Protocols"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /ws [get]
+func (h *Handler) Serve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := r.URL.Query().Get(QueryParamEntityID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(presence.FieldEntityID.String(), idStr).
+			Msg("presence.Handler.Serve: invalid entity ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	client, err := h.svc.Join(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	defer h.svc.Leave(context.WithoutCancel(ctx), client)
+
+	// Server-wide read/write timeouts would otherwise survive the hijack and kill long-lived connections.
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})  //nolint:errcheck // not supported by every writer; best-effort
+	_ = rc.SetWriteDeadline(time.Time{}) //nolint:errcheck // not supported by every writer; best-effort
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: h.cfg.AllowedOrigins})
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(presence.FieldEntityID.String(), idStr).
+			Msg("presence.Handler.Serve: websocket accept failed")
+		return
+	}
+	defer conn.CloseNow() //nolint:errcheck // connection is already being torn down
+	conn.SetReadLimit(h.cfg.MaxMessageBytes)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go h.writeLoop(ctx, cancel, conn, client)
+	h.readLoop(ctx, conn, client)
+}
+
+func (h *Handler) readLoop(ctx context.Context, conn *websocket.Conn, client *presence.Client) {
+	for {
+		typ, data, err := conn.Read(ctx)
+		if err != nil {
+			if websocket.CloseStatus(err) == -1 && !errors.Is(err, context.Canceled) {
+				logger.Warn(ctx, err).Msg("presence.Handler.readLoop: read failed")
+			}
+			return
+		}
+
+		var msg presence.InboundMessage
+		if typ != websocket.MessageText || json.Unmarshal(data, &msg) != nil {
+			h.writeError(ctx, conn, presence.ErrInvalidMessage())
+			continue
+		}
+		if err = h.svc.Handle(ctx, client, msg); err != nil {
+			h.writeError(ctx, conn, err)
+		}
+	}
+}
+
+func (h *Handler) writeLoop(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, client *presence.Client) {
+	defer cancel()
+	ticker := time.NewTicker(time.Duration(h.cfg.PingIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-client.Done():
+			_ = conn.Close(websocket.StatusPolicyViolation, "client too slow") //nolint:errcheck // closing anyway
+			return
+		case msg := <-client.Send():
+			if err := h.write(ctx, conn, msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			pingCtx, pingCancel := context.WithTimeout(ctx, writeTimeout)
+			err := conn.Ping(pingCtx)
+			pingCancel()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (h *Handler) write(ctx context.Context, conn *websocket.Conn, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	if err := conn.Write(ctx, websocket.MessageText, msg); err != nil {
+		return fmt.Errorf("presence.Handler.write: %w", err)
+	}
+	return nil
+}
+
+func (h *Handler) writeError(ctx context.Context, conn *websocket.Conn, err error) {
+	problem := apperr.ToProblem(err)
+	problem.RequestID = contextx.RequestID(ctx)
+	i18n.LocalizeProblem(ctx, &problem)
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	if err = wsjson.Write(ctx, conn, presence.ErrorMessage{
+		Type:    presence.MessageTypeError,
+		Problem: problem,
+	}); err != nil {
+		logger.Warn(ctx, err).Msg("presence.Handler.writeError: write failed")
+	}
+}
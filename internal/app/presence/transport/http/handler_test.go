@@ -0,0 +1,149 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	presence_http "github.com/66gu1/easygodocs/internal/app/presence/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/presence/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type clock struct{}
+
+func (clock) Now() time.Time { return time.Now() }
+
+func cfg() presence.Config {
+	return presence.Config{
+		SendBufferSize:       8,
+		MaxRoomSize:          10,
+		MaxMessageBytes:      1024,
+		MaxMessagesPerSecond: 10,
+		PingIntervalSeconds:  30,
+	}
+}
+
+func TestHandler_Serve_Errors(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		entityID   string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "invalid entity id -> 400",
+			entityID:   "bad",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "room full -> 429",
+			entityID:   id.String(),
+			wantStatus: http.StatusTooManyRequests,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.JoinMock.Expect(minimock.AnyContext, id).Return(nil, presence.ErrRoomFull(1))
+			},
+		},
+		{
+			name:       "usecase error -> 500",
+			entityID:   id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.JoinMock.Expect(minimock.AnyContext, id).Return(nil, fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := presence_http.NewHandler(svcMock, cfg())
+
+			r := httptest.NewRequest(http.MethodGet, "/ws?entity_id="+tt.entityID, nil)
+			w := httptest.NewRecorder()
+			h.Serve(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		})
+	}
+}
+
+func TestHandler_Serve_Roundtrip(t *testing.T) {
+	t.Parallel()
+
+	var (
+		entityID = uuid.New()
+		mc       = minimock.NewController(t)
+		svcMock  = mocks.NewServiceMock(mc)
+	)
+	hub, err := presence.NewHub(cfg(), clock{})
+	require.NoError(t, err)
+
+	svcMock.JoinMock.Set(func(_ context.Context, id uuid.UUID) (*presence.Client, error) {
+		return hub.Join(id, uuid.New(), true)
+	})
+	svcMock.LeaveMock.Set(func(_ context.Context, c *presence.Client) { hub.Leave(c) })
+	svcMock.HandleMock.Set(func(_ context.Context, c *presence.Client, msg presence.InboundMessage) error {
+		return hub.Handle(c, msg)
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(presence_http.NewHandler(svcMock, cfg()).Serve))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws?entity_id=" + entityID.String()
+
+	conn, _, err := websocket.Dial(ctx, url, nil)
+	require.NoError(t, err)
+	defer conn.CloseNow() //nolint:errcheck // test cleanup
+
+	var snapshot presence.PresenceMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &snapshot))
+	require.Equal(t, presence.MessageTypePresence, snapshot.Type)
+	require.Equal(t, 1, snapshot.Viewing)
+
+	require.NoError(t, wsjson.Write(ctx, conn, presence.InboundMessage{Type: presence.MessageTypeState, State: presence.StateEditing}))
+	require.NoError(t, wsjson.Read(ctx, conn, &snapshot))
+	require.Equal(t, 1, snapshot.Editing)
+	require.Equal(t, 0, snapshot.Viewing)
+
+	require.NoError(t, wsjson.Write(ctx, conn, presence.InboundMessage{Type: "unknown"}))
+	var errMsg presence.ErrorMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &errMsg))
+	require.Equal(t, presence.MessageTypeError, errMsg.Type)
+	require.Equal(t, presence.CodeValidationFailed, errMsg.Problem.Code)
+	require.Equal(t, apperr.TypeURI(presence.CodeValidationFailed), errMsg.Problem.Type)
+	require.Equal(t, http.StatusBadRequest, errMsg.Problem.Status)
+
+	require.NoError(t, conn.Close(websocket.StatusNormalClosure, ""))
+	require.Eventually(t, func() bool {
+		return len(hub.Snapshot(entityID).Users) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,1153 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/presence/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcHandle          func(ctx context.Context, c *presence.Client, msg presence.InboundMessage) (err error)
+	funcHandleOrigin    string
+	inspectFuncHandle   func(ctx context.Context, c *presence.Client, msg presence.InboundMessage)
+	afterHandleCounter  uint64
+	beforeHandleCounter uint64
+	HandleMock          mServiceMockHandle
+
+	funcJoin          func(ctx context.Context, entityID uuid.UUID) (cp1 *presence.Client, err error)
+	funcJoinOrigin    string
+	inspectFuncJoin   func(ctx context.Context, entityID uuid.UUID)
+	afterJoinCounter  uint64
+	beforeJoinCounter uint64
+	JoinMock          mServiceMockJoin
+
+	funcLeave          func(ctx context.Context, c *presence.Client)
+	funcLeaveOrigin    string
+	inspectFuncLeave   func(ctx context.Context, c *presence.Client)
+	afterLeaveCounter  uint64
+	beforeLeaveCounter uint64
+	LeaveMock          mServiceMockLeave
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.HandleMock = mServiceMockHandle{mock: m}
+	m.HandleMock.callArgs = []*ServiceMockHandleParams{}
+
+	m.JoinMock = mServiceMockJoin{mock: m}
+	m.JoinMock.callArgs = []*ServiceMockJoinParams{}
+
+	m.LeaveMock = mServiceMockLeave{mock: m}
+	m.LeaveMock.callArgs = []*ServiceMockLeaveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockHandle struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockHandleExpectation
+	expectations       []*ServiceMockHandleExpectation
+
+	callArgs []*ServiceMockHandleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockHandleExpectation specifies expectation struct of the Service.Handle
+type ServiceMockHandleExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockHandleParams
+	paramPtrs          *ServiceMockHandleParamPtrs
+	expectationOrigins ServiceMockHandleExpectationOrigins
+	results            *ServiceMockHandleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockHandleParams contains parameters of the Service.Handle
+type ServiceMockHandleParams struct {
+	ctx context.Context
+	c   *presence.Client
+	msg presence.InboundMessage
+}
+
+// ServiceMockHandleParamPtrs contains pointers to parameters of the Service.Handle
+type ServiceMockHandleParamPtrs struct {
+	ctx *context.Context
+	c   **presence.Client
+	msg *presence.InboundMessage
+}
+
+// ServiceMockHandleResults contains results of the Service.Handle
+type ServiceMockHandleResults struct {
+	err error
+}
+
+// ServiceMockHandleOrigins contains origins of expectations of the Service.Handle
+type ServiceMockHandleExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originC   string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHandle *mServiceMockHandle) Optional() *mServiceMockHandle {
+	mmHandle.optional = true
+	return mmHandle
+}
+
+// Expect sets up expected params for Service.Handle
+func (mmHandle *mServiceMockHandle) Expect(ctx context.Context, c *presence.Client, msg presence.InboundMessage) *mServiceMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &ServiceMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by ExpectParams functions")
+	}
+
+	mmHandle.defaultExpectation.params = &ServiceMockHandleParams{ctx, c, msg}
+	mmHandle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHandle.expectations {
+		if minimock.Equal(e.params, mmHandle.defaultExpectation.params) {
+			mmHandle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHandle.defaultExpectation.params)
+		}
+	}
+
+	return mmHandle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Handle
+func (mmHandle *mServiceMockHandle) ExpectCtxParam1(ctx context.Context) *mServiceMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &ServiceMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.params != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Expect")
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs == nil {
+		mmHandle.defaultExpectation.paramPtrs = &ServiceMockHandleParamPtrs{}
+	}
+	mmHandle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHandle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHandle
+}
+
+// ExpectCParam2 sets up expected param c for Service.Handle
+func (mmHandle *mServiceMockHandle) ExpectCParam2(c *presence.Client) *mServiceMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &ServiceMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.params != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Expect")
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs == nil {
+		mmHandle.defaultExpectation.paramPtrs = &ServiceMockHandleParamPtrs{}
+	}
+	mmHandle.defaultExpectation.paramPtrs.c = &c
+	mmHandle.defaultExpectation.expectationOrigins.originC = minimock.CallerInfo(1)
+
+	return mmHandle
+}
+
+// ExpectMsgParam3 sets up expected param msg for Service.Handle
+func (mmHandle *mServiceMockHandle) ExpectMsgParam3(msg presence.InboundMessage) *mServiceMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &ServiceMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.params != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Expect")
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs == nil {
+		mmHandle.defaultExpectation.paramPtrs = &ServiceMockHandleParamPtrs{}
+	}
+	mmHandle.defaultExpectation.paramPtrs.msg = &msg
+	mmHandle.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmHandle
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Handle
+func (mmHandle *mServiceMockHandle) Inspect(f func(ctx context.Context, c *presence.Client, msg presence.InboundMessage)) *mServiceMockHandle {
+	if mmHandle.mock.inspectFuncHandle != nil {
+		mmHandle.mock.t.Fatalf("Inspect function is already set for ServiceMock.Handle")
+	}
+
+	mmHandle.mock.inspectFuncHandle = f
+
+	return mmHandle
+}
+
+// Return sets up results that will be returned by Service.Handle
+func (mmHandle *mServiceMockHandle) Return(err error) *ServiceMock {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &ServiceMockHandleExpectation{mock: mmHandle.mock}
+	}
+	mmHandle.defaultExpectation.results = &ServiceMockHandleResults{err}
+	mmHandle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHandle.mock
+}
+
+// Set uses given function f to mock the Service.Handle method
+func (mmHandle *mServiceMockHandle) Set(f func(ctx context.Context, c *presence.Client, msg presence.InboundMessage) (err error)) *ServiceMock {
+	if mmHandle.defaultExpectation != nil {
+		mmHandle.mock.t.Fatalf("Default expectation is already set for the Service.Handle method")
+	}
+
+	if len(mmHandle.expectations) > 0 {
+		mmHandle.mock.t.Fatalf("Some expectations are already set for the Service.Handle method")
+	}
+
+	mmHandle.mock.funcHandle = f
+	mmHandle.mock.funcHandleOrigin = minimock.CallerInfo(1)
+	return mmHandle.mock
+}
+
+// When sets expectation for the Service.Handle which will trigger the result defined by the following
+// Then helper
+func (mmHandle *mServiceMockHandle) When(ctx context.Context, c *presence.Client, msg presence.InboundMessage) *ServiceMockHandleExpectation {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("ServiceMock.Handle mock is already set by Set")
+	}
+
+	expectation := &ServiceMockHandleExpectation{
+		mock:               mmHandle.mock,
+		params:             &ServiceMockHandleParams{ctx, c, msg},
+		expectationOrigins: ServiceMockHandleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHandle.expectations = append(mmHandle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Handle return parameters for the expectation previously defined by the When method
+func (e *ServiceMockHandleExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockHandleResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.Handle should be invoked
+func (mmHandle *mServiceMockHandle) Times(n uint64) *mServiceMockHandle {
+	if n == 0 {
+		mmHandle.mock.t.Fatalf("Times of ServiceMock.Handle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHandle.expectedInvocations, n)
+	mmHandle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHandle
+}
+
+func (mmHandle *mServiceMockHandle) invocationsDone() bool {
+	if len(mmHandle.expectations) == 0 && mmHandle.defaultExpectation == nil && mmHandle.mock.funcHandle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHandle.mock.afterHandleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHandle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Handle implements mm_http.Service
+func (mmHandle *ServiceMock) Handle(ctx context.Context, c *presence.Client, msg presence.InboundMessage) (err error) {
+	mm_atomic.AddUint64(&mmHandle.beforeHandleCounter, 1)
+	defer mm_atomic.AddUint64(&mmHandle.afterHandleCounter, 1)
+
+	mmHandle.t.Helper()
+
+	if mmHandle.inspectFuncHandle != nil {
+		mmHandle.inspectFuncHandle(ctx, c, msg)
+	}
+
+	mm_params := ServiceMockHandleParams{ctx, c, msg}
+
+	// Record call args
+	mmHandle.HandleMock.mutex.Lock()
+	mmHandle.HandleMock.callArgs = append(mmHandle.HandleMock.callArgs, &mm_params)
+	mmHandle.HandleMock.mutex.Unlock()
+
+	for _, e := range mmHandle.HandleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHandle.HandleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHandle.HandleMock.defaultExpectation.Counter, 1)
+		mm_want := mmHandle.HandleMock.defaultExpectation.params
+		mm_want_ptrs := mmHandle.HandleMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockHandleParams{ctx, c, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHandle.t.Errorf("ServiceMock.Handle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandle.HandleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.c != nil && !minimock.Equal(*mm_want_ptrs.c, mm_got.c) {
+				mmHandle.t.Errorf("ServiceMock.Handle got unexpected parameter c, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandle.HandleMock.defaultExpectation.expectationOrigins.originC, *mm_want_ptrs.c, mm_got.c, minimock.Diff(*mm_want_ptrs.c, mm_got.c))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmHandle.t.Errorf("ServiceMock.Handle got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandle.HandleMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHandle.t.Errorf("ServiceMock.Handle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHandle.HandleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHandle.HandleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHandle.t.Fatal("No results are set for the ServiceMock.Handle")
+		}
+		return (*mm_results).err
+	}
+	if mmHandle.funcHandle != nil {
+		return mmHandle.funcHandle(ctx, c, msg)
+	}
+	mmHandle.t.Fatalf("Unexpected call to ServiceMock.Handle. %v %v %v", ctx, c, msg)
+	return
+}
+
+// HandleAfterCounter returns a count of finished ServiceMock.Handle invocations
+func (mmHandle *ServiceMock) HandleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandle.afterHandleCounter)
+}
+
+// HandleBeforeCounter returns a count of ServiceMock.Handle invocations
+func (mmHandle *ServiceMock) HandleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandle.beforeHandleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Handle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHandle *mServiceMockHandle) Calls() []*ServiceMockHandleParams {
+	mmHandle.mutex.RLock()
+
+	argCopy := make([]*ServiceMockHandleParams, len(mmHandle.callArgs))
+	copy(argCopy, mmHandle.callArgs)
+
+	mmHandle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHandleDone returns true if the count of the Handle invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockHandleDone() bool {
+	if m.HandleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HandleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HandleMock.invocationsDone()
+}
+
+// MinimockHandleInspect logs each unmet expectation
+func (m *ServiceMock) MinimockHandleInspect() {
+	for _, e := range m.HandleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Handle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHandleCounter := mm_atomic.LoadUint64(&m.afterHandleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HandleMock.defaultExpectation != nil && afterHandleCounter < 1 {
+		if m.HandleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Handle at\n%s", m.HandleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Handle at\n%s with params: %#v", m.HandleMock.defaultExpectation.expectationOrigins.origin, *m.HandleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHandle != nil && afterHandleCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Handle at\n%s", m.funcHandleOrigin)
+	}
+
+	if !m.HandleMock.invocationsDone() && afterHandleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Handle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HandleMock.expectedInvocations), m.HandleMock.expectedInvocationsOrigin, afterHandleCounter)
+	}
+}
+
+type mServiceMockJoin struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockJoinExpectation
+	expectations       []*ServiceMockJoinExpectation
+
+	callArgs []*ServiceMockJoinParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockJoinExpectation specifies expectation struct of the Service.Join
+type ServiceMockJoinExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockJoinParams
+	paramPtrs          *ServiceMockJoinParamPtrs
+	expectationOrigins ServiceMockJoinExpectationOrigins
+	results            *ServiceMockJoinResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockJoinParams contains parameters of the Service.Join
+type ServiceMockJoinParams struct {
+	ctx      context.Context
+	entityID uuid.UUID
+}
+
+// ServiceMockJoinParamPtrs contains pointers to parameters of the Service.Join
+type ServiceMockJoinParamPtrs struct {
+	ctx      *context.Context
+	entityID *uuid.UUID
+}
+
+// ServiceMockJoinResults contains results of the Service.Join
+type ServiceMockJoinResults struct {
+	cp1 *presence.Client
+	err error
+}
+
+// ServiceMockJoinOrigins contains origins of expectations of the Service.Join
+type ServiceMockJoinExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEntityID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmJoin *mServiceMockJoin) Optional() *mServiceMockJoin {
+	mmJoin.optional = true
+	return mmJoin
+}
+
+// Expect sets up expected params for Service.Join
+func (mmJoin *mServiceMockJoin) Expect(ctx context.Context, entityID uuid.UUID) *mServiceMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &ServiceMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by ExpectParams functions")
+	}
+
+	mmJoin.defaultExpectation.params = &ServiceMockJoinParams{ctx, entityID}
+	mmJoin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmJoin.expectations {
+		if minimock.Equal(e.params, mmJoin.defaultExpectation.params) {
+			mmJoin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmJoin.defaultExpectation.params)
+		}
+	}
+
+	return mmJoin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Join
+func (mmJoin *mServiceMockJoin) ExpectCtxParam1(ctx context.Context) *mServiceMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &ServiceMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.params != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Expect")
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs == nil {
+		mmJoin.defaultExpectation.paramPtrs = &ServiceMockJoinParamPtrs{}
+	}
+	mmJoin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmJoin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmJoin
+}
+
+// ExpectEntityIDParam2 sets up expected param entityID for Service.Join
+func (mmJoin *mServiceMockJoin) ExpectEntityIDParam2(entityID uuid.UUID) *mServiceMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &ServiceMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.params != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Expect")
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs == nil {
+		mmJoin.defaultExpectation.paramPtrs = &ServiceMockJoinParamPtrs{}
+	}
+	mmJoin.defaultExpectation.paramPtrs.entityID = &entityID
+	mmJoin.defaultExpectation.expectationOrigins.originEntityID = minimock.CallerInfo(1)
+
+	return mmJoin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Join
+func (mmJoin *mServiceMockJoin) Inspect(f func(ctx context.Context, entityID uuid.UUID)) *mServiceMockJoin {
+	if mmJoin.mock.inspectFuncJoin != nil {
+		mmJoin.mock.t.Fatalf("Inspect function is already set for ServiceMock.Join")
+	}
+
+	mmJoin.mock.inspectFuncJoin = f
+
+	return mmJoin
+}
+
+// Return sets up results that will be returned by Service.Join
+func (mmJoin *mServiceMockJoin) Return(cp1 *presence.Client, err error) *ServiceMock {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &ServiceMockJoinExpectation{mock: mmJoin.mock}
+	}
+	mmJoin.defaultExpectation.results = &ServiceMockJoinResults{cp1, err}
+	mmJoin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmJoin.mock
+}
+
+// Set uses given function f to mock the Service.Join method
+func (mmJoin *mServiceMockJoin) Set(f func(ctx context.Context, entityID uuid.UUID) (cp1 *presence.Client, err error)) *ServiceMock {
+	if mmJoin.defaultExpectation != nil {
+		mmJoin.mock.t.Fatalf("Default expectation is already set for the Service.Join method")
+	}
+
+	if len(mmJoin.expectations) > 0 {
+		mmJoin.mock.t.Fatalf("Some expectations are already set for the Service.Join method")
+	}
+
+	mmJoin.mock.funcJoin = f
+	mmJoin.mock.funcJoinOrigin = minimock.CallerInfo(1)
+	return mmJoin.mock
+}
+
+// When sets expectation for the Service.Join which will trigger the result defined by the following
+// Then helper
+func (mmJoin *mServiceMockJoin) When(ctx context.Context, entityID uuid.UUID) *ServiceMockJoinExpectation {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("ServiceMock.Join mock is already set by Set")
+	}
+
+	expectation := &ServiceMockJoinExpectation{
+		mock:               mmJoin.mock,
+		params:             &ServiceMockJoinParams{ctx, entityID},
+		expectationOrigins: ServiceMockJoinExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmJoin.expectations = append(mmJoin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Join return parameters for the expectation previously defined by the When method
+func (e *ServiceMockJoinExpectation) Then(cp1 *presence.Client, err error) *ServiceMock {
+	e.results = &ServiceMockJoinResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.Join should be invoked
+func (mmJoin *mServiceMockJoin) Times(n uint64) *mServiceMockJoin {
+	if n == 0 {
+		mmJoin.mock.t.Fatalf("Times of ServiceMock.Join mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmJoin.expectedInvocations, n)
+	mmJoin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmJoin
+}
+
+func (mmJoin *mServiceMockJoin) invocationsDone() bool {
+	if len(mmJoin.expectations) == 0 && mmJoin.defaultExpectation == nil && mmJoin.mock.funcJoin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmJoin.mock.afterJoinCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmJoin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Join implements mm_http.Service
+func (mmJoin *ServiceMock) Join(ctx context.Context, entityID uuid.UUID) (cp1 *presence.Client, err error) {
+	mm_atomic.AddUint64(&mmJoin.beforeJoinCounter, 1)
+	defer mm_atomic.AddUint64(&mmJoin.afterJoinCounter, 1)
+
+	mmJoin.t.Helper()
+
+	if mmJoin.inspectFuncJoin != nil {
+		mmJoin.inspectFuncJoin(ctx, entityID)
+	}
+
+	mm_params := ServiceMockJoinParams{ctx, entityID}
+
+	// Record call args
+	mmJoin.JoinMock.mutex.Lock()
+	mmJoin.JoinMock.callArgs = append(mmJoin.JoinMock.callArgs, &mm_params)
+	mmJoin.JoinMock.mutex.Unlock()
+
+	for _, e := range mmJoin.JoinMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmJoin.JoinMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmJoin.JoinMock.defaultExpectation.Counter, 1)
+		mm_want := mmJoin.JoinMock.defaultExpectation.params
+		mm_want_ptrs := mmJoin.JoinMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockJoinParams{ctx, entityID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmJoin.t.Errorf("ServiceMock.Join got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmJoin.JoinMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.entityID != nil && !minimock.Equal(*mm_want_ptrs.entityID, mm_got.entityID) {
+				mmJoin.t.Errorf("ServiceMock.Join got unexpected parameter entityID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmJoin.JoinMock.defaultExpectation.expectationOrigins.originEntityID, *mm_want_ptrs.entityID, mm_got.entityID, minimock.Diff(*mm_want_ptrs.entityID, mm_got.entityID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmJoin.t.Errorf("ServiceMock.Join got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmJoin.JoinMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmJoin.JoinMock.defaultExpectation.results
+		if mm_results == nil {
+			mmJoin.t.Fatal("No results are set for the ServiceMock.Join")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmJoin.funcJoin != nil {
+		return mmJoin.funcJoin(ctx, entityID)
+	}
+	mmJoin.t.Fatalf("Unexpected call to ServiceMock.Join. %v %v", ctx, entityID)
+	return
+}
+
+// JoinAfterCounter returns a count of finished ServiceMock.Join invocations
+func (mmJoin *ServiceMock) JoinAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.afterJoinCounter)
+}
+
+// JoinBeforeCounter returns a count of ServiceMock.Join invocations
+func (mmJoin *ServiceMock) JoinBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.beforeJoinCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Join.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmJoin *mServiceMockJoin) Calls() []*ServiceMockJoinParams {
+	mmJoin.mutex.RLock()
+
+	argCopy := make([]*ServiceMockJoinParams, len(mmJoin.callArgs))
+	copy(argCopy, mmJoin.callArgs)
+
+	mmJoin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockJoinDone returns true if the count of the Join invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockJoinDone() bool {
+	if m.JoinMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.JoinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.JoinMock.invocationsDone()
+}
+
+// MinimockJoinInspect logs each unmet expectation
+func (m *ServiceMock) MinimockJoinInspect() {
+	for _, e := range m.JoinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Join at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterJoinCounter := mm_atomic.LoadUint64(&m.afterJoinCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.JoinMock.defaultExpectation != nil && afterJoinCounter < 1 {
+		if m.JoinMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Join at\n%s", m.JoinMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Join at\n%s with params: %#v", m.JoinMock.defaultExpectation.expectationOrigins.origin, *m.JoinMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcJoin != nil && afterJoinCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Join at\n%s", m.funcJoinOrigin)
+	}
+
+	if !m.JoinMock.invocationsDone() && afterJoinCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Join at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.JoinMock.expectedInvocations), m.JoinMock.expectedInvocationsOrigin, afterJoinCounter)
+	}
+}
+
+type mServiceMockLeave struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockLeaveExpectation
+	expectations       []*ServiceMockLeaveExpectation
+
+	callArgs []*ServiceMockLeaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockLeaveExpectation specifies expectation struct of the Service.Leave
+type ServiceMockLeaveExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockLeaveParams
+	paramPtrs          *ServiceMockLeaveParamPtrs
+	expectationOrigins ServiceMockLeaveExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// ServiceMockLeaveParams contains parameters of the Service.Leave
+type ServiceMockLeaveParams struct {
+	ctx context.Context
+	c   *presence.Client
+}
+
+// ServiceMockLeaveParamPtrs contains pointers to parameters of the Service.Leave
+type ServiceMockLeaveParamPtrs struct {
+	ctx *context.Context
+	c   **presence.Client
+}
+
+// ServiceMockLeaveOrigins contains origins of expectations of the Service.Leave
+type ServiceMockLeaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originC   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeave *mServiceMockLeave) Optional() *mServiceMockLeave {
+	mmLeave.optional = true
+	return mmLeave
+}
+
+// Expect sets up expected params for Service.Leave
+func (mmLeave *mServiceMockLeave) Expect(ctx context.Context, c *presence.Client) *mServiceMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &ServiceMockLeaveExpectation{}
+	}
+
+	if mmLeave.defaultExpectation.paramPtrs != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by ExpectParams functions")
+	}
+
+	mmLeave.defaultExpectation.params = &ServiceMockLeaveParams{ctx, c}
+	mmLeave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLeave.expectations {
+		if minimock.Equal(e.params, mmLeave.defaultExpectation.params) {
+			mmLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeave.defaultExpectation.params)
+		}
+	}
+
+	return mmLeave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Leave
+func (mmLeave *mServiceMockLeave) ExpectCtxParam1(ctx context.Context) *mServiceMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &ServiceMockLeaveExpectation{}
+	}
+
+	if mmLeave.defaultExpectation.params != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Expect")
+	}
+
+	if mmLeave.defaultExpectation.paramPtrs == nil {
+		mmLeave.defaultExpectation.paramPtrs = &ServiceMockLeaveParamPtrs{}
+	}
+	mmLeave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLeave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLeave
+}
+
+// ExpectCParam2 sets up expected param c for Service.Leave
+func (mmLeave *mServiceMockLeave) ExpectCParam2(c *presence.Client) *mServiceMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &ServiceMockLeaveExpectation{}
+	}
+
+	if mmLeave.defaultExpectation.params != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Expect")
+	}
+
+	if mmLeave.defaultExpectation.paramPtrs == nil {
+		mmLeave.defaultExpectation.paramPtrs = &ServiceMockLeaveParamPtrs{}
+	}
+	mmLeave.defaultExpectation.paramPtrs.c = &c
+	mmLeave.defaultExpectation.expectationOrigins.originC = minimock.CallerInfo(1)
+
+	return mmLeave
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Leave
+func (mmLeave *mServiceMockLeave) Inspect(f func(ctx context.Context, c *presence.Client)) *mServiceMockLeave {
+	if mmLeave.mock.inspectFuncLeave != nil {
+		mmLeave.mock.t.Fatalf("Inspect function is already set for ServiceMock.Leave")
+	}
+
+	mmLeave.mock.inspectFuncLeave = f
+
+	return mmLeave
+}
+
+// Return sets up results that will be returned by Service.Leave
+func (mmLeave *mServiceMockLeave) Return() *ServiceMock {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &ServiceMockLeaveExpectation{mock: mmLeave.mock}
+	}
+
+	mmLeave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeave.mock
+}
+
+// Set uses given function f to mock the Service.Leave method
+func (mmLeave *mServiceMockLeave) Set(f func(ctx context.Context, c *presence.Client)) *ServiceMock {
+	if mmLeave.defaultExpectation != nil {
+		mmLeave.mock.t.Fatalf("Default expectation is already set for the Service.Leave method")
+	}
+
+	if len(mmLeave.expectations) > 0 {
+		mmLeave.mock.t.Fatalf("Some expectations are already set for the Service.Leave method")
+	}
+
+	mmLeave.mock.funcLeave = f
+	mmLeave.mock.funcLeaveOrigin = minimock.CallerInfo(1)
+	return mmLeave.mock
+}
+
+// When sets expectation for the Service.Leave which will trigger the result defined by the following
+// Then helper
+func (mmLeave *mServiceMockLeave) When(ctx context.Context, c *presence.Client) *ServiceMockLeaveExpectation {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("ServiceMock.Leave mock is already set by Set")
+	}
+
+	expectation := &ServiceMockLeaveExpectation{
+		mock:               mmLeave.mock,
+		params:             &ServiceMockLeaveParams{ctx, c},
+		expectationOrigins: ServiceMockLeaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLeave.expectations = append(mmLeave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Leave return parameters for the expectation previously defined by the When method
+
+func (e *ServiceMockLeaveExpectation) Then() *ServiceMock {
+	return e.mock
+}
+
+// Times sets number of times Service.Leave should be invoked
+func (mmLeave *mServiceMockLeave) Times(n uint64) *mServiceMockLeave {
+	if n == 0 {
+		mmLeave.mock.t.Fatalf("Times of ServiceMock.Leave mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeave.expectedInvocations, n)
+	mmLeave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeave
+}
+
+func (mmLeave *mServiceMockLeave) invocationsDone() bool {
+	if len(mmLeave.expectations) == 0 && mmLeave.defaultExpectation == nil && mmLeave.mock.funcLeave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeave.mock.afterLeaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Leave implements mm_http.Service
+func (mmLeave *ServiceMock) Leave(ctx context.Context, c *presence.Client) {
+	mm_atomic.AddUint64(&mmLeave.beforeLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeave.afterLeaveCounter, 1)
+
+	mmLeave.t.Helper()
+
+	if mmLeave.inspectFuncLeave != nil {
+		mmLeave.inspectFuncLeave(ctx, c)
+	}
+
+	mm_params := ServiceMockLeaveParams{ctx, c}
+
+	// Record call args
+	mmLeave.LeaveMock.mutex.Lock()
+	mmLeave.LeaveMock.callArgs = append(mmLeave.LeaveMock.callArgs, &mm_params)
+	mmLeave.LeaveMock.mutex.Unlock()
+
+	for _, e := range mmLeave.LeaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmLeave.LeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeave.LeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeave.LeaveMock.defaultExpectation.params
+		mm_want_ptrs := mmLeave.LeaveMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockLeaveParams{ctx, c}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLeave.t.Errorf("ServiceMock.Leave got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeave.LeaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.c != nil && !minimock.Equal(*mm_want_ptrs.c, mm_got.c) {
+				mmLeave.t.Errorf("ServiceMock.Leave got unexpected parameter c, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeave.LeaveMock.defaultExpectation.expectationOrigins.originC, *mm_want_ptrs.c, mm_got.c, minimock.Diff(*mm_want_ptrs.c, mm_got.c))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeave.t.Errorf("ServiceMock.Leave got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLeave.LeaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmLeave.funcLeave != nil {
+		mmLeave.funcLeave(ctx, c)
+		return
+	}
+	mmLeave.t.Fatalf("Unexpected call to ServiceMock.Leave. %v %v", ctx, c)
+
+}
+
+// LeaveAfterCounter returns a count of finished ServiceMock.Leave invocations
+func (mmLeave *ServiceMock) LeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.afterLeaveCounter)
+}
+
+// LeaveBeforeCounter returns a count of ServiceMock.Leave invocations
+func (mmLeave *ServiceMock) LeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.beforeLeaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Leave.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeave *mServiceMockLeave) Calls() []*ServiceMockLeaveParams {
+	mmLeave.mutex.RLock()
+
+	argCopy := make([]*ServiceMockLeaveParams, len(mmLeave.callArgs))
+	copy(argCopy, mmLeave.callArgs)
+
+	mmLeave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveDone returns true if the count of the Leave invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockLeaveDone() bool {
+	if m.LeaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveMock.invocationsDone()
+}
+
+// MinimockLeaveInspect logs each unmet expectation
+func (m *ServiceMock) MinimockLeaveInspect() {
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Leave at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLeaveCounter := mm_atomic.LoadUint64(&m.afterLeaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.defaultExpectation != nil && afterLeaveCounter < 1 {
+		if m.LeaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Leave at\n%s", m.LeaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Leave at\n%s with params: %#v", m.LeaveMock.defaultExpectation.expectationOrigins.origin, *m.LeaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeave != nil && afterLeaveCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Leave at\n%s", m.funcLeaveOrigin)
+	}
+
+	if !m.LeaveMock.invocationsDone() && afterLeaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Leave at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveMock.expectedInvocations), m.LeaveMock.expectedInvocationsOrigin, afterLeaveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockHandleInspect()
+
+			m.MinimockJoinInspect()
+
+			m.MinimockLeaveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockHandleDone() &&
+		m.MinimockJoinDone() &&
+		m.MinimockLeaveDone()
+}
@@ -0,0 +1,1121 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/presence/usecase.Hub -o hub_mock.go -n HubMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// HubMock implements mm_usecase.Hub
+type HubMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcHandle          func(c *presence.Client, msg presence.InboundMessage) (err error)
+	funcHandleOrigin    string
+	inspectFuncHandle   func(c *presence.Client, msg presence.InboundMessage)
+	afterHandleCounter  uint64
+	beforeHandleCounter uint64
+	HandleMock          mHubMockHandle
+
+	funcJoin          func(entityID uuid.UUID, userID uuid.UUID, canEdit bool) (cp1 *presence.Client, err error)
+	funcJoinOrigin    string
+	inspectFuncJoin   func(entityID uuid.UUID, userID uuid.UUID, canEdit bool)
+	afterJoinCounter  uint64
+	beforeJoinCounter uint64
+	JoinMock          mHubMockJoin
+
+	funcLeave          func(c *presence.Client)
+	funcLeaveOrigin    string
+	inspectFuncLeave   func(c *presence.Client)
+	afterLeaveCounter  uint64
+	beforeLeaveCounter uint64
+	LeaveMock          mHubMockLeave
+}
+
+// NewHubMock returns a mock for mm_usecase.Hub
+func NewHubMock(t minimock.Tester) *HubMock {
+	m := &HubMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.HandleMock = mHubMockHandle{mock: m}
+	m.HandleMock.callArgs = []*HubMockHandleParams{}
+
+	m.JoinMock = mHubMockJoin{mock: m}
+	m.JoinMock.callArgs = []*HubMockJoinParams{}
+
+	m.LeaveMock = mHubMockLeave{mock: m}
+	m.LeaveMock.callArgs = []*HubMockLeaveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mHubMockHandle struct {
+	optional           bool
+	mock               *HubMock
+	defaultExpectation *HubMockHandleExpectation
+	expectations       []*HubMockHandleExpectation
+
+	callArgs []*HubMockHandleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HubMockHandleExpectation specifies expectation struct of the Hub.Handle
+type HubMockHandleExpectation struct {
+	mock               *HubMock
+	params             *HubMockHandleParams
+	paramPtrs          *HubMockHandleParamPtrs
+	expectationOrigins HubMockHandleExpectationOrigins
+	results            *HubMockHandleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HubMockHandleParams contains parameters of the Hub.Handle
+type HubMockHandleParams struct {
+	c   *presence.Client
+	msg presence.InboundMessage
+}
+
+// HubMockHandleParamPtrs contains pointers to parameters of the Hub.Handle
+type HubMockHandleParamPtrs struct {
+	c   **presence.Client
+	msg *presence.InboundMessage
+}
+
+// HubMockHandleResults contains results of the Hub.Handle
+type HubMockHandleResults struct {
+	err error
+}
+
+// HubMockHandleOrigins contains origins of expectations of the Hub.Handle
+type HubMockHandleExpectationOrigins struct {
+	origin    string
+	originC   string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHandle *mHubMockHandle) Optional() *mHubMockHandle {
+	mmHandle.optional = true
+	return mmHandle
+}
+
+// Expect sets up expected params for Hub.Handle
+func (mmHandle *mHubMockHandle) Expect(c *presence.Client, msg presence.InboundMessage) *mHubMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &HubMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by ExpectParams functions")
+	}
+
+	mmHandle.defaultExpectation.params = &HubMockHandleParams{c, msg}
+	mmHandle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHandle.expectations {
+		if minimock.Equal(e.params, mmHandle.defaultExpectation.params) {
+			mmHandle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHandle.defaultExpectation.params)
+		}
+	}
+
+	return mmHandle
+}
+
+// ExpectCParam1 sets up expected param c for Hub.Handle
+func (mmHandle *mHubMockHandle) ExpectCParam1(c *presence.Client) *mHubMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &HubMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.params != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Expect")
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs == nil {
+		mmHandle.defaultExpectation.paramPtrs = &HubMockHandleParamPtrs{}
+	}
+	mmHandle.defaultExpectation.paramPtrs.c = &c
+	mmHandle.defaultExpectation.expectationOrigins.originC = minimock.CallerInfo(1)
+
+	return mmHandle
+}
+
+// ExpectMsgParam2 sets up expected param msg for Hub.Handle
+func (mmHandle *mHubMockHandle) ExpectMsgParam2(msg presence.InboundMessage) *mHubMockHandle {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &HubMockHandleExpectation{}
+	}
+
+	if mmHandle.defaultExpectation.params != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Expect")
+	}
+
+	if mmHandle.defaultExpectation.paramPtrs == nil {
+		mmHandle.defaultExpectation.paramPtrs = &HubMockHandleParamPtrs{}
+	}
+	mmHandle.defaultExpectation.paramPtrs.msg = &msg
+	mmHandle.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmHandle
+}
+
+// Inspect accepts an inspector function that has same arguments as the Hub.Handle
+func (mmHandle *mHubMockHandle) Inspect(f func(c *presence.Client, msg presence.InboundMessage)) *mHubMockHandle {
+	if mmHandle.mock.inspectFuncHandle != nil {
+		mmHandle.mock.t.Fatalf("Inspect function is already set for HubMock.Handle")
+	}
+
+	mmHandle.mock.inspectFuncHandle = f
+
+	return mmHandle
+}
+
+// Return sets up results that will be returned by Hub.Handle
+func (mmHandle *mHubMockHandle) Return(err error) *HubMock {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Set")
+	}
+
+	if mmHandle.defaultExpectation == nil {
+		mmHandle.defaultExpectation = &HubMockHandleExpectation{mock: mmHandle.mock}
+	}
+	mmHandle.defaultExpectation.results = &HubMockHandleResults{err}
+	mmHandle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHandle.mock
+}
+
+// Set uses given function f to mock the Hub.Handle method
+func (mmHandle *mHubMockHandle) Set(f func(c *presence.Client, msg presence.InboundMessage) (err error)) *HubMock {
+	if mmHandle.defaultExpectation != nil {
+		mmHandle.mock.t.Fatalf("Default expectation is already set for the Hub.Handle method")
+	}
+
+	if len(mmHandle.expectations) > 0 {
+		mmHandle.mock.t.Fatalf("Some expectations are already set for the Hub.Handle method")
+	}
+
+	mmHandle.mock.funcHandle = f
+	mmHandle.mock.funcHandleOrigin = minimock.CallerInfo(1)
+	return mmHandle.mock
+}
+
+// When sets expectation for the Hub.Handle which will trigger the result defined by the following
+// Then helper
+func (mmHandle *mHubMockHandle) When(c *presence.Client, msg presence.InboundMessage) *HubMockHandleExpectation {
+	if mmHandle.mock.funcHandle != nil {
+		mmHandle.mock.t.Fatalf("HubMock.Handle mock is already set by Set")
+	}
+
+	expectation := &HubMockHandleExpectation{
+		mock:               mmHandle.mock,
+		params:             &HubMockHandleParams{c, msg},
+		expectationOrigins: HubMockHandleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHandle.expectations = append(mmHandle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Hub.Handle return parameters for the expectation previously defined by the When method
+func (e *HubMockHandleExpectation) Then(err error) *HubMock {
+	e.results = &HubMockHandleResults{err}
+	return e.mock
+}
+
+// Times sets number of times Hub.Handle should be invoked
+func (mmHandle *mHubMockHandle) Times(n uint64) *mHubMockHandle {
+	if n == 0 {
+		mmHandle.mock.t.Fatalf("Times of HubMock.Handle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHandle.expectedInvocations, n)
+	mmHandle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHandle
+}
+
+func (mmHandle *mHubMockHandle) invocationsDone() bool {
+	if len(mmHandle.expectations) == 0 && mmHandle.defaultExpectation == nil && mmHandle.mock.funcHandle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHandle.mock.afterHandleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHandle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Handle implements mm_usecase.Hub
+func (mmHandle *HubMock) Handle(c *presence.Client, msg presence.InboundMessage) (err error) {
+	mm_atomic.AddUint64(&mmHandle.beforeHandleCounter, 1)
+	defer mm_atomic.AddUint64(&mmHandle.afterHandleCounter, 1)
+
+	mmHandle.t.Helper()
+
+	if mmHandle.inspectFuncHandle != nil {
+		mmHandle.inspectFuncHandle(c, msg)
+	}
+
+	mm_params := HubMockHandleParams{c, msg}
+
+	// Record call args
+	mmHandle.HandleMock.mutex.Lock()
+	mmHandle.HandleMock.callArgs = append(mmHandle.HandleMock.callArgs, &mm_params)
+	mmHandle.HandleMock.mutex.Unlock()
+
+	for _, e := range mmHandle.HandleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHandle.HandleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHandle.HandleMock.defaultExpectation.Counter, 1)
+		mm_want := mmHandle.HandleMock.defaultExpectation.params
+		mm_want_ptrs := mmHandle.HandleMock.defaultExpectation.paramPtrs
+
+		mm_got := HubMockHandleParams{c, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.c != nil && !minimock.Equal(*mm_want_ptrs.c, mm_got.c) {
+				mmHandle.t.Errorf("HubMock.Handle got unexpected parameter c, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandle.HandleMock.defaultExpectation.expectationOrigins.originC, *mm_want_ptrs.c, mm_got.c, minimock.Diff(*mm_want_ptrs.c, mm_got.c))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmHandle.t.Errorf("HubMock.Handle got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandle.HandleMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHandle.t.Errorf("HubMock.Handle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHandle.HandleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHandle.HandleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHandle.t.Fatal("No results are set for the HubMock.Handle")
+		}
+		return (*mm_results).err
+	}
+	if mmHandle.funcHandle != nil {
+		return mmHandle.funcHandle(c, msg)
+	}
+	mmHandle.t.Fatalf("Unexpected call to HubMock.Handle. %v %v", c, msg)
+	return
+}
+
+// HandleAfterCounter returns a count of finished HubMock.Handle invocations
+func (mmHandle *HubMock) HandleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandle.afterHandleCounter)
+}
+
+// HandleBeforeCounter returns a count of HubMock.Handle invocations
+func (mmHandle *HubMock) HandleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandle.beforeHandleCounter)
+}
+
+// Calls returns a list of arguments used in each call to HubMock.Handle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHandle *mHubMockHandle) Calls() []*HubMockHandleParams {
+	mmHandle.mutex.RLock()
+
+	argCopy := make([]*HubMockHandleParams, len(mmHandle.callArgs))
+	copy(argCopy, mmHandle.callArgs)
+
+	mmHandle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHandleDone returns true if the count of the Handle invocations corresponds
+// the number of defined expectations
+func (m *HubMock) MinimockHandleDone() bool {
+	if m.HandleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HandleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HandleMock.invocationsDone()
+}
+
+// MinimockHandleInspect logs each unmet expectation
+func (m *HubMock) MinimockHandleInspect() {
+	for _, e := range m.HandleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HubMock.Handle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHandleCounter := mm_atomic.LoadUint64(&m.afterHandleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HandleMock.defaultExpectation != nil && afterHandleCounter < 1 {
+		if m.HandleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HubMock.Handle at\n%s", m.HandleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HubMock.Handle at\n%s with params: %#v", m.HandleMock.defaultExpectation.expectationOrigins.origin, *m.HandleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHandle != nil && afterHandleCounter < 1 {
+		m.t.Errorf("Expected call to HubMock.Handle at\n%s", m.funcHandleOrigin)
+	}
+
+	if !m.HandleMock.invocationsDone() && afterHandleCounter > 0 {
+		m.t.Errorf("Expected %d calls to HubMock.Handle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HandleMock.expectedInvocations), m.HandleMock.expectedInvocationsOrigin, afterHandleCounter)
+	}
+}
+
+type mHubMockJoin struct {
+	optional           bool
+	mock               *HubMock
+	defaultExpectation *HubMockJoinExpectation
+	expectations       []*HubMockJoinExpectation
+
+	callArgs []*HubMockJoinParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HubMockJoinExpectation specifies expectation struct of the Hub.Join
+type HubMockJoinExpectation struct {
+	mock               *HubMock
+	params             *HubMockJoinParams
+	paramPtrs          *HubMockJoinParamPtrs
+	expectationOrigins HubMockJoinExpectationOrigins
+	results            *HubMockJoinResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HubMockJoinParams contains parameters of the Hub.Join
+type HubMockJoinParams struct {
+	entityID uuid.UUID
+	userID   uuid.UUID
+	canEdit  bool
+}
+
+// HubMockJoinParamPtrs contains pointers to parameters of the Hub.Join
+type HubMockJoinParamPtrs struct {
+	entityID *uuid.UUID
+	userID   *uuid.UUID
+	canEdit  *bool
+}
+
+// HubMockJoinResults contains results of the Hub.Join
+type HubMockJoinResults struct {
+	cp1 *presence.Client
+	err error
+}
+
+// HubMockJoinOrigins contains origins of expectations of the Hub.Join
+type HubMockJoinExpectationOrigins struct {
+	origin         string
+	originEntityID string
+	originUserID   string
+	originCanEdit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmJoin *mHubMockJoin) Optional() *mHubMockJoin {
+	mmJoin.optional = true
+	return mmJoin
+}
+
+// Expect sets up expected params for Hub.Join
+func (mmJoin *mHubMockJoin) Expect(entityID uuid.UUID, userID uuid.UUID, canEdit bool) *mHubMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &HubMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by ExpectParams functions")
+	}
+
+	mmJoin.defaultExpectation.params = &HubMockJoinParams{entityID, userID, canEdit}
+	mmJoin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmJoin.expectations {
+		if minimock.Equal(e.params, mmJoin.defaultExpectation.params) {
+			mmJoin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmJoin.defaultExpectation.params)
+		}
+	}
+
+	return mmJoin
+}
+
+// ExpectEntityIDParam1 sets up expected param entityID for Hub.Join
+func (mmJoin *mHubMockJoin) ExpectEntityIDParam1(entityID uuid.UUID) *mHubMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &HubMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.params != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Expect")
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs == nil {
+		mmJoin.defaultExpectation.paramPtrs = &HubMockJoinParamPtrs{}
+	}
+	mmJoin.defaultExpectation.paramPtrs.entityID = &entityID
+	mmJoin.defaultExpectation.expectationOrigins.originEntityID = minimock.CallerInfo(1)
+
+	return mmJoin
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Hub.Join
+func (mmJoin *mHubMockJoin) ExpectUserIDParam2(userID uuid.UUID) *mHubMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &HubMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.params != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Expect")
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs == nil {
+		mmJoin.defaultExpectation.paramPtrs = &HubMockJoinParamPtrs{}
+	}
+	mmJoin.defaultExpectation.paramPtrs.userID = &userID
+	mmJoin.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmJoin
+}
+
+// ExpectCanEditParam3 sets up expected param canEdit for Hub.Join
+func (mmJoin *mHubMockJoin) ExpectCanEditParam3(canEdit bool) *mHubMockJoin {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &HubMockJoinExpectation{}
+	}
+
+	if mmJoin.defaultExpectation.params != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Expect")
+	}
+
+	if mmJoin.defaultExpectation.paramPtrs == nil {
+		mmJoin.defaultExpectation.paramPtrs = &HubMockJoinParamPtrs{}
+	}
+	mmJoin.defaultExpectation.paramPtrs.canEdit = &canEdit
+	mmJoin.defaultExpectation.expectationOrigins.originCanEdit = minimock.CallerInfo(1)
+
+	return mmJoin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Hub.Join
+func (mmJoin *mHubMockJoin) Inspect(f func(entityID uuid.UUID, userID uuid.UUID, canEdit bool)) *mHubMockJoin {
+	if mmJoin.mock.inspectFuncJoin != nil {
+		mmJoin.mock.t.Fatalf("Inspect function is already set for HubMock.Join")
+	}
+
+	mmJoin.mock.inspectFuncJoin = f
+
+	return mmJoin
+}
+
+// Return sets up results that will be returned by Hub.Join
+func (mmJoin *mHubMockJoin) Return(cp1 *presence.Client, err error) *HubMock {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	if mmJoin.defaultExpectation == nil {
+		mmJoin.defaultExpectation = &HubMockJoinExpectation{mock: mmJoin.mock}
+	}
+	mmJoin.defaultExpectation.results = &HubMockJoinResults{cp1, err}
+	mmJoin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmJoin.mock
+}
+
+// Set uses given function f to mock the Hub.Join method
+func (mmJoin *mHubMockJoin) Set(f func(entityID uuid.UUID, userID uuid.UUID, canEdit bool) (cp1 *presence.Client, err error)) *HubMock {
+	if mmJoin.defaultExpectation != nil {
+		mmJoin.mock.t.Fatalf("Default expectation is already set for the Hub.Join method")
+	}
+
+	if len(mmJoin.expectations) > 0 {
+		mmJoin.mock.t.Fatalf("Some expectations are already set for the Hub.Join method")
+	}
+
+	mmJoin.mock.funcJoin = f
+	mmJoin.mock.funcJoinOrigin = minimock.CallerInfo(1)
+	return mmJoin.mock
+}
+
+// When sets expectation for the Hub.Join which will trigger the result defined by the following
+// Then helper
+func (mmJoin *mHubMockJoin) When(entityID uuid.UUID, userID uuid.UUID, canEdit bool) *HubMockJoinExpectation {
+	if mmJoin.mock.funcJoin != nil {
+		mmJoin.mock.t.Fatalf("HubMock.Join mock is already set by Set")
+	}
+
+	expectation := &HubMockJoinExpectation{
+		mock:               mmJoin.mock,
+		params:             &HubMockJoinParams{entityID, userID, canEdit},
+		expectationOrigins: HubMockJoinExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmJoin.expectations = append(mmJoin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Hub.Join return parameters for the expectation previously defined by the When method
+func (e *HubMockJoinExpectation) Then(cp1 *presence.Client, err error) *HubMock {
+	e.results = &HubMockJoinResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Hub.Join should be invoked
+func (mmJoin *mHubMockJoin) Times(n uint64) *mHubMockJoin {
+	if n == 0 {
+		mmJoin.mock.t.Fatalf("Times of HubMock.Join mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmJoin.expectedInvocations, n)
+	mmJoin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmJoin
+}
+
+func (mmJoin *mHubMockJoin) invocationsDone() bool {
+	if len(mmJoin.expectations) == 0 && mmJoin.defaultExpectation == nil && mmJoin.mock.funcJoin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmJoin.mock.afterJoinCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmJoin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Join implements mm_usecase.Hub
+func (mmJoin *HubMock) Join(entityID uuid.UUID, userID uuid.UUID, canEdit bool) (cp1 *presence.Client, err error) {
+	mm_atomic.AddUint64(&mmJoin.beforeJoinCounter, 1)
+	defer mm_atomic.AddUint64(&mmJoin.afterJoinCounter, 1)
+
+	mmJoin.t.Helper()
+
+	if mmJoin.inspectFuncJoin != nil {
+		mmJoin.inspectFuncJoin(entityID, userID, canEdit)
+	}
+
+	mm_params := HubMockJoinParams{entityID, userID, canEdit}
+
+	// Record call args
+	mmJoin.JoinMock.mutex.Lock()
+	mmJoin.JoinMock.callArgs = append(mmJoin.JoinMock.callArgs, &mm_params)
+	mmJoin.JoinMock.mutex.Unlock()
+
+	for _, e := range mmJoin.JoinMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmJoin.JoinMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmJoin.JoinMock.defaultExpectation.Counter, 1)
+		mm_want := mmJoin.JoinMock.defaultExpectation.params
+		mm_want_ptrs := mmJoin.JoinMock.defaultExpectation.paramPtrs
+
+		mm_got := HubMockJoinParams{entityID, userID, canEdit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.entityID != nil && !minimock.Equal(*mm_want_ptrs.entityID, mm_got.entityID) {
+				mmJoin.t.Errorf("HubMock.Join got unexpected parameter entityID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmJoin.JoinMock.defaultExpectation.expectationOrigins.originEntityID, *mm_want_ptrs.entityID, mm_got.entityID, minimock.Diff(*mm_want_ptrs.entityID, mm_got.entityID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmJoin.t.Errorf("HubMock.Join got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmJoin.JoinMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.canEdit != nil && !minimock.Equal(*mm_want_ptrs.canEdit, mm_got.canEdit) {
+				mmJoin.t.Errorf("HubMock.Join got unexpected parameter canEdit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmJoin.JoinMock.defaultExpectation.expectationOrigins.originCanEdit, *mm_want_ptrs.canEdit, mm_got.canEdit, minimock.Diff(*mm_want_ptrs.canEdit, mm_got.canEdit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmJoin.t.Errorf("HubMock.Join got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmJoin.JoinMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmJoin.JoinMock.defaultExpectation.results
+		if mm_results == nil {
+			mmJoin.t.Fatal("No results are set for the HubMock.Join")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmJoin.funcJoin != nil {
+		return mmJoin.funcJoin(entityID, userID, canEdit)
+	}
+	mmJoin.t.Fatalf("Unexpected call to HubMock.Join. %v %v %v", entityID, userID, canEdit)
+	return
+}
+
+// JoinAfterCounter returns a count of finished HubMock.Join invocations
+func (mmJoin *HubMock) JoinAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.afterJoinCounter)
+}
+
+// JoinBeforeCounter returns a count of HubMock.Join invocations
+func (mmJoin *HubMock) JoinBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmJoin.beforeJoinCounter)
+}
+
+// Calls returns a list of arguments used in each call to HubMock.Join.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmJoin *mHubMockJoin) Calls() []*HubMockJoinParams {
+	mmJoin.mutex.RLock()
+
+	argCopy := make([]*HubMockJoinParams, len(mmJoin.callArgs))
+	copy(argCopy, mmJoin.callArgs)
+
+	mmJoin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockJoinDone returns true if the count of the Join invocations corresponds
+// the number of defined expectations
+func (m *HubMock) MinimockJoinDone() bool {
+	if m.JoinMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.JoinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.JoinMock.invocationsDone()
+}
+
+// MinimockJoinInspect logs each unmet expectation
+func (m *HubMock) MinimockJoinInspect() {
+	for _, e := range m.JoinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HubMock.Join at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterJoinCounter := mm_atomic.LoadUint64(&m.afterJoinCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.JoinMock.defaultExpectation != nil && afterJoinCounter < 1 {
+		if m.JoinMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HubMock.Join at\n%s", m.JoinMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HubMock.Join at\n%s with params: %#v", m.JoinMock.defaultExpectation.expectationOrigins.origin, *m.JoinMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcJoin != nil && afterJoinCounter < 1 {
+		m.t.Errorf("Expected call to HubMock.Join at\n%s", m.funcJoinOrigin)
+	}
+
+	if !m.JoinMock.invocationsDone() && afterJoinCounter > 0 {
+		m.t.Errorf("Expected %d calls to HubMock.Join at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.JoinMock.expectedInvocations), m.JoinMock.expectedInvocationsOrigin, afterJoinCounter)
+	}
+}
+
+type mHubMockLeave struct {
+	optional           bool
+	mock               *HubMock
+	defaultExpectation *HubMockLeaveExpectation
+	expectations       []*HubMockLeaveExpectation
+
+	callArgs []*HubMockLeaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HubMockLeaveExpectation specifies expectation struct of the Hub.Leave
+type HubMockLeaveExpectation struct {
+	mock               *HubMock
+	params             *HubMockLeaveParams
+	paramPtrs          *HubMockLeaveParamPtrs
+	expectationOrigins HubMockLeaveExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// HubMockLeaveParams contains parameters of the Hub.Leave
+type HubMockLeaveParams struct {
+	c *presence.Client
+}
+
+// HubMockLeaveParamPtrs contains pointers to parameters of the Hub.Leave
+type HubMockLeaveParamPtrs struct {
+	c **presence.Client
+}
+
+// HubMockLeaveOrigins contains origins of expectations of the Hub.Leave
+type HubMockLeaveExpectationOrigins struct {
+	origin  string
+	originC string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeave *mHubMockLeave) Optional() *mHubMockLeave {
+	mmLeave.optional = true
+	return mmLeave
+}
+
+// Expect sets up expected params for Hub.Leave
+func (mmLeave *mHubMockLeave) Expect(c *presence.Client) *mHubMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &HubMockLeaveExpectation{}
+	}
+
+	if mmLeave.defaultExpectation.paramPtrs != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by ExpectParams functions")
+	}
+
+	mmLeave.defaultExpectation.params = &HubMockLeaveParams{c}
+	mmLeave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLeave.expectations {
+		if minimock.Equal(e.params, mmLeave.defaultExpectation.params) {
+			mmLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeave.defaultExpectation.params)
+		}
+	}
+
+	return mmLeave
+}
+
+// ExpectCParam1 sets up expected param c for Hub.Leave
+func (mmLeave *mHubMockLeave) ExpectCParam1(c *presence.Client) *mHubMockLeave {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &HubMockLeaveExpectation{}
+	}
+
+	if mmLeave.defaultExpectation.params != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by Expect")
+	}
+
+	if mmLeave.defaultExpectation.paramPtrs == nil {
+		mmLeave.defaultExpectation.paramPtrs = &HubMockLeaveParamPtrs{}
+	}
+	mmLeave.defaultExpectation.paramPtrs.c = &c
+	mmLeave.defaultExpectation.expectationOrigins.originC = minimock.CallerInfo(1)
+
+	return mmLeave
+}
+
+// Inspect accepts an inspector function that has same arguments as the Hub.Leave
+func (mmLeave *mHubMockLeave) Inspect(f func(c *presence.Client)) *mHubMockLeave {
+	if mmLeave.mock.inspectFuncLeave != nil {
+		mmLeave.mock.t.Fatalf("Inspect function is already set for HubMock.Leave")
+	}
+
+	mmLeave.mock.inspectFuncLeave = f
+
+	return mmLeave
+}
+
+// Return sets up results that will be returned by Hub.Leave
+func (mmLeave *mHubMockLeave) Return() *HubMock {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by Set")
+	}
+
+	if mmLeave.defaultExpectation == nil {
+		mmLeave.defaultExpectation = &HubMockLeaveExpectation{mock: mmLeave.mock}
+	}
+
+	mmLeave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeave.mock
+}
+
+// Set uses given function f to mock the Hub.Leave method
+func (mmLeave *mHubMockLeave) Set(f func(c *presence.Client)) *HubMock {
+	if mmLeave.defaultExpectation != nil {
+		mmLeave.mock.t.Fatalf("Default expectation is already set for the Hub.Leave method")
+	}
+
+	if len(mmLeave.expectations) > 0 {
+		mmLeave.mock.t.Fatalf("Some expectations are already set for the Hub.Leave method")
+	}
+
+	mmLeave.mock.funcLeave = f
+	mmLeave.mock.funcLeaveOrigin = minimock.CallerInfo(1)
+	return mmLeave.mock
+}
+
+// When sets expectation for the Hub.Leave which will trigger the result defined by the following
+// Then helper
+func (mmLeave *mHubMockLeave) When(c *presence.Client) *HubMockLeaveExpectation {
+	if mmLeave.mock.funcLeave != nil {
+		mmLeave.mock.t.Fatalf("HubMock.Leave mock is already set by Set")
+	}
+
+	expectation := &HubMockLeaveExpectation{
+		mock:               mmLeave.mock,
+		params:             &HubMockLeaveParams{c},
+		expectationOrigins: HubMockLeaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLeave.expectations = append(mmLeave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Hub.Leave return parameters for the expectation previously defined by the When method
+
+func (e *HubMockLeaveExpectation) Then() *HubMock {
+	return e.mock
+}
+
+// Times sets number of times Hub.Leave should be invoked
+func (mmLeave *mHubMockLeave) Times(n uint64) *mHubMockLeave {
+	if n == 0 {
+		mmLeave.mock.t.Fatalf("Times of HubMock.Leave mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeave.expectedInvocations, n)
+	mmLeave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeave
+}
+
+func (mmLeave *mHubMockLeave) invocationsDone() bool {
+	if len(mmLeave.expectations) == 0 && mmLeave.defaultExpectation == nil && mmLeave.mock.funcLeave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeave.mock.afterLeaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Leave implements mm_usecase.Hub
+func (mmLeave *HubMock) Leave(c *presence.Client) {
+	mm_atomic.AddUint64(&mmLeave.beforeLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeave.afterLeaveCounter, 1)
+
+	mmLeave.t.Helper()
+
+	if mmLeave.inspectFuncLeave != nil {
+		mmLeave.inspectFuncLeave(c)
+	}
+
+	mm_params := HubMockLeaveParams{c}
+
+	// Record call args
+	mmLeave.LeaveMock.mutex.Lock()
+	mmLeave.LeaveMock.callArgs = append(mmLeave.LeaveMock.callArgs, &mm_params)
+	mmLeave.LeaveMock.mutex.Unlock()
+
+	for _, e := range mmLeave.LeaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmLeave.LeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeave.LeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeave.LeaveMock.defaultExpectation.params
+		mm_want_ptrs := mmLeave.LeaveMock.defaultExpectation.paramPtrs
+
+		mm_got := HubMockLeaveParams{c}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.c != nil && !minimock.Equal(*mm_want_ptrs.c, mm_got.c) {
+				mmLeave.t.Errorf("HubMock.Leave got unexpected parameter c, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeave.LeaveMock.defaultExpectation.expectationOrigins.originC, *mm_want_ptrs.c, mm_got.c, minimock.Diff(*mm_want_ptrs.c, mm_got.c))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeave.t.Errorf("HubMock.Leave got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLeave.LeaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmLeave.funcLeave != nil {
+		mmLeave.funcLeave(c)
+		return
+	}
+	mmLeave.t.Fatalf("Unexpected call to HubMock.Leave. %v", c)
+
+}
+
+// LeaveAfterCounter returns a count of finished HubMock.Leave invocations
+func (mmLeave *HubMock) LeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.afterLeaveCounter)
+}
+
+// LeaveBeforeCounter returns a count of HubMock.Leave invocations
+func (mmLeave *HubMock) LeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeave.beforeLeaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to HubMock.Leave.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeave *mHubMockLeave) Calls() []*HubMockLeaveParams {
+	mmLeave.mutex.RLock()
+
+	argCopy := make([]*HubMockLeaveParams, len(mmLeave.callArgs))
+	copy(argCopy, mmLeave.callArgs)
+
+	mmLeave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveDone returns true if the count of the Leave invocations corresponds
+// the number of defined expectations
+func (m *HubMock) MinimockLeaveDone() bool {
+	if m.LeaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveMock.invocationsDone()
+}
+
+// MinimockLeaveInspect logs each unmet expectation
+func (m *HubMock) MinimockLeaveInspect() {
+	for _, e := range m.LeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HubMock.Leave at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLeaveCounter := mm_atomic.LoadUint64(&m.afterLeaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveMock.defaultExpectation != nil && afterLeaveCounter < 1 {
+		if m.LeaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HubMock.Leave at\n%s", m.LeaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HubMock.Leave at\n%s with params: %#v", m.LeaveMock.defaultExpectation.expectationOrigins.origin, *m.LeaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeave != nil && afterLeaveCounter < 1 {
+		m.t.Errorf("Expected call to HubMock.Leave at\n%s", m.funcLeaveOrigin)
+	}
+
+	if !m.LeaveMock.invocationsDone() && afterLeaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to HubMock.Leave at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveMock.expectedInvocations), m.LeaveMock.expectedInvocationsOrigin, afterLeaveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *HubMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockHandleInspect()
+
+			m.MinimockJoinInspect()
+
+			m.MinimockLeaveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *HubMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *HubMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockHandleDone() &&
+		m.MinimockJoinDone() &&
+		m.MinimockLeaveDone()
+}
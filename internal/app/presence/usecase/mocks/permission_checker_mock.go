@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/presence/usecase.PermissionChecker -o permission_checker_mock.go -n PermissionCheckerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// PermissionCheckerMock implements mm_usecase.PermissionChecker
+type PermissionCheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckEntityPermission          func(ctx context.Context, id uuid.UUID, role auth.Role) (err error)
+	funcCheckEntityPermissionOrigin    string
+	inspectFuncCheckEntityPermission   func(ctx context.Context, id uuid.UUID, role auth.Role)
+	afterCheckEntityPermissionCounter  uint64
+	beforeCheckEntityPermissionCounter uint64
+	CheckEntityPermissionMock          mPermissionCheckerMockCheckEntityPermission
+}
+
+// NewPermissionCheckerMock returns a mock for mm_usecase.PermissionChecker
+func NewPermissionCheckerMock(t minimock.Tester) *PermissionCheckerMock {
+	m := &PermissionCheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckEntityPermissionMock = mPermissionCheckerMockCheckEntityPermission{mock: m}
+	m.CheckEntityPermissionMock.callArgs = []*PermissionCheckerMockCheckEntityPermissionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPermissionCheckerMockCheckEntityPermission struct {
+	optional           bool
+	mock               *PermissionCheckerMock
+	defaultExpectation *PermissionCheckerMockCheckEntityPermissionExpectation
+	expectations       []*PermissionCheckerMockCheckEntityPermissionExpectation
+
+	callArgs []*PermissionCheckerMockCheckEntityPermissionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PermissionCheckerMockCheckEntityPermissionExpectation specifies expectation struct of the PermissionChecker.CheckEntityPermission
+type PermissionCheckerMockCheckEntityPermissionExpectation struct {
+	mock               *PermissionCheckerMock
+	params             *PermissionCheckerMockCheckEntityPermissionParams
+	paramPtrs          *PermissionCheckerMockCheckEntityPermissionParamPtrs
+	expectationOrigins PermissionCheckerMockCheckEntityPermissionExpectationOrigins
+	results            *PermissionCheckerMockCheckEntityPermissionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PermissionCheckerMockCheckEntityPermissionParams contains parameters of the PermissionChecker.CheckEntityPermission
+type PermissionCheckerMockCheckEntityPermissionParams struct {
+	ctx  context.Context
+	id   uuid.UUID
+	role auth.Role
+}
+
+// PermissionCheckerMockCheckEntityPermissionParamPtrs contains pointers to parameters of the PermissionChecker.CheckEntityPermission
+type PermissionCheckerMockCheckEntityPermissionParamPtrs struct {
+	ctx  *context.Context
+	id   *uuid.UUID
+	role *auth.Role
+}
+
+// PermissionCheckerMockCheckEntityPermissionResults contains results of the PermissionChecker.CheckEntityPermission
+type PermissionCheckerMockCheckEntityPermissionResults struct {
+	err error
+}
+
+// PermissionCheckerMockCheckEntityPermissionOrigins contains origins of expectations of the PermissionChecker.CheckEntityPermission
+type PermissionCheckerMockCheckEntityPermissionExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Optional() *mPermissionCheckerMockCheckEntityPermission {
+	mmCheckEntityPermission.optional = true
+	return mmCheckEntityPermission
+}
+
+// Expect sets up expected params for PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Expect(ctx context.Context, id uuid.UUID, role auth.Role) *mPermissionCheckerMockCheckEntityPermission {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation == nil {
+		mmCheckEntityPermission.defaultExpectation = &PermissionCheckerMockCheckEntityPermissionExpectation{}
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.paramPtrs != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by ExpectParams functions")
+	}
+
+	mmCheckEntityPermission.defaultExpectation.params = &PermissionCheckerMockCheckEntityPermissionParams{ctx, id, role}
+	mmCheckEntityPermission.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckEntityPermission.expectations {
+		if minimock.Equal(e.params, mmCheckEntityPermission.defaultExpectation.params) {
+			mmCheckEntityPermission.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckEntityPermission.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckEntityPermission
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) ExpectCtxParam1(ctx context.Context) *mPermissionCheckerMockCheckEntityPermission {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation == nil {
+		mmCheckEntityPermission.defaultExpectation = &PermissionCheckerMockCheckEntityPermissionExpectation{}
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.params != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Expect")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.paramPtrs == nil {
+		mmCheckEntityPermission.defaultExpectation.paramPtrs = &PermissionCheckerMockCheckEntityPermissionParamPtrs{}
+	}
+	mmCheckEntityPermission.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckEntityPermission.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckEntityPermission
+}
+
+// ExpectIdParam2 sets up expected param id for PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) ExpectIdParam2(id uuid.UUID) *mPermissionCheckerMockCheckEntityPermission {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation == nil {
+		mmCheckEntityPermission.defaultExpectation = &PermissionCheckerMockCheckEntityPermissionExpectation{}
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.params != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Expect")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.paramPtrs == nil {
+		mmCheckEntityPermission.defaultExpectation.paramPtrs = &PermissionCheckerMockCheckEntityPermissionParamPtrs{}
+	}
+	mmCheckEntityPermission.defaultExpectation.paramPtrs.id = &id
+	mmCheckEntityPermission.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCheckEntityPermission
+}
+
+// ExpectRoleParam3 sets up expected param role for PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) ExpectRoleParam3(role auth.Role) *mPermissionCheckerMockCheckEntityPermission {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation == nil {
+		mmCheckEntityPermission.defaultExpectation = &PermissionCheckerMockCheckEntityPermissionExpectation{}
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.params != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Expect")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation.paramPtrs == nil {
+		mmCheckEntityPermission.defaultExpectation.paramPtrs = &PermissionCheckerMockCheckEntityPermissionParamPtrs{}
+	}
+	mmCheckEntityPermission.defaultExpectation.paramPtrs.role = &role
+	mmCheckEntityPermission.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmCheckEntityPermission
+}
+
+// Inspect accepts an inspector function that has same arguments as the PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Inspect(f func(ctx context.Context, id uuid.UUID, role auth.Role)) *mPermissionCheckerMockCheckEntityPermission {
+	if mmCheckEntityPermission.mock.inspectFuncCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("Inspect function is already set for PermissionCheckerMock.CheckEntityPermission")
+	}
+
+	mmCheckEntityPermission.mock.inspectFuncCheckEntityPermission = f
+
+	return mmCheckEntityPermission
+}
+
+// Return sets up results that will be returned by PermissionChecker.CheckEntityPermission
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Return(err error) *PermissionCheckerMock {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	if mmCheckEntityPermission.defaultExpectation == nil {
+		mmCheckEntityPermission.defaultExpectation = &PermissionCheckerMockCheckEntityPermissionExpectation{mock: mmCheckEntityPermission.mock}
+	}
+	mmCheckEntityPermission.defaultExpectation.results = &PermissionCheckerMockCheckEntityPermissionResults{err}
+	mmCheckEntityPermission.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckEntityPermission.mock
+}
+
+// Set uses given function f to mock the PermissionChecker.CheckEntityPermission method
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Set(f func(ctx context.Context, id uuid.UUID, role auth.Role) (err error)) *PermissionCheckerMock {
+	if mmCheckEntityPermission.defaultExpectation != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("Default expectation is already set for the PermissionChecker.CheckEntityPermission method")
+	}
+
+	if len(mmCheckEntityPermission.expectations) > 0 {
+		mmCheckEntityPermission.mock.t.Fatalf("Some expectations are already set for the PermissionChecker.CheckEntityPermission method")
+	}
+
+	mmCheckEntityPermission.mock.funcCheckEntityPermission = f
+	mmCheckEntityPermission.mock.funcCheckEntityPermissionOrigin = minimock.CallerInfo(1)
+	return mmCheckEntityPermission.mock
+}
+
+// When sets expectation for the PermissionChecker.CheckEntityPermission which will trigger the result defined by the following
+// Then helper
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) When(ctx context.Context, id uuid.UUID, role auth.Role) *PermissionCheckerMockCheckEntityPermissionExpectation {
+	if mmCheckEntityPermission.mock.funcCheckEntityPermission != nil {
+		mmCheckEntityPermission.mock.t.Fatalf("PermissionCheckerMock.CheckEntityPermission mock is already set by Set")
+	}
+
+	expectation := &PermissionCheckerMockCheckEntityPermissionExpectation{
+		mock:               mmCheckEntityPermission.mock,
+		params:             &PermissionCheckerMockCheckEntityPermissionParams{ctx, id, role},
+		expectationOrigins: PermissionCheckerMockCheckEntityPermissionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckEntityPermission.expectations = append(mmCheckEntityPermission.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PermissionChecker.CheckEntityPermission return parameters for the expectation previously defined by the When method
+func (e *PermissionCheckerMockCheckEntityPermissionExpectation) Then(err error) *PermissionCheckerMock {
+	e.results = &PermissionCheckerMockCheckEntityPermissionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PermissionChecker.CheckEntityPermission should be invoked
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Times(n uint64) *mPermissionCheckerMockCheckEntityPermission {
+	if n == 0 {
+		mmCheckEntityPermission.mock.t.Fatalf("Times of PermissionCheckerMock.CheckEntityPermission mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckEntityPermission.expectedInvocations, n)
+	mmCheckEntityPermission.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckEntityPermission
+}
+
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) invocationsDone() bool {
+	if len(mmCheckEntityPermission.expectations) == 0 && mmCheckEntityPermission.defaultExpectation == nil && mmCheckEntityPermission.mock.funcCheckEntityPermission == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckEntityPermission.mock.afterCheckEntityPermissionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckEntityPermission.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckEntityPermission implements mm_usecase.PermissionChecker
+func (mmCheckEntityPermission *PermissionCheckerMock) CheckEntityPermission(ctx context.Context, id uuid.UUID, role auth.Role) (err error) {
+	mm_atomic.AddUint64(&mmCheckEntityPermission.beforeCheckEntityPermissionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckEntityPermission.afterCheckEntityPermissionCounter, 1)
+
+	mmCheckEntityPermission.t.Helper()
+
+	if mmCheckEntityPermission.inspectFuncCheckEntityPermission != nil {
+		mmCheckEntityPermission.inspectFuncCheckEntityPermission(ctx, id, role)
+	}
+
+	mm_params := PermissionCheckerMockCheckEntityPermissionParams{ctx, id, role}
+
+	// Record call args
+	mmCheckEntityPermission.CheckEntityPermissionMock.mutex.Lock()
+	mmCheckEntityPermission.CheckEntityPermissionMock.callArgs = append(mmCheckEntityPermission.CheckEntityPermissionMock.callArgs, &mm_params)
+	mmCheckEntityPermission.CheckEntityPermissionMock.mutex.Unlock()
+
+	for _, e := range mmCheckEntityPermission.CheckEntityPermissionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.paramPtrs
+
+		mm_got := PermissionCheckerMockCheckEntityPermissionParams{ctx, id, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckEntityPermission.t.Errorf("PermissionCheckerMock.CheckEntityPermission got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCheckEntityPermission.t.Errorf("PermissionCheckerMock.CheckEntityPermission got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmCheckEntityPermission.t.Errorf("PermissionCheckerMock.CheckEntityPermission got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckEntityPermission.t.Errorf("PermissionCheckerMock.CheckEntityPermission got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckEntityPermission.CheckEntityPermissionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckEntityPermission.t.Fatal("No results are set for the PermissionCheckerMock.CheckEntityPermission")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckEntityPermission.funcCheckEntityPermission != nil {
+		return mmCheckEntityPermission.funcCheckEntityPermission(ctx, id, role)
+	}
+	mmCheckEntityPermission.t.Fatalf("Unexpected call to PermissionCheckerMock.CheckEntityPermission. %v %v %v", ctx, id, role)
+	return
+}
+
+// CheckEntityPermissionAfterCounter returns a count of finished PermissionCheckerMock.CheckEntityPermission invocations
+func (mmCheckEntityPermission *PermissionCheckerMock) CheckEntityPermissionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckEntityPermission.afterCheckEntityPermissionCounter)
+}
+
+// CheckEntityPermissionBeforeCounter returns a count of PermissionCheckerMock.CheckEntityPermission invocations
+func (mmCheckEntityPermission *PermissionCheckerMock) CheckEntityPermissionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckEntityPermission.beforeCheckEntityPermissionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PermissionCheckerMock.CheckEntityPermission.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckEntityPermission *mPermissionCheckerMockCheckEntityPermission) Calls() []*PermissionCheckerMockCheckEntityPermissionParams {
+	mmCheckEntityPermission.mutex.RLock()
+
+	argCopy := make([]*PermissionCheckerMockCheckEntityPermissionParams, len(mmCheckEntityPermission.callArgs))
+	copy(argCopy, mmCheckEntityPermission.callArgs)
+
+	mmCheckEntityPermission.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckEntityPermissionDone returns true if the count of the CheckEntityPermission invocations corresponds
+// the number of defined expectations
+func (m *PermissionCheckerMock) MinimockCheckEntityPermissionDone() bool {
+	if m.CheckEntityPermissionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckEntityPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckEntityPermissionMock.invocationsDone()
+}
+
+// MinimockCheckEntityPermissionInspect logs each unmet expectation
+func (m *PermissionCheckerMock) MinimockCheckEntityPermissionInspect() {
+	for _, e := range m.CheckEntityPermissionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PermissionCheckerMock.CheckEntityPermission at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckEntityPermissionCounter := mm_atomic.LoadUint64(&m.afterCheckEntityPermissionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckEntityPermissionMock.defaultExpectation != nil && afterCheckEntityPermissionCounter < 1 {
+		if m.CheckEntityPermissionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PermissionCheckerMock.CheckEntityPermission at\n%s", m.CheckEntityPermissionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PermissionCheckerMock.CheckEntityPermission at\n%s with params: %#v", m.CheckEntityPermissionMock.defaultExpectation.expectationOrigins.origin, *m.CheckEntityPermissionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckEntityPermission != nil && afterCheckEntityPermissionCounter < 1 {
+		m.t.Errorf("Expected call to PermissionCheckerMock.CheckEntityPermission at\n%s", m.funcCheckEntityPermissionOrigin)
+	}
+
+	if !m.CheckEntityPermissionMock.invocationsDone() && afterCheckEntityPermissionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PermissionCheckerMock.CheckEntityPermission at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckEntityPermissionMock.expectedInvocations), m.CheckEntityPermissionMock.expectedInvocationsOrigin, afterCheckEntityPermissionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PermissionCheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckEntityPermissionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PermissionCheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PermissionCheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckEntityPermissionDone()
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Hub interface {
+	Join(entityID, userID uuid.UUID, canEdit bool) (*presence.Client, error)
+	Leave(c *presence.Client)
+	Handle(c *presence.Client, msg presence.InboundMessage) error
+}
+
+type PermissionChecker interface {
+	CheckEntityPermission(ctx context.Context, id uuid.UUID, role auth.Role) error
+}
+
+type service struct {
+	hub  Hub
+	perm PermissionChecker
+}
+
+func NewService(hub Hub, perm PermissionChecker) *service {
+	if hub == nil || perm == nil {
+		panic("presence.NewService: nil dependency")
+	}
+	return &service{hub: hub, perm: perm}
+}
+
+// Join checks that the current user may read the entity and adds a client to its room.
+// Users without write permission join as viewers and cannot switch to the editing state.
+func (s *service) Join(ctx context.Context, entityID uuid.UUID) (*presence.Client, error) {
+	if entityID == uuid.Nil {
+		err := apperr.ErrNilUUID(presence.FieldEntityID)
+		logger.Error(ctx, err).Msg("presence.service.Join: nil entity ID")
+		return nil, fmt.Errorf("presence.service.Join: %w", err)
+	}
+	if err := s.perm.CheckEntityPermission(ctx, entityID, auth.RoleRead); err != nil {
+		logger.Error(ctx, err).
+			Str(presence.FieldEntityID.String(), entityID.String()).
+			Msg("presence.service.Join: checkEntityPermission read")
+		return nil, fmt.Errorf("presence.service.Join: %w", err)
+	}
+
+	canEdit := true
+	if err := s.perm.CheckEntityPermission(ctx, entityID, auth.RoleWrite); err != nil {
+		if apperr.ClassOf(err) != apperr.ClassForbidden {
+			logger.Error(ctx, err).
+				Str(presence.FieldEntityID.String(), entityID.String()).
+				Msg("presence.service.Join: checkEntityPermission write")
+			return nil, fmt.Errorf("presence.service.Join: %w", err)
+		}
+		canEdit = false
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(presence.FieldEntityID.String(), entityID.String()).
+			Msg("presence.service.Join: GetUserID")
+		return nil, fmt.Errorf("presence.service.Join: %w", err)
+	}
+
+	client, err := s.hub.Join(entityID, userID, canEdit)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(presence.FieldEntityID.String(), entityID.String()).
+			Msg("presence.service.Join: hub.Join")
+		return nil, fmt.Errorf("presence.service.Join: %w", err)
+	}
+
+	return client, nil
+}
+
+func (s *service) Leave(_ context.Context, c *presence.Client) {
+	s.hub.Leave(c)
+}
+
+func (s *service) Handle(ctx context.Context, c *presence.Client, msg presence.InboundMessage) error {
+	if err := s.hub.Handle(c, msg); err != nil {
+		logger.Error(ctx, err).
+			Str(presence.FieldEntityID.String(), c.EntityID.String()).
+			Interface(presence.FieldMessage.String(), msg).
+			Msg("presence.service.Handle: hub.Handle")
+		return fmt.Errorf("presence.service.Handle: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/auth"
+	"github.com/66gu1/easygodocs/internal/app/presence"
+	"github.com/66gu1/easygodocs/internal/app/presence/usecase"
+	"github.com/66gu1/easygodocs/internal/app/presence/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	hub  *mocks.HubMock
+	perm *mocks.PermissionCheckerMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		hub:  mocks.NewHubMock(t),
+		perm: mocks.NewPermissionCheckerMock(t),
+	}
+}
+
+func TestService_Join(t *testing.T) {
+	t.Parallel()
+
+	var (
+		entityID = uuid.New()
+		userID   = uuid.New()
+		ctx      = contextx.SetUserID(t.Context(), userID)
+		client   = &presence.Client{EntityID: entityID, UserID: userID}
+		expErr   = errors.New("unexpected error")
+	)
+
+	tests := []struct {
+		name     string
+		entityID uuid.UUID
+		setup    func(mocks mock)
+		err      error
+	}{
+		{
+			name:     "ok, editor",
+			entityID: entityID,
+			setup: func(mocks mock) {
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleRead).Then(nil)
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleWrite).Then(nil)
+				mocks.hub.JoinMock.Expect(entityID, userID, true).Return(client, nil)
+			},
+		},
+		{
+			name:     "ok, viewer",
+			entityID: entityID,
+			setup: func(mocks mock) {
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleRead).Then(nil)
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleWrite).Then(apperr.ErrForbidden())
+				mocks.hub.JoinMock.Expect(entityID, userID, false).Return(client, nil)
+			},
+		},
+		{
+			name:     "nil entity ID",
+			entityID: uuid.Nil,
+			err:      apperr.ErrNilUUID(presence.FieldEntityID),
+		},
+		{
+			name:     "read forbidden",
+			entityID: entityID,
+			setup: func(mocks mock) {
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleRead).Then(apperr.ErrForbidden())
+			},
+			err: apperr.ErrForbidden(),
+		},
+		{
+			name:     "write check returns unexpected error",
+			entityID: entityID,
+			setup: func(mocks mock) {
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleRead).Then(nil)
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleWrite).Then(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:     "hub.Join returns error",
+			entityID: entityID,
+			setup: func(mocks mock) {
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleRead).Then(nil)
+				mocks.perm.CheckEntityPermissionMock.When(ctx, entityID, auth.RoleWrite).Then(nil)
+				mocks.hub.JoinMock.Expect(entityID, userID, true).Return(nil, presence.ErrRoomFull(1))
+			},
+			err: presence.ErrRoomFull(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+
+			svc := usecase.NewService(mocks.hub, mocks.perm)
+			got, err := svc.Join(ctx, tt.entityID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, client, got)
+		})
+	}
+}
+
+func TestService_Handle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		client = &presence.Client{EntityID: uuid.New()}
+		msg    = presence.InboundMessage{Type: presence.MessageTypeTyping}
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.hub.HandleMock.Expect(client, msg).Return(nil)
+			},
+		},
+		{
+			name: "hub.Handle returns error",
+			setup: func(mocks mock) {
+				mocks.hub.HandleMock.Expect(client, msg).Return(presence.ErrRateLimited())
+			},
+			err: presence.ErrRateLimited(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.hub, mocks.perm)
+			err := svc.Handle(ctx, client, msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+// ExcerptLength caps Document.Excerpt, in runes.
+const ExcerptLength = 300
+
+type Repository interface {
+	// GetPublished returns the published entities under rootIDs and under the roots of public spaces,
+	// roots included, newest update first. A draft hides its subtree.
+	GetPublished(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) ([]Document, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// Sanitizer removes the markup feed readers must not render from an excerpt.
+type Sanitizer interface {
+	SanitizeHTML(html string) string
+}
+
+// Config defines the public subtrees: entity_ids are their roots, next to the spaces whose settings
+// make them public. Documents are linked as <base_url>/<slug>, so base_url is where the public site
+// is served; without it only entity_ids would need one, and public spaces are not listed.
+type Config struct {
+	EntityIDs       []string `mapstructure:"entity_ids" json:"entity_ids"`
+	BaseURL         string   `mapstructure:"base_url" json:"base_url"`
+	Title           string   `mapstructure:"title" json:"title"`
+	FeedSize        int      `mapstructure:"feed_size" json:"feed_size"`
+	CacheTTLSeconds int      `mapstructure:"cache_ttl_seconds" json:"cache_ttl_seconds"`
+}
+
+func (c Config) Validate() error {
+	if _, err := c.RootIDs(); err != nil {
+		return err
+	}
+	if len(c.EntityIDs) > 0 || c.BaseURL != "" {
+		u, err := url.Parse(c.BaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("base_url must be an absolute http(s) URL")
+		}
+	}
+	if c.FeedSize <= 0 {
+		return fmt.Errorf("feed_size must be positive")
+	}
+	if c.CacheTTLSeconds <= 0 {
+		return fmt.Errorf("cache_ttl_seconds must be positive")
+	}
+
+	return nil
+}
+
+func (c Config) RootIDs() ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(c.EntityIDs))
+	for _, s := range c.EntityIDs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("entity_ids: invalid id %q", s)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// URL is the public address of a document.
+func (c Config) URL(slug string) string {
+	return strings.TrimSuffix(c.BaseURL, "/") + "/" + url.PathEscape(slug)
+}
+
+// core reads the public documents on demand and caches them per workspace, so crawlers and feed readers
+// polling the sitemap and the feed do not walk the tree on every request.
+type core struct {
+	repo      Repository
+	timeGen   TimeGenerator
+	sanitizer Sanitizer
+	cfg       Config
+	rootIDs   []uuid.UUID
+
+	mu     sync.Mutex
+	cached map[uuid.UUID]cachedListing
+}
+
+// cachedListing is the listing of a workspace until it expires.
+type cachedListing struct {
+	listing Listing
+	expires time.Time
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, sanitizer Sanitizer, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil || sanitizer == nil {
+		return nil, fmt.Errorf("public.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("public.NewCore: %w", err)
+	}
+	rootIDs, _ := cfg.RootIDs()
+
+	return &core{repo: repo, timeGen: timeGen, sanitizer: sanitizer, cfg: cfg, rootIDs: rootIDs,
+		cached: make(map[uuid.UUID]cachedListing)}, nil
+}
+
+// GetListing returns the cached listing of the workspace in ctx or reads it. Concurrent callers wait for
+// a single read.
+func (c *core) GetListing(ctx context.Context) (Listing, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	workspaceID := contextx.WorkspaceID(ctx)
+	now := c.timeGen.Now()
+	if cached, ok := c.cached[workspaceID]; ok && now.Before(cached.expires) {
+		return cached.listing, nil
+	}
+
+	listing := Listing{Documents: []Document{}, GeneratedAt: now}
+	if len(c.rootIDs) > 0 || c.cfg.BaseURL != "" {
+		docs, err := c.repo.GetPublished(ctx, c.rootIDs, ExcerptLength)
+		if err != nil {
+			return Listing{}, fmt.Errorf("public.core.GetListing: %w", err)
+		}
+		for i := range docs {
+			docs[i].Excerpt = c.sanitizer.SanitizeHTML(docs[i].Excerpt)
+		}
+		listing.Documents = docs
+	}
+	c.cached[workspaceID] = cachedListing{listing: listing, expires: now.Add(time.Duration(c.cfg.CacheTTLSeconds) * time.Second)}
+
+	return listing, nil
+}
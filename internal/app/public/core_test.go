@@ -0,0 +1,203 @@
+package public_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/66gu1/easygodocs/internal/app/public/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+var rootID = uuid.MustParse("5d7a1f5e-3c1b-4b8e-9a43-2f0e6c1d9b10")
+
+func cfg() public.Config {
+	return public.Config{
+		EntityIDs:       []string{rootID.String()},
+		BaseURL:         "https://docs.example.com/",
+		Title:           "Docs",
+		FeedSize:        20,
+		CacheTTLSeconds: 60,
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(c *public.Config)
+		ok     bool
+	}{
+		{name: "valid", modify: func(c *public.Config) {}, ok: true},
+		{name: "no subtrees without base url", modify: func(c *public.Config) { c.EntityIDs, c.BaseURL = nil, "" }, ok: true},
+		{name: "invalid id", modify: func(c *public.Config) { c.EntityIDs = []string{"root"} }},
+		{name: "relative base url", modify: func(c *public.Config) { c.BaseURL = "/docs" }},
+		{name: "ftp base url", modify: func(c *public.Config) { c.BaseURL = "ftp://docs.example.com" }},
+		{name: "public spaces only", modify: func(c *public.Config) { c.EntityIDs = nil }, ok: true},
+		{name: "relative base url without subtrees", modify: func(c *public.Config) { c.EntityIDs, c.BaseURL = nil, "/docs" }},
+		{name: "feed size", modify: func(c *public.Config) { c.FeedSize = 0 }},
+		{name: "cache ttl", modify: func(c *public.Config) { c.CacheTTLSeconds = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := cfg()
+			tt.modify(&c)
+			if tt.ok {
+				require.NoError(t, c.Validate())
+			} else {
+				require.Error(t, c.Validate())
+			}
+		})
+	}
+}
+
+func TestConfig_URL(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "https://docs.example.com/getting-started", cfg().URL("getting-started"))
+	require.Equal(t, "https://docs.example.com/%D1%8F", cfg().URL("я"))
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := public.NewCore(nil, mocks.NewTimeGeneratorMock(t), mocks.NewSanitizerMock(t), cfg())
+	require.Error(t, err)
+
+	_, err = public.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), nil, cfg())
+	require.Error(t, err)
+
+	_, err = public.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), mocks.NewSanitizerMock(t), public.Config{})
+	require.Error(t, err)
+
+	_, err = public.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), mocks.NewSanitizerMock(t), cfg())
+	require.NoError(t, err)
+}
+
+func TestCore_GetListing(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = t.Context()
+		now  = time.Date(2025, 9, 30, 15, 4, 0, 0, time.UTC)
+		docs = []public.Document{
+			{ID: uuid.New(), Name: "B", Slug: "b", UpdatedAt: now.Add(-time.Hour), Excerpt: "b <img src=x onerror=alert(1)>"},
+			{ID: rootID, Name: "A", Slug: "a", UpdatedAt: now.Add(-2 * time.Hour), Excerpt: "a"},
+		}
+		want = []public.Document{
+			{ID: docs[0].ID, Name: "B", Slug: "b", UpdatedAt: now.Add(-time.Hour), Excerpt: `b <img src="x">`},
+			{ID: rootID, Name: "A", Slug: "a", UpdatedAt: now.Add(-2 * time.Hour), Excerpt: "a"},
+		}
+	)
+	repo := mocks.NewRepositoryMock(t)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	sanitizer := mocks.NewSanitizerMock(t)
+	current := now
+	timeGen.NowMock.Set(func() time.Time { return current })
+	repo.GetPublishedMock.Set(func(_ context.Context, rootIDs []uuid.UUID, excerptLength int) ([]public.Document, error) {
+		require.Equal(t, []uuid.UUID{rootID}, rootIDs)
+		require.Equal(t, public.ExcerptLength, excerptLength)
+		return slices.Clone(docs), nil
+	})
+	sanitizer.SanitizeHTMLMock.When(docs[0].Excerpt).Then(want[0].Excerpt)
+	sanitizer.SanitizeHTMLMock.When(docs[1].Excerpt).Then(want[1].Excerpt)
+
+	core, err := public.NewCore(repo, timeGen, sanitizer, cfg())
+	require.NoError(t, err)
+
+	got, err := core.GetListing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, public.Listing{Documents: want, GeneratedAt: now}, got)
+	require.Equal(t, want[0].UpdatedAt, got.LastModified())
+
+	// served from cache until the period ends
+	current = now.Add(59 * time.Second)
+	cached, err := core.GetListing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, got, cached)
+	require.Equal(t, uint64(1), repo.GetPublishedAfterCounter())
+
+	// another workspace has a listing of its own
+	_, err = core.GetListing(contextx.SetWorkspaceID(ctx, uuid.New()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), repo.GetPublishedAfterCounter())
+
+	current = now.Add(time.Minute)
+	_, err = core.GetListing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), repo.GetPublishedAfterCounter())
+}
+
+func TestCore_GetListing_NoSubtrees(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 30, 15, 4, 0, 0, time.UTC)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Return(now)
+	c := cfg()
+	c.EntityIDs = nil
+	c.BaseURL = ""
+
+	core, err := public.NewCore(mocks.NewRepositoryMock(t), timeGen, mocks.NewSanitizerMock(t), c)
+	require.NoError(t, err)
+
+	got, err := core.GetListing(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, got.Documents)
+	require.True(t, got.LastModified().IsZero())
+}
+
+func TestCore_GetListing_PublicSpacesOnly(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 30, 15, 4, 0, 0, time.UTC)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Return(now)
+	repo := mocks.NewRepositoryMock(t)
+	docs := []public.Document{{ID: uuid.New(), Name: "Space", Slug: "space", UpdatedAt: now}}
+	repo.GetPublishedMock.Set(func(_ context.Context, rootIDs []uuid.UUID, excerptLength int) ([]public.Document, error) {
+		require.Empty(t, rootIDs)
+		return slices.Clone(docs), nil
+	})
+	sanitizer := mocks.NewSanitizerMock(t)
+	sanitizer.SanitizeHTMLMock.Return("")
+	c := cfg()
+	c.EntityIDs = nil
+
+	core, err := public.NewCore(repo, timeGen, sanitizer, c)
+	require.NoError(t, err)
+
+	got, err := core.GetListing(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, docs, got.Documents)
+}
+
+func TestCore_GetListing_Error(t *testing.T) {
+	t.Parallel()
+
+	expErr := errors.New("db down")
+	repo := mocks.NewRepositoryMock(t)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Return(time.Now())
+	repo.GetPublishedMock.Return(nil, expErr)
+
+	core, err := public.NewCore(repo, timeGen, mocks.NewSanitizerMock(t), cfg())
+	require.NoError(t, err)
+
+	_, err = core.GetListing(t.Context())
+	require.ErrorIs(t, err, expErr)
+
+	// errors are not cached
+	_, err = core.GetListing(t.Context())
+	require.ErrorIs(t, err, expErr)
+	require.Equal(t, uint64(2), repo.GetPublishedAfterCounter())
+}
@@ -0,0 +1,32 @@
+package public
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Document is a published entity inside one of the public subtrees.
+type Document struct {
+	ID        uuid.UUID
+	Name      string
+	Slug      string
+	UpdatedAt time.Time
+	// Excerpt is the beginning of the content, at most ExcerptLength runes, with unsafe markup removed.
+	Excerpt string
+}
+
+// Listing is the set of public documents, most recently updated first.
+// It may be up to the configured cache period old.
+type Listing struct {
+	Documents   []Document
+	GeneratedAt time.Time
+}
+
+// LastModified is the newest update among the documents, zero for an empty listing.
+func (l Listing) LastModified() time.Time {
+	if len(l.Documents) == 0 {
+		return time.Time{}
+	}
+	return l.Documents[0].UpdatedAt
+}
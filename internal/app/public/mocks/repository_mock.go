@@ -0,0 +1,450 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/public.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_public "github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_public.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPublished          func(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) (da1 []mm_public.Document, err error)
+	funcGetPublishedOrigin    string
+	inspectFuncGetPublished   func(ctx context.Context, rootIDs []uuid.UUID, excerptLength int)
+	afterGetPublishedCounter  uint64
+	beforeGetPublishedCounter uint64
+	GetPublishedMock          mRepositoryMockGetPublished
+}
+
+// NewRepositoryMock returns a mock for mm_public.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPublishedMock = mRepositoryMockGetPublished{mock: m}
+	m.GetPublishedMock.callArgs = []*RepositoryMockGetPublishedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetPublished struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPublishedExpectation
+	expectations       []*RepositoryMockGetPublishedExpectation
+
+	callArgs []*RepositoryMockGetPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPublishedExpectation specifies expectation struct of the Repository.GetPublished
+type RepositoryMockGetPublishedExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPublishedParams
+	paramPtrs          *RepositoryMockGetPublishedParamPtrs
+	expectationOrigins RepositoryMockGetPublishedExpectationOrigins
+	results            *RepositoryMockGetPublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPublishedParams contains parameters of the Repository.GetPublished
+type RepositoryMockGetPublishedParams struct {
+	ctx           context.Context
+	rootIDs       []uuid.UUID
+	excerptLength int
+}
+
+// RepositoryMockGetPublishedParamPtrs contains pointers to parameters of the Repository.GetPublished
+type RepositoryMockGetPublishedParamPtrs struct {
+	ctx           *context.Context
+	rootIDs       *[]uuid.UUID
+	excerptLength *int
+}
+
+// RepositoryMockGetPublishedResults contains results of the Repository.GetPublished
+type RepositoryMockGetPublishedResults struct {
+	da1 []mm_public.Document
+	err error
+}
+
+// RepositoryMockGetPublishedOrigins contains origins of expectations of the Repository.GetPublished
+type RepositoryMockGetPublishedExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originRootIDs       string
+	originExcerptLength string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPublished *mRepositoryMockGetPublished) Optional() *mRepositoryMockGetPublished {
+	mmGetPublished.optional = true
+	return mmGetPublished
+}
+
+// Expect sets up expected params for Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) Expect(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) *mRepositoryMockGetPublished {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	if mmGetPublished.defaultExpectation == nil {
+		mmGetPublished.defaultExpectation = &RepositoryMockGetPublishedExpectation{}
+	}
+
+	if mmGetPublished.defaultExpectation.paramPtrs != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by ExpectParams functions")
+	}
+
+	mmGetPublished.defaultExpectation.params = &RepositoryMockGetPublishedParams{ctx, rootIDs, excerptLength}
+	mmGetPublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPublished.expectations {
+		if minimock.Equal(e.params, mmGetPublished.defaultExpectation.params) {
+			mmGetPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPublished {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	if mmGetPublished.defaultExpectation == nil {
+		mmGetPublished.defaultExpectation = &RepositoryMockGetPublishedExpectation{}
+	}
+
+	if mmGetPublished.defaultExpectation.params != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Expect")
+	}
+
+	if mmGetPublished.defaultExpectation.paramPtrs == nil {
+		mmGetPublished.defaultExpectation.paramPtrs = &RepositoryMockGetPublishedParamPtrs{}
+	}
+	mmGetPublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPublished
+}
+
+// ExpectRootIDsParam2 sets up expected param rootIDs for Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) ExpectRootIDsParam2(rootIDs []uuid.UUID) *mRepositoryMockGetPublished {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	if mmGetPublished.defaultExpectation == nil {
+		mmGetPublished.defaultExpectation = &RepositoryMockGetPublishedExpectation{}
+	}
+
+	if mmGetPublished.defaultExpectation.params != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Expect")
+	}
+
+	if mmGetPublished.defaultExpectation.paramPtrs == nil {
+		mmGetPublished.defaultExpectation.paramPtrs = &RepositoryMockGetPublishedParamPtrs{}
+	}
+	mmGetPublished.defaultExpectation.paramPtrs.rootIDs = &rootIDs
+	mmGetPublished.defaultExpectation.expectationOrigins.originRootIDs = minimock.CallerInfo(1)
+
+	return mmGetPublished
+}
+
+// ExpectExcerptLengthParam3 sets up expected param excerptLength for Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) ExpectExcerptLengthParam3(excerptLength int) *mRepositoryMockGetPublished {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	if mmGetPublished.defaultExpectation == nil {
+		mmGetPublished.defaultExpectation = &RepositoryMockGetPublishedExpectation{}
+	}
+
+	if mmGetPublished.defaultExpectation.params != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Expect")
+	}
+
+	if mmGetPublished.defaultExpectation.paramPtrs == nil {
+		mmGetPublished.defaultExpectation.paramPtrs = &RepositoryMockGetPublishedParamPtrs{}
+	}
+	mmGetPublished.defaultExpectation.paramPtrs.excerptLength = &excerptLength
+	mmGetPublished.defaultExpectation.expectationOrigins.originExcerptLength = minimock.CallerInfo(1)
+
+	return mmGetPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) Inspect(f func(ctx context.Context, rootIDs []uuid.UUID, excerptLength int)) *mRepositoryMockGetPublished {
+	if mmGetPublished.mock.inspectFuncGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPublished")
+	}
+
+	mmGetPublished.mock.inspectFuncGetPublished = f
+
+	return mmGetPublished
+}
+
+// Return sets up results that will be returned by Repository.GetPublished
+func (mmGetPublished *mRepositoryMockGetPublished) Return(da1 []mm_public.Document, err error) *RepositoryMock {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	if mmGetPublished.defaultExpectation == nil {
+		mmGetPublished.defaultExpectation = &RepositoryMockGetPublishedExpectation{mock: mmGetPublished.mock}
+	}
+	mmGetPublished.defaultExpectation.results = &RepositoryMockGetPublishedResults{da1, err}
+	mmGetPublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPublished.mock
+}
+
+// Set uses given function f to mock the Repository.GetPublished method
+func (mmGetPublished *mRepositoryMockGetPublished) Set(f func(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) (da1 []mm_public.Document, err error)) *RepositoryMock {
+	if mmGetPublished.defaultExpectation != nil {
+		mmGetPublished.mock.t.Fatalf("Default expectation is already set for the Repository.GetPublished method")
+	}
+
+	if len(mmGetPublished.expectations) > 0 {
+		mmGetPublished.mock.t.Fatalf("Some expectations are already set for the Repository.GetPublished method")
+	}
+
+	mmGetPublished.mock.funcGetPublished = f
+	mmGetPublished.mock.funcGetPublishedOrigin = minimock.CallerInfo(1)
+	return mmGetPublished.mock
+}
+
+// When sets expectation for the Repository.GetPublished which will trigger the result defined by the following
+// Then helper
+func (mmGetPublished *mRepositoryMockGetPublished) When(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) *RepositoryMockGetPublishedExpectation {
+	if mmGetPublished.mock.funcGetPublished != nil {
+		mmGetPublished.mock.t.Fatalf("RepositoryMock.GetPublished mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPublishedExpectation{
+		mock:               mmGetPublished.mock,
+		params:             &RepositoryMockGetPublishedParams{ctx, rootIDs, excerptLength},
+		expectationOrigins: RepositoryMockGetPublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPublished.expectations = append(mmGetPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPublished return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPublishedExpectation) Then(da1 []mm_public.Document, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPublishedResults{da1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPublished should be invoked
+func (mmGetPublished *mRepositoryMockGetPublished) Times(n uint64) *mRepositoryMockGetPublished {
+	if n == 0 {
+		mmGetPublished.mock.t.Fatalf("Times of RepositoryMock.GetPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPublished.expectedInvocations, n)
+	mmGetPublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPublished
+}
+
+func (mmGetPublished *mRepositoryMockGetPublished) invocationsDone() bool {
+	if len(mmGetPublished.expectations) == 0 && mmGetPublished.defaultExpectation == nil && mmGetPublished.mock.funcGetPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPublished.mock.afterGetPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPublished implements mm_public.Repository
+func (mmGetPublished *RepositoryMock) GetPublished(ctx context.Context, rootIDs []uuid.UUID, excerptLength int) (da1 []mm_public.Document, err error) {
+	mm_atomic.AddUint64(&mmGetPublished.beforeGetPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPublished.afterGetPublishedCounter, 1)
+
+	mmGetPublished.t.Helper()
+
+	if mmGetPublished.inspectFuncGetPublished != nil {
+		mmGetPublished.inspectFuncGetPublished(ctx, rootIDs, excerptLength)
+	}
+
+	mm_params := RepositoryMockGetPublishedParams{ctx, rootIDs, excerptLength}
+
+	// Record call args
+	mmGetPublished.GetPublishedMock.mutex.Lock()
+	mmGetPublished.GetPublishedMock.callArgs = append(mmGetPublished.GetPublishedMock.callArgs, &mm_params)
+	mmGetPublished.GetPublishedMock.mutex.Unlock()
+
+	for _, e := range mmGetPublished.GetPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmGetPublished.GetPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPublished.GetPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPublished.GetPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPublished.GetPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPublishedParams{ctx, rootIDs, excerptLength}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPublished.t.Errorf("RepositoryMock.GetPublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublished.GetPublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootIDs != nil && !minimock.Equal(*mm_want_ptrs.rootIDs, mm_got.rootIDs) {
+				mmGetPublished.t.Errorf("RepositoryMock.GetPublished got unexpected parameter rootIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublished.GetPublishedMock.defaultExpectation.expectationOrigins.originRootIDs, *mm_want_ptrs.rootIDs, mm_got.rootIDs, minimock.Diff(*mm_want_ptrs.rootIDs, mm_got.rootIDs))
+			}
+
+			if mm_want_ptrs.excerptLength != nil && !minimock.Equal(*mm_want_ptrs.excerptLength, mm_got.excerptLength) {
+				mmGetPublished.t.Errorf("RepositoryMock.GetPublished got unexpected parameter excerptLength, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublished.GetPublishedMock.defaultExpectation.expectationOrigins.originExcerptLength, *mm_want_ptrs.excerptLength, mm_got.excerptLength, minimock.Diff(*mm_want_ptrs.excerptLength, mm_got.excerptLength))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPublished.t.Errorf("RepositoryMock.GetPublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPublished.GetPublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPublished.GetPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPublished.t.Fatal("No results are set for the RepositoryMock.GetPublished")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmGetPublished.funcGetPublished != nil {
+		return mmGetPublished.funcGetPublished(ctx, rootIDs, excerptLength)
+	}
+	mmGetPublished.t.Fatalf("Unexpected call to RepositoryMock.GetPublished. %v %v %v", ctx, rootIDs, excerptLength)
+	return
+}
+
+// GetPublishedAfterCounter returns a count of finished RepositoryMock.GetPublished invocations
+func (mmGetPublished *RepositoryMock) GetPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublished.afterGetPublishedCounter)
+}
+
+// GetPublishedBeforeCounter returns a count of RepositoryMock.GetPublished invocations
+func (mmGetPublished *RepositoryMock) GetPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublished.beforeGetPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPublished *mRepositoryMockGetPublished) Calls() []*RepositoryMockGetPublishedParams {
+	mmGetPublished.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPublishedParams, len(mmGetPublished.callArgs))
+	copy(argCopy, mmGetPublished.callArgs)
+
+	mmGetPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPublishedDone returns true if the count of the GetPublished invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPublishedDone() bool {
+	if m.GetPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPublishedMock.invocationsDone()
+}
+
+// MinimockGetPublishedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPublishedInspect() {
+	for _, e := range m.GetPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPublishedCounter := mm_atomic.LoadUint64(&m.afterGetPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPublishedMock.defaultExpectation != nil && afterGetPublishedCounter < 1 {
+		if m.GetPublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublished at\n%s", m.GetPublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPublished at\n%s with params: %#v", m.GetPublishedMock.defaultExpectation.expectationOrigins.origin, *m.GetPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPublished != nil && afterGetPublishedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPublished at\n%s", m.funcGetPublishedOrigin)
+	}
+
+	if !m.GetPublishedMock.invocationsDone() && afterGetPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPublishedMock.expectedInvocations), m.GetPublishedMock.expectedInvocationsOrigin, afterGetPublishedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPublishedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPublishedDone()
+}
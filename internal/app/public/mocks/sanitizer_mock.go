@@ -0,0 +1,384 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/public.Sanitizer -o sanitizer_mock.go -n SanitizerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// SanitizerMock implements mm_public.Sanitizer
+type SanitizerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSanitizeHTML          func(html string) (s1 string)
+	funcSanitizeHTMLOrigin    string
+	inspectFuncSanitizeHTML   func(html string)
+	afterSanitizeHTMLCounter  uint64
+	beforeSanitizeHTMLCounter uint64
+	SanitizeHTMLMock          mSanitizerMockSanitizeHTML
+}
+
+// NewSanitizerMock returns a mock for mm_public.Sanitizer
+func NewSanitizerMock(t minimock.Tester) *SanitizerMock {
+	m := &SanitizerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SanitizeHTMLMock = mSanitizerMockSanitizeHTML{mock: m}
+	m.SanitizeHTMLMock.callArgs = []*SanitizerMockSanitizeHTMLParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSanitizerMockSanitizeHTML struct {
+	optional           bool
+	mock               *SanitizerMock
+	defaultExpectation *SanitizerMockSanitizeHTMLExpectation
+	expectations       []*SanitizerMockSanitizeHTMLExpectation
+
+	callArgs []*SanitizerMockSanitizeHTMLParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SanitizerMockSanitizeHTMLExpectation specifies expectation struct of the Sanitizer.SanitizeHTML
+type SanitizerMockSanitizeHTMLExpectation struct {
+	mock               *SanitizerMock
+	params             *SanitizerMockSanitizeHTMLParams
+	paramPtrs          *SanitizerMockSanitizeHTMLParamPtrs
+	expectationOrigins SanitizerMockSanitizeHTMLExpectationOrigins
+	results            *SanitizerMockSanitizeHTMLResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SanitizerMockSanitizeHTMLParams contains parameters of the Sanitizer.SanitizeHTML
+type SanitizerMockSanitizeHTMLParams struct {
+	html string
+}
+
+// SanitizerMockSanitizeHTMLParamPtrs contains pointers to parameters of the Sanitizer.SanitizeHTML
+type SanitizerMockSanitizeHTMLParamPtrs struct {
+	html *string
+}
+
+// SanitizerMockSanitizeHTMLResults contains results of the Sanitizer.SanitizeHTML
+type SanitizerMockSanitizeHTMLResults struct {
+	s1 string
+}
+
+// SanitizerMockSanitizeHTMLOrigins contains origins of expectations of the Sanitizer.SanitizeHTML
+type SanitizerMockSanitizeHTMLExpectationOrigins struct {
+	origin     string
+	originHtml string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Optional() *mSanitizerMockSanitizeHTML {
+	mmSanitizeHTML.optional = true
+	return mmSanitizeHTML
+}
+
+// Expect sets up expected params for Sanitizer.SanitizeHTML
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Expect(html string) *mSanitizerMockSanitizeHTML {
+	if mmSanitizeHTML.mock.funcSanitizeHTML != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by Set")
+	}
+
+	if mmSanitizeHTML.defaultExpectation == nil {
+		mmSanitizeHTML.defaultExpectation = &SanitizerMockSanitizeHTMLExpectation{}
+	}
+
+	if mmSanitizeHTML.defaultExpectation.paramPtrs != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by ExpectParams functions")
+	}
+
+	mmSanitizeHTML.defaultExpectation.params = &SanitizerMockSanitizeHTMLParams{html}
+	mmSanitizeHTML.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSanitizeHTML.expectations {
+		if minimock.Equal(e.params, mmSanitizeHTML.defaultExpectation.params) {
+			mmSanitizeHTML.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSanitizeHTML.defaultExpectation.params)
+		}
+	}
+
+	return mmSanitizeHTML
+}
+
+// ExpectHtmlParam1 sets up expected param html for Sanitizer.SanitizeHTML
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) ExpectHtmlParam1(html string) *mSanitizerMockSanitizeHTML {
+	if mmSanitizeHTML.mock.funcSanitizeHTML != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by Set")
+	}
+
+	if mmSanitizeHTML.defaultExpectation == nil {
+		mmSanitizeHTML.defaultExpectation = &SanitizerMockSanitizeHTMLExpectation{}
+	}
+
+	if mmSanitizeHTML.defaultExpectation.params != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by Expect")
+	}
+
+	if mmSanitizeHTML.defaultExpectation.paramPtrs == nil {
+		mmSanitizeHTML.defaultExpectation.paramPtrs = &SanitizerMockSanitizeHTMLParamPtrs{}
+	}
+	mmSanitizeHTML.defaultExpectation.paramPtrs.html = &html
+	mmSanitizeHTML.defaultExpectation.expectationOrigins.originHtml = minimock.CallerInfo(1)
+
+	return mmSanitizeHTML
+}
+
+// Inspect accepts an inspector function that has same arguments as the Sanitizer.SanitizeHTML
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Inspect(f func(html string)) *mSanitizerMockSanitizeHTML {
+	if mmSanitizeHTML.mock.inspectFuncSanitizeHTML != nil {
+		mmSanitizeHTML.mock.t.Fatalf("Inspect function is already set for SanitizerMock.SanitizeHTML")
+	}
+
+	mmSanitizeHTML.mock.inspectFuncSanitizeHTML = f
+
+	return mmSanitizeHTML
+}
+
+// Return sets up results that will be returned by Sanitizer.SanitizeHTML
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Return(s1 string) *SanitizerMock {
+	if mmSanitizeHTML.mock.funcSanitizeHTML != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by Set")
+	}
+
+	if mmSanitizeHTML.defaultExpectation == nil {
+		mmSanitizeHTML.defaultExpectation = &SanitizerMockSanitizeHTMLExpectation{mock: mmSanitizeHTML.mock}
+	}
+	mmSanitizeHTML.defaultExpectation.results = &SanitizerMockSanitizeHTMLResults{s1}
+	mmSanitizeHTML.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSanitizeHTML.mock
+}
+
+// Set uses given function f to mock the Sanitizer.SanitizeHTML method
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Set(f func(html string) (s1 string)) *SanitizerMock {
+	if mmSanitizeHTML.defaultExpectation != nil {
+		mmSanitizeHTML.mock.t.Fatalf("Default expectation is already set for the Sanitizer.SanitizeHTML method")
+	}
+
+	if len(mmSanitizeHTML.expectations) > 0 {
+		mmSanitizeHTML.mock.t.Fatalf("Some expectations are already set for the Sanitizer.SanitizeHTML method")
+	}
+
+	mmSanitizeHTML.mock.funcSanitizeHTML = f
+	mmSanitizeHTML.mock.funcSanitizeHTMLOrigin = minimock.CallerInfo(1)
+	return mmSanitizeHTML.mock
+}
+
+// When sets expectation for the Sanitizer.SanitizeHTML which will trigger the result defined by the following
+// Then helper
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) When(html string) *SanitizerMockSanitizeHTMLExpectation {
+	if mmSanitizeHTML.mock.funcSanitizeHTML != nil {
+		mmSanitizeHTML.mock.t.Fatalf("SanitizerMock.SanitizeHTML mock is already set by Set")
+	}
+
+	expectation := &SanitizerMockSanitizeHTMLExpectation{
+		mock:               mmSanitizeHTML.mock,
+		params:             &SanitizerMockSanitizeHTMLParams{html},
+		expectationOrigins: SanitizerMockSanitizeHTMLExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSanitizeHTML.expectations = append(mmSanitizeHTML.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Sanitizer.SanitizeHTML return parameters for the expectation previously defined by the When method
+func (e *SanitizerMockSanitizeHTMLExpectation) Then(s1 string) *SanitizerMock {
+	e.results = &SanitizerMockSanitizeHTMLResults{s1}
+	return e.mock
+}
+
+// Times sets number of times Sanitizer.SanitizeHTML should be invoked
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Times(n uint64) *mSanitizerMockSanitizeHTML {
+	if n == 0 {
+		mmSanitizeHTML.mock.t.Fatalf("Times of SanitizerMock.SanitizeHTML mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSanitizeHTML.expectedInvocations, n)
+	mmSanitizeHTML.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSanitizeHTML
+}
+
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) invocationsDone() bool {
+	if len(mmSanitizeHTML.expectations) == 0 && mmSanitizeHTML.defaultExpectation == nil && mmSanitizeHTML.mock.funcSanitizeHTML == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSanitizeHTML.mock.afterSanitizeHTMLCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSanitizeHTML.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SanitizeHTML implements mm_public.Sanitizer
+func (mmSanitizeHTML *SanitizerMock) SanitizeHTML(html string) (s1 string) {
+	mm_atomic.AddUint64(&mmSanitizeHTML.beforeSanitizeHTMLCounter, 1)
+	defer mm_atomic.AddUint64(&mmSanitizeHTML.afterSanitizeHTMLCounter, 1)
+
+	mmSanitizeHTML.t.Helper()
+
+	if mmSanitizeHTML.inspectFuncSanitizeHTML != nil {
+		mmSanitizeHTML.inspectFuncSanitizeHTML(html)
+	}
+
+	mm_params := SanitizerMockSanitizeHTMLParams{html}
+
+	// Record call args
+	mmSanitizeHTML.SanitizeHTMLMock.mutex.Lock()
+	mmSanitizeHTML.SanitizeHTMLMock.callArgs = append(mmSanitizeHTML.SanitizeHTMLMock.callArgs, &mm_params)
+	mmSanitizeHTML.SanitizeHTMLMock.mutex.Unlock()
+
+	for _, e := range mmSanitizeHTML.SanitizeHTMLMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1
+		}
+	}
+
+	if mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.Counter, 1)
+		mm_want := mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.params
+		mm_want_ptrs := mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.paramPtrs
+
+		mm_got := SanitizerMockSanitizeHTMLParams{html}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.html != nil && !minimock.Equal(*mm_want_ptrs.html, mm_got.html) {
+				mmSanitizeHTML.t.Errorf("SanitizerMock.SanitizeHTML got unexpected parameter html, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.expectationOrigins.originHtml, *mm_want_ptrs.html, mm_got.html, minimock.Diff(*mm_want_ptrs.html, mm_got.html))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSanitizeHTML.t.Errorf("SanitizerMock.SanitizeHTML got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSanitizeHTML.SanitizeHTMLMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSanitizeHTML.t.Fatal("No results are set for the SanitizerMock.SanitizeHTML")
+		}
+		return (*mm_results).s1
+	}
+	if mmSanitizeHTML.funcSanitizeHTML != nil {
+		return mmSanitizeHTML.funcSanitizeHTML(html)
+	}
+	mmSanitizeHTML.t.Fatalf("Unexpected call to SanitizerMock.SanitizeHTML. %v", html)
+	return
+}
+
+// SanitizeHTMLAfterCounter returns a count of finished SanitizerMock.SanitizeHTML invocations
+func (mmSanitizeHTML *SanitizerMock) SanitizeHTMLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSanitizeHTML.afterSanitizeHTMLCounter)
+}
+
+// SanitizeHTMLBeforeCounter returns a count of SanitizerMock.SanitizeHTML invocations
+func (mmSanitizeHTML *SanitizerMock) SanitizeHTMLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSanitizeHTML.beforeSanitizeHTMLCounter)
+}
+
+// Calls returns a list of arguments used in each call to SanitizerMock.SanitizeHTML.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSanitizeHTML *mSanitizerMockSanitizeHTML) Calls() []*SanitizerMockSanitizeHTMLParams {
+	mmSanitizeHTML.mutex.RLock()
+
+	argCopy := make([]*SanitizerMockSanitizeHTMLParams, len(mmSanitizeHTML.callArgs))
+	copy(argCopy, mmSanitizeHTML.callArgs)
+
+	mmSanitizeHTML.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSanitizeHTMLDone returns true if the count of the SanitizeHTML invocations corresponds
+// the number of defined expectations
+func (m *SanitizerMock) MinimockSanitizeHTMLDone() bool {
+	if m.SanitizeHTMLMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SanitizeHTMLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SanitizeHTMLMock.invocationsDone()
+}
+
+// MinimockSanitizeHTMLInspect logs each unmet expectation
+func (m *SanitizerMock) MinimockSanitizeHTMLInspect() {
+	for _, e := range m.SanitizeHTMLMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SanitizerMock.SanitizeHTML at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSanitizeHTMLCounter := mm_atomic.LoadUint64(&m.afterSanitizeHTMLCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SanitizeHTMLMock.defaultExpectation != nil && afterSanitizeHTMLCounter < 1 {
+		if m.SanitizeHTMLMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SanitizerMock.SanitizeHTML at\n%s", m.SanitizeHTMLMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SanitizerMock.SanitizeHTML at\n%s with params: %#v", m.SanitizeHTMLMock.defaultExpectation.expectationOrigins.origin, *m.SanitizeHTMLMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSanitizeHTML != nil && afterSanitizeHTMLCounter < 1 {
+		m.t.Errorf("Expected call to SanitizerMock.SanitizeHTML at\n%s", m.funcSanitizeHTMLOrigin)
+	}
+
+	if !m.SanitizeHTMLMock.invocationsDone() && afterSanitizeHTMLCounter > 0 {
+		m.t.Errorf("Expected %d calls to SanitizerMock.SanitizeHTML at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SanitizeHTMLMock.expectedInvocations), m.SanitizeHTMLMock.expectedInvocationsOrigin, afterSanitizeHTMLCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SanitizerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSanitizeHTMLInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SanitizerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SanitizerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSanitizeHTMLDone()
+}
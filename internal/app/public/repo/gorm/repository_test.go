@@ -0,0 +1,130 @@
+package gorm
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb, nil)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestGetPublished(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	uid := uuid.New()
+	exec(t, gdb, `INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+		VALUES (?,?,'Test','hash',NOW(),NOW(),0)`, uid, uid.String()+"@example.com")
+	now := time.Now().UTC().Truncate(time.Second)
+
+	// root -> child -> grandchild ; root -> draft -> hidden ; root -> deleted ; other
+	root := createEntity(t, gdb, uid, nil, "root", "Root", "root content", now.Add(-3*time.Hour), true)
+	child := createEntity(t, gdb, uid, &root, "child", "Child", "child content", now.Add(-time.Hour), true)
+	grandchild := createEntity(t, gdb, uid, &child, "grandchild", "Grandchild", "", now.Add(-2*time.Hour), true)
+	draft := createEntity(t, gdb, uid, &root, "draft", "Draft", "", now, false)
+	createEntity(t, gdb, uid, &draft, "hidden", "Hidden", "", now, true)
+	deleted := createEntity(t, gdb, uid, &root, "deleted", "Deleted", "", now, true)
+	exec(t, gdb, `UPDATE entities SET deleted_at = NOW() WHERE id = ?`, deleted)
+	other := createEntity(t, gdb, uid, nil, "other", "Other", "", now, true)
+
+	docs, err := repo.GetPublished(t.Context(), []uuid.UUID{root, child}, 4)
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+	require.True(t, now.Add(-time.Hour).Equal(docs[0].UpdatedAt))
+	docs[0].UpdatedAt = time.Time{}
+	require.Equal(t, public.Document{ID: child, Name: "Child", Slug: "child", Excerpt: "chil"}, docs[0])
+	require.Equal(t, grandchild, docs[1].ID)
+	require.Equal(t, root, docs[2].ID)
+
+	// a draft root publishes nothing
+	docs, err = repo.GetPublished(t.Context(), []uuid.UUID{draft}, 4)
+	require.NoError(t, err)
+	require.Empty(t, docs)
+
+	// a public space is listed without being among the roots
+	exec(t, gdb, `UPDATE entities SET space_settings = '{"public": true}' WHERE id = ?`, other)
+	docs, err = repo.GetPublished(t.Context(), []uuid.UUID{}, 4)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	require.Equal(t, other, docs[0].ID)
+
+	// err
+	cleanup()
+	_, err = repo.GetPublished(t.Context(), []uuid.UUID{root}, 4)
+	require.Error(t, err)
+}
+
+// prefixCipher "encrypts" content by prefixing it with the key id and the additional data.
+type prefixCipher struct{}
+
+func (prefixCipher) Decrypt(ciphertext, keyID string, aad []byte) (string, error) {
+	prefix := keyID + ":" + string(aad) + ":"
+	if !strings.HasPrefix(ciphertext, prefix) {
+		return "", errors.New("wrong key or row")
+	}
+	return strings.TrimPrefix(ciphertext, prefix), nil
+}
+
+func TestGetPublished_Encrypted(t *testing.T) {
+	t.Parallel()
+	plain, gdb, _ := newRepo(t)
+	repo, err := NewRepository(gdb, prefixCipher{})
+	require.NoError(t, err)
+
+	uid := uuid.New()
+	exec(t, gdb, `INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+		VALUES (?,?,'Test','hash',NOW(),NOW(),0)`, uid, uid.String()+"@example.com")
+	root := createEntity(t, gdb, uid, nil, "root", "Root", "", time.Now(), true)
+	exec(t, gdb, `UPDATE entities SET content = ?, content_key_id = 'k1' WHERE id = ?`, "k1:entities/"+root.String()+"/1:ünïcode content", root)
+
+	docs, err := repo.GetPublished(t.Context(), []uuid.UUID{root}, 4)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	require.Equal(t, "ünïc", docs[0].Excerpt)
+
+	_, err = plain.GetPublished(t.Context(), []uuid.UUID{root}, 4)
+	require.Error(t, err)
+}
+
+func exec(t *testing.T, gdb *gorm.DB, query string, args ...any) {
+	t.Helper()
+	require.NoError(t, gdb.WithContext(t.Context()).Exec(query, args...).Error)
+}
+
+func createEntity(t *testing.T, gdb *gorm.DB, userID uuid.UUID, parentID *uuid.UUID, slug, name, content string, updatedAt time.Time, published bool) uuid.UUID {
+	t.Helper()
+
+	eid := uuid.New()
+	var version *int
+	if published {
+		version = &[]int{1}[0]
+	}
+	exec(t, gdb, `INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,parent_id,created_by,updated_by,owner_id,current_version)
+		VALUES (?,'article',?,?,?,?,?,?,?,?,?,?)`, eid, updatedAt, updatedAt, name, slug, content, parentID, userID, userID, userID, version)
+
+	return eid
+}
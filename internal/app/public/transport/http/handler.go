@@ -0,0 +1,147 @@
+package http
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+const (
+	sitemapContentType = "application/xml; charset=utf-8"
+	feedContentType    = "application/rss+xml; charset=utf-8"
+	sitemapNamespace   = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+type Service interface {
+	GetListing(ctx context.Context) (public.Listing, error)
+}
+
+// Handler renders the public documents for crawlers and feed readers. It needs no authentication.
+type Handler struct {
+	svc Service
+	cfg public.Config
+}
+
+func NewHandler(svc Service, cfg public.Config) *Handler {
+	if svc == nil {
+		panic("public HTTP handler: nil service")
+	}
+	return &Handler{svc: svc, cfg: cfg}
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// GetSitemap serves GET /sitemap.xml: every published document in the public subtrees with its
+// last modification. It is mounted outside /api/v1, so it is not part of the swagger spec.
+func (h *Handler) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	listing, err := h.svc.GetListing(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	set := urlSet{XMLNS: sitemapNamespace, URLs: make([]sitemapURL, 0, len(listing.Documents))}
+	for _, doc := range listing.Documents {
+		set.URLs = append(set.URLs, sitemapURL{
+			Loc:     h.cfg.URL(doc.Slug),
+			LastMod: doc.UpdatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	h.writeXML(ctx, w, sitemapContentType, listing, set)
+}
+
+// GetFeed serves GET /feed.xml: the public.feed_size most recently updated published documents
+// in the public subtrees as RSS 2.0.
+func (h *Handler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	listing, err := h.svc.GetListing(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	docs := listing.Documents[:min(len(listing.Documents), h.cfg.FeedSize)]
+	channel := rssChannel{
+		Title:       h.cfg.Title,
+		Link:        h.cfg.BaseURL,
+		Description: h.cfg.Title,
+		Items:       make([]rssItem, 0, len(docs)),
+	}
+	if modified := listing.LastModified(); !modified.IsZero() {
+		channel.LastBuildDate = modified.UTC().Format(time.RFC1123Z)
+	}
+	for _, doc := range docs {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       doc.Name,
+			Link:        h.cfg.URL(doc.Slug),
+			GUID:        rssGUID{Value: doc.ID.String()},
+			PubDate:     doc.UpdatedAt.UTC().Format(time.RFC1123Z),
+			Description: doc.Excerpt,
+		})
+	}
+
+	h.writeXML(ctx, w, feedContentType, listing, rss{Version: "2.0", Channel: channel})
+}
+
+func (h *Handler) writeXML(ctx context.Context, w http.ResponseWriter, contentType string, listing public.Listing, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(h.cfg.CacheTTLSeconds))
+	if modified := listing.LastModified(); !modified.IsZero() {
+		w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		logger.Error(ctx, err).Msg("public.Handler.writeXML: failed to write XML")
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		logger.Error(ctx, err).Msg("public.Handler.writeXML: failed to encode XML")
+	}
+}
@@ -0,0 +1,135 @@
+package http_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/public"
+	public_http "github.com/66gu1/easygodocs/internal/app/public/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/public/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+var (
+	updated = time.Date(2025, 9, 30, 15, 4, 5, 0, time.UTC)
+	docA    = public.Document{ID: uuid.New(), Name: "Getting started", Slug: "getting-started", UpdatedAt: updated, Excerpt: "Install & run"}
+	docB    = public.Document{ID: uuid.New(), Name: "FAQ", Slug: "faq", UpdatedAt: updated.Add(-time.Hour)}
+	cfg     = public.Config{BaseURL: "https://docs.example.com", Title: "Docs", FeedSize: 1, CacheTTLSeconds: 300}
+)
+
+func serve(t *testing.T, path string, setup func(mock *mocks.ServiceMock)) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mock := mocks.NewServiceMock(t)
+	setup(mock)
+	h := public_http.NewHandler(mock, cfg)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sitemap.xml", h.GetSitemap)
+	mux.HandleFunc("/feed.xml", h.GetFeed)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return rr
+}
+
+func TestHandler_GetSitemap(t *testing.T) {
+	t.Parallel()
+
+	rr := serve(t, "/sitemap.xml", func(mock *mocks.ServiceMock) {
+		mock.GetListingMock.Expect(minimock.AnyContext).Return(public.Listing{Documents: []public.Document{docA, docB}}, nil)
+	})
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/xml; charset=utf-8", rr.Header().Get("Content-Type"))
+	require.Equal(t, "public, max-age=300", rr.Header().Get("Cache-Control"))
+	require.Equal(t, "Tue, 30 Sep 2025 15:04:05 GMT", rr.Header().Get("Last-Modified"))
+	require.True(t, strings.HasPrefix(rr.Body.String(), xml.Header))
+
+	var got struct {
+		XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		URLs    []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got.URLs, 2)
+	require.Equal(t, "https://docs.example.com/getting-started", got.URLs[0].Loc)
+	require.Equal(t, "2025-09-30T15:04:05Z", got.URLs[0].LastMod)
+	require.Equal(t, "https://docs.example.com/faq", got.URLs[1].Loc)
+}
+
+func TestHandler_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	rr := serve(t, "/feed.xml", func(mock *mocks.ServiceMock) {
+		mock.GetListingMock.Expect(minimock.AnyContext).Return(public.Listing{Documents: []public.Document{docA, docB}}, nil)
+	})
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/rss+xml; charset=utf-8", rr.Header().Get("Content-Type"))
+
+	var got struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			Title         string `xml:"title"`
+			Link          string `xml:"link"`
+			LastBuildDate string `xml:"lastBuildDate"`
+			Items         []struct {
+				Title       string `xml:"title"`
+				Link        string `xml:"link"`
+				GUID        string `xml:"guid"`
+				PubDate     string `xml:"pubDate"`
+				Description string `xml:"description"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	require.NoError(t, xml.Unmarshal(rr.Body.Bytes(), &got))
+	require.Equal(t, "2.0", got.Version)
+	require.Equal(t, "Docs", got.Channel.Title)
+	require.Equal(t, "https://docs.example.com", got.Channel.Link)
+	require.Equal(t, "Tue, 30 Sep 2025 15:04:05 +0000", got.Channel.LastBuildDate)
+	// feed_size caps the items
+	require.Len(t, got.Channel.Items, 1)
+	item := got.Channel.Items[0]
+	require.Equal(t, docA.Name, item.Title)
+	require.Equal(t, "https://docs.example.com/getting-started", item.Link)
+	require.Equal(t, docA.ID.String(), item.GUID)
+	require.Equal(t, "Tue, 30 Sep 2025 15:04:05 +0000", item.PubDate)
+	require.Equal(t, docA.Excerpt, item.Description)
+}
+
+func TestHandler_Empty(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"/sitemap.xml", "/feed.xml"} {
+		rr := serve(t, path, func(mock *mocks.ServiceMock) {
+			mock.GetListingMock.Return(public.Listing{Documents: []public.Document{}}, nil)
+		})
+		require.Equal(t, http.StatusOK, rr.Code, path)
+		require.Empty(t, rr.Header().Get("Last-Modified"), path)
+	}
+}
+
+func TestHandler_Error(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"/sitemap.xml", "/feed.xml"} {
+		rr := serve(t, path, func(mock *mocks.ServiceMock) {
+			mock.GetListingMock.Return(public.Listing{}, fmt.Errorf("error"))
+		})
+		require.Equal(t, http.StatusInternalServerError, rr.Code, path)
+		require.Equal(t, httpx.ProblemContentType, rr.Header().Get("Content-Type"), path)
+	}
+}
@@ -0,0 +1,387 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/public/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/public"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetListing          func(ctx context.Context) (l1 public.Listing, err error)
+	funcGetListingOrigin    string
+	inspectFuncGetListing   func(ctx context.Context)
+	afterGetListingCounter  uint64
+	beforeGetListingCounter uint64
+	GetListingMock          mServiceMockGetListing
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetListingMock = mServiceMockGetListing{mock: m}
+	m.GetListingMock.callArgs = []*ServiceMockGetListingParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockGetListing struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetListingExpectation
+	expectations       []*ServiceMockGetListingExpectation
+
+	callArgs []*ServiceMockGetListingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetListingExpectation specifies expectation struct of the Service.GetListing
+type ServiceMockGetListingExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetListingParams
+	paramPtrs          *ServiceMockGetListingParamPtrs
+	expectationOrigins ServiceMockGetListingExpectationOrigins
+	results            *ServiceMockGetListingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetListingParams contains parameters of the Service.GetListing
+type ServiceMockGetListingParams struct {
+	ctx context.Context
+}
+
+// ServiceMockGetListingParamPtrs contains pointers to parameters of the Service.GetListing
+type ServiceMockGetListingParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockGetListingResults contains results of the Service.GetListing
+type ServiceMockGetListingResults struct {
+	l1  public.Listing
+	err error
+}
+
+// ServiceMockGetListingOrigins contains origins of expectations of the Service.GetListing
+type ServiceMockGetListingExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetListing *mServiceMockGetListing) Optional() *mServiceMockGetListing {
+	mmGetListing.optional = true
+	return mmGetListing
+}
+
+// Expect sets up expected params for Service.GetListing
+func (mmGetListing *mServiceMockGetListing) Expect(ctx context.Context) *mServiceMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &ServiceMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by ExpectParams functions")
+	}
+
+	mmGetListing.defaultExpectation.params = &ServiceMockGetListingParams{ctx}
+	mmGetListing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetListing.expectations {
+		if minimock.Equal(e.params, mmGetListing.defaultExpectation.params) {
+			mmGetListing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetListing.defaultExpectation.params)
+		}
+	}
+
+	return mmGetListing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetListing
+func (mmGetListing *mServiceMockGetListing) ExpectCtxParam1(ctx context.Context) *mServiceMockGetListing {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &ServiceMockGetListingExpectation{}
+	}
+
+	if mmGetListing.defaultExpectation.params != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by Expect")
+	}
+
+	if mmGetListing.defaultExpectation.paramPtrs == nil {
+		mmGetListing.defaultExpectation.paramPtrs = &ServiceMockGetListingParamPtrs{}
+	}
+	mmGetListing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetListing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetListing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetListing
+func (mmGetListing *mServiceMockGetListing) Inspect(f func(ctx context.Context)) *mServiceMockGetListing {
+	if mmGetListing.mock.inspectFuncGetListing != nil {
+		mmGetListing.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetListing")
+	}
+
+	mmGetListing.mock.inspectFuncGetListing = f
+
+	return mmGetListing
+}
+
+// Return sets up results that will be returned by Service.GetListing
+func (mmGetListing *mServiceMockGetListing) Return(l1 public.Listing, err error) *ServiceMock {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by Set")
+	}
+
+	if mmGetListing.defaultExpectation == nil {
+		mmGetListing.defaultExpectation = &ServiceMockGetListingExpectation{mock: mmGetListing.mock}
+	}
+	mmGetListing.defaultExpectation.results = &ServiceMockGetListingResults{l1, err}
+	mmGetListing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetListing.mock
+}
+
+// Set uses given function f to mock the Service.GetListing method
+func (mmGetListing *mServiceMockGetListing) Set(f func(ctx context.Context) (l1 public.Listing, err error)) *ServiceMock {
+	if mmGetListing.defaultExpectation != nil {
+		mmGetListing.mock.t.Fatalf("Default expectation is already set for the Service.GetListing method")
+	}
+
+	if len(mmGetListing.expectations) > 0 {
+		mmGetListing.mock.t.Fatalf("Some expectations are already set for the Service.GetListing method")
+	}
+
+	mmGetListing.mock.funcGetListing = f
+	mmGetListing.mock.funcGetListingOrigin = minimock.CallerInfo(1)
+	return mmGetListing.mock
+}
+
+// When sets expectation for the Service.GetListing which will trigger the result defined by the following
+// Then helper
+func (mmGetListing *mServiceMockGetListing) When(ctx context.Context) *ServiceMockGetListingExpectation {
+	if mmGetListing.mock.funcGetListing != nil {
+		mmGetListing.mock.t.Fatalf("ServiceMock.GetListing mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetListingExpectation{
+		mock:               mmGetListing.mock,
+		params:             &ServiceMockGetListingParams{ctx},
+		expectationOrigins: ServiceMockGetListingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetListing.expectations = append(mmGetListing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetListing return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetListingExpectation) Then(l1 public.Listing, err error) *ServiceMock {
+	e.results = &ServiceMockGetListingResults{l1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetListing should be invoked
+func (mmGetListing *mServiceMockGetListing) Times(n uint64) *mServiceMockGetListing {
+	if n == 0 {
+		mmGetListing.mock.t.Fatalf("Times of ServiceMock.GetListing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetListing.expectedInvocations, n)
+	mmGetListing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetListing
+}
+
+func (mmGetListing *mServiceMockGetListing) invocationsDone() bool {
+	if len(mmGetListing.expectations) == 0 && mmGetListing.defaultExpectation == nil && mmGetListing.mock.funcGetListing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetListing.mock.afterGetListingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetListing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetListing implements mm_http.Service
+func (mmGetListing *ServiceMock) GetListing(ctx context.Context) (l1 public.Listing, err error) {
+	mm_atomic.AddUint64(&mmGetListing.beforeGetListingCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetListing.afterGetListingCounter, 1)
+
+	mmGetListing.t.Helper()
+
+	if mmGetListing.inspectFuncGetListing != nil {
+		mmGetListing.inspectFuncGetListing(ctx)
+	}
+
+	mm_params := ServiceMockGetListingParams{ctx}
+
+	// Record call args
+	mmGetListing.GetListingMock.mutex.Lock()
+	mmGetListing.GetListingMock.callArgs = append(mmGetListing.GetListingMock.callArgs, &mm_params)
+	mmGetListing.GetListingMock.mutex.Unlock()
+
+	for _, e := range mmGetListing.GetListingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.l1, e.results.err
+		}
+	}
+
+	if mmGetListing.GetListingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetListing.GetListingMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetListing.GetListingMock.defaultExpectation.params
+		mm_want_ptrs := mmGetListing.GetListingMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetListingParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetListing.t.Errorf("ServiceMock.GetListing got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetListing.t.Errorf("ServiceMock.GetListing got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetListing.GetListingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetListing.GetListingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetListing.t.Fatal("No results are set for the ServiceMock.GetListing")
+		}
+		return (*mm_results).l1, (*mm_results).err
+	}
+	if mmGetListing.funcGetListing != nil {
+		return mmGetListing.funcGetListing(ctx)
+	}
+	mmGetListing.t.Fatalf("Unexpected call to ServiceMock.GetListing. %v", ctx)
+	return
+}
+
+// GetListingAfterCounter returns a count of finished ServiceMock.GetListing invocations
+func (mmGetListing *ServiceMock) GetListingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListing.afterGetListingCounter)
+}
+
+// GetListingBeforeCounter returns a count of ServiceMock.GetListing invocations
+func (mmGetListing *ServiceMock) GetListingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetListing.beforeGetListingCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetListing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetListing *mServiceMockGetListing) Calls() []*ServiceMockGetListingParams {
+	mmGetListing.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetListingParams, len(mmGetListing.callArgs))
+	copy(argCopy, mmGetListing.callArgs)
+
+	mmGetListing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetListingDone returns true if the count of the GetListing invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetListingDone() bool {
+	if m.GetListingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetListingMock.invocationsDone()
+}
+
+// MinimockGetListingInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetListingInspect() {
+	for _, e := range m.GetListingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetListing at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetListingCounter := mm_atomic.LoadUint64(&m.afterGetListingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetListingMock.defaultExpectation != nil && afterGetListingCounter < 1 {
+		if m.GetListingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetListing at\n%s", m.GetListingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetListing at\n%s with params: %#v", m.GetListingMock.defaultExpectation.expectationOrigins.origin, *m.GetListingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetListing != nil && afterGetListingCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetListing at\n%s", m.funcGetListingOrigin)
+	}
+
+	if !m.GetListingMock.invocationsDone() && afterGetListingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetListing at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetListingMock.expectedInvocations), m.GetListingMock.expectedInvocationsOrigin, afterGetListingCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetListingInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetListingDone()
+}
@@ -0,0 +1,174 @@
+package quarantine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Add(ctx context.Context, upload Upload) error
+	// List returns the quarantined uploads, newest first.
+	List(ctx context.Context) ([]Upload, error)
+	Get(ctx context.Context, id uuid.UUID) (Upload, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// BlobStorage keeps the quarantined files. Delete returns blob.ErrNotFound for missing keys.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Delete(ctx context.Context, key string) error
+}
+
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (scan.Result, error)
+}
+
+type IDGenerator interface {
+	New() (uuid.UUID, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// keyPrefix is where quarantined files are kept in blob storage, apart from the keys uploads are served from.
+const keyPrefix = "quarantine"
+
+type core struct {
+	repo    Repository
+	storage BlobStorage
+	scanner Scanner
+	idGen   IDGenerator
+	timeGen TimeGenerator
+}
+
+func NewCore(repo Repository, storage BlobStorage, scanner Scanner, idGen IDGenerator, timeGen TimeGenerator) (*core, error) {
+	if repo == nil || storage == nil || scanner == nil || idGen == nil || timeGen == nil {
+		return nil, fmt.Errorf("quarantine.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+
+	return &core{repo: repo, storage: storage, scanner: scanner, idGen: idGen, timeGen: timeGen}, nil
+}
+
+// Check scans a file before it is stored. An infected file is copied to the quarantine and rejected
+// with ErrInfected. A file that cannot be scanned is rejected with the scanner's error, so nothing is
+// stored unscanned.
+func (c *core) Check(ctx context.Context, req CheckReq) error {
+	result, size, err := c.scan(ctx, req.Open)
+	if err != nil {
+		return fmt.Errorf("quarantine.core.Check: %w", err)
+	}
+	if !result.Infected {
+		return nil
+	}
+	if err = c.quarantine(ctx, req, result.Signature, size); err != nil {
+		return fmt.Errorf("quarantine.core.Check: %w", err)
+	}
+
+	return fmt.Errorf("quarantine.core.Check: %w", ErrInfected())
+}
+
+func (c *core) scan(ctx context.Context, open func() (io.ReadCloser, error)) (scan.Result, int64, error) {
+	f, err := open()
+	if err != nil {
+		return scan.Result{}, 0, err
+	}
+	defer f.Close()
+
+	counter := &countingReader{r: f}
+	result, err := c.scanner.Scan(ctx, counter)
+	if err != nil {
+		return scan.Result{}, 0, err
+	}
+
+	return result, counter.n, nil
+}
+
+// quarantine stores the file before its row, so a failed insert leaves at most an unreferenced blob
+// that is removed best-effort.
+func (c *core) quarantine(ctx context.Context, req CheckReq, signature string, size int64) error {
+	id, err := c.idGen.New()
+	if err != nil {
+		return err
+	}
+	key := path.Join(keyPrefix, id.String())
+
+	f, err := req.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = c.storage.Put(ctx, key, f); err != nil {
+		return err
+	}
+
+	upload := Upload{
+		ID:            id,
+		Source:        req.Source,
+		Name:          req.Name,
+		Signature:     signature,
+		Size:          size,
+		Key:           key,
+		UploadedBy:    req.UploadedBy,
+		QuarantinedAt: c.timeGen.Now(),
+	}
+	if err = c.repo.Add(ctx, upload); err != nil {
+		if delErr := c.storage.Delete(context.WithoutCancel(ctx), key); delErr != nil {
+			logger.Error(ctx, delErr).
+				Str(FieldUploadID.String(), id.String()).
+				Msg("quarantine.core.quarantine: failed to remove orphaned file")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *core) List(ctx context.Context) ([]Upload, error) {
+	uploads, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("quarantine.core.List: %w", err)
+	}
+
+	return uploads, nil
+}
+
+// Delete removes the upload and its file.
+func (c *core) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("quarantine.core.Delete: %w", apperr.ErrNilUUID(FieldUploadID))
+	}
+	upload, err := c.repo.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("quarantine.core.Delete: %w", err)
+	}
+	if err = c.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("quarantine.core.Delete: %w", err)
+	}
+	if err = c.storage.Delete(ctx, upload.Key); err != nil && !errors.Is(err, blob.ErrNotFound) {
+		return fmt.Errorf("quarantine.core.Delete: %w", err)
+	}
+
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
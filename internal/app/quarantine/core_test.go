@@ -0,0 +1,249 @@
+package quarantine_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/app/quarantine/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	repo    *mocks.RepositoryMock
+	storage *mocks.BlobStorageMock
+	scanner *mocks.ScannerMock
+	idGen   *mocks.IDGeneratorMock
+	timeGen *mocks.TimeGeneratorMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		repo:    mocks.NewRepositoryMock(t),
+		storage: mocks.NewBlobStorageMock(t),
+		scanner: mocks.NewScannerMock(t),
+		idGen:   mocks.NewIDGeneratorMock(t),
+		timeGen: mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+func readAll(t *testing.T) func(context.Context, io.Reader) {
+	return func(_ context.Context, r io.Reader) {
+		_, err := io.ReadAll(r)
+		require.NoError(t, err)
+	}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	m := getMocks(t)
+	_, err := quarantine.NewCore(m.repo, m.storage, nil, m.idGen, m.timeGen)
+	require.Error(t, err)
+}
+
+func TestCore_Check(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		id       = uuid.New()
+		userID   = uuid.New()
+		now      = time.Date(2025, 9, 24, 10, 0, 0, 0, time.UTC)
+		content  = "X5O!P%@AP EICAR"
+		key      = "quarantine/" + id.String()
+		infected = scan.Result{Infected: true, Signature: "Eicar-Test-Signature"}
+		expErr   = fmt.Errorf("test error")
+		req      = quarantine.CheckReq{
+			Source:     quarantine.SourceAvatar,
+			Name:       "avatar",
+			UploadedBy: &userID,
+			Open:       func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader(content)), nil },
+		}
+		upload = quarantine.Upload{
+			ID: id, Source: quarantine.SourceAvatar, Name: "avatar", Signature: "Eicar-Test-Signature",
+			Size: int64(len(content)), Key: key, UploadedBy: &userID, QuarantinedAt: now,
+		}
+	)
+
+	tests := []struct {
+		name  string
+		req   quarantine.CheckReq
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "clean",
+			req:  req,
+			setup: func(m mock) {
+				m.scanner.ScanMock.Inspect(readAll(t)).Return(scan.Result{}, nil)
+			},
+		},
+		{
+			name: "infected",
+			req:  req,
+			setup: func(m mock) {
+				m.scanner.ScanMock.Inspect(readAll(t)).Return(infected, nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.storage.PutMock.Set(func(_ context.Context, k string, r io.Reader) error {
+					require.Equal(t, key, k)
+					data, err := io.ReadAll(r)
+					require.NoError(t, err)
+					require.Equal(t, content, string(data))
+					return nil
+				})
+				m.timeGen.NowMock.Return(now)
+				m.repo.AddMock.Expect(ctx, upload).Return(nil)
+			},
+			err: quarantine.ErrInfected(),
+		},
+		{
+			name: "error/open",
+			req: quarantine.CheckReq{Open: func() (io.ReadCloser, error) {
+				return nil, expErr
+			}},
+			err: expErr,
+		},
+		{
+			name: "error/scan",
+			req:  req,
+			setup: func(m mock) {
+				m.scanner.ScanMock.Return(scan.Result{}, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/put",
+			req:  req,
+			setup: func(m mock) {
+				m.scanner.ScanMock.Inspect(readAll(t)).Return(infected, nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.storage.PutMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/add",
+			req:  req,
+			setup: func(m mock) {
+				m.scanner.ScanMock.Inspect(readAll(t)).Return(infected, nil)
+				m.idGen.NewMock.Return(id, nil)
+				m.storage.PutMock.Return(nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.AddMock.Return(expErr)
+				m.storage.DeleteMock.Expect(minimock.AnyContext, key).Return(nil)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			c, err := quarantine.NewCore(m.repo, m.storage, m.scanner, m.idGen, m.timeGen)
+			require.NoError(t, err)
+
+			err = c.Check(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_Delete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		upload = quarantine.Upload{ID: id, Key: "quarantine/" + id.String()}
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		setup func(m mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			id:   id,
+			setup: func(m mock) {
+				m.repo.GetMock.Expect(ctx, id).Return(upload, nil)
+				m.repo.DeleteMock.Expect(ctx, id).Return(nil)
+				m.storage.DeleteMock.Expect(ctx, upload.Key).Return(nil)
+			},
+		},
+		{
+			name: "ok/file_missing",
+			id:   id,
+			setup: func(m mock) {
+				m.repo.GetMock.Return(upload, nil)
+				m.repo.DeleteMock.Return(nil)
+				m.storage.DeleteMock.Return(blob.ErrNotFound)
+			},
+		},
+		{
+			name: "error/nil_id",
+			id:   uuid.Nil,
+			err:  apperr.ErrNilUUID(quarantine.FieldUploadID),
+		},
+		{
+			name: "error/not_found",
+			id:   id,
+			setup: func(m mock) {
+				m.repo.GetMock.Return(quarantine.Upload{}, quarantine.ErrUploadNotFound())
+			},
+			err: quarantine.ErrUploadNotFound(),
+		},
+		{
+			name: "error/storage",
+			id:   id,
+			setup: func(m mock) {
+				m.repo.GetMock.Return(upload, nil)
+				m.repo.DeleteMock.Return(nil)
+				m.storage.DeleteMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			c, err := quarantine.NewCore(m.repo, m.storage, m.scanner, m.idGen, m.timeGen)
+			require.NoError(t, err)
+
+			err = c.Delete(ctx, tt.id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
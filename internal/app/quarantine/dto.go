@@ -0,0 +1,43 @@
+package quarantine
+
+import (
+	"io"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldUploadID apperr.Field = "upload_id"
+	FieldFile     apperr.Field = "file"
+)
+
+// Source is the feature a file was uploaded through.
+type Source string
+
+const (
+	SourceAvatar     Source = "avatar"
+	SourceConfluence Source = "confluence"
+)
+
+// Upload is a file the scanner found infected. It is kept under the blob key Key until an admin deletes it.
+type Upload struct {
+	ID            uuid.UUID  `json:"id"`
+	Source        Source     `json:"source"`
+	Name          string     `json:"name"`
+	Signature     string     `json:"signature"`
+	Size          int64      `json:"size"`
+	Key           string     `json:"key"`
+	UploadedBy    *uuid.UUID `json:"uploaded_by,omitempty"`
+	QuarantinedAt time.Time  `json:"quarantined_at"`
+}
+
+// CheckReq is a file to scan before it is stored. Open is called for the scan and, if the file is
+// infected, once more to copy it to the quarantine.
+type CheckReq struct {
+	Source     Source
+	Name       string
+	UploadedBy *uuid.UUID
+	Open       func() (io.ReadCloser, error)
+}
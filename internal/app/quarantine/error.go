@@ -0,0 +1,24 @@
+package quarantine
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeInfected       apperr.Code = "quarantine/infected"
+	CodeUploadNotFound apperr.Code = "quarantine/upload_not_found"
+)
+
+func init() {
+	apperr.Register(CodeInfected, "Infected file", apperr.ClassUnprocessable)
+	apperr.Register(CodeUploadNotFound, "Quarantined upload not found", apperr.ClassNotFound)
+}
+
+func ErrInfected() error {
+	return apperr.New("The file did not pass the virus scan", CodeInfected, apperr.ClassUnprocessable, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldFile, Rule: apperr.RuleForbidden,
+		})
+}
+
+func ErrUploadNotFound() error {
+	return apperr.New("Quarantined upload not found", CodeUploadNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
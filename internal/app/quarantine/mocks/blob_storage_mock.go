@@ -0,0 +1,803 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/quarantine.BlobStorage -o blob_storage_mock.go -n BlobStorageMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// BlobStorageMock implements mm_quarantine.BlobStorage
+type BlobStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, key string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, key string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mBlobStorageMockDelete
+
+	funcPut          func(ctx context.Context, key string, r io.Reader) (err error)
+	funcPutOrigin    string
+	inspectFuncPut   func(ctx context.Context, key string, r io.Reader)
+	afterPutCounter  uint64
+	beforePutCounter uint64
+	PutMock          mBlobStorageMockPut
+}
+
+// NewBlobStorageMock returns a mock for mm_quarantine.BlobStorage
+func NewBlobStorageMock(t minimock.Tester) *BlobStorageMock {
+	m := &BlobStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mBlobStorageMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*BlobStorageMockDeleteParams{}
+
+	m.PutMock = mBlobStorageMockPut{mock: m}
+	m.PutMock.callArgs = []*BlobStorageMockPutParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBlobStorageMockDelete struct {
+	optional           bool
+	mock               *BlobStorageMock
+	defaultExpectation *BlobStorageMockDeleteExpectation
+	expectations       []*BlobStorageMockDeleteExpectation
+
+	callArgs []*BlobStorageMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BlobStorageMockDeleteExpectation specifies expectation struct of the BlobStorage.Delete
+type BlobStorageMockDeleteExpectation struct {
+	mock               *BlobStorageMock
+	params             *BlobStorageMockDeleteParams
+	paramPtrs          *BlobStorageMockDeleteParamPtrs
+	expectationOrigins BlobStorageMockDeleteExpectationOrigins
+	results            *BlobStorageMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BlobStorageMockDeleteParams contains parameters of the BlobStorage.Delete
+type BlobStorageMockDeleteParams struct {
+	ctx context.Context
+	key string
+}
+
+// BlobStorageMockDeleteParamPtrs contains pointers to parameters of the BlobStorage.Delete
+type BlobStorageMockDeleteParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// BlobStorageMockDeleteResults contains results of the BlobStorage.Delete
+type BlobStorageMockDeleteResults struct {
+	err error
+}
+
+// BlobStorageMockDeleteOrigins contains origins of expectations of the BlobStorage.Delete
+type BlobStorageMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mBlobStorageMockDelete) Optional() *mBlobStorageMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for BlobStorage.Delete
+func (mmDelete *mBlobStorageMockDelete) Expect(ctx context.Context, key string) *mBlobStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &BlobStorageMockDeleteParams{ctx, key}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlobStorage.Delete
+func (mmDelete *mBlobStorageMockDelete) ExpectCtxParam1(ctx context.Context) *mBlobStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &BlobStorageMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectKeyParam2 sets up expected param key for BlobStorage.Delete
+func (mmDelete *mBlobStorageMockDelete) ExpectKeyParam2(key string) *mBlobStorageMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStorageMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &BlobStorageMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.key = &key
+	mmDelete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlobStorage.Delete
+func (mmDelete *mBlobStorageMockDelete) Inspect(f func(ctx context.Context, key string)) *mBlobStorageMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for BlobStorageMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by BlobStorage.Delete
+func (mmDelete *mBlobStorageMockDelete) Return(err error) *BlobStorageMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStorageMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &BlobStorageMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the BlobStorage.Delete method
+func (mmDelete *mBlobStorageMockDelete) Set(f func(ctx context.Context, key string) (err error)) *BlobStorageMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the BlobStorage.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the BlobStorage.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the BlobStorage.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mBlobStorageMockDelete) When(ctx context.Context, key string) *BlobStorageMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStorageMock.Delete mock is already set by Set")
+	}
+
+	expectation := &BlobStorageMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &BlobStorageMockDeleteParams{ctx, key},
+		expectationOrigins: BlobStorageMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlobStorage.Delete return parameters for the expectation previously defined by the When method
+func (e *BlobStorageMockDeleteExpectation) Then(err error) *BlobStorageMock {
+	e.results = &BlobStorageMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlobStorage.Delete should be invoked
+func (mmDelete *mBlobStorageMockDelete) Times(n uint64) *mBlobStorageMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of BlobStorageMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mBlobStorageMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_quarantine.BlobStorage
+func (mmDelete *BlobStorageMock) Delete(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, key)
+	}
+
+	mm_params := BlobStorageMockDeleteParams{ctx, key}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := BlobStorageMockDeleteParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("BlobStorageMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDelete.t.Errorf("BlobStorageMock.Delete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("BlobStorageMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the BlobStorageMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, key)
+	}
+	mmDelete.t.Fatalf("Unexpected call to BlobStorageMock.Delete. %v %v", ctx, key)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished BlobStorageMock.Delete invocations
+func (mmDelete *BlobStorageMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of BlobStorageMock.Delete invocations
+func (mmDelete *BlobStorageMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlobStorageMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mBlobStorageMockDelete) Calls() []*BlobStorageMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*BlobStorageMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *BlobStorageMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *BlobStorageMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlobStorageMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BlobStorageMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BlobStorageMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to BlobStorageMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlobStorageMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mBlobStorageMockPut struct {
+	optional           bool
+	mock               *BlobStorageMock
+	defaultExpectation *BlobStorageMockPutExpectation
+	expectations       []*BlobStorageMockPutExpectation
+
+	callArgs []*BlobStorageMockPutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BlobStorageMockPutExpectation specifies expectation struct of the BlobStorage.Put
+type BlobStorageMockPutExpectation struct {
+	mock               *BlobStorageMock
+	params             *BlobStorageMockPutParams
+	paramPtrs          *BlobStorageMockPutParamPtrs
+	expectationOrigins BlobStorageMockPutExpectationOrigins
+	results            *BlobStorageMockPutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BlobStorageMockPutParams contains parameters of the BlobStorage.Put
+type BlobStorageMockPutParams struct {
+	ctx context.Context
+	key string
+	r   io.Reader
+}
+
+// BlobStorageMockPutParamPtrs contains pointers to parameters of the BlobStorage.Put
+type BlobStorageMockPutParamPtrs struct {
+	ctx *context.Context
+	key *string
+	r   *io.Reader
+}
+
+// BlobStorageMockPutResults contains results of the BlobStorage.Put
+type BlobStorageMockPutResults struct {
+	err error
+}
+
+// BlobStorageMockPutOrigins contains origins of expectations of the BlobStorage.Put
+type BlobStorageMockPutExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+	originR   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPut *mBlobStorageMockPut) Optional() *mBlobStorageMockPut {
+	mmPut.optional = true
+	return mmPut
+}
+
+// Expect sets up expected params for BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) Expect(ctx context.Context, key string, r io.Reader) *mBlobStorageMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStorageMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.paramPtrs != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by ExpectParams functions")
+	}
+
+	mmPut.defaultExpectation.params = &BlobStorageMockPutParams{ctx, key, r}
+	mmPut.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPut.expectations {
+		if minimock.Equal(e.params, mmPut.defaultExpectation.params) {
+			mmPut.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPut.defaultExpectation.params)
+		}
+	}
+
+	return mmPut
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) ExpectCtxParam1(ctx context.Context) *mBlobStorageMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStorageMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStorageMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPut.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectKeyParam2 sets up expected param key for BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) ExpectKeyParam2(key string) *mBlobStorageMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStorageMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStorageMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.key = &key
+	mmPut.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectRParam3 sets up expected param r for BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) ExpectRParam3(r io.Reader) *mBlobStorageMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStorageMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStorageMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.r = &r
+	mmPut.defaultExpectation.expectationOrigins.originR = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) Inspect(f func(ctx context.Context, key string, r io.Reader)) *mBlobStorageMockPut {
+	if mmPut.mock.inspectFuncPut != nil {
+		mmPut.mock.t.Fatalf("Inspect function is already set for BlobStorageMock.Put")
+	}
+
+	mmPut.mock.inspectFuncPut = f
+
+	return mmPut
+}
+
+// Return sets up results that will be returned by BlobStorage.Put
+func (mmPut *mBlobStorageMockPut) Return(err error) *BlobStorageMock {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStorageMockPutExpectation{mock: mmPut.mock}
+	}
+	mmPut.defaultExpectation.results = &BlobStorageMockPutResults{err}
+	mmPut.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// Set uses given function f to mock the BlobStorage.Put method
+func (mmPut *mBlobStorageMockPut) Set(f func(ctx context.Context, key string, r io.Reader) (err error)) *BlobStorageMock {
+	if mmPut.defaultExpectation != nil {
+		mmPut.mock.t.Fatalf("Default expectation is already set for the BlobStorage.Put method")
+	}
+
+	if len(mmPut.expectations) > 0 {
+		mmPut.mock.t.Fatalf("Some expectations are already set for the BlobStorage.Put method")
+	}
+
+	mmPut.mock.funcPut = f
+	mmPut.mock.funcPutOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// When sets expectation for the BlobStorage.Put which will trigger the result defined by the following
+// Then helper
+func (mmPut *mBlobStorageMockPut) When(ctx context.Context, key string, r io.Reader) *BlobStorageMockPutExpectation {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStorageMock.Put mock is already set by Set")
+	}
+
+	expectation := &BlobStorageMockPutExpectation{
+		mock:               mmPut.mock,
+		params:             &BlobStorageMockPutParams{ctx, key, r},
+		expectationOrigins: BlobStorageMockPutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPut.expectations = append(mmPut.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlobStorage.Put return parameters for the expectation previously defined by the When method
+func (e *BlobStorageMockPutExpectation) Then(err error) *BlobStorageMock {
+	e.results = &BlobStorageMockPutResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlobStorage.Put should be invoked
+func (mmPut *mBlobStorageMockPut) Times(n uint64) *mBlobStorageMockPut {
+	if n == 0 {
+		mmPut.mock.t.Fatalf("Times of BlobStorageMock.Put mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPut.expectedInvocations, n)
+	mmPut.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPut
+}
+
+func (mmPut *mBlobStorageMockPut) invocationsDone() bool {
+	if len(mmPut.expectations) == 0 && mmPut.defaultExpectation == nil && mmPut.mock.funcPut == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPut.mock.afterPutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPut.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Put implements mm_quarantine.BlobStorage
+func (mmPut *BlobStorageMock) Put(ctx context.Context, key string, r io.Reader) (err error) {
+	mm_atomic.AddUint64(&mmPut.beforePutCounter, 1)
+	defer mm_atomic.AddUint64(&mmPut.afterPutCounter, 1)
+
+	mmPut.t.Helper()
+
+	if mmPut.inspectFuncPut != nil {
+		mmPut.inspectFuncPut(ctx, key, r)
+	}
+
+	mm_params := BlobStorageMockPutParams{ctx, key, r}
+
+	// Record call args
+	mmPut.PutMock.mutex.Lock()
+	mmPut.PutMock.callArgs = append(mmPut.PutMock.callArgs, &mm_params)
+	mmPut.PutMock.mutex.Unlock()
+
+	for _, e := range mmPut.PutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPut.PutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPut.PutMock.defaultExpectation.Counter, 1)
+		mm_want := mmPut.PutMock.defaultExpectation.params
+		mm_want_ptrs := mmPut.PutMock.defaultExpectation.paramPtrs
+
+		mm_got := BlobStorageMockPutParams{ctx, key, r}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPut.t.Errorf("BlobStorageMock.Put got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmPut.t.Errorf("BlobStorageMock.Put got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.r != nil && !minimock.Equal(*mm_want_ptrs.r, mm_got.r) {
+				mmPut.t.Errorf("BlobStorageMock.Put got unexpected parameter r, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originR, *mm_want_ptrs.r, mm_got.r, minimock.Diff(*mm_want_ptrs.r, mm_got.r))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPut.t.Errorf("BlobStorageMock.Put got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPut.PutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPut.PutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPut.t.Fatal("No results are set for the BlobStorageMock.Put")
+		}
+		return (*mm_results).err
+	}
+	if mmPut.funcPut != nil {
+		return mmPut.funcPut(ctx, key, r)
+	}
+	mmPut.t.Fatalf("Unexpected call to BlobStorageMock.Put. %v %v %v", ctx, key, r)
+	return
+}
+
+// PutAfterCounter returns a count of finished BlobStorageMock.Put invocations
+func (mmPut *BlobStorageMock) PutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.afterPutCounter)
+}
+
+// PutBeforeCounter returns a count of BlobStorageMock.Put invocations
+func (mmPut *BlobStorageMock) PutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.beforePutCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlobStorageMock.Put.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPut *mBlobStorageMockPut) Calls() []*BlobStorageMockPutParams {
+	mmPut.mutex.RLock()
+
+	argCopy := make([]*BlobStorageMockPutParams, len(mmPut.callArgs))
+	copy(argCopy, mmPut.callArgs)
+
+	mmPut.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPutDone returns true if the count of the Put invocations corresponds
+// the number of defined expectations
+func (m *BlobStorageMock) MinimockPutDone() bool {
+	if m.PutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PutMock.invocationsDone()
+}
+
+// MinimockPutInspect logs each unmet expectation
+func (m *BlobStorageMock) MinimockPutInspect() {
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlobStorageMock.Put at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPutCounter := mm_atomic.LoadUint64(&m.afterPutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PutMock.defaultExpectation != nil && afterPutCounter < 1 {
+		if m.PutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BlobStorageMock.Put at\n%s", m.PutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BlobStorageMock.Put at\n%s with params: %#v", m.PutMock.defaultExpectation.expectationOrigins.origin, *m.PutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPut != nil && afterPutCounter < 1 {
+		m.t.Errorf("Expected call to BlobStorageMock.Put at\n%s", m.funcPutOrigin)
+	}
+
+	if !m.PutMock.invocationsDone() && afterPutCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlobStorageMock.Put at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PutMock.expectedInvocations), m.PutMock.expectedInvocationsOrigin, afterPutCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BlobStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockPutInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BlobStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BlobStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockPutDone()
+}
@@ -0,0 +1,1454 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/quarantine.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_quarantine "github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_quarantine.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, upload mm_quarantine.Upload) (err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, upload mm_quarantine.Upload)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mRepositoryMockAdd
+
+	funcDelete          func(ctx context.Context, id uuid.UUID) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id uuid.UUID)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id uuid.UUID) (u1 mm_quarantine.Upload, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id uuid.UUID)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mRepositoryMockGet
+
+	funcList          func(ctx context.Context) (ua1 []mm_quarantine.Upload, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRepositoryMockList
+}
+
+// NewRepositoryMock returns a mock for mm_quarantine.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*RepositoryMockAddParams{}
+
+	m.DeleteMock = mRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*RepositoryMockDeleteParams{}
+
+	m.GetMock = mRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*RepositoryMockGetParams{}
+
+	m.ListMock = mRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RepositoryMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockAdd struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAddExpectation
+	expectations       []*RepositoryMockAddExpectation
+
+	callArgs []*RepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAddExpectation specifies expectation struct of the Repository.Add
+type RepositoryMockAddExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAddParams
+	paramPtrs          *RepositoryMockAddParamPtrs
+	expectationOrigins RepositoryMockAddExpectationOrigins
+	results            *RepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAddParams contains parameters of the Repository.Add
+type RepositoryMockAddParams struct {
+	ctx    context.Context
+	upload mm_quarantine.Upload
+}
+
+// RepositoryMockAddParamPtrs contains pointers to parameters of the Repository.Add
+type RepositoryMockAddParamPtrs struct {
+	ctx    *context.Context
+	upload *mm_quarantine.Upload
+}
+
+// RepositoryMockAddResults contains results of the Repository.Add
+type RepositoryMockAddResults struct {
+	err error
+}
+
+// RepositoryMockAddOrigins contains origins of expectations of the Repository.Add
+type RepositoryMockAddExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpload string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mRepositoryMockAdd) Optional() *mRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for Repository.Add
+func (mmAdd *mRepositoryMockAdd) Expect(ctx context.Context, upload mm_quarantine.Upload) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &RepositoryMockAddParams{ctx, upload}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Add
+func (mmAdd *mRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &RepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectUploadParam2 sets up expected param upload for Repository.Add
+func (mmAdd *mRepositoryMockAdd) ExpectUploadParam2(upload mm_quarantine.Upload) *mRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &RepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.upload = &upload
+	mmAdd.defaultExpectation.expectationOrigins.originUpload = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Add
+func (mmAdd *mRepositoryMockAdd) Inspect(f func(ctx context.Context, upload mm_quarantine.Upload)) *mRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by Repository.Add
+func (mmAdd *mRepositoryMockAdd) Return(err error) *RepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &RepositoryMockAddResults{err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the Repository.Add method
+func (mmAdd *mRepositoryMockAdd) Set(f func(ctx context.Context, upload mm_quarantine.Upload) (err error)) *RepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the Repository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the Repository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the Repository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mRepositoryMockAdd) When(ctx context.Context, upload mm_quarantine.Upload) *RepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &RepositoryMockAddParams{ctx, upload},
+		expectationOrigins: RepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Add return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Add should be invoked
+func (mmAdd *mRepositoryMockAdd) Times(n uint64) *mRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of RepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_quarantine.Repository
+func (mmAdd *RepositoryMock) Add(ctx context.Context, upload mm_quarantine.Upload) (err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, upload)
+	}
+
+	mm_params := RepositoryMockAddParams{ctx, upload}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddParams{ctx, upload}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.upload != nil && !minimock.Equal(*mm_want_ptrs.upload, mm_got.upload) {
+				mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameter upload, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originUpload, *mm_want_ptrs.upload, mm_got.upload, minimock.Diff(*mm_want_ptrs.upload, mm_got.upload))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the RepositoryMock.Add")
+		}
+		return (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, upload)
+	}
+	mmAdd.t.Fatalf("Unexpected call to RepositoryMock.Add. %v %v", ctx, upload)
+	return
+}
+
+// AddAfterCounter returns a count of finished RepositoryMock.Add invocations
+func (mmAdd *RepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of RepositoryMock.Add invocations
+func (mmAdd *RepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mRepositoryMockAdd) Calls() []*RepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mRepositoryMockDelete struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteExpectation
+	expectations       []*RepositoryMockDeleteExpectation
+
+	callArgs []*RepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteExpectation specifies expectation struct of the Repository.Delete
+type RepositoryMockDeleteExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteParams
+	paramPtrs          *RepositoryMockDeleteParamPtrs
+	expectationOrigins RepositoryMockDeleteExpectationOrigins
+	results            *RepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteParams contains parameters of the Repository.Delete
+type RepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RepositoryMockDeleteParamPtrs contains pointers to parameters of the Repository.Delete
+type RepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RepositoryMockDeleteResults contains results of the Repository.Delete
+type RepositoryMockDeleteResults struct {
+	err error
+}
+
+// RepositoryMockDeleteOrigins contains origins of expectations of the Repository.Delete
+type RepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mRepositoryMockDelete) Optional() *mRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &RepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.Delete
+func (mmDelete *mRepositoryMockDelete) ExpectIdParam2(id uuid.UUID) *mRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &RepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by Repository.Delete
+func (mmDelete *mRepositoryMockDelete) Return(err error) *RepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &RepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &RepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the Repository.Delete method
+func (mmDelete *mRepositoryMockDelete) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *RepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the Repository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the Repository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the Repository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mRepositoryMockDelete) When(ctx context.Context, id uuid.UUID) *RepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("RepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &RepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: RepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Delete return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Delete should be invoked
+func (mmDelete *mRepositoryMockDelete) Times(n uint64) *mRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of RepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_quarantine.Repository
+func (mmDelete *RepositoryMock) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := RepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("RepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the RepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to RepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished RepositoryMock.Delete invocations
+func (mmDelete *RepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of RepositoryMock.Delete invocations
+func (mmDelete *RepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mRepositoryMockDelete) Calls() []*RepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mRepositoryMockGet struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetExpectation
+	expectations       []*RepositoryMockGetExpectation
+
+	callArgs []*RepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetExpectation specifies expectation struct of the Repository.Get
+type RepositoryMockGetExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetParams
+	paramPtrs          *RepositoryMockGetParamPtrs
+	expectationOrigins RepositoryMockGetExpectationOrigins
+	results            *RepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetParams contains parameters of the Repository.Get
+type RepositoryMockGetParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RepositoryMockGetParamPtrs contains pointers to parameters of the Repository.Get
+type RepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RepositoryMockGetResults contains results of the Repository.Get
+type RepositoryMockGetResults struct {
+	u1  mm_quarantine.Upload
+	err error
+}
+
+// RepositoryMockGetOrigins contains origins of expectations of the Repository.Get
+type RepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mRepositoryMockGet) Optional() *mRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for Repository.Get
+func (mmGet *mRepositoryMockGet) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &RepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Get
+func (mmGet *mRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.Get
+func (mmGet *mRepositoryMockGet) ExpectIdParam2(id uuid.UUID) *mRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &RepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Get
+func (mmGet *mRepositoryMockGet) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by Repository.Get
+func (mmGet *mRepositoryMockGet) Return(u1 mm_quarantine.Upload, err error) *RepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &RepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &RepositoryMockGetResults{u1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the Repository.Get method
+func (mmGet *mRepositoryMockGet) Set(f func(ctx context.Context, id uuid.UUID) (u1 mm_quarantine.Upload, err error)) *RepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the Repository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the Repository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the Repository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mRepositoryMockGet) When(ctx context.Context, id uuid.UUID) *RepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("RepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &RepositoryMockGetParams{ctx, id},
+		expectationOrigins: RepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Get return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetExpectation) Then(u1 mm_quarantine.Upload, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Get should be invoked
+func (mmGet *mRepositoryMockGet) Times(n uint64) *mRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of RepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_quarantine.Repository
+func (mmGet *RepositoryMock) Get(ctx context.Context, id uuid.UUID) (u1 mm_quarantine.Upload, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := RepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("RepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("RepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("RepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the RepositoryMock.Get")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to RepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished RepositoryMock.Get invocations
+func (mmGet *RepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of RepositoryMock.Get invocations
+func (mmGet *RepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mRepositoryMockGet) Calls() []*RepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mRepositoryMockList struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListExpectation
+	expectations       []*RepositoryMockListExpectation
+
+	callArgs []*RepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListExpectation specifies expectation struct of the Repository.List
+type RepositoryMockListExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListParams
+	paramPtrs          *RepositoryMockListParamPtrs
+	expectationOrigins RepositoryMockListExpectationOrigins
+	results            *RepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListParams contains parameters of the Repository.List
+type RepositoryMockListParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockListParamPtrs contains pointers to parameters of the Repository.List
+type RepositoryMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockListResults contains results of the Repository.List
+type RepositoryMockListResults struct {
+	ua1 []mm_quarantine.Upload
+	err error
+}
+
+// RepositoryMockListOrigins contains origins of expectations of the Repository.List
+type RepositoryMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRepositoryMockList) Optional() *mRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Repository.List
+func (mmList *mRepositoryMockList) Expect(ctx context.Context) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RepositoryMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.List
+func (mmList *mRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.List
+func (mmList *mRepositoryMockList) Inspect(f func(ctx context.Context)) *mRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Repository.List
+func (mmList *mRepositoryMockList) Return(ua1 []mm_quarantine.Upload, err error) *RepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RepositoryMockListResults{ua1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Repository.List method
+func (mmList *mRepositoryMockList) Set(f func(ctx context.Context) (ua1 []mm_quarantine.Upload, err error)) *RepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Repository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Repository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Repository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRepositoryMockList) When(ctx context.Context) *RepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &RepositoryMockListParams{ctx},
+		expectationOrigins: RepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.List return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListExpectation) Then(ua1 []mm_quarantine.Upload, err error) *RepositoryMock {
+	e.results = &RepositoryMockListResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.List should be invoked
+func (mmList *mRepositoryMockList) Times(n uint64) *mRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_quarantine.Repository
+func (mmList *RepositoryMock) List(ctx context.Context) (ua1 []mm_quarantine.Upload, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := RepositoryMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RepositoryMock.List")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to RepositoryMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRepositoryMockList) Calls() []*RepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,419 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/quarantine.Scanner -o scanner_mock.go -n ScannerMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/scan"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ScannerMock implements mm_quarantine.Scanner
+type ScannerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcScan          func(ctx context.Context, r io.Reader) (r1 scan.Result, err error)
+	funcScanOrigin    string
+	inspectFuncScan   func(ctx context.Context, r io.Reader)
+	afterScanCounter  uint64
+	beforeScanCounter uint64
+	ScanMock          mScannerMockScan
+}
+
+// NewScannerMock returns a mock for mm_quarantine.Scanner
+func NewScannerMock(t minimock.Tester) *ScannerMock {
+	m := &ScannerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ScanMock = mScannerMockScan{mock: m}
+	m.ScanMock.callArgs = []*ScannerMockScanParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mScannerMockScan struct {
+	optional           bool
+	mock               *ScannerMock
+	defaultExpectation *ScannerMockScanExpectation
+	expectations       []*ScannerMockScanExpectation
+
+	callArgs []*ScannerMockScanParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScannerMockScanExpectation specifies expectation struct of the Scanner.Scan
+type ScannerMockScanExpectation struct {
+	mock               *ScannerMock
+	params             *ScannerMockScanParams
+	paramPtrs          *ScannerMockScanParamPtrs
+	expectationOrigins ScannerMockScanExpectationOrigins
+	results            *ScannerMockScanResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScannerMockScanParams contains parameters of the Scanner.Scan
+type ScannerMockScanParams struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// ScannerMockScanParamPtrs contains pointers to parameters of the Scanner.Scan
+type ScannerMockScanParamPtrs struct {
+	ctx *context.Context
+	r   *io.Reader
+}
+
+// ScannerMockScanResults contains results of the Scanner.Scan
+type ScannerMockScanResults struct {
+	r1  scan.Result
+	err error
+}
+
+// ScannerMockScanOrigins contains origins of expectations of the Scanner.Scan
+type ScannerMockScanExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originR   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScan *mScannerMockScan) Optional() *mScannerMockScan {
+	mmScan.optional = true
+	return mmScan
+}
+
+// Expect sets up expected params for Scanner.Scan
+func (mmScan *mScannerMockScan) Expect(ctx context.Context, r io.Reader) *mScannerMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ScannerMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.paramPtrs != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by ExpectParams functions")
+	}
+
+	mmScan.defaultExpectation.params = &ScannerMockScanParams{ctx, r}
+	mmScan.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmScan.expectations {
+		if minimock.Equal(e.params, mmScan.defaultExpectation.params) {
+			mmScan.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScan.defaultExpectation.params)
+		}
+	}
+
+	return mmScan
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Scanner.Scan
+func (mmScan *mScannerMockScan) ExpectCtxParam1(ctx context.Context) *mScannerMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ScannerMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.params != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Expect")
+	}
+
+	if mmScan.defaultExpectation.paramPtrs == nil {
+		mmScan.defaultExpectation.paramPtrs = &ScannerMockScanParamPtrs{}
+	}
+	mmScan.defaultExpectation.paramPtrs.ctx = &ctx
+	mmScan.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmScan
+}
+
+// ExpectRParam2 sets up expected param r for Scanner.Scan
+func (mmScan *mScannerMockScan) ExpectRParam2(r io.Reader) *mScannerMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ScannerMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.params != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Expect")
+	}
+
+	if mmScan.defaultExpectation.paramPtrs == nil {
+		mmScan.defaultExpectation.paramPtrs = &ScannerMockScanParamPtrs{}
+	}
+	mmScan.defaultExpectation.paramPtrs.r = &r
+	mmScan.defaultExpectation.expectationOrigins.originR = minimock.CallerInfo(1)
+
+	return mmScan
+}
+
+// Inspect accepts an inspector function that has same arguments as the Scanner.Scan
+func (mmScan *mScannerMockScan) Inspect(f func(ctx context.Context, r io.Reader)) *mScannerMockScan {
+	if mmScan.mock.inspectFuncScan != nil {
+		mmScan.mock.t.Fatalf("Inspect function is already set for ScannerMock.Scan")
+	}
+
+	mmScan.mock.inspectFuncScan = f
+
+	return mmScan
+}
+
+// Return sets up results that will be returned by Scanner.Scan
+func (mmScan *mScannerMockScan) Return(r1 scan.Result, err error) *ScannerMock {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ScannerMockScanExpectation{mock: mmScan.mock}
+	}
+	mmScan.defaultExpectation.results = &ScannerMockScanResults{r1, err}
+	mmScan.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmScan.mock
+}
+
+// Set uses given function f to mock the Scanner.Scan method
+func (mmScan *mScannerMockScan) Set(f func(ctx context.Context, r io.Reader) (r1 scan.Result, err error)) *ScannerMock {
+	if mmScan.defaultExpectation != nil {
+		mmScan.mock.t.Fatalf("Default expectation is already set for the Scanner.Scan method")
+	}
+
+	if len(mmScan.expectations) > 0 {
+		mmScan.mock.t.Fatalf("Some expectations are already set for the Scanner.Scan method")
+	}
+
+	mmScan.mock.funcScan = f
+	mmScan.mock.funcScanOrigin = minimock.CallerInfo(1)
+	return mmScan.mock
+}
+
+// When sets expectation for the Scanner.Scan which will trigger the result defined by the following
+// Then helper
+func (mmScan *mScannerMockScan) When(ctx context.Context, r io.Reader) *ScannerMockScanExpectation {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ScannerMock.Scan mock is already set by Set")
+	}
+
+	expectation := &ScannerMockScanExpectation{
+		mock:               mmScan.mock,
+		params:             &ScannerMockScanParams{ctx, r},
+		expectationOrigins: ScannerMockScanExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmScan.expectations = append(mmScan.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Scanner.Scan return parameters for the expectation previously defined by the When method
+func (e *ScannerMockScanExpectation) Then(r1 scan.Result, err error) *ScannerMock {
+	e.results = &ScannerMockScanResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times Scanner.Scan should be invoked
+func (mmScan *mScannerMockScan) Times(n uint64) *mScannerMockScan {
+	if n == 0 {
+		mmScan.mock.t.Fatalf("Times of ScannerMock.Scan mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScan.expectedInvocations, n)
+	mmScan.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmScan
+}
+
+func (mmScan *mScannerMockScan) invocationsDone() bool {
+	if len(mmScan.expectations) == 0 && mmScan.defaultExpectation == nil && mmScan.mock.funcScan == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScan.mock.afterScanCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScan.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Scan implements mm_quarantine.Scanner
+func (mmScan *ScannerMock) Scan(ctx context.Context, r io.Reader) (r1 scan.Result, err error) {
+	mm_atomic.AddUint64(&mmScan.beforeScanCounter, 1)
+	defer mm_atomic.AddUint64(&mmScan.afterScanCounter, 1)
+
+	mmScan.t.Helper()
+
+	if mmScan.inspectFuncScan != nil {
+		mmScan.inspectFuncScan(ctx, r)
+	}
+
+	mm_params := ScannerMockScanParams{ctx, r}
+
+	// Record call args
+	mmScan.ScanMock.mutex.Lock()
+	mmScan.ScanMock.callArgs = append(mmScan.ScanMock.callArgs, &mm_params)
+	mmScan.ScanMock.mutex.Unlock()
+
+	for _, e := range mmScan.ScanMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmScan.ScanMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScan.ScanMock.defaultExpectation.Counter, 1)
+		mm_want := mmScan.ScanMock.defaultExpectation.params
+		mm_want_ptrs := mmScan.ScanMock.defaultExpectation.paramPtrs
+
+		mm_got := ScannerMockScanParams{ctx, r}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScan.t.Errorf("ScannerMock.Scan got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScan.ScanMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.r != nil && !minimock.Equal(*mm_want_ptrs.r, mm_got.r) {
+				mmScan.t.Errorf("ScannerMock.Scan got unexpected parameter r, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScan.ScanMock.defaultExpectation.expectationOrigins.originR, *mm_want_ptrs.r, mm_got.r, minimock.Diff(*mm_want_ptrs.r, mm_got.r))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScan.t.Errorf("ScannerMock.Scan got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmScan.ScanMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScan.ScanMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScan.t.Fatal("No results are set for the ScannerMock.Scan")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmScan.funcScan != nil {
+		return mmScan.funcScan(ctx, r)
+	}
+	mmScan.t.Fatalf("Unexpected call to ScannerMock.Scan. %v %v", ctx, r)
+	return
+}
+
+// ScanAfterCounter returns a count of finished ScannerMock.Scan invocations
+func (mmScan *ScannerMock) ScanAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.afterScanCounter)
+}
+
+// ScanBeforeCounter returns a count of ScannerMock.Scan invocations
+func (mmScan *ScannerMock) ScanBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.beforeScanCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScannerMock.Scan.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScan *mScannerMockScan) Calls() []*ScannerMockScanParams {
+	mmScan.mutex.RLock()
+
+	argCopy := make([]*ScannerMockScanParams, len(mmScan.callArgs))
+	copy(argCopy, mmScan.callArgs)
+
+	mmScan.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanDone returns true if the count of the Scan invocations corresponds
+// the number of defined expectations
+func (m *ScannerMock) MinimockScanDone() bool {
+	if m.ScanMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScanMock.invocationsDone()
+}
+
+// MinimockScanInspect logs each unmet expectation
+func (m *ScannerMock) MinimockScanInspect() {
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScannerMock.Scan at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterScanCounter := mm_atomic.LoadUint64(&m.afterScanCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanMock.defaultExpectation != nil && afterScanCounter < 1 {
+		if m.ScanMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScannerMock.Scan at\n%s", m.ScanMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScannerMock.Scan at\n%s with params: %#v", m.ScanMock.defaultExpectation.expectationOrigins.origin, *m.ScanMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScan != nil && afterScanCounter < 1 {
+		m.t.Errorf("Expected call to ScannerMock.Scan at\n%s", m.funcScanOrigin)
+	}
+
+	if !m.ScanMock.invocationsDone() && afterScanCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScannerMock.Scan at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ScanMock.expectedInvocations), m.ScanMock.expectedInvocationsOrigin, afterScanCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ScannerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockScanInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ScannerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ScannerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockScanDone()
+}
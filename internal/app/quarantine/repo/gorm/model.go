@@ -0,0 +1,37 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/google/uuid"
+)
+
+type uploadModel struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	WorkspaceID   uuid.UUID
+	Source        string
+	Name          string
+	Signature     string
+	Size          int64
+	BlobKey       string
+	UploadedBy    *uuid.UUID
+	QuarantinedAt time.Time
+}
+
+func (m *uploadModel) TableName() string {
+	return "quarantined_uploads"
+}
+
+func (m *uploadModel) toDTO() quarantine.Upload {
+	return quarantine.Upload{
+		ID:            m.ID,
+		Source:        quarantine.Source(m.Source),
+		Name:          m.Name,
+		Signature:     m.Signature,
+		Size:          m.Size,
+		Key:           m.BlobKey,
+		UploadedBy:    m.UploadedBy,
+		QuarantinedAt: m.QuarantinedAt,
+	}
+}
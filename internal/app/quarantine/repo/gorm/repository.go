@@ -0,0 +1,83 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// Add records the upload in the workspace of the request, or the default one outside a request.
+func (r *gormRepo) Add(ctx context.Context, upload quarantine.Upload) error {
+	model := uploadModel{
+		ID:            upload.ID,
+		WorkspaceID:   contextx.WorkspaceID(ctx),
+		Source:        string(upload.Source),
+		Name:          upload.Name,
+		Signature:     upload.Signature,
+		Size:          upload.Size,
+		BlobKey:       upload.Key,
+		UploadedBy:    upload.UploadedBy,
+		QuarantinedAt: upload.QuarantinedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return fmt.Errorf("gormRepo.Add: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) List(ctx context.Context) ([]quarantine.Upload, error) {
+	var models []uploadModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).
+		Order("quarantined_at DESC, id").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+
+	return lo.Map(models, func(m uploadModel, _ int) quarantine.Upload { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) Get(ctx context.Context, id uuid.UUID) (quarantine.Upload, error) {
+	var model uploadModel
+
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = quarantine.ErrUploadNotFound()
+		}
+		return quarantine.Upload{}, fmt.Errorf("gormRepo.Get: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	res := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Delete(&uploadModel{})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.Delete: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.Delete: %w", quarantine.ErrUploadNotFound())
+	}
+
+	return nil
+}
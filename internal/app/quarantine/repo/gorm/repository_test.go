@@ -0,0 +1,88 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestUploads(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+	ctx := t.Context()
+
+	userID := createUser(t, gdb)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	older := quarantine.Upload{
+		ID: uuid.New(), Source: quarantine.SourceConfluence, Name: "attachments/1/run.exe", Signature: "Win.Trojan",
+		Size: 10, Key: "quarantine/a", QuarantinedAt: now.Add(-time.Hour),
+	}
+	newer := quarantine.Upload{
+		ID: uuid.New(), Source: quarantine.SourceAvatar, Name: "avatar", Signature: "Eicar-Test-Signature",
+		Size: 68, Key: "quarantine/b", UploadedBy: &userID, QuarantinedAt: now,
+	}
+	require.NoError(t, repo.Add(ctx, older))
+	require.NoError(t, repo.Add(ctx, newer))
+
+	got, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, newer.ID, got[0].ID)
+	require.Equal(t, older.ID, got[1].ID)
+
+	upload, err := repo.Get(ctx, newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, newer.Key, upload.Key)
+	require.Equal(t, &userID, upload.UploadedBy)
+	require.True(t, newer.QuarantinedAt.Equal(upload.QuarantinedAt))
+
+	require.NoError(t, repo.Delete(ctx, newer.ID))
+	_, err = repo.Get(ctx, newer.ID)
+	require.ErrorIs(t, err, quarantine.ErrUploadNotFound())
+	err = repo.Delete(ctx, newer.ID)
+	require.ErrorIs(t, err, quarantine.ErrUploadNotFound())
+
+	// pool closed error
+	cleanup()
+	_, err = repo.List(ctx)
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	email := uid.String() + "@example.com"
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, email, "Test", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const URLParamUploadID = "upload_id"
+
+type Service interface {
+	List(ctx context.Context) ([]quarantine.Upload, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("quarantine HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// List godoc
+// @Summary      List quarantined uploads
+// @Description  Returns the uploads the virus scanner rejected, newest first, with the signature found. The files are kept in blob storage under their key until deleted. Requires admin role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} quarantine.Upload
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/quarantine [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	uploads, err := h.svc.List(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, uploads)
+}
+
+// Delete godoc
+// @Summary      Delete quarantined upload
+// @Description  Removes a quarantined upload and its file. Requires admin role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Param        upload_id path string true "Upload ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/quarantine/{upload_id} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUploadID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(quarantine.FieldUploadID.String(), idStr).
+			Msg("quarantine.Handler.Delete: invalid upload ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.Delete(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	quarantine_http "github.com/66gu1/easygodocs/internal/app/quarantine/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/quarantine/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_List(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "ok -> 200",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.ListMock.Return([]quarantine.Upload{{
+					ID: id, Source: quarantine.SourceAvatar, Name: "avatar", Signature: "Eicar-Test-Signature", Size: 68,
+					Key: "quarantine/" + id.String(),
+				}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: `[{"id":"` + id.String() + `","source":"avatar","name":"avatar","signature":"Eicar-Test-Signature",` +
+				`"size":68,"key":"quarantine/` + id.String() + `","quarantined_at":"0001-01-01T00:00:00Z"}]`,
+		},
+		{
+			name: "not admin -> 403",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.ListMock.Return(nil, apperr.ErrForbidden())
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			tc.setup(mock)
+			h := quarantine_http.NewHandler(mock)
+
+			rr := httptest.NewRecorder()
+			h.List(rr, httptest.NewRequest(http.MethodGet, "/admin/quarantine", nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name       string
+		uploadID   string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "invalid UUID -> 400",
+			uploadID:   "invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "not found -> 404",
+			uploadID: id.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(quarantine.ErrUploadNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:     "ok -> 204",
+			uploadID: id.String(),
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := quarantine_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Delete("/admin/quarantine/{"+quarantine_http.URLParamUploadID+"}", h.Delete)
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/admin/quarantine/"+tc.uploadID, nil))
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	List(ctx context.Context) ([]quarantine.Upload, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("quarantine.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+// List returns the quarantined uploads of the workspace, newest first. The route requires admin role.
+func (s *service) List(ctx context.Context) ([]quarantine.Upload, error) {
+	uploads, err := s.core.List(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("quarantine.service.List: failed to list uploads")
+		return nil, fmt.Errorf("quarantine.service.List: %w", err)
+	}
+
+	return uploads, nil
+}
+
+// Delete removes a quarantined upload and its file. The route requires admin role.
+func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.core.Delete(ctx, id); err != nil {
+		logger.Error(ctx, err).
+			Str(quarantine.FieldUploadID.String(), id.String()).
+			Msg("quarantine.service.Delete: failed to delete upload")
+		return fmt.Errorf("quarantine.service.Delete: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/66gu1/easygodocs/internal/app/quarantine/usecase"
+	"github.com/66gu1/easygodocs/internal/app/quarantine/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core *mocks.CoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core: mocks.NewCoreMock(t),
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		uploads = []quarantine.Upload{{ID: uuid.New(), Signature: "Eicar-Test-Signature"}}
+		errCore = errors.New("db down")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		want  []quarantine.Upload
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.core.ListMock.Expect(ctx).Return(uploads, nil)
+			},
+			want: uploads,
+		},
+		{
+			name: "core error",
+			setup: func(mocks mock) {
+				mocks.core.ListMock.Expect(ctx).Return(nil, errCore)
+			},
+			err: errCore,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mocks := getMocks(t)
+			tc.setup(mocks)
+			svc := usecase.NewService(mocks.core)
+
+			got, err := svc.List(ctx)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = t.Context()
+		id  = uuid.New()
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.core.DeleteMock.Expect(ctx, id).Return(nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(mocks mock) {
+				mocks.core.DeleteMock.Expect(ctx, id).Return(quarantine.ErrUploadNotFound())
+			},
+			err: quarantine.ErrUploadNotFound(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mocks := getMocks(t)
+			tc.setup(mocks)
+			svc := usecase.NewService(mocks.core)
+
+			err := svc.Delete(ctx, id)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
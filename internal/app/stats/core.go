@@ -0,0 +1,120 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	GetTotals(ctx context.Context) (Totals, error)
+	GetEntitiesByType(ctx context.Context) (map[string]int64, error)
+	// GetDailyActivity returns the days since the given UTC midnight that had any activity.
+	GetDailyActivity(ctx context.Context, since time.Time) ([]DailyActivity, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+type Config struct {
+	CacheTTLSeconds int `mapstructure:"cache_ttl_seconds" json:"cache_ttl_seconds"`
+}
+
+func (c Config) Validate() error {
+	if c.CacheTTLSeconds <= 0 {
+		return fmt.Errorf("cache_ttl_seconds must be positive")
+	}
+
+	return nil
+}
+
+// core computes the stats on demand and caches them per workspace, so dashboard refreshes
+// do not repeat the aggregate queries.
+type core struct {
+	repo    Repository
+	timeGen TimeGenerator
+	cfg     Config
+
+	mu     sync.Mutex
+	cached map[uuid.UUID]cachedStats
+}
+
+// cachedStats are the stats of a workspace until they expire.
+type cachedStats struct {
+	stats   Stats
+	expires time.Time
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil {
+		return nil, fmt.Errorf("stats.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("stats.NewCore: %w", err)
+	}
+
+	return &core{repo: repo, timeGen: timeGen, cfg: cfg, cached: make(map[uuid.UUID]cachedStats)}, nil
+}
+
+// Get returns the cached stats of the workspace in ctx or computes them. Concurrent callers wait for a
+// single computation.
+func (c *core) Get(ctx context.Context) (Stats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	workspaceID := contextx.WorkspaceID(ctx)
+	now := c.timeGen.Now()
+	if cached, ok := c.cached[workspaceID]; ok && now.Before(cached.expires) {
+		return cached.stats, nil
+	}
+
+	stats, err := c.compute(ctx, now)
+	if err != nil {
+		return Stats{}, fmt.Errorf("stats.core.Get: %w", err)
+	}
+	c.cached[workspaceID] = cachedStats{stats: stats, expires: now.Add(time.Duration(c.cfg.CacheTTLSeconds) * time.Second)}
+
+	return stats, nil
+}
+
+func (c *core) compute(ctx context.Context, now time.Time) (Stats, error) {
+	totals, err := c.repo.GetTotals(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("compute: %w", err)
+	}
+	byType, err := c.repo.GetEntitiesByType(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("compute: %w", err)
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, -(ActivityDays - 1))
+	active, err := c.repo.GetDailyActivity(ctx, since)
+	if err != nil {
+		return Stats{}, fmt.Errorf("compute: %w", err)
+	}
+	byDay := make(map[time.Time]DailyActivity, len(active))
+	for _, a := range active {
+		byDay[a.Day.UTC()] = a
+	}
+	daily := make([]DailyActivity, ActivityDays)
+	for i := range daily {
+		day := since.AddDate(0, 0, i)
+		a := byDay[day]
+		daily[i] = DailyActivity{Day: day, Created: a.Created, Updated: a.Updated}
+	}
+
+	return Stats{
+		TotalUsers:     totals.TotalUsers,
+		ActiveSessions: totals.ActiveSessions,
+		EntitiesByType: byType,
+		Daily:          daily,
+		StorageBytes:   totals.StorageBytes,
+		GeneratedAt:    now,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package stats_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/app/stats/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func cfg() stats.Config {
+	return stats.Config{CacheTTLSeconds: 60}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := stats.NewCore(nil, mocks.NewTimeGeneratorMock(t), cfg())
+	require.Error(t, err)
+
+	_, err = stats.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), stats.Config{})
+	require.Error(t, err)
+
+	_, err = stats.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), cfg())
+	require.NoError(t, err)
+}
+
+func TestCore_Get(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		now    = time.Date(2025, 9, 30, 15, 4, 0, 0, time.UTC)
+		today  = time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+		since  = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		totals = stats.Totals{TotalUsers: 3, ActiveSessions: 2, StorageBytes: 1024}
+		byType = map[string]int64{"article": 5, "department": 1}
+	)
+	repo := mocks.NewRepositoryMock(t)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	current := now
+	timeGen.NowMock.Set(func() time.Time { return current })
+	repo.GetTotalsMock.Expect(minimock.AnyContext).Return(totals, nil)
+	repo.GetEntitiesByTypeMock.Expect(minimock.AnyContext).Return(byType, nil)
+	repo.GetDailyActivityMock.Expect(minimock.AnyContext, since).Return([]stats.DailyActivity{
+		{Day: since, Created: 2},
+		{Day: today, Created: 1, Updated: 4},
+	}, nil)
+
+	core, err := stats.NewCore(repo, timeGen, cfg())
+	require.NoError(t, err)
+
+	got, err := core.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, totals.TotalUsers, got.TotalUsers)
+	require.Equal(t, totals.ActiveSessions, got.ActiveSessions)
+	require.Equal(t, totals.StorageBytes, got.StorageBytes)
+	require.Equal(t, byType, got.EntitiesByType)
+	require.Equal(t, now, got.GeneratedAt)
+	require.Len(t, got.Daily, stats.ActivityDays)
+	require.Equal(t, stats.DailyActivity{Day: since, Created: 2}, got.Daily[0])
+	require.Equal(t, stats.DailyActivity{Day: since.AddDate(0, 0, 1)}, got.Daily[1])
+	require.Equal(t, stats.DailyActivity{Day: today, Created: 1, Updated: 4}, got.Daily[stats.ActivityDays-1])
+
+	// served from cache until the period ends
+	current = now.Add(59 * time.Second)
+	cached, err := core.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, got, cached)
+	require.Equal(t, uint64(1), repo.GetTotalsAfterCounter())
+
+	// another workspace has stats of its own
+	_, err = core.Get(contextx.SetWorkspaceID(ctx, uuid.New()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), repo.GetTotalsAfterCounter())
+
+	current = now.Add(time.Minute)
+	_, err = core.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), repo.GetTotalsAfterCounter())
+}
+
+func TestCore_Get_Errors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		now    = time.Date(2025, 9, 30, 15, 4, 0, 0, time.UTC)
+		expErr = errors.New("expected error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(repo *mocks.RepositoryMock)
+	}{
+		{
+			name: "totals",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetTotalsMock.Return(stats.Totals{}, expErr)
+			},
+		},
+		{
+			name: "entities_by_type",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetTotalsMock.Return(stats.Totals{}, nil)
+				repo.GetEntitiesByTypeMock.Return(nil, expErr)
+			},
+		},
+		{
+			name: "daily_activity",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetTotalsMock.Return(stats.Totals{}, nil)
+				repo.GetEntitiesByTypeMock.Return(nil, nil)
+				repo.GetDailyActivityMock.Return(nil, expErr)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Return(now)
+			tt.setup(repo)
+
+			core, err := stats.NewCore(repo, timeGen, cfg())
+			require.NoError(t, err)
+			_, err = core.Get(ctx)
+			require.ErrorIs(t, err, expErr)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package stats
+
+import "time"
+
+// ActivityDays is the length of the daily activity series, counting today.
+const ActivityDays = 30
+
+// Stats is an admin overview of the installation. It may be up to the configured cache period old.
+type Stats struct {
+	TotalUsers     int64 `json:"total_users"`
+	ActiveSessions int64 `json:"active_sessions"`
+	// EntitiesByType counts entities that are not deleted, drafts included.
+	EntitiesByType map[string]int64 `json:"entities_by_type"`
+	// Daily has one entry per UTC day, oldest first, including days without activity.
+	Daily []DailyActivity `json:"daily"`
+	// StorageBytes is the size of uploaded files. Avatars are the only uploads so far.
+	StorageBytes int64     `json:"storage_bytes"`
+	GeneratedAt  time.Time `json:"generated_at"`
+}
+
+// DailyActivity counts documents created and published edits on one UTC day.
+// Draft edits create no version and are not counted.
+type DailyActivity struct {
+	Day     time.Time `json:"day"`
+	Created int64     `json:"created"`
+	Updated int64     `json:"updated"`
+}
+
+// Totals are the scalar counters of Stats, read in one query.
+type Totals struct {
+	TotalUsers     int64
+	ActiveSessions int64
+	StorageBytes   int64
+}
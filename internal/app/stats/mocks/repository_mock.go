@@ -0,0 +1,1069 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/stats.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_stats "github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_stats.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetDailyActivity          func(ctx context.Context, since time.Time) (da1 []mm_stats.DailyActivity, err error)
+	funcGetDailyActivityOrigin    string
+	inspectFuncGetDailyActivity   func(ctx context.Context, since time.Time)
+	afterGetDailyActivityCounter  uint64
+	beforeGetDailyActivityCounter uint64
+	GetDailyActivityMock          mRepositoryMockGetDailyActivity
+
+	funcGetEntitiesByType          func(ctx context.Context) (m1 map[string]int64, err error)
+	funcGetEntitiesByTypeOrigin    string
+	inspectFuncGetEntitiesByType   func(ctx context.Context)
+	afterGetEntitiesByTypeCounter  uint64
+	beforeGetEntitiesByTypeCounter uint64
+	GetEntitiesByTypeMock          mRepositoryMockGetEntitiesByType
+
+	funcGetTotals          func(ctx context.Context) (t1 mm_stats.Totals, err error)
+	funcGetTotalsOrigin    string
+	inspectFuncGetTotals   func(ctx context.Context)
+	afterGetTotalsCounter  uint64
+	beforeGetTotalsCounter uint64
+	GetTotalsMock          mRepositoryMockGetTotals
+}
+
+// NewRepositoryMock returns a mock for mm_stats.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetDailyActivityMock = mRepositoryMockGetDailyActivity{mock: m}
+	m.GetDailyActivityMock.callArgs = []*RepositoryMockGetDailyActivityParams{}
+
+	m.GetEntitiesByTypeMock = mRepositoryMockGetEntitiesByType{mock: m}
+	m.GetEntitiesByTypeMock.callArgs = []*RepositoryMockGetEntitiesByTypeParams{}
+
+	m.GetTotalsMock = mRepositoryMockGetTotals{mock: m}
+	m.GetTotalsMock.callArgs = []*RepositoryMockGetTotalsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetDailyActivity struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetDailyActivityExpectation
+	expectations       []*RepositoryMockGetDailyActivityExpectation
+
+	callArgs []*RepositoryMockGetDailyActivityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetDailyActivityExpectation specifies expectation struct of the Repository.GetDailyActivity
+type RepositoryMockGetDailyActivityExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetDailyActivityParams
+	paramPtrs          *RepositoryMockGetDailyActivityParamPtrs
+	expectationOrigins RepositoryMockGetDailyActivityExpectationOrigins
+	results            *RepositoryMockGetDailyActivityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetDailyActivityParams contains parameters of the Repository.GetDailyActivity
+type RepositoryMockGetDailyActivityParams struct {
+	ctx   context.Context
+	since time.Time
+}
+
+// RepositoryMockGetDailyActivityParamPtrs contains pointers to parameters of the Repository.GetDailyActivity
+type RepositoryMockGetDailyActivityParamPtrs struct {
+	ctx   *context.Context
+	since *time.Time
+}
+
+// RepositoryMockGetDailyActivityResults contains results of the Repository.GetDailyActivity
+type RepositoryMockGetDailyActivityResults struct {
+	da1 []mm_stats.DailyActivity
+	err error
+}
+
+// RepositoryMockGetDailyActivityOrigins contains origins of expectations of the Repository.GetDailyActivity
+type RepositoryMockGetDailyActivityExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSince string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Optional() *mRepositoryMockGetDailyActivity {
+	mmGetDailyActivity.optional = true
+	return mmGetDailyActivity
+}
+
+// Expect sets up expected params for Repository.GetDailyActivity
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Expect(ctx context.Context, since time.Time) *mRepositoryMockGetDailyActivity {
+	if mmGetDailyActivity.mock.funcGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Set")
+	}
+
+	if mmGetDailyActivity.defaultExpectation == nil {
+		mmGetDailyActivity.defaultExpectation = &RepositoryMockGetDailyActivityExpectation{}
+	}
+
+	if mmGetDailyActivity.defaultExpectation.paramPtrs != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by ExpectParams functions")
+	}
+
+	mmGetDailyActivity.defaultExpectation.params = &RepositoryMockGetDailyActivityParams{ctx, since}
+	mmGetDailyActivity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDailyActivity.expectations {
+		if minimock.Equal(e.params, mmGetDailyActivity.defaultExpectation.params) {
+			mmGetDailyActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDailyActivity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDailyActivity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetDailyActivity
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetDailyActivity {
+	if mmGetDailyActivity.mock.funcGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Set")
+	}
+
+	if mmGetDailyActivity.defaultExpectation == nil {
+		mmGetDailyActivity.defaultExpectation = &RepositoryMockGetDailyActivityExpectation{}
+	}
+
+	if mmGetDailyActivity.defaultExpectation.params != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Expect")
+	}
+
+	if mmGetDailyActivity.defaultExpectation.paramPtrs == nil {
+		mmGetDailyActivity.defaultExpectation.paramPtrs = &RepositoryMockGetDailyActivityParamPtrs{}
+	}
+	mmGetDailyActivity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDailyActivity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDailyActivity
+}
+
+// ExpectSinceParam2 sets up expected param since for Repository.GetDailyActivity
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) ExpectSinceParam2(since time.Time) *mRepositoryMockGetDailyActivity {
+	if mmGetDailyActivity.mock.funcGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Set")
+	}
+
+	if mmGetDailyActivity.defaultExpectation == nil {
+		mmGetDailyActivity.defaultExpectation = &RepositoryMockGetDailyActivityExpectation{}
+	}
+
+	if mmGetDailyActivity.defaultExpectation.params != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Expect")
+	}
+
+	if mmGetDailyActivity.defaultExpectation.paramPtrs == nil {
+		mmGetDailyActivity.defaultExpectation.paramPtrs = &RepositoryMockGetDailyActivityParamPtrs{}
+	}
+	mmGetDailyActivity.defaultExpectation.paramPtrs.since = &since
+	mmGetDailyActivity.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmGetDailyActivity
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetDailyActivity
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Inspect(f func(ctx context.Context, since time.Time)) *mRepositoryMockGetDailyActivity {
+	if mmGetDailyActivity.mock.inspectFuncGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetDailyActivity")
+	}
+
+	mmGetDailyActivity.mock.inspectFuncGetDailyActivity = f
+
+	return mmGetDailyActivity
+}
+
+// Return sets up results that will be returned by Repository.GetDailyActivity
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Return(da1 []mm_stats.DailyActivity, err error) *RepositoryMock {
+	if mmGetDailyActivity.mock.funcGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Set")
+	}
+
+	if mmGetDailyActivity.defaultExpectation == nil {
+		mmGetDailyActivity.defaultExpectation = &RepositoryMockGetDailyActivityExpectation{mock: mmGetDailyActivity.mock}
+	}
+	mmGetDailyActivity.defaultExpectation.results = &RepositoryMockGetDailyActivityResults{da1, err}
+	mmGetDailyActivity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDailyActivity.mock
+}
+
+// Set uses given function f to mock the Repository.GetDailyActivity method
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Set(f func(ctx context.Context, since time.Time) (da1 []mm_stats.DailyActivity, err error)) *RepositoryMock {
+	if mmGetDailyActivity.defaultExpectation != nil {
+		mmGetDailyActivity.mock.t.Fatalf("Default expectation is already set for the Repository.GetDailyActivity method")
+	}
+
+	if len(mmGetDailyActivity.expectations) > 0 {
+		mmGetDailyActivity.mock.t.Fatalf("Some expectations are already set for the Repository.GetDailyActivity method")
+	}
+
+	mmGetDailyActivity.mock.funcGetDailyActivity = f
+	mmGetDailyActivity.mock.funcGetDailyActivityOrigin = minimock.CallerInfo(1)
+	return mmGetDailyActivity.mock
+}
+
+// When sets expectation for the Repository.GetDailyActivity which will trigger the result defined by the following
+// Then helper
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) When(ctx context.Context, since time.Time) *RepositoryMockGetDailyActivityExpectation {
+	if mmGetDailyActivity.mock.funcGetDailyActivity != nil {
+		mmGetDailyActivity.mock.t.Fatalf("RepositoryMock.GetDailyActivity mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetDailyActivityExpectation{
+		mock:               mmGetDailyActivity.mock,
+		params:             &RepositoryMockGetDailyActivityParams{ctx, since},
+		expectationOrigins: RepositoryMockGetDailyActivityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDailyActivity.expectations = append(mmGetDailyActivity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetDailyActivity return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetDailyActivityExpectation) Then(da1 []mm_stats.DailyActivity, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetDailyActivityResults{da1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetDailyActivity should be invoked
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Times(n uint64) *mRepositoryMockGetDailyActivity {
+	if n == 0 {
+		mmGetDailyActivity.mock.t.Fatalf("Times of RepositoryMock.GetDailyActivity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDailyActivity.expectedInvocations, n)
+	mmGetDailyActivity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDailyActivity
+}
+
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) invocationsDone() bool {
+	if len(mmGetDailyActivity.expectations) == 0 && mmGetDailyActivity.defaultExpectation == nil && mmGetDailyActivity.mock.funcGetDailyActivity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDailyActivity.mock.afterGetDailyActivityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDailyActivity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDailyActivity implements mm_stats.Repository
+func (mmGetDailyActivity *RepositoryMock) GetDailyActivity(ctx context.Context, since time.Time) (da1 []mm_stats.DailyActivity, err error) {
+	mm_atomic.AddUint64(&mmGetDailyActivity.beforeGetDailyActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDailyActivity.afterGetDailyActivityCounter, 1)
+
+	mmGetDailyActivity.t.Helper()
+
+	if mmGetDailyActivity.inspectFuncGetDailyActivity != nil {
+		mmGetDailyActivity.inspectFuncGetDailyActivity(ctx, since)
+	}
+
+	mm_params := RepositoryMockGetDailyActivityParams{ctx, since}
+
+	// Record call args
+	mmGetDailyActivity.GetDailyActivityMock.mutex.Lock()
+	mmGetDailyActivity.GetDailyActivityMock.callArgs = append(mmGetDailyActivity.GetDailyActivityMock.callArgs, &mm_params)
+	mmGetDailyActivity.GetDailyActivityMock.mutex.Unlock()
+
+	for _, e := range mmGetDailyActivity.GetDailyActivityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmGetDailyActivity.GetDailyActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetDailyActivityParams{ctx, since}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDailyActivity.t.Errorf("RepositoryMock.GetDailyActivity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmGetDailyActivity.t.Errorf("RepositoryMock.GetDailyActivity got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDailyActivity.t.Errorf("RepositoryMock.GetDailyActivity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDailyActivity.GetDailyActivityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDailyActivity.t.Fatal("No results are set for the RepositoryMock.GetDailyActivity")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmGetDailyActivity.funcGetDailyActivity != nil {
+		return mmGetDailyActivity.funcGetDailyActivity(ctx, since)
+	}
+	mmGetDailyActivity.t.Fatalf("Unexpected call to RepositoryMock.GetDailyActivity. %v %v", ctx, since)
+	return
+}
+
+// GetDailyActivityAfterCounter returns a count of finished RepositoryMock.GetDailyActivity invocations
+func (mmGetDailyActivity *RepositoryMock) GetDailyActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDailyActivity.afterGetDailyActivityCounter)
+}
+
+// GetDailyActivityBeforeCounter returns a count of RepositoryMock.GetDailyActivity invocations
+func (mmGetDailyActivity *RepositoryMock) GetDailyActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDailyActivity.beforeGetDailyActivityCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetDailyActivity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDailyActivity *mRepositoryMockGetDailyActivity) Calls() []*RepositoryMockGetDailyActivityParams {
+	mmGetDailyActivity.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetDailyActivityParams, len(mmGetDailyActivity.callArgs))
+	copy(argCopy, mmGetDailyActivity.callArgs)
+
+	mmGetDailyActivity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDailyActivityDone returns true if the count of the GetDailyActivity invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetDailyActivityDone() bool {
+	if m.GetDailyActivityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDailyActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDailyActivityMock.invocationsDone()
+}
+
+// MinimockGetDailyActivityInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetDailyActivityInspect() {
+	for _, e := range m.GetDailyActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetDailyActivity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDailyActivityCounter := mm_atomic.LoadUint64(&m.afterGetDailyActivityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDailyActivityMock.defaultExpectation != nil && afterGetDailyActivityCounter < 1 {
+		if m.GetDailyActivityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetDailyActivity at\n%s", m.GetDailyActivityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetDailyActivity at\n%s with params: %#v", m.GetDailyActivityMock.defaultExpectation.expectationOrigins.origin, *m.GetDailyActivityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDailyActivity != nil && afterGetDailyActivityCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetDailyActivity at\n%s", m.funcGetDailyActivityOrigin)
+	}
+
+	if !m.GetDailyActivityMock.invocationsDone() && afterGetDailyActivityCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetDailyActivity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDailyActivityMock.expectedInvocations), m.GetDailyActivityMock.expectedInvocationsOrigin, afterGetDailyActivityCounter)
+	}
+}
+
+type mRepositoryMockGetEntitiesByType struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetEntitiesByTypeExpectation
+	expectations       []*RepositoryMockGetEntitiesByTypeExpectation
+
+	callArgs []*RepositoryMockGetEntitiesByTypeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetEntitiesByTypeExpectation specifies expectation struct of the Repository.GetEntitiesByType
+type RepositoryMockGetEntitiesByTypeExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetEntitiesByTypeParams
+	paramPtrs          *RepositoryMockGetEntitiesByTypeParamPtrs
+	expectationOrigins RepositoryMockGetEntitiesByTypeExpectationOrigins
+	results            *RepositoryMockGetEntitiesByTypeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetEntitiesByTypeParams contains parameters of the Repository.GetEntitiesByType
+type RepositoryMockGetEntitiesByTypeParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetEntitiesByTypeParamPtrs contains pointers to parameters of the Repository.GetEntitiesByType
+type RepositoryMockGetEntitiesByTypeParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetEntitiesByTypeResults contains results of the Repository.GetEntitiesByType
+type RepositoryMockGetEntitiesByTypeResults struct {
+	m1  map[string]int64
+	err error
+}
+
+// RepositoryMockGetEntitiesByTypeOrigins contains origins of expectations of the Repository.GetEntitiesByType
+type RepositoryMockGetEntitiesByTypeExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Optional() *mRepositoryMockGetEntitiesByType {
+	mmGetEntitiesByType.optional = true
+	return mmGetEntitiesByType
+}
+
+// Expect sets up expected params for Repository.GetEntitiesByType
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Expect(ctx context.Context) *mRepositoryMockGetEntitiesByType {
+	if mmGetEntitiesByType.mock.funcGetEntitiesByType != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by Set")
+	}
+
+	if mmGetEntitiesByType.defaultExpectation == nil {
+		mmGetEntitiesByType.defaultExpectation = &RepositoryMockGetEntitiesByTypeExpectation{}
+	}
+
+	if mmGetEntitiesByType.defaultExpectation.paramPtrs != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by ExpectParams functions")
+	}
+
+	mmGetEntitiesByType.defaultExpectation.params = &RepositoryMockGetEntitiesByTypeParams{ctx}
+	mmGetEntitiesByType.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetEntitiesByType.expectations {
+		if minimock.Equal(e.params, mmGetEntitiesByType.defaultExpectation.params) {
+			mmGetEntitiesByType.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEntitiesByType.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEntitiesByType
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetEntitiesByType
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetEntitiesByType {
+	if mmGetEntitiesByType.mock.funcGetEntitiesByType != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by Set")
+	}
+
+	if mmGetEntitiesByType.defaultExpectation == nil {
+		mmGetEntitiesByType.defaultExpectation = &RepositoryMockGetEntitiesByTypeExpectation{}
+	}
+
+	if mmGetEntitiesByType.defaultExpectation.params != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by Expect")
+	}
+
+	if mmGetEntitiesByType.defaultExpectation.paramPtrs == nil {
+		mmGetEntitiesByType.defaultExpectation.paramPtrs = &RepositoryMockGetEntitiesByTypeParamPtrs{}
+	}
+	mmGetEntitiesByType.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetEntitiesByType.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetEntitiesByType
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetEntitiesByType
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Inspect(f func(ctx context.Context)) *mRepositoryMockGetEntitiesByType {
+	if mmGetEntitiesByType.mock.inspectFuncGetEntitiesByType != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetEntitiesByType")
+	}
+
+	mmGetEntitiesByType.mock.inspectFuncGetEntitiesByType = f
+
+	return mmGetEntitiesByType
+}
+
+// Return sets up results that will be returned by Repository.GetEntitiesByType
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Return(m1 map[string]int64, err error) *RepositoryMock {
+	if mmGetEntitiesByType.mock.funcGetEntitiesByType != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by Set")
+	}
+
+	if mmGetEntitiesByType.defaultExpectation == nil {
+		mmGetEntitiesByType.defaultExpectation = &RepositoryMockGetEntitiesByTypeExpectation{mock: mmGetEntitiesByType.mock}
+	}
+	mmGetEntitiesByType.defaultExpectation.results = &RepositoryMockGetEntitiesByTypeResults{m1, err}
+	mmGetEntitiesByType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetEntitiesByType.mock
+}
+
+// Set uses given function f to mock the Repository.GetEntitiesByType method
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Set(f func(ctx context.Context) (m1 map[string]int64, err error)) *RepositoryMock {
+	if mmGetEntitiesByType.defaultExpectation != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("Default expectation is already set for the Repository.GetEntitiesByType method")
+	}
+
+	if len(mmGetEntitiesByType.expectations) > 0 {
+		mmGetEntitiesByType.mock.t.Fatalf("Some expectations are already set for the Repository.GetEntitiesByType method")
+	}
+
+	mmGetEntitiesByType.mock.funcGetEntitiesByType = f
+	mmGetEntitiesByType.mock.funcGetEntitiesByTypeOrigin = minimock.CallerInfo(1)
+	return mmGetEntitiesByType.mock
+}
+
+// When sets expectation for the Repository.GetEntitiesByType which will trigger the result defined by the following
+// Then helper
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) When(ctx context.Context) *RepositoryMockGetEntitiesByTypeExpectation {
+	if mmGetEntitiesByType.mock.funcGetEntitiesByType != nil {
+		mmGetEntitiesByType.mock.t.Fatalf("RepositoryMock.GetEntitiesByType mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetEntitiesByTypeExpectation{
+		mock:               mmGetEntitiesByType.mock,
+		params:             &RepositoryMockGetEntitiesByTypeParams{ctx},
+		expectationOrigins: RepositoryMockGetEntitiesByTypeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetEntitiesByType.expectations = append(mmGetEntitiesByType.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetEntitiesByType return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetEntitiesByTypeExpectation) Then(m1 map[string]int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetEntitiesByTypeResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetEntitiesByType should be invoked
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Times(n uint64) *mRepositoryMockGetEntitiesByType {
+	if n == 0 {
+		mmGetEntitiesByType.mock.t.Fatalf("Times of RepositoryMock.GetEntitiesByType mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetEntitiesByType.expectedInvocations, n)
+	mmGetEntitiesByType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetEntitiesByType
+}
+
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) invocationsDone() bool {
+	if len(mmGetEntitiesByType.expectations) == 0 && mmGetEntitiesByType.defaultExpectation == nil && mmGetEntitiesByType.mock.funcGetEntitiesByType == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetEntitiesByType.mock.afterGetEntitiesByTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetEntitiesByType.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetEntitiesByType implements mm_stats.Repository
+func (mmGetEntitiesByType *RepositoryMock) GetEntitiesByType(ctx context.Context) (m1 map[string]int64, err error) {
+	mm_atomic.AddUint64(&mmGetEntitiesByType.beforeGetEntitiesByTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEntitiesByType.afterGetEntitiesByTypeCounter, 1)
+
+	mmGetEntitiesByType.t.Helper()
+
+	if mmGetEntitiesByType.inspectFuncGetEntitiesByType != nil {
+		mmGetEntitiesByType.inspectFuncGetEntitiesByType(ctx)
+	}
+
+	mm_params := RepositoryMockGetEntitiesByTypeParams{ctx}
+
+	// Record call args
+	mmGetEntitiesByType.GetEntitiesByTypeMock.mutex.Lock()
+	mmGetEntitiesByType.GetEntitiesByTypeMock.callArgs = append(mmGetEntitiesByType.GetEntitiesByTypeMock.callArgs, &mm_params)
+	mmGetEntitiesByType.GetEntitiesByTypeMock.mutex.Unlock()
+
+	for _, e := range mmGetEntitiesByType.GetEntitiesByTypeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetEntitiesByTypeParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetEntitiesByType.t.Errorf("RepositoryMock.GetEntitiesByType got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEntitiesByType.t.Errorf("RepositoryMock.GetEntitiesByType got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEntitiesByType.GetEntitiesByTypeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEntitiesByType.t.Fatal("No results are set for the RepositoryMock.GetEntitiesByType")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetEntitiesByType.funcGetEntitiesByType != nil {
+		return mmGetEntitiesByType.funcGetEntitiesByType(ctx)
+	}
+	mmGetEntitiesByType.t.Fatalf("Unexpected call to RepositoryMock.GetEntitiesByType. %v", ctx)
+	return
+}
+
+// GetEntitiesByTypeAfterCounter returns a count of finished RepositoryMock.GetEntitiesByType invocations
+func (mmGetEntitiesByType *RepositoryMock) GetEntitiesByTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEntitiesByType.afterGetEntitiesByTypeCounter)
+}
+
+// GetEntitiesByTypeBeforeCounter returns a count of RepositoryMock.GetEntitiesByType invocations
+func (mmGetEntitiesByType *RepositoryMock) GetEntitiesByTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEntitiesByType.beforeGetEntitiesByTypeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetEntitiesByType.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEntitiesByType *mRepositoryMockGetEntitiesByType) Calls() []*RepositoryMockGetEntitiesByTypeParams {
+	mmGetEntitiesByType.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetEntitiesByTypeParams, len(mmGetEntitiesByType.callArgs))
+	copy(argCopy, mmGetEntitiesByType.callArgs)
+
+	mmGetEntitiesByType.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEntitiesByTypeDone returns true if the count of the GetEntitiesByType invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetEntitiesByTypeDone() bool {
+	if m.GetEntitiesByTypeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetEntitiesByTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetEntitiesByTypeMock.invocationsDone()
+}
+
+// MinimockGetEntitiesByTypeInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetEntitiesByTypeInspect() {
+	for _, e := range m.GetEntitiesByTypeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetEntitiesByType at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetEntitiesByTypeCounter := mm_atomic.LoadUint64(&m.afterGetEntitiesByTypeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEntitiesByTypeMock.defaultExpectation != nil && afterGetEntitiesByTypeCounter < 1 {
+		if m.GetEntitiesByTypeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetEntitiesByType at\n%s", m.GetEntitiesByTypeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetEntitiesByType at\n%s with params: %#v", m.GetEntitiesByTypeMock.defaultExpectation.expectationOrigins.origin, *m.GetEntitiesByTypeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEntitiesByType != nil && afterGetEntitiesByTypeCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetEntitiesByType at\n%s", m.funcGetEntitiesByTypeOrigin)
+	}
+
+	if !m.GetEntitiesByTypeMock.invocationsDone() && afterGetEntitiesByTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetEntitiesByType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetEntitiesByTypeMock.expectedInvocations), m.GetEntitiesByTypeMock.expectedInvocationsOrigin, afterGetEntitiesByTypeCounter)
+	}
+}
+
+type mRepositoryMockGetTotals struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTotalsExpectation
+	expectations       []*RepositoryMockGetTotalsExpectation
+
+	callArgs []*RepositoryMockGetTotalsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTotalsExpectation specifies expectation struct of the Repository.GetTotals
+type RepositoryMockGetTotalsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTotalsParams
+	paramPtrs          *RepositoryMockGetTotalsParamPtrs
+	expectationOrigins RepositoryMockGetTotalsExpectationOrigins
+	results            *RepositoryMockGetTotalsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTotalsParams contains parameters of the Repository.GetTotals
+type RepositoryMockGetTotalsParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetTotalsParamPtrs contains pointers to parameters of the Repository.GetTotals
+type RepositoryMockGetTotalsParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetTotalsResults contains results of the Repository.GetTotals
+type RepositoryMockGetTotalsResults struct {
+	t1  mm_stats.Totals
+	err error
+}
+
+// RepositoryMockGetTotalsOrigins contains origins of expectations of the Repository.GetTotals
+type RepositoryMockGetTotalsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTotals *mRepositoryMockGetTotals) Optional() *mRepositoryMockGetTotals {
+	mmGetTotals.optional = true
+	return mmGetTotals
+}
+
+// Expect sets up expected params for Repository.GetTotals
+func (mmGetTotals *mRepositoryMockGetTotals) Expect(ctx context.Context) *mRepositoryMockGetTotals {
+	if mmGetTotals.mock.funcGetTotals != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by Set")
+	}
+
+	if mmGetTotals.defaultExpectation == nil {
+		mmGetTotals.defaultExpectation = &RepositoryMockGetTotalsExpectation{}
+	}
+
+	if mmGetTotals.defaultExpectation.paramPtrs != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by ExpectParams functions")
+	}
+
+	mmGetTotals.defaultExpectation.params = &RepositoryMockGetTotalsParams{ctx}
+	mmGetTotals.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTotals.expectations {
+		if minimock.Equal(e.params, mmGetTotals.defaultExpectation.params) {
+			mmGetTotals.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTotals.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTotals
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetTotals
+func (mmGetTotals *mRepositoryMockGetTotals) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTotals {
+	if mmGetTotals.mock.funcGetTotals != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by Set")
+	}
+
+	if mmGetTotals.defaultExpectation == nil {
+		mmGetTotals.defaultExpectation = &RepositoryMockGetTotalsExpectation{}
+	}
+
+	if mmGetTotals.defaultExpectation.params != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by Expect")
+	}
+
+	if mmGetTotals.defaultExpectation.paramPtrs == nil {
+		mmGetTotals.defaultExpectation.paramPtrs = &RepositoryMockGetTotalsParamPtrs{}
+	}
+	mmGetTotals.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTotals.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTotals
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetTotals
+func (mmGetTotals *mRepositoryMockGetTotals) Inspect(f func(ctx context.Context)) *mRepositoryMockGetTotals {
+	if mmGetTotals.mock.inspectFuncGetTotals != nil {
+		mmGetTotals.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTotals")
+	}
+
+	mmGetTotals.mock.inspectFuncGetTotals = f
+
+	return mmGetTotals
+}
+
+// Return sets up results that will be returned by Repository.GetTotals
+func (mmGetTotals *mRepositoryMockGetTotals) Return(t1 mm_stats.Totals, err error) *RepositoryMock {
+	if mmGetTotals.mock.funcGetTotals != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by Set")
+	}
+
+	if mmGetTotals.defaultExpectation == nil {
+		mmGetTotals.defaultExpectation = &RepositoryMockGetTotalsExpectation{mock: mmGetTotals.mock}
+	}
+	mmGetTotals.defaultExpectation.results = &RepositoryMockGetTotalsResults{t1, err}
+	mmGetTotals.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTotals.mock
+}
+
+// Set uses given function f to mock the Repository.GetTotals method
+func (mmGetTotals *mRepositoryMockGetTotals) Set(f func(ctx context.Context) (t1 mm_stats.Totals, err error)) *RepositoryMock {
+	if mmGetTotals.defaultExpectation != nil {
+		mmGetTotals.mock.t.Fatalf("Default expectation is already set for the Repository.GetTotals method")
+	}
+
+	if len(mmGetTotals.expectations) > 0 {
+		mmGetTotals.mock.t.Fatalf("Some expectations are already set for the Repository.GetTotals method")
+	}
+
+	mmGetTotals.mock.funcGetTotals = f
+	mmGetTotals.mock.funcGetTotalsOrigin = minimock.CallerInfo(1)
+	return mmGetTotals.mock
+}
+
+// When sets expectation for the Repository.GetTotals which will trigger the result defined by the following
+// Then helper
+func (mmGetTotals *mRepositoryMockGetTotals) When(ctx context.Context) *RepositoryMockGetTotalsExpectation {
+	if mmGetTotals.mock.funcGetTotals != nil {
+		mmGetTotals.mock.t.Fatalf("RepositoryMock.GetTotals mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTotalsExpectation{
+		mock:               mmGetTotals.mock,
+		params:             &RepositoryMockGetTotalsParams{ctx},
+		expectationOrigins: RepositoryMockGetTotalsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTotals.expectations = append(mmGetTotals.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetTotals return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTotalsExpectation) Then(t1 mm_stats.Totals, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTotalsResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetTotals should be invoked
+func (mmGetTotals *mRepositoryMockGetTotals) Times(n uint64) *mRepositoryMockGetTotals {
+	if n == 0 {
+		mmGetTotals.mock.t.Fatalf("Times of RepositoryMock.GetTotals mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTotals.expectedInvocations, n)
+	mmGetTotals.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTotals
+}
+
+func (mmGetTotals *mRepositoryMockGetTotals) invocationsDone() bool {
+	if len(mmGetTotals.expectations) == 0 && mmGetTotals.defaultExpectation == nil && mmGetTotals.mock.funcGetTotals == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTotals.mock.afterGetTotalsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTotals.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTotals implements mm_stats.Repository
+func (mmGetTotals *RepositoryMock) GetTotals(ctx context.Context) (t1 mm_stats.Totals, err error) {
+	mm_atomic.AddUint64(&mmGetTotals.beforeGetTotalsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTotals.afterGetTotalsCounter, 1)
+
+	mmGetTotals.t.Helper()
+
+	if mmGetTotals.inspectFuncGetTotals != nil {
+		mmGetTotals.inspectFuncGetTotals(ctx)
+	}
+
+	mm_params := RepositoryMockGetTotalsParams{ctx}
+
+	// Record call args
+	mmGetTotals.GetTotalsMock.mutex.Lock()
+	mmGetTotals.GetTotalsMock.callArgs = append(mmGetTotals.GetTotalsMock.callArgs, &mm_params)
+	mmGetTotals.GetTotalsMock.mutex.Unlock()
+
+	for _, e := range mmGetTotals.GetTotalsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTotals.GetTotalsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTotals.GetTotalsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTotals.GetTotalsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTotals.GetTotalsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTotalsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTotals.t.Errorf("RepositoryMock.GetTotals got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTotals.GetTotalsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTotals.t.Errorf("RepositoryMock.GetTotals got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTotals.GetTotalsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTotals.GetTotalsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTotals.t.Fatal("No results are set for the RepositoryMock.GetTotals")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTotals.funcGetTotals != nil {
+		return mmGetTotals.funcGetTotals(ctx)
+	}
+	mmGetTotals.t.Fatalf("Unexpected call to RepositoryMock.GetTotals. %v", ctx)
+	return
+}
+
+// GetTotalsAfterCounter returns a count of finished RepositoryMock.GetTotals invocations
+func (mmGetTotals *RepositoryMock) GetTotalsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTotals.afterGetTotalsCounter)
+}
+
+// GetTotalsBeforeCounter returns a count of RepositoryMock.GetTotals invocations
+func (mmGetTotals *RepositoryMock) GetTotalsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTotals.beforeGetTotalsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTotals.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTotals *mRepositoryMockGetTotals) Calls() []*RepositoryMockGetTotalsParams {
+	mmGetTotals.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTotalsParams, len(mmGetTotals.callArgs))
+	copy(argCopy, mmGetTotals.callArgs)
+
+	mmGetTotals.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTotalsDone returns true if the count of the GetTotals invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTotalsDone() bool {
+	if m.GetTotalsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTotalsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTotalsMock.invocationsDone()
+}
+
+// MinimockGetTotalsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTotalsInspect() {
+	for _, e := range m.GetTotalsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTotals at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTotalsCounter := mm_atomic.LoadUint64(&m.afterGetTotalsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTotalsMock.defaultExpectation != nil && afterGetTotalsCounter < 1 {
+		if m.GetTotalsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTotals at\n%s", m.GetTotalsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTotals at\n%s with params: %#v", m.GetTotalsMock.defaultExpectation.expectationOrigins.origin, *m.GetTotalsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTotals != nil && afterGetTotalsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTotals at\n%s", m.funcGetTotalsOrigin)
+	}
+
+	if !m.GetTotalsMock.invocationsDone() && afterGetTotalsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTotals at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTotalsMock.expectedInvocations), m.GetTotalsMock.expectedInvocationsOrigin, afterGetTotalsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetDailyActivityInspect()
+
+			m.MinimockGetEntitiesByTypeInspect()
+
+			m.MinimockGetTotalsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDailyActivityDone() &&
+		m.MinimockGetEntitiesByTypeDone() &&
+		m.MinimockGetTotalsDone()
+}
@@ -0,0 +1,102 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"gorm.io/gorm"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// GetTotals counts a session as active while it is unexpired and its user has not
+// invalidated it by a password change or deletion.
+func (r *gormRepo) GetTotals(ctx context.Context) (stats.Totals, error) {
+	var totals stats.Totals
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM users WHERE @active AND @users) AS total_users,
+			(SELECT COUNT(*)
+			 FROM user_sessions s
+			 JOIN users u ON u.id = s.user_id
+			 WHERE s.expires_at > NOW()
+			   AND s.session_version = u.session_version
+			   AND @active_user
+			   AND @sessions) AS active_sessions,
+			(SELECT COALESCE(SUM(avatar_size), 0) FROM users WHERE @users) AS storage_bytes`,
+		map[string]any{
+			"users": db.WorkspaceCond(ctx, "workspace_id"), "sessions": db.WorkspaceCond(ctx, "u.workspace_id"),
+			"active": db.ActiveCond("deleted_at"), "active_user": db.ActiveCond("u.deleted_at"),
+		}).
+		Scan(&totals).Error
+	if err != nil {
+		return stats.Totals{}, fmt.Errorf("gormRepo.GetTotals: %w", err)
+	}
+
+	return totals, nil
+}
+
+func (r *gormRepo) GetEntitiesByType(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Type  string
+		Count int64
+	}
+	err := r.db.WithContext(ctx).
+		Table("entities").
+		Select("type, COUNT(*) AS count").
+		Scopes(db.InWorkspace(ctx), db.ActiveOnly("deleted_at")).
+		Group("type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetEntitiesByType: %w", err)
+	}
+
+	byType := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		byType[row.Type] = row.Count
+	}
+
+	return byType, nil
+}
+
+// GetDailyActivity counts entity creations and versions after the first one, grouped by UTC day.
+// Deleted entities are included: the activity happened.
+func (r *gormRepo) GetDailyActivity(ctx context.Context, since time.Time) ([]stats.DailyActivity, error) {
+	activity := make([]stats.DailyActivity, 0)
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT day, SUM(created) AS created, SUM(updated) AS updated
+		FROM (
+			SELECT date_trunc('day', created_at AT TIME ZONE 'UTC') AS day, 1 AS created, 0 AS updated
+			FROM entities
+			WHERE created_at >= @since AND @entities
+			UNION ALL
+			SELECT date_trunc('day', v.created_at AT TIME ZONE 'UTC'), 0, 1
+			FROM entity_versions v
+			JOIN entities e ON e.id = v.entity_id
+			WHERE v.created_at >= @since AND v.version > 1 AND @versions
+		) a
+		GROUP BY day
+		ORDER BY day`, map[string]any{
+		"since":    since,
+		"entities": db.WorkspaceCond(ctx, "workspace_id"),
+		"versions": db.WorkspaceCond(ctx, "e.workspace_id"),
+	}).
+		Scan(&activity).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetDailyActivity: %w", err)
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,137 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestGetTotals(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u1 := createUser(t, gdb)
+	u2 := createUser(t, gdb)
+	deleted := createUser(t, gdb)
+	exec(t, gdb, `UPDATE users SET deleted_at = NOW() WHERE id = ?`, deleted)
+	exec(t, gdb, `UPDATE users SET avatar_size = 100 WHERE id = ?`, u1)
+	exec(t, gdb, `UPDATE users SET avatar_size = 50 WHERE id = ?`, deleted)
+
+	createSession(t, gdb, u1, time.Hour, 0)
+	createSession(t, gdb, u2, time.Hour, 0)
+	createSession(t, gdb, u2, -time.Hour, 0)     // expired
+	createSession(t, gdb, u2, time.Hour, 1)      // stale session version
+	createSession(t, gdb, deleted, time.Hour, 0) // user deleted
+
+	totals, err := repo.GetTotals(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, stats.Totals{TotalUsers: 2, ActiveSessions: 2, StorageBytes: 150}, totals)
+
+	// err
+	cleanup()
+	_, err = repo.GetTotals(t.Context())
+	require.Error(t, err)
+}
+
+func TestGetEntitiesByTypeAndDailyActivity(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	var (
+		userID  = createUser(t, gdb)
+		today   = time.Now().UTC().Truncate(24 * time.Hour)
+		old     = today.AddDate(0, 0, -40)
+		article = createEntity(t, gdb, userID, "article", today.Add(time.Hour))
+	)
+	createEntity(t, gdb, userID, "article", old)
+	createEntity(t, gdb, userID, "department", today.Add(2*time.Hour))
+	removed := createEntity(t, gdb, userID, "article", today.AddDate(0, 0, -1))
+	exec(t, gdb, `UPDATE entities SET deleted_at = NOW() WHERE id = ?`, removed)
+	for version := 1; version <= 3; version++ {
+		exec(t, gdb, `INSERT INTO entity_versions (entity_id, version, name, content, created_by, created_at)
+			VALUES (?, ?, 'name', '', ?, ?)`, article, version, userID, today.Add(time.Duration(version)*time.Hour))
+	}
+
+	byType, err := repo.GetEntitiesByType(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"article": 2, "department": 1}, byType)
+
+	activity, err := repo.GetDailyActivity(t.Context(), today.AddDate(0, 0, -29))
+	require.NoError(t, err)
+	require.Len(t, activity, 2)
+	require.True(t, activity[0].Day.Equal(today.AddDate(0, 0, -1)))
+	require.Equal(t, int64(1), activity[0].Created)
+	require.True(t, activity[1].Day.Equal(today))
+	require.Equal(t, int64(2), activity[1].Created)
+	require.Equal(t, int64(2), activity[1].Updated)
+
+	// err
+	cleanup()
+	_, err = repo.GetEntitiesByType(t.Context())
+	require.Error(t, err)
+	_, err = repo.GetDailyActivity(t.Context(), today)
+	require.Error(t, err)
+}
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRepository(nil)
+	require.Error(t, err)
+}
+
+func exec(t *testing.T, gdb *gorm.DB, sql string, args ...any) {
+	t.Helper()
+	require.NoError(t, gdb.WithContext(t.Context()).Exec(sql, args...).Error)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	exec(t, gdb, `INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+		VALUES (?,?,'Test','hash',NOW(),NOW(),0)`, uid, uid.String()+"@example.com")
+
+	return uid
+}
+
+func createSession(t *testing.T, gdb *gorm.DB, userID uuid.UUID, ttl time.Duration, sessionVersion int) {
+	t.Helper()
+
+	exec(t, gdb, `INSERT INTO user_sessions(id,user_id,refresh_token_hash,created_at,expires_at,session_version)
+		VALUES (?,?,'hash',NOW(),?,?)`, uuid.New(), userID, time.Now().Add(ttl), sessionVersion)
+}
+
+func createEntity(t *testing.T, gdb *gorm.DB, userID uuid.UUID, typ string, createdAt time.Time) uuid.UUID {
+	t.Helper()
+
+	eid := uuid.New()
+	exec(t, gdb, `INSERT INTO entities(id,type,created_at,updated_at,name,slug,content,created_by,updated_by,owner_id)
+		VALUES (?,?,?,?,'name',?,'',?,?,?)`, eid, typ, createdAt, createdAt, eid.String(), userID, userID, userID)
+
+	return eid
+}
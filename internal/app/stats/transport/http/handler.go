@@ -0,0 +1,46 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+)
+
+type Service interface {
+	GetStats(ctx context.Context) (stats.Stats, error)
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("stats HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// GetStats godoc
+// @Summary      Dashboard stats
+// @Description  Returns total users, active sessions, entities by type, documents created and edited per day over the last 30 days, and storage used by uploads.
+// @Description  The result is cached for stats.cache_ttl_seconds, see generated_at. Requires admin role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} stats.Stats
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/stats [get]
+func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.svc.GetStats(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, result)
+}
@@ -0,0 +1,74 @@
+package http_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	stats_http "github.com/66gu1/easygodocs/internal/app/stats/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/stats/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_GetStats(t *testing.T) {
+	t.Parallel()
+
+	result := stats.Stats{TotalUsers: 3, EntitiesByType: map[string]int64{"article": 2}}
+
+	tests := []struct {
+		name       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetStatsMock.Expect(minimock.AnyContext).Return(result, nil)
+			},
+		},
+		{
+			name:       "not admin -> 403",
+			wantStatus: http.StatusForbidden,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetStatsMock.Expect(minimock.AnyContext).Return(stats.Stats{}, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "usecase error -> 500",
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetStatsMock.Expect(minimock.AnyContext).Return(stats.Stats{}, fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			tt.setup(svcMock)
+
+			h := stats_http.NewHandler(svcMock)
+			req := httptest.NewRequest(http.MethodGet, "/admin/stats", http.NoBody)
+			rr := httptest.NewRecorder()
+
+			h.GetStats(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got stats.Stats
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				require.Equal(t, result, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type Core interface {
+	Get(ctx context.Context) (stats.Stats, error)
+}
+
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("stats.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+// GetStats returns the dashboard stats. The route requires admin role.
+func (s *service) GetStats(ctx context.Context) (stats.Stats, error) {
+	result, err := s.core.Get(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("stats.service.GetStats: failed to get stats")
+		return stats.Stats{}, fmt.Errorf("stats.service.GetStats: %w", err)
+	}
+
+	return result, nil
+}
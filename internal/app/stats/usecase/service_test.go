@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/stats"
+	"github.com/66gu1/easygodocs/internal/app/stats/usecase"
+	"github.com/66gu1/easygodocs/internal/app/stats/usecase/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestService_GetStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		result = stats.Stats{TotalUsers: 3}
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(core *mocks.CoreMock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(core *mocks.CoreMock) {
+				core.GetMock.Expect(ctx).Return(result, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(core *mocks.CoreMock) {
+				core.GetMock.Expect(ctx).Return(stats.Stats{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			core := mocks.NewCoreMock(t)
+			tt.setup(core)
+
+			got, err := usecase.NewService(core).GetStats(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, result, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package terms
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Accept records the acceptance; accepting a version again keeps the first time.
+	Accept(ctx context.Context, userID uuid.UUID, version string, at time.Time) error
+	HasAccepted(ctx context.Context, userID uuid.UUID, version string) (bool, error)
+	// GetStatus returns the version the user accepted last and whether they accepted the given one.
+	GetStatus(ctx context.Context, userID uuid.UUID, version string) (Status, error)
+	// List returns the status of every user of the workspace, ordered by name.
+	List(ctx context.Context, version string) ([]Status, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// MaxVersionLength caps Config.Version, which is stored with every acceptance.
+const MaxVersionLength = 64
+
+// Config names the terms of service users must accept before using the API; url is where they are
+// published. An empty version requires nothing.
+type Config struct {
+	Version string `mapstructure:"version" json:"version"`
+	URL     string `mapstructure:"url" json:"url"`
+}
+
+func (c Config) Validate() error {
+	if len(c.Version) > MaxVersionLength {
+		return fmt.Errorf("version must not exceed %d bytes", MaxVersionLength)
+	}
+	if c.URL != "" {
+		u, err := url.Parse(c.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("url must be an absolute http(s) URL")
+		}
+	}
+
+	return nil
+}
+
+// core checks acceptance on every request, so it remembers the users known to have accepted the
+// version in force. An acceptance is never withdrawn, so the cache needs no invalidation.
+type core struct {
+	repo    Repository
+	timeGen TimeGenerator
+	cfg     Config
+
+	accepted sync.Map // uuid.UUID -> struct{}
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil {
+		return nil, fmt.Errorf("terms.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("terms.NewCore: %w", err)
+	}
+
+	return &core{repo: repo, timeGen: timeGen, cfg: cfg}, nil
+}
+
+func (c *core) GetTerms() Terms {
+	return Terms{Version: c.cfg.Version, URL: c.cfg.URL}
+}
+
+// Check returns ErrNotAccepted if the user has not accepted the terms in force.
+func (c *core) Check(ctx context.Context, userID uuid.UUID) error {
+	if c.cfg.Version == "" {
+		return nil
+	}
+	if _, ok := c.accepted.Load(userID); ok {
+		return nil
+	}
+
+	ok, err := c.repo.HasAccepted(ctx, userID, c.cfg.Version)
+	if err != nil {
+		return fmt.Errorf("terms.core.Check: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("terms.core.Check: %w", ErrNotAccepted(c.cfg.Version))
+	}
+	c.accepted.Store(userID, struct{}{})
+
+	return nil
+}
+
+// Accept records that the user accepted the terms in force. The version must be the one in force, so
+// that terms changed since the user read them are not accepted unseen.
+func (c *core) Accept(ctx context.Context, userID uuid.UUID, version string) error {
+	if c.cfg.Version == "" {
+		return fmt.Errorf("terms.core.Accept: %w", ErrNoTerms())
+	}
+	if version != c.cfg.Version {
+		return fmt.Errorf("terms.core.Accept: %w", ErrVersionMismatch(c.cfg.Version))
+	}
+
+	if err := c.repo.Accept(ctx, userID, version, c.timeGen.Now().UTC()); err != nil {
+		return fmt.Errorf("terms.core.Accept: %w", err)
+	}
+	c.accepted.Store(userID, struct{}{})
+
+	return nil
+}
+
+func (c *core) GetStatus(ctx context.Context, userID uuid.UUID) (Status, error) {
+	status, err := c.repo.GetStatus(ctx, userID, c.cfg.Version)
+	if err != nil {
+		return Status{}, fmt.Errorf("terms.core.GetStatus: %w", err)
+	}
+	status.Accepted = status.Accepted || c.cfg.Version == ""
+
+	return status, nil
+}
+
+// List returns the acceptance status of every user of the workspace.
+func (c *core) List(ctx context.Context) ([]Status, error) {
+	statuses, err := c.repo.List(ctx, c.cfg.Version)
+	if err != nil {
+		return nil, fmt.Errorf("terms.core.List: %w", err)
+	}
+	for i := range statuses {
+		statuses[i].Accepted = statuses[i].Accepted || c.cfg.Version == ""
+	}
+
+	return statuses, nil
+}
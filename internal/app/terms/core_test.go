@@ -0,0 +1,210 @@
+package terms_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/app/terms/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func cfg() terms.Config {
+	return terms.Config{Version: "2025-09", URL: "https://example.com/terms"}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, terms.Config{}.Validate())
+	require.NoError(t, cfg().Validate())
+	require.Error(t, terms.Config{Version: string(make([]byte, terms.MaxVersionLength+1))}.Validate())
+	require.Error(t, terms.Config{Version: "1", URL: "/terms"}.Validate())
+	require.Error(t, terms.Config{Version: "1", URL: "javascript:alert(1)"}.Validate())
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := terms.NewCore(nil, mocks.NewTimeGeneratorMock(t), cfg())
+	require.Error(t, err)
+
+	_, err = terms.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), terms.Config{URL: "ftp://x"})
+	require.Error(t, err)
+}
+
+func TestCore_Check(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		userID  = uuid.New()
+		errRepo = errors.New("db down")
+	)
+
+	t.Run("no terms", func(t *testing.T) {
+		t.Parallel()
+		core, err := terms.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), terms.Config{})
+		require.NoError(t, err)
+		require.NoError(t, core.Check(ctx, userID))
+	})
+
+	t.Run("not accepted", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.HasAcceptedMock.Expect(minimock.AnyContext, userID, "2025-09").Return(false, nil)
+		core, err := terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), cfg())
+		require.NoError(t, err)
+
+		err = core.Check(ctx, userID)
+		require.ErrorIs(t, err, terms.ErrNotAccepted("2025-09"))
+		require.Equal(t, apperr.ClassForbidden, apperr.ClassOf(err))
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.HasAcceptedMock.Return(false, errRepo)
+		core, err := terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), cfg())
+		require.NoError(t, err)
+		require.ErrorIs(t, core.Check(ctx, userID), errRepo)
+	})
+
+	t.Run("accepted is remembered", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewRepositoryMock(t)
+		repo.HasAcceptedMock.Return(true, nil)
+		core, err := terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), cfg())
+		require.NoError(t, err)
+
+		require.NoError(t, core.Check(ctx, userID))
+		require.NoError(t, core.Check(ctx, userID))
+		require.Equal(t, uint64(1), repo.HasAcceptedAfterCounter())
+	})
+}
+
+func TestCore_Accept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		now     = time.Date(2025, 9, 25, 10, 0, 0, 0, time.UTC)
+		userID  = uuid.New()
+		errRepo = errors.New("db down")
+	)
+
+	tests := []struct {
+		name    string
+		cfg     terms.Config
+		version string
+		setup   func(repo *mocks.RepositoryMock)
+		err     error
+	}{
+		{
+			name:    "ok",
+			cfg:     cfg(),
+			version: "2025-09",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.AcceptMock.Expect(minimock.AnyContext, userID, "2025-09", now).Return(nil)
+			},
+		},
+		{
+			name:    "other version",
+			cfg:     cfg(),
+			version: "2025-01",
+			err:     terms.ErrVersionMismatch("2025-09"),
+		},
+		{
+			name:    "no terms",
+			version: "2025-09",
+			err:     terms.ErrNoTerms(),
+		},
+		{
+			name:    "repo error",
+			cfg:     cfg(),
+			version: "2025-09",
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.AcceptMock.Return(errRepo)
+			},
+			err: errRepo,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Optional().Return(now)
+			if tc.setup != nil {
+				tc.setup(repo)
+			}
+			core, err := terms.NewCore(repo, timeGen, tc.cfg)
+			require.NoError(t, err)
+
+			err = core.Accept(ctx, userID, tc.version)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			// accepted terms need no lookup
+			require.NoError(t, core.Check(ctx, userID))
+		})
+	}
+}
+
+func TestCore_Status(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		at     = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		userID = uuid.New()
+	)
+	repo := mocks.NewRepositoryMock(t)
+	repo.GetStatusMock.Set(func(_ context.Context, id uuid.UUID, version string) (terms.Status, error) {
+		return terms.Status{UserID: id, AcceptedVersion: "2025-01", AcceptedAt: &at, Accepted: version == "2025-01"}, nil
+	})
+	repo.ListMock.Set(func(_ context.Context, version string) ([]terms.Status, error) {
+		return []terms.Status{{UserID: userID}, {UserID: uuid.New(), AcceptedVersion: version, Accepted: version != ""}}, nil
+	})
+
+	core, err := terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), cfg())
+	require.NoError(t, err)
+	status, err := core.GetStatus(ctx, userID)
+	require.NoError(t, err)
+	require.False(t, status.Accepted)
+	require.Equal(t, "2025-01", status.AcceptedVersion)
+	statuses, err := core.List(ctx)
+	require.NoError(t, err)
+	require.False(t, statuses[0].Accepted)
+	require.True(t, statuses[1].Accepted)
+
+	// without terms everyone has accepted them
+	core, err = terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), terms.Config{})
+	require.NoError(t, err)
+	status, err = core.GetStatus(ctx, userID)
+	require.NoError(t, err)
+	require.True(t, status.Accepted)
+	statuses, err = core.List(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Accepted)
+
+	repo = mocks.NewRepositoryMock(t)
+	repo.GetStatusMock.Return(terms.Status{}, apperr.ErrNotFound())
+	repo.ListMock.Return(nil, errors.New("db down"))
+	core, err = terms.NewCore(repo, mocks.NewTimeGeneratorMock(t), cfg())
+	require.NoError(t, err)
+	_, err = core.GetStatus(ctx, userID)
+	require.Equal(t, apperr.ClassNotFound, apperr.ClassOf(err))
+	_, err = core.List(ctx)
+	require.Error(t, err)
+}
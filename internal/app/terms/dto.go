@@ -0,0 +1,28 @@
+package terms
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const FieldVersion apperr.Field = "version"
+
+// Terms are the terms of service in force. Version is empty when there are none.
+type Terms struct {
+	Version string `json:"version"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Status is where a user stands with the terms of service.
+type Status struct {
+	UserID uuid.UUID `json:"user_id"`
+	Name   string    `json:"name,omitempty"`
+	Email  string    `json:"email,omitempty"`
+	// AcceptedVersion is the version the user accepted last, empty if none.
+	AcceptedVersion string     `json:"accepted_version,omitempty"`
+	AcceptedAt      *time.Time `json:"accepted_at,omitempty"`
+	// Accepted tells whether the user accepted the version in force, which is always the case without terms.
+	Accepted bool `json:"accepted"`
+}
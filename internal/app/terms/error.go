@@ -0,0 +1,36 @@
+package terms
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeNotAccepted     apperr.Code = "terms/not_accepted"
+	CodeVersionMismatch apperr.Code = "terms/version_mismatch"
+	CodeNoTerms         apperr.Code = "terms/no_terms"
+)
+
+func init() {
+	apperr.Register(CodeNotAccepted, "Terms of service not accepted", apperr.ClassForbidden)
+	apperr.Register(CodeVersionMismatch, "Terms of service version mismatch", apperr.ClassConflict)
+	apperr.Register(CodeNoTerms, "No terms of service", apperr.ClassNotFound)
+}
+
+// ErrNotAccepted is returned for requests of a user who has not accepted the version in force yet.
+func ErrNotAccepted(version string) error {
+	return apperr.New("The current terms of service must be accepted first", CodeNotAccepted, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldVersion, Rule: apperr.RuleRequired, Params: map[string]any{"version": version},
+		})
+}
+
+// ErrVersionMismatch is returned when a user accepts a version other than the one in force, usually
+// because the terms changed while they were reading them.
+func ErrVersionMismatch(version string) error {
+	return apperr.New("These are not the current terms of service", CodeVersionMismatch, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldVersion, Rule: apperr.RuleMismatch, Params: map[string]any{"version": version},
+		})
+}
+
+func ErrNoTerms() error {
+	return apperr.New("No terms of service are in force", CodeNoTerms, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
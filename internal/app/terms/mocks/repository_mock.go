@@ -0,0 +1,1611 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/terms.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_terms "github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// RepositoryMock implements mm_terms.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAccept          func(ctx context.Context, userID uuid.UUID, version string, at time.Time) (err error)
+	funcAcceptOrigin    string
+	inspectFuncAccept   func(ctx context.Context, userID uuid.UUID, version string, at time.Time)
+	afterAcceptCounter  uint64
+	beforeAcceptCounter uint64
+	AcceptMock          mRepositoryMockAccept
+
+	funcGetStatus          func(ctx context.Context, userID uuid.UUID, version string) (s1 mm_terms.Status, err error)
+	funcGetStatusOrigin    string
+	inspectFuncGetStatus   func(ctx context.Context, userID uuid.UUID, version string)
+	afterGetStatusCounter  uint64
+	beforeGetStatusCounter uint64
+	GetStatusMock          mRepositoryMockGetStatus
+
+	funcHasAccepted          func(ctx context.Context, userID uuid.UUID, version string) (b1 bool, err error)
+	funcHasAcceptedOrigin    string
+	inspectFuncHasAccepted   func(ctx context.Context, userID uuid.UUID, version string)
+	afterHasAcceptedCounter  uint64
+	beforeHasAcceptedCounter uint64
+	HasAcceptedMock          mRepositoryMockHasAccepted
+
+	funcList          func(ctx context.Context, version string) (sa1 []mm_terms.Status, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, version string)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mRepositoryMockList
+}
+
+// NewRepositoryMock returns a mock for mm_terms.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcceptMock = mRepositoryMockAccept{mock: m}
+	m.AcceptMock.callArgs = []*RepositoryMockAcceptParams{}
+
+	m.GetStatusMock = mRepositoryMockGetStatus{mock: m}
+	m.GetStatusMock.callArgs = []*RepositoryMockGetStatusParams{}
+
+	m.HasAcceptedMock = mRepositoryMockHasAccepted{mock: m}
+	m.HasAcceptedMock.callArgs = []*RepositoryMockHasAcceptedParams{}
+
+	m.ListMock = mRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*RepositoryMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockAccept struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAcceptExpectation
+	expectations       []*RepositoryMockAcceptExpectation
+
+	callArgs []*RepositoryMockAcceptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAcceptExpectation specifies expectation struct of the Repository.Accept
+type RepositoryMockAcceptExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAcceptParams
+	paramPtrs          *RepositoryMockAcceptParamPtrs
+	expectationOrigins RepositoryMockAcceptExpectationOrigins
+	results            *RepositoryMockAcceptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAcceptParams contains parameters of the Repository.Accept
+type RepositoryMockAcceptParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	version string
+	at      time.Time
+}
+
+// RepositoryMockAcceptParamPtrs contains pointers to parameters of the Repository.Accept
+type RepositoryMockAcceptParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	version *string
+	at      *time.Time
+}
+
+// RepositoryMockAcceptResults contains results of the Repository.Accept
+type RepositoryMockAcceptResults struct {
+	err error
+}
+
+// RepositoryMockAcceptOrigins contains origins of expectations of the Repository.Accept
+type RepositoryMockAcceptExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originVersion string
+	originAt      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAccept *mRepositoryMockAccept) Optional() *mRepositoryMockAccept {
+	mmAccept.optional = true
+	return mmAccept
+}
+
+// Expect sets up expected params for Repository.Accept
+func (mmAccept *mRepositoryMockAccept) Expect(ctx context.Context, userID uuid.UUID, version string, at time.Time) *mRepositoryMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by ExpectParams functions")
+	}
+
+	mmAccept.defaultExpectation.params = &RepositoryMockAcceptParams{ctx, userID, version, at}
+	mmAccept.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAccept.expectations {
+		if minimock.Equal(e.params, mmAccept.defaultExpectation.params) {
+			mmAccept.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAccept.defaultExpectation.params)
+		}
+	}
+
+	return mmAccept
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Accept
+func (mmAccept *mRepositoryMockAccept) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &RepositoryMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAccept.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.Accept
+func (mmAccept *mRepositoryMockAccept) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &RepositoryMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.userID = &userID
+	mmAccept.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectVersionParam3 sets up expected param version for Repository.Accept
+func (mmAccept *mRepositoryMockAccept) ExpectVersionParam3(version string) *mRepositoryMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &RepositoryMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.version = &version
+	mmAccept.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectAtParam4 sets up expected param at for Repository.Accept
+func (mmAccept *mRepositoryMockAccept) ExpectAtParam4(at time.Time) *mRepositoryMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &RepositoryMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.at = &at
+	mmAccept.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Accept
+func (mmAccept *mRepositoryMockAccept) Inspect(f func(ctx context.Context, userID uuid.UUID, version string, at time.Time)) *mRepositoryMockAccept {
+	if mmAccept.mock.inspectFuncAccept != nil {
+		mmAccept.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Accept")
+	}
+
+	mmAccept.mock.inspectFuncAccept = f
+
+	return mmAccept
+}
+
+// Return sets up results that will be returned by Repository.Accept
+func (mmAccept *mRepositoryMockAccept) Return(err error) *RepositoryMock {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &RepositoryMockAcceptExpectation{mock: mmAccept.mock}
+	}
+	mmAccept.defaultExpectation.results = &RepositoryMockAcceptResults{err}
+	mmAccept.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// Set uses given function f to mock the Repository.Accept method
+func (mmAccept *mRepositoryMockAccept) Set(f func(ctx context.Context, userID uuid.UUID, version string, at time.Time) (err error)) *RepositoryMock {
+	if mmAccept.defaultExpectation != nil {
+		mmAccept.mock.t.Fatalf("Default expectation is already set for the Repository.Accept method")
+	}
+
+	if len(mmAccept.expectations) > 0 {
+		mmAccept.mock.t.Fatalf("Some expectations are already set for the Repository.Accept method")
+	}
+
+	mmAccept.mock.funcAccept = f
+	mmAccept.mock.funcAcceptOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// When sets expectation for the Repository.Accept which will trigger the result defined by the following
+// Then helper
+func (mmAccept *mRepositoryMockAccept) When(ctx context.Context, userID uuid.UUID, version string, at time.Time) *RepositoryMockAcceptExpectation {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("RepositoryMock.Accept mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAcceptExpectation{
+		mock:               mmAccept.mock,
+		params:             &RepositoryMockAcceptParams{ctx, userID, version, at},
+		expectationOrigins: RepositoryMockAcceptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAccept.expectations = append(mmAccept.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Accept return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAcceptExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAcceptResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Accept should be invoked
+func (mmAccept *mRepositoryMockAccept) Times(n uint64) *mRepositoryMockAccept {
+	if n == 0 {
+		mmAccept.mock.t.Fatalf("Times of RepositoryMock.Accept mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAccept.expectedInvocations, n)
+	mmAccept.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAccept
+}
+
+func (mmAccept *mRepositoryMockAccept) invocationsDone() bool {
+	if len(mmAccept.expectations) == 0 && mmAccept.defaultExpectation == nil && mmAccept.mock.funcAccept == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAccept.mock.afterAcceptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAccept.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Accept implements mm_terms.Repository
+func (mmAccept *RepositoryMock) Accept(ctx context.Context, userID uuid.UUID, version string, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmAccept.beforeAcceptCounter, 1)
+	defer mm_atomic.AddUint64(&mmAccept.afterAcceptCounter, 1)
+
+	mmAccept.t.Helper()
+
+	if mmAccept.inspectFuncAccept != nil {
+		mmAccept.inspectFuncAccept(ctx, userID, version, at)
+	}
+
+	mm_params := RepositoryMockAcceptParams{ctx, userID, version, at}
+
+	// Record call args
+	mmAccept.AcceptMock.mutex.Lock()
+	mmAccept.AcceptMock.callArgs = append(mmAccept.AcceptMock.callArgs, &mm_params)
+	mmAccept.AcceptMock.mutex.Unlock()
+
+	for _, e := range mmAccept.AcceptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAccept.AcceptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAccept.AcceptMock.defaultExpectation.Counter, 1)
+		mm_want := mmAccept.AcceptMock.defaultExpectation.params
+		mm_want_ptrs := mmAccept.AcceptMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAcceptParams{ctx, userID, version, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAccept.t.Errorf("RepositoryMock.Accept got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAccept.t.Errorf("RepositoryMock.Accept got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmAccept.t.Errorf("RepositoryMock.Accept got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmAccept.t.Errorf("RepositoryMock.Accept got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAccept.t.Errorf("RepositoryMock.Accept got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAccept.AcceptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAccept.AcceptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAccept.t.Fatal("No results are set for the RepositoryMock.Accept")
+		}
+		return (*mm_results).err
+	}
+	if mmAccept.funcAccept != nil {
+		return mmAccept.funcAccept(ctx, userID, version, at)
+	}
+	mmAccept.t.Fatalf("Unexpected call to RepositoryMock.Accept. %v %v %v %v", ctx, userID, version, at)
+	return
+}
+
+// AcceptAfterCounter returns a count of finished RepositoryMock.Accept invocations
+func (mmAccept *RepositoryMock) AcceptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.afterAcceptCounter)
+}
+
+// AcceptBeforeCounter returns a count of RepositoryMock.Accept invocations
+func (mmAccept *RepositoryMock) AcceptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.beforeAcceptCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Accept.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAccept *mRepositoryMockAccept) Calls() []*RepositoryMockAcceptParams {
+	mmAccept.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAcceptParams, len(mmAccept.callArgs))
+	copy(argCopy, mmAccept.callArgs)
+
+	mmAccept.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcceptDone returns true if the count of the Accept invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAcceptDone() bool {
+	if m.AcceptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcceptMock.invocationsDone()
+}
+
+// MinimockAcceptInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAcceptInspect() {
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Accept at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAcceptCounter := mm_atomic.LoadUint64(&m.afterAcceptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcceptMock.defaultExpectation != nil && afterAcceptCounter < 1 {
+		if m.AcceptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Accept at\n%s", m.AcceptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Accept at\n%s with params: %#v", m.AcceptMock.defaultExpectation.expectationOrigins.origin, *m.AcceptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAccept != nil && afterAcceptCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Accept at\n%s", m.funcAcceptOrigin)
+	}
+
+	if !m.AcceptMock.invocationsDone() && afterAcceptCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Accept at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AcceptMock.expectedInvocations), m.AcceptMock.expectedInvocationsOrigin, afterAcceptCounter)
+	}
+}
+
+type mRepositoryMockGetStatus struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetStatusExpectation
+	expectations       []*RepositoryMockGetStatusExpectation
+
+	callArgs []*RepositoryMockGetStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetStatusExpectation specifies expectation struct of the Repository.GetStatus
+type RepositoryMockGetStatusExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetStatusParams
+	paramPtrs          *RepositoryMockGetStatusParamPtrs
+	expectationOrigins RepositoryMockGetStatusExpectationOrigins
+	results            *RepositoryMockGetStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetStatusParams contains parameters of the Repository.GetStatus
+type RepositoryMockGetStatusParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	version string
+}
+
+// RepositoryMockGetStatusParamPtrs contains pointers to parameters of the Repository.GetStatus
+type RepositoryMockGetStatusParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	version *string
+}
+
+// RepositoryMockGetStatusResults contains results of the Repository.GetStatus
+type RepositoryMockGetStatusResults struct {
+	s1  mm_terms.Status
+	err error
+}
+
+// RepositoryMockGetStatusOrigins contains origins of expectations of the Repository.GetStatus
+type RepositoryMockGetStatusExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStatus *mRepositoryMockGetStatus) Optional() *mRepositoryMockGetStatus {
+	mmGetStatus.optional = true
+	return mmGetStatus
+}
+
+// Expect sets up expected params for Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) Expect(ctx context.Context, userID uuid.UUID, version string) *mRepositoryMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &RepositoryMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetStatus.defaultExpectation.params = &RepositoryMockGetStatusParams{ctx, userID, version}
+	mmGetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStatus.expectations {
+		if minimock.Equal(e.params, mmGetStatus.defaultExpectation.params) {
+			mmGetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &RepositoryMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.params != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Expect")
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs == nil {
+		mmGetStatus.defaultExpectation.paramPtrs = &RepositoryMockGetStatusParamPtrs{}
+	}
+	mmGetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStatus
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &RepositoryMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.params != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Expect")
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs == nil {
+		mmGetStatus.defaultExpectation.paramPtrs = &RepositoryMockGetStatusParamPtrs{}
+	}
+	mmGetStatus.defaultExpectation.paramPtrs.userID = &userID
+	mmGetStatus.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetStatus
+}
+
+// ExpectVersionParam3 sets up expected param version for Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) ExpectVersionParam3(version string) *mRepositoryMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &RepositoryMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.params != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Expect")
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs == nil {
+		mmGetStatus.defaultExpectation.paramPtrs = &RepositoryMockGetStatusParamPtrs{}
+	}
+	mmGetStatus.defaultExpectation.paramPtrs.version = &version
+	mmGetStatus.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) Inspect(f func(ctx context.Context, userID uuid.UUID, version string)) *mRepositoryMockGetStatus {
+	if mmGetStatus.mock.inspectFuncGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetStatus")
+	}
+
+	mmGetStatus.mock.inspectFuncGetStatus = f
+
+	return mmGetStatus
+}
+
+// Return sets up results that will be returned by Repository.GetStatus
+func (mmGetStatus *mRepositoryMockGetStatus) Return(s1 mm_terms.Status, err error) *RepositoryMock {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &RepositoryMockGetStatusExpectation{mock: mmGetStatus.mock}
+	}
+	mmGetStatus.defaultExpectation.results = &RepositoryMockGetStatusResults{s1, err}
+	mmGetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStatus.mock
+}
+
+// Set uses given function f to mock the Repository.GetStatus method
+func (mmGetStatus *mRepositoryMockGetStatus) Set(f func(ctx context.Context, userID uuid.UUID, version string) (s1 mm_terms.Status, err error)) *RepositoryMock {
+	if mmGetStatus.defaultExpectation != nil {
+		mmGetStatus.mock.t.Fatalf("Default expectation is already set for the Repository.GetStatus method")
+	}
+
+	if len(mmGetStatus.expectations) > 0 {
+		mmGetStatus.mock.t.Fatalf("Some expectations are already set for the Repository.GetStatus method")
+	}
+
+	mmGetStatus.mock.funcGetStatus = f
+	mmGetStatus.mock.funcGetStatusOrigin = minimock.CallerInfo(1)
+	return mmGetStatus.mock
+}
+
+// When sets expectation for the Repository.GetStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetStatus *mRepositoryMockGetStatus) When(ctx context.Context, userID uuid.UUID, version string) *RepositoryMockGetStatusExpectation {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("RepositoryMock.GetStatus mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetStatusExpectation{
+		mock:               mmGetStatus.mock,
+		params:             &RepositoryMockGetStatusParams{ctx, userID, version},
+		expectationOrigins: RepositoryMockGetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStatus.expectations = append(mmGetStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetStatus return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetStatusExpectation) Then(s1 mm_terms.Status, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetStatusResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetStatus should be invoked
+func (mmGetStatus *mRepositoryMockGetStatus) Times(n uint64) *mRepositoryMockGetStatus {
+	if n == 0 {
+		mmGetStatus.mock.t.Fatalf("Times of RepositoryMock.GetStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStatus.expectedInvocations, n)
+	mmGetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStatus
+}
+
+func (mmGetStatus *mRepositoryMockGetStatus) invocationsDone() bool {
+	if len(mmGetStatus.expectations) == 0 && mmGetStatus.defaultExpectation == nil && mmGetStatus.mock.funcGetStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStatus.mock.afterGetStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStatus implements mm_terms.Repository
+func (mmGetStatus *RepositoryMock) GetStatus(ctx context.Context, userID uuid.UUID, version string) (s1 mm_terms.Status, err error) {
+	mm_atomic.AddUint64(&mmGetStatus.beforeGetStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStatus.afterGetStatusCounter, 1)
+
+	mmGetStatus.t.Helper()
+
+	if mmGetStatus.inspectFuncGetStatus != nil {
+		mmGetStatus.inspectFuncGetStatus(ctx, userID, version)
+	}
+
+	mm_params := RepositoryMockGetStatusParams{ctx, userID, version}
+
+	// Record call args
+	mmGetStatus.GetStatusMock.mutex.Lock()
+	mmGetStatus.GetStatusMock.callArgs = append(mmGetStatus.GetStatusMock.callArgs, &mm_params)
+	mmGetStatus.GetStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetStatus.GetStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStatus.GetStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStatus.GetStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStatus.GetStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStatus.GetStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetStatusParams{ctx, userID, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStatus.t.Errorf("RepositoryMock.GetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetStatus.t.Errorf("RepositoryMock.GetStatus got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetStatus.t.Errorf("RepositoryMock.GetStatus got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStatus.t.Errorf("RepositoryMock.GetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStatus.GetStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStatus.t.Fatal("No results are set for the RepositoryMock.GetStatus")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStatus.funcGetStatus != nil {
+		return mmGetStatus.funcGetStatus(ctx, userID, version)
+	}
+	mmGetStatus.t.Fatalf("Unexpected call to RepositoryMock.GetStatus. %v %v %v", ctx, userID, version)
+	return
+}
+
+// GetStatusAfterCounter returns a count of finished RepositoryMock.GetStatus invocations
+func (mmGetStatus *RepositoryMock) GetStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatus.afterGetStatusCounter)
+}
+
+// GetStatusBeforeCounter returns a count of RepositoryMock.GetStatus invocations
+func (mmGetStatus *RepositoryMock) GetStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatus.beforeGetStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStatus *mRepositoryMockGetStatus) Calls() []*RepositoryMockGetStatusParams {
+	mmGetStatus.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetStatusParams, len(mmGetStatus.callArgs))
+	copy(argCopy, mmGetStatus.callArgs)
+
+	mmGetStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStatusDone returns true if the count of the GetStatus invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetStatusDone() bool {
+	if m.GetStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStatusMock.invocationsDone()
+}
+
+// MinimockGetStatusInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetStatusInspect() {
+	for _, e := range m.GetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStatusCounter := mm_atomic.LoadUint64(&m.afterGetStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStatusMock.defaultExpectation != nil && afterGetStatusCounter < 1 {
+		if m.GetStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetStatus at\n%s", m.GetStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetStatus at\n%s with params: %#v", m.GetStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStatus != nil && afterGetStatusCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetStatus at\n%s", m.funcGetStatusOrigin)
+	}
+
+	if !m.GetStatusMock.invocationsDone() && afterGetStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStatusMock.expectedInvocations), m.GetStatusMock.expectedInvocationsOrigin, afterGetStatusCounter)
+	}
+}
+
+type mRepositoryMockHasAccepted struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockHasAcceptedExpectation
+	expectations       []*RepositoryMockHasAcceptedExpectation
+
+	callArgs []*RepositoryMockHasAcceptedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockHasAcceptedExpectation specifies expectation struct of the Repository.HasAccepted
+type RepositoryMockHasAcceptedExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockHasAcceptedParams
+	paramPtrs          *RepositoryMockHasAcceptedParamPtrs
+	expectationOrigins RepositoryMockHasAcceptedExpectationOrigins
+	results            *RepositoryMockHasAcceptedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockHasAcceptedParams contains parameters of the Repository.HasAccepted
+type RepositoryMockHasAcceptedParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	version string
+}
+
+// RepositoryMockHasAcceptedParamPtrs contains pointers to parameters of the Repository.HasAccepted
+type RepositoryMockHasAcceptedParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	version *string
+}
+
+// RepositoryMockHasAcceptedResults contains results of the Repository.HasAccepted
+type RepositoryMockHasAcceptedResults struct {
+	b1  bool
+	err error
+}
+
+// RepositoryMockHasAcceptedOrigins contains origins of expectations of the Repository.HasAccepted
+type RepositoryMockHasAcceptedExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHasAccepted *mRepositoryMockHasAccepted) Optional() *mRepositoryMockHasAccepted {
+	mmHasAccepted.optional = true
+	return mmHasAccepted
+}
+
+// Expect sets up expected params for Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) Expect(ctx context.Context, userID uuid.UUID, version string) *mRepositoryMockHasAccepted {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	if mmHasAccepted.defaultExpectation == nil {
+		mmHasAccepted.defaultExpectation = &RepositoryMockHasAcceptedExpectation{}
+	}
+
+	if mmHasAccepted.defaultExpectation.paramPtrs != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by ExpectParams functions")
+	}
+
+	mmHasAccepted.defaultExpectation.params = &RepositoryMockHasAcceptedParams{ctx, userID, version}
+	mmHasAccepted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHasAccepted.expectations {
+		if minimock.Equal(e.params, mmHasAccepted.defaultExpectation.params) {
+			mmHasAccepted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHasAccepted.defaultExpectation.params)
+		}
+	}
+
+	return mmHasAccepted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) ExpectCtxParam1(ctx context.Context) *mRepositoryMockHasAccepted {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	if mmHasAccepted.defaultExpectation == nil {
+		mmHasAccepted.defaultExpectation = &RepositoryMockHasAcceptedExpectation{}
+	}
+
+	if mmHasAccepted.defaultExpectation.params != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Expect")
+	}
+
+	if mmHasAccepted.defaultExpectation.paramPtrs == nil {
+		mmHasAccepted.defaultExpectation.paramPtrs = &RepositoryMockHasAcceptedParamPtrs{}
+	}
+	mmHasAccepted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHasAccepted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHasAccepted
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockHasAccepted {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	if mmHasAccepted.defaultExpectation == nil {
+		mmHasAccepted.defaultExpectation = &RepositoryMockHasAcceptedExpectation{}
+	}
+
+	if mmHasAccepted.defaultExpectation.params != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Expect")
+	}
+
+	if mmHasAccepted.defaultExpectation.paramPtrs == nil {
+		mmHasAccepted.defaultExpectation.paramPtrs = &RepositoryMockHasAcceptedParamPtrs{}
+	}
+	mmHasAccepted.defaultExpectation.paramPtrs.userID = &userID
+	mmHasAccepted.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmHasAccepted
+}
+
+// ExpectVersionParam3 sets up expected param version for Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) ExpectVersionParam3(version string) *mRepositoryMockHasAccepted {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	if mmHasAccepted.defaultExpectation == nil {
+		mmHasAccepted.defaultExpectation = &RepositoryMockHasAcceptedExpectation{}
+	}
+
+	if mmHasAccepted.defaultExpectation.params != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Expect")
+	}
+
+	if mmHasAccepted.defaultExpectation.paramPtrs == nil {
+		mmHasAccepted.defaultExpectation.paramPtrs = &RepositoryMockHasAcceptedParamPtrs{}
+	}
+	mmHasAccepted.defaultExpectation.paramPtrs.version = &version
+	mmHasAccepted.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmHasAccepted
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) Inspect(f func(ctx context.Context, userID uuid.UUID, version string)) *mRepositoryMockHasAccepted {
+	if mmHasAccepted.mock.inspectFuncHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("Inspect function is already set for RepositoryMock.HasAccepted")
+	}
+
+	mmHasAccepted.mock.inspectFuncHasAccepted = f
+
+	return mmHasAccepted
+}
+
+// Return sets up results that will be returned by Repository.HasAccepted
+func (mmHasAccepted *mRepositoryMockHasAccepted) Return(b1 bool, err error) *RepositoryMock {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	if mmHasAccepted.defaultExpectation == nil {
+		mmHasAccepted.defaultExpectation = &RepositoryMockHasAcceptedExpectation{mock: mmHasAccepted.mock}
+	}
+	mmHasAccepted.defaultExpectation.results = &RepositoryMockHasAcceptedResults{b1, err}
+	mmHasAccepted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHasAccepted.mock
+}
+
+// Set uses given function f to mock the Repository.HasAccepted method
+func (mmHasAccepted *mRepositoryMockHasAccepted) Set(f func(ctx context.Context, userID uuid.UUID, version string) (b1 bool, err error)) *RepositoryMock {
+	if mmHasAccepted.defaultExpectation != nil {
+		mmHasAccepted.mock.t.Fatalf("Default expectation is already set for the Repository.HasAccepted method")
+	}
+
+	if len(mmHasAccepted.expectations) > 0 {
+		mmHasAccepted.mock.t.Fatalf("Some expectations are already set for the Repository.HasAccepted method")
+	}
+
+	mmHasAccepted.mock.funcHasAccepted = f
+	mmHasAccepted.mock.funcHasAcceptedOrigin = minimock.CallerInfo(1)
+	return mmHasAccepted.mock
+}
+
+// When sets expectation for the Repository.HasAccepted which will trigger the result defined by the following
+// Then helper
+func (mmHasAccepted *mRepositoryMockHasAccepted) When(ctx context.Context, userID uuid.UUID, version string) *RepositoryMockHasAcceptedExpectation {
+	if mmHasAccepted.mock.funcHasAccepted != nil {
+		mmHasAccepted.mock.t.Fatalf("RepositoryMock.HasAccepted mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockHasAcceptedExpectation{
+		mock:               mmHasAccepted.mock,
+		params:             &RepositoryMockHasAcceptedParams{ctx, userID, version},
+		expectationOrigins: RepositoryMockHasAcceptedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHasAccepted.expectations = append(mmHasAccepted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.HasAccepted return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockHasAcceptedExpectation) Then(b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockHasAcceptedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.HasAccepted should be invoked
+func (mmHasAccepted *mRepositoryMockHasAccepted) Times(n uint64) *mRepositoryMockHasAccepted {
+	if n == 0 {
+		mmHasAccepted.mock.t.Fatalf("Times of RepositoryMock.HasAccepted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHasAccepted.expectedInvocations, n)
+	mmHasAccepted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHasAccepted
+}
+
+func (mmHasAccepted *mRepositoryMockHasAccepted) invocationsDone() bool {
+	if len(mmHasAccepted.expectations) == 0 && mmHasAccepted.defaultExpectation == nil && mmHasAccepted.mock.funcHasAccepted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHasAccepted.mock.afterHasAcceptedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHasAccepted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HasAccepted implements mm_terms.Repository
+func (mmHasAccepted *RepositoryMock) HasAccepted(ctx context.Context, userID uuid.UUID, version string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmHasAccepted.beforeHasAcceptedCounter, 1)
+	defer mm_atomic.AddUint64(&mmHasAccepted.afterHasAcceptedCounter, 1)
+
+	mmHasAccepted.t.Helper()
+
+	if mmHasAccepted.inspectFuncHasAccepted != nil {
+		mmHasAccepted.inspectFuncHasAccepted(ctx, userID, version)
+	}
+
+	mm_params := RepositoryMockHasAcceptedParams{ctx, userID, version}
+
+	// Record call args
+	mmHasAccepted.HasAcceptedMock.mutex.Lock()
+	mmHasAccepted.HasAcceptedMock.callArgs = append(mmHasAccepted.HasAcceptedMock.callArgs, &mm_params)
+	mmHasAccepted.HasAcceptedMock.mutex.Unlock()
+
+	for _, e := range mmHasAccepted.HasAcceptedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmHasAccepted.HasAcceptedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHasAccepted.HasAcceptedMock.defaultExpectation.Counter, 1)
+		mm_want := mmHasAccepted.HasAcceptedMock.defaultExpectation.params
+		mm_want_ptrs := mmHasAccepted.HasAcceptedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockHasAcceptedParams{ctx, userID, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHasAccepted.t.Errorf("RepositoryMock.HasAccepted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasAccepted.HasAcceptedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmHasAccepted.t.Errorf("RepositoryMock.HasAccepted got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasAccepted.HasAcceptedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmHasAccepted.t.Errorf("RepositoryMock.HasAccepted got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasAccepted.HasAcceptedMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasAccepted.t.Errorf("RepositoryMock.HasAccepted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHasAccepted.HasAcceptedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHasAccepted.HasAcceptedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHasAccepted.t.Fatal("No results are set for the RepositoryMock.HasAccepted")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmHasAccepted.funcHasAccepted != nil {
+		return mmHasAccepted.funcHasAccepted(ctx, userID, version)
+	}
+	mmHasAccepted.t.Fatalf("Unexpected call to RepositoryMock.HasAccepted. %v %v %v", ctx, userID, version)
+	return
+}
+
+// HasAcceptedAfterCounter returns a count of finished RepositoryMock.HasAccepted invocations
+func (mmHasAccepted *RepositoryMock) HasAcceptedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasAccepted.afterHasAcceptedCounter)
+}
+
+// HasAcceptedBeforeCounter returns a count of RepositoryMock.HasAccepted invocations
+func (mmHasAccepted *RepositoryMock) HasAcceptedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasAccepted.beforeHasAcceptedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.HasAccepted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHasAccepted *mRepositoryMockHasAccepted) Calls() []*RepositoryMockHasAcceptedParams {
+	mmHasAccepted.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockHasAcceptedParams, len(mmHasAccepted.callArgs))
+	copy(argCopy, mmHasAccepted.callArgs)
+
+	mmHasAccepted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHasAcceptedDone returns true if the count of the HasAccepted invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockHasAcceptedDone() bool {
+	if m.HasAcceptedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HasAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HasAcceptedMock.invocationsDone()
+}
+
+// MinimockHasAcceptedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockHasAcceptedInspect() {
+	for _, e := range m.HasAcceptedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.HasAccepted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHasAcceptedCounter := mm_atomic.LoadUint64(&m.afterHasAcceptedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HasAcceptedMock.defaultExpectation != nil && afterHasAcceptedCounter < 1 {
+		if m.HasAcceptedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.HasAccepted at\n%s", m.HasAcceptedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.HasAccepted at\n%s with params: %#v", m.HasAcceptedMock.defaultExpectation.expectationOrigins.origin, *m.HasAcceptedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHasAccepted != nil && afterHasAcceptedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.HasAccepted at\n%s", m.funcHasAcceptedOrigin)
+	}
+
+	if !m.HasAcceptedMock.invocationsDone() && afterHasAcceptedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.HasAccepted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HasAcceptedMock.expectedInvocations), m.HasAcceptedMock.expectedInvocationsOrigin, afterHasAcceptedCounter)
+	}
+}
+
+type mRepositoryMockList struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListExpectation
+	expectations       []*RepositoryMockListExpectation
+
+	callArgs []*RepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListExpectation specifies expectation struct of the Repository.List
+type RepositoryMockListExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListParams
+	paramPtrs          *RepositoryMockListParamPtrs
+	expectationOrigins RepositoryMockListExpectationOrigins
+	results            *RepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListParams contains parameters of the Repository.List
+type RepositoryMockListParams struct {
+	ctx     context.Context
+	version string
+}
+
+// RepositoryMockListParamPtrs contains pointers to parameters of the Repository.List
+type RepositoryMockListParamPtrs struct {
+	ctx     *context.Context
+	version *string
+}
+
+// RepositoryMockListResults contains results of the Repository.List
+type RepositoryMockListResults struct {
+	sa1 []mm_terms.Status
+	err error
+}
+
+// RepositoryMockListOrigins contains origins of expectations of the Repository.List
+type RepositoryMockListExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mRepositoryMockList) Optional() *mRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Repository.List
+func (mmList *mRepositoryMockList) Expect(ctx context.Context, version string) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &RepositoryMockListParams{ctx, version}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.List
+func (mmList *mRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectVersionParam2 sets up expected param version for Repository.List
+func (mmList *mRepositoryMockList) ExpectVersionParam2(version string) *mRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &RepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.version = &version
+	mmList.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.List
+func (mmList *mRepositoryMockList) Inspect(f func(ctx context.Context, version string)) *mRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for RepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Repository.List
+func (mmList *mRepositoryMockList) Return(sa1 []mm_terms.Status, err error) *RepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &RepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &RepositoryMockListResults{sa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Repository.List method
+func (mmList *mRepositoryMockList) Set(f func(ctx context.Context, version string) (sa1 []mm_terms.Status, err error)) *RepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Repository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Repository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Repository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mRepositoryMockList) When(ctx context.Context, version string) *RepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("RepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &RepositoryMockListParams{ctx, version},
+		expectationOrigins: RepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.List return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListExpectation) Then(sa1 []mm_terms.Status, err error) *RepositoryMock {
+	e.results = &RepositoryMockListResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.List should be invoked
+func (mmList *mRepositoryMockList) Times(n uint64) *mRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of RepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_terms.Repository
+func (mmList *RepositoryMock) List(ctx context.Context, version string) (sa1 []mm_terms.Status, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, version)
+	}
+
+	mm_params := RepositoryMockListParams{ctx, version}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("RepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmList.t.Errorf("RepositoryMock.List got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("RepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the RepositoryMock.List")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, version)
+	}
+	mmList.t.Fatalf("Unexpected call to RepositoryMock.List. %v %v", ctx, version)
+	return
+}
+
+// ListAfterCounter returns a count of finished RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of RepositoryMock.List invocations
+func (mmList *RepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mRepositoryMockList) Calls() []*RepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcceptInspect()
+
+			m.MinimockGetStatusInspect()
+
+			m.MinimockHasAcceptedInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcceptDone() &&
+		m.MinimockGetStatusDone() &&
+		m.MinimockHasAcceptedDone() &&
+		m.MinimockListDone()
+}
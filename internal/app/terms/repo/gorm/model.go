@@ -0,0 +1,38 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/google/uuid"
+)
+
+type acceptanceModel struct {
+	UserID     uuid.UUID `gorm:"primaryKey"`
+	Version    string    `gorm:"primaryKey"`
+	AcceptedAt time.Time
+}
+
+func (m *acceptanceModel) TableName() string {
+	return "user_terms_acceptances"
+}
+
+type statusModel struct {
+	UserID          uuid.UUID
+	Name            string
+	Email           string
+	AcceptedVersion string
+	AcceptedAt      *time.Time
+	Accepted        bool
+}
+
+func (m statusModel) toDTO() terms.Status {
+	return terms.Status{
+		UserID:          m.UserID,
+		Name:            m.Name,
+		Email:           m.Email,
+		AcceptedVersion: m.AcceptedVersion,
+		AcceptedAt:      m.AcceptedAt,
+		Accepted:        m.Accepted,
+	}
+}
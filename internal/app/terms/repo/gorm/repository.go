@@ -0,0 +1,87 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+func (r *gormRepo) Accept(ctx context.Context, userID uuid.UUID, version string, at time.Time) error {
+	model := acceptanceModel{UserID: userID, Version: version, AcceptedAt: at}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+		return fmt.Errorf("gormRepo.Accept: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) HasAccepted(ctx context.Context, userID uuid.UUID, version string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&acceptanceModel{}).
+		Where("user_id = ? AND version = ?", userID, version).Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("gormRepo.HasAccepted: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// statusQuery selects users with the acceptance they made last, and whether they accepted the version.
+const statusQuery = `
+SELECT u.id AS user_id, u.name, u.email,
+       COALESCE(a.version, '') AS accepted_version, a.accepted_at,
+       EXISTS (SELECT 1 FROM user_terms_acceptances c WHERE c.user_id = u.id AND c.version = ?) AS accepted
+FROM users u
+LEFT JOIN LATERAL (
+    SELECT version, accepted_at FROM user_terms_acceptances
+    WHERE user_id = u.id
+    ORDER BY accepted_at DESC
+    LIMIT 1
+) a ON TRUE
+WHERE ? AND ?`
+
+func (r *gormRepo) GetStatus(ctx context.Context, userID uuid.UUID, version string) (terms.Status, error) {
+	var models []statusModel
+
+	err := r.db.WithContext(ctx).Raw(statusQuery+` AND u.id = ?`,
+		version, db.ActiveCond("u.deleted_at"), db.WorkspaceCond(ctx, "u.workspace_id"), userID).Scan(&models).Error
+	if err != nil {
+		return terms.Status{}, fmt.Errorf("gormRepo.GetStatus: %w", err)
+	}
+	if len(models) == 0 {
+		return terms.Status{}, fmt.Errorf("gormRepo.GetStatus: %w", apperr.ErrNotFound())
+	}
+
+	return models[0].toDTO(), nil
+}
+
+func (r *gormRepo) List(ctx context.Context, version string) ([]terms.Status, error) {
+	var models []statusModel
+
+	err := r.db.WithContext(ctx).Raw(statusQuery+` ORDER BY u.name, u.id`,
+		version, db.ActiveCond("u.deleted_at"), db.WorkspaceCond(ctx, "u.workspace_id")).Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+
+	return lo.Map(models, func(m statusModel, _ int) terms.Status { return m.toDTO() }), nil
+}
@@ -0,0 +1,96 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestAcceptAndStatus(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	ann := createUser(t, gdb, "Ann")
+	bob := createUser(t, gdb, "Bob")
+	at := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Accept(t.Context(), ann, "v1", at.Add(-time.Hour)))
+	require.NoError(t, repo.Accept(t.Context(), ann, "v2", at))
+	// accepting again keeps the first time
+	require.NoError(t, repo.Accept(t.Context(), ann, "v2", at.Add(time.Hour)))
+
+	ok, err := repo.HasAccepted(t.Context(), ann, "v1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = repo.HasAccepted(t.Context(), bob, "v1")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	status, err := repo.GetStatus(t.Context(), ann, "v1")
+	require.NoError(t, err)
+	require.Equal(t, "v2", status.AcceptedVersion)
+	require.True(t, at.Equal(*status.AcceptedAt))
+	require.True(t, status.Accepted)
+
+	status, err = repo.GetStatus(t.Context(), bob, "v2")
+	require.NoError(t, err)
+	require.Empty(t, status.AcceptedVersion)
+	require.Nil(t, status.AcceptedAt)
+	require.False(t, status.Accepted)
+
+	_, err = repo.GetStatus(t.Context(), uuid.New(), "v2")
+	require.Equal(t, apperr.ClassNotFound, apperr.ClassOf(err))
+
+	statuses, err := repo.List(t.Context(), "v2")
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, ann, statuses[0].UserID)
+	require.True(t, statuses[0].Accepted)
+	require.Equal(t, bob, statuses[1].UserID)
+	require.False(t, statuses[1].Accepted)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.List(t.Context(), "v2")
+	require.Error(t, err)
+	require.Error(t, repo.Accept(t.Context(), bob, "v2", at))
+}
+
+func createUser(t *testing.T, gdb *gorm.DB, name string) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	email := uid.String() + "@example.com"
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, email, name, "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
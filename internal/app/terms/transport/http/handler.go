@@ -0,0 +1,115 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type Service interface {
+	GetTerms() terms.Terms
+	GetMyStatus(ctx context.Context) (terms.Status, error)
+	Accept(ctx context.Context, version string) error
+	List(ctx context.Context) ([]terms.Status, error)
+}
+
+type AcceptInput struct {
+	Version string `json:"version"`
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("terms HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// GetTerms godoc
+// @Summary      Current terms of service
+// @Description  Returns the version of the terms of service users must accept and where they are published. The version is empty when there are none.
+// @Tags         terms
+// @Produce      json
+// @Success      200 {object} terms.Terms
+// @Router       /terms [get]
+func (h *Handler) GetTerms(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(r.Context(), w, http.StatusOK, h.svc.GetTerms())
+}
+
+// GetMyStatus godoc
+// @Summary      My terms of service status
+// @Description  Returns the version of the terms of service the current user accepted last and whether they accepted the current one.
+// @Tags         terms
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {object} terms.Status
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/me/terms [get]
+func (h *Handler) GetMyStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status, err := h.svc.GetMyStatus(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, status)
+}
+
+// Accept godoc
+// @Summary      Accept terms of service
+// @Description  Records that the current user accepted the terms of service. The version must be the current one; until it is accepted, the rest of the API answers 403 terms/not_accepted. Not allowed while impersonating.
+// @Tags         terms
+// @Security     BearerAuth
+// @Accept       json
+// @Param        request body AcceptInput true "Accepted version"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/me/accept-terms [post]
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var in AcceptInput
+	if err := httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Msg("terms.Handler.Accept: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err := h.svc.Accept(ctx, in.Version); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List godoc
+// @Summary      Terms of service acceptance
+// @Description  Returns every user of the workspace with the version of the terms of service they accepted last and whether they accepted the current one. Requires admin role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} terms.Status
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/terms [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	statuses, err := h.svc.List(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, statuses)
+}
@@ -0,0 +1,175 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	terms_http "github.com/66gu1/easygodocs/internal/app/terms/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/terms/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_GetTerms(t *testing.T) {
+	t.Parallel()
+
+	svc := mocks.NewServiceMock(t)
+	svc.GetTermsMock.Return(terms.Terms{Version: "2025-09", URL: "https://example.com/terms"})
+	w := httptest.NewRecorder()
+	terms_http.NewHandler(svc).GetTerms(w, httptest.NewRequest(http.MethodGet, "/terms", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"version":"2025-09","url":"https://example.com/terms"}`, w.Body.String())
+}
+
+func TestHandler_GetMyStatus(t *testing.T) {
+	t.Parallel()
+
+	status := terms.Status{UserID: uuid.New(), AcceptedVersion: "2025-09", Accepted: true}
+
+	svc := mocks.NewServiceMock(t)
+	svc.GetMyStatusMock.Return(status, nil)
+	w := httptest.NewRecorder()
+	terms_http.NewHandler(svc).GetMyStatus(w, httptest.NewRequest(http.MethodGet, "/users/me/terms", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var got terms.Status
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Equal(t, status, got)
+
+	svc = mocks.NewServiceMock(t)
+	svc.GetMyStatusMock.Return(terms.Status{}, apperr.ErrUnauthorized())
+	w = httptest.NewRecorder()
+	terms_http.NewHandler(svc).GetMyStatus(w, httptest.NewRequest(http.MethodGet, "/users/me/terms", nil))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Accept(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "ok",
+			body:       `{"version":"2025-09"}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AcceptMock.Expect(minimock.AnyContext, "2025-09").Return(nil)
+			},
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "other version -> 409",
+			body:       `{"version":"2025-01"}`,
+			wantStatus: http.StatusConflict,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AcceptMock.Return(terms.ErrVersionMismatch("2025-09"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+			h := terms_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodPost, "/users/me/accept-terms", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.Accept(w, r)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	t.Parallel()
+
+	statuses := []terms.Status{{UserID: uuid.New(), Name: "Ann", Accepted: true}}
+
+	svc := mocks.NewServiceMock(t)
+	svc.ListMock.Return(statuses, nil)
+	w := httptest.NewRecorder()
+	terms_http.NewHandler(svc).List(w, httptest.NewRequest(http.MethodGet, "/admin/terms", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var got []terms.Status
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	require.Equal(t, statuses, got)
+
+	svc = mocks.NewServiceMock(t)
+	svc.ListMock.Return(nil, apperr.ErrForbidden())
+	w = httptest.NewRecorder()
+	terms_http.NewHandler(svc).List(w, httptest.NewRequest(http.MethodGet, "/admin/terms", nil))
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRequireAccepted(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	t.Run("accepted", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewCheckerMock(t)
+		checker.CheckMock.Expect(minimock.AnyContext, userID).Return(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(contextx.SetUserID(r.Context(), userID))
+		w := httptest.NewRecorder()
+		terms_http.RequireAccepted(checker)(next).ServeHTTP(w, r)
+		require.Equal(t, "hello", w.Body.String())
+	})
+
+	t.Run("not accepted -> 403", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewCheckerMock(t)
+		checker.CheckMock.Return(terms.ErrNotAccepted("2025-09"))
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(contextx.SetUserID(r.Context(), userID))
+		w := httptest.NewRecorder()
+		terms_http.RequireAccepted(checker)(next).ServeHTTP(w, r)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), string(terms.CodeNotAccepted))
+	})
+
+	t.Run("impersonated and anonymous requests pass through", func(t *testing.T) {
+		t.Parallel()
+		checker := mocks.NewCheckerMock(t)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(contextx.SetActorID(contextx.SetUserID(r.Context(), userID), uuid.New()))
+		w := httptest.NewRecorder()
+		terms_http.RequireAccepted(checker)(next).ServeHTTP(w, r)
+		require.Equal(t, "hello", w.Body.String())
+
+		w = httptest.NewRecorder()
+		terms_http.RequireAccepted(checker)(next).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, "hello", w.Body.String())
+	})
+}
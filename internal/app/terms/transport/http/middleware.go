@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Checker interface {
+	Check(ctx context.Context, userID uuid.UUID) error
+}
+
+// RequireAccepted rejects requests of users who have not accepted the current terms of service. It
+// must run after AuthMiddleware. An admin acting as a user is let through, as they cannot accept the
+// terms on the user's behalf.
+func RequireAccepted(checker Checker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID, err := contextx.GetUserID(ctx)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if _, err = contextx.GetActorID(ctx); err == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err = checker.Check(ctx, userID); err != nil {
+				logger.Error(ctx, err).
+					Str("user_id", userID.String()).
+					Msg("terms.RequireAccepted: terms not accepted")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
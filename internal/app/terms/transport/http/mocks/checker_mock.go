@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/terms/transport/http.Checker -o checker_mock.go -n CheckerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// CheckerMock implements mm_http.Checker
+type CheckerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context, userID uuid.UUID)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mCheckerMockCheck
+}
+
+// NewCheckerMock returns a mock for mm_http.Checker
+func NewCheckerMock(t minimock.Tester) *CheckerMock {
+	m := &CheckerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mCheckerMockCheck{mock: m}
+	m.CheckMock.callArgs = []*CheckerMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCheckerMockCheck struct {
+	optional           bool
+	mock               *CheckerMock
+	defaultExpectation *CheckerMockCheckExpectation
+	expectations       []*CheckerMockCheckExpectation
+
+	callArgs []*CheckerMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CheckerMockCheckExpectation specifies expectation struct of the Checker.Check
+type CheckerMockCheckExpectation struct {
+	mock               *CheckerMock
+	params             *CheckerMockCheckParams
+	paramPtrs          *CheckerMockCheckParamPtrs
+	expectationOrigins CheckerMockCheckExpectationOrigins
+	results            *CheckerMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CheckerMockCheckParams contains parameters of the Checker.Check
+type CheckerMockCheckParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CheckerMockCheckParamPtrs contains pointers to parameters of the Checker.Check
+type CheckerMockCheckParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CheckerMockCheckResults contains results of the Checker.Check
+type CheckerMockCheckResults struct {
+	err error
+}
+
+// CheckerMockCheckOrigins contains origins of expectations of the Checker.Check
+type CheckerMockCheckExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mCheckerMockCheck) Optional() *mCheckerMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for Checker.Check
+func (mmCheck *mCheckerMockCheck) Expect(ctx context.Context, userID uuid.UUID) *mCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &CheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &CheckerMockCheckParams{ctx, userID}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Checker.Check
+func (mmCheck *mCheckerMockCheck) ExpectCtxParam1(ctx context.Context) *mCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &CheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &CheckerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Checker.Check
+func (mmCheck *mCheckerMockCheck) ExpectUserIDParam2(userID uuid.UUID) *mCheckerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &CheckerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &CheckerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.userID = &userID
+	mmCheck.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the Checker.Check
+func (mmCheck *mCheckerMockCheck) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCheckerMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for CheckerMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by Checker.Check
+func (mmCheck *mCheckerMockCheck) Return(err error) *CheckerMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &CheckerMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &CheckerMockCheckResults{err}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the Checker.Check method
+func (mmCheck *mCheckerMockCheck) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *CheckerMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the Checker.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the Checker.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the Checker.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mCheckerMockCheck) When(ctx context.Context, userID uuid.UUID) *CheckerMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("CheckerMock.Check mock is already set by Set")
+	}
+
+	expectation := &CheckerMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &CheckerMockCheckParams{ctx, userID},
+		expectationOrigins: CheckerMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Checker.Check return parameters for the expectation previously defined by the When method
+func (e *CheckerMockCheckExpectation) Then(err error) *CheckerMock {
+	e.results = &CheckerMockCheckResults{err}
+	return e.mock
+}
+
+// Times sets number of times Checker.Check should be invoked
+func (mmCheck *mCheckerMockCheck) Times(n uint64) *mCheckerMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of CheckerMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mCheckerMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_http.Checker
+func (mmCheck *CheckerMock) Check(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, userID)
+	}
+
+	mm_params := CheckerMockCheckParams{ctx, userID}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := CheckerMockCheckParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("CheckerMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheck.t.Errorf("CheckerMock.Check got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("CheckerMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the CheckerMock.Check")
+		}
+		return (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, userID)
+	}
+	mmCheck.t.Fatalf("Unexpected call to CheckerMock.Check. %v %v", ctx, userID)
+	return
+}
+
+// CheckAfterCounter returns a count of finished CheckerMock.Check invocations
+func (mmCheck *CheckerMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of CheckerMock.Check invocations
+func (mmCheck *CheckerMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to CheckerMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mCheckerMockCheck) Calls() []*CheckerMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*CheckerMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *CheckerMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *CheckerMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CheckerMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CheckerMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CheckerMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to CheckerMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to CheckerMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CheckerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CheckerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CheckerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
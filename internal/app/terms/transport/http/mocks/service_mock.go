@@ -0,0 +1,1265 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/terms/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAccept          func(ctx context.Context, version string) (err error)
+	funcAcceptOrigin    string
+	inspectFuncAccept   func(ctx context.Context, version string)
+	afterAcceptCounter  uint64
+	beforeAcceptCounter uint64
+	AcceptMock          mServiceMockAccept
+
+	funcGetMyStatus          func(ctx context.Context) (s1 terms.Status, err error)
+	funcGetMyStatusOrigin    string
+	inspectFuncGetMyStatus   func(ctx context.Context)
+	afterGetMyStatusCounter  uint64
+	beforeGetMyStatusCounter uint64
+	GetMyStatusMock          mServiceMockGetMyStatus
+
+	funcGetTerms          func() (t1 terms.Terms)
+	funcGetTermsOrigin    string
+	inspectFuncGetTerms   func()
+	afterGetTermsCounter  uint64
+	beforeGetTermsCounter uint64
+	GetTermsMock          mServiceMockGetTerms
+
+	funcList          func(ctx context.Context) (sa1 []terms.Status, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mServiceMockList
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcceptMock = mServiceMockAccept{mock: m}
+	m.AcceptMock.callArgs = []*ServiceMockAcceptParams{}
+
+	m.GetMyStatusMock = mServiceMockGetMyStatus{mock: m}
+	m.GetMyStatusMock.callArgs = []*ServiceMockGetMyStatusParams{}
+
+	m.GetTermsMock = mServiceMockGetTerms{mock: m}
+
+	m.ListMock = mServiceMockList{mock: m}
+	m.ListMock.callArgs = []*ServiceMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockAccept struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockAcceptExpectation
+	expectations       []*ServiceMockAcceptExpectation
+
+	callArgs []*ServiceMockAcceptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockAcceptExpectation specifies expectation struct of the Service.Accept
+type ServiceMockAcceptExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockAcceptParams
+	paramPtrs          *ServiceMockAcceptParamPtrs
+	expectationOrigins ServiceMockAcceptExpectationOrigins
+	results            *ServiceMockAcceptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockAcceptParams contains parameters of the Service.Accept
+type ServiceMockAcceptParams struct {
+	ctx     context.Context
+	version string
+}
+
+// ServiceMockAcceptParamPtrs contains pointers to parameters of the Service.Accept
+type ServiceMockAcceptParamPtrs struct {
+	ctx     *context.Context
+	version *string
+}
+
+// ServiceMockAcceptResults contains results of the Service.Accept
+type ServiceMockAcceptResults struct {
+	err error
+}
+
+// ServiceMockAcceptOrigins contains origins of expectations of the Service.Accept
+type ServiceMockAcceptExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAccept *mServiceMockAccept) Optional() *mServiceMockAccept {
+	mmAccept.optional = true
+	return mmAccept
+}
+
+// Expect sets up expected params for Service.Accept
+func (mmAccept *mServiceMockAccept) Expect(ctx context.Context, version string) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by ExpectParams functions")
+	}
+
+	mmAccept.defaultExpectation.params = &ServiceMockAcceptParams{ctx, version}
+	mmAccept.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAccept.expectations {
+		if minimock.Equal(e.params, mmAccept.defaultExpectation.params) {
+			mmAccept.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAccept.defaultExpectation.params)
+		}
+	}
+
+	return mmAccept
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.Accept
+func (mmAccept *mServiceMockAccept) ExpectCtxParam1(ctx context.Context) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &ServiceMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAccept.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectVersionParam2 sets up expected param version for Service.Accept
+func (mmAccept *mServiceMockAccept) ExpectVersionParam2(version string) *mServiceMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &ServiceMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.version = &version
+	mmAccept.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.Accept
+func (mmAccept *mServiceMockAccept) Inspect(f func(ctx context.Context, version string)) *mServiceMockAccept {
+	if mmAccept.mock.inspectFuncAccept != nil {
+		mmAccept.mock.t.Fatalf("Inspect function is already set for ServiceMock.Accept")
+	}
+
+	mmAccept.mock.inspectFuncAccept = f
+
+	return mmAccept
+}
+
+// Return sets up results that will be returned by Service.Accept
+func (mmAccept *mServiceMockAccept) Return(err error) *ServiceMock {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &ServiceMockAcceptExpectation{mock: mmAccept.mock}
+	}
+	mmAccept.defaultExpectation.results = &ServiceMockAcceptResults{err}
+	mmAccept.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// Set uses given function f to mock the Service.Accept method
+func (mmAccept *mServiceMockAccept) Set(f func(ctx context.Context, version string) (err error)) *ServiceMock {
+	if mmAccept.defaultExpectation != nil {
+		mmAccept.mock.t.Fatalf("Default expectation is already set for the Service.Accept method")
+	}
+
+	if len(mmAccept.expectations) > 0 {
+		mmAccept.mock.t.Fatalf("Some expectations are already set for the Service.Accept method")
+	}
+
+	mmAccept.mock.funcAccept = f
+	mmAccept.mock.funcAcceptOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// When sets expectation for the Service.Accept which will trigger the result defined by the following
+// Then helper
+func (mmAccept *mServiceMockAccept) When(ctx context.Context, version string) *ServiceMockAcceptExpectation {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("ServiceMock.Accept mock is already set by Set")
+	}
+
+	expectation := &ServiceMockAcceptExpectation{
+		mock:               mmAccept.mock,
+		params:             &ServiceMockAcceptParams{ctx, version},
+		expectationOrigins: ServiceMockAcceptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAccept.expectations = append(mmAccept.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.Accept return parameters for the expectation previously defined by the When method
+func (e *ServiceMockAcceptExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockAcceptResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.Accept should be invoked
+func (mmAccept *mServiceMockAccept) Times(n uint64) *mServiceMockAccept {
+	if n == 0 {
+		mmAccept.mock.t.Fatalf("Times of ServiceMock.Accept mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAccept.expectedInvocations, n)
+	mmAccept.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAccept
+}
+
+func (mmAccept *mServiceMockAccept) invocationsDone() bool {
+	if len(mmAccept.expectations) == 0 && mmAccept.defaultExpectation == nil && mmAccept.mock.funcAccept == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAccept.mock.afterAcceptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAccept.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Accept implements mm_http.Service
+func (mmAccept *ServiceMock) Accept(ctx context.Context, version string) (err error) {
+	mm_atomic.AddUint64(&mmAccept.beforeAcceptCounter, 1)
+	defer mm_atomic.AddUint64(&mmAccept.afterAcceptCounter, 1)
+
+	mmAccept.t.Helper()
+
+	if mmAccept.inspectFuncAccept != nil {
+		mmAccept.inspectFuncAccept(ctx, version)
+	}
+
+	mm_params := ServiceMockAcceptParams{ctx, version}
+
+	// Record call args
+	mmAccept.AcceptMock.mutex.Lock()
+	mmAccept.AcceptMock.callArgs = append(mmAccept.AcceptMock.callArgs, &mm_params)
+	mmAccept.AcceptMock.mutex.Unlock()
+
+	for _, e := range mmAccept.AcceptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAccept.AcceptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAccept.AcceptMock.defaultExpectation.Counter, 1)
+		mm_want := mmAccept.AcceptMock.defaultExpectation.params
+		mm_want_ptrs := mmAccept.AcceptMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockAcceptParams{ctx, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAccept.t.Errorf("ServiceMock.Accept got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAccept.AcceptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAccept.AcceptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAccept.t.Fatal("No results are set for the ServiceMock.Accept")
+		}
+		return (*mm_results).err
+	}
+	if mmAccept.funcAccept != nil {
+		return mmAccept.funcAccept(ctx, version)
+	}
+	mmAccept.t.Fatalf("Unexpected call to ServiceMock.Accept. %v %v", ctx, version)
+	return
+}
+
+// AcceptAfterCounter returns a count of finished ServiceMock.Accept invocations
+func (mmAccept *ServiceMock) AcceptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.afterAcceptCounter)
+}
+
+// AcceptBeforeCounter returns a count of ServiceMock.Accept invocations
+func (mmAccept *ServiceMock) AcceptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.beforeAcceptCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.Accept.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAccept *mServiceMockAccept) Calls() []*ServiceMockAcceptParams {
+	mmAccept.mutex.RLock()
+
+	argCopy := make([]*ServiceMockAcceptParams, len(mmAccept.callArgs))
+	copy(argCopy, mmAccept.callArgs)
+
+	mmAccept.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcceptDone returns true if the count of the Accept invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockAcceptDone() bool {
+	if m.AcceptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcceptMock.invocationsDone()
+}
+
+// MinimockAcceptInspect logs each unmet expectation
+func (m *ServiceMock) MinimockAcceptInspect() {
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAcceptCounter := mm_atomic.LoadUint64(&m.afterAcceptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcceptMock.defaultExpectation != nil && afterAcceptCounter < 1 {
+		if m.AcceptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s", m.AcceptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.Accept at\n%s with params: %#v", m.AcceptMock.defaultExpectation.expectationOrigins.origin, *m.AcceptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAccept != nil && afterAcceptCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.Accept at\n%s", m.funcAcceptOrigin)
+	}
+
+	if !m.AcceptMock.invocationsDone() && afterAcceptCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.Accept at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AcceptMock.expectedInvocations), m.AcceptMock.expectedInvocationsOrigin, afterAcceptCounter)
+	}
+}
+
+type mServiceMockGetMyStatus struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetMyStatusExpectation
+	expectations       []*ServiceMockGetMyStatusExpectation
+
+	callArgs []*ServiceMockGetMyStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetMyStatusExpectation specifies expectation struct of the Service.GetMyStatus
+type ServiceMockGetMyStatusExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetMyStatusParams
+	paramPtrs          *ServiceMockGetMyStatusParamPtrs
+	expectationOrigins ServiceMockGetMyStatusExpectationOrigins
+	results            *ServiceMockGetMyStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetMyStatusParams contains parameters of the Service.GetMyStatus
+type ServiceMockGetMyStatusParams struct {
+	ctx context.Context
+}
+
+// ServiceMockGetMyStatusParamPtrs contains pointers to parameters of the Service.GetMyStatus
+type ServiceMockGetMyStatusParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockGetMyStatusResults contains results of the Service.GetMyStatus
+type ServiceMockGetMyStatusResults struct {
+	s1  terms.Status
+	err error
+}
+
+// ServiceMockGetMyStatusOrigins contains origins of expectations of the Service.GetMyStatus
+type ServiceMockGetMyStatusExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMyStatus *mServiceMockGetMyStatus) Optional() *mServiceMockGetMyStatus {
+	mmGetMyStatus.optional = true
+	return mmGetMyStatus
+}
+
+// Expect sets up expected params for Service.GetMyStatus
+func (mmGetMyStatus *mServiceMockGetMyStatus) Expect(ctx context.Context) *mServiceMockGetMyStatus {
+	if mmGetMyStatus.mock.funcGetMyStatus != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by Set")
+	}
+
+	if mmGetMyStatus.defaultExpectation == nil {
+		mmGetMyStatus.defaultExpectation = &ServiceMockGetMyStatusExpectation{}
+	}
+
+	if mmGetMyStatus.defaultExpectation.paramPtrs != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetMyStatus.defaultExpectation.params = &ServiceMockGetMyStatusParams{ctx}
+	mmGetMyStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMyStatus.expectations {
+		if minimock.Equal(e.params, mmGetMyStatus.defaultExpectation.params) {
+			mmGetMyStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMyStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMyStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetMyStatus
+func (mmGetMyStatus *mServiceMockGetMyStatus) ExpectCtxParam1(ctx context.Context) *mServiceMockGetMyStatus {
+	if mmGetMyStatus.mock.funcGetMyStatus != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by Set")
+	}
+
+	if mmGetMyStatus.defaultExpectation == nil {
+		mmGetMyStatus.defaultExpectation = &ServiceMockGetMyStatusExpectation{}
+	}
+
+	if mmGetMyStatus.defaultExpectation.params != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by Expect")
+	}
+
+	if mmGetMyStatus.defaultExpectation.paramPtrs == nil {
+		mmGetMyStatus.defaultExpectation.paramPtrs = &ServiceMockGetMyStatusParamPtrs{}
+	}
+	mmGetMyStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMyStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMyStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetMyStatus
+func (mmGetMyStatus *mServiceMockGetMyStatus) Inspect(f func(ctx context.Context)) *mServiceMockGetMyStatus {
+	if mmGetMyStatus.mock.inspectFuncGetMyStatus != nil {
+		mmGetMyStatus.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetMyStatus")
+	}
+
+	mmGetMyStatus.mock.inspectFuncGetMyStatus = f
+
+	return mmGetMyStatus
+}
+
+// Return sets up results that will be returned by Service.GetMyStatus
+func (mmGetMyStatus *mServiceMockGetMyStatus) Return(s1 terms.Status, err error) *ServiceMock {
+	if mmGetMyStatus.mock.funcGetMyStatus != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by Set")
+	}
+
+	if mmGetMyStatus.defaultExpectation == nil {
+		mmGetMyStatus.defaultExpectation = &ServiceMockGetMyStatusExpectation{mock: mmGetMyStatus.mock}
+	}
+	mmGetMyStatus.defaultExpectation.results = &ServiceMockGetMyStatusResults{s1, err}
+	mmGetMyStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMyStatus.mock
+}
+
+// Set uses given function f to mock the Service.GetMyStatus method
+func (mmGetMyStatus *mServiceMockGetMyStatus) Set(f func(ctx context.Context) (s1 terms.Status, err error)) *ServiceMock {
+	if mmGetMyStatus.defaultExpectation != nil {
+		mmGetMyStatus.mock.t.Fatalf("Default expectation is already set for the Service.GetMyStatus method")
+	}
+
+	if len(mmGetMyStatus.expectations) > 0 {
+		mmGetMyStatus.mock.t.Fatalf("Some expectations are already set for the Service.GetMyStatus method")
+	}
+
+	mmGetMyStatus.mock.funcGetMyStatus = f
+	mmGetMyStatus.mock.funcGetMyStatusOrigin = minimock.CallerInfo(1)
+	return mmGetMyStatus.mock
+}
+
+// When sets expectation for the Service.GetMyStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetMyStatus *mServiceMockGetMyStatus) When(ctx context.Context) *ServiceMockGetMyStatusExpectation {
+	if mmGetMyStatus.mock.funcGetMyStatus != nil {
+		mmGetMyStatus.mock.t.Fatalf("ServiceMock.GetMyStatus mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetMyStatusExpectation{
+		mock:               mmGetMyStatus.mock,
+		params:             &ServiceMockGetMyStatusParams{ctx},
+		expectationOrigins: ServiceMockGetMyStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMyStatus.expectations = append(mmGetMyStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetMyStatus return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetMyStatusExpectation) Then(s1 terms.Status, err error) *ServiceMock {
+	e.results = &ServiceMockGetMyStatusResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetMyStatus should be invoked
+func (mmGetMyStatus *mServiceMockGetMyStatus) Times(n uint64) *mServiceMockGetMyStatus {
+	if n == 0 {
+		mmGetMyStatus.mock.t.Fatalf("Times of ServiceMock.GetMyStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMyStatus.expectedInvocations, n)
+	mmGetMyStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMyStatus
+}
+
+func (mmGetMyStatus *mServiceMockGetMyStatus) invocationsDone() bool {
+	if len(mmGetMyStatus.expectations) == 0 && mmGetMyStatus.defaultExpectation == nil && mmGetMyStatus.mock.funcGetMyStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMyStatus.mock.afterGetMyStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMyStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMyStatus implements mm_http.Service
+func (mmGetMyStatus *ServiceMock) GetMyStatus(ctx context.Context) (s1 terms.Status, err error) {
+	mm_atomic.AddUint64(&mmGetMyStatus.beforeGetMyStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMyStatus.afterGetMyStatusCounter, 1)
+
+	mmGetMyStatus.t.Helper()
+
+	if mmGetMyStatus.inspectFuncGetMyStatus != nil {
+		mmGetMyStatus.inspectFuncGetMyStatus(ctx)
+	}
+
+	mm_params := ServiceMockGetMyStatusParams{ctx}
+
+	// Record call args
+	mmGetMyStatus.GetMyStatusMock.mutex.Lock()
+	mmGetMyStatus.GetMyStatusMock.callArgs = append(mmGetMyStatus.GetMyStatusMock.callArgs, &mm_params)
+	mmGetMyStatus.GetMyStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetMyStatus.GetMyStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetMyStatus.GetMyStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMyStatus.GetMyStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMyStatus.GetMyStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMyStatus.GetMyStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetMyStatusParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMyStatus.t.Errorf("ServiceMock.GetMyStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMyStatus.GetMyStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMyStatus.t.Errorf("ServiceMock.GetMyStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMyStatus.GetMyStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMyStatus.GetMyStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMyStatus.t.Fatal("No results are set for the ServiceMock.GetMyStatus")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetMyStatus.funcGetMyStatus != nil {
+		return mmGetMyStatus.funcGetMyStatus(ctx)
+	}
+	mmGetMyStatus.t.Fatalf("Unexpected call to ServiceMock.GetMyStatus. %v", ctx)
+	return
+}
+
+// GetMyStatusAfterCounter returns a count of finished ServiceMock.GetMyStatus invocations
+func (mmGetMyStatus *ServiceMock) GetMyStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyStatus.afterGetMyStatusCounter)
+}
+
+// GetMyStatusBeforeCounter returns a count of ServiceMock.GetMyStatus invocations
+func (mmGetMyStatus *ServiceMock) GetMyStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMyStatus.beforeGetMyStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetMyStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMyStatus *mServiceMockGetMyStatus) Calls() []*ServiceMockGetMyStatusParams {
+	mmGetMyStatus.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetMyStatusParams, len(mmGetMyStatus.callArgs))
+	copy(argCopy, mmGetMyStatus.callArgs)
+
+	mmGetMyStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMyStatusDone returns true if the count of the GetMyStatus invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetMyStatusDone() bool {
+	if m.GetMyStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMyStatusMock.invocationsDone()
+}
+
+// MinimockGetMyStatusInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetMyStatusInspect() {
+	for _, e := range m.GetMyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetMyStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMyStatusCounter := mm_atomic.LoadUint64(&m.afterGetMyStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMyStatusMock.defaultExpectation != nil && afterGetMyStatusCounter < 1 {
+		if m.GetMyStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetMyStatus at\n%s", m.GetMyStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetMyStatus at\n%s with params: %#v", m.GetMyStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetMyStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMyStatus != nil && afterGetMyStatusCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetMyStatus at\n%s", m.funcGetMyStatusOrigin)
+	}
+
+	if !m.GetMyStatusMock.invocationsDone() && afterGetMyStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetMyStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMyStatusMock.expectedInvocations), m.GetMyStatusMock.expectedInvocationsOrigin, afterGetMyStatusCounter)
+	}
+}
+
+type mServiceMockGetTerms struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetTermsExpectation
+	expectations       []*ServiceMockGetTermsExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetTermsExpectation specifies expectation struct of the Service.GetTerms
+type ServiceMockGetTermsExpectation struct {
+	mock *ServiceMock
+
+	results      *ServiceMockGetTermsResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// ServiceMockGetTermsResults contains results of the Service.GetTerms
+type ServiceMockGetTermsResults struct {
+	t1 terms.Terms
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTerms *mServiceMockGetTerms) Optional() *mServiceMockGetTerms {
+	mmGetTerms.optional = true
+	return mmGetTerms
+}
+
+// Expect sets up expected params for Service.GetTerms
+func (mmGetTerms *mServiceMockGetTerms) Expect() *mServiceMockGetTerms {
+	if mmGetTerms.mock.funcGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("ServiceMock.GetTerms mock is already set by Set")
+	}
+
+	if mmGetTerms.defaultExpectation == nil {
+		mmGetTerms.defaultExpectation = &ServiceMockGetTermsExpectation{}
+	}
+
+	return mmGetTerms
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetTerms
+func (mmGetTerms *mServiceMockGetTerms) Inspect(f func()) *mServiceMockGetTerms {
+	if mmGetTerms.mock.inspectFuncGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetTerms")
+	}
+
+	mmGetTerms.mock.inspectFuncGetTerms = f
+
+	return mmGetTerms
+}
+
+// Return sets up results that will be returned by Service.GetTerms
+func (mmGetTerms *mServiceMockGetTerms) Return(t1 terms.Terms) *ServiceMock {
+	if mmGetTerms.mock.funcGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("ServiceMock.GetTerms mock is already set by Set")
+	}
+
+	if mmGetTerms.defaultExpectation == nil {
+		mmGetTerms.defaultExpectation = &ServiceMockGetTermsExpectation{mock: mmGetTerms.mock}
+	}
+	mmGetTerms.defaultExpectation.results = &ServiceMockGetTermsResults{t1}
+	mmGetTerms.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTerms.mock
+}
+
+// Set uses given function f to mock the Service.GetTerms method
+func (mmGetTerms *mServiceMockGetTerms) Set(f func() (t1 terms.Terms)) *ServiceMock {
+	if mmGetTerms.defaultExpectation != nil {
+		mmGetTerms.mock.t.Fatalf("Default expectation is already set for the Service.GetTerms method")
+	}
+
+	if len(mmGetTerms.expectations) > 0 {
+		mmGetTerms.mock.t.Fatalf("Some expectations are already set for the Service.GetTerms method")
+	}
+
+	mmGetTerms.mock.funcGetTerms = f
+	mmGetTerms.mock.funcGetTermsOrigin = minimock.CallerInfo(1)
+	return mmGetTerms.mock
+}
+
+// Times sets number of times Service.GetTerms should be invoked
+func (mmGetTerms *mServiceMockGetTerms) Times(n uint64) *mServiceMockGetTerms {
+	if n == 0 {
+		mmGetTerms.mock.t.Fatalf("Times of ServiceMock.GetTerms mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTerms.expectedInvocations, n)
+	mmGetTerms.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTerms
+}
+
+func (mmGetTerms *mServiceMockGetTerms) invocationsDone() bool {
+	if len(mmGetTerms.expectations) == 0 && mmGetTerms.defaultExpectation == nil && mmGetTerms.mock.funcGetTerms == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTerms.mock.afterGetTermsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTerms.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTerms implements mm_http.Service
+func (mmGetTerms *ServiceMock) GetTerms() (t1 terms.Terms) {
+	mm_atomic.AddUint64(&mmGetTerms.beforeGetTermsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTerms.afterGetTermsCounter, 1)
+
+	mmGetTerms.t.Helper()
+
+	if mmGetTerms.inspectFuncGetTerms != nil {
+		mmGetTerms.inspectFuncGetTerms()
+	}
+
+	if mmGetTerms.GetTermsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTerms.GetTermsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetTerms.GetTermsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTerms.t.Fatal("No results are set for the ServiceMock.GetTerms")
+		}
+		return (*mm_results).t1
+	}
+	if mmGetTerms.funcGetTerms != nil {
+		return mmGetTerms.funcGetTerms()
+	}
+	mmGetTerms.t.Fatalf("Unexpected call to ServiceMock.GetTerms.")
+	return
+}
+
+// GetTermsAfterCounter returns a count of finished ServiceMock.GetTerms invocations
+func (mmGetTerms *ServiceMock) GetTermsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTerms.afterGetTermsCounter)
+}
+
+// GetTermsBeforeCounter returns a count of ServiceMock.GetTerms invocations
+func (mmGetTerms *ServiceMock) GetTermsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTerms.beforeGetTermsCounter)
+}
+
+// MinimockGetTermsDone returns true if the count of the GetTerms invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetTermsDone() bool {
+	if m.GetTermsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTermsMock.invocationsDone()
+}
+
+// MinimockGetTermsInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetTermsInspect() {
+	for _, e := range m.GetTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ServiceMock.GetTerms")
+		}
+	}
+
+	afterGetTermsCounter := mm_atomic.LoadUint64(&m.afterGetTermsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTermsMock.defaultExpectation != nil && afterGetTermsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetTerms at\n%s", m.GetTermsMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTerms != nil && afterGetTermsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetTerms at\n%s", m.funcGetTermsOrigin)
+	}
+
+	if !m.GetTermsMock.invocationsDone() && afterGetTermsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetTerms at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTermsMock.expectedInvocations), m.GetTermsMock.expectedInvocationsOrigin, afterGetTermsCounter)
+	}
+}
+
+type mServiceMockList struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockListExpectation
+	expectations       []*ServiceMockListExpectation
+
+	callArgs []*ServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockListExpectation specifies expectation struct of the Service.List
+type ServiceMockListExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockListParams
+	paramPtrs          *ServiceMockListParamPtrs
+	expectationOrigins ServiceMockListExpectationOrigins
+	results            *ServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockListParams contains parameters of the Service.List
+type ServiceMockListParams struct {
+	ctx context.Context
+}
+
+// ServiceMockListParamPtrs contains pointers to parameters of the Service.List
+type ServiceMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// ServiceMockListResults contains results of the Service.List
+type ServiceMockListResults struct {
+	sa1 []terms.Status
+	err error
+}
+
+// ServiceMockListOrigins contains origins of expectations of the Service.List
+type ServiceMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mServiceMockList) Optional() *mServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Service.List
+func (mmList *mServiceMockList) Expect(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ServiceMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.List
+func (mmList *mServiceMockList) ExpectCtxParam1(ctx context.Context) *mServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.List
+func (mmList *mServiceMockList) Inspect(f func(ctx context.Context)) *mServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Service.List
+func (mmList *mServiceMockList) Return(sa1 []terms.Status, err error) *ServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ServiceMockListResults{sa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Service.List method
+func (mmList *mServiceMockList) Set(f func(ctx context.Context) (sa1 []terms.Status, err error)) *ServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Service.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Service.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Service.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mServiceMockList) When(ctx context.Context) *ServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &ServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &ServiceMockListParams{ctx},
+		expectationOrigins: ServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.List return parameters for the expectation previously defined by the When method
+func (e *ServiceMockListExpectation) Then(sa1 []terms.Status, err error) *ServiceMock {
+	e.results = &ServiceMockListResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.List should be invoked
+func (mmList *mServiceMockList) Times(n uint64) *mServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_http.Service
+func (mmList *ServiceMock) List(ctx context.Context) (sa1 []terms.Status, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := ServiceMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ServiceMock.List")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to ServiceMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished ServiceMock.List invocations
+func (mmList *ServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ServiceMock.List invocations
+func (mmList *ServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mServiceMockList) Calls() []*ServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcceptInspect()
+
+			m.MinimockGetMyStatusInspect()
+
+			m.MinimockGetTermsInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcceptDone() &&
+		m.MinimockGetMyStatusDone() &&
+		m.MinimockGetTermsDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,1328 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/terms/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAccept          func(ctx context.Context, userID uuid.UUID, version string) (err error)
+	funcAcceptOrigin    string
+	inspectFuncAccept   func(ctx context.Context, userID uuid.UUID, version string)
+	afterAcceptCounter  uint64
+	beforeAcceptCounter uint64
+	AcceptMock          mCoreMockAccept
+
+	funcGetStatus          func(ctx context.Context, userID uuid.UUID) (s1 terms.Status, err error)
+	funcGetStatusOrigin    string
+	inspectFuncGetStatus   func(ctx context.Context, userID uuid.UUID)
+	afterGetStatusCounter  uint64
+	beforeGetStatusCounter uint64
+	GetStatusMock          mCoreMockGetStatus
+
+	funcGetTerms          func() (t1 terms.Terms)
+	funcGetTermsOrigin    string
+	inspectFuncGetTerms   func()
+	afterGetTermsCounter  uint64
+	beforeGetTermsCounter uint64
+	GetTermsMock          mCoreMockGetTerms
+
+	funcList          func(ctx context.Context) (sa1 []terms.Status, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mCoreMockList
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcceptMock = mCoreMockAccept{mock: m}
+	m.AcceptMock.callArgs = []*CoreMockAcceptParams{}
+
+	m.GetStatusMock = mCoreMockGetStatus{mock: m}
+	m.GetStatusMock.callArgs = []*CoreMockGetStatusParams{}
+
+	m.GetTermsMock = mCoreMockGetTerms{mock: m}
+
+	m.ListMock = mCoreMockList{mock: m}
+	m.ListMock.callArgs = []*CoreMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockAccept struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockAcceptExpectation
+	expectations       []*CoreMockAcceptExpectation
+
+	callArgs []*CoreMockAcceptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockAcceptExpectation specifies expectation struct of the Core.Accept
+type CoreMockAcceptExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockAcceptParams
+	paramPtrs          *CoreMockAcceptParamPtrs
+	expectationOrigins CoreMockAcceptExpectationOrigins
+	results            *CoreMockAcceptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockAcceptParams contains parameters of the Core.Accept
+type CoreMockAcceptParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	version string
+}
+
+// CoreMockAcceptParamPtrs contains pointers to parameters of the Core.Accept
+type CoreMockAcceptParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	version *string
+}
+
+// CoreMockAcceptResults contains results of the Core.Accept
+type CoreMockAcceptResults struct {
+	err error
+}
+
+// CoreMockAcceptOrigins contains origins of expectations of the Core.Accept
+type CoreMockAcceptExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAccept *mCoreMockAccept) Optional() *mCoreMockAccept {
+	mmAccept.optional = true
+	return mmAccept
+}
+
+// Expect sets up expected params for Core.Accept
+func (mmAccept *mCoreMockAccept) Expect(ctx context.Context, userID uuid.UUID, version string) *mCoreMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &CoreMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by ExpectParams functions")
+	}
+
+	mmAccept.defaultExpectation.params = &CoreMockAcceptParams{ctx, userID, version}
+	mmAccept.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAccept.expectations {
+		if minimock.Equal(e.params, mmAccept.defaultExpectation.params) {
+			mmAccept.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAccept.defaultExpectation.params)
+		}
+	}
+
+	return mmAccept
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.Accept
+func (mmAccept *mCoreMockAccept) ExpectCtxParam1(ctx context.Context) *mCoreMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &CoreMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &CoreMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAccept.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.Accept
+func (mmAccept *mCoreMockAccept) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &CoreMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &CoreMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.userID = &userID
+	mmAccept.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// ExpectVersionParam3 sets up expected param version for Core.Accept
+func (mmAccept *mCoreMockAccept) ExpectVersionParam3(version string) *mCoreMockAccept {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &CoreMockAcceptExpectation{}
+	}
+
+	if mmAccept.defaultExpectation.params != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Expect")
+	}
+
+	if mmAccept.defaultExpectation.paramPtrs == nil {
+		mmAccept.defaultExpectation.paramPtrs = &CoreMockAcceptParamPtrs{}
+	}
+	mmAccept.defaultExpectation.paramPtrs.version = &version
+	mmAccept.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmAccept
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.Accept
+func (mmAccept *mCoreMockAccept) Inspect(f func(ctx context.Context, userID uuid.UUID, version string)) *mCoreMockAccept {
+	if mmAccept.mock.inspectFuncAccept != nil {
+		mmAccept.mock.t.Fatalf("Inspect function is already set for CoreMock.Accept")
+	}
+
+	mmAccept.mock.inspectFuncAccept = f
+
+	return mmAccept
+}
+
+// Return sets up results that will be returned by Core.Accept
+func (mmAccept *mCoreMockAccept) Return(err error) *CoreMock {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	if mmAccept.defaultExpectation == nil {
+		mmAccept.defaultExpectation = &CoreMockAcceptExpectation{mock: mmAccept.mock}
+	}
+	mmAccept.defaultExpectation.results = &CoreMockAcceptResults{err}
+	mmAccept.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// Set uses given function f to mock the Core.Accept method
+func (mmAccept *mCoreMockAccept) Set(f func(ctx context.Context, userID uuid.UUID, version string) (err error)) *CoreMock {
+	if mmAccept.defaultExpectation != nil {
+		mmAccept.mock.t.Fatalf("Default expectation is already set for the Core.Accept method")
+	}
+
+	if len(mmAccept.expectations) > 0 {
+		mmAccept.mock.t.Fatalf("Some expectations are already set for the Core.Accept method")
+	}
+
+	mmAccept.mock.funcAccept = f
+	mmAccept.mock.funcAcceptOrigin = minimock.CallerInfo(1)
+	return mmAccept.mock
+}
+
+// When sets expectation for the Core.Accept which will trigger the result defined by the following
+// Then helper
+func (mmAccept *mCoreMockAccept) When(ctx context.Context, userID uuid.UUID, version string) *CoreMockAcceptExpectation {
+	if mmAccept.mock.funcAccept != nil {
+		mmAccept.mock.t.Fatalf("CoreMock.Accept mock is already set by Set")
+	}
+
+	expectation := &CoreMockAcceptExpectation{
+		mock:               mmAccept.mock,
+		params:             &CoreMockAcceptParams{ctx, userID, version},
+		expectationOrigins: CoreMockAcceptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAccept.expectations = append(mmAccept.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.Accept return parameters for the expectation previously defined by the When method
+func (e *CoreMockAcceptExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockAcceptResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.Accept should be invoked
+func (mmAccept *mCoreMockAccept) Times(n uint64) *mCoreMockAccept {
+	if n == 0 {
+		mmAccept.mock.t.Fatalf("Times of CoreMock.Accept mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAccept.expectedInvocations, n)
+	mmAccept.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAccept
+}
+
+func (mmAccept *mCoreMockAccept) invocationsDone() bool {
+	if len(mmAccept.expectations) == 0 && mmAccept.defaultExpectation == nil && mmAccept.mock.funcAccept == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAccept.mock.afterAcceptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAccept.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Accept implements mm_usecase.Core
+func (mmAccept *CoreMock) Accept(ctx context.Context, userID uuid.UUID, version string) (err error) {
+	mm_atomic.AddUint64(&mmAccept.beforeAcceptCounter, 1)
+	defer mm_atomic.AddUint64(&mmAccept.afterAcceptCounter, 1)
+
+	mmAccept.t.Helper()
+
+	if mmAccept.inspectFuncAccept != nil {
+		mmAccept.inspectFuncAccept(ctx, userID, version)
+	}
+
+	mm_params := CoreMockAcceptParams{ctx, userID, version}
+
+	// Record call args
+	mmAccept.AcceptMock.mutex.Lock()
+	mmAccept.AcceptMock.callArgs = append(mmAccept.AcceptMock.callArgs, &mm_params)
+	mmAccept.AcceptMock.mutex.Unlock()
+
+	for _, e := range mmAccept.AcceptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAccept.AcceptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAccept.AcceptMock.defaultExpectation.Counter, 1)
+		mm_want := mmAccept.AcceptMock.defaultExpectation.params
+		mm_want_ptrs := mmAccept.AcceptMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockAcceptParams{ctx, userID, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAccept.t.Errorf("CoreMock.Accept got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAccept.t.Errorf("CoreMock.Accept got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmAccept.t.Errorf("CoreMock.Accept got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAccept.AcceptMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAccept.t.Errorf("CoreMock.Accept got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAccept.AcceptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAccept.AcceptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAccept.t.Fatal("No results are set for the CoreMock.Accept")
+		}
+		return (*mm_results).err
+	}
+	if mmAccept.funcAccept != nil {
+		return mmAccept.funcAccept(ctx, userID, version)
+	}
+	mmAccept.t.Fatalf("Unexpected call to CoreMock.Accept. %v %v %v", ctx, userID, version)
+	return
+}
+
+// AcceptAfterCounter returns a count of finished CoreMock.Accept invocations
+func (mmAccept *CoreMock) AcceptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.afterAcceptCounter)
+}
+
+// AcceptBeforeCounter returns a count of CoreMock.Accept invocations
+func (mmAccept *CoreMock) AcceptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAccept.beforeAcceptCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.Accept.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAccept *mCoreMockAccept) Calls() []*CoreMockAcceptParams {
+	mmAccept.mutex.RLock()
+
+	argCopy := make([]*CoreMockAcceptParams, len(mmAccept.callArgs))
+	copy(argCopy, mmAccept.callArgs)
+
+	mmAccept.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcceptDone returns true if the count of the Accept invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockAcceptDone() bool {
+	if m.AcceptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcceptMock.invocationsDone()
+}
+
+// MinimockAcceptInspect logs each unmet expectation
+func (m *CoreMock) MinimockAcceptInspect() {
+	for _, e := range m.AcceptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.Accept at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAcceptCounter := mm_atomic.LoadUint64(&m.afterAcceptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcceptMock.defaultExpectation != nil && afterAcceptCounter < 1 {
+		if m.AcceptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.Accept at\n%s", m.AcceptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.Accept at\n%s with params: %#v", m.AcceptMock.defaultExpectation.expectationOrigins.origin, *m.AcceptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAccept != nil && afterAcceptCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.Accept at\n%s", m.funcAcceptOrigin)
+	}
+
+	if !m.AcceptMock.invocationsDone() && afterAcceptCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.Accept at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AcceptMock.expectedInvocations), m.AcceptMock.expectedInvocationsOrigin, afterAcceptCounter)
+	}
+}
+
+type mCoreMockGetStatus struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetStatusExpectation
+	expectations       []*CoreMockGetStatusExpectation
+
+	callArgs []*CoreMockGetStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetStatusExpectation specifies expectation struct of the Core.GetStatus
+type CoreMockGetStatusExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetStatusParams
+	paramPtrs          *CoreMockGetStatusParamPtrs
+	expectationOrigins CoreMockGetStatusExpectationOrigins
+	results            *CoreMockGetStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetStatusParams contains parameters of the Core.GetStatus
+type CoreMockGetStatusParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// CoreMockGetStatusParamPtrs contains pointers to parameters of the Core.GetStatus
+type CoreMockGetStatusParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// CoreMockGetStatusResults contains results of the Core.GetStatus
+type CoreMockGetStatusResults struct {
+	s1  terms.Status
+	err error
+}
+
+// CoreMockGetStatusOrigins contains origins of expectations of the Core.GetStatus
+type CoreMockGetStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStatus *mCoreMockGetStatus) Optional() *mCoreMockGetStatus {
+	mmGetStatus.optional = true
+	return mmGetStatus
+}
+
+// Expect sets up expected params for Core.GetStatus
+func (mmGetStatus *mCoreMockGetStatus) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &CoreMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetStatus.defaultExpectation.params = &CoreMockGetStatusParams{ctx, userID}
+	mmGetStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStatus.expectations {
+		if minimock.Equal(e.params, mmGetStatus.defaultExpectation.params) {
+			mmGetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetStatus
+func (mmGetStatus *mCoreMockGetStatus) ExpectCtxParam1(ctx context.Context) *mCoreMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &CoreMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.params != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Expect")
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs == nil {
+		mmGetStatus.defaultExpectation.paramPtrs = &CoreMockGetStatusParamPtrs{}
+	}
+	mmGetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStatus
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.GetStatus
+func (mmGetStatus *mCoreMockGetStatus) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockGetStatus {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &CoreMockGetStatusExpectation{}
+	}
+
+	if mmGetStatus.defaultExpectation.params != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Expect")
+	}
+
+	if mmGetStatus.defaultExpectation.paramPtrs == nil {
+		mmGetStatus.defaultExpectation.paramPtrs = &CoreMockGetStatusParamPtrs{}
+	}
+	mmGetStatus.defaultExpectation.paramPtrs.userID = &userID
+	mmGetStatus.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetStatus
+func (mmGetStatus *mCoreMockGetStatus) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockGetStatus {
+	if mmGetStatus.mock.inspectFuncGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("Inspect function is already set for CoreMock.GetStatus")
+	}
+
+	mmGetStatus.mock.inspectFuncGetStatus = f
+
+	return mmGetStatus
+}
+
+// Return sets up results that will be returned by Core.GetStatus
+func (mmGetStatus *mCoreMockGetStatus) Return(s1 terms.Status, err error) *CoreMock {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Set")
+	}
+
+	if mmGetStatus.defaultExpectation == nil {
+		mmGetStatus.defaultExpectation = &CoreMockGetStatusExpectation{mock: mmGetStatus.mock}
+	}
+	mmGetStatus.defaultExpectation.results = &CoreMockGetStatusResults{s1, err}
+	mmGetStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStatus.mock
+}
+
+// Set uses given function f to mock the Core.GetStatus method
+func (mmGetStatus *mCoreMockGetStatus) Set(f func(ctx context.Context, userID uuid.UUID) (s1 terms.Status, err error)) *CoreMock {
+	if mmGetStatus.defaultExpectation != nil {
+		mmGetStatus.mock.t.Fatalf("Default expectation is already set for the Core.GetStatus method")
+	}
+
+	if len(mmGetStatus.expectations) > 0 {
+		mmGetStatus.mock.t.Fatalf("Some expectations are already set for the Core.GetStatus method")
+	}
+
+	mmGetStatus.mock.funcGetStatus = f
+	mmGetStatus.mock.funcGetStatusOrigin = minimock.CallerInfo(1)
+	return mmGetStatus.mock
+}
+
+// When sets expectation for the Core.GetStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetStatus *mCoreMockGetStatus) When(ctx context.Context, userID uuid.UUID) *CoreMockGetStatusExpectation {
+	if mmGetStatus.mock.funcGetStatus != nil {
+		mmGetStatus.mock.t.Fatalf("CoreMock.GetStatus mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetStatusExpectation{
+		mock:               mmGetStatus.mock,
+		params:             &CoreMockGetStatusParams{ctx, userID},
+		expectationOrigins: CoreMockGetStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStatus.expectations = append(mmGetStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetStatus return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetStatusExpectation) Then(s1 terms.Status, err error) *CoreMock {
+	e.results = &CoreMockGetStatusResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetStatus should be invoked
+func (mmGetStatus *mCoreMockGetStatus) Times(n uint64) *mCoreMockGetStatus {
+	if n == 0 {
+		mmGetStatus.mock.t.Fatalf("Times of CoreMock.GetStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStatus.expectedInvocations, n)
+	mmGetStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStatus
+}
+
+func (mmGetStatus *mCoreMockGetStatus) invocationsDone() bool {
+	if len(mmGetStatus.expectations) == 0 && mmGetStatus.defaultExpectation == nil && mmGetStatus.mock.funcGetStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStatus.mock.afterGetStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStatus implements mm_usecase.Core
+func (mmGetStatus *CoreMock) GetStatus(ctx context.Context, userID uuid.UUID) (s1 terms.Status, err error) {
+	mm_atomic.AddUint64(&mmGetStatus.beforeGetStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStatus.afterGetStatusCounter, 1)
+
+	mmGetStatus.t.Helper()
+
+	if mmGetStatus.inspectFuncGetStatus != nil {
+		mmGetStatus.inspectFuncGetStatus(ctx, userID)
+	}
+
+	mm_params := CoreMockGetStatusParams{ctx, userID}
+
+	// Record call args
+	mmGetStatus.GetStatusMock.mutex.Lock()
+	mmGetStatus.GetStatusMock.callArgs = append(mmGetStatus.GetStatusMock.callArgs, &mm_params)
+	mmGetStatus.GetStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetStatus.GetStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStatus.GetStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStatus.GetStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStatus.GetStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStatus.GetStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetStatusParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStatus.t.Errorf("CoreMock.GetStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetStatus.t.Errorf("CoreMock.GetStatus got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStatus.t.Errorf("CoreMock.GetStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStatus.GetStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStatus.GetStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStatus.t.Fatal("No results are set for the CoreMock.GetStatus")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStatus.funcGetStatus != nil {
+		return mmGetStatus.funcGetStatus(ctx, userID)
+	}
+	mmGetStatus.t.Fatalf("Unexpected call to CoreMock.GetStatus. %v %v", ctx, userID)
+	return
+}
+
+// GetStatusAfterCounter returns a count of finished CoreMock.GetStatus invocations
+func (mmGetStatus *CoreMock) GetStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatus.afterGetStatusCounter)
+}
+
+// GetStatusBeforeCounter returns a count of CoreMock.GetStatus invocations
+func (mmGetStatus *CoreMock) GetStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatus.beforeGetStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStatus *mCoreMockGetStatus) Calls() []*CoreMockGetStatusParams {
+	mmGetStatus.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetStatusParams, len(mmGetStatus.callArgs))
+	copy(argCopy, mmGetStatus.callArgs)
+
+	mmGetStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStatusDone returns true if the count of the GetStatus invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetStatusDone() bool {
+	if m.GetStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStatusMock.invocationsDone()
+}
+
+// MinimockGetStatusInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetStatusInspect() {
+	for _, e := range m.GetStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStatusCounter := mm_atomic.LoadUint64(&m.afterGetStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStatusMock.defaultExpectation != nil && afterGetStatusCounter < 1 {
+		if m.GetStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetStatus at\n%s", m.GetStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetStatus at\n%s with params: %#v", m.GetStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStatus != nil && afterGetStatusCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetStatus at\n%s", m.funcGetStatusOrigin)
+	}
+
+	if !m.GetStatusMock.invocationsDone() && afterGetStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStatusMock.expectedInvocations), m.GetStatusMock.expectedInvocationsOrigin, afterGetStatusCounter)
+	}
+}
+
+type mCoreMockGetTerms struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetTermsExpectation
+	expectations       []*CoreMockGetTermsExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetTermsExpectation specifies expectation struct of the Core.GetTerms
+type CoreMockGetTermsExpectation struct {
+	mock *CoreMock
+
+	results      *CoreMockGetTermsResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// CoreMockGetTermsResults contains results of the Core.GetTerms
+type CoreMockGetTermsResults struct {
+	t1 terms.Terms
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTerms *mCoreMockGetTerms) Optional() *mCoreMockGetTerms {
+	mmGetTerms.optional = true
+	return mmGetTerms
+}
+
+// Expect sets up expected params for Core.GetTerms
+func (mmGetTerms *mCoreMockGetTerms) Expect() *mCoreMockGetTerms {
+	if mmGetTerms.mock.funcGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("CoreMock.GetTerms mock is already set by Set")
+	}
+
+	if mmGetTerms.defaultExpectation == nil {
+		mmGetTerms.defaultExpectation = &CoreMockGetTermsExpectation{}
+	}
+
+	return mmGetTerms
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetTerms
+func (mmGetTerms *mCoreMockGetTerms) Inspect(f func()) *mCoreMockGetTerms {
+	if mmGetTerms.mock.inspectFuncGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("Inspect function is already set for CoreMock.GetTerms")
+	}
+
+	mmGetTerms.mock.inspectFuncGetTerms = f
+
+	return mmGetTerms
+}
+
+// Return sets up results that will be returned by Core.GetTerms
+func (mmGetTerms *mCoreMockGetTerms) Return(t1 terms.Terms) *CoreMock {
+	if mmGetTerms.mock.funcGetTerms != nil {
+		mmGetTerms.mock.t.Fatalf("CoreMock.GetTerms mock is already set by Set")
+	}
+
+	if mmGetTerms.defaultExpectation == nil {
+		mmGetTerms.defaultExpectation = &CoreMockGetTermsExpectation{mock: mmGetTerms.mock}
+	}
+	mmGetTerms.defaultExpectation.results = &CoreMockGetTermsResults{t1}
+	mmGetTerms.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTerms.mock
+}
+
+// Set uses given function f to mock the Core.GetTerms method
+func (mmGetTerms *mCoreMockGetTerms) Set(f func() (t1 terms.Terms)) *CoreMock {
+	if mmGetTerms.defaultExpectation != nil {
+		mmGetTerms.mock.t.Fatalf("Default expectation is already set for the Core.GetTerms method")
+	}
+
+	if len(mmGetTerms.expectations) > 0 {
+		mmGetTerms.mock.t.Fatalf("Some expectations are already set for the Core.GetTerms method")
+	}
+
+	mmGetTerms.mock.funcGetTerms = f
+	mmGetTerms.mock.funcGetTermsOrigin = minimock.CallerInfo(1)
+	return mmGetTerms.mock
+}
+
+// Times sets number of times Core.GetTerms should be invoked
+func (mmGetTerms *mCoreMockGetTerms) Times(n uint64) *mCoreMockGetTerms {
+	if n == 0 {
+		mmGetTerms.mock.t.Fatalf("Times of CoreMock.GetTerms mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTerms.expectedInvocations, n)
+	mmGetTerms.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTerms
+}
+
+func (mmGetTerms *mCoreMockGetTerms) invocationsDone() bool {
+	if len(mmGetTerms.expectations) == 0 && mmGetTerms.defaultExpectation == nil && mmGetTerms.mock.funcGetTerms == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTerms.mock.afterGetTermsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTerms.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTerms implements mm_usecase.Core
+func (mmGetTerms *CoreMock) GetTerms() (t1 terms.Terms) {
+	mm_atomic.AddUint64(&mmGetTerms.beforeGetTermsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTerms.afterGetTermsCounter, 1)
+
+	mmGetTerms.t.Helper()
+
+	if mmGetTerms.inspectFuncGetTerms != nil {
+		mmGetTerms.inspectFuncGetTerms()
+	}
+
+	if mmGetTerms.GetTermsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTerms.GetTermsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmGetTerms.GetTermsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTerms.t.Fatal("No results are set for the CoreMock.GetTerms")
+		}
+		return (*mm_results).t1
+	}
+	if mmGetTerms.funcGetTerms != nil {
+		return mmGetTerms.funcGetTerms()
+	}
+	mmGetTerms.t.Fatalf("Unexpected call to CoreMock.GetTerms.")
+	return
+}
+
+// GetTermsAfterCounter returns a count of finished CoreMock.GetTerms invocations
+func (mmGetTerms *CoreMock) GetTermsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTerms.afterGetTermsCounter)
+}
+
+// GetTermsBeforeCounter returns a count of CoreMock.GetTerms invocations
+func (mmGetTerms *CoreMock) GetTermsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTerms.beforeGetTermsCounter)
+}
+
+// MinimockGetTermsDone returns true if the count of the GetTerms invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetTermsDone() bool {
+	if m.GetTermsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTermsMock.invocationsDone()
+}
+
+// MinimockGetTermsInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetTermsInspect() {
+	for _, e := range m.GetTermsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to CoreMock.GetTerms")
+		}
+	}
+
+	afterGetTermsCounter := mm_atomic.LoadUint64(&m.afterGetTermsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTermsMock.defaultExpectation != nil && afterGetTermsCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetTerms at\n%s", m.GetTermsMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTerms != nil && afterGetTermsCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetTerms at\n%s", m.funcGetTermsOrigin)
+	}
+
+	if !m.GetTermsMock.invocationsDone() && afterGetTermsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetTerms at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTermsMock.expectedInvocations), m.GetTermsMock.expectedInvocationsOrigin, afterGetTermsCounter)
+	}
+}
+
+type mCoreMockList struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockListExpectation
+	expectations       []*CoreMockListExpectation
+
+	callArgs []*CoreMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockListExpectation specifies expectation struct of the Core.List
+type CoreMockListExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockListParams
+	paramPtrs          *CoreMockListParamPtrs
+	expectationOrigins CoreMockListExpectationOrigins
+	results            *CoreMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockListParams contains parameters of the Core.List
+type CoreMockListParams struct {
+	ctx context.Context
+}
+
+// CoreMockListParamPtrs contains pointers to parameters of the Core.List
+type CoreMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// CoreMockListResults contains results of the Core.List
+type CoreMockListResults struct {
+	sa1 []terms.Status
+	err error
+}
+
+// CoreMockListOrigins contains origins of expectations of the Core.List
+type CoreMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mCoreMockList) Optional() *mCoreMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for Core.List
+func (mmList *mCoreMockList) Expect(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &CoreMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.List
+func (mmList *mCoreMockList) ExpectCtxParam1(ctx context.Context) *mCoreMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &CoreMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.List
+func (mmList *mCoreMockList) Inspect(f func(ctx context.Context)) *mCoreMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for CoreMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by Core.List
+func (mmList *mCoreMockList) Return(sa1 []terms.Status, err error) *CoreMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &CoreMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &CoreMockListResults{sa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the Core.List method
+func (mmList *mCoreMockList) Set(f func(ctx context.Context) (sa1 []terms.Status, err error)) *CoreMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the Core.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the Core.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the Core.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mCoreMockList) When(ctx context.Context) *CoreMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("CoreMock.List mock is already set by Set")
+	}
+
+	expectation := &CoreMockListExpectation{
+		mock:               mmList.mock,
+		params:             &CoreMockListParams{ctx},
+		expectationOrigins: CoreMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.List return parameters for the expectation previously defined by the When method
+func (e *CoreMockListExpectation) Then(sa1 []terms.Status, err error) *CoreMock {
+	e.results = &CoreMockListResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.List should be invoked
+func (mmList *mCoreMockList) Times(n uint64) *mCoreMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of CoreMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mCoreMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_usecase.Core
+func (mmList *CoreMock) List(ctx context.Context) (sa1 []terms.Status, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := CoreMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("CoreMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("CoreMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the CoreMock.List")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to CoreMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished CoreMock.List invocations
+func (mmList *CoreMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of CoreMock.List invocations
+func (mmList *CoreMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mCoreMockList) Calls() []*CoreMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*CoreMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *CoreMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcceptInspect()
+
+			m.MinimockGetStatusInspect()
+
+			m.MinimockGetTermsInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcceptDone() &&
+		m.MinimockGetStatusDone() &&
+		m.MinimockGetTermsDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	GetTerms() terms.Terms
+	Accept(ctx context.Context, userID uuid.UUID, version string) error
+	GetStatus(ctx context.Context, userID uuid.UUID) (terms.Status, error)
+	List(ctx context.Context) ([]terms.Status, error)
+}
+
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("terms.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+func (s *service) GetTerms() terms.Terms {
+	return s.core.GetTerms()
+}
+
+// GetMyStatus returns whether the current user accepted the terms in force.
+func (s *service) GetMyStatus(ctx context.Context) (terms.Status, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("terms.service.GetMyStatus.contextx.GetUserID")
+		return terms.Status{}, fmt.Errorf("terms.service.GetMyStatus: %w", err)
+	}
+
+	status, err := s.core.GetStatus(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).Msg("terms.service.GetMyStatus: failed to get status")
+		return terms.Status{}, fmt.Errorf("terms.service.GetMyStatus: %w", err)
+	}
+
+	return status, nil
+}
+
+// Accept records that the current user accepted the given version of the terms. An admin acting as
+// the user cannot accept them on their behalf.
+func (s *service) Accept(ctx context.Context, version string) error {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("terms.service.Accept.contextx.GetUserID")
+		return fmt.Errorf("terms.service.Accept: %w", err)
+	}
+	if _, err = contextx.GetActorID(ctx); err == nil {
+		err = apperr.ErrForbidden().WithDetail("terms cannot be accepted on behalf of a user")
+		logger.Error(ctx, err).Msg("terms.service.Accept: impersonated")
+		return fmt.Errorf("terms.service.Accept: %w", err)
+	}
+
+	if err = s.core.Accept(ctx, userID, version); err != nil {
+		logger.Error(ctx, err).
+			Str(terms.FieldVersion.String(), version).
+			Msg("terms.service.Accept: failed to accept terms")
+		return fmt.Errorf("terms.service.Accept: %w", err)
+	}
+
+	logger.Audit(ctx, "terms.accepted").
+		Str(terms.FieldVersion.String(), version).
+		Msg("user accepted the terms of service")
+	return nil
+}
+
+// List returns the acceptance status of every user of the workspace. The route requires admin role.
+func (s *service) List(ctx context.Context) ([]terms.Status, error) {
+	statuses, err := s.core.List(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("terms.service.List: failed to list statuses")
+		return nil, fmt.Errorf("terms.service.List: %w", err)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/terms"
+	"github.com/66gu1/easygodocs/internal/app/terms/usecase"
+	"github.com/66gu1/easygodocs/internal/app/terms/usecase/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core *mocks.CoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core: mocks.NewCoreMock(t),
+	}
+}
+
+func TestService_GetMyStatus(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	ctx := contextx.SetUserID(t.Context(), userID)
+	status := terms.Status{UserID: userID, AcceptedVersion: "2025-09", Accepted: true}
+
+	mocks := getMocks(t)
+	mocks.core.GetStatusMock.Expect(ctx, userID).Return(status, nil)
+	svc := usecase.NewService(mocks.core)
+
+	got, err := svc.GetMyStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, status, got)
+
+	_, err = svc.GetMyStatus(t.Context())
+	require.Error(t, err)
+}
+
+func TestService_Accept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.New()
+		ctx     = contextx.SetUserID(t.Context(), userID)
+		errCore = errors.New("db down")
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		mocks.core.AcceptMock.Expect(minimock.AnyContext, userID, "2025-09").Return(nil)
+		require.NoError(t, usecase.NewService(mocks.core).Accept(ctx, "2025-09"))
+	})
+
+	t.Run("core error", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		mocks.core.AcceptMock.Return(errCore)
+		require.ErrorIs(t, usecase.NewService(mocks.core).Accept(ctx, "2025-09"), errCore)
+	})
+
+	t.Run("impersonated", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		err := usecase.NewService(mocks.core).Accept(contextx.SetActorID(ctx, uuid.New()), "2025-09")
+		require.Equal(t, apperr.ClassForbidden, apperr.ClassOf(err))
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		t.Parallel()
+		mocks := getMocks(t)
+		require.Error(t, usecase.NewService(mocks.core).Accept(t.Context(), "2025-09"))
+	})
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		statuses = []terms.Status{{UserID: uuid.New(), Accepted: true}}
+		errCore  = errors.New("db down")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		want  []terms.Status
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.core.ListMock.Expect(ctx).Return(statuses, nil)
+			},
+			want: statuses,
+		},
+		{
+			name: "core error",
+			setup: func(mocks mock) {
+				mocks.core.ListMock.Expect(ctx).Return(nil, errCore)
+			},
+			err: errCore,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mocks := getMocks(t)
+			tc.setup(mocks)
+			svc := usecase.NewService(mocks.core)
+
+			got, err := svc.List(ctx)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	AddUsage(ctx context.Context, records []Record) error
+	GetTopConsumers(ctx context.Context, since time.Time, limit int) ([]Consumer, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+type Config struct {
+	FlushIntervalSeconds int `mapstructure:"flush_interval_seconds" json:"flush_interval_seconds"`
+	// QuotaRequestsPerHour of 0 disables enforcement; usage is tracked anyway.
+	QuotaRequestsPerHour int `mapstructure:"quota_requests_per_hour" json:"quota_requests_per_hour"`
+	MaxReportHours       int `mapstructure:"max_report_hours" json:"max_report_hours"`
+	MaxReportLimit       int `mapstructure:"max_report_limit" json:"max_report_limit"`
+}
+
+func (c Config) Validate() error {
+	if c.FlushIntervalSeconds <= 0 || c.MaxReportHours <= 0 || c.MaxReportLimit <= 0 {
+		return fmt.Errorf("flush interval and report limits must be positive")
+	}
+	if c.QuotaRequestsPerHour < 0 {
+		return fmt.Errorf("quota must not be negative")
+	}
+
+	return nil
+}
+
+type key struct {
+	userID uuid.UUID
+	hour   time.Time
+}
+
+// core aggregates usage in memory and periodically flushes it to the repository,
+// so tracking adds no database round trip to a request.
+// Quota counters are per process: with several instances each enforces the quota on its own.
+type core struct {
+	repo    Repository
+	timeGen TimeGenerator
+	cfg     Config
+
+	mu      sync.Mutex
+	hour    time.Time
+	current map[uuid.UUID]int64
+	pending map[key]Record
+}
+
+func NewCore(repo Repository, timeGen TimeGenerator, cfg Config) (*core, error) {
+	if repo == nil || timeGen == nil {
+		return nil, fmt.Errorf("usage.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("usage.NewCore: %w", err)
+	}
+
+	return &core{
+		repo:    repo,
+		timeGen: timeGen,
+		cfg:     cfg,
+		current: make(map[uuid.UUID]int64),
+		pending: make(map[key]Record),
+	}, nil
+}
+
+// Allow reports whether the user may make another request in the current hour.
+func (c *core) Allow(userID uuid.UUID) error {
+	if c.cfg.QuotaRequestsPerHour == 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rollHour()
+	if c.current[userID] >= int64(c.cfg.QuotaRequestsPerHour) {
+		return fmt.Errorf("usage.core.Allow: %w", ErrQuotaExceeded(c.cfg.QuotaRequestsPerHour))
+	}
+
+	return nil
+}
+
+// Track counts one request of the user.
+func (c *core) Track(userID uuid.UUID, bytesIn, bytesOut int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rollHour()
+	c.current[userID]++
+
+	k := key{userID: userID, hour: c.hour}
+	rec := c.pending[k]
+	rec.UserID, rec.Hour = userID, c.hour
+	rec.Requests++
+	rec.BytesIn += bytesIn
+	rec.BytesOut += bytesOut
+	c.pending[k] = rec
+}
+
+// rollHour must be called with c.mu held.
+func (c *core) rollHour() {
+	hour := c.timeGen.Now().UTC().Truncate(time.Hour)
+	if !hour.Equal(c.hour) {
+		c.hour = hour
+		clear(c.current)
+	}
+}
+
+// Flush writes the aggregated usage. On failure the records are kept for the next flush.
+func (c *core) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	if len(c.pending) == 0 {
+		c.mu.Unlock()
+		return nil
+	}
+	pending := c.pending
+	c.pending = make(map[key]Record, len(pending))
+	c.mu.Unlock()
+
+	records := make([]Record, 0, len(pending))
+	for _, rec := range pending {
+		records = append(records, rec)
+	}
+	if err := c.repo.AddUsage(ctx, records); err != nil {
+		c.mu.Lock()
+		for k, rec := range pending {
+			merged := c.pending[k]
+			merged.UserID, merged.Hour = rec.UserID, rec.Hour
+			merged.Requests += rec.Requests
+			merged.BytesIn += rec.BytesIn
+			merged.BytesOut += rec.BytesOut
+			c.pending[k] = merged
+		}
+		c.mu.Unlock()
+		return fmt.Errorf("usage.core.Flush: %w", err)
+	}
+
+	return nil
+}
+
+// Run flushes every FlushIntervalSeconds until ctx is done, then flushes once more.
+func (c *core) Run(ctx context.Context, onError func(error)) {
+	ticker := time.NewTicker(time.Duration(c.cfg.FlushIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			if err := c.Flush(flushCtx); err != nil {
+				onError(err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// GetTopConsumers returns users with the most requests in the last req.Hours hours.
+// Usage not yet flushed is not included.
+func (c *core) GetTopConsumers(ctx context.Context, req GetTopConsumersReq) ([]Consumer, error) {
+	if req.Hours <= 0 || req.Hours > c.cfg.MaxReportHours {
+		return nil, fmt.Errorf("usage.core.GetTopConsumers: %w", ErrInvalidHours(c.cfg.MaxReportHours))
+	}
+	if req.Limit <= 0 || req.Limit > c.cfg.MaxReportLimit {
+		return nil, fmt.Errorf("usage.core.GetTopConsumers: %w", ErrInvalidLimit(c.cfg.MaxReportLimit))
+	}
+
+	since := c.timeGen.Now().UTC().Truncate(time.Hour).Add(-time.Duration(req.Hours-1) * time.Hour)
+	consumers, err := c.repo.GetTopConsumers(ctx, since, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("usage.core.GetTopConsumers: %w", err)
+	}
+
+	return consumers, nil
+}
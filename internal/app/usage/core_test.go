@@ -0,0 +1,175 @@
+package usage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/app/usage/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func cfg() usage.Config {
+	return usage.Config{
+		FlushIntervalSeconds: 60,
+		QuotaRequestsPerHour: 2,
+		MaxReportHours:       48,
+		MaxReportLimit:       100,
+	}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	_, err := usage.NewCore(nil, mocks.NewTimeGeneratorMock(t), cfg())
+	require.Error(t, err)
+
+	bad := cfg()
+	bad.QuotaRequestsPerHour = -1
+	_, err = usage.NewCore(mocks.NewRepositoryMock(t), mocks.NewTimeGeneratorMock(t), bad)
+	require.Error(t, err)
+}
+
+func TestCore_QuotaAndFlush(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		now    = time.Date(2025, 9, 1, 10, 15, 0, 0, time.UTC)
+		hour   = now.Truncate(time.Hour)
+		userID = uuid.New()
+		other  = uuid.New()
+	)
+	repo := mocks.NewRepositoryMock(t)
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Set(func() time.Time { return now })
+	core, err := usage.NewCore(repo, timeGen, cfg())
+	require.NoError(t, err)
+
+	require.NoError(t, core.Allow(userID))
+	core.Track(userID, 10, 100)
+	require.NoError(t, core.Allow(userID))
+	core.Track(userID, 0, 50)
+	err = core.Allow(userID)
+	require.ErrorIs(t, err, usage.ErrQuotaExceeded(2))
+	require.Equal(t, apperr.ClassTooManyRequests, apperr.ClassOf(err))
+	require.NoError(t, core.Allow(other))
+
+	// failed flush keeps the records and merges them with new ones
+	var flushed []usage.Record
+	flushErr := errors.New("db down")
+	repo.AddUsageMock.Set(func(_ context.Context, records []usage.Record) error {
+		flushed = records
+		return flushErr
+	})
+	require.ErrorIs(t, core.Flush(ctx), flushErr)
+	core.Track(other, 1, 1)
+
+	now = now.Add(time.Hour)
+	require.NoError(t, core.Allow(userID), "quota resets with the hour")
+	core.Track(userID, 5, 5)
+
+	flushErr = nil
+	require.NoError(t, core.Flush(ctx))
+	require.ElementsMatch(t, []usage.Record{
+		{UserID: userID, Hour: hour, Requests: 2, BytesIn: 10, BytesOut: 150},
+		{UserID: other, Hour: hour, Requests: 1, BytesIn: 1, BytesOut: 1},
+		{UserID: userID, Hour: hour.Add(time.Hour), Requests: 1, BytesIn: 5, BytesOut: 5},
+	}, flushed)
+	// nothing pending
+	require.NoError(t, core.Flush(ctx))
+	require.Equal(t, uint64(2), repo.AddUsageAfterCounter())
+}
+
+func TestCore_QuotaDisabled(t *testing.T) {
+	t.Parallel()
+
+	c := cfg()
+	c.QuotaRequestsPerHour = 0
+	timeGen := mocks.NewTimeGeneratorMock(t)
+	timeGen.NowMock.Return(time.Now())
+	core, err := usage.NewCore(mocks.NewRepositoryMock(t), timeGen, c)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	for range 10 {
+		require.NoError(t, core.Allow(userID))
+		core.Track(userID, 0, 0)
+	}
+}
+
+func TestCore_GetTopConsumers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		now       = time.Date(2025, 9, 1, 10, 15, 0, 0, time.UTC)
+		consumers = []usage.Consumer{{UserID: uuid.New(), Requests: 3}}
+		errRepo   = errors.New("db down")
+	)
+
+	tests := []struct {
+		name  string
+		req   usage.GetTopConsumersReq
+		setup func(repo *mocks.RepositoryMock)
+		want  []usage.Consumer
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  usage.GetTopConsumersReq{Hours: 3, Limit: 5},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetTopConsumersMock.Expect(minimock.AnyContext, time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC), 5).
+					Return(consumers, nil)
+			},
+			want: consumers,
+		},
+		{
+			name: "hours out of range",
+			req:  usage.GetTopConsumersReq{Hours: 49, Limit: 5},
+			err:  usage.ErrInvalidHours(48),
+		},
+		{
+			name: "limit out of range",
+			req:  usage.GetTopConsumersReq{Hours: 1, Limit: 0},
+			err:  usage.ErrInvalidLimit(100),
+		},
+		{
+			name: "repo error",
+			req:  usage.GetTopConsumersReq{Hours: 1, Limit: 1},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.GetTopConsumersMock.Return(nil, errRepo)
+			},
+			err: errRepo,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewRepositoryMock(t)
+			timeGen := mocks.NewTimeGeneratorMock(t)
+			timeGen.NowMock.Optional().Return(now)
+			if tc.setup != nil {
+				tc.setup(repo)
+			}
+			core, err := usage.NewCore(repo, timeGen, cfg())
+			require.NoError(t, err)
+
+			got, err := core.GetTopConsumers(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/google/uuid"
+)
+
+const (
+	FieldUserID apperr.Field = "user_id"
+	FieldHours  apperr.Field = "hours"
+	FieldLimit  apperr.Field = "limit"
+)
+
+// Record is the usage of one user within one hour.
+type Record struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Hour     time.Time `json:"hour"`
+	Requests int64     `json:"requests"`
+	BytesIn  int64     `json:"bytes_in"`
+	BytesOut int64     `json:"bytes_out"`
+}
+
+// Consumer is the usage of one user summed over a period.
+type Consumer struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Requests int64     `json:"requests"`
+	BytesIn  int64     `json:"bytes_in"`
+	BytesOut int64     `json:"bytes_out"`
+}
+
+type GetTopConsumersReq struct {
+	Hours int `json:"hours"`
+	Limit int `json:"limit"`
+}
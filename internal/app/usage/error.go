@@ -0,0 +1,37 @@
+package usage
+
+import "github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+
+const (
+	CodeValidationFailed apperr.Code = "usage/validation_failed"
+	CodeQuotaExceeded    apperr.Code = "usage/quota_exceeded"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid usage query", apperr.ClassBadRequest)
+	apperr.Register(CodeQuotaExceeded, "Request quota exceeded", apperr.ClassTooManyRequests)
+}
+
+func ErrQuotaExceeded(quota int) error {
+	return apperr.New("Hourly request quota exceeded", CodeQuotaExceeded, apperr.ClassTooManyRequests, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldUserID, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"max": quota},
+		})
+}
+
+func ErrInvalidHours(maxHours int) error {
+	return apperr.New("hours is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldHours, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxHours},
+		})
+}
+
+func ErrInvalidLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
@@ -0,0 +1,805 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/usage.Repository -o repository_mock.go -n RepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_usage "github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements mm_usage.Repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddUsage          func(ctx context.Context, records []mm_usage.Record) (err error)
+	funcAddUsageOrigin    string
+	inspectFuncAddUsage   func(ctx context.Context, records []mm_usage.Record)
+	afterAddUsageCounter  uint64
+	beforeAddUsageCounter uint64
+	AddUsageMock          mRepositoryMockAddUsage
+
+	funcGetTopConsumers          func(ctx context.Context, since time.Time, limit int) (ca1 []mm_usage.Consumer, err error)
+	funcGetTopConsumersOrigin    string
+	inspectFuncGetTopConsumers   func(ctx context.Context, since time.Time, limit int)
+	afterGetTopConsumersCounter  uint64
+	beforeGetTopConsumersCounter uint64
+	GetTopConsumersMock          mRepositoryMockGetTopConsumers
+}
+
+// NewRepositoryMock returns a mock for mm_usage.Repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddUsageMock = mRepositoryMockAddUsage{mock: m}
+	m.AddUsageMock.callArgs = []*RepositoryMockAddUsageParams{}
+
+	m.GetTopConsumersMock = mRepositoryMockGetTopConsumers{mock: m}
+	m.GetTopConsumersMock.callArgs = []*RepositoryMockGetTopConsumersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockAddUsage struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAddUsageExpectation
+	expectations       []*RepositoryMockAddUsageExpectation
+
+	callArgs []*RepositoryMockAddUsageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAddUsageExpectation specifies expectation struct of the Repository.AddUsage
+type RepositoryMockAddUsageExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAddUsageParams
+	paramPtrs          *RepositoryMockAddUsageParamPtrs
+	expectationOrigins RepositoryMockAddUsageExpectationOrigins
+	results            *RepositoryMockAddUsageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAddUsageParams contains parameters of the Repository.AddUsage
+type RepositoryMockAddUsageParams struct {
+	ctx     context.Context
+	records []mm_usage.Record
+}
+
+// RepositoryMockAddUsageParamPtrs contains pointers to parameters of the Repository.AddUsage
+type RepositoryMockAddUsageParamPtrs struct {
+	ctx     *context.Context
+	records *[]mm_usage.Record
+}
+
+// RepositoryMockAddUsageResults contains results of the Repository.AddUsage
+type RepositoryMockAddUsageResults struct {
+	err error
+}
+
+// RepositoryMockAddUsageOrigins contains origins of expectations of the Repository.AddUsage
+type RepositoryMockAddUsageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRecords string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddUsage *mRepositoryMockAddUsage) Optional() *mRepositoryMockAddUsage {
+	mmAddUsage.optional = true
+	return mmAddUsage
+}
+
+// Expect sets up expected params for Repository.AddUsage
+func (mmAddUsage *mRepositoryMockAddUsage) Expect(ctx context.Context, records []mm_usage.Record) *mRepositoryMockAddUsage {
+	if mmAddUsage.mock.funcAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Set")
+	}
+
+	if mmAddUsage.defaultExpectation == nil {
+		mmAddUsage.defaultExpectation = &RepositoryMockAddUsageExpectation{}
+	}
+
+	if mmAddUsage.defaultExpectation.paramPtrs != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by ExpectParams functions")
+	}
+
+	mmAddUsage.defaultExpectation.params = &RepositoryMockAddUsageParams{ctx, records}
+	mmAddUsage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddUsage.expectations {
+		if minimock.Equal(e.params, mmAddUsage.defaultExpectation.params) {
+			mmAddUsage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddUsage.defaultExpectation.params)
+		}
+	}
+
+	return mmAddUsage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.AddUsage
+func (mmAddUsage *mRepositoryMockAddUsage) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAddUsage {
+	if mmAddUsage.mock.funcAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Set")
+	}
+
+	if mmAddUsage.defaultExpectation == nil {
+		mmAddUsage.defaultExpectation = &RepositoryMockAddUsageExpectation{}
+	}
+
+	if mmAddUsage.defaultExpectation.params != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Expect")
+	}
+
+	if mmAddUsage.defaultExpectation.paramPtrs == nil {
+		mmAddUsage.defaultExpectation.paramPtrs = &RepositoryMockAddUsageParamPtrs{}
+	}
+	mmAddUsage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddUsage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddUsage
+}
+
+// ExpectRecordsParam2 sets up expected param records for Repository.AddUsage
+func (mmAddUsage *mRepositoryMockAddUsage) ExpectRecordsParam2(records []mm_usage.Record) *mRepositoryMockAddUsage {
+	if mmAddUsage.mock.funcAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Set")
+	}
+
+	if mmAddUsage.defaultExpectation == nil {
+		mmAddUsage.defaultExpectation = &RepositoryMockAddUsageExpectation{}
+	}
+
+	if mmAddUsage.defaultExpectation.params != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Expect")
+	}
+
+	if mmAddUsage.defaultExpectation.paramPtrs == nil {
+		mmAddUsage.defaultExpectation.paramPtrs = &RepositoryMockAddUsageParamPtrs{}
+	}
+	mmAddUsage.defaultExpectation.paramPtrs.records = &records
+	mmAddUsage.defaultExpectation.expectationOrigins.originRecords = minimock.CallerInfo(1)
+
+	return mmAddUsage
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.AddUsage
+func (mmAddUsage *mRepositoryMockAddUsage) Inspect(f func(ctx context.Context, records []mm_usage.Record)) *mRepositoryMockAddUsage {
+	if mmAddUsage.mock.inspectFuncAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("Inspect function is already set for RepositoryMock.AddUsage")
+	}
+
+	mmAddUsage.mock.inspectFuncAddUsage = f
+
+	return mmAddUsage
+}
+
+// Return sets up results that will be returned by Repository.AddUsage
+func (mmAddUsage *mRepositoryMockAddUsage) Return(err error) *RepositoryMock {
+	if mmAddUsage.mock.funcAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Set")
+	}
+
+	if mmAddUsage.defaultExpectation == nil {
+		mmAddUsage.defaultExpectation = &RepositoryMockAddUsageExpectation{mock: mmAddUsage.mock}
+	}
+	mmAddUsage.defaultExpectation.results = &RepositoryMockAddUsageResults{err}
+	mmAddUsage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddUsage.mock
+}
+
+// Set uses given function f to mock the Repository.AddUsage method
+func (mmAddUsage *mRepositoryMockAddUsage) Set(f func(ctx context.Context, records []mm_usage.Record) (err error)) *RepositoryMock {
+	if mmAddUsage.defaultExpectation != nil {
+		mmAddUsage.mock.t.Fatalf("Default expectation is already set for the Repository.AddUsage method")
+	}
+
+	if len(mmAddUsage.expectations) > 0 {
+		mmAddUsage.mock.t.Fatalf("Some expectations are already set for the Repository.AddUsage method")
+	}
+
+	mmAddUsage.mock.funcAddUsage = f
+	mmAddUsage.mock.funcAddUsageOrigin = minimock.CallerInfo(1)
+	return mmAddUsage.mock
+}
+
+// When sets expectation for the Repository.AddUsage which will trigger the result defined by the following
+// Then helper
+func (mmAddUsage *mRepositoryMockAddUsage) When(ctx context.Context, records []mm_usage.Record) *RepositoryMockAddUsageExpectation {
+	if mmAddUsage.mock.funcAddUsage != nil {
+		mmAddUsage.mock.t.Fatalf("RepositoryMock.AddUsage mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddUsageExpectation{
+		mock:               mmAddUsage.mock,
+		params:             &RepositoryMockAddUsageParams{ctx, records},
+		expectationOrigins: RepositoryMockAddUsageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddUsage.expectations = append(mmAddUsage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.AddUsage return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddUsageExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAddUsageResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.AddUsage should be invoked
+func (mmAddUsage *mRepositoryMockAddUsage) Times(n uint64) *mRepositoryMockAddUsage {
+	if n == 0 {
+		mmAddUsage.mock.t.Fatalf("Times of RepositoryMock.AddUsage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddUsage.expectedInvocations, n)
+	mmAddUsage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddUsage
+}
+
+func (mmAddUsage *mRepositoryMockAddUsage) invocationsDone() bool {
+	if len(mmAddUsage.expectations) == 0 && mmAddUsage.defaultExpectation == nil && mmAddUsage.mock.funcAddUsage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddUsage.mock.afterAddUsageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddUsage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddUsage implements mm_usage.Repository
+func (mmAddUsage *RepositoryMock) AddUsage(ctx context.Context, records []mm_usage.Record) (err error) {
+	mm_atomic.AddUint64(&mmAddUsage.beforeAddUsageCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddUsage.afterAddUsageCounter, 1)
+
+	mmAddUsage.t.Helper()
+
+	if mmAddUsage.inspectFuncAddUsage != nil {
+		mmAddUsage.inspectFuncAddUsage(ctx, records)
+	}
+
+	mm_params := RepositoryMockAddUsageParams{ctx, records}
+
+	// Record call args
+	mmAddUsage.AddUsageMock.mutex.Lock()
+	mmAddUsage.AddUsageMock.callArgs = append(mmAddUsage.AddUsageMock.callArgs, &mm_params)
+	mmAddUsage.AddUsageMock.mutex.Unlock()
+
+	for _, e := range mmAddUsage.AddUsageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddUsage.AddUsageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddUsage.AddUsageMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddUsage.AddUsageMock.defaultExpectation.params
+		mm_want_ptrs := mmAddUsage.AddUsageMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddUsageParams{ctx, records}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddUsage.t.Errorf("RepositoryMock.AddUsage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUsage.AddUsageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.records != nil && !minimock.Equal(*mm_want_ptrs.records, mm_got.records) {
+				mmAddUsage.t.Errorf("RepositoryMock.AddUsage got unexpected parameter records, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUsage.AddUsageMock.defaultExpectation.expectationOrigins.originRecords, *mm_want_ptrs.records, mm_got.records, minimock.Diff(*mm_want_ptrs.records, mm_got.records))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddUsage.t.Errorf("RepositoryMock.AddUsage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddUsage.AddUsageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddUsage.AddUsageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddUsage.t.Fatal("No results are set for the RepositoryMock.AddUsage")
+		}
+		return (*mm_results).err
+	}
+	if mmAddUsage.funcAddUsage != nil {
+		return mmAddUsage.funcAddUsage(ctx, records)
+	}
+	mmAddUsage.t.Fatalf("Unexpected call to RepositoryMock.AddUsage. %v %v", ctx, records)
+	return
+}
+
+// AddUsageAfterCounter returns a count of finished RepositoryMock.AddUsage invocations
+func (mmAddUsage *RepositoryMock) AddUsageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUsage.afterAddUsageCounter)
+}
+
+// AddUsageBeforeCounter returns a count of RepositoryMock.AddUsage invocations
+func (mmAddUsage *RepositoryMock) AddUsageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUsage.beforeAddUsageCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.AddUsage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddUsage *mRepositoryMockAddUsage) Calls() []*RepositoryMockAddUsageParams {
+	mmAddUsage.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddUsageParams, len(mmAddUsage.callArgs))
+	copy(argCopy, mmAddUsage.callArgs)
+
+	mmAddUsage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddUsageDone returns true if the count of the AddUsage invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddUsageDone() bool {
+	if m.AddUsageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddUsageMock.invocationsDone()
+}
+
+// MinimockAddUsageInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddUsageInspect() {
+	for _, e := range m.AddUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.AddUsage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddUsageCounter := mm_atomic.LoadUint64(&m.afterAddUsageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddUsageMock.defaultExpectation != nil && afterAddUsageCounter < 1 {
+		if m.AddUsageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.AddUsage at\n%s", m.AddUsageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.AddUsage at\n%s with params: %#v", m.AddUsageMock.defaultExpectation.expectationOrigins.origin, *m.AddUsageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddUsage != nil && afterAddUsageCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.AddUsage at\n%s", m.funcAddUsageOrigin)
+	}
+
+	if !m.AddUsageMock.invocationsDone() && afterAddUsageCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.AddUsage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddUsageMock.expectedInvocations), m.AddUsageMock.expectedInvocationsOrigin, afterAddUsageCounter)
+	}
+}
+
+type mRepositoryMockGetTopConsumers struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTopConsumersExpectation
+	expectations       []*RepositoryMockGetTopConsumersExpectation
+
+	callArgs []*RepositoryMockGetTopConsumersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTopConsumersExpectation specifies expectation struct of the Repository.GetTopConsumers
+type RepositoryMockGetTopConsumersExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTopConsumersParams
+	paramPtrs          *RepositoryMockGetTopConsumersParamPtrs
+	expectationOrigins RepositoryMockGetTopConsumersExpectationOrigins
+	results            *RepositoryMockGetTopConsumersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTopConsumersParams contains parameters of the Repository.GetTopConsumers
+type RepositoryMockGetTopConsumersParams struct {
+	ctx   context.Context
+	since time.Time
+	limit int
+}
+
+// RepositoryMockGetTopConsumersParamPtrs contains pointers to parameters of the Repository.GetTopConsumers
+type RepositoryMockGetTopConsumersParamPtrs struct {
+	ctx   *context.Context
+	since *time.Time
+	limit *int
+}
+
+// RepositoryMockGetTopConsumersResults contains results of the Repository.GetTopConsumers
+type RepositoryMockGetTopConsumersResults struct {
+	ca1 []mm_usage.Consumer
+	err error
+}
+
+// RepositoryMockGetTopConsumersOrigins contains origins of expectations of the Repository.GetTopConsumers
+type RepositoryMockGetTopConsumersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSince string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Optional() *mRepositoryMockGetTopConsumers {
+	mmGetTopConsumers.optional = true
+	return mmGetTopConsumers
+}
+
+// Expect sets up expected params for Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Expect(ctx context.Context, since time.Time, limit int) *mRepositoryMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &RepositoryMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by ExpectParams functions")
+	}
+
+	mmGetTopConsumers.defaultExpectation.params = &RepositoryMockGetTopConsumersParams{ctx, since, limit}
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTopConsumers.expectations {
+		if minimock.Equal(e.params, mmGetTopConsumers.defaultExpectation.params) {
+			mmGetTopConsumers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTopConsumers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTopConsumers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &RepositoryMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &RepositoryMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// ExpectSinceParam2 sets up expected param since for Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) ExpectSinceParam2(since time.Time) *mRepositoryMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &RepositoryMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &RepositoryMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.since = &since
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// ExpectLimitParam3 sets up expected param limit for Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) ExpectLimitParam3(limit int) *mRepositoryMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &RepositoryMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &RepositoryMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.limit = &limit
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Inspect(f func(ctx context.Context, since time.Time, limit int)) *mRepositoryMockGetTopConsumers {
+	if mmGetTopConsumers.mock.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTopConsumers")
+	}
+
+	mmGetTopConsumers.mock.inspectFuncGetTopConsumers = f
+
+	return mmGetTopConsumers
+}
+
+// Return sets up results that will be returned by Repository.GetTopConsumers
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Return(ca1 []mm_usage.Consumer, err error) *RepositoryMock {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &RepositoryMockGetTopConsumersExpectation{mock: mmGetTopConsumers.mock}
+	}
+	mmGetTopConsumers.defaultExpectation.results = &RepositoryMockGetTopConsumersResults{ca1, err}
+	mmGetTopConsumers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// Set uses given function f to mock the Repository.GetTopConsumers method
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Set(f func(ctx context.Context, since time.Time, limit int) (ca1 []mm_usage.Consumer, err error)) *RepositoryMock {
+	if mmGetTopConsumers.defaultExpectation != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Default expectation is already set for the Repository.GetTopConsumers method")
+	}
+
+	if len(mmGetTopConsumers.expectations) > 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Some expectations are already set for the Repository.GetTopConsumers method")
+	}
+
+	mmGetTopConsumers.mock.funcGetTopConsumers = f
+	mmGetTopConsumers.mock.funcGetTopConsumersOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// When sets expectation for the Repository.GetTopConsumers which will trigger the result defined by the following
+// Then helper
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) When(ctx context.Context, since time.Time, limit int) *RepositoryMockGetTopConsumersExpectation {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("RepositoryMock.GetTopConsumers mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTopConsumersExpectation{
+		mock:               mmGetTopConsumers.mock,
+		params:             &RepositoryMockGetTopConsumersParams{ctx, since, limit},
+		expectationOrigins: RepositoryMockGetTopConsumersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTopConsumers.expectations = append(mmGetTopConsumers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetTopConsumers return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTopConsumersExpectation) Then(ca1 []mm_usage.Consumer, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTopConsumersResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetTopConsumers should be invoked
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Times(n uint64) *mRepositoryMockGetTopConsumers {
+	if n == 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Times of RepositoryMock.GetTopConsumers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTopConsumers.expectedInvocations, n)
+	mmGetTopConsumers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers
+}
+
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) invocationsDone() bool {
+	if len(mmGetTopConsumers.expectations) == 0 && mmGetTopConsumers.defaultExpectation == nil && mmGetTopConsumers.mock.funcGetTopConsumers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.mock.afterGetTopConsumersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTopConsumers implements mm_usage.Repository
+func (mmGetTopConsumers *RepositoryMock) GetTopConsumers(ctx context.Context, since time.Time, limit int) (ca1 []mm_usage.Consumer, err error) {
+	mm_atomic.AddUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTopConsumers.afterGetTopConsumersCounter, 1)
+
+	mmGetTopConsumers.t.Helper()
+
+	if mmGetTopConsumers.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.inspectFuncGetTopConsumers(ctx, since, limit)
+	}
+
+	mm_params := RepositoryMockGetTopConsumersParams{ctx, since, limit}
+
+	// Record call args
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Lock()
+	mmGetTopConsumers.GetTopConsumersMock.callArgs = append(mmGetTopConsumers.GetTopConsumersMock.callArgs, &mm_params)
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Unlock()
+
+	for _, e := range mmGetTopConsumers.GetTopConsumersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetTopConsumers.GetTopConsumersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTopConsumersParams{ctx, since, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTopConsumers.t.Errorf("RepositoryMock.GetTopConsumers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmGetTopConsumers.t.Errorf("RepositoryMock.GetTopConsumers got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetTopConsumers.t.Errorf("RepositoryMock.GetTopConsumers got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTopConsumers.t.Errorf("RepositoryMock.GetTopConsumers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTopConsumers.t.Fatal("No results are set for the RepositoryMock.GetTopConsumers")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetTopConsumers.funcGetTopConsumers != nil {
+		return mmGetTopConsumers.funcGetTopConsumers(ctx, since, limit)
+	}
+	mmGetTopConsumers.t.Fatalf("Unexpected call to RepositoryMock.GetTopConsumers. %v %v %v", ctx, since, limit)
+	return
+}
+
+// GetTopConsumersAfterCounter returns a count of finished RepositoryMock.GetTopConsumers invocations
+func (mmGetTopConsumers *RepositoryMock) GetTopConsumersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.afterGetTopConsumersCounter)
+}
+
+// GetTopConsumersBeforeCounter returns a count of RepositoryMock.GetTopConsumers invocations
+func (mmGetTopConsumers *RepositoryMock) GetTopConsumersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTopConsumers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTopConsumers *mRepositoryMockGetTopConsumers) Calls() []*RepositoryMockGetTopConsumersParams {
+	mmGetTopConsumers.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTopConsumersParams, len(mmGetTopConsumers.callArgs))
+	copy(argCopy, mmGetTopConsumers.callArgs)
+
+	mmGetTopConsumers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTopConsumersDone returns true if the count of the GetTopConsumers invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTopConsumersDone() bool {
+	if m.GetTopConsumersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTopConsumersMock.invocationsDone()
+}
+
+// MinimockGetTopConsumersInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTopConsumersInspect() {
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTopConsumers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTopConsumersCounter := mm_atomic.LoadUint64(&m.afterGetTopConsumersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTopConsumersMock.defaultExpectation != nil && afterGetTopConsumersCounter < 1 {
+		if m.GetTopConsumersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTopConsumers at\n%s", m.GetTopConsumersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTopConsumers at\n%s with params: %#v", m.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *m.GetTopConsumersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTopConsumers != nil && afterGetTopConsumersCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTopConsumers at\n%s", m.funcGetTopConsumersOrigin)
+	}
+
+	if !m.GetTopConsumersMock.invocationsDone() && afterGetTopConsumersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTopConsumers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTopConsumersMock.expectedInvocations), m.GetTopConsumersMock.expectedInvocationsOrigin, afterGetTopConsumersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddUsageInspect()
+
+			m.MinimockGetTopConsumersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddUsageDone() &&
+		m.MinimockGetTopConsumersDone()
+}
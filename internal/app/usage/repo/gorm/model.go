@@ -0,0 +1,19 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type usageModel struct {
+	UserID   uuid.UUID `gorm:"primaryKey"`
+	Hour     time.Time `gorm:"primaryKey"`
+	Requests int64
+	BytesIn  int64
+	BytesOut int64
+}
+
+func (m *usageModel) TableName() string {
+	return "user_usage_hourly"
+}
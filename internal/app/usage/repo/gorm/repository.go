@@ -0,0 +1,73 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// AddUsage adds the records to the stored counters of the same user and hour.
+func (r *gormRepo) AddUsage(ctx context.Context, records []usage.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	models := lo.Map(records, func(rec usage.Record, _ int) usageModel {
+		return usageModel{
+			UserID:   rec.UserID,
+			Hour:     rec.Hour,
+			Requests: rec.Requests,
+			BytesIn:  rec.BytesIn,
+			BytesOut: rec.BytesOut,
+		}
+	})
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "hour"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"requests":  gorm.Expr("user_usage_hourly.requests + EXCLUDED.requests"),
+			"bytes_in":  gorm.Expr("user_usage_hourly.bytes_in + EXCLUDED.bytes_in"),
+			"bytes_out": gorm.Expr("user_usage_hourly.bytes_out + EXCLUDED.bytes_out"),
+		}),
+	}).Create(&models).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.AddUsage: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) GetTopConsumers(ctx context.Context, since time.Time, limit int) ([]usage.Consumer, error) {
+	consumers := make([]usage.Consumer, 0)
+
+	err := r.db.WithContext(ctx).
+		Model(&usageModel{}).
+		Select("user_id, SUM(requests) AS requests, SUM(bytes_in) AS bytes_in, SUM(bytes_out) AS bytes_out").
+		Where("hour >= ?", since).
+		Where("user_id IN (?)", r.db.Table("users").Select("id").Where(db.WorkspaceCond(ctx, "workspace_id"))).
+		Group("user_id").
+		Order("requests DESC, user_id").
+		Limit(limit).
+		Scan(&consumers).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetTopConsumers: %w", err)
+	}
+
+	return consumers, nil
+}
@@ -0,0 +1,86 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestAddUsageAndGetTopConsumers(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	u1 := createUser(t, gdb)
+	u2 := createUser(t, gdb)
+	hour := time.Now().UTC().Truncate(time.Hour)
+
+	require.NoError(t, repo.AddUsage(t.Context(), nil))
+	require.NoError(t, repo.AddUsage(t.Context(), []usage.Record{
+		{UserID: u1, Hour: hour, Requests: 2, BytesIn: 10, BytesOut: 100},
+		{UserID: u1, Hour: hour.Add(-time.Hour), Requests: 1, BytesIn: 1, BytesOut: 1},
+		{UserID: u1, Hour: hour.Add(-5 * time.Hour), Requests: 50, BytesIn: 1, BytesOut: 1},
+		{UserID: u2, Hour: hour, Requests: 3, BytesIn: 5, BytesOut: 5},
+	}))
+	// second flush of the same hour accumulates
+	require.NoError(t, repo.AddUsage(t.Context(), []usage.Record{
+		{UserID: u1, Hour: hour, Requests: 2, BytesIn: 10, BytesOut: 100},
+	}))
+
+	got, err := repo.GetTopConsumers(t.Context(), hour.Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []usage.Consumer{
+		{UserID: u1, Requests: 5, BytesIn: 21, BytesOut: 201},
+		{UserID: u2, Requests: 3, BytesIn: 5, BytesOut: 5},
+	}, got)
+
+	got, err = repo.GetTopConsumers(t.Context(), hour.Add(-time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, u1, got[0].UserID)
+
+	// pool closed error
+	cleanup()
+	_, err = repo.GetTopConsumers(t.Context(), hour, 1)
+	require.Error(t, err)
+	err = repo.AddUsage(t.Context(), []usage.Record{{UserID: u1, Hour: hour, Requests: 1}})
+	require.Error(t, err)
+}
+
+func createUser(t *testing.T, gdb *gorm.DB) uuid.UUID {
+	t.Helper()
+
+	uid := uuid.New()
+	email := uid.String() + "@example.com"
+	err := gdb.WithContext(t.Context()).Exec(
+		`INSERT INTO users(id,email,name,password_hash,created_at,updated_at,session_version)
+         VALUES ($1,$2,$3,$4,NOW(),NOW(),$5)`,
+		uid, email, "Test", "hash", 0,
+	).Error
+	require.NoError(t, err)
+
+	return uid
+}
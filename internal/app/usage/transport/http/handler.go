@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+const (
+	QueryParamHours = "hours"
+	QueryParamLimit = "limit"
+
+	defaultHours = 24
+	defaultLimit = 10
+)
+
+type Service interface {
+	GetTopConsumers(ctx context.Context, req usage.GetTopConsumersReq) ([]usage.Consumer, error)
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("usage HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// GetTopConsumers godoc
+// @Summary      Top API consumers
+// @Description  Returns users with the most requests over the last hours, with bytes received and sent.
+// @Description  Usage is aggregated per hour and flushed periodically, so the latest requests may be missing. Requires admin role.
+// @Tags         admin
+// @Security     BearerAuth
+// @Produce      json
+// @Param        hours query int false "Period in hours, counting the current one" default(24)
+// @Param        limit query int false "Maximum number of users" default(10)
+// @Success      200 {array} usage.Consumer
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /usage [get]
+func (h *Handler) GetTopConsumers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := usage.GetTopConsumersReq{Hours: defaultHours, Limit: defaultLimit}
+	var err error
+	if v := r.URL.Query().Get(QueryParamHours); v != "" {
+		if req.Hours, err = strconv.Atoi(v); err != nil {
+			logger.Error(ctx, err).Str(QueryParamHours, v).
+				Msg("usage.Handler.GetTopConsumers: invalid hours")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Error(ctx, err).Str(QueryParamLimit, v).
+				Msg("usage.Handler.GetTopConsumers: invalid limit")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+
+	consumers, err := h.svc.GetTopConsumers(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, consumers)
+}
@@ -0,0 +1,138 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	usage_http "github.com/66gu1/easygodocs/internal/app/usage/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/usage/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_GetTopConsumers(t *testing.T) {
+	t.Parallel()
+
+	consumers := []usage.Consumer{{UserID: uuid.New(), Requests: 3, BytesIn: 1, BytesOut: 2}}
+
+	tests := []struct {
+		name       string
+		query      string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "ok, defaults",
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetTopConsumersMock.Expect(minimock.AnyContext, usage.GetTopConsumersReq{Hours: 24, Limit: 10}).
+					Return(consumers, nil)
+			},
+		},
+		{
+			name:       "ok, explicit",
+			query:      "?hours=2&limit=1",
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetTopConsumersMock.Expect(minimock.AnyContext, usage.GetTopConsumersReq{Hours: 2, Limit: 1}).
+					Return(consumers, nil)
+			},
+		},
+		{
+			name:       "invalid hours -> 400",
+			query:      "?hours=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid limit -> 400",
+			query:      "?limit=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden -> 403",
+			wantStatus: http.StatusForbidden,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetTopConsumersMock.Return(nil, apperr.ErrForbidden())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+			h := usage_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodGet, "/usage"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.GetTopConsumers(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got []usage.Consumer
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+			require.Equal(t, consumers, got)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	t.Run("tracks authenticated requests", func(t *testing.T) {
+		t.Parallel()
+		tracker := mocks.NewTrackerMock(t)
+		tracker.AllowMock.Expect(userID).Return(nil)
+		tracker.TrackMock.Expect(userID, 4, 5).Return()
+
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("body"))
+		r = r.WithContext(contextx.SetUserID(r.Context(), userID))
+		w := httptest.NewRecorder()
+		usage_http.Middleware(tracker)(next).ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("quota exceeded -> 429", func(t *testing.T) {
+		t.Parallel()
+		tracker := mocks.NewTrackerMock(t)
+		tracker.AllowMock.Expect(userID).Return(usage.ErrQuotaExceeded(1))
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(contextx.SetUserID(r.Context(), userID))
+		w := httptest.NewRecorder()
+		usage_http.Middleware(tracker)(next).ServeHTTP(w, r)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("anonymous requests pass through", func(t *testing.T) {
+		t.Parallel()
+		tracker := mocks.NewTrackerMock(t)
+
+		w := httptest.NewRecorder()
+		usage_http.Middleware(tracker)(next).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, "hello", w.Body.String())
+	})
+}
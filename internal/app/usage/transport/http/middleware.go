@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Tracker interface {
+	Allow(userID uuid.UUID) error
+	Track(userID uuid.UUID, bytesIn, bytesOut int64)
+}
+
+// Middleware records request count and bytes per authenticated user and rejects
+// requests over the hourly quota with 429. It must run after AuthMiddleware.
+// Rejected requests are not counted.
+func Middleware(tracker Tracker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID, err := contextx.GetUserID(ctx)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err = tracker.Allow(userID); err != nil {
+				logger.Error(ctx, err).
+					Str("user_id", userID.String()).
+					Msg("usage.Middleware: quota exceeded")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			cw := &countingWriter{ResponseWriter: w}
+			next.ServeHTTP(cw, r)
+
+			tracker.Track(userID, max(r.ContentLength, 0), cw.written)
+		})
+	}
+}
+
+type countingWriter struct {
+	http.ResponseWriter
+	written int64
+}
+
+func (w *countingWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.written += int64(n)
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer,
+// which WebSocket upgrades and flushing rely on.
+func (w *countingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
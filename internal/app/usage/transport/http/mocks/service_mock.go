@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/usage/transport/http.Service -o service_mock.go -n ServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ServiceMock implements mm_http.Service
+type ServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetTopConsumers          func(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error)
+	funcGetTopConsumersOrigin    string
+	inspectFuncGetTopConsumers   func(ctx context.Context, req usage.GetTopConsumersReq)
+	afterGetTopConsumersCounter  uint64
+	beforeGetTopConsumersCounter uint64
+	GetTopConsumersMock          mServiceMockGetTopConsumers
+}
+
+// NewServiceMock returns a mock for mm_http.Service
+func NewServiceMock(t minimock.Tester) *ServiceMock {
+	m := &ServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetTopConsumersMock = mServiceMockGetTopConsumers{mock: m}
+	m.GetTopConsumersMock.callArgs = []*ServiceMockGetTopConsumersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mServiceMockGetTopConsumers struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetTopConsumersExpectation
+	expectations       []*ServiceMockGetTopConsumersExpectation
+
+	callArgs []*ServiceMockGetTopConsumersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetTopConsumersExpectation specifies expectation struct of the Service.GetTopConsumers
+type ServiceMockGetTopConsumersExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetTopConsumersParams
+	paramPtrs          *ServiceMockGetTopConsumersParamPtrs
+	expectationOrigins ServiceMockGetTopConsumersExpectationOrigins
+	results            *ServiceMockGetTopConsumersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetTopConsumersParams contains parameters of the Service.GetTopConsumers
+type ServiceMockGetTopConsumersParams struct {
+	ctx context.Context
+	req usage.GetTopConsumersReq
+}
+
+// ServiceMockGetTopConsumersParamPtrs contains pointers to parameters of the Service.GetTopConsumers
+type ServiceMockGetTopConsumersParamPtrs struct {
+	ctx *context.Context
+	req *usage.GetTopConsumersReq
+}
+
+// ServiceMockGetTopConsumersResults contains results of the Service.GetTopConsumers
+type ServiceMockGetTopConsumersResults struct {
+	ca1 []usage.Consumer
+	err error
+}
+
+// ServiceMockGetTopConsumersOrigins contains origins of expectations of the Service.GetTopConsumers
+type ServiceMockGetTopConsumersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Optional() *mServiceMockGetTopConsumers {
+	mmGetTopConsumers.optional = true
+	return mmGetTopConsumers
+}
+
+// Expect sets up expected params for Service.GetTopConsumers
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Expect(ctx context.Context, req usage.GetTopConsumersReq) *mServiceMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &ServiceMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by ExpectParams functions")
+	}
+
+	mmGetTopConsumers.defaultExpectation.params = &ServiceMockGetTopConsumersParams{ctx, req}
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTopConsumers.expectations {
+		if minimock.Equal(e.params, mmGetTopConsumers.defaultExpectation.params) {
+			mmGetTopConsumers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTopConsumers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTopConsumers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetTopConsumers
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) ExpectCtxParam1(ctx context.Context) *mServiceMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &ServiceMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &ServiceMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// ExpectReqParam2 sets up expected param req for Service.GetTopConsumers
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) ExpectReqParam2(req usage.GetTopConsumersReq) *mServiceMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &ServiceMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &ServiceMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.req = &req
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetTopConsumers
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Inspect(f func(ctx context.Context, req usage.GetTopConsumersReq)) *mServiceMockGetTopConsumers {
+	if mmGetTopConsumers.mock.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetTopConsumers")
+	}
+
+	mmGetTopConsumers.mock.inspectFuncGetTopConsumers = f
+
+	return mmGetTopConsumers
+}
+
+// Return sets up results that will be returned by Service.GetTopConsumers
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Return(ca1 []usage.Consumer, err error) *ServiceMock {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &ServiceMockGetTopConsumersExpectation{mock: mmGetTopConsumers.mock}
+	}
+	mmGetTopConsumers.defaultExpectation.results = &ServiceMockGetTopConsumersResults{ca1, err}
+	mmGetTopConsumers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// Set uses given function f to mock the Service.GetTopConsumers method
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Set(f func(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error)) *ServiceMock {
+	if mmGetTopConsumers.defaultExpectation != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Default expectation is already set for the Service.GetTopConsumers method")
+	}
+
+	if len(mmGetTopConsumers.expectations) > 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Some expectations are already set for the Service.GetTopConsumers method")
+	}
+
+	mmGetTopConsumers.mock.funcGetTopConsumers = f
+	mmGetTopConsumers.mock.funcGetTopConsumersOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// When sets expectation for the Service.GetTopConsumers which will trigger the result defined by the following
+// Then helper
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) When(ctx context.Context, req usage.GetTopConsumersReq) *ServiceMockGetTopConsumersExpectation {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("ServiceMock.GetTopConsumers mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetTopConsumersExpectation{
+		mock:               mmGetTopConsumers.mock,
+		params:             &ServiceMockGetTopConsumersParams{ctx, req},
+		expectationOrigins: ServiceMockGetTopConsumersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTopConsumers.expectations = append(mmGetTopConsumers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetTopConsumers return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetTopConsumersExpectation) Then(ca1 []usage.Consumer, err error) *ServiceMock {
+	e.results = &ServiceMockGetTopConsumersResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetTopConsumers should be invoked
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Times(n uint64) *mServiceMockGetTopConsumers {
+	if n == 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Times of ServiceMock.GetTopConsumers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTopConsumers.expectedInvocations, n)
+	mmGetTopConsumers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers
+}
+
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) invocationsDone() bool {
+	if len(mmGetTopConsumers.expectations) == 0 && mmGetTopConsumers.defaultExpectation == nil && mmGetTopConsumers.mock.funcGetTopConsumers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.mock.afterGetTopConsumersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTopConsumers implements mm_http.Service
+func (mmGetTopConsumers *ServiceMock) GetTopConsumers(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error) {
+	mm_atomic.AddUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTopConsumers.afterGetTopConsumersCounter, 1)
+
+	mmGetTopConsumers.t.Helper()
+
+	if mmGetTopConsumers.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.inspectFuncGetTopConsumers(ctx, req)
+	}
+
+	mm_params := ServiceMockGetTopConsumersParams{ctx, req}
+
+	// Record call args
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Lock()
+	mmGetTopConsumers.GetTopConsumersMock.callArgs = append(mmGetTopConsumers.GetTopConsumersMock.callArgs, &mm_params)
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Unlock()
+
+	for _, e := range mmGetTopConsumers.GetTopConsumersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetTopConsumers.GetTopConsumersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetTopConsumersParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTopConsumers.t.Errorf("ServiceMock.GetTopConsumers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetTopConsumers.t.Errorf("ServiceMock.GetTopConsumers got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTopConsumers.t.Errorf("ServiceMock.GetTopConsumers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTopConsumers.t.Fatal("No results are set for the ServiceMock.GetTopConsumers")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetTopConsumers.funcGetTopConsumers != nil {
+		return mmGetTopConsumers.funcGetTopConsumers(ctx, req)
+	}
+	mmGetTopConsumers.t.Fatalf("Unexpected call to ServiceMock.GetTopConsumers. %v %v", ctx, req)
+	return
+}
+
+// GetTopConsumersAfterCounter returns a count of finished ServiceMock.GetTopConsumers invocations
+func (mmGetTopConsumers *ServiceMock) GetTopConsumersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.afterGetTopConsumersCounter)
+}
+
+// GetTopConsumersBeforeCounter returns a count of ServiceMock.GetTopConsumers invocations
+func (mmGetTopConsumers *ServiceMock) GetTopConsumersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetTopConsumers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTopConsumers *mServiceMockGetTopConsumers) Calls() []*ServiceMockGetTopConsumersParams {
+	mmGetTopConsumers.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetTopConsumersParams, len(mmGetTopConsumers.callArgs))
+	copy(argCopy, mmGetTopConsumers.callArgs)
+
+	mmGetTopConsumers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTopConsumersDone returns true if the count of the GetTopConsumers invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetTopConsumersDone() bool {
+	if m.GetTopConsumersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTopConsumersMock.invocationsDone()
+}
+
+// MinimockGetTopConsumersInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetTopConsumersInspect() {
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetTopConsumers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTopConsumersCounter := mm_atomic.LoadUint64(&m.afterGetTopConsumersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTopConsumersMock.defaultExpectation != nil && afterGetTopConsumersCounter < 1 {
+		if m.GetTopConsumersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetTopConsumers at\n%s", m.GetTopConsumersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetTopConsumers at\n%s with params: %#v", m.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *m.GetTopConsumersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTopConsumers != nil && afterGetTopConsumersCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetTopConsumers at\n%s", m.funcGetTopConsumersOrigin)
+	}
+
+	if !m.GetTopConsumersMock.invocationsDone() && afterGetTopConsumersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetTopConsumers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTopConsumersMock.expectedInvocations), m.GetTopConsumersMock.expectedInvocationsOrigin, afterGetTopConsumersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetTopConsumersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetTopConsumersDone()
+}
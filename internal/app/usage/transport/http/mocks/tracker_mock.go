@@ -0,0 +1,764 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/usage/transport/http.Tracker -o tracker_mock.go -n TrackerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// TrackerMock implements mm_http.Tracker
+type TrackerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAllow          func(userID uuid.UUID) (err error)
+	funcAllowOrigin    string
+	inspectFuncAllow   func(userID uuid.UUID)
+	afterAllowCounter  uint64
+	beforeAllowCounter uint64
+	AllowMock          mTrackerMockAllow
+
+	funcTrack          func(userID uuid.UUID, bytesIn int64, bytesOut int64)
+	funcTrackOrigin    string
+	inspectFuncTrack   func(userID uuid.UUID, bytesIn int64, bytesOut int64)
+	afterTrackCounter  uint64
+	beforeTrackCounter uint64
+	TrackMock          mTrackerMockTrack
+}
+
+// NewTrackerMock returns a mock for mm_http.Tracker
+func NewTrackerMock(t minimock.Tester) *TrackerMock {
+	m := &TrackerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AllowMock = mTrackerMockAllow{mock: m}
+	m.AllowMock.callArgs = []*TrackerMockAllowParams{}
+
+	m.TrackMock = mTrackerMockTrack{mock: m}
+	m.TrackMock.callArgs = []*TrackerMockTrackParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTrackerMockAllow struct {
+	optional           bool
+	mock               *TrackerMock
+	defaultExpectation *TrackerMockAllowExpectation
+	expectations       []*TrackerMockAllowExpectation
+
+	callArgs []*TrackerMockAllowParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TrackerMockAllowExpectation specifies expectation struct of the Tracker.Allow
+type TrackerMockAllowExpectation struct {
+	mock               *TrackerMock
+	params             *TrackerMockAllowParams
+	paramPtrs          *TrackerMockAllowParamPtrs
+	expectationOrigins TrackerMockAllowExpectationOrigins
+	results            *TrackerMockAllowResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TrackerMockAllowParams contains parameters of the Tracker.Allow
+type TrackerMockAllowParams struct {
+	userID uuid.UUID
+}
+
+// TrackerMockAllowParamPtrs contains pointers to parameters of the Tracker.Allow
+type TrackerMockAllowParamPtrs struct {
+	userID *uuid.UUID
+}
+
+// TrackerMockAllowResults contains results of the Tracker.Allow
+type TrackerMockAllowResults struct {
+	err error
+}
+
+// TrackerMockAllowOrigins contains origins of expectations of the Tracker.Allow
+type TrackerMockAllowExpectationOrigins struct {
+	origin       string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAllow *mTrackerMockAllow) Optional() *mTrackerMockAllow {
+	mmAllow.optional = true
+	return mmAllow
+}
+
+// Expect sets up expected params for Tracker.Allow
+func (mmAllow *mTrackerMockAllow) Expect(userID uuid.UUID) *mTrackerMockAllow {
+	if mmAllow.mock.funcAllow != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by Set")
+	}
+
+	if mmAllow.defaultExpectation == nil {
+		mmAllow.defaultExpectation = &TrackerMockAllowExpectation{}
+	}
+
+	if mmAllow.defaultExpectation.paramPtrs != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by ExpectParams functions")
+	}
+
+	mmAllow.defaultExpectation.params = &TrackerMockAllowParams{userID}
+	mmAllow.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAllow.expectations {
+		if minimock.Equal(e.params, mmAllow.defaultExpectation.params) {
+			mmAllow.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAllow.defaultExpectation.params)
+		}
+	}
+
+	return mmAllow
+}
+
+// ExpectUserIDParam1 sets up expected param userID for Tracker.Allow
+func (mmAllow *mTrackerMockAllow) ExpectUserIDParam1(userID uuid.UUID) *mTrackerMockAllow {
+	if mmAllow.mock.funcAllow != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by Set")
+	}
+
+	if mmAllow.defaultExpectation == nil {
+		mmAllow.defaultExpectation = &TrackerMockAllowExpectation{}
+	}
+
+	if mmAllow.defaultExpectation.params != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by Expect")
+	}
+
+	if mmAllow.defaultExpectation.paramPtrs == nil {
+		mmAllow.defaultExpectation.paramPtrs = &TrackerMockAllowParamPtrs{}
+	}
+	mmAllow.defaultExpectation.paramPtrs.userID = &userID
+	mmAllow.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAllow
+}
+
+// Inspect accepts an inspector function that has same arguments as the Tracker.Allow
+func (mmAllow *mTrackerMockAllow) Inspect(f func(userID uuid.UUID)) *mTrackerMockAllow {
+	if mmAllow.mock.inspectFuncAllow != nil {
+		mmAllow.mock.t.Fatalf("Inspect function is already set for TrackerMock.Allow")
+	}
+
+	mmAllow.mock.inspectFuncAllow = f
+
+	return mmAllow
+}
+
+// Return sets up results that will be returned by Tracker.Allow
+func (mmAllow *mTrackerMockAllow) Return(err error) *TrackerMock {
+	if mmAllow.mock.funcAllow != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by Set")
+	}
+
+	if mmAllow.defaultExpectation == nil {
+		mmAllow.defaultExpectation = &TrackerMockAllowExpectation{mock: mmAllow.mock}
+	}
+	mmAllow.defaultExpectation.results = &TrackerMockAllowResults{err}
+	mmAllow.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAllow.mock
+}
+
+// Set uses given function f to mock the Tracker.Allow method
+func (mmAllow *mTrackerMockAllow) Set(f func(userID uuid.UUID) (err error)) *TrackerMock {
+	if mmAllow.defaultExpectation != nil {
+		mmAllow.mock.t.Fatalf("Default expectation is already set for the Tracker.Allow method")
+	}
+
+	if len(mmAllow.expectations) > 0 {
+		mmAllow.mock.t.Fatalf("Some expectations are already set for the Tracker.Allow method")
+	}
+
+	mmAllow.mock.funcAllow = f
+	mmAllow.mock.funcAllowOrigin = minimock.CallerInfo(1)
+	return mmAllow.mock
+}
+
+// When sets expectation for the Tracker.Allow which will trigger the result defined by the following
+// Then helper
+func (mmAllow *mTrackerMockAllow) When(userID uuid.UUID) *TrackerMockAllowExpectation {
+	if mmAllow.mock.funcAllow != nil {
+		mmAllow.mock.t.Fatalf("TrackerMock.Allow mock is already set by Set")
+	}
+
+	expectation := &TrackerMockAllowExpectation{
+		mock:               mmAllow.mock,
+		params:             &TrackerMockAllowParams{userID},
+		expectationOrigins: TrackerMockAllowExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAllow.expectations = append(mmAllow.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Tracker.Allow return parameters for the expectation previously defined by the When method
+func (e *TrackerMockAllowExpectation) Then(err error) *TrackerMock {
+	e.results = &TrackerMockAllowResults{err}
+	return e.mock
+}
+
+// Times sets number of times Tracker.Allow should be invoked
+func (mmAllow *mTrackerMockAllow) Times(n uint64) *mTrackerMockAllow {
+	if n == 0 {
+		mmAllow.mock.t.Fatalf("Times of TrackerMock.Allow mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAllow.expectedInvocations, n)
+	mmAllow.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAllow
+}
+
+func (mmAllow *mTrackerMockAllow) invocationsDone() bool {
+	if len(mmAllow.expectations) == 0 && mmAllow.defaultExpectation == nil && mmAllow.mock.funcAllow == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAllow.mock.afterAllowCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAllow.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Allow implements mm_http.Tracker
+func (mmAllow *TrackerMock) Allow(userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmAllow.beforeAllowCounter, 1)
+	defer mm_atomic.AddUint64(&mmAllow.afterAllowCounter, 1)
+
+	mmAllow.t.Helper()
+
+	if mmAllow.inspectFuncAllow != nil {
+		mmAllow.inspectFuncAllow(userID)
+	}
+
+	mm_params := TrackerMockAllowParams{userID}
+
+	// Record call args
+	mmAllow.AllowMock.mutex.Lock()
+	mmAllow.AllowMock.callArgs = append(mmAllow.AllowMock.callArgs, &mm_params)
+	mmAllow.AllowMock.mutex.Unlock()
+
+	for _, e := range mmAllow.AllowMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAllow.AllowMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAllow.AllowMock.defaultExpectation.Counter, 1)
+		mm_want := mmAllow.AllowMock.defaultExpectation.params
+		mm_want_ptrs := mmAllow.AllowMock.defaultExpectation.paramPtrs
+
+		mm_got := TrackerMockAllowParams{userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAllow.t.Errorf("TrackerMock.Allow got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAllow.AllowMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAllow.t.Errorf("TrackerMock.Allow got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAllow.AllowMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAllow.AllowMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAllow.t.Fatal("No results are set for the TrackerMock.Allow")
+		}
+		return (*mm_results).err
+	}
+	if mmAllow.funcAllow != nil {
+		return mmAllow.funcAllow(userID)
+	}
+	mmAllow.t.Fatalf("Unexpected call to TrackerMock.Allow. %v", userID)
+	return
+}
+
+// AllowAfterCounter returns a count of finished TrackerMock.Allow invocations
+func (mmAllow *TrackerMock) AllowAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAllow.afterAllowCounter)
+}
+
+// AllowBeforeCounter returns a count of TrackerMock.Allow invocations
+func (mmAllow *TrackerMock) AllowBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAllow.beforeAllowCounter)
+}
+
+// Calls returns a list of arguments used in each call to TrackerMock.Allow.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAllow *mTrackerMockAllow) Calls() []*TrackerMockAllowParams {
+	mmAllow.mutex.RLock()
+
+	argCopy := make([]*TrackerMockAllowParams, len(mmAllow.callArgs))
+	copy(argCopy, mmAllow.callArgs)
+
+	mmAllow.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAllowDone returns true if the count of the Allow invocations corresponds
+// the number of defined expectations
+func (m *TrackerMock) MinimockAllowDone() bool {
+	if m.AllowMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AllowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AllowMock.invocationsDone()
+}
+
+// MinimockAllowInspect logs each unmet expectation
+func (m *TrackerMock) MinimockAllowInspect() {
+	for _, e := range m.AllowMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TrackerMock.Allow at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAllowCounter := mm_atomic.LoadUint64(&m.afterAllowCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AllowMock.defaultExpectation != nil && afterAllowCounter < 1 {
+		if m.AllowMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TrackerMock.Allow at\n%s", m.AllowMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TrackerMock.Allow at\n%s with params: %#v", m.AllowMock.defaultExpectation.expectationOrigins.origin, *m.AllowMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAllow != nil && afterAllowCounter < 1 {
+		m.t.Errorf("Expected call to TrackerMock.Allow at\n%s", m.funcAllowOrigin)
+	}
+
+	if !m.AllowMock.invocationsDone() && afterAllowCounter > 0 {
+		m.t.Errorf("Expected %d calls to TrackerMock.Allow at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AllowMock.expectedInvocations), m.AllowMock.expectedInvocationsOrigin, afterAllowCounter)
+	}
+}
+
+type mTrackerMockTrack struct {
+	optional           bool
+	mock               *TrackerMock
+	defaultExpectation *TrackerMockTrackExpectation
+	expectations       []*TrackerMockTrackExpectation
+
+	callArgs []*TrackerMockTrackParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TrackerMockTrackExpectation specifies expectation struct of the Tracker.Track
+type TrackerMockTrackExpectation struct {
+	mock               *TrackerMock
+	params             *TrackerMockTrackParams
+	paramPtrs          *TrackerMockTrackParamPtrs
+	expectationOrigins TrackerMockTrackExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// TrackerMockTrackParams contains parameters of the Tracker.Track
+type TrackerMockTrackParams struct {
+	userID   uuid.UUID
+	bytesIn  int64
+	bytesOut int64
+}
+
+// TrackerMockTrackParamPtrs contains pointers to parameters of the Tracker.Track
+type TrackerMockTrackParamPtrs struct {
+	userID   *uuid.UUID
+	bytesIn  *int64
+	bytesOut *int64
+}
+
+// TrackerMockTrackOrigins contains origins of expectations of the Tracker.Track
+type TrackerMockTrackExpectationOrigins struct {
+	origin         string
+	originUserID   string
+	originBytesIn  string
+	originBytesOut string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTrack *mTrackerMockTrack) Optional() *mTrackerMockTrack {
+	mmTrack.optional = true
+	return mmTrack
+}
+
+// Expect sets up expected params for Tracker.Track
+func (mmTrack *mTrackerMockTrack) Expect(userID uuid.UUID, bytesIn int64, bytesOut int64) *mTrackerMockTrack {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	if mmTrack.defaultExpectation == nil {
+		mmTrack.defaultExpectation = &TrackerMockTrackExpectation{}
+	}
+
+	if mmTrack.defaultExpectation.paramPtrs != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by ExpectParams functions")
+	}
+
+	mmTrack.defaultExpectation.params = &TrackerMockTrackParams{userID, bytesIn, bytesOut}
+	mmTrack.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTrack.expectations {
+		if minimock.Equal(e.params, mmTrack.defaultExpectation.params) {
+			mmTrack.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTrack.defaultExpectation.params)
+		}
+	}
+
+	return mmTrack
+}
+
+// ExpectUserIDParam1 sets up expected param userID for Tracker.Track
+func (mmTrack *mTrackerMockTrack) ExpectUserIDParam1(userID uuid.UUID) *mTrackerMockTrack {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	if mmTrack.defaultExpectation == nil {
+		mmTrack.defaultExpectation = &TrackerMockTrackExpectation{}
+	}
+
+	if mmTrack.defaultExpectation.params != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Expect")
+	}
+
+	if mmTrack.defaultExpectation.paramPtrs == nil {
+		mmTrack.defaultExpectation.paramPtrs = &TrackerMockTrackParamPtrs{}
+	}
+	mmTrack.defaultExpectation.paramPtrs.userID = &userID
+	mmTrack.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmTrack
+}
+
+// ExpectBytesInParam2 sets up expected param bytesIn for Tracker.Track
+func (mmTrack *mTrackerMockTrack) ExpectBytesInParam2(bytesIn int64) *mTrackerMockTrack {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	if mmTrack.defaultExpectation == nil {
+		mmTrack.defaultExpectation = &TrackerMockTrackExpectation{}
+	}
+
+	if mmTrack.defaultExpectation.params != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Expect")
+	}
+
+	if mmTrack.defaultExpectation.paramPtrs == nil {
+		mmTrack.defaultExpectation.paramPtrs = &TrackerMockTrackParamPtrs{}
+	}
+	mmTrack.defaultExpectation.paramPtrs.bytesIn = &bytesIn
+	mmTrack.defaultExpectation.expectationOrigins.originBytesIn = minimock.CallerInfo(1)
+
+	return mmTrack
+}
+
+// ExpectBytesOutParam3 sets up expected param bytesOut for Tracker.Track
+func (mmTrack *mTrackerMockTrack) ExpectBytesOutParam3(bytesOut int64) *mTrackerMockTrack {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	if mmTrack.defaultExpectation == nil {
+		mmTrack.defaultExpectation = &TrackerMockTrackExpectation{}
+	}
+
+	if mmTrack.defaultExpectation.params != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Expect")
+	}
+
+	if mmTrack.defaultExpectation.paramPtrs == nil {
+		mmTrack.defaultExpectation.paramPtrs = &TrackerMockTrackParamPtrs{}
+	}
+	mmTrack.defaultExpectation.paramPtrs.bytesOut = &bytesOut
+	mmTrack.defaultExpectation.expectationOrigins.originBytesOut = minimock.CallerInfo(1)
+
+	return mmTrack
+}
+
+// Inspect accepts an inspector function that has same arguments as the Tracker.Track
+func (mmTrack *mTrackerMockTrack) Inspect(f func(userID uuid.UUID, bytesIn int64, bytesOut int64)) *mTrackerMockTrack {
+	if mmTrack.mock.inspectFuncTrack != nil {
+		mmTrack.mock.t.Fatalf("Inspect function is already set for TrackerMock.Track")
+	}
+
+	mmTrack.mock.inspectFuncTrack = f
+
+	return mmTrack
+}
+
+// Return sets up results that will be returned by Tracker.Track
+func (mmTrack *mTrackerMockTrack) Return() *TrackerMock {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	if mmTrack.defaultExpectation == nil {
+		mmTrack.defaultExpectation = &TrackerMockTrackExpectation{mock: mmTrack.mock}
+	}
+
+	mmTrack.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTrack.mock
+}
+
+// Set uses given function f to mock the Tracker.Track method
+func (mmTrack *mTrackerMockTrack) Set(f func(userID uuid.UUID, bytesIn int64, bytesOut int64)) *TrackerMock {
+	if mmTrack.defaultExpectation != nil {
+		mmTrack.mock.t.Fatalf("Default expectation is already set for the Tracker.Track method")
+	}
+
+	if len(mmTrack.expectations) > 0 {
+		mmTrack.mock.t.Fatalf("Some expectations are already set for the Tracker.Track method")
+	}
+
+	mmTrack.mock.funcTrack = f
+	mmTrack.mock.funcTrackOrigin = minimock.CallerInfo(1)
+	return mmTrack.mock
+}
+
+// When sets expectation for the Tracker.Track which will trigger the result defined by the following
+// Then helper
+func (mmTrack *mTrackerMockTrack) When(userID uuid.UUID, bytesIn int64, bytesOut int64) *TrackerMockTrackExpectation {
+	if mmTrack.mock.funcTrack != nil {
+		mmTrack.mock.t.Fatalf("TrackerMock.Track mock is already set by Set")
+	}
+
+	expectation := &TrackerMockTrackExpectation{
+		mock:               mmTrack.mock,
+		params:             &TrackerMockTrackParams{userID, bytesIn, bytesOut},
+		expectationOrigins: TrackerMockTrackExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTrack.expectations = append(mmTrack.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Tracker.Track return parameters for the expectation previously defined by the When method
+
+func (e *TrackerMockTrackExpectation) Then() *TrackerMock {
+	return e.mock
+}
+
+// Times sets number of times Tracker.Track should be invoked
+func (mmTrack *mTrackerMockTrack) Times(n uint64) *mTrackerMockTrack {
+	if n == 0 {
+		mmTrack.mock.t.Fatalf("Times of TrackerMock.Track mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTrack.expectedInvocations, n)
+	mmTrack.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTrack
+}
+
+func (mmTrack *mTrackerMockTrack) invocationsDone() bool {
+	if len(mmTrack.expectations) == 0 && mmTrack.defaultExpectation == nil && mmTrack.mock.funcTrack == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTrack.mock.afterTrackCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTrack.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Track implements mm_http.Tracker
+func (mmTrack *TrackerMock) Track(userID uuid.UUID, bytesIn int64, bytesOut int64) {
+	mm_atomic.AddUint64(&mmTrack.beforeTrackCounter, 1)
+	defer mm_atomic.AddUint64(&mmTrack.afterTrackCounter, 1)
+
+	mmTrack.t.Helper()
+
+	if mmTrack.inspectFuncTrack != nil {
+		mmTrack.inspectFuncTrack(userID, bytesIn, bytesOut)
+	}
+
+	mm_params := TrackerMockTrackParams{userID, bytesIn, bytesOut}
+
+	// Record call args
+	mmTrack.TrackMock.mutex.Lock()
+	mmTrack.TrackMock.callArgs = append(mmTrack.TrackMock.callArgs, &mm_params)
+	mmTrack.TrackMock.mutex.Unlock()
+
+	for _, e := range mmTrack.TrackMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmTrack.TrackMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTrack.TrackMock.defaultExpectation.Counter, 1)
+		mm_want := mmTrack.TrackMock.defaultExpectation.params
+		mm_want_ptrs := mmTrack.TrackMock.defaultExpectation.paramPtrs
+
+		mm_got := TrackerMockTrackParams{userID, bytesIn, bytesOut}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmTrack.t.Errorf("TrackerMock.Track got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTrack.TrackMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.bytesIn != nil && !minimock.Equal(*mm_want_ptrs.bytesIn, mm_got.bytesIn) {
+				mmTrack.t.Errorf("TrackerMock.Track got unexpected parameter bytesIn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTrack.TrackMock.defaultExpectation.expectationOrigins.originBytesIn, *mm_want_ptrs.bytesIn, mm_got.bytesIn, minimock.Diff(*mm_want_ptrs.bytesIn, mm_got.bytesIn))
+			}
+
+			if mm_want_ptrs.bytesOut != nil && !minimock.Equal(*mm_want_ptrs.bytesOut, mm_got.bytesOut) {
+				mmTrack.t.Errorf("TrackerMock.Track got unexpected parameter bytesOut, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTrack.TrackMock.defaultExpectation.expectationOrigins.originBytesOut, *mm_want_ptrs.bytesOut, mm_got.bytesOut, minimock.Diff(*mm_want_ptrs.bytesOut, mm_got.bytesOut))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTrack.t.Errorf("TrackerMock.Track got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTrack.TrackMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmTrack.funcTrack != nil {
+		mmTrack.funcTrack(userID, bytesIn, bytesOut)
+		return
+	}
+	mmTrack.t.Fatalf("Unexpected call to TrackerMock.Track. %v %v %v", userID, bytesIn, bytesOut)
+
+}
+
+// TrackAfterCounter returns a count of finished TrackerMock.Track invocations
+func (mmTrack *TrackerMock) TrackAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTrack.afterTrackCounter)
+}
+
+// TrackBeforeCounter returns a count of TrackerMock.Track invocations
+func (mmTrack *TrackerMock) TrackBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTrack.beforeTrackCounter)
+}
+
+// Calls returns a list of arguments used in each call to TrackerMock.Track.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTrack *mTrackerMockTrack) Calls() []*TrackerMockTrackParams {
+	mmTrack.mutex.RLock()
+
+	argCopy := make([]*TrackerMockTrackParams, len(mmTrack.callArgs))
+	copy(argCopy, mmTrack.callArgs)
+
+	mmTrack.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTrackDone returns true if the count of the Track invocations corresponds
+// the number of defined expectations
+func (m *TrackerMock) MinimockTrackDone() bool {
+	if m.TrackMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TrackMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TrackMock.invocationsDone()
+}
+
+// MinimockTrackInspect logs each unmet expectation
+func (m *TrackerMock) MinimockTrackInspect() {
+	for _, e := range m.TrackMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TrackerMock.Track at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTrackCounter := mm_atomic.LoadUint64(&m.afterTrackCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TrackMock.defaultExpectation != nil && afterTrackCounter < 1 {
+		if m.TrackMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TrackerMock.Track at\n%s", m.TrackMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TrackerMock.Track at\n%s with params: %#v", m.TrackMock.defaultExpectation.expectationOrigins.origin, *m.TrackMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTrack != nil && afterTrackCounter < 1 {
+		m.t.Errorf("Expected call to TrackerMock.Track at\n%s", m.funcTrackOrigin)
+	}
+
+	if !m.TrackMock.invocationsDone() && afterTrackCounter > 0 {
+		m.t.Errorf("Expected %d calls to TrackerMock.Track at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TrackMock.expectedInvocations), m.TrackMock.expectedInvocationsOrigin, afterTrackCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TrackerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAllowInspect()
+
+			m.MinimockTrackInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TrackerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TrackerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAllowDone() &&
+		m.MinimockTrackDone()
+}
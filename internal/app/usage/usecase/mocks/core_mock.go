@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/usage/usecase.Core -o core_mock.go -n CoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CoreMock implements mm_usecase.Core
+type CoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetTopConsumers          func(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error)
+	funcGetTopConsumersOrigin    string
+	inspectFuncGetTopConsumers   func(ctx context.Context, req usage.GetTopConsumersReq)
+	afterGetTopConsumersCounter  uint64
+	beforeGetTopConsumersCounter uint64
+	GetTopConsumersMock          mCoreMockGetTopConsumers
+}
+
+// NewCoreMock returns a mock for mm_usecase.Core
+func NewCoreMock(t minimock.Tester) *CoreMock {
+	m := &CoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetTopConsumersMock = mCoreMockGetTopConsumers{mock: m}
+	m.GetTopConsumersMock.callArgs = []*CoreMockGetTopConsumersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCoreMockGetTopConsumers struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetTopConsumersExpectation
+	expectations       []*CoreMockGetTopConsumersExpectation
+
+	callArgs []*CoreMockGetTopConsumersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetTopConsumersExpectation specifies expectation struct of the Core.GetTopConsumers
+type CoreMockGetTopConsumersExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetTopConsumersParams
+	paramPtrs          *CoreMockGetTopConsumersParamPtrs
+	expectationOrigins CoreMockGetTopConsumersExpectationOrigins
+	results            *CoreMockGetTopConsumersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetTopConsumersParams contains parameters of the Core.GetTopConsumers
+type CoreMockGetTopConsumersParams struct {
+	ctx context.Context
+	req usage.GetTopConsumersReq
+}
+
+// CoreMockGetTopConsumersParamPtrs contains pointers to parameters of the Core.GetTopConsumers
+type CoreMockGetTopConsumersParamPtrs struct {
+	ctx *context.Context
+	req *usage.GetTopConsumersReq
+}
+
+// CoreMockGetTopConsumersResults contains results of the Core.GetTopConsumers
+type CoreMockGetTopConsumersResults struct {
+	ca1 []usage.Consumer
+	err error
+}
+
+// CoreMockGetTopConsumersOrigins contains origins of expectations of the Core.GetTopConsumers
+type CoreMockGetTopConsumersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Optional() *mCoreMockGetTopConsumers {
+	mmGetTopConsumers.optional = true
+	return mmGetTopConsumers
+}
+
+// Expect sets up expected params for Core.GetTopConsumers
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Expect(ctx context.Context, req usage.GetTopConsumersReq) *mCoreMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &CoreMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by ExpectParams functions")
+	}
+
+	mmGetTopConsumers.defaultExpectation.params = &CoreMockGetTopConsumersParams{ctx, req}
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTopConsumers.expectations {
+		if minimock.Equal(e.params, mmGetTopConsumers.defaultExpectation.params) {
+			mmGetTopConsumers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTopConsumers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTopConsumers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetTopConsumers
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) ExpectCtxParam1(ctx context.Context) *mCoreMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &CoreMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &CoreMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// ExpectReqParam2 sets up expected param req for Core.GetTopConsumers
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) ExpectReqParam2(req usage.GetTopConsumersReq) *mCoreMockGetTopConsumers {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &CoreMockGetTopConsumersExpectation{}
+	}
+
+	if mmGetTopConsumers.defaultExpectation.params != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Expect")
+	}
+
+	if mmGetTopConsumers.defaultExpectation.paramPtrs == nil {
+		mmGetTopConsumers.defaultExpectation.paramPtrs = &CoreMockGetTopConsumersParamPtrs{}
+	}
+	mmGetTopConsumers.defaultExpectation.paramPtrs.req = &req
+	mmGetTopConsumers.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetTopConsumers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetTopConsumers
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Inspect(f func(ctx context.Context, req usage.GetTopConsumersReq)) *mCoreMockGetTopConsumers {
+	if mmGetTopConsumers.mock.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Inspect function is already set for CoreMock.GetTopConsumers")
+	}
+
+	mmGetTopConsumers.mock.inspectFuncGetTopConsumers = f
+
+	return mmGetTopConsumers
+}
+
+// Return sets up results that will be returned by Core.GetTopConsumers
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Return(ca1 []usage.Consumer, err error) *CoreMock {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Set")
+	}
+
+	if mmGetTopConsumers.defaultExpectation == nil {
+		mmGetTopConsumers.defaultExpectation = &CoreMockGetTopConsumersExpectation{mock: mmGetTopConsumers.mock}
+	}
+	mmGetTopConsumers.defaultExpectation.results = &CoreMockGetTopConsumersResults{ca1, err}
+	mmGetTopConsumers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// Set uses given function f to mock the Core.GetTopConsumers method
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Set(f func(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error)) *CoreMock {
+	if mmGetTopConsumers.defaultExpectation != nil {
+		mmGetTopConsumers.mock.t.Fatalf("Default expectation is already set for the Core.GetTopConsumers method")
+	}
+
+	if len(mmGetTopConsumers.expectations) > 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Some expectations are already set for the Core.GetTopConsumers method")
+	}
+
+	mmGetTopConsumers.mock.funcGetTopConsumers = f
+	mmGetTopConsumers.mock.funcGetTopConsumersOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers.mock
+}
+
+// When sets expectation for the Core.GetTopConsumers which will trigger the result defined by the following
+// Then helper
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) When(ctx context.Context, req usage.GetTopConsumersReq) *CoreMockGetTopConsumersExpectation {
+	if mmGetTopConsumers.mock.funcGetTopConsumers != nil {
+		mmGetTopConsumers.mock.t.Fatalf("CoreMock.GetTopConsumers mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetTopConsumersExpectation{
+		mock:               mmGetTopConsumers.mock,
+		params:             &CoreMockGetTopConsumersParams{ctx, req},
+		expectationOrigins: CoreMockGetTopConsumersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTopConsumers.expectations = append(mmGetTopConsumers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetTopConsumers return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetTopConsumersExpectation) Then(ca1 []usage.Consumer, err error) *CoreMock {
+	e.results = &CoreMockGetTopConsumersResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetTopConsumers should be invoked
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Times(n uint64) *mCoreMockGetTopConsumers {
+	if n == 0 {
+		mmGetTopConsumers.mock.t.Fatalf("Times of CoreMock.GetTopConsumers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTopConsumers.expectedInvocations, n)
+	mmGetTopConsumers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTopConsumers
+}
+
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) invocationsDone() bool {
+	if len(mmGetTopConsumers.expectations) == 0 && mmGetTopConsumers.defaultExpectation == nil && mmGetTopConsumers.mock.funcGetTopConsumers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.mock.afterGetTopConsumersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTopConsumers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTopConsumers implements mm_usecase.Core
+func (mmGetTopConsumers *CoreMock) GetTopConsumers(ctx context.Context, req usage.GetTopConsumersReq) (ca1 []usage.Consumer, err error) {
+	mm_atomic.AddUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTopConsumers.afterGetTopConsumersCounter, 1)
+
+	mmGetTopConsumers.t.Helper()
+
+	if mmGetTopConsumers.inspectFuncGetTopConsumers != nil {
+		mmGetTopConsumers.inspectFuncGetTopConsumers(ctx, req)
+	}
+
+	mm_params := CoreMockGetTopConsumersParams{ctx, req}
+
+	// Record call args
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Lock()
+	mmGetTopConsumers.GetTopConsumersMock.callArgs = append(mmGetTopConsumers.GetTopConsumersMock.callArgs, &mm_params)
+	mmGetTopConsumers.GetTopConsumersMock.mutex.Unlock()
+
+	for _, e := range mmGetTopConsumers.GetTopConsumersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetTopConsumers.GetTopConsumersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetTopConsumersParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTopConsumers.t.Errorf("CoreMock.GetTopConsumers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetTopConsumers.t.Errorf("CoreMock.GetTopConsumers got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTopConsumers.t.Errorf("CoreMock.GetTopConsumers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTopConsumers.GetTopConsumersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTopConsumers.t.Fatal("No results are set for the CoreMock.GetTopConsumers")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetTopConsumers.funcGetTopConsumers != nil {
+		return mmGetTopConsumers.funcGetTopConsumers(ctx, req)
+	}
+	mmGetTopConsumers.t.Fatalf("Unexpected call to CoreMock.GetTopConsumers. %v %v", ctx, req)
+	return
+}
+
+// GetTopConsumersAfterCounter returns a count of finished CoreMock.GetTopConsumers invocations
+func (mmGetTopConsumers *CoreMock) GetTopConsumersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.afterGetTopConsumersCounter)
+}
+
+// GetTopConsumersBeforeCounter returns a count of CoreMock.GetTopConsumers invocations
+func (mmGetTopConsumers *CoreMock) GetTopConsumersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTopConsumers.beforeGetTopConsumersCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetTopConsumers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTopConsumers *mCoreMockGetTopConsumers) Calls() []*CoreMockGetTopConsumersParams {
+	mmGetTopConsumers.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetTopConsumersParams, len(mmGetTopConsumers.callArgs))
+	copy(argCopy, mmGetTopConsumers.callArgs)
+
+	mmGetTopConsumers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTopConsumersDone returns true if the count of the GetTopConsumers invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetTopConsumersDone() bool {
+	if m.GetTopConsumersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTopConsumersMock.invocationsDone()
+}
+
+// MinimockGetTopConsumersInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetTopConsumersInspect() {
+	for _, e := range m.GetTopConsumersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetTopConsumers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTopConsumersCounter := mm_atomic.LoadUint64(&m.afterGetTopConsumersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTopConsumersMock.defaultExpectation != nil && afterGetTopConsumersCounter < 1 {
+		if m.GetTopConsumersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetTopConsumers at\n%s", m.GetTopConsumersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetTopConsumers at\n%s with params: %#v", m.GetTopConsumersMock.defaultExpectation.expectationOrigins.origin, *m.GetTopConsumersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTopConsumers != nil && afterGetTopConsumersCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetTopConsumers at\n%s", m.funcGetTopConsumersOrigin)
+	}
+
+	if !m.GetTopConsumersMock.invocationsDone() && afterGetTopConsumersCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetTopConsumers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTopConsumersMock.expectedInvocations), m.GetTopConsumersMock.expectedInvocationsOrigin, afterGetTopConsumersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetTopConsumersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetTopConsumersDone()
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+type Core interface {
+	GetTopConsumers(ctx context.Context, req usage.GetTopConsumersReq) ([]usage.Consumer, error)
+}
+
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("usage.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+// GetTopConsumers returns the users with the most requests in the requested period. The route requires admin role.
+func (s *service) GetTopConsumers(ctx context.Context, req usage.GetTopConsumersReq) ([]usage.Consumer, error) {
+	consumers, err := s.core.GetTopConsumers(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("usage.service.GetTopConsumers: failed to get top consumers")
+		return nil, fmt.Errorf("usage.service.GetTopConsumers: %w", err)
+	}
+
+	return consumers, nil
+}
@@ -0,0 +1,74 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/usage"
+	"github.com/66gu1/easygodocs/internal/app/usage/usecase"
+	"github.com/66gu1/easygodocs/internal/app/usage/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+type mock struct {
+	core *mocks.CoreMock
+}
+
+func getMocks(t *testing.T) mock {
+	t.Helper()
+	return mock{
+		core: mocks.NewCoreMock(t),
+	}
+}
+
+func TestService_GetTopConsumers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		req       = usage.GetTopConsumersReq{Hours: 24, Limit: 10}
+		consumers = []usage.Consumer{{UserID: uuid.New(), Requests: 7}}
+		errLimit  = usage.ErrInvalidLimit(100)
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		want  []usage.Consumer
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.core.GetTopConsumersMock.Expect(ctx, req).Return(consumers, nil)
+			},
+			want: consumers,
+		},
+		{
+			name: "core error",
+			setup: func(mocks mock) {
+				mocks.core.GetTopConsumersMock.Expect(ctx, req).Return(nil, errLimit)
+			},
+			err: errLimit,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mocks := getMocks(t)
+			tc.setup(mocks)
+			svc := usecase.NewService(mocks.core)
+
+			got, err := svc.GetTopConsumers(ctx, req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type AvatarRepository interface {
+	GetAvatar(ctx context.Context, userID uuid.UUID) (Avatar, error)
+	SetAvatar(ctx context.Context, userID uuid.UUID, avatar Avatar) error
+	DeleteAvatar(ctx context.Context, userID uuid.UUID) error
+}
+
+// BlobStorage stores avatar bytes. Get and Delete return blob.ErrNotFound for missing keys.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// avatarCore keeps the image in blob storage and its content type and version on the user row.
+type avatarCore struct {
+	repo      AvatarRepository
+	storage   BlobStorage
+	validator Validator
+	timeGen   TimeGenerator
+}
+
+func NewAvatarCore(repo AvatarRepository, storage BlobStorage, validator Validator, timeGen TimeGenerator) (*avatarCore, error) {
+	if repo == nil || storage == nil || validator == nil || timeGen == nil {
+		return nil, fmt.Errorf("user.NewAvatarCore: %w", fmt.Errorf("nil dependency"))
+	}
+
+	return &avatarCore{repo: repo, storage: storage, validator: validator, timeGen: timeGen}, nil
+}
+
+// Upload replaces the user's avatar. The image is written before the user row,
+// so a failed row update leaves at most an unreferenced blob that is removed best-effort.
+func (c *avatarCore) Upload(ctx context.Context, userID uuid.UUID, data []byte) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user.avatarCore.Upload: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	contentType, err := c.validator.ValidateAvatar(data)
+	if err != nil {
+		return fmt.Errorf("user.avatarCore.Upload: %w", err)
+	}
+
+	key := avatarKey(userID)
+	if err = c.storage.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("user.avatarCore.Upload: %w", err)
+	}
+	avatar := Avatar{ContentType: contentType, Size: int64(len(data)), UpdatedAt: c.timeGen.Now()}
+	if err = c.repo.SetAvatar(ctx, userID, avatar); err != nil {
+		if errors.Is(err, ErrUserNotFound()) {
+			if delErr := c.storage.Delete(context.WithoutCancel(ctx), key); delErr != nil {
+				logger.Error(ctx, delErr).
+					Str(FieldUserID.String(), userID.String()).
+					Msg("user.avatarCore.Upload: failed to remove orphaned avatar")
+			}
+		}
+		return fmt.Errorf("user.avatarCore.Upload: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the avatar metadata and its content. The caller must close the reader.
+func (c *avatarCore) Get(ctx context.Context, userID uuid.UUID) (Avatar, io.ReadCloser, error) {
+	if userID == uuid.Nil {
+		return Avatar{}, nil, fmt.Errorf("user.avatarCore.Get: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	avatar, err := c.repo.GetAvatar(ctx, userID)
+	if err != nil {
+		return Avatar{}, nil, fmt.Errorf("user.avatarCore.Get: %w", err)
+	}
+	content, err := c.storage.Get(ctx, avatarKey(userID))
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			err = ErrAvatarNotFound()
+		}
+		return Avatar{}, nil, fmt.Errorf("user.avatarCore.Get: %w", err)
+	}
+
+	return avatar, content, nil
+}
+
+func (c *avatarCore) Delete(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return fmt.Errorf("user.avatarCore.Delete: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if err := c.repo.DeleteAvatar(ctx, userID); err != nil {
+		return fmt.Errorf("user.avatarCore.Delete: %w", err)
+	}
+	if err := c.storage.Delete(ctx, avatarKey(userID)); err != nil && !errors.Is(err, blob.ErrNotFound) {
+		return fmt.Errorf("user.avatarCore.Delete: %w", err)
+	}
+
+	return nil
+}
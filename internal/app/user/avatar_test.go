@@ -0,0 +1,300 @@
+package user_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/app/user/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type avatarMock struct {
+	repo      *mocks.AvatarRepositoryMock
+	storage   *mocks.BlobStorageMock
+	validator *mocks.ValidatorMock
+	timeGen   *mocks.TimeGeneratorMock
+}
+
+func getAvatarMocks(t *testing.T) avatarMock {
+	t.Helper()
+	return avatarMock{
+		repo:      mocks.NewAvatarRepositoryMock(t),
+		storage:   mocks.NewBlobStorageMock(t),
+		validator: mocks.NewValidatorMock(t),
+		timeGen:   mocks.NewTimeGeneratorMock(t),
+	}
+}
+
+func TestNewAvatarCore(t *testing.T) {
+	t.Parallel()
+
+	m := getAvatarMocks(t)
+	_, err := user.NewAvatarCore(m.repo, m.storage, m.validator, m.timeGen)
+	require.NoError(t, err)
+	_, err = user.NewAvatarCore(m.repo, nil, m.validator, m.timeGen)
+	require.Error(t, err)
+}
+
+func TestAvatarCore_Upload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		data   = []byte("image")
+		key    = "avatars/" + userID.String()
+		now    = time.Now()
+		avatar = user.Avatar{ContentType: "image/png", Size: int64(len(data)), UpdatedAt: now}
+		expErr = errors.New("expected error")
+	)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(m avatarMock)
+		err    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.validator.ValidateAvatarMock.Expect(data).Return(avatar.ContentType, nil)
+				m.storage.PutMock.Expect(ctx, key, bytes.NewReader(data)).Return(nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.SetAvatarMock.Expect(ctx, userID, avatar).Return(nil)
+			},
+		},
+		{
+			name:   "error/nil_id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(user.FieldUserID),
+		},
+		{
+			name:   "error/validation",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.validator.ValidateAvatarMock.Expect(data).Return("", user.ErrAvatarEmpty())
+			},
+			err: user.ErrAvatarEmpty(),
+		},
+		{
+			name:   "error/storage",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.validator.ValidateAvatarMock.Expect(data).Return(avatar.ContentType, nil)
+				m.storage.PutMock.Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "error/user_not_found/blob_removed",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.validator.ValidateAvatarMock.Expect(data).Return(avatar.ContentType, nil)
+				m.storage.PutMock.Return(nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.SetAvatarMock.Expect(ctx, userID, avatar).Return(user.ErrUserNotFound())
+				m.storage.DeleteMock.Expect(minimock.AnyContext, key).Return(nil)
+			},
+			err: user.ErrUserNotFound(),
+		},
+		{
+			name:   "error/repo",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.validator.ValidateAvatarMock.Expect(data).Return(avatar.ContentType, nil)
+				m.storage.PutMock.Return(nil)
+				m.timeGen.NowMock.Return(now)
+				m.repo.SetAvatarMock.Expect(ctx, userID, avatar).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := getAvatarMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			c, err := user.NewAvatarCore(m.repo, m.storage, m.validator, m.timeGen)
+			require.NoError(t, err)
+			err = c.Upload(ctx, tt.userID, data)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAvatarCore_Get(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = uuid.New()
+		key     = "avatars/" + userID.String()
+		avatar  = user.Avatar{ContentType: "image/png", UpdatedAt: time.Now()}
+		content = io.NopCloser(strings.NewReader("image"))
+		expErr  = errors.New("expected error")
+	)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(m avatarMock)
+		err    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.GetAvatarMock.Expect(ctx, userID).Return(avatar, nil)
+				m.storage.GetMock.Expect(ctx, key).Return(content, nil)
+			},
+		},
+		{
+			name:   "error/nil_id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(user.FieldUserID),
+		},
+		{
+			name:   "error/repo",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.GetAvatarMock.Expect(ctx, userID).Return(user.Avatar{}, user.ErrAvatarNotFound())
+			},
+			err: user.ErrAvatarNotFound(),
+		},
+		{
+			name:   "error/blob_missing",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.GetAvatarMock.Expect(ctx, userID).Return(avatar, nil)
+				m.storage.GetMock.Expect(ctx, key).Return(nil, blob.ErrNotFound)
+			},
+			err: user.ErrAvatarNotFound(),
+		},
+		{
+			name:   "error/storage",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.GetAvatarMock.Expect(ctx, userID).Return(avatar, nil)
+				m.storage.GetMock.Expect(ctx, key).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := getAvatarMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			c, err := user.NewAvatarCore(m.repo, m.storage, m.validator, m.timeGen)
+			require.NoError(t, err)
+			gotAvatar, gotContent, err := c.Get(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, gotContent)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, avatar, gotAvatar)
+			require.Equal(t, content, gotContent)
+		})
+	}
+}
+
+func TestAvatarCore_Delete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		key    = "avatars/" + userID.String()
+		expErr = errors.New("expected error")
+	)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(m avatarMock)
+		err    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.DeleteAvatarMock.Expect(ctx, userID).Return(nil)
+				m.storage.DeleteMock.Expect(ctx, key).Return(nil)
+			},
+		},
+		{
+			name:   "success/blob_already_missing",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.DeleteAvatarMock.Expect(ctx, userID).Return(nil)
+				m.storage.DeleteMock.Expect(ctx, key).Return(blob.ErrNotFound)
+			},
+		},
+		{
+			name:   "error/nil_id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(user.FieldUserID),
+		},
+		{
+			name:   "error/repo",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.DeleteAvatarMock.Expect(ctx, userID).Return(user.ErrAvatarNotFound())
+			},
+			err: user.ErrAvatarNotFound(),
+		},
+		{
+			name:   "error/storage",
+			userID: userID,
+			setup: func(m avatarMock) {
+				m.repo.DeleteAvatarMock.Expect(ctx, userID).Return(nil)
+				m.storage.DeleteMock.Expect(ctx, key).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := getAvatarMocks(t)
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			c, err := user.NewAvatarCore(m.repo, m.storage, m.validator, m.timeGen)
+			require.NoError(t, err)
+			err = c.Delete(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,24 +2,45 @@ package user
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/mail"
+	"slices"
 	"strings"
-	"unicode/utf8"
+	"sync/atomic"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/text/language"
 )
 
 type Repository interface {
 	CreateUser(ctx context.Context, req CreateUserReq, id uuid.UUID, passwordHash string) error
 	GetUser(ctx context.Context, id uuid.UUID) (User, string, error)
 	GetUserByEmail(ctx context.Context, email string) (User, string, error)
-	GetAllUsers(ctx context.Context) ([]User, error)
+	GetAllUsers(ctx context.Context, opts ListUsersOptions) (UsersPage, error)
 	UpdateUser(ctx context.Context, req UpdateUserReq) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// AnonymizeUser replaces email and name, clears the rest of the personal data but the avatar, see
+	// DeleteAvatar, and ends the sessions by bumping the session version. It returns ErrUserAnonymized
+	// for a user anonymized before.
+	AnonymizeUser(ctx context.Context, id uuid.UUID, email, name string) error
 	ChangePassword(ctx context.Context, id uuid.UUID, newPasswordHash string) error
+	// UpdatePasswordHash replaces oldHash with newHash without ending sessions. It does nothing
+	// when the stored hash is no longer oldHash.
+	UpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) error
+	// LinkExternalUser marks a local user as signing in with the external directory.
+	LinkExternalUser(ctx context.Context, id uuid.UUID) error
+	UpdateProfile(ctx context.Context, req UpdateProfileReq) error
+	// GetPreferences returns the stored document, or nil if the user never saved preferences.
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	SetPreferences(ctx context.Context, userID uuid.UUID, data []byte) error
 }
 
 type IDGenerator interface {
@@ -27,7 +48,8 @@ type IDGenerator interface {
 }
 
 type PasswordHasher interface {
-	HashPassword(password []byte, cost int) ([]byte, error)
+	HashPassword(password []byte, params secure.HashParams) ([]byte, error)
+	NeedsRehash(hash []byte, params secure.HashParams) bool
 }
 
 type Validator interface {
@@ -36,10 +58,38 @@ type Validator interface {
 	ValidateName(name string) error
 	NormalizeName(name string) string
 	NormalizeEmail(address string) string
+	NormalizeProfile(req UpdateProfileReq) UpdateProfileReq
+	ValidateProfile(req UpdateProfileReq) error
+	// ValidateAvatar checks size and format and returns the detected content type.
+	ValidateAvatar(data []byte) (string, error)
+	ValidatePreferences(prefs Preferences) error
 }
 
+// Config chooses how new password hashes are made. Stored hashes made with another algorithm or a
+// weaker cost keep working and are replaced on the user's next sign-in.
 type Config struct {
-	PasswordHashCost int `mapstructure:"password_hash_cost" json:"password_hash_cost"`
+	PasswordHashAlgorithm secure.Algorithm    `mapstructure:"password_hash_algorithm" json:"password_hash_algorithm"`
+	PasswordHashCost      int                 `mapstructure:"password_hash_cost" json:"password_hash_cost"`
+	Argon2                secure.Argon2Params `mapstructure:"argon2" json:"argon2"`
+	Registration          RegistrationConfig  `mapstructure:"registration" json:"registration"`
+}
+
+func (c Config) Validate() error {
+	if c.PasswordHashCost < bcrypt.MinCost || c.PasswordHashCost > bcrypt.MaxCost {
+		return fmt.Errorf("Config.PasswordHashCost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if err := c.HashParams().Validate(); err != nil {
+		return fmt.Errorf("Config.PasswordHashAlgorithm: %w", err)
+	}
+	if err := c.Registration.Validate(); err != nil {
+		return fmt.Errorf("Config.Registration: %w", err)
+	}
+
+	return nil
+}
+
+func (c Config) HashParams() secure.HashParams {
+	return secure.HashParams{Algorithm: c.PasswordHashAlgorithm, BcryptCost: c.PasswordHashCost, Argon2: c.Argon2}
 }
 
 type core struct {
@@ -51,8 +101,8 @@ type core struct {
 }
 
 func NewCore(repo Repository, idGenerator IDGenerator, passwordHasher PasswordHasher, validator Validator, cfg Config) (*core, error) {
-	if cfg.PasswordHashCost < bcrypt.MinCost || cfg.PasswordHashCost > bcrypt.MaxCost {
-		return nil, fmt.Errorf("user.NewCore: %w", fmt.Errorf("Config.PasswordHashCost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost))
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("user.NewCore: %w", err)
 	}
 	if idGenerator == nil || passwordHasher == nil || repo == nil || validator == nil {
 		return nil, fmt.Errorf("user.NewCore: %w", fmt.Errorf("nil dependency"))
@@ -73,7 +123,7 @@ func (c *core) CreateUser(ctx context.Context, req CreateUserReq) (uuid.UUID, er
 	if err := c.validator.ValidatePassword(req.Password); err != nil {
 		return uuid.Nil, fmt.Errorf("user.core.CreateUser: %w", err)
 	}
-	passwordHash, err := c.passwordHasher.HashPassword(req.Password, c.cfg.PasswordHashCost)
+	passwordHash, err := c.passwordHasher.HashPassword(req.Password, c.cfg.HashParams())
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("user.core.CreateUser: %w", err)
 	}
@@ -89,6 +139,42 @@ func (c *core) CreateUser(ctx context.Context, req CreateUserReq) (uuid.UUID, er
 	return id, nil
 }
 
+// externalPasswordBytes is the entropy of the password of users who sign in elsewhere.
+const externalPasswordBytes = 32
+
+// CreateExternalUser creates a user who signs in with an external directory. The local password is
+// random and never shown, so the account has no usable one until it is reset.
+func (c *core) CreateExternalUser(ctx context.Context, email, name string) (uuid.UUID, error) {
+	name = c.validator.NormalizeName(name)
+	if err := c.validator.ValidateName(name); err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+	email = c.validator.NormalizeEmail(email)
+	if err := c.validator.ValidateEmail(email, true); err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+
+	password := make([]byte, externalPasswordBytes)
+	if _, err := rand.Read(password); err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+	defer secure.ZeroBytes(password)
+	passwordHash, err := c.passwordHasher.HashPassword(password, c.cfg.HashParams())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+
+	id, err := c.idGenerator.New()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+	if err = c.repo.CreateUser(ctx, CreateUserReq{Email: email, Name: name, External: true}, id, string(passwordHash)); err != nil {
+		return uuid.Nil, fmt.Errorf("user.core.CreateExternalUser: %w", err)
+	}
+
+	return id, nil
+}
+
 func (c *core) GetUser(ctx context.Context, id uuid.UUID) (User, string, error) {
 	if id == uuid.Nil {
 		return User{}, "", fmt.Errorf("user.core.GetUser: %w", apperr.ErrNilUUID(FieldUserID))
@@ -102,13 +188,17 @@ func (c *core) GetUser(ctx context.Context, id uuid.UUID) (User, string, error)
 	return user, passwordHash, nil
 }
 
-func (c *core) GetAllUsers(ctx context.Context) ([]User, error) {
-	users, err := c.repo.GetAllUsers(ctx)
+// GetAllUsers lists the users, all of them or, with opts.Limit, a page.
+func (c *core) GetAllUsers(ctx context.Context, opts ListUsersOptions) (UsersPage, error) {
+	if opts.Limit < 0 || opts.Limit > MaxListUsersLimit {
+		return UsersPage{}, fmt.Errorf("user.core.GetAllUsers: %w", ErrInvalidListLimit(MaxListUsersLimit))
+	}
+	page, err := c.repo.GetAllUsers(ctx, opts)
 	if err != nil {
-		return nil, fmt.Errorf("user.core.GetAllUsers: %w", err)
+		return UsersPage{}, fmt.Errorf("user.core.GetAllUsers: %w", err)
 	}
 
-	return users, nil
+	return page, nil
 }
 
 func (c *core) UpdateUser(ctx context.Context, req UpdateUserReq) error {
@@ -139,6 +229,18 @@ func (c *core) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// AnonymizeUser scrubs the personal data of the user and leaves placeholders, see AnonymizedName.
+func (c *core) AnonymizeUser(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("user.core.AnonymizeUser: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if err := c.repo.AnonymizeUser(ctx, id, AnonymizedEmail(id), AnonymizedName); err != nil {
+		return fmt.Errorf("user.core.AnonymizeUser: %w", err)
+	}
+
+	return nil
+}
+
 func (c *core) ChangePassword(ctx context.Context, id uuid.UUID, newPassword []byte) error {
 	if id == uuid.Nil {
 		return fmt.Errorf("user.core.ChangePassword: %w", apperr.ErrNilUUID(FieldUserID))
@@ -146,7 +248,7 @@ func (c *core) ChangePassword(ctx context.Context, id uuid.UUID, newPassword []b
 	if err := c.validator.ValidatePassword(newPassword); err != nil {
 		return fmt.Errorf("user.core.ChangePassword: %w", err)
 	}
-	newPasswordHash, err := c.passwordHasher.HashPassword(newPassword, c.cfg.PasswordHashCost)
+	newPasswordHash, err := c.passwordHasher.HashPassword(newPassword, c.cfg.HashParams())
 	if err != nil {
 		return fmt.Errorf("user.core.ChangePassword: %w", err)
 	}
@@ -157,6 +259,107 @@ func (c *core) ChangePassword(ctx context.Context, id uuid.UUID, newPassword []b
 	return nil
 }
 
+// UpgradePasswordHash rehashes a verified password whose stored hash uses another algorithm or a
+// weaker cost than configured. Sessions stay valid. password is zeroed when it is rehashed.
+func (c *core) UpgradePasswordHash(ctx context.Context, id uuid.UUID, password []byte, hash string) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("user.core.UpgradePasswordHash: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	params := c.cfg.HashParams()
+	if !c.passwordHasher.NeedsRehash([]byte(hash), params) {
+		return nil
+	}
+	newHash, err := c.passwordHasher.HashPassword(password, params)
+	if err != nil {
+		return fmt.Errorf("user.core.UpgradePasswordHash: %w", err)
+	}
+	if err = c.repo.UpdatePasswordHash(ctx, id, hash, string(newHash)); err != nil {
+		return fmt.Errorf("user.core.UpgradePasswordHash: %w", err)
+	}
+
+	return nil
+}
+
+// LinkExternalUser makes a local user sign in with the external directory from now on. Its local
+// password is kept.
+func (c *core) LinkExternalUser(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("user.core.LinkExternalUser: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if err := c.repo.LinkExternalUser(ctx, id); err != nil {
+		return fmt.Errorf("user.core.LinkExternalUser: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateProfile changes the profile fields set in req; an empty string clears a field.
+func (c *core) UpdateProfile(ctx context.Context, req UpdateProfileReq) error {
+	if req.UserID == uuid.Nil {
+		return fmt.Errorf("user.core.UpdateProfile: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	if req.IsEmpty() {
+		return fmt.Errorf("user.core.UpdateProfile: %w", ErrEmptyProfileUpdate())
+	}
+	req = c.validator.NormalizeProfile(req)
+	if err := c.validator.ValidateProfile(req); err != nil {
+		return fmt.Errorf("user.core.UpdateProfile: %w", err)
+	}
+
+	if err := c.repo.UpdateProfile(ctx, req); err != nil {
+		return fmt.Errorf("user.core.UpdateProfile: %w", err)
+	}
+
+	return nil
+}
+
+// GetPreferences returns the user's preferences upgraded to the current schema,
+// or the defaults if none were saved.
+func (c *core) GetPreferences(ctx context.Context, userID uuid.UUID) (Preferences, error) {
+	if userID == uuid.Nil {
+		return Preferences{}, fmt.Errorf("user.core.GetPreferences: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+
+	data, err := c.repo.GetPreferences(ctx, userID)
+	if err != nil {
+		return Preferences{}, fmt.Errorf("user.core.GetPreferences: %w", err)
+	}
+	if data == nil {
+		return DefaultPreferences(), nil
+	}
+	prefs, err := ParsePreferences(data)
+	if err != nil {
+		return Preferences{}, fmt.Errorf("user.core.GetPreferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// UpdatePreferences replaces the user's preferences with data, which may use an older schema
+// version, and returns the stored result.
+func (c *core) UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (Preferences, error) {
+	if userID == uuid.Nil {
+		return Preferences{}, fmt.Errorf("user.core.UpdatePreferences: %w", apperr.ErrNilUUID(FieldUserID))
+	}
+	prefs, err := ParsePreferences(data)
+	if err != nil {
+		return Preferences{}, fmt.Errorf("user.core.UpdatePreferences: %w", err)
+	}
+	if err = c.validator.ValidatePreferences(prefs); err != nil {
+		return Preferences{}, fmt.Errorf("user.core.UpdatePreferences: %w", err)
+	}
+
+	stored, err := json.Marshal(prefs)
+	if err != nil {
+		return Preferences{}, fmt.Errorf("user.core.UpdatePreferences: %w", err)
+	}
+	if err = c.repo.SetPreferences(ctx, userID, stored); err != nil {
+		return Preferences{}, fmt.Errorf("user.core.UpdatePreferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
 func (c *core) GetUserByEmail(ctx context.Context, email string) (User, string, error) {
 	email = c.validator.NormalizeEmail(email)
 	if err := c.validator.ValidateEmail(email, false); err != nil {
@@ -175,39 +378,79 @@ type ValidationConfig struct {
 	MaxNameLength     int `mapstructure:"max_name_length" json:"max_name_length"`
 	MinPasswordLength int `mapstructure:"min_password_length" json:"min_password_length"`
 	MaxPasswordLength int `mapstructure:"max_password_length" json:"max_password_length"`
+	MaxBioLength      int `mapstructure:"max_bio_length" json:"max_bio_length"`
+	MaxAvatarBytes    int `mapstructure:"max_avatar_bytes" json:"max_avatar_bytes"`
+	// NameRules apply to the account name; the display name is only trimmed.
+	NameRules text.NameRules `mapstructure:"name_rules" json:"name_rules"`
 }
 
-type validator struct {
-	cfg ValidationConfig
-}
-
-func NewValidator(cfg ValidationConfig) (Validator, error) {
-	if cfg.MaxEmailLength <= 0 {
-		return nil, fmt.Errorf("NewValidator: %w", fmt.Errorf("ValidationConfig.MaxEmailLength must be > 0"))
+func (c ValidationConfig) Validate() error {
+	if c.MaxEmailLength <= 0 {
+		return fmt.Errorf("ValidationConfig.MaxEmailLength must be > 0")
+	}
+	if c.MaxNameLength <= 0 {
+		return fmt.Errorf("ValidationConfig.MaxNameLength must be > 0")
+	}
+	if c.MinPasswordLength <= 0 {
+		return fmt.Errorf("ValidationConfig.MinPasswordLength must be > 0")
+	}
+	if c.MaxPasswordLength < c.MinPasswordLength {
+		return fmt.Errorf("ValidationConfig.MaxPasswordLength must be >= MinPasswordLength")
 	}
-	if cfg.MaxNameLength <= 0 {
-		return nil, fmt.Errorf("NewValidator: %w", fmt.Errorf("ValidationConfig.MaxNameLength must be > 0"))
+	// a longer limit could never be reached under the byte cap
+	if c.MaxPasswordLength > secure.MaxPasswordBytes {
+		return fmt.Errorf("ValidationConfig.MaxPasswordLength must be > 0 and <= %d", secure.MaxPasswordBytes)
 	}
-	if cfg.MinPasswordLength <= 0 {
-		return nil, fmt.Errorf("NewValidator: %w", fmt.Errorf("ValidationConfig.MinPasswordLength must be > 0"))
+	if c.MaxBioLength <= 0 {
+		return fmt.Errorf("ValidationConfig.MaxBioLength must be > 0")
 	}
-	if cfg.MaxPasswordLength < cfg.MinPasswordLength {
-		return nil, fmt.Errorf("NewValidator: %w", fmt.Errorf("ValidationConfig.MaxPasswordLength must be >= MinPasswordLength"))
+	if c.MaxAvatarBytes <= 0 {
+		return fmt.Errorf("ValidationConfig.MaxAvatarBytes must be > 0")
 	}
-	if cfg.MaxPasswordLength > 72 {
-		return nil, fmt.Errorf("NewValidator: %w", fmt.Errorf("ValidationConfig.MaxPasswordLength must be > 0 and <= 72"))
+	if err := c.NameRules.Validate(); err != nil {
+		return fmt.Errorf("ValidationConfig.NameRules: %w", err)
+	}
+
+	return nil
+}
+
+// validator limits can be swapped at runtime with Reload.
+type validator struct {
+	cfg atomic.Pointer[ValidationConfig]
+}
+
+func NewValidator(cfg ValidationConfig) (*validator, error) {
+	v := &validator{}
+	if err := v.Reload(cfg); err != nil {
+		return nil, fmt.Errorf("NewValidator: %w", err)
 	}
 
-	return &validator{cfg: cfg}, nil
+	return v, nil
 }
 
+// Reload replaces the limits. An invalid config is rejected and the current one is kept.
+func (v *validator) Reload(cfg ValidationConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("validator.Reload: %w", err)
+	}
+	v.cfg.Store(&cfg)
+
+	return nil
+}
+
+// ValidatePassword counts the length in characters and also caps the bytes, since a few emoji can
+// reach the bcrypt limit well before the character limit.
 func (v *validator) ValidatePassword(password []byte) error {
-	n := utf8.RuneCount(password)
-	if n < v.cfg.MinPasswordLength {
-		return fmt.Errorf("ValidatePassword: %w", ErrPasswordTooShort(v.cfg.MinPasswordLength))
+	cfg := v.cfg.Load()
+	n := text.Length(string(password))
+	if n < cfg.MinPasswordLength {
+		return fmt.Errorf("ValidatePassword: %w", ErrPasswordTooShort(cfg.MinPasswordLength))
+	}
+	if n > cfg.MaxPasswordLength {
+		return fmt.Errorf("ValidatePassword: %w", ErrPasswordTooLong(cfg.MaxPasswordLength))
 	}
-	if n > v.cfg.MaxPasswordLength {
-		return fmt.Errorf("ValidatePassword: %w", ErrPasswordTooLong(v.cfg.MaxPasswordLength))
+	if len(password) > secure.MaxPasswordBytes {
+		return fmt.Errorf("ValidatePassword: %w", ErrPasswordTooManyBytes(secure.MaxPasswordBytes))
 	}
 	return nil
 }
@@ -217,26 +460,113 @@ func (v *validator) ValidateEmail(address string, validateLength bool) error {
 	if err != nil {
 		return fmt.Errorf("ValidateEmail: %w", ErrInvalidEmail())
 	}
-	if validateLength && len(address) > v.cfg.MaxEmailLength {
-		return fmt.Errorf("validateEmail: %w", ErrEmailTooLong(v.cfg.MaxEmailLength))
+	if maxLen := v.cfg.Load().MaxEmailLength; validateLength && len(address) > maxLen {
+		return fmt.Errorf("validateEmail: %w", ErrEmailTooLong(maxLen))
 	}
 	return nil
 }
 
 func (v *validator) ValidateName(name string) error {
+	cfg := v.cfg.Load()
 	if name == "" {
 		return fmt.Errorf("ValidateName: %w", ErrNameEmpty())
 	}
-	if len(name) > v.cfg.MaxNameLength {
-		return fmt.Errorf("ValidateName: %w", ErrNameTooLong(v.cfg.MaxNameLength))
+	if text.Length(name) > cfg.MaxNameLength {
+		return fmt.Errorf("ValidateName: %w", ErrNameTooLong(cfg.MaxNameLength))
+	}
+	if ch, ok := text.FirstForbidden(name, cfg.NameRules.ForbiddenChars); ok {
+		return fmt.Errorf("ValidateName: %w", ErrNameForbiddenChar(ch))
 	}
 	return nil
 }
 
 func (v *validator) NormalizeName(name string) string {
-	return strings.TrimSpace(name)
+	return v.cfg.Load().NameRules.Normalize(name)
 }
 
 func (v *validator) NormalizeEmail(address string) string {
 	return strings.TrimSpace(strings.ToLower(address))
 }
+
+func (v *validator) NormalizeProfile(req UpdateProfileReq) UpdateProfileReq {
+	trim := func(s *string) *string {
+		if s == nil {
+			return nil
+		}
+		t := strings.TrimSpace(*s)
+		return &t
+	}
+	req.DisplayName = trim(req.DisplayName)
+	req.Bio = trim(req.Bio)
+	req.Timezone = trim(req.Timezone)
+	req.Locale = trim(req.Locale)
+	if req.Locale != nil && *req.Locale != "" {
+		// store the canonical form, e.g. "en_us" -> "en-US"
+		if tag, err := language.Parse(*req.Locale); err == nil {
+			canonical := tag.String()
+			req.Locale = &canonical
+		}
+	}
+
+	return req
+}
+
+// ValidateProfile checks the fields that are set. Empty values are valid and clear the field.
+func (v *validator) ValidateProfile(req UpdateProfileReq) error {
+	cfg := v.cfg.Load()
+	if req.DisplayName != nil && text.Length(*req.DisplayName) > cfg.MaxNameLength {
+		return fmt.Errorf("ValidateProfile: %w", ErrDisplayNameTooLong(cfg.MaxNameLength))
+	}
+	if req.Bio != nil && text.Length(*req.Bio) > cfg.MaxBioLength {
+		return fmt.Errorf("ValidateProfile: %w", ErrBioTooLong(cfg.MaxBioLength))
+	}
+	if req.Timezone != nil && *req.Timezone != "" {
+		// "Local" depends on the server and is not a real zone name
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "Local" {
+			return fmt.Errorf("ValidateProfile: %w", ErrInvalidTimezone())
+		}
+	}
+	if req.Locale != nil && *req.Locale != "" {
+		if _, err := language.Parse(*req.Locale); err != nil {
+			return fmt.Errorf("ValidateProfile: %w", ErrInvalidLocale())
+		}
+	}
+
+	return nil
+}
+
+// avatarContentTypes are the accepted avatar formats, as detected by http.DetectContentType.
+var avatarContentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+func (v *validator) ValidateAvatar(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("ValidateAvatar: %w", ErrAvatarEmpty())
+	}
+	if maxBytes := v.cfg.Load().MaxAvatarBytes; len(data) > maxBytes {
+		return "", fmt.Errorf("ValidateAvatar: %w", ErrAvatarTooLarge(maxBytes))
+	}
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(avatarContentTypes, contentType) {
+		return "", fmt.Errorf("ValidateAvatar: %w", ErrAvatarUnsupportedType())
+	}
+
+	return contentType, nil
+}
+
+func (v *validator) ValidatePreferences(prefs Preferences) error {
+	switch prefs.Theme {
+	case ThemeSystem, ThemeLight, ThemeDark:
+	default:
+		return fmt.Errorf("ValidatePreferences: %w", ErrInvalidTheme())
+	}
+	switch prefs.Notifications.Digest {
+	case DigestNone, DigestDaily, DigestWeekly:
+	default:
+		return fmt.Errorf("ValidatePreferences: %w", ErrInvalidDigest())
+	}
+	if prefs.DefaultSpaceID != nil && *prefs.DefaultSpaceID == uuid.Nil {
+		return fmt.Errorf("ValidatePreferences: %w", apperr.ErrNilUUID(FieldDefaultSpace))
+	}
+
+	return nil
+}
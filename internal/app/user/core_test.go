@@ -5,12 +5,15 @@ package user_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/app/user/mocks"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
@@ -18,7 +21,8 @@ import (
 
 func cfg() user.Config {
 	return user.Config{
-		PasswordHashCost: bcrypt.MinCost,
+		PasswordHashAlgorithm: secure.AlgorithmBcrypt,
+		PasswordHashCost:      bcrypt.MinCost,
 	}
 }
 
@@ -111,7 +115,45 @@ func TestNewCore(t *testing.T) {
 			hasher: passwordHasher,
 			v:      validator,
 			cfg: user.Config{
-				PasswordHashCost: bcrypt.MaxCost + 1,
+				PasswordHashAlgorithm: secure.AlgorithmBcrypt,
+				PasswordHashCost:      bcrypt.MaxCost + 1,
+			},
+			wantErr: true,
+		},
+		{
+			name:   "ok/argon2id",
+			repo:   repo,
+			idGen:  idGen,
+			hasher: passwordHasher,
+			v:      validator,
+			cfg: user.Config{
+				PasswordHashAlgorithm: secure.AlgorithmArgon2id,
+				PasswordHashCost:      bcrypt.MinCost,
+				Argon2:                secure.Argon2Params{MemoryKiB: 64 * 1024, Iterations: 3, Parallelism: 4},
+			},
+		},
+		{
+			name:   "error_invalid_argon2_params",
+			repo:   repo,
+			idGen:  idGen,
+			hasher: passwordHasher,
+			v:      validator,
+			cfg: user.Config{
+				PasswordHashAlgorithm: secure.AlgorithmArgon2id,
+				PasswordHashCost:      bcrypt.MinCost,
+				Argon2:                secure.Argon2Params{MemoryKiB: 1024, Iterations: 3, Parallelism: 4},
+			},
+			wantErr: true,
+		},
+		{
+			name:   "error_unknown_algorithm",
+			repo:   repo,
+			idGen:  idGen,
+			hasher: passwordHasher,
+			v:      validator,
+			cfg: user.Config{
+				PasswordHashAlgorithm: "md5",
+				PasswordHashCost:      bcrypt.MinCost,
 			},
 			wantErr: true,
 		},
@@ -156,7 +198,7 @@ func TestCore_CreateUser(t *testing.T) {
 				mocks.validator.NormalizeEmailMock.Expect(req.Email).Return(normalizedEmail)
 				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, true).Return(nil)
 				mocks.validator.ValidatePasswordMock.Expect(req.Password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().PasswordHashCost).Return(hash, nil)
+				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().HashParams()).Return(hash, nil)
 				mocks.idGen.NewMock.Return(id, nil)
 				mocks.repo.CreateUserMock.Expect(ctx, expReq, id, string(hash)).Return(nil)
 			},
@@ -198,7 +240,7 @@ func TestCore_CreateUser(t *testing.T) {
 				mocks.validator.NormalizeEmailMock.Expect(req.Email).Return(normalizedEmail)
 				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, true).Return(nil)
 				mocks.validator.ValidatePasswordMock.Expect(req.Password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().PasswordHashCost).Return(nil, expErr)
+				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().HashParams()).Return(nil, expErr)
 			},
 			err: expErr,
 		},
@@ -210,7 +252,7 @@ func TestCore_CreateUser(t *testing.T) {
 				mocks.validator.NormalizeEmailMock.Expect(req.Email).Return(normalizedEmail)
 				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, true).Return(nil)
 				mocks.validator.ValidatePasswordMock.Expect(req.Password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().PasswordHashCost).Return(hash, nil)
+				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().HashParams()).Return(hash, nil)
 				mocks.idGen.NewMock.Return(uuid.Nil, expErr)
 			},
 			err: expErr,
@@ -223,7 +265,7 @@ func TestCore_CreateUser(t *testing.T) {
 				mocks.validator.NormalizeEmailMock.Expect(req.Email).Return(normalizedEmail)
 				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, true).Return(nil)
 				mocks.validator.ValidatePasswordMock.Expect(req.Password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().PasswordHashCost).Return(hash, nil)
+				mocks.passwordHasher.HashPasswordMock.Expect(req.Password, cfg().HashParams()).Return(hash, nil)
 				mocks.idGen.NewMock.Return(id, nil)
 				mocks.repo.CreateUserMock.Expect(ctx, expReq, id, string(hash)).Return(expErr)
 			},
@@ -258,6 +300,84 @@ func TestCore_CreateUser(t *testing.T) {
 	}
 }
 
+func TestCore_CreateExternalUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		hash   = []byte("hashed-random")
+		expErr = errors.New(`expected error`)
+		expReq = user.CreateUserReq{Email: "ann@example.org", Name: "Ann", External: true}
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "success/random password",
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeNameMock.Expect(" Ann ").Return("Ann")
+				mocks.validator.ValidateNameMock.Expect("Ann").Return(nil)
+				mocks.validator.NormalizeEmailMock.Expect("Ann@example.org").Return("ann@example.org")
+				mocks.validator.ValidateEmailMock.Expect("ann@example.org", true).Return(nil)
+				mocks.passwordHasher.HashPasswordMock.Set(func(password []byte, _ secure.HashParams) ([]byte, error) {
+					require.Len(t, password, 32)
+					return hash, nil
+				})
+				mocks.idGen.NewMock.Return(id, nil)
+				mocks.repo.CreateUserMock.Expect(ctx, expReq, id, string(hash)).Return(nil)
+			},
+		},
+		{
+			name: "error/validation/name",
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeNameMock.Expect(" Ann ").Return("Ann")
+				mocks.validator.ValidateNameMock.Expect("Ann").Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/repo",
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeNameMock.Expect(" Ann ").Return("Ann")
+				mocks.validator.ValidateNameMock.Expect("Ann").Return(nil)
+				mocks.validator.NormalizeEmailMock.Expect("Ann@example.org").Return("ann@example.org")
+				mocks.validator.ValidateEmailMock.Expect("ann@example.org", true).Return(nil)
+				mocks.passwordHasher.HashPasswordMock.Return(hash, nil)
+				mocks.idGen.NewMock.Return(id, nil)
+				mocks.repo.CreateUserMock.Expect(ctx, expReq, id, string(hash)).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				validator:      mocks.NewValidatorMock(t),
+				passwordHasher: mocks.NewPasswordHasherMock(t),
+				idGen:          mocks.NewIDGeneratorMock(t),
+				repo:           mocks.NewRepositoryMock(t),
+			}
+			tt.setup(m)
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			got, err := core.CreateExternalUser(ctx, "Ann@example.org", " Ann ")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, id, got)
+			}
+		})
+	}
+}
+
 func TestCore_GetUser(t *testing.T) {
 	t.Parallel()
 
@@ -334,26 +454,49 @@ func TestCore_GetAllUsers(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx    = context.Background()
-		want   = []user.User{{ID: uuid.New(), Email: "email", Name: "name", CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+		ctx  = context.Background()
+		want = user.UsersPage{
+			Users:      []user.User{{ID: uuid.New(), Email: "email", Name: "name", CreatedAt: time.Now(), UpdatedAt: time.Now()}},
+			NextCursor: "cursor",
+		}
 		expErr = errors.New(`expected error`)
 	)
 	tests := []struct {
 		name  string
+		opts  user.ListUsersOptions
 		setup func(mocks mock)
 		err   error
 	}{
 		{
 			name: "success",
+			opts: user.ListUsersOptions{IncludeDeleted: true},
+			setup: func(mocks mock) {
+				mocks.repo.GetAllUsersMock.Expect(ctx, user.ListUsersOptions{IncludeDeleted: true}).Return(want, nil)
+			},
+		},
+		{
+			name: "success/page",
+			opts: user.ListUsersOptions{Limit: user.MaxListUsersLimit, After: "after"},
 			setup: func(mocks mock) {
-				mocks.repo.GetAllUsersMock.Expect(ctx).Return(want, nil)
+				mocks.repo.GetAllUsersMock.Expect(ctx, user.ListUsersOptions{Limit: user.MaxListUsersLimit, After: "after"}).Return(want, nil)
 			},
 		},
+		{
+			name: "error/negative limit",
+			opts: user.ListUsersOptions{Limit: -1},
+			err:  user.ErrInvalidListLimit(user.MaxListUsersLimit),
+		},
+		{
+			name: "error/limit too large",
+			opts: user.ListUsersOptions{Limit: user.MaxListUsersLimit + 1},
+			err:  user.ErrInvalidListLimit(user.MaxListUsersLimit),
+		},
 		{
 			name: "error/repo",
+			opts: user.ListUsersOptions{IncludeDeleted: true},
 			err:  expErr,
 			setup: func(mocks mock) {
-				mocks.repo.GetAllUsersMock.Expect(ctx).Return(nil, expErr)
+				mocks.repo.GetAllUsersMock.Expect(ctx, user.ListUsersOptions{IncludeDeleted: true}).Return(user.UsersPage{}, expErr)
 			},
 		},
 	}
@@ -370,13 +513,13 @@ func TestCore_GetAllUsers(t *testing.T) {
 
 			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
 			require.NoError(t, err)
-			users, err := core.GetAllUsers(ctx)
+			page, err := core.GetAllUsers(ctx, tt.opts)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
-			require.Equal(t, want, users)
+			require.Equal(t, want, page)
 		})
 	}
 }
@@ -524,6 +667,65 @@ func TestCore_DeleteUser(t *testing.T) {
 	}
 }
 
+func TestCore_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		email  = "anonymized-" + id.String() + "@anonymized.invalid"
+		expErr = errors.New(`expected error`)
+	)
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		in    uuid.UUID
+		err   error
+	}{
+		{
+			name: "success",
+			in:   id,
+			setup: func(mocks mock) {
+				mocks.repo.AnonymizeUserMock.Expect(ctx, id, email, user.AnonymizedName).Return(nil)
+			},
+		},
+		{
+			name: "error/nil_id",
+			in:   uuid.Nil,
+			err:  apperr.ErrNilUUID(user.FieldUserID),
+		},
+		{
+			name: "error/repo",
+			in:   id,
+			err:  expErr,
+			setup: func(mocks mock) {
+				mocks.repo.AnonymizeUserMock.Expect(ctx, id, email, user.AnonymizedName).Return(expErr)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				repo: mocks.NewRepositoryMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			err = core.AnonymizeUser(ctx, tt.in)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCore_ChangePassword(t *testing.T) {
 	t.Parallel()
 
@@ -545,7 +747,7 @@ func TestCore_ChangePassword(t *testing.T) {
 			in:   id,
 			setup: func(mocks mock) {
 				mocks.validator.ValidatePasswordMock.Expect(password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().PasswordHashCost).Return(hash, nil)
+				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().HashParams()).Return(hash, nil)
 				mocks.repo.ChangePasswordMock.Expect(ctx, id, string(hash)).Return(nil)
 			},
 		},
@@ -567,7 +769,7 @@ func TestCore_ChangePassword(t *testing.T) {
 			in:   id,
 			setup: func(mocks mock) {
 				mocks.validator.ValidatePasswordMock.Expect(password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().PasswordHashCost).Return(nil, expErr)
+				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().HashParams()).Return(nil, expErr)
 			},
 			err: expErr,
 		},
@@ -577,7 +779,7 @@ func TestCore_ChangePassword(t *testing.T) {
 			err:  expErr,
 			setup: func(mocks mock) {
 				mocks.validator.ValidatePasswordMock.Expect(password).Return(nil)
-				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().PasswordHashCost).Return(hash, nil)
+				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().HashParams()).Return(hash, nil)
 				mocks.repo.ChangePasswordMock.Expect(ctx, id, string(hash)).Return(expErr)
 			},
 		},
@@ -607,67 +809,71 @@ func TestCore_ChangePassword(t *testing.T) {
 	}
 }
 
-func TestCore_GetUserByEmail(t *testing.T) {
+func TestCore_UpgradePasswordHash(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx             = context.Background()
-		email           = "email"
-		normalizedEmail = "n_email"
-		id              = uuid.New()
-		hash            = "hashed-pa$$123"
-		want            = user.User{
-			ID:             id,
-			Email:          "email",
-			Name:           "name",
-			SessionVersion: 0,
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-			DeletedAt:      nil,
-		}
-		expErr = errors.New(`expected error`)
+		ctx      = context.Background()
+		id       = uuid.New()
+		password = []byte("pa$1")
+		oldHash  = "$2a$04$old"
+		newHash  = []byte("$2a$12$new")
+		expErr   = errors.New(`expected error`)
 	)
 	tests := []struct {
 		name  string
 		setup func(mocks mock)
-		in    string
+		in    uuid.UUID
 		err   error
 	}{
 		{
-			name: "success/normalized",
-			in:   email,
+			name: "success",
+			in:   id,
 			setup: func(mocks mock) {
-				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
-				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(nil)
-				mocks.repo.GetUserByEmailMock.Expect(ctx, normalizedEmail).Return(want, hash, nil)
+				mocks.passwordHasher.NeedsRehashMock.Expect([]byte(oldHash), cfg().HashParams()).Return(true)
+				mocks.passwordHasher.HashPasswordMock.Expect(password, cfg().HashParams()).Return(newHash, nil)
+				mocks.repo.UpdatePasswordHashMock.Expect(ctx, id, oldHash, string(newHash)).Return(nil)
 			},
 		},
 		{
-			name: "error/validation/email",
-			in:   email,
+			name: "up to date",
+			in:   id,
 			setup: func(mocks mock) {
-				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
-				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(expErr)
+				mocks.passwordHasher.NeedsRehashMock.Expect([]byte(oldHash), cfg().HashParams()).Return(false)
+			},
+		},
+		{
+			name: "error/validation/id",
+			in:   uuid.Nil,
+			err:  apperr.ErrNilUUID(""),
+		},
+		{
+			name: "error/hash_password",
+			in:   id,
+			setup: func(mocks mock) {
+				mocks.passwordHasher.NeedsRehashMock.Return(true)
+				mocks.passwordHasher.HashPasswordMock.Return(nil, expErr)
 			},
 			err: expErr,
 		},
 		{
 			name: "error/repo",
-			in:   email,
-			err:  expErr,
+			in:   id,
 			setup: func(mocks mock) {
-				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
-				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(nil)
-				mocks.repo.GetUserByEmailMock.Expect(ctx, normalizedEmail).Return(want, hash, expErr)
+				mocks.passwordHasher.NeedsRehashMock.Return(true)
+				mocks.passwordHasher.HashPasswordMock.Return(newHash, nil)
+				mocks.repo.UpdatePasswordHashMock.Return(expErr)
 			},
+			err: expErr,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			m := mock{
-				repo:      mocks.NewRepositoryMock(t),
-				validator: mocks.NewValidatorMock(t),
+				repo:           mocks.NewRepositoryMock(t),
+				passwordHasher: mocks.NewPasswordHasherMock(t),
+				validator:      mocks.NewValidatorMock(t),
 			}
 
 			if tt.setup != nil {
@@ -676,61 +882,204 @@ func TestCore_GetUserByEmail(t *testing.T) {
 
 			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
 			require.NoError(t, err)
-			usr, h, err := core.GetUserByEmail(ctx, tt.in)
+			err = core.UpgradePasswordHash(ctx, tt.in, password, oldHash)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
-			require.Equal(t, want, usr)
-			require.Equal(t, hash, h)
 		})
 	}
 }
 
-func vCFG() user.ValidationConfig {
-	return user.ValidationConfig{
-		MaxEmailLength:    15,
-		MaxNameLength:     5,
-		MinPasswordLength: 3,
-		MaxPasswordLength: 5,
-	}
-}
-
-func TestNewValidator(t *testing.T) {
+func TestCore_LinkExternalUser(t *testing.T) {
 	t.Parallel()
 
+	var (
+		ctx    = context.Background()
+		id     = uuid.New()
+		expErr = errors.New(`expected error`)
+	)
 	tests := []struct {
-		name    string
-		cfg     user.ValidationConfig
-		wantErr bool
+		name  string
+		setup func(mocks mock)
+		in    uuid.UUID
+		err   error
 	}{
 		{
 			name: "success",
-			cfg:  vCFG(),
+			in:   id,
+			setup: func(mocks mock) {
+				mocks.repo.LinkExternalUserMock.Expect(ctx, id).Return(nil)
+			},
 		},
 		{
-			name: "error/max_email_length",
-			cfg: user.ValidationConfig{
-				MaxEmailLength:    0,
-				MaxNameLength:     5,
-				MinPasswordLength: 3,
-				MaxPasswordLength: 5,
-			},
-			wantErr: true,
+			name: "error/validation/id",
+			in:   uuid.Nil,
+			err:  apperr.ErrNilUUID(""),
 		},
 		{
-			name: "error/max_name_length",
-			cfg: user.ValidationConfig{
-				MaxEmailLength:    15,
-				MaxNameLength:     0,
-				MinPasswordLength: 3,
-				MaxPasswordLength: 5,
+			name: "error/repo",
+			in:   id,
+			setup: func(mocks mock) {
+				mocks.repo.LinkExternalUserMock.Return(expErr)
 			},
-			wantErr: true,
+			err: expErr,
 		},
-		{
-			name: "error/min_password_length",
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				repo:           mocks.NewRepositoryMock(t),
+				passwordHasher: mocks.NewPasswordHasherMock(t),
+				validator:      mocks.NewValidatorMock(t),
+				idGen:          mocks.NewIDGeneratorMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			err = core.LinkExternalUser(ctx, tt.in)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_GetUserByEmail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx             = context.Background()
+		email           = "email"
+		normalizedEmail = "n_email"
+		id              = uuid.New()
+		hash            = "hashed-pa$$123"
+		want            = user.User{
+			ID:             id,
+			Email:          "email",
+			Name:           "name",
+			SessionVersion: 0,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+			DeletedAt:      nil,
+		}
+		expErr = errors.New(`expected error`)
+	)
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		in    string
+		err   error
+	}{
+		{
+			name: "success/normalized",
+			in:   email,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
+				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(nil)
+				mocks.repo.GetUserByEmailMock.Expect(ctx, normalizedEmail).Return(want, hash, nil)
+			},
+		},
+		{
+			name: "error/validation/email",
+			in:   email,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
+				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/repo",
+			in:   email,
+			err:  expErr,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeEmailMock.Expect(email).Return(normalizedEmail)
+				mocks.validator.ValidateEmailMock.Expect(normalizedEmail, false).Return(nil)
+				mocks.repo.GetUserByEmailMock.Expect(ctx, normalizedEmail).Return(want, hash, expErr)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				repo:      mocks.NewRepositoryMock(t),
+				validator: mocks.NewValidatorMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			usr, h, err := core.GetUserByEmail(ctx, tt.in)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, want, usr)
+			require.Equal(t, hash, h)
+		})
+	}
+}
+
+func vCFG() user.ValidationConfig {
+	return user.ValidationConfig{
+		MaxEmailLength:    15,
+		MaxNameLength:     5,
+		MinPasswordLength: 3,
+		MaxPasswordLength: 5,
+		MaxBioLength:      10,
+		MaxAvatarBytes:    64,
+		NameRules:         text.NameRules{CollapseSpaces: true, ForbiddenChars: "@"},
+	}
+}
+
+func TestNewValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     user.ValidationConfig
+		wantErr bool
+	}{
+		{
+			name: "success",
+			cfg:  vCFG(),
+		},
+		{
+			name: "error/max_email_length",
+			cfg: user.ValidationConfig{
+				MaxEmailLength:    0,
+				MaxNameLength:     5,
+				MinPasswordLength: 3,
+				MaxPasswordLength: 5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error/max_name_length",
+			cfg: user.ValidationConfig{
+				MaxEmailLength:    15,
+				MaxNameLength:     0,
+				MinPasswordLength: 3,
+				MaxPasswordLength: 5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error/min_password_length",
 			cfg: user.ValidationConfig{
 				MaxEmailLength:    15,
 				MaxNameLength:     5,
@@ -759,6 +1108,33 @@ func TestNewValidator(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error/max_bio_length",
+			cfg: func() user.ValidationConfig {
+				cfg := vCFG()
+				cfg.MaxBioLength = 0
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "error/max_avatar_bytes",
+			cfg: func() user.ValidationConfig {
+				cfg := vCFG()
+				cfg.MaxAvatarBytes = 0
+				return cfg
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "error/forbidden_chars",
+			cfg: func() user.ValidationConfig {
+				cfg := vCFG()
+				cfg.NameRules.ForbiddenChars = "@ "
+				return cfg
+			}(),
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -790,11 +1166,20 @@ func TestValidator_ValidateName(t *testing.T) {
 			in:   "",
 			err:  user.ErrNameEmpty(),
 		},
+		{
+			name: "success/multibyte",
+			in:   "Jose\u0301\U0001f44d\U0001f3fd",
+		},
 		{
 			name: "error/too long",
 			in:   "long_name",
 			err:  user.ErrNameTooLong(vCFG().MaxNameLength),
 		},
+		{
+			name: "error/forbidden char",
+			in:   "a@b",
+			err:  user.ErrNameForbiddenChar('@'),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -877,11 +1262,20 @@ func TestValidator_ValidatePassword(t *testing.T) {
 			in:   []byte("p$"),
 			err:  user.ErrPasswordTooShort(vCFG().MinPasswordLength),
 		},
+		{
+			name: "success/emoji",
+			in:   []byte("\U0001f468\u200d\U0001f469\u200d\U0001f467\U0001f511\U0001f512"),
+		},
 		{
 			name: "error/too long",
 			in:   []byte("long_pa$1"),
 			err:  user.ErrPasswordTooLong(vCFG().MaxPasswordLength),
 		},
+		{
+			name: "error/too many bytes",
+			in:   []byte(strings.Repeat("\U0001f468\u200d\U0001f469\u200d\U0001f467", 5)),
+			err:  user.ErrPasswordTooManyBytes(secure.MaxPasswordBytes),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -891,7 +1285,9 @@ func TestValidator_ValidatePassword(t *testing.T) {
 
 			err = v.ValidatePassword(tt.in)
 			if tt.err != nil {
-				require.ErrorIs(t, err, tt.err)
+				// both length errors share field and rule, so compare the params too
+				require.Error(t, err)
+				require.Equal(t, tt.err, apperr.FromError(err))
 				return
 			}
 			require.NoError(t, err)
@@ -916,11 +1312,444 @@ func TestValidator_NormalizeName(t *testing.T) {
 	t.Parallel()
 
 	var (
-		in   = "  Name  "
-		want = "Name"
+		in   = "  Jane \t Doe  "
+		want = "Jane Doe"
 	)
 	v, err := user.NewValidator(vCFG())
 	require.NoError(t, err)
 	got := v.NormalizeName(in)
 	require.Equal(t, want, got)
 }
+
+func TestValidator_Reload(t *testing.T) {
+	t.Parallel()
+
+	v, err := user.NewValidator(vCFG())
+	require.NoError(t, err)
+	require.ErrorIs(t, v.ValidateName("long_name"), user.ErrNameTooLong(vCFG().MaxNameLength))
+
+	cfg := vCFG()
+	cfg.MaxNameLength = 20
+	require.NoError(t, v.Reload(cfg))
+	require.NoError(t, v.ValidateName("long_name"))
+
+	cfg.MaxNameLength = 0
+	require.Error(t, v.Reload(cfg))
+	require.NoError(t, v.ValidateName("long_name"), "invalid config must not be applied")
+}
+
+func TestCore_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		name       = " Jane "
+		normalized = "Jane"
+		req        = user.UpdateProfileReq{UserID: uuid.New(), DisplayName: &name}
+		expReq     = user.UpdateProfileReq{UserID: req.UserID, DisplayName: &normalized}
+		expErr     = errors.New(`expected error`)
+	)
+	tests := []struct {
+		name  string
+		in    user.UpdateProfileReq
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "success/normalized",
+			in:   req,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeProfileMock.Expect(req).Return(expReq)
+				mocks.validator.ValidateProfileMock.Expect(expReq).Return(nil)
+				mocks.repo.UpdateProfileMock.Expect(ctx, expReq).Return(nil)
+			},
+		},
+		{
+			name: "error/validation/id",
+			in:   user.UpdateProfileReq{UserID: uuid.Nil, DisplayName: &name},
+			err:  apperr.ErrNilUUID(""),
+		},
+		{
+			name: "error/empty",
+			in:   user.UpdateProfileReq{UserID: req.UserID},
+			err:  user.ErrEmptyProfileUpdate(),
+		},
+		{
+			name: "error/validation",
+			in:   req,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeProfileMock.Expect(req).Return(expReq)
+				mocks.validator.ValidateProfileMock.Expect(expReq).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "error/repo",
+			in:   req,
+			setup: func(mocks mock) {
+				mocks.validator.NormalizeProfileMock.Expect(req).Return(expReq)
+				mocks.validator.ValidateProfileMock.Expect(expReq).Return(nil)
+				mocks.repo.UpdateProfileMock.Expect(ctx, expReq).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				validator: mocks.NewValidatorMock(t),
+				repo:      mocks.NewRepositoryMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			err = core.UpdateProfile(ctx, tt.in)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidator_NormalizeProfile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		name   = "  Jane "
+		locale = " en_us "
+		tz     = ""
+	)
+	v, err := user.NewValidator(vCFG())
+	require.NoError(t, err)
+
+	got := v.NormalizeProfile(user.UpdateProfileReq{DisplayName: &name, Locale: &locale, Timezone: &tz})
+	require.Equal(t, "Jane", *got.DisplayName)
+	require.Equal(t, "en-US", *got.Locale)
+	require.Empty(t, *got.Timezone)
+	require.Nil(t, got.Bio)
+}
+
+func TestValidator_ValidateProfile(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name string
+		req  user.UpdateProfileReq
+		err  error
+	}{
+		{
+			name: "success",
+			req:  user.UpdateProfileReq{DisplayName: ptr("Jane"), Bio: ptr("hi"), Timezone: ptr("Europe/Berlin"), Locale: ptr("de-DE")},
+		},
+		{
+			name: "success/cleared",
+			req:  user.UpdateProfileReq{DisplayName: ptr(""), Bio: ptr(""), Timezone: ptr(""), Locale: ptr("")},
+		},
+		{
+			name: "error/display_name_too_long",
+			req:  user.UpdateProfileReq{DisplayName: ptr("Jane Doe")},
+			err:  user.ErrDisplayNameTooLong(vCFG().MaxNameLength),
+		},
+		{
+			name: "error/bio_too_long",
+			req:  user.UpdateProfileReq{Bio: ptr("a very long bio")},
+			err:  user.ErrBioTooLong(vCFG().MaxBioLength),
+		},
+		{
+			name: "error/timezone",
+			req:  user.UpdateProfileReq{Timezone: ptr("Mars/Olympus")},
+			err:  user.ErrInvalidTimezone(),
+		},
+		{
+			name: "error/timezone_local",
+			req:  user.UpdateProfileReq{Timezone: ptr("Local")},
+			err:  user.ErrInvalidTimezone(),
+		},
+		{
+			name: "error/locale",
+			req:  user.UpdateProfileReq{Locale: ptr("not a locale")},
+			err:  user.ErrInvalidLocale(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := user.NewValidator(vCFG())
+			require.NoError(t, err)
+			err = v.ValidateProfile(tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidator_ValidateAvatar(t *testing.T) {
+	t.Parallel()
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		err         error
+	}{
+		{
+			name:        "success/png",
+			data:        png,
+			contentType: "image/png",
+		},
+		{
+			name: "error/empty",
+			err:  user.ErrAvatarEmpty(),
+		},
+		{
+			name: "error/too_large",
+			data: make([]byte, vCFG().MaxAvatarBytes+1),
+			err:  user.ErrAvatarTooLarge(vCFG().MaxAvatarBytes),
+		},
+		{
+			name: "error/unsupported_type",
+			data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"/>"),
+			err:  user.ErrAvatarUnsupportedType(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := user.NewValidator(vCFG())
+			require.NoError(t, err)
+			contentType, err := v.ValidateAvatar(tt.data)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.contentType, contentType)
+		})
+	}
+}
+
+func TestCore_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		expErr = errors.New(`expected error`)
+	)
+	dark := user.DefaultPreferences()
+	dark.Theme = user.ThemeDark
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		setup  func(mocks mock)
+		want   user.Preferences
+		err    error
+	}{
+		{
+			name:   "success/stored",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.GetPreferencesMock.Expect(ctx, userID).Return([]byte(`{"schema_version":1,"theme":"dark"}`), nil)
+			},
+			want: dark,
+		},
+		{
+			name:   "success/defaults",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.GetPreferencesMock.Expect(ctx, userID).Return(nil, nil)
+			},
+			want: user.DefaultPreferences(),
+		},
+		{
+			name:   "error/validation/id",
+			userID: uuid.Nil,
+			err:    apperr.ErrNilUUID(""),
+		},
+		{
+			name:   "error/repo",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.GetPreferencesMock.Expect(ctx, userID).Return(nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "error/stored_version_unsupported",
+			userID: userID,
+			setup: func(mocks mock) {
+				mocks.repo.GetPreferencesMock.Expect(ctx, userID).Return([]byte(`{"schema_version":99}`), nil)
+			},
+			err: user.ErrUnsupportedPreferencesVersion(user.PreferencesSchemaVersion),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				validator: mocks.NewValidatorMock(t),
+				repo:      mocks.NewRepositoryMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			got, err := core.GetPreferences(ctx, tt.userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCore_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = uuid.New()
+		data   = []byte(`{"theme":"dark"}`)
+		expErr = errors.New(`expected error`)
+	)
+	want := user.DefaultPreferences()
+	want.Theme = user.ThemeDark
+	stored := []byte(`{"schema_version":1,"theme":"dark","default_space_id":null,` +
+		`"notifications":{"email":true,"mentions":true,"comments":true,"digest":"none"},"drafts":{"keep_stale":false}}`)
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		data   []byte
+		setup  func(mocks mock)
+		err    error
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			data:   data,
+			setup: func(mocks mock) {
+				mocks.validator.ValidatePreferencesMock.Expect(want).Return(nil)
+				mocks.repo.SetPreferencesMock.Expect(ctx, userID, stored).Return(nil)
+			},
+		},
+		{
+			name:   "error/validation/id",
+			userID: uuid.Nil,
+			data:   data,
+			err:    apperr.ErrNilUUID(""),
+		},
+		{
+			name:   "error/parse",
+			userID: userID,
+			data:   []byte(`{"unknown":1}`),
+			err:    user.ErrInvalidPreferences(""),
+		},
+		{
+			name:   "error/validation",
+			userID: userID,
+			data:   data,
+			setup: func(mocks mock) {
+				mocks.validator.ValidatePreferencesMock.Expect(want).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name:   "error/repo",
+			userID: userID,
+			data:   data,
+			setup: func(mocks mock) {
+				mocks.validator.ValidatePreferencesMock.Expect(want).Return(nil)
+				mocks.repo.SetPreferencesMock.Expect(ctx, userID, stored).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := mock{
+				validator: mocks.NewValidatorMock(t),
+				repo:      mocks.NewRepositoryMock(t),
+			}
+
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			core, err := user.NewCore(m.repo, m.idGen, m.passwordHasher, m.validator, cfg())
+			require.NoError(t, err)
+			got, err := core.UpdatePreferences(ctx, tt.userID, tt.data)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestValidator_ValidatePreferences(t *testing.T) {
+	t.Parallel()
+
+	nilID := uuid.Nil
+	tests := []struct {
+		name   string
+		modify func(p *user.Preferences)
+		err    error
+	}{
+		{
+			name:   "success",
+			modify: func(p *user.Preferences) {},
+		},
+		{
+			name:   "error/theme",
+			modify: func(p *user.Preferences) { p.Theme = "neon" },
+			err:    user.ErrInvalidTheme(),
+		},
+		{
+			name:   "error/digest",
+			modify: func(p *user.Preferences) { p.Notifications.Digest = "hourly" },
+			err:    user.ErrInvalidDigest(),
+		},
+		{
+			name:   "error/default_space_nil",
+			modify: func(p *user.Preferences) { p.DefaultSpaceID = &nilID },
+			err:    apperr.ErrNilUUID(user.FieldDefaultSpace),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := user.NewValidator(vCFG())
+			require.NoError(t, err)
+			prefs := user.DefaultPreferences()
+			tt.modify(&prefs)
+			err = v.ValidatePreferences(prefs)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,12 +15,48 @@ type User struct {
 	CreatedAt      time.Time  `json:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at"`
 	DeletedAt      *time.Time `json:"deleted_at"`
+	// AnonymizedAt is when the personal data of the user was scrubbed, see AnonymizedName.
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty"`
+	// External is set for a user who signs in with the external directory.
+	External bool `json:"-"`
+
+	Profile
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+// AnonymizedName replaces the name of an anonymized user, so what they authored stays attributed
+// to a user without telling who it was.
+const AnonymizedName = "Anonymized user"
+
+// AnonymizedEmail is the placeholder address of an anonymized user. It is unique, as emails must be,
+// and the reserved .invalid domain never receives mail.
+func AnonymizedEmail(id uuid.UUID) string {
+	return fmt.Sprintf("anonymized-%s@anonymized.invalid", id)
+}
+
+// MaxListUsersLimit caps the page size of the user listing.
+const MaxListUsersLimit = 100
+
+// ListUsersOptions narrows a user listing. Deleted users are left out unless IncludeDeleted is set.
+// Users are ordered by creation; with a Limit they come in pages, each After the cursor of the one before.
+type ListUsersOptions struct {
+	IncludeDeleted bool   `json:"include_deleted"`
+	Limit          int    `json:"limit"`
+	After          string `json:"after"`
+}
+
+// UsersPage is a page of a user listing. NextCursor is empty on the last page.
+type UsersPage struct {
+	Users      []User `json:"users"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type CreateUserReq struct {
 	Email    string
 	Name     string
 	Password []byte `json:"-"`
+	// External marks a user who signs in with the external directory, see core.CreateExternalUser.
+	External bool `json:"-"`
 }
 
 type UpdateUserReq struct {
@@ -27,3 +64,100 @@ type UpdateUserReq struct {
 	Email  string    `json:"email"`
 	Name   string    `json:"name"`
 }
+
+// Profile holds optional public details. Empty strings mean "not set".
+type Profile struct {
+	DisplayName string `json:"display_name"`
+	Bio         string `json:"bio"`
+	Timezone    string `json:"timezone"` // IANA name, e.g. Europe/Berlin
+	Locale      string `json:"locale"`   // BCP 47 tag, e.g. en-US
+}
+
+// UpdateProfileReq changes only the fields that are not nil.
+type UpdateProfileReq struct {
+	UserID      uuid.UUID `json:"user_id"`
+	DisplayName *string   `json:"display_name,omitempty"`
+	Bio         *string   `json:"bio,omitempty"`
+	Timezone    *string   `json:"timezone,omitempty"`
+	Locale      *string   `json:"locale,omitempty"`
+}
+
+func (r UpdateProfileReq) IsEmpty() bool {
+	return r.DisplayName == nil && r.Bio == nil && r.Timezone == nil && r.Locale == nil
+}
+
+// Avatar describes a stored avatar image; the bytes live in blob storage.
+type Avatar struct {
+	ContentType string
+	Size        int64
+	UpdatedAt   time.Time
+}
+
+// avatarURLFormat is the download route; the version parameter changes with every upload
+// so clients can cache the image indefinitely.
+const avatarURLFormat = "/api/v1/users/%s/avatar?v=%d"
+
+// AvatarURL returns the download URL of the avatar uploaded at updatedAt, or "" when there is none.
+func AvatarURL(userID uuid.UUID, updatedAt *time.Time) string {
+	if updatedAt == nil {
+		return ""
+	}
+	return fmt.Sprintf(avatarURLFormat, userID, updatedAt.Unix())
+}
+
+func avatarKey(userID uuid.UUID) string {
+	return "avatars/" + userID.String()
+}
+
+type Theme string
+
+const (
+	ThemeSystem Theme = "system"
+	ThemeLight  Theme = "light"
+	ThemeDark   Theme = "dark"
+)
+
+type DigestFrequency string
+
+const (
+	DigestNone   DigestFrequency = "none"
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// Preferences are client settings synced across devices. The document is stored as JSON;
+// see ParsePreferences for how older schema versions are upgraded.
+type Preferences struct {
+	SchemaVersion  int                     `json:"schema_version"`
+	Theme          Theme                   `json:"theme"`
+	DefaultSpaceID *uuid.UUID              `json:"default_space_id"` // root entity opened on start; may no longer exist
+	Notifications  NotificationPreferences `json:"notifications"`
+	Drafts         DraftPreferences        `json:"drafts"`
+}
+
+type NotificationPreferences struct {
+	Email    bool            `json:"email"`
+	Mentions bool            `json:"mentions"`
+	Comments bool            `json:"comments"`
+	Digest   DigestFrequency `json:"digest"`
+}
+
+type DraftPreferences struct {
+	// KeepStale opts the user's drafts out of the stale draft policy: they are neither reminded about nor
+	// cleaned up however long they are left untouched.
+	KeepStale bool `json:"keep_stale"`
+}
+
+// DefaultPreferences are returned for users who never saved any and fill fields missing from an update.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		SchemaVersion: PreferencesSchemaVersion,
+		Theme:         ThemeSystem,
+		Notifications: NotificationPreferences{
+			Email:    true,
+			Mentions: true,
+			Comments: true,
+			Digest:   DigestNone,
+		},
+	}
+}
@@ -12,18 +12,57 @@ const (
 	CodeEmailDuplicate   apperr.Code = "user/email_duplicate"
 	CodeSamePassword     apperr.Code = "user/same_password"
 	CodePasswordMismatch apperr.Code = "user/password_mismatch"
+	CodeAvatarNotFound   apperr.Code = "user/avatar_not_found"
+	CodeAnonymized       apperr.Code = "user/anonymized"
+
+	CodeRegistrationDisabled  apperr.Code = "user/registration_disabled"
+	CodeEmailDomainNotAllowed apperr.Code = "user/email_domain_not_allowed"
 )
 
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid user data", apperr.ClassBadRequest)
+	apperr.Register(CodeNotFound, "User not found", apperr.ClassNotFound)
+	apperr.Register(CodeEmailDuplicate, "Email already in use", apperr.ClassConflict)
+	apperr.Register(CodeSamePassword, "New password matches the old one", apperr.ClassBadRequest)
+	apperr.Register(CodePasswordMismatch, "Password does not match", apperr.ClassBadRequest)
+	apperr.Register(CodeAvatarNotFound, "Avatar not found", apperr.ClassNotFound)
+	apperr.Register(CodeAnonymized, "User already anonymized", apperr.ClassConflict)
+	apperr.Register(CodeRegistrationDisabled, "Registration disabled", apperr.ClassForbidden)
+	apperr.Register(CodeEmailDomainNotAllowed, "Email domain not allowed", apperr.ClassForbidden)
+}
+
 const (
 	FieldEmail    apperr.Field = "email"
 	FieldName     apperr.Field = "name"
 	FieldPassword apperr.Field = "password"
 	FieldUserID   apperr.Field = "user_id"
 	FieldUser     apperr.Field = "user"
+	FieldLimit    apperr.Field = "limit"
+
+	FieldDisplayName apperr.Field = "display_name"
+	FieldBio         apperr.Field = "bio"
+	FieldTimezone    apperr.Field = "timezone"
+	FieldLocale      apperr.Field = "locale"
+	FieldProfile     apperr.Field = "profile"
+	FieldAvatar      apperr.Field = "avatar"
+
+	FieldPreferences   apperr.Field = "preferences"
+	FieldSchemaVersion apperr.Field = "schema_version"
+	FieldTheme         apperr.Field = "theme"
+	FieldDefaultSpace  apperr.Field = "default_space_id"
+	FieldDigest        apperr.Field = "notifications.digest"
 )
 
 // Validation errors
 
+func ErrInvalidListLimit(maxLimit int) error {
+	return apperr.New("limit is out of range", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLimit, Rule: apperr.RuleOutOfRange,
+			Params: map[string]any{"min": 1, "max": maxLimit},
+		})
+}
+
 func ErrInvalidEmail() error {
 	return apperr.New("Invalid email", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
@@ -41,40 +80,159 @@ func ErrNameEmpty() error {
 func ErrNameTooLong(max int) error {
 	return apperr.New("Name is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
-			Field: FieldName, Rule: apperr.RuleTooLong, Params: map[string]any{"max": max},
+			Field: FieldName, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max},
+		})
+}
+
+func ErrNameForbiddenChar(c rune) error {
+	return apperr.New("Name contains a forbidden character", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldName, Rule: apperr.RuleInvalidFormat, Params: map[string]any{"char": string(c)},
 		})
 }
 
 func ErrEmailTooLong(max int) error {
 	return apperr.New("Email is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
-			Field: FieldEmail, Rule: apperr.RuleTooLong, Params: map[string]any{"max": max},
+			Field: FieldEmail, Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": max},
 		})
 }
 
 func ErrPasswordTooShort(min int) error {
 	return apperr.New("password is too short", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
-			Field: FieldPassword, Rule: apperr.RuleTooShort, Params: map[string]any{"min": min},
+			Field: FieldPassword, Rule: apperr.RuleTooShort, Params: map[string]any{"min_chars": min},
 		}).WithUserMessage(fmt.Sprintf("Password must be at least %d characters", min))
 }
 
 func ErrPasswordTooLong(max int) error {
 	return apperr.New("password is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
-			Field: FieldPassword, Rule: apperr.RuleTooLong, Params: map[string]any{"max": max},
+			Field: FieldPassword, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max},
 		}).WithUserMessage(fmt.Sprintf("Password must be at most %d characters", max))
 }
 
+func ErrPasswordTooManyBytes(max int) error {
+	return apperr.New("password is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldPassword, Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": max},
+		}).WithUserMessage(fmt.Sprintf("Password must be at most %d bytes", max))
+}
+
+func ErrDisplayNameTooLong(max int) error {
+	return apperr.New("Display name is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldDisplayName, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max},
+		})
+}
+
+func ErrBioTooLong(max int) error {
+	return apperr.New("Bio is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldBio, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max},
+		})
+}
+
+func ErrInvalidTimezone() error {
+	return apperr.New("Unknown timezone", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldTimezone, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
+func ErrInvalidLocale() error {
+	return apperr.New("Invalid locale", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldLocale, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
+func ErrEmptyProfileUpdate() error {
+	return apperr.New("No profile fields to update", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldProfile, Rule: apperr.RuleRequired,
+		})
+}
+
+func ErrAvatarEmpty() error {
+	return apperr.New("Avatar image is required", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldAvatar, Rule: apperr.RuleRequired,
+		})
+}
+
+func ErrAvatarTooLarge(maxBytes int) error {
+	return apperr.New("Avatar image is too large", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldAvatar, Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": maxBytes},
+		})
+}
+
+func ErrAvatarUnsupportedType() error {
+	return apperr.New("Avatar must be a PNG, JPEG, GIF or WebP image", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldAvatar, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
 // Business logic errors
 
+func ErrInvalidPreferences(detail string) error {
+	return apperr.New("Invalid preferences", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithDetail(detail).
+		WithViolation(apperr.Violation{
+			Field: FieldPreferences, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
+func ErrUnsupportedPreferencesVersion(max int) error {
+	return apperr.New("Unsupported preferences schema version", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldSchemaVersion, Rule: apperr.RuleOutOfRange, Params: map[string]any{"min": 1, "max": max},
+		})
+}
+
+func ErrInvalidTheme() error {
+	return apperr.New("Theme must be system, light or dark", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldTheme, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
+func ErrInvalidDigest() error {
+	return apperr.New("Digest must be none, daily or weekly", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldDigest, Rule: apperr.RuleInvalidFormat,
+		})
+}
+
+func ErrAvatarNotFound() error {
+	return apperr.New("Avatar not found", CodeAvatarNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
 func ErrUserNotFound() error {
 	return apperr.New("User not found", CodeNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
 }
 
+func ErrUserAnonymized() error {
+	return apperr.New("User already anonymized", CodeAnonymized, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldUserID, Rule: apperr.RuleInvalidState})
+}
+
 func ErrUserWithEmailAlreadyExists() error {
 	return apperr.New("User with this email already exists", CodeEmailDuplicate, apperr.ClassConflict, apperr.LogLevelWarn).
 		WithViolation(apperr.Violation{
 			Field: FieldEmail, Rule: apperr.RuleDuplicate,
 		})
 }
+
+func ErrRegistrationDisabled() error {
+	return apperr.New("Self-registration is disabled, ask an admin for an account", CodeRegistrationDisabled, apperr.ClassForbidden, apperr.LogLevelWarn)
+}
+
+func ErrEmailDomainNotAllowed() error {
+	return apperr.New("Registration is not open to this email domain", CodeEmailDomainNotAllowed, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldEmail, Rule: apperr.RuleForbidden,
+		})
+}
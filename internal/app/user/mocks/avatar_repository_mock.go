@@ -0,0 +1,1160 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user.AvatarRepository -o avatar_repository_mock.go -n AvatarRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	mm_user "github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// AvatarRepositoryMock implements mm_user.AvatarRepository
+type AvatarRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteAvatar          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcDeleteAvatarOrigin    string
+	inspectFuncDeleteAvatar   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteAvatarCounter  uint64
+	beforeDeleteAvatarCounter uint64
+	DeleteAvatarMock          mAvatarRepositoryMockDeleteAvatar
+
+	funcGetAvatar          func(ctx context.Context, userID uuid.UUID) (a1 mm_user.Avatar, err error)
+	funcGetAvatarOrigin    string
+	inspectFuncGetAvatar   func(ctx context.Context, userID uuid.UUID)
+	afterGetAvatarCounter  uint64
+	beforeGetAvatarCounter uint64
+	GetAvatarMock          mAvatarRepositoryMockGetAvatar
+
+	funcSetAvatar          func(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar) (err error)
+	funcSetAvatarOrigin    string
+	inspectFuncSetAvatar   func(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar)
+	afterSetAvatarCounter  uint64
+	beforeSetAvatarCounter uint64
+	SetAvatarMock          mAvatarRepositoryMockSetAvatar
+}
+
+// NewAvatarRepositoryMock returns a mock for mm_user.AvatarRepository
+func NewAvatarRepositoryMock(t minimock.Tester) *AvatarRepositoryMock {
+	m := &AvatarRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteAvatarMock = mAvatarRepositoryMockDeleteAvatar{mock: m}
+	m.DeleteAvatarMock.callArgs = []*AvatarRepositoryMockDeleteAvatarParams{}
+
+	m.GetAvatarMock = mAvatarRepositoryMockGetAvatar{mock: m}
+	m.GetAvatarMock.callArgs = []*AvatarRepositoryMockGetAvatarParams{}
+
+	m.SetAvatarMock = mAvatarRepositoryMockSetAvatar{mock: m}
+	m.SetAvatarMock.callArgs = []*AvatarRepositoryMockSetAvatarParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAvatarRepositoryMockDeleteAvatar struct {
+	optional           bool
+	mock               *AvatarRepositoryMock
+	defaultExpectation *AvatarRepositoryMockDeleteAvatarExpectation
+	expectations       []*AvatarRepositoryMockDeleteAvatarExpectation
+
+	callArgs []*AvatarRepositoryMockDeleteAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarRepositoryMockDeleteAvatarExpectation specifies expectation struct of the AvatarRepository.DeleteAvatar
+type AvatarRepositoryMockDeleteAvatarExpectation struct {
+	mock               *AvatarRepositoryMock
+	params             *AvatarRepositoryMockDeleteAvatarParams
+	paramPtrs          *AvatarRepositoryMockDeleteAvatarParamPtrs
+	expectationOrigins AvatarRepositoryMockDeleteAvatarExpectationOrigins
+	results            *AvatarRepositoryMockDeleteAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarRepositoryMockDeleteAvatarParams contains parameters of the AvatarRepository.DeleteAvatar
+type AvatarRepositoryMockDeleteAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AvatarRepositoryMockDeleteAvatarParamPtrs contains pointers to parameters of the AvatarRepository.DeleteAvatar
+type AvatarRepositoryMockDeleteAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AvatarRepositoryMockDeleteAvatarResults contains results of the AvatarRepository.DeleteAvatar
+type AvatarRepositoryMockDeleteAvatarResults struct {
+	err error
+}
+
+// AvatarRepositoryMockDeleteAvatarOrigins contains origins of expectations of the AvatarRepository.DeleteAvatar
+type AvatarRepositoryMockDeleteAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Optional() *mAvatarRepositoryMockDeleteAvatar {
+	mmDeleteAvatar.optional = true
+	return mmDeleteAvatar
+}
+
+// Expect sets up expected params for AvatarRepository.DeleteAvatar
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Expect(ctx context.Context, userID uuid.UUID) *mAvatarRepositoryMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &AvatarRepositoryMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAvatar.defaultExpectation.params = &AvatarRepositoryMockDeleteAvatarParams{ctx, userID}
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAvatar.expectations {
+		if minimock.Equal(e.params, mmDeleteAvatar.defaultExpectation.params) {
+			mmDeleteAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarRepository.DeleteAvatar
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) ExpectCtxParam1(ctx context.Context) *mAvatarRepositoryMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &AvatarRepositoryMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.params != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Expect")
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs == nil {
+		mmDeleteAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockDeleteAvatarParamPtrs{}
+	}
+	mmDeleteAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarRepository.DeleteAvatar
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) ExpectUserIDParam2(userID uuid.UUID) *mAvatarRepositoryMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &AvatarRepositoryMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.params != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Expect")
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs == nil {
+		mmDeleteAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockDeleteAvatarParamPtrs{}
+	}
+	mmDeleteAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarRepository.DeleteAvatar
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAvatarRepositoryMockDeleteAvatar {
+	if mmDeleteAvatar.mock.inspectFuncDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("Inspect function is already set for AvatarRepositoryMock.DeleteAvatar")
+	}
+
+	mmDeleteAvatar.mock.inspectFuncDeleteAvatar = f
+
+	return mmDeleteAvatar
+}
+
+// Return sets up results that will be returned by AvatarRepository.DeleteAvatar
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Return(err error) *AvatarRepositoryMock {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &AvatarRepositoryMockDeleteAvatarExpectation{mock: mmDeleteAvatar.mock}
+	}
+	mmDeleteAvatar.defaultExpectation.results = &AvatarRepositoryMockDeleteAvatarResults{err}
+	mmDeleteAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar.mock
+}
+
+// Set uses given function f to mock the AvatarRepository.DeleteAvatar method
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *AvatarRepositoryMock {
+	if mmDeleteAvatar.defaultExpectation != nil {
+		mmDeleteAvatar.mock.t.Fatalf("Default expectation is already set for the AvatarRepository.DeleteAvatar method")
+	}
+
+	if len(mmDeleteAvatar.expectations) > 0 {
+		mmDeleteAvatar.mock.t.Fatalf("Some expectations are already set for the AvatarRepository.DeleteAvatar method")
+	}
+
+	mmDeleteAvatar.mock.funcDeleteAvatar = f
+	mmDeleteAvatar.mock.funcDeleteAvatarOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar.mock
+}
+
+// When sets expectation for the AvatarRepository.DeleteAvatar which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) When(ctx context.Context, userID uuid.UUID) *AvatarRepositoryMockDeleteAvatarExpectation {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("AvatarRepositoryMock.DeleteAvatar mock is already set by Set")
+	}
+
+	expectation := &AvatarRepositoryMockDeleteAvatarExpectation{
+		mock:               mmDeleteAvatar.mock,
+		params:             &AvatarRepositoryMockDeleteAvatarParams{ctx, userID},
+		expectationOrigins: AvatarRepositoryMockDeleteAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAvatar.expectations = append(mmDeleteAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarRepository.DeleteAvatar return parameters for the expectation previously defined by the When method
+func (e *AvatarRepositoryMockDeleteAvatarExpectation) Then(err error) *AvatarRepositoryMock {
+	e.results = &AvatarRepositoryMockDeleteAvatarResults{err}
+	return e.mock
+}
+
+// Times sets number of times AvatarRepository.DeleteAvatar should be invoked
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Times(n uint64) *mAvatarRepositoryMockDeleteAvatar {
+	if n == 0 {
+		mmDeleteAvatar.mock.t.Fatalf("Times of AvatarRepositoryMock.DeleteAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAvatar.expectedInvocations, n)
+	mmDeleteAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar
+}
+
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) invocationsDone() bool {
+	if len(mmDeleteAvatar.expectations) == 0 && mmDeleteAvatar.defaultExpectation == nil && mmDeleteAvatar.mock.funcDeleteAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAvatar.mock.afterDeleteAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAvatar implements mm_user.AvatarRepository
+func (mmDeleteAvatar *AvatarRepositoryMock) DeleteAvatar(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAvatar.beforeDeleteAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAvatar.afterDeleteAvatarCounter, 1)
+
+	mmDeleteAvatar.t.Helper()
+
+	if mmDeleteAvatar.inspectFuncDeleteAvatar != nil {
+		mmDeleteAvatar.inspectFuncDeleteAvatar(ctx, userID)
+	}
+
+	mm_params := AvatarRepositoryMockDeleteAvatarParams{ctx, userID}
+
+	// Record call args
+	mmDeleteAvatar.DeleteAvatarMock.mutex.Lock()
+	mmDeleteAvatar.DeleteAvatarMock.callArgs = append(mmDeleteAvatar.DeleteAvatarMock.callArgs, &mm_params)
+	mmDeleteAvatar.DeleteAvatarMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAvatar.DeleteAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAvatar.DeleteAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarRepositoryMockDeleteAvatarParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAvatar.t.Errorf("AvatarRepositoryMock.DeleteAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteAvatar.t.Errorf("AvatarRepositoryMock.DeleteAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAvatar.t.Errorf("AvatarRepositoryMock.DeleteAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAvatar.t.Fatal("No results are set for the AvatarRepositoryMock.DeleteAvatar")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAvatar.funcDeleteAvatar != nil {
+		return mmDeleteAvatar.funcDeleteAvatar(ctx, userID)
+	}
+	mmDeleteAvatar.t.Fatalf("Unexpected call to AvatarRepositoryMock.DeleteAvatar. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAvatarAfterCounter returns a count of finished AvatarRepositoryMock.DeleteAvatar invocations
+func (mmDeleteAvatar *AvatarRepositoryMock) DeleteAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAvatar.afterDeleteAvatarCounter)
+}
+
+// DeleteAvatarBeforeCounter returns a count of AvatarRepositoryMock.DeleteAvatar invocations
+func (mmDeleteAvatar *AvatarRepositoryMock) DeleteAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAvatar.beforeDeleteAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarRepositoryMock.DeleteAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAvatar *mAvatarRepositoryMockDeleteAvatar) Calls() []*AvatarRepositoryMockDeleteAvatarParams {
+	mmDeleteAvatar.mutex.RLock()
+
+	argCopy := make([]*AvatarRepositoryMockDeleteAvatarParams, len(mmDeleteAvatar.callArgs))
+	copy(argCopy, mmDeleteAvatar.callArgs)
+
+	mmDeleteAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAvatarDone returns true if the count of the DeleteAvatar invocations corresponds
+// the number of defined expectations
+func (m *AvatarRepositoryMock) MinimockDeleteAvatarDone() bool {
+	if m.DeleteAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAvatarMock.invocationsDone()
+}
+
+// MinimockDeleteAvatarInspect logs each unmet expectation
+func (m *AvatarRepositoryMock) MinimockDeleteAvatarInspect() {
+	for _, e := range m.DeleteAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.DeleteAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAvatarCounter := mm_atomic.LoadUint64(&m.afterDeleteAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAvatarMock.defaultExpectation != nil && afterDeleteAvatarCounter < 1 {
+		if m.DeleteAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.DeleteAvatar at\n%s", m.DeleteAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.DeleteAvatar at\n%s with params: %#v", m.DeleteAvatarMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAvatar != nil && afterDeleteAvatarCounter < 1 {
+		m.t.Errorf("Expected call to AvatarRepositoryMock.DeleteAvatar at\n%s", m.funcDeleteAvatarOrigin)
+	}
+
+	if !m.DeleteAvatarMock.invocationsDone() && afterDeleteAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarRepositoryMock.DeleteAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAvatarMock.expectedInvocations), m.DeleteAvatarMock.expectedInvocationsOrigin, afterDeleteAvatarCounter)
+	}
+}
+
+type mAvatarRepositoryMockGetAvatar struct {
+	optional           bool
+	mock               *AvatarRepositoryMock
+	defaultExpectation *AvatarRepositoryMockGetAvatarExpectation
+	expectations       []*AvatarRepositoryMockGetAvatarExpectation
+
+	callArgs []*AvatarRepositoryMockGetAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarRepositoryMockGetAvatarExpectation specifies expectation struct of the AvatarRepository.GetAvatar
+type AvatarRepositoryMockGetAvatarExpectation struct {
+	mock               *AvatarRepositoryMock
+	params             *AvatarRepositoryMockGetAvatarParams
+	paramPtrs          *AvatarRepositoryMockGetAvatarParamPtrs
+	expectationOrigins AvatarRepositoryMockGetAvatarExpectationOrigins
+	results            *AvatarRepositoryMockGetAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarRepositoryMockGetAvatarParams contains parameters of the AvatarRepository.GetAvatar
+type AvatarRepositoryMockGetAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AvatarRepositoryMockGetAvatarParamPtrs contains pointers to parameters of the AvatarRepository.GetAvatar
+type AvatarRepositoryMockGetAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AvatarRepositoryMockGetAvatarResults contains results of the AvatarRepository.GetAvatar
+type AvatarRepositoryMockGetAvatarResults struct {
+	a1  mm_user.Avatar
+	err error
+}
+
+// AvatarRepositoryMockGetAvatarOrigins contains origins of expectations of the AvatarRepository.GetAvatar
+type AvatarRepositoryMockGetAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Optional() *mAvatarRepositoryMockGetAvatar {
+	mmGetAvatar.optional = true
+	return mmGetAvatar
+}
+
+// Expect sets up expected params for AvatarRepository.GetAvatar
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Expect(ctx context.Context, userID uuid.UUID) *mAvatarRepositoryMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &AvatarRepositoryMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmGetAvatar.defaultExpectation.params = &AvatarRepositoryMockGetAvatarParams{ctx, userID}
+	mmGetAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAvatar.expectations {
+		if minimock.Equal(e.params, mmGetAvatar.defaultExpectation.params) {
+			mmGetAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarRepository.GetAvatar
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) ExpectCtxParam1(ctx context.Context) *mAvatarRepositoryMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &AvatarRepositoryMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.params != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Expect")
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs == nil {
+		mmGetAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockGetAvatarParamPtrs{}
+	}
+	mmGetAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarRepository.GetAvatar
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) ExpectUserIDParam2(userID uuid.UUID) *mAvatarRepositoryMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &AvatarRepositoryMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.params != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Expect")
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs == nil {
+		mmGetAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockGetAvatarParamPtrs{}
+	}
+	mmGetAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmGetAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarRepository.GetAvatar
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAvatarRepositoryMockGetAvatar {
+	if mmGetAvatar.mock.inspectFuncGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("Inspect function is already set for AvatarRepositoryMock.GetAvatar")
+	}
+
+	mmGetAvatar.mock.inspectFuncGetAvatar = f
+
+	return mmGetAvatar
+}
+
+// Return sets up results that will be returned by AvatarRepository.GetAvatar
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Return(a1 mm_user.Avatar, err error) *AvatarRepositoryMock {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &AvatarRepositoryMockGetAvatarExpectation{mock: mmGetAvatar.mock}
+	}
+	mmGetAvatar.defaultExpectation.results = &AvatarRepositoryMockGetAvatarResults{a1, err}
+	mmGetAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar.mock
+}
+
+// Set uses given function f to mock the AvatarRepository.GetAvatar method
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Set(f func(ctx context.Context, userID uuid.UUID) (a1 mm_user.Avatar, err error)) *AvatarRepositoryMock {
+	if mmGetAvatar.defaultExpectation != nil {
+		mmGetAvatar.mock.t.Fatalf("Default expectation is already set for the AvatarRepository.GetAvatar method")
+	}
+
+	if len(mmGetAvatar.expectations) > 0 {
+		mmGetAvatar.mock.t.Fatalf("Some expectations are already set for the AvatarRepository.GetAvatar method")
+	}
+
+	mmGetAvatar.mock.funcGetAvatar = f
+	mmGetAvatar.mock.funcGetAvatarOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar.mock
+}
+
+// When sets expectation for the AvatarRepository.GetAvatar which will trigger the result defined by the following
+// Then helper
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) When(ctx context.Context, userID uuid.UUID) *AvatarRepositoryMockGetAvatarExpectation {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("AvatarRepositoryMock.GetAvatar mock is already set by Set")
+	}
+
+	expectation := &AvatarRepositoryMockGetAvatarExpectation{
+		mock:               mmGetAvatar.mock,
+		params:             &AvatarRepositoryMockGetAvatarParams{ctx, userID},
+		expectationOrigins: AvatarRepositoryMockGetAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAvatar.expectations = append(mmGetAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarRepository.GetAvatar return parameters for the expectation previously defined by the When method
+func (e *AvatarRepositoryMockGetAvatarExpectation) Then(a1 mm_user.Avatar, err error) *AvatarRepositoryMock {
+	e.results = &AvatarRepositoryMockGetAvatarResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times AvatarRepository.GetAvatar should be invoked
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Times(n uint64) *mAvatarRepositoryMockGetAvatar {
+	if n == 0 {
+		mmGetAvatar.mock.t.Fatalf("Times of AvatarRepositoryMock.GetAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAvatar.expectedInvocations, n)
+	mmGetAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar
+}
+
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) invocationsDone() bool {
+	if len(mmGetAvatar.expectations) == 0 && mmGetAvatar.defaultExpectation == nil && mmGetAvatar.mock.funcGetAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAvatar.mock.afterGetAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAvatar implements mm_user.AvatarRepository
+func (mmGetAvatar *AvatarRepositoryMock) GetAvatar(ctx context.Context, userID uuid.UUID) (a1 mm_user.Avatar, err error) {
+	mm_atomic.AddUint64(&mmGetAvatar.beforeGetAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAvatar.afterGetAvatarCounter, 1)
+
+	mmGetAvatar.t.Helper()
+
+	if mmGetAvatar.inspectFuncGetAvatar != nil {
+		mmGetAvatar.inspectFuncGetAvatar(ctx, userID)
+	}
+
+	mm_params := AvatarRepositoryMockGetAvatarParams{ctx, userID}
+
+	// Record call args
+	mmGetAvatar.GetAvatarMock.mutex.Lock()
+	mmGetAvatar.GetAvatarMock.callArgs = append(mmGetAvatar.GetAvatarMock.callArgs, &mm_params)
+	mmGetAvatar.GetAvatarMock.mutex.Unlock()
+
+	for _, e := range mmGetAvatar.GetAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetAvatar.GetAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAvatar.GetAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAvatar.GetAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAvatar.GetAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarRepositoryMockGetAvatarParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAvatar.t.Errorf("AvatarRepositoryMock.GetAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetAvatar.t.Errorf("AvatarRepositoryMock.GetAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAvatar.t.Errorf("AvatarRepositoryMock.GetAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAvatar.GetAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAvatar.t.Fatal("No results are set for the AvatarRepositoryMock.GetAvatar")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetAvatar.funcGetAvatar != nil {
+		return mmGetAvatar.funcGetAvatar(ctx, userID)
+	}
+	mmGetAvatar.t.Fatalf("Unexpected call to AvatarRepositoryMock.GetAvatar. %v %v", ctx, userID)
+	return
+}
+
+// GetAvatarAfterCounter returns a count of finished AvatarRepositoryMock.GetAvatar invocations
+func (mmGetAvatar *AvatarRepositoryMock) GetAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAvatar.afterGetAvatarCounter)
+}
+
+// GetAvatarBeforeCounter returns a count of AvatarRepositoryMock.GetAvatar invocations
+func (mmGetAvatar *AvatarRepositoryMock) GetAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAvatar.beforeGetAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarRepositoryMock.GetAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAvatar *mAvatarRepositoryMockGetAvatar) Calls() []*AvatarRepositoryMockGetAvatarParams {
+	mmGetAvatar.mutex.RLock()
+
+	argCopy := make([]*AvatarRepositoryMockGetAvatarParams, len(mmGetAvatar.callArgs))
+	copy(argCopy, mmGetAvatar.callArgs)
+
+	mmGetAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAvatarDone returns true if the count of the GetAvatar invocations corresponds
+// the number of defined expectations
+func (m *AvatarRepositoryMock) MinimockGetAvatarDone() bool {
+	if m.GetAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAvatarMock.invocationsDone()
+}
+
+// MinimockGetAvatarInspect logs each unmet expectation
+func (m *AvatarRepositoryMock) MinimockGetAvatarInspect() {
+	for _, e := range m.GetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.GetAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAvatarCounter := mm_atomic.LoadUint64(&m.afterGetAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAvatarMock.defaultExpectation != nil && afterGetAvatarCounter < 1 {
+		if m.GetAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.GetAvatar at\n%s", m.GetAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.GetAvatar at\n%s with params: %#v", m.GetAvatarMock.defaultExpectation.expectationOrigins.origin, *m.GetAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAvatar != nil && afterGetAvatarCounter < 1 {
+		m.t.Errorf("Expected call to AvatarRepositoryMock.GetAvatar at\n%s", m.funcGetAvatarOrigin)
+	}
+
+	if !m.GetAvatarMock.invocationsDone() && afterGetAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarRepositoryMock.GetAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAvatarMock.expectedInvocations), m.GetAvatarMock.expectedInvocationsOrigin, afterGetAvatarCounter)
+	}
+}
+
+type mAvatarRepositoryMockSetAvatar struct {
+	optional           bool
+	mock               *AvatarRepositoryMock
+	defaultExpectation *AvatarRepositoryMockSetAvatarExpectation
+	expectations       []*AvatarRepositoryMockSetAvatarExpectation
+
+	callArgs []*AvatarRepositoryMockSetAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarRepositoryMockSetAvatarExpectation specifies expectation struct of the AvatarRepository.SetAvatar
+type AvatarRepositoryMockSetAvatarExpectation struct {
+	mock               *AvatarRepositoryMock
+	params             *AvatarRepositoryMockSetAvatarParams
+	paramPtrs          *AvatarRepositoryMockSetAvatarParamPtrs
+	expectationOrigins AvatarRepositoryMockSetAvatarExpectationOrigins
+	results            *AvatarRepositoryMockSetAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarRepositoryMockSetAvatarParams contains parameters of the AvatarRepository.SetAvatar
+type AvatarRepositoryMockSetAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	avatar mm_user.Avatar
+}
+
+// AvatarRepositoryMockSetAvatarParamPtrs contains pointers to parameters of the AvatarRepository.SetAvatar
+type AvatarRepositoryMockSetAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	avatar *mm_user.Avatar
+}
+
+// AvatarRepositoryMockSetAvatarResults contains results of the AvatarRepository.SetAvatar
+type AvatarRepositoryMockSetAvatarResults struct {
+	err error
+}
+
+// AvatarRepositoryMockSetAvatarOrigins contains origins of expectations of the AvatarRepository.SetAvatar
+type AvatarRepositoryMockSetAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originAvatar string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Optional() *mAvatarRepositoryMockSetAvatar {
+	mmSetAvatar.optional = true
+	return mmSetAvatar
+}
+
+// Expect sets up expected params for AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Expect(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar) *mAvatarRepositoryMockSetAvatar {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	if mmSetAvatar.defaultExpectation == nil {
+		mmSetAvatar.defaultExpectation = &AvatarRepositoryMockSetAvatarExpectation{}
+	}
+
+	if mmSetAvatar.defaultExpectation.paramPtrs != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmSetAvatar.defaultExpectation.params = &AvatarRepositoryMockSetAvatarParams{ctx, userID, avatar}
+	mmSetAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetAvatar.expectations {
+		if minimock.Equal(e.params, mmSetAvatar.defaultExpectation.params) {
+			mmSetAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmSetAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) ExpectCtxParam1(ctx context.Context) *mAvatarRepositoryMockSetAvatar {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	if mmSetAvatar.defaultExpectation == nil {
+		mmSetAvatar.defaultExpectation = &AvatarRepositoryMockSetAvatarExpectation{}
+	}
+
+	if mmSetAvatar.defaultExpectation.params != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Expect")
+	}
+
+	if mmSetAvatar.defaultExpectation.paramPtrs == nil {
+		mmSetAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockSetAvatarParamPtrs{}
+	}
+	mmSetAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) ExpectUserIDParam2(userID uuid.UUID) *mAvatarRepositoryMockSetAvatar {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	if mmSetAvatar.defaultExpectation == nil {
+		mmSetAvatar.defaultExpectation = &AvatarRepositoryMockSetAvatarExpectation{}
+	}
+
+	if mmSetAvatar.defaultExpectation.params != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Expect")
+	}
+
+	if mmSetAvatar.defaultExpectation.paramPtrs == nil {
+		mmSetAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockSetAvatarParamPtrs{}
+	}
+	mmSetAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmSetAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetAvatar
+}
+
+// ExpectAvatarParam3 sets up expected param avatar for AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) ExpectAvatarParam3(avatar mm_user.Avatar) *mAvatarRepositoryMockSetAvatar {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	if mmSetAvatar.defaultExpectation == nil {
+		mmSetAvatar.defaultExpectation = &AvatarRepositoryMockSetAvatarExpectation{}
+	}
+
+	if mmSetAvatar.defaultExpectation.params != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Expect")
+	}
+
+	if mmSetAvatar.defaultExpectation.paramPtrs == nil {
+		mmSetAvatar.defaultExpectation.paramPtrs = &AvatarRepositoryMockSetAvatarParamPtrs{}
+	}
+	mmSetAvatar.defaultExpectation.paramPtrs.avatar = &avatar
+	mmSetAvatar.defaultExpectation.expectationOrigins.originAvatar = minimock.CallerInfo(1)
+
+	return mmSetAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar)) *mAvatarRepositoryMockSetAvatar {
+	if mmSetAvatar.mock.inspectFuncSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("Inspect function is already set for AvatarRepositoryMock.SetAvatar")
+	}
+
+	mmSetAvatar.mock.inspectFuncSetAvatar = f
+
+	return mmSetAvatar
+}
+
+// Return sets up results that will be returned by AvatarRepository.SetAvatar
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Return(err error) *AvatarRepositoryMock {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	if mmSetAvatar.defaultExpectation == nil {
+		mmSetAvatar.defaultExpectation = &AvatarRepositoryMockSetAvatarExpectation{mock: mmSetAvatar.mock}
+	}
+	mmSetAvatar.defaultExpectation.results = &AvatarRepositoryMockSetAvatarResults{err}
+	mmSetAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetAvatar.mock
+}
+
+// Set uses given function f to mock the AvatarRepository.SetAvatar method
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Set(f func(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar) (err error)) *AvatarRepositoryMock {
+	if mmSetAvatar.defaultExpectation != nil {
+		mmSetAvatar.mock.t.Fatalf("Default expectation is already set for the AvatarRepository.SetAvatar method")
+	}
+
+	if len(mmSetAvatar.expectations) > 0 {
+		mmSetAvatar.mock.t.Fatalf("Some expectations are already set for the AvatarRepository.SetAvatar method")
+	}
+
+	mmSetAvatar.mock.funcSetAvatar = f
+	mmSetAvatar.mock.funcSetAvatarOrigin = minimock.CallerInfo(1)
+	return mmSetAvatar.mock
+}
+
+// When sets expectation for the AvatarRepository.SetAvatar which will trigger the result defined by the following
+// Then helper
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) When(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar) *AvatarRepositoryMockSetAvatarExpectation {
+	if mmSetAvatar.mock.funcSetAvatar != nil {
+		mmSetAvatar.mock.t.Fatalf("AvatarRepositoryMock.SetAvatar mock is already set by Set")
+	}
+
+	expectation := &AvatarRepositoryMockSetAvatarExpectation{
+		mock:               mmSetAvatar.mock,
+		params:             &AvatarRepositoryMockSetAvatarParams{ctx, userID, avatar},
+		expectationOrigins: AvatarRepositoryMockSetAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetAvatar.expectations = append(mmSetAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarRepository.SetAvatar return parameters for the expectation previously defined by the When method
+func (e *AvatarRepositoryMockSetAvatarExpectation) Then(err error) *AvatarRepositoryMock {
+	e.results = &AvatarRepositoryMockSetAvatarResults{err}
+	return e.mock
+}
+
+// Times sets number of times AvatarRepository.SetAvatar should be invoked
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Times(n uint64) *mAvatarRepositoryMockSetAvatar {
+	if n == 0 {
+		mmSetAvatar.mock.t.Fatalf("Times of AvatarRepositoryMock.SetAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetAvatar.expectedInvocations, n)
+	mmSetAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetAvatar
+}
+
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) invocationsDone() bool {
+	if len(mmSetAvatar.expectations) == 0 && mmSetAvatar.defaultExpectation == nil && mmSetAvatar.mock.funcSetAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetAvatar.mock.afterSetAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetAvatar implements mm_user.AvatarRepository
+func (mmSetAvatar *AvatarRepositoryMock) SetAvatar(ctx context.Context, userID uuid.UUID, avatar mm_user.Avatar) (err error) {
+	mm_atomic.AddUint64(&mmSetAvatar.beforeSetAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetAvatar.afterSetAvatarCounter, 1)
+
+	mmSetAvatar.t.Helper()
+
+	if mmSetAvatar.inspectFuncSetAvatar != nil {
+		mmSetAvatar.inspectFuncSetAvatar(ctx, userID, avatar)
+	}
+
+	mm_params := AvatarRepositoryMockSetAvatarParams{ctx, userID, avatar}
+
+	// Record call args
+	mmSetAvatar.SetAvatarMock.mutex.Lock()
+	mmSetAvatar.SetAvatarMock.callArgs = append(mmSetAvatar.SetAvatarMock.callArgs, &mm_params)
+	mmSetAvatar.SetAvatarMock.mutex.Unlock()
+
+	for _, e := range mmSetAvatar.SetAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetAvatar.SetAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetAvatar.SetAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetAvatar.SetAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmSetAvatar.SetAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarRepositoryMockSetAvatarParams{ctx, userID, avatar}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetAvatar.t.Errorf("AvatarRepositoryMock.SetAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAvatar.SetAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetAvatar.t.Errorf("AvatarRepositoryMock.SetAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAvatar.SetAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.avatar != nil && !minimock.Equal(*mm_want_ptrs.avatar, mm_got.avatar) {
+				mmSetAvatar.t.Errorf("AvatarRepositoryMock.SetAvatar got unexpected parameter avatar, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAvatar.SetAvatarMock.defaultExpectation.expectationOrigins.originAvatar, *mm_want_ptrs.avatar, mm_got.avatar, minimock.Diff(*mm_want_ptrs.avatar, mm_got.avatar))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetAvatar.t.Errorf("AvatarRepositoryMock.SetAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetAvatar.SetAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetAvatar.SetAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetAvatar.t.Fatal("No results are set for the AvatarRepositoryMock.SetAvatar")
+		}
+		return (*mm_results).err
+	}
+	if mmSetAvatar.funcSetAvatar != nil {
+		return mmSetAvatar.funcSetAvatar(ctx, userID, avatar)
+	}
+	mmSetAvatar.t.Fatalf("Unexpected call to AvatarRepositoryMock.SetAvatar. %v %v %v", ctx, userID, avatar)
+	return
+}
+
+// SetAvatarAfterCounter returns a count of finished AvatarRepositoryMock.SetAvatar invocations
+func (mmSetAvatar *AvatarRepositoryMock) SetAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAvatar.afterSetAvatarCounter)
+}
+
+// SetAvatarBeforeCounter returns a count of AvatarRepositoryMock.SetAvatar invocations
+func (mmSetAvatar *AvatarRepositoryMock) SetAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAvatar.beforeSetAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarRepositoryMock.SetAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetAvatar *mAvatarRepositoryMockSetAvatar) Calls() []*AvatarRepositoryMockSetAvatarParams {
+	mmSetAvatar.mutex.RLock()
+
+	argCopy := make([]*AvatarRepositoryMockSetAvatarParams, len(mmSetAvatar.callArgs))
+	copy(argCopy, mmSetAvatar.callArgs)
+
+	mmSetAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetAvatarDone returns true if the count of the SetAvatar invocations corresponds
+// the number of defined expectations
+func (m *AvatarRepositoryMock) MinimockSetAvatarDone() bool {
+	if m.SetAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetAvatarMock.invocationsDone()
+}
+
+// MinimockSetAvatarInspect logs each unmet expectation
+func (m *AvatarRepositoryMock) MinimockSetAvatarInspect() {
+	for _, e := range m.SetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.SetAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetAvatarCounter := mm_atomic.LoadUint64(&m.afterSetAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetAvatarMock.defaultExpectation != nil && afterSetAvatarCounter < 1 {
+		if m.SetAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.SetAvatar at\n%s", m.SetAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarRepositoryMock.SetAvatar at\n%s with params: %#v", m.SetAvatarMock.defaultExpectation.expectationOrigins.origin, *m.SetAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetAvatar != nil && afterSetAvatarCounter < 1 {
+		m.t.Errorf("Expected call to AvatarRepositoryMock.SetAvatar at\n%s", m.funcSetAvatarOrigin)
+	}
+
+	if !m.SetAvatarMock.invocationsDone() && afterSetAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarRepositoryMock.SetAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetAvatarMock.expectedInvocations), m.SetAvatarMock.expectedInvocationsOrigin, afterSetAvatarCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AvatarRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteAvatarInspect()
+
+			m.MinimockGetAvatarInspect()
+
+			m.MinimockSetAvatarInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AvatarRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AvatarRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteAvatarDone() &&
+		m.MinimockGetAvatarDone() &&
+		m.MinimockSetAvatarDone()
+}
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -9,6 +9,7 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -17,12 +18,19 @@ type PasswordHasherMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcHashPassword          func(password []byte, cost int) (ba1 []byte, err error)
+	funcHashPassword          func(password []byte, params secure.HashParams) (ba1 []byte, err error)
 	funcHashPasswordOrigin    string
-	inspectFuncHashPassword   func(password []byte, cost int)
+	inspectFuncHashPassword   func(password []byte, params secure.HashParams)
 	afterHashPasswordCounter  uint64
 	beforeHashPasswordCounter uint64
 	HashPasswordMock          mPasswordHasherMockHashPassword
+
+	funcNeedsRehash          func(hash []byte, params secure.HashParams) (b1 bool)
+	funcNeedsRehashOrigin    string
+	inspectFuncNeedsRehash   func(hash []byte, params secure.HashParams)
+	afterNeedsRehashCounter  uint64
+	beforeNeedsRehashCounter uint64
+	NeedsRehashMock          mPasswordHasherMockNeedsRehash
 }
 
 // NewPasswordHasherMock returns a mock for mm_user.PasswordHasher
@@ -36,6 +44,9 @@ func NewPasswordHasherMock(t minimock.Tester) *PasswordHasherMock {
 	m.HashPasswordMock = mPasswordHasherMockHashPassword{mock: m}
 	m.HashPasswordMock.callArgs = []*PasswordHasherMockHashPasswordParams{}
 
+	m.NeedsRehashMock = mPasswordHasherMockNeedsRehash{mock: m}
+	m.NeedsRehashMock.callArgs = []*PasswordHasherMockNeedsRehashParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -68,13 +79,13 @@ type PasswordHasherMockHashPasswordExpectation struct {
 // PasswordHasherMockHashPasswordParams contains parameters of the PasswordHasher.HashPassword
 type PasswordHasherMockHashPasswordParams struct {
 	password []byte
-	cost     int
+	params   secure.HashParams
 }
 
 // PasswordHasherMockHashPasswordParamPtrs contains pointers to parameters of the PasswordHasher.HashPassword
 type PasswordHasherMockHashPasswordParamPtrs struct {
 	password *[]byte
-	cost     *int
+	params   *secure.HashParams
 }
 
 // PasswordHasherMockHashPasswordResults contains results of the PasswordHasher.HashPassword
@@ -87,7 +98,7 @@ type PasswordHasherMockHashPasswordResults struct {
 type PasswordHasherMockHashPasswordExpectationOrigins struct {
 	origin         string
 	originPassword string
-	originCost     string
+	originParams   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -101,7 +112,7 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) Optional() *mPasswordHash
 }
 
 // Expect sets up expected params for PasswordHasher.HashPassword
-func (mmHashPassword *mPasswordHasherMockHashPassword) Expect(password []byte, cost int) *mPasswordHasherMockHashPassword {
+func (mmHashPassword *mPasswordHasherMockHashPassword) Expect(password []byte, params secure.HashParams) *mPasswordHasherMockHashPassword {
 	if mmHashPassword.mock.funcHashPassword != nil {
 		mmHashPassword.mock.t.Fatalf("PasswordHasherMock.HashPassword mock is already set by Set")
 	}
@@ -114,7 +125,7 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) Expect(password []byte, c
 		mmHashPassword.mock.t.Fatalf("PasswordHasherMock.HashPassword mock is already set by ExpectParams functions")
 	}
 
-	mmHashPassword.defaultExpectation.params = &PasswordHasherMockHashPasswordParams{password, cost}
+	mmHashPassword.defaultExpectation.params = &PasswordHasherMockHashPasswordParams{password, params}
 	mmHashPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmHashPassword.expectations {
 		if minimock.Equal(e.params, mmHashPassword.defaultExpectation.params) {
@@ -148,8 +159,8 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) ExpectPasswordParam1(pass
 	return mmHashPassword
 }
 
-// ExpectCostParam2 sets up expected param cost for PasswordHasher.HashPassword
-func (mmHashPassword *mPasswordHasherMockHashPassword) ExpectCostParam2(cost int) *mPasswordHasherMockHashPassword {
+// ExpectParamsParam2 sets up expected param params for PasswordHasher.HashPassword
+func (mmHashPassword *mPasswordHasherMockHashPassword) ExpectParamsParam2(params secure.HashParams) *mPasswordHasherMockHashPassword {
 	if mmHashPassword.mock.funcHashPassword != nil {
 		mmHashPassword.mock.t.Fatalf("PasswordHasherMock.HashPassword mock is already set by Set")
 	}
@@ -165,14 +176,14 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) ExpectCostParam2(cost int
 	if mmHashPassword.defaultExpectation.paramPtrs == nil {
 		mmHashPassword.defaultExpectation.paramPtrs = &PasswordHasherMockHashPasswordParamPtrs{}
 	}
-	mmHashPassword.defaultExpectation.paramPtrs.cost = &cost
-	mmHashPassword.defaultExpectation.expectationOrigins.originCost = minimock.CallerInfo(1)
+	mmHashPassword.defaultExpectation.paramPtrs.params = &params
+	mmHashPassword.defaultExpectation.expectationOrigins.originParams = minimock.CallerInfo(1)
 
 	return mmHashPassword
 }
 
 // Inspect accepts an inspector function that has same arguments as the PasswordHasher.HashPassword
-func (mmHashPassword *mPasswordHasherMockHashPassword) Inspect(f func(password []byte, cost int)) *mPasswordHasherMockHashPassword {
+func (mmHashPassword *mPasswordHasherMockHashPassword) Inspect(f func(password []byte, params secure.HashParams)) *mPasswordHasherMockHashPassword {
 	if mmHashPassword.mock.inspectFuncHashPassword != nil {
 		mmHashPassword.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.HashPassword")
 	}
@@ -197,7 +208,7 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) Return(ba1 []byte, err er
 }
 
 // Set uses given function f to mock the PasswordHasher.HashPassword method
-func (mmHashPassword *mPasswordHasherMockHashPassword) Set(f func(password []byte, cost int) (ba1 []byte, err error)) *PasswordHasherMock {
+func (mmHashPassword *mPasswordHasherMockHashPassword) Set(f func(password []byte, params secure.HashParams) (ba1 []byte, err error)) *PasswordHasherMock {
 	if mmHashPassword.defaultExpectation != nil {
 		mmHashPassword.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.HashPassword method")
 	}
@@ -213,14 +224,14 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) Set(f func(password []byt
 
 // When sets expectation for the PasswordHasher.HashPassword which will trigger the result defined by the following
 // Then helper
-func (mmHashPassword *mPasswordHasherMockHashPassword) When(password []byte, cost int) *PasswordHasherMockHashPasswordExpectation {
+func (mmHashPassword *mPasswordHasherMockHashPassword) When(password []byte, params secure.HashParams) *PasswordHasherMockHashPasswordExpectation {
 	if mmHashPassword.mock.funcHashPassword != nil {
 		mmHashPassword.mock.t.Fatalf("PasswordHasherMock.HashPassword mock is already set by Set")
 	}
 
 	expectation := &PasswordHasherMockHashPasswordExpectation{
 		mock:               mmHashPassword.mock,
-		params:             &PasswordHasherMockHashPasswordParams{password, cost},
+		params:             &PasswordHasherMockHashPasswordParams{password, params},
 		expectationOrigins: PasswordHasherMockHashPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmHashPassword.expectations = append(mmHashPassword.expectations, expectation)
@@ -255,17 +266,17 @@ func (mmHashPassword *mPasswordHasherMockHashPassword) invocationsDone() bool {
 }
 
 // HashPassword implements mm_user.PasswordHasher
-func (mmHashPassword *PasswordHasherMock) HashPassword(password []byte, cost int) (ba1 []byte, err error) {
+func (mmHashPassword *PasswordHasherMock) HashPassword(password []byte, params secure.HashParams) (ba1 []byte, err error) {
 	mm_atomic.AddUint64(&mmHashPassword.beforeHashPasswordCounter, 1)
 	defer mm_atomic.AddUint64(&mmHashPassword.afterHashPasswordCounter, 1)
 
 	mmHashPassword.t.Helper()
 
 	if mmHashPassword.inspectFuncHashPassword != nil {
-		mmHashPassword.inspectFuncHashPassword(password, cost)
+		mmHashPassword.inspectFuncHashPassword(password, params)
 	}
 
-	mm_params := PasswordHasherMockHashPasswordParams{password, cost}
+	mm_params := PasswordHasherMockHashPasswordParams{password, params}
 
 	// Record call args
 	mmHashPassword.HashPasswordMock.mutex.Lock()
@@ -284,7 +295,7 @@ func (mmHashPassword *PasswordHasherMock) HashPassword(password []byte, cost int
 		mm_want := mmHashPassword.HashPasswordMock.defaultExpectation.params
 		mm_want_ptrs := mmHashPassword.HashPasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := PasswordHasherMockHashPasswordParams{password, cost}
+		mm_got := PasswordHasherMockHashPasswordParams{password, params}
 
 		if mm_want_ptrs != nil {
 
@@ -293,9 +304,9 @@ func (mmHashPassword *PasswordHasherMock) HashPassword(password []byte, cost int
 					mmHashPassword.HashPasswordMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
 			}
 
-			if mm_want_ptrs.cost != nil && !minimock.Equal(*mm_want_ptrs.cost, mm_got.cost) {
-				mmHashPassword.t.Errorf("PasswordHasherMock.HashPassword got unexpected parameter cost, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmHashPassword.HashPasswordMock.defaultExpectation.expectationOrigins.originCost, *mm_want_ptrs.cost, mm_got.cost, minimock.Diff(*mm_want_ptrs.cost, mm_got.cost))
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmHashPassword.t.Errorf("PasswordHasherMock.HashPassword got unexpected parameter params, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHashPassword.HashPasswordMock.defaultExpectation.expectationOrigins.originParams, *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -310,9 +321,9 @@ func (mmHashPassword *PasswordHasherMock) HashPassword(password []byte, cost int
 		return (*mm_results).ba1, (*mm_results).err
 	}
 	if mmHashPassword.funcHashPassword != nil {
-		return mmHashPassword.funcHashPassword(password, cost)
+		return mmHashPassword.funcHashPassword(password, params)
 	}
-	mmHashPassword.t.Fatalf("Unexpected call to PasswordHasherMock.HashPassword. %v %v", password, cost)
+	mmHashPassword.t.Fatalf("Unexpected call to PasswordHasherMock.HashPassword. %v %v", password, params)
 	return
 }
 
@@ -384,11 +395,355 @@ func (m *PasswordHasherMock) MinimockHashPasswordInspect() {
 	}
 }
 
+type mPasswordHasherMockNeedsRehash struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockNeedsRehashExpectation
+	expectations       []*PasswordHasherMockNeedsRehashExpectation
+
+	callArgs []*PasswordHasherMockNeedsRehashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordHasherMockNeedsRehashExpectation specifies expectation struct of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashExpectation struct {
+	mock               *PasswordHasherMock
+	params             *PasswordHasherMockNeedsRehashParams
+	paramPtrs          *PasswordHasherMockNeedsRehashParamPtrs
+	expectationOrigins PasswordHasherMockNeedsRehashExpectationOrigins
+	results            *PasswordHasherMockNeedsRehashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordHasherMockNeedsRehashParams contains parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParams struct {
+	hash   []byte
+	params secure.HashParams
+}
+
+// PasswordHasherMockNeedsRehashParamPtrs contains pointers to parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParamPtrs struct {
+	hash   *[]byte
+	params *secure.HashParams
+}
+
+// PasswordHasherMockNeedsRehashResults contains results of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashResults struct {
+	b1 bool
+}
+
+// PasswordHasherMockNeedsRehashOrigins contains origins of expectations of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashExpectationOrigins struct {
+	origin       string
+	originHash   string
+	originParams string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Optional() *mPasswordHasherMockNeedsRehash {
+	mmNeedsRehash.optional = true
+	return mmNeedsRehash
+}
+
+// Expect sets up expected params for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Expect(hash []byte, params secure.HashParams) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by ExpectParams functions")
+	}
+
+	mmNeedsRehash.defaultExpectation.params = &PasswordHasherMockNeedsRehashParams{hash, params}
+	mmNeedsRehash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNeedsRehash.expectations {
+		if minimock.Equal(e.params, mmNeedsRehash.defaultExpectation.params) {
+			mmNeedsRehash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNeedsRehash.defaultExpectation.params)
+		}
+	}
+
+	return mmNeedsRehash
+}
+
+// ExpectHashParam1 sets up expected param hash for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) ExpectHashParam1(hash []byte) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.params != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Expect")
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs == nil {
+		mmNeedsRehash.defaultExpectation.paramPtrs = &PasswordHasherMockNeedsRehashParamPtrs{}
+	}
+	mmNeedsRehash.defaultExpectation.paramPtrs.hash = &hash
+	mmNeedsRehash.defaultExpectation.expectationOrigins.originHash = minimock.CallerInfo(1)
+
+	return mmNeedsRehash
+}
+
+// ExpectParamsParam2 sets up expected param params for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) ExpectParamsParam2(params secure.HashParams) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.params != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Expect")
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs == nil {
+		mmNeedsRehash.defaultExpectation.paramPtrs = &PasswordHasherMockNeedsRehashParamPtrs{}
+	}
+	mmNeedsRehash.defaultExpectation.paramPtrs.params = &params
+	mmNeedsRehash.defaultExpectation.expectationOrigins.originParams = minimock.CallerInfo(1)
+
+	return mmNeedsRehash
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Inspect(f func(hash []byte, params secure.HashParams)) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.NeedsRehash")
+	}
+
+	mmNeedsRehash.mock.inspectFuncNeedsRehash = f
+
+	return mmNeedsRehash
+}
+
+// Return sets up results that will be returned by PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Return(b1 bool) *PasswordHasherMock {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{mock: mmNeedsRehash.mock}
+	}
+	mmNeedsRehash.defaultExpectation.results = &PasswordHasherMockNeedsRehashResults{b1}
+	mmNeedsRehash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.NeedsRehash method
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Set(f func(hash []byte, params secure.HashParams) (b1 bool)) *PasswordHasherMock {
+	if mmNeedsRehash.defaultExpectation != nil {
+		mmNeedsRehash.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	if len(mmNeedsRehash.expectations) > 0 {
+		mmNeedsRehash.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	mmNeedsRehash.mock.funcNeedsRehash = f
+	mmNeedsRehash.mock.funcNeedsRehashOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash.mock
+}
+
+// When sets expectation for the PasswordHasher.NeedsRehash which will trigger the result defined by the following
+// Then helper
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) When(hash []byte, params secure.HashParams) *PasswordHasherMockNeedsRehashExpectation {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockNeedsRehashExpectation{
+		mock:               mmNeedsRehash.mock,
+		params:             &PasswordHasherMockNeedsRehashParams{hash, params},
+		expectationOrigins: PasswordHasherMockNeedsRehashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNeedsRehash.expectations = append(mmNeedsRehash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.NeedsRehash return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockNeedsRehashExpectation) Then(b1 bool) *PasswordHasherMock {
+	e.results = &PasswordHasherMockNeedsRehashResults{b1}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.NeedsRehash should be invoked
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Times(n uint64) *mPasswordHasherMockNeedsRehash {
+	if n == 0 {
+		mmNeedsRehash.mock.t.Fatalf("Times of PasswordHasherMock.NeedsRehash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNeedsRehash.expectedInvocations, n)
+	mmNeedsRehash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNeedsRehash
+}
+
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) invocationsDone() bool {
+	if len(mmNeedsRehash.expectations) == 0 && mmNeedsRehash.defaultExpectation == nil && mmNeedsRehash.mock.funcNeedsRehash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.mock.afterNeedsRehashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NeedsRehash implements mm_user.PasswordHasher
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehash(hash []byte, params secure.HashParams) (b1 bool) {
+	mm_atomic.AddUint64(&mmNeedsRehash.beforeNeedsRehashCounter, 1)
+	defer mm_atomic.AddUint64(&mmNeedsRehash.afterNeedsRehashCounter, 1)
+
+	mmNeedsRehash.t.Helper()
+
+	if mmNeedsRehash.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.inspectFuncNeedsRehash(hash, params)
+	}
+
+	mm_params := PasswordHasherMockNeedsRehashParams{hash, params}
+
+	// Record call args
+	mmNeedsRehash.NeedsRehashMock.mutex.Lock()
+	mmNeedsRehash.NeedsRehashMock.callArgs = append(mmNeedsRehash.NeedsRehashMock.callArgs, &mm_params)
+	mmNeedsRehash.NeedsRehashMock.mutex.Unlock()
+
+	for _, e := range mmNeedsRehash.NeedsRehashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmNeedsRehash.NeedsRehashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNeedsRehash.NeedsRehashMock.defaultExpectation.Counter, 1)
+		mm_want := mmNeedsRehash.NeedsRehashMock.defaultExpectation.params
+		mm_want_ptrs := mmNeedsRehash.NeedsRehashMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockNeedsRehashParams{hash, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.hash != nil && !minimock.Equal(*mm_want_ptrs.hash, mm_got.hash) {
+				mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameter hash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNeedsRehash.NeedsRehashMock.defaultExpectation.expectationOrigins.originHash, *mm_want_ptrs.hash, mm_got.hash, minimock.Diff(*mm_want_ptrs.hash, mm_got.hash))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameter params, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNeedsRehash.NeedsRehashMock.defaultExpectation.expectationOrigins.originParams, *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNeedsRehash.NeedsRehashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNeedsRehash.NeedsRehashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNeedsRehash.t.Fatal("No results are set for the PasswordHasherMock.NeedsRehash")
+		}
+		return (*mm_results).b1
+	}
+	if mmNeedsRehash.funcNeedsRehash != nil {
+		return mmNeedsRehash.funcNeedsRehash(hash, params)
+	}
+	mmNeedsRehash.t.Fatalf("Unexpected call to PasswordHasherMock.NeedsRehash. %v %v", hash, params)
+	return
+}
+
+// NeedsRehashAfterCounter returns a count of finished PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.afterNeedsRehashCounter)
+}
+
+// NeedsRehashBeforeCounter returns a count of PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.beforeNeedsRehashCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.NeedsRehash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Calls() []*PasswordHasherMockNeedsRehashParams {
+	mmNeedsRehash.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockNeedsRehashParams, len(mmNeedsRehash.callArgs))
+	copy(argCopy, mmNeedsRehash.callArgs)
+
+	mmNeedsRehash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNeedsRehashDone returns true if the count of the NeedsRehash invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockNeedsRehashDone() bool {
+	if m.NeedsRehashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NeedsRehashMock.invocationsDone()
+}
+
+// MinimockNeedsRehashInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockNeedsRehashInspect() {
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNeedsRehashCounter := mm_atomic.LoadUint64(&m.afterNeedsRehashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NeedsRehashMock.defaultExpectation != nil && afterNeedsRehashCounter < 1 {
+		if m.NeedsRehashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s", m.NeedsRehashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s with params: %#v", m.NeedsRehashMock.defaultExpectation.expectationOrigins.origin, *m.NeedsRehashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNeedsRehash != nil && afterNeedsRehashCounter < 1 {
+		m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash at\n%s", m.funcNeedsRehashOrigin)
+	}
+
+	if !m.NeedsRehashMock.invocationsDone() && afterNeedsRehashCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.NeedsRehash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NeedsRehashMock.expectedInvocations), m.NeedsRehashMock.expectedInvocationsOrigin, afterNeedsRehashCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *PasswordHasherMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockHashPasswordInspect()
+
+			m.MinimockNeedsRehashInspect()
 		}
 	})
 }
@@ -412,5 +767,6 @@ func (m *PasswordHasherMock) MinimockWait(timeout mm_time.Duration) {
 func (m *PasswordHasherMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockHashPasswordDone()
+		m.MinimockHashPasswordDone() &&
+		m.MinimockNeedsRehashDone()
 }
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -20,6 +20,13 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAnonymizeUser          func(ctx context.Context, id uuid.UUID, email string, name string) (err error)
+	funcAnonymizeUserOrigin    string
+	inspectFuncAnonymizeUser   func(ctx context.Context, id uuid.UUID, email string, name string)
+	afterAnonymizeUserCounter  uint64
+	beforeAnonymizeUserCounter uint64
+	AnonymizeUserMock          mRepositoryMockAnonymizeUser
+
 	funcChangePassword          func(ctx context.Context, id uuid.UUID, newPasswordHash string) (err error)
 	funcChangePasswordOrigin    string
 	inspectFuncChangePassword   func(ctx context.Context, id uuid.UUID, newPasswordHash string)
@@ -41,13 +48,20 @@ type RepositoryMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mRepositoryMockDeleteUser
 
-	funcGetAllUsers          func(ctx context.Context) (ua1 []mm_user.User, err error)
+	funcGetAllUsers          func(ctx context.Context, opts mm_user.ListUsersOptions) (u1 mm_user.UsersPage, err error)
 	funcGetAllUsersOrigin    string
-	inspectFuncGetAllUsers   func(ctx context.Context)
+	inspectFuncGetAllUsers   func(ctx context.Context, opts mm_user.ListUsersOptions)
 	afterGetAllUsersCounter  uint64
 	beforeGetAllUsersCounter uint64
 	GetAllUsersMock          mRepositoryMockGetAllUsers
 
+	funcGetPreferences          func(ctx context.Context, userID uuid.UUID) (ba1 []byte, err error)
+	funcGetPreferencesOrigin    string
+	inspectFuncGetPreferences   func(ctx context.Context, userID uuid.UUID)
+	afterGetPreferencesCounter  uint64
+	beforeGetPreferencesCounter uint64
+	GetPreferencesMock          mRepositoryMockGetPreferences
+
 	funcGetUser          func(ctx context.Context, id uuid.UUID) (u1 mm_user.User, s1 string, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id uuid.UUID)
@@ -62,6 +76,34 @@ type RepositoryMock struct {
 	beforeGetUserByEmailCounter uint64
 	GetUserByEmailMock          mRepositoryMockGetUserByEmail
 
+	funcLinkExternalUser          func(ctx context.Context, id uuid.UUID) (err error)
+	funcLinkExternalUserOrigin    string
+	inspectFuncLinkExternalUser   func(ctx context.Context, id uuid.UUID)
+	afterLinkExternalUserCounter  uint64
+	beforeLinkExternalUserCounter uint64
+	LinkExternalUserMock          mRepositoryMockLinkExternalUser
+
+	funcSetPreferences          func(ctx context.Context, userID uuid.UUID, data []byte) (err error)
+	funcSetPreferencesOrigin    string
+	inspectFuncSetPreferences   func(ctx context.Context, userID uuid.UUID, data []byte)
+	afterSetPreferencesCounter  uint64
+	beforeSetPreferencesCounter uint64
+	SetPreferencesMock          mRepositoryMockSetPreferences
+
+	funcUpdatePasswordHash          func(ctx context.Context, id uuid.UUID, oldHash string, newHash string) (err error)
+	funcUpdatePasswordHashOrigin    string
+	inspectFuncUpdatePasswordHash   func(ctx context.Context, id uuid.UUID, oldHash string, newHash string)
+	afterUpdatePasswordHashCounter  uint64
+	beforeUpdatePasswordHashCounter uint64
+	UpdatePasswordHashMock          mRepositoryMockUpdatePasswordHash
+
+	funcUpdateProfile          func(ctx context.Context, req mm_user.UpdateProfileReq) (err error)
+	funcUpdateProfileOrigin    string
+	inspectFuncUpdateProfile   func(ctx context.Context, req mm_user.UpdateProfileReq)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mRepositoryMockUpdateProfile
+
 	funcUpdateUser          func(ctx context.Context, req mm_user.UpdateUserReq) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, req mm_user.UpdateUserReq)
@@ -78,6 +120,9 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AnonymizeUserMock = mRepositoryMockAnonymizeUser{mock: m}
+	m.AnonymizeUserMock.callArgs = []*RepositoryMockAnonymizeUserParams{}
+
 	m.ChangePasswordMock = mRepositoryMockChangePassword{mock: m}
 	m.ChangePasswordMock.callArgs = []*RepositoryMockChangePasswordParams{}
 
@@ -90,12 +135,27 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.GetAllUsersMock = mRepositoryMockGetAllUsers{mock: m}
 	m.GetAllUsersMock.callArgs = []*RepositoryMockGetAllUsersParams{}
 
+	m.GetPreferencesMock = mRepositoryMockGetPreferences{mock: m}
+	m.GetPreferencesMock.callArgs = []*RepositoryMockGetPreferencesParams{}
+
 	m.GetUserMock = mRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*RepositoryMockGetUserParams{}
 
 	m.GetUserByEmailMock = mRepositoryMockGetUserByEmail{mock: m}
 	m.GetUserByEmailMock.callArgs = []*RepositoryMockGetUserByEmailParams{}
 
+	m.LinkExternalUserMock = mRepositoryMockLinkExternalUser{mock: m}
+	m.LinkExternalUserMock.callArgs = []*RepositoryMockLinkExternalUserParams{}
+
+	m.SetPreferencesMock = mRepositoryMockSetPreferences{mock: m}
+	m.SetPreferencesMock.callArgs = []*RepositoryMockSetPreferencesParams{}
+
+	m.UpdatePasswordHashMock = mRepositoryMockUpdatePasswordHash{mock: m}
+	m.UpdatePasswordHashMock.callArgs = []*RepositoryMockUpdatePasswordHashParams{}
+
+	m.UpdateProfileMock = mRepositoryMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*RepositoryMockUpdateProfileParams{}
+
 	m.UpdateUserMock = mRepositoryMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*RepositoryMockUpdateUserParams{}
 
@@ -104,6 +164,410 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	return m
 }
 
+type mRepositoryMockAnonymizeUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAnonymizeUserExpectation
+	expectations       []*RepositoryMockAnonymizeUserExpectation
+
+	callArgs []*RepositoryMockAnonymizeUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAnonymizeUserExpectation specifies expectation struct of the Repository.AnonymizeUser
+type RepositoryMockAnonymizeUserExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAnonymizeUserParams
+	paramPtrs          *RepositoryMockAnonymizeUserParamPtrs
+	expectationOrigins RepositoryMockAnonymizeUserExpectationOrigins
+	results            *RepositoryMockAnonymizeUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAnonymizeUserParams contains parameters of the Repository.AnonymizeUser
+type RepositoryMockAnonymizeUserParams struct {
+	ctx   context.Context
+	id    uuid.UUID
+	email string
+	name  string
+}
+
+// RepositoryMockAnonymizeUserParamPtrs contains pointers to parameters of the Repository.AnonymizeUser
+type RepositoryMockAnonymizeUserParamPtrs struct {
+	ctx   *context.Context
+	id    *uuid.UUID
+	email *string
+	name  *string
+}
+
+// RepositoryMockAnonymizeUserResults contains results of the Repository.AnonymizeUser
+type RepositoryMockAnonymizeUserResults struct {
+	err error
+}
+
+// RepositoryMockAnonymizeUserOrigins contains origins of expectations of the Repository.AnonymizeUser
+type RepositoryMockAnonymizeUserExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originEmail string
+	originName  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Optional() *mRepositoryMockAnonymizeUser {
+	mmAnonymizeUser.optional = true
+	return mmAnonymizeUser
+}
+
+// Expect sets up expected params for Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Expect(ctx context.Context, id uuid.UUID, email string, name string) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by ExpectParams functions")
+	}
+
+	mmAnonymizeUser.defaultExpectation.params = &RepositoryMockAnonymizeUserParams{ctx, id, email, name}
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAnonymizeUser.expectations {
+		if minimock.Equal(e.params, mmAnonymizeUser.defaultExpectation.params) {
+			mmAnonymizeUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAnonymizeUser.defaultExpectation.params)
+		}
+	}
+
+	return mmAnonymizeUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &RepositoryMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) ExpectIdParam2(id uuid.UUID) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &RepositoryMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.id = &id
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// ExpectEmailParam3 sets up expected param email for Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) ExpectEmailParam3(email string) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &RepositoryMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.email = &email
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// ExpectNameParam4 sets up expected param name for Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) ExpectNameParam4(name string) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &RepositoryMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.name = &name
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Inspect(f func(ctx context.Context, id uuid.UUID, email string, name string)) *mRepositoryMockAnonymizeUser {
+	if mmAnonymizeUser.mock.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.AnonymizeUser")
+	}
+
+	mmAnonymizeUser.mock.inspectFuncAnonymizeUser = f
+
+	return mmAnonymizeUser
+}
+
+// Return sets up results that will be returned by Repository.AnonymizeUser
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Return(err error) *RepositoryMock {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &RepositoryMockAnonymizeUserExpectation{mock: mmAnonymizeUser.mock}
+	}
+	mmAnonymizeUser.defaultExpectation.results = &RepositoryMockAnonymizeUserResults{err}
+	mmAnonymizeUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
+}
+
+// Set uses given function f to mock the Repository.AnonymizeUser method
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Set(f func(ctx context.Context, id uuid.UUID, email string, name string) (err error)) *RepositoryMock {
+	if mmAnonymizeUser.defaultExpectation != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Default expectation is already set for the Repository.AnonymizeUser method")
+	}
+
+	if len(mmAnonymizeUser.expectations) > 0 {
+		mmAnonymizeUser.mock.t.Fatalf("Some expectations are already set for the Repository.AnonymizeUser method")
+	}
+
+	mmAnonymizeUser.mock.funcAnonymizeUser = f
+	mmAnonymizeUser.mock.funcAnonymizeUserOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
+}
+
+// When sets expectation for the Repository.AnonymizeUser which will trigger the result defined by the following
+// Then helper
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) When(ctx context.Context, id uuid.UUID, email string, name string) *RepositoryMockAnonymizeUserExpectation {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("RepositoryMock.AnonymizeUser mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAnonymizeUserExpectation{
+		mock:               mmAnonymizeUser.mock,
+		params:             &RepositoryMockAnonymizeUserParams{ctx, id, email, name},
+		expectationOrigins: RepositoryMockAnonymizeUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAnonymizeUser.expectations = append(mmAnonymizeUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.AnonymizeUser return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAnonymizeUserExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAnonymizeUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.AnonymizeUser should be invoked
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Times(n uint64) *mRepositoryMockAnonymizeUser {
+	if n == 0 {
+		mmAnonymizeUser.mock.t.Fatalf("Times of RepositoryMock.AnonymizeUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAnonymizeUser.expectedInvocations, n)
+	mmAnonymizeUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser
+}
+
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) invocationsDone() bool {
+	if len(mmAnonymizeUser.expectations) == 0 && mmAnonymizeUser.defaultExpectation == nil && mmAnonymizeUser.mock.funcAnonymizeUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.mock.afterAnonymizeUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AnonymizeUser implements mm_user.Repository
+func (mmAnonymizeUser *RepositoryMock) AnonymizeUser(ctx context.Context, id uuid.UUID, email string, name string) (err error) {
+	mm_atomic.AddUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAnonymizeUser.afterAnonymizeUserCounter, 1)
+
+	mmAnonymizeUser.t.Helper()
+
+	if mmAnonymizeUser.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.inspectFuncAnonymizeUser(ctx, id, email, name)
+	}
+
+	mm_params := RepositoryMockAnonymizeUserParams{ctx, id, email, name}
+
+	// Record call args
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Lock()
+	mmAnonymizeUser.AnonymizeUserMock.callArgs = append(mmAnonymizeUser.AnonymizeUserMock.callArgs, &mm_params)
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Unlock()
+
+	for _, e := range mmAnonymizeUser.AnonymizeUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAnonymizeUser.AnonymizeUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAnonymizeUserParams{ctx, id, email, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAnonymizeUser.t.Errorf("RepositoryMock.AnonymizeUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAnonymizeUser.t.Errorf("RepositoryMock.AnonymizeUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmAnonymizeUser.t.Errorf("RepositoryMock.AnonymizeUser got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmAnonymizeUser.t.Errorf("RepositoryMock.AnonymizeUser got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAnonymizeUser.t.Errorf("RepositoryMock.AnonymizeUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAnonymizeUser.t.Fatal("No results are set for the RepositoryMock.AnonymizeUser")
+		}
+		return (*mm_results).err
+	}
+	if mmAnonymizeUser.funcAnonymizeUser != nil {
+		return mmAnonymizeUser.funcAnonymizeUser(ctx, id, email, name)
+	}
+	mmAnonymizeUser.t.Fatalf("Unexpected call to RepositoryMock.AnonymizeUser. %v %v %v %v", ctx, id, email, name)
+	return
+}
+
+// AnonymizeUserAfterCounter returns a count of finished RepositoryMock.AnonymizeUser invocations
+func (mmAnonymizeUser *RepositoryMock) AnonymizeUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.afterAnonymizeUserCounter)
+}
+
+// AnonymizeUserBeforeCounter returns a count of RepositoryMock.AnonymizeUser invocations
+func (mmAnonymizeUser *RepositoryMock) AnonymizeUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.AnonymizeUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAnonymizeUser *mRepositoryMockAnonymizeUser) Calls() []*RepositoryMockAnonymizeUserParams {
+	mmAnonymizeUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAnonymizeUserParams, len(mmAnonymizeUser.callArgs))
+	copy(argCopy, mmAnonymizeUser.callArgs)
+
+	mmAnonymizeUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAnonymizeUserDone returns true if the count of the AnonymizeUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAnonymizeUserDone() bool {
+	if m.AnonymizeUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AnonymizeUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AnonymizeUserMock.invocationsDone()
+}
+
+// MinimockAnonymizeUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAnonymizeUserInspect() {
+	for _, e := range m.AnonymizeUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.AnonymizeUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAnonymizeUserCounter := mm_atomic.LoadUint64(&m.afterAnonymizeUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AnonymizeUserMock.defaultExpectation != nil && afterAnonymizeUserCounter < 1 {
+		if m.AnonymizeUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.AnonymizeUser at\n%s", m.AnonymizeUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.AnonymizeUser at\n%s with params: %#v", m.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *m.AnonymizeUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAnonymizeUser != nil && afterAnonymizeUserCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.AnonymizeUser at\n%s", m.funcAnonymizeUserOrigin)
+	}
+
+	if !m.AnonymizeUserMock.invocationsDone() && afterAnonymizeUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.AnonymizeUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AnonymizeUserMock.expectedInvocations), m.AnonymizeUserMock.expectedInvocationsOrigin, afterAnonymizeUserCounter)
+	}
+}
+
 type mRepositoryMockChangePassword struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -1249,24 +1713,27 @@ type RepositoryMockGetAllUsersExpectation struct {
 
 // RepositoryMockGetAllUsersParams contains parameters of the Repository.GetAllUsers
 type RepositoryMockGetAllUsersParams struct {
-	ctx context.Context
+	ctx  context.Context
+	opts mm_user.ListUsersOptions
 }
 
 // RepositoryMockGetAllUsersParamPtrs contains pointers to parameters of the Repository.GetAllUsers
 type RepositoryMockGetAllUsersParamPtrs struct {
-	ctx *context.Context
+	ctx  *context.Context
+	opts *mm_user.ListUsersOptions
 }
 
 // RepositoryMockGetAllUsersResults contains results of the Repository.GetAllUsers
 type RepositoryMockGetAllUsersResults struct {
-	ua1 []mm_user.User
+	u1  mm_user.UsersPage
 	err error
 }
 
 // RepositoryMockGetAllUsersOrigins contains origins of expectations of the Repository.GetAllUsers
 type RepositoryMockGetAllUsersExpectationOrigins struct {
-	origin    string
-	originCtx string
+	origin     string
+	originCtx  string
+	originOpts string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1280,7 +1747,7 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) Optional() *mRepositoryMockGetA
 }
 
 // Expect sets up expected params for Repository.GetAllUsers
-func (mmGetAllUsers *mRepositoryMockGetAllUsers) Expect(ctx context.Context) *mRepositoryMockGetAllUsers {
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) Expect(ctx context.Context, opts mm_user.ListUsersOptions) *mRepositoryMockGetAllUsers {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by Set")
 	}
@@ -1293,7 +1760,7 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) Expect(ctx context.Context) *mR
 		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by ExpectParams functions")
 	}
 
-	mmGetAllUsers.defaultExpectation.params = &RepositoryMockGetAllUsersParams{ctx}
+	mmGetAllUsers.defaultExpectation.params = &RepositoryMockGetAllUsersParams{ctx, opts}
 	mmGetAllUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetAllUsers.expectations {
 		if minimock.Equal(e.params, mmGetAllUsers.defaultExpectation.params) {
@@ -1327,8 +1794,31 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) ExpectCtxParam1(ctx context.Con
 	return mmGetAllUsers
 }
 
+// ExpectOptsParam2 sets up expected param opts for Repository.GetAllUsers
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) ExpectOptsParam2(opts mm_user.ListUsersOptions) *mRepositoryMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &RepositoryMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &RepositoryMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.opts = &opts
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
 // Inspect accepts an inspector function that has same arguments as the Repository.GetAllUsers
-func (mmGetAllUsers *mRepositoryMockGetAllUsers) Inspect(f func(ctx context.Context)) *mRepositoryMockGetAllUsers {
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) Inspect(f func(ctx context.Context, opts mm_user.ListUsersOptions)) *mRepositoryMockGetAllUsers {
 	if mmGetAllUsers.mock.inspectFuncGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetAllUsers")
 	}
@@ -1339,7 +1829,7 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) Inspect(f func(ctx context.Cont
 }
 
 // Return sets up results that will be returned by Repository.GetAllUsers
-func (mmGetAllUsers *mRepositoryMockGetAllUsers) Return(ua1 []mm_user.User, err error) *RepositoryMock {
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) Return(u1 mm_user.UsersPage, err error) *RepositoryMock {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by Set")
 	}
@@ -1347,13 +1837,13 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) Return(ua1 []mm_user.User, err
 	if mmGetAllUsers.defaultExpectation == nil {
 		mmGetAllUsers.defaultExpectation = &RepositoryMockGetAllUsersExpectation{mock: mmGetAllUsers.mock}
 	}
-	mmGetAllUsers.defaultExpectation.results = &RepositoryMockGetAllUsersResults{ua1, err}
+	mmGetAllUsers.defaultExpectation.results = &RepositoryMockGetAllUsersResults{u1, err}
 	mmGetAllUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmGetAllUsers.mock
 }
 
 // Set uses given function f to mock the Repository.GetAllUsers method
-func (mmGetAllUsers *mRepositoryMockGetAllUsers) Set(f func(ctx context.Context) (ua1 []mm_user.User, err error)) *RepositoryMock {
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) Set(f func(ctx context.Context, opts mm_user.ListUsersOptions) (u1 mm_user.UsersPage, err error)) *RepositoryMock {
 	if mmGetAllUsers.defaultExpectation != nil {
 		mmGetAllUsers.mock.t.Fatalf("Default expectation is already set for the Repository.GetAllUsers method")
 	}
@@ -1369,14 +1859,14 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) Set(f func(ctx context.Context)
 
 // When sets expectation for the Repository.GetAllUsers which will trigger the result defined by the following
 // Then helper
-func (mmGetAllUsers *mRepositoryMockGetAllUsers) When(ctx context.Context) *RepositoryMockGetAllUsersExpectation {
+func (mmGetAllUsers *mRepositoryMockGetAllUsers) When(ctx context.Context, opts mm_user.ListUsersOptions) *RepositoryMockGetAllUsersExpectation {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("RepositoryMock.GetAllUsers mock is already set by Set")
 	}
 
 	expectation := &RepositoryMockGetAllUsersExpectation{
 		mock:               mmGetAllUsers.mock,
-		params:             &RepositoryMockGetAllUsersParams{ctx},
+		params:             &RepositoryMockGetAllUsersParams{ctx, opts},
 		expectationOrigins: RepositoryMockGetAllUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetAllUsers.expectations = append(mmGetAllUsers.expectations, expectation)
@@ -1384,8 +1874,8 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) When(ctx context.Context) *Repo
 }
 
 // Then sets up Repository.GetAllUsers return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockGetAllUsersExpectation) Then(ua1 []mm_user.User, err error) *RepositoryMock {
-	e.results = &RepositoryMockGetAllUsersResults{ua1, err}
+func (e *RepositoryMockGetAllUsersExpectation) Then(u1 mm_user.UsersPage, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetAllUsersResults{u1, err}
 	return e.mock
 }
 
@@ -1411,17 +1901,17 @@ func (mmGetAllUsers *mRepositoryMockGetAllUsers) invocationsDone() bool {
 }
 
 // GetAllUsers implements mm_user.Repository
-func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context) (ua1 []mm_user.User, err error) {
+func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context, opts mm_user.ListUsersOptions) (u1 mm_user.UsersPage, err error) {
 	mm_atomic.AddUint64(&mmGetAllUsers.beforeGetAllUsersCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetAllUsers.afterGetAllUsersCounter, 1)
 
 	mmGetAllUsers.t.Helper()
 
 	if mmGetAllUsers.inspectFuncGetAllUsers != nil {
-		mmGetAllUsers.inspectFuncGetAllUsers(ctx)
+		mmGetAllUsers.inspectFuncGetAllUsers(ctx, opts)
 	}
 
-	mm_params := RepositoryMockGetAllUsersParams{ctx}
+	mm_params := RepositoryMockGetAllUsersParams{ctx, opts}
 
 	// Record call args
 	mmGetAllUsers.GetAllUsersMock.mutex.Lock()
@@ -1431,7 +1921,7 @@ func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context) (ua1 []mm_
 	for _, e := range mmGetAllUsers.GetAllUsersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
@@ -1440,7 +1930,7 @@ func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context) (ua1 []mm_
 		mm_want := mmGetAllUsers.GetAllUsersMock.defaultExpectation.params
 		mm_want_ptrs := mmGetAllUsers.GetAllUsersMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockGetAllUsersParams{ctx}
+		mm_got := RepositoryMockGetAllUsersParams{ctx, opts}
 
 		if mm_want_ptrs != nil {
 
@@ -1449,6 +1939,11 @@ func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context) (ua1 []mm_
 					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmGetAllUsers.t.Errorf("RepositoryMock.GetAllUsers got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetAllUsers.t.Errorf("RepositoryMock.GetAllUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1458,12 +1953,12 @@ func (mmGetAllUsers *RepositoryMock) GetAllUsers(ctx context.Context) (ua1 []mm_
 		if mm_results == nil {
 			mmGetAllUsers.t.Fatal("No results are set for the RepositoryMock.GetAllUsers")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
 	if mmGetAllUsers.funcGetAllUsers != nil {
-		return mmGetAllUsers.funcGetAllUsers(ctx)
+		return mmGetAllUsers.funcGetAllUsers(ctx, opts)
 	}
-	mmGetAllUsers.t.Fatalf("Unexpected call to RepositoryMock.GetAllUsers. %v", ctx)
+	mmGetAllUsers.t.Fatalf("Unexpected call to RepositoryMock.GetAllUsers. %v %v", ctx, opts)
 	return
 }
 
@@ -1535,45 +2030,388 @@ func (m *RepositoryMock) MinimockGetAllUsersInspect() {
 	}
 }
 
-type mRepositoryMockGetUser struct {
+type mRepositoryMockGetPreferences struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockGetUserExpectation
-	expectations       []*RepositoryMockGetUserExpectation
+	defaultExpectation *RepositoryMockGetPreferencesExpectation
+	expectations       []*RepositoryMockGetPreferencesExpectation
 
-	callArgs []*RepositoryMockGetUserParams
+	callArgs []*RepositoryMockGetPreferencesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockGetUserExpectation specifies expectation struct of the Repository.GetUser
-type RepositoryMockGetUserExpectation struct {
+// RepositoryMockGetPreferencesExpectation specifies expectation struct of the Repository.GetPreferences
+type RepositoryMockGetPreferencesExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockGetUserParams
-	paramPtrs          *RepositoryMockGetUserParamPtrs
-	expectationOrigins RepositoryMockGetUserExpectationOrigins
-	results            *RepositoryMockGetUserResults
+	params             *RepositoryMockGetPreferencesParams
+	paramPtrs          *RepositoryMockGetPreferencesParamPtrs
+	expectationOrigins RepositoryMockGetPreferencesExpectationOrigins
+	results            *RepositoryMockGetPreferencesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockGetUserParams contains parameters of the Repository.GetUser
-type RepositoryMockGetUserParams struct {
-	ctx context.Context
-	id  uuid.UUID
+// RepositoryMockGetPreferencesParams contains parameters of the Repository.GetPreferences
+type RepositoryMockGetPreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
 }
 
-// RepositoryMockGetUserParamPtrs contains pointers to parameters of the Repository.GetUser
-type RepositoryMockGetUserParamPtrs struct {
-	ctx *context.Context
-	id  *uuid.UUID
+// RepositoryMockGetPreferencesParamPtrs contains pointers to parameters of the Repository.GetPreferences
+type RepositoryMockGetPreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
 }
 
-// RepositoryMockGetUserResults contains results of the Repository.GetUser
-type RepositoryMockGetUserResults struct {
-	u1  mm_user.User
+// RepositoryMockGetPreferencesResults contains results of the Repository.GetPreferences
+type RepositoryMockGetPreferencesResults struct {
+	ba1 []byte
+	err error
+}
+
+// RepositoryMockGetPreferencesOrigins contains origins of expectations of the Repository.GetPreferences
+type RepositoryMockGetPreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPreferences *mRepositoryMockGetPreferences) Optional() *mRepositoryMockGetPreferences {
+	mmGetPreferences.optional = true
+	return mmGetPreferences
+}
+
+// Expect sets up expected params for Repository.GetPreferences
+func (mmGetPreferences *mRepositoryMockGetPreferences) Expect(ctx context.Context, userID uuid.UUID) *mRepositoryMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &RepositoryMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by ExpectParams functions")
+	}
+
+	mmGetPreferences.defaultExpectation.params = &RepositoryMockGetPreferencesParams{ctx, userID}
+	mmGetPreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreferences.expectations {
+		if minimock.Equal(e.params, mmGetPreferences.defaultExpectation.params) {
+			mmGetPreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreferences.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPreferences
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPreferences
+func (mmGetPreferences *mRepositoryMockGetPreferences) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &RepositoryMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &RepositoryMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetPreferences
+func (mmGetPreferences *mRepositoryMockGetPreferences) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &RepositoryMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &RepositoryMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPreferences
+func (mmGetPreferences *mRepositoryMockGetPreferences) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mRepositoryMockGetPreferences {
+	if mmGetPreferences.mock.inspectFuncGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPreferences")
+	}
+
+	mmGetPreferences.mock.inspectFuncGetPreferences = f
+
+	return mmGetPreferences
+}
+
+// Return sets up results that will be returned by Repository.GetPreferences
+func (mmGetPreferences *mRepositoryMockGetPreferences) Return(ba1 []byte, err error) *RepositoryMock {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &RepositoryMockGetPreferencesExpectation{mock: mmGetPreferences.mock}
+	}
+	mmGetPreferences.defaultExpectation.results = &RepositoryMockGetPreferencesResults{ba1, err}
+	mmGetPreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// Set uses given function f to mock the Repository.GetPreferences method
+func (mmGetPreferences *mRepositoryMockGetPreferences) Set(f func(ctx context.Context, userID uuid.UUID) (ba1 []byte, err error)) *RepositoryMock {
+	if mmGetPreferences.defaultExpectation != nil {
+		mmGetPreferences.mock.t.Fatalf("Default expectation is already set for the Repository.GetPreferences method")
+	}
+
+	if len(mmGetPreferences.expectations) > 0 {
+		mmGetPreferences.mock.t.Fatalf("Some expectations are already set for the Repository.GetPreferences method")
+	}
+
+	mmGetPreferences.mock.funcGetPreferences = f
+	mmGetPreferences.mock.funcGetPreferencesOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// When sets expectation for the Repository.GetPreferences which will trigger the result defined by the following
+// Then helper
+func (mmGetPreferences *mRepositoryMockGetPreferences) When(ctx context.Context, userID uuid.UUID) *RepositoryMockGetPreferencesExpectation {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("RepositoryMock.GetPreferences mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPreferencesExpectation{
+		mock:               mmGetPreferences.mock,
+		params:             &RepositoryMockGetPreferencesParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetPreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPreferences.expectations = append(mmGetPreferences.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPreferences return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPreferencesExpectation) Then(ba1 []byte, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPreferencesResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPreferences should be invoked
+func (mmGetPreferences *mRepositoryMockGetPreferences) Times(n uint64) *mRepositoryMockGetPreferences {
+	if n == 0 {
+		mmGetPreferences.mock.t.Fatalf("Times of RepositoryMock.GetPreferences mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPreferences.expectedInvocations, n)
+	mmGetPreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences
+}
+
+func (mmGetPreferences *mRepositoryMockGetPreferences) invocationsDone() bool {
+	if len(mmGetPreferences.expectations) == 0 && mmGetPreferences.defaultExpectation == nil && mmGetPreferences.mock.funcGetPreferences == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreferences.mock.afterGetPreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreferences.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPreferences implements mm_user.Repository
+func (mmGetPreferences *RepositoryMock) GetPreferences(ctx context.Context, userID uuid.UUID) (ba1 []byte, err error) {
+	mm_atomic.AddUint64(&mmGetPreferences.beforeGetPreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreferences.afterGetPreferencesCounter, 1)
+
+	mmGetPreferences.t.Helper()
+
+	if mmGetPreferences.inspectFuncGetPreferences != nil {
+		mmGetPreferences.inspectFuncGetPreferences(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetPreferencesParams{ctx, userID}
+
+	// Record call args
+	mmGetPreferences.GetPreferencesMock.mutex.Lock()
+	mmGetPreferences.GetPreferencesMock.callArgs = append(mmGetPreferences.GetPreferencesMock.callArgs, &mm_params)
+	mmGetPreferences.GetPreferencesMock.mutex.Unlock()
+
+	for _, e := range mmGetPreferences.GetPreferencesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmGetPreferences.GetPreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreferences.GetPreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreferences.GetPreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreferences.GetPreferencesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPreferencesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPreferences.t.Errorf("RepositoryMock.GetPreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPreferences.t.Errorf("RepositoryMock.GetPreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPreferences.t.Errorf("RepositoryMock.GetPreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPreferences.GetPreferencesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPreferences.t.Fatal("No results are set for the RepositoryMock.GetPreferences")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmGetPreferences.funcGetPreferences != nil {
+		return mmGetPreferences.funcGetPreferences(ctx, userID)
+	}
+	mmGetPreferences.t.Fatalf("Unexpected call to RepositoryMock.GetPreferences. %v %v", ctx, userID)
+	return
+}
+
+// GetPreferencesAfterCounter returns a count of finished RepositoryMock.GetPreferences invocations
+func (mmGetPreferences *RepositoryMock) GetPreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.afterGetPreferencesCounter)
+}
+
+// GetPreferencesBeforeCounter returns a count of RepositoryMock.GetPreferences invocations
+func (mmGetPreferences *RepositoryMock) GetPreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.beforeGetPreferencesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPreferences.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPreferences *mRepositoryMockGetPreferences) Calls() []*RepositoryMockGetPreferencesParams {
+	mmGetPreferences.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPreferencesParams, len(mmGetPreferences.callArgs))
+	copy(argCopy, mmGetPreferences.callArgs)
+
+	mmGetPreferences.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPreferencesDone returns true if the count of the GetPreferences invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPreferencesDone() bool {
+	if m.GetPreferencesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPreferencesMock.invocationsDone()
+}
+
+// MinimockGetPreferencesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPreferencesInspect() {
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPreferencesCounter := mm_atomic.LoadUint64(&m.afterGetPreferencesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPreferencesMock.defaultExpectation != nil && afterGetPreferencesCounter < 1 {
+		if m.GetPreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPreferences at\n%s", m.GetPreferencesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPreferences at\n%s with params: %#v", m.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *m.GetPreferencesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPreferences != nil && afterGetPreferencesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPreferences at\n%s", m.funcGetPreferencesOrigin)
+	}
+
+	if !m.GetPreferencesMock.invocationsDone() && afterGetPreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPreferencesMock.expectedInvocations), m.GetPreferencesMock.expectedInvocationsOrigin, afterGetPreferencesCounter)
+	}
+}
+
+type mRepositoryMockGetUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetUserExpectation
+	expectations       []*RepositoryMockGetUserExpectation
+
+	callArgs []*RepositoryMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetUserExpectation specifies expectation struct of the Repository.GetUser
+type RepositoryMockGetUserExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetUserParams
+	paramPtrs          *RepositoryMockGetUserParamPtrs
+	expectationOrigins RepositoryMockGetUserExpectationOrigins
+	results            *RepositoryMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetUserParams contains parameters of the Repository.GetUser
+type RepositoryMockGetUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// RepositoryMockGetUserParamPtrs contains pointers to parameters of the Repository.GetUser
+type RepositoryMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// RepositoryMockGetUserResults contains results of the Repository.GetUser
+type RepositoryMockGetUserResults struct {
+	u1  mm_user.User
 	s1  string
 	err error
 }
@@ -2223,52 +3061,52 @@ func (m *RepositoryMock) MinimockGetUserByEmailInspect() {
 	}
 }
 
-type mRepositoryMockUpdateUser struct {
+type mRepositoryMockLinkExternalUser struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockUpdateUserExpectation
-	expectations       []*RepositoryMockUpdateUserExpectation
+	defaultExpectation *RepositoryMockLinkExternalUserExpectation
+	expectations       []*RepositoryMockLinkExternalUserExpectation
 
-	callArgs []*RepositoryMockUpdateUserParams
+	callArgs []*RepositoryMockLinkExternalUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockUpdateUserExpectation specifies expectation struct of the Repository.UpdateUser
-type RepositoryMockUpdateUserExpectation struct {
+// RepositoryMockLinkExternalUserExpectation specifies expectation struct of the Repository.LinkExternalUser
+type RepositoryMockLinkExternalUserExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockUpdateUserParams
-	paramPtrs          *RepositoryMockUpdateUserParamPtrs
-	expectationOrigins RepositoryMockUpdateUserExpectationOrigins
-	results            *RepositoryMockUpdateUserResults
+	params             *RepositoryMockLinkExternalUserParams
+	paramPtrs          *RepositoryMockLinkExternalUserParamPtrs
+	expectationOrigins RepositoryMockLinkExternalUserExpectationOrigins
+	results            *RepositoryMockLinkExternalUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockUpdateUserParams contains parameters of the Repository.UpdateUser
-type RepositoryMockUpdateUserParams struct {
+// RepositoryMockLinkExternalUserParams contains parameters of the Repository.LinkExternalUser
+type RepositoryMockLinkExternalUserParams struct {
 	ctx context.Context
-	req mm_user.UpdateUserReq
+	id  uuid.UUID
 }
 
-// RepositoryMockUpdateUserParamPtrs contains pointers to parameters of the Repository.UpdateUser
-type RepositoryMockUpdateUserParamPtrs struct {
+// RepositoryMockLinkExternalUserParamPtrs contains pointers to parameters of the Repository.LinkExternalUser
+type RepositoryMockLinkExternalUserParamPtrs struct {
 	ctx *context.Context
-	req *mm_user.UpdateUserReq
+	id  *uuid.UUID
 }
 
-// RepositoryMockUpdateUserResults contains results of the Repository.UpdateUser
-type RepositoryMockUpdateUserResults struct {
+// RepositoryMockLinkExternalUserResults contains results of the Repository.LinkExternalUser
+type RepositoryMockLinkExternalUserResults struct {
 	err error
 }
 
-// RepositoryMockUpdateUserOrigins contains origins of expectations of the Repository.UpdateUser
-type RepositoryMockUpdateUserExpectationOrigins struct {
+// RepositoryMockLinkExternalUserOrigins contains origins of expectations of the Repository.LinkExternalUser
+type RepositoryMockLinkExternalUserExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originReq string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2276,84 +3114,1545 @@ type RepositoryMockUpdateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateUser *mRepositoryMockUpdateUser) Optional() *mRepositoryMockUpdateUser {
-	mmUpdateUser.optional = true
-	return mmUpdateUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Optional() *mRepositoryMockLinkExternalUser {
+	mmLinkExternalUser.optional = true
+	return mmLinkExternalUser
 }
 
-// Expect sets up expected params for Repository.UpdateUser
-func (mmUpdateUser *mRepositoryMockUpdateUser) Expect(ctx context.Context, req mm_user.UpdateUserReq) *mRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+// Expect sets up expected params for Repository.LinkExternalUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Expect(ctx context.Context, id uuid.UUID) *mRepositoryMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &RepositoryMockLinkExternalUserExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	if mmLinkExternalUser.defaultExpectation.paramPtrs != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateUser.defaultExpectation.params = &RepositoryMockUpdateUserParams{ctx, req}
-	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateUser.expectations {
-		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
-			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+	mmLinkExternalUser.defaultExpectation.params = &RepositoryMockLinkExternalUserParams{ctx, id}
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLinkExternalUser.expectations {
+		if minimock.Equal(e.params, mmLinkExternalUser.defaultExpectation.params) {
+			mmLinkExternalUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLinkExternalUser.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateUser
+	return mmLinkExternalUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateUser
-func (mmUpdateUser *mRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.LinkExternalUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &RepositoryMockLinkExternalUserExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Expect")
+	if mmLinkExternalUser.defaultExpectation.params != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &RepositoryMockUpdateUserParamPtrs{}
+	if mmLinkExternalUser.defaultExpectation.paramPtrs == nil {
+		mmLinkExternalUser.defaultExpectation.paramPtrs = &RepositoryMockLinkExternalUserParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmLinkExternalUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmLinkExternalUser
 }
 
-// ExpectReqParam2 sets up expected param req for Repository.UpdateUser
-func (mmUpdateUser *mRepositoryMockUpdateUser) ExpectReqParam2(req mm_user.UpdateUserReq) *mRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Repository.LinkExternalUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) ExpectIdParam2(id uuid.UUID) *mRepositoryMockLinkExternalUser {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &RepositoryMockLinkExternalUserExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Expect")
+	if mmLinkExternalUser.defaultExpectation.params != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &RepositoryMockUpdateUserParamPtrs{}
+	if mmLinkExternalUser.defaultExpectation.paramPtrs == nil {
+		mmLinkExternalUser.defaultExpectation.paramPtrs = &RepositoryMockLinkExternalUserParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.req = &req
-	mmUpdateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmLinkExternalUser.defaultExpectation.paramPtrs.id = &id
+	mmLinkExternalUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmLinkExternalUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.UpdateUser
-func (mmUpdateUser *mRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, req mm_user.UpdateUserReq)) *mRepositoryMockUpdateUser {
+// Inspect accepts an inspector function that has same arguments as the Repository.LinkExternalUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mRepositoryMockLinkExternalUser {
+	if mmLinkExternalUser.mock.inspectFuncLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.LinkExternalUser")
+	}
+
+	mmLinkExternalUser.mock.inspectFuncLinkExternalUser = f
+
+	return mmLinkExternalUser
+}
+
+// Return sets up results that will be returned by Repository.LinkExternalUser
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Return(err error) *RepositoryMock {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Set")
+	}
+
+	if mmLinkExternalUser.defaultExpectation == nil {
+		mmLinkExternalUser.defaultExpectation = &RepositoryMockLinkExternalUserExpectation{mock: mmLinkExternalUser.mock}
+	}
+	mmLinkExternalUser.defaultExpectation.results = &RepositoryMockLinkExternalUserResults{err}
+	mmLinkExternalUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser.mock
+}
+
+// Set uses given function f to mock the Repository.LinkExternalUser method
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *RepositoryMock {
+	if mmLinkExternalUser.defaultExpectation != nil {
+		mmLinkExternalUser.mock.t.Fatalf("Default expectation is already set for the Repository.LinkExternalUser method")
+	}
+
+	if len(mmLinkExternalUser.expectations) > 0 {
+		mmLinkExternalUser.mock.t.Fatalf("Some expectations are already set for the Repository.LinkExternalUser method")
+	}
+
+	mmLinkExternalUser.mock.funcLinkExternalUser = f
+	mmLinkExternalUser.mock.funcLinkExternalUserOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser.mock
+}
+
+// When sets expectation for the Repository.LinkExternalUser which will trigger the result defined by the following
+// Then helper
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) When(ctx context.Context, id uuid.UUID) *RepositoryMockLinkExternalUserExpectation {
+	if mmLinkExternalUser.mock.funcLinkExternalUser != nil {
+		mmLinkExternalUser.mock.t.Fatalf("RepositoryMock.LinkExternalUser mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockLinkExternalUserExpectation{
+		mock:               mmLinkExternalUser.mock,
+		params:             &RepositoryMockLinkExternalUserParams{ctx, id},
+		expectationOrigins: RepositoryMockLinkExternalUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLinkExternalUser.expectations = append(mmLinkExternalUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.LinkExternalUser return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockLinkExternalUserExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockLinkExternalUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.LinkExternalUser should be invoked
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Times(n uint64) *mRepositoryMockLinkExternalUser {
+	if n == 0 {
+		mmLinkExternalUser.mock.t.Fatalf("Times of RepositoryMock.LinkExternalUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLinkExternalUser.expectedInvocations, n)
+	mmLinkExternalUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLinkExternalUser
+}
+
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) invocationsDone() bool {
+	if len(mmLinkExternalUser.expectations) == 0 && mmLinkExternalUser.defaultExpectation == nil && mmLinkExternalUser.mock.funcLinkExternalUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLinkExternalUser.mock.afterLinkExternalUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLinkExternalUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LinkExternalUser implements mm_user.Repository
+func (mmLinkExternalUser *RepositoryMock) LinkExternalUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmLinkExternalUser.beforeLinkExternalUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmLinkExternalUser.afterLinkExternalUserCounter, 1)
+
+	mmLinkExternalUser.t.Helper()
+
+	if mmLinkExternalUser.inspectFuncLinkExternalUser != nil {
+		mmLinkExternalUser.inspectFuncLinkExternalUser(ctx, id)
+	}
+
+	mm_params := RepositoryMockLinkExternalUserParams{ctx, id}
+
+	// Record call args
+	mmLinkExternalUser.LinkExternalUserMock.mutex.Lock()
+	mmLinkExternalUser.LinkExternalUserMock.callArgs = append(mmLinkExternalUser.LinkExternalUserMock.callArgs, &mm_params)
+	mmLinkExternalUser.LinkExternalUserMock.mutex.Unlock()
+
+	for _, e := range mmLinkExternalUser.LinkExternalUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLinkExternalUser.LinkExternalUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.params
+		mm_want_ptrs := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockLinkExternalUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLinkExternalUser.t.Errorf("RepositoryMock.LinkExternalUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLinkExternalUser.t.Errorf("RepositoryMock.LinkExternalUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLinkExternalUser.t.Errorf("RepositoryMock.LinkExternalUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLinkExternalUser.LinkExternalUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLinkExternalUser.t.Fatal("No results are set for the RepositoryMock.LinkExternalUser")
+		}
+		return (*mm_results).err
+	}
+	if mmLinkExternalUser.funcLinkExternalUser != nil {
+		return mmLinkExternalUser.funcLinkExternalUser(ctx, id)
+	}
+	mmLinkExternalUser.t.Fatalf("Unexpected call to RepositoryMock.LinkExternalUser. %v %v", ctx, id)
+	return
+}
+
+// LinkExternalUserAfterCounter returns a count of finished RepositoryMock.LinkExternalUser invocations
+func (mmLinkExternalUser *RepositoryMock) LinkExternalUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkExternalUser.afterLinkExternalUserCounter)
+}
+
+// LinkExternalUserBeforeCounter returns a count of RepositoryMock.LinkExternalUser invocations
+func (mmLinkExternalUser *RepositoryMock) LinkExternalUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkExternalUser.beforeLinkExternalUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.LinkExternalUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLinkExternalUser *mRepositoryMockLinkExternalUser) Calls() []*RepositoryMockLinkExternalUserParams {
+	mmLinkExternalUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockLinkExternalUserParams, len(mmLinkExternalUser.callArgs))
+	copy(argCopy, mmLinkExternalUser.callArgs)
+
+	mmLinkExternalUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLinkExternalUserDone returns true if the count of the LinkExternalUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockLinkExternalUserDone() bool {
+	if m.LinkExternalUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LinkExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LinkExternalUserMock.invocationsDone()
+}
+
+// MinimockLinkExternalUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockLinkExternalUserInspect() {
+	for _, e := range m.LinkExternalUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.LinkExternalUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLinkExternalUserCounter := mm_atomic.LoadUint64(&m.afterLinkExternalUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LinkExternalUserMock.defaultExpectation != nil && afterLinkExternalUserCounter < 1 {
+		if m.LinkExternalUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.LinkExternalUser at\n%s", m.LinkExternalUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.LinkExternalUser at\n%s with params: %#v", m.LinkExternalUserMock.defaultExpectation.expectationOrigins.origin, *m.LinkExternalUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLinkExternalUser != nil && afterLinkExternalUserCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.LinkExternalUser at\n%s", m.funcLinkExternalUserOrigin)
+	}
+
+	if !m.LinkExternalUserMock.invocationsDone() && afterLinkExternalUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.LinkExternalUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LinkExternalUserMock.expectedInvocations), m.LinkExternalUserMock.expectedInvocationsOrigin, afterLinkExternalUserCounter)
+	}
+}
+
+type mRepositoryMockSetPreferences struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSetPreferencesExpectation
+	expectations       []*RepositoryMockSetPreferencesExpectation
+
+	callArgs []*RepositoryMockSetPreferencesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSetPreferencesExpectation specifies expectation struct of the Repository.SetPreferences
+type RepositoryMockSetPreferencesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSetPreferencesParams
+	paramPtrs          *RepositoryMockSetPreferencesParamPtrs
+	expectationOrigins RepositoryMockSetPreferencesExpectationOrigins
+	results            *RepositoryMockSetPreferencesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSetPreferencesParams contains parameters of the Repository.SetPreferences
+type RepositoryMockSetPreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	data   []byte
+}
+
+// RepositoryMockSetPreferencesParamPtrs contains pointers to parameters of the Repository.SetPreferences
+type RepositoryMockSetPreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	data   *[]byte
+}
+
+// RepositoryMockSetPreferencesResults contains results of the Repository.SetPreferences
+type RepositoryMockSetPreferencesResults struct {
+	err error
+}
+
+// RepositoryMockSetPreferencesOrigins contains origins of expectations of the Repository.SetPreferences
+type RepositoryMockSetPreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originData   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPreferences *mRepositoryMockSetPreferences) Optional() *mRepositoryMockSetPreferences {
+	mmSetPreferences.optional = true
+	return mmSetPreferences
+}
+
+// Expect sets up expected params for Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) Expect(ctx context.Context, userID uuid.UUID, data []byte) *mRepositoryMockSetPreferences {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	if mmSetPreferences.defaultExpectation == nil {
+		mmSetPreferences.defaultExpectation = &RepositoryMockSetPreferencesExpectation{}
+	}
+
+	if mmSetPreferences.defaultExpectation.paramPtrs != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by ExpectParams functions")
+	}
+
+	mmSetPreferences.defaultExpectation.params = &RepositoryMockSetPreferencesParams{ctx, userID, data}
+	mmSetPreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPreferences.expectations {
+		if minimock.Equal(e.params, mmSetPreferences.defaultExpectation.params) {
+			mmSetPreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPreferences.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPreferences
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetPreferences {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	if mmSetPreferences.defaultExpectation == nil {
+		mmSetPreferences.defaultExpectation = &RepositoryMockSetPreferencesExpectation{}
+	}
+
+	if mmSetPreferences.defaultExpectation.params != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Expect")
+	}
+
+	if mmSetPreferences.defaultExpectation.paramPtrs == nil {
+		mmSetPreferences.defaultExpectation.paramPtrs = &RepositoryMockSetPreferencesParamPtrs{}
+	}
+	mmSetPreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPreferences
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) ExpectUserIDParam2(userID uuid.UUID) *mRepositoryMockSetPreferences {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	if mmSetPreferences.defaultExpectation == nil {
+		mmSetPreferences.defaultExpectation = &RepositoryMockSetPreferencesExpectation{}
+	}
+
+	if mmSetPreferences.defaultExpectation.params != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Expect")
+	}
+
+	if mmSetPreferences.defaultExpectation.paramPtrs == nil {
+		mmSetPreferences.defaultExpectation.paramPtrs = &RepositoryMockSetPreferencesParamPtrs{}
+	}
+	mmSetPreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmSetPreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetPreferences
+}
+
+// ExpectDataParam3 sets up expected param data for Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) ExpectDataParam3(data []byte) *mRepositoryMockSetPreferences {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	if mmSetPreferences.defaultExpectation == nil {
+		mmSetPreferences.defaultExpectation = &RepositoryMockSetPreferencesExpectation{}
+	}
+
+	if mmSetPreferences.defaultExpectation.params != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Expect")
+	}
+
+	if mmSetPreferences.defaultExpectation.paramPtrs == nil {
+		mmSetPreferences.defaultExpectation.paramPtrs = &RepositoryMockSetPreferencesParamPtrs{}
+	}
+	mmSetPreferences.defaultExpectation.paramPtrs.data = &data
+	mmSetPreferences.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmSetPreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) Inspect(f func(ctx context.Context, userID uuid.UUID, data []byte)) *mRepositoryMockSetPreferences {
+	if mmSetPreferences.mock.inspectFuncSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SetPreferences")
+	}
+
+	mmSetPreferences.mock.inspectFuncSetPreferences = f
+
+	return mmSetPreferences
+}
+
+// Return sets up results that will be returned by Repository.SetPreferences
+func (mmSetPreferences *mRepositoryMockSetPreferences) Return(err error) *RepositoryMock {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	if mmSetPreferences.defaultExpectation == nil {
+		mmSetPreferences.defaultExpectation = &RepositoryMockSetPreferencesExpectation{mock: mmSetPreferences.mock}
+	}
+	mmSetPreferences.defaultExpectation.results = &RepositoryMockSetPreferencesResults{err}
+	mmSetPreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPreferences.mock
+}
+
+// Set uses given function f to mock the Repository.SetPreferences method
+func (mmSetPreferences *mRepositoryMockSetPreferences) Set(f func(ctx context.Context, userID uuid.UUID, data []byte) (err error)) *RepositoryMock {
+	if mmSetPreferences.defaultExpectation != nil {
+		mmSetPreferences.mock.t.Fatalf("Default expectation is already set for the Repository.SetPreferences method")
+	}
+
+	if len(mmSetPreferences.expectations) > 0 {
+		mmSetPreferences.mock.t.Fatalf("Some expectations are already set for the Repository.SetPreferences method")
+	}
+
+	mmSetPreferences.mock.funcSetPreferences = f
+	mmSetPreferences.mock.funcSetPreferencesOrigin = minimock.CallerInfo(1)
+	return mmSetPreferences.mock
+}
+
+// When sets expectation for the Repository.SetPreferences which will trigger the result defined by the following
+// Then helper
+func (mmSetPreferences *mRepositoryMockSetPreferences) When(ctx context.Context, userID uuid.UUID, data []byte) *RepositoryMockSetPreferencesExpectation {
+	if mmSetPreferences.mock.funcSetPreferences != nil {
+		mmSetPreferences.mock.t.Fatalf("RepositoryMock.SetPreferences mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSetPreferencesExpectation{
+		mock:               mmSetPreferences.mock,
+		params:             &RepositoryMockSetPreferencesParams{ctx, userID, data},
+		expectationOrigins: RepositoryMockSetPreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPreferences.expectations = append(mmSetPreferences.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.SetPreferences return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSetPreferencesExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSetPreferencesResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.SetPreferences should be invoked
+func (mmSetPreferences *mRepositoryMockSetPreferences) Times(n uint64) *mRepositoryMockSetPreferences {
+	if n == 0 {
+		mmSetPreferences.mock.t.Fatalf("Times of RepositoryMock.SetPreferences mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPreferences.expectedInvocations, n)
+	mmSetPreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPreferences
+}
+
+func (mmSetPreferences *mRepositoryMockSetPreferences) invocationsDone() bool {
+	if len(mmSetPreferences.expectations) == 0 && mmSetPreferences.defaultExpectation == nil && mmSetPreferences.mock.funcSetPreferences == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPreferences.mock.afterSetPreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPreferences.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPreferences implements mm_user.Repository
+func (mmSetPreferences *RepositoryMock) SetPreferences(ctx context.Context, userID uuid.UUID, data []byte) (err error) {
+	mm_atomic.AddUint64(&mmSetPreferences.beforeSetPreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPreferences.afterSetPreferencesCounter, 1)
+
+	mmSetPreferences.t.Helper()
+
+	if mmSetPreferences.inspectFuncSetPreferences != nil {
+		mmSetPreferences.inspectFuncSetPreferences(ctx, userID, data)
+	}
+
+	mm_params := RepositoryMockSetPreferencesParams{ctx, userID, data}
+
+	// Record call args
+	mmSetPreferences.SetPreferencesMock.mutex.Lock()
+	mmSetPreferences.SetPreferencesMock.callArgs = append(mmSetPreferences.SetPreferencesMock.callArgs, &mm_params)
+	mmSetPreferences.SetPreferencesMock.mutex.Unlock()
+
+	for _, e := range mmSetPreferences.SetPreferencesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPreferences.SetPreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPreferences.SetPreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPreferences.SetPreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPreferences.SetPreferencesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSetPreferencesParams{ctx, userID, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPreferences.t.Errorf("RepositoryMock.SetPreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPreferences.SetPreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetPreferences.t.Errorf("RepositoryMock.SetPreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPreferences.SetPreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmSetPreferences.t.Errorf("RepositoryMock.SetPreferences got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPreferences.SetPreferencesMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPreferences.t.Errorf("RepositoryMock.SetPreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPreferences.SetPreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPreferences.SetPreferencesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPreferences.t.Fatal("No results are set for the RepositoryMock.SetPreferences")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPreferences.funcSetPreferences != nil {
+		return mmSetPreferences.funcSetPreferences(ctx, userID, data)
+	}
+	mmSetPreferences.t.Fatalf("Unexpected call to RepositoryMock.SetPreferences. %v %v %v", ctx, userID, data)
+	return
+}
+
+// SetPreferencesAfterCounter returns a count of finished RepositoryMock.SetPreferences invocations
+func (mmSetPreferences *RepositoryMock) SetPreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPreferences.afterSetPreferencesCounter)
+}
+
+// SetPreferencesBeforeCounter returns a count of RepositoryMock.SetPreferences invocations
+func (mmSetPreferences *RepositoryMock) SetPreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPreferences.beforeSetPreferencesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SetPreferences.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPreferences *mRepositoryMockSetPreferences) Calls() []*RepositoryMockSetPreferencesParams {
+	mmSetPreferences.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSetPreferencesParams, len(mmSetPreferences.callArgs))
+	copy(argCopy, mmSetPreferences.callArgs)
+
+	mmSetPreferences.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPreferencesDone returns true if the count of the SetPreferences invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSetPreferencesDone() bool {
+	if m.SetPreferencesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPreferencesMock.invocationsDone()
+}
+
+// MinimockSetPreferencesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSetPreferencesInspect() {
+	for _, e := range m.SetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SetPreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPreferencesCounter := mm_atomic.LoadUint64(&m.afterSetPreferencesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPreferencesMock.defaultExpectation != nil && afterSetPreferencesCounter < 1 {
+		if m.SetPreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SetPreferences at\n%s", m.SetPreferencesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SetPreferences at\n%s with params: %#v", m.SetPreferencesMock.defaultExpectation.expectationOrigins.origin, *m.SetPreferencesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPreferences != nil && afterSetPreferencesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SetPreferences at\n%s", m.funcSetPreferencesOrigin)
+	}
+
+	if !m.SetPreferencesMock.invocationsDone() && afterSetPreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SetPreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPreferencesMock.expectedInvocations), m.SetPreferencesMock.expectedInvocationsOrigin, afterSetPreferencesCounter)
+	}
+}
+
+type mRepositoryMockUpdatePasswordHash struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdatePasswordHashExpectation
+	expectations       []*RepositoryMockUpdatePasswordHashExpectation
+
+	callArgs []*RepositoryMockUpdatePasswordHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdatePasswordHashExpectation specifies expectation struct of the Repository.UpdatePasswordHash
+type RepositoryMockUpdatePasswordHashExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdatePasswordHashParams
+	paramPtrs          *RepositoryMockUpdatePasswordHashParamPtrs
+	expectationOrigins RepositoryMockUpdatePasswordHashExpectationOrigins
+	results            *RepositoryMockUpdatePasswordHashResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdatePasswordHashParams contains parameters of the Repository.UpdatePasswordHash
+type RepositoryMockUpdatePasswordHashParams struct {
+	ctx     context.Context
+	id      uuid.UUID
+	oldHash string
+	newHash string
+}
+
+// RepositoryMockUpdatePasswordHashParamPtrs contains pointers to parameters of the Repository.UpdatePasswordHash
+type RepositoryMockUpdatePasswordHashParamPtrs struct {
+	ctx     *context.Context
+	id      *uuid.UUID
+	oldHash *string
+	newHash *string
+}
+
+// RepositoryMockUpdatePasswordHashResults contains results of the Repository.UpdatePasswordHash
+type RepositoryMockUpdatePasswordHashResults struct {
+	err error
+}
+
+// RepositoryMockUpdatePasswordHashOrigins contains origins of expectations of the Repository.UpdatePasswordHash
+type RepositoryMockUpdatePasswordHashExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originId      string
+	originOldHash string
+	originNewHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Optional() *mRepositoryMockUpdatePasswordHash {
+	mmUpdatePasswordHash.optional = true
+	return mmUpdatePasswordHash
+}
+
+// Expect sets up expected params for Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Expect(ctx context.Context, id uuid.UUID, oldHash string, newHash string) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePasswordHash.defaultExpectation.params = &RepositoryMockUpdatePasswordHashParams{ctx, id, oldHash, newHash}
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePasswordHash.expectations {
+		if minimock.Equal(e.params, mmUpdatePasswordHash.defaultExpectation.params) {
+			mmUpdatePasswordHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePasswordHash.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePasswordHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &RepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
+}
+
+// ExpectIdParam2 sets up expected param id for Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) ExpectIdParam2(id uuid.UUID) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &RepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
+}
+
+// ExpectOldHashParam3 sets up expected param oldHash for Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) ExpectOldHashParam3(oldHash string) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &RepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.oldHash = &oldHash
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originOldHash = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
+}
+
+// ExpectNewHashParam4 sets up expected param newHash for Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) ExpectNewHashParam4(newHash string) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &RepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.newHash = &newHash
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originNewHash = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Inspect(f func(ctx context.Context, id uuid.UUID, oldHash string, newHash string)) *mRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.inspectFuncUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdatePasswordHash")
+	}
+
+	mmUpdatePasswordHash.mock.inspectFuncUpdatePasswordHash = f
+
+	return mmUpdatePasswordHash
+}
+
+// Return sets up results that will be returned by Repository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Return(err error) *RepositoryMock {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &RepositoryMockUpdatePasswordHashExpectation{mock: mmUpdatePasswordHash.mock}
+	}
+	mmUpdatePasswordHash.defaultExpectation.results = &RepositoryMockUpdatePasswordHashResults{err}
+	mmUpdatePasswordHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash.mock
+}
+
+// Set uses given function f to mock the Repository.UpdatePasswordHash method
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Set(f func(ctx context.Context, id uuid.UUID, oldHash string, newHash string) (err error)) *RepositoryMock {
+	if mmUpdatePasswordHash.defaultExpectation != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("Default expectation is already set for the Repository.UpdatePasswordHash method")
+	}
+
+	if len(mmUpdatePasswordHash.expectations) > 0 {
+		mmUpdatePasswordHash.mock.t.Fatalf("Some expectations are already set for the Repository.UpdatePasswordHash method")
+	}
+
+	mmUpdatePasswordHash.mock.funcUpdatePasswordHash = f
+	mmUpdatePasswordHash.mock.funcUpdatePasswordHashOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash.mock
+}
+
+// When sets expectation for the Repository.UpdatePasswordHash which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) When(ctx context.Context, id uuid.UUID, oldHash string, newHash string) *RepositoryMockUpdatePasswordHashExpectation {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("RepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdatePasswordHashExpectation{
+		mock:               mmUpdatePasswordHash.mock,
+		params:             &RepositoryMockUpdatePasswordHashParams{ctx, id, oldHash, newHash},
+		expectationOrigins: RepositoryMockUpdatePasswordHashExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePasswordHash.expectations = append(mmUpdatePasswordHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdatePasswordHash return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdatePasswordHashExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdatePasswordHashResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdatePasswordHash should be invoked
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Times(n uint64) *mRepositoryMockUpdatePasswordHash {
+	if n == 0 {
+		mmUpdatePasswordHash.mock.t.Fatalf("Times of RepositoryMock.UpdatePasswordHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePasswordHash.expectedInvocations, n)
+	mmUpdatePasswordHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash
+}
+
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) invocationsDone() bool {
+	if len(mmUpdatePasswordHash.expectations) == 0 && mmUpdatePasswordHash.defaultExpectation == nil && mmUpdatePasswordHash.mock.funcUpdatePasswordHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePasswordHash.mock.afterUpdatePasswordHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePasswordHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePasswordHash implements mm_user.Repository
+func (mmUpdatePasswordHash *RepositoryMock) UpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash string, newHash string) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePasswordHash.beforeUpdatePasswordHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePasswordHash.afterUpdatePasswordHashCounter, 1)
+
+	mmUpdatePasswordHash.t.Helper()
+
+	if mmUpdatePasswordHash.inspectFuncUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.inspectFuncUpdatePasswordHash(ctx, id, oldHash, newHash)
+	}
+
+	mm_params := RepositoryMockUpdatePasswordHashParams{ctx, id, oldHash, newHash}
+
+	// Record call args
+	mmUpdatePasswordHash.UpdatePasswordHashMock.mutex.Lock()
+	mmUpdatePasswordHash.UpdatePasswordHashMock.callArgs = append(mmUpdatePasswordHash.UpdatePasswordHashMock.callArgs, &mm_params)
+	mmUpdatePasswordHash.UpdatePasswordHashMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePasswordHash.UpdatePasswordHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdatePasswordHashParams{ctx, id, oldHash, newHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePasswordHash.t.Errorf("RepositoryMock.UpdatePasswordHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePasswordHash.t.Errorf("RepositoryMock.UpdatePasswordHash got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.oldHash != nil && !minimock.Equal(*mm_want_ptrs.oldHash, mm_got.oldHash) {
+				mmUpdatePasswordHash.t.Errorf("RepositoryMock.UpdatePasswordHash got unexpected parameter oldHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originOldHash, *mm_want_ptrs.oldHash, mm_got.oldHash, minimock.Diff(*mm_want_ptrs.oldHash, mm_got.oldHash))
+			}
+
+			if mm_want_ptrs.newHash != nil && !minimock.Equal(*mm_want_ptrs.newHash, mm_got.newHash) {
+				mmUpdatePasswordHash.t.Errorf("RepositoryMock.UpdatePasswordHash got unexpected parameter newHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originNewHash, *mm_want_ptrs.newHash, mm_got.newHash, minimock.Diff(*mm_want_ptrs.newHash, mm_got.newHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePasswordHash.t.Errorf("RepositoryMock.UpdatePasswordHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePasswordHash.t.Fatal("No results are set for the RepositoryMock.UpdatePasswordHash")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePasswordHash.funcUpdatePasswordHash != nil {
+		return mmUpdatePasswordHash.funcUpdatePasswordHash(ctx, id, oldHash, newHash)
+	}
+	mmUpdatePasswordHash.t.Fatalf("Unexpected call to RepositoryMock.UpdatePasswordHash. %v %v %v %v", ctx, id, oldHash, newHash)
+	return
+}
+
+// UpdatePasswordHashAfterCounter returns a count of finished RepositoryMock.UpdatePasswordHash invocations
+func (mmUpdatePasswordHash *RepositoryMock) UpdatePasswordHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePasswordHash.afterUpdatePasswordHashCounter)
+}
+
+// UpdatePasswordHashBeforeCounter returns a count of RepositoryMock.UpdatePasswordHash invocations
+func (mmUpdatePasswordHash *RepositoryMock) UpdatePasswordHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePasswordHash.beforeUpdatePasswordHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdatePasswordHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePasswordHash *mRepositoryMockUpdatePasswordHash) Calls() []*RepositoryMockUpdatePasswordHashParams {
+	mmUpdatePasswordHash.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpdatePasswordHashParams, len(mmUpdatePasswordHash.callArgs))
+	copy(argCopy, mmUpdatePasswordHash.callArgs)
+
+	mmUpdatePasswordHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePasswordHashDone returns true if the count of the UpdatePasswordHash invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdatePasswordHashDone() bool {
+	if m.UpdatePasswordHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePasswordHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePasswordHashMock.invocationsDone()
+}
+
+// MinimockUpdatePasswordHashInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdatePasswordHashInspect() {
+	for _, e := range m.UpdatePasswordHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePasswordHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdatePasswordHashCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePasswordHashMock.defaultExpectation != nil && afterUpdatePasswordHashCounter < 1 {
+		if m.UpdatePasswordHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePasswordHash at\n%s", m.UpdatePasswordHashMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdatePasswordHash at\n%s with params: %#v", m.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePasswordHash != nil && afterUpdatePasswordHashCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdatePasswordHash at\n%s", m.funcUpdatePasswordHashOrigin)
+	}
+
+	if !m.UpdatePasswordHashMock.invocationsDone() && afterUpdatePasswordHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdatePasswordHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordHashMock.expectedInvocations), m.UpdatePasswordHashMock.expectedInvocationsOrigin, afterUpdatePasswordHashCounter)
+	}
+}
+
+type mRepositoryMockUpdateProfile struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateProfileExpectation
+	expectations       []*RepositoryMockUpdateProfileExpectation
+
+	callArgs []*RepositoryMockUpdateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdateProfileExpectation specifies expectation struct of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdateProfileParams
+	paramPtrs          *RepositoryMockUpdateProfileParamPtrs
+	expectationOrigins RepositoryMockUpdateProfileExpectationOrigins
+	results            *RepositoryMockUpdateProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdateProfileParams contains parameters of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileParams struct {
+	ctx context.Context
+	req mm_user.UpdateProfileReq
+}
+
+// RepositoryMockUpdateProfileParamPtrs contains pointers to parameters of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileParamPtrs struct {
+	ctx *context.Context
+	req *mm_user.UpdateProfileReq
+}
+
+// RepositoryMockUpdateProfileResults contains results of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileResults struct {
+	err error
+}
+
+// RepositoryMockUpdateProfileOrigins contains origins of expectations of the Repository.UpdateProfile
+type RepositoryMockUpdateProfileExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Optional() *mRepositoryMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
+}
+
+// Expect sets up expected params for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Expect(ctx context.Context, req mm_user.UpdateProfileReq) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateProfile.defaultExpectation.params = &RepositoryMockUpdateProfileParams{ctx, req}
+	mmUpdateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &RepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
+}
+
+// ExpectReqParam2 sets up expected param req for Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) ExpectReqParam2(req mm_user.UpdateProfileReq) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &RepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.req = &req
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Inspect(f func(ctx context.Context, req mm_user.UpdateProfileReq)) *mRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateProfile")
+	}
+
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
+
+	return mmUpdateProfile
+}
+
+// Return sets up results that will be returned by Repository.UpdateProfile
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Return(err error) *RepositoryMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &RepositoryMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
+	}
+	mmUpdateProfile.defaultExpectation.results = &RepositoryMockUpdateProfileResults{err}
+	mmUpdateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
+}
+
+// Set uses given function f to mock the Repository.UpdateProfile method
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Set(f func(ctx context.Context, req mm_user.UpdateProfileReq) (err error)) *RepositoryMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the Repository.UpdateProfile method")
+	}
+
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the Repository.UpdateProfile method")
+	}
+
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	mmUpdateProfile.mock.funcUpdateProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
+}
+
+// When sets expectation for the Repository.UpdateProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) When(ctx context.Context, req mm_user.UpdateProfileReq) *RepositoryMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("RepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockUpdateProfileExpectation{
+		mock:               mmUpdateProfile.mock,
+		params:             &RepositoryMockUpdateProfileParams{ctx, req},
+		expectationOrigins: RepositoryMockUpdateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockUpdateProfileExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockUpdateProfileResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.UpdateProfile should be invoked
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Times(n uint64) *mRepositoryMockUpdateProfile {
+	if n == 0 {
+		mmUpdateProfile.mock.t.Fatalf("Times of RepositoryMock.UpdateProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	mmUpdateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile
+}
+
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateProfile implements mm_user.Repository
+func (mmUpdateProfile *RepositoryMock) UpdateProfile(ctx context.Context, req mm_user.UpdateProfileReq) (err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
+
+	mmUpdateProfile.t.Helper()
+
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, req)
+	}
+
+	mm_params := RepositoryMockUpdateProfileParams{ctx, req}
+
+	// Record call args
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockUpdateProfileParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateProfile.t.Errorf("RepositoryMock.UpdateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateProfile.t.Fatal("No results are set for the RepositoryMock.UpdateProfile")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, req)
+	}
+	mmUpdateProfile.t.Fatalf("Unexpected call to RepositoryMock.UpdateProfile. %v %v", ctx, req)
+	return
+}
+
+// UpdateProfileAfterCounter returns a count of finished RepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *RepositoryMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
+}
+
+// UpdateProfileBeforeCounter returns a count of RepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *RepositoryMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.UpdateProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateProfile *mRepositoryMockUpdateProfile) Calls() []*RepositoryMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
+
+	mmUpdateProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateProfileMock.invocationsDone()
+}
+
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s", m.UpdateProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s with params: %#v", m.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.UpdateProfile at\n%s", m.funcUpdateProfileOrigin)
+	}
+
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.UpdateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), m.UpdateProfileMock.expectedInvocationsOrigin, afterUpdateProfileCounter)
+	}
+}
+
+type mRepositoryMockUpdateUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockUpdateUserExpectation
+	expectations       []*RepositoryMockUpdateUserExpectation
+
+	callArgs []*RepositoryMockUpdateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockUpdateUserExpectation specifies expectation struct of the Repository.UpdateUser
+type RepositoryMockUpdateUserExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockUpdateUserParams
+	paramPtrs          *RepositoryMockUpdateUserParamPtrs
+	expectationOrigins RepositoryMockUpdateUserExpectationOrigins
+	results            *RepositoryMockUpdateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockUpdateUserParams contains parameters of the Repository.UpdateUser
+type RepositoryMockUpdateUserParams struct {
+	ctx context.Context
+	req mm_user.UpdateUserReq
+}
+
+// RepositoryMockUpdateUserParamPtrs contains pointers to parameters of the Repository.UpdateUser
+type RepositoryMockUpdateUserParamPtrs struct {
+	ctx *context.Context
+	req *mm_user.UpdateUserReq
+}
+
+// RepositoryMockUpdateUserResults contains results of the Repository.UpdateUser
+type RepositoryMockUpdateUserResults struct {
+	err error
+}
+
+// RepositoryMockUpdateUserOrigins contains origins of expectations of the Repository.UpdateUser
+type RepositoryMockUpdateUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUser *mRepositoryMockUpdateUser) Optional() *mRepositoryMockUpdateUser {
+	mmUpdateUser.optional = true
+	return mmUpdateUser
+}
+
+// Expect sets up expected params for Repository.UpdateUser
+func (mmUpdateUser *mRepositoryMockUpdateUser) Expect(ctx context.Context, req mm_user.UpdateUserReq) *mRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUser.defaultExpectation.params = &RepositoryMockUpdateUserParams{ctx, req}
+	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateUser.expectations {
+		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
+			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.UpdateUser
+func (mmUpdateUser *mRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &RepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// ExpectReqParam2 sets up expected param req for Repository.UpdateUser
+func (mmUpdateUser *mRepositoryMockUpdateUser) ExpectReqParam2(req mm_user.UpdateUserReq) *mRepositoryMockUpdateUser {
+	if mmUpdateUser.mock.funcUpdateUser != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Set")
+	}
+
+	if mmUpdateUser.defaultExpectation == nil {
+		mmUpdateUser.defaultExpectation = &RepositoryMockUpdateUserExpectation{}
+	}
+
+	if mmUpdateUser.defaultExpectation.params != nil {
+		mmUpdateUser.mock.t.Fatalf("RepositoryMock.UpdateUser mock is already set by Expect")
+	}
+
+	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
+		mmUpdateUser.defaultExpectation.paramPtrs = &RepositoryMockUpdateUserParamPtrs{}
+	}
+	mmUpdateUser.defaultExpectation.paramPtrs.req = &req
+	mmUpdateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.UpdateUser
+func (mmUpdateUser *mRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, req mm_user.UpdateUserReq)) *mRepositoryMockUpdateUser {
 	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
 		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.UpdateUser")
 	}
@@ -2569,6 +4868,8 @@ func (m *RepositoryMock) MinimockUpdateUserInspect() {
 func (m *RepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAnonymizeUserInspect()
+
 			m.MinimockChangePasswordInspect()
 
 			m.MinimockCreateUserInspect()
@@ -2577,10 +4878,20 @@ func (m *RepositoryMock) MinimockFinish() {
 
 			m.MinimockGetAllUsersInspect()
 
+			m.MinimockGetPreferencesInspect()
+
 			m.MinimockGetUserInspect()
 
 			m.MinimockGetUserByEmailInspect()
 
+			m.MinimockLinkExternalUserInspect()
+
+			m.MinimockSetPreferencesInspect()
+
+			m.MinimockUpdatePasswordHashInspect()
+
+			m.MinimockUpdateProfileInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -2605,11 +4916,17 @@ func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAnonymizeUserDone() &&
 		m.MinimockChangePasswordDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetAllUsersDone() &&
+		m.MinimockGetPreferencesDone() &&
 		m.MinimockGetUserDone() &&
 		m.MinimockGetUserByEmailDone() &&
+		m.MinimockLinkExternalUserDone() &&
+		m.MinimockSetPreferencesDone() &&
+		m.MinimockUpdatePasswordHashDone() &&
+		m.MinimockUpdateProfileDone() &&
 		m.MinimockUpdateUserDone()
 }
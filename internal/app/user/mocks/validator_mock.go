@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -9,6 +9,7 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	mm_user "github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -31,6 +32,20 @@ type ValidatorMock struct {
 	beforeNormalizeNameCounter uint64
 	NormalizeNameMock          mValidatorMockNormalizeName
 
+	funcNormalizeProfile          func(req mm_user.UpdateProfileReq) (u1 mm_user.UpdateProfileReq)
+	funcNormalizeProfileOrigin    string
+	inspectFuncNormalizeProfile   func(req mm_user.UpdateProfileReq)
+	afterNormalizeProfileCounter  uint64
+	beforeNormalizeProfileCounter uint64
+	NormalizeProfileMock          mValidatorMockNormalizeProfile
+
+	funcValidateAvatar          func(data []byte) (s1 string, err error)
+	funcValidateAvatarOrigin    string
+	inspectFuncValidateAvatar   func(data []byte)
+	afterValidateAvatarCounter  uint64
+	beforeValidateAvatarCounter uint64
+	ValidateAvatarMock          mValidatorMockValidateAvatar
+
 	funcValidateEmail          func(address string, validateLength bool) (err error)
 	funcValidateEmailOrigin    string
 	inspectFuncValidateEmail   func(address string, validateLength bool)
@@ -51,6 +66,20 @@ type ValidatorMock struct {
 	afterValidatePasswordCounter  uint64
 	beforeValidatePasswordCounter uint64
 	ValidatePasswordMock          mValidatorMockValidatePassword
+
+	funcValidatePreferences          func(prefs mm_user.Preferences) (err error)
+	funcValidatePreferencesOrigin    string
+	inspectFuncValidatePreferences   func(prefs mm_user.Preferences)
+	afterValidatePreferencesCounter  uint64
+	beforeValidatePreferencesCounter uint64
+	ValidatePreferencesMock          mValidatorMockValidatePreferences
+
+	funcValidateProfile          func(req mm_user.UpdateProfileReq) (err error)
+	funcValidateProfileOrigin    string
+	inspectFuncValidateProfile   func(req mm_user.UpdateProfileReq)
+	afterValidateProfileCounter  uint64
+	beforeValidateProfileCounter uint64
+	ValidateProfileMock          mValidatorMockValidateProfile
 }
 
 // NewValidatorMock returns a mock for mm_user.Validator
@@ -67,6 +96,12 @@ func NewValidatorMock(t minimock.Tester) *ValidatorMock {
 	m.NormalizeNameMock = mValidatorMockNormalizeName{mock: m}
 	m.NormalizeNameMock.callArgs = []*ValidatorMockNormalizeNameParams{}
 
+	m.NormalizeProfileMock = mValidatorMockNormalizeProfile{mock: m}
+	m.NormalizeProfileMock.callArgs = []*ValidatorMockNormalizeProfileParams{}
+
+	m.ValidateAvatarMock = mValidatorMockValidateAvatar{mock: m}
+	m.ValidateAvatarMock.callArgs = []*ValidatorMockValidateAvatarParams{}
+
 	m.ValidateEmailMock = mValidatorMockValidateEmail{mock: m}
 	m.ValidateEmailMock.callArgs = []*ValidatorMockValidateEmailParams{}
 
@@ -76,6 +111,12 @@ func NewValidatorMock(t minimock.Tester) *ValidatorMock {
 	m.ValidatePasswordMock = mValidatorMockValidatePassword{mock: m}
 	m.ValidatePasswordMock.callArgs = []*ValidatorMockValidatePasswordParams{}
 
+	m.ValidatePreferencesMock = mValidatorMockValidatePreferences{mock: m}
+	m.ValidatePreferencesMock.callArgs = []*ValidatorMockValidatePreferencesParams{}
+
+	m.ValidateProfileMock = mValidatorMockValidateProfile{mock: m}
+	m.ValidateProfileMock.callArgs = []*ValidatorMockValidateProfileParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -703,52 +744,49 @@ func (m *ValidatorMock) MinimockNormalizeNameInspect() {
 	}
 }
 
-type mValidatorMockValidateEmail struct {
+type mValidatorMockNormalizeProfile struct {
 	optional           bool
 	mock               *ValidatorMock
-	defaultExpectation *ValidatorMockValidateEmailExpectation
-	expectations       []*ValidatorMockValidateEmailExpectation
+	defaultExpectation *ValidatorMockNormalizeProfileExpectation
+	expectations       []*ValidatorMockNormalizeProfileExpectation
 
-	callArgs []*ValidatorMockValidateEmailParams
+	callArgs []*ValidatorMockNormalizeProfileParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ValidatorMockValidateEmailExpectation specifies expectation struct of the Validator.ValidateEmail
-type ValidatorMockValidateEmailExpectation struct {
+// ValidatorMockNormalizeProfileExpectation specifies expectation struct of the Validator.NormalizeProfile
+type ValidatorMockNormalizeProfileExpectation struct {
 	mock               *ValidatorMock
-	params             *ValidatorMockValidateEmailParams
-	paramPtrs          *ValidatorMockValidateEmailParamPtrs
-	expectationOrigins ValidatorMockValidateEmailExpectationOrigins
-	results            *ValidatorMockValidateEmailResults
+	params             *ValidatorMockNormalizeProfileParams
+	paramPtrs          *ValidatorMockNormalizeProfileParamPtrs
+	expectationOrigins ValidatorMockNormalizeProfileExpectationOrigins
+	results            *ValidatorMockNormalizeProfileResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ValidatorMockValidateEmailParams contains parameters of the Validator.ValidateEmail
-type ValidatorMockValidateEmailParams struct {
-	address        string
-	validateLength bool
+// ValidatorMockNormalizeProfileParams contains parameters of the Validator.NormalizeProfile
+type ValidatorMockNormalizeProfileParams struct {
+	req mm_user.UpdateProfileReq
 }
 
-// ValidatorMockValidateEmailParamPtrs contains pointers to parameters of the Validator.ValidateEmail
-type ValidatorMockValidateEmailParamPtrs struct {
-	address        *string
-	validateLength *bool
+// ValidatorMockNormalizeProfileParamPtrs contains pointers to parameters of the Validator.NormalizeProfile
+type ValidatorMockNormalizeProfileParamPtrs struct {
+	req *mm_user.UpdateProfileReq
 }
 
-// ValidatorMockValidateEmailResults contains results of the Validator.ValidateEmail
-type ValidatorMockValidateEmailResults struct {
-	err error
+// ValidatorMockNormalizeProfileResults contains results of the Validator.NormalizeProfile
+type ValidatorMockNormalizeProfileResults struct {
+	u1 mm_user.UpdateProfileReq
 }
 
-// ValidatorMockValidateEmailOrigins contains origins of expectations of the Validator.ValidateEmail
-type ValidatorMockValidateEmailExpectationOrigins struct {
-	origin               string
-	originAddress        string
-	originValidateLength string
+// ValidatorMockNormalizeProfileOrigins contains origins of expectations of the Validator.NormalizeProfile
+type ValidatorMockNormalizeProfileExpectationOrigins struct {
+	origin    string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -756,338 +794,311 @@ type ValidatorMockValidateEmailExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmValidateEmail *mValidatorMockValidateEmail) Optional() *mValidatorMockValidateEmail {
-	mmValidateEmail.optional = true
-	return mmValidateEmail
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Optional() *mValidatorMockNormalizeProfile {
+	mmNormalizeProfile.optional = true
+	return mmNormalizeProfile
 }
 
-// Expect sets up expected params for Validator.ValidateEmail
-func (mmValidateEmail *mValidatorMockValidateEmail) Expect(address string, validateLength bool) *mValidatorMockValidateEmail {
-	if mmValidateEmail.mock.funcValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+// Expect sets up expected params for Validator.NormalizeProfile
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Expect(req mm_user.UpdateProfileReq) *mValidatorMockNormalizeProfile {
+	if mmNormalizeProfile.mock.funcNormalizeProfile != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by Set")
 	}
 
-	if mmValidateEmail.defaultExpectation == nil {
-		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
+	if mmNormalizeProfile.defaultExpectation == nil {
+		mmNormalizeProfile.defaultExpectation = &ValidatorMockNormalizeProfileExpectation{}
 	}
 
-	if mmValidateEmail.defaultExpectation.paramPtrs != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by ExpectParams functions")
+	if mmNormalizeProfile.defaultExpectation.paramPtrs != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by ExpectParams functions")
 	}
 
-	mmValidateEmail.defaultExpectation.params = &ValidatorMockValidateEmailParams{address, validateLength}
-	mmValidateEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmValidateEmail.expectations {
-		if minimock.Equal(e.params, mmValidateEmail.defaultExpectation.params) {
-			mmValidateEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateEmail.defaultExpectation.params)
+	mmNormalizeProfile.defaultExpectation.params = &ValidatorMockNormalizeProfileParams{req}
+	mmNormalizeProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNormalizeProfile.expectations {
+		if minimock.Equal(e.params, mmNormalizeProfile.defaultExpectation.params) {
+			mmNormalizeProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNormalizeProfile.defaultExpectation.params)
 		}
 	}
 
-	return mmValidateEmail
-}
-
-// ExpectAddressParam1 sets up expected param address for Validator.ValidateEmail
-func (mmValidateEmail *mValidatorMockValidateEmail) ExpectAddressParam1(address string) *mValidatorMockValidateEmail {
-	if mmValidateEmail.mock.funcValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
-	}
-
-	if mmValidateEmail.defaultExpectation == nil {
-		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
-	}
-
-	if mmValidateEmail.defaultExpectation.params != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Expect")
-	}
-
-	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
-		mmValidateEmail.defaultExpectation.paramPtrs = &ValidatorMockValidateEmailParamPtrs{}
-	}
-	mmValidateEmail.defaultExpectation.paramPtrs.address = &address
-	mmValidateEmail.defaultExpectation.expectationOrigins.originAddress = minimock.CallerInfo(1)
-
-	return mmValidateEmail
+	return mmNormalizeProfile
 }
 
-// ExpectValidateLengthParam2 sets up expected param validateLength for Validator.ValidateEmail
-func (mmValidateEmail *mValidatorMockValidateEmail) ExpectValidateLengthParam2(validateLength bool) *mValidatorMockValidateEmail {
-	if mmValidateEmail.mock.funcValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+// ExpectReqParam1 sets up expected param req for Validator.NormalizeProfile
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) ExpectReqParam1(req mm_user.UpdateProfileReq) *mValidatorMockNormalizeProfile {
+	if mmNormalizeProfile.mock.funcNormalizeProfile != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by Set")
 	}
 
-	if mmValidateEmail.defaultExpectation == nil {
-		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
+	if mmNormalizeProfile.defaultExpectation == nil {
+		mmNormalizeProfile.defaultExpectation = &ValidatorMockNormalizeProfileExpectation{}
 	}
 
-	if mmValidateEmail.defaultExpectation.params != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Expect")
+	if mmNormalizeProfile.defaultExpectation.params != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by Expect")
 	}
 
-	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
-		mmValidateEmail.defaultExpectation.paramPtrs = &ValidatorMockValidateEmailParamPtrs{}
+	if mmNormalizeProfile.defaultExpectation.paramPtrs == nil {
+		mmNormalizeProfile.defaultExpectation.paramPtrs = &ValidatorMockNormalizeProfileParamPtrs{}
 	}
-	mmValidateEmail.defaultExpectation.paramPtrs.validateLength = &validateLength
-	mmValidateEmail.defaultExpectation.expectationOrigins.originValidateLength = minimock.CallerInfo(1)
+	mmNormalizeProfile.defaultExpectation.paramPtrs.req = &req
+	mmNormalizeProfile.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmValidateEmail
+	return mmNormalizeProfile
 }
 
-// Inspect accepts an inspector function that has same arguments as the Validator.ValidateEmail
-func (mmValidateEmail *mValidatorMockValidateEmail) Inspect(f func(address string, validateLength bool)) *mValidatorMockValidateEmail {
-	if mmValidateEmail.mock.inspectFuncValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateEmail")
+// Inspect accepts an inspector function that has same arguments as the Validator.NormalizeProfile
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Inspect(f func(req mm_user.UpdateProfileReq)) *mValidatorMockNormalizeProfile {
+	if mmNormalizeProfile.mock.inspectFuncNormalizeProfile != nil {
+		mmNormalizeProfile.mock.t.Fatalf("Inspect function is already set for ValidatorMock.NormalizeProfile")
 	}
 
-	mmValidateEmail.mock.inspectFuncValidateEmail = f
+	mmNormalizeProfile.mock.inspectFuncNormalizeProfile = f
 
-	return mmValidateEmail
+	return mmNormalizeProfile
 }
 
-// Return sets up results that will be returned by Validator.ValidateEmail
-func (mmValidateEmail *mValidatorMockValidateEmail) Return(err error) *ValidatorMock {
-	if mmValidateEmail.mock.funcValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+// Return sets up results that will be returned by Validator.NormalizeProfile
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Return(u1 mm_user.UpdateProfileReq) *ValidatorMock {
+	if mmNormalizeProfile.mock.funcNormalizeProfile != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by Set")
 	}
 
-	if mmValidateEmail.defaultExpectation == nil {
-		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{mock: mmValidateEmail.mock}
+	if mmNormalizeProfile.defaultExpectation == nil {
+		mmNormalizeProfile.defaultExpectation = &ValidatorMockNormalizeProfileExpectation{mock: mmNormalizeProfile.mock}
 	}
-	mmValidateEmail.defaultExpectation.results = &ValidatorMockValidateEmailResults{err}
-	mmValidateEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmValidateEmail.mock
+	mmNormalizeProfile.defaultExpectation.results = &ValidatorMockNormalizeProfileResults{u1}
+	mmNormalizeProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNormalizeProfile.mock
 }
 
-// Set uses given function f to mock the Validator.ValidateEmail method
-func (mmValidateEmail *mValidatorMockValidateEmail) Set(f func(address string, validateLength bool) (err error)) *ValidatorMock {
-	if mmValidateEmail.defaultExpectation != nil {
-		mmValidateEmail.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateEmail method")
+// Set uses given function f to mock the Validator.NormalizeProfile method
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Set(f func(req mm_user.UpdateProfileReq) (u1 mm_user.UpdateProfileReq)) *ValidatorMock {
+	if mmNormalizeProfile.defaultExpectation != nil {
+		mmNormalizeProfile.mock.t.Fatalf("Default expectation is already set for the Validator.NormalizeProfile method")
 	}
 
-	if len(mmValidateEmail.expectations) > 0 {
-		mmValidateEmail.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateEmail method")
+	if len(mmNormalizeProfile.expectations) > 0 {
+		mmNormalizeProfile.mock.t.Fatalf("Some expectations are already set for the Validator.NormalizeProfile method")
 	}
 
-	mmValidateEmail.mock.funcValidateEmail = f
-	mmValidateEmail.mock.funcValidateEmailOrigin = minimock.CallerInfo(1)
-	return mmValidateEmail.mock
+	mmNormalizeProfile.mock.funcNormalizeProfile = f
+	mmNormalizeProfile.mock.funcNormalizeProfileOrigin = minimock.CallerInfo(1)
+	return mmNormalizeProfile.mock
 }
 
-// When sets expectation for the Validator.ValidateEmail which will trigger the result defined by the following
+// When sets expectation for the Validator.NormalizeProfile which will trigger the result defined by the following
 // Then helper
-func (mmValidateEmail *mValidatorMockValidateEmail) When(address string, validateLength bool) *ValidatorMockValidateEmailExpectation {
-	if mmValidateEmail.mock.funcValidateEmail != nil {
-		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) When(req mm_user.UpdateProfileReq) *ValidatorMockNormalizeProfileExpectation {
+	if mmNormalizeProfile.mock.funcNormalizeProfile != nil {
+		mmNormalizeProfile.mock.t.Fatalf("ValidatorMock.NormalizeProfile mock is already set by Set")
 	}
 
-	expectation := &ValidatorMockValidateEmailExpectation{
-		mock:               mmValidateEmail.mock,
-		params:             &ValidatorMockValidateEmailParams{address, validateLength},
-		expectationOrigins: ValidatorMockValidateEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ValidatorMockNormalizeProfileExpectation{
+		mock:               mmNormalizeProfile.mock,
+		params:             &ValidatorMockNormalizeProfileParams{req},
+		expectationOrigins: ValidatorMockNormalizeProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmValidateEmail.expectations = append(mmValidateEmail.expectations, expectation)
+	mmNormalizeProfile.expectations = append(mmNormalizeProfile.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Validator.ValidateEmail return parameters for the expectation previously defined by the When method
-func (e *ValidatorMockValidateEmailExpectation) Then(err error) *ValidatorMock {
-	e.results = &ValidatorMockValidateEmailResults{err}
+// Then sets up Validator.NormalizeProfile return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockNormalizeProfileExpectation) Then(u1 mm_user.UpdateProfileReq) *ValidatorMock {
+	e.results = &ValidatorMockNormalizeProfileResults{u1}
 	return e.mock
 }
 
-// Times sets number of times Validator.ValidateEmail should be invoked
-func (mmValidateEmail *mValidatorMockValidateEmail) Times(n uint64) *mValidatorMockValidateEmail {
+// Times sets number of times Validator.NormalizeProfile should be invoked
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Times(n uint64) *mValidatorMockNormalizeProfile {
 	if n == 0 {
-		mmValidateEmail.mock.t.Fatalf("Times of ValidatorMock.ValidateEmail mock can not be zero")
+		mmNormalizeProfile.mock.t.Fatalf("Times of ValidatorMock.NormalizeProfile mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmValidateEmail.expectedInvocations, n)
-	mmValidateEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmValidateEmail
+	mm_atomic.StoreUint64(&mmNormalizeProfile.expectedInvocations, n)
+	mmNormalizeProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNormalizeProfile
 }
 
-func (mmValidateEmail *mValidatorMockValidateEmail) invocationsDone() bool {
-	if len(mmValidateEmail.expectations) == 0 && mmValidateEmail.defaultExpectation == nil && mmValidateEmail.mock.funcValidateEmail == nil {
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) invocationsDone() bool {
+	if len(mmNormalizeProfile.expectations) == 0 && mmNormalizeProfile.defaultExpectation == nil && mmNormalizeProfile.mock.funcNormalizeProfile == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmValidateEmail.mock.afterValidateEmailCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmValidateEmail.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmNormalizeProfile.mock.afterNormalizeProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNormalizeProfile.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ValidateEmail implements mm_user.Validator
-func (mmValidateEmail *ValidatorMock) ValidateEmail(address string, validateLength bool) (err error) {
-	mm_atomic.AddUint64(&mmValidateEmail.beforeValidateEmailCounter, 1)
-	defer mm_atomic.AddUint64(&mmValidateEmail.afterValidateEmailCounter, 1)
+// NormalizeProfile implements mm_user.Validator
+func (mmNormalizeProfile *ValidatorMock) NormalizeProfile(req mm_user.UpdateProfileReq) (u1 mm_user.UpdateProfileReq) {
+	mm_atomic.AddUint64(&mmNormalizeProfile.beforeNormalizeProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmNormalizeProfile.afterNormalizeProfileCounter, 1)
 
-	mmValidateEmail.t.Helper()
+	mmNormalizeProfile.t.Helper()
 
-	if mmValidateEmail.inspectFuncValidateEmail != nil {
-		mmValidateEmail.inspectFuncValidateEmail(address, validateLength)
+	if mmNormalizeProfile.inspectFuncNormalizeProfile != nil {
+		mmNormalizeProfile.inspectFuncNormalizeProfile(req)
 	}
 
-	mm_params := ValidatorMockValidateEmailParams{address, validateLength}
+	mm_params := ValidatorMockNormalizeProfileParams{req}
 
 	// Record call args
-	mmValidateEmail.ValidateEmailMock.mutex.Lock()
-	mmValidateEmail.ValidateEmailMock.callArgs = append(mmValidateEmail.ValidateEmailMock.callArgs, &mm_params)
-	mmValidateEmail.ValidateEmailMock.mutex.Unlock()
+	mmNormalizeProfile.NormalizeProfileMock.mutex.Lock()
+	mmNormalizeProfile.NormalizeProfileMock.callArgs = append(mmNormalizeProfile.NormalizeProfileMock.callArgs, &mm_params)
+	mmNormalizeProfile.NormalizeProfileMock.mutex.Unlock()
 
-	for _, e := range mmValidateEmail.ValidateEmailMock.expectations {
+	for _, e := range mmNormalizeProfile.NormalizeProfileMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.u1
 		}
 	}
 
-	if mmValidateEmail.ValidateEmailMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmValidateEmail.ValidateEmailMock.defaultExpectation.Counter, 1)
-		mm_want := mmValidateEmail.ValidateEmailMock.defaultExpectation.params
-		mm_want_ptrs := mmValidateEmail.ValidateEmailMock.defaultExpectation.paramPtrs
+	if mmNormalizeProfile.NormalizeProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.paramPtrs
 
-		mm_got := ValidatorMockValidateEmailParams{address, validateLength}
+		mm_got := ValidatorMockNormalizeProfileParams{req}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.address != nil && !minimock.Equal(*mm_want_ptrs.address, mm_got.address) {
-				mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameter address, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originAddress, *mm_want_ptrs.address, mm_got.address, minimock.Diff(*mm_want_ptrs.address, mm_got.address))
-			}
-
-			if mm_want_ptrs.validateLength != nil && !minimock.Equal(*mm_want_ptrs.validateLength, mm_got.validateLength) {
-				mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameter validateLength, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originValidateLength, *mm_want_ptrs.validateLength, mm_got.validateLength, minimock.Diff(*mm_want_ptrs.validateLength, mm_got.validateLength))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmNormalizeProfile.t.Errorf("ValidatorMock.NormalizeProfile got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmNormalizeProfile.t.Errorf("ValidatorMock.NormalizeProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmValidateEmail.ValidateEmailMock.defaultExpectation.results
+		mm_results := mmNormalizeProfile.NormalizeProfileMock.defaultExpectation.results
 		if mm_results == nil {
-			mmValidateEmail.t.Fatal("No results are set for the ValidatorMock.ValidateEmail")
+			mmNormalizeProfile.t.Fatal("No results are set for the ValidatorMock.NormalizeProfile")
 		}
-		return (*mm_results).err
+		return (*mm_results).u1
 	}
-	if mmValidateEmail.funcValidateEmail != nil {
-		return mmValidateEmail.funcValidateEmail(address, validateLength)
+	if mmNormalizeProfile.funcNormalizeProfile != nil {
+		return mmNormalizeProfile.funcNormalizeProfile(req)
 	}
-	mmValidateEmail.t.Fatalf("Unexpected call to ValidatorMock.ValidateEmail. %v %v", address, validateLength)
+	mmNormalizeProfile.t.Fatalf("Unexpected call to ValidatorMock.NormalizeProfile. %v", req)
 	return
 }
 
-// ValidateEmailAfterCounter returns a count of finished ValidatorMock.ValidateEmail invocations
-func (mmValidateEmail *ValidatorMock) ValidateEmailAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateEmail.afterValidateEmailCounter)
+// NormalizeProfileAfterCounter returns a count of finished ValidatorMock.NormalizeProfile invocations
+func (mmNormalizeProfile *ValidatorMock) NormalizeProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNormalizeProfile.afterNormalizeProfileCounter)
 }
 
-// ValidateEmailBeforeCounter returns a count of ValidatorMock.ValidateEmail invocations
-func (mmValidateEmail *ValidatorMock) ValidateEmailBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateEmail.beforeValidateEmailCounter)
+// NormalizeProfileBeforeCounter returns a count of ValidatorMock.NormalizeProfile invocations
+func (mmNormalizeProfile *ValidatorMock) NormalizeProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNormalizeProfile.beforeNormalizeProfileCounter)
 }
 
-// Calls returns a list of arguments used in each call to ValidatorMock.ValidateEmail.
+// Calls returns a list of arguments used in each call to ValidatorMock.NormalizeProfile.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmValidateEmail *mValidatorMockValidateEmail) Calls() []*ValidatorMockValidateEmailParams {
-	mmValidateEmail.mutex.RLock()
+func (mmNormalizeProfile *mValidatorMockNormalizeProfile) Calls() []*ValidatorMockNormalizeProfileParams {
+	mmNormalizeProfile.mutex.RLock()
 
-	argCopy := make([]*ValidatorMockValidateEmailParams, len(mmValidateEmail.callArgs))
-	copy(argCopy, mmValidateEmail.callArgs)
+	argCopy := make([]*ValidatorMockNormalizeProfileParams, len(mmNormalizeProfile.callArgs))
+	copy(argCopy, mmNormalizeProfile.callArgs)
 
-	mmValidateEmail.mutex.RUnlock()
+	mmNormalizeProfile.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockValidateEmailDone returns true if the count of the ValidateEmail invocations corresponds
+// MinimockNormalizeProfileDone returns true if the count of the NormalizeProfile invocations corresponds
 // the number of defined expectations
-func (m *ValidatorMock) MinimockValidateEmailDone() bool {
-	if m.ValidateEmailMock.optional {
+func (m *ValidatorMock) MinimockNormalizeProfileDone() bool {
+	if m.NormalizeProfileMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ValidateEmailMock.expectations {
+	for _, e := range m.NormalizeProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ValidateEmailMock.invocationsDone()
+	return m.NormalizeProfileMock.invocationsDone()
 }
 
-// MinimockValidateEmailInspect logs each unmet expectation
-func (m *ValidatorMock) MinimockValidateEmailInspect() {
-	for _, e := range m.ValidateEmailMock.expectations {
+// MinimockNormalizeProfileInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockNormalizeProfileInspect() {
+	for _, e := range m.NormalizeProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ValidatorMock.NormalizeProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterValidateEmailCounter := mm_atomic.LoadUint64(&m.afterValidateEmailCounter)
+	afterNormalizeProfileCounter := mm_atomic.LoadUint64(&m.afterNormalizeProfileCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ValidateEmailMock.defaultExpectation != nil && afterValidateEmailCounter < 1 {
-		if m.ValidateEmailMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s", m.ValidateEmailMock.defaultExpectation.returnOrigin)
+	if m.NormalizeProfileMock.defaultExpectation != nil && afterNormalizeProfileCounter < 1 {
+		if m.NormalizeProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.NormalizeProfile at\n%s", m.NormalizeProfileMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s with params: %#v", m.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *m.ValidateEmailMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ValidatorMock.NormalizeProfile at\n%s with params: %#v", m.NormalizeProfileMock.defaultExpectation.expectationOrigins.origin, *m.NormalizeProfileMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcValidateEmail != nil && afterValidateEmailCounter < 1 {
-		m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s", m.funcValidateEmailOrigin)
+	if m.funcNormalizeProfile != nil && afterNormalizeProfileCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.NormalizeProfile at\n%s", m.funcNormalizeProfileOrigin)
 	}
 
-	if !m.ValidateEmailMock.invocationsDone() && afterValidateEmailCounter > 0 {
-		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateEmail at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ValidateEmailMock.expectedInvocations), m.ValidateEmailMock.expectedInvocationsOrigin, afterValidateEmailCounter)
+	if !m.NormalizeProfileMock.invocationsDone() && afterNormalizeProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.NormalizeProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NormalizeProfileMock.expectedInvocations), m.NormalizeProfileMock.expectedInvocationsOrigin, afterNormalizeProfileCounter)
 	}
 }
 
-type mValidatorMockValidateName struct {
+type mValidatorMockValidateAvatar struct {
 	optional           bool
 	mock               *ValidatorMock
-	defaultExpectation *ValidatorMockValidateNameExpectation
-	expectations       []*ValidatorMockValidateNameExpectation
+	defaultExpectation *ValidatorMockValidateAvatarExpectation
+	expectations       []*ValidatorMockValidateAvatarExpectation
 
-	callArgs []*ValidatorMockValidateNameParams
+	callArgs []*ValidatorMockValidateAvatarParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ValidatorMockValidateNameExpectation specifies expectation struct of the Validator.ValidateName
-type ValidatorMockValidateNameExpectation struct {
+// ValidatorMockValidateAvatarExpectation specifies expectation struct of the Validator.ValidateAvatar
+type ValidatorMockValidateAvatarExpectation struct {
 	mock               *ValidatorMock
-	params             *ValidatorMockValidateNameParams
-	paramPtrs          *ValidatorMockValidateNameParamPtrs
-	expectationOrigins ValidatorMockValidateNameExpectationOrigins
-	results            *ValidatorMockValidateNameResults
+	params             *ValidatorMockValidateAvatarParams
+	paramPtrs          *ValidatorMockValidateAvatarParamPtrs
+	expectationOrigins ValidatorMockValidateAvatarExpectationOrigins
+	results            *ValidatorMockValidateAvatarResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ValidatorMockValidateNameParams contains parameters of the Validator.ValidateName
-type ValidatorMockValidateNameParams struct {
-	name string
+// ValidatorMockValidateAvatarParams contains parameters of the Validator.ValidateAvatar
+type ValidatorMockValidateAvatarParams struct {
+	data []byte
 }
 
-// ValidatorMockValidateNameParamPtrs contains pointers to parameters of the Validator.ValidateName
-type ValidatorMockValidateNameParamPtrs struct {
-	name *string
+// ValidatorMockValidateAvatarParamPtrs contains pointers to parameters of the Validator.ValidateAvatar
+type ValidatorMockValidateAvatarParamPtrs struct {
+	data *[]byte
 }
 
-// ValidatorMockValidateNameResults contains results of the Validator.ValidateName
-type ValidatorMockValidateNameResults struct {
+// ValidatorMockValidateAvatarResults contains results of the Validator.ValidateAvatar
+type ValidatorMockValidateAvatarResults struct {
+	s1  string
 	err error
 }
 
-// ValidatorMockValidateNameOrigins contains origins of expectations of the Validator.ValidateName
-type ValidatorMockValidateNameExpectationOrigins struct {
+// ValidatorMockValidateAvatarOrigins contains origins of expectations of the Validator.ValidateAvatar
+type ValidatorMockValidateAvatarExpectationOrigins struct {
 	origin     string
-	originName string
+	originData string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1095,310 +1106,1585 @@ type ValidatorMockValidateNameExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmValidateName *mValidatorMockValidateName) Optional() *mValidatorMockValidateName {
-	mmValidateName.optional = true
-	return mmValidateName
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Optional() *mValidatorMockValidateAvatar {
+	mmValidateAvatar.optional = true
+	return mmValidateAvatar
 }
 
-// Expect sets up expected params for Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) Expect(name string) *mValidatorMockValidateName {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+// Expect sets up expected params for Validator.ValidateAvatar
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Expect(data []byte) *mValidatorMockValidateAvatar {
+	if mmValidateAvatar.mock.funcValidateAvatar != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by Set")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	if mmValidateAvatar.defaultExpectation == nil {
+		mmValidateAvatar.defaultExpectation = &ValidatorMockValidateAvatarExpectation{}
 	}
 
-	if mmValidateName.defaultExpectation.paramPtrs != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by ExpectParams functions")
+	if mmValidateAvatar.defaultExpectation.paramPtrs != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by ExpectParams functions")
 	}
 
-	mmValidateName.defaultExpectation.params = &ValidatorMockValidateNameParams{name}
-	mmValidateName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmValidateName.expectations {
-		if minimock.Equal(e.params, mmValidateName.defaultExpectation.params) {
-			mmValidateName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateName.defaultExpectation.params)
+	mmValidateAvatar.defaultExpectation.params = &ValidatorMockValidateAvatarParams{data}
+	mmValidateAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateAvatar.expectations {
+		if minimock.Equal(e.params, mmValidateAvatar.defaultExpectation.params) {
+			mmValidateAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateAvatar.defaultExpectation.params)
 		}
 	}
 
-	return mmValidateName
+	return mmValidateAvatar
 }
 
-// ExpectNameParam1 sets up expected param name for Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) ExpectNameParam1(name string) *mValidatorMockValidateName {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+// ExpectDataParam1 sets up expected param data for Validator.ValidateAvatar
+func (mmValidateAvatar *mValidatorMockValidateAvatar) ExpectDataParam1(data []byte) *mValidatorMockValidateAvatar {
+	if mmValidateAvatar.mock.funcValidateAvatar != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by Set")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	if mmValidateAvatar.defaultExpectation == nil {
+		mmValidateAvatar.defaultExpectation = &ValidatorMockValidateAvatarExpectation{}
 	}
 
-	if mmValidateName.defaultExpectation.params != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Expect")
+	if mmValidateAvatar.defaultExpectation.params != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by Expect")
 	}
 
-	if mmValidateName.defaultExpectation.paramPtrs == nil {
-		mmValidateName.defaultExpectation.paramPtrs = &ValidatorMockValidateNameParamPtrs{}
+	if mmValidateAvatar.defaultExpectation.paramPtrs == nil {
+		mmValidateAvatar.defaultExpectation.paramPtrs = &ValidatorMockValidateAvatarParamPtrs{}
 	}
-	mmValidateName.defaultExpectation.paramPtrs.name = &name
-	mmValidateName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+	mmValidateAvatar.defaultExpectation.paramPtrs.data = &data
+	mmValidateAvatar.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
 
-	return mmValidateName
+	return mmValidateAvatar
 }
 
-// Inspect accepts an inspector function that has same arguments as the Validator.ValidateName
-func (mmValidateName *mValidatorMockValidateName) Inspect(f func(name string)) *mValidatorMockValidateName {
-	if mmValidateName.mock.inspectFuncValidateName != nil {
-		mmValidateName.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateName")
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateAvatar
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Inspect(f func(data []byte)) *mValidatorMockValidateAvatar {
+	if mmValidateAvatar.mock.inspectFuncValidateAvatar != nil {
+		mmValidateAvatar.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateAvatar")
 	}
 
-	mmValidateName.mock.inspectFuncValidateName = f
+	mmValidateAvatar.mock.inspectFuncValidateAvatar = f
 
-	return mmValidateName
+	return mmValidateAvatar
 }
 
-// Return sets up results that will be returned by Validator.ValidateName
+// Return sets up results that will be returned by Validator.ValidateAvatar
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Return(s1 string, err error) *ValidatorMock {
+	if mmValidateAvatar.mock.funcValidateAvatar != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by Set")
+	}
+
+	if mmValidateAvatar.defaultExpectation == nil {
+		mmValidateAvatar.defaultExpectation = &ValidatorMockValidateAvatarExpectation{mock: mmValidateAvatar.mock}
+	}
+	mmValidateAvatar.defaultExpectation.results = &ValidatorMockValidateAvatarResults{s1, err}
+	mmValidateAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateAvatar.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateAvatar method
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Set(f func(data []byte) (s1 string, err error)) *ValidatorMock {
+	if mmValidateAvatar.defaultExpectation != nil {
+		mmValidateAvatar.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateAvatar method")
+	}
+
+	if len(mmValidateAvatar.expectations) > 0 {
+		mmValidateAvatar.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateAvatar method")
+	}
+
+	mmValidateAvatar.mock.funcValidateAvatar = f
+	mmValidateAvatar.mock.funcValidateAvatarOrigin = minimock.CallerInfo(1)
+	return mmValidateAvatar.mock
+}
+
+// When sets expectation for the Validator.ValidateAvatar which will trigger the result defined by the following
+// Then helper
+func (mmValidateAvatar *mValidatorMockValidateAvatar) When(data []byte) *ValidatorMockValidateAvatarExpectation {
+	if mmValidateAvatar.mock.funcValidateAvatar != nil {
+		mmValidateAvatar.mock.t.Fatalf("ValidatorMock.ValidateAvatar mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateAvatarExpectation{
+		mock:               mmValidateAvatar.mock,
+		params:             &ValidatorMockValidateAvatarParams{data},
+		expectationOrigins: ValidatorMockValidateAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateAvatar.expectations = append(mmValidateAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateAvatar return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateAvatarExpectation) Then(s1 string, err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateAvatarResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateAvatar should be invoked
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Times(n uint64) *mValidatorMockValidateAvatar {
+	if n == 0 {
+		mmValidateAvatar.mock.t.Fatalf("Times of ValidatorMock.ValidateAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateAvatar.expectedInvocations, n)
+	mmValidateAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateAvatar
+}
+
+func (mmValidateAvatar *mValidatorMockValidateAvatar) invocationsDone() bool {
+	if len(mmValidateAvatar.expectations) == 0 && mmValidateAvatar.defaultExpectation == nil && mmValidateAvatar.mock.funcValidateAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateAvatar.mock.afterValidateAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateAvatar implements mm_user.Validator
+func (mmValidateAvatar *ValidatorMock) ValidateAvatar(data []byte) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmValidateAvatar.beforeValidateAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateAvatar.afterValidateAvatarCounter, 1)
+
+	mmValidateAvatar.t.Helper()
+
+	if mmValidateAvatar.inspectFuncValidateAvatar != nil {
+		mmValidateAvatar.inspectFuncValidateAvatar(data)
+	}
+
+	mm_params := ValidatorMockValidateAvatarParams{data}
+
+	// Record call args
+	mmValidateAvatar.ValidateAvatarMock.mutex.Lock()
+	mmValidateAvatar.ValidateAvatarMock.callArgs = append(mmValidateAvatar.ValidateAvatarMock.callArgs, &mm_params)
+	mmValidateAvatar.ValidateAvatarMock.mutex.Unlock()
+
+	for _, e := range mmValidateAvatar.ValidateAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmValidateAvatar.ValidateAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateAvatar.ValidateAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateAvatar.ValidateAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateAvatar.ValidateAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateAvatarParams{data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmValidateAvatar.t.Errorf("ValidatorMock.ValidateAvatar got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateAvatar.ValidateAvatarMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateAvatar.t.Errorf("ValidatorMock.ValidateAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateAvatar.ValidateAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateAvatar.ValidateAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateAvatar.t.Fatal("No results are set for the ValidatorMock.ValidateAvatar")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmValidateAvatar.funcValidateAvatar != nil {
+		return mmValidateAvatar.funcValidateAvatar(data)
+	}
+	mmValidateAvatar.t.Fatalf("Unexpected call to ValidatorMock.ValidateAvatar. %v", data)
+	return
+}
+
+// ValidateAvatarAfterCounter returns a count of finished ValidatorMock.ValidateAvatar invocations
+func (mmValidateAvatar *ValidatorMock) ValidateAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateAvatar.afterValidateAvatarCounter)
+}
+
+// ValidateAvatarBeforeCounter returns a count of ValidatorMock.ValidateAvatar invocations
+func (mmValidateAvatar *ValidatorMock) ValidateAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateAvatar.beforeValidateAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateAvatar *mValidatorMockValidateAvatar) Calls() []*ValidatorMockValidateAvatarParams {
+	mmValidateAvatar.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateAvatarParams, len(mmValidateAvatar.callArgs))
+	copy(argCopy, mmValidateAvatar.callArgs)
+
+	mmValidateAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateAvatarDone returns true if the count of the ValidateAvatar invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateAvatarDone() bool {
+	if m.ValidateAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateAvatarMock.invocationsDone()
+}
+
+// MinimockValidateAvatarInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateAvatarInspect() {
+	for _, e := range m.ValidateAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateAvatarCounter := mm_atomic.LoadUint64(&m.afterValidateAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateAvatarMock.defaultExpectation != nil && afterValidateAvatarCounter < 1 {
+		if m.ValidateAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateAvatar at\n%s", m.ValidateAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateAvatar at\n%s with params: %#v", m.ValidateAvatarMock.defaultExpectation.expectationOrigins.origin, *m.ValidateAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateAvatar != nil && afterValidateAvatarCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateAvatar at\n%s", m.funcValidateAvatarOrigin)
+	}
+
+	if !m.ValidateAvatarMock.invocationsDone() && afterValidateAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateAvatarMock.expectedInvocations), m.ValidateAvatarMock.expectedInvocationsOrigin, afterValidateAvatarCounter)
+	}
+}
+
+type mValidatorMockValidateEmail struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateEmailExpectation
+	expectations       []*ValidatorMockValidateEmailExpectation
+
+	callArgs []*ValidatorMockValidateEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidateEmailExpectation specifies expectation struct of the Validator.ValidateEmail
+type ValidatorMockValidateEmailExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidateEmailParams
+	paramPtrs          *ValidatorMockValidateEmailParamPtrs
+	expectationOrigins ValidatorMockValidateEmailExpectationOrigins
+	results            *ValidatorMockValidateEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidateEmailParams contains parameters of the Validator.ValidateEmail
+type ValidatorMockValidateEmailParams struct {
+	address        string
+	validateLength bool
+}
+
+// ValidatorMockValidateEmailParamPtrs contains pointers to parameters of the Validator.ValidateEmail
+type ValidatorMockValidateEmailParamPtrs struct {
+	address        *string
+	validateLength *bool
+}
+
+// ValidatorMockValidateEmailResults contains results of the Validator.ValidateEmail
+type ValidatorMockValidateEmailResults struct {
+	err error
+}
+
+// ValidatorMockValidateEmailOrigins contains origins of expectations of the Validator.ValidateEmail
+type ValidatorMockValidateEmailExpectationOrigins struct {
+	origin               string
+	originAddress        string
+	originValidateLength string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateEmail *mValidatorMockValidateEmail) Optional() *mValidatorMockValidateEmail {
+	mmValidateEmail.optional = true
+	return mmValidateEmail
+}
+
+// Expect sets up expected params for Validator.ValidateEmail
+func (mmValidateEmail *mValidatorMockValidateEmail) Expect(address string, validateLength bool) *mValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by ExpectParams functions")
+	}
+
+	mmValidateEmail.defaultExpectation.params = &ValidatorMockValidateEmailParams{address, validateLength}
+	mmValidateEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateEmail.expectations {
+		if minimock.Equal(e.params, mmValidateEmail.defaultExpectation.params) {
+			mmValidateEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateEmail
+}
+
+// ExpectAddressParam1 sets up expected param address for Validator.ValidateEmail
+func (mmValidateEmail *mValidatorMockValidateEmail) ExpectAddressParam1(address string) *mValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.params != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Expect")
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
+		mmValidateEmail.defaultExpectation.paramPtrs = &ValidatorMockValidateEmailParamPtrs{}
+	}
+	mmValidateEmail.defaultExpectation.paramPtrs.address = &address
+	mmValidateEmail.defaultExpectation.expectationOrigins.originAddress = minimock.CallerInfo(1)
+
+	return mmValidateEmail
+}
+
+// ExpectValidateLengthParam2 sets up expected param validateLength for Validator.ValidateEmail
+func (mmValidateEmail *mValidatorMockValidateEmail) ExpectValidateLengthParam2(validateLength bool) *mValidatorMockValidateEmail {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{}
+	}
+
+	if mmValidateEmail.defaultExpectation.params != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Expect")
+	}
+
+	if mmValidateEmail.defaultExpectation.paramPtrs == nil {
+		mmValidateEmail.defaultExpectation.paramPtrs = &ValidatorMockValidateEmailParamPtrs{}
+	}
+	mmValidateEmail.defaultExpectation.paramPtrs.validateLength = &validateLength
+	mmValidateEmail.defaultExpectation.expectationOrigins.originValidateLength = minimock.CallerInfo(1)
+
+	return mmValidateEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateEmail
+func (mmValidateEmail *mValidatorMockValidateEmail) Inspect(f func(address string, validateLength bool)) *mValidatorMockValidateEmail {
+	if mmValidateEmail.mock.inspectFuncValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateEmail")
+	}
+
+	mmValidateEmail.mock.inspectFuncValidateEmail = f
+
+	return mmValidateEmail
+}
+
+// Return sets up results that will be returned by Validator.ValidateEmail
+func (mmValidateEmail *mValidatorMockValidateEmail) Return(err error) *ValidatorMock {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	if mmValidateEmail.defaultExpectation == nil {
+		mmValidateEmail.defaultExpectation = &ValidatorMockValidateEmailExpectation{mock: mmValidateEmail.mock}
+	}
+	mmValidateEmail.defaultExpectation.results = &ValidatorMockValidateEmailResults{err}
+	mmValidateEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateEmail method
+func (mmValidateEmail *mValidatorMockValidateEmail) Set(f func(address string, validateLength bool) (err error)) *ValidatorMock {
+	if mmValidateEmail.defaultExpectation != nil {
+		mmValidateEmail.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateEmail method")
+	}
+
+	if len(mmValidateEmail.expectations) > 0 {
+		mmValidateEmail.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateEmail method")
+	}
+
+	mmValidateEmail.mock.funcValidateEmail = f
+	mmValidateEmail.mock.funcValidateEmailOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail.mock
+}
+
+// When sets expectation for the Validator.ValidateEmail which will trigger the result defined by the following
+// Then helper
+func (mmValidateEmail *mValidatorMockValidateEmail) When(address string, validateLength bool) *ValidatorMockValidateEmailExpectation {
+	if mmValidateEmail.mock.funcValidateEmail != nil {
+		mmValidateEmail.mock.t.Fatalf("ValidatorMock.ValidateEmail mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateEmailExpectation{
+		mock:               mmValidateEmail.mock,
+		params:             &ValidatorMockValidateEmailParams{address, validateLength},
+		expectationOrigins: ValidatorMockValidateEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateEmail.expectations = append(mmValidateEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateEmail return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateEmailExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateEmail should be invoked
+func (mmValidateEmail *mValidatorMockValidateEmail) Times(n uint64) *mValidatorMockValidateEmail {
+	if n == 0 {
+		mmValidateEmail.mock.t.Fatalf("Times of ValidatorMock.ValidateEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateEmail.expectedInvocations, n)
+	mmValidateEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateEmail
+}
+
+func (mmValidateEmail *mValidatorMockValidateEmail) invocationsDone() bool {
+	if len(mmValidateEmail.expectations) == 0 && mmValidateEmail.defaultExpectation == nil && mmValidateEmail.mock.funcValidateEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateEmail.mock.afterValidateEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateEmail implements mm_user.Validator
+func (mmValidateEmail *ValidatorMock) ValidateEmail(address string, validateLength bool) (err error) {
+	mm_atomic.AddUint64(&mmValidateEmail.beforeValidateEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateEmail.afterValidateEmailCounter, 1)
+
+	mmValidateEmail.t.Helper()
+
+	if mmValidateEmail.inspectFuncValidateEmail != nil {
+		mmValidateEmail.inspectFuncValidateEmail(address, validateLength)
+	}
+
+	mm_params := ValidatorMockValidateEmailParams{address, validateLength}
+
+	// Record call args
+	mmValidateEmail.ValidateEmailMock.mutex.Lock()
+	mmValidateEmail.ValidateEmailMock.callArgs = append(mmValidateEmail.ValidateEmailMock.callArgs, &mm_params)
+	mmValidateEmail.ValidateEmailMock.mutex.Unlock()
+
+	for _, e := range mmValidateEmail.ValidateEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateEmail.ValidateEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateEmail.ValidateEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateEmail.ValidateEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateEmail.ValidateEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateEmailParams{address, validateLength}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.address != nil && !minimock.Equal(*mm_want_ptrs.address, mm_got.address) {
+				mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameter address, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originAddress, *mm_want_ptrs.address, mm_got.address, minimock.Diff(*mm_want_ptrs.address, mm_got.address))
+			}
+
+			if mm_want_ptrs.validateLength != nil && !minimock.Equal(*mm_want_ptrs.validateLength, mm_got.validateLength) {
+				mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameter validateLength, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.originValidateLength, *mm_want_ptrs.validateLength, mm_got.validateLength, minimock.Diff(*mm_want_ptrs.validateLength, mm_got.validateLength))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateEmail.t.Errorf("ValidatorMock.ValidateEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateEmail.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateEmail.ValidateEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateEmail.t.Fatal("No results are set for the ValidatorMock.ValidateEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateEmail.funcValidateEmail != nil {
+		return mmValidateEmail.funcValidateEmail(address, validateLength)
+	}
+	mmValidateEmail.t.Fatalf("Unexpected call to ValidatorMock.ValidateEmail. %v %v", address, validateLength)
+	return
+}
+
+// ValidateEmailAfterCounter returns a count of finished ValidatorMock.ValidateEmail invocations
+func (mmValidateEmail *ValidatorMock) ValidateEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateEmail.afterValidateEmailCounter)
+}
+
+// ValidateEmailBeforeCounter returns a count of ValidatorMock.ValidateEmail invocations
+func (mmValidateEmail *ValidatorMock) ValidateEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateEmail.beforeValidateEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateEmail *mValidatorMockValidateEmail) Calls() []*ValidatorMockValidateEmailParams {
+	mmValidateEmail.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateEmailParams, len(mmValidateEmail.callArgs))
+	copy(argCopy, mmValidateEmail.callArgs)
+
+	mmValidateEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateEmailDone returns true if the count of the ValidateEmail invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateEmailDone() bool {
+	if m.ValidateEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateEmailMock.invocationsDone()
+}
+
+// MinimockValidateEmailInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateEmailInspect() {
+	for _, e := range m.ValidateEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateEmailCounter := mm_atomic.LoadUint64(&m.afterValidateEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateEmailMock.defaultExpectation != nil && afterValidateEmailCounter < 1 {
+		if m.ValidateEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s", m.ValidateEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s with params: %#v", m.ValidateEmailMock.defaultExpectation.expectationOrigins.origin, *m.ValidateEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateEmail != nil && afterValidateEmailCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateEmail at\n%s", m.funcValidateEmailOrigin)
+	}
+
+	if !m.ValidateEmailMock.invocationsDone() && afterValidateEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateEmailMock.expectedInvocations), m.ValidateEmailMock.expectedInvocationsOrigin, afterValidateEmailCounter)
+	}
+}
+
+type mValidatorMockValidateName struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateNameExpectation
+	expectations       []*ValidatorMockValidateNameExpectation
+
+	callArgs []*ValidatorMockValidateNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidateNameExpectation specifies expectation struct of the Validator.ValidateName
+type ValidatorMockValidateNameExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidateNameParams
+	paramPtrs          *ValidatorMockValidateNameParamPtrs
+	expectationOrigins ValidatorMockValidateNameExpectationOrigins
+	results            *ValidatorMockValidateNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidateNameParams contains parameters of the Validator.ValidateName
+type ValidatorMockValidateNameParams struct {
+	name string
+}
+
+// ValidatorMockValidateNameParamPtrs contains pointers to parameters of the Validator.ValidateName
+type ValidatorMockValidateNameParamPtrs struct {
+	name *string
+}
+
+// ValidatorMockValidateNameResults contains results of the Validator.ValidateName
+type ValidatorMockValidateNameResults struct {
+	err error
+}
+
+// ValidatorMockValidateNameOrigins contains origins of expectations of the Validator.ValidateName
+type ValidatorMockValidateNameExpectationOrigins struct {
+	origin     string
+	originName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateName *mValidatorMockValidateName) Optional() *mValidatorMockValidateName {
+	mmValidateName.optional = true
+	return mmValidateName
+}
+
+// Expect sets up expected params for Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) Expect(name string) *mValidatorMockValidateName {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	}
+
+	if mmValidateName.defaultExpectation.paramPtrs != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by ExpectParams functions")
+	}
+
+	mmValidateName.defaultExpectation.params = &ValidatorMockValidateNameParams{name}
+	mmValidateName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateName.expectations {
+		if minimock.Equal(e.params, mmValidateName.defaultExpectation.params) {
+			mmValidateName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateName.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateName
+}
+
+// ExpectNameParam1 sets up expected param name for Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) ExpectNameParam1(name string) *mValidatorMockValidateName {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{}
+	}
+
+	if mmValidateName.defaultExpectation.params != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Expect")
+	}
+
+	if mmValidateName.defaultExpectation.paramPtrs == nil {
+		mmValidateName.defaultExpectation.paramPtrs = &ValidatorMockValidateNameParamPtrs{}
+	}
+	mmValidateName.defaultExpectation.paramPtrs.name = &name
+	mmValidateName.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmValidateName
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateName
+func (mmValidateName *mValidatorMockValidateName) Inspect(f func(name string)) *mValidatorMockValidateName {
+	if mmValidateName.mock.inspectFuncValidateName != nil {
+		mmValidateName.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateName")
+	}
+
+	mmValidateName.mock.inspectFuncValidateName = f
+
+	return mmValidateName
+}
+
+// Return sets up results that will be returned by Validator.ValidateName
 func (mmValidateName *mValidatorMockValidateName) Return(err error) *ValidatorMock {
 	if mmValidateName.mock.funcValidateName != nil {
 		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
 	}
 
-	if mmValidateName.defaultExpectation == nil {
-		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{mock: mmValidateName.mock}
+	if mmValidateName.defaultExpectation == nil {
+		mmValidateName.defaultExpectation = &ValidatorMockValidateNameExpectation{mock: mmValidateName.mock}
+	}
+	mmValidateName.defaultExpectation.results = &ValidatorMockValidateNameResults{err}
+	mmValidateName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateName.mock
+}
+
+// Set uses given function f to mock the Validator.ValidateName method
+func (mmValidateName *mValidatorMockValidateName) Set(f func(name string) (err error)) *ValidatorMock {
+	if mmValidateName.defaultExpectation != nil {
+		mmValidateName.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateName method")
+	}
+
+	if len(mmValidateName.expectations) > 0 {
+		mmValidateName.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateName method")
+	}
+
+	mmValidateName.mock.funcValidateName = f
+	mmValidateName.mock.funcValidateNameOrigin = minimock.CallerInfo(1)
+	return mmValidateName.mock
+}
+
+// When sets expectation for the Validator.ValidateName which will trigger the result defined by the following
+// Then helper
+func (mmValidateName *mValidatorMockValidateName) When(name string) *ValidatorMockValidateNameExpectation {
+	if mmValidateName.mock.funcValidateName != nil {
+		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateNameExpectation{
+		mock:               mmValidateName.mock,
+		params:             &ValidatorMockValidateNameParams{name},
+		expectationOrigins: ValidatorMockValidateNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateName.expectations = append(mmValidateName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidateName return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateNameExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateNameResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidateName should be invoked
+func (mmValidateName *mValidatorMockValidateName) Times(n uint64) *mValidatorMockValidateName {
+	if n == 0 {
+		mmValidateName.mock.t.Fatalf("Times of ValidatorMock.ValidateName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateName.expectedInvocations, n)
+	mmValidateName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateName
+}
+
+func (mmValidateName *mValidatorMockValidateName) invocationsDone() bool {
+	if len(mmValidateName.expectations) == 0 && mmValidateName.defaultExpectation == nil && mmValidateName.mock.funcValidateName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateName.mock.afterValidateNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateName implements mm_user.Validator
+func (mmValidateName *ValidatorMock) ValidateName(name string) (err error) {
+	mm_atomic.AddUint64(&mmValidateName.beforeValidateNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateName.afterValidateNameCounter, 1)
+
+	mmValidateName.t.Helper()
+
+	if mmValidateName.inspectFuncValidateName != nil {
+		mmValidateName.inspectFuncValidateName(name)
+	}
+
+	mm_params := ValidatorMockValidateNameParams{name}
+
+	// Record call args
+	mmValidateName.ValidateNameMock.mutex.Lock()
+	mmValidateName.ValidateNameMock.callArgs = append(mmValidateName.ValidateNameMock.callArgs, &mm_params)
+	mmValidateName.ValidateNameMock.mutex.Unlock()
+
+	for _, e := range mmValidateName.ValidateNameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateName.ValidateNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateName.ValidateNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateName.ValidateNameMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateName.ValidateNameMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidateNameParams{name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateName.ValidateNameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateName.t.Fatal("No results are set for the ValidatorMock.ValidateName")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateName.funcValidateName != nil {
+		return mmValidateName.funcValidateName(name)
+	}
+	mmValidateName.t.Fatalf("Unexpected call to ValidatorMock.ValidateName. %v", name)
+	return
+}
+
+// ValidateNameAfterCounter returns a count of finished ValidatorMock.ValidateName invocations
+func (mmValidateName *ValidatorMock) ValidateNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateName.afterValidateNameCounter)
+}
+
+// ValidateNameBeforeCounter returns a count of ValidatorMock.ValidateName invocations
+func (mmValidateName *ValidatorMock) ValidateNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateName.beforeValidateNameCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateName.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateName *mValidatorMockValidateName) Calls() []*ValidatorMockValidateNameParams {
+	mmValidateName.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateNameParams, len(mmValidateName.callArgs))
+	copy(argCopy, mmValidateName.callArgs)
+
+	mmValidateName.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateNameDone returns true if the count of the ValidateName invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateNameDone() bool {
+	if m.ValidateNameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateNameMock.invocationsDone()
+}
+
+// MinimockValidateNameInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateNameInspect() {
+	for _, e := range m.ValidateNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateNameCounter := mm_atomic.LoadUint64(&m.afterValidateNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateNameMock.defaultExpectation != nil && afterValidateNameCounter < 1 {
+		if m.ValidateNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.ValidateNameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", m.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *m.ValidateNameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateName != nil && afterValidateNameCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.funcValidateNameOrigin)
+	}
+
+	if !m.ValidateNameMock.invocationsDone() && afterValidateNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateNameMock.expectedInvocations), m.ValidateNameMock.expectedInvocationsOrigin, afterValidateNameCounter)
+	}
+}
+
+type mValidatorMockValidatePassword struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidatePasswordExpectation
+	expectations       []*ValidatorMockValidatePasswordExpectation
+
+	callArgs []*ValidatorMockValidatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidatePasswordExpectation specifies expectation struct of the Validator.ValidatePassword
+type ValidatorMockValidatePasswordExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidatePasswordParams
+	paramPtrs          *ValidatorMockValidatePasswordParamPtrs
+	expectationOrigins ValidatorMockValidatePasswordExpectationOrigins
+	results            *ValidatorMockValidatePasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidatePasswordParams contains parameters of the Validator.ValidatePassword
+type ValidatorMockValidatePasswordParams struct {
+	password []byte
+}
+
+// ValidatorMockValidatePasswordParamPtrs contains pointers to parameters of the Validator.ValidatePassword
+type ValidatorMockValidatePasswordParamPtrs struct {
+	password *[]byte
+}
+
+// ValidatorMockValidatePasswordResults contains results of the Validator.ValidatePassword
+type ValidatorMockValidatePasswordResults struct {
+	err error
+}
+
+// ValidatorMockValidatePasswordOrigins contains origins of expectations of the Validator.ValidatePassword
+type ValidatorMockValidatePasswordExpectationOrigins struct {
+	origin         string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidatePassword *mValidatorMockValidatePassword) Optional() *mValidatorMockValidatePassword {
+	mmValidatePassword.optional = true
+	return mmValidatePassword
+}
+
+// Expect sets up expected params for Validator.ValidatePassword
+func (mmValidatePassword *mValidatorMockValidatePassword) Expect(password []byte) *mValidatorMockValidatePassword {
+	if mmValidatePassword.mock.funcValidatePassword != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+	}
+
+	if mmValidatePassword.defaultExpectation == nil {
+		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{}
+	}
+
+	if mmValidatePassword.defaultExpectation.paramPtrs != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by ExpectParams functions")
+	}
+
+	mmValidatePassword.defaultExpectation.params = &ValidatorMockValidatePasswordParams{password}
+	mmValidatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidatePassword.expectations {
+		if minimock.Equal(e.params, mmValidatePassword.defaultExpectation.params) {
+			mmValidatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidatePassword.defaultExpectation.params)
+		}
+	}
+
+	return mmValidatePassword
+}
+
+// ExpectPasswordParam1 sets up expected param password for Validator.ValidatePassword
+func (mmValidatePassword *mValidatorMockValidatePassword) ExpectPasswordParam1(password []byte) *mValidatorMockValidatePassword {
+	if mmValidatePassword.mock.funcValidatePassword != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+	}
+
+	if mmValidatePassword.defaultExpectation == nil {
+		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{}
+	}
+
+	if mmValidatePassword.defaultExpectation.params != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Expect")
+	}
+
+	if mmValidatePassword.defaultExpectation.paramPtrs == nil {
+		mmValidatePassword.defaultExpectation.paramPtrs = &ValidatorMockValidatePasswordParamPtrs{}
+	}
+	mmValidatePassword.defaultExpectation.paramPtrs.password = &password
+	mmValidatePassword.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmValidatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidatePassword
+func (mmValidatePassword *mValidatorMockValidatePassword) Inspect(f func(password []byte)) *mValidatorMockValidatePassword {
+	if mmValidatePassword.mock.inspectFuncValidatePassword != nil {
+		mmValidatePassword.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidatePassword")
+	}
+
+	mmValidatePassword.mock.inspectFuncValidatePassword = f
+
+	return mmValidatePassword
+}
+
+// Return sets up results that will be returned by Validator.ValidatePassword
+func (mmValidatePassword *mValidatorMockValidatePassword) Return(err error) *ValidatorMock {
+	if mmValidatePassword.mock.funcValidatePassword != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+	}
+
+	if mmValidatePassword.defaultExpectation == nil {
+		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{mock: mmValidatePassword.mock}
+	}
+	mmValidatePassword.defaultExpectation.results = &ValidatorMockValidatePasswordResults{err}
+	mmValidatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidatePassword.mock
+}
+
+// Set uses given function f to mock the Validator.ValidatePassword method
+func (mmValidatePassword *mValidatorMockValidatePassword) Set(f func(password []byte) (err error)) *ValidatorMock {
+	if mmValidatePassword.defaultExpectation != nil {
+		mmValidatePassword.mock.t.Fatalf("Default expectation is already set for the Validator.ValidatePassword method")
+	}
+
+	if len(mmValidatePassword.expectations) > 0 {
+		mmValidatePassword.mock.t.Fatalf("Some expectations are already set for the Validator.ValidatePassword method")
+	}
+
+	mmValidatePassword.mock.funcValidatePassword = f
+	mmValidatePassword.mock.funcValidatePasswordOrigin = minimock.CallerInfo(1)
+	return mmValidatePassword.mock
+}
+
+// When sets expectation for the Validator.ValidatePassword which will trigger the result defined by the following
+// Then helper
+func (mmValidatePassword *mValidatorMockValidatePassword) When(password []byte) *ValidatorMockValidatePasswordExpectation {
+	if mmValidatePassword.mock.funcValidatePassword != nil {
+		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidatePasswordExpectation{
+		mock:               mmValidatePassword.mock,
+		params:             &ValidatorMockValidatePasswordParams{password},
+		expectationOrigins: ValidatorMockValidatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidatePassword.expectations = append(mmValidatePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.ValidatePassword return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidatePasswordExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidatePasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times Validator.ValidatePassword should be invoked
+func (mmValidatePassword *mValidatorMockValidatePassword) Times(n uint64) *mValidatorMockValidatePassword {
+	if n == 0 {
+		mmValidatePassword.mock.t.Fatalf("Times of ValidatorMock.ValidatePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidatePassword.expectedInvocations, n)
+	mmValidatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidatePassword
+}
+
+func (mmValidatePassword *mValidatorMockValidatePassword) invocationsDone() bool {
+	if len(mmValidatePassword.expectations) == 0 && mmValidatePassword.defaultExpectation == nil && mmValidatePassword.mock.funcValidatePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidatePassword.mock.afterValidatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidatePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidatePassword implements mm_user.Validator
+func (mmValidatePassword *ValidatorMock) ValidatePassword(password []byte) (err error) {
+	mm_atomic.AddUint64(&mmValidatePassword.beforeValidatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidatePassword.afterValidatePasswordCounter, 1)
+
+	mmValidatePassword.t.Helper()
+
+	if mmValidatePassword.inspectFuncValidatePassword != nil {
+		mmValidatePassword.inspectFuncValidatePassword(password)
+	}
+
+	mm_params := ValidatorMockValidatePasswordParams{password}
+
+	// Record call args
+	mmValidatePassword.ValidatePasswordMock.mutex.Lock()
+	mmValidatePassword.ValidatePasswordMock.callArgs = append(mmValidatePassword.ValidatePasswordMock.callArgs, &mm_params)
+	mmValidatePassword.ValidatePasswordMock.mutex.Unlock()
+
+	for _, e := range mmValidatePassword.ValidatePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidatePassword.ValidatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidatePassword.ValidatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidatePassword.ValidatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmValidatePassword.ValidatePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := ValidatorMockValidatePasswordParams{password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmValidatePassword.t.Errorf("ValidatorMock.ValidatePassword got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidatePassword.ValidatePasswordMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidatePassword.t.Errorf("ValidatorMock.ValidatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidatePassword.ValidatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidatePassword.ValidatePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidatePassword.t.Fatal("No results are set for the ValidatorMock.ValidatePassword")
+		}
+		return (*mm_results).err
+	}
+	if mmValidatePassword.funcValidatePassword != nil {
+		return mmValidatePassword.funcValidatePassword(password)
+	}
+	mmValidatePassword.t.Fatalf("Unexpected call to ValidatorMock.ValidatePassword. %v", password)
+	return
+}
+
+// ValidatePasswordAfterCounter returns a count of finished ValidatorMock.ValidatePassword invocations
+func (mmValidatePassword *ValidatorMock) ValidatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePassword.afterValidatePasswordCounter)
+}
+
+// ValidatePasswordBeforeCounter returns a count of ValidatorMock.ValidatePassword invocations
+func (mmValidatePassword *ValidatorMock) ValidatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePassword.beforeValidatePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidatePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidatePassword *mValidatorMockValidatePassword) Calls() []*ValidatorMockValidatePasswordParams {
+	mmValidatePassword.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidatePasswordParams, len(mmValidatePassword.callArgs))
+	copy(argCopy, mmValidatePassword.callArgs)
+
+	mmValidatePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidatePasswordDone returns true if the count of the ValidatePassword invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidatePasswordDone() bool {
+	if m.ValidatePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidatePasswordMock.invocationsDone()
+}
+
+// MinimockValidatePasswordInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidatePasswordInspect() {
+	for _, e := range m.ValidatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidatePasswordCounter := mm_atomic.LoadUint64(&m.afterValidatePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidatePasswordMock.defaultExpectation != nil && afterValidatePasswordCounter < 1 {
+		if m.ValidatePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s", m.ValidatePasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s with params: %#v", m.ValidatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ValidatePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidatePassword != nil && afterValidatePasswordCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s", m.funcValidatePasswordOrigin)
+	}
+
+	if !m.ValidatePasswordMock.invocationsDone() && afterValidatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidatePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidatePasswordMock.expectedInvocations), m.ValidatePasswordMock.expectedInvocationsOrigin, afterValidatePasswordCounter)
+	}
+}
+
+type mValidatorMockValidatePreferences struct {
+	optional           bool
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidatePreferencesExpectation
+	expectations       []*ValidatorMockValidatePreferencesExpectation
+
+	callArgs []*ValidatorMockValidatePreferencesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ValidatorMockValidatePreferencesExpectation specifies expectation struct of the Validator.ValidatePreferences
+type ValidatorMockValidatePreferencesExpectation struct {
+	mock               *ValidatorMock
+	params             *ValidatorMockValidatePreferencesParams
+	paramPtrs          *ValidatorMockValidatePreferencesParamPtrs
+	expectationOrigins ValidatorMockValidatePreferencesExpectationOrigins
+	results            *ValidatorMockValidatePreferencesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ValidatorMockValidatePreferencesParams contains parameters of the Validator.ValidatePreferences
+type ValidatorMockValidatePreferencesParams struct {
+	prefs mm_user.Preferences
+}
+
+// ValidatorMockValidatePreferencesParamPtrs contains pointers to parameters of the Validator.ValidatePreferences
+type ValidatorMockValidatePreferencesParamPtrs struct {
+	prefs *mm_user.Preferences
+}
+
+// ValidatorMockValidatePreferencesResults contains results of the Validator.ValidatePreferences
+type ValidatorMockValidatePreferencesResults struct {
+	err error
+}
+
+// ValidatorMockValidatePreferencesOrigins contains origins of expectations of the Validator.ValidatePreferences
+type ValidatorMockValidatePreferencesExpectationOrigins struct {
+	origin      string
+	originPrefs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Optional() *mValidatorMockValidatePreferences {
+	mmValidatePreferences.optional = true
+	return mmValidatePreferences
+}
+
+// Expect sets up expected params for Validator.ValidatePreferences
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Expect(prefs mm_user.Preferences) *mValidatorMockValidatePreferences {
+	if mmValidatePreferences.mock.funcValidatePreferences != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by Set")
+	}
+
+	if mmValidatePreferences.defaultExpectation == nil {
+		mmValidatePreferences.defaultExpectation = &ValidatorMockValidatePreferencesExpectation{}
+	}
+
+	if mmValidatePreferences.defaultExpectation.paramPtrs != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by ExpectParams functions")
+	}
+
+	mmValidatePreferences.defaultExpectation.params = &ValidatorMockValidatePreferencesParams{prefs}
+	mmValidatePreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidatePreferences.expectations {
+		if minimock.Equal(e.params, mmValidatePreferences.defaultExpectation.params) {
+			mmValidatePreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidatePreferences.defaultExpectation.params)
+		}
+	}
+
+	return mmValidatePreferences
+}
+
+// ExpectPrefsParam1 sets up expected param prefs for Validator.ValidatePreferences
+func (mmValidatePreferences *mValidatorMockValidatePreferences) ExpectPrefsParam1(prefs mm_user.Preferences) *mValidatorMockValidatePreferences {
+	if mmValidatePreferences.mock.funcValidatePreferences != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by Set")
+	}
+
+	if mmValidatePreferences.defaultExpectation == nil {
+		mmValidatePreferences.defaultExpectation = &ValidatorMockValidatePreferencesExpectation{}
+	}
+
+	if mmValidatePreferences.defaultExpectation.params != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by Expect")
+	}
+
+	if mmValidatePreferences.defaultExpectation.paramPtrs == nil {
+		mmValidatePreferences.defaultExpectation.paramPtrs = &ValidatorMockValidatePreferencesParamPtrs{}
+	}
+	mmValidatePreferences.defaultExpectation.paramPtrs.prefs = &prefs
+	mmValidatePreferences.defaultExpectation.expectationOrigins.originPrefs = minimock.CallerInfo(1)
+
+	return mmValidatePreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidatePreferences
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Inspect(f func(prefs mm_user.Preferences)) *mValidatorMockValidatePreferences {
+	if mmValidatePreferences.mock.inspectFuncValidatePreferences != nil {
+		mmValidatePreferences.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidatePreferences")
+	}
+
+	mmValidatePreferences.mock.inspectFuncValidatePreferences = f
+
+	return mmValidatePreferences
+}
+
+// Return sets up results that will be returned by Validator.ValidatePreferences
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Return(err error) *ValidatorMock {
+	if mmValidatePreferences.mock.funcValidatePreferences != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by Set")
+	}
+
+	if mmValidatePreferences.defaultExpectation == nil {
+		mmValidatePreferences.defaultExpectation = &ValidatorMockValidatePreferencesExpectation{mock: mmValidatePreferences.mock}
 	}
-	mmValidateName.defaultExpectation.results = &ValidatorMockValidateNameResults{err}
-	mmValidateName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmValidateName.mock
+	mmValidatePreferences.defaultExpectation.results = &ValidatorMockValidatePreferencesResults{err}
+	mmValidatePreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidatePreferences.mock
 }
 
-// Set uses given function f to mock the Validator.ValidateName method
-func (mmValidateName *mValidatorMockValidateName) Set(f func(name string) (err error)) *ValidatorMock {
-	if mmValidateName.defaultExpectation != nil {
-		mmValidateName.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateName method")
+// Set uses given function f to mock the Validator.ValidatePreferences method
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Set(f func(prefs mm_user.Preferences) (err error)) *ValidatorMock {
+	if mmValidatePreferences.defaultExpectation != nil {
+		mmValidatePreferences.mock.t.Fatalf("Default expectation is already set for the Validator.ValidatePreferences method")
 	}
 
-	if len(mmValidateName.expectations) > 0 {
-		mmValidateName.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateName method")
+	if len(mmValidatePreferences.expectations) > 0 {
+		mmValidatePreferences.mock.t.Fatalf("Some expectations are already set for the Validator.ValidatePreferences method")
 	}
 
-	mmValidateName.mock.funcValidateName = f
-	mmValidateName.mock.funcValidateNameOrigin = minimock.CallerInfo(1)
-	return mmValidateName.mock
+	mmValidatePreferences.mock.funcValidatePreferences = f
+	mmValidatePreferences.mock.funcValidatePreferencesOrigin = minimock.CallerInfo(1)
+	return mmValidatePreferences.mock
 }
 
-// When sets expectation for the Validator.ValidateName which will trigger the result defined by the following
+// When sets expectation for the Validator.ValidatePreferences which will trigger the result defined by the following
 // Then helper
-func (mmValidateName *mValidatorMockValidateName) When(name string) *ValidatorMockValidateNameExpectation {
-	if mmValidateName.mock.funcValidateName != nil {
-		mmValidateName.mock.t.Fatalf("ValidatorMock.ValidateName mock is already set by Set")
+func (mmValidatePreferences *mValidatorMockValidatePreferences) When(prefs mm_user.Preferences) *ValidatorMockValidatePreferencesExpectation {
+	if mmValidatePreferences.mock.funcValidatePreferences != nil {
+		mmValidatePreferences.mock.t.Fatalf("ValidatorMock.ValidatePreferences mock is already set by Set")
 	}
 
-	expectation := &ValidatorMockValidateNameExpectation{
-		mock:               mmValidateName.mock,
-		params:             &ValidatorMockValidateNameParams{name},
-		expectationOrigins: ValidatorMockValidateNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ValidatorMockValidatePreferencesExpectation{
+		mock:               mmValidatePreferences.mock,
+		params:             &ValidatorMockValidatePreferencesParams{prefs},
+		expectationOrigins: ValidatorMockValidatePreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmValidateName.expectations = append(mmValidateName.expectations, expectation)
+	mmValidatePreferences.expectations = append(mmValidatePreferences.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Validator.ValidateName return parameters for the expectation previously defined by the When method
-func (e *ValidatorMockValidateNameExpectation) Then(err error) *ValidatorMock {
-	e.results = &ValidatorMockValidateNameResults{err}
+// Then sets up Validator.ValidatePreferences return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidatePreferencesExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidatePreferencesResults{err}
 	return e.mock
 }
 
-// Times sets number of times Validator.ValidateName should be invoked
-func (mmValidateName *mValidatorMockValidateName) Times(n uint64) *mValidatorMockValidateName {
+// Times sets number of times Validator.ValidatePreferences should be invoked
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Times(n uint64) *mValidatorMockValidatePreferences {
 	if n == 0 {
-		mmValidateName.mock.t.Fatalf("Times of ValidatorMock.ValidateName mock can not be zero")
+		mmValidatePreferences.mock.t.Fatalf("Times of ValidatorMock.ValidatePreferences mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmValidateName.expectedInvocations, n)
-	mmValidateName.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmValidateName
+	mm_atomic.StoreUint64(&mmValidatePreferences.expectedInvocations, n)
+	mmValidatePreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidatePreferences
 }
 
-func (mmValidateName *mValidatorMockValidateName) invocationsDone() bool {
-	if len(mmValidateName.expectations) == 0 && mmValidateName.defaultExpectation == nil && mmValidateName.mock.funcValidateName == nil {
+func (mmValidatePreferences *mValidatorMockValidatePreferences) invocationsDone() bool {
+	if len(mmValidatePreferences.expectations) == 0 && mmValidatePreferences.defaultExpectation == nil && mmValidatePreferences.mock.funcValidatePreferences == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmValidateName.mock.afterValidateNameCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmValidateName.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmValidatePreferences.mock.afterValidatePreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidatePreferences.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ValidateName implements mm_user.Validator
-func (mmValidateName *ValidatorMock) ValidateName(name string) (err error) {
-	mm_atomic.AddUint64(&mmValidateName.beforeValidateNameCounter, 1)
-	defer mm_atomic.AddUint64(&mmValidateName.afterValidateNameCounter, 1)
+// ValidatePreferences implements mm_user.Validator
+func (mmValidatePreferences *ValidatorMock) ValidatePreferences(prefs mm_user.Preferences) (err error) {
+	mm_atomic.AddUint64(&mmValidatePreferences.beforeValidatePreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidatePreferences.afterValidatePreferencesCounter, 1)
 
-	mmValidateName.t.Helper()
+	mmValidatePreferences.t.Helper()
 
-	if mmValidateName.inspectFuncValidateName != nil {
-		mmValidateName.inspectFuncValidateName(name)
+	if mmValidatePreferences.inspectFuncValidatePreferences != nil {
+		mmValidatePreferences.inspectFuncValidatePreferences(prefs)
 	}
 
-	mm_params := ValidatorMockValidateNameParams{name}
+	mm_params := ValidatorMockValidatePreferencesParams{prefs}
 
 	// Record call args
-	mmValidateName.ValidateNameMock.mutex.Lock()
-	mmValidateName.ValidateNameMock.callArgs = append(mmValidateName.ValidateNameMock.callArgs, &mm_params)
-	mmValidateName.ValidateNameMock.mutex.Unlock()
+	mmValidatePreferences.ValidatePreferencesMock.mutex.Lock()
+	mmValidatePreferences.ValidatePreferencesMock.callArgs = append(mmValidatePreferences.ValidatePreferencesMock.callArgs, &mm_params)
+	mmValidatePreferences.ValidatePreferencesMock.mutex.Unlock()
 
-	for _, e := range mmValidateName.ValidateNameMock.expectations {
+	for _, e := range mmValidatePreferences.ValidatePreferencesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmValidateName.ValidateNameMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmValidateName.ValidateNameMock.defaultExpectation.Counter, 1)
-		mm_want := mmValidateName.ValidateNameMock.defaultExpectation.params
-		mm_want_ptrs := mmValidateName.ValidateNameMock.defaultExpectation.paramPtrs
+	if mmValidatePreferences.ValidatePreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.paramPtrs
 
-		mm_got := ValidatorMockValidateNameParams{name}
+		mm_got := ValidatorMockValidatePreferencesParams{prefs}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
-				mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			if mm_want_ptrs.prefs != nil && !minimock.Equal(*mm_want_ptrs.prefs, mm_got.prefs) {
+				mmValidatePreferences.t.Errorf("ValidatorMock.ValidatePreferences got unexpected parameter prefs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.expectationOrigins.originPrefs, *mm_want_ptrs.prefs, mm_got.prefs, minimock.Diff(*mm_want_ptrs.prefs, mm_got.prefs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmValidateName.t.Errorf("ValidatorMock.ValidateName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmValidateName.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmValidatePreferences.t.Errorf("ValidatorMock.ValidatePreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmValidateName.ValidateNameMock.defaultExpectation.results
+		mm_results := mmValidatePreferences.ValidatePreferencesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmValidateName.t.Fatal("No results are set for the ValidatorMock.ValidateName")
+			mmValidatePreferences.t.Fatal("No results are set for the ValidatorMock.ValidatePreferences")
 		}
 		return (*mm_results).err
 	}
-	if mmValidateName.funcValidateName != nil {
-		return mmValidateName.funcValidateName(name)
+	if mmValidatePreferences.funcValidatePreferences != nil {
+		return mmValidatePreferences.funcValidatePreferences(prefs)
 	}
-	mmValidateName.t.Fatalf("Unexpected call to ValidatorMock.ValidateName. %v", name)
+	mmValidatePreferences.t.Fatalf("Unexpected call to ValidatorMock.ValidatePreferences. %v", prefs)
 	return
 }
 
-// ValidateNameAfterCounter returns a count of finished ValidatorMock.ValidateName invocations
-func (mmValidateName *ValidatorMock) ValidateNameAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateName.afterValidateNameCounter)
+// ValidatePreferencesAfterCounter returns a count of finished ValidatorMock.ValidatePreferences invocations
+func (mmValidatePreferences *ValidatorMock) ValidatePreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePreferences.afterValidatePreferencesCounter)
 }
 
-// ValidateNameBeforeCounter returns a count of ValidatorMock.ValidateName invocations
-func (mmValidateName *ValidatorMock) ValidateNameBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidateName.beforeValidateNameCounter)
+// ValidatePreferencesBeforeCounter returns a count of ValidatorMock.ValidatePreferences invocations
+func (mmValidatePreferences *ValidatorMock) ValidatePreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidatePreferences.beforeValidatePreferencesCounter)
 }
 
-// Calls returns a list of arguments used in each call to ValidatorMock.ValidateName.
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidatePreferences.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmValidateName *mValidatorMockValidateName) Calls() []*ValidatorMockValidateNameParams {
-	mmValidateName.mutex.RLock()
+func (mmValidatePreferences *mValidatorMockValidatePreferences) Calls() []*ValidatorMockValidatePreferencesParams {
+	mmValidatePreferences.mutex.RLock()
 
-	argCopy := make([]*ValidatorMockValidateNameParams, len(mmValidateName.callArgs))
-	copy(argCopy, mmValidateName.callArgs)
+	argCopy := make([]*ValidatorMockValidatePreferencesParams, len(mmValidatePreferences.callArgs))
+	copy(argCopy, mmValidatePreferences.callArgs)
 
-	mmValidateName.mutex.RUnlock()
+	mmValidatePreferences.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockValidateNameDone returns true if the count of the ValidateName invocations corresponds
+// MinimockValidatePreferencesDone returns true if the count of the ValidatePreferences invocations corresponds
 // the number of defined expectations
-func (m *ValidatorMock) MinimockValidateNameDone() bool {
-	if m.ValidateNameMock.optional {
+func (m *ValidatorMock) MinimockValidatePreferencesDone() bool {
+	if m.ValidatePreferencesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ValidateNameMock.expectations {
+	for _, e := range m.ValidatePreferencesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ValidateNameMock.invocationsDone()
+	return m.ValidatePreferencesMock.invocationsDone()
 }
 
-// MinimockValidateNameInspect logs each unmet expectation
-func (m *ValidatorMock) MinimockValidateNameInspect() {
-	for _, e := range m.ValidateNameMock.expectations {
+// MinimockValidatePreferencesInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidatePreferencesInspect() {
+	for _, e := range m.ValidatePreferencesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterValidateNameCounter := mm_atomic.LoadUint64(&m.afterValidateNameCounter)
+	afterValidatePreferencesCounter := mm_atomic.LoadUint64(&m.afterValidatePreferencesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ValidateNameMock.defaultExpectation != nil && afterValidateNameCounter < 1 {
-		if m.ValidateNameMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.ValidateNameMock.defaultExpectation.returnOrigin)
+	if m.ValidatePreferencesMock.defaultExpectation != nil && afterValidatePreferencesCounter < 1 {
+		if m.ValidatePreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePreferences at\n%s", m.ValidatePreferencesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s with params: %#v", m.ValidateNameMock.defaultExpectation.expectationOrigins.origin, *m.ValidateNameMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidatePreferences at\n%s with params: %#v", m.ValidatePreferencesMock.defaultExpectation.expectationOrigins.origin, *m.ValidatePreferencesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcValidateName != nil && afterValidateNameCounter < 1 {
-		m.t.Errorf("Expected call to ValidatorMock.ValidateName at\n%s", m.funcValidateNameOrigin)
+	if m.funcValidatePreferences != nil && afterValidatePreferencesCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidatePreferences at\n%s", m.funcValidatePreferencesOrigin)
 	}
 
-	if !m.ValidateNameMock.invocationsDone() && afterValidateNameCounter > 0 {
-		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateName at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ValidateNameMock.expectedInvocations), m.ValidateNameMock.expectedInvocationsOrigin, afterValidateNameCounter)
+	if !m.ValidatePreferencesMock.invocationsDone() && afterValidatePreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidatePreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidatePreferencesMock.expectedInvocations), m.ValidatePreferencesMock.expectedInvocationsOrigin, afterValidatePreferencesCounter)
 	}
 }
 
-type mValidatorMockValidatePassword struct {
+type mValidatorMockValidateProfile struct {
 	optional           bool
 	mock               *ValidatorMock
-	defaultExpectation *ValidatorMockValidatePasswordExpectation
-	expectations       []*ValidatorMockValidatePasswordExpectation
+	defaultExpectation *ValidatorMockValidateProfileExpectation
+	expectations       []*ValidatorMockValidateProfileExpectation
 
-	callArgs []*ValidatorMockValidatePasswordParams
+	callArgs []*ValidatorMockValidateProfileParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ValidatorMockValidatePasswordExpectation specifies expectation struct of the Validator.ValidatePassword
-type ValidatorMockValidatePasswordExpectation struct {
+// ValidatorMockValidateProfileExpectation specifies expectation struct of the Validator.ValidateProfile
+type ValidatorMockValidateProfileExpectation struct {
 	mock               *ValidatorMock
-	params             *ValidatorMockValidatePasswordParams
-	paramPtrs          *ValidatorMockValidatePasswordParamPtrs
-	expectationOrigins ValidatorMockValidatePasswordExpectationOrigins
-	results            *ValidatorMockValidatePasswordResults
+	params             *ValidatorMockValidateProfileParams
+	paramPtrs          *ValidatorMockValidateProfileParamPtrs
+	expectationOrigins ValidatorMockValidateProfileExpectationOrigins
+	results            *ValidatorMockValidateProfileResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ValidatorMockValidatePasswordParams contains parameters of the Validator.ValidatePassword
-type ValidatorMockValidatePasswordParams struct {
-	password []byte
+// ValidatorMockValidateProfileParams contains parameters of the Validator.ValidateProfile
+type ValidatorMockValidateProfileParams struct {
+	req mm_user.UpdateProfileReq
 }
 
-// ValidatorMockValidatePasswordParamPtrs contains pointers to parameters of the Validator.ValidatePassword
-type ValidatorMockValidatePasswordParamPtrs struct {
-	password *[]byte
+// ValidatorMockValidateProfileParamPtrs contains pointers to parameters of the Validator.ValidateProfile
+type ValidatorMockValidateProfileParamPtrs struct {
+	req *mm_user.UpdateProfileReq
 }
 
-// ValidatorMockValidatePasswordResults contains results of the Validator.ValidatePassword
-type ValidatorMockValidatePasswordResults struct {
+// ValidatorMockValidateProfileResults contains results of the Validator.ValidateProfile
+type ValidatorMockValidateProfileResults struct {
 	err error
 }
 
-// ValidatorMockValidatePasswordOrigins contains origins of expectations of the Validator.ValidatePassword
-type ValidatorMockValidatePasswordExpectationOrigins struct {
-	origin         string
-	originPassword string
+// ValidatorMockValidateProfileOrigins contains origins of expectations of the Validator.ValidateProfile
+type ValidatorMockValidateProfileExpectationOrigins struct {
+	origin    string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1406,264 +2692,264 @@ type ValidatorMockValidatePasswordExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmValidatePassword *mValidatorMockValidatePassword) Optional() *mValidatorMockValidatePassword {
-	mmValidatePassword.optional = true
-	return mmValidatePassword
+func (mmValidateProfile *mValidatorMockValidateProfile) Optional() *mValidatorMockValidateProfile {
+	mmValidateProfile.optional = true
+	return mmValidateProfile
 }
 
-// Expect sets up expected params for Validator.ValidatePassword
-func (mmValidatePassword *mValidatorMockValidatePassword) Expect(password []byte) *mValidatorMockValidatePassword {
-	if mmValidatePassword.mock.funcValidatePassword != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+// Expect sets up expected params for Validator.ValidateProfile
+func (mmValidateProfile *mValidatorMockValidateProfile) Expect(req mm_user.UpdateProfileReq) *mValidatorMockValidateProfile {
+	if mmValidateProfile.mock.funcValidateProfile != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by Set")
 	}
 
-	if mmValidatePassword.defaultExpectation == nil {
-		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{}
+	if mmValidateProfile.defaultExpectation == nil {
+		mmValidateProfile.defaultExpectation = &ValidatorMockValidateProfileExpectation{}
 	}
 
-	if mmValidatePassword.defaultExpectation.paramPtrs != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by ExpectParams functions")
+	if mmValidateProfile.defaultExpectation.paramPtrs != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by ExpectParams functions")
 	}
 
-	mmValidatePassword.defaultExpectation.params = &ValidatorMockValidatePasswordParams{password}
-	mmValidatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmValidatePassword.expectations {
-		if minimock.Equal(e.params, mmValidatePassword.defaultExpectation.params) {
-			mmValidatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidatePassword.defaultExpectation.params)
+	mmValidateProfile.defaultExpectation.params = &ValidatorMockValidateProfileParams{req}
+	mmValidateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateProfile.expectations {
+		if minimock.Equal(e.params, mmValidateProfile.defaultExpectation.params) {
+			mmValidateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateProfile.defaultExpectation.params)
 		}
 	}
 
-	return mmValidatePassword
+	return mmValidateProfile
 }
 
-// ExpectPasswordParam1 sets up expected param password for Validator.ValidatePassword
-func (mmValidatePassword *mValidatorMockValidatePassword) ExpectPasswordParam1(password []byte) *mValidatorMockValidatePassword {
-	if mmValidatePassword.mock.funcValidatePassword != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+// ExpectReqParam1 sets up expected param req for Validator.ValidateProfile
+func (mmValidateProfile *mValidatorMockValidateProfile) ExpectReqParam1(req mm_user.UpdateProfileReq) *mValidatorMockValidateProfile {
+	if mmValidateProfile.mock.funcValidateProfile != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by Set")
 	}
 
-	if mmValidatePassword.defaultExpectation == nil {
-		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{}
+	if mmValidateProfile.defaultExpectation == nil {
+		mmValidateProfile.defaultExpectation = &ValidatorMockValidateProfileExpectation{}
 	}
 
-	if mmValidatePassword.defaultExpectation.params != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Expect")
+	if mmValidateProfile.defaultExpectation.params != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by Expect")
 	}
 
-	if mmValidatePassword.defaultExpectation.paramPtrs == nil {
-		mmValidatePassword.defaultExpectation.paramPtrs = &ValidatorMockValidatePasswordParamPtrs{}
+	if mmValidateProfile.defaultExpectation.paramPtrs == nil {
+		mmValidateProfile.defaultExpectation.paramPtrs = &ValidatorMockValidateProfileParamPtrs{}
 	}
-	mmValidatePassword.defaultExpectation.paramPtrs.password = &password
-	mmValidatePassword.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+	mmValidateProfile.defaultExpectation.paramPtrs.req = &req
+	mmValidateProfile.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmValidatePassword
+	return mmValidateProfile
 }
 
-// Inspect accepts an inspector function that has same arguments as the Validator.ValidatePassword
-func (mmValidatePassword *mValidatorMockValidatePassword) Inspect(f func(password []byte)) *mValidatorMockValidatePassword {
-	if mmValidatePassword.mock.inspectFuncValidatePassword != nil {
-		mmValidatePassword.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidatePassword")
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidateProfile
+func (mmValidateProfile *mValidatorMockValidateProfile) Inspect(f func(req mm_user.UpdateProfileReq)) *mValidatorMockValidateProfile {
+	if mmValidateProfile.mock.inspectFuncValidateProfile != nil {
+		mmValidateProfile.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidateProfile")
 	}
 
-	mmValidatePassword.mock.inspectFuncValidatePassword = f
+	mmValidateProfile.mock.inspectFuncValidateProfile = f
 
-	return mmValidatePassword
+	return mmValidateProfile
 }
 
-// Return sets up results that will be returned by Validator.ValidatePassword
-func (mmValidatePassword *mValidatorMockValidatePassword) Return(err error) *ValidatorMock {
-	if mmValidatePassword.mock.funcValidatePassword != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+// Return sets up results that will be returned by Validator.ValidateProfile
+func (mmValidateProfile *mValidatorMockValidateProfile) Return(err error) *ValidatorMock {
+	if mmValidateProfile.mock.funcValidateProfile != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by Set")
 	}
 
-	if mmValidatePassword.defaultExpectation == nil {
-		mmValidatePassword.defaultExpectation = &ValidatorMockValidatePasswordExpectation{mock: mmValidatePassword.mock}
+	if mmValidateProfile.defaultExpectation == nil {
+		mmValidateProfile.defaultExpectation = &ValidatorMockValidateProfileExpectation{mock: mmValidateProfile.mock}
 	}
-	mmValidatePassword.defaultExpectation.results = &ValidatorMockValidatePasswordResults{err}
-	mmValidatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmValidatePassword.mock
+	mmValidateProfile.defaultExpectation.results = &ValidatorMockValidateProfileResults{err}
+	mmValidateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateProfile.mock
 }
 
-// Set uses given function f to mock the Validator.ValidatePassword method
-func (mmValidatePassword *mValidatorMockValidatePassword) Set(f func(password []byte) (err error)) *ValidatorMock {
-	if mmValidatePassword.defaultExpectation != nil {
-		mmValidatePassword.mock.t.Fatalf("Default expectation is already set for the Validator.ValidatePassword method")
+// Set uses given function f to mock the Validator.ValidateProfile method
+func (mmValidateProfile *mValidatorMockValidateProfile) Set(f func(req mm_user.UpdateProfileReq) (err error)) *ValidatorMock {
+	if mmValidateProfile.defaultExpectation != nil {
+		mmValidateProfile.mock.t.Fatalf("Default expectation is already set for the Validator.ValidateProfile method")
 	}
 
-	if len(mmValidatePassword.expectations) > 0 {
-		mmValidatePassword.mock.t.Fatalf("Some expectations are already set for the Validator.ValidatePassword method")
+	if len(mmValidateProfile.expectations) > 0 {
+		mmValidateProfile.mock.t.Fatalf("Some expectations are already set for the Validator.ValidateProfile method")
 	}
 
-	mmValidatePassword.mock.funcValidatePassword = f
-	mmValidatePassword.mock.funcValidatePasswordOrigin = minimock.CallerInfo(1)
-	return mmValidatePassword.mock
+	mmValidateProfile.mock.funcValidateProfile = f
+	mmValidateProfile.mock.funcValidateProfileOrigin = minimock.CallerInfo(1)
+	return mmValidateProfile.mock
 }
 
-// When sets expectation for the Validator.ValidatePassword which will trigger the result defined by the following
+// When sets expectation for the Validator.ValidateProfile which will trigger the result defined by the following
 // Then helper
-func (mmValidatePassword *mValidatorMockValidatePassword) When(password []byte) *ValidatorMockValidatePasswordExpectation {
-	if mmValidatePassword.mock.funcValidatePassword != nil {
-		mmValidatePassword.mock.t.Fatalf("ValidatorMock.ValidatePassword mock is already set by Set")
+func (mmValidateProfile *mValidatorMockValidateProfile) When(req mm_user.UpdateProfileReq) *ValidatorMockValidateProfileExpectation {
+	if mmValidateProfile.mock.funcValidateProfile != nil {
+		mmValidateProfile.mock.t.Fatalf("ValidatorMock.ValidateProfile mock is already set by Set")
 	}
 
-	expectation := &ValidatorMockValidatePasswordExpectation{
-		mock:               mmValidatePassword.mock,
-		params:             &ValidatorMockValidatePasswordParams{password},
-		expectationOrigins: ValidatorMockValidatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ValidatorMockValidateProfileExpectation{
+		mock:               mmValidateProfile.mock,
+		params:             &ValidatorMockValidateProfileParams{req},
+		expectationOrigins: ValidatorMockValidateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmValidatePassword.expectations = append(mmValidatePassword.expectations, expectation)
+	mmValidateProfile.expectations = append(mmValidateProfile.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Validator.ValidatePassword return parameters for the expectation previously defined by the When method
-func (e *ValidatorMockValidatePasswordExpectation) Then(err error) *ValidatorMock {
-	e.results = &ValidatorMockValidatePasswordResults{err}
+// Then sets up Validator.ValidateProfile return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateProfileExpectation) Then(err error) *ValidatorMock {
+	e.results = &ValidatorMockValidateProfileResults{err}
 	return e.mock
 }
 
-// Times sets number of times Validator.ValidatePassword should be invoked
-func (mmValidatePassword *mValidatorMockValidatePassword) Times(n uint64) *mValidatorMockValidatePassword {
+// Times sets number of times Validator.ValidateProfile should be invoked
+func (mmValidateProfile *mValidatorMockValidateProfile) Times(n uint64) *mValidatorMockValidateProfile {
 	if n == 0 {
-		mmValidatePassword.mock.t.Fatalf("Times of ValidatorMock.ValidatePassword mock can not be zero")
+		mmValidateProfile.mock.t.Fatalf("Times of ValidatorMock.ValidateProfile mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmValidatePassword.expectedInvocations, n)
-	mmValidatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmValidatePassword
+	mm_atomic.StoreUint64(&mmValidateProfile.expectedInvocations, n)
+	mmValidateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateProfile
 }
 
-func (mmValidatePassword *mValidatorMockValidatePassword) invocationsDone() bool {
-	if len(mmValidatePassword.expectations) == 0 && mmValidatePassword.defaultExpectation == nil && mmValidatePassword.mock.funcValidatePassword == nil {
+func (mmValidateProfile *mValidatorMockValidateProfile) invocationsDone() bool {
+	if len(mmValidateProfile.expectations) == 0 && mmValidateProfile.defaultExpectation == nil && mmValidateProfile.mock.funcValidateProfile == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmValidatePassword.mock.afterValidatePasswordCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmValidatePassword.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateProfile.mock.afterValidateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateProfile.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ValidatePassword implements mm_user.Validator
-func (mmValidatePassword *ValidatorMock) ValidatePassword(password []byte) (err error) {
-	mm_atomic.AddUint64(&mmValidatePassword.beforeValidatePasswordCounter, 1)
-	defer mm_atomic.AddUint64(&mmValidatePassword.afterValidatePasswordCounter, 1)
+// ValidateProfile implements mm_user.Validator
+func (mmValidateProfile *ValidatorMock) ValidateProfile(req mm_user.UpdateProfileReq) (err error) {
+	mm_atomic.AddUint64(&mmValidateProfile.beforeValidateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateProfile.afterValidateProfileCounter, 1)
 
-	mmValidatePassword.t.Helper()
+	mmValidateProfile.t.Helper()
 
-	if mmValidatePassword.inspectFuncValidatePassword != nil {
-		mmValidatePassword.inspectFuncValidatePassword(password)
+	if mmValidateProfile.inspectFuncValidateProfile != nil {
+		mmValidateProfile.inspectFuncValidateProfile(req)
 	}
 
-	mm_params := ValidatorMockValidatePasswordParams{password}
+	mm_params := ValidatorMockValidateProfileParams{req}
 
 	// Record call args
-	mmValidatePassword.ValidatePasswordMock.mutex.Lock()
-	mmValidatePassword.ValidatePasswordMock.callArgs = append(mmValidatePassword.ValidatePasswordMock.callArgs, &mm_params)
-	mmValidatePassword.ValidatePasswordMock.mutex.Unlock()
+	mmValidateProfile.ValidateProfileMock.mutex.Lock()
+	mmValidateProfile.ValidateProfileMock.callArgs = append(mmValidateProfile.ValidateProfileMock.callArgs, &mm_params)
+	mmValidateProfile.ValidateProfileMock.mutex.Unlock()
 
-	for _, e := range mmValidatePassword.ValidatePasswordMock.expectations {
+	for _, e := range mmValidateProfile.ValidateProfileMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmValidatePassword.ValidatePasswordMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmValidatePassword.ValidatePasswordMock.defaultExpectation.Counter, 1)
-		mm_want := mmValidatePassword.ValidatePasswordMock.defaultExpectation.params
-		mm_want_ptrs := mmValidatePassword.ValidatePasswordMock.defaultExpectation.paramPtrs
+	if mmValidateProfile.ValidateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateProfile.ValidateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateProfile.ValidateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateProfile.ValidateProfileMock.defaultExpectation.paramPtrs
 
-		mm_got := ValidatorMockValidatePasswordParams{password}
+		mm_got := ValidatorMockValidateProfileParams{req}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
-				mmValidatePassword.t.Errorf("ValidatorMock.ValidatePassword got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmValidatePassword.ValidatePasswordMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmValidateProfile.t.Errorf("ValidatorMock.ValidateProfile got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateProfile.ValidateProfileMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmValidatePassword.t.Errorf("ValidatorMock.ValidatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmValidatePassword.ValidatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmValidateProfile.t.Errorf("ValidatorMock.ValidateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateProfile.ValidateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmValidatePassword.ValidatePasswordMock.defaultExpectation.results
+		mm_results := mmValidateProfile.ValidateProfileMock.defaultExpectation.results
 		if mm_results == nil {
-			mmValidatePassword.t.Fatal("No results are set for the ValidatorMock.ValidatePassword")
+			mmValidateProfile.t.Fatal("No results are set for the ValidatorMock.ValidateProfile")
 		}
 		return (*mm_results).err
 	}
-	if mmValidatePassword.funcValidatePassword != nil {
-		return mmValidatePassword.funcValidatePassword(password)
+	if mmValidateProfile.funcValidateProfile != nil {
+		return mmValidateProfile.funcValidateProfile(req)
 	}
-	mmValidatePassword.t.Fatalf("Unexpected call to ValidatorMock.ValidatePassword. %v", password)
+	mmValidateProfile.t.Fatalf("Unexpected call to ValidatorMock.ValidateProfile. %v", req)
 	return
 }
 
-// ValidatePasswordAfterCounter returns a count of finished ValidatorMock.ValidatePassword invocations
-func (mmValidatePassword *ValidatorMock) ValidatePasswordAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidatePassword.afterValidatePasswordCounter)
+// ValidateProfileAfterCounter returns a count of finished ValidatorMock.ValidateProfile invocations
+func (mmValidateProfile *ValidatorMock) ValidateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateProfile.afterValidateProfileCounter)
 }
 
-// ValidatePasswordBeforeCounter returns a count of ValidatorMock.ValidatePassword invocations
-func (mmValidatePassword *ValidatorMock) ValidatePasswordBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmValidatePassword.beforeValidatePasswordCounter)
+// ValidateProfileBeforeCounter returns a count of ValidatorMock.ValidateProfile invocations
+func (mmValidateProfile *ValidatorMock) ValidateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateProfile.beforeValidateProfileCounter)
 }
 
-// Calls returns a list of arguments used in each call to ValidatorMock.ValidatePassword.
+// Calls returns a list of arguments used in each call to ValidatorMock.ValidateProfile.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmValidatePassword *mValidatorMockValidatePassword) Calls() []*ValidatorMockValidatePasswordParams {
-	mmValidatePassword.mutex.RLock()
+func (mmValidateProfile *mValidatorMockValidateProfile) Calls() []*ValidatorMockValidateProfileParams {
+	mmValidateProfile.mutex.RLock()
 
-	argCopy := make([]*ValidatorMockValidatePasswordParams, len(mmValidatePassword.callArgs))
-	copy(argCopy, mmValidatePassword.callArgs)
+	argCopy := make([]*ValidatorMockValidateProfileParams, len(mmValidateProfile.callArgs))
+	copy(argCopy, mmValidateProfile.callArgs)
 
-	mmValidatePassword.mutex.RUnlock()
+	mmValidateProfile.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockValidatePasswordDone returns true if the count of the ValidatePassword invocations corresponds
+// MinimockValidateProfileDone returns true if the count of the ValidateProfile invocations corresponds
 // the number of defined expectations
-func (m *ValidatorMock) MinimockValidatePasswordDone() bool {
-	if m.ValidatePasswordMock.optional {
+func (m *ValidatorMock) MinimockValidateProfileDone() bool {
+	if m.ValidateProfileMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ValidatePasswordMock.expectations {
+	for _, e := range m.ValidateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ValidatePasswordMock.invocationsDone()
+	return m.ValidateProfileMock.invocationsDone()
 }
 
-// MinimockValidatePasswordInspect logs each unmet expectation
-func (m *ValidatorMock) MinimockValidatePasswordInspect() {
-	for _, e := range m.ValidatePasswordMock.expectations {
+// MinimockValidateProfileInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateProfileInspect() {
+	for _, e := range m.ValidateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterValidatePasswordCounter := mm_atomic.LoadUint64(&m.afterValidatePasswordCounter)
+	afterValidateProfileCounter := mm_atomic.LoadUint64(&m.afterValidateProfileCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ValidatePasswordMock.defaultExpectation != nil && afterValidatePasswordCounter < 1 {
-		if m.ValidatePasswordMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s", m.ValidatePasswordMock.defaultExpectation.returnOrigin)
+	if m.ValidateProfileMock.defaultExpectation != nil && afterValidateProfileCounter < 1 {
+		if m.ValidateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ValidatorMock.ValidateProfile at\n%s", m.ValidateProfileMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s with params: %#v", m.ValidatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ValidatePasswordMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ValidatorMock.ValidateProfile at\n%s with params: %#v", m.ValidateProfileMock.defaultExpectation.expectationOrigins.origin, *m.ValidateProfileMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcValidatePassword != nil && afterValidatePasswordCounter < 1 {
-		m.t.Errorf("Expected call to ValidatorMock.ValidatePassword at\n%s", m.funcValidatePasswordOrigin)
+	if m.funcValidateProfile != nil && afterValidateProfileCounter < 1 {
+		m.t.Errorf("Expected call to ValidatorMock.ValidateProfile at\n%s", m.funcValidateProfileOrigin)
 	}
 
-	if !m.ValidatePasswordMock.invocationsDone() && afterValidatePasswordCounter > 0 {
-		m.t.Errorf("Expected %d calls to ValidatorMock.ValidatePassword at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ValidatePasswordMock.expectedInvocations), m.ValidatePasswordMock.expectedInvocationsOrigin, afterValidatePasswordCounter)
+	if !m.ValidateProfileMock.invocationsDone() && afterValidateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to ValidatorMock.ValidateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateProfileMock.expectedInvocations), m.ValidateProfileMock.expectedInvocationsOrigin, afterValidateProfileCounter)
 	}
 }
 
@@ -1675,11 +2961,19 @@ func (m *ValidatorMock) MinimockFinish() {
 
 			m.MinimockNormalizeNameInspect()
 
+			m.MinimockNormalizeProfileInspect()
+
+			m.MinimockValidateAvatarInspect()
+
 			m.MinimockValidateEmailInspect()
 
 			m.MinimockValidateNameInspect()
 
 			m.MinimockValidatePasswordInspect()
+
+			m.MinimockValidatePreferencesInspect()
+
+			m.MinimockValidateProfileInspect()
 		}
 	})
 }
@@ -1705,7 +2999,11 @@ func (m *ValidatorMock) minimockDone() bool {
 	return done &&
 		m.MinimockNormalizeEmailDone() &&
 		m.MinimockNormalizeNameDone() &&
+		m.MinimockNormalizeProfileDone() &&
+		m.MinimockValidateAvatarDone() &&
 		m.MinimockValidateEmailDone() &&
 		m.MinimockValidateNameDone() &&
-		m.MinimockValidatePasswordDone()
+		m.MinimockValidatePasswordDone() &&
+		m.MinimockValidatePreferencesDone() &&
+		m.MinimockValidateProfileDone()
 }
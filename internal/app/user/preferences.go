@@ -0,0 +1,61 @@
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// PreferencesSchemaVersion is the version of the Preferences document written by this build.
+const PreferencesSchemaVersion = 1
+
+const preferencesVersionKey = "schema_version"
+
+// preferencesMigrations[v] upgrades a raw document from schema version v to v+1.
+// To change the schema, bump PreferencesSchemaVersion and register the step from the
+// previous version here. Stored documents are upgraded lazily when read or replaced.
+var preferencesMigrations = map[int]func(doc map[string]any){}
+
+// ParsePreferences decodes a preferences document of any supported schema version into the
+// current one. A missing schema_version means the current version, missing fields take their
+// defaults and unknown fields are rejected. Values are checked by Validator.ValidatePreferences.
+func ParsePreferences(data []byte) (Preferences, error) {
+	doc := map[string]any{}
+	if err := json.Unmarshal(data, &doc); err != nil || doc == nil {
+		return Preferences{}, fmt.Errorf("ParsePreferences: %w", ErrInvalidPreferences("preferences must be a JSON object"))
+	}
+
+	version := PreferencesSchemaVersion
+	if raw, ok := doc[preferencesVersionKey]; ok {
+		f, ok := raw.(float64)
+		if !ok || f != math.Trunc(f) {
+			return Preferences{}, fmt.Errorf("ParsePreferences: %w", ErrInvalidPreferences("schema_version must be an integer"))
+		}
+		version = int(f)
+	}
+	if version < 1 || version > PreferencesSchemaVersion {
+		return Preferences{}, fmt.Errorf("ParsePreferences: %w", ErrUnsupportedPreferencesVersion(PreferencesSchemaVersion))
+	}
+	for ; version < PreferencesSchemaVersion; version++ {
+		migrate, ok := preferencesMigrations[version]
+		if !ok {
+			return Preferences{}, fmt.Errorf("ParsePreferences: no migration from schema version %d", version)
+		}
+		migrate(doc)
+	}
+	doc[preferencesVersionKey] = PreferencesSchemaVersion
+
+	upgraded, err := json.Marshal(doc)
+	if err != nil {
+		return Preferences{}, fmt.Errorf("ParsePreferences: %w", err)
+	}
+	prefs := DefaultPreferences()
+	dec := json.NewDecoder(bytes.NewReader(upgraded))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&prefs); err != nil {
+		return Preferences{}, fmt.Errorf("ParsePreferences: %w", ErrInvalidPreferences(err.Error()))
+	}
+
+	return prefs, nil
+}
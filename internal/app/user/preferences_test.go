@@ -0,0 +1,94 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePreferences(t *testing.T) {
+	t.Parallel()
+
+	spaceID := uuid.New()
+	tests := []struct {
+		name string
+		data string
+		want func() user.Preferences
+		err  error
+	}{
+		{
+			name: "success/full",
+			data: `{"schema_version":1,"theme":"dark","default_space_id":"` + spaceID.String() + `",
+				"notifications":{"email":false,"mentions":true,"comments":false,"digest":"weekly"},
+				"drafts":{"keep_stale":true}}`,
+			want: func() user.Preferences {
+				return user.Preferences{
+					SchemaVersion:  1,
+					Theme:          user.ThemeDark,
+					DefaultSpaceID: &spaceID,
+					Notifications:  user.NotificationPreferences{Mentions: true, Digest: user.DigestWeekly},
+					Drafts:         user.DraftPreferences{KeepStale: true},
+				}
+			},
+		},
+		{
+			name: "success/defaults_for_missing_fields",
+			data: `{"theme":"light","notifications":{"email":false}}`,
+			want: func() user.Preferences {
+				p := user.DefaultPreferences()
+				p.Theme = user.ThemeLight
+				p.Notifications.Email = false
+				return p
+			},
+		},
+		{
+			name: "error/not_an_object",
+			data: `[1]`,
+			err:  user.ErrInvalidPreferences(""),
+		},
+		{
+			name: "error/null",
+			data: `null`,
+			err:  user.ErrInvalidPreferences(""),
+		},
+		{
+			name: "error/unknown_field",
+			data: `{"colour":"red"}`,
+			err:  user.ErrInvalidPreferences(""),
+		},
+		{
+			name: "error/wrong_type",
+			data: `{"notifications":{"email":"yes"}}`,
+			err:  user.ErrInvalidPreferences(""),
+		},
+		{
+			name: "error/version_not_integer",
+			data: `{"schema_version":1.5}`,
+			err:  user.ErrInvalidPreferences(""),
+		},
+		{
+			name: "error/version_too_old",
+			data: `{"schema_version":0}`,
+			err:  user.ErrUnsupportedPreferencesVersion(user.PreferencesSchemaVersion),
+		},
+		{
+			name: "error/version_from_the_future",
+			data: `{"schema_version":99}`,
+			err:  user.ErrUnsupportedPreferencesVersion(user.PreferencesSchemaVersion),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.ParsePreferences([]byte(tt.data))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want(), got)
+		})
+	}
+}
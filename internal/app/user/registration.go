@@ -0,0 +1,48 @@
+package user
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// RegistrationConfig controls who can register themselves with POST /register. Users created by
+// admins and invitees are not restricted by it.
+type RegistrationConfig struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// AllowedDomains limits registration to emails on these domains, compared without case.
+	// Subdomains are not included; empty allows every domain.
+	AllowedDomains []string `mapstructure:"allowed_domains" json:"allowed_domains"`
+}
+
+func (c RegistrationConfig) Validate() error {
+	for _, domain := range c.AllowedDomains {
+		if domain == "" || strings.ContainsAny(domain, "@ \t") {
+			return fmt.Errorf("allowed_domains: %q is not a domain", domain)
+		}
+	}
+
+	return nil
+}
+
+// Check returns ErrRegistrationDisabled or ErrEmailDomainNotAllowed when the owner of email
+// cannot register themselves.
+func (c RegistrationConfig) Check(email string) error {
+	if !c.Enabled {
+		return ErrRegistrationDisabled()
+	}
+	if len(c.AllowedDomains) == 0 {
+		return nil
+	}
+
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return ErrEmailDomainNotAllowed()
+	}
+	domain := strings.TrimSpace(email[at+1:])
+	if !slices.ContainsFunc(c.AllowedDomains, func(d string) bool { return strings.EqualFold(d, domain) }) {
+		return ErrEmailDomainNotAllowed()
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationConfig_Check(t *testing.T) {
+	t.Parallel()
+
+	restricted := user.RegistrationConfig{Enabled: true, AllowedDomains: []string{"example.com", "Corp.example.org"}}
+	tests := []struct {
+		name  string
+		cfg   user.RegistrationConfig
+		email string
+		err   error
+	}{
+		{name: "open", cfg: user.RegistrationConfig{Enabled: true}, email: "ann@gmail.com"},
+		{name: "allowed", cfg: restricted, email: "ann@example.com"},
+		{name: "allowed/case", cfg: restricted, email: " Ann@CORP.Example.org "},
+		{name: "disabled", cfg: user.RegistrationConfig{AllowedDomains: []string{"example.com"}}, email: "ann@example.com", err: user.ErrRegistrationDisabled()},
+		{name: "other_domain", cfg: restricted, email: "ann@gmail.com", err: user.ErrEmailDomainNotAllowed()},
+		{name: "subdomain", cfg: restricted, email: "ann@mail.example.com", err: user.ErrEmailDomainNotAllowed()},
+		{name: "lookalike", cfg: restricted, email: "ann@example.com@evil.com", err: user.ErrEmailDomainNotAllowed()},
+		{name: "no_domain", cfg: restricted, email: "ann", err: user.ErrEmailDomainNotAllowed()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Check(tt.email)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRegistrationConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, user.RegistrationConfig{AllowedDomains: []string{"example.com"}}.Validate())
+	require.Error(t, user.RegistrationConfig{AllowedDomains: []string{""}}.Validate())
+	require.Error(t, user.RegistrationConfig{AllowedDomains: []string{"@example.com"}}.Validate())
+}
@@ -11,10 +11,21 @@ import (
 type userModel struct {
 	db.Base
 	ID             uuid.UUID
+	WorkspaceID    uuid.UUID
 	Email          string
 	PasswordHash   string `json:"-"`
 	Name           string
 	SessionVersion int
+	AnonymizedAt   *time.Time
+	External       bool
+
+	DisplayName       string
+	Bio               string
+	Timezone          string
+	Locale            string
+	AvatarContentType *string
+	AvatarSize        *int64
+	AvatarUpdatedAt   *time.Time
 }
 
 func (u *userModel) TableName() string {
@@ -35,5 +46,14 @@ func (u *userModel) toDTO() user.User {
 		UpdatedAt:      u.UpdatedAt,
 		DeletedAt:      deletedAt,
 		SessionVersion: u.SessionVersion,
+		AnonymizedAt:   u.AnonymizedAt,
+		External:       u.External,
+		Profile: user.Profile{
+			DisplayName: u.DisplayName,
+			Bio:         u.Bio,
+			Timezone:    u.Timezone,
+			Locale:      u.Locale,
+		},
+		AvatarURL: user.AvatarURL(u.ID, u.AvatarUpdatedAt),
 	}
 }
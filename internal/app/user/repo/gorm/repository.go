@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -27,12 +30,14 @@ func NewRepository(db *gorm.DB) (*gormRepo, error) {
 func (r *gormRepo) CreateUser(ctx context.Context, req user.CreateUserReq, id uuid.UUID, passwordHash string) error {
 	model := &userModel{
 		ID:           id,
+		WorkspaceID:  contextx.WorkspaceID(ctx),
 		Email:        req.Email,
 		PasswordHash: passwordHash,
 		Name:         req.Name,
+		External:     req.External,
 	}
 
-	err := r.db.WithContext(ctx).Create(model).Error
+	err := db.Conn(ctx, r.db).Create(model).Error
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
@@ -47,7 +52,7 @@ func (r *gormRepo) CreateUser(ctx context.Context, req user.CreateUserReq, id uu
 func (r *gormRepo) GetUser(ctx context.Context, id uuid.UUID) (user.User, string, error) {
 	model := userModel{}
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = user.ErrUserNotFound()
@@ -61,7 +66,7 @@ func (r *gormRepo) GetUser(ctx context.Context, id uuid.UUID) (user.User, string
 func (r *gormRepo) GetUserByEmail(ctx context.Context, email string) (user.User, string, error) {
 	model := userModel{}
 
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&model).Error
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Where("email = ?", email).First(&model).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			err = user.ErrUserNotFound()
@@ -72,23 +77,63 @@ func (r *gormRepo) GetUserByEmail(ctx context.Context, email string) (user.User,
 	return model.toDTO(), model.PasswordHash, nil
 }
 
-func (r *gormRepo) GetAllUsers(ctx context.Context) ([]user.User, error) {
+var usersKeyset = db.Keyset{Columns: []string{"created_at", "id"}}
+
+func (r *gormRepo) GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (user.UsersPage, error) {
 	models := make([]userModel, 0)
 
-	err := r.db.WithContext(ctx).
-		Select("id", "email", "name", "created_at", "updated_at", "deleted_at", "session_version").
-		Find(&models).Error
+	q := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx), db.WithDeleted(opts.IncludeDeleted), usersKeyset.Order()).
+		Select("id", "email", "name", "created_at", "updated_at", "deleted_at", "session_version",
+			"display_name", "bio", "timezone", "locale", "avatar_updated_at")
+	if opts.After != "" {
+		createdAt, id, err := decodeUsersCursor(opts.After)
+		if err != nil {
+			return user.UsersPage{}, fmt.Errorf("gormRepo.GetAllUsers: %w", err)
+		}
+		q = q.Scopes(usersKeyset.After(createdAt, id))
+	}
+	if opts.Limit > 0 {
+		q = q.Scopes(db.PageLimit(opts.Limit))
+	}
+	if err := q.Find(&models).Error; err != nil {
+		return user.UsersPage{}, fmt.Errorf("gormRepo.GetAllUsers: %w", err)
+	}
+
+	var page user.UsersPage
+	if opts.Limit > 0 {
+		var more bool
+		if models, more = db.TrimPage(models, opts.Limit); more {
+			last := models[len(models)-1]
+			page.NextCursor = db.EncodeCursor(strconv.FormatInt(last.CreatedAt.UnixMicro(), 10), last.ID.String())
+		}
+	}
+	page.Users = lo.Map(models, func(u userModel, _ int) user.User { return u.toDTO() })
+
+	return page, nil
+}
+
+// decodeUsersCursor reads the creation time, in microseconds, and the ID of the last user of a page.
+func decodeUsersCursor(cursor string) (time.Time, uuid.UUID, error) {
+	values, err := db.DecodeCursor(cursor, 2)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("decodeUsersCursor: %w", err)
+	}
+	micros, err := strconv.ParseInt(values[0], 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("gormRepo.GetAllUsers: %w", err)
+		return time.Time{}, uuid.Nil, fmt.Errorf("decodeUsersCursor: %w", db.ErrInvalidCursor())
+	}
+	id, err := uuid.Parse(values[1])
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("decodeUsersCursor: %w", db.ErrInvalidCursor())
 	}
 
-	return lo.Map(models, func(u userModel, _ int) user.User { return u.toDTO() }), nil
+	return time.UnixMicro(micros).UTC(), id, nil
 }
 
 func (r *gormRepo) UpdateUser(ctx context.Context, req user.UpdateUserReq) error {
 	model := &userModel{}
 
-	result := r.db.WithContext(ctx).Model(model).Where("id = ?", req.UserID).
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Model(model).Where("id = ?", req.UserID).
 		Updates(map[string]interface{}{"name": req.Name, "email": req.Email})
 	if result.Error != nil {
 		err := result.Error
@@ -106,7 +151,7 @@ func (r *gormRepo) UpdateUser(ctx context.Context, req user.UpdateUserReq) error
 }
 
 func (r *gormRepo) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&userModel{}, "id = ?", id)
+	result := db.Conn(ctx, r.db).Scopes(db.InWorkspace(ctx)).Delete(&userModel{}, "id = ?", id)
 	if result.Error != nil {
 		return fmt.Errorf("gormRepo.DeleteUser: %w", result.Error)
 	}
@@ -117,8 +162,53 @@ func (r *gormRepo) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// AnonymizeUser keeps the row, so created_by and updated_by references stay valid, and clears what
+// identifies the user: profile, preferences, the password, which no login matches, and the IP
+// addresses and user agents of the sign-in history. The avatar goes with its blob, see DeleteAvatar.
+func (r *gormRepo) AnonymizeUser(ctx context.Context, id uuid.UUID, email, name string) error {
+	err := db.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Scopes(db.InWorkspace(ctx)).Model(&userModel{}).
+			Where("id = ? AND anonymized_at IS NULL", id).
+			Updates(map[string]any{
+				"email":           email,
+				"name":            name,
+				"password_hash":   "",
+				"display_name":    "",
+				"bio":             "",
+				"timezone":        "",
+				"locale":          "",
+				"anonymized_at":   gorm.Expr("NOW()"),
+				"session_version": gorm.Expr("session_version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&userModel{}).Scopes(db.InWorkspace(ctx)).Where("id = ?", id).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return user.ErrUserAnonymized()
+			}
+			return user.ErrUserNotFound()
+		}
+
+		if err := tx.Exec("DELETE FROM user_preferences WHERE user_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("UPDATE login_events SET ip = '', user_agent = '' WHERE user_id = ?", id).Error
+	})
+	if err != nil {
+		return fmt.Errorf("gormRepo.AnonymizeUser: %w", err)
+	}
+
+	return nil
+}
+
 func (r *gormRepo) ChangePassword(ctx context.Context, id uuid.UUID, newPasswordHash string) error {
-	result := r.db.WithContext(ctx).
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).
 		Model(&userModel{}).
 		Where("id = ?", id).
 		Updates(map[string]any{
@@ -134,3 +224,148 @@ func (r *gormRepo) ChangePassword(ctx context.Context, id uuid.UUID, newPassword
 
 	return nil
 }
+
+func (r *gormRepo) UpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) error {
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).
+		Model(&userModel{}).
+		Where("id = ? AND password_hash = ?", id, oldHash).
+		Update("password_hash", newHash).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.UpdatePasswordHash: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) LinkExternalUser(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Update("external", true)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.LinkExternalUser: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.LinkExternalUser: %w", user.ErrUserNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) UpdateProfile(ctx context.Context, req user.UpdateProfileReq) error {
+	updates := make(map[string]any, 4)
+	if req.DisplayName != nil {
+		updates["display_name"] = *req.DisplayName
+	}
+	if req.Bio != nil {
+		updates["bio"] = *req.Bio
+	}
+	if req.Timezone != nil {
+		updates["timezone"] = *req.Timezone
+	}
+	if req.Locale != nil {
+		updates["locale"] = *req.Locale
+	}
+
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Model(&userModel{}).Where("id = ?", req.UserID).Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.UpdateProfile: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.UpdateProfile: %w", user.ErrUserNotFound())
+	}
+
+	return nil
+}
+
+// GetAvatar returns ErrAvatarNotFound both for users without an avatar and for missing users.
+func (r *gormRepo) GetAvatar(ctx context.Context, userID uuid.UUID) (user.Avatar, error) {
+	model := userModel{}
+	err := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).
+		Select("avatar_content_type", "avatar_size", "avatar_updated_at").
+		Where("id = ? AND avatar_updated_at IS NOT NULL", userID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = user.ErrAvatarNotFound()
+		}
+		return user.Avatar{}, fmt.Errorf("gormRepo.GetAvatar: %w", err)
+	}
+
+	return user.Avatar{
+		ContentType: lo.FromPtr(model.AvatarContentType),
+		Size:        lo.FromPtr(model.AvatarSize),
+		UpdatedAt:   *model.AvatarUpdatedAt,
+	}, nil
+}
+
+func (r *gormRepo) SetAvatar(ctx context.Context, userID uuid.UUID, avatar user.Avatar) error {
+	result := r.db.WithContext(ctx).Scopes(db.InWorkspace(ctx)).Model(&userModel{}).Where("id = ?", userID).
+		Updates(map[string]any{
+			"avatar_content_type": avatar.ContentType,
+			"avatar_size":         avatar.Size,
+			"avatar_updated_at":   avatar.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.SetAvatar: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.SetAvatar: %w", user.ErrUserNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteAvatar(ctx context.Context, userID uuid.UUID) error {
+	result := db.Conn(ctx, r.db).Scopes(db.InWorkspace(ctx)).Model(&userModel{}).
+		Where("id = ? AND avatar_updated_at IS NOT NULL", userID).
+		Updates(map[string]any{"avatar_content_type": nil, "avatar_size": nil, "avatar_updated_at": nil})
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.DeleteAvatar: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.DeleteAvatar: %w", user.ErrAvatarNotFound())
+	}
+
+	return nil
+}
+
+// GetPreferences returns nil data for an existing user without saved preferences.
+func (r *gormRepo) GetPreferences(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	var rows []struct {
+		Data *string
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT p.data::text AS data
+		FROM users u
+		LEFT JOIN user_preferences p ON p.user_id = u.id
+		WHERE u.id = ? AND ? AND ?`, userID, db.ActiveCond("u.deleted_at"), db.WorkspaceCond(ctx, "u.workspace_id")).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.GetPreferences: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("gormRepo.GetPreferences: %w", user.ErrUserNotFound())
+	}
+	if rows[0].Data == nil {
+		return nil, nil
+	}
+
+	return []byte(*rows[0].Data), nil
+}
+
+func (r *gormRepo) SetPreferences(ctx context.Context, userID uuid.UUID, data []byte) error {
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO user_preferences (user_id, data, updated_at)
+		SELECT id, ?::jsonb, NOW() FROM users WHERE id = ? AND ? AND ?
+		ON CONFLICT (user_id) DO UPDATE SET data = EXCLUDED.data, updated_at = EXCLUDED.updated_at`,
+		string(data), userID, db.ActiveCond("deleted_at"), db.WorkspaceCond(ctx, "workspace_id"))
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.SetPreferences: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.SetPreferences: %w", user.ErrUserNotFound())
+	}
+
+	return nil
+}
@@ -3,9 +3,11 @@ package gorm
 import (
 	"os"
 	"testing"
+	"time"
 
 	uapp "github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/db"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -117,19 +119,49 @@ func TestUser_GetAllUsers(t *testing.T) {
 	}
 
 	// get all
-	list, err := repo.GetAllUsers(t.Context())
+	page, err := repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{})
 	require.NoError(t, err)
-	require.Len(t, list, 3)
-	for _, d := range list {
+	require.Empty(t, page.NextCursor)
+	require.Len(t, page.Users, 3)
+	all := page.Users
+	for _, d := range page.Users {
 		exp, ok := expMap[d.ID]
 		require.True(t, ok)
 		compareUsersDTO(t, d, exp.req.Name, exp.req.Email, exp.id)
 		delete(expMap, d.ID)
 	}
 
+	// pages follow the order of the full list
+	page, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, all[:2], page.Users)
+	require.NotEmpty(t, page.NextCursor)
+	page, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{Limit: 2, After: page.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, all[2:], page.Users)
+	require.Empty(t, page.NextCursor)
+	_, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{Limit: 2, After: "not a cursor"})
+	require.ErrorIs(t, err, db.ErrInvalidCursor())
+
+	// deleted users are listed only when asked for
+	require.NoError(t, repo.DeleteUser(t.Context(), data3.id))
+	page, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+	for _, d := range page.Users {
+		require.NotEqual(t, data3.id, d.ID)
+		require.Nil(t, d.DeletedAt)
+	}
+	page, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 3)
+	for _, d := range page.Users {
+		require.Equal(t, d.ID == data3.id, d.DeletedAt != nil)
+	}
+
 	// err
 	cleanup()
-	_, err = repo.GetAllUsers(t.Context())
+	_, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{})
 	require.Error(t, err)
 }
 
@@ -210,6 +242,47 @@ func TestUser_DeleteUser_Success_And_NotFound(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUser_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Ann"}, id, "hash"))
+	bio, tz := "Writes docs", "Europe/Berlin"
+	require.NoError(t, repo.UpdateProfile(t.Context(), uapp.UpdateProfileReq{UserID: id, Bio: &bio, Timezone: &tz}))
+	require.NoError(t, repo.SetPreferences(t.Context(), id, []byte(`{"theme":"dark"}`)))
+	require.NoError(t, gdb.Exec(`INSERT INTO login_events(id, user_id, ip, user_agent, success) VALUES (?, ?, '203.0.113.7', 'curl/8.0', TRUE)`,
+		uuid.New(), id).Error)
+
+	email := uapp.AnonymizedEmail(id)
+	require.NoError(t, repo.AnonymizeUser(t.Context(), id, email, uapp.AnonymizedName))
+
+	u, hash, err := repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, email, u.Email)
+	require.Equal(t, uapp.AnonymizedName, u.Name)
+	require.Empty(t, hash)
+	require.Equal(t, uapp.Profile{}, u.Profile)
+	require.NotNil(t, u.AnonymizedAt)
+	require.Equal(t, 1, u.SessionVersion)
+	data, err := repo.GetPreferences(t.Context(), id)
+	require.NoError(t, err)
+	require.Nil(t, data)
+	var events []struct{ IP, UserAgent string }
+	require.NoError(t, gdb.Raw("SELECT ip, user_agent FROM login_events WHERE user_id = ?", id).Scan(&events).Error)
+	require.Equal(t, []struct{ IP, UserAgent string }{{}}, events)
+
+	// already anonymized
+	require.ErrorIs(t, repo.AnonymizeUser(t.Context(), id, email, uapp.AnonymizedName), uapp.ErrUserAnonymized())
+	// not found
+	other := uuid.New()
+	require.ErrorIs(t, repo.AnonymizeUser(t.Context(), other, uapp.AnonymizedEmail(other), uapp.AnonymizedName), uapp.ErrUserNotFound())
+
+	// err
+	cleanup()
+	require.Error(t, repo.AnonymizeUser(t.Context(), id, email, uapp.AnonymizedName))
+}
+
 func TestUser_ChangePassword_IncrementsSessionVersion(t *testing.T) {
 	t.Parallel()
 	repo, _, cleanup := newRepo(t)
@@ -235,6 +308,189 @@ func TestUser_ChangePassword_IncrementsSessionVersion(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUser_UpdatePasswordHash_KeepsSessionVersion(t *testing.T) {
+	t.Parallel()
+	repo, _, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Rehash"}, id, "oldhash"))
+
+	require.NoError(t, repo.UpdatePasswordHash(t.Context(), id, "oldhash", "newhash"))
+	u, ph, err := repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, "newhash", ph)
+	require.Equal(t, 0, u.SessionVersion)
+
+	// the password changed meanwhile: nothing happens
+	require.NoError(t, repo.UpdatePasswordHash(t.Context(), id, "oldhash", "stalehash"))
+	_, ph, err = repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, "newhash", ph)
+
+	// err
+	cleanup()
+	err = repo.UpdatePasswordHash(t.Context(), id, "newhash", "xxx")
+	require.Error(t, err)
+}
+
+func TestUser_LinkExternalUser(t *testing.T) {
+	t.Parallel()
+	repo, _, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Local"}, id, "hash"))
+	u, _, err := repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.False(t, u.External)
+
+	require.NoError(t, repo.LinkExternalUser(t.Context(), id))
+	u, ph, err := repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.True(t, u.External)
+	require.Equal(t, "hash", ph)
+
+	// not found
+	err = repo.LinkExternalUser(t.Context(), uuid.New())
+	require.ErrorIs(t, err, uapp.ErrUserNotFound())
+
+	// err
+	cleanup()
+	err = repo.LinkExternalUser(t.Context(), id)
+	require.Error(t, err)
+}
+
+func TestUser_UpdateProfile(t *testing.T) {
+	t.Parallel()
+	repo, _, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Profile"}, id, "hash"))
+
+	name, tz, empty := "Jane", "Europe/Berlin", ""
+	require.NoError(t, repo.UpdateProfile(t.Context(), uapp.UpdateProfileReq{UserID: id, DisplayName: &name, Timezone: &tz}))
+	u, _, err := repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, uapp.Profile{DisplayName: name, Timezone: tz}, u.Profile)
+
+	// only the given fields change
+	require.NoError(t, repo.UpdateProfile(t.Context(), uapp.UpdateProfileReq{UserID: id, Timezone: &empty}))
+	u, _, err = repo.GetUser(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, uapp.Profile{DisplayName: name}, u.Profile)
+
+	// not found
+	err = repo.UpdateProfile(t.Context(), uapp.UpdateProfileReq{UserID: uuid.New(), DisplayName: &name})
+	require.ErrorIs(t, err, uapp.ErrUserNotFound())
+
+	// err
+	cleanup()
+	err = repo.UpdateProfile(t.Context(), uapp.UpdateProfileReq{UserID: id, DisplayName: &name})
+	require.Error(t, err)
+}
+
+func TestUser_Avatar(t *testing.T) {
+	t.Parallel()
+	repo, _, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Avatar"}, id, "hash"))
+
+	// no avatar yet
+	_, err := repo.GetAvatar(t.Context(), id)
+	require.ErrorIs(t, err, uapp.ErrAvatarNotFound())
+	require.ErrorIs(t, repo.DeleteAvatar(t.Context(), id), uapp.ErrAvatarNotFound())
+
+	avatar := uapp.Avatar{ContentType: "image/png", Size: 1024, UpdatedAt: time.Now().UTC().Truncate(time.Microsecond)}
+	require.NoError(t, repo.SetAvatar(t.Context(), id, avatar))
+	got, err := repo.GetAvatar(t.Context(), id)
+	require.NoError(t, err)
+	require.Equal(t, avatar.ContentType, got.ContentType)
+	require.Equal(t, avatar.Size, got.Size)
+	require.True(t, avatar.UpdatedAt.Equal(got.UpdatedAt))
+
+	// the URL is part of the listing
+	page, err := repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, uapp.AvatarURL(id, &avatar.UpdatedAt), page.Users[0].AvatarURL)
+
+	require.NoError(t, repo.DeleteAvatar(t.Context(), id))
+	_, err = repo.GetAvatar(t.Context(), id)
+	require.ErrorIs(t, err, uapp.ErrAvatarNotFound())
+
+	// not found
+	require.ErrorIs(t, repo.SetAvatar(t.Context(), uuid.New(), avatar), uapp.ErrUserNotFound())
+
+	// err
+	cleanup()
+	require.Error(t, repo.SetAvatar(t.Context(), id, avatar))
+}
+
+func TestUser_Preferences(t *testing.T) {
+	t.Parallel()
+	repo, _, cleanup := newRepo(t)
+
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(t.Context(), uapp.CreateUserReq{Email: uuid.New().String() + "@ex.com", Name: "Prefs"}, id, "hash"))
+
+	// nothing saved yet
+	data, err := repo.GetPreferences(t.Context(), id)
+	require.NoError(t, err)
+	require.Nil(t, data)
+
+	require.NoError(t, repo.SetPreferences(t.Context(), id, []byte(`{"theme":"dark"}`)))
+	require.NoError(t, repo.SetPreferences(t.Context(), id, []byte(`{"theme":"light"}`)))
+	data, err = repo.GetPreferences(t.Context(), id)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"theme":"light"}`, string(data))
+
+	// not found
+	_, err = repo.GetPreferences(t.Context(), uuid.New())
+	require.ErrorIs(t, err, uapp.ErrUserNotFound())
+	require.ErrorIs(t, repo.SetPreferences(t.Context(), uuid.New(), []byte(`{}`)), uapp.ErrUserNotFound())
+
+	// err
+	cleanup()
+	_, err = repo.GetPreferences(t.Context(), id)
+	require.Error(t, err)
+}
+
+func TestUser_WorkspaceIsolation(t *testing.T) {
+	t.Parallel()
+	repo, gdb, _ := newRepo(t)
+
+	other := uuid.New()
+	require.NoError(t, gdb.Exec(`INSERT INTO workspaces (id, slug, name, created_at, updated_at)
+		VALUES (?, 'other', 'Other', NOW(), NOW())`, other).Error)
+	defaultCtx := contextx.SetWorkspaceID(t.Context(), contextx.DefaultWorkspaceID)
+	otherCtx := contextx.SetWorkspaceID(t.Context(), other)
+
+	// the same email in both workspaces
+	req := uapp.CreateUserReq{Email: "same@ex.com", Name: "John"}
+	id := uuid.New()
+	require.NoError(t, repo.CreateUser(defaultCtx, req, id, "phash"))
+	otherID := uuid.New()
+	require.NoError(t, repo.CreateUser(otherCtx, req, otherID, "phash"))
+
+	u, _, err := repo.GetUserByEmail(otherCtx, req.Email)
+	require.NoError(t, err)
+	require.Equal(t, otherID, u.ID)
+
+	_, _, err = repo.GetUser(otherCtx, id)
+	require.ErrorIs(t, err, uapp.ErrUserNotFound())
+	require.ErrorIs(t, repo.DeleteUser(otherCtx, id), uapp.ErrUserNotFound())
+
+	page, err := repo.GetAllUsers(otherCtx, uapp.ListUsersOptions{})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, otherID, page.Users[0].ID)
+
+	// no workspace in the context, as in jobs: every workspace
+	page, err = repo.GetAllUsers(t.Context(), uapp.ListUsersOptions{})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+}
+
 func TestNewRepository(t *testing.T) {
 	t.Parallel()
 
@@ -2,7 +2,10 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/app/user/usecase"
@@ -16,6 +19,17 @@ import (
 
 const (
 	URLParamUserID = "user_id"
+
+	QueryParamIncludeDeleted = "include_deleted"
+	QueryParamLimit          = "limit"
+	QueryParamAfter          = "after"
+
+	// HeaderNextCursor is set on pages of the user list that are not the last, as the after value of
+	// the next page.
+	HeaderNextCursor = "X-Next-Cursor"
+
+	// avatarCacheControl lets clients cache avatars; the URL changes with every upload.
+	avatarCacheControl = "private, max-age=86400"
 )
 
 type CreateUserInput struct {
@@ -24,11 +38,23 @@ type CreateUserInput struct {
 	Password string `json:"password"`
 }
 
+type CreateUserResp struct {
+	ID uuid.UUID `json:"id"`
+}
+
 type UpdateUserInput struct {
 	Email string `json:"email"`
 	Name  string `json:"name"`
 }
 
+// UpdateProfileInput changes only the fields that are present; an empty string clears a field.
+type UpdateProfileInput struct {
+	DisplayName *string `json:"display_name"`
+	Bio         *string `json:"bio"`
+	Timezone    *string `json:"timezone"`
+	Locale      *string `json:"locale"`
+}
+
 type ChangePasswordInput struct {
 	OldPassword string `json:"old_password"`
 	NewPassword string `json:"new_password"`
@@ -42,11 +68,19 @@ type Handler struct {
 //go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user/usecase.Service -o ./mock -s _mock.go
 type Service interface {
 	CreateUser(ctx context.Context, req user.CreateUserReq) error
+	AdminCreateUser(ctx context.Context, req user.CreateUserReq) (uuid.UUID, error)
 	GetUser(ctx context.Context, id uuid.UUID) (user.User, error)
-	GetAllUsers(ctx context.Context) ([]user.User, error)
+	GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (user.UsersPage, error)
 	UpdateUser(ctx context.Context, req user.UpdateUserReq) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	AnonymizeUser(ctx context.Context, id uuid.UUID) error
 	ChangePassword(ctx context.Context, req usecase.ChangePasswordCmd) error
+	UpdateProfile(ctx context.Context, req user.UpdateProfileReq) error
+	UploadAvatar(ctx context.Context, userID uuid.UUID, data []byte) error
+	GetAvatar(ctx context.Context, userID uuid.UUID) (user.Avatar, io.ReadCloser, error)
+	DeleteAvatar(ctx context.Context, userID uuid.UUID) error
+	GetPreferences(ctx context.Context, userID uuid.UUID) (user.Preferences, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (user.Preferences, error)
 }
 
 func NewHandler(svc Service) *Handler {
@@ -57,14 +91,15 @@ func NewHandler(svc Service) *Handler {
 }
 
 // CreateUser godoc
-// @Summary      Create user
-// @Description  Creates a new user.
+// @Summary      Register
+// @Description  Creates an account for the caller. Fails with 403 when user.registration disables self-registration or does not allow the email domain.
 // @Tags         users
 // @Security     BearerAuth
 // @Accept       json
 // @Param        request body CreateUserInput true "Create user payload"
+// @Param        X-Captcha-Token header string false "CAPTCHA token, when captcha.register is set"
 // @Success      201 "Created"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /register [post]
 func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -93,6 +128,45 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 }
 
+// AdminCreateUser godoc
+// @Summary      Create user
+// @Description  Creates a user regardless of user.registration and returns its ID. Requires admin role.
+// @Tags         users
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateUserInput true "Create user payload"
+// @Success      201 {object} CreateUserResp
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users [post]
+func (h *Handler) AdminCreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var in CreateUserInput
+	if err := httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Msg("user.Handler.AdminCreateUser: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	req := user.CreateUserReq{
+		Name:     in.Name,
+		Email:    in.Email,
+		Password: []byte(in.Password),
+	}
+	defer secure.ZeroBytes(req.Password)
+	in.Password = ""
+
+	id, err := h.svc.AdminCreateUser(ctx, req)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusCreated, CreateUserResp{ID: id})
+}
+
 // GetUser godoc
 // @Summary      Get user by ID
 // @Description  Returns a single user by ID. Requires admin role or self.
@@ -101,7 +175,7 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 // @Produce      json
 // @Param        user_id path string true "User ID"
 // @Success      200 {object} user.User
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /users/{user_id} [get]
 func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -126,22 +200,50 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 
 // GetAllUsers godoc
 // @Summary      List users
-// @Description  Returns all users. Requires admin role.
+// @Description  Returns the users, oldest first: all of them or, with limit, a page. The X-Next-Cursor header of a page is the after value of the next one. Deleted users are left out unless include_deleted is set. Requires admin role.
 // @Tags         users
 // @Security     BearerAuth
 // @Produce      json
+// @Param        include_deleted query bool false "Also return deleted users"
+// @Param        limit query int false "Page size, up to 100"
+// @Param        after query string false "Cursor of the page"
 // @Success      200 {array} user.User
-// @Failure      default {object} apperr.appError "Error"
+// @Header       200 {string} X-Next-Cursor "Cursor of the next page, set unless this is the last page"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /users [get]
 func (h *Handler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	users, err := h.svc.GetAllUsers(ctx)
+	var opts user.ListUsersOptions
+	if v := r.URL.Query().Get(QueryParamIncludeDeleted); v != "" {
+		var err error
+		if opts.IncludeDeleted, err = strconv.ParseBool(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamIncludeDeleted, v).
+				Msg("user.Handler.GetAllUsers: invalid include_deleted")
+			httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+			return
+		}
+	}
+	if v := r.URL.Query().Get(QueryParamLimit); v != "" {
+		var err error
+		if opts.Limit, err = strconv.Atoi(v); err != nil {
+			logger.Warn(ctx, err).Str(QueryParamLimit, v).
+				Msg("user.Handler.GetAllUsers: invalid limit")
+			httpx.ReturnError(ctx, w, user.ErrInvalidListLimit(user.MaxListUsersLimit))
+			return
+		}
+	}
+	opts.After = r.URL.Query().Get(QueryParamAfter)
+
+	page, err := h.svc.GetAllUsers(ctx, opts)
 	if err != nil {
 		httpx.ReturnError(ctx, w, err)
 		return
 	}
 
-	httpx.WriteJSON(ctx, w, http.StatusOK, users)
+	if page.NextCursor != "" {
+		w.Header().Set(HeaderNextCursor, page.NextCursor)
+	}
+	httpx.WriteJSON(ctx, w, http.StatusOK, page.Users)
 }
 
 // UpdateUser godoc
@@ -153,7 +255,7 @@ func (h *Handler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 // @Param        user_id path string true "User ID"
 // @Param        request body UpdateUserInput true "Update user payload"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /users/{user_id} [put]
 func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -198,7 +300,7 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 // @Param        user_id path string true "User ID"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /users/{user_id} [delete]
 func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -221,6 +323,36 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// AnonymizeUser godoc
+// @Summary      Anonymize user
+// @Description  Scrubs the user's email, name and profile while keeping an opaque placeholder for authorship references. Deletes the avatar, the data exports and the IP addresses and user agents of past sign-ins. Revokes sessions and roles. Requires admin role.
+// @Tags         users
+// @Security     BearerAuth
+// @Param        user_id path string true "User ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /admin/users/{user_id}/anonymize [post]
+func (h *Handler) AnonymizeUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.AnonymizeUser: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.AnonymizeUser(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // ChangePassword godoc
 // @Summary      Change user password
 // @Description  Changes password for the specified user (old -> new). Requires admin role or self. If admin changes password, old password is not checked.
@@ -230,7 +362,7 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 // @Param        user_id path string true "User ID"
 // @Param        request body ChangePasswordInput true "Change password payload"
 // @Success      204 "No Content"
-// @Failure      default {object} apperr.appError "Error"
+// @Failure      default {object} apperr.Problem "Error"
 // @Router       /users/{user_id}/password [post]
 func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -271,3 +403,238 @@ func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// UpdateProfile godoc
+// @Summary      Update user profile
+// @Description  Updates the given profile fields; omitted fields are left unchanged. Timezone is an IANA name, locale a BCP 47 tag. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Accept       json
+// @Param        user_id path string true "User ID"
+// @Param        request body UpdateProfileInput true "Update profile payload"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/profile [patch]
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UpdateProfile: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var in UpdateProfileInput
+	if err = httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UpdateProfile: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	req := user.UpdateProfileReq{
+		UserID:      id,
+		DisplayName: in.DisplayName,
+		Bio:         in.Bio,
+		Timezone:    in.Timezone,
+		Locale:      in.Locale,
+	}
+
+	if err = h.svc.UpdateProfile(ctx, req); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UploadAvatar godoc
+// @Summary      Upload user avatar
+// @Description  Replaces the user's avatar with the raw request body. PNG, JPEG, GIF and WebP images are accepted. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Accept       image/png,image/jpeg,image/gif,image/webp
+// @Param        user_id path string true "User ID"
+// @Param        request body []byte true "Image"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/avatar [put]
+func (h *Handler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UploadAvatar: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UploadAvatar: failed to read request body")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.UploadAvatar(ctx, id, data); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetAvatar godoc
+// @Summary      Get user avatar
+// @Description  Returns the user's avatar image. Available to any authenticated user.
+// @Tags         users
+// @Security     BearerAuth
+// @Produce      image/png,image/jpeg,image/gif,image/webp
+// @Param        user_id path string true "User ID"
+// @Success      200 {file} binary
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/avatar [get]
+func (h *Handler) GetAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.GetAvatar: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	avatar, content, err := h.svc.GetAvatar(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", avatar.ContentType)
+	w.Header().Set("Cache-Control", avatarCacheControl)
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, content); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.GetAvatar: failed to write response")
+	}
+}
+
+// DeleteAvatar godoc
+// @Summary      Delete user avatar
+// @Description  Removes the user's avatar. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Param        user_id path string true "User ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/avatar [delete]
+func (h *Handler) DeleteAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.DeleteAvatar: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.DeleteAvatar(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPreferences godoc
+// @Summary      Get user preferences
+// @Description  Returns the user's preferences in the current schema version, or the defaults if none were saved. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Produce      json
+// @Param        user_id path string true "User ID"
+// @Success      200 {object} user.Preferences
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/preferences [get]
+func (h *Handler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.GetPreferences: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	prefs, err := h.svc.GetPreferences(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, prefs)
+}
+
+// UpdatePreferences godoc
+// @Summary      Replace user preferences
+// @Description  Replaces the user's preferences. Missing fields take their defaults, unknown fields are rejected. Documents of older schema versions are upgraded. Requires admin role or self.
+// @Tags         users
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        user_id path string true "User ID"
+// @Param        request body user.Preferences true "Preferences"
+// @Success      200 {object} user.Preferences
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /users/{user_id}/preferences [put]
+func (h *Handler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamUserID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UpdatePreferences: invalid user ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var in json.RawMessage
+	if err = httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), idStr).
+			Msg("user.Handler.UpdatePreferences: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	prefs, err := h.svc.UpdatePreferences(ctx, id, in)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, prefs)
+}
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/app/user"
 	user_http "github.com/66gu1/easygodocs/internal/app/user/transport/http"
@@ -93,6 +96,68 @@ func TestHandler_CreateUser(t *testing.T) {
 	}
 }
 
+func TestHandler_AdminCreateUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id  = uuid.New()
+		cmd = user.CreateUserReq{Email: "ann@example.com", Name: "Ann", Password: []byte("password")}
+	)
+	body := []byte(`{"email":"ann@example.com","name":"Ann","password":"password"}`)
+
+	tests := []struct {
+		name       string
+		body       []byte
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"` + id.String() + `"}`,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AdminCreateUserMock.Expect(minimock.AnyContext, cmd).Return(id, nil)
+			},
+		},
+		{
+			name:       "invalid json -> 400",
+			body:       []byte("{"),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "email in use -> 409",
+			body:       body,
+			wantStatus: http.StatusConflict,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AdminCreateUserMock.Return(uuid.Nil, user.ErrUserWithEmailAlreadyExists())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svcMock := mocks.NewServiceMock(t)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+			h := user_http.NewHandler(svcMock)
+
+			r := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.AdminCreateUser(w, r)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				require.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
 func TestHandler_GetUser(t *testing.T) {
 	t.Parallel()
 
@@ -180,21 +245,51 @@ func TestHandler_GetUsers(t *testing.T) {
 
 	tests := []struct {
 		name       string
+		query      string
 		setup      func(mock *mocks.ServiceMock)
 		wantStatus int
+		wantCursor string
 	}{
 		{
 			name:       "valid",
 			wantStatus: http.StatusOK,
 			setup: func(mock *mocks.ServiceMock) {
-				mock.GetAllUsersMock.Expect(minimock.AnyContext).Return(users, nil)
+				mock.GetAllUsersMock.Expect(minimock.AnyContext, user.ListUsersOptions{}).Return(user.UsersPage{Users: users}, nil)
+			},
+		},
+		{
+			name:       "include deleted",
+			query:      "?include_deleted=true",
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetAllUsersMock.Expect(minimock.AnyContext, user.ListUsersOptions{IncludeDeleted: true}).Return(user.UsersPage{Users: users}, nil)
+			},
+		},
+		{
+			name:       "page",
+			query:      "?limit=1&after=cursor",
+			wantStatus: http.StatusOK,
+			wantCursor: "next",
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetAllUsersMock.Expect(minimock.AnyContext, user.ListUsersOptions{Limit: 1, After: "cursor"}).
+					Return(user.UsersPage{Users: users, NextCursor: "next"}, nil)
 			},
 		},
+		{
+			name:       "invalid limit -> 400",
+			query:      "?limit=ten",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid include_deleted -> 400",
+			query:      "?include_deleted=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "usecase error -> 500",
 			wantStatus: http.StatusInternalServerError,
 			setup: func(mock *mocks.ServiceMock) {
-				mock.GetAllUsersMock.Expect(minimock.AnyContext).Return(nil, fmt.Errorf("error"))
+				mock.GetAllUsersMock.Expect(minimock.AnyContext, user.ListUsersOptions{}).Return(user.UsersPage{}, fmt.Errorf("error"))
 			},
 		},
 	}
@@ -214,7 +309,7 @@ func TestHandler_GetUsers(t *testing.T) {
 
 			r.Get("/users", h.GetAllUsers)
 
-			req := httptest.NewRequest(http.MethodGet, "/users", http.NoBody)
+			req := httptest.NewRequest(http.MethodGet, "/users"+tt.query, http.NoBody)
 			req.Header.Set("Content-Type", "application/json")
 
 			rr := httptest.NewRecorder()
@@ -227,6 +322,7 @@ func TestHandler_GetUsers(t *testing.T) {
 				err := json.NewDecoder(rr.Body).Decode(&got)
 				require.NoError(t, err)
 				require.Equal(t, users, got)
+				require.Equal(t, tt.wantCursor, rr.Header().Get(user_http.HeaderNextCursor))
 			}
 		})
 	}
@@ -375,6 +471,66 @@ func TestHandler_DeleteUser(t *testing.T) {
 	}
 }
 
+func TestHandler_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AnonymizeUserMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error -> 500",
+			userID:     id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.AnonymizeUserMock.Expect(minimock.AnyContext, id).Return(fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+
+			r.Post("/admin/users/{"+user_http.URLParamUserID+"}/anonymize", h.AnonymizeUser)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/"+tt.userID+"/anonymize", http.NoBody)
+
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
 func TestHandler_ChangePassword(t *testing.T) {
 	t.Parallel()
 
@@ -456,3 +612,409 @@ func TestHandler_ChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id  = uuid.New()
+		tz  = "Europe/Berlin"
+		bio = ""
+		req = user.UpdateProfileReq{UserID: id, Timezone: &tz, Bio: &bio}
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			body:       `{"timezone":"Europe/Berlin","bio":""}`,
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdateProfileMock.Expect(minimock.AnyContext, req).Return(nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid json -> 400",
+			userID:     id.String(),
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error -> 500",
+			userID:     id.String(),
+			body:       `{"timezone":"Europe/Berlin","bio":""}`,
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdateProfileMock.Expect(minimock.AnyContext, req).Return(fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Patch("/users/{"+user_http.URLParamUserID+"}/profile", h.UpdateProfile)
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/"+tt.userID+"/profile", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_UploadAvatar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id   = uuid.New()
+		data = []byte("image")
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UploadAvatarMock.Expect(minimock.AnyContext, id, data).Return(nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error -> 500",
+			userID:     id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UploadAvatarMock.Expect(minimock.AnyContext, id, data).Return(fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Put("/users/{"+user_http.URLParamUserID+"}/avatar", h.UploadAvatar)
+
+			req := httptest.NewRequest(http.MethodPut, "/users/"+tt.userID+"/avatar", bytes.NewReader(data))
+			req.Header.Set("Content-Type", "image/png")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_GetAvatar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = uuid.New()
+		avatar = user.Avatar{ContentType: "image/png", UpdatedAt: time.Now()}
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetAvatarMock.Expect(minimock.AnyContext, id).
+					Return(avatar, io.NopCloser(strings.NewReader("image")), nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not found -> 404",
+			userID:     id.String(),
+			wantStatus: http.StatusNotFound,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetAvatarMock.Expect(minimock.AnyContext, id).Return(user.Avatar{}, nil, user.ErrAvatarNotFound())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Get("/users/{"+user_http.URLParamUserID+"}/avatar", h.GetAvatar)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID+"/avatar", http.NoBody)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				require.Equal(t, avatar.ContentType, rr.Header().Get("Content-Type"))
+				require.NotEmpty(t, rr.Header().Get("Cache-Control"))
+				require.Equal(t, "image", rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteAvatar(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			wantStatus: http.StatusNoContent,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DeleteAvatarMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error -> 500",
+			userID:     id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.DeleteAvatarMock.Expect(minimock.AnyContext, id).Return(fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Delete("/users/{"+user_http.URLParamUserID+"}/avatar", h.DeleteAvatar)
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/"+tt.userID+"/avatar", http.NoBody)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandler_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id    = uuid.New()
+		prefs = user.DefaultPreferences()
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetPreferencesMock.Expect(minimock.AnyContext, id).Return(prefs, nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "usecase error -> 500",
+			userID:     id.String(),
+			wantStatus: http.StatusInternalServerError,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.GetPreferencesMock.Expect(minimock.AnyContext, id).Return(user.Preferences{}, fmt.Errorf("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Get("/users/{"+user_http.URLParamUserID+"}/preferences", h.GetPreferences)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID+"/preferences", http.NoBody)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got user.Preferences
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				require.Equal(t, prefs, got)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id    = uuid.New()
+		body  = `{"theme":"dark"}`
+		prefs = user.DefaultPreferences()
+	)
+	prefs.Theme = user.ThemeDark
+
+	tests := []struct {
+		name       string
+		userID     string
+		body       string
+		setup      func(mock *mocks.ServiceMock)
+		wantStatus int
+	}{
+		{
+			name:       "valid",
+			userID:     id.String(),
+			body:       body,
+			wantStatus: http.StatusOK,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdatePreferencesMock.Expect(minimock.AnyContext, id, []byte(body)).Return(prefs, nil)
+			},
+		},
+		{
+			name:       "invalid uuid -> 400",
+			userID:     "id",
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid json -> 400",
+			userID:     id.String(),
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "validation error -> 400",
+			userID:     id.String(),
+			body:       body,
+			wantStatus: http.StatusBadRequest,
+			setup: func(mock *mocks.ServiceMock) {
+				mock.UpdatePreferencesMock.Expect(minimock.AnyContext, id, []byte(body)).Return(user.Preferences{}, user.ErrInvalidTheme())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			svcMock := mocks.NewServiceMock(mc)
+			if tt.setup != nil {
+				tt.setup(svcMock)
+			}
+
+			h := user_http.NewHandler(svcMock)
+			r := chi.NewRouter()
+			r.Put("/users/{"+user_http.URLParamUserID+"}/preferences", h.UpdatePreferences)
+
+			req := httptest.NewRequest(http.MethodPut, "/users/"+tt.userID+"/preferences", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus == http.StatusOK {
+				var got user.Preferences
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				require.Equal(t, prefs, got)
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -21,6 +22,20 @@ type ServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAdminCreateUser          func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)
+	funcAdminCreateUserOrigin    string
+	inspectFuncAdminCreateUser   func(ctx context.Context, req user.CreateUserReq)
+	afterAdminCreateUserCounter  uint64
+	beforeAdminCreateUserCounter uint64
+	AdminCreateUserMock          mServiceMockAdminCreateUser
+
+	funcAnonymizeUser          func(ctx context.Context, id uuid.UUID) (err error)
+	funcAnonymizeUserOrigin    string
+	inspectFuncAnonymizeUser   func(ctx context.Context, id uuid.UUID)
+	afterAnonymizeUserCounter  uint64
+	beforeAnonymizeUserCounter uint64
+	AnonymizeUserMock          mServiceMockAnonymizeUser
+
 	funcChangePassword          func(ctx context.Context, req usecase.ChangePasswordCmd) (err error)
 	funcChangePasswordOrigin    string
 	inspectFuncChangePassword   func(ctx context.Context, req usecase.ChangePasswordCmd)
@@ -35,6 +50,13 @@ type ServiceMock struct {
 	beforeCreateUserCounter uint64
 	CreateUserMock          mServiceMockCreateUser
 
+	funcDeleteAvatar          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcDeleteAvatarOrigin    string
+	inspectFuncDeleteAvatar   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteAvatarCounter  uint64
+	beforeDeleteAvatarCounter uint64
+	DeleteAvatarMock          mServiceMockDeleteAvatar
+
 	funcDeleteUser          func(ctx context.Context, id uuid.UUID) (err error)
 	funcDeleteUserOrigin    string
 	inspectFuncDeleteUser   func(ctx context.Context, id uuid.UUID)
@@ -42,13 +64,27 @@ type ServiceMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mServiceMockDeleteUser
 
-	funcGetAllUsers          func(ctx context.Context) (ua1 []user.User, err error)
+	funcGetAllUsers          func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)
 	funcGetAllUsersOrigin    string
-	inspectFuncGetAllUsers   func(ctx context.Context)
+	inspectFuncGetAllUsers   func(ctx context.Context, opts user.ListUsersOptions)
 	afterGetAllUsersCounter  uint64
 	beforeGetAllUsersCounter uint64
 	GetAllUsersMock          mServiceMockGetAllUsers
 
+	funcGetAvatar          func(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error)
+	funcGetAvatarOrigin    string
+	inspectFuncGetAvatar   func(ctx context.Context, userID uuid.UUID)
+	afterGetAvatarCounter  uint64
+	beforeGetAvatarCounter uint64
+	GetAvatarMock          mServiceMockGetAvatar
+
+	funcGetPreferences          func(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error)
+	funcGetPreferencesOrigin    string
+	inspectFuncGetPreferences   func(ctx context.Context, userID uuid.UUID)
+	afterGetPreferencesCounter  uint64
+	beforeGetPreferencesCounter uint64
+	GetPreferencesMock          mServiceMockGetPreferences
+
 	funcGetUser          func(ctx context.Context, id uuid.UUID) (u1 user.User, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id uuid.UUID)
@@ -56,12 +92,33 @@ type ServiceMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mServiceMockGetUser
 
+	funcUpdatePreferences          func(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error)
+	funcUpdatePreferencesOrigin    string
+	inspectFuncUpdatePreferences   func(ctx context.Context, userID uuid.UUID, data []byte)
+	afterUpdatePreferencesCounter  uint64
+	beforeUpdatePreferencesCounter uint64
+	UpdatePreferencesMock          mServiceMockUpdatePreferences
+
+	funcUpdateProfile          func(ctx context.Context, req user.UpdateProfileReq) (err error)
+	funcUpdateProfileOrigin    string
+	inspectFuncUpdateProfile   func(ctx context.Context, req user.UpdateProfileReq)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mServiceMockUpdateProfile
+
 	funcUpdateUser          func(ctx context.Context, req user.UpdateUserReq) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, req user.UpdateUserReq)
 	afterUpdateUserCounter  uint64
 	beforeUpdateUserCounter uint64
 	UpdateUserMock          mServiceMockUpdateUser
+
+	funcUploadAvatar          func(ctx context.Context, userID uuid.UUID, data []byte) (err error)
+	funcUploadAvatarOrigin    string
+	inspectFuncUploadAvatar   func(ctx context.Context, userID uuid.UUID, data []byte)
+	afterUploadAvatarCounter  uint64
+	beforeUploadAvatarCounter uint64
+	UploadAvatarMock          mServiceMockUploadAvatar
 }
 
 // NewServiceMock returns a mock for mm_http.Service
@@ -72,72 +129,97 @@ func NewServiceMock(t minimock.Tester) *ServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AdminCreateUserMock = mServiceMockAdminCreateUser{mock: m}
+	m.AdminCreateUserMock.callArgs = []*ServiceMockAdminCreateUserParams{}
+
+	m.AnonymizeUserMock = mServiceMockAnonymizeUser{mock: m}
+	m.AnonymizeUserMock.callArgs = []*ServiceMockAnonymizeUserParams{}
+
 	m.ChangePasswordMock = mServiceMockChangePassword{mock: m}
 	m.ChangePasswordMock.callArgs = []*ServiceMockChangePasswordParams{}
 
 	m.CreateUserMock = mServiceMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*ServiceMockCreateUserParams{}
 
+	m.DeleteAvatarMock = mServiceMockDeleteAvatar{mock: m}
+	m.DeleteAvatarMock.callArgs = []*ServiceMockDeleteAvatarParams{}
+
 	m.DeleteUserMock = mServiceMockDeleteUser{mock: m}
 	m.DeleteUserMock.callArgs = []*ServiceMockDeleteUserParams{}
 
 	m.GetAllUsersMock = mServiceMockGetAllUsers{mock: m}
 	m.GetAllUsersMock.callArgs = []*ServiceMockGetAllUsersParams{}
 
+	m.GetAvatarMock = mServiceMockGetAvatar{mock: m}
+	m.GetAvatarMock.callArgs = []*ServiceMockGetAvatarParams{}
+
+	m.GetPreferencesMock = mServiceMockGetPreferences{mock: m}
+	m.GetPreferencesMock.callArgs = []*ServiceMockGetPreferencesParams{}
+
 	m.GetUserMock = mServiceMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*ServiceMockGetUserParams{}
 
+	m.UpdatePreferencesMock = mServiceMockUpdatePreferences{mock: m}
+	m.UpdatePreferencesMock.callArgs = []*ServiceMockUpdatePreferencesParams{}
+
+	m.UpdateProfileMock = mServiceMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*ServiceMockUpdateProfileParams{}
+
 	m.UpdateUserMock = mServiceMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*ServiceMockUpdateUserParams{}
 
+	m.UploadAvatarMock = mServiceMockUploadAvatar{mock: m}
+	m.UploadAvatarMock.callArgs = []*ServiceMockUploadAvatarParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mServiceMockChangePassword struct {
+type mServiceMockAdminCreateUser struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockChangePasswordExpectation
-	expectations       []*ServiceMockChangePasswordExpectation
+	defaultExpectation *ServiceMockAdminCreateUserExpectation
+	expectations       []*ServiceMockAdminCreateUserExpectation
 
-	callArgs []*ServiceMockChangePasswordParams
+	callArgs []*ServiceMockAdminCreateUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockChangePasswordExpectation specifies expectation struct of the Service.ChangePassword
-type ServiceMockChangePasswordExpectation struct {
+// ServiceMockAdminCreateUserExpectation specifies expectation struct of the Service.AdminCreateUser
+type ServiceMockAdminCreateUserExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockChangePasswordParams
-	paramPtrs          *ServiceMockChangePasswordParamPtrs
-	expectationOrigins ServiceMockChangePasswordExpectationOrigins
-	results            *ServiceMockChangePasswordResults
+	params             *ServiceMockAdminCreateUserParams
+	paramPtrs          *ServiceMockAdminCreateUserParamPtrs
+	expectationOrigins ServiceMockAdminCreateUserExpectationOrigins
+	results            *ServiceMockAdminCreateUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockChangePasswordParams contains parameters of the Service.ChangePassword
-type ServiceMockChangePasswordParams struct {
+// ServiceMockAdminCreateUserParams contains parameters of the Service.AdminCreateUser
+type ServiceMockAdminCreateUserParams struct {
 	ctx context.Context
-	req usecase.ChangePasswordCmd
+	req user.CreateUserReq
 }
 
-// ServiceMockChangePasswordParamPtrs contains pointers to parameters of the Service.ChangePassword
-type ServiceMockChangePasswordParamPtrs struct {
+// ServiceMockAdminCreateUserParamPtrs contains pointers to parameters of the Service.AdminCreateUser
+type ServiceMockAdminCreateUserParamPtrs struct {
 	ctx *context.Context
-	req *usecase.ChangePasswordCmd
+	req *user.CreateUserReq
 }
 
-// ServiceMockChangePasswordResults contains results of the Service.ChangePassword
-type ServiceMockChangePasswordResults struct {
+// ServiceMockAdminCreateUserResults contains results of the Service.AdminCreateUser
+type ServiceMockAdminCreateUserResults struct {
+	u1  uuid.UUID
 	err error
 }
 
-// ServiceMockChangePasswordOrigins contains origins of expectations of the Service.ChangePassword
-type ServiceMockChangePasswordExpectationOrigins struct {
+// ServiceMockAdminCreateUserOrigins contains origins of expectations of the Service.AdminCreateUser
+type ServiceMockAdminCreateUserExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originReq string
@@ -148,341 +230,341 @@ type ServiceMockChangePasswordExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmChangePassword *mServiceMockChangePassword) Optional() *mServiceMockChangePassword {
-	mmChangePassword.optional = true
-	return mmChangePassword
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Optional() *mServiceMockAdminCreateUser {
+	mmAdminCreateUser.optional = true
+	return mmAdminCreateUser
 }
 
-// Expect sets up expected params for Service.ChangePassword
-func (mmChangePassword *mServiceMockChangePassword) Expect(ctx context.Context, req usecase.ChangePasswordCmd) *mServiceMockChangePassword {
-	if mmChangePassword.mock.funcChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
+// Expect sets up expected params for Service.AdminCreateUser
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Expect(ctx context.Context, req user.CreateUserReq) *mServiceMockAdminCreateUser {
+	if mmAdminCreateUser.mock.funcAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Set")
 	}
 
-	if mmChangePassword.defaultExpectation == nil {
-		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
+	if mmAdminCreateUser.defaultExpectation == nil {
+		mmAdminCreateUser.defaultExpectation = &ServiceMockAdminCreateUserExpectation{}
 	}
 
-	if mmChangePassword.defaultExpectation.paramPtrs != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by ExpectParams functions")
+	if mmAdminCreateUser.defaultExpectation.paramPtrs != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by ExpectParams functions")
 	}
 
-	mmChangePassword.defaultExpectation.params = &ServiceMockChangePasswordParams{ctx, req}
-	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmChangePassword.expectations {
-		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
-			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
+	mmAdminCreateUser.defaultExpectation.params = &ServiceMockAdminCreateUserParams{ctx, req}
+	mmAdminCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdminCreateUser.expectations {
+		if minimock.Equal(e.params, mmAdminCreateUser.defaultExpectation.params) {
+			mmAdminCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdminCreateUser.defaultExpectation.params)
 		}
 	}
 
-	return mmChangePassword
+	return mmAdminCreateUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.ChangePassword
-func (mmChangePassword *mServiceMockChangePassword) ExpectCtxParam1(ctx context.Context) *mServiceMockChangePassword {
-	if mmChangePassword.mock.funcChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.AdminCreateUser
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) ExpectCtxParam1(ctx context.Context) *mServiceMockAdminCreateUser {
+	if mmAdminCreateUser.mock.funcAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Set")
 	}
 
-	if mmChangePassword.defaultExpectation == nil {
-		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
+	if mmAdminCreateUser.defaultExpectation == nil {
+		mmAdminCreateUser.defaultExpectation = &ServiceMockAdminCreateUserExpectation{}
 	}
 
-	if mmChangePassword.defaultExpectation.params != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Expect")
+	if mmAdminCreateUser.defaultExpectation.params != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Expect")
 	}
 
-	if mmChangePassword.defaultExpectation.paramPtrs == nil {
-		mmChangePassword.defaultExpectation.paramPtrs = &ServiceMockChangePasswordParamPtrs{}
+	if mmAdminCreateUser.defaultExpectation.paramPtrs == nil {
+		mmAdminCreateUser.defaultExpectation.paramPtrs = &ServiceMockAdminCreateUserParamPtrs{}
 	}
-	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
-	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAdminCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdminCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmChangePassword
+	return mmAdminCreateUser
 }
 
-// ExpectReqParam2 sets up expected param req for Service.ChangePassword
-func (mmChangePassword *mServiceMockChangePassword) ExpectReqParam2(req usecase.ChangePasswordCmd) *mServiceMockChangePassword {
-	if mmChangePassword.mock.funcChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Service.AdminCreateUser
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) ExpectReqParam2(req user.CreateUserReq) *mServiceMockAdminCreateUser {
+	if mmAdminCreateUser.mock.funcAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Set")
 	}
 
-	if mmChangePassword.defaultExpectation == nil {
-		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
+	if mmAdminCreateUser.defaultExpectation == nil {
+		mmAdminCreateUser.defaultExpectation = &ServiceMockAdminCreateUserExpectation{}
 	}
 
-	if mmChangePassword.defaultExpectation.params != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Expect")
+	if mmAdminCreateUser.defaultExpectation.params != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Expect")
 	}
 
-	if mmChangePassword.defaultExpectation.paramPtrs == nil {
-		mmChangePassword.defaultExpectation.paramPtrs = &ServiceMockChangePasswordParamPtrs{}
+	if mmAdminCreateUser.defaultExpectation.paramPtrs == nil {
+		mmAdminCreateUser.defaultExpectation.paramPtrs = &ServiceMockAdminCreateUserParamPtrs{}
 	}
-	mmChangePassword.defaultExpectation.paramPtrs.req = &req
-	mmChangePassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmAdminCreateUser.defaultExpectation.paramPtrs.req = &req
+	mmAdminCreateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmChangePassword
+	return mmAdminCreateUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.ChangePassword
-func (mmChangePassword *mServiceMockChangePassword) Inspect(f func(ctx context.Context, req usecase.ChangePasswordCmd)) *mServiceMockChangePassword {
-	if mmChangePassword.mock.inspectFuncChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("Inspect function is already set for ServiceMock.ChangePassword")
+// Inspect accepts an inspector function that has same arguments as the Service.AdminCreateUser
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Inspect(f func(ctx context.Context, req user.CreateUserReq)) *mServiceMockAdminCreateUser {
+	if mmAdminCreateUser.mock.inspectFuncAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.AdminCreateUser")
 	}
 
-	mmChangePassword.mock.inspectFuncChangePassword = f
+	mmAdminCreateUser.mock.inspectFuncAdminCreateUser = f
 
-	return mmChangePassword
+	return mmAdminCreateUser
 }
 
-// Return sets up results that will be returned by Service.ChangePassword
-func (mmChangePassword *mServiceMockChangePassword) Return(err error) *ServiceMock {
-	if mmChangePassword.mock.funcChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
+// Return sets up results that will be returned by Service.AdminCreateUser
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Return(u1 uuid.UUID, err error) *ServiceMock {
+	if mmAdminCreateUser.mock.funcAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Set")
 	}
 
-	if mmChangePassword.defaultExpectation == nil {
-		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{mock: mmChangePassword.mock}
+	if mmAdminCreateUser.defaultExpectation == nil {
+		mmAdminCreateUser.defaultExpectation = &ServiceMockAdminCreateUserExpectation{mock: mmAdminCreateUser.mock}
 	}
-	mmChangePassword.defaultExpectation.results = &ServiceMockChangePasswordResults{err}
-	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmChangePassword.mock
+	mmAdminCreateUser.defaultExpectation.results = &ServiceMockAdminCreateUserResults{u1, err}
+	mmAdminCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdminCreateUser.mock
 }
 
-// Set uses given function f to mock the Service.ChangePassword method
-func (mmChangePassword *mServiceMockChangePassword) Set(f func(ctx context.Context, req usecase.ChangePasswordCmd) (err error)) *ServiceMock {
-	if mmChangePassword.defaultExpectation != nil {
-		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the Service.ChangePassword method")
+// Set uses given function f to mock the Service.AdminCreateUser method
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Set(f func(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error)) *ServiceMock {
+	if mmAdminCreateUser.defaultExpectation != nil {
+		mmAdminCreateUser.mock.t.Fatalf("Default expectation is already set for the Service.AdminCreateUser method")
 	}
 
-	if len(mmChangePassword.expectations) > 0 {
-		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the Service.ChangePassword method")
+	if len(mmAdminCreateUser.expectations) > 0 {
+		mmAdminCreateUser.mock.t.Fatalf("Some expectations are already set for the Service.AdminCreateUser method")
 	}
 
-	mmChangePassword.mock.funcChangePassword = f
-	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
-	return mmChangePassword.mock
+	mmAdminCreateUser.mock.funcAdminCreateUser = f
+	mmAdminCreateUser.mock.funcAdminCreateUserOrigin = minimock.CallerInfo(1)
+	return mmAdminCreateUser.mock
 }
 
-// When sets expectation for the Service.ChangePassword which will trigger the result defined by the following
+// When sets expectation for the Service.AdminCreateUser which will trigger the result defined by the following
 // Then helper
-func (mmChangePassword *mServiceMockChangePassword) When(ctx context.Context, req usecase.ChangePasswordCmd) *ServiceMockChangePasswordExpectation {
-	if mmChangePassword.mock.funcChangePassword != nil {
-		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) When(ctx context.Context, req user.CreateUserReq) *ServiceMockAdminCreateUserExpectation {
+	if mmAdminCreateUser.mock.funcAdminCreateUser != nil {
+		mmAdminCreateUser.mock.t.Fatalf("ServiceMock.AdminCreateUser mock is already set by Set")
 	}
 
-	expectation := &ServiceMockChangePasswordExpectation{
-		mock:               mmChangePassword.mock,
-		params:             &ServiceMockChangePasswordParams{ctx, req},
-		expectationOrigins: ServiceMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockAdminCreateUserExpectation{
+		mock:               mmAdminCreateUser.mock,
+		params:             &ServiceMockAdminCreateUserParams{ctx, req},
+		expectationOrigins: ServiceMockAdminCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
+	mmAdminCreateUser.expectations = append(mmAdminCreateUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.ChangePassword return parameters for the expectation previously defined by the When method
-func (e *ServiceMockChangePasswordExpectation) Then(err error) *ServiceMock {
-	e.results = &ServiceMockChangePasswordResults{err}
+// Then sets up Service.AdminCreateUser return parameters for the expectation previously defined by the When method
+func (e *ServiceMockAdminCreateUserExpectation) Then(u1 uuid.UUID, err error) *ServiceMock {
+	e.results = &ServiceMockAdminCreateUserResults{u1, err}
 	return e.mock
 }
 
-// Times sets number of times Service.ChangePassword should be invoked
-func (mmChangePassword *mServiceMockChangePassword) Times(n uint64) *mServiceMockChangePassword {
+// Times sets number of times Service.AdminCreateUser should be invoked
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Times(n uint64) *mServiceMockAdminCreateUser {
 	if n == 0 {
-		mmChangePassword.mock.t.Fatalf("Times of ServiceMock.ChangePassword mock can not be zero")
+		mmAdminCreateUser.mock.t.Fatalf("Times of ServiceMock.AdminCreateUser mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
-	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmChangePassword
+	mm_atomic.StoreUint64(&mmAdminCreateUser.expectedInvocations, n)
+	mmAdminCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdminCreateUser
 }
 
-func (mmChangePassword *mServiceMockChangePassword) invocationsDone() bool {
-	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) invocationsDone() bool {
+	if len(mmAdminCreateUser.expectations) == 0 && mmAdminCreateUser.defaultExpectation == nil && mmAdminCreateUser.mock.funcAdminCreateUser == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAdminCreateUser.mock.afterAdminCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdminCreateUser.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ChangePassword implements mm_http.Service
-func (mmChangePassword *ServiceMock) ChangePassword(ctx context.Context, req usecase.ChangePasswordCmd) (err error) {
-	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
-	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
+// AdminCreateUser implements mm_http.Service
+func (mmAdminCreateUser *ServiceMock) AdminCreateUser(ctx context.Context, req user.CreateUserReq) (u1 uuid.UUID, err error) {
+	mm_atomic.AddUint64(&mmAdminCreateUser.beforeAdminCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdminCreateUser.afterAdminCreateUserCounter, 1)
 
-	mmChangePassword.t.Helper()
+	mmAdminCreateUser.t.Helper()
 
-	if mmChangePassword.inspectFuncChangePassword != nil {
-		mmChangePassword.inspectFuncChangePassword(ctx, req)
+	if mmAdminCreateUser.inspectFuncAdminCreateUser != nil {
+		mmAdminCreateUser.inspectFuncAdminCreateUser(ctx, req)
 	}
 
-	mm_params := ServiceMockChangePasswordParams{ctx, req}
+	mm_params := ServiceMockAdminCreateUserParams{ctx, req}
 
 	// Record call args
-	mmChangePassword.ChangePasswordMock.mutex.Lock()
-	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
-	mmChangePassword.ChangePasswordMock.mutex.Unlock()
+	mmAdminCreateUser.AdminCreateUserMock.mutex.Lock()
+	mmAdminCreateUser.AdminCreateUserMock.callArgs = append(mmAdminCreateUser.AdminCreateUserMock.callArgs, &mm_params)
+	mmAdminCreateUser.AdminCreateUserMock.mutex.Unlock()
 
-	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
+	for _, e := range mmAdminCreateUser.AdminCreateUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
-	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
-		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
-		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
+	if mmAdminCreateUser.AdminCreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockChangePasswordParams{ctx, req}
+		mm_got := ServiceMockAdminCreateUserParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAdminCreateUser.t.Errorf("ServiceMock.AdminCreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmAdminCreateUser.t.Errorf("ServiceMock.AdminCreateUser got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAdminCreateUser.t.Errorf("ServiceMock.AdminCreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
+		mm_results := mmAdminCreateUser.AdminCreateUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmChangePassword.t.Fatal("No results are set for the ServiceMock.ChangePassword")
+			mmAdminCreateUser.t.Fatal("No results are set for the ServiceMock.AdminCreateUser")
 		}
-		return (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmChangePassword.funcChangePassword != nil {
-		return mmChangePassword.funcChangePassword(ctx, req)
+	if mmAdminCreateUser.funcAdminCreateUser != nil {
+		return mmAdminCreateUser.funcAdminCreateUser(ctx, req)
 	}
-	mmChangePassword.t.Fatalf("Unexpected call to ServiceMock.ChangePassword. %v %v", ctx, req)
+	mmAdminCreateUser.t.Fatalf("Unexpected call to ServiceMock.AdminCreateUser. %v %v", ctx, req)
 	return
 }
 
-// ChangePasswordAfterCounter returns a count of finished ServiceMock.ChangePassword invocations
-func (mmChangePassword *ServiceMock) ChangePasswordAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
+// AdminCreateUserAfterCounter returns a count of finished ServiceMock.AdminCreateUser invocations
+func (mmAdminCreateUser *ServiceMock) AdminCreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdminCreateUser.afterAdminCreateUserCounter)
 }
 
-// ChangePasswordBeforeCounter returns a count of ServiceMock.ChangePassword invocations
-func (mmChangePassword *ServiceMock) ChangePasswordBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
+// AdminCreateUserBeforeCounter returns a count of ServiceMock.AdminCreateUser invocations
+func (mmAdminCreateUser *ServiceMock) AdminCreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdminCreateUser.beforeAdminCreateUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.ChangePassword.
+// Calls returns a list of arguments used in each call to ServiceMock.AdminCreateUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmChangePassword *mServiceMockChangePassword) Calls() []*ServiceMockChangePasswordParams {
-	mmChangePassword.mutex.RLock()
+func (mmAdminCreateUser *mServiceMockAdminCreateUser) Calls() []*ServiceMockAdminCreateUserParams {
+	mmAdminCreateUser.mutex.RLock()
 
-	argCopy := make([]*ServiceMockChangePasswordParams, len(mmChangePassword.callArgs))
-	copy(argCopy, mmChangePassword.callArgs)
+	argCopy := make([]*ServiceMockAdminCreateUserParams, len(mmAdminCreateUser.callArgs))
+	copy(argCopy, mmAdminCreateUser.callArgs)
 
-	mmChangePassword.mutex.RUnlock()
+	mmAdminCreateUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
+// MinimockAdminCreateUserDone returns true if the count of the AdminCreateUser invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockChangePasswordDone() bool {
-	if m.ChangePasswordMock.optional {
+func (m *ServiceMock) MinimockAdminCreateUserDone() bool {
+	if m.AdminCreateUserMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ChangePasswordMock.expectations {
+	for _, e := range m.AdminCreateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ChangePasswordMock.invocationsDone()
+	return m.AdminCreateUserMock.invocationsDone()
 }
 
-// MinimockChangePasswordInspect logs each unmet expectation
-func (m *ServiceMock) MinimockChangePasswordInspect() {
-	for _, e := range m.ChangePasswordMock.expectations {
+// MinimockAdminCreateUserInspect logs each unmet expectation
+func (m *ServiceMock) MinimockAdminCreateUserInspect() {
+	for _, e := range m.AdminCreateUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.AdminCreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
+	afterAdminCreateUserCounter := mm_atomic.LoadUint64(&m.afterAdminCreateUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
-		if m.ChangePasswordMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
+	if m.AdminCreateUserMock.defaultExpectation != nil && afterAdminCreateUserCounter < 1 {
+		if m.AdminCreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.AdminCreateUser at\n%s", m.AdminCreateUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.AdminCreateUser at\n%s with params: %#v", m.AdminCreateUserMock.defaultExpectation.expectationOrigins.origin, *m.AdminCreateUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
+	if m.funcAdminCreateUser != nil && afterAdminCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.AdminCreateUser at\n%s", m.funcAdminCreateUserOrigin)
 	}
 
-	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.ChangePassword at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
+	if !m.AdminCreateUserMock.invocationsDone() && afterAdminCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.AdminCreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdminCreateUserMock.expectedInvocations), m.AdminCreateUserMock.expectedInvocationsOrigin, afterAdminCreateUserCounter)
 	}
 }
 
-type mServiceMockCreateUser struct {
+type mServiceMockAnonymizeUser struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockCreateUserExpectation
-	expectations       []*ServiceMockCreateUserExpectation
+	defaultExpectation *ServiceMockAnonymizeUserExpectation
+	expectations       []*ServiceMockAnonymizeUserExpectation
 
-	callArgs []*ServiceMockCreateUserParams
+	callArgs []*ServiceMockAnonymizeUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockCreateUserExpectation specifies expectation struct of the Service.CreateUser
-type ServiceMockCreateUserExpectation struct {
+// ServiceMockAnonymizeUserExpectation specifies expectation struct of the Service.AnonymizeUser
+type ServiceMockAnonymizeUserExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockCreateUserParams
-	paramPtrs          *ServiceMockCreateUserParamPtrs
-	expectationOrigins ServiceMockCreateUserExpectationOrigins
-	results            *ServiceMockCreateUserResults
+	params             *ServiceMockAnonymizeUserParams
+	paramPtrs          *ServiceMockAnonymizeUserParamPtrs
+	expectationOrigins ServiceMockAnonymizeUserExpectationOrigins
+	results            *ServiceMockAnonymizeUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockCreateUserParams contains parameters of the Service.CreateUser
-type ServiceMockCreateUserParams struct {
+// ServiceMockAnonymizeUserParams contains parameters of the Service.AnonymizeUser
+type ServiceMockAnonymizeUserParams struct {
 	ctx context.Context
-	req user.CreateUserReq
+	id  uuid.UUID
 }
 
-// ServiceMockCreateUserParamPtrs contains pointers to parameters of the Service.CreateUser
-type ServiceMockCreateUserParamPtrs struct {
+// ServiceMockAnonymizeUserParamPtrs contains pointers to parameters of the Service.AnonymizeUser
+type ServiceMockAnonymizeUserParamPtrs struct {
 	ctx *context.Context
-	req *user.CreateUserReq
+	id  *uuid.UUID
 }
 
-// ServiceMockCreateUserResults contains results of the Service.CreateUser
-type ServiceMockCreateUserResults struct {
+// ServiceMockAnonymizeUserResults contains results of the Service.AnonymizeUser
+type ServiceMockAnonymizeUserResults struct {
 	err error
 }
 
-// ServiceMockCreateUserOrigins contains origins of expectations of the Service.CreateUser
-type ServiceMockCreateUserExpectationOrigins struct {
+// ServiceMockAnonymizeUserOrigins contains origins of expectations of the Service.AnonymizeUser
+type ServiceMockAnonymizeUserExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originReq string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -490,341 +572,341 @@ type ServiceMockCreateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateUser *mServiceMockCreateUser) Optional() *mServiceMockCreateUser {
-	mmCreateUser.optional = true
-	return mmCreateUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Optional() *mServiceMockAnonymizeUser {
+	mmAnonymizeUser.optional = true
+	return mmAnonymizeUser
 }
 
-// Expect sets up expected params for Service.CreateUser
-func (mmCreateUser *mServiceMockCreateUser) Expect(ctx context.Context, req user.CreateUserReq) *mServiceMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+// Expect sets up expected params for Service.AnonymizeUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Expect(ctx context.Context, id uuid.UUID) *mServiceMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &ServiceMockAnonymizeUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by ExpectParams functions")
+	if mmAnonymizeUser.defaultExpectation.paramPtrs != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by ExpectParams functions")
 	}
 
-	mmCreateUser.defaultExpectation.params = &ServiceMockCreateUserParams{ctx, req}
-	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateUser.expectations {
-		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
-			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+	mmAnonymizeUser.defaultExpectation.params = &ServiceMockAnonymizeUserParams{ctx, id}
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAnonymizeUser.expectations {
+		if minimock.Equal(e.params, mmAnonymizeUser.defaultExpectation.params) {
+			mmAnonymizeUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAnonymizeUser.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateUser
+	return mmAnonymizeUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.CreateUser
-func (mmCreateUser *mServiceMockCreateUser) ExpectCtxParam1(ctx context.Context) *mServiceMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.AnonymizeUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) ExpectCtxParam1(ctx context.Context) *mServiceMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &ServiceMockAnonymizeUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Expect")
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &ServiceMockCreateUserParamPtrs{}
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &ServiceMockAnonymizeUserParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAnonymizeUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmAnonymizeUser
 }
 
-// ExpectReqParam2 sets up expected param req for Service.CreateUser
-func (mmCreateUser *mServiceMockCreateUser) ExpectReqParam2(req user.CreateUserReq) *mServiceMockCreateUser {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Service.AnonymizeUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) ExpectIdParam2(id uuid.UUID) *mServiceMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &ServiceMockAnonymizeUserExpectation{}
 	}
 
-	if mmCreateUser.defaultExpectation.params != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Expect")
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Expect")
 	}
 
-	if mmCreateUser.defaultExpectation.paramPtrs == nil {
-		mmCreateUser.defaultExpectation.paramPtrs = &ServiceMockCreateUserParamPtrs{}
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &ServiceMockAnonymizeUserParamPtrs{}
 	}
-	mmCreateUser.defaultExpectation.paramPtrs.req = &req
-	mmCreateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmAnonymizeUser.defaultExpectation.paramPtrs.id = &id
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCreateUser
+	return mmAnonymizeUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.CreateUser
-func (mmCreateUser *mServiceMockCreateUser) Inspect(f func(ctx context.Context, req user.CreateUserReq)) *mServiceMockCreateUser {
-	if mmCreateUser.mock.inspectFuncCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.CreateUser")
+// Inspect accepts an inspector function that has same arguments as the Service.AnonymizeUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockAnonymizeUser {
+	if mmAnonymizeUser.mock.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.AnonymizeUser")
 	}
 
-	mmCreateUser.mock.inspectFuncCreateUser = f
+	mmAnonymizeUser.mock.inspectFuncAnonymizeUser = f
 
-	return mmCreateUser
+	return mmAnonymizeUser
 }
 
-// Return sets up results that will be returned by Service.CreateUser
-func (mmCreateUser *mServiceMockCreateUser) Return(err error) *ServiceMock {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+// Return sets up results that will be returned by Service.AnonymizeUser
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Return(err error) *ServiceMock {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Set")
 	}
 
-	if mmCreateUser.defaultExpectation == nil {
-		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{mock: mmCreateUser.mock}
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &ServiceMockAnonymizeUserExpectation{mock: mmAnonymizeUser.mock}
 	}
-	mmCreateUser.defaultExpectation.results = &ServiceMockCreateUserResults{err}
-	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmAnonymizeUser.defaultExpectation.results = &ServiceMockAnonymizeUserResults{err}
+	mmAnonymizeUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
 }
 
-// Set uses given function f to mock the Service.CreateUser method
-func (mmCreateUser *mServiceMockCreateUser) Set(f func(ctx context.Context, req user.CreateUserReq) (err error)) *ServiceMock {
-	if mmCreateUser.defaultExpectation != nil {
-		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the Service.CreateUser method")
+// Set uses given function f to mock the Service.AnonymizeUser method
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *ServiceMock {
+	if mmAnonymizeUser.defaultExpectation != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Default expectation is already set for the Service.AnonymizeUser method")
 	}
 
-	if len(mmCreateUser.expectations) > 0 {
-		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the Service.CreateUser method")
+	if len(mmAnonymizeUser.expectations) > 0 {
+		mmAnonymizeUser.mock.t.Fatalf("Some expectations are already set for the Service.AnonymizeUser method")
 	}
 
-	mmCreateUser.mock.funcCreateUser = f
-	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
-	return mmCreateUser.mock
+	mmAnonymizeUser.mock.funcAnonymizeUser = f
+	mmAnonymizeUser.mock.funcAnonymizeUserOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
 }
 
-// When sets expectation for the Service.CreateUser which will trigger the result defined by the following
+// When sets expectation for the Service.AnonymizeUser which will trigger the result defined by the following
 // Then helper
-func (mmCreateUser *mServiceMockCreateUser) When(ctx context.Context, req user.CreateUserReq) *ServiceMockCreateUserExpectation {
-	if mmCreateUser.mock.funcCreateUser != nil {
-		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) When(ctx context.Context, id uuid.UUID) *ServiceMockAnonymizeUserExpectation {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("ServiceMock.AnonymizeUser mock is already set by Set")
 	}
 
-	expectation := &ServiceMockCreateUserExpectation{
-		mock:               mmCreateUser.mock,
-		params:             &ServiceMockCreateUserParams{ctx, req},
-		expectationOrigins: ServiceMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockAnonymizeUserExpectation{
+		mock:               mmAnonymizeUser.mock,
+		params:             &ServiceMockAnonymizeUserParams{ctx, id},
+		expectationOrigins: ServiceMockAnonymizeUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	mmAnonymizeUser.expectations = append(mmAnonymizeUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.CreateUser return parameters for the expectation previously defined by the When method
-func (e *ServiceMockCreateUserExpectation) Then(err error) *ServiceMock {
-	e.results = &ServiceMockCreateUserResults{err}
+// Then sets up Service.AnonymizeUser return parameters for the expectation previously defined by the When method
+func (e *ServiceMockAnonymizeUserExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockAnonymizeUserResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.CreateUser should be invoked
-func (mmCreateUser *mServiceMockCreateUser) Times(n uint64) *mServiceMockCreateUser {
+// Times sets number of times Service.AnonymizeUser should be invoked
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Times(n uint64) *mServiceMockAnonymizeUser {
 	if n == 0 {
-		mmCreateUser.mock.t.Fatalf("Times of ServiceMock.CreateUser mock can not be zero")
+		mmAnonymizeUser.mock.t.Fatalf("Times of ServiceMock.AnonymizeUser mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
-	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateUser
+	mm_atomic.StoreUint64(&mmAnonymizeUser.expectedInvocations, n)
+	mmAnonymizeUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser
 }
 
-func (mmCreateUser *mServiceMockCreateUser) invocationsDone() bool {
-	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) invocationsDone() bool {
+	if len(mmAnonymizeUser.expectations) == 0 && mmAnonymizeUser.defaultExpectation == nil && mmAnonymizeUser.mock.funcAnonymizeUser == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.mock.afterAnonymizeUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateUser implements mm_http.Service
-func (mmCreateUser *ServiceMock) CreateUser(ctx context.Context, req user.CreateUserReq) (err error) {
-	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+// AnonymizeUser implements mm_http.Service
+func (mmAnonymizeUser *ServiceMock) AnonymizeUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAnonymizeUser.afterAnonymizeUserCounter, 1)
 
-	mmCreateUser.t.Helper()
+	mmAnonymizeUser.t.Helper()
 
-	if mmCreateUser.inspectFuncCreateUser != nil {
-		mmCreateUser.inspectFuncCreateUser(ctx, req)
+	if mmAnonymizeUser.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.inspectFuncAnonymizeUser(ctx, id)
 	}
 
-	mm_params := ServiceMockCreateUserParams{ctx, req}
+	mm_params := ServiceMockAnonymizeUserParams{ctx, id}
 
 	// Record call args
-	mmCreateUser.CreateUserMock.mutex.Lock()
-	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
-	mmCreateUser.CreateUserMock.mutex.Unlock()
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Lock()
+	mmAnonymizeUser.AnonymizeUserMock.callArgs = append(mmAnonymizeUser.AnonymizeUserMock.callArgs, &mm_params)
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Unlock()
 
-	for _, e := range mmCreateUser.CreateUserMock.expectations {
+	for _, e := range mmAnonymizeUser.AnonymizeUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+	if mmAnonymizeUser.AnonymizeUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockCreateUserParams{ctx, req}
+		mm_got := ServiceMockAnonymizeUserParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAnonymizeUser.t.Errorf("ServiceMock.AnonymizeUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAnonymizeUser.t.Errorf("ServiceMock.AnonymizeUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAnonymizeUser.t.Errorf("ServiceMock.AnonymizeUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		mm_results := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateUser.t.Fatal("No results are set for the ServiceMock.CreateUser")
+			mmAnonymizeUser.t.Fatal("No results are set for the ServiceMock.AnonymizeUser")
 		}
 		return (*mm_results).err
 	}
-	if mmCreateUser.funcCreateUser != nil {
-		return mmCreateUser.funcCreateUser(ctx, req)
+	if mmAnonymizeUser.funcAnonymizeUser != nil {
+		return mmAnonymizeUser.funcAnonymizeUser(ctx, id)
 	}
-	mmCreateUser.t.Fatalf("Unexpected call to ServiceMock.CreateUser. %v %v", ctx, req)
+	mmAnonymizeUser.t.Fatalf("Unexpected call to ServiceMock.AnonymizeUser. %v %v", ctx, id)
 	return
 }
 
-// CreateUserAfterCounter returns a count of finished ServiceMock.CreateUser invocations
-func (mmCreateUser *ServiceMock) CreateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+// AnonymizeUserAfterCounter returns a count of finished ServiceMock.AnonymizeUser invocations
+func (mmAnonymizeUser *ServiceMock) AnonymizeUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.afterAnonymizeUserCounter)
 }
 
-// CreateUserBeforeCounter returns a count of ServiceMock.CreateUser invocations
-func (mmCreateUser *ServiceMock) CreateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+// AnonymizeUserBeforeCounter returns a count of ServiceMock.AnonymizeUser invocations
+func (mmAnonymizeUser *ServiceMock) AnonymizeUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.CreateUser.
+// Calls returns a list of arguments used in each call to ServiceMock.AnonymizeUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateUser *mServiceMockCreateUser) Calls() []*ServiceMockCreateUserParams {
-	mmCreateUser.mutex.RLock()
+func (mmAnonymizeUser *mServiceMockAnonymizeUser) Calls() []*ServiceMockAnonymizeUserParams {
+	mmAnonymizeUser.mutex.RLock()
 
-	argCopy := make([]*ServiceMockCreateUserParams, len(mmCreateUser.callArgs))
-	copy(argCopy, mmCreateUser.callArgs)
+	argCopy := make([]*ServiceMockAnonymizeUserParams, len(mmAnonymizeUser.callArgs))
+	copy(argCopy, mmAnonymizeUser.callArgs)
 
-	mmCreateUser.mutex.RUnlock()
+	mmAnonymizeUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// MinimockAnonymizeUserDone returns true if the count of the AnonymizeUser invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockCreateUserDone() bool {
-	if m.CreateUserMock.optional {
+func (m *ServiceMock) MinimockAnonymizeUserDone() bool {
+	if m.AnonymizeUserMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateUserMock.expectations {
+	for _, e := range m.AnonymizeUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateUserMock.invocationsDone()
+	return m.AnonymizeUserMock.invocationsDone()
 }
 
-// MinimockCreateUserInspect logs each unmet expectation
-func (m *ServiceMock) MinimockCreateUserInspect() {
-	for _, e := range m.CreateUserMock.expectations {
+// MinimockAnonymizeUserInspect logs each unmet expectation
+func (m *ServiceMock) MinimockAnonymizeUserInspect() {
+	for _, e := range m.AnonymizeUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.AnonymizeUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	afterAnonymizeUserCounter := mm_atomic.LoadUint64(&m.afterAnonymizeUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
-		if m.CreateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+	if m.AnonymizeUserMock.defaultExpectation != nil && afterAnonymizeUserCounter < 1 {
+		if m.AnonymizeUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.AnonymizeUser at\n%s", m.AnonymizeUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.AnonymizeUser at\n%s with params: %#v", m.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *m.AnonymizeUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	if m.funcAnonymizeUser != nil && afterAnonymizeUserCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.AnonymizeUser at\n%s", m.funcAnonymizeUserOrigin)
 	}
 
-	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.CreateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	if !m.AnonymizeUserMock.invocationsDone() && afterAnonymizeUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.AnonymizeUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AnonymizeUserMock.expectedInvocations), m.AnonymizeUserMock.expectedInvocationsOrigin, afterAnonymizeUserCounter)
 	}
 }
 
-type mServiceMockDeleteUser struct {
+type mServiceMockChangePassword struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockDeleteUserExpectation
-	expectations       []*ServiceMockDeleteUserExpectation
+	defaultExpectation *ServiceMockChangePasswordExpectation
+	expectations       []*ServiceMockChangePasswordExpectation
 
-	callArgs []*ServiceMockDeleteUserParams
+	callArgs []*ServiceMockChangePasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockDeleteUserExpectation specifies expectation struct of the Service.DeleteUser
-type ServiceMockDeleteUserExpectation struct {
+// ServiceMockChangePasswordExpectation specifies expectation struct of the Service.ChangePassword
+type ServiceMockChangePasswordExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockDeleteUserParams
-	paramPtrs          *ServiceMockDeleteUserParamPtrs
-	expectationOrigins ServiceMockDeleteUserExpectationOrigins
-	results            *ServiceMockDeleteUserResults
+	params             *ServiceMockChangePasswordParams
+	paramPtrs          *ServiceMockChangePasswordParamPtrs
+	expectationOrigins ServiceMockChangePasswordExpectationOrigins
+	results            *ServiceMockChangePasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockDeleteUserParams contains parameters of the Service.DeleteUser
-type ServiceMockDeleteUserParams struct {
+// ServiceMockChangePasswordParams contains parameters of the Service.ChangePassword
+type ServiceMockChangePasswordParams struct {
 	ctx context.Context
-	id  uuid.UUID
+	req usecase.ChangePasswordCmd
 }
 
-// ServiceMockDeleteUserParamPtrs contains pointers to parameters of the Service.DeleteUser
-type ServiceMockDeleteUserParamPtrs struct {
+// ServiceMockChangePasswordParamPtrs contains pointers to parameters of the Service.ChangePassword
+type ServiceMockChangePasswordParamPtrs struct {
 	ctx *context.Context
-	id  *uuid.UUID
+	req *usecase.ChangePasswordCmd
 }
 
-// ServiceMockDeleteUserResults contains results of the Service.DeleteUser
-type ServiceMockDeleteUserResults struct {
+// ServiceMockChangePasswordResults contains results of the Service.ChangePassword
+type ServiceMockChangePasswordResults struct {
 	err error
 }
 
-// ServiceMockDeleteUserOrigins contains origins of expectations of the Service.DeleteUser
-type ServiceMockDeleteUserExpectationOrigins struct {
+// ServiceMockChangePasswordOrigins contains origins of expectations of the Service.ChangePassword
+type ServiceMockChangePasswordExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -832,339 +914,2744 @@ type ServiceMockDeleteUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteUser *mServiceMockDeleteUser) Optional() *mServiceMockDeleteUser {
-	mmDeleteUser.optional = true
-	return mmDeleteUser
+func (mmChangePassword *mServiceMockChangePassword) Optional() *mServiceMockChangePassword {
+	mmChangePassword.optional = true
+	return mmChangePassword
 }
 
-// Expect sets up expected params for Service.DeleteUser
-func (mmDeleteUser *mServiceMockDeleteUser) Expect(ctx context.Context, id uuid.UUID) *mServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+// Expect sets up expected params for Service.ChangePassword
+func (mmChangePassword *mServiceMockChangePassword) Expect(ctx context.Context, req usecase.ChangePasswordCmd) *mServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by ExpectParams functions")
+	if mmChangePassword.defaultExpectation.paramPtrs != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteUser.defaultExpectation.params = &ServiceMockDeleteUserParams{ctx, id}
-	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteUser.expectations {
-		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
-			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+	mmChangePassword.defaultExpectation.params = &ServiceMockChangePasswordParams{ctx, req}
+	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangePassword.expectations {
+		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
+			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteUser
+	return mmChangePassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.DeleteUser
-func (mmDeleteUser *mServiceMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.ChangePassword
+func (mmChangePassword *mServiceMockChangePassword) ExpectCtxParam1(ctx context.Context) *mServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &ServiceMockDeleteUserParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &ServiceMockChangePasswordParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmChangePassword
 }
 
-// ExpectIdParam2 sets up expected param id for Service.DeleteUser
-func (mmDeleteUser *mServiceMockDeleteUser) ExpectIdParam2(id uuid.UUID) *mServiceMockDeleteUser {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Service.ChangePassword
+func (mmChangePassword *mServiceMockChangePassword) ExpectReqParam2(req usecase.ChangePasswordCmd) *mServiceMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{}
 	}
 
-	if mmDeleteUser.defaultExpectation.params != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
-		mmDeleteUser.defaultExpectation.paramPtrs = &ServiceMockDeleteUserParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &ServiceMockChangePasswordParamPtrs{}
 	}
-	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
-	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.req = &req
+	mmChangePassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmDeleteUser
+	return mmChangePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.DeleteUser
-func (mmDeleteUser *mServiceMockDeleteUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockDeleteUser {
-	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteUser")
+// Inspect accepts an inspector function that has same arguments as the Service.ChangePassword
+func (mmChangePassword *mServiceMockChangePassword) Inspect(f func(ctx context.Context, req usecase.ChangePasswordCmd)) *mServiceMockChangePassword {
+	if mmChangePassword.mock.inspectFuncChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("Inspect function is already set for ServiceMock.ChangePassword")
 	}
 
-	mmDeleteUser.mock.inspectFuncDeleteUser = f
+	mmChangePassword.mock.inspectFuncChangePassword = f
 
-	return mmDeleteUser
+	return mmChangePassword
 }
 
-// Return sets up results that will be returned by Service.DeleteUser
-func (mmDeleteUser *mServiceMockDeleteUser) Return(err error) *ServiceMock {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+// Return sets up results that will be returned by Service.ChangePassword
+func (mmChangePassword *mServiceMockChangePassword) Return(err error) *ServiceMock {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmDeleteUser.defaultExpectation == nil {
-		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &ServiceMockChangePasswordExpectation{mock: mmChangePassword.mock}
 	}
-	mmDeleteUser.defaultExpectation.results = &ServiceMockDeleteUserResults{err}
-	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmChangePassword.defaultExpectation.results = &ServiceMockChangePasswordResults{err}
+	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// Set uses given function f to mock the Service.DeleteUser method
-func (mmDeleteUser *mServiceMockDeleteUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *ServiceMock {
-	if mmDeleteUser.defaultExpectation != nil {
-		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the Service.DeleteUser method")
+// Set uses given function f to mock the Service.ChangePassword method
+func (mmChangePassword *mServiceMockChangePassword) Set(f func(ctx context.Context, req usecase.ChangePasswordCmd) (err error)) *ServiceMock {
+	if mmChangePassword.defaultExpectation != nil {
+		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the Service.ChangePassword method")
 	}
 
-	if len(mmDeleteUser.expectations) > 0 {
-		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the Service.DeleteUser method")
+	if len(mmChangePassword.expectations) > 0 {
+		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the Service.ChangePassword method")
 	}
 
-	mmDeleteUser.mock.funcDeleteUser = f
-	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser.mock
+	mmChangePassword.mock.funcChangePassword = f
+	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// When sets expectation for the Service.DeleteUser which will trigger the result defined by the following
+// When sets expectation for the Service.ChangePassword which will trigger the result defined by the following
 // Then helper
-func (mmDeleteUser *mServiceMockDeleteUser) When(ctx context.Context, id uuid.UUID) *ServiceMockDeleteUserExpectation {
-	if mmDeleteUser.mock.funcDeleteUser != nil {
-		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+func (mmChangePassword *mServiceMockChangePassword) When(ctx context.Context, req usecase.ChangePasswordCmd) *ServiceMockChangePasswordExpectation {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("ServiceMock.ChangePassword mock is already set by Set")
 	}
 
-	expectation := &ServiceMockDeleteUserExpectation{
-		mock:               mmDeleteUser.mock,
-		params:             &ServiceMockDeleteUserParams{ctx, id},
-		expectationOrigins: ServiceMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockChangePasswordExpectation{
+		mock:               mmChangePassword.mock,
+		params:             &ServiceMockChangePasswordParams{ctx, req},
+		expectationOrigins: ServiceMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.DeleteUser return parameters for the expectation previously defined by the When method
-func (e *ServiceMockDeleteUserExpectation) Then(err error) *ServiceMock {
-	e.results = &ServiceMockDeleteUserResults{err}
+// Then sets up Service.ChangePassword return parameters for the expectation previously defined by the When method
+func (e *ServiceMockChangePasswordExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockChangePasswordResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.DeleteUser should be invoked
-func (mmDeleteUser *mServiceMockDeleteUser) Times(n uint64) *mServiceMockDeleteUser {
+// Times sets number of times Service.ChangePassword should be invoked
+func (mmChangePassword *mServiceMockChangePassword) Times(n uint64) *mServiceMockChangePassword {
 	if n == 0 {
-		mmDeleteUser.mock.t.Fatalf("Times of ServiceMock.DeleteUser mock can not be zero")
+		mmChangePassword.mock.t.Fatalf("Times of ServiceMock.ChangePassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
-	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteUser
+	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
+	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangePassword
 }
 
-func (mmDeleteUser *mServiceMockDeleteUser) invocationsDone() bool {
-	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+func (mmChangePassword *mServiceMockChangePassword) invocationsDone() bool {
+	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteUser implements mm_http.Service
-func (mmDeleteUser *ServiceMock) DeleteUser(ctx context.Context, id uuid.UUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+// ChangePassword implements mm_http.Service
+func (mmChangePassword *ServiceMock) ChangePassword(ctx context.Context, req usecase.ChangePasswordCmd) (err error) {
+	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
 
-	mmDeleteUser.t.Helper()
+	mmChangePassword.t.Helper()
 
-	if mmDeleteUser.inspectFuncDeleteUser != nil {
-		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	if mmChangePassword.inspectFuncChangePassword != nil {
+		mmChangePassword.inspectFuncChangePassword(ctx, req)
 	}
 
-	mm_params := ServiceMockDeleteUserParams{ctx, id}
+	mm_params := ServiceMockChangePasswordParams{ctx, req}
 
 	// Record call args
-	mmDeleteUser.DeleteUserMock.mutex.Lock()
-	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
-	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+	mmChangePassword.ChangePasswordMock.mutex.Lock()
+	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
+	mmChangePassword.ChangePasswordMock.mutex.Unlock()
 
-	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockDeleteUserParams{ctx, id}
+		mm_got := ServiceMockChangePasswordParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmChangePassword.t.Errorf("ServiceMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteUser.t.Fatal("No results are set for the ServiceMock.DeleteUser")
+			mmChangePassword.t.Fatal("No results are set for the ServiceMock.ChangePassword")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteUser.funcDeleteUser != nil {
-		return mmDeleteUser.funcDeleteUser(ctx, id)
+	if mmChangePassword.funcChangePassword != nil {
+		return mmChangePassword.funcChangePassword(ctx, req)
 	}
-	mmDeleteUser.t.Fatalf("Unexpected call to ServiceMock.DeleteUser. %v %v", ctx, id)
+	mmChangePassword.t.Fatalf("Unexpected call to ServiceMock.ChangePassword. %v %v", ctx, req)
 	return
 }
 
-// DeleteUserAfterCounter returns a count of finished ServiceMock.DeleteUser invocations
-func (mmDeleteUser *ServiceMock) DeleteUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+// ChangePasswordAfterCounter returns a count of finished ServiceMock.ChangePassword invocations
+func (mmChangePassword *ServiceMock) ChangePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
 }
 
-// DeleteUserBeforeCounter returns a count of ServiceMock.DeleteUser invocations
-func (mmDeleteUser *ServiceMock) DeleteUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+// ChangePasswordBeforeCounter returns a count of ServiceMock.ChangePassword invocations
+func (mmChangePassword *ServiceMock) ChangePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.DeleteUser.
+// Calls returns a list of arguments used in each call to ServiceMock.ChangePassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteUser *mServiceMockDeleteUser) Calls() []*ServiceMockDeleteUserParams {
-	mmDeleteUser.mutex.RLock()
+func (mmChangePassword *mServiceMockChangePassword) Calls() []*ServiceMockChangePasswordParams {
+	mmChangePassword.mutex.RLock()
 
-	argCopy := make([]*ServiceMockDeleteUserParams, len(mmDeleteUser.callArgs))
-	copy(argCopy, mmDeleteUser.callArgs)
+	argCopy := make([]*ServiceMockChangePasswordParams, len(mmChangePassword.callArgs))
+	copy(argCopy, mmChangePassword.callArgs)
 
-	mmDeleteUser.mutex.RUnlock()
+	mmChangePassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockDeleteUserDone() bool {
-	if m.DeleteUserMock.optional {
+func (m *ServiceMock) MinimockChangePasswordDone() bool {
+	if m.ChangePasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteUserMock.expectations {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteUserMock.invocationsDone()
+	return m.ChangePasswordMock.invocationsDone()
 }
 
-// MinimockDeleteUserInspect logs each unmet expectation
-func (m *ServiceMock) MinimockDeleteUserInspect() {
-	for _, e := range m.DeleteUserMock.expectations {
+// MinimockChangePasswordInspect logs each unmet expectation
+func (m *ServiceMock) MinimockChangePasswordInspect() {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
-		if m.DeleteUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
+		if m.ChangePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
 	}
 
-	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.DeleteUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.ChangePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
 	}
 }
 
-type mServiceMockGetAllUsers struct {
+type mServiceMockCreateUser struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockGetAllUsersExpectation
-	expectations       []*ServiceMockGetAllUsersExpectation
+	defaultExpectation *ServiceMockCreateUserExpectation
+	expectations       []*ServiceMockCreateUserExpectation
 
-	callArgs []*ServiceMockGetAllUsersParams
+	callArgs []*ServiceMockCreateUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockGetAllUsersExpectation specifies expectation struct of the Service.GetAllUsers
-type ServiceMockGetAllUsersExpectation struct {
+// ServiceMockCreateUserExpectation specifies expectation struct of the Service.CreateUser
+type ServiceMockCreateUserExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockGetAllUsersParams
-	paramPtrs          *ServiceMockGetAllUsersParamPtrs
-	expectationOrigins ServiceMockGetAllUsersExpectationOrigins
-	results            *ServiceMockGetAllUsersResults
+	params             *ServiceMockCreateUserParams
+	paramPtrs          *ServiceMockCreateUserParamPtrs
+	expectationOrigins ServiceMockCreateUserExpectationOrigins
+	results            *ServiceMockCreateUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockGetAllUsersParams contains parameters of the Service.GetAllUsers
-type ServiceMockGetAllUsersParams struct {
+// ServiceMockCreateUserParams contains parameters of the Service.CreateUser
+type ServiceMockCreateUserParams struct {
 	ctx context.Context
+	req user.CreateUserReq
 }
 
-// ServiceMockGetAllUsersParamPtrs contains pointers to parameters of the Service.GetAllUsers
-type ServiceMockGetAllUsersParamPtrs struct {
+// ServiceMockCreateUserParamPtrs contains pointers to parameters of the Service.CreateUser
+type ServiceMockCreateUserParamPtrs struct {
 	ctx *context.Context
+	req *user.CreateUserReq
 }
 
-// ServiceMockGetAllUsersResults contains results of the Service.GetAllUsers
-type ServiceMockGetAllUsersResults struct {
-	ua1 []user.User
+// ServiceMockCreateUserResults contains results of the Service.CreateUser
+type ServiceMockCreateUserResults struct {
+	err error
+}
+
+// ServiceMockCreateUserOrigins contains origins of expectations of the Service.CreateUser
+type ServiceMockCreateUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mServiceMockCreateUser) Optional() *mServiceMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for Service.CreateUser
+func (mmCreateUser *mServiceMockCreateUser) Expect(ctx context.Context, req user.CreateUserReq) *mServiceMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &ServiceMockCreateUserParams{ctx, req}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.CreateUser
+func (mmCreateUser *mServiceMockCreateUser) ExpectCtxParam1(ctx context.Context) *mServiceMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &ServiceMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectReqParam2 sets up expected param req for Service.CreateUser
+func (mmCreateUser *mServiceMockCreateUser) ExpectReqParam2(req user.CreateUserReq) *mServiceMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &ServiceMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.req = &req
+	mmCreateUser.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.CreateUser
+func (mmCreateUser *mServiceMockCreateUser) Inspect(f func(ctx context.Context, req user.CreateUserReq)) *mServiceMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by Service.CreateUser
+func (mmCreateUser *mServiceMockCreateUser) Return(err error) *ServiceMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &ServiceMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &ServiceMockCreateUserResults{err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the Service.CreateUser method
+func (mmCreateUser *mServiceMockCreateUser) Set(f func(ctx context.Context, req user.CreateUserReq) (err error)) *ServiceMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the Service.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the Service.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the Service.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mServiceMockCreateUser) When(ctx context.Context, req user.CreateUserReq) *ServiceMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("ServiceMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &ServiceMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &ServiceMockCreateUserParams{ctx, req},
+		expectationOrigins: ServiceMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.CreateUser return parameters for the expectation previously defined by the When method
+func (e *ServiceMockCreateUserExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockCreateUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.CreateUser should be invoked
+func (mmCreateUser *mServiceMockCreateUser) Times(n uint64) *mServiceMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of ServiceMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mServiceMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_http.Service
+func (mmCreateUser *ServiceMock) CreateUser(ctx context.Context, req user.CreateUserReq) (err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, req)
+	}
+
+	mm_params := ServiceMockCreateUserParams{ctx, req}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockCreateUserParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("ServiceMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the ServiceMock.CreateUser")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, req)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to ServiceMock.CreateUser. %v %v", ctx, req)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished ServiceMock.CreateUser invocations
+func (mmCreateUser *ServiceMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of ServiceMock.CreateUser invocations
+func (mmCreateUser *ServiceMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mServiceMockCreateUser) Calls() []*ServiceMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*ServiceMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *ServiceMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mServiceMockDeleteAvatar struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteAvatarExpectation
+	expectations       []*ServiceMockDeleteAvatarExpectation
+
+	callArgs []*ServiceMockDeleteAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteAvatarExpectation specifies expectation struct of the Service.DeleteAvatar
+type ServiceMockDeleteAvatarExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteAvatarParams
+	paramPtrs          *ServiceMockDeleteAvatarParamPtrs
+	expectationOrigins ServiceMockDeleteAvatarExpectationOrigins
+	results            *ServiceMockDeleteAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteAvatarParams contains parameters of the Service.DeleteAvatar
+type ServiceMockDeleteAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// ServiceMockDeleteAvatarParamPtrs contains pointers to parameters of the Service.DeleteAvatar
+type ServiceMockDeleteAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// ServiceMockDeleteAvatarResults contains results of the Service.DeleteAvatar
+type ServiceMockDeleteAvatarResults struct {
+	err error
+}
+
+// ServiceMockDeleteAvatarOrigins contains origins of expectations of the Service.DeleteAvatar
+type ServiceMockDeleteAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Optional() *mServiceMockDeleteAvatar {
+	mmDeleteAvatar.optional = true
+	return mmDeleteAvatar
+}
+
+// Expect sets up expected params for Service.DeleteAvatar
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Expect(ctx context.Context, userID uuid.UUID) *mServiceMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &ServiceMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAvatar.defaultExpectation.params = &ServiceMockDeleteAvatarParams{ctx, userID}
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAvatar.expectations {
+		if minimock.Equal(e.params, mmDeleteAvatar.defaultExpectation.params) {
+			mmDeleteAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteAvatar
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &ServiceMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.params != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Expect")
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs == nil {
+		mmDeleteAvatar.defaultExpectation.paramPtrs = &ServiceMockDeleteAvatarParamPtrs{}
+	}
+	mmDeleteAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.DeleteAvatar
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockDeleteAvatar {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &ServiceMockDeleteAvatarExpectation{}
+	}
+
+	if mmDeleteAvatar.defaultExpectation.params != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Expect")
+	}
+
+	if mmDeleteAvatar.defaultExpectation.paramPtrs == nil {
+		mmDeleteAvatar.defaultExpectation.paramPtrs = &ServiceMockDeleteAvatarParamPtrs{}
+	}
+	mmDeleteAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteAvatar
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mServiceMockDeleteAvatar {
+	if mmDeleteAvatar.mock.inspectFuncDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteAvatar")
+	}
+
+	mmDeleteAvatar.mock.inspectFuncDeleteAvatar = f
+
+	return mmDeleteAvatar
+}
+
+// Return sets up results that will be returned by Service.DeleteAvatar
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Return(err error) *ServiceMock {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Set")
+	}
+
+	if mmDeleteAvatar.defaultExpectation == nil {
+		mmDeleteAvatar.defaultExpectation = &ServiceMockDeleteAvatarExpectation{mock: mmDeleteAvatar.mock}
+	}
+	mmDeleteAvatar.defaultExpectation.results = &ServiceMockDeleteAvatarResults{err}
+	mmDeleteAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar.mock
+}
+
+// Set uses given function f to mock the Service.DeleteAvatar method
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *ServiceMock {
+	if mmDeleteAvatar.defaultExpectation != nil {
+		mmDeleteAvatar.mock.t.Fatalf("Default expectation is already set for the Service.DeleteAvatar method")
+	}
+
+	if len(mmDeleteAvatar.expectations) > 0 {
+		mmDeleteAvatar.mock.t.Fatalf("Some expectations are already set for the Service.DeleteAvatar method")
+	}
+
+	mmDeleteAvatar.mock.funcDeleteAvatar = f
+	mmDeleteAvatar.mock.funcDeleteAvatarOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar.mock
+}
+
+// When sets expectation for the Service.DeleteAvatar which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) When(ctx context.Context, userID uuid.UUID) *ServiceMockDeleteAvatarExpectation {
+	if mmDeleteAvatar.mock.funcDeleteAvatar != nil {
+		mmDeleteAvatar.mock.t.Fatalf("ServiceMock.DeleteAvatar mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDeleteAvatarExpectation{
+		mock:               mmDeleteAvatar.mock,
+		params:             &ServiceMockDeleteAvatarParams{ctx, userID},
+		expectationOrigins: ServiceMockDeleteAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAvatar.expectations = append(mmDeleteAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteAvatar return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteAvatarExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteAvatarResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteAvatar should be invoked
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Times(n uint64) *mServiceMockDeleteAvatar {
+	if n == 0 {
+		mmDeleteAvatar.mock.t.Fatalf("Times of ServiceMock.DeleteAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAvatar.expectedInvocations, n)
+	mmDeleteAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAvatar
+}
+
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) invocationsDone() bool {
+	if len(mmDeleteAvatar.expectations) == 0 && mmDeleteAvatar.defaultExpectation == nil && mmDeleteAvatar.mock.funcDeleteAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAvatar.mock.afterDeleteAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAvatar implements mm_http.Service
+func (mmDeleteAvatar *ServiceMock) DeleteAvatar(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAvatar.beforeDeleteAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAvatar.afterDeleteAvatarCounter, 1)
+
+	mmDeleteAvatar.t.Helper()
+
+	if mmDeleteAvatar.inspectFuncDeleteAvatar != nil {
+		mmDeleteAvatar.inspectFuncDeleteAvatar(ctx, userID)
+	}
+
+	mm_params := ServiceMockDeleteAvatarParams{ctx, userID}
+
+	// Record call args
+	mmDeleteAvatar.DeleteAvatarMock.mutex.Lock()
+	mmDeleteAvatar.DeleteAvatarMock.callArgs = append(mmDeleteAvatar.DeleteAvatarMock.callArgs, &mm_params)
+	mmDeleteAvatar.DeleteAvatarMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAvatar.DeleteAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAvatar.DeleteAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteAvatarParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAvatar.t.Errorf("ServiceMock.DeleteAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteAvatar.t.Errorf("ServiceMock.DeleteAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAvatar.t.Errorf("ServiceMock.DeleteAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAvatar.DeleteAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAvatar.t.Fatal("No results are set for the ServiceMock.DeleteAvatar")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAvatar.funcDeleteAvatar != nil {
+		return mmDeleteAvatar.funcDeleteAvatar(ctx, userID)
+	}
+	mmDeleteAvatar.t.Fatalf("Unexpected call to ServiceMock.DeleteAvatar. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAvatarAfterCounter returns a count of finished ServiceMock.DeleteAvatar invocations
+func (mmDeleteAvatar *ServiceMock) DeleteAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAvatar.afterDeleteAvatarCounter)
+}
+
+// DeleteAvatarBeforeCounter returns a count of ServiceMock.DeleteAvatar invocations
+func (mmDeleteAvatar *ServiceMock) DeleteAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAvatar.beforeDeleteAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAvatar *mServiceMockDeleteAvatar) Calls() []*ServiceMockDeleteAvatarParams {
+	mmDeleteAvatar.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteAvatarParams, len(mmDeleteAvatar.callArgs))
+	copy(argCopy, mmDeleteAvatar.callArgs)
+
+	mmDeleteAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAvatarDone returns true if the count of the DeleteAvatar invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteAvatarDone() bool {
+	if m.DeleteAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAvatarMock.invocationsDone()
+}
+
+// MinimockDeleteAvatarInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteAvatarInspect() {
+	for _, e := range m.DeleteAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAvatarCounter := mm_atomic.LoadUint64(&m.afterDeleteAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAvatarMock.defaultExpectation != nil && afterDeleteAvatarCounter < 1 {
+		if m.DeleteAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteAvatar at\n%s", m.DeleteAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteAvatar at\n%s with params: %#v", m.DeleteAvatarMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAvatar != nil && afterDeleteAvatarCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteAvatar at\n%s", m.funcDeleteAvatarOrigin)
+	}
+
+	if !m.DeleteAvatarMock.invocationsDone() && afterDeleteAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAvatarMock.expectedInvocations), m.DeleteAvatarMock.expectedInvocationsOrigin, afterDeleteAvatarCounter)
+	}
+}
+
+type mServiceMockDeleteUser struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteUserExpectation
+	expectations       []*ServiceMockDeleteUserExpectation
+
+	callArgs []*ServiceMockDeleteUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteUserExpectation specifies expectation struct of the Service.DeleteUser
+type ServiceMockDeleteUserExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteUserParams
+	paramPtrs          *ServiceMockDeleteUserParamPtrs
+	expectationOrigins ServiceMockDeleteUserExpectationOrigins
+	results            *ServiceMockDeleteUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteUserParams contains parameters of the Service.DeleteUser
+type ServiceMockDeleteUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// ServiceMockDeleteUserParamPtrs contains pointers to parameters of the Service.DeleteUser
+type ServiceMockDeleteUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// ServiceMockDeleteUserResults contains results of the Service.DeleteUser
+type ServiceMockDeleteUserResults struct {
+	err error
+}
+
+// ServiceMockDeleteUserOrigins contains origins of expectations of the Service.DeleteUser
+type ServiceMockDeleteUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUser *mServiceMockDeleteUser) Optional() *mServiceMockDeleteUser {
+	mmDeleteUser.optional = true
+	return mmDeleteUser
+}
+
+// Expect sets up expected params for Service.DeleteUser
+func (mmDeleteUser *mServiceMockDeleteUser) Expect(ctx context.Context, id uuid.UUID) *mServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUser.defaultExpectation.params = &ServiceMockDeleteUserParams{ctx, id}
+	mmDeleteUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUser.expectations {
+		if minimock.Equal(e.params, mmDeleteUser.defaultExpectation.params) {
+			mmDeleteUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteUser
+func (mmDeleteUser *mServiceMockDeleteUser) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &ServiceMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// ExpectIdParam2 sets up expected param id for Service.DeleteUser
+func (mmDeleteUser *mServiceMockDeleteUser) ExpectIdParam2(id uuid.UUID) *mServiceMockDeleteUser {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{}
+	}
+
+	if mmDeleteUser.defaultExpectation.params != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Expect")
+	}
+
+	if mmDeleteUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteUser.defaultExpectation.paramPtrs = &ServiceMockDeleteUserParamPtrs{}
+	}
+	mmDeleteUser.defaultExpectation.paramPtrs.id = &id
+	mmDeleteUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteUser
+func (mmDeleteUser *mServiceMockDeleteUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockDeleteUser {
+	if mmDeleteUser.mock.inspectFuncDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteUser")
+	}
+
+	mmDeleteUser.mock.inspectFuncDeleteUser = f
+
+	return mmDeleteUser
+}
+
+// Return sets up results that will be returned by Service.DeleteUser
+func (mmDeleteUser *mServiceMockDeleteUser) Return(err error) *ServiceMock {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	if mmDeleteUser.defaultExpectation == nil {
+		mmDeleteUser.defaultExpectation = &ServiceMockDeleteUserExpectation{mock: mmDeleteUser.mock}
+	}
+	mmDeleteUser.defaultExpectation.results = &ServiceMockDeleteUserResults{err}
+	mmDeleteUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// Set uses given function f to mock the Service.DeleteUser method
+func (mmDeleteUser *mServiceMockDeleteUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *ServiceMock {
+	if mmDeleteUser.defaultExpectation != nil {
+		mmDeleteUser.mock.t.Fatalf("Default expectation is already set for the Service.DeleteUser method")
+	}
+
+	if len(mmDeleteUser.expectations) > 0 {
+		mmDeleteUser.mock.t.Fatalf("Some expectations are already set for the Service.DeleteUser method")
+	}
+
+	mmDeleteUser.mock.funcDeleteUser = f
+	mmDeleteUser.mock.funcDeleteUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser.mock
+}
+
+// When sets expectation for the Service.DeleteUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUser *mServiceMockDeleteUser) When(ctx context.Context, id uuid.UUID) *ServiceMockDeleteUserExpectation {
+	if mmDeleteUser.mock.funcDeleteUser != nil {
+		mmDeleteUser.mock.t.Fatalf("ServiceMock.DeleteUser mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDeleteUserExpectation{
+		mock:               mmDeleteUser.mock,
+		params:             &ServiceMockDeleteUserParams{ctx, id},
+		expectationOrigins: ServiceMockDeleteUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUser.expectations = append(mmDeleteUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteUser return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteUserExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteUser should be invoked
+func (mmDeleteUser *mServiceMockDeleteUser) Times(n uint64) *mServiceMockDeleteUser {
+	if n == 0 {
+		mmDeleteUser.mock.t.Fatalf("Times of ServiceMock.DeleteUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUser.expectedInvocations, n)
+	mmDeleteUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUser
+}
+
+func (mmDeleteUser *mServiceMockDeleteUser) invocationsDone() bool {
+	if len(mmDeleteUser.expectations) == 0 && mmDeleteUser.defaultExpectation == nil && mmDeleteUser.mock.funcDeleteUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUser.mock.afterDeleteUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUser implements mm_http.Service
+func (mmDeleteUser *ServiceMock) DeleteUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteUser.beforeDeleteUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUser.afterDeleteUserCounter, 1)
+
+	mmDeleteUser.t.Helper()
+
+	if mmDeleteUser.inspectFuncDeleteUser != nil {
+		mmDeleteUser.inspectFuncDeleteUser(ctx, id)
+	}
+
+	mm_params := ServiceMockDeleteUserParams{ctx, id}
+
+	// Record call args
+	mmDeleteUser.DeleteUserMock.mutex.Lock()
+	mmDeleteUser.DeleteUserMock.callArgs = append(mmDeleteUser.DeleteUserMock.callArgs, &mm_params)
+	mmDeleteUser.DeleteUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUser.DeleteUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteUser.DeleteUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUser.DeleteUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUser.DeleteUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUser.DeleteUserMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUser.t.Errorf("ServiceMock.DeleteUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUser.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUser.DeleteUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUser.t.Fatal("No results are set for the ServiceMock.DeleteUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteUser.funcDeleteUser != nil {
+		return mmDeleteUser.funcDeleteUser(ctx, id)
+	}
+	mmDeleteUser.t.Fatalf("Unexpected call to ServiceMock.DeleteUser. %v %v", ctx, id)
+	return
+}
+
+// DeleteUserAfterCounter returns a count of finished ServiceMock.DeleteUser invocations
+func (mmDeleteUser *ServiceMock) DeleteUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.afterDeleteUserCounter)
+}
+
+// DeleteUserBeforeCounter returns a count of ServiceMock.DeleteUser invocations
+func (mmDeleteUser *ServiceMock) DeleteUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUser.beforeDeleteUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUser *mServiceMockDeleteUser) Calls() []*ServiceMockDeleteUserParams {
+	mmDeleteUser.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteUserParams, len(mmDeleteUser.callArgs))
+	copy(argCopy, mmDeleteUser.callArgs)
+
+	mmDeleteUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserDone returns true if the count of the DeleteUser invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteUserDone() bool {
+	if m.DeleteUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserMock.invocationsDone()
+}
+
+// MinimockDeleteUserInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteUserInspect() {
+	for _, e := range m.DeleteUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserCounter := mm_atomic.LoadUint64(&m.afterDeleteUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserMock.defaultExpectation != nil && afterDeleteUserCounter < 1 {
+		if m.DeleteUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s", m.DeleteUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s with params: %#v", m.DeleteUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUser != nil && afterDeleteUserCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteUser at\n%s", m.funcDeleteUserOrigin)
+	}
+
+	if !m.DeleteUserMock.invocationsDone() && afterDeleteUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserMock.expectedInvocations), m.DeleteUserMock.expectedInvocationsOrigin, afterDeleteUserCounter)
+	}
+}
+
+type mServiceMockGetAllUsers struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetAllUsersExpectation
+	expectations       []*ServiceMockGetAllUsersExpectation
+
+	callArgs []*ServiceMockGetAllUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetAllUsersExpectation specifies expectation struct of the Service.GetAllUsers
+type ServiceMockGetAllUsersExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetAllUsersParams
+	paramPtrs          *ServiceMockGetAllUsersParamPtrs
+	expectationOrigins ServiceMockGetAllUsersExpectationOrigins
+	results            *ServiceMockGetAllUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetAllUsersParams contains parameters of the Service.GetAllUsers
+type ServiceMockGetAllUsersParams struct {
+	ctx  context.Context
+	opts user.ListUsersOptions
+}
+
+// ServiceMockGetAllUsersParamPtrs contains pointers to parameters of the Service.GetAllUsers
+type ServiceMockGetAllUsersParamPtrs struct {
+	ctx  *context.Context
+	opts *user.ListUsersOptions
+}
+
+// ServiceMockGetAllUsersResults contains results of the Service.GetAllUsers
+type ServiceMockGetAllUsersResults struct {
+	u1  user.UsersPage
+	err error
+}
+
+// ServiceMockGetAllUsersOrigins contains origins of expectations of the Service.GetAllUsers
+type ServiceMockGetAllUsersExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAllUsers *mServiceMockGetAllUsers) Optional() *mServiceMockGetAllUsers {
+	mmGetAllUsers.optional = true
+	return mmGetAllUsers
+}
+
+// Expect sets up expected params for Service.GetAllUsers
+func (mmGetAllUsers *mServiceMockGetAllUsers) Expect(ctx context.Context, opts user.ListUsersOptions) *mServiceMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by ExpectParams functions")
+	}
+
+	mmGetAllUsers.defaultExpectation.params = &ServiceMockGetAllUsersParams{ctx, opts}
+	mmGetAllUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAllUsers.expectations {
+		if minimock.Equal(e.params, mmGetAllUsers.defaultExpectation.params) {
+			mmGetAllUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAllUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAllUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetAllUsers
+func (mmGetAllUsers *mServiceMockGetAllUsers) ExpectCtxParam1(ctx context.Context) *mServiceMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &ServiceMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
+// ExpectOptsParam2 sets up expected param opts for Service.GetAllUsers
+func (mmGetAllUsers *mServiceMockGetAllUsers) ExpectOptsParam2(opts user.ListUsersOptions) *mServiceMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &ServiceMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.opts = &opts
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetAllUsers
+func (mmGetAllUsers *mServiceMockGetAllUsers) Inspect(f func(ctx context.Context, opts user.ListUsersOptions)) *mServiceMockGetAllUsers {
+	if mmGetAllUsers.mock.inspectFuncGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetAllUsers")
+	}
+
+	mmGetAllUsers.mock.inspectFuncGetAllUsers = f
+
+	return mmGetAllUsers
+}
+
+// Return sets up results that will be returned by Service.GetAllUsers
+func (mmGetAllUsers *mServiceMockGetAllUsers) Return(u1 user.UsersPage, err error) *ServiceMock {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{mock: mmGetAllUsers.mock}
+	}
+	mmGetAllUsers.defaultExpectation.results = &ServiceMockGetAllUsersResults{u1, err}
+	mmGetAllUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers.mock
+}
+
+// Set uses given function f to mock the Service.GetAllUsers method
+func (mmGetAllUsers *mServiceMockGetAllUsers) Set(f func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)) *ServiceMock {
+	if mmGetAllUsers.defaultExpectation != nil {
+		mmGetAllUsers.mock.t.Fatalf("Default expectation is already set for the Service.GetAllUsers method")
+	}
+
+	if len(mmGetAllUsers.expectations) > 0 {
+		mmGetAllUsers.mock.t.Fatalf("Some expectations are already set for the Service.GetAllUsers method")
+	}
+
+	mmGetAllUsers.mock.funcGetAllUsers = f
+	mmGetAllUsers.mock.funcGetAllUsersOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers.mock
+}
+
+// When sets expectation for the Service.GetAllUsers which will trigger the result defined by the following
+// Then helper
+func (mmGetAllUsers *mServiceMockGetAllUsers) When(ctx context.Context, opts user.ListUsersOptions) *ServiceMockGetAllUsersExpectation {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetAllUsersExpectation{
+		mock:               mmGetAllUsers.mock,
+		params:             &ServiceMockGetAllUsersParams{ctx, opts},
+		expectationOrigins: ServiceMockGetAllUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAllUsers.expectations = append(mmGetAllUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetAllUsers return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetAllUsersExpectation) Then(u1 user.UsersPage, err error) *ServiceMock {
+	e.results = &ServiceMockGetAllUsersResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetAllUsers should be invoked
+func (mmGetAllUsers *mServiceMockGetAllUsers) Times(n uint64) *mServiceMockGetAllUsers {
+	if n == 0 {
+		mmGetAllUsers.mock.t.Fatalf("Times of ServiceMock.GetAllUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAllUsers.expectedInvocations, n)
+	mmGetAllUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAllUsers
+}
+
+func (mmGetAllUsers *mServiceMockGetAllUsers) invocationsDone() bool {
+	if len(mmGetAllUsers.expectations) == 0 && mmGetAllUsers.defaultExpectation == nil && mmGetAllUsers.mock.funcGetAllUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.mock.afterGetAllUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAllUsers implements mm_http.Service
+func (mmGetAllUsers *ServiceMock) GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error) {
+	mm_atomic.AddUint64(&mmGetAllUsers.beforeGetAllUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAllUsers.afterGetAllUsersCounter, 1)
+
+	mmGetAllUsers.t.Helper()
+
+	if mmGetAllUsers.inspectFuncGetAllUsers != nil {
+		mmGetAllUsers.inspectFuncGetAllUsers(ctx, opts)
+	}
+
+	mm_params := ServiceMockGetAllUsersParams{ctx, opts}
+
+	// Record call args
+	mmGetAllUsers.GetAllUsersMock.mutex.Lock()
+	mmGetAllUsers.GetAllUsersMock.callArgs = append(mmGetAllUsers.GetAllUsersMock.callArgs, &mm_params)
+	mmGetAllUsers.GetAllUsersMock.mutex.Unlock()
+
+	for _, e := range mmGetAllUsers.GetAllUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetAllUsers.GetAllUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAllUsers.GetAllUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAllUsers.GetAllUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAllUsers.GetAllUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetAllUsersParams{ctx, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAllUsers.t.Errorf("ServiceMock.GetAllUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmGetAllUsers.t.Errorf("ServiceMock.GetAllUsers got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAllUsers.t.Errorf("ServiceMock.GetAllUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAllUsers.GetAllUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAllUsers.t.Fatal("No results are set for the ServiceMock.GetAllUsers")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetAllUsers.funcGetAllUsers != nil {
+		return mmGetAllUsers.funcGetAllUsers(ctx, opts)
+	}
+	mmGetAllUsers.t.Fatalf("Unexpected call to ServiceMock.GetAllUsers. %v %v", ctx, opts)
+	return
+}
+
+// GetAllUsersAfterCounter returns a count of finished ServiceMock.GetAllUsers invocations
+func (mmGetAllUsers *ServiceMock) GetAllUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllUsers.afterGetAllUsersCounter)
+}
+
+// GetAllUsersBeforeCounter returns a count of ServiceMock.GetAllUsers invocations
+func (mmGetAllUsers *ServiceMock) GetAllUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAllUsers.beforeGetAllUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetAllUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAllUsers *mServiceMockGetAllUsers) Calls() []*ServiceMockGetAllUsersParams {
+	mmGetAllUsers.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetAllUsersParams, len(mmGetAllUsers.callArgs))
+	copy(argCopy, mmGetAllUsers.callArgs)
+
+	mmGetAllUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAllUsersDone returns true if the count of the GetAllUsers invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetAllUsersDone() bool {
+	if m.GetAllUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAllUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAllUsersMock.invocationsDone()
+}
+
+// MinimockGetAllUsersInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetAllUsersInspect() {
+	for _, e := range m.GetAllUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAllUsersCounter := mm_atomic.LoadUint64(&m.afterGetAllUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAllUsersMock.defaultExpectation != nil && afterGetAllUsersCounter < 1 {
+		if m.GetAllUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s", m.GetAllUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s with params: %#v", m.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *m.GetAllUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAllUsers != nil && afterGetAllUsersCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s", m.funcGetAllUsersOrigin)
+	}
+
+	if !m.GetAllUsersMock.invocationsDone() && afterGetAllUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetAllUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAllUsersMock.expectedInvocations), m.GetAllUsersMock.expectedInvocationsOrigin, afterGetAllUsersCounter)
+	}
+}
+
+type mServiceMockGetAvatar struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetAvatarExpectation
+	expectations       []*ServiceMockGetAvatarExpectation
+
+	callArgs []*ServiceMockGetAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetAvatarExpectation specifies expectation struct of the Service.GetAvatar
+type ServiceMockGetAvatarExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetAvatarParams
+	paramPtrs          *ServiceMockGetAvatarParamPtrs
+	expectationOrigins ServiceMockGetAvatarExpectationOrigins
+	results            *ServiceMockGetAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetAvatarParams contains parameters of the Service.GetAvatar
+type ServiceMockGetAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// ServiceMockGetAvatarParamPtrs contains pointers to parameters of the Service.GetAvatar
+type ServiceMockGetAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// ServiceMockGetAvatarResults contains results of the Service.GetAvatar
+type ServiceMockGetAvatarResults struct {
+	a1  user.Avatar
+	r1  io.ReadCloser
+	err error
+}
+
+// ServiceMockGetAvatarOrigins contains origins of expectations of the Service.GetAvatar
+type ServiceMockGetAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAvatar *mServiceMockGetAvatar) Optional() *mServiceMockGetAvatar {
+	mmGetAvatar.optional = true
+	return mmGetAvatar
+}
+
+// Expect sets up expected params for Service.GetAvatar
+func (mmGetAvatar *mServiceMockGetAvatar) Expect(ctx context.Context, userID uuid.UUID) *mServiceMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &ServiceMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmGetAvatar.defaultExpectation.params = &ServiceMockGetAvatarParams{ctx, userID}
+	mmGetAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAvatar.expectations {
+		if minimock.Equal(e.params, mmGetAvatar.defaultExpectation.params) {
+			mmGetAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetAvatar
+func (mmGetAvatar *mServiceMockGetAvatar) ExpectCtxParam1(ctx context.Context) *mServiceMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &ServiceMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.params != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Expect")
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs == nil {
+		mmGetAvatar.defaultExpectation.paramPtrs = &ServiceMockGetAvatarParamPtrs{}
+	}
+	mmGetAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.GetAvatar
+func (mmGetAvatar *mServiceMockGetAvatar) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockGetAvatar {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &ServiceMockGetAvatarExpectation{}
+	}
+
+	if mmGetAvatar.defaultExpectation.params != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Expect")
+	}
+
+	if mmGetAvatar.defaultExpectation.paramPtrs == nil {
+		mmGetAvatar.defaultExpectation.paramPtrs = &ServiceMockGetAvatarParamPtrs{}
+	}
+	mmGetAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmGetAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetAvatar
+func (mmGetAvatar *mServiceMockGetAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mServiceMockGetAvatar {
+	if mmGetAvatar.mock.inspectFuncGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetAvatar")
+	}
+
+	mmGetAvatar.mock.inspectFuncGetAvatar = f
+
+	return mmGetAvatar
+}
+
+// Return sets up results that will be returned by Service.GetAvatar
+func (mmGetAvatar *mServiceMockGetAvatar) Return(a1 user.Avatar, r1 io.ReadCloser, err error) *ServiceMock {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Set")
+	}
+
+	if mmGetAvatar.defaultExpectation == nil {
+		mmGetAvatar.defaultExpectation = &ServiceMockGetAvatarExpectation{mock: mmGetAvatar.mock}
+	}
+	mmGetAvatar.defaultExpectation.results = &ServiceMockGetAvatarResults{a1, r1, err}
+	mmGetAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar.mock
+}
+
+// Set uses given function f to mock the Service.GetAvatar method
+func (mmGetAvatar *mServiceMockGetAvatar) Set(f func(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error)) *ServiceMock {
+	if mmGetAvatar.defaultExpectation != nil {
+		mmGetAvatar.mock.t.Fatalf("Default expectation is already set for the Service.GetAvatar method")
+	}
+
+	if len(mmGetAvatar.expectations) > 0 {
+		mmGetAvatar.mock.t.Fatalf("Some expectations are already set for the Service.GetAvatar method")
+	}
+
+	mmGetAvatar.mock.funcGetAvatar = f
+	mmGetAvatar.mock.funcGetAvatarOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar.mock
+}
+
+// When sets expectation for the Service.GetAvatar which will trigger the result defined by the following
+// Then helper
+func (mmGetAvatar *mServiceMockGetAvatar) When(ctx context.Context, userID uuid.UUID) *ServiceMockGetAvatarExpectation {
+	if mmGetAvatar.mock.funcGetAvatar != nil {
+		mmGetAvatar.mock.t.Fatalf("ServiceMock.GetAvatar mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetAvatarExpectation{
+		mock:               mmGetAvatar.mock,
+		params:             &ServiceMockGetAvatarParams{ctx, userID},
+		expectationOrigins: ServiceMockGetAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAvatar.expectations = append(mmGetAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetAvatar return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetAvatarExpectation) Then(a1 user.Avatar, r1 io.ReadCloser, err error) *ServiceMock {
+	e.results = &ServiceMockGetAvatarResults{a1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetAvatar should be invoked
+func (mmGetAvatar *mServiceMockGetAvatar) Times(n uint64) *mServiceMockGetAvatar {
+	if n == 0 {
+		mmGetAvatar.mock.t.Fatalf("Times of ServiceMock.GetAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAvatar.expectedInvocations, n)
+	mmGetAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAvatar
+}
+
+func (mmGetAvatar *mServiceMockGetAvatar) invocationsDone() bool {
+	if len(mmGetAvatar.expectations) == 0 && mmGetAvatar.defaultExpectation == nil && mmGetAvatar.mock.funcGetAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAvatar.mock.afterGetAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAvatar implements mm_http.Service
+func (mmGetAvatar *ServiceMock) GetAvatar(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmGetAvatar.beforeGetAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAvatar.afterGetAvatarCounter, 1)
+
+	mmGetAvatar.t.Helper()
+
+	if mmGetAvatar.inspectFuncGetAvatar != nil {
+		mmGetAvatar.inspectFuncGetAvatar(ctx, userID)
+	}
+
+	mm_params := ServiceMockGetAvatarParams{ctx, userID}
+
+	// Record call args
+	mmGetAvatar.GetAvatarMock.mutex.Lock()
+	mmGetAvatar.GetAvatarMock.callArgs = append(mmGetAvatar.GetAvatarMock.callArgs, &mm_params)
+	mmGetAvatar.GetAvatarMock.mutex.Unlock()
+
+	for _, e := range mmGetAvatar.GetAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetAvatar.GetAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAvatar.GetAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAvatar.GetAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAvatar.GetAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetAvatarParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAvatar.t.Errorf("ServiceMock.GetAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetAvatar.t.Errorf("ServiceMock.GetAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAvatar.t.Errorf("ServiceMock.GetAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAvatar.GetAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAvatar.GetAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAvatar.t.Fatal("No results are set for the ServiceMock.GetAvatar")
+		}
+		return (*mm_results).a1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetAvatar.funcGetAvatar != nil {
+		return mmGetAvatar.funcGetAvatar(ctx, userID)
+	}
+	mmGetAvatar.t.Fatalf("Unexpected call to ServiceMock.GetAvatar. %v %v", ctx, userID)
+	return
+}
+
+// GetAvatarAfterCounter returns a count of finished ServiceMock.GetAvatar invocations
+func (mmGetAvatar *ServiceMock) GetAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAvatar.afterGetAvatarCounter)
+}
+
+// GetAvatarBeforeCounter returns a count of ServiceMock.GetAvatar invocations
+func (mmGetAvatar *ServiceMock) GetAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAvatar.beforeGetAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAvatar *mServiceMockGetAvatar) Calls() []*ServiceMockGetAvatarParams {
+	mmGetAvatar.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetAvatarParams, len(mmGetAvatar.callArgs))
+	copy(argCopy, mmGetAvatar.callArgs)
+
+	mmGetAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAvatarDone returns true if the count of the GetAvatar invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetAvatarDone() bool {
+	if m.GetAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAvatarMock.invocationsDone()
+}
+
+// MinimockGetAvatarInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetAvatarInspect() {
+	for _, e := range m.GetAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAvatarCounter := mm_atomic.LoadUint64(&m.afterGetAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAvatarMock.defaultExpectation != nil && afterGetAvatarCounter < 1 {
+		if m.GetAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetAvatar at\n%s", m.GetAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetAvatar at\n%s with params: %#v", m.GetAvatarMock.defaultExpectation.expectationOrigins.origin, *m.GetAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAvatar != nil && afterGetAvatarCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetAvatar at\n%s", m.funcGetAvatarOrigin)
+	}
+
+	if !m.GetAvatarMock.invocationsDone() && afterGetAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAvatarMock.expectedInvocations), m.GetAvatarMock.expectedInvocationsOrigin, afterGetAvatarCounter)
+	}
+}
+
+type mServiceMockGetPreferences struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetPreferencesExpectation
+	expectations       []*ServiceMockGetPreferencesExpectation
+
+	callArgs []*ServiceMockGetPreferencesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetPreferencesExpectation specifies expectation struct of the Service.GetPreferences
+type ServiceMockGetPreferencesExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetPreferencesParams
+	paramPtrs          *ServiceMockGetPreferencesParamPtrs
+	expectationOrigins ServiceMockGetPreferencesExpectationOrigins
+	results            *ServiceMockGetPreferencesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetPreferencesParams contains parameters of the Service.GetPreferences
+type ServiceMockGetPreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// ServiceMockGetPreferencesParamPtrs contains pointers to parameters of the Service.GetPreferences
+type ServiceMockGetPreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// ServiceMockGetPreferencesResults contains results of the Service.GetPreferences
+type ServiceMockGetPreferencesResults struct {
+	p1  user.Preferences
+	err error
+}
+
+// ServiceMockGetPreferencesOrigins contains origins of expectations of the Service.GetPreferences
+type ServiceMockGetPreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPreferences *mServiceMockGetPreferences) Optional() *mServiceMockGetPreferences {
+	mmGetPreferences.optional = true
+	return mmGetPreferences
+}
+
+// Expect sets up expected params for Service.GetPreferences
+func (mmGetPreferences *mServiceMockGetPreferences) Expect(ctx context.Context, userID uuid.UUID) *mServiceMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &ServiceMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by ExpectParams functions")
+	}
+
+	mmGetPreferences.defaultExpectation.params = &ServiceMockGetPreferencesParams{ctx, userID}
+	mmGetPreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreferences.expectations {
+		if minimock.Equal(e.params, mmGetPreferences.defaultExpectation.params) {
+			mmGetPreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreferences.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPreferences
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetPreferences
+func (mmGetPreferences *mServiceMockGetPreferences) ExpectCtxParam1(ctx context.Context) *mServiceMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &ServiceMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &ServiceMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.GetPreferences
+func (mmGetPreferences *mServiceMockGetPreferences) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &ServiceMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &ServiceMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetPreferences
+func (mmGetPreferences *mServiceMockGetPreferences) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mServiceMockGetPreferences {
+	if mmGetPreferences.mock.inspectFuncGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetPreferences")
+	}
+
+	mmGetPreferences.mock.inspectFuncGetPreferences = f
+
+	return mmGetPreferences
+}
+
+// Return sets up results that will be returned by Service.GetPreferences
+func (mmGetPreferences *mServiceMockGetPreferences) Return(p1 user.Preferences, err error) *ServiceMock {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &ServiceMockGetPreferencesExpectation{mock: mmGetPreferences.mock}
+	}
+	mmGetPreferences.defaultExpectation.results = &ServiceMockGetPreferencesResults{p1, err}
+	mmGetPreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// Set uses given function f to mock the Service.GetPreferences method
+func (mmGetPreferences *mServiceMockGetPreferences) Set(f func(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error)) *ServiceMock {
+	if mmGetPreferences.defaultExpectation != nil {
+		mmGetPreferences.mock.t.Fatalf("Default expectation is already set for the Service.GetPreferences method")
+	}
+
+	if len(mmGetPreferences.expectations) > 0 {
+		mmGetPreferences.mock.t.Fatalf("Some expectations are already set for the Service.GetPreferences method")
+	}
+
+	mmGetPreferences.mock.funcGetPreferences = f
+	mmGetPreferences.mock.funcGetPreferencesOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// When sets expectation for the Service.GetPreferences which will trigger the result defined by the following
+// Then helper
+func (mmGetPreferences *mServiceMockGetPreferences) When(ctx context.Context, userID uuid.UUID) *ServiceMockGetPreferencesExpectation {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("ServiceMock.GetPreferences mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetPreferencesExpectation{
+		mock:               mmGetPreferences.mock,
+		params:             &ServiceMockGetPreferencesParams{ctx, userID},
+		expectationOrigins: ServiceMockGetPreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPreferences.expectations = append(mmGetPreferences.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetPreferences return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetPreferencesExpectation) Then(p1 user.Preferences, err error) *ServiceMock {
+	e.results = &ServiceMockGetPreferencesResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetPreferences should be invoked
+func (mmGetPreferences *mServiceMockGetPreferences) Times(n uint64) *mServiceMockGetPreferences {
+	if n == 0 {
+		mmGetPreferences.mock.t.Fatalf("Times of ServiceMock.GetPreferences mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPreferences.expectedInvocations, n)
+	mmGetPreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences
+}
+
+func (mmGetPreferences *mServiceMockGetPreferences) invocationsDone() bool {
+	if len(mmGetPreferences.expectations) == 0 && mmGetPreferences.defaultExpectation == nil && mmGetPreferences.mock.funcGetPreferences == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreferences.mock.afterGetPreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreferences.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPreferences implements mm_http.Service
+func (mmGetPreferences *ServiceMock) GetPreferences(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error) {
+	mm_atomic.AddUint64(&mmGetPreferences.beforeGetPreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreferences.afterGetPreferencesCounter, 1)
+
+	mmGetPreferences.t.Helper()
+
+	if mmGetPreferences.inspectFuncGetPreferences != nil {
+		mmGetPreferences.inspectFuncGetPreferences(ctx, userID)
+	}
+
+	mm_params := ServiceMockGetPreferencesParams{ctx, userID}
+
+	// Record call args
+	mmGetPreferences.GetPreferencesMock.mutex.Lock()
+	mmGetPreferences.GetPreferencesMock.callArgs = append(mmGetPreferences.GetPreferencesMock.callArgs, &mm_params)
+	mmGetPreferences.GetPreferencesMock.mutex.Unlock()
+
+	for _, e := range mmGetPreferences.GetPreferencesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPreferences.GetPreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreferences.GetPreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreferences.GetPreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreferences.GetPreferencesMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetPreferencesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPreferences.t.Errorf("ServiceMock.GetPreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPreferences.t.Errorf("ServiceMock.GetPreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPreferences.t.Errorf("ServiceMock.GetPreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPreferences.GetPreferencesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPreferences.t.Fatal("No results are set for the ServiceMock.GetPreferences")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPreferences.funcGetPreferences != nil {
+		return mmGetPreferences.funcGetPreferences(ctx, userID)
+	}
+	mmGetPreferences.t.Fatalf("Unexpected call to ServiceMock.GetPreferences. %v %v", ctx, userID)
+	return
+}
+
+// GetPreferencesAfterCounter returns a count of finished ServiceMock.GetPreferences invocations
+func (mmGetPreferences *ServiceMock) GetPreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.afterGetPreferencesCounter)
+}
+
+// GetPreferencesBeforeCounter returns a count of ServiceMock.GetPreferences invocations
+func (mmGetPreferences *ServiceMock) GetPreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.beforeGetPreferencesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetPreferences.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPreferences *mServiceMockGetPreferences) Calls() []*ServiceMockGetPreferencesParams {
+	mmGetPreferences.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetPreferencesParams, len(mmGetPreferences.callArgs))
+	copy(argCopy, mmGetPreferences.callArgs)
+
+	mmGetPreferences.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPreferencesDone returns true if the count of the GetPreferences invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetPreferencesDone() bool {
+	if m.GetPreferencesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPreferencesMock.invocationsDone()
+}
+
+// MinimockGetPreferencesInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetPreferencesInspect() {
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetPreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPreferencesCounter := mm_atomic.LoadUint64(&m.afterGetPreferencesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPreferencesMock.defaultExpectation != nil && afterGetPreferencesCounter < 1 {
+		if m.GetPreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetPreferences at\n%s", m.GetPreferencesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetPreferences at\n%s with params: %#v", m.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *m.GetPreferencesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPreferences != nil && afterGetPreferencesCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetPreferences at\n%s", m.funcGetPreferencesOrigin)
+	}
+
+	if !m.GetPreferencesMock.invocationsDone() && afterGetPreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetPreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPreferencesMock.expectedInvocations), m.GetPreferencesMock.expectedInvocationsOrigin, afterGetPreferencesCounter)
+	}
+}
+
+type mServiceMockGetUser struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetUserExpectation
+	expectations       []*ServiceMockGetUserExpectation
+
+	callArgs []*ServiceMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetUserExpectation specifies expectation struct of the Service.GetUser
+type ServiceMockGetUserExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetUserParams
+	paramPtrs          *ServiceMockGetUserParamPtrs
+	expectationOrigins ServiceMockGetUserExpectationOrigins
+	results            *ServiceMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetUserParams contains parameters of the Service.GetUser
+type ServiceMockGetUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// ServiceMockGetUserParamPtrs contains pointers to parameters of the Service.GetUser
+type ServiceMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// ServiceMockGetUserResults contains results of the Service.GetUser
+type ServiceMockGetUserResults struct {
+	u1  user.User
+	err error
+}
+
+// ServiceMockGetUserOrigins contains origins of expectations of the Service.GetUser
+type ServiceMockGetUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mServiceMockGetUser) Optional() *mServiceMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for Service.GetUser
+func (mmGetUser *mServiceMockGetUser) Expect(ctx context.Context, id uuid.UUID) *mServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &ServiceMockGetUserParams{ctx, id}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetUser
+func (mmGetUser *mServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &ServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectIdParam2 sets up expected param id for Service.GetUser
+func (mmGetUser *mServiceMockGetUser) ExpectIdParam2(id uuid.UUID) *mServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &ServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.id = &id
+	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetUser
+func (mmGetUser *mServiceMockGetUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by Service.GetUser
+func (mmGetUser *mServiceMockGetUser) Return(u1 user.User, err error) *ServiceMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &ServiceMockGetUserResults{u1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the Service.GetUser method
+func (mmGetUser *mServiceMockGetUser) Set(f func(ctx context.Context, id uuid.UUID) (u1 user.User, err error)) *ServiceMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the Service.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the Service.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the Service.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mServiceMockGetUser) When(ctx context.Context, id uuid.UUID) *ServiceMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &ServiceMockGetUserParams{ctx, id},
+		expectationOrigins: ServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetUser return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetUserExpectation) Then(u1 user.User, err error) *ServiceMock {
+	e.results = &ServiceMockGetUserResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetUser should be invoked
+func (mmGetUser *mServiceMockGetUser) Times(n uint64) *mServiceMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of ServiceMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mServiceMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements mm_http.Service
+func (mmGetUser *ServiceMock) GetUser(ctx context.Context, id uuid.UUID) (u1 user.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, id)
+	}
+
+	mm_params := ServiceMockGetUserParams{ctx, id}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the ServiceMock.GetUser")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, id)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to ServiceMock.GetUser. %v %v", ctx, id)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished ServiceMock.GetUser invocations
+func (mmGetUser *ServiceMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of ServiceMock.GetUser invocations
+func (mmGetUser *ServiceMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mServiceMockGetUser) Calls() []*ServiceMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mServiceMockUpdatePreferences struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockUpdatePreferencesExpectation
+	expectations       []*ServiceMockUpdatePreferencesExpectation
+
+	callArgs []*ServiceMockUpdatePreferencesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockUpdatePreferencesExpectation specifies expectation struct of the Service.UpdatePreferences
+type ServiceMockUpdatePreferencesExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockUpdatePreferencesParams
+	paramPtrs          *ServiceMockUpdatePreferencesParamPtrs
+	expectationOrigins ServiceMockUpdatePreferencesExpectationOrigins
+	results            *ServiceMockUpdatePreferencesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockUpdatePreferencesParams contains parameters of the Service.UpdatePreferences
+type ServiceMockUpdatePreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	data   []byte
+}
+
+// ServiceMockUpdatePreferencesParamPtrs contains pointers to parameters of the Service.UpdatePreferences
+type ServiceMockUpdatePreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	data   *[]byte
+}
+
+// ServiceMockUpdatePreferencesResults contains results of the Service.UpdatePreferences
+type ServiceMockUpdatePreferencesResults struct {
+	p1  user.Preferences
 	err error
 }
 
-// ServiceMockGetAllUsersOrigins contains origins of expectations of the Service.GetAllUsers
-type ServiceMockGetAllUsersExpectationOrigins struct {
-	origin    string
-	originCtx string
+// ServiceMockUpdatePreferencesOrigins contains origins of expectations of the Service.UpdatePreferences
+type ServiceMockUpdatePreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originData   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1172,314 +3659,369 @@ type ServiceMockGetAllUsersExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetAllUsers *mServiceMockGetAllUsers) Optional() *mServiceMockGetAllUsers {
-	mmGetAllUsers.optional = true
-	return mmGetAllUsers
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Optional() *mServiceMockUpdatePreferences {
+	mmUpdatePreferences.optional = true
+	return mmUpdatePreferences
 }
 
-// Expect sets up expected params for Service.GetAllUsers
-func (mmGetAllUsers *mServiceMockGetAllUsers) Expect(ctx context.Context) *mServiceMockGetAllUsers {
-	if mmGetAllUsers.mock.funcGetAllUsers != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+// Expect sets up expected params for Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Expect(ctx context.Context, userID uuid.UUID, data []byte) *mServiceMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	if mmGetAllUsers.defaultExpectation == nil {
-		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{}
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &ServiceMockUpdatePreferencesExpectation{}
 	}
 
-	if mmGetAllUsers.defaultExpectation.paramPtrs != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by ExpectParams functions")
+	if mmUpdatePreferences.defaultExpectation.paramPtrs != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by ExpectParams functions")
 	}
 
-	mmGetAllUsers.defaultExpectation.params = &ServiceMockGetAllUsersParams{ctx}
-	mmGetAllUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetAllUsers.expectations {
-		if minimock.Equal(e.params, mmGetAllUsers.defaultExpectation.params) {
-			mmGetAllUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAllUsers.defaultExpectation.params)
+	mmUpdatePreferences.defaultExpectation.params = &ServiceMockUpdatePreferencesParams{ctx, userID, data}
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePreferences.expectations {
+		if minimock.Equal(e.params, mmUpdatePreferences.defaultExpectation.params) {
+			mmUpdatePreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePreferences.defaultExpectation.params)
 		}
 	}
 
-	return mmGetAllUsers
+	return mmUpdatePreferences
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.GetAllUsers
-func (mmGetAllUsers *mServiceMockGetAllUsers) ExpectCtxParam1(ctx context.Context) *mServiceMockGetAllUsers {
-	if mmGetAllUsers.mock.funcGetAllUsers != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) ExpectCtxParam1(ctx context.Context) *mServiceMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	if mmGetAllUsers.defaultExpectation == nil {
-		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{}
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &ServiceMockUpdatePreferencesExpectation{}
 	}
 
-	if mmGetAllUsers.defaultExpectation.params != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Expect")
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Expect")
 	}
 
-	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
-		mmGetAllUsers.defaultExpectation.paramPtrs = &ServiceMockGetAllUsersParamPtrs{}
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &ServiceMockUpdatePreferencesParamPtrs{}
 	}
-	mmGetAllUsers.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetAllUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdatePreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetAllUsers
+	return mmUpdatePreferences
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.GetAllUsers
-func (mmGetAllUsers *mServiceMockGetAllUsers) Inspect(f func(ctx context.Context)) *mServiceMockGetAllUsers {
-	if mmGetAllUsers.mock.inspectFuncGetAllUsers != nil {
-		mmGetAllUsers.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetAllUsers")
+// ExpectUserIDParam2 sets up expected param userID for Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	mmGetAllUsers.mock.inspectFuncGetAllUsers = f
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &ServiceMockUpdatePreferencesExpectation{}
+	}
 
-	return mmGetAllUsers
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Expect")
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &ServiceMockUpdatePreferencesParamPtrs{}
+	}
+	mmUpdatePreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdatePreferences
 }
 
-// Return sets up results that will be returned by Service.GetAllUsers
-func (mmGetAllUsers *mServiceMockGetAllUsers) Return(ua1 []user.User, err error) *ServiceMock {
-	if mmGetAllUsers.mock.funcGetAllUsers != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+// ExpectDataParam3 sets up expected param data for Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) ExpectDataParam3(data []byte) *mServiceMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	if mmGetAllUsers.defaultExpectation == nil {
-		mmGetAllUsers.defaultExpectation = &ServiceMockGetAllUsersExpectation{mock: mmGetAllUsers.mock}
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &ServiceMockUpdatePreferencesExpectation{}
 	}
-	mmGetAllUsers.defaultExpectation.results = &ServiceMockGetAllUsersResults{ua1, err}
-	mmGetAllUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetAllUsers.mock
+
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Expect")
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &ServiceMockUpdatePreferencesParamPtrs{}
+	}
+	mmUpdatePreferences.defaultExpectation.paramPtrs.data = &data
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmUpdatePreferences
 }
 
-// Set uses given function f to mock the Service.GetAllUsers method
-func (mmGetAllUsers *mServiceMockGetAllUsers) Set(f func(ctx context.Context) (ua1 []user.User, err error)) *ServiceMock {
-	if mmGetAllUsers.defaultExpectation != nil {
-		mmGetAllUsers.mock.t.Fatalf("Default expectation is already set for the Service.GetAllUsers method")
+// Inspect accepts an inspector function that has same arguments as the Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Inspect(f func(ctx context.Context, userID uuid.UUID, data []byte)) *mServiceMockUpdatePreferences {
+	if mmUpdatePreferences.mock.inspectFuncUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("Inspect function is already set for ServiceMock.UpdatePreferences")
 	}
 
-	if len(mmGetAllUsers.expectations) > 0 {
-		mmGetAllUsers.mock.t.Fatalf("Some expectations are already set for the Service.GetAllUsers method")
+	mmUpdatePreferences.mock.inspectFuncUpdatePreferences = f
+
+	return mmUpdatePreferences
+}
+
+// Return sets up results that will be returned by Service.UpdatePreferences
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Return(p1 user.Preferences, err error) *ServiceMock {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	mmGetAllUsers.mock.funcGetAllUsers = f
-	mmGetAllUsers.mock.funcGetAllUsersOrigin = minimock.CallerInfo(1)
-	return mmGetAllUsers.mock
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &ServiceMockUpdatePreferencesExpectation{mock: mmUpdatePreferences.mock}
+	}
+	mmUpdatePreferences.defaultExpectation.results = &ServiceMockUpdatePreferencesResults{p1, err}
+	mmUpdatePreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences.mock
 }
 
-// When sets expectation for the Service.GetAllUsers which will trigger the result defined by the following
+// Set uses given function f to mock the Service.UpdatePreferences method
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Set(f func(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error)) *ServiceMock {
+	if mmUpdatePreferences.defaultExpectation != nil {
+		mmUpdatePreferences.mock.t.Fatalf("Default expectation is already set for the Service.UpdatePreferences method")
+	}
+
+	if len(mmUpdatePreferences.expectations) > 0 {
+		mmUpdatePreferences.mock.t.Fatalf("Some expectations are already set for the Service.UpdatePreferences method")
+	}
+
+	mmUpdatePreferences.mock.funcUpdatePreferences = f
+	mmUpdatePreferences.mock.funcUpdatePreferencesOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences.mock
+}
+
+// When sets expectation for the Service.UpdatePreferences which will trigger the result defined by the following
 // Then helper
-func (mmGetAllUsers *mServiceMockGetAllUsers) When(ctx context.Context) *ServiceMockGetAllUsersExpectation {
-	if mmGetAllUsers.mock.funcGetAllUsers != nil {
-		mmGetAllUsers.mock.t.Fatalf("ServiceMock.GetAllUsers mock is already set by Set")
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) When(ctx context.Context, userID uuid.UUID, data []byte) *ServiceMockUpdatePreferencesExpectation {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("ServiceMock.UpdatePreferences mock is already set by Set")
 	}
 
-	expectation := &ServiceMockGetAllUsersExpectation{
-		mock:               mmGetAllUsers.mock,
-		params:             &ServiceMockGetAllUsersParams{ctx},
-		expectationOrigins: ServiceMockGetAllUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockUpdatePreferencesExpectation{
+		mock:               mmUpdatePreferences.mock,
+		params:             &ServiceMockUpdatePreferencesParams{ctx, userID, data},
+		expectationOrigins: ServiceMockUpdatePreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetAllUsers.expectations = append(mmGetAllUsers.expectations, expectation)
+	mmUpdatePreferences.expectations = append(mmUpdatePreferences.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.GetAllUsers return parameters for the expectation previously defined by the When method
-func (e *ServiceMockGetAllUsersExpectation) Then(ua1 []user.User, err error) *ServiceMock {
-	e.results = &ServiceMockGetAllUsersResults{ua1, err}
+// Then sets up Service.UpdatePreferences return parameters for the expectation previously defined by the When method
+func (e *ServiceMockUpdatePreferencesExpectation) Then(p1 user.Preferences, err error) *ServiceMock {
+	e.results = &ServiceMockUpdatePreferencesResults{p1, err}
 	return e.mock
 }
 
-// Times sets number of times Service.GetAllUsers should be invoked
-func (mmGetAllUsers *mServiceMockGetAllUsers) Times(n uint64) *mServiceMockGetAllUsers {
+// Times sets number of times Service.UpdatePreferences should be invoked
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Times(n uint64) *mServiceMockUpdatePreferences {
 	if n == 0 {
-		mmGetAllUsers.mock.t.Fatalf("Times of ServiceMock.GetAllUsers mock can not be zero")
+		mmUpdatePreferences.mock.t.Fatalf("Times of ServiceMock.UpdatePreferences mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetAllUsers.expectedInvocations, n)
-	mmGetAllUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetAllUsers
+	mm_atomic.StoreUint64(&mmUpdatePreferences.expectedInvocations, n)
+	mmUpdatePreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences
 }
 
-func (mmGetAllUsers *mServiceMockGetAllUsers) invocationsDone() bool {
-	if len(mmGetAllUsers.expectations) == 0 && mmGetAllUsers.defaultExpectation == nil && mmGetAllUsers.mock.funcGetAllUsers == nil {
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) invocationsDone() bool {
+	if len(mmUpdatePreferences.expectations) == 0 && mmUpdatePreferences.defaultExpectation == nil && mmUpdatePreferences.mock.funcUpdatePreferences == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.mock.afterGetAllUsersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetAllUsers.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePreferences.mock.afterUpdatePreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePreferences.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetAllUsers implements mm_http.Service
-func (mmGetAllUsers *ServiceMock) GetAllUsers(ctx context.Context) (ua1 []user.User, err error) {
-	mm_atomic.AddUint64(&mmGetAllUsers.beforeGetAllUsersCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetAllUsers.afterGetAllUsersCounter, 1)
+// UpdatePreferences implements mm_http.Service
+func (mmUpdatePreferences *ServiceMock) UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error) {
+	mm_atomic.AddUint64(&mmUpdatePreferences.beforeUpdatePreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePreferences.afterUpdatePreferencesCounter, 1)
 
-	mmGetAllUsers.t.Helper()
+	mmUpdatePreferences.t.Helper()
 
-	if mmGetAllUsers.inspectFuncGetAllUsers != nil {
-		mmGetAllUsers.inspectFuncGetAllUsers(ctx)
+	if mmUpdatePreferences.inspectFuncUpdatePreferences != nil {
+		mmUpdatePreferences.inspectFuncUpdatePreferences(ctx, userID, data)
 	}
 
-	mm_params := ServiceMockGetAllUsersParams{ctx}
+	mm_params := ServiceMockUpdatePreferencesParams{ctx, userID, data}
 
 	// Record call args
-	mmGetAllUsers.GetAllUsersMock.mutex.Lock()
-	mmGetAllUsers.GetAllUsersMock.callArgs = append(mmGetAllUsers.GetAllUsersMock.callArgs, &mm_params)
-	mmGetAllUsers.GetAllUsersMock.mutex.Unlock()
+	mmUpdatePreferences.UpdatePreferencesMock.mutex.Lock()
+	mmUpdatePreferences.UpdatePreferencesMock.callArgs = append(mmUpdatePreferences.UpdatePreferencesMock.callArgs, &mm_params)
+	mmUpdatePreferences.UpdatePreferencesMock.mutex.Unlock()
 
-	for _, e := range mmGetAllUsers.GetAllUsersMock.expectations {
+	for _, e := range mmUpdatePreferences.UpdatePreferencesMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.p1, e.results.err
 		}
 	}
 
-	if mmGetAllUsers.GetAllUsersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetAllUsers.GetAllUsersMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetAllUsers.GetAllUsersMock.defaultExpectation.params
-		mm_want_ptrs := mmGetAllUsers.GetAllUsersMock.defaultExpectation.paramPtrs
+	if mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockGetAllUsersParams{ctx}
+		mm_got := ServiceMockUpdatePreferencesParams{ctx, userID, data}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetAllUsers.t.Errorf("ServiceMock.GetAllUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdatePreferences.t.Errorf("ServiceMock.UpdatePreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdatePreferences.t.Errorf("ServiceMock.UpdatePreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmUpdatePreferences.t.Errorf("ServiceMock.UpdatePreferences got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetAllUsers.t.Errorf("ServiceMock.GetAllUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePreferences.t.Errorf("ServiceMock.UpdatePreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetAllUsers.GetAllUsersMock.defaultExpectation.results
+		mm_results := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetAllUsers.t.Fatal("No results are set for the ServiceMock.GetAllUsers")
+			mmUpdatePreferences.t.Fatal("No results are set for the ServiceMock.UpdatePreferences")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).p1, (*mm_results).err
 	}
-	if mmGetAllUsers.funcGetAllUsers != nil {
-		return mmGetAllUsers.funcGetAllUsers(ctx)
+	if mmUpdatePreferences.funcUpdatePreferences != nil {
+		return mmUpdatePreferences.funcUpdatePreferences(ctx, userID, data)
 	}
-	mmGetAllUsers.t.Fatalf("Unexpected call to ServiceMock.GetAllUsers. %v", ctx)
+	mmUpdatePreferences.t.Fatalf("Unexpected call to ServiceMock.UpdatePreferences. %v %v %v", ctx, userID, data)
 	return
 }
 
-// GetAllUsersAfterCounter returns a count of finished ServiceMock.GetAllUsers invocations
-func (mmGetAllUsers *ServiceMock) GetAllUsersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAllUsers.afterGetAllUsersCounter)
+// UpdatePreferencesAfterCounter returns a count of finished ServiceMock.UpdatePreferences invocations
+func (mmUpdatePreferences *ServiceMock) UpdatePreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreferences.afterUpdatePreferencesCounter)
 }
 
-// GetAllUsersBeforeCounter returns a count of ServiceMock.GetAllUsers invocations
-func (mmGetAllUsers *ServiceMock) GetAllUsersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAllUsers.beforeGetAllUsersCounter)
+// UpdatePreferencesBeforeCounter returns a count of ServiceMock.UpdatePreferences invocations
+func (mmUpdatePreferences *ServiceMock) UpdatePreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreferences.beforeUpdatePreferencesCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.GetAllUsers.
+// Calls returns a list of arguments used in each call to ServiceMock.UpdatePreferences.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetAllUsers *mServiceMockGetAllUsers) Calls() []*ServiceMockGetAllUsersParams {
-	mmGetAllUsers.mutex.RLock()
+func (mmUpdatePreferences *mServiceMockUpdatePreferences) Calls() []*ServiceMockUpdatePreferencesParams {
+	mmUpdatePreferences.mutex.RLock()
 
-	argCopy := make([]*ServiceMockGetAllUsersParams, len(mmGetAllUsers.callArgs))
-	copy(argCopy, mmGetAllUsers.callArgs)
+	argCopy := make([]*ServiceMockUpdatePreferencesParams, len(mmUpdatePreferences.callArgs))
+	copy(argCopy, mmUpdatePreferences.callArgs)
 
-	mmGetAllUsers.mutex.RUnlock()
+	mmUpdatePreferences.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetAllUsersDone returns true if the count of the GetAllUsers invocations corresponds
+// MinimockUpdatePreferencesDone returns true if the count of the UpdatePreferences invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockGetAllUsersDone() bool {
-	if m.GetAllUsersMock.optional {
+func (m *ServiceMock) MinimockUpdatePreferencesDone() bool {
+	if m.UpdatePreferencesMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetAllUsersMock.expectations {
+	for _, e := range m.UpdatePreferencesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetAllUsersMock.invocationsDone()
+	return m.UpdatePreferencesMock.invocationsDone()
 }
 
-// MinimockGetAllUsersInspect logs each unmet expectation
-func (m *ServiceMock) MinimockGetAllUsersInspect() {
-	for _, e := range m.GetAllUsersMock.expectations {
+// MinimockUpdatePreferencesInspect logs each unmet expectation
+func (m *ServiceMock) MinimockUpdatePreferencesInspect() {
+	for _, e := range m.UpdatePreferencesMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.UpdatePreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetAllUsersCounter := mm_atomic.LoadUint64(&m.afterGetAllUsersCounter)
+	afterUpdatePreferencesCounter := mm_atomic.LoadUint64(&m.afterUpdatePreferencesCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetAllUsersMock.defaultExpectation != nil && afterGetAllUsersCounter < 1 {
-		if m.GetAllUsersMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s", m.GetAllUsersMock.defaultExpectation.returnOrigin)
+	if m.UpdatePreferencesMock.defaultExpectation != nil && afterUpdatePreferencesCounter < 1 {
+		if m.UpdatePreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.UpdatePreferences at\n%s", m.UpdatePreferencesMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s with params: %#v", m.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *m.GetAllUsersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.UpdatePreferences at\n%s with params: %#v", m.UpdatePreferencesMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePreferencesMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetAllUsers != nil && afterGetAllUsersCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.GetAllUsers at\n%s", m.funcGetAllUsersOrigin)
+	if m.funcUpdatePreferences != nil && afterUpdatePreferencesCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.UpdatePreferences at\n%s", m.funcUpdatePreferencesOrigin)
 	}
 
-	if !m.GetAllUsersMock.invocationsDone() && afterGetAllUsersCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.GetAllUsers at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetAllUsersMock.expectedInvocations), m.GetAllUsersMock.expectedInvocationsOrigin, afterGetAllUsersCounter)
+	if !m.UpdatePreferencesMock.invocationsDone() && afterUpdatePreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.UpdatePreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePreferencesMock.expectedInvocations), m.UpdatePreferencesMock.expectedInvocationsOrigin, afterUpdatePreferencesCounter)
 	}
 }
 
-type mServiceMockGetUser struct {
+type mServiceMockUpdateProfile struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockGetUserExpectation
-	expectations       []*ServiceMockGetUserExpectation
+	defaultExpectation *ServiceMockUpdateProfileExpectation
+	expectations       []*ServiceMockUpdateProfileExpectation
 
-	callArgs []*ServiceMockGetUserParams
+	callArgs []*ServiceMockUpdateProfileParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockGetUserExpectation specifies expectation struct of the Service.GetUser
-type ServiceMockGetUserExpectation struct {
+// ServiceMockUpdateProfileExpectation specifies expectation struct of the Service.UpdateProfile
+type ServiceMockUpdateProfileExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockGetUserParams
-	paramPtrs          *ServiceMockGetUserParamPtrs
-	expectationOrigins ServiceMockGetUserExpectationOrigins
-	results            *ServiceMockGetUserResults
+	params             *ServiceMockUpdateProfileParams
+	paramPtrs          *ServiceMockUpdateProfileParamPtrs
+	expectationOrigins ServiceMockUpdateProfileExpectationOrigins
+	results            *ServiceMockUpdateProfileResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockGetUserParams contains parameters of the Service.GetUser
-type ServiceMockGetUserParams struct {
+// ServiceMockUpdateProfileParams contains parameters of the Service.UpdateProfile
+type ServiceMockUpdateProfileParams struct {
 	ctx context.Context
-	id  uuid.UUID
+	req user.UpdateProfileReq
 }
 
-// ServiceMockGetUserParamPtrs contains pointers to parameters of the Service.GetUser
-type ServiceMockGetUserParamPtrs struct {
+// ServiceMockUpdateProfileParamPtrs contains pointers to parameters of the Service.UpdateProfile
+type ServiceMockUpdateProfileParamPtrs struct {
 	ctx *context.Context
-	id  *uuid.UUID
+	req *user.UpdateProfileReq
 }
 
-// ServiceMockGetUserResults contains results of the Service.GetUser
-type ServiceMockGetUserResults struct {
-	u1  user.User
+// ServiceMockUpdateProfileResults contains results of the Service.UpdateProfile
+type ServiceMockUpdateProfileResults struct {
 	err error
 }
 
-// ServiceMockGetUserOrigins contains origins of expectations of the Service.GetUser
-type ServiceMockGetUserExpectationOrigins struct {
+// ServiceMockUpdateProfileOrigins contains origins of expectations of the Service.UpdateProfile
+type ServiceMockUpdateProfileExpectationOrigins struct {
 	origin    string
 	originCtx string
-	originId  string
+	originReq string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1487,292 +4029,292 @@ type ServiceMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mServiceMockGetUser) Optional() *mServiceMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmUpdateProfile *mServiceMockUpdateProfile) Optional() *mServiceMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
 }
 
-// Expect sets up expected params for Service.GetUser
-func (mmGetUser *mServiceMockGetUser) Expect(ctx context.Context, id uuid.UUID) *mServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+// Expect sets up expected params for Service.UpdateProfile
+func (mmUpdateProfile *mServiceMockUpdateProfile) Expect(ctx context.Context, req user.UpdateProfileReq) *mServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &ServiceMockUpdateProfileExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by ExpectParams functions")
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &ServiceMockGetUserParams{ctx, id}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmUpdateProfile.defaultExpectation.params = &ServiceMockUpdateProfileParams{ctx, req}
+	mmUpdateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmUpdateProfile
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.GetUser
-func (mmGetUser *mServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.UpdateProfile
+func (mmUpdateProfile *mServiceMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &ServiceMockUpdateProfileExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Expect")
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &ServiceMockGetUserParamPtrs{}
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &ServiceMockUpdateProfileParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmUpdateProfile
 }
 
-// ExpectIdParam2 sets up expected param id for Service.GetUser
-func (mmGetUser *mServiceMockGetUser) ExpectIdParam2(id uuid.UUID) *mServiceMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Service.UpdateProfile
+func (mmUpdateProfile *mServiceMockUpdateProfile) ExpectReqParam2(req user.UpdateProfileReq) *mServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{}
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &ServiceMockUpdateProfileExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Expect")
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Expect")
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &ServiceMockGetUserParamPtrs{}
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &ServiceMockUpdateProfileParamPtrs{}
 	}
-	mmGetUser.defaultExpectation.paramPtrs.id = &id
-	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmUpdateProfile.defaultExpectation.paramPtrs.req = &req
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmGetUser
+	return mmUpdateProfile
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.GetUser
-func (mmGetUser *mServiceMockGetUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mServiceMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetUser")
+// Inspect accepts an inspector function that has same arguments as the Service.UpdateProfile
+func (mmUpdateProfile *mServiceMockUpdateProfile) Inspect(f func(ctx context.Context, req user.UpdateProfileReq)) *mServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for ServiceMock.UpdateProfile")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
 
-	return mmGetUser
+	return mmUpdateProfile
 }
 
-// Return sets up results that will be returned by Service.GetUser
-func (mmGetUser *mServiceMockGetUser) Return(u1 user.User, err error) *ServiceMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+// Return sets up results that will be returned by Service.UpdateProfile
+func (mmUpdateProfile *mServiceMockUpdateProfile) Return(err error) *ServiceMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &ServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &ServiceMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
 	}
-	mmGetUser.defaultExpectation.results = &ServiceMockGetUserResults{u1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	mmUpdateProfile.defaultExpectation.results = &ServiceMockUpdateProfileResults{err}
+	mmUpdateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
 }
 
-// Set uses given function f to mock the Service.GetUser method
-func (mmGetUser *mServiceMockGetUser) Set(f func(ctx context.Context, id uuid.UUID) (u1 user.User, err error)) *ServiceMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the Service.GetUser method")
+// Set uses given function f to mock the Service.UpdateProfile method
+func (mmUpdateProfile *mServiceMockUpdateProfile) Set(f func(ctx context.Context, req user.UpdateProfileReq) (err error)) *ServiceMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the Service.UpdateProfile method")
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the Service.GetUser method")
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the Service.UpdateProfile method")
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	mmUpdateProfile.mock.funcUpdateProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
 }
 
-// When sets expectation for the Service.GetUser which will trigger the result defined by the following
+// When sets expectation for the Service.UpdateProfile which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mServiceMockGetUser) When(ctx context.Context, id uuid.UUID) *ServiceMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("ServiceMock.GetUser mock is already set by Set")
+func (mmUpdateProfile *mServiceMockUpdateProfile) When(ctx context.Context, req user.UpdateProfileReq) *ServiceMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("ServiceMock.UpdateProfile mock is already set by Set")
 	}
 
-	expectation := &ServiceMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &ServiceMockGetUserParams{ctx, id},
-		expectationOrigins: ServiceMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockUpdateProfileExpectation{
+		mock:               mmUpdateProfile.mock,
+		params:             &ServiceMockUpdateProfileParams{ctx, req},
+		expectationOrigins: ServiceMockUpdateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.GetUser return parameters for the expectation previously defined by the When method
-func (e *ServiceMockGetUserExpectation) Then(u1 user.User, err error) *ServiceMock {
-	e.results = &ServiceMockGetUserResults{u1, err}
+// Then sets up Service.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *ServiceMockUpdateProfileExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockUpdateProfileResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.GetUser should be invoked
-func (mmGetUser *mServiceMockGetUser) Times(n uint64) *mServiceMockGetUser {
+// Times sets number of times Service.UpdateProfile should be invoked
+func (mmUpdateProfile *mServiceMockUpdateProfile) Times(n uint64) *mServiceMockUpdateProfile {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of ServiceMock.GetUser mock can not be zero")
+		mmUpdateProfile.mock.t.Fatalf("Times of ServiceMock.UpdateProfile mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	mmUpdateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile
 }
-
-func (mmGetUser *mServiceMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+
+func (mmUpdateProfile *mServiceMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements mm_http.Service
-func (mmGetUser *ServiceMock) GetUser(ctx context.Context, id uuid.UUID) (u1 user.User, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// UpdateProfile implements mm_http.Service
+func (mmUpdateProfile *ServiceMock) UpdateProfile(ctx context.Context, req user.UpdateProfileReq) (err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
 
-	mmGetUser.t.Helper()
+	mmUpdateProfile.t.Helper()
 
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, id)
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, req)
 	}
 
-	mm_params := ServiceMockGetUserParams{ctx, id}
+	mm_params := ServiceMockUpdateProfileParams{ctx, req}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockGetUserParams{ctx, id}
+		mm_got := ServiceMockUpdateProfileParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateProfile.t.Errorf("ServiceMock.UpdateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdateProfile.t.Errorf("ServiceMock.UpdateProfile got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("ServiceMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateProfile.t.Errorf("ServiceMock.UpdateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the ServiceMock.GetUser")
+			mmUpdateProfile.t.Fatal("No results are set for the ServiceMock.UpdateProfile")
 		}
-		return (*mm_results).u1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, id)
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, req)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to ServiceMock.GetUser. %v %v", ctx, id)
+	mmUpdateProfile.t.Fatalf("Unexpected call to ServiceMock.UpdateProfile. %v %v", ctx, req)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished ServiceMock.GetUser invocations
-func (mmGetUser *ServiceMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// UpdateProfileAfterCounter returns a count of finished ServiceMock.UpdateProfile invocations
+func (mmUpdateProfile *ServiceMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
 }
 
-// GetUserBeforeCounter returns a count of ServiceMock.GetUser invocations
-func (mmGetUser *ServiceMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// UpdateProfileBeforeCounter returns a count of ServiceMock.UpdateProfile invocations
+func (mmUpdateProfile *ServiceMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.GetUser.
+// Calls returns a list of arguments used in each call to ServiceMock.UpdateProfile.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mServiceMockGetUser) Calls() []*ServiceMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmUpdateProfile *mServiceMockUpdateProfile) Calls() []*ServiceMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
 
-	argCopy := make([]*ServiceMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*ServiceMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmUpdateProfile.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *ServiceMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.UpdateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.UpdateProfileMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *ServiceMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *ServiceMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.UpdateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.UpdateProfile at\n%s", m.UpdateProfileMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.UpdateProfile at\n%s with params: %#v", m.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateProfileMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.UpdateProfile at\n%s", m.funcUpdateProfileOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.UpdateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), m.UpdateProfileMock.expectedInvocationsOrigin, afterUpdateProfileCounter)
 	}
 }
 
@@ -2118,21 +4660,410 @@ func (m *ServiceMock) MinimockUpdateUserInspect() {
 	}
 }
 
+type mServiceMockUploadAvatar struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockUploadAvatarExpectation
+	expectations       []*ServiceMockUploadAvatarExpectation
+
+	callArgs []*ServiceMockUploadAvatarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockUploadAvatarExpectation specifies expectation struct of the Service.UploadAvatar
+type ServiceMockUploadAvatarExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockUploadAvatarParams
+	paramPtrs          *ServiceMockUploadAvatarParamPtrs
+	expectationOrigins ServiceMockUploadAvatarExpectationOrigins
+	results            *ServiceMockUploadAvatarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockUploadAvatarParams contains parameters of the Service.UploadAvatar
+type ServiceMockUploadAvatarParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	data   []byte
+}
+
+// ServiceMockUploadAvatarParamPtrs contains pointers to parameters of the Service.UploadAvatar
+type ServiceMockUploadAvatarParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	data   *[]byte
+}
+
+// ServiceMockUploadAvatarResults contains results of the Service.UploadAvatar
+type ServiceMockUploadAvatarResults struct {
+	err error
+}
+
+// ServiceMockUploadAvatarOrigins contains origins of expectations of the Service.UploadAvatar
+type ServiceMockUploadAvatarExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originData   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUploadAvatar *mServiceMockUploadAvatar) Optional() *mServiceMockUploadAvatar {
+	mmUploadAvatar.optional = true
+	return mmUploadAvatar
+}
+
+// Expect sets up expected params for Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) Expect(ctx context.Context, userID uuid.UUID, data []byte) *mServiceMockUploadAvatar {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	if mmUploadAvatar.defaultExpectation == nil {
+		mmUploadAvatar.defaultExpectation = &ServiceMockUploadAvatarExpectation{}
+	}
+
+	if mmUploadAvatar.defaultExpectation.paramPtrs != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by ExpectParams functions")
+	}
+
+	mmUploadAvatar.defaultExpectation.params = &ServiceMockUploadAvatarParams{ctx, userID, data}
+	mmUploadAvatar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUploadAvatar.expectations {
+		if minimock.Equal(e.params, mmUploadAvatar.defaultExpectation.params) {
+			mmUploadAvatar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadAvatar.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadAvatar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) ExpectCtxParam1(ctx context.Context) *mServiceMockUploadAvatar {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	if mmUploadAvatar.defaultExpectation == nil {
+		mmUploadAvatar.defaultExpectation = &ServiceMockUploadAvatarExpectation{}
+	}
+
+	if mmUploadAvatar.defaultExpectation.params != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Expect")
+	}
+
+	if mmUploadAvatar.defaultExpectation.paramPtrs == nil {
+		mmUploadAvatar.defaultExpectation.paramPtrs = &ServiceMockUploadAvatarParamPtrs{}
+	}
+	mmUploadAvatar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUploadAvatar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUploadAvatar
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) ExpectUserIDParam2(userID uuid.UUID) *mServiceMockUploadAvatar {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	if mmUploadAvatar.defaultExpectation == nil {
+		mmUploadAvatar.defaultExpectation = &ServiceMockUploadAvatarExpectation{}
+	}
+
+	if mmUploadAvatar.defaultExpectation.params != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Expect")
+	}
+
+	if mmUploadAvatar.defaultExpectation.paramPtrs == nil {
+		mmUploadAvatar.defaultExpectation.paramPtrs = &ServiceMockUploadAvatarParamPtrs{}
+	}
+	mmUploadAvatar.defaultExpectation.paramPtrs.userID = &userID
+	mmUploadAvatar.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUploadAvatar
+}
+
+// ExpectDataParam3 sets up expected param data for Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) ExpectDataParam3(data []byte) *mServiceMockUploadAvatar {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	if mmUploadAvatar.defaultExpectation == nil {
+		mmUploadAvatar.defaultExpectation = &ServiceMockUploadAvatarExpectation{}
+	}
+
+	if mmUploadAvatar.defaultExpectation.params != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Expect")
+	}
+
+	if mmUploadAvatar.defaultExpectation.paramPtrs == nil {
+		mmUploadAvatar.defaultExpectation.paramPtrs = &ServiceMockUploadAvatarParamPtrs{}
+	}
+	mmUploadAvatar.defaultExpectation.paramPtrs.data = &data
+	mmUploadAvatar.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmUploadAvatar
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) Inspect(f func(ctx context.Context, userID uuid.UUID, data []byte)) *mServiceMockUploadAvatar {
+	if mmUploadAvatar.mock.inspectFuncUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("Inspect function is already set for ServiceMock.UploadAvatar")
+	}
+
+	mmUploadAvatar.mock.inspectFuncUploadAvatar = f
+
+	return mmUploadAvatar
+}
+
+// Return sets up results that will be returned by Service.UploadAvatar
+func (mmUploadAvatar *mServiceMockUploadAvatar) Return(err error) *ServiceMock {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	if mmUploadAvatar.defaultExpectation == nil {
+		mmUploadAvatar.defaultExpectation = &ServiceMockUploadAvatarExpectation{mock: mmUploadAvatar.mock}
+	}
+	mmUploadAvatar.defaultExpectation.results = &ServiceMockUploadAvatarResults{err}
+	mmUploadAvatar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUploadAvatar.mock
+}
+
+// Set uses given function f to mock the Service.UploadAvatar method
+func (mmUploadAvatar *mServiceMockUploadAvatar) Set(f func(ctx context.Context, userID uuid.UUID, data []byte) (err error)) *ServiceMock {
+	if mmUploadAvatar.defaultExpectation != nil {
+		mmUploadAvatar.mock.t.Fatalf("Default expectation is already set for the Service.UploadAvatar method")
+	}
+
+	if len(mmUploadAvatar.expectations) > 0 {
+		mmUploadAvatar.mock.t.Fatalf("Some expectations are already set for the Service.UploadAvatar method")
+	}
+
+	mmUploadAvatar.mock.funcUploadAvatar = f
+	mmUploadAvatar.mock.funcUploadAvatarOrigin = minimock.CallerInfo(1)
+	return mmUploadAvatar.mock
+}
+
+// When sets expectation for the Service.UploadAvatar which will trigger the result defined by the following
+// Then helper
+func (mmUploadAvatar *mServiceMockUploadAvatar) When(ctx context.Context, userID uuid.UUID, data []byte) *ServiceMockUploadAvatarExpectation {
+	if mmUploadAvatar.mock.funcUploadAvatar != nil {
+		mmUploadAvatar.mock.t.Fatalf("ServiceMock.UploadAvatar mock is already set by Set")
+	}
+
+	expectation := &ServiceMockUploadAvatarExpectation{
+		mock:               mmUploadAvatar.mock,
+		params:             &ServiceMockUploadAvatarParams{ctx, userID, data},
+		expectationOrigins: ServiceMockUploadAvatarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUploadAvatar.expectations = append(mmUploadAvatar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.UploadAvatar return parameters for the expectation previously defined by the When method
+func (e *ServiceMockUploadAvatarExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockUploadAvatarResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.UploadAvatar should be invoked
+func (mmUploadAvatar *mServiceMockUploadAvatar) Times(n uint64) *mServiceMockUploadAvatar {
+	if n == 0 {
+		mmUploadAvatar.mock.t.Fatalf("Times of ServiceMock.UploadAvatar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUploadAvatar.expectedInvocations, n)
+	mmUploadAvatar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUploadAvatar
+}
+
+func (mmUploadAvatar *mServiceMockUploadAvatar) invocationsDone() bool {
+	if len(mmUploadAvatar.expectations) == 0 && mmUploadAvatar.defaultExpectation == nil && mmUploadAvatar.mock.funcUploadAvatar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadAvatar.mock.afterUploadAvatarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadAvatar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UploadAvatar implements mm_http.Service
+func (mmUploadAvatar *ServiceMock) UploadAvatar(ctx context.Context, userID uuid.UUID, data []byte) (err error) {
+	mm_atomic.AddUint64(&mmUploadAvatar.beforeUploadAvatarCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadAvatar.afterUploadAvatarCounter, 1)
+
+	mmUploadAvatar.t.Helper()
+
+	if mmUploadAvatar.inspectFuncUploadAvatar != nil {
+		mmUploadAvatar.inspectFuncUploadAvatar(ctx, userID, data)
+	}
+
+	mm_params := ServiceMockUploadAvatarParams{ctx, userID, data}
+
+	// Record call args
+	mmUploadAvatar.UploadAvatarMock.mutex.Lock()
+	mmUploadAvatar.UploadAvatarMock.callArgs = append(mmUploadAvatar.UploadAvatarMock.callArgs, &mm_params)
+	mmUploadAvatar.UploadAvatarMock.mutex.Unlock()
+
+	for _, e := range mmUploadAvatar.UploadAvatarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUploadAvatar.UploadAvatarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadAvatar.UploadAvatarMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadAvatar.UploadAvatarMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadAvatar.UploadAvatarMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockUploadAvatarParams{ctx, userID, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUploadAvatar.t.Errorf("ServiceMock.UploadAvatar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAvatar.UploadAvatarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUploadAvatar.t.Errorf("ServiceMock.UploadAvatar got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAvatar.UploadAvatarMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmUploadAvatar.t.Errorf("ServiceMock.UploadAvatar got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAvatar.UploadAvatarMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadAvatar.t.Errorf("ServiceMock.UploadAvatar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUploadAvatar.UploadAvatarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadAvatar.UploadAvatarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadAvatar.t.Fatal("No results are set for the ServiceMock.UploadAvatar")
+		}
+		return (*mm_results).err
+	}
+	if mmUploadAvatar.funcUploadAvatar != nil {
+		return mmUploadAvatar.funcUploadAvatar(ctx, userID, data)
+	}
+	mmUploadAvatar.t.Fatalf("Unexpected call to ServiceMock.UploadAvatar. %v %v %v", ctx, userID, data)
+	return
+}
+
+// UploadAvatarAfterCounter returns a count of finished ServiceMock.UploadAvatar invocations
+func (mmUploadAvatar *ServiceMock) UploadAvatarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAvatar.afterUploadAvatarCounter)
+}
+
+// UploadAvatarBeforeCounter returns a count of ServiceMock.UploadAvatar invocations
+func (mmUploadAvatar *ServiceMock) UploadAvatarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAvatar.beforeUploadAvatarCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.UploadAvatar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadAvatar *mServiceMockUploadAvatar) Calls() []*ServiceMockUploadAvatarParams {
+	mmUploadAvatar.mutex.RLock()
+
+	argCopy := make([]*ServiceMockUploadAvatarParams, len(mmUploadAvatar.callArgs))
+	copy(argCopy, mmUploadAvatar.callArgs)
+
+	mmUploadAvatar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadAvatarDone returns true if the count of the UploadAvatar invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockUploadAvatarDone() bool {
+	if m.UploadAvatarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadAvatarMock.invocationsDone()
+}
+
+// MinimockUploadAvatarInspect logs each unmet expectation
+func (m *ServiceMock) MinimockUploadAvatarInspect() {
+	for _, e := range m.UploadAvatarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.UploadAvatar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUploadAvatarCounter := mm_atomic.LoadUint64(&m.afterUploadAvatarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadAvatarMock.defaultExpectation != nil && afterUploadAvatarCounter < 1 {
+		if m.UploadAvatarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.UploadAvatar at\n%s", m.UploadAvatarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.UploadAvatar at\n%s with params: %#v", m.UploadAvatarMock.defaultExpectation.expectationOrigins.origin, *m.UploadAvatarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadAvatar != nil && afterUploadAvatarCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.UploadAvatar at\n%s", m.funcUploadAvatarOrigin)
+	}
+
+	if !m.UploadAvatarMock.invocationsDone() && afterUploadAvatarCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.UploadAvatar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadAvatarMock.expectedInvocations), m.UploadAvatarMock.expectedInvocationsOrigin, afterUploadAvatarCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAdminCreateUserInspect()
+
+			m.MinimockAnonymizeUserInspect()
+
 			m.MinimockChangePasswordInspect()
 
 			m.MinimockCreateUserInspect()
 
+			m.MinimockDeleteAvatarInspect()
+
 			m.MinimockDeleteUserInspect()
 
 			m.MinimockGetAllUsersInspect()
 
+			m.MinimockGetAvatarInspect()
+
+			m.MinimockGetPreferencesInspect()
+
 			m.MinimockGetUserInspect()
 
+			m.MinimockUpdatePreferencesInspect()
+
+			m.MinimockUpdateProfileInspect()
+
 			m.MinimockUpdateUserInspect()
+
+			m.MinimockUploadAvatarInspect()
 		}
 	})
 }
@@ -2156,10 +5087,18 @@ func (m *ServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAdminCreateUserDone() &&
+		m.MinimockAnonymizeUserDone() &&
 		m.MinimockChangePasswordDone() &&
 		m.MinimockCreateUserDone() &&
+		m.MinimockDeleteAvatarDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetAllUsersDone() &&
+		m.MinimockGetAvatarDone() &&
+		m.MinimockGetPreferencesDone() &&
 		m.MinimockGetUserDone() &&
-		m.MinimockUpdateUserDone()
+		m.MinimockUpdatePreferencesDone() &&
+		m.MinimockUpdateProfileDone() &&
+		m.MinimockUpdateUserDone() &&
+		m.MinimockUploadAvatarDone()
 }
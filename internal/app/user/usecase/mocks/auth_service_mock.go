@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -19,13 +19,6 @@ type AuthServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCheckIsAdmin          func(ctx context.Context) (err error)
-	funcCheckIsAdminOrigin    string
-	inspectFuncCheckIsAdmin   func(ctx context.Context)
-	afterCheckIsAdminCounter  uint64
-	beforeCheckIsAdminCounter uint64
-	CheckIsAdminMock          mAuthServiceMockCheckIsAdmin
-
 	funcCheckSelf          func(ctx context.Context, targetUserID uuid.UUID) (err error)
 	funcCheckSelfOrigin    string
 	inspectFuncCheckSelf   func(ctx context.Context, targetUserID uuid.UUID)
@@ -47,6 +40,13 @@ type AuthServiceMock struct {
 	beforeDeleteSessionsByUserIDCounter uint64
 	DeleteSessionsByUserIDMock          mAuthServiceMockDeleteSessionsByUserID
 
+	funcDeleteUserRoles          func(ctx context.Context, userID uuid.UUID) (i1 int64, err error)
+	funcDeleteUserRolesOrigin    string
+	inspectFuncDeleteUserRoles   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteUserRolesCounter  uint64
+	beforeDeleteUserRolesCounter uint64
+	DeleteUserRolesMock          mAuthServiceMockDeleteUserRoles
+
 	funcIsAdmin          func(ctx context.Context) (b1 bool, err error)
 	funcIsAdminOrigin    string
 	inspectFuncIsAdmin   func(ctx context.Context)
@@ -63,9 +63,6 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.CheckIsAdminMock = mAuthServiceMockCheckIsAdmin{mock: m}
-	m.CheckIsAdminMock.callArgs = []*AuthServiceMockCheckIsAdminParams{}
-
 	m.CheckSelfMock = mAuthServiceMockCheckSelf{mock: m}
 	m.CheckSelfMock.callArgs = []*AuthServiceMockCheckSelfParams{}
 
@@ -75,6 +72,9 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 	m.DeleteSessionsByUserIDMock = mAuthServiceMockDeleteSessionsByUserID{mock: m}
 	m.DeleteSessionsByUserIDMock.callArgs = []*AuthServiceMockDeleteSessionsByUserIDParams{}
 
+	m.DeleteUserRolesMock = mAuthServiceMockDeleteUserRoles{mock: m}
+	m.DeleteUserRolesMock.callArgs = []*AuthServiceMockDeleteUserRolesParams{}
+
 	m.IsAdminMock = mAuthServiceMockIsAdmin{mock: m}
 	m.IsAdminMock.callArgs = []*AuthServiceMockIsAdminParams{}
 
@@ -83,317 +83,6 @@ func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
 	return m
 }
 
-type mAuthServiceMockCheckIsAdmin struct {
-	optional           bool
-	mock               *AuthServiceMock
-	defaultExpectation *AuthServiceMockCheckIsAdminExpectation
-	expectations       []*AuthServiceMockCheckIsAdminExpectation
-
-	callArgs []*AuthServiceMockCheckIsAdminParams
-	mutex    sync.RWMutex
-
-	expectedInvocations       uint64
-	expectedInvocationsOrigin string
-}
-
-// AuthServiceMockCheckIsAdminExpectation specifies expectation struct of the AuthService.CheckIsAdmin
-type AuthServiceMockCheckIsAdminExpectation struct {
-	mock               *AuthServiceMock
-	params             *AuthServiceMockCheckIsAdminParams
-	paramPtrs          *AuthServiceMockCheckIsAdminParamPtrs
-	expectationOrigins AuthServiceMockCheckIsAdminExpectationOrigins
-	results            *AuthServiceMockCheckIsAdminResults
-	returnOrigin       string
-	Counter            uint64
-}
-
-// AuthServiceMockCheckIsAdminParams contains parameters of the AuthService.CheckIsAdmin
-type AuthServiceMockCheckIsAdminParams struct {
-	ctx context.Context
-}
-
-// AuthServiceMockCheckIsAdminParamPtrs contains pointers to parameters of the AuthService.CheckIsAdmin
-type AuthServiceMockCheckIsAdminParamPtrs struct {
-	ctx *context.Context
-}
-
-// AuthServiceMockCheckIsAdminResults contains results of the AuthService.CheckIsAdmin
-type AuthServiceMockCheckIsAdminResults struct {
-	err error
-}
-
-// AuthServiceMockCheckIsAdminOrigins contains origins of expectations of the AuthService.CheckIsAdmin
-type AuthServiceMockCheckIsAdminExpectationOrigins struct {
-	origin    string
-	originCtx string
-}
-
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Optional() *mAuthServiceMockCheckIsAdmin {
-	mmCheckIsAdmin.optional = true
-	return mmCheckIsAdmin
-}
-
-// Expect sets up expected params for AuthService.CheckIsAdmin
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Expect(ctx context.Context) *mAuthServiceMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by Set")
-	}
-
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &AuthServiceMockCheckIsAdminExpectation{}
-	}
-
-	if mmCheckIsAdmin.defaultExpectation.paramPtrs != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by ExpectParams functions")
-	}
-
-	mmCheckIsAdmin.defaultExpectation.params = &AuthServiceMockCheckIsAdminParams{ctx}
-	mmCheckIsAdmin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCheckIsAdmin.expectations {
-		if minimock.Equal(e.params, mmCheckIsAdmin.defaultExpectation.params) {
-			mmCheckIsAdmin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckIsAdmin.defaultExpectation.params)
-		}
-	}
-
-	return mmCheckIsAdmin
-}
-
-// ExpectCtxParam1 sets up expected param ctx for AuthService.CheckIsAdmin
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by Set")
-	}
-
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &AuthServiceMockCheckIsAdminExpectation{}
-	}
-
-	if mmCheckIsAdmin.defaultExpectation.params != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by Expect")
-	}
-
-	if mmCheckIsAdmin.defaultExpectation.paramPtrs == nil {
-		mmCheckIsAdmin.defaultExpectation.paramPtrs = &AuthServiceMockCheckIsAdminParamPtrs{}
-	}
-	mmCheckIsAdmin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCheckIsAdmin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmCheckIsAdmin
-}
-
-// Inspect accepts an inspector function that has same arguments as the AuthService.CheckIsAdmin
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Inspect(f func(ctx context.Context)) *mAuthServiceMockCheckIsAdmin {
-	if mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.CheckIsAdmin")
-	}
-
-	mmCheckIsAdmin.mock.inspectFuncCheckIsAdmin = f
-
-	return mmCheckIsAdmin
-}
-
-// Return sets up results that will be returned by AuthService.CheckIsAdmin
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Return(err error) *AuthServiceMock {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by Set")
-	}
-
-	if mmCheckIsAdmin.defaultExpectation == nil {
-		mmCheckIsAdmin.defaultExpectation = &AuthServiceMockCheckIsAdminExpectation{mock: mmCheckIsAdmin.mock}
-	}
-	mmCheckIsAdmin.defaultExpectation.results = &AuthServiceMockCheckIsAdminResults{err}
-	mmCheckIsAdmin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin.mock
-}
-
-// Set uses given function f to mock the AuthService.CheckIsAdmin method
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Set(f func(ctx context.Context) (err error)) *AuthServiceMock {
-	if mmCheckIsAdmin.defaultExpectation != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("Default expectation is already set for the AuthService.CheckIsAdmin method")
-	}
-
-	if len(mmCheckIsAdmin.expectations) > 0 {
-		mmCheckIsAdmin.mock.t.Fatalf("Some expectations are already set for the AuthService.CheckIsAdmin method")
-	}
-
-	mmCheckIsAdmin.mock.funcCheckIsAdmin = f
-	mmCheckIsAdmin.mock.funcCheckIsAdminOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin.mock
-}
-
-// When sets expectation for the AuthService.CheckIsAdmin which will trigger the result defined by the following
-// Then helper
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) When(ctx context.Context) *AuthServiceMockCheckIsAdminExpectation {
-	if mmCheckIsAdmin.mock.funcCheckIsAdmin != nil {
-		mmCheckIsAdmin.mock.t.Fatalf("AuthServiceMock.CheckIsAdmin mock is already set by Set")
-	}
-
-	expectation := &AuthServiceMockCheckIsAdminExpectation{
-		mock:               mmCheckIsAdmin.mock,
-		params:             &AuthServiceMockCheckIsAdminParams{ctx},
-		expectationOrigins: AuthServiceMockCheckIsAdminExpectationOrigins{origin: minimock.CallerInfo(1)},
-	}
-	mmCheckIsAdmin.expectations = append(mmCheckIsAdmin.expectations, expectation)
-	return expectation
-}
-
-// Then sets up AuthService.CheckIsAdmin return parameters for the expectation previously defined by the When method
-func (e *AuthServiceMockCheckIsAdminExpectation) Then(err error) *AuthServiceMock {
-	e.results = &AuthServiceMockCheckIsAdminResults{err}
-	return e.mock
-}
-
-// Times sets number of times AuthService.CheckIsAdmin should be invoked
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Times(n uint64) *mAuthServiceMockCheckIsAdmin {
-	if n == 0 {
-		mmCheckIsAdmin.mock.t.Fatalf("Times of AuthServiceMock.CheckIsAdmin mock can not be zero")
-	}
-	mm_atomic.StoreUint64(&mmCheckIsAdmin.expectedInvocations, n)
-	mmCheckIsAdmin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCheckIsAdmin
-}
-
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) invocationsDone() bool {
-	if len(mmCheckIsAdmin.expectations) == 0 && mmCheckIsAdmin.defaultExpectation == nil && mmCheckIsAdmin.mock.funcCheckIsAdmin == nil {
-		return true
-	}
-
-	totalInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.mock.afterCheckIsAdminCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCheckIsAdmin.expectedInvocations)
-
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
-
-// CheckIsAdmin implements mm_usecase.AuthService
-func (mmCheckIsAdmin *AuthServiceMock) CheckIsAdmin(ctx context.Context) (err error) {
-	mm_atomic.AddUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter, 1)
-	defer mm_atomic.AddUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter, 1)
-
-	mmCheckIsAdmin.t.Helper()
-
-	if mmCheckIsAdmin.inspectFuncCheckIsAdmin != nil {
-		mmCheckIsAdmin.inspectFuncCheckIsAdmin(ctx)
-	}
-
-	mm_params := AuthServiceMockCheckIsAdminParams{ctx}
-
-	// Record call args
-	mmCheckIsAdmin.CheckIsAdminMock.mutex.Lock()
-	mmCheckIsAdmin.CheckIsAdminMock.callArgs = append(mmCheckIsAdmin.CheckIsAdminMock.callArgs, &mm_params)
-	mmCheckIsAdmin.CheckIsAdminMock.mutex.Unlock()
-
-	for _, e := range mmCheckIsAdmin.CheckIsAdminMock.expectations {
-		if minimock.Equal(*e.params, mm_params) {
-			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
-		}
-	}
-
-	if mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.Counter, 1)
-		mm_want := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.params
-		mm_want_ptrs := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.paramPtrs
-
-		mm_got := AuthServiceMockCheckIsAdminParams{ctx}
-
-		if mm_want_ptrs != nil {
-
-			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCheckIsAdmin.t.Errorf("AuthServiceMock.CheckIsAdmin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCheckIsAdmin.t.Errorf("AuthServiceMock.CheckIsAdmin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
-		}
-
-		mm_results := mmCheckIsAdmin.CheckIsAdminMock.defaultExpectation.results
-		if mm_results == nil {
-			mmCheckIsAdmin.t.Fatal("No results are set for the AuthServiceMock.CheckIsAdmin")
-		}
-		return (*mm_results).err
-	}
-	if mmCheckIsAdmin.funcCheckIsAdmin != nil {
-		return mmCheckIsAdmin.funcCheckIsAdmin(ctx)
-	}
-	mmCheckIsAdmin.t.Fatalf("Unexpected call to AuthServiceMock.CheckIsAdmin. %v", ctx)
-	return
-}
-
-// CheckIsAdminAfterCounter returns a count of finished AuthServiceMock.CheckIsAdmin invocations
-func (mmCheckIsAdmin *AuthServiceMock) CheckIsAdminAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckIsAdmin.afterCheckIsAdminCounter)
-}
-
-// CheckIsAdminBeforeCounter returns a count of AuthServiceMock.CheckIsAdmin invocations
-func (mmCheckIsAdmin *AuthServiceMock) CheckIsAdminBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCheckIsAdmin.beforeCheckIsAdminCounter)
-}
-
-// Calls returns a list of arguments used in each call to AuthServiceMock.CheckIsAdmin.
-// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCheckIsAdmin *mAuthServiceMockCheckIsAdmin) Calls() []*AuthServiceMockCheckIsAdminParams {
-	mmCheckIsAdmin.mutex.RLock()
-
-	argCopy := make([]*AuthServiceMockCheckIsAdminParams, len(mmCheckIsAdmin.callArgs))
-	copy(argCopy, mmCheckIsAdmin.callArgs)
-
-	mmCheckIsAdmin.mutex.RUnlock()
-
-	return argCopy
-}
-
-// MinimockCheckIsAdminDone returns true if the count of the CheckIsAdmin invocations corresponds
-// the number of defined expectations
-func (m *AuthServiceMock) MinimockCheckIsAdminDone() bool {
-	if m.CheckIsAdminMock.optional {
-		// Optional methods provide '0 or more' call count restriction.
-		return true
-	}
-
-	for _, e := range m.CheckIsAdminMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			return false
-		}
-	}
-
-	return m.CheckIsAdminMock.invocationsDone()
-}
-
-// MinimockCheckIsAdminInspect logs each unmet expectation
-func (m *AuthServiceMock) MinimockCheckIsAdminInspect() {
-	for _, e := range m.CheckIsAdminMock.expectations {
-		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthServiceMock.CheckIsAdmin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
-		}
-	}
-
-	afterCheckIsAdminCounter := mm_atomic.LoadUint64(&m.afterCheckIsAdminCounter)
-	// if default expectation was set then invocations count should be greater than zero
-	if m.CheckIsAdminMock.defaultExpectation != nil && afterCheckIsAdminCounter < 1 {
-		if m.CheckIsAdminMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthServiceMock.CheckIsAdmin at\n%s", m.CheckIsAdminMock.defaultExpectation.returnOrigin)
-		} else {
-			m.t.Errorf("Expected call to AuthServiceMock.CheckIsAdmin at\n%s with params: %#v", m.CheckIsAdminMock.defaultExpectation.expectationOrigins.origin, *m.CheckIsAdminMock.defaultExpectation.params)
-		}
-	}
-	// if func was set then invocations count should be greater than zero
-	if m.funcCheckIsAdmin != nil && afterCheckIsAdminCounter < 1 {
-		m.t.Errorf("Expected call to AuthServiceMock.CheckIsAdmin at\n%s", m.funcCheckIsAdminOrigin)
-	}
-
-	if !m.CheckIsAdminMock.invocationsDone() && afterCheckIsAdminCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthServiceMock.CheckIsAdmin at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CheckIsAdminMock.expectedInvocations), m.CheckIsAdminMock.expectedInvocationsOrigin, afterCheckIsAdminCounter)
-	}
-}
-
 type mAuthServiceMockCheckSelf struct {
 	optional           bool
 	mock               *AuthServiceMock
@@ -1420,6 +1109,349 @@ func (m *AuthServiceMock) MinimockDeleteSessionsByUserIDInspect() {
 	}
 }
 
+type mAuthServiceMockDeleteUserRoles struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockDeleteUserRolesExpectation
+	expectations       []*AuthServiceMockDeleteUserRolesExpectation
+
+	callArgs []*AuthServiceMockDeleteUserRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockDeleteUserRolesExpectation specifies expectation struct of the AuthService.DeleteUserRoles
+type AuthServiceMockDeleteUserRolesExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockDeleteUserRolesParams
+	paramPtrs          *AuthServiceMockDeleteUserRolesParamPtrs
+	expectationOrigins AuthServiceMockDeleteUserRolesExpectationOrigins
+	results            *AuthServiceMockDeleteUserRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockDeleteUserRolesParams contains parameters of the AuthService.DeleteUserRoles
+type AuthServiceMockDeleteUserRolesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AuthServiceMockDeleteUserRolesParamPtrs contains pointers to parameters of the AuthService.DeleteUserRoles
+type AuthServiceMockDeleteUserRolesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AuthServiceMockDeleteUserRolesResults contains results of the AuthService.DeleteUserRoles
+type AuthServiceMockDeleteUserRolesResults struct {
+	i1  int64
+	err error
+}
+
+// AuthServiceMockDeleteUserRolesOrigins contains origins of expectations of the AuthService.DeleteUserRoles
+type AuthServiceMockDeleteUserRolesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Optional() *mAuthServiceMockDeleteUserRoles {
+	mmDeleteUserRoles.optional = true
+	return mmDeleteUserRoles
+}
+
+// Expect sets up expected params for AuthService.DeleteUserRoles
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Expect(ctx context.Context, userID uuid.UUID) *mAuthServiceMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &AuthServiceMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUserRoles.defaultExpectation.params = &AuthServiceMockDeleteUserRolesParams{ctx, userID}
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUserRoles.expectations {
+		if minimock.Equal(e.params, mmDeleteUserRoles.defaultExpectation.params) {
+			mmDeleteUserRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUserRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUserRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.DeleteUserRoles
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &AuthServiceMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.params != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Expect")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRolesParamPtrs{}
+	}
+	mmDeleteUserRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUserRoles
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AuthService.DeleteUserRoles
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) ExpectUserIDParam2(userID uuid.UUID) *mAuthServiceMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &AuthServiceMockDeleteUserRolesExpectation{}
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.params != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Expect")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation.paramPtrs == nil {
+		mmDeleteUserRoles.defaultExpectation.paramPtrs = &AuthServiceMockDeleteUserRolesParamPtrs{}
+	}
+	mmDeleteUserRoles.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteUserRoles.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteUserRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.DeleteUserRoles
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAuthServiceMockDeleteUserRoles {
+	if mmDeleteUserRoles.mock.inspectFuncDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.DeleteUserRoles")
+	}
+
+	mmDeleteUserRoles.mock.inspectFuncDeleteUserRoles = f
+
+	return mmDeleteUserRoles
+}
+
+// Return sets up results that will be returned by AuthService.DeleteUserRoles
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Return(i1 int64, err error) *AuthServiceMock {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	if mmDeleteUserRoles.defaultExpectation == nil {
+		mmDeleteUserRoles.defaultExpectation = &AuthServiceMockDeleteUserRolesExpectation{mock: mmDeleteUserRoles.mock}
+	}
+	mmDeleteUserRoles.defaultExpectation.results = &AuthServiceMockDeleteUserRolesResults{i1, err}
+	mmDeleteUserRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles.mock
+}
+
+// Set uses given function f to mock the AuthService.DeleteUserRoles method
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Set(f func(ctx context.Context, userID uuid.UUID) (i1 int64, err error)) *AuthServiceMock {
+	if mmDeleteUserRoles.defaultExpectation != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("Default expectation is already set for the AuthService.DeleteUserRoles method")
+	}
+
+	if len(mmDeleteUserRoles.expectations) > 0 {
+		mmDeleteUserRoles.mock.t.Fatalf("Some expectations are already set for the AuthService.DeleteUserRoles method")
+	}
+
+	mmDeleteUserRoles.mock.funcDeleteUserRoles = f
+	mmDeleteUserRoles.mock.funcDeleteUserRolesOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles.mock
+}
+
+// When sets expectation for the AuthService.DeleteUserRoles which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) When(ctx context.Context, userID uuid.UUID) *AuthServiceMockDeleteUserRolesExpectation {
+	if mmDeleteUserRoles.mock.funcDeleteUserRoles != nil {
+		mmDeleteUserRoles.mock.t.Fatalf("AuthServiceMock.DeleteUserRoles mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockDeleteUserRolesExpectation{
+		mock:               mmDeleteUserRoles.mock,
+		params:             &AuthServiceMockDeleteUserRolesParams{ctx, userID},
+		expectationOrigins: AuthServiceMockDeleteUserRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUserRoles.expectations = append(mmDeleteUserRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.DeleteUserRoles return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockDeleteUserRolesExpectation) Then(i1 int64, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockDeleteUserRolesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.DeleteUserRoles should be invoked
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Times(n uint64) *mAuthServiceMockDeleteUserRoles {
+	if n == 0 {
+		mmDeleteUserRoles.mock.t.Fatalf("Times of AuthServiceMock.DeleteUserRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUserRoles.expectedInvocations, n)
+	mmDeleteUserRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUserRoles
+}
+
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) invocationsDone() bool {
+	if len(mmDeleteUserRoles.expectations) == 0 && mmDeleteUserRoles.defaultExpectation == nil && mmDeleteUserRoles.mock.funcDeleteUserRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUserRoles.mock.afterDeleteUserRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUserRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUserRoles implements mm_usecase.AuthService
+func (mmDeleteUserRoles *AuthServiceMock) DeleteUserRoles(ctx context.Context, userID uuid.UUID) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteUserRoles.beforeDeleteUserRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUserRoles.afterDeleteUserRolesCounter, 1)
+
+	mmDeleteUserRoles.t.Helper()
+
+	if mmDeleteUserRoles.inspectFuncDeleteUserRoles != nil {
+		mmDeleteUserRoles.inspectFuncDeleteUserRoles(ctx, userID)
+	}
+
+	mm_params := AuthServiceMockDeleteUserRolesParams{ctx, userID}
+
+	// Record call args
+	mmDeleteUserRoles.DeleteUserRolesMock.mutex.Lock()
+	mmDeleteUserRoles.DeleteUserRolesMock.callArgs = append(mmDeleteUserRoles.DeleteUserRolesMock.callArgs, &mm_params)
+	mmDeleteUserRoles.DeleteUserRolesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUserRoles.DeleteUserRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockDeleteUserRolesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUserRoles.t.Errorf("AuthServiceMock.DeleteUserRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteUserRoles.t.Errorf("AuthServiceMock.DeleteUserRoles got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUserRoles.t.Errorf("AuthServiceMock.DeleteUserRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUserRoles.DeleteUserRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUserRoles.t.Fatal("No results are set for the AuthServiceMock.DeleteUserRoles")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteUserRoles.funcDeleteUserRoles != nil {
+		return mmDeleteUserRoles.funcDeleteUserRoles(ctx, userID)
+	}
+	mmDeleteUserRoles.t.Fatalf("Unexpected call to AuthServiceMock.DeleteUserRoles. %v %v", ctx, userID)
+	return
+}
+
+// DeleteUserRolesAfterCounter returns a count of finished AuthServiceMock.DeleteUserRoles invocations
+func (mmDeleteUserRoles *AuthServiceMock) DeleteUserRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRoles.afterDeleteUserRolesCounter)
+}
+
+// DeleteUserRolesBeforeCounter returns a count of AuthServiceMock.DeleteUserRoles invocations
+func (mmDeleteUserRoles *AuthServiceMock) DeleteUserRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUserRoles.beforeDeleteUserRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.DeleteUserRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUserRoles *mAuthServiceMockDeleteUserRoles) Calls() []*AuthServiceMockDeleteUserRolesParams {
+	mmDeleteUserRoles.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockDeleteUserRolesParams, len(mmDeleteUserRoles.callArgs))
+	copy(argCopy, mmDeleteUserRoles.callArgs)
+
+	mmDeleteUserRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUserRolesDone returns true if the count of the DeleteUserRoles invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockDeleteUserRolesDone() bool {
+	if m.DeleteUserRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUserRolesMock.invocationsDone()
+}
+
+// MinimockDeleteUserRolesInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockDeleteUserRolesInspect() {
+	for _, e := range m.DeleteUserRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUserRolesCounter := mm_atomic.LoadUint64(&m.afterDeleteUserRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUserRolesMock.defaultExpectation != nil && afterDeleteUserRolesCounter < 1 {
+		if m.DeleteUserRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRoles at\n%s", m.DeleteUserRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRoles at\n%s with params: %#v", m.DeleteUserRolesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUserRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUserRoles != nil && afterDeleteUserRolesCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.DeleteUserRoles at\n%s", m.funcDeleteUserRolesOrigin)
+	}
+
+	if !m.DeleteUserRolesMock.invocationsDone() && afterDeleteUserRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.DeleteUserRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUserRolesMock.expectedInvocations), m.DeleteUserRolesMock.expectedInvocationsOrigin, afterDeleteUserRolesCounter)
+	}
+}
+
 type mAuthServiceMockIsAdmin struct {
 	optional           bool
 	mock               *AuthServiceMock
@@ -1736,14 +1768,14 @@ func (m *AuthServiceMock) MinimockIsAdminInspect() {
 func (m *AuthServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
-			m.MinimockCheckIsAdminInspect()
-
 			m.MinimockCheckSelfInspect()
 
 			m.MinimockCheckSelfOrAdminInspect()
 
 			m.MinimockDeleteSessionsByUserIDInspect()
 
+			m.MinimockDeleteUserRolesInspect()
+
 			m.MinimockIsAdminInspect()
 		}
 	})
@@ -1768,9 +1800,9 @@ func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AuthServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCheckIsAdminDone() &&
 		m.MinimockCheckSelfDone() &&
 		m.MinimockCheckSelfOrAdminDone() &&
 		m.MinimockDeleteSessionsByUserIDDone() &&
+		m.MinimockDeleteUserRolesDone() &&
 		m.MinimockIsAdminDone()
 }
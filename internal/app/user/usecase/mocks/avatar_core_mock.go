@@ -0,0 +1,1162 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user/usecase.AvatarCore -o avatar_core_mock.go -n AvatarCoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/user"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// AvatarCoreMock implements mm_usecase.AvatarCore
+type AvatarCoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mAvatarCoreMockDelete
+
+	funcGet          func(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, userID uuid.UUID)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mAvatarCoreMockGet
+
+	funcUpload          func(ctx context.Context, userID uuid.UUID, data []byte) (err error)
+	funcUploadOrigin    string
+	inspectFuncUpload   func(ctx context.Context, userID uuid.UUID, data []byte)
+	afterUploadCounter  uint64
+	beforeUploadCounter uint64
+	UploadMock          mAvatarCoreMockUpload
+}
+
+// NewAvatarCoreMock returns a mock for mm_usecase.AvatarCore
+func NewAvatarCoreMock(t minimock.Tester) *AvatarCoreMock {
+	m := &AvatarCoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mAvatarCoreMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*AvatarCoreMockDeleteParams{}
+
+	m.GetMock = mAvatarCoreMockGet{mock: m}
+	m.GetMock.callArgs = []*AvatarCoreMockGetParams{}
+
+	m.UploadMock = mAvatarCoreMockUpload{mock: m}
+	m.UploadMock.callArgs = []*AvatarCoreMockUploadParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAvatarCoreMockDelete struct {
+	optional           bool
+	mock               *AvatarCoreMock
+	defaultExpectation *AvatarCoreMockDeleteExpectation
+	expectations       []*AvatarCoreMockDeleteExpectation
+
+	callArgs []*AvatarCoreMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarCoreMockDeleteExpectation specifies expectation struct of the AvatarCore.Delete
+type AvatarCoreMockDeleteExpectation struct {
+	mock               *AvatarCoreMock
+	params             *AvatarCoreMockDeleteParams
+	paramPtrs          *AvatarCoreMockDeleteParamPtrs
+	expectationOrigins AvatarCoreMockDeleteExpectationOrigins
+	results            *AvatarCoreMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarCoreMockDeleteParams contains parameters of the AvatarCore.Delete
+type AvatarCoreMockDeleteParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AvatarCoreMockDeleteParamPtrs contains pointers to parameters of the AvatarCore.Delete
+type AvatarCoreMockDeleteParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AvatarCoreMockDeleteResults contains results of the AvatarCore.Delete
+type AvatarCoreMockDeleteResults struct {
+	err error
+}
+
+// AvatarCoreMockDeleteOrigins contains origins of expectations of the AvatarCore.Delete
+type AvatarCoreMockDeleteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mAvatarCoreMockDelete) Optional() *mAvatarCoreMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for AvatarCore.Delete
+func (mmDelete *mAvatarCoreMockDelete) Expect(ctx context.Context, userID uuid.UUID) *mAvatarCoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &AvatarCoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &AvatarCoreMockDeleteParams{ctx, userID}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarCore.Delete
+func (mmDelete *mAvatarCoreMockDelete) ExpectCtxParam1(ctx context.Context) *mAvatarCoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &AvatarCoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &AvatarCoreMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarCore.Delete
+func (mmDelete *mAvatarCoreMockDelete) ExpectUserIDParam2(userID uuid.UUID) *mAvatarCoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &AvatarCoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &AvatarCoreMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.userID = &userID
+	mmDelete.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarCore.Delete
+func (mmDelete *mAvatarCoreMockDelete) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAvatarCoreMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for AvatarCoreMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by AvatarCore.Delete
+func (mmDelete *mAvatarCoreMockDelete) Return(err error) *AvatarCoreMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &AvatarCoreMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &AvatarCoreMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the AvatarCore.Delete method
+func (mmDelete *mAvatarCoreMockDelete) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *AvatarCoreMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the AvatarCore.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the AvatarCore.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the AvatarCore.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mAvatarCoreMockDelete) When(ctx context.Context, userID uuid.UUID) *AvatarCoreMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("AvatarCoreMock.Delete mock is already set by Set")
+	}
+
+	expectation := &AvatarCoreMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &AvatarCoreMockDeleteParams{ctx, userID},
+		expectationOrigins: AvatarCoreMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarCore.Delete return parameters for the expectation previously defined by the When method
+func (e *AvatarCoreMockDeleteExpectation) Then(err error) *AvatarCoreMock {
+	e.results = &AvatarCoreMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times AvatarCore.Delete should be invoked
+func (mmDelete *mAvatarCoreMockDelete) Times(n uint64) *mAvatarCoreMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of AvatarCoreMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mAvatarCoreMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_usecase.AvatarCore
+func (mmDelete *AvatarCoreMock) Delete(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, userID)
+	}
+
+	mm_params := AvatarCoreMockDeleteParams{ctx, userID}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarCoreMockDeleteParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("AvatarCoreMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDelete.t.Errorf("AvatarCoreMock.Delete got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("AvatarCoreMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the AvatarCoreMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, userID)
+	}
+	mmDelete.t.Fatalf("Unexpected call to AvatarCoreMock.Delete. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished AvatarCoreMock.Delete invocations
+func (mmDelete *AvatarCoreMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of AvatarCoreMock.Delete invocations
+func (mmDelete *AvatarCoreMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarCoreMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mAvatarCoreMockDelete) Calls() []*AvatarCoreMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*AvatarCoreMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *AvatarCoreMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *AvatarCoreMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarCoreMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarCoreMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarCoreMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to AvatarCoreMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarCoreMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mAvatarCoreMockGet struct {
+	optional           bool
+	mock               *AvatarCoreMock
+	defaultExpectation *AvatarCoreMockGetExpectation
+	expectations       []*AvatarCoreMockGetExpectation
+
+	callArgs []*AvatarCoreMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarCoreMockGetExpectation specifies expectation struct of the AvatarCore.Get
+type AvatarCoreMockGetExpectation struct {
+	mock               *AvatarCoreMock
+	params             *AvatarCoreMockGetParams
+	paramPtrs          *AvatarCoreMockGetParamPtrs
+	expectationOrigins AvatarCoreMockGetExpectationOrigins
+	results            *AvatarCoreMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarCoreMockGetParams contains parameters of the AvatarCore.Get
+type AvatarCoreMockGetParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// AvatarCoreMockGetParamPtrs contains pointers to parameters of the AvatarCore.Get
+type AvatarCoreMockGetParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// AvatarCoreMockGetResults contains results of the AvatarCore.Get
+type AvatarCoreMockGetResults struct {
+	a1  user.Avatar
+	r1  io.ReadCloser
+	err error
+}
+
+// AvatarCoreMockGetOrigins contains origins of expectations of the AvatarCore.Get
+type AvatarCoreMockGetExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mAvatarCoreMockGet) Optional() *mAvatarCoreMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for AvatarCore.Get
+func (mmGet *mAvatarCoreMockGet) Expect(ctx context.Context, userID uuid.UUID) *mAvatarCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AvatarCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &AvatarCoreMockGetParams{ctx, userID}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarCore.Get
+func (mmGet *mAvatarCoreMockGet) ExpectCtxParam1(ctx context.Context) *mAvatarCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AvatarCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AvatarCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarCore.Get
+func (mmGet *mAvatarCoreMockGet) ExpectUserIDParam2(userID uuid.UUID) *mAvatarCoreMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AvatarCoreMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AvatarCoreMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.userID = &userID
+	mmGet.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarCore.Get
+func (mmGet *mAvatarCoreMockGet) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mAvatarCoreMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for AvatarCoreMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by AvatarCore.Get
+func (mmGet *mAvatarCoreMockGet) Return(a1 user.Avatar, r1 io.ReadCloser, err error) *AvatarCoreMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AvatarCoreMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &AvatarCoreMockGetResults{a1, r1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the AvatarCore.Get method
+func (mmGet *mAvatarCoreMockGet) Set(f func(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error)) *AvatarCoreMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the AvatarCore.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the AvatarCore.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the AvatarCore.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mAvatarCoreMockGet) When(ctx context.Context, userID uuid.UUID) *AvatarCoreMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AvatarCoreMock.Get mock is already set by Set")
+	}
+
+	expectation := &AvatarCoreMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &AvatarCoreMockGetParams{ctx, userID},
+		expectationOrigins: AvatarCoreMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarCore.Get return parameters for the expectation previously defined by the When method
+func (e *AvatarCoreMockGetExpectation) Then(a1 user.Avatar, r1 io.ReadCloser, err error) *AvatarCoreMock {
+	e.results = &AvatarCoreMockGetResults{a1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times AvatarCore.Get should be invoked
+func (mmGet *mAvatarCoreMockGet) Times(n uint64) *mAvatarCoreMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of AvatarCoreMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mAvatarCoreMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_usecase.AvatarCore
+func (mmGet *AvatarCoreMock) Get(ctx context.Context, userID uuid.UUID) (a1 user.Avatar, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, userID)
+	}
+
+	mm_params := AvatarCoreMockGetParams{ctx, userID}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarCoreMockGetParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("AvatarCoreMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGet.t.Errorf("AvatarCoreMock.Get got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("AvatarCoreMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the AvatarCoreMock.Get")
+		}
+		return (*mm_results).a1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, userID)
+	}
+	mmGet.t.Fatalf("Unexpected call to AvatarCoreMock.Get. %v %v", ctx, userID)
+	return
+}
+
+// GetAfterCounter returns a count of finished AvatarCoreMock.Get invocations
+func (mmGet *AvatarCoreMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of AvatarCoreMock.Get invocations
+func (mmGet *AvatarCoreMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarCoreMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mAvatarCoreMockGet) Calls() []*AvatarCoreMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*AvatarCoreMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *AvatarCoreMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *AvatarCoreMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarCoreMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarCoreMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarCoreMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to AvatarCoreMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarCoreMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mAvatarCoreMockUpload struct {
+	optional           bool
+	mock               *AvatarCoreMock
+	defaultExpectation *AvatarCoreMockUploadExpectation
+	expectations       []*AvatarCoreMockUploadExpectation
+
+	callArgs []*AvatarCoreMockUploadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AvatarCoreMockUploadExpectation specifies expectation struct of the AvatarCore.Upload
+type AvatarCoreMockUploadExpectation struct {
+	mock               *AvatarCoreMock
+	params             *AvatarCoreMockUploadParams
+	paramPtrs          *AvatarCoreMockUploadParamPtrs
+	expectationOrigins AvatarCoreMockUploadExpectationOrigins
+	results            *AvatarCoreMockUploadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AvatarCoreMockUploadParams contains parameters of the AvatarCore.Upload
+type AvatarCoreMockUploadParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	data   []byte
+}
+
+// AvatarCoreMockUploadParamPtrs contains pointers to parameters of the AvatarCore.Upload
+type AvatarCoreMockUploadParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	data   *[]byte
+}
+
+// AvatarCoreMockUploadResults contains results of the AvatarCore.Upload
+type AvatarCoreMockUploadResults struct {
+	err error
+}
+
+// AvatarCoreMockUploadOrigins contains origins of expectations of the AvatarCore.Upload
+type AvatarCoreMockUploadExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originData   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpload *mAvatarCoreMockUpload) Optional() *mAvatarCoreMockUpload {
+	mmUpload.optional = true
+	return mmUpload
+}
+
+// Expect sets up expected params for AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) Expect(ctx context.Context, userID uuid.UUID, data []byte) *mAvatarCoreMockUpload {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	if mmUpload.defaultExpectation == nil {
+		mmUpload.defaultExpectation = &AvatarCoreMockUploadExpectation{}
+	}
+
+	if mmUpload.defaultExpectation.paramPtrs != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by ExpectParams functions")
+	}
+
+	mmUpload.defaultExpectation.params = &AvatarCoreMockUploadParams{ctx, userID, data}
+	mmUpload.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpload.expectations {
+		if minimock.Equal(e.params, mmUpload.defaultExpectation.params) {
+			mmUpload.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpload.defaultExpectation.params)
+		}
+	}
+
+	return mmUpload
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) ExpectCtxParam1(ctx context.Context) *mAvatarCoreMockUpload {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	if mmUpload.defaultExpectation == nil {
+		mmUpload.defaultExpectation = &AvatarCoreMockUploadExpectation{}
+	}
+
+	if mmUpload.defaultExpectation.params != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Expect")
+	}
+
+	if mmUpload.defaultExpectation.paramPtrs == nil {
+		mmUpload.defaultExpectation.paramPtrs = &AvatarCoreMockUploadParamPtrs{}
+	}
+	mmUpload.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpload.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpload
+}
+
+// ExpectUserIDParam2 sets up expected param userID for AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) ExpectUserIDParam2(userID uuid.UUID) *mAvatarCoreMockUpload {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	if mmUpload.defaultExpectation == nil {
+		mmUpload.defaultExpectation = &AvatarCoreMockUploadExpectation{}
+	}
+
+	if mmUpload.defaultExpectation.params != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Expect")
+	}
+
+	if mmUpload.defaultExpectation.paramPtrs == nil {
+		mmUpload.defaultExpectation.paramPtrs = &AvatarCoreMockUploadParamPtrs{}
+	}
+	mmUpload.defaultExpectation.paramPtrs.userID = &userID
+	mmUpload.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpload
+}
+
+// ExpectDataParam3 sets up expected param data for AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) ExpectDataParam3(data []byte) *mAvatarCoreMockUpload {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	if mmUpload.defaultExpectation == nil {
+		mmUpload.defaultExpectation = &AvatarCoreMockUploadExpectation{}
+	}
+
+	if mmUpload.defaultExpectation.params != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Expect")
+	}
+
+	if mmUpload.defaultExpectation.paramPtrs == nil {
+		mmUpload.defaultExpectation.paramPtrs = &AvatarCoreMockUploadParamPtrs{}
+	}
+	mmUpload.defaultExpectation.paramPtrs.data = &data
+	mmUpload.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmUpload
+}
+
+// Inspect accepts an inspector function that has same arguments as the AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) Inspect(f func(ctx context.Context, userID uuid.UUID, data []byte)) *mAvatarCoreMockUpload {
+	if mmUpload.mock.inspectFuncUpload != nil {
+		mmUpload.mock.t.Fatalf("Inspect function is already set for AvatarCoreMock.Upload")
+	}
+
+	mmUpload.mock.inspectFuncUpload = f
+
+	return mmUpload
+}
+
+// Return sets up results that will be returned by AvatarCore.Upload
+func (mmUpload *mAvatarCoreMockUpload) Return(err error) *AvatarCoreMock {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	if mmUpload.defaultExpectation == nil {
+		mmUpload.defaultExpectation = &AvatarCoreMockUploadExpectation{mock: mmUpload.mock}
+	}
+	mmUpload.defaultExpectation.results = &AvatarCoreMockUploadResults{err}
+	mmUpload.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpload.mock
+}
+
+// Set uses given function f to mock the AvatarCore.Upload method
+func (mmUpload *mAvatarCoreMockUpload) Set(f func(ctx context.Context, userID uuid.UUID, data []byte) (err error)) *AvatarCoreMock {
+	if mmUpload.defaultExpectation != nil {
+		mmUpload.mock.t.Fatalf("Default expectation is already set for the AvatarCore.Upload method")
+	}
+
+	if len(mmUpload.expectations) > 0 {
+		mmUpload.mock.t.Fatalf("Some expectations are already set for the AvatarCore.Upload method")
+	}
+
+	mmUpload.mock.funcUpload = f
+	mmUpload.mock.funcUploadOrigin = minimock.CallerInfo(1)
+	return mmUpload.mock
+}
+
+// When sets expectation for the AvatarCore.Upload which will trigger the result defined by the following
+// Then helper
+func (mmUpload *mAvatarCoreMockUpload) When(ctx context.Context, userID uuid.UUID, data []byte) *AvatarCoreMockUploadExpectation {
+	if mmUpload.mock.funcUpload != nil {
+		mmUpload.mock.t.Fatalf("AvatarCoreMock.Upload mock is already set by Set")
+	}
+
+	expectation := &AvatarCoreMockUploadExpectation{
+		mock:               mmUpload.mock,
+		params:             &AvatarCoreMockUploadParams{ctx, userID, data},
+		expectationOrigins: AvatarCoreMockUploadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpload.expectations = append(mmUpload.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AvatarCore.Upload return parameters for the expectation previously defined by the When method
+func (e *AvatarCoreMockUploadExpectation) Then(err error) *AvatarCoreMock {
+	e.results = &AvatarCoreMockUploadResults{err}
+	return e.mock
+}
+
+// Times sets number of times AvatarCore.Upload should be invoked
+func (mmUpload *mAvatarCoreMockUpload) Times(n uint64) *mAvatarCoreMockUpload {
+	if n == 0 {
+		mmUpload.mock.t.Fatalf("Times of AvatarCoreMock.Upload mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpload.expectedInvocations, n)
+	mmUpload.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpload
+}
+
+func (mmUpload *mAvatarCoreMockUpload) invocationsDone() bool {
+	if len(mmUpload.expectations) == 0 && mmUpload.defaultExpectation == nil && mmUpload.mock.funcUpload == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpload.mock.afterUploadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpload.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Upload implements mm_usecase.AvatarCore
+func (mmUpload *AvatarCoreMock) Upload(ctx context.Context, userID uuid.UUID, data []byte) (err error) {
+	mm_atomic.AddUint64(&mmUpload.beforeUploadCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpload.afterUploadCounter, 1)
+
+	mmUpload.t.Helper()
+
+	if mmUpload.inspectFuncUpload != nil {
+		mmUpload.inspectFuncUpload(ctx, userID, data)
+	}
+
+	mm_params := AvatarCoreMockUploadParams{ctx, userID, data}
+
+	// Record call args
+	mmUpload.UploadMock.mutex.Lock()
+	mmUpload.UploadMock.callArgs = append(mmUpload.UploadMock.callArgs, &mm_params)
+	mmUpload.UploadMock.mutex.Unlock()
+
+	for _, e := range mmUpload.UploadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpload.UploadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpload.UploadMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpload.UploadMock.defaultExpectation.params
+		mm_want_ptrs := mmUpload.UploadMock.defaultExpectation.paramPtrs
+
+		mm_got := AvatarCoreMockUploadParams{ctx, userID, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpload.t.Errorf("AvatarCoreMock.Upload got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpload.UploadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpload.t.Errorf("AvatarCoreMock.Upload got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpload.UploadMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmUpload.t.Errorf("AvatarCoreMock.Upload got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpload.UploadMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpload.t.Errorf("AvatarCoreMock.Upload got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpload.UploadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpload.UploadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpload.t.Fatal("No results are set for the AvatarCoreMock.Upload")
+		}
+		return (*mm_results).err
+	}
+	if mmUpload.funcUpload != nil {
+		return mmUpload.funcUpload(ctx, userID, data)
+	}
+	mmUpload.t.Fatalf("Unexpected call to AvatarCoreMock.Upload. %v %v %v", ctx, userID, data)
+	return
+}
+
+// UploadAfterCounter returns a count of finished AvatarCoreMock.Upload invocations
+func (mmUpload *AvatarCoreMock) UploadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpload.afterUploadCounter)
+}
+
+// UploadBeforeCounter returns a count of AvatarCoreMock.Upload invocations
+func (mmUpload *AvatarCoreMock) UploadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpload.beforeUploadCounter)
+}
+
+// Calls returns a list of arguments used in each call to AvatarCoreMock.Upload.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpload *mAvatarCoreMockUpload) Calls() []*AvatarCoreMockUploadParams {
+	mmUpload.mutex.RLock()
+
+	argCopy := make([]*AvatarCoreMockUploadParams, len(mmUpload.callArgs))
+	copy(argCopy, mmUpload.callArgs)
+
+	mmUpload.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadDone returns true if the count of the Upload invocations corresponds
+// the number of defined expectations
+func (m *AvatarCoreMock) MinimockUploadDone() bool {
+	if m.UploadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadMock.invocationsDone()
+}
+
+// MinimockUploadInspect logs each unmet expectation
+func (m *AvatarCoreMock) MinimockUploadInspect() {
+	for _, e := range m.UploadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AvatarCoreMock.Upload at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUploadCounter := mm_atomic.LoadUint64(&m.afterUploadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadMock.defaultExpectation != nil && afterUploadCounter < 1 {
+		if m.UploadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AvatarCoreMock.Upload at\n%s", m.UploadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AvatarCoreMock.Upload at\n%s with params: %#v", m.UploadMock.defaultExpectation.expectationOrigins.origin, *m.UploadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpload != nil && afterUploadCounter < 1 {
+		m.t.Errorf("Expected call to AvatarCoreMock.Upload at\n%s", m.funcUploadOrigin)
+	}
+
+	if !m.UploadMock.invocationsDone() && afterUploadCounter > 0 {
+		m.t.Errorf("Expected %d calls to AvatarCoreMock.Upload at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadMock.expectedInvocations), m.UploadMock.expectedInvocationsOrigin, afterUploadCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AvatarCoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockUploadInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AvatarCoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AvatarCoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockUploadDone()
+}
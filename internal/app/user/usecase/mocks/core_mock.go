@@ -1,4 +1,4 @@
-// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
 
 package mocks
 
@@ -20,6 +20,13 @@ type CoreMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAnonymizeUser          func(ctx context.Context, id uuid.UUID) (err error)
+	funcAnonymizeUserOrigin    string
+	inspectFuncAnonymizeUser   func(ctx context.Context, id uuid.UUID)
+	afterAnonymizeUserCounter  uint64
+	beforeAnonymizeUserCounter uint64
+	AnonymizeUserMock          mCoreMockAnonymizeUser
+
 	funcChangePassword          func(ctx context.Context, id uuid.UUID, newPassword []byte) (err error)
 	funcChangePasswordOrigin    string
 	inspectFuncChangePassword   func(ctx context.Context, id uuid.UUID, newPassword []byte)
@@ -41,13 +48,20 @@ type CoreMock struct {
 	beforeDeleteUserCounter uint64
 	DeleteUserMock          mCoreMockDeleteUser
 
-	funcGetAllUsers          func(ctx context.Context) (ua1 []user.User, err error)
+	funcGetAllUsers          func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)
 	funcGetAllUsersOrigin    string
-	inspectFuncGetAllUsers   func(ctx context.Context)
+	inspectFuncGetAllUsers   func(ctx context.Context, opts user.ListUsersOptions)
 	afterGetAllUsersCounter  uint64
 	beforeGetAllUsersCounter uint64
 	GetAllUsersMock          mCoreMockGetAllUsers
 
+	funcGetPreferences          func(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error)
+	funcGetPreferencesOrigin    string
+	inspectFuncGetPreferences   func(ctx context.Context, userID uuid.UUID)
+	afterGetPreferencesCounter  uint64
+	beforeGetPreferencesCounter uint64
+	GetPreferencesMock          mCoreMockGetPreferences
+
 	funcGetUser          func(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error)
 	funcGetUserOrigin    string
 	inspectFuncGetUser   func(ctx context.Context, id uuid.UUID)
@@ -55,6 +69,20 @@ type CoreMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mCoreMockGetUser
 
+	funcUpdatePreferences          func(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error)
+	funcUpdatePreferencesOrigin    string
+	inspectFuncUpdatePreferences   func(ctx context.Context, userID uuid.UUID, data []byte)
+	afterUpdatePreferencesCounter  uint64
+	beforeUpdatePreferencesCounter uint64
+	UpdatePreferencesMock          mCoreMockUpdatePreferences
+
+	funcUpdateProfile          func(ctx context.Context, req user.UpdateProfileReq) (err error)
+	funcUpdateProfileOrigin    string
+	inspectFuncUpdateProfile   func(ctx context.Context, req user.UpdateProfileReq)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mCoreMockUpdateProfile
+
 	funcUpdateUser          func(ctx context.Context, req user.UpdateUserReq) (err error)
 	funcUpdateUserOrigin    string
 	inspectFuncUpdateUser   func(ctx context.Context, req user.UpdateUserReq)
@@ -71,6 +99,9 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AnonymizeUserMock = mCoreMockAnonymizeUser{mock: m}
+	m.AnonymizeUserMock.callArgs = []*CoreMockAnonymizeUserParams{}
+
 	m.ChangePasswordMock = mCoreMockChangePassword{mock: m}
 	m.ChangePasswordMock.callArgs = []*CoreMockChangePasswordParams{}
 
@@ -83,9 +114,18 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 	m.GetAllUsersMock = mCoreMockGetAllUsers{mock: m}
 	m.GetAllUsersMock.callArgs = []*CoreMockGetAllUsersParams{}
 
+	m.GetPreferencesMock = mCoreMockGetPreferences{mock: m}
+	m.GetPreferencesMock.callArgs = []*CoreMockGetPreferencesParams{}
+
 	m.GetUserMock = mCoreMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*CoreMockGetUserParams{}
 
+	m.UpdatePreferencesMock = mCoreMockUpdatePreferences{mock: m}
+	m.UpdatePreferencesMock.callArgs = []*CoreMockUpdatePreferencesParams{}
+
+	m.UpdateProfileMock = mCoreMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*CoreMockUpdateProfileParams{}
+
 	m.UpdateUserMock = mCoreMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*CoreMockUpdateUserParams{}
 
@@ -94,6 +134,348 @@ func NewCoreMock(t minimock.Tester) *CoreMock {
 	return m
 }
 
+type mCoreMockAnonymizeUser struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockAnonymizeUserExpectation
+	expectations       []*CoreMockAnonymizeUserExpectation
+
+	callArgs []*CoreMockAnonymizeUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockAnonymizeUserExpectation specifies expectation struct of the Core.AnonymizeUser
+type CoreMockAnonymizeUserExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockAnonymizeUserParams
+	paramPtrs          *CoreMockAnonymizeUserParamPtrs
+	expectationOrigins CoreMockAnonymizeUserExpectationOrigins
+	results            *CoreMockAnonymizeUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockAnonymizeUserParams contains parameters of the Core.AnonymizeUser
+type CoreMockAnonymizeUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockAnonymizeUserParamPtrs contains pointers to parameters of the Core.AnonymizeUser
+type CoreMockAnonymizeUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockAnonymizeUserResults contains results of the Core.AnonymizeUser
+type CoreMockAnonymizeUserResults struct {
+	err error
+}
+
+// CoreMockAnonymizeUserOrigins contains origins of expectations of the Core.AnonymizeUser
+type CoreMockAnonymizeUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Optional() *mCoreMockAnonymizeUser {
+	mmAnonymizeUser.optional = true
+	return mmAnonymizeUser
+}
+
+// Expect sets up expected params for Core.AnonymizeUser
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Expect(ctx context.Context, id uuid.UUID) *mCoreMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &CoreMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by ExpectParams functions")
+	}
+
+	mmAnonymizeUser.defaultExpectation.params = &CoreMockAnonymizeUserParams{ctx, id}
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAnonymizeUser.expectations {
+		if minimock.Equal(e.params, mmAnonymizeUser.defaultExpectation.params) {
+			mmAnonymizeUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAnonymizeUser.defaultExpectation.params)
+		}
+	}
+
+	return mmAnonymizeUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.AnonymizeUser
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) ExpectCtxParam1(ctx context.Context) *mCoreMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &CoreMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &CoreMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// ExpectIdParam2 sets up expected param id for Core.AnonymizeUser
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) ExpectIdParam2(id uuid.UUID) *mCoreMockAnonymizeUser {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &CoreMockAnonymizeUserExpectation{}
+	}
+
+	if mmAnonymizeUser.defaultExpectation.params != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Expect")
+	}
+
+	if mmAnonymizeUser.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUser.defaultExpectation.paramPtrs = &CoreMockAnonymizeUserParamPtrs{}
+	}
+	mmAnonymizeUser.defaultExpectation.paramPtrs.id = &id
+	mmAnonymizeUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmAnonymizeUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.AnonymizeUser
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockAnonymizeUser {
+	if mmAnonymizeUser.mock.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Inspect function is already set for CoreMock.AnonymizeUser")
+	}
+
+	mmAnonymizeUser.mock.inspectFuncAnonymizeUser = f
+
+	return mmAnonymizeUser
+}
+
+// Return sets up results that will be returned by Core.AnonymizeUser
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Return(err error) *CoreMock {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Set")
+	}
+
+	if mmAnonymizeUser.defaultExpectation == nil {
+		mmAnonymizeUser.defaultExpectation = &CoreMockAnonymizeUserExpectation{mock: mmAnonymizeUser.mock}
+	}
+	mmAnonymizeUser.defaultExpectation.results = &CoreMockAnonymizeUserResults{err}
+	mmAnonymizeUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
+}
+
+// Set uses given function f to mock the Core.AnonymizeUser method
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Set(f func(ctx context.Context, id uuid.UUID) (err error)) *CoreMock {
+	if mmAnonymizeUser.defaultExpectation != nil {
+		mmAnonymizeUser.mock.t.Fatalf("Default expectation is already set for the Core.AnonymizeUser method")
+	}
+
+	if len(mmAnonymizeUser.expectations) > 0 {
+		mmAnonymizeUser.mock.t.Fatalf("Some expectations are already set for the Core.AnonymizeUser method")
+	}
+
+	mmAnonymizeUser.mock.funcAnonymizeUser = f
+	mmAnonymizeUser.mock.funcAnonymizeUserOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser.mock
+}
+
+// When sets expectation for the Core.AnonymizeUser which will trigger the result defined by the following
+// Then helper
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) When(ctx context.Context, id uuid.UUID) *CoreMockAnonymizeUserExpectation {
+	if mmAnonymizeUser.mock.funcAnonymizeUser != nil {
+		mmAnonymizeUser.mock.t.Fatalf("CoreMock.AnonymizeUser mock is already set by Set")
+	}
+
+	expectation := &CoreMockAnonymizeUserExpectation{
+		mock:               mmAnonymizeUser.mock,
+		params:             &CoreMockAnonymizeUserParams{ctx, id},
+		expectationOrigins: CoreMockAnonymizeUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAnonymizeUser.expectations = append(mmAnonymizeUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.AnonymizeUser return parameters for the expectation previously defined by the When method
+func (e *CoreMockAnonymizeUserExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockAnonymizeUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times Core.AnonymizeUser should be invoked
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Times(n uint64) *mCoreMockAnonymizeUser {
+	if n == 0 {
+		mmAnonymizeUser.mock.t.Fatalf("Times of CoreMock.AnonymizeUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAnonymizeUser.expectedInvocations, n)
+	mmAnonymizeUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUser
+}
+
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) invocationsDone() bool {
+	if len(mmAnonymizeUser.expectations) == 0 && mmAnonymizeUser.defaultExpectation == nil && mmAnonymizeUser.mock.funcAnonymizeUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.mock.afterAnonymizeUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAnonymizeUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AnonymizeUser implements mm_usecase.Core
+func (mmAnonymizeUser *CoreMock) AnonymizeUser(ctx context.Context, id uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAnonymizeUser.afterAnonymizeUserCounter, 1)
+
+	mmAnonymizeUser.t.Helper()
+
+	if mmAnonymizeUser.inspectFuncAnonymizeUser != nil {
+		mmAnonymizeUser.inspectFuncAnonymizeUser(ctx, id)
+	}
+
+	mm_params := CoreMockAnonymizeUserParams{ctx, id}
+
+	// Record call args
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Lock()
+	mmAnonymizeUser.AnonymizeUserMock.callArgs = append(mmAnonymizeUser.AnonymizeUserMock.callArgs, &mm_params)
+	mmAnonymizeUser.AnonymizeUserMock.mutex.Unlock()
+
+	for _, e := range mmAnonymizeUser.AnonymizeUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAnonymizeUser.AnonymizeUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockAnonymizeUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAnonymizeUser.t.Errorf("CoreMock.AnonymizeUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAnonymizeUser.t.Errorf("CoreMock.AnonymizeUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAnonymizeUser.t.Errorf("CoreMock.AnonymizeUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAnonymizeUser.AnonymizeUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAnonymizeUser.t.Fatal("No results are set for the CoreMock.AnonymizeUser")
+		}
+		return (*mm_results).err
+	}
+	if mmAnonymizeUser.funcAnonymizeUser != nil {
+		return mmAnonymizeUser.funcAnonymizeUser(ctx, id)
+	}
+	mmAnonymizeUser.t.Fatalf("Unexpected call to CoreMock.AnonymizeUser. %v %v", ctx, id)
+	return
+}
+
+// AnonymizeUserAfterCounter returns a count of finished CoreMock.AnonymizeUser invocations
+func (mmAnonymizeUser *CoreMock) AnonymizeUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.afterAnonymizeUserCounter)
+}
+
+// AnonymizeUserBeforeCounter returns a count of CoreMock.AnonymizeUser invocations
+func (mmAnonymizeUser *CoreMock) AnonymizeUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUser.beforeAnonymizeUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.AnonymizeUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAnonymizeUser *mCoreMockAnonymizeUser) Calls() []*CoreMockAnonymizeUserParams {
+	mmAnonymizeUser.mutex.RLock()
+
+	argCopy := make([]*CoreMockAnonymizeUserParams, len(mmAnonymizeUser.callArgs))
+	copy(argCopy, mmAnonymizeUser.callArgs)
+
+	mmAnonymizeUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAnonymizeUserDone returns true if the count of the AnonymizeUser invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockAnonymizeUserDone() bool {
+	if m.AnonymizeUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AnonymizeUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AnonymizeUserMock.invocationsDone()
+}
+
+// MinimockAnonymizeUserInspect logs each unmet expectation
+func (m *CoreMock) MinimockAnonymizeUserInspect() {
+	for _, e := range m.AnonymizeUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.AnonymizeUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAnonymizeUserCounter := mm_atomic.LoadUint64(&m.afterAnonymizeUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AnonymizeUserMock.defaultExpectation != nil && afterAnonymizeUserCounter < 1 {
+		if m.AnonymizeUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.AnonymizeUser at\n%s", m.AnonymizeUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.AnonymizeUser at\n%s with params: %#v", m.AnonymizeUserMock.defaultExpectation.expectationOrigins.origin, *m.AnonymizeUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAnonymizeUser != nil && afterAnonymizeUserCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.AnonymizeUser at\n%s", m.funcAnonymizeUserOrigin)
+	}
+
+	if !m.AnonymizeUserMock.invocationsDone() && afterAnonymizeUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.AnonymizeUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AnonymizeUserMock.expectedInvocations), m.AnonymizeUserMock.expectedInvocationsOrigin, afterAnonymizeUserCounter)
+	}
+}
+
 type mCoreMockChangePassword struct {
 	optional           bool
 	mock               *CoreMock
@@ -1178,24 +1560,27 @@ type CoreMockGetAllUsersExpectation struct {
 
 // CoreMockGetAllUsersParams contains parameters of the Core.GetAllUsers
 type CoreMockGetAllUsersParams struct {
-	ctx context.Context
+	ctx  context.Context
+	opts user.ListUsersOptions
 }
 
 // CoreMockGetAllUsersParamPtrs contains pointers to parameters of the Core.GetAllUsers
 type CoreMockGetAllUsersParamPtrs struct {
-	ctx *context.Context
+	ctx  *context.Context
+	opts *user.ListUsersOptions
 }
 
 // CoreMockGetAllUsersResults contains results of the Core.GetAllUsers
 type CoreMockGetAllUsersResults struct {
-	ua1 []user.User
+	u1  user.UsersPage
 	err error
 }
 
 // CoreMockGetAllUsersOrigins contains origins of expectations of the Core.GetAllUsers
 type CoreMockGetAllUsersExpectationOrigins struct {
-	origin    string
-	originCtx string
+	origin     string
+	originCtx  string
+	originOpts string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1209,7 +1594,7 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) Optional() *mCoreMockGetAllUsers {
 }
 
 // Expect sets up expected params for Core.GetAllUsers
-func (mmGetAllUsers *mCoreMockGetAllUsers) Expect(ctx context.Context) *mCoreMockGetAllUsers {
+func (mmGetAllUsers *mCoreMockGetAllUsers) Expect(ctx context.Context, opts user.ListUsersOptions) *mCoreMockGetAllUsers {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by Set")
 	}
@@ -1222,7 +1607,7 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) Expect(ctx context.Context) *mCoreMoc
 		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by ExpectParams functions")
 	}
 
-	mmGetAllUsers.defaultExpectation.params = &CoreMockGetAllUsersParams{ctx}
+	mmGetAllUsers.defaultExpectation.params = &CoreMockGetAllUsersParams{ctx, opts}
 	mmGetAllUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetAllUsers.expectations {
 		if minimock.Equal(e.params, mmGetAllUsers.defaultExpectation.params) {
@@ -1256,8 +1641,31 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) ExpectCtxParam1(ctx context.Context)
 	return mmGetAllUsers
 }
 
+// ExpectOptsParam2 sets up expected param opts for Core.GetAllUsers
+func (mmGetAllUsers *mCoreMockGetAllUsers) ExpectOptsParam2(opts user.ListUsersOptions) *mCoreMockGetAllUsers {
+	if mmGetAllUsers.mock.funcGetAllUsers != nil {
+		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by Set")
+	}
+
+	if mmGetAllUsers.defaultExpectation == nil {
+		mmGetAllUsers.defaultExpectation = &CoreMockGetAllUsersExpectation{}
+	}
+
+	if mmGetAllUsers.defaultExpectation.params != nil {
+		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by Expect")
+	}
+
+	if mmGetAllUsers.defaultExpectation.paramPtrs == nil {
+		mmGetAllUsers.defaultExpectation.paramPtrs = &CoreMockGetAllUsersParamPtrs{}
+	}
+	mmGetAllUsers.defaultExpectation.paramPtrs.opts = &opts
+	mmGetAllUsers.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmGetAllUsers
+}
+
 // Inspect accepts an inspector function that has same arguments as the Core.GetAllUsers
-func (mmGetAllUsers *mCoreMockGetAllUsers) Inspect(f func(ctx context.Context)) *mCoreMockGetAllUsers {
+func (mmGetAllUsers *mCoreMockGetAllUsers) Inspect(f func(ctx context.Context, opts user.ListUsersOptions)) *mCoreMockGetAllUsers {
 	if mmGetAllUsers.mock.inspectFuncGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("Inspect function is already set for CoreMock.GetAllUsers")
 	}
@@ -1268,7 +1676,7 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) Inspect(f func(ctx context.Context))
 }
 
 // Return sets up results that will be returned by Core.GetAllUsers
-func (mmGetAllUsers *mCoreMockGetAllUsers) Return(ua1 []user.User, err error) *CoreMock {
+func (mmGetAllUsers *mCoreMockGetAllUsers) Return(u1 user.UsersPage, err error) *CoreMock {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by Set")
 	}
@@ -1276,13 +1684,13 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) Return(ua1 []user.User, err error) *C
 	if mmGetAllUsers.defaultExpectation == nil {
 		mmGetAllUsers.defaultExpectation = &CoreMockGetAllUsersExpectation{mock: mmGetAllUsers.mock}
 	}
-	mmGetAllUsers.defaultExpectation.results = &CoreMockGetAllUsersResults{ua1, err}
+	mmGetAllUsers.defaultExpectation.results = &CoreMockGetAllUsersResults{u1, err}
 	mmGetAllUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmGetAllUsers.mock
 }
 
 // Set uses given function f to mock the Core.GetAllUsers method
-func (mmGetAllUsers *mCoreMockGetAllUsers) Set(f func(ctx context.Context) (ua1 []user.User, err error)) *CoreMock {
+func (mmGetAllUsers *mCoreMockGetAllUsers) Set(f func(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error)) *CoreMock {
 	if mmGetAllUsers.defaultExpectation != nil {
 		mmGetAllUsers.mock.t.Fatalf("Default expectation is already set for the Core.GetAllUsers method")
 	}
@@ -1298,14 +1706,14 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) Set(f func(ctx context.Context) (ua1
 
 // When sets expectation for the Core.GetAllUsers which will trigger the result defined by the following
 // Then helper
-func (mmGetAllUsers *mCoreMockGetAllUsers) When(ctx context.Context) *CoreMockGetAllUsersExpectation {
+func (mmGetAllUsers *mCoreMockGetAllUsers) When(ctx context.Context, opts user.ListUsersOptions) *CoreMockGetAllUsersExpectation {
 	if mmGetAllUsers.mock.funcGetAllUsers != nil {
 		mmGetAllUsers.mock.t.Fatalf("CoreMock.GetAllUsers mock is already set by Set")
 	}
 
 	expectation := &CoreMockGetAllUsersExpectation{
 		mock:               mmGetAllUsers.mock,
-		params:             &CoreMockGetAllUsersParams{ctx},
+		params:             &CoreMockGetAllUsersParams{ctx, opts},
 		expectationOrigins: CoreMockGetAllUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetAllUsers.expectations = append(mmGetAllUsers.expectations, expectation)
@@ -1313,8 +1721,8 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) When(ctx context.Context) *CoreMockGe
 }
 
 // Then sets up Core.GetAllUsers return parameters for the expectation previously defined by the When method
-func (e *CoreMockGetAllUsersExpectation) Then(ua1 []user.User, err error) *CoreMock {
-	e.results = &CoreMockGetAllUsersResults{ua1, err}
+func (e *CoreMockGetAllUsersExpectation) Then(u1 user.UsersPage, err error) *CoreMock {
+	e.results = &CoreMockGetAllUsersResults{u1, err}
 	return e.mock
 }
 
@@ -1340,17 +1748,17 @@ func (mmGetAllUsers *mCoreMockGetAllUsers) invocationsDone() bool {
 }
 
 // GetAllUsers implements mm_usecase.Core
-func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context) (ua1 []user.User, err error) {
+func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (u1 user.UsersPage, err error) {
 	mm_atomic.AddUint64(&mmGetAllUsers.beforeGetAllUsersCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetAllUsers.afterGetAllUsersCounter, 1)
 
 	mmGetAllUsers.t.Helper()
 
 	if mmGetAllUsers.inspectFuncGetAllUsers != nil {
-		mmGetAllUsers.inspectFuncGetAllUsers(ctx)
+		mmGetAllUsers.inspectFuncGetAllUsers(ctx, opts)
 	}
 
-	mm_params := CoreMockGetAllUsersParams{ctx}
+	mm_params := CoreMockGetAllUsersParams{ctx, opts}
 
 	// Record call args
 	mmGetAllUsers.GetAllUsersMock.mutex.Lock()
@@ -1360,7 +1768,7 @@ func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context) (ua1 []user.User
 	for _, e := range mmGetAllUsers.GetAllUsersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
@@ -1369,7 +1777,7 @@ func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context) (ua1 []user.User
 		mm_want := mmGetAllUsers.GetAllUsersMock.defaultExpectation.params
 		mm_want_ptrs := mmGetAllUsers.GetAllUsersMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockGetAllUsersParams{ctx}
+		mm_got := CoreMockGetAllUsersParams{ctx, opts}
 
 		if mm_want_ptrs != nil {
 
@@ -1378,6 +1786,11 @@ func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context) (ua1 []user.User
 					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmGetAllUsers.t.Errorf("CoreMock.GetAllUsers got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetAllUsers.t.Errorf("CoreMock.GetAllUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGetAllUsers.GetAllUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1387,12 +1800,12 @@ func (mmGetAllUsers *CoreMock) GetAllUsers(ctx context.Context) (ua1 []user.User
 		if mm_results == nil {
 			mmGetAllUsers.t.Fatal("No results are set for the CoreMock.GetAllUsers")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
 	if mmGetAllUsers.funcGetAllUsers != nil {
-		return mmGetAllUsers.funcGetAllUsers(ctx)
+		return mmGetAllUsers.funcGetAllUsers(ctx, opts)
 	}
-	mmGetAllUsers.t.Fatalf("Unexpected call to CoreMock.GetAllUsers. %v", ctx)
+	mmGetAllUsers.t.Fatalf("Unexpected call to CoreMock.GetAllUsers. %v %v", ctx, opts)
 	return
 }
 
@@ -1464,54 +1877,53 @@ func (m *CoreMock) MinimockGetAllUsersInspect() {
 	}
 }
 
-type mCoreMockGetUser struct {
+type mCoreMockGetPreferences struct {
 	optional           bool
 	mock               *CoreMock
-	defaultExpectation *CoreMockGetUserExpectation
-	expectations       []*CoreMockGetUserExpectation
+	defaultExpectation *CoreMockGetPreferencesExpectation
+	expectations       []*CoreMockGetPreferencesExpectation
 
-	callArgs []*CoreMockGetUserParams
+	callArgs []*CoreMockGetPreferencesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CoreMockGetUserExpectation specifies expectation struct of the Core.GetUser
-type CoreMockGetUserExpectation struct {
+// CoreMockGetPreferencesExpectation specifies expectation struct of the Core.GetPreferences
+type CoreMockGetPreferencesExpectation struct {
 	mock               *CoreMock
-	params             *CoreMockGetUserParams
-	paramPtrs          *CoreMockGetUserParamPtrs
-	expectationOrigins CoreMockGetUserExpectationOrigins
-	results            *CoreMockGetUserResults
+	params             *CoreMockGetPreferencesParams
+	paramPtrs          *CoreMockGetPreferencesParamPtrs
+	expectationOrigins CoreMockGetPreferencesExpectationOrigins
+	results            *CoreMockGetPreferencesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CoreMockGetUserParams contains parameters of the Core.GetUser
-type CoreMockGetUserParams struct {
-	ctx context.Context
-	id  uuid.UUID
+// CoreMockGetPreferencesParams contains parameters of the Core.GetPreferences
+type CoreMockGetPreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
 }
 
-// CoreMockGetUserParamPtrs contains pointers to parameters of the Core.GetUser
-type CoreMockGetUserParamPtrs struct {
-	ctx *context.Context
-	id  *uuid.UUID
+// CoreMockGetPreferencesParamPtrs contains pointers to parameters of the Core.GetPreferences
+type CoreMockGetPreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
 }
 
-// CoreMockGetUserResults contains results of the Core.GetUser
-type CoreMockGetUserResults struct {
-	u1  user.User
-	s1  string
+// CoreMockGetPreferencesResults contains results of the Core.GetPreferences
+type CoreMockGetPreferencesResults struct {
+	p1  user.Preferences
 	err error
 }
 
-// CoreMockGetUserOrigins contains origins of expectations of the Core.GetUser
-type CoreMockGetUserExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// CoreMockGetPreferencesOrigins contains origins of expectations of the Core.GetPreferences
+type CoreMockGetPreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1519,292 +1931,1352 @@ type CoreMockGetUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUser *mCoreMockGetUser) Optional() *mCoreMockGetUser {
-	mmGetUser.optional = true
-	return mmGetUser
+func (mmGetPreferences *mCoreMockGetPreferences) Optional() *mCoreMockGetPreferences {
+	mmGetPreferences.optional = true
+	return mmGetPreferences
 }
 
-// Expect sets up expected params for Core.GetUser
-func (mmGetUser *mCoreMockGetUser) Expect(ctx context.Context, id uuid.UUID) *mCoreMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+// Expect sets up expected params for Core.GetPreferences
+func (mmGetPreferences *mCoreMockGetPreferences) Expect(ctx context.Context, userID uuid.UUID) *mCoreMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &CoreMockGetPreferencesExpectation{}
 	}
 
-	if mmGetUser.defaultExpectation.paramPtrs != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by ExpectParams functions")
+	if mmGetPreferences.defaultExpectation.paramPtrs != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by ExpectParams functions")
 	}
 
-	mmGetUser.defaultExpectation.params = &CoreMockGetUserParams{ctx, id}
-	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUser.expectations {
-		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
-			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+	mmGetPreferences.defaultExpectation.params = &CoreMockGetPreferencesParams{ctx, userID}
+	mmGetPreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPreferences.expectations {
+		if minimock.Equal(e.params, mmGetPreferences.defaultExpectation.params) {
+			mmGetPreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPreferences.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUser
+	return mmGetPreferences
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Core.GetUser
+// ExpectCtxParam1 sets up expected param ctx for Core.GetPreferences
+func (mmGetPreferences *mCoreMockGetPreferences) ExpectCtxParam1(ctx context.Context) *mCoreMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &CoreMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &CoreMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.GetPreferences
+func (mmGetPreferences *mCoreMockGetPreferences) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockGetPreferences {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &CoreMockGetPreferencesExpectation{}
+	}
+
+	if mmGetPreferences.defaultExpectation.params != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Expect")
+	}
+
+	if mmGetPreferences.defaultExpectation.paramPtrs == nil {
+		mmGetPreferences.defaultExpectation.paramPtrs = &CoreMockGetPreferencesParamPtrs{}
+	}
+	mmGetPreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetPreferences
+func (mmGetPreferences *mCoreMockGetPreferences) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mCoreMockGetPreferences {
+	if mmGetPreferences.mock.inspectFuncGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("Inspect function is already set for CoreMock.GetPreferences")
+	}
+
+	mmGetPreferences.mock.inspectFuncGetPreferences = f
+
+	return mmGetPreferences
+}
+
+// Return sets up results that will be returned by Core.GetPreferences
+func (mmGetPreferences *mCoreMockGetPreferences) Return(p1 user.Preferences, err error) *CoreMock {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Set")
+	}
+
+	if mmGetPreferences.defaultExpectation == nil {
+		mmGetPreferences.defaultExpectation = &CoreMockGetPreferencesExpectation{mock: mmGetPreferences.mock}
+	}
+	mmGetPreferences.defaultExpectation.results = &CoreMockGetPreferencesResults{p1, err}
+	mmGetPreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// Set uses given function f to mock the Core.GetPreferences method
+func (mmGetPreferences *mCoreMockGetPreferences) Set(f func(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error)) *CoreMock {
+	if mmGetPreferences.defaultExpectation != nil {
+		mmGetPreferences.mock.t.Fatalf("Default expectation is already set for the Core.GetPreferences method")
+	}
+
+	if len(mmGetPreferences.expectations) > 0 {
+		mmGetPreferences.mock.t.Fatalf("Some expectations are already set for the Core.GetPreferences method")
+	}
+
+	mmGetPreferences.mock.funcGetPreferences = f
+	mmGetPreferences.mock.funcGetPreferencesOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences.mock
+}
+
+// When sets expectation for the Core.GetPreferences which will trigger the result defined by the following
+// Then helper
+func (mmGetPreferences *mCoreMockGetPreferences) When(ctx context.Context, userID uuid.UUID) *CoreMockGetPreferencesExpectation {
+	if mmGetPreferences.mock.funcGetPreferences != nil {
+		mmGetPreferences.mock.t.Fatalf("CoreMock.GetPreferences mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetPreferencesExpectation{
+		mock:               mmGetPreferences.mock,
+		params:             &CoreMockGetPreferencesParams{ctx, userID},
+		expectationOrigins: CoreMockGetPreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPreferences.expectations = append(mmGetPreferences.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetPreferences return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetPreferencesExpectation) Then(p1 user.Preferences, err error) *CoreMock {
+	e.results = &CoreMockGetPreferencesResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetPreferences should be invoked
+func (mmGetPreferences *mCoreMockGetPreferences) Times(n uint64) *mCoreMockGetPreferences {
+	if n == 0 {
+		mmGetPreferences.mock.t.Fatalf("Times of CoreMock.GetPreferences mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPreferences.expectedInvocations, n)
+	mmGetPreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPreferences
+}
+
+func (mmGetPreferences *mCoreMockGetPreferences) invocationsDone() bool {
+	if len(mmGetPreferences.expectations) == 0 && mmGetPreferences.defaultExpectation == nil && mmGetPreferences.mock.funcGetPreferences == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPreferences.mock.afterGetPreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPreferences.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPreferences implements mm_usecase.Core
+func (mmGetPreferences *CoreMock) GetPreferences(ctx context.Context, userID uuid.UUID) (p1 user.Preferences, err error) {
+	mm_atomic.AddUint64(&mmGetPreferences.beforeGetPreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPreferences.afterGetPreferencesCounter, 1)
+
+	mmGetPreferences.t.Helper()
+
+	if mmGetPreferences.inspectFuncGetPreferences != nil {
+		mmGetPreferences.inspectFuncGetPreferences(ctx, userID)
+	}
+
+	mm_params := CoreMockGetPreferencesParams{ctx, userID}
+
+	// Record call args
+	mmGetPreferences.GetPreferencesMock.mutex.Lock()
+	mmGetPreferences.GetPreferencesMock.callArgs = append(mmGetPreferences.GetPreferencesMock.callArgs, &mm_params)
+	mmGetPreferences.GetPreferencesMock.mutex.Unlock()
+
+	for _, e := range mmGetPreferences.GetPreferencesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPreferences.GetPreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPreferences.GetPreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPreferences.GetPreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPreferences.GetPreferencesMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetPreferencesParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPreferences.t.Errorf("CoreMock.GetPreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPreferences.t.Errorf("CoreMock.GetPreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPreferences.t.Errorf("CoreMock.GetPreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPreferences.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPreferences.GetPreferencesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPreferences.t.Fatal("No results are set for the CoreMock.GetPreferences")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPreferences.funcGetPreferences != nil {
+		return mmGetPreferences.funcGetPreferences(ctx, userID)
+	}
+	mmGetPreferences.t.Fatalf("Unexpected call to CoreMock.GetPreferences. %v %v", ctx, userID)
+	return
+}
+
+// GetPreferencesAfterCounter returns a count of finished CoreMock.GetPreferences invocations
+func (mmGetPreferences *CoreMock) GetPreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.afterGetPreferencesCounter)
+}
+
+// GetPreferencesBeforeCounter returns a count of CoreMock.GetPreferences invocations
+func (mmGetPreferences *CoreMock) GetPreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPreferences.beforeGetPreferencesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetPreferences.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPreferences *mCoreMockGetPreferences) Calls() []*CoreMockGetPreferencesParams {
+	mmGetPreferences.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetPreferencesParams, len(mmGetPreferences.callArgs))
+	copy(argCopy, mmGetPreferences.callArgs)
+
+	mmGetPreferences.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPreferencesDone returns true if the count of the GetPreferences invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetPreferencesDone() bool {
+	if m.GetPreferencesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPreferencesMock.invocationsDone()
+}
+
+// MinimockGetPreferencesInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetPreferencesInspect() {
+	for _, e := range m.GetPreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetPreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPreferencesCounter := mm_atomic.LoadUint64(&m.afterGetPreferencesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPreferencesMock.defaultExpectation != nil && afterGetPreferencesCounter < 1 {
+		if m.GetPreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetPreferences at\n%s", m.GetPreferencesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetPreferences at\n%s with params: %#v", m.GetPreferencesMock.defaultExpectation.expectationOrigins.origin, *m.GetPreferencesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPreferences != nil && afterGetPreferencesCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetPreferences at\n%s", m.funcGetPreferencesOrigin)
+	}
+
+	if !m.GetPreferencesMock.invocationsDone() && afterGetPreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetPreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPreferencesMock.expectedInvocations), m.GetPreferencesMock.expectedInvocationsOrigin, afterGetPreferencesCounter)
+	}
+}
+
+type mCoreMockGetUser struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockGetUserExpectation
+	expectations       []*CoreMockGetUserExpectation
+
+	callArgs []*CoreMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockGetUserExpectation specifies expectation struct of the Core.GetUser
+type CoreMockGetUserExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockGetUserParams
+	paramPtrs          *CoreMockGetUserParamPtrs
+	expectationOrigins CoreMockGetUserExpectationOrigins
+	results            *CoreMockGetUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockGetUserParams contains parameters of the Core.GetUser
+type CoreMockGetUserParams struct {
+	ctx context.Context
+	id  uuid.UUID
+}
+
+// CoreMockGetUserParamPtrs contains pointers to parameters of the Core.GetUser
+type CoreMockGetUserParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
+}
+
+// CoreMockGetUserResults contains results of the Core.GetUser
+type CoreMockGetUserResults struct {
+	u1  user.User
+	s1  string
+	err error
+}
+
+// CoreMockGetUserOrigins contains origins of expectations of the Core.GetUser
+type CoreMockGetUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mCoreMockGetUser) Optional() *mCoreMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for Core.GetUser
+func (mmGetUser *mCoreMockGetUser) Expect(ctx context.Context, id uuid.UUID) *mCoreMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &CoreMockGetUserParams{ctx, id}
+	mmGetUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.GetUser
 func (mmGetUser *mCoreMockGetUser) ExpectCtxParam1(ctx context.Context) *mCoreMockGetUser {
 	if mmGetUser.mock.funcGetUser != nil {
 		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &CoreMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// ExpectIdParam2 sets up expected param id for Core.GetUser
+func (mmGetUser *mCoreMockGetUser) ExpectIdParam2(id uuid.UUID) *mCoreMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &CoreMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.id = &id
+	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.GetUser
+func (mmGetUser *mCoreMockGetUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for CoreMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by Core.GetUser
+func (mmGetUser *mCoreMockGetUser) Return(u1 user.User, s1 string, err error) *CoreMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &CoreMockGetUserResults{u1, s1, err}
+	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the Core.GetUser method
+func (mmGetUser *mCoreMockGetUser) Set(f func(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error)) *CoreMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the Core.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the Core.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
+	return mmGetUser.mock
+}
+
+// When sets expectation for the Core.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mCoreMockGetUser) When(ctx context.Context, id uuid.UUID) *CoreMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &CoreMockGetUserExpectation{
+		mock:               mmGetUser.mock,
+		params:             &CoreMockGetUserParams{ctx, id},
+		expectationOrigins: CoreMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.GetUser return parameters for the expectation previously defined by the When method
+func (e *CoreMockGetUserExpectation) Then(u1 user.User, s1 string, err error) *CoreMock {
+	e.results = &CoreMockGetUserResults{u1, s1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.GetUser should be invoked
+func (mmGetUser *mCoreMockGetUser) Times(n uint64) *mCoreMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of CoreMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUser
+}
+
+func (mmGetUser *mCoreMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements mm_usecase.Core
+func (mmGetUser *CoreMock) GetUser(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	mmGetUser.t.Helper()
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, id)
+	}
+
+	mm_params := CoreMockGetUserParams{ctx, id}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockGetUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the CoreMock.GetUser")
+		}
+		return (*mm_results).u1, (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, id)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to CoreMock.GetUser. %v %v", ctx, id)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished CoreMock.GetUser invocations
+func (mmGetUser *CoreMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of CoreMock.GetUser invocations
+func (mmGetUser *CoreMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mCoreMockGetUser) Calls() []*CoreMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*CoreMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *CoreMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.GetUser at\n%s", m.funcGetUserOrigin)
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.GetUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	}
+}
+
+type mCoreMockUpdatePreferences struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockUpdatePreferencesExpectation
+	expectations       []*CoreMockUpdatePreferencesExpectation
+
+	callArgs []*CoreMockUpdatePreferencesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockUpdatePreferencesExpectation specifies expectation struct of the Core.UpdatePreferences
+type CoreMockUpdatePreferencesExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockUpdatePreferencesParams
+	paramPtrs          *CoreMockUpdatePreferencesParamPtrs
+	expectationOrigins CoreMockUpdatePreferencesExpectationOrigins
+	results            *CoreMockUpdatePreferencesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockUpdatePreferencesParams contains parameters of the Core.UpdatePreferences
+type CoreMockUpdatePreferencesParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	data   []byte
+}
+
+// CoreMockUpdatePreferencesParamPtrs contains pointers to parameters of the Core.UpdatePreferences
+type CoreMockUpdatePreferencesParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	data   *[]byte
+}
+
+// CoreMockUpdatePreferencesResults contains results of the Core.UpdatePreferences
+type CoreMockUpdatePreferencesResults struct {
+	p1  user.Preferences
+	err error
+}
+
+// CoreMockUpdatePreferencesOrigins contains origins of expectations of the Core.UpdatePreferences
+type CoreMockUpdatePreferencesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originData   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Optional() *mCoreMockUpdatePreferences {
+	mmUpdatePreferences.optional = true
+	return mmUpdatePreferences
+}
+
+// Expect sets up expected params for Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Expect(ctx context.Context, userID uuid.UUID, data []byte) *mCoreMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &CoreMockUpdatePreferencesExpectation{}
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePreferences.defaultExpectation.params = &CoreMockUpdatePreferencesParams{ctx, userID, data}
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePreferences.expectations {
+		if minimock.Equal(e.params, mmUpdatePreferences.defaultExpectation.params) {
+			mmUpdatePreferences.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePreferences.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePreferences
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) ExpectCtxParam1(ctx context.Context) *mCoreMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &CoreMockUpdatePreferencesExpectation{}
+	}
+
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Expect")
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &CoreMockUpdatePreferencesParamPtrs{}
+	}
+	mmUpdatePreferences.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePreferences
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) ExpectUserIDParam2(userID uuid.UUID) *mCoreMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &CoreMockUpdatePreferencesExpectation{}
+	}
+
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Expect")
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &CoreMockUpdatePreferencesParamPtrs{}
+	}
+	mmUpdatePreferences.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdatePreferences
+}
+
+// ExpectDataParam3 sets up expected param data for Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) ExpectDataParam3(data []byte) *mCoreMockUpdatePreferences {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &CoreMockUpdatePreferencesExpectation{}
+	}
+
+	if mmUpdatePreferences.defaultExpectation.params != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Expect")
+	}
+
+	if mmUpdatePreferences.defaultExpectation.paramPtrs == nil {
+		mmUpdatePreferences.defaultExpectation.paramPtrs = &CoreMockUpdatePreferencesParamPtrs{}
+	}
+	mmUpdatePreferences.defaultExpectation.paramPtrs.data = &data
+	mmUpdatePreferences.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmUpdatePreferences
+}
+
+// Inspect accepts an inspector function that has same arguments as the Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Inspect(f func(ctx context.Context, userID uuid.UUID, data []byte)) *mCoreMockUpdatePreferences {
+	if mmUpdatePreferences.mock.inspectFuncUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("Inspect function is already set for CoreMock.UpdatePreferences")
+	}
+
+	mmUpdatePreferences.mock.inspectFuncUpdatePreferences = f
+
+	return mmUpdatePreferences
+}
+
+// Return sets up results that will be returned by Core.UpdatePreferences
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Return(p1 user.Preferences, err error) *CoreMock {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	if mmUpdatePreferences.defaultExpectation == nil {
+		mmUpdatePreferences.defaultExpectation = &CoreMockUpdatePreferencesExpectation{mock: mmUpdatePreferences.mock}
+	}
+	mmUpdatePreferences.defaultExpectation.results = &CoreMockUpdatePreferencesResults{p1, err}
+	mmUpdatePreferences.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences.mock
+}
+
+// Set uses given function f to mock the Core.UpdatePreferences method
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Set(f func(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error)) *CoreMock {
+	if mmUpdatePreferences.defaultExpectation != nil {
+		mmUpdatePreferences.mock.t.Fatalf("Default expectation is already set for the Core.UpdatePreferences method")
+	}
+
+	if len(mmUpdatePreferences.expectations) > 0 {
+		mmUpdatePreferences.mock.t.Fatalf("Some expectations are already set for the Core.UpdatePreferences method")
+	}
+
+	mmUpdatePreferences.mock.funcUpdatePreferences = f
+	mmUpdatePreferences.mock.funcUpdatePreferencesOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences.mock
+}
+
+// When sets expectation for the Core.UpdatePreferences which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) When(ctx context.Context, userID uuid.UUID, data []byte) *CoreMockUpdatePreferencesExpectation {
+	if mmUpdatePreferences.mock.funcUpdatePreferences != nil {
+		mmUpdatePreferences.mock.t.Fatalf("CoreMock.UpdatePreferences mock is already set by Set")
+	}
+
+	expectation := &CoreMockUpdatePreferencesExpectation{
+		mock:               mmUpdatePreferences.mock,
+		params:             &CoreMockUpdatePreferencesParams{ctx, userID, data},
+		expectationOrigins: CoreMockUpdatePreferencesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePreferences.expectations = append(mmUpdatePreferences.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Core.UpdatePreferences return parameters for the expectation previously defined by the When method
+func (e *CoreMockUpdatePreferencesExpectation) Then(p1 user.Preferences, err error) *CoreMock {
+	e.results = &CoreMockUpdatePreferencesResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times Core.UpdatePreferences should be invoked
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Times(n uint64) *mCoreMockUpdatePreferences {
+	if n == 0 {
+		mmUpdatePreferences.mock.t.Fatalf("Times of CoreMock.UpdatePreferences mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePreferences.expectedInvocations, n)
+	mmUpdatePreferences.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePreferences
+}
+
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) invocationsDone() bool {
+	if len(mmUpdatePreferences.expectations) == 0 && mmUpdatePreferences.defaultExpectation == nil && mmUpdatePreferences.mock.funcUpdatePreferences == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePreferences.mock.afterUpdatePreferencesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePreferences.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePreferences implements mm_usecase.Core
+func (mmUpdatePreferences *CoreMock) UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (p1 user.Preferences, err error) {
+	mm_atomic.AddUint64(&mmUpdatePreferences.beforeUpdatePreferencesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePreferences.afterUpdatePreferencesCounter, 1)
+
+	mmUpdatePreferences.t.Helper()
+
+	if mmUpdatePreferences.inspectFuncUpdatePreferences != nil {
+		mmUpdatePreferences.inspectFuncUpdatePreferences(ctx, userID, data)
+	}
+
+	mm_params := CoreMockUpdatePreferencesParams{ctx, userID, data}
+
+	// Record call args
+	mmUpdatePreferences.UpdatePreferencesMock.mutex.Lock()
+	mmUpdatePreferences.UpdatePreferencesMock.callArgs = append(mmUpdatePreferences.UpdatePreferencesMock.callArgs, &mm_params)
+	mmUpdatePreferences.UpdatePreferencesMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePreferences.UpdatePreferencesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Expect")
+	if mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.paramPtrs
+
+		mm_got := CoreMockUpdatePreferencesParams{ctx, userID, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePreferences.t.Errorf("CoreMock.UpdatePreferences got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdatePreferences.t.Errorf("CoreMock.UpdatePreferences got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmUpdatePreferences.t.Errorf("CoreMock.UpdatePreferences got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePreferences.t.Errorf("CoreMock.UpdatePreferences got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePreferences.UpdatePreferencesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePreferences.t.Fatal("No results are set for the CoreMock.UpdatePreferences")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmUpdatePreferences.funcUpdatePreferences != nil {
+		return mmUpdatePreferences.funcUpdatePreferences(ctx, userID, data)
 	}
+	mmUpdatePreferences.t.Fatalf("Unexpected call to CoreMock.UpdatePreferences. %v %v %v", ctx, userID, data)
+	return
+}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &CoreMockGetUserParamPtrs{}
+// UpdatePreferencesAfterCounter returns a count of finished CoreMock.UpdatePreferences invocations
+func (mmUpdatePreferences *CoreMock) UpdatePreferencesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreferences.afterUpdatePreferencesCounter)
+}
+
+// UpdatePreferencesBeforeCounter returns a count of CoreMock.UpdatePreferences invocations
+func (mmUpdatePreferences *CoreMock) UpdatePreferencesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePreferences.beforeUpdatePreferencesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoreMock.UpdatePreferences.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePreferences *mCoreMockUpdatePreferences) Calls() []*CoreMockUpdatePreferencesParams {
+	mmUpdatePreferences.mutex.RLock()
+
+	argCopy := make([]*CoreMockUpdatePreferencesParams, len(mmUpdatePreferences.callArgs))
+	copy(argCopy, mmUpdatePreferences.callArgs)
+
+	mmUpdatePreferences.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePreferencesDone returns true if the count of the UpdatePreferences invocations corresponds
+// the number of defined expectations
+func (m *CoreMock) MinimockUpdatePreferencesDone() bool {
+	if m.UpdatePreferencesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
-	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUser
+	for _, e := range m.UpdatePreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePreferencesMock.invocationsDone()
 }
 
-// ExpectIdParam2 sets up expected param id for Core.GetUser
-func (mmGetUser *mCoreMockGetUser) ExpectIdParam2(id uuid.UUID) *mCoreMockGetUser {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+// MinimockUpdatePreferencesInspect logs each unmet expectation
+func (m *CoreMock) MinimockUpdatePreferencesInspect() {
+	for _, e := range m.UpdatePreferencesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoreMock.UpdatePreferences at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{}
+	afterUpdatePreferencesCounter := mm_atomic.LoadUint64(&m.afterUpdatePreferencesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePreferencesMock.defaultExpectation != nil && afterUpdatePreferencesCounter < 1 {
+		if m.UpdatePreferencesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.UpdatePreferences at\n%s", m.UpdatePreferencesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CoreMock.UpdatePreferences at\n%s with params: %#v", m.UpdatePreferencesMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePreferencesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePreferences != nil && afterUpdatePreferencesCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.UpdatePreferences at\n%s", m.funcUpdatePreferencesOrigin)
 	}
 
-	if mmGetUser.defaultExpectation.params != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Expect")
+	if !m.UpdatePreferencesMock.invocationsDone() && afterUpdatePreferencesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.UpdatePreferences at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePreferencesMock.expectedInvocations), m.UpdatePreferencesMock.expectedInvocationsOrigin, afterUpdatePreferencesCounter)
 	}
+}
 
-	if mmGetUser.defaultExpectation.paramPtrs == nil {
-		mmGetUser.defaultExpectation.paramPtrs = &CoreMockGetUserParamPtrs{}
+type mCoreMockUpdateProfile struct {
+	optional           bool
+	mock               *CoreMock
+	defaultExpectation *CoreMockUpdateProfileExpectation
+	expectations       []*CoreMockUpdateProfileExpectation
+
+	callArgs []*CoreMockUpdateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CoreMockUpdateProfileExpectation specifies expectation struct of the Core.UpdateProfile
+type CoreMockUpdateProfileExpectation struct {
+	mock               *CoreMock
+	params             *CoreMockUpdateProfileParams
+	paramPtrs          *CoreMockUpdateProfileParamPtrs
+	expectationOrigins CoreMockUpdateProfileExpectationOrigins
+	results            *CoreMockUpdateProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CoreMockUpdateProfileParams contains parameters of the Core.UpdateProfile
+type CoreMockUpdateProfileParams struct {
+	ctx context.Context
+	req user.UpdateProfileReq
+}
+
+// CoreMockUpdateProfileParamPtrs contains pointers to parameters of the Core.UpdateProfile
+type CoreMockUpdateProfileParamPtrs struct {
+	ctx *context.Context
+	req *user.UpdateProfileReq
+}
+
+// CoreMockUpdateProfileResults contains results of the Core.UpdateProfile
+type CoreMockUpdateProfileResults struct {
+	err error
+}
+
+// CoreMockUpdateProfileOrigins contains origins of expectations of the Core.UpdateProfile
+type CoreMockUpdateProfileExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateProfile *mCoreMockUpdateProfile) Optional() *mCoreMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
+}
+
+// Expect sets up expected params for Core.UpdateProfile
+func (mmUpdateProfile *mCoreMockUpdateProfile) Expect(ctx context.Context, req user.UpdateProfileReq) *mCoreMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Set")
 	}
-	mmGetUser.defaultExpectation.paramPtrs.id = &id
-	mmGetUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGetUser
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &CoreMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateProfile.defaultExpectation.params = &CoreMockUpdateProfileParams{ctx, req}
+	mmUpdateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateProfile
 }
 
-// Inspect accepts an inspector function that has same arguments as the Core.GetUser
-func (mmGetUser *mCoreMockGetUser) Inspect(f func(ctx context.Context, id uuid.UUID)) *mCoreMockGetUser {
-	if mmGetUser.mock.inspectFuncGetUser != nil {
-		mmGetUser.mock.t.Fatalf("Inspect function is already set for CoreMock.GetUser")
+// ExpectCtxParam1 sets up expected param ctx for Core.UpdateProfile
+func (mmUpdateProfile *mCoreMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mCoreMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Set")
 	}
 
-	mmGetUser.mock.inspectFuncGetUser = f
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &CoreMockUpdateProfileExpectation{}
+	}
 
-	return mmGetUser
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &CoreMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
 }
 
-// Return sets up results that will be returned by Core.GetUser
-func (mmGetUser *mCoreMockGetUser) Return(u1 user.User, s1 string, err error) *CoreMock {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Core.UpdateProfile
+func (mmUpdateProfile *mCoreMockUpdateProfile) ExpectReqParam2(req user.UpdateProfileReq) *mCoreMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Set")
 	}
 
-	if mmGetUser.defaultExpectation == nil {
-		mmGetUser.defaultExpectation = &CoreMockGetUserExpectation{mock: mmGetUser.mock}
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &CoreMockUpdateProfileExpectation{}
 	}
-	mmGetUser.defaultExpectation.results = &CoreMockGetUserResults{u1, s1, err}
-	mmGetUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &CoreMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.req = &req
+	mmUpdateProfile.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUpdateProfile
 }
 
-// Set uses given function f to mock the Core.GetUser method
-func (mmGetUser *mCoreMockGetUser) Set(f func(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error)) *CoreMock {
-	if mmGetUser.defaultExpectation != nil {
-		mmGetUser.mock.t.Fatalf("Default expectation is already set for the Core.GetUser method")
+// Inspect accepts an inspector function that has same arguments as the Core.UpdateProfile
+func (mmUpdateProfile *mCoreMockUpdateProfile) Inspect(f func(ctx context.Context, req user.UpdateProfileReq)) *mCoreMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for CoreMock.UpdateProfile")
 	}
 
-	if len(mmGetUser.expectations) > 0 {
-		mmGetUser.mock.t.Fatalf("Some expectations are already set for the Core.GetUser method")
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
+
+	return mmUpdateProfile
+}
+
+// Return sets up results that will be returned by Core.UpdateProfile
+func (mmUpdateProfile *mCoreMockUpdateProfile) Return(err error) *CoreMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Set")
 	}
 
-	mmGetUser.mock.funcGetUser = f
-	mmGetUser.mock.funcGetUserOrigin = minimock.CallerInfo(1)
-	return mmGetUser.mock
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &CoreMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
+	}
+	mmUpdateProfile.defaultExpectation.results = &CoreMockUpdateProfileResults{err}
+	mmUpdateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
 }
 
-// When sets expectation for the Core.GetUser which will trigger the result defined by the following
+// Set uses given function f to mock the Core.UpdateProfile method
+func (mmUpdateProfile *mCoreMockUpdateProfile) Set(f func(ctx context.Context, req user.UpdateProfileReq) (err error)) *CoreMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the Core.UpdateProfile method")
+	}
+
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the Core.UpdateProfile method")
+	}
+
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	mmUpdateProfile.mock.funcUpdateProfileOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile.mock
+}
+
+// When sets expectation for the Core.UpdateProfile which will trigger the result defined by the following
 // Then helper
-func (mmGetUser *mCoreMockGetUser) When(ctx context.Context, id uuid.UUID) *CoreMockGetUserExpectation {
-	if mmGetUser.mock.funcGetUser != nil {
-		mmGetUser.mock.t.Fatalf("CoreMock.GetUser mock is already set by Set")
+func (mmUpdateProfile *mCoreMockUpdateProfile) When(ctx context.Context, req user.UpdateProfileReq) *CoreMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("CoreMock.UpdateProfile mock is already set by Set")
 	}
 
-	expectation := &CoreMockGetUserExpectation{
-		mock:               mmGetUser.mock,
-		params:             &CoreMockGetUserParams{ctx, id},
-		expectationOrigins: CoreMockGetUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CoreMockUpdateProfileExpectation{
+		mock:               mmUpdateProfile.mock,
+		params:             &CoreMockUpdateProfileParams{ctx, req},
+		expectationOrigins: CoreMockUpdateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Core.GetUser return parameters for the expectation previously defined by the When method
-func (e *CoreMockGetUserExpectation) Then(u1 user.User, s1 string, err error) *CoreMock {
-	e.results = &CoreMockGetUserResults{u1, s1, err}
+// Then sets up Core.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *CoreMockUpdateProfileExpectation) Then(err error) *CoreMock {
+	e.results = &CoreMockUpdateProfileResults{err}
 	return e.mock
 }
 
-// Times sets number of times Core.GetUser should be invoked
-func (mmGetUser *mCoreMockGetUser) Times(n uint64) *mCoreMockGetUser {
+// Times sets number of times Core.UpdateProfile should be invoked
+func (mmUpdateProfile *mCoreMockUpdateProfile) Times(n uint64) *mCoreMockUpdateProfile {
 	if n == 0 {
-		mmGetUser.mock.t.Fatalf("Times of CoreMock.GetUser mock can not be zero")
+		mmUpdateProfile.mock.t.Fatalf("Times of CoreMock.UpdateProfile mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
-	mmGetUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUser
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	mmUpdateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateProfile
 }
 
-func (mmGetUser *mCoreMockGetUser) invocationsDone() bool {
-	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+func (mmUpdateProfile *mCoreMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUser implements mm_usecase.Core
-func (mmGetUser *CoreMock) GetUser(ctx context.Context, id uuid.UUID) (u1 user.User, s1 string, err error) {
-	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+// UpdateProfile implements mm_usecase.Core
+func (mmUpdateProfile *CoreMock) UpdateProfile(ctx context.Context, req user.UpdateProfileReq) (err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
 
-	mmGetUser.t.Helper()
+	mmUpdateProfile.t.Helper()
 
-	if mmGetUser.inspectFuncGetUser != nil {
-		mmGetUser.inspectFuncGetUser(ctx, id)
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, req)
 	}
 
-	mm_params := CoreMockGetUserParams{ctx, id}
+	mm_params := CoreMockUpdateProfileParams{ctx, req}
 
 	// Record call args
-	mmGetUser.GetUserMock.mutex.Lock()
-	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
-	mmGetUser.GetUserMock.mutex.Unlock()
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
 
-	for _, e := range mmGetUser.GetUserMock.expectations {
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUser.GetUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
 
-		mm_got := CoreMockGetUserParams{ctx, id}
+		mm_got := CoreMockUpdateProfileParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateProfile.t.Errorf("CoreMock.UpdateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUpdateProfile.t.Errorf("CoreMock.UpdateProfile got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUser.t.Errorf("CoreMock.GetUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUser.GetUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateProfile.t.Errorf("CoreMock.UpdateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateProfile.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUser.t.Fatal("No results are set for the CoreMock.GetUser")
+			mmUpdateProfile.t.Fatal("No results are set for the CoreMock.UpdateProfile")
 		}
-		return (*mm_results).u1, (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUser.funcGetUser != nil {
-		return mmGetUser.funcGetUser(ctx, id)
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, req)
 	}
-	mmGetUser.t.Fatalf("Unexpected call to CoreMock.GetUser. %v %v", ctx, id)
+	mmUpdateProfile.t.Fatalf("Unexpected call to CoreMock.UpdateProfile. %v %v", ctx, req)
 	return
 }
 
-// GetUserAfterCounter returns a count of finished CoreMock.GetUser invocations
-func (mmGetUser *CoreMock) GetUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+// UpdateProfileAfterCounter returns a count of finished CoreMock.UpdateProfile invocations
+func (mmUpdateProfile *CoreMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
 }
 
-// GetUserBeforeCounter returns a count of CoreMock.GetUser invocations
-func (mmGetUser *CoreMock) GetUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+// UpdateProfileBeforeCounter returns a count of CoreMock.UpdateProfile invocations
+func (mmUpdateProfile *CoreMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
 }
 
-// Calls returns a list of arguments used in each call to CoreMock.GetUser.
+// Calls returns a list of arguments used in each call to CoreMock.UpdateProfile.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUser *mCoreMockGetUser) Calls() []*CoreMockGetUserParams {
-	mmGetUser.mutex.RLock()
+func (mmUpdateProfile *mCoreMockUpdateProfile) Calls() []*CoreMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
 
-	argCopy := make([]*CoreMockGetUserParams, len(mmGetUser.callArgs))
-	copy(argCopy, mmGetUser.callArgs)
+	argCopy := make([]*CoreMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
 
-	mmGetUser.mutex.RUnlock()
+	mmUpdateProfile.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
 // the number of defined expectations
-func (m *CoreMock) MinimockGetUserDone() bool {
-	if m.GetUserMock.optional {
+func (m *CoreMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserMock.expectations {
+	for _, e := range m.UpdateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserMock.invocationsDone()
+	return m.UpdateProfileMock.invocationsDone()
 }
 
-// MinimockGetUserInspect logs each unmet expectation
-func (m *CoreMock) MinimockGetUserInspect() {
-	for _, e := range m.GetUserMock.expectations {
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *CoreMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CoreMock.UpdateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
-		if m.GetUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s", m.GetUserMock.defaultExpectation.returnOrigin)
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CoreMock.UpdateProfile at\n%s", m.UpdateProfileMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CoreMock.GetUser at\n%s with params: %#v", m.GetUserMock.defaultExpectation.expectationOrigins.origin, *m.GetUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CoreMock.UpdateProfile at\n%s with params: %#v", m.UpdateProfileMock.defaultExpectation.expectationOrigins.origin, *m.UpdateProfileMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUser != nil && afterGetUserCounter < 1 {
-		m.t.Errorf("Expected call to CoreMock.GetUser at\n%s", m.funcGetUserOrigin)
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Errorf("Expected call to CoreMock.UpdateProfile at\n%s", m.funcUpdateProfileOrigin)
 	}
 
-	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to CoreMock.GetUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), m.GetUserMock.expectedInvocationsOrigin, afterGetUserCounter)
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to CoreMock.UpdateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), m.UpdateProfileMock.expectedInvocationsOrigin, afterUpdateProfileCounter)
 	}
 }
 
@@ -2154,6 +3626,8 @@ func (m *CoreMock) MinimockUpdateUserInspect() {
 func (m *CoreMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAnonymizeUserInspect()
+
 			m.MinimockChangePasswordInspect()
 
 			m.MinimockCreateUserInspect()
@@ -2162,8 +3636,14 @@ func (m *CoreMock) MinimockFinish() {
 
 			m.MinimockGetAllUsersInspect()
 
+			m.MinimockGetPreferencesInspect()
+
 			m.MinimockGetUserInspect()
 
+			m.MinimockUpdatePreferencesInspect()
+
+			m.MinimockUpdateProfileInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -2188,10 +3668,14 @@ func (m *CoreMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CoreMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAnonymizeUserDone() &&
 		m.MinimockChangePasswordDone() &&
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetAllUsersDone() &&
+		m.MinimockGetPreferencesDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockUpdatePreferencesDone() &&
+		m.MinimockUpdateProfileDone() &&
 		m.MinimockUpdateUserDone()
 }
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user/usecase.DataExports -o data_exports_mock.go -n DataExportsMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// DataExportsMock implements mm_usecase.DataExports
+type DataExportsMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteByUser          func(ctx context.Context, userID uuid.UUID) (i1 int, err error)
+	funcDeleteByUserOrigin    string
+	inspectFuncDeleteByUser   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteByUserCounter  uint64
+	beforeDeleteByUserCounter uint64
+	DeleteByUserMock          mDataExportsMockDeleteByUser
+}
+
+// NewDataExportsMock returns a mock for mm_usecase.DataExports
+func NewDataExportsMock(t minimock.Tester) *DataExportsMock {
+	m := &DataExportsMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteByUserMock = mDataExportsMockDeleteByUser{mock: m}
+	m.DeleteByUserMock.callArgs = []*DataExportsMockDeleteByUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDataExportsMockDeleteByUser struct {
+	optional           bool
+	mock               *DataExportsMock
+	defaultExpectation *DataExportsMockDeleteByUserExpectation
+	expectations       []*DataExportsMockDeleteByUserExpectation
+
+	callArgs []*DataExportsMockDeleteByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DataExportsMockDeleteByUserExpectation specifies expectation struct of the DataExports.DeleteByUser
+type DataExportsMockDeleteByUserExpectation struct {
+	mock               *DataExportsMock
+	params             *DataExportsMockDeleteByUserParams
+	paramPtrs          *DataExportsMockDeleteByUserParamPtrs
+	expectationOrigins DataExportsMockDeleteByUserExpectationOrigins
+	results            *DataExportsMockDeleteByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DataExportsMockDeleteByUserParams contains parameters of the DataExports.DeleteByUser
+type DataExportsMockDeleteByUserParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// DataExportsMockDeleteByUserParamPtrs contains pointers to parameters of the DataExports.DeleteByUser
+type DataExportsMockDeleteByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// DataExportsMockDeleteByUserResults contains results of the DataExports.DeleteByUser
+type DataExportsMockDeleteByUserResults struct {
+	i1  int
+	err error
+}
+
+// DataExportsMockDeleteByUserOrigins contains origins of expectations of the DataExports.DeleteByUser
+type DataExportsMockDeleteByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Optional() *mDataExportsMockDeleteByUser {
+	mmDeleteByUser.optional = true
+	return mmDeleteByUser
+}
+
+// Expect sets up expected params for DataExports.DeleteByUser
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Expect(ctx context.Context, userID uuid.UUID) *mDataExportsMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &DataExportsMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteByUser.defaultExpectation.params = &DataExportsMockDeleteByUserParams{ctx, userID}
+	mmDeleteByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteByUser.expectations {
+		if minimock.Equal(e.params, mmDeleteByUser.defaultExpectation.params) {
+			mmDeleteByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DataExports.DeleteByUser
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) ExpectCtxParam1(ctx context.Context) *mDataExportsMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &DataExportsMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &DataExportsMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for DataExports.DeleteByUser
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) ExpectUserIDParam2(userID uuid.UUID) *mDataExportsMockDeleteByUser {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &DataExportsMockDeleteByUserExpectation{}
+	}
+
+	if mmDeleteByUser.defaultExpectation.params != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Expect")
+	}
+
+	if mmDeleteByUser.defaultExpectation.paramPtrs == nil {
+		mmDeleteByUser.defaultExpectation.paramPtrs = &DataExportsMockDeleteByUserParamPtrs{}
+	}
+	mmDeleteByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the DataExports.DeleteByUser
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mDataExportsMockDeleteByUser {
+	if mmDeleteByUser.mock.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("Inspect function is already set for DataExportsMock.DeleteByUser")
+	}
+
+	mmDeleteByUser.mock.inspectFuncDeleteByUser = f
+
+	return mmDeleteByUser
+}
+
+// Return sets up results that will be returned by DataExports.DeleteByUser
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Return(i1 int, err error) *DataExportsMock {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Set")
+	}
+
+	if mmDeleteByUser.defaultExpectation == nil {
+		mmDeleteByUser.defaultExpectation = &DataExportsMockDeleteByUserExpectation{mock: mmDeleteByUser.mock}
+	}
+	mmDeleteByUser.defaultExpectation.results = &DataExportsMockDeleteByUserResults{i1, err}
+	mmDeleteByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// Set uses given function f to mock the DataExports.DeleteByUser method
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Set(f func(ctx context.Context, userID uuid.UUID) (i1 int, err error)) *DataExportsMock {
+	if mmDeleteByUser.defaultExpectation != nil {
+		mmDeleteByUser.mock.t.Fatalf("Default expectation is already set for the DataExports.DeleteByUser method")
+	}
+
+	if len(mmDeleteByUser.expectations) > 0 {
+		mmDeleteByUser.mock.t.Fatalf("Some expectations are already set for the DataExports.DeleteByUser method")
+	}
+
+	mmDeleteByUser.mock.funcDeleteByUser = f
+	mmDeleteByUser.mock.funcDeleteByUserOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser.mock
+}
+
+// When sets expectation for the DataExports.DeleteByUser which will trigger the result defined by the following
+// Then helper
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) When(ctx context.Context, userID uuid.UUID) *DataExportsMockDeleteByUserExpectation {
+	if mmDeleteByUser.mock.funcDeleteByUser != nil {
+		mmDeleteByUser.mock.t.Fatalf("DataExportsMock.DeleteByUser mock is already set by Set")
+	}
+
+	expectation := &DataExportsMockDeleteByUserExpectation{
+		mock:               mmDeleteByUser.mock,
+		params:             &DataExportsMockDeleteByUserParams{ctx, userID},
+		expectationOrigins: DataExportsMockDeleteByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteByUser.expectations = append(mmDeleteByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DataExports.DeleteByUser return parameters for the expectation previously defined by the When method
+func (e *DataExportsMockDeleteByUserExpectation) Then(i1 int, err error) *DataExportsMock {
+	e.results = &DataExportsMockDeleteByUserResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times DataExports.DeleteByUser should be invoked
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Times(n uint64) *mDataExportsMockDeleteByUser {
+	if n == 0 {
+		mmDeleteByUser.mock.t.Fatalf("Times of DataExportsMock.DeleteByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteByUser.expectedInvocations, n)
+	mmDeleteByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteByUser
+}
+
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) invocationsDone() bool {
+	if len(mmDeleteByUser.expectations) == 0 && mmDeleteByUser.defaultExpectation == nil && mmDeleteByUser.mock.funcDeleteByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.mock.afterDeleteByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteByUser implements mm_usecase.DataExports
+func (mmDeleteByUser *DataExportsMock) DeleteByUser(ctx context.Context, userID uuid.UUID) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmDeleteByUser.beforeDeleteByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteByUser.afterDeleteByUserCounter, 1)
+
+	mmDeleteByUser.t.Helper()
+
+	if mmDeleteByUser.inspectFuncDeleteByUser != nil {
+		mmDeleteByUser.inspectFuncDeleteByUser(ctx, userID)
+	}
+
+	mm_params := DataExportsMockDeleteByUserParams{ctx, userID}
+
+	// Record call args
+	mmDeleteByUser.DeleteByUserMock.mutex.Lock()
+	mmDeleteByUser.DeleteByUserMock.callArgs = append(mmDeleteByUser.DeleteByUserMock.callArgs, &mm_params)
+	mmDeleteByUser.DeleteByUserMock.mutex.Unlock()
+
+	for _, e := range mmDeleteByUser.DeleteByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteByUser.DeleteByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteByUser.DeleteByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteByUser.DeleteByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteByUser.DeleteByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := DataExportsMockDeleteByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteByUser.t.Errorf("DataExportsMock.DeleteByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteByUser.t.Errorf("DataExportsMock.DeleteByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteByUser.t.Errorf("DataExportsMock.DeleteByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteByUser.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteByUser.DeleteByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteByUser.t.Fatal("No results are set for the DataExportsMock.DeleteByUser")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteByUser.funcDeleteByUser != nil {
+		return mmDeleteByUser.funcDeleteByUser(ctx, userID)
+	}
+	mmDeleteByUser.t.Fatalf("Unexpected call to DataExportsMock.DeleteByUser. %v %v", ctx, userID)
+	return
+}
+
+// DeleteByUserAfterCounter returns a count of finished DataExportsMock.DeleteByUser invocations
+func (mmDeleteByUser *DataExportsMock) DeleteByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.afterDeleteByUserCounter)
+}
+
+// DeleteByUserBeforeCounter returns a count of DataExportsMock.DeleteByUser invocations
+func (mmDeleteByUser *DataExportsMock) DeleteByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteByUser.beforeDeleteByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to DataExportsMock.DeleteByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteByUser *mDataExportsMockDeleteByUser) Calls() []*DataExportsMockDeleteByUserParams {
+	mmDeleteByUser.mutex.RLock()
+
+	argCopy := make([]*DataExportsMockDeleteByUserParams, len(mmDeleteByUser.callArgs))
+	copy(argCopy, mmDeleteByUser.callArgs)
+
+	mmDeleteByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteByUserDone returns true if the count of the DeleteByUser invocations corresponds
+// the number of defined expectations
+func (m *DataExportsMock) MinimockDeleteByUserDone() bool {
+	if m.DeleteByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteByUserMock.invocationsDone()
+}
+
+// MinimockDeleteByUserInspect logs each unmet expectation
+func (m *DataExportsMock) MinimockDeleteByUserInspect() {
+	for _, e := range m.DeleteByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DataExportsMock.DeleteByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteByUserCounter := mm_atomic.LoadUint64(&m.afterDeleteByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteByUserMock.defaultExpectation != nil && afterDeleteByUserCounter < 1 {
+		if m.DeleteByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DataExportsMock.DeleteByUser at\n%s", m.DeleteByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DataExportsMock.DeleteByUser at\n%s with params: %#v", m.DeleteByUserMock.defaultExpectation.expectationOrigins.origin, *m.DeleteByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteByUser != nil && afterDeleteByUserCounter < 1 {
+		m.t.Errorf("Expected call to DataExportsMock.DeleteByUser at\n%s", m.funcDeleteByUserOrigin)
+	}
+
+	if !m.DeleteByUserMock.invocationsDone() && afterDeleteByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to DataExportsMock.DeleteByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteByUserMock.expectedInvocations), m.DeleteByUserMock.expectedInvocationsOrigin, afterDeleteByUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DataExportsMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteByUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DataExportsMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DataExportsMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteByUserDone()
+}
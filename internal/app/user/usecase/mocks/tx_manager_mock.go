@@ -0,0 +1,416 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user/usecase.TxManager -o tx_manager_mock.go -n TxManagerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TxManagerMock implements mm_usecase.TxManager
+type TxManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDo          func(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	funcDoOrigin    string
+	inspectFuncDo   func(ctx context.Context, fn func(ctx context.Context) error)
+	afterDoCounter  uint64
+	beforeDoCounter uint64
+	DoMock          mTxManagerMockDo
+}
+
+// NewTxManagerMock returns a mock for mm_usecase.TxManager
+func NewTxManagerMock(t minimock.Tester) *TxManagerMock {
+	m := &TxManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DoMock = mTxManagerMockDo{mock: m}
+	m.DoMock.callArgs = []*TxManagerMockDoParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTxManagerMockDo struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockDoExpectation
+	expectations       []*TxManagerMockDoExpectation
+
+	callArgs []*TxManagerMockDoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockDoExpectation specifies expectation struct of the TxManager.Do
+type TxManagerMockDoExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockDoParams
+	paramPtrs          *TxManagerMockDoParamPtrs
+	expectationOrigins TxManagerMockDoExpectationOrigins
+	results            *TxManagerMockDoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockDoParams contains parameters of the TxManager.Do
+type TxManagerMockDoParams struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// TxManagerMockDoParamPtrs contains pointers to parameters of the TxManager.Do
+type TxManagerMockDoParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctx context.Context) error
+}
+
+// TxManagerMockDoResults contains results of the TxManager.Do
+type TxManagerMockDoResults struct {
+	err error
+}
+
+// TxManagerMockDoOrigins contains origins of expectations of the TxManager.Do
+type TxManagerMockDoExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDo *mTxManagerMockDo) Optional() *mTxManagerMockDo {
+	mmDo.optional = true
+	return mmDo
+}
+
+// Expect sets up expected params for TxManager.Do
+func (mmDo *mTxManagerMockDo) Expect(ctx context.Context, fn func(ctx context.Context) error) *mTxManagerMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &TxManagerMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.paramPtrs != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by ExpectParams functions")
+	}
+
+	mmDo.defaultExpectation.params = &TxManagerMockDoParams{ctx, fn}
+	mmDo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDo.expectations {
+		if minimock.Equal(e.params, mmDo.defaultExpectation.params) {
+			mmDo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDo.defaultExpectation.params)
+		}
+	}
+
+	return mmDo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.Do
+func (mmDo *mTxManagerMockDo) ExpectCtxParam1(ctx context.Context) *mTxManagerMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &TxManagerMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &TxManagerMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// ExpectFnParam2 sets up expected param fn for TxManager.Do
+func (mmDo *mTxManagerMockDo) ExpectFnParam2(fn func(ctx context.Context) error) *mTxManagerMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &TxManagerMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &TxManagerMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.fn = &fn
+	mmDo.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.Do
+func (mmDo *mTxManagerMockDo) Inspect(f func(ctx context.Context, fn func(ctx context.Context) error)) *mTxManagerMockDo {
+	if mmDo.mock.inspectFuncDo != nil {
+		mmDo.mock.t.Fatalf("Inspect function is already set for TxManagerMock.Do")
+	}
+
+	mmDo.mock.inspectFuncDo = f
+
+	return mmDo
+}
+
+// Return sets up results that will be returned by TxManager.Do
+func (mmDo *mTxManagerMockDo) Return(err error) *TxManagerMock {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &TxManagerMockDoExpectation{mock: mmDo.mock}
+	}
+	mmDo.defaultExpectation.results = &TxManagerMockDoResults{err}
+	mmDo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDo.mock
+}
+
+// Set uses given function f to mock the TxManager.Do method
+func (mmDo *mTxManagerMockDo) Set(f func(ctx context.Context, fn func(ctx context.Context) error) (err error)) *TxManagerMock {
+	if mmDo.defaultExpectation != nil {
+		mmDo.mock.t.Fatalf("Default expectation is already set for the TxManager.Do method")
+	}
+
+	if len(mmDo.expectations) > 0 {
+		mmDo.mock.t.Fatalf("Some expectations are already set for the TxManager.Do method")
+	}
+
+	mmDo.mock.funcDo = f
+	mmDo.mock.funcDoOrigin = minimock.CallerInfo(1)
+	return mmDo.mock
+}
+
+// When sets expectation for the TxManager.Do which will trigger the result defined by the following
+// Then helper
+func (mmDo *mTxManagerMockDo) When(ctx context.Context, fn func(ctx context.Context) error) *TxManagerMockDoExpectation {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("TxManagerMock.Do mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockDoExpectation{
+		mock:               mmDo.mock,
+		params:             &TxManagerMockDoParams{ctx, fn},
+		expectationOrigins: TxManagerMockDoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDo.expectations = append(mmDo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.Do return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockDoExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockDoResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.Do should be invoked
+func (mmDo *mTxManagerMockDo) Times(n uint64) *mTxManagerMockDo {
+	if n == 0 {
+		mmDo.mock.t.Fatalf("Times of TxManagerMock.Do mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDo.expectedInvocations, n)
+	mmDo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDo
+}
+
+func (mmDo *mTxManagerMockDo) invocationsDone() bool {
+	if len(mmDo.expectations) == 0 && mmDo.defaultExpectation == nil && mmDo.mock.funcDo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDo.mock.afterDoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Do implements mm_usecase.TxManager
+func (mmDo *TxManagerMock) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmDo.beforeDoCounter, 1)
+	defer mm_atomic.AddUint64(&mmDo.afterDoCounter, 1)
+
+	mmDo.t.Helper()
+
+	if mmDo.inspectFuncDo != nil {
+		mmDo.inspectFuncDo(ctx, fn)
+	}
+
+	mm_params := TxManagerMockDoParams{ctx, fn}
+
+	// Record call args
+	mmDo.DoMock.mutex.Lock()
+	mmDo.DoMock.callArgs = append(mmDo.DoMock.callArgs, &mm_params)
+	mmDo.DoMock.mutex.Unlock()
+
+	for _, e := range mmDo.DoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDo.DoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDo.DoMock.defaultExpectation.Counter, 1)
+		mm_want := mmDo.DoMock.defaultExpectation.params
+		mm_want_ptrs := mmDo.DoMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockDoParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDo.t.Errorf("TxManagerMock.Do got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmDo.t.Errorf("TxManagerMock.Do got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDo.t.Errorf("TxManagerMock.Do got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDo.DoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDo.DoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDo.t.Fatal("No results are set for the TxManagerMock.Do")
+		}
+		return (*mm_results).err
+	}
+	if mmDo.funcDo != nil {
+		return mmDo.funcDo(ctx, fn)
+	}
+	mmDo.t.Fatalf("Unexpected call to TxManagerMock.Do. %v %v", ctx, fn)
+	return
+}
+
+// DoAfterCounter returns a count of finished TxManagerMock.Do invocations
+func (mmDo *TxManagerMock) DoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDo.afterDoCounter)
+}
+
+// DoBeforeCounter returns a count of TxManagerMock.Do invocations
+func (mmDo *TxManagerMock) DoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDo.beforeDoCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.Do.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDo *mTxManagerMockDo) Calls() []*TxManagerMockDoParams {
+	mmDo.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockDoParams, len(mmDo.callArgs))
+	copy(argCopy, mmDo.callArgs)
+
+	mmDo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDoDone returns true if the count of the Do invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockDoDone() bool {
+	if m.DoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DoMock.invocationsDone()
+}
+
+// MinimockDoInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockDoInspect() {
+	for _, e := range m.DoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.Do at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDoCounter := mm_atomic.LoadUint64(&m.afterDoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DoMock.defaultExpectation != nil && afterDoCounter < 1 {
+		if m.DoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.Do at\n%s", m.DoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.Do at\n%s with params: %#v", m.DoMock.defaultExpectation.expectationOrigins.origin, *m.DoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDo != nil && afterDoCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.Do at\n%s", m.funcDoOrigin)
+	}
+
+	if !m.DoMock.invocationsDone() && afterDoCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.Do at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DoMock.expectedInvocations), m.DoMock.expectedInvocationsOrigin, afterDoCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TxManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDoInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TxManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TxManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDoDone()
+}
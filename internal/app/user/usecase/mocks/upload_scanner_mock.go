@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/user/usecase.UploadScanner -o upload_scanner_mock.go -n UploadScannerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UploadScannerMock implements mm_usecase.UploadScanner
+type UploadScannerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context, req quarantine.CheckReq) (err error)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context, req quarantine.CheckReq)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mUploadScannerMockCheck
+}
+
+// NewUploadScannerMock returns a mock for mm_usecase.UploadScanner
+func NewUploadScannerMock(t minimock.Tester) *UploadScannerMock {
+	m := &UploadScannerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mUploadScannerMockCheck{mock: m}
+	m.CheckMock.callArgs = []*UploadScannerMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUploadScannerMockCheck struct {
+	optional           bool
+	mock               *UploadScannerMock
+	defaultExpectation *UploadScannerMockCheckExpectation
+	expectations       []*UploadScannerMockCheckExpectation
+
+	callArgs []*UploadScannerMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UploadScannerMockCheckExpectation specifies expectation struct of the UploadScanner.Check
+type UploadScannerMockCheckExpectation struct {
+	mock               *UploadScannerMock
+	params             *UploadScannerMockCheckParams
+	paramPtrs          *UploadScannerMockCheckParamPtrs
+	expectationOrigins UploadScannerMockCheckExpectationOrigins
+	results            *UploadScannerMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UploadScannerMockCheckParams contains parameters of the UploadScanner.Check
+type UploadScannerMockCheckParams struct {
+	ctx context.Context
+	req quarantine.CheckReq
+}
+
+// UploadScannerMockCheckParamPtrs contains pointers to parameters of the UploadScanner.Check
+type UploadScannerMockCheckParamPtrs struct {
+	ctx *context.Context
+	req *quarantine.CheckReq
+}
+
+// UploadScannerMockCheckResults contains results of the UploadScanner.Check
+type UploadScannerMockCheckResults struct {
+	err error
+}
+
+// UploadScannerMockCheckOrigins contains origins of expectations of the UploadScanner.Check
+type UploadScannerMockCheckExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mUploadScannerMockCheck) Optional() *mUploadScannerMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for UploadScanner.Check
+func (mmCheck *mUploadScannerMockCheck) Expect(ctx context.Context, req quarantine.CheckReq) *mUploadScannerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &UploadScannerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &UploadScannerMockCheckParams{ctx, req}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UploadScanner.Check
+func (mmCheck *mUploadScannerMockCheck) ExpectCtxParam1(ctx context.Context) *mUploadScannerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &UploadScannerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &UploadScannerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectReqParam2 sets up expected param req for UploadScanner.Check
+func (mmCheck *mUploadScannerMockCheck) ExpectReqParam2(req quarantine.CheckReq) *mUploadScannerMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &UploadScannerMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &UploadScannerMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.req = &req
+	mmCheck.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the UploadScanner.Check
+func (mmCheck *mUploadScannerMockCheck) Inspect(f func(ctx context.Context, req quarantine.CheckReq)) *mUploadScannerMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for UploadScannerMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by UploadScanner.Check
+func (mmCheck *mUploadScannerMockCheck) Return(err error) *UploadScannerMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &UploadScannerMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &UploadScannerMockCheckResults{err}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the UploadScanner.Check method
+func (mmCheck *mUploadScannerMockCheck) Set(f func(ctx context.Context, req quarantine.CheckReq) (err error)) *UploadScannerMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the UploadScanner.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the UploadScanner.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the UploadScanner.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mUploadScannerMockCheck) When(ctx context.Context, req quarantine.CheckReq) *UploadScannerMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("UploadScannerMock.Check mock is already set by Set")
+	}
+
+	expectation := &UploadScannerMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &UploadScannerMockCheckParams{ctx, req},
+		expectationOrigins: UploadScannerMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UploadScanner.Check return parameters for the expectation previously defined by the When method
+func (e *UploadScannerMockCheckExpectation) Then(err error) *UploadScannerMock {
+	e.results = &UploadScannerMockCheckResults{err}
+	return e.mock
+}
+
+// Times sets number of times UploadScanner.Check should be invoked
+func (mmCheck *mUploadScannerMockCheck) Times(n uint64) *mUploadScannerMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of UploadScannerMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mUploadScannerMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_usecase.UploadScanner
+func (mmCheck *UploadScannerMock) Check(ctx context.Context, req quarantine.CheckReq) (err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, req)
+	}
+
+	mm_params := UploadScannerMockCheckParams{ctx, req}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := UploadScannerMockCheckParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("UploadScannerMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCheck.t.Errorf("UploadScannerMock.Check got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("UploadScannerMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the UploadScannerMock.Check")
+		}
+		return (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, req)
+	}
+	mmCheck.t.Fatalf("Unexpected call to UploadScannerMock.Check. %v %v", ctx, req)
+	return
+}
+
+// CheckAfterCounter returns a count of finished UploadScannerMock.Check invocations
+func (mmCheck *UploadScannerMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of UploadScannerMock.Check invocations
+func (mmCheck *UploadScannerMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to UploadScannerMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mUploadScannerMockCheck) Calls() []*UploadScannerMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*UploadScannerMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *UploadScannerMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *UploadScannerMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UploadScannerMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UploadScannerMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UploadScannerMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to UploadScannerMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to UploadScannerMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UploadScannerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UploadScannerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UploadScannerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
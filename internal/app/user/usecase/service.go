@@ -1,12 +1,16 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
 	"github.com/66gu1/easygodocs/internal/infrastructure/secure"
 	"github.com/google/uuid"
@@ -30,24 +34,48 @@ var (
 type Core interface {
 	CreateUser(ctx context.Context, req user.CreateUserReq) (uuid.UUID, error)
 	GetUser(ctx context.Context, id uuid.UUID) (user.User, string, error)
-	GetAllUsers(ctx context.Context) ([]user.User, error)
+	GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (user.UsersPage, error)
 	UpdateUser(ctx context.Context, req user.UpdateUserReq) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	AnonymizeUser(ctx context.Context, id uuid.UUID) error
 	ChangePassword(ctx context.Context, id uuid.UUID, newPassword []byte) error
+	UpdateProfile(ctx context.Context, req user.UpdateProfileReq) error
+	GetPreferences(ctx context.Context, userID uuid.UUID) (user.Preferences, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (user.Preferences, error)
+}
+
+type AvatarCore interface {
+	Upload(ctx context.Context, userID uuid.UUID, data []byte) error
+	Get(ctx context.Context, userID uuid.UUID) (user.Avatar, io.ReadCloser, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// UploadScanner rejects infected uploads, keeping them in quarantine.
+type UploadScanner interface {
+	Check(ctx context.Context, req quarantine.CheckReq) error
 }
 
 type AuthService interface {
 	CheckSelfOrAdmin(ctx context.Context, targetUserID uuid.UUID) error
-	CheckIsAdmin(ctx context.Context) error
 	DeleteSessionsByUserID(ctx context.Context, userID uuid.UUID) error
+	DeleteUserRoles(ctx context.Context, userID uuid.UUID) (int64, error)
 	CheckSelf(ctx context.Context, targetUserID uuid.UUID) error
 	IsAdmin(ctx context.Context) (bool, error)
 }
 
+// DataExports removes the data export archives of a user, see dataexport.
+type DataExports interface {
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
 type PasswordHasher interface {
 	CheckPasswordHash(hash, password []byte) error
 }
 
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type ChangePasswordCmd struct {
 	ID          uuid.UUID
 	OldPassword []byte
@@ -56,22 +84,42 @@ type ChangePasswordCmd struct {
 
 type service struct {
 	core           Core
+	avatars        AvatarCore
+	scanner        UploadScanner
 	authService    AuthService
+	dataExports    DataExports
 	passwordHasher PasswordHasher
+	tx             TxManager
+	registration   user.RegistrationConfig
 }
 
-func NewService(core Core, authService AuthService, passwordHasher PasswordHasher) *service {
-	if core == nil || authService == nil || passwordHasher == nil {
+func NewService(core Core, avatars AvatarCore, scanner UploadScanner, authService AuthService, dataExports DataExports,
+	passwordHasher PasswordHasher, tx TxManager, registration user.RegistrationConfig,
+) *service {
+	if core == nil || avatars == nil || scanner == nil || authService == nil || dataExports == nil || passwordHasher == nil || tx == nil {
 		panic("user.NewService: nil dependency")
 	}
 	return &service{
 		core:           core,
+		avatars:        avatars,
+		scanner:        scanner,
 		authService:    authService,
+		dataExports:    dataExports,
 		passwordHasher: passwordHasher,
+		tx:             tx,
+		registration:   registration,
 	}
 }
 
+// CreateUser registers the caller, as far as the registration config allows. The route needs no
+// authentication.
 func (s *service) CreateUser(ctx context.Context, req user.CreateUserReq) error {
+	if err := s.registration.Check(req.Email); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldEmail.String(), req.Email).
+			Msg("user.Service.CreateUser: registration not allowed")
+		return fmt.Errorf("user.Service.CreateUser: %w", err)
+	}
 	if _, err := s.core.CreateUser(ctx, req); err != nil {
 		logger.Error(ctx, err).
 			Str(user.FieldEmail.String(), req.Email).
@@ -83,6 +131,24 @@ func (s *service) CreateUser(ctx context.Context, req user.CreateUserReq) error
 	return nil
 }
 
+// AdminCreateUser creates a user whatever the registration config says and returns its ID. The
+// route requires admin role.
+func (s *service) AdminCreateUser(ctx context.Context, req user.CreateUserReq) (uuid.UUID, error) {
+	id, err := s.core.CreateUser(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldEmail.String(), req.Email).
+			Str(user.FieldName.String(), req.Name).
+			Msg("user.Service.AdminCreateUser: failed to create user")
+		return uuid.Nil, fmt.Errorf("user.Service.AdminCreateUser: %w", err)
+	}
+	logger.Audit(ctx, "user.created").
+		Str(user.FieldUserID.String(), id.String()).
+		Msg("user created by admin")
+
+	return id, nil
+}
+
 func (s *service) GetUser(ctx context.Context, id uuid.UUID) (user.User, error) {
 	if err := s.authService.CheckSelfOrAdmin(ctx, id); err != nil {
 		logger.Error(ctx, err).
@@ -101,17 +167,14 @@ func (s *service) GetUser(ctx context.Context, id uuid.UUID) (user.User, error)
 	return u, nil
 }
 
-func (s *service) GetAllUsers(ctx context.Context) ([]user.User, error) {
-	if err := s.authService.CheckIsAdmin(ctx); err != nil {
-		logger.Error(ctx, err).Msg("user.Service.GetAllUsers: failed to check admin")
-		return nil, fmt.Errorf("user.Service.GetAllUsers: %w", err)
-	}
-	users, err := s.core.GetAllUsers(ctx)
+// GetAllUsers returns the users of the workspace. The route requires admin role.
+func (s *service) GetAllUsers(ctx context.Context, opts user.ListUsersOptions) (user.UsersPage, error) {
+	page, err := s.core.GetAllUsers(ctx, opts)
 	if err != nil {
 		logger.Error(ctx, err).Msg("user.Service.GetAllUsers: failed to get all users")
-		return nil, fmt.Errorf("user.Service.GetAllUsers: %w", err)
+		return user.UsersPage{}, fmt.Errorf("user.Service.GetAllUsers: %w", err)
 	}
-	return users, nil
+	return page, nil
 }
 
 func (s *service) UpdateUser(ctx context.Context, req user.UpdateUserReq) error {
@@ -131,20 +194,34 @@ func (s *service) UpdateUser(ctx context.Context, req user.UpdateUserReq) error
 	return nil
 }
 
+// DeleteUser deletes the user with their role grants and sessions. The route requires admin role.
 func (s *service) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	if err := s.authService.CheckIsAdmin(ctx); err != nil {
-		logger.Error(ctx, err).
-			Str(user.FieldUserID.String(), id.String()).
-			Msg("user.Service.DeleteUser: failed to check admin")
-		return fmt.Errorf("user.Service.DeleteUser: %w", err)
-	}
-
-	if err := s.core.DeleteUser(ctx, id); err != nil {
-		logger.Error(ctx, err).
-			Str(user.FieldUserID.String(), id.String()).
-			Msg("user.Service.DeleteUser: failed to delete user")
+	// The user and its role grants go together, so a failed revoke keeps the user.
+	var revoked int64
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		if err := s.core.DeleteUser(ctx, id); err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.DeleteUser: failed to delete user")
+			return err
+		}
+		n, err := s.authService.DeleteUserRoles(ctx, id)
+		if err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.DeleteUser: failed to revoke roles")
+			return err
+		}
+		revoked = n
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("user.Service.DeleteUser: %w", err)
 	}
+	logger.Audit(ctx, "user.deleted").
+		Str(user.FieldUserID.String(), id.String()).
+		Int64("revoked_roles", revoked).
+		Msg("user deleted")
 
 	if err := s.authService.DeleteSessionsByUserID(context.WithoutCancel(ctx), id); err != nil { // Best-effort: session cleanup is attempted, but failures are ignored
 		logger.Error(ctx, err).
@@ -154,6 +231,61 @@ func (s *service) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// AnonymizeUser scrubs the user's personal data but keeps the row, so
+// created_by/updated_by references resolve to an opaque placeholder.
+// The avatar and the data export archives are deleted from blob storage
+// last, so a failed blob delete rolls the rest back and the call can be
+// retried; a failed commit leaves them deleted all the same.
+func (s *service) AnonymizeUser(ctx context.Context, id uuid.UUID) error {
+	var revoked int64
+	var exports int
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		if err := s.core.AnonymizeUser(ctx, id); err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.AnonymizeUser: failed to anonymize user")
+			return err
+		}
+		n, err := s.authService.DeleteUserRoles(ctx, id)
+		if err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.AnonymizeUser: failed to revoke roles")
+			return err
+		}
+		revoked = n
+		if err = s.authService.DeleteSessionsByUserID(ctx, id); err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.AnonymizeUser: failed to delete sessions")
+			return err
+		}
+		if err = s.avatars.Delete(ctx, id); err != nil && !errors.Is(err, user.ErrAvatarNotFound()) {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.AnonymizeUser: failed to delete avatar")
+			return err
+		}
+		if exports, err = s.dataExports.DeleteByUser(ctx, id); err != nil {
+			logger.Error(ctx, err).
+				Str(user.FieldUserID.String(), id.String()).
+				Msg("user.Service.AnonymizeUser: failed to delete data exports")
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("user.Service.AnonymizeUser: %w", err)
+	}
+	logger.Audit(ctx, "user.anonymized").
+		Str(user.FieldUserID.String(), id.String()).
+		Int64("revoked_roles", revoked).
+		Int("deleted_exports", exports).
+		Msg("user anonymized")
+
+	return nil
+}
+
 func (s *service) ChangePassword(ctx context.Context, req ChangePasswordCmd) error {
 	isAdmin, err := s.authService.IsAdmin(ctx)
 	if err != nil {
@@ -235,3 +367,120 @@ func (s *service) ChangePassword(ctx context.Context, req ChangePasswordCmd) err
 
 	return nil
 }
+
+func (s *service) UpdateProfile(ctx context.Context, req user.UpdateProfileReq) error {
+	if err := s.authService.CheckSelfOrAdmin(ctx, req.UserID); err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("user.Service.UpdateProfile: failed to check self or admin")
+		return fmt.Errorf("user.Service.UpdateProfile: %w", err)
+	}
+
+	if err := s.core.UpdateProfile(ctx, req); err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("user.Service.UpdateProfile: failed to update profile")
+		return fmt.Errorf("user.Service.UpdateProfile: %w", err)
+	}
+	return nil
+}
+
+func (s *service) UploadAvatar(ctx context.Context, userID uuid.UUID, data []byte) error {
+	if err := s.authService.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.UploadAvatar: failed to check self or admin")
+		return fmt.Errorf("user.Service.UploadAvatar: %w", err)
+	}
+
+	var uploadedBy *uuid.UUID
+	if callerID, err := contextx.GetUserID(ctx); err == nil {
+		uploadedBy = &callerID
+	}
+	err := s.scanner.Check(ctx, quarantine.CheckReq{
+		Source:     quarantine.SourceAvatar,
+		Name:       userID.String(),
+		UploadedBy: uploadedBy,
+		Open:       func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil },
+	})
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.UploadAvatar: failed to scan avatar")
+		return fmt.Errorf("user.Service.UploadAvatar: %w", err)
+	}
+
+	if err = s.avatars.Upload(ctx, userID, data); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.UploadAvatar: failed to upload avatar")
+		return fmt.Errorf("user.Service.UploadAvatar: %w", err)
+	}
+	return nil
+}
+
+// GetAvatar is available to any authenticated user, like avatar URLs in user listings.
+// The caller must close the returned reader.
+func (s *service) GetAvatar(ctx context.Context, userID uuid.UUID) (user.Avatar, io.ReadCloser, error) {
+	avatar, content, err := s.avatars.Get(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.GetAvatar: failed to get avatar")
+		return user.Avatar{}, nil, fmt.Errorf("user.Service.GetAvatar: %w", err)
+	}
+	return avatar, content, nil
+}
+
+func (s *service) DeleteAvatar(ctx context.Context, userID uuid.UUID) error {
+	if err := s.authService.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.DeleteAvatar: failed to check self or admin")
+		return fmt.Errorf("user.Service.DeleteAvatar: %w", err)
+	}
+
+	if err := s.avatars.Delete(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.DeleteAvatar: failed to delete avatar")
+		return fmt.Errorf("user.Service.DeleteAvatar: %w", err)
+	}
+	return nil
+}
+
+func (s *service) GetPreferences(ctx context.Context, userID uuid.UUID) (user.Preferences, error) {
+	if err := s.authService.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.GetPreferences: failed to check self or admin")
+		return user.Preferences{}, fmt.Errorf("user.Service.GetPreferences: %w", err)
+	}
+
+	prefs, err := s.core.GetPreferences(ctx, userID)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.GetPreferences: failed to get preferences")
+		return user.Preferences{}, fmt.Errorf("user.Service.GetPreferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (s *service) UpdatePreferences(ctx context.Context, userID uuid.UUID, data []byte) (user.Preferences, error) {
+	if err := s.authService.CheckSelfOrAdmin(ctx, userID); err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.UpdatePreferences: failed to check self or admin")
+		return user.Preferences{}, fmt.Errorf("user.Service.UpdatePreferences: %w", err)
+	}
+
+	prefs, err := s.core.UpdatePreferences(ctx, userID, data)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(user.FieldUserID.String(), userID.String()).
+			Msg("user.Service.UpdatePreferences: failed to update preferences")
+		return user.Preferences{}, fmt.Errorf("user.Service.UpdatePreferences: %w", err)
+	}
+	return prefs, nil
+}
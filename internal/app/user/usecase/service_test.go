@@ -3,9 +3,12 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/66gu1/easygodocs/internal/app/quarantine"
 	"github.com/66gu1/easygodocs/internal/app/user"
 	"github.com/66gu1/easygodocs/internal/app/user/usecase"
 	"github.com/66gu1/easygodocs/internal/app/user/usecase/mocks"
@@ -18,19 +21,29 @@ import (
 
 type mock struct {
 	core           *mocks.CoreMock
+	avatars        *mocks.AvatarCoreMock
+	scanner        *mocks.UploadScannerMock
 	authService    *mocks.AuthServiceMock
+	dataExports    *mocks.DataExportsMock
 	passwordHasher *mocks.PasswordHasherMock
+	tx             *mocks.TxManagerMock
 }
 
 func getMocks(t *testing.T) mock {
 	t.Helper()
 	return mock{
 		core:           mocks.NewCoreMock(t),
+		avatars:        mocks.NewAvatarCoreMock(t),
+		scanner:        mocks.NewUploadScannerMock(t),
 		authService:    mocks.NewAuthServiceMock(t),
+		dataExports:    mocks.NewDataExportsMock(t),
 		passwordHasher: mocks.NewPasswordHasherMock(t),
+		tx:             mocks.NewTxManagerMock(t),
 	}
 }
 
+var openRegistration = user.RegistrationConfig{Enabled: true}
+
 func TestService_CreateUser(t *testing.T) {
 	t.Parallel()
 
@@ -46,17 +59,36 @@ func TestService_CreateUser(t *testing.T) {
 
 	tests := []struct {
 		name  string
+		cfg   user.RegistrationConfig
 		setup func(mocks mock)
 		err   error
 	}{
 		{
 			name: "ok",
+			cfg:  openRegistration,
 			setup: func(mocks mock) {
 				mocks.core.CreateUserMock.Expect(ctx, req).Return(uuid.Nil, nil)
 			},
 		},
+		{
+			name: "ok/allowed domain",
+			cfg:  user.RegistrationConfig{Enabled: true, AllowedDomains: []string{"mail.com"}},
+			setup: func(mocks mock) {
+				mocks.core.CreateUserMock.Expect(ctx, req).Return(uuid.Nil, nil)
+			},
+		},
+		{
+			name: "registration disabled",
+			err:  user.ErrRegistrationDisabled(),
+		},
+		{
+			name: "domain not allowed",
+			cfg:  user.RegistrationConfig{Enabled: true, AllowedDomains: []string{"example.com"}},
+			err:  user.ErrEmailDomainNotAllowed(),
+		},
 		{
 			name: "core.CreateUser returns error",
+			cfg:  openRegistration,
 			setup: func(mocks mock) {
 				mocks.core.CreateUserMock.Expect(ctx, req).Return(uuid.Nil, expErr)
 			},
@@ -72,7 +104,7 @@ func TestService_CreateUser(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, tt.cfg)
 			err := svc.CreateUser(ctx, req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -83,6 +115,31 @@ func TestService_CreateUser(t *testing.T) {
 	}
 }
 
+func TestService_AdminCreateUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		req    = user.CreateUserReq{Email: "user@mail.com", Name: "name", Password: []byte("password")}
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = errors.New("user already exists")
+	)
+
+	// registration is disabled, which does not apply to admins
+	mocks := getMocks(t)
+	mocks.core.CreateUserMock.Expect(ctx, req).Return(id, nil)
+	svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, user.RegistrationConfig{})
+	got, err := svc.AdminCreateUser(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, id, got)
+
+	mocks = getMocks(t)
+	mocks.core.CreateUserMock.Return(uuid.Nil, expErr)
+	svc = usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, user.RegistrationConfig{})
+	_, err = svc.AdminCreateUser(ctx, req)
+	require.ErrorIs(t, err, expErr)
+}
+
 func TestService_GetUser(t *testing.T) {
 	t.Parallel()
 
@@ -137,7 +194,7 @@ func TestService_GetUser(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
 			resp, err := svc.GetUser(ctx, userID)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -183,22 +240,13 @@ func TestService_GetAllUsers(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(nil)
-				mocks.core.GetAllUsersMock.Expect(ctx).Return(users, nil)
-			},
-		},
-		{
-			name: "authService.CheckIsAdmin returns error",
-			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(expErr)
+				mocks.core.GetAllUsersMock.Expect(ctx, user.ListUsersOptions{}).Return(user.UsersPage{Users: users}, nil)
 			},
-			err: expErr,
 		},
 		{
 			name: "core.GetAllUsers returns error",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(nil)
-				mocks.core.GetAllUsersMock.Expect(ctx).Return(nil, expErr)
+				mocks.core.GetAllUsersMock.Expect(ctx, user.ListUsersOptions{}).Return(user.UsersPage{}, expErr)
 			},
 			err: expErr,
 		},
@@ -212,13 +260,13 @@ func TestService_GetAllUsers(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
-			resp, err := svc.GetAllUsers(ctx)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			resp, err := svc.GetAllUsers(ctx, user.ListUsersOptions{})
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, users, resp)
+				require.Equal(t, user.UsersPage{Users: users}, resp)
 			}
 		})
 	}
@@ -274,7 +322,7 @@ func TestService_UpdateUser(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
 			err := svc.UpdateUser(ctx, req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -294,6 +342,10 @@ func TestService_DeleteUser(t *testing.T) {
 		expErr = errors.New("user not found")
 	)
 
+	inTx := func(mocks mock) {
+		mocks.tx.DoMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+	}
+
 	tests := []struct {
 		name  string
 		setup func(mocks mock)
@@ -302,31 +354,42 @@ func TestService_DeleteUser(t *testing.T) {
 		{
 			name: "ok",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(nil)
+				inTx(mocks)
 				mocks.core.DeleteUserMock.Expect(ctx, userID).Return(nil)
+				mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(2, nil)
 				mocks.authService.DeleteSessionsByUserIDMock.Expect(context.WithoutCancel(ctx), userID).Return(nil)
 			},
 		},
 		{
 			name: "authService.DeleteSessionsByUserID returns error",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(nil)
+				inTx(mocks)
 				mocks.core.DeleteUserMock.Expect(ctx, userID).Return(nil)
+				mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(0, nil)
 				mocks.authService.DeleteSessionsByUserIDMock.Expect(context.WithoutCancel(ctx), userID).Return(expErr)
 			},
 		},
 		{
-			name: "authService.CheckSelfOrAdmin returns error",
+			name: "core.DeleteUser returns error",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(expErr)
+				inTx(mocks)
+				mocks.core.DeleteUserMock.Expect(ctx, userID).Return(expErr)
 			},
 			err: expErr,
 		},
 		{
-			name: "core.DeleteUser returns error",
+			name: "authService.DeleteUserRoles returns error",
 			setup: func(mocks mock) {
-				mocks.authService.CheckIsAdminMock.Expect(ctx).Return(nil)
-				mocks.core.DeleteUserMock.Expect(ctx, userID).Return(expErr)
+				inTx(mocks)
+				mocks.core.DeleteUserMock.Expect(ctx, userID).Return(nil)
+				mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(0, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "tx.Do returns error",
+			setup: func(mocks mock) {
+				mocks.tx.DoMock.Return(expErr)
 			},
 			err: expErr,
 		},
@@ -340,7 +403,7 @@ func TestService_DeleteUser(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
 			err := svc.DeleteUser(ctx, userID)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -351,6 +414,118 @@ func TestService_DeleteUser(t *testing.T) {
 	}
 }
 
+func TestService_AnonymizeUser(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.New()
+		ctx    = t.Context()
+		expErr = errors.New("user already anonymized")
+	)
+
+	inTx := func(mocks mock) {
+		mocks.tx.DoMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+	}
+	anonymized := func(mocks mock) {
+		inTx(mocks)
+		mocks.core.AnonymizeUserMock.Expect(ctx, userID).Return(nil)
+		mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(2, nil)
+		mocks.authService.DeleteSessionsByUserIDMock.Expect(ctx, userID).Return(nil)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				anonymized(mocks)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(nil)
+				mocks.dataExports.DeleteByUserMock.Expect(ctx, userID).Return(1, nil)
+			},
+		},
+		{
+			name: "ok, no avatar",
+			setup: func(mocks mock) {
+				anonymized(mocks)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(user.ErrAvatarNotFound())
+				mocks.dataExports.DeleteByUserMock.Expect(ctx, userID).Return(0, nil)
+			},
+		},
+		{
+			name: "dataExports.DeleteByUser returns error",
+			setup: func(mocks mock) {
+				anonymized(mocks)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(nil)
+				mocks.dataExports.DeleteByUserMock.Expect(ctx, userID).Return(0, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "avatars.Delete returns error",
+			setup: func(mocks mock) {
+				anonymized(mocks)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "authService.DeleteSessionsByUserID returns error",
+			setup: func(mocks mock) {
+				inTx(mocks)
+				mocks.core.AnonymizeUserMock.Expect(ctx, userID).Return(nil)
+				mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(0, nil)
+				mocks.authService.DeleteSessionsByUserIDMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.AnonymizeUser returns error",
+			setup: func(mocks mock) {
+				inTx(mocks)
+				mocks.core.AnonymizeUserMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "authService.DeleteUserRoles returns error",
+			setup: func(mocks mock) {
+				inTx(mocks)
+				mocks.core.AnonymizeUserMock.Expect(ctx, userID).Return(nil)
+				mocks.authService.DeleteUserRolesMock.Expect(ctx, userID).Return(0, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "tx.Do returns error",
+			setup: func(mocks mock) {
+				mocks.tx.DoMock.Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			if tt.setup != nil {
+				tt.setup(mocks)
+			}
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			err := svc.AnonymizeUser(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestService_ChangePassword(t *testing.T) {
 	t.Parallel()
 
@@ -493,7 +668,7 @@ func TestService_ChangePassword(t *testing.T) {
 				tt.setup(mocks)
 			}
 
-			svc := usecase.NewService(mocks.core, mocks.authService, mocks.passwordHasher)
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
 			err := svc.ChangePassword(ctx, tt.req)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
@@ -503,3 +678,360 @@ func TestService_ChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestService_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		name   = "Jane"
+		req    = user.UpdateProfileReq{UserID: uuid.New(), DisplayName: &name}
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, req.UserID).Return(nil)
+				mocks.core.UpdateProfileMock.Expect(ctx, req).Return(nil)
+			},
+		},
+		{
+			name: "authService.CheckSelfOrAdmin returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, req.UserID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.UpdateProfile returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, req.UserID).Return(nil)
+				mocks.core.UpdateProfileMock.Expect(ctx, req).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			err := svc.UpdateProfile(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_UploadAvatar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		data   = []byte("image")
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.scanner.CheckMock.Set(scanned(t, userID, data, nil))
+				mocks.avatars.UploadMock.Expect(ctx, userID, data).Return(nil)
+			},
+		},
+		{
+			name: "authService.CheckSelfOrAdmin returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "scanner.Check returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.scanner.CheckMock.Set(scanned(t, userID, data, quarantine.ErrInfected()))
+			},
+			err: quarantine.ErrInfected(),
+		},
+		{
+			name: "avatars.Upload returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.scanner.CheckMock.Return(nil)
+				mocks.avatars.UploadMock.Expect(ctx, userID, data).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			err := svc.UploadAvatar(ctx, userID, data)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// scanned checks that the avatar is sent to the scanner as is and answers with err.
+func scanned(t *testing.T, userID uuid.UUID, data []byte, err error) func(context.Context, quarantine.CheckReq) error {
+	return func(_ context.Context, req quarantine.CheckReq) error {
+		require.Equal(t, quarantine.SourceAvatar, req.Source)
+		require.Equal(t, userID.String(), req.Name)
+		f, openErr := req.Open()
+		require.NoError(t, openErr)
+		got, readErr := io.ReadAll(f)
+		require.NoError(t, readErr)
+		require.Equal(t, data, got)
+		return err
+	}
+}
+
+func TestService_GetAvatar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		userID  = uuid.New()
+		avatar  = user.Avatar{ContentType: "image/png", UpdatedAt: time.Now()}
+		content = io.NopCloser(strings.NewReader("image"))
+		expErr  = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.avatars.GetMock.Expect(ctx, userID).Return(avatar, content, nil)
+			},
+		},
+		{
+			name: "avatars.Get returns error",
+			setup: func(mocks mock) {
+				mocks.avatars.GetMock.Expect(ctx, userID).Return(user.Avatar{}, nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			gotAvatar, gotContent, err := svc.GetAvatar(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, gotContent)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, avatar, gotAvatar)
+				require.Equal(t, content, gotContent)
+			}
+		})
+	}
+}
+
+func TestService_DeleteAvatar(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(nil)
+			},
+		},
+		{
+			name: "authService.CheckSelfOrAdmin returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "avatars.Delete returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.avatars.DeleteMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			err := svc.DeleteAvatar(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_GetPreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		prefs  = user.DefaultPreferences()
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.core.GetPreferencesMock.Expect(ctx, userID).Return(prefs, nil)
+			},
+		},
+		{
+			name: "authService.CheckSelfOrAdmin returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.GetPreferences returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.core.GetPreferencesMock.Expect(ctx, userID).Return(user.Preferences{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			got, err := svc.GetPreferences(ctx, userID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, prefs, got)
+			}
+		})
+	}
+}
+
+func TestService_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		userID = uuid.New()
+		data   = []byte(`{"theme":"dark"}`)
+		prefs  = user.DefaultPreferences()
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(mocks mock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.core.UpdatePreferencesMock.Expect(ctx, userID, data).Return(prefs, nil)
+			},
+		},
+		{
+			name: "authService.CheckSelfOrAdmin returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "core.UpdatePreferences returns error",
+			setup: func(mocks mock) {
+				mocks.authService.CheckSelfOrAdminMock.Expect(ctx, userID).Return(nil)
+				mocks.core.UpdatePreferencesMock.Expect(ctx, userID, data).Return(user.Preferences{}, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocks := getMocks(t)
+			tt.setup(mocks)
+
+			svc := usecase.NewService(mocks.core, mocks.avatars, mocks.scanner, mocks.authService, mocks.dataExports, mocks.passwordHasher, mocks.tx, openRegistration)
+			got, err := svc.UpdatePreferences(ctx, userID, data)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, prefs, got)
+			}
+		})
+	}
+}
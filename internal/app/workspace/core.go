@@ -0,0 +1,165 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
+	"github.com/google/uuid"
+)
+
+// MaxNameLength is the limit of a workspace name in characters.
+const MaxNameLength = 100
+
+// slugPattern is an RFC 1123 label, so that every slug can be used as a subdomain.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+type Repository interface {
+	Create(ctx context.Context, ws Workspace) error
+	Get(ctx context.Context, id uuid.UUID) (Workspace, error)
+	GetBySlug(ctx context.Context, slug string) (Workspace, error)
+	List(ctx context.Context) ([]Workspace, error)
+	Update(ctx context.Context, req UpdateWorkspaceReq) error
+	// Delete soft-deletes the workspace. Its data stays but can no longer be reached.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type IDGenerator interface {
+	New() (uuid.UUID, error)
+}
+
+// Config chooses how requests select a workspace. With BaseDomain set, a request to
+// <slug>.<base_domain> is served by that workspace; the X-Workspace header takes precedence.
+type Config struct {
+	BaseDomain string `mapstructure:"base_domain" json:"base_domain"`
+}
+
+func (c Config) Validate() error {
+	if strings.HasPrefix(c.BaseDomain, ".") || strings.Contains(c.BaseDomain, ":") {
+		return fmt.Errorf("base_domain must be a host name without a leading dot or a port")
+	}
+
+	return nil
+}
+
+type core struct {
+	repo        Repository
+	idGenerator IDGenerator
+}
+
+func NewCore(repo Repository, idGenerator IDGenerator) (*core, error) {
+	if repo == nil || idGenerator == nil {
+		return nil, fmt.Errorf("workspace.NewCore: %w", fmt.Errorf("nil dependency"))
+	}
+
+	return &core{repo: repo, idGenerator: idGenerator}, nil
+}
+
+func (c *core) Create(ctx context.Context, req CreateWorkspaceReq) (uuid.UUID, error) {
+	req.Slug = NormalizeSlug(req.Slug)
+	if !slugPattern.MatchString(req.Slug) {
+		return uuid.Nil, fmt.Errorf("workspace.core.Create: %w", ErrInvalidSlug())
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validateName(req.Name); err != nil {
+		return uuid.Nil, fmt.Errorf("workspace.core.Create: %w", err)
+	}
+
+	id, err := c.idGenerator.New()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("workspace.core.Create: %w", err)
+	}
+	if err = c.repo.Create(ctx, Workspace{ID: id, Slug: req.Slug, Name: req.Name}); err != nil {
+		return uuid.Nil, fmt.Errorf("workspace.core.Create: %w", err)
+	}
+
+	return id, nil
+}
+
+func (c *core) Get(ctx context.Context, id uuid.UUID) (Workspace, error) {
+	if id == uuid.Nil {
+		return Workspace{}, fmt.Errorf("workspace.core.Get: %w", apperr.ErrNilUUID(FieldWorkspaceID))
+	}
+
+	ws, err := c.repo.Get(ctx, id)
+	if err != nil {
+		return Workspace{}, fmt.Errorf("workspace.core.Get: %w", err)
+	}
+
+	return ws, nil
+}
+
+// GetBySlug resolves a slug as sent by a client, so it is normalized first.
+func (c *core) GetBySlug(ctx context.Context, slug string) (Workspace, error) {
+	slug = NormalizeSlug(slug)
+	if !slugPattern.MatchString(slug) {
+		return Workspace{}, fmt.Errorf("workspace.core.GetBySlug: %w", ErrWorkspaceNotFound())
+	}
+
+	ws, err := c.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		return Workspace{}, fmt.Errorf("workspace.core.GetBySlug: %w", err)
+	}
+
+	return ws, nil
+}
+
+func (c *core) List(ctx context.Context) ([]Workspace, error) {
+	list, err := c.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("workspace.core.List: %w", err)
+	}
+
+	return list, nil
+}
+
+func (c *core) Update(ctx context.Context, req UpdateWorkspaceReq) error {
+	if req.ID == uuid.Nil {
+		return fmt.Errorf("workspace.core.Update: %w", apperr.ErrNilUUID(FieldWorkspaceID))
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validateName(req.Name); err != nil {
+		return fmt.Errorf("workspace.core.Update: %w", err)
+	}
+
+	if err := c.repo.Update(ctx, req); err != nil {
+		return fmt.Errorf("workspace.core.Update: %w", err)
+	}
+
+	return nil
+}
+
+func (c *core) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("workspace.core.Delete: %w", apperr.ErrNilUUID(FieldWorkspaceID))
+	}
+	if id == contextx.DefaultWorkspaceID {
+		return fmt.Errorf("workspace.core.Delete: %w", ErrDeleteDefault())
+	}
+
+	if err := c.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("workspace.core.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// NormalizeSlug lowercases the slug; host names and the header are case-insensitive.
+func NormalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return ErrNameRequired()
+	}
+	if text.Length(name) > MaxNameLength {
+		return ErrNameTooLong(MaxNameLength)
+	}
+
+	return nil
+}
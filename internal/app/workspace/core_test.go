@@ -0,0 +1,182 @@
+package workspace_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/app/workspace/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestCore_Create(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = fmt.Errorf("test error")
+	)
+
+	tests := []struct {
+		name  string
+		req   workspace.CreateWorkspaceReq
+		setup func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock)
+		err   error
+	}{
+		{
+			name: "ok, slug and name normalized",
+			req:  workspace.CreateWorkspaceReq{Slug: " Team-A ", Name: " Team A "},
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock) {
+				idGen.NewMock.Return(id, nil)
+				repo.CreateMock.Expect(ctx, workspace.Workspace{ID: id, Slug: "team-a", Name: "Team A"}).Return(nil)
+			},
+		},
+		{name: "slug with dot", req: workspace.CreateWorkspaceReq{Slug: "team.a", Name: "Team A"}, err: workspace.ErrInvalidSlug()},
+		{name: "slug with edge dash", req: workspace.CreateWorkspaceReq{Slug: "team-", Name: "Team A"}, err: workspace.ErrInvalidSlug()},
+		{name: "slug too long", req: workspace.CreateWorkspaceReq{Slug: strings.Repeat("a", 64), Name: "Team A"}, err: workspace.ErrInvalidSlug()},
+		{name: "empty name", req: workspace.CreateWorkspaceReq{Slug: "team-a", Name: " "}, err: workspace.ErrNameRequired()},
+		{
+			name: "name too long",
+			req:  workspace.CreateWorkspaceReq{Slug: "team-a", Name: strings.Repeat("a", workspace.MaxNameLength+1)},
+			err:  workspace.ErrNameTooLong(workspace.MaxNameLength),
+		},
+		{
+			name: "id generator error",
+			req:  workspace.CreateWorkspaceReq{Slug: "team-a", Name: "Team A"},
+			setup: func(_ *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock) {
+				idGen.NewMock.Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+		{
+			name: "repo error",
+			req:  workspace.CreateWorkspaceReq{Slug: "team-a", Name: "Team A"},
+			setup: func(repo *mocks.RepositoryMock, idGen *mocks.IDGeneratorMock) {
+				idGen.NewMock.Return(id, nil)
+				repo.CreateMock.Return(workspace.ErrSlugTaken())
+			},
+			err: workspace.ErrSlugTaken(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepositoryMock(t)
+			idGen := mocks.NewIDGeneratorMock(t)
+			if tt.setup != nil {
+				tt.setup(repo, idGen)
+			}
+			c, err := workspace.NewCore(repo, idGen)
+			require.NoError(t, err)
+
+			got, err := c.Create(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, got)
+		})
+	}
+}
+
+func TestCore_GetBySlug(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	ws := workspace.Workspace{ID: uuid.New(), Slug: "team-a", Name: "Team A"}
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := workspace.NewCore(repo, mocks.NewIDGeneratorMock(t))
+	require.NoError(t, err)
+
+	repo.GetBySlugMock.Expect(ctx, "team-a").Return(ws, nil)
+	got, err := c.GetBySlug(ctx, "Team-A")
+	require.NoError(t, err)
+	require.Equal(t, ws, got)
+
+	// not a slug, so no lookup
+	_, err = c.GetBySlug(ctx, "team_a")
+	require.ErrorIs(t, err, workspace.ErrWorkspaceNotFound())
+}
+
+func TestCore_Update(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = t.Context()
+		id  = uuid.New()
+	)
+
+	tests := []struct {
+		name  string
+		req   workspace.UpdateWorkspaceReq
+		setup func(repo *mocks.RepositoryMock)
+		err   error
+	}{
+		{
+			name: "ok",
+			req:  workspace.UpdateWorkspaceReq{ID: id, Name: " Team B "},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.UpdateMock.Expect(ctx, workspace.UpdateWorkspaceReq{ID: id, Name: "Team B"}).Return(nil)
+			},
+		},
+		{name: "nil id", req: workspace.UpdateWorkspaceReq{Name: "Team B"}, err: apperr.ErrNilUUID(workspace.FieldWorkspaceID)},
+		{name: "empty name", req: workspace.UpdateWorkspaceReq{ID: id}, err: workspace.ErrNameRequired()},
+		{
+			name: "not found",
+			req:  workspace.UpdateWorkspaceReq{ID: id, Name: "Team B"},
+			setup: func(repo *mocks.RepositoryMock) {
+				repo.UpdateMock.Return(workspace.ErrWorkspaceNotFound())
+			},
+			err: workspace.ErrWorkspaceNotFound(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			c, err := workspace.NewCore(repo, mocks.NewIDGeneratorMock(t))
+			require.NoError(t, err)
+
+			err = c.Update(ctx, tt.req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCore_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	id := uuid.New()
+
+	repo := mocks.NewRepositoryMock(t)
+	c, err := workspace.NewCore(repo, mocks.NewIDGeneratorMock(t))
+	require.NoError(t, err)
+
+	repo.DeleteMock.Expect(ctx, id).Return(nil)
+	require.NoError(t, c.Delete(ctx, id))
+
+	err = c.Delete(ctx, contextx.DefaultWorkspaceID)
+	require.ErrorIs(t, err, workspace.ErrDeleteDefault())
+
+	err = c.Delete(ctx, uuid.Nil)
+	require.ErrorIs(t, err, apperr.ErrNilUUID(workspace.FieldWorkspaceID))
+}
@@ -0,0 +1,28 @@
+package workspace
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Workspace isolates the users, documents, roles and sessions of one team on a shared deployment.
+// The slug selects it in the X-Workspace header or as a subdomain.
+type Workspace struct {
+	ID        uuid.UUID `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateWorkspaceReq struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// UpdateWorkspaceReq renames a workspace. The slug cannot change: it is part of the URLs of the workspace.
+type UpdateWorkspaceReq struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
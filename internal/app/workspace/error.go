@@ -0,0 +1,55 @@
+package workspace
+
+import (
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+)
+
+const (
+	CodeValidationFailed apperr.Code = "workspace/validation_failed"
+	CodeNotFound         apperr.Code = "workspace/not_found"
+	CodeSlugTaken        apperr.Code = "workspace/slug_taken"
+	CodeDefault          apperr.Code = "workspace/default"
+)
+
+func init() {
+	apperr.Register(CodeValidationFailed, "Invalid workspace", apperr.ClassBadRequest)
+	apperr.Register(CodeNotFound, "Workspace not found", apperr.ClassNotFound)
+	apperr.Register(CodeSlugTaken, "Slug already taken", apperr.ClassConflict)
+	apperr.Register(CodeDefault, "The default workspace cannot be deleted", apperr.ClassConflict)
+}
+
+const (
+	FieldWorkspaceID apperr.Field = "workspace_id"
+	FieldSlug        apperr.Field = "slug"
+	FieldName        apperr.Field = "name"
+)
+
+func ErrWorkspaceNotFound() error {
+	return apperr.New("Workspace not found", CodeNotFound, apperr.ClassNotFound, apperr.LogLevelWarn)
+}
+
+func ErrSlugTaken() error {
+	return apperr.New("A workspace with this slug already exists", CodeSlugTaken, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldSlug, Rule: apperr.RuleDuplicate})
+}
+
+func ErrInvalidSlug() error {
+	return apperr.New("slug must be a DNS label: lowercase letters, digits and inner dashes", CodeValidationFailed,
+		apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldSlug, Rule: apperr.RuleInvalidFormat})
+}
+
+func ErrNameRequired() error {
+	return apperr.New("name is required", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleRequired})
+}
+
+func ErrNameTooLong(max int) error {
+	return apperr.New("name is too long", CodeValidationFailed, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldName, Rule: apperr.RuleTooLong, Params: map[string]any{"max_chars": max}})
+}
+
+func ErrDeleteDefault() error {
+	return apperr.New("The default workspace cannot be deleted", CodeDefault, apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldWorkspaceID, Rule: apperr.RuleForbidden})
+}
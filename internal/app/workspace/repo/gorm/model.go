@@ -0,0 +1,28 @@
+package gorm
+
+import (
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+)
+
+type workspaceModel struct {
+	db.Base
+	ID   uuid.UUID
+	Slug string
+	Name string
+}
+
+func (m *workspaceModel) TableName() string {
+	return "workspaces"
+}
+
+func (m *workspaceModel) toDTO() workspace.Workspace {
+	return workspace.Workspace{
+		ID:        m.ID,
+		Slug:      m.Slug,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, fmt.Errorf("gormRepo.NewRepository: %w", fmt.Errorf("nil db"))
+	}
+	return &gormRepo{db: db}, nil
+}
+
+func (r *gormRepo) Create(ctx context.Context, ws workspace.Workspace) error {
+	model := &workspaceModel{ID: ws.ID, Slug: ws.Slug, Name: ws.Name}
+
+	err := r.db.WithContext(ctx).Create(model).Error
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == db.DuplicateCode {
+			err = workspace.ErrSlugTaken()
+		}
+		return fmt.Errorf("gormRepo.Create: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) Get(ctx context.Context, id uuid.UUID) (workspace.Workspace, error) {
+	var model workspaceModel
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = workspace.ErrWorkspaceNotFound()
+		}
+		return workspace.Workspace{}, fmt.Errorf("gormRepo.Get: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) GetBySlug(ctx context.Context, slug string) (workspace.Workspace, error) {
+	var model workspaceModel
+
+	err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = workspace.ErrWorkspaceNotFound()
+		}
+		return workspace.Workspace{}, fmt.Errorf("gormRepo.GetBySlug: %w", err)
+	}
+
+	return model.toDTO(), nil
+}
+
+func (r *gormRepo) List(ctx context.Context) ([]workspace.Workspace, error) {
+	models := make([]workspaceModel, 0)
+
+	err := r.db.WithContext(ctx).Order("slug").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("gormRepo.List: %w", err)
+	}
+
+	return lo.Map(models, func(m workspaceModel, _ int) workspace.Workspace { return m.toDTO() }), nil
+}
+
+func (r *gormRepo) Update(ctx context.Context, req workspace.UpdateWorkspaceReq) error {
+	result := r.db.WithContext(ctx).Model(&workspaceModel{}).Where("id = ?", req.ID).Update("name", req.Name)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.Update: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.Update: %w", workspace.ErrWorkspaceNotFound())
+	}
+
+	return nil
+}
+
+func (r *gormRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&workspaceModel{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("gormRepo.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.Delete: %w", workspace.ErrWorkspaceNotFound())
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package gorm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, cleanup
+}
+
+func TestWorkspace_CRUD(t *testing.T) {
+	t.Parallel()
+	repo, cleanup := newRepo(t)
+	ctx := t.Context()
+
+	// the migrations create the default workspace
+	def, err := repo.GetBySlug(ctx, "default")
+	require.NoError(t, err)
+	require.Equal(t, contextx.DefaultWorkspaceID, def.ID)
+
+	id := uuid.New()
+	require.NoError(t, repo.Create(ctx, workspace.Workspace{ID: id, Slug: "team-a", Name: "Team A"}))
+	err = repo.Create(ctx, workspace.Workspace{ID: uuid.New(), Slug: "team-a", Name: "Other"})
+	require.ErrorIs(t, err, workspace.ErrSlugTaken())
+
+	got, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "team-a", got.Slug)
+	require.Equal(t, "Team A", got.Name)
+	require.NotZero(t, got.CreatedAt)
+
+	require.NoError(t, repo.Update(ctx, workspace.UpdateWorkspaceReq{ID: id, Name: "Team B"}))
+	got, err = repo.GetBySlug(ctx, "team-a")
+	require.NoError(t, err)
+	require.Equal(t, "Team B", got.Name)
+
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "default", list[0].Slug)
+	require.Equal(t, id, list[1].ID)
+
+	// a deleted workspace is gone and frees its slug
+	require.NoError(t, repo.Delete(ctx, id))
+	_, err = repo.GetBySlug(ctx, "team-a")
+	require.ErrorIs(t, err, workspace.ErrWorkspaceNotFound())
+	require.ErrorIs(t, repo.Delete(ctx, id), workspace.ErrWorkspaceNotFound())
+	require.ErrorIs(t, repo.Update(ctx, workspace.UpdateWorkspaceReq{ID: id, Name: "x"}), workspace.ErrWorkspaceNotFound())
+	require.NoError(t, repo.Create(ctx, workspace.Workspace{ID: uuid.New(), Slug: "team-a", Name: "Team A"}))
+
+	// err
+	cleanup()
+	_, err = repo.List(ctx)
+	require.Error(t, err)
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const URLParamWorkspaceID = "workspace_id"
+
+type CreateWorkspaceInput struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type CreateWorkspaceResp struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type UpdateWorkspaceInput struct {
+	Name string `json:"name"`
+}
+
+type Service interface {
+	Create(ctx context.Context, req workspace.CreateWorkspaceReq) (uuid.UUID, error)
+	Get(ctx context.Context, id uuid.UUID) (workspace.Workspace, error)
+	List(ctx context.Context) ([]workspace.Workspace, error)
+	Update(ctx context.Context, req workspace.UpdateWorkspaceReq) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(svc Service) *Handler {
+	if svc == nil {
+		panic("workspace HTTP handler: nil service")
+	}
+	return &Handler{svc: svc}
+}
+
+// Create godoc
+// @Summary      Create workspace
+// @Description  Creates an empty workspace. Its first admin is added with seedadmin or easygodocsctl. Requires admin role in the default workspace.
+// @Tags         workspaces
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateWorkspaceInput true "Create workspace payload"
+// @Success      201 {object} CreateWorkspaceResp
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /workspaces [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var in CreateWorkspaceInput
+	if err := httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Msg("workspace.Handler.Create: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	id, err := h.svc.Create(ctx, workspace.CreateWorkspaceReq{Slug: in.Slug, Name: in.Name})
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/workspaces/"+id.String())
+	httpx.WriteJSON(ctx, w, http.StatusCreated, CreateWorkspaceResp{ID: id})
+}
+
+// Get godoc
+// @Summary      Get workspace
+// @Description  Returns a workspace by ID. Requires admin role in the default workspace.
+// @Tags         workspaces
+// @Security     BearerAuth
+// @Produce      json
+// @Param        workspace_id path string true "Workspace ID"
+// @Success      200 {object} workspace.Workspace
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /workspaces/{workspace_id} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamWorkspaceID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), idStr).
+			Msg("workspace.Handler.Get: invalid workspace ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	ws, err := h.svc.Get(ctx, id)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, ws)
+}
+
+// List godoc
+// @Summary      List workspaces
+// @Description  Returns all workspaces ordered by slug. Requires admin role in the default workspace.
+// @Tags         workspaces
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200 {array} workspace.Workspace
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /workspaces [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	list, err := h.svc.List(ctx)
+	if err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJSON(ctx, w, http.StatusOK, list)
+}
+
+// Update godoc
+// @Summary      Rename workspace
+// @Description  Changes the name of a workspace; the slug is fixed. Requires admin role in the default workspace.
+// @Tags         workspaces
+// @Security     BearerAuth
+// @Accept       json
+// @Param        workspace_id path string true "Workspace ID"
+// @Param        request body UpdateWorkspaceInput true "Update workspace payload"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /workspaces/{workspace_id} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamWorkspaceID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), idStr).
+			Msg("workspace.Handler.Update: invalid workspace ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	var in UpdateWorkspaceInput
+	if err = httpx.DecodeJSON(r, &in); err != nil {
+		logger.Error(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), idStr).
+			Msg("workspace.Handler.Update: request json decode failed")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.Update(ctx, workspace.UpdateWorkspaceReq{ID: id, Name: in.Name}); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Delete godoc
+// @Summary      Delete workspace
+// @Description  Soft-deletes a workspace: its data is kept but no request can reach it. The default workspace cannot be deleted. Requires admin role in the default workspace.
+// @Tags         workspaces
+// @Security     BearerAuth
+// @Param        workspace_id path string true "Workspace ID"
+// @Success      204 "No Content"
+// @Failure      default {object} apperr.Problem "Error"
+// @Router       /workspaces/{workspace_id} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	idStr := chi.URLParam(r, URLParamWorkspaceID)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		logger.Warn(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), idStr).
+			Msg("workspace.Handler.Delete: invalid workspace ID format")
+		httpx.ReturnError(ctx, w, apperr.ErrBadRequest())
+		return
+	}
+
+	if err = h.svc.Delete(ctx, id); err != nil {
+		httpx.ReturnError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
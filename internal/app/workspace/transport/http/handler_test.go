@@ -0,0 +1,143 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	workspace_http "github.com/66gu1/easygodocs/internal/app/workspace/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/workspace/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/go-chi/chi/v5"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestHandler_Create(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		setup      func(s *mocks.ServiceMock)
+	}{
+		{
+			name:       "invalid JSON -> 400",
+			body:       `{"slug":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "slug taken -> 409",
+			body:       `{"slug":"team-a","name":"Team A"}`,
+			wantStatus: http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Expect(minimock.AnyContext, workspace.CreateWorkspaceReq{Slug: "team-a", Name: "Team A"}).
+					Return(uuid.Nil, workspace.ErrSlugTaken())
+			},
+		},
+		{
+			name:       "not operator -> 403",
+			body:       `{"slug":"team-a","name":"Team A"}`,
+			wantStatus: http.StatusForbidden,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Return(uuid.Nil, apperr.ErrForbidden())
+			},
+		},
+		{
+			name:       "ok -> 201",
+			body:       `{"slug":"team-a","name":"Team A"}`,
+			wantStatus: http.StatusCreated,
+			setup: func(s *mocks.ServiceMock) {
+				s.CreateMock.Expect(minimock.AnyContext, workspace.CreateWorkspaceReq{Slug: "team-a", Name: "Team A"}).
+					Return(id, nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := workspace_http.NewHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/workspaces", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			h.Create(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+			if tc.wantStatus == http.StatusCreated {
+				var got workspace_http.CreateWorkspaceResp
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+				require.Equal(t, id, got.ID)
+				require.Equal(t, "/workspaces/"+id.String(), rr.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name        string
+		workspaceID string
+		wantStatus  int
+		setup       func(s *mocks.ServiceMock)
+	}{
+		{
+			name:        "invalid UUID -> 400",
+			workspaceID: "invalid",
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "default workspace -> 409",
+			workspaceID: id.String(),
+			wantStatus:  http.StatusConflict,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteMock.Expect(minimock.AnyContext, id).Return(workspace.ErrDeleteDefault())
+			},
+		},
+		{
+			name:        "ok -> 204",
+			workspaceID: id.String(),
+			wantStatus:  http.StatusNoContent,
+			setup: func(s *mocks.ServiceMock) {
+				s.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := mocks.NewServiceMock(t)
+			if tc.setup != nil {
+				tc.setup(mock)
+			}
+			h := workspace_http.NewHandler(mock)
+			r := chi.NewRouter()
+			r.Delete("/workspaces/{"+workspace_http.URLParamWorkspaceID+"}", h.Delete)
+
+			req := httptest.NewRequest(http.MethodDelete, "/workspaces/"+tc.workspaceID, http.NoBody)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+// HeaderWorkspace selects the workspace of a request by its slug.
+const HeaderWorkspace = "X-Workspace"
+
+type Resolver interface {
+	GetBySlug(ctx context.Context, slug string) (workspace.Workspace, error)
+}
+
+// Middleware stores the workspace of the request in the context. It is taken from the X-Workspace
+// header, then from the subdomain of cfg.BaseDomain; a request naming neither is served by the
+// default workspace. An unknown or deleted workspace is answered with 404.
+func Middleware(resolver Resolver, cfg workspace.Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			slug := r.Header.Get(HeaderWorkspace)
+			if slug == "" {
+				slug = subdomain(r.Host, cfg.BaseDomain)
+			}
+			if slug == "" {
+				next.ServeHTTP(w, r.WithContext(contextx.SetWorkspaceID(ctx, contextx.DefaultWorkspaceID)))
+				return
+			}
+
+			ws, err := resolver.GetBySlug(ctx, slug)
+			if err != nil {
+				logger.Error(ctx, err).
+					Str(workspace.FieldSlug.String(), slug).
+					Msg("workspace.Middleware: failed to resolve workspace")
+				httpx.ReturnError(ctx, w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(contextx.SetWorkspaceID(ctx, ws.ID)))
+		})
+	}
+}
+
+// subdomain returns the part of host before baseDomain, or "" when host is not under it.
+func subdomain(host, baseDomain string) string {
+	if baseDomain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	label, ok := strings.CutSuffix(host, "."+strings.ToLower(baseDomain))
+	if !ok {
+		return ""
+	}
+
+	return label
+}
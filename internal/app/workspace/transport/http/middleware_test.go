@@ -0,0 +1,101 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	workspace_http "github.com/66gu1/easygodocs/internal/app/workspace/transport/http"
+	"github.com/66gu1/easygodocs/internal/app/workspace/transport/http/mocks"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ws := workspace.Workspace{ID: uuid.New(), Slug: "team-a"}
+	cfg := workspace.Config{BaseDomain: "docs.example.com"}
+
+	tests := []struct {
+		name       string
+		host       string
+		header     string
+		setup      func(r *mocks.ResolverMock)
+		wantStatus int
+		want       uuid.UUID
+	}{
+		{
+			name:       "no header, base domain -> default",
+			host:       "docs.example.com",
+			wantStatus: http.StatusOK,
+			want:       contextx.DefaultWorkspaceID,
+		},
+		{
+			name:       "other host -> default",
+			host:       "localhost:8080",
+			wantStatus: http.StatusOK,
+			want:       contextx.DefaultWorkspaceID,
+		},
+		{
+			name: "subdomain",
+			host: "Team-A.docs.example.com:443",
+			setup: func(r *mocks.ResolverMock) {
+				r.GetBySlugMock.Expect(minimock.AnyContext, "team-a").Return(ws, nil)
+			},
+			wantStatus: http.StatusOK,
+			want:       ws.ID,
+		},
+		{
+			name:   "header wins over subdomain",
+			host:   "other.docs.example.com",
+			header: "team-a",
+			setup: func(r *mocks.ResolverMock) {
+				r.GetBySlugMock.Expect(minimock.AnyContext, "team-a").Return(ws, nil)
+			},
+			wantStatus: http.StatusOK,
+			want:       ws.ID,
+		},
+		{
+			name:   "unknown workspace -> 404",
+			host:   "docs.example.com",
+			header: "nope",
+			setup: func(r *mocks.ResolverMock) {
+				r.GetBySlugMock.Expect(minimock.AnyContext, "nope").Return(workspace.Workspace{}, workspace.ErrWorkspaceNotFound())
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := mocks.NewResolverMock(t)
+			if tt.setup != nil {
+				tt.setup(resolver)
+			}
+			var got uuid.UUID
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				id, err := contextx.GetWorkspaceID(r.Context())
+				require.NoError(t, err)
+				got = id
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			req.Host = tt.host
+			if tt.header != "" {
+				req.Header.Set(workspace_http.HeaderWorkspace, tt.header)
+			}
+			rr := httptest.NewRecorder()
+
+			workspace_http.Middleware(resolver, cfg)(next).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/app/workspace/transport/http.Resolver -o resolver_mock.go -n ResolverMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ResolverMock implements mm_http.Resolver
+type ResolverMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetBySlug          func(ctx context.Context, slug string) (w1 workspace.Workspace, err error)
+	funcGetBySlugOrigin    string
+	inspectFuncGetBySlug   func(ctx context.Context, slug string)
+	afterGetBySlugCounter  uint64
+	beforeGetBySlugCounter uint64
+	GetBySlugMock          mResolverMockGetBySlug
+}
+
+// NewResolverMock returns a mock for mm_http.Resolver
+func NewResolverMock(t minimock.Tester) *ResolverMock {
+	m := &ResolverMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetBySlugMock = mResolverMockGetBySlug{mock: m}
+	m.GetBySlugMock.callArgs = []*ResolverMockGetBySlugParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mResolverMockGetBySlug struct {
+	optional           bool
+	mock               *ResolverMock
+	defaultExpectation *ResolverMockGetBySlugExpectation
+	expectations       []*ResolverMockGetBySlugExpectation
+
+	callArgs []*ResolverMockGetBySlugParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResolverMockGetBySlugExpectation specifies expectation struct of the Resolver.GetBySlug
+type ResolverMockGetBySlugExpectation struct {
+	mock               *ResolverMock
+	params             *ResolverMockGetBySlugParams
+	paramPtrs          *ResolverMockGetBySlugParamPtrs
+	expectationOrigins ResolverMockGetBySlugExpectationOrigins
+	results            *ResolverMockGetBySlugResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResolverMockGetBySlugParams contains parameters of the Resolver.GetBySlug
+type ResolverMockGetBySlugParams struct {
+	ctx  context.Context
+	slug string
+}
+
+// ResolverMockGetBySlugParamPtrs contains pointers to parameters of the Resolver.GetBySlug
+type ResolverMockGetBySlugParamPtrs struct {
+	ctx  *context.Context
+	slug *string
+}
+
+// ResolverMockGetBySlugResults contains results of the Resolver.GetBySlug
+type ResolverMockGetBySlugResults struct {
+	w1  workspace.Workspace
+	err error
+}
+
+// ResolverMockGetBySlugOrigins contains origins of expectations of the Resolver.GetBySlug
+type ResolverMockGetBySlugExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSlug string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBySlug *mResolverMockGetBySlug) Optional() *mResolverMockGetBySlug {
+	mmGetBySlug.optional = true
+	return mmGetBySlug
+}
+
+// Expect sets up expected params for Resolver.GetBySlug
+func (mmGetBySlug *mResolverMockGetBySlug) Expect(ctx context.Context, slug string) *mResolverMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &ResolverMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by ExpectParams functions")
+	}
+
+	mmGetBySlug.defaultExpectation.params = &ResolverMockGetBySlugParams{ctx, slug}
+	mmGetBySlug.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBySlug.expectations {
+		if minimock.Equal(e.params, mmGetBySlug.defaultExpectation.params) {
+			mmGetBySlug.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySlug.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBySlug
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Resolver.GetBySlug
+func (mmGetBySlug *mResolverMockGetBySlug) ExpectCtxParam1(ctx context.Context) *mResolverMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &ResolverMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &ResolverMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBySlug.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBySlug
+}
+
+// ExpectSlugParam2 sets up expected param slug for Resolver.GetBySlug
+func (mmGetBySlug *mResolverMockGetBySlug) ExpectSlugParam2(slug string) *mResolverMockGetBySlug {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &ResolverMockGetBySlugExpectation{}
+	}
+
+	if mmGetBySlug.defaultExpectation.params != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Expect")
+	}
+
+	if mmGetBySlug.defaultExpectation.paramPtrs == nil {
+		mmGetBySlug.defaultExpectation.paramPtrs = &ResolverMockGetBySlugParamPtrs{}
+	}
+	mmGetBySlug.defaultExpectation.paramPtrs.slug = &slug
+	mmGetBySlug.defaultExpectation.expectationOrigins.originSlug = minimock.CallerInfo(1)
+
+	return mmGetBySlug
+}
+
+// Inspect accepts an inspector function that has same arguments as the Resolver.GetBySlug
+func (mmGetBySlug *mResolverMockGetBySlug) Inspect(f func(ctx context.Context, slug string)) *mResolverMockGetBySlug {
+	if mmGetBySlug.mock.inspectFuncGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("Inspect function is already set for ResolverMock.GetBySlug")
+	}
+
+	mmGetBySlug.mock.inspectFuncGetBySlug = f
+
+	return mmGetBySlug
+}
+
+// Return sets up results that will be returned by Resolver.GetBySlug
+func (mmGetBySlug *mResolverMockGetBySlug) Return(w1 workspace.Workspace, err error) *ResolverMock {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Set")
+	}
+
+	if mmGetBySlug.defaultExpectation == nil {
+		mmGetBySlug.defaultExpectation = &ResolverMockGetBySlugExpectation{mock: mmGetBySlug.mock}
+	}
+	mmGetBySlug.defaultExpectation.results = &ResolverMockGetBySlugResults{w1, err}
+	mmGetBySlug.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug.mock
+}
+
+// Set uses given function f to mock the Resolver.GetBySlug method
+func (mmGetBySlug *mResolverMockGetBySlug) Set(f func(ctx context.Context, slug string) (w1 workspace.Workspace, err error)) *ResolverMock {
+	if mmGetBySlug.defaultExpectation != nil {
+		mmGetBySlug.mock.t.Fatalf("Default expectation is already set for the Resolver.GetBySlug method")
+	}
+
+	if len(mmGetBySlug.expectations) > 0 {
+		mmGetBySlug.mock.t.Fatalf("Some expectations are already set for the Resolver.GetBySlug method")
+	}
+
+	mmGetBySlug.mock.funcGetBySlug = f
+	mmGetBySlug.mock.funcGetBySlugOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug.mock
+}
+
+// When sets expectation for the Resolver.GetBySlug which will trigger the result defined by the following
+// Then helper
+func (mmGetBySlug *mResolverMockGetBySlug) When(ctx context.Context, slug string) *ResolverMockGetBySlugExpectation {
+	if mmGetBySlug.mock.funcGetBySlug != nil {
+		mmGetBySlug.mock.t.Fatalf("ResolverMock.GetBySlug mock is already set by Set")
+	}
+
+	expectation := &ResolverMockGetBySlugExpectation{
+		mock:               mmGetBySlug.mock,
+		params:             &ResolverMockGetBySlugParams{ctx, slug},
+		expectationOrigins: ResolverMockGetBySlugExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBySlug.expectations = append(mmGetBySlug.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Resolver.GetBySlug return parameters for the expectation previously defined by the When method
+func (e *ResolverMockGetBySlugExpectation) Then(w1 workspace.Workspace, err error) *ResolverMock {
+	e.results = &ResolverMockGetBySlugResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times Resolver.GetBySlug should be invoked
+func (mmGetBySlug *mResolverMockGetBySlug) Times(n uint64) *mResolverMockGetBySlug {
+	if n == 0 {
+		mmGetBySlug.mock.t.Fatalf("Times of ResolverMock.GetBySlug mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBySlug.expectedInvocations, n)
+	mmGetBySlug.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBySlug
+}
+
+func (mmGetBySlug *mResolverMockGetBySlug) invocationsDone() bool {
+	if len(mmGetBySlug.expectations) == 0 && mmGetBySlug.defaultExpectation == nil && mmGetBySlug.mock.funcGetBySlug == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBySlug.mock.afterGetBySlugCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBySlug.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBySlug implements mm_http.Resolver
+func (mmGetBySlug *ResolverMock) GetBySlug(ctx context.Context, slug string) (w1 workspace.Workspace, err error) {
+	mm_atomic.AddUint64(&mmGetBySlug.beforeGetBySlugCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBySlug.afterGetBySlugCounter, 1)
+
+	mmGetBySlug.t.Helper()
+
+	if mmGetBySlug.inspectFuncGetBySlug != nil {
+		mmGetBySlug.inspectFuncGetBySlug(ctx, slug)
+	}
+
+	mm_params := ResolverMockGetBySlugParams{ctx, slug}
+
+	// Record call args
+	mmGetBySlug.GetBySlugMock.mutex.Lock()
+	mmGetBySlug.GetBySlugMock.callArgs = append(mmGetBySlug.GetBySlugMock.callArgs, &mm_params)
+	mmGetBySlug.GetBySlugMock.mutex.Unlock()
+
+	for _, e := range mmGetBySlug.GetBySlugMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmGetBySlug.GetBySlugMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBySlug.GetBySlugMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBySlug.GetBySlugMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBySlug.GetBySlugMock.defaultExpectation.paramPtrs
+
+		mm_got := ResolverMockGetBySlugParams{ctx, slug}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBySlug.t.Errorf("ResolverMock.GetBySlug got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.slug != nil && !minimock.Equal(*mm_want_ptrs.slug, mm_got.slug) {
+				mmGetBySlug.t.Errorf("ResolverMock.GetBySlug got unexpected parameter slug, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.originSlug, *mm_want_ptrs.slug, mm_got.slug, minimock.Diff(*mm_want_ptrs.slug, mm_got.slug))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBySlug.t.Errorf("ResolverMock.GetBySlug got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBySlug.GetBySlugMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBySlug.GetBySlugMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBySlug.t.Fatal("No results are set for the ResolverMock.GetBySlug")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmGetBySlug.funcGetBySlug != nil {
+		return mmGetBySlug.funcGetBySlug(ctx, slug)
+	}
+	mmGetBySlug.t.Fatalf("Unexpected call to ResolverMock.GetBySlug. %v %v", ctx, slug)
+	return
+}
+
+// GetBySlugAfterCounter returns a count of finished ResolverMock.GetBySlug invocations
+func (mmGetBySlug *ResolverMock) GetBySlugAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.afterGetBySlugCounter)
+}
+
+// GetBySlugBeforeCounter returns a count of ResolverMock.GetBySlug invocations
+func (mmGetBySlug *ResolverMock) GetBySlugBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySlug.beforeGetBySlugCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResolverMock.GetBySlug.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBySlug *mResolverMockGetBySlug) Calls() []*ResolverMockGetBySlugParams {
+	mmGetBySlug.mutex.RLock()
+
+	argCopy := make([]*ResolverMockGetBySlugParams, len(mmGetBySlug.callArgs))
+	copy(argCopy, mmGetBySlug.callArgs)
+
+	mmGetBySlug.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBySlugDone returns true if the count of the GetBySlug invocations corresponds
+// the number of defined expectations
+func (m *ResolverMock) MinimockGetBySlugDone() bool {
+	if m.GetBySlugMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBySlugMock.invocationsDone()
+}
+
+// MinimockGetBySlugInspect logs each unmet expectation
+func (m *ResolverMock) MinimockGetBySlugInspect() {
+	for _, e := range m.GetBySlugMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResolverMock.GetBySlug at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBySlugCounter := mm_atomic.LoadUint64(&m.afterGetBySlugCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySlugMock.defaultExpectation != nil && afterGetBySlugCounter < 1 {
+		if m.GetBySlugMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResolverMock.GetBySlug at\n%s", m.GetBySlugMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResolverMock.GetBySlug at\n%s with params: %#v", m.GetBySlugMock.defaultExpectation.expectationOrigins.origin, *m.GetBySlugMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySlug != nil && afterGetBySlugCounter < 1 {
+		m.t.Errorf("Expected call to ResolverMock.GetBySlug at\n%s", m.funcGetBySlugOrigin)
+	}
+
+	if !m.GetBySlugMock.invocationsDone() && afterGetBySlugCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResolverMock.GetBySlug at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBySlugMock.expectedInvocations), m.GetBySlugMock.expectedInvocationsOrigin, afterGetBySlugCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ResolverMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetBySlugInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ResolverMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ResolverMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetBySlugDone()
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+type Core interface {
+	Create(ctx context.Context, req workspace.CreateWorkspaceReq) (uuid.UUID, error)
+	Get(ctx context.Context, id uuid.UUID) (workspace.Workspace, error)
+	List(ctx context.Context) ([]workspace.Workspace, error)
+	Update(ctx context.Context, req workspace.UpdateWorkspaceReq) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// service manages workspaces. Its routes require an operator: an admin of the default workspace.
+type service struct {
+	core Core
+}
+
+func NewService(core Core) *service {
+	if core == nil {
+		panic("workspace.NewService: nil dependency")
+	}
+	return &service{core: core}
+}
+
+func (s *service) Create(ctx context.Context, req workspace.CreateWorkspaceReq) (uuid.UUID, error) {
+	id, err := s.core.Create(ctx, req)
+	if err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("workspace.service.Create: failed to create workspace")
+		return uuid.Nil, fmt.Errorf("workspace.service.Create: %w", err)
+	}
+	logger.Audit(ctx, "workspace.created").
+		Str(workspace.FieldWorkspaceID.String(), id.String()).
+		Str(workspace.FieldSlug.String(), req.Slug).
+		Msg("workspace created")
+
+	return id, nil
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (workspace.Workspace, error) {
+	ws, err := s.core.Get(ctx, id)
+	if err != nil {
+		logger.Error(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), id.String()).
+			Msg("workspace.service.Get: failed to get workspace")
+		return workspace.Workspace{}, fmt.Errorf("workspace.service.Get: %w", err)
+	}
+
+	return ws, nil
+}
+
+func (s *service) List(ctx context.Context) ([]workspace.Workspace, error) {
+	list, err := s.core.List(ctx)
+	if err != nil {
+		logger.Error(ctx, err).Msg("workspace.service.List: failed to list workspaces")
+		return nil, fmt.Errorf("workspace.service.List: %w", err)
+	}
+
+	return list, nil
+}
+
+func (s *service) Update(ctx context.Context, req workspace.UpdateWorkspaceReq) error {
+	if err := s.core.Update(ctx, req); err != nil {
+		logger.Error(ctx, err).
+			Interface(apperr.FieldRequest.String(), req).
+			Msg("workspace.service.Update: failed to update workspace")
+		return fmt.Errorf("workspace.service.Update: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.core.Delete(ctx, id); err != nil {
+		logger.Error(ctx, err).
+			Str(workspace.FieldWorkspaceID.String(), id.String()).
+			Msg("workspace.service.Delete: failed to delete workspace")
+		return fmt.Errorf("workspace.service.Delete: %w", err)
+	}
+	logger.Audit(ctx, "workspace.deleted").
+		Str(workspace.FieldWorkspaceID.String(), id.String()).
+		Msg("workspace deleted")
+
+	return nil
+}
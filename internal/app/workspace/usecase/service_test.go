@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/app/workspace"
+	"github.com/66gu1/easygodocs/internal/app/workspace/usecase"
+	"github.com/66gu1/easygodocs/internal/app/workspace/usecase/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		req    = workspace.CreateWorkspaceReq{Slug: "team-a", Name: "Team A"}
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(core *mocks.CoreMock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(core *mocks.CoreMock) {
+				core.CreateMock.Expect(ctx, req).Return(id, nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(core *mocks.CoreMock) {
+				core.CreateMock.Expect(ctx, req).Return(uuid.Nil, expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			core := mocks.NewCoreMock(t)
+			tt.setup(core)
+
+			got, err := usecase.NewService(core).Create(ctx, req)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, got)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	list := []workspace.Workspace{{ID: uuid.New(), Slug: "default", Name: "Default"}}
+
+	core := mocks.NewCoreMock(t)
+	core.ListMock.Expect(ctx).Return(list, nil)
+
+	got, err := usecase.NewService(core).List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, list, got)
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		id     = uuid.New()
+		expErr = errors.New("some error")
+	)
+
+	tests := []struct {
+		name  string
+		setup func(core *mocks.CoreMock)
+		err   error
+	}{
+		{
+			name: "ok",
+			setup: func(core *mocks.CoreMock) {
+				core.DeleteMock.Expect(ctx, id).Return(nil)
+			},
+		},
+		{
+			name: "core error",
+			setup: func(core *mocks.CoreMock) {
+				core.DeleteMock.Expect(ctx, id).Return(expErr)
+			},
+			err: expErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			core := mocks.NewCoreMock(t)
+			tt.setup(core)
+
+			err := usecase.NewService(core).Delete(ctx, id)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
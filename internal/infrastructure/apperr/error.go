@@ -11,6 +11,11 @@ const (
 	CodeUnauthorized Code = "core/unauthorized"
 	CodeForbidden    Code = "core/forbidden"
 	CodeInternal     Code = "core/internal_error"
+
+	CodeNotFound         Code = "core/not_found"
+	CodeMethodNotAllowed Code = "core/method_not_allowed"
+	CodeTooLarge         Code = "core/request_too_large"
+	CodeMaintenance      Code = "core/maintenance"
 )
 
 const (
@@ -18,6 +23,11 @@ const (
 	UnauthorizedMsg = "Unauthorized"
 	ForbiddenMsg    = "Forbidden"
 	InternalMsg     = "Internal server error"
+
+	NotFoundMsg         = "Not found"
+	MethodNotAllowedMsg = "Method not allowed"
+	TooLargeMsg         = "Request body too large"
+	MaintenanceMsg      = "The service is in read-only maintenance mode, try again later"
 )
 
 func ErrBadRequest() *appError {
@@ -50,6 +60,22 @@ func ErrForbidden() *appError {
 	}
 }
 
+func ErrNotFound() *appError {
+	return New(NotFoundMsg, CodeNotFound, ClassNotFound, LogLevelWarn)
+}
+
+func ErrMethodNotAllowed() *appError {
+	return New(MethodNotAllowedMsg, CodeMethodNotAllowed, ClassMethodNotAllowed, LogLevelWarn)
+}
+
+func ErrTooLarge() *appError {
+	return New(TooLargeMsg, CodeTooLarge, ClassTooLarge, LogLevelWarn)
+}
+
+func ErrMaintenance() *appError {
+	return New(MaintenanceMsg, CodeMaintenance, ClassUnavailable, LogLevelWarn)
+}
+
 func ErrNilUUID(field Field) *appError {
 	return &appError{
 		Message:  ErrBadRequest().Error(),
@@ -118,6 +144,8 @@ type Violation struct {
 	Field  Field          `json:"field"`
 	Rule   Rule           `json:"rule"`
 	Params map[string]any `json:"params,omitempty"`
+	// Message is a human-readable description in the client's language, filled by the transport.
+	Message string `json:"message,omitempty"`
 }
 
 type Field string
@@ -133,12 +161,18 @@ type Code string
 type Class uint8
 
 const (
-	ClassInternal     Class = 1
-	ClassBadRequest   Class = 2
-	ClassNotFound     Class = 3
-	ClassUnauthorized Class = 4
-	ClassForbidden    Class = 5
-	ClassConflict     Class = 6
+	ClassInternal         Class = 1
+	ClassBadRequest       Class = 2
+	ClassNotFound         Class = 3
+	ClassUnauthorized     Class = 4
+	ClassForbidden        Class = 5
+	ClassConflict         Class = 6
+	ClassTooManyRequests  Class = 7
+	ClassLocked           Class = 8
+	ClassUnprocessable    Class = 9
+	ClassTooLarge         Class = 10
+	ClassMethodNotAllowed Class = 11
+	ClassUnavailable      Class = 12
 )
 
 type LogLevel int
@@ -178,7 +212,7 @@ func FromError(err error) *appError {
 		return ae
 	}
 	return &appError{
-		Message:  "Internal server error",
+		Message:  InternalMsg,
 		Code:     CodeInternal,
 		class:    ClassInternal,
 		logLevel: LogLevelError,
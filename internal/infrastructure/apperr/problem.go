@@ -0,0 +1,138 @@
+package apperr
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ProblemTypeBase prefixes codes to build RFC 7807 problem type URIs.
+const ProblemTypeBase = "urn:easygodocs:problem:"
+
+// Problem is an RFC 7807 problem details object. Code and Violations are extension members.
+// Transports fill Instance and RequestID.
+type Problem struct {
+	Type       string      `json:"type"`
+	Title      string      `json:"title"`
+	Status     int         `json:"status"`
+	Detail     string      `json:"detail,omitempty"`
+	Instance   string      `json:"instance,omitempty"`
+	Code       Code        `json:"code"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// ProblemType is a catalog entry: every registered code has one title and one HTTP status.
+type ProblemType struct {
+	Type   string `json:"type"`
+	Code   Code   `json:"code"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+}
+
+var catalog = struct {
+	sync.RWMutex
+	types map[Code]ProblemType
+}{types: make(map[Code]ProblemType)}
+
+// Register adds code to the catalog. Packages register their codes in init;
+// registering a code twice with a different class panics.
+func Register(code Code, title string, class Class) {
+	pt := ProblemType{Type: TypeURI(code), Code: code, Title: title, Status: class.HTTPStatus()}
+
+	catalog.Lock()
+	defer catalog.Unlock()
+	if existing, ok := catalog.types[code]; ok && existing.Status != pt.Status {
+		panic(fmt.Sprintf("apperr.Register: %s is already registered with status %d", code, existing.Status))
+	}
+	catalog.types[code] = pt
+}
+
+// Catalog returns the registered problem types ordered by code.
+func Catalog() []ProblemType {
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	types := make([]ProblemType, 0, len(catalog.types))
+	for _, pt := range catalog.types {
+		types = append(types, pt)
+	}
+	slices.SortFunc(types, func(a, b ProblemType) int { return strings.Compare(string(a.Code), string(b.Code)) })
+
+	return types
+}
+
+func LookupProblemType(code Code) (ProblemType, bool) {
+	catalog.RLock()
+	defer catalog.RUnlock()
+	pt, ok := catalog.types[code]
+
+	return pt, ok
+}
+
+func TypeURI(code Code) string {
+	return ProblemTypeBase + string(code)
+}
+
+// HTTPStatus is the single mapping of error classes to HTTP statuses.
+func (c Class) HTTPStatus() int {
+	switch c {
+	case ClassBadRequest:
+		return http.StatusBadRequest
+	case ClassNotFound:
+		return http.StatusNotFound
+	case ClassUnauthorized:
+		return http.StatusUnauthorized
+	case ClassForbidden:
+		return http.StatusForbidden
+	case ClassConflict:
+		return http.StatusConflict
+	case ClassTooManyRequests:
+		return http.StatusTooManyRequests
+	case ClassLocked:
+		return http.StatusLocked
+	case ClassUnprocessable:
+		return http.StatusUnprocessableEntity
+	case ClassTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case ClassMethodNotAllowed:
+		return http.StatusMethodNotAllowed
+	case ClassUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ToProblem converts any error; errors that are not application errors become a generic internal problem.
+// The status and title come from the catalog when the code is registered.
+func ToProblem(err error) Problem {
+	ae := FromError(err)
+	status := ae.class.HTTPStatus()
+	title := http.StatusText(status)
+	if pt, ok := LookupProblemType(ae.Code); ok {
+		status, title = pt.Status, pt.Title
+	}
+
+	return Problem{
+		Type:       TypeURI(ae.Code),
+		Title:      title,
+		Status:     status,
+		Detail:     ae.Message,
+		Code:       ae.Code,
+		Violations: ae.Violations,
+	}
+}
+
+func init() {
+	Register(CodeBadRequest, BadRequestMsg, ClassBadRequest)
+	Register(CodeUnauthorized, UnauthorizedMsg, ClassUnauthorized)
+	Register(CodeForbidden, ForbiddenMsg, ClassForbidden)
+	Register(CodeInternal, InternalMsg, ClassInternal)
+	Register(CodeNotFound, NotFoundMsg, ClassNotFound)
+	Register(CodeMethodNotAllowed, MethodNotAllowedMsg, ClassMethodNotAllowed)
+	Register(CodeTooLarge, TooLargeMsg, ClassTooLarge)
+	Register(CodeMaintenance, "Read-only maintenance mode", ClassUnavailable)
+}
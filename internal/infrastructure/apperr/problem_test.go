@@ -0,0 +1,97 @@
+package apperr_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProblem(t *testing.T) {
+	t.Parallel()
+
+	violation := apperr.Violation{Field: "name", Rule: apperr.RuleRequired}
+
+	tests := []struct {
+		name string
+		err  error
+		want apperr.Problem
+	}{
+		{
+			name: "registered code",
+			err: fmt.Errorf("wrapped: %w", apperr.ErrBadRequest().
+				WithUserMessage("name is required").WithDetail("log only").WithViolation(violation)),
+			want: apperr.Problem{
+				Type:       "urn:easygodocs:problem:core/bad_request",
+				Title:      apperr.BadRequestMsg,
+				Status:     http.StatusBadRequest,
+				Detail:     "name is required",
+				Code:       apperr.CodeBadRequest,
+				Violations: []apperr.Violation{violation},
+			},
+		},
+		{
+			name: "unregistered code falls back to the class",
+			err:  apperr.New("Gone fishing", "test/unregistered", apperr.ClassLocked, apperr.LogLevelWarn),
+			want: apperr.Problem{
+				Type:   "urn:easygodocs:problem:test/unregistered",
+				Title:  http.StatusText(http.StatusLocked),
+				Status: http.StatusLocked,
+				Detail: "Gone fishing",
+				Code:   "test/unregistered",
+			},
+		},
+		{
+			name: "internal error hides details",
+			err:  errors.New("db is down"),
+			want: apperr.Problem{
+				Type:   "urn:easygodocs:problem:core/internal_error",
+				Title:  apperr.InternalMsg,
+				Status: http.StatusInternalServerError,
+				Detail: apperr.InternalMsg,
+				Code:   apperr.CodeInternal,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, apperr.ToProblem(tt.err))
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	const code apperr.Code = "test/registered"
+	apperr.Register(code, "Registered", apperr.ClassConflict)
+	// same status is fine, e.g. when a title is reworded
+	apperr.Register(code, "Registered again", apperr.ClassConflict)
+
+	pt, ok := apperr.LookupProblemType(code)
+	require.True(t, ok)
+	require.Equal(t, apperr.ProblemType{
+		Type:   apperr.TypeURI(code),
+		Code:   code,
+		Title:  "Registered again",
+		Status: http.StatusConflict,
+	}, pt)
+	require.Contains(t, apperr.Catalog(), pt)
+
+	require.Panics(t, func() { apperr.Register(code, "Registered", apperr.ClassBadRequest) })
+}
+
+func TestCatalog_Sorted(t *testing.T) {
+	t.Parallel()
+
+	types := apperr.Catalog()
+	require.NotEmpty(t, types)
+	for i := 1; i < len(types); i++ {
+		require.Less(t, types[i-1].Code, types[i].Code)
+	}
+}
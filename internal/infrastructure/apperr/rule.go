@@ -14,4 +14,6 @@ const (
 	RuleForbidden     Rule = "forbidden"
 	RuleInvalidState  Rule = "invalid_state"
 	RuleNotFound      Rule = "not_found"
+	RuleOutOfRange    Rule = "out_of_range"
+	RuleLocked        Rule = "locked"
 )
@@ -0,0 +1,122 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ErrNotFound is returned when no object is stored under the key.
+var ErrNotFound = errors.New("blob not found")
+
+type Config struct {
+	Dir string `mapstructure:"dir" json:"dir"`
+}
+
+func (c Config) Validate() error {
+	if c.Dir == "" {
+		return fmt.Errorf("dir is required")
+	}
+
+	return nil
+}
+
+// localStore keeps objects as files under a root directory. Keys are slash-separated
+// relative paths, e.g. "avatars/<user_id>".
+type localStore struct {
+	root string
+}
+
+func NewLocalStore(cfg Config) (*localStore, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("blob.NewLocalStore: %w", err)
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("blob.NewLocalStore: %w", err)
+	}
+
+	return &localStore{root: cfg.Dir}, nil
+}
+
+// Put replaces the object atomically: readers see either the old or the new content.
+func (s *localStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".put-*")
+	if err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("blob.localStore.Put: %w", err)
+	}
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("blob.localStore.Get: %w", err)
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return nil, fmt.Errorf("blob.localStore.Get: %w", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = ErrNotFound
+		}
+		return nil, fmt.Errorf("blob.localStore.Get: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("blob.localStore.Delete: %w", err)
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return fmt.Errorf("blob.localStore.Delete: %w", err)
+	}
+	if err = os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = ErrNotFound
+		}
+		return fmt.Errorf("blob.localStore.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// path rejects keys that would escape the root directory.
+func (s *localStore) path(key string) (string, error) {
+	local := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(local) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.root, local), nil
+}
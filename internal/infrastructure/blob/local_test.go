@@ -0,0 +1,41 @@
+package blob_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/blob"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	t.Parallel()
+
+	_, err := blob.NewLocalStore(blob.Config{})
+	require.Error(t, err)
+
+	s, err := blob.NewLocalStore(blob.Config{Dir: t.TempDir()})
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	_, err = s.Get(ctx, "avatars/a")
+	require.ErrorIs(t, err, blob.ErrNotFound)
+
+	require.NoError(t, s.Put(ctx, "avatars/a", strings.NewReader("first")))
+	require.NoError(t, s.Put(ctx, "avatars/a", strings.NewReader("second")))
+
+	r, err := s.Get(ctx, "avatars/a")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "second", string(data))
+
+	require.NoError(t, s.Delete(ctx, "avatars/a"))
+	require.ErrorIs(t, s.Delete(ctx, "avatars/a"), blob.ErrNotFound)
+
+	for _, key := range []string{"", "../escape", "/abs"} {
+		require.Error(t, s.Put(ctx, key, strings.NewReader("x")), key)
+	}
+}
@@ -0,0 +1,57 @@
+package buildinfo
+
+import (
+	"expvar"
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time with -ldflags "-X github.com/66gu1/easygodocs/internal/infrastructure/buildinfo.Version=...";
+// Commit and Date fall back to what the Go toolchain recorded from the VCS.
+var (
+	Version = "dev"
+	Commit  = ""
+	Date    = ""
+)
+
+// Info describes the running binary and the config profile it was started with.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	Profile   string `json:"profile"`
+}
+
+// Get returns the build info of the binary.
+func Get(profile string) Info {
+	info := Info{Version: Version, Commit: Commit, BuildDate: Date, GoVersion: runtime.Version(), Profile: profile}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, s := range bi.Settings {
+		switch {
+		case s.Key == "vcs.revision" && info.Commit == "":
+			info.Commit = s.Value
+		case s.Key == "vcs.time" && info.BuildDate == "":
+			info.BuildDate = s.Value
+		}
+	}
+
+	return info
+}
+
+// Release names the build for error trackers: the version, or the commit of a development build.
+func (i Info) Release() string {
+	if i.Version != "dev" || i.Commit == "" {
+		return i.Version
+	}
+
+	return i.Commit
+}
+
+// Publish adds the info to the expvar metrics as "build_info". It panics when called twice.
+func Publish(info Info) {
+	expvar.Publish("build_info", expvar.Func(func() any { return info }))
+}
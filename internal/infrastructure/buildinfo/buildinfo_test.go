@@ -0,0 +1,39 @@
+package buildinfo_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/buildinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	info := buildinfo.Get("staging")
+	require.Equal(t, buildinfo.Version, info.Version)
+	require.Equal(t, runtime.Version(), info.GoVersion)
+	require.Equal(t, "staging", info.Profile)
+}
+
+func TestInfo_Release(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		info buildinfo.Info
+		want string
+	}{
+		{name: "tagged build", info: buildinfo.Info{Version: "v1.2.0", Commit: "abc"}, want: "v1.2.0"},
+		{name: "development build", info: buildinfo.Info{Version: "dev", Commit: "abc"}, want: "abc"},
+		{name: "development build without commit", info: buildinfo.Info{Version: "dev"}, want: "dev"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.info.Release())
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package captcha
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Provider string
+
+const (
+	ProviderRecaptcha Provider = "recaptcha"
+	ProviderHCaptcha  Provider = "hcaptcha"
+	ProviderTurnstile Provider = "turnstile"
+)
+
+// verifyURLs are the siteverify endpoints of the providers; they share one protocol.
+var verifyURLs = map[Provider]string{
+	ProviderRecaptcha: "https://www.google.com/recaptcha/api/siteverify",
+	ProviderHCaptcha:  "https://api.hcaptcha.com/siteverify",
+	ProviderTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+}
+
+// Verifier checks the token a client got by solving a CAPTCHA. It returns false for a token the
+// provider rejects and an error only when the provider could not be asked.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+type Config struct {
+	// Provider is recaptcha, hcaptcha or turnstile; empty disables CAPTCHAs.
+	Provider Provider `mapstructure:"provider" json:"provider"`
+	Secret   string   `mapstructure:"secret" json:"-"`
+	// MinScore is the lowest reCAPTCHA v3 score accepted; v2 tokens and other providers have no score.
+	MinScore       float64 `mapstructure:"min_score" json:"min_score"`
+	TimeoutSeconds int     `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+
+	Register bool        `mapstructure:"register" json:"register"`
+	Login    LoginConfig `mapstructure:"login" json:"login"`
+	// APIKeys let callers such as provisioning scripts skip CAPTCHAs with the X-API-Key header.
+	APIKeys []string `mapstructure:"api_keys" json:"-"`
+}
+
+// LoginConfig requires a CAPTCHA on sign-in once a client IP or an email has failed AfterFailures
+// times within WindowMinutes; 0 requires it on every attempt.
+type LoginConfig struct {
+	Enabled       bool `mapstructure:"enabled" json:"enabled"`
+	AfterFailures int  `mapstructure:"after_failures" json:"after_failures"`
+	WindowMinutes int  `mapstructure:"window_minutes" json:"window_minutes"`
+}
+
+func (c Config) Validate() error {
+	if c.Provider == "" {
+		return nil
+	}
+	if _, ok := verifyURLs[c.Provider]; !ok {
+		return fmt.Errorf("provider must be recaptcha, hcaptcha or turnstile")
+	}
+	if c.Secret == "" {
+		return fmt.Errorf("secret must be set with a provider")
+	}
+	if c.MinScore < 0 || c.MinScore > 1 {
+		return fmt.Errorf("min_score must be between 0 and 1")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+	if c.Login.AfterFailures < 0 {
+		return fmt.Errorf("login.after_failures must not be negative")
+	}
+	if c.Login.Enabled && c.Login.AfterFailures > 0 && c.Login.WindowMinutes <= 0 {
+		return fmt.Errorf("login.window_minutes must be positive")
+	}
+	for _, key := range c.APIKeys {
+		if len(key) < 16 {
+			return fmt.Errorf("api_keys must be at least 16 characters")
+		}
+	}
+
+	return nil
+}
+
+// New returns the verifier the config chooses, or Noop without a provider.
+func New(cfg Config, client *http.Client) (Verifier, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("captcha.New: %w", err)
+	}
+	if cfg.Provider == "" {
+		return Noop{}, nil
+	}
+	if client == nil {
+		client = &http.Client{Timeout: time.Duration(cfg.TimeoutSeconds) * time.Second}
+	}
+	minScore := 0.0
+	if cfg.Provider == ProviderRecaptcha {
+		minScore = cfg.MinScore
+	}
+
+	return NewSiteVerify(verifyURLs[cfg.Provider], cfg.Secret, minScore, client), nil
+}
+
+// Noop accepts every token.
+type Noop struct{}
+
+func (Noop) Verify(context.Context, string, string) (bool, error) {
+	return true, nil
+}
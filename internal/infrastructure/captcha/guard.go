@@ -0,0 +1,243 @@
+package captcha
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+)
+
+const (
+	// TokenHeader carries the token the client got from the CAPTCHA widget.
+	TokenHeader  = "X-Captcha-Token"
+	APIKeyHeader = "X-API-Key"
+
+	// maxTrackedClients bounds the failed sign-ins kept in memory, of client IPs and emails; expired
+	// entries are dropped first.
+	maxTrackedClients = 100_000
+)
+
+const (
+	CodeRequired apperr.Code = "captcha/required"
+	CodeFailed   apperr.Code = "captcha/failed"
+
+	FieldToken apperr.Field = "captcha_token"
+)
+
+func init() {
+	apperr.Register(CodeRequired, "CAPTCHA required", apperr.ClassForbidden)
+	apperr.Register(CodeFailed, "CAPTCHA failed", apperr.ClassForbidden)
+}
+
+func ErrRequired() error {
+	return apperr.New("Solve the CAPTCHA and send its token in the X-Captcha-Token header", CodeRequired,
+		apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldToken, Rule: apperr.RuleRequired})
+}
+
+func ErrFailed() error {
+	return apperr.New("The CAPTCHA token was not accepted", CodeFailed, apperr.ClassForbidden, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldToken, Rule: apperr.RuleMismatch})
+}
+
+type failures struct {
+	count int
+	since time.Time
+}
+
+// Guard enforces CAPTCHAs on the endpoints the config names. Failed sign-ins are counted per client
+// IP and per email sent, since the IP alone can be changed with every request through a forwarding
+// header. The email is counted whether or not an account has it, so asking for a CAPTCHA does not
+// tell whether one exists. The counts are kept per instance.
+type Guard struct {
+	verifier Verifier
+	cfg      Config
+	now      func() time.Time
+
+	mu       sync.Mutex
+	failures map[string]failures
+}
+
+func NewGuard(verifier Verifier, cfg Config) *Guard {
+	return &Guard{verifier: verifier, cfg: cfg, now: time.Now, failures: make(map[string]failures)}
+}
+
+// Register requires a CAPTCHA on every request when the config enables it for registration.
+func (g *Guard) Register(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.cfg.Provider == "" || !g.cfg.Register || g.bypass(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !g.check(w, r) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Login requires a CAPTCHA when the client or the email signed in with has failed too often, judging
+// the outcome of a request by its status: 401 counts as a failure and a success clears the counts.
+func (g *Guard) Login(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.cfg.Provider == "" || !g.cfg.Login.Enabled || g.bypass(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		keys := []string{"ip:" + httpx.ClientIP(r)}
+		if email := loginEmail(r); email != "" {
+			keys = append(keys, "email:"+email)
+		}
+		if g.failedTimes(keys) >= g.cfg.Login.AfterFailures && !g.check(w, r) {
+			return
+		}
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+		switch {
+		case sw.status == http.StatusUnauthorized:
+			g.recordFailure(keys)
+		case sw.status < http.StatusBadRequest:
+			g.clearFailures(keys)
+		}
+	})
+}
+
+// loginEmail returns the normalized email of the sign-in request r, or "" when the body has none, and
+// leaves the body to be read again. Malformed bodies are for the handler to reject.
+func loginEmail(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+	var input struct {
+		Email string `json:"email"`
+	}
+	if json.Unmarshal(body, &input) != nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(input.Email))
+}
+
+// check verifies the token of r, answering the request itself when it fails.
+func (g *Guard) check(w http.ResponseWriter, r *http.Request) bool {
+	ctx := r.Context()
+	token := r.Header.Get(TokenHeader)
+	if token == "" {
+		httpx.ReturnError(ctx, w, ErrRequired())
+		return false
+	}
+	ok, err := g.verifier.Verify(ctx, token, httpx.ClientIP(r))
+	if err != nil {
+		logger.Error(ctx, err).Msg("captcha.Guard: failed to verify token")
+		httpx.ReturnError(ctx, w, err)
+		return false
+	}
+	if !ok {
+		httpx.ReturnError(ctx, w, ErrFailed())
+		return false
+	}
+
+	return true
+}
+
+func (g *Guard) bypass(r *http.Request) bool {
+	key := r.Header.Get(APIKeyHeader)
+	if key == "" {
+		return false
+	}
+	for _, k := range g.cfg.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(k)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Guard) window() time.Duration {
+	return time.Duration(g.cfg.Login.WindowMinutes) * time.Minute
+}
+
+// failedTimes returns the highest count of failures of keys.
+func (g *Guard) failedTimes(keys []string) int {
+	if g.cfg.Login.AfterFailures == 0 {
+		return 0
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	most := 0
+	for _, key := range keys {
+		f, ok := g.failures[key]
+		if ok && g.now().Sub(f.since) < g.window() {
+			most = max(most, f.count)
+		}
+	}
+
+	return most
+}
+
+func (g *Guard) recordFailure(keys []string) {
+	if g.cfg.Login.AfterFailures == 0 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	for _, key := range keys {
+		f, ok := g.failures[key]
+		if !ok || now.Sub(f.since) >= g.window() {
+			if len(g.failures) >= maxTrackedClients {
+				g.prune(now)
+			}
+			f = failures{since: now}
+		}
+		f.count++
+		g.failures[key] = f
+	}
+}
+
+func (g *Guard) clearFailures(keys []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, key := range keys {
+		delete(g.failures, key)
+	}
+}
+
+// prune drops expired counts, and all of them if none has expired, so memory stays bounded.
+func (g *Guard) prune(now time.Time) {
+	for key, f := range g.failures {
+		if now.Sub(f.since) >= g.window() {
+			delete(g.failures, key)
+		}
+	}
+	if len(g.failures) >= maxTrackedClients {
+		clear(g.failures)
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
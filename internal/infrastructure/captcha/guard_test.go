@@ -0,0 +1,119 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// verifierFunc accepts the tokens the function accepts.
+type verifierFunc func(token string) (bool, error)
+
+func (f verifierFunc) Verify(_ context.Context, token, _ string) (bool, error) {
+	return f(token)
+}
+
+var goodToken verifierFunc = func(token string) (bool, error) { return token == "good", nil }
+
+func serve(h http.Handler, ip string, header map[string]string) int {
+	return serveBody(h, ip, "", header)
+}
+
+func serveBody(h http.Handler, ip, body string, header map[string]string) int {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.RemoteAddr = ip + ":1234"
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+	return rr.Code
+}
+
+func TestGuard_Register(t *testing.T) {
+	t.Parallel()
+
+	created := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusCreated) })
+	cfg := Config{Provider: ProviderTurnstile, Register: true, APIKeys: []string{"0123456789abcdef"}}
+	h := NewGuard(goodToken, cfg).Register(created)
+
+	require.Equal(t, http.StatusForbidden, serve(h, "203.0.113.1", nil))
+	require.Equal(t, http.StatusForbidden, serve(h, "203.0.113.1", map[string]string{TokenHeader: "bad"}))
+	require.Equal(t, http.StatusCreated, serve(h, "203.0.113.1", map[string]string{TokenHeader: "good"}))
+	require.Equal(t, http.StatusCreated, serve(h, "203.0.113.1", map[string]string{APIKeyHeader: "0123456789abcdef"}))
+	require.Equal(t, http.StatusForbidden, serve(h, "203.0.113.1", map[string]string{APIKeyHeader: "0123456789abcdeX"}))
+
+	failing := verifierFunc(func(string) (bool, error) { return false, errors.New("provider down") })
+	require.Equal(t, http.StatusInternalServerError,
+		serve(NewGuard(failing, cfg).Register(created), "203.0.113.1", map[string]string{TokenHeader: "good"}))
+
+	// not enforced without a provider or for an endpoint that is off
+	require.Equal(t, http.StatusCreated, serve(NewGuard(Noop{}, Config{Register: true}).Register(created), "203.0.113.1", nil))
+	require.Equal(t, http.StatusCreated, serve(NewGuard(goodToken, Config{Provider: ProviderTurnstile}).Register(created), "203.0.113.1", nil))
+}
+
+func TestGuard_Login(t *testing.T) {
+	t.Parallel()
+
+	// the handler signs in callers sending the password "secret" in X-Password
+	login := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Password") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	now := time.Date(2025, 10, 3, 10, 0, 0, 0, time.UTC)
+	g := NewGuard(goodToken, Config{Provider: ProviderHCaptcha, Login: LoginConfig{Enabled: true, AfterFailures: 2, WindowMinutes: 15}})
+	g.now = func() time.Time { return now }
+	h := g.Login(login)
+
+	const ip, other = "203.0.113.1", "203.0.113.2"
+	wrong := map[string]string{"X-Password": "wrong"}
+	right := map[string]string{"X-Password": "secret"}
+	withToken := map[string]string{"X-Password": "secret", TokenHeader: "good"}
+
+	require.Equal(t, http.StatusUnauthorized, serve(h, ip, wrong))
+	require.Equal(t, http.StatusUnauthorized, serve(h, ip, wrong))
+	// two failures: a CAPTCHA is required from this client only
+	require.Equal(t, http.StatusForbidden, serve(h, ip, right))
+	require.Equal(t, http.StatusOK, serve(h, other, right))
+	// a success with a CAPTCHA clears the count
+	require.Equal(t, http.StatusOK, serve(h, ip, withToken))
+	require.Equal(t, http.StatusOK, serve(h, ip, right))
+
+	// failures expire with the window
+	require.Equal(t, http.StatusUnauthorized, serve(h, ip, wrong))
+	require.Equal(t, http.StatusUnauthorized, serve(h, ip, wrong))
+	require.Equal(t, http.StatusForbidden, serve(h, ip, right))
+	now = now.Add(15 * time.Minute)
+	require.Equal(t, http.StatusOK, serve(h, ip, right))
+
+	// failures are counted per email too, whichever IP they come from
+	ann := func(email string) string { return `{"email":"` + email + `","password":"x"}` }
+	require.Equal(t, http.StatusUnauthorized, serveBody(h, "198.51.100.1", ann("ann@example.com"), wrong))
+	require.Equal(t, http.StatusUnauthorized, serveBody(h, "198.51.100.2", ann(" Ann@Example.com"), wrong))
+	require.Equal(t, http.StatusForbidden, serveBody(h, "198.51.100.3", ann("ann@example.com"), right))
+	require.Equal(t, http.StatusOK, serveBody(h, "198.51.100.3", ann("bob@example.com"), right))
+	// the handler still reads the body
+	echo := g.Login(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, ann("ann@example.com"), string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	require.Equal(t, http.StatusOK, serveBody(echo, "198.51.100.3", ann("ann@example.com"), withToken))
+	require.Equal(t, http.StatusOK, serveBody(h, "198.51.100.4", ann("ann@example.com"), right))
+
+	// after_failures 0 asks every time
+	always := NewGuard(goodToken, Config{Provider: ProviderHCaptcha, Login: LoginConfig{Enabled: true}}).Login(login)
+	require.Equal(t, http.StatusForbidden, serve(always, ip, right))
+	require.Equal(t, http.StatusOK, serve(always, ip, withToken))
+}
@@ -0,0 +1,76 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// maxResponseBytes bounds what is read from a provider; its answers are a few hundred bytes.
+const maxResponseBytes = 64 << 10
+
+// SiteVerify asks a provider's siteverify endpoint, which reCAPTCHA, hCaptcha and Turnstile all
+// implement: a form with the secret, the token and the client IP, answered with JSON.
+type SiteVerify struct {
+	url      string
+	secret   string
+	minScore float64
+	client   *http.Client
+}
+
+func NewSiteVerify(url, secret string, minScore float64, client *http.Client) *SiteVerify {
+	return &SiteVerify{url: url, secret: secret, minScore: minScore, client: client}
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (v *SiteVerify) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	form := url.Values{"secret": {v.secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("captcha.SiteVerify.Verify: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("captcha.SiteVerify.Verify: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("captcha.SiteVerify.Verify: %w", fmt.Errorf("unexpected status %d", resp.StatusCode))
+	}
+
+	var out siteVerifyResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&out); err != nil {
+		return false, fmt.Errorf("captcha.SiteVerify.Verify: %w", err)
+	}
+	if !out.Success {
+		// a bad secret is a misconfiguration, not a bad token
+		if slices.Contains(out.ErrorCodes, "invalid-input-secret") || slices.Contains(out.ErrorCodes, "missing-input-secret") {
+			return false, fmt.Errorf("captcha.SiteVerify.Verify: %w", fmt.Errorf("secret rejected by the provider"))
+		}
+		return false, nil
+	}
+	if v.minScore > 0 && out.Score != nil && *out.Score < v.minScore {
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,87 @@
+package captcha
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteVerify_Verify(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "s3cret", r.PostForm.Get("secret"))
+		require.Equal(t, "203.0.113.1", r.PostForm.Get("remoteip"))
+		switch r.PostForm.Get("response") {
+		case "good":
+			_, _ = w.Write([]byte(`{"success":true}`))
+		case "low-score":
+			_, _ = w.Write([]byte(`{"success":true,"score":0.2}`))
+		case "high-score":
+			_, _ = w.Write([]byte(`{"success":true,"score":0.9}`))
+		case "bad-secret":
+			_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-secret"]}`))
+		case "down":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	v := NewSiteVerify(srv.URL, "s3cret", 0.5, srv.Client())
+
+	tests := []struct {
+		token   string
+		want    bool
+		wantErr bool
+	}{
+		{token: "good", want: true},
+		{token: "high-score", want: true},
+		{token: "low-score"},
+		{token: "expired"},
+		{token: ""},
+		{token: "bad-secret", wantErr: true},
+		{token: "down", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := v.Verify(t.Context(), tt.token, "203.0.113.1")
+		if tt.wantErr {
+			require.Error(t, err, tt.token)
+			continue
+		}
+		require.NoError(t, err, tt.token)
+		require.Equal(t, tt.want, got, tt.token)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	v, err := New(Config{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, Noop{}, v)
+
+	v, err = New(Config{Provider: ProviderRecaptcha, Secret: "s", MinScore: 0.5, TimeoutSeconds: 5}, nil)
+	require.NoError(t, err)
+	require.Equal(t, verifyURLs[ProviderRecaptcha], v.(*SiteVerify).url)
+	require.InDelta(t, 0.5, v.(*SiteVerify).minScore, 0)
+
+	// scores only apply to reCAPTCHA
+	v, err = New(Config{Provider: ProviderHCaptcha, Secret: "s", MinScore: 0.5, TimeoutSeconds: 5}, nil)
+	require.NoError(t, err)
+	require.Zero(t, v.(*SiteVerify).minScore)
+
+	for _, cfg := range []Config{
+		{Provider: "captchas.example", Secret: "s", TimeoutSeconds: 5},
+		{Provider: ProviderTurnstile, TimeoutSeconds: 5},
+		{Provider: ProviderTurnstile, Secret: "s"},
+		{Provider: ProviderTurnstile, Secret: "s", TimeoutSeconds: 5, Login: LoginConfig{Enabled: true, AfterFailures: 3}},
+		{Provider: ProviderTurnstile, Secret: "s", TimeoutSeconds: 5, APIKeys: []string{"short"}},
+	} {
+		_, err = New(cfg, nil)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,57 @@
+package contextx
+
+import (
+	"context"
+	"sync"
+)
+
+const cacheKey = contextKey("cache")
+
+// cache holds values computed once per request, see Cached.
+type cache struct {
+	mu     sync.Mutex
+	values map[any]any
+}
+
+// WithCache gives ctx a cache that lives as long as the request it belongs to.
+func WithCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheKey, &cache{values: make(map[any]any)})
+}
+
+// Cached returns the value stored under key in the cache of ctx, or calls load and stores what it
+// returns. Errors are not stored. Without a cache, as in background jobs, load is called every time.
+func Cached[T any](ctx context.Context, key any, load func() (T, error)) (T, error) {
+	c, err := getValue[*cache](ctx, cacheKey)
+	if err != nil {
+		return load()
+	}
+
+	c.mu.Lock()
+	value, ok := c.values[key].(T)
+	c.mu.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err = load()
+	if err != nil {
+		return value, err
+	}
+	c.mu.Lock()
+	c.values[key] = value
+	c.mu.Unlock()
+
+	return value, nil
+}
+
+// ClearCache drops the values cached for the request, for when it changes what they were computed from.
+func ClearCache(ctx context.Context) {
+	c, err := getValue[*cache](ctx, cacheKey)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	clear(c.values)
+	c.mu.Unlock()
+}
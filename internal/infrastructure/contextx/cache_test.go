@@ -0,0 +1,57 @@
+package contextx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCached(t *testing.T) {
+	t.Parallel()
+
+	var loads int
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	t.Run("without cache every call loads", func(t *testing.T) {
+		loads = 0
+		ctx := context.Background()
+		_, _ = contextx.Cached(ctx, "key", load)
+		got, err := contextx.Cached(ctx, "key", load)
+		require.NoError(t, err)
+		require.Equal(t, 2, got)
+	})
+
+	t.Run("with cache the first value is kept until cleared", func(t *testing.T) {
+		loads = 0
+		ctx := contextx.WithCache(context.Background())
+		_, _ = contextx.Cached(ctx, "key", load)
+		got, err := contextx.Cached(ctx, "key", load)
+		require.NoError(t, err)
+		require.Equal(t, 1, got)
+
+		got, err = contextx.Cached(ctx, "other", load)
+		require.NoError(t, err)
+		require.Equal(t, 2, got)
+
+		contextx.ClearCache(ctx)
+		got, err = contextx.Cached(ctx, "key", load)
+		require.NoError(t, err)
+		require.Equal(t, 3, got)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		ctx := contextx.WithCache(context.Background())
+		errExp := errors.New("expected")
+		_, err := contextx.Cached(ctx, "key", func() (int, error) { return 0, errExp })
+		require.ErrorIs(t, err, errExp)
+		got, err := contextx.Cached(ctx, "key", func() (int, error) { return 7, nil })
+		require.NoError(t, err)
+		require.Equal(t, 7, got)
+	})
+}
@@ -18,10 +18,17 @@ func (key contextKey) String() string {
 }
 
 const (
-	userIDKey    = contextKey("user_id")
-	SessionIDKey = contextKey("session_id")
+	userIDKey      = contextKey("user_id")
+	SessionIDKey   = contextKey("session_id")
+	workspaceIDKey = contextKey("workspace_id")
+	actorIDKey     = contextKey("actor_id")
+	requestIDKey   = contextKey("request_id")
 )
 
+// DefaultWorkspaceID is the workspace created by the migrations. Data created outside a request
+// belongs to it, and it is the one served when a request names no workspace.
+var DefaultWorkspaceID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
 func getValue[T any](ctx context.Context, key contextKey) (T, error) {
 	var zero T
 
@@ -62,6 +69,47 @@ func GetSessionID(ctx context.Context) (uuid.UUID, error) {
 	return sessionID, nil
 }
 
+// GetActorID returns the admin acting on behalf of the current user. Only impersonated requests have one.
+func GetActorID(ctx context.Context) (uuid.UUID, error) {
+	actorID, err := getValue[uuid.UUID](ctx, actorIDKey)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("contextx.GetActorID: %w", err)
+	}
+
+	return actorID, nil
+}
+
+// GetWorkspaceID returns the workspace resolved for the request. Background jobs and CLIs
+// run without one.
+func GetWorkspaceID(ctx context.Context) (uuid.UUID, error) {
+	workspaceID, err := getValue[uuid.UUID](ctx, workspaceIDKey)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("contextx.GetWorkspaceID: %w", err)
+	}
+
+	return workspaceID, nil
+}
+
+// WorkspaceID returns the workspace of the request, or DefaultWorkspaceID outside a request.
+func WorkspaceID(ctx context.Context) uuid.UUID {
+	workspaceID, err := GetWorkspaceID(ctx)
+	if err != nil {
+		return DefaultWorkspaceID
+	}
+
+	return workspaceID
+}
+
+// RequestID returns the ID of the request the context belongs to, or "" outside a request.
+func RequestID(ctx context.Context) string {
+	requestID, err := getValue[string](ctx, requestIDKey)
+	if err != nil {
+		return ""
+	}
+
+	return requestID
+}
+
 func SetUserID(ctx context.Context, userID uuid.UUID) context.Context {
 	return context.WithValue(ctx, userIDKey, userID)
 }
@@ -69,3 +117,15 @@ func SetUserID(ctx context.Context, userID uuid.UUID) context.Context {
 func SetSessionID(ctx context.Context, sessionID uuid.UUID) context.Context {
 	return context.WithValue(ctx, SessionIDKey, sessionID)
 }
+
+func SetActorID(ctx context.Context, actorID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorIDKey, actorID)
+}
+
+func SetWorkspaceID(ctx context.Context, workspaceID uuid.UUID) context.Context {
+	return context.WithValue(ctx, workspaceIDKey, workspaceID)
+}
+
+func SetRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
@@ -100,7 +100,7 @@ func StartPostgres() (td *TestDB, cleanup func()) {
 	return td, cleanup
 }
 
-func (td *TestDB) CreateIsolatedDB(t *testing.T) (*gorm.DB, *sql.DB, func()) {
+func (td *TestDB) CreateIsolatedDB(t testing.TB) (*gorm.DB, *sql.DB, func()) {
 	t.Helper()
 
 	admin, err := sql.Open("pgx", td.adminDSN(defaultDB))
@@ -157,7 +157,7 @@ func (td *TestDB) sqlDSN(dbname string) string {
 
 // --- goose ---
 
-func runGooseUp(t *testing.T, sdb *sql.DB) {
+func runGooseUp(t testing.TB, sdb *sql.DB) {
 	t.Helper()
 
 	migrationsDir := findMigrationsDir()
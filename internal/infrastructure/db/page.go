@@ -0,0 +1,93 @@
+package db
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"gorm.io/gorm"
+)
+
+const FieldCursor apperr.Field = "cursor"
+
+// cursorSeparator joins the values of a cursor; it cannot appear in the text values pages are sorted by.
+const cursorSeparator = "\x00"
+
+func ErrInvalidCursor() error {
+	return apperr.New("cursor is malformed", apperr.CodeBadRequest, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldCursor, Rule: apperr.RuleInvalidFormat})
+}
+
+// EncodeCursor makes an opaque cursor for clients of the keyset values of the last row of a page.
+func EncodeCursor(values ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(values, cursorSeparator)))
+}
+
+// DecodeCursor returns the n values of a cursor made by EncodeCursor.
+func DecodeCursor(cursor string, n int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("db.DecodeCursor: %w", ErrInvalidCursor())
+	}
+	values := strings.Split(string(data), cursorSeparator)
+	if len(values) != n {
+		return nil, fmt.Errorf("db.DecodeCursor: %w", ErrInvalidCursor())
+	}
+
+	return values, nil
+}
+
+// Keyset pages through rows ordered by Columns, the last of which must be unique, by the values of
+// the last row of the previous page. Unlike offsets, rows added or removed meanwhile do not shift
+// the pages.
+type Keyset struct {
+	Columns []string
+	Desc    bool
+}
+
+// Order sorts the rows by the columns of the keyset.
+func (k Keyset) Order() func(*gorm.DB) *gorm.DB {
+	dir := "ASC"
+	if k.Desc {
+		dir = "DESC"
+	}
+	order := make([]string, len(k.Columns))
+	for i, column := range k.Columns {
+		order[i] = column + " " + dir
+	}
+
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Order(strings.Join(order, ", "))
+	}
+}
+
+// After keeps the rows that come after the row with values, one per column.
+func (k Keyset) After(values ...any) func(*gorm.DB) *gorm.DB {
+	cmp := ">"
+	if k.Desc {
+		cmp = "<"
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(k.Columns)), ", ")
+	cond := fmt.Sprintf("(%s) %s (%s)", strings.Join(k.Columns, ", "), cmp, placeholders)
+
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(cond, values...)
+	}
+}
+
+// PageLimit fetches one row more than limit, so TrimPage can tell whether another page follows.
+func PageLimit(limit int) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit + 1)
+	}
+}
+
+// TrimPage cuts rows fetched with PageLimit to limit and reports whether more rows follow.
+func TrimPage[T any](rows []T, limit int) ([]T, bool) {
+	if len(rows) > limit {
+		return rows[:limit], true
+	}
+
+	return rows, false
+}
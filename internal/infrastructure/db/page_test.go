@@ -0,0 +1,33 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := EncodeCursor("1700000000000000", "a b/c")
+	values, err := DecodeCursor(cursor, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1700000000000000", "a b/c"}, values)
+
+	_, err = DecodeCursor(cursor, 3)
+	require.ErrorIs(t, err, ErrInvalidCursor())
+	_, err = DecodeCursor("not base64!", 2)
+	require.ErrorIs(t, err, ErrInvalidCursor())
+}
+
+func TestTrimPage(t *testing.T) {
+	t.Parallel()
+
+	rows, more := TrimPage([]int{1, 2, 3}, 2)
+	require.Equal(t, []int{1, 2}, rows)
+	require.True(t, more)
+
+	rows, more = TrimPage([]int{1, 2}, 2)
+	require.Equal(t, []int{1, 2}, rows)
+	require.False(t, more)
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"expvar"
+	"fmt"
+	"time"
+)
+
+// PoolConfig sizes the connection pool of database/sql. MaxOpenConns 0 leaves the pool unbounded, and
+// then it never counts as saturated.
+type PoolConfig struct {
+	MaxOpenConns           int `mapstructure:"max_open_conns" json:"max_open_conns"`
+	MaxIdleConns           int `mapstructure:"max_idle_conns" json:"max_idle_conns"`
+	ConnMaxLifetimeMinutes int `mapstructure:"conn_max_lifetime_minutes" json:"conn_max_lifetime_minutes"`
+	ConnMaxIdleTimeMinutes int `mapstructure:"conn_max_idle_time_minutes" json:"conn_max_idle_time_minutes"`
+	// SaturationWarnPercent of MaxOpenConns in use, checked every CheckIntervalSeconds, logs a warning.
+	SaturationWarnPercent int `mapstructure:"saturation_warn_percent" json:"saturation_warn_percent"`
+	CheckIntervalSeconds  int `mapstructure:"check_interval_seconds" json:"check_interval_seconds"`
+}
+
+func (c PoolConfig) Validate() error {
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 || c.ConnMaxLifetimeMinutes < 0 || c.ConnMaxIdleTimeMinutes < 0 {
+		return fmt.Errorf("connection limits must not be negative")
+	}
+	if c.MaxOpenConns > 0 && c.MaxIdleConns > c.MaxOpenConns {
+		return fmt.Errorf("max_idle_conns must not exceed max_open_conns")
+	}
+	if c.SaturationWarnPercent < 1 || c.SaturationWarnPercent > 100 {
+		return fmt.Errorf("saturation_warn_percent must be between 1 and 100")
+	}
+	if c.CheckIntervalSeconds <= 0 {
+		return fmt.Errorf("check_interval_seconds must be positive")
+	}
+
+	return nil
+}
+
+func (c PoolConfig) CheckInterval() time.Duration {
+	return time.Duration(c.CheckIntervalSeconds) * time.Second
+}
+
+// Saturated reports whether the connections in use reach SaturationWarnPercent of MaxOpenConns.
+func (c PoolConfig) Saturated(stats PoolStats) bool {
+	return c.MaxOpenConns > 0 && stats.InUse*100 >= c.MaxOpenConns*c.SaturationWarnPercent
+}
+
+// ConfigurePool applies the limits of cfg to the pool; zero values keep the database/sql defaults.
+func ConfigurePool(sqlDB *sql.DB, cfg PoolConfig) {
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetimeMinutes) * time.Minute)
+	sqlDB.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTimeMinutes) * time.Minute)
+}
+
+// PoolStats is the part of sql.DBStats worth watching. WaitCount and WaitDurationMS add up since
+// the pool was opened.
+type PoolStats struct {
+	MaxOpen        int   `json:"max_open"`
+	Open           int   `json:"open"`
+	InUse          int   `json:"in_use"`
+	Idle           int   `json:"idle"`
+	WaitCount      int64 `json:"wait_count"`
+	WaitDurationMS int64 `json:"wait_duration_ms"`
+}
+
+func ReadPoolStats(sqlDB *sql.DB) PoolStats {
+	s := sqlDB.Stats()
+
+	return PoolStats{
+		MaxOpen:        s.MaxOpenConnections,
+		Open:           s.OpenConnections,
+		InUse:          s.InUse,
+		Idle:           s.Idle,
+		WaitCount:      s.WaitCount,
+		WaitDurationMS: s.WaitDuration.Milliseconds(),
+	}
+}
+
+// PublishPoolStats adds the stats of the pool to the expvar metrics as "db_pool". It panics when
+// called twice.
+func PublishPoolStats(sqlDB *sql.DB) {
+	expvar.Publish("db_pool", expvar.Func(func() any { return ReadPoolStats(sqlDB) }))
+}
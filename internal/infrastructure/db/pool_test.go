@@ -0,0 +1,53 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := PoolConfig{MaxOpenConns: 25, MaxIdleConns: 10, SaturationWarnPercent: 80, CheckIntervalSeconds: 30}
+	tests := []struct {
+		name    string
+		modify  func(c *PoolConfig)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*PoolConfig) {}},
+		{name: "unbounded", modify: func(c *PoolConfig) { c.MaxOpenConns = 0 }},
+		{name: "negative limit", modify: func(c *PoolConfig) { c.ConnMaxLifetimeMinutes = -1 }, wantErr: true},
+		{name: "more idle than open", modify: func(c *PoolConfig) { c.MaxIdleConns = 26 }, wantErr: true},
+		{name: "zero percent", modify: func(c *PoolConfig) { c.SaturationWarnPercent = 0 }, wantErr: true},
+		{name: "percent over 100", modify: func(c *PoolConfig) { c.SaturationWarnPercent = 101 }, wantErr: true},
+		{name: "no interval", modify: func(c *PoolConfig) { c.CheckIntervalSeconds = 0 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := valid
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPoolConfig_Saturated(t *testing.T) {
+	t.Parallel()
+
+	cfg := PoolConfig{MaxOpenConns: 10, SaturationWarnPercent: 80}
+	require.False(t, cfg.Saturated(PoolStats{InUse: 7}))
+	require.True(t, cfg.Saturated(PoolStats{InUse: 8}))
+	require.True(t, cfg.Saturated(PoolStats{InUse: 10}))
+
+	cfg.MaxOpenConns = 0
+	require.False(t, cfg.Saturated(PoolStats{InUse: 100}))
+}
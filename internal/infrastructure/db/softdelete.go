@@ -0,0 +1,31 @@
+package db
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ActiveCond is the condition excluding soft-deleted rows by their deleted_at column. It can be passed
+// to Where, Joins or as an argument of a raw query.
+func ActiveCond(column string) clause.Expr {
+	return gorm.Expr(column + " IS NULL")
+}
+
+// ActiveOnly is the ActiveCond scope. Models embedding Base are filtered by gorm already; queries built
+// with Table, which gorm cannot filter, must use it.
+func ActiveOnly(column string) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(ActiveCond(column))
+	}
+}
+
+// WithDeleted lifts the soft-delete filter of the queried model when include is set, so callers opt
+// in to deleted rows explicitly.
+func WithDeleted(include bool) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if include {
+			return tx.Unscoped()
+		}
+		return tx
+	}
+}
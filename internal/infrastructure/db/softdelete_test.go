@@ -0,0 +1,46 @@
+//go:build testutil
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type softDeleteModel struct {
+	ID int
+	Base
+}
+
+func (softDeleteModel) TableName() string { return "soft_delete_test" }
+
+func TestSoftDeleteScopes(t *testing.T) {
+	t.Parallel()
+	gdb, _, _ := shared.CreateIsolatedDB(t)
+
+	require.NoError(t, gdb.Exec(`CREATE TABLE soft_delete_test (
+		id INT PRIMARY KEY, created_at TIMESTAMPTZ, updated_at TIMESTAMPTZ, deleted_at TIMESTAMPTZ)`).Error)
+	require.NoError(t, gdb.Create(&[]softDeleteModel{{ID: 1}, {ID: 2}}).Error)
+	require.NoError(t, gdb.Delete(&softDeleteModel{ID: 2}).Error)
+
+	ids := func(q *gorm.DB) []int {
+		var ids []int
+		require.NoError(t, q.Order("id").Pluck("id", &ids).Error)
+		return ids
+	}
+
+	// models are filtered by gorm unless deleted rows are asked for
+	require.Equal(t, []int{1}, ids(gdb.Model(&softDeleteModel{})))
+	require.Equal(t, []int{1}, ids(gdb.Model(&softDeleteModel{}).Scopes(WithDeleted(false))))
+	require.Equal(t, []int{1, 2}, ids(gdb.Model(&softDeleteModel{}).Scopes(WithDeleted(true))))
+
+	// tables are not
+	require.Equal(t, []int{1, 2}, ids(gdb.Table("soft_delete_test")))
+	require.Equal(t, []int{1}, ids(gdb.Table("soft_delete_test t").Scopes(ActiveOnly("t.deleted_at"))))
+
+	var raw []int
+	require.NoError(t, gdb.Raw("SELECT id FROM soft_delete_test WHERE ?", ActiveCond("deleted_at")).Scan(&raw).Error)
+	require.Equal(t, []int{1}, raw)
+}
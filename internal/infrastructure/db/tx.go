@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// TxManager runs use case steps of several repositories in one transaction. The transaction travels
+// in the context; repositories pick it up with Conn.
+type TxManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) (*TxManager, error) {
+	if db == nil {
+		return nil, fmt.Errorf("db.NewTxManager: db is nil")
+	}
+	return &TxManager{db: db}, nil
+}
+
+// Do runs fn in a transaction that is committed when fn returns nil. Inside another Do, fn joins
+// the outer transaction.
+func (m *TxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction started by TxManager.Do for ctx, or db when there is none.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,63 @@
+//go:build testutil
+
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var shared *TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func TestTxManager(t *testing.T) {
+	t.Parallel()
+	gdb, _, _ := shared.CreateIsolatedDB(t)
+
+	_, err := NewTxManager(nil)
+	require.Error(t, err)
+	tm, err := NewTxManager(gdb)
+	require.NoError(t, err)
+
+	require.NoError(t, gdb.Exec("CREATE TABLE tx_test (n INT)").Error)
+	count := func() int64 {
+		var n int64
+		require.NoError(t, gdb.Table("tx_test").Count(&n).Error)
+		return n
+	}
+	insert := func(ctx context.Context) error {
+		return Conn(ctx, gdb).Exec("INSERT INTO tx_test VALUES (1)").Error
+	}
+
+	// a failing step rolls back the steps before it, nested Do included
+	expErr := errors.New("fail")
+	err = tm.Do(t.Context(), func(ctx context.Context) error {
+		require.NoError(t, insert(ctx))
+		require.NoError(t, tm.Do(ctx, insert))
+		return expErr
+	})
+	require.ErrorIs(t, err, expErr)
+	require.Zero(t, count())
+
+	err = tm.Do(t.Context(), func(ctx context.Context) error {
+		require.NoError(t, insert(ctx))
+		return tm.Do(ctx, insert)
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count())
+
+	// outside Do every statement commits on its own
+	require.NoError(t, insert(t.Context()))
+	require.Equal(t, int64(3), count())
+}
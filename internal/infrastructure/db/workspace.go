@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WorkspaceCond is the condition restricting column to the workspace of the request. Without one,
+// as in background jobs that sweep every workspace, it matches every row.
+// It can be passed to Where or as an argument of a raw query.
+func WorkspaceCond(ctx context.Context, column string) clause.Expr {
+	workspaceID, err := contextx.GetWorkspaceID(ctx)
+	if err != nil {
+		return gorm.Expr("TRUE")
+	}
+
+	return gorm.Expr(column+" = ?", workspaceID)
+}
+
+// InWorkspace is the WorkspaceCond scope for the workspace_id column of the queried model.
+func InWorkspace(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(WorkspaceCond(ctx, "workspace_id"))
+	}
+}
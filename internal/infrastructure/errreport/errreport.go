@@ -0,0 +1,95 @@
+package errreport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/buildinfo"
+)
+
+// Reporter forwards errors to an error tracker. Report must not block the caller; stack may be nil.
+type Reporter interface {
+	Report(ctx context.Context, err error, stack []byte)
+	// Close sends what is still queued, giving up when ctx is done.
+	Close(ctx context.Context) error
+}
+
+type Config struct {
+	// SentryDSN is the DSN of a Sentry project, or of a compatible tracker; empty disables reporting.
+	SentryDSN   string `mapstructure:"sentry_dsn" json:"-"`
+	Environment string `mapstructure:"environment" json:"environment"`
+	// Release defaults to the build version, or the VCS revision of a development build.
+	Release        string `mapstructure:"release" json:"release"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+}
+
+func (c Config) Validate() error {
+	if c.SentryDSN == "" {
+		return nil
+	}
+	if _, err := parseDSN(c.SentryDSN); err != nil {
+		return fmt.Errorf("sentry_dsn: %w", err)
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+
+	return nil
+}
+
+// Version returns Release, or the release of the build when it is empty.
+func (c Config) Version() string {
+	if c.Release != "" {
+		return c.Release
+	}
+
+	return buildinfo.Get("").Release()
+}
+
+// dsn is a parsed https://<key>@<host>[/<path>]/<project_id> DSN.
+type dsn struct {
+	key      string
+	endpoint string
+}
+
+func parseDSN(raw string) (dsn, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return dsn{}, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return dsn{}, fmt.Errorf("must be http(s)://<key>@<host>/<project_id>")
+	}
+	i := strings.LastIndex(u.Path, "/")
+	dir, project := u.Path[:max(i, 0)], u.Path[i+1:]
+	if project == "" {
+		return dsn{}, fmt.Errorf("project ID is missing")
+	}
+	endpoint := url.URL{Scheme: u.Scheme, Host: u.Host, Path: dir + "/api/" + project + "/envelope/"}
+
+	return dsn{key: u.User.Username(), endpoint: endpoint.String()}, nil
+}
+
+// New returns the reporter the config chooses: Sentry, or Noop without a DSN.
+func New(cfg Config, client *http.Client, timeGen TimeGenerator) (Reporter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("errreport.New: %w", err)
+	}
+	if cfg.SentryDSN == "" {
+		return Noop{}, nil
+	}
+
+	return NewSentry(cfg, client, timeGen)
+}
+
+// Noop drops every report.
+type Noop struct{}
+
+func (Noop) Report(context.Context, error, []byte) {}
+
+func (Noop) Close(context.Context) error {
+	return nil
+}
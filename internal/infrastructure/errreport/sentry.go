@@ -0,0 +1,204 @@
+package errreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// sentryQueueSize bounds the reports waiting to be sent; more are dropped rather than slowing requests.
+const sentryQueueSize = 100
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// Sentry sends reports as events to the envelope endpoint of a Sentry project, one at a time in the
+// background.
+type Sentry struct {
+	dsn         dsn
+	release     string
+	environment string
+	client      *http.Client
+	timeGen     TimeGenerator
+	queue       chan []byte
+	done        chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewSentry(cfg Config, client *http.Client, timeGen TimeGenerator) (*Sentry, error) {
+	if client == nil || timeGen == nil {
+		return nil, fmt.Errorf("errreport.NewSentry: client and timeGen are required")
+	}
+	d, err := parseDSN(cfg.SentryDSN)
+	if err != nil {
+		return nil, fmt.Errorf("errreport.NewSentry: %w", err)
+	}
+	s := &Sentry{
+		dsn:         d,
+		release:     cfg.Version(),
+		environment: cfg.Environment,
+		client:      client,
+		timeGen:     timeGen,
+		queue:       make(chan []byte, sentryQueueSize),
+		done:        make(chan struct{}),
+	}
+	go s.run()
+
+	return s, nil
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Exception   sentryExceptions  `json:"exception"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        *sentryUser       `json:"user,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+}
+
+type sentryExceptions struct {
+	Values []sentryException `json:"values"`
+}
+
+// sentryException is typed by the apperr code, so errors group by what went wrong rather than by Go type.
+type sentryException struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sentryUser struct {
+	ID string `json:"id"`
+}
+
+// Report queues err with the request, user and workspace of ctx as tags. Reports are dropped when the
+// tracker cannot keep up, and after Close.
+func (s *Sentry) Report(ctx context.Context, reportErr error, stack []byte) {
+	body, err := s.envelope(s.event(ctx, reportErr, stack))
+	if err != nil {
+		log.Error().Err(err).Msg("errreport.Sentry.Report")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- body:
+	default:
+		log.Warn().Msg("errreport.Sentry.Report: queue full, report dropped")
+	}
+}
+
+func (s *Sentry) event(ctx context.Context, err error, stack []byte) sentryEvent {
+	event := sentryEvent{
+		EventID:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Timestamp:   s.timeGen.Now().UTC(),
+		Platform:    "go",
+		Level:       "error",
+		Release:     s.release,
+		Environment: s.environment,
+		Exception: sentryExceptions{Values: []sentryException{{
+			Type:  string(apperr.FromError(err).Code),
+			Value: err.Error(),
+		}}},
+		Tags:  map[string]string{"workspace_id": contextx.WorkspaceID(ctx).String()},
+		Extra: map[string]string{},
+	}
+	if requestID := contextx.RequestID(ctx); requestID != "" {
+		event.Tags["request_id"] = requestID
+	}
+	if userID, err := contextx.GetUserID(ctx); err == nil {
+		event.User = &sentryUser{ID: userID.String()}
+	}
+	if actorID, err := contextx.GetActorID(ctx); err == nil {
+		event.Extra["actor_user_id"] = actorID.String()
+	}
+	if len(stack) > 0 {
+		event.Extra["stack"] = string(stack)
+	}
+
+	return event
+}
+
+// envelope wraps the event in the envelope format: a header line, an item header line and the item.
+func (s *Sentry) envelope(event sentryEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, v := range []any{
+		map[string]string{"event_id": event.EventID, "sent_at": event.Timestamp.Format(time.RFC3339)},
+		map[string]string{"type": "event"},
+		event,
+	} {
+		if err := enc.Encode(v); err != nil {
+			return nil, fmt.Errorf("errreport.Sentry.envelope: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Sentry) run() {
+	defer close(s.done)
+	for body := range s.queue {
+		if err := s.send(body); err != nil {
+			log.Warn().Err(err).Msg("errreport.Sentry.run: report not sent")
+		}
+	}
+}
+
+func (s *Sentry) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.dsn.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("errreport.Sentry.send: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=easygodocs/%s, sentry_key=%s", s.release, s.dsn.key))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("errreport.Sentry.send: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("errreport.Sentry.send: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Close stops accepting reports and waits for the queued ones to be sent.
+func (s *Sentry) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("errreport.Sentry.Close: %w", ctx.Err())
+	}
+}
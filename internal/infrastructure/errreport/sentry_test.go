@@ -0,0 +1,121 @@
+package errreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedTime time.Time
+
+func (t fixedTime) Now() time.Time { return time.Time(t) }
+
+func TestParseDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    dsn
+		wantErr bool
+	}{
+		{name: "plain", raw: "https://key@o1.ingest.sentry.io/42", want: dsn{key: "key", endpoint: "https://o1.ingest.sentry.io/api/42/envelope/"}},
+		{name: "path prefix", raw: "http://key@tracker:9000/sentry/7", want: dsn{key: "key", endpoint: "http://tracker:9000/sentry/api/7/envelope/"}},
+		{name: "no key", raw: "https://o1.ingest.sentry.io/42", wantErr: true},
+		{name: "no project", raw: "https://key@o1.ingest.sentry.io/", wantErr: true},
+		{name: "not http", raw: "ftp://key@host/1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDSN(tt.raw)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSentry(t *testing.T) {
+	t.Parallel()
+
+	type received struct {
+		auth  string
+		lines []string
+	}
+	got := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/42/envelope/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var lines []string
+		sc := bufio.NewScanner(r.Body)
+		sc.Buffer(nil, 1<<20)
+		for sc.Scan() {
+			lines = append(lines, sc.Text())
+		}
+		got <- received{auth: r.Header.Get("X-Sentry-Auth"), lines: lines}
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := Config{
+		SentryDSN:      strings.Replace(srv.URL, "://", "://key@", 1) + "/42",
+		Environment:    "test",
+		Release:        "v1.2.3",
+		TimeoutSeconds: 5,
+	}
+	s, err := NewSentry(cfg, srv.Client(), fixedTime(now))
+	require.NoError(t, err)
+
+	userID, actorID, workspaceID := uuid.New(), uuid.New(), uuid.New()
+	ctx := contextx.SetRequestID(t.Context(), "req-1")
+	ctx = contextx.SetUserID(ctx, userID)
+	ctx = contextx.SetActorID(ctx, actorID)
+	ctx = contextx.SetWorkspaceID(ctx, workspaceID)
+	s.Report(ctx, fmt.Errorf("user.service.Get: %w", errors.New("db down")), []byte("goroutine 1"))
+	require.NoError(t, s.Close(t.Context()))
+	s.Report(ctx, errors.New("after close"), nil)
+
+	r := <-got
+	require.Equal(t, "Sentry sentry_version=7, sentry_client=easygodocs/v1.2.3, sentry_key=key", r.auth)
+	require.Len(t, r.lines, 3)
+	require.JSONEq(t, `{"type":"event"}`, r.lines[1])
+	var event sentryEvent
+	require.NoError(t, json.Unmarshal([]byte(r.lines[2]), &event))
+	require.Len(t, event.EventID, 32)
+	require.Contains(t, r.lines[0], event.EventID)
+	require.Equal(t, sentryEvent{
+		EventID:     event.EventID,
+		Timestamp:   now,
+		Platform:    "go",
+		Level:       "error",
+		Release:     "v1.2.3",
+		Environment: "test",
+		Exception: sentryExceptions{Values: []sentryException{{
+			Type:  string(apperr.CodeInternal),
+			Value: "user.service.Get: db down",
+		}}},
+		Tags:  map[string]string{"request_id": "req-1", "workspace_id": workspaceID.String()},
+		User:  &sentryUser{ID: userID.String()},
+		Extra: map[string]string{"actor_user_id": actorID.String(), "stack": "goroutine 1"},
+	}, event)
+	require.Empty(t, got)
+}
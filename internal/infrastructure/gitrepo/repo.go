@@ -0,0 +1,146 @@
+package gitrepo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Config is a working copy of one branch of a remote. Remote may carry credentials, so it is not
+// shown; prefer SSH keys or a credential helper of the git installation.
+type Config struct {
+	Dir            string `mapstructure:"dir" json:"dir"`
+	Remote         string `mapstructure:"remote" json:"-"`
+	Branch         string `mapstructure:"branch" json:"branch"`
+	CommitterName  string `mapstructure:"committer_name" json:"committer_name"`
+	CommitterEmail string `mapstructure:"committer_email" json:"committer_email"`
+}
+
+func (c Config) Validate() error {
+	if c.Dir == "" {
+		return fmt.Errorf("dir is required")
+	}
+	if c.Remote == "" {
+		return fmt.Errorf("remote is required")
+	}
+	if c.Branch == "" {
+		return fmt.Errorf("branch is required")
+	}
+	if c.CommitterName == "" || c.CommitterEmail == "" {
+		return fmt.Errorf("committer_name and committer_email are required")
+	}
+
+	return nil
+}
+
+// repo drives the git binary, which must be on PATH.
+type repo struct {
+	cfg Config
+}
+
+func NewRepo(cfg Config) (*repo, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("gitrepo.NewRepo: %w", err)
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, fmt.Errorf("gitrepo.NewRepo: %w", err)
+	}
+
+	return &repo{cfg: cfg}, nil
+}
+
+func (r *repo) Dir() string {
+	return r.cfg.Dir
+}
+
+// Pull makes the working copy match the remote branch, dropping local changes and commits, and
+// returns its head. The working copy is created on first use. While nothing was pushed to the branch
+// yet, the working copy is left as is and the head is empty.
+func (r *repo) Pull(ctx context.Context) (string, error) {
+	if _, err := os.Stat(filepath.Join(r.cfg.Dir, ".git")); errors.Is(err, os.ErrNotExist) {
+		if err = os.MkdirAll(r.cfg.Dir, 0o750); err != nil {
+			return "", fmt.Errorf("gitrepo.Pull: %w", err)
+		}
+		if _, err = r.git(ctx, "init", "--quiet", "--initial-branch", r.cfg.Branch); err != nil {
+			return "", fmt.Errorf("gitrepo.Pull: %w", err)
+		}
+		if _, err = r.git(ctx, "remote", "add", "origin", r.cfg.Remote); err != nil {
+			return "", fmt.Errorf("gitrepo.Pull: %w", err)
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("gitrepo.Pull: %w", err)
+	}
+
+	if _, err := r.git(ctx, "fetch", "--quiet", "--prune", "origin"); err != nil {
+		return "", fmt.Errorf("gitrepo.Pull: %w", err)
+	}
+	remoteRef := "refs/remotes/origin/" + r.cfg.Branch
+	head, err := r.git(ctx, "rev-parse", "--verify", "--quiet", remoteRef)
+	if err != nil {
+		// nothing was pushed to the branch yet
+		return "", nil
+	}
+	if _, err = r.git(ctx, "checkout", "--quiet", "--force", "-B", r.cfg.Branch, remoteRef); err != nil {
+		return "", fmt.Errorf("gitrepo.Pull: %w", err)
+	}
+	if _, err = r.git(ctx, "clean", "--quiet", "-fd"); err != nil {
+		return "", fmt.Errorf("gitrepo.Pull: %w", err)
+	}
+
+	return head, nil
+}
+
+// CommitAll commits every change of the working copy and returns the new head, or an empty string
+// when there was nothing to commit.
+func (r *repo) CommitAll(ctx context.Context, message string) (string, error) {
+	if _, err := r.git(ctx, "add", "--all"); err != nil {
+		return "", fmt.Errorf("gitrepo.CommitAll: %w", err)
+	}
+	status, err := r.git(ctx, "status", "--porcelain")
+	if err != nil {
+		return "", fmt.Errorf("gitrepo.CommitAll: %w", err)
+	}
+	if status == "" {
+		return "", nil
+	}
+	_, err = r.git(ctx,
+		"-c", "user.name="+r.cfg.CommitterName, "-c", "user.email="+r.cfg.CommitterEmail,
+		"commit", "--quiet", "--no-verify", "--message", message)
+	if err != nil {
+		return "", fmt.Errorf("gitrepo.CommitAll: %w", err)
+	}
+	head, err := r.git(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("gitrepo.CommitAll: %w", err)
+	}
+
+	return head, nil
+}
+
+// Push updates the remote branch. It fails if the branch moved since Pull; the next Pull picks up
+// the remote changes instead.
+func (r *repo) Push(ctx context.Context) error {
+	if _, err := r.git(ctx, "push", "--quiet", "origin", "HEAD:refs/heads/"+r.cfg.Branch); err != nil {
+		return fmt.Errorf("gitrepo.Push: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) git(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", r.cfg.Dir}, args...)...)
+	// never wait for credentials on a terminal the server does not have
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,76 @@
+package gitrepo_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/gitrepo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepo(t *testing.T) {
+	t.Parallel()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	_, err := gitrepo.NewRepo(gitrepo.Config{})
+	require.Error(t, err)
+
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	require.NoError(t, exec.Command("git", "init", "--quiet", "--bare", remote).Run())
+	cfg := func(dir string) gitrepo.Config {
+		return gitrepo.Config{Dir: dir, Remote: remote, Branch: "main", CommitterName: "Sync", CommitterEmail: "sync@example.com"}
+	}
+	ctx := t.Context()
+
+	// the first working copy starts from the empty remote
+	a, err := gitrepo.NewRepo(cfg(filepath.Join(t.TempDir(), "a")))
+	require.NoError(t, err)
+	head, err := a.Pull(ctx)
+	require.NoError(t, err)
+	require.Empty(t, head)
+	head, err = a.CommitAll(ctx, "nothing")
+	require.NoError(t, err)
+	require.Empty(t, head)
+
+	require.NoError(t, os.WriteFile(filepath.Join(a.Dir(), "doc.md"), []byte("one"), 0o600))
+	first, err := a.CommitAll(ctx, "add doc")
+	require.NoError(t, err)
+	require.NotEmpty(t, first)
+	require.NoError(t, a.Push(ctx))
+
+	// a second working copy sees the commit and pushes on top of it
+	b, err := gitrepo.NewRepo(cfg(filepath.Join(t.TempDir(), "b")))
+	require.NoError(t, err)
+	head, err = b.Pull(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first, head)
+	require.NoError(t, os.WriteFile(filepath.Join(b.Dir(), "doc.md"), []byte("two"), 0o600))
+	second, err := b.CommitAll(ctx, "edit doc")
+	require.NoError(t, err)
+	require.NoError(t, b.Push(ctx))
+
+	// local changes of the first copy are dropped by Pull, which catches up with the remote
+	require.NoError(t, os.WriteFile(filepath.Join(a.Dir(), "doc.md"), []byte("local"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(a.Dir(), "new.md"), []byte("local"), 0o600))
+	head, err = a.Pull(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second, head)
+	data, err := os.ReadFile(filepath.Join(a.Dir(), "doc.md"))
+	require.NoError(t, err)
+	require.Equal(t, "two", string(data))
+	require.NoFileExists(t, filepath.Join(a.Dir(), "new.md"))
+
+	// a push behind the remote is rejected
+	require.NoError(t, os.WriteFile(filepath.Join(b.Dir(), "doc.md"), []byte("three"), 0o600))
+	_, err = b.CommitAll(ctx, "edit again")
+	require.NoError(t, err)
+	require.NoError(t, b.Push(ctx))
+	require.NoError(t, os.WriteFile(filepath.Join(a.Dir(), "doc.md"), []byte("stale"), 0o600))
+	_, err = a.CommitAll(ctx, "stale edit")
+	require.NoError(t, err)
+	require.Error(t, a.Push(ctx))
+}
@@ -6,43 +6,59 @@ import (
 	"net/http"
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
 	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
 )
 
+const ProblemContentType = "application/problem+json"
+
+// ReturnError writes err as an RFC 7807 problem in the negotiated language.
+// The request ID ties the response to the server logs.
 func ReturnError(ctx context.Context, w http.ResponseWriter, returningErr error) {
-	appError := apperr.FromError(returningErr)
-	code := toHTTPCode(apperr.ClassOf(appError))
-	if code == 0 {
-		logger.Error(ctx, returningErr).Int("error_code", code).Msg("incorrect error code")
-		code = http.StatusInternalServerError
+	problem := apperr.ToProblem(returningErr)
+	i18n.LocalizeProblem(ctx, &problem)
+	problem.RequestID = contextx.RequestID(ctx)
+	if path, ok := ctx.Value(instanceKey{}).(string); ok {
+		problem.Instance = path
 	}
 
-	if w.Header().Get("Content-Type") == "" {
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	}
-	w.WriteHeader(code)
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
 
-	err := json.NewEncoder(w).Encode(appError)
+	err := json.NewEncoder(w).Encode(problem)
 	if err != nil {
 		logger.Error(ctx, err).Str("returning_error", returningErr.Error()).Msg("error encode failed")
 	}
 }
 
-func toHTTPCode(code apperr.Class) int {
-	switch code {
-	case apperr.ClassBadRequest:
-		return http.StatusBadRequest
-	case apperr.ClassNotFound:
-		return http.StatusNotFound
-	case apperr.ClassUnauthorized:
-		return http.StatusUnauthorized
-	case apperr.ClassForbidden:
-		return http.StatusForbidden
-	case apperr.ClassInternal:
-		return http.StatusInternalServerError
-	case apperr.ClassConflict:
-		return http.StatusConflict
-	}
+type instanceKey struct{}
+
+// Instance makes ReturnError report the request path as the problem instance.
+func Instance(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), instanceKey{}, r.URL.Path)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// NotFound and MethodNotAllowed replace the router's plain text responses.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	ReturnError(r.Context(), w, apperr.ErrNotFound())
+}
+
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	ReturnError(r.Context(), w, apperr.ErrMethodNotAllowed())
+}
 
-	return 0
+// GetProblemTypes godoc
+// @Summary      Error catalog
+// @Description  Lists every problem type the API can return, with its title and HTTP status. Error responses are
+// @Description  application/problem+json objects whose type is one of these URIs.
+// @Tags         meta
+// @Produce      json
+// @Success      200 {array} apperr.ProblemType
+// @Router       /problems [get]
+func GetProblemTypes(w http.ResponseWriter, r *http.Request) {
+	WriteJSON(r.Context(), w, http.StatusOK, apperr.Catalog())
 }
@@ -0,0 +1,157 @@
+package httpx_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReturnError(t *testing.T) {
+	t.Parallel()
+
+	violation := apperr.Violation{Field: "name", Rule: apperr.RuleRequired}
+	described := apperr.Violation{Field: "name", Rule: apperr.RuleRequired, Message: "is required"}
+	r := chi.NewRouter()
+	r.Use(httpx.RequestID)
+	r.Use(httpx.Instance)
+	r.Use(httpx.Language)
+	r.Use(httpx.MaxBodyBytes(8))
+	r.NotFound(httpx.NotFound)
+	r.MethodNotAllowed(httpx.MethodNotAllowed)
+	r.Get("/fail", func(w http.ResponseWriter, r *http.Request) {
+		httpx.ReturnError(r.Context(), w, apperr.ErrBadRequest().WithViolation(violation))
+	})
+	r.Post("/echo", func(w http.ResponseWriter, r *http.Request) {
+		var v map[string]any
+		if err := httpx.DecodeJSON(r, &v); err != nil {
+			httpx.ReturnError(r.Context(), w, err)
+			return
+		}
+		httpx.WriteJSON(r.Context(), w, http.StatusOK, v)
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		lang   string
+		want   apperr.Problem
+	}{
+		{
+			name:   "handler error",
+			method: http.MethodGet,
+			path:   "/fail",
+			want: apperr.Problem{
+				Type:       apperr.TypeURI(apperr.CodeBadRequest),
+				Title:      apperr.BadRequestMsg,
+				Status:     http.StatusBadRequest,
+				Detail:     apperr.BadRequestMsg,
+				Instance:   "/fail",
+				Code:       apperr.CodeBadRequest,
+				Violations: []apperr.Violation{described},
+			},
+		},
+		{
+			name:   "localized",
+			method: http.MethodGet,
+			path:   "/fail",
+			lang:   "ru-RU,ru;q=0.9,en;q=0.8",
+			want: apperr.Problem{
+				Type:     apperr.TypeURI(apperr.CodeBadRequest),
+				Title:    "Некорректный запрос",
+				Status:   http.StatusBadRequest,
+				Detail:   "Некорректный запрос",
+				Instance: "/fail",
+				Code:     apperr.CodeBadRequest,
+				Violations: []apperr.Violation{
+					{Field: "name", Rule: apperr.RuleRequired, Message: "обязательное поле"},
+				},
+			},
+		},
+		{
+			name:   "not found",
+			method: http.MethodGet,
+			path:   "/missing",
+			want: apperr.Problem{
+				Type:     apperr.TypeURI(apperr.CodeNotFound),
+				Title:    apperr.NotFoundMsg,
+				Status:   http.StatusNotFound,
+				Detail:   apperr.NotFoundMsg,
+				Instance: "/missing",
+				Code:     apperr.CodeNotFound,
+			},
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodDelete,
+			path:   "/fail",
+			want: apperr.Problem{
+				Type:     apperr.TypeURI(apperr.CodeMethodNotAllowed),
+				Title:    apperr.MethodNotAllowedMsg,
+				Status:   http.StatusMethodNotAllowed,
+				Detail:   apperr.MethodNotAllowedMsg,
+				Instance: "/fail",
+				Code:     apperr.CodeMethodNotAllowed,
+			},
+		},
+		{
+			name:   "body too large",
+			method: http.MethodPost,
+			path:   "/echo",
+			body:   `{"name":"too long"}`,
+			want: apperr.Problem{
+				Type:     apperr.TypeURI(apperr.CodeTooLarge),
+				Title:    apperr.TooLargeMsg,
+				Status:   http.StatusRequestEntityTooLarge,
+				Detail:   apperr.TooLargeMsg,
+				Instance: "/echo",
+				Code:     apperr.CodeTooLarge,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", tt.lang)
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.want.Status, rr.Code)
+			require.Equal(t, httpx.ProblemContentType, rr.Header().Get("Content-Type"))
+			var got apperr.Problem
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+			require.NotEmpty(t, got.RequestID)
+			got.RequestID = ""
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetProblemTypes(t *testing.T) {
+	t.Parallel()
+
+	rr := httptest.NewRecorder()
+	httpx.GetProblemTypes(rr, httptest.NewRequest(http.MethodGet, "/problems", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []apperr.ProblemType
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Contains(t, got, apperr.ProblemType{
+		Type:   apperr.TypeURI(httpx.CodeIdempotencyKeyReused),
+		Code:   httpx.CodeIdempotencyKeyReused,
+		Title:  "Idempotency-Key reused",
+		Status: http.StatusUnprocessableEntity,
+	})
+}
@@ -47,6 +47,10 @@ func DecodeJSON(r *http.Request, v any) error {
 	dec.UseNumber()
 
 	if err = dec.Decode(v); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return fmt.Errorf("httpx.DecodeJSON: %w", apperr.ErrTooLarge().WithDetail(err.Error()))
+		}
 		return fmt.Errorf("httpx.DecodeJSON: %w",
 			apperr.ErrBadRequest().WithDetail(err.Error()))
 	}
@@ -0,0 +1,21 @@
+package httpx
+
+import (
+	"expvar"
+	"net/http"
+)
+
+// GetMetrics godoc
+// @Summary      Metrics
+// @Description  Returns the expvar metrics of the server: build_info, db_pool, http_panics, and the Go runtime memstats.
+// @Description  Requires admin role in the default workspace.
+// @Tags         meta
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} map[string]any
+// @Failure      401 {object} apperr.Problem
+// @Failure      403 {object} apperr.Problem
+// @Router       /admin/metrics [get]
+func GetMetrics(w http.ResponseWriter, r *http.Request) {
+	expvar.Handler().ServeHTTP(w, r)
+}
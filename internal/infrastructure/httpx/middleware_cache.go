@@ -0,0 +1,15 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+)
+
+// RequestCache gives every request its own cache, so values such as the roles of the caller are
+// loaded once per request however many layers ask for them. See contextx.Cached.
+func RequestCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(contextx.WithCache(r.Context())))
+	})
+}
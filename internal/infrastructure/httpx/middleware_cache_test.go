@@ -0,0 +1,33 @@
+package httpx_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCache(t *testing.T) {
+	t.Parallel()
+
+	var loads int
+	h := httpx.RequestCache(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		for range 3 {
+			_, err := contextx.Cached(r.Context(), "roles", func() (int, error) {
+				loads++
+				return loads, nil
+			})
+			require.NoError(t, err)
+		}
+	}))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, 1, loads)
+
+	// every request gets a new cache
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, 2, loads)
+}
@@ -0,0 +1,200 @@
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+const (
+	CodeIdempotencyKeyReused     apperr.Code = "idempotency/key_reused"
+	CodeIdempotencyKeyInProgress apperr.Code = "idempotency/in_progress"
+
+	FieldIdempotencyKey apperr.Field = "idempotency_key"
+)
+
+func init() {
+	apperr.Register(CodeIdempotencyKeyReused, "Idempotency-Key reused", apperr.ClassUnprocessable)
+	apperr.Register(CodeIdempotencyKeyInProgress, "Request in progress", apperr.ClassConflict)
+}
+
+// IdempotencyRecord is the stored outcome of a request. StatusCode is zero while the request is in progress.
+type IdempotencyRecord struct {
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type IdempotencyStore interface {
+	// Reserve claims key for userID for ttl. If an unexpired record exists, it is returned with reserved false.
+	Reserve(ctx context.Context, userID uuid.UUID, key, requestHash string, ttl time.Duration) (rec IdempotencyRecord, reserved bool, err error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, rec IdempotencyRecord) error
+	Release(ctx context.Context, userID uuid.UUID, key string) error
+}
+
+func ErrIdempotencyKeyTooLong() error {
+	return apperr.New("Idempotency-Key is too long", apperr.CodeBadRequest, apperr.ClassBadRequest, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{
+			Field: FieldIdempotencyKey, Rule: apperr.RuleTooLong,
+			Params: map[string]any{"max": maxIdempotencyKeyLength},
+		})
+}
+
+func ErrIdempotencyKeyReused() error {
+	return apperr.New("Idempotency-Key was already used for a different request", CodeIdempotencyKeyReused,
+		apperr.ClassUnprocessable, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldIdempotencyKey, Rule: apperr.RuleMismatch})
+}
+
+func ErrIdempotencyKeyInProgress() error {
+	return apperr.New("A request with this Idempotency-Key is still in progress", CodeIdempotencyKeyInProgress,
+		apperr.ClassConflict, apperr.LogLevelWarn).
+		WithViolation(apperr.Violation{Field: FieldIdempotencyKey, Rule: apperr.RuleInvalidState})
+}
+
+// Idempotency replays the stored response when an authenticated user retries a request with the same
+// Idempotency-Key, and rejects the key if it comes with a different method, path or body.
+// Requests without the header or a user pass through. Server errors are not stored so that they can be retried.
+// It must run after AuthMiddleware.
+func Idempotency(store IdempotencyStore, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx := r.Context()
+			userID, err := contextx.GetUserID(ctx)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				ReturnError(ctx, w, ErrIdempotencyKeyTooLong())
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				logger.Warn(ctx, err).Msg("httpx.Idempotency: failed to read body")
+				ReturnError(ctx, w, apperr.ErrBadRequest().WithDetail(err.Error()))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := requestHash(r, body)
+
+			rec, reserved, err := store.Reserve(ctx, userID, key, hash, ttl)
+			if err != nil {
+				logger.Error(ctx, err).Str(FieldIdempotencyKey.String(), key).Msg("httpx.Idempotency: Reserve")
+				ReturnError(ctx, w, err)
+				return
+			}
+			if !reserved {
+				switch {
+				case rec.RequestHash != hash:
+					ReturnError(ctx, w, ErrIdempotencyKeyReused())
+				case rec.StatusCode == 0:
+					ReturnError(ctx, w, ErrIdempotencyKeyInProgress())
+				default:
+					if rec.ContentType != "" {
+						w.Header().Set("Content-Type", rec.ContentType)
+					}
+					w.Header().Set(IdempotencyReplayedHeader, "true")
+					w.WriteHeader(rec.StatusCode)
+					_, _ = w.Write(rec.Body)
+				}
+				return
+			}
+
+			rw := &recordingWriter{ResponseWriter: w}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				// the request failed or panicked: free the key so that it can be retried
+				if err := store.Release(context.WithoutCancel(ctx), userID, key); err != nil {
+					logger.Error(ctx, err).Str(FieldIdempotencyKey.String(), key).Msg("httpx.Idempotency: Release")
+				}
+			}()
+
+			next.ServeHTTP(rw, r)
+
+			status := rw.statusCode()
+			if status >= http.StatusInternalServerError {
+				return
+			}
+			err = store.Complete(context.WithoutCancel(ctx), userID, key, IdempotencyRecord{
+				RequestHash: hash,
+				StatusCode:  status,
+				ContentType: rw.Header().Get("Content-Type"),
+				Body:        rw.body.Bytes(),
+			})
+			if err != nil {
+				logger.Error(ctx, err).Str(FieldIdempotencyKey.String(), key).Msg("httpx.Idempotency: Complete")
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingWriter passes the response through and keeps a copy for replays.
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
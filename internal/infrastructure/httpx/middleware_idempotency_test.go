@@ -0,0 +1,199 @@
+package httpx_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+//go:generate minimock -o ./mocks -s _mock.go
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	const (
+		key = "key-1"
+		ttl = time.Hour
+	)
+	userID := uuid.New()
+	stored := httpx.IdempotencyRecord{StatusCode: http.StatusCreated, ContentType: "application/json", Body: []byte(`{"id":"stored"}`)}
+
+	tests := []struct {
+		name       string
+		key        string
+		noUser     bool
+		status     int
+		setup      func(store *mocks.IdempotencyStoreMock)
+		wantStatus int
+		wantBody   string
+		wantCalled bool
+		wantReplay bool
+	}{
+		{
+			name:       "no key passes through",
+			wantStatus: http.StatusCreated,
+			wantBody:   "created",
+			wantCalled: true,
+		},
+		{
+			name:       "no user passes through",
+			key:        key,
+			noUser:     true,
+			wantStatus: http.StatusCreated,
+			wantBody:   "created",
+			wantCalled: true,
+		},
+		{
+			name:       "key too long",
+			key:        strings.Repeat("k", 256),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "first request is stored",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				var hash string
+				store.ReserveMock.Set(func(_ context.Context, uid uuid.UUID, k, requestHash string, d time.Duration) (httpx.IdempotencyRecord, bool, error) {
+					require.Equal(t, userID, uid)
+					require.Equal(t, key, k)
+					require.Equal(t, ttl, d)
+					hash = requestHash
+					return httpx.IdempotencyRecord{RequestHash: requestHash}, true, nil
+				})
+				store.CompleteMock.Set(func(_ context.Context, uid uuid.UUID, k string, rec httpx.IdempotencyRecord) error {
+					require.Equal(t, httpx.IdempotencyRecord{
+						RequestHash: hash, StatusCode: http.StatusCreated, ContentType: "text/plain", Body: []byte("created"),
+					}, rec)
+					return nil
+				})
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   "created",
+			wantCalled: true,
+		},
+		{
+			name:   "server error releases the key",
+			key:    key,
+			status: http.StatusInternalServerError,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				store.ReserveMock.Return(httpx.IdempotencyRecord{}, true, nil)
+				store.ReleaseMock.Expect(minimock.AnyContext, userID, key).Return(nil)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "created",
+			wantCalled: true,
+		},
+		{
+			name: "failed complete releases the key",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				store.ReserveMock.Return(httpx.IdempotencyRecord{}, true, nil)
+				store.CompleteMock.Return(errors.New("db down"))
+				store.ReleaseMock.Expect(minimock.AnyContext, userID, key).Return(nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   "created",
+			wantCalled: true,
+		},
+		{
+			name: "retry replays the stored response",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				store.ReserveMock.Set(func(_ context.Context, _ uuid.UUID, _, requestHash string, _ time.Duration) (httpx.IdempotencyRecord, bool, error) {
+					rec := stored
+					rec.RequestHash = requestHash
+					return rec, false, nil
+				})
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"stored"}`,
+			wantReplay: true,
+		},
+		{
+			name: "key reused with another payload",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				rec := stored
+				rec.RequestHash = "other"
+				store.ReserveMock.Return(rec, false, nil)
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "first request still in progress",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				store.ReserveMock.Set(func(_ context.Context, _ uuid.UUID, _, requestHash string, _ time.Duration) (httpx.IdempotencyRecord, bool, error) {
+					return httpx.IdempotencyRecord{RequestHash: requestHash}, false, nil
+				})
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "store error",
+			key:  key,
+			setup: func(store *mocks.IdempotencyStoreMock) {
+				store.ReserveMock.Return(httpx.IdempotencyRecord{}, false, errors.New("db down"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := mocks.NewIdempotencyStoreMock(t)
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+			status := tt.status
+			if status == 0 {
+				status = http.StatusCreated
+			}
+
+			called := false
+			h := httpx.Idempotency(store, ttl)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, `{"name":"doc"}`, string(body))
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte("created"))
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/entities", strings.NewReader(`{"name":"doc"}`))
+			if tt.key != "" {
+				req.Header.Set(httpx.IdempotencyKeyHeader, tt.key)
+			}
+			if !tt.noUser {
+				req = req.WithContext(contextx.SetUserID(req.Context(), userID))
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Equal(t, tt.wantCalled, called)
+			if tt.wantBody != "" {
+				require.Equal(t, tt.wantBody, rr.Body.String())
+			}
+			if tt.wantReplay {
+				require.Equal(t, "true", rr.Header().Get(httpx.IdempotencyReplayedHeader))
+				require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+			} else {
+				require.Empty(t, rr.Header().Get(httpx.IdempotencyReplayedHeader))
+			}
+		})
+	}
+}
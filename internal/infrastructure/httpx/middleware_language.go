@@ -0,0 +1,18 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
+)
+
+// Language negotiates the response language from Accept-Language and stores it in the context,
+// so that ReturnError can localize problems.
+func Language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tag := i18n.Negotiate(r.Header.Get("Accept-Language"))
+		w.Header().Add("Vary", "Accept-Language")
+		w.Header().Set("Content-Language", tag.String())
+		next.ServeHTTP(w, r.WithContext(i18n.WithLanguage(r.Context(), tag)))
+	})
+}
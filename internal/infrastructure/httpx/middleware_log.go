@@ -5,10 +5,21 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
 	"github.com/go-chi/chi/v5/middleware"
 	zerolog "github.com/rs/zerolog/log"
 )
 
+// ClientIP returns the address of the client without the port. Behind a proxy it relies on
+// middleware.RealIP having rewritten RemoteAddr.
+func ClientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return ip
+}
+
 // Logger is a middleware that injects a zerolog.Logger into the context,
 // and logs the request with method, path, status, and duration.
 func Logger(next http.Handler) http.Handler {
@@ -16,16 +27,9 @@ func Logger(next http.Handler) http.Handler {
 		// Start time
 		start := time.Now()
 		// Extract real IP
-		remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
-		if err != nil {
-			remoteIP = r.RemoteAddr
-		}
-		// Get request ID and IP from context
-		reqID := middleware.GetReqID(r.Context())
-
-		// Create request-scoped logger
+		remoteIP := ClientIP(r)
+		// Create request-scoped logger; the logger package adds the request ID from the context
 		l := zerolog.With().
-			Str("request_id", reqID).
 			Str("remote_ip", remoteIP).
 			Str("method", r.Method).
 			Str("url", r.URL.Path).
@@ -43,6 +47,7 @@ func Logger(next http.Handler) http.Handler {
 
 		// Log request
 		l.Info().
+			Str("request_id", contextx.RequestID(ctx)).
 			Int("status", ww.Status()).
 			Int("bytes", ww.BytesWritten()).
 			Dur("duration", time.Since(start)).
@@ -0,0 +1,93 @@
+package httpx
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+)
+
+// MaintenanceConfig puts the server in read-only mode, e.g. while migrations run.
+type MaintenanceConfig struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+	// RetryAfterSeconds is sent in the Retry-After header of rejected writes.
+	RetryAfterSeconds int `mapstructure:"retry_after_seconds" json:"retry_after_seconds"`
+}
+
+func (c MaintenanceConfig) Validate() error {
+	if c.RetryAfterSeconds <= 0 {
+		return fmt.Errorf("retry_after_seconds must be positive")
+	}
+
+	return nil
+}
+
+// Maintenance holds the current maintenance state, which can be switched while the server runs.
+type Maintenance struct {
+	state  atomic.Pointer[MaintenanceConfig]
+	exempt map[string]struct{}
+}
+
+// NewMaintenance returns the maintenance state with cfg applied. Writes to the exempt paths, such as
+// login or the settings that switch maintenance off again, are let through while it is enabled.
+func NewMaintenance(cfg MaintenanceConfig, exempt ...string) *Maintenance {
+	m := &Maintenance{exempt: make(map[string]struct{}, len(exempt))}
+	for _, path := range exempt {
+		m.exempt[path] = struct{}{}
+	}
+	m.Set(cfg)
+
+	return m
+}
+
+func (m *Maintenance) Set(cfg MaintenanceConfig) {
+	m.state.Store(&cfg)
+}
+
+func (m *Maintenance) State() MaintenanceConfig {
+	return *m.state.Load()
+}
+
+// Middleware rejects requests with mutating methods with 503 and Retry-After while maintenance is enabled.
+// Reads and the exempt paths are served as usual.
+func (m *Maintenance) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := m.State()
+		if !state.Enabled || isSafeMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if _, ok := m.exempt[r.URL.Path]; ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Retry-After", strconv.Itoa(state.RetryAfterSeconds))
+		ReturnError(r.Context(), w, apperr.ErrMaintenance())
+	})
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// Health is the state reported by GET /healthz.
+type Health struct {
+	Status      string            `json:"status"`
+	Maintenance MaintenanceConfig `json:"maintenance"`
+}
+
+// GetHealth reports that the server is up and whether it is in maintenance mode. The status stays 200
+// during maintenance, as reads are still served. It is mounted outside the API, so it is not in the swagger docs.
+func GetHealth(m *Maintenance) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(r.Context(), w, http.StatusOK, Health{Status: "ok", Maintenance: m.State()})
+	}
+}
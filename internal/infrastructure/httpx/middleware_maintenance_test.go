@@ -0,0 +1,91 @@
+package httpx_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenance_Middleware(t *testing.T) {
+	t.Parallel()
+
+	const exempt = "/api/v1/login"
+	on := httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 120}
+
+	tests := []struct {
+		name       string
+		cfg        httpx.MaintenanceConfig
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "disabled: write served", cfg: httpx.MaintenanceConfig{RetryAfterSeconds: 120}, method: http.MethodPost, path: "/api/v1/entities", wantStatus: http.StatusOK},
+		{name: "read served", cfg: on, method: http.MethodGet, path: "/api/v1/entities", wantStatus: http.StatusOK},
+		{name: "head served", cfg: on, method: http.MethodHead, path: "/api/v1/entities", wantStatus: http.StatusOK},
+		{name: "exempt write served", cfg: on, method: http.MethodPost, path: exempt, wantStatus: http.StatusOK},
+		{name: "post rejected", cfg: on, method: http.MethodPost, path: "/api/v1/entities", wantStatus: http.StatusServiceUnavailable},
+		{name: "put rejected", cfg: on, method: http.MethodPut, path: "/api/v1/entities/1", wantStatus: http.StatusServiceUnavailable},
+		{name: "patch rejected", cfg: on, method: http.MethodPatch, path: "/api/v1/entities/1/draft", wantStatus: http.StatusServiceUnavailable},
+		{name: "delete rejected", cfg: on, method: http.MethodDelete, path: "/api/v1/entities/1", wantStatus: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := httpx.NewMaintenance(tt.cfg, exempt)
+			h := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus != http.StatusServiceUnavailable {
+				require.Empty(t, rr.Header().Get("Retry-After"))
+				return
+			}
+			require.Equal(t, "120", rr.Header().Get("Retry-After"))
+			var problem apperr.Problem
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&problem))
+			require.Equal(t, apperr.CodeMaintenance, problem.Code)
+		})
+	}
+}
+
+func TestMaintenance_Set(t *testing.T) {
+	t.Parallel()
+
+	m := httpx.NewMaintenance(httpx.MaintenanceConfig{RetryAfterSeconds: 60})
+	h := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	serve := func() int {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/api/v1/entities/1", nil))
+		return rr.Code
+	}
+
+	require.Equal(t, http.StatusNoContent, serve())
+	m.Set(httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 60})
+	require.Equal(t, http.StatusServiceUnavailable, serve())
+	m.Set(httpx.MaintenanceConfig{RetryAfterSeconds: 60})
+	require.Equal(t, http.StatusNoContent, serve())
+}
+
+func TestGetHealth(t *testing.T) {
+	t.Parallel()
+
+	m := httpx.NewMaintenance(httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 60})
+	rr := httptest.NewRecorder()
+	httpx.GetHealth(m)(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got httpx.Health
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&got))
+	require.Equal(t, httpx.Health{Status: "ok", Maintenance: httpx.MaintenanceConfig{Enabled: true, RetryAfterSeconds: 60}}, got)
+}
@@ -1,6 +1,10 @@
 package httpx
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+)
 
 func MaxBodyBytes(max int64) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -10,7 +14,7 @@ func MaxBodyBytes(max int64) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.ContentLength > max {
 				w.Header().Set("Connection", "close")
-				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				ReturnError(r.Context(), w, apperr.ErrTooLarge())
 				return
 			}
 			r.Body = http.MaxBytesReader(w, r.Body, max)
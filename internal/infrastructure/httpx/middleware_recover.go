@@ -0,0 +1,61 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/logger"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Panics counts the panics recovered from handlers; it is published as the expvar "http_panics".
+var Panics = expvar.NewInt("http_panics")
+
+// PanicAlerter is told about every recovered panic, e.g. to forward it to an error tracker.
+// A Sentry hub fits with a one-line adapter calling RecoverWithContext.
+type PanicAlerter interface {
+	AlertPanic(ctx context.Context, recovered any, stack []byte)
+}
+
+// Recoverer turns a panic in a handler into a 500 problem carrying the request ID. The panic is logged
+// and reported with its stack, counted in Panics and passed to alerter, which may be nil. It has to run
+// after Logger for the log line to carry the request fields. http.ErrAbortHandler is re-panicked, as
+// net/http expects.
+func Recoverer(alerter PanicAlerter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(rec)
+				}
+
+				ctx := r.Context()
+				stack := debug.Stack()
+				// %v, not %w: whatever was panicked with, the client gets an internal error
+				err := fmt.Errorf("panic: %v", rec)
+				Panics.Add(1)
+				logger.Recovered(ctx, err, stack).Msg("httpx.Recoverer")
+				if alerter != nil {
+					alerter.AlertPanic(ctx, rec, stack)
+				}
+
+				// the client already got a status, or the connection was taken over by a websocket
+				if ww.Status() != 0 || r.Header.Get("Connection") == "Upgrade" {
+					return
+				}
+				ReturnError(ctx, ww, err)
+			}()
+
+			next.ServeHTTP(ww, r)
+		})
+	}
+}
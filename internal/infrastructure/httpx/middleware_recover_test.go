@@ -0,0 +1,99 @@
+package httpx_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		noAlerter  bool
+		wantStatus int
+		wantBody   bool
+	}{
+		{
+			name:       "panic before writing",
+			handler:    func(http.ResponseWriter, *http.Request) { panic("boom") },
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   true,
+		},
+		{
+			name:       "app error panic is still internal",
+			handler:    func(http.ResponseWriter, *http.Request) { panic(apperr.ErrNotFound()) },
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   true,
+		},
+		{
+			name: "panic after writing keeps the status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				panic("boom")
+			},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "no alerter",
+			handler:    func(http.ResponseWriter, *http.Request) { panic("boom") },
+			noAlerter:  true,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var alerter httpx.PanicAlerter
+			if !tt.noAlerter {
+				ctrl := minimock.NewController(t)
+				m := mocks.NewPanicAlerterMock(ctrl)
+				m.AlertPanicMock.Set(func(_ context.Context, recovered any, stack []byte) {
+					require.NotNil(t, recovered)
+					require.NotEmpty(t, stack)
+				})
+				alerter = m
+			}
+			h := httpx.RequestID(httpx.Recoverer(alerter)(tt.handler))
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if !tt.wantBody {
+				return
+			}
+			require.Equal(t, httpx.ProblemContentType, rec.Header().Get("Content-Type"))
+			var got apperr.Problem
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			require.Equal(t, apperr.CodeInternal, got.Code)
+			require.Equal(t, rec.Header().Get(httpx.RequestIDHeader), got.RequestID)
+			require.NotEmpty(t, got.RequestID)
+		})
+	}
+
+	t.Run("abort handler is re-panicked", func(t *testing.T) {
+		t.Parallel()
+
+		h := httpx.Recoverer(nil)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		})
+	})
+}
@@ -0,0 +1,28 @@
+package httpx
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern keeps incoming IDs short and printable, so a client cannot forge log lines with them.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:/+=-]{1,128}$`)
+
+// RequestID takes the request ID from the X-Request-ID header, so a trace can span services, or
+// generates one when the header is missing or malformed. The ID is stored in the context for the
+// logs and error responses of every layer, and echoed in the response.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(contextx.SetRequestID(r.Context(), requestID)))
+	})
+}
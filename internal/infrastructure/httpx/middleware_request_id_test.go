@@ -0,0 +1,56 @@
+package httpx_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		want     string
+		generate bool
+	}{
+		{name: "incoming ID is kept", header: "trace-1/span.2", want: "trace-1/span.2"},
+		{name: "missing ID is generated", generate: true},
+		{name: "ID with control characters is replaced", header: "a\r\nb", generate: true},
+		{name: "ID with spaces is replaced", header: "a b", generate: true},
+		{name: "too long ID is replaced", header: strings.Repeat("a", 129), generate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			h := httpx.RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = contextx.RequestID(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(httpx.RequestIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if tt.generate {
+				_, err := uuid.Parse(got)
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, tt.want, got)
+			}
+			require.Equal(t, got, rec.Header().Get(httpx.RequestIDHeader))
+		})
+	}
+}
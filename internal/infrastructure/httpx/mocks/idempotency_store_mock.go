@@ -0,0 +1,1317 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/infrastructure/httpx.IdempotencyStore -o idempotency_store_mock.go -n IdempotencyStoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	mm_httpx "github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+)
+
+// IdempotencyStoreMock implements mm_httpx.IdempotencyStore
+type IdempotencyStoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcComplete          func(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord) (err error)
+	funcCompleteOrigin    string
+	inspectFuncComplete   func(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord)
+	afterCompleteCounter  uint64
+	beforeCompleteCounter uint64
+	CompleteMock          mIdempotencyStoreMockComplete
+
+	funcRelease          func(ctx context.Context, userID uuid.UUID, key string) (err error)
+	funcReleaseOrigin    string
+	inspectFuncRelease   func(ctx context.Context, userID uuid.UUID, key string)
+	afterReleaseCounter  uint64
+	beforeReleaseCounter uint64
+	ReleaseMock          mIdempotencyStoreMockRelease
+
+	funcReserve          func(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration) (rec mm_httpx.IdempotencyRecord, reserved bool, err error)
+	funcReserveOrigin    string
+	inspectFuncReserve   func(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration)
+	afterReserveCounter  uint64
+	beforeReserveCounter uint64
+	ReserveMock          mIdempotencyStoreMockReserve
+}
+
+// NewIdempotencyStoreMock returns a mock for mm_httpx.IdempotencyStore
+func NewIdempotencyStoreMock(t minimock.Tester) *IdempotencyStoreMock {
+	m := &IdempotencyStoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CompleteMock = mIdempotencyStoreMockComplete{mock: m}
+	m.CompleteMock.callArgs = []*IdempotencyStoreMockCompleteParams{}
+
+	m.ReleaseMock = mIdempotencyStoreMockRelease{mock: m}
+	m.ReleaseMock.callArgs = []*IdempotencyStoreMockReleaseParams{}
+
+	m.ReserveMock = mIdempotencyStoreMockReserve{mock: m}
+	m.ReserveMock.callArgs = []*IdempotencyStoreMockReserveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdempotencyStoreMockComplete struct {
+	optional           bool
+	mock               *IdempotencyStoreMock
+	defaultExpectation *IdempotencyStoreMockCompleteExpectation
+	expectations       []*IdempotencyStoreMockCompleteExpectation
+
+	callArgs []*IdempotencyStoreMockCompleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyStoreMockCompleteExpectation specifies expectation struct of the IdempotencyStore.Complete
+type IdempotencyStoreMockCompleteExpectation struct {
+	mock               *IdempotencyStoreMock
+	params             *IdempotencyStoreMockCompleteParams
+	paramPtrs          *IdempotencyStoreMockCompleteParamPtrs
+	expectationOrigins IdempotencyStoreMockCompleteExpectationOrigins
+	results            *IdempotencyStoreMockCompleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyStoreMockCompleteParams contains parameters of the IdempotencyStore.Complete
+type IdempotencyStoreMockCompleteParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	key    string
+	rec    mm_httpx.IdempotencyRecord
+}
+
+// IdempotencyStoreMockCompleteParamPtrs contains pointers to parameters of the IdempotencyStore.Complete
+type IdempotencyStoreMockCompleteParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	key    *string
+	rec    *mm_httpx.IdempotencyRecord
+}
+
+// IdempotencyStoreMockCompleteResults contains results of the IdempotencyStore.Complete
+type IdempotencyStoreMockCompleteResults struct {
+	err error
+}
+
+// IdempotencyStoreMockCompleteOrigins contains origins of expectations of the IdempotencyStore.Complete
+type IdempotencyStoreMockCompleteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originKey    string
+	originRec    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmComplete *mIdempotencyStoreMockComplete) Optional() *mIdempotencyStoreMockComplete {
+	mmComplete.optional = true
+	return mmComplete
+}
+
+// Expect sets up expected params for IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) Expect(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by ExpectParams functions")
+	}
+
+	mmComplete.defaultExpectation.params = &IdempotencyStoreMockCompleteParams{ctx, userID, key, rec}
+	mmComplete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmComplete.expectations {
+		if minimock.Equal(e.params, mmComplete.defaultExpectation.params) {
+			mmComplete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmComplete.defaultExpectation.params)
+		}
+	}
+
+	return mmComplete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) ExpectCtxParam1(ctx context.Context) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyStoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmComplete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) ExpectUserIDParam2(userID uuid.UUID) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyStoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.userID = &userID
+	mmComplete.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) ExpectKeyParam3(key string) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyStoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.key = &key
+	mmComplete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectRecParam4 sets up expected param rec for IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) ExpectRecParam4(rec mm_httpx.IdempotencyRecord) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyStoreMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.rec = &rec
+	mmComplete.defaultExpectation.expectationOrigins.originRec = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) Inspect(f func(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord)) *mIdempotencyStoreMockComplete {
+	if mmComplete.mock.inspectFuncComplete != nil {
+		mmComplete.mock.t.Fatalf("Inspect function is already set for IdempotencyStoreMock.Complete")
+	}
+
+	mmComplete.mock.inspectFuncComplete = f
+
+	return mmComplete
+}
+
+// Return sets up results that will be returned by IdempotencyStore.Complete
+func (mmComplete *mIdempotencyStoreMockComplete) Return(err error) *IdempotencyStoreMock {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyStoreMockCompleteExpectation{mock: mmComplete.mock}
+	}
+	mmComplete.defaultExpectation.results = &IdempotencyStoreMockCompleteResults{err}
+	mmComplete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// Set uses given function f to mock the IdempotencyStore.Complete method
+func (mmComplete *mIdempotencyStoreMockComplete) Set(f func(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord) (err error)) *IdempotencyStoreMock {
+	if mmComplete.defaultExpectation != nil {
+		mmComplete.mock.t.Fatalf("Default expectation is already set for the IdempotencyStore.Complete method")
+	}
+
+	if len(mmComplete.expectations) > 0 {
+		mmComplete.mock.t.Fatalf("Some expectations are already set for the IdempotencyStore.Complete method")
+	}
+
+	mmComplete.mock.funcComplete = f
+	mmComplete.mock.funcCompleteOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// When sets expectation for the IdempotencyStore.Complete which will trigger the result defined by the following
+// Then helper
+func (mmComplete *mIdempotencyStoreMockComplete) When(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord) *IdempotencyStoreMockCompleteExpectation {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyStoreMock.Complete mock is already set by Set")
+	}
+
+	expectation := &IdempotencyStoreMockCompleteExpectation{
+		mock:               mmComplete.mock,
+		params:             &IdempotencyStoreMockCompleteParams{ctx, userID, key, rec},
+		expectationOrigins: IdempotencyStoreMockCompleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmComplete.expectations = append(mmComplete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyStore.Complete return parameters for the expectation previously defined by the When method
+func (e *IdempotencyStoreMockCompleteExpectation) Then(err error) *IdempotencyStoreMock {
+	e.results = &IdempotencyStoreMockCompleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyStore.Complete should be invoked
+func (mmComplete *mIdempotencyStoreMockComplete) Times(n uint64) *mIdempotencyStoreMockComplete {
+	if n == 0 {
+		mmComplete.mock.t.Fatalf("Times of IdempotencyStoreMock.Complete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmComplete.expectedInvocations, n)
+	mmComplete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmComplete
+}
+
+func (mmComplete *mIdempotencyStoreMockComplete) invocationsDone() bool {
+	if len(mmComplete.expectations) == 0 && mmComplete.defaultExpectation == nil && mmComplete.mock.funcComplete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmComplete.mock.afterCompleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmComplete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Complete implements mm_httpx.IdempotencyStore
+func (mmComplete *IdempotencyStoreMock) Complete(ctx context.Context, userID uuid.UUID, key string, rec mm_httpx.IdempotencyRecord) (err error) {
+	mm_atomic.AddUint64(&mmComplete.beforeCompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmComplete.afterCompleteCounter, 1)
+
+	mmComplete.t.Helper()
+
+	if mmComplete.inspectFuncComplete != nil {
+		mmComplete.inspectFuncComplete(ctx, userID, key, rec)
+	}
+
+	mm_params := IdempotencyStoreMockCompleteParams{ctx, userID, key, rec}
+
+	// Record call args
+	mmComplete.CompleteMock.mutex.Lock()
+	mmComplete.CompleteMock.callArgs = append(mmComplete.CompleteMock.callArgs, &mm_params)
+	mmComplete.CompleteMock.mutex.Unlock()
+
+	for _, e := range mmComplete.CompleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmComplete.CompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmComplete.CompleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmComplete.CompleteMock.defaultExpectation.params
+		mm_want_ptrs := mmComplete.CompleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyStoreMockCompleteParams{ctx, userID, key, rec}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmComplete.t.Errorf("IdempotencyStoreMock.Complete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmComplete.t.Errorf("IdempotencyStoreMock.Complete got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmComplete.t.Errorf("IdempotencyStoreMock.Complete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.rec != nil && !minimock.Equal(*mm_want_ptrs.rec, mm_got.rec) {
+				mmComplete.t.Errorf("IdempotencyStoreMock.Complete got unexpected parameter rec, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originRec, *mm_want_ptrs.rec, mm_got.rec, minimock.Diff(*mm_want_ptrs.rec, mm_got.rec))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmComplete.t.Errorf("IdempotencyStoreMock.Complete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmComplete.CompleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmComplete.CompleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmComplete.t.Fatal("No results are set for the IdempotencyStoreMock.Complete")
+		}
+		return (*mm_results).err
+	}
+	if mmComplete.funcComplete != nil {
+		return mmComplete.funcComplete(ctx, userID, key, rec)
+	}
+	mmComplete.t.Fatalf("Unexpected call to IdempotencyStoreMock.Complete. %v %v %v %v", ctx, userID, key, rec)
+	return
+}
+
+// CompleteAfterCounter returns a count of finished IdempotencyStoreMock.Complete invocations
+func (mmComplete *IdempotencyStoreMock) CompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.afterCompleteCounter)
+}
+
+// CompleteBeforeCounter returns a count of IdempotencyStoreMock.Complete invocations
+func (mmComplete *IdempotencyStoreMock) CompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.beforeCompleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyStoreMock.Complete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmComplete *mIdempotencyStoreMockComplete) Calls() []*IdempotencyStoreMockCompleteParams {
+	mmComplete.mutex.RLock()
+
+	argCopy := make([]*IdempotencyStoreMockCompleteParams, len(mmComplete.callArgs))
+	copy(argCopy, mmComplete.callArgs)
+
+	mmComplete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteDone returns true if the count of the Complete invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyStoreMock) MinimockCompleteDone() bool {
+	if m.CompleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteMock.invocationsDone()
+}
+
+// MinimockCompleteInspect logs each unmet expectation
+func (m *IdempotencyStoreMock) MinimockCompleteInspect() {
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Complete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteCounter := mm_atomic.LoadUint64(&m.afterCompleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteMock.defaultExpectation != nil && afterCompleteCounter < 1 {
+		if m.CompleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Complete at\n%s", m.CompleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Complete at\n%s with params: %#v", m.CompleteMock.defaultExpectation.expectationOrigins.origin, *m.CompleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcComplete != nil && afterCompleteCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyStoreMock.Complete at\n%s", m.funcCompleteOrigin)
+	}
+
+	if !m.CompleteMock.invocationsDone() && afterCompleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyStoreMock.Complete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteMock.expectedInvocations), m.CompleteMock.expectedInvocationsOrigin, afterCompleteCounter)
+	}
+}
+
+type mIdempotencyStoreMockRelease struct {
+	optional           bool
+	mock               *IdempotencyStoreMock
+	defaultExpectation *IdempotencyStoreMockReleaseExpectation
+	expectations       []*IdempotencyStoreMockReleaseExpectation
+
+	callArgs []*IdempotencyStoreMockReleaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyStoreMockReleaseExpectation specifies expectation struct of the IdempotencyStore.Release
+type IdempotencyStoreMockReleaseExpectation struct {
+	mock               *IdempotencyStoreMock
+	params             *IdempotencyStoreMockReleaseParams
+	paramPtrs          *IdempotencyStoreMockReleaseParamPtrs
+	expectationOrigins IdempotencyStoreMockReleaseExpectationOrigins
+	results            *IdempotencyStoreMockReleaseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyStoreMockReleaseParams contains parameters of the IdempotencyStore.Release
+type IdempotencyStoreMockReleaseParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	key    string
+}
+
+// IdempotencyStoreMockReleaseParamPtrs contains pointers to parameters of the IdempotencyStore.Release
+type IdempotencyStoreMockReleaseParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	key    *string
+}
+
+// IdempotencyStoreMockReleaseResults contains results of the IdempotencyStore.Release
+type IdempotencyStoreMockReleaseResults struct {
+	err error
+}
+
+// IdempotencyStoreMockReleaseOrigins contains origins of expectations of the IdempotencyStore.Release
+type IdempotencyStoreMockReleaseExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originKey    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRelease *mIdempotencyStoreMockRelease) Optional() *mIdempotencyStoreMockRelease {
+	mmRelease.optional = true
+	return mmRelease
+}
+
+// Expect sets up expected params for IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) Expect(ctx context.Context, userID uuid.UUID, key string) *mIdempotencyStoreMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyStoreMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by ExpectParams functions")
+	}
+
+	mmRelease.defaultExpectation.params = &IdempotencyStoreMockReleaseParams{ctx, userID, key}
+	mmRelease.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRelease.expectations {
+		if minimock.Equal(e.params, mmRelease.defaultExpectation.params) {
+			mmRelease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRelease.defaultExpectation.params)
+		}
+	}
+
+	return mmRelease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) ExpectCtxParam1(ctx context.Context) *mIdempotencyStoreMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyStoreMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyStoreMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRelease.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) ExpectUserIDParam2(userID uuid.UUID) *mIdempotencyStoreMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyStoreMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyStoreMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.userID = &userID
+	mmRelease.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) ExpectKeyParam3(key string) *mIdempotencyStoreMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyStoreMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyStoreMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.key = &key
+	mmRelease.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) Inspect(f func(ctx context.Context, userID uuid.UUID, key string)) *mIdempotencyStoreMockRelease {
+	if mmRelease.mock.inspectFuncRelease != nil {
+		mmRelease.mock.t.Fatalf("Inspect function is already set for IdempotencyStoreMock.Release")
+	}
+
+	mmRelease.mock.inspectFuncRelease = f
+
+	return mmRelease
+}
+
+// Return sets up results that will be returned by IdempotencyStore.Release
+func (mmRelease *mIdempotencyStoreMockRelease) Return(err error) *IdempotencyStoreMock {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyStoreMockReleaseExpectation{mock: mmRelease.mock}
+	}
+	mmRelease.defaultExpectation.results = &IdempotencyStoreMockReleaseResults{err}
+	mmRelease.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// Set uses given function f to mock the IdempotencyStore.Release method
+func (mmRelease *mIdempotencyStoreMockRelease) Set(f func(ctx context.Context, userID uuid.UUID, key string) (err error)) *IdempotencyStoreMock {
+	if mmRelease.defaultExpectation != nil {
+		mmRelease.mock.t.Fatalf("Default expectation is already set for the IdempotencyStore.Release method")
+	}
+
+	if len(mmRelease.expectations) > 0 {
+		mmRelease.mock.t.Fatalf("Some expectations are already set for the IdempotencyStore.Release method")
+	}
+
+	mmRelease.mock.funcRelease = f
+	mmRelease.mock.funcReleaseOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// When sets expectation for the IdempotencyStore.Release which will trigger the result defined by the following
+// Then helper
+func (mmRelease *mIdempotencyStoreMockRelease) When(ctx context.Context, userID uuid.UUID, key string) *IdempotencyStoreMockReleaseExpectation {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyStoreMock.Release mock is already set by Set")
+	}
+
+	expectation := &IdempotencyStoreMockReleaseExpectation{
+		mock:               mmRelease.mock,
+		params:             &IdempotencyStoreMockReleaseParams{ctx, userID, key},
+		expectationOrigins: IdempotencyStoreMockReleaseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRelease.expectations = append(mmRelease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyStore.Release return parameters for the expectation previously defined by the When method
+func (e *IdempotencyStoreMockReleaseExpectation) Then(err error) *IdempotencyStoreMock {
+	e.results = &IdempotencyStoreMockReleaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyStore.Release should be invoked
+func (mmRelease *mIdempotencyStoreMockRelease) Times(n uint64) *mIdempotencyStoreMockRelease {
+	if n == 0 {
+		mmRelease.mock.t.Fatalf("Times of IdempotencyStoreMock.Release mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRelease.expectedInvocations, n)
+	mmRelease.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRelease
+}
+
+func (mmRelease *mIdempotencyStoreMockRelease) invocationsDone() bool {
+	if len(mmRelease.expectations) == 0 && mmRelease.defaultExpectation == nil && mmRelease.mock.funcRelease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRelease.mock.afterReleaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRelease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Release implements mm_httpx.IdempotencyStore
+func (mmRelease *IdempotencyStoreMock) Release(ctx context.Context, userID uuid.UUID, key string) (err error) {
+	mm_atomic.AddUint64(&mmRelease.beforeReleaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmRelease.afterReleaseCounter, 1)
+
+	mmRelease.t.Helper()
+
+	if mmRelease.inspectFuncRelease != nil {
+		mmRelease.inspectFuncRelease(ctx, userID, key)
+	}
+
+	mm_params := IdempotencyStoreMockReleaseParams{ctx, userID, key}
+
+	// Record call args
+	mmRelease.ReleaseMock.mutex.Lock()
+	mmRelease.ReleaseMock.callArgs = append(mmRelease.ReleaseMock.callArgs, &mm_params)
+	mmRelease.ReleaseMock.mutex.Unlock()
+
+	for _, e := range mmRelease.ReleaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRelease.ReleaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRelease.ReleaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmRelease.ReleaseMock.defaultExpectation.params
+		mm_want_ptrs := mmRelease.ReleaseMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyStoreMockReleaseParams{ctx, userID, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRelease.t.Errorf("IdempotencyStoreMock.Release got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRelease.t.Errorf("IdempotencyStoreMock.Release got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRelease.t.Errorf("IdempotencyStoreMock.Release got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRelease.t.Errorf("IdempotencyStoreMock.Release got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRelease.ReleaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRelease.t.Fatal("No results are set for the IdempotencyStoreMock.Release")
+		}
+		return (*mm_results).err
+	}
+	if mmRelease.funcRelease != nil {
+		return mmRelease.funcRelease(ctx, userID, key)
+	}
+	mmRelease.t.Fatalf("Unexpected call to IdempotencyStoreMock.Release. %v %v %v", ctx, userID, key)
+	return
+}
+
+// ReleaseAfterCounter returns a count of finished IdempotencyStoreMock.Release invocations
+func (mmRelease *IdempotencyStoreMock) ReleaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.afterReleaseCounter)
+}
+
+// ReleaseBeforeCounter returns a count of IdempotencyStoreMock.Release invocations
+func (mmRelease *IdempotencyStoreMock) ReleaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.beforeReleaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyStoreMock.Release.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRelease *mIdempotencyStoreMockRelease) Calls() []*IdempotencyStoreMockReleaseParams {
+	mmRelease.mutex.RLock()
+
+	argCopy := make([]*IdempotencyStoreMockReleaseParams, len(mmRelease.callArgs))
+	copy(argCopy, mmRelease.callArgs)
+
+	mmRelease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseDone returns true if the count of the Release invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyStoreMock) MinimockReleaseDone() bool {
+	if m.ReleaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseMock.invocationsDone()
+}
+
+// MinimockReleaseInspect logs each unmet expectation
+func (m *IdempotencyStoreMock) MinimockReleaseInspect() {
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Release at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseCounter := mm_atomic.LoadUint64(&m.afterReleaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseMock.defaultExpectation != nil && afterReleaseCounter < 1 {
+		if m.ReleaseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Release at\n%s", m.ReleaseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Release at\n%s with params: %#v", m.ReleaseMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRelease != nil && afterReleaseCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyStoreMock.Release at\n%s", m.funcReleaseOrigin)
+	}
+
+	if !m.ReleaseMock.invocationsDone() && afterReleaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyStoreMock.Release at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseMock.expectedInvocations), m.ReleaseMock.expectedInvocationsOrigin, afterReleaseCounter)
+	}
+}
+
+type mIdempotencyStoreMockReserve struct {
+	optional           bool
+	mock               *IdempotencyStoreMock
+	defaultExpectation *IdempotencyStoreMockReserveExpectation
+	expectations       []*IdempotencyStoreMockReserveExpectation
+
+	callArgs []*IdempotencyStoreMockReserveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyStoreMockReserveExpectation specifies expectation struct of the IdempotencyStore.Reserve
+type IdempotencyStoreMockReserveExpectation struct {
+	mock               *IdempotencyStoreMock
+	params             *IdempotencyStoreMockReserveParams
+	paramPtrs          *IdempotencyStoreMockReserveParamPtrs
+	expectationOrigins IdempotencyStoreMockReserveExpectationOrigins
+	results            *IdempotencyStoreMockReserveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyStoreMockReserveParams contains parameters of the IdempotencyStore.Reserve
+type IdempotencyStoreMockReserveParams struct {
+	ctx         context.Context
+	userID      uuid.UUID
+	key         string
+	requestHash string
+	ttl         time.Duration
+}
+
+// IdempotencyStoreMockReserveParamPtrs contains pointers to parameters of the IdempotencyStore.Reserve
+type IdempotencyStoreMockReserveParamPtrs struct {
+	ctx         *context.Context
+	userID      *uuid.UUID
+	key         *string
+	requestHash *string
+	ttl         *time.Duration
+}
+
+// IdempotencyStoreMockReserveResults contains results of the IdempotencyStore.Reserve
+type IdempotencyStoreMockReserveResults struct {
+	rec      mm_httpx.IdempotencyRecord
+	reserved bool
+	err      error
+}
+
+// IdempotencyStoreMockReserveOrigins contains origins of expectations of the IdempotencyStore.Reserve
+type IdempotencyStoreMockReserveExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originKey         string
+	originRequestHash string
+	originTtl         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserve *mIdempotencyStoreMockReserve) Optional() *mIdempotencyStoreMockReserve {
+	mmReserve.optional = true
+	return mmReserve
+}
+
+// Expect sets up expected params for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) Expect(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by ExpectParams functions")
+	}
+
+	mmReserve.defaultExpectation.params = &IdempotencyStoreMockReserveParams{ctx, userID, key, requestHash, ttl}
+	mmReserve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserve.expectations {
+		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
+			mmReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserve.defaultExpectation.params)
+		}
+	}
+
+	return mmReserve
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) ExpectCtxParam1(ctx context.Context) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyStoreMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserve.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) ExpectUserIDParam2(userID uuid.UUID) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyStoreMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.userID = &userID
+	mmReserve.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) ExpectKeyParam3(key string) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyStoreMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.key = &key
+	mmReserve.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectRequestHashParam4 sets up expected param requestHash for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) ExpectRequestHashParam4(requestHash string) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyStoreMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.requestHash = &requestHash
+	mmReserve.defaultExpectation.expectationOrigins.originRequestHash = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectTtlParam5 sets up expected param ttl for IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) ExpectTtlParam5(ttl time.Duration) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyStoreMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.ttl = &ttl
+	mmReserve.defaultExpectation.expectationOrigins.originTtl = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) Inspect(f func(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration)) *mIdempotencyStoreMockReserve {
+	if mmReserve.mock.inspectFuncReserve != nil {
+		mmReserve.mock.t.Fatalf("Inspect function is already set for IdempotencyStoreMock.Reserve")
+	}
+
+	mmReserve.mock.inspectFuncReserve = f
+
+	return mmReserve
+}
+
+// Return sets up results that will be returned by IdempotencyStore.Reserve
+func (mmReserve *mIdempotencyStoreMockReserve) Return(rec mm_httpx.IdempotencyRecord, reserved bool, err error) *IdempotencyStoreMock {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyStoreMockReserveExpectation{mock: mmReserve.mock}
+	}
+	mmReserve.defaultExpectation.results = &IdempotencyStoreMockReserveResults{rec, reserved, err}
+	mmReserve.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// Set uses given function f to mock the IdempotencyStore.Reserve method
+func (mmReserve *mIdempotencyStoreMockReserve) Set(f func(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration) (rec mm_httpx.IdempotencyRecord, reserved bool, err error)) *IdempotencyStoreMock {
+	if mmReserve.defaultExpectation != nil {
+		mmReserve.mock.t.Fatalf("Default expectation is already set for the IdempotencyStore.Reserve method")
+	}
+
+	if len(mmReserve.expectations) > 0 {
+		mmReserve.mock.t.Fatalf("Some expectations are already set for the IdempotencyStore.Reserve method")
+	}
+
+	mmReserve.mock.funcReserve = f
+	mmReserve.mock.funcReserveOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// When sets expectation for the IdempotencyStore.Reserve which will trigger the result defined by the following
+// Then helper
+func (mmReserve *mIdempotencyStoreMockReserve) When(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration) *IdempotencyStoreMockReserveExpectation {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyStoreMock.Reserve mock is already set by Set")
+	}
+
+	expectation := &IdempotencyStoreMockReserveExpectation{
+		mock:               mmReserve.mock,
+		params:             &IdempotencyStoreMockReserveParams{ctx, userID, key, requestHash, ttl},
+		expectationOrigins: IdempotencyStoreMockReserveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserve.expectations = append(mmReserve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyStore.Reserve return parameters for the expectation previously defined by the When method
+func (e *IdempotencyStoreMockReserveExpectation) Then(rec mm_httpx.IdempotencyRecord, reserved bool, err error) *IdempotencyStoreMock {
+	e.results = &IdempotencyStoreMockReserveResults{rec, reserved, err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyStore.Reserve should be invoked
+func (mmReserve *mIdempotencyStoreMockReserve) Times(n uint64) *mIdempotencyStoreMockReserve {
+	if n == 0 {
+		mmReserve.mock.t.Fatalf("Times of IdempotencyStoreMock.Reserve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserve.expectedInvocations, n)
+	mmReserve.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserve
+}
+
+func (mmReserve *mIdempotencyStoreMockReserve) invocationsDone() bool {
+	if len(mmReserve.expectations) == 0 && mmReserve.defaultExpectation == nil && mmReserve.mock.funcReserve == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserve.mock.afterReserveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserve.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reserve implements mm_httpx.IdempotencyStore
+func (mmReserve *IdempotencyStoreMock) Reserve(ctx context.Context, userID uuid.UUID, key string, requestHash string, ttl time.Duration) (rec mm_httpx.IdempotencyRecord, reserved bool, err error) {
+	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
+
+	mmReserve.t.Helper()
+
+	if mmReserve.inspectFuncReserve != nil {
+		mmReserve.inspectFuncReserve(ctx, userID, key, requestHash, ttl)
+	}
+
+	mm_params := IdempotencyStoreMockReserveParams{ctx, userID, key, requestHash, ttl}
+
+	// Record call args
+	mmReserve.ReserveMock.mutex.Lock()
+	mmReserve.ReserveMock.callArgs = append(mmReserve.ReserveMock.callArgs, &mm_params)
+	mmReserve.ReserveMock.mutex.Unlock()
+
+	for _, e := range mmReserve.ReserveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rec, e.results.reserved, e.results.err
+		}
+	}
+
+	if mmReserve.ReserveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserve.ReserveMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserve.ReserveMock.defaultExpectation.params
+		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyStoreMockReserveParams{ctx, userID, key, requestHash, ttl}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.requestHash != nil && !minimock.Equal(*mm_want_ptrs.requestHash, mm_got.requestHash) {
+				mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameter requestHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originRequestHash, *mm_want_ptrs.requestHash, mm_got.requestHash, minimock.Diff(*mm_want_ptrs.requestHash, mm_got.requestHash))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameter ttl, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originTtl, *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserve.t.Errorf("IdempotencyStoreMock.Reserve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserve.ReserveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserve.ReserveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserve.t.Fatal("No results are set for the IdempotencyStoreMock.Reserve")
+		}
+		return (*mm_results).rec, (*mm_results).reserved, (*mm_results).err
+	}
+	if mmReserve.funcReserve != nil {
+		return mmReserve.funcReserve(ctx, userID, key, requestHash, ttl)
+	}
+	mmReserve.t.Fatalf("Unexpected call to IdempotencyStoreMock.Reserve. %v %v %v %v %v", ctx, userID, key, requestHash, ttl)
+	return
+}
+
+// ReserveAfterCounter returns a count of finished IdempotencyStoreMock.Reserve invocations
+func (mmReserve *IdempotencyStoreMock) ReserveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.afterReserveCounter)
+}
+
+// ReserveBeforeCounter returns a count of IdempotencyStoreMock.Reserve invocations
+func (mmReserve *IdempotencyStoreMock) ReserveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.beforeReserveCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyStoreMock.Reserve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserve *mIdempotencyStoreMockReserve) Calls() []*IdempotencyStoreMockReserveParams {
+	mmReserve.mutex.RLock()
+
+	argCopy := make([]*IdempotencyStoreMockReserveParams, len(mmReserve.callArgs))
+	copy(argCopy, mmReserve.callArgs)
+
+	mmReserve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveDone returns true if the count of the Reserve invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyStoreMock) MinimockReserveDone() bool {
+	if m.ReserveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveMock.invocationsDone()
+}
+
+// MinimockReserveInspect logs each unmet expectation
+func (m *IdempotencyStoreMock) MinimockReserveInspect() {
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Reserve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveCounter := mm_atomic.LoadUint64(&m.afterReserveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveMock.defaultExpectation != nil && afterReserveCounter < 1 {
+		if m.ReserveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Reserve at\n%s", m.ReserveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Reserve at\n%s with params: %#v", m.ReserveMock.defaultExpectation.expectationOrigins.origin, *m.ReserveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserve != nil && afterReserveCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyStoreMock.Reserve at\n%s", m.funcReserveOrigin)
+	}
+
+	if !m.ReserveMock.invocationsDone() && afterReserveCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyStoreMock.Reserve at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveMock.expectedInvocations), m.ReserveMock.expectedInvocationsOrigin, afterReserveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdempotencyStoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCompleteInspect()
+
+			m.MinimockReleaseInspect()
+
+			m.MinimockReserveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdempotencyStoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdempotencyStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCompleteDone() &&
+		m.MinimockReleaseDone() &&
+		m.MinimockReserveDone()
+}
@@ -0,0 +1,440 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/66gu1/easygodocs/internal/infrastructure/httpx.PanicAlerter -o panic_alerter_mock.go -n PanicAlerterMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PanicAlerterMock implements mm_httpx.PanicAlerter
+type PanicAlerterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAlertPanic          func(ctx context.Context, recovered any, stack []byte)
+	funcAlertPanicOrigin    string
+	inspectFuncAlertPanic   func(ctx context.Context, recovered any, stack []byte)
+	afterAlertPanicCounter  uint64
+	beforeAlertPanicCounter uint64
+	AlertPanicMock          mPanicAlerterMockAlertPanic
+}
+
+// NewPanicAlerterMock returns a mock for mm_httpx.PanicAlerter
+func NewPanicAlerterMock(t minimock.Tester) *PanicAlerterMock {
+	m := &PanicAlerterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AlertPanicMock = mPanicAlerterMockAlertPanic{mock: m}
+	m.AlertPanicMock.callArgs = []*PanicAlerterMockAlertPanicParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPanicAlerterMockAlertPanic struct {
+	optional           bool
+	mock               *PanicAlerterMock
+	defaultExpectation *PanicAlerterMockAlertPanicExpectation
+	expectations       []*PanicAlerterMockAlertPanicExpectation
+
+	callArgs []*PanicAlerterMockAlertPanicParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PanicAlerterMockAlertPanicExpectation specifies expectation struct of the PanicAlerter.AlertPanic
+type PanicAlerterMockAlertPanicExpectation struct {
+	mock               *PanicAlerterMock
+	params             *PanicAlerterMockAlertPanicParams
+	paramPtrs          *PanicAlerterMockAlertPanicParamPtrs
+	expectationOrigins PanicAlerterMockAlertPanicExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// PanicAlerterMockAlertPanicParams contains parameters of the PanicAlerter.AlertPanic
+type PanicAlerterMockAlertPanicParams struct {
+	ctx       context.Context
+	recovered any
+	stack     []byte
+}
+
+// PanicAlerterMockAlertPanicParamPtrs contains pointers to parameters of the PanicAlerter.AlertPanic
+type PanicAlerterMockAlertPanicParamPtrs struct {
+	ctx       *context.Context
+	recovered *any
+	stack     *[]byte
+}
+
+// PanicAlerterMockAlertPanicOrigins contains origins of expectations of the PanicAlerter.AlertPanic
+type PanicAlerterMockAlertPanicExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originRecovered string
+	originStack     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Optional() *mPanicAlerterMockAlertPanic {
+	mmAlertPanic.optional = true
+	return mmAlertPanic
+}
+
+// Expect sets up expected params for PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Expect(ctx context.Context, recovered any, stack []byte) *mPanicAlerterMockAlertPanic {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	if mmAlertPanic.defaultExpectation == nil {
+		mmAlertPanic.defaultExpectation = &PanicAlerterMockAlertPanicExpectation{}
+	}
+
+	if mmAlertPanic.defaultExpectation.paramPtrs != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by ExpectParams functions")
+	}
+
+	mmAlertPanic.defaultExpectation.params = &PanicAlerterMockAlertPanicParams{ctx, recovered, stack}
+	mmAlertPanic.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAlertPanic.expectations {
+		if minimock.Equal(e.params, mmAlertPanic.defaultExpectation.params) {
+			mmAlertPanic.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAlertPanic.defaultExpectation.params)
+		}
+	}
+
+	return mmAlertPanic
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) ExpectCtxParam1(ctx context.Context) *mPanicAlerterMockAlertPanic {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	if mmAlertPanic.defaultExpectation == nil {
+		mmAlertPanic.defaultExpectation = &PanicAlerterMockAlertPanicExpectation{}
+	}
+
+	if mmAlertPanic.defaultExpectation.params != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Expect")
+	}
+
+	if mmAlertPanic.defaultExpectation.paramPtrs == nil {
+		mmAlertPanic.defaultExpectation.paramPtrs = &PanicAlerterMockAlertPanicParamPtrs{}
+	}
+	mmAlertPanic.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAlertPanic.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAlertPanic
+}
+
+// ExpectRecoveredParam2 sets up expected param recovered for PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) ExpectRecoveredParam2(recovered any) *mPanicAlerterMockAlertPanic {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	if mmAlertPanic.defaultExpectation == nil {
+		mmAlertPanic.defaultExpectation = &PanicAlerterMockAlertPanicExpectation{}
+	}
+
+	if mmAlertPanic.defaultExpectation.params != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Expect")
+	}
+
+	if mmAlertPanic.defaultExpectation.paramPtrs == nil {
+		mmAlertPanic.defaultExpectation.paramPtrs = &PanicAlerterMockAlertPanicParamPtrs{}
+	}
+	mmAlertPanic.defaultExpectation.paramPtrs.recovered = &recovered
+	mmAlertPanic.defaultExpectation.expectationOrigins.originRecovered = minimock.CallerInfo(1)
+
+	return mmAlertPanic
+}
+
+// ExpectStackParam3 sets up expected param stack for PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) ExpectStackParam3(stack []byte) *mPanicAlerterMockAlertPanic {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	if mmAlertPanic.defaultExpectation == nil {
+		mmAlertPanic.defaultExpectation = &PanicAlerterMockAlertPanicExpectation{}
+	}
+
+	if mmAlertPanic.defaultExpectation.params != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Expect")
+	}
+
+	if mmAlertPanic.defaultExpectation.paramPtrs == nil {
+		mmAlertPanic.defaultExpectation.paramPtrs = &PanicAlerterMockAlertPanicParamPtrs{}
+	}
+	mmAlertPanic.defaultExpectation.paramPtrs.stack = &stack
+	mmAlertPanic.defaultExpectation.expectationOrigins.originStack = minimock.CallerInfo(1)
+
+	return mmAlertPanic
+}
+
+// Inspect accepts an inspector function that has same arguments as the PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Inspect(f func(ctx context.Context, recovered any, stack []byte)) *mPanicAlerterMockAlertPanic {
+	if mmAlertPanic.mock.inspectFuncAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("Inspect function is already set for PanicAlerterMock.AlertPanic")
+	}
+
+	mmAlertPanic.mock.inspectFuncAlertPanic = f
+
+	return mmAlertPanic
+}
+
+// Return sets up results that will be returned by PanicAlerter.AlertPanic
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Return() *PanicAlerterMock {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	if mmAlertPanic.defaultExpectation == nil {
+		mmAlertPanic.defaultExpectation = &PanicAlerterMockAlertPanicExpectation{mock: mmAlertPanic.mock}
+	}
+
+	mmAlertPanic.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAlertPanic.mock
+}
+
+// Set uses given function f to mock the PanicAlerter.AlertPanic method
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Set(f func(ctx context.Context, recovered any, stack []byte)) *PanicAlerterMock {
+	if mmAlertPanic.defaultExpectation != nil {
+		mmAlertPanic.mock.t.Fatalf("Default expectation is already set for the PanicAlerter.AlertPanic method")
+	}
+
+	if len(mmAlertPanic.expectations) > 0 {
+		mmAlertPanic.mock.t.Fatalf("Some expectations are already set for the PanicAlerter.AlertPanic method")
+	}
+
+	mmAlertPanic.mock.funcAlertPanic = f
+	mmAlertPanic.mock.funcAlertPanicOrigin = minimock.CallerInfo(1)
+	return mmAlertPanic.mock
+}
+
+// When sets expectation for the PanicAlerter.AlertPanic which will trigger the result defined by the following
+// Then helper
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) When(ctx context.Context, recovered any, stack []byte) *PanicAlerterMockAlertPanicExpectation {
+	if mmAlertPanic.mock.funcAlertPanic != nil {
+		mmAlertPanic.mock.t.Fatalf("PanicAlerterMock.AlertPanic mock is already set by Set")
+	}
+
+	expectation := &PanicAlerterMockAlertPanicExpectation{
+		mock:               mmAlertPanic.mock,
+		params:             &PanicAlerterMockAlertPanicParams{ctx, recovered, stack},
+		expectationOrigins: PanicAlerterMockAlertPanicExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAlertPanic.expectations = append(mmAlertPanic.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PanicAlerter.AlertPanic return parameters for the expectation previously defined by the When method
+
+func (e *PanicAlerterMockAlertPanicExpectation) Then() *PanicAlerterMock {
+	return e.mock
+}
+
+// Times sets number of times PanicAlerter.AlertPanic should be invoked
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Times(n uint64) *mPanicAlerterMockAlertPanic {
+	if n == 0 {
+		mmAlertPanic.mock.t.Fatalf("Times of PanicAlerterMock.AlertPanic mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAlertPanic.expectedInvocations, n)
+	mmAlertPanic.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAlertPanic
+}
+
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) invocationsDone() bool {
+	if len(mmAlertPanic.expectations) == 0 && mmAlertPanic.defaultExpectation == nil && mmAlertPanic.mock.funcAlertPanic == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAlertPanic.mock.afterAlertPanicCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAlertPanic.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AlertPanic implements mm_httpx.PanicAlerter
+func (mmAlertPanic *PanicAlerterMock) AlertPanic(ctx context.Context, recovered any, stack []byte) {
+	mm_atomic.AddUint64(&mmAlertPanic.beforeAlertPanicCounter, 1)
+	defer mm_atomic.AddUint64(&mmAlertPanic.afterAlertPanicCounter, 1)
+
+	mmAlertPanic.t.Helper()
+
+	if mmAlertPanic.inspectFuncAlertPanic != nil {
+		mmAlertPanic.inspectFuncAlertPanic(ctx, recovered, stack)
+	}
+
+	mm_params := PanicAlerterMockAlertPanicParams{ctx, recovered, stack}
+
+	// Record call args
+	mmAlertPanic.AlertPanicMock.mutex.Lock()
+	mmAlertPanic.AlertPanicMock.callArgs = append(mmAlertPanic.AlertPanicMock.callArgs, &mm_params)
+	mmAlertPanic.AlertPanicMock.mutex.Unlock()
+
+	for _, e := range mmAlertPanic.AlertPanicMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmAlertPanic.AlertPanicMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAlertPanic.AlertPanicMock.defaultExpectation.Counter, 1)
+		mm_want := mmAlertPanic.AlertPanicMock.defaultExpectation.params
+		mm_want_ptrs := mmAlertPanic.AlertPanicMock.defaultExpectation.paramPtrs
+
+		mm_got := PanicAlerterMockAlertPanicParams{ctx, recovered, stack}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAlertPanic.t.Errorf("PanicAlerterMock.AlertPanic got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAlertPanic.AlertPanicMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.recovered != nil && !minimock.Equal(*mm_want_ptrs.recovered, mm_got.recovered) {
+				mmAlertPanic.t.Errorf("PanicAlerterMock.AlertPanic got unexpected parameter recovered, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAlertPanic.AlertPanicMock.defaultExpectation.expectationOrigins.originRecovered, *mm_want_ptrs.recovered, mm_got.recovered, minimock.Diff(*mm_want_ptrs.recovered, mm_got.recovered))
+			}
+
+			if mm_want_ptrs.stack != nil && !minimock.Equal(*mm_want_ptrs.stack, mm_got.stack) {
+				mmAlertPanic.t.Errorf("PanicAlerterMock.AlertPanic got unexpected parameter stack, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAlertPanic.AlertPanicMock.defaultExpectation.expectationOrigins.originStack, *mm_want_ptrs.stack, mm_got.stack, minimock.Diff(*mm_want_ptrs.stack, mm_got.stack))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAlertPanic.t.Errorf("PanicAlerterMock.AlertPanic got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAlertPanic.AlertPanicMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmAlertPanic.funcAlertPanic != nil {
+		mmAlertPanic.funcAlertPanic(ctx, recovered, stack)
+		return
+	}
+	mmAlertPanic.t.Fatalf("Unexpected call to PanicAlerterMock.AlertPanic. %v %v %v", ctx, recovered, stack)
+
+}
+
+// AlertPanicAfterCounter returns a count of finished PanicAlerterMock.AlertPanic invocations
+func (mmAlertPanic *PanicAlerterMock) AlertPanicAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAlertPanic.afterAlertPanicCounter)
+}
+
+// AlertPanicBeforeCounter returns a count of PanicAlerterMock.AlertPanic invocations
+func (mmAlertPanic *PanicAlerterMock) AlertPanicBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAlertPanic.beforeAlertPanicCounter)
+}
+
+// Calls returns a list of arguments used in each call to PanicAlerterMock.AlertPanic.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAlertPanic *mPanicAlerterMockAlertPanic) Calls() []*PanicAlerterMockAlertPanicParams {
+	mmAlertPanic.mutex.RLock()
+
+	argCopy := make([]*PanicAlerterMockAlertPanicParams, len(mmAlertPanic.callArgs))
+	copy(argCopy, mmAlertPanic.callArgs)
+
+	mmAlertPanic.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAlertPanicDone returns true if the count of the AlertPanic invocations corresponds
+// the number of defined expectations
+func (m *PanicAlerterMock) MinimockAlertPanicDone() bool {
+	if m.AlertPanicMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AlertPanicMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AlertPanicMock.invocationsDone()
+}
+
+// MinimockAlertPanicInspect logs each unmet expectation
+func (m *PanicAlerterMock) MinimockAlertPanicInspect() {
+	for _, e := range m.AlertPanicMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PanicAlerterMock.AlertPanic at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAlertPanicCounter := mm_atomic.LoadUint64(&m.afterAlertPanicCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AlertPanicMock.defaultExpectation != nil && afterAlertPanicCounter < 1 {
+		if m.AlertPanicMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PanicAlerterMock.AlertPanic at\n%s", m.AlertPanicMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PanicAlerterMock.AlertPanic at\n%s with params: %#v", m.AlertPanicMock.defaultExpectation.expectationOrigins.origin, *m.AlertPanicMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAlertPanic != nil && afterAlertPanicCounter < 1 {
+		m.t.Errorf("Expected call to PanicAlerterMock.AlertPanic at\n%s", m.funcAlertPanicOrigin)
+	}
+
+	if !m.AlertPanicMock.invocationsDone() && afterAlertPanicCounter > 0 {
+		m.t.Errorf("Expected %d calls to PanicAlerterMock.AlertPanic at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AlertPanicMock.expectedInvocations), m.AlertPanicMock.expectedInvocationsOrigin, afterAlertPanicCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PanicAlerterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAlertPanicInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PanicAlerterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PanicAlerterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAlertPanicDone()
+}
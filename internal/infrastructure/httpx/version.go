@@ -0,0 +1,21 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/buildinfo"
+)
+
+// GetVersion godoc
+// @Summary      Build info
+// @Description  Returns the version, commit and build date of the server, the Go version it was built with and
+// @Description  the active config profile.
+// @Tags         meta
+// @Produce      json
+// @Success      200 {object} buildinfo.Info
+// @Router       /version [get]
+func GetVersion(info buildinfo.Info) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(r.Context(), w, http.StatusOK, info)
+	}
+}
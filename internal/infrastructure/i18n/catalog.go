@@ -0,0 +1,278 @@
+package i18n
+
+import (
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"golang.org/x/text/language"
+)
+
+// messages translates problem titles and user messages, keyed by the English text used in the code.
+var messages = map[language.Tag]map[string]string{
+	language.Russian: {
+		// core
+		"Bad request":            "Некорректный запрос",
+		"Unauthorized":           "Требуется авторизация",
+		"Forbidden":              "Доступ запрещён",
+		"Internal server error":  "Внутренняя ошибка сервера",
+		"Not found":              "Не найдено",
+		"Method not allowed":     "Метод не поддерживается",
+		"Request body too large": "Слишком большое тело запроса",
+
+		// idempotency
+		"Idempotency-Key is too long":                              "Idempotency-Key слишком длинный",
+		"Idempotency-Key reused":                                   "Idempotency-Key использован повторно",
+		"Idempotency-Key was already used for a different request": "Idempotency-Key уже использован для другого запроса",
+		"Request in progress":                                      "Запрос выполняется",
+		"A request with this Idempotency-Key is still in progress": "Запрос с этим Idempotency-Key ещё выполняется",
+
+		// admin
+		"Invalid settings": "Некорректные настройки",
+
+		// auth
+		"Invalid credentials":                                     "Неверные учётные данные",
+		"invalid password or email":                               "Неверный пароль или email",
+		"Session not found":                                       "Сессия не найдена",
+		"session not found":                                       "Сессия не найдена",
+		"Role not found":                                          "Роль не найдена",
+		"role not found":                                          "Роль не найдена",
+		"Invalid role":                                            "Некорректная роль",
+		"invalid role":                                            "Некорректная роль",
+		"Role already assigned":                                   "Роль уже назначена",
+		"role already assigned to user":                           "Роль уже назначена пользователю",
+		"role entity is required":                                 "Для роли требуется сущность",
+		"role entity must be nil":                                 "Для этой роли сущность не указывается",
+		"role preset not found":                                   "Шаблон ролей не найден",
+		"role preset name already taken":                          "Имя шаблона ролей уже занято",
+		"role preset name must be 1 to 100 characters":            "Имя шаблона ролей должно содержать от 1 до 100 символов",
+		"Session used from another device":                        "Сессия использована с другого устройства",
+		"session was refreshed from another device and has ended": "Сессия обновлена с другого устройства и завершена",
+		"number of preset grants is out of range":                 "Количество ролей в шаблоне вне допустимого диапазона",
+		"a preset can have one role per entity":                   "В шаблоне может быть только одна роль на сущность",
+		"number of users is out of range":                         "Количество пользователей вне допустимого диапазона",
+		"Impersonation not allowed":                               "Вход от имени пользователя запрещён",
+		"impersonation not allowed":                               "Вход от имени пользователя запрещён",
+		"Invalid scope":                                           "Некорректная область доступа",
+		"invalid scope":                                           "Некорректная область доступа",
+		"scope exceeds the scope of the session":                  "Область доступа шире, чем у сессии",
+		"Insufficient scope":                                      "Недостаточная область доступа",
+		"token scope does not allow this request":                 "Область доступа токена не разрешает этот запрос",
+
+		// user
+		"Invalid user data":       "Некорректные данные пользователя",
+		"User not found":          "Пользователь не найден",
+		"User already anonymized": "Пользователь уже анонимизирован",
+		"Registration disabled":   "Регистрация отключена",
+		"Self-registration is disabled, ask an admin for an account": "Самостоятельная регистрация отключена, обратитесь к администратору за учётной записью",
+		"Email domain not allowed":                                   "Домен email не разрешён",
+		"Registration is not open to this email domain":              "Регистрация для этого домена email закрыта",
+		"Email already in use":                                       "Email уже используется",
+		"User with this email already exists":                        "Пользователь с таким email уже существует",
+		"New password matches the old one":                           "Новый пароль совпадает со старым",
+		"New password must differ from the old one":                  "Новый пароль должен отличаться от старого",
+		"Password does not match":                                    "Пароль не совпадает",
+		"Old password does not match":                                "Старый пароль указан неверно",
+		"Old password is required":                                   "Укажите старый пароль",
+		"Invalid email":                                              "Некорректный email",
+		"Email is too long":                                          "Слишком длинный email",
+		"Name cannot be empty":                                       "Имя не может быть пустым",
+		"Name is too long":                                           "Слишком длинное имя",
+		"Name contains a forbidden character":                        "Имя содержит запрещённый символ",
+		"password is too short":                                      "Пароль слишком короткий",
+		"password is too long":                                       "Пароль слишком длинный",
+		"Display name is too long":                                   "Слишком длинное отображаемое имя",
+		"Bio is too long":                                            "Слишком длинный текст о себе",
+		"Unknown timezone":                                           "Неизвестный часовой пояс",
+		"Invalid locale":                                             "Некорректная локаль",
+		"No profile fields to update":                                "Нет полей профиля для обновления",
+		"Avatar not found":                                           "Аватар не найден",
+		"Avatar image is required":                                   "Требуется изображение аватара",
+		"Avatar image is too large":                                  "Изображение аватара слишком большое",
+		"Avatar must be a PNG, JPEG, GIF or WebP image":              "Аватар должен быть изображением PNG, JPEG, GIF или WebP",
+		"Invalid preferences":                                        "Некорректные настройки пользователя",
+		"Unsupported preferences schema version":                     "Неподдерживаемая версия схемы настроек",
+		"Theme must be system, light or dark":                        "Тема должна быть system, light или dark",
+		"Digest must be none, daily or weekly":                       "Рассылка должна быть none, daily или weekly",
+
+		// entity
+		"Invalid entity":                                                "Некорректная сущность",
+		"Entity not found":                                              "Сущность не найдена",
+		"Parent cycle detected":                                         "Обнаружен цикл родителей",
+		"Maximum hierarchy depth exceeded":                              "Превышена максимальная глубина иерархии",
+		"Entity is locked":                                              "Сущность заблокирована",
+		"Entity is locked by another user":                              "Сущность заблокирована другим пользователем",
+		"Entity is not locked":                                          "Сущность не заблокирована",
+		"Entity has no autosave":                                        "У сущности нет автосохранения",
+		"Cannot create draft for entity with children":                  "Нельзя создать черновик для сущности с дочерними элементами",
+		"Content is too long":                                           "Слишком большое содержимое",
+		"Name already used by a sibling":                                "Название уже занято на этом уровне",
+		"An entity with this name already exists under the same parent": "На этом уровне уже есть сущность с таким названием",
+		"Slug already taken":                                            "Адрес уже занят",
+		"The slug was taken by a concurrent change, please retry":       "Адрес занят параллельным изменением, повторите запрос",
+		"content is too long":                                           "Слишком большое содержимое",
+		"Content links to entities that do not exist":                   "Содержимое ссылается на несуществующие сущности",
+		"name is required":                                              "Укажите название",
+		"name is too long":                                              "Слишком длинное название",
+		"name contains a forbidden character":                           "Название содержит запрещённый символ",
+		"change summary is too long":                                    "Слишком длинное описание изменения",
+		"article must have a parent entity":                             "У статьи должна быть родительская сущность",
+		"parent entity not found":                                       "Родительская сущность не найдена",
+		"version must be positive":                                      "Версия должна быть положительной",
+		"invalid entity type":                                           "Некорректный тип сущности",
+		"invalid parent type":                                           "Некорректный тип родителя",
+		"limit is out of range":                                         "Лимит вне допустимого диапазона",
+		"cursor is malformed":                                           "Некорректный курсор",
+		"Number of IDs is out of range":                                 "Количество идентификаторов вне допустимого диапазона",
+		"IDs must not repeat":                                           "Идентификаторы не должны повторяться",
+		"IDs must be children of the parent":                            "Идентификаторы должны принадлежать дочерним сущностям родителя",
+		"too many default permissions":                                  "Слишком много разрешений по умолчанию",
+		"default role must be read or write":                            "Роль по умолчанию должна быть read или write",
+		"a user can have one default permission":                        "У пользователя может быть только одно разрешение по умолчанию",
+		"user of a default permission not found":                        "Пользователь разрешения по умолчанию не найден",
+		"Snapshot not found":                                            "Снимок не найден",
+		"Snapshot label already used":                                   "Метка снимка уже используется",
+		"A snapshot with this label already exists for the entity":      "У сущности уже есть снимок с такой меткой",
+		"label is required":                                             "Укажите метку",
+		"label is too long":                                             "Слишком длинная метка",
+		"label may only contain letters, digits, ., - and _":            "Метка может содержать только буквы, цифры, ., - и _",
+		"cannot take a snapshot of a draft":                             "Нельзя сделать снимок черновика",
+		"format must be html or pdf":                                    "Формат должен быть html или pdf",
+		"PDF output is not configured":                                  "Вывод в PDF не настроен",
+		"Variable not found":                                            "Переменная не найдена",
+		"key is too long":                                               "Слишком длинный ключ",
+		"key may only contain letters, digits, ., - and _":              "Ключ может содержать только буквы, цифры, ., - и _",
+		"value is too long":                                             "Слишком длинное значение",
+		"too many variables":                                            "Слишком много переменных",
+		"content is too long with its variables":                        "Содержимое с подставленными переменными слишком большое",
+		"render must be html":                                           "Параметр render должен быть html",
+		"content is too long with its includes":                         "Содержимое с включенными сущностями слишком большое",
+		"Entity has no language":                                        "У сущности не указан язык",
+		"Language variant already exists":                               "Языковая версия уже существует",
+		"The document already has a variant in this language":           "У документа уже есть версия на этом языке",
+		"language must be a language tag such as en or pt-BR":           "Язык должен быть тегом языка, например en или pt-BR",
+		"language is not one of the workspace languages":                "Язык не входит в языки рабочего пространства",
+		"an entity cannot be a variant of itself":                       "Сущность не может быть версией самой себя",
+		"the entity is a variant of another document":                   "Сущность является версией другого документа",
+		"No appearance fields to update":                                "Нет полей оформления для обновления",
+		"icon must be an emoji or an attachment key":                    "Иконка должна быть эмодзи или ключом вложения",
+		"no attachment is stored under the key":                         "Вложение с таким ключом не найдено",
+		"cover must be an attachment key or an http or https URL":       "Обложка должна быть ключом вложения или адресом http или https",
+		"Pin limit reached":                                             "Достигнут предел закреплённых сущностей",
+		"The parent already has the maximum number of pinned children":  "У родителя уже закреплено максимальное число дочерних сущностей",
+		"entity must be a child of the parent":                          "Сущность должна быть дочерней для родителя",
+		"only entities without a parent have space settings":            "Настройки пространства есть только у сущностей без родителя",
+		"keep_last_versions and keep_days must not be negative":         "keep_last_versions и keep_days не могут быть отрицательными",
+		"The space requires a change summary":                           "Пространство требует описания изменений",
+		"The space does not allow minor edits":                          "Пространство не допускает незначительных правок",
+		"Move to another space not confirmed":                           "Перемещение в другое пространство не подтверждено",
+		"The entity would move to another space":                        "Сущность переместится в другое пространство",
+		"grants must be keep, strip or remap":                           "grants должно быть keep, strip или remap",
+
+		// presence
+		"Invalid presence message":             "Некорректное сообщение присутствия",
+		"invalid presence state":               "Некорректное состояние присутствия",
+		"invalid message":                      "Некорректное сообщение",
+		"Room is full":                         "Комната заполнена",
+		"Too many clients in this room":        "Слишком много клиентов в этой комнате",
+		"Too many messages":                    "Слишком много сообщений",
+		"Write permission required":            "Требуется право на запись",
+		"Write permission is required to edit": "Для редактирования требуется право на запись",
+
+		// usage
+		"Invalid usage query":           "Некорректный запрос статистики",
+		"Request quota exceeded":        "Превышена квота запросов",
+		"Hourly request quota exceeded": "Превышена часовая квота запросов",
+		"hours is out of range":         "Число часов вне допустимого диапазона",
+
+		// terms
+		"Terms of service not accepted":                       "Условия использования не приняты",
+		"The current terms of service must be accepted first": "Сначала необходимо принять действующие условия использования",
+		"Terms of service version mismatch":                   "Версия условий использования не совпадает",
+		"These are not the current terms of service":          "Это не действующая версия условий использования",
+		"No terms of service":                                 "Условия использования не заданы",
+		"No terms of service are in force":                    "Действующих условий использования нет",
+		"terms cannot be accepted on behalf of a user":        "Нельзя принять условия использования от имени пользователя",
+
+		// attachment
+		"Invalid attachment":                           "Некорректное вложение",
+		"key must be a relative path with a directory": "Ключ должен быть относительным путём с каталогом",
+		"Attachment not found":                         "Вложение не найдено",
+
+		// backup
+		"Backup in progress":          "Идёт резервное копирование",
+		"A backup is already running": "Резервная копия уже создаётся",
+
+		// quarantine
+		"Infected file":                        "Заражённый файл",
+		"The file did not pass the virus scan": "Файл не прошёл антивирусную проверку",
+		"Quarantined upload not found":         "Файл на карантине не найден",
+
+		// feature
+		"Invalid feature flag rule":                 "Некорректное правило флага функции",
+		"Feature flag not found":                    "Флаг функции не найден",
+		"Feature flag not overridden":               "Флаг функции не переопределён",
+		"The feature flag uses its configured rule": "Флаг функции использует правило из конфигурации",
+
+		// token
+		"Token expired":                     "Срок действия токена истёк",
+		"The token has expired, refresh it": "Срок действия токена истёк, обновите его",
+		"Token not yet valid":               "Токен ещё не действителен",
+		"The token is not valid yet":        "Токен ещё не действителен",
+
+		// captcha
+		"CAPTCHA required": "Требуется пройти CAPTCHA",
+		"Solve the CAPTCHA and send its token in the X-Captcha-Token header": "Пройдите CAPTCHA и передайте её токен в заголовке X-Captcha-Token",
+		"CAPTCHA failed":                     "CAPTCHA не пройдена",
+		"The CAPTCHA token was not accepted": "Токен CAPTCHA не принят",
+
+		// invitation
+		"Invalid invitation":                         "Некорректное приглашение",
+		"Invitation not found":                       "Приглашение не найдено",
+		"invitation not found":                       "Приглашение не найдено",
+		"Invitation expired":                         "Срок действия приглашения истёк",
+		"invitation expired":                         "Срок действия приглашения истёк",
+		"Invitation already accepted":                "Приглашение уже принято",
+		"invitation already accepted":                "Приглашение уже принято",
+		"too many invitation roles":                  "Слишком много ролей в приглашении",
+		"an invitation can have one role per entity": "В приглашении может быть только одна роль на сущность",
+		"entity of an invitation role not found":     "Сущность роли из приглашения не найдена",
+
+		// data export
+		"Data export not found":        "Выгрузка данных не найдена",
+		"data export not found":        "Выгрузка данных не найдена",
+		"Data export is not ready yet": "Выгрузка данных ещё не готова",
+		"data export is not ready":     "Выгрузка данных ещё не готова",
+	},
+}
+
+// rules describes violations per rule. The first template whose placeholders all have params wins.
+var rules = map[language.Tag]map[apperr.Rule][]string{
+	language.English: {
+		apperr.RuleRequired:      {"is required"},
+		apperr.RuleTooLong:       {"must not exceed {max_chars} characters", "must not exceed {max}", "must not exceed {max_bytes} bytes", "is too long"},
+		apperr.RuleTooShort:      {"must be at least {min_chars} characters", "must be at least {min}", "is too short"},
+		apperr.RuleCycle:         {"would create a cycle"},
+		apperr.RuleMaxHierarchy:  {"exceeds the maximum depth of {max_depth}", "exceeds the maximum depth"},
+		apperr.RuleInvalidFormat: {"must not contain {char}", "has an invalid format"},
+		apperr.RuleDuplicate:     {"already exists"},
+		apperr.RuleMismatch:      {"does not match"},
+		apperr.RuleForbidden:     {"is not allowed"},
+		apperr.RuleInvalidState:  {"is in an invalid state"},
+		apperr.RuleNotFound:      {"was not found"},
+		apperr.RuleOutOfRange:    {"must be between {min} and {max}", "must not exceed {max}", "must be at least {min}", "is out of range"},
+		apperr.RuleLocked:        {"is locked by {user_id} until {expires_at}", "is locked"},
+	},
+	language.Russian: {
+		apperr.RuleRequired:      {"обязательное поле"},
+		apperr.RuleTooLong:       {"не должно превышать {max_chars} символов", "не должно превышать {max}", "не должно превышать {max_bytes} байт", "слишком длинное значение"},
+		apperr.RuleTooShort:      {"должно содержать не меньше {min_chars} символов", "должно быть не меньше {min}", "слишком короткое значение"},
+		apperr.RuleCycle:         {"создаёт цикл"},
+		apperr.RuleMaxHierarchy:  {"превышает максимальную глубину {max_depth}", "превышает максимальную глубину"},
+		apperr.RuleInvalidFormat: {"не должно содержать {char}", "неверный формат"},
+		apperr.RuleDuplicate:     {"уже существует"},
+		apperr.RuleMismatch:      {"не совпадает"},
+		apperr.RuleForbidden:     {"не разрешено"},
+		apperr.RuleInvalidState:  {"недопустимое состояние"},
+		apperr.RuleNotFound:      {"не найдено"},
+		apperr.RuleOutOfRange:    {"должно быть от {min} до {max}", "не должно превышать {max}", "должно быть не меньше {min}", "вне допустимого диапазона"},
+		apperr.RuleLocked:        {"заблокировано пользователем {user_id} до {expires_at}", "заблокировано"},
+	},
+}
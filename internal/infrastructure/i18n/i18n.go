@@ -0,0 +1,38 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// Supported lists the languages that have a message catalog. The first one is the fallback
+// and the language the messages are written in.
+var Supported = []language.Tag{language.English, language.Russian}
+
+var matcher = language.NewMatcher(Supported)
+
+type languageKey struct{}
+
+func WithLanguage(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, languageKey{}, tag)
+}
+
+// Language returns the negotiated language, or the fallback if none was set.
+func Language(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(languageKey{}).(language.Tag); ok {
+		return tag
+	}
+	return Supported[0]
+}
+
+// Negotiate picks the best supported language for an Accept-Language header value.
+func Negotiate(acceptLanguage string) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Supported[0]
+	}
+	_, idx, _ := matcher.Match(tags...)
+
+	return Supported[idx]
+}
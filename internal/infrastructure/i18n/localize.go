@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"golang.org/x/text/language"
+)
+
+// Translate returns msg in the given language. Messages are keyed by their English text;
+// a message without a translation is returned as is.
+func Translate(tag language.Tag, msg string) string {
+	if translated, ok := messages[tag][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// LocalizeProblem translates the title and detail of p into the language from ctx and
+// describes every violation in that language. Transports call it right before writing the problem.
+func LocalizeProblem(ctx context.Context, p *apperr.Problem) {
+	tag := Language(ctx)
+	p.Title = Translate(tag, p.Title)
+	p.Detail = Translate(tag, p.Detail)
+
+	// the violations are shared with the error value
+	p.Violations = slices.Clone(p.Violations)
+	for i := range p.Violations {
+		p.Violations[i].Message = describe(tag, p.Violations[i])
+	}
+}
+
+// describe renders the first rule template whose placeholders are all covered by the violation params.
+func describe(tag language.Tag, v apperr.Violation) string {
+	templates, ok := rules[tag][v.Rule]
+	if !ok {
+		templates = rules[Supported[0]][v.Rule]
+	}
+	for _, tmpl := range templates {
+		if msg, ok := render(tmpl, v.Params); ok {
+			return msg
+		}
+	}
+	return ""
+}
+
+func render(tmpl string, params map[string]any) (string, bool) {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			b.WriteString(tmpl)
+			return b.String(), true
+		}
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			b.WriteString(tmpl)
+			return b.String(), true
+		}
+		value, ok := params[tmpl[start+1:start+end]]
+		if !ok {
+			return "", false
+		}
+		b.WriteString(tmpl[:start])
+		b.WriteString(formatParam(value))
+		tmpl = tmpl[start+end+1:]
+	}
+}
+
+func formatParam(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprint(value)
+}
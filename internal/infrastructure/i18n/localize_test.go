@@ -0,0 +1,144 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
+	"github.com/66gu1/easygodocs/internal/infrastructure/i18n"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header string
+		want   language.Tag
+	}{
+		{name: "empty", header: "", want: language.English},
+		{name: "russian", header: "ru", want: language.Russian},
+		{name: "regional variant", header: "ru-RU,ru;q=0.9,en-US;q=0.8", want: language.Russian},
+		{name: "preference order", header: "de-DE,en;q=0.9,ru;q=0.5", want: language.English},
+		{name: "unsupported", header: "fr-FR", want: language.English},
+		{name: "malformed", header: ";;;q=x", want: language.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, i18n.Negotiate(tt.header))
+		})
+	}
+}
+
+func TestLocalizeProblem(t *testing.T) {
+	t.Parallel()
+
+	userID := "4b0a6c5e-0000-4000-8000-000000000000"
+	expiresAt := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	violations := []apperr.Violation{
+		{Field: "name", Rule: apperr.RuleTooLong, Params: map[string]any{"max": 255}},
+		{Field: "avatar", Rule: apperr.RuleTooLong, Params: map[string]any{"max_bytes": 1024}},
+		{Field: "password", Rule: apperr.RuleTooShort, Params: map[string]any{"min_chars": 8}},
+		{Field: "limit", Rule: apperr.RuleOutOfRange, Params: map[string]any{"min": 1, "max": 100}},
+		{Field: "entity_id", Rule: apperr.RuleLocked, Params: map[string]any{"user_id": userID, "expires_at": expiresAt}},
+		{Field: "email", Rule: "unknown_rule"},
+	}
+
+	tests := []struct {
+		name     string
+		lang     *language.Tag
+		detail   string
+		want     string
+		messages []string
+	}{
+		{
+			name:   "default language",
+			detail: "Entity not found",
+			want:   "Entity not found",
+			messages: []string{
+				"must not exceed 255",
+				"must not exceed 1024 bytes",
+				"must be at least 8 characters",
+				"must be between 1 and 100",
+				"is locked by " + userID + " until 2025-09-01T12:00:00Z",
+				"",
+			},
+		},
+		{
+			name:   "russian",
+			lang:   &language.Russian,
+			detail: "Entity not found",
+			want:   "Сущность не найдена",
+			messages: []string{
+				"не должно превышать 255",
+				"не должно превышать 1024 байт",
+				"должно содержать не меньше 8 символов",
+				"должно быть от 1 до 100",
+				"заблокировано пользователем " + userID + " до 2025-09-01T12:00:00Z",
+				"",
+			},
+		},
+		{
+			name:   "untranslated detail is kept",
+			lang:   &language.Russian,
+			detail: "Invalid settings: unknown key",
+			want:   "Invalid settings: unknown key",
+			messages: []string{
+				"не должно превышать 255",
+				"не должно превышать 1024 байт",
+				"должно содержать не меньше 8 символов",
+				"должно быть от 1 до 100",
+				"заблокировано пользователем " + userID + " до 2025-09-01T12:00:00Z",
+				"",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.lang != nil {
+				ctx = i18n.WithLanguage(ctx, *tt.lang)
+			}
+			problem := apperr.Problem{Title: tt.detail, Detail: tt.detail, Violations: violations}
+			i18n.LocalizeProblem(ctx, &problem)
+
+			require.Equal(t, tt.want, problem.Title)
+			require.Equal(t, tt.want, problem.Detail)
+			require.Len(t, problem.Violations, len(tt.messages))
+			for i, msg := range tt.messages {
+				require.Equal(t, msg, problem.Violations[i].Message)
+			}
+			// the error's own violations stay untouched
+			for _, v := range violations {
+				require.Empty(t, v.Message)
+			}
+		})
+	}
+}
+
+func TestRules_Complete(t *testing.T) {
+	t.Parallel()
+
+	rules := []apperr.Rule{
+		apperr.RuleRequired, apperr.RuleTooLong, apperr.RuleCycle, apperr.RuleMaxHierarchy,
+		apperr.RuleInvalidFormat, apperr.RuleTooShort, apperr.RuleDuplicate, apperr.RuleMismatch,
+		apperr.RuleForbidden, apperr.RuleInvalidState, apperr.RuleNotFound, apperr.RuleOutOfRange,
+		apperr.RuleLocked,
+	}
+	for _, tag := range i18n.Supported {
+		ctx := i18n.WithLanguage(context.Background(), tag)
+		for _, rule := range rules {
+			problem := apperr.Problem{Violations: []apperr.Violation{{Field: "field", Rule: rule}}}
+			i18n.LocalizeProblem(ctx, &problem)
+			require.NotEmpty(t, problem.Violations[0].Message, "%s: %s", tag, rule)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/google/uuid"
+)
+
+type keyModel struct {
+	UserID      uuid.UUID `gorm:"primaryKey"`
+	Key         string    `gorm:"primaryKey"`
+	RequestHash string
+	StatusCode  *int
+	ContentType *string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (m *keyModel) TableName() string {
+	return "idempotency_keys"
+}
+
+func (m *keyModel) toDTO() httpx.IdempotencyRecord {
+	var (
+		status      int
+		contentType string
+	)
+	if m.StatusCode != nil {
+		status = *m.StatusCode
+	}
+	if m.ContentType != nil {
+		contentType = *m.ContentType
+	}
+
+	return httpx.IdempotencyRecord{
+		RequestHash: m.RequestHash,
+		StatusCode:  status,
+		ContentType: contentType,
+		Body:        m.Body,
+	}
+}
@@ -0,0 +1,123 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	TTLMinutes int `mapstructure:"ttl_minutes" json:"ttl_minutes"`
+}
+
+func (c Config) Validate() error {
+	if c.TTLMinutes <= 0 {
+		return fmt.Errorf("ttl_minutes must be positive")
+	}
+
+	return nil
+}
+
+func (c Config) TTL() time.Duration {
+	return time.Duration(c.TTLMinutes) * time.Minute
+}
+
+// gormRepo stores idempotency records for httpx.Idempotency. Expiry is checked against database time.
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) (*gormRepo, error) {
+	if db == nil {
+		return nil, errors.New("db is nil")
+	}
+	return &gormRepo{db: db}, nil
+}
+
+// Reserve inserts an in-progress record or takes over an expired one.
+func (r *gormRepo) Reserve(ctx context.Context, userID uuid.UUID, key, requestHash string, ttl time.Duration) (httpx.IdempotencyRecord, bool, error) {
+	const upsert = `
+INSERT INTO idempotency_keys (user_id, key, request_hash, created_at, expires_at)
+VALUES (@user_id, @key, @request_hash, NOW(), NOW() + make_interval(secs => @ttl_seconds))
+ON CONFLICT (user_id, key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code  = NULL,
+    content_type = NULL,
+    body         = NULL,
+    created_at   = EXCLUDED.created_at,
+    expires_at   = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+RETURNING user_id, key, request_hash, created_at, expires_at
+`
+	var (
+		models   []keyModel
+		reserved bool
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(upsert, map[string]any{
+			"user_id":      userID,
+			"key":          key,
+			"request_hash": requestHash,
+			"ttl_seconds":  ttl.Seconds(),
+		}).Scan(&models).Error
+		if err != nil {
+			return err
+		}
+		if len(models) > 0 {
+			reserved = true
+			return nil
+		}
+
+		return tx.Where("user_id = ? AND key = ? AND expires_at > NOW()", userID, key).Limit(1).Find(&models).Error
+	})
+	if err != nil {
+		return httpx.IdempotencyRecord{}, false, fmt.Errorf("gormRepo.Reserve: %w", err)
+	}
+	if len(models) == 0 {
+		// released between the two statements
+		return httpx.IdempotencyRecord{}, false, fmt.Errorf("gormRepo.Reserve: %w", errors.New("record released concurrently"))
+	}
+
+	return models[0].toDTO(), reserved, nil
+}
+
+func (r *gormRepo) Complete(ctx context.Context, userID uuid.UUID, key string, rec httpx.IdempotencyRecord) error {
+	res := r.db.WithContext(ctx).Model(&keyModel{}).
+		Where("user_id = ? AND key = ? AND request_hash = ?", userID, key, rec.RequestHash).
+		Updates(map[string]any{
+			"status_code":  rec.StatusCode,
+			"content_type": rec.ContentType,
+			"body":         rec.Body,
+		})
+	if res.Error != nil {
+		return fmt.Errorf("gormRepo.Complete: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("gormRepo.Complete: %w", errors.New("record not found"))
+	}
+
+	return nil
+}
+
+func (r *gormRepo) Release(ctx context.Context, userID uuid.UUID, key string) error {
+	err := r.db.WithContext(ctx).Where("user_id = ? AND key = ?", userID, key).Delete(&keyModel{}).Error
+	if err != nil {
+		return fmt.Errorf("gormRepo.Release: %w", err)
+	}
+
+	return nil
+}
+
+func (r *gormRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	res := r.db.WithContext(ctx).Where("expires_at <= NOW()").Delete(&keyModel{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("gormRepo.DeleteExpired: %w", res.Error)
+	}
+
+	return res.RowsAffected, nil
+}
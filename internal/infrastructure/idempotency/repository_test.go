@@ -0,0 +1,93 @@
+package idempotency
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/db"
+	"github.com/66gu1/easygodocs/internal/infrastructure/httpx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var shared *db.TestDB
+
+func TestMain(m *testing.M) {
+	var stop func()
+	shared, stop = db.StartPostgres()
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func newRepo(t *testing.T) (*gormRepo, *gorm.DB, func()) {
+	gdb, _, cleanup := shared.CreateIsolatedDB(t)
+	t.Cleanup(cleanup)
+	repo, err := NewRepository(gdb)
+	require.NoError(t, err)
+	return repo, gdb, cleanup
+}
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+	_, err := NewRepository(nil)
+	require.Error(t, err)
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+	repo, gdb, cleanup := newRepo(t)
+	ctx := t.Context()
+	userID, otherID := uuid.New(), uuid.New()
+
+	// first reservation wins, the second sees it in progress
+	rec, reserved, err := repo.Reserve(ctx, userID, "k", "h1", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Equal(t, httpx.IdempotencyRecord{RequestHash: "h1"}, rec)
+	rec, reserved, err = repo.Reserve(ctx, userID, "k", "h2", time.Hour)
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, httpx.IdempotencyRecord{RequestHash: "h1"}, rec)
+
+	// keys are scoped per user
+	_, reserved, err = repo.Reserve(ctx, otherID, "k", "h1", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	done := httpx.IdempotencyRecord{RequestHash: "h1", StatusCode: 201, ContentType: "application/json", Body: []byte(`{"id":1}`)}
+	require.NoError(t, repo.Complete(ctx, userID, "k", done))
+	rec, reserved, err = repo.Reserve(ctx, userID, "k", "h1", time.Hour)
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, done, rec)
+	require.Error(t, repo.Complete(ctx, userID, "missing", done))
+
+	// released keys can be reserved again
+	require.NoError(t, repo.Release(ctx, otherID, "k"))
+	_, reserved, err = repo.Reserve(ctx, otherID, "k", "h3", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	// expired records are taken over and cleaned up
+	require.NoError(t, gdb.Exec(`UPDATE idempotency_keys SET expires_at = NOW() - INTERVAL '1 minute' WHERE user_id = ?`, userID).Error)
+	rec, reserved, err = repo.Reserve(ctx, userID, "k", "h4", time.Hour)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Equal(t, httpx.IdempotencyRecord{RequestHash: "h4"}, rec)
+	require.NoError(t, gdb.Exec(`UPDATE idempotency_keys SET expires_at = NOW() - INTERVAL '1 minute' WHERE user_id = ?`, otherID).Error)
+	n, err := repo.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	// pool closed error
+	cleanup()
+	_, _, err = repo.Reserve(ctx, userID, "k", "h1", time.Hour)
+	require.Error(t, err)
+	require.Error(t, repo.Complete(ctx, userID, "k", done))
+	require.Error(t, repo.Release(ctx, userID, "k"))
+	_, err = repo.DeleteExpired(ctx)
+	require.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Job is a task run periodically by Runner.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Runner runs registered jobs on their own tickers until the context passed to Start is done.
+// A run that is still in progress when the next tick fires delays that tick instead of overlapping.
+type Runner struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// Add registers a job. It must be called before Start.
+func (r *Runner) Add(job Job) error {
+	if job.Name == "" || job.Run == nil || job.Interval <= 0 {
+		return fmt.Errorf("jobs.Runner.Add: invalid job %q", job.Name)
+	}
+	r.jobs = append(r.jobs, job)
+
+	return nil
+}
+
+func (r *Runner) Start(ctx context.Context) {
+	for _, job := range r.jobs {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.loop(ctx, job)
+		}()
+	}
+}
+
+// Wait blocks until every job has stopped after the context was done.
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+func (r *Runner) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			started := time.Now()
+			if err := job.Run(ctx); err != nil {
+				log.Error().Err(err).Str("job", job.Name).Msg("jobs.Runner: job failed")
+				continue
+			}
+			log.Debug().Str("job", job.Name).Dur("took", time.Since(started)).Msg("jobs.Runner: job finished")
+		}
+	}
+}
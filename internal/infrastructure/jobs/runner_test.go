@@ -0,0 +1,38 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/jobs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	var ok, failing atomic.Int32
+	r := jobs.NewRunner()
+	require.Error(t, r.Add(jobs.Job{Name: "no interval", Run: func(context.Context) error { return nil }}))
+	require.NoError(t, r.Add(jobs.Job{Name: "ok", Interval: time.Millisecond, Run: func(context.Context) error {
+		ok.Add(1)
+		return nil
+	}}))
+	require.NoError(t, r.Add(jobs.Job{Name: "failing", Interval: time.Millisecond, Run: func(context.Context) error {
+		failing.Add(1)
+		return errors.New("boom")
+	}}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	r.Start(ctx)
+	require.Eventually(t, func() bool { return ok.Load() >= 3 && failing.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+	r.Wait()
+
+	stopped := ok.Load()
+	time.Sleep(5 * time.Millisecond)
+	require.Equal(t, stopped, ok.Load())
+}
@@ -0,0 +1,141 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// searchSizeLimit of two entries tells an ambiguous login apart.
+const searchSizeLimit = 2
+
+// Client authenticates against an LDAP server with one connection per sign-in: the service account
+// searches the entry of the login, then the entry binds with the password.
+type Client struct {
+	cfg     Config
+	addr    string
+	host    string
+	ldaps   bool
+	timeout time.Duration
+	dialer  net.Dialer
+	// rootCAs verifies the server certificate; nil uses the system roots.
+	rootCAs *x509.CertPool
+}
+
+func NewClient(cfg Config) *Client {
+	u, _ := url.Parse(cfg.URL)
+	ldaps := u.Scheme == "ldaps"
+	port := u.Port()
+	if port == "" {
+		port = goldap.DefaultLdapPort
+		if ldaps {
+			port = goldap.DefaultLdapsPort
+		}
+	}
+
+	return &Client{
+		cfg:     cfg,
+		addr:    net.JoinHostPort(u.Hostname(), port),
+		host:    u.Hostname(),
+		ldaps:   ldaps,
+		timeout: time.Duration(cfg.TimeoutSeconds) * time.Second,
+	}
+}
+
+func (c *Client) Authenticate(ctx context.Context, login string, password []byte) (Identity, error) {
+	// an empty password makes an unauthenticated bind, which servers accept without checking anything
+	if login == "" || len(password) == 0 {
+		return Identity{}, fmt.Errorf("ldap.Client.Authenticate: %w", ErrInvalidCredentials)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	netConn, err := c.dial(ctx)
+	if err != nil {
+		return Identity{}, fmt.Errorf("ldap.Client.Authenticate: %w", err)
+	}
+	conn := goldap.NewConn(netConn, c.ldaps)
+	conn.Start()
+	conn.SetTimeout(c.timeout)
+	stop := context.AfterFunc(ctx, func() { _ = netConn.SetDeadline(time.Now()) })
+	defer stop()
+	// unbinds, as servers expect before the connection ends
+	defer func() { _ = conn.Unbind() }()
+
+	identity, err := c.authenticate(conn, login, password)
+	if err != nil {
+		return identity, fmt.Errorf("ldap.Client.Authenticate: %w", err)
+	}
+
+	return identity, nil
+}
+
+func (c *Client) dial(ctx context.Context) (net.Conn, error) {
+	if c.ldaps {
+		d := tls.Dialer{NetDialer: &c.dialer, Config: c.tlsConfig()}
+		return d.DialContext(ctx, "tcp", c.addr)
+	}
+
+	return c.dialer.DialContext(ctx, "tcp", c.addr)
+}
+
+func (c *Client) tlsConfig() *tls.Config {
+	return &tls.Config{ServerName: c.host, RootCAs: c.rootCAs, MinVersion: tls.VersionTLS12}
+}
+
+func (c *Client) authenticate(conn *goldap.Conn, login string, password []byte) (Identity, error) {
+	if c.cfg.StartTLS {
+		if err := conn.StartTLS(c.tlsConfig()); err != nil {
+			return Identity{}, fmt.Errorf("start TLS: %w", err)
+		}
+	}
+	if c.cfg.BindDN != "" {
+		if err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			return Identity{}, fmt.Errorf("bind as %s: %w", c.cfg.BindDN, err)
+		}
+	}
+
+	attributes := []string{c.cfg.Attributes.Email}
+	if c.cfg.Attributes.Name != "" {
+		attributes = append(attributes, c.cfg.Attributes.Name)
+	}
+	// referrals to other servers are not followed
+	req := goldap.NewSearchRequest(c.cfg.BaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		searchSizeLimit, int(c.timeout.Seconds()), false,
+		fmt.Sprintf("(%s=%s)", c.cfg.LoginAttribute, goldap.EscapeFilter(login)), attributes, nil)
+	result, err := conn.Search(req)
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return Identity{}, fmt.Errorf("search: %w", err)
+	}
+	switch {
+	case len(result.Entries) == 0:
+		return Identity{}, ErrNotFound
+	case len(result.Entries) > 1:
+		return Identity{}, fmt.Errorf("more than one entry has %s=%s", c.cfg.LoginAttribute, login)
+	}
+
+	// attribute names are case-insensitive
+	entry := result.Entries[0]
+	identity := Identity{
+		DN:    entry.DN,
+		Email: entry.GetEqualFoldAttributeValue(c.cfg.Attributes.Email),
+		Name:  entry.GetEqualFoldAttributeValue(c.cfg.Attributes.Name),
+	}
+	if identity.Email == "" {
+		return Identity{}, fmt.Errorf("entry %s has no %s", entry.DN, c.cfg.Attributes.Email)
+	}
+	if err = conn.Bind(entry.DN, string(password)); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return identity, ErrInvalidCredentials
+		}
+		return Identity{}, fmt.Errorf("bind as %s: %w", entry.DN, err)
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,190 @@
+package ldap
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEntry struct {
+	password   string
+	attributes map[string][]string
+}
+
+// fakeLDAP answers simple binds and equality searches over the entries, keyed by DN, until the
+// test ends. Attribute names are matched exactly.
+func fakeLDAP(t *testing.T, entries map[string]fakeEntry) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveLDAP(conn, entries)
+		}
+	}()
+
+	return "ldap://" + ln.Addr().String()
+}
+
+func serveLDAP(conn net.Conn, entries map[string]fakeEntry) {
+	defer conn.Close()
+	reply := func(id int64, op *ber.Packet) {
+		msg := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+		msg.AppendChild(op)
+		_, _ = conn.Write(msg.Bytes())
+	}
+	result := func(tag ber.Tag, code int) *ber.Packet {
+		op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+		op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+		return op
+	}
+	octetString := func(v string) *ber.Packet {
+		return ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "")
+	}
+
+	for {
+		msg, err := ber.ReadPacket(conn)
+		if err != nil || len(msg.Children) < 2 {
+			return
+		}
+		id, _ := msg.Children[0].Value.(int64)
+		op := msg.Children[1]
+
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			e, ok := entries[op.Children[1].Data.String()]
+			code := goldap.LDAPResultSuccess
+			if !ok || e.password != op.Children[2].Data.String() {
+				code = goldap.LDAPResultInvalidCredentials
+			}
+			reply(id, result(goldap.ApplicationBindResponse, code))
+		case goldap.ApplicationSearchRequest:
+			base := op.Children[0].Data.String()
+			filter := op.Children[6].Children
+			for dn, e := range entries {
+				if !strings.HasSuffix(dn, base) || first(e.attributes[filter[0].Data.String()]) != filter[1].Data.String() {
+					continue
+				}
+				found := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "")
+				found.AppendChild(octetString(dn))
+				attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+				for name, values := range e.attributes {
+					attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+					attribute.AppendChild(octetString(name))
+					set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+					for _, v := range values {
+						set.AppendChild(octetString(v))
+					}
+					attribute.AppendChild(set)
+					attributes.AppendChild(attribute)
+				}
+				found.AppendChild(attributes)
+				reply(id, found)
+			}
+			reply(id, result(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+		default:
+			return
+		}
+	}
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func TestClient_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	url := fakeLDAP(t, map[string]fakeEntry{
+		"cn=svc,dc=example,dc=org": {password: "svc-secret"},
+		"uid=ann,ou=people,dc=example,dc=org": {password: "ann-secret", attributes: map[string][]string{
+			"uid": {"ann"}, "mail": {"ann@example.org"}, "displayName": {"Ann Lee"},
+		}},
+		"uid=bob,ou=people,dc=example,dc=org": {password: "bob-secret", attributes: map[string][]string{
+			"uid": {"bob"},
+		}},
+		"uid=dup1,ou=people,dc=example,dc=org": {password: "x", attributes: map[string][]string{"uid": {"dup"}, "mail": {"a@example.org"}}},
+		"uid=dup2,ou=people,dc=example,dc=org": {password: "x", attributes: map[string][]string{"uid": {"dup"}, "mail": {"b@example.org"}}},
+	})
+	cfg := Config{
+		URL:            url,
+		BindDN:         "cn=svc,dc=example,dc=org",
+		BindPassword:   "svc-secret",
+		BaseDN:         "ou=people,dc=example,dc=org",
+		LoginAttribute: "uid",
+		Attributes:     AttributesConfig{Email: "mail", Name: "displayName"},
+		TimeoutSeconds: 5,
+	}
+	c := NewClient(cfg)
+
+	identity, err := c.Authenticate(t.Context(), "ann", []byte("ann-secret"))
+	require.NoError(t, err)
+	require.Equal(t, Identity{DN: "uid=ann,ou=people,dc=example,dc=org", Email: "ann@example.org", Name: "Ann Lee"}, identity)
+
+	identity, err = c.Authenticate(t.Context(), "ann", []byte("wrong"))
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	require.Equal(t, "ann@example.org", identity.Email)
+
+	_, err = c.Authenticate(t.Context(), "ann", nil)
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = c.Authenticate(t.Context(), "eve", []byte("secret"))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// no email attribute
+	_, err = c.Authenticate(t.Context(), "bob", []byte("bob-secret"))
+	require.ErrorContains(t, err, "has no mail")
+
+	_, err = c.Authenticate(t.Context(), "dup", []byte("x"))
+	require.ErrorContains(t, err, "more than one entry")
+
+	cfg.BindPassword = "wrong"
+	_, err = NewClient(cfg).Authenticate(t.Context(), "ann", []byte("ann-secret"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	d, err := New(Config{})
+	require.NoError(t, err)
+	_, err = d.Authenticate(t.Context(), "ann", []byte("secret"))
+	require.ErrorIs(t, err, ErrDisabled)
+
+	valid := Config{URL: "ldaps://ldap.example.org", BaseDN: "dc=example,dc=org", LoginAttribute: "mail",
+		Attributes: AttributesConfig{Email: "mail"}, TimeoutSeconds: 5}
+	d, err = New(valid)
+	require.NoError(t, err)
+	require.Equal(t, "ldap.example.org:636", d.(*Client).addr)
+
+	for _, mutate := range []func(c *Config){
+		func(c *Config) { c.URL = "http://ldap.example.org" },
+		func(c *Config) { c.StartTLS = true },
+		func(c *Config) { c.BindDN = "cn=svc" },
+		func(c *Config) { c.BaseDN = "" },
+		func(c *Config) { c.Attributes.Email = "" },
+		func(c *Config) { c.TimeoutSeconds = 0 },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		_, err = New(cfg)
+		require.Error(t, err)
+	}
+}
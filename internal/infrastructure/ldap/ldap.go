@@ -0,0 +1,105 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+var (
+	// ErrDisabled is returned by Noop, so callers can fall back to local passwords.
+	ErrDisabled = errors.New("ldap: no directory is configured")
+	// ErrNotFound means the directory has no entry for the login.
+	ErrNotFound = errors.New("ldap: no such login")
+	// ErrInvalidCredentials means the entry exists but the password is wrong.
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+)
+
+// Identity is the directory entry a login signed in as.
+type Identity struct {
+	DN    string
+	Email string
+	Name  string
+}
+
+// Directory checks a login and password against a directory. With ErrInvalidCredentials the
+// identity of the entry is returned too, so the failed attempt can be recorded.
+type Directory interface {
+	Authenticate(ctx context.Context, login string, password []byte) (Identity, error)
+}
+
+type Config struct {
+	// URL is ldap://host[:port] or ldaps://host[:port]; empty disables the directory.
+	URL string `mapstructure:"url" json:"url"`
+	// StartTLS upgrades an ldap:// connection before anything is sent.
+	StartTLS bool `mapstructure:"start_tls" json:"start_tls"`
+	// BindDN and BindPassword are the service account entries are searched with; empty binds
+	// anonymously.
+	BindDN       string `mapstructure:"bind_dn" json:"bind_dn"`
+	BindPassword string `mapstructure:"bind_password" json:"-"`
+	BaseDN       string `mapstructure:"base_dn" json:"base_dn"`
+	// LoginAttribute is matched against what users type as their login, e.g. mail, uid or
+	// sAMAccountName.
+	LoginAttribute string           `mapstructure:"login_attribute" json:"login_attribute"`
+	Attributes     AttributesConfig `mapstructure:"attributes" json:"attributes"`
+	TimeoutSeconds int              `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+	// LocalFallback lets logins the directory does not have sign in with their local password.
+	LocalFallback bool `mapstructure:"local_fallback" json:"local_fallback"`
+	// LinkExistingUsers lets the directory sign in a local user with the same email, except an admin;
+	// otherwise such sign-ins are refused.
+	LinkExistingUsers bool `mapstructure:"link_existing_users" json:"link_existing_users"`
+}
+
+// AttributesConfig maps entry attributes to user fields. Without a name the local part of the email
+// is used.
+type AttributesConfig struct {
+	Email string `mapstructure:"email" json:"email"`
+	Name  string `mapstructure:"name" json:"name"`
+}
+
+func (c Config) Validate() error {
+	if c.URL == "" {
+		return nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Hostname() == "" {
+		return fmt.Errorf("url must be ldap://host or ldaps://host")
+	}
+	if c.StartTLS && u.Scheme == "ldaps" {
+		return fmt.Errorf("start_tls only applies to ldap:// urls")
+	}
+	if c.BindDN != "" && c.BindPassword == "" {
+		return fmt.Errorf("bind_password must be set with bind_dn")
+	}
+	if c.BaseDN == "" {
+		return fmt.Errorf("base_dn must be set with a url")
+	}
+	if c.LoginAttribute == "" || c.Attributes.Email == "" {
+		return fmt.Errorf("login_attribute and attributes.email must not be empty")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+
+	return nil
+}
+
+// New returns the directory the config chooses: Client, or Noop without a URL.
+func New(cfg Config) (Directory, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("ldap.New: %w", err)
+	}
+	if cfg.URL == "" {
+		return Noop{}, nil
+	}
+
+	return NewClient(cfg), nil
+}
+
+// Noop has no entries and returns ErrDisabled.
+type Noop struct{}
+
+func (Noop) Authenticate(context.Context, string, []byte) (Identity, error) {
+	return Identity{}, ErrDisabled
+}
@@ -0,0 +1,92 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// maxResponseBytes bounds what is read from the server; a long document with many issues stays well below.
+	maxResponseBytes = 8 << 20
+	// maxReplacements caps the suggestions kept per match; LanguageTool may offer dozens for a misspelling.
+	maxReplacements = 5
+)
+
+// LanguageTool checks texts with the /v2/check endpoint of a LanguageTool server.
+type LanguageTool struct {
+	url      string
+	language string
+	client   *http.Client
+}
+
+// NewLanguageTool returns a client of the server at baseURL. language is used when Lint is given none.
+func NewLanguageTool(baseURL, language string, client *http.Client) *LanguageTool {
+	return &LanguageTool{url: strings.TrimSuffix(baseURL, "/") + "/v2/check", language: language, client: client}
+}
+
+type languageToolResponse struct {
+	Matches []struct {
+		Message      string `json:"message"`
+		Offset       int    `json:"offset"`
+		Length       int    `json:"length"`
+		Replacements []struct {
+			Value string `json:"value"`
+		} `json:"replacements"`
+		Rule struct {
+			ID        string `json:"id"`
+			IssueType string `json:"issueType"`
+			Category  struct {
+				ID string `json:"id"`
+			} `json:"category"`
+		} `json:"rule"`
+	} `json:"matches"`
+}
+
+func (l *LanguageTool) Lint(ctx context.Context, text, language string) ([]Match, error) {
+	if language == "" {
+		language = l.language
+	}
+	form := url.Values{"text": {text}, "language": {language}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, l.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("lint.LanguageTool.Lint: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("lint.LanguageTool.Lint: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("lint.LanguageTool.Lint: %w", fmt.Errorf("unexpected status %d", resp.StatusCode))
+	}
+
+	var out languageToolResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&out); err != nil {
+		return nil, fmt.Errorf("lint.LanguageTool.Lint: %w", err)
+	}
+	matches := make([]Match, 0, len(out.Matches))
+	for _, m := range out.Matches {
+		match := Match{
+			Offset:    m.Offset,
+			Length:    m.Length,
+			Message:   m.Message,
+			Rule:      m.Rule.ID,
+			Category:  m.Rule.Category.ID,
+			IssueType: m.Rule.IssueType,
+		}
+		for _, r := range m.Replacements[:min(len(m.Replacements), maxReplacements)] {
+			match.Replacements = append(match.Replacements, r.Value)
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguageTool_Lint(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v2/check", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		switch r.PostForm.Get("text") {
+		case "Thiss is fine.":
+			require.Equal(t, "en-US", r.PostForm.Get("language"))
+			_, _ = w.Write([]byte(`{"matches":[{"message":"Possible spelling mistake found.","offset":0,"length":5,
+				"replacements":[{"value":"This"},{"value":"Thins"},{"value":"Thesis"},{"value":"Thus"},{"value":"Tiss"},{"value":"Hiss"}],
+				"rule":{"id":"MORFOLOGIK_RULE_EN_US","issueType":"misspelling","category":{"id":"TYPOS"}}}]}`))
+		case "Fine.":
+			require.Equal(t, "auto", r.PostForm.Get("language"))
+			_, _ = w.Write([]byte(`{"matches":[]}`))
+		case "garbled":
+			_, _ = w.Write([]byte(`{"matches":`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	l := NewLanguageTool(srv.URL+"/", "auto", srv.Client())
+
+	matches, err := l.Lint(t.Context(), "Thiss is fine.", "en-US")
+	require.NoError(t, err)
+	require.Equal(t, []Match{{
+		Offset:       0,
+		Length:       5,
+		Message:      "Possible spelling mistake found.",
+		Rule:         "MORFOLOGIK_RULE_EN_US",
+		Category:     "TYPOS",
+		IssueType:    "misspelling",
+		Replacements: []string{"This", "Thins", "Thesis", "Thus", "Tiss"},
+	}}, matches)
+
+	matches, err = l.Lint(t.Context(), "Fine.", "")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	_, err = l.Lint(t.Context(), "garbled", "en")
+	require.Error(t, err)
+	_, err = l.Lint(t.Context(), "down", "en")
+	require.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	l, err := New(Config{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, Noop{}, l)
+
+	l, err = New(Config{LanguageToolURL: "http://languagetool:8010", Language: "auto", TimeoutSeconds: 10, IntervalSeconds: 30}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "http://languagetool:8010/v2/check", l.(*LanguageTool).url)
+
+	for _, cfg := range []Config{
+		{LanguageToolURL: "languagetool:8010", Language: "auto", TimeoutSeconds: 10, IntervalSeconds: 30},
+		{LanguageToolURL: "http://languagetool:8010", TimeoutSeconds: 10, IntervalSeconds: 30},
+		{LanguageToolURL: "http://languagetool:8010", Language: "auto", IntervalSeconds: 30},
+		{LanguageToolURL: "http://languagetool:8010", Language: "auto", TimeoutSeconds: 10},
+	} {
+		_, err = New(cfg, nil)
+		require.Error(t, err)
+	}
+}
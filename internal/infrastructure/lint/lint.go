@@ -0,0 +1,83 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Match is an issue a linter found in a text. Offset and Length locate it in the text as the backend
+// counts characters.
+type Match struct {
+	Offset  int
+	Length  int
+	Message string
+	// Rule names the check that matched; Category and IssueType group checks, e.g. TYPOS and misspelling.
+	Rule         string
+	Category     string
+	IssueType    string
+	Replacements []string
+}
+
+// Linter checks a text for spelling, grammar and style issues.
+type Linter interface {
+	Lint(ctx context.Context, text, language string) ([]Match, error)
+}
+
+type Config struct {
+	// LanguageToolURL is the base URL of a LanguageTool server, e.g. http://languagetool:8010; empty
+	// disables linting.
+	LanguageToolURL string `mapstructure:"languagetool_url" json:"languagetool_url"`
+	// Language is used for content without a language of its own; auto lets the server detect it.
+	Language        string `mapstructure:"language" json:"language"`
+	TimeoutSeconds  int    `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+	IntervalSeconds int    `mapstructure:"interval_seconds" json:"interval_seconds"`
+}
+
+func (c Config) Enabled() bool {
+	return c.LanguageToolURL != ""
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if u, err := url.Parse(c.LanguageToolURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("languagetool_url must be an http or https URL")
+	}
+	if c.Language == "" {
+		return fmt.Errorf("language must be set, auto to detect it")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+	if c.IntervalSeconds <= 0 {
+		return fmt.Errorf("interval_seconds must be positive")
+	}
+
+	return nil
+}
+
+// New returns the linter the config chooses: LanguageTool, or Noop without a URL.
+func New(cfg Config, client *http.Client) (Linter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("lint.New: %w", err)
+	}
+	if !cfg.Enabled() {
+		return Noop{}, nil
+	}
+	if client == nil {
+		client = &http.Client{Timeout: time.Duration(cfg.TimeoutSeconds) * time.Second}
+	}
+
+	return NewLanguageTool(cfg.LanguageToolURL, cfg.Language, client), nil
+}
+
+// Noop finds no issues.
+type Noop struct{}
+
+func (Noop) Lint(context.Context, string, string) ([]Match, error) {
+	return nil, nil
+}
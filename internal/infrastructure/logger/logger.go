@@ -6,20 +6,60 @@ import (
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/66gu1/easygodocs/internal/infrastructure/contextx"
+	"github.com/66gu1/easygodocs/internal/infrastructure/errreport"
 	"github.com/rs/zerolog"
 )
 
+// reporter receives every error logged at error level; see SetReporter.
+var reporter errreport.Reporter = errreport.Noop{}
+
+// SetReporter makes errors logged at error level go to r as well. It is meant to be called once at
+// startup, before anything is logged.
+func SetReporter(r errreport.Reporter) {
+	reporter = r
+}
+
 func Error(ctx context.Context, loggingErr error) *zerolog.Event {
-	return log(ctx, apperr.LogLevelOf(loggingErr), loggingErr)
+	level := apperr.LogLevelOf(loggingErr)
+	if level == apperr.LogLevelError && loggingErr != nil {
+		reporter.Report(context.WithoutCancel(ctx), loggingErr, nil)
+	}
+	return log(ctx, level, loggingErr)
+}
+
+// Recovered logs and reports a panic recovered from, with the stack it was raised on.
+func Recovered(ctx context.Context, panicErr error, stack []byte) *zerolog.Event {
+	reporter.Report(context.WithoutCancel(ctx), panicErr, stack)
+	return log(ctx, apperr.LogLevelError, panicErr).Str("stack", string(stack))
 }
 
 func Warn(ctx context.Context, loggingErr error) *zerolog.Event {
 	return log(ctx, apperr.LogLevelWarn, loggingErr)
 }
 
+// Audit records a security-relevant change at info level, e.g. Audit(ctx, "user.deleted").
+func Audit(ctx context.Context, action string) *zerolog.Event {
+	ctx = context.WithoutCancel(ctx)
+	return withActor(ctx, zerolog.Ctx(ctx).Info()).Str("audit", action)
+}
+
 func log(ctx context.Context, level apperr.LogLevel, loggingErr error) *zerolog.Event {
 	ctx = context.WithoutCancel(ctx)
-	event := zerolog.Ctx(ctx).WithLevel(toZerologLevel(level))
+	event := withActor(ctx, zerolog.Ctx(ctx).WithLevel(toZerologLevel(level)))
+
+	if loggingErr != nil {
+		event = event.Err(loggingErr)
+	}
+
+	return event
+}
+
+// withActor adds the request, the current user and session, and the impersonating admin, when the
+// context has them.
+func withActor(ctx context.Context, event *zerolog.Event) *zerolog.Event {
+	if requestID := contextx.RequestID(ctx); requestID != "" {
+		event = event.Str("request_id", requestID)
+	}
 
 	currentUser, err := contextx.GetUserID(ctx)
 	if err != nil {
@@ -39,8 +79,8 @@ func log(ctx context.Context, level apperr.LogLevel, loggingErr error) *zerolog.
 		event = event.Str("session_id", sessionID.String())
 	}
 
-	if loggingErr != nil {
-		event = event.Err(loggingErr)
+	if actorID, err := contextx.GetActorID(ctx); err == nil {
+		event = event.Str("actor_user_id", actorID.String())
 	}
 
 	return event
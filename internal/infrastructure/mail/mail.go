@@ -0,0 +1,69 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	netmail "net/mail"
+)
+
+// ErrDisabled is returned by Noop, so callers can tell an unsent message from a failed one.
+var ErrDisabled = errors.New("mail: sending is disabled")
+
+// Message is a plain-text email to one recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	// SMTPAddr is the host:port of the SMTP server mail is sent through; empty disables sending.
+	SMTPAddr string `mapstructure:"smtp_addr" json:"smtp_addr"`
+	// Username and Password sign in with PLAIN auth, which is only used over TLS or to localhost.
+	Username       string `mapstructure:"username" json:"username"`
+	Password       string `mapstructure:"password" json:"-"`
+	From           string `mapstructure:"from" json:"from"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+}
+
+func (c Config) Validate() error {
+	if c.SMTPAddr == "" {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(c.SMTPAddr); err != nil {
+		return fmt.Errorf("smtp_addr must be host:port")
+	}
+	if _, err := netmail.ParseAddress(c.From); err != nil {
+		return fmt.Errorf("from must be an email address")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+
+	return nil
+}
+
+// New returns the sender the config chooses: SMTP, or Noop without an address.
+func New(cfg Config) (Sender, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("mail.New: %w", err)
+	}
+	if cfg.SMTPAddr == "" {
+		return Noop{}, nil
+	}
+
+	return NewSMTP(cfg), nil
+}
+
+// Noop sends nothing and returns ErrDisabled.
+type Noop struct{}
+
+func (Noop) Send(context.Context, Message) error {
+	return ErrDisabled
+}
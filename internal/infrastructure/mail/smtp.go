@@ -0,0 +1,132 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTP sends mail through an SMTP server, one connection per message. STARTTLS is used when the
+// server offers it.
+type SMTP struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+	dialer   net.Dialer
+	now      func() time.Time
+}
+
+func NewSMTP(cfg Config) *SMTP {
+	host, _, _ := net.SplitHostPort(cfg.SMTPAddr)
+	return &SMTP{
+		addr:     cfg.SMTPAddr,
+		host:     host,
+		username: cfg.Username,
+		password: cfg.Password,
+		from:     cfg.From,
+		timeout:  time.Duration(cfg.TimeoutSeconds) * time.Second,
+		now:      time.Now,
+	}
+}
+
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	from, err := netmail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("mail.SMTP.Send: %w", err)
+	}
+	data, err := format(from, msg, s.now())
+	if err != nil {
+		return fmt.Errorf("mail.SMTP.Send: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("mail.SMTP.Send: %w", err)
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err = s.deliver(conn, from.Address, msg.To, data); err != nil {
+		return fmt.Errorf("mail.SMTP.Send: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SMTP) deliver(conn net.Conn, from, to string, data []byte) error {
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// format renders msg with its headers and CRLF line endings. Line breaks in the recipient or the
+// subject are rejected, so neither can add headers.
+func format(from *netmail.Address, msg Message, now time.Time) ([]byte, error) {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, fmt.Errorf("line break in recipient or subject")
+	}
+	to, err := netmail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("recipient: %w", err)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from.String())
+	fmt.Fprintf(&b, "To: %s\r\n", to.String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	body := strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n")
+	b.WriteString(body)
+	if !strings.HasSuffix(body, "\r\n") {
+		b.WriteString("\r\n")
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,140 @@
+package mail
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP accepts one message per connection without STARTTLS or auth and sends what it
+// received, envelope and data, to the returned channel. Recipients on example.org are refused.
+func fakeSMTP(t *testing.T) (string, <-chan []string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan []string, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, received)
+		}
+	}()
+
+	return ln.Addr().String(), received
+}
+
+func serveSMTP(conn net.Conn, received chan<- []string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); {
+		case cmd == "EHLO" || cmd == "HELO":
+			reply("250 localhost")
+		case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:") && strings.Contains(line, "@example.org"):
+			reply("550 no such user")
+		case cmd == "MAIL" || cmd == "RCPT":
+			lines = append(lines, line)
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			for {
+				data, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if data == ".\r\n" {
+					break
+				}
+				lines = append(lines, strings.TrimRight(data, "\r\n"))
+			}
+			received <- lines
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("500 unknown command")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Parallel()
+
+	addr, received := fakeSMTP(t)
+	s := NewSMTP(Config{SMTPAddr: addr, From: "Docs <docs@example.com>", TimeoutSeconds: 5})
+	s.now = func() time.Time { return time.Date(2025, 10, 2, 10, 0, 0, 0, time.UTC) }
+
+	err := s.Send(t.Context(), Message{To: "ann@example.com", Subject: "Привет", Body: "Hello\nworld"})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"MAIL FROM:<docs@example.com>",
+		"RCPT TO:<ann@example.com>",
+		`From: "Docs" <docs@example.com>`,
+		"To: <ann@example.com>",
+		"Subject: =?utf-8?q?=D0=9F=D1=80=D0=B8=D0=B2=D0=B5=D1=82?=",
+		"Date: Thu, 02 Oct 2025 10:00:00 +0000",
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+		"",
+		"Hello",
+		"world",
+	}, <-received)
+
+	err = s.Send(t.Context(), Message{To: "bob@example.org", Subject: "Hi", Body: "Hi"})
+	require.Error(t, err)
+}
+
+func TestSMTP_Send_Errors(t *testing.T) {
+	t.Parallel()
+
+	s := NewSMTP(Config{SMTPAddr: "127.0.0.1:1", From: "docs@example.com", TimeoutSeconds: 5})
+	// rejected before connecting
+	err := s.Send(t.Context(), Message{To: "ann@example.com\r\nBcc: eve@example.com", Subject: "Hi"})
+	require.ErrorContains(t, err, "line break")
+	err = s.Send(t.Context(), Message{To: "not an address", Subject: "Hi"})
+	require.ErrorContains(t, err, "recipient")
+
+	err = s.Send(t.Context(), Message{To: "ann@example.com", Subject: "Hi"})
+	require.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	s, err := New(Config{})
+	require.NoError(t, err)
+	require.ErrorIs(t, s.Send(t.Context(), Message{}), ErrDisabled)
+
+	s, err = New(Config{SMTPAddr: "localhost:25", From: "docs@example.com", TimeoutSeconds: 5})
+	require.NoError(t, err)
+	require.IsType(t, &SMTP{}, s)
+
+	for _, cfg := range []Config{
+		{SMTPAddr: "localhost", From: "docs@example.com", TimeoutSeconds: 5},
+		{SMTPAddr: "localhost:25", From: "docs", TimeoutSeconds: 5},
+		{SMTPAddr: "localhost:25", From: "docs@example.com"},
+	} {
+		_, err = New(cfg)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,97 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Config runs an external converter, such as wkhtmltopdf or a headless browser, that reads HTML on
+// stdin and writes PDF to stdout. Without a command, PDF output is off.
+type Config struct {
+	// Command is the program and its arguments, e.g. ["wkhtmltopdf", "--quiet", "-", "-"].
+	Command        []string `mapstructure:"command" json:"command"`
+	TimeoutSeconds int      `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+}
+
+func (c Config) Enabled() bool {
+	return len(c.Command) > 0
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.Command[0] == "" {
+		return fmt.Errorf("command must name a program")
+	}
+	if c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+
+	return nil
+}
+
+// maxStderr caps how much of what the converter reports on stderr ends up in an error.
+const maxStderr = 1 << 10
+
+// Converter turns HTML into PDF with the configured command, one process per document.
+type Converter struct {
+	cfg Config
+}
+
+func NewConverter(cfg Config) (*Converter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("pdf.NewConverter: %w", err)
+	}
+	if !cfg.Enabled() {
+		return nil, errors.New("pdf.NewConverter: no command")
+	}
+	if _, err := exec.LookPath(cfg.Command[0]); err != nil {
+		return nil, fmt.Errorf("pdf.NewConverter: %w", err)
+	}
+
+	return &Converter{cfg: cfg}, nil
+}
+
+// Convert feeds the converter the HTML render writes and copies the PDF to dst as it comes. If render
+// fails, the converter is killed before it sees the end of its input and the error of render is
+// returned.
+func (c *Converter) Convert(ctx context.Context, dst io.Writer, render func(w io.Writer) error) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.cfg.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.cfg.Command[0], c.cfg.Command[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("pdf.Convert: %w", err)
+	}
+	var stderr bytes.Buffer
+	cmd.Stdout = dst
+	cmd.Stderr = &stderr
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("pdf.Convert: %w", err)
+	}
+
+	if err = render(stdin); err != nil {
+		cancel()
+		_ = stdin.Close()
+		_ = cmd.Wait()
+		return fmt.Errorf("pdf.Convert: %w", err)
+	}
+	// a converter that exits early breaks the pipe; Wait tells why
+	_ = stdin.Close()
+	if err = cmd.Wait(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg[:min(len(msg), maxStderr)])
+		}
+		return fmt.Errorf("pdf.Convert: %w", err)
+	}
+
+	return nil
+}
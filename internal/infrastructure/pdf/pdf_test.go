@@ -0,0 +1,69 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Config{}.Validate())
+	require.NoError(t, Config{Command: []string{"cat"}, TimeoutSeconds: 1}.Validate())
+	require.Error(t, Config{Command: []string{""}, TimeoutSeconds: 1}.Validate())
+	require.Error(t, Config{Command: []string{"cat"}}.Validate())
+}
+
+func TestNewConverter(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewConverter(Config{TimeoutSeconds: 1})
+	require.Error(t, err)
+	_, err = NewConverter(Config{Command: []string{"no-such-converter-installed"}, TimeoutSeconds: 1})
+	require.Error(t, err)
+}
+
+func TestConverter_Convert(t *testing.T) {
+	t.Parallel()
+
+	html := func(w io.Writer) error {
+		_, err := io.WriteString(w, "<html></html>")
+		return err
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+		// cat stands in for a converter, passing its input through
+		c, err := NewConverter(Config{Command: []string{"cat"}, TimeoutSeconds: 5})
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		require.NoError(t, c.Convert(t.Context(), &out, html))
+		require.Equal(t, "<html></html>", out.String())
+	})
+
+	t.Run("render error", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewConverter(Config{Command: []string{"cat"}, TimeoutSeconds: 5})
+		require.NoError(t, err)
+
+		expErr := errors.New("test error")
+		var out bytes.Buffer
+		err = c.Convert(t.Context(), &out, func(io.Writer) error { return expErr })
+		require.ErrorIs(t, err, expErr)
+		require.Empty(t, out.String())
+	})
+
+	t.Run("converter fails", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewConverter(Config{Command: []string{"sh", "-c", "echo broken >&2; exit 1"}, TimeoutSeconds: 5})
+		require.NoError(t, err)
+
+		err = c.Convert(t.Context(), io.Discard, html)
+		require.ErrorContains(t, err, "broken")
+	})
+}
@@ -0,0 +1,110 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// ErrNotFound is returned when no object is stored under the key.
+var ErrNotFound = errors.New("object not found")
+
+// Config locates a bucket of an S3-compatible storage. Endpoint is the base URL of the service, e.g.
+// https://s3.eu-central-1.amazonaws.com or http://minio:9000; with path_style the bucket goes into the
+// path instead of the host name, as MinIO and most self-hosted services expect.
+type Config struct {
+	Endpoint        string `mapstructure:"endpoint" json:"endpoint"`
+	Region          string `mapstructure:"region" json:"region"`
+	Bucket          string `mapstructure:"bucket" json:"bucket"`
+	PathStyle       bool   `mapstructure:"path_style" json:"path_style"`
+	AccessKeyID     string `mapstructure:"access_key_id" json:"-"`
+	SecretAccessKey string `mapstructure:"secret_access_key" json:"-"`
+}
+
+func (c Config) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("endpoint must be an absolute http(s) URL")
+	}
+	if c.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+	if c.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return fmt.Errorf("access_key_id and secret_access_key are required")
+	}
+
+	return nil
+}
+
+// store reads and writes objects with the AWS SDK client.
+type store struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewStore creates a store sending its requests with httpClient. Checksums are only sent and validated
+// where S3 requires them, as not every S3-compatible service supports the flexible checksums the SDK
+// adds by default.
+func NewStore(cfg Config, httpClient *http.Client) (*store, error) {
+	if httpClient == nil {
+		return nil, fmt.Errorf("s3.NewStore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("s3.NewStore: %w", err)
+	}
+
+	client := s3.New(s3.Options{
+		Region:                     cfg.Region,
+		BaseEndpoint:               aws.String(strings.TrimSuffix(cfg.Endpoint, "/")),
+		UsePathStyle:               cfg.PathStyle,
+		Credentials:                credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		HTTPClient:                 httpClient,
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	})
+
+	return &store{client: client, bucket: cfg.Bucket}, nil
+}
+
+// Put uploads body under key. The body is seekable, so the SDK can sign its hash and retry the upload.
+func (s *store) Put(ctx context.Context, key string, body io.ReadSeeker) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("s3.Put: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the object stored under key. The caller must close it.
+func (s *store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var respErr *awshttp.ResponseError
+		if errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound {
+			err = ErrNotFound
+		}
+		return nil, fmt.Errorf("s3.Get: %w", err)
+	}
+
+	return out.Body, nil
+}
@@ -0,0 +1,74 @@
+package s3
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			sum := sha256.Sum256(body)
+			if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := NewStore(Config{Endpoint: "minio:9000"}, srv.Client())
+	require.Error(t, err)
+
+	cfg := Config{Endpoint: srv.URL, Region: "us-east-1", Bucket: "backups", PathStyle: true, AccessKeyID: "key", SecretAccessKey: "secret"}
+	s, err := NewStore(cfg, srv.Client())
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	_, err = s.Get(ctx, "a/b.tar.gz")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.Put(ctx, "a/b.tar.gz", strings.NewReader("archive")))
+	require.Contains(t, objects, "/backups/a/b.tar.gz")
+	r, err := s.Get(ctx, "a/b.tar.gz")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "archive", string(data))
+
+	cfg.AccessKeyID = "other"
+	s, err = NewStore(cfg, srv.Client())
+	require.NoError(t, err)
+	require.ErrorContains(t, s.Put(ctx, "a/b.tar.gz", strings.NewReader("archive")), "StatusCode: 403")
+}
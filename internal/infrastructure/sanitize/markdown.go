@@ -0,0 +1,150 @@
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+)
+
+type blockKind int
+
+const (
+	blockMarkdown blockKind = iota
+	// blockHTML lines may be an HTML block, which renderers pass through as is: no code spans or escapes.
+	blockHTML
+	// blockCode lines are a fenced code block, which renderers escape.
+	blockCode
+	// blockBlank lines end a paragraph, so no code span runs across them.
+	blockBlank
+)
+
+// block is a run of lines of one kind, ending at end; it starts where the previous one ends.
+type block struct {
+	end  int
+	kind blockKind
+}
+
+var (
+	rawTextStart   = regexp.MustCompile(`^<(?i:(script|pre|style|textarea))(?:[\s>]|$)`)
+	containerStart = regexp.MustCompile(`^(?:[ \t]+|>|[-*+](?:[ \t]|$)|[0-9]{1,9}[.)](?:[ \t]|$))`)
+)
+
+// markdownBlocks splits content into code, HTML and Markdown lines. It errs on the side of HTML: a wrong
+// guess only means markup in a code span is sanitized too. So a code block counts only when fenced at
+// the very start of a line, where no list item or block quote can hold it, and any line starting with
+// markup may open an HTML block, kept until a line that is entirely blank or holds its end marker.
+func markdownBlocks(content string) []block {
+	var (
+		blocks  []block
+		fence   string
+		inHTML  bool
+		htmlEnd string
+	)
+	for start := 0; start < len(content); {
+		end := len(content)
+		if i := strings.IndexByte(content[start:], '\n'); i >= 0 {
+			end = start + i + 1
+		}
+		line := content[start:end]
+
+		kind := blockMarkdown
+		switch {
+		case fence != "":
+			kind = blockCode
+			if closesFence(line, fence) {
+				fence = ""
+			}
+		case inHTML:
+			kind = blockHTML
+			inHTML = !endsHTMLBlock(line, htmlEnd)
+			if !inHTML && htmlEnd == "" {
+				kind = blockBlank
+			}
+		default:
+			if strings.TrimSpace(line) == "" {
+				kind = blockBlank
+				break
+			}
+			if fence = openingFence(line); fence != "" {
+				kind = blockCode
+				break
+			}
+			var ok bool
+			if htmlEnd, ok = htmlBlockStart(line); ok {
+				kind = blockHTML
+				inHTML = htmlEnd == "" || !endsHTMLBlock(line, htmlEnd)
+			}
+		}
+
+		if n := len(blocks); n > 0 && blocks[n-1].kind == kind {
+			blocks[n-1].end = end
+		} else {
+			blocks = append(blocks, block{end: end, kind: kind})
+		}
+		start = end
+	}
+
+	return blocks
+}
+
+// openingFence returns the fence opening a code block on the line, if any.
+func openingFence(line string) string {
+	if line == "" || (line[0] != '`' && line[0] != '~') {
+		return ""
+	}
+	n := runLength(line, line[0])
+	if n < 3 || (line[0] == '`' && strings.Contains(line[n:], "`")) {
+		return ""
+	}
+
+	return line[:n]
+}
+
+func closesFence(line, fence string) bool {
+	for i := 0; i < 3 && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+	n := runLength(line, fence[0])
+
+	return n >= len(fence) && strings.TrimSpace(line[n:]) == ""
+}
+
+// htmlBlockStart reports whether the line, past block quote and list markers, starts like an HTML
+// block, and returns the marker ending it; empty means a blank line does.
+func htmlBlockStart(line string) (string, bool) {
+	for {
+		m := containerStart.FindString(line)
+		if m == "" {
+			break
+		}
+		line = line[len(m):]
+	}
+	if len(line) < 2 || line[0] != '<' || !isTagStart(line[1]) {
+		return "", false
+	}
+
+	switch {
+	case rawTextStart.MatchString(line):
+		return "</" + strings.ToLower(rawTextStart.FindStringSubmatch(line)[1]) + ">", true
+	case strings.HasPrefix(line, "<!--"):
+		return "-->", true
+	case strings.HasPrefix(line, "<?"):
+		return "?>", true
+	case strings.HasPrefix(line, "<![CDATA["):
+		return "]]>", true
+	case strings.HasPrefix(line, "<!"):
+		return ">", true
+	case uriAutolinkPattern.MatchString(line) || emailAutolinkPattern.MatchString(line):
+		// the ':' or '@' right after the name rules out a tag
+		return "", false
+	default:
+		return "", true
+	}
+}
+
+func endsHTMLBlock(line, marker string) bool {
+	if marker == "" {
+		return strings.TrimSpace(line) == ""
+	}
+
+	return strings.Contains(asciiLower(line), marker)
+}
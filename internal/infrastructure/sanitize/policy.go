@@ -0,0 +1,178 @@
+package sanitize
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Config is the allowlist content is sanitized with. Names are matched case-insensitively; relative URLs
+// are always allowed, absolute ones only with one of the schemes.
+type Config struct {
+	AllowedTags       []string `mapstructure:"allowed_tags" json:"allowed_tags"`
+	AllowedAttributes []string `mapstructure:"allowed_attributes" json:"allowed_attributes"`
+	AllowedSchemes    []string `mapstructure:"allowed_schemes" json:"allowed_schemes"`
+}
+
+var (
+	DefaultAllowedTags = []string{
+		"a", "abbr", "b", "blockquote", "br", "caption", "code", "dd", "del", "details", "div", "dl", "dt", "em",
+		"figcaption", "figure", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "i", "img", "ins", "kbd", "li", "mark",
+		"ol", "p", "pre", "q", "s", "samp", "small", "span", "strong", "sub", "summary", "sup", "table", "tbody",
+		"td", "tfoot", "th", "thead", "tr", "u", "ul",
+	}
+	DefaultAllowedAttributes = []string{
+		"align", "alt", "cite", "class", "colspan", "dir", "height", "href", "id", "lang", "open", "rowspan",
+		"src", "start", "title", "width",
+	}
+	DefaultAllowedSchemes = []string{"http", "https", "mailto"}
+)
+
+// forbiddenTags run or load code whatever their attributes, so no config can allow them.
+var forbiddenTags = map[string]struct{}{
+	"script": {}, "base": {}, "meta": {}, "object": {}, "embed": {}, "applet": {}, "frame": {}, "frameset": {},
+}
+
+// urlAttributes hold a URL whose scheme is checked; srcset holds a list of them.
+var urlAttributes = map[string]struct{}{
+	"href": {}, "src": {}, "cite": {}, "action": {}, "formaction": {}, "poster": {}, "background": {},
+	"longdesc": {}, "data": {}, "xlink:href": {}, "srcset": {},
+}
+
+var (
+	tagNamePattern  = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+	attrNamePattern = regexp.MustCompile(`^[a-z_:][a-z0-9_.:-]*$`)
+	schemePattern   = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+)
+
+func (c Config) Validate() error {
+	for _, tag := range c.AllowedTags {
+		tag = strings.ToLower(tag)
+		if !tagNamePattern.MatchString(tag) {
+			return fmt.Errorf("allowed_tags: invalid tag %q", tag)
+		}
+		if _, ok := forbiddenTags[tag]; ok {
+			return fmt.Errorf("allowed_tags: %s cannot be allowed", tag)
+		}
+	}
+	for _, attr := range c.AllowedAttributes {
+		attr = strings.ToLower(attr)
+		if !attrNamePattern.MatchString(attr) {
+			return fmt.Errorf("allowed_attributes: invalid attribute %q", attr)
+		}
+		// event handlers run scripts, srcdoc is a whole document
+		if strings.HasPrefix(attr, "on") || attr == "srcdoc" {
+			return fmt.Errorf("allowed_attributes: %s cannot be allowed", attr)
+		}
+	}
+	for _, scheme := range c.AllowedSchemes {
+		scheme = strings.ToLower(scheme)
+		if !schemePattern.MatchString(scheme) {
+			return fmt.Errorf("allowed_schemes: invalid scheme %q", scheme)
+		}
+		if scheme == "javascript" || scheme == "vbscript" {
+			return fmt.Errorf("allowed_schemes: %s cannot be allowed", scheme)
+		}
+	}
+
+	return nil
+}
+
+// Policy removes the markup its config does not allow. It is safe for concurrent use.
+type Policy struct {
+	tags    map[string]struct{}
+	attrs   map[string]struct{}
+	schemes map[string]struct{}
+	// html is the same allowlist for HTML, applied by SanitizeHTML.
+	html *bluemonday.Policy
+}
+
+func New(cfg Config) (*Policy, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("sanitize.New: %w", err)
+	}
+
+	p := &Policy{
+		tags:    toSet(cfg.AllowedTags),
+		attrs:   toSet(cfg.AllowedAttributes),
+		schemes: toSet(cfg.AllowedSchemes),
+		html:    bluemonday.NewPolicy(),
+	}
+	p.html.AllowElements(toList(p.tags)...)
+	p.html.AllowAttrs(toList(p.attrs)...).Globally()
+	// URLs the url package cannot parse, such as ones with a tab or a newline, are dropped
+	p.html.RequireParseableURLs(true)
+	p.html.AllowRelativeURLs(true)
+	p.html.AllowURLSchemes(toList(p.schemes)...)
+
+	return p, nil
+}
+
+// SanitizeHTML removes the markup the policy does not allow from HTML, such as the HTML rendered from
+// content or a feed item description. Unlike Sanitize, it reads the markup as browsers do, so this is
+// what makes HTML safe to serve.
+func (p *Policy) SanitizeHTML(html string) string {
+	return p.html.Sanitize(html)
+}
+
+func toSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[strings.ToLower(name)] = struct{}{}
+	}
+
+	return set
+}
+
+func toList(set map[string]struct{}) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func (p *Policy) allowsTag(name string) bool {
+	_, ok := p.tags[name]
+	return ok
+}
+
+func (p *Policy) allowsAttr(name string) bool {
+	_, ok := p.attrs[name]
+	return ok
+}
+
+// unsafeScheme returns the scheme of u if it is absolute and the scheme is not allowed.
+func (p *Policy) unsafeScheme(u string) (string, bool) {
+	scheme := urlScheme(u)
+	if scheme == "" {
+		return "", false
+	}
+	_, ok := p.schemes[scheme]
+
+	return scheme, !ok
+}
+
+// urlScheme returns the lowercased scheme of u as a browser reads it, after dropping the whitespace and
+// control characters it ignores; empty for a relative URL.
+func urlScheme(u string) string {
+	u = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, u)
+	i := strings.IndexByte(u, ':')
+	if i <= 0 {
+		return ""
+	}
+	scheme := strings.ToLower(u[:i])
+	if !schemePattern.MatchString(scheme) {
+		return ""
+	}
+
+	return scheme
+}
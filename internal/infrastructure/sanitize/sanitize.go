@@ -0,0 +1,432 @@
+package sanitize
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// The raw HTML forms CommonMark passes through to its output; anything else that starts like a tag
+// is escaped, so browsers cannot read it as one either.
+var (
+	openTagPattern = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9-]*)` +
+		`(?:\s+[A-Za-z_:][A-Za-z0-9_.:-]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*\s*/?>`)
+	closeTagPattern = regexp.MustCompile(`^</([A-Za-z][A-Za-z0-9-]*)\s*>`)
+	// autolinks are Markdown links rather than raw HTML, so renderers escape them
+	uriAutolinkPattern   = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}):[^<>\x00-\x20]*>`)
+	emailAutolinkPattern = regexp.MustCompile("^<[A-Za-z0-9.!#$%&'*+/=?^_`{|}~-]+@[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?" +
+		`(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*>`)
+)
+
+// dropContent are the elements whose content is code rather than text; it is removed with their tags.
+var dropContent = map[string]struct{}{"script": {}, "style": {}}
+
+// Sanitize removes the markup the policy does not allow from Markdown content: raw HTML tags and
+// attributes off the allowlist, the content of script and style elements, comments, and URLs of links
+// and images with another scheme. Code spans and code blocks fenced at the start of a line are kept as
+// they are, since renderers escape them. It returns the clean content and what was removed, each kind
+// once in order of appearance: "<tag>", "attribute=", "scheme:", "<!--", "<!" or "<?".
+//
+// Sanitize does not make rendered HTML safe: a renderer may read Markdown differently, so everything
+// served as HTML goes through SanitizeHTML. Sanitize is for content that leaves or enters as Markdown,
+// where there is no HTML to sanitize yet: Markdown exports, which other renderers display, content
+// imported from Confluence or by easygodocsctl, and the unsafe markup report, which lists what stored
+// content holds.
+func (p *Policy) Sanitize(content string) (string, []string) {
+	s := &sanitizer{policy: p, src: content, blocks: markdownBlocks(content)}
+	out := s.run()
+
+	return out, s.removed
+}
+
+type sanitizer struct {
+	policy *Policy
+	src    string
+	blocks []block
+	// lower is src with ASCII letters lowercased, made on first use to find end tags.
+	lower string
+	// next caches where the markers skipThrough looks for occur next, -1 if nowhere.
+	next map[string]int
+	// spanDirty is set once a backtick run of the paragraph was not read as a code span: a renderer may
+	// pair it with a run on a later line, so spans are no longer certain.
+	spanDirty bool
+	// lineEnd and linePipe describe the line of the last code span looked for.
+	lineEnd  int
+	linePipe bool
+	pos      int
+	out      strings.Builder
+	removed  []string
+}
+
+func (s *sanitizer) run() string {
+	s.out.Grow(len(s.src))
+	// markup may run on through the following blocks up to the next code block
+	limits := make([]int, len(s.blocks))
+	for i := len(s.blocks) - 1; i >= 0; i-- {
+		limits[i] = s.blocks[i].end
+		if i+1 < len(s.blocks) && s.blocks[i+1].kind != blockCode {
+			limits[i] = limits[i+1]
+		}
+	}
+
+	for i, b := range s.blocks {
+		if s.pos >= b.end {
+			continue
+		}
+		if b.kind == blockCode || b.kind == blockBlank {
+			s.spanDirty = false
+		}
+		if b.kind == blockCode {
+			s.out.WriteString(s.src[s.pos:b.end])
+			s.pos = b.end
+			continue
+		}
+		for s.pos < b.end {
+			s.step(b.kind != blockHTML, b.end, limits[i])
+		}
+	}
+
+	return s.out.String()
+}
+
+func (s *sanitizer) remove(what string) {
+	if !slices.Contains(s.removed, what) {
+		s.removed = append(s.removed, what)
+	}
+}
+
+// step copies the text up to the next character that may start markup, then handles that markup.
+// Code spans and backslash escapes only count in Markdown blocks.
+func (s *sanitizer) step(markdown bool, end, limit int) {
+	special := "<]"
+	if markdown {
+		special = "<]`\\"
+	}
+	i := strings.IndexAny(s.src[s.pos:end], special)
+	if i < 0 {
+		i = end - s.pos
+	}
+	text := s.src[s.pos : s.pos+i]
+	if !markdown && strings.Contains(text, "`") {
+		// the line may be read as Markdown after all
+		s.spanDirty = true
+	}
+	s.out.WriteString(text)
+	s.pos += i
+	if s.pos == end {
+		return
+	}
+
+	switch s.src[s.pos] {
+	case '<':
+		s.angle(markdown, limit)
+	case ']':
+		s.bracket(limit)
+	case '`':
+		s.codeSpan(end)
+	case '\\':
+		n := 1
+		if s.pos+1 < len(s.src) && isASCIIPunct(s.src[s.pos+1]) {
+			n = 2
+		}
+		s.out.WriteString(s.src[s.pos : s.pos+n])
+		s.pos += n
+	}
+}
+
+// angle handles a '<': an autolink, a comment, a declaration, a processing instruction or a tag.
+func (s *sanitizer) angle(markdown bool, limit int) {
+	rest := s.src[s.pos:limit]
+	if len(rest) < 2 || !isTagStart(rest[1]) {
+		s.out.WriteByte('<')
+		s.pos++
+		return
+	}
+
+	if markdown {
+		if m := uriAutolinkPattern.FindStringSubmatch(rest); m != nil {
+			if scheme, unsafe := s.policy.unsafeScheme(m[1] + ":"); unsafe {
+				s.remove(scheme + ":")
+			} else {
+				s.out.WriteString(m[0])
+			}
+			s.pos += len(m[0])
+			return
+		}
+		if m := emailAutolinkPattern.FindString(rest); m != "" {
+			s.out.WriteString(m)
+			s.pos += len(m)
+			return
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(rest, "<!--"):
+		// from 2 on, so <!--> and <!---> end where browsers end them
+		s.skipThrough(rest, 2, "-->", "<!--")
+	case strings.HasPrefix(rest, "<![CDATA["):
+		s.skipThrough(rest, 9, "]]>", "<!")
+	case strings.HasPrefix(rest, "<!"):
+		s.skipThrough(rest, 2, ">", "<!")
+	case strings.HasPrefix(rest, "<?"):
+		s.skipThrough(rest, 2, "?>", "<?")
+	default:
+		if m := closeTagPattern.FindStringSubmatch(rest); m != nil {
+			name := strings.ToLower(m[1])
+			if s.policy.allowsTag(name) {
+				s.out.WriteString("</" + name + ">")
+			} else {
+				s.remove("<" + name + ">")
+			}
+			s.pos += len(m[0])
+			return
+		}
+		if m := openTagPattern.FindStringSubmatch(rest); m != nil {
+			s.openTag(m[0], strings.ToLower(m[1]))
+			return
+		}
+		s.escape()
+	}
+}
+
+func (s *sanitizer) escape() {
+	s.out.WriteString("&lt;")
+	s.pos++
+}
+
+// skipThrough removes rest up to and including the first marker at or after from. Without a marker,
+// it is not markup and the '<' is escaped.
+func (s *sanitizer) skipThrough(rest string, from int, marker, what string) {
+	start := s.pos + from
+	i, ok := s.next[marker]
+	if !ok || (i >= 0 && i < start) {
+		if i = strings.Index(s.src[start:], marker); i >= 0 {
+			i += start
+		}
+		if s.next == nil {
+			s.next = make(map[string]int)
+		}
+		s.next[marker] = i
+	}
+	if i < 0 || i+len(marker) > s.pos+len(rest) {
+		s.escape()
+		return
+	}
+	s.remove(what)
+	s.pos = i + len(marker)
+}
+
+func (s *sanitizer) openTag(tag, name string) {
+	if !s.policy.allowsTag(name) {
+		s.remove("<" + name + ">")
+		s.pos += len(tag)
+		if _, ok := dropContent[name]; ok {
+			s.pos = s.endOfElement(s.pos, name)
+		}
+		return
+	}
+
+	// the tokenizer reads the attributes as browsers do, values unescaped
+	z := html.NewTokenizer(strings.NewReader(tag))
+	tt := z.Next()
+	_, more := z.TagName()
+	s.out.WriteString("<" + name)
+	seen := make(map[string]struct{})
+	for more {
+		var key, val []byte
+		key, val, more = z.TagAttr()
+		attr := string(key)
+		// browsers keep the first of repeated attributes
+		if _, ok := seen[attr]; ok {
+			continue
+		}
+		seen[attr] = struct{}{}
+		if !s.policy.allowsAttr(attr) {
+			s.remove(attr + "=")
+			continue
+		}
+		value := string(val)
+		if scheme, unsafe := s.unsafeURL(attr, value); unsafe {
+			s.remove(scheme + ":")
+			continue
+		}
+		s.out.WriteString(" " + attr + `="` + html.EscapeString(value) + `"`)
+	}
+	if tt == html.SelfClosingTagToken {
+		s.out.WriteString(" /")
+	}
+	s.out.WriteByte('>')
+	s.pos += len(tag)
+}
+
+// unsafeURL checks the URL attributes: srcset is unsafe if any of its candidates is.
+func (s *sanitizer) unsafeURL(attr, value string) (string, bool) {
+	if _, ok := urlAttributes[attr]; !ok {
+		return "", false
+	}
+	if attr != "srcset" {
+		return s.policy.unsafeScheme(value)
+	}
+	for _, candidate := range strings.Split(value, ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			if scheme, unsafe := s.policy.unsafeScheme(fields[0]); unsafe {
+				return scheme, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// endOfElement returns the position after the end tag of name that closes the element, or the end of
+// the content if there is none; browsers read everything up to it as the element's content.
+func (s *sanitizer) endOfElement(from int, name string) int {
+	if s.lower == "" {
+		s.lower = asciiLower(s.src)
+	}
+	for {
+		i := strings.Index(s.lower[from:], "</"+name)
+		if i < 0 {
+			return len(s.src)
+		}
+		from += i + 2 + len(name)
+		if from == len(s.src) || isTagNameEnd(s.src[from]) {
+			if j := strings.IndexByte(s.src[from:], '>'); j >= 0 {
+				return from + j + 1
+			}
+			return len(s.src)
+		}
+	}
+}
+
+// bracket checks the destination of a Markdown link or image, "](...)", or of a link reference
+// definition, "]: ...". A destination with a scheme that is not allowed is removed.
+func (s *sanitizer) bracket(limit int) {
+	if s.pos+1 >= limit || (s.src[s.pos+1] != '(' && s.src[s.pos+1] != ':') {
+		s.out.WriteByte(']')
+		s.pos++
+		return
+	}
+	start := s.pos + 2
+	for start < limit && isSpace(s.src[start]) {
+		start++
+	}
+	s.out.WriteString(s.src[s.pos:start])
+	s.pos = start
+
+	// '<' and '`' are left to the next steps, as the brackets may turn out not to be a link
+	end, depth := start, 0
+	for end < limit {
+		c := s.src[end]
+		if c <= ' ' || c == '<' || c == '`' {
+			break
+		}
+		if c == '\\' && end+1 < limit && isASCIIPunct(s.src[end+1]) && s.src[end+1] != '<' && s.src[end+1] != '`' {
+			end += 2
+			continue
+		}
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			if depth == 0 {
+				break
+			}
+			depth--
+		}
+		end++
+	}
+	dest := s.src[start:end]
+	if scheme, unsafe := s.policy.unsafeScheme(html.UnescapeString(unescapeBackslashes(dest))); unsafe {
+		s.remove(scheme + ":")
+	} else {
+		s.out.WriteString(dest)
+	}
+	s.pos = end
+}
+
+// codeSpan copies a code span as it is, when renderers are certain to read one: within the line, with
+// no run left unpaired before it in the paragraph, and on a line without '|', which would split table
+// cells before spans are read.
+func (s *sanitizer) codeSpan(end int) {
+	n := runLength(s.src[s.pos:end], '`')
+	if s.pos >= s.lineEnd {
+		s.lineEnd = end
+		if i := strings.IndexByte(s.src[s.pos:end], '\n'); i >= 0 {
+			s.lineEnd = s.pos + i
+		}
+		lineStart := strings.LastIndexByte(s.src[:s.pos], '\n') + 1
+		s.linePipe = strings.Contains(s.src[lineStart:s.lineEnd], "|")
+	}
+
+	for j := s.pos + n; !s.spanDirty && !s.linePipe && j < s.lineEnd; {
+		k := strings.IndexByte(s.src[j:s.lineEnd], '`')
+		if k < 0 {
+			break
+		}
+		k += j
+		m := runLength(s.src[k:s.lineEnd], '`')
+		if m == n {
+			s.out.WriteString(s.src[s.pos : k+m])
+			s.pos = k + m
+			return
+		}
+		j = k + m
+	}
+	s.spanDirty = true
+	s.out.WriteString(s.src[s.pos : s.pos+n])
+	s.pos += n
+}
+
+func runLength(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func unescapeBackslashes(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+func isTagStart(c byte) bool {
+	return isASCIILetter(c) || c == '/' || c == '!' || c == '?'
+}
+
+func isTagNameEnd(c byte) bool {
+	return isSpace(c) || c == '/' || c == '>'
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
@@ -0,0 +1,366 @@
+package sanitize_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/sanitize"
+	"github.com/stretchr/testify/require"
+)
+
+func defaultPolicy(t *testing.T) *sanitize.Policy {
+	t.Helper()
+	p, err := sanitize.New(sanitize.Config{
+		AllowedTags:       sanitize.DefaultAllowedTags,
+		AllowedAttributes: sanitize.DefaultAllowedAttributes,
+		AllowedSchemes:    sanitize.DefaultAllowedSchemes,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestPolicy_Sanitize(t *testing.T) {
+	t.Parallel()
+
+	p := defaultPolicy(t)
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		removed []string
+	}{
+		{
+			name: "plain",
+			in:   "# Title\n\nSome *text* with a < b, 3 > 2 and [a link](https://example.com).\n",
+			want: "# Title\n\nSome *text* with a < b, 3 > 2 and [a link](https://example.com).\n",
+		},
+		{
+			name: "allowed_markup",
+			in:   `<details open><summary>More</summary><img src="/a.png" alt='a "b"' width=10 /></details>`,
+			want: `<details open=""><summary>More</summary><img src="/a.png" alt="a &#34;b&#34;" width="10" /></details>`,
+		},
+		{
+			name:    "script",
+			in:      "before<script>alert(1)</script>after",
+			want:    "beforeafter",
+			removed: []string{"<script>"},
+		},
+		{
+			name:    "script/upper_case_and_spaced_end",
+			in:      "a<SCRIPT type=x>alert('</scriptx>')</Script\n>b",
+			want:    "ab",
+			removed: []string{"<script>"},
+		},
+		{
+			name:    "script/unclosed",
+			in:      "a<script>alert(1)\n\n```\ncode\n```\n",
+			want:    "a",
+			removed: []string{"<script>"},
+		},
+		{
+			name:    "style",
+			in:      "<style>body{display:none}</style>text",
+			want:    "text",
+			removed: []string{"<style>"},
+		},
+		{
+			name:    "event_handler",
+			in:      `<img src=x onerror=alert(1)>`,
+			want:    `<img src="x">`,
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "event_handler/slash_separated",
+			in:      `<img/src=x/onerror=alert(1)>`,
+			want:    `&lt;img/src=x/onerror=alert(1)>`,
+			removed: nil,
+		},
+		{
+			name:    "event_handler/multiline",
+			in:      "<img\nsrc=x\nONERROR=\"alert(1)\">",
+			want:    `<img src="x">`,
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "disallowed_tag_keeps_text",
+			in:      `<svg onload=alert(1)><text>hi</text></svg>`,
+			want:    `hi`,
+			removed: []string{"<svg>", "<text>"},
+		},
+		{
+			name:    "iframe",
+			in:      `<iframe src="https://evil.example"></iframe>`,
+			want:    ``,
+			removed: []string{"<iframe>"},
+		},
+		{
+			name:    "javascript_href",
+			in:      `<a href="javascript:alert(1)" title=t>x</a>`,
+			want:    `<a title="t">x</a>`,
+			removed: []string{"javascript:"},
+		},
+		{
+			name:    "javascript_href/obfuscated",
+			in:      "<a href=\" &#106;ava\tscript&colon;alert(1)\">x</a><a href='JAVASCRIPT:x'>y</a>",
+			want:    `<a>x</a><a>y</a>`,
+			removed: []string{"javascript:"},
+		},
+		{
+			name:    "data_src",
+			in:      `<img src="data:image/svg+xml;base64,PHN2Zz4=">`,
+			want:    `<img>`,
+			removed: []string{"data:"},
+		},
+		{
+			name: "relative_and_allowed_urls",
+			in:   `<a href="/entities/1?a=b#c">x</a><a href="mailto:a@b.c">y</a><a href="a/b:c">z</a>`,
+			want: `<a href="/entities/1?a=b#c">x</a><a href="mailto:a@b.c">y</a><a href="a/b:c">z</a>`,
+		},
+		{
+			name:    "attribute_breakout",
+			in:      `<a title="x" "onmouseover=alert(1)">y</a>`,
+			want:    `&lt;a title="x" "onmouseover=alert(1)">y</a>`,
+			removed: nil,
+		},
+		{
+			name:    "quoted_gt",
+			in:      `<a title="a>b" onclick="alert(1)">y</a>`,
+			want:    `<a title="a&gt;b">y</a>`,
+			removed: []string{"onclick="},
+		},
+		{
+			name:    "comment",
+			in:      "a<!-- note -->b<!--><img src=x onerror=alert(1)>-->c",
+			want:    "ab<img src=\"x\">-->c",
+			removed: []string{"<!--", "onerror="},
+		},
+		{
+			name: "comment/unclosed",
+			in:   "a<!-- b",
+			want: "a&lt;!-- b",
+		},
+		{
+			name:    "declaration_and_instruction",
+			in:      "<!DOCTYPE html><?php echo 1 ?><![CDATA[<script>x</script>]]>a",
+			want:    "a",
+			removed: []string{"<!", "<?"},
+		},
+		{
+			name:    "markdown_link",
+			in:      "[x](javascript:alert(1)) ![y](  JaVaScRiPt:alert(1) \"t\") [z](java\\script:x) [w](&#x6A;avascript:x)",
+			want:    "[x]() ![y](   \"t\") [z](java\\script:x) [w]()",
+			removed: []string{"javascript:"},
+		},
+		{
+			name:    "markdown_link/escaped_colon",
+			in:      `[x](javascript\:alert(1))`,
+			want:    `[x]()`,
+			removed: []string{"javascript:"},
+		},
+		{
+			name:    "markdown_reference",
+			in:      "[x]\n\n[x]: vbscript:msgbox(1)\n",
+			want:    "[x]\n\n[x]: \n",
+			removed: []string{"vbscript:"},
+		},
+		{
+			name:    "markdown_link/stops_at_markup",
+			in:      "x](foo<img src=x onerror=alert(1)>)",
+			want:    `x](foo<img src="x">)`,
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "autolink",
+			in:      "<https://example.com/a?b> <me@example.com> <javascript:alert(1)>",
+			want:    "<https://example.com/a?b> <me@example.com> ",
+			removed: []string{"javascript:"},
+		},
+		{
+			name: "stray_lt",
+			in:   "List<T> is generic, i<j, <3",
+			want: "List is generic, i&lt;j, <3",
+			// List<T> reads as a <t> tag in Markdown as well, i<j is escaped as it could start one
+			removed: []string{"<t>"},
+		},
+		{
+			name: "code_span",
+			in:   "Use `<script>alert(1)</script>` or ``a ` <b onclick=x>``.\n",
+			want: "Use `<script>alert(1)</script>` or ``a ` <b onclick=x>``.\n",
+		},
+		{
+			name:    "code_span/escaped_backtick",
+			in:      "\\`<img src=x onerror=alert(1)>`",
+			want:    "\\`<img src=\"x\">`",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "code_span/table_cell",
+			in:      "| `a | <img src=x onerror=alert(1)>` |\n",
+			want:    "| `a | <img src=\"x\">` |\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "code_span/unpaired_run_before",
+			in:      "`a\nb` <img src=x onerror=alert(1)> `c`\n\n`<b onclick=x>`\n",
+			want:    "`a\nb` <img src=\"x\"> `c`\n\n`<b onclick=x>`\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "code_span/not_across_lines",
+			in:      "`a\n# <img src=x onerror=alert(1)>`\n",
+			want:    "`a\n# <img src=\"x\">`\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name: "fenced_code",
+			in:   "```html\n<script>alert(1)</script>\n<img src=x onerror=alert(1)>\n```\n\n~~~~\n<b onclick=x>\n~~~~\n",
+			want: "```html\n<script>alert(1)</script>\n<img src=x onerror=alert(1)>\n```\n\n~~~~\n<b onclick=x>\n~~~~\n",
+		},
+		{
+			name:    "fenced_code/in_list_item",
+			in:      "- item\n\n  ```\n<img src=x onerror=alert(1)>\n  ```\n",
+			want:    "- item\n\n  ```\n<img src=\"x\">\n  ```\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "fenced_code/in_html_block",
+			in:      "<div>\n```\n<img src=x onerror=alert(1)>\n```\n</div>\n",
+			want:    "<div>\n```\n<img src=\"x\">\n```\n</div>\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "html_block/no_code_spans",
+			in:      "<div>\n`<img src=x onerror=alert(1)>`\n</div>\n\n`<b onclick=x>`\n",
+			want:    "<div>\n`<img src=\"x\">`\n</div>\n\n`<b onclick=x>`\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "html_block/pre_spans_blank_lines",
+			in:      "> <pre>\n\n`<img src=x onerror=alert(1)>`\n</pre>\n",
+			want:    "> <pre>\n\n`<img src=\"x\">`\n</pre>\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name:    "html_block/unclosed_tag",
+			in:      "<div>\n<img src=x onerror=alert(1)//\n</div>\n",
+			want:    "<div>\n&lt;img src=x onerror=alert(1)//\n</div>\n",
+			removed: nil,
+		},
+		{
+			name:    "html_block/escape_is_raw",
+			in:      "<div>\n\\<img src=x onerror=alert(1)>\n</div>\n",
+			want:    "<div>\n\\<img src=\"x\">\n</div>\n",
+			removed: []string{"onerror="},
+		},
+		{
+			name: "backslash_escape",
+			in:   `\<img src=x onerror=alert(1)>`,
+			want: `\<img src=x onerror=alert(1)>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, removed := p.Sanitize(tt.in)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.removed, removed)
+		})
+	}
+}
+
+func TestPolicy_SanitizeHTML(t *testing.T) {
+	t.Parallel()
+
+	p := defaultPolicy(t)
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "allowed_markup",
+			in:   `<p>a <a href="https://example.com/a?b" title="t">link</a> <img src="/a.png" alt="a"></p>`,
+			want: `<p>a <a href="https://example.com/a?b" title="t">link</a> <img src="/a.png" alt="a"></p>`,
+		},
+		{
+			name: "script",
+			in:   `<p>before<script>alert(1)</script>after</p>`,
+			want: `<p>beforeafter</p>`,
+		},
+		{
+			name: "event_handler",
+			in:   `<img src="x" onerror="alert(1)">`,
+			want: `<img src="x">`,
+		},
+		{
+			name: "javascript_href",
+			in:   `<a href="javascript:alert(1)">x</a><a href="JaVaScRiPt:alert(1)">y</a>`,
+			want: `xy`,
+		},
+		{
+			name: "javascript_href/tab",
+			in:   "<a href=\"java\tscript:alert(document.domain)\">x</a>",
+			want: `x`,
+		},
+		{
+			name: "javascript_href/newline",
+			in:   "<a href=\"java\nscript:alert(document.domain)\">x</a>",
+			want: `x`,
+		},
+		{
+			name: "javascript_src/tab",
+			in:   "<img src=\"java\tscript:alert(document.domain)\" alt=\"x\">",
+			want: `<img alt="x">`,
+		},
+		{
+			name: "disallowed_tag_keeps_text",
+			in:   `<svg onload="alert(1)"><text>hi</text></svg>`,
+			want: `hi`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, p.SanitizeHTML(tt.in))
+		})
+	}
+}
+
+func TestPolicy_Sanitize_Config(t *testing.T) {
+	t.Parallel()
+
+	p, err := sanitize.New(sanitize.Config{AllowedTags: []string{"IFRAME"}, AllowedAttributes: []string{"Src"}, AllowedSchemes: []string{"https"}})
+	require.NoError(t, err)
+	got, removed := p.Sanitize(`<iframe src="https://video.example/1" style="x"></iframe><iframe src="http://video.example/2"></iframe><b>x</b>`)
+	require.Equal(t, `<iframe src="https://video.example/1"></iframe><iframe></iframe>x`, got)
+	require.Equal(t, []string{"style=", "http:", "<b>"}, removed)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		cfg   sanitize.Config
+		valid bool
+	}{
+		{name: "empty", valid: true},
+		{name: "defaults", cfg: sanitize.Config{AllowedTags: sanitize.DefaultAllowedTags, AllowedAttributes: sanitize.DefaultAllowedAttributes, AllowedSchemes: sanitize.DefaultAllowedSchemes}, valid: true},
+		{name: "script", cfg: sanitize.Config{AllowedTags: []string{"Script"}}},
+		{name: "invalid_tag", cfg: sanitize.Config{AllowedTags: []string{"a b"}}},
+		{name: "event_handler", cfg: sanitize.Config{AllowedAttributes: []string{"onclick"}}},
+		{name: "srcdoc", cfg: sanitize.Config{AllowedAttributes: []string{"srcdoc"}}},
+		{name: "javascript", cfg: sanitize.Config{AllowedSchemes: []string{"JavaScript"}}},
+		{name: "invalid_scheme", cfg: sanitize.Config{AllowedSchemes: []string{"http:"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.cfg.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
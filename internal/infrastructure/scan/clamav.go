@@ -0,0 +1,97 @@
+package scan
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamChunkSize is the most sent in one INSTREAM chunk; clamd rejects a stream longer than its
+// StreamMaxLength whatever the chunks.
+const clamChunkSize = 64 << 10
+
+// ClamAV scans files with clamd over TCP, one connection per file.
+type ClamAV struct {
+	addr    string
+	timeout time.Duration
+	dialer  net.Dialer
+}
+
+func NewClamAV(cfg Config) *ClamAV {
+	return &ClamAV{addr: cfg.ClamAVAddr, timeout: time.Duration(cfg.TimeoutSeconds) * time.Second}
+}
+
+// Scan streams r to clamd with the INSTREAM command. A file clamd cannot scan, e.g. one over its size
+// limit, is an error rather than a clean result.
+func (s *ClamAV) Scan(ctx context.Context, r io.Reader) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return Result{}, fmt.Errorf("scan.ClamAV.Scan: %w", err)
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err = stream(conn, r); err != nil {
+		return Result{}, fmt.Errorf("scan.ClamAV.Scan: %w", err)
+	}
+	// the z prefix of the command makes clamd terminate the reply with a null byte
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil {
+		return Result{}, fmt.Errorf("scan.ClamAV.Scan: %w", err)
+	}
+	result, err := parseReply(strings.TrimSuffix(reply, "\x00"))
+	if err != nil {
+		return Result{}, fmt.Errorf("scan.ClamAV.Scan: %w", err)
+	}
+
+	return result, nil
+}
+
+// stream sends r as length-prefixed chunks ended by an empty one.
+func stream(conn net.Conn, r io.Reader) error {
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return err
+	}
+	buf := make([]byte, 4+clamChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n))
+			if _, werr := conn.Write(buf[:4+n]); werr != nil {
+				return werr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := conn.Write([]byte{0, 0, 0, 0})
+
+	return err
+}
+
+// parseReply reads "stream: OK", "stream: <signature> FOUND" or "<reason> ERROR".
+func parseReply(reply string) (Result, error) {
+	verdict := strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case verdict == "OK":
+		return Result{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return Result{Infected: true, Signature: strings.TrimSuffix(verdict, " FOUND")}, nil
+	default:
+		return Result{}, fmt.Errorf("clamd: %s", reply)
+	}
+}
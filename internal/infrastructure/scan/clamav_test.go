@@ -0,0 +1,115 @@
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClamd answers INSTREAM like clamd, finding files that contain "EICAR" infected and refusing
+// ones longer than limit bytes.
+func fakeClamd(t *testing.T, limit int) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveClamd(conn, limit)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func serveClamd(conn net.Conn, limit int) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	if cmd, err := r.ReadString(0); err != nil || cmd != "zINSTREAM\x00" {
+		return
+	}
+	var data bytes.Buffer
+	for {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if _, err := io.CopyN(&data, r, int64(size)); err != nil {
+			return
+		}
+		if data.Len() > limit {
+			_, _ = conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+			return
+		}
+	}
+	reply := "stream: OK\x00"
+	if bytes.Contains(data.Bytes(), []byte("EICAR")) {
+		reply = "stream: Eicar-Test-Signature FOUND\x00"
+	}
+	_, _ = conn.Write([]byte(reply))
+}
+
+func TestClamAV_Scan(t *testing.T) {
+	t.Parallel()
+
+	s := NewClamAV(Config{ClamAVAddr: fakeClamd(t, 1<<20), TimeoutSeconds: 5})
+
+	got, err := s.Scan(t.Context(), strings.NewReader("harmless"))
+	require.NoError(t, err)
+	require.Equal(t, Result{}, got)
+
+	// spans several chunks
+	infected := strings.Repeat("x", clamChunkSize) + "EICAR" + strings.Repeat("x", clamChunkSize)
+	got, err = s.Scan(t.Context(), strings.NewReader(infected))
+	require.NoError(t, err)
+	require.Equal(t, Result{Infected: true, Signature: "Eicar-Test-Signature"}, got)
+
+	got, err = s.Scan(t.Context(), strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, Result{}, got)
+}
+
+func TestClamAV_Scan_Error(t *testing.T) {
+	t.Parallel()
+
+	s := NewClamAV(Config{ClamAVAddr: fakeClamd(t, 10), TimeoutSeconds: 5})
+	_, err := s.Scan(t.Context(), strings.NewReader("more than ten bytes"))
+	require.Error(t, err)
+
+	// nothing listens there
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	_, err = NewClamAV(Config{ClamAVAddr: addr, TimeoutSeconds: 5}).Scan(t.Context(), strings.NewReader("x"))
+	require.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	s, err := New(Config{})
+	require.NoError(t, err)
+	require.Equal(t, Noop{}, s)
+
+	s, err = New(Config{ClamAVAddr: "clamd:3310", TimeoutSeconds: 30})
+	require.NoError(t, err)
+	require.IsType(t, &ClamAV{}, s)
+
+	_, err = New(Config{ClamAVAddr: "clamd:3310"})
+	require.Error(t, err)
+}
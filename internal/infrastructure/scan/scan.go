@@ -0,0 +1,50 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Result is the verdict on a file. Signature names what was found in an infected one.
+type Result struct {
+	Infected  bool
+	Signature string
+}
+
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (Result, error)
+}
+
+type Config struct {
+	// ClamAVAddr is the host:port clamd listens on; empty disables scanning.
+	ClamAVAddr     string `mapstructure:"clamav_addr" json:"clamav_addr"`
+	TimeoutSeconds int    `mapstructure:"timeout_seconds" json:"timeout_seconds"`
+}
+
+func (c Config) Validate() error {
+	if c.ClamAVAddr != "" && c.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeout_seconds must be positive")
+	}
+
+	return nil
+}
+
+// New returns the scanner the config chooses: ClamAV, or Noop without an address.
+func New(cfg Config) (Scanner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("scan.New: %w", err)
+	}
+	if cfg.ClamAVAddr == "" {
+		return Noop{}, nil
+	}
+
+	return NewClamAV(cfg), nil
+}
+
+// Noop finds every file clean.
+type Noop struct{}
+
+func (Noop) Scan(context.Context, io.Reader) (Result, error) {
+	return Result{}, nil
+}
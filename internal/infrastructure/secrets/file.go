@@ -0,0 +1,43 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileProvider reads every secret from its own file, named after the secret, e.g. the files
+// Docker and Kubernetes mount under /run/secrets. A missing file is an unset secret.
+type fileProvider struct {
+	dir string
+}
+
+func NewFileProvider(dir string) (*fileProvider, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("secrets.NewFileProvider: %w", fmt.Errorf("dir is required"))
+	}
+
+	return &fileProvider{dir: dir}, nil
+}
+
+func (p *fileProvider) Fetch(ctx context.Context, name string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("secrets.fileProvider.Fetch: %w", err)
+	}
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("secrets.fileProvider.Fetch: invalid name %q", name)
+	}
+	data, err := os.ReadFile(filepath.Join(p.dir, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("secrets.fileProvider.Fetch: %w", err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
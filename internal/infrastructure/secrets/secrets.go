@@ -0,0 +1,205 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Names of the secrets the binaries use.
+const (
+	JWTSecret        = "jwt_secret"
+	DatabasePassword = "database_password"
+	PasswordPepper   = "password_pepper"
+	// ContentKey encrypts entity content; ContentKeyPrevious holds the key it replaced until the
+	// content is rewrapped, as the previous value of ContentKey is only known until a restart.
+	ContentKey         = "content_key"
+	ContentKeyPrevious = "content_key_previous"
+)
+
+const (
+	ProviderEnv   = "env"
+	ProviderFile  = "file"
+	ProviderVault = "vault"
+)
+
+// fetchTimeout bounds a fetch made for a caller without a context, see Key.
+const fetchTimeout = 10 * time.Second
+
+type Provider interface {
+	// Fetch returns the secret called name, or "" when it is not set.
+	Fetch(ctx context.Context, name string) (string, error)
+}
+
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// Config chooses where secrets come from. With refresh_seconds a secret is fetched again once it
+// is that old, so it can be rotated without a restart; 0 fetches every secret once.
+type Config struct {
+	Provider       string      `mapstructure:"provider" json:"provider"`
+	Dir            string      `mapstructure:"dir" json:"dir"`
+	RefreshSeconds int         `mapstructure:"refresh_seconds" json:"refresh_seconds"`
+	Vault          VaultConfig `mapstructure:"vault" json:"vault"`
+}
+
+// VaultConfig points at a KV version 2 secret whose keys are the secret names. The token is read
+// from VAULT_TOKEN.
+type VaultConfig struct {
+	Address string `mapstructure:"address" json:"address"`
+	Mount   string `mapstructure:"mount" json:"mount"`
+	Path    string `mapstructure:"path" json:"path"`
+}
+
+func (c Config) Validate() error {
+	switch c.Provider {
+	case ProviderEnv:
+	case ProviderFile:
+		if c.Dir == "" {
+			return fmt.Errorf("dir is required for the file provider")
+		}
+	case ProviderVault:
+		u, err := url.Parse(c.Vault.Address)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("vault.address must be an absolute http(s) URL")
+		}
+		if c.Vault.Mount == "" || c.Vault.Path == "" {
+			return fmt.Errorf("vault.mount and vault.path are required")
+		}
+	default:
+		return fmt.Errorf("unknown provider %q, expected env, file or vault", c.Provider)
+	}
+	if c.RefreshSeconds < 0 {
+		return fmt.Errorf("refresh_seconds must not be negative")
+	}
+
+	return nil
+}
+
+// Map serves secrets that are already loaded, e.g. from the environment by the config loader.
+type Map map[string]string
+
+func (m Map) Fetch(_ context.Context, name string) (string, error) {
+	return m[name], nil
+}
+
+// Store fetches secrets from a provider on first use and caches them. When a refetched secret
+// has changed, the value it replaced is kept as the previous one, so that tokens signed and
+// passwords peppered with it stay valid during a rotation.
+type Store struct {
+	provider Provider
+	timeGen  TimeGenerator
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	current  string
+	previous string
+	fetched  time.Time
+	stale    bool
+}
+
+func NewStore(provider Provider, timeGen TimeGenerator, cfg Config) (*Store, error) {
+	if provider == nil || timeGen == nil {
+		return nil, fmt.Errorf("secrets.NewStore: %w", fmt.Errorf("nil dependency"))
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("secrets.NewStore: %w", err)
+	}
+
+	return &Store{
+		provider: provider,
+		timeGen:  timeGen,
+		ttl:      time.Duration(cfg.RefreshSeconds) * time.Second,
+		entries:  make(map[string]*entry),
+	}, nil
+}
+
+// Get returns the current value of the secret, "" when it is not set.
+func (s *Store) Get(ctx context.Context, name string) (string, error) {
+	current, _, err := s.Versions(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("secrets.Store.Get: %w", err)
+	}
+
+	return current, nil
+}
+
+// Versions returns the current value of the secret and the one it replaced, if any.
+func (s *Store) Versions(ctx context.Context, name string) (string, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timeGen.Now()
+	e, ok := s.entries[name]
+	if ok && !e.stale && (s.ttl == 0 || now.Sub(e.fetched) < s.ttl) {
+		return e.current, e.previous, nil
+	}
+
+	value, err := s.provider.Fetch(ctx, name)
+	if err != nil {
+		if ok {
+			// keep serving the cached value, the provider may be back on the next refresh
+			return e.current, e.previous, fmt.Errorf("secrets.Store.Versions: %s: %w", name, err)
+		}
+		return "", "", fmt.Errorf("secrets.Store.Versions: %s: %w", name, err)
+	}
+	if !ok {
+		e = &entry{}
+		s.entries[name] = e
+	}
+	if ok && value != e.current {
+		e.previous = e.current
+	}
+	e.current = value
+	e.fetched = now
+	e.stale = false
+
+	return e.current, e.previous, nil
+}
+
+// Refresh makes the next use of every secret fetch it again.
+func (s *Store) Refresh() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.entries {
+		e.stale = true
+	}
+}
+
+// Key returns the secret as a signing or pepper key for code that has no context.
+func (s *Store) Key(name string) Key {
+	return Key{store: s, name: name}
+}
+
+type Key struct {
+	store *Store
+	name  string
+}
+
+// Keys returns the current key, nil when the secret is not set, and the key it replaced.
+func (k Key) Keys() ([]byte, [][]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	current, previous, err := k.store.Versions(ctx, k.name)
+	if err != nil && current == "" {
+		return nil, nil, fmt.Errorf("secrets.Key.Keys: %w", err)
+	}
+	var prev [][]byte
+	if previous != "" {
+		prev = [][]byte{[]byte(previous)}
+	}
+	if current == "" {
+		return nil, prev, nil
+	}
+
+	return []byte(current), prev, nil
+}
@@ -0,0 +1,233 @@
+package secrets_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+// counting serves values and counts the fetches.
+type counting struct {
+	values  map[string]string
+	err     error
+	fetches int
+}
+
+func (p *counting) Fetch(_ context.Context, name string) (string, error) {
+	p.fetches++
+	return p.values[name], p.err
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	vault := secrets.VaultConfig{Address: "https://vault.example.com:8200", Mount: "secret", Path: "easygodocs"}
+	tests := []struct {
+		name string
+		cfg  secrets.Config
+		ok   bool
+	}{
+		{name: "env", cfg: secrets.Config{Provider: secrets.ProviderEnv}, ok: true},
+		{name: "file", cfg: secrets.Config{Provider: secrets.ProviderFile, Dir: "/run/secrets"}, ok: true},
+		{name: "file without dir", cfg: secrets.Config{Provider: secrets.ProviderFile}},
+		{name: "vault", cfg: secrets.Config{Provider: secrets.ProviderVault, Vault: vault, RefreshSeconds: 300}, ok: true},
+		{name: "vault relative address", cfg: secrets.Config{Provider: secrets.ProviderVault, Vault: secrets.VaultConfig{Address: "vault", Mount: "secret", Path: "p"}}},
+		{name: "vault without path", cfg: secrets.Config{Provider: secrets.ProviderVault, Vault: secrets.VaultConfig{Address: vault.Address, Mount: "secret"}}},
+		{name: "unknown provider", cfg: secrets.Config{Provider: "aws"}},
+		{name: "negative refresh", cfg: secrets.Config{Provider: secrets.ProviderEnv, RefreshSeconds: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.ok {
+				require.NoError(t, tt.cfg.Validate())
+			} else {
+				require.Error(t, tt.cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	t.Parallel()
+
+	_, err := secrets.NewStore(nil, &clock{}, secrets.Config{Provider: secrets.ProviderEnv})
+	require.Error(t, err)
+	_, err = secrets.NewStore(secrets.Map{}, &clock{}, secrets.Config{})
+	require.Error(t, err)
+	_, err = secrets.NewStore(secrets.Map{}, &clock{}, secrets.Config{Provider: secrets.ProviderEnv})
+	require.NoError(t, err)
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	now := &clock{now: time.Date(2025, 9, 30, 12, 0, 0, 0, time.UTC)}
+	provider := &counting{values: map[string]string{secrets.JWTSecret: "one"}}
+	store, err := secrets.NewStore(provider, now, secrets.Config{
+		Provider: secrets.ProviderVault, RefreshSeconds: 60,
+		Vault: secrets.VaultConfig{Address: "http://vault:8200", Mount: "secret", Path: "p"},
+	})
+	require.NoError(t, err)
+	require.Zero(t, provider.fetches, "fetched lazily")
+
+	got, err := store.Get(ctx, secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "one", got)
+
+	// cached until refresh_seconds pass
+	provider.values[secrets.JWTSecret] = "two"
+	now.now = now.now.Add(59 * time.Second)
+	got, err = store.Get(ctx, secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "one", got)
+	require.Equal(t, 1, provider.fetches)
+
+	// rotated: the replaced value is kept
+	now.now = now.now.Add(time.Second)
+	current, previous, err := store.Versions(ctx, secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "two", current)
+	require.Equal(t, "one", previous)
+
+	key, prev, err := store.Key(secrets.JWTSecret).Keys()
+	require.NoError(t, err)
+	require.Equal(t, []byte("two"), key)
+	require.Equal(t, [][]byte{[]byte("one")}, prev)
+
+	// an unchanged value keeps the previous one
+	now.now = now.now.Add(time.Minute)
+	_, previous, err = store.Versions(ctx, secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "one", previous)
+
+	// a failed refetch keeps serving the cached value
+	provider.err = errors.New("vault down")
+	now.now = now.now.Add(time.Minute)
+	current, _, err = store.Versions(ctx, secrets.JWTSecret)
+	require.ErrorIs(t, err, provider.err)
+	require.Equal(t, "two", current)
+	key, _, err = store.Key(secrets.JWTSecret).Keys()
+	require.NoError(t, err)
+	require.Equal(t, []byte("two"), key)
+
+	// nothing cached to fall back to
+	_, _, err = store.Key(secrets.PasswordPepper).Keys()
+	require.ErrorIs(t, err, provider.err)
+
+	// unset
+	provider.err = nil
+	key, prev, err = store.Key(secrets.PasswordPepper).Keys()
+	require.NoError(t, err)
+	require.Nil(t, key)
+	require.Nil(t, prev)
+}
+
+func TestStore_Refresh(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	provider := &counting{values: map[string]string{secrets.PasswordPepper: "one"}}
+	store, err := secrets.NewStore(provider, &clock{now: time.Now()}, secrets.Config{Provider: secrets.ProviderEnv})
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, secrets.PasswordPepper)
+	require.NoError(t, err)
+	// refresh_seconds 0 fetches once
+	provider.values[secrets.PasswordPepper] = "two"
+	got, err := store.Get(ctx, secrets.PasswordPepper)
+	require.NoError(t, err)
+	require.Equal(t, "one", got)
+
+	store.Refresh()
+	got, err = store.Get(ctx, secrets.PasswordPepper)
+	require.NoError(t, err)
+	require.Equal(t, "two", got)
+	require.Equal(t, 2, provider.fetches)
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := secrets.NewFileProvider("")
+	require.Error(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, secrets.DatabasePassword), []byte("p@ss word\n"), 0o600))
+	provider, err := secrets.NewFileProvider(dir)
+	require.NoError(t, err)
+
+	got, err := provider.Fetch(t.Context(), secrets.DatabasePassword)
+	require.NoError(t, err)
+	require.Equal(t, "p@ss word", got)
+
+	got, err = provider.Fetch(t.Context(), secrets.PasswordPepper)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = provider.Fetch(t.Context(), "../etc/passwd")
+	require.Error(t, err)
+}
+
+func TestVaultProvider(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/easygodocs":
+			_, _ = w.Write([]byte(`{"data":{"data":{"jwt_secret":"s3cret","password_pepper":42},"metadata":{"version":3}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := secrets.VaultConfig{Address: srv.URL, Mount: "secret", Path: "/easygodocs"}
+	_, err := secrets.NewVaultProvider(cfg, "", srv.Client())
+	require.Error(t, err)
+	_, err = secrets.NewVaultProvider(secrets.VaultConfig{}, "token", srv.Client())
+	require.Error(t, err)
+
+	provider, err := secrets.NewVaultProvider(cfg, "token", srv.Client())
+	require.NoError(t, err)
+
+	got, err := provider.Fetch(t.Context(), secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", got)
+
+	got, err = provider.Fetch(t.Context(), secrets.DatabasePassword)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	_, err = provider.Fetch(t.Context(), secrets.PasswordPepper)
+	require.Error(t, err)
+
+	cfg.Path = "missing"
+	provider, err = secrets.NewVaultProvider(cfg, "token", srv.Client())
+	require.NoError(t, err)
+	got, err = provider.Fetch(t.Context(), secrets.JWTSecret)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	provider, err = secrets.NewVaultProvider(secrets.VaultConfig{Address: srv.URL, Mount: "secret", Path: "easygodocs"}, "wrong", srv.Client())
+	require.NoError(t, err)
+	_, err = provider.Fetch(t.Context(), secrets.JWTSecret)
+	require.Error(t, err)
+}
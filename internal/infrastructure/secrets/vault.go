@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// vaultProvider reads a KV version 2 secret over the HTTP API: every secret is a key of it.
+type vaultProvider struct {
+	cfg    VaultConfig
+	token  string
+	client *http.Client
+}
+
+func NewVaultProvider(cfg VaultConfig, token string, client *http.Client) (*vaultProvider, error) {
+	if err := (Config{Provider: ProviderVault, Vault: cfg}).Validate(); err != nil {
+		return nil, fmt.Errorf("secrets.NewVaultProvider: %w", err)
+	}
+	if token == "" {
+		return nil, fmt.Errorf("secrets.NewVaultProvider: %w", fmt.Errorf("token is required, set VAULT_TOKEN"))
+	}
+	if client == nil {
+		return nil, fmt.Errorf("secrets.NewVaultProvider: %w", fmt.Errorf("nil client"))
+	}
+
+	return &vaultProvider{cfg: cfg, token: token, client: client}, nil
+}
+
+func (p *vaultProvider) Fetch(ctx context.Context, name string) (string, error) {
+	endpoint, err := url.JoinPath(p.cfg.Address, "v1", p.cfg.Mount, "data", strings.Trim(p.cfg.Path, "/"))
+	if err != nil {
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: unexpected status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: %w", err)
+	}
+	value, ok := body.Data.Data[name]
+	if !ok {
+		return "", nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("secrets.vaultProvider.Fetch: %s is not a string", name)
+	}
+
+	return s, nil
+}
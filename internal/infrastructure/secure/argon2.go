@@ -0,0 +1,96 @@
+package secure
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix    = "$argon2id$"
+	argon2SaltLength  = 16
+	argon2KeyLength   = 32
+	argon2MinMemoryKB = 8 * 1024
+)
+
+// Argon2Params are the argon2id cost settings, see RFC 9106.
+type Argon2Params struct {
+	MemoryKiB   uint32 `mapstructure:"memory_kib" json:"memory_kib"`
+	Iterations  uint32 `mapstructure:"iterations" json:"iterations"`
+	Parallelism uint8  `mapstructure:"parallelism" json:"parallelism"`
+}
+
+func (p Argon2Params) Validate() error {
+	if p.MemoryKiB < argon2MinMemoryKB {
+		return fmt.Errorf("argon2 memory_kib must be at least %d", argon2MinMemoryKB)
+	}
+	if p.Iterations == 0 || p.Parallelism == 0 {
+		return fmt.Errorf("argon2 iterations and parallelism must be positive")
+	}
+
+	return nil
+}
+
+func (p Argon2Params) weakerThan(o Argon2Params) bool {
+	return p.MemoryKiB < o.MemoryKiB || p.Iterations < o.Iterations || p.Parallelism < o.Parallelism
+}
+
+// hashArgon2id returns the hash in the PHC string format: $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>.
+func hashArgon2id(password []byte, params Argon2Params) ([]byte, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("hashArgon2id: %w", err)
+	}
+	key := argon2.IDKey(password, salt, params.Iterations, params.MemoryKiB, params.Parallelism, argon2KeyLength)
+
+	return fmt.Appendf(nil, "%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version,
+		params.MemoryKiB, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func checkArgon2id(hash, password []byte) error {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+	got := argon2.IDKey(password, salt, params.Iterations, params.MemoryKiB, params.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(got, key) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+
+	return nil
+}
+
+func decodeArgon2id(hash []byte) (Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: malformed hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: unsupported version %q", parts[2])
+	}
+	var params Argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.MemoryKiB, &params.Iterations, &params.Parallelism); err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: %w", err)
+	}
+	if params.Iterations == 0 || params.Parallelism == 0 {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: malformed parameters %q", parts[3])
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Argon2Params{}, nil, nil, fmt.Errorf("decodeArgon2id: malformed key")
+	}
+
+	return params, salt, key, nil
+}
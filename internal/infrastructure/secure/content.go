@@ -0,0 +1,222 @@
+package secure
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoContentKey means no content key is set to encrypt with.
+	ErrNoContentKey = errors.New("secure: no content key is set")
+	// ErrUnknownContentKey means the key content was encrypted with is neither a current nor a previous one.
+	ErrUnknownContentKey = errors.New("secure: content was encrypted with an unknown key")
+)
+
+// Encrypted content is the base64 of a wrapped data key followed by the content sealed with it.
+// Every value gets its own random data key, sealed with AES-256-GCM under the key encryption key
+// derived from the content key; the id of that content key is stored next to the value, made like a
+// pepper id. Rotating the content key then only has to rewrap the data keys, not reseal the content.
+// The content is sealed with additional data naming the row it is stored in, see ContentAAD.
+const (
+	dataKeySize    = 32
+	wrappedKeySize = gcmNonceSize + dataKeySize + gcmTagSize
+	gcmNonceSize   = 12
+	gcmTagSize     = 16
+
+	// contentKeyLabel separates the key encryption key from other uses of the same secret.
+	contentKeyLabel = "easygodocs content encryption"
+)
+
+// ContentCipher encrypts content with the current key of the first key source and decrypts content
+// encrypted with any current or previous key of all of them. Further sources hold retired keys
+// explicitly, so content is readable across restarts until it is rewrapped.
+type ContentCipher struct {
+	keys []KeySource
+}
+
+func NewContentCipher(keys ...KeySource) *ContentCipher {
+	if len(keys) == 0 {
+		panic("ContentCipher: no key source")
+	}
+	for _, k := range keys {
+		if k == nil {
+			panic("ContentCipher: nil key source")
+		}
+	}
+
+	return &ContentCipher{keys: keys}
+}
+
+// ContentAAD returns the additional data content is sealed with: the table and the columns keying its
+// row there. Content copied to another row, or an older value of the row, then fails to decrypt.
+func ContentAAD(table string, key ...any) []byte {
+	aad := []byte(table)
+	for _, k := range key {
+		aad = fmt.Appendf(aad, "/%v", k)
+	}
+
+	return aad
+}
+
+// KeyID returns the id of the key content is encrypted with now.
+func (c *ContentCipher) KeyID() (string, error) {
+	key, err := c.current()
+	if err != nil {
+		return "", fmt.Errorf("secure.ContentCipher.KeyID: %w", err)
+	}
+
+	return pepperID(key), nil
+}
+
+// Encrypt seals plaintext with aad under a new data key and returns it with the id of the key the data
+// key is wrapped with.
+func (c *ContentCipher) Encrypt(plaintext string, aad []byte) (string, string, error) {
+	key, err := c.current()
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Encrypt: %w", err)
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	defer ZeroBytes(dataKey)
+	if _, err = rand.Read(dataKey); err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Encrypt: %w", err)
+	}
+	wrapped, err := seal(kek(key), dataKey, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Encrypt: %w", err)
+	}
+	sealed, err := seal(dataKey, []byte(plaintext), aad)
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Encrypt: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrapped, sealed...)), pepperID(key), nil
+}
+
+// Decrypt opens ciphertext encrypted with the key keyID and sealed with aad.
+func (c *ContentCipher) Decrypt(ciphertext, keyID string, aad []byte) (string, error) {
+	b, dataKey, err := c.unwrap(ciphertext, keyID)
+	if err != nil {
+		return "", fmt.Errorf("secure.ContentCipher.Decrypt: %w", err)
+	}
+	defer ZeroBytes(dataKey)
+	plaintext, err := open(dataKey, b[wrappedKeySize:], aad)
+	if err != nil {
+		return "", fmt.Errorf("secure.ContentCipher.Decrypt: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// Rewrap wraps the data key of ciphertext, encrypted with the key keyID, with the current key. The
+// sealed content is kept as it is, so it needs no additional data.
+func (c *ContentCipher) Rewrap(ciphertext, keyID string) (string, string, error) {
+	key, err := c.current()
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Rewrap: %w", err)
+	}
+	b, dataKey, err := c.unwrap(ciphertext, keyID)
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Rewrap: %w", err)
+	}
+	defer ZeroBytes(dataKey)
+	wrapped, err := seal(kek(key), dataKey, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("secure.ContentCipher.Rewrap: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrapped, b[wrappedKeySize:]...)), pepperID(key), nil
+}
+
+func (c *ContentCipher) current() ([]byte, error) {
+	key, _, err := c.keys[0].Keys()
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, ErrNoContentKey
+	}
+
+	return key, nil
+}
+
+// find returns the current or previous key with id.
+func (c *ContentCipher) find(id string) ([]byte, error) {
+	for _, source := range c.keys {
+		current, previous, err := source.Keys()
+		if err != nil {
+			return nil, err
+		}
+		if key, ok := findPepper(id, current, previous); ok {
+			return key, nil
+		}
+	}
+
+	return nil, ErrUnknownContentKey
+}
+
+// unwrap decodes ciphertext and returns it with its data key.
+func (c *ContentCipher) unwrap(ciphertext, keyID string) ([]byte, []byte, error) {
+	key, err := c.find(keyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil || len(b) < wrappedKeySize+gcmNonceSize+gcmTagSize {
+		return nil, nil, fmt.Errorf("malformed ciphertext")
+	}
+	dataKey, err := open(kek(key), b[:wrappedKeySize], nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, dataKey, nil
+}
+
+// kek derives the AES-256 key encryption key from a content key of any length.
+func kek(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(contentKeyLabel))
+	return mac.Sum(nil)
+}
+
+// seal returns a random nonce followed by plaintext sealed with key and aad.
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcmNonceSize, gcmNonceSize+len(plaintext)+gcmTagSize)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcmNonceSize+gcmTagSize {
+		return nil, fmt.Errorf("malformed ciphertext")
+	}
+
+	return aead.Open(nil, sealed[:gcmNonceSize], sealed[gcmNonceSize:], aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
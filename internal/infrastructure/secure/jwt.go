@@ -1,14 +1,57 @@
 package secure
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/66gu1/easygodocs/internal/infrastructure/apperr"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	CodeTokenExpired     apperr.Code = "token/expired"
+	CodeTokenNotYetValid apperr.Code = "token/not_yet_valid"
+)
+
+func init() {
+	apperr.Register(CodeTokenExpired, "Token expired", apperr.ClassUnauthorized)
+	apperr.Register(CodeTokenNotYetValid, "Token not yet valid", apperr.ClassUnauthorized)
+}
+
+// ErrTokenExpired means the client should refresh the token.
+func ErrTokenExpired() error {
+	return apperr.New("The token has expired, refresh it", CodeTokenExpired, apperr.ClassUnauthorized, apperr.LogLevelWarn)
+}
+
+// ErrTokenNotYetValid means the token was issued for later, usually by a server whose clock is ahead,
+// so refreshing does not help.
+func ErrTokenNotYetValid() error {
+	return apperr.New("The token is not valid yet", CodeTokenNotYetValid, apperr.ClassUnauthorized, apperr.LogLevelWarn)
+}
+
+// KeySource returns the key in use and the keys it replaced. Replaced keys are still accepted
+// when checking, so a key can be rotated without invalidating what was made with the old one.
+type KeySource interface {
+	Keys() (current []byte, previous [][]byte, err error)
+}
+
+type staticKey []byte
+
+func (k staticKey) Keys() ([]byte, [][]byte, error) {
+	return k, nil, nil
+}
+
 type TokenCodec struct {
-	JWTSecret []byte
+	keys KeySource
+	// issuer and audience, when set, must be in every token parsed. With acceptLegacy, tokens
+	// that have neither are accepted too.
+	issuer       string
+	audience     string
+	acceptLegacy bool
+	// leeway is how far the clock may be off when checking exp and nbf.
+	leeway time.Duration
 }
 
 func NewTokenCodec(secret []byte) *TokenCodec {
@@ -16,31 +59,119 @@ func NewTokenCodec(secret []byte) *TokenCodec {
 		panic("TokenCodec: secret is empty")
 	}
 	return &TokenCodec{
-		JWTSecret: secret,
+		keys: staticKey(secret),
 	}
 }
 
+// NewRotatingTokenCodec signs with the current key of keys and accepts tokens signed with the
+// previous ones too.
+func NewRotatingTokenCodec(keys KeySource) *TokenCodec {
+	if keys == nil {
+		panic("TokenCodec: nil key source")
+	}
+	return &TokenCodec{
+		keys: keys,
+	}
+}
+
+// WithIssuerAudience makes ParseToken reject tokens of another issuer or audience, so tokens of one
+// environment are not accepted in another. With acceptLegacy, tokens made before the issuer and the
+// audience were set are still accepted, until they expire.
+func (c *TokenCodec) WithIssuerAudience(issuer, audience string, acceptLegacy bool) *TokenCodec {
+	c.issuer = issuer
+	c.audience = audience
+	c.acceptLegacy = acceptLegacy
+	return c
+}
+
+// WithLeeway tolerates clocks that are off by up to leeway when checking the times of tokens.
+func (c *TokenCodec) WithLeeway(leeway time.Duration) *TokenCodec {
+	c.leeway = leeway
+	return c
+}
+
+// Leeway is the clock skew tolerated when checking the times of tokens.
+func (c *TokenCodec) Leeway() time.Duration {
+	return c.leeway
+}
+
 func (c *TokenCodec) ParseToken(tokenStr string, claims jwt.Claims) error {
+	current, previous, err := c.keys.Keys()
+	if err != nil {
+		return fmt.Errorf("NewTokenCodec.ParseToken: %w", err)
+	}
+	keySet := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(previous)+1)}
+	if len(current) > 0 {
+		keySet.Keys = append(keySet.Keys, current)
+	}
+	for _, key := range previous {
+		keySet.Keys = append(keySet.Keys, key)
+	}
+	if len(keySet.Keys) == 0 {
+		return fmt.Errorf("NewTokenCodec.ParseToken: %w", fmt.Errorf("no signing key"))
+	}
+
 	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
 		method, ok := t.Method.(*jwt.SigningMethodHMAC)
 		if !ok || method != jwt.SigningMethodHS256 {
 			return nil, fmt.Errorf("NewTokenCodec.ParseToken: %w", apperr.ErrUnauthorized().WithDetail("unexpected signing method"))
 		}
-		return c.JWTSecret, nil
-	})
-	if err != nil {
+		return keySet, nil
+	}, jwt.WithLeeway(c.leeway))
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return fmt.Errorf("NewTokenCodec.ParseToken: %w", ErrTokenExpired())
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		return fmt.Errorf("NewTokenCodec.ParseToken: %w", ErrTokenNotYetValid())
+	case err != nil:
 		return fmt.Errorf("NewTokenCodec.ParseToken: %w", apperr.ErrUnauthorized().WithDetail(err.Error()))
 	}
 	if !token.Valid {
 		return fmt.Errorf("NewTokenCodec.ParseToken: %w", apperr.ErrUnauthorized().WithDetail("invalid token"))
 	}
+	if err = c.checkIssuerAudience(claims); err != nil {
+		return fmt.Errorf("NewTokenCodec.ParseToken: %w", err)
+	}
+
+	return nil
+}
+
+func (c *TokenCodec) checkIssuerAudience(claims jwt.Claims) error {
+	if c.issuer == "" && c.audience == "" {
+		return nil
+	}
+	issuer, err := claims.GetIssuer()
+	if err != nil {
+		return apperr.ErrUnauthorized().WithDetail(err.Error())
+	}
+	audience, err := claims.GetAudience()
+	if err != nil {
+		return apperr.ErrUnauthorized().WithDetail(err.Error())
+	}
+
+	if c.acceptLegacy && issuer == "" && len(audience) == 0 {
+		return nil
+	}
+	if c.issuer != "" && issuer != c.issuer {
+		return apperr.ErrUnauthorized().WithDetail("unexpected token issuer")
+	}
+	if c.audience != "" && !slices.Contains(audience, c.audience) {
+		return apperr.ErrUnauthorized().WithDetail("unexpected token audience")
+	}
 
 	return nil
 }
 
 func (c *TokenCodec) GenerateToken(claims jwt.Claims) (string, error) {
+	key, _, err := c.keys.Keys()
+	if err != nil {
+		return "", fmt.Errorf("TokenCodec.GenerateToken: %w", err)
+	}
+	if len(key) == 0 {
+		return "", fmt.Errorf("TokenCodec.GenerateToken: %w", fmt.Errorf("no signing key"))
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenStr, err := token.SignedString(c.JWTSecret)
+	tokenStr, err := token.SignedString(key)
 	if err != nil {
 		return "", fmt.Errorf("TokenCodec.GenerateToken: %w", err)
 	}
@@ -1,6 +1,7 @@
 package secure
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"runtime"
@@ -10,6 +11,10 @@ import (
 
 var ErrMismatchedHashAndPassword = fmt.Errorf("mismatched hash and password")
 
+// MaxPasswordBytes is the longest password bcrypt accepts. Passwords are held to it whatever the
+// algorithm, so switching back to bcrypt can still rehash every one of them.
+const MaxPasswordBytes = 72
+
 func ZeroBytes(b []byte) {
 	for i := range b {
 		b[i] = 0
@@ -17,24 +22,107 @@ func ZeroBytes(b []byte) {
 	runtime.KeepAlive(b)
 }
 
-type PasswordHasher struct{}
+// Algorithm names a password hash algorithm. A stored hash carries its algorithm and cost in a
+// "$"-separated prefix ("$2a$12$..." for bcrypt, "$argon2id$v=19$m=...,t=...,p=...$..." for argon2id),
+// so hashes made with different settings can be checked side by side.
+type Algorithm string
+
+const (
+	AlgorithmBcrypt   Algorithm = "bcrypt"
+	AlgorithmArgon2id Algorithm = "argon2id"
+)
+
+// HashParams choose how new password hashes are made.
+type HashParams struct {
+	Algorithm  Algorithm
+	BcryptCost int
+	Argon2     Argon2Params
+}
+
+func (p HashParams) Validate() error {
+	switch p.Algorithm {
+	case AlgorithmBcrypt:
+		if p.BcryptCost < bcrypt.MinCost || p.BcryptCost > bcrypt.MaxCost {
+			return fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case AlgorithmArgon2id:
+		return p.Argon2.Validate()
+	default:
+		return fmt.Errorf("unknown password hash algorithm %q", p.Algorithm)
+	}
+
+	return nil
+}
+
+type PasswordHasher struct {
+	peppers KeySource
+}
 
 func NewPasswordHasher() *PasswordHasher {
 	return &PasswordHasher{}
 }
 
-func (p *PasswordHasher) HashPassword(password []byte, cost int) ([]byte, error) {
+// NewPepperedPasswordHasher mixes the current pepper of peppers into new password hashes, so a
+// leaked database alone is not enough to guess passwords. Hashes made with a previous pepper or
+// without one are still checked; NeedsRehash reports them, so they are upgraded on the next login.
+// No pepper set means no pepper. Refresh token hashes are never peppered.
+func NewPepperedPasswordHasher(peppers KeySource) *PasswordHasher {
+	if peppers == nil {
+		panic("PasswordHasher: nil pepper source")
+	}
+	return &PasswordHasher{peppers: peppers}
+}
+
+// HashPassword hashes password with params and zeroes it.
+func (p *PasswordHasher) HashPassword(password []byte, params HashParams) ([]byte, error) {
 	defer ZeroBytes(password)
-	hash, err := bcrypt.GenerateFromPassword(password, cost)
+
+	pepper, _, err := p.pepper()
+	if err != nil {
+		return nil, fmt.Errorf("secure.HashPassword: %w", err)
+	}
+	if len(pepper) == 0 {
+		hash, err := hashPassword(password, params)
+		if err != nil {
+			return nil, fmt.Errorf("secure.HashPassword: %w", err)
+		}
+		return hash, nil
+	}
+
+	peppered := pepperPassword(pepper, password)
+	defer ZeroBytes(peppered)
+	hash, err := hashPassword(peppered, params)
 	if err != nil {
 		return nil, fmt.Errorf("secure.HashPassword: %w", err)
 	}
 
+	return append([]byte(pepperPrefix+pepperID(pepper)), hash...), nil
+}
+
+func hashPassword(password []byte, params HashParams) ([]byte, error) {
+	var (
+		hash []byte
+		err  error
+	)
+	switch params.Algorithm {
+	case AlgorithmBcrypt:
+		hash, err = bcrypt.GenerateFromPassword(password, params.BcryptCost)
+	case AlgorithmArgon2id:
+		hash, err = hashArgon2id(password, params.Argon2)
+	default:
+		err = fmt.Errorf("unknown password hash algorithm %q", params.Algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	return hash, nil
 }
 
 func (p *PasswordHasher) HashRefreshToken(token []byte) ([]byte, error) {
-	hash, err := p.HashPassword(token, bcrypt.MinCost)
+	defer ZeroBytes(token)
+
+	hash, err := hashPassword(token, HashParams{Algorithm: AlgorithmBcrypt, BcryptCost: bcrypt.MinCost})
 	if err != nil {
 		return nil, fmt.Errorf("secure.HashRefreshToken: %w", err)
 	}
@@ -43,8 +131,31 @@ func (p *PasswordHasher) HashRefreshToken(token []byte) ([]byte, error) {
 }
 
 func (p *PasswordHasher) CheckPasswordHash(hash, password []byte) error {
-	if err := bcrypt.CompareHashAndPassword(hash, password); err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+	if isPeppered(hash) {
+		id, inner, err := splitPeppered(hash)
+		if err != nil {
+			return fmt.Errorf("secure.CheckPasswordHash: %w", err)
+		}
+		current, previous, err := p.pepper()
+		if err != nil {
+			return fmt.Errorf("secure.CheckPasswordHash: %w", err)
+		}
+		pepper, ok := findPepper(id, current, previous)
+		if !ok {
+			return fmt.Errorf("secure.CheckPasswordHash: %w", fmt.Errorf("unknown pepper %s", id))
+		}
+		hash, password = inner, pepperPassword(pepper, password)
+		defer ZeroBytes(password)
+	}
+
+	var err error
+	if isArgon2id(hash) {
+		err = checkArgon2id(hash, password)
+	} else {
+		err = bcrypt.CompareHashAndPassword(hash, password)
+	}
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) || errors.Is(err, ErrMismatchedHashAndPassword) {
 			return fmt.Errorf("secure.CheckPasswordHash: %w", ErrMismatchedHashAndPassword)
 		}
 		return fmt.Errorf("secure.CheckPasswordHash: %w", err)
@@ -52,3 +163,55 @@ func (p *PasswordHasher) CheckPasswordHash(hash, password []byte) error {
 
 	return nil
 }
+
+// NeedsRehash reports whether hash was made with another algorithm than params choose, with
+// a weaker cost or with another pepper than the current one. A hash that cannot be parsed needs
+// a rehash too.
+func (p *PasswordHasher) NeedsRehash(hash []byte, params HashParams) bool {
+	pepper, _, err := p.pepper()
+	if err != nil {
+		return false
+	}
+	if isPeppered(hash) {
+		id, inner, err := splitPeppered(hash)
+		if err != nil || len(pepper) == 0 || id != pepperID(pepper) {
+			return true
+		}
+		hash = inner
+	} else if len(pepper) > 0 {
+		return true
+	}
+
+	switch params.Algorithm {
+	case AlgorithmBcrypt:
+		if isArgon2id(hash) {
+			return true
+		}
+		cost, err := bcrypt.Cost(hash)
+		return err != nil || cost < params.BcryptCost
+	case AlgorithmArgon2id:
+		if !isArgon2id(hash) {
+			return true
+		}
+		stored, _, _, err := decodeArgon2id(hash)
+		return err != nil || stored.weakerThan(params.Argon2)
+	default:
+		return false
+	}
+}
+
+func (p *PasswordHasher) pepper() ([]byte, [][]byte, error) {
+	if p.peppers == nil {
+		return nil, nil, nil
+	}
+	current, previous, err := p.peppers.Keys()
+	if err != nil {
+		return nil, nil, fmt.Errorf("pepper: %w", err)
+	}
+
+	return current, previous, nil
+}
+
+func isArgon2id(hash []byte) bool {
+	return bytes.HasPrefix(hash, []byte(argon2idPrefix))
+}
@@ -0,0 +1,60 @@
+package secure
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// A peppered hash is "$pepper$<pepper id>" followed by the inner hash of HMAC-SHA256(pepper, password).
+// The pepper id is the start of the SHA-256 of the pepper, so the pepper a hash was made with can
+// be found among the current and previous ones without storing the pepper itself.
+const (
+	pepperPrefix   = "$pepper$"
+	pepperIDLength = 8
+)
+
+func pepperID(pepper []byte) string {
+	sum := sha256.Sum256(pepper)
+	return hex.EncodeToString(sum[:])[:pepperIDLength]
+}
+
+// pepperPassword returns the base64 of the HMAC, which is short enough for bcrypt whatever the
+// length of the password.
+func pepperPassword(pepper, password []byte) []byte {
+	mac := hmac.New(sha256.New, pepper)
+	mac.Write(password)
+	sum := mac.Sum(nil)
+	defer ZeroBytes(sum)
+
+	return []byte(base64.StdEncoding.EncodeToString(sum))
+}
+
+func isPeppered(hash []byte) bool {
+	return bytes.HasPrefix(hash, []byte(pepperPrefix))
+}
+
+// splitPeppered returns the pepper id and the inner hash of a peppered hash.
+func splitPeppered(hash []byte) (string, []byte, error) {
+	rest := hash[len(pepperPrefix):]
+	i := bytes.IndexByte(rest, '$')
+	if i != pepperIDLength {
+		return "", nil, fmt.Errorf("splitPeppered: malformed hash")
+	}
+
+	return string(rest[:i]), rest[i:], nil
+}
+
+// findPepper returns the pepper with id among the current and previous ones.
+func findPepper(id string, current []byte, previous [][]byte) ([]byte, bool) {
+	for _, pepper := range append([][]byte{current}, previous...) {
+		if len(pepper) > 0 && pepperID(pepper) == id {
+			return pepper, true
+		}
+	}
+
+	return nil, false
+}
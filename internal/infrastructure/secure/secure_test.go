@@ -1,6 +1,8 @@
 package secure_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,7 +37,7 @@ func TestPasswordHasher_HashPassword(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			hasher := secure.NewPasswordHasher()
-			hash, err := hasher.HashPassword(tt.password, 4)
+			hash, err := hasher.HashPassword(tt.password, secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 4})
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -95,6 +97,85 @@ func TestPasswordHasher_CheckPasswordHash(t *testing.T) {
 	}
 }
 
+var fastArgon2 = secure.Argon2Params{MemoryKiB: 8 * 1024, Iterations: 1, Parallelism: 1}
+
+func TestPasswordHasher_Argon2id(t *testing.T) {
+	t.Parallel()
+
+	hasher := secure.NewPasswordHasher()
+	hash, err := hasher.HashPassword([]byte("password"), secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2})
+	require.NoError(t, err)
+	require.Regexp(t, `^\$argon2id\$v=19\$m=8192,t=1,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`, string(hash))
+
+	require.NoError(t, hasher.CheckPasswordHash(hash, []byte("password")))
+	require.ErrorIs(t, hasher.CheckPasswordHash(hash, []byte("wrongpassword")), secure.ErrMismatchedHashAndPassword)
+
+	// salted: the same password hashes differently
+	again, err := hasher.HashPassword([]byte("password"), secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, again)
+
+	for _, malformed := range []string{
+		"$argon2id$v=19$m=8192,t=1,p=1$c2FsdA",
+		"$argon2id$v=16$m=8192,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=8192,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=8192,t=1,p=1$!!$a2V5",
+		"$argon2id$v=19$m=8192,t=1,p=1$c2FsdA$",
+	} {
+		err = hasher.CheckPasswordHash([]byte(malformed), []byte("password"))
+		require.Error(t, err, malformed)
+		require.NotErrorIs(t, err, secure.ErrMismatchedHashAndPassword, malformed)
+	}
+
+	_, err = hasher.HashPassword([]byte("password"), secure.HashParams{Algorithm: "md5"})
+	require.Error(t, err)
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	hasher := secure.NewPasswordHasher()
+	bcrypt4, err := bcrypt.GenerateFromPassword([]byte("password"), 4)
+	require.NoError(t, err)
+	argon, err := hasher.HashPassword([]byte("password"), secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2})
+	require.NoError(t, err)
+
+	stronger := fastArgon2
+	stronger.Iterations = 2
+	tests := []struct {
+		name   string
+		hash   []byte
+		params secure.HashParams
+		want   bool
+	}{
+		{name: "bcrypt same cost", hash: bcrypt4, params: secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 4}},
+		{name: "bcrypt lower configured cost", hash: bcrypt4, params: secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 3}},
+		{name: "bcrypt weaker cost", hash: bcrypt4, params: secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 5}, want: true},
+		{name: "bcrypt to argon2id", hash: bcrypt4, params: secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2}, want: true},
+		{name: "argon2id same params", hash: argon, params: secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2}},
+		{name: "argon2id weaker params", hash: argon, params: secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: stronger}, want: true},
+		{name: "argon2id to bcrypt", hash: argon, params: secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 4}, want: true},
+		{name: "malformed", hash: []byte("hash"), params: secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 4}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, hasher.NeedsRehash(tt.hash, tt.params))
+		})
+	}
+}
+
+func TestHashParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 12}.Validate())
+	require.Error(t, secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 40}.Validate())
+	require.NoError(t, secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: fastArgon2}.Validate())
+	require.Error(t, secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: secure.Argon2Params{MemoryKiB: 1024, Iterations: 1, Parallelism: 1}}.Validate())
+	require.Error(t, secure.HashParams{Algorithm: secure.AlgorithmArgon2id, Argon2: secure.Argon2Params{MemoryKiB: 8192, Parallelism: 1}}.Validate())
+	require.Error(t, secure.HashParams{Algorithm: "md5"}.Validate())
+}
+
 func TestTokenCodec_GenerateToken(t *testing.T) {
 	t.Parallel()
 	secret := []byte("mysecret")
@@ -180,3 +261,280 @@ func TestTokenCodec_ParseToken(t *testing.T) {
 		})
 	}
 }
+
+type keys struct {
+	current  []byte
+	previous [][]byte
+	err      error
+}
+
+func (k *keys) Keys() ([]byte, [][]byte, error) {
+	return k.current, k.previous, k.err
+}
+
+func TestTokenCodec_Rotation(t *testing.T) {
+	t.Parallel()
+
+	source := &keys{current: []byte("old")}
+	codec := secure.NewRotatingTokenCodec(source)
+	claims := auth.AccessTokenClaims{SID: "sid", RegisteredClaims: jwt.RegisteredClaims{Subject: "Subject"}}
+	oldToken, err := codec.GenerateToken(claims)
+	require.NoError(t, err)
+
+	// rotated: new tokens are signed with the new key, old ones still parse
+	source.current, source.previous = []byte("new"), [][]byte{[]byte("old")}
+	newToken, err := codec.GenerateToken(claims)
+	require.NoError(t, err)
+	require.NotEqual(t, oldToken, newToken)
+	_, err = jwt.ParseWithClaims(newToken, &auth.AccessTokenClaims{}, func(*jwt.Token) (interface{}, error) { return []byte("new"), nil })
+	require.NoError(t, err)
+	require.NoError(t, codec.ParseToken(oldToken, &auth.AccessTokenClaims{}))
+	require.NoError(t, codec.ParseToken(newToken, &auth.AccessTokenClaims{}))
+
+	// the old key is retired
+	source.previous = nil
+	require.ErrorIs(t, codec.ParseToken(oldToken, &auth.AccessTokenClaims{}), apperr.ErrUnauthorized())
+	require.NoError(t, codec.ParseToken(newToken, &auth.AccessTokenClaims{}))
+
+	// no key
+	source.current = nil
+	_, err = codec.GenerateToken(claims)
+	require.Error(t, err)
+	require.Error(t, codec.ParseToken(newToken, &auth.AccessTokenClaims{}))
+
+	// source error
+	source.current, source.err = []byte("new"), errors.New("vault down")
+	_, err = codec.GenerateToken(claims)
+	require.ErrorIs(t, err, source.err)
+	err = codec.ParseToken(newToken, &auth.AccessTokenClaims{})
+	require.ErrorIs(t, err, source.err)
+	require.NotErrorIs(t, err, apperr.ErrUnauthorized())
+}
+
+func TestTokenCodec_IssuerAudience(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("mysecret")
+	token := func(issuer string, audience ...string) string {
+		tokenStr, err := secure.NewTokenCodec(secret).GenerateToken(auth.AccessTokenClaims{
+			SID:              "sid",
+			RegisteredClaims: jwt.RegisteredClaims{Issuer: issuer, Audience: audience, Subject: "Subject"},
+		})
+		require.NoError(t, err)
+		return tokenStr
+	}
+
+	tests := []struct {
+		name         string
+		tokenStr     string
+		acceptLegacy bool
+		err          error
+	}{
+		{name: "matching", tokenStr: token("docs-prod", "api-prod")},
+		{name: "one of the audiences", tokenStr: token("docs-prod", "other", "api-prod")},
+		{name: "another issuer", tokenStr: token("docs-staging", "api-prod"), err: apperr.ErrUnauthorized()},
+		{name: "another audience", tokenStr: token("docs-prod", "api-staging"), err: apperr.ErrUnauthorized()},
+		{name: "legacy token", tokenStr: token(""), err: apperr.ErrUnauthorized()},
+		{name: "legacy token accepted", tokenStr: token(""), acceptLegacy: true},
+		{name: "another issuer with legacy accepted", tokenStr: token("docs-staging", "api-prod"), acceptLegacy: true, err: apperr.ErrUnauthorized()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			codec := secure.NewTokenCodec(secret).WithIssuerAudience("docs-prod", "api-prod", tt.acceptLegacy)
+			err := codec.ParseToken(tt.tokenStr, &auth.AccessTokenClaims{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTokenCodec_Leeway(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("mysecret")
+	token := func(notBefore, expiresAt time.Time) string {
+		tokenStr, err := secure.NewTokenCodec(secret).GenerateToken(auth.AccessTokenClaims{
+			SID: "sid",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "Subject",
+				NotBefore: jwt.NewNumericDate(notBefore),
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		})
+		require.NoError(t, err)
+		return tokenStr
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		tokenStr string
+		leeway   time.Duration
+		err      error
+	}{
+		{name: "valid", tokenStr: token(now.Add(-time.Minute), now.Add(time.Minute))},
+		{name: "expired", tokenStr: token(now.Add(-time.Hour), now.Add(-10*time.Second)), err: secure.ErrTokenExpired()},
+		{name: "expired within leeway", tokenStr: token(now.Add(-time.Hour), now.Add(-10*time.Second)), leeway: time.Minute},
+		{name: "not yet valid", tokenStr: token(now.Add(10*time.Second), now.Add(time.Hour)), err: secure.ErrTokenNotYetValid()},
+		{name: "not yet valid within leeway", tokenStr: token(now.Add(10*time.Second), now.Add(time.Hour)), leeway: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			codec := secure.NewTokenCodec(secret).WithLeeway(tt.leeway)
+			err := codec.ParseToken(tt.tokenStr, &auth.AccessTokenClaims{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPasswordHasher_Pepper(t *testing.T) {
+	t.Parallel()
+
+	params := secure.HashParams{Algorithm: secure.AlgorithmBcrypt, BcryptCost: 4}
+	plain, err := secure.NewPasswordHasher().HashPassword([]byte("password"), params)
+	require.NoError(t, err)
+
+	source := &keys{current: []byte("pepper1")}
+	hasher := secure.NewPepperedPasswordHasher(source)
+	peppered, err := hasher.HashPassword([]byte("password"), params)
+	require.NoError(t, err)
+	require.Regexp(t, `^\$pepper\$[0-9a-f]{8}\$2a\$04\$`, string(peppered))
+	require.NoError(t, hasher.CheckPasswordHash(peppered, []byte("password")))
+	require.ErrorIs(t, hasher.CheckPasswordHash(peppered, []byte("wrongpassword")), secure.ErrMismatchedHashAndPassword)
+	require.False(t, hasher.NeedsRehash(peppered, params))
+	// the pepper is part of the hash input: without it the inner hash does not match
+	require.Error(t, secure.NewPasswordHasher().CheckPasswordHash(peppered[len("$pepper$12345678"):], []byte("password")))
+
+	// hashes made before the pepper still work and get upgraded
+	require.NoError(t, hasher.CheckPasswordHash(plain, []byte("password")))
+	require.True(t, hasher.NeedsRehash(plain, params))
+
+	// long passwords fit bcrypt once peppered
+	_, err = hasher.HashPassword([]byte(strings.Repeat("a", 100)), params)
+	require.NoError(t, err)
+
+	// refresh tokens are not peppered
+	rt, err := hasher.HashRefreshToken([]byte("token"))
+	require.NoError(t, err)
+	require.NoError(t, secure.NewPasswordHasher().CheckPasswordHash(rt, []byte("token")))
+
+	// rotated: the previous pepper still checks, but needs a rehash
+	source.current, source.previous = []byte("pepper2"), [][]byte{[]byte("pepper1")}
+	require.NoError(t, hasher.CheckPasswordHash(peppered, []byte("password")))
+	require.True(t, hasher.NeedsRehash(peppered, params))
+	rehashed, err := hasher.HashPassword([]byte("password"), params)
+	require.NoError(t, err)
+	require.NotEqual(t, peppered[:16], rehashed[:16])
+	require.False(t, hasher.NeedsRehash(rehashed, params))
+
+	// a retired pepper is an error, not a wrong password
+	source.previous = nil
+	err = hasher.CheckPasswordHash(peppered, []byte("password"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, secure.ErrMismatchedHashAndPassword)
+
+	// malformed
+	require.Error(t, hasher.CheckPasswordHash([]byte("$pepper$abc$2a$04$x"), []byte("password")))
+	require.True(t, hasher.NeedsRehash([]byte("$pepper$abc$2a$04$x"), params))
+
+	// source error
+	source.err = errors.New("vault down")
+	_, err = hasher.HashPassword([]byte("password"), params)
+	require.ErrorIs(t, err, source.err)
+	require.False(t, hasher.NeedsRehash(plain, params))
+}
+
+func TestContentCipher(t *testing.T) {
+	t.Parallel()
+
+	source, retired := &keys{current: []byte("key1")}, &keys{}
+	c := secure.NewContentCipher(source, retired)
+	aad := secure.ContentAAD("entity_versions", "9b2c", 3)
+	require.Equal(t, "entity_versions/9b2c/3", string(aad))
+	ciphertext, keyID, err := c.Encrypt("# Title\n\ncontent", aad)
+	require.NoError(t, err)
+	require.Len(t, keyID, 8)
+	require.NotContains(t, ciphertext, "content")
+	id, err := c.KeyID()
+	require.NoError(t, err)
+	require.Equal(t, keyID, id)
+	plaintext, err := c.Decrypt(ciphertext, keyID, aad)
+	require.NoError(t, err)
+	require.Equal(t, "# Title\n\ncontent", plaintext)
+
+	// every value has its own data key
+	again, _, err := c.Encrypt("# Title\n\ncontent", aad)
+	require.NoError(t, err)
+	require.NotEqual(t, ciphertext, again)
+
+	// content moved to another row, or an older value of the row, is not opened
+	_, err = c.Decrypt(ciphertext, keyID, secure.ContentAAD("entity_versions", "9b2c", 2))
+	require.Error(t, err)
+	_, err = c.Decrypt(ciphertext, keyID, secure.ContentAAD("entities", "9b2c", 3))
+	require.Error(t, err)
+	_, err = c.Decrypt(ciphertext, keyID, nil)
+	require.Error(t, err)
+
+	empty, emptyID, err := c.Encrypt("", aad)
+	require.NoError(t, err)
+	plaintext, err = c.Decrypt(empty, emptyID, aad)
+	require.NoError(t, err)
+	require.Empty(t, plaintext)
+
+	// rotated: the previous key still decrypts, rewrapping moves the value to the new key
+	source.current, source.previous = []byte("key2"), [][]byte{[]byte("key1")}
+	plaintext, err = c.Decrypt(ciphertext, keyID, aad)
+	require.NoError(t, err)
+	require.Equal(t, "# Title\n\ncontent", plaintext)
+	rewrapped, newID, err := c.Rewrap(ciphertext, keyID)
+	require.NoError(t, err)
+	require.NotEqual(t, keyID, newID)
+	// the wrapped data key is the first 60 bytes, 80 base64 characters
+	require.Equal(t, ciphertext[80:], rewrapped[80:], "the sealed content is kept")
+
+	// after a restart the retired key comes from the second source
+	source.previous, retired.current = nil, []byte("key1")
+	plaintext, err = c.Decrypt(ciphertext, keyID, aad)
+	require.NoError(t, err)
+	require.Equal(t, "# Title\n\ncontent", plaintext)
+	retired.current = nil
+	_, err = c.Decrypt(ciphertext, keyID, aad)
+	require.ErrorIs(t, err, secure.ErrUnknownContentKey)
+	plaintext, err = c.Decrypt(rewrapped, newID, aad)
+	require.NoError(t, err)
+	require.Equal(t, "# Title\n\ncontent", plaintext)
+
+	// tampered or truncated
+	b := []byte(rewrapped)
+	// a character inside the sealed content; the last ones may only be padding
+	if b[90] == 'A' {
+		b[90] = 'B'
+	} else {
+		b[90] = 'A'
+	}
+	_, err = c.Decrypt(string(b), newID, aad)
+	require.Error(t, err)
+	_, err = c.Decrypt(rewrapped[:20], newID, aad)
+	require.Error(t, err)
+
+	// no key
+	source.current = nil
+	_, _, err = c.Encrypt("content", aad)
+	require.ErrorIs(t, err, secure.ErrNoContentKey)
+
+	// source error
+	source.current, source.err = []byte("key2"), errors.New("vault down")
+	_, err = c.Decrypt(rewrapped, newID, aad)
+	require.ErrorIs(t, err, source.err)
+}
@@ -0,0 +1,52 @@
+package settings
+
+import "sync"
+
+// Registry holds the current value of runtime-tunable settings and notifies subscribers on change.
+// Subscribers are called synchronously, in subscription order, and never concurrently with each other.
+type Registry[T any] struct {
+	mu          sync.RWMutex
+	current     T
+	updateMu    sync.Mutex
+	subscribers []func(T)
+}
+
+func NewRegistry[T any](initial T) *Registry[T] {
+	return &Registry[T]{current: initial}
+}
+
+func (r *Registry[T]) Get() T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.current
+}
+
+// Subscribe registers fn and immediately calls it with the current value,
+// so components do not need separate initialization.
+func (r *Registry[T]) Subscribe(fn func(T)) {
+	r.updateMu.Lock()
+	defer r.updateMu.Unlock()
+
+	r.mu.Lock()
+	r.subscribers = append(r.subscribers, fn)
+	current := r.current
+	r.mu.Unlock()
+
+	fn(current)
+}
+
+// Update stores value and notifies all subscribers. Validation is the caller's job.
+func (r *Registry[T]) Update(value T) {
+	r.updateMu.Lock()
+	defer r.updateMu.Unlock()
+
+	r.mu.Lock()
+	r.current = value
+	subscribers := r.subscribers
+	r.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(value)
+	}
+}
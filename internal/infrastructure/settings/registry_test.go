@@ -0,0 +1,50 @@
+package settings_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/settings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := settings.NewRegistry(1)
+	require.Equal(t, 1, r.Get())
+
+	var got []int
+	r.Subscribe(func(v int) { got = append(got, v) })
+	require.Equal(t, []int{1}, got)
+
+	r.Update(2)
+	require.Equal(t, 2, r.Get())
+	require.Equal(t, []int{1, 2}, got)
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		r   = settings.NewRegistry(0)
+		mu  sync.Mutex
+		sum int
+		wg  sync.WaitGroup
+	)
+	r.Subscribe(func(v int) {
+		mu.Lock()
+		sum += v
+		mu.Unlock()
+	})
+	for i := 1; i <= 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.Update(i)
+			_ = r.Get()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 5050, sum)
+}
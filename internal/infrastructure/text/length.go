@@ -0,0 +1,50 @@
+package text
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Length counts the characters a reader sees in s. It approximates the extended grapheme clusters of
+// UAX #29 without their tables: combining marks, variation selectors, emoji modifiers and tags extend
+// the character before them, a zero width joiner glues two characters together, a pair of regional
+// indicators is one flag and CRLF is one line break. Invalid UTF-8 counts one per byte.
+func Length(s string) int {
+	var (
+		n      int
+		prev   rune = -1
+		joined bool // prev is a ZWJ
+		single bool // the current character is a lone regional indicator
+	)
+	for len(s) > 0 {
+		c, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		switch {
+		case prev == -1:
+			n++
+			single = isRegionalIndicator(c)
+		case joined, prev == '\r' && c == '\n', isExtend(c):
+		case single && isRegionalIndicator(c):
+			single = false
+		default:
+			n++
+			single = isRegionalIndicator(c)
+		}
+		prev, joined = c, c == zwj
+	}
+
+	return n
+}
+
+const zwj = '\u200d'
+
+func isExtend(c rune) bool {
+	return c == zwj ||
+		unicode.In(c, unicode.Mn, unicode.Me, unicode.Mc) ||
+		(c >= 0x1f3fb && c <= 0x1f3ff) || // emoji modifiers
+		(c >= 0xe0020 && c <= 0xe007f) // tags
+}
+
+func isRegionalIndicator(c rune) bool {
+	return c >= 0x1f1e6 && c <= 0x1f1ff
+}
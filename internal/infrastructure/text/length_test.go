@@ -0,0 +1,35 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{name: "empty", in: "", want: 0},
+		{name: "ascii", in: "abc", want: 3},
+		{name: "cyrillic", in: "Привет", want: 6},
+		{name: "combining mark", in: "Cafe\u0301", want: 4},
+		{name: "variation selector", in: "\u2764\ufe0f", want: 1},
+		{name: "skin tone", in: "\U0001f44d\U0001f3fd", want: 1},
+		{name: "zwj sequence", in: "\U0001f468\u200d\U0001f469\u200d\U0001f467", want: 1},
+		{name: "flags", in: "\U0001f1fa\U0001f1e6\U0001f1e9\U0001f1ea\U0001f1eb", want: 3},
+		{name: "crlf", in: "a\r\nb", want: 3},
+		{name: "invalid utf8", in: "a\xff\xfe", want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, text.Length(tt.in))
+		})
+	}
+}
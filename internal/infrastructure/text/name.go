@@ -0,0 +1,73 @@
+// Package text holds the rules names of users and entities are normalized and checked with.
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NameRules normalize a name before it is validated. The zero value only trims surrounding spaces.
+type NameRules struct {
+	// CollapseSpaces replaces every run of whitespace inside the name by a single space.
+	CollapseSpaces bool `mapstructure:"collapse_spaces" json:"collapse_spaces"`
+	// StripControl removes control characters such as NUL, escapes or line breaks. With CollapseSpaces
+	// the whitespace ones become a space first.
+	StripControl bool `mapstructure:"strip_control" json:"strip_control"`
+	// NFC composes the name to Unicode Normalization Form C, so names typed on different systems
+	// compare equal.
+	NFC bool `mapstructure:"nfc" json:"nfc"`
+	// ForbiddenChars are the characters a name must not contain.
+	ForbiddenChars string `mapstructure:"forbidden_chars" json:"forbidden_chars"`
+}
+
+func (r NameRules) Validate() error {
+	return ValidateForbiddenChars(r.ForbiddenChars)
+}
+
+// ValidateForbiddenChars checks a list of forbidden characters is usable: a normalized name never has
+// surrounding or repeated spaces, so listing whitespace would be confusing.
+func ValidateForbiddenChars(chars string) error {
+	if !utf8.ValidString(chars) {
+		return fmt.Errorf("forbidden chars must be valid UTF-8")
+	}
+	if strings.IndexFunc(chars, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("forbidden chars must not contain whitespace")
+	}
+
+	return nil
+}
+
+// Normalize applies the rules to name and trims the spaces around it.
+func (r NameRules) Normalize(name string) string {
+	if r.NFC {
+		name = norm.NFC.String(name)
+	}
+	if r.CollapseSpaces {
+		name = strings.Join(strings.Fields(name), " ")
+	}
+	if r.StripControl {
+		name = strings.Map(func(c rune) rune {
+			if unicode.IsControl(c) {
+				return -1
+			}
+			return c
+		}, name)
+	}
+
+	return strings.TrimSpace(name)
+}
+
+// FirstForbidden returns the first character of name found in chars.
+func FirstForbidden(name, chars string) (rune, bool) {
+	i := strings.IndexAny(name, chars)
+	if i < 0 {
+		return 0, false
+	}
+	c, _ := utf8.DecodeRuneInString(name[i:])
+
+	return c, true
+}
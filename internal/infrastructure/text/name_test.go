@@ -0,0 +1,53 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/66gu1/easygodocs/internal/infrastructure/text"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameRules_Normalize(t *testing.T) {
+	t.Parallel()
+
+	all := text.NameRules{CollapseSpaces: true, StripControl: true, NFC: true}
+	tests := []struct {
+		name  string
+		rules text.NameRules
+		in    string
+		want  string
+	}{
+		{name: "zero value trims only", in: "  a \t b\x00 ", want: "a \t b\x00"},
+		{name: "collapse", rules: text.NameRules{CollapseSpaces: true}, in: " a \t\n b  c ", want: "a b c"},
+		{name: "strip control", rules: text.NameRules{StripControl: true}, in: "a\x00b\x1b[0m\tc", want: "ab[0mc"},
+		{name: "collapse before strip", rules: all, in: "a\tb\x07", want: "a b"},
+		{name: "nfc", rules: text.NameRules{NFC: true}, in: "Cafe\u0301", want: "Caf\u00e9"},
+		{name: "no space left", rules: all, in: " \x00\t ", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.rules.Normalize(tt.in))
+		})
+	}
+}
+
+func TestNameRules_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, text.NameRules{ForbiddenChars: `/\<>`}.Validate())
+	require.Error(t, text.NameRules{ForbiddenChars: "a b"}.Validate())
+	require.Error(t, text.NameRules{ForbiddenChars: "\xff"}.Validate())
+}
+
+func TestFirstForbidden(t *testing.T) {
+	t.Parallel()
+
+	c, ok := text.FirstForbidden("Résumé/draft<1>", "<>/")
+	require.True(t, ok)
+	require.Equal(t, '/', c)
+	_, ok = text.FirstForbidden("Résumé", "<>/")
+	require.False(t, ok)
+	_, ok = text.FirstForbidden("Résumé", "")
+	require.False(t, ok)
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_usage_hourly (
+    user_id   UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hour      TIMESTAMPTZ NOT NULL,
+    requests  BIGINT      NOT NULL DEFAULT 0,
+    bytes_in  BIGINT      NOT NULL DEFAULT 0,
+    bytes_out BIGINT      NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, hour)
+);
+
+CREATE INDEX idx_user_usage_hourly_hour ON user_usage_hourly(hour);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_usage_hourly;
+-- +goose StatementEnd
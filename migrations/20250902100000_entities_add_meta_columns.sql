@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE entities
+    ADD COLUMN word_count           INT NOT NULL DEFAULT 0,
+    ADD COLUMN reading_time_minutes INT NOT NULL DEFAULT 0,
+    ADD COLUMN version_count        INT NOT NULL DEFAULT 0;
+
+UPDATE entities e
+SET word_count    = CASE WHEN btrim(e.content) = '' THEN 0
+                         ELSE array_length(regexp_split_to_array(btrim(e.content), '\s+'), 1) END,
+    version_count = (SELECT COUNT(*) FROM entity_versions v WHERE v.entity_id = e.id);
+
+UPDATE entities
+SET reading_time_minutes = (word_count + 199) / 200;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities
+    DROP COLUMN word_count,
+    DROP COLUMN reading_time_minutes,
+    DROP COLUMN version_count;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- target_id has no foreign key: links to missing entities are kept and reported as broken.
+CREATE TABLE entity_links
+(
+    source_id UUID NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    target_id UUID NOT NULL,
+    PRIMARY KEY (source_id, target_id)
+);
+CREATE INDEX idx_entity_links_target ON entity_links (target_id);
+
+INSERT INTO entity_links (source_id, target_id)
+SELECT DISTINCT e.id, lower(m[1])::uuid
+FROM entities e,
+     regexp_matches(e.content,
+                    '(?:\[\[|/entities/)([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})',
+                    'g') AS m
+WHERE lower(m[1])::uuid <> e.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_links;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A lock whose expires_at has passed is treated as absent and can be taken over;
+-- expired rows are removed by a background job.
+CREATE TABLE entity_locks
+(
+    entity_id   UUID PRIMARY KEY REFERENCES entities (id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    acquired_at TIMESTAMPTZ NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_entity_locks_expires_at ON entity_locks (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_locks;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Covers the per-entity contributor aggregation and the last editors of entity meta
+-- with an index-only scan.
+CREATE INDEX idx_entity_versions_contributors ON entity_versions (entity_id, created_by) INCLUDE (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entity_versions_contributors;
+-- +goose StatementEnd
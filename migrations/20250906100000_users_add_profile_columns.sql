@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN display_name        TEXT NOT NULL DEFAULT '',
+    ADD COLUMN bio                 TEXT NOT NULL DEFAULT '',
+    ADD COLUMN timezone            TEXT NOT NULL DEFAULT '',
+    ADD COLUMN locale              TEXT NOT NULL DEFAULT '',
+    -- the image itself is in blob storage under avatars/<id>; NULL means no avatar
+    ADD COLUMN avatar_content_type TEXT,
+    ADD COLUMN avatar_updated_at   TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN display_name,
+    DROP COLUMN bio,
+    DROP COLUMN timezone,
+    DROP COLUMN locale,
+    DROP COLUMN avatar_content_type,
+    DROP COLUMN avatar_updated_at;
+-- +goose StatementEnd
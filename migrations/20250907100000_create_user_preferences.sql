@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- data is a versioned JSON document (see user.ParsePreferences); older versions are
+-- upgraded in the application when read or replaced.
+CREATE TABLE user_preferences
+(
+    user_id    UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    data       JSONB       NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_preferences;
+-- +goose StatementEnd
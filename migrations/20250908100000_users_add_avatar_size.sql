@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- avatars uploaded before this migration have no size and count as 0 in storage stats
+ALTER TABLE users ADD COLUMN avatar_size BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN avatar_size;
+-- +goose StatementEnd
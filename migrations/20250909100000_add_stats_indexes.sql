@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- daily activity in the admin stats scans the last 30 days of creations and edits
+CREATE INDEX idx_entities_created_at ON entities (created_at);
+CREATE INDEX idx_entity_versions_created_at ON entity_versions (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entity_versions_created_at;
+DROP INDEX idx_entities_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Append-only log of entity changes for activity feeds. Rows are written in the same
+-- transaction as the change; actor_id is NULL for events recovered from older data.
+CREATE TABLE entity_events
+(
+    id             BIGSERIAL PRIMARY KEY,
+    entity_id      UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    type           TEXT        NOT NULL,
+    actor_id       UUID        REFERENCES users (id) ON DELETE SET NULL,
+    version        INT,
+    from_parent_id UUID,
+    to_parent_id   UUID,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_entity_events_entity_id ON entity_events (entity_id, id DESC);
+
+-- Backfill from entities and the versions that survived retention, in chronological order
+-- so that ids follow time. Moves are detected by comparing a version with the previous kept one.
+INSERT INTO entity_events (entity_id, type, actor_id, version, from_parent_id, to_parent_id, created_at)
+SELECT entity_id, type, actor_id, version, from_parent_id, to_parent_id, created_at
+FROM (SELECT e.id AS entity_id, 'created' AS type, e.created_by AS actor_id,
+             v.version, NULL::UUID AS from_parent_id, NULL::UUID AS to_parent_id,
+             COALESCE(v.created_at, e.created_at) AS created_at, 0 AS ord
+      FROM entities e
+               LEFT JOIN entity_versions v ON v.entity_id = e.id AND v.version = 1
+
+      UNION ALL
+
+      SELECT entity_id, 'moved', created_by, version, prev_parent_id, parent_id, created_at, 1
+      FROM (SELECT v.*, LAG(v.parent_id) OVER w AS prev_parent_id, LAG(v.version) OVER w AS prev_version
+            FROM entity_versions v
+            WINDOW w AS (PARTITION BY v.entity_id ORDER BY v.version)) moves
+      WHERE version > 1 AND prev_version IS NOT NULL AND parent_id IS DISTINCT FROM prev_parent_id
+
+      UNION ALL
+
+      SELECT entity_id, 'edited', created_by, version, NULL, NULL, created_at, 2
+      FROM (SELECT v.*, LAG(v.name) OVER w AS prev_name, LAG(v.content) OVER w AS prev_content,
+                   LAG(v.version) OVER w AS prev_version
+            FROM entity_versions v
+            WINDOW w AS (PARTITION BY v.entity_id ORDER BY v.version)) edits
+      WHERE version > 1
+        AND (prev_version IS NULL OR name IS DISTINCT FROM prev_name OR content IS DISTINCT FROM prev_content)
+
+      UNION ALL
+
+      SELECT id, 'deleted', NULL, NULL, NULL, NULL, deleted_at, 3
+      FROM entities
+      WHERE deleted_at IS NOT NULL) events
+ORDER BY created_at, ord;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_events;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Responses of requests sent with an Idempotency-Key. status_code is NULL while the request is in progress;
+-- rows whose expires_at has passed are treated as absent and removed by a background job.
+CREATE TABLE idempotency_keys
+(
+    user_id      UUID        NOT NULL,
+    key          TEXT        NOT NULL,
+    request_hash TEXT        NOT NULL,
+    status_code  INT,
+    content_type TEXT,
+    body         BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- duplicate sibling name checks (entity.unique_sibling_names) look up the normalized name within a parent
+CREATE INDEX idx_entities_sibling_name ON entities (parent_id, LOWER(REGEXP_REPLACE(BTRIM(name), '\s+', ' ', 'g')))
+    WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entities_sibling_name;
+-- +goose StatementEnd
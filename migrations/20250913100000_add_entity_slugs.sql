@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- entities.slug is the current slug; entity_slugs keeps every slug an entity ever had so that
+-- old URLs keep resolving. The primary key makes slugs unique across current and former ones.
+ALTER TABLE entities ADD COLUMN slug TEXT;
+CREATE TABLE entity_slugs
+(
+    slug       TEXT PRIMARY KEY,
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_entity_slugs_entity_id ON entity_slugs (entity_id);
+
+-- Backfill with the rules of entity.Slugify. The oldest entity keeps the plain slug, later ones
+-- are numbered like entity.PickSlug does; a number clashing with another name's slug falls back
+-- to a suffix from the id.
+WITH base AS (SELECT id,
+                     created_at,
+                     COALESCE(NULLIF(BTRIM(LEFT(BTRIM(REGEXP_REPLACE(LOWER(name), '[^[:alnum:]]+', '-', 'g'), '-'), 80), '-'), ''),
+                              'entity') AS slug
+              FROM entities),
+     numbered AS (SELECT id, created_at, slug,
+                         ROW_NUMBER() OVER (PARTITION BY slug ORDER BY created_at, id) AS n
+                  FROM base),
+     candidate AS (SELECT id, created_at, slug AS base,
+                          CASE WHEN n = 1 THEN slug ELSE slug || '-' || n END AS slug,
+                          n
+                   FROM numbered),
+     ranked AS (SELECT id, base, slug,
+                       ROW_NUMBER() OVER (PARTITION BY slug ORDER BY n, created_at, id) AS r
+                FROM candidate)
+UPDATE entities e
+SET slug = CASE WHEN r.r = 1 THEN r.slug ELSE r.base || '-' || LEFT(REPLACE(e.id::TEXT, '-', ''), 8) END
+FROM ranked r
+WHERE r.id = e.id;
+
+INSERT INTO entity_slugs (slug, entity_id, created_at)
+SELECT slug, id, created_at
+FROM entities;
+
+ALTER TABLE entities ALTER COLUMN slug SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_slugs;
+ALTER TABLE entities DROP COLUMN slug;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Sign-in attempts for accounts that exist: successes and wrong passwords. new_device marks a
+-- success from a user agent or IP address the user has not signed in from before.
+CREATE TABLE login_events
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    ip         TEXT        NOT NULL,
+    user_agent TEXT        NOT NULL,
+    success    BOOLEAN     NOT NULL,
+    new_device BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_login_events_user_id ON login_events (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE login_events;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reads of entities, one row per entity and session: viewed_at is the first read in the session.
+CREATE TABLE entity_views
+(
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    session_id UUID        NOT NULL,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    viewed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (entity_id, session_id)
+);
+CREATE INDEX idx_entity_views_viewed_at ON entity_views (viewed_at, entity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_views;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE entities
+    ADD COLUMN owner_id UUID REFERENCES users(id) ON DELETE RESTRICT;
+
+UPDATE entities
+SET owner_id = created_by;
+
+ALTER TABLE entities
+    ALTER COLUMN owner_id SET NOT NULL;
+
+CREATE INDEX idx_entities_owner ON entities (owner_id) WHERE deleted_at ISNULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entities_owner;
+
+ALTER TABLE entities
+    DROP COLUMN owner_id;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A workspace isolates the users, documents, roles and sessions of one team. Existing data moves to
+-- the default workspace, which is also the column default for rows inserted by older tools.
+CREATE TABLE workspaces
+(
+    id         UUID PRIMARY KEY,
+    slug       TEXT        NOT NULL,
+    name       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    deleted_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX idx_workspaces_slug ON workspaces (slug) WHERE deleted_at ISNULL;
+
+INSERT INTO workspaces (id, slug, name, created_at, updated_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'default', 'Default', NOW(), NOW());
+
+ALTER TABLE users
+    ADD COLUMN workspace_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id);
+ALTER TABLE entities
+    ADD COLUMN workspace_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id);
+ALTER TABLE user_roles
+    ADD COLUMN workspace_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id);
+ALTER TABLE user_sessions
+    ADD COLUMN workspace_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id);
+
+-- emails and slugs only have to be unique within a workspace
+DROP INDEX idx_users_email;
+CREATE UNIQUE INDEX idx_users_email ON users (workspace_id, lower(email)) WHERE deleted_at ISNULL;
+
+ALTER TABLE entity_slugs
+    ADD COLUMN workspace_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id);
+ALTER TABLE entity_slugs
+    DROP CONSTRAINT entity_slugs_pkey,
+    ADD PRIMARY KEY (workspace_id, slug);
+
+CREATE INDEX idx_entities_workspace ON entities (workspace_id) WHERE deleted_at ISNULL;
+CREATE INDEX idx_user_roles_workspace ON user_roles (workspace_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_user_roles_workspace;
+DROP INDEX idx_entities_workspace;
+
+ALTER TABLE entity_slugs
+    DROP CONSTRAINT entity_slugs_pkey,
+    ADD PRIMARY KEY (slug);
+ALTER TABLE entity_slugs
+    DROP COLUMN workspace_id;
+
+DROP INDEX idx_users_email;
+CREATE UNIQUE INDEX idx_users_email ON users (lower(email)) WHERE deleted_at ISNULL;
+
+ALTER TABLE user_sessions
+    DROP COLUMN workspace_id;
+ALTER TABLE user_roles
+    DROP COLUMN workspace_id;
+ALTER TABLE entities
+    DROP COLUMN workspace_id;
+ALTER TABLE users
+    DROP COLUMN workspace_id;
+
+DROP TABLE workspaces;
+-- +goose StatementEnd
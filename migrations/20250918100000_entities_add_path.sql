@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- path lists the ids from the root down to the entity itself, so subtrees and ancestors are found
+-- with one indexed lookup instead of a recursive walk. Deleted entities keep theirs.
+ALTER TABLE entities
+    ADD COLUMN path UUID[] NOT NULL DEFAULT '{}';
+
+WITH RECURSIVE tree AS (
+    SELECT id, ARRAY[id] AS path
+    FROM entities
+    WHERE parent_id ISNULL
+
+    UNION ALL
+
+    SELECT e.id, t.path || e.id
+    FROM tree t
+    JOIN entities e ON e.parent_id = t.id
+)
+UPDATE entities e
+SET path = tree.path
+FROM tree
+WHERE e.id = tree.id;
+
+CREATE INDEX idx_entities_path ON entities USING GIN (path);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entities_path;
+
+ALTER TABLE entities
+    DROP COLUMN path;
+-- +goose StatementEnd
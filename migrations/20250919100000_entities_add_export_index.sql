@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Exports page through the live entities of a workspace level by level.
+CREATE INDEX idx_entities_export ON entities (workspace_id, (array_length(path, 1)), id) WHERE deleted_at ISNULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entities_export;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Space-separated scopes requested at login; empty grants every scope, as before scopes existed.
+ALTER TABLE user_sessions
+    ADD COLUMN scope TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_sessions
+    DROP COLUMN scope;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- TTL class chosen at login ('default' or 'remember_me'); refreshes extend the session by the same TTL.
+ALTER TABLE user_sessions
+    ADD COLUMN ttl_class TEXT NOT NULL DEFAULT 'default';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_sessions
+    DROP COLUMN ttl_class;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Grants and revocations of roles on an entity are logged as role_granted and role_revoked events:
+-- subject_id is the user who gained or lost the role. Earlier grants were not logged and have no events.
+ALTER TABLE entity_events
+    ADD COLUMN subject_id UUID REFERENCES users (id) ON DELETE SET NULL,
+    ADD COLUMN role       TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM entity_events WHERE type IN ('role_granted', 'role_revoked');
+ALTER TABLE entity_events
+    DROP COLUMN subject_id,
+    DROP COLUMN role;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One autosave per editor and entity, overwritten on every autosave and removed when the editor
+-- saves the entity. Autosaves never become versions.
+CREATE TABLE entity_autosaves
+(
+    entity_id UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    user_id   UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    content   TEXT        NOT NULL,
+    saved_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entity_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_autosaves;
+-- +goose StatementEnd
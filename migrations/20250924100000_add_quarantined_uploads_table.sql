@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Uploads the virus scanner rejected. The file is kept in blob storage under blob_key for review
+-- until an admin deletes it.
+CREATE TABLE quarantined_uploads
+(
+    id             UUID PRIMARY KEY,
+    workspace_id   UUID        NOT NULL REFERENCES workspaces (id),
+    source         TEXT        NOT NULL,
+    name           TEXT        NOT NULL,
+    signature      TEXT        NOT NULL,
+    size           BIGINT      NOT NULL,
+    blob_key       TEXT        NOT NULL,
+    uploaded_by    UUID        REFERENCES users (id) ON DELETE SET NULL,
+    quarantined_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_quarantined_uploads_workspace ON quarantined_uploads (workspace_id, quarantined_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE quarantined_uploads;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every version of the terms of service a user accepted, kept as a record of when they did.
+CREATE TABLE user_terms_acceptances
+(
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    version     TEXT        NOT NULL,
+    accepted_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, version)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_terms_acceptances;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The recursive hierarchy walks step from an entity to its live children and to its live parent. Covering
+-- the selected and visibility columns lets each step be an index-only scan. idx_entities_live_children
+-- leads with parent_id under the same predicate, so it replaces idx_entities_parent.
+CREATE INDEX idx_entities_live_children ON entities (parent_id, id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+CREATE INDEX idx_entities_live_parents ON entities (id, parent_id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+DROP INDEX idx_entities_parent;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX idx_entities_parent ON entities (parent_id) WHERE deleted_at ISNULL;
+DROP INDEX idx_entities_live_parents;
+DROP INDEX idx_entities_live_children;
+-- +goose StatementEnd
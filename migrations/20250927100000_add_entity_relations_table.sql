@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- "Related to" links between entities. A relation is symmetric and stored in both directions, so the
+-- related pages of an entity are read by entity_id alone; the repository writes and removes both rows
+-- together.
+CREATE TABLE entity_relations
+(
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    related_id UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entity_id, related_id),
+    CHECK (entity_id <> related_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_relations;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rules set by admins for feature flags declared in the config; a row replaces the configured rule
+-- of its flag until it is deleted. Flags are per deployment, not per workspace.
+CREATE TABLE feature_flag_overrides
+(
+    name       TEXT PRIMARY KEY,
+    enabled    BOOLEAN     NOT NULL,
+    percentage INT         NOT NULL CHECK (percentage BETWEEN 0 AND 100),
+    users      JSONB       NOT NULL DEFAULT '[]',
+    updated_by UUID        REFERENCES users (id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feature_flag_overrides;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- sort_order places an entity among its siblings: ordered siblings, 1 and up, come first, the unordered
+-- ones, 0, follow by name. The hierarchy indexes cover it as the hierarchy queries select it.
+ALTER TABLE entities ADD COLUMN sort_order INT NOT NULL DEFAULT 0;
+DROP INDEX idx_entities_live_children;
+DROP INDEX idx_entities_live_parents;
+CREATE INDEX idx_entities_live_children ON entities (parent_id, id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, sort_order, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+CREATE INDEX idx_entities_live_parents ON entities (id, parent_id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, sort_order, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_entities_live_parents;
+DROP INDEX idx_entities_live_children;
+ALTER TABLE entities DROP COLUMN sort_order;
+CREATE INDEX idx_entities_live_children ON entities (parent_id, id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+CREATE INDEX idx_entities_live_parents ON entities (id, parent_id)
+    INCLUDE (type, name, slug, owner_id, word_count, reading_time_minutes, current_version, updated_by)
+    WHERE deleted_at ISNULL;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Roles given to users on every entity created below entity_id. Only entity roles are allowed: the
+-- admin role is global.
+CREATE TABLE entity_default_permissions
+(
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role       TEXT        NOT NULL CHECK (role IN ('read', 'write')),
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entity_id, user_id)
+);
+CREATE INDEX idx_entity_default_permissions_user ON entity_default_permissions (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_default_permissions;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Named sets of grants an admin applies to users in one call. Applying copies the grants into
+-- user_roles, so later changes to a preset leave the grants made with it alone.
+CREATE TABLE role_presets
+(
+    id           UUID PRIMARY KEY,
+    workspace_id UUID        NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id),
+    name         TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX uq_role_presets_name ON role_presets (workspace_id, lower(name));
+
+CREATE TABLE role_preset_grants
+(
+    preset_id UUID NOT NULL REFERENCES role_presets (id) ON DELETE CASCADE,
+    role      TEXT NOT NULL,
+    entity_id UUID REFERENCES entities (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_role_preset_grants_preset ON role_preset_grants (preset_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_preset_grants;
+DROP TABLE role_presets;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Invitations to register in a workspace. Only a SHA-256 hash of the token is stored; the roles are
+-- granted to the new user when the invitation is accepted.
+CREATE TABLE invitations
+(
+    id           UUID PRIMARY KEY,
+    workspace_id UUID        NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES workspaces (id),
+    email        TEXT        NOT NULL,
+    token_hash   TEXT        NOT NULL,
+    invited_by   UUID        REFERENCES users (id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    accepted_at  TIMESTAMPTZ,
+    user_id      UUID        REFERENCES users (id) ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX uq_invitations_token_hash ON invitations (token_hash);
+CREATE INDEX idx_invitations_workspace ON invitations (workspace_id, created_at DESC);
+
+CREATE TABLE invitation_roles
+(
+    invitation_id UUID NOT NULL REFERENCES invitations (id) ON DELETE CASCADE,
+    role          TEXT NOT NULL,
+    entity_id     UUID REFERENCES entities (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_invitation_roles_invitation ON invitation_roles (invitation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE invitation_roles;
+DROP TABLE invitations;
+-- +goose StatementEnd
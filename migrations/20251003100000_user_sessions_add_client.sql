@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Client the session was created from; refreshes from another one are refused or flagged, see
+-- auth.device_binding. Sessions created before have no client and are not checked.
+ALTER TABLE user_sessions
+    ADD COLUMN ip         TEXT NOT NULL DEFAULT '',
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN flagged_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_sessions
+    DROP COLUMN ip,
+    DROP COLUMN user_agent,
+    DROP COLUMN flagged_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Id of the content key the content is encrypted with, see entity.encryption. NULL is plain content.
+ALTER TABLE entities ADD COLUMN content_key_id TEXT;
+ALTER TABLE entity_versions ADD COLUMN content_key_id TEXT;
+ALTER TABLE entity_autosaves ADD COLUMN content_key_id TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities DROP COLUMN content_key_id;
+ALTER TABLE entity_versions DROP COLUMN content_key_id;
+ALTER TABLE entity_autosaves DROP COLUMN content_key_id;
+-- +goose StatementEnd
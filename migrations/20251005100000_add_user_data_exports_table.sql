@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Archives of what is stored about a user, for data subject requests. They are made in the
+-- background; the archive itself is in blob storage under data-exports/<id>.zip until expires_at.
+CREATE TABLE user_data_exports
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    requested_by UUID        REFERENCES users (id) ON DELETE SET NULL,
+    status       TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ
+);
+CREATE INDEX idx_user_data_exports_user ON user_data_exports (user_id, created_at DESC);
+CREATE INDEX idx_user_data_exports_status ON user_data_exports (status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_data_exports;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When an admin scrubbed the personal data of the user. The row stays so authored content keeps
+-- pointing at it; its email and name are placeholders from then on.
+ALTER TABLE users ADD COLUMN anonymized_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN anonymized_at;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Named sets of the versions a subtree had at a point in time, such as the pages of a product release.
+-- Retention keeps the versions a snapshot holds; purging an entity from the trash removes it from them.
+CREATE TABLE entity_snapshots
+(
+    id         UUID PRIMARY KEY,
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    label      TEXT        NOT NULL,
+    created_by UUID        NOT NULL REFERENCES users (id),
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (entity_id, label)
+);
+
+CREATE TABLE entity_snapshot_versions
+(
+    snapshot_id UUID NOT NULL REFERENCES entity_snapshots (id) ON DELETE CASCADE,
+    entity_id   UUID NOT NULL,
+    version     INT  NOT NULL,
+    -- depth below the snapshot root when it was taken, so the subtree reads parents first after moves too
+    depth       INT  NOT NULL,
+    PRIMARY KEY (snapshot_id, entity_id),
+    FOREIGN KEY (entity_id, version) REFERENCES entity_versions (entity_id, version) ON DELETE CASCADE
+);
+CREATE INDEX idx_entity_snapshot_versions_version ON entity_snapshot_versions (entity_id, version);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_snapshot_versions;
+DROP TABLE entity_snapshots;
+-- +goose StatementEnd
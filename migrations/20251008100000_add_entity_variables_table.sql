@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Values content refers to as {{key}}, defined on an entity for its subtree; the nearest definition wins.
+CREATE TABLE entity_variables
+(
+    entity_id  UUID        NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    key        TEXT        NOT NULL,
+    value      TEXT        NOT NULL,
+    updated_by UUID        NOT NULL REFERENCES users (id),
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entity_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_variables;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the creator of the draft was last reminded that it is stale. A draft touched after it is
+-- reminded about again once it goes stale again.
+ALTER TABLE entities ADD COLUMN draft_reminded_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities DROP COLUMN draft_reminded_at;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The change summary the editor gave when saving the version; empty when none was given.
+ALTER TABLE entity_versions ADD COLUMN summary TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entity_versions DROP COLUMN summary;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Versions saved as minor edits; their edits are left out of the activity feed.
+ALTER TABLE entity_versions ADD COLUMN minor_edit BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entity_versions DROP COLUMN minor_edit;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Language variants: entities holding the same document in different languages share group_id. An
+-- entity is in at most one group and a group has one entity per language.
+CREATE TABLE entity_variants
+(
+    entity_id  UUID        PRIMARY KEY REFERENCES entities (id) ON DELETE CASCADE,
+    group_id   UUID        NOT NULL,
+    language   TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (group_id, language)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE entity_variants;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Lint results: what the content linter found in a version. A row is inserted as running when a version is
+-- claimed for linting and finished as done or failed. lint_disabled turns linting off for a whole subtree.
+CREATE TABLE entity_lint_results
+(
+    entity_id  UUID        NOT NULL,
+    version    INT         NOT NULL,
+    status     TEXT        NOT NULL,
+    findings   JSONB       NOT NULL DEFAULT '[]',
+    started_at TIMESTAMPTZ NOT NULL,
+    checked_at TIMESTAMPTZ,
+    PRIMARY KEY (entity_id, version),
+    FOREIGN KEY (entity_id, version) REFERENCES entity_versions (entity_id, version) ON DELETE CASCADE
+);
+CREATE INDEX idx_entity_lint_results_running ON entity_lint_results (started_at) WHERE status = 'running';
+
+ALTER TABLE entities ADD COLUMN lint_disabled BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities DROP COLUMN lint_disabled;
+DROP TABLE entity_lint_results;
+-- +goose StatementEnd
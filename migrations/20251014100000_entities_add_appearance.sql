@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Appearance of entities in navigation: icon is an emoji or an attachment key, cover an attachment key or
+-- an image URL; empty when unset. Neither is versioned.
+ALTER TABLE entities
+    ADD COLUMN icon  TEXT NOT NULL DEFAULT '',
+    ADD COLUMN cover TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities
+    DROP COLUMN cover,
+    DROP COLUMN icon;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pinned children come first among their siblings, before the ordered ones. The flag belongs to the place
+-- under the parent, so a move clears it (see setPath).
+ALTER TABLE entities
+    ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+-- the pin limit counts the pinned children of a parent
+CREATE INDEX idx_entities_pinned_parent ON entities (parent_id) WHERE pinned;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_entities_pinned_parent;
+ALTER TABLE entities
+    DROP COLUMN pinned;
+-- +goose StatementEnd
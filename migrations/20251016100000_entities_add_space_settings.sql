@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Settings of the space a root entity opens (entity.SpaceSettings as JSON). NULL keeps the defaults; only
+-- rows without a parent carry them.
+ALTER TABLE entities
+    ADD COLUMN space_settings JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE entities
+    DROP COLUMN space_settings;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Files stored in blob storage under blob_key, such as the attachments of imported pages. Icons and
+-- covers refer to them by key, which is resolved in the workspace of the request. entity_id is the page
+-- the file belongs to; reading it requires read permission for that page.
+CREATE TABLE attachments
+(
+    blob_key     TEXT PRIMARY KEY,
+    workspace_id UUID        NOT NULL REFERENCES workspaces (id),
+    entity_id    UUID        REFERENCES entities (id) ON DELETE SET NULL,
+    name         TEXT        NOT NULL,
+    content_type TEXT        NOT NULL,
+    size         BIGINT      NOT NULL,
+    uploaded_by  UUID        REFERENCES users (id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_attachments_workspace ON attachments (workspace_id);
+CREATE INDEX idx_attachments_entity ON attachments (entity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attachments;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Whether the user signs in with the external directory. Directory sign-ins are refused for a local
+-- user with the same email until it is linked.
+ALTER TABLE users ADD COLUMN external BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN external;
+-- +goose StatementEnd